@@ -79,6 +79,30 @@ func seedPermissions(ctx context.Context, client *ent.Client) error {
 		{"treasury.invoices.send", "Send Invoices", "invoices", "send", "invoices", "Send invoices to customers"},
 		{"treasury.invoices.view", "View Invoices", "invoices", "view", "invoices", "View invoices"},
 
+		// Credit control permissions
+		{"treasury.credit.override", "Override Credit Controls", "credit", "override", "credit", "Authorise sales beyond credit limit or on credit hold"},
+
+		// Bill permissions
+		{"treasury.bills.create", "Create Bills", "bills", "create", "bills", "Create vendors and vendor bills"},
+		{"treasury.bills.edit", "Edit Bills", "bills", "edit", "bills", "Edit vendors and vendor bills"},
+		{"treasury.bills.approve", "Approve Bills", "bills", "approve", "bills", "Approve vendor bills"},
+		{"treasury.bills.accept_variance", "Accept Bill Variances", "bills", "accept_variance", "bills", "Accept vendor bills that do not match purchase order receipts"},
+		{"treasury.bills.pay", "Pay Bills", "bills", "pay", "bills", "Schedule and pay vendor bills"},
+		{"treasury.bills.view", "View Bills", "bills", "view", "bills", "View vendors and vendor bills"},
+
+		// Settlement permissions
+		{"treasury.settlements.manage", "Manage Settlements", "settlements", "manage", "settlements", "Generate, adjust and submit settlement batches"},
+		{"treasury.settlements.approve", "Approve Settlements", "settlements", "approve", "settlements", "Approve settlement batches for disbursement"},
+		{"treasury.settlements.view", "View Settlements", "settlements", "view", "settlements", "View settlement batches"},
+		{"treasury.payouts.manage", "Manage Payouts", "payouts", "manage", "payouts", "Request rider and driver payouts and record wallet deductions"},
+		{"treasury.payouts.approve", "Approve Payouts", "payouts", "approve", "payouts", "Complete or reject rider and driver payouts"},
+		{"treasury.payouts.view", "View Payouts", "payouts", "view", "payouts", "View payee wallets, statements and payouts"},
+		{"treasury.wallets.manage", "Manage Wallets", "wallets", "manage", "wallets", "Open wallets, post credits, debits and transfers, and manage holds"},
+		{"treasury.wallets.view", "View Wallets", "wallets", "view", "wallets", "View wallet balances, holds and transactions"},
+		{"treasury.cash_drawers.manage", "Manage Cash Drawers", "cash_drawers", "manage", "cash_drawers", "Recount POS cash drawer sessions"},
+		{"treasury.cash_drawers.approve", "Approve Cash Variances", "cash_drawers", "approve", "cash_drawers", "Approve or reject cash drawer variances above the threshold"},
+		{"treasury.cash_drawers.view", "View Cash Drawers", "cash_drawers", "view", "cash_drawers", "View cash drawer sessions, counts and variances"},
+
 		// Ledger permissions
 		{"treasury.ledger.create", "Create Journal Entries", "ledger", "create", "ledger", "Create journal entries"},
 		{"treasury.ledger.approve", "Approve Journal Entries", "ledger", "approve", "ledger", "Approve journal entries"},
@@ -155,6 +179,12 @@ func seedTenantRoles(ctx context.Context, client *ent.Client, tenantID uuid.UUID
 			permissions: []string{
 				"treasury.payments.*",
 				"treasury.invoices.*",
+				"treasury.credit.*",
+				"treasury.bills.*",
+				"treasury.settlements.*",
+				"treasury.payouts.*",
+				"treasury.wallets.*",
+				"treasury.cash_drawers.*",
 				"treasury.ledger.*",
 				"treasury.banking.*",
 				"treasury.expenses.*",
@@ -174,6 +204,18 @@ func seedTenantRoles(ctx context.Context, client *ent.Client, tenantID uuid.UUID
 				"treasury.invoices.create",
 				"treasury.invoices.edit",
 				"treasury.invoices.view",
+				"treasury.bills.create",
+				"treasury.bills.edit",
+				"treasury.bills.pay",
+				"treasury.bills.view",
+				"treasury.settlements.manage",
+				"treasury.settlements.view",
+				"treasury.payouts.manage",
+				"treasury.payouts.view",
+				"treasury.wallets.manage",
+				"treasury.wallets.view",
+				"treasury.cash_drawers.manage",
+				"treasury.cash_drawers.view",
 				"treasury.ledger.create",
 				"treasury.ledger.view",
 				"treasury.banking.reconcile",
@@ -202,6 +244,17 @@ func seedTenantRoles(ctx context.Context, client *ent.Client, tenantID uuid.UUID
 				"treasury.payments.view",
 				"treasury.invoices.approve",
 				"treasury.invoices.view",
+				"treasury.credit.override",
+				"treasury.bills.approve",
+				"treasury.bills.accept_variance",
+				"treasury.bills.view",
+				"treasury.settlements.approve",
+				"treasury.settlements.view",
+				"treasury.payouts.approve",
+				"treasury.payouts.view",
+				"treasury.wallets.view",
+				"treasury.cash_drawers.approve",
+				"treasury.cash_drawers.view",
 				"treasury.ledger.approve",
 				"treasury.ledger.post",
 				"treasury.ledger.view",
@@ -216,6 +269,11 @@ func seedTenantRoles(ctx context.Context, client *ent.Client, tenantID uuid.UUID
 			permissions: []string{
 				"treasury.payments.view",
 				"treasury.invoices.view",
+				"treasury.bills.view",
+				"treasury.settlements.view",
+				"treasury.payouts.view",
+				"treasury.wallets.view",
+				"treasury.cash_drawers.view",
 				"treasury.ledger.view",
 				"treasury.banking.view",
 				"treasury.expenses.view",
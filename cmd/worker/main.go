@@ -1,7 +1,25 @@
 package main
 
-import "log"
+import (
+	"context"
+	"log"
+	"os/signal"
+	"syscall"
+
+	"github.com/bengobox/treasury-api/internal/worker"
+)
 
 func main() {
-	log.Println("treasury worker coming soon - integrate JetStream consumers and scheduled jobs here")
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	w, err := worker.New(ctx)
+	if err != nil {
+		log.Fatalf("failed to initialise worker: %v", err)
+	}
+	defer w.Close()
+
+	if err := w.Run(ctx); err != nil {
+		log.Fatalf("runtime error: %v", err)
+	}
 }
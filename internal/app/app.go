@@ -12,16 +12,40 @@ import (
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 
+	authclient "github.com/Bengo-Hub/shared-auth-client"
 	"github.com/bengobox/treasury-api/internal/config"
+	"github.com/bengobox/treasury-api/internal/ent"
 	handlers "github.com/bengobox/treasury-api/internal/http/handlers"
 	router "github.com/bengobox/treasury-api/internal/http/router"
+	"github.com/bengobox/treasury-api/internal/modules/aging"
+	"github.com/bengobox/treasury-api/internal/modules/baddebts"
+	"github.com/bengobox/treasury-api/internal/modules/banking"
+	"github.com/bengobox/treasury-api/internal/modules/bills"
+	"github.com/bengobox/treasury-api/internal/modules/cashdrawers"
+	"github.com/bengobox/treasury-api/internal/modules/credit"
+	"github.com/bengobox/treasury-api/internal/modules/customers"
+	"github.com/bengobox/treasury-api/internal/modules/dunning"
+	"github.com/bengobox/treasury-api/internal/modules/earnings"
+	"github.com/bengobox/treasury-api/internal/modules/invoicing"
+	"github.com/bengobox/treasury-api/internal/modules/metering"
+	"github.com/bengobox/treasury-api/internal/modules/paymentruns"
+	"github.com/bengobox/treasury-api/internal/modules/payments"
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/modules/receivables"
+	"github.com/bengobox/treasury-api/internal/modules/reconciliation"
+	"github.com/bengobox/treasury-api/internal/modules/settlements"
+	"github.com/bengobox/treasury-api/internal/modules/statements"
+	"github.com/bengobox/treasury-api/internal/modules/subscriptions"
+	"github.com/bengobox/treasury-api/internal/modules/vendors"
+	"github.com/bengobox/treasury-api/internal/modules/wallets"
+	"github.com/bengobox/treasury-api/internal/modules/withholding"
 	"github.com/bengobox/treasury-api/internal/platform/cache"
 	"github.com/bengobox/treasury-api/internal/platform/database"
 	"github.com/bengobox/treasury-api/internal/platform/events"
+	"github.com/bengobox/treasury-api/internal/platform/mpesa"
 	"github.com/bengobox/treasury-api/internal/platform/secrets"
 	"github.com/bengobox/treasury-api/internal/platform/storage"
 	"github.com/bengobox/treasury-api/internal/shared/logger"
-	authclient "github.com/Bengo-Hub/shared-auth-client"
 )
 
 type App struct {
@@ -29,6 +53,7 @@ type App struct {
 	log        *zap.Logger
 	httpServer *http.Server
 	db         *pgxpool.Pool
+	ent        *ent.Client
 	cache      *redis.Client
 	events     *nats.Conn
 	secrets    secrets.Provider
@@ -50,6 +75,20 @@ func New(ctx context.Context) (*App, error) {
 		return nil, fmt.Errorf("postgres init: %w", err)
 	}
 
+	entClient, err := database.NewEntClient(cfg.Postgres)
+	if err != nil {
+		return nil, fmt.Errorf("ent init: %w", err)
+	}
+
+	if cfg.Postgres.RunMigrations {
+		if err := entClient.Schema.Create(ctx); err != nil {
+			return nil, fmt.Errorf("run migrations: %w", err)
+		}
+		if err := database.Backfill(ctx, entClient); err != nil {
+			return nil, fmt.Errorf("backfill: %w", err)
+		}
+	}
+
 	redisClient := cache.NewClient(cfg.Redis)
 
 	natsConn, err := events.Connect(cfg.Events)
@@ -87,7 +126,73 @@ func New(ctx context.Context) (*App, error) {
 	ledgerHandler := handlers.NewLedger(log)
 	paymentsHandler := handlers.NewPayments()
 
-	httpRouter := router.New(log, healthHandler, ledgerHandler, paymentsHandler, authMiddleware)
+	rbacService := rbac.NewService(rbac.NewEntRepository(entClient), log)
+	receivablesService := receivables.NewService(receivables.NewEntRepository(entClient), log)
+	receivablesHandler := handlers.NewReceivables(log, receivablesService, rbacService)
+	subscriptionsService := subscriptions.NewService(subscriptions.NewEntRepository(entClient), log)
+	subscriptionsHandler := handlers.NewSubscriptions(log, subscriptionsService, rbacService)
+	meteringService := metering.NewService(metering.NewEntRepository(entClient), log)
+	meteringHandler := handlers.NewMetering(log, meteringService, rbacService)
+	customersService := customers.NewService(customers.NewEntRepository(entClient), log)
+	customersHandler := handlers.NewCustomers(log, customersService, rbacService)
+	creditService := credit.NewService(credit.NewEntRepository(entClient), customersService, log)
+	creditHandler := handlers.NewCredit(log, creditService, rbacService)
+	invoicingService := invoicing.NewService(invoicing.NewEntRepository(entClient), creditService, log)
+	invoicingHandler := handlers.NewInvoicing(log, invoicingService, rbacService)
+	dunningService := dunning.NewService(dunning.NewEntRepository(entClient), log)
+	dunningHandler := handlers.NewDunning(log, dunningService, rbacService)
+	agingService := aging.NewService(aging.NewEntRepository(entClient), log)
+	agingHandler := handlers.NewAging(log, agingService, rbacService)
+	statementsService := statements.NewService(statements.NewEntRepository(entClient), customersService, agingService, storage.NewClient(cfg.Storage), log)
+	statementsHandler := handlers.NewStatements(log, statementsService, rbacService)
+	badDebtsService := baddebts.NewService(baddebts.NewEntRepository(entClient), agingService, log)
+	badDebtsHandler := handlers.NewBadDebts(log, badDebtsService, rbacService)
+	paymentsService := payments.NewService(payments.NewEntRepository(entClient), creditService, log)
+	paymentIntentsHandler := handlers.NewPaymentIntents(log, paymentsService, rbacService)
+	vendorsService := vendors.NewService(vendors.NewEntRepository(entClient), log)
+	vendorsHandler := handlers.NewVendors(log, vendorsService, rbacService)
+	withholdingService := withholding.NewService(withholding.NewEntRepository(entClient), log)
+	withholdingHandler := handlers.NewWithholding(log, withholdingService, rbacService)
+	billsService := bills.NewService(bills.NewEntRepository(entClient), vendorsService, withholdingService, log)
+	billsHandler := handlers.NewBills(log, billsService, rbacService)
+	paymentRunsService := paymentruns.NewService(paymentruns.NewEntRepository(entClient), vendorsService, storage.NewClient(cfg.Storage), log)
+	paymentRunsHandler := handlers.NewPaymentRuns(log, paymentRunsService, rbacService)
+	bankingService := banking.NewService(banking.NewEntRepository(entClient), log)
+	bankingHandler := handlers.NewBanking(log, bankingService, rbacService)
+	reconciliationService := reconciliation.NewService(reconciliation.NewEntRepository(entClient), bankingService, log)
+	reconciliationHandler := handlers.NewReconciliation(log, reconciliationService, rbacService)
+	settlementsService := settlements.NewService(settlements.NewEntRepository(entClient), mpesa.NewClient(cfg.Mpesa), log)
+	settlementsHandler := handlers.NewSettlements(log, settlementsService, rbacService, cfg.Mpesa.CallbackToken)
+	earningsService := earnings.NewService(earnings.NewEntRepository(entClient), log)
+	earningsHandler := handlers.NewEarnings(log, earningsService, rbacService)
+	walletsService := wallets.NewService(wallets.NewEntRepository(entClient), log)
+	walletsHandler := handlers.NewWallets(log, walletsService, rbacService)
+	cashDrawersService := cashdrawers.NewService(cashdrawers.NewEntRepository(entClient), log)
+	cashDrawersHandler := handlers.NewCashDrawers(log, cashDrawersService, rbacService)
+
+	httpRouter := router.New(log, healthHandler, ledgerHandler, paymentsHandler, authMiddleware,
+		receivablesHandler,
+		subscriptionsHandler,
+		meteringHandler,
+		invoicingHandler,
+		dunningHandler,
+		customersHandler,
+		agingHandler,
+		statementsHandler,
+		badDebtsHandler,
+		creditHandler,
+		paymentIntentsHandler,
+		vendorsHandler,
+		billsHandler,
+		paymentRunsHandler,
+		withholdingHandler,
+		bankingHandler,
+		reconciliationHandler,
+		settlementsHandler,
+		earningsHandler,
+		walletsHandler,
+		cashDrawersHandler,
+	)
 
 	httpServer := &http.Server{
 		Addr:              fmt.Sprintf("%s:%d", cfg.HTTP.Host, cfg.HTTP.Port),
@@ -103,6 +208,7 @@ func New(ctx context.Context) (*App, error) {
 		log:        log,
 		httpServer: httpServer,
 		db:         dbPool,
+		ent:        entClient,
 		cache:      redisClient,
 		events:     natsConn,
 		secrets:    secretsProvider,
@@ -159,6 +265,12 @@ func (a *App) Close() {
 		}
 	}
 
+	if a.ent != nil {
+		if err := a.ent.Close(); err != nil {
+			a.log.Warn("ent close failed", zap.Error(err))
+		}
+	}
+
 	if a.db != nil {
 		a.db.Close()
 	}
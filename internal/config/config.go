@@ -22,6 +22,8 @@ type Config struct {
 	Secrets   SecretsConfig
 	Telemetry TelemetryConfig
 	Auth      AuthConfig
+	Worker    WorkerConfig
+	Mpesa     MpesaConfig
 }
 
 type AppConfig struct {
@@ -81,6 +83,7 @@ type StorageConfig struct {
 	AccessKey string `envconfig:"STORAGE_ACCESS_KEY"`
 	SecretKey string `envconfig:"STORAGE_SECRET_KEY"`
 	Bucket    string `envconfig:"STORAGE_BUCKET" default:"treasury-artifacts"`
+	Region    string `envconfig:"STORAGE_REGION" default:"us-east-1"`
 	UseSSL    bool   `envconfig:"STORAGE_USE_SSL" default:"false"`
 }
 
@@ -108,6 +111,46 @@ type AuthConfig struct {
 	APIKey              string        `envconfig:"AUTH_API_KEY"` // For service-to-service user sync
 }
 
+// MpesaConfig holds the Daraja API credentials used for B2C and B2B
+// settlement payouts. Result and timeout callbacks are received at
+// CallbackBaseURL/callbacks/mpesa/{result,timeout}?token=CallbackToken.
+type MpesaConfig struct {
+	BaseURL            string        `envconfig:"MPESA_BASE_URL" default:"https://sandbox.safaricom.co.ke"`
+	ConsumerKey        string        `envconfig:"MPESA_CONSUMER_KEY"`
+	ConsumerSecret     string        `envconfig:"MPESA_CONSUMER_SECRET"`
+	ShortCode          string        `envconfig:"MPESA_SHORTCODE"`
+	InitiatorName      string        `envconfig:"MPESA_INITIATOR_NAME"`
+	SecurityCredential string        `envconfig:"MPESA_SECURITY_CREDENTIAL"`
+	CallbackBaseURL    string        `envconfig:"MPESA_CALLBACK_BASE_URL" default:"http://localhost:4001"`
+	CallbackToken      string        `envconfig:"MPESA_CALLBACK_TOKEN"`
+	Timeout            time.Duration `envconfig:"MPESA_TIMEOUT" default:"30s"`
+}
+
+// WorkerConfig controls the background job intervals of cmd/worker.
+type WorkerConfig struct {
+	OutboxInterval  time.Duration `envconfig:"WORKER_OUTBOX_INTERVAL" default:"5s"`
+	BillingInterval time.Duration `envconfig:"WORKER_BILLING_INTERVAL" default:"1m"`
+	DunningInterval time.Duration `envconfig:"WORKER_DUNNING_INTERVAL" default:"1h"`
+	// ReceiptInterval is how often succeeded payments, refunds and
+	// chargebacks are booked into unapplied receipts.
+	ReceiptInterval time.Duration `envconfig:"WORKER_RECEIPT_INTERVAL" default:"1m"`
+	// StatementInterval is how often month-end statements are checked for;
+	// each customer's statement is generated once per month.
+	StatementInterval time.Duration `envconfig:"WORKER_STATEMENT_INTERVAL" default:"6h"`
+	// ProvisionInterval is how often the month-end doubtful debt provision is
+	// checked for; each tenant is provisioned once per month end.
+	ProvisionInterval time.Duration `envconfig:"WORKER_PROVISION_INTERVAL" default:"6h"`
+	// CreditHoldInterval is how often customers are put on or released from
+	// automatic credit hold for overdue invoices.
+	CreditHoldInterval time.Duration `envconfig:"WORKER_CREDIT_HOLD_INTERVAL" default:"1h"`
+	// SettlementInterval is how often the previous day's collections are
+	// checked for settlement; each tenant is batched once per day.
+	SettlementInterval time.Duration `envconfig:"WORKER_SETTLEMENT_INTERVAL" default:"1h"`
+	// DisbursementInterval is how often payouts due for a retry are
+	// resubmitted and payouts with no M-Pesa result are timed out.
+	DisbursementInterval time.Duration `envconfig:"WORKER_DISBURSEMENT_INTERVAL" default:"1m"`
+}
+
 // Load gathers configuration from environment variables and optional .env files.
 func Load() (*Config, error) {
 	_ = godotenv.Load()
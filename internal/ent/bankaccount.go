@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/bankaccount"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BankAccount is the model entity for the BankAccount schema.
+type BankAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Name the account is known by, e.g. KCB Operating
+	AccountName string `json:"account_name,omitempty"`
+	// Bank or provider, e.g. KCB, Safaricom
+	BankName string `json:"bank_name,omitempty"`
+	// Branch holds the value of the "branch" field.
+	Branch string `json:"branch,omitempty"`
+	// Account type: bank, mpesa_paybill, mpesa_till, float_wallet
+	AccountType string `json:"account_type,omitempty"`
+	// Account number with all but the last four characters masked
+	AccountNumberMasked string `json:"account_number_masked,omitempty"`
+	// SHA-256 fingerprint of the normalised account number
+	AccountNumberHash string `json:"-"`
+	// ISO currency code
+	Currency string `json:"currency,omitempty"`
+	// Cash account in the chart of accounts the account is booked to
+	LedgerAccountCode string `json:"ledger_account_code,omitempty"`
+	// Closing balance of the last imported statement
+	StatementBalance *decimal.Decimal `json:"statement_balance,omitempty"`
+	// Date of the last imported statement balance
+	StatementDate *time.Time `json:"statement_date,omitempty"`
+	// Status: active, inactive, closed
+	Status string `json:"status,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy uuid.UUID `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BankAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bankaccount.FieldStatementBalance:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case bankaccount.FieldMetadata:
+			values[i] = new([]byte)
+		case bankaccount.FieldAccountName, bankaccount.FieldBankName, bankaccount.FieldBranch, bankaccount.FieldAccountType, bankaccount.FieldAccountNumberMasked, bankaccount.FieldAccountNumberHash, bankaccount.FieldCurrency, bankaccount.FieldLedgerAccountCode, bankaccount.FieldStatus:
+			values[i] = new(sql.NullString)
+		case bankaccount.FieldStatementDate, bankaccount.FieldCreatedAt, bankaccount.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case bankaccount.FieldID, bankaccount.FieldTenantID, bankaccount.FieldCreatedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BankAccount fields.
+func (_m *BankAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bankaccount.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case bankaccount.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case bankaccount.FieldAccountName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_name", values[i])
+			} else if value.Valid {
+				_m.AccountName = value.String
+			}
+		case bankaccount.FieldBankName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bank_name", values[i])
+			} else if value.Valid {
+				_m.BankName = value.String
+			}
+		case bankaccount.FieldBranch:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field branch", values[i])
+			} else if value.Valid {
+				_m.Branch = value.String
+			}
+		case bankaccount.FieldAccountType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_type", values[i])
+			} else if value.Valid {
+				_m.AccountType = value.String
+			}
+		case bankaccount.FieldAccountNumberMasked:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_number_masked", values[i])
+			} else if value.Valid {
+				_m.AccountNumberMasked = value.String
+			}
+		case bankaccount.FieldAccountNumberHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_number_hash", values[i])
+			} else if value.Valid {
+				_m.AccountNumberHash = value.String
+			}
+		case bankaccount.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case bankaccount.FieldLedgerAccountCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_account_code", values[i])
+			} else if value.Valid {
+				_m.LedgerAccountCode = value.String
+			}
+		case bankaccount.FieldStatementBalance:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field statement_balance", values[i])
+			} else if value.Valid {
+				_m.StatementBalance = new(decimal.Decimal)
+				*_m.StatementBalance = *value.S.(*decimal.Decimal)
+			}
+		case bankaccount.FieldStatementDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field statement_date", values[i])
+			} else if value.Valid {
+				_m.StatementDate = new(time.Time)
+				*_m.StatementDate = value.Time
+			}
+		case bankaccount.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case bankaccount.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case bankaccount.FieldCreatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value != nil {
+				_m.CreatedBy = *value
+			}
+		case bankaccount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case bankaccount.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BankAccount.
+// This includes values selected through modifiers, order, etc.
+func (_m *BankAccount) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BankAccount.
+// Note that you need to call BankAccount.Unwrap() before calling this method if this BankAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BankAccount) Update() *BankAccountUpdateOne {
+	return NewBankAccountClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BankAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BankAccount) Unwrap() *BankAccount {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BankAccount is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BankAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("BankAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("account_name=")
+	builder.WriteString(_m.AccountName)
+	builder.WriteString(", ")
+	builder.WriteString("bank_name=")
+	builder.WriteString(_m.BankName)
+	builder.WriteString(", ")
+	builder.WriteString("branch=")
+	builder.WriteString(_m.Branch)
+	builder.WriteString(", ")
+	builder.WriteString("account_type=")
+	builder.WriteString(_m.AccountType)
+	builder.WriteString(", ")
+	builder.WriteString("account_number_masked=")
+	builder.WriteString(_m.AccountNumberMasked)
+	builder.WriteString(", ")
+	builder.WriteString("account_number_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("ledger_account_code=")
+	builder.WriteString(_m.LedgerAccountCode)
+	builder.WriteString(", ")
+	if v := _m.StatementBalance; v != nil {
+		builder.WriteString("statement_balance=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.StatementDate; v != nil {
+		builder.WriteString("statement_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BankAccounts is a parsable slice of BankAccount.
+type BankAccounts []*BankAccount
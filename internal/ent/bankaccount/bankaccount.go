@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bankaccount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the bankaccount type in the database.
+	Label = "bank_account"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldAccountName holds the string denoting the account_name field in the database.
+	FieldAccountName = "account_name"
+	// FieldBankName holds the string denoting the bank_name field in the database.
+	FieldBankName = "bank_name"
+	// FieldBranch holds the string denoting the branch field in the database.
+	FieldBranch = "branch"
+	// FieldAccountType holds the string denoting the account_type field in the database.
+	FieldAccountType = "account_type"
+	// FieldAccountNumberMasked holds the string denoting the account_number_masked field in the database.
+	FieldAccountNumberMasked = "account_number_masked"
+	// FieldAccountNumberHash holds the string denoting the account_number_hash field in the database.
+	FieldAccountNumberHash = "account_number_hash"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldLedgerAccountCode holds the string denoting the ledger_account_code field in the database.
+	FieldLedgerAccountCode = "ledger_account_code"
+	// FieldStatementBalance holds the string denoting the statement_balance field in the database.
+	FieldStatementBalance = "statement_balance"
+	// FieldStatementDate holds the string denoting the statement_date field in the database.
+	FieldStatementDate = "statement_date"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the bankaccount in the database.
+	Table = "bank_accounts"
+)
+
+// Columns holds all SQL columns for bankaccount fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldAccountName,
+	FieldBankName,
+	FieldBranch,
+	FieldAccountType,
+	FieldAccountNumberMasked,
+	FieldAccountNumberHash,
+	FieldCurrency,
+	FieldLedgerAccountCode,
+	FieldStatementBalance,
+	FieldStatementDate,
+	FieldStatus,
+	FieldMetadata,
+	FieldCreatedBy,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// AccountNameValidator is a validator for the "account_name" field. It is called by the builders before save.
+	AccountNameValidator func(string) error
+	// BankNameValidator is a validator for the "bank_name" field. It is called by the builders before save.
+	BankNameValidator func(string) error
+	// AccountTypeValidator is a validator for the "account_type" field. It is called by the builders before save.
+	AccountTypeValidator func(string) error
+	// AccountNumberMaskedValidator is a validator for the "account_number_masked" field. It is called by the builders before save.
+	AccountNumberMaskedValidator func(string) error
+	// AccountNumberHashValidator is a validator for the "account_number_hash" field. It is called by the builders before save.
+	AccountNumberHashValidator func(string) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// LedgerAccountCodeValidator is a validator for the "ledger_account_code" field. It is called by the builders before save.
+	LedgerAccountCodeValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultMetadata holds the default value on creation for the "metadata" field.
+	DefaultMetadata map[string]interface{}
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the BankAccount queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByAccountName orders the results by the account_name field.
+func ByAccountName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountName, opts...).ToFunc()
+}
+
+// ByBankName orders the results by the bank_name field.
+func ByBankName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBankName, opts...).ToFunc()
+}
+
+// ByBranch orders the results by the branch field.
+func ByBranch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBranch, opts...).ToFunc()
+}
+
+// ByAccountType orders the results by the account_type field.
+func ByAccountType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountType, opts...).ToFunc()
+}
+
+// ByAccountNumberMasked orders the results by the account_number_masked field.
+func ByAccountNumberMasked(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountNumberMasked, opts...).ToFunc()
+}
+
+// ByAccountNumberHash orders the results by the account_number_hash field.
+func ByAccountNumberHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountNumberHash, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByLedgerAccountCode orders the results by the ledger_account_code field.
+func ByLedgerAccountCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLedgerAccountCode, opts...).ToFunc()
+}
+
+// ByStatementBalance orders the results by the statement_balance field.
+func ByStatementBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatementBalance, opts...).ToFunc()
+}
+
+// ByStatementDate orders the results by the statement_date field.
+func ByStatementDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatementDate, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,1012 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bankaccount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldTenantID, v))
+}
+
+// AccountName applies equality check predicate on the "account_name" field. It's identical to AccountNameEQ.
+func AccountName(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldAccountName, v))
+}
+
+// BankName applies equality check predicate on the "bank_name" field. It's identical to BankNameEQ.
+func BankName(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldBankName, v))
+}
+
+// Branch applies equality check predicate on the "branch" field. It's identical to BranchEQ.
+func Branch(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldBranch, v))
+}
+
+// AccountType applies equality check predicate on the "account_type" field. It's identical to AccountTypeEQ.
+func AccountType(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldAccountType, v))
+}
+
+// AccountNumberMasked applies equality check predicate on the "account_number_masked" field. It's identical to AccountNumberMaskedEQ.
+func AccountNumberMasked(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldAccountNumberMasked, v))
+}
+
+// AccountNumberHash applies equality check predicate on the "account_number_hash" field. It's identical to AccountNumberHashEQ.
+func AccountNumberHash(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldAccountNumberHash, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldCurrency, v))
+}
+
+// LedgerAccountCode applies equality check predicate on the "ledger_account_code" field. It's identical to LedgerAccountCodeEQ.
+func LedgerAccountCode(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldLedgerAccountCode, v))
+}
+
+// StatementBalance applies equality check predicate on the "statement_balance" field. It's identical to StatementBalanceEQ.
+func StatementBalance(v decimal.Decimal) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldStatementBalance, v))
+}
+
+// StatementDate applies equality check predicate on the "statement_date" field. It's identical to StatementDateEQ.
+func StatementDate(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldStatementDate, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLTE(FieldTenantID, v))
+}
+
+// AccountNameEQ applies the EQ predicate on the "account_name" field.
+func AccountNameEQ(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldAccountName, v))
+}
+
+// AccountNameNEQ applies the NEQ predicate on the "account_name" field.
+func AccountNameNEQ(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNEQ(FieldAccountName, v))
+}
+
+// AccountNameIn applies the In predicate on the "account_name" field.
+func AccountNameIn(vs ...string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIn(FieldAccountName, vs...))
+}
+
+// AccountNameNotIn applies the NotIn predicate on the "account_name" field.
+func AccountNameNotIn(vs ...string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotIn(FieldAccountName, vs...))
+}
+
+// AccountNameGT applies the GT predicate on the "account_name" field.
+func AccountNameGT(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGT(FieldAccountName, v))
+}
+
+// AccountNameGTE applies the GTE predicate on the "account_name" field.
+func AccountNameGTE(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGTE(FieldAccountName, v))
+}
+
+// AccountNameLT applies the LT predicate on the "account_name" field.
+func AccountNameLT(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLT(FieldAccountName, v))
+}
+
+// AccountNameLTE applies the LTE predicate on the "account_name" field.
+func AccountNameLTE(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLTE(FieldAccountName, v))
+}
+
+// AccountNameContains applies the Contains predicate on the "account_name" field.
+func AccountNameContains(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldContains(FieldAccountName, v))
+}
+
+// AccountNameHasPrefix applies the HasPrefix predicate on the "account_name" field.
+func AccountNameHasPrefix(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldHasPrefix(FieldAccountName, v))
+}
+
+// AccountNameHasSuffix applies the HasSuffix predicate on the "account_name" field.
+func AccountNameHasSuffix(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldHasSuffix(FieldAccountName, v))
+}
+
+// AccountNameEqualFold applies the EqualFold predicate on the "account_name" field.
+func AccountNameEqualFold(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEqualFold(FieldAccountName, v))
+}
+
+// AccountNameContainsFold applies the ContainsFold predicate on the "account_name" field.
+func AccountNameContainsFold(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldContainsFold(FieldAccountName, v))
+}
+
+// BankNameEQ applies the EQ predicate on the "bank_name" field.
+func BankNameEQ(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldBankName, v))
+}
+
+// BankNameNEQ applies the NEQ predicate on the "bank_name" field.
+func BankNameNEQ(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNEQ(FieldBankName, v))
+}
+
+// BankNameIn applies the In predicate on the "bank_name" field.
+func BankNameIn(vs ...string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIn(FieldBankName, vs...))
+}
+
+// BankNameNotIn applies the NotIn predicate on the "bank_name" field.
+func BankNameNotIn(vs ...string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotIn(FieldBankName, vs...))
+}
+
+// BankNameGT applies the GT predicate on the "bank_name" field.
+func BankNameGT(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGT(FieldBankName, v))
+}
+
+// BankNameGTE applies the GTE predicate on the "bank_name" field.
+func BankNameGTE(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGTE(FieldBankName, v))
+}
+
+// BankNameLT applies the LT predicate on the "bank_name" field.
+func BankNameLT(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLT(FieldBankName, v))
+}
+
+// BankNameLTE applies the LTE predicate on the "bank_name" field.
+func BankNameLTE(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLTE(FieldBankName, v))
+}
+
+// BankNameContains applies the Contains predicate on the "bank_name" field.
+func BankNameContains(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldContains(FieldBankName, v))
+}
+
+// BankNameHasPrefix applies the HasPrefix predicate on the "bank_name" field.
+func BankNameHasPrefix(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldHasPrefix(FieldBankName, v))
+}
+
+// BankNameHasSuffix applies the HasSuffix predicate on the "bank_name" field.
+func BankNameHasSuffix(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldHasSuffix(FieldBankName, v))
+}
+
+// BankNameEqualFold applies the EqualFold predicate on the "bank_name" field.
+func BankNameEqualFold(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEqualFold(FieldBankName, v))
+}
+
+// BankNameContainsFold applies the ContainsFold predicate on the "bank_name" field.
+func BankNameContainsFold(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldContainsFold(FieldBankName, v))
+}
+
+// BranchEQ applies the EQ predicate on the "branch" field.
+func BranchEQ(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldBranch, v))
+}
+
+// BranchNEQ applies the NEQ predicate on the "branch" field.
+func BranchNEQ(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNEQ(FieldBranch, v))
+}
+
+// BranchIn applies the In predicate on the "branch" field.
+func BranchIn(vs ...string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIn(FieldBranch, vs...))
+}
+
+// BranchNotIn applies the NotIn predicate on the "branch" field.
+func BranchNotIn(vs ...string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotIn(FieldBranch, vs...))
+}
+
+// BranchGT applies the GT predicate on the "branch" field.
+func BranchGT(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGT(FieldBranch, v))
+}
+
+// BranchGTE applies the GTE predicate on the "branch" field.
+func BranchGTE(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGTE(FieldBranch, v))
+}
+
+// BranchLT applies the LT predicate on the "branch" field.
+func BranchLT(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLT(FieldBranch, v))
+}
+
+// BranchLTE applies the LTE predicate on the "branch" field.
+func BranchLTE(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLTE(FieldBranch, v))
+}
+
+// BranchContains applies the Contains predicate on the "branch" field.
+func BranchContains(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldContains(FieldBranch, v))
+}
+
+// BranchHasPrefix applies the HasPrefix predicate on the "branch" field.
+func BranchHasPrefix(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldHasPrefix(FieldBranch, v))
+}
+
+// BranchHasSuffix applies the HasSuffix predicate on the "branch" field.
+func BranchHasSuffix(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldHasSuffix(FieldBranch, v))
+}
+
+// BranchIsNil applies the IsNil predicate on the "branch" field.
+func BranchIsNil() predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIsNull(FieldBranch))
+}
+
+// BranchNotNil applies the NotNil predicate on the "branch" field.
+func BranchNotNil() predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotNull(FieldBranch))
+}
+
+// BranchEqualFold applies the EqualFold predicate on the "branch" field.
+func BranchEqualFold(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEqualFold(FieldBranch, v))
+}
+
+// BranchContainsFold applies the ContainsFold predicate on the "branch" field.
+func BranchContainsFold(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldContainsFold(FieldBranch, v))
+}
+
+// AccountTypeEQ applies the EQ predicate on the "account_type" field.
+func AccountTypeEQ(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldAccountType, v))
+}
+
+// AccountTypeNEQ applies the NEQ predicate on the "account_type" field.
+func AccountTypeNEQ(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNEQ(FieldAccountType, v))
+}
+
+// AccountTypeIn applies the In predicate on the "account_type" field.
+func AccountTypeIn(vs ...string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIn(FieldAccountType, vs...))
+}
+
+// AccountTypeNotIn applies the NotIn predicate on the "account_type" field.
+func AccountTypeNotIn(vs ...string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotIn(FieldAccountType, vs...))
+}
+
+// AccountTypeGT applies the GT predicate on the "account_type" field.
+func AccountTypeGT(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGT(FieldAccountType, v))
+}
+
+// AccountTypeGTE applies the GTE predicate on the "account_type" field.
+func AccountTypeGTE(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGTE(FieldAccountType, v))
+}
+
+// AccountTypeLT applies the LT predicate on the "account_type" field.
+func AccountTypeLT(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLT(FieldAccountType, v))
+}
+
+// AccountTypeLTE applies the LTE predicate on the "account_type" field.
+func AccountTypeLTE(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLTE(FieldAccountType, v))
+}
+
+// AccountTypeContains applies the Contains predicate on the "account_type" field.
+func AccountTypeContains(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldContains(FieldAccountType, v))
+}
+
+// AccountTypeHasPrefix applies the HasPrefix predicate on the "account_type" field.
+func AccountTypeHasPrefix(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldHasPrefix(FieldAccountType, v))
+}
+
+// AccountTypeHasSuffix applies the HasSuffix predicate on the "account_type" field.
+func AccountTypeHasSuffix(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldHasSuffix(FieldAccountType, v))
+}
+
+// AccountTypeEqualFold applies the EqualFold predicate on the "account_type" field.
+func AccountTypeEqualFold(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEqualFold(FieldAccountType, v))
+}
+
+// AccountTypeContainsFold applies the ContainsFold predicate on the "account_type" field.
+func AccountTypeContainsFold(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldContainsFold(FieldAccountType, v))
+}
+
+// AccountNumberMaskedEQ applies the EQ predicate on the "account_number_masked" field.
+func AccountNumberMaskedEQ(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldAccountNumberMasked, v))
+}
+
+// AccountNumberMaskedNEQ applies the NEQ predicate on the "account_number_masked" field.
+func AccountNumberMaskedNEQ(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNEQ(FieldAccountNumberMasked, v))
+}
+
+// AccountNumberMaskedIn applies the In predicate on the "account_number_masked" field.
+func AccountNumberMaskedIn(vs ...string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIn(FieldAccountNumberMasked, vs...))
+}
+
+// AccountNumberMaskedNotIn applies the NotIn predicate on the "account_number_masked" field.
+func AccountNumberMaskedNotIn(vs ...string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotIn(FieldAccountNumberMasked, vs...))
+}
+
+// AccountNumberMaskedGT applies the GT predicate on the "account_number_masked" field.
+func AccountNumberMaskedGT(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGT(FieldAccountNumberMasked, v))
+}
+
+// AccountNumberMaskedGTE applies the GTE predicate on the "account_number_masked" field.
+func AccountNumberMaskedGTE(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGTE(FieldAccountNumberMasked, v))
+}
+
+// AccountNumberMaskedLT applies the LT predicate on the "account_number_masked" field.
+func AccountNumberMaskedLT(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLT(FieldAccountNumberMasked, v))
+}
+
+// AccountNumberMaskedLTE applies the LTE predicate on the "account_number_masked" field.
+func AccountNumberMaskedLTE(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLTE(FieldAccountNumberMasked, v))
+}
+
+// AccountNumberMaskedContains applies the Contains predicate on the "account_number_masked" field.
+func AccountNumberMaskedContains(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldContains(FieldAccountNumberMasked, v))
+}
+
+// AccountNumberMaskedHasPrefix applies the HasPrefix predicate on the "account_number_masked" field.
+func AccountNumberMaskedHasPrefix(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldHasPrefix(FieldAccountNumberMasked, v))
+}
+
+// AccountNumberMaskedHasSuffix applies the HasSuffix predicate on the "account_number_masked" field.
+func AccountNumberMaskedHasSuffix(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldHasSuffix(FieldAccountNumberMasked, v))
+}
+
+// AccountNumberMaskedEqualFold applies the EqualFold predicate on the "account_number_masked" field.
+func AccountNumberMaskedEqualFold(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEqualFold(FieldAccountNumberMasked, v))
+}
+
+// AccountNumberMaskedContainsFold applies the ContainsFold predicate on the "account_number_masked" field.
+func AccountNumberMaskedContainsFold(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldContainsFold(FieldAccountNumberMasked, v))
+}
+
+// AccountNumberHashEQ applies the EQ predicate on the "account_number_hash" field.
+func AccountNumberHashEQ(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldAccountNumberHash, v))
+}
+
+// AccountNumberHashNEQ applies the NEQ predicate on the "account_number_hash" field.
+func AccountNumberHashNEQ(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNEQ(FieldAccountNumberHash, v))
+}
+
+// AccountNumberHashIn applies the In predicate on the "account_number_hash" field.
+func AccountNumberHashIn(vs ...string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIn(FieldAccountNumberHash, vs...))
+}
+
+// AccountNumberHashNotIn applies the NotIn predicate on the "account_number_hash" field.
+func AccountNumberHashNotIn(vs ...string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotIn(FieldAccountNumberHash, vs...))
+}
+
+// AccountNumberHashGT applies the GT predicate on the "account_number_hash" field.
+func AccountNumberHashGT(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGT(FieldAccountNumberHash, v))
+}
+
+// AccountNumberHashGTE applies the GTE predicate on the "account_number_hash" field.
+func AccountNumberHashGTE(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGTE(FieldAccountNumberHash, v))
+}
+
+// AccountNumberHashLT applies the LT predicate on the "account_number_hash" field.
+func AccountNumberHashLT(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLT(FieldAccountNumberHash, v))
+}
+
+// AccountNumberHashLTE applies the LTE predicate on the "account_number_hash" field.
+func AccountNumberHashLTE(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLTE(FieldAccountNumberHash, v))
+}
+
+// AccountNumberHashContains applies the Contains predicate on the "account_number_hash" field.
+func AccountNumberHashContains(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldContains(FieldAccountNumberHash, v))
+}
+
+// AccountNumberHashHasPrefix applies the HasPrefix predicate on the "account_number_hash" field.
+func AccountNumberHashHasPrefix(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldHasPrefix(FieldAccountNumberHash, v))
+}
+
+// AccountNumberHashHasSuffix applies the HasSuffix predicate on the "account_number_hash" field.
+func AccountNumberHashHasSuffix(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldHasSuffix(FieldAccountNumberHash, v))
+}
+
+// AccountNumberHashEqualFold applies the EqualFold predicate on the "account_number_hash" field.
+func AccountNumberHashEqualFold(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEqualFold(FieldAccountNumberHash, v))
+}
+
+// AccountNumberHashContainsFold applies the ContainsFold predicate on the "account_number_hash" field.
+func AccountNumberHashContainsFold(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldContainsFold(FieldAccountNumberHash, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// LedgerAccountCodeEQ applies the EQ predicate on the "ledger_account_code" field.
+func LedgerAccountCodeEQ(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldLedgerAccountCode, v))
+}
+
+// LedgerAccountCodeNEQ applies the NEQ predicate on the "ledger_account_code" field.
+func LedgerAccountCodeNEQ(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNEQ(FieldLedgerAccountCode, v))
+}
+
+// LedgerAccountCodeIn applies the In predicate on the "ledger_account_code" field.
+func LedgerAccountCodeIn(vs ...string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIn(FieldLedgerAccountCode, vs...))
+}
+
+// LedgerAccountCodeNotIn applies the NotIn predicate on the "ledger_account_code" field.
+func LedgerAccountCodeNotIn(vs ...string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotIn(FieldLedgerAccountCode, vs...))
+}
+
+// LedgerAccountCodeGT applies the GT predicate on the "ledger_account_code" field.
+func LedgerAccountCodeGT(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGT(FieldLedgerAccountCode, v))
+}
+
+// LedgerAccountCodeGTE applies the GTE predicate on the "ledger_account_code" field.
+func LedgerAccountCodeGTE(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGTE(FieldLedgerAccountCode, v))
+}
+
+// LedgerAccountCodeLT applies the LT predicate on the "ledger_account_code" field.
+func LedgerAccountCodeLT(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLT(FieldLedgerAccountCode, v))
+}
+
+// LedgerAccountCodeLTE applies the LTE predicate on the "ledger_account_code" field.
+func LedgerAccountCodeLTE(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLTE(FieldLedgerAccountCode, v))
+}
+
+// LedgerAccountCodeContains applies the Contains predicate on the "ledger_account_code" field.
+func LedgerAccountCodeContains(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldContains(FieldLedgerAccountCode, v))
+}
+
+// LedgerAccountCodeHasPrefix applies the HasPrefix predicate on the "ledger_account_code" field.
+func LedgerAccountCodeHasPrefix(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldHasPrefix(FieldLedgerAccountCode, v))
+}
+
+// LedgerAccountCodeHasSuffix applies the HasSuffix predicate on the "ledger_account_code" field.
+func LedgerAccountCodeHasSuffix(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldHasSuffix(FieldLedgerAccountCode, v))
+}
+
+// LedgerAccountCodeEqualFold applies the EqualFold predicate on the "ledger_account_code" field.
+func LedgerAccountCodeEqualFold(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEqualFold(FieldLedgerAccountCode, v))
+}
+
+// LedgerAccountCodeContainsFold applies the ContainsFold predicate on the "ledger_account_code" field.
+func LedgerAccountCodeContainsFold(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldContainsFold(FieldLedgerAccountCode, v))
+}
+
+// StatementBalanceEQ applies the EQ predicate on the "statement_balance" field.
+func StatementBalanceEQ(v decimal.Decimal) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldStatementBalance, v))
+}
+
+// StatementBalanceNEQ applies the NEQ predicate on the "statement_balance" field.
+func StatementBalanceNEQ(v decimal.Decimal) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNEQ(FieldStatementBalance, v))
+}
+
+// StatementBalanceIn applies the In predicate on the "statement_balance" field.
+func StatementBalanceIn(vs ...decimal.Decimal) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIn(FieldStatementBalance, vs...))
+}
+
+// StatementBalanceNotIn applies the NotIn predicate on the "statement_balance" field.
+func StatementBalanceNotIn(vs ...decimal.Decimal) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotIn(FieldStatementBalance, vs...))
+}
+
+// StatementBalanceGT applies the GT predicate on the "statement_balance" field.
+func StatementBalanceGT(v decimal.Decimal) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGT(FieldStatementBalance, v))
+}
+
+// StatementBalanceGTE applies the GTE predicate on the "statement_balance" field.
+func StatementBalanceGTE(v decimal.Decimal) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGTE(FieldStatementBalance, v))
+}
+
+// StatementBalanceLT applies the LT predicate on the "statement_balance" field.
+func StatementBalanceLT(v decimal.Decimal) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLT(FieldStatementBalance, v))
+}
+
+// StatementBalanceLTE applies the LTE predicate on the "statement_balance" field.
+func StatementBalanceLTE(v decimal.Decimal) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLTE(FieldStatementBalance, v))
+}
+
+// StatementBalanceIsNil applies the IsNil predicate on the "statement_balance" field.
+func StatementBalanceIsNil() predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIsNull(FieldStatementBalance))
+}
+
+// StatementBalanceNotNil applies the NotNil predicate on the "statement_balance" field.
+func StatementBalanceNotNil() predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotNull(FieldStatementBalance))
+}
+
+// StatementDateEQ applies the EQ predicate on the "statement_date" field.
+func StatementDateEQ(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldStatementDate, v))
+}
+
+// StatementDateNEQ applies the NEQ predicate on the "statement_date" field.
+func StatementDateNEQ(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNEQ(FieldStatementDate, v))
+}
+
+// StatementDateIn applies the In predicate on the "statement_date" field.
+func StatementDateIn(vs ...time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIn(FieldStatementDate, vs...))
+}
+
+// StatementDateNotIn applies the NotIn predicate on the "statement_date" field.
+func StatementDateNotIn(vs ...time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotIn(FieldStatementDate, vs...))
+}
+
+// StatementDateGT applies the GT predicate on the "statement_date" field.
+func StatementDateGT(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGT(FieldStatementDate, v))
+}
+
+// StatementDateGTE applies the GTE predicate on the "statement_date" field.
+func StatementDateGTE(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGTE(FieldStatementDate, v))
+}
+
+// StatementDateLT applies the LT predicate on the "statement_date" field.
+func StatementDateLT(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLT(FieldStatementDate, v))
+}
+
+// StatementDateLTE applies the LTE predicate on the "statement_date" field.
+func StatementDateLTE(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLTE(FieldStatementDate, v))
+}
+
+// StatementDateIsNil applies the IsNil predicate on the "statement_date" field.
+func StatementDateIsNil() predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIsNull(FieldStatementDate))
+}
+
+// StatementDateNotNil applies the NotNil predicate on the "statement_date" field.
+func StatementDateNotNil() predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotNull(FieldStatementDate))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uuid.UUID) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BankAccount {
+	return predicate.BankAccount(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BankAccount) predicate.BankAccount {
+	return predicate.BankAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BankAccount) predicate.BankAccount {
+	return predicate.BankAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BankAccount) predicate.BankAccount {
+	return predicate.BankAccount(sql.NotPredicates(p))
+}
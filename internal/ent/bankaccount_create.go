@@ -0,0 +1,1473 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/bankaccount"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BankAccountCreate is the builder for creating a BankAccount entity.
+type BankAccountCreate struct {
+	config
+	mutation *BankAccountMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *BankAccountCreate) SetTenantID(v uuid.UUID) *BankAccountCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetAccountName sets the "account_name" field.
+func (_c *BankAccountCreate) SetAccountName(v string) *BankAccountCreate {
+	_c.mutation.SetAccountName(v)
+	return _c
+}
+
+// SetBankName sets the "bank_name" field.
+func (_c *BankAccountCreate) SetBankName(v string) *BankAccountCreate {
+	_c.mutation.SetBankName(v)
+	return _c
+}
+
+// SetBranch sets the "branch" field.
+func (_c *BankAccountCreate) SetBranch(v string) *BankAccountCreate {
+	_c.mutation.SetBranch(v)
+	return _c
+}
+
+// SetNillableBranch sets the "branch" field if the given value is not nil.
+func (_c *BankAccountCreate) SetNillableBranch(v *string) *BankAccountCreate {
+	if v != nil {
+		_c.SetBranch(*v)
+	}
+	return _c
+}
+
+// SetAccountType sets the "account_type" field.
+func (_c *BankAccountCreate) SetAccountType(v string) *BankAccountCreate {
+	_c.mutation.SetAccountType(v)
+	return _c
+}
+
+// SetAccountNumberMasked sets the "account_number_masked" field.
+func (_c *BankAccountCreate) SetAccountNumberMasked(v string) *BankAccountCreate {
+	_c.mutation.SetAccountNumberMasked(v)
+	return _c
+}
+
+// SetAccountNumberHash sets the "account_number_hash" field.
+func (_c *BankAccountCreate) SetAccountNumberHash(v string) *BankAccountCreate {
+	_c.mutation.SetAccountNumberHash(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *BankAccountCreate) SetCurrency(v string) *BankAccountCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *BankAccountCreate) SetNillableCurrency(v *string) *BankAccountCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetLedgerAccountCode sets the "ledger_account_code" field.
+func (_c *BankAccountCreate) SetLedgerAccountCode(v string) *BankAccountCreate {
+	_c.mutation.SetLedgerAccountCode(v)
+	return _c
+}
+
+// SetStatementBalance sets the "statement_balance" field.
+func (_c *BankAccountCreate) SetStatementBalance(v decimal.Decimal) *BankAccountCreate {
+	_c.mutation.SetStatementBalance(v)
+	return _c
+}
+
+// SetNillableStatementBalance sets the "statement_balance" field if the given value is not nil.
+func (_c *BankAccountCreate) SetNillableStatementBalance(v *decimal.Decimal) *BankAccountCreate {
+	if v != nil {
+		_c.SetStatementBalance(*v)
+	}
+	return _c
+}
+
+// SetStatementDate sets the "statement_date" field.
+func (_c *BankAccountCreate) SetStatementDate(v time.Time) *BankAccountCreate {
+	_c.mutation.SetStatementDate(v)
+	return _c
+}
+
+// SetNillableStatementDate sets the "statement_date" field if the given value is not nil.
+func (_c *BankAccountCreate) SetNillableStatementDate(v *time.Time) *BankAccountCreate {
+	if v != nil {
+		_c.SetStatementDate(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *BankAccountCreate) SetStatus(v string) *BankAccountCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *BankAccountCreate) SetNillableStatus(v *string) *BankAccountCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *BankAccountCreate) SetMetadata(v map[string]interface{}) *BankAccountCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *BankAccountCreate) SetCreatedBy(v uuid.UUID) *BankAccountCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *BankAccountCreate) SetNillableCreatedBy(v *uuid.UUID) *BankAccountCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BankAccountCreate) SetCreatedAt(v time.Time) *BankAccountCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BankAccountCreate) SetNillableCreatedAt(v *time.Time) *BankAccountCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BankAccountCreate) SetUpdatedAt(v time.Time) *BankAccountCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BankAccountCreate) SetNillableUpdatedAt(v *time.Time) *BankAccountCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BankAccountCreate) SetID(v uuid.UUID) *BankAccountCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BankAccountCreate) SetNillableID(v *uuid.UUID) *BankAccountCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the BankAccountMutation object of the builder.
+func (_c *BankAccountCreate) Mutation() *BankAccountMutation {
+	return _c.mutation
+}
+
+// Save creates the BankAccount in the database.
+func (_c *BankAccountCreate) Save(ctx context.Context) (*BankAccount, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BankAccountCreate) SaveX(ctx context.Context) *BankAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BankAccountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BankAccountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BankAccountCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := bankaccount.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := bankaccount.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		v := bankaccount.DefaultMetadata
+		_c.mutation.SetMetadata(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := bankaccount.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := bankaccount.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := bankaccount.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BankAccountCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "BankAccount.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.AccountName(); !ok {
+		return &ValidationError{Name: "account_name", err: errors.New(`ent: missing required field "BankAccount.account_name"`)}
+	}
+	if v, ok := _c.mutation.AccountName(); ok {
+		if err := bankaccount.AccountNameValidator(v); err != nil {
+			return &ValidationError{Name: "account_name", err: fmt.Errorf(`ent: validator failed for field "BankAccount.account_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BankName(); !ok {
+		return &ValidationError{Name: "bank_name", err: errors.New(`ent: missing required field "BankAccount.bank_name"`)}
+	}
+	if v, ok := _c.mutation.BankName(); ok {
+		if err := bankaccount.BankNameValidator(v); err != nil {
+			return &ValidationError{Name: "bank_name", err: fmt.Errorf(`ent: validator failed for field "BankAccount.bank_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountType(); !ok {
+		return &ValidationError{Name: "account_type", err: errors.New(`ent: missing required field "BankAccount.account_type"`)}
+	}
+	if v, ok := _c.mutation.AccountType(); ok {
+		if err := bankaccount.AccountTypeValidator(v); err != nil {
+			return &ValidationError{Name: "account_type", err: fmt.Errorf(`ent: validator failed for field "BankAccount.account_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountNumberMasked(); !ok {
+		return &ValidationError{Name: "account_number_masked", err: errors.New(`ent: missing required field "BankAccount.account_number_masked"`)}
+	}
+	if v, ok := _c.mutation.AccountNumberMasked(); ok {
+		if err := bankaccount.AccountNumberMaskedValidator(v); err != nil {
+			return &ValidationError{Name: "account_number_masked", err: fmt.Errorf(`ent: validator failed for field "BankAccount.account_number_masked": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountNumberHash(); !ok {
+		return &ValidationError{Name: "account_number_hash", err: errors.New(`ent: missing required field "BankAccount.account_number_hash"`)}
+	}
+	if v, ok := _c.mutation.AccountNumberHash(); ok {
+		if err := bankaccount.AccountNumberHashValidator(v); err != nil {
+			return &ValidationError{Name: "account_number_hash", err: fmt.Errorf(`ent: validator failed for field "BankAccount.account_number_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "BankAccount.currency"`)}
+	}
+	if _, ok := _c.mutation.LedgerAccountCode(); !ok {
+		return &ValidationError{Name: "ledger_account_code", err: errors.New(`ent: missing required field "BankAccount.ledger_account_code"`)}
+	}
+	if v, ok := _c.mutation.LedgerAccountCode(); ok {
+		if err := bankaccount.LedgerAccountCodeValidator(v); err != nil {
+			return &ValidationError{Name: "ledger_account_code", err: fmt.Errorf(`ent: validator failed for field "BankAccount.ledger_account_code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "BankAccount.status"`)}
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		return &ValidationError{Name: "metadata", err: errors.New(`ent: missing required field "BankAccount.metadata"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BankAccount.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BankAccount.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *BankAccountCreate) sqlSave(ctx context.Context) (*BankAccount, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BankAccountCreate) createSpec() (*BankAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BankAccount{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bankaccount.Table, sqlgraph.NewFieldSpec(bankaccount.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(bankaccount.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.AccountName(); ok {
+		_spec.SetField(bankaccount.FieldAccountName, field.TypeString, value)
+		_node.AccountName = value
+	}
+	if value, ok := _c.mutation.BankName(); ok {
+		_spec.SetField(bankaccount.FieldBankName, field.TypeString, value)
+		_node.BankName = value
+	}
+	if value, ok := _c.mutation.Branch(); ok {
+		_spec.SetField(bankaccount.FieldBranch, field.TypeString, value)
+		_node.Branch = value
+	}
+	if value, ok := _c.mutation.AccountType(); ok {
+		_spec.SetField(bankaccount.FieldAccountType, field.TypeString, value)
+		_node.AccountType = value
+	}
+	if value, ok := _c.mutation.AccountNumberMasked(); ok {
+		_spec.SetField(bankaccount.FieldAccountNumberMasked, field.TypeString, value)
+		_node.AccountNumberMasked = value
+	}
+	if value, ok := _c.mutation.AccountNumberHash(); ok {
+		_spec.SetField(bankaccount.FieldAccountNumberHash, field.TypeString, value)
+		_node.AccountNumberHash = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(bankaccount.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.LedgerAccountCode(); ok {
+		_spec.SetField(bankaccount.FieldLedgerAccountCode, field.TypeString, value)
+		_node.LedgerAccountCode = value
+	}
+	if value, ok := _c.mutation.StatementBalance(); ok {
+		_spec.SetField(bankaccount.FieldStatementBalance, field.TypeFloat64, value)
+		_node.StatementBalance = &value
+	}
+	if value, ok := _c.mutation.StatementDate(); ok {
+		_spec.SetField(bankaccount.FieldStatementDate, field.TypeTime, value)
+		_node.StatementDate = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(bankaccount.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(bankaccount.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(bankaccount.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(bankaccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(bankaccount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BankAccount.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BankAccountUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BankAccountCreate) OnConflict(opts ...sql.ConflictOption) *BankAccountUpsertOne {
+	_c.conflict = opts
+	return &BankAccountUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BankAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BankAccountCreate) OnConflictColumns(columns ...string) *BankAccountUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BankAccountUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BankAccountUpsertOne is the builder for "upsert"-ing
+	//  one BankAccount node.
+	BankAccountUpsertOne struct {
+		create *BankAccountCreate
+	}
+
+	// BankAccountUpsert is the "OnConflict" setter.
+	BankAccountUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *BankAccountUpsert) SetTenantID(v uuid.UUID) *BankAccountUpsert {
+	u.Set(bankaccount.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *BankAccountUpsert) UpdateTenantID() *BankAccountUpsert {
+	u.SetExcluded(bankaccount.FieldTenantID)
+	return u
+}
+
+// SetAccountName sets the "account_name" field.
+func (u *BankAccountUpsert) SetAccountName(v string) *BankAccountUpsert {
+	u.Set(bankaccount.FieldAccountName, v)
+	return u
+}
+
+// UpdateAccountName sets the "account_name" field to the value that was provided on create.
+func (u *BankAccountUpsert) UpdateAccountName() *BankAccountUpsert {
+	u.SetExcluded(bankaccount.FieldAccountName)
+	return u
+}
+
+// SetBankName sets the "bank_name" field.
+func (u *BankAccountUpsert) SetBankName(v string) *BankAccountUpsert {
+	u.Set(bankaccount.FieldBankName, v)
+	return u
+}
+
+// UpdateBankName sets the "bank_name" field to the value that was provided on create.
+func (u *BankAccountUpsert) UpdateBankName() *BankAccountUpsert {
+	u.SetExcluded(bankaccount.FieldBankName)
+	return u
+}
+
+// SetBranch sets the "branch" field.
+func (u *BankAccountUpsert) SetBranch(v string) *BankAccountUpsert {
+	u.Set(bankaccount.FieldBranch, v)
+	return u
+}
+
+// UpdateBranch sets the "branch" field to the value that was provided on create.
+func (u *BankAccountUpsert) UpdateBranch() *BankAccountUpsert {
+	u.SetExcluded(bankaccount.FieldBranch)
+	return u
+}
+
+// ClearBranch clears the value of the "branch" field.
+func (u *BankAccountUpsert) ClearBranch() *BankAccountUpsert {
+	u.SetNull(bankaccount.FieldBranch)
+	return u
+}
+
+// SetAccountType sets the "account_type" field.
+func (u *BankAccountUpsert) SetAccountType(v string) *BankAccountUpsert {
+	u.Set(bankaccount.FieldAccountType, v)
+	return u
+}
+
+// UpdateAccountType sets the "account_type" field to the value that was provided on create.
+func (u *BankAccountUpsert) UpdateAccountType() *BankAccountUpsert {
+	u.SetExcluded(bankaccount.FieldAccountType)
+	return u
+}
+
+// SetAccountNumberMasked sets the "account_number_masked" field.
+func (u *BankAccountUpsert) SetAccountNumberMasked(v string) *BankAccountUpsert {
+	u.Set(bankaccount.FieldAccountNumberMasked, v)
+	return u
+}
+
+// UpdateAccountNumberMasked sets the "account_number_masked" field to the value that was provided on create.
+func (u *BankAccountUpsert) UpdateAccountNumberMasked() *BankAccountUpsert {
+	u.SetExcluded(bankaccount.FieldAccountNumberMasked)
+	return u
+}
+
+// SetAccountNumberHash sets the "account_number_hash" field.
+func (u *BankAccountUpsert) SetAccountNumberHash(v string) *BankAccountUpsert {
+	u.Set(bankaccount.FieldAccountNumberHash, v)
+	return u
+}
+
+// UpdateAccountNumberHash sets the "account_number_hash" field to the value that was provided on create.
+func (u *BankAccountUpsert) UpdateAccountNumberHash() *BankAccountUpsert {
+	u.SetExcluded(bankaccount.FieldAccountNumberHash)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *BankAccountUpsert) SetCurrency(v string) *BankAccountUpsert {
+	u.Set(bankaccount.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *BankAccountUpsert) UpdateCurrency() *BankAccountUpsert {
+	u.SetExcluded(bankaccount.FieldCurrency)
+	return u
+}
+
+// SetLedgerAccountCode sets the "ledger_account_code" field.
+func (u *BankAccountUpsert) SetLedgerAccountCode(v string) *BankAccountUpsert {
+	u.Set(bankaccount.FieldLedgerAccountCode, v)
+	return u
+}
+
+// UpdateLedgerAccountCode sets the "ledger_account_code" field to the value that was provided on create.
+func (u *BankAccountUpsert) UpdateLedgerAccountCode() *BankAccountUpsert {
+	u.SetExcluded(bankaccount.FieldLedgerAccountCode)
+	return u
+}
+
+// SetStatementBalance sets the "statement_balance" field.
+func (u *BankAccountUpsert) SetStatementBalance(v decimal.Decimal) *BankAccountUpsert {
+	u.Set(bankaccount.FieldStatementBalance, v)
+	return u
+}
+
+// UpdateStatementBalance sets the "statement_balance" field to the value that was provided on create.
+func (u *BankAccountUpsert) UpdateStatementBalance() *BankAccountUpsert {
+	u.SetExcluded(bankaccount.FieldStatementBalance)
+	return u
+}
+
+// AddStatementBalance adds v to the "statement_balance" field.
+func (u *BankAccountUpsert) AddStatementBalance(v decimal.Decimal) *BankAccountUpsert {
+	u.Add(bankaccount.FieldStatementBalance, v)
+	return u
+}
+
+// ClearStatementBalance clears the value of the "statement_balance" field.
+func (u *BankAccountUpsert) ClearStatementBalance() *BankAccountUpsert {
+	u.SetNull(bankaccount.FieldStatementBalance)
+	return u
+}
+
+// SetStatementDate sets the "statement_date" field.
+func (u *BankAccountUpsert) SetStatementDate(v time.Time) *BankAccountUpsert {
+	u.Set(bankaccount.FieldStatementDate, v)
+	return u
+}
+
+// UpdateStatementDate sets the "statement_date" field to the value that was provided on create.
+func (u *BankAccountUpsert) UpdateStatementDate() *BankAccountUpsert {
+	u.SetExcluded(bankaccount.FieldStatementDate)
+	return u
+}
+
+// ClearStatementDate clears the value of the "statement_date" field.
+func (u *BankAccountUpsert) ClearStatementDate() *BankAccountUpsert {
+	u.SetNull(bankaccount.FieldStatementDate)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *BankAccountUpsert) SetStatus(v string) *BankAccountUpsert {
+	u.Set(bankaccount.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BankAccountUpsert) UpdateStatus() *BankAccountUpsert {
+	u.SetExcluded(bankaccount.FieldStatus)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *BankAccountUpsert) SetMetadata(v map[string]interface{}) *BankAccountUpsert {
+	u.Set(bankaccount.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *BankAccountUpsert) UpdateMetadata() *BankAccountUpsert {
+	u.SetExcluded(bankaccount.FieldMetadata)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *BankAccountUpsert) SetCreatedBy(v uuid.UUID) *BankAccountUpsert {
+	u.Set(bankaccount.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *BankAccountUpsert) UpdateCreatedBy() *BankAccountUpsert {
+	u.SetExcluded(bankaccount.FieldCreatedBy)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *BankAccountUpsert) ClearCreatedBy() *BankAccountUpsert {
+	u.SetNull(bankaccount.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BankAccountUpsert) SetUpdatedAt(v time.Time) *BankAccountUpsert {
+	u.Set(bankaccount.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BankAccountUpsert) UpdateUpdatedAt() *BankAccountUpsert {
+	u.SetExcluded(bankaccount.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.BankAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(bankaccount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BankAccountUpsertOne) UpdateNewValues() *BankAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(bankaccount.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(bankaccount.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BankAccount.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BankAccountUpsertOne) Ignore() *BankAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BankAccountUpsertOne) DoNothing() *BankAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BankAccountCreate.OnConflict
+// documentation for more info.
+func (u *BankAccountUpsertOne) Update(set func(*BankAccountUpsert)) *BankAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BankAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *BankAccountUpsertOne) SetTenantID(v uuid.UUID) *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *BankAccountUpsertOne) UpdateTenantID() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetAccountName sets the "account_name" field.
+func (u *BankAccountUpsertOne) SetAccountName(v string) *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetAccountName(v)
+	})
+}
+
+// UpdateAccountName sets the "account_name" field to the value that was provided on create.
+func (u *BankAccountUpsertOne) UpdateAccountName() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateAccountName()
+	})
+}
+
+// SetBankName sets the "bank_name" field.
+func (u *BankAccountUpsertOne) SetBankName(v string) *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetBankName(v)
+	})
+}
+
+// UpdateBankName sets the "bank_name" field to the value that was provided on create.
+func (u *BankAccountUpsertOne) UpdateBankName() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateBankName()
+	})
+}
+
+// SetBranch sets the "branch" field.
+func (u *BankAccountUpsertOne) SetBranch(v string) *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetBranch(v)
+	})
+}
+
+// UpdateBranch sets the "branch" field to the value that was provided on create.
+func (u *BankAccountUpsertOne) UpdateBranch() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateBranch()
+	})
+}
+
+// ClearBranch clears the value of the "branch" field.
+func (u *BankAccountUpsertOne) ClearBranch() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.ClearBranch()
+	})
+}
+
+// SetAccountType sets the "account_type" field.
+func (u *BankAccountUpsertOne) SetAccountType(v string) *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetAccountType(v)
+	})
+}
+
+// UpdateAccountType sets the "account_type" field to the value that was provided on create.
+func (u *BankAccountUpsertOne) UpdateAccountType() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateAccountType()
+	})
+}
+
+// SetAccountNumberMasked sets the "account_number_masked" field.
+func (u *BankAccountUpsertOne) SetAccountNumberMasked(v string) *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetAccountNumberMasked(v)
+	})
+}
+
+// UpdateAccountNumberMasked sets the "account_number_masked" field to the value that was provided on create.
+func (u *BankAccountUpsertOne) UpdateAccountNumberMasked() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateAccountNumberMasked()
+	})
+}
+
+// SetAccountNumberHash sets the "account_number_hash" field.
+func (u *BankAccountUpsertOne) SetAccountNumberHash(v string) *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetAccountNumberHash(v)
+	})
+}
+
+// UpdateAccountNumberHash sets the "account_number_hash" field to the value that was provided on create.
+func (u *BankAccountUpsertOne) UpdateAccountNumberHash() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateAccountNumberHash()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *BankAccountUpsertOne) SetCurrency(v string) *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *BankAccountUpsertOne) UpdateCurrency() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetLedgerAccountCode sets the "ledger_account_code" field.
+func (u *BankAccountUpsertOne) SetLedgerAccountCode(v string) *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetLedgerAccountCode(v)
+	})
+}
+
+// UpdateLedgerAccountCode sets the "ledger_account_code" field to the value that was provided on create.
+func (u *BankAccountUpsertOne) UpdateLedgerAccountCode() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateLedgerAccountCode()
+	})
+}
+
+// SetStatementBalance sets the "statement_balance" field.
+func (u *BankAccountUpsertOne) SetStatementBalance(v decimal.Decimal) *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetStatementBalance(v)
+	})
+}
+
+// AddStatementBalance adds v to the "statement_balance" field.
+func (u *BankAccountUpsertOne) AddStatementBalance(v decimal.Decimal) *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.AddStatementBalance(v)
+	})
+}
+
+// UpdateStatementBalance sets the "statement_balance" field to the value that was provided on create.
+func (u *BankAccountUpsertOne) UpdateStatementBalance() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateStatementBalance()
+	})
+}
+
+// ClearStatementBalance clears the value of the "statement_balance" field.
+func (u *BankAccountUpsertOne) ClearStatementBalance() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.ClearStatementBalance()
+	})
+}
+
+// SetStatementDate sets the "statement_date" field.
+func (u *BankAccountUpsertOne) SetStatementDate(v time.Time) *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetStatementDate(v)
+	})
+}
+
+// UpdateStatementDate sets the "statement_date" field to the value that was provided on create.
+func (u *BankAccountUpsertOne) UpdateStatementDate() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateStatementDate()
+	})
+}
+
+// ClearStatementDate clears the value of the "statement_date" field.
+func (u *BankAccountUpsertOne) ClearStatementDate() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.ClearStatementDate()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *BankAccountUpsertOne) SetStatus(v string) *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BankAccountUpsertOne) UpdateStatus() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *BankAccountUpsertOne) SetMetadata(v map[string]interface{}) *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *BankAccountUpsertOne) UpdateMetadata() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *BankAccountUpsertOne) SetCreatedBy(v uuid.UUID) *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *BankAccountUpsertOne) UpdateCreatedBy() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *BankAccountUpsertOne) ClearCreatedBy() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BankAccountUpsertOne) SetUpdatedAt(v time.Time) *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BankAccountUpsertOne) UpdateUpdatedAt() *BankAccountUpsertOne {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BankAccountUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BankAccountCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BankAccountUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BankAccountUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BankAccountUpsertOne.ID is not supported by MySQL driver. Use BankAccountUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BankAccountUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BankAccountCreateBulk is the builder for creating many BankAccount entities in bulk.
+type BankAccountCreateBulk struct {
+	config
+	err      error
+	builders []*BankAccountCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BankAccount entities in the database.
+func (_c *BankAccountCreateBulk) Save(ctx context.Context) ([]*BankAccount, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BankAccount, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BankAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BankAccountCreateBulk) SaveX(ctx context.Context) []*BankAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BankAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BankAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BankAccount.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BankAccountUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BankAccountCreateBulk) OnConflict(opts ...sql.ConflictOption) *BankAccountUpsertBulk {
+	_c.conflict = opts
+	return &BankAccountUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BankAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BankAccountCreateBulk) OnConflictColumns(columns ...string) *BankAccountUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BankAccountUpsertBulk{
+		create: _c,
+	}
+}
+
+// BankAccountUpsertBulk is the builder for "upsert"-ing
+// a bulk of BankAccount nodes.
+type BankAccountUpsertBulk struct {
+	create *BankAccountCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BankAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(bankaccount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BankAccountUpsertBulk) UpdateNewValues() *BankAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(bankaccount.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(bankaccount.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BankAccount.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BankAccountUpsertBulk) Ignore() *BankAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BankAccountUpsertBulk) DoNothing() *BankAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BankAccountCreateBulk.OnConflict
+// documentation for more info.
+func (u *BankAccountUpsertBulk) Update(set func(*BankAccountUpsert)) *BankAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BankAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *BankAccountUpsertBulk) SetTenantID(v uuid.UUID) *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *BankAccountUpsertBulk) UpdateTenantID() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetAccountName sets the "account_name" field.
+func (u *BankAccountUpsertBulk) SetAccountName(v string) *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetAccountName(v)
+	})
+}
+
+// UpdateAccountName sets the "account_name" field to the value that was provided on create.
+func (u *BankAccountUpsertBulk) UpdateAccountName() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateAccountName()
+	})
+}
+
+// SetBankName sets the "bank_name" field.
+func (u *BankAccountUpsertBulk) SetBankName(v string) *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetBankName(v)
+	})
+}
+
+// UpdateBankName sets the "bank_name" field to the value that was provided on create.
+func (u *BankAccountUpsertBulk) UpdateBankName() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateBankName()
+	})
+}
+
+// SetBranch sets the "branch" field.
+func (u *BankAccountUpsertBulk) SetBranch(v string) *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetBranch(v)
+	})
+}
+
+// UpdateBranch sets the "branch" field to the value that was provided on create.
+func (u *BankAccountUpsertBulk) UpdateBranch() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateBranch()
+	})
+}
+
+// ClearBranch clears the value of the "branch" field.
+func (u *BankAccountUpsertBulk) ClearBranch() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.ClearBranch()
+	})
+}
+
+// SetAccountType sets the "account_type" field.
+func (u *BankAccountUpsertBulk) SetAccountType(v string) *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetAccountType(v)
+	})
+}
+
+// UpdateAccountType sets the "account_type" field to the value that was provided on create.
+func (u *BankAccountUpsertBulk) UpdateAccountType() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateAccountType()
+	})
+}
+
+// SetAccountNumberMasked sets the "account_number_masked" field.
+func (u *BankAccountUpsertBulk) SetAccountNumberMasked(v string) *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetAccountNumberMasked(v)
+	})
+}
+
+// UpdateAccountNumberMasked sets the "account_number_masked" field to the value that was provided on create.
+func (u *BankAccountUpsertBulk) UpdateAccountNumberMasked() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateAccountNumberMasked()
+	})
+}
+
+// SetAccountNumberHash sets the "account_number_hash" field.
+func (u *BankAccountUpsertBulk) SetAccountNumberHash(v string) *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetAccountNumberHash(v)
+	})
+}
+
+// UpdateAccountNumberHash sets the "account_number_hash" field to the value that was provided on create.
+func (u *BankAccountUpsertBulk) UpdateAccountNumberHash() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateAccountNumberHash()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *BankAccountUpsertBulk) SetCurrency(v string) *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *BankAccountUpsertBulk) UpdateCurrency() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetLedgerAccountCode sets the "ledger_account_code" field.
+func (u *BankAccountUpsertBulk) SetLedgerAccountCode(v string) *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetLedgerAccountCode(v)
+	})
+}
+
+// UpdateLedgerAccountCode sets the "ledger_account_code" field to the value that was provided on create.
+func (u *BankAccountUpsertBulk) UpdateLedgerAccountCode() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateLedgerAccountCode()
+	})
+}
+
+// SetStatementBalance sets the "statement_balance" field.
+func (u *BankAccountUpsertBulk) SetStatementBalance(v decimal.Decimal) *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetStatementBalance(v)
+	})
+}
+
+// AddStatementBalance adds v to the "statement_balance" field.
+func (u *BankAccountUpsertBulk) AddStatementBalance(v decimal.Decimal) *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.AddStatementBalance(v)
+	})
+}
+
+// UpdateStatementBalance sets the "statement_balance" field to the value that was provided on create.
+func (u *BankAccountUpsertBulk) UpdateStatementBalance() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateStatementBalance()
+	})
+}
+
+// ClearStatementBalance clears the value of the "statement_balance" field.
+func (u *BankAccountUpsertBulk) ClearStatementBalance() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.ClearStatementBalance()
+	})
+}
+
+// SetStatementDate sets the "statement_date" field.
+func (u *BankAccountUpsertBulk) SetStatementDate(v time.Time) *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetStatementDate(v)
+	})
+}
+
+// UpdateStatementDate sets the "statement_date" field to the value that was provided on create.
+func (u *BankAccountUpsertBulk) UpdateStatementDate() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateStatementDate()
+	})
+}
+
+// ClearStatementDate clears the value of the "statement_date" field.
+func (u *BankAccountUpsertBulk) ClearStatementDate() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.ClearStatementDate()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *BankAccountUpsertBulk) SetStatus(v string) *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BankAccountUpsertBulk) UpdateStatus() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *BankAccountUpsertBulk) SetMetadata(v map[string]interface{}) *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *BankAccountUpsertBulk) UpdateMetadata() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *BankAccountUpsertBulk) SetCreatedBy(v uuid.UUID) *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *BankAccountUpsertBulk) UpdateCreatedBy() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *BankAccountUpsertBulk) ClearCreatedBy() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BankAccountUpsertBulk) SetUpdatedAt(v time.Time) *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BankAccountUpsertBulk) UpdateUpdatedAt() *BankAccountUpsertBulk {
+	return u.Update(func(s *BankAccountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BankAccountUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BankAccountCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BankAccountCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BankAccountUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,842 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/bankaccount"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BankAccountUpdate is the builder for updating BankAccount entities.
+type BankAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BankAccountMutation
+}
+
+// Where appends a list predicates to the BankAccountUpdate builder.
+func (_u *BankAccountUpdate) Where(ps ...predicate.BankAccount) *BankAccountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *BankAccountUpdate) SetTenantID(v uuid.UUID) *BankAccountUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *BankAccountUpdate) SetNillableTenantID(v *uuid.UUID) *BankAccountUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetAccountName sets the "account_name" field.
+func (_u *BankAccountUpdate) SetAccountName(v string) *BankAccountUpdate {
+	_u.mutation.SetAccountName(v)
+	return _u
+}
+
+// SetNillableAccountName sets the "account_name" field if the given value is not nil.
+func (_u *BankAccountUpdate) SetNillableAccountName(v *string) *BankAccountUpdate {
+	if v != nil {
+		_u.SetAccountName(*v)
+	}
+	return _u
+}
+
+// SetBankName sets the "bank_name" field.
+func (_u *BankAccountUpdate) SetBankName(v string) *BankAccountUpdate {
+	_u.mutation.SetBankName(v)
+	return _u
+}
+
+// SetNillableBankName sets the "bank_name" field if the given value is not nil.
+func (_u *BankAccountUpdate) SetNillableBankName(v *string) *BankAccountUpdate {
+	if v != nil {
+		_u.SetBankName(*v)
+	}
+	return _u
+}
+
+// SetBranch sets the "branch" field.
+func (_u *BankAccountUpdate) SetBranch(v string) *BankAccountUpdate {
+	_u.mutation.SetBranch(v)
+	return _u
+}
+
+// SetNillableBranch sets the "branch" field if the given value is not nil.
+func (_u *BankAccountUpdate) SetNillableBranch(v *string) *BankAccountUpdate {
+	if v != nil {
+		_u.SetBranch(*v)
+	}
+	return _u
+}
+
+// ClearBranch clears the value of the "branch" field.
+func (_u *BankAccountUpdate) ClearBranch() *BankAccountUpdate {
+	_u.mutation.ClearBranch()
+	return _u
+}
+
+// SetAccountType sets the "account_type" field.
+func (_u *BankAccountUpdate) SetAccountType(v string) *BankAccountUpdate {
+	_u.mutation.SetAccountType(v)
+	return _u
+}
+
+// SetNillableAccountType sets the "account_type" field if the given value is not nil.
+func (_u *BankAccountUpdate) SetNillableAccountType(v *string) *BankAccountUpdate {
+	if v != nil {
+		_u.SetAccountType(*v)
+	}
+	return _u
+}
+
+// SetAccountNumberMasked sets the "account_number_masked" field.
+func (_u *BankAccountUpdate) SetAccountNumberMasked(v string) *BankAccountUpdate {
+	_u.mutation.SetAccountNumberMasked(v)
+	return _u
+}
+
+// SetNillableAccountNumberMasked sets the "account_number_masked" field if the given value is not nil.
+func (_u *BankAccountUpdate) SetNillableAccountNumberMasked(v *string) *BankAccountUpdate {
+	if v != nil {
+		_u.SetAccountNumberMasked(*v)
+	}
+	return _u
+}
+
+// SetAccountNumberHash sets the "account_number_hash" field.
+func (_u *BankAccountUpdate) SetAccountNumberHash(v string) *BankAccountUpdate {
+	_u.mutation.SetAccountNumberHash(v)
+	return _u
+}
+
+// SetNillableAccountNumberHash sets the "account_number_hash" field if the given value is not nil.
+func (_u *BankAccountUpdate) SetNillableAccountNumberHash(v *string) *BankAccountUpdate {
+	if v != nil {
+		_u.SetAccountNumberHash(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *BankAccountUpdate) SetCurrency(v string) *BankAccountUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *BankAccountUpdate) SetNillableCurrency(v *string) *BankAccountUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetLedgerAccountCode sets the "ledger_account_code" field.
+func (_u *BankAccountUpdate) SetLedgerAccountCode(v string) *BankAccountUpdate {
+	_u.mutation.SetLedgerAccountCode(v)
+	return _u
+}
+
+// SetNillableLedgerAccountCode sets the "ledger_account_code" field if the given value is not nil.
+func (_u *BankAccountUpdate) SetNillableLedgerAccountCode(v *string) *BankAccountUpdate {
+	if v != nil {
+		_u.SetLedgerAccountCode(*v)
+	}
+	return _u
+}
+
+// SetStatementBalance sets the "statement_balance" field.
+func (_u *BankAccountUpdate) SetStatementBalance(v decimal.Decimal) *BankAccountUpdate {
+	_u.mutation.ResetStatementBalance()
+	_u.mutation.SetStatementBalance(v)
+	return _u
+}
+
+// SetNillableStatementBalance sets the "statement_balance" field if the given value is not nil.
+func (_u *BankAccountUpdate) SetNillableStatementBalance(v *decimal.Decimal) *BankAccountUpdate {
+	if v != nil {
+		_u.SetStatementBalance(*v)
+	}
+	return _u
+}
+
+// AddStatementBalance adds value to the "statement_balance" field.
+func (_u *BankAccountUpdate) AddStatementBalance(v decimal.Decimal) *BankAccountUpdate {
+	_u.mutation.AddStatementBalance(v)
+	return _u
+}
+
+// ClearStatementBalance clears the value of the "statement_balance" field.
+func (_u *BankAccountUpdate) ClearStatementBalance() *BankAccountUpdate {
+	_u.mutation.ClearStatementBalance()
+	return _u
+}
+
+// SetStatementDate sets the "statement_date" field.
+func (_u *BankAccountUpdate) SetStatementDate(v time.Time) *BankAccountUpdate {
+	_u.mutation.SetStatementDate(v)
+	return _u
+}
+
+// SetNillableStatementDate sets the "statement_date" field if the given value is not nil.
+func (_u *BankAccountUpdate) SetNillableStatementDate(v *time.Time) *BankAccountUpdate {
+	if v != nil {
+		_u.SetStatementDate(*v)
+	}
+	return _u
+}
+
+// ClearStatementDate clears the value of the "statement_date" field.
+func (_u *BankAccountUpdate) ClearStatementDate() *BankAccountUpdate {
+	_u.mutation.ClearStatementDate()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BankAccountUpdate) SetStatus(v string) *BankAccountUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BankAccountUpdate) SetNillableStatus(v *string) *BankAccountUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *BankAccountUpdate) SetMetadata(v map[string]interface{}) *BankAccountUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *BankAccountUpdate) SetCreatedBy(v uuid.UUID) *BankAccountUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *BankAccountUpdate) SetNillableCreatedBy(v *uuid.UUID) *BankAccountUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *BankAccountUpdate) ClearCreatedBy() *BankAccountUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BankAccountUpdate) SetUpdatedAt(v time.Time) *BankAccountUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the BankAccountMutation object of the builder.
+func (_u *BankAccountUpdate) Mutation() *BankAccountMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BankAccountUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BankAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BankAccountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BankAccountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BankAccountUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := bankaccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BankAccountUpdate) check() error {
+	if v, ok := _u.mutation.AccountName(); ok {
+		if err := bankaccount.AccountNameValidator(v); err != nil {
+			return &ValidationError{Name: "account_name", err: fmt.Errorf(`ent: validator failed for field "BankAccount.account_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BankName(); ok {
+		if err := bankaccount.BankNameValidator(v); err != nil {
+			return &ValidationError{Name: "bank_name", err: fmt.Errorf(`ent: validator failed for field "BankAccount.bank_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountType(); ok {
+		if err := bankaccount.AccountTypeValidator(v); err != nil {
+			return &ValidationError{Name: "account_type", err: fmt.Errorf(`ent: validator failed for field "BankAccount.account_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountNumberMasked(); ok {
+		if err := bankaccount.AccountNumberMaskedValidator(v); err != nil {
+			return &ValidationError{Name: "account_number_masked", err: fmt.Errorf(`ent: validator failed for field "BankAccount.account_number_masked": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountNumberHash(); ok {
+		if err := bankaccount.AccountNumberHashValidator(v); err != nil {
+			return &ValidationError{Name: "account_number_hash", err: fmt.Errorf(`ent: validator failed for field "BankAccount.account_number_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LedgerAccountCode(); ok {
+		if err := bankaccount.LedgerAccountCodeValidator(v); err != nil {
+			return &ValidationError{Name: "ledger_account_code", err: fmt.Errorf(`ent: validator failed for field "BankAccount.ledger_account_code": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BankAccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bankaccount.Table, bankaccount.Columns, sqlgraph.NewFieldSpec(bankaccount.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(bankaccount.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.AccountName(); ok {
+		_spec.SetField(bankaccount.FieldAccountName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BankName(); ok {
+		_spec.SetField(bankaccount.FieldBankName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Branch(); ok {
+		_spec.SetField(bankaccount.FieldBranch, field.TypeString, value)
+	}
+	if _u.mutation.BranchCleared() {
+		_spec.ClearField(bankaccount.FieldBranch, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccountType(); ok {
+		_spec.SetField(bankaccount.FieldAccountType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountNumberMasked(); ok {
+		_spec.SetField(bankaccount.FieldAccountNumberMasked, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountNumberHash(); ok {
+		_spec.SetField(bankaccount.FieldAccountNumberHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(bankaccount.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LedgerAccountCode(); ok {
+		_spec.SetField(bankaccount.FieldLedgerAccountCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StatementBalance(); ok {
+		_spec.SetField(bankaccount.FieldStatementBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedStatementBalance(); ok {
+		_spec.AddField(bankaccount.FieldStatementBalance, field.TypeFloat64, value)
+	}
+	if _u.mutation.StatementBalanceCleared() {
+		_spec.ClearField(bankaccount.FieldStatementBalance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.StatementDate(); ok {
+		_spec.SetField(bankaccount.FieldStatementDate, field.TypeTime, value)
+	}
+	if _u.mutation.StatementDateCleared() {
+		_spec.ClearField(bankaccount.FieldStatementDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bankaccount.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(bankaccount.FieldMetadata, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(bankaccount.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(bankaccount.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(bankaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bankaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BankAccountUpdateOne is the builder for updating a single BankAccount entity.
+type BankAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BankAccountMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *BankAccountUpdateOne) SetTenantID(v uuid.UUID) *BankAccountUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *BankAccountUpdateOne) SetNillableTenantID(v *uuid.UUID) *BankAccountUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetAccountName sets the "account_name" field.
+func (_u *BankAccountUpdateOne) SetAccountName(v string) *BankAccountUpdateOne {
+	_u.mutation.SetAccountName(v)
+	return _u
+}
+
+// SetNillableAccountName sets the "account_name" field if the given value is not nil.
+func (_u *BankAccountUpdateOne) SetNillableAccountName(v *string) *BankAccountUpdateOne {
+	if v != nil {
+		_u.SetAccountName(*v)
+	}
+	return _u
+}
+
+// SetBankName sets the "bank_name" field.
+func (_u *BankAccountUpdateOne) SetBankName(v string) *BankAccountUpdateOne {
+	_u.mutation.SetBankName(v)
+	return _u
+}
+
+// SetNillableBankName sets the "bank_name" field if the given value is not nil.
+func (_u *BankAccountUpdateOne) SetNillableBankName(v *string) *BankAccountUpdateOne {
+	if v != nil {
+		_u.SetBankName(*v)
+	}
+	return _u
+}
+
+// SetBranch sets the "branch" field.
+func (_u *BankAccountUpdateOne) SetBranch(v string) *BankAccountUpdateOne {
+	_u.mutation.SetBranch(v)
+	return _u
+}
+
+// SetNillableBranch sets the "branch" field if the given value is not nil.
+func (_u *BankAccountUpdateOne) SetNillableBranch(v *string) *BankAccountUpdateOne {
+	if v != nil {
+		_u.SetBranch(*v)
+	}
+	return _u
+}
+
+// ClearBranch clears the value of the "branch" field.
+func (_u *BankAccountUpdateOne) ClearBranch() *BankAccountUpdateOne {
+	_u.mutation.ClearBranch()
+	return _u
+}
+
+// SetAccountType sets the "account_type" field.
+func (_u *BankAccountUpdateOne) SetAccountType(v string) *BankAccountUpdateOne {
+	_u.mutation.SetAccountType(v)
+	return _u
+}
+
+// SetNillableAccountType sets the "account_type" field if the given value is not nil.
+func (_u *BankAccountUpdateOne) SetNillableAccountType(v *string) *BankAccountUpdateOne {
+	if v != nil {
+		_u.SetAccountType(*v)
+	}
+	return _u
+}
+
+// SetAccountNumberMasked sets the "account_number_masked" field.
+func (_u *BankAccountUpdateOne) SetAccountNumberMasked(v string) *BankAccountUpdateOne {
+	_u.mutation.SetAccountNumberMasked(v)
+	return _u
+}
+
+// SetNillableAccountNumberMasked sets the "account_number_masked" field if the given value is not nil.
+func (_u *BankAccountUpdateOne) SetNillableAccountNumberMasked(v *string) *BankAccountUpdateOne {
+	if v != nil {
+		_u.SetAccountNumberMasked(*v)
+	}
+	return _u
+}
+
+// SetAccountNumberHash sets the "account_number_hash" field.
+func (_u *BankAccountUpdateOne) SetAccountNumberHash(v string) *BankAccountUpdateOne {
+	_u.mutation.SetAccountNumberHash(v)
+	return _u
+}
+
+// SetNillableAccountNumberHash sets the "account_number_hash" field if the given value is not nil.
+func (_u *BankAccountUpdateOne) SetNillableAccountNumberHash(v *string) *BankAccountUpdateOne {
+	if v != nil {
+		_u.SetAccountNumberHash(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *BankAccountUpdateOne) SetCurrency(v string) *BankAccountUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *BankAccountUpdateOne) SetNillableCurrency(v *string) *BankAccountUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetLedgerAccountCode sets the "ledger_account_code" field.
+func (_u *BankAccountUpdateOne) SetLedgerAccountCode(v string) *BankAccountUpdateOne {
+	_u.mutation.SetLedgerAccountCode(v)
+	return _u
+}
+
+// SetNillableLedgerAccountCode sets the "ledger_account_code" field if the given value is not nil.
+func (_u *BankAccountUpdateOne) SetNillableLedgerAccountCode(v *string) *BankAccountUpdateOne {
+	if v != nil {
+		_u.SetLedgerAccountCode(*v)
+	}
+	return _u
+}
+
+// SetStatementBalance sets the "statement_balance" field.
+func (_u *BankAccountUpdateOne) SetStatementBalance(v decimal.Decimal) *BankAccountUpdateOne {
+	_u.mutation.ResetStatementBalance()
+	_u.mutation.SetStatementBalance(v)
+	return _u
+}
+
+// SetNillableStatementBalance sets the "statement_balance" field if the given value is not nil.
+func (_u *BankAccountUpdateOne) SetNillableStatementBalance(v *decimal.Decimal) *BankAccountUpdateOne {
+	if v != nil {
+		_u.SetStatementBalance(*v)
+	}
+	return _u
+}
+
+// AddStatementBalance adds value to the "statement_balance" field.
+func (_u *BankAccountUpdateOne) AddStatementBalance(v decimal.Decimal) *BankAccountUpdateOne {
+	_u.mutation.AddStatementBalance(v)
+	return _u
+}
+
+// ClearStatementBalance clears the value of the "statement_balance" field.
+func (_u *BankAccountUpdateOne) ClearStatementBalance() *BankAccountUpdateOne {
+	_u.mutation.ClearStatementBalance()
+	return _u
+}
+
+// SetStatementDate sets the "statement_date" field.
+func (_u *BankAccountUpdateOne) SetStatementDate(v time.Time) *BankAccountUpdateOne {
+	_u.mutation.SetStatementDate(v)
+	return _u
+}
+
+// SetNillableStatementDate sets the "statement_date" field if the given value is not nil.
+func (_u *BankAccountUpdateOne) SetNillableStatementDate(v *time.Time) *BankAccountUpdateOne {
+	if v != nil {
+		_u.SetStatementDate(*v)
+	}
+	return _u
+}
+
+// ClearStatementDate clears the value of the "statement_date" field.
+func (_u *BankAccountUpdateOne) ClearStatementDate() *BankAccountUpdateOne {
+	_u.mutation.ClearStatementDate()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BankAccountUpdateOne) SetStatus(v string) *BankAccountUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BankAccountUpdateOne) SetNillableStatus(v *string) *BankAccountUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *BankAccountUpdateOne) SetMetadata(v map[string]interface{}) *BankAccountUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *BankAccountUpdateOne) SetCreatedBy(v uuid.UUID) *BankAccountUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *BankAccountUpdateOne) SetNillableCreatedBy(v *uuid.UUID) *BankAccountUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *BankAccountUpdateOne) ClearCreatedBy() *BankAccountUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BankAccountUpdateOne) SetUpdatedAt(v time.Time) *BankAccountUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the BankAccountMutation object of the builder.
+func (_u *BankAccountUpdateOne) Mutation() *BankAccountMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BankAccountUpdate builder.
+func (_u *BankAccountUpdateOne) Where(ps ...predicate.BankAccount) *BankAccountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BankAccountUpdateOne) Select(field string, fields ...string) *BankAccountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BankAccount entity.
+func (_u *BankAccountUpdateOne) Save(ctx context.Context) (*BankAccount, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BankAccountUpdateOne) SaveX(ctx context.Context) *BankAccount {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BankAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BankAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BankAccountUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := bankaccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BankAccountUpdateOne) check() error {
+	if v, ok := _u.mutation.AccountName(); ok {
+		if err := bankaccount.AccountNameValidator(v); err != nil {
+			return &ValidationError{Name: "account_name", err: fmt.Errorf(`ent: validator failed for field "BankAccount.account_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BankName(); ok {
+		if err := bankaccount.BankNameValidator(v); err != nil {
+			return &ValidationError{Name: "bank_name", err: fmt.Errorf(`ent: validator failed for field "BankAccount.bank_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountType(); ok {
+		if err := bankaccount.AccountTypeValidator(v); err != nil {
+			return &ValidationError{Name: "account_type", err: fmt.Errorf(`ent: validator failed for field "BankAccount.account_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountNumberMasked(); ok {
+		if err := bankaccount.AccountNumberMaskedValidator(v); err != nil {
+			return &ValidationError{Name: "account_number_masked", err: fmt.Errorf(`ent: validator failed for field "BankAccount.account_number_masked": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountNumberHash(); ok {
+		if err := bankaccount.AccountNumberHashValidator(v); err != nil {
+			return &ValidationError{Name: "account_number_hash", err: fmt.Errorf(`ent: validator failed for field "BankAccount.account_number_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LedgerAccountCode(); ok {
+		if err := bankaccount.LedgerAccountCodeValidator(v); err != nil {
+			return &ValidationError{Name: "ledger_account_code", err: fmt.Errorf(`ent: validator failed for field "BankAccount.ledger_account_code": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BankAccountUpdateOne) sqlSave(ctx context.Context) (_node *BankAccount, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bankaccount.Table, bankaccount.Columns, sqlgraph.NewFieldSpec(bankaccount.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BankAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bankaccount.FieldID)
+		for _, f := range fields {
+			if !bankaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bankaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(bankaccount.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.AccountName(); ok {
+		_spec.SetField(bankaccount.FieldAccountName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BankName(); ok {
+		_spec.SetField(bankaccount.FieldBankName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Branch(); ok {
+		_spec.SetField(bankaccount.FieldBranch, field.TypeString, value)
+	}
+	if _u.mutation.BranchCleared() {
+		_spec.ClearField(bankaccount.FieldBranch, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccountType(); ok {
+		_spec.SetField(bankaccount.FieldAccountType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountNumberMasked(); ok {
+		_spec.SetField(bankaccount.FieldAccountNumberMasked, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountNumberHash(); ok {
+		_spec.SetField(bankaccount.FieldAccountNumberHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(bankaccount.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LedgerAccountCode(); ok {
+		_spec.SetField(bankaccount.FieldLedgerAccountCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StatementBalance(); ok {
+		_spec.SetField(bankaccount.FieldStatementBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedStatementBalance(); ok {
+		_spec.AddField(bankaccount.FieldStatementBalance, field.TypeFloat64, value)
+	}
+	if _u.mutation.StatementBalanceCleared() {
+		_spec.ClearField(bankaccount.FieldStatementBalance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.StatementDate(); ok {
+		_spec.SetField(bankaccount.FieldStatementDate, field.TypeTime, value)
+	}
+	if _u.mutation.StatementDateCleared() {
+		_spec.ClearField(bankaccount.FieldStatementDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bankaccount.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(bankaccount.FieldMetadata, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(bankaccount.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(bankaccount.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(bankaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &BankAccount{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bankaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatement"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BankStatement is the model entity for the BankStatement schema.
+type BankStatement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Bank account identifier
+	BankAccountID uuid.UUID `json:"bank_account_id,omitempty"`
+	// File format: csv, mt940, camt053, mpesa
+	Format string `json:"format,omitempty"`
+	// CSV column mapping profile used
+	Profile string `json:"profile,omitempty"`
+	// FileName holds the value of the "file_name" field.
+	FileName string `json:"file_name,omitempty"`
+	// SHA-256 of the imported file
+	FileHash string `json:"file_hash,omitempty"`
+	// Statement identifier assigned by the bank
+	StatementReference string `json:"statement_reference,omitempty"`
+	// ISO currency code
+	Currency string `json:"currency,omitempty"`
+	// Date of the first day covered
+	PeriodStart time.Time `json:"period_start,omitempty"`
+	// Date of the last day covered
+	PeriodEnd time.Time `json:"period_end,omitempty"`
+	// Balance at the start of the period, when the file states it
+	OpeningBalance *decimal.Decimal `json:"opening_balance,omitempty"`
+	// Balance at the end of the period, when the file states it
+	ClosingBalance *decimal.Decimal `json:"closing_balance,omitempty"`
+	// Opening balance check against the previous statement: continuous, first, gap, unverified
+	Continuity string `json:"continuity,omitempty"`
+	// Opening balance implied by the previous statement
+	ExpectedOpeningBalance *decimal.Decimal `json:"expected_opening_balance,omitempty"`
+	// Transactions imported
+	TransactionCount int `json:"transaction_count,omitempty"`
+	// Transactions skipped as already imported
+	DuplicateCount int `json:"duplicate_count,omitempty"`
+	// Transactions linked to payment transactions by provider reference
+	LinkedCount int `json:"linked_count,omitempty"`
+	// ImportedBy holds the value of the "imported_by" field.
+	ImportedBy uuid.UUID `json:"imported_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BankStatementQuery when eager-loading is set.
+	Edges        BankStatementEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BankStatementEdges holds the relations/edges for other nodes in the graph.
+type BankStatementEdges struct {
+	// Transactions holds the value of the transactions edge.
+	Transactions []*BankTransaction `json:"transactions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TransactionsOrErr returns the Transactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e BankStatementEdges) TransactionsOrErr() ([]*BankTransaction, error) {
+	if e.loadedTypes[0] {
+		return e.Transactions, nil
+	}
+	return nil, &NotLoadedError{edge: "transactions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BankStatement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bankstatement.FieldOpeningBalance, bankstatement.FieldClosingBalance, bankstatement.FieldExpectedOpeningBalance:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case bankstatement.FieldTransactionCount, bankstatement.FieldDuplicateCount, bankstatement.FieldLinkedCount:
+			values[i] = new(sql.NullInt64)
+		case bankstatement.FieldFormat, bankstatement.FieldProfile, bankstatement.FieldFileName, bankstatement.FieldFileHash, bankstatement.FieldStatementReference, bankstatement.FieldCurrency, bankstatement.FieldContinuity:
+			values[i] = new(sql.NullString)
+		case bankstatement.FieldPeriodStart, bankstatement.FieldPeriodEnd, bankstatement.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case bankstatement.FieldID, bankstatement.FieldTenantID, bankstatement.FieldBankAccountID, bankstatement.FieldImportedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BankStatement fields.
+func (_m *BankStatement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bankstatement.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case bankstatement.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case bankstatement.FieldBankAccountID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field bank_account_id", values[i])
+			} else if value != nil {
+				_m.BankAccountID = *value
+			}
+		case bankstatement.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = value.String
+			}
+		case bankstatement.FieldProfile:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field profile", values[i])
+			} else if value.Valid {
+				_m.Profile = value.String
+			}
+		case bankstatement.FieldFileName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_name", values[i])
+			} else if value.Valid {
+				_m.FileName = value.String
+			}
+		case bankstatement.FieldFileHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_hash", values[i])
+			} else if value.Valid {
+				_m.FileHash = value.String
+			}
+		case bankstatement.FieldStatementReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field statement_reference", values[i])
+			} else if value.Valid {
+				_m.StatementReference = value.String
+			}
+		case bankstatement.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case bankstatement.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				_m.PeriodStart = value.Time
+			}
+		case bankstatement.FieldPeriodEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_end", values[i])
+			} else if value.Valid {
+				_m.PeriodEnd = value.Time
+			}
+		case bankstatement.FieldOpeningBalance:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field opening_balance", values[i])
+			} else if value.Valid {
+				_m.OpeningBalance = new(decimal.Decimal)
+				*_m.OpeningBalance = *value.S.(*decimal.Decimal)
+			}
+		case bankstatement.FieldClosingBalance:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field closing_balance", values[i])
+			} else if value.Valid {
+				_m.ClosingBalance = new(decimal.Decimal)
+				*_m.ClosingBalance = *value.S.(*decimal.Decimal)
+			}
+		case bankstatement.FieldContinuity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field continuity", values[i])
+			} else if value.Valid {
+				_m.Continuity = value.String
+			}
+		case bankstatement.FieldExpectedOpeningBalance:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field expected_opening_balance", values[i])
+			} else if value.Valid {
+				_m.ExpectedOpeningBalance = new(decimal.Decimal)
+				*_m.ExpectedOpeningBalance = *value.S.(*decimal.Decimal)
+			}
+		case bankstatement.FieldTransactionCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_count", values[i])
+			} else if value.Valid {
+				_m.TransactionCount = int(value.Int64)
+			}
+		case bankstatement.FieldDuplicateCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duplicate_count", values[i])
+			} else if value.Valid {
+				_m.DuplicateCount = int(value.Int64)
+			}
+		case bankstatement.FieldLinkedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field linked_count", values[i])
+			} else if value.Valid {
+				_m.LinkedCount = int(value.Int64)
+			}
+		case bankstatement.FieldImportedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field imported_by", values[i])
+			} else if value != nil {
+				_m.ImportedBy = *value
+			}
+		case bankstatement.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BankStatement.
+// This includes values selected through modifiers, order, etc.
+func (_m *BankStatement) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTransactions queries the "transactions" edge of the BankStatement entity.
+func (_m *BankStatement) QueryTransactions() *BankTransactionQuery {
+	return NewBankStatementClient(_m.config).QueryTransactions(_m)
+}
+
+// Update returns a builder for updating this BankStatement.
+// Note that you need to call BankStatement.Unwrap() before calling this method if this BankStatement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BankStatement) Update() *BankStatementUpdateOne {
+	return NewBankStatementClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BankStatement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BankStatement) Unwrap() *BankStatement {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BankStatement is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BankStatement) String() string {
+	var builder strings.Builder
+	builder.WriteString("BankStatement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("bank_account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BankAccountID))
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(_m.Format)
+	builder.WriteString(", ")
+	builder.WriteString("profile=")
+	builder.WriteString(_m.Profile)
+	builder.WriteString(", ")
+	builder.WriteString("file_name=")
+	builder.WriteString(_m.FileName)
+	builder.WriteString(", ")
+	builder.WriteString("file_hash=")
+	builder.WriteString(_m.FileHash)
+	builder.WriteString(", ")
+	builder.WriteString("statement_reference=")
+	builder.WriteString(_m.StatementReference)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("period_start=")
+	builder.WriteString(_m.PeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("period_end=")
+	builder.WriteString(_m.PeriodEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.OpeningBalance; v != nil {
+		builder.WriteString("opening_balance=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ClosingBalance; v != nil {
+		builder.WriteString("closing_balance=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("continuity=")
+	builder.WriteString(_m.Continuity)
+	builder.WriteString(", ")
+	if v := _m.ExpectedOpeningBalance; v != nil {
+		builder.WriteString("expected_opening_balance=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("transaction_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TransactionCount))
+	builder.WriteString(", ")
+	builder.WriteString("duplicate_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DuplicateCount))
+	builder.WriteString(", ")
+	builder.WriteString("linked_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LinkedCount))
+	builder.WriteString(", ")
+	builder.WriteString("imported_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ImportedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BankStatements is a parsable slice of BankStatement.
+type BankStatements []*BankStatement
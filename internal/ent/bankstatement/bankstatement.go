@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bankstatement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the bankstatement type in the database.
+	Label = "bank_statement"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldBankAccountID holds the string denoting the bank_account_id field in the database.
+	FieldBankAccountID = "bank_account_id"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldProfile holds the string denoting the profile field in the database.
+	FieldProfile = "profile"
+	// FieldFileName holds the string denoting the file_name field in the database.
+	FieldFileName = "file_name"
+	// FieldFileHash holds the string denoting the file_hash field in the database.
+	FieldFileHash = "file_hash"
+	// FieldStatementReference holds the string denoting the statement_reference field in the database.
+	FieldStatementReference = "statement_reference"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldPeriodStart holds the string denoting the period_start field in the database.
+	FieldPeriodStart = "period_start"
+	// FieldPeriodEnd holds the string denoting the period_end field in the database.
+	FieldPeriodEnd = "period_end"
+	// FieldOpeningBalance holds the string denoting the opening_balance field in the database.
+	FieldOpeningBalance = "opening_balance"
+	// FieldClosingBalance holds the string denoting the closing_balance field in the database.
+	FieldClosingBalance = "closing_balance"
+	// FieldContinuity holds the string denoting the continuity field in the database.
+	FieldContinuity = "continuity"
+	// FieldExpectedOpeningBalance holds the string denoting the expected_opening_balance field in the database.
+	FieldExpectedOpeningBalance = "expected_opening_balance"
+	// FieldTransactionCount holds the string denoting the transaction_count field in the database.
+	FieldTransactionCount = "transaction_count"
+	// FieldDuplicateCount holds the string denoting the duplicate_count field in the database.
+	FieldDuplicateCount = "duplicate_count"
+	// FieldLinkedCount holds the string denoting the linked_count field in the database.
+	FieldLinkedCount = "linked_count"
+	// FieldImportedBy holds the string denoting the imported_by field in the database.
+	FieldImportedBy = "imported_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeTransactions holds the string denoting the transactions edge name in mutations.
+	EdgeTransactions = "transactions"
+	// Table holds the table name of the bankstatement in the database.
+	Table = "bank_statements"
+	// TransactionsTable is the table that holds the transactions relation/edge.
+	TransactionsTable = "bank_transactions"
+	// TransactionsInverseTable is the table name for the BankTransaction entity.
+	// It exists in this package in order to avoid circular dependency with the "banktransaction" package.
+	TransactionsInverseTable = "bank_transactions"
+	// TransactionsColumn is the table column denoting the transactions relation/edge.
+	TransactionsColumn = "statement_id"
+)
+
+// Columns holds all SQL columns for bankstatement fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldBankAccountID,
+	FieldFormat,
+	FieldProfile,
+	FieldFileName,
+	FieldFileHash,
+	FieldStatementReference,
+	FieldCurrency,
+	FieldPeriodStart,
+	FieldPeriodEnd,
+	FieldOpeningBalance,
+	FieldClosingBalance,
+	FieldContinuity,
+	FieldExpectedOpeningBalance,
+	FieldTransactionCount,
+	FieldDuplicateCount,
+	FieldLinkedCount,
+	FieldImportedBy,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultLinkedCount holds the default value on creation for the "linked_count" field.
+	DefaultLinkedCount int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the BankStatement queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByBankAccountID orders the results by the bank_account_id field.
+func ByBankAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBankAccountID, opts...).ToFunc()
+}
+
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// ByProfile orders the results by the profile field.
+func ByProfile(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProfile, opts...).ToFunc()
+}
+
+// ByFileName orders the results by the file_name field.
+func ByFileName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileName, opts...).ToFunc()
+}
+
+// ByFileHash orders the results by the file_hash field.
+func ByFileHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileHash, opts...).ToFunc()
+}
+
+// ByStatementReference orders the results by the statement_reference field.
+func ByStatementReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatementReference, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByPeriodStart orders the results by the period_start field.
+func ByPeriodStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodStart, opts...).ToFunc()
+}
+
+// ByPeriodEnd orders the results by the period_end field.
+func ByPeriodEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodEnd, opts...).ToFunc()
+}
+
+// ByOpeningBalance orders the results by the opening_balance field.
+func ByOpeningBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpeningBalance, opts...).ToFunc()
+}
+
+// ByClosingBalance orders the results by the closing_balance field.
+func ByClosingBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosingBalance, opts...).ToFunc()
+}
+
+// ByContinuity orders the results by the continuity field.
+func ByContinuity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContinuity, opts...).ToFunc()
+}
+
+// ByExpectedOpeningBalance orders the results by the expected_opening_balance field.
+func ByExpectedOpeningBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpectedOpeningBalance, opts...).ToFunc()
+}
+
+// ByTransactionCount orders the results by the transaction_count field.
+func ByTransactionCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransactionCount, opts...).ToFunc()
+}
+
+// ByDuplicateCount orders the results by the duplicate_count field.
+func ByDuplicateCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDuplicateCount, opts...).ToFunc()
+}
+
+// ByLinkedCount orders the results by the linked_count field.
+func ByLinkedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLinkedCount, opts...).ToFunc()
+}
+
+// ByImportedBy orders the results by the imported_by field.
+func ByImportedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImportedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTransactionsCount orders the results by transactions count.
+func ByTransactionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTransactionsStep(), opts...)
+	}
+}
+
+// ByTransactions orders the results by transactions terms.
+func ByTransactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTransactionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransactionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TransactionsTable, TransactionsColumn),
+	)
+}
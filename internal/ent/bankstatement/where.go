@@ -0,0 +1,1196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bankstatement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldTenantID, v))
+}
+
+// BankAccountID applies equality check predicate on the "bank_account_id" field. It's identical to BankAccountIDEQ.
+func BankAccountID(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldBankAccountID, v))
+}
+
+// Format applies equality check predicate on the "format" field. It's identical to FormatEQ.
+func Format(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldFormat, v))
+}
+
+// Profile applies equality check predicate on the "profile" field. It's identical to ProfileEQ.
+func Profile(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldProfile, v))
+}
+
+// FileName applies equality check predicate on the "file_name" field. It's identical to FileNameEQ.
+func FileName(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldFileName, v))
+}
+
+// FileHash applies equality check predicate on the "file_hash" field. It's identical to FileHashEQ.
+func FileHash(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldFileHash, v))
+}
+
+// StatementReference applies equality check predicate on the "statement_reference" field. It's identical to StatementReferenceEQ.
+func StatementReference(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldStatementReference, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldCurrency, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodEnd applies equality check predicate on the "period_end" field. It's identical to PeriodEndEQ.
+func PeriodEnd(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// OpeningBalance applies equality check predicate on the "opening_balance" field. It's identical to OpeningBalanceEQ.
+func OpeningBalance(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldOpeningBalance, v))
+}
+
+// ClosingBalance applies equality check predicate on the "closing_balance" field. It's identical to ClosingBalanceEQ.
+func ClosingBalance(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldClosingBalance, v))
+}
+
+// Continuity applies equality check predicate on the "continuity" field. It's identical to ContinuityEQ.
+func Continuity(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldContinuity, v))
+}
+
+// ExpectedOpeningBalance applies equality check predicate on the "expected_opening_balance" field. It's identical to ExpectedOpeningBalanceEQ.
+func ExpectedOpeningBalance(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldExpectedOpeningBalance, v))
+}
+
+// TransactionCount applies equality check predicate on the "transaction_count" field. It's identical to TransactionCountEQ.
+func TransactionCount(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldTransactionCount, v))
+}
+
+// DuplicateCount applies equality check predicate on the "duplicate_count" field. It's identical to DuplicateCountEQ.
+func DuplicateCount(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldDuplicateCount, v))
+}
+
+// LinkedCount applies equality check predicate on the "linked_count" field. It's identical to LinkedCountEQ.
+func LinkedCount(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldLinkedCount, v))
+}
+
+// ImportedBy applies equality check predicate on the "imported_by" field. It's identical to ImportedByEQ.
+func ImportedBy(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldImportedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldTenantID, v))
+}
+
+// BankAccountIDEQ applies the EQ predicate on the "bank_account_id" field.
+func BankAccountIDEQ(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldBankAccountID, v))
+}
+
+// BankAccountIDNEQ applies the NEQ predicate on the "bank_account_id" field.
+func BankAccountIDNEQ(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldBankAccountID, v))
+}
+
+// BankAccountIDIn applies the In predicate on the "bank_account_id" field.
+func BankAccountIDIn(vs ...uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldBankAccountID, vs...))
+}
+
+// BankAccountIDNotIn applies the NotIn predicate on the "bank_account_id" field.
+func BankAccountIDNotIn(vs ...uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldBankAccountID, vs...))
+}
+
+// BankAccountIDGT applies the GT predicate on the "bank_account_id" field.
+func BankAccountIDGT(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldBankAccountID, v))
+}
+
+// BankAccountIDGTE applies the GTE predicate on the "bank_account_id" field.
+func BankAccountIDGTE(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldBankAccountID, v))
+}
+
+// BankAccountIDLT applies the LT predicate on the "bank_account_id" field.
+func BankAccountIDLT(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldBankAccountID, v))
+}
+
+// BankAccountIDLTE applies the LTE predicate on the "bank_account_id" field.
+func BankAccountIDLTE(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldBankAccountID, v))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// FormatGT applies the GT predicate on the "format" field.
+func FormatGT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldFormat, v))
+}
+
+// FormatGTE applies the GTE predicate on the "format" field.
+func FormatGTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldFormat, v))
+}
+
+// FormatLT applies the LT predicate on the "format" field.
+func FormatLT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldFormat, v))
+}
+
+// FormatLTE applies the LTE predicate on the "format" field.
+func FormatLTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldFormat, v))
+}
+
+// FormatContains applies the Contains predicate on the "format" field.
+func FormatContains(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContains(FieldFormat, v))
+}
+
+// FormatHasPrefix applies the HasPrefix predicate on the "format" field.
+func FormatHasPrefix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasPrefix(FieldFormat, v))
+}
+
+// FormatHasSuffix applies the HasSuffix predicate on the "format" field.
+func FormatHasSuffix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasSuffix(FieldFormat, v))
+}
+
+// FormatEqualFold applies the EqualFold predicate on the "format" field.
+func FormatEqualFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEqualFold(FieldFormat, v))
+}
+
+// FormatContainsFold applies the ContainsFold predicate on the "format" field.
+func FormatContainsFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContainsFold(FieldFormat, v))
+}
+
+// ProfileEQ applies the EQ predicate on the "profile" field.
+func ProfileEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldProfile, v))
+}
+
+// ProfileNEQ applies the NEQ predicate on the "profile" field.
+func ProfileNEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldProfile, v))
+}
+
+// ProfileIn applies the In predicate on the "profile" field.
+func ProfileIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldProfile, vs...))
+}
+
+// ProfileNotIn applies the NotIn predicate on the "profile" field.
+func ProfileNotIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldProfile, vs...))
+}
+
+// ProfileGT applies the GT predicate on the "profile" field.
+func ProfileGT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldProfile, v))
+}
+
+// ProfileGTE applies the GTE predicate on the "profile" field.
+func ProfileGTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldProfile, v))
+}
+
+// ProfileLT applies the LT predicate on the "profile" field.
+func ProfileLT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldProfile, v))
+}
+
+// ProfileLTE applies the LTE predicate on the "profile" field.
+func ProfileLTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldProfile, v))
+}
+
+// ProfileContains applies the Contains predicate on the "profile" field.
+func ProfileContains(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContains(FieldProfile, v))
+}
+
+// ProfileHasPrefix applies the HasPrefix predicate on the "profile" field.
+func ProfileHasPrefix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasPrefix(FieldProfile, v))
+}
+
+// ProfileHasSuffix applies the HasSuffix predicate on the "profile" field.
+func ProfileHasSuffix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasSuffix(FieldProfile, v))
+}
+
+// ProfileIsNil applies the IsNil predicate on the "profile" field.
+func ProfileIsNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIsNull(FieldProfile))
+}
+
+// ProfileNotNil applies the NotNil predicate on the "profile" field.
+func ProfileNotNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotNull(FieldProfile))
+}
+
+// ProfileEqualFold applies the EqualFold predicate on the "profile" field.
+func ProfileEqualFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEqualFold(FieldProfile, v))
+}
+
+// ProfileContainsFold applies the ContainsFold predicate on the "profile" field.
+func ProfileContainsFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContainsFold(FieldProfile, v))
+}
+
+// FileNameEQ applies the EQ predicate on the "file_name" field.
+func FileNameEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldFileName, v))
+}
+
+// FileNameNEQ applies the NEQ predicate on the "file_name" field.
+func FileNameNEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldFileName, v))
+}
+
+// FileNameIn applies the In predicate on the "file_name" field.
+func FileNameIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldFileName, vs...))
+}
+
+// FileNameNotIn applies the NotIn predicate on the "file_name" field.
+func FileNameNotIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldFileName, vs...))
+}
+
+// FileNameGT applies the GT predicate on the "file_name" field.
+func FileNameGT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldFileName, v))
+}
+
+// FileNameGTE applies the GTE predicate on the "file_name" field.
+func FileNameGTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldFileName, v))
+}
+
+// FileNameLT applies the LT predicate on the "file_name" field.
+func FileNameLT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldFileName, v))
+}
+
+// FileNameLTE applies the LTE predicate on the "file_name" field.
+func FileNameLTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldFileName, v))
+}
+
+// FileNameContains applies the Contains predicate on the "file_name" field.
+func FileNameContains(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContains(FieldFileName, v))
+}
+
+// FileNameHasPrefix applies the HasPrefix predicate on the "file_name" field.
+func FileNameHasPrefix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasPrefix(FieldFileName, v))
+}
+
+// FileNameHasSuffix applies the HasSuffix predicate on the "file_name" field.
+func FileNameHasSuffix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasSuffix(FieldFileName, v))
+}
+
+// FileNameIsNil applies the IsNil predicate on the "file_name" field.
+func FileNameIsNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIsNull(FieldFileName))
+}
+
+// FileNameNotNil applies the NotNil predicate on the "file_name" field.
+func FileNameNotNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotNull(FieldFileName))
+}
+
+// FileNameEqualFold applies the EqualFold predicate on the "file_name" field.
+func FileNameEqualFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEqualFold(FieldFileName, v))
+}
+
+// FileNameContainsFold applies the ContainsFold predicate on the "file_name" field.
+func FileNameContainsFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContainsFold(FieldFileName, v))
+}
+
+// FileHashEQ applies the EQ predicate on the "file_hash" field.
+func FileHashEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldFileHash, v))
+}
+
+// FileHashNEQ applies the NEQ predicate on the "file_hash" field.
+func FileHashNEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldFileHash, v))
+}
+
+// FileHashIn applies the In predicate on the "file_hash" field.
+func FileHashIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldFileHash, vs...))
+}
+
+// FileHashNotIn applies the NotIn predicate on the "file_hash" field.
+func FileHashNotIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldFileHash, vs...))
+}
+
+// FileHashGT applies the GT predicate on the "file_hash" field.
+func FileHashGT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldFileHash, v))
+}
+
+// FileHashGTE applies the GTE predicate on the "file_hash" field.
+func FileHashGTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldFileHash, v))
+}
+
+// FileHashLT applies the LT predicate on the "file_hash" field.
+func FileHashLT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldFileHash, v))
+}
+
+// FileHashLTE applies the LTE predicate on the "file_hash" field.
+func FileHashLTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldFileHash, v))
+}
+
+// FileHashContains applies the Contains predicate on the "file_hash" field.
+func FileHashContains(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContains(FieldFileHash, v))
+}
+
+// FileHashHasPrefix applies the HasPrefix predicate on the "file_hash" field.
+func FileHashHasPrefix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasPrefix(FieldFileHash, v))
+}
+
+// FileHashHasSuffix applies the HasSuffix predicate on the "file_hash" field.
+func FileHashHasSuffix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasSuffix(FieldFileHash, v))
+}
+
+// FileHashEqualFold applies the EqualFold predicate on the "file_hash" field.
+func FileHashEqualFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEqualFold(FieldFileHash, v))
+}
+
+// FileHashContainsFold applies the ContainsFold predicate on the "file_hash" field.
+func FileHashContainsFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContainsFold(FieldFileHash, v))
+}
+
+// StatementReferenceEQ applies the EQ predicate on the "statement_reference" field.
+func StatementReferenceEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldStatementReference, v))
+}
+
+// StatementReferenceNEQ applies the NEQ predicate on the "statement_reference" field.
+func StatementReferenceNEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldStatementReference, v))
+}
+
+// StatementReferenceIn applies the In predicate on the "statement_reference" field.
+func StatementReferenceIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldStatementReference, vs...))
+}
+
+// StatementReferenceNotIn applies the NotIn predicate on the "statement_reference" field.
+func StatementReferenceNotIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldStatementReference, vs...))
+}
+
+// StatementReferenceGT applies the GT predicate on the "statement_reference" field.
+func StatementReferenceGT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldStatementReference, v))
+}
+
+// StatementReferenceGTE applies the GTE predicate on the "statement_reference" field.
+func StatementReferenceGTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldStatementReference, v))
+}
+
+// StatementReferenceLT applies the LT predicate on the "statement_reference" field.
+func StatementReferenceLT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldStatementReference, v))
+}
+
+// StatementReferenceLTE applies the LTE predicate on the "statement_reference" field.
+func StatementReferenceLTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldStatementReference, v))
+}
+
+// StatementReferenceContains applies the Contains predicate on the "statement_reference" field.
+func StatementReferenceContains(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContains(FieldStatementReference, v))
+}
+
+// StatementReferenceHasPrefix applies the HasPrefix predicate on the "statement_reference" field.
+func StatementReferenceHasPrefix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasPrefix(FieldStatementReference, v))
+}
+
+// StatementReferenceHasSuffix applies the HasSuffix predicate on the "statement_reference" field.
+func StatementReferenceHasSuffix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasSuffix(FieldStatementReference, v))
+}
+
+// StatementReferenceIsNil applies the IsNil predicate on the "statement_reference" field.
+func StatementReferenceIsNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIsNull(FieldStatementReference))
+}
+
+// StatementReferenceNotNil applies the NotNil predicate on the "statement_reference" field.
+func StatementReferenceNotNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotNull(FieldStatementReference))
+}
+
+// StatementReferenceEqualFold applies the EqualFold predicate on the "statement_reference" field.
+func StatementReferenceEqualFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEqualFold(FieldStatementReference, v))
+}
+
+// StatementReferenceContainsFold applies the ContainsFold predicate on the "statement_reference" field.
+func StatementReferenceContainsFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContainsFold(FieldStatementReference, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// PeriodEndEQ applies the EQ predicate on the "period_end" field.
+func PeriodEndEQ(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndNEQ applies the NEQ predicate on the "period_end" field.
+func PeriodEndNEQ(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndIn applies the In predicate on the "period_end" field.
+func PeriodEndIn(vs ...time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndNotIn applies the NotIn predicate on the "period_end" field.
+func PeriodEndNotIn(vs ...time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndGT applies the GT predicate on the "period_end" field.
+func PeriodEndGT(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldPeriodEnd, v))
+}
+
+// PeriodEndGTE applies the GTE predicate on the "period_end" field.
+func PeriodEndGTE(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndLT applies the LT predicate on the "period_end" field.
+func PeriodEndLT(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldPeriodEnd, v))
+}
+
+// PeriodEndLTE applies the LTE predicate on the "period_end" field.
+func PeriodEndLTE(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldPeriodEnd, v))
+}
+
+// OpeningBalanceEQ applies the EQ predicate on the "opening_balance" field.
+func OpeningBalanceEQ(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceNEQ applies the NEQ predicate on the "opening_balance" field.
+func OpeningBalanceNEQ(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceIn applies the In predicate on the "opening_balance" field.
+func OpeningBalanceIn(vs ...decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldOpeningBalance, vs...))
+}
+
+// OpeningBalanceNotIn applies the NotIn predicate on the "opening_balance" field.
+func OpeningBalanceNotIn(vs ...decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldOpeningBalance, vs...))
+}
+
+// OpeningBalanceGT applies the GT predicate on the "opening_balance" field.
+func OpeningBalanceGT(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceGTE applies the GTE predicate on the "opening_balance" field.
+func OpeningBalanceGTE(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceLT applies the LT predicate on the "opening_balance" field.
+func OpeningBalanceLT(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceLTE applies the LTE predicate on the "opening_balance" field.
+func OpeningBalanceLTE(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceIsNil applies the IsNil predicate on the "opening_balance" field.
+func OpeningBalanceIsNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIsNull(FieldOpeningBalance))
+}
+
+// OpeningBalanceNotNil applies the NotNil predicate on the "opening_balance" field.
+func OpeningBalanceNotNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotNull(FieldOpeningBalance))
+}
+
+// ClosingBalanceEQ applies the EQ predicate on the "closing_balance" field.
+func ClosingBalanceEQ(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldClosingBalance, v))
+}
+
+// ClosingBalanceNEQ applies the NEQ predicate on the "closing_balance" field.
+func ClosingBalanceNEQ(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldClosingBalance, v))
+}
+
+// ClosingBalanceIn applies the In predicate on the "closing_balance" field.
+func ClosingBalanceIn(vs ...decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldClosingBalance, vs...))
+}
+
+// ClosingBalanceNotIn applies the NotIn predicate on the "closing_balance" field.
+func ClosingBalanceNotIn(vs ...decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldClosingBalance, vs...))
+}
+
+// ClosingBalanceGT applies the GT predicate on the "closing_balance" field.
+func ClosingBalanceGT(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldClosingBalance, v))
+}
+
+// ClosingBalanceGTE applies the GTE predicate on the "closing_balance" field.
+func ClosingBalanceGTE(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldClosingBalance, v))
+}
+
+// ClosingBalanceLT applies the LT predicate on the "closing_balance" field.
+func ClosingBalanceLT(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldClosingBalance, v))
+}
+
+// ClosingBalanceLTE applies the LTE predicate on the "closing_balance" field.
+func ClosingBalanceLTE(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldClosingBalance, v))
+}
+
+// ClosingBalanceIsNil applies the IsNil predicate on the "closing_balance" field.
+func ClosingBalanceIsNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIsNull(FieldClosingBalance))
+}
+
+// ClosingBalanceNotNil applies the NotNil predicate on the "closing_balance" field.
+func ClosingBalanceNotNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotNull(FieldClosingBalance))
+}
+
+// ContinuityEQ applies the EQ predicate on the "continuity" field.
+func ContinuityEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldContinuity, v))
+}
+
+// ContinuityNEQ applies the NEQ predicate on the "continuity" field.
+func ContinuityNEQ(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldContinuity, v))
+}
+
+// ContinuityIn applies the In predicate on the "continuity" field.
+func ContinuityIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldContinuity, vs...))
+}
+
+// ContinuityNotIn applies the NotIn predicate on the "continuity" field.
+func ContinuityNotIn(vs ...string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldContinuity, vs...))
+}
+
+// ContinuityGT applies the GT predicate on the "continuity" field.
+func ContinuityGT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldContinuity, v))
+}
+
+// ContinuityGTE applies the GTE predicate on the "continuity" field.
+func ContinuityGTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldContinuity, v))
+}
+
+// ContinuityLT applies the LT predicate on the "continuity" field.
+func ContinuityLT(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldContinuity, v))
+}
+
+// ContinuityLTE applies the LTE predicate on the "continuity" field.
+func ContinuityLTE(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldContinuity, v))
+}
+
+// ContinuityContains applies the Contains predicate on the "continuity" field.
+func ContinuityContains(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContains(FieldContinuity, v))
+}
+
+// ContinuityHasPrefix applies the HasPrefix predicate on the "continuity" field.
+func ContinuityHasPrefix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasPrefix(FieldContinuity, v))
+}
+
+// ContinuityHasSuffix applies the HasSuffix predicate on the "continuity" field.
+func ContinuityHasSuffix(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldHasSuffix(FieldContinuity, v))
+}
+
+// ContinuityEqualFold applies the EqualFold predicate on the "continuity" field.
+func ContinuityEqualFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEqualFold(FieldContinuity, v))
+}
+
+// ContinuityContainsFold applies the ContainsFold predicate on the "continuity" field.
+func ContinuityContainsFold(v string) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldContainsFold(FieldContinuity, v))
+}
+
+// ExpectedOpeningBalanceEQ applies the EQ predicate on the "expected_opening_balance" field.
+func ExpectedOpeningBalanceEQ(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldExpectedOpeningBalance, v))
+}
+
+// ExpectedOpeningBalanceNEQ applies the NEQ predicate on the "expected_opening_balance" field.
+func ExpectedOpeningBalanceNEQ(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldExpectedOpeningBalance, v))
+}
+
+// ExpectedOpeningBalanceIn applies the In predicate on the "expected_opening_balance" field.
+func ExpectedOpeningBalanceIn(vs ...decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldExpectedOpeningBalance, vs...))
+}
+
+// ExpectedOpeningBalanceNotIn applies the NotIn predicate on the "expected_opening_balance" field.
+func ExpectedOpeningBalanceNotIn(vs ...decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldExpectedOpeningBalance, vs...))
+}
+
+// ExpectedOpeningBalanceGT applies the GT predicate on the "expected_opening_balance" field.
+func ExpectedOpeningBalanceGT(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldExpectedOpeningBalance, v))
+}
+
+// ExpectedOpeningBalanceGTE applies the GTE predicate on the "expected_opening_balance" field.
+func ExpectedOpeningBalanceGTE(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldExpectedOpeningBalance, v))
+}
+
+// ExpectedOpeningBalanceLT applies the LT predicate on the "expected_opening_balance" field.
+func ExpectedOpeningBalanceLT(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldExpectedOpeningBalance, v))
+}
+
+// ExpectedOpeningBalanceLTE applies the LTE predicate on the "expected_opening_balance" field.
+func ExpectedOpeningBalanceLTE(v decimal.Decimal) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldExpectedOpeningBalance, v))
+}
+
+// ExpectedOpeningBalanceIsNil applies the IsNil predicate on the "expected_opening_balance" field.
+func ExpectedOpeningBalanceIsNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIsNull(FieldExpectedOpeningBalance))
+}
+
+// ExpectedOpeningBalanceNotNil applies the NotNil predicate on the "expected_opening_balance" field.
+func ExpectedOpeningBalanceNotNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotNull(FieldExpectedOpeningBalance))
+}
+
+// TransactionCountEQ applies the EQ predicate on the "transaction_count" field.
+func TransactionCountEQ(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldTransactionCount, v))
+}
+
+// TransactionCountNEQ applies the NEQ predicate on the "transaction_count" field.
+func TransactionCountNEQ(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldTransactionCount, v))
+}
+
+// TransactionCountIn applies the In predicate on the "transaction_count" field.
+func TransactionCountIn(vs ...int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldTransactionCount, vs...))
+}
+
+// TransactionCountNotIn applies the NotIn predicate on the "transaction_count" field.
+func TransactionCountNotIn(vs ...int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldTransactionCount, vs...))
+}
+
+// TransactionCountGT applies the GT predicate on the "transaction_count" field.
+func TransactionCountGT(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldTransactionCount, v))
+}
+
+// TransactionCountGTE applies the GTE predicate on the "transaction_count" field.
+func TransactionCountGTE(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldTransactionCount, v))
+}
+
+// TransactionCountLT applies the LT predicate on the "transaction_count" field.
+func TransactionCountLT(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldTransactionCount, v))
+}
+
+// TransactionCountLTE applies the LTE predicate on the "transaction_count" field.
+func TransactionCountLTE(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldTransactionCount, v))
+}
+
+// DuplicateCountEQ applies the EQ predicate on the "duplicate_count" field.
+func DuplicateCountEQ(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldDuplicateCount, v))
+}
+
+// DuplicateCountNEQ applies the NEQ predicate on the "duplicate_count" field.
+func DuplicateCountNEQ(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldDuplicateCount, v))
+}
+
+// DuplicateCountIn applies the In predicate on the "duplicate_count" field.
+func DuplicateCountIn(vs ...int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldDuplicateCount, vs...))
+}
+
+// DuplicateCountNotIn applies the NotIn predicate on the "duplicate_count" field.
+func DuplicateCountNotIn(vs ...int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldDuplicateCount, vs...))
+}
+
+// DuplicateCountGT applies the GT predicate on the "duplicate_count" field.
+func DuplicateCountGT(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldDuplicateCount, v))
+}
+
+// DuplicateCountGTE applies the GTE predicate on the "duplicate_count" field.
+func DuplicateCountGTE(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldDuplicateCount, v))
+}
+
+// DuplicateCountLT applies the LT predicate on the "duplicate_count" field.
+func DuplicateCountLT(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldDuplicateCount, v))
+}
+
+// DuplicateCountLTE applies the LTE predicate on the "duplicate_count" field.
+func DuplicateCountLTE(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldDuplicateCount, v))
+}
+
+// LinkedCountEQ applies the EQ predicate on the "linked_count" field.
+func LinkedCountEQ(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldLinkedCount, v))
+}
+
+// LinkedCountNEQ applies the NEQ predicate on the "linked_count" field.
+func LinkedCountNEQ(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldLinkedCount, v))
+}
+
+// LinkedCountIn applies the In predicate on the "linked_count" field.
+func LinkedCountIn(vs ...int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldLinkedCount, vs...))
+}
+
+// LinkedCountNotIn applies the NotIn predicate on the "linked_count" field.
+func LinkedCountNotIn(vs ...int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldLinkedCount, vs...))
+}
+
+// LinkedCountGT applies the GT predicate on the "linked_count" field.
+func LinkedCountGT(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldLinkedCount, v))
+}
+
+// LinkedCountGTE applies the GTE predicate on the "linked_count" field.
+func LinkedCountGTE(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldLinkedCount, v))
+}
+
+// LinkedCountLT applies the LT predicate on the "linked_count" field.
+func LinkedCountLT(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldLinkedCount, v))
+}
+
+// LinkedCountLTE applies the LTE predicate on the "linked_count" field.
+func LinkedCountLTE(v int) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldLinkedCount, v))
+}
+
+// ImportedByEQ applies the EQ predicate on the "imported_by" field.
+func ImportedByEQ(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldImportedBy, v))
+}
+
+// ImportedByNEQ applies the NEQ predicate on the "imported_by" field.
+func ImportedByNEQ(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldImportedBy, v))
+}
+
+// ImportedByIn applies the In predicate on the "imported_by" field.
+func ImportedByIn(vs ...uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldImportedBy, vs...))
+}
+
+// ImportedByNotIn applies the NotIn predicate on the "imported_by" field.
+func ImportedByNotIn(vs ...uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldImportedBy, vs...))
+}
+
+// ImportedByGT applies the GT predicate on the "imported_by" field.
+func ImportedByGT(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldImportedBy, v))
+}
+
+// ImportedByGTE applies the GTE predicate on the "imported_by" field.
+func ImportedByGTE(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldImportedBy, v))
+}
+
+// ImportedByLT applies the LT predicate on the "imported_by" field.
+func ImportedByLT(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldImportedBy, v))
+}
+
+// ImportedByLTE applies the LTE predicate on the "imported_by" field.
+func ImportedByLTE(v uuid.UUID) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldImportedBy, v))
+}
+
+// ImportedByIsNil applies the IsNil predicate on the "imported_by" field.
+func ImportedByIsNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIsNull(FieldImportedBy))
+}
+
+// ImportedByNotNil applies the NotNil predicate on the "imported_by" field.
+func ImportedByNotNil() predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotNull(FieldImportedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BankStatement {
+	return predicate.BankStatement(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTransactions applies the HasEdge predicate on the "transactions" edge.
+func HasTransactions() predicate.BankStatement {
+	return predicate.BankStatement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TransactionsTable, TransactionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionsWith applies the HasEdge predicate on the "transactions" edge with a given conditions (other predicates).
+func HasTransactionsWith(preds ...predicate.BankTransaction) predicate.BankStatement {
+	return predicate.BankStatement(func(s *sql.Selector) {
+		step := newTransactionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BankStatement) predicate.BankStatement {
+	return predicate.BankStatement(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BankStatement) predicate.BankStatement {
+	return predicate.BankStatement(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BankStatement) predicate.BankStatement {
+	return predicate.BankStatement(sql.NotPredicates(p))
+}
@@ -0,0 +1,1793 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatement"
+	"github.com/bengobox/treasury-api/internal/ent/banktransaction"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BankStatementCreate is the builder for creating a BankStatement entity.
+type BankStatementCreate struct {
+	config
+	mutation *BankStatementMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *BankStatementCreate) SetTenantID(v uuid.UUID) *BankStatementCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (_c *BankStatementCreate) SetBankAccountID(v uuid.UUID) *BankStatementCreate {
+	_c.mutation.SetBankAccountID(v)
+	return _c
+}
+
+// SetFormat sets the "format" field.
+func (_c *BankStatementCreate) SetFormat(v string) *BankStatementCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetProfile sets the "profile" field.
+func (_c *BankStatementCreate) SetProfile(v string) *BankStatementCreate {
+	_c.mutation.SetProfile(v)
+	return _c
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillableProfile(v *string) *BankStatementCreate {
+	if v != nil {
+		_c.SetProfile(*v)
+	}
+	return _c
+}
+
+// SetFileName sets the "file_name" field.
+func (_c *BankStatementCreate) SetFileName(v string) *BankStatementCreate {
+	_c.mutation.SetFileName(v)
+	return _c
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillableFileName(v *string) *BankStatementCreate {
+	if v != nil {
+		_c.SetFileName(*v)
+	}
+	return _c
+}
+
+// SetFileHash sets the "file_hash" field.
+func (_c *BankStatementCreate) SetFileHash(v string) *BankStatementCreate {
+	_c.mutation.SetFileHash(v)
+	return _c
+}
+
+// SetStatementReference sets the "statement_reference" field.
+func (_c *BankStatementCreate) SetStatementReference(v string) *BankStatementCreate {
+	_c.mutation.SetStatementReference(v)
+	return _c
+}
+
+// SetNillableStatementReference sets the "statement_reference" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillableStatementReference(v *string) *BankStatementCreate {
+	if v != nil {
+		_c.SetStatementReference(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *BankStatementCreate) SetCurrency(v string) *BankStatementCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillableCurrency(v *string) *BankStatementCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_c *BankStatementCreate) SetPeriodStart(v time.Time) *BankStatementCreate {
+	_c.mutation.SetPeriodStart(v)
+	return _c
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_c *BankStatementCreate) SetPeriodEnd(v time.Time) *BankStatementCreate {
+	_c.mutation.SetPeriodEnd(v)
+	return _c
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (_c *BankStatementCreate) SetOpeningBalance(v decimal.Decimal) *BankStatementCreate {
+	_c.mutation.SetOpeningBalance(v)
+	return _c
+}
+
+// SetNillableOpeningBalance sets the "opening_balance" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillableOpeningBalance(v *decimal.Decimal) *BankStatementCreate {
+	if v != nil {
+		_c.SetOpeningBalance(*v)
+	}
+	return _c
+}
+
+// SetClosingBalance sets the "closing_balance" field.
+func (_c *BankStatementCreate) SetClosingBalance(v decimal.Decimal) *BankStatementCreate {
+	_c.mutation.SetClosingBalance(v)
+	return _c
+}
+
+// SetNillableClosingBalance sets the "closing_balance" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillableClosingBalance(v *decimal.Decimal) *BankStatementCreate {
+	if v != nil {
+		_c.SetClosingBalance(*v)
+	}
+	return _c
+}
+
+// SetContinuity sets the "continuity" field.
+func (_c *BankStatementCreate) SetContinuity(v string) *BankStatementCreate {
+	_c.mutation.SetContinuity(v)
+	return _c
+}
+
+// SetExpectedOpeningBalance sets the "expected_opening_balance" field.
+func (_c *BankStatementCreate) SetExpectedOpeningBalance(v decimal.Decimal) *BankStatementCreate {
+	_c.mutation.SetExpectedOpeningBalance(v)
+	return _c
+}
+
+// SetNillableExpectedOpeningBalance sets the "expected_opening_balance" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillableExpectedOpeningBalance(v *decimal.Decimal) *BankStatementCreate {
+	if v != nil {
+		_c.SetExpectedOpeningBalance(*v)
+	}
+	return _c
+}
+
+// SetTransactionCount sets the "transaction_count" field.
+func (_c *BankStatementCreate) SetTransactionCount(v int) *BankStatementCreate {
+	_c.mutation.SetTransactionCount(v)
+	return _c
+}
+
+// SetDuplicateCount sets the "duplicate_count" field.
+func (_c *BankStatementCreate) SetDuplicateCount(v int) *BankStatementCreate {
+	_c.mutation.SetDuplicateCount(v)
+	return _c
+}
+
+// SetLinkedCount sets the "linked_count" field.
+func (_c *BankStatementCreate) SetLinkedCount(v int) *BankStatementCreate {
+	_c.mutation.SetLinkedCount(v)
+	return _c
+}
+
+// SetNillableLinkedCount sets the "linked_count" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillableLinkedCount(v *int) *BankStatementCreate {
+	if v != nil {
+		_c.SetLinkedCount(*v)
+	}
+	return _c
+}
+
+// SetImportedBy sets the "imported_by" field.
+func (_c *BankStatementCreate) SetImportedBy(v uuid.UUID) *BankStatementCreate {
+	_c.mutation.SetImportedBy(v)
+	return _c
+}
+
+// SetNillableImportedBy sets the "imported_by" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillableImportedBy(v *uuid.UUID) *BankStatementCreate {
+	if v != nil {
+		_c.SetImportedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BankStatementCreate) SetCreatedAt(v time.Time) *BankStatementCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillableCreatedAt(v *time.Time) *BankStatementCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BankStatementCreate) SetID(v uuid.UUID) *BankStatementCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BankStatementCreate) SetNillableID(v *uuid.UUID) *BankStatementCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddTransactionIDs adds the "transactions" edge to the BankTransaction entity by IDs.
+func (_c *BankStatementCreate) AddTransactionIDs(ids ...uuid.UUID) *BankStatementCreate {
+	_c.mutation.AddTransactionIDs(ids...)
+	return _c
+}
+
+// AddTransactions adds the "transactions" edges to the BankTransaction entity.
+func (_c *BankStatementCreate) AddTransactions(v ...*BankTransaction) *BankStatementCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the BankStatementMutation object of the builder.
+func (_c *BankStatementCreate) Mutation() *BankStatementMutation {
+	return _c.mutation
+}
+
+// Save creates the BankStatement in the database.
+func (_c *BankStatementCreate) Save(ctx context.Context) (*BankStatement, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BankStatementCreate) SaveX(ctx context.Context) *BankStatement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BankStatementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BankStatementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BankStatementCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := bankstatement.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.LinkedCount(); !ok {
+		v := bankstatement.DefaultLinkedCount
+		_c.mutation.SetLinkedCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := bankstatement.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := bankstatement.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BankStatementCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "BankStatement.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.BankAccountID(); !ok {
+		return &ValidationError{Name: "bank_account_id", err: errors.New(`ent: missing required field "BankStatement.bank_account_id"`)}
+	}
+	if _, ok := _c.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "BankStatement.format"`)}
+	}
+	if _, ok := _c.mutation.FileHash(); !ok {
+		return &ValidationError{Name: "file_hash", err: errors.New(`ent: missing required field "BankStatement.file_hash"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "BankStatement.currency"`)}
+	}
+	if _, ok := _c.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`ent: missing required field "BankStatement.period_start"`)}
+	}
+	if _, ok := _c.mutation.PeriodEnd(); !ok {
+		return &ValidationError{Name: "period_end", err: errors.New(`ent: missing required field "BankStatement.period_end"`)}
+	}
+	if _, ok := _c.mutation.Continuity(); !ok {
+		return &ValidationError{Name: "continuity", err: errors.New(`ent: missing required field "BankStatement.continuity"`)}
+	}
+	if _, ok := _c.mutation.TransactionCount(); !ok {
+		return &ValidationError{Name: "transaction_count", err: errors.New(`ent: missing required field "BankStatement.transaction_count"`)}
+	}
+	if _, ok := _c.mutation.DuplicateCount(); !ok {
+		return &ValidationError{Name: "duplicate_count", err: errors.New(`ent: missing required field "BankStatement.duplicate_count"`)}
+	}
+	if _, ok := _c.mutation.LinkedCount(); !ok {
+		return &ValidationError{Name: "linked_count", err: errors.New(`ent: missing required field "BankStatement.linked_count"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BankStatement.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *BankStatementCreate) sqlSave(ctx context.Context) (*BankStatement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BankStatementCreate) createSpec() (*BankStatement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BankStatement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bankstatement.Table, sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(bankstatement.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.BankAccountID(); ok {
+		_spec.SetField(bankstatement.FieldBankAccountID, field.TypeUUID, value)
+		_node.BankAccountID = value
+	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(bankstatement.FieldFormat, field.TypeString, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.Profile(); ok {
+		_spec.SetField(bankstatement.FieldProfile, field.TypeString, value)
+		_node.Profile = value
+	}
+	if value, ok := _c.mutation.FileName(); ok {
+		_spec.SetField(bankstatement.FieldFileName, field.TypeString, value)
+		_node.FileName = value
+	}
+	if value, ok := _c.mutation.FileHash(); ok {
+		_spec.SetField(bankstatement.FieldFileHash, field.TypeString, value)
+		_node.FileHash = value
+	}
+	if value, ok := _c.mutation.StatementReference(); ok {
+		_spec.SetField(bankstatement.FieldStatementReference, field.TypeString, value)
+		_node.StatementReference = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(bankstatement.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.PeriodStart(); ok {
+		_spec.SetField(bankstatement.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := _c.mutation.PeriodEnd(); ok {
+		_spec.SetField(bankstatement.FieldPeriodEnd, field.TypeTime, value)
+		_node.PeriodEnd = value
+	}
+	if value, ok := _c.mutation.OpeningBalance(); ok {
+		_spec.SetField(bankstatement.FieldOpeningBalance, field.TypeFloat64, value)
+		_node.OpeningBalance = &value
+	}
+	if value, ok := _c.mutation.ClosingBalance(); ok {
+		_spec.SetField(bankstatement.FieldClosingBalance, field.TypeFloat64, value)
+		_node.ClosingBalance = &value
+	}
+	if value, ok := _c.mutation.Continuity(); ok {
+		_spec.SetField(bankstatement.FieldContinuity, field.TypeString, value)
+		_node.Continuity = value
+	}
+	if value, ok := _c.mutation.ExpectedOpeningBalance(); ok {
+		_spec.SetField(bankstatement.FieldExpectedOpeningBalance, field.TypeFloat64, value)
+		_node.ExpectedOpeningBalance = &value
+	}
+	if value, ok := _c.mutation.TransactionCount(); ok {
+		_spec.SetField(bankstatement.FieldTransactionCount, field.TypeInt, value)
+		_node.TransactionCount = value
+	}
+	if value, ok := _c.mutation.DuplicateCount(); ok {
+		_spec.SetField(bankstatement.FieldDuplicateCount, field.TypeInt, value)
+		_node.DuplicateCount = value
+	}
+	if value, ok := _c.mutation.LinkedCount(); ok {
+		_spec.SetField(bankstatement.FieldLinkedCount, field.TypeInt, value)
+		_node.LinkedCount = value
+	}
+	if value, ok := _c.mutation.ImportedBy(); ok {
+		_spec.SetField(bankstatement.FieldImportedBy, field.TypeUUID, value)
+		_node.ImportedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(bankstatement.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bankstatement.TransactionsTable,
+			Columns: []string{bankstatement.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(banktransaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BankStatement.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BankStatementUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BankStatementCreate) OnConflict(opts ...sql.ConflictOption) *BankStatementUpsertOne {
+	_c.conflict = opts
+	return &BankStatementUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BankStatement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BankStatementCreate) OnConflictColumns(columns ...string) *BankStatementUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BankStatementUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BankStatementUpsertOne is the builder for "upsert"-ing
+	//  one BankStatement node.
+	BankStatementUpsertOne struct {
+		create *BankStatementCreate
+	}
+
+	// BankStatementUpsert is the "OnConflict" setter.
+	BankStatementUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *BankStatementUpsert) SetTenantID(v uuid.UUID) *BankStatementUpsert {
+	u.Set(bankstatement.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *BankStatementUpsert) UpdateTenantID() *BankStatementUpsert {
+	u.SetExcluded(bankstatement.FieldTenantID)
+	return u
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (u *BankStatementUpsert) SetBankAccountID(v uuid.UUID) *BankStatementUpsert {
+	u.Set(bankstatement.FieldBankAccountID, v)
+	return u
+}
+
+// UpdateBankAccountID sets the "bank_account_id" field to the value that was provided on create.
+func (u *BankStatementUpsert) UpdateBankAccountID() *BankStatementUpsert {
+	u.SetExcluded(bankstatement.FieldBankAccountID)
+	return u
+}
+
+// SetFormat sets the "format" field.
+func (u *BankStatementUpsert) SetFormat(v string) *BankStatementUpsert {
+	u.Set(bankstatement.FieldFormat, v)
+	return u
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *BankStatementUpsert) UpdateFormat() *BankStatementUpsert {
+	u.SetExcluded(bankstatement.FieldFormat)
+	return u
+}
+
+// SetProfile sets the "profile" field.
+func (u *BankStatementUpsert) SetProfile(v string) *BankStatementUpsert {
+	u.Set(bankstatement.FieldProfile, v)
+	return u
+}
+
+// UpdateProfile sets the "profile" field to the value that was provided on create.
+func (u *BankStatementUpsert) UpdateProfile() *BankStatementUpsert {
+	u.SetExcluded(bankstatement.FieldProfile)
+	return u
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (u *BankStatementUpsert) ClearProfile() *BankStatementUpsert {
+	u.SetNull(bankstatement.FieldProfile)
+	return u
+}
+
+// SetFileName sets the "file_name" field.
+func (u *BankStatementUpsert) SetFileName(v string) *BankStatementUpsert {
+	u.Set(bankstatement.FieldFileName, v)
+	return u
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *BankStatementUpsert) UpdateFileName() *BankStatementUpsert {
+	u.SetExcluded(bankstatement.FieldFileName)
+	return u
+}
+
+// ClearFileName clears the value of the "file_name" field.
+func (u *BankStatementUpsert) ClearFileName() *BankStatementUpsert {
+	u.SetNull(bankstatement.FieldFileName)
+	return u
+}
+
+// SetFileHash sets the "file_hash" field.
+func (u *BankStatementUpsert) SetFileHash(v string) *BankStatementUpsert {
+	u.Set(bankstatement.FieldFileHash, v)
+	return u
+}
+
+// UpdateFileHash sets the "file_hash" field to the value that was provided on create.
+func (u *BankStatementUpsert) UpdateFileHash() *BankStatementUpsert {
+	u.SetExcluded(bankstatement.FieldFileHash)
+	return u
+}
+
+// SetStatementReference sets the "statement_reference" field.
+func (u *BankStatementUpsert) SetStatementReference(v string) *BankStatementUpsert {
+	u.Set(bankstatement.FieldStatementReference, v)
+	return u
+}
+
+// UpdateStatementReference sets the "statement_reference" field to the value that was provided on create.
+func (u *BankStatementUpsert) UpdateStatementReference() *BankStatementUpsert {
+	u.SetExcluded(bankstatement.FieldStatementReference)
+	return u
+}
+
+// ClearStatementReference clears the value of the "statement_reference" field.
+func (u *BankStatementUpsert) ClearStatementReference() *BankStatementUpsert {
+	u.SetNull(bankstatement.FieldStatementReference)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *BankStatementUpsert) SetCurrency(v string) *BankStatementUpsert {
+	u.Set(bankstatement.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *BankStatementUpsert) UpdateCurrency() *BankStatementUpsert {
+	u.SetExcluded(bankstatement.FieldCurrency)
+	return u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *BankStatementUpsert) SetPeriodStart(v time.Time) *BankStatementUpsert {
+	u.Set(bankstatement.FieldPeriodStart, v)
+	return u
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *BankStatementUpsert) UpdatePeriodStart() *BankStatementUpsert {
+	u.SetExcluded(bankstatement.FieldPeriodStart)
+	return u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *BankStatementUpsert) SetPeriodEnd(v time.Time) *BankStatementUpsert {
+	u.Set(bankstatement.FieldPeriodEnd, v)
+	return u
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *BankStatementUpsert) UpdatePeriodEnd() *BankStatementUpsert {
+	u.SetExcluded(bankstatement.FieldPeriodEnd)
+	return u
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (u *BankStatementUpsert) SetOpeningBalance(v decimal.Decimal) *BankStatementUpsert {
+	u.Set(bankstatement.FieldOpeningBalance, v)
+	return u
+}
+
+// UpdateOpeningBalance sets the "opening_balance" field to the value that was provided on create.
+func (u *BankStatementUpsert) UpdateOpeningBalance() *BankStatementUpsert {
+	u.SetExcluded(bankstatement.FieldOpeningBalance)
+	return u
+}
+
+// AddOpeningBalance adds v to the "opening_balance" field.
+func (u *BankStatementUpsert) AddOpeningBalance(v decimal.Decimal) *BankStatementUpsert {
+	u.Add(bankstatement.FieldOpeningBalance, v)
+	return u
+}
+
+// ClearOpeningBalance clears the value of the "opening_balance" field.
+func (u *BankStatementUpsert) ClearOpeningBalance() *BankStatementUpsert {
+	u.SetNull(bankstatement.FieldOpeningBalance)
+	return u
+}
+
+// SetClosingBalance sets the "closing_balance" field.
+func (u *BankStatementUpsert) SetClosingBalance(v decimal.Decimal) *BankStatementUpsert {
+	u.Set(bankstatement.FieldClosingBalance, v)
+	return u
+}
+
+// UpdateClosingBalance sets the "closing_balance" field to the value that was provided on create.
+func (u *BankStatementUpsert) UpdateClosingBalance() *BankStatementUpsert {
+	u.SetExcluded(bankstatement.FieldClosingBalance)
+	return u
+}
+
+// AddClosingBalance adds v to the "closing_balance" field.
+func (u *BankStatementUpsert) AddClosingBalance(v decimal.Decimal) *BankStatementUpsert {
+	u.Add(bankstatement.FieldClosingBalance, v)
+	return u
+}
+
+// ClearClosingBalance clears the value of the "closing_balance" field.
+func (u *BankStatementUpsert) ClearClosingBalance() *BankStatementUpsert {
+	u.SetNull(bankstatement.FieldClosingBalance)
+	return u
+}
+
+// SetContinuity sets the "continuity" field.
+func (u *BankStatementUpsert) SetContinuity(v string) *BankStatementUpsert {
+	u.Set(bankstatement.FieldContinuity, v)
+	return u
+}
+
+// UpdateContinuity sets the "continuity" field to the value that was provided on create.
+func (u *BankStatementUpsert) UpdateContinuity() *BankStatementUpsert {
+	u.SetExcluded(bankstatement.FieldContinuity)
+	return u
+}
+
+// SetExpectedOpeningBalance sets the "expected_opening_balance" field.
+func (u *BankStatementUpsert) SetExpectedOpeningBalance(v decimal.Decimal) *BankStatementUpsert {
+	u.Set(bankstatement.FieldExpectedOpeningBalance, v)
+	return u
+}
+
+// UpdateExpectedOpeningBalance sets the "expected_opening_balance" field to the value that was provided on create.
+func (u *BankStatementUpsert) UpdateExpectedOpeningBalance() *BankStatementUpsert {
+	u.SetExcluded(bankstatement.FieldExpectedOpeningBalance)
+	return u
+}
+
+// AddExpectedOpeningBalance adds v to the "expected_opening_balance" field.
+func (u *BankStatementUpsert) AddExpectedOpeningBalance(v decimal.Decimal) *BankStatementUpsert {
+	u.Add(bankstatement.FieldExpectedOpeningBalance, v)
+	return u
+}
+
+// ClearExpectedOpeningBalance clears the value of the "expected_opening_balance" field.
+func (u *BankStatementUpsert) ClearExpectedOpeningBalance() *BankStatementUpsert {
+	u.SetNull(bankstatement.FieldExpectedOpeningBalance)
+	return u
+}
+
+// SetTransactionCount sets the "transaction_count" field.
+func (u *BankStatementUpsert) SetTransactionCount(v int) *BankStatementUpsert {
+	u.Set(bankstatement.FieldTransactionCount, v)
+	return u
+}
+
+// UpdateTransactionCount sets the "transaction_count" field to the value that was provided on create.
+func (u *BankStatementUpsert) UpdateTransactionCount() *BankStatementUpsert {
+	u.SetExcluded(bankstatement.FieldTransactionCount)
+	return u
+}
+
+// AddTransactionCount adds v to the "transaction_count" field.
+func (u *BankStatementUpsert) AddTransactionCount(v int) *BankStatementUpsert {
+	u.Add(bankstatement.FieldTransactionCount, v)
+	return u
+}
+
+// SetDuplicateCount sets the "duplicate_count" field.
+func (u *BankStatementUpsert) SetDuplicateCount(v int) *BankStatementUpsert {
+	u.Set(bankstatement.FieldDuplicateCount, v)
+	return u
+}
+
+// UpdateDuplicateCount sets the "duplicate_count" field to the value that was provided on create.
+func (u *BankStatementUpsert) UpdateDuplicateCount() *BankStatementUpsert {
+	u.SetExcluded(bankstatement.FieldDuplicateCount)
+	return u
+}
+
+// AddDuplicateCount adds v to the "duplicate_count" field.
+func (u *BankStatementUpsert) AddDuplicateCount(v int) *BankStatementUpsert {
+	u.Add(bankstatement.FieldDuplicateCount, v)
+	return u
+}
+
+// SetLinkedCount sets the "linked_count" field.
+func (u *BankStatementUpsert) SetLinkedCount(v int) *BankStatementUpsert {
+	u.Set(bankstatement.FieldLinkedCount, v)
+	return u
+}
+
+// UpdateLinkedCount sets the "linked_count" field to the value that was provided on create.
+func (u *BankStatementUpsert) UpdateLinkedCount() *BankStatementUpsert {
+	u.SetExcluded(bankstatement.FieldLinkedCount)
+	return u
+}
+
+// AddLinkedCount adds v to the "linked_count" field.
+func (u *BankStatementUpsert) AddLinkedCount(v int) *BankStatementUpsert {
+	u.Add(bankstatement.FieldLinkedCount, v)
+	return u
+}
+
+// SetImportedBy sets the "imported_by" field.
+func (u *BankStatementUpsert) SetImportedBy(v uuid.UUID) *BankStatementUpsert {
+	u.Set(bankstatement.FieldImportedBy, v)
+	return u
+}
+
+// UpdateImportedBy sets the "imported_by" field to the value that was provided on create.
+func (u *BankStatementUpsert) UpdateImportedBy() *BankStatementUpsert {
+	u.SetExcluded(bankstatement.FieldImportedBy)
+	return u
+}
+
+// ClearImportedBy clears the value of the "imported_by" field.
+func (u *BankStatementUpsert) ClearImportedBy() *BankStatementUpsert {
+	u.SetNull(bankstatement.FieldImportedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.BankStatement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(bankstatement.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BankStatementUpsertOne) UpdateNewValues() *BankStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(bankstatement.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(bankstatement.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BankStatement.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BankStatementUpsertOne) Ignore() *BankStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BankStatementUpsertOne) DoNothing() *BankStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BankStatementCreate.OnConflict
+// documentation for more info.
+func (u *BankStatementUpsertOne) Update(set func(*BankStatementUpsert)) *BankStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BankStatementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *BankStatementUpsertOne) SetTenantID(v uuid.UUID) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *BankStatementUpsertOne) UpdateTenantID() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (u *BankStatementUpsertOne) SetBankAccountID(v uuid.UUID) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetBankAccountID(v)
+	})
+}
+
+// UpdateBankAccountID sets the "bank_account_id" field to the value that was provided on create.
+func (u *BankStatementUpsertOne) UpdateBankAccountID() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateBankAccountID()
+	})
+}
+
+// SetFormat sets the "format" field.
+func (u *BankStatementUpsertOne) SetFormat(v string) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetFormat(v)
+	})
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *BankStatementUpsertOne) UpdateFormat() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateFormat()
+	})
+}
+
+// SetProfile sets the "profile" field.
+func (u *BankStatementUpsertOne) SetProfile(v string) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetProfile(v)
+	})
+}
+
+// UpdateProfile sets the "profile" field to the value that was provided on create.
+func (u *BankStatementUpsertOne) UpdateProfile() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateProfile()
+	})
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (u *BankStatementUpsertOne) ClearProfile() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.ClearProfile()
+	})
+}
+
+// SetFileName sets the "file_name" field.
+func (u *BankStatementUpsertOne) SetFileName(v string) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetFileName(v)
+	})
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *BankStatementUpsertOne) UpdateFileName() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateFileName()
+	})
+}
+
+// ClearFileName clears the value of the "file_name" field.
+func (u *BankStatementUpsertOne) ClearFileName() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.ClearFileName()
+	})
+}
+
+// SetFileHash sets the "file_hash" field.
+func (u *BankStatementUpsertOne) SetFileHash(v string) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetFileHash(v)
+	})
+}
+
+// UpdateFileHash sets the "file_hash" field to the value that was provided on create.
+func (u *BankStatementUpsertOne) UpdateFileHash() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateFileHash()
+	})
+}
+
+// SetStatementReference sets the "statement_reference" field.
+func (u *BankStatementUpsertOne) SetStatementReference(v string) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetStatementReference(v)
+	})
+}
+
+// UpdateStatementReference sets the "statement_reference" field to the value that was provided on create.
+func (u *BankStatementUpsertOne) UpdateStatementReference() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateStatementReference()
+	})
+}
+
+// ClearStatementReference clears the value of the "statement_reference" field.
+func (u *BankStatementUpsertOne) ClearStatementReference() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.ClearStatementReference()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *BankStatementUpsertOne) SetCurrency(v string) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *BankStatementUpsertOne) UpdateCurrency() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *BankStatementUpsertOne) SetPeriodStart(v time.Time) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *BankStatementUpsertOne) UpdatePeriodStart() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *BankStatementUpsertOne) SetPeriodEnd(v time.Time) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetPeriodEnd(v)
+	})
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *BankStatementUpsertOne) UpdatePeriodEnd() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdatePeriodEnd()
+	})
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (u *BankStatementUpsertOne) SetOpeningBalance(v decimal.Decimal) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetOpeningBalance(v)
+	})
+}
+
+// AddOpeningBalance adds v to the "opening_balance" field.
+func (u *BankStatementUpsertOne) AddOpeningBalance(v decimal.Decimal) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.AddOpeningBalance(v)
+	})
+}
+
+// UpdateOpeningBalance sets the "opening_balance" field to the value that was provided on create.
+func (u *BankStatementUpsertOne) UpdateOpeningBalance() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateOpeningBalance()
+	})
+}
+
+// ClearOpeningBalance clears the value of the "opening_balance" field.
+func (u *BankStatementUpsertOne) ClearOpeningBalance() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.ClearOpeningBalance()
+	})
+}
+
+// SetClosingBalance sets the "closing_balance" field.
+func (u *BankStatementUpsertOne) SetClosingBalance(v decimal.Decimal) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetClosingBalance(v)
+	})
+}
+
+// AddClosingBalance adds v to the "closing_balance" field.
+func (u *BankStatementUpsertOne) AddClosingBalance(v decimal.Decimal) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.AddClosingBalance(v)
+	})
+}
+
+// UpdateClosingBalance sets the "closing_balance" field to the value that was provided on create.
+func (u *BankStatementUpsertOne) UpdateClosingBalance() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateClosingBalance()
+	})
+}
+
+// ClearClosingBalance clears the value of the "closing_balance" field.
+func (u *BankStatementUpsertOne) ClearClosingBalance() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.ClearClosingBalance()
+	})
+}
+
+// SetContinuity sets the "continuity" field.
+func (u *BankStatementUpsertOne) SetContinuity(v string) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetContinuity(v)
+	})
+}
+
+// UpdateContinuity sets the "continuity" field to the value that was provided on create.
+func (u *BankStatementUpsertOne) UpdateContinuity() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateContinuity()
+	})
+}
+
+// SetExpectedOpeningBalance sets the "expected_opening_balance" field.
+func (u *BankStatementUpsertOne) SetExpectedOpeningBalance(v decimal.Decimal) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetExpectedOpeningBalance(v)
+	})
+}
+
+// AddExpectedOpeningBalance adds v to the "expected_opening_balance" field.
+func (u *BankStatementUpsertOne) AddExpectedOpeningBalance(v decimal.Decimal) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.AddExpectedOpeningBalance(v)
+	})
+}
+
+// UpdateExpectedOpeningBalance sets the "expected_opening_balance" field to the value that was provided on create.
+func (u *BankStatementUpsertOne) UpdateExpectedOpeningBalance() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateExpectedOpeningBalance()
+	})
+}
+
+// ClearExpectedOpeningBalance clears the value of the "expected_opening_balance" field.
+func (u *BankStatementUpsertOne) ClearExpectedOpeningBalance() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.ClearExpectedOpeningBalance()
+	})
+}
+
+// SetTransactionCount sets the "transaction_count" field.
+func (u *BankStatementUpsertOne) SetTransactionCount(v int) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetTransactionCount(v)
+	})
+}
+
+// AddTransactionCount adds v to the "transaction_count" field.
+func (u *BankStatementUpsertOne) AddTransactionCount(v int) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.AddTransactionCount(v)
+	})
+}
+
+// UpdateTransactionCount sets the "transaction_count" field to the value that was provided on create.
+func (u *BankStatementUpsertOne) UpdateTransactionCount() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateTransactionCount()
+	})
+}
+
+// SetDuplicateCount sets the "duplicate_count" field.
+func (u *BankStatementUpsertOne) SetDuplicateCount(v int) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetDuplicateCount(v)
+	})
+}
+
+// AddDuplicateCount adds v to the "duplicate_count" field.
+func (u *BankStatementUpsertOne) AddDuplicateCount(v int) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.AddDuplicateCount(v)
+	})
+}
+
+// UpdateDuplicateCount sets the "duplicate_count" field to the value that was provided on create.
+func (u *BankStatementUpsertOne) UpdateDuplicateCount() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateDuplicateCount()
+	})
+}
+
+// SetLinkedCount sets the "linked_count" field.
+func (u *BankStatementUpsertOne) SetLinkedCount(v int) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetLinkedCount(v)
+	})
+}
+
+// AddLinkedCount adds v to the "linked_count" field.
+func (u *BankStatementUpsertOne) AddLinkedCount(v int) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.AddLinkedCount(v)
+	})
+}
+
+// UpdateLinkedCount sets the "linked_count" field to the value that was provided on create.
+func (u *BankStatementUpsertOne) UpdateLinkedCount() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateLinkedCount()
+	})
+}
+
+// SetImportedBy sets the "imported_by" field.
+func (u *BankStatementUpsertOne) SetImportedBy(v uuid.UUID) *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetImportedBy(v)
+	})
+}
+
+// UpdateImportedBy sets the "imported_by" field to the value that was provided on create.
+func (u *BankStatementUpsertOne) UpdateImportedBy() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateImportedBy()
+	})
+}
+
+// ClearImportedBy clears the value of the "imported_by" field.
+func (u *BankStatementUpsertOne) ClearImportedBy() *BankStatementUpsertOne {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.ClearImportedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *BankStatementUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BankStatementCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BankStatementUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BankStatementUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BankStatementUpsertOne.ID is not supported by MySQL driver. Use BankStatementUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BankStatementUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BankStatementCreateBulk is the builder for creating many BankStatement entities in bulk.
+type BankStatementCreateBulk struct {
+	config
+	err      error
+	builders []*BankStatementCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BankStatement entities in the database.
+func (_c *BankStatementCreateBulk) Save(ctx context.Context) ([]*BankStatement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BankStatement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BankStatementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BankStatementCreateBulk) SaveX(ctx context.Context) []*BankStatement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BankStatementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BankStatementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BankStatement.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BankStatementUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BankStatementCreateBulk) OnConflict(opts ...sql.ConflictOption) *BankStatementUpsertBulk {
+	_c.conflict = opts
+	return &BankStatementUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BankStatement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BankStatementCreateBulk) OnConflictColumns(columns ...string) *BankStatementUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BankStatementUpsertBulk{
+		create: _c,
+	}
+}
+
+// BankStatementUpsertBulk is the builder for "upsert"-ing
+// a bulk of BankStatement nodes.
+type BankStatementUpsertBulk struct {
+	create *BankStatementCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BankStatement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(bankstatement.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BankStatementUpsertBulk) UpdateNewValues() *BankStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(bankstatement.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(bankstatement.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BankStatement.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BankStatementUpsertBulk) Ignore() *BankStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BankStatementUpsertBulk) DoNothing() *BankStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BankStatementCreateBulk.OnConflict
+// documentation for more info.
+func (u *BankStatementUpsertBulk) Update(set func(*BankStatementUpsert)) *BankStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BankStatementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *BankStatementUpsertBulk) SetTenantID(v uuid.UUID) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *BankStatementUpsertBulk) UpdateTenantID() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (u *BankStatementUpsertBulk) SetBankAccountID(v uuid.UUID) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetBankAccountID(v)
+	})
+}
+
+// UpdateBankAccountID sets the "bank_account_id" field to the value that was provided on create.
+func (u *BankStatementUpsertBulk) UpdateBankAccountID() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateBankAccountID()
+	})
+}
+
+// SetFormat sets the "format" field.
+func (u *BankStatementUpsertBulk) SetFormat(v string) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetFormat(v)
+	})
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *BankStatementUpsertBulk) UpdateFormat() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateFormat()
+	})
+}
+
+// SetProfile sets the "profile" field.
+func (u *BankStatementUpsertBulk) SetProfile(v string) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetProfile(v)
+	})
+}
+
+// UpdateProfile sets the "profile" field to the value that was provided on create.
+func (u *BankStatementUpsertBulk) UpdateProfile() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateProfile()
+	})
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (u *BankStatementUpsertBulk) ClearProfile() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.ClearProfile()
+	})
+}
+
+// SetFileName sets the "file_name" field.
+func (u *BankStatementUpsertBulk) SetFileName(v string) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetFileName(v)
+	})
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *BankStatementUpsertBulk) UpdateFileName() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateFileName()
+	})
+}
+
+// ClearFileName clears the value of the "file_name" field.
+func (u *BankStatementUpsertBulk) ClearFileName() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.ClearFileName()
+	})
+}
+
+// SetFileHash sets the "file_hash" field.
+func (u *BankStatementUpsertBulk) SetFileHash(v string) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetFileHash(v)
+	})
+}
+
+// UpdateFileHash sets the "file_hash" field to the value that was provided on create.
+func (u *BankStatementUpsertBulk) UpdateFileHash() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateFileHash()
+	})
+}
+
+// SetStatementReference sets the "statement_reference" field.
+func (u *BankStatementUpsertBulk) SetStatementReference(v string) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetStatementReference(v)
+	})
+}
+
+// UpdateStatementReference sets the "statement_reference" field to the value that was provided on create.
+func (u *BankStatementUpsertBulk) UpdateStatementReference() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateStatementReference()
+	})
+}
+
+// ClearStatementReference clears the value of the "statement_reference" field.
+func (u *BankStatementUpsertBulk) ClearStatementReference() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.ClearStatementReference()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *BankStatementUpsertBulk) SetCurrency(v string) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *BankStatementUpsertBulk) UpdateCurrency() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *BankStatementUpsertBulk) SetPeriodStart(v time.Time) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *BankStatementUpsertBulk) UpdatePeriodStart() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *BankStatementUpsertBulk) SetPeriodEnd(v time.Time) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetPeriodEnd(v)
+	})
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *BankStatementUpsertBulk) UpdatePeriodEnd() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdatePeriodEnd()
+	})
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (u *BankStatementUpsertBulk) SetOpeningBalance(v decimal.Decimal) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetOpeningBalance(v)
+	})
+}
+
+// AddOpeningBalance adds v to the "opening_balance" field.
+func (u *BankStatementUpsertBulk) AddOpeningBalance(v decimal.Decimal) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.AddOpeningBalance(v)
+	})
+}
+
+// UpdateOpeningBalance sets the "opening_balance" field to the value that was provided on create.
+func (u *BankStatementUpsertBulk) UpdateOpeningBalance() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateOpeningBalance()
+	})
+}
+
+// ClearOpeningBalance clears the value of the "opening_balance" field.
+func (u *BankStatementUpsertBulk) ClearOpeningBalance() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.ClearOpeningBalance()
+	})
+}
+
+// SetClosingBalance sets the "closing_balance" field.
+func (u *BankStatementUpsertBulk) SetClosingBalance(v decimal.Decimal) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetClosingBalance(v)
+	})
+}
+
+// AddClosingBalance adds v to the "closing_balance" field.
+func (u *BankStatementUpsertBulk) AddClosingBalance(v decimal.Decimal) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.AddClosingBalance(v)
+	})
+}
+
+// UpdateClosingBalance sets the "closing_balance" field to the value that was provided on create.
+func (u *BankStatementUpsertBulk) UpdateClosingBalance() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateClosingBalance()
+	})
+}
+
+// ClearClosingBalance clears the value of the "closing_balance" field.
+func (u *BankStatementUpsertBulk) ClearClosingBalance() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.ClearClosingBalance()
+	})
+}
+
+// SetContinuity sets the "continuity" field.
+func (u *BankStatementUpsertBulk) SetContinuity(v string) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetContinuity(v)
+	})
+}
+
+// UpdateContinuity sets the "continuity" field to the value that was provided on create.
+func (u *BankStatementUpsertBulk) UpdateContinuity() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateContinuity()
+	})
+}
+
+// SetExpectedOpeningBalance sets the "expected_opening_balance" field.
+func (u *BankStatementUpsertBulk) SetExpectedOpeningBalance(v decimal.Decimal) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetExpectedOpeningBalance(v)
+	})
+}
+
+// AddExpectedOpeningBalance adds v to the "expected_opening_balance" field.
+func (u *BankStatementUpsertBulk) AddExpectedOpeningBalance(v decimal.Decimal) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.AddExpectedOpeningBalance(v)
+	})
+}
+
+// UpdateExpectedOpeningBalance sets the "expected_opening_balance" field to the value that was provided on create.
+func (u *BankStatementUpsertBulk) UpdateExpectedOpeningBalance() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateExpectedOpeningBalance()
+	})
+}
+
+// ClearExpectedOpeningBalance clears the value of the "expected_opening_balance" field.
+func (u *BankStatementUpsertBulk) ClearExpectedOpeningBalance() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.ClearExpectedOpeningBalance()
+	})
+}
+
+// SetTransactionCount sets the "transaction_count" field.
+func (u *BankStatementUpsertBulk) SetTransactionCount(v int) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetTransactionCount(v)
+	})
+}
+
+// AddTransactionCount adds v to the "transaction_count" field.
+func (u *BankStatementUpsertBulk) AddTransactionCount(v int) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.AddTransactionCount(v)
+	})
+}
+
+// UpdateTransactionCount sets the "transaction_count" field to the value that was provided on create.
+func (u *BankStatementUpsertBulk) UpdateTransactionCount() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateTransactionCount()
+	})
+}
+
+// SetDuplicateCount sets the "duplicate_count" field.
+func (u *BankStatementUpsertBulk) SetDuplicateCount(v int) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetDuplicateCount(v)
+	})
+}
+
+// AddDuplicateCount adds v to the "duplicate_count" field.
+func (u *BankStatementUpsertBulk) AddDuplicateCount(v int) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.AddDuplicateCount(v)
+	})
+}
+
+// UpdateDuplicateCount sets the "duplicate_count" field to the value that was provided on create.
+func (u *BankStatementUpsertBulk) UpdateDuplicateCount() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateDuplicateCount()
+	})
+}
+
+// SetLinkedCount sets the "linked_count" field.
+func (u *BankStatementUpsertBulk) SetLinkedCount(v int) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetLinkedCount(v)
+	})
+}
+
+// AddLinkedCount adds v to the "linked_count" field.
+func (u *BankStatementUpsertBulk) AddLinkedCount(v int) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.AddLinkedCount(v)
+	})
+}
+
+// UpdateLinkedCount sets the "linked_count" field to the value that was provided on create.
+func (u *BankStatementUpsertBulk) UpdateLinkedCount() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateLinkedCount()
+	})
+}
+
+// SetImportedBy sets the "imported_by" field.
+func (u *BankStatementUpsertBulk) SetImportedBy(v uuid.UUID) *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.SetImportedBy(v)
+	})
+}
+
+// UpdateImportedBy sets the "imported_by" field to the value that was provided on create.
+func (u *BankStatementUpsertBulk) UpdateImportedBy() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.UpdateImportedBy()
+	})
+}
+
+// ClearImportedBy clears the value of the "imported_by" field.
+func (u *BankStatementUpsertBulk) ClearImportedBy() *BankStatementUpsertBulk {
+	return u.Update(func(s *BankStatementUpsert) {
+		s.ClearImportedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *BankStatementUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BankStatementCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BankStatementCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BankStatementUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatement"
+	"github.com/bengobox/treasury-api/internal/ent/banktransaction"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BankStatementUpdate is the builder for updating BankStatement entities.
+type BankStatementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BankStatementMutation
+}
+
+// Where appends a list predicates to the BankStatementUpdate builder.
+func (_u *BankStatementUpdate) Where(ps ...predicate.BankStatement) *BankStatementUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *BankStatementUpdate) SetTenantID(v uuid.UUID) *BankStatementUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableTenantID(v *uuid.UUID) *BankStatementUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (_u *BankStatementUpdate) SetBankAccountID(v uuid.UUID) *BankStatementUpdate {
+	_u.mutation.SetBankAccountID(v)
+	return _u
+}
+
+// SetNillableBankAccountID sets the "bank_account_id" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableBankAccountID(v *uuid.UUID) *BankStatementUpdate {
+	if v != nil {
+		_u.SetBankAccountID(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *BankStatementUpdate) SetFormat(v string) *BankStatementUpdate {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableFormat(v *string) *BankStatementUpdate {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetProfile sets the "profile" field.
+func (_u *BankStatementUpdate) SetProfile(v string) *BankStatementUpdate {
+	_u.mutation.SetProfile(v)
+	return _u
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableProfile(v *string) *BankStatementUpdate {
+	if v != nil {
+		_u.SetProfile(*v)
+	}
+	return _u
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (_u *BankStatementUpdate) ClearProfile() *BankStatementUpdate {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// SetFileName sets the "file_name" field.
+func (_u *BankStatementUpdate) SetFileName(v string) *BankStatementUpdate {
+	_u.mutation.SetFileName(v)
+	return _u
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableFileName(v *string) *BankStatementUpdate {
+	if v != nil {
+		_u.SetFileName(*v)
+	}
+	return _u
+}
+
+// ClearFileName clears the value of the "file_name" field.
+func (_u *BankStatementUpdate) ClearFileName() *BankStatementUpdate {
+	_u.mutation.ClearFileName()
+	return _u
+}
+
+// SetFileHash sets the "file_hash" field.
+func (_u *BankStatementUpdate) SetFileHash(v string) *BankStatementUpdate {
+	_u.mutation.SetFileHash(v)
+	return _u
+}
+
+// SetNillableFileHash sets the "file_hash" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableFileHash(v *string) *BankStatementUpdate {
+	if v != nil {
+		_u.SetFileHash(*v)
+	}
+	return _u
+}
+
+// SetStatementReference sets the "statement_reference" field.
+func (_u *BankStatementUpdate) SetStatementReference(v string) *BankStatementUpdate {
+	_u.mutation.SetStatementReference(v)
+	return _u
+}
+
+// SetNillableStatementReference sets the "statement_reference" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableStatementReference(v *string) *BankStatementUpdate {
+	if v != nil {
+		_u.SetStatementReference(*v)
+	}
+	return _u
+}
+
+// ClearStatementReference clears the value of the "statement_reference" field.
+func (_u *BankStatementUpdate) ClearStatementReference() *BankStatementUpdate {
+	_u.mutation.ClearStatementReference()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *BankStatementUpdate) SetCurrency(v string) *BankStatementUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableCurrency(v *string) *BankStatementUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *BankStatementUpdate) SetPeriodStart(v time.Time) *BankStatementUpdate {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillablePeriodStart(v *time.Time) *BankStatementUpdate {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_u *BankStatementUpdate) SetPeriodEnd(v time.Time) *BankStatementUpdate {
+	_u.mutation.SetPeriodEnd(v)
+	return _u
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillablePeriodEnd(v *time.Time) *BankStatementUpdate {
+	if v != nil {
+		_u.SetPeriodEnd(*v)
+	}
+	return _u
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (_u *BankStatementUpdate) SetOpeningBalance(v decimal.Decimal) *BankStatementUpdate {
+	_u.mutation.ResetOpeningBalance()
+	_u.mutation.SetOpeningBalance(v)
+	return _u
+}
+
+// SetNillableOpeningBalance sets the "opening_balance" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableOpeningBalance(v *decimal.Decimal) *BankStatementUpdate {
+	if v != nil {
+		_u.SetOpeningBalance(*v)
+	}
+	return _u
+}
+
+// AddOpeningBalance adds value to the "opening_balance" field.
+func (_u *BankStatementUpdate) AddOpeningBalance(v decimal.Decimal) *BankStatementUpdate {
+	_u.mutation.AddOpeningBalance(v)
+	return _u
+}
+
+// ClearOpeningBalance clears the value of the "opening_balance" field.
+func (_u *BankStatementUpdate) ClearOpeningBalance() *BankStatementUpdate {
+	_u.mutation.ClearOpeningBalance()
+	return _u
+}
+
+// SetClosingBalance sets the "closing_balance" field.
+func (_u *BankStatementUpdate) SetClosingBalance(v decimal.Decimal) *BankStatementUpdate {
+	_u.mutation.ResetClosingBalance()
+	_u.mutation.SetClosingBalance(v)
+	return _u
+}
+
+// SetNillableClosingBalance sets the "closing_balance" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableClosingBalance(v *decimal.Decimal) *BankStatementUpdate {
+	if v != nil {
+		_u.SetClosingBalance(*v)
+	}
+	return _u
+}
+
+// AddClosingBalance adds value to the "closing_balance" field.
+func (_u *BankStatementUpdate) AddClosingBalance(v decimal.Decimal) *BankStatementUpdate {
+	_u.mutation.AddClosingBalance(v)
+	return _u
+}
+
+// ClearClosingBalance clears the value of the "closing_balance" field.
+func (_u *BankStatementUpdate) ClearClosingBalance() *BankStatementUpdate {
+	_u.mutation.ClearClosingBalance()
+	return _u
+}
+
+// SetContinuity sets the "continuity" field.
+func (_u *BankStatementUpdate) SetContinuity(v string) *BankStatementUpdate {
+	_u.mutation.SetContinuity(v)
+	return _u
+}
+
+// SetNillableContinuity sets the "continuity" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableContinuity(v *string) *BankStatementUpdate {
+	if v != nil {
+		_u.SetContinuity(*v)
+	}
+	return _u
+}
+
+// SetExpectedOpeningBalance sets the "expected_opening_balance" field.
+func (_u *BankStatementUpdate) SetExpectedOpeningBalance(v decimal.Decimal) *BankStatementUpdate {
+	_u.mutation.ResetExpectedOpeningBalance()
+	_u.mutation.SetExpectedOpeningBalance(v)
+	return _u
+}
+
+// SetNillableExpectedOpeningBalance sets the "expected_opening_balance" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableExpectedOpeningBalance(v *decimal.Decimal) *BankStatementUpdate {
+	if v != nil {
+		_u.SetExpectedOpeningBalance(*v)
+	}
+	return _u
+}
+
+// AddExpectedOpeningBalance adds value to the "expected_opening_balance" field.
+func (_u *BankStatementUpdate) AddExpectedOpeningBalance(v decimal.Decimal) *BankStatementUpdate {
+	_u.mutation.AddExpectedOpeningBalance(v)
+	return _u
+}
+
+// ClearExpectedOpeningBalance clears the value of the "expected_opening_balance" field.
+func (_u *BankStatementUpdate) ClearExpectedOpeningBalance() *BankStatementUpdate {
+	_u.mutation.ClearExpectedOpeningBalance()
+	return _u
+}
+
+// SetTransactionCount sets the "transaction_count" field.
+func (_u *BankStatementUpdate) SetTransactionCount(v int) *BankStatementUpdate {
+	_u.mutation.ResetTransactionCount()
+	_u.mutation.SetTransactionCount(v)
+	return _u
+}
+
+// SetNillableTransactionCount sets the "transaction_count" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableTransactionCount(v *int) *BankStatementUpdate {
+	if v != nil {
+		_u.SetTransactionCount(*v)
+	}
+	return _u
+}
+
+// AddTransactionCount adds value to the "transaction_count" field.
+func (_u *BankStatementUpdate) AddTransactionCount(v int) *BankStatementUpdate {
+	_u.mutation.AddTransactionCount(v)
+	return _u
+}
+
+// SetDuplicateCount sets the "duplicate_count" field.
+func (_u *BankStatementUpdate) SetDuplicateCount(v int) *BankStatementUpdate {
+	_u.mutation.ResetDuplicateCount()
+	_u.mutation.SetDuplicateCount(v)
+	return _u
+}
+
+// SetNillableDuplicateCount sets the "duplicate_count" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableDuplicateCount(v *int) *BankStatementUpdate {
+	if v != nil {
+		_u.SetDuplicateCount(*v)
+	}
+	return _u
+}
+
+// AddDuplicateCount adds value to the "duplicate_count" field.
+func (_u *BankStatementUpdate) AddDuplicateCount(v int) *BankStatementUpdate {
+	_u.mutation.AddDuplicateCount(v)
+	return _u
+}
+
+// SetLinkedCount sets the "linked_count" field.
+func (_u *BankStatementUpdate) SetLinkedCount(v int) *BankStatementUpdate {
+	_u.mutation.ResetLinkedCount()
+	_u.mutation.SetLinkedCount(v)
+	return _u
+}
+
+// SetNillableLinkedCount sets the "linked_count" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableLinkedCount(v *int) *BankStatementUpdate {
+	if v != nil {
+		_u.SetLinkedCount(*v)
+	}
+	return _u
+}
+
+// AddLinkedCount adds value to the "linked_count" field.
+func (_u *BankStatementUpdate) AddLinkedCount(v int) *BankStatementUpdate {
+	_u.mutation.AddLinkedCount(v)
+	return _u
+}
+
+// SetImportedBy sets the "imported_by" field.
+func (_u *BankStatementUpdate) SetImportedBy(v uuid.UUID) *BankStatementUpdate {
+	_u.mutation.SetImportedBy(v)
+	return _u
+}
+
+// SetNillableImportedBy sets the "imported_by" field if the given value is not nil.
+func (_u *BankStatementUpdate) SetNillableImportedBy(v *uuid.UUID) *BankStatementUpdate {
+	if v != nil {
+		_u.SetImportedBy(*v)
+	}
+	return _u
+}
+
+// ClearImportedBy clears the value of the "imported_by" field.
+func (_u *BankStatementUpdate) ClearImportedBy() *BankStatementUpdate {
+	_u.mutation.ClearImportedBy()
+	return _u
+}
+
+// AddTransactionIDs adds the "transactions" edge to the BankTransaction entity by IDs.
+func (_u *BankStatementUpdate) AddTransactionIDs(ids ...uuid.UUID) *BankStatementUpdate {
+	_u.mutation.AddTransactionIDs(ids...)
+	return _u
+}
+
+// AddTransactions adds the "transactions" edges to the BankTransaction entity.
+func (_u *BankStatementUpdate) AddTransactions(v ...*BankTransaction) *BankStatementUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the BankStatementMutation object of the builder.
+func (_u *BankStatementUpdate) Mutation() *BankStatementMutation {
+	return _u.mutation
+}
+
+// ClearTransactions clears all "transactions" edges to the BankTransaction entity.
+func (_u *BankStatementUpdate) ClearTransactions() *BankStatementUpdate {
+	_u.mutation.ClearTransactions()
+	return _u
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to BankTransaction entities by IDs.
+func (_u *BankStatementUpdate) RemoveTransactionIDs(ids ...uuid.UUID) *BankStatementUpdate {
+	_u.mutation.RemoveTransactionIDs(ids...)
+	return _u
+}
+
+// RemoveTransactions removes "transactions" edges to BankTransaction entities.
+func (_u *BankStatementUpdate) RemoveTransactions(v ...*BankTransaction) *BankStatementUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransactionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BankStatementUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BankStatementUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BankStatementUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BankStatementUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BankStatementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bankstatement.Table, bankstatement.Columns, sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(bankstatement.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.BankAccountID(); ok {
+		_spec.SetField(bankstatement.FieldBankAccountID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(bankstatement.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Profile(); ok {
+		_spec.SetField(bankstatement.FieldProfile, field.TypeString, value)
+	}
+	if _u.mutation.ProfileCleared() {
+		_spec.ClearField(bankstatement.FieldProfile, field.TypeString)
+	}
+	if value, ok := _u.mutation.FileName(); ok {
+		_spec.SetField(bankstatement.FieldFileName, field.TypeString, value)
+	}
+	if _u.mutation.FileNameCleared() {
+		_spec.ClearField(bankstatement.FieldFileName, field.TypeString)
+	}
+	if value, ok := _u.mutation.FileHash(); ok {
+		_spec.SetField(bankstatement.FieldFileHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StatementReference(); ok {
+		_spec.SetField(bankstatement.FieldStatementReference, field.TypeString, value)
+	}
+	if _u.mutation.StatementReferenceCleared() {
+		_spec.ClearField(bankstatement.FieldStatementReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(bankstatement.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(bankstatement.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PeriodEnd(); ok {
+		_spec.SetField(bankstatement.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.OpeningBalance(); ok {
+		_spec.SetField(bankstatement.FieldOpeningBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOpeningBalance(); ok {
+		_spec.AddField(bankstatement.FieldOpeningBalance, field.TypeFloat64, value)
+	}
+	if _u.mutation.OpeningBalanceCleared() {
+		_spec.ClearField(bankstatement.FieldOpeningBalance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ClosingBalance(); ok {
+		_spec.SetField(bankstatement.FieldClosingBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedClosingBalance(); ok {
+		_spec.AddField(bankstatement.FieldClosingBalance, field.TypeFloat64, value)
+	}
+	if _u.mutation.ClosingBalanceCleared() {
+		_spec.ClearField(bankstatement.FieldClosingBalance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Continuity(); ok {
+		_spec.SetField(bankstatement.FieldContinuity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpectedOpeningBalance(); ok {
+		_spec.SetField(bankstatement.FieldExpectedOpeningBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedExpectedOpeningBalance(); ok {
+		_spec.AddField(bankstatement.FieldExpectedOpeningBalance, field.TypeFloat64, value)
+	}
+	if _u.mutation.ExpectedOpeningBalanceCleared() {
+		_spec.ClearField(bankstatement.FieldExpectedOpeningBalance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TransactionCount(); ok {
+		_spec.SetField(bankstatement.FieldTransactionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTransactionCount(); ok {
+		_spec.AddField(bankstatement.FieldTransactionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DuplicateCount(); ok {
+		_spec.SetField(bankstatement.FieldDuplicateCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDuplicateCount(); ok {
+		_spec.AddField(bankstatement.FieldDuplicateCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LinkedCount(); ok {
+		_spec.SetField(bankstatement.FieldLinkedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLinkedCount(); ok {
+		_spec.AddField(bankstatement.FieldLinkedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ImportedBy(); ok {
+		_spec.SetField(bankstatement.FieldImportedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ImportedByCleared() {
+		_spec.ClearField(bankstatement.FieldImportedBy, field.TypeUUID)
+	}
+	if _u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bankstatement.TransactionsTable,
+			Columns: []string{bankstatement.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(banktransaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !_u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bankstatement.TransactionsTable,
+			Columns: []string{bankstatement.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(banktransaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bankstatement.TransactionsTable,
+			Columns: []string{bankstatement.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(banktransaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bankstatement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BankStatementUpdateOne is the builder for updating a single BankStatement entity.
+type BankStatementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BankStatementMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *BankStatementUpdateOne) SetTenantID(v uuid.UUID) *BankStatementUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableTenantID(v *uuid.UUID) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (_u *BankStatementUpdateOne) SetBankAccountID(v uuid.UUID) *BankStatementUpdateOne {
+	_u.mutation.SetBankAccountID(v)
+	return _u
+}
+
+// SetNillableBankAccountID sets the "bank_account_id" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableBankAccountID(v *uuid.UUID) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetBankAccountID(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *BankStatementUpdateOne) SetFormat(v string) *BankStatementUpdateOne {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableFormat(v *string) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetProfile sets the "profile" field.
+func (_u *BankStatementUpdateOne) SetProfile(v string) *BankStatementUpdateOne {
+	_u.mutation.SetProfile(v)
+	return _u
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableProfile(v *string) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetProfile(*v)
+	}
+	return _u
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (_u *BankStatementUpdateOne) ClearProfile() *BankStatementUpdateOne {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// SetFileName sets the "file_name" field.
+func (_u *BankStatementUpdateOne) SetFileName(v string) *BankStatementUpdateOne {
+	_u.mutation.SetFileName(v)
+	return _u
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableFileName(v *string) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetFileName(*v)
+	}
+	return _u
+}
+
+// ClearFileName clears the value of the "file_name" field.
+func (_u *BankStatementUpdateOne) ClearFileName() *BankStatementUpdateOne {
+	_u.mutation.ClearFileName()
+	return _u
+}
+
+// SetFileHash sets the "file_hash" field.
+func (_u *BankStatementUpdateOne) SetFileHash(v string) *BankStatementUpdateOne {
+	_u.mutation.SetFileHash(v)
+	return _u
+}
+
+// SetNillableFileHash sets the "file_hash" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableFileHash(v *string) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetFileHash(*v)
+	}
+	return _u
+}
+
+// SetStatementReference sets the "statement_reference" field.
+func (_u *BankStatementUpdateOne) SetStatementReference(v string) *BankStatementUpdateOne {
+	_u.mutation.SetStatementReference(v)
+	return _u
+}
+
+// SetNillableStatementReference sets the "statement_reference" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableStatementReference(v *string) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetStatementReference(*v)
+	}
+	return _u
+}
+
+// ClearStatementReference clears the value of the "statement_reference" field.
+func (_u *BankStatementUpdateOne) ClearStatementReference() *BankStatementUpdateOne {
+	_u.mutation.ClearStatementReference()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *BankStatementUpdateOne) SetCurrency(v string) *BankStatementUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableCurrency(v *string) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *BankStatementUpdateOne) SetPeriodStart(v time.Time) *BankStatementUpdateOne {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillablePeriodStart(v *time.Time) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_u *BankStatementUpdateOne) SetPeriodEnd(v time.Time) *BankStatementUpdateOne {
+	_u.mutation.SetPeriodEnd(v)
+	return _u
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillablePeriodEnd(v *time.Time) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetPeriodEnd(*v)
+	}
+	return _u
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (_u *BankStatementUpdateOne) SetOpeningBalance(v decimal.Decimal) *BankStatementUpdateOne {
+	_u.mutation.ResetOpeningBalance()
+	_u.mutation.SetOpeningBalance(v)
+	return _u
+}
+
+// SetNillableOpeningBalance sets the "opening_balance" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableOpeningBalance(v *decimal.Decimal) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetOpeningBalance(*v)
+	}
+	return _u
+}
+
+// AddOpeningBalance adds value to the "opening_balance" field.
+func (_u *BankStatementUpdateOne) AddOpeningBalance(v decimal.Decimal) *BankStatementUpdateOne {
+	_u.mutation.AddOpeningBalance(v)
+	return _u
+}
+
+// ClearOpeningBalance clears the value of the "opening_balance" field.
+func (_u *BankStatementUpdateOne) ClearOpeningBalance() *BankStatementUpdateOne {
+	_u.mutation.ClearOpeningBalance()
+	return _u
+}
+
+// SetClosingBalance sets the "closing_balance" field.
+func (_u *BankStatementUpdateOne) SetClosingBalance(v decimal.Decimal) *BankStatementUpdateOne {
+	_u.mutation.ResetClosingBalance()
+	_u.mutation.SetClosingBalance(v)
+	return _u
+}
+
+// SetNillableClosingBalance sets the "closing_balance" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableClosingBalance(v *decimal.Decimal) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetClosingBalance(*v)
+	}
+	return _u
+}
+
+// AddClosingBalance adds value to the "closing_balance" field.
+func (_u *BankStatementUpdateOne) AddClosingBalance(v decimal.Decimal) *BankStatementUpdateOne {
+	_u.mutation.AddClosingBalance(v)
+	return _u
+}
+
+// ClearClosingBalance clears the value of the "closing_balance" field.
+func (_u *BankStatementUpdateOne) ClearClosingBalance() *BankStatementUpdateOne {
+	_u.mutation.ClearClosingBalance()
+	return _u
+}
+
+// SetContinuity sets the "continuity" field.
+func (_u *BankStatementUpdateOne) SetContinuity(v string) *BankStatementUpdateOne {
+	_u.mutation.SetContinuity(v)
+	return _u
+}
+
+// SetNillableContinuity sets the "continuity" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableContinuity(v *string) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetContinuity(*v)
+	}
+	return _u
+}
+
+// SetExpectedOpeningBalance sets the "expected_opening_balance" field.
+func (_u *BankStatementUpdateOne) SetExpectedOpeningBalance(v decimal.Decimal) *BankStatementUpdateOne {
+	_u.mutation.ResetExpectedOpeningBalance()
+	_u.mutation.SetExpectedOpeningBalance(v)
+	return _u
+}
+
+// SetNillableExpectedOpeningBalance sets the "expected_opening_balance" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableExpectedOpeningBalance(v *decimal.Decimal) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetExpectedOpeningBalance(*v)
+	}
+	return _u
+}
+
+// AddExpectedOpeningBalance adds value to the "expected_opening_balance" field.
+func (_u *BankStatementUpdateOne) AddExpectedOpeningBalance(v decimal.Decimal) *BankStatementUpdateOne {
+	_u.mutation.AddExpectedOpeningBalance(v)
+	return _u
+}
+
+// ClearExpectedOpeningBalance clears the value of the "expected_opening_balance" field.
+func (_u *BankStatementUpdateOne) ClearExpectedOpeningBalance() *BankStatementUpdateOne {
+	_u.mutation.ClearExpectedOpeningBalance()
+	return _u
+}
+
+// SetTransactionCount sets the "transaction_count" field.
+func (_u *BankStatementUpdateOne) SetTransactionCount(v int) *BankStatementUpdateOne {
+	_u.mutation.ResetTransactionCount()
+	_u.mutation.SetTransactionCount(v)
+	return _u
+}
+
+// SetNillableTransactionCount sets the "transaction_count" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableTransactionCount(v *int) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetTransactionCount(*v)
+	}
+	return _u
+}
+
+// AddTransactionCount adds value to the "transaction_count" field.
+func (_u *BankStatementUpdateOne) AddTransactionCount(v int) *BankStatementUpdateOne {
+	_u.mutation.AddTransactionCount(v)
+	return _u
+}
+
+// SetDuplicateCount sets the "duplicate_count" field.
+func (_u *BankStatementUpdateOne) SetDuplicateCount(v int) *BankStatementUpdateOne {
+	_u.mutation.ResetDuplicateCount()
+	_u.mutation.SetDuplicateCount(v)
+	return _u
+}
+
+// SetNillableDuplicateCount sets the "duplicate_count" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableDuplicateCount(v *int) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetDuplicateCount(*v)
+	}
+	return _u
+}
+
+// AddDuplicateCount adds value to the "duplicate_count" field.
+func (_u *BankStatementUpdateOne) AddDuplicateCount(v int) *BankStatementUpdateOne {
+	_u.mutation.AddDuplicateCount(v)
+	return _u
+}
+
+// SetLinkedCount sets the "linked_count" field.
+func (_u *BankStatementUpdateOne) SetLinkedCount(v int) *BankStatementUpdateOne {
+	_u.mutation.ResetLinkedCount()
+	_u.mutation.SetLinkedCount(v)
+	return _u
+}
+
+// SetNillableLinkedCount sets the "linked_count" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableLinkedCount(v *int) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetLinkedCount(*v)
+	}
+	return _u
+}
+
+// AddLinkedCount adds value to the "linked_count" field.
+func (_u *BankStatementUpdateOne) AddLinkedCount(v int) *BankStatementUpdateOne {
+	_u.mutation.AddLinkedCount(v)
+	return _u
+}
+
+// SetImportedBy sets the "imported_by" field.
+func (_u *BankStatementUpdateOne) SetImportedBy(v uuid.UUID) *BankStatementUpdateOne {
+	_u.mutation.SetImportedBy(v)
+	return _u
+}
+
+// SetNillableImportedBy sets the "imported_by" field if the given value is not nil.
+func (_u *BankStatementUpdateOne) SetNillableImportedBy(v *uuid.UUID) *BankStatementUpdateOne {
+	if v != nil {
+		_u.SetImportedBy(*v)
+	}
+	return _u
+}
+
+// ClearImportedBy clears the value of the "imported_by" field.
+func (_u *BankStatementUpdateOne) ClearImportedBy() *BankStatementUpdateOne {
+	_u.mutation.ClearImportedBy()
+	return _u
+}
+
+// AddTransactionIDs adds the "transactions" edge to the BankTransaction entity by IDs.
+func (_u *BankStatementUpdateOne) AddTransactionIDs(ids ...uuid.UUID) *BankStatementUpdateOne {
+	_u.mutation.AddTransactionIDs(ids...)
+	return _u
+}
+
+// AddTransactions adds the "transactions" edges to the BankTransaction entity.
+func (_u *BankStatementUpdateOne) AddTransactions(v ...*BankTransaction) *BankStatementUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the BankStatementMutation object of the builder.
+func (_u *BankStatementUpdateOne) Mutation() *BankStatementMutation {
+	return _u.mutation
+}
+
+// ClearTransactions clears all "transactions" edges to the BankTransaction entity.
+func (_u *BankStatementUpdateOne) ClearTransactions() *BankStatementUpdateOne {
+	_u.mutation.ClearTransactions()
+	return _u
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to BankTransaction entities by IDs.
+func (_u *BankStatementUpdateOne) RemoveTransactionIDs(ids ...uuid.UUID) *BankStatementUpdateOne {
+	_u.mutation.RemoveTransactionIDs(ids...)
+	return _u
+}
+
+// RemoveTransactions removes "transactions" edges to BankTransaction entities.
+func (_u *BankStatementUpdateOne) RemoveTransactions(v ...*BankTransaction) *BankStatementUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransactionIDs(ids...)
+}
+
+// Where appends a list predicates to the BankStatementUpdate builder.
+func (_u *BankStatementUpdateOne) Where(ps ...predicate.BankStatement) *BankStatementUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BankStatementUpdateOne) Select(field string, fields ...string) *BankStatementUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BankStatement entity.
+func (_u *BankStatementUpdateOne) Save(ctx context.Context) (*BankStatement, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BankStatementUpdateOne) SaveX(ctx context.Context) *BankStatement {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BankStatementUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BankStatementUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BankStatementUpdateOne) sqlSave(ctx context.Context) (_node *BankStatement, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bankstatement.Table, bankstatement.Columns, sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BankStatement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bankstatement.FieldID)
+		for _, f := range fields {
+			if !bankstatement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bankstatement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(bankstatement.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.BankAccountID(); ok {
+		_spec.SetField(bankstatement.FieldBankAccountID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(bankstatement.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Profile(); ok {
+		_spec.SetField(bankstatement.FieldProfile, field.TypeString, value)
+	}
+	if _u.mutation.ProfileCleared() {
+		_spec.ClearField(bankstatement.FieldProfile, field.TypeString)
+	}
+	if value, ok := _u.mutation.FileName(); ok {
+		_spec.SetField(bankstatement.FieldFileName, field.TypeString, value)
+	}
+	if _u.mutation.FileNameCleared() {
+		_spec.ClearField(bankstatement.FieldFileName, field.TypeString)
+	}
+	if value, ok := _u.mutation.FileHash(); ok {
+		_spec.SetField(bankstatement.FieldFileHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StatementReference(); ok {
+		_spec.SetField(bankstatement.FieldStatementReference, field.TypeString, value)
+	}
+	if _u.mutation.StatementReferenceCleared() {
+		_spec.ClearField(bankstatement.FieldStatementReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(bankstatement.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(bankstatement.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PeriodEnd(); ok {
+		_spec.SetField(bankstatement.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.OpeningBalance(); ok {
+		_spec.SetField(bankstatement.FieldOpeningBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOpeningBalance(); ok {
+		_spec.AddField(bankstatement.FieldOpeningBalance, field.TypeFloat64, value)
+	}
+	if _u.mutation.OpeningBalanceCleared() {
+		_spec.ClearField(bankstatement.FieldOpeningBalance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ClosingBalance(); ok {
+		_spec.SetField(bankstatement.FieldClosingBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedClosingBalance(); ok {
+		_spec.AddField(bankstatement.FieldClosingBalance, field.TypeFloat64, value)
+	}
+	if _u.mutation.ClosingBalanceCleared() {
+		_spec.ClearField(bankstatement.FieldClosingBalance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Continuity(); ok {
+		_spec.SetField(bankstatement.FieldContinuity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpectedOpeningBalance(); ok {
+		_spec.SetField(bankstatement.FieldExpectedOpeningBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedExpectedOpeningBalance(); ok {
+		_spec.AddField(bankstatement.FieldExpectedOpeningBalance, field.TypeFloat64, value)
+	}
+	if _u.mutation.ExpectedOpeningBalanceCleared() {
+		_spec.ClearField(bankstatement.FieldExpectedOpeningBalance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TransactionCount(); ok {
+		_spec.SetField(bankstatement.FieldTransactionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTransactionCount(); ok {
+		_spec.AddField(bankstatement.FieldTransactionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DuplicateCount(); ok {
+		_spec.SetField(bankstatement.FieldDuplicateCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDuplicateCount(); ok {
+		_spec.AddField(bankstatement.FieldDuplicateCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LinkedCount(); ok {
+		_spec.SetField(bankstatement.FieldLinkedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLinkedCount(); ok {
+		_spec.AddField(bankstatement.FieldLinkedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ImportedBy(); ok {
+		_spec.SetField(bankstatement.FieldImportedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ImportedByCleared() {
+		_spec.ClearField(bankstatement.FieldImportedBy, field.TypeUUID)
+	}
+	if _u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bankstatement.TransactionsTable,
+			Columns: []string{bankstatement.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(banktransaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !_u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bankstatement.TransactionsTable,
+			Columns: []string{bankstatement.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(banktransaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bankstatement.TransactionsTable,
+			Columns: []string{bankstatement.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(banktransaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BankStatement{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bankstatement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatementprofile"
+	"github.com/google/uuid"
+)
+
+// BankStatementProfile is the model entity for the BankStatementProfile schema.
+type BankStatementProfile struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Profile name, e.g. kcb or equity
+	Name string `json:"name,omitempty"`
+	// Field separator
+	Delimiter string `json:"delimiter,omitempty"`
+	// Lines before the header row
+	SkipRows int `json:"skip_rows,omitempty"`
+	// DateColumn holds the value of the "date_column" field.
+	DateColumn string `json:"date_column,omitempty"`
+	// Go reference layout of the dates, e.g. 02/01/2006
+	DateFormat string `json:"date_format,omitempty"`
+	// ValueDateColumn holds the value of the "value_date_column" field.
+	ValueDateColumn string `json:"value_date_column,omitempty"`
+	// DescriptionColumn holds the value of the "description_column" field.
+	DescriptionColumn string `json:"description_column,omitempty"`
+	// ReferenceColumn holds the value of the "reference_column" field.
+	ReferenceColumn string `json:"reference_column,omitempty"`
+	// CounterpartyColumn holds the value of the "counterparty_column" field.
+	CounterpartyColumn string `json:"counterparty_column,omitempty"`
+	// Signed amount column; used instead of debit and credit columns
+	AmountColumn string `json:"amount_column,omitempty"`
+	// DebitColumn holds the value of the "debit_column" field.
+	DebitColumn string `json:"debit_column,omitempty"`
+	// CreditColumn holds the value of the "credit_column" field.
+	CreditColumn string `json:"credit_column,omitempty"`
+	// Running balance column the opening and closing balances are derived from
+	BalanceColumn string `json:"balance_column,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy uuid.UUID `json:"updated_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BankStatementProfile) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bankstatementprofile.FieldSkipRows:
+			values[i] = new(sql.NullInt64)
+		case bankstatementprofile.FieldName, bankstatementprofile.FieldDelimiter, bankstatementprofile.FieldDateColumn, bankstatementprofile.FieldDateFormat, bankstatementprofile.FieldValueDateColumn, bankstatementprofile.FieldDescriptionColumn, bankstatementprofile.FieldReferenceColumn, bankstatementprofile.FieldCounterpartyColumn, bankstatementprofile.FieldAmountColumn, bankstatementprofile.FieldDebitColumn, bankstatementprofile.FieldCreditColumn, bankstatementprofile.FieldBalanceColumn:
+			values[i] = new(sql.NullString)
+		case bankstatementprofile.FieldCreatedAt, bankstatementprofile.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case bankstatementprofile.FieldID, bankstatementprofile.FieldTenantID, bankstatementprofile.FieldUpdatedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BankStatementProfile fields.
+func (_m *BankStatementProfile) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bankstatementprofile.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case bankstatementprofile.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case bankstatementprofile.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bankstatementprofile.FieldDelimiter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delimiter", values[i])
+			} else if value.Valid {
+				_m.Delimiter = value.String
+			}
+		case bankstatementprofile.FieldSkipRows:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field skip_rows", values[i])
+			} else if value.Valid {
+				_m.SkipRows = int(value.Int64)
+			}
+		case bankstatementprofile.FieldDateColumn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field date_column", values[i])
+			} else if value.Valid {
+				_m.DateColumn = value.String
+			}
+		case bankstatementprofile.FieldDateFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field date_format", values[i])
+			} else if value.Valid {
+				_m.DateFormat = value.String
+			}
+		case bankstatementprofile.FieldValueDateColumn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value_date_column", values[i])
+			} else if value.Valid {
+				_m.ValueDateColumn = value.String
+			}
+		case bankstatementprofile.FieldDescriptionColumn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description_column", values[i])
+			} else if value.Valid {
+				_m.DescriptionColumn = value.String
+			}
+		case bankstatementprofile.FieldReferenceColumn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference_column", values[i])
+			} else if value.Valid {
+				_m.ReferenceColumn = value.String
+			}
+		case bankstatementprofile.FieldCounterpartyColumn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field counterparty_column", values[i])
+			} else if value.Valid {
+				_m.CounterpartyColumn = value.String
+			}
+		case bankstatementprofile.FieldAmountColumn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_column", values[i])
+			} else if value.Valid {
+				_m.AmountColumn = value.String
+			}
+		case bankstatementprofile.FieldDebitColumn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field debit_column", values[i])
+			} else if value.Valid {
+				_m.DebitColumn = value.String
+			}
+		case bankstatementprofile.FieldCreditColumn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_column", values[i])
+			} else if value.Valid {
+				_m.CreditColumn = value.String
+			}
+		case bankstatementprofile.FieldBalanceColumn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field balance_column", values[i])
+			} else if value.Valid {
+				_m.BalanceColumn = value.String
+			}
+		case bankstatementprofile.FieldUpdatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value != nil {
+				_m.UpdatedBy = *value
+			}
+		case bankstatementprofile.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case bankstatementprofile.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BankStatementProfile.
+// This includes values selected through modifiers, order, etc.
+func (_m *BankStatementProfile) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BankStatementProfile.
+// Note that you need to call BankStatementProfile.Unwrap() before calling this method if this BankStatementProfile
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BankStatementProfile) Update() *BankStatementProfileUpdateOne {
+	return NewBankStatementProfileClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BankStatementProfile entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BankStatementProfile) Unwrap() *BankStatementProfile {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BankStatementProfile is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BankStatementProfile) String() string {
+	var builder strings.Builder
+	builder.WriteString("BankStatementProfile(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("delimiter=")
+	builder.WriteString(_m.Delimiter)
+	builder.WriteString(", ")
+	builder.WriteString("skip_rows=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SkipRows))
+	builder.WriteString(", ")
+	builder.WriteString("date_column=")
+	builder.WriteString(_m.DateColumn)
+	builder.WriteString(", ")
+	builder.WriteString("date_format=")
+	builder.WriteString(_m.DateFormat)
+	builder.WriteString(", ")
+	builder.WriteString("value_date_column=")
+	builder.WriteString(_m.ValueDateColumn)
+	builder.WriteString(", ")
+	builder.WriteString("description_column=")
+	builder.WriteString(_m.DescriptionColumn)
+	builder.WriteString(", ")
+	builder.WriteString("reference_column=")
+	builder.WriteString(_m.ReferenceColumn)
+	builder.WriteString(", ")
+	builder.WriteString("counterparty_column=")
+	builder.WriteString(_m.CounterpartyColumn)
+	builder.WriteString(", ")
+	builder.WriteString("amount_column=")
+	builder.WriteString(_m.AmountColumn)
+	builder.WriteString(", ")
+	builder.WriteString("debit_column=")
+	builder.WriteString(_m.DebitColumn)
+	builder.WriteString(", ")
+	builder.WriteString("credit_column=")
+	builder.WriteString(_m.CreditColumn)
+	builder.WriteString(", ")
+	builder.WriteString("balance_column=")
+	builder.WriteString(_m.BalanceColumn)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BankStatementProfiles is a parsable slice of BankStatementProfile.
+type BankStatementProfiles []*BankStatementProfile
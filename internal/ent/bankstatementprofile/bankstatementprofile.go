@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bankstatementprofile
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the bankstatementprofile type in the database.
+	Label = "bank_statement_profile"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDelimiter holds the string denoting the delimiter field in the database.
+	FieldDelimiter = "delimiter"
+	// FieldSkipRows holds the string denoting the skip_rows field in the database.
+	FieldSkipRows = "skip_rows"
+	// FieldDateColumn holds the string denoting the date_column field in the database.
+	FieldDateColumn = "date_column"
+	// FieldDateFormat holds the string denoting the date_format field in the database.
+	FieldDateFormat = "date_format"
+	// FieldValueDateColumn holds the string denoting the value_date_column field in the database.
+	FieldValueDateColumn = "value_date_column"
+	// FieldDescriptionColumn holds the string denoting the description_column field in the database.
+	FieldDescriptionColumn = "description_column"
+	// FieldReferenceColumn holds the string denoting the reference_column field in the database.
+	FieldReferenceColumn = "reference_column"
+	// FieldCounterpartyColumn holds the string denoting the counterparty_column field in the database.
+	FieldCounterpartyColumn = "counterparty_column"
+	// FieldAmountColumn holds the string denoting the amount_column field in the database.
+	FieldAmountColumn = "amount_column"
+	// FieldDebitColumn holds the string denoting the debit_column field in the database.
+	FieldDebitColumn = "debit_column"
+	// FieldCreditColumn holds the string denoting the credit_column field in the database.
+	FieldCreditColumn = "credit_column"
+	// FieldBalanceColumn holds the string denoting the balance_column field in the database.
+	FieldBalanceColumn = "balance_column"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the bankstatementprofile in the database.
+	Table = "bank_statement_profiles"
+)
+
+// Columns holds all SQL columns for bankstatementprofile fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldName,
+	FieldDelimiter,
+	FieldSkipRows,
+	FieldDateColumn,
+	FieldDateFormat,
+	FieldValueDateColumn,
+	FieldDescriptionColumn,
+	FieldReferenceColumn,
+	FieldCounterpartyColumn,
+	FieldAmountColumn,
+	FieldDebitColumn,
+	FieldCreditColumn,
+	FieldBalanceColumn,
+	FieldUpdatedBy,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultDelimiter holds the default value on creation for the "delimiter" field.
+	DefaultDelimiter string
+	// DateColumnValidator is a validator for the "date_column" field. It is called by the builders before save.
+	DateColumnValidator func(string) error
+	// DateFormatValidator is a validator for the "date_format" field. It is called by the builders before save.
+	DateFormatValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the BankStatementProfile queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDelimiter orders the results by the delimiter field.
+func ByDelimiter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDelimiter, opts...).ToFunc()
+}
+
+// BySkipRows orders the results by the skip_rows field.
+func BySkipRows(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkipRows, opts...).ToFunc()
+}
+
+// ByDateColumn orders the results by the date_column field.
+func ByDateColumn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDateColumn, opts...).ToFunc()
+}
+
+// ByDateFormat orders the results by the date_format field.
+func ByDateFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDateFormat, opts...).ToFunc()
+}
+
+// ByValueDateColumn orders the results by the value_date_column field.
+func ByValueDateColumn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValueDateColumn, opts...).ToFunc()
+}
+
+// ByDescriptionColumn orders the results by the description_column field.
+func ByDescriptionColumn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescriptionColumn, opts...).ToFunc()
+}
+
+// ByReferenceColumn orders the results by the reference_column field.
+func ByReferenceColumn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferenceColumn, opts...).ToFunc()
+}
+
+// ByCounterpartyColumn orders the results by the counterparty_column field.
+func ByCounterpartyColumn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCounterpartyColumn, opts...).ToFunc()
+}
+
+// ByAmountColumn orders the results by the amount_column field.
+func ByAmountColumn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountColumn, opts...).ToFunc()
+}
+
+// ByDebitColumn orders the results by the debit_column field.
+func ByDebitColumn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDebitColumn, opts...).ToFunc()
+}
+
+// ByCreditColumn orders the results by the credit_column field.
+func ByCreditColumn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditColumn, opts...).ToFunc()
+}
+
+// ByBalanceColumn orders the results by the balance_column field.
+func ByBalanceColumn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBalanceColumn, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,1236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bankstatementprofile
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldName, v))
+}
+
+// Delimiter applies equality check predicate on the "delimiter" field. It's identical to DelimiterEQ.
+func Delimiter(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldDelimiter, v))
+}
+
+// SkipRows applies equality check predicate on the "skip_rows" field. It's identical to SkipRowsEQ.
+func SkipRows(v int) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldSkipRows, v))
+}
+
+// DateColumn applies equality check predicate on the "date_column" field. It's identical to DateColumnEQ.
+func DateColumn(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldDateColumn, v))
+}
+
+// DateFormat applies equality check predicate on the "date_format" field. It's identical to DateFormatEQ.
+func DateFormat(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldDateFormat, v))
+}
+
+// ValueDateColumn applies equality check predicate on the "value_date_column" field. It's identical to ValueDateColumnEQ.
+func ValueDateColumn(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldValueDateColumn, v))
+}
+
+// DescriptionColumn applies equality check predicate on the "description_column" field. It's identical to DescriptionColumnEQ.
+func DescriptionColumn(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldDescriptionColumn, v))
+}
+
+// ReferenceColumn applies equality check predicate on the "reference_column" field. It's identical to ReferenceColumnEQ.
+func ReferenceColumn(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldReferenceColumn, v))
+}
+
+// CounterpartyColumn applies equality check predicate on the "counterparty_column" field. It's identical to CounterpartyColumnEQ.
+func CounterpartyColumn(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldCounterpartyColumn, v))
+}
+
+// AmountColumn applies equality check predicate on the "amount_column" field. It's identical to AmountColumnEQ.
+func AmountColumn(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldAmountColumn, v))
+}
+
+// DebitColumn applies equality check predicate on the "debit_column" field. It's identical to DebitColumnEQ.
+func DebitColumn(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldDebitColumn, v))
+}
+
+// CreditColumn applies equality check predicate on the "credit_column" field. It's identical to CreditColumnEQ.
+func CreditColumn(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldCreditColumn, v))
+}
+
+// BalanceColumn applies equality check predicate on the "balance_column" field. It's identical to BalanceColumnEQ.
+func BalanceColumn(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldBalanceColumn, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLTE(FieldTenantID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContainsFold(FieldName, v))
+}
+
+// DelimiterEQ applies the EQ predicate on the "delimiter" field.
+func DelimiterEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldDelimiter, v))
+}
+
+// DelimiterNEQ applies the NEQ predicate on the "delimiter" field.
+func DelimiterNEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNEQ(FieldDelimiter, v))
+}
+
+// DelimiterIn applies the In predicate on the "delimiter" field.
+func DelimiterIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIn(FieldDelimiter, vs...))
+}
+
+// DelimiterNotIn applies the NotIn predicate on the "delimiter" field.
+func DelimiterNotIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotIn(FieldDelimiter, vs...))
+}
+
+// DelimiterGT applies the GT predicate on the "delimiter" field.
+func DelimiterGT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGT(FieldDelimiter, v))
+}
+
+// DelimiterGTE applies the GTE predicate on the "delimiter" field.
+func DelimiterGTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGTE(FieldDelimiter, v))
+}
+
+// DelimiterLT applies the LT predicate on the "delimiter" field.
+func DelimiterLT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLT(FieldDelimiter, v))
+}
+
+// DelimiterLTE applies the LTE predicate on the "delimiter" field.
+func DelimiterLTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLTE(FieldDelimiter, v))
+}
+
+// DelimiterContains applies the Contains predicate on the "delimiter" field.
+func DelimiterContains(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContains(FieldDelimiter, v))
+}
+
+// DelimiterHasPrefix applies the HasPrefix predicate on the "delimiter" field.
+func DelimiterHasPrefix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasPrefix(FieldDelimiter, v))
+}
+
+// DelimiterHasSuffix applies the HasSuffix predicate on the "delimiter" field.
+func DelimiterHasSuffix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasSuffix(FieldDelimiter, v))
+}
+
+// DelimiterEqualFold applies the EqualFold predicate on the "delimiter" field.
+func DelimiterEqualFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEqualFold(FieldDelimiter, v))
+}
+
+// DelimiterContainsFold applies the ContainsFold predicate on the "delimiter" field.
+func DelimiterContainsFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContainsFold(FieldDelimiter, v))
+}
+
+// SkipRowsEQ applies the EQ predicate on the "skip_rows" field.
+func SkipRowsEQ(v int) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldSkipRows, v))
+}
+
+// SkipRowsNEQ applies the NEQ predicate on the "skip_rows" field.
+func SkipRowsNEQ(v int) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNEQ(FieldSkipRows, v))
+}
+
+// SkipRowsIn applies the In predicate on the "skip_rows" field.
+func SkipRowsIn(vs ...int) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIn(FieldSkipRows, vs...))
+}
+
+// SkipRowsNotIn applies the NotIn predicate on the "skip_rows" field.
+func SkipRowsNotIn(vs ...int) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotIn(FieldSkipRows, vs...))
+}
+
+// SkipRowsGT applies the GT predicate on the "skip_rows" field.
+func SkipRowsGT(v int) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGT(FieldSkipRows, v))
+}
+
+// SkipRowsGTE applies the GTE predicate on the "skip_rows" field.
+func SkipRowsGTE(v int) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGTE(FieldSkipRows, v))
+}
+
+// SkipRowsLT applies the LT predicate on the "skip_rows" field.
+func SkipRowsLT(v int) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLT(FieldSkipRows, v))
+}
+
+// SkipRowsLTE applies the LTE predicate on the "skip_rows" field.
+func SkipRowsLTE(v int) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLTE(FieldSkipRows, v))
+}
+
+// SkipRowsIsNil applies the IsNil predicate on the "skip_rows" field.
+func SkipRowsIsNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIsNull(FieldSkipRows))
+}
+
+// SkipRowsNotNil applies the NotNil predicate on the "skip_rows" field.
+func SkipRowsNotNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotNull(FieldSkipRows))
+}
+
+// DateColumnEQ applies the EQ predicate on the "date_column" field.
+func DateColumnEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldDateColumn, v))
+}
+
+// DateColumnNEQ applies the NEQ predicate on the "date_column" field.
+func DateColumnNEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNEQ(FieldDateColumn, v))
+}
+
+// DateColumnIn applies the In predicate on the "date_column" field.
+func DateColumnIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIn(FieldDateColumn, vs...))
+}
+
+// DateColumnNotIn applies the NotIn predicate on the "date_column" field.
+func DateColumnNotIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotIn(FieldDateColumn, vs...))
+}
+
+// DateColumnGT applies the GT predicate on the "date_column" field.
+func DateColumnGT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGT(FieldDateColumn, v))
+}
+
+// DateColumnGTE applies the GTE predicate on the "date_column" field.
+func DateColumnGTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGTE(FieldDateColumn, v))
+}
+
+// DateColumnLT applies the LT predicate on the "date_column" field.
+func DateColumnLT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLT(FieldDateColumn, v))
+}
+
+// DateColumnLTE applies the LTE predicate on the "date_column" field.
+func DateColumnLTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLTE(FieldDateColumn, v))
+}
+
+// DateColumnContains applies the Contains predicate on the "date_column" field.
+func DateColumnContains(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContains(FieldDateColumn, v))
+}
+
+// DateColumnHasPrefix applies the HasPrefix predicate on the "date_column" field.
+func DateColumnHasPrefix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasPrefix(FieldDateColumn, v))
+}
+
+// DateColumnHasSuffix applies the HasSuffix predicate on the "date_column" field.
+func DateColumnHasSuffix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasSuffix(FieldDateColumn, v))
+}
+
+// DateColumnEqualFold applies the EqualFold predicate on the "date_column" field.
+func DateColumnEqualFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEqualFold(FieldDateColumn, v))
+}
+
+// DateColumnContainsFold applies the ContainsFold predicate on the "date_column" field.
+func DateColumnContainsFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContainsFold(FieldDateColumn, v))
+}
+
+// DateFormatEQ applies the EQ predicate on the "date_format" field.
+func DateFormatEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldDateFormat, v))
+}
+
+// DateFormatNEQ applies the NEQ predicate on the "date_format" field.
+func DateFormatNEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNEQ(FieldDateFormat, v))
+}
+
+// DateFormatIn applies the In predicate on the "date_format" field.
+func DateFormatIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIn(FieldDateFormat, vs...))
+}
+
+// DateFormatNotIn applies the NotIn predicate on the "date_format" field.
+func DateFormatNotIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotIn(FieldDateFormat, vs...))
+}
+
+// DateFormatGT applies the GT predicate on the "date_format" field.
+func DateFormatGT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGT(FieldDateFormat, v))
+}
+
+// DateFormatGTE applies the GTE predicate on the "date_format" field.
+func DateFormatGTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGTE(FieldDateFormat, v))
+}
+
+// DateFormatLT applies the LT predicate on the "date_format" field.
+func DateFormatLT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLT(FieldDateFormat, v))
+}
+
+// DateFormatLTE applies the LTE predicate on the "date_format" field.
+func DateFormatLTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLTE(FieldDateFormat, v))
+}
+
+// DateFormatContains applies the Contains predicate on the "date_format" field.
+func DateFormatContains(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContains(FieldDateFormat, v))
+}
+
+// DateFormatHasPrefix applies the HasPrefix predicate on the "date_format" field.
+func DateFormatHasPrefix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasPrefix(FieldDateFormat, v))
+}
+
+// DateFormatHasSuffix applies the HasSuffix predicate on the "date_format" field.
+func DateFormatHasSuffix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasSuffix(FieldDateFormat, v))
+}
+
+// DateFormatEqualFold applies the EqualFold predicate on the "date_format" field.
+func DateFormatEqualFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEqualFold(FieldDateFormat, v))
+}
+
+// DateFormatContainsFold applies the ContainsFold predicate on the "date_format" field.
+func DateFormatContainsFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContainsFold(FieldDateFormat, v))
+}
+
+// ValueDateColumnEQ applies the EQ predicate on the "value_date_column" field.
+func ValueDateColumnEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldValueDateColumn, v))
+}
+
+// ValueDateColumnNEQ applies the NEQ predicate on the "value_date_column" field.
+func ValueDateColumnNEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNEQ(FieldValueDateColumn, v))
+}
+
+// ValueDateColumnIn applies the In predicate on the "value_date_column" field.
+func ValueDateColumnIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIn(FieldValueDateColumn, vs...))
+}
+
+// ValueDateColumnNotIn applies the NotIn predicate on the "value_date_column" field.
+func ValueDateColumnNotIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotIn(FieldValueDateColumn, vs...))
+}
+
+// ValueDateColumnGT applies the GT predicate on the "value_date_column" field.
+func ValueDateColumnGT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGT(FieldValueDateColumn, v))
+}
+
+// ValueDateColumnGTE applies the GTE predicate on the "value_date_column" field.
+func ValueDateColumnGTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGTE(FieldValueDateColumn, v))
+}
+
+// ValueDateColumnLT applies the LT predicate on the "value_date_column" field.
+func ValueDateColumnLT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLT(FieldValueDateColumn, v))
+}
+
+// ValueDateColumnLTE applies the LTE predicate on the "value_date_column" field.
+func ValueDateColumnLTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLTE(FieldValueDateColumn, v))
+}
+
+// ValueDateColumnContains applies the Contains predicate on the "value_date_column" field.
+func ValueDateColumnContains(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContains(FieldValueDateColumn, v))
+}
+
+// ValueDateColumnHasPrefix applies the HasPrefix predicate on the "value_date_column" field.
+func ValueDateColumnHasPrefix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasPrefix(FieldValueDateColumn, v))
+}
+
+// ValueDateColumnHasSuffix applies the HasSuffix predicate on the "value_date_column" field.
+func ValueDateColumnHasSuffix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasSuffix(FieldValueDateColumn, v))
+}
+
+// ValueDateColumnIsNil applies the IsNil predicate on the "value_date_column" field.
+func ValueDateColumnIsNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIsNull(FieldValueDateColumn))
+}
+
+// ValueDateColumnNotNil applies the NotNil predicate on the "value_date_column" field.
+func ValueDateColumnNotNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotNull(FieldValueDateColumn))
+}
+
+// ValueDateColumnEqualFold applies the EqualFold predicate on the "value_date_column" field.
+func ValueDateColumnEqualFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEqualFold(FieldValueDateColumn, v))
+}
+
+// ValueDateColumnContainsFold applies the ContainsFold predicate on the "value_date_column" field.
+func ValueDateColumnContainsFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContainsFold(FieldValueDateColumn, v))
+}
+
+// DescriptionColumnEQ applies the EQ predicate on the "description_column" field.
+func DescriptionColumnEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldDescriptionColumn, v))
+}
+
+// DescriptionColumnNEQ applies the NEQ predicate on the "description_column" field.
+func DescriptionColumnNEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNEQ(FieldDescriptionColumn, v))
+}
+
+// DescriptionColumnIn applies the In predicate on the "description_column" field.
+func DescriptionColumnIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIn(FieldDescriptionColumn, vs...))
+}
+
+// DescriptionColumnNotIn applies the NotIn predicate on the "description_column" field.
+func DescriptionColumnNotIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotIn(FieldDescriptionColumn, vs...))
+}
+
+// DescriptionColumnGT applies the GT predicate on the "description_column" field.
+func DescriptionColumnGT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGT(FieldDescriptionColumn, v))
+}
+
+// DescriptionColumnGTE applies the GTE predicate on the "description_column" field.
+func DescriptionColumnGTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGTE(FieldDescriptionColumn, v))
+}
+
+// DescriptionColumnLT applies the LT predicate on the "description_column" field.
+func DescriptionColumnLT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLT(FieldDescriptionColumn, v))
+}
+
+// DescriptionColumnLTE applies the LTE predicate on the "description_column" field.
+func DescriptionColumnLTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLTE(FieldDescriptionColumn, v))
+}
+
+// DescriptionColumnContains applies the Contains predicate on the "description_column" field.
+func DescriptionColumnContains(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContains(FieldDescriptionColumn, v))
+}
+
+// DescriptionColumnHasPrefix applies the HasPrefix predicate on the "description_column" field.
+func DescriptionColumnHasPrefix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasPrefix(FieldDescriptionColumn, v))
+}
+
+// DescriptionColumnHasSuffix applies the HasSuffix predicate on the "description_column" field.
+func DescriptionColumnHasSuffix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasSuffix(FieldDescriptionColumn, v))
+}
+
+// DescriptionColumnIsNil applies the IsNil predicate on the "description_column" field.
+func DescriptionColumnIsNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIsNull(FieldDescriptionColumn))
+}
+
+// DescriptionColumnNotNil applies the NotNil predicate on the "description_column" field.
+func DescriptionColumnNotNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotNull(FieldDescriptionColumn))
+}
+
+// DescriptionColumnEqualFold applies the EqualFold predicate on the "description_column" field.
+func DescriptionColumnEqualFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEqualFold(FieldDescriptionColumn, v))
+}
+
+// DescriptionColumnContainsFold applies the ContainsFold predicate on the "description_column" field.
+func DescriptionColumnContainsFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContainsFold(FieldDescriptionColumn, v))
+}
+
+// ReferenceColumnEQ applies the EQ predicate on the "reference_column" field.
+func ReferenceColumnEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldReferenceColumn, v))
+}
+
+// ReferenceColumnNEQ applies the NEQ predicate on the "reference_column" field.
+func ReferenceColumnNEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNEQ(FieldReferenceColumn, v))
+}
+
+// ReferenceColumnIn applies the In predicate on the "reference_column" field.
+func ReferenceColumnIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIn(FieldReferenceColumn, vs...))
+}
+
+// ReferenceColumnNotIn applies the NotIn predicate on the "reference_column" field.
+func ReferenceColumnNotIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotIn(FieldReferenceColumn, vs...))
+}
+
+// ReferenceColumnGT applies the GT predicate on the "reference_column" field.
+func ReferenceColumnGT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGT(FieldReferenceColumn, v))
+}
+
+// ReferenceColumnGTE applies the GTE predicate on the "reference_column" field.
+func ReferenceColumnGTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGTE(FieldReferenceColumn, v))
+}
+
+// ReferenceColumnLT applies the LT predicate on the "reference_column" field.
+func ReferenceColumnLT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLT(FieldReferenceColumn, v))
+}
+
+// ReferenceColumnLTE applies the LTE predicate on the "reference_column" field.
+func ReferenceColumnLTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLTE(FieldReferenceColumn, v))
+}
+
+// ReferenceColumnContains applies the Contains predicate on the "reference_column" field.
+func ReferenceColumnContains(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContains(FieldReferenceColumn, v))
+}
+
+// ReferenceColumnHasPrefix applies the HasPrefix predicate on the "reference_column" field.
+func ReferenceColumnHasPrefix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasPrefix(FieldReferenceColumn, v))
+}
+
+// ReferenceColumnHasSuffix applies the HasSuffix predicate on the "reference_column" field.
+func ReferenceColumnHasSuffix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasSuffix(FieldReferenceColumn, v))
+}
+
+// ReferenceColumnIsNil applies the IsNil predicate on the "reference_column" field.
+func ReferenceColumnIsNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIsNull(FieldReferenceColumn))
+}
+
+// ReferenceColumnNotNil applies the NotNil predicate on the "reference_column" field.
+func ReferenceColumnNotNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotNull(FieldReferenceColumn))
+}
+
+// ReferenceColumnEqualFold applies the EqualFold predicate on the "reference_column" field.
+func ReferenceColumnEqualFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEqualFold(FieldReferenceColumn, v))
+}
+
+// ReferenceColumnContainsFold applies the ContainsFold predicate on the "reference_column" field.
+func ReferenceColumnContainsFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContainsFold(FieldReferenceColumn, v))
+}
+
+// CounterpartyColumnEQ applies the EQ predicate on the "counterparty_column" field.
+func CounterpartyColumnEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldCounterpartyColumn, v))
+}
+
+// CounterpartyColumnNEQ applies the NEQ predicate on the "counterparty_column" field.
+func CounterpartyColumnNEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNEQ(FieldCounterpartyColumn, v))
+}
+
+// CounterpartyColumnIn applies the In predicate on the "counterparty_column" field.
+func CounterpartyColumnIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIn(FieldCounterpartyColumn, vs...))
+}
+
+// CounterpartyColumnNotIn applies the NotIn predicate on the "counterparty_column" field.
+func CounterpartyColumnNotIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotIn(FieldCounterpartyColumn, vs...))
+}
+
+// CounterpartyColumnGT applies the GT predicate on the "counterparty_column" field.
+func CounterpartyColumnGT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGT(FieldCounterpartyColumn, v))
+}
+
+// CounterpartyColumnGTE applies the GTE predicate on the "counterparty_column" field.
+func CounterpartyColumnGTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGTE(FieldCounterpartyColumn, v))
+}
+
+// CounterpartyColumnLT applies the LT predicate on the "counterparty_column" field.
+func CounterpartyColumnLT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLT(FieldCounterpartyColumn, v))
+}
+
+// CounterpartyColumnLTE applies the LTE predicate on the "counterparty_column" field.
+func CounterpartyColumnLTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLTE(FieldCounterpartyColumn, v))
+}
+
+// CounterpartyColumnContains applies the Contains predicate on the "counterparty_column" field.
+func CounterpartyColumnContains(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContains(FieldCounterpartyColumn, v))
+}
+
+// CounterpartyColumnHasPrefix applies the HasPrefix predicate on the "counterparty_column" field.
+func CounterpartyColumnHasPrefix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasPrefix(FieldCounterpartyColumn, v))
+}
+
+// CounterpartyColumnHasSuffix applies the HasSuffix predicate on the "counterparty_column" field.
+func CounterpartyColumnHasSuffix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasSuffix(FieldCounterpartyColumn, v))
+}
+
+// CounterpartyColumnIsNil applies the IsNil predicate on the "counterparty_column" field.
+func CounterpartyColumnIsNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIsNull(FieldCounterpartyColumn))
+}
+
+// CounterpartyColumnNotNil applies the NotNil predicate on the "counterparty_column" field.
+func CounterpartyColumnNotNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotNull(FieldCounterpartyColumn))
+}
+
+// CounterpartyColumnEqualFold applies the EqualFold predicate on the "counterparty_column" field.
+func CounterpartyColumnEqualFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEqualFold(FieldCounterpartyColumn, v))
+}
+
+// CounterpartyColumnContainsFold applies the ContainsFold predicate on the "counterparty_column" field.
+func CounterpartyColumnContainsFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContainsFold(FieldCounterpartyColumn, v))
+}
+
+// AmountColumnEQ applies the EQ predicate on the "amount_column" field.
+func AmountColumnEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldAmountColumn, v))
+}
+
+// AmountColumnNEQ applies the NEQ predicate on the "amount_column" field.
+func AmountColumnNEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNEQ(FieldAmountColumn, v))
+}
+
+// AmountColumnIn applies the In predicate on the "amount_column" field.
+func AmountColumnIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIn(FieldAmountColumn, vs...))
+}
+
+// AmountColumnNotIn applies the NotIn predicate on the "amount_column" field.
+func AmountColumnNotIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotIn(FieldAmountColumn, vs...))
+}
+
+// AmountColumnGT applies the GT predicate on the "amount_column" field.
+func AmountColumnGT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGT(FieldAmountColumn, v))
+}
+
+// AmountColumnGTE applies the GTE predicate on the "amount_column" field.
+func AmountColumnGTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGTE(FieldAmountColumn, v))
+}
+
+// AmountColumnLT applies the LT predicate on the "amount_column" field.
+func AmountColumnLT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLT(FieldAmountColumn, v))
+}
+
+// AmountColumnLTE applies the LTE predicate on the "amount_column" field.
+func AmountColumnLTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLTE(FieldAmountColumn, v))
+}
+
+// AmountColumnContains applies the Contains predicate on the "amount_column" field.
+func AmountColumnContains(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContains(FieldAmountColumn, v))
+}
+
+// AmountColumnHasPrefix applies the HasPrefix predicate on the "amount_column" field.
+func AmountColumnHasPrefix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasPrefix(FieldAmountColumn, v))
+}
+
+// AmountColumnHasSuffix applies the HasSuffix predicate on the "amount_column" field.
+func AmountColumnHasSuffix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasSuffix(FieldAmountColumn, v))
+}
+
+// AmountColumnIsNil applies the IsNil predicate on the "amount_column" field.
+func AmountColumnIsNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIsNull(FieldAmountColumn))
+}
+
+// AmountColumnNotNil applies the NotNil predicate on the "amount_column" field.
+func AmountColumnNotNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotNull(FieldAmountColumn))
+}
+
+// AmountColumnEqualFold applies the EqualFold predicate on the "amount_column" field.
+func AmountColumnEqualFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEqualFold(FieldAmountColumn, v))
+}
+
+// AmountColumnContainsFold applies the ContainsFold predicate on the "amount_column" field.
+func AmountColumnContainsFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContainsFold(FieldAmountColumn, v))
+}
+
+// DebitColumnEQ applies the EQ predicate on the "debit_column" field.
+func DebitColumnEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldDebitColumn, v))
+}
+
+// DebitColumnNEQ applies the NEQ predicate on the "debit_column" field.
+func DebitColumnNEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNEQ(FieldDebitColumn, v))
+}
+
+// DebitColumnIn applies the In predicate on the "debit_column" field.
+func DebitColumnIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIn(FieldDebitColumn, vs...))
+}
+
+// DebitColumnNotIn applies the NotIn predicate on the "debit_column" field.
+func DebitColumnNotIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotIn(FieldDebitColumn, vs...))
+}
+
+// DebitColumnGT applies the GT predicate on the "debit_column" field.
+func DebitColumnGT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGT(FieldDebitColumn, v))
+}
+
+// DebitColumnGTE applies the GTE predicate on the "debit_column" field.
+func DebitColumnGTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGTE(FieldDebitColumn, v))
+}
+
+// DebitColumnLT applies the LT predicate on the "debit_column" field.
+func DebitColumnLT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLT(FieldDebitColumn, v))
+}
+
+// DebitColumnLTE applies the LTE predicate on the "debit_column" field.
+func DebitColumnLTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLTE(FieldDebitColumn, v))
+}
+
+// DebitColumnContains applies the Contains predicate on the "debit_column" field.
+func DebitColumnContains(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContains(FieldDebitColumn, v))
+}
+
+// DebitColumnHasPrefix applies the HasPrefix predicate on the "debit_column" field.
+func DebitColumnHasPrefix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasPrefix(FieldDebitColumn, v))
+}
+
+// DebitColumnHasSuffix applies the HasSuffix predicate on the "debit_column" field.
+func DebitColumnHasSuffix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasSuffix(FieldDebitColumn, v))
+}
+
+// DebitColumnIsNil applies the IsNil predicate on the "debit_column" field.
+func DebitColumnIsNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIsNull(FieldDebitColumn))
+}
+
+// DebitColumnNotNil applies the NotNil predicate on the "debit_column" field.
+func DebitColumnNotNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotNull(FieldDebitColumn))
+}
+
+// DebitColumnEqualFold applies the EqualFold predicate on the "debit_column" field.
+func DebitColumnEqualFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEqualFold(FieldDebitColumn, v))
+}
+
+// DebitColumnContainsFold applies the ContainsFold predicate on the "debit_column" field.
+func DebitColumnContainsFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContainsFold(FieldDebitColumn, v))
+}
+
+// CreditColumnEQ applies the EQ predicate on the "credit_column" field.
+func CreditColumnEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldCreditColumn, v))
+}
+
+// CreditColumnNEQ applies the NEQ predicate on the "credit_column" field.
+func CreditColumnNEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNEQ(FieldCreditColumn, v))
+}
+
+// CreditColumnIn applies the In predicate on the "credit_column" field.
+func CreditColumnIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIn(FieldCreditColumn, vs...))
+}
+
+// CreditColumnNotIn applies the NotIn predicate on the "credit_column" field.
+func CreditColumnNotIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotIn(FieldCreditColumn, vs...))
+}
+
+// CreditColumnGT applies the GT predicate on the "credit_column" field.
+func CreditColumnGT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGT(FieldCreditColumn, v))
+}
+
+// CreditColumnGTE applies the GTE predicate on the "credit_column" field.
+func CreditColumnGTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGTE(FieldCreditColumn, v))
+}
+
+// CreditColumnLT applies the LT predicate on the "credit_column" field.
+func CreditColumnLT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLT(FieldCreditColumn, v))
+}
+
+// CreditColumnLTE applies the LTE predicate on the "credit_column" field.
+func CreditColumnLTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLTE(FieldCreditColumn, v))
+}
+
+// CreditColumnContains applies the Contains predicate on the "credit_column" field.
+func CreditColumnContains(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContains(FieldCreditColumn, v))
+}
+
+// CreditColumnHasPrefix applies the HasPrefix predicate on the "credit_column" field.
+func CreditColumnHasPrefix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasPrefix(FieldCreditColumn, v))
+}
+
+// CreditColumnHasSuffix applies the HasSuffix predicate on the "credit_column" field.
+func CreditColumnHasSuffix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasSuffix(FieldCreditColumn, v))
+}
+
+// CreditColumnIsNil applies the IsNil predicate on the "credit_column" field.
+func CreditColumnIsNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIsNull(FieldCreditColumn))
+}
+
+// CreditColumnNotNil applies the NotNil predicate on the "credit_column" field.
+func CreditColumnNotNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotNull(FieldCreditColumn))
+}
+
+// CreditColumnEqualFold applies the EqualFold predicate on the "credit_column" field.
+func CreditColumnEqualFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEqualFold(FieldCreditColumn, v))
+}
+
+// CreditColumnContainsFold applies the ContainsFold predicate on the "credit_column" field.
+func CreditColumnContainsFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContainsFold(FieldCreditColumn, v))
+}
+
+// BalanceColumnEQ applies the EQ predicate on the "balance_column" field.
+func BalanceColumnEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldBalanceColumn, v))
+}
+
+// BalanceColumnNEQ applies the NEQ predicate on the "balance_column" field.
+func BalanceColumnNEQ(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNEQ(FieldBalanceColumn, v))
+}
+
+// BalanceColumnIn applies the In predicate on the "balance_column" field.
+func BalanceColumnIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIn(FieldBalanceColumn, vs...))
+}
+
+// BalanceColumnNotIn applies the NotIn predicate on the "balance_column" field.
+func BalanceColumnNotIn(vs ...string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotIn(FieldBalanceColumn, vs...))
+}
+
+// BalanceColumnGT applies the GT predicate on the "balance_column" field.
+func BalanceColumnGT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGT(FieldBalanceColumn, v))
+}
+
+// BalanceColumnGTE applies the GTE predicate on the "balance_column" field.
+func BalanceColumnGTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGTE(FieldBalanceColumn, v))
+}
+
+// BalanceColumnLT applies the LT predicate on the "balance_column" field.
+func BalanceColumnLT(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLT(FieldBalanceColumn, v))
+}
+
+// BalanceColumnLTE applies the LTE predicate on the "balance_column" field.
+func BalanceColumnLTE(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLTE(FieldBalanceColumn, v))
+}
+
+// BalanceColumnContains applies the Contains predicate on the "balance_column" field.
+func BalanceColumnContains(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContains(FieldBalanceColumn, v))
+}
+
+// BalanceColumnHasPrefix applies the HasPrefix predicate on the "balance_column" field.
+func BalanceColumnHasPrefix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasPrefix(FieldBalanceColumn, v))
+}
+
+// BalanceColumnHasSuffix applies the HasSuffix predicate on the "balance_column" field.
+func BalanceColumnHasSuffix(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldHasSuffix(FieldBalanceColumn, v))
+}
+
+// BalanceColumnIsNil applies the IsNil predicate on the "balance_column" field.
+func BalanceColumnIsNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIsNull(FieldBalanceColumn))
+}
+
+// BalanceColumnNotNil applies the NotNil predicate on the "balance_column" field.
+func BalanceColumnNotNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotNull(FieldBalanceColumn))
+}
+
+// BalanceColumnEqualFold applies the EqualFold predicate on the "balance_column" field.
+func BalanceColumnEqualFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEqualFold(FieldBalanceColumn, v))
+}
+
+// BalanceColumnContainsFold applies the ContainsFold predicate on the "balance_column" field.
+func BalanceColumnContainsFold(v string) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldContainsFold(FieldBalanceColumn, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v uuid.UUID) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BankStatementProfile) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BankStatementProfile) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BankStatementProfile) predicate.BankStatementProfile {
+	return predicate.BankStatementProfile(sql.NotPredicates(p))
+}
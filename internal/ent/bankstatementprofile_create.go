@@ -0,0 +1,1610 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatementprofile"
+	"github.com/google/uuid"
+)
+
+// BankStatementProfileCreate is the builder for creating a BankStatementProfile entity.
+type BankStatementProfileCreate struct {
+	config
+	mutation *BankStatementProfileMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *BankStatementProfileCreate) SetTenantID(v uuid.UUID) *BankStatementProfileCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BankStatementProfileCreate) SetName(v string) *BankStatementProfileCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDelimiter sets the "delimiter" field.
+func (_c *BankStatementProfileCreate) SetDelimiter(v string) *BankStatementProfileCreate {
+	_c.mutation.SetDelimiter(v)
+	return _c
+}
+
+// SetNillableDelimiter sets the "delimiter" field if the given value is not nil.
+func (_c *BankStatementProfileCreate) SetNillableDelimiter(v *string) *BankStatementProfileCreate {
+	if v != nil {
+		_c.SetDelimiter(*v)
+	}
+	return _c
+}
+
+// SetSkipRows sets the "skip_rows" field.
+func (_c *BankStatementProfileCreate) SetSkipRows(v int) *BankStatementProfileCreate {
+	_c.mutation.SetSkipRows(v)
+	return _c
+}
+
+// SetNillableSkipRows sets the "skip_rows" field if the given value is not nil.
+func (_c *BankStatementProfileCreate) SetNillableSkipRows(v *int) *BankStatementProfileCreate {
+	if v != nil {
+		_c.SetSkipRows(*v)
+	}
+	return _c
+}
+
+// SetDateColumn sets the "date_column" field.
+func (_c *BankStatementProfileCreate) SetDateColumn(v string) *BankStatementProfileCreate {
+	_c.mutation.SetDateColumn(v)
+	return _c
+}
+
+// SetDateFormat sets the "date_format" field.
+func (_c *BankStatementProfileCreate) SetDateFormat(v string) *BankStatementProfileCreate {
+	_c.mutation.SetDateFormat(v)
+	return _c
+}
+
+// SetValueDateColumn sets the "value_date_column" field.
+func (_c *BankStatementProfileCreate) SetValueDateColumn(v string) *BankStatementProfileCreate {
+	_c.mutation.SetValueDateColumn(v)
+	return _c
+}
+
+// SetNillableValueDateColumn sets the "value_date_column" field if the given value is not nil.
+func (_c *BankStatementProfileCreate) SetNillableValueDateColumn(v *string) *BankStatementProfileCreate {
+	if v != nil {
+		_c.SetValueDateColumn(*v)
+	}
+	return _c
+}
+
+// SetDescriptionColumn sets the "description_column" field.
+func (_c *BankStatementProfileCreate) SetDescriptionColumn(v string) *BankStatementProfileCreate {
+	_c.mutation.SetDescriptionColumn(v)
+	return _c
+}
+
+// SetNillableDescriptionColumn sets the "description_column" field if the given value is not nil.
+func (_c *BankStatementProfileCreate) SetNillableDescriptionColumn(v *string) *BankStatementProfileCreate {
+	if v != nil {
+		_c.SetDescriptionColumn(*v)
+	}
+	return _c
+}
+
+// SetReferenceColumn sets the "reference_column" field.
+func (_c *BankStatementProfileCreate) SetReferenceColumn(v string) *BankStatementProfileCreate {
+	_c.mutation.SetReferenceColumn(v)
+	return _c
+}
+
+// SetNillableReferenceColumn sets the "reference_column" field if the given value is not nil.
+func (_c *BankStatementProfileCreate) SetNillableReferenceColumn(v *string) *BankStatementProfileCreate {
+	if v != nil {
+		_c.SetReferenceColumn(*v)
+	}
+	return _c
+}
+
+// SetCounterpartyColumn sets the "counterparty_column" field.
+func (_c *BankStatementProfileCreate) SetCounterpartyColumn(v string) *BankStatementProfileCreate {
+	_c.mutation.SetCounterpartyColumn(v)
+	return _c
+}
+
+// SetNillableCounterpartyColumn sets the "counterparty_column" field if the given value is not nil.
+func (_c *BankStatementProfileCreate) SetNillableCounterpartyColumn(v *string) *BankStatementProfileCreate {
+	if v != nil {
+		_c.SetCounterpartyColumn(*v)
+	}
+	return _c
+}
+
+// SetAmountColumn sets the "amount_column" field.
+func (_c *BankStatementProfileCreate) SetAmountColumn(v string) *BankStatementProfileCreate {
+	_c.mutation.SetAmountColumn(v)
+	return _c
+}
+
+// SetNillableAmountColumn sets the "amount_column" field if the given value is not nil.
+func (_c *BankStatementProfileCreate) SetNillableAmountColumn(v *string) *BankStatementProfileCreate {
+	if v != nil {
+		_c.SetAmountColumn(*v)
+	}
+	return _c
+}
+
+// SetDebitColumn sets the "debit_column" field.
+func (_c *BankStatementProfileCreate) SetDebitColumn(v string) *BankStatementProfileCreate {
+	_c.mutation.SetDebitColumn(v)
+	return _c
+}
+
+// SetNillableDebitColumn sets the "debit_column" field if the given value is not nil.
+func (_c *BankStatementProfileCreate) SetNillableDebitColumn(v *string) *BankStatementProfileCreate {
+	if v != nil {
+		_c.SetDebitColumn(*v)
+	}
+	return _c
+}
+
+// SetCreditColumn sets the "credit_column" field.
+func (_c *BankStatementProfileCreate) SetCreditColumn(v string) *BankStatementProfileCreate {
+	_c.mutation.SetCreditColumn(v)
+	return _c
+}
+
+// SetNillableCreditColumn sets the "credit_column" field if the given value is not nil.
+func (_c *BankStatementProfileCreate) SetNillableCreditColumn(v *string) *BankStatementProfileCreate {
+	if v != nil {
+		_c.SetCreditColumn(*v)
+	}
+	return _c
+}
+
+// SetBalanceColumn sets the "balance_column" field.
+func (_c *BankStatementProfileCreate) SetBalanceColumn(v string) *BankStatementProfileCreate {
+	_c.mutation.SetBalanceColumn(v)
+	return _c
+}
+
+// SetNillableBalanceColumn sets the "balance_column" field if the given value is not nil.
+func (_c *BankStatementProfileCreate) SetNillableBalanceColumn(v *string) *BankStatementProfileCreate {
+	if v != nil {
+		_c.SetBalanceColumn(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *BankStatementProfileCreate) SetUpdatedBy(v uuid.UUID) *BankStatementProfileCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *BankStatementProfileCreate) SetNillableUpdatedBy(v *uuid.UUID) *BankStatementProfileCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BankStatementProfileCreate) SetCreatedAt(v time.Time) *BankStatementProfileCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BankStatementProfileCreate) SetNillableCreatedAt(v *time.Time) *BankStatementProfileCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BankStatementProfileCreate) SetUpdatedAt(v time.Time) *BankStatementProfileCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BankStatementProfileCreate) SetNillableUpdatedAt(v *time.Time) *BankStatementProfileCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BankStatementProfileCreate) SetID(v uuid.UUID) *BankStatementProfileCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BankStatementProfileCreate) SetNillableID(v *uuid.UUID) *BankStatementProfileCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the BankStatementProfileMutation object of the builder.
+func (_c *BankStatementProfileCreate) Mutation() *BankStatementProfileMutation {
+	return _c.mutation
+}
+
+// Save creates the BankStatementProfile in the database.
+func (_c *BankStatementProfileCreate) Save(ctx context.Context) (*BankStatementProfile, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BankStatementProfileCreate) SaveX(ctx context.Context) *BankStatementProfile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BankStatementProfileCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BankStatementProfileCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BankStatementProfileCreate) defaults() {
+	if _, ok := _c.mutation.Delimiter(); !ok {
+		v := bankstatementprofile.DefaultDelimiter
+		_c.mutation.SetDelimiter(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := bankstatementprofile.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := bankstatementprofile.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := bankstatementprofile.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BankStatementProfileCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "BankStatementProfile.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "BankStatementProfile.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := bankstatementprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "BankStatementProfile.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Delimiter(); !ok {
+		return &ValidationError{Name: "delimiter", err: errors.New(`ent: missing required field "BankStatementProfile.delimiter"`)}
+	}
+	if _, ok := _c.mutation.DateColumn(); !ok {
+		return &ValidationError{Name: "date_column", err: errors.New(`ent: missing required field "BankStatementProfile.date_column"`)}
+	}
+	if v, ok := _c.mutation.DateColumn(); ok {
+		if err := bankstatementprofile.DateColumnValidator(v); err != nil {
+			return &ValidationError{Name: "date_column", err: fmt.Errorf(`ent: validator failed for field "BankStatementProfile.date_column": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DateFormat(); !ok {
+		return &ValidationError{Name: "date_format", err: errors.New(`ent: missing required field "BankStatementProfile.date_format"`)}
+	}
+	if v, ok := _c.mutation.DateFormat(); ok {
+		if err := bankstatementprofile.DateFormatValidator(v); err != nil {
+			return &ValidationError{Name: "date_format", err: fmt.Errorf(`ent: validator failed for field "BankStatementProfile.date_format": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BankStatementProfile.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BankStatementProfile.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *BankStatementProfileCreate) sqlSave(ctx context.Context) (*BankStatementProfile, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BankStatementProfileCreate) createSpec() (*BankStatementProfile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BankStatementProfile{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bankstatementprofile.Table, sqlgraph.NewFieldSpec(bankstatementprofile.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(bankstatementprofile.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bankstatementprofile.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Delimiter(); ok {
+		_spec.SetField(bankstatementprofile.FieldDelimiter, field.TypeString, value)
+		_node.Delimiter = value
+	}
+	if value, ok := _c.mutation.SkipRows(); ok {
+		_spec.SetField(bankstatementprofile.FieldSkipRows, field.TypeInt, value)
+		_node.SkipRows = value
+	}
+	if value, ok := _c.mutation.DateColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldDateColumn, field.TypeString, value)
+		_node.DateColumn = value
+	}
+	if value, ok := _c.mutation.DateFormat(); ok {
+		_spec.SetField(bankstatementprofile.FieldDateFormat, field.TypeString, value)
+		_node.DateFormat = value
+	}
+	if value, ok := _c.mutation.ValueDateColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldValueDateColumn, field.TypeString, value)
+		_node.ValueDateColumn = value
+	}
+	if value, ok := _c.mutation.DescriptionColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldDescriptionColumn, field.TypeString, value)
+		_node.DescriptionColumn = value
+	}
+	if value, ok := _c.mutation.ReferenceColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldReferenceColumn, field.TypeString, value)
+		_node.ReferenceColumn = value
+	}
+	if value, ok := _c.mutation.CounterpartyColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldCounterpartyColumn, field.TypeString, value)
+		_node.CounterpartyColumn = value
+	}
+	if value, ok := _c.mutation.AmountColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldAmountColumn, field.TypeString, value)
+		_node.AmountColumn = value
+	}
+	if value, ok := _c.mutation.DebitColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldDebitColumn, field.TypeString, value)
+		_node.DebitColumn = value
+	}
+	if value, ok := _c.mutation.CreditColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldCreditColumn, field.TypeString, value)
+		_node.CreditColumn = value
+	}
+	if value, ok := _c.mutation.BalanceColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldBalanceColumn, field.TypeString, value)
+		_node.BalanceColumn = value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(bankstatementprofile.FieldUpdatedBy, field.TypeUUID, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(bankstatementprofile.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(bankstatementprofile.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BankStatementProfile.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BankStatementProfileUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BankStatementProfileCreate) OnConflict(opts ...sql.ConflictOption) *BankStatementProfileUpsertOne {
+	_c.conflict = opts
+	return &BankStatementProfileUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BankStatementProfile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BankStatementProfileCreate) OnConflictColumns(columns ...string) *BankStatementProfileUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BankStatementProfileUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BankStatementProfileUpsertOne is the builder for "upsert"-ing
+	//  one BankStatementProfile node.
+	BankStatementProfileUpsertOne struct {
+		create *BankStatementProfileCreate
+	}
+
+	// BankStatementProfileUpsert is the "OnConflict" setter.
+	BankStatementProfileUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *BankStatementProfileUpsert) SetTenantID(v uuid.UUID) *BankStatementProfileUpsert {
+	u.Set(bankstatementprofile.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *BankStatementProfileUpsert) UpdateTenantID() *BankStatementProfileUpsert {
+	u.SetExcluded(bankstatementprofile.FieldTenantID)
+	return u
+}
+
+// SetDelimiter sets the "delimiter" field.
+func (u *BankStatementProfileUpsert) SetDelimiter(v string) *BankStatementProfileUpsert {
+	u.Set(bankstatementprofile.FieldDelimiter, v)
+	return u
+}
+
+// UpdateDelimiter sets the "delimiter" field to the value that was provided on create.
+func (u *BankStatementProfileUpsert) UpdateDelimiter() *BankStatementProfileUpsert {
+	u.SetExcluded(bankstatementprofile.FieldDelimiter)
+	return u
+}
+
+// SetSkipRows sets the "skip_rows" field.
+func (u *BankStatementProfileUpsert) SetSkipRows(v int) *BankStatementProfileUpsert {
+	u.Set(bankstatementprofile.FieldSkipRows, v)
+	return u
+}
+
+// UpdateSkipRows sets the "skip_rows" field to the value that was provided on create.
+func (u *BankStatementProfileUpsert) UpdateSkipRows() *BankStatementProfileUpsert {
+	u.SetExcluded(bankstatementprofile.FieldSkipRows)
+	return u
+}
+
+// AddSkipRows adds v to the "skip_rows" field.
+func (u *BankStatementProfileUpsert) AddSkipRows(v int) *BankStatementProfileUpsert {
+	u.Add(bankstatementprofile.FieldSkipRows, v)
+	return u
+}
+
+// ClearSkipRows clears the value of the "skip_rows" field.
+func (u *BankStatementProfileUpsert) ClearSkipRows() *BankStatementProfileUpsert {
+	u.SetNull(bankstatementprofile.FieldSkipRows)
+	return u
+}
+
+// SetDateColumn sets the "date_column" field.
+func (u *BankStatementProfileUpsert) SetDateColumn(v string) *BankStatementProfileUpsert {
+	u.Set(bankstatementprofile.FieldDateColumn, v)
+	return u
+}
+
+// UpdateDateColumn sets the "date_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsert) UpdateDateColumn() *BankStatementProfileUpsert {
+	u.SetExcluded(bankstatementprofile.FieldDateColumn)
+	return u
+}
+
+// SetDateFormat sets the "date_format" field.
+func (u *BankStatementProfileUpsert) SetDateFormat(v string) *BankStatementProfileUpsert {
+	u.Set(bankstatementprofile.FieldDateFormat, v)
+	return u
+}
+
+// UpdateDateFormat sets the "date_format" field to the value that was provided on create.
+func (u *BankStatementProfileUpsert) UpdateDateFormat() *BankStatementProfileUpsert {
+	u.SetExcluded(bankstatementprofile.FieldDateFormat)
+	return u
+}
+
+// SetValueDateColumn sets the "value_date_column" field.
+func (u *BankStatementProfileUpsert) SetValueDateColumn(v string) *BankStatementProfileUpsert {
+	u.Set(bankstatementprofile.FieldValueDateColumn, v)
+	return u
+}
+
+// UpdateValueDateColumn sets the "value_date_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsert) UpdateValueDateColumn() *BankStatementProfileUpsert {
+	u.SetExcluded(bankstatementprofile.FieldValueDateColumn)
+	return u
+}
+
+// ClearValueDateColumn clears the value of the "value_date_column" field.
+func (u *BankStatementProfileUpsert) ClearValueDateColumn() *BankStatementProfileUpsert {
+	u.SetNull(bankstatementprofile.FieldValueDateColumn)
+	return u
+}
+
+// SetDescriptionColumn sets the "description_column" field.
+func (u *BankStatementProfileUpsert) SetDescriptionColumn(v string) *BankStatementProfileUpsert {
+	u.Set(bankstatementprofile.FieldDescriptionColumn, v)
+	return u
+}
+
+// UpdateDescriptionColumn sets the "description_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsert) UpdateDescriptionColumn() *BankStatementProfileUpsert {
+	u.SetExcluded(bankstatementprofile.FieldDescriptionColumn)
+	return u
+}
+
+// ClearDescriptionColumn clears the value of the "description_column" field.
+func (u *BankStatementProfileUpsert) ClearDescriptionColumn() *BankStatementProfileUpsert {
+	u.SetNull(bankstatementprofile.FieldDescriptionColumn)
+	return u
+}
+
+// SetReferenceColumn sets the "reference_column" field.
+func (u *BankStatementProfileUpsert) SetReferenceColumn(v string) *BankStatementProfileUpsert {
+	u.Set(bankstatementprofile.FieldReferenceColumn, v)
+	return u
+}
+
+// UpdateReferenceColumn sets the "reference_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsert) UpdateReferenceColumn() *BankStatementProfileUpsert {
+	u.SetExcluded(bankstatementprofile.FieldReferenceColumn)
+	return u
+}
+
+// ClearReferenceColumn clears the value of the "reference_column" field.
+func (u *BankStatementProfileUpsert) ClearReferenceColumn() *BankStatementProfileUpsert {
+	u.SetNull(bankstatementprofile.FieldReferenceColumn)
+	return u
+}
+
+// SetCounterpartyColumn sets the "counterparty_column" field.
+func (u *BankStatementProfileUpsert) SetCounterpartyColumn(v string) *BankStatementProfileUpsert {
+	u.Set(bankstatementprofile.FieldCounterpartyColumn, v)
+	return u
+}
+
+// UpdateCounterpartyColumn sets the "counterparty_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsert) UpdateCounterpartyColumn() *BankStatementProfileUpsert {
+	u.SetExcluded(bankstatementprofile.FieldCounterpartyColumn)
+	return u
+}
+
+// ClearCounterpartyColumn clears the value of the "counterparty_column" field.
+func (u *BankStatementProfileUpsert) ClearCounterpartyColumn() *BankStatementProfileUpsert {
+	u.SetNull(bankstatementprofile.FieldCounterpartyColumn)
+	return u
+}
+
+// SetAmountColumn sets the "amount_column" field.
+func (u *BankStatementProfileUpsert) SetAmountColumn(v string) *BankStatementProfileUpsert {
+	u.Set(bankstatementprofile.FieldAmountColumn, v)
+	return u
+}
+
+// UpdateAmountColumn sets the "amount_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsert) UpdateAmountColumn() *BankStatementProfileUpsert {
+	u.SetExcluded(bankstatementprofile.FieldAmountColumn)
+	return u
+}
+
+// ClearAmountColumn clears the value of the "amount_column" field.
+func (u *BankStatementProfileUpsert) ClearAmountColumn() *BankStatementProfileUpsert {
+	u.SetNull(bankstatementprofile.FieldAmountColumn)
+	return u
+}
+
+// SetDebitColumn sets the "debit_column" field.
+func (u *BankStatementProfileUpsert) SetDebitColumn(v string) *BankStatementProfileUpsert {
+	u.Set(bankstatementprofile.FieldDebitColumn, v)
+	return u
+}
+
+// UpdateDebitColumn sets the "debit_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsert) UpdateDebitColumn() *BankStatementProfileUpsert {
+	u.SetExcluded(bankstatementprofile.FieldDebitColumn)
+	return u
+}
+
+// ClearDebitColumn clears the value of the "debit_column" field.
+func (u *BankStatementProfileUpsert) ClearDebitColumn() *BankStatementProfileUpsert {
+	u.SetNull(bankstatementprofile.FieldDebitColumn)
+	return u
+}
+
+// SetCreditColumn sets the "credit_column" field.
+func (u *BankStatementProfileUpsert) SetCreditColumn(v string) *BankStatementProfileUpsert {
+	u.Set(bankstatementprofile.FieldCreditColumn, v)
+	return u
+}
+
+// UpdateCreditColumn sets the "credit_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsert) UpdateCreditColumn() *BankStatementProfileUpsert {
+	u.SetExcluded(bankstatementprofile.FieldCreditColumn)
+	return u
+}
+
+// ClearCreditColumn clears the value of the "credit_column" field.
+func (u *BankStatementProfileUpsert) ClearCreditColumn() *BankStatementProfileUpsert {
+	u.SetNull(bankstatementprofile.FieldCreditColumn)
+	return u
+}
+
+// SetBalanceColumn sets the "balance_column" field.
+func (u *BankStatementProfileUpsert) SetBalanceColumn(v string) *BankStatementProfileUpsert {
+	u.Set(bankstatementprofile.FieldBalanceColumn, v)
+	return u
+}
+
+// UpdateBalanceColumn sets the "balance_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsert) UpdateBalanceColumn() *BankStatementProfileUpsert {
+	u.SetExcluded(bankstatementprofile.FieldBalanceColumn)
+	return u
+}
+
+// ClearBalanceColumn clears the value of the "balance_column" field.
+func (u *BankStatementProfileUpsert) ClearBalanceColumn() *BankStatementProfileUpsert {
+	u.SetNull(bankstatementprofile.FieldBalanceColumn)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *BankStatementProfileUpsert) SetUpdatedBy(v uuid.UUID) *BankStatementProfileUpsert {
+	u.Set(bankstatementprofile.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *BankStatementProfileUpsert) UpdateUpdatedBy() *BankStatementProfileUpsert {
+	u.SetExcluded(bankstatementprofile.FieldUpdatedBy)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *BankStatementProfileUpsert) ClearUpdatedBy() *BankStatementProfileUpsert {
+	u.SetNull(bankstatementprofile.FieldUpdatedBy)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BankStatementProfileUpsert) SetUpdatedAt(v time.Time) *BankStatementProfileUpsert {
+	u.Set(bankstatementprofile.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BankStatementProfileUpsert) UpdateUpdatedAt() *BankStatementProfileUpsert {
+	u.SetExcluded(bankstatementprofile.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.BankStatementProfile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(bankstatementprofile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BankStatementProfileUpsertOne) UpdateNewValues() *BankStatementProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(bankstatementprofile.FieldID)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(bankstatementprofile.FieldName)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(bankstatementprofile.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BankStatementProfile.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BankStatementProfileUpsertOne) Ignore() *BankStatementProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BankStatementProfileUpsertOne) DoNothing() *BankStatementProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BankStatementProfileCreate.OnConflict
+// documentation for more info.
+func (u *BankStatementProfileUpsertOne) Update(set func(*BankStatementProfileUpsert)) *BankStatementProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BankStatementProfileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *BankStatementProfileUpsertOne) SetTenantID(v uuid.UUID) *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertOne) UpdateTenantID() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetDelimiter sets the "delimiter" field.
+func (u *BankStatementProfileUpsertOne) SetDelimiter(v string) *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetDelimiter(v)
+	})
+}
+
+// UpdateDelimiter sets the "delimiter" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertOne) UpdateDelimiter() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateDelimiter()
+	})
+}
+
+// SetSkipRows sets the "skip_rows" field.
+func (u *BankStatementProfileUpsertOne) SetSkipRows(v int) *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetSkipRows(v)
+	})
+}
+
+// AddSkipRows adds v to the "skip_rows" field.
+func (u *BankStatementProfileUpsertOne) AddSkipRows(v int) *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.AddSkipRows(v)
+	})
+}
+
+// UpdateSkipRows sets the "skip_rows" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertOne) UpdateSkipRows() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateSkipRows()
+	})
+}
+
+// ClearSkipRows clears the value of the "skip_rows" field.
+func (u *BankStatementProfileUpsertOne) ClearSkipRows() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearSkipRows()
+	})
+}
+
+// SetDateColumn sets the "date_column" field.
+func (u *BankStatementProfileUpsertOne) SetDateColumn(v string) *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetDateColumn(v)
+	})
+}
+
+// UpdateDateColumn sets the "date_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertOne) UpdateDateColumn() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateDateColumn()
+	})
+}
+
+// SetDateFormat sets the "date_format" field.
+func (u *BankStatementProfileUpsertOne) SetDateFormat(v string) *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetDateFormat(v)
+	})
+}
+
+// UpdateDateFormat sets the "date_format" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertOne) UpdateDateFormat() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateDateFormat()
+	})
+}
+
+// SetValueDateColumn sets the "value_date_column" field.
+func (u *BankStatementProfileUpsertOne) SetValueDateColumn(v string) *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetValueDateColumn(v)
+	})
+}
+
+// UpdateValueDateColumn sets the "value_date_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertOne) UpdateValueDateColumn() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateValueDateColumn()
+	})
+}
+
+// ClearValueDateColumn clears the value of the "value_date_column" field.
+func (u *BankStatementProfileUpsertOne) ClearValueDateColumn() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearValueDateColumn()
+	})
+}
+
+// SetDescriptionColumn sets the "description_column" field.
+func (u *BankStatementProfileUpsertOne) SetDescriptionColumn(v string) *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetDescriptionColumn(v)
+	})
+}
+
+// UpdateDescriptionColumn sets the "description_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertOne) UpdateDescriptionColumn() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateDescriptionColumn()
+	})
+}
+
+// ClearDescriptionColumn clears the value of the "description_column" field.
+func (u *BankStatementProfileUpsertOne) ClearDescriptionColumn() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearDescriptionColumn()
+	})
+}
+
+// SetReferenceColumn sets the "reference_column" field.
+func (u *BankStatementProfileUpsertOne) SetReferenceColumn(v string) *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetReferenceColumn(v)
+	})
+}
+
+// UpdateReferenceColumn sets the "reference_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertOne) UpdateReferenceColumn() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateReferenceColumn()
+	})
+}
+
+// ClearReferenceColumn clears the value of the "reference_column" field.
+func (u *BankStatementProfileUpsertOne) ClearReferenceColumn() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearReferenceColumn()
+	})
+}
+
+// SetCounterpartyColumn sets the "counterparty_column" field.
+func (u *BankStatementProfileUpsertOne) SetCounterpartyColumn(v string) *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetCounterpartyColumn(v)
+	})
+}
+
+// UpdateCounterpartyColumn sets the "counterparty_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertOne) UpdateCounterpartyColumn() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateCounterpartyColumn()
+	})
+}
+
+// ClearCounterpartyColumn clears the value of the "counterparty_column" field.
+func (u *BankStatementProfileUpsertOne) ClearCounterpartyColumn() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearCounterpartyColumn()
+	})
+}
+
+// SetAmountColumn sets the "amount_column" field.
+func (u *BankStatementProfileUpsertOne) SetAmountColumn(v string) *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetAmountColumn(v)
+	})
+}
+
+// UpdateAmountColumn sets the "amount_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertOne) UpdateAmountColumn() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateAmountColumn()
+	})
+}
+
+// ClearAmountColumn clears the value of the "amount_column" field.
+func (u *BankStatementProfileUpsertOne) ClearAmountColumn() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearAmountColumn()
+	})
+}
+
+// SetDebitColumn sets the "debit_column" field.
+func (u *BankStatementProfileUpsertOne) SetDebitColumn(v string) *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetDebitColumn(v)
+	})
+}
+
+// UpdateDebitColumn sets the "debit_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertOne) UpdateDebitColumn() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateDebitColumn()
+	})
+}
+
+// ClearDebitColumn clears the value of the "debit_column" field.
+func (u *BankStatementProfileUpsertOne) ClearDebitColumn() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearDebitColumn()
+	})
+}
+
+// SetCreditColumn sets the "credit_column" field.
+func (u *BankStatementProfileUpsertOne) SetCreditColumn(v string) *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetCreditColumn(v)
+	})
+}
+
+// UpdateCreditColumn sets the "credit_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertOne) UpdateCreditColumn() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateCreditColumn()
+	})
+}
+
+// ClearCreditColumn clears the value of the "credit_column" field.
+func (u *BankStatementProfileUpsertOne) ClearCreditColumn() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearCreditColumn()
+	})
+}
+
+// SetBalanceColumn sets the "balance_column" field.
+func (u *BankStatementProfileUpsertOne) SetBalanceColumn(v string) *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetBalanceColumn(v)
+	})
+}
+
+// UpdateBalanceColumn sets the "balance_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertOne) UpdateBalanceColumn() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateBalanceColumn()
+	})
+}
+
+// ClearBalanceColumn clears the value of the "balance_column" field.
+func (u *BankStatementProfileUpsertOne) ClearBalanceColumn() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearBalanceColumn()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *BankStatementProfileUpsertOne) SetUpdatedBy(v uuid.UUID) *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertOne) UpdateUpdatedBy() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *BankStatementProfileUpsertOne) ClearUpdatedBy() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BankStatementProfileUpsertOne) SetUpdatedAt(v time.Time) *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertOne) UpdateUpdatedAt() *BankStatementProfileUpsertOne {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BankStatementProfileUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BankStatementProfileCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BankStatementProfileUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BankStatementProfileUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BankStatementProfileUpsertOne.ID is not supported by MySQL driver. Use BankStatementProfileUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BankStatementProfileUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BankStatementProfileCreateBulk is the builder for creating many BankStatementProfile entities in bulk.
+type BankStatementProfileCreateBulk struct {
+	config
+	err      error
+	builders []*BankStatementProfileCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BankStatementProfile entities in the database.
+func (_c *BankStatementProfileCreateBulk) Save(ctx context.Context) ([]*BankStatementProfile, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BankStatementProfile, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BankStatementProfileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BankStatementProfileCreateBulk) SaveX(ctx context.Context) []*BankStatementProfile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BankStatementProfileCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BankStatementProfileCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BankStatementProfile.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BankStatementProfileUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BankStatementProfileCreateBulk) OnConflict(opts ...sql.ConflictOption) *BankStatementProfileUpsertBulk {
+	_c.conflict = opts
+	return &BankStatementProfileUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BankStatementProfile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BankStatementProfileCreateBulk) OnConflictColumns(columns ...string) *BankStatementProfileUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BankStatementProfileUpsertBulk{
+		create: _c,
+	}
+}
+
+// BankStatementProfileUpsertBulk is the builder for "upsert"-ing
+// a bulk of BankStatementProfile nodes.
+type BankStatementProfileUpsertBulk struct {
+	create *BankStatementProfileCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BankStatementProfile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(bankstatementprofile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BankStatementProfileUpsertBulk) UpdateNewValues() *BankStatementProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(bankstatementprofile.FieldID)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(bankstatementprofile.FieldName)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(bankstatementprofile.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BankStatementProfile.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BankStatementProfileUpsertBulk) Ignore() *BankStatementProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BankStatementProfileUpsertBulk) DoNothing() *BankStatementProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BankStatementProfileCreateBulk.OnConflict
+// documentation for more info.
+func (u *BankStatementProfileUpsertBulk) Update(set func(*BankStatementProfileUpsert)) *BankStatementProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BankStatementProfileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *BankStatementProfileUpsertBulk) SetTenantID(v uuid.UUID) *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertBulk) UpdateTenantID() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetDelimiter sets the "delimiter" field.
+func (u *BankStatementProfileUpsertBulk) SetDelimiter(v string) *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetDelimiter(v)
+	})
+}
+
+// UpdateDelimiter sets the "delimiter" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertBulk) UpdateDelimiter() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateDelimiter()
+	})
+}
+
+// SetSkipRows sets the "skip_rows" field.
+func (u *BankStatementProfileUpsertBulk) SetSkipRows(v int) *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetSkipRows(v)
+	})
+}
+
+// AddSkipRows adds v to the "skip_rows" field.
+func (u *BankStatementProfileUpsertBulk) AddSkipRows(v int) *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.AddSkipRows(v)
+	})
+}
+
+// UpdateSkipRows sets the "skip_rows" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertBulk) UpdateSkipRows() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateSkipRows()
+	})
+}
+
+// ClearSkipRows clears the value of the "skip_rows" field.
+func (u *BankStatementProfileUpsertBulk) ClearSkipRows() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearSkipRows()
+	})
+}
+
+// SetDateColumn sets the "date_column" field.
+func (u *BankStatementProfileUpsertBulk) SetDateColumn(v string) *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetDateColumn(v)
+	})
+}
+
+// UpdateDateColumn sets the "date_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertBulk) UpdateDateColumn() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateDateColumn()
+	})
+}
+
+// SetDateFormat sets the "date_format" field.
+func (u *BankStatementProfileUpsertBulk) SetDateFormat(v string) *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetDateFormat(v)
+	})
+}
+
+// UpdateDateFormat sets the "date_format" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertBulk) UpdateDateFormat() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateDateFormat()
+	})
+}
+
+// SetValueDateColumn sets the "value_date_column" field.
+func (u *BankStatementProfileUpsertBulk) SetValueDateColumn(v string) *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetValueDateColumn(v)
+	})
+}
+
+// UpdateValueDateColumn sets the "value_date_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertBulk) UpdateValueDateColumn() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateValueDateColumn()
+	})
+}
+
+// ClearValueDateColumn clears the value of the "value_date_column" field.
+func (u *BankStatementProfileUpsertBulk) ClearValueDateColumn() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearValueDateColumn()
+	})
+}
+
+// SetDescriptionColumn sets the "description_column" field.
+func (u *BankStatementProfileUpsertBulk) SetDescriptionColumn(v string) *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetDescriptionColumn(v)
+	})
+}
+
+// UpdateDescriptionColumn sets the "description_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertBulk) UpdateDescriptionColumn() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateDescriptionColumn()
+	})
+}
+
+// ClearDescriptionColumn clears the value of the "description_column" field.
+func (u *BankStatementProfileUpsertBulk) ClearDescriptionColumn() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearDescriptionColumn()
+	})
+}
+
+// SetReferenceColumn sets the "reference_column" field.
+func (u *BankStatementProfileUpsertBulk) SetReferenceColumn(v string) *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetReferenceColumn(v)
+	})
+}
+
+// UpdateReferenceColumn sets the "reference_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertBulk) UpdateReferenceColumn() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateReferenceColumn()
+	})
+}
+
+// ClearReferenceColumn clears the value of the "reference_column" field.
+func (u *BankStatementProfileUpsertBulk) ClearReferenceColumn() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearReferenceColumn()
+	})
+}
+
+// SetCounterpartyColumn sets the "counterparty_column" field.
+func (u *BankStatementProfileUpsertBulk) SetCounterpartyColumn(v string) *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetCounterpartyColumn(v)
+	})
+}
+
+// UpdateCounterpartyColumn sets the "counterparty_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertBulk) UpdateCounterpartyColumn() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateCounterpartyColumn()
+	})
+}
+
+// ClearCounterpartyColumn clears the value of the "counterparty_column" field.
+func (u *BankStatementProfileUpsertBulk) ClearCounterpartyColumn() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearCounterpartyColumn()
+	})
+}
+
+// SetAmountColumn sets the "amount_column" field.
+func (u *BankStatementProfileUpsertBulk) SetAmountColumn(v string) *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetAmountColumn(v)
+	})
+}
+
+// UpdateAmountColumn sets the "amount_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertBulk) UpdateAmountColumn() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateAmountColumn()
+	})
+}
+
+// ClearAmountColumn clears the value of the "amount_column" field.
+func (u *BankStatementProfileUpsertBulk) ClearAmountColumn() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearAmountColumn()
+	})
+}
+
+// SetDebitColumn sets the "debit_column" field.
+func (u *BankStatementProfileUpsertBulk) SetDebitColumn(v string) *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetDebitColumn(v)
+	})
+}
+
+// UpdateDebitColumn sets the "debit_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertBulk) UpdateDebitColumn() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateDebitColumn()
+	})
+}
+
+// ClearDebitColumn clears the value of the "debit_column" field.
+func (u *BankStatementProfileUpsertBulk) ClearDebitColumn() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearDebitColumn()
+	})
+}
+
+// SetCreditColumn sets the "credit_column" field.
+func (u *BankStatementProfileUpsertBulk) SetCreditColumn(v string) *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetCreditColumn(v)
+	})
+}
+
+// UpdateCreditColumn sets the "credit_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertBulk) UpdateCreditColumn() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateCreditColumn()
+	})
+}
+
+// ClearCreditColumn clears the value of the "credit_column" field.
+func (u *BankStatementProfileUpsertBulk) ClearCreditColumn() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearCreditColumn()
+	})
+}
+
+// SetBalanceColumn sets the "balance_column" field.
+func (u *BankStatementProfileUpsertBulk) SetBalanceColumn(v string) *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetBalanceColumn(v)
+	})
+}
+
+// UpdateBalanceColumn sets the "balance_column" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertBulk) UpdateBalanceColumn() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateBalanceColumn()
+	})
+}
+
+// ClearBalanceColumn clears the value of the "balance_column" field.
+func (u *BankStatementProfileUpsertBulk) ClearBalanceColumn() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearBalanceColumn()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *BankStatementProfileUpsertBulk) SetUpdatedBy(v uuid.UUID) *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertBulk) UpdateUpdatedBy() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *BankStatementProfileUpsertBulk) ClearUpdatedBy() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BankStatementProfileUpsertBulk) SetUpdatedAt(v time.Time) *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BankStatementProfileUpsertBulk) UpdateUpdatedAt() *BankStatementProfileUpsertBulk {
+	return u.Update(func(s *BankStatementProfileUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BankStatementProfileUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BankStatementProfileCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BankStatementProfileCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BankStatementProfileUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatementprofile"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+)
+
+// BankStatementProfileDelete is the builder for deleting a BankStatementProfile entity.
+type BankStatementProfileDelete struct {
+	config
+	hooks    []Hook
+	mutation *BankStatementProfileMutation
+}
+
+// Where appends a list predicates to the BankStatementProfileDelete builder.
+func (_d *BankStatementProfileDelete) Where(ps ...predicate.BankStatementProfile) *BankStatementProfileDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BankStatementProfileDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BankStatementProfileDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BankStatementProfileDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bankstatementprofile.Table, sqlgraph.NewFieldSpec(bankstatementprofile.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BankStatementProfileDeleteOne is the builder for deleting a single BankStatementProfile entity.
+type BankStatementProfileDeleteOne struct {
+	_d *BankStatementProfileDelete
+}
+
+// Where appends a list predicates to the BankStatementProfileDelete builder.
+func (_d *BankStatementProfileDeleteOne) Where(ps ...predicate.BankStatementProfile) *BankStatementProfileDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BankStatementProfileDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bankstatementprofile.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BankStatementProfileDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
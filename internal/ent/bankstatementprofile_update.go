@@ -0,0 +1,925 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatementprofile"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// BankStatementProfileUpdate is the builder for updating BankStatementProfile entities.
+type BankStatementProfileUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BankStatementProfileMutation
+}
+
+// Where appends a list predicates to the BankStatementProfileUpdate builder.
+func (_u *BankStatementProfileUpdate) Where(ps ...predicate.BankStatementProfile) *BankStatementProfileUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *BankStatementProfileUpdate) SetTenantID(v uuid.UUID) *BankStatementProfileUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *BankStatementProfileUpdate) SetNillableTenantID(v *uuid.UUID) *BankStatementProfileUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetDelimiter sets the "delimiter" field.
+func (_u *BankStatementProfileUpdate) SetDelimiter(v string) *BankStatementProfileUpdate {
+	_u.mutation.SetDelimiter(v)
+	return _u
+}
+
+// SetNillableDelimiter sets the "delimiter" field if the given value is not nil.
+func (_u *BankStatementProfileUpdate) SetNillableDelimiter(v *string) *BankStatementProfileUpdate {
+	if v != nil {
+		_u.SetDelimiter(*v)
+	}
+	return _u
+}
+
+// SetSkipRows sets the "skip_rows" field.
+func (_u *BankStatementProfileUpdate) SetSkipRows(v int) *BankStatementProfileUpdate {
+	_u.mutation.ResetSkipRows()
+	_u.mutation.SetSkipRows(v)
+	return _u
+}
+
+// SetNillableSkipRows sets the "skip_rows" field if the given value is not nil.
+func (_u *BankStatementProfileUpdate) SetNillableSkipRows(v *int) *BankStatementProfileUpdate {
+	if v != nil {
+		_u.SetSkipRows(*v)
+	}
+	return _u
+}
+
+// AddSkipRows adds value to the "skip_rows" field.
+func (_u *BankStatementProfileUpdate) AddSkipRows(v int) *BankStatementProfileUpdate {
+	_u.mutation.AddSkipRows(v)
+	return _u
+}
+
+// ClearSkipRows clears the value of the "skip_rows" field.
+func (_u *BankStatementProfileUpdate) ClearSkipRows() *BankStatementProfileUpdate {
+	_u.mutation.ClearSkipRows()
+	return _u
+}
+
+// SetDateColumn sets the "date_column" field.
+func (_u *BankStatementProfileUpdate) SetDateColumn(v string) *BankStatementProfileUpdate {
+	_u.mutation.SetDateColumn(v)
+	return _u
+}
+
+// SetNillableDateColumn sets the "date_column" field if the given value is not nil.
+func (_u *BankStatementProfileUpdate) SetNillableDateColumn(v *string) *BankStatementProfileUpdate {
+	if v != nil {
+		_u.SetDateColumn(*v)
+	}
+	return _u
+}
+
+// SetDateFormat sets the "date_format" field.
+func (_u *BankStatementProfileUpdate) SetDateFormat(v string) *BankStatementProfileUpdate {
+	_u.mutation.SetDateFormat(v)
+	return _u
+}
+
+// SetNillableDateFormat sets the "date_format" field if the given value is not nil.
+func (_u *BankStatementProfileUpdate) SetNillableDateFormat(v *string) *BankStatementProfileUpdate {
+	if v != nil {
+		_u.SetDateFormat(*v)
+	}
+	return _u
+}
+
+// SetValueDateColumn sets the "value_date_column" field.
+func (_u *BankStatementProfileUpdate) SetValueDateColumn(v string) *BankStatementProfileUpdate {
+	_u.mutation.SetValueDateColumn(v)
+	return _u
+}
+
+// SetNillableValueDateColumn sets the "value_date_column" field if the given value is not nil.
+func (_u *BankStatementProfileUpdate) SetNillableValueDateColumn(v *string) *BankStatementProfileUpdate {
+	if v != nil {
+		_u.SetValueDateColumn(*v)
+	}
+	return _u
+}
+
+// ClearValueDateColumn clears the value of the "value_date_column" field.
+func (_u *BankStatementProfileUpdate) ClearValueDateColumn() *BankStatementProfileUpdate {
+	_u.mutation.ClearValueDateColumn()
+	return _u
+}
+
+// SetDescriptionColumn sets the "description_column" field.
+func (_u *BankStatementProfileUpdate) SetDescriptionColumn(v string) *BankStatementProfileUpdate {
+	_u.mutation.SetDescriptionColumn(v)
+	return _u
+}
+
+// SetNillableDescriptionColumn sets the "description_column" field if the given value is not nil.
+func (_u *BankStatementProfileUpdate) SetNillableDescriptionColumn(v *string) *BankStatementProfileUpdate {
+	if v != nil {
+		_u.SetDescriptionColumn(*v)
+	}
+	return _u
+}
+
+// ClearDescriptionColumn clears the value of the "description_column" field.
+func (_u *BankStatementProfileUpdate) ClearDescriptionColumn() *BankStatementProfileUpdate {
+	_u.mutation.ClearDescriptionColumn()
+	return _u
+}
+
+// SetReferenceColumn sets the "reference_column" field.
+func (_u *BankStatementProfileUpdate) SetReferenceColumn(v string) *BankStatementProfileUpdate {
+	_u.mutation.SetReferenceColumn(v)
+	return _u
+}
+
+// SetNillableReferenceColumn sets the "reference_column" field if the given value is not nil.
+func (_u *BankStatementProfileUpdate) SetNillableReferenceColumn(v *string) *BankStatementProfileUpdate {
+	if v != nil {
+		_u.SetReferenceColumn(*v)
+	}
+	return _u
+}
+
+// ClearReferenceColumn clears the value of the "reference_column" field.
+func (_u *BankStatementProfileUpdate) ClearReferenceColumn() *BankStatementProfileUpdate {
+	_u.mutation.ClearReferenceColumn()
+	return _u
+}
+
+// SetCounterpartyColumn sets the "counterparty_column" field.
+func (_u *BankStatementProfileUpdate) SetCounterpartyColumn(v string) *BankStatementProfileUpdate {
+	_u.mutation.SetCounterpartyColumn(v)
+	return _u
+}
+
+// SetNillableCounterpartyColumn sets the "counterparty_column" field if the given value is not nil.
+func (_u *BankStatementProfileUpdate) SetNillableCounterpartyColumn(v *string) *BankStatementProfileUpdate {
+	if v != nil {
+		_u.SetCounterpartyColumn(*v)
+	}
+	return _u
+}
+
+// ClearCounterpartyColumn clears the value of the "counterparty_column" field.
+func (_u *BankStatementProfileUpdate) ClearCounterpartyColumn() *BankStatementProfileUpdate {
+	_u.mutation.ClearCounterpartyColumn()
+	return _u
+}
+
+// SetAmountColumn sets the "amount_column" field.
+func (_u *BankStatementProfileUpdate) SetAmountColumn(v string) *BankStatementProfileUpdate {
+	_u.mutation.SetAmountColumn(v)
+	return _u
+}
+
+// SetNillableAmountColumn sets the "amount_column" field if the given value is not nil.
+func (_u *BankStatementProfileUpdate) SetNillableAmountColumn(v *string) *BankStatementProfileUpdate {
+	if v != nil {
+		_u.SetAmountColumn(*v)
+	}
+	return _u
+}
+
+// ClearAmountColumn clears the value of the "amount_column" field.
+func (_u *BankStatementProfileUpdate) ClearAmountColumn() *BankStatementProfileUpdate {
+	_u.mutation.ClearAmountColumn()
+	return _u
+}
+
+// SetDebitColumn sets the "debit_column" field.
+func (_u *BankStatementProfileUpdate) SetDebitColumn(v string) *BankStatementProfileUpdate {
+	_u.mutation.SetDebitColumn(v)
+	return _u
+}
+
+// SetNillableDebitColumn sets the "debit_column" field if the given value is not nil.
+func (_u *BankStatementProfileUpdate) SetNillableDebitColumn(v *string) *BankStatementProfileUpdate {
+	if v != nil {
+		_u.SetDebitColumn(*v)
+	}
+	return _u
+}
+
+// ClearDebitColumn clears the value of the "debit_column" field.
+func (_u *BankStatementProfileUpdate) ClearDebitColumn() *BankStatementProfileUpdate {
+	_u.mutation.ClearDebitColumn()
+	return _u
+}
+
+// SetCreditColumn sets the "credit_column" field.
+func (_u *BankStatementProfileUpdate) SetCreditColumn(v string) *BankStatementProfileUpdate {
+	_u.mutation.SetCreditColumn(v)
+	return _u
+}
+
+// SetNillableCreditColumn sets the "credit_column" field if the given value is not nil.
+func (_u *BankStatementProfileUpdate) SetNillableCreditColumn(v *string) *BankStatementProfileUpdate {
+	if v != nil {
+		_u.SetCreditColumn(*v)
+	}
+	return _u
+}
+
+// ClearCreditColumn clears the value of the "credit_column" field.
+func (_u *BankStatementProfileUpdate) ClearCreditColumn() *BankStatementProfileUpdate {
+	_u.mutation.ClearCreditColumn()
+	return _u
+}
+
+// SetBalanceColumn sets the "balance_column" field.
+func (_u *BankStatementProfileUpdate) SetBalanceColumn(v string) *BankStatementProfileUpdate {
+	_u.mutation.SetBalanceColumn(v)
+	return _u
+}
+
+// SetNillableBalanceColumn sets the "balance_column" field if the given value is not nil.
+func (_u *BankStatementProfileUpdate) SetNillableBalanceColumn(v *string) *BankStatementProfileUpdate {
+	if v != nil {
+		_u.SetBalanceColumn(*v)
+	}
+	return _u
+}
+
+// ClearBalanceColumn clears the value of the "balance_column" field.
+func (_u *BankStatementProfileUpdate) ClearBalanceColumn() *BankStatementProfileUpdate {
+	_u.mutation.ClearBalanceColumn()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *BankStatementProfileUpdate) SetUpdatedBy(v uuid.UUID) *BankStatementProfileUpdate {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *BankStatementProfileUpdate) SetNillableUpdatedBy(v *uuid.UUID) *BankStatementProfileUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *BankStatementProfileUpdate) ClearUpdatedBy() *BankStatementProfileUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BankStatementProfileUpdate) SetUpdatedAt(v time.Time) *BankStatementProfileUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the BankStatementProfileMutation object of the builder.
+func (_u *BankStatementProfileUpdate) Mutation() *BankStatementProfileMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BankStatementProfileUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BankStatementProfileUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BankStatementProfileUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BankStatementProfileUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BankStatementProfileUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := bankstatementprofile.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BankStatementProfileUpdate) check() error {
+	if v, ok := _u.mutation.DateColumn(); ok {
+		if err := bankstatementprofile.DateColumnValidator(v); err != nil {
+			return &ValidationError{Name: "date_column", err: fmt.Errorf(`ent: validator failed for field "BankStatementProfile.date_column": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DateFormat(); ok {
+		if err := bankstatementprofile.DateFormatValidator(v); err != nil {
+			return &ValidationError{Name: "date_format", err: fmt.Errorf(`ent: validator failed for field "BankStatementProfile.date_format": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BankStatementProfileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bankstatementprofile.Table, bankstatementprofile.Columns, sqlgraph.NewFieldSpec(bankstatementprofile.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(bankstatementprofile.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Delimiter(); ok {
+		_spec.SetField(bankstatementprofile.FieldDelimiter, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SkipRows(); ok {
+		_spec.SetField(bankstatementprofile.FieldSkipRows, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSkipRows(); ok {
+		_spec.AddField(bankstatementprofile.FieldSkipRows, field.TypeInt, value)
+	}
+	if _u.mutation.SkipRowsCleared() {
+		_spec.ClearField(bankstatementprofile.FieldSkipRows, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DateColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldDateColumn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DateFormat(); ok {
+		_spec.SetField(bankstatementprofile.FieldDateFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ValueDateColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldValueDateColumn, field.TypeString, value)
+	}
+	if _u.mutation.ValueDateColumnCleared() {
+		_spec.ClearField(bankstatementprofile.FieldValueDateColumn, field.TypeString)
+	}
+	if value, ok := _u.mutation.DescriptionColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldDescriptionColumn, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionColumnCleared() {
+		_spec.ClearField(bankstatementprofile.FieldDescriptionColumn, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferenceColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldReferenceColumn, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceColumnCleared() {
+		_spec.ClearField(bankstatementprofile.FieldReferenceColumn, field.TypeString)
+	}
+	if value, ok := _u.mutation.CounterpartyColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldCounterpartyColumn, field.TypeString, value)
+	}
+	if _u.mutation.CounterpartyColumnCleared() {
+		_spec.ClearField(bankstatementprofile.FieldCounterpartyColumn, field.TypeString)
+	}
+	if value, ok := _u.mutation.AmountColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldAmountColumn, field.TypeString, value)
+	}
+	if _u.mutation.AmountColumnCleared() {
+		_spec.ClearField(bankstatementprofile.FieldAmountColumn, field.TypeString)
+	}
+	if value, ok := _u.mutation.DebitColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldDebitColumn, field.TypeString, value)
+	}
+	if _u.mutation.DebitColumnCleared() {
+		_spec.ClearField(bankstatementprofile.FieldDebitColumn, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreditColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldCreditColumn, field.TypeString, value)
+	}
+	if _u.mutation.CreditColumnCleared() {
+		_spec.ClearField(bankstatementprofile.FieldCreditColumn, field.TypeString)
+	}
+	if value, ok := _u.mutation.BalanceColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldBalanceColumn, field.TypeString, value)
+	}
+	if _u.mutation.BalanceColumnCleared() {
+		_spec.ClearField(bankstatementprofile.FieldBalanceColumn, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(bankstatementprofile.FieldUpdatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(bankstatementprofile.FieldUpdatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(bankstatementprofile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bankstatementprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BankStatementProfileUpdateOne is the builder for updating a single BankStatementProfile entity.
+type BankStatementProfileUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BankStatementProfileMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *BankStatementProfileUpdateOne) SetTenantID(v uuid.UUID) *BankStatementProfileUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *BankStatementProfileUpdateOne) SetNillableTenantID(v *uuid.UUID) *BankStatementProfileUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetDelimiter sets the "delimiter" field.
+func (_u *BankStatementProfileUpdateOne) SetDelimiter(v string) *BankStatementProfileUpdateOne {
+	_u.mutation.SetDelimiter(v)
+	return _u
+}
+
+// SetNillableDelimiter sets the "delimiter" field if the given value is not nil.
+func (_u *BankStatementProfileUpdateOne) SetNillableDelimiter(v *string) *BankStatementProfileUpdateOne {
+	if v != nil {
+		_u.SetDelimiter(*v)
+	}
+	return _u
+}
+
+// SetSkipRows sets the "skip_rows" field.
+func (_u *BankStatementProfileUpdateOne) SetSkipRows(v int) *BankStatementProfileUpdateOne {
+	_u.mutation.ResetSkipRows()
+	_u.mutation.SetSkipRows(v)
+	return _u
+}
+
+// SetNillableSkipRows sets the "skip_rows" field if the given value is not nil.
+func (_u *BankStatementProfileUpdateOne) SetNillableSkipRows(v *int) *BankStatementProfileUpdateOne {
+	if v != nil {
+		_u.SetSkipRows(*v)
+	}
+	return _u
+}
+
+// AddSkipRows adds value to the "skip_rows" field.
+func (_u *BankStatementProfileUpdateOne) AddSkipRows(v int) *BankStatementProfileUpdateOne {
+	_u.mutation.AddSkipRows(v)
+	return _u
+}
+
+// ClearSkipRows clears the value of the "skip_rows" field.
+func (_u *BankStatementProfileUpdateOne) ClearSkipRows() *BankStatementProfileUpdateOne {
+	_u.mutation.ClearSkipRows()
+	return _u
+}
+
+// SetDateColumn sets the "date_column" field.
+func (_u *BankStatementProfileUpdateOne) SetDateColumn(v string) *BankStatementProfileUpdateOne {
+	_u.mutation.SetDateColumn(v)
+	return _u
+}
+
+// SetNillableDateColumn sets the "date_column" field if the given value is not nil.
+func (_u *BankStatementProfileUpdateOne) SetNillableDateColumn(v *string) *BankStatementProfileUpdateOne {
+	if v != nil {
+		_u.SetDateColumn(*v)
+	}
+	return _u
+}
+
+// SetDateFormat sets the "date_format" field.
+func (_u *BankStatementProfileUpdateOne) SetDateFormat(v string) *BankStatementProfileUpdateOne {
+	_u.mutation.SetDateFormat(v)
+	return _u
+}
+
+// SetNillableDateFormat sets the "date_format" field if the given value is not nil.
+func (_u *BankStatementProfileUpdateOne) SetNillableDateFormat(v *string) *BankStatementProfileUpdateOne {
+	if v != nil {
+		_u.SetDateFormat(*v)
+	}
+	return _u
+}
+
+// SetValueDateColumn sets the "value_date_column" field.
+func (_u *BankStatementProfileUpdateOne) SetValueDateColumn(v string) *BankStatementProfileUpdateOne {
+	_u.mutation.SetValueDateColumn(v)
+	return _u
+}
+
+// SetNillableValueDateColumn sets the "value_date_column" field if the given value is not nil.
+func (_u *BankStatementProfileUpdateOne) SetNillableValueDateColumn(v *string) *BankStatementProfileUpdateOne {
+	if v != nil {
+		_u.SetValueDateColumn(*v)
+	}
+	return _u
+}
+
+// ClearValueDateColumn clears the value of the "value_date_column" field.
+func (_u *BankStatementProfileUpdateOne) ClearValueDateColumn() *BankStatementProfileUpdateOne {
+	_u.mutation.ClearValueDateColumn()
+	return _u
+}
+
+// SetDescriptionColumn sets the "description_column" field.
+func (_u *BankStatementProfileUpdateOne) SetDescriptionColumn(v string) *BankStatementProfileUpdateOne {
+	_u.mutation.SetDescriptionColumn(v)
+	return _u
+}
+
+// SetNillableDescriptionColumn sets the "description_column" field if the given value is not nil.
+func (_u *BankStatementProfileUpdateOne) SetNillableDescriptionColumn(v *string) *BankStatementProfileUpdateOne {
+	if v != nil {
+		_u.SetDescriptionColumn(*v)
+	}
+	return _u
+}
+
+// ClearDescriptionColumn clears the value of the "description_column" field.
+func (_u *BankStatementProfileUpdateOne) ClearDescriptionColumn() *BankStatementProfileUpdateOne {
+	_u.mutation.ClearDescriptionColumn()
+	return _u
+}
+
+// SetReferenceColumn sets the "reference_column" field.
+func (_u *BankStatementProfileUpdateOne) SetReferenceColumn(v string) *BankStatementProfileUpdateOne {
+	_u.mutation.SetReferenceColumn(v)
+	return _u
+}
+
+// SetNillableReferenceColumn sets the "reference_column" field if the given value is not nil.
+func (_u *BankStatementProfileUpdateOne) SetNillableReferenceColumn(v *string) *BankStatementProfileUpdateOne {
+	if v != nil {
+		_u.SetReferenceColumn(*v)
+	}
+	return _u
+}
+
+// ClearReferenceColumn clears the value of the "reference_column" field.
+func (_u *BankStatementProfileUpdateOne) ClearReferenceColumn() *BankStatementProfileUpdateOne {
+	_u.mutation.ClearReferenceColumn()
+	return _u
+}
+
+// SetCounterpartyColumn sets the "counterparty_column" field.
+func (_u *BankStatementProfileUpdateOne) SetCounterpartyColumn(v string) *BankStatementProfileUpdateOne {
+	_u.mutation.SetCounterpartyColumn(v)
+	return _u
+}
+
+// SetNillableCounterpartyColumn sets the "counterparty_column" field if the given value is not nil.
+func (_u *BankStatementProfileUpdateOne) SetNillableCounterpartyColumn(v *string) *BankStatementProfileUpdateOne {
+	if v != nil {
+		_u.SetCounterpartyColumn(*v)
+	}
+	return _u
+}
+
+// ClearCounterpartyColumn clears the value of the "counterparty_column" field.
+func (_u *BankStatementProfileUpdateOne) ClearCounterpartyColumn() *BankStatementProfileUpdateOne {
+	_u.mutation.ClearCounterpartyColumn()
+	return _u
+}
+
+// SetAmountColumn sets the "amount_column" field.
+func (_u *BankStatementProfileUpdateOne) SetAmountColumn(v string) *BankStatementProfileUpdateOne {
+	_u.mutation.SetAmountColumn(v)
+	return _u
+}
+
+// SetNillableAmountColumn sets the "amount_column" field if the given value is not nil.
+func (_u *BankStatementProfileUpdateOne) SetNillableAmountColumn(v *string) *BankStatementProfileUpdateOne {
+	if v != nil {
+		_u.SetAmountColumn(*v)
+	}
+	return _u
+}
+
+// ClearAmountColumn clears the value of the "amount_column" field.
+func (_u *BankStatementProfileUpdateOne) ClearAmountColumn() *BankStatementProfileUpdateOne {
+	_u.mutation.ClearAmountColumn()
+	return _u
+}
+
+// SetDebitColumn sets the "debit_column" field.
+func (_u *BankStatementProfileUpdateOne) SetDebitColumn(v string) *BankStatementProfileUpdateOne {
+	_u.mutation.SetDebitColumn(v)
+	return _u
+}
+
+// SetNillableDebitColumn sets the "debit_column" field if the given value is not nil.
+func (_u *BankStatementProfileUpdateOne) SetNillableDebitColumn(v *string) *BankStatementProfileUpdateOne {
+	if v != nil {
+		_u.SetDebitColumn(*v)
+	}
+	return _u
+}
+
+// ClearDebitColumn clears the value of the "debit_column" field.
+func (_u *BankStatementProfileUpdateOne) ClearDebitColumn() *BankStatementProfileUpdateOne {
+	_u.mutation.ClearDebitColumn()
+	return _u
+}
+
+// SetCreditColumn sets the "credit_column" field.
+func (_u *BankStatementProfileUpdateOne) SetCreditColumn(v string) *BankStatementProfileUpdateOne {
+	_u.mutation.SetCreditColumn(v)
+	return _u
+}
+
+// SetNillableCreditColumn sets the "credit_column" field if the given value is not nil.
+func (_u *BankStatementProfileUpdateOne) SetNillableCreditColumn(v *string) *BankStatementProfileUpdateOne {
+	if v != nil {
+		_u.SetCreditColumn(*v)
+	}
+	return _u
+}
+
+// ClearCreditColumn clears the value of the "credit_column" field.
+func (_u *BankStatementProfileUpdateOne) ClearCreditColumn() *BankStatementProfileUpdateOne {
+	_u.mutation.ClearCreditColumn()
+	return _u
+}
+
+// SetBalanceColumn sets the "balance_column" field.
+func (_u *BankStatementProfileUpdateOne) SetBalanceColumn(v string) *BankStatementProfileUpdateOne {
+	_u.mutation.SetBalanceColumn(v)
+	return _u
+}
+
+// SetNillableBalanceColumn sets the "balance_column" field if the given value is not nil.
+func (_u *BankStatementProfileUpdateOne) SetNillableBalanceColumn(v *string) *BankStatementProfileUpdateOne {
+	if v != nil {
+		_u.SetBalanceColumn(*v)
+	}
+	return _u
+}
+
+// ClearBalanceColumn clears the value of the "balance_column" field.
+func (_u *BankStatementProfileUpdateOne) ClearBalanceColumn() *BankStatementProfileUpdateOne {
+	_u.mutation.ClearBalanceColumn()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *BankStatementProfileUpdateOne) SetUpdatedBy(v uuid.UUID) *BankStatementProfileUpdateOne {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *BankStatementProfileUpdateOne) SetNillableUpdatedBy(v *uuid.UUID) *BankStatementProfileUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *BankStatementProfileUpdateOne) ClearUpdatedBy() *BankStatementProfileUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BankStatementProfileUpdateOne) SetUpdatedAt(v time.Time) *BankStatementProfileUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the BankStatementProfileMutation object of the builder.
+func (_u *BankStatementProfileUpdateOne) Mutation() *BankStatementProfileMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BankStatementProfileUpdate builder.
+func (_u *BankStatementProfileUpdateOne) Where(ps ...predicate.BankStatementProfile) *BankStatementProfileUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BankStatementProfileUpdateOne) Select(field string, fields ...string) *BankStatementProfileUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BankStatementProfile entity.
+func (_u *BankStatementProfileUpdateOne) Save(ctx context.Context) (*BankStatementProfile, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BankStatementProfileUpdateOne) SaveX(ctx context.Context) *BankStatementProfile {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BankStatementProfileUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BankStatementProfileUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BankStatementProfileUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := bankstatementprofile.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BankStatementProfileUpdateOne) check() error {
+	if v, ok := _u.mutation.DateColumn(); ok {
+		if err := bankstatementprofile.DateColumnValidator(v); err != nil {
+			return &ValidationError{Name: "date_column", err: fmt.Errorf(`ent: validator failed for field "BankStatementProfile.date_column": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DateFormat(); ok {
+		if err := bankstatementprofile.DateFormatValidator(v); err != nil {
+			return &ValidationError{Name: "date_format", err: fmt.Errorf(`ent: validator failed for field "BankStatementProfile.date_format": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BankStatementProfileUpdateOne) sqlSave(ctx context.Context) (_node *BankStatementProfile, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bankstatementprofile.Table, bankstatementprofile.Columns, sqlgraph.NewFieldSpec(bankstatementprofile.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BankStatementProfile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bankstatementprofile.FieldID)
+		for _, f := range fields {
+			if !bankstatementprofile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bankstatementprofile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(bankstatementprofile.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Delimiter(); ok {
+		_spec.SetField(bankstatementprofile.FieldDelimiter, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SkipRows(); ok {
+		_spec.SetField(bankstatementprofile.FieldSkipRows, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSkipRows(); ok {
+		_spec.AddField(bankstatementprofile.FieldSkipRows, field.TypeInt, value)
+	}
+	if _u.mutation.SkipRowsCleared() {
+		_spec.ClearField(bankstatementprofile.FieldSkipRows, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DateColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldDateColumn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DateFormat(); ok {
+		_spec.SetField(bankstatementprofile.FieldDateFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ValueDateColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldValueDateColumn, field.TypeString, value)
+	}
+	if _u.mutation.ValueDateColumnCleared() {
+		_spec.ClearField(bankstatementprofile.FieldValueDateColumn, field.TypeString)
+	}
+	if value, ok := _u.mutation.DescriptionColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldDescriptionColumn, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionColumnCleared() {
+		_spec.ClearField(bankstatementprofile.FieldDescriptionColumn, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferenceColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldReferenceColumn, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceColumnCleared() {
+		_spec.ClearField(bankstatementprofile.FieldReferenceColumn, field.TypeString)
+	}
+	if value, ok := _u.mutation.CounterpartyColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldCounterpartyColumn, field.TypeString, value)
+	}
+	if _u.mutation.CounterpartyColumnCleared() {
+		_spec.ClearField(bankstatementprofile.FieldCounterpartyColumn, field.TypeString)
+	}
+	if value, ok := _u.mutation.AmountColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldAmountColumn, field.TypeString, value)
+	}
+	if _u.mutation.AmountColumnCleared() {
+		_spec.ClearField(bankstatementprofile.FieldAmountColumn, field.TypeString)
+	}
+	if value, ok := _u.mutation.DebitColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldDebitColumn, field.TypeString, value)
+	}
+	if _u.mutation.DebitColumnCleared() {
+		_spec.ClearField(bankstatementprofile.FieldDebitColumn, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreditColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldCreditColumn, field.TypeString, value)
+	}
+	if _u.mutation.CreditColumnCleared() {
+		_spec.ClearField(bankstatementprofile.FieldCreditColumn, field.TypeString)
+	}
+	if value, ok := _u.mutation.BalanceColumn(); ok {
+		_spec.SetField(bankstatementprofile.FieldBalanceColumn, field.TypeString, value)
+	}
+	if _u.mutation.BalanceColumnCleared() {
+		_spec.ClearField(bankstatementprofile.FieldBalanceColumn, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(bankstatementprofile.FieldUpdatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(bankstatementprofile.FieldUpdatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(bankstatementprofile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &BankStatementProfile{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bankstatementprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
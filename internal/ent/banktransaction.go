@@ -0,0 +1,333 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatement"
+	"github.com/bengobox/treasury-api/internal/ent/banktransaction"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BankTransaction is the model entity for the BankTransaction schema.
+type BankTransaction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Bank account identifier
+	BankAccountID uuid.UUID `json:"bank_account_id,omitempty"`
+	// Statement the transaction was first imported from
+	StatementID uuid.UUID `json:"statement_id,omitempty"`
+	// Booking date
+	TransactionDate time.Time `json:"transaction_date,omitempty"`
+	// ValueDate holds the value of the "value_date" field.
+	ValueDate *time.Time `json:"value_date,omitempty"`
+	// Signed amount: positive for money in, negative for money out
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// ISO currency code
+	Currency string `json:"currency,omitempty"`
+	// Transaction type: credit, debit
+	TransactionType string `json:"transaction_type,omitempty"`
+	// Bank or payment reference
+	Reference string `json:"reference,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Payer or payee named on the statement
+	Counterparty string `json:"counterparty,omitempty"`
+	// Account balance after the transaction, when the statement shows it
+	RunningBalance *decimal.Decimal `json:"running_balance,omitempty"`
+	// SHA-256 of date, amount, reference and occurrence used to skip overlapping imports
+	DedupeHash string `json:"dedupe_hash,omitempty"`
+	// Payment transaction whose provider reference matches the line, linked on import
+	PaymentTransactionID *uuid.UUID `json:"payment_transaction_id,omitempty"`
+	// Status: unreconciled, matched, reconciled
+	ReconciliationStatus string `json:"reconciliation_status,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BankTransactionQuery when eager-loading is set.
+	Edges        BankTransactionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BankTransactionEdges holds the relations/edges for other nodes in the graph.
+type BankTransactionEdges struct {
+	// Statement holds the value of the statement edge.
+	Statement *BankStatement `json:"statement,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// StatementOrErr returns the Statement value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BankTransactionEdges) StatementOrErr() (*BankStatement, error) {
+	if e.Statement != nil {
+		return e.Statement, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: bankstatement.Label}
+	}
+	return nil, &NotLoadedError{edge: "statement"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BankTransaction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case banktransaction.FieldRunningBalance:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case banktransaction.FieldPaymentTransactionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case banktransaction.FieldMetadata:
+			values[i] = new([]byte)
+		case banktransaction.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case banktransaction.FieldCurrency, banktransaction.FieldTransactionType, banktransaction.FieldReference, banktransaction.FieldDescription, banktransaction.FieldCounterparty, banktransaction.FieldDedupeHash, banktransaction.FieldReconciliationStatus:
+			values[i] = new(sql.NullString)
+		case banktransaction.FieldTransactionDate, banktransaction.FieldValueDate, banktransaction.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case banktransaction.FieldID, banktransaction.FieldTenantID, banktransaction.FieldBankAccountID, banktransaction.FieldStatementID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BankTransaction fields.
+func (_m *BankTransaction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case banktransaction.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case banktransaction.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case banktransaction.FieldBankAccountID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field bank_account_id", values[i])
+			} else if value != nil {
+				_m.BankAccountID = *value
+			}
+		case banktransaction.FieldStatementID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field statement_id", values[i])
+			} else if value != nil {
+				_m.StatementID = *value
+			}
+		case banktransaction.FieldTransactionDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_date", values[i])
+			} else if value.Valid {
+				_m.TransactionDate = value.Time
+			}
+		case banktransaction.FieldValueDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field value_date", values[i])
+			} else if value.Valid {
+				_m.ValueDate = new(time.Time)
+				*_m.ValueDate = value.Time
+			}
+		case banktransaction.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case banktransaction.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case banktransaction.FieldTransactionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_type", values[i])
+			} else if value.Valid {
+				_m.TransactionType = value.String
+			}
+		case banktransaction.FieldReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference", values[i])
+			} else if value.Valid {
+				_m.Reference = value.String
+			}
+		case banktransaction.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case banktransaction.FieldCounterparty:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field counterparty", values[i])
+			} else if value.Valid {
+				_m.Counterparty = value.String
+			}
+		case banktransaction.FieldRunningBalance:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field running_balance", values[i])
+			} else if value.Valid {
+				_m.RunningBalance = new(decimal.Decimal)
+				*_m.RunningBalance = *value.S.(*decimal.Decimal)
+			}
+		case banktransaction.FieldDedupeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dedupe_hash", values[i])
+			} else if value.Valid {
+				_m.DedupeHash = value.String
+			}
+		case banktransaction.FieldPaymentTransactionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_transaction_id", values[i])
+			} else if value.Valid {
+				_m.PaymentTransactionID = new(uuid.UUID)
+				*_m.PaymentTransactionID = *value.S.(*uuid.UUID)
+			}
+		case banktransaction.FieldReconciliationStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reconciliation_status", values[i])
+			} else if value.Valid {
+				_m.ReconciliationStatus = value.String
+			}
+		case banktransaction.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case banktransaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BankTransaction.
+// This includes values selected through modifiers, order, etc.
+func (_m *BankTransaction) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryStatement queries the "statement" edge of the BankTransaction entity.
+func (_m *BankTransaction) QueryStatement() *BankStatementQuery {
+	return NewBankTransactionClient(_m.config).QueryStatement(_m)
+}
+
+// Update returns a builder for updating this BankTransaction.
+// Note that you need to call BankTransaction.Unwrap() before calling this method if this BankTransaction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BankTransaction) Update() *BankTransactionUpdateOne {
+	return NewBankTransactionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BankTransaction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BankTransaction) Unwrap() *BankTransaction {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BankTransaction is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BankTransaction) String() string {
+	var builder strings.Builder
+	builder.WriteString("BankTransaction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("bank_account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BankAccountID))
+	builder.WriteString(", ")
+	builder.WriteString("statement_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StatementID))
+	builder.WriteString(", ")
+	builder.WriteString("transaction_date=")
+	builder.WriteString(_m.TransactionDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValueDate; v != nil {
+		builder.WriteString("value_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("transaction_type=")
+	builder.WriteString(_m.TransactionType)
+	builder.WriteString(", ")
+	builder.WriteString("reference=")
+	builder.WriteString(_m.Reference)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("counterparty=")
+	builder.WriteString(_m.Counterparty)
+	builder.WriteString(", ")
+	if v := _m.RunningBalance; v != nil {
+		builder.WriteString("running_balance=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("dedupe_hash=")
+	builder.WriteString(_m.DedupeHash)
+	builder.WriteString(", ")
+	if v := _m.PaymentTransactionID; v != nil {
+		builder.WriteString("payment_transaction_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reconciliation_status=")
+	builder.WriteString(_m.ReconciliationStatus)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BankTransactions is a parsable slice of BankTransaction.
+type BankTransactions []*BankTransaction
@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package banktransaction
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the banktransaction type in the database.
+	Label = "bank_transaction"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldBankAccountID holds the string denoting the bank_account_id field in the database.
+	FieldBankAccountID = "bank_account_id"
+	// FieldStatementID holds the string denoting the statement_id field in the database.
+	FieldStatementID = "statement_id"
+	// FieldTransactionDate holds the string denoting the transaction_date field in the database.
+	FieldTransactionDate = "transaction_date"
+	// FieldValueDate holds the string denoting the value_date field in the database.
+	FieldValueDate = "value_date"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldTransactionType holds the string denoting the transaction_type field in the database.
+	FieldTransactionType = "transaction_type"
+	// FieldReference holds the string denoting the reference field in the database.
+	FieldReference = "reference"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldCounterparty holds the string denoting the counterparty field in the database.
+	FieldCounterparty = "counterparty"
+	// FieldRunningBalance holds the string denoting the running_balance field in the database.
+	FieldRunningBalance = "running_balance"
+	// FieldDedupeHash holds the string denoting the dedupe_hash field in the database.
+	FieldDedupeHash = "dedupe_hash"
+	// FieldPaymentTransactionID holds the string denoting the payment_transaction_id field in the database.
+	FieldPaymentTransactionID = "payment_transaction_id"
+	// FieldReconciliationStatus holds the string denoting the reconciliation_status field in the database.
+	FieldReconciliationStatus = "reconciliation_status"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeStatement holds the string denoting the statement edge name in mutations.
+	EdgeStatement = "statement"
+	// Table holds the table name of the banktransaction in the database.
+	Table = "bank_transactions"
+	// StatementTable is the table that holds the statement relation/edge.
+	StatementTable = "bank_transactions"
+	// StatementInverseTable is the table name for the BankStatement entity.
+	// It exists in this package in order to avoid circular dependency with the "bankstatement" package.
+	StatementInverseTable = "bank_statements"
+	// StatementColumn is the table column denoting the statement relation/edge.
+	StatementColumn = "statement_id"
+)
+
+// Columns holds all SQL columns for banktransaction fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldBankAccountID,
+	FieldStatementID,
+	FieldTransactionDate,
+	FieldValueDate,
+	FieldAmount,
+	FieldCurrency,
+	FieldTransactionType,
+	FieldReference,
+	FieldDescription,
+	FieldCounterparty,
+	FieldRunningBalance,
+	FieldDedupeHash,
+	FieldPaymentTransactionID,
+	FieldReconciliationStatus,
+	FieldMetadata,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultReconciliationStatus holds the default value on creation for the "reconciliation_status" field.
+	DefaultReconciliationStatus string
+	// DefaultMetadata holds the default value on creation for the "metadata" field.
+	DefaultMetadata map[string]interface{}
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the BankTransaction queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByBankAccountID orders the results by the bank_account_id field.
+func ByBankAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBankAccountID, opts...).ToFunc()
+}
+
+// ByStatementID orders the results by the statement_id field.
+func ByStatementID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatementID, opts...).ToFunc()
+}
+
+// ByTransactionDate orders the results by the transaction_date field.
+func ByTransactionDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransactionDate, opts...).ToFunc()
+}
+
+// ByValueDate orders the results by the value_date field.
+func ByValueDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValueDate, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByTransactionType orders the results by the transaction_type field.
+func ByTransactionType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransactionType, opts...).ToFunc()
+}
+
+// ByReference orders the results by the reference field.
+func ByReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReference, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByCounterparty orders the results by the counterparty field.
+func ByCounterparty(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCounterparty, opts...).ToFunc()
+}
+
+// ByRunningBalance orders the results by the running_balance field.
+func ByRunningBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunningBalance, opts...).ToFunc()
+}
+
+// ByDedupeHash orders the results by the dedupe_hash field.
+func ByDedupeHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDedupeHash, opts...).ToFunc()
+}
+
+// ByPaymentTransactionID orders the results by the payment_transaction_id field.
+func ByPaymentTransactionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentTransactionID, opts...).ToFunc()
+}
+
+// ByReconciliationStatus orders the results by the reconciliation_status field.
+func ByReconciliationStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReconciliationStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByStatementField orders the results by statement field.
+func ByStatementField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatementStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newStatementStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatementInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, StatementTable, StatementColumn),
+	)
+}
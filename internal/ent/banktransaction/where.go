@@ -0,0 +1,1031 @@
+// Code generated by ent, DO NOT EDIT.
+
+package banktransaction
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldTenantID, v))
+}
+
+// BankAccountID applies equality check predicate on the "bank_account_id" field. It's identical to BankAccountIDEQ.
+func BankAccountID(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldBankAccountID, v))
+}
+
+// StatementID applies equality check predicate on the "statement_id" field. It's identical to StatementIDEQ.
+func StatementID(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldStatementID, v))
+}
+
+// TransactionDate applies equality check predicate on the "transaction_date" field. It's identical to TransactionDateEQ.
+func TransactionDate(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldTransactionDate, v))
+}
+
+// ValueDate applies equality check predicate on the "value_date" field. It's identical to ValueDateEQ.
+func ValueDate(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldValueDate, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldAmount, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldCurrency, v))
+}
+
+// TransactionType applies equality check predicate on the "transaction_type" field. It's identical to TransactionTypeEQ.
+func TransactionType(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldTransactionType, v))
+}
+
+// Reference applies equality check predicate on the "reference" field. It's identical to ReferenceEQ.
+func Reference(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldReference, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldDescription, v))
+}
+
+// Counterparty applies equality check predicate on the "counterparty" field. It's identical to CounterpartyEQ.
+func Counterparty(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldCounterparty, v))
+}
+
+// RunningBalance applies equality check predicate on the "running_balance" field. It's identical to RunningBalanceEQ.
+func RunningBalance(v decimal.Decimal) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldRunningBalance, v))
+}
+
+// DedupeHash applies equality check predicate on the "dedupe_hash" field. It's identical to DedupeHashEQ.
+func DedupeHash(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldDedupeHash, v))
+}
+
+// PaymentTransactionID applies equality check predicate on the "payment_transaction_id" field. It's identical to PaymentTransactionIDEQ.
+func PaymentTransactionID(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldPaymentTransactionID, v))
+}
+
+// ReconciliationStatus applies equality check predicate on the "reconciliation_status" field. It's identical to ReconciliationStatusEQ.
+func ReconciliationStatus(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldReconciliationStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLTE(FieldTenantID, v))
+}
+
+// BankAccountIDEQ applies the EQ predicate on the "bank_account_id" field.
+func BankAccountIDEQ(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldBankAccountID, v))
+}
+
+// BankAccountIDNEQ applies the NEQ predicate on the "bank_account_id" field.
+func BankAccountIDNEQ(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNEQ(FieldBankAccountID, v))
+}
+
+// BankAccountIDIn applies the In predicate on the "bank_account_id" field.
+func BankAccountIDIn(vs ...uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIn(FieldBankAccountID, vs...))
+}
+
+// BankAccountIDNotIn applies the NotIn predicate on the "bank_account_id" field.
+func BankAccountIDNotIn(vs ...uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotIn(FieldBankAccountID, vs...))
+}
+
+// BankAccountIDGT applies the GT predicate on the "bank_account_id" field.
+func BankAccountIDGT(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGT(FieldBankAccountID, v))
+}
+
+// BankAccountIDGTE applies the GTE predicate on the "bank_account_id" field.
+func BankAccountIDGTE(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGTE(FieldBankAccountID, v))
+}
+
+// BankAccountIDLT applies the LT predicate on the "bank_account_id" field.
+func BankAccountIDLT(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLT(FieldBankAccountID, v))
+}
+
+// BankAccountIDLTE applies the LTE predicate on the "bank_account_id" field.
+func BankAccountIDLTE(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLTE(FieldBankAccountID, v))
+}
+
+// StatementIDEQ applies the EQ predicate on the "statement_id" field.
+func StatementIDEQ(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldStatementID, v))
+}
+
+// StatementIDNEQ applies the NEQ predicate on the "statement_id" field.
+func StatementIDNEQ(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNEQ(FieldStatementID, v))
+}
+
+// StatementIDIn applies the In predicate on the "statement_id" field.
+func StatementIDIn(vs ...uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIn(FieldStatementID, vs...))
+}
+
+// StatementIDNotIn applies the NotIn predicate on the "statement_id" field.
+func StatementIDNotIn(vs ...uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotIn(FieldStatementID, vs...))
+}
+
+// TransactionDateEQ applies the EQ predicate on the "transaction_date" field.
+func TransactionDateEQ(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldTransactionDate, v))
+}
+
+// TransactionDateNEQ applies the NEQ predicate on the "transaction_date" field.
+func TransactionDateNEQ(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNEQ(FieldTransactionDate, v))
+}
+
+// TransactionDateIn applies the In predicate on the "transaction_date" field.
+func TransactionDateIn(vs ...time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIn(FieldTransactionDate, vs...))
+}
+
+// TransactionDateNotIn applies the NotIn predicate on the "transaction_date" field.
+func TransactionDateNotIn(vs ...time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotIn(FieldTransactionDate, vs...))
+}
+
+// TransactionDateGT applies the GT predicate on the "transaction_date" field.
+func TransactionDateGT(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGT(FieldTransactionDate, v))
+}
+
+// TransactionDateGTE applies the GTE predicate on the "transaction_date" field.
+func TransactionDateGTE(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGTE(FieldTransactionDate, v))
+}
+
+// TransactionDateLT applies the LT predicate on the "transaction_date" field.
+func TransactionDateLT(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLT(FieldTransactionDate, v))
+}
+
+// TransactionDateLTE applies the LTE predicate on the "transaction_date" field.
+func TransactionDateLTE(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLTE(FieldTransactionDate, v))
+}
+
+// ValueDateEQ applies the EQ predicate on the "value_date" field.
+func ValueDateEQ(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldValueDate, v))
+}
+
+// ValueDateNEQ applies the NEQ predicate on the "value_date" field.
+func ValueDateNEQ(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNEQ(FieldValueDate, v))
+}
+
+// ValueDateIn applies the In predicate on the "value_date" field.
+func ValueDateIn(vs ...time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIn(FieldValueDate, vs...))
+}
+
+// ValueDateNotIn applies the NotIn predicate on the "value_date" field.
+func ValueDateNotIn(vs ...time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotIn(FieldValueDate, vs...))
+}
+
+// ValueDateGT applies the GT predicate on the "value_date" field.
+func ValueDateGT(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGT(FieldValueDate, v))
+}
+
+// ValueDateGTE applies the GTE predicate on the "value_date" field.
+func ValueDateGTE(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGTE(FieldValueDate, v))
+}
+
+// ValueDateLT applies the LT predicate on the "value_date" field.
+func ValueDateLT(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLT(FieldValueDate, v))
+}
+
+// ValueDateLTE applies the LTE predicate on the "value_date" field.
+func ValueDateLTE(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLTE(FieldValueDate, v))
+}
+
+// ValueDateIsNil applies the IsNil predicate on the "value_date" field.
+func ValueDateIsNil() predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIsNull(FieldValueDate))
+}
+
+// ValueDateNotNil applies the NotNil predicate on the "value_date" field.
+func ValueDateNotNil() predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotNull(FieldValueDate))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLTE(FieldAmount, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// TransactionTypeEQ applies the EQ predicate on the "transaction_type" field.
+func TransactionTypeEQ(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldTransactionType, v))
+}
+
+// TransactionTypeNEQ applies the NEQ predicate on the "transaction_type" field.
+func TransactionTypeNEQ(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNEQ(FieldTransactionType, v))
+}
+
+// TransactionTypeIn applies the In predicate on the "transaction_type" field.
+func TransactionTypeIn(vs ...string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIn(FieldTransactionType, vs...))
+}
+
+// TransactionTypeNotIn applies the NotIn predicate on the "transaction_type" field.
+func TransactionTypeNotIn(vs ...string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotIn(FieldTransactionType, vs...))
+}
+
+// TransactionTypeGT applies the GT predicate on the "transaction_type" field.
+func TransactionTypeGT(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGT(FieldTransactionType, v))
+}
+
+// TransactionTypeGTE applies the GTE predicate on the "transaction_type" field.
+func TransactionTypeGTE(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGTE(FieldTransactionType, v))
+}
+
+// TransactionTypeLT applies the LT predicate on the "transaction_type" field.
+func TransactionTypeLT(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLT(FieldTransactionType, v))
+}
+
+// TransactionTypeLTE applies the LTE predicate on the "transaction_type" field.
+func TransactionTypeLTE(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLTE(FieldTransactionType, v))
+}
+
+// TransactionTypeContains applies the Contains predicate on the "transaction_type" field.
+func TransactionTypeContains(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldContains(FieldTransactionType, v))
+}
+
+// TransactionTypeHasPrefix applies the HasPrefix predicate on the "transaction_type" field.
+func TransactionTypeHasPrefix(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldHasPrefix(FieldTransactionType, v))
+}
+
+// TransactionTypeHasSuffix applies the HasSuffix predicate on the "transaction_type" field.
+func TransactionTypeHasSuffix(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldHasSuffix(FieldTransactionType, v))
+}
+
+// TransactionTypeEqualFold applies the EqualFold predicate on the "transaction_type" field.
+func TransactionTypeEqualFold(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEqualFold(FieldTransactionType, v))
+}
+
+// TransactionTypeContainsFold applies the ContainsFold predicate on the "transaction_type" field.
+func TransactionTypeContainsFold(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldContainsFold(FieldTransactionType, v))
+}
+
+// ReferenceEQ applies the EQ predicate on the "reference" field.
+func ReferenceEQ(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldReference, v))
+}
+
+// ReferenceNEQ applies the NEQ predicate on the "reference" field.
+func ReferenceNEQ(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNEQ(FieldReference, v))
+}
+
+// ReferenceIn applies the In predicate on the "reference" field.
+func ReferenceIn(vs ...string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIn(FieldReference, vs...))
+}
+
+// ReferenceNotIn applies the NotIn predicate on the "reference" field.
+func ReferenceNotIn(vs ...string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotIn(FieldReference, vs...))
+}
+
+// ReferenceGT applies the GT predicate on the "reference" field.
+func ReferenceGT(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGT(FieldReference, v))
+}
+
+// ReferenceGTE applies the GTE predicate on the "reference" field.
+func ReferenceGTE(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGTE(FieldReference, v))
+}
+
+// ReferenceLT applies the LT predicate on the "reference" field.
+func ReferenceLT(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLT(FieldReference, v))
+}
+
+// ReferenceLTE applies the LTE predicate on the "reference" field.
+func ReferenceLTE(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLTE(FieldReference, v))
+}
+
+// ReferenceContains applies the Contains predicate on the "reference" field.
+func ReferenceContains(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldContains(FieldReference, v))
+}
+
+// ReferenceHasPrefix applies the HasPrefix predicate on the "reference" field.
+func ReferenceHasPrefix(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldHasPrefix(FieldReference, v))
+}
+
+// ReferenceHasSuffix applies the HasSuffix predicate on the "reference" field.
+func ReferenceHasSuffix(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldHasSuffix(FieldReference, v))
+}
+
+// ReferenceIsNil applies the IsNil predicate on the "reference" field.
+func ReferenceIsNil() predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIsNull(FieldReference))
+}
+
+// ReferenceNotNil applies the NotNil predicate on the "reference" field.
+func ReferenceNotNil() predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotNull(FieldReference))
+}
+
+// ReferenceEqualFold applies the EqualFold predicate on the "reference" field.
+func ReferenceEqualFold(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEqualFold(FieldReference, v))
+}
+
+// ReferenceContainsFold applies the ContainsFold predicate on the "reference" field.
+func ReferenceContainsFold(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldContainsFold(FieldReference, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// CounterpartyEQ applies the EQ predicate on the "counterparty" field.
+func CounterpartyEQ(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldCounterparty, v))
+}
+
+// CounterpartyNEQ applies the NEQ predicate on the "counterparty" field.
+func CounterpartyNEQ(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNEQ(FieldCounterparty, v))
+}
+
+// CounterpartyIn applies the In predicate on the "counterparty" field.
+func CounterpartyIn(vs ...string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIn(FieldCounterparty, vs...))
+}
+
+// CounterpartyNotIn applies the NotIn predicate on the "counterparty" field.
+func CounterpartyNotIn(vs ...string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotIn(FieldCounterparty, vs...))
+}
+
+// CounterpartyGT applies the GT predicate on the "counterparty" field.
+func CounterpartyGT(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGT(FieldCounterparty, v))
+}
+
+// CounterpartyGTE applies the GTE predicate on the "counterparty" field.
+func CounterpartyGTE(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGTE(FieldCounterparty, v))
+}
+
+// CounterpartyLT applies the LT predicate on the "counterparty" field.
+func CounterpartyLT(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLT(FieldCounterparty, v))
+}
+
+// CounterpartyLTE applies the LTE predicate on the "counterparty" field.
+func CounterpartyLTE(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLTE(FieldCounterparty, v))
+}
+
+// CounterpartyContains applies the Contains predicate on the "counterparty" field.
+func CounterpartyContains(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldContains(FieldCounterparty, v))
+}
+
+// CounterpartyHasPrefix applies the HasPrefix predicate on the "counterparty" field.
+func CounterpartyHasPrefix(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldHasPrefix(FieldCounterparty, v))
+}
+
+// CounterpartyHasSuffix applies the HasSuffix predicate on the "counterparty" field.
+func CounterpartyHasSuffix(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldHasSuffix(FieldCounterparty, v))
+}
+
+// CounterpartyIsNil applies the IsNil predicate on the "counterparty" field.
+func CounterpartyIsNil() predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIsNull(FieldCounterparty))
+}
+
+// CounterpartyNotNil applies the NotNil predicate on the "counterparty" field.
+func CounterpartyNotNil() predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotNull(FieldCounterparty))
+}
+
+// CounterpartyEqualFold applies the EqualFold predicate on the "counterparty" field.
+func CounterpartyEqualFold(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEqualFold(FieldCounterparty, v))
+}
+
+// CounterpartyContainsFold applies the ContainsFold predicate on the "counterparty" field.
+func CounterpartyContainsFold(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldContainsFold(FieldCounterparty, v))
+}
+
+// RunningBalanceEQ applies the EQ predicate on the "running_balance" field.
+func RunningBalanceEQ(v decimal.Decimal) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldRunningBalance, v))
+}
+
+// RunningBalanceNEQ applies the NEQ predicate on the "running_balance" field.
+func RunningBalanceNEQ(v decimal.Decimal) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNEQ(FieldRunningBalance, v))
+}
+
+// RunningBalanceIn applies the In predicate on the "running_balance" field.
+func RunningBalanceIn(vs ...decimal.Decimal) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIn(FieldRunningBalance, vs...))
+}
+
+// RunningBalanceNotIn applies the NotIn predicate on the "running_balance" field.
+func RunningBalanceNotIn(vs ...decimal.Decimal) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotIn(FieldRunningBalance, vs...))
+}
+
+// RunningBalanceGT applies the GT predicate on the "running_balance" field.
+func RunningBalanceGT(v decimal.Decimal) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGT(FieldRunningBalance, v))
+}
+
+// RunningBalanceGTE applies the GTE predicate on the "running_balance" field.
+func RunningBalanceGTE(v decimal.Decimal) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGTE(FieldRunningBalance, v))
+}
+
+// RunningBalanceLT applies the LT predicate on the "running_balance" field.
+func RunningBalanceLT(v decimal.Decimal) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLT(FieldRunningBalance, v))
+}
+
+// RunningBalanceLTE applies the LTE predicate on the "running_balance" field.
+func RunningBalanceLTE(v decimal.Decimal) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLTE(FieldRunningBalance, v))
+}
+
+// RunningBalanceIsNil applies the IsNil predicate on the "running_balance" field.
+func RunningBalanceIsNil() predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIsNull(FieldRunningBalance))
+}
+
+// RunningBalanceNotNil applies the NotNil predicate on the "running_balance" field.
+func RunningBalanceNotNil() predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotNull(FieldRunningBalance))
+}
+
+// DedupeHashEQ applies the EQ predicate on the "dedupe_hash" field.
+func DedupeHashEQ(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldDedupeHash, v))
+}
+
+// DedupeHashNEQ applies the NEQ predicate on the "dedupe_hash" field.
+func DedupeHashNEQ(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNEQ(FieldDedupeHash, v))
+}
+
+// DedupeHashIn applies the In predicate on the "dedupe_hash" field.
+func DedupeHashIn(vs ...string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIn(FieldDedupeHash, vs...))
+}
+
+// DedupeHashNotIn applies the NotIn predicate on the "dedupe_hash" field.
+func DedupeHashNotIn(vs ...string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotIn(FieldDedupeHash, vs...))
+}
+
+// DedupeHashGT applies the GT predicate on the "dedupe_hash" field.
+func DedupeHashGT(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGT(FieldDedupeHash, v))
+}
+
+// DedupeHashGTE applies the GTE predicate on the "dedupe_hash" field.
+func DedupeHashGTE(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGTE(FieldDedupeHash, v))
+}
+
+// DedupeHashLT applies the LT predicate on the "dedupe_hash" field.
+func DedupeHashLT(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLT(FieldDedupeHash, v))
+}
+
+// DedupeHashLTE applies the LTE predicate on the "dedupe_hash" field.
+func DedupeHashLTE(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLTE(FieldDedupeHash, v))
+}
+
+// DedupeHashContains applies the Contains predicate on the "dedupe_hash" field.
+func DedupeHashContains(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldContains(FieldDedupeHash, v))
+}
+
+// DedupeHashHasPrefix applies the HasPrefix predicate on the "dedupe_hash" field.
+func DedupeHashHasPrefix(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldHasPrefix(FieldDedupeHash, v))
+}
+
+// DedupeHashHasSuffix applies the HasSuffix predicate on the "dedupe_hash" field.
+func DedupeHashHasSuffix(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldHasSuffix(FieldDedupeHash, v))
+}
+
+// DedupeHashEqualFold applies the EqualFold predicate on the "dedupe_hash" field.
+func DedupeHashEqualFold(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEqualFold(FieldDedupeHash, v))
+}
+
+// DedupeHashContainsFold applies the ContainsFold predicate on the "dedupe_hash" field.
+func DedupeHashContainsFold(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldContainsFold(FieldDedupeHash, v))
+}
+
+// PaymentTransactionIDEQ applies the EQ predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDEQ(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldPaymentTransactionID, v))
+}
+
+// PaymentTransactionIDNEQ applies the NEQ predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDNEQ(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNEQ(FieldPaymentTransactionID, v))
+}
+
+// PaymentTransactionIDIn applies the In predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDIn(vs ...uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIn(FieldPaymentTransactionID, vs...))
+}
+
+// PaymentTransactionIDNotIn applies the NotIn predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDNotIn(vs ...uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotIn(FieldPaymentTransactionID, vs...))
+}
+
+// PaymentTransactionIDGT applies the GT predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDGT(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGT(FieldPaymentTransactionID, v))
+}
+
+// PaymentTransactionIDGTE applies the GTE predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDGTE(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGTE(FieldPaymentTransactionID, v))
+}
+
+// PaymentTransactionIDLT applies the LT predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDLT(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLT(FieldPaymentTransactionID, v))
+}
+
+// PaymentTransactionIDLTE applies the LTE predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDLTE(v uuid.UUID) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLTE(FieldPaymentTransactionID, v))
+}
+
+// PaymentTransactionIDIsNil applies the IsNil predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDIsNil() predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIsNull(FieldPaymentTransactionID))
+}
+
+// PaymentTransactionIDNotNil applies the NotNil predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDNotNil() predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotNull(FieldPaymentTransactionID))
+}
+
+// ReconciliationStatusEQ applies the EQ predicate on the "reconciliation_status" field.
+func ReconciliationStatusEQ(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldReconciliationStatus, v))
+}
+
+// ReconciliationStatusNEQ applies the NEQ predicate on the "reconciliation_status" field.
+func ReconciliationStatusNEQ(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNEQ(FieldReconciliationStatus, v))
+}
+
+// ReconciliationStatusIn applies the In predicate on the "reconciliation_status" field.
+func ReconciliationStatusIn(vs ...string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIn(FieldReconciliationStatus, vs...))
+}
+
+// ReconciliationStatusNotIn applies the NotIn predicate on the "reconciliation_status" field.
+func ReconciliationStatusNotIn(vs ...string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotIn(FieldReconciliationStatus, vs...))
+}
+
+// ReconciliationStatusGT applies the GT predicate on the "reconciliation_status" field.
+func ReconciliationStatusGT(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGT(FieldReconciliationStatus, v))
+}
+
+// ReconciliationStatusGTE applies the GTE predicate on the "reconciliation_status" field.
+func ReconciliationStatusGTE(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGTE(FieldReconciliationStatus, v))
+}
+
+// ReconciliationStatusLT applies the LT predicate on the "reconciliation_status" field.
+func ReconciliationStatusLT(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLT(FieldReconciliationStatus, v))
+}
+
+// ReconciliationStatusLTE applies the LTE predicate on the "reconciliation_status" field.
+func ReconciliationStatusLTE(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLTE(FieldReconciliationStatus, v))
+}
+
+// ReconciliationStatusContains applies the Contains predicate on the "reconciliation_status" field.
+func ReconciliationStatusContains(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldContains(FieldReconciliationStatus, v))
+}
+
+// ReconciliationStatusHasPrefix applies the HasPrefix predicate on the "reconciliation_status" field.
+func ReconciliationStatusHasPrefix(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldHasPrefix(FieldReconciliationStatus, v))
+}
+
+// ReconciliationStatusHasSuffix applies the HasSuffix predicate on the "reconciliation_status" field.
+func ReconciliationStatusHasSuffix(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldHasSuffix(FieldReconciliationStatus, v))
+}
+
+// ReconciliationStatusEqualFold applies the EqualFold predicate on the "reconciliation_status" field.
+func ReconciliationStatusEqualFold(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEqualFold(FieldReconciliationStatus, v))
+}
+
+// ReconciliationStatusContainsFold applies the ContainsFold predicate on the "reconciliation_status" field.
+func ReconciliationStatusContainsFold(v string) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldContainsFold(FieldReconciliationStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasStatement applies the HasEdge predicate on the "statement" edge.
+func HasStatement() predicate.BankTransaction {
+	return predicate.BankTransaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, StatementTable, StatementColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatementWith applies the HasEdge predicate on the "statement" edge with a given conditions (other predicates).
+func HasStatementWith(preds ...predicate.BankStatement) predicate.BankTransaction {
+	return predicate.BankTransaction(func(s *sql.Selector) {
+		step := newStatementStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BankTransaction) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BankTransaction) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BankTransaction) predicate.BankTransaction {
+	return predicate.BankTransaction(sql.NotPredicates(p))
+}
@@ -0,0 +1,1576 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatement"
+	"github.com/bengobox/treasury-api/internal/ent/banktransaction"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BankTransactionCreate is the builder for creating a BankTransaction entity.
+type BankTransactionCreate struct {
+	config
+	mutation *BankTransactionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *BankTransactionCreate) SetTenantID(v uuid.UUID) *BankTransactionCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (_c *BankTransactionCreate) SetBankAccountID(v uuid.UUID) *BankTransactionCreate {
+	_c.mutation.SetBankAccountID(v)
+	return _c
+}
+
+// SetStatementID sets the "statement_id" field.
+func (_c *BankTransactionCreate) SetStatementID(v uuid.UUID) *BankTransactionCreate {
+	_c.mutation.SetStatementID(v)
+	return _c
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (_c *BankTransactionCreate) SetTransactionDate(v time.Time) *BankTransactionCreate {
+	_c.mutation.SetTransactionDate(v)
+	return _c
+}
+
+// SetValueDate sets the "value_date" field.
+func (_c *BankTransactionCreate) SetValueDate(v time.Time) *BankTransactionCreate {
+	_c.mutation.SetValueDate(v)
+	return _c
+}
+
+// SetNillableValueDate sets the "value_date" field if the given value is not nil.
+func (_c *BankTransactionCreate) SetNillableValueDate(v *time.Time) *BankTransactionCreate {
+	if v != nil {
+		_c.SetValueDate(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *BankTransactionCreate) SetAmount(v decimal.Decimal) *BankTransactionCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *BankTransactionCreate) SetCurrency(v string) *BankTransactionCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *BankTransactionCreate) SetNillableCurrency(v *string) *BankTransactionCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (_c *BankTransactionCreate) SetTransactionType(v string) *BankTransactionCreate {
+	_c.mutation.SetTransactionType(v)
+	return _c
+}
+
+// SetReference sets the "reference" field.
+func (_c *BankTransactionCreate) SetReference(v string) *BankTransactionCreate {
+	_c.mutation.SetReference(v)
+	return _c
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_c *BankTransactionCreate) SetNillableReference(v *string) *BankTransactionCreate {
+	if v != nil {
+		_c.SetReference(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BankTransactionCreate) SetDescription(v string) *BankTransactionCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BankTransactionCreate) SetNillableDescription(v *string) *BankTransactionCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetCounterparty sets the "counterparty" field.
+func (_c *BankTransactionCreate) SetCounterparty(v string) *BankTransactionCreate {
+	_c.mutation.SetCounterparty(v)
+	return _c
+}
+
+// SetNillableCounterparty sets the "counterparty" field if the given value is not nil.
+func (_c *BankTransactionCreate) SetNillableCounterparty(v *string) *BankTransactionCreate {
+	if v != nil {
+		_c.SetCounterparty(*v)
+	}
+	return _c
+}
+
+// SetRunningBalance sets the "running_balance" field.
+func (_c *BankTransactionCreate) SetRunningBalance(v decimal.Decimal) *BankTransactionCreate {
+	_c.mutation.SetRunningBalance(v)
+	return _c
+}
+
+// SetNillableRunningBalance sets the "running_balance" field if the given value is not nil.
+func (_c *BankTransactionCreate) SetNillableRunningBalance(v *decimal.Decimal) *BankTransactionCreate {
+	if v != nil {
+		_c.SetRunningBalance(*v)
+	}
+	return _c
+}
+
+// SetDedupeHash sets the "dedupe_hash" field.
+func (_c *BankTransactionCreate) SetDedupeHash(v string) *BankTransactionCreate {
+	_c.mutation.SetDedupeHash(v)
+	return _c
+}
+
+// SetPaymentTransactionID sets the "payment_transaction_id" field.
+func (_c *BankTransactionCreate) SetPaymentTransactionID(v uuid.UUID) *BankTransactionCreate {
+	_c.mutation.SetPaymentTransactionID(v)
+	return _c
+}
+
+// SetNillablePaymentTransactionID sets the "payment_transaction_id" field if the given value is not nil.
+func (_c *BankTransactionCreate) SetNillablePaymentTransactionID(v *uuid.UUID) *BankTransactionCreate {
+	if v != nil {
+		_c.SetPaymentTransactionID(*v)
+	}
+	return _c
+}
+
+// SetReconciliationStatus sets the "reconciliation_status" field.
+func (_c *BankTransactionCreate) SetReconciliationStatus(v string) *BankTransactionCreate {
+	_c.mutation.SetReconciliationStatus(v)
+	return _c
+}
+
+// SetNillableReconciliationStatus sets the "reconciliation_status" field if the given value is not nil.
+func (_c *BankTransactionCreate) SetNillableReconciliationStatus(v *string) *BankTransactionCreate {
+	if v != nil {
+		_c.SetReconciliationStatus(*v)
+	}
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *BankTransactionCreate) SetMetadata(v map[string]interface{}) *BankTransactionCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BankTransactionCreate) SetCreatedAt(v time.Time) *BankTransactionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BankTransactionCreate) SetNillableCreatedAt(v *time.Time) *BankTransactionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BankTransactionCreate) SetID(v uuid.UUID) *BankTransactionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BankTransactionCreate) SetNillableID(v *uuid.UUID) *BankTransactionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetStatement sets the "statement" edge to the BankStatement entity.
+func (_c *BankTransactionCreate) SetStatement(v *BankStatement) *BankTransactionCreate {
+	return _c.SetStatementID(v.ID)
+}
+
+// Mutation returns the BankTransactionMutation object of the builder.
+func (_c *BankTransactionCreate) Mutation() *BankTransactionMutation {
+	return _c.mutation
+}
+
+// Save creates the BankTransaction in the database.
+func (_c *BankTransactionCreate) Save(ctx context.Context) (*BankTransaction, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BankTransactionCreate) SaveX(ctx context.Context) *BankTransaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BankTransactionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BankTransactionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BankTransactionCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := banktransaction.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.ReconciliationStatus(); !ok {
+		v := banktransaction.DefaultReconciliationStatus
+		_c.mutation.SetReconciliationStatus(v)
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		v := banktransaction.DefaultMetadata
+		_c.mutation.SetMetadata(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := banktransaction.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := banktransaction.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BankTransactionCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "BankTransaction.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.BankAccountID(); !ok {
+		return &ValidationError{Name: "bank_account_id", err: errors.New(`ent: missing required field "BankTransaction.bank_account_id"`)}
+	}
+	if _, ok := _c.mutation.StatementID(); !ok {
+		return &ValidationError{Name: "statement_id", err: errors.New(`ent: missing required field "BankTransaction.statement_id"`)}
+	}
+	if _, ok := _c.mutation.TransactionDate(); !ok {
+		return &ValidationError{Name: "transaction_date", err: errors.New(`ent: missing required field "BankTransaction.transaction_date"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "BankTransaction.amount"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "BankTransaction.currency"`)}
+	}
+	if _, ok := _c.mutation.TransactionType(); !ok {
+		return &ValidationError{Name: "transaction_type", err: errors.New(`ent: missing required field "BankTransaction.transaction_type"`)}
+	}
+	if _, ok := _c.mutation.DedupeHash(); !ok {
+		return &ValidationError{Name: "dedupe_hash", err: errors.New(`ent: missing required field "BankTransaction.dedupe_hash"`)}
+	}
+	if _, ok := _c.mutation.ReconciliationStatus(); !ok {
+		return &ValidationError{Name: "reconciliation_status", err: errors.New(`ent: missing required field "BankTransaction.reconciliation_status"`)}
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		return &ValidationError{Name: "metadata", err: errors.New(`ent: missing required field "BankTransaction.metadata"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BankTransaction.created_at"`)}
+	}
+	if len(_c.mutation.StatementIDs()) == 0 {
+		return &ValidationError{Name: "statement", err: errors.New(`ent: missing required edge "BankTransaction.statement"`)}
+	}
+	return nil
+}
+
+func (_c *BankTransactionCreate) sqlSave(ctx context.Context) (*BankTransaction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BankTransactionCreate) createSpec() (*BankTransaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BankTransaction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(banktransaction.Table, sqlgraph.NewFieldSpec(banktransaction.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(banktransaction.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.BankAccountID(); ok {
+		_spec.SetField(banktransaction.FieldBankAccountID, field.TypeUUID, value)
+		_node.BankAccountID = value
+	}
+	if value, ok := _c.mutation.TransactionDate(); ok {
+		_spec.SetField(banktransaction.FieldTransactionDate, field.TypeTime, value)
+		_node.TransactionDate = value
+	}
+	if value, ok := _c.mutation.ValueDate(); ok {
+		_spec.SetField(banktransaction.FieldValueDate, field.TypeTime, value)
+		_node.ValueDate = &value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(banktransaction.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(banktransaction.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.TransactionType(); ok {
+		_spec.SetField(banktransaction.FieldTransactionType, field.TypeString, value)
+		_node.TransactionType = value
+	}
+	if value, ok := _c.mutation.Reference(); ok {
+		_spec.SetField(banktransaction.FieldReference, field.TypeString, value)
+		_node.Reference = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(banktransaction.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Counterparty(); ok {
+		_spec.SetField(banktransaction.FieldCounterparty, field.TypeString, value)
+		_node.Counterparty = value
+	}
+	if value, ok := _c.mutation.RunningBalance(); ok {
+		_spec.SetField(banktransaction.FieldRunningBalance, field.TypeFloat64, value)
+		_node.RunningBalance = &value
+	}
+	if value, ok := _c.mutation.DedupeHash(); ok {
+		_spec.SetField(banktransaction.FieldDedupeHash, field.TypeString, value)
+		_node.DedupeHash = value
+	}
+	if value, ok := _c.mutation.PaymentTransactionID(); ok {
+		_spec.SetField(banktransaction.FieldPaymentTransactionID, field.TypeUUID, value)
+		_node.PaymentTransactionID = &value
+	}
+	if value, ok := _c.mutation.ReconciliationStatus(); ok {
+		_spec.SetField(banktransaction.FieldReconciliationStatus, field.TypeString, value)
+		_node.ReconciliationStatus = value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(banktransaction.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(banktransaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.StatementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   banktransaction.StatementTable,
+			Columns: []string{banktransaction.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StatementID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BankTransaction.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BankTransactionUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BankTransactionCreate) OnConflict(opts ...sql.ConflictOption) *BankTransactionUpsertOne {
+	_c.conflict = opts
+	return &BankTransactionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BankTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BankTransactionCreate) OnConflictColumns(columns ...string) *BankTransactionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BankTransactionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BankTransactionUpsertOne is the builder for "upsert"-ing
+	//  one BankTransaction node.
+	BankTransactionUpsertOne struct {
+		create *BankTransactionCreate
+	}
+
+	// BankTransactionUpsert is the "OnConflict" setter.
+	BankTransactionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *BankTransactionUpsert) SetTenantID(v uuid.UUID) *BankTransactionUpsert {
+	u.Set(banktransaction.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *BankTransactionUpsert) UpdateTenantID() *BankTransactionUpsert {
+	u.SetExcluded(banktransaction.FieldTenantID)
+	return u
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (u *BankTransactionUpsert) SetBankAccountID(v uuid.UUID) *BankTransactionUpsert {
+	u.Set(banktransaction.FieldBankAccountID, v)
+	return u
+}
+
+// UpdateBankAccountID sets the "bank_account_id" field to the value that was provided on create.
+func (u *BankTransactionUpsert) UpdateBankAccountID() *BankTransactionUpsert {
+	u.SetExcluded(banktransaction.FieldBankAccountID)
+	return u
+}
+
+// SetStatementID sets the "statement_id" field.
+func (u *BankTransactionUpsert) SetStatementID(v uuid.UUID) *BankTransactionUpsert {
+	u.Set(banktransaction.FieldStatementID, v)
+	return u
+}
+
+// UpdateStatementID sets the "statement_id" field to the value that was provided on create.
+func (u *BankTransactionUpsert) UpdateStatementID() *BankTransactionUpsert {
+	u.SetExcluded(banktransaction.FieldStatementID)
+	return u
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (u *BankTransactionUpsert) SetTransactionDate(v time.Time) *BankTransactionUpsert {
+	u.Set(banktransaction.FieldTransactionDate, v)
+	return u
+}
+
+// UpdateTransactionDate sets the "transaction_date" field to the value that was provided on create.
+func (u *BankTransactionUpsert) UpdateTransactionDate() *BankTransactionUpsert {
+	u.SetExcluded(banktransaction.FieldTransactionDate)
+	return u
+}
+
+// SetValueDate sets the "value_date" field.
+func (u *BankTransactionUpsert) SetValueDate(v time.Time) *BankTransactionUpsert {
+	u.Set(banktransaction.FieldValueDate, v)
+	return u
+}
+
+// UpdateValueDate sets the "value_date" field to the value that was provided on create.
+func (u *BankTransactionUpsert) UpdateValueDate() *BankTransactionUpsert {
+	u.SetExcluded(banktransaction.FieldValueDate)
+	return u
+}
+
+// ClearValueDate clears the value of the "value_date" field.
+func (u *BankTransactionUpsert) ClearValueDate() *BankTransactionUpsert {
+	u.SetNull(banktransaction.FieldValueDate)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *BankTransactionUpsert) SetAmount(v decimal.Decimal) *BankTransactionUpsert {
+	u.Set(banktransaction.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BankTransactionUpsert) UpdateAmount() *BankTransactionUpsert {
+	u.SetExcluded(banktransaction.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BankTransactionUpsert) AddAmount(v decimal.Decimal) *BankTransactionUpsert {
+	u.Add(banktransaction.FieldAmount, v)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *BankTransactionUpsert) SetCurrency(v string) *BankTransactionUpsert {
+	u.Set(banktransaction.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *BankTransactionUpsert) UpdateCurrency() *BankTransactionUpsert {
+	u.SetExcluded(banktransaction.FieldCurrency)
+	return u
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (u *BankTransactionUpsert) SetTransactionType(v string) *BankTransactionUpsert {
+	u.Set(banktransaction.FieldTransactionType, v)
+	return u
+}
+
+// UpdateTransactionType sets the "transaction_type" field to the value that was provided on create.
+func (u *BankTransactionUpsert) UpdateTransactionType() *BankTransactionUpsert {
+	u.SetExcluded(banktransaction.FieldTransactionType)
+	return u
+}
+
+// SetReference sets the "reference" field.
+func (u *BankTransactionUpsert) SetReference(v string) *BankTransactionUpsert {
+	u.Set(banktransaction.FieldReference, v)
+	return u
+}
+
+// UpdateReference sets the "reference" field to the value that was provided on create.
+func (u *BankTransactionUpsert) UpdateReference() *BankTransactionUpsert {
+	u.SetExcluded(banktransaction.FieldReference)
+	return u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (u *BankTransactionUpsert) ClearReference() *BankTransactionUpsert {
+	u.SetNull(banktransaction.FieldReference)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *BankTransactionUpsert) SetDescription(v string) *BankTransactionUpsert {
+	u.Set(banktransaction.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *BankTransactionUpsert) UpdateDescription() *BankTransactionUpsert {
+	u.SetExcluded(banktransaction.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *BankTransactionUpsert) ClearDescription() *BankTransactionUpsert {
+	u.SetNull(banktransaction.FieldDescription)
+	return u
+}
+
+// SetCounterparty sets the "counterparty" field.
+func (u *BankTransactionUpsert) SetCounterparty(v string) *BankTransactionUpsert {
+	u.Set(banktransaction.FieldCounterparty, v)
+	return u
+}
+
+// UpdateCounterparty sets the "counterparty" field to the value that was provided on create.
+func (u *BankTransactionUpsert) UpdateCounterparty() *BankTransactionUpsert {
+	u.SetExcluded(banktransaction.FieldCounterparty)
+	return u
+}
+
+// ClearCounterparty clears the value of the "counterparty" field.
+func (u *BankTransactionUpsert) ClearCounterparty() *BankTransactionUpsert {
+	u.SetNull(banktransaction.FieldCounterparty)
+	return u
+}
+
+// SetRunningBalance sets the "running_balance" field.
+func (u *BankTransactionUpsert) SetRunningBalance(v decimal.Decimal) *BankTransactionUpsert {
+	u.Set(banktransaction.FieldRunningBalance, v)
+	return u
+}
+
+// UpdateRunningBalance sets the "running_balance" field to the value that was provided on create.
+func (u *BankTransactionUpsert) UpdateRunningBalance() *BankTransactionUpsert {
+	u.SetExcluded(banktransaction.FieldRunningBalance)
+	return u
+}
+
+// AddRunningBalance adds v to the "running_balance" field.
+func (u *BankTransactionUpsert) AddRunningBalance(v decimal.Decimal) *BankTransactionUpsert {
+	u.Add(banktransaction.FieldRunningBalance, v)
+	return u
+}
+
+// ClearRunningBalance clears the value of the "running_balance" field.
+func (u *BankTransactionUpsert) ClearRunningBalance() *BankTransactionUpsert {
+	u.SetNull(banktransaction.FieldRunningBalance)
+	return u
+}
+
+// SetDedupeHash sets the "dedupe_hash" field.
+func (u *BankTransactionUpsert) SetDedupeHash(v string) *BankTransactionUpsert {
+	u.Set(banktransaction.FieldDedupeHash, v)
+	return u
+}
+
+// UpdateDedupeHash sets the "dedupe_hash" field to the value that was provided on create.
+func (u *BankTransactionUpsert) UpdateDedupeHash() *BankTransactionUpsert {
+	u.SetExcluded(banktransaction.FieldDedupeHash)
+	return u
+}
+
+// SetPaymentTransactionID sets the "payment_transaction_id" field.
+func (u *BankTransactionUpsert) SetPaymentTransactionID(v uuid.UUID) *BankTransactionUpsert {
+	u.Set(banktransaction.FieldPaymentTransactionID, v)
+	return u
+}
+
+// UpdatePaymentTransactionID sets the "payment_transaction_id" field to the value that was provided on create.
+func (u *BankTransactionUpsert) UpdatePaymentTransactionID() *BankTransactionUpsert {
+	u.SetExcluded(banktransaction.FieldPaymentTransactionID)
+	return u
+}
+
+// ClearPaymentTransactionID clears the value of the "payment_transaction_id" field.
+func (u *BankTransactionUpsert) ClearPaymentTransactionID() *BankTransactionUpsert {
+	u.SetNull(banktransaction.FieldPaymentTransactionID)
+	return u
+}
+
+// SetReconciliationStatus sets the "reconciliation_status" field.
+func (u *BankTransactionUpsert) SetReconciliationStatus(v string) *BankTransactionUpsert {
+	u.Set(banktransaction.FieldReconciliationStatus, v)
+	return u
+}
+
+// UpdateReconciliationStatus sets the "reconciliation_status" field to the value that was provided on create.
+func (u *BankTransactionUpsert) UpdateReconciliationStatus() *BankTransactionUpsert {
+	u.SetExcluded(banktransaction.FieldReconciliationStatus)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *BankTransactionUpsert) SetMetadata(v map[string]interface{}) *BankTransactionUpsert {
+	u.Set(banktransaction.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *BankTransactionUpsert) UpdateMetadata() *BankTransactionUpsert {
+	u.SetExcluded(banktransaction.FieldMetadata)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.BankTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(banktransaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BankTransactionUpsertOne) UpdateNewValues() *BankTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(banktransaction.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(banktransaction.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BankTransaction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BankTransactionUpsertOne) Ignore() *BankTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BankTransactionUpsertOne) DoNothing() *BankTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BankTransactionCreate.OnConflict
+// documentation for more info.
+func (u *BankTransactionUpsertOne) Update(set func(*BankTransactionUpsert)) *BankTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BankTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *BankTransactionUpsertOne) SetTenantID(v uuid.UUID) *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *BankTransactionUpsertOne) UpdateTenantID() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (u *BankTransactionUpsertOne) SetBankAccountID(v uuid.UUID) *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetBankAccountID(v)
+	})
+}
+
+// UpdateBankAccountID sets the "bank_account_id" field to the value that was provided on create.
+func (u *BankTransactionUpsertOne) UpdateBankAccountID() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateBankAccountID()
+	})
+}
+
+// SetStatementID sets the "statement_id" field.
+func (u *BankTransactionUpsertOne) SetStatementID(v uuid.UUID) *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetStatementID(v)
+	})
+}
+
+// UpdateStatementID sets the "statement_id" field to the value that was provided on create.
+func (u *BankTransactionUpsertOne) UpdateStatementID() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateStatementID()
+	})
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (u *BankTransactionUpsertOne) SetTransactionDate(v time.Time) *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetTransactionDate(v)
+	})
+}
+
+// UpdateTransactionDate sets the "transaction_date" field to the value that was provided on create.
+func (u *BankTransactionUpsertOne) UpdateTransactionDate() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateTransactionDate()
+	})
+}
+
+// SetValueDate sets the "value_date" field.
+func (u *BankTransactionUpsertOne) SetValueDate(v time.Time) *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetValueDate(v)
+	})
+}
+
+// UpdateValueDate sets the "value_date" field to the value that was provided on create.
+func (u *BankTransactionUpsertOne) UpdateValueDate() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateValueDate()
+	})
+}
+
+// ClearValueDate clears the value of the "value_date" field.
+func (u *BankTransactionUpsertOne) ClearValueDate() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.ClearValueDate()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BankTransactionUpsertOne) SetAmount(v decimal.Decimal) *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BankTransactionUpsertOne) AddAmount(v decimal.Decimal) *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BankTransactionUpsertOne) UpdateAmount() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *BankTransactionUpsertOne) SetCurrency(v string) *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *BankTransactionUpsertOne) UpdateCurrency() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (u *BankTransactionUpsertOne) SetTransactionType(v string) *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetTransactionType(v)
+	})
+}
+
+// UpdateTransactionType sets the "transaction_type" field to the value that was provided on create.
+func (u *BankTransactionUpsertOne) UpdateTransactionType() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateTransactionType()
+	})
+}
+
+// SetReference sets the "reference" field.
+func (u *BankTransactionUpsertOne) SetReference(v string) *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetReference(v)
+	})
+}
+
+// UpdateReference sets the "reference" field to the value that was provided on create.
+func (u *BankTransactionUpsertOne) UpdateReference() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateReference()
+	})
+}
+
+// ClearReference clears the value of the "reference" field.
+func (u *BankTransactionUpsertOne) ClearReference() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.ClearReference()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *BankTransactionUpsertOne) SetDescription(v string) *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *BankTransactionUpsertOne) UpdateDescription() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *BankTransactionUpsertOne) ClearDescription() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetCounterparty sets the "counterparty" field.
+func (u *BankTransactionUpsertOne) SetCounterparty(v string) *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetCounterparty(v)
+	})
+}
+
+// UpdateCounterparty sets the "counterparty" field to the value that was provided on create.
+func (u *BankTransactionUpsertOne) UpdateCounterparty() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateCounterparty()
+	})
+}
+
+// ClearCounterparty clears the value of the "counterparty" field.
+func (u *BankTransactionUpsertOne) ClearCounterparty() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.ClearCounterparty()
+	})
+}
+
+// SetRunningBalance sets the "running_balance" field.
+func (u *BankTransactionUpsertOne) SetRunningBalance(v decimal.Decimal) *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetRunningBalance(v)
+	})
+}
+
+// AddRunningBalance adds v to the "running_balance" field.
+func (u *BankTransactionUpsertOne) AddRunningBalance(v decimal.Decimal) *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.AddRunningBalance(v)
+	})
+}
+
+// UpdateRunningBalance sets the "running_balance" field to the value that was provided on create.
+func (u *BankTransactionUpsertOne) UpdateRunningBalance() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateRunningBalance()
+	})
+}
+
+// ClearRunningBalance clears the value of the "running_balance" field.
+func (u *BankTransactionUpsertOne) ClearRunningBalance() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.ClearRunningBalance()
+	})
+}
+
+// SetDedupeHash sets the "dedupe_hash" field.
+func (u *BankTransactionUpsertOne) SetDedupeHash(v string) *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetDedupeHash(v)
+	})
+}
+
+// UpdateDedupeHash sets the "dedupe_hash" field to the value that was provided on create.
+func (u *BankTransactionUpsertOne) UpdateDedupeHash() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateDedupeHash()
+	})
+}
+
+// SetPaymentTransactionID sets the "payment_transaction_id" field.
+func (u *BankTransactionUpsertOne) SetPaymentTransactionID(v uuid.UUID) *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetPaymentTransactionID(v)
+	})
+}
+
+// UpdatePaymentTransactionID sets the "payment_transaction_id" field to the value that was provided on create.
+func (u *BankTransactionUpsertOne) UpdatePaymentTransactionID() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdatePaymentTransactionID()
+	})
+}
+
+// ClearPaymentTransactionID clears the value of the "payment_transaction_id" field.
+func (u *BankTransactionUpsertOne) ClearPaymentTransactionID() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.ClearPaymentTransactionID()
+	})
+}
+
+// SetReconciliationStatus sets the "reconciliation_status" field.
+func (u *BankTransactionUpsertOne) SetReconciliationStatus(v string) *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetReconciliationStatus(v)
+	})
+}
+
+// UpdateReconciliationStatus sets the "reconciliation_status" field to the value that was provided on create.
+func (u *BankTransactionUpsertOne) UpdateReconciliationStatus() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateReconciliationStatus()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *BankTransactionUpsertOne) SetMetadata(v map[string]interface{}) *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *BankTransactionUpsertOne) UpdateMetadata() *BankTransactionUpsertOne {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *BankTransactionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BankTransactionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BankTransactionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BankTransactionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BankTransactionUpsertOne.ID is not supported by MySQL driver. Use BankTransactionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BankTransactionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BankTransactionCreateBulk is the builder for creating many BankTransaction entities in bulk.
+type BankTransactionCreateBulk struct {
+	config
+	err      error
+	builders []*BankTransactionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BankTransaction entities in the database.
+func (_c *BankTransactionCreateBulk) Save(ctx context.Context) ([]*BankTransaction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BankTransaction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BankTransactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BankTransactionCreateBulk) SaveX(ctx context.Context) []*BankTransaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BankTransactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BankTransactionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BankTransaction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BankTransactionUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BankTransactionCreateBulk) OnConflict(opts ...sql.ConflictOption) *BankTransactionUpsertBulk {
+	_c.conflict = opts
+	return &BankTransactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BankTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BankTransactionCreateBulk) OnConflictColumns(columns ...string) *BankTransactionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BankTransactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// BankTransactionUpsertBulk is the builder for "upsert"-ing
+// a bulk of BankTransaction nodes.
+type BankTransactionUpsertBulk struct {
+	create *BankTransactionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BankTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(banktransaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BankTransactionUpsertBulk) UpdateNewValues() *BankTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(banktransaction.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(banktransaction.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BankTransaction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BankTransactionUpsertBulk) Ignore() *BankTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BankTransactionUpsertBulk) DoNothing() *BankTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BankTransactionCreateBulk.OnConflict
+// documentation for more info.
+func (u *BankTransactionUpsertBulk) Update(set func(*BankTransactionUpsert)) *BankTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BankTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *BankTransactionUpsertBulk) SetTenantID(v uuid.UUID) *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *BankTransactionUpsertBulk) UpdateTenantID() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (u *BankTransactionUpsertBulk) SetBankAccountID(v uuid.UUID) *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetBankAccountID(v)
+	})
+}
+
+// UpdateBankAccountID sets the "bank_account_id" field to the value that was provided on create.
+func (u *BankTransactionUpsertBulk) UpdateBankAccountID() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateBankAccountID()
+	})
+}
+
+// SetStatementID sets the "statement_id" field.
+func (u *BankTransactionUpsertBulk) SetStatementID(v uuid.UUID) *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetStatementID(v)
+	})
+}
+
+// UpdateStatementID sets the "statement_id" field to the value that was provided on create.
+func (u *BankTransactionUpsertBulk) UpdateStatementID() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateStatementID()
+	})
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (u *BankTransactionUpsertBulk) SetTransactionDate(v time.Time) *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetTransactionDate(v)
+	})
+}
+
+// UpdateTransactionDate sets the "transaction_date" field to the value that was provided on create.
+func (u *BankTransactionUpsertBulk) UpdateTransactionDate() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateTransactionDate()
+	})
+}
+
+// SetValueDate sets the "value_date" field.
+func (u *BankTransactionUpsertBulk) SetValueDate(v time.Time) *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetValueDate(v)
+	})
+}
+
+// UpdateValueDate sets the "value_date" field to the value that was provided on create.
+func (u *BankTransactionUpsertBulk) UpdateValueDate() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateValueDate()
+	})
+}
+
+// ClearValueDate clears the value of the "value_date" field.
+func (u *BankTransactionUpsertBulk) ClearValueDate() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.ClearValueDate()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BankTransactionUpsertBulk) SetAmount(v decimal.Decimal) *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BankTransactionUpsertBulk) AddAmount(v decimal.Decimal) *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BankTransactionUpsertBulk) UpdateAmount() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *BankTransactionUpsertBulk) SetCurrency(v string) *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *BankTransactionUpsertBulk) UpdateCurrency() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (u *BankTransactionUpsertBulk) SetTransactionType(v string) *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetTransactionType(v)
+	})
+}
+
+// UpdateTransactionType sets the "transaction_type" field to the value that was provided on create.
+func (u *BankTransactionUpsertBulk) UpdateTransactionType() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateTransactionType()
+	})
+}
+
+// SetReference sets the "reference" field.
+func (u *BankTransactionUpsertBulk) SetReference(v string) *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetReference(v)
+	})
+}
+
+// UpdateReference sets the "reference" field to the value that was provided on create.
+func (u *BankTransactionUpsertBulk) UpdateReference() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateReference()
+	})
+}
+
+// ClearReference clears the value of the "reference" field.
+func (u *BankTransactionUpsertBulk) ClearReference() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.ClearReference()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *BankTransactionUpsertBulk) SetDescription(v string) *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *BankTransactionUpsertBulk) UpdateDescription() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *BankTransactionUpsertBulk) ClearDescription() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetCounterparty sets the "counterparty" field.
+func (u *BankTransactionUpsertBulk) SetCounterparty(v string) *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetCounterparty(v)
+	})
+}
+
+// UpdateCounterparty sets the "counterparty" field to the value that was provided on create.
+func (u *BankTransactionUpsertBulk) UpdateCounterparty() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateCounterparty()
+	})
+}
+
+// ClearCounterparty clears the value of the "counterparty" field.
+func (u *BankTransactionUpsertBulk) ClearCounterparty() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.ClearCounterparty()
+	})
+}
+
+// SetRunningBalance sets the "running_balance" field.
+func (u *BankTransactionUpsertBulk) SetRunningBalance(v decimal.Decimal) *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetRunningBalance(v)
+	})
+}
+
+// AddRunningBalance adds v to the "running_balance" field.
+func (u *BankTransactionUpsertBulk) AddRunningBalance(v decimal.Decimal) *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.AddRunningBalance(v)
+	})
+}
+
+// UpdateRunningBalance sets the "running_balance" field to the value that was provided on create.
+func (u *BankTransactionUpsertBulk) UpdateRunningBalance() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateRunningBalance()
+	})
+}
+
+// ClearRunningBalance clears the value of the "running_balance" field.
+func (u *BankTransactionUpsertBulk) ClearRunningBalance() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.ClearRunningBalance()
+	})
+}
+
+// SetDedupeHash sets the "dedupe_hash" field.
+func (u *BankTransactionUpsertBulk) SetDedupeHash(v string) *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetDedupeHash(v)
+	})
+}
+
+// UpdateDedupeHash sets the "dedupe_hash" field to the value that was provided on create.
+func (u *BankTransactionUpsertBulk) UpdateDedupeHash() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateDedupeHash()
+	})
+}
+
+// SetPaymentTransactionID sets the "payment_transaction_id" field.
+func (u *BankTransactionUpsertBulk) SetPaymentTransactionID(v uuid.UUID) *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetPaymentTransactionID(v)
+	})
+}
+
+// UpdatePaymentTransactionID sets the "payment_transaction_id" field to the value that was provided on create.
+func (u *BankTransactionUpsertBulk) UpdatePaymentTransactionID() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdatePaymentTransactionID()
+	})
+}
+
+// ClearPaymentTransactionID clears the value of the "payment_transaction_id" field.
+func (u *BankTransactionUpsertBulk) ClearPaymentTransactionID() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.ClearPaymentTransactionID()
+	})
+}
+
+// SetReconciliationStatus sets the "reconciliation_status" field.
+func (u *BankTransactionUpsertBulk) SetReconciliationStatus(v string) *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetReconciliationStatus(v)
+	})
+}
+
+// UpdateReconciliationStatus sets the "reconciliation_status" field to the value that was provided on create.
+func (u *BankTransactionUpsertBulk) UpdateReconciliationStatus() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateReconciliationStatus()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *BankTransactionUpsertBulk) SetMetadata(v map[string]interface{}) *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *BankTransactionUpsertBulk) UpdateMetadata() *BankTransactionUpsertBulk {
+	return u.Update(func(s *BankTransactionUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *BankTransactionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BankTransactionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BankTransactionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BankTransactionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
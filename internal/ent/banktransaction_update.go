@@ -0,0 +1,951 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatement"
+	"github.com/bengobox/treasury-api/internal/ent/banktransaction"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BankTransactionUpdate is the builder for updating BankTransaction entities.
+type BankTransactionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BankTransactionMutation
+}
+
+// Where appends a list predicates to the BankTransactionUpdate builder.
+func (_u *BankTransactionUpdate) Where(ps ...predicate.BankTransaction) *BankTransactionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *BankTransactionUpdate) SetTenantID(v uuid.UUID) *BankTransactionUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *BankTransactionUpdate) SetNillableTenantID(v *uuid.UUID) *BankTransactionUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (_u *BankTransactionUpdate) SetBankAccountID(v uuid.UUID) *BankTransactionUpdate {
+	_u.mutation.SetBankAccountID(v)
+	return _u
+}
+
+// SetNillableBankAccountID sets the "bank_account_id" field if the given value is not nil.
+func (_u *BankTransactionUpdate) SetNillableBankAccountID(v *uuid.UUID) *BankTransactionUpdate {
+	if v != nil {
+		_u.SetBankAccountID(*v)
+	}
+	return _u
+}
+
+// SetStatementID sets the "statement_id" field.
+func (_u *BankTransactionUpdate) SetStatementID(v uuid.UUID) *BankTransactionUpdate {
+	_u.mutation.SetStatementID(v)
+	return _u
+}
+
+// SetNillableStatementID sets the "statement_id" field if the given value is not nil.
+func (_u *BankTransactionUpdate) SetNillableStatementID(v *uuid.UUID) *BankTransactionUpdate {
+	if v != nil {
+		_u.SetStatementID(*v)
+	}
+	return _u
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (_u *BankTransactionUpdate) SetTransactionDate(v time.Time) *BankTransactionUpdate {
+	_u.mutation.SetTransactionDate(v)
+	return _u
+}
+
+// SetNillableTransactionDate sets the "transaction_date" field if the given value is not nil.
+func (_u *BankTransactionUpdate) SetNillableTransactionDate(v *time.Time) *BankTransactionUpdate {
+	if v != nil {
+		_u.SetTransactionDate(*v)
+	}
+	return _u
+}
+
+// SetValueDate sets the "value_date" field.
+func (_u *BankTransactionUpdate) SetValueDate(v time.Time) *BankTransactionUpdate {
+	_u.mutation.SetValueDate(v)
+	return _u
+}
+
+// SetNillableValueDate sets the "value_date" field if the given value is not nil.
+func (_u *BankTransactionUpdate) SetNillableValueDate(v *time.Time) *BankTransactionUpdate {
+	if v != nil {
+		_u.SetValueDate(*v)
+	}
+	return _u
+}
+
+// ClearValueDate clears the value of the "value_date" field.
+func (_u *BankTransactionUpdate) ClearValueDate() *BankTransactionUpdate {
+	_u.mutation.ClearValueDate()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *BankTransactionUpdate) SetAmount(v decimal.Decimal) *BankTransactionUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *BankTransactionUpdate) SetNillableAmount(v *decimal.Decimal) *BankTransactionUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *BankTransactionUpdate) AddAmount(v decimal.Decimal) *BankTransactionUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *BankTransactionUpdate) SetCurrency(v string) *BankTransactionUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *BankTransactionUpdate) SetNillableCurrency(v *string) *BankTransactionUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (_u *BankTransactionUpdate) SetTransactionType(v string) *BankTransactionUpdate {
+	_u.mutation.SetTransactionType(v)
+	return _u
+}
+
+// SetNillableTransactionType sets the "transaction_type" field if the given value is not nil.
+func (_u *BankTransactionUpdate) SetNillableTransactionType(v *string) *BankTransactionUpdate {
+	if v != nil {
+		_u.SetTransactionType(*v)
+	}
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *BankTransactionUpdate) SetReference(v string) *BankTransactionUpdate {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *BankTransactionUpdate) SetNillableReference(v *string) *BankTransactionUpdate {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *BankTransactionUpdate) ClearReference() *BankTransactionUpdate {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BankTransactionUpdate) SetDescription(v string) *BankTransactionUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BankTransactionUpdate) SetNillableDescription(v *string) *BankTransactionUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BankTransactionUpdate) ClearDescription() *BankTransactionUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetCounterparty sets the "counterparty" field.
+func (_u *BankTransactionUpdate) SetCounterparty(v string) *BankTransactionUpdate {
+	_u.mutation.SetCounterparty(v)
+	return _u
+}
+
+// SetNillableCounterparty sets the "counterparty" field if the given value is not nil.
+func (_u *BankTransactionUpdate) SetNillableCounterparty(v *string) *BankTransactionUpdate {
+	if v != nil {
+		_u.SetCounterparty(*v)
+	}
+	return _u
+}
+
+// ClearCounterparty clears the value of the "counterparty" field.
+func (_u *BankTransactionUpdate) ClearCounterparty() *BankTransactionUpdate {
+	_u.mutation.ClearCounterparty()
+	return _u
+}
+
+// SetRunningBalance sets the "running_balance" field.
+func (_u *BankTransactionUpdate) SetRunningBalance(v decimal.Decimal) *BankTransactionUpdate {
+	_u.mutation.ResetRunningBalance()
+	_u.mutation.SetRunningBalance(v)
+	return _u
+}
+
+// SetNillableRunningBalance sets the "running_balance" field if the given value is not nil.
+func (_u *BankTransactionUpdate) SetNillableRunningBalance(v *decimal.Decimal) *BankTransactionUpdate {
+	if v != nil {
+		_u.SetRunningBalance(*v)
+	}
+	return _u
+}
+
+// AddRunningBalance adds value to the "running_balance" field.
+func (_u *BankTransactionUpdate) AddRunningBalance(v decimal.Decimal) *BankTransactionUpdate {
+	_u.mutation.AddRunningBalance(v)
+	return _u
+}
+
+// ClearRunningBalance clears the value of the "running_balance" field.
+func (_u *BankTransactionUpdate) ClearRunningBalance() *BankTransactionUpdate {
+	_u.mutation.ClearRunningBalance()
+	return _u
+}
+
+// SetDedupeHash sets the "dedupe_hash" field.
+func (_u *BankTransactionUpdate) SetDedupeHash(v string) *BankTransactionUpdate {
+	_u.mutation.SetDedupeHash(v)
+	return _u
+}
+
+// SetNillableDedupeHash sets the "dedupe_hash" field if the given value is not nil.
+func (_u *BankTransactionUpdate) SetNillableDedupeHash(v *string) *BankTransactionUpdate {
+	if v != nil {
+		_u.SetDedupeHash(*v)
+	}
+	return _u
+}
+
+// SetPaymentTransactionID sets the "payment_transaction_id" field.
+func (_u *BankTransactionUpdate) SetPaymentTransactionID(v uuid.UUID) *BankTransactionUpdate {
+	_u.mutation.SetPaymentTransactionID(v)
+	return _u
+}
+
+// SetNillablePaymentTransactionID sets the "payment_transaction_id" field if the given value is not nil.
+func (_u *BankTransactionUpdate) SetNillablePaymentTransactionID(v *uuid.UUID) *BankTransactionUpdate {
+	if v != nil {
+		_u.SetPaymentTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearPaymentTransactionID clears the value of the "payment_transaction_id" field.
+func (_u *BankTransactionUpdate) ClearPaymentTransactionID() *BankTransactionUpdate {
+	_u.mutation.ClearPaymentTransactionID()
+	return _u
+}
+
+// SetReconciliationStatus sets the "reconciliation_status" field.
+func (_u *BankTransactionUpdate) SetReconciliationStatus(v string) *BankTransactionUpdate {
+	_u.mutation.SetReconciliationStatus(v)
+	return _u
+}
+
+// SetNillableReconciliationStatus sets the "reconciliation_status" field if the given value is not nil.
+func (_u *BankTransactionUpdate) SetNillableReconciliationStatus(v *string) *BankTransactionUpdate {
+	if v != nil {
+		_u.SetReconciliationStatus(*v)
+	}
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *BankTransactionUpdate) SetMetadata(v map[string]interface{}) *BankTransactionUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetStatement sets the "statement" edge to the BankStatement entity.
+func (_u *BankTransactionUpdate) SetStatement(v *BankStatement) *BankTransactionUpdate {
+	return _u.SetStatementID(v.ID)
+}
+
+// Mutation returns the BankTransactionMutation object of the builder.
+func (_u *BankTransactionUpdate) Mutation() *BankTransactionMutation {
+	return _u.mutation
+}
+
+// ClearStatement clears the "statement" edge to the BankStatement entity.
+func (_u *BankTransactionUpdate) ClearStatement() *BankTransactionUpdate {
+	_u.mutation.ClearStatement()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BankTransactionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BankTransactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BankTransactionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BankTransactionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BankTransactionUpdate) check() error {
+	if _u.mutation.StatementCleared() && len(_u.mutation.StatementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BankTransaction.statement"`)
+	}
+	return nil
+}
+
+func (_u *BankTransactionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(banktransaction.Table, banktransaction.Columns, sqlgraph.NewFieldSpec(banktransaction.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(banktransaction.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.BankAccountID(); ok {
+		_spec.SetField(banktransaction.FieldBankAccountID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TransactionDate(); ok {
+		_spec.SetField(banktransaction.FieldTransactionDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ValueDate(); ok {
+		_spec.SetField(banktransaction.FieldValueDate, field.TypeTime, value)
+	}
+	if _u.mutation.ValueDateCleared() {
+		_spec.ClearField(banktransaction.FieldValueDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(banktransaction.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(banktransaction.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(banktransaction.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TransactionType(); ok {
+		_spec.SetField(banktransaction.FieldTransactionType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(banktransaction.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(banktransaction.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(banktransaction.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(banktransaction.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Counterparty(); ok {
+		_spec.SetField(banktransaction.FieldCounterparty, field.TypeString, value)
+	}
+	if _u.mutation.CounterpartyCleared() {
+		_spec.ClearField(banktransaction.FieldCounterparty, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunningBalance(); ok {
+		_spec.SetField(banktransaction.FieldRunningBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRunningBalance(); ok {
+		_spec.AddField(banktransaction.FieldRunningBalance, field.TypeFloat64, value)
+	}
+	if _u.mutation.RunningBalanceCleared() {
+		_spec.ClearField(banktransaction.FieldRunningBalance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.DedupeHash(); ok {
+		_spec.SetField(banktransaction.FieldDedupeHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PaymentTransactionID(); ok {
+		_spec.SetField(banktransaction.FieldPaymentTransactionID, field.TypeUUID, value)
+	}
+	if _u.mutation.PaymentTransactionIDCleared() {
+		_spec.ClearField(banktransaction.FieldPaymentTransactionID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ReconciliationStatus(); ok {
+		_spec.SetField(banktransaction.FieldReconciliationStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(banktransaction.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.StatementCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   banktransaction.StatementTable,
+			Columns: []string{banktransaction.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   banktransaction.StatementTable,
+			Columns: []string{banktransaction.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{banktransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BankTransactionUpdateOne is the builder for updating a single BankTransaction entity.
+type BankTransactionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BankTransactionMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *BankTransactionUpdateOne) SetTenantID(v uuid.UUID) *BankTransactionUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *BankTransactionUpdateOne) SetNillableTenantID(v *uuid.UUID) *BankTransactionUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (_u *BankTransactionUpdateOne) SetBankAccountID(v uuid.UUID) *BankTransactionUpdateOne {
+	_u.mutation.SetBankAccountID(v)
+	return _u
+}
+
+// SetNillableBankAccountID sets the "bank_account_id" field if the given value is not nil.
+func (_u *BankTransactionUpdateOne) SetNillableBankAccountID(v *uuid.UUID) *BankTransactionUpdateOne {
+	if v != nil {
+		_u.SetBankAccountID(*v)
+	}
+	return _u
+}
+
+// SetStatementID sets the "statement_id" field.
+func (_u *BankTransactionUpdateOne) SetStatementID(v uuid.UUID) *BankTransactionUpdateOne {
+	_u.mutation.SetStatementID(v)
+	return _u
+}
+
+// SetNillableStatementID sets the "statement_id" field if the given value is not nil.
+func (_u *BankTransactionUpdateOne) SetNillableStatementID(v *uuid.UUID) *BankTransactionUpdateOne {
+	if v != nil {
+		_u.SetStatementID(*v)
+	}
+	return _u
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (_u *BankTransactionUpdateOne) SetTransactionDate(v time.Time) *BankTransactionUpdateOne {
+	_u.mutation.SetTransactionDate(v)
+	return _u
+}
+
+// SetNillableTransactionDate sets the "transaction_date" field if the given value is not nil.
+func (_u *BankTransactionUpdateOne) SetNillableTransactionDate(v *time.Time) *BankTransactionUpdateOne {
+	if v != nil {
+		_u.SetTransactionDate(*v)
+	}
+	return _u
+}
+
+// SetValueDate sets the "value_date" field.
+func (_u *BankTransactionUpdateOne) SetValueDate(v time.Time) *BankTransactionUpdateOne {
+	_u.mutation.SetValueDate(v)
+	return _u
+}
+
+// SetNillableValueDate sets the "value_date" field if the given value is not nil.
+func (_u *BankTransactionUpdateOne) SetNillableValueDate(v *time.Time) *BankTransactionUpdateOne {
+	if v != nil {
+		_u.SetValueDate(*v)
+	}
+	return _u
+}
+
+// ClearValueDate clears the value of the "value_date" field.
+func (_u *BankTransactionUpdateOne) ClearValueDate() *BankTransactionUpdateOne {
+	_u.mutation.ClearValueDate()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *BankTransactionUpdateOne) SetAmount(v decimal.Decimal) *BankTransactionUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *BankTransactionUpdateOne) SetNillableAmount(v *decimal.Decimal) *BankTransactionUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *BankTransactionUpdateOne) AddAmount(v decimal.Decimal) *BankTransactionUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *BankTransactionUpdateOne) SetCurrency(v string) *BankTransactionUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *BankTransactionUpdateOne) SetNillableCurrency(v *string) *BankTransactionUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (_u *BankTransactionUpdateOne) SetTransactionType(v string) *BankTransactionUpdateOne {
+	_u.mutation.SetTransactionType(v)
+	return _u
+}
+
+// SetNillableTransactionType sets the "transaction_type" field if the given value is not nil.
+func (_u *BankTransactionUpdateOne) SetNillableTransactionType(v *string) *BankTransactionUpdateOne {
+	if v != nil {
+		_u.SetTransactionType(*v)
+	}
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *BankTransactionUpdateOne) SetReference(v string) *BankTransactionUpdateOne {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *BankTransactionUpdateOne) SetNillableReference(v *string) *BankTransactionUpdateOne {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *BankTransactionUpdateOne) ClearReference() *BankTransactionUpdateOne {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BankTransactionUpdateOne) SetDescription(v string) *BankTransactionUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BankTransactionUpdateOne) SetNillableDescription(v *string) *BankTransactionUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BankTransactionUpdateOne) ClearDescription() *BankTransactionUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetCounterparty sets the "counterparty" field.
+func (_u *BankTransactionUpdateOne) SetCounterparty(v string) *BankTransactionUpdateOne {
+	_u.mutation.SetCounterparty(v)
+	return _u
+}
+
+// SetNillableCounterparty sets the "counterparty" field if the given value is not nil.
+func (_u *BankTransactionUpdateOne) SetNillableCounterparty(v *string) *BankTransactionUpdateOne {
+	if v != nil {
+		_u.SetCounterparty(*v)
+	}
+	return _u
+}
+
+// ClearCounterparty clears the value of the "counterparty" field.
+func (_u *BankTransactionUpdateOne) ClearCounterparty() *BankTransactionUpdateOne {
+	_u.mutation.ClearCounterparty()
+	return _u
+}
+
+// SetRunningBalance sets the "running_balance" field.
+func (_u *BankTransactionUpdateOne) SetRunningBalance(v decimal.Decimal) *BankTransactionUpdateOne {
+	_u.mutation.ResetRunningBalance()
+	_u.mutation.SetRunningBalance(v)
+	return _u
+}
+
+// SetNillableRunningBalance sets the "running_balance" field if the given value is not nil.
+func (_u *BankTransactionUpdateOne) SetNillableRunningBalance(v *decimal.Decimal) *BankTransactionUpdateOne {
+	if v != nil {
+		_u.SetRunningBalance(*v)
+	}
+	return _u
+}
+
+// AddRunningBalance adds value to the "running_balance" field.
+func (_u *BankTransactionUpdateOne) AddRunningBalance(v decimal.Decimal) *BankTransactionUpdateOne {
+	_u.mutation.AddRunningBalance(v)
+	return _u
+}
+
+// ClearRunningBalance clears the value of the "running_balance" field.
+func (_u *BankTransactionUpdateOne) ClearRunningBalance() *BankTransactionUpdateOne {
+	_u.mutation.ClearRunningBalance()
+	return _u
+}
+
+// SetDedupeHash sets the "dedupe_hash" field.
+func (_u *BankTransactionUpdateOne) SetDedupeHash(v string) *BankTransactionUpdateOne {
+	_u.mutation.SetDedupeHash(v)
+	return _u
+}
+
+// SetNillableDedupeHash sets the "dedupe_hash" field if the given value is not nil.
+func (_u *BankTransactionUpdateOne) SetNillableDedupeHash(v *string) *BankTransactionUpdateOne {
+	if v != nil {
+		_u.SetDedupeHash(*v)
+	}
+	return _u
+}
+
+// SetPaymentTransactionID sets the "payment_transaction_id" field.
+func (_u *BankTransactionUpdateOne) SetPaymentTransactionID(v uuid.UUID) *BankTransactionUpdateOne {
+	_u.mutation.SetPaymentTransactionID(v)
+	return _u
+}
+
+// SetNillablePaymentTransactionID sets the "payment_transaction_id" field if the given value is not nil.
+func (_u *BankTransactionUpdateOne) SetNillablePaymentTransactionID(v *uuid.UUID) *BankTransactionUpdateOne {
+	if v != nil {
+		_u.SetPaymentTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearPaymentTransactionID clears the value of the "payment_transaction_id" field.
+func (_u *BankTransactionUpdateOne) ClearPaymentTransactionID() *BankTransactionUpdateOne {
+	_u.mutation.ClearPaymentTransactionID()
+	return _u
+}
+
+// SetReconciliationStatus sets the "reconciliation_status" field.
+func (_u *BankTransactionUpdateOne) SetReconciliationStatus(v string) *BankTransactionUpdateOne {
+	_u.mutation.SetReconciliationStatus(v)
+	return _u
+}
+
+// SetNillableReconciliationStatus sets the "reconciliation_status" field if the given value is not nil.
+func (_u *BankTransactionUpdateOne) SetNillableReconciliationStatus(v *string) *BankTransactionUpdateOne {
+	if v != nil {
+		_u.SetReconciliationStatus(*v)
+	}
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *BankTransactionUpdateOne) SetMetadata(v map[string]interface{}) *BankTransactionUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetStatement sets the "statement" edge to the BankStatement entity.
+func (_u *BankTransactionUpdateOne) SetStatement(v *BankStatement) *BankTransactionUpdateOne {
+	return _u.SetStatementID(v.ID)
+}
+
+// Mutation returns the BankTransactionMutation object of the builder.
+func (_u *BankTransactionUpdateOne) Mutation() *BankTransactionMutation {
+	return _u.mutation
+}
+
+// ClearStatement clears the "statement" edge to the BankStatement entity.
+func (_u *BankTransactionUpdateOne) ClearStatement() *BankTransactionUpdateOne {
+	_u.mutation.ClearStatement()
+	return _u
+}
+
+// Where appends a list predicates to the BankTransactionUpdate builder.
+func (_u *BankTransactionUpdateOne) Where(ps ...predicate.BankTransaction) *BankTransactionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BankTransactionUpdateOne) Select(field string, fields ...string) *BankTransactionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BankTransaction entity.
+func (_u *BankTransactionUpdateOne) Save(ctx context.Context) (*BankTransaction, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BankTransactionUpdateOne) SaveX(ctx context.Context) *BankTransaction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BankTransactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BankTransactionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BankTransactionUpdateOne) check() error {
+	if _u.mutation.StatementCleared() && len(_u.mutation.StatementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BankTransaction.statement"`)
+	}
+	return nil
+}
+
+func (_u *BankTransactionUpdateOne) sqlSave(ctx context.Context) (_node *BankTransaction, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(banktransaction.Table, banktransaction.Columns, sqlgraph.NewFieldSpec(banktransaction.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BankTransaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, banktransaction.FieldID)
+		for _, f := range fields {
+			if !banktransaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != banktransaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(banktransaction.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.BankAccountID(); ok {
+		_spec.SetField(banktransaction.FieldBankAccountID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TransactionDate(); ok {
+		_spec.SetField(banktransaction.FieldTransactionDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ValueDate(); ok {
+		_spec.SetField(banktransaction.FieldValueDate, field.TypeTime, value)
+	}
+	if _u.mutation.ValueDateCleared() {
+		_spec.ClearField(banktransaction.FieldValueDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(banktransaction.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(banktransaction.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(banktransaction.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TransactionType(); ok {
+		_spec.SetField(banktransaction.FieldTransactionType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(banktransaction.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(banktransaction.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(banktransaction.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(banktransaction.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Counterparty(); ok {
+		_spec.SetField(banktransaction.FieldCounterparty, field.TypeString, value)
+	}
+	if _u.mutation.CounterpartyCleared() {
+		_spec.ClearField(banktransaction.FieldCounterparty, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunningBalance(); ok {
+		_spec.SetField(banktransaction.FieldRunningBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRunningBalance(); ok {
+		_spec.AddField(banktransaction.FieldRunningBalance, field.TypeFloat64, value)
+	}
+	if _u.mutation.RunningBalanceCleared() {
+		_spec.ClearField(banktransaction.FieldRunningBalance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.DedupeHash(); ok {
+		_spec.SetField(banktransaction.FieldDedupeHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PaymentTransactionID(); ok {
+		_spec.SetField(banktransaction.FieldPaymentTransactionID, field.TypeUUID, value)
+	}
+	if _u.mutation.PaymentTransactionIDCleared() {
+		_spec.ClearField(banktransaction.FieldPaymentTransactionID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ReconciliationStatus(); ok {
+		_spec.SetField(banktransaction.FieldReconciliationStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(banktransaction.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.StatementCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   banktransaction.StatementTable,
+			Columns: []string{banktransaction.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   banktransaction.StatementTable,
+			Columns: []string{banktransaction.StatementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bankstatement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BankTransaction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{banktransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
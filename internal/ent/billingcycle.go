@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/billingcycle"
+	"github.com/bengobox/treasury-api/internal/ent/subscription"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BillingCycle is the model entity for the BillingCycle schema.
+type BillingCycle struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Subscription identifier
+	SubscriptionID uuid.UUID `json:"subscription_id,omitempty"`
+	// Cycle start
+	CycleStart time.Time `json:"cycle_start,omitempty"`
+	// Cycle end (exclusive)
+	CycleEnd time.Time `json:"cycle_end,omitempty"`
+	// Generated invoice identifier
+	InvoiceID uuid.UUID `json:"invoice_id,omitempty"`
+	// Status: pending, invoiced, failed
+	Status string `json:"status,omitempty"`
+	// Usage-based billing amount
+	UsageAmount decimal.Decimal `json:"usage_amount,omitempty"`
+	// Total billed amount
+	BillingAmount decimal.Decimal `json:"billing_amount,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BillingCycleQuery when eager-loading is set.
+	Edges        BillingCycleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BillingCycleEdges holds the relations/edges for other nodes in the graph.
+type BillingCycleEdges struct {
+	// Subscription holds the value of the subscription edge.
+	Subscription *Subscription `json:"subscription,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SubscriptionOrErr returns the Subscription value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BillingCycleEdges) SubscriptionOrErr() (*Subscription, error) {
+	if e.Subscription != nil {
+		return e.Subscription, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: subscription.Label}
+	}
+	return nil, &NotLoadedError{edge: "subscription"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BillingCycle) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case billingcycle.FieldMetadata:
+			values[i] = new([]byte)
+		case billingcycle.FieldUsageAmount, billingcycle.FieldBillingAmount:
+			values[i] = new(decimal.Decimal)
+		case billingcycle.FieldStatus:
+			values[i] = new(sql.NullString)
+		case billingcycle.FieldCycleStart, billingcycle.FieldCycleEnd, billingcycle.FieldCreatedAt, billingcycle.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case billingcycle.FieldID, billingcycle.FieldTenantID, billingcycle.FieldSubscriptionID, billingcycle.FieldInvoiceID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BillingCycle fields.
+func (_m *BillingCycle) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case billingcycle.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case billingcycle.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case billingcycle.FieldSubscriptionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value != nil {
+				_m.SubscriptionID = *value
+			}
+		case billingcycle.FieldCycleStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cycle_start", values[i])
+			} else if value.Valid {
+				_m.CycleStart = value.Time
+			}
+		case billingcycle.FieldCycleEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cycle_end", values[i])
+			} else if value.Valid {
+				_m.CycleEnd = value.Time
+			}
+		case billingcycle.FieldInvoiceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value != nil {
+				_m.InvoiceID = *value
+			}
+		case billingcycle.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case billingcycle.FieldUsageAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field usage_amount", values[i])
+			} else if value != nil {
+				_m.UsageAmount = *value
+			}
+		case billingcycle.FieldBillingAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_amount", values[i])
+			} else if value != nil {
+				_m.BillingAmount = *value
+			}
+		case billingcycle.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case billingcycle.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case billingcycle.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BillingCycle.
+// This includes values selected through modifiers, order, etc.
+func (_m *BillingCycle) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySubscription queries the "subscription" edge of the BillingCycle entity.
+func (_m *BillingCycle) QuerySubscription() *SubscriptionQuery {
+	return NewBillingCycleClient(_m.config).QuerySubscription(_m)
+}
+
+// Update returns a builder for updating this BillingCycle.
+// Note that you need to call BillingCycle.Unwrap() before calling this method if this BillingCycle
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BillingCycle) Update() *BillingCycleUpdateOne {
+	return NewBillingCycleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BillingCycle entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BillingCycle) Unwrap() *BillingCycle {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BillingCycle is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BillingCycle) String() string {
+	var builder strings.Builder
+	builder.WriteString("BillingCycle(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("subscription_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SubscriptionID))
+	builder.WriteString(", ")
+	builder.WriteString("cycle_start=")
+	builder.WriteString(_m.CycleStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("cycle_end=")
+	builder.WriteString(_m.CycleEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("invoice_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InvoiceID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("usage_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UsageAmount))
+	builder.WriteString(", ")
+	builder.WriteString("billing_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BillingAmount))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BillingCycles is a parsable slice of BillingCycle.
+type BillingCycles []*BillingCycle
@@ -0,0 +1,571 @@
+// Code generated by ent, DO NOT EDIT.
+
+package billingcycle
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldTenantID, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// CycleStart applies equality check predicate on the "cycle_start" field. It's identical to CycleStartEQ.
+func CycleStart(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldCycleStart, v))
+}
+
+// CycleEnd applies equality check predicate on the "cycle_end" field. It's identical to CycleEndEQ.
+func CycleEnd(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldCycleEnd, v))
+}
+
+// InvoiceID applies equality check predicate on the "invoice_id" field. It's identical to InvoiceIDEQ.
+func InvoiceID(v uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldStatus, v))
+}
+
+// UsageAmount applies equality check predicate on the "usage_amount" field. It's identical to UsageAmountEQ.
+func UsageAmount(v decimal.Decimal) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldUsageAmount, v))
+}
+
+// BillingAmount applies equality check predicate on the "billing_amount" field. It's identical to BillingAmountEQ.
+func BillingAmount(v decimal.Decimal) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldBillingAmount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLTE(FieldTenantID, v))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// CycleStartEQ applies the EQ predicate on the "cycle_start" field.
+func CycleStartEQ(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldCycleStart, v))
+}
+
+// CycleStartNEQ applies the NEQ predicate on the "cycle_start" field.
+func CycleStartNEQ(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNEQ(FieldCycleStart, v))
+}
+
+// CycleStartIn applies the In predicate on the "cycle_start" field.
+func CycleStartIn(vs ...time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldIn(FieldCycleStart, vs...))
+}
+
+// CycleStartNotIn applies the NotIn predicate on the "cycle_start" field.
+func CycleStartNotIn(vs ...time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNotIn(FieldCycleStart, vs...))
+}
+
+// CycleStartGT applies the GT predicate on the "cycle_start" field.
+func CycleStartGT(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGT(FieldCycleStart, v))
+}
+
+// CycleStartGTE applies the GTE predicate on the "cycle_start" field.
+func CycleStartGTE(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGTE(FieldCycleStart, v))
+}
+
+// CycleStartLT applies the LT predicate on the "cycle_start" field.
+func CycleStartLT(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLT(FieldCycleStart, v))
+}
+
+// CycleStartLTE applies the LTE predicate on the "cycle_start" field.
+func CycleStartLTE(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLTE(FieldCycleStart, v))
+}
+
+// CycleEndEQ applies the EQ predicate on the "cycle_end" field.
+func CycleEndEQ(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldCycleEnd, v))
+}
+
+// CycleEndNEQ applies the NEQ predicate on the "cycle_end" field.
+func CycleEndNEQ(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNEQ(FieldCycleEnd, v))
+}
+
+// CycleEndIn applies the In predicate on the "cycle_end" field.
+func CycleEndIn(vs ...time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldIn(FieldCycleEnd, vs...))
+}
+
+// CycleEndNotIn applies the NotIn predicate on the "cycle_end" field.
+func CycleEndNotIn(vs ...time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNotIn(FieldCycleEnd, vs...))
+}
+
+// CycleEndGT applies the GT predicate on the "cycle_end" field.
+func CycleEndGT(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGT(FieldCycleEnd, v))
+}
+
+// CycleEndGTE applies the GTE predicate on the "cycle_end" field.
+func CycleEndGTE(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGTE(FieldCycleEnd, v))
+}
+
+// CycleEndLT applies the LT predicate on the "cycle_end" field.
+func CycleEndLT(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLT(FieldCycleEnd, v))
+}
+
+// CycleEndLTE applies the LTE predicate on the "cycle_end" field.
+func CycleEndLTE(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLTE(FieldCycleEnd, v))
+}
+
+// InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
+func InvoiceIDEQ(v uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDNEQ applies the NEQ predicate on the "invoice_id" field.
+func InvoiceIDNEQ(v uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDIn applies the In predicate on the "invoice_id" field.
+func InvoiceIDIn(vs ...uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDNotIn applies the NotIn predicate on the "invoice_id" field.
+func InvoiceIDNotIn(vs ...uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNotIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDGT applies the GT predicate on the "invoice_id" field.
+func InvoiceIDGT(v uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGT(FieldInvoiceID, v))
+}
+
+// InvoiceIDGTE applies the GTE predicate on the "invoice_id" field.
+func InvoiceIDGTE(v uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGTE(FieldInvoiceID, v))
+}
+
+// InvoiceIDLT applies the LT predicate on the "invoice_id" field.
+func InvoiceIDLT(v uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLT(FieldInvoiceID, v))
+}
+
+// InvoiceIDLTE applies the LTE predicate on the "invoice_id" field.
+func InvoiceIDLTE(v uuid.UUID) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLTE(FieldInvoiceID, v))
+}
+
+// InvoiceIDIsNil applies the IsNil predicate on the "invoice_id" field.
+func InvoiceIDIsNil() predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldIsNull(FieldInvoiceID))
+}
+
+// InvoiceIDNotNil applies the NotNil predicate on the "invoice_id" field.
+func InvoiceIDNotNil() predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNotNull(FieldInvoiceID))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// UsageAmountEQ applies the EQ predicate on the "usage_amount" field.
+func UsageAmountEQ(v decimal.Decimal) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldUsageAmount, v))
+}
+
+// UsageAmountNEQ applies the NEQ predicate on the "usage_amount" field.
+func UsageAmountNEQ(v decimal.Decimal) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNEQ(FieldUsageAmount, v))
+}
+
+// UsageAmountIn applies the In predicate on the "usage_amount" field.
+func UsageAmountIn(vs ...decimal.Decimal) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldIn(FieldUsageAmount, vs...))
+}
+
+// UsageAmountNotIn applies the NotIn predicate on the "usage_amount" field.
+func UsageAmountNotIn(vs ...decimal.Decimal) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNotIn(FieldUsageAmount, vs...))
+}
+
+// UsageAmountGT applies the GT predicate on the "usage_amount" field.
+func UsageAmountGT(v decimal.Decimal) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGT(FieldUsageAmount, v))
+}
+
+// UsageAmountGTE applies the GTE predicate on the "usage_amount" field.
+func UsageAmountGTE(v decimal.Decimal) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGTE(FieldUsageAmount, v))
+}
+
+// UsageAmountLT applies the LT predicate on the "usage_amount" field.
+func UsageAmountLT(v decimal.Decimal) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLT(FieldUsageAmount, v))
+}
+
+// UsageAmountLTE applies the LTE predicate on the "usage_amount" field.
+func UsageAmountLTE(v decimal.Decimal) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLTE(FieldUsageAmount, v))
+}
+
+// UsageAmountIsNil applies the IsNil predicate on the "usage_amount" field.
+func UsageAmountIsNil() predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldIsNull(FieldUsageAmount))
+}
+
+// UsageAmountNotNil applies the NotNil predicate on the "usage_amount" field.
+func UsageAmountNotNil() predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNotNull(FieldUsageAmount))
+}
+
+// BillingAmountEQ applies the EQ predicate on the "billing_amount" field.
+func BillingAmountEQ(v decimal.Decimal) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldBillingAmount, v))
+}
+
+// BillingAmountNEQ applies the NEQ predicate on the "billing_amount" field.
+func BillingAmountNEQ(v decimal.Decimal) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNEQ(FieldBillingAmount, v))
+}
+
+// BillingAmountIn applies the In predicate on the "billing_amount" field.
+func BillingAmountIn(vs ...decimal.Decimal) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldIn(FieldBillingAmount, vs...))
+}
+
+// BillingAmountNotIn applies the NotIn predicate on the "billing_amount" field.
+func BillingAmountNotIn(vs ...decimal.Decimal) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNotIn(FieldBillingAmount, vs...))
+}
+
+// BillingAmountGT applies the GT predicate on the "billing_amount" field.
+func BillingAmountGT(v decimal.Decimal) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGT(FieldBillingAmount, v))
+}
+
+// BillingAmountGTE applies the GTE predicate on the "billing_amount" field.
+func BillingAmountGTE(v decimal.Decimal) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGTE(FieldBillingAmount, v))
+}
+
+// BillingAmountLT applies the LT predicate on the "billing_amount" field.
+func BillingAmountLT(v decimal.Decimal) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLT(FieldBillingAmount, v))
+}
+
+// BillingAmountLTE applies the LTE predicate on the "billing_amount" field.
+func BillingAmountLTE(v decimal.Decimal) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLTE(FieldBillingAmount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasSubscription applies the HasEdge predicate on the "subscription" edge.
+func HasSubscription() predicate.BillingCycle {
+	return predicate.BillingCycle(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SubscriptionTable, SubscriptionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubscriptionWith applies the HasEdge predicate on the "subscription" edge with a given conditions (other predicates).
+func HasSubscriptionWith(preds ...predicate.Subscription) predicate.BillingCycle {
+	return predicate.BillingCycle(func(s *sql.Selector) {
+		step := newSubscriptionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BillingCycle) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BillingCycle) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BillingCycle) predicate.BillingCycle {
+	return predicate.BillingCycle(sql.NotPredicates(p))
+}
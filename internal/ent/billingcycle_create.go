@@ -0,0 +1,1158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/billingcycle"
+	"github.com/bengobox/treasury-api/internal/ent/subscription"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BillingCycleCreate is the builder for creating a BillingCycle entity.
+type BillingCycleCreate struct {
+	config
+	mutation *BillingCycleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *BillingCycleCreate) SetTenantID(v uuid.UUID) *BillingCycleCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_c *BillingCycleCreate) SetSubscriptionID(v uuid.UUID) *BillingCycleCreate {
+	_c.mutation.SetSubscriptionID(v)
+	return _c
+}
+
+// SetCycleStart sets the "cycle_start" field.
+func (_c *BillingCycleCreate) SetCycleStart(v time.Time) *BillingCycleCreate {
+	_c.mutation.SetCycleStart(v)
+	return _c
+}
+
+// SetCycleEnd sets the "cycle_end" field.
+func (_c *BillingCycleCreate) SetCycleEnd(v time.Time) *BillingCycleCreate {
+	_c.mutation.SetCycleEnd(v)
+	return _c
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_c *BillingCycleCreate) SetInvoiceID(v uuid.UUID) *BillingCycleCreate {
+	_c.mutation.SetInvoiceID(v)
+	return _c
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_c *BillingCycleCreate) SetNillableInvoiceID(v *uuid.UUID) *BillingCycleCreate {
+	if v != nil {
+		_c.SetInvoiceID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *BillingCycleCreate) SetStatus(v string) *BillingCycleCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *BillingCycleCreate) SetNillableStatus(v *string) *BillingCycleCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetUsageAmount sets the "usage_amount" field.
+func (_c *BillingCycleCreate) SetUsageAmount(v decimal.Decimal) *BillingCycleCreate {
+	_c.mutation.SetUsageAmount(v)
+	return _c
+}
+
+// SetNillableUsageAmount sets the "usage_amount" field if the given value is not nil.
+func (_c *BillingCycleCreate) SetNillableUsageAmount(v *decimal.Decimal) *BillingCycleCreate {
+	if v != nil {
+		_c.SetUsageAmount(*v)
+	}
+	return _c
+}
+
+// SetBillingAmount sets the "billing_amount" field.
+func (_c *BillingCycleCreate) SetBillingAmount(v decimal.Decimal) *BillingCycleCreate {
+	_c.mutation.SetBillingAmount(v)
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *BillingCycleCreate) SetMetadata(v map[string]interface{}) *BillingCycleCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BillingCycleCreate) SetCreatedAt(v time.Time) *BillingCycleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BillingCycleCreate) SetNillableCreatedAt(v *time.Time) *BillingCycleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BillingCycleCreate) SetUpdatedAt(v time.Time) *BillingCycleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BillingCycleCreate) SetNillableUpdatedAt(v *time.Time) *BillingCycleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BillingCycleCreate) SetID(v uuid.UUID) *BillingCycleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BillingCycleCreate) SetNillableID(v *uuid.UUID) *BillingCycleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetSubscription sets the "subscription" edge to the Subscription entity.
+func (_c *BillingCycleCreate) SetSubscription(v *Subscription) *BillingCycleCreate {
+	return _c.SetSubscriptionID(v.ID)
+}
+
+// Mutation returns the BillingCycleMutation object of the builder.
+func (_c *BillingCycleCreate) Mutation() *BillingCycleMutation {
+	return _c.mutation
+}
+
+// Save creates the BillingCycle in the database.
+func (_c *BillingCycleCreate) Save(ctx context.Context) (*BillingCycle, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BillingCycleCreate) SaveX(ctx context.Context) *BillingCycle {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BillingCycleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BillingCycleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BillingCycleCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := billingcycle.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		v := billingcycle.DefaultMetadata
+		_c.mutation.SetMetadata(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := billingcycle.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := billingcycle.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := billingcycle.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BillingCycleCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "BillingCycle.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.SubscriptionID(); !ok {
+		return &ValidationError{Name: "subscription_id", err: errors.New(`ent: missing required field "BillingCycle.subscription_id"`)}
+	}
+	if _, ok := _c.mutation.CycleStart(); !ok {
+		return &ValidationError{Name: "cycle_start", err: errors.New(`ent: missing required field "BillingCycle.cycle_start"`)}
+	}
+	if _, ok := _c.mutation.CycleEnd(); !ok {
+		return &ValidationError{Name: "cycle_end", err: errors.New(`ent: missing required field "BillingCycle.cycle_end"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "BillingCycle.status"`)}
+	}
+	if _, ok := _c.mutation.BillingAmount(); !ok {
+		return &ValidationError{Name: "billing_amount", err: errors.New(`ent: missing required field "BillingCycle.billing_amount"`)}
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		return &ValidationError{Name: "metadata", err: errors.New(`ent: missing required field "BillingCycle.metadata"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BillingCycle.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BillingCycle.updated_at"`)}
+	}
+	if len(_c.mutation.SubscriptionIDs()) == 0 {
+		return &ValidationError{Name: "subscription", err: errors.New(`ent: missing required edge "BillingCycle.subscription"`)}
+	}
+	return nil
+}
+
+func (_c *BillingCycleCreate) sqlSave(ctx context.Context) (*BillingCycle, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BillingCycleCreate) createSpec() (*BillingCycle, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BillingCycle{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(billingcycle.Table, sqlgraph.NewFieldSpec(billingcycle.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(billingcycle.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CycleStart(); ok {
+		_spec.SetField(billingcycle.FieldCycleStart, field.TypeTime, value)
+		_node.CycleStart = value
+	}
+	if value, ok := _c.mutation.CycleEnd(); ok {
+		_spec.SetField(billingcycle.FieldCycleEnd, field.TypeTime, value)
+		_node.CycleEnd = value
+	}
+	if value, ok := _c.mutation.InvoiceID(); ok {
+		_spec.SetField(billingcycle.FieldInvoiceID, field.TypeUUID, value)
+		_node.InvoiceID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(billingcycle.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.UsageAmount(); ok {
+		_spec.SetField(billingcycle.FieldUsageAmount, field.TypeFloat64, value)
+		_node.UsageAmount = value
+	}
+	if value, ok := _c.mutation.BillingAmount(); ok {
+		_spec.SetField(billingcycle.FieldBillingAmount, field.TypeFloat64, value)
+		_node.BillingAmount = value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(billingcycle.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(billingcycle.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(billingcycle.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   billingcycle.SubscriptionTable,
+			Columns: []string{billingcycle.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SubscriptionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BillingCycle.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BillingCycleUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BillingCycleCreate) OnConflict(opts ...sql.ConflictOption) *BillingCycleUpsertOne {
+	_c.conflict = opts
+	return &BillingCycleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BillingCycle.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BillingCycleCreate) OnConflictColumns(columns ...string) *BillingCycleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BillingCycleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BillingCycleUpsertOne is the builder for "upsert"-ing
+	//  one BillingCycle node.
+	BillingCycleUpsertOne struct {
+		create *BillingCycleCreate
+	}
+
+	// BillingCycleUpsert is the "OnConflict" setter.
+	BillingCycleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *BillingCycleUpsert) SetTenantID(v uuid.UUID) *BillingCycleUpsert {
+	u.Set(billingcycle.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *BillingCycleUpsert) UpdateTenantID() *BillingCycleUpsert {
+	u.SetExcluded(billingcycle.FieldTenantID)
+	return u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *BillingCycleUpsert) SetSubscriptionID(v uuid.UUID) *BillingCycleUpsert {
+	u.Set(billingcycle.FieldSubscriptionID, v)
+	return u
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *BillingCycleUpsert) UpdateSubscriptionID() *BillingCycleUpsert {
+	u.SetExcluded(billingcycle.FieldSubscriptionID)
+	return u
+}
+
+// SetCycleStart sets the "cycle_start" field.
+func (u *BillingCycleUpsert) SetCycleStart(v time.Time) *BillingCycleUpsert {
+	u.Set(billingcycle.FieldCycleStart, v)
+	return u
+}
+
+// UpdateCycleStart sets the "cycle_start" field to the value that was provided on create.
+func (u *BillingCycleUpsert) UpdateCycleStart() *BillingCycleUpsert {
+	u.SetExcluded(billingcycle.FieldCycleStart)
+	return u
+}
+
+// SetCycleEnd sets the "cycle_end" field.
+func (u *BillingCycleUpsert) SetCycleEnd(v time.Time) *BillingCycleUpsert {
+	u.Set(billingcycle.FieldCycleEnd, v)
+	return u
+}
+
+// UpdateCycleEnd sets the "cycle_end" field to the value that was provided on create.
+func (u *BillingCycleUpsert) UpdateCycleEnd() *BillingCycleUpsert {
+	u.SetExcluded(billingcycle.FieldCycleEnd)
+	return u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *BillingCycleUpsert) SetInvoiceID(v uuid.UUID) *BillingCycleUpsert {
+	u.Set(billingcycle.FieldInvoiceID, v)
+	return u
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *BillingCycleUpsert) UpdateInvoiceID() *BillingCycleUpsert {
+	u.SetExcluded(billingcycle.FieldInvoiceID)
+	return u
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (u *BillingCycleUpsert) ClearInvoiceID() *BillingCycleUpsert {
+	u.SetNull(billingcycle.FieldInvoiceID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *BillingCycleUpsert) SetStatus(v string) *BillingCycleUpsert {
+	u.Set(billingcycle.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BillingCycleUpsert) UpdateStatus() *BillingCycleUpsert {
+	u.SetExcluded(billingcycle.FieldStatus)
+	return u
+}
+
+// SetUsageAmount sets the "usage_amount" field.
+func (u *BillingCycleUpsert) SetUsageAmount(v decimal.Decimal) *BillingCycleUpsert {
+	u.Set(billingcycle.FieldUsageAmount, v)
+	return u
+}
+
+// UpdateUsageAmount sets the "usage_amount" field to the value that was provided on create.
+func (u *BillingCycleUpsert) UpdateUsageAmount() *BillingCycleUpsert {
+	u.SetExcluded(billingcycle.FieldUsageAmount)
+	return u
+}
+
+// AddUsageAmount adds v to the "usage_amount" field.
+func (u *BillingCycleUpsert) AddUsageAmount(v decimal.Decimal) *BillingCycleUpsert {
+	u.Add(billingcycle.FieldUsageAmount, v)
+	return u
+}
+
+// ClearUsageAmount clears the value of the "usage_amount" field.
+func (u *BillingCycleUpsert) ClearUsageAmount() *BillingCycleUpsert {
+	u.SetNull(billingcycle.FieldUsageAmount)
+	return u
+}
+
+// SetBillingAmount sets the "billing_amount" field.
+func (u *BillingCycleUpsert) SetBillingAmount(v decimal.Decimal) *BillingCycleUpsert {
+	u.Set(billingcycle.FieldBillingAmount, v)
+	return u
+}
+
+// UpdateBillingAmount sets the "billing_amount" field to the value that was provided on create.
+func (u *BillingCycleUpsert) UpdateBillingAmount() *BillingCycleUpsert {
+	u.SetExcluded(billingcycle.FieldBillingAmount)
+	return u
+}
+
+// AddBillingAmount adds v to the "billing_amount" field.
+func (u *BillingCycleUpsert) AddBillingAmount(v decimal.Decimal) *BillingCycleUpsert {
+	u.Add(billingcycle.FieldBillingAmount, v)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *BillingCycleUpsert) SetMetadata(v map[string]interface{}) *BillingCycleUpsert {
+	u.Set(billingcycle.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *BillingCycleUpsert) UpdateMetadata() *BillingCycleUpsert {
+	u.SetExcluded(billingcycle.FieldMetadata)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BillingCycleUpsert) SetUpdatedAt(v time.Time) *BillingCycleUpsert {
+	u.Set(billingcycle.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BillingCycleUpsert) UpdateUpdatedAt() *BillingCycleUpsert {
+	u.SetExcluded(billingcycle.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.BillingCycle.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(billingcycle.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BillingCycleUpsertOne) UpdateNewValues() *BillingCycleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(billingcycle.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(billingcycle.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BillingCycle.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BillingCycleUpsertOne) Ignore() *BillingCycleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BillingCycleUpsertOne) DoNothing() *BillingCycleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BillingCycleCreate.OnConflict
+// documentation for more info.
+func (u *BillingCycleUpsertOne) Update(set func(*BillingCycleUpsert)) *BillingCycleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BillingCycleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *BillingCycleUpsertOne) SetTenantID(v uuid.UUID) *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *BillingCycleUpsertOne) UpdateTenantID() *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *BillingCycleUpsertOne) SetSubscriptionID(v uuid.UUID) *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetSubscriptionID(v)
+	})
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *BillingCycleUpsertOne) UpdateSubscriptionID() *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateSubscriptionID()
+	})
+}
+
+// SetCycleStart sets the "cycle_start" field.
+func (u *BillingCycleUpsertOne) SetCycleStart(v time.Time) *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetCycleStart(v)
+	})
+}
+
+// UpdateCycleStart sets the "cycle_start" field to the value that was provided on create.
+func (u *BillingCycleUpsertOne) UpdateCycleStart() *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateCycleStart()
+	})
+}
+
+// SetCycleEnd sets the "cycle_end" field.
+func (u *BillingCycleUpsertOne) SetCycleEnd(v time.Time) *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetCycleEnd(v)
+	})
+}
+
+// UpdateCycleEnd sets the "cycle_end" field to the value that was provided on create.
+func (u *BillingCycleUpsertOne) UpdateCycleEnd() *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateCycleEnd()
+	})
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *BillingCycleUpsertOne) SetInvoiceID(v uuid.UUID) *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetInvoiceID(v)
+	})
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *BillingCycleUpsertOne) UpdateInvoiceID() *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateInvoiceID()
+	})
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (u *BillingCycleUpsertOne) ClearInvoiceID() *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.ClearInvoiceID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *BillingCycleUpsertOne) SetStatus(v string) *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BillingCycleUpsertOne) UpdateStatus() *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUsageAmount sets the "usage_amount" field.
+func (u *BillingCycleUpsertOne) SetUsageAmount(v decimal.Decimal) *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetUsageAmount(v)
+	})
+}
+
+// AddUsageAmount adds v to the "usage_amount" field.
+func (u *BillingCycleUpsertOne) AddUsageAmount(v decimal.Decimal) *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.AddUsageAmount(v)
+	})
+}
+
+// UpdateUsageAmount sets the "usage_amount" field to the value that was provided on create.
+func (u *BillingCycleUpsertOne) UpdateUsageAmount() *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateUsageAmount()
+	})
+}
+
+// ClearUsageAmount clears the value of the "usage_amount" field.
+func (u *BillingCycleUpsertOne) ClearUsageAmount() *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.ClearUsageAmount()
+	})
+}
+
+// SetBillingAmount sets the "billing_amount" field.
+func (u *BillingCycleUpsertOne) SetBillingAmount(v decimal.Decimal) *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetBillingAmount(v)
+	})
+}
+
+// AddBillingAmount adds v to the "billing_amount" field.
+func (u *BillingCycleUpsertOne) AddBillingAmount(v decimal.Decimal) *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.AddBillingAmount(v)
+	})
+}
+
+// UpdateBillingAmount sets the "billing_amount" field to the value that was provided on create.
+func (u *BillingCycleUpsertOne) UpdateBillingAmount() *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateBillingAmount()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *BillingCycleUpsertOne) SetMetadata(v map[string]interface{}) *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *BillingCycleUpsertOne) UpdateMetadata() *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BillingCycleUpsertOne) SetUpdatedAt(v time.Time) *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BillingCycleUpsertOne) UpdateUpdatedAt() *BillingCycleUpsertOne {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BillingCycleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BillingCycleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BillingCycleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BillingCycleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BillingCycleUpsertOne.ID is not supported by MySQL driver. Use BillingCycleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BillingCycleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BillingCycleCreateBulk is the builder for creating many BillingCycle entities in bulk.
+type BillingCycleCreateBulk struct {
+	config
+	err      error
+	builders []*BillingCycleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BillingCycle entities in the database.
+func (_c *BillingCycleCreateBulk) Save(ctx context.Context) ([]*BillingCycle, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BillingCycle, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BillingCycleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BillingCycleCreateBulk) SaveX(ctx context.Context) []*BillingCycle {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BillingCycleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BillingCycleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BillingCycle.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BillingCycleUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BillingCycleCreateBulk) OnConflict(opts ...sql.ConflictOption) *BillingCycleUpsertBulk {
+	_c.conflict = opts
+	return &BillingCycleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BillingCycle.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BillingCycleCreateBulk) OnConflictColumns(columns ...string) *BillingCycleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BillingCycleUpsertBulk{
+		create: _c,
+	}
+}
+
+// BillingCycleUpsertBulk is the builder for "upsert"-ing
+// a bulk of BillingCycle nodes.
+type BillingCycleUpsertBulk struct {
+	create *BillingCycleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BillingCycle.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(billingcycle.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BillingCycleUpsertBulk) UpdateNewValues() *BillingCycleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(billingcycle.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(billingcycle.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BillingCycle.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BillingCycleUpsertBulk) Ignore() *BillingCycleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BillingCycleUpsertBulk) DoNothing() *BillingCycleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BillingCycleCreateBulk.OnConflict
+// documentation for more info.
+func (u *BillingCycleUpsertBulk) Update(set func(*BillingCycleUpsert)) *BillingCycleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BillingCycleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *BillingCycleUpsertBulk) SetTenantID(v uuid.UUID) *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *BillingCycleUpsertBulk) UpdateTenantID() *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *BillingCycleUpsertBulk) SetSubscriptionID(v uuid.UUID) *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetSubscriptionID(v)
+	})
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *BillingCycleUpsertBulk) UpdateSubscriptionID() *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateSubscriptionID()
+	})
+}
+
+// SetCycleStart sets the "cycle_start" field.
+func (u *BillingCycleUpsertBulk) SetCycleStart(v time.Time) *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetCycleStart(v)
+	})
+}
+
+// UpdateCycleStart sets the "cycle_start" field to the value that was provided on create.
+func (u *BillingCycleUpsertBulk) UpdateCycleStart() *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateCycleStart()
+	})
+}
+
+// SetCycleEnd sets the "cycle_end" field.
+func (u *BillingCycleUpsertBulk) SetCycleEnd(v time.Time) *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetCycleEnd(v)
+	})
+}
+
+// UpdateCycleEnd sets the "cycle_end" field to the value that was provided on create.
+func (u *BillingCycleUpsertBulk) UpdateCycleEnd() *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateCycleEnd()
+	})
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *BillingCycleUpsertBulk) SetInvoiceID(v uuid.UUID) *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetInvoiceID(v)
+	})
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *BillingCycleUpsertBulk) UpdateInvoiceID() *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateInvoiceID()
+	})
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (u *BillingCycleUpsertBulk) ClearInvoiceID() *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.ClearInvoiceID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *BillingCycleUpsertBulk) SetStatus(v string) *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BillingCycleUpsertBulk) UpdateStatus() *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUsageAmount sets the "usage_amount" field.
+func (u *BillingCycleUpsertBulk) SetUsageAmount(v decimal.Decimal) *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetUsageAmount(v)
+	})
+}
+
+// AddUsageAmount adds v to the "usage_amount" field.
+func (u *BillingCycleUpsertBulk) AddUsageAmount(v decimal.Decimal) *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.AddUsageAmount(v)
+	})
+}
+
+// UpdateUsageAmount sets the "usage_amount" field to the value that was provided on create.
+func (u *BillingCycleUpsertBulk) UpdateUsageAmount() *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateUsageAmount()
+	})
+}
+
+// ClearUsageAmount clears the value of the "usage_amount" field.
+func (u *BillingCycleUpsertBulk) ClearUsageAmount() *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.ClearUsageAmount()
+	})
+}
+
+// SetBillingAmount sets the "billing_amount" field.
+func (u *BillingCycleUpsertBulk) SetBillingAmount(v decimal.Decimal) *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetBillingAmount(v)
+	})
+}
+
+// AddBillingAmount adds v to the "billing_amount" field.
+func (u *BillingCycleUpsertBulk) AddBillingAmount(v decimal.Decimal) *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.AddBillingAmount(v)
+	})
+}
+
+// UpdateBillingAmount sets the "billing_amount" field to the value that was provided on create.
+func (u *BillingCycleUpsertBulk) UpdateBillingAmount() *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateBillingAmount()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *BillingCycleUpsertBulk) SetMetadata(v map[string]interface{}) *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *BillingCycleUpsertBulk) UpdateMetadata() *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BillingCycleUpsertBulk) SetUpdatedAt(v time.Time) *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BillingCycleUpsertBulk) UpdateUpdatedAt() *BillingCycleUpsertBulk {
+	return u.Update(func(s *BillingCycleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BillingCycleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BillingCycleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BillingCycleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BillingCycleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,677 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/billingcycle"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/subscription"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BillingCycleUpdate is the builder for updating BillingCycle entities.
+type BillingCycleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BillingCycleMutation
+}
+
+// Where appends a list predicates to the BillingCycleUpdate builder.
+func (_u *BillingCycleUpdate) Where(ps ...predicate.BillingCycle) *BillingCycleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *BillingCycleUpdate) SetTenantID(v uuid.UUID) *BillingCycleUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *BillingCycleUpdate) SetNillableTenantID(v *uuid.UUID) *BillingCycleUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_u *BillingCycleUpdate) SetSubscriptionID(v uuid.UUID) *BillingCycleUpdate {
+	_u.mutation.SetSubscriptionID(v)
+	return _u
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_u *BillingCycleUpdate) SetNillableSubscriptionID(v *uuid.UUID) *BillingCycleUpdate {
+	if v != nil {
+		_u.SetSubscriptionID(*v)
+	}
+	return _u
+}
+
+// SetCycleStart sets the "cycle_start" field.
+func (_u *BillingCycleUpdate) SetCycleStart(v time.Time) *BillingCycleUpdate {
+	_u.mutation.SetCycleStart(v)
+	return _u
+}
+
+// SetNillableCycleStart sets the "cycle_start" field if the given value is not nil.
+func (_u *BillingCycleUpdate) SetNillableCycleStart(v *time.Time) *BillingCycleUpdate {
+	if v != nil {
+		_u.SetCycleStart(*v)
+	}
+	return _u
+}
+
+// SetCycleEnd sets the "cycle_end" field.
+func (_u *BillingCycleUpdate) SetCycleEnd(v time.Time) *BillingCycleUpdate {
+	_u.mutation.SetCycleEnd(v)
+	return _u
+}
+
+// SetNillableCycleEnd sets the "cycle_end" field if the given value is not nil.
+func (_u *BillingCycleUpdate) SetNillableCycleEnd(v *time.Time) *BillingCycleUpdate {
+	if v != nil {
+		_u.SetCycleEnd(*v)
+	}
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *BillingCycleUpdate) SetInvoiceID(v uuid.UUID) *BillingCycleUpdate {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *BillingCycleUpdate) SetNillableInvoiceID(v *uuid.UUID) *BillingCycleUpdate {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (_u *BillingCycleUpdate) ClearInvoiceID() *BillingCycleUpdate {
+	_u.mutation.ClearInvoiceID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BillingCycleUpdate) SetStatus(v string) *BillingCycleUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BillingCycleUpdate) SetNillableStatus(v *string) *BillingCycleUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetUsageAmount sets the "usage_amount" field.
+func (_u *BillingCycleUpdate) SetUsageAmount(v decimal.Decimal) *BillingCycleUpdate {
+	_u.mutation.ResetUsageAmount()
+	_u.mutation.SetUsageAmount(v)
+	return _u
+}
+
+// SetNillableUsageAmount sets the "usage_amount" field if the given value is not nil.
+func (_u *BillingCycleUpdate) SetNillableUsageAmount(v *decimal.Decimal) *BillingCycleUpdate {
+	if v != nil {
+		_u.SetUsageAmount(*v)
+	}
+	return _u
+}
+
+// AddUsageAmount adds value to the "usage_amount" field.
+func (_u *BillingCycleUpdate) AddUsageAmount(v decimal.Decimal) *BillingCycleUpdate {
+	_u.mutation.AddUsageAmount(v)
+	return _u
+}
+
+// ClearUsageAmount clears the value of the "usage_amount" field.
+func (_u *BillingCycleUpdate) ClearUsageAmount() *BillingCycleUpdate {
+	_u.mutation.ClearUsageAmount()
+	return _u
+}
+
+// SetBillingAmount sets the "billing_amount" field.
+func (_u *BillingCycleUpdate) SetBillingAmount(v decimal.Decimal) *BillingCycleUpdate {
+	_u.mutation.ResetBillingAmount()
+	_u.mutation.SetBillingAmount(v)
+	return _u
+}
+
+// SetNillableBillingAmount sets the "billing_amount" field if the given value is not nil.
+func (_u *BillingCycleUpdate) SetNillableBillingAmount(v *decimal.Decimal) *BillingCycleUpdate {
+	if v != nil {
+		_u.SetBillingAmount(*v)
+	}
+	return _u
+}
+
+// AddBillingAmount adds value to the "billing_amount" field.
+func (_u *BillingCycleUpdate) AddBillingAmount(v decimal.Decimal) *BillingCycleUpdate {
+	_u.mutation.AddBillingAmount(v)
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *BillingCycleUpdate) SetMetadata(v map[string]interface{}) *BillingCycleUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BillingCycleUpdate) SetUpdatedAt(v time.Time) *BillingCycleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSubscription sets the "subscription" edge to the Subscription entity.
+func (_u *BillingCycleUpdate) SetSubscription(v *Subscription) *BillingCycleUpdate {
+	return _u.SetSubscriptionID(v.ID)
+}
+
+// Mutation returns the BillingCycleMutation object of the builder.
+func (_u *BillingCycleUpdate) Mutation() *BillingCycleMutation {
+	return _u.mutation
+}
+
+// ClearSubscription clears the "subscription" edge to the Subscription entity.
+func (_u *BillingCycleUpdate) ClearSubscription() *BillingCycleUpdate {
+	_u.mutation.ClearSubscription()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BillingCycleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BillingCycleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BillingCycleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BillingCycleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BillingCycleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := billingcycle.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BillingCycleUpdate) check() error {
+	if _u.mutation.SubscriptionCleared() && len(_u.mutation.SubscriptionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BillingCycle.subscription"`)
+	}
+	return nil
+}
+
+func (_u *BillingCycleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(billingcycle.Table, billingcycle.Columns, sqlgraph.NewFieldSpec(billingcycle.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(billingcycle.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.CycleStart(); ok {
+		_spec.SetField(billingcycle.FieldCycleStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CycleEnd(); ok {
+		_spec.SetField(billingcycle.FieldCycleEnd, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.InvoiceID(); ok {
+		_spec.SetField(billingcycle.FieldInvoiceID, field.TypeUUID, value)
+	}
+	if _u.mutation.InvoiceIDCleared() {
+		_spec.ClearField(billingcycle.FieldInvoiceID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(billingcycle.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UsageAmount(); ok {
+		_spec.SetField(billingcycle.FieldUsageAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedUsageAmount(); ok {
+		_spec.AddField(billingcycle.FieldUsageAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.UsageAmountCleared() {
+		_spec.ClearField(billingcycle.FieldUsageAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.BillingAmount(); ok {
+		_spec.SetField(billingcycle.FieldBillingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBillingAmount(); ok {
+		_spec.AddField(billingcycle.FieldBillingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(billingcycle.FieldMetadata, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(billingcycle.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubscriptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   billingcycle.SubscriptionTable,
+			Columns: []string{billingcycle.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   billingcycle.SubscriptionTable,
+			Columns: []string{billingcycle.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{billingcycle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BillingCycleUpdateOne is the builder for updating a single BillingCycle entity.
+type BillingCycleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BillingCycleMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *BillingCycleUpdateOne) SetTenantID(v uuid.UUID) *BillingCycleUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *BillingCycleUpdateOne) SetNillableTenantID(v *uuid.UUID) *BillingCycleUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_u *BillingCycleUpdateOne) SetSubscriptionID(v uuid.UUID) *BillingCycleUpdateOne {
+	_u.mutation.SetSubscriptionID(v)
+	return _u
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_u *BillingCycleUpdateOne) SetNillableSubscriptionID(v *uuid.UUID) *BillingCycleUpdateOne {
+	if v != nil {
+		_u.SetSubscriptionID(*v)
+	}
+	return _u
+}
+
+// SetCycleStart sets the "cycle_start" field.
+func (_u *BillingCycleUpdateOne) SetCycleStart(v time.Time) *BillingCycleUpdateOne {
+	_u.mutation.SetCycleStart(v)
+	return _u
+}
+
+// SetNillableCycleStart sets the "cycle_start" field if the given value is not nil.
+func (_u *BillingCycleUpdateOne) SetNillableCycleStart(v *time.Time) *BillingCycleUpdateOne {
+	if v != nil {
+		_u.SetCycleStart(*v)
+	}
+	return _u
+}
+
+// SetCycleEnd sets the "cycle_end" field.
+func (_u *BillingCycleUpdateOne) SetCycleEnd(v time.Time) *BillingCycleUpdateOne {
+	_u.mutation.SetCycleEnd(v)
+	return _u
+}
+
+// SetNillableCycleEnd sets the "cycle_end" field if the given value is not nil.
+func (_u *BillingCycleUpdateOne) SetNillableCycleEnd(v *time.Time) *BillingCycleUpdateOne {
+	if v != nil {
+		_u.SetCycleEnd(*v)
+	}
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *BillingCycleUpdateOne) SetInvoiceID(v uuid.UUID) *BillingCycleUpdateOne {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *BillingCycleUpdateOne) SetNillableInvoiceID(v *uuid.UUID) *BillingCycleUpdateOne {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (_u *BillingCycleUpdateOne) ClearInvoiceID() *BillingCycleUpdateOne {
+	_u.mutation.ClearInvoiceID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BillingCycleUpdateOne) SetStatus(v string) *BillingCycleUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BillingCycleUpdateOne) SetNillableStatus(v *string) *BillingCycleUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetUsageAmount sets the "usage_amount" field.
+func (_u *BillingCycleUpdateOne) SetUsageAmount(v decimal.Decimal) *BillingCycleUpdateOne {
+	_u.mutation.ResetUsageAmount()
+	_u.mutation.SetUsageAmount(v)
+	return _u
+}
+
+// SetNillableUsageAmount sets the "usage_amount" field if the given value is not nil.
+func (_u *BillingCycleUpdateOne) SetNillableUsageAmount(v *decimal.Decimal) *BillingCycleUpdateOne {
+	if v != nil {
+		_u.SetUsageAmount(*v)
+	}
+	return _u
+}
+
+// AddUsageAmount adds value to the "usage_amount" field.
+func (_u *BillingCycleUpdateOne) AddUsageAmount(v decimal.Decimal) *BillingCycleUpdateOne {
+	_u.mutation.AddUsageAmount(v)
+	return _u
+}
+
+// ClearUsageAmount clears the value of the "usage_amount" field.
+func (_u *BillingCycleUpdateOne) ClearUsageAmount() *BillingCycleUpdateOne {
+	_u.mutation.ClearUsageAmount()
+	return _u
+}
+
+// SetBillingAmount sets the "billing_amount" field.
+func (_u *BillingCycleUpdateOne) SetBillingAmount(v decimal.Decimal) *BillingCycleUpdateOne {
+	_u.mutation.ResetBillingAmount()
+	_u.mutation.SetBillingAmount(v)
+	return _u
+}
+
+// SetNillableBillingAmount sets the "billing_amount" field if the given value is not nil.
+func (_u *BillingCycleUpdateOne) SetNillableBillingAmount(v *decimal.Decimal) *BillingCycleUpdateOne {
+	if v != nil {
+		_u.SetBillingAmount(*v)
+	}
+	return _u
+}
+
+// AddBillingAmount adds value to the "billing_amount" field.
+func (_u *BillingCycleUpdateOne) AddBillingAmount(v decimal.Decimal) *BillingCycleUpdateOne {
+	_u.mutation.AddBillingAmount(v)
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *BillingCycleUpdateOne) SetMetadata(v map[string]interface{}) *BillingCycleUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BillingCycleUpdateOne) SetUpdatedAt(v time.Time) *BillingCycleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSubscription sets the "subscription" edge to the Subscription entity.
+func (_u *BillingCycleUpdateOne) SetSubscription(v *Subscription) *BillingCycleUpdateOne {
+	return _u.SetSubscriptionID(v.ID)
+}
+
+// Mutation returns the BillingCycleMutation object of the builder.
+func (_u *BillingCycleUpdateOne) Mutation() *BillingCycleMutation {
+	return _u.mutation
+}
+
+// ClearSubscription clears the "subscription" edge to the Subscription entity.
+func (_u *BillingCycleUpdateOne) ClearSubscription() *BillingCycleUpdateOne {
+	_u.mutation.ClearSubscription()
+	return _u
+}
+
+// Where appends a list predicates to the BillingCycleUpdate builder.
+func (_u *BillingCycleUpdateOne) Where(ps ...predicate.BillingCycle) *BillingCycleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BillingCycleUpdateOne) Select(field string, fields ...string) *BillingCycleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BillingCycle entity.
+func (_u *BillingCycleUpdateOne) Save(ctx context.Context) (*BillingCycle, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BillingCycleUpdateOne) SaveX(ctx context.Context) *BillingCycle {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BillingCycleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BillingCycleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BillingCycleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := billingcycle.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BillingCycleUpdateOne) check() error {
+	if _u.mutation.SubscriptionCleared() && len(_u.mutation.SubscriptionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BillingCycle.subscription"`)
+	}
+	return nil
+}
+
+func (_u *BillingCycleUpdateOne) sqlSave(ctx context.Context) (_node *BillingCycle, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(billingcycle.Table, billingcycle.Columns, sqlgraph.NewFieldSpec(billingcycle.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BillingCycle.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, billingcycle.FieldID)
+		for _, f := range fields {
+			if !billingcycle.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != billingcycle.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(billingcycle.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.CycleStart(); ok {
+		_spec.SetField(billingcycle.FieldCycleStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CycleEnd(); ok {
+		_spec.SetField(billingcycle.FieldCycleEnd, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.InvoiceID(); ok {
+		_spec.SetField(billingcycle.FieldInvoiceID, field.TypeUUID, value)
+	}
+	if _u.mutation.InvoiceIDCleared() {
+		_spec.ClearField(billingcycle.FieldInvoiceID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(billingcycle.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UsageAmount(); ok {
+		_spec.SetField(billingcycle.FieldUsageAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedUsageAmount(); ok {
+		_spec.AddField(billingcycle.FieldUsageAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.UsageAmountCleared() {
+		_spec.ClearField(billingcycle.FieldUsageAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.BillingAmount(); ok {
+		_spec.SetField(billingcycle.FieldBillingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBillingAmount(); ok {
+		_spec.AddField(billingcycle.FieldBillingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(billingcycle.FieldMetadata, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(billingcycle.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubscriptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   billingcycle.SubscriptionTable,
+			Columns: []string{billingcycle.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   billingcycle.SubscriptionTable,
+			Columns: []string{billingcycle.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BillingCycle{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{billingcycle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
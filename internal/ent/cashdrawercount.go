@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawercount"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CashDrawerCount is the model entity for the CashDrawerCount schema.
+type CashDrawerCount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// SessionID holds the value of the "session_id" field.
+	SessionID uuid.UUID `json:"session_id,omitempty"`
+	// Face value of the note or coin
+	Denomination decimal.Decimal `json:"denomination,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// Denomination times quantity
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CashDrawerCount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case cashdrawercount.FieldDenomination, cashdrawercount.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case cashdrawercount.FieldQuantity:
+			values[i] = new(sql.NullInt64)
+		case cashdrawercount.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case cashdrawercount.FieldID, cashdrawercount.FieldTenantID, cashdrawercount.FieldSessionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CashDrawerCount fields.
+func (_m *CashDrawerCount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case cashdrawercount.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case cashdrawercount.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case cashdrawercount.FieldSessionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value != nil {
+				_m.SessionID = *value
+			}
+		case cashdrawercount.FieldDenomination:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field denomination", values[i])
+			} else if value != nil {
+				_m.Denomination = *value
+			}
+		case cashdrawercount.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				_m.Quantity = int(value.Int64)
+			}
+		case cashdrawercount.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case cashdrawercount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CashDrawerCount.
+// This includes values selected through modifiers, order, etc.
+func (_m *CashDrawerCount) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CashDrawerCount.
+// Note that you need to call CashDrawerCount.Unwrap() before calling this method if this CashDrawerCount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CashDrawerCount) Update() *CashDrawerCountUpdateOne {
+	return NewCashDrawerCountClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CashDrawerCount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CashDrawerCount) Unwrap() *CashDrawerCount {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CashDrawerCount is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CashDrawerCount) String() string {
+	var builder strings.Builder
+	builder.WriteString("CashDrawerCount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("session_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SessionID))
+	builder.WriteString(", ")
+	builder.WriteString("denomination=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Denomination))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CashDrawerCounts is a parsable slice of CashDrawerCount.
+type CashDrawerCounts []*CashDrawerCount
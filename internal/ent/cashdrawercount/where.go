@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cashdrawercount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldEQ(FieldTenantID, v))
+}
+
+// SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
+func SessionID(v uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldEQ(FieldSessionID, v))
+}
+
+// Denomination applies equality check predicate on the "denomination" field. It's identical to DenominationEQ.
+func Denomination(v decimal.Decimal) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldEQ(FieldDenomination, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldEQ(FieldQuantity, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldEQ(FieldAmount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldLTE(FieldTenantID, v))
+}
+
+// SessionIDEQ applies the EQ predicate on the "session_id" field.
+func SessionIDEQ(v uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldEQ(FieldSessionID, v))
+}
+
+// SessionIDNEQ applies the NEQ predicate on the "session_id" field.
+func SessionIDNEQ(v uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldNEQ(FieldSessionID, v))
+}
+
+// SessionIDIn applies the In predicate on the "session_id" field.
+func SessionIDIn(vs ...uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldIn(FieldSessionID, vs...))
+}
+
+// SessionIDNotIn applies the NotIn predicate on the "session_id" field.
+func SessionIDNotIn(vs ...uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldNotIn(FieldSessionID, vs...))
+}
+
+// SessionIDGT applies the GT predicate on the "session_id" field.
+func SessionIDGT(v uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldGT(FieldSessionID, v))
+}
+
+// SessionIDGTE applies the GTE predicate on the "session_id" field.
+func SessionIDGTE(v uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldGTE(FieldSessionID, v))
+}
+
+// SessionIDLT applies the LT predicate on the "session_id" field.
+func SessionIDLT(v uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldLT(FieldSessionID, v))
+}
+
+// SessionIDLTE applies the LTE predicate on the "session_id" field.
+func SessionIDLTE(v uuid.UUID) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldLTE(FieldSessionID, v))
+}
+
+// DenominationEQ applies the EQ predicate on the "denomination" field.
+func DenominationEQ(v decimal.Decimal) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldEQ(FieldDenomination, v))
+}
+
+// DenominationNEQ applies the NEQ predicate on the "denomination" field.
+func DenominationNEQ(v decimal.Decimal) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldNEQ(FieldDenomination, v))
+}
+
+// DenominationIn applies the In predicate on the "denomination" field.
+func DenominationIn(vs ...decimal.Decimal) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldIn(FieldDenomination, vs...))
+}
+
+// DenominationNotIn applies the NotIn predicate on the "denomination" field.
+func DenominationNotIn(vs ...decimal.Decimal) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldNotIn(FieldDenomination, vs...))
+}
+
+// DenominationGT applies the GT predicate on the "denomination" field.
+func DenominationGT(v decimal.Decimal) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldGT(FieldDenomination, v))
+}
+
+// DenominationGTE applies the GTE predicate on the "denomination" field.
+func DenominationGTE(v decimal.Decimal) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldGTE(FieldDenomination, v))
+}
+
+// DenominationLT applies the LT predicate on the "denomination" field.
+func DenominationLT(v decimal.Decimal) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldLT(FieldDenomination, v))
+}
+
+// DenominationLTE applies the LTE predicate on the "denomination" field.
+func DenominationLTE(v decimal.Decimal) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldLTE(FieldDenomination, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldLTE(FieldQuantity, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldLTE(FieldAmount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CashDrawerCount) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CashDrawerCount) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CashDrawerCount) predicate.CashDrawerCount {
+	return predicate.CashDrawerCount(sql.NotPredicates(p))
+}
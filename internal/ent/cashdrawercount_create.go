@@ -0,0 +1,622 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawercount"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CashDrawerCountCreate is the builder for creating a CashDrawerCount entity.
+type CashDrawerCountCreate struct {
+	config
+	mutation *CashDrawerCountMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *CashDrawerCountCreate) SetTenantID(v uuid.UUID) *CashDrawerCountCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetSessionID sets the "session_id" field.
+func (_c *CashDrawerCountCreate) SetSessionID(v uuid.UUID) *CashDrawerCountCreate {
+	_c.mutation.SetSessionID(v)
+	return _c
+}
+
+// SetDenomination sets the "denomination" field.
+func (_c *CashDrawerCountCreate) SetDenomination(v decimal.Decimal) *CashDrawerCountCreate {
+	_c.mutation.SetDenomination(v)
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *CashDrawerCountCreate) SetQuantity(v int) *CashDrawerCountCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *CashDrawerCountCreate) SetAmount(v decimal.Decimal) *CashDrawerCountCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CashDrawerCountCreate) SetCreatedAt(v time.Time) *CashDrawerCountCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CashDrawerCountCreate) SetNillableCreatedAt(v *time.Time) *CashDrawerCountCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CashDrawerCountCreate) SetID(v uuid.UUID) *CashDrawerCountCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CashDrawerCountCreate) SetNillableID(v *uuid.UUID) *CashDrawerCountCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CashDrawerCountMutation object of the builder.
+func (_c *CashDrawerCountCreate) Mutation() *CashDrawerCountMutation {
+	return _c.mutation
+}
+
+// Save creates the CashDrawerCount in the database.
+func (_c *CashDrawerCountCreate) Save(ctx context.Context) (*CashDrawerCount, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CashDrawerCountCreate) SaveX(ctx context.Context) *CashDrawerCount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CashDrawerCountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CashDrawerCountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CashDrawerCountCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := cashdrawercount.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := cashdrawercount.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CashDrawerCountCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "CashDrawerCount.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "CashDrawerCount.session_id"`)}
+	}
+	if _, ok := _c.mutation.Denomination(); !ok {
+		return &ValidationError{Name: "denomination", err: errors.New(`ent: missing required field "CashDrawerCount.denomination"`)}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "CashDrawerCount.quantity"`)}
+	}
+	if v, ok := _c.mutation.Quantity(); ok {
+		if err := cashdrawercount.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "CashDrawerCount.quantity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "CashDrawerCount.amount"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CashDrawerCount.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *CashDrawerCountCreate) sqlSave(ctx context.Context) (*CashDrawerCount, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CashDrawerCountCreate) createSpec() (*CashDrawerCount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CashDrawerCount{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(cashdrawercount.Table, sqlgraph.NewFieldSpec(cashdrawercount.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(cashdrawercount.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.SessionID(); ok {
+		_spec.SetField(cashdrawercount.FieldSessionID, field.TypeUUID, value)
+		_node.SessionID = value
+	}
+	if value, ok := _c.mutation.Denomination(); ok {
+		_spec.SetField(cashdrawercount.FieldDenomination, field.TypeFloat64, value)
+		_node.Denomination = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(cashdrawercount.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(cashdrawercount.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(cashdrawercount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CashDrawerCount.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CashDrawerCountUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CashDrawerCountCreate) OnConflict(opts ...sql.ConflictOption) *CashDrawerCountUpsertOne {
+	_c.conflict = opts
+	return &CashDrawerCountUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CashDrawerCount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CashDrawerCountCreate) OnConflictColumns(columns ...string) *CashDrawerCountUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CashDrawerCountUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CashDrawerCountUpsertOne is the builder for "upsert"-ing
+	//  one CashDrawerCount node.
+	CashDrawerCountUpsertOne struct {
+		create *CashDrawerCountCreate
+	}
+
+	// CashDrawerCountUpsert is the "OnConflict" setter.
+	CashDrawerCountUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *CashDrawerCountUpsert) SetTenantID(v uuid.UUID) *CashDrawerCountUpsert {
+	u.Set(cashdrawercount.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *CashDrawerCountUpsert) UpdateTenantID() *CashDrawerCountUpsert {
+	u.SetExcluded(cashdrawercount.FieldTenantID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CashDrawerCount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(cashdrawercount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CashDrawerCountUpsertOne) UpdateNewValues() *CashDrawerCountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(cashdrawercount.FieldID)
+		}
+		if _, exists := u.create.mutation.SessionID(); exists {
+			s.SetIgnore(cashdrawercount.FieldSessionID)
+		}
+		if _, exists := u.create.mutation.Denomination(); exists {
+			s.SetIgnore(cashdrawercount.FieldDenomination)
+		}
+		if _, exists := u.create.mutation.Quantity(); exists {
+			s.SetIgnore(cashdrawercount.FieldQuantity)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(cashdrawercount.FieldAmount)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(cashdrawercount.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CashDrawerCount.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CashDrawerCountUpsertOne) Ignore() *CashDrawerCountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CashDrawerCountUpsertOne) DoNothing() *CashDrawerCountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CashDrawerCountCreate.OnConflict
+// documentation for more info.
+func (u *CashDrawerCountUpsertOne) Update(set func(*CashDrawerCountUpsert)) *CashDrawerCountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CashDrawerCountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *CashDrawerCountUpsertOne) SetTenantID(v uuid.UUID) *CashDrawerCountUpsertOne {
+	return u.Update(func(s *CashDrawerCountUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *CashDrawerCountUpsertOne) UpdateTenantID() *CashDrawerCountUpsertOne {
+	return u.Update(func(s *CashDrawerCountUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// Exec executes the query.
+func (u *CashDrawerCountUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CashDrawerCountCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CashDrawerCountUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CashDrawerCountUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CashDrawerCountUpsertOne.ID is not supported by MySQL driver. Use CashDrawerCountUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CashDrawerCountUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CashDrawerCountCreateBulk is the builder for creating many CashDrawerCount entities in bulk.
+type CashDrawerCountCreateBulk struct {
+	config
+	err      error
+	builders []*CashDrawerCountCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CashDrawerCount entities in the database.
+func (_c *CashDrawerCountCreateBulk) Save(ctx context.Context) ([]*CashDrawerCount, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CashDrawerCount, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CashDrawerCountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CashDrawerCountCreateBulk) SaveX(ctx context.Context) []*CashDrawerCount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CashDrawerCountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CashDrawerCountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CashDrawerCount.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CashDrawerCountUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CashDrawerCountCreateBulk) OnConflict(opts ...sql.ConflictOption) *CashDrawerCountUpsertBulk {
+	_c.conflict = opts
+	return &CashDrawerCountUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CashDrawerCount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CashDrawerCountCreateBulk) OnConflictColumns(columns ...string) *CashDrawerCountUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CashDrawerCountUpsertBulk{
+		create: _c,
+	}
+}
+
+// CashDrawerCountUpsertBulk is the builder for "upsert"-ing
+// a bulk of CashDrawerCount nodes.
+type CashDrawerCountUpsertBulk struct {
+	create *CashDrawerCountCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CashDrawerCount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(cashdrawercount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CashDrawerCountUpsertBulk) UpdateNewValues() *CashDrawerCountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(cashdrawercount.FieldID)
+			}
+			if _, exists := b.mutation.SessionID(); exists {
+				s.SetIgnore(cashdrawercount.FieldSessionID)
+			}
+			if _, exists := b.mutation.Denomination(); exists {
+				s.SetIgnore(cashdrawercount.FieldDenomination)
+			}
+			if _, exists := b.mutation.Quantity(); exists {
+				s.SetIgnore(cashdrawercount.FieldQuantity)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(cashdrawercount.FieldAmount)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(cashdrawercount.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CashDrawerCount.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CashDrawerCountUpsertBulk) Ignore() *CashDrawerCountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CashDrawerCountUpsertBulk) DoNothing() *CashDrawerCountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CashDrawerCountCreateBulk.OnConflict
+// documentation for more info.
+func (u *CashDrawerCountUpsertBulk) Update(set func(*CashDrawerCountUpsert)) *CashDrawerCountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CashDrawerCountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *CashDrawerCountUpsertBulk) SetTenantID(v uuid.UUID) *CashDrawerCountUpsertBulk {
+	return u.Update(func(s *CashDrawerCountUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *CashDrawerCountUpsertBulk) UpdateTenantID() *CashDrawerCountUpsertBulk {
+	return u.Update(func(s *CashDrawerCountUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// Exec executes the query.
+func (u *CashDrawerCountUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CashDrawerCountCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CashDrawerCountCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CashDrawerCountUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawercount"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+)
+
+// CashDrawerCountDelete is the builder for deleting a CashDrawerCount entity.
+type CashDrawerCountDelete struct {
+	config
+	hooks    []Hook
+	mutation *CashDrawerCountMutation
+}
+
+// Where appends a list predicates to the CashDrawerCountDelete builder.
+func (_d *CashDrawerCountDelete) Where(ps ...predicate.CashDrawerCount) *CashDrawerCountDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CashDrawerCountDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CashDrawerCountDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CashDrawerCountDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(cashdrawercount.Table, sqlgraph.NewFieldSpec(cashdrawercount.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CashDrawerCountDeleteOne is the builder for deleting a single CashDrawerCount entity.
+type CashDrawerCountDeleteOne struct {
+	_d *CashDrawerCountDelete
+}
+
+// Where appends a list predicates to the CashDrawerCountDelete builder.
+func (_d *CashDrawerCountDeleteOne) Where(ps ...predicate.CashDrawerCount) *CashDrawerCountDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CashDrawerCountDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{cashdrawercount.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CashDrawerCountDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawercount"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// CashDrawerCountUpdate is the builder for updating CashDrawerCount entities.
+type CashDrawerCountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CashDrawerCountMutation
+}
+
+// Where appends a list predicates to the CashDrawerCountUpdate builder.
+func (_u *CashDrawerCountUpdate) Where(ps ...predicate.CashDrawerCount) *CashDrawerCountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *CashDrawerCountUpdate) SetTenantID(v uuid.UUID) *CashDrawerCountUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *CashDrawerCountUpdate) SetNillableTenantID(v *uuid.UUID) *CashDrawerCountUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CashDrawerCountMutation object of the builder.
+func (_u *CashDrawerCountUpdate) Mutation() *CashDrawerCountMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CashDrawerCountUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CashDrawerCountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CashDrawerCountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CashDrawerCountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CashDrawerCountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(cashdrawercount.Table, cashdrawercount.Columns, sqlgraph.NewFieldSpec(cashdrawercount.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(cashdrawercount.FieldTenantID, field.TypeUUID, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cashdrawercount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CashDrawerCountUpdateOne is the builder for updating a single CashDrawerCount entity.
+type CashDrawerCountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CashDrawerCountMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *CashDrawerCountUpdateOne) SetTenantID(v uuid.UUID) *CashDrawerCountUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *CashDrawerCountUpdateOne) SetNillableTenantID(v *uuid.UUID) *CashDrawerCountUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CashDrawerCountMutation object of the builder.
+func (_u *CashDrawerCountUpdateOne) Mutation() *CashDrawerCountMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CashDrawerCountUpdate builder.
+func (_u *CashDrawerCountUpdateOne) Where(ps ...predicate.CashDrawerCount) *CashDrawerCountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CashDrawerCountUpdateOne) Select(field string, fields ...string) *CashDrawerCountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CashDrawerCount entity.
+func (_u *CashDrawerCountUpdateOne) Save(ctx context.Context) (*CashDrawerCount, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CashDrawerCountUpdateOne) SaveX(ctx context.Context) *CashDrawerCount {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CashDrawerCountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CashDrawerCountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CashDrawerCountUpdateOne) sqlSave(ctx context.Context) (_node *CashDrawerCount, err error) {
+	_spec := sqlgraph.NewUpdateSpec(cashdrawercount.Table, cashdrawercount.Columns, sqlgraph.NewFieldSpec(cashdrawercount.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CashDrawerCount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cashdrawercount.FieldID)
+		for _, f := range fields {
+			if !cashdrawercount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != cashdrawercount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(cashdrawercount.FieldTenantID, field.TypeUUID, value)
+	}
+	_node = &CashDrawerCount{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cashdrawercount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
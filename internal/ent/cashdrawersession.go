@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawersession"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CashDrawerSession is the model entity for the CashDrawerSession schema.
+type CashDrawerSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Drawer session identifier in the POS service
+	PosSessionID string `json:"pos_session_id,omitempty"`
+	// Cash drawer identifier in the POS service
+	DrawerID string `json:"drawer_id,omitempty"`
+	// OutletID holds the value of the "outlet_id" field.
+	OutletID string `json:"outlet_id,omitempty"`
+	// POS user who ran the session
+	CashierID string `json:"cashier_id,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// OpenedAt holds the value of the "opened_at" field.
+	OpenedAt time.Time `json:"opened_at,omitempty"`
+	// ClosedAt holds the value of the "closed_at" field.
+	ClosedAt time.Time `json:"closed_at,omitempty"`
+	// OpeningFloat holds the value of the "opening_float" field.
+	OpeningFloat decimal.Decimal `json:"opening_float,omitempty"`
+	// Cash payments taken into the drawer during the session
+	CashSales decimal.Decimal `json:"cash_sales,omitempty"`
+	// Cash refunds paid out of the drawer during the session
+	CashRefunds decimal.Decimal `json:"cash_refunds,omitempty"`
+	// Number of cash payments and refunds in the session
+	CashTransactions int `json:"cash_transactions,omitempty"`
+	// Opening float plus cash sales less cash refunds
+	ExpectedCash decimal.Decimal `json:"expected_cash,omitempty"`
+	// Total of the denomination counts
+	CountedCash decimal.Decimal `json:"counted_cash,omitempty"`
+	// Counted less expected cash: positive when over, negative when short
+	Variance decimal.Decimal `json:"variance,omitempty"`
+	// Status: balanced, posted, pending_approval, approved, rejected
+	Status string `json:"status,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes string `json:"notes,omitempty"`
+	// RejectionReason holds the value of the "rejection_reason" field.
+	RejectionReason string `json:"rejection_reason,omitempty"`
+	// Supervisor who approved or rejected the variance
+	ReviewedBy uuid.UUID `json:"reviewed_by,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt time.Time `json:"reviewed_at,omitempty"`
+	// Over/short journal of the variance
+	JournalEntryID uuid.UUID `json:"journal_entry_id,omitempty"`
+	// pos.cash.drawer.closed event the session was recorded from
+	EventID string `json:"event_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CashDrawerSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case cashdrawersession.FieldOpeningFloat, cashdrawersession.FieldCashSales, cashdrawersession.FieldCashRefunds, cashdrawersession.FieldExpectedCash, cashdrawersession.FieldCountedCash, cashdrawersession.FieldVariance:
+			values[i] = new(decimal.Decimal)
+		case cashdrawersession.FieldCashTransactions:
+			values[i] = new(sql.NullInt64)
+		case cashdrawersession.FieldPosSessionID, cashdrawersession.FieldDrawerID, cashdrawersession.FieldOutletID, cashdrawersession.FieldCashierID, cashdrawersession.FieldCurrency, cashdrawersession.FieldStatus, cashdrawersession.FieldNotes, cashdrawersession.FieldRejectionReason, cashdrawersession.FieldEventID:
+			values[i] = new(sql.NullString)
+		case cashdrawersession.FieldOpenedAt, cashdrawersession.FieldClosedAt, cashdrawersession.FieldReviewedAt, cashdrawersession.FieldCreatedAt, cashdrawersession.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case cashdrawersession.FieldID, cashdrawersession.FieldTenantID, cashdrawersession.FieldReviewedBy, cashdrawersession.FieldJournalEntryID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CashDrawerSession fields.
+func (_m *CashDrawerSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case cashdrawersession.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case cashdrawersession.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case cashdrawersession.FieldPosSessionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pos_session_id", values[i])
+			} else if value.Valid {
+				_m.PosSessionID = value.String
+			}
+		case cashdrawersession.FieldDrawerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field drawer_id", values[i])
+			} else if value.Valid {
+				_m.DrawerID = value.String
+			}
+		case cashdrawersession.FieldOutletID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field outlet_id", values[i])
+			} else if value.Valid {
+				_m.OutletID = value.String
+			}
+		case cashdrawersession.FieldCashierID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cashier_id", values[i])
+			} else if value.Valid {
+				_m.CashierID = value.String
+			}
+		case cashdrawersession.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case cashdrawersession.FieldOpenedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field opened_at", values[i])
+			} else if value.Valid {
+				_m.OpenedAt = value.Time
+			}
+		case cashdrawersession.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				_m.ClosedAt = value.Time
+			}
+		case cashdrawersession.FieldOpeningFloat:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field opening_float", values[i])
+			} else if value != nil {
+				_m.OpeningFloat = *value
+			}
+		case cashdrawersession.FieldCashSales:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field cash_sales", values[i])
+			} else if value != nil {
+				_m.CashSales = *value
+			}
+		case cashdrawersession.FieldCashRefunds:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field cash_refunds", values[i])
+			} else if value != nil {
+				_m.CashRefunds = *value
+			}
+		case cashdrawersession.FieldCashTransactions:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cash_transactions", values[i])
+			} else if value.Valid {
+				_m.CashTransactions = int(value.Int64)
+			}
+		case cashdrawersession.FieldExpectedCash:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field expected_cash", values[i])
+			} else if value != nil {
+				_m.ExpectedCash = *value
+			}
+		case cashdrawersession.FieldCountedCash:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field counted_cash", values[i])
+			} else if value != nil {
+				_m.CountedCash = *value
+			}
+		case cashdrawersession.FieldVariance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field variance", values[i])
+			} else if value != nil {
+				_m.Variance = *value
+			}
+		case cashdrawersession.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case cashdrawersession.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = value.String
+			}
+		case cashdrawersession.FieldRejectionReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rejection_reason", values[i])
+			} else if value.Valid {
+				_m.RejectionReason = value.String
+			}
+		case cashdrawersession.FieldReviewedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value != nil {
+				_m.ReviewedBy = *value
+			}
+		case cashdrawersession.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				_m.ReviewedAt = value.Time
+			}
+		case cashdrawersession.FieldJournalEntryID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field journal_entry_id", values[i])
+			} else if value != nil {
+				_m.JournalEntryID = *value
+			}
+		case cashdrawersession.FieldEventID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				_m.EventID = value.String
+			}
+		case cashdrawersession.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case cashdrawersession.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CashDrawerSession.
+// This includes values selected through modifiers, order, etc.
+func (_m *CashDrawerSession) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CashDrawerSession.
+// Note that you need to call CashDrawerSession.Unwrap() before calling this method if this CashDrawerSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CashDrawerSession) Update() *CashDrawerSessionUpdateOne {
+	return NewCashDrawerSessionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CashDrawerSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CashDrawerSession) Unwrap() *CashDrawerSession {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CashDrawerSession is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CashDrawerSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("CashDrawerSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("pos_session_id=")
+	builder.WriteString(_m.PosSessionID)
+	builder.WriteString(", ")
+	builder.WriteString("drawer_id=")
+	builder.WriteString(_m.DrawerID)
+	builder.WriteString(", ")
+	builder.WriteString("outlet_id=")
+	builder.WriteString(_m.OutletID)
+	builder.WriteString(", ")
+	builder.WriteString("cashier_id=")
+	builder.WriteString(_m.CashierID)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("opened_at=")
+	builder.WriteString(_m.OpenedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("closed_at=")
+	builder.WriteString(_m.ClosedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("opening_float=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OpeningFloat))
+	builder.WriteString(", ")
+	builder.WriteString("cash_sales=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CashSales))
+	builder.WriteString(", ")
+	builder.WriteString("cash_refunds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CashRefunds))
+	builder.WriteString(", ")
+	builder.WriteString("cash_transactions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CashTransactions))
+	builder.WriteString(", ")
+	builder.WriteString("expected_cash=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExpectedCash))
+	builder.WriteString(", ")
+	builder.WriteString("counted_cash=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CountedCash))
+	builder.WriteString(", ")
+	builder.WriteString("variance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Variance))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(_m.Notes)
+	builder.WriteString(", ")
+	builder.WriteString("rejection_reason=")
+	builder.WriteString(_m.RejectionReason)
+	builder.WriteString(", ")
+	builder.WriteString("reviewed_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReviewedBy))
+	builder.WriteString(", ")
+	builder.WriteString("reviewed_at=")
+	builder.WriteString(_m.ReviewedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("journal_entry_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JournalEntryID))
+	builder.WriteString(", ")
+	builder.WriteString("event_id=")
+	builder.WriteString(_m.EventID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CashDrawerSessions is a parsable slice of CashDrawerSession.
+type CashDrawerSessions []*CashDrawerSession
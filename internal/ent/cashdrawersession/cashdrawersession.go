@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cashdrawersession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the cashdrawersession type in the database.
+	Label = "cash_drawer_session"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldPosSessionID holds the string denoting the pos_session_id field in the database.
+	FieldPosSessionID = "pos_session_id"
+	// FieldDrawerID holds the string denoting the drawer_id field in the database.
+	FieldDrawerID = "drawer_id"
+	// FieldOutletID holds the string denoting the outlet_id field in the database.
+	FieldOutletID = "outlet_id"
+	// FieldCashierID holds the string denoting the cashier_id field in the database.
+	FieldCashierID = "cashier_id"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldOpenedAt holds the string denoting the opened_at field in the database.
+	FieldOpenedAt = "opened_at"
+	// FieldClosedAt holds the string denoting the closed_at field in the database.
+	FieldClosedAt = "closed_at"
+	// FieldOpeningFloat holds the string denoting the opening_float field in the database.
+	FieldOpeningFloat = "opening_float"
+	// FieldCashSales holds the string denoting the cash_sales field in the database.
+	FieldCashSales = "cash_sales"
+	// FieldCashRefunds holds the string denoting the cash_refunds field in the database.
+	FieldCashRefunds = "cash_refunds"
+	// FieldCashTransactions holds the string denoting the cash_transactions field in the database.
+	FieldCashTransactions = "cash_transactions"
+	// FieldExpectedCash holds the string denoting the expected_cash field in the database.
+	FieldExpectedCash = "expected_cash"
+	// FieldCountedCash holds the string denoting the counted_cash field in the database.
+	FieldCountedCash = "counted_cash"
+	// FieldVariance holds the string denoting the variance field in the database.
+	FieldVariance = "variance"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// FieldRejectionReason holds the string denoting the rejection_reason field in the database.
+	FieldRejectionReason = "rejection_reason"
+	// FieldReviewedBy holds the string denoting the reviewed_by field in the database.
+	FieldReviewedBy = "reviewed_by"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldJournalEntryID holds the string denoting the journal_entry_id field in the database.
+	FieldJournalEntryID = "journal_entry_id"
+	// FieldEventID holds the string denoting the event_id field in the database.
+	FieldEventID = "event_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the cashdrawersession in the database.
+	Table = "cash_drawer_sessions"
+)
+
+// Columns holds all SQL columns for cashdrawersession fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldPosSessionID,
+	FieldDrawerID,
+	FieldOutletID,
+	FieldCashierID,
+	FieldCurrency,
+	FieldOpenedAt,
+	FieldClosedAt,
+	FieldOpeningFloat,
+	FieldCashSales,
+	FieldCashRefunds,
+	FieldCashTransactions,
+	FieldExpectedCash,
+	FieldCountedCash,
+	FieldVariance,
+	FieldStatus,
+	FieldNotes,
+	FieldRejectionReason,
+	FieldReviewedBy,
+	FieldReviewedAt,
+	FieldJournalEntryID,
+	FieldEventID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PosSessionIDValidator is a validator for the "pos_session_id" field. It is called by the builders before save.
+	PosSessionIDValidator func(string) error
+	// DrawerIDValidator is a validator for the "drawer_id" field. It is called by the builders before save.
+	DrawerIDValidator func(string) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the CashDrawerSession queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByPosSessionID orders the results by the pos_session_id field.
+func ByPosSessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosSessionID, opts...).ToFunc()
+}
+
+// ByDrawerID orders the results by the drawer_id field.
+func ByDrawerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDrawerID, opts...).ToFunc()
+}
+
+// ByOutletID orders the results by the outlet_id field.
+func ByOutletID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutletID, opts...).ToFunc()
+}
+
+// ByCashierID orders the results by the cashier_id field.
+func ByCashierID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCashierID, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByOpenedAt orders the results by the opened_at field.
+func ByOpenedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpenedAt, opts...).ToFunc()
+}
+
+// ByClosedAt orders the results by the closed_at field.
+func ByClosedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosedAt, opts...).ToFunc()
+}
+
+// ByOpeningFloat orders the results by the opening_float field.
+func ByOpeningFloat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpeningFloat, opts...).ToFunc()
+}
+
+// ByCashSales orders the results by the cash_sales field.
+func ByCashSales(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCashSales, opts...).ToFunc()
+}
+
+// ByCashRefunds orders the results by the cash_refunds field.
+func ByCashRefunds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCashRefunds, opts...).ToFunc()
+}
+
+// ByCashTransactions orders the results by the cash_transactions field.
+func ByCashTransactions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCashTransactions, opts...).ToFunc()
+}
+
+// ByExpectedCash orders the results by the expected_cash field.
+func ByExpectedCash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpectedCash, opts...).ToFunc()
+}
+
+// ByCountedCash orders the results by the counted_cash field.
+func ByCountedCash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCountedCash, opts...).ToFunc()
+}
+
+// ByVariance orders the results by the variance field.
+func ByVariance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVariance, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByNotes orders the results by the notes field.
+func ByNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotes, opts...).ToFunc()
+}
+
+// ByRejectionReason orders the results by the rejection_reason field.
+func ByRejectionReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRejectionReason, opts...).ToFunc()
+}
+
+// ByReviewedBy orders the results by the reviewed_by field.
+func ByReviewedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedBy, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
+// ByJournalEntryID orders the results by the journal_entry_id field.
+func ByJournalEntryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJournalEntryID, opts...).ToFunc()
+}
+
+// ByEventID orders the results by the event_id field.
+func ByEventID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,1517 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cashdrawersession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldTenantID, v))
+}
+
+// PosSessionID applies equality check predicate on the "pos_session_id" field. It's identical to PosSessionIDEQ.
+func PosSessionID(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldPosSessionID, v))
+}
+
+// DrawerID applies equality check predicate on the "drawer_id" field. It's identical to DrawerIDEQ.
+func DrawerID(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldDrawerID, v))
+}
+
+// OutletID applies equality check predicate on the "outlet_id" field. It's identical to OutletIDEQ.
+func OutletID(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldOutletID, v))
+}
+
+// CashierID applies equality check predicate on the "cashier_id" field. It's identical to CashierIDEQ.
+func CashierID(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldCashierID, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldCurrency, v))
+}
+
+// OpenedAt applies equality check predicate on the "opened_at" field. It's identical to OpenedAtEQ.
+func OpenedAt(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldOpenedAt, v))
+}
+
+// ClosedAt applies equality check predicate on the "closed_at" field. It's identical to ClosedAtEQ.
+func ClosedAt(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// OpeningFloat applies equality check predicate on the "opening_float" field. It's identical to OpeningFloatEQ.
+func OpeningFloat(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldOpeningFloat, v))
+}
+
+// CashSales applies equality check predicate on the "cash_sales" field. It's identical to CashSalesEQ.
+func CashSales(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldCashSales, v))
+}
+
+// CashRefunds applies equality check predicate on the "cash_refunds" field. It's identical to CashRefundsEQ.
+func CashRefunds(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldCashRefunds, v))
+}
+
+// CashTransactions applies equality check predicate on the "cash_transactions" field. It's identical to CashTransactionsEQ.
+func CashTransactions(v int) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldCashTransactions, v))
+}
+
+// ExpectedCash applies equality check predicate on the "expected_cash" field. It's identical to ExpectedCashEQ.
+func ExpectedCash(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldExpectedCash, v))
+}
+
+// CountedCash applies equality check predicate on the "counted_cash" field. It's identical to CountedCashEQ.
+func CountedCash(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldCountedCash, v))
+}
+
+// Variance applies equality check predicate on the "variance" field. It's identical to VarianceEQ.
+func Variance(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldVariance, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldStatus, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldNotes, v))
+}
+
+// RejectionReason applies equality check predicate on the "rejection_reason" field. It's identical to RejectionReasonEQ.
+func RejectionReason(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldRejectionReason, v))
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// JournalEntryID applies equality check predicate on the "journal_entry_id" field. It's identical to JournalEntryIDEQ.
+func JournalEntryID(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldEventID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldTenantID, v))
+}
+
+// PosSessionIDEQ applies the EQ predicate on the "pos_session_id" field.
+func PosSessionIDEQ(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldPosSessionID, v))
+}
+
+// PosSessionIDNEQ applies the NEQ predicate on the "pos_session_id" field.
+func PosSessionIDNEQ(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldPosSessionID, v))
+}
+
+// PosSessionIDIn applies the In predicate on the "pos_session_id" field.
+func PosSessionIDIn(vs ...string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldPosSessionID, vs...))
+}
+
+// PosSessionIDNotIn applies the NotIn predicate on the "pos_session_id" field.
+func PosSessionIDNotIn(vs ...string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldPosSessionID, vs...))
+}
+
+// PosSessionIDGT applies the GT predicate on the "pos_session_id" field.
+func PosSessionIDGT(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldPosSessionID, v))
+}
+
+// PosSessionIDGTE applies the GTE predicate on the "pos_session_id" field.
+func PosSessionIDGTE(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldPosSessionID, v))
+}
+
+// PosSessionIDLT applies the LT predicate on the "pos_session_id" field.
+func PosSessionIDLT(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldPosSessionID, v))
+}
+
+// PosSessionIDLTE applies the LTE predicate on the "pos_session_id" field.
+func PosSessionIDLTE(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldPosSessionID, v))
+}
+
+// PosSessionIDContains applies the Contains predicate on the "pos_session_id" field.
+func PosSessionIDContains(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldContains(FieldPosSessionID, v))
+}
+
+// PosSessionIDHasPrefix applies the HasPrefix predicate on the "pos_session_id" field.
+func PosSessionIDHasPrefix(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldHasPrefix(FieldPosSessionID, v))
+}
+
+// PosSessionIDHasSuffix applies the HasSuffix predicate on the "pos_session_id" field.
+func PosSessionIDHasSuffix(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldHasSuffix(FieldPosSessionID, v))
+}
+
+// PosSessionIDEqualFold applies the EqualFold predicate on the "pos_session_id" field.
+func PosSessionIDEqualFold(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEqualFold(FieldPosSessionID, v))
+}
+
+// PosSessionIDContainsFold applies the ContainsFold predicate on the "pos_session_id" field.
+func PosSessionIDContainsFold(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldContainsFold(FieldPosSessionID, v))
+}
+
+// DrawerIDEQ applies the EQ predicate on the "drawer_id" field.
+func DrawerIDEQ(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldDrawerID, v))
+}
+
+// DrawerIDNEQ applies the NEQ predicate on the "drawer_id" field.
+func DrawerIDNEQ(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldDrawerID, v))
+}
+
+// DrawerIDIn applies the In predicate on the "drawer_id" field.
+func DrawerIDIn(vs ...string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldDrawerID, vs...))
+}
+
+// DrawerIDNotIn applies the NotIn predicate on the "drawer_id" field.
+func DrawerIDNotIn(vs ...string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldDrawerID, vs...))
+}
+
+// DrawerIDGT applies the GT predicate on the "drawer_id" field.
+func DrawerIDGT(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldDrawerID, v))
+}
+
+// DrawerIDGTE applies the GTE predicate on the "drawer_id" field.
+func DrawerIDGTE(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldDrawerID, v))
+}
+
+// DrawerIDLT applies the LT predicate on the "drawer_id" field.
+func DrawerIDLT(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldDrawerID, v))
+}
+
+// DrawerIDLTE applies the LTE predicate on the "drawer_id" field.
+func DrawerIDLTE(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldDrawerID, v))
+}
+
+// DrawerIDContains applies the Contains predicate on the "drawer_id" field.
+func DrawerIDContains(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldContains(FieldDrawerID, v))
+}
+
+// DrawerIDHasPrefix applies the HasPrefix predicate on the "drawer_id" field.
+func DrawerIDHasPrefix(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldHasPrefix(FieldDrawerID, v))
+}
+
+// DrawerIDHasSuffix applies the HasSuffix predicate on the "drawer_id" field.
+func DrawerIDHasSuffix(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldHasSuffix(FieldDrawerID, v))
+}
+
+// DrawerIDEqualFold applies the EqualFold predicate on the "drawer_id" field.
+func DrawerIDEqualFold(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEqualFold(FieldDrawerID, v))
+}
+
+// DrawerIDContainsFold applies the ContainsFold predicate on the "drawer_id" field.
+func DrawerIDContainsFold(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldContainsFold(FieldDrawerID, v))
+}
+
+// OutletIDEQ applies the EQ predicate on the "outlet_id" field.
+func OutletIDEQ(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldOutletID, v))
+}
+
+// OutletIDNEQ applies the NEQ predicate on the "outlet_id" field.
+func OutletIDNEQ(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldOutletID, v))
+}
+
+// OutletIDIn applies the In predicate on the "outlet_id" field.
+func OutletIDIn(vs ...string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldOutletID, vs...))
+}
+
+// OutletIDNotIn applies the NotIn predicate on the "outlet_id" field.
+func OutletIDNotIn(vs ...string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldOutletID, vs...))
+}
+
+// OutletIDGT applies the GT predicate on the "outlet_id" field.
+func OutletIDGT(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldOutletID, v))
+}
+
+// OutletIDGTE applies the GTE predicate on the "outlet_id" field.
+func OutletIDGTE(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldOutletID, v))
+}
+
+// OutletIDLT applies the LT predicate on the "outlet_id" field.
+func OutletIDLT(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldOutletID, v))
+}
+
+// OutletIDLTE applies the LTE predicate on the "outlet_id" field.
+func OutletIDLTE(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldOutletID, v))
+}
+
+// OutletIDContains applies the Contains predicate on the "outlet_id" field.
+func OutletIDContains(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldContains(FieldOutletID, v))
+}
+
+// OutletIDHasPrefix applies the HasPrefix predicate on the "outlet_id" field.
+func OutletIDHasPrefix(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldHasPrefix(FieldOutletID, v))
+}
+
+// OutletIDHasSuffix applies the HasSuffix predicate on the "outlet_id" field.
+func OutletIDHasSuffix(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldHasSuffix(FieldOutletID, v))
+}
+
+// OutletIDIsNil applies the IsNil predicate on the "outlet_id" field.
+func OutletIDIsNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIsNull(FieldOutletID))
+}
+
+// OutletIDNotNil applies the NotNil predicate on the "outlet_id" field.
+func OutletIDNotNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotNull(FieldOutletID))
+}
+
+// OutletIDEqualFold applies the EqualFold predicate on the "outlet_id" field.
+func OutletIDEqualFold(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEqualFold(FieldOutletID, v))
+}
+
+// OutletIDContainsFold applies the ContainsFold predicate on the "outlet_id" field.
+func OutletIDContainsFold(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldContainsFold(FieldOutletID, v))
+}
+
+// CashierIDEQ applies the EQ predicate on the "cashier_id" field.
+func CashierIDEQ(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldCashierID, v))
+}
+
+// CashierIDNEQ applies the NEQ predicate on the "cashier_id" field.
+func CashierIDNEQ(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldCashierID, v))
+}
+
+// CashierIDIn applies the In predicate on the "cashier_id" field.
+func CashierIDIn(vs ...string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldCashierID, vs...))
+}
+
+// CashierIDNotIn applies the NotIn predicate on the "cashier_id" field.
+func CashierIDNotIn(vs ...string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldCashierID, vs...))
+}
+
+// CashierIDGT applies the GT predicate on the "cashier_id" field.
+func CashierIDGT(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldCashierID, v))
+}
+
+// CashierIDGTE applies the GTE predicate on the "cashier_id" field.
+func CashierIDGTE(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldCashierID, v))
+}
+
+// CashierIDLT applies the LT predicate on the "cashier_id" field.
+func CashierIDLT(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldCashierID, v))
+}
+
+// CashierIDLTE applies the LTE predicate on the "cashier_id" field.
+func CashierIDLTE(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldCashierID, v))
+}
+
+// CashierIDContains applies the Contains predicate on the "cashier_id" field.
+func CashierIDContains(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldContains(FieldCashierID, v))
+}
+
+// CashierIDHasPrefix applies the HasPrefix predicate on the "cashier_id" field.
+func CashierIDHasPrefix(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldHasPrefix(FieldCashierID, v))
+}
+
+// CashierIDHasSuffix applies the HasSuffix predicate on the "cashier_id" field.
+func CashierIDHasSuffix(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldHasSuffix(FieldCashierID, v))
+}
+
+// CashierIDIsNil applies the IsNil predicate on the "cashier_id" field.
+func CashierIDIsNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIsNull(FieldCashierID))
+}
+
+// CashierIDNotNil applies the NotNil predicate on the "cashier_id" field.
+func CashierIDNotNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotNull(FieldCashierID))
+}
+
+// CashierIDEqualFold applies the EqualFold predicate on the "cashier_id" field.
+func CashierIDEqualFold(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEqualFold(FieldCashierID, v))
+}
+
+// CashierIDContainsFold applies the ContainsFold predicate on the "cashier_id" field.
+func CashierIDContainsFold(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldContainsFold(FieldCashierID, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// OpenedAtEQ applies the EQ predicate on the "opened_at" field.
+func OpenedAtEQ(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldOpenedAt, v))
+}
+
+// OpenedAtNEQ applies the NEQ predicate on the "opened_at" field.
+func OpenedAtNEQ(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldOpenedAt, v))
+}
+
+// OpenedAtIn applies the In predicate on the "opened_at" field.
+func OpenedAtIn(vs ...time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldOpenedAt, vs...))
+}
+
+// OpenedAtNotIn applies the NotIn predicate on the "opened_at" field.
+func OpenedAtNotIn(vs ...time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldOpenedAt, vs...))
+}
+
+// OpenedAtGT applies the GT predicate on the "opened_at" field.
+func OpenedAtGT(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldOpenedAt, v))
+}
+
+// OpenedAtGTE applies the GTE predicate on the "opened_at" field.
+func OpenedAtGTE(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldOpenedAt, v))
+}
+
+// OpenedAtLT applies the LT predicate on the "opened_at" field.
+func OpenedAtLT(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldOpenedAt, v))
+}
+
+// OpenedAtLTE applies the LTE predicate on the "opened_at" field.
+func OpenedAtLTE(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldOpenedAt, v))
+}
+
+// ClosedAtEQ applies the EQ predicate on the "closed_at" field.
+func ClosedAtEQ(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// ClosedAtNEQ applies the NEQ predicate on the "closed_at" field.
+func ClosedAtNEQ(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldClosedAt, v))
+}
+
+// ClosedAtIn applies the In predicate on the "closed_at" field.
+func ClosedAtIn(vs ...time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtNotIn applies the NotIn predicate on the "closed_at" field.
+func ClosedAtNotIn(vs ...time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtGT applies the GT predicate on the "closed_at" field.
+func ClosedAtGT(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldClosedAt, v))
+}
+
+// ClosedAtGTE applies the GTE predicate on the "closed_at" field.
+func ClosedAtGTE(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldClosedAt, v))
+}
+
+// ClosedAtLT applies the LT predicate on the "closed_at" field.
+func ClosedAtLT(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldClosedAt, v))
+}
+
+// ClosedAtLTE applies the LTE predicate on the "closed_at" field.
+func ClosedAtLTE(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldClosedAt, v))
+}
+
+// OpeningFloatEQ applies the EQ predicate on the "opening_float" field.
+func OpeningFloatEQ(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldOpeningFloat, v))
+}
+
+// OpeningFloatNEQ applies the NEQ predicate on the "opening_float" field.
+func OpeningFloatNEQ(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldOpeningFloat, v))
+}
+
+// OpeningFloatIn applies the In predicate on the "opening_float" field.
+func OpeningFloatIn(vs ...decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldOpeningFloat, vs...))
+}
+
+// OpeningFloatNotIn applies the NotIn predicate on the "opening_float" field.
+func OpeningFloatNotIn(vs ...decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldOpeningFloat, vs...))
+}
+
+// OpeningFloatGT applies the GT predicate on the "opening_float" field.
+func OpeningFloatGT(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldOpeningFloat, v))
+}
+
+// OpeningFloatGTE applies the GTE predicate on the "opening_float" field.
+func OpeningFloatGTE(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldOpeningFloat, v))
+}
+
+// OpeningFloatLT applies the LT predicate on the "opening_float" field.
+func OpeningFloatLT(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldOpeningFloat, v))
+}
+
+// OpeningFloatLTE applies the LTE predicate on the "opening_float" field.
+func OpeningFloatLTE(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldOpeningFloat, v))
+}
+
+// CashSalesEQ applies the EQ predicate on the "cash_sales" field.
+func CashSalesEQ(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldCashSales, v))
+}
+
+// CashSalesNEQ applies the NEQ predicate on the "cash_sales" field.
+func CashSalesNEQ(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldCashSales, v))
+}
+
+// CashSalesIn applies the In predicate on the "cash_sales" field.
+func CashSalesIn(vs ...decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldCashSales, vs...))
+}
+
+// CashSalesNotIn applies the NotIn predicate on the "cash_sales" field.
+func CashSalesNotIn(vs ...decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldCashSales, vs...))
+}
+
+// CashSalesGT applies the GT predicate on the "cash_sales" field.
+func CashSalesGT(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldCashSales, v))
+}
+
+// CashSalesGTE applies the GTE predicate on the "cash_sales" field.
+func CashSalesGTE(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldCashSales, v))
+}
+
+// CashSalesLT applies the LT predicate on the "cash_sales" field.
+func CashSalesLT(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldCashSales, v))
+}
+
+// CashSalesLTE applies the LTE predicate on the "cash_sales" field.
+func CashSalesLTE(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldCashSales, v))
+}
+
+// CashSalesIsNil applies the IsNil predicate on the "cash_sales" field.
+func CashSalesIsNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIsNull(FieldCashSales))
+}
+
+// CashSalesNotNil applies the NotNil predicate on the "cash_sales" field.
+func CashSalesNotNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotNull(FieldCashSales))
+}
+
+// CashRefundsEQ applies the EQ predicate on the "cash_refunds" field.
+func CashRefundsEQ(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldCashRefunds, v))
+}
+
+// CashRefundsNEQ applies the NEQ predicate on the "cash_refunds" field.
+func CashRefundsNEQ(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldCashRefunds, v))
+}
+
+// CashRefundsIn applies the In predicate on the "cash_refunds" field.
+func CashRefundsIn(vs ...decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldCashRefunds, vs...))
+}
+
+// CashRefundsNotIn applies the NotIn predicate on the "cash_refunds" field.
+func CashRefundsNotIn(vs ...decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldCashRefunds, vs...))
+}
+
+// CashRefundsGT applies the GT predicate on the "cash_refunds" field.
+func CashRefundsGT(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldCashRefunds, v))
+}
+
+// CashRefundsGTE applies the GTE predicate on the "cash_refunds" field.
+func CashRefundsGTE(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldCashRefunds, v))
+}
+
+// CashRefundsLT applies the LT predicate on the "cash_refunds" field.
+func CashRefundsLT(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldCashRefunds, v))
+}
+
+// CashRefundsLTE applies the LTE predicate on the "cash_refunds" field.
+func CashRefundsLTE(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldCashRefunds, v))
+}
+
+// CashRefundsIsNil applies the IsNil predicate on the "cash_refunds" field.
+func CashRefundsIsNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIsNull(FieldCashRefunds))
+}
+
+// CashRefundsNotNil applies the NotNil predicate on the "cash_refunds" field.
+func CashRefundsNotNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotNull(FieldCashRefunds))
+}
+
+// CashTransactionsEQ applies the EQ predicate on the "cash_transactions" field.
+func CashTransactionsEQ(v int) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldCashTransactions, v))
+}
+
+// CashTransactionsNEQ applies the NEQ predicate on the "cash_transactions" field.
+func CashTransactionsNEQ(v int) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldCashTransactions, v))
+}
+
+// CashTransactionsIn applies the In predicate on the "cash_transactions" field.
+func CashTransactionsIn(vs ...int) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldCashTransactions, vs...))
+}
+
+// CashTransactionsNotIn applies the NotIn predicate on the "cash_transactions" field.
+func CashTransactionsNotIn(vs ...int) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldCashTransactions, vs...))
+}
+
+// CashTransactionsGT applies the GT predicate on the "cash_transactions" field.
+func CashTransactionsGT(v int) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldCashTransactions, v))
+}
+
+// CashTransactionsGTE applies the GTE predicate on the "cash_transactions" field.
+func CashTransactionsGTE(v int) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldCashTransactions, v))
+}
+
+// CashTransactionsLT applies the LT predicate on the "cash_transactions" field.
+func CashTransactionsLT(v int) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldCashTransactions, v))
+}
+
+// CashTransactionsLTE applies the LTE predicate on the "cash_transactions" field.
+func CashTransactionsLTE(v int) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldCashTransactions, v))
+}
+
+// CashTransactionsIsNil applies the IsNil predicate on the "cash_transactions" field.
+func CashTransactionsIsNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIsNull(FieldCashTransactions))
+}
+
+// CashTransactionsNotNil applies the NotNil predicate on the "cash_transactions" field.
+func CashTransactionsNotNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotNull(FieldCashTransactions))
+}
+
+// ExpectedCashEQ applies the EQ predicate on the "expected_cash" field.
+func ExpectedCashEQ(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldExpectedCash, v))
+}
+
+// ExpectedCashNEQ applies the NEQ predicate on the "expected_cash" field.
+func ExpectedCashNEQ(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldExpectedCash, v))
+}
+
+// ExpectedCashIn applies the In predicate on the "expected_cash" field.
+func ExpectedCashIn(vs ...decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldExpectedCash, vs...))
+}
+
+// ExpectedCashNotIn applies the NotIn predicate on the "expected_cash" field.
+func ExpectedCashNotIn(vs ...decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldExpectedCash, vs...))
+}
+
+// ExpectedCashGT applies the GT predicate on the "expected_cash" field.
+func ExpectedCashGT(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldExpectedCash, v))
+}
+
+// ExpectedCashGTE applies the GTE predicate on the "expected_cash" field.
+func ExpectedCashGTE(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldExpectedCash, v))
+}
+
+// ExpectedCashLT applies the LT predicate on the "expected_cash" field.
+func ExpectedCashLT(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldExpectedCash, v))
+}
+
+// ExpectedCashLTE applies the LTE predicate on the "expected_cash" field.
+func ExpectedCashLTE(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldExpectedCash, v))
+}
+
+// ExpectedCashIsNil applies the IsNil predicate on the "expected_cash" field.
+func ExpectedCashIsNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIsNull(FieldExpectedCash))
+}
+
+// ExpectedCashNotNil applies the NotNil predicate on the "expected_cash" field.
+func ExpectedCashNotNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotNull(FieldExpectedCash))
+}
+
+// CountedCashEQ applies the EQ predicate on the "counted_cash" field.
+func CountedCashEQ(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldCountedCash, v))
+}
+
+// CountedCashNEQ applies the NEQ predicate on the "counted_cash" field.
+func CountedCashNEQ(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldCountedCash, v))
+}
+
+// CountedCashIn applies the In predicate on the "counted_cash" field.
+func CountedCashIn(vs ...decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldCountedCash, vs...))
+}
+
+// CountedCashNotIn applies the NotIn predicate on the "counted_cash" field.
+func CountedCashNotIn(vs ...decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldCountedCash, vs...))
+}
+
+// CountedCashGT applies the GT predicate on the "counted_cash" field.
+func CountedCashGT(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldCountedCash, v))
+}
+
+// CountedCashGTE applies the GTE predicate on the "counted_cash" field.
+func CountedCashGTE(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldCountedCash, v))
+}
+
+// CountedCashLT applies the LT predicate on the "counted_cash" field.
+func CountedCashLT(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldCountedCash, v))
+}
+
+// CountedCashLTE applies the LTE predicate on the "counted_cash" field.
+func CountedCashLTE(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldCountedCash, v))
+}
+
+// CountedCashIsNil applies the IsNil predicate on the "counted_cash" field.
+func CountedCashIsNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIsNull(FieldCountedCash))
+}
+
+// CountedCashNotNil applies the NotNil predicate on the "counted_cash" field.
+func CountedCashNotNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotNull(FieldCountedCash))
+}
+
+// VarianceEQ applies the EQ predicate on the "variance" field.
+func VarianceEQ(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldVariance, v))
+}
+
+// VarianceNEQ applies the NEQ predicate on the "variance" field.
+func VarianceNEQ(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldVariance, v))
+}
+
+// VarianceIn applies the In predicate on the "variance" field.
+func VarianceIn(vs ...decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldVariance, vs...))
+}
+
+// VarianceNotIn applies the NotIn predicate on the "variance" field.
+func VarianceNotIn(vs ...decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldVariance, vs...))
+}
+
+// VarianceGT applies the GT predicate on the "variance" field.
+func VarianceGT(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldVariance, v))
+}
+
+// VarianceGTE applies the GTE predicate on the "variance" field.
+func VarianceGTE(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldVariance, v))
+}
+
+// VarianceLT applies the LT predicate on the "variance" field.
+func VarianceLT(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldVariance, v))
+}
+
+// VarianceLTE applies the LTE predicate on the "variance" field.
+func VarianceLTE(v decimal.Decimal) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldVariance, v))
+}
+
+// VarianceIsNil applies the IsNil predicate on the "variance" field.
+func VarianceIsNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIsNull(FieldVariance))
+}
+
+// VarianceNotNil applies the NotNil predicate on the "variance" field.
+func VarianceNotNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotNull(FieldVariance))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIsNull(FieldNotes))
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotNull(FieldNotes))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// RejectionReasonEQ applies the EQ predicate on the "rejection_reason" field.
+func RejectionReasonEQ(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldRejectionReason, v))
+}
+
+// RejectionReasonNEQ applies the NEQ predicate on the "rejection_reason" field.
+func RejectionReasonNEQ(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldRejectionReason, v))
+}
+
+// RejectionReasonIn applies the In predicate on the "rejection_reason" field.
+func RejectionReasonIn(vs ...string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldRejectionReason, vs...))
+}
+
+// RejectionReasonNotIn applies the NotIn predicate on the "rejection_reason" field.
+func RejectionReasonNotIn(vs ...string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldRejectionReason, vs...))
+}
+
+// RejectionReasonGT applies the GT predicate on the "rejection_reason" field.
+func RejectionReasonGT(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldRejectionReason, v))
+}
+
+// RejectionReasonGTE applies the GTE predicate on the "rejection_reason" field.
+func RejectionReasonGTE(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldRejectionReason, v))
+}
+
+// RejectionReasonLT applies the LT predicate on the "rejection_reason" field.
+func RejectionReasonLT(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldRejectionReason, v))
+}
+
+// RejectionReasonLTE applies the LTE predicate on the "rejection_reason" field.
+func RejectionReasonLTE(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldRejectionReason, v))
+}
+
+// RejectionReasonContains applies the Contains predicate on the "rejection_reason" field.
+func RejectionReasonContains(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldContains(FieldRejectionReason, v))
+}
+
+// RejectionReasonHasPrefix applies the HasPrefix predicate on the "rejection_reason" field.
+func RejectionReasonHasPrefix(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldHasPrefix(FieldRejectionReason, v))
+}
+
+// RejectionReasonHasSuffix applies the HasSuffix predicate on the "rejection_reason" field.
+func RejectionReasonHasSuffix(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldHasSuffix(FieldRejectionReason, v))
+}
+
+// RejectionReasonIsNil applies the IsNil predicate on the "rejection_reason" field.
+func RejectionReasonIsNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIsNull(FieldRejectionReason))
+}
+
+// RejectionReasonNotNil applies the NotNil predicate on the "rejection_reason" field.
+func RejectionReasonNotNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotNull(FieldRejectionReason))
+}
+
+// RejectionReasonEqualFold applies the EqualFold predicate on the "rejection_reason" field.
+func RejectionReasonEqualFold(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEqualFold(FieldRejectionReason, v))
+}
+
+// RejectionReasonContainsFold applies the ContainsFold predicate on the "rejection_reason" field.
+func RejectionReasonContainsFold(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldContainsFold(FieldRejectionReason, v))
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldReviewedBy, v))
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldReviewedBy, v))
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldReviewedBy, v))
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldReviewedBy, v))
+}
+
+// ReviewedByIsNil applies the IsNil predicate on the "reviewed_by" field.
+func ReviewedByIsNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIsNull(FieldReviewedBy))
+}
+
+// ReviewedByNotNil applies the NotNil predicate on the "reviewed_by" field.
+func ReviewedByNotNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotNull(FieldReviewedBy))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// JournalEntryIDEQ applies the EQ predicate on the "journal_entry_id" field.
+func JournalEntryIDEQ(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDNEQ applies the NEQ predicate on the "journal_entry_id" field.
+func JournalEntryIDNEQ(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIn applies the In predicate on the "journal_entry_id" field.
+func JournalEntryIDIn(vs ...uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDNotIn applies the NotIn predicate on the "journal_entry_id" field.
+func JournalEntryIDNotIn(vs ...uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDGT applies the GT predicate on the "journal_entry_id" field.
+func JournalEntryIDGT(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDGTE applies the GTE predicate on the "journal_entry_id" field.
+func JournalEntryIDGTE(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDLT applies the LT predicate on the "journal_entry_id" field.
+func JournalEntryIDLT(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDLTE applies the LTE predicate on the "journal_entry_id" field.
+func JournalEntryIDLTE(v uuid.UUID) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIsNil applies the IsNil predicate on the "journal_entry_id" field.
+func JournalEntryIDIsNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIsNull(FieldJournalEntryID))
+}
+
+// JournalEntryIDNotNil applies the NotNil predicate on the "journal_entry_id" field.
+func JournalEntryIDNotNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotNull(FieldJournalEntryID))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldEventID, v))
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldEventID, v))
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldEventID, v))
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldEventID, v))
+}
+
+// EventIDContains applies the Contains predicate on the "event_id" field.
+func EventIDContains(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldContains(FieldEventID, v))
+}
+
+// EventIDHasPrefix applies the HasPrefix predicate on the "event_id" field.
+func EventIDHasPrefix(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldHasPrefix(FieldEventID, v))
+}
+
+// EventIDHasSuffix applies the HasSuffix predicate on the "event_id" field.
+func EventIDHasSuffix(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldHasSuffix(FieldEventID, v))
+}
+
+// EventIDIsNil applies the IsNil predicate on the "event_id" field.
+func EventIDIsNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIsNull(FieldEventID))
+}
+
+// EventIDNotNil applies the NotNil predicate on the "event_id" field.
+func EventIDNotNil() predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotNull(FieldEventID))
+}
+
+// EventIDEqualFold applies the EqualFold predicate on the "event_id" field.
+func EventIDEqualFold(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEqualFold(FieldEventID, v))
+}
+
+// EventIDContainsFold applies the ContainsFold predicate on the "event_id" field.
+func EventIDContainsFold(v string) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldContainsFold(FieldEventID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CashDrawerSession) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CashDrawerSession) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CashDrawerSession) predicate.CashDrawerSession {
+	return predicate.CashDrawerSession(sql.NotPredicates(p))
+}
@@ -0,0 +1,1857 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawersession"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CashDrawerSessionCreate is the builder for creating a CashDrawerSession entity.
+type CashDrawerSessionCreate struct {
+	config
+	mutation *CashDrawerSessionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *CashDrawerSessionCreate) SetTenantID(v uuid.UUID) *CashDrawerSessionCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetPosSessionID sets the "pos_session_id" field.
+func (_c *CashDrawerSessionCreate) SetPosSessionID(v string) *CashDrawerSessionCreate {
+	_c.mutation.SetPosSessionID(v)
+	return _c
+}
+
+// SetDrawerID sets the "drawer_id" field.
+func (_c *CashDrawerSessionCreate) SetDrawerID(v string) *CashDrawerSessionCreate {
+	_c.mutation.SetDrawerID(v)
+	return _c
+}
+
+// SetOutletID sets the "outlet_id" field.
+func (_c *CashDrawerSessionCreate) SetOutletID(v string) *CashDrawerSessionCreate {
+	_c.mutation.SetOutletID(v)
+	return _c
+}
+
+// SetNillableOutletID sets the "outlet_id" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableOutletID(v *string) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetOutletID(*v)
+	}
+	return _c
+}
+
+// SetCashierID sets the "cashier_id" field.
+func (_c *CashDrawerSessionCreate) SetCashierID(v string) *CashDrawerSessionCreate {
+	_c.mutation.SetCashierID(v)
+	return _c
+}
+
+// SetNillableCashierID sets the "cashier_id" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableCashierID(v *string) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetCashierID(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *CashDrawerSessionCreate) SetCurrency(v string) *CashDrawerSessionCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableCurrency(v *string) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (_c *CashDrawerSessionCreate) SetOpenedAt(v time.Time) *CashDrawerSessionCreate {
+	_c.mutation.SetOpenedAt(v)
+	return _c
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_c *CashDrawerSessionCreate) SetClosedAt(v time.Time) *CashDrawerSessionCreate {
+	_c.mutation.SetClosedAt(v)
+	return _c
+}
+
+// SetOpeningFloat sets the "opening_float" field.
+func (_c *CashDrawerSessionCreate) SetOpeningFloat(v decimal.Decimal) *CashDrawerSessionCreate {
+	_c.mutation.SetOpeningFloat(v)
+	return _c
+}
+
+// SetCashSales sets the "cash_sales" field.
+func (_c *CashDrawerSessionCreate) SetCashSales(v decimal.Decimal) *CashDrawerSessionCreate {
+	_c.mutation.SetCashSales(v)
+	return _c
+}
+
+// SetNillableCashSales sets the "cash_sales" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableCashSales(v *decimal.Decimal) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetCashSales(*v)
+	}
+	return _c
+}
+
+// SetCashRefunds sets the "cash_refunds" field.
+func (_c *CashDrawerSessionCreate) SetCashRefunds(v decimal.Decimal) *CashDrawerSessionCreate {
+	_c.mutation.SetCashRefunds(v)
+	return _c
+}
+
+// SetNillableCashRefunds sets the "cash_refunds" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableCashRefunds(v *decimal.Decimal) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetCashRefunds(*v)
+	}
+	return _c
+}
+
+// SetCashTransactions sets the "cash_transactions" field.
+func (_c *CashDrawerSessionCreate) SetCashTransactions(v int) *CashDrawerSessionCreate {
+	_c.mutation.SetCashTransactions(v)
+	return _c
+}
+
+// SetNillableCashTransactions sets the "cash_transactions" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableCashTransactions(v *int) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetCashTransactions(*v)
+	}
+	return _c
+}
+
+// SetExpectedCash sets the "expected_cash" field.
+func (_c *CashDrawerSessionCreate) SetExpectedCash(v decimal.Decimal) *CashDrawerSessionCreate {
+	_c.mutation.SetExpectedCash(v)
+	return _c
+}
+
+// SetNillableExpectedCash sets the "expected_cash" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableExpectedCash(v *decimal.Decimal) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetExpectedCash(*v)
+	}
+	return _c
+}
+
+// SetCountedCash sets the "counted_cash" field.
+func (_c *CashDrawerSessionCreate) SetCountedCash(v decimal.Decimal) *CashDrawerSessionCreate {
+	_c.mutation.SetCountedCash(v)
+	return _c
+}
+
+// SetNillableCountedCash sets the "counted_cash" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableCountedCash(v *decimal.Decimal) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetCountedCash(*v)
+	}
+	return _c
+}
+
+// SetVariance sets the "variance" field.
+func (_c *CashDrawerSessionCreate) SetVariance(v decimal.Decimal) *CashDrawerSessionCreate {
+	_c.mutation.SetVariance(v)
+	return _c
+}
+
+// SetNillableVariance sets the "variance" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableVariance(v *decimal.Decimal) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetVariance(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CashDrawerSessionCreate) SetStatus(v string) *CashDrawerSessionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableStatus(v *string) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *CashDrawerSessionCreate) SetNotes(v string) *CashDrawerSessionCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableNotes(v *string) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_c *CashDrawerSessionCreate) SetRejectionReason(v string) *CashDrawerSessionCreate {
+	_c.mutation.SetRejectionReason(v)
+	return _c
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableRejectionReason(v *string) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetRejectionReason(*v)
+	}
+	return _c
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_c *CashDrawerSessionCreate) SetReviewedBy(v uuid.UUID) *CashDrawerSessionCreate {
+	_c.mutation.SetReviewedBy(v)
+	return _c
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableReviewedBy(v *uuid.UUID) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetReviewedBy(*v)
+	}
+	return _c
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *CashDrawerSessionCreate) SetReviewedAt(v time.Time) *CashDrawerSessionCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableReviewedAt(v *time.Time) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetReviewedAt(*v)
+	}
+	return _c
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_c *CashDrawerSessionCreate) SetJournalEntryID(v uuid.UUID) *CashDrawerSessionCreate {
+	_c.mutation.SetJournalEntryID(v)
+	return _c
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableJournalEntryID(v *uuid.UUID) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetJournalEntryID(*v)
+	}
+	return _c
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *CashDrawerSessionCreate) SetEventID(v string) *CashDrawerSessionCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableEventID(v *string) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetEventID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CashDrawerSessionCreate) SetCreatedAt(v time.Time) *CashDrawerSessionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableCreatedAt(v *time.Time) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CashDrawerSessionCreate) SetUpdatedAt(v time.Time) *CashDrawerSessionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableUpdatedAt(v *time.Time) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CashDrawerSessionCreate) SetID(v uuid.UUID) *CashDrawerSessionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CashDrawerSessionCreate) SetNillableID(v *uuid.UUID) *CashDrawerSessionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CashDrawerSessionMutation object of the builder.
+func (_c *CashDrawerSessionCreate) Mutation() *CashDrawerSessionMutation {
+	return _c.mutation
+}
+
+// Save creates the CashDrawerSession in the database.
+func (_c *CashDrawerSessionCreate) Save(ctx context.Context) (*CashDrawerSession, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CashDrawerSessionCreate) SaveX(ctx context.Context) *CashDrawerSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CashDrawerSessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CashDrawerSessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CashDrawerSessionCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := cashdrawersession.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := cashdrawersession.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := cashdrawersession.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := cashdrawersession.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := cashdrawersession.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CashDrawerSessionCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "CashDrawerSession.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.PosSessionID(); !ok {
+		return &ValidationError{Name: "pos_session_id", err: errors.New(`ent: missing required field "CashDrawerSession.pos_session_id"`)}
+	}
+	if v, ok := _c.mutation.PosSessionID(); ok {
+		if err := cashdrawersession.PosSessionIDValidator(v); err != nil {
+			return &ValidationError{Name: "pos_session_id", err: fmt.Errorf(`ent: validator failed for field "CashDrawerSession.pos_session_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DrawerID(); !ok {
+		return &ValidationError{Name: "drawer_id", err: errors.New(`ent: missing required field "CashDrawerSession.drawer_id"`)}
+	}
+	if v, ok := _c.mutation.DrawerID(); ok {
+		if err := cashdrawersession.DrawerIDValidator(v); err != nil {
+			return &ValidationError{Name: "drawer_id", err: fmt.Errorf(`ent: validator failed for field "CashDrawerSession.drawer_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "CashDrawerSession.currency"`)}
+	}
+	if _, ok := _c.mutation.OpenedAt(); !ok {
+		return &ValidationError{Name: "opened_at", err: errors.New(`ent: missing required field "CashDrawerSession.opened_at"`)}
+	}
+	if _, ok := _c.mutation.ClosedAt(); !ok {
+		return &ValidationError{Name: "closed_at", err: errors.New(`ent: missing required field "CashDrawerSession.closed_at"`)}
+	}
+	if _, ok := _c.mutation.OpeningFloat(); !ok {
+		return &ValidationError{Name: "opening_float", err: errors.New(`ent: missing required field "CashDrawerSession.opening_float"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CashDrawerSession.status"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CashDrawerSession.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CashDrawerSession.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *CashDrawerSessionCreate) sqlSave(ctx context.Context) (*CashDrawerSession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CashDrawerSessionCreate) createSpec() (*CashDrawerSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CashDrawerSession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(cashdrawersession.Table, sqlgraph.NewFieldSpec(cashdrawersession.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(cashdrawersession.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.PosSessionID(); ok {
+		_spec.SetField(cashdrawersession.FieldPosSessionID, field.TypeString, value)
+		_node.PosSessionID = value
+	}
+	if value, ok := _c.mutation.DrawerID(); ok {
+		_spec.SetField(cashdrawersession.FieldDrawerID, field.TypeString, value)
+		_node.DrawerID = value
+	}
+	if value, ok := _c.mutation.OutletID(); ok {
+		_spec.SetField(cashdrawersession.FieldOutletID, field.TypeString, value)
+		_node.OutletID = value
+	}
+	if value, ok := _c.mutation.CashierID(); ok {
+		_spec.SetField(cashdrawersession.FieldCashierID, field.TypeString, value)
+		_node.CashierID = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(cashdrawersession.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.OpenedAt(); ok {
+		_spec.SetField(cashdrawersession.FieldOpenedAt, field.TypeTime, value)
+		_node.OpenedAt = value
+	}
+	if value, ok := _c.mutation.ClosedAt(); ok {
+		_spec.SetField(cashdrawersession.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = value
+	}
+	if value, ok := _c.mutation.OpeningFloat(); ok {
+		_spec.SetField(cashdrawersession.FieldOpeningFloat, field.TypeFloat64, value)
+		_node.OpeningFloat = value
+	}
+	if value, ok := _c.mutation.CashSales(); ok {
+		_spec.SetField(cashdrawersession.FieldCashSales, field.TypeFloat64, value)
+		_node.CashSales = value
+	}
+	if value, ok := _c.mutation.CashRefunds(); ok {
+		_spec.SetField(cashdrawersession.FieldCashRefunds, field.TypeFloat64, value)
+		_node.CashRefunds = value
+	}
+	if value, ok := _c.mutation.CashTransactions(); ok {
+		_spec.SetField(cashdrawersession.FieldCashTransactions, field.TypeInt, value)
+		_node.CashTransactions = value
+	}
+	if value, ok := _c.mutation.ExpectedCash(); ok {
+		_spec.SetField(cashdrawersession.FieldExpectedCash, field.TypeFloat64, value)
+		_node.ExpectedCash = value
+	}
+	if value, ok := _c.mutation.CountedCash(); ok {
+		_spec.SetField(cashdrawersession.FieldCountedCash, field.TypeFloat64, value)
+		_node.CountedCash = value
+	}
+	if value, ok := _c.mutation.Variance(); ok {
+		_spec.SetField(cashdrawersession.FieldVariance, field.TypeFloat64, value)
+		_node.Variance = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(cashdrawersession.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(cashdrawersession.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	if value, ok := _c.mutation.RejectionReason(); ok {
+		_spec.SetField(cashdrawersession.FieldRejectionReason, field.TypeString, value)
+		_node.RejectionReason = value
+	}
+	if value, ok := _c.mutation.ReviewedBy(); ok {
+		_spec.SetField(cashdrawersession.FieldReviewedBy, field.TypeUUID, value)
+		_node.ReviewedBy = value
+	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(cashdrawersession.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = value
+	}
+	if value, ok := _c.mutation.JournalEntryID(); ok {
+		_spec.SetField(cashdrawersession.FieldJournalEntryID, field.TypeUUID, value)
+		_node.JournalEntryID = value
+	}
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(cashdrawersession.FieldEventID, field.TypeString, value)
+		_node.EventID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(cashdrawersession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(cashdrawersession.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CashDrawerSession.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CashDrawerSessionUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CashDrawerSessionCreate) OnConflict(opts ...sql.ConflictOption) *CashDrawerSessionUpsertOne {
+	_c.conflict = opts
+	return &CashDrawerSessionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CashDrawerSession.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CashDrawerSessionCreate) OnConflictColumns(columns ...string) *CashDrawerSessionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CashDrawerSessionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CashDrawerSessionUpsertOne is the builder for "upsert"-ing
+	//  one CashDrawerSession node.
+	CashDrawerSessionUpsertOne struct {
+		create *CashDrawerSessionCreate
+	}
+
+	// CashDrawerSessionUpsert is the "OnConflict" setter.
+	CashDrawerSessionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *CashDrawerSessionUpsert) SetTenantID(v uuid.UUID) *CashDrawerSessionUpsert {
+	u.Set(cashdrawersession.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsert) UpdateTenantID() *CashDrawerSessionUpsert {
+	u.SetExcluded(cashdrawersession.FieldTenantID)
+	return u
+}
+
+// SetCashSales sets the "cash_sales" field.
+func (u *CashDrawerSessionUpsert) SetCashSales(v decimal.Decimal) *CashDrawerSessionUpsert {
+	u.Set(cashdrawersession.FieldCashSales, v)
+	return u
+}
+
+// UpdateCashSales sets the "cash_sales" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsert) UpdateCashSales() *CashDrawerSessionUpsert {
+	u.SetExcluded(cashdrawersession.FieldCashSales)
+	return u
+}
+
+// AddCashSales adds v to the "cash_sales" field.
+func (u *CashDrawerSessionUpsert) AddCashSales(v decimal.Decimal) *CashDrawerSessionUpsert {
+	u.Add(cashdrawersession.FieldCashSales, v)
+	return u
+}
+
+// ClearCashSales clears the value of the "cash_sales" field.
+func (u *CashDrawerSessionUpsert) ClearCashSales() *CashDrawerSessionUpsert {
+	u.SetNull(cashdrawersession.FieldCashSales)
+	return u
+}
+
+// SetCashRefunds sets the "cash_refunds" field.
+func (u *CashDrawerSessionUpsert) SetCashRefunds(v decimal.Decimal) *CashDrawerSessionUpsert {
+	u.Set(cashdrawersession.FieldCashRefunds, v)
+	return u
+}
+
+// UpdateCashRefunds sets the "cash_refunds" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsert) UpdateCashRefunds() *CashDrawerSessionUpsert {
+	u.SetExcluded(cashdrawersession.FieldCashRefunds)
+	return u
+}
+
+// AddCashRefunds adds v to the "cash_refunds" field.
+func (u *CashDrawerSessionUpsert) AddCashRefunds(v decimal.Decimal) *CashDrawerSessionUpsert {
+	u.Add(cashdrawersession.FieldCashRefunds, v)
+	return u
+}
+
+// ClearCashRefunds clears the value of the "cash_refunds" field.
+func (u *CashDrawerSessionUpsert) ClearCashRefunds() *CashDrawerSessionUpsert {
+	u.SetNull(cashdrawersession.FieldCashRefunds)
+	return u
+}
+
+// SetCashTransactions sets the "cash_transactions" field.
+func (u *CashDrawerSessionUpsert) SetCashTransactions(v int) *CashDrawerSessionUpsert {
+	u.Set(cashdrawersession.FieldCashTransactions, v)
+	return u
+}
+
+// UpdateCashTransactions sets the "cash_transactions" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsert) UpdateCashTransactions() *CashDrawerSessionUpsert {
+	u.SetExcluded(cashdrawersession.FieldCashTransactions)
+	return u
+}
+
+// AddCashTransactions adds v to the "cash_transactions" field.
+func (u *CashDrawerSessionUpsert) AddCashTransactions(v int) *CashDrawerSessionUpsert {
+	u.Add(cashdrawersession.FieldCashTransactions, v)
+	return u
+}
+
+// ClearCashTransactions clears the value of the "cash_transactions" field.
+func (u *CashDrawerSessionUpsert) ClearCashTransactions() *CashDrawerSessionUpsert {
+	u.SetNull(cashdrawersession.FieldCashTransactions)
+	return u
+}
+
+// SetExpectedCash sets the "expected_cash" field.
+func (u *CashDrawerSessionUpsert) SetExpectedCash(v decimal.Decimal) *CashDrawerSessionUpsert {
+	u.Set(cashdrawersession.FieldExpectedCash, v)
+	return u
+}
+
+// UpdateExpectedCash sets the "expected_cash" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsert) UpdateExpectedCash() *CashDrawerSessionUpsert {
+	u.SetExcluded(cashdrawersession.FieldExpectedCash)
+	return u
+}
+
+// AddExpectedCash adds v to the "expected_cash" field.
+func (u *CashDrawerSessionUpsert) AddExpectedCash(v decimal.Decimal) *CashDrawerSessionUpsert {
+	u.Add(cashdrawersession.FieldExpectedCash, v)
+	return u
+}
+
+// ClearExpectedCash clears the value of the "expected_cash" field.
+func (u *CashDrawerSessionUpsert) ClearExpectedCash() *CashDrawerSessionUpsert {
+	u.SetNull(cashdrawersession.FieldExpectedCash)
+	return u
+}
+
+// SetCountedCash sets the "counted_cash" field.
+func (u *CashDrawerSessionUpsert) SetCountedCash(v decimal.Decimal) *CashDrawerSessionUpsert {
+	u.Set(cashdrawersession.FieldCountedCash, v)
+	return u
+}
+
+// UpdateCountedCash sets the "counted_cash" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsert) UpdateCountedCash() *CashDrawerSessionUpsert {
+	u.SetExcluded(cashdrawersession.FieldCountedCash)
+	return u
+}
+
+// AddCountedCash adds v to the "counted_cash" field.
+func (u *CashDrawerSessionUpsert) AddCountedCash(v decimal.Decimal) *CashDrawerSessionUpsert {
+	u.Add(cashdrawersession.FieldCountedCash, v)
+	return u
+}
+
+// ClearCountedCash clears the value of the "counted_cash" field.
+func (u *CashDrawerSessionUpsert) ClearCountedCash() *CashDrawerSessionUpsert {
+	u.SetNull(cashdrawersession.FieldCountedCash)
+	return u
+}
+
+// SetVariance sets the "variance" field.
+func (u *CashDrawerSessionUpsert) SetVariance(v decimal.Decimal) *CashDrawerSessionUpsert {
+	u.Set(cashdrawersession.FieldVariance, v)
+	return u
+}
+
+// UpdateVariance sets the "variance" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsert) UpdateVariance() *CashDrawerSessionUpsert {
+	u.SetExcluded(cashdrawersession.FieldVariance)
+	return u
+}
+
+// AddVariance adds v to the "variance" field.
+func (u *CashDrawerSessionUpsert) AddVariance(v decimal.Decimal) *CashDrawerSessionUpsert {
+	u.Add(cashdrawersession.FieldVariance, v)
+	return u
+}
+
+// ClearVariance clears the value of the "variance" field.
+func (u *CashDrawerSessionUpsert) ClearVariance() *CashDrawerSessionUpsert {
+	u.SetNull(cashdrawersession.FieldVariance)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CashDrawerSessionUpsert) SetStatus(v string) *CashDrawerSessionUpsert {
+	u.Set(cashdrawersession.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsert) UpdateStatus() *CashDrawerSessionUpsert {
+	u.SetExcluded(cashdrawersession.FieldStatus)
+	return u
+}
+
+// SetNotes sets the "notes" field.
+func (u *CashDrawerSessionUpsert) SetNotes(v string) *CashDrawerSessionUpsert {
+	u.Set(cashdrawersession.FieldNotes, v)
+	return u
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsert) UpdateNotes() *CashDrawerSessionUpsert {
+	u.SetExcluded(cashdrawersession.FieldNotes)
+	return u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *CashDrawerSessionUpsert) ClearNotes() *CashDrawerSessionUpsert {
+	u.SetNull(cashdrawersession.FieldNotes)
+	return u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *CashDrawerSessionUpsert) SetRejectionReason(v string) *CashDrawerSessionUpsert {
+	u.Set(cashdrawersession.FieldRejectionReason, v)
+	return u
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsert) UpdateRejectionReason() *CashDrawerSessionUpsert {
+	u.SetExcluded(cashdrawersession.FieldRejectionReason)
+	return u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *CashDrawerSessionUpsert) ClearRejectionReason() *CashDrawerSessionUpsert {
+	u.SetNull(cashdrawersession.FieldRejectionReason)
+	return u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *CashDrawerSessionUpsert) SetReviewedBy(v uuid.UUID) *CashDrawerSessionUpsert {
+	u.Set(cashdrawersession.FieldReviewedBy, v)
+	return u
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsert) UpdateReviewedBy() *CashDrawerSessionUpsert {
+	u.SetExcluded(cashdrawersession.FieldReviewedBy)
+	return u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *CashDrawerSessionUpsert) ClearReviewedBy() *CashDrawerSessionUpsert {
+	u.SetNull(cashdrawersession.FieldReviewedBy)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *CashDrawerSessionUpsert) SetReviewedAt(v time.Time) *CashDrawerSessionUpsert {
+	u.Set(cashdrawersession.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsert) UpdateReviewedAt() *CashDrawerSessionUpsert {
+	u.SetExcluded(cashdrawersession.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *CashDrawerSessionUpsert) ClearReviewedAt() *CashDrawerSessionUpsert {
+	u.SetNull(cashdrawersession.FieldReviewedAt)
+	return u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *CashDrawerSessionUpsert) SetJournalEntryID(v uuid.UUID) *CashDrawerSessionUpsert {
+	u.Set(cashdrawersession.FieldJournalEntryID, v)
+	return u
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsert) UpdateJournalEntryID() *CashDrawerSessionUpsert {
+	u.SetExcluded(cashdrawersession.FieldJournalEntryID)
+	return u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *CashDrawerSessionUpsert) ClearJournalEntryID() *CashDrawerSessionUpsert {
+	u.SetNull(cashdrawersession.FieldJournalEntryID)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CashDrawerSessionUpsert) SetUpdatedAt(v time.Time) *CashDrawerSessionUpsert {
+	u.Set(cashdrawersession.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsert) UpdateUpdatedAt() *CashDrawerSessionUpsert {
+	u.SetExcluded(cashdrawersession.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CashDrawerSession.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(cashdrawersession.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CashDrawerSessionUpsertOne) UpdateNewValues() *CashDrawerSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(cashdrawersession.FieldID)
+		}
+		if _, exists := u.create.mutation.PosSessionID(); exists {
+			s.SetIgnore(cashdrawersession.FieldPosSessionID)
+		}
+		if _, exists := u.create.mutation.DrawerID(); exists {
+			s.SetIgnore(cashdrawersession.FieldDrawerID)
+		}
+		if _, exists := u.create.mutation.OutletID(); exists {
+			s.SetIgnore(cashdrawersession.FieldOutletID)
+		}
+		if _, exists := u.create.mutation.CashierID(); exists {
+			s.SetIgnore(cashdrawersession.FieldCashierID)
+		}
+		if _, exists := u.create.mutation.Currency(); exists {
+			s.SetIgnore(cashdrawersession.FieldCurrency)
+		}
+		if _, exists := u.create.mutation.OpenedAt(); exists {
+			s.SetIgnore(cashdrawersession.FieldOpenedAt)
+		}
+		if _, exists := u.create.mutation.ClosedAt(); exists {
+			s.SetIgnore(cashdrawersession.FieldClosedAt)
+		}
+		if _, exists := u.create.mutation.OpeningFloat(); exists {
+			s.SetIgnore(cashdrawersession.FieldOpeningFloat)
+		}
+		if _, exists := u.create.mutation.EventID(); exists {
+			s.SetIgnore(cashdrawersession.FieldEventID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(cashdrawersession.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CashDrawerSession.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CashDrawerSessionUpsertOne) Ignore() *CashDrawerSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CashDrawerSessionUpsertOne) DoNothing() *CashDrawerSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CashDrawerSessionCreate.OnConflict
+// documentation for more info.
+func (u *CashDrawerSessionUpsertOne) Update(set func(*CashDrawerSessionUpsert)) *CashDrawerSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CashDrawerSessionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *CashDrawerSessionUpsertOne) SetTenantID(v uuid.UUID) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertOne) UpdateTenantID() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetCashSales sets the "cash_sales" field.
+func (u *CashDrawerSessionUpsertOne) SetCashSales(v decimal.Decimal) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetCashSales(v)
+	})
+}
+
+// AddCashSales adds v to the "cash_sales" field.
+func (u *CashDrawerSessionUpsertOne) AddCashSales(v decimal.Decimal) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.AddCashSales(v)
+	})
+}
+
+// UpdateCashSales sets the "cash_sales" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertOne) UpdateCashSales() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateCashSales()
+	})
+}
+
+// ClearCashSales clears the value of the "cash_sales" field.
+func (u *CashDrawerSessionUpsertOne) ClearCashSales() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearCashSales()
+	})
+}
+
+// SetCashRefunds sets the "cash_refunds" field.
+func (u *CashDrawerSessionUpsertOne) SetCashRefunds(v decimal.Decimal) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetCashRefunds(v)
+	})
+}
+
+// AddCashRefunds adds v to the "cash_refunds" field.
+func (u *CashDrawerSessionUpsertOne) AddCashRefunds(v decimal.Decimal) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.AddCashRefunds(v)
+	})
+}
+
+// UpdateCashRefunds sets the "cash_refunds" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertOne) UpdateCashRefunds() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateCashRefunds()
+	})
+}
+
+// ClearCashRefunds clears the value of the "cash_refunds" field.
+func (u *CashDrawerSessionUpsertOne) ClearCashRefunds() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearCashRefunds()
+	})
+}
+
+// SetCashTransactions sets the "cash_transactions" field.
+func (u *CashDrawerSessionUpsertOne) SetCashTransactions(v int) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetCashTransactions(v)
+	})
+}
+
+// AddCashTransactions adds v to the "cash_transactions" field.
+func (u *CashDrawerSessionUpsertOne) AddCashTransactions(v int) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.AddCashTransactions(v)
+	})
+}
+
+// UpdateCashTransactions sets the "cash_transactions" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertOne) UpdateCashTransactions() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateCashTransactions()
+	})
+}
+
+// ClearCashTransactions clears the value of the "cash_transactions" field.
+func (u *CashDrawerSessionUpsertOne) ClearCashTransactions() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearCashTransactions()
+	})
+}
+
+// SetExpectedCash sets the "expected_cash" field.
+func (u *CashDrawerSessionUpsertOne) SetExpectedCash(v decimal.Decimal) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetExpectedCash(v)
+	})
+}
+
+// AddExpectedCash adds v to the "expected_cash" field.
+func (u *CashDrawerSessionUpsertOne) AddExpectedCash(v decimal.Decimal) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.AddExpectedCash(v)
+	})
+}
+
+// UpdateExpectedCash sets the "expected_cash" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertOne) UpdateExpectedCash() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateExpectedCash()
+	})
+}
+
+// ClearExpectedCash clears the value of the "expected_cash" field.
+func (u *CashDrawerSessionUpsertOne) ClearExpectedCash() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearExpectedCash()
+	})
+}
+
+// SetCountedCash sets the "counted_cash" field.
+func (u *CashDrawerSessionUpsertOne) SetCountedCash(v decimal.Decimal) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetCountedCash(v)
+	})
+}
+
+// AddCountedCash adds v to the "counted_cash" field.
+func (u *CashDrawerSessionUpsertOne) AddCountedCash(v decimal.Decimal) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.AddCountedCash(v)
+	})
+}
+
+// UpdateCountedCash sets the "counted_cash" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertOne) UpdateCountedCash() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateCountedCash()
+	})
+}
+
+// ClearCountedCash clears the value of the "counted_cash" field.
+func (u *CashDrawerSessionUpsertOne) ClearCountedCash() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearCountedCash()
+	})
+}
+
+// SetVariance sets the "variance" field.
+func (u *CashDrawerSessionUpsertOne) SetVariance(v decimal.Decimal) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetVariance(v)
+	})
+}
+
+// AddVariance adds v to the "variance" field.
+func (u *CashDrawerSessionUpsertOne) AddVariance(v decimal.Decimal) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.AddVariance(v)
+	})
+}
+
+// UpdateVariance sets the "variance" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertOne) UpdateVariance() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateVariance()
+	})
+}
+
+// ClearVariance clears the value of the "variance" field.
+func (u *CashDrawerSessionUpsertOne) ClearVariance() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearVariance()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CashDrawerSessionUpsertOne) SetStatus(v string) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertOne) UpdateStatus() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *CashDrawerSessionUpsertOne) SetNotes(v string) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertOne) UpdateNotes() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *CashDrawerSessionUpsertOne) ClearNotes() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *CashDrawerSessionUpsertOne) SetRejectionReason(v string) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetRejectionReason(v)
+	})
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertOne) UpdateRejectionReason() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateRejectionReason()
+	})
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *CashDrawerSessionUpsertOne) ClearRejectionReason() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearRejectionReason()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *CashDrawerSessionUpsertOne) SetReviewedBy(v uuid.UUID) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertOne) UpdateReviewedBy() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *CashDrawerSessionUpsertOne) ClearReviewedBy() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearReviewedBy()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *CashDrawerSessionUpsertOne) SetReviewedAt(v time.Time) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertOne) UpdateReviewedAt() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *CashDrawerSessionUpsertOne) ClearReviewedAt() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *CashDrawerSessionUpsertOne) SetJournalEntryID(v uuid.UUID) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetJournalEntryID(v)
+	})
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertOne) UpdateJournalEntryID() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateJournalEntryID()
+	})
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *CashDrawerSessionUpsertOne) ClearJournalEntryID() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearJournalEntryID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CashDrawerSessionUpsertOne) SetUpdatedAt(v time.Time) *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertOne) UpdateUpdatedAt() *CashDrawerSessionUpsertOne {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CashDrawerSessionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CashDrawerSessionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CashDrawerSessionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CashDrawerSessionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CashDrawerSessionUpsertOne.ID is not supported by MySQL driver. Use CashDrawerSessionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CashDrawerSessionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CashDrawerSessionCreateBulk is the builder for creating many CashDrawerSession entities in bulk.
+type CashDrawerSessionCreateBulk struct {
+	config
+	err      error
+	builders []*CashDrawerSessionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CashDrawerSession entities in the database.
+func (_c *CashDrawerSessionCreateBulk) Save(ctx context.Context) ([]*CashDrawerSession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CashDrawerSession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CashDrawerSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CashDrawerSessionCreateBulk) SaveX(ctx context.Context) []*CashDrawerSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CashDrawerSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CashDrawerSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CashDrawerSession.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CashDrawerSessionUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CashDrawerSessionCreateBulk) OnConflict(opts ...sql.ConflictOption) *CashDrawerSessionUpsertBulk {
+	_c.conflict = opts
+	return &CashDrawerSessionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CashDrawerSession.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CashDrawerSessionCreateBulk) OnConflictColumns(columns ...string) *CashDrawerSessionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CashDrawerSessionUpsertBulk{
+		create: _c,
+	}
+}
+
+// CashDrawerSessionUpsertBulk is the builder for "upsert"-ing
+// a bulk of CashDrawerSession nodes.
+type CashDrawerSessionUpsertBulk struct {
+	create *CashDrawerSessionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CashDrawerSession.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(cashdrawersession.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CashDrawerSessionUpsertBulk) UpdateNewValues() *CashDrawerSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(cashdrawersession.FieldID)
+			}
+			if _, exists := b.mutation.PosSessionID(); exists {
+				s.SetIgnore(cashdrawersession.FieldPosSessionID)
+			}
+			if _, exists := b.mutation.DrawerID(); exists {
+				s.SetIgnore(cashdrawersession.FieldDrawerID)
+			}
+			if _, exists := b.mutation.OutletID(); exists {
+				s.SetIgnore(cashdrawersession.FieldOutletID)
+			}
+			if _, exists := b.mutation.CashierID(); exists {
+				s.SetIgnore(cashdrawersession.FieldCashierID)
+			}
+			if _, exists := b.mutation.Currency(); exists {
+				s.SetIgnore(cashdrawersession.FieldCurrency)
+			}
+			if _, exists := b.mutation.OpenedAt(); exists {
+				s.SetIgnore(cashdrawersession.FieldOpenedAt)
+			}
+			if _, exists := b.mutation.ClosedAt(); exists {
+				s.SetIgnore(cashdrawersession.FieldClosedAt)
+			}
+			if _, exists := b.mutation.OpeningFloat(); exists {
+				s.SetIgnore(cashdrawersession.FieldOpeningFloat)
+			}
+			if _, exists := b.mutation.EventID(); exists {
+				s.SetIgnore(cashdrawersession.FieldEventID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(cashdrawersession.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CashDrawerSession.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CashDrawerSessionUpsertBulk) Ignore() *CashDrawerSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CashDrawerSessionUpsertBulk) DoNothing() *CashDrawerSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CashDrawerSessionCreateBulk.OnConflict
+// documentation for more info.
+func (u *CashDrawerSessionUpsertBulk) Update(set func(*CashDrawerSessionUpsert)) *CashDrawerSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CashDrawerSessionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *CashDrawerSessionUpsertBulk) SetTenantID(v uuid.UUID) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertBulk) UpdateTenantID() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetCashSales sets the "cash_sales" field.
+func (u *CashDrawerSessionUpsertBulk) SetCashSales(v decimal.Decimal) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetCashSales(v)
+	})
+}
+
+// AddCashSales adds v to the "cash_sales" field.
+func (u *CashDrawerSessionUpsertBulk) AddCashSales(v decimal.Decimal) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.AddCashSales(v)
+	})
+}
+
+// UpdateCashSales sets the "cash_sales" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertBulk) UpdateCashSales() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateCashSales()
+	})
+}
+
+// ClearCashSales clears the value of the "cash_sales" field.
+func (u *CashDrawerSessionUpsertBulk) ClearCashSales() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearCashSales()
+	})
+}
+
+// SetCashRefunds sets the "cash_refunds" field.
+func (u *CashDrawerSessionUpsertBulk) SetCashRefunds(v decimal.Decimal) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetCashRefunds(v)
+	})
+}
+
+// AddCashRefunds adds v to the "cash_refunds" field.
+func (u *CashDrawerSessionUpsertBulk) AddCashRefunds(v decimal.Decimal) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.AddCashRefunds(v)
+	})
+}
+
+// UpdateCashRefunds sets the "cash_refunds" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertBulk) UpdateCashRefunds() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateCashRefunds()
+	})
+}
+
+// ClearCashRefunds clears the value of the "cash_refunds" field.
+func (u *CashDrawerSessionUpsertBulk) ClearCashRefunds() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearCashRefunds()
+	})
+}
+
+// SetCashTransactions sets the "cash_transactions" field.
+func (u *CashDrawerSessionUpsertBulk) SetCashTransactions(v int) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetCashTransactions(v)
+	})
+}
+
+// AddCashTransactions adds v to the "cash_transactions" field.
+func (u *CashDrawerSessionUpsertBulk) AddCashTransactions(v int) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.AddCashTransactions(v)
+	})
+}
+
+// UpdateCashTransactions sets the "cash_transactions" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertBulk) UpdateCashTransactions() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateCashTransactions()
+	})
+}
+
+// ClearCashTransactions clears the value of the "cash_transactions" field.
+func (u *CashDrawerSessionUpsertBulk) ClearCashTransactions() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearCashTransactions()
+	})
+}
+
+// SetExpectedCash sets the "expected_cash" field.
+func (u *CashDrawerSessionUpsertBulk) SetExpectedCash(v decimal.Decimal) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetExpectedCash(v)
+	})
+}
+
+// AddExpectedCash adds v to the "expected_cash" field.
+func (u *CashDrawerSessionUpsertBulk) AddExpectedCash(v decimal.Decimal) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.AddExpectedCash(v)
+	})
+}
+
+// UpdateExpectedCash sets the "expected_cash" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertBulk) UpdateExpectedCash() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateExpectedCash()
+	})
+}
+
+// ClearExpectedCash clears the value of the "expected_cash" field.
+func (u *CashDrawerSessionUpsertBulk) ClearExpectedCash() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearExpectedCash()
+	})
+}
+
+// SetCountedCash sets the "counted_cash" field.
+func (u *CashDrawerSessionUpsertBulk) SetCountedCash(v decimal.Decimal) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetCountedCash(v)
+	})
+}
+
+// AddCountedCash adds v to the "counted_cash" field.
+func (u *CashDrawerSessionUpsertBulk) AddCountedCash(v decimal.Decimal) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.AddCountedCash(v)
+	})
+}
+
+// UpdateCountedCash sets the "counted_cash" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertBulk) UpdateCountedCash() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateCountedCash()
+	})
+}
+
+// ClearCountedCash clears the value of the "counted_cash" field.
+func (u *CashDrawerSessionUpsertBulk) ClearCountedCash() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearCountedCash()
+	})
+}
+
+// SetVariance sets the "variance" field.
+func (u *CashDrawerSessionUpsertBulk) SetVariance(v decimal.Decimal) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetVariance(v)
+	})
+}
+
+// AddVariance adds v to the "variance" field.
+func (u *CashDrawerSessionUpsertBulk) AddVariance(v decimal.Decimal) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.AddVariance(v)
+	})
+}
+
+// UpdateVariance sets the "variance" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertBulk) UpdateVariance() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateVariance()
+	})
+}
+
+// ClearVariance clears the value of the "variance" field.
+func (u *CashDrawerSessionUpsertBulk) ClearVariance() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearVariance()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CashDrawerSessionUpsertBulk) SetStatus(v string) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertBulk) UpdateStatus() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *CashDrawerSessionUpsertBulk) SetNotes(v string) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertBulk) UpdateNotes() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *CashDrawerSessionUpsertBulk) ClearNotes() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *CashDrawerSessionUpsertBulk) SetRejectionReason(v string) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetRejectionReason(v)
+	})
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertBulk) UpdateRejectionReason() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateRejectionReason()
+	})
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *CashDrawerSessionUpsertBulk) ClearRejectionReason() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearRejectionReason()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *CashDrawerSessionUpsertBulk) SetReviewedBy(v uuid.UUID) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertBulk) UpdateReviewedBy() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *CashDrawerSessionUpsertBulk) ClearReviewedBy() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearReviewedBy()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *CashDrawerSessionUpsertBulk) SetReviewedAt(v time.Time) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertBulk) UpdateReviewedAt() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *CashDrawerSessionUpsertBulk) ClearReviewedAt() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *CashDrawerSessionUpsertBulk) SetJournalEntryID(v uuid.UUID) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetJournalEntryID(v)
+	})
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertBulk) UpdateJournalEntryID() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateJournalEntryID()
+	})
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *CashDrawerSessionUpsertBulk) ClearJournalEntryID() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.ClearJournalEntryID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CashDrawerSessionUpsertBulk) SetUpdatedAt(v time.Time) *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CashDrawerSessionUpsertBulk) UpdateUpdatedAt() *CashDrawerSessionUpsertBulk {
+	return u.Update(func(s *CashDrawerSessionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CashDrawerSessionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CashDrawerSessionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CashDrawerSessionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CashDrawerSessionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
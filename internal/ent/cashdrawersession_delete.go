@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawersession"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+)
+
+// CashDrawerSessionDelete is the builder for deleting a CashDrawerSession entity.
+type CashDrawerSessionDelete struct {
+	config
+	hooks    []Hook
+	mutation *CashDrawerSessionMutation
+}
+
+// Where appends a list predicates to the CashDrawerSessionDelete builder.
+func (_d *CashDrawerSessionDelete) Where(ps ...predicate.CashDrawerSession) *CashDrawerSessionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CashDrawerSessionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CashDrawerSessionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CashDrawerSessionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(cashdrawersession.Table, sqlgraph.NewFieldSpec(cashdrawersession.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CashDrawerSessionDeleteOne is the builder for deleting a single CashDrawerSession entity.
+type CashDrawerSessionDeleteOne struct {
+	_d *CashDrawerSessionDelete
+}
+
+// Where appends a list predicates to the CashDrawerSessionDelete builder.
+func (_d *CashDrawerSessionDeleteOne) Where(ps ...predicate.CashDrawerSession) *CashDrawerSessionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CashDrawerSessionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{cashdrawersession.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CashDrawerSessionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
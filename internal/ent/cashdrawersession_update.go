@@ -0,0 +1,992 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawersession"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CashDrawerSessionUpdate is the builder for updating CashDrawerSession entities.
+type CashDrawerSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CashDrawerSessionMutation
+}
+
+// Where appends a list predicates to the CashDrawerSessionUpdate builder.
+func (_u *CashDrawerSessionUpdate) Where(ps ...predicate.CashDrawerSession) *CashDrawerSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *CashDrawerSessionUpdate) SetTenantID(v uuid.UUID) *CashDrawerSessionUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdate) SetNillableTenantID(v *uuid.UUID) *CashDrawerSessionUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetCashSales sets the "cash_sales" field.
+func (_u *CashDrawerSessionUpdate) SetCashSales(v decimal.Decimal) *CashDrawerSessionUpdate {
+	_u.mutation.ResetCashSales()
+	_u.mutation.SetCashSales(v)
+	return _u
+}
+
+// SetNillableCashSales sets the "cash_sales" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdate) SetNillableCashSales(v *decimal.Decimal) *CashDrawerSessionUpdate {
+	if v != nil {
+		_u.SetCashSales(*v)
+	}
+	return _u
+}
+
+// AddCashSales adds value to the "cash_sales" field.
+func (_u *CashDrawerSessionUpdate) AddCashSales(v decimal.Decimal) *CashDrawerSessionUpdate {
+	_u.mutation.AddCashSales(v)
+	return _u
+}
+
+// ClearCashSales clears the value of the "cash_sales" field.
+func (_u *CashDrawerSessionUpdate) ClearCashSales() *CashDrawerSessionUpdate {
+	_u.mutation.ClearCashSales()
+	return _u
+}
+
+// SetCashRefunds sets the "cash_refunds" field.
+func (_u *CashDrawerSessionUpdate) SetCashRefunds(v decimal.Decimal) *CashDrawerSessionUpdate {
+	_u.mutation.ResetCashRefunds()
+	_u.mutation.SetCashRefunds(v)
+	return _u
+}
+
+// SetNillableCashRefunds sets the "cash_refunds" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdate) SetNillableCashRefunds(v *decimal.Decimal) *CashDrawerSessionUpdate {
+	if v != nil {
+		_u.SetCashRefunds(*v)
+	}
+	return _u
+}
+
+// AddCashRefunds adds value to the "cash_refunds" field.
+func (_u *CashDrawerSessionUpdate) AddCashRefunds(v decimal.Decimal) *CashDrawerSessionUpdate {
+	_u.mutation.AddCashRefunds(v)
+	return _u
+}
+
+// ClearCashRefunds clears the value of the "cash_refunds" field.
+func (_u *CashDrawerSessionUpdate) ClearCashRefunds() *CashDrawerSessionUpdate {
+	_u.mutation.ClearCashRefunds()
+	return _u
+}
+
+// SetCashTransactions sets the "cash_transactions" field.
+func (_u *CashDrawerSessionUpdate) SetCashTransactions(v int) *CashDrawerSessionUpdate {
+	_u.mutation.ResetCashTransactions()
+	_u.mutation.SetCashTransactions(v)
+	return _u
+}
+
+// SetNillableCashTransactions sets the "cash_transactions" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdate) SetNillableCashTransactions(v *int) *CashDrawerSessionUpdate {
+	if v != nil {
+		_u.SetCashTransactions(*v)
+	}
+	return _u
+}
+
+// AddCashTransactions adds value to the "cash_transactions" field.
+func (_u *CashDrawerSessionUpdate) AddCashTransactions(v int) *CashDrawerSessionUpdate {
+	_u.mutation.AddCashTransactions(v)
+	return _u
+}
+
+// ClearCashTransactions clears the value of the "cash_transactions" field.
+func (_u *CashDrawerSessionUpdate) ClearCashTransactions() *CashDrawerSessionUpdate {
+	_u.mutation.ClearCashTransactions()
+	return _u
+}
+
+// SetExpectedCash sets the "expected_cash" field.
+func (_u *CashDrawerSessionUpdate) SetExpectedCash(v decimal.Decimal) *CashDrawerSessionUpdate {
+	_u.mutation.ResetExpectedCash()
+	_u.mutation.SetExpectedCash(v)
+	return _u
+}
+
+// SetNillableExpectedCash sets the "expected_cash" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdate) SetNillableExpectedCash(v *decimal.Decimal) *CashDrawerSessionUpdate {
+	if v != nil {
+		_u.SetExpectedCash(*v)
+	}
+	return _u
+}
+
+// AddExpectedCash adds value to the "expected_cash" field.
+func (_u *CashDrawerSessionUpdate) AddExpectedCash(v decimal.Decimal) *CashDrawerSessionUpdate {
+	_u.mutation.AddExpectedCash(v)
+	return _u
+}
+
+// ClearExpectedCash clears the value of the "expected_cash" field.
+func (_u *CashDrawerSessionUpdate) ClearExpectedCash() *CashDrawerSessionUpdate {
+	_u.mutation.ClearExpectedCash()
+	return _u
+}
+
+// SetCountedCash sets the "counted_cash" field.
+func (_u *CashDrawerSessionUpdate) SetCountedCash(v decimal.Decimal) *CashDrawerSessionUpdate {
+	_u.mutation.ResetCountedCash()
+	_u.mutation.SetCountedCash(v)
+	return _u
+}
+
+// SetNillableCountedCash sets the "counted_cash" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdate) SetNillableCountedCash(v *decimal.Decimal) *CashDrawerSessionUpdate {
+	if v != nil {
+		_u.SetCountedCash(*v)
+	}
+	return _u
+}
+
+// AddCountedCash adds value to the "counted_cash" field.
+func (_u *CashDrawerSessionUpdate) AddCountedCash(v decimal.Decimal) *CashDrawerSessionUpdate {
+	_u.mutation.AddCountedCash(v)
+	return _u
+}
+
+// ClearCountedCash clears the value of the "counted_cash" field.
+func (_u *CashDrawerSessionUpdate) ClearCountedCash() *CashDrawerSessionUpdate {
+	_u.mutation.ClearCountedCash()
+	return _u
+}
+
+// SetVariance sets the "variance" field.
+func (_u *CashDrawerSessionUpdate) SetVariance(v decimal.Decimal) *CashDrawerSessionUpdate {
+	_u.mutation.ResetVariance()
+	_u.mutation.SetVariance(v)
+	return _u
+}
+
+// SetNillableVariance sets the "variance" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdate) SetNillableVariance(v *decimal.Decimal) *CashDrawerSessionUpdate {
+	if v != nil {
+		_u.SetVariance(*v)
+	}
+	return _u
+}
+
+// AddVariance adds value to the "variance" field.
+func (_u *CashDrawerSessionUpdate) AddVariance(v decimal.Decimal) *CashDrawerSessionUpdate {
+	_u.mutation.AddVariance(v)
+	return _u
+}
+
+// ClearVariance clears the value of the "variance" field.
+func (_u *CashDrawerSessionUpdate) ClearVariance() *CashDrawerSessionUpdate {
+	_u.mutation.ClearVariance()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CashDrawerSessionUpdate) SetStatus(v string) *CashDrawerSessionUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdate) SetNillableStatus(v *string) *CashDrawerSessionUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *CashDrawerSessionUpdate) SetNotes(v string) *CashDrawerSessionUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdate) SetNillableNotes(v *string) *CashDrawerSessionUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *CashDrawerSessionUpdate) ClearNotes() *CashDrawerSessionUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_u *CashDrawerSessionUpdate) SetRejectionReason(v string) *CashDrawerSessionUpdate {
+	_u.mutation.SetRejectionReason(v)
+	return _u
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdate) SetNillableRejectionReason(v *string) *CashDrawerSessionUpdate {
+	if v != nil {
+		_u.SetRejectionReason(*v)
+	}
+	return _u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (_u *CashDrawerSessionUpdate) ClearRejectionReason() *CashDrawerSessionUpdate {
+	_u.mutation.ClearRejectionReason()
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *CashDrawerSessionUpdate) SetReviewedBy(v uuid.UUID) *CashDrawerSessionUpdate {
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdate) SetNillableReviewedBy(v *uuid.UUID) *CashDrawerSessionUpdate {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *CashDrawerSessionUpdate) ClearReviewedBy() *CashDrawerSessionUpdate {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *CashDrawerSessionUpdate) SetReviewedAt(v time.Time) *CashDrawerSessionUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdate) SetNillableReviewedAt(v *time.Time) *CashDrawerSessionUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *CashDrawerSessionUpdate) ClearReviewedAt() *CashDrawerSessionUpdate {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_u *CashDrawerSessionUpdate) SetJournalEntryID(v uuid.UUID) *CashDrawerSessionUpdate {
+	_u.mutation.SetJournalEntryID(v)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdate) SetNillableJournalEntryID(v *uuid.UUID) *CashDrawerSessionUpdate {
+	if v != nil {
+		_u.SetJournalEntryID(*v)
+	}
+	return _u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (_u *CashDrawerSessionUpdate) ClearJournalEntryID() *CashDrawerSessionUpdate {
+	_u.mutation.ClearJournalEntryID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CashDrawerSessionUpdate) SetUpdatedAt(v time.Time) *CashDrawerSessionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the CashDrawerSessionMutation object of the builder.
+func (_u *CashDrawerSessionUpdate) Mutation() *CashDrawerSessionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CashDrawerSessionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CashDrawerSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CashDrawerSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CashDrawerSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CashDrawerSessionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := cashdrawersession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *CashDrawerSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(cashdrawersession.Table, cashdrawersession.Columns, sqlgraph.NewFieldSpec(cashdrawersession.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(cashdrawersession.FieldTenantID, field.TypeUUID, value)
+	}
+	if _u.mutation.OutletIDCleared() {
+		_spec.ClearField(cashdrawersession.FieldOutletID, field.TypeString)
+	}
+	if _u.mutation.CashierIDCleared() {
+		_spec.ClearField(cashdrawersession.FieldCashierID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CashSales(); ok {
+		_spec.SetField(cashdrawersession.FieldCashSales, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCashSales(); ok {
+		_spec.AddField(cashdrawersession.FieldCashSales, field.TypeFloat64, value)
+	}
+	if _u.mutation.CashSalesCleared() {
+		_spec.ClearField(cashdrawersession.FieldCashSales, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CashRefunds(); ok {
+		_spec.SetField(cashdrawersession.FieldCashRefunds, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCashRefunds(); ok {
+		_spec.AddField(cashdrawersession.FieldCashRefunds, field.TypeFloat64, value)
+	}
+	if _u.mutation.CashRefundsCleared() {
+		_spec.ClearField(cashdrawersession.FieldCashRefunds, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CashTransactions(); ok {
+		_spec.SetField(cashdrawersession.FieldCashTransactions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCashTransactions(); ok {
+		_spec.AddField(cashdrawersession.FieldCashTransactions, field.TypeInt, value)
+	}
+	if _u.mutation.CashTransactionsCleared() {
+		_spec.ClearField(cashdrawersession.FieldCashTransactions, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ExpectedCash(); ok {
+		_spec.SetField(cashdrawersession.FieldExpectedCash, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedExpectedCash(); ok {
+		_spec.AddField(cashdrawersession.FieldExpectedCash, field.TypeFloat64, value)
+	}
+	if _u.mutation.ExpectedCashCleared() {
+		_spec.ClearField(cashdrawersession.FieldExpectedCash, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CountedCash(); ok {
+		_spec.SetField(cashdrawersession.FieldCountedCash, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCountedCash(); ok {
+		_spec.AddField(cashdrawersession.FieldCountedCash, field.TypeFloat64, value)
+	}
+	if _u.mutation.CountedCashCleared() {
+		_spec.ClearField(cashdrawersession.FieldCountedCash, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Variance(); ok {
+		_spec.SetField(cashdrawersession.FieldVariance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVariance(); ok {
+		_spec.AddField(cashdrawersession.FieldVariance, field.TypeFloat64, value)
+	}
+	if _u.mutation.VarianceCleared() {
+		_spec.ClearField(cashdrawersession.FieldVariance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(cashdrawersession.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(cashdrawersession.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(cashdrawersession.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.RejectionReason(); ok {
+		_spec.SetField(cashdrawersession.FieldRejectionReason, field.TypeString, value)
+	}
+	if _u.mutation.RejectionReasonCleared() {
+		_spec.ClearField(cashdrawersession.FieldRejectionReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(cashdrawersession.FieldReviewedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(cashdrawersession.FieldReviewedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(cashdrawersession.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(cashdrawersession.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.JournalEntryID(); ok {
+		_spec.SetField(cashdrawersession.FieldJournalEntryID, field.TypeUUID, value)
+	}
+	if _u.mutation.JournalEntryIDCleared() {
+		_spec.ClearField(cashdrawersession.FieldJournalEntryID, field.TypeUUID)
+	}
+	if _u.mutation.EventIDCleared() {
+		_spec.ClearField(cashdrawersession.FieldEventID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(cashdrawersession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cashdrawersession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CashDrawerSessionUpdateOne is the builder for updating a single CashDrawerSession entity.
+type CashDrawerSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CashDrawerSessionMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *CashDrawerSessionUpdateOne) SetTenantID(v uuid.UUID) *CashDrawerSessionUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdateOne) SetNillableTenantID(v *uuid.UUID) *CashDrawerSessionUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetCashSales sets the "cash_sales" field.
+func (_u *CashDrawerSessionUpdateOne) SetCashSales(v decimal.Decimal) *CashDrawerSessionUpdateOne {
+	_u.mutation.ResetCashSales()
+	_u.mutation.SetCashSales(v)
+	return _u
+}
+
+// SetNillableCashSales sets the "cash_sales" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdateOne) SetNillableCashSales(v *decimal.Decimal) *CashDrawerSessionUpdateOne {
+	if v != nil {
+		_u.SetCashSales(*v)
+	}
+	return _u
+}
+
+// AddCashSales adds value to the "cash_sales" field.
+func (_u *CashDrawerSessionUpdateOne) AddCashSales(v decimal.Decimal) *CashDrawerSessionUpdateOne {
+	_u.mutation.AddCashSales(v)
+	return _u
+}
+
+// ClearCashSales clears the value of the "cash_sales" field.
+func (_u *CashDrawerSessionUpdateOne) ClearCashSales() *CashDrawerSessionUpdateOne {
+	_u.mutation.ClearCashSales()
+	return _u
+}
+
+// SetCashRefunds sets the "cash_refunds" field.
+func (_u *CashDrawerSessionUpdateOne) SetCashRefunds(v decimal.Decimal) *CashDrawerSessionUpdateOne {
+	_u.mutation.ResetCashRefunds()
+	_u.mutation.SetCashRefunds(v)
+	return _u
+}
+
+// SetNillableCashRefunds sets the "cash_refunds" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdateOne) SetNillableCashRefunds(v *decimal.Decimal) *CashDrawerSessionUpdateOne {
+	if v != nil {
+		_u.SetCashRefunds(*v)
+	}
+	return _u
+}
+
+// AddCashRefunds adds value to the "cash_refunds" field.
+func (_u *CashDrawerSessionUpdateOne) AddCashRefunds(v decimal.Decimal) *CashDrawerSessionUpdateOne {
+	_u.mutation.AddCashRefunds(v)
+	return _u
+}
+
+// ClearCashRefunds clears the value of the "cash_refunds" field.
+func (_u *CashDrawerSessionUpdateOne) ClearCashRefunds() *CashDrawerSessionUpdateOne {
+	_u.mutation.ClearCashRefunds()
+	return _u
+}
+
+// SetCashTransactions sets the "cash_transactions" field.
+func (_u *CashDrawerSessionUpdateOne) SetCashTransactions(v int) *CashDrawerSessionUpdateOne {
+	_u.mutation.ResetCashTransactions()
+	_u.mutation.SetCashTransactions(v)
+	return _u
+}
+
+// SetNillableCashTransactions sets the "cash_transactions" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdateOne) SetNillableCashTransactions(v *int) *CashDrawerSessionUpdateOne {
+	if v != nil {
+		_u.SetCashTransactions(*v)
+	}
+	return _u
+}
+
+// AddCashTransactions adds value to the "cash_transactions" field.
+func (_u *CashDrawerSessionUpdateOne) AddCashTransactions(v int) *CashDrawerSessionUpdateOne {
+	_u.mutation.AddCashTransactions(v)
+	return _u
+}
+
+// ClearCashTransactions clears the value of the "cash_transactions" field.
+func (_u *CashDrawerSessionUpdateOne) ClearCashTransactions() *CashDrawerSessionUpdateOne {
+	_u.mutation.ClearCashTransactions()
+	return _u
+}
+
+// SetExpectedCash sets the "expected_cash" field.
+func (_u *CashDrawerSessionUpdateOne) SetExpectedCash(v decimal.Decimal) *CashDrawerSessionUpdateOne {
+	_u.mutation.ResetExpectedCash()
+	_u.mutation.SetExpectedCash(v)
+	return _u
+}
+
+// SetNillableExpectedCash sets the "expected_cash" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdateOne) SetNillableExpectedCash(v *decimal.Decimal) *CashDrawerSessionUpdateOne {
+	if v != nil {
+		_u.SetExpectedCash(*v)
+	}
+	return _u
+}
+
+// AddExpectedCash adds value to the "expected_cash" field.
+func (_u *CashDrawerSessionUpdateOne) AddExpectedCash(v decimal.Decimal) *CashDrawerSessionUpdateOne {
+	_u.mutation.AddExpectedCash(v)
+	return _u
+}
+
+// ClearExpectedCash clears the value of the "expected_cash" field.
+func (_u *CashDrawerSessionUpdateOne) ClearExpectedCash() *CashDrawerSessionUpdateOne {
+	_u.mutation.ClearExpectedCash()
+	return _u
+}
+
+// SetCountedCash sets the "counted_cash" field.
+func (_u *CashDrawerSessionUpdateOne) SetCountedCash(v decimal.Decimal) *CashDrawerSessionUpdateOne {
+	_u.mutation.ResetCountedCash()
+	_u.mutation.SetCountedCash(v)
+	return _u
+}
+
+// SetNillableCountedCash sets the "counted_cash" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdateOne) SetNillableCountedCash(v *decimal.Decimal) *CashDrawerSessionUpdateOne {
+	if v != nil {
+		_u.SetCountedCash(*v)
+	}
+	return _u
+}
+
+// AddCountedCash adds value to the "counted_cash" field.
+func (_u *CashDrawerSessionUpdateOne) AddCountedCash(v decimal.Decimal) *CashDrawerSessionUpdateOne {
+	_u.mutation.AddCountedCash(v)
+	return _u
+}
+
+// ClearCountedCash clears the value of the "counted_cash" field.
+func (_u *CashDrawerSessionUpdateOne) ClearCountedCash() *CashDrawerSessionUpdateOne {
+	_u.mutation.ClearCountedCash()
+	return _u
+}
+
+// SetVariance sets the "variance" field.
+func (_u *CashDrawerSessionUpdateOne) SetVariance(v decimal.Decimal) *CashDrawerSessionUpdateOne {
+	_u.mutation.ResetVariance()
+	_u.mutation.SetVariance(v)
+	return _u
+}
+
+// SetNillableVariance sets the "variance" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdateOne) SetNillableVariance(v *decimal.Decimal) *CashDrawerSessionUpdateOne {
+	if v != nil {
+		_u.SetVariance(*v)
+	}
+	return _u
+}
+
+// AddVariance adds value to the "variance" field.
+func (_u *CashDrawerSessionUpdateOne) AddVariance(v decimal.Decimal) *CashDrawerSessionUpdateOne {
+	_u.mutation.AddVariance(v)
+	return _u
+}
+
+// ClearVariance clears the value of the "variance" field.
+func (_u *CashDrawerSessionUpdateOne) ClearVariance() *CashDrawerSessionUpdateOne {
+	_u.mutation.ClearVariance()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CashDrawerSessionUpdateOne) SetStatus(v string) *CashDrawerSessionUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdateOne) SetNillableStatus(v *string) *CashDrawerSessionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *CashDrawerSessionUpdateOne) SetNotes(v string) *CashDrawerSessionUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdateOne) SetNillableNotes(v *string) *CashDrawerSessionUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *CashDrawerSessionUpdateOne) ClearNotes() *CashDrawerSessionUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_u *CashDrawerSessionUpdateOne) SetRejectionReason(v string) *CashDrawerSessionUpdateOne {
+	_u.mutation.SetRejectionReason(v)
+	return _u
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdateOne) SetNillableRejectionReason(v *string) *CashDrawerSessionUpdateOne {
+	if v != nil {
+		_u.SetRejectionReason(*v)
+	}
+	return _u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (_u *CashDrawerSessionUpdateOne) ClearRejectionReason() *CashDrawerSessionUpdateOne {
+	_u.mutation.ClearRejectionReason()
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *CashDrawerSessionUpdateOne) SetReviewedBy(v uuid.UUID) *CashDrawerSessionUpdateOne {
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdateOne) SetNillableReviewedBy(v *uuid.UUID) *CashDrawerSessionUpdateOne {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *CashDrawerSessionUpdateOne) ClearReviewedBy() *CashDrawerSessionUpdateOne {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *CashDrawerSessionUpdateOne) SetReviewedAt(v time.Time) *CashDrawerSessionUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdateOne) SetNillableReviewedAt(v *time.Time) *CashDrawerSessionUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *CashDrawerSessionUpdateOne) ClearReviewedAt() *CashDrawerSessionUpdateOne {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_u *CashDrawerSessionUpdateOne) SetJournalEntryID(v uuid.UUID) *CashDrawerSessionUpdateOne {
+	_u.mutation.SetJournalEntryID(v)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_u *CashDrawerSessionUpdateOne) SetNillableJournalEntryID(v *uuid.UUID) *CashDrawerSessionUpdateOne {
+	if v != nil {
+		_u.SetJournalEntryID(*v)
+	}
+	return _u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (_u *CashDrawerSessionUpdateOne) ClearJournalEntryID() *CashDrawerSessionUpdateOne {
+	_u.mutation.ClearJournalEntryID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CashDrawerSessionUpdateOne) SetUpdatedAt(v time.Time) *CashDrawerSessionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the CashDrawerSessionMutation object of the builder.
+func (_u *CashDrawerSessionUpdateOne) Mutation() *CashDrawerSessionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CashDrawerSessionUpdate builder.
+func (_u *CashDrawerSessionUpdateOne) Where(ps ...predicate.CashDrawerSession) *CashDrawerSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CashDrawerSessionUpdateOne) Select(field string, fields ...string) *CashDrawerSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CashDrawerSession entity.
+func (_u *CashDrawerSessionUpdateOne) Save(ctx context.Context) (*CashDrawerSession, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CashDrawerSessionUpdateOne) SaveX(ctx context.Context) *CashDrawerSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CashDrawerSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CashDrawerSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CashDrawerSessionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := cashdrawersession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *CashDrawerSessionUpdateOne) sqlSave(ctx context.Context) (_node *CashDrawerSession, err error) {
+	_spec := sqlgraph.NewUpdateSpec(cashdrawersession.Table, cashdrawersession.Columns, sqlgraph.NewFieldSpec(cashdrawersession.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CashDrawerSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cashdrawersession.FieldID)
+		for _, f := range fields {
+			if !cashdrawersession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != cashdrawersession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(cashdrawersession.FieldTenantID, field.TypeUUID, value)
+	}
+	if _u.mutation.OutletIDCleared() {
+		_spec.ClearField(cashdrawersession.FieldOutletID, field.TypeString)
+	}
+	if _u.mutation.CashierIDCleared() {
+		_spec.ClearField(cashdrawersession.FieldCashierID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CashSales(); ok {
+		_spec.SetField(cashdrawersession.FieldCashSales, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCashSales(); ok {
+		_spec.AddField(cashdrawersession.FieldCashSales, field.TypeFloat64, value)
+	}
+	if _u.mutation.CashSalesCleared() {
+		_spec.ClearField(cashdrawersession.FieldCashSales, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CashRefunds(); ok {
+		_spec.SetField(cashdrawersession.FieldCashRefunds, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCashRefunds(); ok {
+		_spec.AddField(cashdrawersession.FieldCashRefunds, field.TypeFloat64, value)
+	}
+	if _u.mutation.CashRefundsCleared() {
+		_spec.ClearField(cashdrawersession.FieldCashRefunds, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CashTransactions(); ok {
+		_spec.SetField(cashdrawersession.FieldCashTransactions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCashTransactions(); ok {
+		_spec.AddField(cashdrawersession.FieldCashTransactions, field.TypeInt, value)
+	}
+	if _u.mutation.CashTransactionsCleared() {
+		_spec.ClearField(cashdrawersession.FieldCashTransactions, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ExpectedCash(); ok {
+		_spec.SetField(cashdrawersession.FieldExpectedCash, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedExpectedCash(); ok {
+		_spec.AddField(cashdrawersession.FieldExpectedCash, field.TypeFloat64, value)
+	}
+	if _u.mutation.ExpectedCashCleared() {
+		_spec.ClearField(cashdrawersession.FieldExpectedCash, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CountedCash(); ok {
+		_spec.SetField(cashdrawersession.FieldCountedCash, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCountedCash(); ok {
+		_spec.AddField(cashdrawersession.FieldCountedCash, field.TypeFloat64, value)
+	}
+	if _u.mutation.CountedCashCleared() {
+		_spec.ClearField(cashdrawersession.FieldCountedCash, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Variance(); ok {
+		_spec.SetField(cashdrawersession.FieldVariance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVariance(); ok {
+		_spec.AddField(cashdrawersession.FieldVariance, field.TypeFloat64, value)
+	}
+	if _u.mutation.VarianceCleared() {
+		_spec.ClearField(cashdrawersession.FieldVariance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(cashdrawersession.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(cashdrawersession.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(cashdrawersession.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.RejectionReason(); ok {
+		_spec.SetField(cashdrawersession.FieldRejectionReason, field.TypeString, value)
+	}
+	if _u.mutation.RejectionReasonCleared() {
+		_spec.ClearField(cashdrawersession.FieldRejectionReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(cashdrawersession.FieldReviewedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(cashdrawersession.FieldReviewedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(cashdrawersession.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(cashdrawersession.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.JournalEntryID(); ok {
+		_spec.SetField(cashdrawersession.FieldJournalEntryID, field.TypeUUID, value)
+	}
+	if _u.mutation.JournalEntryIDCleared() {
+		_spec.ClearField(cashdrawersession.FieldJournalEntryID, field.TypeUUID)
+	}
+	if _u.mutation.EventIDCleared() {
+		_spec.ClearField(cashdrawersession.FieldEventID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(cashdrawersession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &CashDrawerSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cashdrawersession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawersetting"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CashDrawerSetting is the model entity for the CashDrawerSetting schema.
+type CashDrawerSetting struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Largest variance posted without supervisor approval (defaults to zero)
+	ApprovalThreshold decimal.Decimal `json:"approval_threshold,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CashDrawerSetting) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case cashdrawersetting.FieldApprovalThreshold:
+			values[i] = new(decimal.Decimal)
+		case cashdrawersetting.FieldCreatedAt, cashdrawersetting.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case cashdrawersetting.FieldID, cashdrawersetting.FieldTenantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CashDrawerSetting fields.
+func (_m *CashDrawerSetting) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case cashdrawersetting.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case cashdrawersetting.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case cashdrawersetting.FieldApprovalThreshold:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field approval_threshold", values[i])
+			} else if value != nil {
+				_m.ApprovalThreshold = *value
+			}
+		case cashdrawersetting.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case cashdrawersetting.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CashDrawerSetting.
+// This includes values selected through modifiers, order, etc.
+func (_m *CashDrawerSetting) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CashDrawerSetting.
+// Note that you need to call CashDrawerSetting.Unwrap() before calling this method if this CashDrawerSetting
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CashDrawerSetting) Update() *CashDrawerSettingUpdateOne {
+	return NewCashDrawerSettingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CashDrawerSetting entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CashDrawerSetting) Unwrap() *CashDrawerSetting {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CashDrawerSetting is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CashDrawerSetting) String() string {
+	var builder strings.Builder
+	builder.WriteString("CashDrawerSetting(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("approval_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ApprovalThreshold))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CashDrawerSettings is a parsable slice of CashDrawerSetting.
+type CashDrawerSettings []*CashDrawerSetting
@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cashdrawersetting
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ApprovalThreshold applies equality check predicate on the "approval_threshold" field. It's identical to ApprovalThresholdEQ.
+func ApprovalThreshold(v decimal.Decimal) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldEQ(FieldApprovalThreshold, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldLTE(FieldTenantID, v))
+}
+
+// ApprovalThresholdEQ applies the EQ predicate on the "approval_threshold" field.
+func ApprovalThresholdEQ(v decimal.Decimal) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldEQ(FieldApprovalThreshold, v))
+}
+
+// ApprovalThresholdNEQ applies the NEQ predicate on the "approval_threshold" field.
+func ApprovalThresholdNEQ(v decimal.Decimal) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldNEQ(FieldApprovalThreshold, v))
+}
+
+// ApprovalThresholdIn applies the In predicate on the "approval_threshold" field.
+func ApprovalThresholdIn(vs ...decimal.Decimal) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldIn(FieldApprovalThreshold, vs...))
+}
+
+// ApprovalThresholdNotIn applies the NotIn predicate on the "approval_threshold" field.
+func ApprovalThresholdNotIn(vs ...decimal.Decimal) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldNotIn(FieldApprovalThreshold, vs...))
+}
+
+// ApprovalThresholdGT applies the GT predicate on the "approval_threshold" field.
+func ApprovalThresholdGT(v decimal.Decimal) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldGT(FieldApprovalThreshold, v))
+}
+
+// ApprovalThresholdGTE applies the GTE predicate on the "approval_threshold" field.
+func ApprovalThresholdGTE(v decimal.Decimal) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldGTE(FieldApprovalThreshold, v))
+}
+
+// ApprovalThresholdLT applies the LT predicate on the "approval_threshold" field.
+func ApprovalThresholdLT(v decimal.Decimal) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldLT(FieldApprovalThreshold, v))
+}
+
+// ApprovalThresholdLTE applies the LTE predicate on the "approval_threshold" field.
+func ApprovalThresholdLTE(v decimal.Decimal) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldLTE(FieldApprovalThreshold, v))
+}
+
+// ApprovalThresholdIsNil applies the IsNil predicate on the "approval_threshold" field.
+func ApprovalThresholdIsNil() predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldIsNull(FieldApprovalThreshold))
+}
+
+// ApprovalThresholdNotNil applies the NotNil predicate on the "approval_threshold" field.
+func ApprovalThresholdNotNil() predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldNotNull(FieldApprovalThreshold))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CashDrawerSetting) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CashDrawerSetting) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CashDrawerSetting) predicate.CashDrawerSetting {
+	return predicate.CashDrawerSetting(sql.NotPredicates(p))
+}
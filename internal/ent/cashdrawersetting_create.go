@@ -0,0 +1,704 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawersetting"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CashDrawerSettingCreate is the builder for creating a CashDrawerSetting entity.
+type CashDrawerSettingCreate struct {
+	config
+	mutation *CashDrawerSettingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *CashDrawerSettingCreate) SetTenantID(v uuid.UUID) *CashDrawerSettingCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetApprovalThreshold sets the "approval_threshold" field.
+func (_c *CashDrawerSettingCreate) SetApprovalThreshold(v decimal.Decimal) *CashDrawerSettingCreate {
+	_c.mutation.SetApprovalThreshold(v)
+	return _c
+}
+
+// SetNillableApprovalThreshold sets the "approval_threshold" field if the given value is not nil.
+func (_c *CashDrawerSettingCreate) SetNillableApprovalThreshold(v *decimal.Decimal) *CashDrawerSettingCreate {
+	if v != nil {
+		_c.SetApprovalThreshold(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CashDrawerSettingCreate) SetCreatedAt(v time.Time) *CashDrawerSettingCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CashDrawerSettingCreate) SetNillableCreatedAt(v *time.Time) *CashDrawerSettingCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CashDrawerSettingCreate) SetUpdatedAt(v time.Time) *CashDrawerSettingCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CashDrawerSettingCreate) SetNillableUpdatedAt(v *time.Time) *CashDrawerSettingCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CashDrawerSettingCreate) SetID(v uuid.UUID) *CashDrawerSettingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CashDrawerSettingCreate) SetNillableID(v *uuid.UUID) *CashDrawerSettingCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CashDrawerSettingMutation object of the builder.
+func (_c *CashDrawerSettingCreate) Mutation() *CashDrawerSettingMutation {
+	return _c.mutation
+}
+
+// Save creates the CashDrawerSetting in the database.
+func (_c *CashDrawerSettingCreate) Save(ctx context.Context) (*CashDrawerSetting, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CashDrawerSettingCreate) SaveX(ctx context.Context) *CashDrawerSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CashDrawerSettingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CashDrawerSettingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CashDrawerSettingCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := cashdrawersetting.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := cashdrawersetting.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := cashdrawersetting.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CashDrawerSettingCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "CashDrawerSetting.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CashDrawerSetting.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CashDrawerSetting.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *CashDrawerSettingCreate) sqlSave(ctx context.Context) (*CashDrawerSetting, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CashDrawerSettingCreate) createSpec() (*CashDrawerSetting, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CashDrawerSetting{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(cashdrawersetting.Table, sqlgraph.NewFieldSpec(cashdrawersetting.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(cashdrawersetting.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.ApprovalThreshold(); ok {
+		_spec.SetField(cashdrawersetting.FieldApprovalThreshold, field.TypeFloat64, value)
+		_node.ApprovalThreshold = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(cashdrawersetting.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(cashdrawersetting.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CashDrawerSetting.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CashDrawerSettingUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CashDrawerSettingCreate) OnConflict(opts ...sql.ConflictOption) *CashDrawerSettingUpsertOne {
+	_c.conflict = opts
+	return &CashDrawerSettingUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CashDrawerSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CashDrawerSettingCreate) OnConflictColumns(columns ...string) *CashDrawerSettingUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CashDrawerSettingUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CashDrawerSettingUpsertOne is the builder for "upsert"-ing
+	//  one CashDrawerSetting node.
+	CashDrawerSettingUpsertOne struct {
+		create *CashDrawerSettingCreate
+	}
+
+	// CashDrawerSettingUpsert is the "OnConflict" setter.
+	CashDrawerSettingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *CashDrawerSettingUpsert) SetTenantID(v uuid.UUID) *CashDrawerSettingUpsert {
+	u.Set(cashdrawersetting.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *CashDrawerSettingUpsert) UpdateTenantID() *CashDrawerSettingUpsert {
+	u.SetExcluded(cashdrawersetting.FieldTenantID)
+	return u
+}
+
+// SetApprovalThreshold sets the "approval_threshold" field.
+func (u *CashDrawerSettingUpsert) SetApprovalThreshold(v decimal.Decimal) *CashDrawerSettingUpsert {
+	u.Set(cashdrawersetting.FieldApprovalThreshold, v)
+	return u
+}
+
+// UpdateApprovalThreshold sets the "approval_threshold" field to the value that was provided on create.
+func (u *CashDrawerSettingUpsert) UpdateApprovalThreshold() *CashDrawerSettingUpsert {
+	u.SetExcluded(cashdrawersetting.FieldApprovalThreshold)
+	return u
+}
+
+// AddApprovalThreshold adds v to the "approval_threshold" field.
+func (u *CashDrawerSettingUpsert) AddApprovalThreshold(v decimal.Decimal) *CashDrawerSettingUpsert {
+	u.Add(cashdrawersetting.FieldApprovalThreshold, v)
+	return u
+}
+
+// ClearApprovalThreshold clears the value of the "approval_threshold" field.
+func (u *CashDrawerSettingUpsert) ClearApprovalThreshold() *CashDrawerSettingUpsert {
+	u.SetNull(cashdrawersetting.FieldApprovalThreshold)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CashDrawerSettingUpsert) SetUpdatedAt(v time.Time) *CashDrawerSettingUpsert {
+	u.Set(cashdrawersetting.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CashDrawerSettingUpsert) UpdateUpdatedAt() *CashDrawerSettingUpsert {
+	u.SetExcluded(cashdrawersetting.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CashDrawerSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(cashdrawersetting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CashDrawerSettingUpsertOne) UpdateNewValues() *CashDrawerSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(cashdrawersetting.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(cashdrawersetting.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CashDrawerSetting.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CashDrawerSettingUpsertOne) Ignore() *CashDrawerSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CashDrawerSettingUpsertOne) DoNothing() *CashDrawerSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CashDrawerSettingCreate.OnConflict
+// documentation for more info.
+func (u *CashDrawerSettingUpsertOne) Update(set func(*CashDrawerSettingUpsert)) *CashDrawerSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CashDrawerSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *CashDrawerSettingUpsertOne) SetTenantID(v uuid.UUID) *CashDrawerSettingUpsertOne {
+	return u.Update(func(s *CashDrawerSettingUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *CashDrawerSettingUpsertOne) UpdateTenantID() *CashDrawerSettingUpsertOne {
+	return u.Update(func(s *CashDrawerSettingUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetApprovalThreshold sets the "approval_threshold" field.
+func (u *CashDrawerSettingUpsertOne) SetApprovalThreshold(v decimal.Decimal) *CashDrawerSettingUpsertOne {
+	return u.Update(func(s *CashDrawerSettingUpsert) {
+		s.SetApprovalThreshold(v)
+	})
+}
+
+// AddApprovalThreshold adds v to the "approval_threshold" field.
+func (u *CashDrawerSettingUpsertOne) AddApprovalThreshold(v decimal.Decimal) *CashDrawerSettingUpsertOne {
+	return u.Update(func(s *CashDrawerSettingUpsert) {
+		s.AddApprovalThreshold(v)
+	})
+}
+
+// UpdateApprovalThreshold sets the "approval_threshold" field to the value that was provided on create.
+func (u *CashDrawerSettingUpsertOne) UpdateApprovalThreshold() *CashDrawerSettingUpsertOne {
+	return u.Update(func(s *CashDrawerSettingUpsert) {
+		s.UpdateApprovalThreshold()
+	})
+}
+
+// ClearApprovalThreshold clears the value of the "approval_threshold" field.
+func (u *CashDrawerSettingUpsertOne) ClearApprovalThreshold() *CashDrawerSettingUpsertOne {
+	return u.Update(func(s *CashDrawerSettingUpsert) {
+		s.ClearApprovalThreshold()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CashDrawerSettingUpsertOne) SetUpdatedAt(v time.Time) *CashDrawerSettingUpsertOne {
+	return u.Update(func(s *CashDrawerSettingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CashDrawerSettingUpsertOne) UpdateUpdatedAt() *CashDrawerSettingUpsertOne {
+	return u.Update(func(s *CashDrawerSettingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CashDrawerSettingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CashDrawerSettingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CashDrawerSettingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CashDrawerSettingUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CashDrawerSettingUpsertOne.ID is not supported by MySQL driver. Use CashDrawerSettingUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CashDrawerSettingUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CashDrawerSettingCreateBulk is the builder for creating many CashDrawerSetting entities in bulk.
+type CashDrawerSettingCreateBulk struct {
+	config
+	err      error
+	builders []*CashDrawerSettingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CashDrawerSetting entities in the database.
+func (_c *CashDrawerSettingCreateBulk) Save(ctx context.Context) ([]*CashDrawerSetting, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CashDrawerSetting, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CashDrawerSettingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CashDrawerSettingCreateBulk) SaveX(ctx context.Context) []*CashDrawerSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CashDrawerSettingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CashDrawerSettingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CashDrawerSetting.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CashDrawerSettingUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CashDrawerSettingCreateBulk) OnConflict(opts ...sql.ConflictOption) *CashDrawerSettingUpsertBulk {
+	_c.conflict = opts
+	return &CashDrawerSettingUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CashDrawerSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CashDrawerSettingCreateBulk) OnConflictColumns(columns ...string) *CashDrawerSettingUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CashDrawerSettingUpsertBulk{
+		create: _c,
+	}
+}
+
+// CashDrawerSettingUpsertBulk is the builder for "upsert"-ing
+// a bulk of CashDrawerSetting nodes.
+type CashDrawerSettingUpsertBulk struct {
+	create *CashDrawerSettingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CashDrawerSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(cashdrawersetting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CashDrawerSettingUpsertBulk) UpdateNewValues() *CashDrawerSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(cashdrawersetting.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(cashdrawersetting.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CashDrawerSetting.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CashDrawerSettingUpsertBulk) Ignore() *CashDrawerSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CashDrawerSettingUpsertBulk) DoNothing() *CashDrawerSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CashDrawerSettingCreateBulk.OnConflict
+// documentation for more info.
+func (u *CashDrawerSettingUpsertBulk) Update(set func(*CashDrawerSettingUpsert)) *CashDrawerSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CashDrawerSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *CashDrawerSettingUpsertBulk) SetTenantID(v uuid.UUID) *CashDrawerSettingUpsertBulk {
+	return u.Update(func(s *CashDrawerSettingUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *CashDrawerSettingUpsertBulk) UpdateTenantID() *CashDrawerSettingUpsertBulk {
+	return u.Update(func(s *CashDrawerSettingUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetApprovalThreshold sets the "approval_threshold" field.
+func (u *CashDrawerSettingUpsertBulk) SetApprovalThreshold(v decimal.Decimal) *CashDrawerSettingUpsertBulk {
+	return u.Update(func(s *CashDrawerSettingUpsert) {
+		s.SetApprovalThreshold(v)
+	})
+}
+
+// AddApprovalThreshold adds v to the "approval_threshold" field.
+func (u *CashDrawerSettingUpsertBulk) AddApprovalThreshold(v decimal.Decimal) *CashDrawerSettingUpsertBulk {
+	return u.Update(func(s *CashDrawerSettingUpsert) {
+		s.AddApprovalThreshold(v)
+	})
+}
+
+// UpdateApprovalThreshold sets the "approval_threshold" field to the value that was provided on create.
+func (u *CashDrawerSettingUpsertBulk) UpdateApprovalThreshold() *CashDrawerSettingUpsertBulk {
+	return u.Update(func(s *CashDrawerSettingUpsert) {
+		s.UpdateApprovalThreshold()
+	})
+}
+
+// ClearApprovalThreshold clears the value of the "approval_threshold" field.
+func (u *CashDrawerSettingUpsertBulk) ClearApprovalThreshold() *CashDrawerSettingUpsertBulk {
+	return u.Update(func(s *CashDrawerSettingUpsert) {
+		s.ClearApprovalThreshold()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CashDrawerSettingUpsertBulk) SetUpdatedAt(v time.Time) *CashDrawerSettingUpsertBulk {
+	return u.Update(func(s *CashDrawerSettingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CashDrawerSettingUpsertBulk) UpdateUpdatedAt() *CashDrawerSettingUpsertBulk {
+	return u.Update(func(s *CashDrawerSettingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CashDrawerSettingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CashDrawerSettingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CashDrawerSettingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CashDrawerSettingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
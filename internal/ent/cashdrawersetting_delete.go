@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawersetting"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+)
+
+// CashDrawerSettingDelete is the builder for deleting a CashDrawerSetting entity.
+type CashDrawerSettingDelete struct {
+	config
+	hooks    []Hook
+	mutation *CashDrawerSettingMutation
+}
+
+// Where appends a list predicates to the CashDrawerSettingDelete builder.
+func (_d *CashDrawerSettingDelete) Where(ps ...predicate.CashDrawerSetting) *CashDrawerSettingDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CashDrawerSettingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CashDrawerSettingDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CashDrawerSettingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(cashdrawersetting.Table, sqlgraph.NewFieldSpec(cashdrawersetting.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CashDrawerSettingDeleteOne is the builder for deleting a single CashDrawerSetting entity.
+type CashDrawerSettingDeleteOne struct {
+	_d *CashDrawerSettingDelete
+}
+
+// Where appends a list predicates to the CashDrawerSettingDelete builder.
+func (_d *CashDrawerSettingDeleteOne) Where(ps ...predicate.CashDrawerSetting) *CashDrawerSettingDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CashDrawerSettingDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{cashdrawersetting.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CashDrawerSettingDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
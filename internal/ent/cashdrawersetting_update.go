@@ -0,0 +1,320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawersetting"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CashDrawerSettingUpdate is the builder for updating CashDrawerSetting entities.
+type CashDrawerSettingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CashDrawerSettingMutation
+}
+
+// Where appends a list predicates to the CashDrawerSettingUpdate builder.
+func (_u *CashDrawerSettingUpdate) Where(ps ...predicate.CashDrawerSetting) *CashDrawerSettingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *CashDrawerSettingUpdate) SetTenantID(v uuid.UUID) *CashDrawerSettingUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *CashDrawerSettingUpdate) SetNillableTenantID(v *uuid.UUID) *CashDrawerSettingUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetApprovalThreshold sets the "approval_threshold" field.
+func (_u *CashDrawerSettingUpdate) SetApprovalThreshold(v decimal.Decimal) *CashDrawerSettingUpdate {
+	_u.mutation.ResetApprovalThreshold()
+	_u.mutation.SetApprovalThreshold(v)
+	return _u
+}
+
+// SetNillableApprovalThreshold sets the "approval_threshold" field if the given value is not nil.
+func (_u *CashDrawerSettingUpdate) SetNillableApprovalThreshold(v *decimal.Decimal) *CashDrawerSettingUpdate {
+	if v != nil {
+		_u.SetApprovalThreshold(*v)
+	}
+	return _u
+}
+
+// AddApprovalThreshold adds value to the "approval_threshold" field.
+func (_u *CashDrawerSettingUpdate) AddApprovalThreshold(v decimal.Decimal) *CashDrawerSettingUpdate {
+	_u.mutation.AddApprovalThreshold(v)
+	return _u
+}
+
+// ClearApprovalThreshold clears the value of the "approval_threshold" field.
+func (_u *CashDrawerSettingUpdate) ClearApprovalThreshold() *CashDrawerSettingUpdate {
+	_u.mutation.ClearApprovalThreshold()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CashDrawerSettingUpdate) SetUpdatedAt(v time.Time) *CashDrawerSettingUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the CashDrawerSettingMutation object of the builder.
+func (_u *CashDrawerSettingUpdate) Mutation() *CashDrawerSettingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CashDrawerSettingUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CashDrawerSettingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CashDrawerSettingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CashDrawerSettingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CashDrawerSettingUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := cashdrawersetting.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *CashDrawerSettingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(cashdrawersetting.Table, cashdrawersetting.Columns, sqlgraph.NewFieldSpec(cashdrawersetting.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(cashdrawersetting.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ApprovalThreshold(); ok {
+		_spec.SetField(cashdrawersetting.FieldApprovalThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedApprovalThreshold(); ok {
+		_spec.AddField(cashdrawersetting.FieldApprovalThreshold, field.TypeFloat64, value)
+	}
+	if _u.mutation.ApprovalThresholdCleared() {
+		_spec.ClearField(cashdrawersetting.FieldApprovalThreshold, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(cashdrawersetting.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cashdrawersetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CashDrawerSettingUpdateOne is the builder for updating a single CashDrawerSetting entity.
+type CashDrawerSettingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CashDrawerSettingMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *CashDrawerSettingUpdateOne) SetTenantID(v uuid.UUID) *CashDrawerSettingUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *CashDrawerSettingUpdateOne) SetNillableTenantID(v *uuid.UUID) *CashDrawerSettingUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetApprovalThreshold sets the "approval_threshold" field.
+func (_u *CashDrawerSettingUpdateOne) SetApprovalThreshold(v decimal.Decimal) *CashDrawerSettingUpdateOne {
+	_u.mutation.ResetApprovalThreshold()
+	_u.mutation.SetApprovalThreshold(v)
+	return _u
+}
+
+// SetNillableApprovalThreshold sets the "approval_threshold" field if the given value is not nil.
+func (_u *CashDrawerSettingUpdateOne) SetNillableApprovalThreshold(v *decimal.Decimal) *CashDrawerSettingUpdateOne {
+	if v != nil {
+		_u.SetApprovalThreshold(*v)
+	}
+	return _u
+}
+
+// AddApprovalThreshold adds value to the "approval_threshold" field.
+func (_u *CashDrawerSettingUpdateOne) AddApprovalThreshold(v decimal.Decimal) *CashDrawerSettingUpdateOne {
+	_u.mutation.AddApprovalThreshold(v)
+	return _u
+}
+
+// ClearApprovalThreshold clears the value of the "approval_threshold" field.
+func (_u *CashDrawerSettingUpdateOne) ClearApprovalThreshold() *CashDrawerSettingUpdateOne {
+	_u.mutation.ClearApprovalThreshold()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CashDrawerSettingUpdateOne) SetUpdatedAt(v time.Time) *CashDrawerSettingUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the CashDrawerSettingMutation object of the builder.
+func (_u *CashDrawerSettingUpdateOne) Mutation() *CashDrawerSettingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CashDrawerSettingUpdate builder.
+func (_u *CashDrawerSettingUpdateOne) Where(ps ...predicate.CashDrawerSetting) *CashDrawerSettingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CashDrawerSettingUpdateOne) Select(field string, fields ...string) *CashDrawerSettingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CashDrawerSetting entity.
+func (_u *CashDrawerSettingUpdateOne) Save(ctx context.Context) (*CashDrawerSetting, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CashDrawerSettingUpdateOne) SaveX(ctx context.Context) *CashDrawerSetting {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CashDrawerSettingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CashDrawerSettingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CashDrawerSettingUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := cashdrawersetting.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *CashDrawerSettingUpdateOne) sqlSave(ctx context.Context) (_node *CashDrawerSetting, err error) {
+	_spec := sqlgraph.NewUpdateSpec(cashdrawersetting.Table, cashdrawersetting.Columns, sqlgraph.NewFieldSpec(cashdrawersetting.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CashDrawerSetting.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cashdrawersetting.FieldID)
+		for _, f := range fields {
+			if !cashdrawersetting.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != cashdrawersetting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(cashdrawersetting.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ApprovalThreshold(); ok {
+		_spec.SetField(cashdrawersetting.FieldApprovalThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedApprovalThreshold(); ok {
+		_spec.AddField(cashdrawersetting.FieldApprovalThreshold, field.TypeFloat64, value)
+	}
+	if _u.mutation.ApprovalThresholdCleared() {
+		_spec.ClearField(cashdrawersetting.FieldApprovalThreshold, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(cashdrawersetting.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &CashDrawerSetting{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cashdrawersetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
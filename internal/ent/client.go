@@ -16,17 +16,68 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/bankaccount"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatement"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatementprofile"
+	"github.com/bengobox/treasury-api/internal/ent/banktransaction"
+	"github.com/bengobox/treasury-api/internal/ent/billingcycle"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawercount"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawersession"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawersetting"
 	"github.com/bengobox/treasury-api/internal/ent/chartofaccount"
+	"github.com/bengobox/treasury-api/internal/ent/creditoverride"
+	"github.com/bengobox/treasury-api/internal/ent/customer"
+	"github.com/bengobox/treasury-api/internal/ent/customerstatement"
+	"github.com/bengobox/treasury-api/internal/ent/documentsequence"
+	"github.com/bengobox/treasury-api/internal/ent/dunningnotice"
+	"github.com/bengobox/treasury-api/internal/ent/dunningpause"
+	"github.com/bengobox/treasury-api/internal/ent/dunningstep"
+	"github.com/bengobox/treasury-api/internal/ent/earningssetting"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceipt"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceiptline"
 	"github.com/bengobox/treasury-api/internal/ent/invoice"
+	"github.com/bengobox/treasury-api/internal/ent/invoiceline"
+	"github.com/bengobox/treasury-api/internal/ent/invoicepayment"
+	"github.com/bengobox/treasury-api/internal/ent/invoicesetting"
 	"github.com/bengobox/treasury-api/internal/ent/ledgertransaction"
 	"github.com/bengobox/treasury-api/internal/ent/outboxevent"
+	"github.com/bengobox/treasury-api/internal/ent/payablesetting"
+	"github.com/bengobox/treasury-api/internal/ent/payeepayout"
+	"github.com/bengobox/treasury-api/internal/ent/payeewallet"
+	"github.com/bengobox/treasury-api/internal/ent/payeewalletentry"
 	"github.com/bengobox/treasury-api/internal/ent/paymentintent"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrun"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrunitem"
 	"github.com/bengobox/treasury-api/internal/ent/paymenttransaction"
+	"github.com/bengobox/treasury-api/internal/ent/provisionpolicy"
+	"github.com/bengobox/treasury-api/internal/ent/provisionrun"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliation"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatch"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatchitem"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationrule"
 	"github.com/bengobox/treasury-api/internal/ent/rolepermission"
+	"github.com/bengobox/treasury-api/internal/ent/settlementbatch"
+	"github.com/bengobox/treasury-api/internal/ent/settlementdisbursement"
+	"github.com/bengobox/treasury-api/internal/ent/settlementitem"
+	"github.com/bengobox/treasury-api/internal/ent/settlementsetting"
+	"github.com/bengobox/treasury-api/internal/ent/subscription"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionadjustment"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionmeter"
 	"github.com/bengobox/treasury-api/internal/ent/treasurypermission"
 	"github.com/bengobox/treasury-api/internal/ent/treasuryrole"
 	"github.com/bengobox/treasury-api/internal/ent/treasuryuser"
+	"github.com/bengobox/treasury-api/internal/ent/usagerecord"
 	"github.com/bengobox/treasury-api/internal/ent/userroleassignment"
+	"github.com/bengobox/treasury-api/internal/ent/vendor"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbill"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbillline"
+	"github.com/bengobox/treasury-api/internal/ent/wallet"
+	"github.com/bengobox/treasury-api/internal/ent/wallethold"
+	"github.com/bengobox/treasury-api/internal/ent/wallettransaction"
+	"github.com/bengobox/treasury-api/internal/ent/withholdingcertificate"
+	"github.com/bengobox/treasury-api/internal/ent/withholdingrate"
+	"github.com/bengobox/treasury-api/internal/ent/writeoff"
+	"github.com/bengobox/treasury-api/internal/ent/writeoffrecovery"
 )
 
 // Client is the client that holds all ent builders.
@@ -34,28 +85,130 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// BankAccount is the client for interacting with the BankAccount builders.
+	BankAccount *BankAccountClient
+	// BankStatement is the client for interacting with the BankStatement builders.
+	BankStatement *BankStatementClient
+	// BankStatementProfile is the client for interacting with the BankStatementProfile builders.
+	BankStatementProfile *BankStatementProfileClient
+	// BankTransaction is the client for interacting with the BankTransaction builders.
+	BankTransaction *BankTransactionClient
+	// BillingCycle is the client for interacting with the BillingCycle builders.
+	BillingCycle *BillingCycleClient
+	// CashDrawerCount is the client for interacting with the CashDrawerCount builders.
+	CashDrawerCount *CashDrawerCountClient
+	// CashDrawerSession is the client for interacting with the CashDrawerSession builders.
+	CashDrawerSession *CashDrawerSessionClient
+	// CashDrawerSetting is the client for interacting with the CashDrawerSetting builders.
+	CashDrawerSetting *CashDrawerSettingClient
 	// ChartOfAccount is the client for interacting with the ChartOfAccount builders.
 	ChartOfAccount *ChartOfAccountClient
+	// CreditOverride is the client for interacting with the CreditOverride builders.
+	CreditOverride *CreditOverrideClient
+	// Customer is the client for interacting with the Customer builders.
+	Customer *CustomerClient
+	// CustomerStatement is the client for interacting with the CustomerStatement builders.
+	CustomerStatement *CustomerStatementClient
+	// DocumentSequence is the client for interacting with the DocumentSequence builders.
+	DocumentSequence *DocumentSequenceClient
+	// DunningNotice is the client for interacting with the DunningNotice builders.
+	DunningNotice *DunningNoticeClient
+	// DunningPause is the client for interacting with the DunningPause builders.
+	DunningPause *DunningPauseClient
+	// DunningStep is the client for interacting with the DunningStep builders.
+	DunningStep *DunningStepClient
+	// EarningsSetting is the client for interacting with the EarningsSetting builders.
+	EarningsSetting *EarningsSettingClient
+	// GoodsReceipt is the client for interacting with the GoodsReceipt builders.
+	GoodsReceipt *GoodsReceiptClient
+	// GoodsReceiptLine is the client for interacting with the GoodsReceiptLine builders.
+	GoodsReceiptLine *GoodsReceiptLineClient
 	// Invoice is the client for interacting with the Invoice builders.
 	Invoice *InvoiceClient
+	// InvoiceLine is the client for interacting with the InvoiceLine builders.
+	InvoiceLine *InvoiceLineClient
+	// InvoicePayment is the client for interacting with the InvoicePayment builders.
+	InvoicePayment *InvoicePaymentClient
+	// InvoiceSetting is the client for interacting with the InvoiceSetting builders.
+	InvoiceSetting *InvoiceSettingClient
 	// LedgerTransaction is the client for interacting with the LedgerTransaction builders.
 	LedgerTransaction *LedgerTransactionClient
 	// OutboxEvent is the client for interacting with the OutboxEvent builders.
 	OutboxEvent *OutboxEventClient
+	// PayableSetting is the client for interacting with the PayableSetting builders.
+	PayableSetting *PayableSettingClient
+	// PayeePayout is the client for interacting with the PayeePayout builders.
+	PayeePayout *PayeePayoutClient
+	// PayeeWallet is the client for interacting with the PayeeWallet builders.
+	PayeeWallet *PayeeWalletClient
+	// PayeeWalletEntry is the client for interacting with the PayeeWalletEntry builders.
+	PayeeWalletEntry *PayeeWalletEntryClient
 	// PaymentIntent is the client for interacting with the PaymentIntent builders.
 	PaymentIntent *PaymentIntentClient
+	// PaymentRun is the client for interacting with the PaymentRun builders.
+	PaymentRun *PaymentRunClient
+	// PaymentRunItem is the client for interacting with the PaymentRunItem builders.
+	PaymentRunItem *PaymentRunItemClient
 	// PaymentTransaction is the client for interacting with the PaymentTransaction builders.
 	PaymentTransaction *PaymentTransactionClient
+	// ProvisionPolicy is the client for interacting with the ProvisionPolicy builders.
+	ProvisionPolicy *ProvisionPolicyClient
+	// ProvisionRun is the client for interacting with the ProvisionRun builders.
+	ProvisionRun *ProvisionRunClient
+	// Reconciliation is the client for interacting with the Reconciliation builders.
+	Reconciliation *ReconciliationClient
+	// ReconciliationMatch is the client for interacting with the ReconciliationMatch builders.
+	ReconciliationMatch *ReconciliationMatchClient
+	// ReconciliationMatchItem is the client for interacting with the ReconciliationMatchItem builders.
+	ReconciliationMatchItem *ReconciliationMatchItemClient
+	// ReconciliationRule is the client for interacting with the ReconciliationRule builders.
+	ReconciliationRule *ReconciliationRuleClient
 	// RolePermission is the client for interacting with the RolePermission builders.
 	RolePermission *RolePermissionClient
+	// SettlementBatch is the client for interacting with the SettlementBatch builders.
+	SettlementBatch *SettlementBatchClient
+	// SettlementDisbursement is the client for interacting with the SettlementDisbursement builders.
+	SettlementDisbursement *SettlementDisbursementClient
+	// SettlementItem is the client for interacting with the SettlementItem builders.
+	SettlementItem *SettlementItemClient
+	// SettlementSetting is the client for interacting with the SettlementSetting builders.
+	SettlementSetting *SettlementSettingClient
+	// Subscription is the client for interacting with the Subscription builders.
+	Subscription *SubscriptionClient
+	// SubscriptionAdjustment is the client for interacting with the SubscriptionAdjustment builders.
+	SubscriptionAdjustment *SubscriptionAdjustmentClient
+	// SubscriptionMeter is the client for interacting with the SubscriptionMeter builders.
+	SubscriptionMeter *SubscriptionMeterClient
 	// TreasuryPermission is the client for interacting with the TreasuryPermission builders.
 	TreasuryPermission *TreasuryPermissionClient
 	// TreasuryRole is the client for interacting with the TreasuryRole builders.
 	TreasuryRole *TreasuryRoleClient
 	// TreasuryUser is the client for interacting with the TreasuryUser builders.
 	TreasuryUser *TreasuryUserClient
+	// UsageRecord is the client for interacting with the UsageRecord builders.
+	UsageRecord *UsageRecordClient
 	// UserRoleAssignment is the client for interacting with the UserRoleAssignment builders.
 	UserRoleAssignment *UserRoleAssignmentClient
+	// Vendor is the client for interacting with the Vendor builders.
+	Vendor *VendorClient
+	// VendorBill is the client for interacting with the VendorBill builders.
+	VendorBill *VendorBillClient
+	// VendorBillLine is the client for interacting with the VendorBillLine builders.
+	VendorBillLine *VendorBillLineClient
+	// Wallet is the client for interacting with the Wallet builders.
+	Wallet *WalletClient
+	// WalletHold is the client for interacting with the WalletHold builders.
+	WalletHold *WalletHoldClient
+	// WalletTransaction is the client for interacting with the WalletTransaction builders.
+	WalletTransaction *WalletTransactionClient
+	// WithholdingCertificate is the client for interacting with the WithholdingCertificate builders.
+	WithholdingCertificate *WithholdingCertificateClient
+	// WithholdingRate is the client for interacting with the WithholdingRate builders.
+	WithholdingRate *WithholdingRateClient
+	// WriteOff is the client for interacting with the WriteOff builders.
+	WriteOff *WriteOffClient
+	// WriteOffRecovery is the client for interacting with the WriteOffRecovery builders.
+	WriteOffRecovery *WriteOffRecoveryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -67,17 +220,68 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.BankAccount = NewBankAccountClient(c.config)
+	c.BankStatement = NewBankStatementClient(c.config)
+	c.BankStatementProfile = NewBankStatementProfileClient(c.config)
+	c.BankTransaction = NewBankTransactionClient(c.config)
+	c.BillingCycle = NewBillingCycleClient(c.config)
+	c.CashDrawerCount = NewCashDrawerCountClient(c.config)
+	c.CashDrawerSession = NewCashDrawerSessionClient(c.config)
+	c.CashDrawerSetting = NewCashDrawerSettingClient(c.config)
 	c.ChartOfAccount = NewChartOfAccountClient(c.config)
+	c.CreditOverride = NewCreditOverrideClient(c.config)
+	c.Customer = NewCustomerClient(c.config)
+	c.CustomerStatement = NewCustomerStatementClient(c.config)
+	c.DocumentSequence = NewDocumentSequenceClient(c.config)
+	c.DunningNotice = NewDunningNoticeClient(c.config)
+	c.DunningPause = NewDunningPauseClient(c.config)
+	c.DunningStep = NewDunningStepClient(c.config)
+	c.EarningsSetting = NewEarningsSettingClient(c.config)
+	c.GoodsReceipt = NewGoodsReceiptClient(c.config)
+	c.GoodsReceiptLine = NewGoodsReceiptLineClient(c.config)
 	c.Invoice = NewInvoiceClient(c.config)
+	c.InvoiceLine = NewInvoiceLineClient(c.config)
+	c.InvoicePayment = NewInvoicePaymentClient(c.config)
+	c.InvoiceSetting = NewInvoiceSettingClient(c.config)
 	c.LedgerTransaction = NewLedgerTransactionClient(c.config)
 	c.OutboxEvent = NewOutboxEventClient(c.config)
+	c.PayableSetting = NewPayableSettingClient(c.config)
+	c.PayeePayout = NewPayeePayoutClient(c.config)
+	c.PayeeWallet = NewPayeeWalletClient(c.config)
+	c.PayeeWalletEntry = NewPayeeWalletEntryClient(c.config)
 	c.PaymentIntent = NewPaymentIntentClient(c.config)
+	c.PaymentRun = NewPaymentRunClient(c.config)
+	c.PaymentRunItem = NewPaymentRunItemClient(c.config)
 	c.PaymentTransaction = NewPaymentTransactionClient(c.config)
+	c.ProvisionPolicy = NewProvisionPolicyClient(c.config)
+	c.ProvisionRun = NewProvisionRunClient(c.config)
+	c.Reconciliation = NewReconciliationClient(c.config)
+	c.ReconciliationMatch = NewReconciliationMatchClient(c.config)
+	c.ReconciliationMatchItem = NewReconciliationMatchItemClient(c.config)
+	c.ReconciliationRule = NewReconciliationRuleClient(c.config)
 	c.RolePermission = NewRolePermissionClient(c.config)
+	c.SettlementBatch = NewSettlementBatchClient(c.config)
+	c.SettlementDisbursement = NewSettlementDisbursementClient(c.config)
+	c.SettlementItem = NewSettlementItemClient(c.config)
+	c.SettlementSetting = NewSettlementSettingClient(c.config)
+	c.Subscription = NewSubscriptionClient(c.config)
+	c.SubscriptionAdjustment = NewSubscriptionAdjustmentClient(c.config)
+	c.SubscriptionMeter = NewSubscriptionMeterClient(c.config)
 	c.TreasuryPermission = NewTreasuryPermissionClient(c.config)
 	c.TreasuryRole = NewTreasuryRoleClient(c.config)
 	c.TreasuryUser = NewTreasuryUserClient(c.config)
+	c.UsageRecord = NewUsageRecordClient(c.config)
 	c.UserRoleAssignment = NewUserRoleAssignmentClient(c.config)
+	c.Vendor = NewVendorClient(c.config)
+	c.VendorBill = NewVendorBillClient(c.config)
+	c.VendorBillLine = NewVendorBillLineClient(c.config)
+	c.Wallet = NewWalletClient(c.config)
+	c.WalletHold = NewWalletHoldClient(c.config)
+	c.WalletTransaction = NewWalletTransactionClient(c.config)
+	c.WithholdingCertificate = NewWithholdingCertificateClient(c.config)
+	c.WithholdingRate = NewWithholdingRateClient(c.config)
+	c.WriteOff = NewWriteOffClient(c.config)
+	c.WriteOffRecovery = NewWriteOffRecoveryClient(c.config)
 }
 
 type (
@@ -168,19 +372,70 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                ctx,
-		config:             cfg,
-		ChartOfAccount:     NewChartOfAccountClient(cfg),
-		Invoice:            NewInvoiceClient(cfg),
-		LedgerTransaction:  NewLedgerTransactionClient(cfg),
-		OutboxEvent:        NewOutboxEventClient(cfg),
-		PaymentIntent:      NewPaymentIntentClient(cfg),
-		PaymentTransaction: NewPaymentTransactionClient(cfg),
-		RolePermission:     NewRolePermissionClient(cfg),
-		TreasuryPermission: NewTreasuryPermissionClient(cfg),
-		TreasuryRole:       NewTreasuryRoleClient(cfg),
-		TreasuryUser:       NewTreasuryUserClient(cfg),
-		UserRoleAssignment: NewUserRoleAssignmentClient(cfg),
+		ctx:                     ctx,
+		config:                  cfg,
+		BankAccount:             NewBankAccountClient(cfg),
+		BankStatement:           NewBankStatementClient(cfg),
+		BankStatementProfile:    NewBankStatementProfileClient(cfg),
+		BankTransaction:         NewBankTransactionClient(cfg),
+		BillingCycle:            NewBillingCycleClient(cfg),
+		CashDrawerCount:         NewCashDrawerCountClient(cfg),
+		CashDrawerSession:       NewCashDrawerSessionClient(cfg),
+		CashDrawerSetting:       NewCashDrawerSettingClient(cfg),
+		ChartOfAccount:          NewChartOfAccountClient(cfg),
+		CreditOverride:          NewCreditOverrideClient(cfg),
+		Customer:                NewCustomerClient(cfg),
+		CustomerStatement:       NewCustomerStatementClient(cfg),
+		DocumentSequence:        NewDocumentSequenceClient(cfg),
+		DunningNotice:           NewDunningNoticeClient(cfg),
+		DunningPause:            NewDunningPauseClient(cfg),
+		DunningStep:             NewDunningStepClient(cfg),
+		EarningsSetting:         NewEarningsSettingClient(cfg),
+		GoodsReceipt:            NewGoodsReceiptClient(cfg),
+		GoodsReceiptLine:        NewGoodsReceiptLineClient(cfg),
+		Invoice:                 NewInvoiceClient(cfg),
+		InvoiceLine:             NewInvoiceLineClient(cfg),
+		InvoicePayment:          NewInvoicePaymentClient(cfg),
+		InvoiceSetting:          NewInvoiceSettingClient(cfg),
+		LedgerTransaction:       NewLedgerTransactionClient(cfg),
+		OutboxEvent:             NewOutboxEventClient(cfg),
+		PayableSetting:          NewPayableSettingClient(cfg),
+		PayeePayout:             NewPayeePayoutClient(cfg),
+		PayeeWallet:             NewPayeeWalletClient(cfg),
+		PayeeWalletEntry:        NewPayeeWalletEntryClient(cfg),
+		PaymentIntent:           NewPaymentIntentClient(cfg),
+		PaymentRun:              NewPaymentRunClient(cfg),
+		PaymentRunItem:          NewPaymentRunItemClient(cfg),
+		PaymentTransaction:      NewPaymentTransactionClient(cfg),
+		ProvisionPolicy:         NewProvisionPolicyClient(cfg),
+		ProvisionRun:            NewProvisionRunClient(cfg),
+		Reconciliation:          NewReconciliationClient(cfg),
+		ReconciliationMatch:     NewReconciliationMatchClient(cfg),
+		ReconciliationMatchItem: NewReconciliationMatchItemClient(cfg),
+		ReconciliationRule:      NewReconciliationRuleClient(cfg),
+		RolePermission:          NewRolePermissionClient(cfg),
+		SettlementBatch:         NewSettlementBatchClient(cfg),
+		SettlementDisbursement:  NewSettlementDisbursementClient(cfg),
+		SettlementItem:          NewSettlementItemClient(cfg),
+		SettlementSetting:       NewSettlementSettingClient(cfg),
+		Subscription:            NewSubscriptionClient(cfg),
+		SubscriptionAdjustment:  NewSubscriptionAdjustmentClient(cfg),
+		SubscriptionMeter:       NewSubscriptionMeterClient(cfg),
+		TreasuryPermission:      NewTreasuryPermissionClient(cfg),
+		TreasuryRole:            NewTreasuryRoleClient(cfg),
+		TreasuryUser:            NewTreasuryUserClient(cfg),
+		UsageRecord:             NewUsageRecordClient(cfg),
+		UserRoleAssignment:      NewUserRoleAssignmentClient(cfg),
+		Vendor:                  NewVendorClient(cfg),
+		VendorBill:              NewVendorBillClient(cfg),
+		VendorBillLine:          NewVendorBillLineClient(cfg),
+		Wallet:                  NewWalletClient(cfg),
+		WalletHold:              NewWalletHoldClient(cfg),
+		WalletTransaction:       NewWalletTransactionClient(cfg),
+		WithholdingCertificate:  NewWithholdingCertificateClient(cfg),
+		WithholdingRate:         NewWithholdingRateClient(cfg),
+		WriteOff:                NewWriteOffClient(cfg),
+		WriteOffRecovery:        NewWriteOffRecoveryClient(cfg),
 	}, nil
 }
 
@@ -198,26 +453,77 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                ctx,
-		config:             cfg,
-		ChartOfAccount:     NewChartOfAccountClient(cfg),
-		Invoice:            NewInvoiceClient(cfg),
-		LedgerTransaction:  NewLedgerTransactionClient(cfg),
-		OutboxEvent:        NewOutboxEventClient(cfg),
-		PaymentIntent:      NewPaymentIntentClient(cfg),
-		PaymentTransaction: NewPaymentTransactionClient(cfg),
-		RolePermission:     NewRolePermissionClient(cfg),
-		TreasuryPermission: NewTreasuryPermissionClient(cfg),
-		TreasuryRole:       NewTreasuryRoleClient(cfg),
-		TreasuryUser:       NewTreasuryUserClient(cfg),
-		UserRoleAssignment: NewUserRoleAssignmentClient(cfg),
+		ctx:                     ctx,
+		config:                  cfg,
+		BankAccount:             NewBankAccountClient(cfg),
+		BankStatement:           NewBankStatementClient(cfg),
+		BankStatementProfile:    NewBankStatementProfileClient(cfg),
+		BankTransaction:         NewBankTransactionClient(cfg),
+		BillingCycle:            NewBillingCycleClient(cfg),
+		CashDrawerCount:         NewCashDrawerCountClient(cfg),
+		CashDrawerSession:       NewCashDrawerSessionClient(cfg),
+		CashDrawerSetting:       NewCashDrawerSettingClient(cfg),
+		ChartOfAccount:          NewChartOfAccountClient(cfg),
+		CreditOverride:          NewCreditOverrideClient(cfg),
+		Customer:                NewCustomerClient(cfg),
+		CustomerStatement:       NewCustomerStatementClient(cfg),
+		DocumentSequence:        NewDocumentSequenceClient(cfg),
+		DunningNotice:           NewDunningNoticeClient(cfg),
+		DunningPause:            NewDunningPauseClient(cfg),
+		DunningStep:             NewDunningStepClient(cfg),
+		EarningsSetting:         NewEarningsSettingClient(cfg),
+		GoodsReceipt:            NewGoodsReceiptClient(cfg),
+		GoodsReceiptLine:        NewGoodsReceiptLineClient(cfg),
+		Invoice:                 NewInvoiceClient(cfg),
+		InvoiceLine:             NewInvoiceLineClient(cfg),
+		InvoicePayment:          NewInvoicePaymentClient(cfg),
+		InvoiceSetting:          NewInvoiceSettingClient(cfg),
+		LedgerTransaction:       NewLedgerTransactionClient(cfg),
+		OutboxEvent:             NewOutboxEventClient(cfg),
+		PayableSetting:          NewPayableSettingClient(cfg),
+		PayeePayout:             NewPayeePayoutClient(cfg),
+		PayeeWallet:             NewPayeeWalletClient(cfg),
+		PayeeWalletEntry:        NewPayeeWalletEntryClient(cfg),
+		PaymentIntent:           NewPaymentIntentClient(cfg),
+		PaymentRun:              NewPaymentRunClient(cfg),
+		PaymentRunItem:          NewPaymentRunItemClient(cfg),
+		PaymentTransaction:      NewPaymentTransactionClient(cfg),
+		ProvisionPolicy:         NewProvisionPolicyClient(cfg),
+		ProvisionRun:            NewProvisionRunClient(cfg),
+		Reconciliation:          NewReconciliationClient(cfg),
+		ReconciliationMatch:     NewReconciliationMatchClient(cfg),
+		ReconciliationMatchItem: NewReconciliationMatchItemClient(cfg),
+		ReconciliationRule:      NewReconciliationRuleClient(cfg),
+		RolePermission:          NewRolePermissionClient(cfg),
+		SettlementBatch:         NewSettlementBatchClient(cfg),
+		SettlementDisbursement:  NewSettlementDisbursementClient(cfg),
+		SettlementItem:          NewSettlementItemClient(cfg),
+		SettlementSetting:       NewSettlementSettingClient(cfg),
+		Subscription:            NewSubscriptionClient(cfg),
+		SubscriptionAdjustment:  NewSubscriptionAdjustmentClient(cfg),
+		SubscriptionMeter:       NewSubscriptionMeterClient(cfg),
+		TreasuryPermission:      NewTreasuryPermissionClient(cfg),
+		TreasuryRole:            NewTreasuryRoleClient(cfg),
+		TreasuryUser:            NewTreasuryUserClient(cfg),
+		UsageRecord:             NewUsageRecordClient(cfg),
+		UserRoleAssignment:      NewUserRoleAssignmentClient(cfg),
+		Vendor:                  NewVendorClient(cfg),
+		VendorBill:              NewVendorBillClient(cfg),
+		VendorBillLine:          NewVendorBillLineClient(cfg),
+		Wallet:                  NewWalletClient(cfg),
+		WalletHold:              NewWalletHoldClient(cfg),
+		WalletTransaction:       NewWalletTransactionClient(cfg),
+		WithholdingCertificate:  NewWithholdingCertificateClient(cfg),
+		WithholdingRate:         NewWithholdingRateClient(cfg),
+		WriteOff:                NewWriteOffClient(cfg),
+		WriteOffRecovery:        NewWriteOffRecoveryClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		ChartOfAccount.
+//		BankAccount.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -240,9 +546,22 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.ChartOfAccount, c.Invoice, c.LedgerTransaction, c.OutboxEvent,
-		c.PaymentIntent, c.PaymentTransaction, c.RolePermission, c.TreasuryPermission,
-		c.TreasuryRole, c.TreasuryUser, c.UserRoleAssignment,
+		c.BankAccount, c.BankStatement, c.BankStatementProfile, c.BankTransaction,
+		c.BillingCycle, c.CashDrawerCount, c.CashDrawerSession, c.CashDrawerSetting,
+		c.ChartOfAccount, c.CreditOverride, c.Customer, c.CustomerStatement,
+		c.DocumentSequence, c.DunningNotice, c.DunningPause, c.DunningStep,
+		c.EarningsSetting, c.GoodsReceipt, c.GoodsReceiptLine, c.Invoice,
+		c.InvoiceLine, c.InvoicePayment, c.InvoiceSetting, c.LedgerTransaction,
+		c.OutboxEvent, c.PayableSetting, c.PayeePayout, c.PayeeWallet,
+		c.PayeeWalletEntry, c.PaymentIntent, c.PaymentRun, c.PaymentRunItem,
+		c.PaymentTransaction, c.ProvisionPolicy, c.ProvisionRun, c.Reconciliation,
+		c.ReconciliationMatch, c.ReconciliationMatchItem, c.ReconciliationRule,
+		c.RolePermission, c.SettlementBatch, c.SettlementDisbursement,
+		c.SettlementItem, c.SettlementSetting, c.Subscription,
+		c.SubscriptionAdjustment, c.SubscriptionMeter, c.TreasuryPermission,
+		c.TreasuryRole, c.TreasuryUser, c.UsageRecord, c.UserRoleAssignment, c.Vendor,
+		c.VendorBill, c.VendorBillLine, c.Wallet, c.WalletHold, c.WalletTransaction,
+		c.WithholdingCertificate, c.WithholdingRate, c.WriteOff, c.WriteOffRecovery,
 	} {
 		n.Use(hooks...)
 	}
@@ -252,9 +571,22 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.ChartOfAccount, c.Invoice, c.LedgerTransaction, c.OutboxEvent,
-		c.PaymentIntent, c.PaymentTransaction, c.RolePermission, c.TreasuryPermission,
-		c.TreasuryRole, c.TreasuryUser, c.UserRoleAssignment,
+		c.BankAccount, c.BankStatement, c.BankStatementProfile, c.BankTransaction,
+		c.BillingCycle, c.CashDrawerCount, c.CashDrawerSession, c.CashDrawerSetting,
+		c.ChartOfAccount, c.CreditOverride, c.Customer, c.CustomerStatement,
+		c.DocumentSequence, c.DunningNotice, c.DunningPause, c.DunningStep,
+		c.EarningsSetting, c.GoodsReceipt, c.GoodsReceiptLine, c.Invoice,
+		c.InvoiceLine, c.InvoicePayment, c.InvoiceSetting, c.LedgerTransaction,
+		c.OutboxEvent, c.PayableSetting, c.PayeePayout, c.PayeeWallet,
+		c.PayeeWalletEntry, c.PaymentIntent, c.PaymentRun, c.PaymentRunItem,
+		c.PaymentTransaction, c.ProvisionPolicy, c.ProvisionRun, c.Reconciliation,
+		c.ReconciliationMatch, c.ReconciliationMatchItem, c.ReconciliationRule,
+		c.RolePermission, c.SettlementBatch, c.SettlementDisbursement,
+		c.SettlementItem, c.SettlementSetting, c.Subscription,
+		c.SubscriptionAdjustment, c.SubscriptionMeter, c.TreasuryPermission,
+		c.TreasuryRole, c.TreasuryUser, c.UsageRecord, c.UserRoleAssignment, c.Vendor,
+		c.VendorBill, c.VendorBillLine, c.Wallet, c.WalletHold, c.WalletTransaction,
+		c.WithholdingCertificate, c.WithholdingRate, c.WriteOff, c.WriteOffRecovery,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -263,134 +595,236 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *BankAccountMutation:
+		return c.BankAccount.mutate(ctx, m)
+	case *BankStatementMutation:
+		return c.BankStatement.mutate(ctx, m)
+	case *BankStatementProfileMutation:
+		return c.BankStatementProfile.mutate(ctx, m)
+	case *BankTransactionMutation:
+		return c.BankTransaction.mutate(ctx, m)
+	case *BillingCycleMutation:
+		return c.BillingCycle.mutate(ctx, m)
+	case *CashDrawerCountMutation:
+		return c.CashDrawerCount.mutate(ctx, m)
+	case *CashDrawerSessionMutation:
+		return c.CashDrawerSession.mutate(ctx, m)
+	case *CashDrawerSettingMutation:
+		return c.CashDrawerSetting.mutate(ctx, m)
 	case *ChartOfAccountMutation:
 		return c.ChartOfAccount.mutate(ctx, m)
+	case *CreditOverrideMutation:
+		return c.CreditOverride.mutate(ctx, m)
+	case *CustomerMutation:
+		return c.Customer.mutate(ctx, m)
+	case *CustomerStatementMutation:
+		return c.CustomerStatement.mutate(ctx, m)
+	case *DocumentSequenceMutation:
+		return c.DocumentSequence.mutate(ctx, m)
+	case *DunningNoticeMutation:
+		return c.DunningNotice.mutate(ctx, m)
+	case *DunningPauseMutation:
+		return c.DunningPause.mutate(ctx, m)
+	case *DunningStepMutation:
+		return c.DunningStep.mutate(ctx, m)
+	case *EarningsSettingMutation:
+		return c.EarningsSetting.mutate(ctx, m)
+	case *GoodsReceiptMutation:
+		return c.GoodsReceipt.mutate(ctx, m)
+	case *GoodsReceiptLineMutation:
+		return c.GoodsReceiptLine.mutate(ctx, m)
 	case *InvoiceMutation:
 		return c.Invoice.mutate(ctx, m)
+	case *InvoiceLineMutation:
+		return c.InvoiceLine.mutate(ctx, m)
+	case *InvoicePaymentMutation:
+		return c.InvoicePayment.mutate(ctx, m)
+	case *InvoiceSettingMutation:
+		return c.InvoiceSetting.mutate(ctx, m)
 	case *LedgerTransactionMutation:
 		return c.LedgerTransaction.mutate(ctx, m)
 	case *OutboxEventMutation:
 		return c.OutboxEvent.mutate(ctx, m)
+	case *PayableSettingMutation:
+		return c.PayableSetting.mutate(ctx, m)
+	case *PayeePayoutMutation:
+		return c.PayeePayout.mutate(ctx, m)
+	case *PayeeWalletMutation:
+		return c.PayeeWallet.mutate(ctx, m)
+	case *PayeeWalletEntryMutation:
+		return c.PayeeWalletEntry.mutate(ctx, m)
 	case *PaymentIntentMutation:
 		return c.PaymentIntent.mutate(ctx, m)
+	case *PaymentRunMutation:
+		return c.PaymentRun.mutate(ctx, m)
+	case *PaymentRunItemMutation:
+		return c.PaymentRunItem.mutate(ctx, m)
 	case *PaymentTransactionMutation:
 		return c.PaymentTransaction.mutate(ctx, m)
+	case *ProvisionPolicyMutation:
+		return c.ProvisionPolicy.mutate(ctx, m)
+	case *ProvisionRunMutation:
+		return c.ProvisionRun.mutate(ctx, m)
+	case *ReconciliationMutation:
+		return c.Reconciliation.mutate(ctx, m)
+	case *ReconciliationMatchMutation:
+		return c.ReconciliationMatch.mutate(ctx, m)
+	case *ReconciliationMatchItemMutation:
+		return c.ReconciliationMatchItem.mutate(ctx, m)
+	case *ReconciliationRuleMutation:
+		return c.ReconciliationRule.mutate(ctx, m)
 	case *RolePermissionMutation:
 		return c.RolePermission.mutate(ctx, m)
+	case *SettlementBatchMutation:
+		return c.SettlementBatch.mutate(ctx, m)
+	case *SettlementDisbursementMutation:
+		return c.SettlementDisbursement.mutate(ctx, m)
+	case *SettlementItemMutation:
+		return c.SettlementItem.mutate(ctx, m)
+	case *SettlementSettingMutation:
+		return c.SettlementSetting.mutate(ctx, m)
+	case *SubscriptionMutation:
+		return c.Subscription.mutate(ctx, m)
+	case *SubscriptionAdjustmentMutation:
+		return c.SubscriptionAdjustment.mutate(ctx, m)
+	case *SubscriptionMeterMutation:
+		return c.SubscriptionMeter.mutate(ctx, m)
 	case *TreasuryPermissionMutation:
 		return c.TreasuryPermission.mutate(ctx, m)
 	case *TreasuryRoleMutation:
 		return c.TreasuryRole.mutate(ctx, m)
 	case *TreasuryUserMutation:
 		return c.TreasuryUser.mutate(ctx, m)
+	case *UsageRecordMutation:
+		return c.UsageRecord.mutate(ctx, m)
 	case *UserRoleAssignmentMutation:
 		return c.UserRoleAssignment.mutate(ctx, m)
+	case *VendorMutation:
+		return c.Vendor.mutate(ctx, m)
+	case *VendorBillMutation:
+		return c.VendorBill.mutate(ctx, m)
+	case *VendorBillLineMutation:
+		return c.VendorBillLine.mutate(ctx, m)
+	case *WalletMutation:
+		return c.Wallet.mutate(ctx, m)
+	case *WalletHoldMutation:
+		return c.WalletHold.mutate(ctx, m)
+	case *WalletTransactionMutation:
+		return c.WalletTransaction.mutate(ctx, m)
+	case *WithholdingCertificateMutation:
+		return c.WithholdingCertificate.mutate(ctx, m)
+	case *WithholdingRateMutation:
+		return c.WithholdingRate.mutate(ctx, m)
+	case *WriteOffMutation:
+		return c.WriteOff.mutate(ctx, m)
+	case *WriteOffRecoveryMutation:
+		return c.WriteOffRecovery.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
-// ChartOfAccountClient is a client for the ChartOfAccount schema.
-type ChartOfAccountClient struct {
+// BankAccountClient is a client for the BankAccount schema.
+type BankAccountClient struct {
 	config
 }
 
-// NewChartOfAccountClient returns a client for the ChartOfAccount from the given config.
-func NewChartOfAccountClient(c config) *ChartOfAccountClient {
-	return &ChartOfAccountClient{config: c}
+// NewBankAccountClient returns a client for the BankAccount from the given config.
+func NewBankAccountClient(c config) *BankAccountClient {
+	return &BankAccountClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `chartofaccount.Hooks(f(g(h())))`.
-func (c *ChartOfAccountClient) Use(hooks ...Hook) {
-	c.hooks.ChartOfAccount = append(c.hooks.ChartOfAccount, hooks...)
+// A call to `Use(f, g, h)` equals to `bankaccount.Hooks(f(g(h())))`.
+func (c *BankAccountClient) Use(hooks ...Hook) {
+	c.hooks.BankAccount = append(c.hooks.BankAccount, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `chartofaccount.Intercept(f(g(h())))`.
-func (c *ChartOfAccountClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ChartOfAccount = append(c.inters.ChartOfAccount, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `bankaccount.Intercept(f(g(h())))`.
+func (c *BankAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BankAccount = append(c.inters.BankAccount, interceptors...)
 }
 
-// Create returns a builder for creating a ChartOfAccount entity.
-func (c *ChartOfAccountClient) Create() *ChartOfAccountCreate {
-	mutation := newChartOfAccountMutation(c.config, OpCreate)
-	return &ChartOfAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BankAccount entity.
+func (c *BankAccountClient) Create() *BankAccountCreate {
+	mutation := newBankAccountMutation(c.config, OpCreate)
+	return &BankAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ChartOfAccount entities.
-func (c *ChartOfAccountClient) CreateBulk(builders ...*ChartOfAccountCreate) *ChartOfAccountCreateBulk {
-	return &ChartOfAccountCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BankAccount entities.
+func (c *BankAccountClient) CreateBulk(builders ...*BankAccountCreate) *BankAccountCreateBulk {
+	return &BankAccountCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ChartOfAccountClient) MapCreateBulk(slice any, setFunc func(*ChartOfAccountCreate, int)) *ChartOfAccountCreateBulk {
+func (c *BankAccountClient) MapCreateBulk(slice any, setFunc func(*BankAccountCreate, int)) *BankAccountCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ChartOfAccountCreateBulk{err: fmt.Errorf("calling to ChartOfAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BankAccountCreateBulk{err: fmt.Errorf("calling to BankAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ChartOfAccountCreate, rv.Len())
+	builders := make([]*BankAccountCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ChartOfAccountCreateBulk{config: c.config, builders: builders}
+	return &BankAccountCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ChartOfAccount.
-func (c *ChartOfAccountClient) Update() *ChartOfAccountUpdate {
-	mutation := newChartOfAccountMutation(c.config, OpUpdate)
-	return &ChartOfAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BankAccount.
+func (c *BankAccountClient) Update() *BankAccountUpdate {
+	mutation := newBankAccountMutation(c.config, OpUpdate)
+	return &BankAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ChartOfAccountClient) UpdateOne(_m *ChartOfAccount) *ChartOfAccountUpdateOne {
-	mutation := newChartOfAccountMutation(c.config, OpUpdateOne, withChartOfAccount(_m))
-	return &ChartOfAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BankAccountClient) UpdateOne(_m *BankAccount) *BankAccountUpdateOne {
+	mutation := newBankAccountMutation(c.config, OpUpdateOne, withBankAccount(_m))
+	return &BankAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ChartOfAccountClient) UpdateOneID(id uuid.UUID) *ChartOfAccountUpdateOne {
-	mutation := newChartOfAccountMutation(c.config, OpUpdateOne, withChartOfAccountID(id))
-	return &ChartOfAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BankAccountClient) UpdateOneID(id uuid.UUID) *BankAccountUpdateOne {
+	mutation := newBankAccountMutation(c.config, OpUpdateOne, withBankAccountID(id))
+	return &BankAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ChartOfAccount.
-func (c *ChartOfAccountClient) Delete() *ChartOfAccountDelete {
-	mutation := newChartOfAccountMutation(c.config, OpDelete)
-	return &ChartOfAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BankAccount.
+func (c *BankAccountClient) Delete() *BankAccountDelete {
+	mutation := newBankAccountMutation(c.config, OpDelete)
+	return &BankAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ChartOfAccountClient) DeleteOne(_m *ChartOfAccount) *ChartOfAccountDeleteOne {
+func (c *BankAccountClient) DeleteOne(_m *BankAccount) *BankAccountDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ChartOfAccountClient) DeleteOneID(id uuid.UUID) *ChartOfAccountDeleteOne {
-	builder := c.Delete().Where(chartofaccount.ID(id))
+func (c *BankAccountClient) DeleteOneID(id uuid.UUID) *BankAccountDeleteOne {
+	builder := c.Delete().Where(bankaccount.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ChartOfAccountDeleteOne{builder}
+	return &BankAccountDeleteOne{builder}
 }
 
-// Query returns a query builder for ChartOfAccount.
-func (c *ChartOfAccountClient) Query() *ChartOfAccountQuery {
-	return &ChartOfAccountQuery{
+// Query returns a query builder for BankAccount.
+func (c *BankAccountClient) Query() *BankAccountQuery {
+	return &BankAccountQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeChartOfAccount},
+		ctx:    &QueryContext{Type: TypeBankAccount},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ChartOfAccount entity by its id.
-func (c *ChartOfAccountClient) Get(ctx context.Context, id uuid.UUID) (*ChartOfAccount, error) {
-	return c.Query().Where(chartofaccount.ID(id)).Only(ctx)
+// Get returns a BankAccount entity by its id.
+func (c *BankAccountClient) Get(ctx context.Context, id uuid.UUID) (*BankAccount, error) {
+	return c.Query().Where(bankaccount.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ChartOfAccountClient) GetX(ctx context.Context, id uuid.UUID) *ChartOfAccount {
+func (c *BankAccountClient) GetX(ctx context.Context, id uuid.UUID) *BankAccount {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -398,164 +832,132 @@ func (c *ChartOfAccountClient) GetX(ctx context.Context, id uuid.UUID) *ChartOfA
 	return obj
 }
 
-// QueryParent queries the parent edge of a ChartOfAccount.
-func (c *ChartOfAccountClient) QueryParent(_m *ChartOfAccount) *ChartOfAccountQuery {
-	query := (&ChartOfAccountClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(chartofaccount.Table, chartofaccount.FieldID, id),
-			sqlgraph.To(chartofaccount.Table, chartofaccount.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, chartofaccount.ParentTable, chartofaccount.ParentColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryChildren queries the children edge of a ChartOfAccount.
-func (c *ChartOfAccountClient) QueryChildren(_m *ChartOfAccount) *ChartOfAccountQuery {
-	query := (&ChartOfAccountClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(chartofaccount.Table, chartofaccount.FieldID, id),
-			sqlgraph.To(chartofaccount.Table, chartofaccount.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, chartofaccount.ChildrenTable, chartofaccount.ChildrenColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *ChartOfAccountClient) Hooks() []Hook {
-	return c.hooks.ChartOfAccount
+func (c *BankAccountClient) Hooks() []Hook {
+	return c.hooks.BankAccount
 }
 
 // Interceptors returns the client interceptors.
-func (c *ChartOfAccountClient) Interceptors() []Interceptor {
-	return c.inters.ChartOfAccount
+func (c *BankAccountClient) Interceptors() []Interceptor {
+	return c.inters.BankAccount
 }
 
-func (c *ChartOfAccountClient) mutate(ctx context.Context, m *ChartOfAccountMutation) (Value, error) {
+func (c *BankAccountClient) mutate(ctx context.Context, m *BankAccountMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ChartOfAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BankAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ChartOfAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BankAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ChartOfAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BankAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ChartOfAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BankAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ChartOfAccount mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BankAccount mutation op: %q", m.Op())
 	}
 }
 
-// InvoiceClient is a client for the Invoice schema.
-type InvoiceClient struct {
+// BankStatementClient is a client for the BankStatement schema.
+type BankStatementClient struct {
 	config
 }
 
-// NewInvoiceClient returns a client for the Invoice from the given config.
-func NewInvoiceClient(c config) *InvoiceClient {
-	return &InvoiceClient{config: c}
+// NewBankStatementClient returns a client for the BankStatement from the given config.
+func NewBankStatementClient(c config) *BankStatementClient {
+	return &BankStatementClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `invoice.Hooks(f(g(h())))`.
-func (c *InvoiceClient) Use(hooks ...Hook) {
-	c.hooks.Invoice = append(c.hooks.Invoice, hooks...)
+// A call to `Use(f, g, h)` equals to `bankstatement.Hooks(f(g(h())))`.
+func (c *BankStatementClient) Use(hooks ...Hook) {
+	c.hooks.BankStatement = append(c.hooks.BankStatement, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `invoice.Intercept(f(g(h())))`.
-func (c *InvoiceClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Invoice = append(c.inters.Invoice, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `bankstatement.Intercept(f(g(h())))`.
+func (c *BankStatementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BankStatement = append(c.inters.BankStatement, interceptors...)
 }
 
-// Create returns a builder for creating a Invoice entity.
-func (c *InvoiceClient) Create() *InvoiceCreate {
-	mutation := newInvoiceMutation(c.config, OpCreate)
-	return &InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BankStatement entity.
+func (c *BankStatementClient) Create() *BankStatementCreate {
+	mutation := newBankStatementMutation(c.config, OpCreate)
+	return &BankStatementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Invoice entities.
-func (c *InvoiceClient) CreateBulk(builders ...*InvoiceCreate) *InvoiceCreateBulk {
-	return &InvoiceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BankStatement entities.
+func (c *BankStatementClient) CreateBulk(builders ...*BankStatementCreate) *BankStatementCreateBulk {
+	return &BankStatementCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *InvoiceClient) MapCreateBulk(slice any, setFunc func(*InvoiceCreate, int)) *InvoiceCreateBulk {
+func (c *BankStatementClient) MapCreateBulk(slice any, setFunc func(*BankStatementCreate, int)) *BankStatementCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &InvoiceCreateBulk{err: fmt.Errorf("calling to InvoiceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BankStatementCreateBulk{err: fmt.Errorf("calling to BankStatementClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*InvoiceCreate, rv.Len())
+	builders := make([]*BankStatementCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &InvoiceCreateBulk{config: c.config, builders: builders}
+	return &BankStatementCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Invoice.
-func (c *InvoiceClient) Update() *InvoiceUpdate {
-	mutation := newInvoiceMutation(c.config, OpUpdate)
-	return &InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BankStatement.
+func (c *BankStatementClient) Update() *BankStatementUpdate {
+	mutation := newBankStatementMutation(c.config, OpUpdate)
+	return &BankStatementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InvoiceClient) UpdateOne(_m *Invoice) *InvoiceUpdateOne {
-	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoice(_m))
-	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BankStatementClient) UpdateOne(_m *BankStatement) *BankStatementUpdateOne {
+	mutation := newBankStatementMutation(c.config, OpUpdateOne, withBankStatement(_m))
+	return &BankStatementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InvoiceClient) UpdateOneID(id uuid.UUID) *InvoiceUpdateOne {
-	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoiceID(id))
-	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BankStatementClient) UpdateOneID(id uuid.UUID) *BankStatementUpdateOne {
+	mutation := newBankStatementMutation(c.config, OpUpdateOne, withBankStatementID(id))
+	return &BankStatementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Invoice.
-func (c *InvoiceClient) Delete() *InvoiceDelete {
-	mutation := newInvoiceMutation(c.config, OpDelete)
-	return &InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BankStatement.
+func (c *BankStatementClient) Delete() *BankStatementDelete {
+	mutation := newBankStatementMutation(c.config, OpDelete)
+	return &BankStatementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InvoiceClient) DeleteOne(_m *Invoice) *InvoiceDeleteOne {
+func (c *BankStatementClient) DeleteOne(_m *BankStatement) *BankStatementDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *InvoiceClient) DeleteOneID(id uuid.UUID) *InvoiceDeleteOne {
-	builder := c.Delete().Where(invoice.ID(id))
+func (c *BankStatementClient) DeleteOneID(id uuid.UUID) *BankStatementDeleteOne {
+	builder := c.Delete().Where(bankstatement.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InvoiceDeleteOne{builder}
+	return &BankStatementDeleteOne{builder}
 }
 
-// Query returns a query builder for Invoice.
-func (c *InvoiceClient) Query() *InvoiceQuery {
-	return &InvoiceQuery{
+// Query returns a query builder for BankStatement.
+func (c *BankStatementClient) Query() *BankStatementQuery {
+	return &BankStatementQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeInvoice},
+		ctx:    &QueryContext{Type: TypeBankStatement},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Invoice entity by its id.
-func (c *InvoiceClient) Get(ctx context.Context, id uuid.UUID) (*Invoice, error) {
-	return c.Query().Where(invoice.ID(id)).Only(ctx)
+// Get returns a BankStatement entity by its id.
+func (c *BankStatementClient) Get(ctx context.Context, id uuid.UUID) (*BankStatement, error) {
+	return c.Query().Where(bankstatement.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InvoiceClient) GetX(ctx context.Context, id uuid.UUID) *Invoice {
+func (c *BankStatementClient) GetX(ctx context.Context, id uuid.UUID) *BankStatement {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -563,132 +965,148 @@ func (c *InvoiceClient) GetX(ctx context.Context, id uuid.UUID) *Invoice {
 	return obj
 }
 
+// QueryTransactions queries the transactions edge of a BankStatement.
+func (c *BankStatementClient) QueryTransactions(_m *BankStatement) *BankTransactionQuery {
+	query := (&BankTransactionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bankstatement.Table, bankstatement.FieldID, id),
+			sqlgraph.To(banktransaction.Table, banktransaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, bankstatement.TransactionsTable, bankstatement.TransactionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *InvoiceClient) Hooks() []Hook {
-	return c.hooks.Invoice
+func (c *BankStatementClient) Hooks() []Hook {
+	return c.hooks.BankStatement
 }
 
 // Interceptors returns the client interceptors.
-func (c *InvoiceClient) Interceptors() []Interceptor {
-	return c.inters.Invoice
+func (c *BankStatementClient) Interceptors() []Interceptor {
+	return c.inters.BankStatement
 }
 
-func (c *InvoiceClient) mutate(ctx context.Context, m *InvoiceMutation) (Value, error) {
+func (c *BankStatementClient) mutate(ctx context.Context, m *BankStatementMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BankStatementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BankStatementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BankStatementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BankStatementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Invoice mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BankStatement mutation op: %q", m.Op())
 	}
 }
 
-// LedgerTransactionClient is a client for the LedgerTransaction schema.
-type LedgerTransactionClient struct {
+// BankStatementProfileClient is a client for the BankStatementProfile schema.
+type BankStatementProfileClient struct {
 	config
 }
 
-// NewLedgerTransactionClient returns a client for the LedgerTransaction from the given config.
-func NewLedgerTransactionClient(c config) *LedgerTransactionClient {
-	return &LedgerTransactionClient{config: c}
+// NewBankStatementProfileClient returns a client for the BankStatementProfile from the given config.
+func NewBankStatementProfileClient(c config) *BankStatementProfileClient {
+	return &BankStatementProfileClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `ledgertransaction.Hooks(f(g(h())))`.
-func (c *LedgerTransactionClient) Use(hooks ...Hook) {
-	c.hooks.LedgerTransaction = append(c.hooks.LedgerTransaction, hooks...)
+// A call to `Use(f, g, h)` equals to `bankstatementprofile.Hooks(f(g(h())))`.
+func (c *BankStatementProfileClient) Use(hooks ...Hook) {
+	c.hooks.BankStatementProfile = append(c.hooks.BankStatementProfile, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `ledgertransaction.Intercept(f(g(h())))`.
-func (c *LedgerTransactionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LedgerTransaction = append(c.inters.LedgerTransaction, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `bankstatementprofile.Intercept(f(g(h())))`.
+func (c *BankStatementProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BankStatementProfile = append(c.inters.BankStatementProfile, interceptors...)
 }
 
-// Create returns a builder for creating a LedgerTransaction entity.
-func (c *LedgerTransactionClient) Create() *LedgerTransactionCreate {
-	mutation := newLedgerTransactionMutation(c.config, OpCreate)
-	return &LedgerTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BankStatementProfile entity.
+func (c *BankStatementProfileClient) Create() *BankStatementProfileCreate {
+	mutation := newBankStatementProfileMutation(c.config, OpCreate)
+	return &BankStatementProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LedgerTransaction entities.
-func (c *LedgerTransactionClient) CreateBulk(builders ...*LedgerTransactionCreate) *LedgerTransactionCreateBulk {
-	return &LedgerTransactionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BankStatementProfile entities.
+func (c *BankStatementProfileClient) CreateBulk(builders ...*BankStatementProfileCreate) *BankStatementProfileCreateBulk {
+	return &BankStatementProfileCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LedgerTransactionClient) MapCreateBulk(slice any, setFunc func(*LedgerTransactionCreate, int)) *LedgerTransactionCreateBulk {
+func (c *BankStatementProfileClient) MapCreateBulk(slice any, setFunc func(*BankStatementProfileCreate, int)) *BankStatementProfileCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LedgerTransactionCreateBulk{err: fmt.Errorf("calling to LedgerTransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BankStatementProfileCreateBulk{err: fmt.Errorf("calling to BankStatementProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LedgerTransactionCreate, rv.Len())
+	builders := make([]*BankStatementProfileCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LedgerTransactionCreateBulk{config: c.config, builders: builders}
+	return &BankStatementProfileCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LedgerTransaction.
-func (c *LedgerTransactionClient) Update() *LedgerTransactionUpdate {
-	mutation := newLedgerTransactionMutation(c.config, OpUpdate)
-	return &LedgerTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BankStatementProfile.
+func (c *BankStatementProfileClient) Update() *BankStatementProfileUpdate {
+	mutation := newBankStatementProfileMutation(c.config, OpUpdate)
+	return &BankStatementProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LedgerTransactionClient) UpdateOne(_m *LedgerTransaction) *LedgerTransactionUpdateOne {
-	mutation := newLedgerTransactionMutation(c.config, OpUpdateOne, withLedgerTransaction(_m))
-	return &LedgerTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BankStatementProfileClient) UpdateOne(_m *BankStatementProfile) *BankStatementProfileUpdateOne {
+	mutation := newBankStatementProfileMutation(c.config, OpUpdateOne, withBankStatementProfile(_m))
+	return &BankStatementProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LedgerTransactionClient) UpdateOneID(id uuid.UUID) *LedgerTransactionUpdateOne {
-	mutation := newLedgerTransactionMutation(c.config, OpUpdateOne, withLedgerTransactionID(id))
-	return &LedgerTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BankStatementProfileClient) UpdateOneID(id uuid.UUID) *BankStatementProfileUpdateOne {
+	mutation := newBankStatementProfileMutation(c.config, OpUpdateOne, withBankStatementProfileID(id))
+	return &BankStatementProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LedgerTransaction.
-func (c *LedgerTransactionClient) Delete() *LedgerTransactionDelete {
-	mutation := newLedgerTransactionMutation(c.config, OpDelete)
-	return &LedgerTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BankStatementProfile.
+func (c *BankStatementProfileClient) Delete() *BankStatementProfileDelete {
+	mutation := newBankStatementProfileMutation(c.config, OpDelete)
+	return &BankStatementProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LedgerTransactionClient) DeleteOne(_m *LedgerTransaction) *LedgerTransactionDeleteOne {
+func (c *BankStatementProfileClient) DeleteOne(_m *BankStatementProfile) *BankStatementProfileDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LedgerTransactionClient) DeleteOneID(id uuid.UUID) *LedgerTransactionDeleteOne {
-	builder := c.Delete().Where(ledgertransaction.ID(id))
+func (c *BankStatementProfileClient) DeleteOneID(id uuid.UUID) *BankStatementProfileDeleteOne {
+	builder := c.Delete().Where(bankstatementprofile.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LedgerTransactionDeleteOne{builder}
+	return &BankStatementProfileDeleteOne{builder}
 }
 
-// Query returns a query builder for LedgerTransaction.
-func (c *LedgerTransactionClient) Query() *LedgerTransactionQuery {
-	return &LedgerTransactionQuery{
+// Query returns a query builder for BankStatementProfile.
+func (c *BankStatementProfileClient) Query() *BankStatementProfileQuery {
+	return &BankStatementProfileQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLedgerTransaction},
+		ctx:    &QueryContext{Type: TypeBankStatementProfile},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LedgerTransaction entity by its id.
-func (c *LedgerTransactionClient) Get(ctx context.Context, id uuid.UUID) (*LedgerTransaction, error) {
-	return c.Query().Where(ledgertransaction.ID(id)).Only(ctx)
+// Get returns a BankStatementProfile entity by its id.
+func (c *BankStatementProfileClient) Get(ctx context.Context, id uuid.UUID) (*BankStatementProfile, error) {
+	return c.Query().Where(bankstatementprofile.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LedgerTransactionClient) GetX(ctx context.Context, id uuid.UUID) *LedgerTransaction {
+func (c *BankStatementProfileClient) GetX(ctx context.Context, id uuid.UUID) *BankStatementProfile {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -696,148 +1114,132 @@ func (c *LedgerTransactionClient) GetX(ctx context.Context, id uuid.UUID) *Ledge
 	return obj
 }
 
-// QueryAccount queries the account edge of a LedgerTransaction.
-func (c *LedgerTransactionClient) QueryAccount(_m *LedgerTransaction) *ChartOfAccountQuery {
-	query := (&ChartOfAccountClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(ledgertransaction.Table, ledgertransaction.FieldID, id),
-			sqlgraph.To(chartofaccount.Table, chartofaccount.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, ledgertransaction.AccountTable, ledgertransaction.AccountColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *LedgerTransactionClient) Hooks() []Hook {
-	return c.hooks.LedgerTransaction
+func (c *BankStatementProfileClient) Hooks() []Hook {
+	return c.hooks.BankStatementProfile
 }
 
 // Interceptors returns the client interceptors.
-func (c *LedgerTransactionClient) Interceptors() []Interceptor {
-	return c.inters.LedgerTransaction
+func (c *BankStatementProfileClient) Interceptors() []Interceptor {
+	return c.inters.BankStatementProfile
 }
 
-func (c *LedgerTransactionClient) mutate(ctx context.Context, m *LedgerTransactionMutation) (Value, error) {
+func (c *BankStatementProfileClient) mutate(ctx context.Context, m *BankStatementProfileMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LedgerTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BankStatementProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LedgerTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BankStatementProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LedgerTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BankStatementProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LedgerTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BankStatementProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LedgerTransaction mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BankStatementProfile mutation op: %q", m.Op())
 	}
 }
 
-// OutboxEventClient is a client for the OutboxEvent schema.
-type OutboxEventClient struct {
+// BankTransactionClient is a client for the BankTransaction schema.
+type BankTransactionClient struct {
 	config
 }
 
-// NewOutboxEventClient returns a client for the OutboxEvent from the given config.
-func NewOutboxEventClient(c config) *OutboxEventClient {
-	return &OutboxEventClient{config: c}
+// NewBankTransactionClient returns a client for the BankTransaction from the given config.
+func NewBankTransactionClient(c config) *BankTransactionClient {
+	return &BankTransactionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `outboxevent.Hooks(f(g(h())))`.
-func (c *OutboxEventClient) Use(hooks ...Hook) {
-	c.hooks.OutboxEvent = append(c.hooks.OutboxEvent, hooks...)
+// A call to `Use(f, g, h)` equals to `banktransaction.Hooks(f(g(h())))`.
+func (c *BankTransactionClient) Use(hooks ...Hook) {
+	c.hooks.BankTransaction = append(c.hooks.BankTransaction, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `outboxevent.Intercept(f(g(h())))`.
-func (c *OutboxEventClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OutboxEvent = append(c.inters.OutboxEvent, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `banktransaction.Intercept(f(g(h())))`.
+func (c *BankTransactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BankTransaction = append(c.inters.BankTransaction, interceptors...)
 }
 
-// Create returns a builder for creating a OutboxEvent entity.
-func (c *OutboxEventClient) Create() *OutboxEventCreate {
-	mutation := newOutboxEventMutation(c.config, OpCreate)
-	return &OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BankTransaction entity.
+func (c *BankTransactionClient) Create() *BankTransactionCreate {
+	mutation := newBankTransactionMutation(c.config, OpCreate)
+	return &BankTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OutboxEvent entities.
-func (c *OutboxEventClient) CreateBulk(builders ...*OutboxEventCreate) *OutboxEventCreateBulk {
-	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BankTransaction entities.
+func (c *BankTransactionClient) CreateBulk(builders ...*BankTransactionCreate) *BankTransactionCreateBulk {
+	return &BankTransactionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OutboxEventClient) MapCreateBulk(slice any, setFunc func(*OutboxEventCreate, int)) *OutboxEventCreateBulk {
+func (c *BankTransactionClient) MapCreateBulk(slice any, setFunc func(*BankTransactionCreate, int)) *BankTransactionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OutboxEventCreateBulk{err: fmt.Errorf("calling to OutboxEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BankTransactionCreateBulk{err: fmt.Errorf("calling to BankTransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OutboxEventCreate, rv.Len())
+	builders := make([]*BankTransactionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+	return &BankTransactionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OutboxEvent.
-func (c *OutboxEventClient) Update() *OutboxEventUpdate {
-	mutation := newOutboxEventMutation(c.config, OpUpdate)
-	return &OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BankTransaction.
+func (c *BankTransactionClient) Update() *BankTransactionUpdate {
+	mutation := newBankTransactionMutation(c.config, OpUpdate)
+	return &BankTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OutboxEventClient) UpdateOne(_m *OutboxEvent) *OutboxEventUpdateOne {
-	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEvent(_m))
-	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BankTransactionClient) UpdateOne(_m *BankTransaction) *BankTransactionUpdateOne {
+	mutation := newBankTransactionMutation(c.config, OpUpdateOne, withBankTransaction(_m))
+	return &BankTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OutboxEventClient) UpdateOneID(id uuid.UUID) *OutboxEventUpdateOne {
-	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEventID(id))
-	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BankTransactionClient) UpdateOneID(id uuid.UUID) *BankTransactionUpdateOne {
+	mutation := newBankTransactionMutation(c.config, OpUpdateOne, withBankTransactionID(id))
+	return &BankTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OutboxEvent.
-func (c *OutboxEventClient) Delete() *OutboxEventDelete {
-	mutation := newOutboxEventMutation(c.config, OpDelete)
-	return &OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BankTransaction.
+func (c *BankTransactionClient) Delete() *BankTransactionDelete {
+	mutation := newBankTransactionMutation(c.config, OpDelete)
+	return &BankTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OutboxEventClient) DeleteOne(_m *OutboxEvent) *OutboxEventDeleteOne {
+func (c *BankTransactionClient) DeleteOne(_m *BankTransaction) *BankTransactionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OutboxEventClient) DeleteOneID(id uuid.UUID) *OutboxEventDeleteOne {
-	builder := c.Delete().Where(outboxevent.ID(id))
+func (c *BankTransactionClient) DeleteOneID(id uuid.UUID) *BankTransactionDeleteOne {
+	builder := c.Delete().Where(banktransaction.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OutboxEventDeleteOne{builder}
+	return &BankTransactionDeleteOne{builder}
 }
 
-// Query returns a query builder for OutboxEvent.
-func (c *OutboxEventClient) Query() *OutboxEventQuery {
-	return &OutboxEventQuery{
+// Query returns a query builder for BankTransaction.
+func (c *BankTransactionClient) Query() *BankTransactionQuery {
+	return &BankTransactionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOutboxEvent},
+		ctx:    &QueryContext{Type: TypeBankTransaction},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OutboxEvent entity by its id.
-func (c *OutboxEventClient) Get(ctx context.Context, id uuid.UUID) (*OutboxEvent, error) {
-	return c.Query().Where(outboxevent.ID(id)).Only(ctx)
+// Get returns a BankTransaction entity by its id.
+func (c *BankTransactionClient) Get(ctx context.Context, id uuid.UUID) (*BankTransaction, error) {
+	return c.Query().Where(banktransaction.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OutboxEventClient) GetX(ctx context.Context, id uuid.UUID) *OutboxEvent {
+func (c *BankTransactionClient) GetX(ctx context.Context, id uuid.UUID) *BankTransaction {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -845,132 +1247,148 @@ func (c *OutboxEventClient) GetX(ctx context.Context, id uuid.UUID) *OutboxEvent
 	return obj
 }
 
+// QueryStatement queries the statement edge of a BankTransaction.
+func (c *BankTransactionClient) QueryStatement(_m *BankTransaction) *BankStatementQuery {
+	query := (&BankStatementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(banktransaction.Table, banktransaction.FieldID, id),
+			sqlgraph.To(bankstatement.Table, bankstatement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, banktransaction.StatementTable, banktransaction.StatementColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *OutboxEventClient) Hooks() []Hook {
-	return c.hooks.OutboxEvent
+func (c *BankTransactionClient) Hooks() []Hook {
+	return c.hooks.BankTransaction
 }
 
 // Interceptors returns the client interceptors.
-func (c *OutboxEventClient) Interceptors() []Interceptor {
-	return c.inters.OutboxEvent
+func (c *BankTransactionClient) Interceptors() []Interceptor {
+	return c.inters.BankTransaction
 }
 
-func (c *OutboxEventClient) mutate(ctx context.Context, m *OutboxEventMutation) (Value, error) {
+func (c *BankTransactionClient) mutate(ctx context.Context, m *BankTransactionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BankTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BankTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BankTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BankTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown OutboxEvent mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BankTransaction mutation op: %q", m.Op())
 	}
 }
 
-// PaymentIntentClient is a client for the PaymentIntent schema.
-type PaymentIntentClient struct {
+// BillingCycleClient is a client for the BillingCycle schema.
+type BillingCycleClient struct {
 	config
 }
 
-// NewPaymentIntentClient returns a client for the PaymentIntent from the given config.
-func NewPaymentIntentClient(c config) *PaymentIntentClient {
-	return &PaymentIntentClient{config: c}
+// NewBillingCycleClient returns a client for the BillingCycle from the given config.
+func NewBillingCycleClient(c config) *BillingCycleClient {
+	return &BillingCycleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `paymentintent.Hooks(f(g(h())))`.
-func (c *PaymentIntentClient) Use(hooks ...Hook) {
-	c.hooks.PaymentIntent = append(c.hooks.PaymentIntent, hooks...)
+// A call to `Use(f, g, h)` equals to `billingcycle.Hooks(f(g(h())))`.
+func (c *BillingCycleClient) Use(hooks ...Hook) {
+	c.hooks.BillingCycle = append(c.hooks.BillingCycle, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `paymentintent.Intercept(f(g(h())))`.
-func (c *PaymentIntentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PaymentIntent = append(c.inters.PaymentIntent, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `billingcycle.Intercept(f(g(h())))`.
+func (c *BillingCycleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BillingCycle = append(c.inters.BillingCycle, interceptors...)
 }
 
-// Create returns a builder for creating a PaymentIntent entity.
-func (c *PaymentIntentClient) Create() *PaymentIntentCreate {
-	mutation := newPaymentIntentMutation(c.config, OpCreate)
-	return &PaymentIntentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BillingCycle entity.
+func (c *BillingCycleClient) Create() *BillingCycleCreate {
+	mutation := newBillingCycleMutation(c.config, OpCreate)
+	return &BillingCycleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PaymentIntent entities.
-func (c *PaymentIntentClient) CreateBulk(builders ...*PaymentIntentCreate) *PaymentIntentCreateBulk {
-	return &PaymentIntentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BillingCycle entities.
+func (c *BillingCycleClient) CreateBulk(builders ...*BillingCycleCreate) *BillingCycleCreateBulk {
+	return &BillingCycleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PaymentIntentClient) MapCreateBulk(slice any, setFunc func(*PaymentIntentCreate, int)) *PaymentIntentCreateBulk {
+func (c *BillingCycleClient) MapCreateBulk(slice any, setFunc func(*BillingCycleCreate, int)) *BillingCycleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PaymentIntentCreateBulk{err: fmt.Errorf("calling to PaymentIntentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BillingCycleCreateBulk{err: fmt.Errorf("calling to BillingCycleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PaymentIntentCreate, rv.Len())
+	builders := make([]*BillingCycleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PaymentIntentCreateBulk{config: c.config, builders: builders}
+	return &BillingCycleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PaymentIntent.
-func (c *PaymentIntentClient) Update() *PaymentIntentUpdate {
-	mutation := newPaymentIntentMutation(c.config, OpUpdate)
-	return &PaymentIntentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BillingCycle.
+func (c *BillingCycleClient) Update() *BillingCycleUpdate {
+	mutation := newBillingCycleMutation(c.config, OpUpdate)
+	return &BillingCycleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PaymentIntentClient) UpdateOne(_m *PaymentIntent) *PaymentIntentUpdateOne {
-	mutation := newPaymentIntentMutation(c.config, OpUpdateOne, withPaymentIntent(_m))
-	return &PaymentIntentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BillingCycleClient) UpdateOne(_m *BillingCycle) *BillingCycleUpdateOne {
+	mutation := newBillingCycleMutation(c.config, OpUpdateOne, withBillingCycle(_m))
+	return &BillingCycleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PaymentIntentClient) UpdateOneID(id uuid.UUID) *PaymentIntentUpdateOne {
-	mutation := newPaymentIntentMutation(c.config, OpUpdateOne, withPaymentIntentID(id))
-	return &PaymentIntentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BillingCycleClient) UpdateOneID(id uuid.UUID) *BillingCycleUpdateOne {
+	mutation := newBillingCycleMutation(c.config, OpUpdateOne, withBillingCycleID(id))
+	return &BillingCycleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PaymentIntent.
-func (c *PaymentIntentClient) Delete() *PaymentIntentDelete {
-	mutation := newPaymentIntentMutation(c.config, OpDelete)
-	return &PaymentIntentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BillingCycle.
+func (c *BillingCycleClient) Delete() *BillingCycleDelete {
+	mutation := newBillingCycleMutation(c.config, OpDelete)
+	return &BillingCycleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PaymentIntentClient) DeleteOne(_m *PaymentIntent) *PaymentIntentDeleteOne {
+func (c *BillingCycleClient) DeleteOne(_m *BillingCycle) *BillingCycleDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PaymentIntentClient) DeleteOneID(id uuid.UUID) *PaymentIntentDeleteOne {
-	builder := c.Delete().Where(paymentintent.ID(id))
+func (c *BillingCycleClient) DeleteOneID(id uuid.UUID) *BillingCycleDeleteOne {
+	builder := c.Delete().Where(billingcycle.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PaymentIntentDeleteOne{builder}
+	return &BillingCycleDeleteOne{builder}
 }
 
-// Query returns a query builder for PaymentIntent.
-func (c *PaymentIntentClient) Query() *PaymentIntentQuery {
-	return &PaymentIntentQuery{
+// Query returns a query builder for BillingCycle.
+func (c *BillingCycleClient) Query() *BillingCycleQuery {
+	return &BillingCycleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePaymentIntent},
+		ctx:    &QueryContext{Type: TypeBillingCycle},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PaymentIntent entity by its id.
-func (c *PaymentIntentClient) Get(ctx context.Context, id uuid.UUID) (*PaymentIntent, error) {
-	return c.Query().Where(paymentintent.ID(id)).Only(ctx)
+// Get returns a BillingCycle entity by its id.
+func (c *BillingCycleClient) Get(ctx context.Context, id uuid.UUID) (*BillingCycle, error) {
+	return c.Query().Where(billingcycle.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PaymentIntentClient) GetX(ctx context.Context, id uuid.UUID) *PaymentIntent {
+func (c *BillingCycleClient) GetX(ctx context.Context, id uuid.UUID) *BillingCycle {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -978,132 +1396,148 @@ func (c *PaymentIntentClient) GetX(ctx context.Context, id uuid.UUID) *PaymentIn
 	return obj
 }
 
+// QuerySubscription queries the subscription edge of a BillingCycle.
+func (c *BillingCycleClient) QuerySubscription(_m *BillingCycle) *SubscriptionQuery {
+	query := (&SubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(billingcycle.Table, billingcycle.FieldID, id),
+			sqlgraph.To(subscription.Table, subscription.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, billingcycle.SubscriptionTable, billingcycle.SubscriptionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *PaymentIntentClient) Hooks() []Hook {
-	return c.hooks.PaymentIntent
+func (c *BillingCycleClient) Hooks() []Hook {
+	return c.hooks.BillingCycle
 }
 
 // Interceptors returns the client interceptors.
-func (c *PaymentIntentClient) Interceptors() []Interceptor {
-	return c.inters.PaymentIntent
+func (c *BillingCycleClient) Interceptors() []Interceptor {
+	return c.inters.BillingCycle
 }
 
-func (c *PaymentIntentClient) mutate(ctx context.Context, m *PaymentIntentMutation) (Value, error) {
+func (c *BillingCycleClient) mutate(ctx context.Context, m *BillingCycleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PaymentIntentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BillingCycleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PaymentIntentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BillingCycleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PaymentIntentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BillingCycleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PaymentIntentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BillingCycleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PaymentIntent mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BillingCycle mutation op: %q", m.Op())
 	}
 }
 
-// PaymentTransactionClient is a client for the PaymentTransaction schema.
-type PaymentTransactionClient struct {
+// CashDrawerCountClient is a client for the CashDrawerCount schema.
+type CashDrawerCountClient struct {
 	config
 }
 
-// NewPaymentTransactionClient returns a client for the PaymentTransaction from the given config.
-func NewPaymentTransactionClient(c config) *PaymentTransactionClient {
-	return &PaymentTransactionClient{config: c}
+// NewCashDrawerCountClient returns a client for the CashDrawerCount from the given config.
+func NewCashDrawerCountClient(c config) *CashDrawerCountClient {
+	return &CashDrawerCountClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `paymenttransaction.Hooks(f(g(h())))`.
-func (c *PaymentTransactionClient) Use(hooks ...Hook) {
-	c.hooks.PaymentTransaction = append(c.hooks.PaymentTransaction, hooks...)
+// A call to `Use(f, g, h)` equals to `cashdrawercount.Hooks(f(g(h())))`.
+func (c *CashDrawerCountClient) Use(hooks ...Hook) {
+	c.hooks.CashDrawerCount = append(c.hooks.CashDrawerCount, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `paymenttransaction.Intercept(f(g(h())))`.
-func (c *PaymentTransactionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PaymentTransaction = append(c.inters.PaymentTransaction, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `cashdrawercount.Intercept(f(g(h())))`.
+func (c *CashDrawerCountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CashDrawerCount = append(c.inters.CashDrawerCount, interceptors...)
 }
 
-// Create returns a builder for creating a PaymentTransaction entity.
-func (c *PaymentTransactionClient) Create() *PaymentTransactionCreate {
-	mutation := newPaymentTransactionMutation(c.config, OpCreate)
-	return &PaymentTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CashDrawerCount entity.
+func (c *CashDrawerCountClient) Create() *CashDrawerCountCreate {
+	mutation := newCashDrawerCountMutation(c.config, OpCreate)
+	return &CashDrawerCountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PaymentTransaction entities.
-func (c *PaymentTransactionClient) CreateBulk(builders ...*PaymentTransactionCreate) *PaymentTransactionCreateBulk {
-	return &PaymentTransactionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CashDrawerCount entities.
+func (c *CashDrawerCountClient) CreateBulk(builders ...*CashDrawerCountCreate) *CashDrawerCountCreateBulk {
+	return &CashDrawerCountCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PaymentTransactionClient) MapCreateBulk(slice any, setFunc func(*PaymentTransactionCreate, int)) *PaymentTransactionCreateBulk {
+func (c *CashDrawerCountClient) MapCreateBulk(slice any, setFunc func(*CashDrawerCountCreate, int)) *CashDrawerCountCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PaymentTransactionCreateBulk{err: fmt.Errorf("calling to PaymentTransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CashDrawerCountCreateBulk{err: fmt.Errorf("calling to CashDrawerCountClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PaymentTransactionCreate, rv.Len())
+	builders := make([]*CashDrawerCountCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PaymentTransactionCreateBulk{config: c.config, builders: builders}
+	return &CashDrawerCountCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PaymentTransaction.
-func (c *PaymentTransactionClient) Update() *PaymentTransactionUpdate {
-	mutation := newPaymentTransactionMutation(c.config, OpUpdate)
-	return &PaymentTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CashDrawerCount.
+func (c *CashDrawerCountClient) Update() *CashDrawerCountUpdate {
+	mutation := newCashDrawerCountMutation(c.config, OpUpdate)
+	return &CashDrawerCountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PaymentTransactionClient) UpdateOne(_m *PaymentTransaction) *PaymentTransactionUpdateOne {
-	mutation := newPaymentTransactionMutation(c.config, OpUpdateOne, withPaymentTransaction(_m))
-	return &PaymentTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CashDrawerCountClient) UpdateOne(_m *CashDrawerCount) *CashDrawerCountUpdateOne {
+	mutation := newCashDrawerCountMutation(c.config, OpUpdateOne, withCashDrawerCount(_m))
+	return &CashDrawerCountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PaymentTransactionClient) UpdateOneID(id uuid.UUID) *PaymentTransactionUpdateOne {
-	mutation := newPaymentTransactionMutation(c.config, OpUpdateOne, withPaymentTransactionID(id))
-	return &PaymentTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CashDrawerCountClient) UpdateOneID(id uuid.UUID) *CashDrawerCountUpdateOne {
+	mutation := newCashDrawerCountMutation(c.config, OpUpdateOne, withCashDrawerCountID(id))
+	return &CashDrawerCountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PaymentTransaction.
-func (c *PaymentTransactionClient) Delete() *PaymentTransactionDelete {
-	mutation := newPaymentTransactionMutation(c.config, OpDelete)
-	return &PaymentTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CashDrawerCount.
+func (c *CashDrawerCountClient) Delete() *CashDrawerCountDelete {
+	mutation := newCashDrawerCountMutation(c.config, OpDelete)
+	return &CashDrawerCountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PaymentTransactionClient) DeleteOne(_m *PaymentTransaction) *PaymentTransactionDeleteOne {
+func (c *CashDrawerCountClient) DeleteOne(_m *CashDrawerCount) *CashDrawerCountDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PaymentTransactionClient) DeleteOneID(id uuid.UUID) *PaymentTransactionDeleteOne {
-	builder := c.Delete().Where(paymenttransaction.ID(id))
+func (c *CashDrawerCountClient) DeleteOneID(id uuid.UUID) *CashDrawerCountDeleteOne {
+	builder := c.Delete().Where(cashdrawercount.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PaymentTransactionDeleteOne{builder}
+	return &CashDrawerCountDeleteOne{builder}
 }
 
-// Query returns a query builder for PaymentTransaction.
-func (c *PaymentTransactionClient) Query() *PaymentTransactionQuery {
-	return &PaymentTransactionQuery{
+// Query returns a query builder for CashDrawerCount.
+func (c *CashDrawerCountClient) Query() *CashDrawerCountQuery {
+	return &CashDrawerCountQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePaymentTransaction},
+		ctx:    &QueryContext{Type: TypeCashDrawerCount},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PaymentTransaction entity by its id.
-func (c *PaymentTransactionClient) Get(ctx context.Context, id uuid.UUID) (*PaymentTransaction, error) {
-	return c.Query().Where(paymenttransaction.ID(id)).Only(ctx)
+// Get returns a CashDrawerCount entity by its id.
+func (c *CashDrawerCountClient) Get(ctx context.Context, id uuid.UUID) (*CashDrawerCount, error) {
+	return c.Query().Where(cashdrawercount.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PaymentTransactionClient) GetX(ctx context.Context, id uuid.UUID) *PaymentTransaction {
+func (c *CashDrawerCountClient) GetX(ctx context.Context, id uuid.UUID) *CashDrawerCount {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1112,131 +1546,131 @@ func (c *PaymentTransactionClient) GetX(ctx context.Context, id uuid.UUID) *Paym
 }
 
 // Hooks returns the client hooks.
-func (c *PaymentTransactionClient) Hooks() []Hook {
-	return c.hooks.PaymentTransaction
+func (c *CashDrawerCountClient) Hooks() []Hook {
+	return c.hooks.CashDrawerCount
 }
 
 // Interceptors returns the client interceptors.
-func (c *PaymentTransactionClient) Interceptors() []Interceptor {
-	return c.inters.PaymentTransaction
+func (c *CashDrawerCountClient) Interceptors() []Interceptor {
+	return c.inters.CashDrawerCount
 }
 
-func (c *PaymentTransactionClient) mutate(ctx context.Context, m *PaymentTransactionMutation) (Value, error) {
+func (c *CashDrawerCountClient) mutate(ctx context.Context, m *CashDrawerCountMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PaymentTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CashDrawerCountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PaymentTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CashDrawerCountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PaymentTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CashDrawerCountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PaymentTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CashDrawerCountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PaymentTransaction mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CashDrawerCount mutation op: %q", m.Op())
 	}
 }
 
-// RolePermissionClient is a client for the RolePermission schema.
-type RolePermissionClient struct {
+// CashDrawerSessionClient is a client for the CashDrawerSession schema.
+type CashDrawerSessionClient struct {
 	config
 }
 
-// NewRolePermissionClient returns a client for the RolePermission from the given config.
-func NewRolePermissionClient(c config) *RolePermissionClient {
-	return &RolePermissionClient{config: c}
+// NewCashDrawerSessionClient returns a client for the CashDrawerSession from the given config.
+func NewCashDrawerSessionClient(c config) *CashDrawerSessionClient {
+	return &CashDrawerSessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `rolepermission.Hooks(f(g(h())))`.
-func (c *RolePermissionClient) Use(hooks ...Hook) {
-	c.hooks.RolePermission = append(c.hooks.RolePermission, hooks...)
+// A call to `Use(f, g, h)` equals to `cashdrawersession.Hooks(f(g(h())))`.
+func (c *CashDrawerSessionClient) Use(hooks ...Hook) {
+	c.hooks.CashDrawerSession = append(c.hooks.CashDrawerSession, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `rolepermission.Intercept(f(g(h())))`.
-func (c *RolePermissionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RolePermission = append(c.inters.RolePermission, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `cashdrawersession.Intercept(f(g(h())))`.
+func (c *CashDrawerSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CashDrawerSession = append(c.inters.CashDrawerSession, interceptors...)
 }
 
-// Create returns a builder for creating a RolePermission entity.
-func (c *RolePermissionClient) Create() *RolePermissionCreate {
-	mutation := newRolePermissionMutation(c.config, OpCreate)
-	return &RolePermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CashDrawerSession entity.
+func (c *CashDrawerSessionClient) Create() *CashDrawerSessionCreate {
+	mutation := newCashDrawerSessionMutation(c.config, OpCreate)
+	return &CashDrawerSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RolePermission entities.
-func (c *RolePermissionClient) CreateBulk(builders ...*RolePermissionCreate) *RolePermissionCreateBulk {
-	return &RolePermissionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CashDrawerSession entities.
+func (c *CashDrawerSessionClient) CreateBulk(builders ...*CashDrawerSessionCreate) *CashDrawerSessionCreateBulk {
+	return &CashDrawerSessionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RolePermissionClient) MapCreateBulk(slice any, setFunc func(*RolePermissionCreate, int)) *RolePermissionCreateBulk {
+func (c *CashDrawerSessionClient) MapCreateBulk(slice any, setFunc func(*CashDrawerSessionCreate, int)) *CashDrawerSessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RolePermissionCreateBulk{err: fmt.Errorf("calling to RolePermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CashDrawerSessionCreateBulk{err: fmt.Errorf("calling to CashDrawerSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RolePermissionCreate, rv.Len())
+	builders := make([]*CashDrawerSessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RolePermissionCreateBulk{config: c.config, builders: builders}
+	return &CashDrawerSessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RolePermission.
-func (c *RolePermissionClient) Update() *RolePermissionUpdate {
-	mutation := newRolePermissionMutation(c.config, OpUpdate)
-	return &RolePermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CashDrawerSession.
+func (c *CashDrawerSessionClient) Update() *CashDrawerSessionUpdate {
+	mutation := newCashDrawerSessionMutation(c.config, OpUpdate)
+	return &CashDrawerSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RolePermissionClient) UpdateOne(_m *RolePermission) *RolePermissionUpdateOne {
-	mutation := newRolePermissionMutation(c.config, OpUpdateOne, withRolePermission(_m))
-	return &RolePermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CashDrawerSessionClient) UpdateOne(_m *CashDrawerSession) *CashDrawerSessionUpdateOne {
+	mutation := newCashDrawerSessionMutation(c.config, OpUpdateOne, withCashDrawerSession(_m))
+	return &CashDrawerSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RolePermissionClient) UpdateOneID(id int) *RolePermissionUpdateOne {
-	mutation := newRolePermissionMutation(c.config, OpUpdateOne, withRolePermissionID(id))
-	return &RolePermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CashDrawerSessionClient) UpdateOneID(id uuid.UUID) *CashDrawerSessionUpdateOne {
+	mutation := newCashDrawerSessionMutation(c.config, OpUpdateOne, withCashDrawerSessionID(id))
+	return &CashDrawerSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for RolePermission.
-func (c *RolePermissionClient) Delete() *RolePermissionDelete {
-	mutation := newRolePermissionMutation(c.config, OpDelete)
-	return &RolePermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CashDrawerSession.
+func (c *CashDrawerSessionClient) Delete() *CashDrawerSessionDelete {
+	mutation := newCashDrawerSessionMutation(c.config, OpDelete)
+	return &CashDrawerSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RolePermissionClient) DeleteOne(_m *RolePermission) *RolePermissionDeleteOne {
+func (c *CashDrawerSessionClient) DeleteOne(_m *CashDrawerSession) *CashDrawerSessionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RolePermissionClient) DeleteOneID(id int) *RolePermissionDeleteOne {
-	builder := c.Delete().Where(rolepermission.ID(id))
+func (c *CashDrawerSessionClient) DeleteOneID(id uuid.UUID) *CashDrawerSessionDeleteOne {
+	builder := c.Delete().Where(cashdrawersession.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RolePermissionDeleteOne{builder}
+	return &CashDrawerSessionDeleteOne{builder}
 }
 
-// Query returns a query builder for RolePermission.
-func (c *RolePermissionClient) Query() *RolePermissionQuery {
-	return &RolePermissionQuery{
+// Query returns a query builder for CashDrawerSession.
+func (c *CashDrawerSessionClient) Query() *CashDrawerSessionQuery {
+	return &CashDrawerSessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRolePermission},
+		ctx:    &QueryContext{Type: TypeCashDrawerSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a RolePermission entity by its id.
-func (c *RolePermissionClient) Get(ctx context.Context, id int) (*RolePermission, error) {
-	return c.Query().Where(rolepermission.ID(id)).Only(ctx)
+// Get returns a CashDrawerSession entity by its id.
+func (c *CashDrawerSessionClient) Get(ctx context.Context, id uuid.UUID) (*CashDrawerSession, error) {
+	return c.Query().Where(cashdrawersession.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RolePermissionClient) GetX(ctx context.Context, id int) *RolePermission {
+func (c *CashDrawerSessionClient) GetX(ctx context.Context, id uuid.UUID) *CashDrawerSession {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1244,164 +1678,132 @@ func (c *RolePermissionClient) GetX(ctx context.Context, id int) *RolePermission
 	return obj
 }
 
-// QueryRole queries the role edge of a RolePermission.
-func (c *RolePermissionClient) QueryRole(_m *RolePermission) *TreasuryRoleQuery {
-	query := (&TreasuryRoleClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(rolepermission.Table, rolepermission.FieldID, id),
-			sqlgraph.To(treasuryrole.Table, treasuryrole.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, rolepermission.RoleTable, rolepermission.RoleColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPermission queries the permission edge of a RolePermission.
-func (c *RolePermissionClient) QueryPermission(_m *RolePermission) *TreasuryPermissionQuery {
-	query := (&TreasuryPermissionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(rolepermission.Table, rolepermission.FieldID, id),
-			sqlgraph.To(treasurypermission.Table, treasurypermission.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, rolepermission.PermissionTable, rolepermission.PermissionColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *RolePermissionClient) Hooks() []Hook {
-	return c.hooks.RolePermission
+func (c *CashDrawerSessionClient) Hooks() []Hook {
+	return c.hooks.CashDrawerSession
 }
 
 // Interceptors returns the client interceptors.
-func (c *RolePermissionClient) Interceptors() []Interceptor {
-	return c.inters.RolePermission
+func (c *CashDrawerSessionClient) Interceptors() []Interceptor {
+	return c.inters.CashDrawerSession
 }
 
-func (c *RolePermissionClient) mutate(ctx context.Context, m *RolePermissionMutation) (Value, error) {
+func (c *CashDrawerSessionClient) mutate(ctx context.Context, m *CashDrawerSessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RolePermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CashDrawerSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RolePermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CashDrawerSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RolePermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CashDrawerSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RolePermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CashDrawerSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown RolePermission mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CashDrawerSession mutation op: %q", m.Op())
 	}
 }
 
-// TreasuryPermissionClient is a client for the TreasuryPermission schema.
-type TreasuryPermissionClient struct {
+// CashDrawerSettingClient is a client for the CashDrawerSetting schema.
+type CashDrawerSettingClient struct {
 	config
 }
 
-// NewTreasuryPermissionClient returns a client for the TreasuryPermission from the given config.
-func NewTreasuryPermissionClient(c config) *TreasuryPermissionClient {
-	return &TreasuryPermissionClient{config: c}
+// NewCashDrawerSettingClient returns a client for the CashDrawerSetting from the given config.
+func NewCashDrawerSettingClient(c config) *CashDrawerSettingClient {
+	return &CashDrawerSettingClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `treasurypermission.Hooks(f(g(h())))`.
-func (c *TreasuryPermissionClient) Use(hooks ...Hook) {
-	c.hooks.TreasuryPermission = append(c.hooks.TreasuryPermission, hooks...)
+// A call to `Use(f, g, h)` equals to `cashdrawersetting.Hooks(f(g(h())))`.
+func (c *CashDrawerSettingClient) Use(hooks ...Hook) {
+	c.hooks.CashDrawerSetting = append(c.hooks.CashDrawerSetting, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `treasurypermission.Intercept(f(g(h())))`.
-func (c *TreasuryPermissionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.TreasuryPermission = append(c.inters.TreasuryPermission, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `cashdrawersetting.Intercept(f(g(h())))`.
+func (c *CashDrawerSettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CashDrawerSetting = append(c.inters.CashDrawerSetting, interceptors...)
 }
 
-// Create returns a builder for creating a TreasuryPermission entity.
-func (c *TreasuryPermissionClient) Create() *TreasuryPermissionCreate {
-	mutation := newTreasuryPermissionMutation(c.config, OpCreate)
-	return &TreasuryPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CashDrawerSetting entity.
+func (c *CashDrawerSettingClient) Create() *CashDrawerSettingCreate {
+	mutation := newCashDrawerSettingMutation(c.config, OpCreate)
+	return &CashDrawerSettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of TreasuryPermission entities.
-func (c *TreasuryPermissionClient) CreateBulk(builders ...*TreasuryPermissionCreate) *TreasuryPermissionCreateBulk {
-	return &TreasuryPermissionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CashDrawerSetting entities.
+func (c *CashDrawerSettingClient) CreateBulk(builders ...*CashDrawerSettingCreate) *CashDrawerSettingCreateBulk {
+	return &CashDrawerSettingCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TreasuryPermissionClient) MapCreateBulk(slice any, setFunc func(*TreasuryPermissionCreate, int)) *TreasuryPermissionCreateBulk {
+func (c *CashDrawerSettingClient) MapCreateBulk(slice any, setFunc func(*CashDrawerSettingCreate, int)) *CashDrawerSettingCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TreasuryPermissionCreateBulk{err: fmt.Errorf("calling to TreasuryPermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CashDrawerSettingCreateBulk{err: fmt.Errorf("calling to CashDrawerSettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TreasuryPermissionCreate, rv.Len())
+	builders := make([]*CashDrawerSettingCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TreasuryPermissionCreateBulk{config: c.config, builders: builders}
+	return &CashDrawerSettingCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for TreasuryPermission.
-func (c *TreasuryPermissionClient) Update() *TreasuryPermissionUpdate {
-	mutation := newTreasuryPermissionMutation(c.config, OpUpdate)
-	return &TreasuryPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CashDrawerSetting.
+func (c *CashDrawerSettingClient) Update() *CashDrawerSettingUpdate {
+	mutation := newCashDrawerSettingMutation(c.config, OpUpdate)
+	return &CashDrawerSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TreasuryPermissionClient) UpdateOne(_m *TreasuryPermission) *TreasuryPermissionUpdateOne {
-	mutation := newTreasuryPermissionMutation(c.config, OpUpdateOne, withTreasuryPermission(_m))
-	return &TreasuryPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CashDrawerSettingClient) UpdateOne(_m *CashDrawerSetting) *CashDrawerSettingUpdateOne {
+	mutation := newCashDrawerSettingMutation(c.config, OpUpdateOne, withCashDrawerSetting(_m))
+	return &CashDrawerSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TreasuryPermissionClient) UpdateOneID(id uuid.UUID) *TreasuryPermissionUpdateOne {
-	mutation := newTreasuryPermissionMutation(c.config, OpUpdateOne, withTreasuryPermissionID(id))
-	return &TreasuryPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CashDrawerSettingClient) UpdateOneID(id uuid.UUID) *CashDrawerSettingUpdateOne {
+	mutation := newCashDrawerSettingMutation(c.config, OpUpdateOne, withCashDrawerSettingID(id))
+	return &CashDrawerSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for TreasuryPermission.
-func (c *TreasuryPermissionClient) Delete() *TreasuryPermissionDelete {
-	mutation := newTreasuryPermissionMutation(c.config, OpDelete)
-	return &TreasuryPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CashDrawerSetting.
+func (c *CashDrawerSettingClient) Delete() *CashDrawerSettingDelete {
+	mutation := newCashDrawerSettingMutation(c.config, OpDelete)
+	return &CashDrawerSettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TreasuryPermissionClient) DeleteOne(_m *TreasuryPermission) *TreasuryPermissionDeleteOne {
+func (c *CashDrawerSettingClient) DeleteOne(_m *CashDrawerSetting) *CashDrawerSettingDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TreasuryPermissionClient) DeleteOneID(id uuid.UUID) *TreasuryPermissionDeleteOne {
-	builder := c.Delete().Where(treasurypermission.ID(id))
+func (c *CashDrawerSettingClient) DeleteOneID(id uuid.UUID) *CashDrawerSettingDeleteOne {
+	builder := c.Delete().Where(cashdrawersetting.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TreasuryPermissionDeleteOne{builder}
+	return &CashDrawerSettingDeleteOne{builder}
 }
 
-// Query returns a query builder for TreasuryPermission.
-func (c *TreasuryPermissionClient) Query() *TreasuryPermissionQuery {
-	return &TreasuryPermissionQuery{
+// Query returns a query builder for CashDrawerSetting.
+func (c *CashDrawerSettingClient) Query() *CashDrawerSettingQuery {
+	return &CashDrawerSettingQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTreasuryPermission},
+		ctx:    &QueryContext{Type: TypeCashDrawerSetting},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a TreasuryPermission entity by its id.
-func (c *TreasuryPermissionClient) Get(ctx context.Context, id uuid.UUID) (*TreasuryPermission, error) {
-	return c.Query().Where(treasurypermission.ID(id)).Only(ctx)
+// Get returns a CashDrawerSetting entity by its id.
+func (c *CashDrawerSettingClient) Get(ctx context.Context, id uuid.UUID) (*CashDrawerSetting, error) {
+	return c.Query().Where(cashdrawersetting.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TreasuryPermissionClient) GetX(ctx context.Context, id uuid.UUID) *TreasuryPermission {
+func (c *CashDrawerSettingClient) GetX(ctx context.Context, id uuid.UUID) *CashDrawerSetting {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1409,15 +1811,148 @@ func (c *TreasuryPermissionClient) GetX(ctx context.Context, id uuid.UUID) *Trea
 	return obj
 }
 
-// QueryRoles queries the roles edge of a TreasuryPermission.
-func (c *TreasuryPermissionClient) QueryRoles(_m *TreasuryPermission) *TreasuryRoleQuery {
-	query := (&TreasuryRoleClient{config: c.config}).Query()
+// Hooks returns the client hooks.
+func (c *CashDrawerSettingClient) Hooks() []Hook {
+	return c.hooks.CashDrawerSetting
+}
+
+// Interceptors returns the client interceptors.
+func (c *CashDrawerSettingClient) Interceptors() []Interceptor {
+	return c.inters.CashDrawerSetting
+}
+
+func (c *CashDrawerSettingClient) mutate(ctx context.Context, m *CashDrawerSettingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CashDrawerSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CashDrawerSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CashDrawerSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CashDrawerSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CashDrawerSetting mutation op: %q", m.Op())
+	}
+}
+
+// ChartOfAccountClient is a client for the ChartOfAccount schema.
+type ChartOfAccountClient struct {
+	config
+}
+
+// NewChartOfAccountClient returns a client for the ChartOfAccount from the given config.
+func NewChartOfAccountClient(c config) *ChartOfAccountClient {
+	return &ChartOfAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chartofaccount.Hooks(f(g(h())))`.
+func (c *ChartOfAccountClient) Use(hooks ...Hook) {
+	c.hooks.ChartOfAccount = append(c.hooks.ChartOfAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `chartofaccount.Intercept(f(g(h())))`.
+func (c *ChartOfAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChartOfAccount = append(c.inters.ChartOfAccount, interceptors...)
+}
+
+// Create returns a builder for creating a ChartOfAccount entity.
+func (c *ChartOfAccountClient) Create() *ChartOfAccountCreate {
+	mutation := newChartOfAccountMutation(c.config, OpCreate)
+	return &ChartOfAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChartOfAccount entities.
+func (c *ChartOfAccountClient) CreateBulk(builders ...*ChartOfAccountCreate) *ChartOfAccountCreateBulk {
+	return &ChartOfAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChartOfAccountClient) MapCreateBulk(slice any, setFunc func(*ChartOfAccountCreate, int)) *ChartOfAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChartOfAccountCreateBulk{err: fmt.Errorf("calling to ChartOfAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChartOfAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChartOfAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChartOfAccount.
+func (c *ChartOfAccountClient) Update() *ChartOfAccountUpdate {
+	mutation := newChartOfAccountMutation(c.config, OpUpdate)
+	return &ChartOfAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChartOfAccountClient) UpdateOne(_m *ChartOfAccount) *ChartOfAccountUpdateOne {
+	mutation := newChartOfAccountMutation(c.config, OpUpdateOne, withChartOfAccount(_m))
+	return &ChartOfAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChartOfAccountClient) UpdateOneID(id uuid.UUID) *ChartOfAccountUpdateOne {
+	mutation := newChartOfAccountMutation(c.config, OpUpdateOne, withChartOfAccountID(id))
+	return &ChartOfAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChartOfAccount.
+func (c *ChartOfAccountClient) Delete() *ChartOfAccountDelete {
+	mutation := newChartOfAccountMutation(c.config, OpDelete)
+	return &ChartOfAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChartOfAccountClient) DeleteOne(_m *ChartOfAccount) *ChartOfAccountDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChartOfAccountClient) DeleteOneID(id uuid.UUID) *ChartOfAccountDeleteOne {
+	builder := c.Delete().Where(chartofaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChartOfAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for ChartOfAccount.
+func (c *ChartOfAccountClient) Query() *ChartOfAccountQuery {
+	return &ChartOfAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChartOfAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChartOfAccount entity by its id.
+func (c *ChartOfAccountClient) Get(ctx context.Context, id uuid.UUID) (*ChartOfAccount, error) {
+	return c.Query().Where(chartofaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChartOfAccountClient) GetX(ctx context.Context, id uuid.UUID) *ChartOfAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryParent queries the parent edge of a ChartOfAccount.
+func (c *ChartOfAccountClient) QueryParent(_m *ChartOfAccount) *ChartOfAccountQuery {
+	query := (&ChartOfAccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(treasurypermission.Table, treasurypermission.FieldID, id),
-			sqlgraph.To(treasuryrole.Table, treasuryrole.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, treasurypermission.RolesTable, treasurypermission.RolesPrimaryKey...),
+			sqlgraph.From(chartofaccount.Table, chartofaccount.FieldID, id),
+			sqlgraph.To(chartofaccount.Table, chartofaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, chartofaccount.ParentTable, chartofaccount.ParentColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1425,15 +1960,15 @@ func (c *TreasuryPermissionClient) QueryRoles(_m *TreasuryPermission) *TreasuryR
 	return query
 }
 
-// QueryRolePermissions queries the role_permissions edge of a TreasuryPermission.
-func (c *TreasuryPermissionClient) QueryRolePermissions(_m *TreasuryPermission) *RolePermissionQuery {
-	query := (&RolePermissionClient{config: c.config}).Query()
+// QueryChildren queries the children edge of a ChartOfAccount.
+func (c *ChartOfAccountClient) QueryChildren(_m *ChartOfAccount) *ChartOfAccountQuery {
+	query := (&ChartOfAccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(treasurypermission.Table, treasurypermission.FieldID, id),
-			sqlgraph.To(rolepermission.Table, rolepermission.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, treasurypermission.RolePermissionsTable, treasurypermission.RolePermissionsColumn),
+			sqlgraph.From(chartofaccount.Table, chartofaccount.FieldID, id),
+			sqlgraph.To(chartofaccount.Table, chartofaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, chartofaccount.ChildrenTable, chartofaccount.ChildrenColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1442,131 +1977,7309 @@ func (c *TreasuryPermissionClient) QueryRolePermissions(_m *TreasuryPermission)
 }
 
 // Hooks returns the client hooks.
-func (c *TreasuryPermissionClient) Hooks() []Hook {
-	return c.hooks.TreasuryPermission
+func (c *ChartOfAccountClient) Hooks() []Hook {
+	return c.hooks.ChartOfAccount
 }
 
 // Interceptors returns the client interceptors.
-func (c *TreasuryPermissionClient) Interceptors() []Interceptor {
-	return c.inters.TreasuryPermission
+func (c *ChartOfAccountClient) Interceptors() []Interceptor {
+	return c.inters.ChartOfAccount
 }
 
-func (c *TreasuryPermissionClient) mutate(ctx context.Context, m *TreasuryPermissionMutation) (Value, error) {
+func (c *ChartOfAccountClient) mutate(ctx context.Context, m *ChartOfAccountMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TreasuryPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChartOfAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TreasuryPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChartOfAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TreasuryPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChartOfAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChartOfAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ChartOfAccount mutation op: %q", m.Op())
+	}
+}
+
+// CreditOverrideClient is a client for the CreditOverride schema.
+type CreditOverrideClient struct {
+	config
+}
+
+// NewCreditOverrideClient returns a client for the CreditOverride from the given config.
+func NewCreditOverrideClient(c config) *CreditOverrideClient {
+	return &CreditOverrideClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `creditoverride.Hooks(f(g(h())))`.
+func (c *CreditOverrideClient) Use(hooks ...Hook) {
+	c.hooks.CreditOverride = append(c.hooks.CreditOverride, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `creditoverride.Intercept(f(g(h())))`.
+func (c *CreditOverrideClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CreditOverride = append(c.inters.CreditOverride, interceptors...)
+}
+
+// Create returns a builder for creating a CreditOverride entity.
+func (c *CreditOverrideClient) Create() *CreditOverrideCreate {
+	mutation := newCreditOverrideMutation(c.config, OpCreate)
+	return &CreditOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CreditOverride entities.
+func (c *CreditOverrideClient) CreateBulk(builders ...*CreditOverrideCreate) *CreditOverrideCreateBulk {
+	return &CreditOverrideCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CreditOverrideClient) MapCreateBulk(slice any, setFunc func(*CreditOverrideCreate, int)) *CreditOverrideCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CreditOverrideCreateBulk{err: fmt.Errorf("calling to CreditOverrideClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CreditOverrideCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CreditOverrideCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CreditOverride.
+func (c *CreditOverrideClient) Update() *CreditOverrideUpdate {
+	mutation := newCreditOverrideMutation(c.config, OpUpdate)
+	return &CreditOverrideUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CreditOverrideClient) UpdateOne(_m *CreditOverride) *CreditOverrideUpdateOne {
+	mutation := newCreditOverrideMutation(c.config, OpUpdateOne, withCreditOverride(_m))
+	return &CreditOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CreditOverrideClient) UpdateOneID(id uuid.UUID) *CreditOverrideUpdateOne {
+	mutation := newCreditOverrideMutation(c.config, OpUpdateOne, withCreditOverrideID(id))
+	return &CreditOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CreditOverride.
+func (c *CreditOverrideClient) Delete() *CreditOverrideDelete {
+	mutation := newCreditOverrideMutation(c.config, OpDelete)
+	return &CreditOverrideDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CreditOverrideClient) DeleteOne(_m *CreditOverride) *CreditOverrideDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CreditOverrideClient) DeleteOneID(id uuid.UUID) *CreditOverrideDeleteOne {
+	builder := c.Delete().Where(creditoverride.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CreditOverrideDeleteOne{builder}
+}
+
+// Query returns a query builder for CreditOverride.
+func (c *CreditOverrideClient) Query() *CreditOverrideQuery {
+	return &CreditOverrideQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCreditOverride},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CreditOverride entity by its id.
+func (c *CreditOverrideClient) Get(ctx context.Context, id uuid.UUID) (*CreditOverride, error) {
+	return c.Query().Where(creditoverride.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CreditOverrideClient) GetX(ctx context.Context, id uuid.UUID) *CreditOverride {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CreditOverrideClient) Hooks() []Hook {
+	return c.hooks.CreditOverride
+}
+
+// Interceptors returns the client interceptors.
+func (c *CreditOverrideClient) Interceptors() []Interceptor {
+	return c.inters.CreditOverride
+}
+
+func (c *CreditOverrideClient) mutate(ctx context.Context, m *CreditOverrideMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CreditOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CreditOverrideUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CreditOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CreditOverrideDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CreditOverride mutation op: %q", m.Op())
+	}
+}
+
+// CustomerClient is a client for the Customer schema.
+type CustomerClient struct {
+	config
+}
+
+// NewCustomerClient returns a client for the Customer from the given config.
+func NewCustomerClient(c config) *CustomerClient {
+	return &CustomerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `customer.Hooks(f(g(h())))`.
+func (c *CustomerClient) Use(hooks ...Hook) {
+	c.hooks.Customer = append(c.hooks.Customer, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `customer.Intercept(f(g(h())))`.
+func (c *CustomerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Customer = append(c.inters.Customer, interceptors...)
+}
+
+// Create returns a builder for creating a Customer entity.
+func (c *CustomerClient) Create() *CustomerCreate {
+	mutation := newCustomerMutation(c.config, OpCreate)
+	return &CustomerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Customer entities.
+func (c *CustomerClient) CreateBulk(builders ...*CustomerCreate) *CustomerCreateBulk {
+	return &CustomerCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CustomerClient) MapCreateBulk(slice any, setFunc func(*CustomerCreate, int)) *CustomerCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CustomerCreateBulk{err: fmt.Errorf("calling to CustomerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CustomerCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CustomerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Customer.
+func (c *CustomerClient) Update() *CustomerUpdate {
+	mutation := newCustomerMutation(c.config, OpUpdate)
+	return &CustomerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CustomerClient) UpdateOne(_m *Customer) *CustomerUpdateOne {
+	mutation := newCustomerMutation(c.config, OpUpdateOne, withCustomer(_m))
+	return &CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CustomerClient) UpdateOneID(id uuid.UUID) *CustomerUpdateOne {
+	mutation := newCustomerMutation(c.config, OpUpdateOne, withCustomerID(id))
+	return &CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Customer.
+func (c *CustomerClient) Delete() *CustomerDelete {
+	mutation := newCustomerMutation(c.config, OpDelete)
+	return &CustomerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CustomerClient) DeleteOne(_m *Customer) *CustomerDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CustomerClient) DeleteOneID(id uuid.UUID) *CustomerDeleteOne {
+	builder := c.Delete().Where(customer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CustomerDeleteOne{builder}
+}
+
+// Query returns a query builder for Customer.
+func (c *CustomerClient) Query() *CustomerQuery {
+	return &CustomerQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCustomer},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Customer entity by its id.
+func (c *CustomerClient) Get(ctx context.Context, id uuid.UUID) (*Customer, error) {
+	return c.Query().Where(customer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CustomerClient) GetX(ctx context.Context, id uuid.UUID) *Customer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CustomerClient) Hooks() []Hook {
+	return c.hooks.Customer
+}
+
+// Interceptors returns the client interceptors.
+func (c *CustomerClient) Interceptors() []Interceptor {
+	return c.inters.Customer
+}
+
+func (c *CustomerClient) mutate(ctx context.Context, m *CustomerMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CustomerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CustomerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CustomerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Customer mutation op: %q", m.Op())
+	}
+}
+
+// CustomerStatementClient is a client for the CustomerStatement schema.
+type CustomerStatementClient struct {
+	config
+}
+
+// NewCustomerStatementClient returns a client for the CustomerStatement from the given config.
+func NewCustomerStatementClient(c config) *CustomerStatementClient {
+	return &CustomerStatementClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `customerstatement.Hooks(f(g(h())))`.
+func (c *CustomerStatementClient) Use(hooks ...Hook) {
+	c.hooks.CustomerStatement = append(c.hooks.CustomerStatement, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `customerstatement.Intercept(f(g(h())))`.
+func (c *CustomerStatementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CustomerStatement = append(c.inters.CustomerStatement, interceptors...)
+}
+
+// Create returns a builder for creating a CustomerStatement entity.
+func (c *CustomerStatementClient) Create() *CustomerStatementCreate {
+	mutation := newCustomerStatementMutation(c.config, OpCreate)
+	return &CustomerStatementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CustomerStatement entities.
+func (c *CustomerStatementClient) CreateBulk(builders ...*CustomerStatementCreate) *CustomerStatementCreateBulk {
+	return &CustomerStatementCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CustomerStatementClient) MapCreateBulk(slice any, setFunc func(*CustomerStatementCreate, int)) *CustomerStatementCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CustomerStatementCreateBulk{err: fmt.Errorf("calling to CustomerStatementClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CustomerStatementCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CustomerStatementCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CustomerStatement.
+func (c *CustomerStatementClient) Update() *CustomerStatementUpdate {
+	mutation := newCustomerStatementMutation(c.config, OpUpdate)
+	return &CustomerStatementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CustomerStatementClient) UpdateOne(_m *CustomerStatement) *CustomerStatementUpdateOne {
+	mutation := newCustomerStatementMutation(c.config, OpUpdateOne, withCustomerStatement(_m))
+	return &CustomerStatementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CustomerStatementClient) UpdateOneID(id uuid.UUID) *CustomerStatementUpdateOne {
+	mutation := newCustomerStatementMutation(c.config, OpUpdateOne, withCustomerStatementID(id))
+	return &CustomerStatementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CustomerStatement.
+func (c *CustomerStatementClient) Delete() *CustomerStatementDelete {
+	mutation := newCustomerStatementMutation(c.config, OpDelete)
+	return &CustomerStatementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CustomerStatementClient) DeleteOne(_m *CustomerStatement) *CustomerStatementDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CustomerStatementClient) DeleteOneID(id uuid.UUID) *CustomerStatementDeleteOne {
+	builder := c.Delete().Where(customerstatement.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CustomerStatementDeleteOne{builder}
+}
+
+// Query returns a query builder for CustomerStatement.
+func (c *CustomerStatementClient) Query() *CustomerStatementQuery {
+	return &CustomerStatementQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCustomerStatement},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CustomerStatement entity by its id.
+func (c *CustomerStatementClient) Get(ctx context.Context, id uuid.UUID) (*CustomerStatement, error) {
+	return c.Query().Where(customerstatement.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CustomerStatementClient) GetX(ctx context.Context, id uuid.UUID) *CustomerStatement {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CustomerStatementClient) Hooks() []Hook {
+	return c.hooks.CustomerStatement
+}
+
+// Interceptors returns the client interceptors.
+func (c *CustomerStatementClient) Interceptors() []Interceptor {
+	return c.inters.CustomerStatement
+}
+
+func (c *CustomerStatementClient) mutate(ctx context.Context, m *CustomerStatementMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CustomerStatementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CustomerStatementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CustomerStatementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CustomerStatementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CustomerStatement mutation op: %q", m.Op())
+	}
+}
+
+// DocumentSequenceClient is a client for the DocumentSequence schema.
+type DocumentSequenceClient struct {
+	config
+}
+
+// NewDocumentSequenceClient returns a client for the DocumentSequence from the given config.
+func NewDocumentSequenceClient(c config) *DocumentSequenceClient {
+	return &DocumentSequenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `documentsequence.Hooks(f(g(h())))`.
+func (c *DocumentSequenceClient) Use(hooks ...Hook) {
+	c.hooks.DocumentSequence = append(c.hooks.DocumentSequence, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `documentsequence.Intercept(f(g(h())))`.
+func (c *DocumentSequenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DocumentSequence = append(c.inters.DocumentSequence, interceptors...)
+}
+
+// Create returns a builder for creating a DocumentSequence entity.
+func (c *DocumentSequenceClient) Create() *DocumentSequenceCreate {
+	mutation := newDocumentSequenceMutation(c.config, OpCreate)
+	return &DocumentSequenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DocumentSequence entities.
+func (c *DocumentSequenceClient) CreateBulk(builders ...*DocumentSequenceCreate) *DocumentSequenceCreateBulk {
+	return &DocumentSequenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DocumentSequenceClient) MapCreateBulk(slice any, setFunc func(*DocumentSequenceCreate, int)) *DocumentSequenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DocumentSequenceCreateBulk{err: fmt.Errorf("calling to DocumentSequenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DocumentSequenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DocumentSequenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DocumentSequence.
+func (c *DocumentSequenceClient) Update() *DocumentSequenceUpdate {
+	mutation := newDocumentSequenceMutation(c.config, OpUpdate)
+	return &DocumentSequenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DocumentSequenceClient) UpdateOne(_m *DocumentSequence) *DocumentSequenceUpdateOne {
+	mutation := newDocumentSequenceMutation(c.config, OpUpdateOne, withDocumentSequence(_m))
+	return &DocumentSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DocumentSequenceClient) UpdateOneID(id uuid.UUID) *DocumentSequenceUpdateOne {
+	mutation := newDocumentSequenceMutation(c.config, OpUpdateOne, withDocumentSequenceID(id))
+	return &DocumentSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DocumentSequence.
+func (c *DocumentSequenceClient) Delete() *DocumentSequenceDelete {
+	mutation := newDocumentSequenceMutation(c.config, OpDelete)
+	return &DocumentSequenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DocumentSequenceClient) DeleteOne(_m *DocumentSequence) *DocumentSequenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DocumentSequenceClient) DeleteOneID(id uuid.UUID) *DocumentSequenceDeleteOne {
+	builder := c.Delete().Where(documentsequence.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DocumentSequenceDeleteOne{builder}
+}
+
+// Query returns a query builder for DocumentSequence.
+func (c *DocumentSequenceClient) Query() *DocumentSequenceQuery {
+	return &DocumentSequenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDocumentSequence},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DocumentSequence entity by its id.
+func (c *DocumentSequenceClient) Get(ctx context.Context, id uuid.UUID) (*DocumentSequence, error) {
+	return c.Query().Where(documentsequence.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DocumentSequenceClient) GetX(ctx context.Context, id uuid.UUID) *DocumentSequence {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DocumentSequenceClient) Hooks() []Hook {
+	return c.hooks.DocumentSequence
+}
+
+// Interceptors returns the client interceptors.
+func (c *DocumentSequenceClient) Interceptors() []Interceptor {
+	return c.inters.DocumentSequence
+}
+
+func (c *DocumentSequenceClient) mutate(ctx context.Context, m *DocumentSequenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DocumentSequenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DocumentSequenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DocumentSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DocumentSequenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DocumentSequence mutation op: %q", m.Op())
+	}
+}
+
+// DunningNoticeClient is a client for the DunningNotice schema.
+type DunningNoticeClient struct {
+	config
+}
+
+// NewDunningNoticeClient returns a client for the DunningNotice from the given config.
+func NewDunningNoticeClient(c config) *DunningNoticeClient {
+	return &DunningNoticeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `dunningnotice.Hooks(f(g(h())))`.
+func (c *DunningNoticeClient) Use(hooks ...Hook) {
+	c.hooks.DunningNotice = append(c.hooks.DunningNotice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `dunningnotice.Intercept(f(g(h())))`.
+func (c *DunningNoticeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DunningNotice = append(c.inters.DunningNotice, interceptors...)
+}
+
+// Create returns a builder for creating a DunningNotice entity.
+func (c *DunningNoticeClient) Create() *DunningNoticeCreate {
+	mutation := newDunningNoticeMutation(c.config, OpCreate)
+	return &DunningNoticeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DunningNotice entities.
+func (c *DunningNoticeClient) CreateBulk(builders ...*DunningNoticeCreate) *DunningNoticeCreateBulk {
+	return &DunningNoticeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DunningNoticeClient) MapCreateBulk(slice any, setFunc func(*DunningNoticeCreate, int)) *DunningNoticeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DunningNoticeCreateBulk{err: fmt.Errorf("calling to DunningNoticeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DunningNoticeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DunningNoticeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DunningNotice.
+func (c *DunningNoticeClient) Update() *DunningNoticeUpdate {
+	mutation := newDunningNoticeMutation(c.config, OpUpdate)
+	return &DunningNoticeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DunningNoticeClient) UpdateOne(_m *DunningNotice) *DunningNoticeUpdateOne {
+	mutation := newDunningNoticeMutation(c.config, OpUpdateOne, withDunningNotice(_m))
+	return &DunningNoticeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DunningNoticeClient) UpdateOneID(id uuid.UUID) *DunningNoticeUpdateOne {
+	mutation := newDunningNoticeMutation(c.config, OpUpdateOne, withDunningNoticeID(id))
+	return &DunningNoticeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DunningNotice.
+func (c *DunningNoticeClient) Delete() *DunningNoticeDelete {
+	mutation := newDunningNoticeMutation(c.config, OpDelete)
+	return &DunningNoticeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DunningNoticeClient) DeleteOne(_m *DunningNotice) *DunningNoticeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DunningNoticeClient) DeleteOneID(id uuid.UUID) *DunningNoticeDeleteOne {
+	builder := c.Delete().Where(dunningnotice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DunningNoticeDeleteOne{builder}
+}
+
+// Query returns a query builder for DunningNotice.
+func (c *DunningNoticeClient) Query() *DunningNoticeQuery {
+	return &DunningNoticeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDunningNotice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DunningNotice entity by its id.
+func (c *DunningNoticeClient) Get(ctx context.Context, id uuid.UUID) (*DunningNotice, error) {
+	return c.Query().Where(dunningnotice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DunningNoticeClient) GetX(ctx context.Context, id uuid.UUID) *DunningNotice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DunningNoticeClient) Hooks() []Hook {
+	return c.hooks.DunningNotice
+}
+
+// Interceptors returns the client interceptors.
+func (c *DunningNoticeClient) Interceptors() []Interceptor {
+	return c.inters.DunningNotice
+}
+
+func (c *DunningNoticeClient) mutate(ctx context.Context, m *DunningNoticeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DunningNoticeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DunningNoticeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DunningNoticeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DunningNoticeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DunningNotice mutation op: %q", m.Op())
+	}
+}
+
+// DunningPauseClient is a client for the DunningPause schema.
+type DunningPauseClient struct {
+	config
+}
+
+// NewDunningPauseClient returns a client for the DunningPause from the given config.
+func NewDunningPauseClient(c config) *DunningPauseClient {
+	return &DunningPauseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `dunningpause.Hooks(f(g(h())))`.
+func (c *DunningPauseClient) Use(hooks ...Hook) {
+	c.hooks.DunningPause = append(c.hooks.DunningPause, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `dunningpause.Intercept(f(g(h())))`.
+func (c *DunningPauseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DunningPause = append(c.inters.DunningPause, interceptors...)
+}
+
+// Create returns a builder for creating a DunningPause entity.
+func (c *DunningPauseClient) Create() *DunningPauseCreate {
+	mutation := newDunningPauseMutation(c.config, OpCreate)
+	return &DunningPauseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DunningPause entities.
+func (c *DunningPauseClient) CreateBulk(builders ...*DunningPauseCreate) *DunningPauseCreateBulk {
+	return &DunningPauseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DunningPauseClient) MapCreateBulk(slice any, setFunc func(*DunningPauseCreate, int)) *DunningPauseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DunningPauseCreateBulk{err: fmt.Errorf("calling to DunningPauseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DunningPauseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DunningPauseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DunningPause.
+func (c *DunningPauseClient) Update() *DunningPauseUpdate {
+	mutation := newDunningPauseMutation(c.config, OpUpdate)
+	return &DunningPauseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DunningPauseClient) UpdateOne(_m *DunningPause) *DunningPauseUpdateOne {
+	mutation := newDunningPauseMutation(c.config, OpUpdateOne, withDunningPause(_m))
+	return &DunningPauseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DunningPauseClient) UpdateOneID(id uuid.UUID) *DunningPauseUpdateOne {
+	mutation := newDunningPauseMutation(c.config, OpUpdateOne, withDunningPauseID(id))
+	return &DunningPauseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DunningPause.
+func (c *DunningPauseClient) Delete() *DunningPauseDelete {
+	mutation := newDunningPauseMutation(c.config, OpDelete)
+	return &DunningPauseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DunningPauseClient) DeleteOne(_m *DunningPause) *DunningPauseDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DunningPauseClient) DeleteOneID(id uuid.UUID) *DunningPauseDeleteOne {
+	builder := c.Delete().Where(dunningpause.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DunningPauseDeleteOne{builder}
+}
+
+// Query returns a query builder for DunningPause.
+func (c *DunningPauseClient) Query() *DunningPauseQuery {
+	return &DunningPauseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDunningPause},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DunningPause entity by its id.
+func (c *DunningPauseClient) Get(ctx context.Context, id uuid.UUID) (*DunningPause, error) {
+	return c.Query().Where(dunningpause.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DunningPauseClient) GetX(ctx context.Context, id uuid.UUID) *DunningPause {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DunningPauseClient) Hooks() []Hook {
+	return c.hooks.DunningPause
+}
+
+// Interceptors returns the client interceptors.
+func (c *DunningPauseClient) Interceptors() []Interceptor {
+	return c.inters.DunningPause
+}
+
+func (c *DunningPauseClient) mutate(ctx context.Context, m *DunningPauseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DunningPauseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DunningPauseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DunningPauseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DunningPauseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DunningPause mutation op: %q", m.Op())
+	}
+}
+
+// DunningStepClient is a client for the DunningStep schema.
+type DunningStepClient struct {
+	config
+}
+
+// NewDunningStepClient returns a client for the DunningStep from the given config.
+func NewDunningStepClient(c config) *DunningStepClient {
+	return &DunningStepClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `dunningstep.Hooks(f(g(h())))`.
+func (c *DunningStepClient) Use(hooks ...Hook) {
+	c.hooks.DunningStep = append(c.hooks.DunningStep, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `dunningstep.Intercept(f(g(h())))`.
+func (c *DunningStepClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DunningStep = append(c.inters.DunningStep, interceptors...)
+}
+
+// Create returns a builder for creating a DunningStep entity.
+func (c *DunningStepClient) Create() *DunningStepCreate {
+	mutation := newDunningStepMutation(c.config, OpCreate)
+	return &DunningStepCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DunningStep entities.
+func (c *DunningStepClient) CreateBulk(builders ...*DunningStepCreate) *DunningStepCreateBulk {
+	return &DunningStepCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DunningStepClient) MapCreateBulk(slice any, setFunc func(*DunningStepCreate, int)) *DunningStepCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DunningStepCreateBulk{err: fmt.Errorf("calling to DunningStepClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DunningStepCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DunningStepCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DunningStep.
+func (c *DunningStepClient) Update() *DunningStepUpdate {
+	mutation := newDunningStepMutation(c.config, OpUpdate)
+	return &DunningStepUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DunningStepClient) UpdateOne(_m *DunningStep) *DunningStepUpdateOne {
+	mutation := newDunningStepMutation(c.config, OpUpdateOne, withDunningStep(_m))
+	return &DunningStepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DunningStepClient) UpdateOneID(id uuid.UUID) *DunningStepUpdateOne {
+	mutation := newDunningStepMutation(c.config, OpUpdateOne, withDunningStepID(id))
+	return &DunningStepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DunningStep.
+func (c *DunningStepClient) Delete() *DunningStepDelete {
+	mutation := newDunningStepMutation(c.config, OpDelete)
+	return &DunningStepDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DunningStepClient) DeleteOne(_m *DunningStep) *DunningStepDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DunningStepClient) DeleteOneID(id uuid.UUID) *DunningStepDeleteOne {
+	builder := c.Delete().Where(dunningstep.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DunningStepDeleteOne{builder}
+}
+
+// Query returns a query builder for DunningStep.
+func (c *DunningStepClient) Query() *DunningStepQuery {
+	return &DunningStepQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDunningStep},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DunningStep entity by its id.
+func (c *DunningStepClient) Get(ctx context.Context, id uuid.UUID) (*DunningStep, error) {
+	return c.Query().Where(dunningstep.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DunningStepClient) GetX(ctx context.Context, id uuid.UUID) *DunningStep {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DunningStepClient) Hooks() []Hook {
+	return c.hooks.DunningStep
+}
+
+// Interceptors returns the client interceptors.
+func (c *DunningStepClient) Interceptors() []Interceptor {
+	return c.inters.DunningStep
+}
+
+func (c *DunningStepClient) mutate(ctx context.Context, m *DunningStepMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DunningStepCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DunningStepUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DunningStepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DunningStepDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DunningStep mutation op: %q", m.Op())
+	}
+}
+
+// EarningsSettingClient is a client for the EarningsSetting schema.
+type EarningsSettingClient struct {
+	config
+}
+
+// NewEarningsSettingClient returns a client for the EarningsSetting from the given config.
+func NewEarningsSettingClient(c config) *EarningsSettingClient {
+	return &EarningsSettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `earningssetting.Hooks(f(g(h())))`.
+func (c *EarningsSettingClient) Use(hooks ...Hook) {
+	c.hooks.EarningsSetting = append(c.hooks.EarningsSetting, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `earningssetting.Intercept(f(g(h())))`.
+func (c *EarningsSettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EarningsSetting = append(c.inters.EarningsSetting, interceptors...)
+}
+
+// Create returns a builder for creating a EarningsSetting entity.
+func (c *EarningsSettingClient) Create() *EarningsSettingCreate {
+	mutation := newEarningsSettingMutation(c.config, OpCreate)
+	return &EarningsSettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EarningsSetting entities.
+func (c *EarningsSettingClient) CreateBulk(builders ...*EarningsSettingCreate) *EarningsSettingCreateBulk {
+	return &EarningsSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EarningsSettingClient) MapCreateBulk(slice any, setFunc func(*EarningsSettingCreate, int)) *EarningsSettingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EarningsSettingCreateBulk{err: fmt.Errorf("calling to EarningsSettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EarningsSettingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EarningsSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EarningsSetting.
+func (c *EarningsSettingClient) Update() *EarningsSettingUpdate {
+	mutation := newEarningsSettingMutation(c.config, OpUpdate)
+	return &EarningsSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EarningsSettingClient) UpdateOne(_m *EarningsSetting) *EarningsSettingUpdateOne {
+	mutation := newEarningsSettingMutation(c.config, OpUpdateOne, withEarningsSetting(_m))
+	return &EarningsSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EarningsSettingClient) UpdateOneID(id uuid.UUID) *EarningsSettingUpdateOne {
+	mutation := newEarningsSettingMutation(c.config, OpUpdateOne, withEarningsSettingID(id))
+	return &EarningsSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EarningsSetting.
+func (c *EarningsSettingClient) Delete() *EarningsSettingDelete {
+	mutation := newEarningsSettingMutation(c.config, OpDelete)
+	return &EarningsSettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EarningsSettingClient) DeleteOne(_m *EarningsSetting) *EarningsSettingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EarningsSettingClient) DeleteOneID(id uuid.UUID) *EarningsSettingDeleteOne {
+	builder := c.Delete().Where(earningssetting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EarningsSettingDeleteOne{builder}
+}
+
+// Query returns a query builder for EarningsSetting.
+func (c *EarningsSettingClient) Query() *EarningsSettingQuery {
+	return &EarningsSettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEarningsSetting},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EarningsSetting entity by its id.
+func (c *EarningsSettingClient) Get(ctx context.Context, id uuid.UUID) (*EarningsSetting, error) {
+	return c.Query().Where(earningssetting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EarningsSettingClient) GetX(ctx context.Context, id uuid.UUID) *EarningsSetting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EarningsSettingClient) Hooks() []Hook {
+	return c.hooks.EarningsSetting
+}
+
+// Interceptors returns the client interceptors.
+func (c *EarningsSettingClient) Interceptors() []Interceptor {
+	return c.inters.EarningsSetting
+}
+
+func (c *EarningsSettingClient) mutate(ctx context.Context, m *EarningsSettingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EarningsSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EarningsSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EarningsSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EarningsSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EarningsSetting mutation op: %q", m.Op())
+	}
+}
+
+// GoodsReceiptClient is a client for the GoodsReceipt schema.
+type GoodsReceiptClient struct {
+	config
+}
+
+// NewGoodsReceiptClient returns a client for the GoodsReceipt from the given config.
+func NewGoodsReceiptClient(c config) *GoodsReceiptClient {
+	return &GoodsReceiptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `goodsreceipt.Hooks(f(g(h())))`.
+func (c *GoodsReceiptClient) Use(hooks ...Hook) {
+	c.hooks.GoodsReceipt = append(c.hooks.GoodsReceipt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `goodsreceipt.Intercept(f(g(h())))`.
+func (c *GoodsReceiptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GoodsReceipt = append(c.inters.GoodsReceipt, interceptors...)
+}
+
+// Create returns a builder for creating a GoodsReceipt entity.
+func (c *GoodsReceiptClient) Create() *GoodsReceiptCreate {
+	mutation := newGoodsReceiptMutation(c.config, OpCreate)
+	return &GoodsReceiptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GoodsReceipt entities.
+func (c *GoodsReceiptClient) CreateBulk(builders ...*GoodsReceiptCreate) *GoodsReceiptCreateBulk {
+	return &GoodsReceiptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GoodsReceiptClient) MapCreateBulk(slice any, setFunc func(*GoodsReceiptCreate, int)) *GoodsReceiptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GoodsReceiptCreateBulk{err: fmt.Errorf("calling to GoodsReceiptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GoodsReceiptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GoodsReceiptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GoodsReceipt.
+func (c *GoodsReceiptClient) Update() *GoodsReceiptUpdate {
+	mutation := newGoodsReceiptMutation(c.config, OpUpdate)
+	return &GoodsReceiptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GoodsReceiptClient) UpdateOne(_m *GoodsReceipt) *GoodsReceiptUpdateOne {
+	mutation := newGoodsReceiptMutation(c.config, OpUpdateOne, withGoodsReceipt(_m))
+	return &GoodsReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GoodsReceiptClient) UpdateOneID(id uuid.UUID) *GoodsReceiptUpdateOne {
+	mutation := newGoodsReceiptMutation(c.config, OpUpdateOne, withGoodsReceiptID(id))
+	return &GoodsReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GoodsReceipt.
+func (c *GoodsReceiptClient) Delete() *GoodsReceiptDelete {
+	mutation := newGoodsReceiptMutation(c.config, OpDelete)
+	return &GoodsReceiptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GoodsReceiptClient) DeleteOne(_m *GoodsReceipt) *GoodsReceiptDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GoodsReceiptClient) DeleteOneID(id uuid.UUID) *GoodsReceiptDeleteOne {
+	builder := c.Delete().Where(goodsreceipt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GoodsReceiptDeleteOne{builder}
+}
+
+// Query returns a query builder for GoodsReceipt.
+func (c *GoodsReceiptClient) Query() *GoodsReceiptQuery {
+	return &GoodsReceiptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGoodsReceipt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GoodsReceipt entity by its id.
+func (c *GoodsReceiptClient) Get(ctx context.Context, id uuid.UUID) (*GoodsReceipt, error) {
+	return c.Query().Where(goodsreceipt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GoodsReceiptClient) GetX(ctx context.Context, id uuid.UUID) *GoodsReceipt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLines queries the lines edge of a GoodsReceipt.
+func (c *GoodsReceiptClient) QueryLines(_m *GoodsReceipt) *GoodsReceiptLineQuery {
+	query := (&GoodsReceiptLineClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(goodsreceipt.Table, goodsreceipt.FieldID, id),
+			sqlgraph.To(goodsreceiptline.Table, goodsreceiptline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, goodsreceipt.LinesTable, goodsreceipt.LinesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GoodsReceiptClient) Hooks() []Hook {
+	return c.hooks.GoodsReceipt
+}
+
+// Interceptors returns the client interceptors.
+func (c *GoodsReceiptClient) Interceptors() []Interceptor {
+	return c.inters.GoodsReceipt
+}
+
+func (c *GoodsReceiptClient) mutate(ctx context.Context, m *GoodsReceiptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GoodsReceiptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GoodsReceiptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GoodsReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GoodsReceiptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GoodsReceipt mutation op: %q", m.Op())
+	}
+}
+
+// GoodsReceiptLineClient is a client for the GoodsReceiptLine schema.
+type GoodsReceiptLineClient struct {
+	config
+}
+
+// NewGoodsReceiptLineClient returns a client for the GoodsReceiptLine from the given config.
+func NewGoodsReceiptLineClient(c config) *GoodsReceiptLineClient {
+	return &GoodsReceiptLineClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `goodsreceiptline.Hooks(f(g(h())))`.
+func (c *GoodsReceiptLineClient) Use(hooks ...Hook) {
+	c.hooks.GoodsReceiptLine = append(c.hooks.GoodsReceiptLine, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `goodsreceiptline.Intercept(f(g(h())))`.
+func (c *GoodsReceiptLineClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GoodsReceiptLine = append(c.inters.GoodsReceiptLine, interceptors...)
+}
+
+// Create returns a builder for creating a GoodsReceiptLine entity.
+func (c *GoodsReceiptLineClient) Create() *GoodsReceiptLineCreate {
+	mutation := newGoodsReceiptLineMutation(c.config, OpCreate)
+	return &GoodsReceiptLineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GoodsReceiptLine entities.
+func (c *GoodsReceiptLineClient) CreateBulk(builders ...*GoodsReceiptLineCreate) *GoodsReceiptLineCreateBulk {
+	return &GoodsReceiptLineCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GoodsReceiptLineClient) MapCreateBulk(slice any, setFunc func(*GoodsReceiptLineCreate, int)) *GoodsReceiptLineCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GoodsReceiptLineCreateBulk{err: fmt.Errorf("calling to GoodsReceiptLineClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GoodsReceiptLineCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GoodsReceiptLineCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GoodsReceiptLine.
+func (c *GoodsReceiptLineClient) Update() *GoodsReceiptLineUpdate {
+	mutation := newGoodsReceiptLineMutation(c.config, OpUpdate)
+	return &GoodsReceiptLineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GoodsReceiptLineClient) UpdateOne(_m *GoodsReceiptLine) *GoodsReceiptLineUpdateOne {
+	mutation := newGoodsReceiptLineMutation(c.config, OpUpdateOne, withGoodsReceiptLine(_m))
+	return &GoodsReceiptLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GoodsReceiptLineClient) UpdateOneID(id uuid.UUID) *GoodsReceiptLineUpdateOne {
+	mutation := newGoodsReceiptLineMutation(c.config, OpUpdateOne, withGoodsReceiptLineID(id))
+	return &GoodsReceiptLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GoodsReceiptLine.
+func (c *GoodsReceiptLineClient) Delete() *GoodsReceiptLineDelete {
+	mutation := newGoodsReceiptLineMutation(c.config, OpDelete)
+	return &GoodsReceiptLineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GoodsReceiptLineClient) DeleteOne(_m *GoodsReceiptLine) *GoodsReceiptLineDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GoodsReceiptLineClient) DeleteOneID(id uuid.UUID) *GoodsReceiptLineDeleteOne {
+	builder := c.Delete().Where(goodsreceiptline.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GoodsReceiptLineDeleteOne{builder}
+}
+
+// Query returns a query builder for GoodsReceiptLine.
+func (c *GoodsReceiptLineClient) Query() *GoodsReceiptLineQuery {
+	return &GoodsReceiptLineQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGoodsReceiptLine},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GoodsReceiptLine entity by its id.
+func (c *GoodsReceiptLineClient) Get(ctx context.Context, id uuid.UUID) (*GoodsReceiptLine, error) {
+	return c.Query().Where(goodsreceiptline.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GoodsReceiptLineClient) GetX(ctx context.Context, id uuid.UUID) *GoodsReceiptLine {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReceipt queries the receipt edge of a GoodsReceiptLine.
+func (c *GoodsReceiptLineClient) QueryReceipt(_m *GoodsReceiptLine) *GoodsReceiptQuery {
+	query := (&GoodsReceiptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(goodsreceiptline.Table, goodsreceiptline.FieldID, id),
+			sqlgraph.To(goodsreceipt.Table, goodsreceipt.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, goodsreceiptline.ReceiptTable, goodsreceiptline.ReceiptColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GoodsReceiptLineClient) Hooks() []Hook {
+	return c.hooks.GoodsReceiptLine
+}
+
+// Interceptors returns the client interceptors.
+func (c *GoodsReceiptLineClient) Interceptors() []Interceptor {
+	return c.inters.GoodsReceiptLine
+}
+
+func (c *GoodsReceiptLineClient) mutate(ctx context.Context, m *GoodsReceiptLineMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GoodsReceiptLineCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GoodsReceiptLineUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GoodsReceiptLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GoodsReceiptLineDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GoodsReceiptLine mutation op: %q", m.Op())
+	}
+}
+
+// InvoiceClient is a client for the Invoice schema.
+type InvoiceClient struct {
+	config
+}
+
+// NewInvoiceClient returns a client for the Invoice from the given config.
+func NewInvoiceClient(c config) *InvoiceClient {
+	return &InvoiceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoice.Hooks(f(g(h())))`.
+func (c *InvoiceClient) Use(hooks ...Hook) {
+	c.hooks.Invoice = append(c.hooks.Invoice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invoice.Intercept(f(g(h())))`.
+func (c *InvoiceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invoice = append(c.inters.Invoice, interceptors...)
+}
+
+// Create returns a builder for creating a Invoice entity.
+func (c *InvoiceClient) Create() *InvoiceCreate {
+	mutation := newInvoiceMutation(c.config, OpCreate)
+	return &InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Invoice entities.
+func (c *InvoiceClient) CreateBulk(builders ...*InvoiceCreate) *InvoiceCreateBulk {
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvoiceClient) MapCreateBulk(slice any, setFunc func(*InvoiceCreate, int)) *InvoiceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvoiceCreateBulk{err: fmt.Errorf("calling to InvoiceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvoiceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Invoice.
+func (c *InvoiceClient) Update() *InvoiceUpdate {
+	mutation := newInvoiceMutation(c.config, OpUpdate)
+	return &InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoiceClient) UpdateOne(_m *Invoice) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoice(_m))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvoiceClient) UpdateOneID(id uuid.UUID) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoiceID(id))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Invoice.
+func (c *InvoiceClient) Delete() *InvoiceDelete {
+	mutation := newInvoiceMutation(c.config, OpDelete)
+	return &InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoiceClient) DeleteOne(_m *Invoice) *InvoiceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvoiceClient) DeleteOneID(id uuid.UUID) *InvoiceDeleteOne {
+	builder := c.Delete().Where(invoice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvoiceDeleteOne{builder}
+}
+
+// Query returns a query builder for Invoice.
+func (c *InvoiceClient) Query() *InvoiceQuery {
+	return &InvoiceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvoice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Invoice entity by its id.
+func (c *InvoiceClient) Get(ctx context.Context, id uuid.UUID) (*Invoice, error) {
+	return c.Query().Where(invoice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceClient) GetX(ctx context.Context, id uuid.UUID) *Invoice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLines queries the lines edge of a Invoice.
+func (c *InvoiceClient) QueryLines(_m *Invoice) *InvoiceLineQuery {
+	query := (&InvoiceLineClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, id),
+			sqlgraph.To(invoiceline.Table, invoiceline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, invoice.LinesTable, invoice.LinesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceClient) Hooks() []Hook {
+	return c.hooks.Invoice
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvoiceClient) Interceptors() []Interceptor {
+	return c.inters.Invoice
+}
+
+func (c *InvoiceClient) mutate(ctx context.Context, m *InvoiceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Invoice mutation op: %q", m.Op())
+	}
+}
+
+// InvoiceLineClient is a client for the InvoiceLine schema.
+type InvoiceLineClient struct {
+	config
+}
+
+// NewInvoiceLineClient returns a client for the InvoiceLine from the given config.
+func NewInvoiceLineClient(c config) *InvoiceLineClient {
+	return &InvoiceLineClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoiceline.Hooks(f(g(h())))`.
+func (c *InvoiceLineClient) Use(hooks ...Hook) {
+	c.hooks.InvoiceLine = append(c.hooks.InvoiceLine, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invoiceline.Intercept(f(g(h())))`.
+func (c *InvoiceLineClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InvoiceLine = append(c.inters.InvoiceLine, interceptors...)
+}
+
+// Create returns a builder for creating a InvoiceLine entity.
+func (c *InvoiceLineClient) Create() *InvoiceLineCreate {
+	mutation := newInvoiceLineMutation(c.config, OpCreate)
+	return &InvoiceLineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InvoiceLine entities.
+func (c *InvoiceLineClient) CreateBulk(builders ...*InvoiceLineCreate) *InvoiceLineCreateBulk {
+	return &InvoiceLineCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvoiceLineClient) MapCreateBulk(slice any, setFunc func(*InvoiceLineCreate, int)) *InvoiceLineCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvoiceLineCreateBulk{err: fmt.Errorf("calling to InvoiceLineClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvoiceLineCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvoiceLineCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InvoiceLine.
+func (c *InvoiceLineClient) Update() *InvoiceLineUpdate {
+	mutation := newInvoiceLineMutation(c.config, OpUpdate)
+	return &InvoiceLineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoiceLineClient) UpdateOne(_m *InvoiceLine) *InvoiceLineUpdateOne {
+	mutation := newInvoiceLineMutation(c.config, OpUpdateOne, withInvoiceLine(_m))
+	return &InvoiceLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvoiceLineClient) UpdateOneID(id uuid.UUID) *InvoiceLineUpdateOne {
+	mutation := newInvoiceLineMutation(c.config, OpUpdateOne, withInvoiceLineID(id))
+	return &InvoiceLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InvoiceLine.
+func (c *InvoiceLineClient) Delete() *InvoiceLineDelete {
+	mutation := newInvoiceLineMutation(c.config, OpDelete)
+	return &InvoiceLineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoiceLineClient) DeleteOne(_m *InvoiceLine) *InvoiceLineDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvoiceLineClient) DeleteOneID(id uuid.UUID) *InvoiceLineDeleteOne {
+	builder := c.Delete().Where(invoiceline.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvoiceLineDeleteOne{builder}
+}
+
+// Query returns a query builder for InvoiceLine.
+func (c *InvoiceLineClient) Query() *InvoiceLineQuery {
+	return &InvoiceLineQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvoiceLine},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InvoiceLine entity by its id.
+func (c *InvoiceLineClient) Get(ctx context.Context, id uuid.UUID) (*InvoiceLine, error) {
+	return c.Query().Where(invoiceline.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceLineClient) GetX(ctx context.Context, id uuid.UUID) *InvoiceLine {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryInvoice queries the invoice edge of a InvoiceLine.
+func (c *InvoiceLineClient) QueryInvoice(_m *InvoiceLine) *InvoiceQuery {
+	query := (&InvoiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoiceline.Table, invoiceline.FieldID, id),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoiceline.InvoiceTable, invoiceline.InvoiceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceLineClient) Hooks() []Hook {
+	return c.hooks.InvoiceLine
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvoiceLineClient) Interceptors() []Interceptor {
+	return c.inters.InvoiceLine
+}
+
+func (c *InvoiceLineClient) mutate(ctx context.Context, m *InvoiceLineMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvoiceLineCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvoiceLineUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvoiceLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvoiceLineDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown InvoiceLine mutation op: %q", m.Op())
+	}
+}
+
+// InvoicePaymentClient is a client for the InvoicePayment schema.
+type InvoicePaymentClient struct {
+	config
+}
+
+// NewInvoicePaymentClient returns a client for the InvoicePayment from the given config.
+func NewInvoicePaymentClient(c config) *InvoicePaymentClient {
+	return &InvoicePaymentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoicepayment.Hooks(f(g(h())))`.
+func (c *InvoicePaymentClient) Use(hooks ...Hook) {
+	c.hooks.InvoicePayment = append(c.hooks.InvoicePayment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invoicepayment.Intercept(f(g(h())))`.
+func (c *InvoicePaymentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InvoicePayment = append(c.inters.InvoicePayment, interceptors...)
+}
+
+// Create returns a builder for creating a InvoicePayment entity.
+func (c *InvoicePaymentClient) Create() *InvoicePaymentCreate {
+	mutation := newInvoicePaymentMutation(c.config, OpCreate)
+	return &InvoicePaymentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InvoicePayment entities.
+func (c *InvoicePaymentClient) CreateBulk(builders ...*InvoicePaymentCreate) *InvoicePaymentCreateBulk {
+	return &InvoicePaymentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvoicePaymentClient) MapCreateBulk(slice any, setFunc func(*InvoicePaymentCreate, int)) *InvoicePaymentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvoicePaymentCreateBulk{err: fmt.Errorf("calling to InvoicePaymentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvoicePaymentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvoicePaymentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InvoicePayment.
+func (c *InvoicePaymentClient) Update() *InvoicePaymentUpdate {
+	mutation := newInvoicePaymentMutation(c.config, OpUpdate)
+	return &InvoicePaymentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoicePaymentClient) UpdateOne(_m *InvoicePayment) *InvoicePaymentUpdateOne {
+	mutation := newInvoicePaymentMutation(c.config, OpUpdateOne, withInvoicePayment(_m))
+	return &InvoicePaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvoicePaymentClient) UpdateOneID(id uuid.UUID) *InvoicePaymentUpdateOne {
+	mutation := newInvoicePaymentMutation(c.config, OpUpdateOne, withInvoicePaymentID(id))
+	return &InvoicePaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InvoicePayment.
+func (c *InvoicePaymentClient) Delete() *InvoicePaymentDelete {
+	mutation := newInvoicePaymentMutation(c.config, OpDelete)
+	return &InvoicePaymentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoicePaymentClient) DeleteOne(_m *InvoicePayment) *InvoicePaymentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvoicePaymentClient) DeleteOneID(id uuid.UUID) *InvoicePaymentDeleteOne {
+	builder := c.Delete().Where(invoicepayment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvoicePaymentDeleteOne{builder}
+}
+
+// Query returns a query builder for InvoicePayment.
+func (c *InvoicePaymentClient) Query() *InvoicePaymentQuery {
+	return &InvoicePaymentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvoicePayment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InvoicePayment entity by its id.
+func (c *InvoicePaymentClient) Get(ctx context.Context, id uuid.UUID) (*InvoicePayment, error) {
+	return c.Query().Where(invoicepayment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoicePaymentClient) GetX(ctx context.Context, id uuid.UUID) *InvoicePayment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryInvoice queries the invoice edge of a InvoicePayment.
+func (c *InvoicePaymentClient) QueryInvoice(_m *InvoicePayment) *InvoiceQuery {
+	query := (&InvoiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoicepayment.Table, invoicepayment.FieldID, id),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, invoicepayment.InvoiceTable, invoicepayment.InvoiceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPayment queries the payment edge of a InvoicePayment.
+func (c *InvoicePaymentClient) QueryPayment(_m *InvoicePayment) *PaymentTransactionQuery {
+	query := (&PaymentTransactionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoicepayment.Table, invoicepayment.FieldID, id),
+			sqlgraph.To(paymenttransaction.Table, paymenttransaction.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, invoicepayment.PaymentTable, invoicepayment.PaymentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InvoicePaymentClient) Hooks() []Hook {
+	return c.hooks.InvoicePayment
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvoicePaymentClient) Interceptors() []Interceptor {
+	return c.inters.InvoicePayment
+}
+
+func (c *InvoicePaymentClient) mutate(ctx context.Context, m *InvoicePaymentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvoicePaymentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvoicePaymentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvoicePaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvoicePaymentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown InvoicePayment mutation op: %q", m.Op())
+	}
+}
+
+// InvoiceSettingClient is a client for the InvoiceSetting schema.
+type InvoiceSettingClient struct {
+	config
+}
+
+// NewInvoiceSettingClient returns a client for the InvoiceSetting from the given config.
+func NewInvoiceSettingClient(c config) *InvoiceSettingClient {
+	return &InvoiceSettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoicesetting.Hooks(f(g(h())))`.
+func (c *InvoiceSettingClient) Use(hooks ...Hook) {
+	c.hooks.InvoiceSetting = append(c.hooks.InvoiceSetting, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invoicesetting.Intercept(f(g(h())))`.
+func (c *InvoiceSettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InvoiceSetting = append(c.inters.InvoiceSetting, interceptors...)
+}
+
+// Create returns a builder for creating a InvoiceSetting entity.
+func (c *InvoiceSettingClient) Create() *InvoiceSettingCreate {
+	mutation := newInvoiceSettingMutation(c.config, OpCreate)
+	return &InvoiceSettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InvoiceSetting entities.
+func (c *InvoiceSettingClient) CreateBulk(builders ...*InvoiceSettingCreate) *InvoiceSettingCreateBulk {
+	return &InvoiceSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvoiceSettingClient) MapCreateBulk(slice any, setFunc func(*InvoiceSettingCreate, int)) *InvoiceSettingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvoiceSettingCreateBulk{err: fmt.Errorf("calling to InvoiceSettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvoiceSettingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvoiceSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InvoiceSetting.
+func (c *InvoiceSettingClient) Update() *InvoiceSettingUpdate {
+	mutation := newInvoiceSettingMutation(c.config, OpUpdate)
+	return &InvoiceSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoiceSettingClient) UpdateOne(_m *InvoiceSetting) *InvoiceSettingUpdateOne {
+	mutation := newInvoiceSettingMutation(c.config, OpUpdateOne, withInvoiceSetting(_m))
+	return &InvoiceSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvoiceSettingClient) UpdateOneID(id uuid.UUID) *InvoiceSettingUpdateOne {
+	mutation := newInvoiceSettingMutation(c.config, OpUpdateOne, withInvoiceSettingID(id))
+	return &InvoiceSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InvoiceSetting.
+func (c *InvoiceSettingClient) Delete() *InvoiceSettingDelete {
+	mutation := newInvoiceSettingMutation(c.config, OpDelete)
+	return &InvoiceSettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoiceSettingClient) DeleteOne(_m *InvoiceSetting) *InvoiceSettingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvoiceSettingClient) DeleteOneID(id uuid.UUID) *InvoiceSettingDeleteOne {
+	builder := c.Delete().Where(invoicesetting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvoiceSettingDeleteOne{builder}
+}
+
+// Query returns a query builder for InvoiceSetting.
+func (c *InvoiceSettingClient) Query() *InvoiceSettingQuery {
+	return &InvoiceSettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvoiceSetting},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InvoiceSetting entity by its id.
+func (c *InvoiceSettingClient) Get(ctx context.Context, id uuid.UUID) (*InvoiceSetting, error) {
+	return c.Query().Where(invoicesetting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceSettingClient) GetX(ctx context.Context, id uuid.UUID) *InvoiceSetting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceSettingClient) Hooks() []Hook {
+	return c.hooks.InvoiceSetting
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvoiceSettingClient) Interceptors() []Interceptor {
+	return c.inters.InvoiceSetting
+}
+
+func (c *InvoiceSettingClient) mutate(ctx context.Context, m *InvoiceSettingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvoiceSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvoiceSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvoiceSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvoiceSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown InvoiceSetting mutation op: %q", m.Op())
+	}
+}
+
+// LedgerTransactionClient is a client for the LedgerTransaction schema.
+type LedgerTransactionClient struct {
+	config
+}
+
+// NewLedgerTransactionClient returns a client for the LedgerTransaction from the given config.
+func NewLedgerTransactionClient(c config) *LedgerTransactionClient {
+	return &LedgerTransactionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ledgertransaction.Hooks(f(g(h())))`.
+func (c *LedgerTransactionClient) Use(hooks ...Hook) {
+	c.hooks.LedgerTransaction = append(c.hooks.LedgerTransaction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ledgertransaction.Intercept(f(g(h())))`.
+func (c *LedgerTransactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LedgerTransaction = append(c.inters.LedgerTransaction, interceptors...)
+}
+
+// Create returns a builder for creating a LedgerTransaction entity.
+func (c *LedgerTransactionClient) Create() *LedgerTransactionCreate {
+	mutation := newLedgerTransactionMutation(c.config, OpCreate)
+	return &LedgerTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LedgerTransaction entities.
+func (c *LedgerTransactionClient) CreateBulk(builders ...*LedgerTransactionCreate) *LedgerTransactionCreateBulk {
+	return &LedgerTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LedgerTransactionClient) MapCreateBulk(slice any, setFunc func(*LedgerTransactionCreate, int)) *LedgerTransactionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LedgerTransactionCreateBulk{err: fmt.Errorf("calling to LedgerTransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LedgerTransactionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LedgerTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LedgerTransaction.
+func (c *LedgerTransactionClient) Update() *LedgerTransactionUpdate {
+	mutation := newLedgerTransactionMutation(c.config, OpUpdate)
+	return &LedgerTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LedgerTransactionClient) UpdateOne(_m *LedgerTransaction) *LedgerTransactionUpdateOne {
+	mutation := newLedgerTransactionMutation(c.config, OpUpdateOne, withLedgerTransaction(_m))
+	return &LedgerTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LedgerTransactionClient) UpdateOneID(id uuid.UUID) *LedgerTransactionUpdateOne {
+	mutation := newLedgerTransactionMutation(c.config, OpUpdateOne, withLedgerTransactionID(id))
+	return &LedgerTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LedgerTransaction.
+func (c *LedgerTransactionClient) Delete() *LedgerTransactionDelete {
+	mutation := newLedgerTransactionMutation(c.config, OpDelete)
+	return &LedgerTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LedgerTransactionClient) DeleteOne(_m *LedgerTransaction) *LedgerTransactionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LedgerTransactionClient) DeleteOneID(id uuid.UUID) *LedgerTransactionDeleteOne {
+	builder := c.Delete().Where(ledgertransaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LedgerTransactionDeleteOne{builder}
+}
+
+// Query returns a query builder for LedgerTransaction.
+func (c *LedgerTransactionClient) Query() *LedgerTransactionQuery {
+	return &LedgerTransactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLedgerTransaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LedgerTransaction entity by its id.
+func (c *LedgerTransactionClient) Get(ctx context.Context, id uuid.UUID) (*LedgerTransaction, error) {
+	return c.Query().Where(ledgertransaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LedgerTransactionClient) GetX(ctx context.Context, id uuid.UUID) *LedgerTransaction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a LedgerTransaction.
+func (c *LedgerTransactionClient) QueryAccount(_m *LedgerTransaction) *ChartOfAccountQuery {
+	query := (&ChartOfAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ledgertransaction.Table, ledgertransaction.FieldID, id),
+			sqlgraph.To(chartofaccount.Table, chartofaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ledgertransaction.AccountTable, ledgertransaction.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LedgerTransactionClient) Hooks() []Hook {
+	return c.hooks.LedgerTransaction
+}
+
+// Interceptors returns the client interceptors.
+func (c *LedgerTransactionClient) Interceptors() []Interceptor {
+	return c.inters.LedgerTransaction
+}
+
+func (c *LedgerTransactionClient) mutate(ctx context.Context, m *LedgerTransactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LedgerTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LedgerTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LedgerTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LedgerTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LedgerTransaction mutation op: %q", m.Op())
+	}
+}
+
+// OutboxEventClient is a client for the OutboxEvent schema.
+type OutboxEventClient struct {
+	config
+}
+
+// NewOutboxEventClient returns a client for the OutboxEvent from the given config.
+func NewOutboxEventClient(c config) *OutboxEventClient {
+	return &OutboxEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outboxevent.Hooks(f(g(h())))`.
+func (c *OutboxEventClient) Use(hooks ...Hook) {
+	c.hooks.OutboxEvent = append(c.hooks.OutboxEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outboxevent.Intercept(f(g(h())))`.
+func (c *OutboxEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutboxEvent = append(c.inters.OutboxEvent, interceptors...)
+}
+
+// Create returns a builder for creating a OutboxEvent entity.
+func (c *OutboxEventClient) Create() *OutboxEventCreate {
+	mutation := newOutboxEventMutation(c.config, OpCreate)
+	return &OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutboxEvent entities.
+func (c *OutboxEventClient) CreateBulk(builders ...*OutboxEventCreate) *OutboxEventCreateBulk {
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutboxEventClient) MapCreateBulk(slice any, setFunc func(*OutboxEventCreate, int)) *OutboxEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutboxEventCreateBulk{err: fmt.Errorf("calling to OutboxEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutboxEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutboxEvent.
+func (c *OutboxEventClient) Update() *OutboxEventUpdate {
+	mutation := newOutboxEventMutation(c.config, OpUpdate)
+	return &OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxEventClient) UpdateOne(_m *OutboxEvent) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEvent(_m))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxEventClient) UpdateOneID(id uuid.UUID) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEventID(id))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutboxEvent.
+func (c *OutboxEventClient) Delete() *OutboxEventDelete {
+	mutation := newOutboxEventMutation(c.config, OpDelete)
+	return &OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxEventClient) DeleteOne(_m *OutboxEvent) *OutboxEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxEventClient) DeleteOneID(id uuid.UUID) *OutboxEventDeleteOne {
+	builder := c.Delete().Where(outboxevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxEventDeleteOne{builder}
+}
+
+// Query returns a query builder for OutboxEvent.
+func (c *OutboxEventClient) Query() *OutboxEventQuery {
+	return &OutboxEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutboxEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OutboxEvent entity by its id.
+func (c *OutboxEventClient) Get(ctx context.Context, id uuid.UUID) (*OutboxEvent, error) {
+	return c.Query().Where(outboxevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxEventClient) GetX(ctx context.Context, id uuid.UUID) *OutboxEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxEventClient) Hooks() []Hook {
+	return c.hooks.OutboxEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxEventClient) Interceptors() []Interceptor {
+	return c.inters.OutboxEvent
+}
+
+func (c *OutboxEventClient) mutate(ctx context.Context, m *OutboxEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OutboxEvent mutation op: %q", m.Op())
+	}
+}
+
+// PayableSettingClient is a client for the PayableSetting schema.
+type PayableSettingClient struct {
+	config
+}
+
+// NewPayableSettingClient returns a client for the PayableSetting from the given config.
+func NewPayableSettingClient(c config) *PayableSettingClient {
+	return &PayableSettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `payablesetting.Hooks(f(g(h())))`.
+func (c *PayableSettingClient) Use(hooks ...Hook) {
+	c.hooks.PayableSetting = append(c.hooks.PayableSetting, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `payablesetting.Intercept(f(g(h())))`.
+func (c *PayableSettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PayableSetting = append(c.inters.PayableSetting, interceptors...)
+}
+
+// Create returns a builder for creating a PayableSetting entity.
+func (c *PayableSettingClient) Create() *PayableSettingCreate {
+	mutation := newPayableSettingMutation(c.config, OpCreate)
+	return &PayableSettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PayableSetting entities.
+func (c *PayableSettingClient) CreateBulk(builders ...*PayableSettingCreate) *PayableSettingCreateBulk {
+	return &PayableSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PayableSettingClient) MapCreateBulk(slice any, setFunc func(*PayableSettingCreate, int)) *PayableSettingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PayableSettingCreateBulk{err: fmt.Errorf("calling to PayableSettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PayableSettingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PayableSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PayableSetting.
+func (c *PayableSettingClient) Update() *PayableSettingUpdate {
+	mutation := newPayableSettingMutation(c.config, OpUpdate)
+	return &PayableSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PayableSettingClient) UpdateOne(_m *PayableSetting) *PayableSettingUpdateOne {
+	mutation := newPayableSettingMutation(c.config, OpUpdateOne, withPayableSetting(_m))
+	return &PayableSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PayableSettingClient) UpdateOneID(id uuid.UUID) *PayableSettingUpdateOne {
+	mutation := newPayableSettingMutation(c.config, OpUpdateOne, withPayableSettingID(id))
+	return &PayableSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PayableSetting.
+func (c *PayableSettingClient) Delete() *PayableSettingDelete {
+	mutation := newPayableSettingMutation(c.config, OpDelete)
+	return &PayableSettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PayableSettingClient) DeleteOne(_m *PayableSetting) *PayableSettingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PayableSettingClient) DeleteOneID(id uuid.UUID) *PayableSettingDeleteOne {
+	builder := c.Delete().Where(payablesetting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PayableSettingDeleteOne{builder}
+}
+
+// Query returns a query builder for PayableSetting.
+func (c *PayableSettingClient) Query() *PayableSettingQuery {
+	return &PayableSettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePayableSetting},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PayableSetting entity by its id.
+func (c *PayableSettingClient) Get(ctx context.Context, id uuid.UUID) (*PayableSetting, error) {
+	return c.Query().Where(payablesetting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PayableSettingClient) GetX(ctx context.Context, id uuid.UUID) *PayableSetting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PayableSettingClient) Hooks() []Hook {
+	return c.hooks.PayableSetting
+}
+
+// Interceptors returns the client interceptors.
+func (c *PayableSettingClient) Interceptors() []Interceptor {
+	return c.inters.PayableSetting
+}
+
+func (c *PayableSettingClient) mutate(ctx context.Context, m *PayableSettingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PayableSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PayableSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PayableSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PayableSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PayableSetting mutation op: %q", m.Op())
+	}
+}
+
+// PayeePayoutClient is a client for the PayeePayout schema.
+type PayeePayoutClient struct {
+	config
+}
+
+// NewPayeePayoutClient returns a client for the PayeePayout from the given config.
+func NewPayeePayoutClient(c config) *PayeePayoutClient {
+	return &PayeePayoutClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `payeepayout.Hooks(f(g(h())))`.
+func (c *PayeePayoutClient) Use(hooks ...Hook) {
+	c.hooks.PayeePayout = append(c.hooks.PayeePayout, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `payeepayout.Intercept(f(g(h())))`.
+func (c *PayeePayoutClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PayeePayout = append(c.inters.PayeePayout, interceptors...)
+}
+
+// Create returns a builder for creating a PayeePayout entity.
+func (c *PayeePayoutClient) Create() *PayeePayoutCreate {
+	mutation := newPayeePayoutMutation(c.config, OpCreate)
+	return &PayeePayoutCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PayeePayout entities.
+func (c *PayeePayoutClient) CreateBulk(builders ...*PayeePayoutCreate) *PayeePayoutCreateBulk {
+	return &PayeePayoutCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PayeePayoutClient) MapCreateBulk(slice any, setFunc func(*PayeePayoutCreate, int)) *PayeePayoutCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PayeePayoutCreateBulk{err: fmt.Errorf("calling to PayeePayoutClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PayeePayoutCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PayeePayoutCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PayeePayout.
+func (c *PayeePayoutClient) Update() *PayeePayoutUpdate {
+	mutation := newPayeePayoutMutation(c.config, OpUpdate)
+	return &PayeePayoutUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PayeePayoutClient) UpdateOne(_m *PayeePayout) *PayeePayoutUpdateOne {
+	mutation := newPayeePayoutMutation(c.config, OpUpdateOne, withPayeePayout(_m))
+	return &PayeePayoutUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PayeePayoutClient) UpdateOneID(id uuid.UUID) *PayeePayoutUpdateOne {
+	mutation := newPayeePayoutMutation(c.config, OpUpdateOne, withPayeePayoutID(id))
+	return &PayeePayoutUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PayeePayout.
+func (c *PayeePayoutClient) Delete() *PayeePayoutDelete {
+	mutation := newPayeePayoutMutation(c.config, OpDelete)
+	return &PayeePayoutDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PayeePayoutClient) DeleteOne(_m *PayeePayout) *PayeePayoutDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PayeePayoutClient) DeleteOneID(id uuid.UUID) *PayeePayoutDeleteOne {
+	builder := c.Delete().Where(payeepayout.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PayeePayoutDeleteOne{builder}
+}
+
+// Query returns a query builder for PayeePayout.
+func (c *PayeePayoutClient) Query() *PayeePayoutQuery {
+	return &PayeePayoutQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePayeePayout},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PayeePayout entity by its id.
+func (c *PayeePayoutClient) Get(ctx context.Context, id uuid.UUID) (*PayeePayout, error) {
+	return c.Query().Where(payeepayout.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PayeePayoutClient) GetX(ctx context.Context, id uuid.UUID) *PayeePayout {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PayeePayoutClient) Hooks() []Hook {
+	return c.hooks.PayeePayout
+}
+
+// Interceptors returns the client interceptors.
+func (c *PayeePayoutClient) Interceptors() []Interceptor {
+	return c.inters.PayeePayout
+}
+
+func (c *PayeePayoutClient) mutate(ctx context.Context, m *PayeePayoutMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PayeePayoutCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PayeePayoutUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PayeePayoutUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PayeePayoutDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PayeePayout mutation op: %q", m.Op())
+	}
+}
+
+// PayeeWalletClient is a client for the PayeeWallet schema.
+type PayeeWalletClient struct {
+	config
+}
+
+// NewPayeeWalletClient returns a client for the PayeeWallet from the given config.
+func NewPayeeWalletClient(c config) *PayeeWalletClient {
+	return &PayeeWalletClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `payeewallet.Hooks(f(g(h())))`.
+func (c *PayeeWalletClient) Use(hooks ...Hook) {
+	c.hooks.PayeeWallet = append(c.hooks.PayeeWallet, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `payeewallet.Intercept(f(g(h())))`.
+func (c *PayeeWalletClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PayeeWallet = append(c.inters.PayeeWallet, interceptors...)
+}
+
+// Create returns a builder for creating a PayeeWallet entity.
+func (c *PayeeWalletClient) Create() *PayeeWalletCreate {
+	mutation := newPayeeWalletMutation(c.config, OpCreate)
+	return &PayeeWalletCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PayeeWallet entities.
+func (c *PayeeWalletClient) CreateBulk(builders ...*PayeeWalletCreate) *PayeeWalletCreateBulk {
+	return &PayeeWalletCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PayeeWalletClient) MapCreateBulk(slice any, setFunc func(*PayeeWalletCreate, int)) *PayeeWalletCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PayeeWalletCreateBulk{err: fmt.Errorf("calling to PayeeWalletClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PayeeWalletCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PayeeWalletCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PayeeWallet.
+func (c *PayeeWalletClient) Update() *PayeeWalletUpdate {
+	mutation := newPayeeWalletMutation(c.config, OpUpdate)
+	return &PayeeWalletUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PayeeWalletClient) UpdateOne(_m *PayeeWallet) *PayeeWalletUpdateOne {
+	mutation := newPayeeWalletMutation(c.config, OpUpdateOne, withPayeeWallet(_m))
+	return &PayeeWalletUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PayeeWalletClient) UpdateOneID(id uuid.UUID) *PayeeWalletUpdateOne {
+	mutation := newPayeeWalletMutation(c.config, OpUpdateOne, withPayeeWalletID(id))
+	return &PayeeWalletUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PayeeWallet.
+func (c *PayeeWalletClient) Delete() *PayeeWalletDelete {
+	mutation := newPayeeWalletMutation(c.config, OpDelete)
+	return &PayeeWalletDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PayeeWalletClient) DeleteOne(_m *PayeeWallet) *PayeeWalletDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PayeeWalletClient) DeleteOneID(id uuid.UUID) *PayeeWalletDeleteOne {
+	builder := c.Delete().Where(payeewallet.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PayeeWalletDeleteOne{builder}
+}
+
+// Query returns a query builder for PayeeWallet.
+func (c *PayeeWalletClient) Query() *PayeeWalletQuery {
+	return &PayeeWalletQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePayeeWallet},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PayeeWallet entity by its id.
+func (c *PayeeWalletClient) Get(ctx context.Context, id uuid.UUID) (*PayeeWallet, error) {
+	return c.Query().Where(payeewallet.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PayeeWalletClient) GetX(ctx context.Context, id uuid.UUID) *PayeeWallet {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PayeeWalletClient) Hooks() []Hook {
+	return c.hooks.PayeeWallet
+}
+
+// Interceptors returns the client interceptors.
+func (c *PayeeWalletClient) Interceptors() []Interceptor {
+	return c.inters.PayeeWallet
+}
+
+func (c *PayeeWalletClient) mutate(ctx context.Context, m *PayeeWalletMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PayeeWalletCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PayeeWalletUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PayeeWalletUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PayeeWalletDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PayeeWallet mutation op: %q", m.Op())
+	}
+}
+
+// PayeeWalletEntryClient is a client for the PayeeWalletEntry schema.
+type PayeeWalletEntryClient struct {
+	config
+}
+
+// NewPayeeWalletEntryClient returns a client for the PayeeWalletEntry from the given config.
+func NewPayeeWalletEntryClient(c config) *PayeeWalletEntryClient {
+	return &PayeeWalletEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `payeewalletentry.Hooks(f(g(h())))`.
+func (c *PayeeWalletEntryClient) Use(hooks ...Hook) {
+	c.hooks.PayeeWalletEntry = append(c.hooks.PayeeWalletEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `payeewalletentry.Intercept(f(g(h())))`.
+func (c *PayeeWalletEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PayeeWalletEntry = append(c.inters.PayeeWalletEntry, interceptors...)
+}
+
+// Create returns a builder for creating a PayeeWalletEntry entity.
+func (c *PayeeWalletEntryClient) Create() *PayeeWalletEntryCreate {
+	mutation := newPayeeWalletEntryMutation(c.config, OpCreate)
+	return &PayeeWalletEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PayeeWalletEntry entities.
+func (c *PayeeWalletEntryClient) CreateBulk(builders ...*PayeeWalletEntryCreate) *PayeeWalletEntryCreateBulk {
+	return &PayeeWalletEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PayeeWalletEntryClient) MapCreateBulk(slice any, setFunc func(*PayeeWalletEntryCreate, int)) *PayeeWalletEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PayeeWalletEntryCreateBulk{err: fmt.Errorf("calling to PayeeWalletEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PayeeWalletEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PayeeWalletEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PayeeWalletEntry.
+func (c *PayeeWalletEntryClient) Update() *PayeeWalletEntryUpdate {
+	mutation := newPayeeWalletEntryMutation(c.config, OpUpdate)
+	return &PayeeWalletEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PayeeWalletEntryClient) UpdateOne(_m *PayeeWalletEntry) *PayeeWalletEntryUpdateOne {
+	mutation := newPayeeWalletEntryMutation(c.config, OpUpdateOne, withPayeeWalletEntry(_m))
+	return &PayeeWalletEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PayeeWalletEntryClient) UpdateOneID(id uuid.UUID) *PayeeWalletEntryUpdateOne {
+	mutation := newPayeeWalletEntryMutation(c.config, OpUpdateOne, withPayeeWalletEntryID(id))
+	return &PayeeWalletEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PayeeWalletEntry.
+func (c *PayeeWalletEntryClient) Delete() *PayeeWalletEntryDelete {
+	mutation := newPayeeWalletEntryMutation(c.config, OpDelete)
+	return &PayeeWalletEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PayeeWalletEntryClient) DeleteOne(_m *PayeeWalletEntry) *PayeeWalletEntryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PayeeWalletEntryClient) DeleteOneID(id uuid.UUID) *PayeeWalletEntryDeleteOne {
+	builder := c.Delete().Where(payeewalletentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PayeeWalletEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for PayeeWalletEntry.
+func (c *PayeeWalletEntryClient) Query() *PayeeWalletEntryQuery {
+	return &PayeeWalletEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePayeeWalletEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PayeeWalletEntry entity by its id.
+func (c *PayeeWalletEntryClient) Get(ctx context.Context, id uuid.UUID) (*PayeeWalletEntry, error) {
+	return c.Query().Where(payeewalletentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PayeeWalletEntryClient) GetX(ctx context.Context, id uuid.UUID) *PayeeWalletEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PayeeWalletEntryClient) Hooks() []Hook {
+	return c.hooks.PayeeWalletEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *PayeeWalletEntryClient) Interceptors() []Interceptor {
+	return c.inters.PayeeWalletEntry
+}
+
+func (c *PayeeWalletEntryClient) mutate(ctx context.Context, m *PayeeWalletEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PayeeWalletEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PayeeWalletEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PayeeWalletEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PayeeWalletEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PayeeWalletEntry mutation op: %q", m.Op())
+	}
+}
+
+// PaymentIntentClient is a client for the PaymentIntent schema.
+type PaymentIntentClient struct {
+	config
+}
+
+// NewPaymentIntentClient returns a client for the PaymentIntent from the given config.
+func NewPaymentIntentClient(c config) *PaymentIntentClient {
+	return &PaymentIntentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentintent.Hooks(f(g(h())))`.
+func (c *PaymentIntentClient) Use(hooks ...Hook) {
+	c.hooks.PaymentIntent = append(c.hooks.PaymentIntent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentintent.Intercept(f(g(h())))`.
+func (c *PaymentIntentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentIntent = append(c.inters.PaymentIntent, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentIntent entity.
+func (c *PaymentIntentClient) Create() *PaymentIntentCreate {
+	mutation := newPaymentIntentMutation(c.config, OpCreate)
+	return &PaymentIntentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentIntent entities.
+func (c *PaymentIntentClient) CreateBulk(builders ...*PaymentIntentCreate) *PaymentIntentCreateBulk {
+	return &PaymentIntentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentIntentClient) MapCreateBulk(slice any, setFunc func(*PaymentIntentCreate, int)) *PaymentIntentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentIntentCreateBulk{err: fmt.Errorf("calling to PaymentIntentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentIntentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentIntentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentIntent.
+func (c *PaymentIntentClient) Update() *PaymentIntentUpdate {
+	mutation := newPaymentIntentMutation(c.config, OpUpdate)
+	return &PaymentIntentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentIntentClient) UpdateOne(_m *PaymentIntent) *PaymentIntentUpdateOne {
+	mutation := newPaymentIntentMutation(c.config, OpUpdateOne, withPaymentIntent(_m))
+	return &PaymentIntentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentIntentClient) UpdateOneID(id uuid.UUID) *PaymentIntentUpdateOne {
+	mutation := newPaymentIntentMutation(c.config, OpUpdateOne, withPaymentIntentID(id))
+	return &PaymentIntentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentIntent.
+func (c *PaymentIntentClient) Delete() *PaymentIntentDelete {
+	mutation := newPaymentIntentMutation(c.config, OpDelete)
+	return &PaymentIntentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentIntentClient) DeleteOne(_m *PaymentIntent) *PaymentIntentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentIntentClient) DeleteOneID(id uuid.UUID) *PaymentIntentDeleteOne {
+	builder := c.Delete().Where(paymentintent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentIntentDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentIntent.
+func (c *PaymentIntentClient) Query() *PaymentIntentQuery {
+	return &PaymentIntentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentIntent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentIntent entity by its id.
+func (c *PaymentIntentClient) Get(ctx context.Context, id uuid.UUID) (*PaymentIntent, error) {
+	return c.Query().Where(paymentintent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentIntentClient) GetX(ctx context.Context, id uuid.UUID) *PaymentIntent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentIntentClient) Hooks() []Hook {
+	return c.hooks.PaymentIntent
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentIntentClient) Interceptors() []Interceptor {
+	return c.inters.PaymentIntent
+}
+
+func (c *PaymentIntentClient) mutate(ctx context.Context, m *PaymentIntentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentIntentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentIntentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentIntentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentIntentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaymentIntent mutation op: %q", m.Op())
+	}
+}
+
+// PaymentRunClient is a client for the PaymentRun schema.
+type PaymentRunClient struct {
+	config
+}
+
+// NewPaymentRunClient returns a client for the PaymentRun from the given config.
+func NewPaymentRunClient(c config) *PaymentRunClient {
+	return &PaymentRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentrun.Hooks(f(g(h())))`.
+func (c *PaymentRunClient) Use(hooks ...Hook) {
+	c.hooks.PaymentRun = append(c.hooks.PaymentRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentrun.Intercept(f(g(h())))`.
+func (c *PaymentRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentRun = append(c.inters.PaymentRun, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentRun entity.
+func (c *PaymentRunClient) Create() *PaymentRunCreate {
+	mutation := newPaymentRunMutation(c.config, OpCreate)
+	return &PaymentRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentRun entities.
+func (c *PaymentRunClient) CreateBulk(builders ...*PaymentRunCreate) *PaymentRunCreateBulk {
+	return &PaymentRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentRunClient) MapCreateBulk(slice any, setFunc func(*PaymentRunCreate, int)) *PaymentRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentRunCreateBulk{err: fmt.Errorf("calling to PaymentRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentRun.
+func (c *PaymentRunClient) Update() *PaymentRunUpdate {
+	mutation := newPaymentRunMutation(c.config, OpUpdate)
+	return &PaymentRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentRunClient) UpdateOne(_m *PaymentRun) *PaymentRunUpdateOne {
+	mutation := newPaymentRunMutation(c.config, OpUpdateOne, withPaymentRun(_m))
+	return &PaymentRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentRunClient) UpdateOneID(id uuid.UUID) *PaymentRunUpdateOne {
+	mutation := newPaymentRunMutation(c.config, OpUpdateOne, withPaymentRunID(id))
+	return &PaymentRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentRun.
+func (c *PaymentRunClient) Delete() *PaymentRunDelete {
+	mutation := newPaymentRunMutation(c.config, OpDelete)
+	return &PaymentRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentRunClient) DeleteOne(_m *PaymentRun) *PaymentRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentRunClient) DeleteOneID(id uuid.UUID) *PaymentRunDeleteOne {
+	builder := c.Delete().Where(paymentrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentRunDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentRun.
+func (c *PaymentRunClient) Query() *PaymentRunQuery {
+	return &PaymentRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentRun entity by its id.
+func (c *PaymentRunClient) Get(ctx context.Context, id uuid.UUID) (*PaymentRun, error) {
+	return c.Query().Where(paymentrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentRunClient) GetX(ctx context.Context, id uuid.UUID) *PaymentRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItems queries the items edge of a PaymentRun.
+func (c *PaymentRunClient) QueryItems(_m *PaymentRun) *PaymentRunItemQuery {
+	query := (&PaymentRunItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentrun.Table, paymentrun.FieldID, id),
+			sqlgraph.To(paymentrunitem.Table, paymentrunitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentrun.ItemsTable, paymentrun.ItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentRunClient) Hooks() []Hook {
+	return c.hooks.PaymentRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentRunClient) Interceptors() []Interceptor {
+	return c.inters.PaymentRun
+}
+
+func (c *PaymentRunClient) mutate(ctx context.Context, m *PaymentRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaymentRun mutation op: %q", m.Op())
+	}
+}
+
+// PaymentRunItemClient is a client for the PaymentRunItem schema.
+type PaymentRunItemClient struct {
+	config
+}
+
+// NewPaymentRunItemClient returns a client for the PaymentRunItem from the given config.
+func NewPaymentRunItemClient(c config) *PaymentRunItemClient {
+	return &PaymentRunItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentrunitem.Hooks(f(g(h())))`.
+func (c *PaymentRunItemClient) Use(hooks ...Hook) {
+	c.hooks.PaymentRunItem = append(c.hooks.PaymentRunItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentrunitem.Intercept(f(g(h())))`.
+func (c *PaymentRunItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentRunItem = append(c.inters.PaymentRunItem, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentRunItem entity.
+func (c *PaymentRunItemClient) Create() *PaymentRunItemCreate {
+	mutation := newPaymentRunItemMutation(c.config, OpCreate)
+	return &PaymentRunItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentRunItem entities.
+func (c *PaymentRunItemClient) CreateBulk(builders ...*PaymentRunItemCreate) *PaymentRunItemCreateBulk {
+	return &PaymentRunItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentRunItemClient) MapCreateBulk(slice any, setFunc func(*PaymentRunItemCreate, int)) *PaymentRunItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentRunItemCreateBulk{err: fmt.Errorf("calling to PaymentRunItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentRunItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentRunItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentRunItem.
+func (c *PaymentRunItemClient) Update() *PaymentRunItemUpdate {
+	mutation := newPaymentRunItemMutation(c.config, OpUpdate)
+	return &PaymentRunItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentRunItemClient) UpdateOne(_m *PaymentRunItem) *PaymentRunItemUpdateOne {
+	mutation := newPaymentRunItemMutation(c.config, OpUpdateOne, withPaymentRunItem(_m))
+	return &PaymentRunItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentRunItemClient) UpdateOneID(id uuid.UUID) *PaymentRunItemUpdateOne {
+	mutation := newPaymentRunItemMutation(c.config, OpUpdateOne, withPaymentRunItemID(id))
+	return &PaymentRunItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentRunItem.
+func (c *PaymentRunItemClient) Delete() *PaymentRunItemDelete {
+	mutation := newPaymentRunItemMutation(c.config, OpDelete)
+	return &PaymentRunItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentRunItemClient) DeleteOne(_m *PaymentRunItem) *PaymentRunItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentRunItemClient) DeleteOneID(id uuid.UUID) *PaymentRunItemDeleteOne {
+	builder := c.Delete().Where(paymentrunitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentRunItemDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentRunItem.
+func (c *PaymentRunItemClient) Query() *PaymentRunItemQuery {
+	return &PaymentRunItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentRunItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentRunItem entity by its id.
+func (c *PaymentRunItemClient) Get(ctx context.Context, id uuid.UUID) (*PaymentRunItem, error) {
+	return c.Query().Where(paymentrunitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentRunItemClient) GetX(ctx context.Context, id uuid.UUID) *PaymentRunItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRun queries the run edge of a PaymentRunItem.
+func (c *PaymentRunItemClient) QueryRun(_m *PaymentRunItem) *PaymentRunQuery {
+	query := (&PaymentRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentrunitem.Table, paymentrunitem.FieldID, id),
+			sqlgraph.To(paymentrun.Table, paymentrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentrunitem.RunTable, paymentrunitem.RunColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentRunItemClient) Hooks() []Hook {
+	return c.hooks.PaymentRunItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentRunItemClient) Interceptors() []Interceptor {
+	return c.inters.PaymentRunItem
+}
+
+func (c *PaymentRunItemClient) mutate(ctx context.Context, m *PaymentRunItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentRunItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentRunItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentRunItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentRunItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaymentRunItem mutation op: %q", m.Op())
+	}
+}
+
+// PaymentTransactionClient is a client for the PaymentTransaction schema.
+type PaymentTransactionClient struct {
+	config
+}
+
+// NewPaymentTransactionClient returns a client for the PaymentTransaction from the given config.
+func NewPaymentTransactionClient(c config) *PaymentTransactionClient {
+	return &PaymentTransactionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymenttransaction.Hooks(f(g(h())))`.
+func (c *PaymentTransactionClient) Use(hooks ...Hook) {
+	c.hooks.PaymentTransaction = append(c.hooks.PaymentTransaction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymenttransaction.Intercept(f(g(h())))`.
+func (c *PaymentTransactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentTransaction = append(c.inters.PaymentTransaction, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentTransaction entity.
+func (c *PaymentTransactionClient) Create() *PaymentTransactionCreate {
+	mutation := newPaymentTransactionMutation(c.config, OpCreate)
+	return &PaymentTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentTransaction entities.
+func (c *PaymentTransactionClient) CreateBulk(builders ...*PaymentTransactionCreate) *PaymentTransactionCreateBulk {
+	return &PaymentTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentTransactionClient) MapCreateBulk(slice any, setFunc func(*PaymentTransactionCreate, int)) *PaymentTransactionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentTransactionCreateBulk{err: fmt.Errorf("calling to PaymentTransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentTransactionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentTransaction.
+func (c *PaymentTransactionClient) Update() *PaymentTransactionUpdate {
+	mutation := newPaymentTransactionMutation(c.config, OpUpdate)
+	return &PaymentTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentTransactionClient) UpdateOne(_m *PaymentTransaction) *PaymentTransactionUpdateOne {
+	mutation := newPaymentTransactionMutation(c.config, OpUpdateOne, withPaymentTransaction(_m))
+	return &PaymentTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentTransactionClient) UpdateOneID(id uuid.UUID) *PaymentTransactionUpdateOne {
+	mutation := newPaymentTransactionMutation(c.config, OpUpdateOne, withPaymentTransactionID(id))
+	return &PaymentTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentTransaction.
+func (c *PaymentTransactionClient) Delete() *PaymentTransactionDelete {
+	mutation := newPaymentTransactionMutation(c.config, OpDelete)
+	return &PaymentTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentTransactionClient) DeleteOne(_m *PaymentTransaction) *PaymentTransactionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentTransactionClient) DeleteOneID(id uuid.UUID) *PaymentTransactionDeleteOne {
+	builder := c.Delete().Where(paymenttransaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentTransactionDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentTransaction.
+func (c *PaymentTransactionClient) Query() *PaymentTransactionQuery {
+	return &PaymentTransactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentTransaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentTransaction entity by its id.
+func (c *PaymentTransactionClient) Get(ctx context.Context, id uuid.UUID) (*PaymentTransaction, error) {
+	return c.Query().Where(paymenttransaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentTransactionClient) GetX(ctx context.Context, id uuid.UUID) *PaymentTransaction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentTransactionClient) Hooks() []Hook {
+	return c.hooks.PaymentTransaction
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentTransactionClient) Interceptors() []Interceptor {
+	return c.inters.PaymentTransaction
+}
+
+func (c *PaymentTransactionClient) mutate(ctx context.Context, m *PaymentTransactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaymentTransaction mutation op: %q", m.Op())
+	}
+}
+
+// ProvisionPolicyClient is a client for the ProvisionPolicy schema.
+type ProvisionPolicyClient struct {
+	config
+}
+
+// NewProvisionPolicyClient returns a client for the ProvisionPolicy from the given config.
+func NewProvisionPolicyClient(c config) *ProvisionPolicyClient {
+	return &ProvisionPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `provisionpolicy.Hooks(f(g(h())))`.
+func (c *ProvisionPolicyClient) Use(hooks ...Hook) {
+	c.hooks.ProvisionPolicy = append(c.hooks.ProvisionPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `provisionpolicy.Intercept(f(g(h())))`.
+func (c *ProvisionPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProvisionPolicy = append(c.inters.ProvisionPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a ProvisionPolicy entity.
+func (c *ProvisionPolicyClient) Create() *ProvisionPolicyCreate {
+	mutation := newProvisionPolicyMutation(c.config, OpCreate)
+	return &ProvisionPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProvisionPolicy entities.
+func (c *ProvisionPolicyClient) CreateBulk(builders ...*ProvisionPolicyCreate) *ProvisionPolicyCreateBulk {
+	return &ProvisionPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProvisionPolicyClient) MapCreateBulk(slice any, setFunc func(*ProvisionPolicyCreate, int)) *ProvisionPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProvisionPolicyCreateBulk{err: fmt.Errorf("calling to ProvisionPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProvisionPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProvisionPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProvisionPolicy.
+func (c *ProvisionPolicyClient) Update() *ProvisionPolicyUpdate {
+	mutation := newProvisionPolicyMutation(c.config, OpUpdate)
+	return &ProvisionPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProvisionPolicyClient) UpdateOne(_m *ProvisionPolicy) *ProvisionPolicyUpdateOne {
+	mutation := newProvisionPolicyMutation(c.config, OpUpdateOne, withProvisionPolicy(_m))
+	return &ProvisionPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProvisionPolicyClient) UpdateOneID(id uuid.UUID) *ProvisionPolicyUpdateOne {
+	mutation := newProvisionPolicyMutation(c.config, OpUpdateOne, withProvisionPolicyID(id))
+	return &ProvisionPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProvisionPolicy.
+func (c *ProvisionPolicyClient) Delete() *ProvisionPolicyDelete {
+	mutation := newProvisionPolicyMutation(c.config, OpDelete)
+	return &ProvisionPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProvisionPolicyClient) DeleteOne(_m *ProvisionPolicy) *ProvisionPolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProvisionPolicyClient) DeleteOneID(id uuid.UUID) *ProvisionPolicyDeleteOne {
+	builder := c.Delete().Where(provisionpolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProvisionPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for ProvisionPolicy.
+func (c *ProvisionPolicyClient) Query() *ProvisionPolicyQuery {
+	return &ProvisionPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProvisionPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProvisionPolicy entity by its id.
+func (c *ProvisionPolicyClient) Get(ctx context.Context, id uuid.UUID) (*ProvisionPolicy, error) {
+	return c.Query().Where(provisionpolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProvisionPolicyClient) GetX(ctx context.Context, id uuid.UUID) *ProvisionPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProvisionPolicyClient) Hooks() []Hook {
+	return c.hooks.ProvisionPolicy
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProvisionPolicyClient) Interceptors() []Interceptor {
+	return c.inters.ProvisionPolicy
+}
+
+func (c *ProvisionPolicyClient) mutate(ctx context.Context, m *ProvisionPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProvisionPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProvisionPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProvisionPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProvisionPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProvisionPolicy mutation op: %q", m.Op())
+	}
+}
+
+// ProvisionRunClient is a client for the ProvisionRun schema.
+type ProvisionRunClient struct {
+	config
+}
+
+// NewProvisionRunClient returns a client for the ProvisionRun from the given config.
+func NewProvisionRunClient(c config) *ProvisionRunClient {
+	return &ProvisionRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `provisionrun.Hooks(f(g(h())))`.
+func (c *ProvisionRunClient) Use(hooks ...Hook) {
+	c.hooks.ProvisionRun = append(c.hooks.ProvisionRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `provisionrun.Intercept(f(g(h())))`.
+func (c *ProvisionRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProvisionRun = append(c.inters.ProvisionRun, interceptors...)
+}
+
+// Create returns a builder for creating a ProvisionRun entity.
+func (c *ProvisionRunClient) Create() *ProvisionRunCreate {
+	mutation := newProvisionRunMutation(c.config, OpCreate)
+	return &ProvisionRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProvisionRun entities.
+func (c *ProvisionRunClient) CreateBulk(builders ...*ProvisionRunCreate) *ProvisionRunCreateBulk {
+	return &ProvisionRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProvisionRunClient) MapCreateBulk(slice any, setFunc func(*ProvisionRunCreate, int)) *ProvisionRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProvisionRunCreateBulk{err: fmt.Errorf("calling to ProvisionRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProvisionRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProvisionRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProvisionRun.
+func (c *ProvisionRunClient) Update() *ProvisionRunUpdate {
+	mutation := newProvisionRunMutation(c.config, OpUpdate)
+	return &ProvisionRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProvisionRunClient) UpdateOne(_m *ProvisionRun) *ProvisionRunUpdateOne {
+	mutation := newProvisionRunMutation(c.config, OpUpdateOne, withProvisionRun(_m))
+	return &ProvisionRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProvisionRunClient) UpdateOneID(id uuid.UUID) *ProvisionRunUpdateOne {
+	mutation := newProvisionRunMutation(c.config, OpUpdateOne, withProvisionRunID(id))
+	return &ProvisionRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProvisionRun.
+func (c *ProvisionRunClient) Delete() *ProvisionRunDelete {
+	mutation := newProvisionRunMutation(c.config, OpDelete)
+	return &ProvisionRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProvisionRunClient) DeleteOne(_m *ProvisionRun) *ProvisionRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProvisionRunClient) DeleteOneID(id uuid.UUID) *ProvisionRunDeleteOne {
+	builder := c.Delete().Where(provisionrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProvisionRunDeleteOne{builder}
+}
+
+// Query returns a query builder for ProvisionRun.
+func (c *ProvisionRunClient) Query() *ProvisionRunQuery {
+	return &ProvisionRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProvisionRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProvisionRun entity by its id.
+func (c *ProvisionRunClient) Get(ctx context.Context, id uuid.UUID) (*ProvisionRun, error) {
+	return c.Query().Where(provisionrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProvisionRunClient) GetX(ctx context.Context, id uuid.UUID) *ProvisionRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProvisionRunClient) Hooks() []Hook {
+	return c.hooks.ProvisionRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProvisionRunClient) Interceptors() []Interceptor {
+	return c.inters.ProvisionRun
+}
+
+func (c *ProvisionRunClient) mutate(ctx context.Context, m *ProvisionRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProvisionRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProvisionRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProvisionRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProvisionRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProvisionRun mutation op: %q", m.Op())
+	}
+}
+
+// ReconciliationClient is a client for the Reconciliation schema.
+type ReconciliationClient struct {
+	config
+}
+
+// NewReconciliationClient returns a client for the Reconciliation from the given config.
+func NewReconciliationClient(c config) *ReconciliationClient {
+	return &ReconciliationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reconciliation.Hooks(f(g(h())))`.
+func (c *ReconciliationClient) Use(hooks ...Hook) {
+	c.hooks.Reconciliation = append(c.hooks.Reconciliation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reconciliation.Intercept(f(g(h())))`.
+func (c *ReconciliationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Reconciliation = append(c.inters.Reconciliation, interceptors...)
+}
+
+// Create returns a builder for creating a Reconciliation entity.
+func (c *ReconciliationClient) Create() *ReconciliationCreate {
+	mutation := newReconciliationMutation(c.config, OpCreate)
+	return &ReconciliationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Reconciliation entities.
+func (c *ReconciliationClient) CreateBulk(builders ...*ReconciliationCreate) *ReconciliationCreateBulk {
+	return &ReconciliationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReconciliationClient) MapCreateBulk(slice any, setFunc func(*ReconciliationCreate, int)) *ReconciliationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReconciliationCreateBulk{err: fmt.Errorf("calling to ReconciliationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReconciliationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReconciliationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Reconciliation.
+func (c *ReconciliationClient) Update() *ReconciliationUpdate {
+	mutation := newReconciliationMutation(c.config, OpUpdate)
+	return &ReconciliationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReconciliationClient) UpdateOne(_m *Reconciliation) *ReconciliationUpdateOne {
+	mutation := newReconciliationMutation(c.config, OpUpdateOne, withReconciliation(_m))
+	return &ReconciliationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReconciliationClient) UpdateOneID(id uuid.UUID) *ReconciliationUpdateOne {
+	mutation := newReconciliationMutation(c.config, OpUpdateOne, withReconciliationID(id))
+	return &ReconciliationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Reconciliation.
+func (c *ReconciliationClient) Delete() *ReconciliationDelete {
+	mutation := newReconciliationMutation(c.config, OpDelete)
+	return &ReconciliationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReconciliationClient) DeleteOne(_m *Reconciliation) *ReconciliationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReconciliationClient) DeleteOneID(id uuid.UUID) *ReconciliationDeleteOne {
+	builder := c.Delete().Where(reconciliation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReconciliationDeleteOne{builder}
+}
+
+// Query returns a query builder for Reconciliation.
+func (c *ReconciliationClient) Query() *ReconciliationQuery {
+	return &ReconciliationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReconciliation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Reconciliation entity by its id.
+func (c *ReconciliationClient) Get(ctx context.Context, id uuid.UUID) (*Reconciliation, error) {
+	return c.Query().Where(reconciliation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReconciliationClient) GetX(ctx context.Context, id uuid.UUID) *Reconciliation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMatches queries the matches edge of a Reconciliation.
+func (c *ReconciliationClient) QueryMatches(_m *Reconciliation) *ReconciliationMatchQuery {
+	query := (&ReconciliationMatchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reconciliation.Table, reconciliation.FieldID, id),
+			sqlgraph.To(reconciliationmatch.Table, reconciliationmatch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reconciliation.MatchesTable, reconciliation.MatchesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReconciliationClient) Hooks() []Hook {
+	return c.hooks.Reconciliation
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReconciliationClient) Interceptors() []Interceptor {
+	return c.inters.Reconciliation
+}
+
+func (c *ReconciliationClient) mutate(ctx context.Context, m *ReconciliationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReconciliationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReconciliationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReconciliationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReconciliationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Reconciliation mutation op: %q", m.Op())
+	}
+}
+
+// ReconciliationMatchClient is a client for the ReconciliationMatch schema.
+type ReconciliationMatchClient struct {
+	config
+}
+
+// NewReconciliationMatchClient returns a client for the ReconciliationMatch from the given config.
+func NewReconciliationMatchClient(c config) *ReconciliationMatchClient {
+	return &ReconciliationMatchClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reconciliationmatch.Hooks(f(g(h())))`.
+func (c *ReconciliationMatchClient) Use(hooks ...Hook) {
+	c.hooks.ReconciliationMatch = append(c.hooks.ReconciliationMatch, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reconciliationmatch.Intercept(f(g(h())))`.
+func (c *ReconciliationMatchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReconciliationMatch = append(c.inters.ReconciliationMatch, interceptors...)
+}
+
+// Create returns a builder for creating a ReconciliationMatch entity.
+func (c *ReconciliationMatchClient) Create() *ReconciliationMatchCreate {
+	mutation := newReconciliationMatchMutation(c.config, OpCreate)
+	return &ReconciliationMatchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReconciliationMatch entities.
+func (c *ReconciliationMatchClient) CreateBulk(builders ...*ReconciliationMatchCreate) *ReconciliationMatchCreateBulk {
+	return &ReconciliationMatchCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReconciliationMatchClient) MapCreateBulk(slice any, setFunc func(*ReconciliationMatchCreate, int)) *ReconciliationMatchCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReconciliationMatchCreateBulk{err: fmt.Errorf("calling to ReconciliationMatchClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReconciliationMatchCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReconciliationMatchCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReconciliationMatch.
+func (c *ReconciliationMatchClient) Update() *ReconciliationMatchUpdate {
+	mutation := newReconciliationMatchMutation(c.config, OpUpdate)
+	return &ReconciliationMatchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReconciliationMatchClient) UpdateOne(_m *ReconciliationMatch) *ReconciliationMatchUpdateOne {
+	mutation := newReconciliationMatchMutation(c.config, OpUpdateOne, withReconciliationMatch(_m))
+	return &ReconciliationMatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReconciliationMatchClient) UpdateOneID(id uuid.UUID) *ReconciliationMatchUpdateOne {
+	mutation := newReconciliationMatchMutation(c.config, OpUpdateOne, withReconciliationMatchID(id))
+	return &ReconciliationMatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReconciliationMatch.
+func (c *ReconciliationMatchClient) Delete() *ReconciliationMatchDelete {
+	mutation := newReconciliationMatchMutation(c.config, OpDelete)
+	return &ReconciliationMatchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReconciliationMatchClient) DeleteOne(_m *ReconciliationMatch) *ReconciliationMatchDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReconciliationMatchClient) DeleteOneID(id uuid.UUID) *ReconciliationMatchDeleteOne {
+	builder := c.Delete().Where(reconciliationmatch.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReconciliationMatchDeleteOne{builder}
+}
+
+// Query returns a query builder for ReconciliationMatch.
+func (c *ReconciliationMatchClient) Query() *ReconciliationMatchQuery {
+	return &ReconciliationMatchQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReconciliationMatch},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReconciliationMatch entity by its id.
+func (c *ReconciliationMatchClient) Get(ctx context.Context, id uuid.UUID) (*ReconciliationMatch, error) {
+	return c.Query().Where(reconciliationmatch.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReconciliationMatchClient) GetX(ctx context.Context, id uuid.UUID) *ReconciliationMatch {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReconciliation queries the reconciliation edge of a ReconciliationMatch.
+func (c *ReconciliationMatchClient) QueryReconciliation(_m *ReconciliationMatch) *ReconciliationQuery {
+	query := (&ReconciliationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reconciliationmatch.Table, reconciliationmatch.FieldID, id),
+			sqlgraph.To(reconciliation.Table, reconciliation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reconciliationmatch.ReconciliationTable, reconciliationmatch.ReconciliationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItems queries the items edge of a ReconciliationMatch.
+func (c *ReconciliationMatchClient) QueryItems(_m *ReconciliationMatch) *ReconciliationMatchItemQuery {
+	query := (&ReconciliationMatchItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reconciliationmatch.Table, reconciliationmatch.FieldID, id),
+			sqlgraph.To(reconciliationmatchitem.Table, reconciliationmatchitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reconciliationmatch.ItemsTable, reconciliationmatch.ItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReconciliationMatchClient) Hooks() []Hook {
+	return c.hooks.ReconciliationMatch
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReconciliationMatchClient) Interceptors() []Interceptor {
+	return c.inters.ReconciliationMatch
+}
+
+func (c *ReconciliationMatchClient) mutate(ctx context.Context, m *ReconciliationMatchMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReconciliationMatchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReconciliationMatchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReconciliationMatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReconciliationMatchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReconciliationMatch mutation op: %q", m.Op())
+	}
+}
+
+// ReconciliationMatchItemClient is a client for the ReconciliationMatchItem schema.
+type ReconciliationMatchItemClient struct {
+	config
+}
+
+// NewReconciliationMatchItemClient returns a client for the ReconciliationMatchItem from the given config.
+func NewReconciliationMatchItemClient(c config) *ReconciliationMatchItemClient {
+	return &ReconciliationMatchItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reconciliationmatchitem.Hooks(f(g(h())))`.
+func (c *ReconciliationMatchItemClient) Use(hooks ...Hook) {
+	c.hooks.ReconciliationMatchItem = append(c.hooks.ReconciliationMatchItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reconciliationmatchitem.Intercept(f(g(h())))`.
+func (c *ReconciliationMatchItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReconciliationMatchItem = append(c.inters.ReconciliationMatchItem, interceptors...)
+}
+
+// Create returns a builder for creating a ReconciliationMatchItem entity.
+func (c *ReconciliationMatchItemClient) Create() *ReconciliationMatchItemCreate {
+	mutation := newReconciliationMatchItemMutation(c.config, OpCreate)
+	return &ReconciliationMatchItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReconciliationMatchItem entities.
+func (c *ReconciliationMatchItemClient) CreateBulk(builders ...*ReconciliationMatchItemCreate) *ReconciliationMatchItemCreateBulk {
+	return &ReconciliationMatchItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReconciliationMatchItemClient) MapCreateBulk(slice any, setFunc func(*ReconciliationMatchItemCreate, int)) *ReconciliationMatchItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReconciliationMatchItemCreateBulk{err: fmt.Errorf("calling to ReconciliationMatchItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReconciliationMatchItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReconciliationMatchItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReconciliationMatchItem.
+func (c *ReconciliationMatchItemClient) Update() *ReconciliationMatchItemUpdate {
+	mutation := newReconciliationMatchItemMutation(c.config, OpUpdate)
+	return &ReconciliationMatchItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReconciliationMatchItemClient) UpdateOne(_m *ReconciliationMatchItem) *ReconciliationMatchItemUpdateOne {
+	mutation := newReconciliationMatchItemMutation(c.config, OpUpdateOne, withReconciliationMatchItem(_m))
+	return &ReconciliationMatchItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReconciliationMatchItemClient) UpdateOneID(id uuid.UUID) *ReconciliationMatchItemUpdateOne {
+	mutation := newReconciliationMatchItemMutation(c.config, OpUpdateOne, withReconciliationMatchItemID(id))
+	return &ReconciliationMatchItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReconciliationMatchItem.
+func (c *ReconciliationMatchItemClient) Delete() *ReconciliationMatchItemDelete {
+	mutation := newReconciliationMatchItemMutation(c.config, OpDelete)
+	return &ReconciliationMatchItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReconciliationMatchItemClient) DeleteOne(_m *ReconciliationMatchItem) *ReconciliationMatchItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReconciliationMatchItemClient) DeleteOneID(id uuid.UUID) *ReconciliationMatchItemDeleteOne {
+	builder := c.Delete().Where(reconciliationmatchitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReconciliationMatchItemDeleteOne{builder}
+}
+
+// Query returns a query builder for ReconciliationMatchItem.
+func (c *ReconciliationMatchItemClient) Query() *ReconciliationMatchItemQuery {
+	return &ReconciliationMatchItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReconciliationMatchItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReconciliationMatchItem entity by its id.
+func (c *ReconciliationMatchItemClient) Get(ctx context.Context, id uuid.UUID) (*ReconciliationMatchItem, error) {
+	return c.Query().Where(reconciliationmatchitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReconciliationMatchItemClient) GetX(ctx context.Context, id uuid.UUID) *ReconciliationMatchItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMatch queries the match edge of a ReconciliationMatchItem.
+func (c *ReconciliationMatchItemClient) QueryMatch(_m *ReconciliationMatchItem) *ReconciliationMatchQuery {
+	query := (&ReconciliationMatchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reconciliationmatchitem.Table, reconciliationmatchitem.FieldID, id),
+			sqlgraph.To(reconciliationmatch.Table, reconciliationmatch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reconciliationmatchitem.MatchTable, reconciliationmatchitem.MatchColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReconciliationMatchItemClient) Hooks() []Hook {
+	return c.hooks.ReconciliationMatchItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReconciliationMatchItemClient) Interceptors() []Interceptor {
+	return c.inters.ReconciliationMatchItem
+}
+
+func (c *ReconciliationMatchItemClient) mutate(ctx context.Context, m *ReconciliationMatchItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReconciliationMatchItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReconciliationMatchItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReconciliationMatchItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReconciliationMatchItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReconciliationMatchItem mutation op: %q", m.Op())
+	}
+}
+
+// ReconciliationRuleClient is a client for the ReconciliationRule schema.
+type ReconciliationRuleClient struct {
+	config
+}
+
+// NewReconciliationRuleClient returns a client for the ReconciliationRule from the given config.
+func NewReconciliationRuleClient(c config) *ReconciliationRuleClient {
+	return &ReconciliationRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reconciliationrule.Hooks(f(g(h())))`.
+func (c *ReconciliationRuleClient) Use(hooks ...Hook) {
+	c.hooks.ReconciliationRule = append(c.hooks.ReconciliationRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reconciliationrule.Intercept(f(g(h())))`.
+func (c *ReconciliationRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReconciliationRule = append(c.inters.ReconciliationRule, interceptors...)
+}
+
+// Create returns a builder for creating a ReconciliationRule entity.
+func (c *ReconciliationRuleClient) Create() *ReconciliationRuleCreate {
+	mutation := newReconciliationRuleMutation(c.config, OpCreate)
+	return &ReconciliationRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReconciliationRule entities.
+func (c *ReconciliationRuleClient) CreateBulk(builders ...*ReconciliationRuleCreate) *ReconciliationRuleCreateBulk {
+	return &ReconciliationRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReconciliationRuleClient) MapCreateBulk(slice any, setFunc func(*ReconciliationRuleCreate, int)) *ReconciliationRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReconciliationRuleCreateBulk{err: fmt.Errorf("calling to ReconciliationRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReconciliationRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReconciliationRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReconciliationRule.
+func (c *ReconciliationRuleClient) Update() *ReconciliationRuleUpdate {
+	mutation := newReconciliationRuleMutation(c.config, OpUpdate)
+	return &ReconciliationRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReconciliationRuleClient) UpdateOne(_m *ReconciliationRule) *ReconciliationRuleUpdateOne {
+	mutation := newReconciliationRuleMutation(c.config, OpUpdateOne, withReconciliationRule(_m))
+	return &ReconciliationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReconciliationRuleClient) UpdateOneID(id uuid.UUID) *ReconciliationRuleUpdateOne {
+	mutation := newReconciliationRuleMutation(c.config, OpUpdateOne, withReconciliationRuleID(id))
+	return &ReconciliationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReconciliationRule.
+func (c *ReconciliationRuleClient) Delete() *ReconciliationRuleDelete {
+	mutation := newReconciliationRuleMutation(c.config, OpDelete)
+	return &ReconciliationRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReconciliationRuleClient) DeleteOne(_m *ReconciliationRule) *ReconciliationRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReconciliationRuleClient) DeleteOneID(id uuid.UUID) *ReconciliationRuleDeleteOne {
+	builder := c.Delete().Where(reconciliationrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReconciliationRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for ReconciliationRule.
+func (c *ReconciliationRuleClient) Query() *ReconciliationRuleQuery {
+	return &ReconciliationRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReconciliationRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReconciliationRule entity by its id.
+func (c *ReconciliationRuleClient) Get(ctx context.Context, id uuid.UUID) (*ReconciliationRule, error) {
+	return c.Query().Where(reconciliationrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReconciliationRuleClient) GetX(ctx context.Context, id uuid.UUID) *ReconciliationRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReconciliationRuleClient) Hooks() []Hook {
+	return c.hooks.ReconciliationRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReconciliationRuleClient) Interceptors() []Interceptor {
+	return c.inters.ReconciliationRule
+}
+
+func (c *ReconciliationRuleClient) mutate(ctx context.Context, m *ReconciliationRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReconciliationRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReconciliationRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReconciliationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReconciliationRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReconciliationRule mutation op: %q", m.Op())
+	}
+}
+
+// RolePermissionClient is a client for the RolePermission schema.
+type RolePermissionClient struct {
+	config
+}
+
+// NewRolePermissionClient returns a client for the RolePermission from the given config.
+func NewRolePermissionClient(c config) *RolePermissionClient {
+	return &RolePermissionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rolepermission.Hooks(f(g(h())))`.
+func (c *RolePermissionClient) Use(hooks ...Hook) {
+	c.hooks.RolePermission = append(c.hooks.RolePermission, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rolepermission.Intercept(f(g(h())))`.
+func (c *RolePermissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RolePermission = append(c.inters.RolePermission, interceptors...)
+}
+
+// Create returns a builder for creating a RolePermission entity.
+func (c *RolePermissionClient) Create() *RolePermissionCreate {
+	mutation := newRolePermissionMutation(c.config, OpCreate)
+	return &RolePermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RolePermission entities.
+func (c *RolePermissionClient) CreateBulk(builders ...*RolePermissionCreate) *RolePermissionCreateBulk {
+	return &RolePermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RolePermissionClient) MapCreateBulk(slice any, setFunc func(*RolePermissionCreate, int)) *RolePermissionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RolePermissionCreateBulk{err: fmt.Errorf("calling to RolePermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RolePermissionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RolePermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RolePermission.
+func (c *RolePermissionClient) Update() *RolePermissionUpdate {
+	mutation := newRolePermissionMutation(c.config, OpUpdate)
+	return &RolePermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RolePermissionClient) UpdateOne(_m *RolePermission) *RolePermissionUpdateOne {
+	mutation := newRolePermissionMutation(c.config, OpUpdateOne, withRolePermission(_m))
+	return &RolePermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RolePermissionClient) UpdateOneID(id int) *RolePermissionUpdateOne {
+	mutation := newRolePermissionMutation(c.config, OpUpdateOne, withRolePermissionID(id))
+	return &RolePermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RolePermission.
+func (c *RolePermissionClient) Delete() *RolePermissionDelete {
+	mutation := newRolePermissionMutation(c.config, OpDelete)
+	return &RolePermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RolePermissionClient) DeleteOne(_m *RolePermission) *RolePermissionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RolePermissionClient) DeleteOneID(id int) *RolePermissionDeleteOne {
+	builder := c.Delete().Where(rolepermission.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RolePermissionDeleteOne{builder}
+}
+
+// Query returns a query builder for RolePermission.
+func (c *RolePermissionClient) Query() *RolePermissionQuery {
+	return &RolePermissionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRolePermission},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RolePermission entity by its id.
+func (c *RolePermissionClient) Get(ctx context.Context, id int) (*RolePermission, error) {
+	return c.Query().Where(rolepermission.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RolePermissionClient) GetX(ctx context.Context, id int) *RolePermission {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRole queries the role edge of a RolePermission.
+func (c *RolePermissionClient) QueryRole(_m *RolePermission) *TreasuryRoleQuery {
+	query := (&TreasuryRoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolepermission.Table, rolepermission.FieldID, id),
+			sqlgraph.To(treasuryrole.Table, treasuryrole.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, rolepermission.RoleTable, rolepermission.RoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPermission queries the permission edge of a RolePermission.
+func (c *RolePermissionClient) QueryPermission(_m *RolePermission) *TreasuryPermissionQuery {
+	query := (&TreasuryPermissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolepermission.Table, rolepermission.FieldID, id),
+			sqlgraph.To(treasurypermission.Table, treasurypermission.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, rolepermission.PermissionTable, rolepermission.PermissionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RolePermissionClient) Hooks() []Hook {
+	return c.hooks.RolePermission
+}
+
+// Interceptors returns the client interceptors.
+func (c *RolePermissionClient) Interceptors() []Interceptor {
+	return c.inters.RolePermission
+}
+
+func (c *RolePermissionClient) mutate(ctx context.Context, m *RolePermissionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RolePermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RolePermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RolePermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RolePermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RolePermission mutation op: %q", m.Op())
+	}
+}
+
+// SettlementBatchClient is a client for the SettlementBatch schema.
+type SettlementBatchClient struct {
+	config
+}
+
+// NewSettlementBatchClient returns a client for the SettlementBatch from the given config.
+func NewSettlementBatchClient(c config) *SettlementBatchClient {
+	return &SettlementBatchClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `settlementbatch.Hooks(f(g(h())))`.
+func (c *SettlementBatchClient) Use(hooks ...Hook) {
+	c.hooks.SettlementBatch = append(c.hooks.SettlementBatch, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `settlementbatch.Intercept(f(g(h())))`.
+func (c *SettlementBatchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SettlementBatch = append(c.inters.SettlementBatch, interceptors...)
+}
+
+// Create returns a builder for creating a SettlementBatch entity.
+func (c *SettlementBatchClient) Create() *SettlementBatchCreate {
+	mutation := newSettlementBatchMutation(c.config, OpCreate)
+	return &SettlementBatchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SettlementBatch entities.
+func (c *SettlementBatchClient) CreateBulk(builders ...*SettlementBatchCreate) *SettlementBatchCreateBulk {
+	return &SettlementBatchCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SettlementBatchClient) MapCreateBulk(slice any, setFunc func(*SettlementBatchCreate, int)) *SettlementBatchCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SettlementBatchCreateBulk{err: fmt.Errorf("calling to SettlementBatchClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SettlementBatchCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SettlementBatchCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SettlementBatch.
+func (c *SettlementBatchClient) Update() *SettlementBatchUpdate {
+	mutation := newSettlementBatchMutation(c.config, OpUpdate)
+	return &SettlementBatchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SettlementBatchClient) UpdateOne(_m *SettlementBatch) *SettlementBatchUpdateOne {
+	mutation := newSettlementBatchMutation(c.config, OpUpdateOne, withSettlementBatch(_m))
+	return &SettlementBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SettlementBatchClient) UpdateOneID(id uuid.UUID) *SettlementBatchUpdateOne {
+	mutation := newSettlementBatchMutation(c.config, OpUpdateOne, withSettlementBatchID(id))
+	return &SettlementBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SettlementBatch.
+func (c *SettlementBatchClient) Delete() *SettlementBatchDelete {
+	mutation := newSettlementBatchMutation(c.config, OpDelete)
+	return &SettlementBatchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SettlementBatchClient) DeleteOne(_m *SettlementBatch) *SettlementBatchDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SettlementBatchClient) DeleteOneID(id uuid.UUID) *SettlementBatchDeleteOne {
+	builder := c.Delete().Where(settlementbatch.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SettlementBatchDeleteOne{builder}
+}
+
+// Query returns a query builder for SettlementBatch.
+func (c *SettlementBatchClient) Query() *SettlementBatchQuery {
+	return &SettlementBatchQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSettlementBatch},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SettlementBatch entity by its id.
+func (c *SettlementBatchClient) Get(ctx context.Context, id uuid.UUID) (*SettlementBatch, error) {
+	return c.Query().Where(settlementbatch.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SettlementBatchClient) GetX(ctx context.Context, id uuid.UUID) *SettlementBatch {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItems queries the items edge of a SettlementBatch.
+func (c *SettlementBatchClient) QueryItems(_m *SettlementBatch) *SettlementItemQuery {
+	query := (&SettlementItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlementbatch.Table, settlementbatch.FieldID, id),
+			sqlgraph.To(settlementitem.Table, settlementitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlementbatch.ItemsTable, settlementbatch.ItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SettlementBatchClient) Hooks() []Hook {
+	return c.hooks.SettlementBatch
+}
+
+// Interceptors returns the client interceptors.
+func (c *SettlementBatchClient) Interceptors() []Interceptor {
+	return c.inters.SettlementBatch
+}
+
+func (c *SettlementBatchClient) mutate(ctx context.Context, m *SettlementBatchMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SettlementBatchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SettlementBatchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SettlementBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SettlementBatchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SettlementBatch mutation op: %q", m.Op())
+	}
+}
+
+// SettlementDisbursementClient is a client for the SettlementDisbursement schema.
+type SettlementDisbursementClient struct {
+	config
+}
+
+// NewSettlementDisbursementClient returns a client for the SettlementDisbursement from the given config.
+func NewSettlementDisbursementClient(c config) *SettlementDisbursementClient {
+	return &SettlementDisbursementClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `settlementdisbursement.Hooks(f(g(h())))`.
+func (c *SettlementDisbursementClient) Use(hooks ...Hook) {
+	c.hooks.SettlementDisbursement = append(c.hooks.SettlementDisbursement, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `settlementdisbursement.Intercept(f(g(h())))`.
+func (c *SettlementDisbursementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SettlementDisbursement = append(c.inters.SettlementDisbursement, interceptors...)
+}
+
+// Create returns a builder for creating a SettlementDisbursement entity.
+func (c *SettlementDisbursementClient) Create() *SettlementDisbursementCreate {
+	mutation := newSettlementDisbursementMutation(c.config, OpCreate)
+	return &SettlementDisbursementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SettlementDisbursement entities.
+func (c *SettlementDisbursementClient) CreateBulk(builders ...*SettlementDisbursementCreate) *SettlementDisbursementCreateBulk {
+	return &SettlementDisbursementCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SettlementDisbursementClient) MapCreateBulk(slice any, setFunc func(*SettlementDisbursementCreate, int)) *SettlementDisbursementCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SettlementDisbursementCreateBulk{err: fmt.Errorf("calling to SettlementDisbursementClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SettlementDisbursementCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SettlementDisbursementCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SettlementDisbursement.
+func (c *SettlementDisbursementClient) Update() *SettlementDisbursementUpdate {
+	mutation := newSettlementDisbursementMutation(c.config, OpUpdate)
+	return &SettlementDisbursementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SettlementDisbursementClient) UpdateOne(_m *SettlementDisbursement) *SettlementDisbursementUpdateOne {
+	mutation := newSettlementDisbursementMutation(c.config, OpUpdateOne, withSettlementDisbursement(_m))
+	return &SettlementDisbursementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SettlementDisbursementClient) UpdateOneID(id uuid.UUID) *SettlementDisbursementUpdateOne {
+	mutation := newSettlementDisbursementMutation(c.config, OpUpdateOne, withSettlementDisbursementID(id))
+	return &SettlementDisbursementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SettlementDisbursement.
+func (c *SettlementDisbursementClient) Delete() *SettlementDisbursementDelete {
+	mutation := newSettlementDisbursementMutation(c.config, OpDelete)
+	return &SettlementDisbursementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SettlementDisbursementClient) DeleteOne(_m *SettlementDisbursement) *SettlementDisbursementDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SettlementDisbursementClient) DeleteOneID(id uuid.UUID) *SettlementDisbursementDeleteOne {
+	builder := c.Delete().Where(settlementdisbursement.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SettlementDisbursementDeleteOne{builder}
+}
+
+// Query returns a query builder for SettlementDisbursement.
+func (c *SettlementDisbursementClient) Query() *SettlementDisbursementQuery {
+	return &SettlementDisbursementQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSettlementDisbursement},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SettlementDisbursement entity by its id.
+func (c *SettlementDisbursementClient) Get(ctx context.Context, id uuid.UUID) (*SettlementDisbursement, error) {
+	return c.Query().Where(settlementdisbursement.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SettlementDisbursementClient) GetX(ctx context.Context, id uuid.UUID) *SettlementDisbursement {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SettlementDisbursementClient) Hooks() []Hook {
+	return c.hooks.SettlementDisbursement
+}
+
+// Interceptors returns the client interceptors.
+func (c *SettlementDisbursementClient) Interceptors() []Interceptor {
+	return c.inters.SettlementDisbursement
+}
+
+func (c *SettlementDisbursementClient) mutate(ctx context.Context, m *SettlementDisbursementMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SettlementDisbursementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SettlementDisbursementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SettlementDisbursementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SettlementDisbursementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SettlementDisbursement mutation op: %q", m.Op())
+	}
+}
+
+// SettlementItemClient is a client for the SettlementItem schema.
+type SettlementItemClient struct {
+	config
+}
+
+// NewSettlementItemClient returns a client for the SettlementItem from the given config.
+func NewSettlementItemClient(c config) *SettlementItemClient {
+	return &SettlementItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `settlementitem.Hooks(f(g(h())))`.
+func (c *SettlementItemClient) Use(hooks ...Hook) {
+	c.hooks.SettlementItem = append(c.hooks.SettlementItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `settlementitem.Intercept(f(g(h())))`.
+func (c *SettlementItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SettlementItem = append(c.inters.SettlementItem, interceptors...)
+}
+
+// Create returns a builder for creating a SettlementItem entity.
+func (c *SettlementItemClient) Create() *SettlementItemCreate {
+	mutation := newSettlementItemMutation(c.config, OpCreate)
+	return &SettlementItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SettlementItem entities.
+func (c *SettlementItemClient) CreateBulk(builders ...*SettlementItemCreate) *SettlementItemCreateBulk {
+	return &SettlementItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SettlementItemClient) MapCreateBulk(slice any, setFunc func(*SettlementItemCreate, int)) *SettlementItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SettlementItemCreateBulk{err: fmt.Errorf("calling to SettlementItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SettlementItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SettlementItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SettlementItem.
+func (c *SettlementItemClient) Update() *SettlementItemUpdate {
+	mutation := newSettlementItemMutation(c.config, OpUpdate)
+	return &SettlementItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SettlementItemClient) UpdateOne(_m *SettlementItem) *SettlementItemUpdateOne {
+	mutation := newSettlementItemMutation(c.config, OpUpdateOne, withSettlementItem(_m))
+	return &SettlementItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SettlementItemClient) UpdateOneID(id uuid.UUID) *SettlementItemUpdateOne {
+	mutation := newSettlementItemMutation(c.config, OpUpdateOne, withSettlementItemID(id))
+	return &SettlementItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SettlementItem.
+func (c *SettlementItemClient) Delete() *SettlementItemDelete {
+	mutation := newSettlementItemMutation(c.config, OpDelete)
+	return &SettlementItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SettlementItemClient) DeleteOne(_m *SettlementItem) *SettlementItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SettlementItemClient) DeleteOneID(id uuid.UUID) *SettlementItemDeleteOne {
+	builder := c.Delete().Where(settlementitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SettlementItemDeleteOne{builder}
+}
+
+// Query returns a query builder for SettlementItem.
+func (c *SettlementItemClient) Query() *SettlementItemQuery {
+	return &SettlementItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSettlementItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SettlementItem entity by its id.
+func (c *SettlementItemClient) Get(ctx context.Context, id uuid.UUID) (*SettlementItem, error) {
+	return c.Query().Where(settlementitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SettlementItemClient) GetX(ctx context.Context, id uuid.UUID) *SettlementItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBatch queries the batch edge of a SettlementItem.
+func (c *SettlementItemClient) QueryBatch(_m *SettlementItem) *SettlementBatchQuery {
+	query := (&SettlementBatchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlementitem.Table, settlementitem.FieldID, id),
+			sqlgraph.To(settlementbatch.Table, settlementbatch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, settlementitem.BatchTable, settlementitem.BatchColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SettlementItemClient) Hooks() []Hook {
+	return c.hooks.SettlementItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *SettlementItemClient) Interceptors() []Interceptor {
+	return c.inters.SettlementItem
+}
+
+func (c *SettlementItemClient) mutate(ctx context.Context, m *SettlementItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SettlementItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SettlementItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SettlementItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SettlementItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SettlementItem mutation op: %q", m.Op())
+	}
+}
+
+// SettlementSettingClient is a client for the SettlementSetting schema.
+type SettlementSettingClient struct {
+	config
+}
+
+// NewSettlementSettingClient returns a client for the SettlementSetting from the given config.
+func NewSettlementSettingClient(c config) *SettlementSettingClient {
+	return &SettlementSettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `settlementsetting.Hooks(f(g(h())))`.
+func (c *SettlementSettingClient) Use(hooks ...Hook) {
+	c.hooks.SettlementSetting = append(c.hooks.SettlementSetting, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `settlementsetting.Intercept(f(g(h())))`.
+func (c *SettlementSettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SettlementSetting = append(c.inters.SettlementSetting, interceptors...)
+}
+
+// Create returns a builder for creating a SettlementSetting entity.
+func (c *SettlementSettingClient) Create() *SettlementSettingCreate {
+	mutation := newSettlementSettingMutation(c.config, OpCreate)
+	return &SettlementSettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SettlementSetting entities.
+func (c *SettlementSettingClient) CreateBulk(builders ...*SettlementSettingCreate) *SettlementSettingCreateBulk {
+	return &SettlementSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SettlementSettingClient) MapCreateBulk(slice any, setFunc func(*SettlementSettingCreate, int)) *SettlementSettingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SettlementSettingCreateBulk{err: fmt.Errorf("calling to SettlementSettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SettlementSettingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SettlementSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SettlementSetting.
+func (c *SettlementSettingClient) Update() *SettlementSettingUpdate {
+	mutation := newSettlementSettingMutation(c.config, OpUpdate)
+	return &SettlementSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SettlementSettingClient) UpdateOne(_m *SettlementSetting) *SettlementSettingUpdateOne {
+	mutation := newSettlementSettingMutation(c.config, OpUpdateOne, withSettlementSetting(_m))
+	return &SettlementSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SettlementSettingClient) UpdateOneID(id uuid.UUID) *SettlementSettingUpdateOne {
+	mutation := newSettlementSettingMutation(c.config, OpUpdateOne, withSettlementSettingID(id))
+	return &SettlementSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SettlementSetting.
+func (c *SettlementSettingClient) Delete() *SettlementSettingDelete {
+	mutation := newSettlementSettingMutation(c.config, OpDelete)
+	return &SettlementSettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SettlementSettingClient) DeleteOne(_m *SettlementSetting) *SettlementSettingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SettlementSettingClient) DeleteOneID(id uuid.UUID) *SettlementSettingDeleteOne {
+	builder := c.Delete().Where(settlementsetting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SettlementSettingDeleteOne{builder}
+}
+
+// Query returns a query builder for SettlementSetting.
+func (c *SettlementSettingClient) Query() *SettlementSettingQuery {
+	return &SettlementSettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSettlementSetting},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SettlementSetting entity by its id.
+func (c *SettlementSettingClient) Get(ctx context.Context, id uuid.UUID) (*SettlementSetting, error) {
+	return c.Query().Where(settlementsetting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SettlementSettingClient) GetX(ctx context.Context, id uuid.UUID) *SettlementSetting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SettlementSettingClient) Hooks() []Hook {
+	return c.hooks.SettlementSetting
+}
+
+// Interceptors returns the client interceptors.
+func (c *SettlementSettingClient) Interceptors() []Interceptor {
+	return c.inters.SettlementSetting
+}
+
+func (c *SettlementSettingClient) mutate(ctx context.Context, m *SettlementSettingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SettlementSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SettlementSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SettlementSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SettlementSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SettlementSetting mutation op: %q", m.Op())
+	}
+}
+
+// SubscriptionClient is a client for the Subscription schema.
+type SubscriptionClient struct {
+	config
+}
+
+// NewSubscriptionClient returns a client for the Subscription from the given config.
+func NewSubscriptionClient(c config) *SubscriptionClient {
+	return &SubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `subscription.Hooks(f(g(h())))`.
+func (c *SubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.Subscription = append(c.hooks.Subscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `subscription.Intercept(f(g(h())))`.
+func (c *SubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Subscription = append(c.inters.Subscription, interceptors...)
+}
+
+// Create returns a builder for creating a Subscription entity.
+func (c *SubscriptionClient) Create() *SubscriptionCreate {
+	mutation := newSubscriptionMutation(c.config, OpCreate)
+	return &SubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Subscription entities.
+func (c *SubscriptionClient) CreateBulk(builders ...*SubscriptionCreate) *SubscriptionCreateBulk {
+	return &SubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SubscriptionClient) MapCreateBulk(slice any, setFunc func(*SubscriptionCreate, int)) *SubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SubscriptionCreateBulk{err: fmt.Errorf("calling to SubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Subscription.
+func (c *SubscriptionClient) Update() *SubscriptionUpdate {
+	mutation := newSubscriptionMutation(c.config, OpUpdate)
+	return &SubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SubscriptionClient) UpdateOne(_m *Subscription) *SubscriptionUpdateOne {
+	mutation := newSubscriptionMutation(c.config, OpUpdateOne, withSubscription(_m))
+	return &SubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SubscriptionClient) UpdateOneID(id uuid.UUID) *SubscriptionUpdateOne {
+	mutation := newSubscriptionMutation(c.config, OpUpdateOne, withSubscriptionID(id))
+	return &SubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Subscription.
+func (c *SubscriptionClient) Delete() *SubscriptionDelete {
+	mutation := newSubscriptionMutation(c.config, OpDelete)
+	return &SubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SubscriptionClient) DeleteOne(_m *Subscription) *SubscriptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SubscriptionClient) DeleteOneID(id uuid.UUID) *SubscriptionDeleteOne {
+	builder := c.Delete().Where(subscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for Subscription.
+func (c *SubscriptionClient) Query() *SubscriptionQuery {
+	return &SubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Subscription entity by its id.
+func (c *SubscriptionClient) Get(ctx context.Context, id uuid.UUID) (*Subscription, error) {
+	return c.Query().Where(subscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SubscriptionClient) GetX(ctx context.Context, id uuid.UUID) *Subscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBillingCycles queries the billing_cycles edge of a Subscription.
+func (c *SubscriptionClient) QueryBillingCycles(_m *Subscription) *BillingCycleQuery {
+	query := (&BillingCycleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscription.Table, subscription.FieldID, id),
+			sqlgraph.To(billingcycle.Table, billingcycle.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, subscription.BillingCyclesTable, subscription.BillingCyclesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAdjustments queries the adjustments edge of a Subscription.
+func (c *SubscriptionClient) QueryAdjustments(_m *Subscription) *SubscriptionAdjustmentQuery {
+	query := (&SubscriptionAdjustmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscription.Table, subscription.FieldID, id),
+			sqlgraph.To(subscriptionadjustment.Table, subscriptionadjustment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, subscription.AdjustmentsTable, subscription.AdjustmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMeters queries the meters edge of a Subscription.
+func (c *SubscriptionClient) QueryMeters(_m *Subscription) *SubscriptionMeterQuery {
+	query := (&SubscriptionMeterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscription.Table, subscription.FieldID, id),
+			sqlgraph.To(subscriptionmeter.Table, subscriptionmeter.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, subscription.MetersTable, subscription.MetersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SubscriptionClient) Hooks() []Hook {
+	return c.hooks.Subscription
+}
+
+// Interceptors returns the client interceptors.
+func (c *SubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.Subscription
+}
+
+func (c *SubscriptionClient) mutate(ctx context.Context, m *SubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Subscription mutation op: %q", m.Op())
+	}
+}
+
+// SubscriptionAdjustmentClient is a client for the SubscriptionAdjustment schema.
+type SubscriptionAdjustmentClient struct {
+	config
+}
+
+// NewSubscriptionAdjustmentClient returns a client for the SubscriptionAdjustment from the given config.
+func NewSubscriptionAdjustmentClient(c config) *SubscriptionAdjustmentClient {
+	return &SubscriptionAdjustmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `subscriptionadjustment.Hooks(f(g(h())))`.
+func (c *SubscriptionAdjustmentClient) Use(hooks ...Hook) {
+	c.hooks.SubscriptionAdjustment = append(c.hooks.SubscriptionAdjustment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `subscriptionadjustment.Intercept(f(g(h())))`.
+func (c *SubscriptionAdjustmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SubscriptionAdjustment = append(c.inters.SubscriptionAdjustment, interceptors...)
+}
+
+// Create returns a builder for creating a SubscriptionAdjustment entity.
+func (c *SubscriptionAdjustmentClient) Create() *SubscriptionAdjustmentCreate {
+	mutation := newSubscriptionAdjustmentMutation(c.config, OpCreate)
+	return &SubscriptionAdjustmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SubscriptionAdjustment entities.
+func (c *SubscriptionAdjustmentClient) CreateBulk(builders ...*SubscriptionAdjustmentCreate) *SubscriptionAdjustmentCreateBulk {
+	return &SubscriptionAdjustmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SubscriptionAdjustmentClient) MapCreateBulk(slice any, setFunc func(*SubscriptionAdjustmentCreate, int)) *SubscriptionAdjustmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SubscriptionAdjustmentCreateBulk{err: fmt.Errorf("calling to SubscriptionAdjustmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SubscriptionAdjustmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SubscriptionAdjustmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SubscriptionAdjustment.
+func (c *SubscriptionAdjustmentClient) Update() *SubscriptionAdjustmentUpdate {
+	mutation := newSubscriptionAdjustmentMutation(c.config, OpUpdate)
+	return &SubscriptionAdjustmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SubscriptionAdjustmentClient) UpdateOne(_m *SubscriptionAdjustment) *SubscriptionAdjustmentUpdateOne {
+	mutation := newSubscriptionAdjustmentMutation(c.config, OpUpdateOne, withSubscriptionAdjustment(_m))
+	return &SubscriptionAdjustmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SubscriptionAdjustmentClient) UpdateOneID(id uuid.UUID) *SubscriptionAdjustmentUpdateOne {
+	mutation := newSubscriptionAdjustmentMutation(c.config, OpUpdateOne, withSubscriptionAdjustmentID(id))
+	return &SubscriptionAdjustmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SubscriptionAdjustment.
+func (c *SubscriptionAdjustmentClient) Delete() *SubscriptionAdjustmentDelete {
+	mutation := newSubscriptionAdjustmentMutation(c.config, OpDelete)
+	return &SubscriptionAdjustmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SubscriptionAdjustmentClient) DeleteOne(_m *SubscriptionAdjustment) *SubscriptionAdjustmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SubscriptionAdjustmentClient) DeleteOneID(id uuid.UUID) *SubscriptionAdjustmentDeleteOne {
+	builder := c.Delete().Where(subscriptionadjustment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SubscriptionAdjustmentDeleteOne{builder}
+}
+
+// Query returns a query builder for SubscriptionAdjustment.
+func (c *SubscriptionAdjustmentClient) Query() *SubscriptionAdjustmentQuery {
+	return &SubscriptionAdjustmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSubscriptionAdjustment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SubscriptionAdjustment entity by its id.
+func (c *SubscriptionAdjustmentClient) Get(ctx context.Context, id uuid.UUID) (*SubscriptionAdjustment, error) {
+	return c.Query().Where(subscriptionadjustment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SubscriptionAdjustmentClient) GetX(ctx context.Context, id uuid.UUID) *SubscriptionAdjustment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySubscription queries the subscription edge of a SubscriptionAdjustment.
+func (c *SubscriptionAdjustmentClient) QuerySubscription(_m *SubscriptionAdjustment) *SubscriptionQuery {
+	query := (&SubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscriptionadjustment.Table, subscriptionadjustment.FieldID, id),
+			sqlgraph.To(subscription.Table, subscription.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, subscriptionadjustment.SubscriptionTable, subscriptionadjustment.SubscriptionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SubscriptionAdjustmentClient) Hooks() []Hook {
+	return c.hooks.SubscriptionAdjustment
+}
+
+// Interceptors returns the client interceptors.
+func (c *SubscriptionAdjustmentClient) Interceptors() []Interceptor {
+	return c.inters.SubscriptionAdjustment
+}
+
+func (c *SubscriptionAdjustmentClient) mutate(ctx context.Context, m *SubscriptionAdjustmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SubscriptionAdjustmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SubscriptionAdjustmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SubscriptionAdjustmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SubscriptionAdjustmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SubscriptionAdjustment mutation op: %q", m.Op())
+	}
+}
+
+// SubscriptionMeterClient is a client for the SubscriptionMeter schema.
+type SubscriptionMeterClient struct {
+	config
+}
+
+// NewSubscriptionMeterClient returns a client for the SubscriptionMeter from the given config.
+func NewSubscriptionMeterClient(c config) *SubscriptionMeterClient {
+	return &SubscriptionMeterClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `subscriptionmeter.Hooks(f(g(h())))`.
+func (c *SubscriptionMeterClient) Use(hooks ...Hook) {
+	c.hooks.SubscriptionMeter = append(c.hooks.SubscriptionMeter, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `subscriptionmeter.Intercept(f(g(h())))`.
+func (c *SubscriptionMeterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SubscriptionMeter = append(c.inters.SubscriptionMeter, interceptors...)
+}
+
+// Create returns a builder for creating a SubscriptionMeter entity.
+func (c *SubscriptionMeterClient) Create() *SubscriptionMeterCreate {
+	mutation := newSubscriptionMeterMutation(c.config, OpCreate)
+	return &SubscriptionMeterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SubscriptionMeter entities.
+func (c *SubscriptionMeterClient) CreateBulk(builders ...*SubscriptionMeterCreate) *SubscriptionMeterCreateBulk {
+	return &SubscriptionMeterCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SubscriptionMeterClient) MapCreateBulk(slice any, setFunc func(*SubscriptionMeterCreate, int)) *SubscriptionMeterCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SubscriptionMeterCreateBulk{err: fmt.Errorf("calling to SubscriptionMeterClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SubscriptionMeterCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SubscriptionMeterCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SubscriptionMeter.
+func (c *SubscriptionMeterClient) Update() *SubscriptionMeterUpdate {
+	mutation := newSubscriptionMeterMutation(c.config, OpUpdate)
+	return &SubscriptionMeterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SubscriptionMeterClient) UpdateOne(_m *SubscriptionMeter) *SubscriptionMeterUpdateOne {
+	mutation := newSubscriptionMeterMutation(c.config, OpUpdateOne, withSubscriptionMeter(_m))
+	return &SubscriptionMeterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SubscriptionMeterClient) UpdateOneID(id uuid.UUID) *SubscriptionMeterUpdateOne {
+	mutation := newSubscriptionMeterMutation(c.config, OpUpdateOne, withSubscriptionMeterID(id))
+	return &SubscriptionMeterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SubscriptionMeter.
+func (c *SubscriptionMeterClient) Delete() *SubscriptionMeterDelete {
+	mutation := newSubscriptionMeterMutation(c.config, OpDelete)
+	return &SubscriptionMeterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SubscriptionMeterClient) DeleteOne(_m *SubscriptionMeter) *SubscriptionMeterDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SubscriptionMeterClient) DeleteOneID(id uuid.UUID) *SubscriptionMeterDeleteOne {
+	builder := c.Delete().Where(subscriptionmeter.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SubscriptionMeterDeleteOne{builder}
+}
+
+// Query returns a query builder for SubscriptionMeter.
+func (c *SubscriptionMeterClient) Query() *SubscriptionMeterQuery {
+	return &SubscriptionMeterQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSubscriptionMeter},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SubscriptionMeter entity by its id.
+func (c *SubscriptionMeterClient) Get(ctx context.Context, id uuid.UUID) (*SubscriptionMeter, error) {
+	return c.Query().Where(subscriptionmeter.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SubscriptionMeterClient) GetX(ctx context.Context, id uuid.UUID) *SubscriptionMeter {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySubscription queries the subscription edge of a SubscriptionMeter.
+func (c *SubscriptionMeterClient) QuerySubscription(_m *SubscriptionMeter) *SubscriptionQuery {
+	query := (&SubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscriptionmeter.Table, subscriptionmeter.FieldID, id),
+			sqlgraph.To(subscription.Table, subscription.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, subscriptionmeter.SubscriptionTable, subscriptionmeter.SubscriptionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUsageRecords queries the usage_records edge of a SubscriptionMeter.
+func (c *SubscriptionMeterClient) QueryUsageRecords(_m *SubscriptionMeter) *UsageRecordQuery {
+	query := (&UsageRecordClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscriptionmeter.Table, subscriptionmeter.FieldID, id),
+			sqlgraph.To(usagerecord.Table, usagerecord.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, subscriptionmeter.UsageRecordsTable, subscriptionmeter.UsageRecordsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SubscriptionMeterClient) Hooks() []Hook {
+	return c.hooks.SubscriptionMeter
+}
+
+// Interceptors returns the client interceptors.
+func (c *SubscriptionMeterClient) Interceptors() []Interceptor {
+	return c.inters.SubscriptionMeter
+}
+
+func (c *SubscriptionMeterClient) mutate(ctx context.Context, m *SubscriptionMeterMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SubscriptionMeterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SubscriptionMeterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SubscriptionMeterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SubscriptionMeterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SubscriptionMeter mutation op: %q", m.Op())
+	}
+}
+
+// TreasuryPermissionClient is a client for the TreasuryPermission schema.
+type TreasuryPermissionClient struct {
+	config
+}
+
+// NewTreasuryPermissionClient returns a client for the TreasuryPermission from the given config.
+func NewTreasuryPermissionClient(c config) *TreasuryPermissionClient {
+	return &TreasuryPermissionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `treasurypermission.Hooks(f(g(h())))`.
+func (c *TreasuryPermissionClient) Use(hooks ...Hook) {
+	c.hooks.TreasuryPermission = append(c.hooks.TreasuryPermission, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `treasurypermission.Intercept(f(g(h())))`.
+func (c *TreasuryPermissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TreasuryPermission = append(c.inters.TreasuryPermission, interceptors...)
+}
+
+// Create returns a builder for creating a TreasuryPermission entity.
+func (c *TreasuryPermissionClient) Create() *TreasuryPermissionCreate {
+	mutation := newTreasuryPermissionMutation(c.config, OpCreate)
+	return &TreasuryPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TreasuryPermission entities.
+func (c *TreasuryPermissionClient) CreateBulk(builders ...*TreasuryPermissionCreate) *TreasuryPermissionCreateBulk {
+	return &TreasuryPermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TreasuryPermissionClient) MapCreateBulk(slice any, setFunc func(*TreasuryPermissionCreate, int)) *TreasuryPermissionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TreasuryPermissionCreateBulk{err: fmt.Errorf("calling to TreasuryPermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TreasuryPermissionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TreasuryPermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TreasuryPermission.
+func (c *TreasuryPermissionClient) Update() *TreasuryPermissionUpdate {
+	mutation := newTreasuryPermissionMutation(c.config, OpUpdate)
+	return &TreasuryPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TreasuryPermissionClient) UpdateOne(_m *TreasuryPermission) *TreasuryPermissionUpdateOne {
+	mutation := newTreasuryPermissionMutation(c.config, OpUpdateOne, withTreasuryPermission(_m))
+	return &TreasuryPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TreasuryPermissionClient) UpdateOneID(id uuid.UUID) *TreasuryPermissionUpdateOne {
+	mutation := newTreasuryPermissionMutation(c.config, OpUpdateOne, withTreasuryPermissionID(id))
+	return &TreasuryPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TreasuryPermission.
+func (c *TreasuryPermissionClient) Delete() *TreasuryPermissionDelete {
+	mutation := newTreasuryPermissionMutation(c.config, OpDelete)
+	return &TreasuryPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TreasuryPermissionClient) DeleteOne(_m *TreasuryPermission) *TreasuryPermissionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TreasuryPermissionClient) DeleteOneID(id uuid.UUID) *TreasuryPermissionDeleteOne {
+	builder := c.Delete().Where(treasurypermission.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TreasuryPermissionDeleteOne{builder}
+}
+
+// Query returns a query builder for TreasuryPermission.
+func (c *TreasuryPermissionClient) Query() *TreasuryPermissionQuery {
+	return &TreasuryPermissionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTreasuryPermission},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TreasuryPermission entity by its id.
+func (c *TreasuryPermissionClient) Get(ctx context.Context, id uuid.UUID) (*TreasuryPermission, error) {
+	return c.Query().Where(treasurypermission.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TreasuryPermissionClient) GetX(ctx context.Context, id uuid.UUID) *TreasuryPermission {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRoles queries the roles edge of a TreasuryPermission.
+func (c *TreasuryPermissionClient) QueryRoles(_m *TreasuryPermission) *TreasuryRoleQuery {
+	query := (&TreasuryRoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(treasurypermission.Table, treasurypermission.FieldID, id),
+			sqlgraph.To(treasuryrole.Table, treasuryrole.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, treasurypermission.RolesTable, treasurypermission.RolesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRolePermissions queries the role_permissions edge of a TreasuryPermission.
+func (c *TreasuryPermissionClient) QueryRolePermissions(_m *TreasuryPermission) *RolePermissionQuery {
+	query := (&RolePermissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(treasurypermission.Table, treasurypermission.FieldID, id),
+			sqlgraph.To(rolepermission.Table, rolepermission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, treasurypermission.RolePermissionsTable, treasurypermission.RolePermissionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TreasuryPermissionClient) Hooks() []Hook {
+	return c.hooks.TreasuryPermission
+}
+
+// Interceptors returns the client interceptors.
+func (c *TreasuryPermissionClient) Interceptors() []Interceptor {
+	return c.inters.TreasuryPermission
+}
+
+func (c *TreasuryPermissionClient) mutate(ctx context.Context, m *TreasuryPermissionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TreasuryPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TreasuryPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TreasuryPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TreasuryPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TreasuryPermission mutation op: %q", m.Op())
+	}
+}
+
+// TreasuryRoleClient is a client for the TreasuryRole schema.
+type TreasuryRoleClient struct {
+	config
+}
+
+// NewTreasuryRoleClient returns a client for the TreasuryRole from the given config.
+func NewTreasuryRoleClient(c config) *TreasuryRoleClient {
+	return &TreasuryRoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `treasuryrole.Hooks(f(g(h())))`.
+func (c *TreasuryRoleClient) Use(hooks ...Hook) {
+	c.hooks.TreasuryRole = append(c.hooks.TreasuryRole, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `treasuryrole.Intercept(f(g(h())))`.
+func (c *TreasuryRoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TreasuryRole = append(c.inters.TreasuryRole, interceptors...)
+}
+
+// Create returns a builder for creating a TreasuryRole entity.
+func (c *TreasuryRoleClient) Create() *TreasuryRoleCreate {
+	mutation := newTreasuryRoleMutation(c.config, OpCreate)
+	return &TreasuryRoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TreasuryRole entities.
+func (c *TreasuryRoleClient) CreateBulk(builders ...*TreasuryRoleCreate) *TreasuryRoleCreateBulk {
+	return &TreasuryRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TreasuryRoleClient) MapCreateBulk(slice any, setFunc func(*TreasuryRoleCreate, int)) *TreasuryRoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TreasuryRoleCreateBulk{err: fmt.Errorf("calling to TreasuryRoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TreasuryRoleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TreasuryRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TreasuryRole.
+func (c *TreasuryRoleClient) Update() *TreasuryRoleUpdate {
+	mutation := newTreasuryRoleMutation(c.config, OpUpdate)
+	return &TreasuryRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TreasuryRoleClient) UpdateOne(_m *TreasuryRole) *TreasuryRoleUpdateOne {
+	mutation := newTreasuryRoleMutation(c.config, OpUpdateOne, withTreasuryRole(_m))
+	return &TreasuryRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TreasuryRoleClient) UpdateOneID(id uuid.UUID) *TreasuryRoleUpdateOne {
+	mutation := newTreasuryRoleMutation(c.config, OpUpdateOne, withTreasuryRoleID(id))
+	return &TreasuryRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TreasuryRole.
+func (c *TreasuryRoleClient) Delete() *TreasuryRoleDelete {
+	mutation := newTreasuryRoleMutation(c.config, OpDelete)
+	return &TreasuryRoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TreasuryRoleClient) DeleteOne(_m *TreasuryRole) *TreasuryRoleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TreasuryRoleClient) DeleteOneID(id uuid.UUID) *TreasuryRoleDeleteOne {
+	builder := c.Delete().Where(treasuryrole.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TreasuryRoleDeleteOne{builder}
+}
+
+// Query returns a query builder for TreasuryRole.
+func (c *TreasuryRoleClient) Query() *TreasuryRoleQuery {
+	return &TreasuryRoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTreasuryRole},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TreasuryRole entity by its id.
+func (c *TreasuryRoleClient) Get(ctx context.Context, id uuid.UUID) (*TreasuryRole, error) {
+	return c.Query().Where(treasuryrole.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TreasuryRoleClient) GetX(ctx context.Context, id uuid.UUID) *TreasuryRole {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPermissions queries the permissions edge of a TreasuryRole.
+func (c *TreasuryRoleClient) QueryPermissions(_m *TreasuryRole) *TreasuryPermissionQuery {
+	query := (&TreasuryPermissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(treasuryrole.Table, treasuryrole.FieldID, id),
+			sqlgraph.To(treasurypermission.Table, treasurypermission.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, treasuryrole.PermissionsTable, treasuryrole.PermissionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUserAssignments queries the user_assignments edge of a TreasuryRole.
+func (c *TreasuryRoleClient) QueryUserAssignments(_m *TreasuryRole) *UserRoleAssignmentQuery {
+	query := (&UserRoleAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(treasuryrole.Table, treasuryrole.FieldID, id),
+			sqlgraph.To(userroleassignment.Table, userroleassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, treasuryrole.UserAssignmentsTable, treasuryrole.UserAssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRolePermissions queries the role_permissions edge of a TreasuryRole.
+func (c *TreasuryRoleClient) QueryRolePermissions(_m *TreasuryRole) *RolePermissionQuery {
+	query := (&RolePermissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(treasuryrole.Table, treasuryrole.FieldID, id),
+			sqlgraph.To(rolepermission.Table, rolepermission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, treasuryrole.RolePermissionsTable, treasuryrole.RolePermissionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TreasuryRoleClient) Hooks() []Hook {
+	return c.hooks.TreasuryRole
+}
+
+// Interceptors returns the client interceptors.
+func (c *TreasuryRoleClient) Interceptors() []Interceptor {
+	return c.inters.TreasuryRole
+}
+
+func (c *TreasuryRoleClient) mutate(ctx context.Context, m *TreasuryRoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TreasuryRoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TreasuryRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TreasuryRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TreasuryRoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TreasuryRole mutation op: %q", m.Op())
+	}
+}
+
+// TreasuryUserClient is a client for the TreasuryUser schema.
+type TreasuryUserClient struct {
+	config
+}
+
+// NewTreasuryUserClient returns a client for the TreasuryUser from the given config.
+func NewTreasuryUserClient(c config) *TreasuryUserClient {
+	return &TreasuryUserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `treasuryuser.Hooks(f(g(h())))`.
+func (c *TreasuryUserClient) Use(hooks ...Hook) {
+	c.hooks.TreasuryUser = append(c.hooks.TreasuryUser, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `treasuryuser.Intercept(f(g(h())))`.
+func (c *TreasuryUserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TreasuryUser = append(c.inters.TreasuryUser, interceptors...)
+}
+
+// Create returns a builder for creating a TreasuryUser entity.
+func (c *TreasuryUserClient) Create() *TreasuryUserCreate {
+	mutation := newTreasuryUserMutation(c.config, OpCreate)
+	return &TreasuryUserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TreasuryUser entities.
+func (c *TreasuryUserClient) CreateBulk(builders ...*TreasuryUserCreate) *TreasuryUserCreateBulk {
+	return &TreasuryUserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TreasuryUserClient) MapCreateBulk(slice any, setFunc func(*TreasuryUserCreate, int)) *TreasuryUserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TreasuryUserCreateBulk{err: fmt.Errorf("calling to TreasuryUserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TreasuryUserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TreasuryUserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TreasuryUser.
+func (c *TreasuryUserClient) Update() *TreasuryUserUpdate {
+	mutation := newTreasuryUserMutation(c.config, OpUpdate)
+	return &TreasuryUserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TreasuryUserClient) UpdateOne(_m *TreasuryUser) *TreasuryUserUpdateOne {
+	mutation := newTreasuryUserMutation(c.config, OpUpdateOne, withTreasuryUser(_m))
+	return &TreasuryUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TreasuryUserClient) UpdateOneID(id uuid.UUID) *TreasuryUserUpdateOne {
+	mutation := newTreasuryUserMutation(c.config, OpUpdateOne, withTreasuryUserID(id))
+	return &TreasuryUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TreasuryUser.
+func (c *TreasuryUserClient) Delete() *TreasuryUserDelete {
+	mutation := newTreasuryUserMutation(c.config, OpDelete)
+	return &TreasuryUserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TreasuryUserClient) DeleteOne(_m *TreasuryUser) *TreasuryUserDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TreasuryUserClient) DeleteOneID(id uuid.UUID) *TreasuryUserDeleteOne {
+	builder := c.Delete().Where(treasuryuser.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TreasuryUserDeleteOne{builder}
+}
+
+// Query returns a query builder for TreasuryUser.
+func (c *TreasuryUserClient) Query() *TreasuryUserQuery {
+	return &TreasuryUserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTreasuryUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TreasuryUser entity by its id.
+func (c *TreasuryUserClient) Get(ctx context.Context, id uuid.UUID) (*TreasuryUser, error) {
+	return c.Query().Where(treasuryuser.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TreasuryUserClient) GetX(ctx context.Context, id uuid.UUID) *TreasuryUser {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TreasuryUserClient) Hooks() []Hook {
+	return c.hooks.TreasuryUser
+}
+
+// Interceptors returns the client interceptors.
+func (c *TreasuryUserClient) Interceptors() []Interceptor {
+	return c.inters.TreasuryUser
+}
+
+func (c *TreasuryUserClient) mutate(ctx context.Context, m *TreasuryUserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TreasuryUserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TreasuryUserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TreasuryUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TreasuryUserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TreasuryUser mutation op: %q", m.Op())
+	}
+}
+
+// UsageRecordClient is a client for the UsageRecord schema.
+type UsageRecordClient struct {
+	config
+}
+
+// NewUsageRecordClient returns a client for the UsageRecord from the given config.
+func NewUsageRecordClient(c config) *UsageRecordClient {
+	return &UsageRecordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usagerecord.Hooks(f(g(h())))`.
+func (c *UsageRecordClient) Use(hooks ...Hook) {
+	c.hooks.UsageRecord = append(c.hooks.UsageRecord, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usagerecord.Intercept(f(g(h())))`.
+func (c *UsageRecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UsageRecord = append(c.inters.UsageRecord, interceptors...)
+}
+
+// Create returns a builder for creating a UsageRecord entity.
+func (c *UsageRecordClient) Create() *UsageRecordCreate {
+	mutation := newUsageRecordMutation(c.config, OpCreate)
+	return &UsageRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UsageRecord entities.
+func (c *UsageRecordClient) CreateBulk(builders ...*UsageRecordCreate) *UsageRecordCreateBulk {
+	return &UsageRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UsageRecordClient) MapCreateBulk(slice any, setFunc func(*UsageRecordCreate, int)) *UsageRecordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UsageRecordCreateBulk{err: fmt.Errorf("calling to UsageRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UsageRecordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UsageRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UsageRecord.
+func (c *UsageRecordClient) Update() *UsageRecordUpdate {
+	mutation := newUsageRecordMutation(c.config, OpUpdate)
+	return &UsageRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UsageRecordClient) UpdateOne(_m *UsageRecord) *UsageRecordUpdateOne {
+	mutation := newUsageRecordMutation(c.config, OpUpdateOne, withUsageRecord(_m))
+	return &UsageRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UsageRecordClient) UpdateOneID(id uuid.UUID) *UsageRecordUpdateOne {
+	mutation := newUsageRecordMutation(c.config, OpUpdateOne, withUsageRecordID(id))
+	return &UsageRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UsageRecord.
+func (c *UsageRecordClient) Delete() *UsageRecordDelete {
+	mutation := newUsageRecordMutation(c.config, OpDelete)
+	return &UsageRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UsageRecordClient) DeleteOne(_m *UsageRecord) *UsageRecordDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UsageRecordClient) DeleteOneID(id uuid.UUID) *UsageRecordDeleteOne {
+	builder := c.Delete().Where(usagerecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UsageRecordDeleteOne{builder}
+}
+
+// Query returns a query builder for UsageRecord.
+func (c *UsageRecordClient) Query() *UsageRecordQuery {
+	return &UsageRecordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUsageRecord},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UsageRecord entity by its id.
+func (c *UsageRecordClient) Get(ctx context.Context, id uuid.UUID) (*UsageRecord, error) {
+	return c.Query().Where(usagerecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UsageRecordClient) GetX(ctx context.Context, id uuid.UUID) *UsageRecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMeter queries the meter edge of a UsageRecord.
+func (c *UsageRecordClient) QueryMeter(_m *UsageRecord) *SubscriptionMeterQuery {
+	query := (&SubscriptionMeterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(usagerecord.Table, usagerecord.FieldID, id),
+			sqlgraph.To(subscriptionmeter.Table, subscriptionmeter.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, usagerecord.MeterTable, usagerecord.MeterColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UsageRecordClient) Hooks() []Hook {
+	return c.hooks.UsageRecord
+}
+
+// Interceptors returns the client interceptors.
+func (c *UsageRecordClient) Interceptors() []Interceptor {
+	return c.inters.UsageRecord
+}
+
+func (c *UsageRecordClient) mutate(ctx context.Context, m *UsageRecordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UsageRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UsageRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UsageRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UsageRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UsageRecord mutation op: %q", m.Op())
+	}
+}
+
+// UserRoleAssignmentClient is a client for the UserRoleAssignment schema.
+type UserRoleAssignmentClient struct {
+	config
+}
+
+// NewUserRoleAssignmentClient returns a client for the UserRoleAssignment from the given config.
+func NewUserRoleAssignmentClient(c config) *UserRoleAssignmentClient {
+	return &UserRoleAssignmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userroleassignment.Hooks(f(g(h())))`.
+func (c *UserRoleAssignmentClient) Use(hooks ...Hook) {
+	c.hooks.UserRoleAssignment = append(c.hooks.UserRoleAssignment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userroleassignment.Intercept(f(g(h())))`.
+func (c *UserRoleAssignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserRoleAssignment = append(c.inters.UserRoleAssignment, interceptors...)
+}
+
+// Create returns a builder for creating a UserRoleAssignment entity.
+func (c *UserRoleAssignmentClient) Create() *UserRoleAssignmentCreate {
+	mutation := newUserRoleAssignmentMutation(c.config, OpCreate)
+	return &UserRoleAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserRoleAssignment entities.
+func (c *UserRoleAssignmentClient) CreateBulk(builders ...*UserRoleAssignmentCreate) *UserRoleAssignmentCreateBulk {
+	return &UserRoleAssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserRoleAssignmentClient) MapCreateBulk(slice any, setFunc func(*UserRoleAssignmentCreate, int)) *UserRoleAssignmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserRoleAssignmentCreateBulk{err: fmt.Errorf("calling to UserRoleAssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserRoleAssignmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserRoleAssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserRoleAssignment.
+func (c *UserRoleAssignmentClient) Update() *UserRoleAssignmentUpdate {
+	mutation := newUserRoleAssignmentMutation(c.config, OpUpdate)
+	return &UserRoleAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserRoleAssignmentClient) UpdateOne(_m *UserRoleAssignment) *UserRoleAssignmentUpdateOne {
+	mutation := newUserRoleAssignmentMutation(c.config, OpUpdateOne, withUserRoleAssignment(_m))
+	return &UserRoleAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserRoleAssignmentClient) UpdateOneID(id uuid.UUID) *UserRoleAssignmentUpdateOne {
+	mutation := newUserRoleAssignmentMutation(c.config, OpUpdateOne, withUserRoleAssignmentID(id))
+	return &UserRoleAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserRoleAssignment.
+func (c *UserRoleAssignmentClient) Delete() *UserRoleAssignmentDelete {
+	mutation := newUserRoleAssignmentMutation(c.config, OpDelete)
+	return &UserRoleAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserRoleAssignmentClient) DeleteOne(_m *UserRoleAssignment) *UserRoleAssignmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserRoleAssignmentClient) DeleteOneID(id uuid.UUID) *UserRoleAssignmentDeleteOne {
+	builder := c.Delete().Where(userroleassignment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserRoleAssignmentDeleteOne{builder}
+}
+
+// Query returns a query builder for UserRoleAssignment.
+func (c *UserRoleAssignmentClient) Query() *UserRoleAssignmentQuery {
+	return &UserRoleAssignmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserRoleAssignment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserRoleAssignment entity by its id.
+func (c *UserRoleAssignmentClient) Get(ctx context.Context, id uuid.UUID) (*UserRoleAssignment, error) {
+	return c.Query().Where(userroleassignment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserRoleAssignmentClient) GetX(ctx context.Context, id uuid.UUID) *UserRoleAssignment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UserRoleAssignment.
+func (c *UserRoleAssignmentClient) QueryUser(_m *UserRoleAssignment) *TreasuryUserQuery {
+	query := (&TreasuryUserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userroleassignment.Table, userroleassignment.FieldID, id),
+			sqlgraph.To(treasuryuser.Table, treasuryuser.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, userroleassignment.UserTable, userroleassignment.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRole queries the role edge of a UserRoleAssignment.
+func (c *UserRoleAssignmentClient) QueryRole(_m *UserRoleAssignment) *TreasuryRoleQuery {
+	query := (&TreasuryRoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userroleassignment.Table, userroleassignment.FieldID, id),
+			sqlgraph.To(treasuryrole.Table, treasuryrole.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, userroleassignment.RoleTable, userroleassignment.RoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserRoleAssignmentClient) Hooks() []Hook {
+	return c.hooks.UserRoleAssignment
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserRoleAssignmentClient) Interceptors() []Interceptor {
+	return c.inters.UserRoleAssignment
+}
+
+func (c *UserRoleAssignmentClient) mutate(ctx context.Context, m *UserRoleAssignmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserRoleAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserRoleAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserRoleAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserRoleAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserRoleAssignment mutation op: %q", m.Op())
+	}
+}
+
+// VendorClient is a client for the Vendor schema.
+type VendorClient struct {
+	config
+}
+
+// NewVendorClient returns a client for the Vendor from the given config.
+func NewVendorClient(c config) *VendorClient {
+	return &VendorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vendor.Hooks(f(g(h())))`.
+func (c *VendorClient) Use(hooks ...Hook) {
+	c.hooks.Vendor = append(c.hooks.Vendor, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vendor.Intercept(f(g(h())))`.
+func (c *VendorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Vendor = append(c.inters.Vendor, interceptors...)
+}
+
+// Create returns a builder for creating a Vendor entity.
+func (c *VendorClient) Create() *VendorCreate {
+	mutation := newVendorMutation(c.config, OpCreate)
+	return &VendorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Vendor entities.
+func (c *VendorClient) CreateBulk(builders ...*VendorCreate) *VendorCreateBulk {
+	return &VendorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VendorClient) MapCreateBulk(slice any, setFunc func(*VendorCreate, int)) *VendorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VendorCreateBulk{err: fmt.Errorf("calling to VendorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VendorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VendorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Vendor.
+func (c *VendorClient) Update() *VendorUpdate {
+	mutation := newVendorMutation(c.config, OpUpdate)
+	return &VendorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VendorClient) UpdateOne(_m *Vendor) *VendorUpdateOne {
+	mutation := newVendorMutation(c.config, OpUpdateOne, withVendor(_m))
+	return &VendorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VendorClient) UpdateOneID(id uuid.UUID) *VendorUpdateOne {
+	mutation := newVendorMutation(c.config, OpUpdateOne, withVendorID(id))
+	return &VendorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Vendor.
+func (c *VendorClient) Delete() *VendorDelete {
+	mutation := newVendorMutation(c.config, OpDelete)
+	return &VendorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VendorClient) DeleteOne(_m *Vendor) *VendorDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VendorClient) DeleteOneID(id uuid.UUID) *VendorDeleteOne {
+	builder := c.Delete().Where(vendor.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VendorDeleteOne{builder}
+}
+
+// Query returns a query builder for Vendor.
+func (c *VendorClient) Query() *VendorQuery {
+	return &VendorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVendor},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Vendor entity by its id.
+func (c *VendorClient) Get(ctx context.Context, id uuid.UUID) (*Vendor, error) {
+	return c.Query().Where(vendor.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VendorClient) GetX(ctx context.Context, id uuid.UUID) *Vendor {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *VendorClient) Hooks() []Hook {
+	return c.hooks.Vendor
+}
+
+// Interceptors returns the client interceptors.
+func (c *VendorClient) Interceptors() []Interceptor {
+	return c.inters.Vendor
+}
+
+func (c *VendorClient) mutate(ctx context.Context, m *VendorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VendorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VendorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VendorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VendorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Vendor mutation op: %q", m.Op())
+	}
+}
+
+// VendorBillClient is a client for the VendorBill schema.
+type VendorBillClient struct {
+	config
+}
+
+// NewVendorBillClient returns a client for the VendorBill from the given config.
+func NewVendorBillClient(c config) *VendorBillClient {
+	return &VendorBillClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vendorbill.Hooks(f(g(h())))`.
+func (c *VendorBillClient) Use(hooks ...Hook) {
+	c.hooks.VendorBill = append(c.hooks.VendorBill, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vendorbill.Intercept(f(g(h())))`.
+func (c *VendorBillClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VendorBill = append(c.inters.VendorBill, interceptors...)
+}
+
+// Create returns a builder for creating a VendorBill entity.
+func (c *VendorBillClient) Create() *VendorBillCreate {
+	mutation := newVendorBillMutation(c.config, OpCreate)
+	return &VendorBillCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VendorBill entities.
+func (c *VendorBillClient) CreateBulk(builders ...*VendorBillCreate) *VendorBillCreateBulk {
+	return &VendorBillCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VendorBillClient) MapCreateBulk(slice any, setFunc func(*VendorBillCreate, int)) *VendorBillCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VendorBillCreateBulk{err: fmt.Errorf("calling to VendorBillClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VendorBillCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VendorBillCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VendorBill.
+func (c *VendorBillClient) Update() *VendorBillUpdate {
+	mutation := newVendorBillMutation(c.config, OpUpdate)
+	return &VendorBillUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VendorBillClient) UpdateOne(_m *VendorBill) *VendorBillUpdateOne {
+	mutation := newVendorBillMutation(c.config, OpUpdateOne, withVendorBill(_m))
+	return &VendorBillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VendorBillClient) UpdateOneID(id uuid.UUID) *VendorBillUpdateOne {
+	mutation := newVendorBillMutation(c.config, OpUpdateOne, withVendorBillID(id))
+	return &VendorBillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VendorBill.
+func (c *VendorBillClient) Delete() *VendorBillDelete {
+	mutation := newVendorBillMutation(c.config, OpDelete)
+	return &VendorBillDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VendorBillClient) DeleteOne(_m *VendorBill) *VendorBillDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VendorBillClient) DeleteOneID(id uuid.UUID) *VendorBillDeleteOne {
+	builder := c.Delete().Where(vendorbill.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VendorBillDeleteOne{builder}
+}
+
+// Query returns a query builder for VendorBill.
+func (c *VendorBillClient) Query() *VendorBillQuery {
+	return &VendorBillQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVendorBill},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VendorBill entity by its id.
+func (c *VendorBillClient) Get(ctx context.Context, id uuid.UUID) (*VendorBill, error) {
+	return c.Query().Where(vendorbill.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VendorBillClient) GetX(ctx context.Context, id uuid.UUID) *VendorBill {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLines queries the lines edge of a VendorBill.
+func (c *VendorBillClient) QueryLines(_m *VendorBill) *VendorBillLineQuery {
+	query := (&VendorBillLineClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vendorbill.Table, vendorbill.FieldID, id),
+			sqlgraph.To(vendorbillline.Table, vendorbillline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vendorbill.LinesTable, vendorbill.LinesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *VendorBillClient) Hooks() []Hook {
+	return c.hooks.VendorBill
+}
+
+// Interceptors returns the client interceptors.
+func (c *VendorBillClient) Interceptors() []Interceptor {
+	return c.inters.VendorBill
+}
+
+func (c *VendorBillClient) mutate(ctx context.Context, m *VendorBillMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VendorBillCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VendorBillUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VendorBillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VendorBillDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown VendorBill mutation op: %q", m.Op())
+	}
+}
+
+// VendorBillLineClient is a client for the VendorBillLine schema.
+type VendorBillLineClient struct {
+	config
+}
+
+// NewVendorBillLineClient returns a client for the VendorBillLine from the given config.
+func NewVendorBillLineClient(c config) *VendorBillLineClient {
+	return &VendorBillLineClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vendorbillline.Hooks(f(g(h())))`.
+func (c *VendorBillLineClient) Use(hooks ...Hook) {
+	c.hooks.VendorBillLine = append(c.hooks.VendorBillLine, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vendorbillline.Intercept(f(g(h())))`.
+func (c *VendorBillLineClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VendorBillLine = append(c.inters.VendorBillLine, interceptors...)
+}
+
+// Create returns a builder for creating a VendorBillLine entity.
+func (c *VendorBillLineClient) Create() *VendorBillLineCreate {
+	mutation := newVendorBillLineMutation(c.config, OpCreate)
+	return &VendorBillLineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VendorBillLine entities.
+func (c *VendorBillLineClient) CreateBulk(builders ...*VendorBillLineCreate) *VendorBillLineCreateBulk {
+	return &VendorBillLineCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VendorBillLineClient) MapCreateBulk(slice any, setFunc func(*VendorBillLineCreate, int)) *VendorBillLineCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VendorBillLineCreateBulk{err: fmt.Errorf("calling to VendorBillLineClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VendorBillLineCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VendorBillLineCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VendorBillLine.
+func (c *VendorBillLineClient) Update() *VendorBillLineUpdate {
+	mutation := newVendorBillLineMutation(c.config, OpUpdate)
+	return &VendorBillLineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VendorBillLineClient) UpdateOne(_m *VendorBillLine) *VendorBillLineUpdateOne {
+	mutation := newVendorBillLineMutation(c.config, OpUpdateOne, withVendorBillLine(_m))
+	return &VendorBillLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VendorBillLineClient) UpdateOneID(id uuid.UUID) *VendorBillLineUpdateOne {
+	mutation := newVendorBillLineMutation(c.config, OpUpdateOne, withVendorBillLineID(id))
+	return &VendorBillLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VendorBillLine.
+func (c *VendorBillLineClient) Delete() *VendorBillLineDelete {
+	mutation := newVendorBillLineMutation(c.config, OpDelete)
+	return &VendorBillLineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VendorBillLineClient) DeleteOne(_m *VendorBillLine) *VendorBillLineDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VendorBillLineClient) DeleteOneID(id uuid.UUID) *VendorBillLineDeleteOne {
+	builder := c.Delete().Where(vendorbillline.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VendorBillLineDeleteOne{builder}
+}
+
+// Query returns a query builder for VendorBillLine.
+func (c *VendorBillLineClient) Query() *VendorBillLineQuery {
+	return &VendorBillLineQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVendorBillLine},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VendorBillLine entity by its id.
+func (c *VendorBillLineClient) Get(ctx context.Context, id uuid.UUID) (*VendorBillLine, error) {
+	return c.Query().Where(vendorbillline.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VendorBillLineClient) GetX(ctx context.Context, id uuid.UUID) *VendorBillLine {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBill queries the bill edge of a VendorBillLine.
+func (c *VendorBillLineClient) QueryBill(_m *VendorBillLine) *VendorBillQuery {
+	query := (&VendorBillClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vendorbillline.Table, vendorbillline.FieldID, id),
+			sqlgraph.To(vendorbill.Table, vendorbill.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, vendorbillline.BillTable, vendorbillline.BillColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *VendorBillLineClient) Hooks() []Hook {
+	return c.hooks.VendorBillLine
+}
+
+// Interceptors returns the client interceptors.
+func (c *VendorBillLineClient) Interceptors() []Interceptor {
+	return c.inters.VendorBillLine
+}
+
+func (c *VendorBillLineClient) mutate(ctx context.Context, m *VendorBillLineMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VendorBillLineCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VendorBillLineUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VendorBillLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VendorBillLineDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown VendorBillLine mutation op: %q", m.Op())
+	}
+}
+
+// WalletClient is a client for the Wallet schema.
+type WalletClient struct {
+	config
+}
+
+// NewWalletClient returns a client for the Wallet from the given config.
+func NewWalletClient(c config) *WalletClient {
+	return &WalletClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `wallet.Hooks(f(g(h())))`.
+func (c *WalletClient) Use(hooks ...Hook) {
+	c.hooks.Wallet = append(c.hooks.Wallet, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `wallet.Intercept(f(g(h())))`.
+func (c *WalletClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Wallet = append(c.inters.Wallet, interceptors...)
+}
+
+// Create returns a builder for creating a Wallet entity.
+func (c *WalletClient) Create() *WalletCreate {
+	mutation := newWalletMutation(c.config, OpCreate)
+	return &WalletCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Wallet entities.
+func (c *WalletClient) CreateBulk(builders ...*WalletCreate) *WalletCreateBulk {
+	return &WalletCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WalletClient) MapCreateBulk(slice any, setFunc func(*WalletCreate, int)) *WalletCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WalletCreateBulk{err: fmt.Errorf("calling to WalletClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WalletCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WalletCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Wallet.
+func (c *WalletClient) Update() *WalletUpdate {
+	mutation := newWalletMutation(c.config, OpUpdate)
+	return &WalletUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WalletClient) UpdateOne(_m *Wallet) *WalletUpdateOne {
+	mutation := newWalletMutation(c.config, OpUpdateOne, withWallet(_m))
+	return &WalletUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WalletClient) UpdateOneID(id uuid.UUID) *WalletUpdateOne {
+	mutation := newWalletMutation(c.config, OpUpdateOne, withWalletID(id))
+	return &WalletUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Wallet.
+func (c *WalletClient) Delete() *WalletDelete {
+	mutation := newWalletMutation(c.config, OpDelete)
+	return &WalletDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WalletClient) DeleteOne(_m *Wallet) *WalletDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WalletClient) DeleteOneID(id uuid.UUID) *WalletDeleteOne {
+	builder := c.Delete().Where(wallet.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WalletDeleteOne{builder}
+}
+
+// Query returns a query builder for Wallet.
+func (c *WalletClient) Query() *WalletQuery {
+	return &WalletQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWallet},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Wallet entity by its id.
+func (c *WalletClient) Get(ctx context.Context, id uuid.UUID) (*Wallet, error) {
+	return c.Query().Where(wallet.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WalletClient) GetX(ctx context.Context, id uuid.UUID) *Wallet {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WalletClient) Hooks() []Hook {
+	return c.hooks.Wallet
+}
+
+// Interceptors returns the client interceptors.
+func (c *WalletClient) Interceptors() []Interceptor {
+	return c.inters.Wallet
+}
+
+func (c *WalletClient) mutate(ctx context.Context, m *WalletMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WalletCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WalletUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WalletUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WalletDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Wallet mutation op: %q", m.Op())
+	}
+}
+
+// WalletHoldClient is a client for the WalletHold schema.
+type WalletHoldClient struct {
+	config
+}
+
+// NewWalletHoldClient returns a client for the WalletHold from the given config.
+func NewWalletHoldClient(c config) *WalletHoldClient {
+	return &WalletHoldClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `wallethold.Hooks(f(g(h())))`.
+func (c *WalletHoldClient) Use(hooks ...Hook) {
+	c.hooks.WalletHold = append(c.hooks.WalletHold, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `wallethold.Intercept(f(g(h())))`.
+func (c *WalletHoldClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WalletHold = append(c.inters.WalletHold, interceptors...)
+}
+
+// Create returns a builder for creating a WalletHold entity.
+func (c *WalletHoldClient) Create() *WalletHoldCreate {
+	mutation := newWalletHoldMutation(c.config, OpCreate)
+	return &WalletHoldCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WalletHold entities.
+func (c *WalletHoldClient) CreateBulk(builders ...*WalletHoldCreate) *WalletHoldCreateBulk {
+	return &WalletHoldCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WalletHoldClient) MapCreateBulk(slice any, setFunc func(*WalletHoldCreate, int)) *WalletHoldCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WalletHoldCreateBulk{err: fmt.Errorf("calling to WalletHoldClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WalletHoldCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WalletHoldCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WalletHold.
+func (c *WalletHoldClient) Update() *WalletHoldUpdate {
+	mutation := newWalletHoldMutation(c.config, OpUpdate)
+	return &WalletHoldUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WalletHoldClient) UpdateOne(_m *WalletHold) *WalletHoldUpdateOne {
+	mutation := newWalletHoldMutation(c.config, OpUpdateOne, withWalletHold(_m))
+	return &WalletHoldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WalletHoldClient) UpdateOneID(id uuid.UUID) *WalletHoldUpdateOne {
+	mutation := newWalletHoldMutation(c.config, OpUpdateOne, withWalletHoldID(id))
+	return &WalletHoldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WalletHold.
+func (c *WalletHoldClient) Delete() *WalletHoldDelete {
+	mutation := newWalletHoldMutation(c.config, OpDelete)
+	return &WalletHoldDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WalletHoldClient) DeleteOne(_m *WalletHold) *WalletHoldDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WalletHoldClient) DeleteOneID(id uuid.UUID) *WalletHoldDeleteOne {
+	builder := c.Delete().Where(wallethold.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WalletHoldDeleteOne{builder}
+}
+
+// Query returns a query builder for WalletHold.
+func (c *WalletHoldClient) Query() *WalletHoldQuery {
+	return &WalletHoldQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWalletHold},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WalletHold entity by its id.
+func (c *WalletHoldClient) Get(ctx context.Context, id uuid.UUID) (*WalletHold, error) {
+	return c.Query().Where(wallethold.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WalletHoldClient) GetX(ctx context.Context, id uuid.UUID) *WalletHold {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WalletHoldClient) Hooks() []Hook {
+	return c.hooks.WalletHold
+}
+
+// Interceptors returns the client interceptors.
+func (c *WalletHoldClient) Interceptors() []Interceptor {
+	return c.inters.WalletHold
+}
+
+func (c *WalletHoldClient) mutate(ctx context.Context, m *WalletHoldMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WalletHoldCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WalletHoldUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WalletHoldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WalletHoldDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WalletHold mutation op: %q", m.Op())
+	}
+}
+
+// WalletTransactionClient is a client for the WalletTransaction schema.
+type WalletTransactionClient struct {
+	config
+}
+
+// NewWalletTransactionClient returns a client for the WalletTransaction from the given config.
+func NewWalletTransactionClient(c config) *WalletTransactionClient {
+	return &WalletTransactionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `wallettransaction.Hooks(f(g(h())))`.
+func (c *WalletTransactionClient) Use(hooks ...Hook) {
+	c.hooks.WalletTransaction = append(c.hooks.WalletTransaction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `wallettransaction.Intercept(f(g(h())))`.
+func (c *WalletTransactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WalletTransaction = append(c.inters.WalletTransaction, interceptors...)
+}
+
+// Create returns a builder for creating a WalletTransaction entity.
+func (c *WalletTransactionClient) Create() *WalletTransactionCreate {
+	mutation := newWalletTransactionMutation(c.config, OpCreate)
+	return &WalletTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WalletTransaction entities.
+func (c *WalletTransactionClient) CreateBulk(builders ...*WalletTransactionCreate) *WalletTransactionCreateBulk {
+	return &WalletTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WalletTransactionClient) MapCreateBulk(slice any, setFunc func(*WalletTransactionCreate, int)) *WalletTransactionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WalletTransactionCreateBulk{err: fmt.Errorf("calling to WalletTransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WalletTransactionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WalletTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WalletTransaction.
+func (c *WalletTransactionClient) Update() *WalletTransactionUpdate {
+	mutation := newWalletTransactionMutation(c.config, OpUpdate)
+	return &WalletTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WalletTransactionClient) UpdateOne(_m *WalletTransaction) *WalletTransactionUpdateOne {
+	mutation := newWalletTransactionMutation(c.config, OpUpdateOne, withWalletTransaction(_m))
+	return &WalletTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WalletTransactionClient) UpdateOneID(id uuid.UUID) *WalletTransactionUpdateOne {
+	mutation := newWalletTransactionMutation(c.config, OpUpdateOne, withWalletTransactionID(id))
+	return &WalletTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WalletTransaction.
+func (c *WalletTransactionClient) Delete() *WalletTransactionDelete {
+	mutation := newWalletTransactionMutation(c.config, OpDelete)
+	return &WalletTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WalletTransactionClient) DeleteOne(_m *WalletTransaction) *WalletTransactionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WalletTransactionClient) DeleteOneID(id uuid.UUID) *WalletTransactionDeleteOne {
+	builder := c.Delete().Where(wallettransaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WalletTransactionDeleteOne{builder}
+}
+
+// Query returns a query builder for WalletTransaction.
+func (c *WalletTransactionClient) Query() *WalletTransactionQuery {
+	return &WalletTransactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWalletTransaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WalletTransaction entity by its id.
+func (c *WalletTransactionClient) Get(ctx context.Context, id uuid.UUID) (*WalletTransaction, error) {
+	return c.Query().Where(wallettransaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WalletTransactionClient) GetX(ctx context.Context, id uuid.UUID) *WalletTransaction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WalletTransactionClient) Hooks() []Hook {
+	return c.hooks.WalletTransaction
+}
+
+// Interceptors returns the client interceptors.
+func (c *WalletTransactionClient) Interceptors() []Interceptor {
+	return c.inters.WalletTransaction
+}
+
+func (c *WalletTransactionClient) mutate(ctx context.Context, m *WalletTransactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WalletTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WalletTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WalletTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WalletTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WalletTransaction mutation op: %q", m.Op())
+	}
+}
+
+// WithholdingCertificateClient is a client for the WithholdingCertificate schema.
+type WithholdingCertificateClient struct {
+	config
+}
+
+// NewWithholdingCertificateClient returns a client for the WithholdingCertificate from the given config.
+func NewWithholdingCertificateClient(c config) *WithholdingCertificateClient {
+	return &WithholdingCertificateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `withholdingcertificate.Hooks(f(g(h())))`.
+func (c *WithholdingCertificateClient) Use(hooks ...Hook) {
+	c.hooks.WithholdingCertificate = append(c.hooks.WithholdingCertificate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `withholdingcertificate.Intercept(f(g(h())))`.
+func (c *WithholdingCertificateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WithholdingCertificate = append(c.inters.WithholdingCertificate, interceptors...)
+}
+
+// Create returns a builder for creating a WithholdingCertificate entity.
+func (c *WithholdingCertificateClient) Create() *WithholdingCertificateCreate {
+	mutation := newWithholdingCertificateMutation(c.config, OpCreate)
+	return &WithholdingCertificateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WithholdingCertificate entities.
+func (c *WithholdingCertificateClient) CreateBulk(builders ...*WithholdingCertificateCreate) *WithholdingCertificateCreateBulk {
+	return &WithholdingCertificateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WithholdingCertificateClient) MapCreateBulk(slice any, setFunc func(*WithholdingCertificateCreate, int)) *WithholdingCertificateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WithholdingCertificateCreateBulk{err: fmt.Errorf("calling to WithholdingCertificateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WithholdingCertificateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WithholdingCertificateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WithholdingCertificate.
+func (c *WithholdingCertificateClient) Update() *WithholdingCertificateUpdate {
+	mutation := newWithholdingCertificateMutation(c.config, OpUpdate)
+	return &WithholdingCertificateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WithholdingCertificateClient) UpdateOne(_m *WithholdingCertificate) *WithholdingCertificateUpdateOne {
+	mutation := newWithholdingCertificateMutation(c.config, OpUpdateOne, withWithholdingCertificate(_m))
+	return &WithholdingCertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WithholdingCertificateClient) UpdateOneID(id uuid.UUID) *WithholdingCertificateUpdateOne {
+	mutation := newWithholdingCertificateMutation(c.config, OpUpdateOne, withWithholdingCertificateID(id))
+	return &WithholdingCertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WithholdingCertificate.
+func (c *WithholdingCertificateClient) Delete() *WithholdingCertificateDelete {
+	mutation := newWithholdingCertificateMutation(c.config, OpDelete)
+	return &WithholdingCertificateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WithholdingCertificateClient) DeleteOne(_m *WithholdingCertificate) *WithholdingCertificateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WithholdingCertificateClient) DeleteOneID(id uuid.UUID) *WithholdingCertificateDeleteOne {
+	builder := c.Delete().Where(withholdingcertificate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WithholdingCertificateDeleteOne{builder}
+}
+
+// Query returns a query builder for WithholdingCertificate.
+func (c *WithholdingCertificateClient) Query() *WithholdingCertificateQuery {
+	return &WithholdingCertificateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWithholdingCertificate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WithholdingCertificate entity by its id.
+func (c *WithholdingCertificateClient) Get(ctx context.Context, id uuid.UUID) (*WithholdingCertificate, error) {
+	return c.Query().Where(withholdingcertificate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WithholdingCertificateClient) GetX(ctx context.Context, id uuid.UUID) *WithholdingCertificate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WithholdingCertificateClient) Hooks() []Hook {
+	return c.hooks.WithholdingCertificate
+}
+
+// Interceptors returns the client interceptors.
+func (c *WithholdingCertificateClient) Interceptors() []Interceptor {
+	return c.inters.WithholdingCertificate
+}
+
+func (c *WithholdingCertificateClient) mutate(ctx context.Context, m *WithholdingCertificateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WithholdingCertificateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WithholdingCertificateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WithholdingCertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TreasuryPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WithholdingCertificateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown TreasuryPermission mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WithholdingCertificate mutation op: %q", m.Op())
 	}
 }
 
-// TreasuryRoleClient is a client for the TreasuryRole schema.
-type TreasuryRoleClient struct {
+// WithholdingRateClient is a client for the WithholdingRate schema.
+type WithholdingRateClient struct {
 	config
 }
 
-// NewTreasuryRoleClient returns a client for the TreasuryRole from the given config.
-func NewTreasuryRoleClient(c config) *TreasuryRoleClient {
-	return &TreasuryRoleClient{config: c}
+// NewWithholdingRateClient returns a client for the WithholdingRate from the given config.
+func NewWithholdingRateClient(c config) *WithholdingRateClient {
+	return &WithholdingRateClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `treasuryrole.Hooks(f(g(h())))`.
-func (c *TreasuryRoleClient) Use(hooks ...Hook) {
-	c.hooks.TreasuryRole = append(c.hooks.TreasuryRole, hooks...)
+// A call to `Use(f, g, h)` equals to `withholdingrate.Hooks(f(g(h())))`.
+func (c *WithholdingRateClient) Use(hooks ...Hook) {
+	c.hooks.WithholdingRate = append(c.hooks.WithholdingRate, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `treasuryrole.Intercept(f(g(h())))`.
-func (c *TreasuryRoleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.TreasuryRole = append(c.inters.TreasuryRole, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `withholdingrate.Intercept(f(g(h())))`.
+func (c *WithholdingRateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WithholdingRate = append(c.inters.WithholdingRate, interceptors...)
 }
 
-// Create returns a builder for creating a TreasuryRole entity.
-func (c *TreasuryRoleClient) Create() *TreasuryRoleCreate {
-	mutation := newTreasuryRoleMutation(c.config, OpCreate)
-	return &TreasuryRoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WithholdingRate entity.
+func (c *WithholdingRateClient) Create() *WithholdingRateCreate {
+	mutation := newWithholdingRateMutation(c.config, OpCreate)
+	return &WithholdingRateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of TreasuryRole entities.
-func (c *TreasuryRoleClient) CreateBulk(builders ...*TreasuryRoleCreate) *TreasuryRoleCreateBulk {
-	return &TreasuryRoleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WithholdingRate entities.
+func (c *WithholdingRateClient) CreateBulk(builders ...*WithholdingRateCreate) *WithholdingRateCreateBulk {
+	return &WithholdingRateCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TreasuryRoleClient) MapCreateBulk(slice any, setFunc func(*TreasuryRoleCreate, int)) *TreasuryRoleCreateBulk {
+func (c *WithholdingRateClient) MapCreateBulk(slice any, setFunc func(*WithholdingRateCreate, int)) *WithholdingRateCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TreasuryRoleCreateBulk{err: fmt.Errorf("calling to TreasuryRoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WithholdingRateCreateBulk{err: fmt.Errorf("calling to WithholdingRateClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TreasuryRoleCreate, rv.Len())
+	builders := make([]*WithholdingRateCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TreasuryRoleCreateBulk{config: c.config, builders: builders}
+	return &WithholdingRateCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for TreasuryRole.
-func (c *TreasuryRoleClient) Update() *TreasuryRoleUpdate {
-	mutation := newTreasuryRoleMutation(c.config, OpUpdate)
-	return &TreasuryRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WithholdingRate.
+func (c *WithholdingRateClient) Update() *WithholdingRateUpdate {
+	mutation := newWithholdingRateMutation(c.config, OpUpdate)
+	return &WithholdingRateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TreasuryRoleClient) UpdateOne(_m *TreasuryRole) *TreasuryRoleUpdateOne {
-	mutation := newTreasuryRoleMutation(c.config, OpUpdateOne, withTreasuryRole(_m))
-	return &TreasuryRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WithholdingRateClient) UpdateOne(_m *WithholdingRate) *WithholdingRateUpdateOne {
+	mutation := newWithholdingRateMutation(c.config, OpUpdateOne, withWithholdingRate(_m))
+	return &WithholdingRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TreasuryRoleClient) UpdateOneID(id uuid.UUID) *TreasuryRoleUpdateOne {
-	mutation := newTreasuryRoleMutation(c.config, OpUpdateOne, withTreasuryRoleID(id))
-	return &TreasuryRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WithholdingRateClient) UpdateOneID(id uuid.UUID) *WithholdingRateUpdateOne {
+	mutation := newWithholdingRateMutation(c.config, OpUpdateOne, withWithholdingRateID(id))
+	return &WithholdingRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for TreasuryRole.
-func (c *TreasuryRoleClient) Delete() *TreasuryRoleDelete {
-	mutation := newTreasuryRoleMutation(c.config, OpDelete)
-	return &TreasuryRoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WithholdingRate.
+func (c *WithholdingRateClient) Delete() *WithholdingRateDelete {
+	mutation := newWithholdingRateMutation(c.config, OpDelete)
+	return &WithholdingRateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TreasuryRoleClient) DeleteOne(_m *TreasuryRole) *TreasuryRoleDeleteOne {
+func (c *WithholdingRateClient) DeleteOne(_m *WithholdingRate) *WithholdingRateDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TreasuryRoleClient) DeleteOneID(id uuid.UUID) *TreasuryRoleDeleteOne {
-	builder := c.Delete().Where(treasuryrole.ID(id))
+func (c *WithholdingRateClient) DeleteOneID(id uuid.UUID) *WithholdingRateDeleteOne {
+	builder := c.Delete().Where(withholdingrate.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TreasuryRoleDeleteOne{builder}
+	return &WithholdingRateDeleteOne{builder}
 }
 
-// Query returns a query builder for TreasuryRole.
-func (c *TreasuryRoleClient) Query() *TreasuryRoleQuery {
-	return &TreasuryRoleQuery{
+// Query returns a query builder for WithholdingRate.
+func (c *WithholdingRateClient) Query() *WithholdingRateQuery {
+	return &WithholdingRateQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTreasuryRole},
+		ctx:    &QueryContext{Type: TypeWithholdingRate},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a TreasuryRole entity by its id.
-func (c *TreasuryRoleClient) Get(ctx context.Context, id uuid.UUID) (*TreasuryRole, error) {
-	return c.Query().Where(treasuryrole.ID(id)).Only(ctx)
+// Get returns a WithholdingRate entity by its id.
+func (c *WithholdingRateClient) Get(ctx context.Context, id uuid.UUID) (*WithholdingRate, error) {
+	return c.Query().Where(withholdingrate.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TreasuryRoleClient) GetX(ctx context.Context, id uuid.UUID) *TreasuryRole {
+func (c *WithholdingRateClient) GetX(ctx context.Context, id uuid.UUID) *WithholdingRate {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1574,180 +9287,132 @@ func (c *TreasuryRoleClient) GetX(ctx context.Context, id uuid.UUID) *TreasuryRo
 	return obj
 }
 
-// QueryPermissions queries the permissions edge of a TreasuryRole.
-func (c *TreasuryRoleClient) QueryPermissions(_m *TreasuryRole) *TreasuryPermissionQuery {
-	query := (&TreasuryPermissionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(treasuryrole.Table, treasuryrole.FieldID, id),
-			sqlgraph.To(treasurypermission.Table, treasurypermission.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, treasuryrole.PermissionsTable, treasuryrole.PermissionsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryUserAssignments queries the user_assignments edge of a TreasuryRole.
-func (c *TreasuryRoleClient) QueryUserAssignments(_m *TreasuryRole) *UserRoleAssignmentQuery {
-	query := (&UserRoleAssignmentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(treasuryrole.Table, treasuryrole.FieldID, id),
-			sqlgraph.To(userroleassignment.Table, userroleassignment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, treasuryrole.UserAssignmentsTable, treasuryrole.UserAssignmentsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRolePermissions queries the role_permissions edge of a TreasuryRole.
-func (c *TreasuryRoleClient) QueryRolePermissions(_m *TreasuryRole) *RolePermissionQuery {
-	query := (&RolePermissionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(treasuryrole.Table, treasuryrole.FieldID, id),
-			sqlgraph.To(rolepermission.Table, rolepermission.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, treasuryrole.RolePermissionsTable, treasuryrole.RolePermissionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *TreasuryRoleClient) Hooks() []Hook {
-	return c.hooks.TreasuryRole
+func (c *WithholdingRateClient) Hooks() []Hook {
+	return c.hooks.WithholdingRate
 }
 
 // Interceptors returns the client interceptors.
-func (c *TreasuryRoleClient) Interceptors() []Interceptor {
-	return c.inters.TreasuryRole
+func (c *WithholdingRateClient) Interceptors() []Interceptor {
+	return c.inters.WithholdingRate
 }
 
-func (c *TreasuryRoleClient) mutate(ctx context.Context, m *TreasuryRoleMutation) (Value, error) {
+func (c *WithholdingRateClient) mutate(ctx context.Context, m *WithholdingRateMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TreasuryRoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WithholdingRateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TreasuryRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WithholdingRateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TreasuryRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WithholdingRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TreasuryRoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WithholdingRateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown TreasuryRole mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WithholdingRate mutation op: %q", m.Op())
 	}
 }
 
-// TreasuryUserClient is a client for the TreasuryUser schema.
-type TreasuryUserClient struct {
+// WriteOffClient is a client for the WriteOff schema.
+type WriteOffClient struct {
 	config
 }
 
-// NewTreasuryUserClient returns a client for the TreasuryUser from the given config.
-func NewTreasuryUserClient(c config) *TreasuryUserClient {
-	return &TreasuryUserClient{config: c}
+// NewWriteOffClient returns a client for the WriteOff from the given config.
+func NewWriteOffClient(c config) *WriteOffClient {
+	return &WriteOffClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `treasuryuser.Hooks(f(g(h())))`.
-func (c *TreasuryUserClient) Use(hooks ...Hook) {
-	c.hooks.TreasuryUser = append(c.hooks.TreasuryUser, hooks...)
+// A call to `Use(f, g, h)` equals to `writeoff.Hooks(f(g(h())))`.
+func (c *WriteOffClient) Use(hooks ...Hook) {
+	c.hooks.WriteOff = append(c.hooks.WriteOff, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `treasuryuser.Intercept(f(g(h())))`.
-func (c *TreasuryUserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.TreasuryUser = append(c.inters.TreasuryUser, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `writeoff.Intercept(f(g(h())))`.
+func (c *WriteOffClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WriteOff = append(c.inters.WriteOff, interceptors...)
 }
 
-// Create returns a builder for creating a TreasuryUser entity.
-func (c *TreasuryUserClient) Create() *TreasuryUserCreate {
-	mutation := newTreasuryUserMutation(c.config, OpCreate)
-	return &TreasuryUserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WriteOff entity.
+func (c *WriteOffClient) Create() *WriteOffCreate {
+	mutation := newWriteOffMutation(c.config, OpCreate)
+	return &WriteOffCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of TreasuryUser entities.
-func (c *TreasuryUserClient) CreateBulk(builders ...*TreasuryUserCreate) *TreasuryUserCreateBulk {
-	return &TreasuryUserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WriteOff entities.
+func (c *WriteOffClient) CreateBulk(builders ...*WriteOffCreate) *WriteOffCreateBulk {
+	return &WriteOffCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TreasuryUserClient) MapCreateBulk(slice any, setFunc func(*TreasuryUserCreate, int)) *TreasuryUserCreateBulk {
+func (c *WriteOffClient) MapCreateBulk(slice any, setFunc func(*WriteOffCreate, int)) *WriteOffCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TreasuryUserCreateBulk{err: fmt.Errorf("calling to TreasuryUserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WriteOffCreateBulk{err: fmt.Errorf("calling to WriteOffClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TreasuryUserCreate, rv.Len())
+	builders := make([]*WriteOffCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TreasuryUserCreateBulk{config: c.config, builders: builders}
+	return &WriteOffCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for TreasuryUser.
-func (c *TreasuryUserClient) Update() *TreasuryUserUpdate {
-	mutation := newTreasuryUserMutation(c.config, OpUpdate)
-	return &TreasuryUserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WriteOff.
+func (c *WriteOffClient) Update() *WriteOffUpdate {
+	mutation := newWriteOffMutation(c.config, OpUpdate)
+	return &WriteOffUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TreasuryUserClient) UpdateOne(_m *TreasuryUser) *TreasuryUserUpdateOne {
-	mutation := newTreasuryUserMutation(c.config, OpUpdateOne, withTreasuryUser(_m))
-	return &TreasuryUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WriteOffClient) UpdateOne(_m *WriteOff) *WriteOffUpdateOne {
+	mutation := newWriteOffMutation(c.config, OpUpdateOne, withWriteOff(_m))
+	return &WriteOffUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TreasuryUserClient) UpdateOneID(id uuid.UUID) *TreasuryUserUpdateOne {
-	mutation := newTreasuryUserMutation(c.config, OpUpdateOne, withTreasuryUserID(id))
-	return &TreasuryUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WriteOffClient) UpdateOneID(id uuid.UUID) *WriteOffUpdateOne {
+	mutation := newWriteOffMutation(c.config, OpUpdateOne, withWriteOffID(id))
+	return &WriteOffUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for TreasuryUser.
-func (c *TreasuryUserClient) Delete() *TreasuryUserDelete {
-	mutation := newTreasuryUserMutation(c.config, OpDelete)
-	return &TreasuryUserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WriteOff.
+func (c *WriteOffClient) Delete() *WriteOffDelete {
+	mutation := newWriteOffMutation(c.config, OpDelete)
+	return &WriteOffDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TreasuryUserClient) DeleteOne(_m *TreasuryUser) *TreasuryUserDeleteOne {
+func (c *WriteOffClient) DeleteOne(_m *WriteOff) *WriteOffDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TreasuryUserClient) DeleteOneID(id uuid.UUID) *TreasuryUserDeleteOne {
-	builder := c.Delete().Where(treasuryuser.ID(id))
+func (c *WriteOffClient) DeleteOneID(id uuid.UUID) *WriteOffDeleteOne {
+	builder := c.Delete().Where(writeoff.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TreasuryUserDeleteOne{builder}
+	return &WriteOffDeleteOne{builder}
 }
 
-// Query returns a query builder for TreasuryUser.
-func (c *TreasuryUserClient) Query() *TreasuryUserQuery {
-	return &TreasuryUserQuery{
+// Query returns a query builder for WriteOff.
+func (c *WriteOffClient) Query() *WriteOffQuery {
+	return &WriteOffQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTreasuryUser},
+		ctx:    &QueryContext{Type: TypeWriteOff},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a TreasuryUser entity by its id.
-func (c *TreasuryUserClient) Get(ctx context.Context, id uuid.UUID) (*TreasuryUser, error) {
-	return c.Query().Where(treasuryuser.ID(id)).Only(ctx)
+// Get returns a WriteOff entity by its id.
+func (c *WriteOffClient) Get(ctx context.Context, id uuid.UUID) (*WriteOff, error) {
+	return c.Query().Where(writeoff.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TreasuryUserClient) GetX(ctx context.Context, id uuid.UUID) *TreasuryUser {
+func (c *WriteOffClient) GetX(ctx context.Context, id uuid.UUID) *WriteOff {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1756,131 +9421,131 @@ func (c *TreasuryUserClient) GetX(ctx context.Context, id uuid.UUID) *TreasuryUs
 }
 
 // Hooks returns the client hooks.
-func (c *TreasuryUserClient) Hooks() []Hook {
-	return c.hooks.TreasuryUser
+func (c *WriteOffClient) Hooks() []Hook {
+	return c.hooks.WriteOff
 }
 
 // Interceptors returns the client interceptors.
-func (c *TreasuryUserClient) Interceptors() []Interceptor {
-	return c.inters.TreasuryUser
+func (c *WriteOffClient) Interceptors() []Interceptor {
+	return c.inters.WriteOff
 }
 
-func (c *TreasuryUserClient) mutate(ctx context.Context, m *TreasuryUserMutation) (Value, error) {
+func (c *WriteOffClient) mutate(ctx context.Context, m *WriteOffMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TreasuryUserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WriteOffCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TreasuryUserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WriteOffUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TreasuryUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WriteOffUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TreasuryUserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WriteOffDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown TreasuryUser mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WriteOff mutation op: %q", m.Op())
 	}
 }
 
-// UserRoleAssignmentClient is a client for the UserRoleAssignment schema.
-type UserRoleAssignmentClient struct {
+// WriteOffRecoveryClient is a client for the WriteOffRecovery schema.
+type WriteOffRecoveryClient struct {
 	config
 }
 
-// NewUserRoleAssignmentClient returns a client for the UserRoleAssignment from the given config.
-func NewUserRoleAssignmentClient(c config) *UserRoleAssignmentClient {
-	return &UserRoleAssignmentClient{config: c}
+// NewWriteOffRecoveryClient returns a client for the WriteOffRecovery from the given config.
+func NewWriteOffRecoveryClient(c config) *WriteOffRecoveryClient {
+	return &WriteOffRecoveryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `userroleassignment.Hooks(f(g(h())))`.
-func (c *UserRoleAssignmentClient) Use(hooks ...Hook) {
-	c.hooks.UserRoleAssignment = append(c.hooks.UserRoleAssignment, hooks...)
+// A call to `Use(f, g, h)` equals to `writeoffrecovery.Hooks(f(g(h())))`.
+func (c *WriteOffRecoveryClient) Use(hooks ...Hook) {
+	c.hooks.WriteOffRecovery = append(c.hooks.WriteOffRecovery, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `userroleassignment.Intercept(f(g(h())))`.
-func (c *UserRoleAssignmentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.UserRoleAssignment = append(c.inters.UserRoleAssignment, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `writeoffrecovery.Intercept(f(g(h())))`.
+func (c *WriteOffRecoveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WriteOffRecovery = append(c.inters.WriteOffRecovery, interceptors...)
 }
 
-// Create returns a builder for creating a UserRoleAssignment entity.
-func (c *UserRoleAssignmentClient) Create() *UserRoleAssignmentCreate {
-	mutation := newUserRoleAssignmentMutation(c.config, OpCreate)
-	return &UserRoleAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WriteOffRecovery entity.
+func (c *WriteOffRecoveryClient) Create() *WriteOffRecoveryCreate {
+	mutation := newWriteOffRecoveryMutation(c.config, OpCreate)
+	return &WriteOffRecoveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of UserRoleAssignment entities.
-func (c *UserRoleAssignmentClient) CreateBulk(builders ...*UserRoleAssignmentCreate) *UserRoleAssignmentCreateBulk {
-	return &UserRoleAssignmentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WriteOffRecovery entities.
+func (c *WriteOffRecoveryClient) CreateBulk(builders ...*WriteOffRecoveryCreate) *WriteOffRecoveryCreateBulk {
+	return &WriteOffRecoveryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserRoleAssignmentClient) MapCreateBulk(slice any, setFunc func(*UserRoleAssignmentCreate, int)) *UserRoleAssignmentCreateBulk {
+func (c *WriteOffRecoveryClient) MapCreateBulk(slice any, setFunc func(*WriteOffRecoveryCreate, int)) *WriteOffRecoveryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserRoleAssignmentCreateBulk{err: fmt.Errorf("calling to UserRoleAssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WriteOffRecoveryCreateBulk{err: fmt.Errorf("calling to WriteOffRecoveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserRoleAssignmentCreate, rv.Len())
+	builders := make([]*WriteOffRecoveryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserRoleAssignmentCreateBulk{config: c.config, builders: builders}
+	return &WriteOffRecoveryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for UserRoleAssignment.
-func (c *UserRoleAssignmentClient) Update() *UserRoleAssignmentUpdate {
-	mutation := newUserRoleAssignmentMutation(c.config, OpUpdate)
-	return &UserRoleAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WriteOffRecovery.
+func (c *WriteOffRecoveryClient) Update() *WriteOffRecoveryUpdate {
+	mutation := newWriteOffRecoveryMutation(c.config, OpUpdate)
+	return &WriteOffRecoveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserRoleAssignmentClient) UpdateOne(_m *UserRoleAssignment) *UserRoleAssignmentUpdateOne {
-	mutation := newUserRoleAssignmentMutation(c.config, OpUpdateOne, withUserRoleAssignment(_m))
-	return &UserRoleAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WriteOffRecoveryClient) UpdateOne(_m *WriteOffRecovery) *WriteOffRecoveryUpdateOne {
+	mutation := newWriteOffRecoveryMutation(c.config, OpUpdateOne, withWriteOffRecovery(_m))
+	return &WriteOffRecoveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserRoleAssignmentClient) UpdateOneID(id uuid.UUID) *UserRoleAssignmentUpdateOne {
-	mutation := newUserRoleAssignmentMutation(c.config, OpUpdateOne, withUserRoleAssignmentID(id))
-	return &UserRoleAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WriteOffRecoveryClient) UpdateOneID(id uuid.UUID) *WriteOffRecoveryUpdateOne {
+	mutation := newWriteOffRecoveryMutation(c.config, OpUpdateOne, withWriteOffRecoveryID(id))
+	return &WriteOffRecoveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for UserRoleAssignment.
-func (c *UserRoleAssignmentClient) Delete() *UserRoleAssignmentDelete {
-	mutation := newUserRoleAssignmentMutation(c.config, OpDelete)
-	return &UserRoleAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WriteOffRecovery.
+func (c *WriteOffRecoveryClient) Delete() *WriteOffRecoveryDelete {
+	mutation := newWriteOffRecoveryMutation(c.config, OpDelete)
+	return &WriteOffRecoveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserRoleAssignmentClient) DeleteOne(_m *UserRoleAssignment) *UserRoleAssignmentDeleteOne {
+func (c *WriteOffRecoveryClient) DeleteOne(_m *WriteOffRecovery) *WriteOffRecoveryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserRoleAssignmentClient) DeleteOneID(id uuid.UUID) *UserRoleAssignmentDeleteOne {
-	builder := c.Delete().Where(userroleassignment.ID(id))
+func (c *WriteOffRecoveryClient) DeleteOneID(id uuid.UUID) *WriteOffRecoveryDeleteOne {
+	builder := c.Delete().Where(writeoffrecovery.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserRoleAssignmentDeleteOne{builder}
+	return &WriteOffRecoveryDeleteOne{builder}
 }
 
-// Query returns a query builder for UserRoleAssignment.
-func (c *UserRoleAssignmentClient) Query() *UserRoleAssignmentQuery {
-	return &UserRoleAssignmentQuery{
+// Query returns a query builder for WriteOffRecovery.
+func (c *WriteOffRecoveryClient) Query() *WriteOffRecoveryQuery {
+	return &WriteOffRecoveryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUserRoleAssignment},
+		ctx:    &QueryContext{Type: TypeWriteOffRecovery},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a UserRoleAssignment entity by its id.
-func (c *UserRoleAssignmentClient) Get(ctx context.Context, id uuid.UUID) (*UserRoleAssignment, error) {
-	return c.Query().Where(userroleassignment.ID(id)).Only(ctx)
+// Get returns a WriteOffRecovery entity by its id.
+func (c *WriteOffRecoveryClient) Get(ctx context.Context, id uuid.UUID) (*WriteOffRecovery, error) {
+	return c.Query().Where(writeoffrecovery.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserRoleAssignmentClient) GetX(ctx context.Context, id uuid.UUID) *UserRoleAssignment {
+func (c *WriteOffRecoveryClient) GetX(ctx context.Context, id uuid.UUID) *WriteOffRecovery {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1888,73 +9553,63 @@ func (c *UserRoleAssignmentClient) GetX(ctx context.Context, id uuid.UUID) *User
 	return obj
 }
 
-// QueryUser queries the user edge of a UserRoleAssignment.
-func (c *UserRoleAssignmentClient) QueryUser(_m *UserRoleAssignment) *TreasuryUserQuery {
-	query := (&TreasuryUserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(userroleassignment.Table, userroleassignment.FieldID, id),
-			sqlgraph.To(treasuryuser.Table, treasuryuser.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, userroleassignment.UserTable, userroleassignment.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRole queries the role edge of a UserRoleAssignment.
-func (c *UserRoleAssignmentClient) QueryRole(_m *UserRoleAssignment) *TreasuryRoleQuery {
-	query := (&TreasuryRoleClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(userroleassignment.Table, userroleassignment.FieldID, id),
-			sqlgraph.To(treasuryrole.Table, treasuryrole.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, userroleassignment.RoleTable, userroleassignment.RoleColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *UserRoleAssignmentClient) Hooks() []Hook {
-	return c.hooks.UserRoleAssignment
+func (c *WriteOffRecoveryClient) Hooks() []Hook {
+	return c.hooks.WriteOffRecovery
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserRoleAssignmentClient) Interceptors() []Interceptor {
-	return c.inters.UserRoleAssignment
+func (c *WriteOffRecoveryClient) Interceptors() []Interceptor {
+	return c.inters.WriteOffRecovery
 }
 
-func (c *UserRoleAssignmentClient) mutate(ctx context.Context, m *UserRoleAssignmentMutation) (Value, error) {
+func (c *WriteOffRecoveryClient) mutate(ctx context.Context, m *WriteOffRecoveryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserRoleAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WriteOffRecoveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserRoleAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WriteOffRecoveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserRoleAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WriteOffRecoveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserRoleAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WriteOffRecoveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown UserRoleAssignment mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WriteOffRecovery mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ChartOfAccount, Invoice, LedgerTransaction, OutboxEvent, PaymentIntent,
-		PaymentTransaction, RolePermission, TreasuryPermission, TreasuryRole,
-		TreasuryUser, UserRoleAssignment []ent.Hook
+		BankAccount, BankStatement, BankStatementProfile, BankTransaction, BillingCycle,
+		CashDrawerCount, CashDrawerSession, CashDrawerSetting, ChartOfAccount,
+		CreditOverride, Customer, CustomerStatement, DocumentSequence, DunningNotice,
+		DunningPause, DunningStep, EarningsSetting, GoodsReceipt, GoodsReceiptLine,
+		Invoice, InvoiceLine, InvoicePayment, InvoiceSetting, LedgerTransaction,
+		OutboxEvent, PayableSetting, PayeePayout, PayeeWallet, PayeeWalletEntry,
+		PaymentIntent, PaymentRun, PaymentRunItem, PaymentTransaction, ProvisionPolicy,
+		ProvisionRun, Reconciliation, ReconciliationMatch, ReconciliationMatchItem,
+		ReconciliationRule, RolePermission, SettlementBatch, SettlementDisbursement,
+		SettlementItem, SettlementSetting, Subscription, SubscriptionAdjustment,
+		SubscriptionMeter, TreasuryPermission, TreasuryRole, TreasuryUser, UsageRecord,
+		UserRoleAssignment, Vendor, VendorBill, VendorBillLine, Wallet, WalletHold,
+		WalletTransaction, WithholdingCertificate, WithholdingRate, WriteOff,
+		WriteOffRecovery []ent.Hook
 	}
 	inters struct {
-		ChartOfAccount, Invoice, LedgerTransaction, OutboxEvent, PaymentIntent,
-		PaymentTransaction, RolePermission, TreasuryPermission, TreasuryRole,
-		TreasuryUser, UserRoleAssignment []ent.Interceptor
+		BankAccount, BankStatement, BankStatementProfile, BankTransaction, BillingCycle,
+		CashDrawerCount, CashDrawerSession, CashDrawerSetting, ChartOfAccount,
+		CreditOverride, Customer, CustomerStatement, DocumentSequence, DunningNotice,
+		DunningPause, DunningStep, EarningsSetting, GoodsReceipt, GoodsReceiptLine,
+		Invoice, InvoiceLine, InvoicePayment, InvoiceSetting, LedgerTransaction,
+		OutboxEvent, PayableSetting, PayeePayout, PayeeWallet, PayeeWalletEntry,
+		PaymentIntent, PaymentRun, PaymentRunItem, PaymentTransaction, ProvisionPolicy,
+		ProvisionRun, Reconciliation, ReconciliationMatch, ReconciliationMatchItem,
+		ReconciliationRule, RolePermission, SettlementBatch, SettlementDisbursement,
+		SettlementItem, SettlementSetting, Subscription, SubscriptionAdjustment,
+		SubscriptionMeter, TreasuryPermission, TreasuryRole, TreasuryUser, UsageRecord,
+		UserRoleAssignment, Vendor, VendorBill, VendorBillLine, Wallet, WalletHold,
+		WalletTransaction, WithholdingCertificate, WithholdingRate, WriteOff,
+		WriteOffRecovery []ent.Interceptor
 	}
 )
@@ -0,0 +1,263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/creditoverride"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CreditOverride is the model entity for the CreditOverride schema.
+type CreditOverride struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Customer identifier
+	CustomerID uuid.UUID `json:"customer_id,omitempty"`
+	// Largest document the override covers
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// ISO currency code
+	Currency string `json:"currency,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// GrantedBy holds the value of the "granted_by" field.
+	GrantedBy uuid.UUID `json:"granted_by,omitempty"`
+	// The override lapses if unused by this time
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Status: active, used, revoked
+	Status string `json:"status,omitempty"`
+	// Document the override was used for: invoice, payment_intent
+	DocumentType string `json:"document_type,omitempty"`
+	// DocumentID holds the value of the "document_id" field.
+	DocumentID uuid.UUID `json:"document_id,omitempty"`
+	// Amount of the document the override was used for
+	DocumentAmount *decimal.Decimal `json:"document_amount,omitempty"`
+	// Credit position (limit, exposure, hold) when the override was used
+	CreditSnapshot map[string]interface{} `json:"credit_snapshot,omitempty"`
+	// UsedAt holds the value of the "used_at" field.
+	UsedAt time.Time `json:"used_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CreditOverride) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case creditoverride.FieldDocumentAmount:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case creditoverride.FieldCreditSnapshot:
+			values[i] = new([]byte)
+		case creditoverride.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case creditoverride.FieldCurrency, creditoverride.FieldReason, creditoverride.FieldStatus, creditoverride.FieldDocumentType:
+			values[i] = new(sql.NullString)
+		case creditoverride.FieldExpiresAt, creditoverride.FieldUsedAt, creditoverride.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case creditoverride.FieldID, creditoverride.FieldTenantID, creditoverride.FieldCustomerID, creditoverride.FieldGrantedBy, creditoverride.FieldDocumentID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CreditOverride fields.
+func (_m *CreditOverride) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case creditoverride.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case creditoverride.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case creditoverride.FieldCustomerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value != nil {
+				_m.CustomerID = *value
+			}
+		case creditoverride.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case creditoverride.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case creditoverride.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case creditoverride.FieldGrantedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field granted_by", values[i])
+			} else if value != nil {
+				_m.GrantedBy = *value
+			}
+		case creditoverride.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case creditoverride.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case creditoverride.FieldDocumentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field document_type", values[i])
+			} else if value.Valid {
+				_m.DocumentType = value.String
+			}
+		case creditoverride.FieldDocumentID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field document_id", values[i])
+			} else if value != nil {
+				_m.DocumentID = *value
+			}
+		case creditoverride.FieldDocumentAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field document_amount", values[i])
+			} else if value.Valid {
+				_m.DocumentAmount = new(decimal.Decimal)
+				*_m.DocumentAmount = *value.S.(*decimal.Decimal)
+			}
+		case creditoverride.FieldCreditSnapshot:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_snapshot", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CreditSnapshot); err != nil {
+					return fmt.Errorf("unmarshal field credit_snapshot: %w", err)
+				}
+			}
+		case creditoverride.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				_m.UsedAt = value.Time
+			}
+		case creditoverride.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CreditOverride.
+// This includes values selected through modifiers, order, etc.
+func (_m *CreditOverride) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CreditOverride.
+// Note that you need to call CreditOverride.Unwrap() before calling this method if this CreditOverride
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CreditOverride) Update() *CreditOverrideUpdateOne {
+	return NewCreditOverrideClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CreditOverride entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CreditOverride) Unwrap() *CreditOverride {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CreditOverride is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CreditOverride) String() string {
+	var builder strings.Builder
+	builder.WriteString("CreditOverride(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("customer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CustomerID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("granted_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GrantedBy))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("document_type=")
+	builder.WriteString(_m.DocumentType)
+	builder.WriteString(", ")
+	builder.WriteString("document_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DocumentID))
+	builder.WriteString(", ")
+	if v := _m.DocumentAmount; v != nil {
+		builder.WriteString("document_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("credit_snapshot=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreditSnapshot))
+	builder.WriteString(", ")
+	builder.WriteString("used_at=")
+	builder.WriteString(_m.UsedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CreditOverrides is a parsable slice of CreditOverride.
+type CreditOverrides []*CreditOverride
@@ -0,0 +1,817 @@
+// Code generated by ent, DO NOT EDIT.
+
+package creditoverride
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldAmount, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldReason, v))
+}
+
+// GrantedBy applies equality check predicate on the "granted_by" field. It's identical to GrantedByEQ.
+func GrantedBy(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldGrantedBy, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldStatus, v))
+}
+
+// DocumentType applies equality check predicate on the "document_type" field. It's identical to DocumentTypeEQ.
+func DocumentType(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldDocumentType, v))
+}
+
+// DocumentID applies equality check predicate on the "document_id" field. It's identical to DocumentIDEQ.
+func DocumentID(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldDocumentID, v))
+}
+
+// DocumentAmount applies equality check predicate on the "document_amount" field. It's identical to DocumentAmountEQ.
+func DocumentAmount(v decimal.Decimal) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldDocumentAmount, v))
+}
+
+// UsedAt applies equality check predicate on the "used_at" field. It's identical to UsedAtEQ.
+func UsedAt(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLTE(FieldTenantID, v))
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNEQ(FieldCustomerID, v))
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDGT applies the GT predicate on the "customer_id" field.
+func CustomerIDGT(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGT(FieldCustomerID, v))
+}
+
+// CustomerIDGTE applies the GTE predicate on the "customer_id" field.
+func CustomerIDGTE(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGTE(FieldCustomerID, v))
+}
+
+// CustomerIDLT applies the LT predicate on the "customer_id" field.
+func CustomerIDLT(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLT(FieldCustomerID, v))
+}
+
+// CustomerIDLTE applies the LTE predicate on the "customer_id" field.
+func CustomerIDLTE(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLTE(FieldCustomerID, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLTE(FieldAmount, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldContainsFold(FieldReason, v))
+}
+
+// GrantedByEQ applies the EQ predicate on the "granted_by" field.
+func GrantedByEQ(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldGrantedBy, v))
+}
+
+// GrantedByNEQ applies the NEQ predicate on the "granted_by" field.
+func GrantedByNEQ(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNEQ(FieldGrantedBy, v))
+}
+
+// GrantedByIn applies the In predicate on the "granted_by" field.
+func GrantedByIn(vs ...uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIn(FieldGrantedBy, vs...))
+}
+
+// GrantedByNotIn applies the NotIn predicate on the "granted_by" field.
+func GrantedByNotIn(vs ...uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotIn(FieldGrantedBy, vs...))
+}
+
+// GrantedByGT applies the GT predicate on the "granted_by" field.
+func GrantedByGT(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGT(FieldGrantedBy, v))
+}
+
+// GrantedByGTE applies the GTE predicate on the "granted_by" field.
+func GrantedByGTE(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGTE(FieldGrantedBy, v))
+}
+
+// GrantedByLT applies the LT predicate on the "granted_by" field.
+func GrantedByLT(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLT(FieldGrantedBy, v))
+}
+
+// GrantedByLTE applies the LTE predicate on the "granted_by" field.
+func GrantedByLTE(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLTE(FieldGrantedBy, v))
+}
+
+// GrantedByIsNil applies the IsNil predicate on the "granted_by" field.
+func GrantedByIsNil() predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIsNull(FieldGrantedBy))
+}
+
+// GrantedByNotNil applies the NotNil predicate on the "granted_by" field.
+func GrantedByNotNil() predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotNull(FieldGrantedBy))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// DocumentTypeEQ applies the EQ predicate on the "document_type" field.
+func DocumentTypeEQ(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldDocumentType, v))
+}
+
+// DocumentTypeNEQ applies the NEQ predicate on the "document_type" field.
+func DocumentTypeNEQ(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNEQ(FieldDocumentType, v))
+}
+
+// DocumentTypeIn applies the In predicate on the "document_type" field.
+func DocumentTypeIn(vs ...string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIn(FieldDocumentType, vs...))
+}
+
+// DocumentTypeNotIn applies the NotIn predicate on the "document_type" field.
+func DocumentTypeNotIn(vs ...string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotIn(FieldDocumentType, vs...))
+}
+
+// DocumentTypeGT applies the GT predicate on the "document_type" field.
+func DocumentTypeGT(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGT(FieldDocumentType, v))
+}
+
+// DocumentTypeGTE applies the GTE predicate on the "document_type" field.
+func DocumentTypeGTE(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGTE(FieldDocumentType, v))
+}
+
+// DocumentTypeLT applies the LT predicate on the "document_type" field.
+func DocumentTypeLT(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLT(FieldDocumentType, v))
+}
+
+// DocumentTypeLTE applies the LTE predicate on the "document_type" field.
+func DocumentTypeLTE(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLTE(FieldDocumentType, v))
+}
+
+// DocumentTypeContains applies the Contains predicate on the "document_type" field.
+func DocumentTypeContains(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldContains(FieldDocumentType, v))
+}
+
+// DocumentTypeHasPrefix applies the HasPrefix predicate on the "document_type" field.
+func DocumentTypeHasPrefix(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldHasPrefix(FieldDocumentType, v))
+}
+
+// DocumentTypeHasSuffix applies the HasSuffix predicate on the "document_type" field.
+func DocumentTypeHasSuffix(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldHasSuffix(FieldDocumentType, v))
+}
+
+// DocumentTypeIsNil applies the IsNil predicate on the "document_type" field.
+func DocumentTypeIsNil() predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIsNull(FieldDocumentType))
+}
+
+// DocumentTypeNotNil applies the NotNil predicate on the "document_type" field.
+func DocumentTypeNotNil() predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotNull(FieldDocumentType))
+}
+
+// DocumentTypeEqualFold applies the EqualFold predicate on the "document_type" field.
+func DocumentTypeEqualFold(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEqualFold(FieldDocumentType, v))
+}
+
+// DocumentTypeContainsFold applies the ContainsFold predicate on the "document_type" field.
+func DocumentTypeContainsFold(v string) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldContainsFold(FieldDocumentType, v))
+}
+
+// DocumentIDEQ applies the EQ predicate on the "document_id" field.
+func DocumentIDEQ(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldDocumentID, v))
+}
+
+// DocumentIDNEQ applies the NEQ predicate on the "document_id" field.
+func DocumentIDNEQ(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNEQ(FieldDocumentID, v))
+}
+
+// DocumentIDIn applies the In predicate on the "document_id" field.
+func DocumentIDIn(vs ...uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIn(FieldDocumentID, vs...))
+}
+
+// DocumentIDNotIn applies the NotIn predicate on the "document_id" field.
+func DocumentIDNotIn(vs ...uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotIn(FieldDocumentID, vs...))
+}
+
+// DocumentIDGT applies the GT predicate on the "document_id" field.
+func DocumentIDGT(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGT(FieldDocumentID, v))
+}
+
+// DocumentIDGTE applies the GTE predicate on the "document_id" field.
+func DocumentIDGTE(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGTE(FieldDocumentID, v))
+}
+
+// DocumentIDLT applies the LT predicate on the "document_id" field.
+func DocumentIDLT(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLT(FieldDocumentID, v))
+}
+
+// DocumentIDLTE applies the LTE predicate on the "document_id" field.
+func DocumentIDLTE(v uuid.UUID) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLTE(FieldDocumentID, v))
+}
+
+// DocumentIDIsNil applies the IsNil predicate on the "document_id" field.
+func DocumentIDIsNil() predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIsNull(FieldDocumentID))
+}
+
+// DocumentIDNotNil applies the NotNil predicate on the "document_id" field.
+func DocumentIDNotNil() predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotNull(FieldDocumentID))
+}
+
+// DocumentAmountEQ applies the EQ predicate on the "document_amount" field.
+func DocumentAmountEQ(v decimal.Decimal) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldDocumentAmount, v))
+}
+
+// DocumentAmountNEQ applies the NEQ predicate on the "document_amount" field.
+func DocumentAmountNEQ(v decimal.Decimal) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNEQ(FieldDocumentAmount, v))
+}
+
+// DocumentAmountIn applies the In predicate on the "document_amount" field.
+func DocumentAmountIn(vs ...decimal.Decimal) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIn(FieldDocumentAmount, vs...))
+}
+
+// DocumentAmountNotIn applies the NotIn predicate on the "document_amount" field.
+func DocumentAmountNotIn(vs ...decimal.Decimal) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotIn(FieldDocumentAmount, vs...))
+}
+
+// DocumentAmountGT applies the GT predicate on the "document_amount" field.
+func DocumentAmountGT(v decimal.Decimal) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGT(FieldDocumentAmount, v))
+}
+
+// DocumentAmountGTE applies the GTE predicate on the "document_amount" field.
+func DocumentAmountGTE(v decimal.Decimal) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGTE(FieldDocumentAmount, v))
+}
+
+// DocumentAmountLT applies the LT predicate on the "document_amount" field.
+func DocumentAmountLT(v decimal.Decimal) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLT(FieldDocumentAmount, v))
+}
+
+// DocumentAmountLTE applies the LTE predicate on the "document_amount" field.
+func DocumentAmountLTE(v decimal.Decimal) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLTE(FieldDocumentAmount, v))
+}
+
+// DocumentAmountIsNil applies the IsNil predicate on the "document_amount" field.
+func DocumentAmountIsNil() predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIsNull(FieldDocumentAmount))
+}
+
+// DocumentAmountNotNil applies the NotNil predicate on the "document_amount" field.
+func DocumentAmountNotNil() predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotNull(FieldDocumentAmount))
+}
+
+// CreditSnapshotIsNil applies the IsNil predicate on the "credit_snapshot" field.
+func CreditSnapshotIsNil() predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIsNull(FieldCreditSnapshot))
+}
+
+// CreditSnapshotNotNil applies the NotNil predicate on the "credit_snapshot" field.
+func CreditSnapshotNotNil() predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotNull(FieldCreditSnapshot))
+}
+
+// UsedAtEQ applies the EQ predicate on the "used_at" field.
+func UsedAtEQ(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// UsedAtNEQ applies the NEQ predicate on the "used_at" field.
+func UsedAtNEQ(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNEQ(FieldUsedAt, v))
+}
+
+// UsedAtIn applies the In predicate on the "used_at" field.
+func UsedAtIn(vs ...time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIn(FieldUsedAt, vs...))
+}
+
+// UsedAtNotIn applies the NotIn predicate on the "used_at" field.
+func UsedAtNotIn(vs ...time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotIn(FieldUsedAt, vs...))
+}
+
+// UsedAtGT applies the GT predicate on the "used_at" field.
+func UsedAtGT(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGT(FieldUsedAt, v))
+}
+
+// UsedAtGTE applies the GTE predicate on the "used_at" field.
+func UsedAtGTE(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGTE(FieldUsedAt, v))
+}
+
+// UsedAtLT applies the LT predicate on the "used_at" field.
+func UsedAtLT(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLT(FieldUsedAt, v))
+}
+
+// UsedAtLTE applies the LTE predicate on the "used_at" field.
+func UsedAtLTE(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLTE(FieldUsedAt, v))
+}
+
+// UsedAtIsNil applies the IsNil predicate on the "used_at" field.
+func UsedAtIsNil() predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIsNull(FieldUsedAt))
+}
+
+// UsedAtNotNil applies the NotNil predicate on the "used_at" field.
+func UsedAtNotNil() predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotNull(FieldUsedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CreditOverride) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CreditOverride) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CreditOverride) predicate.CreditOverride {
+	return predicate.CreditOverride(sql.NotPredicates(p))
+}
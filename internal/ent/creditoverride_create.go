@@ -0,0 +1,1376 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/creditoverride"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CreditOverrideCreate is the builder for creating a CreditOverride entity.
+type CreditOverrideCreate struct {
+	config
+	mutation *CreditOverrideMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *CreditOverrideCreate) SetTenantID(v uuid.UUID) *CreditOverrideCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_c *CreditOverrideCreate) SetCustomerID(v uuid.UUID) *CreditOverrideCreate {
+	_c.mutation.SetCustomerID(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *CreditOverrideCreate) SetAmount(v decimal.Decimal) *CreditOverrideCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *CreditOverrideCreate) SetCurrency(v string) *CreditOverrideCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *CreditOverrideCreate) SetReason(v string) *CreditOverrideCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (_c *CreditOverrideCreate) SetGrantedBy(v uuid.UUID) *CreditOverrideCreate {
+	_c.mutation.SetGrantedBy(v)
+	return _c
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (_c *CreditOverrideCreate) SetNillableGrantedBy(v *uuid.UUID) *CreditOverrideCreate {
+	if v != nil {
+		_c.SetGrantedBy(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *CreditOverrideCreate) SetExpiresAt(v time.Time) *CreditOverrideCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CreditOverrideCreate) SetStatus(v string) *CreditOverrideCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CreditOverrideCreate) SetNillableStatus(v *string) *CreditOverrideCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetDocumentType sets the "document_type" field.
+func (_c *CreditOverrideCreate) SetDocumentType(v string) *CreditOverrideCreate {
+	_c.mutation.SetDocumentType(v)
+	return _c
+}
+
+// SetNillableDocumentType sets the "document_type" field if the given value is not nil.
+func (_c *CreditOverrideCreate) SetNillableDocumentType(v *string) *CreditOverrideCreate {
+	if v != nil {
+		_c.SetDocumentType(*v)
+	}
+	return _c
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_c *CreditOverrideCreate) SetDocumentID(v uuid.UUID) *CreditOverrideCreate {
+	_c.mutation.SetDocumentID(v)
+	return _c
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_c *CreditOverrideCreate) SetNillableDocumentID(v *uuid.UUID) *CreditOverrideCreate {
+	if v != nil {
+		_c.SetDocumentID(*v)
+	}
+	return _c
+}
+
+// SetDocumentAmount sets the "document_amount" field.
+func (_c *CreditOverrideCreate) SetDocumentAmount(v decimal.Decimal) *CreditOverrideCreate {
+	_c.mutation.SetDocumentAmount(v)
+	return _c
+}
+
+// SetNillableDocumentAmount sets the "document_amount" field if the given value is not nil.
+func (_c *CreditOverrideCreate) SetNillableDocumentAmount(v *decimal.Decimal) *CreditOverrideCreate {
+	if v != nil {
+		_c.SetDocumentAmount(*v)
+	}
+	return _c
+}
+
+// SetCreditSnapshot sets the "credit_snapshot" field.
+func (_c *CreditOverrideCreate) SetCreditSnapshot(v map[string]interface{}) *CreditOverrideCreate {
+	_c.mutation.SetCreditSnapshot(v)
+	return _c
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_c *CreditOverrideCreate) SetUsedAt(v time.Time) *CreditOverrideCreate {
+	_c.mutation.SetUsedAt(v)
+	return _c
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_c *CreditOverrideCreate) SetNillableUsedAt(v *time.Time) *CreditOverrideCreate {
+	if v != nil {
+		_c.SetUsedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CreditOverrideCreate) SetCreatedAt(v time.Time) *CreditOverrideCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CreditOverrideCreate) SetNillableCreatedAt(v *time.Time) *CreditOverrideCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CreditOverrideCreate) SetID(v uuid.UUID) *CreditOverrideCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CreditOverrideCreate) SetNillableID(v *uuid.UUID) *CreditOverrideCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CreditOverrideMutation object of the builder.
+func (_c *CreditOverrideCreate) Mutation() *CreditOverrideMutation {
+	return _c.mutation
+}
+
+// Save creates the CreditOverride in the database.
+func (_c *CreditOverrideCreate) Save(ctx context.Context) (*CreditOverride, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CreditOverrideCreate) SaveX(ctx context.Context) *CreditOverride {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CreditOverrideCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CreditOverrideCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CreditOverrideCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := creditoverride.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := creditoverride.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := creditoverride.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CreditOverrideCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "CreditOverride.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CustomerID(); !ok {
+		return &ValidationError{Name: "customer_id", err: errors.New(`ent: missing required field "CreditOverride.customer_id"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "CreditOverride.amount"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "CreditOverride.currency"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "CreditOverride.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := creditoverride.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "CreditOverride.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "CreditOverride.expires_at"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CreditOverride.status"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CreditOverride.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *CreditOverrideCreate) sqlSave(ctx context.Context) (*CreditOverride, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CreditOverrideCreate) createSpec() (*CreditOverride, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CreditOverride{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(creditoverride.Table, sqlgraph.NewFieldSpec(creditoverride.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(creditoverride.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CustomerID(); ok {
+		_spec.SetField(creditoverride.FieldCustomerID, field.TypeUUID, value)
+		_node.CustomerID = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(creditoverride.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(creditoverride.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(creditoverride.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.GrantedBy(); ok {
+		_spec.SetField(creditoverride.FieldGrantedBy, field.TypeUUID, value)
+		_node.GrantedBy = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(creditoverride.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(creditoverride.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.DocumentType(); ok {
+		_spec.SetField(creditoverride.FieldDocumentType, field.TypeString, value)
+		_node.DocumentType = value
+	}
+	if value, ok := _c.mutation.DocumentID(); ok {
+		_spec.SetField(creditoverride.FieldDocumentID, field.TypeUUID, value)
+		_node.DocumentID = value
+	}
+	if value, ok := _c.mutation.DocumentAmount(); ok {
+		_spec.SetField(creditoverride.FieldDocumentAmount, field.TypeFloat64, value)
+		_node.DocumentAmount = &value
+	}
+	if value, ok := _c.mutation.CreditSnapshot(); ok {
+		_spec.SetField(creditoverride.FieldCreditSnapshot, field.TypeJSON, value)
+		_node.CreditSnapshot = value
+	}
+	if value, ok := _c.mutation.UsedAt(); ok {
+		_spec.SetField(creditoverride.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(creditoverride.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CreditOverride.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CreditOverrideUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CreditOverrideCreate) OnConflict(opts ...sql.ConflictOption) *CreditOverrideUpsertOne {
+	_c.conflict = opts
+	return &CreditOverrideUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CreditOverride.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CreditOverrideCreate) OnConflictColumns(columns ...string) *CreditOverrideUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CreditOverrideUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CreditOverrideUpsertOne is the builder for "upsert"-ing
+	//  one CreditOverride node.
+	CreditOverrideUpsertOne struct {
+		create *CreditOverrideCreate
+	}
+
+	// CreditOverrideUpsert is the "OnConflict" setter.
+	CreditOverrideUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *CreditOverrideUpsert) SetTenantID(v uuid.UUID) *CreditOverrideUpsert {
+	u.Set(creditoverride.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *CreditOverrideUpsert) UpdateTenantID() *CreditOverrideUpsert {
+	u.SetExcluded(creditoverride.FieldTenantID)
+	return u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *CreditOverrideUpsert) SetCustomerID(v uuid.UUID) *CreditOverrideUpsert {
+	u.Set(creditoverride.FieldCustomerID, v)
+	return u
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *CreditOverrideUpsert) UpdateCustomerID() *CreditOverrideUpsert {
+	u.SetExcluded(creditoverride.FieldCustomerID)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *CreditOverrideUpsert) SetAmount(v decimal.Decimal) *CreditOverrideUpsert {
+	u.Set(creditoverride.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *CreditOverrideUpsert) UpdateAmount() *CreditOverrideUpsert {
+	u.SetExcluded(creditoverride.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *CreditOverrideUpsert) AddAmount(v decimal.Decimal) *CreditOverrideUpsert {
+	u.Add(creditoverride.FieldAmount, v)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *CreditOverrideUpsert) SetCurrency(v string) *CreditOverrideUpsert {
+	u.Set(creditoverride.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *CreditOverrideUpsert) UpdateCurrency() *CreditOverrideUpsert {
+	u.SetExcluded(creditoverride.FieldCurrency)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *CreditOverrideUpsert) SetReason(v string) *CreditOverrideUpsert {
+	u.Set(creditoverride.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *CreditOverrideUpsert) UpdateReason() *CreditOverrideUpsert {
+	u.SetExcluded(creditoverride.FieldReason)
+	return u
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (u *CreditOverrideUpsert) SetGrantedBy(v uuid.UUID) *CreditOverrideUpsert {
+	u.Set(creditoverride.FieldGrantedBy, v)
+	return u
+}
+
+// UpdateGrantedBy sets the "granted_by" field to the value that was provided on create.
+func (u *CreditOverrideUpsert) UpdateGrantedBy() *CreditOverrideUpsert {
+	u.SetExcluded(creditoverride.FieldGrantedBy)
+	return u
+}
+
+// ClearGrantedBy clears the value of the "granted_by" field.
+func (u *CreditOverrideUpsert) ClearGrantedBy() *CreditOverrideUpsert {
+	u.SetNull(creditoverride.FieldGrantedBy)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *CreditOverrideUpsert) SetExpiresAt(v time.Time) *CreditOverrideUpsert {
+	u.Set(creditoverride.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *CreditOverrideUpsert) UpdateExpiresAt() *CreditOverrideUpsert {
+	u.SetExcluded(creditoverride.FieldExpiresAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CreditOverrideUpsert) SetStatus(v string) *CreditOverrideUpsert {
+	u.Set(creditoverride.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CreditOverrideUpsert) UpdateStatus() *CreditOverrideUpsert {
+	u.SetExcluded(creditoverride.FieldStatus)
+	return u
+}
+
+// SetDocumentType sets the "document_type" field.
+func (u *CreditOverrideUpsert) SetDocumentType(v string) *CreditOverrideUpsert {
+	u.Set(creditoverride.FieldDocumentType, v)
+	return u
+}
+
+// UpdateDocumentType sets the "document_type" field to the value that was provided on create.
+func (u *CreditOverrideUpsert) UpdateDocumentType() *CreditOverrideUpsert {
+	u.SetExcluded(creditoverride.FieldDocumentType)
+	return u
+}
+
+// ClearDocumentType clears the value of the "document_type" field.
+func (u *CreditOverrideUpsert) ClearDocumentType() *CreditOverrideUpsert {
+	u.SetNull(creditoverride.FieldDocumentType)
+	return u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *CreditOverrideUpsert) SetDocumentID(v uuid.UUID) *CreditOverrideUpsert {
+	u.Set(creditoverride.FieldDocumentID, v)
+	return u
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *CreditOverrideUpsert) UpdateDocumentID() *CreditOverrideUpsert {
+	u.SetExcluded(creditoverride.FieldDocumentID)
+	return u
+}
+
+// ClearDocumentID clears the value of the "document_id" field.
+func (u *CreditOverrideUpsert) ClearDocumentID() *CreditOverrideUpsert {
+	u.SetNull(creditoverride.FieldDocumentID)
+	return u
+}
+
+// SetDocumentAmount sets the "document_amount" field.
+func (u *CreditOverrideUpsert) SetDocumentAmount(v decimal.Decimal) *CreditOverrideUpsert {
+	u.Set(creditoverride.FieldDocumentAmount, v)
+	return u
+}
+
+// UpdateDocumentAmount sets the "document_amount" field to the value that was provided on create.
+func (u *CreditOverrideUpsert) UpdateDocumentAmount() *CreditOverrideUpsert {
+	u.SetExcluded(creditoverride.FieldDocumentAmount)
+	return u
+}
+
+// AddDocumentAmount adds v to the "document_amount" field.
+func (u *CreditOverrideUpsert) AddDocumentAmount(v decimal.Decimal) *CreditOverrideUpsert {
+	u.Add(creditoverride.FieldDocumentAmount, v)
+	return u
+}
+
+// ClearDocumentAmount clears the value of the "document_amount" field.
+func (u *CreditOverrideUpsert) ClearDocumentAmount() *CreditOverrideUpsert {
+	u.SetNull(creditoverride.FieldDocumentAmount)
+	return u
+}
+
+// SetCreditSnapshot sets the "credit_snapshot" field.
+func (u *CreditOverrideUpsert) SetCreditSnapshot(v map[string]interface{}) *CreditOverrideUpsert {
+	u.Set(creditoverride.FieldCreditSnapshot, v)
+	return u
+}
+
+// UpdateCreditSnapshot sets the "credit_snapshot" field to the value that was provided on create.
+func (u *CreditOverrideUpsert) UpdateCreditSnapshot() *CreditOverrideUpsert {
+	u.SetExcluded(creditoverride.FieldCreditSnapshot)
+	return u
+}
+
+// ClearCreditSnapshot clears the value of the "credit_snapshot" field.
+func (u *CreditOverrideUpsert) ClearCreditSnapshot() *CreditOverrideUpsert {
+	u.SetNull(creditoverride.FieldCreditSnapshot)
+	return u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *CreditOverrideUpsert) SetUsedAt(v time.Time) *CreditOverrideUpsert {
+	u.Set(creditoverride.FieldUsedAt, v)
+	return u
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *CreditOverrideUpsert) UpdateUsedAt() *CreditOverrideUpsert {
+	u.SetExcluded(creditoverride.FieldUsedAt)
+	return u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *CreditOverrideUpsert) ClearUsedAt() *CreditOverrideUpsert {
+	u.SetNull(creditoverride.FieldUsedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CreditOverride.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(creditoverride.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CreditOverrideUpsertOne) UpdateNewValues() *CreditOverrideUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(creditoverride.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(creditoverride.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CreditOverride.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CreditOverrideUpsertOne) Ignore() *CreditOverrideUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CreditOverrideUpsertOne) DoNothing() *CreditOverrideUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CreditOverrideCreate.OnConflict
+// documentation for more info.
+func (u *CreditOverrideUpsertOne) Update(set func(*CreditOverrideUpsert)) *CreditOverrideUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CreditOverrideUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *CreditOverrideUpsertOne) SetTenantID(v uuid.UUID) *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *CreditOverrideUpsertOne) UpdateTenantID() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *CreditOverrideUpsertOne) SetCustomerID(v uuid.UUID) *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetCustomerID(v)
+	})
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *CreditOverrideUpsertOne) UpdateCustomerID() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateCustomerID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *CreditOverrideUpsertOne) SetAmount(v decimal.Decimal) *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *CreditOverrideUpsertOne) AddAmount(v decimal.Decimal) *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *CreditOverrideUpsertOne) UpdateAmount() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *CreditOverrideUpsertOne) SetCurrency(v string) *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *CreditOverrideUpsertOne) UpdateCurrency() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *CreditOverrideUpsertOne) SetReason(v string) *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *CreditOverrideUpsertOne) UpdateReason() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (u *CreditOverrideUpsertOne) SetGrantedBy(v uuid.UUID) *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetGrantedBy(v)
+	})
+}
+
+// UpdateGrantedBy sets the "granted_by" field to the value that was provided on create.
+func (u *CreditOverrideUpsertOne) UpdateGrantedBy() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateGrantedBy()
+	})
+}
+
+// ClearGrantedBy clears the value of the "granted_by" field.
+func (u *CreditOverrideUpsertOne) ClearGrantedBy() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.ClearGrantedBy()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *CreditOverrideUpsertOne) SetExpiresAt(v time.Time) *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *CreditOverrideUpsertOne) UpdateExpiresAt() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CreditOverrideUpsertOne) SetStatus(v string) *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CreditOverrideUpsertOne) UpdateStatus() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDocumentType sets the "document_type" field.
+func (u *CreditOverrideUpsertOne) SetDocumentType(v string) *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetDocumentType(v)
+	})
+}
+
+// UpdateDocumentType sets the "document_type" field to the value that was provided on create.
+func (u *CreditOverrideUpsertOne) UpdateDocumentType() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateDocumentType()
+	})
+}
+
+// ClearDocumentType clears the value of the "document_type" field.
+func (u *CreditOverrideUpsertOne) ClearDocumentType() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.ClearDocumentType()
+	})
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *CreditOverrideUpsertOne) SetDocumentID(v uuid.UUID) *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetDocumentID(v)
+	})
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *CreditOverrideUpsertOne) UpdateDocumentID() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateDocumentID()
+	})
+}
+
+// ClearDocumentID clears the value of the "document_id" field.
+func (u *CreditOverrideUpsertOne) ClearDocumentID() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.ClearDocumentID()
+	})
+}
+
+// SetDocumentAmount sets the "document_amount" field.
+func (u *CreditOverrideUpsertOne) SetDocumentAmount(v decimal.Decimal) *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetDocumentAmount(v)
+	})
+}
+
+// AddDocumentAmount adds v to the "document_amount" field.
+func (u *CreditOverrideUpsertOne) AddDocumentAmount(v decimal.Decimal) *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.AddDocumentAmount(v)
+	})
+}
+
+// UpdateDocumentAmount sets the "document_amount" field to the value that was provided on create.
+func (u *CreditOverrideUpsertOne) UpdateDocumentAmount() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateDocumentAmount()
+	})
+}
+
+// ClearDocumentAmount clears the value of the "document_amount" field.
+func (u *CreditOverrideUpsertOne) ClearDocumentAmount() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.ClearDocumentAmount()
+	})
+}
+
+// SetCreditSnapshot sets the "credit_snapshot" field.
+func (u *CreditOverrideUpsertOne) SetCreditSnapshot(v map[string]interface{}) *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetCreditSnapshot(v)
+	})
+}
+
+// UpdateCreditSnapshot sets the "credit_snapshot" field to the value that was provided on create.
+func (u *CreditOverrideUpsertOne) UpdateCreditSnapshot() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateCreditSnapshot()
+	})
+}
+
+// ClearCreditSnapshot clears the value of the "credit_snapshot" field.
+func (u *CreditOverrideUpsertOne) ClearCreditSnapshot() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.ClearCreditSnapshot()
+	})
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *CreditOverrideUpsertOne) SetUsedAt(v time.Time) *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *CreditOverrideUpsertOne) UpdateUsedAt() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *CreditOverrideUpsertOne) ClearUsedAt() *CreditOverrideUpsertOne {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CreditOverrideUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CreditOverrideCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CreditOverrideUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CreditOverrideUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CreditOverrideUpsertOne.ID is not supported by MySQL driver. Use CreditOverrideUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CreditOverrideUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CreditOverrideCreateBulk is the builder for creating many CreditOverride entities in bulk.
+type CreditOverrideCreateBulk struct {
+	config
+	err      error
+	builders []*CreditOverrideCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CreditOverride entities in the database.
+func (_c *CreditOverrideCreateBulk) Save(ctx context.Context) ([]*CreditOverride, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CreditOverride, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CreditOverrideMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CreditOverrideCreateBulk) SaveX(ctx context.Context) []*CreditOverride {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CreditOverrideCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CreditOverrideCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CreditOverride.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CreditOverrideUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CreditOverrideCreateBulk) OnConflict(opts ...sql.ConflictOption) *CreditOverrideUpsertBulk {
+	_c.conflict = opts
+	return &CreditOverrideUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CreditOverride.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CreditOverrideCreateBulk) OnConflictColumns(columns ...string) *CreditOverrideUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CreditOverrideUpsertBulk{
+		create: _c,
+	}
+}
+
+// CreditOverrideUpsertBulk is the builder for "upsert"-ing
+// a bulk of CreditOverride nodes.
+type CreditOverrideUpsertBulk struct {
+	create *CreditOverrideCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CreditOverride.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(creditoverride.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CreditOverrideUpsertBulk) UpdateNewValues() *CreditOverrideUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(creditoverride.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(creditoverride.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CreditOverride.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CreditOverrideUpsertBulk) Ignore() *CreditOverrideUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CreditOverrideUpsertBulk) DoNothing() *CreditOverrideUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CreditOverrideCreateBulk.OnConflict
+// documentation for more info.
+func (u *CreditOverrideUpsertBulk) Update(set func(*CreditOverrideUpsert)) *CreditOverrideUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CreditOverrideUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *CreditOverrideUpsertBulk) SetTenantID(v uuid.UUID) *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *CreditOverrideUpsertBulk) UpdateTenantID() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *CreditOverrideUpsertBulk) SetCustomerID(v uuid.UUID) *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetCustomerID(v)
+	})
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *CreditOverrideUpsertBulk) UpdateCustomerID() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateCustomerID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *CreditOverrideUpsertBulk) SetAmount(v decimal.Decimal) *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *CreditOverrideUpsertBulk) AddAmount(v decimal.Decimal) *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *CreditOverrideUpsertBulk) UpdateAmount() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *CreditOverrideUpsertBulk) SetCurrency(v string) *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *CreditOverrideUpsertBulk) UpdateCurrency() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *CreditOverrideUpsertBulk) SetReason(v string) *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *CreditOverrideUpsertBulk) UpdateReason() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (u *CreditOverrideUpsertBulk) SetGrantedBy(v uuid.UUID) *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetGrantedBy(v)
+	})
+}
+
+// UpdateGrantedBy sets the "granted_by" field to the value that was provided on create.
+func (u *CreditOverrideUpsertBulk) UpdateGrantedBy() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateGrantedBy()
+	})
+}
+
+// ClearGrantedBy clears the value of the "granted_by" field.
+func (u *CreditOverrideUpsertBulk) ClearGrantedBy() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.ClearGrantedBy()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *CreditOverrideUpsertBulk) SetExpiresAt(v time.Time) *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *CreditOverrideUpsertBulk) UpdateExpiresAt() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CreditOverrideUpsertBulk) SetStatus(v string) *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CreditOverrideUpsertBulk) UpdateStatus() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDocumentType sets the "document_type" field.
+func (u *CreditOverrideUpsertBulk) SetDocumentType(v string) *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetDocumentType(v)
+	})
+}
+
+// UpdateDocumentType sets the "document_type" field to the value that was provided on create.
+func (u *CreditOverrideUpsertBulk) UpdateDocumentType() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateDocumentType()
+	})
+}
+
+// ClearDocumentType clears the value of the "document_type" field.
+func (u *CreditOverrideUpsertBulk) ClearDocumentType() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.ClearDocumentType()
+	})
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *CreditOverrideUpsertBulk) SetDocumentID(v uuid.UUID) *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetDocumentID(v)
+	})
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *CreditOverrideUpsertBulk) UpdateDocumentID() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateDocumentID()
+	})
+}
+
+// ClearDocumentID clears the value of the "document_id" field.
+func (u *CreditOverrideUpsertBulk) ClearDocumentID() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.ClearDocumentID()
+	})
+}
+
+// SetDocumentAmount sets the "document_amount" field.
+func (u *CreditOverrideUpsertBulk) SetDocumentAmount(v decimal.Decimal) *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetDocumentAmount(v)
+	})
+}
+
+// AddDocumentAmount adds v to the "document_amount" field.
+func (u *CreditOverrideUpsertBulk) AddDocumentAmount(v decimal.Decimal) *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.AddDocumentAmount(v)
+	})
+}
+
+// UpdateDocumentAmount sets the "document_amount" field to the value that was provided on create.
+func (u *CreditOverrideUpsertBulk) UpdateDocumentAmount() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateDocumentAmount()
+	})
+}
+
+// ClearDocumentAmount clears the value of the "document_amount" field.
+func (u *CreditOverrideUpsertBulk) ClearDocumentAmount() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.ClearDocumentAmount()
+	})
+}
+
+// SetCreditSnapshot sets the "credit_snapshot" field.
+func (u *CreditOverrideUpsertBulk) SetCreditSnapshot(v map[string]interface{}) *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetCreditSnapshot(v)
+	})
+}
+
+// UpdateCreditSnapshot sets the "credit_snapshot" field to the value that was provided on create.
+func (u *CreditOverrideUpsertBulk) UpdateCreditSnapshot() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateCreditSnapshot()
+	})
+}
+
+// ClearCreditSnapshot clears the value of the "credit_snapshot" field.
+func (u *CreditOverrideUpsertBulk) ClearCreditSnapshot() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.ClearCreditSnapshot()
+	})
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *CreditOverrideUpsertBulk) SetUsedAt(v time.Time) *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *CreditOverrideUpsertBulk) UpdateUsedAt() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *CreditOverrideUpsertBulk) ClearUsedAt() *CreditOverrideUpsertBulk {
+	return u.Update(func(s *CreditOverrideUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CreditOverrideUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CreditOverrideCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CreditOverrideCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CreditOverrideUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
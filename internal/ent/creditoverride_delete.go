@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/creditoverride"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+)
+
+// CreditOverrideDelete is the builder for deleting a CreditOverride entity.
+type CreditOverrideDelete struct {
+	config
+	hooks    []Hook
+	mutation *CreditOverrideMutation
+}
+
+// Where appends a list predicates to the CreditOverrideDelete builder.
+func (_d *CreditOverrideDelete) Where(ps ...predicate.CreditOverride) *CreditOverrideDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CreditOverrideDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CreditOverrideDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CreditOverrideDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(creditoverride.Table, sqlgraph.NewFieldSpec(creditoverride.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CreditOverrideDeleteOne is the builder for deleting a single CreditOverride entity.
+type CreditOverrideDeleteOne struct {
+	_d *CreditOverrideDelete
+}
+
+// Where appends a list predicates to the CreditOverrideDelete builder.
+func (_d *CreditOverrideDeleteOne) Where(ps ...predicate.CreditOverride) *CreditOverrideDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CreditOverrideDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{creditoverride.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CreditOverrideDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
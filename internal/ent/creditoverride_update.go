@@ -0,0 +1,778 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/creditoverride"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CreditOverrideUpdate is the builder for updating CreditOverride entities.
+type CreditOverrideUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CreditOverrideMutation
+}
+
+// Where appends a list predicates to the CreditOverrideUpdate builder.
+func (_u *CreditOverrideUpdate) Where(ps ...predicate.CreditOverride) *CreditOverrideUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *CreditOverrideUpdate) SetTenantID(v uuid.UUID) *CreditOverrideUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *CreditOverrideUpdate) SetNillableTenantID(v *uuid.UUID) *CreditOverrideUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *CreditOverrideUpdate) SetCustomerID(v uuid.UUID) *CreditOverrideUpdate {
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *CreditOverrideUpdate) SetNillableCustomerID(v *uuid.UUID) *CreditOverrideUpdate {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *CreditOverrideUpdate) SetAmount(v decimal.Decimal) *CreditOverrideUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *CreditOverrideUpdate) SetNillableAmount(v *decimal.Decimal) *CreditOverrideUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *CreditOverrideUpdate) AddAmount(v decimal.Decimal) *CreditOverrideUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *CreditOverrideUpdate) SetCurrency(v string) *CreditOverrideUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *CreditOverrideUpdate) SetNillableCurrency(v *string) *CreditOverrideUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *CreditOverrideUpdate) SetReason(v string) *CreditOverrideUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *CreditOverrideUpdate) SetNillableReason(v *string) *CreditOverrideUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (_u *CreditOverrideUpdate) SetGrantedBy(v uuid.UUID) *CreditOverrideUpdate {
+	_u.mutation.SetGrantedBy(v)
+	return _u
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (_u *CreditOverrideUpdate) SetNillableGrantedBy(v *uuid.UUID) *CreditOverrideUpdate {
+	if v != nil {
+		_u.SetGrantedBy(*v)
+	}
+	return _u
+}
+
+// ClearGrantedBy clears the value of the "granted_by" field.
+func (_u *CreditOverrideUpdate) ClearGrantedBy() *CreditOverrideUpdate {
+	_u.mutation.ClearGrantedBy()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *CreditOverrideUpdate) SetExpiresAt(v time.Time) *CreditOverrideUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *CreditOverrideUpdate) SetNillableExpiresAt(v *time.Time) *CreditOverrideUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CreditOverrideUpdate) SetStatus(v string) *CreditOverrideUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CreditOverrideUpdate) SetNillableStatus(v *string) *CreditOverrideUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDocumentType sets the "document_type" field.
+func (_u *CreditOverrideUpdate) SetDocumentType(v string) *CreditOverrideUpdate {
+	_u.mutation.SetDocumentType(v)
+	return _u
+}
+
+// SetNillableDocumentType sets the "document_type" field if the given value is not nil.
+func (_u *CreditOverrideUpdate) SetNillableDocumentType(v *string) *CreditOverrideUpdate {
+	if v != nil {
+		_u.SetDocumentType(*v)
+	}
+	return _u
+}
+
+// ClearDocumentType clears the value of the "document_type" field.
+func (_u *CreditOverrideUpdate) ClearDocumentType() *CreditOverrideUpdate {
+	_u.mutation.ClearDocumentType()
+	return _u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_u *CreditOverrideUpdate) SetDocumentID(v uuid.UUID) *CreditOverrideUpdate {
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *CreditOverrideUpdate) SetNillableDocumentID(v *uuid.UUID) *CreditOverrideUpdate {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// ClearDocumentID clears the value of the "document_id" field.
+func (_u *CreditOverrideUpdate) ClearDocumentID() *CreditOverrideUpdate {
+	_u.mutation.ClearDocumentID()
+	return _u
+}
+
+// SetDocumentAmount sets the "document_amount" field.
+func (_u *CreditOverrideUpdate) SetDocumentAmount(v decimal.Decimal) *CreditOverrideUpdate {
+	_u.mutation.ResetDocumentAmount()
+	_u.mutation.SetDocumentAmount(v)
+	return _u
+}
+
+// SetNillableDocumentAmount sets the "document_amount" field if the given value is not nil.
+func (_u *CreditOverrideUpdate) SetNillableDocumentAmount(v *decimal.Decimal) *CreditOverrideUpdate {
+	if v != nil {
+		_u.SetDocumentAmount(*v)
+	}
+	return _u
+}
+
+// AddDocumentAmount adds value to the "document_amount" field.
+func (_u *CreditOverrideUpdate) AddDocumentAmount(v decimal.Decimal) *CreditOverrideUpdate {
+	_u.mutation.AddDocumentAmount(v)
+	return _u
+}
+
+// ClearDocumentAmount clears the value of the "document_amount" field.
+func (_u *CreditOverrideUpdate) ClearDocumentAmount() *CreditOverrideUpdate {
+	_u.mutation.ClearDocumentAmount()
+	return _u
+}
+
+// SetCreditSnapshot sets the "credit_snapshot" field.
+func (_u *CreditOverrideUpdate) SetCreditSnapshot(v map[string]interface{}) *CreditOverrideUpdate {
+	_u.mutation.SetCreditSnapshot(v)
+	return _u
+}
+
+// ClearCreditSnapshot clears the value of the "credit_snapshot" field.
+func (_u *CreditOverrideUpdate) ClearCreditSnapshot() *CreditOverrideUpdate {
+	_u.mutation.ClearCreditSnapshot()
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *CreditOverrideUpdate) SetUsedAt(v time.Time) *CreditOverrideUpdate {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *CreditOverrideUpdate) SetNillableUsedAt(v *time.Time) *CreditOverrideUpdate {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *CreditOverrideUpdate) ClearUsedAt() *CreditOverrideUpdate {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// Mutation returns the CreditOverrideMutation object of the builder.
+func (_u *CreditOverrideUpdate) Mutation() *CreditOverrideMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CreditOverrideUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CreditOverrideUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CreditOverrideUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CreditOverrideUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CreditOverrideUpdate) check() error {
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := creditoverride.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "CreditOverride.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CreditOverrideUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(creditoverride.Table, creditoverride.Columns, sqlgraph.NewFieldSpec(creditoverride.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(creditoverride.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(creditoverride.FieldCustomerID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(creditoverride.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(creditoverride.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(creditoverride.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(creditoverride.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GrantedBy(); ok {
+		_spec.SetField(creditoverride.FieldGrantedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.GrantedByCleared() {
+		_spec.ClearField(creditoverride.FieldGrantedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(creditoverride.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(creditoverride.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DocumentType(); ok {
+		_spec.SetField(creditoverride.FieldDocumentType, field.TypeString, value)
+	}
+	if _u.mutation.DocumentTypeCleared() {
+		_spec.ClearField(creditoverride.FieldDocumentType, field.TypeString)
+	}
+	if value, ok := _u.mutation.DocumentID(); ok {
+		_spec.SetField(creditoverride.FieldDocumentID, field.TypeUUID, value)
+	}
+	if _u.mutation.DocumentIDCleared() {
+		_spec.ClearField(creditoverride.FieldDocumentID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DocumentAmount(); ok {
+		_spec.SetField(creditoverride.FieldDocumentAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDocumentAmount(); ok {
+		_spec.AddField(creditoverride.FieldDocumentAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.DocumentAmountCleared() {
+		_spec.ClearField(creditoverride.FieldDocumentAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CreditSnapshot(); ok {
+		_spec.SetField(creditoverride.FieldCreditSnapshot, field.TypeJSON, value)
+	}
+	if _u.mutation.CreditSnapshotCleared() {
+		_spec.ClearField(creditoverride.FieldCreditSnapshot, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(creditoverride.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(creditoverride.FieldUsedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{creditoverride.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CreditOverrideUpdateOne is the builder for updating a single CreditOverride entity.
+type CreditOverrideUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CreditOverrideMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *CreditOverrideUpdateOne) SetTenantID(v uuid.UUID) *CreditOverrideUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *CreditOverrideUpdateOne) SetNillableTenantID(v *uuid.UUID) *CreditOverrideUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *CreditOverrideUpdateOne) SetCustomerID(v uuid.UUID) *CreditOverrideUpdateOne {
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *CreditOverrideUpdateOne) SetNillableCustomerID(v *uuid.UUID) *CreditOverrideUpdateOne {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *CreditOverrideUpdateOne) SetAmount(v decimal.Decimal) *CreditOverrideUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *CreditOverrideUpdateOne) SetNillableAmount(v *decimal.Decimal) *CreditOverrideUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *CreditOverrideUpdateOne) AddAmount(v decimal.Decimal) *CreditOverrideUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *CreditOverrideUpdateOne) SetCurrency(v string) *CreditOverrideUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *CreditOverrideUpdateOne) SetNillableCurrency(v *string) *CreditOverrideUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *CreditOverrideUpdateOne) SetReason(v string) *CreditOverrideUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *CreditOverrideUpdateOne) SetNillableReason(v *string) *CreditOverrideUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (_u *CreditOverrideUpdateOne) SetGrantedBy(v uuid.UUID) *CreditOverrideUpdateOne {
+	_u.mutation.SetGrantedBy(v)
+	return _u
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (_u *CreditOverrideUpdateOne) SetNillableGrantedBy(v *uuid.UUID) *CreditOverrideUpdateOne {
+	if v != nil {
+		_u.SetGrantedBy(*v)
+	}
+	return _u
+}
+
+// ClearGrantedBy clears the value of the "granted_by" field.
+func (_u *CreditOverrideUpdateOne) ClearGrantedBy() *CreditOverrideUpdateOne {
+	_u.mutation.ClearGrantedBy()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *CreditOverrideUpdateOne) SetExpiresAt(v time.Time) *CreditOverrideUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *CreditOverrideUpdateOne) SetNillableExpiresAt(v *time.Time) *CreditOverrideUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CreditOverrideUpdateOne) SetStatus(v string) *CreditOverrideUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CreditOverrideUpdateOne) SetNillableStatus(v *string) *CreditOverrideUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDocumentType sets the "document_type" field.
+func (_u *CreditOverrideUpdateOne) SetDocumentType(v string) *CreditOverrideUpdateOne {
+	_u.mutation.SetDocumentType(v)
+	return _u
+}
+
+// SetNillableDocumentType sets the "document_type" field if the given value is not nil.
+func (_u *CreditOverrideUpdateOne) SetNillableDocumentType(v *string) *CreditOverrideUpdateOne {
+	if v != nil {
+		_u.SetDocumentType(*v)
+	}
+	return _u
+}
+
+// ClearDocumentType clears the value of the "document_type" field.
+func (_u *CreditOverrideUpdateOne) ClearDocumentType() *CreditOverrideUpdateOne {
+	_u.mutation.ClearDocumentType()
+	return _u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_u *CreditOverrideUpdateOne) SetDocumentID(v uuid.UUID) *CreditOverrideUpdateOne {
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *CreditOverrideUpdateOne) SetNillableDocumentID(v *uuid.UUID) *CreditOverrideUpdateOne {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// ClearDocumentID clears the value of the "document_id" field.
+func (_u *CreditOverrideUpdateOne) ClearDocumentID() *CreditOverrideUpdateOne {
+	_u.mutation.ClearDocumentID()
+	return _u
+}
+
+// SetDocumentAmount sets the "document_amount" field.
+func (_u *CreditOverrideUpdateOne) SetDocumentAmount(v decimal.Decimal) *CreditOverrideUpdateOne {
+	_u.mutation.ResetDocumentAmount()
+	_u.mutation.SetDocumentAmount(v)
+	return _u
+}
+
+// SetNillableDocumentAmount sets the "document_amount" field if the given value is not nil.
+func (_u *CreditOverrideUpdateOne) SetNillableDocumentAmount(v *decimal.Decimal) *CreditOverrideUpdateOne {
+	if v != nil {
+		_u.SetDocumentAmount(*v)
+	}
+	return _u
+}
+
+// AddDocumentAmount adds value to the "document_amount" field.
+func (_u *CreditOverrideUpdateOne) AddDocumentAmount(v decimal.Decimal) *CreditOverrideUpdateOne {
+	_u.mutation.AddDocumentAmount(v)
+	return _u
+}
+
+// ClearDocumentAmount clears the value of the "document_amount" field.
+func (_u *CreditOverrideUpdateOne) ClearDocumentAmount() *CreditOverrideUpdateOne {
+	_u.mutation.ClearDocumentAmount()
+	return _u
+}
+
+// SetCreditSnapshot sets the "credit_snapshot" field.
+func (_u *CreditOverrideUpdateOne) SetCreditSnapshot(v map[string]interface{}) *CreditOverrideUpdateOne {
+	_u.mutation.SetCreditSnapshot(v)
+	return _u
+}
+
+// ClearCreditSnapshot clears the value of the "credit_snapshot" field.
+func (_u *CreditOverrideUpdateOne) ClearCreditSnapshot() *CreditOverrideUpdateOne {
+	_u.mutation.ClearCreditSnapshot()
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *CreditOverrideUpdateOne) SetUsedAt(v time.Time) *CreditOverrideUpdateOne {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *CreditOverrideUpdateOne) SetNillableUsedAt(v *time.Time) *CreditOverrideUpdateOne {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *CreditOverrideUpdateOne) ClearUsedAt() *CreditOverrideUpdateOne {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// Mutation returns the CreditOverrideMutation object of the builder.
+func (_u *CreditOverrideUpdateOne) Mutation() *CreditOverrideMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CreditOverrideUpdate builder.
+func (_u *CreditOverrideUpdateOne) Where(ps ...predicate.CreditOverride) *CreditOverrideUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CreditOverrideUpdateOne) Select(field string, fields ...string) *CreditOverrideUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CreditOverride entity.
+func (_u *CreditOverrideUpdateOne) Save(ctx context.Context) (*CreditOverride, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CreditOverrideUpdateOne) SaveX(ctx context.Context) *CreditOverride {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CreditOverrideUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CreditOverrideUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CreditOverrideUpdateOne) check() error {
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := creditoverride.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "CreditOverride.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CreditOverrideUpdateOne) sqlSave(ctx context.Context) (_node *CreditOverride, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(creditoverride.Table, creditoverride.Columns, sqlgraph.NewFieldSpec(creditoverride.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CreditOverride.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, creditoverride.FieldID)
+		for _, f := range fields {
+			if !creditoverride.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != creditoverride.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(creditoverride.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(creditoverride.FieldCustomerID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(creditoverride.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(creditoverride.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(creditoverride.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(creditoverride.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GrantedBy(); ok {
+		_spec.SetField(creditoverride.FieldGrantedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.GrantedByCleared() {
+		_spec.ClearField(creditoverride.FieldGrantedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(creditoverride.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(creditoverride.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DocumentType(); ok {
+		_spec.SetField(creditoverride.FieldDocumentType, field.TypeString, value)
+	}
+	if _u.mutation.DocumentTypeCleared() {
+		_spec.ClearField(creditoverride.FieldDocumentType, field.TypeString)
+	}
+	if value, ok := _u.mutation.DocumentID(); ok {
+		_spec.SetField(creditoverride.FieldDocumentID, field.TypeUUID, value)
+	}
+	if _u.mutation.DocumentIDCleared() {
+		_spec.ClearField(creditoverride.FieldDocumentID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DocumentAmount(); ok {
+		_spec.SetField(creditoverride.FieldDocumentAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDocumentAmount(); ok {
+		_spec.AddField(creditoverride.FieldDocumentAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.DocumentAmountCleared() {
+		_spec.ClearField(creditoverride.FieldDocumentAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CreditSnapshot(); ok {
+		_spec.SetField(creditoverride.FieldCreditSnapshot, field.TypeJSON, value)
+	}
+	if _u.mutation.CreditSnapshotCleared() {
+		_spec.ClearField(creditoverride.FieldCreditSnapshot, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(creditoverride.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(creditoverride.FieldUsedAt, field.TypeTime)
+	}
+	_node = &CreditOverride{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{creditoverride.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,347 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/customer"
+	"github.com/bengobox/treasury-api/internal/modules/customers/profile"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Customer is the model entity for the Customer schema.
+type Customer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Sequential customer number
+	CustomerNumber string `json:"customer_number,omitempty"`
+	// Registered legal name
+	LegalName string `json:"legal_name,omitempty"`
+	// TradingName holds the value of the "trading_name" field.
+	TradingName string `json:"trading_name,omitempty"`
+	// KRA PIN (tax identification number)
+	KraPin string `json:"kra_pin,omitempty"`
+	// Billing email
+	Email string `json:"email,omitempty"`
+	// Billing phone
+	Phone string `json:"phone,omitempty"`
+	// BillingAddresses holds the value of the "billing_addresses" field.
+	BillingAddresses []profile.Address `json:"billing_addresses,omitempty"`
+	// Contacts holds the value of the "contacts" field.
+	Contacts []profile.Contact `json:"contacts,omitempty"`
+	// ISO currency code used for new invoices
+	DefaultCurrency string `json:"default_currency,omitempty"`
+	// Days from invoice date to due date
+	PaymentTermsDays int `json:"payment_terms_days,omitempty"`
+	// Credit limit in the default currency (empty = no limit)
+	CreditLimit *decimal.Decimal `json:"credit_limit,omitempty"`
+	// New invoices and on-account sales are blocked while on hold
+	CreditHold bool `json:"credit_hold,omitempty"`
+	// Hold source: auto (overdue invoices), manual
+	CreditHoldSource string `json:"credit_hold_source,omitempty"`
+	// CreditHoldReason holds the value of the "credit_hold_reason" field.
+	CreditHoldReason string `json:"credit_hold_reason,omitempty"`
+	// CreditHoldAt holds the value of the "credit_hold_at" field.
+	CreditHoldAt time.Time `json:"credit_hold_at,omitempty"`
+	// Linked auth-service user, for customers who log in
+	AuthUserID uuid.UUID `json:"auth_user_id,omitempty"`
+	// Status: active, inactive
+	Status string `json:"status,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Customer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case customer.FieldCreditLimit:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case customer.FieldBillingAddresses, customer.FieldContacts, customer.FieldMetadata:
+			values[i] = new([]byte)
+		case customer.FieldCreditHold:
+			values[i] = new(sql.NullBool)
+		case customer.FieldPaymentTermsDays:
+			values[i] = new(sql.NullInt64)
+		case customer.FieldCustomerNumber, customer.FieldLegalName, customer.FieldTradingName, customer.FieldKraPin, customer.FieldEmail, customer.FieldPhone, customer.FieldDefaultCurrency, customer.FieldCreditHoldSource, customer.FieldCreditHoldReason, customer.FieldStatus:
+			values[i] = new(sql.NullString)
+		case customer.FieldCreditHoldAt, customer.FieldCreatedAt, customer.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case customer.FieldID, customer.FieldTenantID, customer.FieldAuthUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Customer fields.
+func (_m *Customer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case customer.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case customer.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case customer.FieldCustomerNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_number", values[i])
+			} else if value.Valid {
+				_m.CustomerNumber = value.String
+			}
+		case customer.FieldLegalName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field legal_name", values[i])
+			} else if value.Valid {
+				_m.LegalName = value.String
+			}
+		case customer.FieldTradingName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trading_name", values[i])
+			} else if value.Valid {
+				_m.TradingName = value.String
+			}
+		case customer.FieldKraPin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kra_pin", values[i])
+			} else if value.Valid {
+				_m.KraPin = value.String
+			}
+		case customer.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = value.String
+			}
+		case customer.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				_m.Phone = value.String
+			}
+		case customer.FieldBillingAddresses:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_addresses", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.BillingAddresses); err != nil {
+					return fmt.Errorf("unmarshal field billing_addresses: %w", err)
+				}
+			}
+		case customer.FieldContacts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field contacts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Contacts); err != nil {
+					return fmt.Errorf("unmarshal field contacts: %w", err)
+				}
+			}
+		case customer.FieldDefaultCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field default_currency", values[i])
+			} else if value.Valid {
+				_m.DefaultCurrency = value.String
+			}
+		case customer.FieldPaymentTermsDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_terms_days", values[i])
+			} else if value.Valid {
+				_m.PaymentTermsDays = int(value.Int64)
+			}
+		case customer.FieldCreditLimit:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_limit", values[i])
+			} else if value.Valid {
+				_m.CreditLimit = new(decimal.Decimal)
+				*_m.CreditLimit = *value.S.(*decimal.Decimal)
+			}
+		case customer.FieldCreditHold:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_hold", values[i])
+			} else if value.Valid {
+				_m.CreditHold = value.Bool
+			}
+		case customer.FieldCreditHoldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_hold_source", values[i])
+			} else if value.Valid {
+				_m.CreditHoldSource = value.String
+			}
+		case customer.FieldCreditHoldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_hold_reason", values[i])
+			} else if value.Valid {
+				_m.CreditHoldReason = value.String
+			}
+		case customer.FieldCreditHoldAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_hold_at", values[i])
+			} else if value.Valid {
+				_m.CreditHoldAt = value.Time
+			}
+		case customer.FieldAuthUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_user_id", values[i])
+			} else if value != nil {
+				_m.AuthUserID = *value
+			}
+		case customer.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case customer.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case customer.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case customer.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Customer.
+// This includes values selected through modifiers, order, etc.
+func (_m *Customer) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Customer.
+// Note that you need to call Customer.Unwrap() before calling this method if this Customer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Customer) Update() *CustomerUpdateOne {
+	return NewCustomerClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Customer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Customer) Unwrap() *Customer {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Customer is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Customer) String() string {
+	var builder strings.Builder
+	builder.WriteString("Customer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("customer_number=")
+	builder.WriteString(_m.CustomerNumber)
+	builder.WriteString(", ")
+	builder.WriteString("legal_name=")
+	builder.WriteString(_m.LegalName)
+	builder.WriteString(", ")
+	builder.WriteString("trading_name=")
+	builder.WriteString(_m.TradingName)
+	builder.WriteString(", ")
+	builder.WriteString("kra_pin=")
+	builder.WriteString(_m.KraPin)
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(_m.Email)
+	builder.WriteString(", ")
+	builder.WriteString("phone=")
+	builder.WriteString(_m.Phone)
+	builder.WriteString(", ")
+	builder.WriteString("billing_addresses=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BillingAddresses))
+	builder.WriteString(", ")
+	builder.WriteString("contacts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Contacts))
+	builder.WriteString(", ")
+	builder.WriteString("default_currency=")
+	builder.WriteString(_m.DefaultCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("payment_terms_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaymentTermsDays))
+	builder.WriteString(", ")
+	if v := _m.CreditLimit; v != nil {
+		builder.WriteString("credit_limit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("credit_hold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreditHold))
+	builder.WriteString(", ")
+	builder.WriteString("credit_hold_source=")
+	builder.WriteString(_m.CreditHoldSource)
+	builder.WriteString(", ")
+	builder.WriteString("credit_hold_reason=")
+	builder.WriteString(_m.CreditHoldReason)
+	builder.WriteString(", ")
+	builder.WriteString("credit_hold_at=")
+	builder.WriteString(_m.CreditHoldAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("auth_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AuthUserID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Customers is a parsable slice of Customer.
+type Customers []*Customer
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package customer
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the customer type in the database.
+	Label = "customer"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCustomerNumber holds the string denoting the customer_number field in the database.
+	FieldCustomerNumber = "customer_number"
+	// FieldLegalName holds the string denoting the legal_name field in the database.
+	FieldLegalName = "legal_name"
+	// FieldTradingName holds the string denoting the trading_name field in the database.
+	FieldTradingName = "trading_name"
+	// FieldKraPin holds the string denoting the kra_pin field in the database.
+	FieldKraPin = "kra_pin"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldPhone holds the string denoting the phone field in the database.
+	FieldPhone = "phone"
+	// FieldBillingAddresses holds the string denoting the billing_addresses field in the database.
+	FieldBillingAddresses = "billing_addresses"
+	// FieldContacts holds the string denoting the contacts field in the database.
+	FieldContacts = "contacts"
+	// FieldDefaultCurrency holds the string denoting the default_currency field in the database.
+	FieldDefaultCurrency = "default_currency"
+	// FieldPaymentTermsDays holds the string denoting the payment_terms_days field in the database.
+	FieldPaymentTermsDays = "payment_terms_days"
+	// FieldCreditLimit holds the string denoting the credit_limit field in the database.
+	FieldCreditLimit = "credit_limit"
+	// FieldCreditHold holds the string denoting the credit_hold field in the database.
+	FieldCreditHold = "credit_hold"
+	// FieldCreditHoldSource holds the string denoting the credit_hold_source field in the database.
+	FieldCreditHoldSource = "credit_hold_source"
+	// FieldCreditHoldReason holds the string denoting the credit_hold_reason field in the database.
+	FieldCreditHoldReason = "credit_hold_reason"
+	// FieldCreditHoldAt holds the string denoting the credit_hold_at field in the database.
+	FieldCreditHoldAt = "credit_hold_at"
+	// FieldAuthUserID holds the string denoting the auth_user_id field in the database.
+	FieldAuthUserID = "auth_user_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the customer in the database.
+	Table = "customers"
+)
+
+// Columns holds all SQL columns for customer fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCustomerNumber,
+	FieldLegalName,
+	FieldTradingName,
+	FieldKraPin,
+	FieldEmail,
+	FieldPhone,
+	FieldBillingAddresses,
+	FieldContacts,
+	FieldDefaultCurrency,
+	FieldPaymentTermsDays,
+	FieldCreditLimit,
+	FieldCreditHold,
+	FieldCreditHoldSource,
+	FieldCreditHoldReason,
+	FieldCreditHoldAt,
+	FieldAuthUserID,
+	FieldStatus,
+	FieldMetadata,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CustomerNumberValidator is a validator for the "customer_number" field. It is called by the builders before save.
+	CustomerNumberValidator func(string) error
+	// LegalNameValidator is a validator for the "legal_name" field. It is called by the builders before save.
+	LegalNameValidator func(string) error
+	// DefaultDefaultCurrency holds the default value on creation for the "default_currency" field.
+	DefaultDefaultCurrency string
+	// DefaultPaymentTermsDays holds the default value on creation for the "payment_terms_days" field.
+	DefaultPaymentTermsDays int
+	// DefaultCreditHold holds the default value on creation for the "credit_hold" field.
+	DefaultCreditHold bool
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultMetadata holds the default value on creation for the "metadata" field.
+	DefaultMetadata map[string]interface{}
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Customer queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByCustomerNumber orders the results by the customer_number field.
+func ByCustomerNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCustomerNumber, opts...).ToFunc()
+}
+
+// ByLegalName orders the results by the legal_name field.
+func ByLegalName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLegalName, opts...).ToFunc()
+}
+
+// ByTradingName orders the results by the trading_name field.
+func ByTradingName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTradingName, opts...).ToFunc()
+}
+
+// ByKraPin orders the results by the kra_pin field.
+func ByKraPin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKraPin, opts...).ToFunc()
+}
+
+// ByEmail orders the results by the email field.
+func ByEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmail, opts...).ToFunc()
+}
+
+// ByPhone orders the results by the phone field.
+func ByPhone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhone, opts...).ToFunc()
+}
+
+// ByDefaultCurrency orders the results by the default_currency field.
+func ByDefaultCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultCurrency, opts...).ToFunc()
+}
+
+// ByPaymentTermsDays orders the results by the payment_terms_days field.
+func ByPaymentTermsDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentTermsDays, opts...).ToFunc()
+}
+
+// ByCreditLimit orders the results by the credit_limit field.
+func ByCreditLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditLimit, opts...).ToFunc()
+}
+
+// ByCreditHold orders the results by the credit_hold field.
+func ByCreditHold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditHold, opts...).ToFunc()
+}
+
+// ByCreditHoldSource orders the results by the credit_hold_source field.
+func ByCreditHoldSource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditHoldSource, opts...).ToFunc()
+}
+
+// ByCreditHoldReason orders the results by the credit_hold_reason field.
+func ByCreditHoldReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditHoldReason, opts...).ToFunc()
+}
+
+// ByCreditHoldAt orders the results by the credit_hold_at field.
+func ByCreditHoldAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditHoldAt, opts...).ToFunc()
+}
+
+// ByAuthUserID orders the results by the auth_user_id field.
+func ByAuthUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthUserID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,1212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package customer
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CustomerNumber applies equality check predicate on the "customer_number" field. It's identical to CustomerNumberEQ.
+func CustomerNumber(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldCustomerNumber, v))
+}
+
+// LegalName applies equality check predicate on the "legal_name" field. It's identical to LegalNameEQ.
+func LegalName(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldLegalName, v))
+}
+
+// TradingName applies equality check predicate on the "trading_name" field. It's identical to TradingNameEQ.
+func TradingName(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldTradingName, v))
+}
+
+// KraPin applies equality check predicate on the "kra_pin" field. It's identical to KraPinEQ.
+func KraPin(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldKraPin, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldEmail, v))
+}
+
+// Phone applies equality check predicate on the "phone" field. It's identical to PhoneEQ.
+func Phone(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldPhone, v))
+}
+
+// DefaultCurrency applies equality check predicate on the "default_currency" field. It's identical to DefaultCurrencyEQ.
+func DefaultCurrency(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldDefaultCurrency, v))
+}
+
+// PaymentTermsDays applies equality check predicate on the "payment_terms_days" field. It's identical to PaymentTermsDaysEQ.
+func PaymentTermsDays(v int) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldPaymentTermsDays, v))
+}
+
+// CreditLimit applies equality check predicate on the "credit_limit" field. It's identical to CreditLimitEQ.
+func CreditLimit(v decimal.Decimal) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldCreditLimit, v))
+}
+
+// CreditHold applies equality check predicate on the "credit_hold" field. It's identical to CreditHoldEQ.
+func CreditHold(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldCreditHold, v))
+}
+
+// CreditHoldSource applies equality check predicate on the "credit_hold_source" field. It's identical to CreditHoldSourceEQ.
+func CreditHoldSource(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldCreditHoldSource, v))
+}
+
+// CreditHoldReason applies equality check predicate on the "credit_hold_reason" field. It's identical to CreditHoldReasonEQ.
+func CreditHoldReason(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldCreditHoldReason, v))
+}
+
+// CreditHoldAt applies equality check predicate on the "credit_hold_at" field. It's identical to CreditHoldAtEQ.
+func CreditHoldAt(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldCreditHoldAt, v))
+}
+
+// AuthUserID applies equality check predicate on the "auth_user_id" field. It's identical to AuthUserIDEQ.
+func AuthUserID(v uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldAuthUserID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldTenantID, v))
+}
+
+// CustomerNumberEQ applies the EQ predicate on the "customer_number" field.
+func CustomerNumberEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldCustomerNumber, v))
+}
+
+// CustomerNumberNEQ applies the NEQ predicate on the "customer_number" field.
+func CustomerNumberNEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldCustomerNumber, v))
+}
+
+// CustomerNumberIn applies the In predicate on the "customer_number" field.
+func CustomerNumberIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldCustomerNumber, vs...))
+}
+
+// CustomerNumberNotIn applies the NotIn predicate on the "customer_number" field.
+func CustomerNumberNotIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldCustomerNumber, vs...))
+}
+
+// CustomerNumberGT applies the GT predicate on the "customer_number" field.
+func CustomerNumberGT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldCustomerNumber, v))
+}
+
+// CustomerNumberGTE applies the GTE predicate on the "customer_number" field.
+func CustomerNumberGTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldCustomerNumber, v))
+}
+
+// CustomerNumberLT applies the LT predicate on the "customer_number" field.
+func CustomerNumberLT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldCustomerNumber, v))
+}
+
+// CustomerNumberLTE applies the LTE predicate on the "customer_number" field.
+func CustomerNumberLTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldCustomerNumber, v))
+}
+
+// CustomerNumberContains applies the Contains predicate on the "customer_number" field.
+func CustomerNumberContains(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContains(FieldCustomerNumber, v))
+}
+
+// CustomerNumberHasPrefix applies the HasPrefix predicate on the "customer_number" field.
+func CustomerNumberHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasPrefix(FieldCustomerNumber, v))
+}
+
+// CustomerNumberHasSuffix applies the HasSuffix predicate on the "customer_number" field.
+func CustomerNumberHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasSuffix(FieldCustomerNumber, v))
+}
+
+// CustomerNumberEqualFold applies the EqualFold predicate on the "customer_number" field.
+func CustomerNumberEqualFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEqualFold(FieldCustomerNumber, v))
+}
+
+// CustomerNumberContainsFold applies the ContainsFold predicate on the "customer_number" field.
+func CustomerNumberContainsFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContainsFold(FieldCustomerNumber, v))
+}
+
+// LegalNameEQ applies the EQ predicate on the "legal_name" field.
+func LegalNameEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldLegalName, v))
+}
+
+// LegalNameNEQ applies the NEQ predicate on the "legal_name" field.
+func LegalNameNEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldLegalName, v))
+}
+
+// LegalNameIn applies the In predicate on the "legal_name" field.
+func LegalNameIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldLegalName, vs...))
+}
+
+// LegalNameNotIn applies the NotIn predicate on the "legal_name" field.
+func LegalNameNotIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldLegalName, vs...))
+}
+
+// LegalNameGT applies the GT predicate on the "legal_name" field.
+func LegalNameGT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldLegalName, v))
+}
+
+// LegalNameGTE applies the GTE predicate on the "legal_name" field.
+func LegalNameGTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldLegalName, v))
+}
+
+// LegalNameLT applies the LT predicate on the "legal_name" field.
+func LegalNameLT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldLegalName, v))
+}
+
+// LegalNameLTE applies the LTE predicate on the "legal_name" field.
+func LegalNameLTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldLegalName, v))
+}
+
+// LegalNameContains applies the Contains predicate on the "legal_name" field.
+func LegalNameContains(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContains(FieldLegalName, v))
+}
+
+// LegalNameHasPrefix applies the HasPrefix predicate on the "legal_name" field.
+func LegalNameHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasPrefix(FieldLegalName, v))
+}
+
+// LegalNameHasSuffix applies the HasSuffix predicate on the "legal_name" field.
+func LegalNameHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasSuffix(FieldLegalName, v))
+}
+
+// LegalNameEqualFold applies the EqualFold predicate on the "legal_name" field.
+func LegalNameEqualFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEqualFold(FieldLegalName, v))
+}
+
+// LegalNameContainsFold applies the ContainsFold predicate on the "legal_name" field.
+func LegalNameContainsFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContainsFold(FieldLegalName, v))
+}
+
+// TradingNameEQ applies the EQ predicate on the "trading_name" field.
+func TradingNameEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldTradingName, v))
+}
+
+// TradingNameNEQ applies the NEQ predicate on the "trading_name" field.
+func TradingNameNEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldTradingName, v))
+}
+
+// TradingNameIn applies the In predicate on the "trading_name" field.
+func TradingNameIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldTradingName, vs...))
+}
+
+// TradingNameNotIn applies the NotIn predicate on the "trading_name" field.
+func TradingNameNotIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldTradingName, vs...))
+}
+
+// TradingNameGT applies the GT predicate on the "trading_name" field.
+func TradingNameGT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldTradingName, v))
+}
+
+// TradingNameGTE applies the GTE predicate on the "trading_name" field.
+func TradingNameGTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldTradingName, v))
+}
+
+// TradingNameLT applies the LT predicate on the "trading_name" field.
+func TradingNameLT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldTradingName, v))
+}
+
+// TradingNameLTE applies the LTE predicate on the "trading_name" field.
+func TradingNameLTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldTradingName, v))
+}
+
+// TradingNameContains applies the Contains predicate on the "trading_name" field.
+func TradingNameContains(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContains(FieldTradingName, v))
+}
+
+// TradingNameHasPrefix applies the HasPrefix predicate on the "trading_name" field.
+func TradingNameHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasPrefix(FieldTradingName, v))
+}
+
+// TradingNameHasSuffix applies the HasSuffix predicate on the "trading_name" field.
+func TradingNameHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasSuffix(FieldTradingName, v))
+}
+
+// TradingNameIsNil applies the IsNil predicate on the "trading_name" field.
+func TradingNameIsNil() predicate.Customer {
+	return predicate.Customer(sql.FieldIsNull(FieldTradingName))
+}
+
+// TradingNameNotNil applies the NotNil predicate on the "trading_name" field.
+func TradingNameNotNil() predicate.Customer {
+	return predicate.Customer(sql.FieldNotNull(FieldTradingName))
+}
+
+// TradingNameEqualFold applies the EqualFold predicate on the "trading_name" field.
+func TradingNameEqualFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEqualFold(FieldTradingName, v))
+}
+
+// TradingNameContainsFold applies the ContainsFold predicate on the "trading_name" field.
+func TradingNameContainsFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContainsFold(FieldTradingName, v))
+}
+
+// KraPinEQ applies the EQ predicate on the "kra_pin" field.
+func KraPinEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldKraPin, v))
+}
+
+// KraPinNEQ applies the NEQ predicate on the "kra_pin" field.
+func KraPinNEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldKraPin, v))
+}
+
+// KraPinIn applies the In predicate on the "kra_pin" field.
+func KraPinIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldKraPin, vs...))
+}
+
+// KraPinNotIn applies the NotIn predicate on the "kra_pin" field.
+func KraPinNotIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldKraPin, vs...))
+}
+
+// KraPinGT applies the GT predicate on the "kra_pin" field.
+func KraPinGT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldKraPin, v))
+}
+
+// KraPinGTE applies the GTE predicate on the "kra_pin" field.
+func KraPinGTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldKraPin, v))
+}
+
+// KraPinLT applies the LT predicate on the "kra_pin" field.
+func KraPinLT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldKraPin, v))
+}
+
+// KraPinLTE applies the LTE predicate on the "kra_pin" field.
+func KraPinLTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldKraPin, v))
+}
+
+// KraPinContains applies the Contains predicate on the "kra_pin" field.
+func KraPinContains(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContains(FieldKraPin, v))
+}
+
+// KraPinHasPrefix applies the HasPrefix predicate on the "kra_pin" field.
+func KraPinHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasPrefix(FieldKraPin, v))
+}
+
+// KraPinHasSuffix applies the HasSuffix predicate on the "kra_pin" field.
+func KraPinHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasSuffix(FieldKraPin, v))
+}
+
+// KraPinIsNil applies the IsNil predicate on the "kra_pin" field.
+func KraPinIsNil() predicate.Customer {
+	return predicate.Customer(sql.FieldIsNull(FieldKraPin))
+}
+
+// KraPinNotNil applies the NotNil predicate on the "kra_pin" field.
+func KraPinNotNil() predicate.Customer {
+	return predicate.Customer(sql.FieldNotNull(FieldKraPin))
+}
+
+// KraPinEqualFold applies the EqualFold predicate on the "kra_pin" field.
+func KraPinEqualFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEqualFold(FieldKraPin, v))
+}
+
+// KraPinContainsFold applies the ContainsFold predicate on the "kra_pin" field.
+func KraPinContainsFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContainsFold(FieldKraPin, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailIsNil applies the IsNil predicate on the "email" field.
+func EmailIsNil() predicate.Customer {
+	return predicate.Customer(sql.FieldIsNull(FieldEmail))
+}
+
+// EmailNotNil applies the NotNil predicate on the "email" field.
+func EmailNotNil() predicate.Customer {
+	return predicate.Customer(sql.FieldNotNull(FieldEmail))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// PhoneEQ applies the EQ predicate on the "phone" field.
+func PhoneEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldPhone, v))
+}
+
+// PhoneNEQ applies the NEQ predicate on the "phone" field.
+func PhoneNEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldPhone, v))
+}
+
+// PhoneIn applies the In predicate on the "phone" field.
+func PhoneIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldPhone, vs...))
+}
+
+// PhoneNotIn applies the NotIn predicate on the "phone" field.
+func PhoneNotIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldPhone, vs...))
+}
+
+// PhoneGT applies the GT predicate on the "phone" field.
+func PhoneGT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldPhone, v))
+}
+
+// PhoneGTE applies the GTE predicate on the "phone" field.
+func PhoneGTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldPhone, v))
+}
+
+// PhoneLT applies the LT predicate on the "phone" field.
+func PhoneLT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldPhone, v))
+}
+
+// PhoneLTE applies the LTE predicate on the "phone" field.
+func PhoneLTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldPhone, v))
+}
+
+// PhoneContains applies the Contains predicate on the "phone" field.
+func PhoneContains(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContains(FieldPhone, v))
+}
+
+// PhoneHasPrefix applies the HasPrefix predicate on the "phone" field.
+func PhoneHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasPrefix(FieldPhone, v))
+}
+
+// PhoneHasSuffix applies the HasSuffix predicate on the "phone" field.
+func PhoneHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasSuffix(FieldPhone, v))
+}
+
+// PhoneIsNil applies the IsNil predicate on the "phone" field.
+func PhoneIsNil() predicate.Customer {
+	return predicate.Customer(sql.FieldIsNull(FieldPhone))
+}
+
+// PhoneNotNil applies the NotNil predicate on the "phone" field.
+func PhoneNotNil() predicate.Customer {
+	return predicate.Customer(sql.FieldNotNull(FieldPhone))
+}
+
+// PhoneEqualFold applies the EqualFold predicate on the "phone" field.
+func PhoneEqualFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEqualFold(FieldPhone, v))
+}
+
+// PhoneContainsFold applies the ContainsFold predicate on the "phone" field.
+func PhoneContainsFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContainsFold(FieldPhone, v))
+}
+
+// BillingAddressesIsNil applies the IsNil predicate on the "billing_addresses" field.
+func BillingAddressesIsNil() predicate.Customer {
+	return predicate.Customer(sql.FieldIsNull(FieldBillingAddresses))
+}
+
+// BillingAddressesNotNil applies the NotNil predicate on the "billing_addresses" field.
+func BillingAddressesNotNil() predicate.Customer {
+	return predicate.Customer(sql.FieldNotNull(FieldBillingAddresses))
+}
+
+// ContactsIsNil applies the IsNil predicate on the "contacts" field.
+func ContactsIsNil() predicate.Customer {
+	return predicate.Customer(sql.FieldIsNull(FieldContacts))
+}
+
+// ContactsNotNil applies the NotNil predicate on the "contacts" field.
+func ContactsNotNil() predicate.Customer {
+	return predicate.Customer(sql.FieldNotNull(FieldContacts))
+}
+
+// DefaultCurrencyEQ applies the EQ predicate on the "default_currency" field.
+func DefaultCurrencyEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldDefaultCurrency, v))
+}
+
+// DefaultCurrencyNEQ applies the NEQ predicate on the "default_currency" field.
+func DefaultCurrencyNEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldDefaultCurrency, v))
+}
+
+// DefaultCurrencyIn applies the In predicate on the "default_currency" field.
+func DefaultCurrencyIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldDefaultCurrency, vs...))
+}
+
+// DefaultCurrencyNotIn applies the NotIn predicate on the "default_currency" field.
+func DefaultCurrencyNotIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldDefaultCurrency, vs...))
+}
+
+// DefaultCurrencyGT applies the GT predicate on the "default_currency" field.
+func DefaultCurrencyGT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldDefaultCurrency, v))
+}
+
+// DefaultCurrencyGTE applies the GTE predicate on the "default_currency" field.
+func DefaultCurrencyGTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldDefaultCurrency, v))
+}
+
+// DefaultCurrencyLT applies the LT predicate on the "default_currency" field.
+func DefaultCurrencyLT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldDefaultCurrency, v))
+}
+
+// DefaultCurrencyLTE applies the LTE predicate on the "default_currency" field.
+func DefaultCurrencyLTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldDefaultCurrency, v))
+}
+
+// DefaultCurrencyContains applies the Contains predicate on the "default_currency" field.
+func DefaultCurrencyContains(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContains(FieldDefaultCurrency, v))
+}
+
+// DefaultCurrencyHasPrefix applies the HasPrefix predicate on the "default_currency" field.
+func DefaultCurrencyHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasPrefix(FieldDefaultCurrency, v))
+}
+
+// DefaultCurrencyHasSuffix applies the HasSuffix predicate on the "default_currency" field.
+func DefaultCurrencyHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasSuffix(FieldDefaultCurrency, v))
+}
+
+// DefaultCurrencyEqualFold applies the EqualFold predicate on the "default_currency" field.
+func DefaultCurrencyEqualFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEqualFold(FieldDefaultCurrency, v))
+}
+
+// DefaultCurrencyContainsFold applies the ContainsFold predicate on the "default_currency" field.
+func DefaultCurrencyContainsFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContainsFold(FieldDefaultCurrency, v))
+}
+
+// PaymentTermsDaysEQ applies the EQ predicate on the "payment_terms_days" field.
+func PaymentTermsDaysEQ(v int) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldPaymentTermsDays, v))
+}
+
+// PaymentTermsDaysNEQ applies the NEQ predicate on the "payment_terms_days" field.
+func PaymentTermsDaysNEQ(v int) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldPaymentTermsDays, v))
+}
+
+// PaymentTermsDaysIn applies the In predicate on the "payment_terms_days" field.
+func PaymentTermsDaysIn(vs ...int) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldPaymentTermsDays, vs...))
+}
+
+// PaymentTermsDaysNotIn applies the NotIn predicate on the "payment_terms_days" field.
+func PaymentTermsDaysNotIn(vs ...int) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldPaymentTermsDays, vs...))
+}
+
+// PaymentTermsDaysGT applies the GT predicate on the "payment_terms_days" field.
+func PaymentTermsDaysGT(v int) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldPaymentTermsDays, v))
+}
+
+// PaymentTermsDaysGTE applies the GTE predicate on the "payment_terms_days" field.
+func PaymentTermsDaysGTE(v int) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldPaymentTermsDays, v))
+}
+
+// PaymentTermsDaysLT applies the LT predicate on the "payment_terms_days" field.
+func PaymentTermsDaysLT(v int) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldPaymentTermsDays, v))
+}
+
+// PaymentTermsDaysLTE applies the LTE predicate on the "payment_terms_days" field.
+func PaymentTermsDaysLTE(v int) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldPaymentTermsDays, v))
+}
+
+// CreditLimitEQ applies the EQ predicate on the "credit_limit" field.
+func CreditLimitEQ(v decimal.Decimal) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldCreditLimit, v))
+}
+
+// CreditLimitNEQ applies the NEQ predicate on the "credit_limit" field.
+func CreditLimitNEQ(v decimal.Decimal) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldCreditLimit, v))
+}
+
+// CreditLimitIn applies the In predicate on the "credit_limit" field.
+func CreditLimitIn(vs ...decimal.Decimal) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldCreditLimit, vs...))
+}
+
+// CreditLimitNotIn applies the NotIn predicate on the "credit_limit" field.
+func CreditLimitNotIn(vs ...decimal.Decimal) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldCreditLimit, vs...))
+}
+
+// CreditLimitGT applies the GT predicate on the "credit_limit" field.
+func CreditLimitGT(v decimal.Decimal) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldCreditLimit, v))
+}
+
+// CreditLimitGTE applies the GTE predicate on the "credit_limit" field.
+func CreditLimitGTE(v decimal.Decimal) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldCreditLimit, v))
+}
+
+// CreditLimitLT applies the LT predicate on the "credit_limit" field.
+func CreditLimitLT(v decimal.Decimal) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldCreditLimit, v))
+}
+
+// CreditLimitLTE applies the LTE predicate on the "credit_limit" field.
+func CreditLimitLTE(v decimal.Decimal) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldCreditLimit, v))
+}
+
+// CreditLimitIsNil applies the IsNil predicate on the "credit_limit" field.
+func CreditLimitIsNil() predicate.Customer {
+	return predicate.Customer(sql.FieldIsNull(FieldCreditLimit))
+}
+
+// CreditLimitNotNil applies the NotNil predicate on the "credit_limit" field.
+func CreditLimitNotNil() predicate.Customer {
+	return predicate.Customer(sql.FieldNotNull(FieldCreditLimit))
+}
+
+// CreditHoldEQ applies the EQ predicate on the "credit_hold" field.
+func CreditHoldEQ(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldCreditHold, v))
+}
+
+// CreditHoldNEQ applies the NEQ predicate on the "credit_hold" field.
+func CreditHoldNEQ(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldCreditHold, v))
+}
+
+// CreditHoldSourceEQ applies the EQ predicate on the "credit_hold_source" field.
+func CreditHoldSourceEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldCreditHoldSource, v))
+}
+
+// CreditHoldSourceNEQ applies the NEQ predicate on the "credit_hold_source" field.
+func CreditHoldSourceNEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldCreditHoldSource, v))
+}
+
+// CreditHoldSourceIn applies the In predicate on the "credit_hold_source" field.
+func CreditHoldSourceIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldCreditHoldSource, vs...))
+}
+
+// CreditHoldSourceNotIn applies the NotIn predicate on the "credit_hold_source" field.
+func CreditHoldSourceNotIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldCreditHoldSource, vs...))
+}
+
+// CreditHoldSourceGT applies the GT predicate on the "credit_hold_source" field.
+func CreditHoldSourceGT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldCreditHoldSource, v))
+}
+
+// CreditHoldSourceGTE applies the GTE predicate on the "credit_hold_source" field.
+func CreditHoldSourceGTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldCreditHoldSource, v))
+}
+
+// CreditHoldSourceLT applies the LT predicate on the "credit_hold_source" field.
+func CreditHoldSourceLT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldCreditHoldSource, v))
+}
+
+// CreditHoldSourceLTE applies the LTE predicate on the "credit_hold_source" field.
+func CreditHoldSourceLTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldCreditHoldSource, v))
+}
+
+// CreditHoldSourceContains applies the Contains predicate on the "credit_hold_source" field.
+func CreditHoldSourceContains(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContains(FieldCreditHoldSource, v))
+}
+
+// CreditHoldSourceHasPrefix applies the HasPrefix predicate on the "credit_hold_source" field.
+func CreditHoldSourceHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasPrefix(FieldCreditHoldSource, v))
+}
+
+// CreditHoldSourceHasSuffix applies the HasSuffix predicate on the "credit_hold_source" field.
+func CreditHoldSourceHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasSuffix(FieldCreditHoldSource, v))
+}
+
+// CreditHoldSourceIsNil applies the IsNil predicate on the "credit_hold_source" field.
+func CreditHoldSourceIsNil() predicate.Customer {
+	return predicate.Customer(sql.FieldIsNull(FieldCreditHoldSource))
+}
+
+// CreditHoldSourceNotNil applies the NotNil predicate on the "credit_hold_source" field.
+func CreditHoldSourceNotNil() predicate.Customer {
+	return predicate.Customer(sql.FieldNotNull(FieldCreditHoldSource))
+}
+
+// CreditHoldSourceEqualFold applies the EqualFold predicate on the "credit_hold_source" field.
+func CreditHoldSourceEqualFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEqualFold(FieldCreditHoldSource, v))
+}
+
+// CreditHoldSourceContainsFold applies the ContainsFold predicate on the "credit_hold_source" field.
+func CreditHoldSourceContainsFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContainsFold(FieldCreditHoldSource, v))
+}
+
+// CreditHoldReasonEQ applies the EQ predicate on the "credit_hold_reason" field.
+func CreditHoldReasonEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldCreditHoldReason, v))
+}
+
+// CreditHoldReasonNEQ applies the NEQ predicate on the "credit_hold_reason" field.
+func CreditHoldReasonNEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldCreditHoldReason, v))
+}
+
+// CreditHoldReasonIn applies the In predicate on the "credit_hold_reason" field.
+func CreditHoldReasonIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldCreditHoldReason, vs...))
+}
+
+// CreditHoldReasonNotIn applies the NotIn predicate on the "credit_hold_reason" field.
+func CreditHoldReasonNotIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldCreditHoldReason, vs...))
+}
+
+// CreditHoldReasonGT applies the GT predicate on the "credit_hold_reason" field.
+func CreditHoldReasonGT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldCreditHoldReason, v))
+}
+
+// CreditHoldReasonGTE applies the GTE predicate on the "credit_hold_reason" field.
+func CreditHoldReasonGTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldCreditHoldReason, v))
+}
+
+// CreditHoldReasonLT applies the LT predicate on the "credit_hold_reason" field.
+func CreditHoldReasonLT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldCreditHoldReason, v))
+}
+
+// CreditHoldReasonLTE applies the LTE predicate on the "credit_hold_reason" field.
+func CreditHoldReasonLTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldCreditHoldReason, v))
+}
+
+// CreditHoldReasonContains applies the Contains predicate on the "credit_hold_reason" field.
+func CreditHoldReasonContains(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContains(FieldCreditHoldReason, v))
+}
+
+// CreditHoldReasonHasPrefix applies the HasPrefix predicate on the "credit_hold_reason" field.
+func CreditHoldReasonHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasPrefix(FieldCreditHoldReason, v))
+}
+
+// CreditHoldReasonHasSuffix applies the HasSuffix predicate on the "credit_hold_reason" field.
+func CreditHoldReasonHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasSuffix(FieldCreditHoldReason, v))
+}
+
+// CreditHoldReasonIsNil applies the IsNil predicate on the "credit_hold_reason" field.
+func CreditHoldReasonIsNil() predicate.Customer {
+	return predicate.Customer(sql.FieldIsNull(FieldCreditHoldReason))
+}
+
+// CreditHoldReasonNotNil applies the NotNil predicate on the "credit_hold_reason" field.
+func CreditHoldReasonNotNil() predicate.Customer {
+	return predicate.Customer(sql.FieldNotNull(FieldCreditHoldReason))
+}
+
+// CreditHoldReasonEqualFold applies the EqualFold predicate on the "credit_hold_reason" field.
+func CreditHoldReasonEqualFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEqualFold(FieldCreditHoldReason, v))
+}
+
+// CreditHoldReasonContainsFold applies the ContainsFold predicate on the "credit_hold_reason" field.
+func CreditHoldReasonContainsFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContainsFold(FieldCreditHoldReason, v))
+}
+
+// CreditHoldAtEQ applies the EQ predicate on the "credit_hold_at" field.
+func CreditHoldAtEQ(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldCreditHoldAt, v))
+}
+
+// CreditHoldAtNEQ applies the NEQ predicate on the "credit_hold_at" field.
+func CreditHoldAtNEQ(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldCreditHoldAt, v))
+}
+
+// CreditHoldAtIn applies the In predicate on the "credit_hold_at" field.
+func CreditHoldAtIn(vs ...time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldCreditHoldAt, vs...))
+}
+
+// CreditHoldAtNotIn applies the NotIn predicate on the "credit_hold_at" field.
+func CreditHoldAtNotIn(vs ...time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldCreditHoldAt, vs...))
+}
+
+// CreditHoldAtGT applies the GT predicate on the "credit_hold_at" field.
+func CreditHoldAtGT(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldCreditHoldAt, v))
+}
+
+// CreditHoldAtGTE applies the GTE predicate on the "credit_hold_at" field.
+func CreditHoldAtGTE(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldCreditHoldAt, v))
+}
+
+// CreditHoldAtLT applies the LT predicate on the "credit_hold_at" field.
+func CreditHoldAtLT(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldCreditHoldAt, v))
+}
+
+// CreditHoldAtLTE applies the LTE predicate on the "credit_hold_at" field.
+func CreditHoldAtLTE(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldCreditHoldAt, v))
+}
+
+// CreditHoldAtIsNil applies the IsNil predicate on the "credit_hold_at" field.
+func CreditHoldAtIsNil() predicate.Customer {
+	return predicate.Customer(sql.FieldIsNull(FieldCreditHoldAt))
+}
+
+// CreditHoldAtNotNil applies the NotNil predicate on the "credit_hold_at" field.
+func CreditHoldAtNotNil() predicate.Customer {
+	return predicate.Customer(sql.FieldNotNull(FieldCreditHoldAt))
+}
+
+// AuthUserIDEQ applies the EQ predicate on the "auth_user_id" field.
+func AuthUserIDEQ(v uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldAuthUserID, v))
+}
+
+// AuthUserIDNEQ applies the NEQ predicate on the "auth_user_id" field.
+func AuthUserIDNEQ(v uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldAuthUserID, v))
+}
+
+// AuthUserIDIn applies the In predicate on the "auth_user_id" field.
+func AuthUserIDIn(vs ...uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldAuthUserID, vs...))
+}
+
+// AuthUserIDNotIn applies the NotIn predicate on the "auth_user_id" field.
+func AuthUserIDNotIn(vs ...uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldAuthUserID, vs...))
+}
+
+// AuthUserIDGT applies the GT predicate on the "auth_user_id" field.
+func AuthUserIDGT(v uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldAuthUserID, v))
+}
+
+// AuthUserIDGTE applies the GTE predicate on the "auth_user_id" field.
+func AuthUserIDGTE(v uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldAuthUserID, v))
+}
+
+// AuthUserIDLT applies the LT predicate on the "auth_user_id" field.
+func AuthUserIDLT(v uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldAuthUserID, v))
+}
+
+// AuthUserIDLTE applies the LTE predicate on the "auth_user_id" field.
+func AuthUserIDLTE(v uuid.UUID) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldAuthUserID, v))
+}
+
+// AuthUserIDIsNil applies the IsNil predicate on the "auth_user_id" field.
+func AuthUserIDIsNil() predicate.Customer {
+	return predicate.Customer(sql.FieldIsNull(FieldAuthUserID))
+}
+
+// AuthUserIDNotNil applies the NotNil predicate on the "auth_user_id" field.
+func AuthUserIDNotNil() predicate.Customer {
+	return predicate.Customer(sql.FieldNotNull(FieldAuthUserID))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Customer) predicate.Customer {
+	return predicate.Customer(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Customer) predicate.Customer {
+	return predicate.Customer(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Customer) predicate.Customer {
+	return predicate.Customer(sql.NotPredicates(p))
+}
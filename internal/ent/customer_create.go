@@ -0,0 +1,1888 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/customer"
+	"github.com/bengobox/treasury-api/internal/modules/customers/profile"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CustomerCreate is the builder for creating a Customer entity.
+type CustomerCreate struct {
+	config
+	mutation *CustomerMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *CustomerCreate) SetTenantID(v uuid.UUID) *CustomerCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCustomerNumber sets the "customer_number" field.
+func (_c *CustomerCreate) SetCustomerNumber(v string) *CustomerCreate {
+	_c.mutation.SetCustomerNumber(v)
+	return _c
+}
+
+// SetLegalName sets the "legal_name" field.
+func (_c *CustomerCreate) SetLegalName(v string) *CustomerCreate {
+	_c.mutation.SetLegalName(v)
+	return _c
+}
+
+// SetTradingName sets the "trading_name" field.
+func (_c *CustomerCreate) SetTradingName(v string) *CustomerCreate {
+	_c.mutation.SetTradingName(v)
+	return _c
+}
+
+// SetNillableTradingName sets the "trading_name" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableTradingName(v *string) *CustomerCreate {
+	if v != nil {
+		_c.SetTradingName(*v)
+	}
+	return _c
+}
+
+// SetKraPin sets the "kra_pin" field.
+func (_c *CustomerCreate) SetKraPin(v string) *CustomerCreate {
+	_c.mutation.SetKraPin(v)
+	return _c
+}
+
+// SetNillableKraPin sets the "kra_pin" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableKraPin(v *string) *CustomerCreate {
+	if v != nil {
+		_c.SetKraPin(*v)
+	}
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *CustomerCreate) SetEmail(v string) *CustomerCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableEmail(v *string) *CustomerCreate {
+	if v != nil {
+		_c.SetEmail(*v)
+	}
+	return _c
+}
+
+// SetPhone sets the "phone" field.
+func (_c *CustomerCreate) SetPhone(v string) *CustomerCreate {
+	_c.mutation.SetPhone(v)
+	return _c
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillablePhone(v *string) *CustomerCreate {
+	if v != nil {
+		_c.SetPhone(*v)
+	}
+	return _c
+}
+
+// SetBillingAddresses sets the "billing_addresses" field.
+func (_c *CustomerCreate) SetBillingAddresses(v []profile.Address) *CustomerCreate {
+	_c.mutation.SetBillingAddresses(v)
+	return _c
+}
+
+// SetContacts sets the "contacts" field.
+func (_c *CustomerCreate) SetContacts(v []profile.Contact) *CustomerCreate {
+	_c.mutation.SetContacts(v)
+	return _c
+}
+
+// SetDefaultCurrency sets the "default_currency" field.
+func (_c *CustomerCreate) SetDefaultCurrency(v string) *CustomerCreate {
+	_c.mutation.SetDefaultCurrency(v)
+	return _c
+}
+
+// SetNillableDefaultCurrency sets the "default_currency" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableDefaultCurrency(v *string) *CustomerCreate {
+	if v != nil {
+		_c.SetDefaultCurrency(*v)
+	}
+	return _c
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (_c *CustomerCreate) SetPaymentTermsDays(v int) *CustomerCreate {
+	_c.mutation.SetPaymentTermsDays(v)
+	return _c
+}
+
+// SetNillablePaymentTermsDays sets the "payment_terms_days" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillablePaymentTermsDays(v *int) *CustomerCreate {
+	if v != nil {
+		_c.SetPaymentTermsDays(*v)
+	}
+	return _c
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (_c *CustomerCreate) SetCreditLimit(v decimal.Decimal) *CustomerCreate {
+	_c.mutation.SetCreditLimit(v)
+	return _c
+}
+
+// SetNillableCreditLimit sets the "credit_limit" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableCreditLimit(v *decimal.Decimal) *CustomerCreate {
+	if v != nil {
+		_c.SetCreditLimit(*v)
+	}
+	return _c
+}
+
+// SetCreditHold sets the "credit_hold" field.
+func (_c *CustomerCreate) SetCreditHold(v bool) *CustomerCreate {
+	_c.mutation.SetCreditHold(v)
+	return _c
+}
+
+// SetNillableCreditHold sets the "credit_hold" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableCreditHold(v *bool) *CustomerCreate {
+	if v != nil {
+		_c.SetCreditHold(*v)
+	}
+	return _c
+}
+
+// SetCreditHoldSource sets the "credit_hold_source" field.
+func (_c *CustomerCreate) SetCreditHoldSource(v string) *CustomerCreate {
+	_c.mutation.SetCreditHoldSource(v)
+	return _c
+}
+
+// SetNillableCreditHoldSource sets the "credit_hold_source" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableCreditHoldSource(v *string) *CustomerCreate {
+	if v != nil {
+		_c.SetCreditHoldSource(*v)
+	}
+	return _c
+}
+
+// SetCreditHoldReason sets the "credit_hold_reason" field.
+func (_c *CustomerCreate) SetCreditHoldReason(v string) *CustomerCreate {
+	_c.mutation.SetCreditHoldReason(v)
+	return _c
+}
+
+// SetNillableCreditHoldReason sets the "credit_hold_reason" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableCreditHoldReason(v *string) *CustomerCreate {
+	if v != nil {
+		_c.SetCreditHoldReason(*v)
+	}
+	return _c
+}
+
+// SetCreditHoldAt sets the "credit_hold_at" field.
+func (_c *CustomerCreate) SetCreditHoldAt(v time.Time) *CustomerCreate {
+	_c.mutation.SetCreditHoldAt(v)
+	return _c
+}
+
+// SetNillableCreditHoldAt sets the "credit_hold_at" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableCreditHoldAt(v *time.Time) *CustomerCreate {
+	if v != nil {
+		_c.SetCreditHoldAt(*v)
+	}
+	return _c
+}
+
+// SetAuthUserID sets the "auth_user_id" field.
+func (_c *CustomerCreate) SetAuthUserID(v uuid.UUID) *CustomerCreate {
+	_c.mutation.SetAuthUserID(v)
+	return _c
+}
+
+// SetNillableAuthUserID sets the "auth_user_id" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableAuthUserID(v *uuid.UUID) *CustomerCreate {
+	if v != nil {
+		_c.SetAuthUserID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CustomerCreate) SetStatus(v string) *CustomerCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableStatus(v *string) *CustomerCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *CustomerCreate) SetMetadata(v map[string]interface{}) *CustomerCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CustomerCreate) SetCreatedAt(v time.Time) *CustomerCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableCreatedAt(v *time.Time) *CustomerCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CustomerCreate) SetUpdatedAt(v time.Time) *CustomerCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableUpdatedAt(v *time.Time) *CustomerCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CustomerCreate) SetID(v uuid.UUID) *CustomerCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableID(v *uuid.UUID) *CustomerCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CustomerMutation object of the builder.
+func (_c *CustomerCreate) Mutation() *CustomerMutation {
+	return _c.mutation
+}
+
+// Save creates the Customer in the database.
+func (_c *CustomerCreate) Save(ctx context.Context) (*Customer, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CustomerCreate) SaveX(ctx context.Context) *Customer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CustomerCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CustomerCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CustomerCreate) defaults() {
+	if _, ok := _c.mutation.DefaultCurrency(); !ok {
+		v := customer.DefaultDefaultCurrency
+		_c.mutation.SetDefaultCurrency(v)
+	}
+	if _, ok := _c.mutation.PaymentTermsDays(); !ok {
+		v := customer.DefaultPaymentTermsDays
+		_c.mutation.SetPaymentTermsDays(v)
+	}
+	if _, ok := _c.mutation.CreditHold(); !ok {
+		v := customer.DefaultCreditHold
+		_c.mutation.SetCreditHold(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := customer.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		v := customer.DefaultMetadata
+		_c.mutation.SetMetadata(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := customer.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := customer.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := customer.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CustomerCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Customer.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CustomerNumber(); !ok {
+		return &ValidationError{Name: "customer_number", err: errors.New(`ent: missing required field "Customer.customer_number"`)}
+	}
+	if v, ok := _c.mutation.CustomerNumber(); ok {
+		if err := customer.CustomerNumberValidator(v); err != nil {
+			return &ValidationError{Name: "customer_number", err: fmt.Errorf(`ent: validator failed for field "Customer.customer_number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LegalName(); !ok {
+		return &ValidationError{Name: "legal_name", err: errors.New(`ent: missing required field "Customer.legal_name"`)}
+	}
+	if v, ok := _c.mutation.LegalName(); ok {
+		if err := customer.LegalNameValidator(v); err != nil {
+			return &ValidationError{Name: "legal_name", err: fmt.Errorf(`ent: validator failed for field "Customer.legal_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DefaultCurrency(); !ok {
+		return &ValidationError{Name: "default_currency", err: errors.New(`ent: missing required field "Customer.default_currency"`)}
+	}
+	if _, ok := _c.mutation.PaymentTermsDays(); !ok {
+		return &ValidationError{Name: "payment_terms_days", err: errors.New(`ent: missing required field "Customer.payment_terms_days"`)}
+	}
+	if _, ok := _c.mutation.CreditHold(); !ok {
+		return &ValidationError{Name: "credit_hold", err: errors.New(`ent: missing required field "Customer.credit_hold"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Customer.status"`)}
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		return &ValidationError{Name: "metadata", err: errors.New(`ent: missing required field "Customer.metadata"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Customer.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Customer.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *CustomerCreate) sqlSave(ctx context.Context) (*Customer, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CustomerCreate) createSpec() (*Customer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Customer{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(customer.Table, sqlgraph.NewFieldSpec(customer.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(customer.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CustomerNumber(); ok {
+		_spec.SetField(customer.FieldCustomerNumber, field.TypeString, value)
+		_node.CustomerNumber = value
+	}
+	if value, ok := _c.mutation.LegalName(); ok {
+		_spec.SetField(customer.FieldLegalName, field.TypeString, value)
+		_node.LegalName = value
+	}
+	if value, ok := _c.mutation.TradingName(); ok {
+		_spec.SetField(customer.FieldTradingName, field.TypeString, value)
+		_node.TradingName = value
+	}
+	if value, ok := _c.mutation.KraPin(); ok {
+		_spec.SetField(customer.FieldKraPin, field.TypeString, value)
+		_node.KraPin = value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(customer.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.Phone(); ok {
+		_spec.SetField(customer.FieldPhone, field.TypeString, value)
+		_node.Phone = value
+	}
+	if value, ok := _c.mutation.BillingAddresses(); ok {
+		_spec.SetField(customer.FieldBillingAddresses, field.TypeJSON, value)
+		_node.BillingAddresses = value
+	}
+	if value, ok := _c.mutation.Contacts(); ok {
+		_spec.SetField(customer.FieldContacts, field.TypeJSON, value)
+		_node.Contacts = value
+	}
+	if value, ok := _c.mutation.DefaultCurrency(); ok {
+		_spec.SetField(customer.FieldDefaultCurrency, field.TypeString, value)
+		_node.DefaultCurrency = value
+	}
+	if value, ok := _c.mutation.PaymentTermsDays(); ok {
+		_spec.SetField(customer.FieldPaymentTermsDays, field.TypeInt, value)
+		_node.PaymentTermsDays = value
+	}
+	if value, ok := _c.mutation.CreditLimit(); ok {
+		_spec.SetField(customer.FieldCreditLimit, field.TypeFloat64, value)
+		_node.CreditLimit = &value
+	}
+	if value, ok := _c.mutation.CreditHold(); ok {
+		_spec.SetField(customer.FieldCreditHold, field.TypeBool, value)
+		_node.CreditHold = value
+	}
+	if value, ok := _c.mutation.CreditHoldSource(); ok {
+		_spec.SetField(customer.FieldCreditHoldSource, field.TypeString, value)
+		_node.CreditHoldSource = value
+	}
+	if value, ok := _c.mutation.CreditHoldReason(); ok {
+		_spec.SetField(customer.FieldCreditHoldReason, field.TypeString, value)
+		_node.CreditHoldReason = value
+	}
+	if value, ok := _c.mutation.CreditHoldAt(); ok {
+		_spec.SetField(customer.FieldCreditHoldAt, field.TypeTime, value)
+		_node.CreditHoldAt = value
+	}
+	if value, ok := _c.mutation.AuthUserID(); ok {
+		_spec.SetField(customer.FieldAuthUserID, field.TypeUUID, value)
+		_node.AuthUserID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(customer.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(customer.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(customer.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(customer.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Customer.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CustomerUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CustomerCreate) OnConflict(opts ...sql.ConflictOption) *CustomerUpsertOne {
+	_c.conflict = opts
+	return &CustomerUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Customer.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CustomerCreate) OnConflictColumns(columns ...string) *CustomerUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CustomerUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CustomerUpsertOne is the builder for "upsert"-ing
+	//  one Customer node.
+	CustomerUpsertOne struct {
+		create *CustomerCreate
+	}
+
+	// CustomerUpsert is the "OnConflict" setter.
+	CustomerUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *CustomerUpsert) SetTenantID(v uuid.UUID) *CustomerUpsert {
+	u.Set(customer.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateTenantID() *CustomerUpsert {
+	u.SetExcluded(customer.FieldTenantID)
+	return u
+}
+
+// SetLegalName sets the "legal_name" field.
+func (u *CustomerUpsert) SetLegalName(v string) *CustomerUpsert {
+	u.Set(customer.FieldLegalName, v)
+	return u
+}
+
+// UpdateLegalName sets the "legal_name" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateLegalName() *CustomerUpsert {
+	u.SetExcluded(customer.FieldLegalName)
+	return u
+}
+
+// SetTradingName sets the "trading_name" field.
+func (u *CustomerUpsert) SetTradingName(v string) *CustomerUpsert {
+	u.Set(customer.FieldTradingName, v)
+	return u
+}
+
+// UpdateTradingName sets the "trading_name" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateTradingName() *CustomerUpsert {
+	u.SetExcluded(customer.FieldTradingName)
+	return u
+}
+
+// ClearTradingName clears the value of the "trading_name" field.
+func (u *CustomerUpsert) ClearTradingName() *CustomerUpsert {
+	u.SetNull(customer.FieldTradingName)
+	return u
+}
+
+// SetKraPin sets the "kra_pin" field.
+func (u *CustomerUpsert) SetKraPin(v string) *CustomerUpsert {
+	u.Set(customer.FieldKraPin, v)
+	return u
+}
+
+// UpdateKraPin sets the "kra_pin" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateKraPin() *CustomerUpsert {
+	u.SetExcluded(customer.FieldKraPin)
+	return u
+}
+
+// ClearKraPin clears the value of the "kra_pin" field.
+func (u *CustomerUpsert) ClearKraPin() *CustomerUpsert {
+	u.SetNull(customer.FieldKraPin)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *CustomerUpsert) SetEmail(v string) *CustomerUpsert {
+	u.Set(customer.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateEmail() *CustomerUpsert {
+	u.SetExcluded(customer.FieldEmail)
+	return u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *CustomerUpsert) ClearEmail() *CustomerUpsert {
+	u.SetNull(customer.FieldEmail)
+	return u
+}
+
+// SetPhone sets the "phone" field.
+func (u *CustomerUpsert) SetPhone(v string) *CustomerUpsert {
+	u.Set(customer.FieldPhone, v)
+	return u
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdatePhone() *CustomerUpsert {
+	u.SetExcluded(customer.FieldPhone)
+	return u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *CustomerUpsert) ClearPhone() *CustomerUpsert {
+	u.SetNull(customer.FieldPhone)
+	return u
+}
+
+// SetBillingAddresses sets the "billing_addresses" field.
+func (u *CustomerUpsert) SetBillingAddresses(v []profile.Address) *CustomerUpsert {
+	u.Set(customer.FieldBillingAddresses, v)
+	return u
+}
+
+// UpdateBillingAddresses sets the "billing_addresses" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateBillingAddresses() *CustomerUpsert {
+	u.SetExcluded(customer.FieldBillingAddresses)
+	return u
+}
+
+// ClearBillingAddresses clears the value of the "billing_addresses" field.
+func (u *CustomerUpsert) ClearBillingAddresses() *CustomerUpsert {
+	u.SetNull(customer.FieldBillingAddresses)
+	return u
+}
+
+// SetContacts sets the "contacts" field.
+func (u *CustomerUpsert) SetContacts(v []profile.Contact) *CustomerUpsert {
+	u.Set(customer.FieldContacts, v)
+	return u
+}
+
+// UpdateContacts sets the "contacts" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateContacts() *CustomerUpsert {
+	u.SetExcluded(customer.FieldContacts)
+	return u
+}
+
+// ClearContacts clears the value of the "contacts" field.
+func (u *CustomerUpsert) ClearContacts() *CustomerUpsert {
+	u.SetNull(customer.FieldContacts)
+	return u
+}
+
+// SetDefaultCurrency sets the "default_currency" field.
+func (u *CustomerUpsert) SetDefaultCurrency(v string) *CustomerUpsert {
+	u.Set(customer.FieldDefaultCurrency, v)
+	return u
+}
+
+// UpdateDefaultCurrency sets the "default_currency" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateDefaultCurrency() *CustomerUpsert {
+	u.SetExcluded(customer.FieldDefaultCurrency)
+	return u
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (u *CustomerUpsert) SetPaymentTermsDays(v int) *CustomerUpsert {
+	u.Set(customer.FieldPaymentTermsDays, v)
+	return u
+}
+
+// UpdatePaymentTermsDays sets the "payment_terms_days" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdatePaymentTermsDays() *CustomerUpsert {
+	u.SetExcluded(customer.FieldPaymentTermsDays)
+	return u
+}
+
+// AddPaymentTermsDays adds v to the "payment_terms_days" field.
+func (u *CustomerUpsert) AddPaymentTermsDays(v int) *CustomerUpsert {
+	u.Add(customer.FieldPaymentTermsDays, v)
+	return u
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (u *CustomerUpsert) SetCreditLimit(v decimal.Decimal) *CustomerUpsert {
+	u.Set(customer.FieldCreditLimit, v)
+	return u
+}
+
+// UpdateCreditLimit sets the "credit_limit" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateCreditLimit() *CustomerUpsert {
+	u.SetExcluded(customer.FieldCreditLimit)
+	return u
+}
+
+// AddCreditLimit adds v to the "credit_limit" field.
+func (u *CustomerUpsert) AddCreditLimit(v decimal.Decimal) *CustomerUpsert {
+	u.Add(customer.FieldCreditLimit, v)
+	return u
+}
+
+// ClearCreditLimit clears the value of the "credit_limit" field.
+func (u *CustomerUpsert) ClearCreditLimit() *CustomerUpsert {
+	u.SetNull(customer.FieldCreditLimit)
+	return u
+}
+
+// SetCreditHold sets the "credit_hold" field.
+func (u *CustomerUpsert) SetCreditHold(v bool) *CustomerUpsert {
+	u.Set(customer.FieldCreditHold, v)
+	return u
+}
+
+// UpdateCreditHold sets the "credit_hold" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateCreditHold() *CustomerUpsert {
+	u.SetExcluded(customer.FieldCreditHold)
+	return u
+}
+
+// SetCreditHoldSource sets the "credit_hold_source" field.
+func (u *CustomerUpsert) SetCreditHoldSource(v string) *CustomerUpsert {
+	u.Set(customer.FieldCreditHoldSource, v)
+	return u
+}
+
+// UpdateCreditHoldSource sets the "credit_hold_source" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateCreditHoldSource() *CustomerUpsert {
+	u.SetExcluded(customer.FieldCreditHoldSource)
+	return u
+}
+
+// ClearCreditHoldSource clears the value of the "credit_hold_source" field.
+func (u *CustomerUpsert) ClearCreditHoldSource() *CustomerUpsert {
+	u.SetNull(customer.FieldCreditHoldSource)
+	return u
+}
+
+// SetCreditHoldReason sets the "credit_hold_reason" field.
+func (u *CustomerUpsert) SetCreditHoldReason(v string) *CustomerUpsert {
+	u.Set(customer.FieldCreditHoldReason, v)
+	return u
+}
+
+// UpdateCreditHoldReason sets the "credit_hold_reason" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateCreditHoldReason() *CustomerUpsert {
+	u.SetExcluded(customer.FieldCreditHoldReason)
+	return u
+}
+
+// ClearCreditHoldReason clears the value of the "credit_hold_reason" field.
+func (u *CustomerUpsert) ClearCreditHoldReason() *CustomerUpsert {
+	u.SetNull(customer.FieldCreditHoldReason)
+	return u
+}
+
+// SetCreditHoldAt sets the "credit_hold_at" field.
+func (u *CustomerUpsert) SetCreditHoldAt(v time.Time) *CustomerUpsert {
+	u.Set(customer.FieldCreditHoldAt, v)
+	return u
+}
+
+// UpdateCreditHoldAt sets the "credit_hold_at" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateCreditHoldAt() *CustomerUpsert {
+	u.SetExcluded(customer.FieldCreditHoldAt)
+	return u
+}
+
+// ClearCreditHoldAt clears the value of the "credit_hold_at" field.
+func (u *CustomerUpsert) ClearCreditHoldAt() *CustomerUpsert {
+	u.SetNull(customer.FieldCreditHoldAt)
+	return u
+}
+
+// SetAuthUserID sets the "auth_user_id" field.
+func (u *CustomerUpsert) SetAuthUserID(v uuid.UUID) *CustomerUpsert {
+	u.Set(customer.FieldAuthUserID, v)
+	return u
+}
+
+// UpdateAuthUserID sets the "auth_user_id" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateAuthUserID() *CustomerUpsert {
+	u.SetExcluded(customer.FieldAuthUserID)
+	return u
+}
+
+// ClearAuthUserID clears the value of the "auth_user_id" field.
+func (u *CustomerUpsert) ClearAuthUserID() *CustomerUpsert {
+	u.SetNull(customer.FieldAuthUserID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CustomerUpsert) SetStatus(v string) *CustomerUpsert {
+	u.Set(customer.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateStatus() *CustomerUpsert {
+	u.SetExcluded(customer.FieldStatus)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *CustomerUpsert) SetMetadata(v map[string]interface{}) *CustomerUpsert {
+	u.Set(customer.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateMetadata() *CustomerUpsert {
+	u.SetExcluded(customer.FieldMetadata)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CustomerUpsert) SetUpdatedAt(v time.Time) *CustomerUpsert {
+	u.Set(customer.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CustomerUpsert) UpdateUpdatedAt() *CustomerUpsert {
+	u.SetExcluded(customer.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Customer.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(customer.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CustomerUpsertOne) UpdateNewValues() *CustomerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(customer.FieldID)
+		}
+		if _, exists := u.create.mutation.CustomerNumber(); exists {
+			s.SetIgnore(customer.FieldCustomerNumber)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(customer.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Customer.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CustomerUpsertOne) Ignore() *CustomerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CustomerUpsertOne) DoNothing() *CustomerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CustomerCreate.OnConflict
+// documentation for more info.
+func (u *CustomerUpsertOne) Update(set func(*CustomerUpsert)) *CustomerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CustomerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *CustomerUpsertOne) SetTenantID(v uuid.UUID) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateTenantID() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetLegalName sets the "legal_name" field.
+func (u *CustomerUpsertOne) SetLegalName(v string) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetLegalName(v)
+	})
+}
+
+// UpdateLegalName sets the "legal_name" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateLegalName() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateLegalName()
+	})
+}
+
+// SetTradingName sets the "trading_name" field.
+func (u *CustomerUpsertOne) SetTradingName(v string) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetTradingName(v)
+	})
+}
+
+// UpdateTradingName sets the "trading_name" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateTradingName() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateTradingName()
+	})
+}
+
+// ClearTradingName clears the value of the "trading_name" field.
+func (u *CustomerUpsertOne) ClearTradingName() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearTradingName()
+	})
+}
+
+// SetKraPin sets the "kra_pin" field.
+func (u *CustomerUpsertOne) SetKraPin(v string) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetKraPin(v)
+	})
+}
+
+// UpdateKraPin sets the "kra_pin" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateKraPin() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateKraPin()
+	})
+}
+
+// ClearKraPin clears the value of the "kra_pin" field.
+func (u *CustomerUpsertOne) ClearKraPin() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearKraPin()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *CustomerUpsertOne) SetEmail(v string) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateEmail() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *CustomerUpsertOne) ClearEmail() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *CustomerUpsertOne) SetPhone(v string) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdatePhone() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *CustomerUpsertOne) ClearPhone() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearPhone()
+	})
+}
+
+// SetBillingAddresses sets the "billing_addresses" field.
+func (u *CustomerUpsertOne) SetBillingAddresses(v []profile.Address) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetBillingAddresses(v)
+	})
+}
+
+// UpdateBillingAddresses sets the "billing_addresses" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateBillingAddresses() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateBillingAddresses()
+	})
+}
+
+// ClearBillingAddresses clears the value of the "billing_addresses" field.
+func (u *CustomerUpsertOne) ClearBillingAddresses() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearBillingAddresses()
+	})
+}
+
+// SetContacts sets the "contacts" field.
+func (u *CustomerUpsertOne) SetContacts(v []profile.Contact) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetContacts(v)
+	})
+}
+
+// UpdateContacts sets the "contacts" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateContacts() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateContacts()
+	})
+}
+
+// ClearContacts clears the value of the "contacts" field.
+func (u *CustomerUpsertOne) ClearContacts() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearContacts()
+	})
+}
+
+// SetDefaultCurrency sets the "default_currency" field.
+func (u *CustomerUpsertOne) SetDefaultCurrency(v string) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetDefaultCurrency(v)
+	})
+}
+
+// UpdateDefaultCurrency sets the "default_currency" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateDefaultCurrency() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateDefaultCurrency()
+	})
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (u *CustomerUpsertOne) SetPaymentTermsDays(v int) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetPaymentTermsDays(v)
+	})
+}
+
+// AddPaymentTermsDays adds v to the "payment_terms_days" field.
+func (u *CustomerUpsertOne) AddPaymentTermsDays(v int) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.AddPaymentTermsDays(v)
+	})
+}
+
+// UpdatePaymentTermsDays sets the "payment_terms_days" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdatePaymentTermsDays() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdatePaymentTermsDays()
+	})
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (u *CustomerUpsertOne) SetCreditLimit(v decimal.Decimal) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetCreditLimit(v)
+	})
+}
+
+// AddCreditLimit adds v to the "credit_limit" field.
+func (u *CustomerUpsertOne) AddCreditLimit(v decimal.Decimal) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.AddCreditLimit(v)
+	})
+}
+
+// UpdateCreditLimit sets the "credit_limit" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateCreditLimit() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateCreditLimit()
+	})
+}
+
+// ClearCreditLimit clears the value of the "credit_limit" field.
+func (u *CustomerUpsertOne) ClearCreditLimit() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearCreditLimit()
+	})
+}
+
+// SetCreditHold sets the "credit_hold" field.
+func (u *CustomerUpsertOne) SetCreditHold(v bool) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetCreditHold(v)
+	})
+}
+
+// UpdateCreditHold sets the "credit_hold" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateCreditHold() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateCreditHold()
+	})
+}
+
+// SetCreditHoldSource sets the "credit_hold_source" field.
+func (u *CustomerUpsertOne) SetCreditHoldSource(v string) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetCreditHoldSource(v)
+	})
+}
+
+// UpdateCreditHoldSource sets the "credit_hold_source" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateCreditHoldSource() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateCreditHoldSource()
+	})
+}
+
+// ClearCreditHoldSource clears the value of the "credit_hold_source" field.
+func (u *CustomerUpsertOne) ClearCreditHoldSource() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearCreditHoldSource()
+	})
+}
+
+// SetCreditHoldReason sets the "credit_hold_reason" field.
+func (u *CustomerUpsertOne) SetCreditHoldReason(v string) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetCreditHoldReason(v)
+	})
+}
+
+// UpdateCreditHoldReason sets the "credit_hold_reason" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateCreditHoldReason() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateCreditHoldReason()
+	})
+}
+
+// ClearCreditHoldReason clears the value of the "credit_hold_reason" field.
+func (u *CustomerUpsertOne) ClearCreditHoldReason() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearCreditHoldReason()
+	})
+}
+
+// SetCreditHoldAt sets the "credit_hold_at" field.
+func (u *CustomerUpsertOne) SetCreditHoldAt(v time.Time) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetCreditHoldAt(v)
+	})
+}
+
+// UpdateCreditHoldAt sets the "credit_hold_at" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateCreditHoldAt() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateCreditHoldAt()
+	})
+}
+
+// ClearCreditHoldAt clears the value of the "credit_hold_at" field.
+func (u *CustomerUpsertOne) ClearCreditHoldAt() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearCreditHoldAt()
+	})
+}
+
+// SetAuthUserID sets the "auth_user_id" field.
+func (u *CustomerUpsertOne) SetAuthUserID(v uuid.UUID) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetAuthUserID(v)
+	})
+}
+
+// UpdateAuthUserID sets the "auth_user_id" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateAuthUserID() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateAuthUserID()
+	})
+}
+
+// ClearAuthUserID clears the value of the "auth_user_id" field.
+func (u *CustomerUpsertOne) ClearAuthUserID() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearAuthUserID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CustomerUpsertOne) SetStatus(v string) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateStatus() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *CustomerUpsertOne) SetMetadata(v map[string]interface{}) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateMetadata() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CustomerUpsertOne) SetUpdatedAt(v time.Time) *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CustomerUpsertOne) UpdateUpdatedAt() *CustomerUpsertOne {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CustomerUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CustomerCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CustomerUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CustomerUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CustomerUpsertOne.ID is not supported by MySQL driver. Use CustomerUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CustomerUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CustomerCreateBulk is the builder for creating many Customer entities in bulk.
+type CustomerCreateBulk struct {
+	config
+	err      error
+	builders []*CustomerCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Customer entities in the database.
+func (_c *CustomerCreateBulk) Save(ctx context.Context) ([]*Customer, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Customer, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CustomerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CustomerCreateBulk) SaveX(ctx context.Context) []*Customer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CustomerCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CustomerCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Customer.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CustomerUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CustomerCreateBulk) OnConflict(opts ...sql.ConflictOption) *CustomerUpsertBulk {
+	_c.conflict = opts
+	return &CustomerUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Customer.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CustomerCreateBulk) OnConflictColumns(columns ...string) *CustomerUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CustomerUpsertBulk{
+		create: _c,
+	}
+}
+
+// CustomerUpsertBulk is the builder for "upsert"-ing
+// a bulk of Customer nodes.
+type CustomerUpsertBulk struct {
+	create *CustomerCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Customer.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(customer.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CustomerUpsertBulk) UpdateNewValues() *CustomerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(customer.FieldID)
+			}
+			if _, exists := b.mutation.CustomerNumber(); exists {
+				s.SetIgnore(customer.FieldCustomerNumber)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(customer.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Customer.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CustomerUpsertBulk) Ignore() *CustomerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CustomerUpsertBulk) DoNothing() *CustomerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CustomerCreateBulk.OnConflict
+// documentation for more info.
+func (u *CustomerUpsertBulk) Update(set func(*CustomerUpsert)) *CustomerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CustomerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *CustomerUpsertBulk) SetTenantID(v uuid.UUID) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateTenantID() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetLegalName sets the "legal_name" field.
+func (u *CustomerUpsertBulk) SetLegalName(v string) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetLegalName(v)
+	})
+}
+
+// UpdateLegalName sets the "legal_name" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateLegalName() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateLegalName()
+	})
+}
+
+// SetTradingName sets the "trading_name" field.
+func (u *CustomerUpsertBulk) SetTradingName(v string) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetTradingName(v)
+	})
+}
+
+// UpdateTradingName sets the "trading_name" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateTradingName() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateTradingName()
+	})
+}
+
+// ClearTradingName clears the value of the "trading_name" field.
+func (u *CustomerUpsertBulk) ClearTradingName() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearTradingName()
+	})
+}
+
+// SetKraPin sets the "kra_pin" field.
+func (u *CustomerUpsertBulk) SetKraPin(v string) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetKraPin(v)
+	})
+}
+
+// UpdateKraPin sets the "kra_pin" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateKraPin() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateKraPin()
+	})
+}
+
+// ClearKraPin clears the value of the "kra_pin" field.
+func (u *CustomerUpsertBulk) ClearKraPin() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearKraPin()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *CustomerUpsertBulk) SetEmail(v string) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateEmail() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *CustomerUpsertBulk) ClearEmail() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *CustomerUpsertBulk) SetPhone(v string) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdatePhone() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *CustomerUpsertBulk) ClearPhone() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearPhone()
+	})
+}
+
+// SetBillingAddresses sets the "billing_addresses" field.
+func (u *CustomerUpsertBulk) SetBillingAddresses(v []profile.Address) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetBillingAddresses(v)
+	})
+}
+
+// UpdateBillingAddresses sets the "billing_addresses" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateBillingAddresses() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateBillingAddresses()
+	})
+}
+
+// ClearBillingAddresses clears the value of the "billing_addresses" field.
+func (u *CustomerUpsertBulk) ClearBillingAddresses() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearBillingAddresses()
+	})
+}
+
+// SetContacts sets the "contacts" field.
+func (u *CustomerUpsertBulk) SetContacts(v []profile.Contact) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetContacts(v)
+	})
+}
+
+// UpdateContacts sets the "contacts" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateContacts() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateContacts()
+	})
+}
+
+// ClearContacts clears the value of the "contacts" field.
+func (u *CustomerUpsertBulk) ClearContacts() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearContacts()
+	})
+}
+
+// SetDefaultCurrency sets the "default_currency" field.
+func (u *CustomerUpsertBulk) SetDefaultCurrency(v string) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetDefaultCurrency(v)
+	})
+}
+
+// UpdateDefaultCurrency sets the "default_currency" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateDefaultCurrency() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateDefaultCurrency()
+	})
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (u *CustomerUpsertBulk) SetPaymentTermsDays(v int) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetPaymentTermsDays(v)
+	})
+}
+
+// AddPaymentTermsDays adds v to the "payment_terms_days" field.
+func (u *CustomerUpsertBulk) AddPaymentTermsDays(v int) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.AddPaymentTermsDays(v)
+	})
+}
+
+// UpdatePaymentTermsDays sets the "payment_terms_days" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdatePaymentTermsDays() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdatePaymentTermsDays()
+	})
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (u *CustomerUpsertBulk) SetCreditLimit(v decimal.Decimal) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetCreditLimit(v)
+	})
+}
+
+// AddCreditLimit adds v to the "credit_limit" field.
+func (u *CustomerUpsertBulk) AddCreditLimit(v decimal.Decimal) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.AddCreditLimit(v)
+	})
+}
+
+// UpdateCreditLimit sets the "credit_limit" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateCreditLimit() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateCreditLimit()
+	})
+}
+
+// ClearCreditLimit clears the value of the "credit_limit" field.
+func (u *CustomerUpsertBulk) ClearCreditLimit() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearCreditLimit()
+	})
+}
+
+// SetCreditHold sets the "credit_hold" field.
+func (u *CustomerUpsertBulk) SetCreditHold(v bool) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetCreditHold(v)
+	})
+}
+
+// UpdateCreditHold sets the "credit_hold" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateCreditHold() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateCreditHold()
+	})
+}
+
+// SetCreditHoldSource sets the "credit_hold_source" field.
+func (u *CustomerUpsertBulk) SetCreditHoldSource(v string) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetCreditHoldSource(v)
+	})
+}
+
+// UpdateCreditHoldSource sets the "credit_hold_source" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateCreditHoldSource() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateCreditHoldSource()
+	})
+}
+
+// ClearCreditHoldSource clears the value of the "credit_hold_source" field.
+func (u *CustomerUpsertBulk) ClearCreditHoldSource() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearCreditHoldSource()
+	})
+}
+
+// SetCreditHoldReason sets the "credit_hold_reason" field.
+func (u *CustomerUpsertBulk) SetCreditHoldReason(v string) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetCreditHoldReason(v)
+	})
+}
+
+// UpdateCreditHoldReason sets the "credit_hold_reason" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateCreditHoldReason() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateCreditHoldReason()
+	})
+}
+
+// ClearCreditHoldReason clears the value of the "credit_hold_reason" field.
+func (u *CustomerUpsertBulk) ClearCreditHoldReason() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearCreditHoldReason()
+	})
+}
+
+// SetCreditHoldAt sets the "credit_hold_at" field.
+func (u *CustomerUpsertBulk) SetCreditHoldAt(v time.Time) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetCreditHoldAt(v)
+	})
+}
+
+// UpdateCreditHoldAt sets the "credit_hold_at" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateCreditHoldAt() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateCreditHoldAt()
+	})
+}
+
+// ClearCreditHoldAt clears the value of the "credit_hold_at" field.
+func (u *CustomerUpsertBulk) ClearCreditHoldAt() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearCreditHoldAt()
+	})
+}
+
+// SetAuthUserID sets the "auth_user_id" field.
+func (u *CustomerUpsertBulk) SetAuthUserID(v uuid.UUID) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetAuthUserID(v)
+	})
+}
+
+// UpdateAuthUserID sets the "auth_user_id" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateAuthUserID() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateAuthUserID()
+	})
+}
+
+// ClearAuthUserID clears the value of the "auth_user_id" field.
+func (u *CustomerUpsertBulk) ClearAuthUserID() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.ClearAuthUserID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CustomerUpsertBulk) SetStatus(v string) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateStatus() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *CustomerUpsertBulk) SetMetadata(v map[string]interface{}) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateMetadata() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CustomerUpsertBulk) SetUpdatedAt(v time.Time) *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CustomerUpsertBulk) UpdateUpdatedAt() *CustomerUpsertBulk {
+	return u.Update(func(s *CustomerUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CustomerUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CustomerCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CustomerCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CustomerUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
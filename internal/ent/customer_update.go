@@ -0,0 +1,1088 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/customer"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/modules/customers/profile"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CustomerUpdate is the builder for updating Customer entities.
+type CustomerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CustomerMutation
+}
+
+// Where appends a list predicates to the CustomerUpdate builder.
+func (_u *CustomerUpdate) Where(ps ...predicate.Customer) *CustomerUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *CustomerUpdate) SetTenantID(v uuid.UUID) *CustomerUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillableTenantID(v *uuid.UUID) *CustomerUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetLegalName sets the "legal_name" field.
+func (_u *CustomerUpdate) SetLegalName(v string) *CustomerUpdate {
+	_u.mutation.SetLegalName(v)
+	return _u
+}
+
+// SetNillableLegalName sets the "legal_name" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillableLegalName(v *string) *CustomerUpdate {
+	if v != nil {
+		_u.SetLegalName(*v)
+	}
+	return _u
+}
+
+// SetTradingName sets the "trading_name" field.
+func (_u *CustomerUpdate) SetTradingName(v string) *CustomerUpdate {
+	_u.mutation.SetTradingName(v)
+	return _u
+}
+
+// SetNillableTradingName sets the "trading_name" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillableTradingName(v *string) *CustomerUpdate {
+	if v != nil {
+		_u.SetTradingName(*v)
+	}
+	return _u
+}
+
+// ClearTradingName clears the value of the "trading_name" field.
+func (_u *CustomerUpdate) ClearTradingName() *CustomerUpdate {
+	_u.mutation.ClearTradingName()
+	return _u
+}
+
+// SetKraPin sets the "kra_pin" field.
+func (_u *CustomerUpdate) SetKraPin(v string) *CustomerUpdate {
+	_u.mutation.SetKraPin(v)
+	return _u
+}
+
+// SetNillableKraPin sets the "kra_pin" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillableKraPin(v *string) *CustomerUpdate {
+	if v != nil {
+		_u.SetKraPin(*v)
+	}
+	return _u
+}
+
+// ClearKraPin clears the value of the "kra_pin" field.
+func (_u *CustomerUpdate) ClearKraPin() *CustomerUpdate {
+	_u.mutation.ClearKraPin()
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *CustomerUpdate) SetEmail(v string) *CustomerUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillableEmail(v *string) *CustomerUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *CustomerUpdate) ClearEmail() *CustomerUpdate {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *CustomerUpdate) SetPhone(v string) *CustomerUpdate {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillablePhone(v *string) *CustomerUpdate {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *CustomerUpdate) ClearPhone() *CustomerUpdate {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetBillingAddresses sets the "billing_addresses" field.
+func (_u *CustomerUpdate) SetBillingAddresses(v []profile.Address) *CustomerUpdate {
+	_u.mutation.SetBillingAddresses(v)
+	return _u
+}
+
+// AppendBillingAddresses appends value to the "billing_addresses" field.
+func (_u *CustomerUpdate) AppendBillingAddresses(v []profile.Address) *CustomerUpdate {
+	_u.mutation.AppendBillingAddresses(v)
+	return _u
+}
+
+// ClearBillingAddresses clears the value of the "billing_addresses" field.
+func (_u *CustomerUpdate) ClearBillingAddresses() *CustomerUpdate {
+	_u.mutation.ClearBillingAddresses()
+	return _u
+}
+
+// SetContacts sets the "contacts" field.
+func (_u *CustomerUpdate) SetContacts(v []profile.Contact) *CustomerUpdate {
+	_u.mutation.SetContacts(v)
+	return _u
+}
+
+// AppendContacts appends value to the "contacts" field.
+func (_u *CustomerUpdate) AppendContacts(v []profile.Contact) *CustomerUpdate {
+	_u.mutation.AppendContacts(v)
+	return _u
+}
+
+// ClearContacts clears the value of the "contacts" field.
+func (_u *CustomerUpdate) ClearContacts() *CustomerUpdate {
+	_u.mutation.ClearContacts()
+	return _u
+}
+
+// SetDefaultCurrency sets the "default_currency" field.
+func (_u *CustomerUpdate) SetDefaultCurrency(v string) *CustomerUpdate {
+	_u.mutation.SetDefaultCurrency(v)
+	return _u
+}
+
+// SetNillableDefaultCurrency sets the "default_currency" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillableDefaultCurrency(v *string) *CustomerUpdate {
+	if v != nil {
+		_u.SetDefaultCurrency(*v)
+	}
+	return _u
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (_u *CustomerUpdate) SetPaymentTermsDays(v int) *CustomerUpdate {
+	_u.mutation.ResetPaymentTermsDays()
+	_u.mutation.SetPaymentTermsDays(v)
+	return _u
+}
+
+// SetNillablePaymentTermsDays sets the "payment_terms_days" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillablePaymentTermsDays(v *int) *CustomerUpdate {
+	if v != nil {
+		_u.SetPaymentTermsDays(*v)
+	}
+	return _u
+}
+
+// AddPaymentTermsDays adds value to the "payment_terms_days" field.
+func (_u *CustomerUpdate) AddPaymentTermsDays(v int) *CustomerUpdate {
+	_u.mutation.AddPaymentTermsDays(v)
+	return _u
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (_u *CustomerUpdate) SetCreditLimit(v decimal.Decimal) *CustomerUpdate {
+	_u.mutation.ResetCreditLimit()
+	_u.mutation.SetCreditLimit(v)
+	return _u
+}
+
+// SetNillableCreditLimit sets the "credit_limit" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillableCreditLimit(v *decimal.Decimal) *CustomerUpdate {
+	if v != nil {
+		_u.SetCreditLimit(*v)
+	}
+	return _u
+}
+
+// AddCreditLimit adds value to the "credit_limit" field.
+func (_u *CustomerUpdate) AddCreditLimit(v decimal.Decimal) *CustomerUpdate {
+	_u.mutation.AddCreditLimit(v)
+	return _u
+}
+
+// ClearCreditLimit clears the value of the "credit_limit" field.
+func (_u *CustomerUpdate) ClearCreditLimit() *CustomerUpdate {
+	_u.mutation.ClearCreditLimit()
+	return _u
+}
+
+// SetCreditHold sets the "credit_hold" field.
+func (_u *CustomerUpdate) SetCreditHold(v bool) *CustomerUpdate {
+	_u.mutation.SetCreditHold(v)
+	return _u
+}
+
+// SetNillableCreditHold sets the "credit_hold" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillableCreditHold(v *bool) *CustomerUpdate {
+	if v != nil {
+		_u.SetCreditHold(*v)
+	}
+	return _u
+}
+
+// SetCreditHoldSource sets the "credit_hold_source" field.
+func (_u *CustomerUpdate) SetCreditHoldSource(v string) *CustomerUpdate {
+	_u.mutation.SetCreditHoldSource(v)
+	return _u
+}
+
+// SetNillableCreditHoldSource sets the "credit_hold_source" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillableCreditHoldSource(v *string) *CustomerUpdate {
+	if v != nil {
+		_u.SetCreditHoldSource(*v)
+	}
+	return _u
+}
+
+// ClearCreditHoldSource clears the value of the "credit_hold_source" field.
+func (_u *CustomerUpdate) ClearCreditHoldSource() *CustomerUpdate {
+	_u.mutation.ClearCreditHoldSource()
+	return _u
+}
+
+// SetCreditHoldReason sets the "credit_hold_reason" field.
+func (_u *CustomerUpdate) SetCreditHoldReason(v string) *CustomerUpdate {
+	_u.mutation.SetCreditHoldReason(v)
+	return _u
+}
+
+// SetNillableCreditHoldReason sets the "credit_hold_reason" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillableCreditHoldReason(v *string) *CustomerUpdate {
+	if v != nil {
+		_u.SetCreditHoldReason(*v)
+	}
+	return _u
+}
+
+// ClearCreditHoldReason clears the value of the "credit_hold_reason" field.
+func (_u *CustomerUpdate) ClearCreditHoldReason() *CustomerUpdate {
+	_u.mutation.ClearCreditHoldReason()
+	return _u
+}
+
+// SetCreditHoldAt sets the "credit_hold_at" field.
+func (_u *CustomerUpdate) SetCreditHoldAt(v time.Time) *CustomerUpdate {
+	_u.mutation.SetCreditHoldAt(v)
+	return _u
+}
+
+// SetNillableCreditHoldAt sets the "credit_hold_at" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillableCreditHoldAt(v *time.Time) *CustomerUpdate {
+	if v != nil {
+		_u.SetCreditHoldAt(*v)
+	}
+	return _u
+}
+
+// ClearCreditHoldAt clears the value of the "credit_hold_at" field.
+func (_u *CustomerUpdate) ClearCreditHoldAt() *CustomerUpdate {
+	_u.mutation.ClearCreditHoldAt()
+	return _u
+}
+
+// SetAuthUserID sets the "auth_user_id" field.
+func (_u *CustomerUpdate) SetAuthUserID(v uuid.UUID) *CustomerUpdate {
+	_u.mutation.SetAuthUserID(v)
+	return _u
+}
+
+// SetNillableAuthUserID sets the "auth_user_id" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillableAuthUserID(v *uuid.UUID) *CustomerUpdate {
+	if v != nil {
+		_u.SetAuthUserID(*v)
+	}
+	return _u
+}
+
+// ClearAuthUserID clears the value of the "auth_user_id" field.
+func (_u *CustomerUpdate) ClearAuthUserID() *CustomerUpdate {
+	_u.mutation.ClearAuthUserID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CustomerUpdate) SetStatus(v string) *CustomerUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillableStatus(v *string) *CustomerUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *CustomerUpdate) SetMetadata(v map[string]interface{}) *CustomerUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CustomerUpdate) SetUpdatedAt(v time.Time) *CustomerUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the CustomerMutation object of the builder.
+func (_u *CustomerUpdate) Mutation() *CustomerMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CustomerUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CustomerUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CustomerUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CustomerUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CustomerUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := customer.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CustomerUpdate) check() error {
+	if v, ok := _u.mutation.LegalName(); ok {
+		if err := customer.LegalNameValidator(v); err != nil {
+			return &ValidationError{Name: "legal_name", err: fmt.Errorf(`ent: validator failed for field "Customer.legal_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CustomerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(customer.Table, customer.Columns, sqlgraph.NewFieldSpec(customer.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(customer.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.LegalName(); ok {
+		_spec.SetField(customer.FieldLegalName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TradingName(); ok {
+		_spec.SetField(customer.FieldTradingName, field.TypeString, value)
+	}
+	if _u.mutation.TradingNameCleared() {
+		_spec.ClearField(customer.FieldTradingName, field.TypeString)
+	}
+	if value, ok := _u.mutation.KraPin(); ok {
+		_spec.SetField(customer.FieldKraPin, field.TypeString, value)
+	}
+	if _u.mutation.KraPinCleared() {
+		_spec.ClearField(customer.FieldKraPin, field.TypeString)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(customer.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(customer.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(customer.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(customer.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.BillingAddresses(); ok {
+		_spec.SetField(customer.FieldBillingAddresses, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBillingAddresses(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, customer.FieldBillingAddresses, value)
+		})
+	}
+	if _u.mutation.BillingAddressesCleared() {
+		_spec.ClearField(customer.FieldBillingAddresses, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Contacts(); ok {
+		_spec.SetField(customer.FieldContacts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedContacts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, customer.FieldContacts, value)
+		})
+	}
+	if _u.mutation.ContactsCleared() {
+		_spec.ClearField(customer.FieldContacts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DefaultCurrency(); ok {
+		_spec.SetField(customer.FieldDefaultCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PaymentTermsDays(); ok {
+		_spec.SetField(customer.FieldPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPaymentTermsDays(); ok {
+		_spec.AddField(customer.FieldPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreditLimit(); ok {
+		_spec.SetField(customer.FieldCreditLimit, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCreditLimit(); ok {
+		_spec.AddField(customer.FieldCreditLimit, field.TypeFloat64, value)
+	}
+	if _u.mutation.CreditLimitCleared() {
+		_spec.ClearField(customer.FieldCreditLimit, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CreditHold(); ok {
+		_spec.SetField(customer.FieldCreditHold, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreditHoldSource(); ok {
+		_spec.SetField(customer.FieldCreditHoldSource, field.TypeString, value)
+	}
+	if _u.mutation.CreditHoldSourceCleared() {
+		_spec.ClearField(customer.FieldCreditHoldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreditHoldReason(); ok {
+		_spec.SetField(customer.FieldCreditHoldReason, field.TypeString, value)
+	}
+	if _u.mutation.CreditHoldReasonCleared() {
+		_spec.ClearField(customer.FieldCreditHoldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreditHoldAt(); ok {
+		_spec.SetField(customer.FieldCreditHoldAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreditHoldAtCleared() {
+		_spec.ClearField(customer.FieldCreditHoldAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AuthUserID(); ok {
+		_spec.SetField(customer.FieldAuthUserID, field.TypeUUID, value)
+	}
+	if _u.mutation.AuthUserIDCleared() {
+		_spec.ClearField(customer.FieldAuthUserID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(customer.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(customer.FieldMetadata, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(customer.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CustomerUpdateOne is the builder for updating a single Customer entity.
+type CustomerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CustomerMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *CustomerUpdateOne) SetTenantID(v uuid.UUID) *CustomerUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillableTenantID(v *uuid.UUID) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetLegalName sets the "legal_name" field.
+func (_u *CustomerUpdateOne) SetLegalName(v string) *CustomerUpdateOne {
+	_u.mutation.SetLegalName(v)
+	return _u
+}
+
+// SetNillableLegalName sets the "legal_name" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillableLegalName(v *string) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetLegalName(*v)
+	}
+	return _u
+}
+
+// SetTradingName sets the "trading_name" field.
+func (_u *CustomerUpdateOne) SetTradingName(v string) *CustomerUpdateOne {
+	_u.mutation.SetTradingName(v)
+	return _u
+}
+
+// SetNillableTradingName sets the "trading_name" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillableTradingName(v *string) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetTradingName(*v)
+	}
+	return _u
+}
+
+// ClearTradingName clears the value of the "trading_name" field.
+func (_u *CustomerUpdateOne) ClearTradingName() *CustomerUpdateOne {
+	_u.mutation.ClearTradingName()
+	return _u
+}
+
+// SetKraPin sets the "kra_pin" field.
+func (_u *CustomerUpdateOne) SetKraPin(v string) *CustomerUpdateOne {
+	_u.mutation.SetKraPin(v)
+	return _u
+}
+
+// SetNillableKraPin sets the "kra_pin" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillableKraPin(v *string) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetKraPin(*v)
+	}
+	return _u
+}
+
+// ClearKraPin clears the value of the "kra_pin" field.
+func (_u *CustomerUpdateOne) ClearKraPin() *CustomerUpdateOne {
+	_u.mutation.ClearKraPin()
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *CustomerUpdateOne) SetEmail(v string) *CustomerUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillableEmail(v *string) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *CustomerUpdateOne) ClearEmail() *CustomerUpdateOne {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *CustomerUpdateOne) SetPhone(v string) *CustomerUpdateOne {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillablePhone(v *string) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *CustomerUpdateOne) ClearPhone() *CustomerUpdateOne {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetBillingAddresses sets the "billing_addresses" field.
+func (_u *CustomerUpdateOne) SetBillingAddresses(v []profile.Address) *CustomerUpdateOne {
+	_u.mutation.SetBillingAddresses(v)
+	return _u
+}
+
+// AppendBillingAddresses appends value to the "billing_addresses" field.
+func (_u *CustomerUpdateOne) AppendBillingAddresses(v []profile.Address) *CustomerUpdateOne {
+	_u.mutation.AppendBillingAddresses(v)
+	return _u
+}
+
+// ClearBillingAddresses clears the value of the "billing_addresses" field.
+func (_u *CustomerUpdateOne) ClearBillingAddresses() *CustomerUpdateOne {
+	_u.mutation.ClearBillingAddresses()
+	return _u
+}
+
+// SetContacts sets the "contacts" field.
+func (_u *CustomerUpdateOne) SetContacts(v []profile.Contact) *CustomerUpdateOne {
+	_u.mutation.SetContacts(v)
+	return _u
+}
+
+// AppendContacts appends value to the "contacts" field.
+func (_u *CustomerUpdateOne) AppendContacts(v []profile.Contact) *CustomerUpdateOne {
+	_u.mutation.AppendContacts(v)
+	return _u
+}
+
+// ClearContacts clears the value of the "contacts" field.
+func (_u *CustomerUpdateOne) ClearContacts() *CustomerUpdateOne {
+	_u.mutation.ClearContacts()
+	return _u
+}
+
+// SetDefaultCurrency sets the "default_currency" field.
+func (_u *CustomerUpdateOne) SetDefaultCurrency(v string) *CustomerUpdateOne {
+	_u.mutation.SetDefaultCurrency(v)
+	return _u
+}
+
+// SetNillableDefaultCurrency sets the "default_currency" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillableDefaultCurrency(v *string) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetDefaultCurrency(*v)
+	}
+	return _u
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (_u *CustomerUpdateOne) SetPaymentTermsDays(v int) *CustomerUpdateOne {
+	_u.mutation.ResetPaymentTermsDays()
+	_u.mutation.SetPaymentTermsDays(v)
+	return _u
+}
+
+// SetNillablePaymentTermsDays sets the "payment_terms_days" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillablePaymentTermsDays(v *int) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetPaymentTermsDays(*v)
+	}
+	return _u
+}
+
+// AddPaymentTermsDays adds value to the "payment_terms_days" field.
+func (_u *CustomerUpdateOne) AddPaymentTermsDays(v int) *CustomerUpdateOne {
+	_u.mutation.AddPaymentTermsDays(v)
+	return _u
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (_u *CustomerUpdateOne) SetCreditLimit(v decimal.Decimal) *CustomerUpdateOne {
+	_u.mutation.ResetCreditLimit()
+	_u.mutation.SetCreditLimit(v)
+	return _u
+}
+
+// SetNillableCreditLimit sets the "credit_limit" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillableCreditLimit(v *decimal.Decimal) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetCreditLimit(*v)
+	}
+	return _u
+}
+
+// AddCreditLimit adds value to the "credit_limit" field.
+func (_u *CustomerUpdateOne) AddCreditLimit(v decimal.Decimal) *CustomerUpdateOne {
+	_u.mutation.AddCreditLimit(v)
+	return _u
+}
+
+// ClearCreditLimit clears the value of the "credit_limit" field.
+func (_u *CustomerUpdateOne) ClearCreditLimit() *CustomerUpdateOne {
+	_u.mutation.ClearCreditLimit()
+	return _u
+}
+
+// SetCreditHold sets the "credit_hold" field.
+func (_u *CustomerUpdateOne) SetCreditHold(v bool) *CustomerUpdateOne {
+	_u.mutation.SetCreditHold(v)
+	return _u
+}
+
+// SetNillableCreditHold sets the "credit_hold" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillableCreditHold(v *bool) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetCreditHold(*v)
+	}
+	return _u
+}
+
+// SetCreditHoldSource sets the "credit_hold_source" field.
+func (_u *CustomerUpdateOne) SetCreditHoldSource(v string) *CustomerUpdateOne {
+	_u.mutation.SetCreditHoldSource(v)
+	return _u
+}
+
+// SetNillableCreditHoldSource sets the "credit_hold_source" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillableCreditHoldSource(v *string) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetCreditHoldSource(*v)
+	}
+	return _u
+}
+
+// ClearCreditHoldSource clears the value of the "credit_hold_source" field.
+func (_u *CustomerUpdateOne) ClearCreditHoldSource() *CustomerUpdateOne {
+	_u.mutation.ClearCreditHoldSource()
+	return _u
+}
+
+// SetCreditHoldReason sets the "credit_hold_reason" field.
+func (_u *CustomerUpdateOne) SetCreditHoldReason(v string) *CustomerUpdateOne {
+	_u.mutation.SetCreditHoldReason(v)
+	return _u
+}
+
+// SetNillableCreditHoldReason sets the "credit_hold_reason" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillableCreditHoldReason(v *string) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetCreditHoldReason(*v)
+	}
+	return _u
+}
+
+// ClearCreditHoldReason clears the value of the "credit_hold_reason" field.
+func (_u *CustomerUpdateOne) ClearCreditHoldReason() *CustomerUpdateOne {
+	_u.mutation.ClearCreditHoldReason()
+	return _u
+}
+
+// SetCreditHoldAt sets the "credit_hold_at" field.
+func (_u *CustomerUpdateOne) SetCreditHoldAt(v time.Time) *CustomerUpdateOne {
+	_u.mutation.SetCreditHoldAt(v)
+	return _u
+}
+
+// SetNillableCreditHoldAt sets the "credit_hold_at" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillableCreditHoldAt(v *time.Time) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetCreditHoldAt(*v)
+	}
+	return _u
+}
+
+// ClearCreditHoldAt clears the value of the "credit_hold_at" field.
+func (_u *CustomerUpdateOne) ClearCreditHoldAt() *CustomerUpdateOne {
+	_u.mutation.ClearCreditHoldAt()
+	return _u
+}
+
+// SetAuthUserID sets the "auth_user_id" field.
+func (_u *CustomerUpdateOne) SetAuthUserID(v uuid.UUID) *CustomerUpdateOne {
+	_u.mutation.SetAuthUserID(v)
+	return _u
+}
+
+// SetNillableAuthUserID sets the "auth_user_id" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillableAuthUserID(v *uuid.UUID) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetAuthUserID(*v)
+	}
+	return _u
+}
+
+// ClearAuthUserID clears the value of the "auth_user_id" field.
+func (_u *CustomerUpdateOne) ClearAuthUserID() *CustomerUpdateOne {
+	_u.mutation.ClearAuthUserID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CustomerUpdateOne) SetStatus(v string) *CustomerUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillableStatus(v *string) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *CustomerUpdateOne) SetMetadata(v map[string]interface{}) *CustomerUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CustomerUpdateOne) SetUpdatedAt(v time.Time) *CustomerUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the CustomerMutation object of the builder.
+func (_u *CustomerUpdateOne) Mutation() *CustomerMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CustomerUpdate builder.
+func (_u *CustomerUpdateOne) Where(ps ...predicate.Customer) *CustomerUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CustomerUpdateOne) Select(field string, fields ...string) *CustomerUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Customer entity.
+func (_u *CustomerUpdateOne) Save(ctx context.Context) (*Customer, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CustomerUpdateOne) SaveX(ctx context.Context) *Customer {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CustomerUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CustomerUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CustomerUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := customer.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CustomerUpdateOne) check() error {
+	if v, ok := _u.mutation.LegalName(); ok {
+		if err := customer.LegalNameValidator(v); err != nil {
+			return &ValidationError{Name: "legal_name", err: fmt.Errorf(`ent: validator failed for field "Customer.legal_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CustomerUpdateOne) sqlSave(ctx context.Context) (_node *Customer, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(customer.Table, customer.Columns, sqlgraph.NewFieldSpec(customer.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Customer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customer.FieldID)
+		for _, f := range fields {
+			if !customer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != customer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(customer.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.LegalName(); ok {
+		_spec.SetField(customer.FieldLegalName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TradingName(); ok {
+		_spec.SetField(customer.FieldTradingName, field.TypeString, value)
+	}
+	if _u.mutation.TradingNameCleared() {
+		_spec.ClearField(customer.FieldTradingName, field.TypeString)
+	}
+	if value, ok := _u.mutation.KraPin(); ok {
+		_spec.SetField(customer.FieldKraPin, field.TypeString, value)
+	}
+	if _u.mutation.KraPinCleared() {
+		_spec.ClearField(customer.FieldKraPin, field.TypeString)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(customer.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(customer.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(customer.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(customer.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.BillingAddresses(); ok {
+		_spec.SetField(customer.FieldBillingAddresses, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBillingAddresses(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, customer.FieldBillingAddresses, value)
+		})
+	}
+	if _u.mutation.BillingAddressesCleared() {
+		_spec.ClearField(customer.FieldBillingAddresses, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Contacts(); ok {
+		_spec.SetField(customer.FieldContacts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedContacts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, customer.FieldContacts, value)
+		})
+	}
+	if _u.mutation.ContactsCleared() {
+		_spec.ClearField(customer.FieldContacts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DefaultCurrency(); ok {
+		_spec.SetField(customer.FieldDefaultCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PaymentTermsDays(); ok {
+		_spec.SetField(customer.FieldPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPaymentTermsDays(); ok {
+		_spec.AddField(customer.FieldPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreditLimit(); ok {
+		_spec.SetField(customer.FieldCreditLimit, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCreditLimit(); ok {
+		_spec.AddField(customer.FieldCreditLimit, field.TypeFloat64, value)
+	}
+	if _u.mutation.CreditLimitCleared() {
+		_spec.ClearField(customer.FieldCreditLimit, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CreditHold(); ok {
+		_spec.SetField(customer.FieldCreditHold, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreditHoldSource(); ok {
+		_spec.SetField(customer.FieldCreditHoldSource, field.TypeString, value)
+	}
+	if _u.mutation.CreditHoldSourceCleared() {
+		_spec.ClearField(customer.FieldCreditHoldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreditHoldReason(); ok {
+		_spec.SetField(customer.FieldCreditHoldReason, field.TypeString, value)
+	}
+	if _u.mutation.CreditHoldReasonCleared() {
+		_spec.ClearField(customer.FieldCreditHoldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreditHoldAt(); ok {
+		_spec.SetField(customer.FieldCreditHoldAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreditHoldAtCleared() {
+		_spec.ClearField(customer.FieldCreditHoldAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AuthUserID(); ok {
+		_spec.SetField(customer.FieldAuthUserID, field.TypeUUID, value)
+	}
+	if _u.mutation.AuthUserIDCleared() {
+		_spec.ClearField(customer.FieldAuthUserID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(customer.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(customer.FieldMetadata, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(customer.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Customer{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/customerstatement"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CustomerStatement is the model entity for the CustomerStatement schema.
+type CustomerStatement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Customer identifier
+	CustomerID uuid.UUID `json:"customer_id,omitempty"`
+	// ISO currency code
+	Currency string `json:"currency,omitempty"`
+	// First day of the statement period
+	PeriodStart time.Time `json:"period_start,omitempty"`
+	// Last day of the statement period
+	PeriodEnd time.Time `json:"period_end,omitempty"`
+	// OpeningBalance holds the value of the "opening_balance" field.
+	OpeningBalance decimal.Decimal `json:"opening_balance,omitempty"`
+	// ClosingBalance holds the value of the "closing_balance" field.
+	ClosingBalance decimal.Decimal `json:"closing_balance,omitempty"`
+	// Object storage key of the PDF
+	ObjectKey string `json:"object_key,omitempty"`
+	// Trigger: scheduled, manual
+	Trigger string `json:"trigger,omitempty"`
+	// GeneratedAt holds the value of the "generated_at" field.
+	GeneratedAt  time.Time `json:"generated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CustomerStatement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case customerstatement.FieldOpeningBalance, customerstatement.FieldClosingBalance:
+			values[i] = new(decimal.Decimal)
+		case customerstatement.FieldCurrency, customerstatement.FieldObjectKey, customerstatement.FieldTrigger:
+			values[i] = new(sql.NullString)
+		case customerstatement.FieldPeriodStart, customerstatement.FieldPeriodEnd, customerstatement.FieldGeneratedAt:
+			values[i] = new(sql.NullTime)
+		case customerstatement.FieldID, customerstatement.FieldTenantID, customerstatement.FieldCustomerID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CustomerStatement fields.
+func (_m *CustomerStatement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case customerstatement.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case customerstatement.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case customerstatement.FieldCustomerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value != nil {
+				_m.CustomerID = *value
+			}
+		case customerstatement.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case customerstatement.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				_m.PeriodStart = value.Time
+			}
+		case customerstatement.FieldPeriodEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_end", values[i])
+			} else if value.Valid {
+				_m.PeriodEnd = value.Time
+			}
+		case customerstatement.FieldOpeningBalance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field opening_balance", values[i])
+			} else if value != nil {
+				_m.OpeningBalance = *value
+			}
+		case customerstatement.FieldClosingBalance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field closing_balance", values[i])
+			} else if value != nil {
+				_m.ClosingBalance = *value
+			}
+		case customerstatement.FieldObjectKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field object_key", values[i])
+			} else if value.Valid {
+				_m.ObjectKey = value.String
+			}
+		case customerstatement.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				_m.Trigger = value.String
+			}
+		case customerstatement.FieldGeneratedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field generated_at", values[i])
+			} else if value.Valid {
+				_m.GeneratedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CustomerStatement.
+// This includes values selected through modifiers, order, etc.
+func (_m *CustomerStatement) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CustomerStatement.
+// Note that you need to call CustomerStatement.Unwrap() before calling this method if this CustomerStatement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CustomerStatement) Update() *CustomerStatementUpdateOne {
+	return NewCustomerStatementClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CustomerStatement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CustomerStatement) Unwrap() *CustomerStatement {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CustomerStatement is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CustomerStatement) String() string {
+	var builder strings.Builder
+	builder.WriteString("CustomerStatement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("customer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CustomerID))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("period_start=")
+	builder.WriteString(_m.PeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("period_end=")
+	builder.WriteString(_m.PeriodEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("opening_balance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OpeningBalance))
+	builder.WriteString(", ")
+	builder.WriteString("closing_balance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClosingBalance))
+	builder.WriteString(", ")
+	builder.WriteString("object_key=")
+	builder.WriteString(_m.ObjectKey)
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(_m.Trigger)
+	builder.WriteString(", ")
+	builder.WriteString("generated_at=")
+	builder.WriteString(_m.GeneratedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CustomerStatements is a parsable slice of CustomerStatement.
+type CustomerStatements []*CustomerStatement
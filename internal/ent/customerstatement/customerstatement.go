@@ -0,0 +1,131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package customerstatement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the customerstatement type in the database.
+	Label = "customer_statement"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCustomerID holds the string denoting the customer_id field in the database.
+	FieldCustomerID = "customer_id"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldPeriodStart holds the string denoting the period_start field in the database.
+	FieldPeriodStart = "period_start"
+	// FieldPeriodEnd holds the string denoting the period_end field in the database.
+	FieldPeriodEnd = "period_end"
+	// FieldOpeningBalance holds the string denoting the opening_balance field in the database.
+	FieldOpeningBalance = "opening_balance"
+	// FieldClosingBalance holds the string denoting the closing_balance field in the database.
+	FieldClosingBalance = "closing_balance"
+	// FieldObjectKey holds the string denoting the object_key field in the database.
+	FieldObjectKey = "object_key"
+	// FieldTrigger holds the string denoting the trigger field in the database.
+	FieldTrigger = "trigger"
+	// FieldGeneratedAt holds the string denoting the generated_at field in the database.
+	FieldGeneratedAt = "generated_at"
+	// Table holds the table name of the customerstatement in the database.
+	Table = "customer_statements"
+)
+
+// Columns holds all SQL columns for customerstatement fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCustomerID,
+	FieldCurrency,
+	FieldPeriodStart,
+	FieldPeriodEnd,
+	FieldOpeningBalance,
+	FieldClosingBalance,
+	FieldObjectKey,
+	FieldTrigger,
+	FieldGeneratedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTrigger holds the default value on creation for the "trigger" field.
+	DefaultTrigger string
+	// DefaultGeneratedAt holds the default value on creation for the "generated_at" field.
+	DefaultGeneratedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the CustomerStatement queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByCustomerID orders the results by the customer_id field.
+func ByCustomerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCustomerID, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByPeriodStart orders the results by the period_start field.
+func ByPeriodStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodStart, opts...).ToFunc()
+}
+
+// ByPeriodEnd orders the results by the period_end field.
+func ByPeriodEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodEnd, opts...).ToFunc()
+}
+
+// ByOpeningBalance orders the results by the opening_balance field.
+func ByOpeningBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpeningBalance, opts...).ToFunc()
+}
+
+// ByClosingBalance orders the results by the closing_balance field.
+func ByClosingBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosingBalance, opts...).ToFunc()
+}
+
+// ByObjectKey orders the results by the object_key field.
+func ByObjectKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObjectKey, opts...).ToFunc()
+}
+
+// ByTrigger orders the results by the trigger field.
+func ByTrigger(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrigger, opts...).ToFunc()
+}
+
+// ByGeneratedAt orders the results by the generated_at field.
+func ByGeneratedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGeneratedAt, opts...).ToFunc()
+}
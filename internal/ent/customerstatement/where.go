@@ -0,0 +1,597 @@
+// Code generated by ent, DO NOT EDIT.
+
+package customerstatement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldCurrency, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodEnd applies equality check predicate on the "period_end" field. It's identical to PeriodEndEQ.
+func PeriodEnd(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// OpeningBalance applies equality check predicate on the "opening_balance" field. It's identical to OpeningBalanceEQ.
+func OpeningBalance(v decimal.Decimal) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldOpeningBalance, v))
+}
+
+// ClosingBalance applies equality check predicate on the "closing_balance" field. It's identical to ClosingBalanceEQ.
+func ClosingBalance(v decimal.Decimal) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldClosingBalance, v))
+}
+
+// ObjectKey applies equality check predicate on the "object_key" field. It's identical to ObjectKeyEQ.
+func ObjectKey(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldObjectKey, v))
+}
+
+// Trigger applies equality check predicate on the "trigger" field. It's identical to TriggerEQ.
+func Trigger(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldTrigger, v))
+}
+
+// GeneratedAt applies equality check predicate on the "generated_at" field. It's identical to GeneratedAtEQ.
+func GeneratedAt(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldGeneratedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLTE(FieldTenantID, v))
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNEQ(FieldCustomerID, v))
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNotIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDGT applies the GT predicate on the "customer_id" field.
+func CustomerIDGT(v uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGT(FieldCustomerID, v))
+}
+
+// CustomerIDGTE applies the GTE predicate on the "customer_id" field.
+func CustomerIDGTE(v uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGTE(FieldCustomerID, v))
+}
+
+// CustomerIDLT applies the LT predicate on the "customer_id" field.
+func CustomerIDLT(v uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLT(FieldCustomerID, v))
+}
+
+// CustomerIDLTE applies the LTE predicate on the "customer_id" field.
+func CustomerIDLTE(v uuid.UUID) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLTE(FieldCustomerID, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// PeriodEndEQ applies the EQ predicate on the "period_end" field.
+func PeriodEndEQ(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndNEQ applies the NEQ predicate on the "period_end" field.
+func PeriodEndNEQ(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndIn applies the In predicate on the "period_end" field.
+func PeriodEndIn(vs ...time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndNotIn applies the NotIn predicate on the "period_end" field.
+func PeriodEndNotIn(vs ...time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNotIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndGT applies the GT predicate on the "period_end" field.
+func PeriodEndGT(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGT(FieldPeriodEnd, v))
+}
+
+// PeriodEndGTE applies the GTE predicate on the "period_end" field.
+func PeriodEndGTE(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndLT applies the LT predicate on the "period_end" field.
+func PeriodEndLT(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLT(FieldPeriodEnd, v))
+}
+
+// PeriodEndLTE applies the LTE predicate on the "period_end" field.
+func PeriodEndLTE(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLTE(FieldPeriodEnd, v))
+}
+
+// OpeningBalanceEQ applies the EQ predicate on the "opening_balance" field.
+func OpeningBalanceEQ(v decimal.Decimal) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceNEQ applies the NEQ predicate on the "opening_balance" field.
+func OpeningBalanceNEQ(v decimal.Decimal) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNEQ(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceIn applies the In predicate on the "opening_balance" field.
+func OpeningBalanceIn(vs ...decimal.Decimal) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldIn(FieldOpeningBalance, vs...))
+}
+
+// OpeningBalanceNotIn applies the NotIn predicate on the "opening_balance" field.
+func OpeningBalanceNotIn(vs ...decimal.Decimal) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNotIn(FieldOpeningBalance, vs...))
+}
+
+// OpeningBalanceGT applies the GT predicate on the "opening_balance" field.
+func OpeningBalanceGT(v decimal.Decimal) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGT(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceGTE applies the GTE predicate on the "opening_balance" field.
+func OpeningBalanceGTE(v decimal.Decimal) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGTE(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceLT applies the LT predicate on the "opening_balance" field.
+func OpeningBalanceLT(v decimal.Decimal) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLT(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceLTE applies the LTE predicate on the "opening_balance" field.
+func OpeningBalanceLTE(v decimal.Decimal) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLTE(FieldOpeningBalance, v))
+}
+
+// ClosingBalanceEQ applies the EQ predicate on the "closing_balance" field.
+func ClosingBalanceEQ(v decimal.Decimal) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldClosingBalance, v))
+}
+
+// ClosingBalanceNEQ applies the NEQ predicate on the "closing_balance" field.
+func ClosingBalanceNEQ(v decimal.Decimal) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNEQ(FieldClosingBalance, v))
+}
+
+// ClosingBalanceIn applies the In predicate on the "closing_balance" field.
+func ClosingBalanceIn(vs ...decimal.Decimal) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldIn(FieldClosingBalance, vs...))
+}
+
+// ClosingBalanceNotIn applies the NotIn predicate on the "closing_balance" field.
+func ClosingBalanceNotIn(vs ...decimal.Decimal) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNotIn(FieldClosingBalance, vs...))
+}
+
+// ClosingBalanceGT applies the GT predicate on the "closing_balance" field.
+func ClosingBalanceGT(v decimal.Decimal) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGT(FieldClosingBalance, v))
+}
+
+// ClosingBalanceGTE applies the GTE predicate on the "closing_balance" field.
+func ClosingBalanceGTE(v decimal.Decimal) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGTE(FieldClosingBalance, v))
+}
+
+// ClosingBalanceLT applies the LT predicate on the "closing_balance" field.
+func ClosingBalanceLT(v decimal.Decimal) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLT(FieldClosingBalance, v))
+}
+
+// ClosingBalanceLTE applies the LTE predicate on the "closing_balance" field.
+func ClosingBalanceLTE(v decimal.Decimal) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLTE(FieldClosingBalance, v))
+}
+
+// ObjectKeyEQ applies the EQ predicate on the "object_key" field.
+func ObjectKeyEQ(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldObjectKey, v))
+}
+
+// ObjectKeyNEQ applies the NEQ predicate on the "object_key" field.
+func ObjectKeyNEQ(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNEQ(FieldObjectKey, v))
+}
+
+// ObjectKeyIn applies the In predicate on the "object_key" field.
+func ObjectKeyIn(vs ...string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldIn(FieldObjectKey, vs...))
+}
+
+// ObjectKeyNotIn applies the NotIn predicate on the "object_key" field.
+func ObjectKeyNotIn(vs ...string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNotIn(FieldObjectKey, vs...))
+}
+
+// ObjectKeyGT applies the GT predicate on the "object_key" field.
+func ObjectKeyGT(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGT(FieldObjectKey, v))
+}
+
+// ObjectKeyGTE applies the GTE predicate on the "object_key" field.
+func ObjectKeyGTE(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGTE(FieldObjectKey, v))
+}
+
+// ObjectKeyLT applies the LT predicate on the "object_key" field.
+func ObjectKeyLT(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLT(FieldObjectKey, v))
+}
+
+// ObjectKeyLTE applies the LTE predicate on the "object_key" field.
+func ObjectKeyLTE(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLTE(FieldObjectKey, v))
+}
+
+// ObjectKeyContains applies the Contains predicate on the "object_key" field.
+func ObjectKeyContains(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldContains(FieldObjectKey, v))
+}
+
+// ObjectKeyHasPrefix applies the HasPrefix predicate on the "object_key" field.
+func ObjectKeyHasPrefix(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldHasPrefix(FieldObjectKey, v))
+}
+
+// ObjectKeyHasSuffix applies the HasSuffix predicate on the "object_key" field.
+func ObjectKeyHasSuffix(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldHasSuffix(FieldObjectKey, v))
+}
+
+// ObjectKeyEqualFold applies the EqualFold predicate on the "object_key" field.
+func ObjectKeyEqualFold(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEqualFold(FieldObjectKey, v))
+}
+
+// ObjectKeyContainsFold applies the ContainsFold predicate on the "object_key" field.
+func ObjectKeyContainsFold(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldContainsFold(FieldObjectKey, v))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// TriggerGT applies the GT predicate on the "trigger" field.
+func TriggerGT(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGT(FieldTrigger, v))
+}
+
+// TriggerGTE applies the GTE predicate on the "trigger" field.
+func TriggerGTE(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGTE(FieldTrigger, v))
+}
+
+// TriggerLT applies the LT predicate on the "trigger" field.
+func TriggerLT(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLT(FieldTrigger, v))
+}
+
+// TriggerLTE applies the LTE predicate on the "trigger" field.
+func TriggerLTE(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLTE(FieldTrigger, v))
+}
+
+// TriggerContains applies the Contains predicate on the "trigger" field.
+func TriggerContains(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldContains(FieldTrigger, v))
+}
+
+// TriggerHasPrefix applies the HasPrefix predicate on the "trigger" field.
+func TriggerHasPrefix(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldHasPrefix(FieldTrigger, v))
+}
+
+// TriggerHasSuffix applies the HasSuffix predicate on the "trigger" field.
+func TriggerHasSuffix(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldHasSuffix(FieldTrigger, v))
+}
+
+// TriggerEqualFold applies the EqualFold predicate on the "trigger" field.
+func TriggerEqualFold(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEqualFold(FieldTrigger, v))
+}
+
+// TriggerContainsFold applies the ContainsFold predicate on the "trigger" field.
+func TriggerContainsFold(v string) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldContainsFold(FieldTrigger, v))
+}
+
+// GeneratedAtEQ applies the EQ predicate on the "generated_at" field.
+func GeneratedAtEQ(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldEQ(FieldGeneratedAt, v))
+}
+
+// GeneratedAtNEQ applies the NEQ predicate on the "generated_at" field.
+func GeneratedAtNEQ(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNEQ(FieldGeneratedAt, v))
+}
+
+// GeneratedAtIn applies the In predicate on the "generated_at" field.
+func GeneratedAtIn(vs ...time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldIn(FieldGeneratedAt, vs...))
+}
+
+// GeneratedAtNotIn applies the NotIn predicate on the "generated_at" field.
+func GeneratedAtNotIn(vs ...time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldNotIn(FieldGeneratedAt, vs...))
+}
+
+// GeneratedAtGT applies the GT predicate on the "generated_at" field.
+func GeneratedAtGT(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGT(FieldGeneratedAt, v))
+}
+
+// GeneratedAtGTE applies the GTE predicate on the "generated_at" field.
+func GeneratedAtGTE(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldGTE(FieldGeneratedAt, v))
+}
+
+// GeneratedAtLT applies the LT predicate on the "generated_at" field.
+func GeneratedAtLT(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLT(FieldGeneratedAt, v))
+}
+
+// GeneratedAtLTE applies the LTE predicate on the "generated_at" field.
+func GeneratedAtLTE(v time.Time) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.FieldLTE(FieldGeneratedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CustomerStatement) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CustomerStatement) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CustomerStatement) predicate.CustomerStatement {
+	return predicate.CustomerStatement(sql.NotPredicates(p))
+}
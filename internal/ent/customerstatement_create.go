@@ -0,0 +1,1017 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/customerstatement"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CustomerStatementCreate is the builder for creating a CustomerStatement entity.
+type CustomerStatementCreate struct {
+	config
+	mutation *CustomerStatementMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *CustomerStatementCreate) SetTenantID(v uuid.UUID) *CustomerStatementCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_c *CustomerStatementCreate) SetCustomerID(v uuid.UUID) *CustomerStatementCreate {
+	_c.mutation.SetCustomerID(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *CustomerStatementCreate) SetCurrency(v string) *CustomerStatementCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_c *CustomerStatementCreate) SetPeriodStart(v time.Time) *CustomerStatementCreate {
+	_c.mutation.SetPeriodStart(v)
+	return _c
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_c *CustomerStatementCreate) SetPeriodEnd(v time.Time) *CustomerStatementCreate {
+	_c.mutation.SetPeriodEnd(v)
+	return _c
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (_c *CustomerStatementCreate) SetOpeningBalance(v decimal.Decimal) *CustomerStatementCreate {
+	_c.mutation.SetOpeningBalance(v)
+	return _c
+}
+
+// SetClosingBalance sets the "closing_balance" field.
+func (_c *CustomerStatementCreate) SetClosingBalance(v decimal.Decimal) *CustomerStatementCreate {
+	_c.mutation.SetClosingBalance(v)
+	return _c
+}
+
+// SetObjectKey sets the "object_key" field.
+func (_c *CustomerStatementCreate) SetObjectKey(v string) *CustomerStatementCreate {
+	_c.mutation.SetObjectKey(v)
+	return _c
+}
+
+// SetTrigger sets the "trigger" field.
+func (_c *CustomerStatementCreate) SetTrigger(v string) *CustomerStatementCreate {
+	_c.mutation.SetTrigger(v)
+	return _c
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_c *CustomerStatementCreate) SetNillableTrigger(v *string) *CustomerStatementCreate {
+	if v != nil {
+		_c.SetTrigger(*v)
+	}
+	return _c
+}
+
+// SetGeneratedAt sets the "generated_at" field.
+func (_c *CustomerStatementCreate) SetGeneratedAt(v time.Time) *CustomerStatementCreate {
+	_c.mutation.SetGeneratedAt(v)
+	return _c
+}
+
+// SetNillableGeneratedAt sets the "generated_at" field if the given value is not nil.
+func (_c *CustomerStatementCreate) SetNillableGeneratedAt(v *time.Time) *CustomerStatementCreate {
+	if v != nil {
+		_c.SetGeneratedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CustomerStatementCreate) SetID(v uuid.UUID) *CustomerStatementCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CustomerStatementCreate) SetNillableID(v *uuid.UUID) *CustomerStatementCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CustomerStatementMutation object of the builder.
+func (_c *CustomerStatementCreate) Mutation() *CustomerStatementMutation {
+	return _c.mutation
+}
+
+// Save creates the CustomerStatement in the database.
+func (_c *CustomerStatementCreate) Save(ctx context.Context) (*CustomerStatement, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CustomerStatementCreate) SaveX(ctx context.Context) *CustomerStatement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CustomerStatementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CustomerStatementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CustomerStatementCreate) defaults() {
+	if _, ok := _c.mutation.Trigger(); !ok {
+		v := customerstatement.DefaultTrigger
+		_c.mutation.SetTrigger(v)
+	}
+	if _, ok := _c.mutation.GeneratedAt(); !ok {
+		v := customerstatement.DefaultGeneratedAt()
+		_c.mutation.SetGeneratedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := customerstatement.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CustomerStatementCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "CustomerStatement.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CustomerID(); !ok {
+		return &ValidationError{Name: "customer_id", err: errors.New(`ent: missing required field "CustomerStatement.customer_id"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "CustomerStatement.currency"`)}
+	}
+	if _, ok := _c.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`ent: missing required field "CustomerStatement.period_start"`)}
+	}
+	if _, ok := _c.mutation.PeriodEnd(); !ok {
+		return &ValidationError{Name: "period_end", err: errors.New(`ent: missing required field "CustomerStatement.period_end"`)}
+	}
+	if _, ok := _c.mutation.OpeningBalance(); !ok {
+		return &ValidationError{Name: "opening_balance", err: errors.New(`ent: missing required field "CustomerStatement.opening_balance"`)}
+	}
+	if _, ok := _c.mutation.ClosingBalance(); !ok {
+		return &ValidationError{Name: "closing_balance", err: errors.New(`ent: missing required field "CustomerStatement.closing_balance"`)}
+	}
+	if _, ok := _c.mutation.ObjectKey(); !ok {
+		return &ValidationError{Name: "object_key", err: errors.New(`ent: missing required field "CustomerStatement.object_key"`)}
+	}
+	if _, ok := _c.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "CustomerStatement.trigger"`)}
+	}
+	if _, ok := _c.mutation.GeneratedAt(); !ok {
+		return &ValidationError{Name: "generated_at", err: errors.New(`ent: missing required field "CustomerStatement.generated_at"`)}
+	}
+	return nil
+}
+
+func (_c *CustomerStatementCreate) sqlSave(ctx context.Context) (*CustomerStatement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CustomerStatementCreate) createSpec() (*CustomerStatement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CustomerStatement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(customerstatement.Table, sqlgraph.NewFieldSpec(customerstatement.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(customerstatement.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CustomerID(); ok {
+		_spec.SetField(customerstatement.FieldCustomerID, field.TypeUUID, value)
+		_node.CustomerID = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(customerstatement.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.PeriodStart(); ok {
+		_spec.SetField(customerstatement.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := _c.mutation.PeriodEnd(); ok {
+		_spec.SetField(customerstatement.FieldPeriodEnd, field.TypeTime, value)
+		_node.PeriodEnd = value
+	}
+	if value, ok := _c.mutation.OpeningBalance(); ok {
+		_spec.SetField(customerstatement.FieldOpeningBalance, field.TypeFloat64, value)
+		_node.OpeningBalance = value
+	}
+	if value, ok := _c.mutation.ClosingBalance(); ok {
+		_spec.SetField(customerstatement.FieldClosingBalance, field.TypeFloat64, value)
+		_node.ClosingBalance = value
+	}
+	if value, ok := _c.mutation.ObjectKey(); ok {
+		_spec.SetField(customerstatement.FieldObjectKey, field.TypeString, value)
+		_node.ObjectKey = value
+	}
+	if value, ok := _c.mutation.Trigger(); ok {
+		_spec.SetField(customerstatement.FieldTrigger, field.TypeString, value)
+		_node.Trigger = value
+	}
+	if value, ok := _c.mutation.GeneratedAt(); ok {
+		_spec.SetField(customerstatement.FieldGeneratedAt, field.TypeTime, value)
+		_node.GeneratedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CustomerStatement.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CustomerStatementUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CustomerStatementCreate) OnConflict(opts ...sql.ConflictOption) *CustomerStatementUpsertOne {
+	_c.conflict = opts
+	return &CustomerStatementUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CustomerStatement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CustomerStatementCreate) OnConflictColumns(columns ...string) *CustomerStatementUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CustomerStatementUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CustomerStatementUpsertOne is the builder for "upsert"-ing
+	//  one CustomerStatement node.
+	CustomerStatementUpsertOne struct {
+		create *CustomerStatementCreate
+	}
+
+	// CustomerStatementUpsert is the "OnConflict" setter.
+	CustomerStatementUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *CustomerStatementUpsert) SetTenantID(v uuid.UUID) *CustomerStatementUpsert {
+	u.Set(customerstatement.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *CustomerStatementUpsert) UpdateTenantID() *CustomerStatementUpsert {
+	u.SetExcluded(customerstatement.FieldTenantID)
+	return u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *CustomerStatementUpsert) SetCustomerID(v uuid.UUID) *CustomerStatementUpsert {
+	u.Set(customerstatement.FieldCustomerID, v)
+	return u
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *CustomerStatementUpsert) UpdateCustomerID() *CustomerStatementUpsert {
+	u.SetExcluded(customerstatement.FieldCustomerID)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *CustomerStatementUpsert) SetCurrency(v string) *CustomerStatementUpsert {
+	u.Set(customerstatement.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *CustomerStatementUpsert) UpdateCurrency() *CustomerStatementUpsert {
+	u.SetExcluded(customerstatement.FieldCurrency)
+	return u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *CustomerStatementUpsert) SetPeriodStart(v time.Time) *CustomerStatementUpsert {
+	u.Set(customerstatement.FieldPeriodStart, v)
+	return u
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *CustomerStatementUpsert) UpdatePeriodStart() *CustomerStatementUpsert {
+	u.SetExcluded(customerstatement.FieldPeriodStart)
+	return u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *CustomerStatementUpsert) SetPeriodEnd(v time.Time) *CustomerStatementUpsert {
+	u.Set(customerstatement.FieldPeriodEnd, v)
+	return u
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *CustomerStatementUpsert) UpdatePeriodEnd() *CustomerStatementUpsert {
+	u.SetExcluded(customerstatement.FieldPeriodEnd)
+	return u
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (u *CustomerStatementUpsert) SetOpeningBalance(v decimal.Decimal) *CustomerStatementUpsert {
+	u.Set(customerstatement.FieldOpeningBalance, v)
+	return u
+}
+
+// UpdateOpeningBalance sets the "opening_balance" field to the value that was provided on create.
+func (u *CustomerStatementUpsert) UpdateOpeningBalance() *CustomerStatementUpsert {
+	u.SetExcluded(customerstatement.FieldOpeningBalance)
+	return u
+}
+
+// AddOpeningBalance adds v to the "opening_balance" field.
+func (u *CustomerStatementUpsert) AddOpeningBalance(v decimal.Decimal) *CustomerStatementUpsert {
+	u.Add(customerstatement.FieldOpeningBalance, v)
+	return u
+}
+
+// SetClosingBalance sets the "closing_balance" field.
+func (u *CustomerStatementUpsert) SetClosingBalance(v decimal.Decimal) *CustomerStatementUpsert {
+	u.Set(customerstatement.FieldClosingBalance, v)
+	return u
+}
+
+// UpdateClosingBalance sets the "closing_balance" field to the value that was provided on create.
+func (u *CustomerStatementUpsert) UpdateClosingBalance() *CustomerStatementUpsert {
+	u.SetExcluded(customerstatement.FieldClosingBalance)
+	return u
+}
+
+// AddClosingBalance adds v to the "closing_balance" field.
+func (u *CustomerStatementUpsert) AddClosingBalance(v decimal.Decimal) *CustomerStatementUpsert {
+	u.Add(customerstatement.FieldClosingBalance, v)
+	return u
+}
+
+// SetObjectKey sets the "object_key" field.
+func (u *CustomerStatementUpsert) SetObjectKey(v string) *CustomerStatementUpsert {
+	u.Set(customerstatement.FieldObjectKey, v)
+	return u
+}
+
+// UpdateObjectKey sets the "object_key" field to the value that was provided on create.
+func (u *CustomerStatementUpsert) UpdateObjectKey() *CustomerStatementUpsert {
+	u.SetExcluded(customerstatement.FieldObjectKey)
+	return u
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *CustomerStatementUpsert) SetTrigger(v string) *CustomerStatementUpsert {
+	u.Set(customerstatement.FieldTrigger, v)
+	return u
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *CustomerStatementUpsert) UpdateTrigger() *CustomerStatementUpsert {
+	u.SetExcluded(customerstatement.FieldTrigger)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CustomerStatement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(customerstatement.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CustomerStatementUpsertOne) UpdateNewValues() *CustomerStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(customerstatement.FieldID)
+		}
+		if _, exists := u.create.mutation.GeneratedAt(); exists {
+			s.SetIgnore(customerstatement.FieldGeneratedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CustomerStatement.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CustomerStatementUpsertOne) Ignore() *CustomerStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CustomerStatementUpsertOne) DoNothing() *CustomerStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CustomerStatementCreate.OnConflict
+// documentation for more info.
+func (u *CustomerStatementUpsertOne) Update(set func(*CustomerStatementUpsert)) *CustomerStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CustomerStatementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *CustomerStatementUpsertOne) SetTenantID(v uuid.UUID) *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *CustomerStatementUpsertOne) UpdateTenantID() *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *CustomerStatementUpsertOne) SetCustomerID(v uuid.UUID) *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.SetCustomerID(v)
+	})
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *CustomerStatementUpsertOne) UpdateCustomerID() *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.UpdateCustomerID()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *CustomerStatementUpsertOne) SetCurrency(v string) *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *CustomerStatementUpsertOne) UpdateCurrency() *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *CustomerStatementUpsertOne) SetPeriodStart(v time.Time) *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *CustomerStatementUpsertOne) UpdatePeriodStart() *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *CustomerStatementUpsertOne) SetPeriodEnd(v time.Time) *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.SetPeriodEnd(v)
+	})
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *CustomerStatementUpsertOne) UpdatePeriodEnd() *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.UpdatePeriodEnd()
+	})
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (u *CustomerStatementUpsertOne) SetOpeningBalance(v decimal.Decimal) *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.SetOpeningBalance(v)
+	})
+}
+
+// AddOpeningBalance adds v to the "opening_balance" field.
+func (u *CustomerStatementUpsertOne) AddOpeningBalance(v decimal.Decimal) *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.AddOpeningBalance(v)
+	})
+}
+
+// UpdateOpeningBalance sets the "opening_balance" field to the value that was provided on create.
+func (u *CustomerStatementUpsertOne) UpdateOpeningBalance() *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.UpdateOpeningBalance()
+	})
+}
+
+// SetClosingBalance sets the "closing_balance" field.
+func (u *CustomerStatementUpsertOne) SetClosingBalance(v decimal.Decimal) *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.SetClosingBalance(v)
+	})
+}
+
+// AddClosingBalance adds v to the "closing_balance" field.
+func (u *CustomerStatementUpsertOne) AddClosingBalance(v decimal.Decimal) *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.AddClosingBalance(v)
+	})
+}
+
+// UpdateClosingBalance sets the "closing_balance" field to the value that was provided on create.
+func (u *CustomerStatementUpsertOne) UpdateClosingBalance() *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.UpdateClosingBalance()
+	})
+}
+
+// SetObjectKey sets the "object_key" field.
+func (u *CustomerStatementUpsertOne) SetObjectKey(v string) *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.SetObjectKey(v)
+	})
+}
+
+// UpdateObjectKey sets the "object_key" field to the value that was provided on create.
+func (u *CustomerStatementUpsertOne) UpdateObjectKey() *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.UpdateObjectKey()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *CustomerStatementUpsertOne) SetTrigger(v string) *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *CustomerStatementUpsertOne) UpdateTrigger() *CustomerStatementUpsertOne {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// Exec executes the query.
+func (u *CustomerStatementUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CustomerStatementCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CustomerStatementUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CustomerStatementUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CustomerStatementUpsertOne.ID is not supported by MySQL driver. Use CustomerStatementUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CustomerStatementUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CustomerStatementCreateBulk is the builder for creating many CustomerStatement entities in bulk.
+type CustomerStatementCreateBulk struct {
+	config
+	err      error
+	builders []*CustomerStatementCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CustomerStatement entities in the database.
+func (_c *CustomerStatementCreateBulk) Save(ctx context.Context) ([]*CustomerStatement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CustomerStatement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CustomerStatementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CustomerStatementCreateBulk) SaveX(ctx context.Context) []*CustomerStatement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CustomerStatementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CustomerStatementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CustomerStatement.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CustomerStatementUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CustomerStatementCreateBulk) OnConflict(opts ...sql.ConflictOption) *CustomerStatementUpsertBulk {
+	_c.conflict = opts
+	return &CustomerStatementUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CustomerStatement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CustomerStatementCreateBulk) OnConflictColumns(columns ...string) *CustomerStatementUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CustomerStatementUpsertBulk{
+		create: _c,
+	}
+}
+
+// CustomerStatementUpsertBulk is the builder for "upsert"-ing
+// a bulk of CustomerStatement nodes.
+type CustomerStatementUpsertBulk struct {
+	create *CustomerStatementCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CustomerStatement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(customerstatement.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CustomerStatementUpsertBulk) UpdateNewValues() *CustomerStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(customerstatement.FieldID)
+			}
+			if _, exists := b.mutation.GeneratedAt(); exists {
+				s.SetIgnore(customerstatement.FieldGeneratedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CustomerStatement.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CustomerStatementUpsertBulk) Ignore() *CustomerStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CustomerStatementUpsertBulk) DoNothing() *CustomerStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CustomerStatementCreateBulk.OnConflict
+// documentation for more info.
+func (u *CustomerStatementUpsertBulk) Update(set func(*CustomerStatementUpsert)) *CustomerStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CustomerStatementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *CustomerStatementUpsertBulk) SetTenantID(v uuid.UUID) *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *CustomerStatementUpsertBulk) UpdateTenantID() *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *CustomerStatementUpsertBulk) SetCustomerID(v uuid.UUID) *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.SetCustomerID(v)
+	})
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *CustomerStatementUpsertBulk) UpdateCustomerID() *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.UpdateCustomerID()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *CustomerStatementUpsertBulk) SetCurrency(v string) *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *CustomerStatementUpsertBulk) UpdateCurrency() *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *CustomerStatementUpsertBulk) SetPeriodStart(v time.Time) *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *CustomerStatementUpsertBulk) UpdatePeriodStart() *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *CustomerStatementUpsertBulk) SetPeriodEnd(v time.Time) *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.SetPeriodEnd(v)
+	})
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *CustomerStatementUpsertBulk) UpdatePeriodEnd() *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.UpdatePeriodEnd()
+	})
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (u *CustomerStatementUpsertBulk) SetOpeningBalance(v decimal.Decimal) *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.SetOpeningBalance(v)
+	})
+}
+
+// AddOpeningBalance adds v to the "opening_balance" field.
+func (u *CustomerStatementUpsertBulk) AddOpeningBalance(v decimal.Decimal) *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.AddOpeningBalance(v)
+	})
+}
+
+// UpdateOpeningBalance sets the "opening_balance" field to the value that was provided on create.
+func (u *CustomerStatementUpsertBulk) UpdateOpeningBalance() *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.UpdateOpeningBalance()
+	})
+}
+
+// SetClosingBalance sets the "closing_balance" field.
+func (u *CustomerStatementUpsertBulk) SetClosingBalance(v decimal.Decimal) *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.SetClosingBalance(v)
+	})
+}
+
+// AddClosingBalance adds v to the "closing_balance" field.
+func (u *CustomerStatementUpsertBulk) AddClosingBalance(v decimal.Decimal) *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.AddClosingBalance(v)
+	})
+}
+
+// UpdateClosingBalance sets the "closing_balance" field to the value that was provided on create.
+func (u *CustomerStatementUpsertBulk) UpdateClosingBalance() *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.UpdateClosingBalance()
+	})
+}
+
+// SetObjectKey sets the "object_key" field.
+func (u *CustomerStatementUpsertBulk) SetObjectKey(v string) *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.SetObjectKey(v)
+	})
+}
+
+// UpdateObjectKey sets the "object_key" field to the value that was provided on create.
+func (u *CustomerStatementUpsertBulk) UpdateObjectKey() *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.UpdateObjectKey()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *CustomerStatementUpsertBulk) SetTrigger(v string) *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *CustomerStatementUpsertBulk) UpdateTrigger() *CustomerStatementUpsertBulk {
+	return u.Update(func(s *CustomerStatementUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// Exec executes the query.
+func (u *CustomerStatementUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CustomerStatementCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CustomerStatementCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CustomerStatementUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
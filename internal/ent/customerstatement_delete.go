@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/customerstatement"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+)
+
+// CustomerStatementDelete is the builder for deleting a CustomerStatement entity.
+type CustomerStatementDelete struct {
+	config
+	hooks    []Hook
+	mutation *CustomerStatementMutation
+}
+
+// Where appends a list predicates to the CustomerStatementDelete builder.
+func (_d *CustomerStatementDelete) Where(ps ...predicate.CustomerStatement) *CustomerStatementDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CustomerStatementDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CustomerStatementDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CustomerStatementDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(customerstatement.Table, sqlgraph.NewFieldSpec(customerstatement.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CustomerStatementDeleteOne is the builder for deleting a single CustomerStatement entity.
+type CustomerStatementDeleteOne struct {
+	_d *CustomerStatementDelete
+}
+
+// Where appends a list predicates to the CustomerStatementDelete builder.
+func (_d *CustomerStatementDeleteOne) Where(ps ...predicate.CustomerStatement) *CustomerStatementDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CustomerStatementDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{customerstatement.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CustomerStatementDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,524 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/customerstatement"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CustomerStatementUpdate is the builder for updating CustomerStatement entities.
+type CustomerStatementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CustomerStatementMutation
+}
+
+// Where appends a list predicates to the CustomerStatementUpdate builder.
+func (_u *CustomerStatementUpdate) Where(ps ...predicate.CustomerStatement) *CustomerStatementUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *CustomerStatementUpdate) SetTenantID(v uuid.UUID) *CustomerStatementUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *CustomerStatementUpdate) SetNillableTenantID(v *uuid.UUID) *CustomerStatementUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *CustomerStatementUpdate) SetCustomerID(v uuid.UUID) *CustomerStatementUpdate {
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *CustomerStatementUpdate) SetNillableCustomerID(v *uuid.UUID) *CustomerStatementUpdate {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *CustomerStatementUpdate) SetCurrency(v string) *CustomerStatementUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *CustomerStatementUpdate) SetNillableCurrency(v *string) *CustomerStatementUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *CustomerStatementUpdate) SetPeriodStart(v time.Time) *CustomerStatementUpdate {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *CustomerStatementUpdate) SetNillablePeriodStart(v *time.Time) *CustomerStatementUpdate {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_u *CustomerStatementUpdate) SetPeriodEnd(v time.Time) *CustomerStatementUpdate {
+	_u.mutation.SetPeriodEnd(v)
+	return _u
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (_u *CustomerStatementUpdate) SetNillablePeriodEnd(v *time.Time) *CustomerStatementUpdate {
+	if v != nil {
+		_u.SetPeriodEnd(*v)
+	}
+	return _u
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (_u *CustomerStatementUpdate) SetOpeningBalance(v decimal.Decimal) *CustomerStatementUpdate {
+	_u.mutation.ResetOpeningBalance()
+	_u.mutation.SetOpeningBalance(v)
+	return _u
+}
+
+// SetNillableOpeningBalance sets the "opening_balance" field if the given value is not nil.
+func (_u *CustomerStatementUpdate) SetNillableOpeningBalance(v *decimal.Decimal) *CustomerStatementUpdate {
+	if v != nil {
+		_u.SetOpeningBalance(*v)
+	}
+	return _u
+}
+
+// AddOpeningBalance adds value to the "opening_balance" field.
+func (_u *CustomerStatementUpdate) AddOpeningBalance(v decimal.Decimal) *CustomerStatementUpdate {
+	_u.mutation.AddOpeningBalance(v)
+	return _u
+}
+
+// SetClosingBalance sets the "closing_balance" field.
+func (_u *CustomerStatementUpdate) SetClosingBalance(v decimal.Decimal) *CustomerStatementUpdate {
+	_u.mutation.ResetClosingBalance()
+	_u.mutation.SetClosingBalance(v)
+	return _u
+}
+
+// SetNillableClosingBalance sets the "closing_balance" field if the given value is not nil.
+func (_u *CustomerStatementUpdate) SetNillableClosingBalance(v *decimal.Decimal) *CustomerStatementUpdate {
+	if v != nil {
+		_u.SetClosingBalance(*v)
+	}
+	return _u
+}
+
+// AddClosingBalance adds value to the "closing_balance" field.
+func (_u *CustomerStatementUpdate) AddClosingBalance(v decimal.Decimal) *CustomerStatementUpdate {
+	_u.mutation.AddClosingBalance(v)
+	return _u
+}
+
+// SetObjectKey sets the "object_key" field.
+func (_u *CustomerStatementUpdate) SetObjectKey(v string) *CustomerStatementUpdate {
+	_u.mutation.SetObjectKey(v)
+	return _u
+}
+
+// SetNillableObjectKey sets the "object_key" field if the given value is not nil.
+func (_u *CustomerStatementUpdate) SetNillableObjectKey(v *string) *CustomerStatementUpdate {
+	if v != nil {
+		_u.SetObjectKey(*v)
+	}
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *CustomerStatementUpdate) SetTrigger(v string) *CustomerStatementUpdate {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *CustomerStatementUpdate) SetNillableTrigger(v *string) *CustomerStatementUpdate {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CustomerStatementMutation object of the builder.
+func (_u *CustomerStatementUpdate) Mutation() *CustomerStatementMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CustomerStatementUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CustomerStatementUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CustomerStatementUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CustomerStatementUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CustomerStatementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(customerstatement.Table, customerstatement.Columns, sqlgraph.NewFieldSpec(customerstatement.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(customerstatement.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(customerstatement.FieldCustomerID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(customerstatement.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(customerstatement.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PeriodEnd(); ok {
+		_spec.SetField(customerstatement.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.OpeningBalance(); ok {
+		_spec.SetField(customerstatement.FieldOpeningBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOpeningBalance(); ok {
+		_spec.AddField(customerstatement.FieldOpeningBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ClosingBalance(); ok {
+		_spec.SetField(customerstatement.FieldClosingBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedClosingBalance(); ok {
+		_spec.AddField(customerstatement.FieldClosingBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ObjectKey(); ok {
+		_spec.SetField(customerstatement.FieldObjectKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(customerstatement.FieldTrigger, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customerstatement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CustomerStatementUpdateOne is the builder for updating a single CustomerStatement entity.
+type CustomerStatementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CustomerStatementMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *CustomerStatementUpdateOne) SetTenantID(v uuid.UUID) *CustomerStatementUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *CustomerStatementUpdateOne) SetNillableTenantID(v *uuid.UUID) *CustomerStatementUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *CustomerStatementUpdateOne) SetCustomerID(v uuid.UUID) *CustomerStatementUpdateOne {
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *CustomerStatementUpdateOne) SetNillableCustomerID(v *uuid.UUID) *CustomerStatementUpdateOne {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *CustomerStatementUpdateOne) SetCurrency(v string) *CustomerStatementUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *CustomerStatementUpdateOne) SetNillableCurrency(v *string) *CustomerStatementUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *CustomerStatementUpdateOne) SetPeriodStart(v time.Time) *CustomerStatementUpdateOne {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *CustomerStatementUpdateOne) SetNillablePeriodStart(v *time.Time) *CustomerStatementUpdateOne {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_u *CustomerStatementUpdateOne) SetPeriodEnd(v time.Time) *CustomerStatementUpdateOne {
+	_u.mutation.SetPeriodEnd(v)
+	return _u
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (_u *CustomerStatementUpdateOne) SetNillablePeriodEnd(v *time.Time) *CustomerStatementUpdateOne {
+	if v != nil {
+		_u.SetPeriodEnd(*v)
+	}
+	return _u
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (_u *CustomerStatementUpdateOne) SetOpeningBalance(v decimal.Decimal) *CustomerStatementUpdateOne {
+	_u.mutation.ResetOpeningBalance()
+	_u.mutation.SetOpeningBalance(v)
+	return _u
+}
+
+// SetNillableOpeningBalance sets the "opening_balance" field if the given value is not nil.
+func (_u *CustomerStatementUpdateOne) SetNillableOpeningBalance(v *decimal.Decimal) *CustomerStatementUpdateOne {
+	if v != nil {
+		_u.SetOpeningBalance(*v)
+	}
+	return _u
+}
+
+// AddOpeningBalance adds value to the "opening_balance" field.
+func (_u *CustomerStatementUpdateOne) AddOpeningBalance(v decimal.Decimal) *CustomerStatementUpdateOne {
+	_u.mutation.AddOpeningBalance(v)
+	return _u
+}
+
+// SetClosingBalance sets the "closing_balance" field.
+func (_u *CustomerStatementUpdateOne) SetClosingBalance(v decimal.Decimal) *CustomerStatementUpdateOne {
+	_u.mutation.ResetClosingBalance()
+	_u.mutation.SetClosingBalance(v)
+	return _u
+}
+
+// SetNillableClosingBalance sets the "closing_balance" field if the given value is not nil.
+func (_u *CustomerStatementUpdateOne) SetNillableClosingBalance(v *decimal.Decimal) *CustomerStatementUpdateOne {
+	if v != nil {
+		_u.SetClosingBalance(*v)
+	}
+	return _u
+}
+
+// AddClosingBalance adds value to the "closing_balance" field.
+func (_u *CustomerStatementUpdateOne) AddClosingBalance(v decimal.Decimal) *CustomerStatementUpdateOne {
+	_u.mutation.AddClosingBalance(v)
+	return _u
+}
+
+// SetObjectKey sets the "object_key" field.
+func (_u *CustomerStatementUpdateOne) SetObjectKey(v string) *CustomerStatementUpdateOne {
+	_u.mutation.SetObjectKey(v)
+	return _u
+}
+
+// SetNillableObjectKey sets the "object_key" field if the given value is not nil.
+func (_u *CustomerStatementUpdateOne) SetNillableObjectKey(v *string) *CustomerStatementUpdateOne {
+	if v != nil {
+		_u.SetObjectKey(*v)
+	}
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *CustomerStatementUpdateOne) SetTrigger(v string) *CustomerStatementUpdateOne {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *CustomerStatementUpdateOne) SetNillableTrigger(v *string) *CustomerStatementUpdateOne {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CustomerStatementMutation object of the builder.
+func (_u *CustomerStatementUpdateOne) Mutation() *CustomerStatementMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CustomerStatementUpdate builder.
+func (_u *CustomerStatementUpdateOne) Where(ps ...predicate.CustomerStatement) *CustomerStatementUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CustomerStatementUpdateOne) Select(field string, fields ...string) *CustomerStatementUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CustomerStatement entity.
+func (_u *CustomerStatementUpdateOne) Save(ctx context.Context) (*CustomerStatement, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CustomerStatementUpdateOne) SaveX(ctx context.Context) *CustomerStatement {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CustomerStatementUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CustomerStatementUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CustomerStatementUpdateOne) sqlSave(ctx context.Context) (_node *CustomerStatement, err error) {
+	_spec := sqlgraph.NewUpdateSpec(customerstatement.Table, customerstatement.Columns, sqlgraph.NewFieldSpec(customerstatement.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CustomerStatement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customerstatement.FieldID)
+		for _, f := range fields {
+			if !customerstatement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != customerstatement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(customerstatement.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(customerstatement.FieldCustomerID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(customerstatement.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(customerstatement.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PeriodEnd(); ok {
+		_spec.SetField(customerstatement.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.OpeningBalance(); ok {
+		_spec.SetField(customerstatement.FieldOpeningBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOpeningBalance(); ok {
+		_spec.AddField(customerstatement.FieldOpeningBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ClosingBalance(); ok {
+		_spec.SetField(customerstatement.FieldClosingBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedClosingBalance(); ok {
+		_spec.AddField(customerstatement.FieldClosingBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ObjectKey(); ok {
+		_spec.SetField(customerstatement.FieldObjectKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(customerstatement.FieldTrigger, field.TypeString, value)
+	}
+	_node = &CustomerStatement{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customerstatement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
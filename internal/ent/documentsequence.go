@@ -0,0 +1,142 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/documentsequence"
+	"github.com/google/uuid"
+)
+
+// DocumentSequence is the model entity for the DocumentSequence schema.
+type DocumentSequence struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Sequence name (invoice, bill, statement)
+	Name string `json:"name,omitempty"`
+	// Last number handed out
+	LastValue int64 `json:"last_value,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DocumentSequence) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case documentsequence.FieldLastValue:
+			values[i] = new(sql.NullInt64)
+		case documentsequence.FieldName:
+			values[i] = new(sql.NullString)
+		case documentsequence.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case documentsequence.FieldID, documentsequence.FieldTenantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DocumentSequence fields.
+func (_m *DocumentSequence) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case documentsequence.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case documentsequence.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case documentsequence.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case documentsequence.FieldLastValue:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_value", values[i])
+			} else if value.Valid {
+				_m.LastValue = value.Int64
+			}
+		case documentsequence.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DocumentSequence.
+// This includes values selected through modifiers, order, etc.
+func (_m *DocumentSequence) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DocumentSequence.
+// Note that you need to call DocumentSequence.Unwrap() before calling this method if this DocumentSequence
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DocumentSequence) Update() *DocumentSequenceUpdateOne {
+	return NewDocumentSequenceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DocumentSequence entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DocumentSequence) Unwrap() *DocumentSequence {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DocumentSequence is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DocumentSequence) String() string {
+	var builder strings.Builder
+	builder.WriteString("DocumentSequence(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("last_value=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastValue))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DocumentSequences is a parsable slice of DocumentSequence.
+type DocumentSequences []*DocumentSequence
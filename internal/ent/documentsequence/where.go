@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package documentsequence
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldEQ(FieldName, v))
+}
+
+// LastValue applies equality check predicate on the "last_value" field. It's identical to LastValueEQ.
+func LastValue(v int64) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldEQ(FieldLastValue, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldLTE(FieldTenantID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldContainsFold(FieldName, v))
+}
+
+// LastValueEQ applies the EQ predicate on the "last_value" field.
+func LastValueEQ(v int64) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldEQ(FieldLastValue, v))
+}
+
+// LastValueNEQ applies the NEQ predicate on the "last_value" field.
+func LastValueNEQ(v int64) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldNEQ(FieldLastValue, v))
+}
+
+// LastValueIn applies the In predicate on the "last_value" field.
+func LastValueIn(vs ...int64) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldIn(FieldLastValue, vs...))
+}
+
+// LastValueNotIn applies the NotIn predicate on the "last_value" field.
+func LastValueNotIn(vs ...int64) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldNotIn(FieldLastValue, vs...))
+}
+
+// LastValueGT applies the GT predicate on the "last_value" field.
+func LastValueGT(v int64) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldGT(FieldLastValue, v))
+}
+
+// LastValueGTE applies the GTE predicate on the "last_value" field.
+func LastValueGTE(v int64) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldGTE(FieldLastValue, v))
+}
+
+// LastValueLT applies the LT predicate on the "last_value" field.
+func LastValueLT(v int64) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldLT(FieldLastValue, v))
+}
+
+// LastValueLTE applies the LTE predicate on the "last_value" field.
+func LastValueLTE(v int64) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldLTE(FieldLastValue, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DocumentSequence) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DocumentSequence) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DocumentSequence) predicate.DocumentSequence {
+	return predicate.DocumentSequence(sql.NotPredicates(p))
+}
@@ -0,0 +1,717 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/documentsequence"
+	"github.com/google/uuid"
+)
+
+// DocumentSequenceCreate is the builder for creating a DocumentSequence entity.
+type DocumentSequenceCreate struct {
+	config
+	mutation *DocumentSequenceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *DocumentSequenceCreate) SetTenantID(v uuid.UUID) *DocumentSequenceCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *DocumentSequenceCreate) SetName(v string) *DocumentSequenceCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetLastValue sets the "last_value" field.
+func (_c *DocumentSequenceCreate) SetLastValue(v int64) *DocumentSequenceCreate {
+	_c.mutation.SetLastValue(v)
+	return _c
+}
+
+// SetNillableLastValue sets the "last_value" field if the given value is not nil.
+func (_c *DocumentSequenceCreate) SetNillableLastValue(v *int64) *DocumentSequenceCreate {
+	if v != nil {
+		_c.SetLastValue(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DocumentSequenceCreate) SetUpdatedAt(v time.Time) *DocumentSequenceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DocumentSequenceCreate) SetNillableUpdatedAt(v *time.Time) *DocumentSequenceCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DocumentSequenceCreate) SetID(v uuid.UUID) *DocumentSequenceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DocumentSequenceCreate) SetNillableID(v *uuid.UUID) *DocumentSequenceCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DocumentSequenceMutation object of the builder.
+func (_c *DocumentSequenceCreate) Mutation() *DocumentSequenceMutation {
+	return _c.mutation
+}
+
+// Save creates the DocumentSequence in the database.
+func (_c *DocumentSequenceCreate) Save(ctx context.Context) (*DocumentSequence, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DocumentSequenceCreate) SaveX(ctx context.Context) *DocumentSequence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DocumentSequenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DocumentSequenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DocumentSequenceCreate) defaults() {
+	if _, ok := _c.mutation.LastValue(); !ok {
+		v := documentsequence.DefaultLastValue
+		_c.mutation.SetLastValue(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := documentsequence.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := documentsequence.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DocumentSequenceCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "DocumentSequence.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "DocumentSequence.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := documentsequence.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DocumentSequence.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LastValue(); !ok {
+		return &ValidationError{Name: "last_value", err: errors.New(`ent: missing required field "DocumentSequence.last_value"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DocumentSequence.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *DocumentSequenceCreate) sqlSave(ctx context.Context) (*DocumentSequence, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DocumentSequenceCreate) createSpec() (*DocumentSequence, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DocumentSequence{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(documentsequence.Table, sqlgraph.NewFieldSpec(documentsequence.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(documentsequence.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(documentsequence.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.LastValue(); ok {
+		_spec.SetField(documentsequence.FieldLastValue, field.TypeInt64, value)
+		_node.LastValue = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(documentsequence.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DocumentSequence.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DocumentSequenceUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DocumentSequenceCreate) OnConflict(opts ...sql.ConflictOption) *DocumentSequenceUpsertOne {
+	_c.conflict = opts
+	return &DocumentSequenceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DocumentSequence.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DocumentSequenceCreate) OnConflictColumns(columns ...string) *DocumentSequenceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DocumentSequenceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DocumentSequenceUpsertOne is the builder for "upsert"-ing
+	//  one DocumentSequence node.
+	DocumentSequenceUpsertOne struct {
+		create *DocumentSequenceCreate
+	}
+
+	// DocumentSequenceUpsert is the "OnConflict" setter.
+	DocumentSequenceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *DocumentSequenceUpsert) SetTenantID(v uuid.UUID) *DocumentSequenceUpsert {
+	u.Set(documentsequence.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *DocumentSequenceUpsert) UpdateTenantID() *DocumentSequenceUpsert {
+	u.SetExcluded(documentsequence.FieldTenantID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *DocumentSequenceUpsert) SetName(v string) *DocumentSequenceUpsert {
+	u.Set(documentsequence.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DocumentSequenceUpsert) UpdateName() *DocumentSequenceUpsert {
+	u.SetExcluded(documentsequence.FieldName)
+	return u
+}
+
+// SetLastValue sets the "last_value" field.
+func (u *DocumentSequenceUpsert) SetLastValue(v int64) *DocumentSequenceUpsert {
+	u.Set(documentsequence.FieldLastValue, v)
+	return u
+}
+
+// UpdateLastValue sets the "last_value" field to the value that was provided on create.
+func (u *DocumentSequenceUpsert) UpdateLastValue() *DocumentSequenceUpsert {
+	u.SetExcluded(documentsequence.FieldLastValue)
+	return u
+}
+
+// AddLastValue adds v to the "last_value" field.
+func (u *DocumentSequenceUpsert) AddLastValue(v int64) *DocumentSequenceUpsert {
+	u.Add(documentsequence.FieldLastValue, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DocumentSequenceUpsert) SetUpdatedAt(v time.Time) *DocumentSequenceUpsert {
+	u.Set(documentsequence.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DocumentSequenceUpsert) UpdateUpdatedAt() *DocumentSequenceUpsert {
+	u.SetExcluded(documentsequence.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DocumentSequence.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(documentsequence.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DocumentSequenceUpsertOne) UpdateNewValues() *DocumentSequenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(documentsequence.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DocumentSequence.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DocumentSequenceUpsertOne) Ignore() *DocumentSequenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DocumentSequenceUpsertOne) DoNothing() *DocumentSequenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DocumentSequenceCreate.OnConflict
+// documentation for more info.
+func (u *DocumentSequenceUpsertOne) Update(set func(*DocumentSequenceUpsert)) *DocumentSequenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DocumentSequenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *DocumentSequenceUpsertOne) SetTenantID(v uuid.UUID) *DocumentSequenceUpsertOne {
+	return u.Update(func(s *DocumentSequenceUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *DocumentSequenceUpsertOne) UpdateTenantID() *DocumentSequenceUpsertOne {
+	return u.Update(func(s *DocumentSequenceUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *DocumentSequenceUpsertOne) SetName(v string) *DocumentSequenceUpsertOne {
+	return u.Update(func(s *DocumentSequenceUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DocumentSequenceUpsertOne) UpdateName() *DocumentSequenceUpsertOne {
+	return u.Update(func(s *DocumentSequenceUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetLastValue sets the "last_value" field.
+func (u *DocumentSequenceUpsertOne) SetLastValue(v int64) *DocumentSequenceUpsertOne {
+	return u.Update(func(s *DocumentSequenceUpsert) {
+		s.SetLastValue(v)
+	})
+}
+
+// AddLastValue adds v to the "last_value" field.
+func (u *DocumentSequenceUpsertOne) AddLastValue(v int64) *DocumentSequenceUpsertOne {
+	return u.Update(func(s *DocumentSequenceUpsert) {
+		s.AddLastValue(v)
+	})
+}
+
+// UpdateLastValue sets the "last_value" field to the value that was provided on create.
+func (u *DocumentSequenceUpsertOne) UpdateLastValue() *DocumentSequenceUpsertOne {
+	return u.Update(func(s *DocumentSequenceUpsert) {
+		s.UpdateLastValue()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DocumentSequenceUpsertOne) SetUpdatedAt(v time.Time) *DocumentSequenceUpsertOne {
+	return u.Update(func(s *DocumentSequenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DocumentSequenceUpsertOne) UpdateUpdatedAt() *DocumentSequenceUpsertOne {
+	return u.Update(func(s *DocumentSequenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DocumentSequenceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DocumentSequenceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DocumentSequenceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DocumentSequenceUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DocumentSequenceUpsertOne.ID is not supported by MySQL driver. Use DocumentSequenceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DocumentSequenceUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DocumentSequenceCreateBulk is the builder for creating many DocumentSequence entities in bulk.
+type DocumentSequenceCreateBulk struct {
+	config
+	err      error
+	builders []*DocumentSequenceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DocumentSequence entities in the database.
+func (_c *DocumentSequenceCreateBulk) Save(ctx context.Context) ([]*DocumentSequence, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DocumentSequence, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DocumentSequenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DocumentSequenceCreateBulk) SaveX(ctx context.Context) []*DocumentSequence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DocumentSequenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DocumentSequenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DocumentSequence.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DocumentSequenceUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DocumentSequenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *DocumentSequenceUpsertBulk {
+	_c.conflict = opts
+	return &DocumentSequenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DocumentSequence.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DocumentSequenceCreateBulk) OnConflictColumns(columns ...string) *DocumentSequenceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DocumentSequenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// DocumentSequenceUpsertBulk is the builder for "upsert"-ing
+// a bulk of DocumentSequence nodes.
+type DocumentSequenceUpsertBulk struct {
+	create *DocumentSequenceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DocumentSequence.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(documentsequence.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DocumentSequenceUpsertBulk) UpdateNewValues() *DocumentSequenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(documentsequence.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DocumentSequence.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DocumentSequenceUpsertBulk) Ignore() *DocumentSequenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DocumentSequenceUpsertBulk) DoNothing() *DocumentSequenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DocumentSequenceCreateBulk.OnConflict
+// documentation for more info.
+func (u *DocumentSequenceUpsertBulk) Update(set func(*DocumentSequenceUpsert)) *DocumentSequenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DocumentSequenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *DocumentSequenceUpsertBulk) SetTenantID(v uuid.UUID) *DocumentSequenceUpsertBulk {
+	return u.Update(func(s *DocumentSequenceUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *DocumentSequenceUpsertBulk) UpdateTenantID() *DocumentSequenceUpsertBulk {
+	return u.Update(func(s *DocumentSequenceUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *DocumentSequenceUpsertBulk) SetName(v string) *DocumentSequenceUpsertBulk {
+	return u.Update(func(s *DocumentSequenceUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DocumentSequenceUpsertBulk) UpdateName() *DocumentSequenceUpsertBulk {
+	return u.Update(func(s *DocumentSequenceUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetLastValue sets the "last_value" field.
+func (u *DocumentSequenceUpsertBulk) SetLastValue(v int64) *DocumentSequenceUpsertBulk {
+	return u.Update(func(s *DocumentSequenceUpsert) {
+		s.SetLastValue(v)
+	})
+}
+
+// AddLastValue adds v to the "last_value" field.
+func (u *DocumentSequenceUpsertBulk) AddLastValue(v int64) *DocumentSequenceUpsertBulk {
+	return u.Update(func(s *DocumentSequenceUpsert) {
+		s.AddLastValue(v)
+	})
+}
+
+// UpdateLastValue sets the "last_value" field to the value that was provided on create.
+func (u *DocumentSequenceUpsertBulk) UpdateLastValue() *DocumentSequenceUpsertBulk {
+	return u.Update(func(s *DocumentSequenceUpsert) {
+		s.UpdateLastValue()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DocumentSequenceUpsertBulk) SetUpdatedAt(v time.Time) *DocumentSequenceUpsertBulk {
+	return u.Update(func(s *DocumentSequenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DocumentSequenceUpsertBulk) UpdateUpdatedAt() *DocumentSequenceUpsertBulk {
+	return u.Update(func(s *DocumentSequenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DocumentSequenceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DocumentSequenceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DocumentSequenceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DocumentSequenceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/documentsequence"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+)
+
+// DocumentSequenceDelete is the builder for deleting a DocumentSequence entity.
+type DocumentSequenceDelete struct {
+	config
+	hooks    []Hook
+	mutation *DocumentSequenceMutation
+}
+
+// Where appends a list predicates to the DocumentSequenceDelete builder.
+func (_d *DocumentSequenceDelete) Where(ps ...predicate.DocumentSequence) *DocumentSequenceDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *DocumentSequenceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DocumentSequenceDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *DocumentSequenceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(documentsequence.Table, sqlgraph.NewFieldSpec(documentsequence.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// DocumentSequenceDeleteOne is the builder for deleting a single DocumentSequence entity.
+type DocumentSequenceDeleteOne struct {
+	_d *DocumentSequenceDelete
+}
+
+// Where appends a list predicates to the DocumentSequenceDelete builder.
+func (_d *DocumentSequenceDeleteOne) Where(ps ...predicate.DocumentSequence) *DocumentSequenceDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *DocumentSequenceDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{documentsequence.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DocumentSequenceDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
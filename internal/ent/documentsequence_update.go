@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/documentsequence"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// DocumentSequenceUpdate is the builder for updating DocumentSequence entities.
+type DocumentSequenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DocumentSequenceMutation
+}
+
+// Where appends a list predicates to the DocumentSequenceUpdate builder.
+func (_u *DocumentSequenceUpdate) Where(ps ...predicate.DocumentSequence) *DocumentSequenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *DocumentSequenceUpdate) SetTenantID(v uuid.UUID) *DocumentSequenceUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *DocumentSequenceUpdate) SetNillableTenantID(v *uuid.UUID) *DocumentSequenceUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *DocumentSequenceUpdate) SetName(v string) *DocumentSequenceUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DocumentSequenceUpdate) SetNillableName(v *string) *DocumentSequenceUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetLastValue sets the "last_value" field.
+func (_u *DocumentSequenceUpdate) SetLastValue(v int64) *DocumentSequenceUpdate {
+	_u.mutation.ResetLastValue()
+	_u.mutation.SetLastValue(v)
+	return _u
+}
+
+// SetNillableLastValue sets the "last_value" field if the given value is not nil.
+func (_u *DocumentSequenceUpdate) SetNillableLastValue(v *int64) *DocumentSequenceUpdate {
+	if v != nil {
+		_u.SetLastValue(*v)
+	}
+	return _u
+}
+
+// AddLastValue adds value to the "last_value" field.
+func (_u *DocumentSequenceUpdate) AddLastValue(v int64) *DocumentSequenceUpdate {
+	_u.mutation.AddLastValue(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DocumentSequenceUpdate) SetUpdatedAt(v time.Time) *DocumentSequenceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DocumentSequenceMutation object of the builder.
+func (_u *DocumentSequenceUpdate) Mutation() *DocumentSequenceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DocumentSequenceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DocumentSequenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DocumentSequenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DocumentSequenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DocumentSequenceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := documentsequence.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DocumentSequenceUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := documentsequence.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DocumentSequence.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DocumentSequenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(documentsequence.Table, documentsequence.Columns, sqlgraph.NewFieldSpec(documentsequence.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(documentsequence.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(documentsequence.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastValue(); ok {
+		_spec.SetField(documentsequence.FieldLastValue, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastValue(); ok {
+		_spec.AddField(documentsequence.FieldLastValue, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(documentsequence.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{documentsequence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DocumentSequenceUpdateOne is the builder for updating a single DocumentSequence entity.
+type DocumentSequenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DocumentSequenceMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *DocumentSequenceUpdateOne) SetTenantID(v uuid.UUID) *DocumentSequenceUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *DocumentSequenceUpdateOne) SetNillableTenantID(v *uuid.UUID) *DocumentSequenceUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *DocumentSequenceUpdateOne) SetName(v string) *DocumentSequenceUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DocumentSequenceUpdateOne) SetNillableName(v *string) *DocumentSequenceUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetLastValue sets the "last_value" field.
+func (_u *DocumentSequenceUpdateOne) SetLastValue(v int64) *DocumentSequenceUpdateOne {
+	_u.mutation.ResetLastValue()
+	_u.mutation.SetLastValue(v)
+	return _u
+}
+
+// SetNillableLastValue sets the "last_value" field if the given value is not nil.
+func (_u *DocumentSequenceUpdateOne) SetNillableLastValue(v *int64) *DocumentSequenceUpdateOne {
+	if v != nil {
+		_u.SetLastValue(*v)
+	}
+	return _u
+}
+
+// AddLastValue adds value to the "last_value" field.
+func (_u *DocumentSequenceUpdateOne) AddLastValue(v int64) *DocumentSequenceUpdateOne {
+	_u.mutation.AddLastValue(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DocumentSequenceUpdateOne) SetUpdatedAt(v time.Time) *DocumentSequenceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DocumentSequenceMutation object of the builder.
+func (_u *DocumentSequenceUpdateOne) Mutation() *DocumentSequenceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DocumentSequenceUpdate builder.
+func (_u *DocumentSequenceUpdateOne) Where(ps ...predicate.DocumentSequence) *DocumentSequenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DocumentSequenceUpdateOne) Select(field string, fields ...string) *DocumentSequenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DocumentSequence entity.
+func (_u *DocumentSequenceUpdateOne) Save(ctx context.Context) (*DocumentSequence, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DocumentSequenceUpdateOne) SaveX(ctx context.Context) *DocumentSequence {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DocumentSequenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DocumentSequenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DocumentSequenceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := documentsequence.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DocumentSequenceUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := documentsequence.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DocumentSequence.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DocumentSequenceUpdateOne) sqlSave(ctx context.Context) (_node *DocumentSequence, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(documentsequence.Table, documentsequence.Columns, sqlgraph.NewFieldSpec(documentsequence.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DocumentSequence.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, documentsequence.FieldID)
+		for _, f := range fields {
+			if !documentsequence.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != documentsequence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(documentsequence.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(documentsequence.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastValue(); ok {
+		_spec.SetField(documentsequence.FieldLastValue, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastValue(); ok {
+		_spec.AddField(documentsequence.FieldLastValue, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(documentsequence.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &DocumentSequence{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{documentsequence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/dunningnotice"
+	"github.com/google/uuid"
+)
+
+// DunningNotice is the model entity for the DunningNotice schema.
+type DunningNotice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Invoice identifier
+	InvoiceID uuid.UUID `json:"invoice_id,omitempty"`
+	// Customer identifier
+	CustomerID uuid.UUID `json:"customer_id,omitempty"`
+	// Step offset relative to the due date
+	OffsetDays int `json:"offset_days,omitempty"`
+	// Action: reminder, late_fee
+	Action string `json:"action,omitempty"`
+	// Channel holds the value of the "channel" field.
+	Channel string `json:"channel,omitempty"`
+	// Invoice raised for a late fee step
+	LateFeeInvoiceID uuid.UUID `json:"late_fee_invoice_id,omitempty"`
+	// ExecutedAt holds the value of the "executed_at" field.
+	ExecutedAt   time.Time `json:"executed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DunningNotice) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dunningnotice.FieldOffsetDays:
+			values[i] = new(sql.NullInt64)
+		case dunningnotice.FieldAction, dunningnotice.FieldChannel:
+			values[i] = new(sql.NullString)
+		case dunningnotice.FieldExecutedAt:
+			values[i] = new(sql.NullTime)
+		case dunningnotice.FieldID, dunningnotice.FieldTenantID, dunningnotice.FieldInvoiceID, dunningnotice.FieldCustomerID, dunningnotice.FieldLateFeeInvoiceID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DunningNotice fields.
+func (_m *DunningNotice) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dunningnotice.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case dunningnotice.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case dunningnotice.FieldInvoiceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value != nil {
+				_m.InvoiceID = *value
+			}
+		case dunningnotice.FieldCustomerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value != nil {
+				_m.CustomerID = *value
+			}
+		case dunningnotice.FieldOffsetDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field offset_days", values[i])
+			} else if value.Valid {
+				_m.OffsetDays = int(value.Int64)
+			}
+		case dunningnotice.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = value.String
+			}
+		case dunningnotice.FieldChannel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field channel", values[i])
+			} else if value.Valid {
+				_m.Channel = value.String
+			}
+		case dunningnotice.FieldLateFeeInvoiceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field late_fee_invoice_id", values[i])
+			} else if value != nil {
+				_m.LateFeeInvoiceID = *value
+			}
+		case dunningnotice.FieldExecutedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field executed_at", values[i])
+			} else if value.Valid {
+				_m.ExecutedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DunningNotice.
+// This includes values selected through modifiers, order, etc.
+func (_m *DunningNotice) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DunningNotice.
+// Note that you need to call DunningNotice.Unwrap() before calling this method if this DunningNotice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DunningNotice) Update() *DunningNoticeUpdateOne {
+	return NewDunningNoticeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DunningNotice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DunningNotice) Unwrap() *DunningNotice {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DunningNotice is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DunningNotice) String() string {
+	var builder strings.Builder
+	builder.WriteString("DunningNotice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("invoice_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InvoiceID))
+	builder.WriteString(", ")
+	builder.WriteString("customer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CustomerID))
+	builder.WriteString(", ")
+	builder.WriteString("offset_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OffsetDays))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(_m.Action)
+	builder.WriteString(", ")
+	builder.WriteString("channel=")
+	builder.WriteString(_m.Channel)
+	builder.WriteString(", ")
+	builder.WriteString("late_fee_invoice_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LateFeeInvoiceID))
+	builder.WriteString(", ")
+	builder.WriteString("executed_at=")
+	builder.WriteString(_m.ExecutedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DunningNotices is a parsable slice of DunningNotice.
+type DunningNotices []*DunningNotice
@@ -0,0 +1,113 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dunningnotice
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the dunningnotice type in the database.
+	Label = "dunning_notice"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldInvoiceID holds the string denoting the invoice_id field in the database.
+	FieldInvoiceID = "invoice_id"
+	// FieldCustomerID holds the string denoting the customer_id field in the database.
+	FieldCustomerID = "customer_id"
+	// FieldOffsetDays holds the string denoting the offset_days field in the database.
+	FieldOffsetDays = "offset_days"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldChannel holds the string denoting the channel field in the database.
+	FieldChannel = "channel"
+	// FieldLateFeeInvoiceID holds the string denoting the late_fee_invoice_id field in the database.
+	FieldLateFeeInvoiceID = "late_fee_invoice_id"
+	// FieldExecutedAt holds the string denoting the executed_at field in the database.
+	FieldExecutedAt = "executed_at"
+	// Table holds the table name of the dunningnotice in the database.
+	Table = "dunning_notices"
+)
+
+// Columns holds all SQL columns for dunningnotice fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldInvoiceID,
+	FieldCustomerID,
+	FieldOffsetDays,
+	FieldAction,
+	FieldChannel,
+	FieldLateFeeInvoiceID,
+	FieldExecutedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultExecutedAt holds the default value on creation for the "executed_at" field.
+	DefaultExecutedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the DunningNotice queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByInvoiceID orders the results by the invoice_id field.
+func ByInvoiceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceID, opts...).ToFunc()
+}
+
+// ByCustomerID orders the results by the customer_id field.
+func ByCustomerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCustomerID, opts...).ToFunc()
+}
+
+// ByOffsetDays orders the results by the offset_days field.
+func ByOffsetDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOffsetDays, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByChannel orders the results by the channel field.
+func ByChannel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChannel, opts...).ToFunc()
+}
+
+// ByLateFeeInvoiceID orders the results by the late_fee_invoice_id field.
+func ByLateFeeInvoiceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLateFeeInvoiceID, opts...).ToFunc()
+}
+
+// ByExecutedAt orders the results by the executed_at field.
+func ByExecutedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExecutedAt, opts...).ToFunc()
+}
@@ -0,0 +1,511 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dunningnotice
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEQ(FieldTenantID, v))
+}
+
+// InvoiceID applies equality check predicate on the "invoice_id" field. It's identical to InvoiceIDEQ.
+func InvoiceID(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// OffsetDays applies equality check predicate on the "offset_days" field. It's identical to OffsetDaysEQ.
+func OffsetDays(v int) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEQ(FieldOffsetDays, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEQ(FieldAction, v))
+}
+
+// Channel applies equality check predicate on the "channel" field. It's identical to ChannelEQ.
+func Channel(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEQ(FieldChannel, v))
+}
+
+// LateFeeInvoiceID applies equality check predicate on the "late_fee_invoice_id" field. It's identical to LateFeeInvoiceIDEQ.
+func LateFeeInvoiceID(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEQ(FieldLateFeeInvoiceID, v))
+}
+
+// ExecutedAt applies equality check predicate on the "executed_at" field. It's identical to ExecutedAtEQ.
+func ExecutedAt(v time.Time) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEQ(FieldExecutedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldLTE(FieldTenantID, v))
+}
+
+// InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
+func InvoiceIDEQ(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDNEQ applies the NEQ predicate on the "invoice_id" field.
+func InvoiceIDNEQ(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDIn applies the In predicate on the "invoice_id" field.
+func InvoiceIDIn(vs ...uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDNotIn applies the NotIn predicate on the "invoice_id" field.
+func InvoiceIDNotIn(vs ...uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNotIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDGT applies the GT predicate on the "invoice_id" field.
+func InvoiceIDGT(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldGT(FieldInvoiceID, v))
+}
+
+// InvoiceIDGTE applies the GTE predicate on the "invoice_id" field.
+func InvoiceIDGTE(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldGTE(FieldInvoiceID, v))
+}
+
+// InvoiceIDLT applies the LT predicate on the "invoice_id" field.
+func InvoiceIDLT(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldLT(FieldInvoiceID, v))
+}
+
+// InvoiceIDLTE applies the LTE predicate on the "invoice_id" field.
+func InvoiceIDLTE(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldLTE(FieldInvoiceID, v))
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNEQ(FieldCustomerID, v))
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNotIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDGT applies the GT predicate on the "customer_id" field.
+func CustomerIDGT(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldGT(FieldCustomerID, v))
+}
+
+// CustomerIDGTE applies the GTE predicate on the "customer_id" field.
+func CustomerIDGTE(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldGTE(FieldCustomerID, v))
+}
+
+// CustomerIDLT applies the LT predicate on the "customer_id" field.
+func CustomerIDLT(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldLT(FieldCustomerID, v))
+}
+
+// CustomerIDLTE applies the LTE predicate on the "customer_id" field.
+func CustomerIDLTE(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldLTE(FieldCustomerID, v))
+}
+
+// CustomerIDIsNil applies the IsNil predicate on the "customer_id" field.
+func CustomerIDIsNil() predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldIsNull(FieldCustomerID))
+}
+
+// CustomerIDNotNil applies the NotNil predicate on the "customer_id" field.
+func CustomerIDNotNil() predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNotNull(FieldCustomerID))
+}
+
+// OffsetDaysEQ applies the EQ predicate on the "offset_days" field.
+func OffsetDaysEQ(v int) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEQ(FieldOffsetDays, v))
+}
+
+// OffsetDaysNEQ applies the NEQ predicate on the "offset_days" field.
+func OffsetDaysNEQ(v int) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNEQ(FieldOffsetDays, v))
+}
+
+// OffsetDaysIn applies the In predicate on the "offset_days" field.
+func OffsetDaysIn(vs ...int) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldIn(FieldOffsetDays, vs...))
+}
+
+// OffsetDaysNotIn applies the NotIn predicate on the "offset_days" field.
+func OffsetDaysNotIn(vs ...int) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNotIn(FieldOffsetDays, vs...))
+}
+
+// OffsetDaysGT applies the GT predicate on the "offset_days" field.
+func OffsetDaysGT(v int) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldGT(FieldOffsetDays, v))
+}
+
+// OffsetDaysGTE applies the GTE predicate on the "offset_days" field.
+func OffsetDaysGTE(v int) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldGTE(FieldOffsetDays, v))
+}
+
+// OffsetDaysLT applies the LT predicate on the "offset_days" field.
+func OffsetDaysLT(v int) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldLT(FieldOffsetDays, v))
+}
+
+// OffsetDaysLTE applies the LTE predicate on the "offset_days" field.
+func OffsetDaysLTE(v int) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldLTE(FieldOffsetDays, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldContainsFold(FieldAction, v))
+}
+
+// ChannelEQ applies the EQ predicate on the "channel" field.
+func ChannelEQ(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEQ(FieldChannel, v))
+}
+
+// ChannelNEQ applies the NEQ predicate on the "channel" field.
+func ChannelNEQ(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNEQ(FieldChannel, v))
+}
+
+// ChannelIn applies the In predicate on the "channel" field.
+func ChannelIn(vs ...string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldIn(FieldChannel, vs...))
+}
+
+// ChannelNotIn applies the NotIn predicate on the "channel" field.
+func ChannelNotIn(vs ...string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNotIn(FieldChannel, vs...))
+}
+
+// ChannelGT applies the GT predicate on the "channel" field.
+func ChannelGT(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldGT(FieldChannel, v))
+}
+
+// ChannelGTE applies the GTE predicate on the "channel" field.
+func ChannelGTE(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldGTE(FieldChannel, v))
+}
+
+// ChannelLT applies the LT predicate on the "channel" field.
+func ChannelLT(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldLT(FieldChannel, v))
+}
+
+// ChannelLTE applies the LTE predicate on the "channel" field.
+func ChannelLTE(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldLTE(FieldChannel, v))
+}
+
+// ChannelContains applies the Contains predicate on the "channel" field.
+func ChannelContains(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldContains(FieldChannel, v))
+}
+
+// ChannelHasPrefix applies the HasPrefix predicate on the "channel" field.
+func ChannelHasPrefix(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldHasPrefix(FieldChannel, v))
+}
+
+// ChannelHasSuffix applies the HasSuffix predicate on the "channel" field.
+func ChannelHasSuffix(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldHasSuffix(FieldChannel, v))
+}
+
+// ChannelIsNil applies the IsNil predicate on the "channel" field.
+func ChannelIsNil() predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldIsNull(FieldChannel))
+}
+
+// ChannelNotNil applies the NotNil predicate on the "channel" field.
+func ChannelNotNil() predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNotNull(FieldChannel))
+}
+
+// ChannelEqualFold applies the EqualFold predicate on the "channel" field.
+func ChannelEqualFold(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEqualFold(FieldChannel, v))
+}
+
+// ChannelContainsFold applies the ContainsFold predicate on the "channel" field.
+func ChannelContainsFold(v string) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldContainsFold(FieldChannel, v))
+}
+
+// LateFeeInvoiceIDEQ applies the EQ predicate on the "late_fee_invoice_id" field.
+func LateFeeInvoiceIDEQ(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEQ(FieldLateFeeInvoiceID, v))
+}
+
+// LateFeeInvoiceIDNEQ applies the NEQ predicate on the "late_fee_invoice_id" field.
+func LateFeeInvoiceIDNEQ(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNEQ(FieldLateFeeInvoiceID, v))
+}
+
+// LateFeeInvoiceIDIn applies the In predicate on the "late_fee_invoice_id" field.
+func LateFeeInvoiceIDIn(vs ...uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldIn(FieldLateFeeInvoiceID, vs...))
+}
+
+// LateFeeInvoiceIDNotIn applies the NotIn predicate on the "late_fee_invoice_id" field.
+func LateFeeInvoiceIDNotIn(vs ...uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNotIn(FieldLateFeeInvoiceID, vs...))
+}
+
+// LateFeeInvoiceIDGT applies the GT predicate on the "late_fee_invoice_id" field.
+func LateFeeInvoiceIDGT(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldGT(FieldLateFeeInvoiceID, v))
+}
+
+// LateFeeInvoiceIDGTE applies the GTE predicate on the "late_fee_invoice_id" field.
+func LateFeeInvoiceIDGTE(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldGTE(FieldLateFeeInvoiceID, v))
+}
+
+// LateFeeInvoiceIDLT applies the LT predicate on the "late_fee_invoice_id" field.
+func LateFeeInvoiceIDLT(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldLT(FieldLateFeeInvoiceID, v))
+}
+
+// LateFeeInvoiceIDLTE applies the LTE predicate on the "late_fee_invoice_id" field.
+func LateFeeInvoiceIDLTE(v uuid.UUID) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldLTE(FieldLateFeeInvoiceID, v))
+}
+
+// LateFeeInvoiceIDIsNil applies the IsNil predicate on the "late_fee_invoice_id" field.
+func LateFeeInvoiceIDIsNil() predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldIsNull(FieldLateFeeInvoiceID))
+}
+
+// LateFeeInvoiceIDNotNil applies the NotNil predicate on the "late_fee_invoice_id" field.
+func LateFeeInvoiceIDNotNil() predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNotNull(FieldLateFeeInvoiceID))
+}
+
+// ExecutedAtEQ applies the EQ predicate on the "executed_at" field.
+func ExecutedAtEQ(v time.Time) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldEQ(FieldExecutedAt, v))
+}
+
+// ExecutedAtNEQ applies the NEQ predicate on the "executed_at" field.
+func ExecutedAtNEQ(v time.Time) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNEQ(FieldExecutedAt, v))
+}
+
+// ExecutedAtIn applies the In predicate on the "executed_at" field.
+func ExecutedAtIn(vs ...time.Time) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldIn(FieldExecutedAt, vs...))
+}
+
+// ExecutedAtNotIn applies the NotIn predicate on the "executed_at" field.
+func ExecutedAtNotIn(vs ...time.Time) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldNotIn(FieldExecutedAt, vs...))
+}
+
+// ExecutedAtGT applies the GT predicate on the "executed_at" field.
+func ExecutedAtGT(v time.Time) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldGT(FieldExecutedAt, v))
+}
+
+// ExecutedAtGTE applies the GTE predicate on the "executed_at" field.
+func ExecutedAtGTE(v time.Time) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldGTE(FieldExecutedAt, v))
+}
+
+// ExecutedAtLT applies the LT predicate on the "executed_at" field.
+func ExecutedAtLT(v time.Time) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldLT(FieldExecutedAt, v))
+}
+
+// ExecutedAtLTE applies the LTE predicate on the "executed_at" field.
+func ExecutedAtLTE(v time.Time) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.FieldLTE(FieldExecutedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DunningNotice) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DunningNotice) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DunningNotice) predicate.DunningNotice {
+	return predicate.DunningNotice(sql.NotPredicates(p))
+}
@@ -0,0 +1,953 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/dunningnotice"
+	"github.com/google/uuid"
+)
+
+// DunningNoticeCreate is the builder for creating a DunningNotice entity.
+type DunningNoticeCreate struct {
+	config
+	mutation *DunningNoticeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *DunningNoticeCreate) SetTenantID(v uuid.UUID) *DunningNoticeCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_c *DunningNoticeCreate) SetInvoiceID(v uuid.UUID) *DunningNoticeCreate {
+	_c.mutation.SetInvoiceID(v)
+	return _c
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_c *DunningNoticeCreate) SetCustomerID(v uuid.UUID) *DunningNoticeCreate {
+	_c.mutation.SetCustomerID(v)
+	return _c
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_c *DunningNoticeCreate) SetNillableCustomerID(v *uuid.UUID) *DunningNoticeCreate {
+	if v != nil {
+		_c.SetCustomerID(*v)
+	}
+	return _c
+}
+
+// SetOffsetDays sets the "offset_days" field.
+func (_c *DunningNoticeCreate) SetOffsetDays(v int) *DunningNoticeCreate {
+	_c.mutation.SetOffsetDays(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *DunningNoticeCreate) SetAction(v string) *DunningNoticeCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetChannel sets the "channel" field.
+func (_c *DunningNoticeCreate) SetChannel(v string) *DunningNoticeCreate {
+	_c.mutation.SetChannel(v)
+	return _c
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_c *DunningNoticeCreate) SetNillableChannel(v *string) *DunningNoticeCreate {
+	if v != nil {
+		_c.SetChannel(*v)
+	}
+	return _c
+}
+
+// SetLateFeeInvoiceID sets the "late_fee_invoice_id" field.
+func (_c *DunningNoticeCreate) SetLateFeeInvoiceID(v uuid.UUID) *DunningNoticeCreate {
+	_c.mutation.SetLateFeeInvoiceID(v)
+	return _c
+}
+
+// SetNillableLateFeeInvoiceID sets the "late_fee_invoice_id" field if the given value is not nil.
+func (_c *DunningNoticeCreate) SetNillableLateFeeInvoiceID(v *uuid.UUID) *DunningNoticeCreate {
+	if v != nil {
+		_c.SetLateFeeInvoiceID(*v)
+	}
+	return _c
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (_c *DunningNoticeCreate) SetExecutedAt(v time.Time) *DunningNoticeCreate {
+	_c.mutation.SetExecutedAt(v)
+	return _c
+}
+
+// SetNillableExecutedAt sets the "executed_at" field if the given value is not nil.
+func (_c *DunningNoticeCreate) SetNillableExecutedAt(v *time.Time) *DunningNoticeCreate {
+	if v != nil {
+		_c.SetExecutedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DunningNoticeCreate) SetID(v uuid.UUID) *DunningNoticeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DunningNoticeCreate) SetNillableID(v *uuid.UUID) *DunningNoticeCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DunningNoticeMutation object of the builder.
+func (_c *DunningNoticeCreate) Mutation() *DunningNoticeMutation {
+	return _c.mutation
+}
+
+// Save creates the DunningNotice in the database.
+func (_c *DunningNoticeCreate) Save(ctx context.Context) (*DunningNotice, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DunningNoticeCreate) SaveX(ctx context.Context) *DunningNotice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DunningNoticeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DunningNoticeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DunningNoticeCreate) defaults() {
+	if _, ok := _c.mutation.ExecutedAt(); !ok {
+		v := dunningnotice.DefaultExecutedAt()
+		_c.mutation.SetExecutedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := dunningnotice.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DunningNoticeCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "DunningNotice.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.InvoiceID(); !ok {
+		return &ValidationError{Name: "invoice_id", err: errors.New(`ent: missing required field "DunningNotice.invoice_id"`)}
+	}
+	if _, ok := _c.mutation.OffsetDays(); !ok {
+		return &ValidationError{Name: "offset_days", err: errors.New(`ent: missing required field "DunningNotice.offset_days"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "DunningNotice.action"`)}
+	}
+	if _, ok := _c.mutation.ExecutedAt(); !ok {
+		return &ValidationError{Name: "executed_at", err: errors.New(`ent: missing required field "DunningNotice.executed_at"`)}
+	}
+	return nil
+}
+
+func (_c *DunningNoticeCreate) sqlSave(ctx context.Context) (*DunningNotice, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DunningNoticeCreate) createSpec() (*DunningNotice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DunningNotice{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(dunningnotice.Table, sqlgraph.NewFieldSpec(dunningnotice.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(dunningnotice.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.InvoiceID(); ok {
+		_spec.SetField(dunningnotice.FieldInvoiceID, field.TypeUUID, value)
+		_node.InvoiceID = value
+	}
+	if value, ok := _c.mutation.CustomerID(); ok {
+		_spec.SetField(dunningnotice.FieldCustomerID, field.TypeUUID, value)
+		_node.CustomerID = value
+	}
+	if value, ok := _c.mutation.OffsetDays(); ok {
+		_spec.SetField(dunningnotice.FieldOffsetDays, field.TypeInt, value)
+		_node.OffsetDays = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(dunningnotice.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Channel(); ok {
+		_spec.SetField(dunningnotice.FieldChannel, field.TypeString, value)
+		_node.Channel = value
+	}
+	if value, ok := _c.mutation.LateFeeInvoiceID(); ok {
+		_spec.SetField(dunningnotice.FieldLateFeeInvoiceID, field.TypeUUID, value)
+		_node.LateFeeInvoiceID = value
+	}
+	if value, ok := _c.mutation.ExecutedAt(); ok {
+		_spec.SetField(dunningnotice.FieldExecutedAt, field.TypeTime, value)
+		_node.ExecutedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DunningNotice.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DunningNoticeUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DunningNoticeCreate) OnConflict(opts ...sql.ConflictOption) *DunningNoticeUpsertOne {
+	_c.conflict = opts
+	return &DunningNoticeUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DunningNotice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DunningNoticeCreate) OnConflictColumns(columns ...string) *DunningNoticeUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DunningNoticeUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DunningNoticeUpsertOne is the builder for "upsert"-ing
+	//  one DunningNotice node.
+	DunningNoticeUpsertOne struct {
+		create *DunningNoticeCreate
+	}
+
+	// DunningNoticeUpsert is the "OnConflict" setter.
+	DunningNoticeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *DunningNoticeUpsert) SetTenantID(v uuid.UUID) *DunningNoticeUpsert {
+	u.Set(dunningnotice.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *DunningNoticeUpsert) UpdateTenantID() *DunningNoticeUpsert {
+	u.SetExcluded(dunningnotice.FieldTenantID)
+	return u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *DunningNoticeUpsert) SetInvoiceID(v uuid.UUID) *DunningNoticeUpsert {
+	u.Set(dunningnotice.FieldInvoiceID, v)
+	return u
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *DunningNoticeUpsert) UpdateInvoiceID() *DunningNoticeUpsert {
+	u.SetExcluded(dunningnotice.FieldInvoiceID)
+	return u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *DunningNoticeUpsert) SetCustomerID(v uuid.UUID) *DunningNoticeUpsert {
+	u.Set(dunningnotice.FieldCustomerID, v)
+	return u
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *DunningNoticeUpsert) UpdateCustomerID() *DunningNoticeUpsert {
+	u.SetExcluded(dunningnotice.FieldCustomerID)
+	return u
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (u *DunningNoticeUpsert) ClearCustomerID() *DunningNoticeUpsert {
+	u.SetNull(dunningnotice.FieldCustomerID)
+	return u
+}
+
+// SetOffsetDays sets the "offset_days" field.
+func (u *DunningNoticeUpsert) SetOffsetDays(v int) *DunningNoticeUpsert {
+	u.Set(dunningnotice.FieldOffsetDays, v)
+	return u
+}
+
+// UpdateOffsetDays sets the "offset_days" field to the value that was provided on create.
+func (u *DunningNoticeUpsert) UpdateOffsetDays() *DunningNoticeUpsert {
+	u.SetExcluded(dunningnotice.FieldOffsetDays)
+	return u
+}
+
+// AddOffsetDays adds v to the "offset_days" field.
+func (u *DunningNoticeUpsert) AddOffsetDays(v int) *DunningNoticeUpsert {
+	u.Add(dunningnotice.FieldOffsetDays, v)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *DunningNoticeUpsert) SetAction(v string) *DunningNoticeUpsert {
+	u.Set(dunningnotice.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *DunningNoticeUpsert) UpdateAction() *DunningNoticeUpsert {
+	u.SetExcluded(dunningnotice.FieldAction)
+	return u
+}
+
+// SetChannel sets the "channel" field.
+func (u *DunningNoticeUpsert) SetChannel(v string) *DunningNoticeUpsert {
+	u.Set(dunningnotice.FieldChannel, v)
+	return u
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *DunningNoticeUpsert) UpdateChannel() *DunningNoticeUpsert {
+	u.SetExcluded(dunningnotice.FieldChannel)
+	return u
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (u *DunningNoticeUpsert) ClearChannel() *DunningNoticeUpsert {
+	u.SetNull(dunningnotice.FieldChannel)
+	return u
+}
+
+// SetLateFeeInvoiceID sets the "late_fee_invoice_id" field.
+func (u *DunningNoticeUpsert) SetLateFeeInvoiceID(v uuid.UUID) *DunningNoticeUpsert {
+	u.Set(dunningnotice.FieldLateFeeInvoiceID, v)
+	return u
+}
+
+// UpdateLateFeeInvoiceID sets the "late_fee_invoice_id" field to the value that was provided on create.
+func (u *DunningNoticeUpsert) UpdateLateFeeInvoiceID() *DunningNoticeUpsert {
+	u.SetExcluded(dunningnotice.FieldLateFeeInvoiceID)
+	return u
+}
+
+// ClearLateFeeInvoiceID clears the value of the "late_fee_invoice_id" field.
+func (u *DunningNoticeUpsert) ClearLateFeeInvoiceID() *DunningNoticeUpsert {
+	u.SetNull(dunningnotice.FieldLateFeeInvoiceID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DunningNotice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dunningnotice.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DunningNoticeUpsertOne) UpdateNewValues() *DunningNoticeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(dunningnotice.FieldID)
+		}
+		if _, exists := u.create.mutation.ExecutedAt(); exists {
+			s.SetIgnore(dunningnotice.FieldExecutedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DunningNotice.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DunningNoticeUpsertOne) Ignore() *DunningNoticeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DunningNoticeUpsertOne) DoNothing() *DunningNoticeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DunningNoticeCreate.OnConflict
+// documentation for more info.
+func (u *DunningNoticeUpsertOne) Update(set func(*DunningNoticeUpsert)) *DunningNoticeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DunningNoticeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *DunningNoticeUpsertOne) SetTenantID(v uuid.UUID) *DunningNoticeUpsertOne {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *DunningNoticeUpsertOne) UpdateTenantID() *DunningNoticeUpsertOne {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *DunningNoticeUpsertOne) SetInvoiceID(v uuid.UUID) *DunningNoticeUpsertOne {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.SetInvoiceID(v)
+	})
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *DunningNoticeUpsertOne) UpdateInvoiceID() *DunningNoticeUpsertOne {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.UpdateInvoiceID()
+	})
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *DunningNoticeUpsertOne) SetCustomerID(v uuid.UUID) *DunningNoticeUpsertOne {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.SetCustomerID(v)
+	})
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *DunningNoticeUpsertOne) UpdateCustomerID() *DunningNoticeUpsertOne {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.UpdateCustomerID()
+	})
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (u *DunningNoticeUpsertOne) ClearCustomerID() *DunningNoticeUpsertOne {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.ClearCustomerID()
+	})
+}
+
+// SetOffsetDays sets the "offset_days" field.
+func (u *DunningNoticeUpsertOne) SetOffsetDays(v int) *DunningNoticeUpsertOne {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.SetOffsetDays(v)
+	})
+}
+
+// AddOffsetDays adds v to the "offset_days" field.
+func (u *DunningNoticeUpsertOne) AddOffsetDays(v int) *DunningNoticeUpsertOne {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.AddOffsetDays(v)
+	})
+}
+
+// UpdateOffsetDays sets the "offset_days" field to the value that was provided on create.
+func (u *DunningNoticeUpsertOne) UpdateOffsetDays() *DunningNoticeUpsertOne {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.UpdateOffsetDays()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *DunningNoticeUpsertOne) SetAction(v string) *DunningNoticeUpsertOne {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *DunningNoticeUpsertOne) UpdateAction() *DunningNoticeUpsertOne {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetChannel sets the "channel" field.
+func (u *DunningNoticeUpsertOne) SetChannel(v string) *DunningNoticeUpsertOne {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.SetChannel(v)
+	})
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *DunningNoticeUpsertOne) UpdateChannel() *DunningNoticeUpsertOne {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.UpdateChannel()
+	})
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (u *DunningNoticeUpsertOne) ClearChannel() *DunningNoticeUpsertOne {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.ClearChannel()
+	})
+}
+
+// SetLateFeeInvoiceID sets the "late_fee_invoice_id" field.
+func (u *DunningNoticeUpsertOne) SetLateFeeInvoiceID(v uuid.UUID) *DunningNoticeUpsertOne {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.SetLateFeeInvoiceID(v)
+	})
+}
+
+// UpdateLateFeeInvoiceID sets the "late_fee_invoice_id" field to the value that was provided on create.
+func (u *DunningNoticeUpsertOne) UpdateLateFeeInvoiceID() *DunningNoticeUpsertOne {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.UpdateLateFeeInvoiceID()
+	})
+}
+
+// ClearLateFeeInvoiceID clears the value of the "late_fee_invoice_id" field.
+func (u *DunningNoticeUpsertOne) ClearLateFeeInvoiceID() *DunningNoticeUpsertOne {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.ClearLateFeeInvoiceID()
+	})
+}
+
+// Exec executes the query.
+func (u *DunningNoticeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DunningNoticeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DunningNoticeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DunningNoticeUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DunningNoticeUpsertOne.ID is not supported by MySQL driver. Use DunningNoticeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DunningNoticeUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DunningNoticeCreateBulk is the builder for creating many DunningNotice entities in bulk.
+type DunningNoticeCreateBulk struct {
+	config
+	err      error
+	builders []*DunningNoticeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DunningNotice entities in the database.
+func (_c *DunningNoticeCreateBulk) Save(ctx context.Context) ([]*DunningNotice, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DunningNotice, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DunningNoticeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DunningNoticeCreateBulk) SaveX(ctx context.Context) []*DunningNotice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DunningNoticeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DunningNoticeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DunningNotice.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DunningNoticeUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DunningNoticeCreateBulk) OnConflict(opts ...sql.ConflictOption) *DunningNoticeUpsertBulk {
+	_c.conflict = opts
+	return &DunningNoticeUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DunningNotice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DunningNoticeCreateBulk) OnConflictColumns(columns ...string) *DunningNoticeUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DunningNoticeUpsertBulk{
+		create: _c,
+	}
+}
+
+// DunningNoticeUpsertBulk is the builder for "upsert"-ing
+// a bulk of DunningNotice nodes.
+type DunningNoticeUpsertBulk struct {
+	create *DunningNoticeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DunningNotice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dunningnotice.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DunningNoticeUpsertBulk) UpdateNewValues() *DunningNoticeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(dunningnotice.FieldID)
+			}
+			if _, exists := b.mutation.ExecutedAt(); exists {
+				s.SetIgnore(dunningnotice.FieldExecutedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DunningNotice.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DunningNoticeUpsertBulk) Ignore() *DunningNoticeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DunningNoticeUpsertBulk) DoNothing() *DunningNoticeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DunningNoticeCreateBulk.OnConflict
+// documentation for more info.
+func (u *DunningNoticeUpsertBulk) Update(set func(*DunningNoticeUpsert)) *DunningNoticeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DunningNoticeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *DunningNoticeUpsertBulk) SetTenantID(v uuid.UUID) *DunningNoticeUpsertBulk {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *DunningNoticeUpsertBulk) UpdateTenantID() *DunningNoticeUpsertBulk {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *DunningNoticeUpsertBulk) SetInvoiceID(v uuid.UUID) *DunningNoticeUpsertBulk {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.SetInvoiceID(v)
+	})
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *DunningNoticeUpsertBulk) UpdateInvoiceID() *DunningNoticeUpsertBulk {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.UpdateInvoiceID()
+	})
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *DunningNoticeUpsertBulk) SetCustomerID(v uuid.UUID) *DunningNoticeUpsertBulk {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.SetCustomerID(v)
+	})
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *DunningNoticeUpsertBulk) UpdateCustomerID() *DunningNoticeUpsertBulk {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.UpdateCustomerID()
+	})
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (u *DunningNoticeUpsertBulk) ClearCustomerID() *DunningNoticeUpsertBulk {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.ClearCustomerID()
+	})
+}
+
+// SetOffsetDays sets the "offset_days" field.
+func (u *DunningNoticeUpsertBulk) SetOffsetDays(v int) *DunningNoticeUpsertBulk {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.SetOffsetDays(v)
+	})
+}
+
+// AddOffsetDays adds v to the "offset_days" field.
+func (u *DunningNoticeUpsertBulk) AddOffsetDays(v int) *DunningNoticeUpsertBulk {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.AddOffsetDays(v)
+	})
+}
+
+// UpdateOffsetDays sets the "offset_days" field to the value that was provided on create.
+func (u *DunningNoticeUpsertBulk) UpdateOffsetDays() *DunningNoticeUpsertBulk {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.UpdateOffsetDays()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *DunningNoticeUpsertBulk) SetAction(v string) *DunningNoticeUpsertBulk {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *DunningNoticeUpsertBulk) UpdateAction() *DunningNoticeUpsertBulk {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetChannel sets the "channel" field.
+func (u *DunningNoticeUpsertBulk) SetChannel(v string) *DunningNoticeUpsertBulk {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.SetChannel(v)
+	})
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *DunningNoticeUpsertBulk) UpdateChannel() *DunningNoticeUpsertBulk {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.UpdateChannel()
+	})
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (u *DunningNoticeUpsertBulk) ClearChannel() *DunningNoticeUpsertBulk {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.ClearChannel()
+	})
+}
+
+// SetLateFeeInvoiceID sets the "late_fee_invoice_id" field.
+func (u *DunningNoticeUpsertBulk) SetLateFeeInvoiceID(v uuid.UUID) *DunningNoticeUpsertBulk {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.SetLateFeeInvoiceID(v)
+	})
+}
+
+// UpdateLateFeeInvoiceID sets the "late_fee_invoice_id" field to the value that was provided on create.
+func (u *DunningNoticeUpsertBulk) UpdateLateFeeInvoiceID() *DunningNoticeUpsertBulk {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.UpdateLateFeeInvoiceID()
+	})
+}
+
+// ClearLateFeeInvoiceID clears the value of the "late_fee_invoice_id" field.
+func (u *DunningNoticeUpsertBulk) ClearLateFeeInvoiceID() *DunningNoticeUpsertBulk {
+	return u.Update(func(s *DunningNoticeUpsert) {
+		s.ClearLateFeeInvoiceID()
+	})
+}
+
+// Exec executes the query.
+func (u *DunningNoticeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DunningNoticeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DunningNoticeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DunningNoticeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
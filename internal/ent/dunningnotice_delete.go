@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/dunningnotice"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+)
+
+// DunningNoticeDelete is the builder for deleting a DunningNotice entity.
+type DunningNoticeDelete struct {
+	config
+	hooks    []Hook
+	mutation *DunningNoticeMutation
+}
+
+// Where appends a list predicates to the DunningNoticeDelete builder.
+func (_d *DunningNoticeDelete) Where(ps ...predicate.DunningNotice) *DunningNoticeDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *DunningNoticeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DunningNoticeDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *DunningNoticeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(dunningnotice.Table, sqlgraph.NewFieldSpec(dunningnotice.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// DunningNoticeDeleteOne is the builder for deleting a single DunningNotice entity.
+type DunningNoticeDeleteOne struct {
+	_d *DunningNoticeDelete
+}
+
+// Where appends a list predicates to the DunningNoticeDelete builder.
+func (_d *DunningNoticeDeleteOne) Where(ps ...predicate.DunningNotice) *DunningNoticeDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *DunningNoticeDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{dunningnotice.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DunningNoticeDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,488 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/dunningnotice"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// DunningNoticeUpdate is the builder for updating DunningNotice entities.
+type DunningNoticeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DunningNoticeMutation
+}
+
+// Where appends a list predicates to the DunningNoticeUpdate builder.
+func (_u *DunningNoticeUpdate) Where(ps ...predicate.DunningNotice) *DunningNoticeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *DunningNoticeUpdate) SetTenantID(v uuid.UUID) *DunningNoticeUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *DunningNoticeUpdate) SetNillableTenantID(v *uuid.UUID) *DunningNoticeUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *DunningNoticeUpdate) SetInvoiceID(v uuid.UUID) *DunningNoticeUpdate {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *DunningNoticeUpdate) SetNillableInvoiceID(v *uuid.UUID) *DunningNoticeUpdate {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *DunningNoticeUpdate) SetCustomerID(v uuid.UUID) *DunningNoticeUpdate {
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *DunningNoticeUpdate) SetNillableCustomerID(v *uuid.UUID) *DunningNoticeUpdate {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (_u *DunningNoticeUpdate) ClearCustomerID() *DunningNoticeUpdate {
+	_u.mutation.ClearCustomerID()
+	return _u
+}
+
+// SetOffsetDays sets the "offset_days" field.
+func (_u *DunningNoticeUpdate) SetOffsetDays(v int) *DunningNoticeUpdate {
+	_u.mutation.ResetOffsetDays()
+	_u.mutation.SetOffsetDays(v)
+	return _u
+}
+
+// SetNillableOffsetDays sets the "offset_days" field if the given value is not nil.
+func (_u *DunningNoticeUpdate) SetNillableOffsetDays(v *int) *DunningNoticeUpdate {
+	if v != nil {
+		_u.SetOffsetDays(*v)
+	}
+	return _u
+}
+
+// AddOffsetDays adds value to the "offset_days" field.
+func (_u *DunningNoticeUpdate) AddOffsetDays(v int) *DunningNoticeUpdate {
+	_u.mutation.AddOffsetDays(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *DunningNoticeUpdate) SetAction(v string) *DunningNoticeUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *DunningNoticeUpdate) SetNillableAction(v *string) *DunningNoticeUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *DunningNoticeUpdate) SetChannel(v string) *DunningNoticeUpdate {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *DunningNoticeUpdate) SetNillableChannel(v *string) *DunningNoticeUpdate {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (_u *DunningNoticeUpdate) ClearChannel() *DunningNoticeUpdate {
+	_u.mutation.ClearChannel()
+	return _u
+}
+
+// SetLateFeeInvoiceID sets the "late_fee_invoice_id" field.
+func (_u *DunningNoticeUpdate) SetLateFeeInvoiceID(v uuid.UUID) *DunningNoticeUpdate {
+	_u.mutation.SetLateFeeInvoiceID(v)
+	return _u
+}
+
+// SetNillableLateFeeInvoiceID sets the "late_fee_invoice_id" field if the given value is not nil.
+func (_u *DunningNoticeUpdate) SetNillableLateFeeInvoiceID(v *uuid.UUID) *DunningNoticeUpdate {
+	if v != nil {
+		_u.SetLateFeeInvoiceID(*v)
+	}
+	return _u
+}
+
+// ClearLateFeeInvoiceID clears the value of the "late_fee_invoice_id" field.
+func (_u *DunningNoticeUpdate) ClearLateFeeInvoiceID() *DunningNoticeUpdate {
+	_u.mutation.ClearLateFeeInvoiceID()
+	return _u
+}
+
+// Mutation returns the DunningNoticeMutation object of the builder.
+func (_u *DunningNoticeUpdate) Mutation() *DunningNoticeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DunningNoticeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DunningNoticeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DunningNoticeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DunningNoticeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DunningNoticeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dunningnotice.Table, dunningnotice.Columns, sqlgraph.NewFieldSpec(dunningnotice.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(dunningnotice.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.InvoiceID(); ok {
+		_spec.SetField(dunningnotice.FieldInvoiceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(dunningnotice.FieldCustomerID, field.TypeUUID, value)
+	}
+	if _u.mutation.CustomerIDCleared() {
+		_spec.ClearField(dunningnotice.FieldCustomerID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.OffsetDays(); ok {
+		_spec.SetField(dunningnotice.FieldOffsetDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOffsetDays(); ok {
+		_spec.AddField(dunningnotice.FieldOffsetDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(dunningnotice.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(dunningnotice.FieldChannel, field.TypeString, value)
+	}
+	if _u.mutation.ChannelCleared() {
+		_spec.ClearField(dunningnotice.FieldChannel, field.TypeString)
+	}
+	if value, ok := _u.mutation.LateFeeInvoiceID(); ok {
+		_spec.SetField(dunningnotice.FieldLateFeeInvoiceID, field.TypeUUID, value)
+	}
+	if _u.mutation.LateFeeInvoiceIDCleared() {
+		_spec.ClearField(dunningnotice.FieldLateFeeInvoiceID, field.TypeUUID)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dunningnotice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DunningNoticeUpdateOne is the builder for updating a single DunningNotice entity.
+type DunningNoticeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DunningNoticeMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *DunningNoticeUpdateOne) SetTenantID(v uuid.UUID) *DunningNoticeUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *DunningNoticeUpdateOne) SetNillableTenantID(v *uuid.UUID) *DunningNoticeUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *DunningNoticeUpdateOne) SetInvoiceID(v uuid.UUID) *DunningNoticeUpdateOne {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *DunningNoticeUpdateOne) SetNillableInvoiceID(v *uuid.UUID) *DunningNoticeUpdateOne {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *DunningNoticeUpdateOne) SetCustomerID(v uuid.UUID) *DunningNoticeUpdateOne {
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *DunningNoticeUpdateOne) SetNillableCustomerID(v *uuid.UUID) *DunningNoticeUpdateOne {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (_u *DunningNoticeUpdateOne) ClearCustomerID() *DunningNoticeUpdateOne {
+	_u.mutation.ClearCustomerID()
+	return _u
+}
+
+// SetOffsetDays sets the "offset_days" field.
+func (_u *DunningNoticeUpdateOne) SetOffsetDays(v int) *DunningNoticeUpdateOne {
+	_u.mutation.ResetOffsetDays()
+	_u.mutation.SetOffsetDays(v)
+	return _u
+}
+
+// SetNillableOffsetDays sets the "offset_days" field if the given value is not nil.
+func (_u *DunningNoticeUpdateOne) SetNillableOffsetDays(v *int) *DunningNoticeUpdateOne {
+	if v != nil {
+		_u.SetOffsetDays(*v)
+	}
+	return _u
+}
+
+// AddOffsetDays adds value to the "offset_days" field.
+func (_u *DunningNoticeUpdateOne) AddOffsetDays(v int) *DunningNoticeUpdateOne {
+	_u.mutation.AddOffsetDays(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *DunningNoticeUpdateOne) SetAction(v string) *DunningNoticeUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *DunningNoticeUpdateOne) SetNillableAction(v *string) *DunningNoticeUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *DunningNoticeUpdateOne) SetChannel(v string) *DunningNoticeUpdateOne {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *DunningNoticeUpdateOne) SetNillableChannel(v *string) *DunningNoticeUpdateOne {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (_u *DunningNoticeUpdateOne) ClearChannel() *DunningNoticeUpdateOne {
+	_u.mutation.ClearChannel()
+	return _u
+}
+
+// SetLateFeeInvoiceID sets the "late_fee_invoice_id" field.
+func (_u *DunningNoticeUpdateOne) SetLateFeeInvoiceID(v uuid.UUID) *DunningNoticeUpdateOne {
+	_u.mutation.SetLateFeeInvoiceID(v)
+	return _u
+}
+
+// SetNillableLateFeeInvoiceID sets the "late_fee_invoice_id" field if the given value is not nil.
+func (_u *DunningNoticeUpdateOne) SetNillableLateFeeInvoiceID(v *uuid.UUID) *DunningNoticeUpdateOne {
+	if v != nil {
+		_u.SetLateFeeInvoiceID(*v)
+	}
+	return _u
+}
+
+// ClearLateFeeInvoiceID clears the value of the "late_fee_invoice_id" field.
+func (_u *DunningNoticeUpdateOne) ClearLateFeeInvoiceID() *DunningNoticeUpdateOne {
+	_u.mutation.ClearLateFeeInvoiceID()
+	return _u
+}
+
+// Mutation returns the DunningNoticeMutation object of the builder.
+func (_u *DunningNoticeUpdateOne) Mutation() *DunningNoticeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DunningNoticeUpdate builder.
+func (_u *DunningNoticeUpdateOne) Where(ps ...predicate.DunningNotice) *DunningNoticeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DunningNoticeUpdateOne) Select(field string, fields ...string) *DunningNoticeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DunningNotice entity.
+func (_u *DunningNoticeUpdateOne) Save(ctx context.Context) (*DunningNotice, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DunningNoticeUpdateOne) SaveX(ctx context.Context) *DunningNotice {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DunningNoticeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DunningNoticeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DunningNoticeUpdateOne) sqlSave(ctx context.Context) (_node *DunningNotice, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dunningnotice.Table, dunningnotice.Columns, sqlgraph.NewFieldSpec(dunningnotice.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DunningNotice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dunningnotice.FieldID)
+		for _, f := range fields {
+			if !dunningnotice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dunningnotice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(dunningnotice.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.InvoiceID(); ok {
+		_spec.SetField(dunningnotice.FieldInvoiceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(dunningnotice.FieldCustomerID, field.TypeUUID, value)
+	}
+	if _u.mutation.CustomerIDCleared() {
+		_spec.ClearField(dunningnotice.FieldCustomerID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.OffsetDays(); ok {
+		_spec.SetField(dunningnotice.FieldOffsetDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOffsetDays(); ok {
+		_spec.AddField(dunningnotice.FieldOffsetDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(dunningnotice.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(dunningnotice.FieldChannel, field.TypeString, value)
+	}
+	if _u.mutation.ChannelCleared() {
+		_spec.ClearField(dunningnotice.FieldChannel, field.TypeString)
+	}
+	if value, ok := _u.mutation.LateFeeInvoiceID(); ok {
+		_spec.SetField(dunningnotice.FieldLateFeeInvoiceID, field.TypeUUID, value)
+	}
+	if _u.mutation.LateFeeInvoiceIDCleared() {
+		_spec.ClearField(dunningnotice.FieldLateFeeInvoiceID, field.TypeUUID)
+	}
+	_node = &DunningNotice{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dunningnotice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/dunningpause"
+	"github.com/google/uuid"
+)
+
+// DunningPause is the model entity for the DunningPause schema.
+type DunningPause struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Customer identifier
+	CustomerID uuid.UUID `json:"customer_id,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// User who paused dunning
+	PausedBy uuid.UUID `json:"paused_by,omitempty"`
+	// Dunning resumes automatically after this time (empty = until removed)
+	PausedUntil time.Time `json:"paused_until,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DunningPause) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dunningpause.FieldReason:
+			values[i] = new(sql.NullString)
+		case dunningpause.FieldPausedUntil, dunningpause.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case dunningpause.FieldID, dunningpause.FieldTenantID, dunningpause.FieldCustomerID, dunningpause.FieldPausedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DunningPause fields.
+func (_m *DunningPause) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dunningpause.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case dunningpause.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case dunningpause.FieldCustomerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value != nil {
+				_m.CustomerID = *value
+			}
+		case dunningpause.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case dunningpause.FieldPausedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field paused_by", values[i])
+			} else if value != nil {
+				_m.PausedBy = *value
+			}
+		case dunningpause.FieldPausedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paused_until", values[i])
+			} else if value.Valid {
+				_m.PausedUntil = value.Time
+			}
+		case dunningpause.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DunningPause.
+// This includes values selected through modifiers, order, etc.
+func (_m *DunningPause) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DunningPause.
+// Note that you need to call DunningPause.Unwrap() before calling this method if this DunningPause
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DunningPause) Update() *DunningPauseUpdateOne {
+	return NewDunningPauseClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DunningPause entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DunningPause) Unwrap() *DunningPause {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DunningPause is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DunningPause) String() string {
+	var builder strings.Builder
+	builder.WriteString("DunningPause(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("customer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CustomerID))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("paused_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PausedBy))
+	builder.WriteString(", ")
+	builder.WriteString("paused_until=")
+	builder.WriteString(_m.PausedUntil.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DunningPauses is a parsable slice of DunningPause.
+type DunningPauses []*DunningPause
@@ -0,0 +1,827 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/dunningpause"
+	"github.com/google/uuid"
+)
+
+// DunningPauseCreate is the builder for creating a DunningPause entity.
+type DunningPauseCreate struct {
+	config
+	mutation *DunningPauseMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *DunningPauseCreate) SetTenantID(v uuid.UUID) *DunningPauseCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_c *DunningPauseCreate) SetCustomerID(v uuid.UUID) *DunningPauseCreate {
+	_c.mutation.SetCustomerID(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *DunningPauseCreate) SetReason(v string) *DunningPauseCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *DunningPauseCreate) SetNillableReason(v *string) *DunningPauseCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetPausedBy sets the "paused_by" field.
+func (_c *DunningPauseCreate) SetPausedBy(v uuid.UUID) *DunningPauseCreate {
+	_c.mutation.SetPausedBy(v)
+	return _c
+}
+
+// SetNillablePausedBy sets the "paused_by" field if the given value is not nil.
+func (_c *DunningPauseCreate) SetNillablePausedBy(v *uuid.UUID) *DunningPauseCreate {
+	if v != nil {
+		_c.SetPausedBy(*v)
+	}
+	return _c
+}
+
+// SetPausedUntil sets the "paused_until" field.
+func (_c *DunningPauseCreate) SetPausedUntil(v time.Time) *DunningPauseCreate {
+	_c.mutation.SetPausedUntil(v)
+	return _c
+}
+
+// SetNillablePausedUntil sets the "paused_until" field if the given value is not nil.
+func (_c *DunningPauseCreate) SetNillablePausedUntil(v *time.Time) *DunningPauseCreate {
+	if v != nil {
+		_c.SetPausedUntil(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DunningPauseCreate) SetCreatedAt(v time.Time) *DunningPauseCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DunningPauseCreate) SetNillableCreatedAt(v *time.Time) *DunningPauseCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DunningPauseCreate) SetID(v uuid.UUID) *DunningPauseCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DunningPauseCreate) SetNillableID(v *uuid.UUID) *DunningPauseCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DunningPauseMutation object of the builder.
+func (_c *DunningPauseCreate) Mutation() *DunningPauseMutation {
+	return _c.mutation
+}
+
+// Save creates the DunningPause in the database.
+func (_c *DunningPauseCreate) Save(ctx context.Context) (*DunningPause, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DunningPauseCreate) SaveX(ctx context.Context) *DunningPause {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DunningPauseCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DunningPauseCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DunningPauseCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := dunningpause.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := dunningpause.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DunningPauseCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "DunningPause.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CustomerID(); !ok {
+		return &ValidationError{Name: "customer_id", err: errors.New(`ent: missing required field "DunningPause.customer_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DunningPause.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *DunningPauseCreate) sqlSave(ctx context.Context) (*DunningPause, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DunningPauseCreate) createSpec() (*DunningPause, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DunningPause{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(dunningpause.Table, sqlgraph.NewFieldSpec(dunningpause.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(dunningpause.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CustomerID(); ok {
+		_spec.SetField(dunningpause.FieldCustomerID, field.TypeUUID, value)
+		_node.CustomerID = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(dunningpause.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.PausedBy(); ok {
+		_spec.SetField(dunningpause.FieldPausedBy, field.TypeUUID, value)
+		_node.PausedBy = value
+	}
+	if value, ok := _c.mutation.PausedUntil(); ok {
+		_spec.SetField(dunningpause.FieldPausedUntil, field.TypeTime, value)
+		_node.PausedUntil = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(dunningpause.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DunningPause.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DunningPauseUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DunningPauseCreate) OnConflict(opts ...sql.ConflictOption) *DunningPauseUpsertOne {
+	_c.conflict = opts
+	return &DunningPauseUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DunningPause.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DunningPauseCreate) OnConflictColumns(columns ...string) *DunningPauseUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DunningPauseUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DunningPauseUpsertOne is the builder for "upsert"-ing
+	//  one DunningPause node.
+	DunningPauseUpsertOne struct {
+		create *DunningPauseCreate
+	}
+
+	// DunningPauseUpsert is the "OnConflict" setter.
+	DunningPauseUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *DunningPauseUpsert) SetTenantID(v uuid.UUID) *DunningPauseUpsert {
+	u.Set(dunningpause.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *DunningPauseUpsert) UpdateTenantID() *DunningPauseUpsert {
+	u.SetExcluded(dunningpause.FieldTenantID)
+	return u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *DunningPauseUpsert) SetCustomerID(v uuid.UUID) *DunningPauseUpsert {
+	u.Set(dunningpause.FieldCustomerID, v)
+	return u
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *DunningPauseUpsert) UpdateCustomerID() *DunningPauseUpsert {
+	u.SetExcluded(dunningpause.FieldCustomerID)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *DunningPauseUpsert) SetReason(v string) *DunningPauseUpsert {
+	u.Set(dunningpause.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *DunningPauseUpsert) UpdateReason() *DunningPauseUpsert {
+	u.SetExcluded(dunningpause.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *DunningPauseUpsert) ClearReason() *DunningPauseUpsert {
+	u.SetNull(dunningpause.FieldReason)
+	return u
+}
+
+// SetPausedBy sets the "paused_by" field.
+func (u *DunningPauseUpsert) SetPausedBy(v uuid.UUID) *DunningPauseUpsert {
+	u.Set(dunningpause.FieldPausedBy, v)
+	return u
+}
+
+// UpdatePausedBy sets the "paused_by" field to the value that was provided on create.
+func (u *DunningPauseUpsert) UpdatePausedBy() *DunningPauseUpsert {
+	u.SetExcluded(dunningpause.FieldPausedBy)
+	return u
+}
+
+// ClearPausedBy clears the value of the "paused_by" field.
+func (u *DunningPauseUpsert) ClearPausedBy() *DunningPauseUpsert {
+	u.SetNull(dunningpause.FieldPausedBy)
+	return u
+}
+
+// SetPausedUntil sets the "paused_until" field.
+func (u *DunningPauseUpsert) SetPausedUntil(v time.Time) *DunningPauseUpsert {
+	u.Set(dunningpause.FieldPausedUntil, v)
+	return u
+}
+
+// UpdatePausedUntil sets the "paused_until" field to the value that was provided on create.
+func (u *DunningPauseUpsert) UpdatePausedUntil() *DunningPauseUpsert {
+	u.SetExcluded(dunningpause.FieldPausedUntil)
+	return u
+}
+
+// ClearPausedUntil clears the value of the "paused_until" field.
+func (u *DunningPauseUpsert) ClearPausedUntil() *DunningPauseUpsert {
+	u.SetNull(dunningpause.FieldPausedUntil)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DunningPause.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dunningpause.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DunningPauseUpsertOne) UpdateNewValues() *DunningPauseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(dunningpause.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(dunningpause.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DunningPause.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DunningPauseUpsertOne) Ignore() *DunningPauseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DunningPauseUpsertOne) DoNothing() *DunningPauseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DunningPauseCreate.OnConflict
+// documentation for more info.
+func (u *DunningPauseUpsertOne) Update(set func(*DunningPauseUpsert)) *DunningPauseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DunningPauseUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *DunningPauseUpsertOne) SetTenantID(v uuid.UUID) *DunningPauseUpsertOne {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *DunningPauseUpsertOne) UpdateTenantID() *DunningPauseUpsertOne {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *DunningPauseUpsertOne) SetCustomerID(v uuid.UUID) *DunningPauseUpsertOne {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.SetCustomerID(v)
+	})
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *DunningPauseUpsertOne) UpdateCustomerID() *DunningPauseUpsertOne {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.UpdateCustomerID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *DunningPauseUpsertOne) SetReason(v string) *DunningPauseUpsertOne {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *DunningPauseUpsertOne) UpdateReason() *DunningPauseUpsertOne {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *DunningPauseUpsertOne) ClearReason() *DunningPauseUpsertOne {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetPausedBy sets the "paused_by" field.
+func (u *DunningPauseUpsertOne) SetPausedBy(v uuid.UUID) *DunningPauseUpsertOne {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.SetPausedBy(v)
+	})
+}
+
+// UpdatePausedBy sets the "paused_by" field to the value that was provided on create.
+func (u *DunningPauseUpsertOne) UpdatePausedBy() *DunningPauseUpsertOne {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.UpdatePausedBy()
+	})
+}
+
+// ClearPausedBy clears the value of the "paused_by" field.
+func (u *DunningPauseUpsertOne) ClearPausedBy() *DunningPauseUpsertOne {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.ClearPausedBy()
+	})
+}
+
+// SetPausedUntil sets the "paused_until" field.
+func (u *DunningPauseUpsertOne) SetPausedUntil(v time.Time) *DunningPauseUpsertOne {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.SetPausedUntil(v)
+	})
+}
+
+// UpdatePausedUntil sets the "paused_until" field to the value that was provided on create.
+func (u *DunningPauseUpsertOne) UpdatePausedUntil() *DunningPauseUpsertOne {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.UpdatePausedUntil()
+	})
+}
+
+// ClearPausedUntil clears the value of the "paused_until" field.
+func (u *DunningPauseUpsertOne) ClearPausedUntil() *DunningPauseUpsertOne {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.ClearPausedUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *DunningPauseUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DunningPauseCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DunningPauseUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DunningPauseUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DunningPauseUpsertOne.ID is not supported by MySQL driver. Use DunningPauseUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DunningPauseUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DunningPauseCreateBulk is the builder for creating many DunningPause entities in bulk.
+type DunningPauseCreateBulk struct {
+	config
+	err      error
+	builders []*DunningPauseCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DunningPause entities in the database.
+func (_c *DunningPauseCreateBulk) Save(ctx context.Context) ([]*DunningPause, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DunningPause, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DunningPauseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DunningPauseCreateBulk) SaveX(ctx context.Context) []*DunningPause {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DunningPauseCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DunningPauseCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DunningPause.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DunningPauseUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DunningPauseCreateBulk) OnConflict(opts ...sql.ConflictOption) *DunningPauseUpsertBulk {
+	_c.conflict = opts
+	return &DunningPauseUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DunningPause.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DunningPauseCreateBulk) OnConflictColumns(columns ...string) *DunningPauseUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DunningPauseUpsertBulk{
+		create: _c,
+	}
+}
+
+// DunningPauseUpsertBulk is the builder for "upsert"-ing
+// a bulk of DunningPause nodes.
+type DunningPauseUpsertBulk struct {
+	create *DunningPauseCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DunningPause.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dunningpause.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DunningPauseUpsertBulk) UpdateNewValues() *DunningPauseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(dunningpause.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(dunningpause.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DunningPause.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DunningPauseUpsertBulk) Ignore() *DunningPauseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DunningPauseUpsertBulk) DoNothing() *DunningPauseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DunningPauseCreateBulk.OnConflict
+// documentation for more info.
+func (u *DunningPauseUpsertBulk) Update(set func(*DunningPauseUpsert)) *DunningPauseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DunningPauseUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *DunningPauseUpsertBulk) SetTenantID(v uuid.UUID) *DunningPauseUpsertBulk {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *DunningPauseUpsertBulk) UpdateTenantID() *DunningPauseUpsertBulk {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *DunningPauseUpsertBulk) SetCustomerID(v uuid.UUID) *DunningPauseUpsertBulk {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.SetCustomerID(v)
+	})
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *DunningPauseUpsertBulk) UpdateCustomerID() *DunningPauseUpsertBulk {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.UpdateCustomerID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *DunningPauseUpsertBulk) SetReason(v string) *DunningPauseUpsertBulk {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *DunningPauseUpsertBulk) UpdateReason() *DunningPauseUpsertBulk {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *DunningPauseUpsertBulk) ClearReason() *DunningPauseUpsertBulk {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetPausedBy sets the "paused_by" field.
+func (u *DunningPauseUpsertBulk) SetPausedBy(v uuid.UUID) *DunningPauseUpsertBulk {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.SetPausedBy(v)
+	})
+}
+
+// UpdatePausedBy sets the "paused_by" field to the value that was provided on create.
+func (u *DunningPauseUpsertBulk) UpdatePausedBy() *DunningPauseUpsertBulk {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.UpdatePausedBy()
+	})
+}
+
+// ClearPausedBy clears the value of the "paused_by" field.
+func (u *DunningPauseUpsertBulk) ClearPausedBy() *DunningPauseUpsertBulk {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.ClearPausedBy()
+	})
+}
+
+// SetPausedUntil sets the "paused_until" field.
+func (u *DunningPauseUpsertBulk) SetPausedUntil(v time.Time) *DunningPauseUpsertBulk {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.SetPausedUntil(v)
+	})
+}
+
+// UpdatePausedUntil sets the "paused_until" field to the value that was provided on create.
+func (u *DunningPauseUpsertBulk) UpdatePausedUntil() *DunningPauseUpsertBulk {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.UpdatePausedUntil()
+	})
+}
+
+// ClearPausedUntil clears the value of the "paused_until" field.
+func (u *DunningPauseUpsertBulk) ClearPausedUntil() *DunningPauseUpsertBulk {
+	return u.Update(func(s *DunningPauseUpsert) {
+		s.ClearPausedUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *DunningPauseUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DunningPauseCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DunningPauseCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DunningPauseUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,401 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/dunningpause"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// DunningPauseUpdate is the builder for updating DunningPause entities.
+type DunningPauseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DunningPauseMutation
+}
+
+// Where appends a list predicates to the DunningPauseUpdate builder.
+func (_u *DunningPauseUpdate) Where(ps ...predicate.DunningPause) *DunningPauseUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *DunningPauseUpdate) SetTenantID(v uuid.UUID) *DunningPauseUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *DunningPauseUpdate) SetNillableTenantID(v *uuid.UUID) *DunningPauseUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *DunningPauseUpdate) SetCustomerID(v uuid.UUID) *DunningPauseUpdate {
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *DunningPauseUpdate) SetNillableCustomerID(v *uuid.UUID) *DunningPauseUpdate {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *DunningPauseUpdate) SetReason(v string) *DunningPauseUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *DunningPauseUpdate) SetNillableReason(v *string) *DunningPauseUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *DunningPauseUpdate) ClearReason() *DunningPauseUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetPausedBy sets the "paused_by" field.
+func (_u *DunningPauseUpdate) SetPausedBy(v uuid.UUID) *DunningPauseUpdate {
+	_u.mutation.SetPausedBy(v)
+	return _u
+}
+
+// SetNillablePausedBy sets the "paused_by" field if the given value is not nil.
+func (_u *DunningPauseUpdate) SetNillablePausedBy(v *uuid.UUID) *DunningPauseUpdate {
+	if v != nil {
+		_u.SetPausedBy(*v)
+	}
+	return _u
+}
+
+// ClearPausedBy clears the value of the "paused_by" field.
+func (_u *DunningPauseUpdate) ClearPausedBy() *DunningPauseUpdate {
+	_u.mutation.ClearPausedBy()
+	return _u
+}
+
+// SetPausedUntil sets the "paused_until" field.
+func (_u *DunningPauseUpdate) SetPausedUntil(v time.Time) *DunningPauseUpdate {
+	_u.mutation.SetPausedUntil(v)
+	return _u
+}
+
+// SetNillablePausedUntil sets the "paused_until" field if the given value is not nil.
+func (_u *DunningPauseUpdate) SetNillablePausedUntil(v *time.Time) *DunningPauseUpdate {
+	if v != nil {
+		_u.SetPausedUntil(*v)
+	}
+	return _u
+}
+
+// ClearPausedUntil clears the value of the "paused_until" field.
+func (_u *DunningPauseUpdate) ClearPausedUntil() *DunningPauseUpdate {
+	_u.mutation.ClearPausedUntil()
+	return _u
+}
+
+// Mutation returns the DunningPauseMutation object of the builder.
+func (_u *DunningPauseUpdate) Mutation() *DunningPauseMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DunningPauseUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DunningPauseUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DunningPauseUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DunningPauseUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DunningPauseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dunningpause.Table, dunningpause.Columns, sqlgraph.NewFieldSpec(dunningpause.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(dunningpause.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(dunningpause.FieldCustomerID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(dunningpause.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(dunningpause.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.PausedBy(); ok {
+		_spec.SetField(dunningpause.FieldPausedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.PausedByCleared() {
+		_spec.ClearField(dunningpause.FieldPausedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.PausedUntil(); ok {
+		_spec.SetField(dunningpause.FieldPausedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.PausedUntilCleared() {
+		_spec.ClearField(dunningpause.FieldPausedUntil, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dunningpause.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DunningPauseUpdateOne is the builder for updating a single DunningPause entity.
+type DunningPauseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DunningPauseMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *DunningPauseUpdateOne) SetTenantID(v uuid.UUID) *DunningPauseUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *DunningPauseUpdateOne) SetNillableTenantID(v *uuid.UUID) *DunningPauseUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *DunningPauseUpdateOne) SetCustomerID(v uuid.UUID) *DunningPauseUpdateOne {
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *DunningPauseUpdateOne) SetNillableCustomerID(v *uuid.UUID) *DunningPauseUpdateOne {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *DunningPauseUpdateOne) SetReason(v string) *DunningPauseUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *DunningPauseUpdateOne) SetNillableReason(v *string) *DunningPauseUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *DunningPauseUpdateOne) ClearReason() *DunningPauseUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetPausedBy sets the "paused_by" field.
+func (_u *DunningPauseUpdateOne) SetPausedBy(v uuid.UUID) *DunningPauseUpdateOne {
+	_u.mutation.SetPausedBy(v)
+	return _u
+}
+
+// SetNillablePausedBy sets the "paused_by" field if the given value is not nil.
+func (_u *DunningPauseUpdateOne) SetNillablePausedBy(v *uuid.UUID) *DunningPauseUpdateOne {
+	if v != nil {
+		_u.SetPausedBy(*v)
+	}
+	return _u
+}
+
+// ClearPausedBy clears the value of the "paused_by" field.
+func (_u *DunningPauseUpdateOne) ClearPausedBy() *DunningPauseUpdateOne {
+	_u.mutation.ClearPausedBy()
+	return _u
+}
+
+// SetPausedUntil sets the "paused_until" field.
+func (_u *DunningPauseUpdateOne) SetPausedUntil(v time.Time) *DunningPauseUpdateOne {
+	_u.mutation.SetPausedUntil(v)
+	return _u
+}
+
+// SetNillablePausedUntil sets the "paused_until" field if the given value is not nil.
+func (_u *DunningPauseUpdateOne) SetNillablePausedUntil(v *time.Time) *DunningPauseUpdateOne {
+	if v != nil {
+		_u.SetPausedUntil(*v)
+	}
+	return _u
+}
+
+// ClearPausedUntil clears the value of the "paused_until" field.
+func (_u *DunningPauseUpdateOne) ClearPausedUntil() *DunningPauseUpdateOne {
+	_u.mutation.ClearPausedUntil()
+	return _u
+}
+
+// Mutation returns the DunningPauseMutation object of the builder.
+func (_u *DunningPauseUpdateOne) Mutation() *DunningPauseMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DunningPauseUpdate builder.
+func (_u *DunningPauseUpdateOne) Where(ps ...predicate.DunningPause) *DunningPauseUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DunningPauseUpdateOne) Select(field string, fields ...string) *DunningPauseUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DunningPause entity.
+func (_u *DunningPauseUpdateOne) Save(ctx context.Context) (*DunningPause, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DunningPauseUpdateOne) SaveX(ctx context.Context) *DunningPause {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DunningPauseUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DunningPauseUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DunningPauseUpdateOne) sqlSave(ctx context.Context) (_node *DunningPause, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dunningpause.Table, dunningpause.Columns, sqlgraph.NewFieldSpec(dunningpause.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DunningPause.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dunningpause.FieldID)
+		for _, f := range fields {
+			if !dunningpause.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dunningpause.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(dunningpause.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(dunningpause.FieldCustomerID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(dunningpause.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(dunningpause.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.PausedBy(); ok {
+		_spec.SetField(dunningpause.FieldPausedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.PausedByCleared() {
+		_spec.ClearField(dunningpause.FieldPausedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.PausedUntil(); ok {
+		_spec.SetField(dunningpause.FieldPausedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.PausedUntilCleared() {
+		_spec.ClearField(dunningpause.FieldPausedUntil, field.TypeTime)
+	}
+	_node = &DunningPause{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dunningpause.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/dunningstep"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// DunningStep is the model entity for the DunningStep schema.
+type DunningStep struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Days relative to the due date (negative = before due)
+	OffsetDays int `json:"offset_days,omitempty"`
+	// Action: reminder, late_fee
+	Action string `json:"action,omitempty"`
+	// Notification channel hint for reminders (email, sms, whatsapp)
+	Channel string `json:"channel,omitempty"`
+	// Notification template code for reminders
+	Template string `json:"template,omitempty"`
+	// Late fee type: flat, percent
+	LateFeeType string `json:"late_fee_type,omitempty"`
+	// Flat fee, or the fraction of the outstanding balance for percent fees
+	LateFeeAmount decimal.Decimal `json:"late_fee_amount,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DunningStep) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dunningstep.FieldLateFeeAmount:
+			values[i] = new(decimal.Decimal)
+		case dunningstep.FieldOffsetDays:
+			values[i] = new(sql.NullInt64)
+		case dunningstep.FieldAction, dunningstep.FieldChannel, dunningstep.FieldTemplate, dunningstep.FieldLateFeeType:
+			values[i] = new(sql.NullString)
+		case dunningstep.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case dunningstep.FieldID, dunningstep.FieldTenantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DunningStep fields.
+func (_m *DunningStep) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dunningstep.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case dunningstep.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case dunningstep.FieldOffsetDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field offset_days", values[i])
+			} else if value.Valid {
+				_m.OffsetDays = int(value.Int64)
+			}
+		case dunningstep.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = value.String
+			}
+		case dunningstep.FieldChannel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field channel", values[i])
+			} else if value.Valid {
+				_m.Channel = value.String
+			}
+		case dunningstep.FieldTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field template", values[i])
+			} else if value.Valid {
+				_m.Template = value.String
+			}
+		case dunningstep.FieldLateFeeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field late_fee_type", values[i])
+			} else if value.Valid {
+				_m.LateFeeType = value.String
+			}
+		case dunningstep.FieldLateFeeAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field late_fee_amount", values[i])
+			} else if value != nil {
+				_m.LateFeeAmount = *value
+			}
+		case dunningstep.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DunningStep.
+// This includes values selected through modifiers, order, etc.
+func (_m *DunningStep) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DunningStep.
+// Note that you need to call DunningStep.Unwrap() before calling this method if this DunningStep
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DunningStep) Update() *DunningStepUpdateOne {
+	return NewDunningStepClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DunningStep entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DunningStep) Unwrap() *DunningStep {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DunningStep is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DunningStep) String() string {
+	var builder strings.Builder
+	builder.WriteString("DunningStep(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("offset_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OffsetDays))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(_m.Action)
+	builder.WriteString(", ")
+	builder.WriteString("channel=")
+	builder.WriteString(_m.Channel)
+	builder.WriteString(", ")
+	builder.WriteString("template=")
+	builder.WriteString(_m.Template)
+	builder.WriteString(", ")
+	builder.WriteString("late_fee_type=")
+	builder.WriteString(_m.LateFeeType)
+	builder.WriteString(", ")
+	builder.WriteString("late_fee_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LateFeeAmount))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DunningSteps is a parsable slice of DunningStep.
+type DunningSteps []*DunningStep
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dunningstep
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the dunningstep type in the database.
+	Label = "dunning_step"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldOffsetDays holds the string denoting the offset_days field in the database.
+	FieldOffsetDays = "offset_days"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldChannel holds the string denoting the channel field in the database.
+	FieldChannel = "channel"
+	// FieldTemplate holds the string denoting the template field in the database.
+	FieldTemplate = "template"
+	// FieldLateFeeType holds the string denoting the late_fee_type field in the database.
+	FieldLateFeeType = "late_fee_type"
+	// FieldLateFeeAmount holds the string denoting the late_fee_amount field in the database.
+	FieldLateFeeAmount = "late_fee_amount"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the dunningstep in the database.
+	Table = "dunning_steps"
+)
+
+// Columns holds all SQL columns for dunningstep fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldOffsetDays,
+	FieldAction,
+	FieldChannel,
+	FieldTemplate,
+	FieldLateFeeType,
+	FieldLateFeeAmount,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAction holds the default value on creation for the "action" field.
+	DefaultAction string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the DunningStep queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByOffsetDays orders the results by the offset_days field.
+func ByOffsetDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOffsetDays, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByChannel orders the results by the channel field.
+func ByChannel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChannel, opts...).ToFunc()
+}
+
+// ByTemplate orders the results by the template field.
+func ByTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTemplate, opts...).ToFunc()
+}
+
+// ByLateFeeType orders the results by the late_fee_type field.
+func ByLateFeeType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLateFeeType, opts...).ToFunc()
+}
+
+// ByLateFeeAmount orders the results by the late_fee_amount field.
+func ByLateFeeAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLateFeeAmount, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,572 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dunningstep
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldTenantID, v))
+}
+
+// OffsetDays applies equality check predicate on the "offset_days" field. It's identical to OffsetDaysEQ.
+func OffsetDays(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldOffsetDays, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldAction, v))
+}
+
+// Channel applies equality check predicate on the "channel" field. It's identical to ChannelEQ.
+func Channel(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldChannel, v))
+}
+
+// Template applies equality check predicate on the "template" field. It's identical to TemplateEQ.
+func Template(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldTemplate, v))
+}
+
+// LateFeeType applies equality check predicate on the "late_fee_type" field. It's identical to LateFeeTypeEQ.
+func LateFeeType(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldLateFeeType, v))
+}
+
+// LateFeeAmount applies equality check predicate on the "late_fee_amount" field. It's identical to LateFeeAmountEQ.
+func LateFeeAmount(v decimal.Decimal) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldLateFeeAmount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldTenantID, v))
+}
+
+// OffsetDaysEQ applies the EQ predicate on the "offset_days" field.
+func OffsetDaysEQ(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldOffsetDays, v))
+}
+
+// OffsetDaysNEQ applies the NEQ predicate on the "offset_days" field.
+func OffsetDaysNEQ(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldOffsetDays, v))
+}
+
+// OffsetDaysIn applies the In predicate on the "offset_days" field.
+func OffsetDaysIn(vs ...int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldOffsetDays, vs...))
+}
+
+// OffsetDaysNotIn applies the NotIn predicate on the "offset_days" field.
+func OffsetDaysNotIn(vs ...int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldOffsetDays, vs...))
+}
+
+// OffsetDaysGT applies the GT predicate on the "offset_days" field.
+func OffsetDaysGT(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldOffsetDays, v))
+}
+
+// OffsetDaysGTE applies the GTE predicate on the "offset_days" field.
+func OffsetDaysGTE(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldOffsetDays, v))
+}
+
+// OffsetDaysLT applies the LT predicate on the "offset_days" field.
+func OffsetDaysLT(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldOffsetDays, v))
+}
+
+// OffsetDaysLTE applies the LTE predicate on the "offset_days" field.
+func OffsetDaysLTE(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldOffsetDays, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContainsFold(FieldAction, v))
+}
+
+// ChannelEQ applies the EQ predicate on the "channel" field.
+func ChannelEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldChannel, v))
+}
+
+// ChannelNEQ applies the NEQ predicate on the "channel" field.
+func ChannelNEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldChannel, v))
+}
+
+// ChannelIn applies the In predicate on the "channel" field.
+func ChannelIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldChannel, vs...))
+}
+
+// ChannelNotIn applies the NotIn predicate on the "channel" field.
+func ChannelNotIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldChannel, vs...))
+}
+
+// ChannelGT applies the GT predicate on the "channel" field.
+func ChannelGT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldChannel, v))
+}
+
+// ChannelGTE applies the GTE predicate on the "channel" field.
+func ChannelGTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldChannel, v))
+}
+
+// ChannelLT applies the LT predicate on the "channel" field.
+func ChannelLT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldChannel, v))
+}
+
+// ChannelLTE applies the LTE predicate on the "channel" field.
+func ChannelLTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldChannel, v))
+}
+
+// ChannelContains applies the Contains predicate on the "channel" field.
+func ChannelContains(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContains(FieldChannel, v))
+}
+
+// ChannelHasPrefix applies the HasPrefix predicate on the "channel" field.
+func ChannelHasPrefix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasPrefix(FieldChannel, v))
+}
+
+// ChannelHasSuffix applies the HasSuffix predicate on the "channel" field.
+func ChannelHasSuffix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasSuffix(FieldChannel, v))
+}
+
+// ChannelIsNil applies the IsNil predicate on the "channel" field.
+func ChannelIsNil() predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIsNull(FieldChannel))
+}
+
+// ChannelNotNil applies the NotNil predicate on the "channel" field.
+func ChannelNotNil() predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotNull(FieldChannel))
+}
+
+// ChannelEqualFold applies the EqualFold predicate on the "channel" field.
+func ChannelEqualFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEqualFold(FieldChannel, v))
+}
+
+// ChannelContainsFold applies the ContainsFold predicate on the "channel" field.
+func ChannelContainsFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContainsFold(FieldChannel, v))
+}
+
+// TemplateEQ applies the EQ predicate on the "template" field.
+func TemplateEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldTemplate, v))
+}
+
+// TemplateNEQ applies the NEQ predicate on the "template" field.
+func TemplateNEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldTemplate, v))
+}
+
+// TemplateIn applies the In predicate on the "template" field.
+func TemplateIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldTemplate, vs...))
+}
+
+// TemplateNotIn applies the NotIn predicate on the "template" field.
+func TemplateNotIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldTemplate, vs...))
+}
+
+// TemplateGT applies the GT predicate on the "template" field.
+func TemplateGT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldTemplate, v))
+}
+
+// TemplateGTE applies the GTE predicate on the "template" field.
+func TemplateGTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldTemplate, v))
+}
+
+// TemplateLT applies the LT predicate on the "template" field.
+func TemplateLT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldTemplate, v))
+}
+
+// TemplateLTE applies the LTE predicate on the "template" field.
+func TemplateLTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldTemplate, v))
+}
+
+// TemplateContains applies the Contains predicate on the "template" field.
+func TemplateContains(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContains(FieldTemplate, v))
+}
+
+// TemplateHasPrefix applies the HasPrefix predicate on the "template" field.
+func TemplateHasPrefix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasPrefix(FieldTemplate, v))
+}
+
+// TemplateHasSuffix applies the HasSuffix predicate on the "template" field.
+func TemplateHasSuffix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasSuffix(FieldTemplate, v))
+}
+
+// TemplateIsNil applies the IsNil predicate on the "template" field.
+func TemplateIsNil() predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIsNull(FieldTemplate))
+}
+
+// TemplateNotNil applies the NotNil predicate on the "template" field.
+func TemplateNotNil() predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotNull(FieldTemplate))
+}
+
+// TemplateEqualFold applies the EqualFold predicate on the "template" field.
+func TemplateEqualFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEqualFold(FieldTemplate, v))
+}
+
+// TemplateContainsFold applies the ContainsFold predicate on the "template" field.
+func TemplateContainsFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContainsFold(FieldTemplate, v))
+}
+
+// LateFeeTypeEQ applies the EQ predicate on the "late_fee_type" field.
+func LateFeeTypeEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldLateFeeType, v))
+}
+
+// LateFeeTypeNEQ applies the NEQ predicate on the "late_fee_type" field.
+func LateFeeTypeNEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldLateFeeType, v))
+}
+
+// LateFeeTypeIn applies the In predicate on the "late_fee_type" field.
+func LateFeeTypeIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldLateFeeType, vs...))
+}
+
+// LateFeeTypeNotIn applies the NotIn predicate on the "late_fee_type" field.
+func LateFeeTypeNotIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldLateFeeType, vs...))
+}
+
+// LateFeeTypeGT applies the GT predicate on the "late_fee_type" field.
+func LateFeeTypeGT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldLateFeeType, v))
+}
+
+// LateFeeTypeGTE applies the GTE predicate on the "late_fee_type" field.
+func LateFeeTypeGTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldLateFeeType, v))
+}
+
+// LateFeeTypeLT applies the LT predicate on the "late_fee_type" field.
+func LateFeeTypeLT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldLateFeeType, v))
+}
+
+// LateFeeTypeLTE applies the LTE predicate on the "late_fee_type" field.
+func LateFeeTypeLTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldLateFeeType, v))
+}
+
+// LateFeeTypeContains applies the Contains predicate on the "late_fee_type" field.
+func LateFeeTypeContains(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContains(FieldLateFeeType, v))
+}
+
+// LateFeeTypeHasPrefix applies the HasPrefix predicate on the "late_fee_type" field.
+func LateFeeTypeHasPrefix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasPrefix(FieldLateFeeType, v))
+}
+
+// LateFeeTypeHasSuffix applies the HasSuffix predicate on the "late_fee_type" field.
+func LateFeeTypeHasSuffix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasSuffix(FieldLateFeeType, v))
+}
+
+// LateFeeTypeIsNil applies the IsNil predicate on the "late_fee_type" field.
+func LateFeeTypeIsNil() predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIsNull(FieldLateFeeType))
+}
+
+// LateFeeTypeNotNil applies the NotNil predicate on the "late_fee_type" field.
+func LateFeeTypeNotNil() predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotNull(FieldLateFeeType))
+}
+
+// LateFeeTypeEqualFold applies the EqualFold predicate on the "late_fee_type" field.
+func LateFeeTypeEqualFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEqualFold(FieldLateFeeType, v))
+}
+
+// LateFeeTypeContainsFold applies the ContainsFold predicate on the "late_fee_type" field.
+func LateFeeTypeContainsFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContainsFold(FieldLateFeeType, v))
+}
+
+// LateFeeAmountEQ applies the EQ predicate on the "late_fee_amount" field.
+func LateFeeAmountEQ(v decimal.Decimal) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldLateFeeAmount, v))
+}
+
+// LateFeeAmountNEQ applies the NEQ predicate on the "late_fee_amount" field.
+func LateFeeAmountNEQ(v decimal.Decimal) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldLateFeeAmount, v))
+}
+
+// LateFeeAmountIn applies the In predicate on the "late_fee_amount" field.
+func LateFeeAmountIn(vs ...decimal.Decimal) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldLateFeeAmount, vs...))
+}
+
+// LateFeeAmountNotIn applies the NotIn predicate on the "late_fee_amount" field.
+func LateFeeAmountNotIn(vs ...decimal.Decimal) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldLateFeeAmount, vs...))
+}
+
+// LateFeeAmountGT applies the GT predicate on the "late_fee_amount" field.
+func LateFeeAmountGT(v decimal.Decimal) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldLateFeeAmount, v))
+}
+
+// LateFeeAmountGTE applies the GTE predicate on the "late_fee_amount" field.
+func LateFeeAmountGTE(v decimal.Decimal) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldLateFeeAmount, v))
+}
+
+// LateFeeAmountLT applies the LT predicate on the "late_fee_amount" field.
+func LateFeeAmountLT(v decimal.Decimal) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldLateFeeAmount, v))
+}
+
+// LateFeeAmountLTE applies the LTE predicate on the "late_fee_amount" field.
+func LateFeeAmountLTE(v decimal.Decimal) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldLateFeeAmount, v))
+}
+
+// LateFeeAmountIsNil applies the IsNil predicate on the "late_fee_amount" field.
+func LateFeeAmountIsNil() predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIsNull(FieldLateFeeAmount))
+}
+
+// LateFeeAmountNotNil applies the NotNil predicate on the "late_fee_amount" field.
+func LateFeeAmountNotNil() predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotNull(FieldLateFeeAmount))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DunningStep) predicate.DunningStep {
+	return predicate.DunningStep(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DunningStep) predicate.DunningStep {
+	return predicate.DunningStep(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DunningStep) predicate.DunningStep {
+	return predicate.DunningStep(sql.NotPredicates(p))
+}
@@ -0,0 +1,1011 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/dunningstep"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// DunningStepCreate is the builder for creating a DunningStep entity.
+type DunningStepCreate struct {
+	config
+	mutation *DunningStepMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *DunningStepCreate) SetTenantID(v uuid.UUID) *DunningStepCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetOffsetDays sets the "offset_days" field.
+func (_c *DunningStepCreate) SetOffsetDays(v int) *DunningStepCreate {
+	_c.mutation.SetOffsetDays(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *DunningStepCreate) SetAction(v string) *DunningStepCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_c *DunningStepCreate) SetNillableAction(v *string) *DunningStepCreate {
+	if v != nil {
+		_c.SetAction(*v)
+	}
+	return _c
+}
+
+// SetChannel sets the "channel" field.
+func (_c *DunningStepCreate) SetChannel(v string) *DunningStepCreate {
+	_c.mutation.SetChannel(v)
+	return _c
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_c *DunningStepCreate) SetNillableChannel(v *string) *DunningStepCreate {
+	if v != nil {
+		_c.SetChannel(*v)
+	}
+	return _c
+}
+
+// SetTemplate sets the "template" field.
+func (_c *DunningStepCreate) SetTemplate(v string) *DunningStepCreate {
+	_c.mutation.SetTemplate(v)
+	return _c
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (_c *DunningStepCreate) SetNillableTemplate(v *string) *DunningStepCreate {
+	if v != nil {
+		_c.SetTemplate(*v)
+	}
+	return _c
+}
+
+// SetLateFeeType sets the "late_fee_type" field.
+func (_c *DunningStepCreate) SetLateFeeType(v string) *DunningStepCreate {
+	_c.mutation.SetLateFeeType(v)
+	return _c
+}
+
+// SetNillableLateFeeType sets the "late_fee_type" field if the given value is not nil.
+func (_c *DunningStepCreate) SetNillableLateFeeType(v *string) *DunningStepCreate {
+	if v != nil {
+		_c.SetLateFeeType(*v)
+	}
+	return _c
+}
+
+// SetLateFeeAmount sets the "late_fee_amount" field.
+func (_c *DunningStepCreate) SetLateFeeAmount(v decimal.Decimal) *DunningStepCreate {
+	_c.mutation.SetLateFeeAmount(v)
+	return _c
+}
+
+// SetNillableLateFeeAmount sets the "late_fee_amount" field if the given value is not nil.
+func (_c *DunningStepCreate) SetNillableLateFeeAmount(v *decimal.Decimal) *DunningStepCreate {
+	if v != nil {
+		_c.SetLateFeeAmount(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DunningStepCreate) SetCreatedAt(v time.Time) *DunningStepCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DunningStepCreate) SetNillableCreatedAt(v *time.Time) *DunningStepCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DunningStepCreate) SetID(v uuid.UUID) *DunningStepCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DunningStepCreate) SetNillableID(v *uuid.UUID) *DunningStepCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DunningStepMutation object of the builder.
+func (_c *DunningStepCreate) Mutation() *DunningStepMutation {
+	return _c.mutation
+}
+
+// Save creates the DunningStep in the database.
+func (_c *DunningStepCreate) Save(ctx context.Context) (*DunningStep, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DunningStepCreate) SaveX(ctx context.Context) *DunningStep {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DunningStepCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DunningStepCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DunningStepCreate) defaults() {
+	if _, ok := _c.mutation.Action(); !ok {
+		v := dunningstep.DefaultAction
+		_c.mutation.SetAction(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := dunningstep.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := dunningstep.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DunningStepCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "DunningStep.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.OffsetDays(); !ok {
+		return &ValidationError{Name: "offset_days", err: errors.New(`ent: missing required field "DunningStep.offset_days"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "DunningStep.action"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DunningStep.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *DunningStepCreate) sqlSave(ctx context.Context) (*DunningStep, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DunningStepCreate) createSpec() (*DunningStep, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DunningStep{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(dunningstep.Table, sqlgraph.NewFieldSpec(dunningstep.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(dunningstep.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.OffsetDays(); ok {
+		_spec.SetField(dunningstep.FieldOffsetDays, field.TypeInt, value)
+		_node.OffsetDays = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(dunningstep.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Channel(); ok {
+		_spec.SetField(dunningstep.FieldChannel, field.TypeString, value)
+		_node.Channel = value
+	}
+	if value, ok := _c.mutation.Template(); ok {
+		_spec.SetField(dunningstep.FieldTemplate, field.TypeString, value)
+		_node.Template = value
+	}
+	if value, ok := _c.mutation.LateFeeType(); ok {
+		_spec.SetField(dunningstep.FieldLateFeeType, field.TypeString, value)
+		_node.LateFeeType = value
+	}
+	if value, ok := _c.mutation.LateFeeAmount(); ok {
+		_spec.SetField(dunningstep.FieldLateFeeAmount, field.TypeFloat64, value)
+		_node.LateFeeAmount = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(dunningstep.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DunningStep.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DunningStepUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DunningStepCreate) OnConflict(opts ...sql.ConflictOption) *DunningStepUpsertOne {
+	_c.conflict = opts
+	return &DunningStepUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DunningStep.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DunningStepCreate) OnConflictColumns(columns ...string) *DunningStepUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DunningStepUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DunningStepUpsertOne is the builder for "upsert"-ing
+	//  one DunningStep node.
+	DunningStepUpsertOne struct {
+		create *DunningStepCreate
+	}
+
+	// DunningStepUpsert is the "OnConflict" setter.
+	DunningStepUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *DunningStepUpsert) SetTenantID(v uuid.UUID) *DunningStepUpsert {
+	u.Set(dunningstep.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *DunningStepUpsert) UpdateTenantID() *DunningStepUpsert {
+	u.SetExcluded(dunningstep.FieldTenantID)
+	return u
+}
+
+// SetOffsetDays sets the "offset_days" field.
+func (u *DunningStepUpsert) SetOffsetDays(v int) *DunningStepUpsert {
+	u.Set(dunningstep.FieldOffsetDays, v)
+	return u
+}
+
+// UpdateOffsetDays sets the "offset_days" field to the value that was provided on create.
+func (u *DunningStepUpsert) UpdateOffsetDays() *DunningStepUpsert {
+	u.SetExcluded(dunningstep.FieldOffsetDays)
+	return u
+}
+
+// AddOffsetDays adds v to the "offset_days" field.
+func (u *DunningStepUpsert) AddOffsetDays(v int) *DunningStepUpsert {
+	u.Add(dunningstep.FieldOffsetDays, v)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *DunningStepUpsert) SetAction(v string) *DunningStepUpsert {
+	u.Set(dunningstep.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *DunningStepUpsert) UpdateAction() *DunningStepUpsert {
+	u.SetExcluded(dunningstep.FieldAction)
+	return u
+}
+
+// SetChannel sets the "channel" field.
+func (u *DunningStepUpsert) SetChannel(v string) *DunningStepUpsert {
+	u.Set(dunningstep.FieldChannel, v)
+	return u
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *DunningStepUpsert) UpdateChannel() *DunningStepUpsert {
+	u.SetExcluded(dunningstep.FieldChannel)
+	return u
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (u *DunningStepUpsert) ClearChannel() *DunningStepUpsert {
+	u.SetNull(dunningstep.FieldChannel)
+	return u
+}
+
+// SetTemplate sets the "template" field.
+func (u *DunningStepUpsert) SetTemplate(v string) *DunningStepUpsert {
+	u.Set(dunningstep.FieldTemplate, v)
+	return u
+}
+
+// UpdateTemplate sets the "template" field to the value that was provided on create.
+func (u *DunningStepUpsert) UpdateTemplate() *DunningStepUpsert {
+	u.SetExcluded(dunningstep.FieldTemplate)
+	return u
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (u *DunningStepUpsert) ClearTemplate() *DunningStepUpsert {
+	u.SetNull(dunningstep.FieldTemplate)
+	return u
+}
+
+// SetLateFeeType sets the "late_fee_type" field.
+func (u *DunningStepUpsert) SetLateFeeType(v string) *DunningStepUpsert {
+	u.Set(dunningstep.FieldLateFeeType, v)
+	return u
+}
+
+// UpdateLateFeeType sets the "late_fee_type" field to the value that was provided on create.
+func (u *DunningStepUpsert) UpdateLateFeeType() *DunningStepUpsert {
+	u.SetExcluded(dunningstep.FieldLateFeeType)
+	return u
+}
+
+// ClearLateFeeType clears the value of the "late_fee_type" field.
+func (u *DunningStepUpsert) ClearLateFeeType() *DunningStepUpsert {
+	u.SetNull(dunningstep.FieldLateFeeType)
+	return u
+}
+
+// SetLateFeeAmount sets the "late_fee_amount" field.
+func (u *DunningStepUpsert) SetLateFeeAmount(v decimal.Decimal) *DunningStepUpsert {
+	u.Set(dunningstep.FieldLateFeeAmount, v)
+	return u
+}
+
+// UpdateLateFeeAmount sets the "late_fee_amount" field to the value that was provided on create.
+func (u *DunningStepUpsert) UpdateLateFeeAmount() *DunningStepUpsert {
+	u.SetExcluded(dunningstep.FieldLateFeeAmount)
+	return u
+}
+
+// AddLateFeeAmount adds v to the "late_fee_amount" field.
+func (u *DunningStepUpsert) AddLateFeeAmount(v decimal.Decimal) *DunningStepUpsert {
+	u.Add(dunningstep.FieldLateFeeAmount, v)
+	return u
+}
+
+// ClearLateFeeAmount clears the value of the "late_fee_amount" field.
+func (u *DunningStepUpsert) ClearLateFeeAmount() *DunningStepUpsert {
+	u.SetNull(dunningstep.FieldLateFeeAmount)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DunningStep.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dunningstep.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DunningStepUpsertOne) UpdateNewValues() *DunningStepUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(dunningstep.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(dunningstep.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DunningStep.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DunningStepUpsertOne) Ignore() *DunningStepUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DunningStepUpsertOne) DoNothing() *DunningStepUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DunningStepCreate.OnConflict
+// documentation for more info.
+func (u *DunningStepUpsertOne) Update(set func(*DunningStepUpsert)) *DunningStepUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DunningStepUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *DunningStepUpsertOne) SetTenantID(v uuid.UUID) *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *DunningStepUpsertOne) UpdateTenantID() *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetOffsetDays sets the "offset_days" field.
+func (u *DunningStepUpsertOne) SetOffsetDays(v int) *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.SetOffsetDays(v)
+	})
+}
+
+// AddOffsetDays adds v to the "offset_days" field.
+func (u *DunningStepUpsertOne) AddOffsetDays(v int) *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.AddOffsetDays(v)
+	})
+}
+
+// UpdateOffsetDays sets the "offset_days" field to the value that was provided on create.
+func (u *DunningStepUpsertOne) UpdateOffsetDays() *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.UpdateOffsetDays()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *DunningStepUpsertOne) SetAction(v string) *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *DunningStepUpsertOne) UpdateAction() *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetChannel sets the "channel" field.
+func (u *DunningStepUpsertOne) SetChannel(v string) *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.SetChannel(v)
+	})
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *DunningStepUpsertOne) UpdateChannel() *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.UpdateChannel()
+	})
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (u *DunningStepUpsertOne) ClearChannel() *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.ClearChannel()
+	})
+}
+
+// SetTemplate sets the "template" field.
+func (u *DunningStepUpsertOne) SetTemplate(v string) *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.SetTemplate(v)
+	})
+}
+
+// UpdateTemplate sets the "template" field to the value that was provided on create.
+func (u *DunningStepUpsertOne) UpdateTemplate() *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.UpdateTemplate()
+	})
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (u *DunningStepUpsertOne) ClearTemplate() *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.ClearTemplate()
+	})
+}
+
+// SetLateFeeType sets the "late_fee_type" field.
+func (u *DunningStepUpsertOne) SetLateFeeType(v string) *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.SetLateFeeType(v)
+	})
+}
+
+// UpdateLateFeeType sets the "late_fee_type" field to the value that was provided on create.
+func (u *DunningStepUpsertOne) UpdateLateFeeType() *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.UpdateLateFeeType()
+	})
+}
+
+// ClearLateFeeType clears the value of the "late_fee_type" field.
+func (u *DunningStepUpsertOne) ClearLateFeeType() *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.ClearLateFeeType()
+	})
+}
+
+// SetLateFeeAmount sets the "late_fee_amount" field.
+func (u *DunningStepUpsertOne) SetLateFeeAmount(v decimal.Decimal) *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.SetLateFeeAmount(v)
+	})
+}
+
+// AddLateFeeAmount adds v to the "late_fee_amount" field.
+func (u *DunningStepUpsertOne) AddLateFeeAmount(v decimal.Decimal) *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.AddLateFeeAmount(v)
+	})
+}
+
+// UpdateLateFeeAmount sets the "late_fee_amount" field to the value that was provided on create.
+func (u *DunningStepUpsertOne) UpdateLateFeeAmount() *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.UpdateLateFeeAmount()
+	})
+}
+
+// ClearLateFeeAmount clears the value of the "late_fee_amount" field.
+func (u *DunningStepUpsertOne) ClearLateFeeAmount() *DunningStepUpsertOne {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.ClearLateFeeAmount()
+	})
+}
+
+// Exec executes the query.
+func (u *DunningStepUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DunningStepCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DunningStepUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DunningStepUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DunningStepUpsertOne.ID is not supported by MySQL driver. Use DunningStepUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DunningStepUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DunningStepCreateBulk is the builder for creating many DunningStep entities in bulk.
+type DunningStepCreateBulk struct {
+	config
+	err      error
+	builders []*DunningStepCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DunningStep entities in the database.
+func (_c *DunningStepCreateBulk) Save(ctx context.Context) ([]*DunningStep, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DunningStep, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DunningStepMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DunningStepCreateBulk) SaveX(ctx context.Context) []*DunningStep {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DunningStepCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DunningStepCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DunningStep.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DunningStepUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DunningStepCreateBulk) OnConflict(opts ...sql.ConflictOption) *DunningStepUpsertBulk {
+	_c.conflict = opts
+	return &DunningStepUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DunningStep.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DunningStepCreateBulk) OnConflictColumns(columns ...string) *DunningStepUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DunningStepUpsertBulk{
+		create: _c,
+	}
+}
+
+// DunningStepUpsertBulk is the builder for "upsert"-ing
+// a bulk of DunningStep nodes.
+type DunningStepUpsertBulk struct {
+	create *DunningStepCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DunningStep.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dunningstep.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DunningStepUpsertBulk) UpdateNewValues() *DunningStepUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(dunningstep.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(dunningstep.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DunningStep.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DunningStepUpsertBulk) Ignore() *DunningStepUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DunningStepUpsertBulk) DoNothing() *DunningStepUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DunningStepCreateBulk.OnConflict
+// documentation for more info.
+func (u *DunningStepUpsertBulk) Update(set func(*DunningStepUpsert)) *DunningStepUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DunningStepUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *DunningStepUpsertBulk) SetTenantID(v uuid.UUID) *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *DunningStepUpsertBulk) UpdateTenantID() *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetOffsetDays sets the "offset_days" field.
+func (u *DunningStepUpsertBulk) SetOffsetDays(v int) *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.SetOffsetDays(v)
+	})
+}
+
+// AddOffsetDays adds v to the "offset_days" field.
+func (u *DunningStepUpsertBulk) AddOffsetDays(v int) *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.AddOffsetDays(v)
+	})
+}
+
+// UpdateOffsetDays sets the "offset_days" field to the value that was provided on create.
+func (u *DunningStepUpsertBulk) UpdateOffsetDays() *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.UpdateOffsetDays()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *DunningStepUpsertBulk) SetAction(v string) *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *DunningStepUpsertBulk) UpdateAction() *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetChannel sets the "channel" field.
+func (u *DunningStepUpsertBulk) SetChannel(v string) *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.SetChannel(v)
+	})
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *DunningStepUpsertBulk) UpdateChannel() *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.UpdateChannel()
+	})
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (u *DunningStepUpsertBulk) ClearChannel() *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.ClearChannel()
+	})
+}
+
+// SetTemplate sets the "template" field.
+func (u *DunningStepUpsertBulk) SetTemplate(v string) *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.SetTemplate(v)
+	})
+}
+
+// UpdateTemplate sets the "template" field to the value that was provided on create.
+func (u *DunningStepUpsertBulk) UpdateTemplate() *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.UpdateTemplate()
+	})
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (u *DunningStepUpsertBulk) ClearTemplate() *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.ClearTemplate()
+	})
+}
+
+// SetLateFeeType sets the "late_fee_type" field.
+func (u *DunningStepUpsertBulk) SetLateFeeType(v string) *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.SetLateFeeType(v)
+	})
+}
+
+// UpdateLateFeeType sets the "late_fee_type" field to the value that was provided on create.
+func (u *DunningStepUpsertBulk) UpdateLateFeeType() *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.UpdateLateFeeType()
+	})
+}
+
+// ClearLateFeeType clears the value of the "late_fee_type" field.
+func (u *DunningStepUpsertBulk) ClearLateFeeType() *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.ClearLateFeeType()
+	})
+}
+
+// SetLateFeeAmount sets the "late_fee_amount" field.
+func (u *DunningStepUpsertBulk) SetLateFeeAmount(v decimal.Decimal) *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.SetLateFeeAmount(v)
+	})
+}
+
+// AddLateFeeAmount adds v to the "late_fee_amount" field.
+func (u *DunningStepUpsertBulk) AddLateFeeAmount(v decimal.Decimal) *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.AddLateFeeAmount(v)
+	})
+}
+
+// UpdateLateFeeAmount sets the "late_fee_amount" field to the value that was provided on create.
+func (u *DunningStepUpsertBulk) UpdateLateFeeAmount() *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.UpdateLateFeeAmount()
+	})
+}
+
+// ClearLateFeeAmount clears the value of the "late_fee_amount" field.
+func (u *DunningStepUpsertBulk) ClearLateFeeAmount() *DunningStepUpsertBulk {
+	return u.Update(func(s *DunningStepUpsert) {
+		s.ClearLateFeeAmount()
+	})
+}
+
+// Exec executes the query.
+func (u *DunningStepUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DunningStepCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DunningStepCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DunningStepUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/dunningstep"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// DunningStepUpdate is the builder for updating DunningStep entities.
+type DunningStepUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DunningStepMutation
+}
+
+// Where appends a list predicates to the DunningStepUpdate builder.
+func (_u *DunningStepUpdate) Where(ps ...predicate.DunningStep) *DunningStepUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *DunningStepUpdate) SetTenantID(v uuid.UUID) *DunningStepUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *DunningStepUpdate) SetNillableTenantID(v *uuid.UUID) *DunningStepUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetOffsetDays sets the "offset_days" field.
+func (_u *DunningStepUpdate) SetOffsetDays(v int) *DunningStepUpdate {
+	_u.mutation.ResetOffsetDays()
+	_u.mutation.SetOffsetDays(v)
+	return _u
+}
+
+// SetNillableOffsetDays sets the "offset_days" field if the given value is not nil.
+func (_u *DunningStepUpdate) SetNillableOffsetDays(v *int) *DunningStepUpdate {
+	if v != nil {
+		_u.SetOffsetDays(*v)
+	}
+	return _u
+}
+
+// AddOffsetDays adds value to the "offset_days" field.
+func (_u *DunningStepUpdate) AddOffsetDays(v int) *DunningStepUpdate {
+	_u.mutation.AddOffsetDays(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *DunningStepUpdate) SetAction(v string) *DunningStepUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *DunningStepUpdate) SetNillableAction(v *string) *DunningStepUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *DunningStepUpdate) SetChannel(v string) *DunningStepUpdate {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *DunningStepUpdate) SetNillableChannel(v *string) *DunningStepUpdate {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (_u *DunningStepUpdate) ClearChannel() *DunningStepUpdate {
+	_u.mutation.ClearChannel()
+	return _u
+}
+
+// SetTemplate sets the "template" field.
+func (_u *DunningStepUpdate) SetTemplate(v string) *DunningStepUpdate {
+	_u.mutation.SetTemplate(v)
+	return _u
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (_u *DunningStepUpdate) SetNillableTemplate(v *string) *DunningStepUpdate {
+	if v != nil {
+		_u.SetTemplate(*v)
+	}
+	return _u
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (_u *DunningStepUpdate) ClearTemplate() *DunningStepUpdate {
+	_u.mutation.ClearTemplate()
+	return _u
+}
+
+// SetLateFeeType sets the "late_fee_type" field.
+func (_u *DunningStepUpdate) SetLateFeeType(v string) *DunningStepUpdate {
+	_u.mutation.SetLateFeeType(v)
+	return _u
+}
+
+// SetNillableLateFeeType sets the "late_fee_type" field if the given value is not nil.
+func (_u *DunningStepUpdate) SetNillableLateFeeType(v *string) *DunningStepUpdate {
+	if v != nil {
+		_u.SetLateFeeType(*v)
+	}
+	return _u
+}
+
+// ClearLateFeeType clears the value of the "late_fee_type" field.
+func (_u *DunningStepUpdate) ClearLateFeeType() *DunningStepUpdate {
+	_u.mutation.ClearLateFeeType()
+	return _u
+}
+
+// SetLateFeeAmount sets the "late_fee_amount" field.
+func (_u *DunningStepUpdate) SetLateFeeAmount(v decimal.Decimal) *DunningStepUpdate {
+	_u.mutation.ResetLateFeeAmount()
+	_u.mutation.SetLateFeeAmount(v)
+	return _u
+}
+
+// SetNillableLateFeeAmount sets the "late_fee_amount" field if the given value is not nil.
+func (_u *DunningStepUpdate) SetNillableLateFeeAmount(v *decimal.Decimal) *DunningStepUpdate {
+	if v != nil {
+		_u.SetLateFeeAmount(*v)
+	}
+	return _u
+}
+
+// AddLateFeeAmount adds value to the "late_fee_amount" field.
+func (_u *DunningStepUpdate) AddLateFeeAmount(v decimal.Decimal) *DunningStepUpdate {
+	_u.mutation.AddLateFeeAmount(v)
+	return _u
+}
+
+// ClearLateFeeAmount clears the value of the "late_fee_amount" field.
+func (_u *DunningStepUpdate) ClearLateFeeAmount() *DunningStepUpdate {
+	_u.mutation.ClearLateFeeAmount()
+	return _u
+}
+
+// Mutation returns the DunningStepMutation object of the builder.
+func (_u *DunningStepUpdate) Mutation() *DunningStepMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DunningStepUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DunningStepUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DunningStepUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DunningStepUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DunningStepUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dunningstep.Table, dunningstep.Columns, sqlgraph.NewFieldSpec(dunningstep.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(dunningstep.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.OffsetDays(); ok {
+		_spec.SetField(dunningstep.FieldOffsetDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOffsetDays(); ok {
+		_spec.AddField(dunningstep.FieldOffsetDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(dunningstep.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(dunningstep.FieldChannel, field.TypeString, value)
+	}
+	if _u.mutation.ChannelCleared() {
+		_spec.ClearField(dunningstep.FieldChannel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Template(); ok {
+		_spec.SetField(dunningstep.FieldTemplate, field.TypeString, value)
+	}
+	if _u.mutation.TemplateCleared() {
+		_spec.ClearField(dunningstep.FieldTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.LateFeeType(); ok {
+		_spec.SetField(dunningstep.FieldLateFeeType, field.TypeString, value)
+	}
+	if _u.mutation.LateFeeTypeCleared() {
+		_spec.ClearField(dunningstep.FieldLateFeeType, field.TypeString)
+	}
+	if value, ok := _u.mutation.LateFeeAmount(); ok {
+		_spec.SetField(dunningstep.FieldLateFeeAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLateFeeAmount(); ok {
+		_spec.AddField(dunningstep.FieldLateFeeAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.LateFeeAmountCleared() {
+		_spec.ClearField(dunningstep.FieldLateFeeAmount, field.TypeFloat64)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dunningstep.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DunningStepUpdateOne is the builder for updating a single DunningStep entity.
+type DunningStepUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DunningStepMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *DunningStepUpdateOne) SetTenantID(v uuid.UUID) *DunningStepUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *DunningStepUpdateOne) SetNillableTenantID(v *uuid.UUID) *DunningStepUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetOffsetDays sets the "offset_days" field.
+func (_u *DunningStepUpdateOne) SetOffsetDays(v int) *DunningStepUpdateOne {
+	_u.mutation.ResetOffsetDays()
+	_u.mutation.SetOffsetDays(v)
+	return _u
+}
+
+// SetNillableOffsetDays sets the "offset_days" field if the given value is not nil.
+func (_u *DunningStepUpdateOne) SetNillableOffsetDays(v *int) *DunningStepUpdateOne {
+	if v != nil {
+		_u.SetOffsetDays(*v)
+	}
+	return _u
+}
+
+// AddOffsetDays adds value to the "offset_days" field.
+func (_u *DunningStepUpdateOne) AddOffsetDays(v int) *DunningStepUpdateOne {
+	_u.mutation.AddOffsetDays(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *DunningStepUpdateOne) SetAction(v string) *DunningStepUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *DunningStepUpdateOne) SetNillableAction(v *string) *DunningStepUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *DunningStepUpdateOne) SetChannel(v string) *DunningStepUpdateOne {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *DunningStepUpdateOne) SetNillableChannel(v *string) *DunningStepUpdateOne {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (_u *DunningStepUpdateOne) ClearChannel() *DunningStepUpdateOne {
+	_u.mutation.ClearChannel()
+	return _u
+}
+
+// SetTemplate sets the "template" field.
+func (_u *DunningStepUpdateOne) SetTemplate(v string) *DunningStepUpdateOne {
+	_u.mutation.SetTemplate(v)
+	return _u
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (_u *DunningStepUpdateOne) SetNillableTemplate(v *string) *DunningStepUpdateOne {
+	if v != nil {
+		_u.SetTemplate(*v)
+	}
+	return _u
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (_u *DunningStepUpdateOne) ClearTemplate() *DunningStepUpdateOne {
+	_u.mutation.ClearTemplate()
+	return _u
+}
+
+// SetLateFeeType sets the "late_fee_type" field.
+func (_u *DunningStepUpdateOne) SetLateFeeType(v string) *DunningStepUpdateOne {
+	_u.mutation.SetLateFeeType(v)
+	return _u
+}
+
+// SetNillableLateFeeType sets the "late_fee_type" field if the given value is not nil.
+func (_u *DunningStepUpdateOne) SetNillableLateFeeType(v *string) *DunningStepUpdateOne {
+	if v != nil {
+		_u.SetLateFeeType(*v)
+	}
+	return _u
+}
+
+// ClearLateFeeType clears the value of the "late_fee_type" field.
+func (_u *DunningStepUpdateOne) ClearLateFeeType() *DunningStepUpdateOne {
+	_u.mutation.ClearLateFeeType()
+	return _u
+}
+
+// SetLateFeeAmount sets the "late_fee_amount" field.
+func (_u *DunningStepUpdateOne) SetLateFeeAmount(v decimal.Decimal) *DunningStepUpdateOne {
+	_u.mutation.ResetLateFeeAmount()
+	_u.mutation.SetLateFeeAmount(v)
+	return _u
+}
+
+// SetNillableLateFeeAmount sets the "late_fee_amount" field if the given value is not nil.
+func (_u *DunningStepUpdateOne) SetNillableLateFeeAmount(v *decimal.Decimal) *DunningStepUpdateOne {
+	if v != nil {
+		_u.SetLateFeeAmount(*v)
+	}
+	return _u
+}
+
+// AddLateFeeAmount adds value to the "late_fee_amount" field.
+func (_u *DunningStepUpdateOne) AddLateFeeAmount(v decimal.Decimal) *DunningStepUpdateOne {
+	_u.mutation.AddLateFeeAmount(v)
+	return _u
+}
+
+// ClearLateFeeAmount clears the value of the "late_fee_amount" field.
+func (_u *DunningStepUpdateOne) ClearLateFeeAmount() *DunningStepUpdateOne {
+	_u.mutation.ClearLateFeeAmount()
+	return _u
+}
+
+// Mutation returns the DunningStepMutation object of the builder.
+func (_u *DunningStepUpdateOne) Mutation() *DunningStepMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DunningStepUpdate builder.
+func (_u *DunningStepUpdateOne) Where(ps ...predicate.DunningStep) *DunningStepUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DunningStepUpdateOne) Select(field string, fields ...string) *DunningStepUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DunningStep entity.
+func (_u *DunningStepUpdateOne) Save(ctx context.Context) (*DunningStep, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DunningStepUpdateOne) SaveX(ctx context.Context) *DunningStep {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DunningStepUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DunningStepUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DunningStepUpdateOne) sqlSave(ctx context.Context) (_node *DunningStep, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dunningstep.Table, dunningstep.Columns, sqlgraph.NewFieldSpec(dunningstep.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DunningStep.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dunningstep.FieldID)
+		for _, f := range fields {
+			if !dunningstep.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dunningstep.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(dunningstep.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.OffsetDays(); ok {
+		_spec.SetField(dunningstep.FieldOffsetDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOffsetDays(); ok {
+		_spec.AddField(dunningstep.FieldOffsetDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(dunningstep.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(dunningstep.FieldChannel, field.TypeString, value)
+	}
+	if _u.mutation.ChannelCleared() {
+		_spec.ClearField(dunningstep.FieldChannel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Template(); ok {
+		_spec.SetField(dunningstep.FieldTemplate, field.TypeString, value)
+	}
+	if _u.mutation.TemplateCleared() {
+		_spec.ClearField(dunningstep.FieldTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.LateFeeType(); ok {
+		_spec.SetField(dunningstep.FieldLateFeeType, field.TypeString, value)
+	}
+	if _u.mutation.LateFeeTypeCleared() {
+		_spec.ClearField(dunningstep.FieldLateFeeType, field.TypeString)
+	}
+	if value, ok := _u.mutation.LateFeeAmount(); ok {
+		_spec.SetField(dunningstep.FieldLateFeeAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLateFeeAmount(); ok {
+		_spec.AddField(dunningstep.FieldLateFeeAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.LateFeeAmountCleared() {
+		_spec.ClearField(dunningstep.FieldLateFeeAmount, field.TypeFloat64)
+	}
+	_node = &DunningStep{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dunningstep.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
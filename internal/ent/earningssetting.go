@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/earningssetting"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// EarningsSetting is the model entity for the EarningsSetting schema.
+type EarningsSetting struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Smallest payout a payee may request (defaults to zero)
+	MinimumPayout decimal.Decimal `json:"minimum_payout,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EarningsSetting) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case earningssetting.FieldMinimumPayout:
+			values[i] = new(decimal.Decimal)
+		case earningssetting.FieldCreatedAt, earningssetting.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case earningssetting.FieldID, earningssetting.FieldTenantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EarningsSetting fields.
+func (_m *EarningsSetting) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case earningssetting.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case earningssetting.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case earningssetting.FieldMinimumPayout:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field minimum_payout", values[i])
+			} else if value != nil {
+				_m.MinimumPayout = *value
+			}
+		case earningssetting.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case earningssetting.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EarningsSetting.
+// This includes values selected through modifiers, order, etc.
+func (_m *EarningsSetting) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EarningsSetting.
+// Note that you need to call EarningsSetting.Unwrap() before calling this method if this EarningsSetting
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EarningsSetting) Update() *EarningsSettingUpdateOne {
+	return NewEarningsSettingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EarningsSetting entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EarningsSetting) Unwrap() *EarningsSetting {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EarningsSetting is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EarningsSetting) String() string {
+	var builder strings.Builder
+	builder.WriteString("EarningsSetting(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("minimum_payout=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinimumPayout))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EarningsSettings is a parsable slice of EarningsSetting.
+type EarningsSettings []*EarningsSetting
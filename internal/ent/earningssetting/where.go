@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package earningssetting
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldEQ(FieldTenantID, v))
+}
+
+// MinimumPayout applies equality check predicate on the "minimum_payout" field. It's identical to MinimumPayoutEQ.
+func MinimumPayout(v decimal.Decimal) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldEQ(FieldMinimumPayout, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldLTE(FieldTenantID, v))
+}
+
+// MinimumPayoutEQ applies the EQ predicate on the "minimum_payout" field.
+func MinimumPayoutEQ(v decimal.Decimal) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldEQ(FieldMinimumPayout, v))
+}
+
+// MinimumPayoutNEQ applies the NEQ predicate on the "minimum_payout" field.
+func MinimumPayoutNEQ(v decimal.Decimal) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldNEQ(FieldMinimumPayout, v))
+}
+
+// MinimumPayoutIn applies the In predicate on the "minimum_payout" field.
+func MinimumPayoutIn(vs ...decimal.Decimal) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldIn(FieldMinimumPayout, vs...))
+}
+
+// MinimumPayoutNotIn applies the NotIn predicate on the "minimum_payout" field.
+func MinimumPayoutNotIn(vs ...decimal.Decimal) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldNotIn(FieldMinimumPayout, vs...))
+}
+
+// MinimumPayoutGT applies the GT predicate on the "minimum_payout" field.
+func MinimumPayoutGT(v decimal.Decimal) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldGT(FieldMinimumPayout, v))
+}
+
+// MinimumPayoutGTE applies the GTE predicate on the "minimum_payout" field.
+func MinimumPayoutGTE(v decimal.Decimal) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldGTE(FieldMinimumPayout, v))
+}
+
+// MinimumPayoutLT applies the LT predicate on the "minimum_payout" field.
+func MinimumPayoutLT(v decimal.Decimal) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldLT(FieldMinimumPayout, v))
+}
+
+// MinimumPayoutLTE applies the LTE predicate on the "minimum_payout" field.
+func MinimumPayoutLTE(v decimal.Decimal) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldLTE(FieldMinimumPayout, v))
+}
+
+// MinimumPayoutIsNil applies the IsNil predicate on the "minimum_payout" field.
+func MinimumPayoutIsNil() predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldIsNull(FieldMinimumPayout))
+}
+
+// MinimumPayoutNotNil applies the NotNil predicate on the "minimum_payout" field.
+func MinimumPayoutNotNil() predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldNotNull(FieldMinimumPayout))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EarningsSetting) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EarningsSetting) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EarningsSetting) predicate.EarningsSetting {
+	return predicate.EarningsSetting(sql.NotPredicates(p))
+}
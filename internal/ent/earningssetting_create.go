@@ -0,0 +1,704 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/earningssetting"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// EarningsSettingCreate is the builder for creating a EarningsSetting entity.
+type EarningsSettingCreate struct {
+	config
+	mutation *EarningsSettingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *EarningsSettingCreate) SetTenantID(v uuid.UUID) *EarningsSettingCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetMinimumPayout sets the "minimum_payout" field.
+func (_c *EarningsSettingCreate) SetMinimumPayout(v decimal.Decimal) *EarningsSettingCreate {
+	_c.mutation.SetMinimumPayout(v)
+	return _c
+}
+
+// SetNillableMinimumPayout sets the "minimum_payout" field if the given value is not nil.
+func (_c *EarningsSettingCreate) SetNillableMinimumPayout(v *decimal.Decimal) *EarningsSettingCreate {
+	if v != nil {
+		_c.SetMinimumPayout(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EarningsSettingCreate) SetCreatedAt(v time.Time) *EarningsSettingCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EarningsSettingCreate) SetNillableCreatedAt(v *time.Time) *EarningsSettingCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EarningsSettingCreate) SetUpdatedAt(v time.Time) *EarningsSettingCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EarningsSettingCreate) SetNillableUpdatedAt(v *time.Time) *EarningsSettingCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EarningsSettingCreate) SetID(v uuid.UUID) *EarningsSettingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *EarningsSettingCreate) SetNillableID(v *uuid.UUID) *EarningsSettingCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EarningsSettingMutation object of the builder.
+func (_c *EarningsSettingCreate) Mutation() *EarningsSettingMutation {
+	return _c.mutation
+}
+
+// Save creates the EarningsSetting in the database.
+func (_c *EarningsSettingCreate) Save(ctx context.Context) (*EarningsSetting, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EarningsSettingCreate) SaveX(ctx context.Context) *EarningsSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EarningsSettingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EarningsSettingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EarningsSettingCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := earningssetting.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := earningssetting.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := earningssetting.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EarningsSettingCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "EarningsSetting.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EarningsSetting.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EarningsSetting.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *EarningsSettingCreate) sqlSave(ctx context.Context) (*EarningsSetting, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EarningsSettingCreate) createSpec() (*EarningsSetting, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EarningsSetting{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(earningssetting.Table, sqlgraph.NewFieldSpec(earningssetting.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(earningssetting.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.MinimumPayout(); ok {
+		_spec.SetField(earningssetting.FieldMinimumPayout, field.TypeFloat64, value)
+		_node.MinimumPayout = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(earningssetting.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(earningssetting.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EarningsSetting.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EarningsSettingUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EarningsSettingCreate) OnConflict(opts ...sql.ConflictOption) *EarningsSettingUpsertOne {
+	_c.conflict = opts
+	return &EarningsSettingUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EarningsSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EarningsSettingCreate) OnConflictColumns(columns ...string) *EarningsSettingUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EarningsSettingUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EarningsSettingUpsertOne is the builder for "upsert"-ing
+	//  one EarningsSetting node.
+	EarningsSettingUpsertOne struct {
+		create *EarningsSettingCreate
+	}
+
+	// EarningsSettingUpsert is the "OnConflict" setter.
+	EarningsSettingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *EarningsSettingUpsert) SetTenantID(v uuid.UUID) *EarningsSettingUpsert {
+	u.Set(earningssetting.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *EarningsSettingUpsert) UpdateTenantID() *EarningsSettingUpsert {
+	u.SetExcluded(earningssetting.FieldTenantID)
+	return u
+}
+
+// SetMinimumPayout sets the "minimum_payout" field.
+func (u *EarningsSettingUpsert) SetMinimumPayout(v decimal.Decimal) *EarningsSettingUpsert {
+	u.Set(earningssetting.FieldMinimumPayout, v)
+	return u
+}
+
+// UpdateMinimumPayout sets the "minimum_payout" field to the value that was provided on create.
+func (u *EarningsSettingUpsert) UpdateMinimumPayout() *EarningsSettingUpsert {
+	u.SetExcluded(earningssetting.FieldMinimumPayout)
+	return u
+}
+
+// AddMinimumPayout adds v to the "minimum_payout" field.
+func (u *EarningsSettingUpsert) AddMinimumPayout(v decimal.Decimal) *EarningsSettingUpsert {
+	u.Add(earningssetting.FieldMinimumPayout, v)
+	return u
+}
+
+// ClearMinimumPayout clears the value of the "minimum_payout" field.
+func (u *EarningsSettingUpsert) ClearMinimumPayout() *EarningsSettingUpsert {
+	u.SetNull(earningssetting.FieldMinimumPayout)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EarningsSettingUpsert) SetUpdatedAt(v time.Time) *EarningsSettingUpsert {
+	u.Set(earningssetting.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EarningsSettingUpsert) UpdateUpdatedAt() *EarningsSettingUpsert {
+	u.SetExcluded(earningssetting.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EarningsSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(earningssetting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EarningsSettingUpsertOne) UpdateNewValues() *EarningsSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(earningssetting.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(earningssetting.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EarningsSetting.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EarningsSettingUpsertOne) Ignore() *EarningsSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EarningsSettingUpsertOne) DoNothing() *EarningsSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EarningsSettingCreate.OnConflict
+// documentation for more info.
+func (u *EarningsSettingUpsertOne) Update(set func(*EarningsSettingUpsert)) *EarningsSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EarningsSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *EarningsSettingUpsertOne) SetTenantID(v uuid.UUID) *EarningsSettingUpsertOne {
+	return u.Update(func(s *EarningsSettingUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *EarningsSettingUpsertOne) UpdateTenantID() *EarningsSettingUpsertOne {
+	return u.Update(func(s *EarningsSettingUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetMinimumPayout sets the "minimum_payout" field.
+func (u *EarningsSettingUpsertOne) SetMinimumPayout(v decimal.Decimal) *EarningsSettingUpsertOne {
+	return u.Update(func(s *EarningsSettingUpsert) {
+		s.SetMinimumPayout(v)
+	})
+}
+
+// AddMinimumPayout adds v to the "minimum_payout" field.
+func (u *EarningsSettingUpsertOne) AddMinimumPayout(v decimal.Decimal) *EarningsSettingUpsertOne {
+	return u.Update(func(s *EarningsSettingUpsert) {
+		s.AddMinimumPayout(v)
+	})
+}
+
+// UpdateMinimumPayout sets the "minimum_payout" field to the value that was provided on create.
+func (u *EarningsSettingUpsertOne) UpdateMinimumPayout() *EarningsSettingUpsertOne {
+	return u.Update(func(s *EarningsSettingUpsert) {
+		s.UpdateMinimumPayout()
+	})
+}
+
+// ClearMinimumPayout clears the value of the "minimum_payout" field.
+func (u *EarningsSettingUpsertOne) ClearMinimumPayout() *EarningsSettingUpsertOne {
+	return u.Update(func(s *EarningsSettingUpsert) {
+		s.ClearMinimumPayout()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EarningsSettingUpsertOne) SetUpdatedAt(v time.Time) *EarningsSettingUpsertOne {
+	return u.Update(func(s *EarningsSettingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EarningsSettingUpsertOne) UpdateUpdatedAt() *EarningsSettingUpsertOne {
+	return u.Update(func(s *EarningsSettingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EarningsSettingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EarningsSettingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EarningsSettingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EarningsSettingUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EarningsSettingUpsertOne.ID is not supported by MySQL driver. Use EarningsSettingUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EarningsSettingUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EarningsSettingCreateBulk is the builder for creating many EarningsSetting entities in bulk.
+type EarningsSettingCreateBulk struct {
+	config
+	err      error
+	builders []*EarningsSettingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EarningsSetting entities in the database.
+func (_c *EarningsSettingCreateBulk) Save(ctx context.Context) ([]*EarningsSetting, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EarningsSetting, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EarningsSettingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EarningsSettingCreateBulk) SaveX(ctx context.Context) []*EarningsSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EarningsSettingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EarningsSettingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EarningsSetting.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EarningsSettingUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EarningsSettingCreateBulk) OnConflict(opts ...sql.ConflictOption) *EarningsSettingUpsertBulk {
+	_c.conflict = opts
+	return &EarningsSettingUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EarningsSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EarningsSettingCreateBulk) OnConflictColumns(columns ...string) *EarningsSettingUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EarningsSettingUpsertBulk{
+		create: _c,
+	}
+}
+
+// EarningsSettingUpsertBulk is the builder for "upsert"-ing
+// a bulk of EarningsSetting nodes.
+type EarningsSettingUpsertBulk struct {
+	create *EarningsSettingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EarningsSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(earningssetting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EarningsSettingUpsertBulk) UpdateNewValues() *EarningsSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(earningssetting.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(earningssetting.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EarningsSetting.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EarningsSettingUpsertBulk) Ignore() *EarningsSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EarningsSettingUpsertBulk) DoNothing() *EarningsSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EarningsSettingCreateBulk.OnConflict
+// documentation for more info.
+func (u *EarningsSettingUpsertBulk) Update(set func(*EarningsSettingUpsert)) *EarningsSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EarningsSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *EarningsSettingUpsertBulk) SetTenantID(v uuid.UUID) *EarningsSettingUpsertBulk {
+	return u.Update(func(s *EarningsSettingUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *EarningsSettingUpsertBulk) UpdateTenantID() *EarningsSettingUpsertBulk {
+	return u.Update(func(s *EarningsSettingUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetMinimumPayout sets the "minimum_payout" field.
+func (u *EarningsSettingUpsertBulk) SetMinimumPayout(v decimal.Decimal) *EarningsSettingUpsertBulk {
+	return u.Update(func(s *EarningsSettingUpsert) {
+		s.SetMinimumPayout(v)
+	})
+}
+
+// AddMinimumPayout adds v to the "minimum_payout" field.
+func (u *EarningsSettingUpsertBulk) AddMinimumPayout(v decimal.Decimal) *EarningsSettingUpsertBulk {
+	return u.Update(func(s *EarningsSettingUpsert) {
+		s.AddMinimumPayout(v)
+	})
+}
+
+// UpdateMinimumPayout sets the "minimum_payout" field to the value that was provided on create.
+func (u *EarningsSettingUpsertBulk) UpdateMinimumPayout() *EarningsSettingUpsertBulk {
+	return u.Update(func(s *EarningsSettingUpsert) {
+		s.UpdateMinimumPayout()
+	})
+}
+
+// ClearMinimumPayout clears the value of the "minimum_payout" field.
+func (u *EarningsSettingUpsertBulk) ClearMinimumPayout() *EarningsSettingUpsertBulk {
+	return u.Update(func(s *EarningsSettingUpsert) {
+		s.ClearMinimumPayout()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EarningsSettingUpsertBulk) SetUpdatedAt(v time.Time) *EarningsSettingUpsertBulk {
+	return u.Update(func(s *EarningsSettingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EarningsSettingUpsertBulk) UpdateUpdatedAt() *EarningsSettingUpsertBulk {
+	return u.Update(func(s *EarningsSettingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EarningsSettingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EarningsSettingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EarningsSettingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EarningsSettingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
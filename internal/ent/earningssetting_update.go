@@ -0,0 +1,320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/earningssetting"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// EarningsSettingUpdate is the builder for updating EarningsSetting entities.
+type EarningsSettingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EarningsSettingMutation
+}
+
+// Where appends a list predicates to the EarningsSettingUpdate builder.
+func (_u *EarningsSettingUpdate) Where(ps ...predicate.EarningsSetting) *EarningsSettingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *EarningsSettingUpdate) SetTenantID(v uuid.UUID) *EarningsSettingUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *EarningsSettingUpdate) SetNillableTenantID(v *uuid.UUID) *EarningsSettingUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetMinimumPayout sets the "minimum_payout" field.
+func (_u *EarningsSettingUpdate) SetMinimumPayout(v decimal.Decimal) *EarningsSettingUpdate {
+	_u.mutation.ResetMinimumPayout()
+	_u.mutation.SetMinimumPayout(v)
+	return _u
+}
+
+// SetNillableMinimumPayout sets the "minimum_payout" field if the given value is not nil.
+func (_u *EarningsSettingUpdate) SetNillableMinimumPayout(v *decimal.Decimal) *EarningsSettingUpdate {
+	if v != nil {
+		_u.SetMinimumPayout(*v)
+	}
+	return _u
+}
+
+// AddMinimumPayout adds value to the "minimum_payout" field.
+func (_u *EarningsSettingUpdate) AddMinimumPayout(v decimal.Decimal) *EarningsSettingUpdate {
+	_u.mutation.AddMinimumPayout(v)
+	return _u
+}
+
+// ClearMinimumPayout clears the value of the "minimum_payout" field.
+func (_u *EarningsSettingUpdate) ClearMinimumPayout() *EarningsSettingUpdate {
+	_u.mutation.ClearMinimumPayout()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EarningsSettingUpdate) SetUpdatedAt(v time.Time) *EarningsSettingUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EarningsSettingMutation object of the builder.
+func (_u *EarningsSettingUpdate) Mutation() *EarningsSettingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EarningsSettingUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EarningsSettingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EarningsSettingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EarningsSettingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EarningsSettingUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := earningssetting.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *EarningsSettingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(earningssetting.Table, earningssetting.Columns, sqlgraph.NewFieldSpec(earningssetting.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(earningssetting.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.MinimumPayout(); ok {
+		_spec.SetField(earningssetting.FieldMinimumPayout, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinimumPayout(); ok {
+		_spec.AddField(earningssetting.FieldMinimumPayout, field.TypeFloat64, value)
+	}
+	if _u.mutation.MinimumPayoutCleared() {
+		_spec.ClearField(earningssetting.FieldMinimumPayout, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(earningssetting.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{earningssetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EarningsSettingUpdateOne is the builder for updating a single EarningsSetting entity.
+type EarningsSettingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EarningsSettingMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *EarningsSettingUpdateOne) SetTenantID(v uuid.UUID) *EarningsSettingUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *EarningsSettingUpdateOne) SetNillableTenantID(v *uuid.UUID) *EarningsSettingUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetMinimumPayout sets the "minimum_payout" field.
+func (_u *EarningsSettingUpdateOne) SetMinimumPayout(v decimal.Decimal) *EarningsSettingUpdateOne {
+	_u.mutation.ResetMinimumPayout()
+	_u.mutation.SetMinimumPayout(v)
+	return _u
+}
+
+// SetNillableMinimumPayout sets the "minimum_payout" field if the given value is not nil.
+func (_u *EarningsSettingUpdateOne) SetNillableMinimumPayout(v *decimal.Decimal) *EarningsSettingUpdateOne {
+	if v != nil {
+		_u.SetMinimumPayout(*v)
+	}
+	return _u
+}
+
+// AddMinimumPayout adds value to the "minimum_payout" field.
+func (_u *EarningsSettingUpdateOne) AddMinimumPayout(v decimal.Decimal) *EarningsSettingUpdateOne {
+	_u.mutation.AddMinimumPayout(v)
+	return _u
+}
+
+// ClearMinimumPayout clears the value of the "minimum_payout" field.
+func (_u *EarningsSettingUpdateOne) ClearMinimumPayout() *EarningsSettingUpdateOne {
+	_u.mutation.ClearMinimumPayout()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EarningsSettingUpdateOne) SetUpdatedAt(v time.Time) *EarningsSettingUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EarningsSettingMutation object of the builder.
+func (_u *EarningsSettingUpdateOne) Mutation() *EarningsSettingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EarningsSettingUpdate builder.
+func (_u *EarningsSettingUpdateOne) Where(ps ...predicate.EarningsSetting) *EarningsSettingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EarningsSettingUpdateOne) Select(field string, fields ...string) *EarningsSettingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EarningsSetting entity.
+func (_u *EarningsSettingUpdateOne) Save(ctx context.Context) (*EarningsSetting, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EarningsSettingUpdateOne) SaveX(ctx context.Context) *EarningsSetting {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EarningsSettingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EarningsSettingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EarningsSettingUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := earningssetting.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *EarningsSettingUpdateOne) sqlSave(ctx context.Context) (_node *EarningsSetting, err error) {
+	_spec := sqlgraph.NewUpdateSpec(earningssetting.Table, earningssetting.Columns, sqlgraph.NewFieldSpec(earningssetting.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EarningsSetting.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, earningssetting.FieldID)
+		for _, f := range fields {
+			if !earningssetting.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != earningssetting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(earningssetting.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.MinimumPayout(); ok {
+		_spec.SetField(earningssetting.FieldMinimumPayout, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinimumPayout(); ok {
+		_spec.AddField(earningssetting.FieldMinimumPayout, field.TypeFloat64, value)
+	}
+	if _u.mutation.MinimumPayoutCleared() {
+		_spec.ClearField(earningssetting.FieldMinimumPayout, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(earningssetting.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &EarningsSetting{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{earningssetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,17 +12,68 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/bankaccount"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatement"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatementprofile"
+	"github.com/bengobox/treasury-api/internal/ent/banktransaction"
+	"github.com/bengobox/treasury-api/internal/ent/billingcycle"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawercount"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawersession"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawersetting"
 	"github.com/bengobox/treasury-api/internal/ent/chartofaccount"
+	"github.com/bengobox/treasury-api/internal/ent/creditoverride"
+	"github.com/bengobox/treasury-api/internal/ent/customer"
+	"github.com/bengobox/treasury-api/internal/ent/customerstatement"
+	"github.com/bengobox/treasury-api/internal/ent/documentsequence"
+	"github.com/bengobox/treasury-api/internal/ent/dunningnotice"
+	"github.com/bengobox/treasury-api/internal/ent/dunningpause"
+	"github.com/bengobox/treasury-api/internal/ent/dunningstep"
+	"github.com/bengobox/treasury-api/internal/ent/earningssetting"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceipt"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceiptline"
 	"github.com/bengobox/treasury-api/internal/ent/invoice"
+	"github.com/bengobox/treasury-api/internal/ent/invoiceline"
+	"github.com/bengobox/treasury-api/internal/ent/invoicepayment"
+	"github.com/bengobox/treasury-api/internal/ent/invoicesetting"
 	"github.com/bengobox/treasury-api/internal/ent/ledgertransaction"
 	"github.com/bengobox/treasury-api/internal/ent/outboxevent"
+	"github.com/bengobox/treasury-api/internal/ent/payablesetting"
+	"github.com/bengobox/treasury-api/internal/ent/payeepayout"
+	"github.com/bengobox/treasury-api/internal/ent/payeewallet"
+	"github.com/bengobox/treasury-api/internal/ent/payeewalletentry"
 	"github.com/bengobox/treasury-api/internal/ent/paymentintent"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrun"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrunitem"
 	"github.com/bengobox/treasury-api/internal/ent/paymenttransaction"
+	"github.com/bengobox/treasury-api/internal/ent/provisionpolicy"
+	"github.com/bengobox/treasury-api/internal/ent/provisionrun"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliation"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatch"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatchitem"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationrule"
 	"github.com/bengobox/treasury-api/internal/ent/rolepermission"
+	"github.com/bengobox/treasury-api/internal/ent/settlementbatch"
+	"github.com/bengobox/treasury-api/internal/ent/settlementdisbursement"
+	"github.com/bengobox/treasury-api/internal/ent/settlementitem"
+	"github.com/bengobox/treasury-api/internal/ent/settlementsetting"
+	"github.com/bengobox/treasury-api/internal/ent/subscription"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionadjustment"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionmeter"
 	"github.com/bengobox/treasury-api/internal/ent/treasurypermission"
 	"github.com/bengobox/treasury-api/internal/ent/treasuryrole"
 	"github.com/bengobox/treasury-api/internal/ent/treasuryuser"
+	"github.com/bengobox/treasury-api/internal/ent/usagerecord"
 	"github.com/bengobox/treasury-api/internal/ent/userroleassignment"
+	"github.com/bengobox/treasury-api/internal/ent/vendor"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbill"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbillline"
+	"github.com/bengobox/treasury-api/internal/ent/wallet"
+	"github.com/bengobox/treasury-api/internal/ent/wallethold"
+	"github.com/bengobox/treasury-api/internal/ent/wallettransaction"
+	"github.com/bengobox/treasury-api/internal/ent/withholdingcertificate"
+	"github.com/bengobox/treasury-api/internal/ent/withholdingrate"
+	"github.com/bengobox/treasury-api/internal/ent/writeoff"
+	"github.com/bengobox/treasury-api/internal/ent/writeoffrecovery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -83,17 +134,68 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			chartofaccount.Table:     chartofaccount.ValidColumn,
-			invoice.Table:            invoice.ValidColumn,
-			ledgertransaction.Table:  ledgertransaction.ValidColumn,
-			outboxevent.Table:        outboxevent.ValidColumn,
-			paymentintent.Table:      paymentintent.ValidColumn,
-			paymenttransaction.Table: paymenttransaction.ValidColumn,
-			rolepermission.Table:     rolepermission.ValidColumn,
-			treasurypermission.Table: treasurypermission.ValidColumn,
-			treasuryrole.Table:       treasuryrole.ValidColumn,
-			treasuryuser.Table:       treasuryuser.ValidColumn,
-			userroleassignment.Table: userroleassignment.ValidColumn,
+			bankaccount.Table:             bankaccount.ValidColumn,
+			bankstatement.Table:           bankstatement.ValidColumn,
+			bankstatementprofile.Table:    bankstatementprofile.ValidColumn,
+			banktransaction.Table:         banktransaction.ValidColumn,
+			billingcycle.Table:            billingcycle.ValidColumn,
+			cashdrawercount.Table:         cashdrawercount.ValidColumn,
+			cashdrawersession.Table:       cashdrawersession.ValidColumn,
+			cashdrawersetting.Table:       cashdrawersetting.ValidColumn,
+			chartofaccount.Table:          chartofaccount.ValidColumn,
+			creditoverride.Table:          creditoverride.ValidColumn,
+			customer.Table:                customer.ValidColumn,
+			customerstatement.Table:       customerstatement.ValidColumn,
+			documentsequence.Table:        documentsequence.ValidColumn,
+			dunningnotice.Table:           dunningnotice.ValidColumn,
+			dunningpause.Table:            dunningpause.ValidColumn,
+			dunningstep.Table:             dunningstep.ValidColumn,
+			earningssetting.Table:         earningssetting.ValidColumn,
+			goodsreceipt.Table:            goodsreceipt.ValidColumn,
+			goodsreceiptline.Table:        goodsreceiptline.ValidColumn,
+			invoice.Table:                 invoice.ValidColumn,
+			invoiceline.Table:             invoiceline.ValidColumn,
+			invoicepayment.Table:          invoicepayment.ValidColumn,
+			invoicesetting.Table:          invoicesetting.ValidColumn,
+			ledgertransaction.Table:       ledgertransaction.ValidColumn,
+			outboxevent.Table:             outboxevent.ValidColumn,
+			payablesetting.Table:          payablesetting.ValidColumn,
+			payeepayout.Table:             payeepayout.ValidColumn,
+			payeewallet.Table:             payeewallet.ValidColumn,
+			payeewalletentry.Table:        payeewalletentry.ValidColumn,
+			paymentintent.Table:           paymentintent.ValidColumn,
+			paymentrun.Table:              paymentrun.ValidColumn,
+			paymentrunitem.Table:          paymentrunitem.ValidColumn,
+			paymenttransaction.Table:      paymenttransaction.ValidColumn,
+			provisionpolicy.Table:         provisionpolicy.ValidColumn,
+			provisionrun.Table:            provisionrun.ValidColumn,
+			reconciliation.Table:          reconciliation.ValidColumn,
+			reconciliationmatch.Table:     reconciliationmatch.ValidColumn,
+			reconciliationmatchitem.Table: reconciliationmatchitem.ValidColumn,
+			reconciliationrule.Table:      reconciliationrule.ValidColumn,
+			rolepermission.Table:          rolepermission.ValidColumn,
+			settlementbatch.Table:         settlementbatch.ValidColumn,
+			settlementdisbursement.Table:  settlementdisbursement.ValidColumn,
+			settlementitem.Table:          settlementitem.ValidColumn,
+			settlementsetting.Table:       settlementsetting.ValidColumn,
+			subscription.Table:            subscription.ValidColumn,
+			subscriptionadjustment.Table:  subscriptionadjustment.ValidColumn,
+			subscriptionmeter.Table:       subscriptionmeter.ValidColumn,
+			treasurypermission.Table:      treasurypermission.ValidColumn,
+			treasuryrole.Table:            treasuryrole.ValidColumn,
+			treasuryuser.Table:            treasuryuser.ValidColumn,
+			usagerecord.Table:             usagerecord.ValidColumn,
+			userroleassignment.Table:      userroleassignment.ValidColumn,
+			vendor.Table:                  vendor.ValidColumn,
+			vendorbill.Table:              vendorbill.ValidColumn,
+			vendorbillline.Table:          vendorbillline.ValidColumn,
+			wallet.Table:                  wallet.ValidColumn,
+			wallethold.Table:              wallethold.ValidColumn,
+			wallettransaction.Table:       wallettransaction.ValidColumn,
+			withholdingcertificate.Table:  withholdingcertificate.ValidColumn,
+			withholdingrate.Table:         withholdingrate.ValidColumn,
+			writeoff.Table:                writeoff.ValidColumn,
+			writeoffrecovery.Table:        writeoffrecovery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -1,4 +1,4 @@
 package ent
 
-//go:generate go run entgo.io/ent/cmd/ent generate --feature sql/upsert ./schema
+//go:generate go run entgo.io/ent/cmd/ent generate --feature sql/upsert,sql/lock ./schema
 
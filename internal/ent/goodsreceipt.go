@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceipt"
+	"github.com/google/uuid"
+)
+
+// GoodsReceipt is the model entity for the GoodsReceipt schema.
+type GoodsReceipt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Inbound event the receipt was recorded from
+	EventID string `json:"event_id,omitempty"`
+	// Purchase order identifier in the inventory service
+	PoID string `json:"po_id,omitempty"`
+	// Purchase order number quoted on vendor bills
+	PoNumber string `json:"po_number,omitempty"`
+	// Goods received note identifier in the inventory service
+	GrnID string `json:"grn_id,omitempty"`
+	// GrnNumber holds the value of the "grn_number" field.
+	GrnNumber string `json:"grn_number,omitempty"`
+	// Treasury vendor the goods were received from
+	VendorID uuid.UUID `json:"vendor_id,omitempty"`
+	// ISO currency code of the purchase order
+	Currency string `json:"currency,omitempty"`
+	// ReceivedAt holds the value of the "received_at" field.
+	ReceivedAt time.Time `json:"received_at,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GoodsReceiptQuery when eager-loading is set.
+	Edges        GoodsReceiptEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GoodsReceiptEdges holds the relations/edges for other nodes in the graph.
+type GoodsReceiptEdges struct {
+	// Lines holds the value of the lines edge.
+	Lines []*GoodsReceiptLine `json:"lines,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LinesOrErr returns the Lines value or an error if the edge
+// was not loaded in eager-loading.
+func (e GoodsReceiptEdges) LinesOrErr() ([]*GoodsReceiptLine, error) {
+	if e.loadedTypes[0] {
+		return e.Lines, nil
+	}
+	return nil, &NotLoadedError{edge: "lines"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GoodsReceipt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case goodsreceipt.FieldMetadata:
+			values[i] = new([]byte)
+		case goodsreceipt.FieldEventID, goodsreceipt.FieldPoID, goodsreceipt.FieldPoNumber, goodsreceipt.FieldGrnID, goodsreceipt.FieldGrnNumber, goodsreceipt.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case goodsreceipt.FieldReceivedAt, goodsreceipt.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case goodsreceipt.FieldID, goodsreceipt.FieldTenantID, goodsreceipt.FieldVendorID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GoodsReceipt fields.
+func (_m *GoodsReceipt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case goodsreceipt.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case goodsreceipt.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case goodsreceipt.FieldEventID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				_m.EventID = value.String
+			}
+		case goodsreceipt.FieldPoID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field po_id", values[i])
+			} else if value.Valid {
+				_m.PoID = value.String
+			}
+		case goodsreceipt.FieldPoNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field po_number", values[i])
+			} else if value.Valid {
+				_m.PoNumber = value.String
+			}
+		case goodsreceipt.FieldGrnID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field grn_id", values[i])
+			} else if value.Valid {
+				_m.GrnID = value.String
+			}
+		case goodsreceipt.FieldGrnNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field grn_number", values[i])
+			} else if value.Valid {
+				_m.GrnNumber = value.String
+			}
+		case goodsreceipt.FieldVendorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field vendor_id", values[i])
+			} else if value != nil {
+				_m.VendorID = *value
+			}
+		case goodsreceipt.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case goodsreceipt.FieldReceivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field received_at", values[i])
+			} else if value.Valid {
+				_m.ReceivedAt = value.Time
+			}
+		case goodsreceipt.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case goodsreceipt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GoodsReceipt.
+// This includes values selected through modifiers, order, etc.
+func (_m *GoodsReceipt) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLines queries the "lines" edge of the GoodsReceipt entity.
+func (_m *GoodsReceipt) QueryLines() *GoodsReceiptLineQuery {
+	return NewGoodsReceiptClient(_m.config).QueryLines(_m)
+}
+
+// Update returns a builder for updating this GoodsReceipt.
+// Note that you need to call GoodsReceipt.Unwrap() before calling this method if this GoodsReceipt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GoodsReceipt) Update() *GoodsReceiptUpdateOne {
+	return NewGoodsReceiptClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GoodsReceipt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GoodsReceipt) Unwrap() *GoodsReceipt {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GoodsReceipt is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GoodsReceipt) String() string {
+	var builder strings.Builder
+	builder.WriteString("GoodsReceipt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("event_id=")
+	builder.WriteString(_m.EventID)
+	builder.WriteString(", ")
+	builder.WriteString("po_id=")
+	builder.WriteString(_m.PoID)
+	builder.WriteString(", ")
+	builder.WriteString("po_number=")
+	builder.WriteString(_m.PoNumber)
+	builder.WriteString(", ")
+	builder.WriteString("grn_id=")
+	builder.WriteString(_m.GrnID)
+	builder.WriteString(", ")
+	builder.WriteString("grn_number=")
+	builder.WriteString(_m.GrnNumber)
+	builder.WriteString(", ")
+	builder.WriteString("vendor_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VendorID))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("received_at=")
+	builder.WriteString(_m.ReceivedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GoodsReceipts is a parsable slice of GoodsReceipt.
+type GoodsReceipts []*GoodsReceipt
@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goodsreceipt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the goodsreceipt type in the database.
+	Label = "goods_receipt"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldEventID holds the string denoting the event_id field in the database.
+	FieldEventID = "event_id"
+	// FieldPoID holds the string denoting the po_id field in the database.
+	FieldPoID = "po_id"
+	// FieldPoNumber holds the string denoting the po_number field in the database.
+	FieldPoNumber = "po_number"
+	// FieldGrnID holds the string denoting the grn_id field in the database.
+	FieldGrnID = "grn_id"
+	// FieldGrnNumber holds the string denoting the grn_number field in the database.
+	FieldGrnNumber = "grn_number"
+	// FieldVendorID holds the string denoting the vendor_id field in the database.
+	FieldVendorID = "vendor_id"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldReceivedAt holds the string denoting the received_at field in the database.
+	FieldReceivedAt = "received_at"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeLines holds the string denoting the lines edge name in mutations.
+	EdgeLines = "lines"
+	// Table holds the table name of the goodsreceipt in the database.
+	Table = "goods_receipts"
+	// LinesTable is the table that holds the lines relation/edge.
+	LinesTable = "goods_receipt_lines"
+	// LinesInverseTable is the table name for the GoodsReceiptLine entity.
+	// It exists in this package in order to avoid circular dependency with the "goodsreceiptline" package.
+	LinesInverseTable = "goods_receipt_lines"
+	// LinesColumn is the table column denoting the lines relation/edge.
+	LinesColumn = "receipt_id"
+)
+
+// Columns holds all SQL columns for goodsreceipt fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldEventID,
+	FieldPoID,
+	FieldPoNumber,
+	FieldGrnID,
+	FieldGrnNumber,
+	FieldVendorID,
+	FieldCurrency,
+	FieldReceivedAt,
+	FieldMetadata,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	EventIDValidator func(string) error
+	// PoNumberValidator is a validator for the "po_number" field. It is called by the builders before save.
+	PoNumberValidator func(string) error
+	// GrnIDValidator is a validator for the "grn_id" field. It is called by the builders before save.
+	GrnIDValidator func(string) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultMetadata holds the default value on creation for the "metadata" field.
+	DefaultMetadata map[string]interface{}
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the GoodsReceipt queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByEventID orders the results by the event_id field.
+func ByEventID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventID, opts...).ToFunc()
+}
+
+// ByPoID orders the results by the po_id field.
+func ByPoID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPoID, opts...).ToFunc()
+}
+
+// ByPoNumber orders the results by the po_number field.
+func ByPoNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPoNumber, opts...).ToFunc()
+}
+
+// ByGrnID orders the results by the grn_id field.
+func ByGrnID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGrnID, opts...).ToFunc()
+}
+
+// ByGrnNumber orders the results by the grn_number field.
+func ByGrnNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGrnNumber, opts...).ToFunc()
+}
+
+// ByVendorID orders the results by the vendor_id field.
+func ByVendorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVendorID, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByReceivedAt orders the results by the received_at field.
+func ByReceivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByLinesCount orders the results by lines count.
+func ByLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLinesStep(), opts...)
+	}
+}
+
+// ByLines orders the results by lines terms.
+func ByLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LinesTable, LinesColumn),
+	)
+}
@@ -0,0 +1,725 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goodsreceipt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldTenantID, v))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldEventID, v))
+}
+
+// PoID applies equality check predicate on the "po_id" field. It's identical to PoIDEQ.
+func PoID(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldPoID, v))
+}
+
+// PoNumber applies equality check predicate on the "po_number" field. It's identical to PoNumberEQ.
+func PoNumber(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldPoNumber, v))
+}
+
+// GrnID applies equality check predicate on the "grn_id" field. It's identical to GrnIDEQ.
+func GrnID(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldGrnID, v))
+}
+
+// GrnNumber applies equality check predicate on the "grn_number" field. It's identical to GrnNumberEQ.
+func GrnNumber(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldGrnNumber, v))
+}
+
+// VendorID applies equality check predicate on the "vendor_id" field. It's identical to VendorIDEQ.
+func VendorID(v uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldVendorID, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldCurrency, v))
+}
+
+// ReceivedAt applies equality check predicate on the "received_at" field. It's identical to ReceivedAtEQ.
+func ReceivedAt(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldTenantID, v))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldEventID, v))
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldEventID, v))
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldEventID, v))
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldEventID, v))
+}
+
+// EventIDContains applies the Contains predicate on the "event_id" field.
+func EventIDContains(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContains(FieldEventID, v))
+}
+
+// EventIDHasPrefix applies the HasPrefix predicate on the "event_id" field.
+func EventIDHasPrefix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasPrefix(FieldEventID, v))
+}
+
+// EventIDHasSuffix applies the HasSuffix predicate on the "event_id" field.
+func EventIDHasSuffix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasSuffix(FieldEventID, v))
+}
+
+// EventIDEqualFold applies the EqualFold predicate on the "event_id" field.
+func EventIDEqualFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEqualFold(FieldEventID, v))
+}
+
+// EventIDContainsFold applies the ContainsFold predicate on the "event_id" field.
+func EventIDContainsFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContainsFold(FieldEventID, v))
+}
+
+// PoIDEQ applies the EQ predicate on the "po_id" field.
+func PoIDEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldPoID, v))
+}
+
+// PoIDNEQ applies the NEQ predicate on the "po_id" field.
+func PoIDNEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldPoID, v))
+}
+
+// PoIDIn applies the In predicate on the "po_id" field.
+func PoIDIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldPoID, vs...))
+}
+
+// PoIDNotIn applies the NotIn predicate on the "po_id" field.
+func PoIDNotIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldPoID, vs...))
+}
+
+// PoIDGT applies the GT predicate on the "po_id" field.
+func PoIDGT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldPoID, v))
+}
+
+// PoIDGTE applies the GTE predicate on the "po_id" field.
+func PoIDGTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldPoID, v))
+}
+
+// PoIDLT applies the LT predicate on the "po_id" field.
+func PoIDLT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldPoID, v))
+}
+
+// PoIDLTE applies the LTE predicate on the "po_id" field.
+func PoIDLTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldPoID, v))
+}
+
+// PoIDContains applies the Contains predicate on the "po_id" field.
+func PoIDContains(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContains(FieldPoID, v))
+}
+
+// PoIDHasPrefix applies the HasPrefix predicate on the "po_id" field.
+func PoIDHasPrefix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasPrefix(FieldPoID, v))
+}
+
+// PoIDHasSuffix applies the HasSuffix predicate on the "po_id" field.
+func PoIDHasSuffix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasSuffix(FieldPoID, v))
+}
+
+// PoIDIsNil applies the IsNil predicate on the "po_id" field.
+func PoIDIsNil() predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIsNull(FieldPoID))
+}
+
+// PoIDNotNil applies the NotNil predicate on the "po_id" field.
+func PoIDNotNil() predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotNull(FieldPoID))
+}
+
+// PoIDEqualFold applies the EqualFold predicate on the "po_id" field.
+func PoIDEqualFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEqualFold(FieldPoID, v))
+}
+
+// PoIDContainsFold applies the ContainsFold predicate on the "po_id" field.
+func PoIDContainsFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContainsFold(FieldPoID, v))
+}
+
+// PoNumberEQ applies the EQ predicate on the "po_number" field.
+func PoNumberEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldPoNumber, v))
+}
+
+// PoNumberNEQ applies the NEQ predicate on the "po_number" field.
+func PoNumberNEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldPoNumber, v))
+}
+
+// PoNumberIn applies the In predicate on the "po_number" field.
+func PoNumberIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldPoNumber, vs...))
+}
+
+// PoNumberNotIn applies the NotIn predicate on the "po_number" field.
+func PoNumberNotIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldPoNumber, vs...))
+}
+
+// PoNumberGT applies the GT predicate on the "po_number" field.
+func PoNumberGT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldPoNumber, v))
+}
+
+// PoNumberGTE applies the GTE predicate on the "po_number" field.
+func PoNumberGTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldPoNumber, v))
+}
+
+// PoNumberLT applies the LT predicate on the "po_number" field.
+func PoNumberLT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldPoNumber, v))
+}
+
+// PoNumberLTE applies the LTE predicate on the "po_number" field.
+func PoNumberLTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldPoNumber, v))
+}
+
+// PoNumberContains applies the Contains predicate on the "po_number" field.
+func PoNumberContains(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContains(FieldPoNumber, v))
+}
+
+// PoNumberHasPrefix applies the HasPrefix predicate on the "po_number" field.
+func PoNumberHasPrefix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasPrefix(FieldPoNumber, v))
+}
+
+// PoNumberHasSuffix applies the HasSuffix predicate on the "po_number" field.
+func PoNumberHasSuffix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasSuffix(FieldPoNumber, v))
+}
+
+// PoNumberEqualFold applies the EqualFold predicate on the "po_number" field.
+func PoNumberEqualFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEqualFold(FieldPoNumber, v))
+}
+
+// PoNumberContainsFold applies the ContainsFold predicate on the "po_number" field.
+func PoNumberContainsFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContainsFold(FieldPoNumber, v))
+}
+
+// GrnIDEQ applies the EQ predicate on the "grn_id" field.
+func GrnIDEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldGrnID, v))
+}
+
+// GrnIDNEQ applies the NEQ predicate on the "grn_id" field.
+func GrnIDNEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldGrnID, v))
+}
+
+// GrnIDIn applies the In predicate on the "grn_id" field.
+func GrnIDIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldGrnID, vs...))
+}
+
+// GrnIDNotIn applies the NotIn predicate on the "grn_id" field.
+func GrnIDNotIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldGrnID, vs...))
+}
+
+// GrnIDGT applies the GT predicate on the "grn_id" field.
+func GrnIDGT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldGrnID, v))
+}
+
+// GrnIDGTE applies the GTE predicate on the "grn_id" field.
+func GrnIDGTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldGrnID, v))
+}
+
+// GrnIDLT applies the LT predicate on the "grn_id" field.
+func GrnIDLT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldGrnID, v))
+}
+
+// GrnIDLTE applies the LTE predicate on the "grn_id" field.
+func GrnIDLTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldGrnID, v))
+}
+
+// GrnIDContains applies the Contains predicate on the "grn_id" field.
+func GrnIDContains(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContains(FieldGrnID, v))
+}
+
+// GrnIDHasPrefix applies the HasPrefix predicate on the "grn_id" field.
+func GrnIDHasPrefix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasPrefix(FieldGrnID, v))
+}
+
+// GrnIDHasSuffix applies the HasSuffix predicate on the "grn_id" field.
+func GrnIDHasSuffix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasSuffix(FieldGrnID, v))
+}
+
+// GrnIDEqualFold applies the EqualFold predicate on the "grn_id" field.
+func GrnIDEqualFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEqualFold(FieldGrnID, v))
+}
+
+// GrnIDContainsFold applies the ContainsFold predicate on the "grn_id" field.
+func GrnIDContainsFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContainsFold(FieldGrnID, v))
+}
+
+// GrnNumberEQ applies the EQ predicate on the "grn_number" field.
+func GrnNumberEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldGrnNumber, v))
+}
+
+// GrnNumberNEQ applies the NEQ predicate on the "grn_number" field.
+func GrnNumberNEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldGrnNumber, v))
+}
+
+// GrnNumberIn applies the In predicate on the "grn_number" field.
+func GrnNumberIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldGrnNumber, vs...))
+}
+
+// GrnNumberNotIn applies the NotIn predicate on the "grn_number" field.
+func GrnNumberNotIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldGrnNumber, vs...))
+}
+
+// GrnNumberGT applies the GT predicate on the "grn_number" field.
+func GrnNumberGT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldGrnNumber, v))
+}
+
+// GrnNumberGTE applies the GTE predicate on the "grn_number" field.
+func GrnNumberGTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldGrnNumber, v))
+}
+
+// GrnNumberLT applies the LT predicate on the "grn_number" field.
+func GrnNumberLT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldGrnNumber, v))
+}
+
+// GrnNumberLTE applies the LTE predicate on the "grn_number" field.
+func GrnNumberLTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldGrnNumber, v))
+}
+
+// GrnNumberContains applies the Contains predicate on the "grn_number" field.
+func GrnNumberContains(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContains(FieldGrnNumber, v))
+}
+
+// GrnNumberHasPrefix applies the HasPrefix predicate on the "grn_number" field.
+func GrnNumberHasPrefix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasPrefix(FieldGrnNumber, v))
+}
+
+// GrnNumberHasSuffix applies the HasSuffix predicate on the "grn_number" field.
+func GrnNumberHasSuffix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasSuffix(FieldGrnNumber, v))
+}
+
+// GrnNumberIsNil applies the IsNil predicate on the "grn_number" field.
+func GrnNumberIsNil() predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIsNull(FieldGrnNumber))
+}
+
+// GrnNumberNotNil applies the NotNil predicate on the "grn_number" field.
+func GrnNumberNotNil() predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotNull(FieldGrnNumber))
+}
+
+// GrnNumberEqualFold applies the EqualFold predicate on the "grn_number" field.
+func GrnNumberEqualFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEqualFold(FieldGrnNumber, v))
+}
+
+// GrnNumberContainsFold applies the ContainsFold predicate on the "grn_number" field.
+func GrnNumberContainsFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContainsFold(FieldGrnNumber, v))
+}
+
+// VendorIDEQ applies the EQ predicate on the "vendor_id" field.
+func VendorIDEQ(v uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldVendorID, v))
+}
+
+// VendorIDNEQ applies the NEQ predicate on the "vendor_id" field.
+func VendorIDNEQ(v uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldVendorID, v))
+}
+
+// VendorIDIn applies the In predicate on the "vendor_id" field.
+func VendorIDIn(vs ...uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldVendorID, vs...))
+}
+
+// VendorIDNotIn applies the NotIn predicate on the "vendor_id" field.
+func VendorIDNotIn(vs ...uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldVendorID, vs...))
+}
+
+// VendorIDGT applies the GT predicate on the "vendor_id" field.
+func VendorIDGT(v uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldVendorID, v))
+}
+
+// VendorIDGTE applies the GTE predicate on the "vendor_id" field.
+func VendorIDGTE(v uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldVendorID, v))
+}
+
+// VendorIDLT applies the LT predicate on the "vendor_id" field.
+func VendorIDLT(v uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldVendorID, v))
+}
+
+// VendorIDLTE applies the LTE predicate on the "vendor_id" field.
+func VendorIDLTE(v uuid.UUID) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldVendorID, v))
+}
+
+// VendorIDIsNil applies the IsNil predicate on the "vendor_id" field.
+func VendorIDIsNil() predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIsNull(FieldVendorID))
+}
+
+// VendorIDNotNil applies the NotNil predicate on the "vendor_id" field.
+func VendorIDNotNil() predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotNull(FieldVendorID))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// ReceivedAtEQ applies the EQ predicate on the "received_at" field.
+func ReceivedAtEQ(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtNEQ applies the NEQ predicate on the "received_at" field.
+func ReceivedAtNEQ(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtIn applies the In predicate on the "received_at" field.
+func ReceivedAtIn(vs ...time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtNotIn applies the NotIn predicate on the "received_at" field.
+func ReceivedAtNotIn(vs ...time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtGT applies the GT predicate on the "received_at" field.
+func ReceivedAtGT(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldReceivedAt, v))
+}
+
+// ReceivedAtGTE applies the GTE predicate on the "received_at" field.
+func ReceivedAtGTE(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldReceivedAt, v))
+}
+
+// ReceivedAtLT applies the LT predicate on the "received_at" field.
+func ReceivedAtLT(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldReceivedAt, v))
+}
+
+// ReceivedAtLTE applies the LTE predicate on the "received_at" field.
+func ReceivedAtLTE(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldReceivedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasLines applies the HasEdge predicate on the "lines" edge.
+func HasLines() predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LinesTable, LinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinesWith applies the HasEdge predicate on the "lines" edge with a given conditions (other predicates).
+func HasLinesWith(preds ...predicate.GoodsReceiptLine) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(func(s *sql.Selector) {
+		step := newLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GoodsReceipt) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GoodsReceipt) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GoodsReceipt) predicate.GoodsReceipt {
+	return predicate.GoodsReceipt(sql.NotPredicates(p))
+}
@@ -0,0 +1,1121 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceipt"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceiptline"
+	"github.com/google/uuid"
+)
+
+// GoodsReceiptCreate is the builder for creating a GoodsReceipt entity.
+type GoodsReceiptCreate struct {
+	config
+	mutation *GoodsReceiptMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *GoodsReceiptCreate) SetTenantID(v uuid.UUID) *GoodsReceiptCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *GoodsReceiptCreate) SetEventID(v string) *GoodsReceiptCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetPoID sets the "po_id" field.
+func (_c *GoodsReceiptCreate) SetPoID(v string) *GoodsReceiptCreate {
+	_c.mutation.SetPoID(v)
+	return _c
+}
+
+// SetNillablePoID sets the "po_id" field if the given value is not nil.
+func (_c *GoodsReceiptCreate) SetNillablePoID(v *string) *GoodsReceiptCreate {
+	if v != nil {
+		_c.SetPoID(*v)
+	}
+	return _c
+}
+
+// SetPoNumber sets the "po_number" field.
+func (_c *GoodsReceiptCreate) SetPoNumber(v string) *GoodsReceiptCreate {
+	_c.mutation.SetPoNumber(v)
+	return _c
+}
+
+// SetGrnID sets the "grn_id" field.
+func (_c *GoodsReceiptCreate) SetGrnID(v string) *GoodsReceiptCreate {
+	_c.mutation.SetGrnID(v)
+	return _c
+}
+
+// SetGrnNumber sets the "grn_number" field.
+func (_c *GoodsReceiptCreate) SetGrnNumber(v string) *GoodsReceiptCreate {
+	_c.mutation.SetGrnNumber(v)
+	return _c
+}
+
+// SetNillableGrnNumber sets the "grn_number" field if the given value is not nil.
+func (_c *GoodsReceiptCreate) SetNillableGrnNumber(v *string) *GoodsReceiptCreate {
+	if v != nil {
+		_c.SetGrnNumber(*v)
+	}
+	return _c
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_c *GoodsReceiptCreate) SetVendorID(v uuid.UUID) *GoodsReceiptCreate {
+	_c.mutation.SetVendorID(v)
+	return _c
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_c *GoodsReceiptCreate) SetNillableVendorID(v *uuid.UUID) *GoodsReceiptCreate {
+	if v != nil {
+		_c.SetVendorID(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *GoodsReceiptCreate) SetCurrency(v string) *GoodsReceiptCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *GoodsReceiptCreate) SetNillableCurrency(v *string) *GoodsReceiptCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_c *GoodsReceiptCreate) SetReceivedAt(v time.Time) *GoodsReceiptCreate {
+	_c.mutation.SetReceivedAt(v)
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *GoodsReceiptCreate) SetMetadata(v map[string]interface{}) *GoodsReceiptCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GoodsReceiptCreate) SetCreatedAt(v time.Time) *GoodsReceiptCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GoodsReceiptCreate) SetNillableCreatedAt(v *time.Time) *GoodsReceiptCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GoodsReceiptCreate) SetID(v uuid.UUID) *GoodsReceiptCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *GoodsReceiptCreate) SetNillableID(v *uuid.UUID) *GoodsReceiptCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddLineIDs adds the "lines" edge to the GoodsReceiptLine entity by IDs.
+func (_c *GoodsReceiptCreate) AddLineIDs(ids ...uuid.UUID) *GoodsReceiptCreate {
+	_c.mutation.AddLineIDs(ids...)
+	return _c
+}
+
+// AddLines adds the "lines" edges to the GoodsReceiptLine entity.
+func (_c *GoodsReceiptCreate) AddLines(v ...*GoodsReceiptLine) *GoodsReceiptCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLineIDs(ids...)
+}
+
+// Mutation returns the GoodsReceiptMutation object of the builder.
+func (_c *GoodsReceiptCreate) Mutation() *GoodsReceiptMutation {
+	return _c.mutation
+}
+
+// Save creates the GoodsReceipt in the database.
+func (_c *GoodsReceiptCreate) Save(ctx context.Context) (*GoodsReceipt, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GoodsReceiptCreate) SaveX(ctx context.Context) *GoodsReceipt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoodsReceiptCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoodsReceiptCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GoodsReceiptCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := goodsreceipt.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		v := goodsreceipt.DefaultMetadata
+		_c.mutation.SetMetadata(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := goodsreceipt.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := goodsreceipt.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GoodsReceiptCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "GoodsReceipt.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "GoodsReceipt.event_id"`)}
+	}
+	if v, ok := _c.mutation.EventID(); ok {
+		if err := goodsreceipt.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "GoodsReceipt.event_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PoNumber(); !ok {
+		return &ValidationError{Name: "po_number", err: errors.New(`ent: missing required field "GoodsReceipt.po_number"`)}
+	}
+	if v, ok := _c.mutation.PoNumber(); ok {
+		if err := goodsreceipt.PoNumberValidator(v); err != nil {
+			return &ValidationError{Name: "po_number", err: fmt.Errorf(`ent: validator failed for field "GoodsReceipt.po_number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GrnID(); !ok {
+		return &ValidationError{Name: "grn_id", err: errors.New(`ent: missing required field "GoodsReceipt.grn_id"`)}
+	}
+	if v, ok := _c.mutation.GrnID(); ok {
+		if err := goodsreceipt.GrnIDValidator(v); err != nil {
+			return &ValidationError{Name: "grn_id", err: fmt.Errorf(`ent: validator failed for field "GoodsReceipt.grn_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "GoodsReceipt.currency"`)}
+	}
+	if _, ok := _c.mutation.ReceivedAt(); !ok {
+		return &ValidationError{Name: "received_at", err: errors.New(`ent: missing required field "GoodsReceipt.received_at"`)}
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		return &ValidationError{Name: "metadata", err: errors.New(`ent: missing required field "GoodsReceipt.metadata"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GoodsReceipt.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *GoodsReceiptCreate) sqlSave(ctx context.Context) (*GoodsReceipt, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GoodsReceiptCreate) createSpec() (*GoodsReceipt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GoodsReceipt{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(goodsreceipt.Table, sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(goodsreceipt.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(goodsreceipt.FieldEventID, field.TypeString, value)
+		_node.EventID = value
+	}
+	if value, ok := _c.mutation.PoID(); ok {
+		_spec.SetField(goodsreceipt.FieldPoID, field.TypeString, value)
+		_node.PoID = value
+	}
+	if value, ok := _c.mutation.PoNumber(); ok {
+		_spec.SetField(goodsreceipt.FieldPoNumber, field.TypeString, value)
+		_node.PoNumber = value
+	}
+	if value, ok := _c.mutation.GrnID(); ok {
+		_spec.SetField(goodsreceipt.FieldGrnID, field.TypeString, value)
+		_node.GrnID = value
+	}
+	if value, ok := _c.mutation.GrnNumber(); ok {
+		_spec.SetField(goodsreceipt.FieldGrnNumber, field.TypeString, value)
+		_node.GrnNumber = value
+	}
+	if value, ok := _c.mutation.VendorID(); ok {
+		_spec.SetField(goodsreceipt.FieldVendorID, field.TypeUUID, value)
+		_node.VendorID = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(goodsreceipt.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.ReceivedAt(); ok {
+		_spec.SetField(goodsreceipt.FieldReceivedAt, field.TypeTime, value)
+		_node.ReceivedAt = value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(goodsreceipt.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(goodsreceipt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goodsreceipt.LinesTable,
+			Columns: []string{goodsreceipt.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GoodsReceipt.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoodsReceiptUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoodsReceiptCreate) OnConflict(opts ...sql.ConflictOption) *GoodsReceiptUpsertOne {
+	_c.conflict = opts
+	return &GoodsReceiptUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GoodsReceipt.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoodsReceiptCreate) OnConflictColumns(columns ...string) *GoodsReceiptUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoodsReceiptUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GoodsReceiptUpsertOne is the builder for "upsert"-ing
+	//  one GoodsReceipt node.
+	GoodsReceiptUpsertOne struct {
+		create *GoodsReceiptCreate
+	}
+
+	// GoodsReceiptUpsert is the "OnConflict" setter.
+	GoodsReceiptUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *GoodsReceiptUpsert) SetTenantID(v uuid.UUID) *GoodsReceiptUpsert {
+	u.Set(goodsreceipt.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *GoodsReceiptUpsert) UpdateTenantID() *GoodsReceiptUpsert {
+	u.SetExcluded(goodsreceipt.FieldTenantID)
+	return u
+}
+
+// SetPoID sets the "po_id" field.
+func (u *GoodsReceiptUpsert) SetPoID(v string) *GoodsReceiptUpsert {
+	u.Set(goodsreceipt.FieldPoID, v)
+	return u
+}
+
+// UpdatePoID sets the "po_id" field to the value that was provided on create.
+func (u *GoodsReceiptUpsert) UpdatePoID() *GoodsReceiptUpsert {
+	u.SetExcluded(goodsreceipt.FieldPoID)
+	return u
+}
+
+// ClearPoID clears the value of the "po_id" field.
+func (u *GoodsReceiptUpsert) ClearPoID() *GoodsReceiptUpsert {
+	u.SetNull(goodsreceipt.FieldPoID)
+	return u
+}
+
+// SetPoNumber sets the "po_number" field.
+func (u *GoodsReceiptUpsert) SetPoNumber(v string) *GoodsReceiptUpsert {
+	u.Set(goodsreceipt.FieldPoNumber, v)
+	return u
+}
+
+// UpdatePoNumber sets the "po_number" field to the value that was provided on create.
+func (u *GoodsReceiptUpsert) UpdatePoNumber() *GoodsReceiptUpsert {
+	u.SetExcluded(goodsreceipt.FieldPoNumber)
+	return u
+}
+
+// SetGrnID sets the "grn_id" field.
+func (u *GoodsReceiptUpsert) SetGrnID(v string) *GoodsReceiptUpsert {
+	u.Set(goodsreceipt.FieldGrnID, v)
+	return u
+}
+
+// UpdateGrnID sets the "grn_id" field to the value that was provided on create.
+func (u *GoodsReceiptUpsert) UpdateGrnID() *GoodsReceiptUpsert {
+	u.SetExcluded(goodsreceipt.FieldGrnID)
+	return u
+}
+
+// SetGrnNumber sets the "grn_number" field.
+func (u *GoodsReceiptUpsert) SetGrnNumber(v string) *GoodsReceiptUpsert {
+	u.Set(goodsreceipt.FieldGrnNumber, v)
+	return u
+}
+
+// UpdateGrnNumber sets the "grn_number" field to the value that was provided on create.
+func (u *GoodsReceiptUpsert) UpdateGrnNumber() *GoodsReceiptUpsert {
+	u.SetExcluded(goodsreceipt.FieldGrnNumber)
+	return u
+}
+
+// ClearGrnNumber clears the value of the "grn_number" field.
+func (u *GoodsReceiptUpsert) ClearGrnNumber() *GoodsReceiptUpsert {
+	u.SetNull(goodsreceipt.FieldGrnNumber)
+	return u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (u *GoodsReceiptUpsert) SetVendorID(v uuid.UUID) *GoodsReceiptUpsert {
+	u.Set(goodsreceipt.FieldVendorID, v)
+	return u
+}
+
+// UpdateVendorID sets the "vendor_id" field to the value that was provided on create.
+func (u *GoodsReceiptUpsert) UpdateVendorID() *GoodsReceiptUpsert {
+	u.SetExcluded(goodsreceipt.FieldVendorID)
+	return u
+}
+
+// ClearVendorID clears the value of the "vendor_id" field.
+func (u *GoodsReceiptUpsert) ClearVendorID() *GoodsReceiptUpsert {
+	u.SetNull(goodsreceipt.FieldVendorID)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *GoodsReceiptUpsert) SetCurrency(v string) *GoodsReceiptUpsert {
+	u.Set(goodsreceipt.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *GoodsReceiptUpsert) UpdateCurrency() *GoodsReceiptUpsert {
+	u.SetExcluded(goodsreceipt.FieldCurrency)
+	return u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (u *GoodsReceiptUpsert) SetReceivedAt(v time.Time) *GoodsReceiptUpsert {
+	u.Set(goodsreceipt.FieldReceivedAt, v)
+	return u
+}
+
+// UpdateReceivedAt sets the "received_at" field to the value that was provided on create.
+func (u *GoodsReceiptUpsert) UpdateReceivedAt() *GoodsReceiptUpsert {
+	u.SetExcluded(goodsreceipt.FieldReceivedAt)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *GoodsReceiptUpsert) SetMetadata(v map[string]interface{}) *GoodsReceiptUpsert {
+	u.Set(goodsreceipt.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *GoodsReceiptUpsert) UpdateMetadata() *GoodsReceiptUpsert {
+	u.SetExcluded(goodsreceipt.FieldMetadata)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GoodsReceipt.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(goodsreceipt.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoodsReceiptUpsertOne) UpdateNewValues() *GoodsReceiptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(goodsreceipt.FieldID)
+		}
+		if _, exists := u.create.mutation.EventID(); exists {
+			s.SetIgnore(goodsreceipt.FieldEventID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(goodsreceipt.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GoodsReceipt.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GoodsReceiptUpsertOne) Ignore() *GoodsReceiptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoodsReceiptUpsertOne) DoNothing() *GoodsReceiptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoodsReceiptCreate.OnConflict
+// documentation for more info.
+func (u *GoodsReceiptUpsertOne) Update(set func(*GoodsReceiptUpsert)) *GoodsReceiptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoodsReceiptUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *GoodsReceiptUpsertOne) SetTenantID(v uuid.UUID) *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *GoodsReceiptUpsertOne) UpdateTenantID() *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetPoID sets the "po_id" field.
+func (u *GoodsReceiptUpsertOne) SetPoID(v string) *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.SetPoID(v)
+	})
+}
+
+// UpdatePoID sets the "po_id" field to the value that was provided on create.
+func (u *GoodsReceiptUpsertOne) UpdatePoID() *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.UpdatePoID()
+	})
+}
+
+// ClearPoID clears the value of the "po_id" field.
+func (u *GoodsReceiptUpsertOne) ClearPoID() *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.ClearPoID()
+	})
+}
+
+// SetPoNumber sets the "po_number" field.
+func (u *GoodsReceiptUpsertOne) SetPoNumber(v string) *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.SetPoNumber(v)
+	})
+}
+
+// UpdatePoNumber sets the "po_number" field to the value that was provided on create.
+func (u *GoodsReceiptUpsertOne) UpdatePoNumber() *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.UpdatePoNumber()
+	})
+}
+
+// SetGrnID sets the "grn_id" field.
+func (u *GoodsReceiptUpsertOne) SetGrnID(v string) *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.SetGrnID(v)
+	})
+}
+
+// UpdateGrnID sets the "grn_id" field to the value that was provided on create.
+func (u *GoodsReceiptUpsertOne) UpdateGrnID() *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.UpdateGrnID()
+	})
+}
+
+// SetGrnNumber sets the "grn_number" field.
+func (u *GoodsReceiptUpsertOne) SetGrnNumber(v string) *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.SetGrnNumber(v)
+	})
+}
+
+// UpdateGrnNumber sets the "grn_number" field to the value that was provided on create.
+func (u *GoodsReceiptUpsertOne) UpdateGrnNumber() *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.UpdateGrnNumber()
+	})
+}
+
+// ClearGrnNumber clears the value of the "grn_number" field.
+func (u *GoodsReceiptUpsertOne) ClearGrnNumber() *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.ClearGrnNumber()
+	})
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (u *GoodsReceiptUpsertOne) SetVendorID(v uuid.UUID) *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.SetVendorID(v)
+	})
+}
+
+// UpdateVendorID sets the "vendor_id" field to the value that was provided on create.
+func (u *GoodsReceiptUpsertOne) UpdateVendorID() *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.UpdateVendorID()
+	})
+}
+
+// ClearVendorID clears the value of the "vendor_id" field.
+func (u *GoodsReceiptUpsertOne) ClearVendorID() *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.ClearVendorID()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *GoodsReceiptUpsertOne) SetCurrency(v string) *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *GoodsReceiptUpsertOne) UpdateCurrency() *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (u *GoodsReceiptUpsertOne) SetReceivedAt(v time.Time) *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.SetReceivedAt(v)
+	})
+}
+
+// UpdateReceivedAt sets the "received_at" field to the value that was provided on create.
+func (u *GoodsReceiptUpsertOne) UpdateReceivedAt() *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.UpdateReceivedAt()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *GoodsReceiptUpsertOne) SetMetadata(v map[string]interface{}) *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *GoodsReceiptUpsertOne) UpdateMetadata() *GoodsReceiptUpsertOne {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *GoodsReceiptUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoodsReceiptCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoodsReceiptUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GoodsReceiptUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: GoodsReceiptUpsertOne.ID is not supported by MySQL driver. Use GoodsReceiptUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GoodsReceiptUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GoodsReceiptCreateBulk is the builder for creating many GoodsReceipt entities in bulk.
+type GoodsReceiptCreateBulk struct {
+	config
+	err      error
+	builders []*GoodsReceiptCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GoodsReceipt entities in the database.
+func (_c *GoodsReceiptCreateBulk) Save(ctx context.Context) ([]*GoodsReceipt, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GoodsReceipt, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GoodsReceiptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GoodsReceiptCreateBulk) SaveX(ctx context.Context) []*GoodsReceipt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoodsReceiptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoodsReceiptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GoodsReceipt.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoodsReceiptUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoodsReceiptCreateBulk) OnConflict(opts ...sql.ConflictOption) *GoodsReceiptUpsertBulk {
+	_c.conflict = opts
+	return &GoodsReceiptUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GoodsReceipt.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoodsReceiptCreateBulk) OnConflictColumns(columns ...string) *GoodsReceiptUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoodsReceiptUpsertBulk{
+		create: _c,
+	}
+}
+
+// GoodsReceiptUpsertBulk is the builder for "upsert"-ing
+// a bulk of GoodsReceipt nodes.
+type GoodsReceiptUpsertBulk struct {
+	create *GoodsReceiptCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GoodsReceipt.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(goodsreceipt.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoodsReceiptUpsertBulk) UpdateNewValues() *GoodsReceiptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(goodsreceipt.FieldID)
+			}
+			if _, exists := b.mutation.EventID(); exists {
+				s.SetIgnore(goodsreceipt.FieldEventID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(goodsreceipt.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GoodsReceipt.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GoodsReceiptUpsertBulk) Ignore() *GoodsReceiptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoodsReceiptUpsertBulk) DoNothing() *GoodsReceiptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoodsReceiptCreateBulk.OnConflict
+// documentation for more info.
+func (u *GoodsReceiptUpsertBulk) Update(set func(*GoodsReceiptUpsert)) *GoodsReceiptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoodsReceiptUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *GoodsReceiptUpsertBulk) SetTenantID(v uuid.UUID) *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *GoodsReceiptUpsertBulk) UpdateTenantID() *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetPoID sets the "po_id" field.
+func (u *GoodsReceiptUpsertBulk) SetPoID(v string) *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.SetPoID(v)
+	})
+}
+
+// UpdatePoID sets the "po_id" field to the value that was provided on create.
+func (u *GoodsReceiptUpsertBulk) UpdatePoID() *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.UpdatePoID()
+	})
+}
+
+// ClearPoID clears the value of the "po_id" field.
+func (u *GoodsReceiptUpsertBulk) ClearPoID() *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.ClearPoID()
+	})
+}
+
+// SetPoNumber sets the "po_number" field.
+func (u *GoodsReceiptUpsertBulk) SetPoNumber(v string) *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.SetPoNumber(v)
+	})
+}
+
+// UpdatePoNumber sets the "po_number" field to the value that was provided on create.
+func (u *GoodsReceiptUpsertBulk) UpdatePoNumber() *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.UpdatePoNumber()
+	})
+}
+
+// SetGrnID sets the "grn_id" field.
+func (u *GoodsReceiptUpsertBulk) SetGrnID(v string) *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.SetGrnID(v)
+	})
+}
+
+// UpdateGrnID sets the "grn_id" field to the value that was provided on create.
+func (u *GoodsReceiptUpsertBulk) UpdateGrnID() *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.UpdateGrnID()
+	})
+}
+
+// SetGrnNumber sets the "grn_number" field.
+func (u *GoodsReceiptUpsertBulk) SetGrnNumber(v string) *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.SetGrnNumber(v)
+	})
+}
+
+// UpdateGrnNumber sets the "grn_number" field to the value that was provided on create.
+func (u *GoodsReceiptUpsertBulk) UpdateGrnNumber() *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.UpdateGrnNumber()
+	})
+}
+
+// ClearGrnNumber clears the value of the "grn_number" field.
+func (u *GoodsReceiptUpsertBulk) ClearGrnNumber() *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.ClearGrnNumber()
+	})
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (u *GoodsReceiptUpsertBulk) SetVendorID(v uuid.UUID) *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.SetVendorID(v)
+	})
+}
+
+// UpdateVendorID sets the "vendor_id" field to the value that was provided on create.
+func (u *GoodsReceiptUpsertBulk) UpdateVendorID() *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.UpdateVendorID()
+	})
+}
+
+// ClearVendorID clears the value of the "vendor_id" field.
+func (u *GoodsReceiptUpsertBulk) ClearVendorID() *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.ClearVendorID()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *GoodsReceiptUpsertBulk) SetCurrency(v string) *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *GoodsReceiptUpsertBulk) UpdateCurrency() *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (u *GoodsReceiptUpsertBulk) SetReceivedAt(v time.Time) *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.SetReceivedAt(v)
+	})
+}
+
+// UpdateReceivedAt sets the "received_at" field to the value that was provided on create.
+func (u *GoodsReceiptUpsertBulk) UpdateReceivedAt() *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.UpdateReceivedAt()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *GoodsReceiptUpsertBulk) SetMetadata(v map[string]interface{}) *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *GoodsReceiptUpsertBulk) UpdateMetadata() *GoodsReceiptUpsertBulk {
+	return u.Update(func(s *GoodsReceiptUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *GoodsReceiptUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GoodsReceiptCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoodsReceiptCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoodsReceiptUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceipt"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+)
+
+// GoodsReceiptDelete is the builder for deleting a GoodsReceipt entity.
+type GoodsReceiptDelete struct {
+	config
+	hooks    []Hook
+	mutation *GoodsReceiptMutation
+}
+
+// Where appends a list predicates to the GoodsReceiptDelete builder.
+func (_d *GoodsReceiptDelete) Where(ps ...predicate.GoodsReceipt) *GoodsReceiptDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *GoodsReceiptDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GoodsReceiptDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *GoodsReceiptDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(goodsreceipt.Table, sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// GoodsReceiptDeleteOne is the builder for deleting a single GoodsReceipt entity.
+type GoodsReceiptDeleteOne struct {
+	_d *GoodsReceiptDelete
+}
+
+// Where appends a list predicates to the GoodsReceiptDelete builder.
+func (_d *GoodsReceiptDeleteOne) Where(ps ...predicate.GoodsReceipt) *GoodsReceiptDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *GoodsReceiptDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{goodsreceipt.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GoodsReceiptDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
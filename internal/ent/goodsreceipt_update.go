@@ -0,0 +1,720 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceipt"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceiptline"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// GoodsReceiptUpdate is the builder for updating GoodsReceipt entities.
+type GoodsReceiptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GoodsReceiptMutation
+}
+
+// Where appends a list predicates to the GoodsReceiptUpdate builder.
+func (_u *GoodsReceiptUpdate) Where(ps ...predicate.GoodsReceipt) *GoodsReceiptUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *GoodsReceiptUpdate) SetTenantID(v uuid.UUID) *GoodsReceiptUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *GoodsReceiptUpdate) SetNillableTenantID(v *uuid.UUID) *GoodsReceiptUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetPoID sets the "po_id" field.
+func (_u *GoodsReceiptUpdate) SetPoID(v string) *GoodsReceiptUpdate {
+	_u.mutation.SetPoID(v)
+	return _u
+}
+
+// SetNillablePoID sets the "po_id" field if the given value is not nil.
+func (_u *GoodsReceiptUpdate) SetNillablePoID(v *string) *GoodsReceiptUpdate {
+	if v != nil {
+		_u.SetPoID(*v)
+	}
+	return _u
+}
+
+// ClearPoID clears the value of the "po_id" field.
+func (_u *GoodsReceiptUpdate) ClearPoID() *GoodsReceiptUpdate {
+	_u.mutation.ClearPoID()
+	return _u
+}
+
+// SetPoNumber sets the "po_number" field.
+func (_u *GoodsReceiptUpdate) SetPoNumber(v string) *GoodsReceiptUpdate {
+	_u.mutation.SetPoNumber(v)
+	return _u
+}
+
+// SetNillablePoNumber sets the "po_number" field if the given value is not nil.
+func (_u *GoodsReceiptUpdate) SetNillablePoNumber(v *string) *GoodsReceiptUpdate {
+	if v != nil {
+		_u.SetPoNumber(*v)
+	}
+	return _u
+}
+
+// SetGrnID sets the "grn_id" field.
+func (_u *GoodsReceiptUpdate) SetGrnID(v string) *GoodsReceiptUpdate {
+	_u.mutation.SetGrnID(v)
+	return _u
+}
+
+// SetNillableGrnID sets the "grn_id" field if the given value is not nil.
+func (_u *GoodsReceiptUpdate) SetNillableGrnID(v *string) *GoodsReceiptUpdate {
+	if v != nil {
+		_u.SetGrnID(*v)
+	}
+	return _u
+}
+
+// SetGrnNumber sets the "grn_number" field.
+func (_u *GoodsReceiptUpdate) SetGrnNumber(v string) *GoodsReceiptUpdate {
+	_u.mutation.SetGrnNumber(v)
+	return _u
+}
+
+// SetNillableGrnNumber sets the "grn_number" field if the given value is not nil.
+func (_u *GoodsReceiptUpdate) SetNillableGrnNumber(v *string) *GoodsReceiptUpdate {
+	if v != nil {
+		_u.SetGrnNumber(*v)
+	}
+	return _u
+}
+
+// ClearGrnNumber clears the value of the "grn_number" field.
+func (_u *GoodsReceiptUpdate) ClearGrnNumber() *GoodsReceiptUpdate {
+	_u.mutation.ClearGrnNumber()
+	return _u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_u *GoodsReceiptUpdate) SetVendorID(v uuid.UUID) *GoodsReceiptUpdate {
+	_u.mutation.SetVendorID(v)
+	return _u
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_u *GoodsReceiptUpdate) SetNillableVendorID(v *uuid.UUID) *GoodsReceiptUpdate {
+	if v != nil {
+		_u.SetVendorID(*v)
+	}
+	return _u
+}
+
+// ClearVendorID clears the value of the "vendor_id" field.
+func (_u *GoodsReceiptUpdate) ClearVendorID() *GoodsReceiptUpdate {
+	_u.mutation.ClearVendorID()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *GoodsReceiptUpdate) SetCurrency(v string) *GoodsReceiptUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *GoodsReceiptUpdate) SetNillableCurrency(v *string) *GoodsReceiptUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_u *GoodsReceiptUpdate) SetReceivedAt(v time.Time) *GoodsReceiptUpdate {
+	_u.mutation.SetReceivedAt(v)
+	return _u
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_u *GoodsReceiptUpdate) SetNillableReceivedAt(v *time.Time) *GoodsReceiptUpdate {
+	if v != nil {
+		_u.SetReceivedAt(*v)
+	}
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *GoodsReceiptUpdate) SetMetadata(v map[string]interface{}) *GoodsReceiptUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// AddLineIDs adds the "lines" edge to the GoodsReceiptLine entity by IDs.
+func (_u *GoodsReceiptUpdate) AddLineIDs(ids ...uuid.UUID) *GoodsReceiptUpdate {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the GoodsReceiptLine entity.
+func (_u *GoodsReceiptUpdate) AddLines(v ...*GoodsReceiptLine) *GoodsReceiptUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
+// Mutation returns the GoodsReceiptMutation object of the builder.
+func (_u *GoodsReceiptUpdate) Mutation() *GoodsReceiptMutation {
+	return _u.mutation
+}
+
+// ClearLines clears all "lines" edges to the GoodsReceiptLine entity.
+func (_u *GoodsReceiptUpdate) ClearLines() *GoodsReceiptUpdate {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to GoodsReceiptLine entities by IDs.
+func (_u *GoodsReceiptUpdate) RemoveLineIDs(ids ...uuid.UUID) *GoodsReceiptUpdate {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to GoodsReceiptLine entities.
+func (_u *GoodsReceiptUpdate) RemoveLines(v ...*GoodsReceiptLine) *GoodsReceiptUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GoodsReceiptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoodsReceiptUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GoodsReceiptUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoodsReceiptUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoodsReceiptUpdate) check() error {
+	if v, ok := _u.mutation.PoNumber(); ok {
+		if err := goodsreceipt.PoNumberValidator(v); err != nil {
+			return &ValidationError{Name: "po_number", err: fmt.Errorf(`ent: validator failed for field "GoodsReceipt.po_number": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GrnID(); ok {
+		if err := goodsreceipt.GrnIDValidator(v); err != nil {
+			return &ValidationError{Name: "grn_id", err: fmt.Errorf(`ent: validator failed for field "GoodsReceipt.grn_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GoodsReceiptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goodsreceipt.Table, goodsreceipt.Columns, sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(goodsreceipt.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PoID(); ok {
+		_spec.SetField(goodsreceipt.FieldPoID, field.TypeString, value)
+	}
+	if _u.mutation.PoIDCleared() {
+		_spec.ClearField(goodsreceipt.FieldPoID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PoNumber(); ok {
+		_spec.SetField(goodsreceipt.FieldPoNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GrnID(); ok {
+		_spec.SetField(goodsreceipt.FieldGrnID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GrnNumber(); ok {
+		_spec.SetField(goodsreceipt.FieldGrnNumber, field.TypeString, value)
+	}
+	if _u.mutation.GrnNumberCleared() {
+		_spec.ClearField(goodsreceipt.FieldGrnNumber, field.TypeString)
+	}
+	if value, ok := _u.mutation.VendorID(); ok {
+		_spec.SetField(goodsreceipt.FieldVendorID, field.TypeUUID, value)
+	}
+	if _u.mutation.VendorIDCleared() {
+		_spec.ClearField(goodsreceipt.FieldVendorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(goodsreceipt.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReceivedAt(); ok {
+		_spec.SetField(goodsreceipt.FieldReceivedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(goodsreceipt.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goodsreceipt.LinesTable,
+			Columns: []string{goodsreceipt.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goodsreceipt.LinesTable,
+			Columns: []string{goodsreceipt.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goodsreceipt.LinesTable,
+			Columns: []string{goodsreceipt.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goodsreceipt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GoodsReceiptUpdateOne is the builder for updating a single GoodsReceipt entity.
+type GoodsReceiptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GoodsReceiptMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *GoodsReceiptUpdateOne) SetTenantID(v uuid.UUID) *GoodsReceiptUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *GoodsReceiptUpdateOne) SetNillableTenantID(v *uuid.UUID) *GoodsReceiptUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetPoID sets the "po_id" field.
+func (_u *GoodsReceiptUpdateOne) SetPoID(v string) *GoodsReceiptUpdateOne {
+	_u.mutation.SetPoID(v)
+	return _u
+}
+
+// SetNillablePoID sets the "po_id" field if the given value is not nil.
+func (_u *GoodsReceiptUpdateOne) SetNillablePoID(v *string) *GoodsReceiptUpdateOne {
+	if v != nil {
+		_u.SetPoID(*v)
+	}
+	return _u
+}
+
+// ClearPoID clears the value of the "po_id" field.
+func (_u *GoodsReceiptUpdateOne) ClearPoID() *GoodsReceiptUpdateOne {
+	_u.mutation.ClearPoID()
+	return _u
+}
+
+// SetPoNumber sets the "po_number" field.
+func (_u *GoodsReceiptUpdateOne) SetPoNumber(v string) *GoodsReceiptUpdateOne {
+	_u.mutation.SetPoNumber(v)
+	return _u
+}
+
+// SetNillablePoNumber sets the "po_number" field if the given value is not nil.
+func (_u *GoodsReceiptUpdateOne) SetNillablePoNumber(v *string) *GoodsReceiptUpdateOne {
+	if v != nil {
+		_u.SetPoNumber(*v)
+	}
+	return _u
+}
+
+// SetGrnID sets the "grn_id" field.
+func (_u *GoodsReceiptUpdateOne) SetGrnID(v string) *GoodsReceiptUpdateOne {
+	_u.mutation.SetGrnID(v)
+	return _u
+}
+
+// SetNillableGrnID sets the "grn_id" field if the given value is not nil.
+func (_u *GoodsReceiptUpdateOne) SetNillableGrnID(v *string) *GoodsReceiptUpdateOne {
+	if v != nil {
+		_u.SetGrnID(*v)
+	}
+	return _u
+}
+
+// SetGrnNumber sets the "grn_number" field.
+func (_u *GoodsReceiptUpdateOne) SetGrnNumber(v string) *GoodsReceiptUpdateOne {
+	_u.mutation.SetGrnNumber(v)
+	return _u
+}
+
+// SetNillableGrnNumber sets the "grn_number" field if the given value is not nil.
+func (_u *GoodsReceiptUpdateOne) SetNillableGrnNumber(v *string) *GoodsReceiptUpdateOne {
+	if v != nil {
+		_u.SetGrnNumber(*v)
+	}
+	return _u
+}
+
+// ClearGrnNumber clears the value of the "grn_number" field.
+func (_u *GoodsReceiptUpdateOne) ClearGrnNumber() *GoodsReceiptUpdateOne {
+	_u.mutation.ClearGrnNumber()
+	return _u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_u *GoodsReceiptUpdateOne) SetVendorID(v uuid.UUID) *GoodsReceiptUpdateOne {
+	_u.mutation.SetVendorID(v)
+	return _u
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_u *GoodsReceiptUpdateOne) SetNillableVendorID(v *uuid.UUID) *GoodsReceiptUpdateOne {
+	if v != nil {
+		_u.SetVendorID(*v)
+	}
+	return _u
+}
+
+// ClearVendorID clears the value of the "vendor_id" field.
+func (_u *GoodsReceiptUpdateOne) ClearVendorID() *GoodsReceiptUpdateOne {
+	_u.mutation.ClearVendorID()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *GoodsReceiptUpdateOne) SetCurrency(v string) *GoodsReceiptUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *GoodsReceiptUpdateOne) SetNillableCurrency(v *string) *GoodsReceiptUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_u *GoodsReceiptUpdateOne) SetReceivedAt(v time.Time) *GoodsReceiptUpdateOne {
+	_u.mutation.SetReceivedAt(v)
+	return _u
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_u *GoodsReceiptUpdateOne) SetNillableReceivedAt(v *time.Time) *GoodsReceiptUpdateOne {
+	if v != nil {
+		_u.SetReceivedAt(*v)
+	}
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *GoodsReceiptUpdateOne) SetMetadata(v map[string]interface{}) *GoodsReceiptUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// AddLineIDs adds the "lines" edge to the GoodsReceiptLine entity by IDs.
+func (_u *GoodsReceiptUpdateOne) AddLineIDs(ids ...uuid.UUID) *GoodsReceiptUpdateOne {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the GoodsReceiptLine entity.
+func (_u *GoodsReceiptUpdateOne) AddLines(v ...*GoodsReceiptLine) *GoodsReceiptUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
+// Mutation returns the GoodsReceiptMutation object of the builder.
+func (_u *GoodsReceiptUpdateOne) Mutation() *GoodsReceiptMutation {
+	return _u.mutation
+}
+
+// ClearLines clears all "lines" edges to the GoodsReceiptLine entity.
+func (_u *GoodsReceiptUpdateOne) ClearLines() *GoodsReceiptUpdateOne {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to GoodsReceiptLine entities by IDs.
+func (_u *GoodsReceiptUpdateOne) RemoveLineIDs(ids ...uuid.UUID) *GoodsReceiptUpdateOne {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to GoodsReceiptLine entities.
+func (_u *GoodsReceiptUpdateOne) RemoveLines(v ...*GoodsReceiptLine) *GoodsReceiptUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
+// Where appends a list predicates to the GoodsReceiptUpdate builder.
+func (_u *GoodsReceiptUpdateOne) Where(ps ...predicate.GoodsReceipt) *GoodsReceiptUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GoodsReceiptUpdateOne) Select(field string, fields ...string) *GoodsReceiptUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GoodsReceipt entity.
+func (_u *GoodsReceiptUpdateOne) Save(ctx context.Context) (*GoodsReceipt, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoodsReceiptUpdateOne) SaveX(ctx context.Context) *GoodsReceipt {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GoodsReceiptUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoodsReceiptUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoodsReceiptUpdateOne) check() error {
+	if v, ok := _u.mutation.PoNumber(); ok {
+		if err := goodsreceipt.PoNumberValidator(v); err != nil {
+			return &ValidationError{Name: "po_number", err: fmt.Errorf(`ent: validator failed for field "GoodsReceipt.po_number": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GrnID(); ok {
+		if err := goodsreceipt.GrnIDValidator(v); err != nil {
+			return &ValidationError{Name: "grn_id", err: fmt.Errorf(`ent: validator failed for field "GoodsReceipt.grn_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GoodsReceiptUpdateOne) sqlSave(ctx context.Context) (_node *GoodsReceipt, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goodsreceipt.Table, goodsreceipt.Columns, sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GoodsReceipt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, goodsreceipt.FieldID)
+		for _, f := range fields {
+			if !goodsreceipt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != goodsreceipt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(goodsreceipt.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PoID(); ok {
+		_spec.SetField(goodsreceipt.FieldPoID, field.TypeString, value)
+	}
+	if _u.mutation.PoIDCleared() {
+		_spec.ClearField(goodsreceipt.FieldPoID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PoNumber(); ok {
+		_spec.SetField(goodsreceipt.FieldPoNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GrnID(); ok {
+		_spec.SetField(goodsreceipt.FieldGrnID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GrnNumber(); ok {
+		_spec.SetField(goodsreceipt.FieldGrnNumber, field.TypeString, value)
+	}
+	if _u.mutation.GrnNumberCleared() {
+		_spec.ClearField(goodsreceipt.FieldGrnNumber, field.TypeString)
+	}
+	if value, ok := _u.mutation.VendorID(); ok {
+		_spec.SetField(goodsreceipt.FieldVendorID, field.TypeUUID, value)
+	}
+	if _u.mutation.VendorIDCleared() {
+		_spec.ClearField(goodsreceipt.FieldVendorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(goodsreceipt.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReceivedAt(); ok {
+		_spec.SetField(goodsreceipt.FieldReceivedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(goodsreceipt.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goodsreceipt.LinesTable,
+			Columns: []string{goodsreceipt.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goodsreceipt.LinesTable,
+			Columns: []string{goodsreceipt.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goodsreceipt.LinesTable,
+			Columns: []string{goodsreceipt.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GoodsReceipt{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goodsreceipt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceipt"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceiptline"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// GoodsReceiptLine is the model entity for the GoodsReceiptLine schema.
+type GoodsReceiptLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Goods receipt identifier
+	ReceiptID uuid.UUID `json:"receipt_id,omitempty"`
+	// LineNumber holds the value of the "line_number" field.
+	LineNumber int `json:"line_number,omitempty"`
+	// Item code (SKU) as ordered
+	ItemCode string `json:"item_code,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Quantity on the purchase order (defaults to zero)
+	OrderedQuantity decimal.Decimal `json:"ordered_quantity,omitempty"`
+	// Quantity received on this note
+	ReceivedQuantity decimal.Decimal `json:"received_quantity,omitempty"`
+	// Purchase order unit price before tax
+	UnitPrice decimal.Decimal `json:"unit_price,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GoodsReceiptLineQuery when eager-loading is set.
+	Edges        GoodsReceiptLineEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GoodsReceiptLineEdges holds the relations/edges for other nodes in the graph.
+type GoodsReceiptLineEdges struct {
+	// Receipt holds the value of the receipt edge.
+	Receipt *GoodsReceipt `json:"receipt,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ReceiptOrErr returns the Receipt value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoodsReceiptLineEdges) ReceiptOrErr() (*GoodsReceipt, error) {
+	if e.Receipt != nil {
+		return e.Receipt, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: goodsreceipt.Label}
+	}
+	return nil, &NotLoadedError{edge: "receipt"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GoodsReceiptLine) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case goodsreceiptline.FieldOrderedQuantity, goodsreceiptline.FieldReceivedQuantity, goodsreceiptline.FieldUnitPrice:
+			values[i] = new(decimal.Decimal)
+		case goodsreceiptline.FieldLineNumber:
+			values[i] = new(sql.NullInt64)
+		case goodsreceiptline.FieldItemCode, goodsreceiptline.FieldDescription:
+			values[i] = new(sql.NullString)
+		case goodsreceiptline.FieldID, goodsreceiptline.FieldTenantID, goodsreceiptline.FieldReceiptID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GoodsReceiptLine fields.
+func (_m *GoodsReceiptLine) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case goodsreceiptline.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case goodsreceiptline.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case goodsreceiptline.FieldReceiptID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field receipt_id", values[i])
+			} else if value != nil {
+				_m.ReceiptID = *value
+			}
+		case goodsreceiptline.FieldLineNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field line_number", values[i])
+			} else if value.Valid {
+				_m.LineNumber = int(value.Int64)
+			}
+		case goodsreceiptline.FieldItemCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_code", values[i])
+			} else if value.Valid {
+				_m.ItemCode = value.String
+			}
+		case goodsreceiptline.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case goodsreceiptline.FieldOrderedQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field ordered_quantity", values[i])
+			} else if value != nil {
+				_m.OrderedQuantity = *value
+			}
+		case goodsreceiptline.FieldReceivedQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field received_quantity", values[i])
+			} else if value != nil {
+				_m.ReceivedQuantity = *value
+			}
+		case goodsreceiptline.FieldUnitPrice:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_price", values[i])
+			} else if value != nil {
+				_m.UnitPrice = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GoodsReceiptLine.
+// This includes values selected through modifiers, order, etc.
+func (_m *GoodsReceiptLine) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryReceipt queries the "receipt" edge of the GoodsReceiptLine entity.
+func (_m *GoodsReceiptLine) QueryReceipt() *GoodsReceiptQuery {
+	return NewGoodsReceiptLineClient(_m.config).QueryReceipt(_m)
+}
+
+// Update returns a builder for updating this GoodsReceiptLine.
+// Note that you need to call GoodsReceiptLine.Unwrap() before calling this method if this GoodsReceiptLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GoodsReceiptLine) Update() *GoodsReceiptLineUpdateOne {
+	return NewGoodsReceiptLineClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GoodsReceiptLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GoodsReceiptLine) Unwrap() *GoodsReceiptLine {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GoodsReceiptLine is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GoodsReceiptLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("GoodsReceiptLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("receipt_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReceiptID))
+	builder.WriteString(", ")
+	builder.WriteString("line_number=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LineNumber))
+	builder.WriteString(", ")
+	builder.WriteString("item_code=")
+	builder.WriteString(_m.ItemCode)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("ordered_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrderedQuantity))
+	builder.WriteString(", ")
+	builder.WriteString("received_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReceivedQuantity))
+	builder.WriteString(", ")
+	builder.WriteString("unit_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UnitPrice))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GoodsReceiptLines is a parsable slice of GoodsReceiptLine.
+type GoodsReceiptLines []*GoodsReceiptLine
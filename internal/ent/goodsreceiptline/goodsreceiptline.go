@@ -0,0 +1,135 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goodsreceiptline
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the goodsreceiptline type in the database.
+	Label = "goods_receipt_line"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldReceiptID holds the string denoting the receipt_id field in the database.
+	FieldReceiptID = "receipt_id"
+	// FieldLineNumber holds the string denoting the line_number field in the database.
+	FieldLineNumber = "line_number"
+	// FieldItemCode holds the string denoting the item_code field in the database.
+	FieldItemCode = "item_code"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldOrderedQuantity holds the string denoting the ordered_quantity field in the database.
+	FieldOrderedQuantity = "ordered_quantity"
+	// FieldReceivedQuantity holds the string denoting the received_quantity field in the database.
+	FieldReceivedQuantity = "received_quantity"
+	// FieldUnitPrice holds the string denoting the unit_price field in the database.
+	FieldUnitPrice = "unit_price"
+	// EdgeReceipt holds the string denoting the receipt edge name in mutations.
+	EdgeReceipt = "receipt"
+	// Table holds the table name of the goodsreceiptline in the database.
+	Table = "goods_receipt_lines"
+	// ReceiptTable is the table that holds the receipt relation/edge.
+	ReceiptTable = "goods_receipt_lines"
+	// ReceiptInverseTable is the table name for the GoodsReceipt entity.
+	// It exists in this package in order to avoid circular dependency with the "goodsreceipt" package.
+	ReceiptInverseTable = "goods_receipts"
+	// ReceiptColumn is the table column denoting the receipt relation/edge.
+	ReceiptColumn = "receipt_id"
+)
+
+// Columns holds all SQL columns for goodsreceiptline fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldReceiptID,
+	FieldLineNumber,
+	FieldItemCode,
+	FieldDescription,
+	FieldOrderedQuantity,
+	FieldReceivedQuantity,
+	FieldUnitPrice,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ItemCodeValidator is a validator for the "item_code" field. It is called by the builders before save.
+	ItemCodeValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the GoodsReceiptLine queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByReceiptID orders the results by the receipt_id field.
+func ByReceiptID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceiptID, opts...).ToFunc()
+}
+
+// ByLineNumber orders the results by the line_number field.
+func ByLineNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLineNumber, opts...).ToFunc()
+}
+
+// ByItemCode orders the results by the item_code field.
+func ByItemCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemCode, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByOrderedQuantity orders the results by the ordered_quantity field.
+func ByOrderedQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderedQuantity, opts...).ToFunc()
+}
+
+// ByReceivedQuantity orders the results by the received_quantity field.
+func ByReceivedQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedQuantity, opts...).ToFunc()
+}
+
+// ByUnitPrice orders the results by the unit_price field.
+func ByUnitPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnitPrice, opts...).ToFunc()
+}
+
+// ByReceiptField orders the results by receipt field.
+func ByReceiptField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReceiptStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newReceiptStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReceiptInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReceiptTable, ReceiptColumn),
+	)
+}
@@ -0,0 +1,504 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goodsreceiptline
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ReceiptID applies equality check predicate on the "receipt_id" field. It's identical to ReceiptIDEQ.
+func ReceiptID(v uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldReceiptID, v))
+}
+
+// LineNumber applies equality check predicate on the "line_number" field. It's identical to LineNumberEQ.
+func LineNumber(v int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldLineNumber, v))
+}
+
+// ItemCode applies equality check predicate on the "item_code" field. It's identical to ItemCodeEQ.
+func ItemCode(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldItemCode, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldDescription, v))
+}
+
+// OrderedQuantity applies equality check predicate on the "ordered_quantity" field. It's identical to OrderedQuantityEQ.
+func OrderedQuantity(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldOrderedQuantity, v))
+}
+
+// ReceivedQuantity applies equality check predicate on the "received_quantity" field. It's identical to ReceivedQuantityEQ.
+func ReceivedQuantity(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldReceivedQuantity, v))
+}
+
+// UnitPrice applies equality check predicate on the "unit_price" field. It's identical to UnitPriceEQ.
+func UnitPrice(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLTE(FieldTenantID, v))
+}
+
+// ReceiptIDEQ applies the EQ predicate on the "receipt_id" field.
+func ReceiptIDEQ(v uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldReceiptID, v))
+}
+
+// ReceiptIDNEQ applies the NEQ predicate on the "receipt_id" field.
+func ReceiptIDNEQ(v uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNEQ(FieldReceiptID, v))
+}
+
+// ReceiptIDIn applies the In predicate on the "receipt_id" field.
+func ReceiptIDIn(vs ...uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldIn(FieldReceiptID, vs...))
+}
+
+// ReceiptIDNotIn applies the NotIn predicate on the "receipt_id" field.
+func ReceiptIDNotIn(vs ...uuid.UUID) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNotIn(FieldReceiptID, vs...))
+}
+
+// LineNumberEQ applies the EQ predicate on the "line_number" field.
+func LineNumberEQ(v int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldLineNumber, v))
+}
+
+// LineNumberNEQ applies the NEQ predicate on the "line_number" field.
+func LineNumberNEQ(v int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNEQ(FieldLineNumber, v))
+}
+
+// LineNumberIn applies the In predicate on the "line_number" field.
+func LineNumberIn(vs ...int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldIn(FieldLineNumber, vs...))
+}
+
+// LineNumberNotIn applies the NotIn predicate on the "line_number" field.
+func LineNumberNotIn(vs ...int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNotIn(FieldLineNumber, vs...))
+}
+
+// LineNumberGT applies the GT predicate on the "line_number" field.
+func LineNumberGT(v int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGT(FieldLineNumber, v))
+}
+
+// LineNumberGTE applies the GTE predicate on the "line_number" field.
+func LineNumberGTE(v int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGTE(FieldLineNumber, v))
+}
+
+// LineNumberLT applies the LT predicate on the "line_number" field.
+func LineNumberLT(v int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLT(FieldLineNumber, v))
+}
+
+// LineNumberLTE applies the LTE predicate on the "line_number" field.
+func LineNumberLTE(v int) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLTE(FieldLineNumber, v))
+}
+
+// ItemCodeEQ applies the EQ predicate on the "item_code" field.
+func ItemCodeEQ(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldItemCode, v))
+}
+
+// ItemCodeNEQ applies the NEQ predicate on the "item_code" field.
+func ItemCodeNEQ(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNEQ(FieldItemCode, v))
+}
+
+// ItemCodeIn applies the In predicate on the "item_code" field.
+func ItemCodeIn(vs ...string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldIn(FieldItemCode, vs...))
+}
+
+// ItemCodeNotIn applies the NotIn predicate on the "item_code" field.
+func ItemCodeNotIn(vs ...string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNotIn(FieldItemCode, vs...))
+}
+
+// ItemCodeGT applies the GT predicate on the "item_code" field.
+func ItemCodeGT(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGT(FieldItemCode, v))
+}
+
+// ItemCodeGTE applies the GTE predicate on the "item_code" field.
+func ItemCodeGTE(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGTE(FieldItemCode, v))
+}
+
+// ItemCodeLT applies the LT predicate on the "item_code" field.
+func ItemCodeLT(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLT(FieldItemCode, v))
+}
+
+// ItemCodeLTE applies the LTE predicate on the "item_code" field.
+func ItemCodeLTE(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLTE(FieldItemCode, v))
+}
+
+// ItemCodeContains applies the Contains predicate on the "item_code" field.
+func ItemCodeContains(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldContains(FieldItemCode, v))
+}
+
+// ItemCodeHasPrefix applies the HasPrefix predicate on the "item_code" field.
+func ItemCodeHasPrefix(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldHasPrefix(FieldItemCode, v))
+}
+
+// ItemCodeHasSuffix applies the HasSuffix predicate on the "item_code" field.
+func ItemCodeHasSuffix(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldHasSuffix(FieldItemCode, v))
+}
+
+// ItemCodeEqualFold applies the EqualFold predicate on the "item_code" field.
+func ItemCodeEqualFold(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEqualFold(FieldItemCode, v))
+}
+
+// ItemCodeContainsFold applies the ContainsFold predicate on the "item_code" field.
+func ItemCodeContainsFold(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldContainsFold(FieldItemCode, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// OrderedQuantityEQ applies the EQ predicate on the "ordered_quantity" field.
+func OrderedQuantityEQ(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldOrderedQuantity, v))
+}
+
+// OrderedQuantityNEQ applies the NEQ predicate on the "ordered_quantity" field.
+func OrderedQuantityNEQ(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNEQ(FieldOrderedQuantity, v))
+}
+
+// OrderedQuantityIn applies the In predicate on the "ordered_quantity" field.
+func OrderedQuantityIn(vs ...decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldIn(FieldOrderedQuantity, vs...))
+}
+
+// OrderedQuantityNotIn applies the NotIn predicate on the "ordered_quantity" field.
+func OrderedQuantityNotIn(vs ...decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNotIn(FieldOrderedQuantity, vs...))
+}
+
+// OrderedQuantityGT applies the GT predicate on the "ordered_quantity" field.
+func OrderedQuantityGT(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGT(FieldOrderedQuantity, v))
+}
+
+// OrderedQuantityGTE applies the GTE predicate on the "ordered_quantity" field.
+func OrderedQuantityGTE(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGTE(FieldOrderedQuantity, v))
+}
+
+// OrderedQuantityLT applies the LT predicate on the "ordered_quantity" field.
+func OrderedQuantityLT(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLT(FieldOrderedQuantity, v))
+}
+
+// OrderedQuantityLTE applies the LTE predicate on the "ordered_quantity" field.
+func OrderedQuantityLTE(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLTE(FieldOrderedQuantity, v))
+}
+
+// OrderedQuantityIsNil applies the IsNil predicate on the "ordered_quantity" field.
+func OrderedQuantityIsNil() predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldIsNull(FieldOrderedQuantity))
+}
+
+// OrderedQuantityNotNil applies the NotNil predicate on the "ordered_quantity" field.
+func OrderedQuantityNotNil() predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNotNull(FieldOrderedQuantity))
+}
+
+// ReceivedQuantityEQ applies the EQ predicate on the "received_quantity" field.
+func ReceivedQuantityEQ(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldReceivedQuantity, v))
+}
+
+// ReceivedQuantityNEQ applies the NEQ predicate on the "received_quantity" field.
+func ReceivedQuantityNEQ(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNEQ(FieldReceivedQuantity, v))
+}
+
+// ReceivedQuantityIn applies the In predicate on the "received_quantity" field.
+func ReceivedQuantityIn(vs ...decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldIn(FieldReceivedQuantity, vs...))
+}
+
+// ReceivedQuantityNotIn applies the NotIn predicate on the "received_quantity" field.
+func ReceivedQuantityNotIn(vs ...decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNotIn(FieldReceivedQuantity, vs...))
+}
+
+// ReceivedQuantityGT applies the GT predicate on the "received_quantity" field.
+func ReceivedQuantityGT(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGT(FieldReceivedQuantity, v))
+}
+
+// ReceivedQuantityGTE applies the GTE predicate on the "received_quantity" field.
+func ReceivedQuantityGTE(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGTE(FieldReceivedQuantity, v))
+}
+
+// ReceivedQuantityLT applies the LT predicate on the "received_quantity" field.
+func ReceivedQuantityLT(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLT(FieldReceivedQuantity, v))
+}
+
+// ReceivedQuantityLTE applies the LTE predicate on the "received_quantity" field.
+func ReceivedQuantityLTE(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLTE(FieldReceivedQuantity, v))
+}
+
+// UnitPriceEQ applies the EQ predicate on the "unit_price" field.
+func UnitPriceEQ(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceNEQ applies the NEQ predicate on the "unit_price" field.
+func UnitPriceNEQ(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceIn applies the In predicate on the "unit_price" field.
+func UnitPriceIn(vs ...decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceNotIn applies the NotIn predicate on the "unit_price" field.
+func UnitPriceNotIn(vs ...decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldNotIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceGT applies the GT predicate on the "unit_price" field.
+func UnitPriceGT(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGT(FieldUnitPrice, v))
+}
+
+// UnitPriceGTE applies the GTE predicate on the "unit_price" field.
+func UnitPriceGTE(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldGTE(FieldUnitPrice, v))
+}
+
+// UnitPriceLT applies the LT predicate on the "unit_price" field.
+func UnitPriceLT(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLT(FieldUnitPrice, v))
+}
+
+// UnitPriceLTE applies the LTE predicate on the "unit_price" field.
+func UnitPriceLTE(v decimal.Decimal) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.FieldLTE(FieldUnitPrice, v))
+}
+
+// HasReceipt applies the HasEdge predicate on the "receipt" edge.
+func HasReceipt() predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReceiptTable, ReceiptColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReceiptWith applies the HasEdge predicate on the "receipt" edge with a given conditions (other predicates).
+func HasReceiptWith(preds ...predicate.GoodsReceipt) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(func(s *sql.Selector) {
+		step := newReceiptStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GoodsReceiptLine) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GoodsReceiptLine) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GoodsReceiptLine) predicate.GoodsReceiptLine {
+	return predicate.GoodsReceiptLine(sql.NotPredicates(p))
+}
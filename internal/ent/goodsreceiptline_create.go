@@ -0,0 +1,1037 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceipt"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceiptline"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// GoodsReceiptLineCreate is the builder for creating a GoodsReceiptLine entity.
+type GoodsReceiptLineCreate struct {
+	config
+	mutation *GoodsReceiptLineMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *GoodsReceiptLineCreate) SetTenantID(v uuid.UUID) *GoodsReceiptLineCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (_c *GoodsReceiptLineCreate) SetReceiptID(v uuid.UUID) *GoodsReceiptLineCreate {
+	_c.mutation.SetReceiptID(v)
+	return _c
+}
+
+// SetLineNumber sets the "line_number" field.
+func (_c *GoodsReceiptLineCreate) SetLineNumber(v int) *GoodsReceiptLineCreate {
+	_c.mutation.SetLineNumber(v)
+	return _c
+}
+
+// SetItemCode sets the "item_code" field.
+func (_c *GoodsReceiptLineCreate) SetItemCode(v string) *GoodsReceiptLineCreate {
+	_c.mutation.SetItemCode(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *GoodsReceiptLineCreate) SetDescription(v string) *GoodsReceiptLineCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *GoodsReceiptLineCreate) SetNillableDescription(v *string) *GoodsReceiptLineCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetOrderedQuantity sets the "ordered_quantity" field.
+func (_c *GoodsReceiptLineCreate) SetOrderedQuantity(v decimal.Decimal) *GoodsReceiptLineCreate {
+	_c.mutation.SetOrderedQuantity(v)
+	return _c
+}
+
+// SetNillableOrderedQuantity sets the "ordered_quantity" field if the given value is not nil.
+func (_c *GoodsReceiptLineCreate) SetNillableOrderedQuantity(v *decimal.Decimal) *GoodsReceiptLineCreate {
+	if v != nil {
+		_c.SetOrderedQuantity(*v)
+	}
+	return _c
+}
+
+// SetReceivedQuantity sets the "received_quantity" field.
+func (_c *GoodsReceiptLineCreate) SetReceivedQuantity(v decimal.Decimal) *GoodsReceiptLineCreate {
+	_c.mutation.SetReceivedQuantity(v)
+	return _c
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_c *GoodsReceiptLineCreate) SetUnitPrice(v decimal.Decimal) *GoodsReceiptLineCreate {
+	_c.mutation.SetUnitPrice(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GoodsReceiptLineCreate) SetID(v uuid.UUID) *GoodsReceiptLineCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *GoodsReceiptLineCreate) SetNillableID(v *uuid.UUID) *GoodsReceiptLineCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetReceipt sets the "receipt" edge to the GoodsReceipt entity.
+func (_c *GoodsReceiptLineCreate) SetReceipt(v *GoodsReceipt) *GoodsReceiptLineCreate {
+	return _c.SetReceiptID(v.ID)
+}
+
+// Mutation returns the GoodsReceiptLineMutation object of the builder.
+func (_c *GoodsReceiptLineCreate) Mutation() *GoodsReceiptLineMutation {
+	return _c.mutation
+}
+
+// Save creates the GoodsReceiptLine in the database.
+func (_c *GoodsReceiptLineCreate) Save(ctx context.Context) (*GoodsReceiptLine, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GoodsReceiptLineCreate) SaveX(ctx context.Context) *GoodsReceiptLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoodsReceiptLineCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoodsReceiptLineCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GoodsReceiptLineCreate) defaults() {
+	if _, ok := _c.mutation.ID(); !ok {
+		v := goodsreceiptline.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GoodsReceiptLineCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "GoodsReceiptLine.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.ReceiptID(); !ok {
+		return &ValidationError{Name: "receipt_id", err: errors.New(`ent: missing required field "GoodsReceiptLine.receipt_id"`)}
+	}
+	if _, ok := _c.mutation.LineNumber(); !ok {
+		return &ValidationError{Name: "line_number", err: errors.New(`ent: missing required field "GoodsReceiptLine.line_number"`)}
+	}
+	if _, ok := _c.mutation.ItemCode(); !ok {
+		return &ValidationError{Name: "item_code", err: errors.New(`ent: missing required field "GoodsReceiptLine.item_code"`)}
+	}
+	if v, ok := _c.mutation.ItemCode(); ok {
+		if err := goodsreceiptline.ItemCodeValidator(v); err != nil {
+			return &ValidationError{Name: "item_code", err: fmt.Errorf(`ent: validator failed for field "GoodsReceiptLine.item_code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ReceivedQuantity(); !ok {
+		return &ValidationError{Name: "received_quantity", err: errors.New(`ent: missing required field "GoodsReceiptLine.received_quantity"`)}
+	}
+	if _, ok := _c.mutation.UnitPrice(); !ok {
+		return &ValidationError{Name: "unit_price", err: errors.New(`ent: missing required field "GoodsReceiptLine.unit_price"`)}
+	}
+	if len(_c.mutation.ReceiptIDs()) == 0 {
+		return &ValidationError{Name: "receipt", err: errors.New(`ent: missing required edge "GoodsReceiptLine.receipt"`)}
+	}
+	return nil
+}
+
+func (_c *GoodsReceiptLineCreate) sqlSave(ctx context.Context) (*GoodsReceiptLine, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GoodsReceiptLineCreate) createSpec() (*GoodsReceiptLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GoodsReceiptLine{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(goodsreceiptline.Table, sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(goodsreceiptline.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.LineNumber(); ok {
+		_spec.SetField(goodsreceiptline.FieldLineNumber, field.TypeInt, value)
+		_node.LineNumber = value
+	}
+	if value, ok := _c.mutation.ItemCode(); ok {
+		_spec.SetField(goodsreceiptline.FieldItemCode, field.TypeString, value)
+		_node.ItemCode = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(goodsreceiptline.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.OrderedQuantity(); ok {
+		_spec.SetField(goodsreceiptline.FieldOrderedQuantity, field.TypeFloat64, value)
+		_node.OrderedQuantity = value
+	}
+	if value, ok := _c.mutation.ReceivedQuantity(); ok {
+		_spec.SetField(goodsreceiptline.FieldReceivedQuantity, field.TypeFloat64, value)
+		_node.ReceivedQuantity = value
+	}
+	if value, ok := _c.mutation.UnitPrice(); ok {
+		_spec.SetField(goodsreceiptline.FieldUnitPrice, field.TypeFloat64, value)
+		_node.UnitPrice = value
+	}
+	if nodes := _c.mutation.ReceiptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceiptline.ReceiptTable,
+			Columns: []string{goodsreceiptline.ReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReceiptID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GoodsReceiptLine.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoodsReceiptLineUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoodsReceiptLineCreate) OnConflict(opts ...sql.ConflictOption) *GoodsReceiptLineUpsertOne {
+	_c.conflict = opts
+	return &GoodsReceiptLineUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GoodsReceiptLine.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoodsReceiptLineCreate) OnConflictColumns(columns ...string) *GoodsReceiptLineUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoodsReceiptLineUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GoodsReceiptLineUpsertOne is the builder for "upsert"-ing
+	//  one GoodsReceiptLine node.
+	GoodsReceiptLineUpsertOne struct {
+		create *GoodsReceiptLineCreate
+	}
+
+	// GoodsReceiptLineUpsert is the "OnConflict" setter.
+	GoodsReceiptLineUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *GoodsReceiptLineUpsert) SetTenantID(v uuid.UUID) *GoodsReceiptLineUpsert {
+	u.Set(goodsreceiptline.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsert) UpdateTenantID() *GoodsReceiptLineUpsert {
+	u.SetExcluded(goodsreceiptline.FieldTenantID)
+	return u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *GoodsReceiptLineUpsert) SetReceiptID(v uuid.UUID) *GoodsReceiptLineUpsert {
+	u.Set(goodsreceiptline.FieldReceiptID, v)
+	return u
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsert) UpdateReceiptID() *GoodsReceiptLineUpsert {
+	u.SetExcluded(goodsreceiptline.FieldReceiptID)
+	return u
+}
+
+// SetLineNumber sets the "line_number" field.
+func (u *GoodsReceiptLineUpsert) SetLineNumber(v int) *GoodsReceiptLineUpsert {
+	u.Set(goodsreceiptline.FieldLineNumber, v)
+	return u
+}
+
+// UpdateLineNumber sets the "line_number" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsert) UpdateLineNumber() *GoodsReceiptLineUpsert {
+	u.SetExcluded(goodsreceiptline.FieldLineNumber)
+	return u
+}
+
+// AddLineNumber adds v to the "line_number" field.
+func (u *GoodsReceiptLineUpsert) AddLineNumber(v int) *GoodsReceiptLineUpsert {
+	u.Add(goodsreceiptline.FieldLineNumber, v)
+	return u
+}
+
+// SetItemCode sets the "item_code" field.
+func (u *GoodsReceiptLineUpsert) SetItemCode(v string) *GoodsReceiptLineUpsert {
+	u.Set(goodsreceiptline.FieldItemCode, v)
+	return u
+}
+
+// UpdateItemCode sets the "item_code" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsert) UpdateItemCode() *GoodsReceiptLineUpsert {
+	u.SetExcluded(goodsreceiptline.FieldItemCode)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *GoodsReceiptLineUpsert) SetDescription(v string) *GoodsReceiptLineUpsert {
+	u.Set(goodsreceiptline.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsert) UpdateDescription() *GoodsReceiptLineUpsert {
+	u.SetExcluded(goodsreceiptline.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *GoodsReceiptLineUpsert) ClearDescription() *GoodsReceiptLineUpsert {
+	u.SetNull(goodsreceiptline.FieldDescription)
+	return u
+}
+
+// SetOrderedQuantity sets the "ordered_quantity" field.
+func (u *GoodsReceiptLineUpsert) SetOrderedQuantity(v decimal.Decimal) *GoodsReceiptLineUpsert {
+	u.Set(goodsreceiptline.FieldOrderedQuantity, v)
+	return u
+}
+
+// UpdateOrderedQuantity sets the "ordered_quantity" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsert) UpdateOrderedQuantity() *GoodsReceiptLineUpsert {
+	u.SetExcluded(goodsreceiptline.FieldOrderedQuantity)
+	return u
+}
+
+// AddOrderedQuantity adds v to the "ordered_quantity" field.
+func (u *GoodsReceiptLineUpsert) AddOrderedQuantity(v decimal.Decimal) *GoodsReceiptLineUpsert {
+	u.Add(goodsreceiptline.FieldOrderedQuantity, v)
+	return u
+}
+
+// ClearOrderedQuantity clears the value of the "ordered_quantity" field.
+func (u *GoodsReceiptLineUpsert) ClearOrderedQuantity() *GoodsReceiptLineUpsert {
+	u.SetNull(goodsreceiptline.FieldOrderedQuantity)
+	return u
+}
+
+// SetReceivedQuantity sets the "received_quantity" field.
+func (u *GoodsReceiptLineUpsert) SetReceivedQuantity(v decimal.Decimal) *GoodsReceiptLineUpsert {
+	u.Set(goodsreceiptline.FieldReceivedQuantity, v)
+	return u
+}
+
+// UpdateReceivedQuantity sets the "received_quantity" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsert) UpdateReceivedQuantity() *GoodsReceiptLineUpsert {
+	u.SetExcluded(goodsreceiptline.FieldReceivedQuantity)
+	return u
+}
+
+// AddReceivedQuantity adds v to the "received_quantity" field.
+func (u *GoodsReceiptLineUpsert) AddReceivedQuantity(v decimal.Decimal) *GoodsReceiptLineUpsert {
+	u.Add(goodsreceiptline.FieldReceivedQuantity, v)
+	return u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (u *GoodsReceiptLineUpsert) SetUnitPrice(v decimal.Decimal) *GoodsReceiptLineUpsert {
+	u.Set(goodsreceiptline.FieldUnitPrice, v)
+	return u
+}
+
+// UpdateUnitPrice sets the "unit_price" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsert) UpdateUnitPrice() *GoodsReceiptLineUpsert {
+	u.SetExcluded(goodsreceiptline.FieldUnitPrice)
+	return u
+}
+
+// AddUnitPrice adds v to the "unit_price" field.
+func (u *GoodsReceiptLineUpsert) AddUnitPrice(v decimal.Decimal) *GoodsReceiptLineUpsert {
+	u.Add(goodsreceiptline.FieldUnitPrice, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GoodsReceiptLine.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(goodsreceiptline.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoodsReceiptLineUpsertOne) UpdateNewValues() *GoodsReceiptLineUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(goodsreceiptline.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GoodsReceiptLine.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GoodsReceiptLineUpsertOne) Ignore() *GoodsReceiptLineUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoodsReceiptLineUpsertOne) DoNothing() *GoodsReceiptLineUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoodsReceiptLineCreate.OnConflict
+// documentation for more info.
+func (u *GoodsReceiptLineUpsertOne) Update(set func(*GoodsReceiptLineUpsert)) *GoodsReceiptLineUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoodsReceiptLineUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *GoodsReceiptLineUpsertOne) SetTenantID(v uuid.UUID) *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsertOne) UpdateTenantID() *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *GoodsReceiptLineUpsertOne) SetReceiptID(v uuid.UUID) *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.SetReceiptID(v)
+	})
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsertOne) UpdateReceiptID() *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.UpdateReceiptID()
+	})
+}
+
+// SetLineNumber sets the "line_number" field.
+func (u *GoodsReceiptLineUpsertOne) SetLineNumber(v int) *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.SetLineNumber(v)
+	})
+}
+
+// AddLineNumber adds v to the "line_number" field.
+func (u *GoodsReceiptLineUpsertOne) AddLineNumber(v int) *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.AddLineNumber(v)
+	})
+}
+
+// UpdateLineNumber sets the "line_number" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsertOne) UpdateLineNumber() *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.UpdateLineNumber()
+	})
+}
+
+// SetItemCode sets the "item_code" field.
+func (u *GoodsReceiptLineUpsertOne) SetItemCode(v string) *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.SetItemCode(v)
+	})
+}
+
+// UpdateItemCode sets the "item_code" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsertOne) UpdateItemCode() *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.UpdateItemCode()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *GoodsReceiptLineUpsertOne) SetDescription(v string) *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsertOne) UpdateDescription() *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *GoodsReceiptLineUpsertOne) ClearDescription() *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetOrderedQuantity sets the "ordered_quantity" field.
+func (u *GoodsReceiptLineUpsertOne) SetOrderedQuantity(v decimal.Decimal) *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.SetOrderedQuantity(v)
+	})
+}
+
+// AddOrderedQuantity adds v to the "ordered_quantity" field.
+func (u *GoodsReceiptLineUpsertOne) AddOrderedQuantity(v decimal.Decimal) *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.AddOrderedQuantity(v)
+	})
+}
+
+// UpdateOrderedQuantity sets the "ordered_quantity" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsertOne) UpdateOrderedQuantity() *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.UpdateOrderedQuantity()
+	})
+}
+
+// ClearOrderedQuantity clears the value of the "ordered_quantity" field.
+func (u *GoodsReceiptLineUpsertOne) ClearOrderedQuantity() *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.ClearOrderedQuantity()
+	})
+}
+
+// SetReceivedQuantity sets the "received_quantity" field.
+func (u *GoodsReceiptLineUpsertOne) SetReceivedQuantity(v decimal.Decimal) *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.SetReceivedQuantity(v)
+	})
+}
+
+// AddReceivedQuantity adds v to the "received_quantity" field.
+func (u *GoodsReceiptLineUpsertOne) AddReceivedQuantity(v decimal.Decimal) *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.AddReceivedQuantity(v)
+	})
+}
+
+// UpdateReceivedQuantity sets the "received_quantity" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsertOne) UpdateReceivedQuantity() *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.UpdateReceivedQuantity()
+	})
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (u *GoodsReceiptLineUpsertOne) SetUnitPrice(v decimal.Decimal) *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.SetUnitPrice(v)
+	})
+}
+
+// AddUnitPrice adds v to the "unit_price" field.
+func (u *GoodsReceiptLineUpsertOne) AddUnitPrice(v decimal.Decimal) *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.AddUnitPrice(v)
+	})
+}
+
+// UpdateUnitPrice sets the "unit_price" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsertOne) UpdateUnitPrice() *GoodsReceiptLineUpsertOne {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.UpdateUnitPrice()
+	})
+}
+
+// Exec executes the query.
+func (u *GoodsReceiptLineUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoodsReceiptLineCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoodsReceiptLineUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GoodsReceiptLineUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: GoodsReceiptLineUpsertOne.ID is not supported by MySQL driver. Use GoodsReceiptLineUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GoodsReceiptLineUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GoodsReceiptLineCreateBulk is the builder for creating many GoodsReceiptLine entities in bulk.
+type GoodsReceiptLineCreateBulk struct {
+	config
+	err      error
+	builders []*GoodsReceiptLineCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GoodsReceiptLine entities in the database.
+func (_c *GoodsReceiptLineCreateBulk) Save(ctx context.Context) ([]*GoodsReceiptLine, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GoodsReceiptLine, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GoodsReceiptLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GoodsReceiptLineCreateBulk) SaveX(ctx context.Context) []*GoodsReceiptLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoodsReceiptLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoodsReceiptLineCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GoodsReceiptLine.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoodsReceiptLineUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoodsReceiptLineCreateBulk) OnConflict(opts ...sql.ConflictOption) *GoodsReceiptLineUpsertBulk {
+	_c.conflict = opts
+	return &GoodsReceiptLineUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GoodsReceiptLine.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoodsReceiptLineCreateBulk) OnConflictColumns(columns ...string) *GoodsReceiptLineUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoodsReceiptLineUpsertBulk{
+		create: _c,
+	}
+}
+
+// GoodsReceiptLineUpsertBulk is the builder for "upsert"-ing
+// a bulk of GoodsReceiptLine nodes.
+type GoodsReceiptLineUpsertBulk struct {
+	create *GoodsReceiptLineCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GoodsReceiptLine.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(goodsreceiptline.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoodsReceiptLineUpsertBulk) UpdateNewValues() *GoodsReceiptLineUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(goodsreceiptline.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GoodsReceiptLine.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GoodsReceiptLineUpsertBulk) Ignore() *GoodsReceiptLineUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoodsReceiptLineUpsertBulk) DoNothing() *GoodsReceiptLineUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoodsReceiptLineCreateBulk.OnConflict
+// documentation for more info.
+func (u *GoodsReceiptLineUpsertBulk) Update(set func(*GoodsReceiptLineUpsert)) *GoodsReceiptLineUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoodsReceiptLineUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *GoodsReceiptLineUpsertBulk) SetTenantID(v uuid.UUID) *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsertBulk) UpdateTenantID() *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *GoodsReceiptLineUpsertBulk) SetReceiptID(v uuid.UUID) *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.SetReceiptID(v)
+	})
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsertBulk) UpdateReceiptID() *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.UpdateReceiptID()
+	})
+}
+
+// SetLineNumber sets the "line_number" field.
+func (u *GoodsReceiptLineUpsertBulk) SetLineNumber(v int) *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.SetLineNumber(v)
+	})
+}
+
+// AddLineNumber adds v to the "line_number" field.
+func (u *GoodsReceiptLineUpsertBulk) AddLineNumber(v int) *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.AddLineNumber(v)
+	})
+}
+
+// UpdateLineNumber sets the "line_number" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsertBulk) UpdateLineNumber() *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.UpdateLineNumber()
+	})
+}
+
+// SetItemCode sets the "item_code" field.
+func (u *GoodsReceiptLineUpsertBulk) SetItemCode(v string) *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.SetItemCode(v)
+	})
+}
+
+// UpdateItemCode sets the "item_code" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsertBulk) UpdateItemCode() *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.UpdateItemCode()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *GoodsReceiptLineUpsertBulk) SetDescription(v string) *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsertBulk) UpdateDescription() *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *GoodsReceiptLineUpsertBulk) ClearDescription() *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetOrderedQuantity sets the "ordered_quantity" field.
+func (u *GoodsReceiptLineUpsertBulk) SetOrderedQuantity(v decimal.Decimal) *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.SetOrderedQuantity(v)
+	})
+}
+
+// AddOrderedQuantity adds v to the "ordered_quantity" field.
+func (u *GoodsReceiptLineUpsertBulk) AddOrderedQuantity(v decimal.Decimal) *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.AddOrderedQuantity(v)
+	})
+}
+
+// UpdateOrderedQuantity sets the "ordered_quantity" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsertBulk) UpdateOrderedQuantity() *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.UpdateOrderedQuantity()
+	})
+}
+
+// ClearOrderedQuantity clears the value of the "ordered_quantity" field.
+func (u *GoodsReceiptLineUpsertBulk) ClearOrderedQuantity() *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.ClearOrderedQuantity()
+	})
+}
+
+// SetReceivedQuantity sets the "received_quantity" field.
+func (u *GoodsReceiptLineUpsertBulk) SetReceivedQuantity(v decimal.Decimal) *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.SetReceivedQuantity(v)
+	})
+}
+
+// AddReceivedQuantity adds v to the "received_quantity" field.
+func (u *GoodsReceiptLineUpsertBulk) AddReceivedQuantity(v decimal.Decimal) *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.AddReceivedQuantity(v)
+	})
+}
+
+// UpdateReceivedQuantity sets the "received_quantity" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsertBulk) UpdateReceivedQuantity() *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.UpdateReceivedQuantity()
+	})
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (u *GoodsReceiptLineUpsertBulk) SetUnitPrice(v decimal.Decimal) *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.SetUnitPrice(v)
+	})
+}
+
+// AddUnitPrice adds v to the "unit_price" field.
+func (u *GoodsReceiptLineUpsertBulk) AddUnitPrice(v decimal.Decimal) *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.AddUnitPrice(v)
+	})
+}
+
+// UpdateUnitPrice sets the "unit_price" field to the value that was provided on create.
+func (u *GoodsReceiptLineUpsertBulk) UpdateUnitPrice() *GoodsReceiptLineUpsertBulk {
+	return u.Update(func(s *GoodsReceiptLineUpsert) {
+		s.UpdateUnitPrice()
+	})
+}
+
+// Exec executes the query.
+func (u *GoodsReceiptLineUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GoodsReceiptLineCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoodsReceiptLineCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoodsReceiptLineUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,672 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceipt"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceiptline"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// GoodsReceiptLineUpdate is the builder for updating GoodsReceiptLine entities.
+type GoodsReceiptLineUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GoodsReceiptLineMutation
+}
+
+// Where appends a list predicates to the GoodsReceiptLineUpdate builder.
+func (_u *GoodsReceiptLineUpdate) Where(ps ...predicate.GoodsReceiptLine) *GoodsReceiptLineUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *GoodsReceiptLineUpdate) SetTenantID(v uuid.UUID) *GoodsReceiptLineUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdate) SetNillableTenantID(v *uuid.UUID) *GoodsReceiptLineUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (_u *GoodsReceiptLineUpdate) SetReceiptID(v uuid.UUID) *GoodsReceiptLineUpdate {
+	_u.mutation.SetReceiptID(v)
+	return _u
+}
+
+// SetNillableReceiptID sets the "receipt_id" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdate) SetNillableReceiptID(v *uuid.UUID) *GoodsReceiptLineUpdate {
+	if v != nil {
+		_u.SetReceiptID(*v)
+	}
+	return _u
+}
+
+// SetLineNumber sets the "line_number" field.
+func (_u *GoodsReceiptLineUpdate) SetLineNumber(v int) *GoodsReceiptLineUpdate {
+	_u.mutation.ResetLineNumber()
+	_u.mutation.SetLineNumber(v)
+	return _u
+}
+
+// SetNillableLineNumber sets the "line_number" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdate) SetNillableLineNumber(v *int) *GoodsReceiptLineUpdate {
+	if v != nil {
+		_u.SetLineNumber(*v)
+	}
+	return _u
+}
+
+// AddLineNumber adds value to the "line_number" field.
+func (_u *GoodsReceiptLineUpdate) AddLineNumber(v int) *GoodsReceiptLineUpdate {
+	_u.mutation.AddLineNumber(v)
+	return _u
+}
+
+// SetItemCode sets the "item_code" field.
+func (_u *GoodsReceiptLineUpdate) SetItemCode(v string) *GoodsReceiptLineUpdate {
+	_u.mutation.SetItemCode(v)
+	return _u
+}
+
+// SetNillableItemCode sets the "item_code" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdate) SetNillableItemCode(v *string) *GoodsReceiptLineUpdate {
+	if v != nil {
+		_u.SetItemCode(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *GoodsReceiptLineUpdate) SetDescription(v string) *GoodsReceiptLineUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdate) SetNillableDescription(v *string) *GoodsReceiptLineUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *GoodsReceiptLineUpdate) ClearDescription() *GoodsReceiptLineUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetOrderedQuantity sets the "ordered_quantity" field.
+func (_u *GoodsReceiptLineUpdate) SetOrderedQuantity(v decimal.Decimal) *GoodsReceiptLineUpdate {
+	_u.mutation.ResetOrderedQuantity()
+	_u.mutation.SetOrderedQuantity(v)
+	return _u
+}
+
+// SetNillableOrderedQuantity sets the "ordered_quantity" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdate) SetNillableOrderedQuantity(v *decimal.Decimal) *GoodsReceiptLineUpdate {
+	if v != nil {
+		_u.SetOrderedQuantity(*v)
+	}
+	return _u
+}
+
+// AddOrderedQuantity adds value to the "ordered_quantity" field.
+func (_u *GoodsReceiptLineUpdate) AddOrderedQuantity(v decimal.Decimal) *GoodsReceiptLineUpdate {
+	_u.mutation.AddOrderedQuantity(v)
+	return _u
+}
+
+// ClearOrderedQuantity clears the value of the "ordered_quantity" field.
+func (_u *GoodsReceiptLineUpdate) ClearOrderedQuantity() *GoodsReceiptLineUpdate {
+	_u.mutation.ClearOrderedQuantity()
+	return _u
+}
+
+// SetReceivedQuantity sets the "received_quantity" field.
+func (_u *GoodsReceiptLineUpdate) SetReceivedQuantity(v decimal.Decimal) *GoodsReceiptLineUpdate {
+	_u.mutation.ResetReceivedQuantity()
+	_u.mutation.SetReceivedQuantity(v)
+	return _u
+}
+
+// SetNillableReceivedQuantity sets the "received_quantity" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdate) SetNillableReceivedQuantity(v *decimal.Decimal) *GoodsReceiptLineUpdate {
+	if v != nil {
+		_u.SetReceivedQuantity(*v)
+	}
+	return _u
+}
+
+// AddReceivedQuantity adds value to the "received_quantity" field.
+func (_u *GoodsReceiptLineUpdate) AddReceivedQuantity(v decimal.Decimal) *GoodsReceiptLineUpdate {
+	_u.mutation.AddReceivedQuantity(v)
+	return _u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_u *GoodsReceiptLineUpdate) SetUnitPrice(v decimal.Decimal) *GoodsReceiptLineUpdate {
+	_u.mutation.ResetUnitPrice()
+	_u.mutation.SetUnitPrice(v)
+	return _u
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdate) SetNillableUnitPrice(v *decimal.Decimal) *GoodsReceiptLineUpdate {
+	if v != nil {
+		_u.SetUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddUnitPrice adds value to the "unit_price" field.
+func (_u *GoodsReceiptLineUpdate) AddUnitPrice(v decimal.Decimal) *GoodsReceiptLineUpdate {
+	_u.mutation.AddUnitPrice(v)
+	return _u
+}
+
+// SetReceipt sets the "receipt" edge to the GoodsReceipt entity.
+func (_u *GoodsReceiptLineUpdate) SetReceipt(v *GoodsReceipt) *GoodsReceiptLineUpdate {
+	return _u.SetReceiptID(v.ID)
+}
+
+// Mutation returns the GoodsReceiptLineMutation object of the builder.
+func (_u *GoodsReceiptLineUpdate) Mutation() *GoodsReceiptLineMutation {
+	return _u.mutation
+}
+
+// ClearReceipt clears the "receipt" edge to the GoodsReceipt entity.
+func (_u *GoodsReceiptLineUpdate) ClearReceipt() *GoodsReceiptLineUpdate {
+	_u.mutation.ClearReceipt()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GoodsReceiptLineUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoodsReceiptLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GoodsReceiptLineUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoodsReceiptLineUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoodsReceiptLineUpdate) check() error {
+	if v, ok := _u.mutation.ItemCode(); ok {
+		if err := goodsreceiptline.ItemCodeValidator(v); err != nil {
+			return &ValidationError{Name: "item_code", err: fmt.Errorf(`ent: validator failed for field "GoodsReceiptLine.item_code": %w`, err)}
+		}
+	}
+	if _u.mutation.ReceiptCleared() && len(_u.mutation.ReceiptIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoodsReceiptLine.receipt"`)
+	}
+	return nil
+}
+
+func (_u *GoodsReceiptLineUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goodsreceiptline.Table, goodsreceiptline.Columns, sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(goodsreceiptline.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.LineNumber(); ok {
+		_spec.SetField(goodsreceiptline.FieldLineNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLineNumber(); ok {
+		_spec.AddField(goodsreceiptline.FieldLineNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ItemCode(); ok {
+		_spec.SetField(goodsreceiptline.FieldItemCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(goodsreceiptline.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(goodsreceiptline.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.OrderedQuantity(); ok {
+		_spec.SetField(goodsreceiptline.FieldOrderedQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOrderedQuantity(); ok {
+		_spec.AddField(goodsreceiptline.FieldOrderedQuantity, field.TypeFloat64, value)
+	}
+	if _u.mutation.OrderedQuantityCleared() {
+		_spec.ClearField(goodsreceiptline.FieldOrderedQuantity, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ReceivedQuantity(); ok {
+		_spec.SetField(goodsreceiptline.FieldReceivedQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedReceivedQuantity(); ok {
+		_spec.AddField(goodsreceiptline.FieldReceivedQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.UnitPrice(); ok {
+		_spec.SetField(goodsreceiptline.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedUnitPrice(); ok {
+		_spec.AddField(goodsreceiptline.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.ReceiptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceiptline.ReceiptTable,
+			Columns: []string{goodsreceiptline.ReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReceiptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceiptline.ReceiptTable,
+			Columns: []string{goodsreceiptline.ReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goodsreceiptline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GoodsReceiptLineUpdateOne is the builder for updating a single GoodsReceiptLine entity.
+type GoodsReceiptLineUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GoodsReceiptLineMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *GoodsReceiptLineUpdateOne) SetTenantID(v uuid.UUID) *GoodsReceiptLineUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdateOne) SetNillableTenantID(v *uuid.UUID) *GoodsReceiptLineUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (_u *GoodsReceiptLineUpdateOne) SetReceiptID(v uuid.UUID) *GoodsReceiptLineUpdateOne {
+	_u.mutation.SetReceiptID(v)
+	return _u
+}
+
+// SetNillableReceiptID sets the "receipt_id" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdateOne) SetNillableReceiptID(v *uuid.UUID) *GoodsReceiptLineUpdateOne {
+	if v != nil {
+		_u.SetReceiptID(*v)
+	}
+	return _u
+}
+
+// SetLineNumber sets the "line_number" field.
+func (_u *GoodsReceiptLineUpdateOne) SetLineNumber(v int) *GoodsReceiptLineUpdateOne {
+	_u.mutation.ResetLineNumber()
+	_u.mutation.SetLineNumber(v)
+	return _u
+}
+
+// SetNillableLineNumber sets the "line_number" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdateOne) SetNillableLineNumber(v *int) *GoodsReceiptLineUpdateOne {
+	if v != nil {
+		_u.SetLineNumber(*v)
+	}
+	return _u
+}
+
+// AddLineNumber adds value to the "line_number" field.
+func (_u *GoodsReceiptLineUpdateOne) AddLineNumber(v int) *GoodsReceiptLineUpdateOne {
+	_u.mutation.AddLineNumber(v)
+	return _u
+}
+
+// SetItemCode sets the "item_code" field.
+func (_u *GoodsReceiptLineUpdateOne) SetItemCode(v string) *GoodsReceiptLineUpdateOne {
+	_u.mutation.SetItemCode(v)
+	return _u
+}
+
+// SetNillableItemCode sets the "item_code" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdateOne) SetNillableItemCode(v *string) *GoodsReceiptLineUpdateOne {
+	if v != nil {
+		_u.SetItemCode(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *GoodsReceiptLineUpdateOne) SetDescription(v string) *GoodsReceiptLineUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdateOne) SetNillableDescription(v *string) *GoodsReceiptLineUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *GoodsReceiptLineUpdateOne) ClearDescription() *GoodsReceiptLineUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetOrderedQuantity sets the "ordered_quantity" field.
+func (_u *GoodsReceiptLineUpdateOne) SetOrderedQuantity(v decimal.Decimal) *GoodsReceiptLineUpdateOne {
+	_u.mutation.ResetOrderedQuantity()
+	_u.mutation.SetOrderedQuantity(v)
+	return _u
+}
+
+// SetNillableOrderedQuantity sets the "ordered_quantity" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdateOne) SetNillableOrderedQuantity(v *decimal.Decimal) *GoodsReceiptLineUpdateOne {
+	if v != nil {
+		_u.SetOrderedQuantity(*v)
+	}
+	return _u
+}
+
+// AddOrderedQuantity adds value to the "ordered_quantity" field.
+func (_u *GoodsReceiptLineUpdateOne) AddOrderedQuantity(v decimal.Decimal) *GoodsReceiptLineUpdateOne {
+	_u.mutation.AddOrderedQuantity(v)
+	return _u
+}
+
+// ClearOrderedQuantity clears the value of the "ordered_quantity" field.
+func (_u *GoodsReceiptLineUpdateOne) ClearOrderedQuantity() *GoodsReceiptLineUpdateOne {
+	_u.mutation.ClearOrderedQuantity()
+	return _u
+}
+
+// SetReceivedQuantity sets the "received_quantity" field.
+func (_u *GoodsReceiptLineUpdateOne) SetReceivedQuantity(v decimal.Decimal) *GoodsReceiptLineUpdateOne {
+	_u.mutation.ResetReceivedQuantity()
+	_u.mutation.SetReceivedQuantity(v)
+	return _u
+}
+
+// SetNillableReceivedQuantity sets the "received_quantity" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdateOne) SetNillableReceivedQuantity(v *decimal.Decimal) *GoodsReceiptLineUpdateOne {
+	if v != nil {
+		_u.SetReceivedQuantity(*v)
+	}
+	return _u
+}
+
+// AddReceivedQuantity adds value to the "received_quantity" field.
+func (_u *GoodsReceiptLineUpdateOne) AddReceivedQuantity(v decimal.Decimal) *GoodsReceiptLineUpdateOne {
+	_u.mutation.AddReceivedQuantity(v)
+	return _u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_u *GoodsReceiptLineUpdateOne) SetUnitPrice(v decimal.Decimal) *GoodsReceiptLineUpdateOne {
+	_u.mutation.ResetUnitPrice()
+	_u.mutation.SetUnitPrice(v)
+	return _u
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_u *GoodsReceiptLineUpdateOne) SetNillableUnitPrice(v *decimal.Decimal) *GoodsReceiptLineUpdateOne {
+	if v != nil {
+		_u.SetUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddUnitPrice adds value to the "unit_price" field.
+func (_u *GoodsReceiptLineUpdateOne) AddUnitPrice(v decimal.Decimal) *GoodsReceiptLineUpdateOne {
+	_u.mutation.AddUnitPrice(v)
+	return _u
+}
+
+// SetReceipt sets the "receipt" edge to the GoodsReceipt entity.
+func (_u *GoodsReceiptLineUpdateOne) SetReceipt(v *GoodsReceipt) *GoodsReceiptLineUpdateOne {
+	return _u.SetReceiptID(v.ID)
+}
+
+// Mutation returns the GoodsReceiptLineMutation object of the builder.
+func (_u *GoodsReceiptLineUpdateOne) Mutation() *GoodsReceiptLineMutation {
+	return _u.mutation
+}
+
+// ClearReceipt clears the "receipt" edge to the GoodsReceipt entity.
+func (_u *GoodsReceiptLineUpdateOne) ClearReceipt() *GoodsReceiptLineUpdateOne {
+	_u.mutation.ClearReceipt()
+	return _u
+}
+
+// Where appends a list predicates to the GoodsReceiptLineUpdate builder.
+func (_u *GoodsReceiptLineUpdateOne) Where(ps ...predicate.GoodsReceiptLine) *GoodsReceiptLineUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GoodsReceiptLineUpdateOne) Select(field string, fields ...string) *GoodsReceiptLineUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GoodsReceiptLine entity.
+func (_u *GoodsReceiptLineUpdateOne) Save(ctx context.Context) (*GoodsReceiptLine, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoodsReceiptLineUpdateOne) SaveX(ctx context.Context) *GoodsReceiptLine {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GoodsReceiptLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoodsReceiptLineUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoodsReceiptLineUpdateOne) check() error {
+	if v, ok := _u.mutation.ItemCode(); ok {
+		if err := goodsreceiptline.ItemCodeValidator(v); err != nil {
+			return &ValidationError{Name: "item_code", err: fmt.Errorf(`ent: validator failed for field "GoodsReceiptLine.item_code": %w`, err)}
+		}
+	}
+	if _u.mutation.ReceiptCleared() && len(_u.mutation.ReceiptIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoodsReceiptLine.receipt"`)
+	}
+	return nil
+}
+
+func (_u *GoodsReceiptLineUpdateOne) sqlSave(ctx context.Context) (_node *GoodsReceiptLine, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goodsreceiptline.Table, goodsreceiptline.Columns, sqlgraph.NewFieldSpec(goodsreceiptline.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GoodsReceiptLine.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, goodsreceiptline.FieldID)
+		for _, f := range fields {
+			if !goodsreceiptline.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != goodsreceiptline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(goodsreceiptline.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.LineNumber(); ok {
+		_spec.SetField(goodsreceiptline.FieldLineNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLineNumber(); ok {
+		_spec.AddField(goodsreceiptline.FieldLineNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ItemCode(); ok {
+		_spec.SetField(goodsreceiptline.FieldItemCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(goodsreceiptline.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(goodsreceiptline.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.OrderedQuantity(); ok {
+		_spec.SetField(goodsreceiptline.FieldOrderedQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOrderedQuantity(); ok {
+		_spec.AddField(goodsreceiptline.FieldOrderedQuantity, field.TypeFloat64, value)
+	}
+	if _u.mutation.OrderedQuantityCleared() {
+		_spec.ClearField(goodsreceiptline.FieldOrderedQuantity, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ReceivedQuantity(); ok {
+		_spec.SetField(goodsreceiptline.FieldReceivedQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedReceivedQuantity(); ok {
+		_spec.AddField(goodsreceiptline.FieldReceivedQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.UnitPrice(); ok {
+		_spec.SetField(goodsreceiptline.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedUnitPrice(); ok {
+		_spec.AddField(goodsreceiptline.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.ReceiptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceiptline.ReceiptTable,
+			Columns: []string{goodsreceiptline.ReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReceiptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goodsreceiptline.ReceiptTable,
+			Columns: []string{goodsreceiptline.ReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goodsreceipt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GoodsReceiptLine{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goodsreceiptline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,102 @@ import (
 	"github.com/bengobox/treasury-api/internal/ent"
 )
 
+// The BankAccountFunc type is an adapter to allow the use of ordinary
+// function as BankAccount mutator.
+type BankAccountFunc func(context.Context, *ent.BankAccountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BankAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BankAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BankAccountMutation", m)
+}
+
+// The BankStatementFunc type is an adapter to allow the use of ordinary
+// function as BankStatement mutator.
+type BankStatementFunc func(context.Context, *ent.BankStatementMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BankStatementFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BankStatementMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BankStatementMutation", m)
+}
+
+// The BankStatementProfileFunc type is an adapter to allow the use of ordinary
+// function as BankStatementProfile mutator.
+type BankStatementProfileFunc func(context.Context, *ent.BankStatementProfileMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BankStatementProfileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BankStatementProfileMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BankStatementProfileMutation", m)
+}
+
+// The BankTransactionFunc type is an adapter to allow the use of ordinary
+// function as BankTransaction mutator.
+type BankTransactionFunc func(context.Context, *ent.BankTransactionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BankTransactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BankTransactionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BankTransactionMutation", m)
+}
+
+// The BillingCycleFunc type is an adapter to allow the use of ordinary
+// function as BillingCycle mutator.
+type BillingCycleFunc func(context.Context, *ent.BillingCycleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BillingCycleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BillingCycleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BillingCycleMutation", m)
+}
+
+// The CashDrawerCountFunc type is an adapter to allow the use of ordinary
+// function as CashDrawerCount mutator.
+type CashDrawerCountFunc func(context.Context, *ent.CashDrawerCountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CashDrawerCountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CashDrawerCountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CashDrawerCountMutation", m)
+}
+
+// The CashDrawerSessionFunc type is an adapter to allow the use of ordinary
+// function as CashDrawerSession mutator.
+type CashDrawerSessionFunc func(context.Context, *ent.CashDrawerSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CashDrawerSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CashDrawerSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CashDrawerSessionMutation", m)
+}
+
+// The CashDrawerSettingFunc type is an adapter to allow the use of ordinary
+// function as CashDrawerSetting mutator.
+type CashDrawerSettingFunc func(context.Context, *ent.CashDrawerSettingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CashDrawerSettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CashDrawerSettingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CashDrawerSettingMutation", m)
+}
+
 // The ChartOfAccountFunc type is an adapter to allow the use of ordinary
 // function as ChartOfAccount mutator.
 type ChartOfAccountFunc func(context.Context, *ent.ChartOfAccountMutation) (ent.Value, error)
@@ -21,6 +117,126 @@ func (f ChartOfAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChartOfAccountMutation", m)
 }
 
+// The CreditOverrideFunc type is an adapter to allow the use of ordinary
+// function as CreditOverride mutator.
+type CreditOverrideFunc func(context.Context, *ent.CreditOverrideMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CreditOverrideFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CreditOverrideMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CreditOverrideMutation", m)
+}
+
+// The CustomerFunc type is an adapter to allow the use of ordinary
+// function as Customer mutator.
+type CustomerFunc func(context.Context, *ent.CustomerMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CustomerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CustomerMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CustomerMutation", m)
+}
+
+// The CustomerStatementFunc type is an adapter to allow the use of ordinary
+// function as CustomerStatement mutator.
+type CustomerStatementFunc func(context.Context, *ent.CustomerStatementMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CustomerStatementFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CustomerStatementMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CustomerStatementMutation", m)
+}
+
+// The DocumentSequenceFunc type is an adapter to allow the use of ordinary
+// function as DocumentSequence mutator.
+type DocumentSequenceFunc func(context.Context, *ent.DocumentSequenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DocumentSequenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DocumentSequenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DocumentSequenceMutation", m)
+}
+
+// The DunningNoticeFunc type is an adapter to allow the use of ordinary
+// function as DunningNotice mutator.
+type DunningNoticeFunc func(context.Context, *ent.DunningNoticeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DunningNoticeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DunningNoticeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DunningNoticeMutation", m)
+}
+
+// The DunningPauseFunc type is an adapter to allow the use of ordinary
+// function as DunningPause mutator.
+type DunningPauseFunc func(context.Context, *ent.DunningPauseMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DunningPauseFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DunningPauseMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DunningPauseMutation", m)
+}
+
+// The DunningStepFunc type is an adapter to allow the use of ordinary
+// function as DunningStep mutator.
+type DunningStepFunc func(context.Context, *ent.DunningStepMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DunningStepFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DunningStepMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DunningStepMutation", m)
+}
+
+// The EarningsSettingFunc type is an adapter to allow the use of ordinary
+// function as EarningsSetting mutator.
+type EarningsSettingFunc func(context.Context, *ent.EarningsSettingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EarningsSettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EarningsSettingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EarningsSettingMutation", m)
+}
+
+// The GoodsReceiptFunc type is an adapter to allow the use of ordinary
+// function as GoodsReceipt mutator.
+type GoodsReceiptFunc func(context.Context, *ent.GoodsReceiptMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GoodsReceiptFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GoodsReceiptMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GoodsReceiptMutation", m)
+}
+
+// The GoodsReceiptLineFunc type is an adapter to allow the use of ordinary
+// function as GoodsReceiptLine mutator.
+type GoodsReceiptLineFunc func(context.Context, *ent.GoodsReceiptLineMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GoodsReceiptLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GoodsReceiptLineMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GoodsReceiptLineMutation", m)
+}
+
 // The InvoiceFunc type is an adapter to allow the use of ordinary
 // function as Invoice mutator.
 type InvoiceFunc func(context.Context, *ent.InvoiceMutation) (ent.Value, error)
@@ -33,6 +249,42 @@ func (f InvoiceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvoiceMutation", m)
 }
 
+// The InvoiceLineFunc type is an adapter to allow the use of ordinary
+// function as InvoiceLine mutator.
+type InvoiceLineFunc func(context.Context, *ent.InvoiceLineMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoiceLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InvoiceLineMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvoiceLineMutation", m)
+}
+
+// The InvoicePaymentFunc type is an adapter to allow the use of ordinary
+// function as InvoicePayment mutator.
+type InvoicePaymentFunc func(context.Context, *ent.InvoicePaymentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoicePaymentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InvoicePaymentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvoicePaymentMutation", m)
+}
+
+// The InvoiceSettingFunc type is an adapter to allow the use of ordinary
+// function as InvoiceSetting mutator.
+type InvoiceSettingFunc func(context.Context, *ent.InvoiceSettingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoiceSettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InvoiceSettingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvoiceSettingMutation", m)
+}
+
 // The LedgerTransactionFunc type is an adapter to allow the use of ordinary
 // function as LedgerTransaction mutator.
 type LedgerTransactionFunc func(context.Context, *ent.LedgerTransactionMutation) (ent.Value, error)
@@ -57,6 +309,54 @@ func (f OutboxEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OutboxEventMutation", m)
 }
 
+// The PayableSettingFunc type is an adapter to allow the use of ordinary
+// function as PayableSetting mutator.
+type PayableSettingFunc func(context.Context, *ent.PayableSettingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PayableSettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PayableSettingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PayableSettingMutation", m)
+}
+
+// The PayeePayoutFunc type is an adapter to allow the use of ordinary
+// function as PayeePayout mutator.
+type PayeePayoutFunc func(context.Context, *ent.PayeePayoutMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PayeePayoutFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PayeePayoutMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PayeePayoutMutation", m)
+}
+
+// The PayeeWalletFunc type is an adapter to allow the use of ordinary
+// function as PayeeWallet mutator.
+type PayeeWalletFunc func(context.Context, *ent.PayeeWalletMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PayeeWalletFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PayeeWalletMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PayeeWalletMutation", m)
+}
+
+// The PayeeWalletEntryFunc type is an adapter to allow the use of ordinary
+// function as PayeeWalletEntry mutator.
+type PayeeWalletEntryFunc func(context.Context, *ent.PayeeWalletEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PayeeWalletEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PayeeWalletEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PayeeWalletEntryMutation", m)
+}
+
 // The PaymentIntentFunc type is an adapter to allow the use of ordinary
 // function as PaymentIntent mutator.
 type PaymentIntentFunc func(context.Context, *ent.PaymentIntentMutation) (ent.Value, error)
@@ -69,6 +369,30 @@ func (f PaymentIntentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentIntentMutation", m)
 }
 
+// The PaymentRunFunc type is an adapter to allow the use of ordinary
+// function as PaymentRun mutator.
+type PaymentRunFunc func(context.Context, *ent.PaymentRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaymentRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PaymentRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentRunMutation", m)
+}
+
+// The PaymentRunItemFunc type is an adapter to allow the use of ordinary
+// function as PaymentRunItem mutator.
+type PaymentRunItemFunc func(context.Context, *ent.PaymentRunItemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaymentRunItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PaymentRunItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentRunItemMutation", m)
+}
+
 // The PaymentTransactionFunc type is an adapter to allow the use of ordinary
 // function as PaymentTransaction mutator.
 type PaymentTransactionFunc func(context.Context, *ent.PaymentTransactionMutation) (ent.Value, error)
@@ -81,6 +405,78 @@ func (f PaymentTransactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentTransactionMutation", m)
 }
 
+// The ProvisionPolicyFunc type is an adapter to allow the use of ordinary
+// function as ProvisionPolicy mutator.
+type ProvisionPolicyFunc func(context.Context, *ent.ProvisionPolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProvisionPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProvisionPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProvisionPolicyMutation", m)
+}
+
+// The ProvisionRunFunc type is an adapter to allow the use of ordinary
+// function as ProvisionRun mutator.
+type ProvisionRunFunc func(context.Context, *ent.ProvisionRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProvisionRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProvisionRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProvisionRunMutation", m)
+}
+
+// The ReconciliationFunc type is an adapter to allow the use of ordinary
+// function as Reconciliation mutator.
+type ReconciliationFunc func(context.Context, *ent.ReconciliationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReconciliationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReconciliationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReconciliationMutation", m)
+}
+
+// The ReconciliationMatchFunc type is an adapter to allow the use of ordinary
+// function as ReconciliationMatch mutator.
+type ReconciliationMatchFunc func(context.Context, *ent.ReconciliationMatchMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReconciliationMatchFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReconciliationMatchMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReconciliationMatchMutation", m)
+}
+
+// The ReconciliationMatchItemFunc type is an adapter to allow the use of ordinary
+// function as ReconciliationMatchItem mutator.
+type ReconciliationMatchItemFunc func(context.Context, *ent.ReconciliationMatchItemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReconciliationMatchItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReconciliationMatchItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReconciliationMatchItemMutation", m)
+}
+
+// The ReconciliationRuleFunc type is an adapter to allow the use of ordinary
+// function as ReconciliationRule mutator.
+type ReconciliationRuleFunc func(context.Context, *ent.ReconciliationRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReconciliationRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReconciliationRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReconciliationRuleMutation", m)
+}
+
 // The RolePermissionFunc type is an adapter to allow the use of ordinary
 // function as RolePermission mutator.
 type RolePermissionFunc func(context.Context, *ent.RolePermissionMutation) (ent.Value, error)
@@ -93,6 +489,90 @@ func (f RolePermissionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RolePermissionMutation", m)
 }
 
+// The SettlementBatchFunc type is an adapter to allow the use of ordinary
+// function as SettlementBatch mutator.
+type SettlementBatchFunc func(context.Context, *ent.SettlementBatchMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SettlementBatchFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SettlementBatchMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SettlementBatchMutation", m)
+}
+
+// The SettlementDisbursementFunc type is an adapter to allow the use of ordinary
+// function as SettlementDisbursement mutator.
+type SettlementDisbursementFunc func(context.Context, *ent.SettlementDisbursementMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SettlementDisbursementFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SettlementDisbursementMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SettlementDisbursementMutation", m)
+}
+
+// The SettlementItemFunc type is an adapter to allow the use of ordinary
+// function as SettlementItem mutator.
+type SettlementItemFunc func(context.Context, *ent.SettlementItemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SettlementItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SettlementItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SettlementItemMutation", m)
+}
+
+// The SettlementSettingFunc type is an adapter to allow the use of ordinary
+// function as SettlementSetting mutator.
+type SettlementSettingFunc func(context.Context, *ent.SettlementSettingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SettlementSettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SettlementSettingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SettlementSettingMutation", m)
+}
+
+// The SubscriptionFunc type is an adapter to allow the use of ordinary
+// function as Subscription mutator.
+type SubscriptionFunc func(context.Context, *ent.SubscriptionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SubscriptionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SubscriptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SubscriptionMutation", m)
+}
+
+// The SubscriptionAdjustmentFunc type is an adapter to allow the use of ordinary
+// function as SubscriptionAdjustment mutator.
+type SubscriptionAdjustmentFunc func(context.Context, *ent.SubscriptionAdjustmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SubscriptionAdjustmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SubscriptionAdjustmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SubscriptionAdjustmentMutation", m)
+}
+
+// The SubscriptionMeterFunc type is an adapter to allow the use of ordinary
+// function as SubscriptionMeter mutator.
+type SubscriptionMeterFunc func(context.Context, *ent.SubscriptionMeterMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SubscriptionMeterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SubscriptionMeterMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SubscriptionMeterMutation", m)
+}
+
 // The TreasuryPermissionFunc type is an adapter to allow the use of ordinary
 // function as TreasuryPermission mutator.
 type TreasuryPermissionFunc func(context.Context, *ent.TreasuryPermissionMutation) (ent.Value, error)
@@ -129,6 +609,18 @@ func (f TreasuryUserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TreasuryUserMutation", m)
 }
 
+// The UsageRecordFunc type is an adapter to allow the use of ordinary
+// function as UsageRecord mutator.
+type UsageRecordFunc func(context.Context, *ent.UsageRecordMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UsageRecordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UsageRecordMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UsageRecordMutation", m)
+}
+
 // The UserRoleAssignmentFunc type is an adapter to allow the use of ordinary
 // function as UserRoleAssignment mutator.
 type UserRoleAssignmentFunc func(context.Context, *ent.UserRoleAssignmentMutation) (ent.Value, error)
@@ -141,6 +633,126 @@ func (f UserRoleAssignmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserRoleAssignmentMutation", m)
 }
 
+// The VendorFunc type is an adapter to allow the use of ordinary
+// function as Vendor mutator.
+type VendorFunc func(context.Context, *ent.VendorMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VendorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VendorMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VendorMutation", m)
+}
+
+// The VendorBillFunc type is an adapter to allow the use of ordinary
+// function as VendorBill mutator.
+type VendorBillFunc func(context.Context, *ent.VendorBillMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VendorBillFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VendorBillMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VendorBillMutation", m)
+}
+
+// The VendorBillLineFunc type is an adapter to allow the use of ordinary
+// function as VendorBillLine mutator.
+type VendorBillLineFunc func(context.Context, *ent.VendorBillLineMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VendorBillLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VendorBillLineMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VendorBillLineMutation", m)
+}
+
+// The WalletFunc type is an adapter to allow the use of ordinary
+// function as Wallet mutator.
+type WalletFunc func(context.Context, *ent.WalletMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WalletFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WalletMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WalletMutation", m)
+}
+
+// The WalletHoldFunc type is an adapter to allow the use of ordinary
+// function as WalletHold mutator.
+type WalletHoldFunc func(context.Context, *ent.WalletHoldMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WalletHoldFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WalletHoldMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WalletHoldMutation", m)
+}
+
+// The WalletTransactionFunc type is an adapter to allow the use of ordinary
+// function as WalletTransaction mutator.
+type WalletTransactionFunc func(context.Context, *ent.WalletTransactionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WalletTransactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WalletTransactionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WalletTransactionMutation", m)
+}
+
+// The WithholdingCertificateFunc type is an adapter to allow the use of ordinary
+// function as WithholdingCertificate mutator.
+type WithholdingCertificateFunc func(context.Context, *ent.WithholdingCertificateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WithholdingCertificateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WithholdingCertificateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WithholdingCertificateMutation", m)
+}
+
+// The WithholdingRateFunc type is an adapter to allow the use of ordinary
+// function as WithholdingRate mutator.
+type WithholdingRateFunc func(context.Context, *ent.WithholdingRateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WithholdingRateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WithholdingRateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WithholdingRateMutation", m)
+}
+
+// The WriteOffFunc type is an adapter to allow the use of ordinary
+// function as WriteOff mutator.
+type WriteOffFunc func(context.Context, *ent.WriteOffMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WriteOffFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WriteOffMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WriteOffMutation", m)
+}
+
+// The WriteOffRecoveryFunc type is an adapter to allow the use of ordinary
+// function as WriteOffRecovery mutator.
+type WriteOffRecoveryFunc func(context.Context, *ent.WriteOffRecoveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WriteOffRecoveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WriteOffRecoveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WriteOffRecoveryMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -24,7 +24,7 @@ type Invoice struct {
 	TenantID uuid.UUID `json:"tenant_id,omitempty"`
 	// Sequential invoice number
 	InvoiceNumber string `json:"invoice_number,omitempty"`
-	// Customer identifier (from auth-service)
+	// Customer identifier (treasury customer, or auth-service user)
 	CustomerID uuid.UUID `json:"customer_id,omitempty"`
 	// Invoice type: standard, tax, proforma, recurring, credit_note, debit_note
 	InvoiceType string `json:"invoice_type,omitempty"`
@@ -40,10 +40,12 @@ type Invoice struct {
 	TotalAmount decimal.Decimal `json:"total_amount,omitempty"`
 	// ISO currency code
 	Currency string `json:"currency,omitempty"`
-	// Status: draft, sent, paid, overdue, cancelled
+	// Status: draft, sent, paid, overdue, cancelled, written_off
 	Status string `json:"status,omitempty"`
 	// Payment status: unpaid, partial, paid, overpaid
 	PaymentStatus string `json:"payment_status,omitempty"`
+	// Amount written off as bad debt, net of recoveries (defaults to zero)
+	WrittenOffAmount decimal.Decimal `json:"written_off_amount,omitempty"`
 	// Reference ID (e.g., order_id, subscription_id)
 	ReferenceID uuid.UUID `json:"reference_id,omitempty"`
 	// Reference type (order, subscription)
@@ -53,10 +55,31 @@ type Invoice struct {
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
-	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvoiceQuery when eager-loading is set.
+	Edges        InvoiceEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// InvoiceEdges holds the relations/edges for other nodes in the graph.
+type InvoiceEdges struct {
+	// Lines holds the value of the lines edge.
+	Lines []*InvoiceLine `json:"lines,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LinesOrErr returns the Lines value or an error if the edge
+// was not loaded in eager-loading.
+func (e InvoiceEdges) LinesOrErr() ([]*InvoiceLine, error) {
+	if e.loadedTypes[0] {
+		return e.Lines, nil
+	}
+	return nil, &NotLoadedError{edge: "lines"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Invoice) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -64,7 +87,7 @@ func (*Invoice) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case invoice.FieldMetadata:
 			values[i] = new([]byte)
-		case invoice.FieldSubtotal, invoice.FieldTaxAmount, invoice.FieldTotalAmount:
+		case invoice.FieldSubtotal, invoice.FieldTaxAmount, invoice.FieldTotalAmount, invoice.FieldWrittenOffAmount:
 			values[i] = new(decimal.Decimal)
 		case invoice.FieldInvoiceNumber, invoice.FieldInvoiceType, invoice.FieldCurrency, invoice.FieldStatus, invoice.FieldPaymentStatus, invoice.FieldReferenceType:
 			values[i] = new(sql.NullString)
@@ -165,6 +188,12 @@ func (_m *Invoice) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.PaymentStatus = value.String
 			}
+		case invoice.FieldWrittenOffAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field written_off_amount", values[i])
+			} else if value != nil {
+				_m.WrittenOffAmount = *value
+			}
 		case invoice.FieldReferenceID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field reference_id", values[i])
@@ -210,6 +239,11 @@ func (_m *Invoice) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryLines queries the "lines" edge of the Invoice entity.
+func (_m *Invoice) QueryLines() *InvoiceLineQuery {
+	return NewInvoiceClient(_m.config).QueryLines(_m)
+}
+
 // Update returns a builder for updating this Invoice.
 // Note that you need to call Invoice.Unwrap() before calling this method if this Invoice
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -269,6 +303,9 @@ func (_m *Invoice) String() string {
 	builder.WriteString("payment_status=")
 	builder.WriteString(_m.PaymentStatus)
 	builder.WriteString(", ")
+	builder.WriteString("written_off_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WrittenOffAmount))
+	builder.WriteString(", ")
 	builder.WriteString("reference_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ReferenceID))
 	builder.WriteString(", ")
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 )
 
@@ -38,6 +39,8 @@ const (
 	FieldStatus = "status"
 	// FieldPaymentStatus holds the string denoting the payment_status field in the database.
 	FieldPaymentStatus = "payment_status"
+	// FieldWrittenOffAmount holds the string denoting the written_off_amount field in the database.
+	FieldWrittenOffAmount = "written_off_amount"
 	// FieldReferenceID holds the string denoting the reference_id field in the database.
 	FieldReferenceID = "reference_id"
 	// FieldReferenceType holds the string denoting the reference_type field in the database.
@@ -48,8 +51,17 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// EdgeLines holds the string denoting the lines edge name in mutations.
+	EdgeLines = "lines"
 	// Table holds the table name of the invoice in the database.
 	Table = "invoices"
+	// LinesTable is the table that holds the lines relation/edge.
+	LinesTable = "invoice_lines"
+	// LinesInverseTable is the table name for the InvoiceLine entity.
+	// It exists in this package in order to avoid circular dependency with the "invoiceline" package.
+	LinesInverseTable = "invoice_lines"
+	// LinesColumn is the table column denoting the lines relation/edge.
+	LinesColumn = "invoice_id"
 )
 
 // Columns holds all SQL columns for invoice fields.
@@ -67,6 +79,7 @@ var Columns = []string{
 	FieldCurrency,
 	FieldStatus,
 	FieldPaymentStatus,
+	FieldWrittenOffAmount,
 	FieldReferenceID,
 	FieldReferenceType,
 	FieldMetadata,
@@ -175,6 +188,11 @@ func ByPaymentStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPaymentStatus, opts...).ToFunc()
 }
 
+// ByWrittenOffAmount orders the results by the written_off_amount field.
+func ByWrittenOffAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWrittenOffAmount, opts...).ToFunc()
+}
+
 // ByReferenceID orders the results by the reference_id field.
 func ByReferenceID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldReferenceID, opts...).ToFunc()
@@ -194,3 +212,24 @@ func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
+
+// ByLinesCount orders the results by lines count.
+func ByLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLinesStep(), opts...)
+	}
+}
+
+// ByLines orders the results by lines terms.
+func ByLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LinesTable, LinesColumn),
+	)
+}
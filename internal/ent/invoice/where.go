@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/bengobox/treasury-api/internal/ent/predicate"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -116,6 +117,11 @@ func PaymentStatus(v string) predicate.Invoice {
 	return predicate.Invoice(sql.FieldEQ(FieldPaymentStatus, v))
 }
 
+// WrittenOffAmount applies equality check predicate on the "written_off_amount" field. It's identical to WrittenOffAmountEQ.
+func WrittenOffAmount(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldWrittenOffAmount, v))
+}
+
 // ReferenceID applies equality check predicate on the "reference_id" field. It's identical to ReferenceIDEQ.
 func ReferenceID(v uuid.UUID) predicate.Invoice {
 	return predicate.Invoice(sql.FieldEQ(FieldReferenceID, v))
@@ -761,6 +767,56 @@ func PaymentStatusContainsFold(v string) predicate.Invoice {
 	return predicate.Invoice(sql.FieldContainsFold(FieldPaymentStatus, v))
 }
 
+// WrittenOffAmountEQ applies the EQ predicate on the "written_off_amount" field.
+func WrittenOffAmountEQ(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldWrittenOffAmount, v))
+}
+
+// WrittenOffAmountNEQ applies the NEQ predicate on the "written_off_amount" field.
+func WrittenOffAmountNEQ(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldWrittenOffAmount, v))
+}
+
+// WrittenOffAmountIn applies the In predicate on the "written_off_amount" field.
+func WrittenOffAmountIn(vs ...decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldWrittenOffAmount, vs...))
+}
+
+// WrittenOffAmountNotIn applies the NotIn predicate on the "written_off_amount" field.
+func WrittenOffAmountNotIn(vs ...decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldWrittenOffAmount, vs...))
+}
+
+// WrittenOffAmountGT applies the GT predicate on the "written_off_amount" field.
+func WrittenOffAmountGT(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldWrittenOffAmount, v))
+}
+
+// WrittenOffAmountGTE applies the GTE predicate on the "written_off_amount" field.
+func WrittenOffAmountGTE(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldWrittenOffAmount, v))
+}
+
+// WrittenOffAmountLT applies the LT predicate on the "written_off_amount" field.
+func WrittenOffAmountLT(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldWrittenOffAmount, v))
+}
+
+// WrittenOffAmountLTE applies the LTE predicate on the "written_off_amount" field.
+func WrittenOffAmountLTE(v decimal.Decimal) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldWrittenOffAmount, v))
+}
+
+// WrittenOffAmountIsNil applies the IsNil predicate on the "written_off_amount" field.
+func WrittenOffAmountIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldWrittenOffAmount))
+}
+
+// WrittenOffAmountNotNil applies the NotNil predicate on the "written_off_amount" field.
+func WrittenOffAmountNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldWrittenOffAmount))
+}
+
 // ReferenceIDEQ applies the EQ predicate on the "reference_id" field.
 func ReferenceIDEQ(v uuid.UUID) predicate.Invoice {
 	return predicate.Invoice(sql.FieldEQ(FieldReferenceID, v))
@@ -966,6 +1022,29 @@ func UpdatedAtLTE(v time.Time) predicate.Invoice {
 	return predicate.Invoice(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// HasLines applies the HasEdge predicate on the "lines" edge.
+func HasLines() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LinesTable, LinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinesWith applies the HasEdge predicate on the "lines" edge with a given conditions (other predicates).
+func HasLinesWith(preds ...predicate.InvoiceLine) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := newLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Invoice) predicate.Invoice {
 	return predicate.Invoice(sql.AndPredicates(predicates...))
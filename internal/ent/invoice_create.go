@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/bengobox/treasury-api/internal/ent/invoice"
+	"github.com/bengobox/treasury-api/internal/ent/invoiceline"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
@@ -145,6 +146,20 @@ func (_c *InvoiceCreate) SetNillablePaymentStatus(v *string) *InvoiceCreate {
 	return _c
 }
 
+// SetWrittenOffAmount sets the "written_off_amount" field.
+func (_c *InvoiceCreate) SetWrittenOffAmount(v decimal.Decimal) *InvoiceCreate {
+	_c.mutation.SetWrittenOffAmount(v)
+	return _c
+}
+
+// SetNillableWrittenOffAmount sets the "written_off_amount" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableWrittenOffAmount(v *decimal.Decimal) *InvoiceCreate {
+	if v != nil {
+		_c.SetWrittenOffAmount(*v)
+	}
+	return _c
+}
+
 // SetReferenceID sets the "reference_id" field.
 func (_c *InvoiceCreate) SetReferenceID(v uuid.UUID) *InvoiceCreate {
 	_c.mutation.SetReferenceID(v)
@@ -221,6 +236,21 @@ func (_c *InvoiceCreate) SetNillableID(v *uuid.UUID) *InvoiceCreate {
 	return _c
 }
 
+// AddLineIDs adds the "lines" edge to the InvoiceLine entity by IDs.
+func (_c *InvoiceCreate) AddLineIDs(ids ...uuid.UUID) *InvoiceCreate {
+	_c.mutation.AddLineIDs(ids...)
+	return _c
+}
+
+// AddLines adds the "lines" edges to the InvoiceLine entity.
+func (_c *InvoiceCreate) AddLines(v ...*InvoiceLine) *InvoiceCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLineIDs(ids...)
+}
+
 // Mutation returns the InvoiceMutation object of the builder.
 func (_c *InvoiceCreate) Mutation() *InvoiceMutation {
 	return _c.mutation
@@ -420,6 +450,10 @@ func (_c *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
 		_spec.SetField(invoice.FieldPaymentStatus, field.TypeString, value)
 		_node.PaymentStatus = value
 	}
+	if value, ok := _c.mutation.WrittenOffAmount(); ok {
+		_spec.SetField(invoice.FieldWrittenOffAmount, field.TypeFloat64, value)
+		_node.WrittenOffAmount = value
+	}
 	if value, ok := _c.mutation.ReferenceID(); ok {
 		_spec.SetField(invoice.FieldReferenceID, field.TypeUUID, value)
 		_node.ReferenceID = value
@@ -440,6 +474,22 @@ func (_c *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
 		_spec.SetField(invoice.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if nodes := _c.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -666,6 +716,30 @@ func (u *InvoiceUpsert) UpdatePaymentStatus() *InvoiceUpsert {
 	return u
 }
 
+// SetWrittenOffAmount sets the "written_off_amount" field.
+func (u *InvoiceUpsert) SetWrittenOffAmount(v decimal.Decimal) *InvoiceUpsert {
+	u.Set(invoice.FieldWrittenOffAmount, v)
+	return u
+}
+
+// UpdateWrittenOffAmount sets the "written_off_amount" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateWrittenOffAmount() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldWrittenOffAmount)
+	return u
+}
+
+// AddWrittenOffAmount adds v to the "written_off_amount" field.
+func (u *InvoiceUpsert) AddWrittenOffAmount(v decimal.Decimal) *InvoiceUpsert {
+	u.Add(invoice.FieldWrittenOffAmount, v)
+	return u
+}
+
+// ClearWrittenOffAmount clears the value of the "written_off_amount" field.
+func (u *InvoiceUpsert) ClearWrittenOffAmount() *InvoiceUpsert {
+	u.SetNull(invoice.FieldWrittenOffAmount)
+	return u
+}
+
 // SetReferenceID sets the "reference_id" field.
 func (u *InvoiceUpsert) SetReferenceID(v uuid.UUID) *InvoiceUpsert {
 	u.Set(invoice.FieldReferenceID, v)
@@ -980,6 +1054,34 @@ func (u *InvoiceUpsertOne) UpdatePaymentStatus() *InvoiceUpsertOne {
 	})
 }
 
+// SetWrittenOffAmount sets the "written_off_amount" field.
+func (u *InvoiceUpsertOne) SetWrittenOffAmount(v decimal.Decimal) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetWrittenOffAmount(v)
+	})
+}
+
+// AddWrittenOffAmount adds v to the "written_off_amount" field.
+func (u *InvoiceUpsertOne) AddWrittenOffAmount(v decimal.Decimal) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddWrittenOffAmount(v)
+	})
+}
+
+// UpdateWrittenOffAmount sets the "written_off_amount" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateWrittenOffAmount() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateWrittenOffAmount()
+	})
+}
+
+// ClearWrittenOffAmount clears the value of the "written_off_amount" field.
+func (u *InvoiceUpsertOne) ClearWrittenOffAmount() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearWrittenOffAmount()
+	})
+}
+
 // SetReferenceID sets the "reference_id" field.
 func (u *InvoiceUpsertOne) SetReferenceID(v uuid.UUID) *InvoiceUpsertOne {
 	return u.Update(func(s *InvoiceUpsert) {
@@ -1471,6 +1573,34 @@ func (u *InvoiceUpsertBulk) UpdatePaymentStatus() *InvoiceUpsertBulk {
 	})
 }
 
+// SetWrittenOffAmount sets the "written_off_amount" field.
+func (u *InvoiceUpsertBulk) SetWrittenOffAmount(v decimal.Decimal) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetWrittenOffAmount(v)
+	})
+}
+
+// AddWrittenOffAmount adds v to the "written_off_amount" field.
+func (u *InvoiceUpsertBulk) AddWrittenOffAmount(v decimal.Decimal) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddWrittenOffAmount(v)
+	})
+}
+
+// UpdateWrittenOffAmount sets the "written_off_amount" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateWrittenOffAmount() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateWrittenOffAmount()
+	})
+}
+
+// ClearWrittenOffAmount clears the value of the "written_off_amount" field.
+func (u *InvoiceUpsertBulk) ClearWrittenOffAmount() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearWrittenOffAmount()
+	})
+}
+
 // SetReferenceID sets the "reference_id" field.
 func (u *InvoiceUpsertBulk) SetReferenceID(v uuid.UUID) *InvoiceUpsertBulk {
 	return u.Update(func(s *InvoiceUpsert) {
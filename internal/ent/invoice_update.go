@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/bengobox/treasury-api/internal/ent/invoice"
+	"github.com/bengobox/treasury-api/internal/ent/invoiceline"
 	"github.com/bengobox/treasury-api/internal/ent/predicate"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -231,6 +232,33 @@ func (_u *InvoiceUpdate) SetNillablePaymentStatus(v *string) *InvoiceUpdate {
 	return _u
 }
 
+// SetWrittenOffAmount sets the "written_off_amount" field.
+func (_u *InvoiceUpdate) SetWrittenOffAmount(v decimal.Decimal) *InvoiceUpdate {
+	_u.mutation.ResetWrittenOffAmount()
+	_u.mutation.SetWrittenOffAmount(v)
+	return _u
+}
+
+// SetNillableWrittenOffAmount sets the "written_off_amount" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableWrittenOffAmount(v *decimal.Decimal) *InvoiceUpdate {
+	if v != nil {
+		_u.SetWrittenOffAmount(*v)
+	}
+	return _u
+}
+
+// AddWrittenOffAmount adds value to the "written_off_amount" field.
+func (_u *InvoiceUpdate) AddWrittenOffAmount(v decimal.Decimal) *InvoiceUpdate {
+	_u.mutation.AddWrittenOffAmount(v)
+	return _u
+}
+
+// ClearWrittenOffAmount clears the value of the "written_off_amount" field.
+func (_u *InvoiceUpdate) ClearWrittenOffAmount() *InvoiceUpdate {
+	_u.mutation.ClearWrittenOffAmount()
+	return _u
+}
+
 // SetReferenceID sets the "reference_id" field.
 func (_u *InvoiceUpdate) SetReferenceID(v uuid.UUID) *InvoiceUpdate {
 	_u.mutation.SetReferenceID(v)
@@ -283,11 +311,47 @@ func (_u *InvoiceUpdate) SetUpdatedAt(v time.Time) *InvoiceUpdate {
 	return _u
 }
 
+// AddLineIDs adds the "lines" edge to the InvoiceLine entity by IDs.
+func (_u *InvoiceUpdate) AddLineIDs(ids ...uuid.UUID) *InvoiceUpdate {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the InvoiceLine entity.
+func (_u *InvoiceUpdate) AddLines(v ...*InvoiceLine) *InvoiceUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
 // Mutation returns the InvoiceMutation object of the builder.
 func (_u *InvoiceUpdate) Mutation() *InvoiceMutation {
 	return _u.mutation
 }
 
+// ClearLines clears all "lines" edges to the InvoiceLine entity.
+func (_u *InvoiceUpdate) ClearLines() *InvoiceUpdate {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to InvoiceLine entities by IDs.
+func (_u *InvoiceUpdate) RemoveLineIDs(ids ...uuid.UUID) *InvoiceUpdate {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to InvoiceLine entities.
+func (_u *InvoiceUpdate) RemoveLines(v ...*InvoiceLine) *InvoiceUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *InvoiceUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -397,6 +461,15 @@ func (_u *InvoiceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.PaymentStatus(); ok {
 		_spec.SetField(invoice.FieldPaymentStatus, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.WrittenOffAmount(); ok {
+		_spec.SetField(invoice.FieldWrittenOffAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWrittenOffAmount(); ok {
+		_spec.AddField(invoice.FieldWrittenOffAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.WrittenOffAmountCleared() {
+		_spec.ClearField(invoice.FieldWrittenOffAmount, field.TypeFloat64)
+	}
 	if value, ok := _u.mutation.ReferenceID(); ok {
 		_spec.SetField(invoice.FieldReferenceID, field.TypeUUID, value)
 	}
@@ -415,6 +488,51 @@ func (_u *InvoiceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(invoice.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{invoice.Label}
@@ -636,6 +754,33 @@ func (_u *InvoiceUpdateOne) SetNillablePaymentStatus(v *string) *InvoiceUpdateOn
 	return _u
 }
 
+// SetWrittenOffAmount sets the "written_off_amount" field.
+func (_u *InvoiceUpdateOne) SetWrittenOffAmount(v decimal.Decimal) *InvoiceUpdateOne {
+	_u.mutation.ResetWrittenOffAmount()
+	_u.mutation.SetWrittenOffAmount(v)
+	return _u
+}
+
+// SetNillableWrittenOffAmount sets the "written_off_amount" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableWrittenOffAmount(v *decimal.Decimal) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetWrittenOffAmount(*v)
+	}
+	return _u
+}
+
+// AddWrittenOffAmount adds value to the "written_off_amount" field.
+func (_u *InvoiceUpdateOne) AddWrittenOffAmount(v decimal.Decimal) *InvoiceUpdateOne {
+	_u.mutation.AddWrittenOffAmount(v)
+	return _u
+}
+
+// ClearWrittenOffAmount clears the value of the "written_off_amount" field.
+func (_u *InvoiceUpdateOne) ClearWrittenOffAmount() *InvoiceUpdateOne {
+	_u.mutation.ClearWrittenOffAmount()
+	return _u
+}
+
 // SetReferenceID sets the "reference_id" field.
 func (_u *InvoiceUpdateOne) SetReferenceID(v uuid.UUID) *InvoiceUpdateOne {
 	_u.mutation.SetReferenceID(v)
@@ -688,11 +833,47 @@ func (_u *InvoiceUpdateOne) SetUpdatedAt(v time.Time) *InvoiceUpdateOne {
 	return _u
 }
 
+// AddLineIDs adds the "lines" edge to the InvoiceLine entity by IDs.
+func (_u *InvoiceUpdateOne) AddLineIDs(ids ...uuid.UUID) *InvoiceUpdateOne {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the InvoiceLine entity.
+func (_u *InvoiceUpdateOne) AddLines(v ...*InvoiceLine) *InvoiceUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
 // Mutation returns the InvoiceMutation object of the builder.
 func (_u *InvoiceUpdateOne) Mutation() *InvoiceMutation {
 	return _u.mutation
 }
 
+// ClearLines clears all "lines" edges to the InvoiceLine entity.
+func (_u *InvoiceUpdateOne) ClearLines() *InvoiceUpdateOne {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to InvoiceLine entities by IDs.
+func (_u *InvoiceUpdateOne) RemoveLineIDs(ids ...uuid.UUID) *InvoiceUpdateOne {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to InvoiceLine entities.
+func (_u *InvoiceUpdateOne) RemoveLines(v ...*InvoiceLine) *InvoiceUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
 // Where appends a list predicates to the InvoiceUpdate builder.
 func (_u *InvoiceUpdateOne) Where(ps ...predicate.Invoice) *InvoiceUpdateOne {
 	_u.mutation.Where(ps...)
@@ -832,6 +1013,15 @@ func (_u *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err er
 	if value, ok := _u.mutation.PaymentStatus(); ok {
 		_spec.SetField(invoice.FieldPaymentStatus, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.WrittenOffAmount(); ok {
+		_spec.SetField(invoice.FieldWrittenOffAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWrittenOffAmount(); ok {
+		_spec.AddField(invoice.FieldWrittenOffAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.WrittenOffAmountCleared() {
+		_spec.ClearField(invoice.FieldWrittenOffAmount, field.TypeFloat64)
+	}
 	if value, ok := _u.mutation.ReferenceID(); ok {
 		_spec.SetField(invoice.FieldReferenceID, field.TypeUUID, value)
 	}
@@ -850,6 +1040,51 @@ func (_u *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err er
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(invoice.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.LinesTable,
+			Columns: []string{invoice.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Invoice{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
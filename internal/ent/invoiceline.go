@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/invoice"
+	"github.com/bengobox/treasury-api/internal/ent/invoiceline"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceLine is the model entity for the InvoiceLine schema.
+type InvoiceLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Invoice identifier
+	InvoiceID uuid.UUID `json:"invoice_id,omitempty"`
+	// Line sequence number
+	LineNumber int `json:"line_number,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Quantity
+	Quantity decimal.Decimal `json:"quantity,omitempty"`
+	// Unit price
+	UnitPrice decimal.Decimal `json:"unit_price,omitempty"`
+	// Tax rate as a fraction (0.16 for 16% VAT)
+	TaxRate decimal.Decimal `json:"tax_rate,omitempty"`
+	// Tax amount (defaults to zero)
+	TaxAmount decimal.Decimal `json:"tax_amount,omitempty"`
+	// Line total before tax (quantity * unit_price)
+	LineTotal decimal.Decimal `json:"line_total,omitempty"`
+	// Ledger account code credited for the line
+	RevenueAccount string `json:"revenue_account,omitempty"`
+	// Source of the line (subscription, adjustment, meter)
+	ReferenceType string `json:"reference_type,omitempty"`
+	// Source entity identifier
+	ReferenceID uuid.UUID `json:"reference_id,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvoiceLineQuery when eager-loading is set.
+	Edges        InvoiceLineEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// InvoiceLineEdges holds the relations/edges for other nodes in the graph.
+type InvoiceLineEdges struct {
+	// Invoice holds the value of the invoice edge.
+	Invoice *Invoice `json:"invoice,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// InvoiceOrErr returns the Invoice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceLineEdges) InvoiceOrErr() (*Invoice, error) {
+	if e.Invoice != nil {
+		return e.Invoice, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: invoice.Label}
+	}
+	return nil, &NotLoadedError{edge: "invoice"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InvoiceLine) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoiceline.FieldMetadata:
+			values[i] = new([]byte)
+		case invoiceline.FieldQuantity, invoiceline.FieldUnitPrice, invoiceline.FieldTaxRate, invoiceline.FieldTaxAmount, invoiceline.FieldLineTotal:
+			values[i] = new(decimal.Decimal)
+		case invoiceline.FieldLineNumber:
+			values[i] = new(sql.NullInt64)
+		case invoiceline.FieldDescription, invoiceline.FieldRevenueAccount, invoiceline.FieldReferenceType:
+			values[i] = new(sql.NullString)
+		case invoiceline.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case invoiceline.FieldID, invoiceline.FieldTenantID, invoiceline.FieldInvoiceID, invoiceline.FieldReferenceID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InvoiceLine fields.
+func (_m *InvoiceLine) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case invoiceline.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case invoiceline.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case invoiceline.FieldInvoiceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value != nil {
+				_m.InvoiceID = *value
+			}
+		case invoiceline.FieldLineNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field line_number", values[i])
+			} else if value.Valid {
+				_m.LineNumber = int(value.Int64)
+			}
+		case invoiceline.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case invoiceline.FieldQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value != nil {
+				_m.Quantity = *value
+			}
+		case invoiceline.FieldUnitPrice:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_price", values[i])
+			} else if value != nil {
+				_m.UnitPrice = *value
+			}
+		case invoiceline.FieldTaxRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_rate", values[i])
+			} else if value != nil {
+				_m.TaxRate = *value
+			}
+		case invoiceline.FieldTaxAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_amount", values[i])
+			} else if value != nil {
+				_m.TaxAmount = *value
+			}
+		case invoiceline.FieldLineTotal:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field line_total", values[i])
+			} else if value != nil {
+				_m.LineTotal = *value
+			}
+		case invoiceline.FieldRevenueAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field revenue_account", values[i])
+			} else if value.Valid {
+				_m.RevenueAccount = value.String
+			}
+		case invoiceline.FieldReferenceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference_type", values[i])
+			} else if value.Valid {
+				_m.ReferenceType = value.String
+			}
+		case invoiceline.FieldReferenceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field reference_id", values[i])
+			} else if value != nil {
+				_m.ReferenceID = *value
+			}
+		case invoiceline.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case invoiceline.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InvoiceLine.
+// This includes values selected through modifiers, order, etc.
+func (_m *InvoiceLine) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryInvoice queries the "invoice" edge of the InvoiceLine entity.
+func (_m *InvoiceLine) QueryInvoice() *InvoiceQuery {
+	return NewInvoiceLineClient(_m.config).QueryInvoice(_m)
+}
+
+// Update returns a builder for updating this InvoiceLine.
+// Note that you need to call InvoiceLine.Unwrap() before calling this method if this InvoiceLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *InvoiceLine) Update() *InvoiceLineUpdateOne {
+	return NewInvoiceLineClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the InvoiceLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *InvoiceLine) Unwrap() *InvoiceLine {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvoiceLine is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *InvoiceLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("InvoiceLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("invoice_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InvoiceID))
+	builder.WriteString(", ")
+	builder.WriteString("line_number=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LineNumber))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("unit_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UnitPrice))
+	builder.WriteString(", ")
+	builder.WriteString("tax_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxRate))
+	builder.WriteString(", ")
+	builder.WriteString("tax_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxAmount))
+	builder.WriteString(", ")
+	builder.WriteString("line_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LineTotal))
+	builder.WriteString(", ")
+	builder.WriteString("revenue_account=")
+	builder.WriteString(_m.RevenueAccount)
+	builder.WriteString(", ")
+	builder.WriteString("reference_type=")
+	builder.WriteString(_m.ReferenceType)
+	builder.WriteString(", ")
+	builder.WriteString("reference_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReferenceID))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvoiceLines is a parsable slice of InvoiceLine.
+type InvoiceLines []*InvoiceLine
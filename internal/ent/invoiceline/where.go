@@ -0,0 +1,786 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoiceline
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldTenantID, v))
+}
+
+// InvoiceID applies equality check predicate on the "invoice_id" field. It's identical to InvoiceIDEQ.
+func InvoiceID(v uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// LineNumber applies equality check predicate on the "line_number" field. It's identical to LineNumberEQ.
+func LineNumber(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldLineNumber, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldDescription, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldQuantity, v))
+}
+
+// UnitPrice applies equality check predicate on the "unit_price" field. It's identical to UnitPriceEQ.
+func UnitPrice(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// TaxRate applies equality check predicate on the "tax_rate" field. It's identical to TaxRateEQ.
+func TaxRate(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldTaxRate, v))
+}
+
+// TaxAmount applies equality check predicate on the "tax_amount" field. It's identical to TaxAmountEQ.
+func TaxAmount(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldTaxAmount, v))
+}
+
+// LineTotal applies equality check predicate on the "line_total" field. It's identical to LineTotalEQ.
+func LineTotal(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldLineTotal, v))
+}
+
+// RevenueAccount applies equality check predicate on the "revenue_account" field. It's identical to RevenueAccountEQ.
+func RevenueAccount(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldRevenueAccount, v))
+}
+
+// ReferenceType applies equality check predicate on the "reference_type" field. It's identical to ReferenceTypeEQ.
+func ReferenceType(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldReferenceType, v))
+}
+
+// ReferenceID applies equality check predicate on the "reference_id" field. It's identical to ReferenceIDEQ.
+func ReferenceID(v uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldReferenceID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLTE(FieldTenantID, v))
+}
+
+// InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
+func InvoiceIDEQ(v uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDNEQ applies the NEQ predicate on the "invoice_id" field.
+func InvoiceIDNEQ(v uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDIn applies the In predicate on the "invoice_id" field.
+func InvoiceIDIn(vs ...uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDNotIn applies the NotIn predicate on the "invoice_id" field.
+func InvoiceIDNotIn(vs ...uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldInvoiceID, vs...))
+}
+
+// LineNumberEQ applies the EQ predicate on the "line_number" field.
+func LineNumberEQ(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldLineNumber, v))
+}
+
+// LineNumberNEQ applies the NEQ predicate on the "line_number" field.
+func LineNumberNEQ(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldLineNumber, v))
+}
+
+// LineNumberIn applies the In predicate on the "line_number" field.
+func LineNumberIn(vs ...int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldLineNumber, vs...))
+}
+
+// LineNumberNotIn applies the NotIn predicate on the "line_number" field.
+func LineNumberNotIn(vs ...int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldLineNumber, vs...))
+}
+
+// LineNumberGT applies the GT predicate on the "line_number" field.
+func LineNumberGT(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGT(FieldLineNumber, v))
+}
+
+// LineNumberGTE applies the GTE predicate on the "line_number" field.
+func LineNumberGTE(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGTE(FieldLineNumber, v))
+}
+
+// LineNumberLT applies the LT predicate on the "line_number" field.
+func LineNumberLT(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLT(FieldLineNumber, v))
+}
+
+// LineNumberLTE applies the LTE predicate on the "line_number" field.
+func LineNumberLTE(v int) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLTE(FieldLineNumber, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLTE(FieldQuantity, v))
+}
+
+// UnitPriceEQ applies the EQ predicate on the "unit_price" field.
+func UnitPriceEQ(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceNEQ applies the NEQ predicate on the "unit_price" field.
+func UnitPriceNEQ(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceIn applies the In predicate on the "unit_price" field.
+func UnitPriceIn(vs ...decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceNotIn applies the NotIn predicate on the "unit_price" field.
+func UnitPriceNotIn(vs ...decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceGT applies the GT predicate on the "unit_price" field.
+func UnitPriceGT(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGT(FieldUnitPrice, v))
+}
+
+// UnitPriceGTE applies the GTE predicate on the "unit_price" field.
+func UnitPriceGTE(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGTE(FieldUnitPrice, v))
+}
+
+// UnitPriceLT applies the LT predicate on the "unit_price" field.
+func UnitPriceLT(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLT(FieldUnitPrice, v))
+}
+
+// UnitPriceLTE applies the LTE predicate on the "unit_price" field.
+func UnitPriceLTE(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLTE(FieldUnitPrice, v))
+}
+
+// TaxRateEQ applies the EQ predicate on the "tax_rate" field.
+func TaxRateEQ(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldTaxRate, v))
+}
+
+// TaxRateNEQ applies the NEQ predicate on the "tax_rate" field.
+func TaxRateNEQ(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldTaxRate, v))
+}
+
+// TaxRateIn applies the In predicate on the "tax_rate" field.
+func TaxRateIn(vs ...decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldTaxRate, vs...))
+}
+
+// TaxRateNotIn applies the NotIn predicate on the "tax_rate" field.
+func TaxRateNotIn(vs ...decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldTaxRate, vs...))
+}
+
+// TaxRateGT applies the GT predicate on the "tax_rate" field.
+func TaxRateGT(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGT(FieldTaxRate, v))
+}
+
+// TaxRateGTE applies the GTE predicate on the "tax_rate" field.
+func TaxRateGTE(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGTE(FieldTaxRate, v))
+}
+
+// TaxRateLT applies the LT predicate on the "tax_rate" field.
+func TaxRateLT(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLT(FieldTaxRate, v))
+}
+
+// TaxRateLTE applies the LTE predicate on the "tax_rate" field.
+func TaxRateLTE(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLTE(FieldTaxRate, v))
+}
+
+// TaxRateIsNil applies the IsNil predicate on the "tax_rate" field.
+func TaxRateIsNil() predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIsNull(FieldTaxRate))
+}
+
+// TaxRateNotNil applies the NotNil predicate on the "tax_rate" field.
+func TaxRateNotNil() predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotNull(FieldTaxRate))
+}
+
+// TaxAmountEQ applies the EQ predicate on the "tax_amount" field.
+func TaxAmountEQ(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldTaxAmount, v))
+}
+
+// TaxAmountNEQ applies the NEQ predicate on the "tax_amount" field.
+func TaxAmountNEQ(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldTaxAmount, v))
+}
+
+// TaxAmountIn applies the In predicate on the "tax_amount" field.
+func TaxAmountIn(vs ...decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldTaxAmount, vs...))
+}
+
+// TaxAmountNotIn applies the NotIn predicate on the "tax_amount" field.
+func TaxAmountNotIn(vs ...decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldTaxAmount, vs...))
+}
+
+// TaxAmountGT applies the GT predicate on the "tax_amount" field.
+func TaxAmountGT(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGT(FieldTaxAmount, v))
+}
+
+// TaxAmountGTE applies the GTE predicate on the "tax_amount" field.
+func TaxAmountGTE(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGTE(FieldTaxAmount, v))
+}
+
+// TaxAmountLT applies the LT predicate on the "tax_amount" field.
+func TaxAmountLT(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLT(FieldTaxAmount, v))
+}
+
+// TaxAmountLTE applies the LTE predicate on the "tax_amount" field.
+func TaxAmountLTE(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLTE(FieldTaxAmount, v))
+}
+
+// TaxAmountIsNil applies the IsNil predicate on the "tax_amount" field.
+func TaxAmountIsNil() predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIsNull(FieldTaxAmount))
+}
+
+// TaxAmountNotNil applies the NotNil predicate on the "tax_amount" field.
+func TaxAmountNotNil() predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotNull(FieldTaxAmount))
+}
+
+// LineTotalEQ applies the EQ predicate on the "line_total" field.
+func LineTotalEQ(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldLineTotal, v))
+}
+
+// LineTotalNEQ applies the NEQ predicate on the "line_total" field.
+func LineTotalNEQ(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldLineTotal, v))
+}
+
+// LineTotalIn applies the In predicate on the "line_total" field.
+func LineTotalIn(vs ...decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldLineTotal, vs...))
+}
+
+// LineTotalNotIn applies the NotIn predicate on the "line_total" field.
+func LineTotalNotIn(vs ...decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldLineTotal, vs...))
+}
+
+// LineTotalGT applies the GT predicate on the "line_total" field.
+func LineTotalGT(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGT(FieldLineTotal, v))
+}
+
+// LineTotalGTE applies the GTE predicate on the "line_total" field.
+func LineTotalGTE(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGTE(FieldLineTotal, v))
+}
+
+// LineTotalLT applies the LT predicate on the "line_total" field.
+func LineTotalLT(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLT(FieldLineTotal, v))
+}
+
+// LineTotalLTE applies the LTE predicate on the "line_total" field.
+func LineTotalLTE(v decimal.Decimal) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLTE(FieldLineTotal, v))
+}
+
+// RevenueAccountEQ applies the EQ predicate on the "revenue_account" field.
+func RevenueAccountEQ(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldRevenueAccount, v))
+}
+
+// RevenueAccountNEQ applies the NEQ predicate on the "revenue_account" field.
+func RevenueAccountNEQ(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldRevenueAccount, v))
+}
+
+// RevenueAccountIn applies the In predicate on the "revenue_account" field.
+func RevenueAccountIn(vs ...string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldRevenueAccount, vs...))
+}
+
+// RevenueAccountNotIn applies the NotIn predicate on the "revenue_account" field.
+func RevenueAccountNotIn(vs ...string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldRevenueAccount, vs...))
+}
+
+// RevenueAccountGT applies the GT predicate on the "revenue_account" field.
+func RevenueAccountGT(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGT(FieldRevenueAccount, v))
+}
+
+// RevenueAccountGTE applies the GTE predicate on the "revenue_account" field.
+func RevenueAccountGTE(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGTE(FieldRevenueAccount, v))
+}
+
+// RevenueAccountLT applies the LT predicate on the "revenue_account" field.
+func RevenueAccountLT(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLT(FieldRevenueAccount, v))
+}
+
+// RevenueAccountLTE applies the LTE predicate on the "revenue_account" field.
+func RevenueAccountLTE(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLTE(FieldRevenueAccount, v))
+}
+
+// RevenueAccountContains applies the Contains predicate on the "revenue_account" field.
+func RevenueAccountContains(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldContains(FieldRevenueAccount, v))
+}
+
+// RevenueAccountHasPrefix applies the HasPrefix predicate on the "revenue_account" field.
+func RevenueAccountHasPrefix(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldHasPrefix(FieldRevenueAccount, v))
+}
+
+// RevenueAccountHasSuffix applies the HasSuffix predicate on the "revenue_account" field.
+func RevenueAccountHasSuffix(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldHasSuffix(FieldRevenueAccount, v))
+}
+
+// RevenueAccountIsNil applies the IsNil predicate on the "revenue_account" field.
+func RevenueAccountIsNil() predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIsNull(FieldRevenueAccount))
+}
+
+// RevenueAccountNotNil applies the NotNil predicate on the "revenue_account" field.
+func RevenueAccountNotNil() predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotNull(FieldRevenueAccount))
+}
+
+// RevenueAccountEqualFold applies the EqualFold predicate on the "revenue_account" field.
+func RevenueAccountEqualFold(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEqualFold(FieldRevenueAccount, v))
+}
+
+// RevenueAccountContainsFold applies the ContainsFold predicate on the "revenue_account" field.
+func RevenueAccountContainsFold(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldContainsFold(FieldRevenueAccount, v))
+}
+
+// ReferenceTypeEQ applies the EQ predicate on the "reference_type" field.
+func ReferenceTypeEQ(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldReferenceType, v))
+}
+
+// ReferenceTypeNEQ applies the NEQ predicate on the "reference_type" field.
+func ReferenceTypeNEQ(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldReferenceType, v))
+}
+
+// ReferenceTypeIn applies the In predicate on the "reference_type" field.
+func ReferenceTypeIn(vs ...string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldReferenceType, vs...))
+}
+
+// ReferenceTypeNotIn applies the NotIn predicate on the "reference_type" field.
+func ReferenceTypeNotIn(vs ...string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldReferenceType, vs...))
+}
+
+// ReferenceTypeGT applies the GT predicate on the "reference_type" field.
+func ReferenceTypeGT(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGT(FieldReferenceType, v))
+}
+
+// ReferenceTypeGTE applies the GTE predicate on the "reference_type" field.
+func ReferenceTypeGTE(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGTE(FieldReferenceType, v))
+}
+
+// ReferenceTypeLT applies the LT predicate on the "reference_type" field.
+func ReferenceTypeLT(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLT(FieldReferenceType, v))
+}
+
+// ReferenceTypeLTE applies the LTE predicate on the "reference_type" field.
+func ReferenceTypeLTE(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLTE(FieldReferenceType, v))
+}
+
+// ReferenceTypeContains applies the Contains predicate on the "reference_type" field.
+func ReferenceTypeContains(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldContains(FieldReferenceType, v))
+}
+
+// ReferenceTypeHasPrefix applies the HasPrefix predicate on the "reference_type" field.
+func ReferenceTypeHasPrefix(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldHasPrefix(FieldReferenceType, v))
+}
+
+// ReferenceTypeHasSuffix applies the HasSuffix predicate on the "reference_type" field.
+func ReferenceTypeHasSuffix(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldHasSuffix(FieldReferenceType, v))
+}
+
+// ReferenceTypeIsNil applies the IsNil predicate on the "reference_type" field.
+func ReferenceTypeIsNil() predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIsNull(FieldReferenceType))
+}
+
+// ReferenceTypeNotNil applies the NotNil predicate on the "reference_type" field.
+func ReferenceTypeNotNil() predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotNull(FieldReferenceType))
+}
+
+// ReferenceTypeEqualFold applies the EqualFold predicate on the "reference_type" field.
+func ReferenceTypeEqualFold(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEqualFold(FieldReferenceType, v))
+}
+
+// ReferenceTypeContainsFold applies the ContainsFold predicate on the "reference_type" field.
+func ReferenceTypeContainsFold(v string) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldContainsFold(FieldReferenceType, v))
+}
+
+// ReferenceIDEQ applies the EQ predicate on the "reference_id" field.
+func ReferenceIDEQ(v uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldReferenceID, v))
+}
+
+// ReferenceIDNEQ applies the NEQ predicate on the "reference_id" field.
+func ReferenceIDNEQ(v uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldReferenceID, v))
+}
+
+// ReferenceIDIn applies the In predicate on the "reference_id" field.
+func ReferenceIDIn(vs ...uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldReferenceID, vs...))
+}
+
+// ReferenceIDNotIn applies the NotIn predicate on the "reference_id" field.
+func ReferenceIDNotIn(vs ...uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldReferenceID, vs...))
+}
+
+// ReferenceIDGT applies the GT predicate on the "reference_id" field.
+func ReferenceIDGT(v uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGT(FieldReferenceID, v))
+}
+
+// ReferenceIDGTE applies the GTE predicate on the "reference_id" field.
+func ReferenceIDGTE(v uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGTE(FieldReferenceID, v))
+}
+
+// ReferenceIDLT applies the LT predicate on the "reference_id" field.
+func ReferenceIDLT(v uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLT(FieldReferenceID, v))
+}
+
+// ReferenceIDLTE applies the LTE predicate on the "reference_id" field.
+func ReferenceIDLTE(v uuid.UUID) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLTE(FieldReferenceID, v))
+}
+
+// ReferenceIDIsNil applies the IsNil predicate on the "reference_id" field.
+func ReferenceIDIsNil() predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIsNull(FieldReferenceID))
+}
+
+// ReferenceIDNotNil applies the NotNil predicate on the "reference_id" field.
+func ReferenceIDNotNil() predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotNull(FieldReferenceID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasInvoice applies the HasEdge predicate on the "invoice" edge.
+func HasInvoice() predicate.InvoiceLine {
+	return predicate.InvoiceLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, InvoiceTable, InvoiceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoiceWith applies the HasEdge predicate on the "invoice" edge with a given conditions (other predicates).
+func HasInvoiceWith(preds ...predicate.Invoice) predicate.InvoiceLine {
+	return predicate.InvoiceLine(func(s *sql.Selector) {
+		step := newInvoiceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InvoiceLine) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InvoiceLine) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InvoiceLine) predicate.InvoiceLine {
+	return predicate.InvoiceLine(sql.NotPredicates(p))
+}
@@ -0,0 +1,1453 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/invoice"
+	"github.com/bengobox/treasury-api/internal/ent/invoiceline"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceLineCreate is the builder for creating a InvoiceLine entity.
+type InvoiceLineCreate struct {
+	config
+	mutation *InvoiceLineMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *InvoiceLineCreate) SetTenantID(v uuid.UUID) *InvoiceLineCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_c *InvoiceLineCreate) SetInvoiceID(v uuid.UUID) *InvoiceLineCreate {
+	_c.mutation.SetInvoiceID(v)
+	return _c
+}
+
+// SetLineNumber sets the "line_number" field.
+func (_c *InvoiceLineCreate) SetLineNumber(v int) *InvoiceLineCreate {
+	_c.mutation.SetLineNumber(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *InvoiceLineCreate) SetDescription(v string) *InvoiceLineCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *InvoiceLineCreate) SetQuantity(v decimal.Decimal) *InvoiceLineCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_c *InvoiceLineCreate) SetUnitPrice(v decimal.Decimal) *InvoiceLineCreate {
+	_c.mutation.SetUnitPrice(v)
+	return _c
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (_c *InvoiceLineCreate) SetTaxRate(v decimal.Decimal) *InvoiceLineCreate {
+	_c.mutation.SetTaxRate(v)
+	return _c
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillableTaxRate(v *decimal.Decimal) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetTaxRate(*v)
+	}
+	return _c
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (_c *InvoiceLineCreate) SetTaxAmount(v decimal.Decimal) *InvoiceLineCreate {
+	_c.mutation.SetTaxAmount(v)
+	return _c
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillableTaxAmount(v *decimal.Decimal) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetTaxAmount(*v)
+	}
+	return _c
+}
+
+// SetLineTotal sets the "line_total" field.
+func (_c *InvoiceLineCreate) SetLineTotal(v decimal.Decimal) *InvoiceLineCreate {
+	_c.mutation.SetLineTotal(v)
+	return _c
+}
+
+// SetRevenueAccount sets the "revenue_account" field.
+func (_c *InvoiceLineCreate) SetRevenueAccount(v string) *InvoiceLineCreate {
+	_c.mutation.SetRevenueAccount(v)
+	return _c
+}
+
+// SetNillableRevenueAccount sets the "revenue_account" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillableRevenueAccount(v *string) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetRevenueAccount(*v)
+	}
+	return _c
+}
+
+// SetReferenceType sets the "reference_type" field.
+func (_c *InvoiceLineCreate) SetReferenceType(v string) *InvoiceLineCreate {
+	_c.mutation.SetReferenceType(v)
+	return _c
+}
+
+// SetNillableReferenceType sets the "reference_type" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillableReferenceType(v *string) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetReferenceType(*v)
+	}
+	return _c
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (_c *InvoiceLineCreate) SetReferenceID(v uuid.UUID) *InvoiceLineCreate {
+	_c.mutation.SetReferenceID(v)
+	return _c
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillableReferenceID(v *uuid.UUID) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetReferenceID(*v)
+	}
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *InvoiceLineCreate) SetMetadata(v map[string]interface{}) *InvoiceLineCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InvoiceLineCreate) SetCreatedAt(v time.Time) *InvoiceLineCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillableCreatedAt(v *time.Time) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InvoiceLineCreate) SetID(v uuid.UUID) *InvoiceLineCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *InvoiceLineCreate) SetNillableID(v *uuid.UUID) *InvoiceLineCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_c *InvoiceLineCreate) SetInvoice(v *Invoice) *InvoiceLineCreate {
+	return _c.SetInvoiceID(v.ID)
+}
+
+// Mutation returns the InvoiceLineMutation object of the builder.
+func (_c *InvoiceLineCreate) Mutation() *InvoiceLineMutation {
+	return _c.mutation
+}
+
+// Save creates the InvoiceLine in the database.
+func (_c *InvoiceLineCreate) Save(ctx context.Context) (*InvoiceLine, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InvoiceLineCreate) SaveX(ctx context.Context) *InvoiceLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceLineCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceLineCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InvoiceLineCreate) defaults() {
+	if _, ok := _c.mutation.Metadata(); !ok {
+		v := invoiceline.DefaultMetadata
+		_c.mutation.SetMetadata(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := invoiceline.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := invoiceline.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InvoiceLineCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "InvoiceLine.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.InvoiceID(); !ok {
+		return &ValidationError{Name: "invoice_id", err: errors.New(`ent: missing required field "InvoiceLine.invoice_id"`)}
+	}
+	if _, ok := _c.mutation.LineNumber(); !ok {
+		return &ValidationError{Name: "line_number", err: errors.New(`ent: missing required field "InvoiceLine.line_number"`)}
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "InvoiceLine.description"`)}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := invoiceline.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "InvoiceLine.description": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "InvoiceLine.quantity"`)}
+	}
+	if _, ok := _c.mutation.UnitPrice(); !ok {
+		return &ValidationError{Name: "unit_price", err: errors.New(`ent: missing required field "InvoiceLine.unit_price"`)}
+	}
+	if _, ok := _c.mutation.LineTotal(); !ok {
+		return &ValidationError{Name: "line_total", err: errors.New(`ent: missing required field "InvoiceLine.line_total"`)}
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		return &ValidationError{Name: "metadata", err: errors.New(`ent: missing required field "InvoiceLine.metadata"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InvoiceLine.created_at"`)}
+	}
+	if len(_c.mutation.InvoiceIDs()) == 0 {
+		return &ValidationError{Name: "invoice", err: errors.New(`ent: missing required edge "InvoiceLine.invoice"`)}
+	}
+	return nil
+}
+
+func (_c *InvoiceLineCreate) sqlSave(ctx context.Context) (*InvoiceLine, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InvoiceLineCreate) createSpec() (*InvoiceLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvoiceLine{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(invoiceline.Table, sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(invoiceline.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.LineNumber(); ok {
+		_spec.SetField(invoiceline.FieldLineNumber, field.TypeInt, value)
+		_node.LineNumber = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(invoiceline.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(invoiceline.FieldQuantity, field.TypeFloat64, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.UnitPrice(); ok {
+		_spec.SetField(invoiceline.FieldUnitPrice, field.TypeFloat64, value)
+		_node.UnitPrice = value
+	}
+	if value, ok := _c.mutation.TaxRate(); ok {
+		_spec.SetField(invoiceline.FieldTaxRate, field.TypeFloat64, value)
+		_node.TaxRate = value
+	}
+	if value, ok := _c.mutation.TaxAmount(); ok {
+		_spec.SetField(invoiceline.FieldTaxAmount, field.TypeFloat64, value)
+		_node.TaxAmount = value
+	}
+	if value, ok := _c.mutation.LineTotal(); ok {
+		_spec.SetField(invoiceline.FieldLineTotal, field.TypeFloat64, value)
+		_node.LineTotal = value
+	}
+	if value, ok := _c.mutation.RevenueAccount(); ok {
+		_spec.SetField(invoiceline.FieldRevenueAccount, field.TypeString, value)
+		_node.RevenueAccount = value
+	}
+	if value, ok := _c.mutation.ReferenceType(); ok {
+		_spec.SetField(invoiceline.FieldReferenceType, field.TypeString, value)
+		_node.ReferenceType = value
+	}
+	if value, ok := _c.mutation.ReferenceID(); ok {
+		_spec.SetField(invoiceline.FieldReferenceID, field.TypeUUID, value)
+		_node.ReferenceID = value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(invoiceline.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(invoiceline.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceline.InvoiceTable,
+			Columns: []string{invoiceline.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InvoiceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvoiceLine.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceLineUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvoiceLineCreate) OnConflict(opts ...sql.ConflictOption) *InvoiceLineUpsertOne {
+	_c.conflict = opts
+	return &InvoiceLineUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvoiceLine.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvoiceLineCreate) OnConflictColumns(columns ...string) *InvoiceLineUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceLineUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// InvoiceLineUpsertOne is the builder for "upsert"-ing
+	//  one InvoiceLine node.
+	InvoiceLineUpsertOne struct {
+		create *InvoiceLineCreate
+	}
+
+	// InvoiceLineUpsert is the "OnConflict" setter.
+	InvoiceLineUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *InvoiceLineUpsert) SetTenantID(v uuid.UUID) *InvoiceLineUpsert {
+	u.Set(invoiceline.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *InvoiceLineUpsert) UpdateTenantID() *InvoiceLineUpsert {
+	u.SetExcluded(invoiceline.FieldTenantID)
+	return u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *InvoiceLineUpsert) SetInvoiceID(v uuid.UUID) *InvoiceLineUpsert {
+	u.Set(invoiceline.FieldInvoiceID, v)
+	return u
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *InvoiceLineUpsert) UpdateInvoiceID() *InvoiceLineUpsert {
+	u.SetExcluded(invoiceline.FieldInvoiceID)
+	return u
+}
+
+// SetLineNumber sets the "line_number" field.
+func (u *InvoiceLineUpsert) SetLineNumber(v int) *InvoiceLineUpsert {
+	u.Set(invoiceline.FieldLineNumber, v)
+	return u
+}
+
+// UpdateLineNumber sets the "line_number" field to the value that was provided on create.
+func (u *InvoiceLineUpsert) UpdateLineNumber() *InvoiceLineUpsert {
+	u.SetExcluded(invoiceline.FieldLineNumber)
+	return u
+}
+
+// AddLineNumber adds v to the "line_number" field.
+func (u *InvoiceLineUpsert) AddLineNumber(v int) *InvoiceLineUpsert {
+	u.Add(invoiceline.FieldLineNumber, v)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *InvoiceLineUpsert) SetDescription(v string) *InvoiceLineUpsert {
+	u.Set(invoiceline.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *InvoiceLineUpsert) UpdateDescription() *InvoiceLineUpsert {
+	u.SetExcluded(invoiceline.FieldDescription)
+	return u
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *InvoiceLineUpsert) SetQuantity(v decimal.Decimal) *InvoiceLineUpsert {
+	u.Set(invoiceline.FieldQuantity, v)
+	return u
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *InvoiceLineUpsert) UpdateQuantity() *InvoiceLineUpsert {
+	u.SetExcluded(invoiceline.FieldQuantity)
+	return u
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *InvoiceLineUpsert) AddQuantity(v decimal.Decimal) *InvoiceLineUpsert {
+	u.Add(invoiceline.FieldQuantity, v)
+	return u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (u *InvoiceLineUpsert) SetUnitPrice(v decimal.Decimal) *InvoiceLineUpsert {
+	u.Set(invoiceline.FieldUnitPrice, v)
+	return u
+}
+
+// UpdateUnitPrice sets the "unit_price" field to the value that was provided on create.
+func (u *InvoiceLineUpsert) UpdateUnitPrice() *InvoiceLineUpsert {
+	u.SetExcluded(invoiceline.FieldUnitPrice)
+	return u
+}
+
+// AddUnitPrice adds v to the "unit_price" field.
+func (u *InvoiceLineUpsert) AddUnitPrice(v decimal.Decimal) *InvoiceLineUpsert {
+	u.Add(invoiceline.FieldUnitPrice, v)
+	return u
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (u *InvoiceLineUpsert) SetTaxRate(v decimal.Decimal) *InvoiceLineUpsert {
+	u.Set(invoiceline.FieldTaxRate, v)
+	return u
+}
+
+// UpdateTaxRate sets the "tax_rate" field to the value that was provided on create.
+func (u *InvoiceLineUpsert) UpdateTaxRate() *InvoiceLineUpsert {
+	u.SetExcluded(invoiceline.FieldTaxRate)
+	return u
+}
+
+// AddTaxRate adds v to the "tax_rate" field.
+func (u *InvoiceLineUpsert) AddTaxRate(v decimal.Decimal) *InvoiceLineUpsert {
+	u.Add(invoiceline.FieldTaxRate, v)
+	return u
+}
+
+// ClearTaxRate clears the value of the "tax_rate" field.
+func (u *InvoiceLineUpsert) ClearTaxRate() *InvoiceLineUpsert {
+	u.SetNull(invoiceline.FieldTaxRate)
+	return u
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *InvoiceLineUpsert) SetTaxAmount(v decimal.Decimal) *InvoiceLineUpsert {
+	u.Set(invoiceline.FieldTaxAmount, v)
+	return u
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *InvoiceLineUpsert) UpdateTaxAmount() *InvoiceLineUpsert {
+	u.SetExcluded(invoiceline.FieldTaxAmount)
+	return u
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *InvoiceLineUpsert) AddTaxAmount(v decimal.Decimal) *InvoiceLineUpsert {
+	u.Add(invoiceline.FieldTaxAmount, v)
+	return u
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (u *InvoiceLineUpsert) ClearTaxAmount() *InvoiceLineUpsert {
+	u.SetNull(invoiceline.FieldTaxAmount)
+	return u
+}
+
+// SetLineTotal sets the "line_total" field.
+func (u *InvoiceLineUpsert) SetLineTotal(v decimal.Decimal) *InvoiceLineUpsert {
+	u.Set(invoiceline.FieldLineTotal, v)
+	return u
+}
+
+// UpdateLineTotal sets the "line_total" field to the value that was provided on create.
+func (u *InvoiceLineUpsert) UpdateLineTotal() *InvoiceLineUpsert {
+	u.SetExcluded(invoiceline.FieldLineTotal)
+	return u
+}
+
+// AddLineTotal adds v to the "line_total" field.
+func (u *InvoiceLineUpsert) AddLineTotal(v decimal.Decimal) *InvoiceLineUpsert {
+	u.Add(invoiceline.FieldLineTotal, v)
+	return u
+}
+
+// SetRevenueAccount sets the "revenue_account" field.
+func (u *InvoiceLineUpsert) SetRevenueAccount(v string) *InvoiceLineUpsert {
+	u.Set(invoiceline.FieldRevenueAccount, v)
+	return u
+}
+
+// UpdateRevenueAccount sets the "revenue_account" field to the value that was provided on create.
+func (u *InvoiceLineUpsert) UpdateRevenueAccount() *InvoiceLineUpsert {
+	u.SetExcluded(invoiceline.FieldRevenueAccount)
+	return u
+}
+
+// ClearRevenueAccount clears the value of the "revenue_account" field.
+func (u *InvoiceLineUpsert) ClearRevenueAccount() *InvoiceLineUpsert {
+	u.SetNull(invoiceline.FieldRevenueAccount)
+	return u
+}
+
+// SetReferenceType sets the "reference_type" field.
+func (u *InvoiceLineUpsert) SetReferenceType(v string) *InvoiceLineUpsert {
+	u.Set(invoiceline.FieldReferenceType, v)
+	return u
+}
+
+// UpdateReferenceType sets the "reference_type" field to the value that was provided on create.
+func (u *InvoiceLineUpsert) UpdateReferenceType() *InvoiceLineUpsert {
+	u.SetExcluded(invoiceline.FieldReferenceType)
+	return u
+}
+
+// ClearReferenceType clears the value of the "reference_type" field.
+func (u *InvoiceLineUpsert) ClearReferenceType() *InvoiceLineUpsert {
+	u.SetNull(invoiceline.FieldReferenceType)
+	return u
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (u *InvoiceLineUpsert) SetReferenceID(v uuid.UUID) *InvoiceLineUpsert {
+	u.Set(invoiceline.FieldReferenceID, v)
+	return u
+}
+
+// UpdateReferenceID sets the "reference_id" field to the value that was provided on create.
+func (u *InvoiceLineUpsert) UpdateReferenceID() *InvoiceLineUpsert {
+	u.SetExcluded(invoiceline.FieldReferenceID)
+	return u
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (u *InvoiceLineUpsert) ClearReferenceID() *InvoiceLineUpsert {
+	u.SetNull(invoiceline.FieldReferenceID)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *InvoiceLineUpsert) SetMetadata(v map[string]interface{}) *InvoiceLineUpsert {
+	u.Set(invoiceline.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *InvoiceLineUpsert) UpdateMetadata() *InvoiceLineUpsert {
+	u.SetExcluded(invoiceline.FieldMetadata)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.InvoiceLine.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invoiceline.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvoiceLineUpsertOne) UpdateNewValues() *InvoiceLineUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(invoiceline.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(invoiceline.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvoiceLine.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InvoiceLineUpsertOne) Ignore() *InvoiceLineUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceLineUpsertOne) DoNothing() *InvoiceLineUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceLineCreate.OnConflict
+// documentation for more info.
+func (u *InvoiceLineUpsertOne) Update(set func(*InvoiceLineUpsert)) *InvoiceLineUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceLineUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *InvoiceLineUpsertOne) SetTenantID(v uuid.UUID) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *InvoiceLineUpsertOne) UpdateTenantID() *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *InvoiceLineUpsertOne) SetInvoiceID(v uuid.UUID) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetInvoiceID(v)
+	})
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *InvoiceLineUpsertOne) UpdateInvoiceID() *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateInvoiceID()
+	})
+}
+
+// SetLineNumber sets the "line_number" field.
+func (u *InvoiceLineUpsertOne) SetLineNumber(v int) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetLineNumber(v)
+	})
+}
+
+// AddLineNumber adds v to the "line_number" field.
+func (u *InvoiceLineUpsertOne) AddLineNumber(v int) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.AddLineNumber(v)
+	})
+}
+
+// UpdateLineNumber sets the "line_number" field to the value that was provided on create.
+func (u *InvoiceLineUpsertOne) UpdateLineNumber() *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateLineNumber()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *InvoiceLineUpsertOne) SetDescription(v string) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *InvoiceLineUpsertOne) UpdateDescription() *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *InvoiceLineUpsertOne) SetQuantity(v decimal.Decimal) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *InvoiceLineUpsertOne) AddQuantity(v decimal.Decimal) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *InvoiceLineUpsertOne) UpdateQuantity() *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (u *InvoiceLineUpsertOne) SetUnitPrice(v decimal.Decimal) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetUnitPrice(v)
+	})
+}
+
+// AddUnitPrice adds v to the "unit_price" field.
+func (u *InvoiceLineUpsertOne) AddUnitPrice(v decimal.Decimal) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.AddUnitPrice(v)
+	})
+}
+
+// UpdateUnitPrice sets the "unit_price" field to the value that was provided on create.
+func (u *InvoiceLineUpsertOne) UpdateUnitPrice() *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateUnitPrice()
+	})
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (u *InvoiceLineUpsertOne) SetTaxRate(v decimal.Decimal) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetTaxRate(v)
+	})
+}
+
+// AddTaxRate adds v to the "tax_rate" field.
+func (u *InvoiceLineUpsertOne) AddTaxRate(v decimal.Decimal) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.AddTaxRate(v)
+	})
+}
+
+// UpdateTaxRate sets the "tax_rate" field to the value that was provided on create.
+func (u *InvoiceLineUpsertOne) UpdateTaxRate() *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateTaxRate()
+	})
+}
+
+// ClearTaxRate clears the value of the "tax_rate" field.
+func (u *InvoiceLineUpsertOne) ClearTaxRate() *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.ClearTaxRate()
+	})
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *InvoiceLineUpsertOne) SetTaxAmount(v decimal.Decimal) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetTaxAmount(v)
+	})
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *InvoiceLineUpsertOne) AddTaxAmount(v decimal.Decimal) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.AddTaxAmount(v)
+	})
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *InvoiceLineUpsertOne) UpdateTaxAmount() *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateTaxAmount()
+	})
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (u *InvoiceLineUpsertOne) ClearTaxAmount() *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.ClearTaxAmount()
+	})
+}
+
+// SetLineTotal sets the "line_total" field.
+func (u *InvoiceLineUpsertOne) SetLineTotal(v decimal.Decimal) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetLineTotal(v)
+	})
+}
+
+// AddLineTotal adds v to the "line_total" field.
+func (u *InvoiceLineUpsertOne) AddLineTotal(v decimal.Decimal) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.AddLineTotal(v)
+	})
+}
+
+// UpdateLineTotal sets the "line_total" field to the value that was provided on create.
+func (u *InvoiceLineUpsertOne) UpdateLineTotal() *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateLineTotal()
+	})
+}
+
+// SetRevenueAccount sets the "revenue_account" field.
+func (u *InvoiceLineUpsertOne) SetRevenueAccount(v string) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetRevenueAccount(v)
+	})
+}
+
+// UpdateRevenueAccount sets the "revenue_account" field to the value that was provided on create.
+func (u *InvoiceLineUpsertOne) UpdateRevenueAccount() *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateRevenueAccount()
+	})
+}
+
+// ClearRevenueAccount clears the value of the "revenue_account" field.
+func (u *InvoiceLineUpsertOne) ClearRevenueAccount() *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.ClearRevenueAccount()
+	})
+}
+
+// SetReferenceType sets the "reference_type" field.
+func (u *InvoiceLineUpsertOne) SetReferenceType(v string) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetReferenceType(v)
+	})
+}
+
+// UpdateReferenceType sets the "reference_type" field to the value that was provided on create.
+func (u *InvoiceLineUpsertOne) UpdateReferenceType() *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateReferenceType()
+	})
+}
+
+// ClearReferenceType clears the value of the "reference_type" field.
+func (u *InvoiceLineUpsertOne) ClearReferenceType() *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.ClearReferenceType()
+	})
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (u *InvoiceLineUpsertOne) SetReferenceID(v uuid.UUID) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetReferenceID(v)
+	})
+}
+
+// UpdateReferenceID sets the "reference_id" field to the value that was provided on create.
+func (u *InvoiceLineUpsertOne) UpdateReferenceID() *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateReferenceID()
+	})
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (u *InvoiceLineUpsertOne) ClearReferenceID() *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.ClearReferenceID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *InvoiceLineUpsertOne) SetMetadata(v map[string]interface{}) *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *InvoiceLineUpsertOne) UpdateMetadata() *InvoiceLineUpsertOne {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceLineUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoiceLineCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceLineUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InvoiceLineUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: InvoiceLineUpsertOne.ID is not supported by MySQL driver. Use InvoiceLineUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InvoiceLineUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InvoiceLineCreateBulk is the builder for creating many InvoiceLine entities in bulk.
+type InvoiceLineCreateBulk struct {
+	config
+	err      error
+	builders []*InvoiceLineCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the InvoiceLine entities in the database.
+func (_c *InvoiceLineCreateBulk) Save(ctx context.Context) ([]*InvoiceLine, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InvoiceLine, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InvoiceLineCreateBulk) SaveX(ctx context.Context) []*InvoiceLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceLineCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvoiceLine.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceLineUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvoiceLineCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvoiceLineUpsertBulk {
+	_c.conflict = opts
+	return &InvoiceLineUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvoiceLine.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvoiceLineCreateBulk) OnConflictColumns(columns ...string) *InvoiceLineUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceLineUpsertBulk{
+		create: _c,
+	}
+}
+
+// InvoiceLineUpsertBulk is the builder for "upsert"-ing
+// a bulk of InvoiceLine nodes.
+type InvoiceLineUpsertBulk struct {
+	create *InvoiceLineCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.InvoiceLine.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invoiceline.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvoiceLineUpsertBulk) UpdateNewValues() *InvoiceLineUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(invoiceline.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(invoiceline.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvoiceLine.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InvoiceLineUpsertBulk) Ignore() *InvoiceLineUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceLineUpsertBulk) DoNothing() *InvoiceLineUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceLineCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvoiceLineUpsertBulk) Update(set func(*InvoiceLineUpsert)) *InvoiceLineUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceLineUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *InvoiceLineUpsertBulk) SetTenantID(v uuid.UUID) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *InvoiceLineUpsertBulk) UpdateTenantID() *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *InvoiceLineUpsertBulk) SetInvoiceID(v uuid.UUID) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetInvoiceID(v)
+	})
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *InvoiceLineUpsertBulk) UpdateInvoiceID() *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateInvoiceID()
+	})
+}
+
+// SetLineNumber sets the "line_number" field.
+func (u *InvoiceLineUpsertBulk) SetLineNumber(v int) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetLineNumber(v)
+	})
+}
+
+// AddLineNumber adds v to the "line_number" field.
+func (u *InvoiceLineUpsertBulk) AddLineNumber(v int) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.AddLineNumber(v)
+	})
+}
+
+// UpdateLineNumber sets the "line_number" field to the value that was provided on create.
+func (u *InvoiceLineUpsertBulk) UpdateLineNumber() *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateLineNumber()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *InvoiceLineUpsertBulk) SetDescription(v string) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *InvoiceLineUpsertBulk) UpdateDescription() *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *InvoiceLineUpsertBulk) SetQuantity(v decimal.Decimal) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *InvoiceLineUpsertBulk) AddQuantity(v decimal.Decimal) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *InvoiceLineUpsertBulk) UpdateQuantity() *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (u *InvoiceLineUpsertBulk) SetUnitPrice(v decimal.Decimal) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetUnitPrice(v)
+	})
+}
+
+// AddUnitPrice adds v to the "unit_price" field.
+func (u *InvoiceLineUpsertBulk) AddUnitPrice(v decimal.Decimal) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.AddUnitPrice(v)
+	})
+}
+
+// UpdateUnitPrice sets the "unit_price" field to the value that was provided on create.
+func (u *InvoiceLineUpsertBulk) UpdateUnitPrice() *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateUnitPrice()
+	})
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (u *InvoiceLineUpsertBulk) SetTaxRate(v decimal.Decimal) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetTaxRate(v)
+	})
+}
+
+// AddTaxRate adds v to the "tax_rate" field.
+func (u *InvoiceLineUpsertBulk) AddTaxRate(v decimal.Decimal) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.AddTaxRate(v)
+	})
+}
+
+// UpdateTaxRate sets the "tax_rate" field to the value that was provided on create.
+func (u *InvoiceLineUpsertBulk) UpdateTaxRate() *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateTaxRate()
+	})
+}
+
+// ClearTaxRate clears the value of the "tax_rate" field.
+func (u *InvoiceLineUpsertBulk) ClearTaxRate() *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.ClearTaxRate()
+	})
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *InvoiceLineUpsertBulk) SetTaxAmount(v decimal.Decimal) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetTaxAmount(v)
+	})
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *InvoiceLineUpsertBulk) AddTaxAmount(v decimal.Decimal) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.AddTaxAmount(v)
+	})
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *InvoiceLineUpsertBulk) UpdateTaxAmount() *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateTaxAmount()
+	})
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (u *InvoiceLineUpsertBulk) ClearTaxAmount() *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.ClearTaxAmount()
+	})
+}
+
+// SetLineTotal sets the "line_total" field.
+func (u *InvoiceLineUpsertBulk) SetLineTotal(v decimal.Decimal) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetLineTotal(v)
+	})
+}
+
+// AddLineTotal adds v to the "line_total" field.
+func (u *InvoiceLineUpsertBulk) AddLineTotal(v decimal.Decimal) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.AddLineTotal(v)
+	})
+}
+
+// UpdateLineTotal sets the "line_total" field to the value that was provided on create.
+func (u *InvoiceLineUpsertBulk) UpdateLineTotal() *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateLineTotal()
+	})
+}
+
+// SetRevenueAccount sets the "revenue_account" field.
+func (u *InvoiceLineUpsertBulk) SetRevenueAccount(v string) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetRevenueAccount(v)
+	})
+}
+
+// UpdateRevenueAccount sets the "revenue_account" field to the value that was provided on create.
+func (u *InvoiceLineUpsertBulk) UpdateRevenueAccount() *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateRevenueAccount()
+	})
+}
+
+// ClearRevenueAccount clears the value of the "revenue_account" field.
+func (u *InvoiceLineUpsertBulk) ClearRevenueAccount() *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.ClearRevenueAccount()
+	})
+}
+
+// SetReferenceType sets the "reference_type" field.
+func (u *InvoiceLineUpsertBulk) SetReferenceType(v string) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetReferenceType(v)
+	})
+}
+
+// UpdateReferenceType sets the "reference_type" field to the value that was provided on create.
+func (u *InvoiceLineUpsertBulk) UpdateReferenceType() *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateReferenceType()
+	})
+}
+
+// ClearReferenceType clears the value of the "reference_type" field.
+func (u *InvoiceLineUpsertBulk) ClearReferenceType() *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.ClearReferenceType()
+	})
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (u *InvoiceLineUpsertBulk) SetReferenceID(v uuid.UUID) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetReferenceID(v)
+	})
+}
+
+// UpdateReferenceID sets the "reference_id" field to the value that was provided on create.
+func (u *InvoiceLineUpsertBulk) UpdateReferenceID() *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateReferenceID()
+	})
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (u *InvoiceLineUpsertBulk) ClearReferenceID() *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.ClearReferenceID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *InvoiceLineUpsertBulk) SetMetadata(v map[string]interface{}) *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *InvoiceLineUpsertBulk) UpdateMetadata() *InvoiceLineUpsertBulk {
+	return u.Update(func(s *InvoiceLineUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceLineUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InvoiceLineCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoiceLineCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceLineUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
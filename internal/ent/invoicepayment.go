@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/invoice"
+	"github.com/bengobox/treasury-api/internal/ent/invoicepayment"
+	"github.com/bengobox/treasury-api/internal/ent/paymenttransaction"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// InvoicePayment is the model entity for the InvoicePayment schema.
+type InvoicePayment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Invoice the payment is applied to
+	InvoiceID uuid.UUID `json:"invoice_id,omitempty"`
+	// Payment transaction being applied
+	PaymentTransactionID uuid.UUID `json:"payment_transaction_id,omitempty"`
+	// Customer identifier copied from the invoice
+	CustomerID uuid.UUID `json:"customer_id,omitempty"`
+	// Amount applied to the invoice
+	AmountApplied decimal.Decimal `json:"amount_applied,omitempty"`
+	// ISO currency code
+	Currency string `json:"currency,omitempty"`
+	// Allocation method: auto, manual
+	AllocationMethod string `json:"allocation_method,omitempty"`
+	// User who allocated the payment (empty for system allocations)
+	AllocatedBy uuid.UUID `json:"allocated_by,omitempty"`
+	// Ledger journal posted for the allocation
+	JournalEntryID uuid.UUID `json:"journal_entry_id,omitempty"`
+	// Application timestamp
+	AppliedAt time.Time `json:"applied_at,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvoicePaymentQuery when eager-loading is set.
+	Edges        InvoicePaymentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// InvoicePaymentEdges holds the relations/edges for other nodes in the graph.
+type InvoicePaymentEdges struct {
+	// Invoice holds the value of the invoice edge.
+	Invoice *Invoice `json:"invoice,omitempty"`
+	// Payment holds the value of the payment edge.
+	Payment *PaymentTransaction `json:"payment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// InvoiceOrErr returns the Invoice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoicePaymentEdges) InvoiceOrErr() (*Invoice, error) {
+	if e.Invoice != nil {
+		return e.Invoice, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: invoice.Label}
+	}
+	return nil, &NotLoadedError{edge: "invoice"}
+}
+
+// PaymentOrErr returns the Payment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoicePaymentEdges) PaymentOrErr() (*PaymentTransaction, error) {
+	if e.Payment != nil {
+		return e.Payment, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: paymenttransaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "payment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InvoicePayment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoicepayment.FieldMetadata:
+			values[i] = new([]byte)
+		case invoicepayment.FieldAmountApplied:
+			values[i] = new(decimal.Decimal)
+		case invoicepayment.FieldCurrency, invoicepayment.FieldAllocationMethod:
+			values[i] = new(sql.NullString)
+		case invoicepayment.FieldAppliedAt, invoicepayment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case invoicepayment.FieldID, invoicepayment.FieldTenantID, invoicepayment.FieldInvoiceID, invoicepayment.FieldPaymentTransactionID, invoicepayment.FieldCustomerID, invoicepayment.FieldAllocatedBy, invoicepayment.FieldJournalEntryID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InvoicePayment fields.
+func (_m *InvoicePayment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case invoicepayment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case invoicepayment.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case invoicepayment.FieldInvoiceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value != nil {
+				_m.InvoiceID = *value
+			}
+		case invoicepayment.FieldPaymentTransactionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_transaction_id", values[i])
+			} else if value != nil {
+				_m.PaymentTransactionID = *value
+			}
+		case invoicepayment.FieldCustomerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value != nil {
+				_m.CustomerID = *value
+			}
+		case invoicepayment.FieldAmountApplied:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_applied", values[i])
+			} else if value != nil {
+				_m.AmountApplied = *value
+			}
+		case invoicepayment.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case invoicepayment.FieldAllocationMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field allocation_method", values[i])
+			} else if value.Valid {
+				_m.AllocationMethod = value.String
+			}
+		case invoicepayment.FieldAllocatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field allocated_by", values[i])
+			} else if value != nil {
+				_m.AllocatedBy = *value
+			}
+		case invoicepayment.FieldJournalEntryID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field journal_entry_id", values[i])
+			} else if value != nil {
+				_m.JournalEntryID = *value
+			}
+		case invoicepayment.FieldAppliedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field applied_at", values[i])
+			} else if value.Valid {
+				_m.AppliedAt = value.Time
+			}
+		case invoicepayment.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case invoicepayment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InvoicePayment.
+// This includes values selected through modifiers, order, etc.
+func (_m *InvoicePayment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryInvoice queries the "invoice" edge of the InvoicePayment entity.
+func (_m *InvoicePayment) QueryInvoice() *InvoiceQuery {
+	return NewInvoicePaymentClient(_m.config).QueryInvoice(_m)
+}
+
+// QueryPayment queries the "payment" edge of the InvoicePayment entity.
+func (_m *InvoicePayment) QueryPayment() *PaymentTransactionQuery {
+	return NewInvoicePaymentClient(_m.config).QueryPayment(_m)
+}
+
+// Update returns a builder for updating this InvoicePayment.
+// Note that you need to call InvoicePayment.Unwrap() before calling this method if this InvoicePayment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *InvoicePayment) Update() *InvoicePaymentUpdateOne {
+	return NewInvoicePaymentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the InvoicePayment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *InvoicePayment) Unwrap() *InvoicePayment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvoicePayment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *InvoicePayment) String() string {
+	var builder strings.Builder
+	builder.WriteString("InvoicePayment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("invoice_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InvoiceID))
+	builder.WriteString(", ")
+	builder.WriteString("payment_transaction_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaymentTransactionID))
+	builder.WriteString(", ")
+	builder.WriteString("customer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CustomerID))
+	builder.WriteString(", ")
+	builder.WriteString("amount_applied=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AmountApplied))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("allocation_method=")
+	builder.WriteString(_m.AllocationMethod)
+	builder.WriteString(", ")
+	builder.WriteString("allocated_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllocatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("journal_entry_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JournalEntryID))
+	builder.WriteString(", ")
+	builder.WriteString("applied_at=")
+	builder.WriteString(_m.AppliedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvoicePayments is a parsable slice of InvoicePayment.
+type InvoicePayments []*InvoicePayment
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoicepayment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the invoicepayment type in the database.
+	Label = "invoice_payment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldInvoiceID holds the string denoting the invoice_id field in the database.
+	FieldInvoiceID = "invoice_id"
+	// FieldPaymentTransactionID holds the string denoting the payment_transaction_id field in the database.
+	FieldPaymentTransactionID = "payment_transaction_id"
+	// FieldCustomerID holds the string denoting the customer_id field in the database.
+	FieldCustomerID = "customer_id"
+	// FieldAmountApplied holds the string denoting the amount_applied field in the database.
+	FieldAmountApplied = "amount_applied"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldAllocationMethod holds the string denoting the allocation_method field in the database.
+	FieldAllocationMethod = "allocation_method"
+	// FieldAllocatedBy holds the string denoting the allocated_by field in the database.
+	FieldAllocatedBy = "allocated_by"
+	// FieldJournalEntryID holds the string denoting the journal_entry_id field in the database.
+	FieldJournalEntryID = "journal_entry_id"
+	// FieldAppliedAt holds the string denoting the applied_at field in the database.
+	FieldAppliedAt = "applied_at"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeInvoice holds the string denoting the invoice edge name in mutations.
+	EdgeInvoice = "invoice"
+	// EdgePayment holds the string denoting the payment edge name in mutations.
+	EdgePayment = "payment"
+	// Table holds the table name of the invoicepayment in the database.
+	Table = "invoice_payments"
+	// InvoiceTable is the table that holds the invoice relation/edge.
+	InvoiceTable = "invoice_payments"
+	// InvoiceInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	InvoiceInverseTable = "invoices"
+	// InvoiceColumn is the table column denoting the invoice relation/edge.
+	InvoiceColumn = "invoice_id"
+	// PaymentTable is the table that holds the payment relation/edge.
+	PaymentTable = "invoice_payments"
+	// PaymentInverseTable is the table name for the PaymentTransaction entity.
+	// It exists in this package in order to avoid circular dependency with the "paymenttransaction" package.
+	PaymentInverseTable = "payment_transactions"
+	// PaymentColumn is the table column denoting the payment relation/edge.
+	PaymentColumn = "payment_transaction_id"
+)
+
+// Columns holds all SQL columns for invoicepayment fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldInvoiceID,
+	FieldPaymentTransactionID,
+	FieldCustomerID,
+	FieldAmountApplied,
+	FieldCurrency,
+	FieldAllocationMethod,
+	FieldAllocatedBy,
+	FieldJournalEntryID,
+	FieldAppliedAt,
+	FieldMetadata,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultAllocationMethod holds the default value on creation for the "allocation_method" field.
+	DefaultAllocationMethod string
+	// DefaultAppliedAt holds the default value on creation for the "applied_at" field.
+	DefaultAppliedAt func() time.Time
+	// DefaultMetadata holds the default value on creation for the "metadata" field.
+	DefaultMetadata map[string]interface{}
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the InvoicePayment queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByInvoiceID orders the results by the invoice_id field.
+func ByInvoiceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceID, opts...).ToFunc()
+}
+
+// ByPaymentTransactionID orders the results by the payment_transaction_id field.
+func ByPaymentTransactionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentTransactionID, opts...).ToFunc()
+}
+
+// ByCustomerID orders the results by the customer_id field.
+func ByCustomerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCustomerID, opts...).ToFunc()
+}
+
+// ByAmountApplied orders the results by the amount_applied field.
+func ByAmountApplied(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountApplied, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByAllocationMethod orders the results by the allocation_method field.
+func ByAllocationMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAllocationMethod, opts...).ToFunc()
+}
+
+// ByAllocatedBy orders the results by the allocated_by field.
+func ByAllocatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAllocatedBy, opts...).ToFunc()
+}
+
+// ByJournalEntryID orders the results by the journal_entry_id field.
+func ByJournalEntryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJournalEntryID, opts...).ToFunc()
+}
+
+// ByAppliedAt orders the results by the applied_at field.
+func ByAppliedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppliedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByInvoiceField orders the results by invoice field.
+func ByInvoiceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvoiceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPaymentField orders the results by payment field.
+func ByPaymentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newInvoiceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvoiceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, InvoiceTable, InvoiceColumn),
+	)
+}
+func newPaymentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PaymentTable, PaymentColumn),
+	)
+}
@@ -0,0 +1,654 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoicepayment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldTenantID, v))
+}
+
+// InvoiceID applies equality check predicate on the "invoice_id" field. It's identical to InvoiceIDEQ.
+func InvoiceID(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// PaymentTransactionID applies equality check predicate on the "payment_transaction_id" field. It's identical to PaymentTransactionIDEQ.
+func PaymentTransactionID(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldPaymentTransactionID, v))
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// AmountApplied applies equality check predicate on the "amount_applied" field. It's identical to AmountAppliedEQ.
+func AmountApplied(v decimal.Decimal) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldAmountApplied, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldCurrency, v))
+}
+
+// AllocationMethod applies equality check predicate on the "allocation_method" field. It's identical to AllocationMethodEQ.
+func AllocationMethod(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldAllocationMethod, v))
+}
+
+// AllocatedBy applies equality check predicate on the "allocated_by" field. It's identical to AllocatedByEQ.
+func AllocatedBy(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldAllocatedBy, v))
+}
+
+// JournalEntryID applies equality check predicate on the "journal_entry_id" field. It's identical to JournalEntryIDEQ.
+func JournalEntryID(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// AppliedAt applies equality check predicate on the "applied_at" field. It's identical to AppliedAtEQ.
+func AppliedAt(v time.Time) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldAppliedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLTE(FieldTenantID, v))
+}
+
+// InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
+func InvoiceIDEQ(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDNEQ applies the NEQ predicate on the "invoice_id" field.
+func InvoiceIDNEQ(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDIn applies the In predicate on the "invoice_id" field.
+func InvoiceIDIn(vs ...uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDNotIn applies the NotIn predicate on the "invoice_id" field.
+func InvoiceIDNotIn(vs ...uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNotIn(FieldInvoiceID, vs...))
+}
+
+// PaymentTransactionIDEQ applies the EQ predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDEQ(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldPaymentTransactionID, v))
+}
+
+// PaymentTransactionIDNEQ applies the NEQ predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDNEQ(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNEQ(FieldPaymentTransactionID, v))
+}
+
+// PaymentTransactionIDIn applies the In predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDIn(vs ...uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldIn(FieldPaymentTransactionID, vs...))
+}
+
+// PaymentTransactionIDNotIn applies the NotIn predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDNotIn(vs ...uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNotIn(FieldPaymentTransactionID, vs...))
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNEQ(FieldCustomerID, v))
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNotIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDGT applies the GT predicate on the "customer_id" field.
+func CustomerIDGT(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGT(FieldCustomerID, v))
+}
+
+// CustomerIDGTE applies the GTE predicate on the "customer_id" field.
+func CustomerIDGTE(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGTE(FieldCustomerID, v))
+}
+
+// CustomerIDLT applies the LT predicate on the "customer_id" field.
+func CustomerIDLT(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLT(FieldCustomerID, v))
+}
+
+// CustomerIDLTE applies the LTE predicate on the "customer_id" field.
+func CustomerIDLTE(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLTE(FieldCustomerID, v))
+}
+
+// CustomerIDIsNil applies the IsNil predicate on the "customer_id" field.
+func CustomerIDIsNil() predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldIsNull(FieldCustomerID))
+}
+
+// CustomerIDNotNil applies the NotNil predicate on the "customer_id" field.
+func CustomerIDNotNil() predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNotNull(FieldCustomerID))
+}
+
+// AmountAppliedEQ applies the EQ predicate on the "amount_applied" field.
+func AmountAppliedEQ(v decimal.Decimal) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldAmountApplied, v))
+}
+
+// AmountAppliedNEQ applies the NEQ predicate on the "amount_applied" field.
+func AmountAppliedNEQ(v decimal.Decimal) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNEQ(FieldAmountApplied, v))
+}
+
+// AmountAppliedIn applies the In predicate on the "amount_applied" field.
+func AmountAppliedIn(vs ...decimal.Decimal) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldIn(FieldAmountApplied, vs...))
+}
+
+// AmountAppliedNotIn applies the NotIn predicate on the "amount_applied" field.
+func AmountAppliedNotIn(vs ...decimal.Decimal) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNotIn(FieldAmountApplied, vs...))
+}
+
+// AmountAppliedGT applies the GT predicate on the "amount_applied" field.
+func AmountAppliedGT(v decimal.Decimal) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGT(FieldAmountApplied, v))
+}
+
+// AmountAppliedGTE applies the GTE predicate on the "amount_applied" field.
+func AmountAppliedGTE(v decimal.Decimal) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGTE(FieldAmountApplied, v))
+}
+
+// AmountAppliedLT applies the LT predicate on the "amount_applied" field.
+func AmountAppliedLT(v decimal.Decimal) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLT(FieldAmountApplied, v))
+}
+
+// AmountAppliedLTE applies the LTE predicate on the "amount_applied" field.
+func AmountAppliedLTE(v decimal.Decimal) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLTE(FieldAmountApplied, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// AllocationMethodEQ applies the EQ predicate on the "allocation_method" field.
+func AllocationMethodEQ(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldAllocationMethod, v))
+}
+
+// AllocationMethodNEQ applies the NEQ predicate on the "allocation_method" field.
+func AllocationMethodNEQ(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNEQ(FieldAllocationMethod, v))
+}
+
+// AllocationMethodIn applies the In predicate on the "allocation_method" field.
+func AllocationMethodIn(vs ...string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldIn(FieldAllocationMethod, vs...))
+}
+
+// AllocationMethodNotIn applies the NotIn predicate on the "allocation_method" field.
+func AllocationMethodNotIn(vs ...string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNotIn(FieldAllocationMethod, vs...))
+}
+
+// AllocationMethodGT applies the GT predicate on the "allocation_method" field.
+func AllocationMethodGT(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGT(FieldAllocationMethod, v))
+}
+
+// AllocationMethodGTE applies the GTE predicate on the "allocation_method" field.
+func AllocationMethodGTE(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGTE(FieldAllocationMethod, v))
+}
+
+// AllocationMethodLT applies the LT predicate on the "allocation_method" field.
+func AllocationMethodLT(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLT(FieldAllocationMethod, v))
+}
+
+// AllocationMethodLTE applies the LTE predicate on the "allocation_method" field.
+func AllocationMethodLTE(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLTE(FieldAllocationMethod, v))
+}
+
+// AllocationMethodContains applies the Contains predicate on the "allocation_method" field.
+func AllocationMethodContains(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldContains(FieldAllocationMethod, v))
+}
+
+// AllocationMethodHasPrefix applies the HasPrefix predicate on the "allocation_method" field.
+func AllocationMethodHasPrefix(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldHasPrefix(FieldAllocationMethod, v))
+}
+
+// AllocationMethodHasSuffix applies the HasSuffix predicate on the "allocation_method" field.
+func AllocationMethodHasSuffix(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldHasSuffix(FieldAllocationMethod, v))
+}
+
+// AllocationMethodEqualFold applies the EqualFold predicate on the "allocation_method" field.
+func AllocationMethodEqualFold(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEqualFold(FieldAllocationMethod, v))
+}
+
+// AllocationMethodContainsFold applies the ContainsFold predicate on the "allocation_method" field.
+func AllocationMethodContainsFold(v string) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldContainsFold(FieldAllocationMethod, v))
+}
+
+// AllocatedByEQ applies the EQ predicate on the "allocated_by" field.
+func AllocatedByEQ(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldAllocatedBy, v))
+}
+
+// AllocatedByNEQ applies the NEQ predicate on the "allocated_by" field.
+func AllocatedByNEQ(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNEQ(FieldAllocatedBy, v))
+}
+
+// AllocatedByIn applies the In predicate on the "allocated_by" field.
+func AllocatedByIn(vs ...uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldIn(FieldAllocatedBy, vs...))
+}
+
+// AllocatedByNotIn applies the NotIn predicate on the "allocated_by" field.
+func AllocatedByNotIn(vs ...uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNotIn(FieldAllocatedBy, vs...))
+}
+
+// AllocatedByGT applies the GT predicate on the "allocated_by" field.
+func AllocatedByGT(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGT(FieldAllocatedBy, v))
+}
+
+// AllocatedByGTE applies the GTE predicate on the "allocated_by" field.
+func AllocatedByGTE(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGTE(FieldAllocatedBy, v))
+}
+
+// AllocatedByLT applies the LT predicate on the "allocated_by" field.
+func AllocatedByLT(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLT(FieldAllocatedBy, v))
+}
+
+// AllocatedByLTE applies the LTE predicate on the "allocated_by" field.
+func AllocatedByLTE(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLTE(FieldAllocatedBy, v))
+}
+
+// AllocatedByIsNil applies the IsNil predicate on the "allocated_by" field.
+func AllocatedByIsNil() predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldIsNull(FieldAllocatedBy))
+}
+
+// AllocatedByNotNil applies the NotNil predicate on the "allocated_by" field.
+func AllocatedByNotNil() predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNotNull(FieldAllocatedBy))
+}
+
+// JournalEntryIDEQ applies the EQ predicate on the "journal_entry_id" field.
+func JournalEntryIDEQ(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDNEQ applies the NEQ predicate on the "journal_entry_id" field.
+func JournalEntryIDNEQ(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIn applies the In predicate on the "journal_entry_id" field.
+func JournalEntryIDIn(vs ...uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDNotIn applies the NotIn predicate on the "journal_entry_id" field.
+func JournalEntryIDNotIn(vs ...uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNotIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDGT applies the GT predicate on the "journal_entry_id" field.
+func JournalEntryIDGT(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGT(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDGTE applies the GTE predicate on the "journal_entry_id" field.
+func JournalEntryIDGTE(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGTE(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDLT applies the LT predicate on the "journal_entry_id" field.
+func JournalEntryIDLT(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLT(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDLTE applies the LTE predicate on the "journal_entry_id" field.
+func JournalEntryIDLTE(v uuid.UUID) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLTE(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIsNil applies the IsNil predicate on the "journal_entry_id" field.
+func JournalEntryIDIsNil() predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldIsNull(FieldJournalEntryID))
+}
+
+// JournalEntryIDNotNil applies the NotNil predicate on the "journal_entry_id" field.
+func JournalEntryIDNotNil() predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNotNull(FieldJournalEntryID))
+}
+
+// AppliedAtEQ applies the EQ predicate on the "applied_at" field.
+func AppliedAtEQ(v time.Time) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldAppliedAt, v))
+}
+
+// AppliedAtNEQ applies the NEQ predicate on the "applied_at" field.
+func AppliedAtNEQ(v time.Time) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNEQ(FieldAppliedAt, v))
+}
+
+// AppliedAtIn applies the In predicate on the "applied_at" field.
+func AppliedAtIn(vs ...time.Time) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldIn(FieldAppliedAt, vs...))
+}
+
+// AppliedAtNotIn applies the NotIn predicate on the "applied_at" field.
+func AppliedAtNotIn(vs ...time.Time) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNotIn(FieldAppliedAt, vs...))
+}
+
+// AppliedAtGT applies the GT predicate on the "applied_at" field.
+func AppliedAtGT(v time.Time) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGT(FieldAppliedAt, v))
+}
+
+// AppliedAtGTE applies the GTE predicate on the "applied_at" field.
+func AppliedAtGTE(v time.Time) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGTE(FieldAppliedAt, v))
+}
+
+// AppliedAtLT applies the LT predicate on the "applied_at" field.
+func AppliedAtLT(v time.Time) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLT(FieldAppliedAt, v))
+}
+
+// AppliedAtLTE applies the LTE predicate on the "applied_at" field.
+func AppliedAtLTE(v time.Time) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLTE(FieldAppliedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasInvoice applies the HasEdge predicate on the "invoice" edge.
+func HasInvoice() predicate.InvoicePayment {
+	return predicate.InvoicePayment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, InvoiceTable, InvoiceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoiceWith applies the HasEdge predicate on the "invoice" edge with a given conditions (other predicates).
+func HasInvoiceWith(preds ...predicate.Invoice) predicate.InvoicePayment {
+	return predicate.InvoicePayment(func(s *sql.Selector) {
+		step := newInvoiceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPayment applies the HasEdge predicate on the "payment" edge.
+func HasPayment() predicate.InvoicePayment {
+	return predicate.InvoicePayment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PaymentTable, PaymentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentWith applies the HasEdge predicate on the "payment" edge with a given conditions (other predicates).
+func HasPaymentWith(preds ...predicate.PaymentTransaction) predicate.InvoicePayment {
+	return predicate.InvoicePayment(func(s *sql.Selector) {
+		step := newPaymentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InvoicePayment) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InvoicePayment) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InvoicePayment) predicate.InvoicePayment {
+	return predicate.InvoicePayment(sql.NotPredicates(p))
+}
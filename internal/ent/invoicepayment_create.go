@@ -0,0 +1,1256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/invoice"
+	"github.com/bengobox/treasury-api/internal/ent/invoicepayment"
+	"github.com/bengobox/treasury-api/internal/ent/paymenttransaction"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// InvoicePaymentCreate is the builder for creating a InvoicePayment entity.
+type InvoicePaymentCreate struct {
+	config
+	mutation *InvoicePaymentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *InvoicePaymentCreate) SetTenantID(v uuid.UUID) *InvoicePaymentCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_c *InvoicePaymentCreate) SetInvoiceID(v uuid.UUID) *InvoicePaymentCreate {
+	_c.mutation.SetInvoiceID(v)
+	return _c
+}
+
+// SetPaymentTransactionID sets the "payment_transaction_id" field.
+func (_c *InvoicePaymentCreate) SetPaymentTransactionID(v uuid.UUID) *InvoicePaymentCreate {
+	_c.mutation.SetPaymentTransactionID(v)
+	return _c
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_c *InvoicePaymentCreate) SetCustomerID(v uuid.UUID) *InvoicePaymentCreate {
+	_c.mutation.SetCustomerID(v)
+	return _c
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_c *InvoicePaymentCreate) SetNillableCustomerID(v *uuid.UUID) *InvoicePaymentCreate {
+	if v != nil {
+		_c.SetCustomerID(*v)
+	}
+	return _c
+}
+
+// SetAmountApplied sets the "amount_applied" field.
+func (_c *InvoicePaymentCreate) SetAmountApplied(v decimal.Decimal) *InvoicePaymentCreate {
+	_c.mutation.SetAmountApplied(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *InvoicePaymentCreate) SetCurrency(v string) *InvoicePaymentCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *InvoicePaymentCreate) SetNillableCurrency(v *string) *InvoicePaymentCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetAllocationMethod sets the "allocation_method" field.
+func (_c *InvoicePaymentCreate) SetAllocationMethod(v string) *InvoicePaymentCreate {
+	_c.mutation.SetAllocationMethod(v)
+	return _c
+}
+
+// SetNillableAllocationMethod sets the "allocation_method" field if the given value is not nil.
+func (_c *InvoicePaymentCreate) SetNillableAllocationMethod(v *string) *InvoicePaymentCreate {
+	if v != nil {
+		_c.SetAllocationMethod(*v)
+	}
+	return _c
+}
+
+// SetAllocatedBy sets the "allocated_by" field.
+func (_c *InvoicePaymentCreate) SetAllocatedBy(v uuid.UUID) *InvoicePaymentCreate {
+	_c.mutation.SetAllocatedBy(v)
+	return _c
+}
+
+// SetNillableAllocatedBy sets the "allocated_by" field if the given value is not nil.
+func (_c *InvoicePaymentCreate) SetNillableAllocatedBy(v *uuid.UUID) *InvoicePaymentCreate {
+	if v != nil {
+		_c.SetAllocatedBy(*v)
+	}
+	return _c
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_c *InvoicePaymentCreate) SetJournalEntryID(v uuid.UUID) *InvoicePaymentCreate {
+	_c.mutation.SetJournalEntryID(v)
+	return _c
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_c *InvoicePaymentCreate) SetNillableJournalEntryID(v *uuid.UUID) *InvoicePaymentCreate {
+	if v != nil {
+		_c.SetJournalEntryID(*v)
+	}
+	return _c
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (_c *InvoicePaymentCreate) SetAppliedAt(v time.Time) *InvoicePaymentCreate {
+	_c.mutation.SetAppliedAt(v)
+	return _c
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (_c *InvoicePaymentCreate) SetNillableAppliedAt(v *time.Time) *InvoicePaymentCreate {
+	if v != nil {
+		_c.SetAppliedAt(*v)
+	}
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *InvoicePaymentCreate) SetMetadata(v map[string]interface{}) *InvoicePaymentCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InvoicePaymentCreate) SetCreatedAt(v time.Time) *InvoicePaymentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InvoicePaymentCreate) SetNillableCreatedAt(v *time.Time) *InvoicePaymentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InvoicePaymentCreate) SetID(v uuid.UUID) *InvoicePaymentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *InvoicePaymentCreate) SetNillableID(v *uuid.UUID) *InvoicePaymentCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_c *InvoicePaymentCreate) SetInvoice(v *Invoice) *InvoicePaymentCreate {
+	return _c.SetInvoiceID(v.ID)
+}
+
+// SetPaymentID sets the "payment" edge to the PaymentTransaction entity by ID.
+func (_c *InvoicePaymentCreate) SetPaymentID(id uuid.UUID) *InvoicePaymentCreate {
+	_c.mutation.SetPaymentID(id)
+	return _c
+}
+
+// SetPayment sets the "payment" edge to the PaymentTransaction entity.
+func (_c *InvoicePaymentCreate) SetPayment(v *PaymentTransaction) *InvoicePaymentCreate {
+	return _c.SetPaymentID(v.ID)
+}
+
+// Mutation returns the InvoicePaymentMutation object of the builder.
+func (_c *InvoicePaymentCreate) Mutation() *InvoicePaymentMutation {
+	return _c.mutation
+}
+
+// Save creates the InvoicePayment in the database.
+func (_c *InvoicePaymentCreate) Save(ctx context.Context) (*InvoicePayment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InvoicePaymentCreate) SaveX(ctx context.Context) *InvoicePayment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoicePaymentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoicePaymentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InvoicePaymentCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := invoicepayment.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.AllocationMethod(); !ok {
+		v := invoicepayment.DefaultAllocationMethod
+		_c.mutation.SetAllocationMethod(v)
+	}
+	if _, ok := _c.mutation.AppliedAt(); !ok {
+		v := invoicepayment.DefaultAppliedAt()
+		_c.mutation.SetAppliedAt(v)
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		v := invoicepayment.DefaultMetadata
+		_c.mutation.SetMetadata(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := invoicepayment.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := invoicepayment.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InvoicePaymentCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "InvoicePayment.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.InvoiceID(); !ok {
+		return &ValidationError{Name: "invoice_id", err: errors.New(`ent: missing required field "InvoicePayment.invoice_id"`)}
+	}
+	if _, ok := _c.mutation.PaymentTransactionID(); !ok {
+		return &ValidationError{Name: "payment_transaction_id", err: errors.New(`ent: missing required field "InvoicePayment.payment_transaction_id"`)}
+	}
+	if _, ok := _c.mutation.AmountApplied(); !ok {
+		return &ValidationError{Name: "amount_applied", err: errors.New(`ent: missing required field "InvoicePayment.amount_applied"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "InvoicePayment.currency"`)}
+	}
+	if _, ok := _c.mutation.AllocationMethod(); !ok {
+		return &ValidationError{Name: "allocation_method", err: errors.New(`ent: missing required field "InvoicePayment.allocation_method"`)}
+	}
+	if _, ok := _c.mutation.AppliedAt(); !ok {
+		return &ValidationError{Name: "applied_at", err: errors.New(`ent: missing required field "InvoicePayment.applied_at"`)}
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		return &ValidationError{Name: "metadata", err: errors.New(`ent: missing required field "InvoicePayment.metadata"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InvoicePayment.created_at"`)}
+	}
+	if len(_c.mutation.InvoiceIDs()) == 0 {
+		return &ValidationError{Name: "invoice", err: errors.New(`ent: missing required edge "InvoicePayment.invoice"`)}
+	}
+	if len(_c.mutation.PaymentIDs()) == 0 {
+		return &ValidationError{Name: "payment", err: errors.New(`ent: missing required edge "InvoicePayment.payment"`)}
+	}
+	return nil
+}
+
+func (_c *InvoicePaymentCreate) sqlSave(ctx context.Context) (*InvoicePayment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InvoicePaymentCreate) createSpec() (*InvoicePayment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvoicePayment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(invoicepayment.Table, sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(invoicepayment.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CustomerID(); ok {
+		_spec.SetField(invoicepayment.FieldCustomerID, field.TypeUUID, value)
+		_node.CustomerID = value
+	}
+	if value, ok := _c.mutation.AmountApplied(); ok {
+		_spec.SetField(invoicepayment.FieldAmountApplied, field.TypeFloat64, value)
+		_node.AmountApplied = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(invoicepayment.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.AllocationMethod(); ok {
+		_spec.SetField(invoicepayment.FieldAllocationMethod, field.TypeString, value)
+		_node.AllocationMethod = value
+	}
+	if value, ok := _c.mutation.AllocatedBy(); ok {
+		_spec.SetField(invoicepayment.FieldAllocatedBy, field.TypeUUID, value)
+		_node.AllocatedBy = value
+	}
+	if value, ok := _c.mutation.JournalEntryID(); ok {
+		_spec.SetField(invoicepayment.FieldJournalEntryID, field.TypeUUID, value)
+		_node.JournalEntryID = value
+	}
+	if value, ok := _c.mutation.AppliedAt(); ok {
+		_spec.SetField(invoicepayment.FieldAppliedAt, field.TypeTime, value)
+		_node.AppliedAt = value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(invoicepayment.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(invoicepayment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.InvoiceTable,
+			Columns: []string{invoicepayment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InvoiceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.PaymentTable,
+			Columns: []string{invoicepayment.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymenttransaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PaymentTransactionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvoicePayment.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoicePaymentUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvoicePaymentCreate) OnConflict(opts ...sql.ConflictOption) *InvoicePaymentUpsertOne {
+	_c.conflict = opts
+	return &InvoicePaymentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvoicePayment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvoicePaymentCreate) OnConflictColumns(columns ...string) *InvoicePaymentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvoicePaymentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// InvoicePaymentUpsertOne is the builder for "upsert"-ing
+	//  one InvoicePayment node.
+	InvoicePaymentUpsertOne struct {
+		create *InvoicePaymentCreate
+	}
+
+	// InvoicePaymentUpsert is the "OnConflict" setter.
+	InvoicePaymentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *InvoicePaymentUpsert) SetTenantID(v uuid.UUID) *InvoicePaymentUpsert {
+	u.Set(invoicepayment.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *InvoicePaymentUpsert) UpdateTenantID() *InvoicePaymentUpsert {
+	u.SetExcluded(invoicepayment.FieldTenantID)
+	return u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *InvoicePaymentUpsert) SetInvoiceID(v uuid.UUID) *InvoicePaymentUpsert {
+	u.Set(invoicepayment.FieldInvoiceID, v)
+	return u
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *InvoicePaymentUpsert) UpdateInvoiceID() *InvoicePaymentUpsert {
+	u.SetExcluded(invoicepayment.FieldInvoiceID)
+	return u
+}
+
+// SetPaymentTransactionID sets the "payment_transaction_id" field.
+func (u *InvoicePaymentUpsert) SetPaymentTransactionID(v uuid.UUID) *InvoicePaymentUpsert {
+	u.Set(invoicepayment.FieldPaymentTransactionID, v)
+	return u
+}
+
+// UpdatePaymentTransactionID sets the "payment_transaction_id" field to the value that was provided on create.
+func (u *InvoicePaymentUpsert) UpdatePaymentTransactionID() *InvoicePaymentUpsert {
+	u.SetExcluded(invoicepayment.FieldPaymentTransactionID)
+	return u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *InvoicePaymentUpsert) SetCustomerID(v uuid.UUID) *InvoicePaymentUpsert {
+	u.Set(invoicepayment.FieldCustomerID, v)
+	return u
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *InvoicePaymentUpsert) UpdateCustomerID() *InvoicePaymentUpsert {
+	u.SetExcluded(invoicepayment.FieldCustomerID)
+	return u
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (u *InvoicePaymentUpsert) ClearCustomerID() *InvoicePaymentUpsert {
+	u.SetNull(invoicepayment.FieldCustomerID)
+	return u
+}
+
+// SetAmountApplied sets the "amount_applied" field.
+func (u *InvoicePaymentUpsert) SetAmountApplied(v decimal.Decimal) *InvoicePaymentUpsert {
+	u.Set(invoicepayment.FieldAmountApplied, v)
+	return u
+}
+
+// UpdateAmountApplied sets the "amount_applied" field to the value that was provided on create.
+func (u *InvoicePaymentUpsert) UpdateAmountApplied() *InvoicePaymentUpsert {
+	u.SetExcluded(invoicepayment.FieldAmountApplied)
+	return u
+}
+
+// AddAmountApplied adds v to the "amount_applied" field.
+func (u *InvoicePaymentUpsert) AddAmountApplied(v decimal.Decimal) *InvoicePaymentUpsert {
+	u.Add(invoicepayment.FieldAmountApplied, v)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *InvoicePaymentUpsert) SetCurrency(v string) *InvoicePaymentUpsert {
+	u.Set(invoicepayment.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *InvoicePaymentUpsert) UpdateCurrency() *InvoicePaymentUpsert {
+	u.SetExcluded(invoicepayment.FieldCurrency)
+	return u
+}
+
+// SetAllocationMethod sets the "allocation_method" field.
+func (u *InvoicePaymentUpsert) SetAllocationMethod(v string) *InvoicePaymentUpsert {
+	u.Set(invoicepayment.FieldAllocationMethod, v)
+	return u
+}
+
+// UpdateAllocationMethod sets the "allocation_method" field to the value that was provided on create.
+func (u *InvoicePaymentUpsert) UpdateAllocationMethod() *InvoicePaymentUpsert {
+	u.SetExcluded(invoicepayment.FieldAllocationMethod)
+	return u
+}
+
+// SetAllocatedBy sets the "allocated_by" field.
+func (u *InvoicePaymentUpsert) SetAllocatedBy(v uuid.UUID) *InvoicePaymentUpsert {
+	u.Set(invoicepayment.FieldAllocatedBy, v)
+	return u
+}
+
+// UpdateAllocatedBy sets the "allocated_by" field to the value that was provided on create.
+func (u *InvoicePaymentUpsert) UpdateAllocatedBy() *InvoicePaymentUpsert {
+	u.SetExcluded(invoicepayment.FieldAllocatedBy)
+	return u
+}
+
+// ClearAllocatedBy clears the value of the "allocated_by" field.
+func (u *InvoicePaymentUpsert) ClearAllocatedBy() *InvoicePaymentUpsert {
+	u.SetNull(invoicepayment.FieldAllocatedBy)
+	return u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *InvoicePaymentUpsert) SetJournalEntryID(v uuid.UUID) *InvoicePaymentUpsert {
+	u.Set(invoicepayment.FieldJournalEntryID, v)
+	return u
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *InvoicePaymentUpsert) UpdateJournalEntryID() *InvoicePaymentUpsert {
+	u.SetExcluded(invoicepayment.FieldJournalEntryID)
+	return u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *InvoicePaymentUpsert) ClearJournalEntryID() *InvoicePaymentUpsert {
+	u.SetNull(invoicepayment.FieldJournalEntryID)
+	return u
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (u *InvoicePaymentUpsert) SetAppliedAt(v time.Time) *InvoicePaymentUpsert {
+	u.Set(invoicepayment.FieldAppliedAt, v)
+	return u
+}
+
+// UpdateAppliedAt sets the "applied_at" field to the value that was provided on create.
+func (u *InvoicePaymentUpsert) UpdateAppliedAt() *InvoicePaymentUpsert {
+	u.SetExcluded(invoicepayment.FieldAppliedAt)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *InvoicePaymentUpsert) SetMetadata(v map[string]interface{}) *InvoicePaymentUpsert {
+	u.Set(invoicepayment.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *InvoicePaymentUpsert) UpdateMetadata() *InvoicePaymentUpsert {
+	u.SetExcluded(invoicepayment.FieldMetadata)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.InvoicePayment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invoicepayment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvoicePaymentUpsertOne) UpdateNewValues() *InvoicePaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(invoicepayment.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(invoicepayment.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvoicePayment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InvoicePaymentUpsertOne) Ignore() *InvoicePaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoicePaymentUpsertOne) DoNothing() *InvoicePaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoicePaymentCreate.OnConflict
+// documentation for more info.
+func (u *InvoicePaymentUpsertOne) Update(set func(*InvoicePaymentUpsert)) *InvoicePaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoicePaymentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *InvoicePaymentUpsertOne) SetTenantID(v uuid.UUID) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertOne) UpdateTenantID() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *InvoicePaymentUpsertOne) SetInvoiceID(v uuid.UUID) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetInvoiceID(v)
+	})
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertOne) UpdateInvoiceID() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateInvoiceID()
+	})
+}
+
+// SetPaymentTransactionID sets the "payment_transaction_id" field.
+func (u *InvoicePaymentUpsertOne) SetPaymentTransactionID(v uuid.UUID) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetPaymentTransactionID(v)
+	})
+}
+
+// UpdatePaymentTransactionID sets the "payment_transaction_id" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertOne) UpdatePaymentTransactionID() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdatePaymentTransactionID()
+	})
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *InvoicePaymentUpsertOne) SetCustomerID(v uuid.UUID) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetCustomerID(v)
+	})
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertOne) UpdateCustomerID() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateCustomerID()
+	})
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (u *InvoicePaymentUpsertOne) ClearCustomerID() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.ClearCustomerID()
+	})
+}
+
+// SetAmountApplied sets the "amount_applied" field.
+func (u *InvoicePaymentUpsertOne) SetAmountApplied(v decimal.Decimal) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetAmountApplied(v)
+	})
+}
+
+// AddAmountApplied adds v to the "amount_applied" field.
+func (u *InvoicePaymentUpsertOne) AddAmountApplied(v decimal.Decimal) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.AddAmountApplied(v)
+	})
+}
+
+// UpdateAmountApplied sets the "amount_applied" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertOne) UpdateAmountApplied() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateAmountApplied()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *InvoicePaymentUpsertOne) SetCurrency(v string) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertOne) UpdateCurrency() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetAllocationMethod sets the "allocation_method" field.
+func (u *InvoicePaymentUpsertOne) SetAllocationMethod(v string) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetAllocationMethod(v)
+	})
+}
+
+// UpdateAllocationMethod sets the "allocation_method" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertOne) UpdateAllocationMethod() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateAllocationMethod()
+	})
+}
+
+// SetAllocatedBy sets the "allocated_by" field.
+func (u *InvoicePaymentUpsertOne) SetAllocatedBy(v uuid.UUID) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetAllocatedBy(v)
+	})
+}
+
+// UpdateAllocatedBy sets the "allocated_by" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertOne) UpdateAllocatedBy() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateAllocatedBy()
+	})
+}
+
+// ClearAllocatedBy clears the value of the "allocated_by" field.
+func (u *InvoicePaymentUpsertOne) ClearAllocatedBy() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.ClearAllocatedBy()
+	})
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *InvoicePaymentUpsertOne) SetJournalEntryID(v uuid.UUID) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetJournalEntryID(v)
+	})
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertOne) UpdateJournalEntryID() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateJournalEntryID()
+	})
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *InvoicePaymentUpsertOne) ClearJournalEntryID() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.ClearJournalEntryID()
+	})
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (u *InvoicePaymentUpsertOne) SetAppliedAt(v time.Time) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetAppliedAt(v)
+	})
+}
+
+// UpdateAppliedAt sets the "applied_at" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertOne) UpdateAppliedAt() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateAppliedAt()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *InvoicePaymentUpsertOne) SetMetadata(v map[string]interface{}) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertOne) UpdateMetadata() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoicePaymentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoicePaymentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoicePaymentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InvoicePaymentUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: InvoicePaymentUpsertOne.ID is not supported by MySQL driver. Use InvoicePaymentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InvoicePaymentUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InvoicePaymentCreateBulk is the builder for creating many InvoicePayment entities in bulk.
+type InvoicePaymentCreateBulk struct {
+	config
+	err      error
+	builders []*InvoicePaymentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the InvoicePayment entities in the database.
+func (_c *InvoicePaymentCreateBulk) Save(ctx context.Context) ([]*InvoicePayment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InvoicePayment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoicePaymentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InvoicePaymentCreateBulk) SaveX(ctx context.Context) []*InvoicePayment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoicePaymentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoicePaymentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvoicePayment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoicePaymentUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvoicePaymentCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvoicePaymentUpsertBulk {
+	_c.conflict = opts
+	return &InvoicePaymentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvoicePayment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvoicePaymentCreateBulk) OnConflictColumns(columns ...string) *InvoicePaymentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvoicePaymentUpsertBulk{
+		create: _c,
+	}
+}
+
+// InvoicePaymentUpsertBulk is the builder for "upsert"-ing
+// a bulk of InvoicePayment nodes.
+type InvoicePaymentUpsertBulk struct {
+	create *InvoicePaymentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.InvoicePayment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invoicepayment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvoicePaymentUpsertBulk) UpdateNewValues() *InvoicePaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(invoicepayment.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(invoicepayment.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvoicePayment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InvoicePaymentUpsertBulk) Ignore() *InvoicePaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoicePaymentUpsertBulk) DoNothing() *InvoicePaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoicePaymentCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvoicePaymentUpsertBulk) Update(set func(*InvoicePaymentUpsert)) *InvoicePaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoicePaymentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *InvoicePaymentUpsertBulk) SetTenantID(v uuid.UUID) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertBulk) UpdateTenantID() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *InvoicePaymentUpsertBulk) SetInvoiceID(v uuid.UUID) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetInvoiceID(v)
+	})
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertBulk) UpdateInvoiceID() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateInvoiceID()
+	})
+}
+
+// SetPaymentTransactionID sets the "payment_transaction_id" field.
+func (u *InvoicePaymentUpsertBulk) SetPaymentTransactionID(v uuid.UUID) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetPaymentTransactionID(v)
+	})
+}
+
+// UpdatePaymentTransactionID sets the "payment_transaction_id" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertBulk) UpdatePaymentTransactionID() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdatePaymentTransactionID()
+	})
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *InvoicePaymentUpsertBulk) SetCustomerID(v uuid.UUID) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetCustomerID(v)
+	})
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertBulk) UpdateCustomerID() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateCustomerID()
+	})
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (u *InvoicePaymentUpsertBulk) ClearCustomerID() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.ClearCustomerID()
+	})
+}
+
+// SetAmountApplied sets the "amount_applied" field.
+func (u *InvoicePaymentUpsertBulk) SetAmountApplied(v decimal.Decimal) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetAmountApplied(v)
+	})
+}
+
+// AddAmountApplied adds v to the "amount_applied" field.
+func (u *InvoicePaymentUpsertBulk) AddAmountApplied(v decimal.Decimal) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.AddAmountApplied(v)
+	})
+}
+
+// UpdateAmountApplied sets the "amount_applied" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertBulk) UpdateAmountApplied() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateAmountApplied()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *InvoicePaymentUpsertBulk) SetCurrency(v string) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertBulk) UpdateCurrency() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetAllocationMethod sets the "allocation_method" field.
+func (u *InvoicePaymentUpsertBulk) SetAllocationMethod(v string) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetAllocationMethod(v)
+	})
+}
+
+// UpdateAllocationMethod sets the "allocation_method" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertBulk) UpdateAllocationMethod() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateAllocationMethod()
+	})
+}
+
+// SetAllocatedBy sets the "allocated_by" field.
+func (u *InvoicePaymentUpsertBulk) SetAllocatedBy(v uuid.UUID) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetAllocatedBy(v)
+	})
+}
+
+// UpdateAllocatedBy sets the "allocated_by" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertBulk) UpdateAllocatedBy() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateAllocatedBy()
+	})
+}
+
+// ClearAllocatedBy clears the value of the "allocated_by" field.
+func (u *InvoicePaymentUpsertBulk) ClearAllocatedBy() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.ClearAllocatedBy()
+	})
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *InvoicePaymentUpsertBulk) SetJournalEntryID(v uuid.UUID) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetJournalEntryID(v)
+	})
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertBulk) UpdateJournalEntryID() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateJournalEntryID()
+	})
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *InvoicePaymentUpsertBulk) ClearJournalEntryID() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.ClearJournalEntryID()
+	})
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (u *InvoicePaymentUpsertBulk) SetAppliedAt(v time.Time) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetAppliedAt(v)
+	})
+}
+
+// UpdateAppliedAt sets the "applied_at" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertBulk) UpdateAppliedAt() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateAppliedAt()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *InvoicePaymentUpsertBulk) SetMetadata(v map[string]interface{}) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertBulk) UpdateMetadata() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoicePaymentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InvoicePaymentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoicePaymentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoicePaymentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/invoicepayment"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+)
+
+// InvoicePaymentDelete is the builder for deleting a InvoicePayment entity.
+type InvoicePaymentDelete struct {
+	config
+	hooks    []Hook
+	mutation *InvoicePaymentMutation
+}
+
+// Where appends a list predicates to the InvoicePaymentDelete builder.
+func (_d *InvoicePaymentDelete) Where(ps ...predicate.InvoicePayment) *InvoicePaymentDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *InvoicePaymentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *InvoicePaymentDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *InvoicePaymentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(invoicepayment.Table, sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// InvoicePaymentDeleteOne is the builder for deleting a single InvoicePayment entity.
+type InvoicePaymentDeleteOne struct {
+	_d *InvoicePaymentDelete
+}
+
+// Where appends a list predicates to the InvoicePaymentDelete builder.
+func (_d *InvoicePaymentDeleteOne) Where(ps ...predicate.InvoicePayment) *InvoicePaymentDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *InvoicePaymentDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{invoicepayment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *InvoicePaymentDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
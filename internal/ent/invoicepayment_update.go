@@ -0,0 +1,800 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/invoice"
+	"github.com/bengobox/treasury-api/internal/ent/invoicepayment"
+	"github.com/bengobox/treasury-api/internal/ent/paymenttransaction"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// InvoicePaymentUpdate is the builder for updating InvoicePayment entities.
+type InvoicePaymentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvoicePaymentMutation
+}
+
+// Where appends a list predicates to the InvoicePaymentUpdate builder.
+func (_u *InvoicePaymentUpdate) Where(ps ...predicate.InvoicePayment) *InvoicePaymentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *InvoicePaymentUpdate) SetTenantID(v uuid.UUID) *InvoicePaymentUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *InvoicePaymentUpdate) SetNillableTenantID(v *uuid.UUID) *InvoicePaymentUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *InvoicePaymentUpdate) SetInvoiceID(v uuid.UUID) *InvoicePaymentUpdate {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *InvoicePaymentUpdate) SetNillableInvoiceID(v *uuid.UUID) *InvoicePaymentUpdate {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// SetPaymentTransactionID sets the "payment_transaction_id" field.
+func (_u *InvoicePaymentUpdate) SetPaymentTransactionID(v uuid.UUID) *InvoicePaymentUpdate {
+	_u.mutation.SetPaymentTransactionID(v)
+	return _u
+}
+
+// SetNillablePaymentTransactionID sets the "payment_transaction_id" field if the given value is not nil.
+func (_u *InvoicePaymentUpdate) SetNillablePaymentTransactionID(v *uuid.UUID) *InvoicePaymentUpdate {
+	if v != nil {
+		_u.SetPaymentTransactionID(*v)
+	}
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *InvoicePaymentUpdate) SetCustomerID(v uuid.UUID) *InvoicePaymentUpdate {
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *InvoicePaymentUpdate) SetNillableCustomerID(v *uuid.UUID) *InvoicePaymentUpdate {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (_u *InvoicePaymentUpdate) ClearCustomerID() *InvoicePaymentUpdate {
+	_u.mutation.ClearCustomerID()
+	return _u
+}
+
+// SetAmountApplied sets the "amount_applied" field.
+func (_u *InvoicePaymentUpdate) SetAmountApplied(v decimal.Decimal) *InvoicePaymentUpdate {
+	_u.mutation.ResetAmountApplied()
+	_u.mutation.SetAmountApplied(v)
+	return _u
+}
+
+// SetNillableAmountApplied sets the "amount_applied" field if the given value is not nil.
+func (_u *InvoicePaymentUpdate) SetNillableAmountApplied(v *decimal.Decimal) *InvoicePaymentUpdate {
+	if v != nil {
+		_u.SetAmountApplied(*v)
+	}
+	return _u
+}
+
+// AddAmountApplied adds value to the "amount_applied" field.
+func (_u *InvoicePaymentUpdate) AddAmountApplied(v decimal.Decimal) *InvoicePaymentUpdate {
+	_u.mutation.AddAmountApplied(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *InvoicePaymentUpdate) SetCurrency(v string) *InvoicePaymentUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *InvoicePaymentUpdate) SetNillableCurrency(v *string) *InvoicePaymentUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetAllocationMethod sets the "allocation_method" field.
+func (_u *InvoicePaymentUpdate) SetAllocationMethod(v string) *InvoicePaymentUpdate {
+	_u.mutation.SetAllocationMethod(v)
+	return _u
+}
+
+// SetNillableAllocationMethod sets the "allocation_method" field if the given value is not nil.
+func (_u *InvoicePaymentUpdate) SetNillableAllocationMethod(v *string) *InvoicePaymentUpdate {
+	if v != nil {
+		_u.SetAllocationMethod(*v)
+	}
+	return _u
+}
+
+// SetAllocatedBy sets the "allocated_by" field.
+func (_u *InvoicePaymentUpdate) SetAllocatedBy(v uuid.UUID) *InvoicePaymentUpdate {
+	_u.mutation.SetAllocatedBy(v)
+	return _u
+}
+
+// SetNillableAllocatedBy sets the "allocated_by" field if the given value is not nil.
+func (_u *InvoicePaymentUpdate) SetNillableAllocatedBy(v *uuid.UUID) *InvoicePaymentUpdate {
+	if v != nil {
+		_u.SetAllocatedBy(*v)
+	}
+	return _u
+}
+
+// ClearAllocatedBy clears the value of the "allocated_by" field.
+func (_u *InvoicePaymentUpdate) ClearAllocatedBy() *InvoicePaymentUpdate {
+	_u.mutation.ClearAllocatedBy()
+	return _u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_u *InvoicePaymentUpdate) SetJournalEntryID(v uuid.UUID) *InvoicePaymentUpdate {
+	_u.mutation.SetJournalEntryID(v)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_u *InvoicePaymentUpdate) SetNillableJournalEntryID(v *uuid.UUID) *InvoicePaymentUpdate {
+	if v != nil {
+		_u.SetJournalEntryID(*v)
+	}
+	return _u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (_u *InvoicePaymentUpdate) ClearJournalEntryID() *InvoicePaymentUpdate {
+	_u.mutation.ClearJournalEntryID()
+	return _u
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (_u *InvoicePaymentUpdate) SetAppliedAt(v time.Time) *InvoicePaymentUpdate {
+	_u.mutation.SetAppliedAt(v)
+	return _u
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (_u *InvoicePaymentUpdate) SetNillableAppliedAt(v *time.Time) *InvoicePaymentUpdate {
+	if v != nil {
+		_u.SetAppliedAt(*v)
+	}
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *InvoicePaymentUpdate) SetMetadata(v map[string]interface{}) *InvoicePaymentUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *InvoicePaymentUpdate) SetInvoice(v *Invoice) *InvoicePaymentUpdate {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// SetPaymentID sets the "payment" edge to the PaymentTransaction entity by ID.
+func (_u *InvoicePaymentUpdate) SetPaymentID(id uuid.UUID) *InvoicePaymentUpdate {
+	_u.mutation.SetPaymentID(id)
+	return _u
+}
+
+// SetPayment sets the "payment" edge to the PaymentTransaction entity.
+func (_u *InvoicePaymentUpdate) SetPayment(v *PaymentTransaction) *InvoicePaymentUpdate {
+	return _u.SetPaymentID(v.ID)
+}
+
+// Mutation returns the InvoicePaymentMutation object of the builder.
+func (_u *InvoicePaymentUpdate) Mutation() *InvoicePaymentMutation {
+	return _u.mutation
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *InvoicePaymentUpdate) ClearInvoice() *InvoicePaymentUpdate {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// ClearPayment clears the "payment" edge to the PaymentTransaction entity.
+func (_u *InvoicePaymentUpdate) ClearPayment() *InvoicePaymentUpdate {
+	_u.mutation.ClearPayment()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InvoicePaymentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoicePaymentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InvoicePaymentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoicePaymentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvoicePaymentUpdate) check() error {
+	if _u.mutation.InvoiceCleared() && len(_u.mutation.InvoiceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvoicePayment.invoice"`)
+	}
+	if _u.mutation.PaymentCleared() && len(_u.mutation.PaymentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvoicePayment.payment"`)
+	}
+	return nil
+}
+
+func (_u *InvoicePaymentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoicepayment.Table, invoicepayment.Columns, sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(invoicepayment.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(invoicepayment.FieldCustomerID, field.TypeUUID, value)
+	}
+	if _u.mutation.CustomerIDCleared() {
+		_spec.ClearField(invoicepayment.FieldCustomerID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.AmountApplied(); ok {
+		_spec.SetField(invoicepayment.FieldAmountApplied, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountApplied(); ok {
+		_spec.AddField(invoicepayment.FieldAmountApplied, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(invoicepayment.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AllocationMethod(); ok {
+		_spec.SetField(invoicepayment.FieldAllocationMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AllocatedBy(); ok {
+		_spec.SetField(invoicepayment.FieldAllocatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.AllocatedByCleared() {
+		_spec.ClearField(invoicepayment.FieldAllocatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.JournalEntryID(); ok {
+		_spec.SetField(invoicepayment.FieldJournalEntryID, field.TypeUUID, value)
+	}
+	if _u.mutation.JournalEntryIDCleared() {
+		_spec.ClearField(invoicepayment.FieldJournalEntryID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.AppliedAt(); ok {
+		_spec.SetField(invoicepayment.FieldAppliedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(invoicepayment.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.InvoiceTable,
+			Columns: []string{invoicepayment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.InvoiceTable,
+			Columns: []string{invoicepayment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaymentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.PaymentTable,
+			Columns: []string{invoicepayment.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymenttransaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.PaymentTable,
+			Columns: []string{invoicepayment.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymenttransaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoicepayment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InvoicePaymentUpdateOne is the builder for updating a single InvoicePayment entity.
+type InvoicePaymentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvoicePaymentMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *InvoicePaymentUpdateOne) SetTenantID(v uuid.UUID) *InvoicePaymentUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *InvoicePaymentUpdateOne) SetNillableTenantID(v *uuid.UUID) *InvoicePaymentUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *InvoicePaymentUpdateOne) SetInvoiceID(v uuid.UUID) *InvoicePaymentUpdateOne {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *InvoicePaymentUpdateOne) SetNillableInvoiceID(v *uuid.UUID) *InvoicePaymentUpdateOne {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// SetPaymentTransactionID sets the "payment_transaction_id" field.
+func (_u *InvoicePaymentUpdateOne) SetPaymentTransactionID(v uuid.UUID) *InvoicePaymentUpdateOne {
+	_u.mutation.SetPaymentTransactionID(v)
+	return _u
+}
+
+// SetNillablePaymentTransactionID sets the "payment_transaction_id" field if the given value is not nil.
+func (_u *InvoicePaymentUpdateOne) SetNillablePaymentTransactionID(v *uuid.UUID) *InvoicePaymentUpdateOne {
+	if v != nil {
+		_u.SetPaymentTransactionID(*v)
+	}
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *InvoicePaymentUpdateOne) SetCustomerID(v uuid.UUID) *InvoicePaymentUpdateOne {
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *InvoicePaymentUpdateOne) SetNillableCustomerID(v *uuid.UUID) *InvoicePaymentUpdateOne {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (_u *InvoicePaymentUpdateOne) ClearCustomerID() *InvoicePaymentUpdateOne {
+	_u.mutation.ClearCustomerID()
+	return _u
+}
+
+// SetAmountApplied sets the "amount_applied" field.
+func (_u *InvoicePaymentUpdateOne) SetAmountApplied(v decimal.Decimal) *InvoicePaymentUpdateOne {
+	_u.mutation.ResetAmountApplied()
+	_u.mutation.SetAmountApplied(v)
+	return _u
+}
+
+// SetNillableAmountApplied sets the "amount_applied" field if the given value is not nil.
+func (_u *InvoicePaymentUpdateOne) SetNillableAmountApplied(v *decimal.Decimal) *InvoicePaymentUpdateOne {
+	if v != nil {
+		_u.SetAmountApplied(*v)
+	}
+	return _u
+}
+
+// AddAmountApplied adds value to the "amount_applied" field.
+func (_u *InvoicePaymentUpdateOne) AddAmountApplied(v decimal.Decimal) *InvoicePaymentUpdateOne {
+	_u.mutation.AddAmountApplied(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *InvoicePaymentUpdateOne) SetCurrency(v string) *InvoicePaymentUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *InvoicePaymentUpdateOne) SetNillableCurrency(v *string) *InvoicePaymentUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetAllocationMethod sets the "allocation_method" field.
+func (_u *InvoicePaymentUpdateOne) SetAllocationMethod(v string) *InvoicePaymentUpdateOne {
+	_u.mutation.SetAllocationMethod(v)
+	return _u
+}
+
+// SetNillableAllocationMethod sets the "allocation_method" field if the given value is not nil.
+func (_u *InvoicePaymentUpdateOne) SetNillableAllocationMethod(v *string) *InvoicePaymentUpdateOne {
+	if v != nil {
+		_u.SetAllocationMethod(*v)
+	}
+	return _u
+}
+
+// SetAllocatedBy sets the "allocated_by" field.
+func (_u *InvoicePaymentUpdateOne) SetAllocatedBy(v uuid.UUID) *InvoicePaymentUpdateOne {
+	_u.mutation.SetAllocatedBy(v)
+	return _u
+}
+
+// SetNillableAllocatedBy sets the "allocated_by" field if the given value is not nil.
+func (_u *InvoicePaymentUpdateOne) SetNillableAllocatedBy(v *uuid.UUID) *InvoicePaymentUpdateOne {
+	if v != nil {
+		_u.SetAllocatedBy(*v)
+	}
+	return _u
+}
+
+// ClearAllocatedBy clears the value of the "allocated_by" field.
+func (_u *InvoicePaymentUpdateOne) ClearAllocatedBy() *InvoicePaymentUpdateOne {
+	_u.mutation.ClearAllocatedBy()
+	return _u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_u *InvoicePaymentUpdateOne) SetJournalEntryID(v uuid.UUID) *InvoicePaymentUpdateOne {
+	_u.mutation.SetJournalEntryID(v)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_u *InvoicePaymentUpdateOne) SetNillableJournalEntryID(v *uuid.UUID) *InvoicePaymentUpdateOne {
+	if v != nil {
+		_u.SetJournalEntryID(*v)
+	}
+	return _u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (_u *InvoicePaymentUpdateOne) ClearJournalEntryID() *InvoicePaymentUpdateOne {
+	_u.mutation.ClearJournalEntryID()
+	return _u
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (_u *InvoicePaymentUpdateOne) SetAppliedAt(v time.Time) *InvoicePaymentUpdateOne {
+	_u.mutation.SetAppliedAt(v)
+	return _u
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (_u *InvoicePaymentUpdateOne) SetNillableAppliedAt(v *time.Time) *InvoicePaymentUpdateOne {
+	if v != nil {
+		_u.SetAppliedAt(*v)
+	}
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *InvoicePaymentUpdateOne) SetMetadata(v map[string]interface{}) *InvoicePaymentUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *InvoicePaymentUpdateOne) SetInvoice(v *Invoice) *InvoicePaymentUpdateOne {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// SetPaymentID sets the "payment" edge to the PaymentTransaction entity by ID.
+func (_u *InvoicePaymentUpdateOne) SetPaymentID(id uuid.UUID) *InvoicePaymentUpdateOne {
+	_u.mutation.SetPaymentID(id)
+	return _u
+}
+
+// SetPayment sets the "payment" edge to the PaymentTransaction entity.
+func (_u *InvoicePaymentUpdateOne) SetPayment(v *PaymentTransaction) *InvoicePaymentUpdateOne {
+	return _u.SetPaymentID(v.ID)
+}
+
+// Mutation returns the InvoicePaymentMutation object of the builder.
+func (_u *InvoicePaymentUpdateOne) Mutation() *InvoicePaymentMutation {
+	return _u.mutation
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *InvoicePaymentUpdateOne) ClearInvoice() *InvoicePaymentUpdateOne {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// ClearPayment clears the "payment" edge to the PaymentTransaction entity.
+func (_u *InvoicePaymentUpdateOne) ClearPayment() *InvoicePaymentUpdateOne {
+	_u.mutation.ClearPayment()
+	return _u
+}
+
+// Where appends a list predicates to the InvoicePaymentUpdate builder.
+func (_u *InvoicePaymentUpdateOne) Where(ps ...predicate.InvoicePayment) *InvoicePaymentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InvoicePaymentUpdateOne) Select(field string, fields ...string) *InvoicePaymentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InvoicePayment entity.
+func (_u *InvoicePaymentUpdateOne) Save(ctx context.Context) (*InvoicePayment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoicePaymentUpdateOne) SaveX(ctx context.Context) *InvoicePayment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InvoicePaymentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoicePaymentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvoicePaymentUpdateOne) check() error {
+	if _u.mutation.InvoiceCleared() && len(_u.mutation.InvoiceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvoicePayment.invoice"`)
+	}
+	if _u.mutation.PaymentCleared() && len(_u.mutation.PaymentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvoicePayment.payment"`)
+	}
+	return nil
+}
+
+func (_u *InvoicePaymentUpdateOne) sqlSave(ctx context.Context) (_node *InvoicePayment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoicepayment.Table, invoicepayment.Columns, sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InvoicePayment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoicepayment.FieldID)
+		for _, f := range fields {
+			if !invoicepayment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invoicepayment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(invoicepayment.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(invoicepayment.FieldCustomerID, field.TypeUUID, value)
+	}
+	if _u.mutation.CustomerIDCleared() {
+		_spec.ClearField(invoicepayment.FieldCustomerID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.AmountApplied(); ok {
+		_spec.SetField(invoicepayment.FieldAmountApplied, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountApplied(); ok {
+		_spec.AddField(invoicepayment.FieldAmountApplied, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(invoicepayment.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AllocationMethod(); ok {
+		_spec.SetField(invoicepayment.FieldAllocationMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AllocatedBy(); ok {
+		_spec.SetField(invoicepayment.FieldAllocatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.AllocatedByCleared() {
+		_spec.ClearField(invoicepayment.FieldAllocatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.JournalEntryID(); ok {
+		_spec.SetField(invoicepayment.FieldJournalEntryID, field.TypeUUID, value)
+	}
+	if _u.mutation.JournalEntryIDCleared() {
+		_spec.ClearField(invoicepayment.FieldJournalEntryID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.AppliedAt(); ok {
+		_spec.SetField(invoicepayment.FieldAppliedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(invoicepayment.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.InvoiceTable,
+			Columns: []string{invoicepayment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.InvoiceTable,
+			Columns: []string{invoicepayment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaymentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.PaymentTable,
+			Columns: []string{invoicepayment.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymenttransaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.PaymentTable,
+			Columns: []string{invoicepayment.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymenttransaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &InvoicePayment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoicepayment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
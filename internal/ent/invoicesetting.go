@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/invoicesetting"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceSetting is the model entity for the InvoiceSetting schema.
+type InvoiceSetting struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Issue invoices from cafe orders immediately instead of leaving drafts
+	AutoIssueOrders bool `json:"auto_issue_orders,omitempty"`
+	// Issue invoices from project milestones immediately instead of leaving drafts
+	AutoIssueMilestones bool `json:"auto_issue_milestones,omitempty"`
+	// Days until order invoices are due
+	OrderPaymentTermsDays int `json:"order_payment_terms_days,omitempty"`
+	// Days until milestone invoices are due
+	MilestonePaymentTermsDays int `json:"milestone_payment_terms_days,omitempty"`
+	// Put customers on credit hold once an invoice is this many days overdue (0 = never)
+	CreditHoldDays int `json:"credit_hold_days,omitempty"`
+	// Tax rate applied to itemised lines that do not carry one
+	DefaultTaxRate decimal.Decimal `json:"default_tax_rate,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InvoiceSetting) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoicesetting.FieldDefaultTaxRate:
+			values[i] = new(decimal.Decimal)
+		case invoicesetting.FieldAutoIssueOrders, invoicesetting.FieldAutoIssueMilestones:
+			values[i] = new(sql.NullBool)
+		case invoicesetting.FieldOrderPaymentTermsDays, invoicesetting.FieldMilestonePaymentTermsDays, invoicesetting.FieldCreditHoldDays:
+			values[i] = new(sql.NullInt64)
+		case invoicesetting.FieldCreatedAt, invoicesetting.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case invoicesetting.FieldID, invoicesetting.FieldTenantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InvoiceSetting fields.
+func (_m *InvoiceSetting) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case invoicesetting.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case invoicesetting.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case invoicesetting.FieldAutoIssueOrders:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_issue_orders", values[i])
+			} else if value.Valid {
+				_m.AutoIssueOrders = value.Bool
+			}
+		case invoicesetting.FieldAutoIssueMilestones:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_issue_milestones", values[i])
+			} else if value.Valid {
+				_m.AutoIssueMilestones = value.Bool
+			}
+		case invoicesetting.FieldOrderPaymentTermsDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order_payment_terms_days", values[i])
+			} else if value.Valid {
+				_m.OrderPaymentTermsDays = int(value.Int64)
+			}
+		case invoicesetting.FieldMilestonePaymentTermsDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field milestone_payment_terms_days", values[i])
+			} else if value.Valid {
+				_m.MilestonePaymentTermsDays = int(value.Int64)
+			}
+		case invoicesetting.FieldCreditHoldDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_hold_days", values[i])
+			} else if value.Valid {
+				_m.CreditHoldDays = int(value.Int64)
+			}
+		case invoicesetting.FieldDefaultTaxRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field default_tax_rate", values[i])
+			} else if value != nil {
+				_m.DefaultTaxRate = *value
+			}
+		case invoicesetting.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case invoicesetting.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InvoiceSetting.
+// This includes values selected through modifiers, order, etc.
+func (_m *InvoiceSetting) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this InvoiceSetting.
+// Note that you need to call InvoiceSetting.Unwrap() before calling this method if this InvoiceSetting
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *InvoiceSetting) Update() *InvoiceSettingUpdateOne {
+	return NewInvoiceSettingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the InvoiceSetting entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *InvoiceSetting) Unwrap() *InvoiceSetting {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvoiceSetting is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *InvoiceSetting) String() string {
+	var builder strings.Builder
+	builder.WriteString("InvoiceSetting(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("auto_issue_orders=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoIssueOrders))
+	builder.WriteString(", ")
+	builder.WriteString("auto_issue_milestones=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoIssueMilestones))
+	builder.WriteString(", ")
+	builder.WriteString("order_payment_terms_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrderPaymentTermsDays))
+	builder.WriteString(", ")
+	builder.WriteString("milestone_payment_terms_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MilestonePaymentTermsDays))
+	builder.WriteString(", ")
+	builder.WriteString("credit_hold_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreditHoldDays))
+	builder.WriteString(", ")
+	builder.WriteString("default_tax_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefaultTaxRate))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvoiceSettings is a parsable slice of InvoiceSetting.
+type InvoiceSettings []*InvoiceSetting
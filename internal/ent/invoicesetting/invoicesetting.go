@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoicesetting
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the invoicesetting type in the database.
+	Label = "invoice_setting"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldAutoIssueOrders holds the string denoting the auto_issue_orders field in the database.
+	FieldAutoIssueOrders = "auto_issue_orders"
+	// FieldAutoIssueMilestones holds the string denoting the auto_issue_milestones field in the database.
+	FieldAutoIssueMilestones = "auto_issue_milestones"
+	// FieldOrderPaymentTermsDays holds the string denoting the order_payment_terms_days field in the database.
+	FieldOrderPaymentTermsDays = "order_payment_terms_days"
+	// FieldMilestonePaymentTermsDays holds the string denoting the milestone_payment_terms_days field in the database.
+	FieldMilestonePaymentTermsDays = "milestone_payment_terms_days"
+	// FieldCreditHoldDays holds the string denoting the credit_hold_days field in the database.
+	FieldCreditHoldDays = "credit_hold_days"
+	// FieldDefaultTaxRate holds the string denoting the default_tax_rate field in the database.
+	FieldDefaultTaxRate = "default_tax_rate"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the invoicesetting in the database.
+	Table = "invoice_settings"
+)
+
+// Columns holds all SQL columns for invoicesetting fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldAutoIssueOrders,
+	FieldAutoIssueMilestones,
+	FieldOrderPaymentTermsDays,
+	FieldMilestonePaymentTermsDays,
+	FieldCreditHoldDays,
+	FieldDefaultTaxRate,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAutoIssueOrders holds the default value on creation for the "auto_issue_orders" field.
+	DefaultAutoIssueOrders bool
+	// DefaultAutoIssueMilestones holds the default value on creation for the "auto_issue_milestones" field.
+	DefaultAutoIssueMilestones bool
+	// DefaultOrderPaymentTermsDays holds the default value on creation for the "order_payment_terms_days" field.
+	DefaultOrderPaymentTermsDays int
+	// OrderPaymentTermsDaysValidator is a validator for the "order_payment_terms_days" field. It is called by the builders before save.
+	OrderPaymentTermsDaysValidator func(int) error
+	// DefaultMilestonePaymentTermsDays holds the default value on creation for the "milestone_payment_terms_days" field.
+	DefaultMilestonePaymentTermsDays int
+	// MilestonePaymentTermsDaysValidator is a validator for the "milestone_payment_terms_days" field. It is called by the builders before save.
+	MilestonePaymentTermsDaysValidator func(int) error
+	// DefaultCreditHoldDays holds the default value on creation for the "credit_hold_days" field.
+	DefaultCreditHoldDays int
+	// CreditHoldDaysValidator is a validator for the "credit_hold_days" field. It is called by the builders before save.
+	CreditHoldDaysValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the InvoiceSetting queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByAutoIssueOrders orders the results by the auto_issue_orders field.
+func ByAutoIssueOrders(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoIssueOrders, opts...).ToFunc()
+}
+
+// ByAutoIssueMilestones orders the results by the auto_issue_milestones field.
+func ByAutoIssueMilestones(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoIssueMilestones, opts...).ToFunc()
+}
+
+// ByOrderPaymentTermsDays orders the results by the order_payment_terms_days field.
+func ByOrderPaymentTermsDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderPaymentTermsDays, opts...).ToFunc()
+}
+
+// ByMilestonePaymentTermsDays orders the results by the milestone_payment_terms_days field.
+func ByMilestonePaymentTermsDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMilestonePaymentTermsDays, opts...).ToFunc()
+}
+
+// ByCreditHoldDays orders the results by the credit_hold_days field.
+func ByCreditHoldDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditHoldDays, opts...).ToFunc()
+}
+
+// ByDefaultTaxRate orders the results by the default_tax_rate field.
+func ByDefaultTaxRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultTaxRate, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,427 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoicesetting
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldTenantID, v))
+}
+
+// AutoIssueOrders applies equality check predicate on the "auto_issue_orders" field. It's identical to AutoIssueOrdersEQ.
+func AutoIssueOrders(v bool) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldAutoIssueOrders, v))
+}
+
+// AutoIssueMilestones applies equality check predicate on the "auto_issue_milestones" field. It's identical to AutoIssueMilestonesEQ.
+func AutoIssueMilestones(v bool) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldAutoIssueMilestones, v))
+}
+
+// OrderPaymentTermsDays applies equality check predicate on the "order_payment_terms_days" field. It's identical to OrderPaymentTermsDaysEQ.
+func OrderPaymentTermsDays(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldOrderPaymentTermsDays, v))
+}
+
+// MilestonePaymentTermsDays applies equality check predicate on the "milestone_payment_terms_days" field. It's identical to MilestonePaymentTermsDaysEQ.
+func MilestonePaymentTermsDays(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldMilestonePaymentTermsDays, v))
+}
+
+// CreditHoldDays applies equality check predicate on the "credit_hold_days" field. It's identical to CreditHoldDaysEQ.
+func CreditHoldDays(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldCreditHoldDays, v))
+}
+
+// DefaultTaxRate applies equality check predicate on the "default_tax_rate" field. It's identical to DefaultTaxRateEQ.
+func DefaultTaxRate(v decimal.Decimal) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldDefaultTaxRate, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldLTE(FieldTenantID, v))
+}
+
+// AutoIssueOrdersEQ applies the EQ predicate on the "auto_issue_orders" field.
+func AutoIssueOrdersEQ(v bool) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldAutoIssueOrders, v))
+}
+
+// AutoIssueOrdersNEQ applies the NEQ predicate on the "auto_issue_orders" field.
+func AutoIssueOrdersNEQ(v bool) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNEQ(FieldAutoIssueOrders, v))
+}
+
+// AutoIssueMilestonesEQ applies the EQ predicate on the "auto_issue_milestones" field.
+func AutoIssueMilestonesEQ(v bool) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldAutoIssueMilestones, v))
+}
+
+// AutoIssueMilestonesNEQ applies the NEQ predicate on the "auto_issue_milestones" field.
+func AutoIssueMilestonesNEQ(v bool) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNEQ(FieldAutoIssueMilestones, v))
+}
+
+// OrderPaymentTermsDaysEQ applies the EQ predicate on the "order_payment_terms_days" field.
+func OrderPaymentTermsDaysEQ(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldOrderPaymentTermsDays, v))
+}
+
+// OrderPaymentTermsDaysNEQ applies the NEQ predicate on the "order_payment_terms_days" field.
+func OrderPaymentTermsDaysNEQ(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNEQ(FieldOrderPaymentTermsDays, v))
+}
+
+// OrderPaymentTermsDaysIn applies the In predicate on the "order_payment_terms_days" field.
+func OrderPaymentTermsDaysIn(vs ...int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldIn(FieldOrderPaymentTermsDays, vs...))
+}
+
+// OrderPaymentTermsDaysNotIn applies the NotIn predicate on the "order_payment_terms_days" field.
+func OrderPaymentTermsDaysNotIn(vs ...int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNotIn(FieldOrderPaymentTermsDays, vs...))
+}
+
+// OrderPaymentTermsDaysGT applies the GT predicate on the "order_payment_terms_days" field.
+func OrderPaymentTermsDaysGT(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldGT(FieldOrderPaymentTermsDays, v))
+}
+
+// OrderPaymentTermsDaysGTE applies the GTE predicate on the "order_payment_terms_days" field.
+func OrderPaymentTermsDaysGTE(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldGTE(FieldOrderPaymentTermsDays, v))
+}
+
+// OrderPaymentTermsDaysLT applies the LT predicate on the "order_payment_terms_days" field.
+func OrderPaymentTermsDaysLT(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldLT(FieldOrderPaymentTermsDays, v))
+}
+
+// OrderPaymentTermsDaysLTE applies the LTE predicate on the "order_payment_terms_days" field.
+func OrderPaymentTermsDaysLTE(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldLTE(FieldOrderPaymentTermsDays, v))
+}
+
+// MilestonePaymentTermsDaysEQ applies the EQ predicate on the "milestone_payment_terms_days" field.
+func MilestonePaymentTermsDaysEQ(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldMilestonePaymentTermsDays, v))
+}
+
+// MilestonePaymentTermsDaysNEQ applies the NEQ predicate on the "milestone_payment_terms_days" field.
+func MilestonePaymentTermsDaysNEQ(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNEQ(FieldMilestonePaymentTermsDays, v))
+}
+
+// MilestonePaymentTermsDaysIn applies the In predicate on the "milestone_payment_terms_days" field.
+func MilestonePaymentTermsDaysIn(vs ...int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldIn(FieldMilestonePaymentTermsDays, vs...))
+}
+
+// MilestonePaymentTermsDaysNotIn applies the NotIn predicate on the "milestone_payment_terms_days" field.
+func MilestonePaymentTermsDaysNotIn(vs ...int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNotIn(FieldMilestonePaymentTermsDays, vs...))
+}
+
+// MilestonePaymentTermsDaysGT applies the GT predicate on the "milestone_payment_terms_days" field.
+func MilestonePaymentTermsDaysGT(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldGT(FieldMilestonePaymentTermsDays, v))
+}
+
+// MilestonePaymentTermsDaysGTE applies the GTE predicate on the "milestone_payment_terms_days" field.
+func MilestonePaymentTermsDaysGTE(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldGTE(FieldMilestonePaymentTermsDays, v))
+}
+
+// MilestonePaymentTermsDaysLT applies the LT predicate on the "milestone_payment_terms_days" field.
+func MilestonePaymentTermsDaysLT(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldLT(FieldMilestonePaymentTermsDays, v))
+}
+
+// MilestonePaymentTermsDaysLTE applies the LTE predicate on the "milestone_payment_terms_days" field.
+func MilestonePaymentTermsDaysLTE(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldLTE(FieldMilestonePaymentTermsDays, v))
+}
+
+// CreditHoldDaysEQ applies the EQ predicate on the "credit_hold_days" field.
+func CreditHoldDaysEQ(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldCreditHoldDays, v))
+}
+
+// CreditHoldDaysNEQ applies the NEQ predicate on the "credit_hold_days" field.
+func CreditHoldDaysNEQ(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNEQ(FieldCreditHoldDays, v))
+}
+
+// CreditHoldDaysIn applies the In predicate on the "credit_hold_days" field.
+func CreditHoldDaysIn(vs ...int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldIn(FieldCreditHoldDays, vs...))
+}
+
+// CreditHoldDaysNotIn applies the NotIn predicate on the "credit_hold_days" field.
+func CreditHoldDaysNotIn(vs ...int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNotIn(FieldCreditHoldDays, vs...))
+}
+
+// CreditHoldDaysGT applies the GT predicate on the "credit_hold_days" field.
+func CreditHoldDaysGT(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldGT(FieldCreditHoldDays, v))
+}
+
+// CreditHoldDaysGTE applies the GTE predicate on the "credit_hold_days" field.
+func CreditHoldDaysGTE(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldGTE(FieldCreditHoldDays, v))
+}
+
+// CreditHoldDaysLT applies the LT predicate on the "credit_hold_days" field.
+func CreditHoldDaysLT(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldLT(FieldCreditHoldDays, v))
+}
+
+// CreditHoldDaysLTE applies the LTE predicate on the "credit_hold_days" field.
+func CreditHoldDaysLTE(v int) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldLTE(FieldCreditHoldDays, v))
+}
+
+// DefaultTaxRateEQ applies the EQ predicate on the "default_tax_rate" field.
+func DefaultTaxRateEQ(v decimal.Decimal) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldDefaultTaxRate, v))
+}
+
+// DefaultTaxRateNEQ applies the NEQ predicate on the "default_tax_rate" field.
+func DefaultTaxRateNEQ(v decimal.Decimal) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNEQ(FieldDefaultTaxRate, v))
+}
+
+// DefaultTaxRateIn applies the In predicate on the "default_tax_rate" field.
+func DefaultTaxRateIn(vs ...decimal.Decimal) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldIn(FieldDefaultTaxRate, vs...))
+}
+
+// DefaultTaxRateNotIn applies the NotIn predicate on the "default_tax_rate" field.
+func DefaultTaxRateNotIn(vs ...decimal.Decimal) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNotIn(FieldDefaultTaxRate, vs...))
+}
+
+// DefaultTaxRateGT applies the GT predicate on the "default_tax_rate" field.
+func DefaultTaxRateGT(v decimal.Decimal) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldGT(FieldDefaultTaxRate, v))
+}
+
+// DefaultTaxRateGTE applies the GTE predicate on the "default_tax_rate" field.
+func DefaultTaxRateGTE(v decimal.Decimal) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldGTE(FieldDefaultTaxRate, v))
+}
+
+// DefaultTaxRateLT applies the LT predicate on the "default_tax_rate" field.
+func DefaultTaxRateLT(v decimal.Decimal) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldLT(FieldDefaultTaxRate, v))
+}
+
+// DefaultTaxRateLTE applies the LTE predicate on the "default_tax_rate" field.
+func DefaultTaxRateLTE(v decimal.Decimal) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldLTE(FieldDefaultTaxRate, v))
+}
+
+// DefaultTaxRateIsNil applies the IsNil predicate on the "default_tax_rate" field.
+func DefaultTaxRateIsNil() predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldIsNull(FieldDefaultTaxRate))
+}
+
+// DefaultTaxRateNotNil applies the NotNil predicate on the "default_tax_rate" field.
+func DefaultTaxRateNotNil() predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNotNull(FieldDefaultTaxRate))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InvoiceSetting) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InvoiceSetting) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InvoiceSetting) predicate.InvoiceSetting {
+	return predicate.InvoiceSetting(sql.NotPredicates(p))
+}
@@ -0,0 +1,1104 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/invoicesetting"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceSettingCreate is the builder for creating a InvoiceSetting entity.
+type InvoiceSettingCreate struct {
+	config
+	mutation *InvoiceSettingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *InvoiceSettingCreate) SetTenantID(v uuid.UUID) *InvoiceSettingCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetAutoIssueOrders sets the "auto_issue_orders" field.
+func (_c *InvoiceSettingCreate) SetAutoIssueOrders(v bool) *InvoiceSettingCreate {
+	_c.mutation.SetAutoIssueOrders(v)
+	return _c
+}
+
+// SetNillableAutoIssueOrders sets the "auto_issue_orders" field if the given value is not nil.
+func (_c *InvoiceSettingCreate) SetNillableAutoIssueOrders(v *bool) *InvoiceSettingCreate {
+	if v != nil {
+		_c.SetAutoIssueOrders(*v)
+	}
+	return _c
+}
+
+// SetAutoIssueMilestones sets the "auto_issue_milestones" field.
+func (_c *InvoiceSettingCreate) SetAutoIssueMilestones(v bool) *InvoiceSettingCreate {
+	_c.mutation.SetAutoIssueMilestones(v)
+	return _c
+}
+
+// SetNillableAutoIssueMilestones sets the "auto_issue_milestones" field if the given value is not nil.
+func (_c *InvoiceSettingCreate) SetNillableAutoIssueMilestones(v *bool) *InvoiceSettingCreate {
+	if v != nil {
+		_c.SetAutoIssueMilestones(*v)
+	}
+	return _c
+}
+
+// SetOrderPaymentTermsDays sets the "order_payment_terms_days" field.
+func (_c *InvoiceSettingCreate) SetOrderPaymentTermsDays(v int) *InvoiceSettingCreate {
+	_c.mutation.SetOrderPaymentTermsDays(v)
+	return _c
+}
+
+// SetNillableOrderPaymentTermsDays sets the "order_payment_terms_days" field if the given value is not nil.
+func (_c *InvoiceSettingCreate) SetNillableOrderPaymentTermsDays(v *int) *InvoiceSettingCreate {
+	if v != nil {
+		_c.SetOrderPaymentTermsDays(*v)
+	}
+	return _c
+}
+
+// SetMilestonePaymentTermsDays sets the "milestone_payment_terms_days" field.
+func (_c *InvoiceSettingCreate) SetMilestonePaymentTermsDays(v int) *InvoiceSettingCreate {
+	_c.mutation.SetMilestonePaymentTermsDays(v)
+	return _c
+}
+
+// SetNillableMilestonePaymentTermsDays sets the "milestone_payment_terms_days" field if the given value is not nil.
+func (_c *InvoiceSettingCreate) SetNillableMilestonePaymentTermsDays(v *int) *InvoiceSettingCreate {
+	if v != nil {
+		_c.SetMilestonePaymentTermsDays(*v)
+	}
+	return _c
+}
+
+// SetCreditHoldDays sets the "credit_hold_days" field.
+func (_c *InvoiceSettingCreate) SetCreditHoldDays(v int) *InvoiceSettingCreate {
+	_c.mutation.SetCreditHoldDays(v)
+	return _c
+}
+
+// SetNillableCreditHoldDays sets the "credit_hold_days" field if the given value is not nil.
+func (_c *InvoiceSettingCreate) SetNillableCreditHoldDays(v *int) *InvoiceSettingCreate {
+	if v != nil {
+		_c.SetCreditHoldDays(*v)
+	}
+	return _c
+}
+
+// SetDefaultTaxRate sets the "default_tax_rate" field.
+func (_c *InvoiceSettingCreate) SetDefaultTaxRate(v decimal.Decimal) *InvoiceSettingCreate {
+	_c.mutation.SetDefaultTaxRate(v)
+	return _c
+}
+
+// SetNillableDefaultTaxRate sets the "default_tax_rate" field if the given value is not nil.
+func (_c *InvoiceSettingCreate) SetNillableDefaultTaxRate(v *decimal.Decimal) *InvoiceSettingCreate {
+	if v != nil {
+		_c.SetDefaultTaxRate(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InvoiceSettingCreate) SetCreatedAt(v time.Time) *InvoiceSettingCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InvoiceSettingCreate) SetNillableCreatedAt(v *time.Time) *InvoiceSettingCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *InvoiceSettingCreate) SetUpdatedAt(v time.Time) *InvoiceSettingCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *InvoiceSettingCreate) SetNillableUpdatedAt(v *time.Time) *InvoiceSettingCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InvoiceSettingCreate) SetID(v uuid.UUID) *InvoiceSettingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *InvoiceSettingCreate) SetNillableID(v *uuid.UUID) *InvoiceSettingCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the InvoiceSettingMutation object of the builder.
+func (_c *InvoiceSettingCreate) Mutation() *InvoiceSettingMutation {
+	return _c.mutation
+}
+
+// Save creates the InvoiceSetting in the database.
+func (_c *InvoiceSettingCreate) Save(ctx context.Context) (*InvoiceSetting, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InvoiceSettingCreate) SaveX(ctx context.Context) *InvoiceSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceSettingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceSettingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InvoiceSettingCreate) defaults() {
+	if _, ok := _c.mutation.AutoIssueOrders(); !ok {
+		v := invoicesetting.DefaultAutoIssueOrders
+		_c.mutation.SetAutoIssueOrders(v)
+	}
+	if _, ok := _c.mutation.AutoIssueMilestones(); !ok {
+		v := invoicesetting.DefaultAutoIssueMilestones
+		_c.mutation.SetAutoIssueMilestones(v)
+	}
+	if _, ok := _c.mutation.OrderPaymentTermsDays(); !ok {
+		v := invoicesetting.DefaultOrderPaymentTermsDays
+		_c.mutation.SetOrderPaymentTermsDays(v)
+	}
+	if _, ok := _c.mutation.MilestonePaymentTermsDays(); !ok {
+		v := invoicesetting.DefaultMilestonePaymentTermsDays
+		_c.mutation.SetMilestonePaymentTermsDays(v)
+	}
+	if _, ok := _c.mutation.CreditHoldDays(); !ok {
+		v := invoicesetting.DefaultCreditHoldDays
+		_c.mutation.SetCreditHoldDays(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := invoicesetting.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := invoicesetting.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := invoicesetting.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InvoiceSettingCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "InvoiceSetting.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.AutoIssueOrders(); !ok {
+		return &ValidationError{Name: "auto_issue_orders", err: errors.New(`ent: missing required field "InvoiceSetting.auto_issue_orders"`)}
+	}
+	if _, ok := _c.mutation.AutoIssueMilestones(); !ok {
+		return &ValidationError{Name: "auto_issue_milestones", err: errors.New(`ent: missing required field "InvoiceSetting.auto_issue_milestones"`)}
+	}
+	if _, ok := _c.mutation.OrderPaymentTermsDays(); !ok {
+		return &ValidationError{Name: "order_payment_terms_days", err: errors.New(`ent: missing required field "InvoiceSetting.order_payment_terms_days"`)}
+	}
+	if v, ok := _c.mutation.OrderPaymentTermsDays(); ok {
+		if err := invoicesetting.OrderPaymentTermsDaysValidator(v); err != nil {
+			return &ValidationError{Name: "order_payment_terms_days", err: fmt.Errorf(`ent: validator failed for field "InvoiceSetting.order_payment_terms_days": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MilestonePaymentTermsDays(); !ok {
+		return &ValidationError{Name: "milestone_payment_terms_days", err: errors.New(`ent: missing required field "InvoiceSetting.milestone_payment_terms_days"`)}
+	}
+	if v, ok := _c.mutation.MilestonePaymentTermsDays(); ok {
+		if err := invoicesetting.MilestonePaymentTermsDaysValidator(v); err != nil {
+			return &ValidationError{Name: "milestone_payment_terms_days", err: fmt.Errorf(`ent: validator failed for field "InvoiceSetting.milestone_payment_terms_days": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreditHoldDays(); !ok {
+		return &ValidationError{Name: "credit_hold_days", err: errors.New(`ent: missing required field "InvoiceSetting.credit_hold_days"`)}
+	}
+	if v, ok := _c.mutation.CreditHoldDays(); ok {
+		if err := invoicesetting.CreditHoldDaysValidator(v); err != nil {
+			return &ValidationError{Name: "credit_hold_days", err: fmt.Errorf(`ent: validator failed for field "InvoiceSetting.credit_hold_days": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InvoiceSetting.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "InvoiceSetting.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *InvoiceSettingCreate) sqlSave(ctx context.Context) (*InvoiceSetting, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InvoiceSettingCreate) createSpec() (*InvoiceSetting, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvoiceSetting{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(invoicesetting.Table, sqlgraph.NewFieldSpec(invoicesetting.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(invoicesetting.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.AutoIssueOrders(); ok {
+		_spec.SetField(invoicesetting.FieldAutoIssueOrders, field.TypeBool, value)
+		_node.AutoIssueOrders = value
+	}
+	if value, ok := _c.mutation.AutoIssueMilestones(); ok {
+		_spec.SetField(invoicesetting.FieldAutoIssueMilestones, field.TypeBool, value)
+		_node.AutoIssueMilestones = value
+	}
+	if value, ok := _c.mutation.OrderPaymentTermsDays(); ok {
+		_spec.SetField(invoicesetting.FieldOrderPaymentTermsDays, field.TypeInt, value)
+		_node.OrderPaymentTermsDays = value
+	}
+	if value, ok := _c.mutation.MilestonePaymentTermsDays(); ok {
+		_spec.SetField(invoicesetting.FieldMilestonePaymentTermsDays, field.TypeInt, value)
+		_node.MilestonePaymentTermsDays = value
+	}
+	if value, ok := _c.mutation.CreditHoldDays(); ok {
+		_spec.SetField(invoicesetting.FieldCreditHoldDays, field.TypeInt, value)
+		_node.CreditHoldDays = value
+	}
+	if value, ok := _c.mutation.DefaultTaxRate(); ok {
+		_spec.SetField(invoicesetting.FieldDefaultTaxRate, field.TypeFloat64, value)
+		_node.DefaultTaxRate = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(invoicesetting.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(invoicesetting.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvoiceSetting.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceSettingUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvoiceSettingCreate) OnConflict(opts ...sql.ConflictOption) *InvoiceSettingUpsertOne {
+	_c.conflict = opts
+	return &InvoiceSettingUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvoiceSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvoiceSettingCreate) OnConflictColumns(columns ...string) *InvoiceSettingUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceSettingUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// InvoiceSettingUpsertOne is the builder for "upsert"-ing
+	//  one InvoiceSetting node.
+	InvoiceSettingUpsertOne struct {
+		create *InvoiceSettingCreate
+	}
+
+	// InvoiceSettingUpsert is the "OnConflict" setter.
+	InvoiceSettingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *InvoiceSettingUpsert) SetTenantID(v uuid.UUID) *InvoiceSettingUpsert {
+	u.Set(invoicesetting.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *InvoiceSettingUpsert) UpdateTenantID() *InvoiceSettingUpsert {
+	u.SetExcluded(invoicesetting.FieldTenantID)
+	return u
+}
+
+// SetAutoIssueOrders sets the "auto_issue_orders" field.
+func (u *InvoiceSettingUpsert) SetAutoIssueOrders(v bool) *InvoiceSettingUpsert {
+	u.Set(invoicesetting.FieldAutoIssueOrders, v)
+	return u
+}
+
+// UpdateAutoIssueOrders sets the "auto_issue_orders" field to the value that was provided on create.
+func (u *InvoiceSettingUpsert) UpdateAutoIssueOrders() *InvoiceSettingUpsert {
+	u.SetExcluded(invoicesetting.FieldAutoIssueOrders)
+	return u
+}
+
+// SetAutoIssueMilestones sets the "auto_issue_milestones" field.
+func (u *InvoiceSettingUpsert) SetAutoIssueMilestones(v bool) *InvoiceSettingUpsert {
+	u.Set(invoicesetting.FieldAutoIssueMilestones, v)
+	return u
+}
+
+// UpdateAutoIssueMilestones sets the "auto_issue_milestones" field to the value that was provided on create.
+func (u *InvoiceSettingUpsert) UpdateAutoIssueMilestones() *InvoiceSettingUpsert {
+	u.SetExcluded(invoicesetting.FieldAutoIssueMilestones)
+	return u
+}
+
+// SetOrderPaymentTermsDays sets the "order_payment_terms_days" field.
+func (u *InvoiceSettingUpsert) SetOrderPaymentTermsDays(v int) *InvoiceSettingUpsert {
+	u.Set(invoicesetting.FieldOrderPaymentTermsDays, v)
+	return u
+}
+
+// UpdateOrderPaymentTermsDays sets the "order_payment_terms_days" field to the value that was provided on create.
+func (u *InvoiceSettingUpsert) UpdateOrderPaymentTermsDays() *InvoiceSettingUpsert {
+	u.SetExcluded(invoicesetting.FieldOrderPaymentTermsDays)
+	return u
+}
+
+// AddOrderPaymentTermsDays adds v to the "order_payment_terms_days" field.
+func (u *InvoiceSettingUpsert) AddOrderPaymentTermsDays(v int) *InvoiceSettingUpsert {
+	u.Add(invoicesetting.FieldOrderPaymentTermsDays, v)
+	return u
+}
+
+// SetMilestonePaymentTermsDays sets the "milestone_payment_terms_days" field.
+func (u *InvoiceSettingUpsert) SetMilestonePaymentTermsDays(v int) *InvoiceSettingUpsert {
+	u.Set(invoicesetting.FieldMilestonePaymentTermsDays, v)
+	return u
+}
+
+// UpdateMilestonePaymentTermsDays sets the "milestone_payment_terms_days" field to the value that was provided on create.
+func (u *InvoiceSettingUpsert) UpdateMilestonePaymentTermsDays() *InvoiceSettingUpsert {
+	u.SetExcluded(invoicesetting.FieldMilestonePaymentTermsDays)
+	return u
+}
+
+// AddMilestonePaymentTermsDays adds v to the "milestone_payment_terms_days" field.
+func (u *InvoiceSettingUpsert) AddMilestonePaymentTermsDays(v int) *InvoiceSettingUpsert {
+	u.Add(invoicesetting.FieldMilestonePaymentTermsDays, v)
+	return u
+}
+
+// SetCreditHoldDays sets the "credit_hold_days" field.
+func (u *InvoiceSettingUpsert) SetCreditHoldDays(v int) *InvoiceSettingUpsert {
+	u.Set(invoicesetting.FieldCreditHoldDays, v)
+	return u
+}
+
+// UpdateCreditHoldDays sets the "credit_hold_days" field to the value that was provided on create.
+func (u *InvoiceSettingUpsert) UpdateCreditHoldDays() *InvoiceSettingUpsert {
+	u.SetExcluded(invoicesetting.FieldCreditHoldDays)
+	return u
+}
+
+// AddCreditHoldDays adds v to the "credit_hold_days" field.
+func (u *InvoiceSettingUpsert) AddCreditHoldDays(v int) *InvoiceSettingUpsert {
+	u.Add(invoicesetting.FieldCreditHoldDays, v)
+	return u
+}
+
+// SetDefaultTaxRate sets the "default_tax_rate" field.
+func (u *InvoiceSettingUpsert) SetDefaultTaxRate(v decimal.Decimal) *InvoiceSettingUpsert {
+	u.Set(invoicesetting.FieldDefaultTaxRate, v)
+	return u
+}
+
+// UpdateDefaultTaxRate sets the "default_tax_rate" field to the value that was provided on create.
+func (u *InvoiceSettingUpsert) UpdateDefaultTaxRate() *InvoiceSettingUpsert {
+	u.SetExcluded(invoicesetting.FieldDefaultTaxRate)
+	return u
+}
+
+// AddDefaultTaxRate adds v to the "default_tax_rate" field.
+func (u *InvoiceSettingUpsert) AddDefaultTaxRate(v decimal.Decimal) *InvoiceSettingUpsert {
+	u.Add(invoicesetting.FieldDefaultTaxRate, v)
+	return u
+}
+
+// ClearDefaultTaxRate clears the value of the "default_tax_rate" field.
+func (u *InvoiceSettingUpsert) ClearDefaultTaxRate() *InvoiceSettingUpsert {
+	u.SetNull(invoicesetting.FieldDefaultTaxRate)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvoiceSettingUpsert) SetUpdatedAt(v time.Time) *InvoiceSettingUpsert {
+	u.Set(invoicesetting.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvoiceSettingUpsert) UpdateUpdatedAt() *InvoiceSettingUpsert {
+	u.SetExcluded(invoicesetting.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.InvoiceSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invoicesetting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvoiceSettingUpsertOne) UpdateNewValues() *InvoiceSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(invoicesetting.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(invoicesetting.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvoiceSetting.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InvoiceSettingUpsertOne) Ignore() *InvoiceSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceSettingUpsertOne) DoNothing() *InvoiceSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceSettingCreate.OnConflict
+// documentation for more info.
+func (u *InvoiceSettingUpsertOne) Update(set func(*InvoiceSettingUpsert)) *InvoiceSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *InvoiceSettingUpsertOne) SetTenantID(v uuid.UUID) *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *InvoiceSettingUpsertOne) UpdateTenantID() *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetAutoIssueOrders sets the "auto_issue_orders" field.
+func (u *InvoiceSettingUpsertOne) SetAutoIssueOrders(v bool) *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.SetAutoIssueOrders(v)
+	})
+}
+
+// UpdateAutoIssueOrders sets the "auto_issue_orders" field to the value that was provided on create.
+func (u *InvoiceSettingUpsertOne) UpdateAutoIssueOrders() *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.UpdateAutoIssueOrders()
+	})
+}
+
+// SetAutoIssueMilestones sets the "auto_issue_milestones" field.
+func (u *InvoiceSettingUpsertOne) SetAutoIssueMilestones(v bool) *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.SetAutoIssueMilestones(v)
+	})
+}
+
+// UpdateAutoIssueMilestones sets the "auto_issue_milestones" field to the value that was provided on create.
+func (u *InvoiceSettingUpsertOne) UpdateAutoIssueMilestones() *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.UpdateAutoIssueMilestones()
+	})
+}
+
+// SetOrderPaymentTermsDays sets the "order_payment_terms_days" field.
+func (u *InvoiceSettingUpsertOne) SetOrderPaymentTermsDays(v int) *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.SetOrderPaymentTermsDays(v)
+	})
+}
+
+// AddOrderPaymentTermsDays adds v to the "order_payment_terms_days" field.
+func (u *InvoiceSettingUpsertOne) AddOrderPaymentTermsDays(v int) *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.AddOrderPaymentTermsDays(v)
+	})
+}
+
+// UpdateOrderPaymentTermsDays sets the "order_payment_terms_days" field to the value that was provided on create.
+func (u *InvoiceSettingUpsertOne) UpdateOrderPaymentTermsDays() *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.UpdateOrderPaymentTermsDays()
+	})
+}
+
+// SetMilestonePaymentTermsDays sets the "milestone_payment_terms_days" field.
+func (u *InvoiceSettingUpsertOne) SetMilestonePaymentTermsDays(v int) *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.SetMilestonePaymentTermsDays(v)
+	})
+}
+
+// AddMilestonePaymentTermsDays adds v to the "milestone_payment_terms_days" field.
+func (u *InvoiceSettingUpsertOne) AddMilestonePaymentTermsDays(v int) *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.AddMilestonePaymentTermsDays(v)
+	})
+}
+
+// UpdateMilestonePaymentTermsDays sets the "milestone_payment_terms_days" field to the value that was provided on create.
+func (u *InvoiceSettingUpsertOne) UpdateMilestonePaymentTermsDays() *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.UpdateMilestonePaymentTermsDays()
+	})
+}
+
+// SetCreditHoldDays sets the "credit_hold_days" field.
+func (u *InvoiceSettingUpsertOne) SetCreditHoldDays(v int) *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.SetCreditHoldDays(v)
+	})
+}
+
+// AddCreditHoldDays adds v to the "credit_hold_days" field.
+func (u *InvoiceSettingUpsertOne) AddCreditHoldDays(v int) *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.AddCreditHoldDays(v)
+	})
+}
+
+// UpdateCreditHoldDays sets the "credit_hold_days" field to the value that was provided on create.
+func (u *InvoiceSettingUpsertOne) UpdateCreditHoldDays() *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.UpdateCreditHoldDays()
+	})
+}
+
+// SetDefaultTaxRate sets the "default_tax_rate" field.
+func (u *InvoiceSettingUpsertOne) SetDefaultTaxRate(v decimal.Decimal) *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.SetDefaultTaxRate(v)
+	})
+}
+
+// AddDefaultTaxRate adds v to the "default_tax_rate" field.
+func (u *InvoiceSettingUpsertOne) AddDefaultTaxRate(v decimal.Decimal) *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.AddDefaultTaxRate(v)
+	})
+}
+
+// UpdateDefaultTaxRate sets the "default_tax_rate" field to the value that was provided on create.
+func (u *InvoiceSettingUpsertOne) UpdateDefaultTaxRate() *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.UpdateDefaultTaxRate()
+	})
+}
+
+// ClearDefaultTaxRate clears the value of the "default_tax_rate" field.
+func (u *InvoiceSettingUpsertOne) ClearDefaultTaxRate() *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.ClearDefaultTaxRate()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvoiceSettingUpsertOne) SetUpdatedAt(v time.Time) *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvoiceSettingUpsertOne) UpdateUpdatedAt() *InvoiceSettingUpsertOne {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceSettingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoiceSettingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceSettingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InvoiceSettingUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: InvoiceSettingUpsertOne.ID is not supported by MySQL driver. Use InvoiceSettingUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InvoiceSettingUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InvoiceSettingCreateBulk is the builder for creating many InvoiceSetting entities in bulk.
+type InvoiceSettingCreateBulk struct {
+	config
+	err      error
+	builders []*InvoiceSettingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the InvoiceSetting entities in the database.
+func (_c *InvoiceSettingCreateBulk) Save(ctx context.Context) ([]*InvoiceSetting, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InvoiceSetting, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceSettingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InvoiceSettingCreateBulk) SaveX(ctx context.Context) []*InvoiceSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceSettingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceSettingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvoiceSetting.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceSettingUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvoiceSettingCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvoiceSettingUpsertBulk {
+	_c.conflict = opts
+	return &InvoiceSettingUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvoiceSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvoiceSettingCreateBulk) OnConflictColumns(columns ...string) *InvoiceSettingUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceSettingUpsertBulk{
+		create: _c,
+	}
+}
+
+// InvoiceSettingUpsertBulk is the builder for "upsert"-ing
+// a bulk of InvoiceSetting nodes.
+type InvoiceSettingUpsertBulk struct {
+	create *InvoiceSettingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.InvoiceSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invoicesetting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvoiceSettingUpsertBulk) UpdateNewValues() *InvoiceSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(invoicesetting.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(invoicesetting.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvoiceSetting.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InvoiceSettingUpsertBulk) Ignore() *InvoiceSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceSettingUpsertBulk) DoNothing() *InvoiceSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceSettingCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvoiceSettingUpsertBulk) Update(set func(*InvoiceSettingUpsert)) *InvoiceSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *InvoiceSettingUpsertBulk) SetTenantID(v uuid.UUID) *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *InvoiceSettingUpsertBulk) UpdateTenantID() *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetAutoIssueOrders sets the "auto_issue_orders" field.
+func (u *InvoiceSettingUpsertBulk) SetAutoIssueOrders(v bool) *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.SetAutoIssueOrders(v)
+	})
+}
+
+// UpdateAutoIssueOrders sets the "auto_issue_orders" field to the value that was provided on create.
+func (u *InvoiceSettingUpsertBulk) UpdateAutoIssueOrders() *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.UpdateAutoIssueOrders()
+	})
+}
+
+// SetAutoIssueMilestones sets the "auto_issue_milestones" field.
+func (u *InvoiceSettingUpsertBulk) SetAutoIssueMilestones(v bool) *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.SetAutoIssueMilestones(v)
+	})
+}
+
+// UpdateAutoIssueMilestones sets the "auto_issue_milestones" field to the value that was provided on create.
+func (u *InvoiceSettingUpsertBulk) UpdateAutoIssueMilestones() *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.UpdateAutoIssueMilestones()
+	})
+}
+
+// SetOrderPaymentTermsDays sets the "order_payment_terms_days" field.
+func (u *InvoiceSettingUpsertBulk) SetOrderPaymentTermsDays(v int) *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.SetOrderPaymentTermsDays(v)
+	})
+}
+
+// AddOrderPaymentTermsDays adds v to the "order_payment_terms_days" field.
+func (u *InvoiceSettingUpsertBulk) AddOrderPaymentTermsDays(v int) *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.AddOrderPaymentTermsDays(v)
+	})
+}
+
+// UpdateOrderPaymentTermsDays sets the "order_payment_terms_days" field to the value that was provided on create.
+func (u *InvoiceSettingUpsertBulk) UpdateOrderPaymentTermsDays() *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.UpdateOrderPaymentTermsDays()
+	})
+}
+
+// SetMilestonePaymentTermsDays sets the "milestone_payment_terms_days" field.
+func (u *InvoiceSettingUpsertBulk) SetMilestonePaymentTermsDays(v int) *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.SetMilestonePaymentTermsDays(v)
+	})
+}
+
+// AddMilestonePaymentTermsDays adds v to the "milestone_payment_terms_days" field.
+func (u *InvoiceSettingUpsertBulk) AddMilestonePaymentTermsDays(v int) *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.AddMilestonePaymentTermsDays(v)
+	})
+}
+
+// UpdateMilestonePaymentTermsDays sets the "milestone_payment_terms_days" field to the value that was provided on create.
+func (u *InvoiceSettingUpsertBulk) UpdateMilestonePaymentTermsDays() *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.UpdateMilestonePaymentTermsDays()
+	})
+}
+
+// SetCreditHoldDays sets the "credit_hold_days" field.
+func (u *InvoiceSettingUpsertBulk) SetCreditHoldDays(v int) *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.SetCreditHoldDays(v)
+	})
+}
+
+// AddCreditHoldDays adds v to the "credit_hold_days" field.
+func (u *InvoiceSettingUpsertBulk) AddCreditHoldDays(v int) *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.AddCreditHoldDays(v)
+	})
+}
+
+// UpdateCreditHoldDays sets the "credit_hold_days" field to the value that was provided on create.
+func (u *InvoiceSettingUpsertBulk) UpdateCreditHoldDays() *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.UpdateCreditHoldDays()
+	})
+}
+
+// SetDefaultTaxRate sets the "default_tax_rate" field.
+func (u *InvoiceSettingUpsertBulk) SetDefaultTaxRate(v decimal.Decimal) *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.SetDefaultTaxRate(v)
+	})
+}
+
+// AddDefaultTaxRate adds v to the "default_tax_rate" field.
+func (u *InvoiceSettingUpsertBulk) AddDefaultTaxRate(v decimal.Decimal) *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.AddDefaultTaxRate(v)
+	})
+}
+
+// UpdateDefaultTaxRate sets the "default_tax_rate" field to the value that was provided on create.
+func (u *InvoiceSettingUpsertBulk) UpdateDefaultTaxRate() *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.UpdateDefaultTaxRate()
+	})
+}
+
+// ClearDefaultTaxRate clears the value of the "default_tax_rate" field.
+func (u *InvoiceSettingUpsertBulk) ClearDefaultTaxRate() *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.ClearDefaultTaxRate()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvoiceSettingUpsertBulk) SetUpdatedAt(v time.Time) *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvoiceSettingUpsertBulk) UpdateUpdatedAt() *InvoiceSettingUpsertBulk {
+	return u.Update(func(s *InvoiceSettingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceSettingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InvoiceSettingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoiceSettingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceSettingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
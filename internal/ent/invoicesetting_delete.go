@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/invoicesetting"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+)
+
+// InvoiceSettingDelete is the builder for deleting a InvoiceSetting entity.
+type InvoiceSettingDelete struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceSettingMutation
+}
+
+// Where appends a list predicates to the InvoiceSettingDelete builder.
+func (_d *InvoiceSettingDelete) Where(ps ...predicate.InvoiceSetting) *InvoiceSettingDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *InvoiceSettingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *InvoiceSettingDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *InvoiceSettingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(invoicesetting.Table, sqlgraph.NewFieldSpec(invoicesetting.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// InvoiceSettingDeleteOne is the builder for deleting a single InvoiceSetting entity.
+type InvoiceSettingDeleteOne struct {
+	_d *InvoiceSettingDelete
+}
+
+// Where appends a list predicates to the InvoiceSettingDelete builder.
+func (_d *InvoiceSettingDeleteOne) Where(ps ...predicate.InvoiceSetting) *InvoiceSettingDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *InvoiceSettingDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{invoicesetting.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *InvoiceSettingDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
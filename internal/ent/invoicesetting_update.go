@@ -0,0 +1,596 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/invoicesetting"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceSettingUpdate is the builder for updating InvoiceSetting entities.
+type InvoiceSettingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceSettingMutation
+}
+
+// Where appends a list predicates to the InvoiceSettingUpdate builder.
+func (_u *InvoiceSettingUpdate) Where(ps ...predicate.InvoiceSetting) *InvoiceSettingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *InvoiceSettingUpdate) SetTenantID(v uuid.UUID) *InvoiceSettingUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *InvoiceSettingUpdate) SetNillableTenantID(v *uuid.UUID) *InvoiceSettingUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetAutoIssueOrders sets the "auto_issue_orders" field.
+func (_u *InvoiceSettingUpdate) SetAutoIssueOrders(v bool) *InvoiceSettingUpdate {
+	_u.mutation.SetAutoIssueOrders(v)
+	return _u
+}
+
+// SetNillableAutoIssueOrders sets the "auto_issue_orders" field if the given value is not nil.
+func (_u *InvoiceSettingUpdate) SetNillableAutoIssueOrders(v *bool) *InvoiceSettingUpdate {
+	if v != nil {
+		_u.SetAutoIssueOrders(*v)
+	}
+	return _u
+}
+
+// SetAutoIssueMilestones sets the "auto_issue_milestones" field.
+func (_u *InvoiceSettingUpdate) SetAutoIssueMilestones(v bool) *InvoiceSettingUpdate {
+	_u.mutation.SetAutoIssueMilestones(v)
+	return _u
+}
+
+// SetNillableAutoIssueMilestones sets the "auto_issue_milestones" field if the given value is not nil.
+func (_u *InvoiceSettingUpdate) SetNillableAutoIssueMilestones(v *bool) *InvoiceSettingUpdate {
+	if v != nil {
+		_u.SetAutoIssueMilestones(*v)
+	}
+	return _u
+}
+
+// SetOrderPaymentTermsDays sets the "order_payment_terms_days" field.
+func (_u *InvoiceSettingUpdate) SetOrderPaymentTermsDays(v int) *InvoiceSettingUpdate {
+	_u.mutation.ResetOrderPaymentTermsDays()
+	_u.mutation.SetOrderPaymentTermsDays(v)
+	return _u
+}
+
+// SetNillableOrderPaymentTermsDays sets the "order_payment_terms_days" field if the given value is not nil.
+func (_u *InvoiceSettingUpdate) SetNillableOrderPaymentTermsDays(v *int) *InvoiceSettingUpdate {
+	if v != nil {
+		_u.SetOrderPaymentTermsDays(*v)
+	}
+	return _u
+}
+
+// AddOrderPaymentTermsDays adds value to the "order_payment_terms_days" field.
+func (_u *InvoiceSettingUpdate) AddOrderPaymentTermsDays(v int) *InvoiceSettingUpdate {
+	_u.mutation.AddOrderPaymentTermsDays(v)
+	return _u
+}
+
+// SetMilestonePaymentTermsDays sets the "milestone_payment_terms_days" field.
+func (_u *InvoiceSettingUpdate) SetMilestonePaymentTermsDays(v int) *InvoiceSettingUpdate {
+	_u.mutation.ResetMilestonePaymentTermsDays()
+	_u.mutation.SetMilestonePaymentTermsDays(v)
+	return _u
+}
+
+// SetNillableMilestonePaymentTermsDays sets the "milestone_payment_terms_days" field if the given value is not nil.
+func (_u *InvoiceSettingUpdate) SetNillableMilestonePaymentTermsDays(v *int) *InvoiceSettingUpdate {
+	if v != nil {
+		_u.SetMilestonePaymentTermsDays(*v)
+	}
+	return _u
+}
+
+// AddMilestonePaymentTermsDays adds value to the "milestone_payment_terms_days" field.
+func (_u *InvoiceSettingUpdate) AddMilestonePaymentTermsDays(v int) *InvoiceSettingUpdate {
+	_u.mutation.AddMilestonePaymentTermsDays(v)
+	return _u
+}
+
+// SetCreditHoldDays sets the "credit_hold_days" field.
+func (_u *InvoiceSettingUpdate) SetCreditHoldDays(v int) *InvoiceSettingUpdate {
+	_u.mutation.ResetCreditHoldDays()
+	_u.mutation.SetCreditHoldDays(v)
+	return _u
+}
+
+// SetNillableCreditHoldDays sets the "credit_hold_days" field if the given value is not nil.
+func (_u *InvoiceSettingUpdate) SetNillableCreditHoldDays(v *int) *InvoiceSettingUpdate {
+	if v != nil {
+		_u.SetCreditHoldDays(*v)
+	}
+	return _u
+}
+
+// AddCreditHoldDays adds value to the "credit_hold_days" field.
+func (_u *InvoiceSettingUpdate) AddCreditHoldDays(v int) *InvoiceSettingUpdate {
+	_u.mutation.AddCreditHoldDays(v)
+	return _u
+}
+
+// SetDefaultTaxRate sets the "default_tax_rate" field.
+func (_u *InvoiceSettingUpdate) SetDefaultTaxRate(v decimal.Decimal) *InvoiceSettingUpdate {
+	_u.mutation.ResetDefaultTaxRate()
+	_u.mutation.SetDefaultTaxRate(v)
+	return _u
+}
+
+// SetNillableDefaultTaxRate sets the "default_tax_rate" field if the given value is not nil.
+func (_u *InvoiceSettingUpdate) SetNillableDefaultTaxRate(v *decimal.Decimal) *InvoiceSettingUpdate {
+	if v != nil {
+		_u.SetDefaultTaxRate(*v)
+	}
+	return _u
+}
+
+// AddDefaultTaxRate adds value to the "default_tax_rate" field.
+func (_u *InvoiceSettingUpdate) AddDefaultTaxRate(v decimal.Decimal) *InvoiceSettingUpdate {
+	_u.mutation.AddDefaultTaxRate(v)
+	return _u
+}
+
+// ClearDefaultTaxRate clears the value of the "default_tax_rate" field.
+func (_u *InvoiceSettingUpdate) ClearDefaultTaxRate() *InvoiceSettingUpdate {
+	_u.mutation.ClearDefaultTaxRate()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InvoiceSettingUpdate) SetUpdatedAt(v time.Time) *InvoiceSettingUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the InvoiceSettingMutation object of the builder.
+func (_u *InvoiceSettingUpdate) Mutation() *InvoiceSettingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InvoiceSettingUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoiceSettingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InvoiceSettingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoiceSettingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *InvoiceSettingUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := invoicesetting.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvoiceSettingUpdate) check() error {
+	if v, ok := _u.mutation.OrderPaymentTermsDays(); ok {
+		if err := invoicesetting.OrderPaymentTermsDaysValidator(v); err != nil {
+			return &ValidationError{Name: "order_payment_terms_days", err: fmt.Errorf(`ent: validator failed for field "InvoiceSetting.order_payment_terms_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MilestonePaymentTermsDays(); ok {
+		if err := invoicesetting.MilestonePaymentTermsDaysValidator(v); err != nil {
+			return &ValidationError{Name: "milestone_payment_terms_days", err: fmt.Errorf(`ent: validator failed for field "InvoiceSetting.milestone_payment_terms_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreditHoldDays(); ok {
+		if err := invoicesetting.CreditHoldDaysValidator(v); err != nil {
+			return &ValidationError{Name: "credit_hold_days", err: fmt.Errorf(`ent: validator failed for field "InvoiceSetting.credit_hold_days": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InvoiceSettingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoicesetting.Table, invoicesetting.Columns, sqlgraph.NewFieldSpec(invoicesetting.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(invoicesetting.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.AutoIssueOrders(); ok {
+		_spec.SetField(invoicesetting.FieldAutoIssueOrders, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AutoIssueMilestones(); ok {
+		_spec.SetField(invoicesetting.FieldAutoIssueMilestones, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.OrderPaymentTermsDays(); ok {
+		_spec.SetField(invoicesetting.FieldOrderPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderPaymentTermsDays(); ok {
+		_spec.AddField(invoicesetting.FieldOrderPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MilestonePaymentTermsDays(); ok {
+		_spec.SetField(invoicesetting.FieldMilestonePaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMilestonePaymentTermsDays(); ok {
+		_spec.AddField(invoicesetting.FieldMilestonePaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreditHoldDays(); ok {
+		_spec.SetField(invoicesetting.FieldCreditHoldDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreditHoldDays(); ok {
+		_spec.AddField(invoicesetting.FieldCreditHoldDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DefaultTaxRate(); ok {
+		_spec.SetField(invoicesetting.FieldDefaultTaxRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultTaxRate(); ok {
+		_spec.AddField(invoicesetting.FieldDefaultTaxRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.DefaultTaxRateCleared() {
+		_spec.ClearField(invoicesetting.FieldDefaultTaxRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(invoicesetting.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoicesetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InvoiceSettingUpdateOne is the builder for updating a single InvoiceSetting entity.
+type InvoiceSettingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvoiceSettingMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *InvoiceSettingUpdateOne) SetTenantID(v uuid.UUID) *InvoiceSettingUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *InvoiceSettingUpdateOne) SetNillableTenantID(v *uuid.UUID) *InvoiceSettingUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetAutoIssueOrders sets the "auto_issue_orders" field.
+func (_u *InvoiceSettingUpdateOne) SetAutoIssueOrders(v bool) *InvoiceSettingUpdateOne {
+	_u.mutation.SetAutoIssueOrders(v)
+	return _u
+}
+
+// SetNillableAutoIssueOrders sets the "auto_issue_orders" field if the given value is not nil.
+func (_u *InvoiceSettingUpdateOne) SetNillableAutoIssueOrders(v *bool) *InvoiceSettingUpdateOne {
+	if v != nil {
+		_u.SetAutoIssueOrders(*v)
+	}
+	return _u
+}
+
+// SetAutoIssueMilestones sets the "auto_issue_milestones" field.
+func (_u *InvoiceSettingUpdateOne) SetAutoIssueMilestones(v bool) *InvoiceSettingUpdateOne {
+	_u.mutation.SetAutoIssueMilestones(v)
+	return _u
+}
+
+// SetNillableAutoIssueMilestones sets the "auto_issue_milestones" field if the given value is not nil.
+func (_u *InvoiceSettingUpdateOne) SetNillableAutoIssueMilestones(v *bool) *InvoiceSettingUpdateOne {
+	if v != nil {
+		_u.SetAutoIssueMilestones(*v)
+	}
+	return _u
+}
+
+// SetOrderPaymentTermsDays sets the "order_payment_terms_days" field.
+func (_u *InvoiceSettingUpdateOne) SetOrderPaymentTermsDays(v int) *InvoiceSettingUpdateOne {
+	_u.mutation.ResetOrderPaymentTermsDays()
+	_u.mutation.SetOrderPaymentTermsDays(v)
+	return _u
+}
+
+// SetNillableOrderPaymentTermsDays sets the "order_payment_terms_days" field if the given value is not nil.
+func (_u *InvoiceSettingUpdateOne) SetNillableOrderPaymentTermsDays(v *int) *InvoiceSettingUpdateOne {
+	if v != nil {
+		_u.SetOrderPaymentTermsDays(*v)
+	}
+	return _u
+}
+
+// AddOrderPaymentTermsDays adds value to the "order_payment_terms_days" field.
+func (_u *InvoiceSettingUpdateOne) AddOrderPaymentTermsDays(v int) *InvoiceSettingUpdateOne {
+	_u.mutation.AddOrderPaymentTermsDays(v)
+	return _u
+}
+
+// SetMilestonePaymentTermsDays sets the "milestone_payment_terms_days" field.
+func (_u *InvoiceSettingUpdateOne) SetMilestonePaymentTermsDays(v int) *InvoiceSettingUpdateOne {
+	_u.mutation.ResetMilestonePaymentTermsDays()
+	_u.mutation.SetMilestonePaymentTermsDays(v)
+	return _u
+}
+
+// SetNillableMilestonePaymentTermsDays sets the "milestone_payment_terms_days" field if the given value is not nil.
+func (_u *InvoiceSettingUpdateOne) SetNillableMilestonePaymentTermsDays(v *int) *InvoiceSettingUpdateOne {
+	if v != nil {
+		_u.SetMilestonePaymentTermsDays(*v)
+	}
+	return _u
+}
+
+// AddMilestonePaymentTermsDays adds value to the "milestone_payment_terms_days" field.
+func (_u *InvoiceSettingUpdateOne) AddMilestonePaymentTermsDays(v int) *InvoiceSettingUpdateOne {
+	_u.mutation.AddMilestonePaymentTermsDays(v)
+	return _u
+}
+
+// SetCreditHoldDays sets the "credit_hold_days" field.
+func (_u *InvoiceSettingUpdateOne) SetCreditHoldDays(v int) *InvoiceSettingUpdateOne {
+	_u.mutation.ResetCreditHoldDays()
+	_u.mutation.SetCreditHoldDays(v)
+	return _u
+}
+
+// SetNillableCreditHoldDays sets the "credit_hold_days" field if the given value is not nil.
+func (_u *InvoiceSettingUpdateOne) SetNillableCreditHoldDays(v *int) *InvoiceSettingUpdateOne {
+	if v != nil {
+		_u.SetCreditHoldDays(*v)
+	}
+	return _u
+}
+
+// AddCreditHoldDays adds value to the "credit_hold_days" field.
+func (_u *InvoiceSettingUpdateOne) AddCreditHoldDays(v int) *InvoiceSettingUpdateOne {
+	_u.mutation.AddCreditHoldDays(v)
+	return _u
+}
+
+// SetDefaultTaxRate sets the "default_tax_rate" field.
+func (_u *InvoiceSettingUpdateOne) SetDefaultTaxRate(v decimal.Decimal) *InvoiceSettingUpdateOne {
+	_u.mutation.ResetDefaultTaxRate()
+	_u.mutation.SetDefaultTaxRate(v)
+	return _u
+}
+
+// SetNillableDefaultTaxRate sets the "default_tax_rate" field if the given value is not nil.
+func (_u *InvoiceSettingUpdateOne) SetNillableDefaultTaxRate(v *decimal.Decimal) *InvoiceSettingUpdateOne {
+	if v != nil {
+		_u.SetDefaultTaxRate(*v)
+	}
+	return _u
+}
+
+// AddDefaultTaxRate adds value to the "default_tax_rate" field.
+func (_u *InvoiceSettingUpdateOne) AddDefaultTaxRate(v decimal.Decimal) *InvoiceSettingUpdateOne {
+	_u.mutation.AddDefaultTaxRate(v)
+	return _u
+}
+
+// ClearDefaultTaxRate clears the value of the "default_tax_rate" field.
+func (_u *InvoiceSettingUpdateOne) ClearDefaultTaxRate() *InvoiceSettingUpdateOne {
+	_u.mutation.ClearDefaultTaxRate()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InvoiceSettingUpdateOne) SetUpdatedAt(v time.Time) *InvoiceSettingUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the InvoiceSettingMutation object of the builder.
+func (_u *InvoiceSettingUpdateOne) Mutation() *InvoiceSettingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the InvoiceSettingUpdate builder.
+func (_u *InvoiceSettingUpdateOne) Where(ps ...predicate.InvoiceSetting) *InvoiceSettingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InvoiceSettingUpdateOne) Select(field string, fields ...string) *InvoiceSettingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InvoiceSetting entity.
+func (_u *InvoiceSettingUpdateOne) Save(ctx context.Context) (*InvoiceSetting, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoiceSettingUpdateOne) SaveX(ctx context.Context) *InvoiceSetting {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InvoiceSettingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoiceSettingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *InvoiceSettingUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := invoicesetting.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvoiceSettingUpdateOne) check() error {
+	if v, ok := _u.mutation.OrderPaymentTermsDays(); ok {
+		if err := invoicesetting.OrderPaymentTermsDaysValidator(v); err != nil {
+			return &ValidationError{Name: "order_payment_terms_days", err: fmt.Errorf(`ent: validator failed for field "InvoiceSetting.order_payment_terms_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MilestonePaymentTermsDays(); ok {
+		if err := invoicesetting.MilestonePaymentTermsDaysValidator(v); err != nil {
+			return &ValidationError{Name: "milestone_payment_terms_days", err: fmt.Errorf(`ent: validator failed for field "InvoiceSetting.milestone_payment_terms_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreditHoldDays(); ok {
+		if err := invoicesetting.CreditHoldDaysValidator(v); err != nil {
+			return &ValidationError{Name: "credit_hold_days", err: fmt.Errorf(`ent: validator failed for field "InvoiceSetting.credit_hold_days": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InvoiceSettingUpdateOne) sqlSave(ctx context.Context) (_node *InvoiceSetting, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoicesetting.Table, invoicesetting.Columns, sqlgraph.NewFieldSpec(invoicesetting.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InvoiceSetting.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoicesetting.FieldID)
+		for _, f := range fields {
+			if !invoicesetting.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invoicesetting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(invoicesetting.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.AutoIssueOrders(); ok {
+		_spec.SetField(invoicesetting.FieldAutoIssueOrders, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AutoIssueMilestones(); ok {
+		_spec.SetField(invoicesetting.FieldAutoIssueMilestones, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.OrderPaymentTermsDays(); ok {
+		_spec.SetField(invoicesetting.FieldOrderPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderPaymentTermsDays(); ok {
+		_spec.AddField(invoicesetting.FieldOrderPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MilestonePaymentTermsDays(); ok {
+		_spec.SetField(invoicesetting.FieldMilestonePaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMilestonePaymentTermsDays(); ok {
+		_spec.AddField(invoicesetting.FieldMilestonePaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreditHoldDays(); ok {
+		_spec.SetField(invoicesetting.FieldCreditHoldDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreditHoldDays(); ok {
+		_spec.AddField(invoicesetting.FieldCreditHoldDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DefaultTaxRate(); ok {
+		_spec.SetField(invoicesetting.FieldDefaultTaxRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultTaxRate(); ok {
+		_spec.AddField(invoicesetting.FieldDefaultTaxRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.DefaultTaxRateCleared() {
+		_spec.ClearField(invoicesetting.FieldDefaultTaxRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(invoicesetting.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &InvoiceSetting{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoicesetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
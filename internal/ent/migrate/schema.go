@@ -3,306 +3,1330 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
-	// ChartOfAccountsColumns holds the columns for the "chart_of_accounts" table.
-	ChartOfAccountsColumns = []*schema.Column{
+	// BankAccountsColumns holds the columns for the "bank_accounts" table.
+	BankAccountsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "tenant_id", Type: field.TypeUUID},
-		{Name: "account_code", Type: field.TypeString},
 		{Name: "account_name", Type: field.TypeString},
+		{Name: "bank_name", Type: field.TypeString},
+		{Name: "branch", Type: field.TypeString, Nullable: true},
 		{Name: "account_type", Type: field.TypeString},
-		{Name: "is_active", Type: field.TypeBool, Default: true},
-		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "account_number_masked", Type: field.TypeString},
+		{Name: "account_number_hash", Type: field.TypeString},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "ledger_account_code", Type: field.TypeString},
+		{Name: "statement_balance", Type: field.TypeFloat64, Nullable: true},
+		{Name: "statement_date", Type: field.TypeTime, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "active"},
 		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
 	}
-	// ChartOfAccountsTable holds the schema information for the "chart_of_accounts" table.
-	ChartOfAccountsTable = &schema.Table{
-		Name:       "chart_of_accounts",
-		Columns:    ChartOfAccountsColumns,
-		PrimaryKey: []*schema.Column{ChartOfAccountsColumns[0]},
-		ForeignKeys: []*schema.ForeignKey{
+	// BankAccountsTable holds the schema information for the "bank_accounts" table.
+	BankAccountsTable = &schema.Table{
+		Name:       "bank_accounts",
+		Columns:    BankAccountsColumns,
+		PrimaryKey: []*schema.Column{BankAccountsColumns[0]},
+		Indexes: []*schema.Index{
 			{
-				Symbol:     "chart_of_accounts_chart_of_accounts_children",
-				Columns:    []*schema.Column{ChartOfAccountsColumns[10]},
-				RefColumns: []*schema.Column{ChartOfAccountsColumns[0]},
-				OnDelete:   schema.SetNull,
+				Name:    "bankaccount_tenant_id_account_number_hash",
+				Unique:  true,
+				Columns: []*schema.Column{BankAccountsColumns[1], BankAccountsColumns[7]},
 			},
-		},
-		Indexes: []*schema.Index{
 			{
-				Name:    "chartofaccount_tenant_id",
+				Name:    "bankaccount_tenant_id_ledger_account_code",
 				Unique:  false,
-				Columns: []*schema.Column{ChartOfAccountsColumns[1]},
+				Columns: []*schema.Column{BankAccountsColumns[1], BankAccountsColumns[9]},
 			},
 			{
-				Name:    "chartofaccount_tenant_id_account_code",
-				Unique:  true,
-				Columns: []*schema.Column{ChartOfAccountsColumns[1], ChartOfAccountsColumns[2]},
+				Name:    "bankaccount_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{BankAccountsColumns[1], BankAccountsColumns[12]},
 			},
+		},
+	}
+	// BankStatementsColumns holds the columns for the "bank_statements" table.
+	BankStatementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "bank_account_id", Type: field.TypeUUID},
+		{Name: "format", Type: field.TypeString},
+		{Name: "profile", Type: field.TypeString, Nullable: true},
+		{Name: "file_name", Type: field.TypeString, Nullable: true},
+		{Name: "file_hash", Type: field.TypeString},
+		{Name: "statement_reference", Type: field.TypeString, Nullable: true},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "period_start", Type: field.TypeTime},
+		{Name: "period_end", Type: field.TypeTime},
+		{Name: "opening_balance", Type: field.TypeFloat64, Nullable: true},
+		{Name: "closing_balance", Type: field.TypeFloat64, Nullable: true},
+		{Name: "continuity", Type: field.TypeString},
+		{Name: "expected_opening_balance", Type: field.TypeFloat64, Nullable: true},
+		{Name: "transaction_count", Type: field.TypeInt},
+		{Name: "duplicate_count", Type: field.TypeInt},
+		{Name: "linked_count", Type: field.TypeInt, Default: 0},
+		{Name: "imported_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// BankStatementsTable holds the schema information for the "bank_statements" table.
+	BankStatementsTable = &schema.Table{
+		Name:       "bank_statements",
+		Columns:    BankStatementsColumns,
+		PrimaryKey: []*schema.Column{BankStatementsColumns[0]},
+		Indexes: []*schema.Index{
 			{
-				Name:    "chartofaccount_account_type",
+				Name:    "bankstatement_bank_account_id_period_end",
 				Unique:  false,
-				Columns: []*schema.Column{ChartOfAccountsColumns[4]},
+				Columns: []*schema.Column{BankStatementsColumns[2], BankStatementsColumns[10]},
 			},
 			{
-				Name:    "chartofaccount_parent_id",
+				Name:    "bankstatement_bank_account_id_file_hash",
 				Unique:  false,
-				Columns: []*schema.Column{ChartOfAccountsColumns[10]},
+				Columns: []*schema.Column{BankStatementsColumns[2], BankStatementsColumns[6]},
 			},
 			{
-				Name:    "chartofaccount_is_active",
+				Name:    "bankstatement_tenant_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{ChartOfAccountsColumns[5]},
+				Columns: []*schema.Column{BankStatementsColumns[1], BankStatementsColumns[19]},
 			},
 		},
 	}
-	// InvoicesColumns holds the columns for the "invoices" table.
-	InvoicesColumns = []*schema.Column{
+	// BankStatementProfilesColumns holds the columns for the "bank_statement_profiles" table.
+	BankStatementProfilesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "tenant_id", Type: field.TypeUUID},
-		{Name: "invoice_number", Type: field.TypeString},
-		{Name: "customer_id", Type: field.TypeUUID, Nullable: true},
-		{Name: "invoice_type", Type: field.TypeString, Default: "standard"},
-		{Name: "invoice_date", Type: field.TypeTime},
-		{Name: "due_date", Type: field.TypeTime},
-		{Name: "subtotal", Type: field.TypeFloat64},
-		{Name: "tax_amount", Type: field.TypeFloat64, Nullable: true},
-		{Name: "total_amount", Type: field.TypeFloat64},
-		{Name: "currency", Type: field.TypeString, Default: "KES"},
-		{Name: "status", Type: field.TypeString, Default: "draft"},
-		{Name: "payment_status", Type: field.TypeString, Default: "unpaid"},
-		{Name: "reference_id", Type: field.TypeUUID, Nullable: true},
-		{Name: "reference_type", Type: field.TypeString, Nullable: true},
-		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "name", Type: field.TypeString},
+		{Name: "delimiter", Type: field.TypeString, Default: ","},
+		{Name: "skip_rows", Type: field.TypeInt, Nullable: true},
+		{Name: "date_column", Type: field.TypeString},
+		{Name: "date_format", Type: field.TypeString},
+		{Name: "value_date_column", Type: field.TypeString, Nullable: true},
+		{Name: "description_column", Type: field.TypeString, Nullable: true},
+		{Name: "reference_column", Type: field.TypeString, Nullable: true},
+		{Name: "counterparty_column", Type: field.TypeString, Nullable: true},
+		{Name: "amount_column", Type: field.TypeString, Nullable: true},
+		{Name: "debit_column", Type: field.TypeString, Nullable: true},
+		{Name: "credit_column", Type: field.TypeString, Nullable: true},
+		{Name: "balance_column", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeUUID, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
-	// InvoicesTable holds the schema information for the "invoices" table.
-	InvoicesTable = &schema.Table{
-		Name:       "invoices",
-		Columns:    InvoicesColumns,
-		PrimaryKey: []*schema.Column{InvoicesColumns[0]},
+	// BankStatementProfilesTable holds the schema information for the "bank_statement_profiles" table.
+	BankStatementProfilesTable = &schema.Table{
+		Name:       "bank_statement_profiles",
+		Columns:    BankStatementProfilesColumns,
+		PrimaryKey: []*schema.Column{BankStatementProfilesColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "invoice_tenant_id",
-				Unique:  false,
-				Columns: []*schema.Column{InvoicesColumns[1]},
-			},
-			{
-				Name:    "invoice_tenant_id_invoice_number",
+				Name:    "bankstatementprofile_tenant_id_name",
 				Unique:  true,
-				Columns: []*schema.Column{InvoicesColumns[1], InvoicesColumns[2]},
+				Columns: []*schema.Column{BankStatementProfilesColumns[1], BankStatementProfilesColumns[2]},
 			},
+		},
+	}
+	// BankTransactionsColumns holds the columns for the "bank_transactions" table.
+	BankTransactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "bank_account_id", Type: field.TypeUUID},
+		{Name: "transaction_date", Type: field.TypeTime},
+		{Name: "value_date", Type: field.TypeTime, Nullable: true},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "transaction_type", Type: field.TypeString},
+		{Name: "reference", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "counterparty", Type: field.TypeString, Nullable: true},
+		{Name: "running_balance", Type: field.TypeFloat64, Nullable: true},
+		{Name: "dedupe_hash", Type: field.TypeString},
+		{Name: "payment_transaction_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "reconciliation_status", Type: field.TypeString, Default: "unreconciled"},
+		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "statement_id", Type: field.TypeUUID},
+	}
+	// BankTransactionsTable holds the schema information for the "bank_transactions" table.
+	BankTransactionsTable = &schema.Table{
+		Name:       "bank_transactions",
+		Columns:    BankTransactionsColumns,
+		PrimaryKey: []*schema.Column{BankTransactionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
 			{
-				Name:    "invoice_customer_id",
-				Unique:  false,
-				Columns: []*schema.Column{InvoicesColumns[3]},
+				Symbol:     "bank_transactions_bank_statements_transactions",
+				Columns:    []*schema.Column{BankTransactionsColumns[17]},
+				RefColumns: []*schema.Column{BankStatementsColumns[0]},
+				OnDelete:   schema.NoAction,
 			},
+		},
+		Indexes: []*schema.Index{
 			{
-				Name:    "invoice_status",
-				Unique:  false,
-				Columns: []*schema.Column{InvoicesColumns[11]},
+				Name:    "banktransaction_bank_account_id_dedupe_hash",
+				Unique:  true,
+				Columns: []*schema.Column{BankTransactionsColumns[2], BankTransactionsColumns[12]},
 			},
 			{
-				Name:    "invoice_payment_status",
+				Name:    "banktransaction_bank_account_id_transaction_date",
 				Unique:  false,
-				Columns: []*schema.Column{InvoicesColumns[12]},
+				Columns: []*schema.Column{BankTransactionsColumns[2], BankTransactionsColumns[3]},
 			},
 			{
-				Name:    "invoice_invoice_date",
+				Name:    "banktransaction_tenant_id_reconciliation_status",
 				Unique:  false,
-				Columns: []*schema.Column{InvoicesColumns[5]},
+				Columns: []*schema.Column{BankTransactionsColumns[1], BankTransactionsColumns[14]},
 			},
 			{
-				Name:    "invoice_due_date",
+				Name:    "banktransaction_reference",
 				Unique:  false,
-				Columns: []*schema.Column{InvoicesColumns[6]},
+				Columns: []*schema.Column{BankTransactionsColumns[8]},
 			},
 			{
-				Name:    "invoice_tenant_id_status",
+				Name:    "banktransaction_tenant_id_payment_transaction_id",
 				Unique:  false,
-				Columns: []*schema.Column{InvoicesColumns[1], InvoicesColumns[11]},
+				Columns: []*schema.Column{BankTransactionsColumns[1], BankTransactionsColumns[13]},
 			},
 		},
 	}
-	// LedgerTransactionsColumns holds the columns for the "ledger_transactions" table.
-	LedgerTransactionsColumns = []*schema.Column{
+	// BillingCyclesColumns holds the columns for the "billing_cycles" table.
+	BillingCyclesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "tenant_id", Type: field.TypeUUID},
-		{Name: "journal_entry_id", Type: field.TypeUUID, Nullable: true},
-		{Name: "debit_amount", Type: field.TypeFloat64, Nullable: true},
-		{Name: "credit_amount", Type: field.TypeFloat64, Nullable: true},
-		{Name: "currency", Type: field.TypeString, Default: "KES"},
-		{Name: "exchange_rate", Type: field.TypeFloat64, Nullable: true},
-		{Name: "reference_type", Type: field.TypeString, Nullable: true},
-		{Name: "reference_id", Type: field.TypeUUID, Nullable: true},
-		{Name: "transaction_date", Type: field.TypeTime},
-		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "cycle_start", Type: field.TypeTime},
+		{Name: "cycle_end", Type: field.TypeTime},
+		{Name: "invoice_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "usage_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "billing_amount", Type: field.TypeFloat64},
 		{Name: "metadata", Type: field.TypeJSON},
 		{Name: "created_at", Type: field.TypeTime},
-		{Name: "account_id", Type: field.TypeUUID},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "subscription_id", Type: field.TypeUUID},
 	}
-	// LedgerTransactionsTable holds the schema information for the "ledger_transactions" table.
-	LedgerTransactionsTable = &schema.Table{
-		Name:       "ledger_transactions",
-		Columns:    LedgerTransactionsColumns,
-		PrimaryKey: []*schema.Column{LedgerTransactionsColumns[0]},
+	// BillingCyclesTable holds the schema information for the "billing_cycles" table.
+	BillingCyclesTable = &schema.Table{
+		Name:       "billing_cycles",
+		Columns:    BillingCyclesColumns,
+		PrimaryKey: []*schema.Column{BillingCyclesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "ledger_transactions_chart_of_accounts_account",
-				Columns:    []*schema.Column{LedgerTransactionsColumns[13]},
-				RefColumns: []*schema.Column{ChartOfAccountsColumns[0]},
+				Symbol:     "billing_cycles_subscriptions_billing_cycles",
+				Columns:    []*schema.Column{BillingCyclesColumns[11]},
+				RefColumns: []*schema.Column{SubscriptionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "ledgertransaction_tenant_id",
-				Unique:  false,
-				Columns: []*schema.Column{LedgerTransactionsColumns[1]},
-			},
-			{
-				Name:    "ledgertransaction_account_id",
-				Unique:  false,
-				Columns: []*schema.Column{LedgerTransactionsColumns[13]},
-			},
-			{
-				Name:    "ledgertransaction_journal_entry_id",
+				Name:    "billingcycle_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{LedgerTransactionsColumns[2]},
+				Columns: []*schema.Column{BillingCyclesColumns[1]},
 			},
 			{
-				Name:    "ledgertransaction_transaction_date",
-				Unique:  false,
-				Columns: []*schema.Column{LedgerTransactionsColumns[9]},
+				Name:    "billingcycle_subscription_id_cycle_start",
+				Unique:  true,
+				Columns: []*schema.Column{BillingCyclesColumns[11], BillingCyclesColumns[2]},
 			},
 			{
-				Name:    "ledgertransaction_reference_type_reference_id",
+				Name:    "billingcycle_invoice_id",
 				Unique:  false,
-				Columns: []*schema.Column{LedgerTransactionsColumns[7], LedgerTransactionsColumns[8]},
+				Columns: []*schema.Column{BillingCyclesColumns[4]},
 			},
 			{
-				Name:    "ledgertransaction_created_at",
+				Name:    "billingcycle_status",
 				Unique:  false,
-				Columns: []*schema.Column{LedgerTransactionsColumns[12]},
+				Columns: []*schema.Column{BillingCyclesColumns[5]},
 			},
 		},
 	}
-	// OutboxEventsColumns holds the columns for the "outbox_events" table.
-	OutboxEventsColumns = []*schema.Column{
+	// CashDrawerCountsColumns holds the columns for the "cash_drawer_counts" table.
+	CashDrawerCountsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "tenant_id", Type: field.TypeUUID},
-		{Name: "aggregate_type", Type: field.TypeString},
-		{Name: "aggregate_id", Type: field.TypeUUID},
-		{Name: "event_type", Type: field.TypeString},
-		{Name: "payload", Type: field.TypeJSON},
-		{Name: "status", Type: field.TypeString, Default: "PENDING"},
-		{Name: "attempts", Type: field.TypeInt, Default: 0},
-		{Name: "last_attempt_at", Type: field.TypeTime, Nullable: true},
-		{Name: "published_at", Type: field.TypeTime, Nullable: true},
-		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "session_id", Type: field.TypeUUID},
+		{Name: "denomination", Type: field.TypeFloat64},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "amount", Type: field.TypeFloat64},
 		{Name: "created_at", Type: field.TypeTime},
 	}
-	// OutboxEventsTable holds the schema information for the "outbox_events" table.
-	OutboxEventsTable = &schema.Table{
-		Name:       "outbox_events",
-		Columns:    OutboxEventsColumns,
-		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+	// CashDrawerCountsTable holds the schema information for the "cash_drawer_counts" table.
+	CashDrawerCountsTable = &schema.Table{
+		Name:       "cash_drawer_counts",
+		Columns:    CashDrawerCountsColumns,
+		PrimaryKey: []*schema.Column{CashDrawerCountsColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "outboxevent_tenant_id",
+				Name:    "cashdrawercount_session_id",
 				Unique:  false,
-				Columns: []*schema.Column{OutboxEventsColumns[1]},
+				Columns: []*schema.Column{CashDrawerCountsColumns[2]},
 			},
+		},
+	}
+	// CashDrawerSessionsColumns holds the columns for the "cash_drawer_sessions" table.
+	CashDrawerSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "pos_session_id", Type: field.TypeString},
+		{Name: "drawer_id", Type: field.TypeString},
+		{Name: "outlet_id", Type: field.TypeString, Nullable: true},
+		{Name: "cashier_id", Type: field.TypeString, Nullable: true},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "opened_at", Type: field.TypeTime},
+		{Name: "closed_at", Type: field.TypeTime},
+		{Name: "opening_float", Type: field.TypeFloat64},
+		{Name: "cash_sales", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "cash_refunds", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "cash_transactions", Type: field.TypeInt, Nullable: true},
+		{Name: "expected_cash", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "counted_cash", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "variance", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "status", Type: field.TypeString, Default: "pending_approval"},
+		{Name: "notes", Type: field.TypeString, Nullable: true},
+		{Name: "rejection_reason", Type: field.TypeString, Nullable: true},
+		{Name: "reviewed_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "journal_entry_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "event_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// CashDrawerSessionsTable holds the schema information for the "cash_drawer_sessions" table.
+	CashDrawerSessionsTable = &schema.Table{
+		Name:       "cash_drawer_sessions",
+		Columns:    CashDrawerSessionsColumns,
+		PrimaryKey: []*schema.Column{CashDrawerSessionsColumns[0]},
+		Indexes: []*schema.Index{
 			{
-				Name:    "outboxevent_aggregate_type_aggregate_id",
-				Unique:  false,
-				Columns: []*schema.Column{OutboxEventsColumns[2], OutboxEventsColumns[3]},
+				Name:    "cashdrawersession_tenant_id_pos_session_id",
+				Unique:  true,
+				Columns: []*schema.Column{CashDrawerSessionsColumns[1], CashDrawerSessionsColumns[2]},
 			},
 			{
-				Name:    "outboxevent_event_type",
+				Name:    "cashdrawersession_tenant_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{OutboxEventsColumns[4]},
+				Columns: []*schema.Column{CashDrawerSessionsColumns[1], CashDrawerSessionsColumns[16]},
 			},
 			{
-				Name:    "outboxevent_status",
+				Name:    "cashdrawersession_tenant_id_drawer_id_closed_at",
 				Unique:  false,
-				Columns: []*schema.Column{OutboxEventsColumns[6]},
+				Columns: []*schema.Column{CashDrawerSessionsColumns[1], CashDrawerSessionsColumns[3], CashDrawerSessionsColumns[8]},
 			},
+		},
+	}
+	// CashDrawerSettingsColumns holds the columns for the "cash_drawer_settings" table.
+	CashDrawerSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "approval_threshold", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// CashDrawerSettingsTable holds the schema information for the "cash_drawer_settings" table.
+	CashDrawerSettingsTable = &schema.Table{
+		Name:       "cash_drawer_settings",
+		Columns:    CashDrawerSettingsColumns,
+		PrimaryKey: []*schema.Column{CashDrawerSettingsColumns[0]},
+		Indexes: []*schema.Index{
 			{
-				Name:    "outboxevent_created_at",
-				Unique:  false,
-				Columns: []*schema.Column{OutboxEventsColumns[11]},
+				Name:    "cashdrawersetting_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{CashDrawerSettingsColumns[1]},
 			},
 		},
 	}
-	// PaymentIntentsColumns holds the columns for the "payment_intents" table.
-	PaymentIntentsColumns = []*schema.Column{
+	// ChartOfAccountsColumns holds the columns for the "chart_of_accounts" table.
+	ChartOfAccountsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "tenant_id", Type: field.TypeUUID},
-		{Name: "reference_id", Type: field.TypeString},
-		{Name: "reference_type", Type: field.TypeString},
-		{Name: "payment_method", Type: field.TypeString},
-		{Name: "currency", Type: field.TypeString, Default: "KES"},
-		{Name: "amount", Type: field.TypeFloat64},
-		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "account_code", Type: field.TypeString},
+		{Name: "account_name", Type: field.TypeString},
+		{Name: "account_type", Type: field.TypeString},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "metadata", Type: field.TypeJSON},
-		{Name: "customer_id", Type: field.TypeUUID, Nullable: true},
-		{Name: "description", Type: field.TypeString, Nullable: true},
-		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
 	}
-	// PaymentIntentsTable holds the schema information for the "payment_intents" table.
-	PaymentIntentsTable = &schema.Table{
-		Name:       "payment_intents",
-		Columns:    PaymentIntentsColumns,
-		PrimaryKey: []*schema.Column{PaymentIntentsColumns[0]},
+	// ChartOfAccountsTable holds the schema information for the "chart_of_accounts" table.
+	ChartOfAccountsTable = &schema.Table{
+		Name:       "chart_of_accounts",
+		Columns:    ChartOfAccountsColumns,
+		PrimaryKey: []*schema.Column{ChartOfAccountsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "chart_of_accounts_chart_of_accounts_children",
+				Columns:    []*schema.Column{ChartOfAccountsColumns[10]},
+				RefColumns: []*schema.Column{ChartOfAccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "paymentintent_tenant_id",
+				Name:    "chartofaccount_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentIntentsColumns[1]},
+				Columns: []*schema.Column{ChartOfAccountsColumns[1]},
 			},
 			{
-				Name:    "paymentintent_tenant_id_reference_id",
+				Name:    "chartofaccount_tenant_id_account_code",
 				Unique:  true,
-				Columns: []*schema.Column{PaymentIntentsColumns[1], PaymentIntentsColumns[2]},
-			},
-			{
-				Name:    "paymentintent_status",
-				Unique:  false,
-				Columns: []*schema.Column{PaymentIntentsColumns[7]},
+				Columns: []*schema.Column{ChartOfAccountsColumns[1], ChartOfAccountsColumns[2]},
 			},
 			{
-				Name:    "paymentintent_payment_method",
+				Name:    "chartofaccount_account_type",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentIntentsColumns[4]},
+				Columns: []*schema.Column{ChartOfAccountsColumns[4]},
 			},
 			{
-				Name:    "paymentintent_created_at",
+				Name:    "chartofaccount_parent_id",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentIntentsColumns[12]},
+				Columns: []*schema.Column{ChartOfAccountsColumns[10]},
 			},
 			{
-				Name:    "paymentintent_tenant_id_status",
+				Name:    "chartofaccount_is_active",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentIntentsColumns[1], PaymentIntentsColumns[7]},
+				Columns: []*schema.Column{ChartOfAccountsColumns[5]},
 			},
-			{
+		},
+	}
+	// CreditOverridesColumns holds the columns for the "credit_overrides" table.
+	CreditOverridesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "customer_id", Type: field.TypeUUID},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "granted_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeString, Default: "active"},
+		{Name: "document_type", Type: field.TypeString, Nullable: true},
+		{Name: "document_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "document_amount", Type: field.TypeFloat64, Nullable: true},
+		{Name: "credit_snapshot", Type: field.TypeJSON, Nullable: true},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// CreditOverridesTable holds the schema information for the "credit_overrides" table.
+	CreditOverridesTable = &schema.Table{
+		Name:       "credit_overrides",
+		Columns:    CreditOverridesColumns,
+		PrimaryKey: []*schema.Column{CreditOverridesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "creditoverride_tenant_id_customer_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{CreditOverridesColumns[1], CreditOverridesColumns[2], CreditOverridesColumns[8]},
+			},
+		},
+	}
+	// CustomersColumns holds the columns for the "customers" table.
+	CustomersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "customer_number", Type: field.TypeString},
+		{Name: "legal_name", Type: field.TypeString},
+		{Name: "trading_name", Type: field.TypeString, Nullable: true},
+		{Name: "kra_pin", Type: field.TypeString, Nullable: true},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "phone", Type: field.TypeString, Nullable: true},
+		{Name: "billing_addresses", Type: field.TypeJSON, Nullable: true},
+		{Name: "contacts", Type: field.TypeJSON, Nullable: true},
+		{Name: "default_currency", Type: field.TypeString, Default: "KES"},
+		{Name: "payment_terms_days", Type: field.TypeInt, Default: 30},
+		{Name: "credit_limit", Type: field.TypeFloat64, Nullable: true},
+		{Name: "credit_hold", Type: field.TypeBool, Default: false},
+		{Name: "credit_hold_source", Type: field.TypeString, Nullable: true},
+		{Name: "credit_hold_reason", Type: field.TypeString, Nullable: true},
+		{Name: "credit_hold_at", Type: field.TypeTime, Nullable: true},
+		{Name: "auth_user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "active"},
+		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// CustomersTable holds the schema information for the "customers" table.
+	CustomersTable = &schema.Table{
+		Name:       "customers",
+		Columns:    CustomersColumns,
+		PrimaryKey: []*schema.Column{CustomersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "customer_tenant_id_customer_number",
+				Unique:  true,
+				Columns: []*schema.Column{CustomersColumns[1], CustomersColumns[2]},
+			},
+			{
+				Name:    "customer_tenant_id_legal_name",
+				Unique:  false,
+				Columns: []*schema.Column{CustomersColumns[1], CustomersColumns[3]},
+			},
+			{
+				Name:    "customer_tenant_id_kra_pin",
+				Unique:  false,
+				Columns: []*schema.Column{CustomersColumns[1], CustomersColumns[5]},
+			},
+			{
+				Name:    "customer_tenant_id_auth_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{CustomersColumns[1], CustomersColumns[17]},
+			},
+		},
+	}
+	// CustomerStatementsColumns holds the columns for the "customer_statements" table.
+	CustomerStatementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "customer_id", Type: field.TypeUUID},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "period_start", Type: field.TypeTime},
+		{Name: "period_end", Type: field.TypeTime},
+		{Name: "opening_balance", Type: field.TypeFloat64},
+		{Name: "closing_balance", Type: field.TypeFloat64},
+		{Name: "object_key", Type: field.TypeString},
+		{Name: "trigger", Type: field.TypeString, Default: "scheduled"},
+		{Name: "generated_at", Type: field.TypeTime},
+	}
+	// CustomerStatementsTable holds the schema information for the "customer_statements" table.
+	CustomerStatementsTable = &schema.Table{
+		Name:       "customer_statements",
+		Columns:    CustomerStatementsColumns,
+		PrimaryKey: []*schema.Column{CustomerStatementsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "customerstatement_tenant_id_customer_id",
+				Unique:  false,
+				Columns: []*schema.Column{CustomerStatementsColumns[1], CustomerStatementsColumns[2]},
+			},
+			{
+				Name:    "customerstatement_customer_id_currency_period_start_period_end",
+				Unique:  true,
+				Columns: []*schema.Column{CustomerStatementsColumns[2], CustomerStatementsColumns[3], CustomerStatementsColumns[4], CustomerStatementsColumns[5]},
+			},
+		},
+	}
+	// DocumentSequencesColumns holds the columns for the "document_sequences" table.
+	DocumentSequencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "last_value", Type: field.TypeInt64, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DocumentSequencesTable holds the schema information for the "document_sequences" table.
+	DocumentSequencesTable = &schema.Table{
+		Name:       "document_sequences",
+		Columns:    DocumentSequencesColumns,
+		PrimaryKey: []*schema.Column{DocumentSequencesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "documentsequence_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{DocumentSequencesColumns[1], DocumentSequencesColumns[2]},
+			},
+		},
+	}
+	// DunningNoticesColumns holds the columns for the "dunning_notices" table.
+	DunningNoticesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "invoice_id", Type: field.TypeUUID},
+		{Name: "customer_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "offset_days", Type: field.TypeInt},
+		{Name: "action", Type: field.TypeString},
+		{Name: "channel", Type: field.TypeString, Nullable: true},
+		{Name: "late_fee_invoice_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "executed_at", Type: field.TypeTime},
+	}
+	// DunningNoticesTable holds the schema information for the "dunning_notices" table.
+	DunningNoticesTable = &schema.Table{
+		Name:       "dunning_notices",
+		Columns:    DunningNoticesColumns,
+		PrimaryKey: []*schema.Column{DunningNoticesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dunningnotice_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{DunningNoticesColumns[1]},
+			},
+			{
+				Name:    "dunningnotice_invoice_id_offset_days_action",
+				Unique:  true,
+				Columns: []*schema.Column{DunningNoticesColumns[2], DunningNoticesColumns[4], DunningNoticesColumns[5]},
+			},
+		},
+	}
+	// DunningPausesColumns holds the columns for the "dunning_pauses" table.
+	DunningPausesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "customer_id", Type: field.TypeUUID},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "paused_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "paused_until", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// DunningPausesTable holds the schema information for the "dunning_pauses" table.
+	DunningPausesTable = &schema.Table{
+		Name:       "dunning_pauses",
+		Columns:    DunningPausesColumns,
+		PrimaryKey: []*schema.Column{DunningPausesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dunningpause_tenant_id_customer_id",
+				Unique:  true,
+				Columns: []*schema.Column{DunningPausesColumns[1], DunningPausesColumns[2]},
+			},
+		},
+	}
+	// DunningStepsColumns holds the columns for the "dunning_steps" table.
+	DunningStepsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "offset_days", Type: field.TypeInt},
+		{Name: "action", Type: field.TypeString, Default: "reminder"},
+		{Name: "channel", Type: field.TypeString, Nullable: true},
+		{Name: "template", Type: field.TypeString, Nullable: true},
+		{Name: "late_fee_type", Type: field.TypeString, Nullable: true},
+		{Name: "late_fee_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// DunningStepsTable holds the schema information for the "dunning_steps" table.
+	DunningStepsTable = &schema.Table{
+		Name:       "dunning_steps",
+		Columns:    DunningStepsColumns,
+		PrimaryKey: []*schema.Column{DunningStepsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dunningstep_tenant_id_offset_days_action",
+				Unique:  true,
+				Columns: []*schema.Column{DunningStepsColumns[1], DunningStepsColumns[2], DunningStepsColumns[3]},
+			},
+		},
+	}
+	// EarningsSettingsColumns holds the columns for the "earnings_settings" table.
+	EarningsSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "minimum_payout", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// EarningsSettingsTable holds the schema information for the "earnings_settings" table.
+	EarningsSettingsTable = &schema.Table{
+		Name:       "earnings_settings",
+		Columns:    EarningsSettingsColumns,
+		PrimaryKey: []*schema.Column{EarningsSettingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "earningssetting_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{EarningsSettingsColumns[1]},
+			},
+		},
+	}
+	// GoodsReceiptsColumns holds the columns for the "goods_receipts" table.
+	GoodsReceiptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "po_id", Type: field.TypeString, Nullable: true},
+		{Name: "po_number", Type: field.TypeString},
+		{Name: "grn_id", Type: field.TypeString},
+		{Name: "grn_number", Type: field.TypeString, Nullable: true},
+		{Name: "vendor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "received_at", Type: field.TypeTime},
+		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// GoodsReceiptsTable holds the schema information for the "goods_receipts" table.
+	GoodsReceiptsTable = &schema.Table{
+		Name:       "goods_receipts",
+		Columns:    GoodsReceiptsColumns,
+		PrimaryKey: []*schema.Column{GoodsReceiptsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "goodsreceipt_tenant_id_grn_id",
+				Unique:  true,
+				Columns: []*schema.Column{GoodsReceiptsColumns[1], GoodsReceiptsColumns[5]},
+			},
+			{
+				Name:    "goodsreceipt_tenant_id_po_number",
+				Unique:  false,
+				Columns: []*schema.Column{GoodsReceiptsColumns[1], GoodsReceiptsColumns[4]},
+			},
+		},
+	}
+	// GoodsReceiptLinesColumns holds the columns for the "goods_receipt_lines" table.
+	GoodsReceiptLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "line_number", Type: field.TypeInt},
+		{Name: "item_code", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "ordered_quantity", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "received_quantity", Type: field.TypeFloat64},
+		{Name: "unit_price", Type: field.TypeFloat64},
+		{Name: "receipt_id", Type: field.TypeUUID},
+	}
+	// GoodsReceiptLinesTable holds the schema information for the "goods_receipt_lines" table.
+	GoodsReceiptLinesTable = &schema.Table{
+		Name:       "goods_receipt_lines",
+		Columns:    GoodsReceiptLinesColumns,
+		PrimaryKey: []*schema.Column{GoodsReceiptLinesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "goods_receipt_lines_goods_receipts_lines",
+				Columns:    []*schema.Column{GoodsReceiptLinesColumns[8]},
+				RefColumns: []*schema.Column{GoodsReceiptsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "goodsreceiptline_receipt_id_line_number",
+				Unique:  true,
+				Columns: []*schema.Column{GoodsReceiptLinesColumns[8], GoodsReceiptLinesColumns[2]},
+			},
+			{
+				Name:    "goodsreceiptline_tenant_id_item_code",
+				Unique:  false,
+				Columns: []*schema.Column{GoodsReceiptLinesColumns[1], GoodsReceiptLinesColumns[3]},
+			},
+		},
+	}
+	// InvoicesColumns holds the columns for the "invoices" table.
+	InvoicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "invoice_number", Type: field.TypeString},
+		{Name: "customer_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "invoice_type", Type: field.TypeString, Default: "standard"},
+		{Name: "invoice_date", Type: field.TypeTime},
+		{Name: "due_date", Type: field.TypeTime},
+		{Name: "subtotal", Type: field.TypeFloat64},
+		{Name: "tax_amount", Type: field.TypeFloat64, Nullable: true},
+		{Name: "total_amount", Type: field.TypeFloat64},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "status", Type: field.TypeString, Default: "draft"},
+		{Name: "payment_status", Type: field.TypeString, Default: "unpaid"},
+		{Name: "written_off_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "reference_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "reference_type", Type: field.TypeString, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// InvoicesTable holds the schema information for the "invoices" table.
+	InvoicesTable = &schema.Table{
+		Name:       "invoices",
+		Columns:    InvoicesColumns,
+		PrimaryKey: []*schema.Column{InvoicesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoice_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[1]},
+			},
+			{
+				Name:    "invoice_tenant_id_invoice_number",
+				Unique:  true,
+				Columns: []*schema.Column{InvoicesColumns[1], InvoicesColumns[2]},
+			},
+			{
+				Name:    "invoice_customer_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[3]},
+			},
+			{
+				Name:    "invoice_status",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[11]},
+			},
+			{
+				Name:    "invoice_payment_status",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[12]},
+			},
+			{
+				Name:    "invoice_invoice_date",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[5]},
+			},
+			{
+				Name:    "invoice_due_date",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[6]},
+			},
+			{
+				Name:    "invoice_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[1], InvoicesColumns[11]},
+			},
+			{
+				Name:    "invoice_tenant_id_reference_type_reference_id",
+				Unique:  true,
+				Columns: []*schema.Column{InvoicesColumns[1], InvoicesColumns[15], InvoicesColumns[14]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "reference_type IN ('order', 'milestone')",
+				},
+			},
+		},
+	}
+	// InvoiceLinesColumns holds the columns for the "invoice_lines" table.
+	InvoiceLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "line_number", Type: field.TypeInt},
+		{Name: "description", Type: field.TypeString, Size: 2147483647},
+		{Name: "quantity", Type: field.TypeFloat64},
+		{Name: "unit_price", Type: field.TypeFloat64},
+		{Name: "tax_rate", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "tax_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "line_total", Type: field.TypeFloat64},
+		{Name: "revenue_account", Type: field.TypeString, Nullable: true},
+		{Name: "reference_type", Type: field.TypeString, Nullable: true},
+		{Name: "reference_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "invoice_id", Type: field.TypeUUID},
+	}
+	// InvoiceLinesTable holds the schema information for the "invoice_lines" table.
+	InvoiceLinesTable = &schema.Table{
+		Name:       "invoice_lines",
+		Columns:    InvoiceLinesColumns,
+		PrimaryKey: []*schema.Column{InvoiceLinesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invoice_lines_invoices_lines",
+				Columns:    []*schema.Column{InvoiceLinesColumns[14]},
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoiceline_invoice_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoiceLinesColumns[14]},
+			},
+			{
+				Name:    "invoiceline_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoiceLinesColumns[1]},
+			},
+			{
+				Name:    "invoiceline_reference_type_reference_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoiceLinesColumns[10], InvoiceLinesColumns[11]},
+			},
+		},
+	}
+	// InvoicePaymentsColumns holds the columns for the "invoice_payments" table.
+	InvoicePaymentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "customer_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "amount_applied", Type: field.TypeFloat64},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "allocation_method", Type: field.TypeString, Default: "manual"},
+		{Name: "allocated_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "journal_entry_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "applied_at", Type: field.TypeTime},
+		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "invoice_id", Type: field.TypeUUID},
+		{Name: "payment_transaction_id", Type: field.TypeUUID},
+	}
+	// InvoicePaymentsTable holds the schema information for the "invoice_payments" table.
+	InvoicePaymentsTable = &schema.Table{
+		Name:       "invoice_payments",
+		Columns:    InvoicePaymentsColumns,
+		PrimaryKey: []*schema.Column{InvoicePaymentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invoice_payments_invoices_invoice",
+				Columns:    []*schema.Column{InvoicePaymentsColumns[11]},
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "invoice_payments_payment_transactions_payment",
+				Columns:    []*schema.Column{InvoicePaymentsColumns[12]},
+				RefColumns: []*schema.Column{PaymentTransactionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoicepayment_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicePaymentsColumns[1]},
+			},
+			{
+				Name:    "invoicepayment_invoice_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicePaymentsColumns[11]},
+			},
+			{
+				Name:    "invoicepayment_payment_transaction_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicePaymentsColumns[12]},
+			},
+			{
+				Name:    "invoicepayment_tenant_id_customer_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicePaymentsColumns[1], InvoicePaymentsColumns[2]},
+			},
+			{
+				Name:    "invoicepayment_applied_at",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicePaymentsColumns[8]},
+			},
+		},
+	}
+	// InvoiceSettingsColumns holds the columns for the "invoice_settings" table.
+	InvoiceSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "auto_issue_orders", Type: field.TypeBool, Default: false},
+		{Name: "auto_issue_milestones", Type: field.TypeBool, Default: false},
+		{Name: "order_payment_terms_days", Type: field.TypeInt, Default: 0},
+		{Name: "milestone_payment_terms_days", Type: field.TypeInt, Default: 30},
+		{Name: "credit_hold_days", Type: field.TypeInt, Default: 0},
+		{Name: "default_tax_rate", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// InvoiceSettingsTable holds the schema information for the "invoice_settings" table.
+	InvoiceSettingsTable = &schema.Table{
+		Name:       "invoice_settings",
+		Columns:    InvoiceSettingsColumns,
+		PrimaryKey: []*schema.Column{InvoiceSettingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoicesetting_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{InvoiceSettingsColumns[1]},
+			},
+		},
+	}
+	// LedgerTransactionsColumns holds the columns for the "ledger_transactions" table.
+	LedgerTransactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "journal_entry_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "debit_amount", Type: field.TypeFloat64, Nullable: true},
+		{Name: "credit_amount", Type: field.TypeFloat64, Nullable: true},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "exchange_rate", Type: field.TypeFloat64, Nullable: true},
+		{Name: "reference_type", Type: field.TypeString, Nullable: true},
+		{Name: "reference_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "transaction_date", Type: field.TypeTime},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeUUID},
+	}
+	// LedgerTransactionsTable holds the schema information for the "ledger_transactions" table.
+	LedgerTransactionsTable = &schema.Table{
+		Name:       "ledger_transactions",
+		Columns:    LedgerTransactionsColumns,
+		PrimaryKey: []*schema.Column{LedgerTransactionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ledger_transactions_chart_of_accounts_account",
+				Columns:    []*schema.Column{LedgerTransactionsColumns[13]},
+				RefColumns: []*schema.Column{ChartOfAccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ledgertransaction_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerTransactionsColumns[1]},
+			},
+			{
+				Name:    "ledgertransaction_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerTransactionsColumns[13]},
+			},
+			{
+				Name:    "ledgertransaction_journal_entry_id",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerTransactionsColumns[2]},
+			},
+			{
+				Name:    "ledgertransaction_transaction_date",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerTransactionsColumns[9]},
+			},
+			{
+				Name:    "ledgertransaction_reference_type_reference_id",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerTransactionsColumns[7], LedgerTransactionsColumns[8]},
+			},
+			{
+				Name:    "ledgertransaction_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerTransactionsColumns[12]},
+			},
+		},
+	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "aggregate_type", Type: field.TypeString},
+		{Name: "aggregate_id", Type: field.TypeUUID},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeString, Default: "PENDING"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[1]},
+			},
+			{
+				Name:    "outboxevent_aggregate_type_aggregate_id",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[2], OutboxEventsColumns[3]},
+			},
+			{
+				Name:    "outboxevent_event_type",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[4]},
+			},
+			{
+				Name:    "outboxevent_status",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[6]},
+			},
+			{
+				Name:    "outboxevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[11]},
+			},
+		},
+	}
+	// PayableSettingsColumns holds the columns for the "payable_settings" table.
+	PayableSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "quantity_tolerance", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "price_tolerance", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PayableSettingsTable holds the schema information for the "payable_settings" table.
+	PayableSettingsTable = &schema.Table{
+		Name:       "payable_settings",
+		Columns:    PayableSettingsColumns,
+		PrimaryKey: []*schema.Column{PayableSettingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "payablesetting_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{PayableSettingsColumns[1]},
+			},
+		},
+	}
+	// PayeePayoutsColumns holds the columns for the "payee_payouts" table.
+	PayeePayoutsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "wallet_id", Type: field.TypeUUID},
+		{Name: "payout_number", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "destination", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "rejection_code", Type: field.TypeString, Nullable: true},
+		{Name: "rejection_reason", Type: field.TypeString, Nullable: true},
+		{Name: "payment_reference", Type: field.TypeString, Nullable: true},
+		{Name: "requested_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "completed_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "journal_entry_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PayeePayoutsTable holds the schema information for the "payee_payouts" table.
+	PayeePayoutsTable = &schema.Table{
+		Name:       "payee_payouts",
+		Columns:    PayeePayoutsColumns,
+		PrimaryKey: []*schema.Column{PayeePayoutsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "payeepayout_tenant_id_payout_number",
+				Unique:  true,
+				Columns: []*schema.Column{PayeePayoutsColumns[1], PayeePayoutsColumns[3]},
+			},
+			{
+				Name:    "payeepayout_tenant_id_request_id",
+				Unique:  true,
+				Columns: []*schema.Column{PayeePayoutsColumns[1], PayeePayoutsColumns[8]},
+			},
+			{
+				Name:    "payeepayout_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{PayeePayoutsColumns[1], PayeePayoutsColumns[7]},
+			},
+			{
+				Name:    "payeepayout_wallet_id",
+				Unique:  false,
+				Columns: []*schema.Column{PayeePayoutsColumns[2]},
+			},
+		},
+	}
+	// PayeeWalletsColumns holds the columns for the "payee_wallets" table.
+	PayeeWalletsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "payee_id", Type: field.TypeString},
+		{Name: "payee_type", Type: field.TypeString, Default: "rider"},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "phone", Type: field.TypeString, Nullable: true},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "balance", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "reserved_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "total_earned", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "total_deducted", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "total_paid", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PayeeWalletsTable holds the schema information for the "payee_wallets" table.
+	PayeeWalletsTable = &schema.Table{
+		Name:       "payee_wallets",
+		Columns:    PayeeWalletsColumns,
+		PrimaryKey: []*schema.Column{PayeeWalletsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "payeewallet_tenant_id_payee_id_currency",
+				Unique:  true,
+				Columns: []*schema.Column{PayeeWalletsColumns[1], PayeeWalletsColumns[2], PayeeWalletsColumns[6]},
+			},
+			{
+				Name:    "payeewallet_tenant_id_payee_type",
+				Unique:  false,
+				Columns: []*schema.Column{PayeeWalletsColumns[1], PayeeWalletsColumns[3]},
+			},
+		},
+	}
+	// PayeeWalletEntriesColumns holds the columns for the "payee_wallet_entries" table.
+	PayeeWalletEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "wallet_id", Type: field.TypeUUID},
+		{Name: "entry_type", Type: field.TypeString},
+		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "balance_after", Type: field.TypeFloat64},
+		{Name: "reference_id", Type: field.TypeString, Nullable: true},
+		{Name: "payout_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "event_id", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "journal_entry_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "occurred_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PayeeWalletEntriesTable holds the schema information for the "payee_wallet_entries" table.
+	PayeeWalletEntriesTable = &schema.Table{
+		Name:       "payee_wallet_entries",
+		Columns:    PayeeWalletEntriesColumns,
+		PrimaryKey: []*schema.Column{PayeeWalletEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "payeewalletentry_wallet_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PayeeWalletEntriesColumns[2], PayeeWalletEntriesColumns[14]},
+			},
+			{
+				Name:    "payeewalletentry_tenant_id_reference_id",
+				Unique:  true,
+				Columns: []*schema.Column{PayeeWalletEntriesColumns[1], PayeeWalletEntriesColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "entry_type = 'earning'",
+				},
+			},
+		},
+	}
+	// PaymentIntentsColumns holds the columns for the "payment_intents" table.
+	PaymentIntentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "reference_id", Type: field.TypeString},
+		{Name: "reference_type", Type: field.TypeString},
+		{Name: "payment_method", Type: field.TypeString},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "customer_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PaymentIntentsTable holds the schema information for the "payment_intents" table.
+	PaymentIntentsTable = &schema.Table{
+		Name:       "payment_intents",
+		Columns:    PaymentIntentsColumns,
+		PrimaryKey: []*schema.Column{PaymentIntentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "paymentintent_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentIntentsColumns[1]},
+			},
+			{
+				Name:    "paymentintent_tenant_id_reference_id",
+				Unique:  true,
+				Columns: []*schema.Column{PaymentIntentsColumns[1], PaymentIntentsColumns[2]},
+			},
+			{
+				Name:    "paymentintent_status",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentIntentsColumns[7]},
+			},
+			{
+				Name:    "paymentintent_payment_method",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentIntentsColumns[4]},
+			},
+			{
+				Name:    "paymentintent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentIntentsColumns[12]},
+			},
+			{
+				Name:    "paymentintent_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentIntentsColumns[1], PaymentIntentsColumns[7]},
+			},
+			{
 				Name:    "paymentintent_customer_id",
 				Unique:  false,
 				Columns: []*schema.Column{PaymentIntentsColumns[9]},
 			},
 		},
 	}
+	// PaymentRunsColumns holds the columns for the "payment_runs" table.
+	PaymentRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "run_number", Type: field.TypeString},
+		{Name: "payment_date", Type: field.TypeTime},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "method", Type: field.TypeString},
+		{Name: "payment_account", Type: field.TypeString, Default: "1000"},
+		{Name: "due_before", Type: field.TypeTime, Nullable: true},
+		{Name: "vendor_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "draft"},
+		{Name: "total_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "discount_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "withholding_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "bill_count", Type: field.TypeInt, Default: 0},
+		{Name: "file_key", Type: field.TypeString, Nullable: true},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "approved_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "approved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "executed_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "executed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PaymentRunsTable holds the schema information for the "payment_runs" table.
+	PaymentRunsTable = &schema.Table{
+		Name:       "payment_runs",
+		Columns:    PaymentRunsColumns,
+		PrimaryKey: []*schema.Column{PaymentRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "paymentrun_tenant_id_run_number",
+				Unique:  true,
+				Columns: []*schema.Column{PaymentRunsColumns[1], PaymentRunsColumns[2]},
+			},
+			{
+				Name:    "paymentrun_tenant_id_status_payment_date",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentRunsColumns[1], PaymentRunsColumns[9], PaymentRunsColumns[3]},
+			},
+		},
+	}
+	// PaymentRunItemsColumns holds the columns for the "payment_run_items" table.
+	PaymentRunItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "bill_id", Type: field.TypeUUID},
+		{Name: "vendor_id", Type: field.TypeUUID},
+		{Name: "bill_number", Type: field.TypeString},
+		{Name: "vendor_reference", Type: field.TypeString, Nullable: true},
+		{Name: "due_date", Type: field.TypeTime},
+		{Name: "balance", Type: field.TypeFloat64},
+		{Name: "discount_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "withholding_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "status", Type: field.TypeString, Default: "proposed"},
+		{Name: "remittance_key", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "run_id", Type: field.TypeUUID},
+	}
+	// PaymentRunItemsTable holds the schema information for the "payment_run_items" table.
+	PaymentRunItemsTable = &schema.Table{
+		Name:       "payment_run_items",
+		Columns:    PaymentRunItemsColumns,
+		PrimaryKey: []*schema.Column{PaymentRunItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payment_run_items_payment_runs_items",
+				Columns:    []*schema.Column{PaymentRunItemsColumns[14]},
+				RefColumns: []*schema.Column{PaymentRunsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "paymentrunitem_run_id_vendor_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentRunItemsColumns[14], PaymentRunItemsColumns[3]},
+			},
+			{
+				Name:    "paymentrunitem_bill_id",
+				Unique:  true,
+				Columns: []*schema.Column{PaymentRunItemsColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'proposed'",
+				},
+			},
+		},
+	}
 	// PaymentTransactionsColumns holds the columns for the "payment_transactions" table.
 	PaymentTransactionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -315,6 +1339,7 @@ var (
 		{Name: "provider_reference", Type: field.TypeString},
 		{Name: "status", Type: field.TypeString, Default: "pending"},
 		{Name: "processed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "receipt_journal_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -341,62 +1366,595 @@ var (
 				Columns: []*schema.Column{PaymentTransactionsColumns[7]},
 			},
 			{
-				Name:    "paymenttransaction_status",
-				Unique:  false,
-				Columns: []*schema.Column{PaymentTransactionsColumns[8]},
+				Name:    "paymenttransaction_status",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentTransactionsColumns[8]},
+			},
+			{
+				Name:    "paymenttransaction_processed_at",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentTransactionsColumns[9]},
+			},
+			{
+				Name:    "paymenttransaction_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentTransactionsColumns[1], PaymentTransactionsColumns[8]},
+			},
+		},
+	}
+	// ProvisionPoliciesColumns holds the columns for the "provision_policies" table.
+	ProvisionPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "boundaries", Type: field.TypeJSON},
+		{Name: "loss_rates", Type: field.TypeJSON},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "updated_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ProvisionPoliciesTable holds the schema information for the "provision_policies" table.
+	ProvisionPoliciesTable = &schema.Table{
+		Name:       "provision_policies",
+		Columns:    ProvisionPoliciesColumns,
+		PrimaryKey: []*schema.Column{ProvisionPoliciesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "provisionpolicy_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{ProvisionPoliciesColumns[1]},
+			},
+		},
+	}
+	// ProvisionRunsColumns holds the columns for the "provision_runs" table.
+	ProvisionRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "as_of", Type: field.TypeTime},
+		{Name: "buckets", Type: field.TypeJSON},
+		{Name: "required_allowance", Type: field.TypeFloat64},
+		{Name: "previous_allowance", Type: field.TypeFloat64},
+		{Name: "adjustment", Type: field.TypeFloat64},
+		{Name: "journal_entry_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "trigger", Type: field.TypeString, Default: "scheduled"},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ProvisionRunsTable holds the schema information for the "provision_runs" table.
+	ProvisionRunsTable = &schema.Table{
+		Name:       "provision_runs",
+		Columns:    ProvisionRunsColumns,
+		PrimaryKey: []*schema.Column{ProvisionRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "provisionrun_tenant_id_currency_as_of",
+				Unique:  true,
+				Columns: []*schema.Column{ProvisionRunsColumns[1], ProvisionRunsColumns[2], ProvisionRunsColumns[3]},
+			},
+		},
+	}
+	// ReconciliationsColumns holds the columns for the "reconciliations" table.
+	ReconciliationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "bank_account_id", Type: field.TypeUUID},
+		{Name: "status", Type: field.TypeString, Default: "open"},
+		{Name: "period_start", Type: field.TypeTime, Nullable: true},
+		{Name: "period_end", Type: field.TypeTime, Nullable: true},
+		{Name: "statement_balance", Type: field.TypeFloat64, Nullable: true},
+		{Name: "book_balance", Type: field.TypeFloat64, Nullable: true},
+		{Name: "outstanding_deposits", Type: field.TypeFloat64, Nullable: true},
+		{Name: "outstanding_payments", Type: field.TypeFloat64, Nullable: true},
+		{Name: "unrecorded_amount", Type: field.TypeFloat64, Nullable: true},
+		{Name: "difference", Type: field.TypeFloat64, Nullable: true},
+		{Name: "outstanding_items", Type: field.TypeJSON, Nullable: true},
+		{Name: "unrecorded_items", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "finalised_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "finalised_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ReconciliationsTable holds the schema information for the "reconciliations" table.
+	ReconciliationsTable = &schema.Table{
+		Name:       "reconciliations",
+		Columns:    ReconciliationsColumns,
+		PrimaryKey: []*schema.Column{ReconciliationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reconciliation_tenant_id_bank_account_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ReconciliationsColumns[1], ReconciliationsColumns[2], ReconciliationsColumns[3]},
+			},
+		},
+	}
+	// ReconciliationMatchesColumns holds the columns for the "reconciliation_matches" table.
+	ReconciliationMatchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "bank_account_id", Type: field.TypeUUID},
+		{Name: "rule", Type: field.TypeString},
+		{Name: "rule_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "match_type", Type: field.TypeString, Default: "automatic"},
+		{Name: "confidence", Type: field.TypeInt},
+		{Name: "status", Type: field.TypeString, Default: "suggested"},
+		{Name: "bank_amount", Type: field.TypeFloat64},
+		{Name: "book_amount", Type: field.TypeFloat64},
+		{Name: "difference", Type: field.TypeFloat64},
+		{Name: "fingerprint", Type: field.TypeString},
+		{Name: "reasons", Type: field.TypeJSON, Nullable: true},
+		{Name: "matched_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "matched_at", Type: field.TypeTime, Nullable: true},
+		{Name: "rejected_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "rejected_at", Type: field.TypeTime, Nullable: true},
+		{Name: "unmatched_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "unmatched_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "reconciliation_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// ReconciliationMatchesTable holds the schema information for the "reconciliation_matches" table.
+	ReconciliationMatchesTable = &schema.Table{
+		Name:       "reconciliation_matches",
+		Columns:    ReconciliationMatchesColumns,
+		PrimaryKey: []*schema.Column{ReconciliationMatchesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reconciliation_matches_reconciliations_matches",
+				Columns:    []*schema.Column{ReconciliationMatchesColumns[21]},
+				RefColumns: []*schema.Column{ReconciliationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reconciliationmatch_tenant_id_bank_account_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ReconciliationMatchesColumns[1], ReconciliationMatchesColumns[2], ReconciliationMatchesColumns[7]},
+			},
+			{
+				Name:    "reconciliationmatch_bank_account_id_fingerprint",
+				Unique:  false,
+				Columns: []*schema.Column{ReconciliationMatchesColumns[2], ReconciliationMatchesColumns[11]},
+			},
+			{
+				Name:    "reconciliationmatch_reconciliation_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReconciliationMatchesColumns[21]},
+			},
+		},
+	}
+	// ReconciliationMatchItemsColumns holds the columns for the "reconciliation_match_items" table.
+	ReconciliationMatchItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "item_type", Type: field.TypeString},
+		{Name: "item_id", Type: field.TypeUUID},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "transaction_date", Type: field.TypeTime},
+		{Name: "reference", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "status", Type: field.TypeString, Default: "suggested"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "match_id", Type: field.TypeUUID},
+	}
+	// ReconciliationMatchItemsTable holds the schema information for the "reconciliation_match_items" table.
+	ReconciliationMatchItemsTable = &schema.Table{
+		Name:       "reconciliation_match_items",
+		Columns:    ReconciliationMatchItemsColumns,
+		PrimaryKey: []*schema.Column{ReconciliationMatchItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reconciliation_match_items_reconciliation_matches_items",
+				Columns:    []*schema.Column{ReconciliationMatchItemsColumns[10]},
+				RefColumns: []*schema.Column{ReconciliationMatchesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reconciliationmatchitem_match_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReconciliationMatchItemsColumns[10]},
+			},
+			{
+				Name:    "reconciliationmatchitem_item_type_item_id",
+				Unique:  true,
+				Columns: []*schema.Column{ReconciliationMatchItemsColumns[2], ReconciliationMatchItemsColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status <> 'released'",
+				},
+			},
+		},
+	}
+	// ReconciliationRulesColumns holds the columns for the "reconciliation_rules" table.
+	ReconciliationRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "bank_account_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "priority", Type: field.TypeInt, Default: 100},
+		{Name: "match_field", Type: field.TypeString, Default: "description"},
+		{Name: "pattern", Type: field.TypeString},
+		{Name: "counterpart", Type: field.TypeString, Default: "ledger"},
+		{Name: "counterpart_pattern", Type: field.TypeString, Nullable: true},
+		{Name: "amount_tolerance", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "date_window_days", Type: field.TypeInt, Default: 3},
+		{Name: "confidence", Type: field.TypeInt, Default: 90},
+		{Name: "auto_match", Type: field.TypeBool, Default: false},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ReconciliationRulesTable holds the schema information for the "reconciliation_rules" table.
+	ReconciliationRulesTable = &schema.Table{
+		Name:       "reconciliation_rules",
+		Columns:    ReconciliationRulesColumns,
+		PrimaryKey: []*schema.Column{ReconciliationRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reconciliationrule_tenant_id_active_priority",
+				Unique:  false,
+				Columns: []*schema.Column{ReconciliationRulesColumns[1], ReconciliationRulesColumns[13], ReconciliationRulesColumns[4]},
+			},
+		},
+	}
+	// RolePermissionsColumns holds the columns for the "role_permissions" table.
+	RolePermissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "role_id", Type: field.TypeUUID},
+		{Name: "permission_id", Type: field.TypeUUID},
+	}
+	// RolePermissionsTable holds the schema information for the "role_permissions" table.
+	RolePermissionsTable = &schema.Table{
+		Name:       "role_permissions",
+		Columns:    RolePermissionsColumns,
+		PrimaryKey: []*schema.Column{RolePermissionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_permissions_treasury_roles_role",
+				Columns:    []*schema.Column{RolePermissionsColumns[1]},
+				RefColumns: []*schema.Column{TreasuryRolesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "role_permissions_treasury_permissions_permission",
+				Columns:    []*schema.Column{RolePermissionsColumns[2]},
+				RefColumns: []*schema.Column{TreasuryPermissionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rolepermission_role_id_permission_id",
+				Unique:  true,
+				Columns: []*schema.Column{RolePermissionsColumns[1], RolePermissionsColumns[2]},
+			},
+			{
+				Name:    "rolepermission_role_id",
+				Unique:  false,
+				Columns: []*schema.Column{RolePermissionsColumns[1]},
+			},
+			{
+				Name:    "rolepermission_permission_id",
+				Unique:  false,
+				Columns: []*schema.Column{RolePermissionsColumns[2]},
+			},
+		},
+	}
+	// SettlementBatchesColumns holds the columns for the "settlement_batches" table.
+	SettlementBatchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "batch_number", Type: field.TypeString},
+		{Name: "channel", Type: field.TypeString},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "window_start", Type: field.TypeTime},
+		{Name: "window_end", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeString, Default: "draft"},
+		{Name: "fee_rate", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "gross_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "refund_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "fee_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "adjustment_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "net_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "transaction_count", Type: field.TypeInt, Default: 0},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "submitted_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "submitted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "approved_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "approved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "rejection_reason", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SettlementBatchesTable holds the schema information for the "settlement_batches" table.
+	SettlementBatchesTable = &schema.Table{
+		Name:       "settlement_batches",
+		Columns:    SettlementBatchesColumns,
+		PrimaryKey: []*schema.Column{SettlementBatchesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "settlementbatch_tenant_id_batch_number",
+				Unique:  true,
+				Columns: []*schema.Column{SettlementBatchesColumns[1], SettlementBatchesColumns[2]},
+			},
+			{
+				Name:    "settlementbatch_tenant_id_channel_currency_window_end",
+				Unique:  true,
+				Columns: []*schema.Column{SettlementBatchesColumns[1], SettlementBatchesColumns[3], SettlementBatchesColumns[4], SettlementBatchesColumns[6]},
+			},
+			{
+				Name:    "settlementbatch_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{SettlementBatchesColumns[1], SettlementBatchesColumns[7]},
+			},
+		},
+	}
+	// SettlementDisbursementsColumns holds the columns for the "settlement_disbursements" table.
+	SettlementDisbursementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "batch_id", Type: field.TypeUUID},
+		{Name: "method", Type: field.TypeString},
+		{Name: "party", Type: field.TypeString},
+		{Name: "account_reference", Type: field.TypeString, Nullable: true},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "sequence", Type: field.TypeInt, Default: 1},
+		{Name: "originator_conversation_id", Type: field.TypeString},
+		{Name: "conversation_id", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "result_code", Type: field.TypeString, Nullable: true},
+		{Name: "result_description", Type: field.TypeString, Nullable: true},
+		{Name: "failure_reason", Type: field.TypeString, Nullable: true},
+		{Name: "transaction_id", Type: field.TypeString, Nullable: true},
+		{Name: "journal_entry_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "submitted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SettlementDisbursementsTable holds the schema information for the "settlement_disbursements" table.
+	SettlementDisbursementsTable = &schema.Table{
+		Name:       "settlement_disbursements",
+		Columns:    SettlementDisbursementsColumns,
+		PrimaryKey: []*schema.Column{SettlementDisbursementsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "settlementdisbursement_batch_id",
+				Unique:  true,
+				Columns: []*schema.Column{SettlementDisbursementsColumns[2]},
+			},
+			{
+				Name:    "settlementdisbursement_originator_conversation_id",
+				Unique:  true,
+				Columns: []*schema.Column{SettlementDisbursementsColumns[9]},
+			},
+			{
+				Name:    "settlementdisbursement_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{SettlementDisbursementsColumns[11], SettlementDisbursementsColumns[13]},
+			},
+			{
+				Name:    "settlementdisbursement_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{SettlementDisbursementsColumns[1], SettlementDisbursementsColumns[11]},
+			},
+		},
+	}
+	// SettlementItemsColumns holds the columns for the "settlement_items" table.
+	SettlementItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "item_type", Type: field.TypeString},
+		{Name: "payment_transaction_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "provider_reference", Type: field.TypeString, Nullable: true},
+		{Name: "transaction_date", Type: field.TypeTime, Nullable: true},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "included"},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "batch_id", Type: field.TypeUUID},
+	}
+	// SettlementItemsTable holds the schema information for the "settlement_items" table.
+	SettlementItemsTable = &schema.Table{
+		Name:       "settlement_items",
+		Columns:    SettlementItemsColumns,
+		PrimaryKey: []*schema.Column{SettlementItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "settlement_items_settlement_batches_items",
+				Columns:    []*schema.Column{SettlementItemsColumns[11]},
+				RefColumns: []*schema.Column{SettlementBatchesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "settlementitem_batch_id_item_type",
+				Unique:  false,
+				Columns: []*schema.Column{SettlementItemsColumns[11], SettlementItemsColumns[2]},
+			},
+			{
+				Name:    "settlementitem_payment_transaction_id",
+				Unique:  true,
+				Columns: []*schema.Column{SettlementItemsColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'included'",
+				},
+			},
+		},
+	}
+	// SettlementSettingsColumns holds the columns for the "settlement_settings" table.
+	SettlementSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "mpesa_fee_rate", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "card_fee_rate", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "bank_fee_rate", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "payout_method", Type: field.TypeString, Nullable: true},
+		{Name: "payout_party", Type: field.TypeString, Nullable: true},
+		{Name: "payout_account_reference", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SettlementSettingsTable holds the schema information for the "settlement_settings" table.
+	SettlementSettingsTable = &schema.Table{
+		Name:       "settlement_settings",
+		Columns:    SettlementSettingsColumns,
+		PrimaryKey: []*schema.Column{SettlementSettingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "settlementsetting_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{SettlementSettingsColumns[1]},
+			},
+		},
+	}
+	// SubscriptionsColumns holds the columns for the "subscriptions" table.
+	SubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "customer_id", Type: field.TypeUUID},
+		{Name: "plan_code", Type: field.TypeString},
+		{Name: "plan_name", Type: field.TypeString, Nullable: true},
+		{Name: "price", Type: field.TypeFloat64},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "billing_interval", Type: field.TypeString, Default: "month"},
+		{Name: "interval_count", Type: field.TypeInt, Default: 1},
+		{Name: "billing_anchor_day", Type: field.TypeInt, Nullable: true},
+		{Name: "days_until_due", Type: field.TypeInt, Default: 0},
+		{Name: "status", Type: field.TypeString, Default: "active"},
+		{Name: "trial_end", Type: field.TypeTime, Nullable: true},
+		{Name: "current_period_start", Type: field.TypeTime},
+		{Name: "current_period_end", Type: field.TypeTime},
+		{Name: "next_billing_at", Type: field.TypeTime},
+		{Name: "cancel_at_period_end", Type: field.TypeBool, Default: false},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reference_id", Type: field.TypeString, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SubscriptionsTable holds the schema information for the "subscriptions" table.
+	SubscriptionsTable = &schema.Table{
+		Name:       "subscriptions",
+		Columns:    SubscriptionsColumns,
+		PrimaryKey: []*schema.Column{SubscriptionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "subscription_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionsColumns[1]},
+			},
+			{
+				Name:    "subscription_customer_id",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionsColumns[2]},
+			},
+			{
+				Name:    "subscription_status",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionsColumns[11]},
+			},
+			{
+				Name:    "subscription_status_next_billing_at",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionsColumns[11], SubscriptionsColumns[15]},
+			},
+			{
+				Name:    "subscription_tenant_id_reference_id",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionsColumns[1], SubscriptionsColumns[19]},
+			},
+		},
+	}
+	// SubscriptionAdjustmentsColumns holds the columns for the "subscription_adjustments" table.
+	SubscriptionAdjustmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "kind", Type: field.TypeString, Default: "proration"},
+		{Name: "description", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "billing_cycle_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "subscription_id", Type: field.TypeUUID},
+	}
+	// SubscriptionAdjustmentsTable holds the schema information for the "subscription_adjustments" table.
+	SubscriptionAdjustmentsTable = &schema.Table{
+		Name:       "subscription_adjustments",
+		Columns:    SubscriptionAdjustmentsColumns,
+		PrimaryKey: []*schema.Column{SubscriptionAdjustmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "subscription_adjustments_subscriptions_adjustments",
+				Columns:    []*schema.Column{SubscriptionAdjustmentsColumns[9]},
+				RefColumns: []*schema.Column{SubscriptionsColumns[0]},
+				OnDelete:   schema.NoAction,
 			},
+		},
+		Indexes: []*schema.Index{
 			{
-				Name:    "paymenttransaction_processed_at",
+				Name:    "subscriptionadjustment_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentTransactionsColumns[9]},
+				Columns: []*schema.Column{SubscriptionAdjustmentsColumns[1]},
 			},
 			{
-				Name:    "paymenttransaction_tenant_id_status",
+				Name:    "subscriptionadjustment_subscription_id_billing_cycle_id",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentTransactionsColumns[1], PaymentTransactionsColumns[8]},
+				Columns: []*schema.Column{SubscriptionAdjustmentsColumns[9], SubscriptionAdjustmentsColumns[6]},
 			},
 		},
 	}
-	// RolePermissionsColumns holds the columns for the "role_permissions" table.
-	RolePermissionsColumns = []*schema.Column{
-		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "role_id", Type: field.TypeUUID},
-		{Name: "permission_id", Type: field.TypeUUID},
+	// SubscriptionMetersColumns holds the columns for the "subscription_meters" table.
+	SubscriptionMetersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "code", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "unit", Type: field.TypeString, Nullable: true},
+		{Name: "aggregation", Type: field.TypeString, Default: "sum"},
+		{Name: "pricing_model", Type: field.TypeString, Default: "per_unit"},
+		{Name: "unit_price", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "tiers", Type: field.TypeJSON, Nullable: true},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "subscription_id", Type: field.TypeUUID},
 	}
-	// RolePermissionsTable holds the schema information for the "role_permissions" table.
-	RolePermissionsTable = &schema.Table{
-		Name:       "role_permissions",
-		Columns:    RolePermissionsColumns,
-		PrimaryKey: []*schema.Column{RolePermissionsColumns[0]},
+	// SubscriptionMetersTable holds the schema information for the "subscription_meters" table.
+	SubscriptionMetersTable = &schema.Table{
+		Name:       "subscription_meters",
+		Columns:    SubscriptionMetersColumns,
+		PrimaryKey: []*schema.Column{SubscriptionMetersColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "role_permissions_treasury_roles_role",
-				Columns:    []*schema.Column{RolePermissionsColumns[1]},
-				RefColumns: []*schema.Column{TreasuryRolesColumns[0]},
-				OnDelete:   schema.NoAction,
-			},
-			{
-				Symbol:     "role_permissions_treasury_permissions_permission",
-				Columns:    []*schema.Column{RolePermissionsColumns[2]},
-				RefColumns: []*schema.Column{TreasuryPermissionsColumns[0]},
+				Symbol:     "subscription_meters_subscriptions_meters",
+				Columns:    []*schema.Column{SubscriptionMetersColumns[13]},
+				RefColumns: []*schema.Column{SubscriptionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "rolepermission_role_id_permission_id",
-				Unique:  true,
-				Columns: []*schema.Column{RolePermissionsColumns[1], RolePermissionsColumns[2]},
-			},
-			{
-				Name:    "rolepermission_role_id",
+				Name:    "subscriptionmeter_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{RolePermissionsColumns[1]},
+				Columns: []*schema.Column{SubscriptionMetersColumns[1]},
 			},
 			{
-				Name:    "rolepermission_permission_id",
-				Unique:  false,
-				Columns: []*schema.Column{RolePermissionsColumns[2]},
+				Name:    "subscriptionmeter_subscription_id_code",
+				Unique:  true,
+				Columns: []*schema.Column{SubscriptionMetersColumns[13], SubscriptionMetersColumns[2]},
 			},
 		},
 	}
@@ -518,6 +2076,50 @@ var (
 			},
 		},
 	}
+	// UsageRecordsColumns holds the columns for the "usage_records" table.
+	UsageRecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "subscription_id", Type: field.TypeUUID},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "quantity", Type: field.TypeFloat64},
+		{Name: "occurred_at", Type: field.TypeTime},
+		{Name: "billing_cycle_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "meter_id", Type: field.TypeUUID},
+	}
+	// UsageRecordsTable holds the schema information for the "usage_records" table.
+	UsageRecordsTable = &schema.Table{
+		Name:       "usage_records",
+		Columns:    UsageRecordsColumns,
+		PrimaryKey: []*schema.Column{UsageRecordsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "usage_records_subscription_meters_usage_records",
+				Columns:    []*schema.Column{UsageRecordsColumns[9]},
+				RefColumns: []*schema.Column{SubscriptionMetersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usagerecord_tenant_id_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{UsageRecordsColumns[1], UsageRecordsColumns[3]},
+			},
+			{
+				Name:    "usagerecord_subscription_id_billing_cycle_id",
+				Unique:  false,
+				Columns: []*schema.Column{UsageRecordsColumns[2], UsageRecordsColumns[6]},
+			},
+			{
+				Name:    "usagerecord_meter_id_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsageRecordsColumns[9], UsageRecordsColumns[5]},
+			},
+		},
+	}
 	// UserRoleAssignmentsColumns holds the columns for the "user_role_assignments" table.
 	UserRoleAssignmentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -582,28 +2184,521 @@ var (
 			},
 		},
 	}
+	// VendorsColumns holds the columns for the "vendors" table.
+	VendorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "vendor_number", Type: field.TypeString},
+		{Name: "legal_name", Type: field.TypeString},
+		{Name: "trading_name", Type: field.TypeString, Nullable: true},
+		{Name: "kra_pin", Type: field.TypeString, Nullable: true},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "phone", Type: field.TypeString, Nullable: true},
+		{Name: "addresses", Type: field.TypeJSON, Nullable: true},
+		{Name: "contacts", Type: field.TypeJSON, Nullable: true},
+		{Name: "default_currency", Type: field.TypeString, Default: "KES"},
+		{Name: "payment_terms_days", Type: field.TypeInt, Default: 30},
+		{Name: "early_payment_discount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "early_payment_days", Type: field.TypeInt, Nullable: true},
+		{Name: "withholding_category", Type: field.TypeString, Nullable: true},
+		{Name: "default_expense_account", Type: field.TypeString, Nullable: true},
+		{Name: "payment_method", Type: field.TypeString, Nullable: true},
+		{Name: "payment_details", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "active"},
+		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// VendorsTable holds the schema information for the "vendors" table.
+	VendorsTable = &schema.Table{
+		Name:       "vendors",
+		Columns:    VendorsColumns,
+		PrimaryKey: []*schema.Column{VendorsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vendor_tenant_id_vendor_number",
+				Unique:  true,
+				Columns: []*schema.Column{VendorsColumns[1], VendorsColumns[2]},
+			},
+			{
+				Name:    "vendor_tenant_id_legal_name",
+				Unique:  false,
+				Columns: []*schema.Column{VendorsColumns[1], VendorsColumns[3]},
+			},
+			{
+				Name:    "vendor_tenant_id_kra_pin",
+				Unique:  false,
+				Columns: []*schema.Column{VendorsColumns[1], VendorsColumns[5]},
+			},
+		},
+	}
+	// VendorBillsColumns holds the columns for the "vendor_bills" table.
+	VendorBillsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "vendor_id", Type: field.TypeUUID},
+		{Name: "bill_number", Type: field.TypeString},
+		{Name: "vendor_reference", Type: field.TypeString, Nullable: true},
+		{Name: "bill_date", Type: field.TypeTime},
+		{Name: "due_date", Type: field.TypeTime},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "subtotal", Type: field.TypeFloat64},
+		{Name: "tax_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "total_amount", Type: field.TypeFloat64},
+		{Name: "paid_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "discount_rate", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "discount_until", Type: field.TypeTime, Nullable: true},
+		{Name: "discount_taken", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "withholding_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "withholding_taken", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "status", Type: field.TypeString, Default: "draft"},
+		{Name: "attachments", Type: field.TypeJSON, Nullable: true},
+		{Name: "reference_type", Type: field.TypeString, Nullable: true},
+		{Name: "reference_id", Type: field.TypeString, Nullable: true},
+		{Name: "po_number", Type: field.TypeString, Nullable: true},
+		{Name: "match_status", Type: field.TypeString, Nullable: true},
+		{Name: "matched_at", Type: field.TypeTime, Nullable: true},
+		{Name: "variance_accepted_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "variance_accepted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "variance_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "approved_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "approved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "scheduled_for", Type: field.TypeTime, Nullable: true},
+		{Name: "paid_at", Type: field.TypeTime, Nullable: true},
+		{Name: "journal_entry_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// VendorBillsTable holds the schema information for the "vendor_bills" table.
+	VendorBillsTable = &schema.Table{
+		Name:       "vendor_bills",
+		Columns:    VendorBillsColumns,
+		PrimaryKey: []*schema.Column{VendorBillsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vendorbill_tenant_id_bill_number",
+				Unique:  true,
+				Columns: []*schema.Column{VendorBillsColumns[1], VendorBillsColumns[3]},
+			},
+			{
+				Name:    "vendorbill_tenant_id_status_due_date",
+				Unique:  false,
+				Columns: []*schema.Column{VendorBillsColumns[1], VendorBillsColumns[17], VendorBillsColumns[6]},
+			},
+			{
+				Name:    "vendorbill_vendor_id",
+				Unique:  false,
+				Columns: []*schema.Column{VendorBillsColumns[2]},
+			},
+			{
+				Name:    "vendorbill_tenant_id_po_number",
+				Unique:  false,
+				Columns: []*schema.Column{VendorBillsColumns[1], VendorBillsColumns[21]},
+			},
+			{
+				Name:    "vendorbill_tenant_id_vendor_id_vendor_reference",
+				Unique:  true,
+				Columns: []*schema.Column{VendorBillsColumns[1], VendorBillsColumns[2], VendorBillsColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "vendor_reference IS NOT NULL AND vendor_reference <> '' AND status <> 'cancelled'",
+				},
+			},
+			{
+				Name:    "vendorbill_tenant_id_reference_type_reference_id",
+				Unique:  true,
+				Columns: []*schema.Column{VendorBillsColumns[1], VendorBillsColumns[19], VendorBillsColumns[20]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "reference_id IS NOT NULL AND reference_id <> ''",
+				},
+			},
+		},
+	}
+	// VendorBillLinesColumns holds the columns for the "vendor_bill_lines" table.
+	VendorBillLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "line_number", Type: field.TypeInt},
+		{Name: "item_code", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Size: 2147483647},
+		{Name: "quantity", Type: field.TypeFloat64},
+		{Name: "unit_price", Type: field.TypeFloat64},
+		{Name: "account_code", Type: field.TypeString},
+		{Name: "tax_code", Type: field.TypeString, Nullable: true},
+		{Name: "tax_rate", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "tax_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "line_total", Type: field.TypeFloat64},
+		{Name: "withholding_category", Type: field.TypeString, Nullable: true},
+		{Name: "withholding_rate", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "withholding_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "match_status", Type: field.TypeString, Nullable: true},
+		{Name: "received_quantity", Type: field.TypeFloat64, Nullable: true},
+		{Name: "order_unit_price", Type: field.TypeFloat64, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "bill_id", Type: field.TypeUUID},
+	}
+	// VendorBillLinesTable holds the schema information for the "vendor_bill_lines" table.
+	VendorBillLinesTable = &schema.Table{
+		Name:       "vendor_bill_lines",
+		Columns:    VendorBillLinesColumns,
+		PrimaryKey: []*schema.Column{VendorBillLinesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "vendor_bill_lines_vendor_bills_lines",
+				Columns:    []*schema.Column{VendorBillLinesColumns[20]},
+				RefColumns: []*schema.Column{VendorBillsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vendorbillline_bill_id_line_number",
+				Unique:  true,
+				Columns: []*schema.Column{VendorBillLinesColumns[20], VendorBillLinesColumns[2]},
+			},
+		},
+	}
+	// WalletsColumns holds the columns for the "wallets" table.
+	WalletsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "owner_type", Type: field.TypeString},
+		{Name: "owner_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "balance", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "held_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WalletsTable holds the schema information for the "wallets" table.
+	WalletsTable = &schema.Table{
+		Name:       "wallets",
+		Columns:    WalletsColumns,
+		PrimaryKey: []*schema.Column{WalletsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wallet_tenant_id_owner_type_owner_id_currency",
+				Unique:  true,
+				Columns: []*schema.Column{WalletsColumns[1], WalletsColumns[2], WalletsColumns[3], WalletsColumns[5]},
+			},
+		},
+	}
+	// WalletHoldsColumns holds the columns for the "wallet_holds" table.
+	WalletHoldsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "wallet_id", Type: field.TypeUUID},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "captured_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "status", Type: field.TypeString, Default: "active"},
+		{Name: "reference", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "transaction_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WalletHoldsTable holds the schema information for the "wallet_holds" table.
+	WalletHoldsTable = &schema.Table{
+		Name:       "wallet_holds",
+		Columns:    WalletHoldsColumns,
+		PrimaryKey: []*schema.Column{WalletHoldsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wallethold_tenant_id_reference",
+				Unique:  true,
+				Columns: []*schema.Column{WalletHoldsColumns[1], WalletHoldsColumns[6]},
+			},
+			{
+				Name:    "wallethold_wallet_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{WalletHoldsColumns[2], WalletHoldsColumns[5]},
+			},
+		},
+	}
+	// WalletTransactionsColumns holds the columns for the "wallet_transactions" table.
+	WalletTransactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "transaction_type", Type: field.TypeString},
+		{Name: "from_wallet_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "to_wallet_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "account_code", Type: field.TypeString, Nullable: true},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "hold_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "reference", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "journal_entry_id", Type: field.TypeUUID},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WalletTransactionsTable holds the schema information for the "wallet_transactions" table.
+	WalletTransactionsTable = &schema.Table{
+		Name:       "wallet_transactions",
+		Columns:    WalletTransactionsColumns,
+		PrimaryKey: []*schema.Column{WalletTransactionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wallettransaction_tenant_id_reference",
+				Unique:  true,
+				Columns: []*schema.Column{WalletTransactionsColumns[1], WalletTransactionsColumns[9]},
+			},
+			{
+				Name:    "wallettransaction_from_wallet_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WalletTransactionsColumns[3], WalletTransactionsColumns[13]},
+			},
+			{
+				Name:    "wallettransaction_to_wallet_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WalletTransactionsColumns[4], WalletTransactionsColumns[13]},
+			},
+		},
+	}
+	// WithholdingCertificatesColumns holds the columns for the "withholding_certificates" table.
+	WithholdingCertificatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "certificate_number", Type: field.TypeString},
+		{Name: "vendor_id", Type: field.TypeUUID},
+		{Name: "vendor_name", Type: field.TypeString},
+		{Name: "vendor_pin", Type: field.TypeString, Nullable: true},
+		{Name: "bill_id", Type: field.TypeUUID},
+		{Name: "bill_number", Type: field.TypeString},
+		{Name: "vendor_reference", Type: field.TypeString, Nullable: true},
+		{Name: "category", Type: field.TypeString},
+		{Name: "category_name", Type: field.TypeString},
+		{Name: "rate", Type: field.TypeFloat64},
+		{Name: "currency", Type: field.TypeString, Default: "KES"},
+		{Name: "gross_amount", Type: field.TypeFloat64},
+		{Name: "tax_amount", Type: field.TypeFloat64},
+		{Name: "payment_date", Type: field.TypeTime},
+		{Name: "payment_reference", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WithholdingCertificatesTable holds the schema information for the "withholding_certificates" table.
+	WithholdingCertificatesTable = &schema.Table{
+		Name:       "withholding_certificates",
+		Columns:    WithholdingCertificatesColumns,
+		PrimaryKey: []*schema.Column{WithholdingCertificatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "withholdingcertificate_tenant_id_certificate_number",
+				Unique:  true,
+				Columns: []*schema.Column{WithholdingCertificatesColumns[1], WithholdingCertificatesColumns[2]},
+			},
+			{
+				Name:    "withholdingcertificate_tenant_id_payment_date",
+				Unique:  false,
+				Columns: []*schema.Column{WithholdingCertificatesColumns[1], WithholdingCertificatesColumns[15]},
+			},
+			{
+				Name:    "withholdingcertificate_tenant_id_vendor_id",
+				Unique:  false,
+				Columns: []*schema.Column{WithholdingCertificatesColumns[1], WithholdingCertificatesColumns[3]},
+			},
+			{
+				Name:    "withholdingcertificate_bill_id",
+				Unique:  false,
+				Columns: []*schema.Column{WithholdingCertificatesColumns[6]},
+			},
+		},
+	}
+	// WithholdingRatesColumns holds the columns for the "withholding_rates" table.
+	WithholdingRatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "category", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "rate", Type: field.TypeFloat64},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "updated_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WithholdingRatesTable holds the schema information for the "withholding_rates" table.
+	WithholdingRatesTable = &schema.Table{
+		Name:       "withholding_rates",
+		Columns:    WithholdingRatesColumns,
+		PrimaryKey: []*schema.Column{WithholdingRatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "withholdingrate_tenant_id_category",
+				Unique:  true,
+				Columns: []*schema.Column{WithholdingRatesColumns[1], WithholdingRatesColumns[2]},
+			},
+		},
+	}
+	// WriteOffsColumns holds the columns for the "write_offs" table.
+	WriteOffsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "invoice_id", Type: field.TypeUUID},
+		{Name: "customer_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "recovered_amount", Type: field.TypeFloat64, Nullable: true, Default: "0"},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "requested_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "decided_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "decided_at", Type: field.TypeTime, Nullable: true},
+		{Name: "decision_note", Type: field.TypeString, Nullable: true},
+		{Name: "journal_entry_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WriteOffsTable holds the schema information for the "write_offs" table.
+	WriteOffsTable = &schema.Table{
+		Name:       "write_offs",
+		Columns:    WriteOffsColumns,
+		PrimaryKey: []*schema.Column{WriteOffsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "writeoff_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{WriteOffsColumns[1], WriteOffsColumns[8]},
+			},
+			{
+				Name:    "writeoff_invoice_id",
+				Unique:  false,
+				Columns: []*schema.Column{WriteOffsColumns[2]},
+			},
+			{
+				Name:    "writeoff_tenant_id_customer_id",
+				Unique:  false,
+				Columns: []*schema.Column{WriteOffsColumns[1], WriteOffsColumns[3]},
+			},
+		},
+	}
+	// WriteOffRecoveriesColumns holds the columns for the "write_off_recoveries" table.
+	WriteOffRecoveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "write_off_id", Type: field.TypeUUID},
+		{Name: "invoice_id", Type: field.TypeUUID},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "note", Type: field.TypeString, Nullable: true},
+		{Name: "journal_entry_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "recovered_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "recovered_at", Type: field.TypeTime},
+	}
+	// WriteOffRecoveriesTable holds the schema information for the "write_off_recoveries" table.
+	WriteOffRecoveriesTable = &schema.Table{
+		Name:       "write_off_recoveries",
+		Columns:    WriteOffRecoveriesColumns,
+		PrimaryKey: []*schema.Column{WriteOffRecoveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "writeoffrecovery_write_off_id",
+				Unique:  false,
+				Columns: []*schema.Column{WriteOffRecoveriesColumns[2]},
+			},
+			{
+				Name:    "writeoffrecovery_tenant_id_invoice_id",
+				Unique:  false,
+				Columns: []*schema.Column{WriteOffRecoveriesColumns[1], WriteOffRecoveriesColumns[3]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BankAccountsTable,
+		BankStatementsTable,
+		BankStatementProfilesTable,
+		BankTransactionsTable,
+		BillingCyclesTable,
+		CashDrawerCountsTable,
+		CashDrawerSessionsTable,
+		CashDrawerSettingsTable,
 		ChartOfAccountsTable,
+		CreditOverridesTable,
+		CustomersTable,
+		CustomerStatementsTable,
+		DocumentSequencesTable,
+		DunningNoticesTable,
+		DunningPausesTable,
+		DunningStepsTable,
+		EarningsSettingsTable,
+		GoodsReceiptsTable,
+		GoodsReceiptLinesTable,
 		InvoicesTable,
+		InvoiceLinesTable,
+		InvoicePaymentsTable,
+		InvoiceSettingsTable,
 		LedgerTransactionsTable,
 		OutboxEventsTable,
+		PayableSettingsTable,
+		PayeePayoutsTable,
+		PayeeWalletsTable,
+		PayeeWalletEntriesTable,
 		PaymentIntentsTable,
+		PaymentRunsTable,
+		PaymentRunItemsTable,
 		PaymentTransactionsTable,
+		ProvisionPoliciesTable,
+		ProvisionRunsTable,
+		ReconciliationsTable,
+		ReconciliationMatchesTable,
+		ReconciliationMatchItemsTable,
+		ReconciliationRulesTable,
 		RolePermissionsTable,
+		SettlementBatchesTable,
+		SettlementDisbursementsTable,
+		SettlementItemsTable,
+		SettlementSettingsTable,
+		SubscriptionsTable,
+		SubscriptionAdjustmentsTable,
+		SubscriptionMetersTable,
 		TreasuryPermissionsTable,
 		TreasuryRolesTable,
 		TreasuryUsersTable,
+		UsageRecordsTable,
 		UserRoleAssignmentsTable,
+		VendorsTable,
+		VendorBillsTable,
+		VendorBillLinesTable,
+		WalletsTable,
+		WalletHoldsTable,
+		WalletTransactionsTable,
+		WithholdingCertificatesTable,
+		WithholdingRatesTable,
+		WriteOffsTable,
+		WriteOffRecoveriesTable,
 	}
 )
 
 func init() {
+	BankTransactionsTable.ForeignKeys[0].RefTable = BankStatementsTable
+	BillingCyclesTable.ForeignKeys[0].RefTable = SubscriptionsTable
 	ChartOfAccountsTable.ForeignKeys[0].RefTable = ChartOfAccountsTable
+	GoodsReceiptLinesTable.ForeignKeys[0].RefTable = GoodsReceiptsTable
+	InvoiceLinesTable.ForeignKeys[0].RefTable = InvoicesTable
+	InvoicePaymentsTable.ForeignKeys[0].RefTable = InvoicesTable
+	InvoicePaymentsTable.ForeignKeys[1].RefTable = PaymentTransactionsTable
 	LedgerTransactionsTable.ForeignKeys[0].RefTable = ChartOfAccountsTable
+	PaymentRunItemsTable.ForeignKeys[0].RefTable = PaymentRunsTable
+	ReconciliationMatchesTable.ForeignKeys[0].RefTable = ReconciliationsTable
+	ReconciliationMatchItemsTable.ForeignKeys[0].RefTable = ReconciliationMatchesTable
 	RolePermissionsTable.ForeignKeys[0].RefTable = TreasuryRolesTable
 	RolePermissionsTable.ForeignKeys[1].RefTable = TreasuryPermissionsTable
+	SettlementItemsTable.ForeignKeys[0].RefTable = SettlementBatchesTable
+	SubscriptionAdjustmentsTable.ForeignKeys[0].RefTable = SubscriptionsTable
+	SubscriptionMetersTable.ForeignKeys[0].RefTable = SubscriptionsTable
+	UsageRecordsTable.ForeignKeys[0].RefTable = SubscriptionMetersTable
 	UserRoleAssignmentsTable.ForeignKeys[0].RefTable = TreasuryRolesTable
 	UserRoleAssignmentsTable.ForeignKeys[1].RefTable = TreasuryUsersTable
 	UserRoleAssignmentsTable.ForeignKeys[2].RefTable = TreasuryRolesTable
+	VendorBillLinesTable.ForeignKeys[0].RefTable = VendorBillsTable
+	WalletsTable.Annotation = &entsql.Annotation{}
+	WalletsTable.Annotation.Checks = map[string]string{
+		"wallets_balance_not_negative": "balance >= 0",
+		"wallets_holds_covered":        "held_amount >= 0 AND held_amount <= balance",
+	}
 }
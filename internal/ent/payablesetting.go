@@ -0,0 +1,152 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/payablesetting"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PayableSetting is the model entity for the PayableSetting schema.
+type PayableSetting struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Fraction a billed quantity may exceed the unbilled received quantity by (defaults to zero)
+	QuantityTolerance decimal.Decimal `json:"quantity_tolerance,omitempty"`
+	// Fraction a billed unit price may differ from the purchase order price by (defaults to zero)
+	PriceTolerance decimal.Decimal `json:"price_tolerance,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PayableSetting) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case payablesetting.FieldQuantityTolerance, payablesetting.FieldPriceTolerance:
+			values[i] = new(decimal.Decimal)
+		case payablesetting.FieldCreatedAt, payablesetting.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case payablesetting.FieldID, payablesetting.FieldTenantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PayableSetting fields.
+func (_m *PayableSetting) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case payablesetting.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case payablesetting.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case payablesetting.FieldQuantityTolerance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity_tolerance", values[i])
+			} else if value != nil {
+				_m.QuantityTolerance = *value
+			}
+		case payablesetting.FieldPriceTolerance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field price_tolerance", values[i])
+			} else if value != nil {
+				_m.PriceTolerance = *value
+			}
+		case payablesetting.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case payablesetting.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PayableSetting.
+// This includes values selected through modifiers, order, etc.
+func (_m *PayableSetting) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PayableSetting.
+// Note that you need to call PayableSetting.Unwrap() before calling this method if this PayableSetting
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PayableSetting) Update() *PayableSettingUpdateOne {
+	return NewPayableSettingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PayableSetting entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PayableSetting) Unwrap() *PayableSetting {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PayableSetting is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PayableSetting) String() string {
+	var builder strings.Builder
+	builder.WriteString("PayableSetting(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("quantity_tolerance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QuantityTolerance))
+	builder.WriteString(", ")
+	builder.WriteString("price_tolerance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PriceTolerance))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PayableSettings is a parsable slice of PayableSetting.
+type PayableSettings []*PayableSetting
@@ -0,0 +1,93 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payablesetting
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the payablesetting type in the database.
+	Label = "payable_setting"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldQuantityTolerance holds the string denoting the quantity_tolerance field in the database.
+	FieldQuantityTolerance = "quantity_tolerance"
+	// FieldPriceTolerance holds the string denoting the price_tolerance field in the database.
+	FieldPriceTolerance = "price_tolerance"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the payablesetting in the database.
+	Table = "payable_settings"
+)
+
+// Columns holds all SQL columns for payablesetting fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldQuantityTolerance,
+	FieldPriceTolerance,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the PayableSetting queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByQuantityTolerance orders the results by the quantity_tolerance field.
+func ByQuantityTolerance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantityTolerance, opts...).ToFunc()
+}
+
+// ByPriceTolerance orders the results by the price_tolerance field.
+func ByPriceTolerance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriceTolerance, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payablesetting
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldEQ(FieldTenantID, v))
+}
+
+// QuantityTolerance applies equality check predicate on the "quantity_tolerance" field. It's identical to QuantityToleranceEQ.
+func QuantityTolerance(v decimal.Decimal) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldEQ(FieldQuantityTolerance, v))
+}
+
+// PriceTolerance applies equality check predicate on the "price_tolerance" field. It's identical to PriceToleranceEQ.
+func PriceTolerance(v decimal.Decimal) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldEQ(FieldPriceTolerance, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldLTE(FieldTenantID, v))
+}
+
+// QuantityToleranceEQ applies the EQ predicate on the "quantity_tolerance" field.
+func QuantityToleranceEQ(v decimal.Decimal) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldEQ(FieldQuantityTolerance, v))
+}
+
+// QuantityToleranceNEQ applies the NEQ predicate on the "quantity_tolerance" field.
+func QuantityToleranceNEQ(v decimal.Decimal) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldNEQ(FieldQuantityTolerance, v))
+}
+
+// QuantityToleranceIn applies the In predicate on the "quantity_tolerance" field.
+func QuantityToleranceIn(vs ...decimal.Decimal) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldIn(FieldQuantityTolerance, vs...))
+}
+
+// QuantityToleranceNotIn applies the NotIn predicate on the "quantity_tolerance" field.
+func QuantityToleranceNotIn(vs ...decimal.Decimal) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldNotIn(FieldQuantityTolerance, vs...))
+}
+
+// QuantityToleranceGT applies the GT predicate on the "quantity_tolerance" field.
+func QuantityToleranceGT(v decimal.Decimal) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldGT(FieldQuantityTolerance, v))
+}
+
+// QuantityToleranceGTE applies the GTE predicate on the "quantity_tolerance" field.
+func QuantityToleranceGTE(v decimal.Decimal) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldGTE(FieldQuantityTolerance, v))
+}
+
+// QuantityToleranceLT applies the LT predicate on the "quantity_tolerance" field.
+func QuantityToleranceLT(v decimal.Decimal) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldLT(FieldQuantityTolerance, v))
+}
+
+// QuantityToleranceLTE applies the LTE predicate on the "quantity_tolerance" field.
+func QuantityToleranceLTE(v decimal.Decimal) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldLTE(FieldQuantityTolerance, v))
+}
+
+// QuantityToleranceIsNil applies the IsNil predicate on the "quantity_tolerance" field.
+func QuantityToleranceIsNil() predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldIsNull(FieldQuantityTolerance))
+}
+
+// QuantityToleranceNotNil applies the NotNil predicate on the "quantity_tolerance" field.
+func QuantityToleranceNotNil() predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldNotNull(FieldQuantityTolerance))
+}
+
+// PriceToleranceEQ applies the EQ predicate on the "price_tolerance" field.
+func PriceToleranceEQ(v decimal.Decimal) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldEQ(FieldPriceTolerance, v))
+}
+
+// PriceToleranceNEQ applies the NEQ predicate on the "price_tolerance" field.
+func PriceToleranceNEQ(v decimal.Decimal) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldNEQ(FieldPriceTolerance, v))
+}
+
+// PriceToleranceIn applies the In predicate on the "price_tolerance" field.
+func PriceToleranceIn(vs ...decimal.Decimal) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldIn(FieldPriceTolerance, vs...))
+}
+
+// PriceToleranceNotIn applies the NotIn predicate on the "price_tolerance" field.
+func PriceToleranceNotIn(vs ...decimal.Decimal) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldNotIn(FieldPriceTolerance, vs...))
+}
+
+// PriceToleranceGT applies the GT predicate on the "price_tolerance" field.
+func PriceToleranceGT(v decimal.Decimal) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldGT(FieldPriceTolerance, v))
+}
+
+// PriceToleranceGTE applies the GTE predicate on the "price_tolerance" field.
+func PriceToleranceGTE(v decimal.Decimal) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldGTE(FieldPriceTolerance, v))
+}
+
+// PriceToleranceLT applies the LT predicate on the "price_tolerance" field.
+func PriceToleranceLT(v decimal.Decimal) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldLT(FieldPriceTolerance, v))
+}
+
+// PriceToleranceLTE applies the LTE predicate on the "price_tolerance" field.
+func PriceToleranceLTE(v decimal.Decimal) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldLTE(FieldPriceTolerance, v))
+}
+
+// PriceToleranceIsNil applies the IsNil predicate on the "price_tolerance" field.
+func PriceToleranceIsNil() predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldIsNull(FieldPriceTolerance))
+}
+
+// PriceToleranceNotNil applies the NotNil predicate on the "price_tolerance" field.
+func PriceToleranceNotNil() predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldNotNull(FieldPriceTolerance))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PayableSetting) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PayableSetting) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PayableSetting) predicate.PayableSetting {
+	return predicate.PayableSetting(sql.NotPredicates(p))
+}
@@ -0,0 +1,802 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/payablesetting"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PayableSettingCreate is the builder for creating a PayableSetting entity.
+type PayableSettingCreate struct {
+	config
+	mutation *PayableSettingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *PayableSettingCreate) SetTenantID(v uuid.UUID) *PayableSettingCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetQuantityTolerance sets the "quantity_tolerance" field.
+func (_c *PayableSettingCreate) SetQuantityTolerance(v decimal.Decimal) *PayableSettingCreate {
+	_c.mutation.SetQuantityTolerance(v)
+	return _c
+}
+
+// SetNillableQuantityTolerance sets the "quantity_tolerance" field if the given value is not nil.
+func (_c *PayableSettingCreate) SetNillableQuantityTolerance(v *decimal.Decimal) *PayableSettingCreate {
+	if v != nil {
+		_c.SetQuantityTolerance(*v)
+	}
+	return _c
+}
+
+// SetPriceTolerance sets the "price_tolerance" field.
+func (_c *PayableSettingCreate) SetPriceTolerance(v decimal.Decimal) *PayableSettingCreate {
+	_c.mutation.SetPriceTolerance(v)
+	return _c
+}
+
+// SetNillablePriceTolerance sets the "price_tolerance" field if the given value is not nil.
+func (_c *PayableSettingCreate) SetNillablePriceTolerance(v *decimal.Decimal) *PayableSettingCreate {
+	if v != nil {
+		_c.SetPriceTolerance(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PayableSettingCreate) SetCreatedAt(v time.Time) *PayableSettingCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PayableSettingCreate) SetNillableCreatedAt(v *time.Time) *PayableSettingCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PayableSettingCreate) SetUpdatedAt(v time.Time) *PayableSettingCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PayableSettingCreate) SetNillableUpdatedAt(v *time.Time) *PayableSettingCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PayableSettingCreate) SetID(v uuid.UUID) *PayableSettingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PayableSettingCreate) SetNillableID(v *uuid.UUID) *PayableSettingCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PayableSettingMutation object of the builder.
+func (_c *PayableSettingCreate) Mutation() *PayableSettingMutation {
+	return _c.mutation
+}
+
+// Save creates the PayableSetting in the database.
+func (_c *PayableSettingCreate) Save(ctx context.Context) (*PayableSetting, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PayableSettingCreate) SaveX(ctx context.Context) *PayableSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PayableSettingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PayableSettingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PayableSettingCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := payablesetting.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := payablesetting.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := payablesetting.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PayableSettingCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "PayableSetting.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PayableSetting.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PayableSetting.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *PayableSettingCreate) sqlSave(ctx context.Context) (*PayableSetting, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PayableSettingCreate) createSpec() (*PayableSetting, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PayableSetting{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(payablesetting.Table, sqlgraph.NewFieldSpec(payablesetting.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(payablesetting.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.QuantityTolerance(); ok {
+		_spec.SetField(payablesetting.FieldQuantityTolerance, field.TypeFloat64, value)
+		_node.QuantityTolerance = value
+	}
+	if value, ok := _c.mutation.PriceTolerance(); ok {
+		_spec.SetField(payablesetting.FieldPriceTolerance, field.TypeFloat64, value)
+		_node.PriceTolerance = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(payablesetting.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(payablesetting.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PayableSetting.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PayableSettingUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PayableSettingCreate) OnConflict(opts ...sql.ConflictOption) *PayableSettingUpsertOne {
+	_c.conflict = opts
+	return &PayableSettingUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PayableSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PayableSettingCreate) OnConflictColumns(columns ...string) *PayableSettingUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PayableSettingUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PayableSettingUpsertOne is the builder for "upsert"-ing
+	//  one PayableSetting node.
+	PayableSettingUpsertOne struct {
+		create *PayableSettingCreate
+	}
+
+	// PayableSettingUpsert is the "OnConflict" setter.
+	PayableSettingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *PayableSettingUpsert) SetTenantID(v uuid.UUID) *PayableSettingUpsert {
+	u.Set(payablesetting.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *PayableSettingUpsert) UpdateTenantID() *PayableSettingUpsert {
+	u.SetExcluded(payablesetting.FieldTenantID)
+	return u
+}
+
+// SetQuantityTolerance sets the "quantity_tolerance" field.
+func (u *PayableSettingUpsert) SetQuantityTolerance(v decimal.Decimal) *PayableSettingUpsert {
+	u.Set(payablesetting.FieldQuantityTolerance, v)
+	return u
+}
+
+// UpdateQuantityTolerance sets the "quantity_tolerance" field to the value that was provided on create.
+func (u *PayableSettingUpsert) UpdateQuantityTolerance() *PayableSettingUpsert {
+	u.SetExcluded(payablesetting.FieldQuantityTolerance)
+	return u
+}
+
+// AddQuantityTolerance adds v to the "quantity_tolerance" field.
+func (u *PayableSettingUpsert) AddQuantityTolerance(v decimal.Decimal) *PayableSettingUpsert {
+	u.Add(payablesetting.FieldQuantityTolerance, v)
+	return u
+}
+
+// ClearQuantityTolerance clears the value of the "quantity_tolerance" field.
+func (u *PayableSettingUpsert) ClearQuantityTolerance() *PayableSettingUpsert {
+	u.SetNull(payablesetting.FieldQuantityTolerance)
+	return u
+}
+
+// SetPriceTolerance sets the "price_tolerance" field.
+func (u *PayableSettingUpsert) SetPriceTolerance(v decimal.Decimal) *PayableSettingUpsert {
+	u.Set(payablesetting.FieldPriceTolerance, v)
+	return u
+}
+
+// UpdatePriceTolerance sets the "price_tolerance" field to the value that was provided on create.
+func (u *PayableSettingUpsert) UpdatePriceTolerance() *PayableSettingUpsert {
+	u.SetExcluded(payablesetting.FieldPriceTolerance)
+	return u
+}
+
+// AddPriceTolerance adds v to the "price_tolerance" field.
+func (u *PayableSettingUpsert) AddPriceTolerance(v decimal.Decimal) *PayableSettingUpsert {
+	u.Add(payablesetting.FieldPriceTolerance, v)
+	return u
+}
+
+// ClearPriceTolerance clears the value of the "price_tolerance" field.
+func (u *PayableSettingUpsert) ClearPriceTolerance() *PayableSettingUpsert {
+	u.SetNull(payablesetting.FieldPriceTolerance)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PayableSettingUpsert) SetUpdatedAt(v time.Time) *PayableSettingUpsert {
+	u.Set(payablesetting.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PayableSettingUpsert) UpdateUpdatedAt() *PayableSettingUpsert {
+	u.SetExcluded(payablesetting.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PayableSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(payablesetting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PayableSettingUpsertOne) UpdateNewValues() *PayableSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(payablesetting.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(payablesetting.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PayableSetting.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PayableSettingUpsertOne) Ignore() *PayableSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PayableSettingUpsertOne) DoNothing() *PayableSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PayableSettingCreate.OnConflict
+// documentation for more info.
+func (u *PayableSettingUpsertOne) Update(set func(*PayableSettingUpsert)) *PayableSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PayableSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *PayableSettingUpsertOne) SetTenantID(v uuid.UUID) *PayableSettingUpsertOne {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *PayableSettingUpsertOne) UpdateTenantID() *PayableSettingUpsertOne {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetQuantityTolerance sets the "quantity_tolerance" field.
+func (u *PayableSettingUpsertOne) SetQuantityTolerance(v decimal.Decimal) *PayableSettingUpsertOne {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.SetQuantityTolerance(v)
+	})
+}
+
+// AddQuantityTolerance adds v to the "quantity_tolerance" field.
+func (u *PayableSettingUpsertOne) AddQuantityTolerance(v decimal.Decimal) *PayableSettingUpsertOne {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.AddQuantityTolerance(v)
+	})
+}
+
+// UpdateQuantityTolerance sets the "quantity_tolerance" field to the value that was provided on create.
+func (u *PayableSettingUpsertOne) UpdateQuantityTolerance() *PayableSettingUpsertOne {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.UpdateQuantityTolerance()
+	})
+}
+
+// ClearQuantityTolerance clears the value of the "quantity_tolerance" field.
+func (u *PayableSettingUpsertOne) ClearQuantityTolerance() *PayableSettingUpsertOne {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.ClearQuantityTolerance()
+	})
+}
+
+// SetPriceTolerance sets the "price_tolerance" field.
+func (u *PayableSettingUpsertOne) SetPriceTolerance(v decimal.Decimal) *PayableSettingUpsertOne {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.SetPriceTolerance(v)
+	})
+}
+
+// AddPriceTolerance adds v to the "price_tolerance" field.
+func (u *PayableSettingUpsertOne) AddPriceTolerance(v decimal.Decimal) *PayableSettingUpsertOne {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.AddPriceTolerance(v)
+	})
+}
+
+// UpdatePriceTolerance sets the "price_tolerance" field to the value that was provided on create.
+func (u *PayableSettingUpsertOne) UpdatePriceTolerance() *PayableSettingUpsertOne {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.UpdatePriceTolerance()
+	})
+}
+
+// ClearPriceTolerance clears the value of the "price_tolerance" field.
+func (u *PayableSettingUpsertOne) ClearPriceTolerance() *PayableSettingUpsertOne {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.ClearPriceTolerance()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PayableSettingUpsertOne) SetUpdatedAt(v time.Time) *PayableSettingUpsertOne {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PayableSettingUpsertOne) UpdateUpdatedAt() *PayableSettingUpsertOne {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PayableSettingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PayableSettingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PayableSettingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PayableSettingUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PayableSettingUpsertOne.ID is not supported by MySQL driver. Use PayableSettingUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PayableSettingUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PayableSettingCreateBulk is the builder for creating many PayableSetting entities in bulk.
+type PayableSettingCreateBulk struct {
+	config
+	err      error
+	builders []*PayableSettingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PayableSetting entities in the database.
+func (_c *PayableSettingCreateBulk) Save(ctx context.Context) ([]*PayableSetting, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PayableSetting, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PayableSettingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PayableSettingCreateBulk) SaveX(ctx context.Context) []*PayableSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PayableSettingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PayableSettingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PayableSetting.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PayableSettingUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PayableSettingCreateBulk) OnConflict(opts ...sql.ConflictOption) *PayableSettingUpsertBulk {
+	_c.conflict = opts
+	return &PayableSettingUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PayableSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PayableSettingCreateBulk) OnConflictColumns(columns ...string) *PayableSettingUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PayableSettingUpsertBulk{
+		create: _c,
+	}
+}
+
+// PayableSettingUpsertBulk is the builder for "upsert"-ing
+// a bulk of PayableSetting nodes.
+type PayableSettingUpsertBulk struct {
+	create *PayableSettingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PayableSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(payablesetting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PayableSettingUpsertBulk) UpdateNewValues() *PayableSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(payablesetting.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(payablesetting.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PayableSetting.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PayableSettingUpsertBulk) Ignore() *PayableSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PayableSettingUpsertBulk) DoNothing() *PayableSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PayableSettingCreateBulk.OnConflict
+// documentation for more info.
+func (u *PayableSettingUpsertBulk) Update(set func(*PayableSettingUpsert)) *PayableSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PayableSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *PayableSettingUpsertBulk) SetTenantID(v uuid.UUID) *PayableSettingUpsertBulk {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *PayableSettingUpsertBulk) UpdateTenantID() *PayableSettingUpsertBulk {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetQuantityTolerance sets the "quantity_tolerance" field.
+func (u *PayableSettingUpsertBulk) SetQuantityTolerance(v decimal.Decimal) *PayableSettingUpsertBulk {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.SetQuantityTolerance(v)
+	})
+}
+
+// AddQuantityTolerance adds v to the "quantity_tolerance" field.
+func (u *PayableSettingUpsertBulk) AddQuantityTolerance(v decimal.Decimal) *PayableSettingUpsertBulk {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.AddQuantityTolerance(v)
+	})
+}
+
+// UpdateQuantityTolerance sets the "quantity_tolerance" field to the value that was provided on create.
+func (u *PayableSettingUpsertBulk) UpdateQuantityTolerance() *PayableSettingUpsertBulk {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.UpdateQuantityTolerance()
+	})
+}
+
+// ClearQuantityTolerance clears the value of the "quantity_tolerance" field.
+func (u *PayableSettingUpsertBulk) ClearQuantityTolerance() *PayableSettingUpsertBulk {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.ClearQuantityTolerance()
+	})
+}
+
+// SetPriceTolerance sets the "price_tolerance" field.
+func (u *PayableSettingUpsertBulk) SetPriceTolerance(v decimal.Decimal) *PayableSettingUpsertBulk {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.SetPriceTolerance(v)
+	})
+}
+
+// AddPriceTolerance adds v to the "price_tolerance" field.
+func (u *PayableSettingUpsertBulk) AddPriceTolerance(v decimal.Decimal) *PayableSettingUpsertBulk {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.AddPriceTolerance(v)
+	})
+}
+
+// UpdatePriceTolerance sets the "price_tolerance" field to the value that was provided on create.
+func (u *PayableSettingUpsertBulk) UpdatePriceTolerance() *PayableSettingUpsertBulk {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.UpdatePriceTolerance()
+	})
+}
+
+// ClearPriceTolerance clears the value of the "price_tolerance" field.
+func (u *PayableSettingUpsertBulk) ClearPriceTolerance() *PayableSettingUpsertBulk {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.ClearPriceTolerance()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PayableSettingUpsertBulk) SetUpdatedAt(v time.Time) *PayableSettingUpsertBulk {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PayableSettingUpsertBulk) UpdateUpdatedAt() *PayableSettingUpsertBulk {
+	return u.Update(func(s *PayableSettingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PayableSettingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PayableSettingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PayableSettingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PayableSettingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,392 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/payablesetting"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PayableSettingUpdate is the builder for updating PayableSetting entities.
+type PayableSettingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PayableSettingMutation
+}
+
+// Where appends a list predicates to the PayableSettingUpdate builder.
+func (_u *PayableSettingUpdate) Where(ps ...predicate.PayableSetting) *PayableSettingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *PayableSettingUpdate) SetTenantID(v uuid.UUID) *PayableSettingUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *PayableSettingUpdate) SetNillableTenantID(v *uuid.UUID) *PayableSettingUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetQuantityTolerance sets the "quantity_tolerance" field.
+func (_u *PayableSettingUpdate) SetQuantityTolerance(v decimal.Decimal) *PayableSettingUpdate {
+	_u.mutation.ResetQuantityTolerance()
+	_u.mutation.SetQuantityTolerance(v)
+	return _u
+}
+
+// SetNillableQuantityTolerance sets the "quantity_tolerance" field if the given value is not nil.
+func (_u *PayableSettingUpdate) SetNillableQuantityTolerance(v *decimal.Decimal) *PayableSettingUpdate {
+	if v != nil {
+		_u.SetQuantityTolerance(*v)
+	}
+	return _u
+}
+
+// AddQuantityTolerance adds value to the "quantity_tolerance" field.
+func (_u *PayableSettingUpdate) AddQuantityTolerance(v decimal.Decimal) *PayableSettingUpdate {
+	_u.mutation.AddQuantityTolerance(v)
+	return _u
+}
+
+// ClearQuantityTolerance clears the value of the "quantity_tolerance" field.
+func (_u *PayableSettingUpdate) ClearQuantityTolerance() *PayableSettingUpdate {
+	_u.mutation.ClearQuantityTolerance()
+	return _u
+}
+
+// SetPriceTolerance sets the "price_tolerance" field.
+func (_u *PayableSettingUpdate) SetPriceTolerance(v decimal.Decimal) *PayableSettingUpdate {
+	_u.mutation.ResetPriceTolerance()
+	_u.mutation.SetPriceTolerance(v)
+	return _u
+}
+
+// SetNillablePriceTolerance sets the "price_tolerance" field if the given value is not nil.
+func (_u *PayableSettingUpdate) SetNillablePriceTolerance(v *decimal.Decimal) *PayableSettingUpdate {
+	if v != nil {
+		_u.SetPriceTolerance(*v)
+	}
+	return _u
+}
+
+// AddPriceTolerance adds value to the "price_tolerance" field.
+func (_u *PayableSettingUpdate) AddPriceTolerance(v decimal.Decimal) *PayableSettingUpdate {
+	_u.mutation.AddPriceTolerance(v)
+	return _u
+}
+
+// ClearPriceTolerance clears the value of the "price_tolerance" field.
+func (_u *PayableSettingUpdate) ClearPriceTolerance() *PayableSettingUpdate {
+	_u.mutation.ClearPriceTolerance()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PayableSettingUpdate) SetUpdatedAt(v time.Time) *PayableSettingUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the PayableSettingMutation object of the builder.
+func (_u *PayableSettingUpdate) Mutation() *PayableSettingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PayableSettingUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PayableSettingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PayableSettingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PayableSettingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PayableSettingUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := payablesetting.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *PayableSettingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(payablesetting.Table, payablesetting.Columns, sqlgraph.NewFieldSpec(payablesetting.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(payablesetting.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.QuantityTolerance(); ok {
+		_spec.SetField(payablesetting.FieldQuantityTolerance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedQuantityTolerance(); ok {
+		_spec.AddField(payablesetting.FieldQuantityTolerance, field.TypeFloat64, value)
+	}
+	if _u.mutation.QuantityToleranceCleared() {
+		_spec.ClearField(payablesetting.FieldQuantityTolerance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.PriceTolerance(); ok {
+		_spec.SetField(payablesetting.FieldPriceTolerance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPriceTolerance(); ok {
+		_spec.AddField(payablesetting.FieldPriceTolerance, field.TypeFloat64, value)
+	}
+	if _u.mutation.PriceToleranceCleared() {
+		_spec.ClearField(payablesetting.FieldPriceTolerance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(payablesetting.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payablesetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PayableSettingUpdateOne is the builder for updating a single PayableSetting entity.
+type PayableSettingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PayableSettingMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *PayableSettingUpdateOne) SetTenantID(v uuid.UUID) *PayableSettingUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *PayableSettingUpdateOne) SetNillableTenantID(v *uuid.UUID) *PayableSettingUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetQuantityTolerance sets the "quantity_tolerance" field.
+func (_u *PayableSettingUpdateOne) SetQuantityTolerance(v decimal.Decimal) *PayableSettingUpdateOne {
+	_u.mutation.ResetQuantityTolerance()
+	_u.mutation.SetQuantityTolerance(v)
+	return _u
+}
+
+// SetNillableQuantityTolerance sets the "quantity_tolerance" field if the given value is not nil.
+func (_u *PayableSettingUpdateOne) SetNillableQuantityTolerance(v *decimal.Decimal) *PayableSettingUpdateOne {
+	if v != nil {
+		_u.SetQuantityTolerance(*v)
+	}
+	return _u
+}
+
+// AddQuantityTolerance adds value to the "quantity_tolerance" field.
+func (_u *PayableSettingUpdateOne) AddQuantityTolerance(v decimal.Decimal) *PayableSettingUpdateOne {
+	_u.mutation.AddQuantityTolerance(v)
+	return _u
+}
+
+// ClearQuantityTolerance clears the value of the "quantity_tolerance" field.
+func (_u *PayableSettingUpdateOne) ClearQuantityTolerance() *PayableSettingUpdateOne {
+	_u.mutation.ClearQuantityTolerance()
+	return _u
+}
+
+// SetPriceTolerance sets the "price_tolerance" field.
+func (_u *PayableSettingUpdateOne) SetPriceTolerance(v decimal.Decimal) *PayableSettingUpdateOne {
+	_u.mutation.ResetPriceTolerance()
+	_u.mutation.SetPriceTolerance(v)
+	return _u
+}
+
+// SetNillablePriceTolerance sets the "price_tolerance" field if the given value is not nil.
+func (_u *PayableSettingUpdateOne) SetNillablePriceTolerance(v *decimal.Decimal) *PayableSettingUpdateOne {
+	if v != nil {
+		_u.SetPriceTolerance(*v)
+	}
+	return _u
+}
+
+// AddPriceTolerance adds value to the "price_tolerance" field.
+func (_u *PayableSettingUpdateOne) AddPriceTolerance(v decimal.Decimal) *PayableSettingUpdateOne {
+	_u.mutation.AddPriceTolerance(v)
+	return _u
+}
+
+// ClearPriceTolerance clears the value of the "price_tolerance" field.
+func (_u *PayableSettingUpdateOne) ClearPriceTolerance() *PayableSettingUpdateOne {
+	_u.mutation.ClearPriceTolerance()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PayableSettingUpdateOne) SetUpdatedAt(v time.Time) *PayableSettingUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the PayableSettingMutation object of the builder.
+func (_u *PayableSettingUpdateOne) Mutation() *PayableSettingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PayableSettingUpdate builder.
+func (_u *PayableSettingUpdateOne) Where(ps ...predicate.PayableSetting) *PayableSettingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PayableSettingUpdateOne) Select(field string, fields ...string) *PayableSettingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PayableSetting entity.
+func (_u *PayableSettingUpdateOne) Save(ctx context.Context) (*PayableSetting, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PayableSettingUpdateOne) SaveX(ctx context.Context) *PayableSetting {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PayableSettingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PayableSettingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PayableSettingUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := payablesetting.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *PayableSettingUpdateOne) sqlSave(ctx context.Context) (_node *PayableSetting, err error) {
+	_spec := sqlgraph.NewUpdateSpec(payablesetting.Table, payablesetting.Columns, sqlgraph.NewFieldSpec(payablesetting.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PayableSetting.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payablesetting.FieldID)
+		for _, f := range fields {
+			if !payablesetting.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != payablesetting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(payablesetting.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.QuantityTolerance(); ok {
+		_spec.SetField(payablesetting.FieldQuantityTolerance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedQuantityTolerance(); ok {
+		_spec.AddField(payablesetting.FieldQuantityTolerance, field.TypeFloat64, value)
+	}
+	if _u.mutation.QuantityToleranceCleared() {
+		_spec.ClearField(payablesetting.FieldQuantityTolerance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.PriceTolerance(); ok {
+		_spec.SetField(payablesetting.FieldPriceTolerance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPriceTolerance(); ok {
+		_spec.AddField(payablesetting.FieldPriceTolerance, field.TypeFloat64, value)
+	}
+	if _u.mutation.PriceToleranceCleared() {
+		_spec.ClearField(payablesetting.FieldPriceTolerance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(payablesetting.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &PayableSetting{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payablesetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/payeepayout"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PayeePayout is the model entity for the PayeePayout schema.
+type PayeePayout struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// WalletID holds the value of the "wallet_id" field.
+	WalletID uuid.UUID `json:"wallet_id,omitempty"`
+	// PayoutNumber holds the value of the "payout_number" field.
+	PayoutNumber string `json:"payout_number,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// M-Pesa number the payout is sent to
+	Destination string `json:"destination,omitempty"`
+	// Status: pending, completed, rejected
+	Status string `json:"status,omitempty"`
+	// Payout request identifier in the logistics service
+	RequestID *string `json:"request_id,omitempty"`
+	// Reason code of a rejected payout
+	RejectionCode string `json:"rejection_code,omitempty"`
+	// RejectionReason holds the value of the "rejection_reason" field.
+	RejectionReason string `json:"rejection_reason,omitempty"`
+	// M-Pesa receipt or bank reference of the payment
+	PaymentReference string `json:"payment_reference,omitempty"`
+	// User who requested the payout; empty when requested by the logistics service
+	RequestedBy uuid.UUID `json:"requested_by,omitempty"`
+	// CompletedBy holds the value of the "completed_by" field.
+	CompletedBy uuid.UUID `json:"completed_by,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt time.Time `json:"completed_at,omitempty"`
+	// JournalEntryID holds the value of the "journal_entry_id" field.
+	JournalEntryID uuid.UUID `json:"journal_entry_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PayeePayout) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case payeepayout.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case payeepayout.FieldPayoutNumber, payeepayout.FieldCurrency, payeepayout.FieldDestination, payeepayout.FieldStatus, payeepayout.FieldRequestID, payeepayout.FieldRejectionCode, payeepayout.FieldRejectionReason, payeepayout.FieldPaymentReference:
+			values[i] = new(sql.NullString)
+		case payeepayout.FieldCompletedAt, payeepayout.FieldCreatedAt, payeepayout.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case payeepayout.FieldID, payeepayout.FieldTenantID, payeepayout.FieldWalletID, payeepayout.FieldRequestedBy, payeepayout.FieldCompletedBy, payeepayout.FieldJournalEntryID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PayeePayout fields.
+func (_m *PayeePayout) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case payeepayout.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case payeepayout.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case payeepayout.FieldWalletID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field wallet_id", values[i])
+			} else if value != nil {
+				_m.WalletID = *value
+			}
+		case payeepayout.FieldPayoutNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payout_number", values[i])
+			} else if value.Valid {
+				_m.PayoutNumber = value.String
+			}
+		case payeepayout.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case payeepayout.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case payeepayout.FieldDestination:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field destination", values[i])
+			} else if value.Valid {
+				_m.Destination = value.String
+			}
+		case payeepayout.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case payeepayout.FieldRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				_m.RequestID = new(string)
+				*_m.RequestID = value.String
+			}
+		case payeepayout.FieldRejectionCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rejection_code", values[i])
+			} else if value.Valid {
+				_m.RejectionCode = value.String
+			}
+		case payeepayout.FieldRejectionReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rejection_reason", values[i])
+			} else if value.Valid {
+				_m.RejectionReason = value.String
+			}
+		case payeepayout.FieldPaymentReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_reference", values[i])
+			} else if value.Valid {
+				_m.PaymentReference = value.String
+			}
+		case payeepayout.FieldRequestedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_by", values[i])
+			} else if value != nil {
+				_m.RequestedBy = *value
+			}
+		case payeepayout.FieldCompletedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_by", values[i])
+			} else if value != nil {
+				_m.CompletedBy = *value
+			}
+		case payeepayout.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = value.Time
+			}
+		case payeepayout.FieldJournalEntryID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field journal_entry_id", values[i])
+			} else if value != nil {
+				_m.JournalEntryID = *value
+			}
+		case payeepayout.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case payeepayout.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PayeePayout.
+// This includes values selected through modifiers, order, etc.
+func (_m *PayeePayout) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PayeePayout.
+// Note that you need to call PayeePayout.Unwrap() before calling this method if this PayeePayout
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PayeePayout) Update() *PayeePayoutUpdateOne {
+	return NewPayeePayoutClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PayeePayout entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PayeePayout) Unwrap() *PayeePayout {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PayeePayout is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PayeePayout) String() string {
+	var builder strings.Builder
+	builder.WriteString("PayeePayout(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("wallet_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WalletID))
+	builder.WriteString(", ")
+	builder.WriteString("payout_number=")
+	builder.WriteString(_m.PayoutNumber)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("destination=")
+	builder.WriteString(_m.Destination)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.RequestID; v != nil {
+		builder.WriteString("request_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("rejection_code=")
+	builder.WriteString(_m.RejectionCode)
+	builder.WriteString(", ")
+	builder.WriteString("rejection_reason=")
+	builder.WriteString(_m.RejectionReason)
+	builder.WriteString(", ")
+	builder.WriteString("payment_reference=")
+	builder.WriteString(_m.PaymentReference)
+	builder.WriteString(", ")
+	builder.WriteString("requested_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequestedBy))
+	builder.WriteString(", ")
+	builder.WriteString("completed_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CompletedBy))
+	builder.WriteString(", ")
+	builder.WriteString("completed_at=")
+	builder.WriteString(_m.CompletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("journal_entry_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JournalEntryID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PayeePayouts is a parsable slice of PayeePayout.
+type PayeePayouts []*PayeePayout
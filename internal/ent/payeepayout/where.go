@@ -0,0 +1,1127 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payeepayout
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldTenantID, v))
+}
+
+// WalletID applies equality check predicate on the "wallet_id" field. It's identical to WalletIDEQ.
+func WalletID(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldWalletID, v))
+}
+
+// PayoutNumber applies equality check predicate on the "payout_number" field. It's identical to PayoutNumberEQ.
+func PayoutNumber(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldPayoutNumber, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldAmount, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Destination applies equality check predicate on the "destination" field. It's identical to DestinationEQ.
+func Destination(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldDestination, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldStatus, v))
+}
+
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RejectionCode applies equality check predicate on the "rejection_code" field. It's identical to RejectionCodeEQ.
+func RejectionCode(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldRejectionCode, v))
+}
+
+// RejectionReason applies equality check predicate on the "rejection_reason" field. It's identical to RejectionReasonEQ.
+func RejectionReason(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldRejectionReason, v))
+}
+
+// PaymentReference applies equality check predicate on the "payment_reference" field. It's identical to PaymentReferenceEQ.
+func PaymentReference(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldPaymentReference, v))
+}
+
+// RequestedBy applies equality check predicate on the "requested_by" field. It's identical to RequestedByEQ.
+func RequestedBy(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// CompletedBy applies equality check predicate on the "completed_by" field. It's identical to CompletedByEQ.
+func CompletedBy(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldCompletedBy, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// JournalEntryID applies equality check predicate on the "journal_entry_id" field. It's identical to JournalEntryIDEQ.
+func JournalEntryID(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLTE(FieldTenantID, v))
+}
+
+// WalletIDEQ applies the EQ predicate on the "wallet_id" field.
+func WalletIDEQ(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldWalletID, v))
+}
+
+// WalletIDNEQ applies the NEQ predicate on the "wallet_id" field.
+func WalletIDNEQ(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNEQ(FieldWalletID, v))
+}
+
+// WalletIDIn applies the In predicate on the "wallet_id" field.
+func WalletIDIn(vs ...uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIn(FieldWalletID, vs...))
+}
+
+// WalletIDNotIn applies the NotIn predicate on the "wallet_id" field.
+func WalletIDNotIn(vs ...uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotIn(FieldWalletID, vs...))
+}
+
+// WalletIDGT applies the GT predicate on the "wallet_id" field.
+func WalletIDGT(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGT(FieldWalletID, v))
+}
+
+// WalletIDGTE applies the GTE predicate on the "wallet_id" field.
+func WalletIDGTE(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGTE(FieldWalletID, v))
+}
+
+// WalletIDLT applies the LT predicate on the "wallet_id" field.
+func WalletIDLT(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLT(FieldWalletID, v))
+}
+
+// WalletIDLTE applies the LTE predicate on the "wallet_id" field.
+func WalletIDLTE(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLTE(FieldWalletID, v))
+}
+
+// PayoutNumberEQ applies the EQ predicate on the "payout_number" field.
+func PayoutNumberEQ(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldPayoutNumber, v))
+}
+
+// PayoutNumberNEQ applies the NEQ predicate on the "payout_number" field.
+func PayoutNumberNEQ(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNEQ(FieldPayoutNumber, v))
+}
+
+// PayoutNumberIn applies the In predicate on the "payout_number" field.
+func PayoutNumberIn(vs ...string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIn(FieldPayoutNumber, vs...))
+}
+
+// PayoutNumberNotIn applies the NotIn predicate on the "payout_number" field.
+func PayoutNumberNotIn(vs ...string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotIn(FieldPayoutNumber, vs...))
+}
+
+// PayoutNumberGT applies the GT predicate on the "payout_number" field.
+func PayoutNumberGT(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGT(FieldPayoutNumber, v))
+}
+
+// PayoutNumberGTE applies the GTE predicate on the "payout_number" field.
+func PayoutNumberGTE(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGTE(FieldPayoutNumber, v))
+}
+
+// PayoutNumberLT applies the LT predicate on the "payout_number" field.
+func PayoutNumberLT(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLT(FieldPayoutNumber, v))
+}
+
+// PayoutNumberLTE applies the LTE predicate on the "payout_number" field.
+func PayoutNumberLTE(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLTE(FieldPayoutNumber, v))
+}
+
+// PayoutNumberContains applies the Contains predicate on the "payout_number" field.
+func PayoutNumberContains(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldContains(FieldPayoutNumber, v))
+}
+
+// PayoutNumberHasPrefix applies the HasPrefix predicate on the "payout_number" field.
+func PayoutNumberHasPrefix(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldHasPrefix(FieldPayoutNumber, v))
+}
+
+// PayoutNumberHasSuffix applies the HasSuffix predicate on the "payout_number" field.
+func PayoutNumberHasSuffix(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldHasSuffix(FieldPayoutNumber, v))
+}
+
+// PayoutNumberEqualFold applies the EqualFold predicate on the "payout_number" field.
+func PayoutNumberEqualFold(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEqualFold(FieldPayoutNumber, v))
+}
+
+// PayoutNumberContainsFold applies the ContainsFold predicate on the "payout_number" field.
+func PayoutNumberContainsFold(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldContainsFold(FieldPayoutNumber, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLTE(FieldAmount, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// DestinationEQ applies the EQ predicate on the "destination" field.
+func DestinationEQ(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldDestination, v))
+}
+
+// DestinationNEQ applies the NEQ predicate on the "destination" field.
+func DestinationNEQ(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNEQ(FieldDestination, v))
+}
+
+// DestinationIn applies the In predicate on the "destination" field.
+func DestinationIn(vs ...string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIn(FieldDestination, vs...))
+}
+
+// DestinationNotIn applies the NotIn predicate on the "destination" field.
+func DestinationNotIn(vs ...string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotIn(FieldDestination, vs...))
+}
+
+// DestinationGT applies the GT predicate on the "destination" field.
+func DestinationGT(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGT(FieldDestination, v))
+}
+
+// DestinationGTE applies the GTE predicate on the "destination" field.
+func DestinationGTE(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGTE(FieldDestination, v))
+}
+
+// DestinationLT applies the LT predicate on the "destination" field.
+func DestinationLT(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLT(FieldDestination, v))
+}
+
+// DestinationLTE applies the LTE predicate on the "destination" field.
+func DestinationLTE(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLTE(FieldDestination, v))
+}
+
+// DestinationContains applies the Contains predicate on the "destination" field.
+func DestinationContains(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldContains(FieldDestination, v))
+}
+
+// DestinationHasPrefix applies the HasPrefix predicate on the "destination" field.
+func DestinationHasPrefix(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldHasPrefix(FieldDestination, v))
+}
+
+// DestinationHasSuffix applies the HasSuffix predicate on the "destination" field.
+func DestinationHasSuffix(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldHasSuffix(FieldDestination, v))
+}
+
+// DestinationIsNil applies the IsNil predicate on the "destination" field.
+func DestinationIsNil() predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIsNull(FieldDestination))
+}
+
+// DestinationNotNil applies the NotNil predicate on the "destination" field.
+func DestinationNotNil() predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotNull(FieldDestination))
+}
+
+// DestinationEqualFold applies the EqualFold predicate on the "destination" field.
+func DestinationEqualFold(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEqualFold(FieldDestination, v))
+}
+
+// DestinationContainsFold applies the ContainsFold predicate on the "destination" field.
+func DestinationContainsFold(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldContainsFold(FieldDestination, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLTE(FieldRequestID, v))
+}
+
+// RequestIDContains applies the Contains predicate on the "request_id" field.
+func RequestIDContains(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldContains(FieldRequestID, v))
+}
+
+// RequestIDHasPrefix applies the HasPrefix predicate on the "request_id" field.
+func RequestIDHasPrefix(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldHasPrefix(FieldRequestID, v))
+}
+
+// RequestIDHasSuffix applies the HasSuffix predicate on the "request_id" field.
+func RequestIDHasSuffix(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldHasSuffix(FieldRequestID, v))
+}
+
+// RequestIDIsNil applies the IsNil predicate on the "request_id" field.
+func RequestIDIsNil() predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIsNull(FieldRequestID))
+}
+
+// RequestIDNotNil applies the NotNil predicate on the "request_id" field.
+func RequestIDNotNil() predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotNull(FieldRequestID))
+}
+
+// RequestIDEqualFold applies the EqualFold predicate on the "request_id" field.
+func RequestIDEqualFold(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEqualFold(FieldRequestID, v))
+}
+
+// RequestIDContainsFold applies the ContainsFold predicate on the "request_id" field.
+func RequestIDContainsFold(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldContainsFold(FieldRequestID, v))
+}
+
+// RejectionCodeEQ applies the EQ predicate on the "rejection_code" field.
+func RejectionCodeEQ(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldRejectionCode, v))
+}
+
+// RejectionCodeNEQ applies the NEQ predicate on the "rejection_code" field.
+func RejectionCodeNEQ(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNEQ(FieldRejectionCode, v))
+}
+
+// RejectionCodeIn applies the In predicate on the "rejection_code" field.
+func RejectionCodeIn(vs ...string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIn(FieldRejectionCode, vs...))
+}
+
+// RejectionCodeNotIn applies the NotIn predicate on the "rejection_code" field.
+func RejectionCodeNotIn(vs ...string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotIn(FieldRejectionCode, vs...))
+}
+
+// RejectionCodeGT applies the GT predicate on the "rejection_code" field.
+func RejectionCodeGT(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGT(FieldRejectionCode, v))
+}
+
+// RejectionCodeGTE applies the GTE predicate on the "rejection_code" field.
+func RejectionCodeGTE(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGTE(FieldRejectionCode, v))
+}
+
+// RejectionCodeLT applies the LT predicate on the "rejection_code" field.
+func RejectionCodeLT(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLT(FieldRejectionCode, v))
+}
+
+// RejectionCodeLTE applies the LTE predicate on the "rejection_code" field.
+func RejectionCodeLTE(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLTE(FieldRejectionCode, v))
+}
+
+// RejectionCodeContains applies the Contains predicate on the "rejection_code" field.
+func RejectionCodeContains(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldContains(FieldRejectionCode, v))
+}
+
+// RejectionCodeHasPrefix applies the HasPrefix predicate on the "rejection_code" field.
+func RejectionCodeHasPrefix(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldHasPrefix(FieldRejectionCode, v))
+}
+
+// RejectionCodeHasSuffix applies the HasSuffix predicate on the "rejection_code" field.
+func RejectionCodeHasSuffix(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldHasSuffix(FieldRejectionCode, v))
+}
+
+// RejectionCodeIsNil applies the IsNil predicate on the "rejection_code" field.
+func RejectionCodeIsNil() predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIsNull(FieldRejectionCode))
+}
+
+// RejectionCodeNotNil applies the NotNil predicate on the "rejection_code" field.
+func RejectionCodeNotNil() predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotNull(FieldRejectionCode))
+}
+
+// RejectionCodeEqualFold applies the EqualFold predicate on the "rejection_code" field.
+func RejectionCodeEqualFold(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEqualFold(FieldRejectionCode, v))
+}
+
+// RejectionCodeContainsFold applies the ContainsFold predicate on the "rejection_code" field.
+func RejectionCodeContainsFold(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldContainsFold(FieldRejectionCode, v))
+}
+
+// RejectionReasonEQ applies the EQ predicate on the "rejection_reason" field.
+func RejectionReasonEQ(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldRejectionReason, v))
+}
+
+// RejectionReasonNEQ applies the NEQ predicate on the "rejection_reason" field.
+func RejectionReasonNEQ(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNEQ(FieldRejectionReason, v))
+}
+
+// RejectionReasonIn applies the In predicate on the "rejection_reason" field.
+func RejectionReasonIn(vs ...string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIn(FieldRejectionReason, vs...))
+}
+
+// RejectionReasonNotIn applies the NotIn predicate on the "rejection_reason" field.
+func RejectionReasonNotIn(vs ...string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotIn(FieldRejectionReason, vs...))
+}
+
+// RejectionReasonGT applies the GT predicate on the "rejection_reason" field.
+func RejectionReasonGT(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGT(FieldRejectionReason, v))
+}
+
+// RejectionReasonGTE applies the GTE predicate on the "rejection_reason" field.
+func RejectionReasonGTE(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGTE(FieldRejectionReason, v))
+}
+
+// RejectionReasonLT applies the LT predicate on the "rejection_reason" field.
+func RejectionReasonLT(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLT(FieldRejectionReason, v))
+}
+
+// RejectionReasonLTE applies the LTE predicate on the "rejection_reason" field.
+func RejectionReasonLTE(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLTE(FieldRejectionReason, v))
+}
+
+// RejectionReasonContains applies the Contains predicate on the "rejection_reason" field.
+func RejectionReasonContains(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldContains(FieldRejectionReason, v))
+}
+
+// RejectionReasonHasPrefix applies the HasPrefix predicate on the "rejection_reason" field.
+func RejectionReasonHasPrefix(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldHasPrefix(FieldRejectionReason, v))
+}
+
+// RejectionReasonHasSuffix applies the HasSuffix predicate on the "rejection_reason" field.
+func RejectionReasonHasSuffix(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldHasSuffix(FieldRejectionReason, v))
+}
+
+// RejectionReasonIsNil applies the IsNil predicate on the "rejection_reason" field.
+func RejectionReasonIsNil() predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIsNull(FieldRejectionReason))
+}
+
+// RejectionReasonNotNil applies the NotNil predicate on the "rejection_reason" field.
+func RejectionReasonNotNil() predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotNull(FieldRejectionReason))
+}
+
+// RejectionReasonEqualFold applies the EqualFold predicate on the "rejection_reason" field.
+func RejectionReasonEqualFold(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEqualFold(FieldRejectionReason, v))
+}
+
+// RejectionReasonContainsFold applies the ContainsFold predicate on the "rejection_reason" field.
+func RejectionReasonContainsFold(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldContainsFold(FieldRejectionReason, v))
+}
+
+// PaymentReferenceEQ applies the EQ predicate on the "payment_reference" field.
+func PaymentReferenceEQ(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldPaymentReference, v))
+}
+
+// PaymentReferenceNEQ applies the NEQ predicate on the "payment_reference" field.
+func PaymentReferenceNEQ(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNEQ(FieldPaymentReference, v))
+}
+
+// PaymentReferenceIn applies the In predicate on the "payment_reference" field.
+func PaymentReferenceIn(vs ...string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIn(FieldPaymentReference, vs...))
+}
+
+// PaymentReferenceNotIn applies the NotIn predicate on the "payment_reference" field.
+func PaymentReferenceNotIn(vs ...string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotIn(FieldPaymentReference, vs...))
+}
+
+// PaymentReferenceGT applies the GT predicate on the "payment_reference" field.
+func PaymentReferenceGT(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGT(FieldPaymentReference, v))
+}
+
+// PaymentReferenceGTE applies the GTE predicate on the "payment_reference" field.
+func PaymentReferenceGTE(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGTE(FieldPaymentReference, v))
+}
+
+// PaymentReferenceLT applies the LT predicate on the "payment_reference" field.
+func PaymentReferenceLT(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLT(FieldPaymentReference, v))
+}
+
+// PaymentReferenceLTE applies the LTE predicate on the "payment_reference" field.
+func PaymentReferenceLTE(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLTE(FieldPaymentReference, v))
+}
+
+// PaymentReferenceContains applies the Contains predicate on the "payment_reference" field.
+func PaymentReferenceContains(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldContains(FieldPaymentReference, v))
+}
+
+// PaymentReferenceHasPrefix applies the HasPrefix predicate on the "payment_reference" field.
+func PaymentReferenceHasPrefix(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldHasPrefix(FieldPaymentReference, v))
+}
+
+// PaymentReferenceHasSuffix applies the HasSuffix predicate on the "payment_reference" field.
+func PaymentReferenceHasSuffix(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldHasSuffix(FieldPaymentReference, v))
+}
+
+// PaymentReferenceIsNil applies the IsNil predicate on the "payment_reference" field.
+func PaymentReferenceIsNil() predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIsNull(FieldPaymentReference))
+}
+
+// PaymentReferenceNotNil applies the NotNil predicate on the "payment_reference" field.
+func PaymentReferenceNotNil() predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotNull(FieldPaymentReference))
+}
+
+// PaymentReferenceEqualFold applies the EqualFold predicate on the "payment_reference" field.
+func PaymentReferenceEqualFold(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEqualFold(FieldPaymentReference, v))
+}
+
+// PaymentReferenceContainsFold applies the ContainsFold predicate on the "payment_reference" field.
+func PaymentReferenceContainsFold(v string) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldContainsFold(FieldPaymentReference, v))
+}
+
+// RequestedByEQ applies the EQ predicate on the "requested_by" field.
+func RequestedByEQ(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// RequestedByNEQ applies the NEQ predicate on the "requested_by" field.
+func RequestedByNEQ(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNEQ(FieldRequestedBy, v))
+}
+
+// RequestedByIn applies the In predicate on the "requested_by" field.
+func RequestedByIn(vs ...uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByNotIn applies the NotIn predicate on the "requested_by" field.
+func RequestedByNotIn(vs ...uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByGT applies the GT predicate on the "requested_by" field.
+func RequestedByGT(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGT(FieldRequestedBy, v))
+}
+
+// RequestedByGTE applies the GTE predicate on the "requested_by" field.
+func RequestedByGTE(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGTE(FieldRequestedBy, v))
+}
+
+// RequestedByLT applies the LT predicate on the "requested_by" field.
+func RequestedByLT(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLT(FieldRequestedBy, v))
+}
+
+// RequestedByLTE applies the LTE predicate on the "requested_by" field.
+func RequestedByLTE(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLTE(FieldRequestedBy, v))
+}
+
+// RequestedByIsNil applies the IsNil predicate on the "requested_by" field.
+func RequestedByIsNil() predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIsNull(FieldRequestedBy))
+}
+
+// RequestedByNotNil applies the NotNil predicate on the "requested_by" field.
+func RequestedByNotNil() predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotNull(FieldRequestedBy))
+}
+
+// CompletedByEQ applies the EQ predicate on the "completed_by" field.
+func CompletedByEQ(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldCompletedBy, v))
+}
+
+// CompletedByNEQ applies the NEQ predicate on the "completed_by" field.
+func CompletedByNEQ(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNEQ(FieldCompletedBy, v))
+}
+
+// CompletedByIn applies the In predicate on the "completed_by" field.
+func CompletedByIn(vs ...uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIn(FieldCompletedBy, vs...))
+}
+
+// CompletedByNotIn applies the NotIn predicate on the "completed_by" field.
+func CompletedByNotIn(vs ...uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotIn(FieldCompletedBy, vs...))
+}
+
+// CompletedByGT applies the GT predicate on the "completed_by" field.
+func CompletedByGT(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGT(FieldCompletedBy, v))
+}
+
+// CompletedByGTE applies the GTE predicate on the "completed_by" field.
+func CompletedByGTE(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGTE(FieldCompletedBy, v))
+}
+
+// CompletedByLT applies the LT predicate on the "completed_by" field.
+func CompletedByLT(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLT(FieldCompletedBy, v))
+}
+
+// CompletedByLTE applies the LTE predicate on the "completed_by" field.
+func CompletedByLTE(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLTE(FieldCompletedBy, v))
+}
+
+// CompletedByIsNil applies the IsNil predicate on the "completed_by" field.
+func CompletedByIsNil() predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIsNull(FieldCompletedBy))
+}
+
+// CompletedByNotNil applies the NotNil predicate on the "completed_by" field.
+func CompletedByNotNil() predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotNull(FieldCompletedBy))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// JournalEntryIDEQ applies the EQ predicate on the "journal_entry_id" field.
+func JournalEntryIDEQ(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDNEQ applies the NEQ predicate on the "journal_entry_id" field.
+func JournalEntryIDNEQ(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIn applies the In predicate on the "journal_entry_id" field.
+func JournalEntryIDIn(vs ...uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDNotIn applies the NotIn predicate on the "journal_entry_id" field.
+func JournalEntryIDNotIn(vs ...uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDGT applies the GT predicate on the "journal_entry_id" field.
+func JournalEntryIDGT(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGT(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDGTE applies the GTE predicate on the "journal_entry_id" field.
+func JournalEntryIDGTE(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGTE(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDLT applies the LT predicate on the "journal_entry_id" field.
+func JournalEntryIDLT(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLT(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDLTE applies the LTE predicate on the "journal_entry_id" field.
+func JournalEntryIDLTE(v uuid.UUID) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLTE(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIsNil applies the IsNil predicate on the "journal_entry_id" field.
+func JournalEntryIDIsNil() predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIsNull(FieldJournalEntryID))
+}
+
+// JournalEntryIDNotNil applies the NotNil predicate on the "journal_entry_id" field.
+func JournalEntryIDNotNil() predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotNull(FieldJournalEntryID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PayeePayout) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PayeePayout) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PayeePayout) predicate.PayeePayout {
+	return predicate.PayeePayout(sql.NotPredicates(p))
+}
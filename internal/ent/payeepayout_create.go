@@ -0,0 +1,1358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/payeepayout"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PayeePayoutCreate is the builder for creating a PayeePayout entity.
+type PayeePayoutCreate struct {
+	config
+	mutation *PayeePayoutMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *PayeePayoutCreate) SetTenantID(v uuid.UUID) *PayeePayoutCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (_c *PayeePayoutCreate) SetWalletID(v uuid.UUID) *PayeePayoutCreate {
+	_c.mutation.SetWalletID(v)
+	return _c
+}
+
+// SetPayoutNumber sets the "payout_number" field.
+func (_c *PayeePayoutCreate) SetPayoutNumber(v string) *PayeePayoutCreate {
+	_c.mutation.SetPayoutNumber(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *PayeePayoutCreate) SetAmount(v decimal.Decimal) *PayeePayoutCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *PayeePayoutCreate) SetCurrency(v string) *PayeePayoutCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *PayeePayoutCreate) SetNillableCurrency(v *string) *PayeePayoutCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetDestination sets the "destination" field.
+func (_c *PayeePayoutCreate) SetDestination(v string) *PayeePayoutCreate {
+	_c.mutation.SetDestination(v)
+	return _c
+}
+
+// SetNillableDestination sets the "destination" field if the given value is not nil.
+func (_c *PayeePayoutCreate) SetNillableDestination(v *string) *PayeePayoutCreate {
+	if v != nil {
+		_c.SetDestination(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *PayeePayoutCreate) SetStatus(v string) *PayeePayoutCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PayeePayoutCreate) SetNillableStatus(v *string) *PayeePayoutCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetRequestID sets the "request_id" field.
+func (_c *PayeePayoutCreate) SetRequestID(v string) *PayeePayoutCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_c *PayeePayoutCreate) SetNillableRequestID(v *string) *PayeePayoutCreate {
+	if v != nil {
+		_c.SetRequestID(*v)
+	}
+	return _c
+}
+
+// SetRejectionCode sets the "rejection_code" field.
+func (_c *PayeePayoutCreate) SetRejectionCode(v string) *PayeePayoutCreate {
+	_c.mutation.SetRejectionCode(v)
+	return _c
+}
+
+// SetNillableRejectionCode sets the "rejection_code" field if the given value is not nil.
+func (_c *PayeePayoutCreate) SetNillableRejectionCode(v *string) *PayeePayoutCreate {
+	if v != nil {
+		_c.SetRejectionCode(*v)
+	}
+	return _c
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_c *PayeePayoutCreate) SetRejectionReason(v string) *PayeePayoutCreate {
+	_c.mutation.SetRejectionReason(v)
+	return _c
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_c *PayeePayoutCreate) SetNillableRejectionReason(v *string) *PayeePayoutCreate {
+	if v != nil {
+		_c.SetRejectionReason(*v)
+	}
+	return _c
+}
+
+// SetPaymentReference sets the "payment_reference" field.
+func (_c *PayeePayoutCreate) SetPaymentReference(v string) *PayeePayoutCreate {
+	_c.mutation.SetPaymentReference(v)
+	return _c
+}
+
+// SetNillablePaymentReference sets the "payment_reference" field if the given value is not nil.
+func (_c *PayeePayoutCreate) SetNillablePaymentReference(v *string) *PayeePayoutCreate {
+	if v != nil {
+		_c.SetPaymentReference(*v)
+	}
+	return _c
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (_c *PayeePayoutCreate) SetRequestedBy(v uuid.UUID) *PayeePayoutCreate {
+	_c.mutation.SetRequestedBy(v)
+	return _c
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (_c *PayeePayoutCreate) SetNillableRequestedBy(v *uuid.UUID) *PayeePayoutCreate {
+	if v != nil {
+		_c.SetRequestedBy(*v)
+	}
+	return _c
+}
+
+// SetCompletedBy sets the "completed_by" field.
+func (_c *PayeePayoutCreate) SetCompletedBy(v uuid.UUID) *PayeePayoutCreate {
+	_c.mutation.SetCompletedBy(v)
+	return _c
+}
+
+// SetNillableCompletedBy sets the "completed_by" field if the given value is not nil.
+func (_c *PayeePayoutCreate) SetNillableCompletedBy(v *uuid.UUID) *PayeePayoutCreate {
+	if v != nil {
+		_c.SetCompletedBy(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *PayeePayoutCreate) SetCompletedAt(v time.Time) *PayeePayoutCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *PayeePayoutCreate) SetNillableCompletedAt(v *time.Time) *PayeePayoutCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_c *PayeePayoutCreate) SetJournalEntryID(v uuid.UUID) *PayeePayoutCreate {
+	_c.mutation.SetJournalEntryID(v)
+	return _c
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_c *PayeePayoutCreate) SetNillableJournalEntryID(v *uuid.UUID) *PayeePayoutCreate {
+	if v != nil {
+		_c.SetJournalEntryID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PayeePayoutCreate) SetCreatedAt(v time.Time) *PayeePayoutCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PayeePayoutCreate) SetNillableCreatedAt(v *time.Time) *PayeePayoutCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PayeePayoutCreate) SetUpdatedAt(v time.Time) *PayeePayoutCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PayeePayoutCreate) SetNillableUpdatedAt(v *time.Time) *PayeePayoutCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PayeePayoutCreate) SetID(v uuid.UUID) *PayeePayoutCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PayeePayoutCreate) SetNillableID(v *uuid.UUID) *PayeePayoutCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PayeePayoutMutation object of the builder.
+func (_c *PayeePayoutCreate) Mutation() *PayeePayoutMutation {
+	return _c.mutation
+}
+
+// Save creates the PayeePayout in the database.
+func (_c *PayeePayoutCreate) Save(ctx context.Context) (*PayeePayout, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PayeePayoutCreate) SaveX(ctx context.Context) *PayeePayout {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PayeePayoutCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PayeePayoutCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PayeePayoutCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := payeepayout.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := payeepayout.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := payeepayout.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := payeepayout.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := payeepayout.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PayeePayoutCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "PayeePayout.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet_id", err: errors.New(`ent: missing required field "PayeePayout.wallet_id"`)}
+	}
+	if _, ok := _c.mutation.PayoutNumber(); !ok {
+		return &ValidationError{Name: "payout_number", err: errors.New(`ent: missing required field "PayeePayout.payout_number"`)}
+	}
+	if v, ok := _c.mutation.PayoutNumber(); ok {
+		if err := payeepayout.PayoutNumberValidator(v); err != nil {
+			return &ValidationError{Name: "payout_number", err: fmt.Errorf(`ent: validator failed for field "PayeePayout.payout_number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "PayeePayout.amount"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "PayeePayout.currency"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PayeePayout.status"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PayeePayout.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PayeePayout.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *PayeePayoutCreate) sqlSave(ctx context.Context) (*PayeePayout, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PayeePayoutCreate) createSpec() (*PayeePayout, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PayeePayout{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(payeepayout.Table, sqlgraph.NewFieldSpec(payeepayout.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(payeepayout.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.WalletID(); ok {
+		_spec.SetField(payeepayout.FieldWalletID, field.TypeUUID, value)
+		_node.WalletID = value
+	}
+	if value, ok := _c.mutation.PayoutNumber(); ok {
+		_spec.SetField(payeepayout.FieldPayoutNumber, field.TypeString, value)
+		_node.PayoutNumber = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(payeepayout.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(payeepayout.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Destination(); ok {
+		_spec.SetField(payeepayout.FieldDestination, field.TypeString, value)
+		_node.Destination = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(payeepayout.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(payeepayout.FieldRequestID, field.TypeString, value)
+		_node.RequestID = &value
+	}
+	if value, ok := _c.mutation.RejectionCode(); ok {
+		_spec.SetField(payeepayout.FieldRejectionCode, field.TypeString, value)
+		_node.RejectionCode = value
+	}
+	if value, ok := _c.mutation.RejectionReason(); ok {
+		_spec.SetField(payeepayout.FieldRejectionReason, field.TypeString, value)
+		_node.RejectionReason = value
+	}
+	if value, ok := _c.mutation.PaymentReference(); ok {
+		_spec.SetField(payeepayout.FieldPaymentReference, field.TypeString, value)
+		_node.PaymentReference = value
+	}
+	if value, ok := _c.mutation.RequestedBy(); ok {
+		_spec.SetField(payeepayout.FieldRequestedBy, field.TypeUUID, value)
+		_node.RequestedBy = value
+	}
+	if value, ok := _c.mutation.CompletedBy(); ok {
+		_spec.SetField(payeepayout.FieldCompletedBy, field.TypeUUID, value)
+		_node.CompletedBy = value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(payeepayout.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = value
+	}
+	if value, ok := _c.mutation.JournalEntryID(); ok {
+		_spec.SetField(payeepayout.FieldJournalEntryID, field.TypeUUID, value)
+		_node.JournalEntryID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(payeepayout.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(payeepayout.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PayeePayout.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PayeePayoutUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PayeePayoutCreate) OnConflict(opts ...sql.ConflictOption) *PayeePayoutUpsertOne {
+	_c.conflict = opts
+	return &PayeePayoutUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PayeePayout.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PayeePayoutCreate) OnConflictColumns(columns ...string) *PayeePayoutUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PayeePayoutUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PayeePayoutUpsertOne is the builder for "upsert"-ing
+	//  one PayeePayout node.
+	PayeePayoutUpsertOne struct {
+		create *PayeePayoutCreate
+	}
+
+	// PayeePayoutUpsert is the "OnConflict" setter.
+	PayeePayoutUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *PayeePayoutUpsert) SetTenantID(v uuid.UUID) *PayeePayoutUpsert {
+	u.Set(payeepayout.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *PayeePayoutUpsert) UpdateTenantID() *PayeePayoutUpsert {
+	u.SetExcluded(payeepayout.FieldTenantID)
+	return u
+}
+
+// SetDestination sets the "destination" field.
+func (u *PayeePayoutUpsert) SetDestination(v string) *PayeePayoutUpsert {
+	u.Set(payeepayout.FieldDestination, v)
+	return u
+}
+
+// UpdateDestination sets the "destination" field to the value that was provided on create.
+func (u *PayeePayoutUpsert) UpdateDestination() *PayeePayoutUpsert {
+	u.SetExcluded(payeepayout.FieldDestination)
+	return u
+}
+
+// ClearDestination clears the value of the "destination" field.
+func (u *PayeePayoutUpsert) ClearDestination() *PayeePayoutUpsert {
+	u.SetNull(payeepayout.FieldDestination)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PayeePayoutUpsert) SetStatus(v string) *PayeePayoutUpsert {
+	u.Set(payeepayout.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PayeePayoutUpsert) UpdateStatus() *PayeePayoutUpsert {
+	u.SetExcluded(payeepayout.FieldStatus)
+	return u
+}
+
+// SetRejectionCode sets the "rejection_code" field.
+func (u *PayeePayoutUpsert) SetRejectionCode(v string) *PayeePayoutUpsert {
+	u.Set(payeepayout.FieldRejectionCode, v)
+	return u
+}
+
+// UpdateRejectionCode sets the "rejection_code" field to the value that was provided on create.
+func (u *PayeePayoutUpsert) UpdateRejectionCode() *PayeePayoutUpsert {
+	u.SetExcluded(payeepayout.FieldRejectionCode)
+	return u
+}
+
+// ClearRejectionCode clears the value of the "rejection_code" field.
+func (u *PayeePayoutUpsert) ClearRejectionCode() *PayeePayoutUpsert {
+	u.SetNull(payeepayout.FieldRejectionCode)
+	return u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *PayeePayoutUpsert) SetRejectionReason(v string) *PayeePayoutUpsert {
+	u.Set(payeepayout.FieldRejectionReason, v)
+	return u
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *PayeePayoutUpsert) UpdateRejectionReason() *PayeePayoutUpsert {
+	u.SetExcluded(payeepayout.FieldRejectionReason)
+	return u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *PayeePayoutUpsert) ClearRejectionReason() *PayeePayoutUpsert {
+	u.SetNull(payeepayout.FieldRejectionReason)
+	return u
+}
+
+// SetPaymentReference sets the "payment_reference" field.
+func (u *PayeePayoutUpsert) SetPaymentReference(v string) *PayeePayoutUpsert {
+	u.Set(payeepayout.FieldPaymentReference, v)
+	return u
+}
+
+// UpdatePaymentReference sets the "payment_reference" field to the value that was provided on create.
+func (u *PayeePayoutUpsert) UpdatePaymentReference() *PayeePayoutUpsert {
+	u.SetExcluded(payeepayout.FieldPaymentReference)
+	return u
+}
+
+// ClearPaymentReference clears the value of the "payment_reference" field.
+func (u *PayeePayoutUpsert) ClearPaymentReference() *PayeePayoutUpsert {
+	u.SetNull(payeepayout.FieldPaymentReference)
+	return u
+}
+
+// SetCompletedBy sets the "completed_by" field.
+func (u *PayeePayoutUpsert) SetCompletedBy(v uuid.UUID) *PayeePayoutUpsert {
+	u.Set(payeepayout.FieldCompletedBy, v)
+	return u
+}
+
+// UpdateCompletedBy sets the "completed_by" field to the value that was provided on create.
+func (u *PayeePayoutUpsert) UpdateCompletedBy() *PayeePayoutUpsert {
+	u.SetExcluded(payeepayout.FieldCompletedBy)
+	return u
+}
+
+// ClearCompletedBy clears the value of the "completed_by" field.
+func (u *PayeePayoutUpsert) ClearCompletedBy() *PayeePayoutUpsert {
+	u.SetNull(payeepayout.FieldCompletedBy)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *PayeePayoutUpsert) SetCompletedAt(v time.Time) *PayeePayoutUpsert {
+	u.Set(payeepayout.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *PayeePayoutUpsert) UpdateCompletedAt() *PayeePayoutUpsert {
+	u.SetExcluded(payeepayout.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *PayeePayoutUpsert) ClearCompletedAt() *PayeePayoutUpsert {
+	u.SetNull(payeepayout.FieldCompletedAt)
+	return u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *PayeePayoutUpsert) SetJournalEntryID(v uuid.UUID) *PayeePayoutUpsert {
+	u.Set(payeepayout.FieldJournalEntryID, v)
+	return u
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *PayeePayoutUpsert) UpdateJournalEntryID() *PayeePayoutUpsert {
+	u.SetExcluded(payeepayout.FieldJournalEntryID)
+	return u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *PayeePayoutUpsert) ClearJournalEntryID() *PayeePayoutUpsert {
+	u.SetNull(payeepayout.FieldJournalEntryID)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PayeePayoutUpsert) SetUpdatedAt(v time.Time) *PayeePayoutUpsert {
+	u.Set(payeepayout.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PayeePayoutUpsert) UpdateUpdatedAt() *PayeePayoutUpsert {
+	u.SetExcluded(payeepayout.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PayeePayout.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(payeepayout.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PayeePayoutUpsertOne) UpdateNewValues() *PayeePayoutUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(payeepayout.FieldID)
+		}
+		if _, exists := u.create.mutation.WalletID(); exists {
+			s.SetIgnore(payeepayout.FieldWalletID)
+		}
+		if _, exists := u.create.mutation.PayoutNumber(); exists {
+			s.SetIgnore(payeepayout.FieldPayoutNumber)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(payeepayout.FieldAmount)
+		}
+		if _, exists := u.create.mutation.Currency(); exists {
+			s.SetIgnore(payeepayout.FieldCurrency)
+		}
+		if _, exists := u.create.mutation.RequestID(); exists {
+			s.SetIgnore(payeepayout.FieldRequestID)
+		}
+		if _, exists := u.create.mutation.RequestedBy(); exists {
+			s.SetIgnore(payeepayout.FieldRequestedBy)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(payeepayout.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PayeePayout.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PayeePayoutUpsertOne) Ignore() *PayeePayoutUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PayeePayoutUpsertOne) DoNothing() *PayeePayoutUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PayeePayoutCreate.OnConflict
+// documentation for more info.
+func (u *PayeePayoutUpsertOne) Update(set func(*PayeePayoutUpsert)) *PayeePayoutUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PayeePayoutUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *PayeePayoutUpsertOne) SetTenantID(v uuid.UUID) *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *PayeePayoutUpsertOne) UpdateTenantID() *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetDestination sets the "destination" field.
+func (u *PayeePayoutUpsertOne) SetDestination(v string) *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetDestination(v)
+	})
+}
+
+// UpdateDestination sets the "destination" field to the value that was provided on create.
+func (u *PayeePayoutUpsertOne) UpdateDestination() *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdateDestination()
+	})
+}
+
+// ClearDestination clears the value of the "destination" field.
+func (u *PayeePayoutUpsertOne) ClearDestination() *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.ClearDestination()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PayeePayoutUpsertOne) SetStatus(v string) *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PayeePayoutUpsertOne) UpdateStatus() *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRejectionCode sets the "rejection_code" field.
+func (u *PayeePayoutUpsertOne) SetRejectionCode(v string) *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetRejectionCode(v)
+	})
+}
+
+// UpdateRejectionCode sets the "rejection_code" field to the value that was provided on create.
+func (u *PayeePayoutUpsertOne) UpdateRejectionCode() *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdateRejectionCode()
+	})
+}
+
+// ClearRejectionCode clears the value of the "rejection_code" field.
+func (u *PayeePayoutUpsertOne) ClearRejectionCode() *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.ClearRejectionCode()
+	})
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *PayeePayoutUpsertOne) SetRejectionReason(v string) *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetRejectionReason(v)
+	})
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *PayeePayoutUpsertOne) UpdateRejectionReason() *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdateRejectionReason()
+	})
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *PayeePayoutUpsertOne) ClearRejectionReason() *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.ClearRejectionReason()
+	})
+}
+
+// SetPaymentReference sets the "payment_reference" field.
+func (u *PayeePayoutUpsertOne) SetPaymentReference(v string) *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetPaymentReference(v)
+	})
+}
+
+// UpdatePaymentReference sets the "payment_reference" field to the value that was provided on create.
+func (u *PayeePayoutUpsertOne) UpdatePaymentReference() *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdatePaymentReference()
+	})
+}
+
+// ClearPaymentReference clears the value of the "payment_reference" field.
+func (u *PayeePayoutUpsertOne) ClearPaymentReference() *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.ClearPaymentReference()
+	})
+}
+
+// SetCompletedBy sets the "completed_by" field.
+func (u *PayeePayoutUpsertOne) SetCompletedBy(v uuid.UUID) *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetCompletedBy(v)
+	})
+}
+
+// UpdateCompletedBy sets the "completed_by" field to the value that was provided on create.
+func (u *PayeePayoutUpsertOne) UpdateCompletedBy() *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdateCompletedBy()
+	})
+}
+
+// ClearCompletedBy clears the value of the "completed_by" field.
+func (u *PayeePayoutUpsertOne) ClearCompletedBy() *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.ClearCompletedBy()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *PayeePayoutUpsertOne) SetCompletedAt(v time.Time) *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *PayeePayoutUpsertOne) UpdateCompletedAt() *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *PayeePayoutUpsertOne) ClearCompletedAt() *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *PayeePayoutUpsertOne) SetJournalEntryID(v uuid.UUID) *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetJournalEntryID(v)
+	})
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *PayeePayoutUpsertOne) UpdateJournalEntryID() *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdateJournalEntryID()
+	})
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *PayeePayoutUpsertOne) ClearJournalEntryID() *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.ClearJournalEntryID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PayeePayoutUpsertOne) SetUpdatedAt(v time.Time) *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PayeePayoutUpsertOne) UpdateUpdatedAt() *PayeePayoutUpsertOne {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PayeePayoutUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PayeePayoutCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PayeePayoutUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PayeePayoutUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PayeePayoutUpsertOne.ID is not supported by MySQL driver. Use PayeePayoutUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PayeePayoutUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PayeePayoutCreateBulk is the builder for creating many PayeePayout entities in bulk.
+type PayeePayoutCreateBulk struct {
+	config
+	err      error
+	builders []*PayeePayoutCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PayeePayout entities in the database.
+func (_c *PayeePayoutCreateBulk) Save(ctx context.Context) ([]*PayeePayout, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PayeePayout, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PayeePayoutMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PayeePayoutCreateBulk) SaveX(ctx context.Context) []*PayeePayout {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PayeePayoutCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PayeePayoutCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PayeePayout.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PayeePayoutUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PayeePayoutCreateBulk) OnConflict(opts ...sql.ConflictOption) *PayeePayoutUpsertBulk {
+	_c.conflict = opts
+	return &PayeePayoutUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PayeePayout.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PayeePayoutCreateBulk) OnConflictColumns(columns ...string) *PayeePayoutUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PayeePayoutUpsertBulk{
+		create: _c,
+	}
+}
+
+// PayeePayoutUpsertBulk is the builder for "upsert"-ing
+// a bulk of PayeePayout nodes.
+type PayeePayoutUpsertBulk struct {
+	create *PayeePayoutCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PayeePayout.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(payeepayout.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PayeePayoutUpsertBulk) UpdateNewValues() *PayeePayoutUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(payeepayout.FieldID)
+			}
+			if _, exists := b.mutation.WalletID(); exists {
+				s.SetIgnore(payeepayout.FieldWalletID)
+			}
+			if _, exists := b.mutation.PayoutNumber(); exists {
+				s.SetIgnore(payeepayout.FieldPayoutNumber)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(payeepayout.FieldAmount)
+			}
+			if _, exists := b.mutation.Currency(); exists {
+				s.SetIgnore(payeepayout.FieldCurrency)
+			}
+			if _, exists := b.mutation.RequestID(); exists {
+				s.SetIgnore(payeepayout.FieldRequestID)
+			}
+			if _, exists := b.mutation.RequestedBy(); exists {
+				s.SetIgnore(payeepayout.FieldRequestedBy)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(payeepayout.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PayeePayout.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PayeePayoutUpsertBulk) Ignore() *PayeePayoutUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PayeePayoutUpsertBulk) DoNothing() *PayeePayoutUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PayeePayoutCreateBulk.OnConflict
+// documentation for more info.
+func (u *PayeePayoutUpsertBulk) Update(set func(*PayeePayoutUpsert)) *PayeePayoutUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PayeePayoutUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *PayeePayoutUpsertBulk) SetTenantID(v uuid.UUID) *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *PayeePayoutUpsertBulk) UpdateTenantID() *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetDestination sets the "destination" field.
+func (u *PayeePayoutUpsertBulk) SetDestination(v string) *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetDestination(v)
+	})
+}
+
+// UpdateDestination sets the "destination" field to the value that was provided on create.
+func (u *PayeePayoutUpsertBulk) UpdateDestination() *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdateDestination()
+	})
+}
+
+// ClearDestination clears the value of the "destination" field.
+func (u *PayeePayoutUpsertBulk) ClearDestination() *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.ClearDestination()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PayeePayoutUpsertBulk) SetStatus(v string) *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PayeePayoutUpsertBulk) UpdateStatus() *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRejectionCode sets the "rejection_code" field.
+func (u *PayeePayoutUpsertBulk) SetRejectionCode(v string) *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetRejectionCode(v)
+	})
+}
+
+// UpdateRejectionCode sets the "rejection_code" field to the value that was provided on create.
+func (u *PayeePayoutUpsertBulk) UpdateRejectionCode() *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdateRejectionCode()
+	})
+}
+
+// ClearRejectionCode clears the value of the "rejection_code" field.
+func (u *PayeePayoutUpsertBulk) ClearRejectionCode() *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.ClearRejectionCode()
+	})
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *PayeePayoutUpsertBulk) SetRejectionReason(v string) *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetRejectionReason(v)
+	})
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *PayeePayoutUpsertBulk) UpdateRejectionReason() *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdateRejectionReason()
+	})
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *PayeePayoutUpsertBulk) ClearRejectionReason() *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.ClearRejectionReason()
+	})
+}
+
+// SetPaymentReference sets the "payment_reference" field.
+func (u *PayeePayoutUpsertBulk) SetPaymentReference(v string) *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetPaymentReference(v)
+	})
+}
+
+// UpdatePaymentReference sets the "payment_reference" field to the value that was provided on create.
+func (u *PayeePayoutUpsertBulk) UpdatePaymentReference() *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdatePaymentReference()
+	})
+}
+
+// ClearPaymentReference clears the value of the "payment_reference" field.
+func (u *PayeePayoutUpsertBulk) ClearPaymentReference() *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.ClearPaymentReference()
+	})
+}
+
+// SetCompletedBy sets the "completed_by" field.
+func (u *PayeePayoutUpsertBulk) SetCompletedBy(v uuid.UUID) *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetCompletedBy(v)
+	})
+}
+
+// UpdateCompletedBy sets the "completed_by" field to the value that was provided on create.
+func (u *PayeePayoutUpsertBulk) UpdateCompletedBy() *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdateCompletedBy()
+	})
+}
+
+// ClearCompletedBy clears the value of the "completed_by" field.
+func (u *PayeePayoutUpsertBulk) ClearCompletedBy() *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.ClearCompletedBy()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *PayeePayoutUpsertBulk) SetCompletedAt(v time.Time) *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *PayeePayoutUpsertBulk) UpdateCompletedAt() *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *PayeePayoutUpsertBulk) ClearCompletedAt() *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *PayeePayoutUpsertBulk) SetJournalEntryID(v uuid.UUID) *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetJournalEntryID(v)
+	})
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *PayeePayoutUpsertBulk) UpdateJournalEntryID() *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdateJournalEntryID()
+	})
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *PayeePayoutUpsertBulk) ClearJournalEntryID() *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.ClearJournalEntryID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PayeePayoutUpsertBulk) SetUpdatedAt(v time.Time) *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PayeePayoutUpsertBulk) UpdateUpdatedAt() *PayeePayoutUpsertBulk {
+	return u.Update(func(s *PayeePayoutUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PayeePayoutUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PayeePayoutCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PayeePayoutCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PayeePayoutUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/payeepayout"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+)
+
+// PayeePayoutDelete is the builder for deleting a PayeePayout entity.
+type PayeePayoutDelete struct {
+	config
+	hooks    []Hook
+	mutation *PayeePayoutMutation
+}
+
+// Where appends a list predicates to the PayeePayoutDelete builder.
+func (_d *PayeePayoutDelete) Where(ps ...predicate.PayeePayout) *PayeePayoutDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *PayeePayoutDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PayeePayoutDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *PayeePayoutDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(payeepayout.Table, sqlgraph.NewFieldSpec(payeepayout.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// PayeePayoutDeleteOne is the builder for deleting a single PayeePayout entity.
+type PayeePayoutDeleteOne struct {
+	_d *PayeePayoutDelete
+}
+
+// Where appends a list predicates to the PayeePayoutDelete builder.
+func (_d *PayeePayoutDeleteOne) Where(ps ...predicate.PayeePayout) *PayeePayoutDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *PayeePayoutDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{payeepayout.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PayeePayoutDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,657 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/payeepayout"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// PayeePayoutUpdate is the builder for updating PayeePayout entities.
+type PayeePayoutUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PayeePayoutMutation
+}
+
+// Where appends a list predicates to the PayeePayoutUpdate builder.
+func (_u *PayeePayoutUpdate) Where(ps ...predicate.PayeePayout) *PayeePayoutUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *PayeePayoutUpdate) SetTenantID(v uuid.UUID) *PayeePayoutUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *PayeePayoutUpdate) SetNillableTenantID(v *uuid.UUID) *PayeePayoutUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetDestination sets the "destination" field.
+func (_u *PayeePayoutUpdate) SetDestination(v string) *PayeePayoutUpdate {
+	_u.mutation.SetDestination(v)
+	return _u
+}
+
+// SetNillableDestination sets the "destination" field if the given value is not nil.
+func (_u *PayeePayoutUpdate) SetNillableDestination(v *string) *PayeePayoutUpdate {
+	if v != nil {
+		_u.SetDestination(*v)
+	}
+	return _u
+}
+
+// ClearDestination clears the value of the "destination" field.
+func (_u *PayeePayoutUpdate) ClearDestination() *PayeePayoutUpdate {
+	_u.mutation.ClearDestination()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PayeePayoutUpdate) SetStatus(v string) *PayeePayoutUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PayeePayoutUpdate) SetNillableStatus(v *string) *PayeePayoutUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRejectionCode sets the "rejection_code" field.
+func (_u *PayeePayoutUpdate) SetRejectionCode(v string) *PayeePayoutUpdate {
+	_u.mutation.SetRejectionCode(v)
+	return _u
+}
+
+// SetNillableRejectionCode sets the "rejection_code" field if the given value is not nil.
+func (_u *PayeePayoutUpdate) SetNillableRejectionCode(v *string) *PayeePayoutUpdate {
+	if v != nil {
+		_u.SetRejectionCode(*v)
+	}
+	return _u
+}
+
+// ClearRejectionCode clears the value of the "rejection_code" field.
+func (_u *PayeePayoutUpdate) ClearRejectionCode() *PayeePayoutUpdate {
+	_u.mutation.ClearRejectionCode()
+	return _u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_u *PayeePayoutUpdate) SetRejectionReason(v string) *PayeePayoutUpdate {
+	_u.mutation.SetRejectionReason(v)
+	return _u
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_u *PayeePayoutUpdate) SetNillableRejectionReason(v *string) *PayeePayoutUpdate {
+	if v != nil {
+		_u.SetRejectionReason(*v)
+	}
+	return _u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (_u *PayeePayoutUpdate) ClearRejectionReason() *PayeePayoutUpdate {
+	_u.mutation.ClearRejectionReason()
+	return _u
+}
+
+// SetPaymentReference sets the "payment_reference" field.
+func (_u *PayeePayoutUpdate) SetPaymentReference(v string) *PayeePayoutUpdate {
+	_u.mutation.SetPaymentReference(v)
+	return _u
+}
+
+// SetNillablePaymentReference sets the "payment_reference" field if the given value is not nil.
+func (_u *PayeePayoutUpdate) SetNillablePaymentReference(v *string) *PayeePayoutUpdate {
+	if v != nil {
+		_u.SetPaymentReference(*v)
+	}
+	return _u
+}
+
+// ClearPaymentReference clears the value of the "payment_reference" field.
+func (_u *PayeePayoutUpdate) ClearPaymentReference() *PayeePayoutUpdate {
+	_u.mutation.ClearPaymentReference()
+	return _u
+}
+
+// SetCompletedBy sets the "completed_by" field.
+func (_u *PayeePayoutUpdate) SetCompletedBy(v uuid.UUID) *PayeePayoutUpdate {
+	_u.mutation.SetCompletedBy(v)
+	return _u
+}
+
+// SetNillableCompletedBy sets the "completed_by" field if the given value is not nil.
+func (_u *PayeePayoutUpdate) SetNillableCompletedBy(v *uuid.UUID) *PayeePayoutUpdate {
+	if v != nil {
+		_u.SetCompletedBy(*v)
+	}
+	return _u
+}
+
+// ClearCompletedBy clears the value of the "completed_by" field.
+func (_u *PayeePayoutUpdate) ClearCompletedBy() *PayeePayoutUpdate {
+	_u.mutation.ClearCompletedBy()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *PayeePayoutUpdate) SetCompletedAt(v time.Time) *PayeePayoutUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *PayeePayoutUpdate) SetNillableCompletedAt(v *time.Time) *PayeePayoutUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *PayeePayoutUpdate) ClearCompletedAt() *PayeePayoutUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_u *PayeePayoutUpdate) SetJournalEntryID(v uuid.UUID) *PayeePayoutUpdate {
+	_u.mutation.SetJournalEntryID(v)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_u *PayeePayoutUpdate) SetNillableJournalEntryID(v *uuid.UUID) *PayeePayoutUpdate {
+	if v != nil {
+		_u.SetJournalEntryID(*v)
+	}
+	return _u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (_u *PayeePayoutUpdate) ClearJournalEntryID() *PayeePayoutUpdate {
+	_u.mutation.ClearJournalEntryID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PayeePayoutUpdate) SetUpdatedAt(v time.Time) *PayeePayoutUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the PayeePayoutMutation object of the builder.
+func (_u *PayeePayoutUpdate) Mutation() *PayeePayoutMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PayeePayoutUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PayeePayoutUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PayeePayoutUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PayeePayoutUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PayeePayoutUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := payeepayout.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *PayeePayoutUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(payeepayout.Table, payeepayout.Columns, sqlgraph.NewFieldSpec(payeepayout.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(payeepayout.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Destination(); ok {
+		_spec.SetField(payeepayout.FieldDestination, field.TypeString, value)
+	}
+	if _u.mutation.DestinationCleared() {
+		_spec.ClearField(payeepayout.FieldDestination, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(payeepayout.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(payeepayout.FieldRequestID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RejectionCode(); ok {
+		_spec.SetField(payeepayout.FieldRejectionCode, field.TypeString, value)
+	}
+	if _u.mutation.RejectionCodeCleared() {
+		_spec.ClearField(payeepayout.FieldRejectionCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.RejectionReason(); ok {
+		_spec.SetField(payeepayout.FieldRejectionReason, field.TypeString, value)
+	}
+	if _u.mutation.RejectionReasonCleared() {
+		_spec.ClearField(payeepayout.FieldRejectionReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.PaymentReference(); ok {
+		_spec.SetField(payeepayout.FieldPaymentReference, field.TypeString, value)
+	}
+	if _u.mutation.PaymentReferenceCleared() {
+		_spec.ClearField(payeepayout.FieldPaymentReference, field.TypeString)
+	}
+	if _u.mutation.RequestedByCleared() {
+		_spec.ClearField(payeepayout.FieldRequestedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.CompletedBy(); ok {
+		_spec.SetField(payeepayout.FieldCompletedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CompletedByCleared() {
+		_spec.ClearField(payeepayout.FieldCompletedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(payeepayout.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(payeepayout.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.JournalEntryID(); ok {
+		_spec.SetField(payeepayout.FieldJournalEntryID, field.TypeUUID, value)
+	}
+	if _u.mutation.JournalEntryIDCleared() {
+		_spec.ClearField(payeepayout.FieldJournalEntryID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(payeepayout.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payeepayout.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PayeePayoutUpdateOne is the builder for updating a single PayeePayout entity.
+type PayeePayoutUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PayeePayoutMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *PayeePayoutUpdateOne) SetTenantID(v uuid.UUID) *PayeePayoutUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *PayeePayoutUpdateOne) SetNillableTenantID(v *uuid.UUID) *PayeePayoutUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetDestination sets the "destination" field.
+func (_u *PayeePayoutUpdateOne) SetDestination(v string) *PayeePayoutUpdateOne {
+	_u.mutation.SetDestination(v)
+	return _u
+}
+
+// SetNillableDestination sets the "destination" field if the given value is not nil.
+func (_u *PayeePayoutUpdateOne) SetNillableDestination(v *string) *PayeePayoutUpdateOne {
+	if v != nil {
+		_u.SetDestination(*v)
+	}
+	return _u
+}
+
+// ClearDestination clears the value of the "destination" field.
+func (_u *PayeePayoutUpdateOne) ClearDestination() *PayeePayoutUpdateOne {
+	_u.mutation.ClearDestination()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PayeePayoutUpdateOne) SetStatus(v string) *PayeePayoutUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PayeePayoutUpdateOne) SetNillableStatus(v *string) *PayeePayoutUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRejectionCode sets the "rejection_code" field.
+func (_u *PayeePayoutUpdateOne) SetRejectionCode(v string) *PayeePayoutUpdateOne {
+	_u.mutation.SetRejectionCode(v)
+	return _u
+}
+
+// SetNillableRejectionCode sets the "rejection_code" field if the given value is not nil.
+func (_u *PayeePayoutUpdateOne) SetNillableRejectionCode(v *string) *PayeePayoutUpdateOne {
+	if v != nil {
+		_u.SetRejectionCode(*v)
+	}
+	return _u
+}
+
+// ClearRejectionCode clears the value of the "rejection_code" field.
+func (_u *PayeePayoutUpdateOne) ClearRejectionCode() *PayeePayoutUpdateOne {
+	_u.mutation.ClearRejectionCode()
+	return _u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_u *PayeePayoutUpdateOne) SetRejectionReason(v string) *PayeePayoutUpdateOne {
+	_u.mutation.SetRejectionReason(v)
+	return _u
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_u *PayeePayoutUpdateOne) SetNillableRejectionReason(v *string) *PayeePayoutUpdateOne {
+	if v != nil {
+		_u.SetRejectionReason(*v)
+	}
+	return _u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (_u *PayeePayoutUpdateOne) ClearRejectionReason() *PayeePayoutUpdateOne {
+	_u.mutation.ClearRejectionReason()
+	return _u
+}
+
+// SetPaymentReference sets the "payment_reference" field.
+func (_u *PayeePayoutUpdateOne) SetPaymentReference(v string) *PayeePayoutUpdateOne {
+	_u.mutation.SetPaymentReference(v)
+	return _u
+}
+
+// SetNillablePaymentReference sets the "payment_reference" field if the given value is not nil.
+func (_u *PayeePayoutUpdateOne) SetNillablePaymentReference(v *string) *PayeePayoutUpdateOne {
+	if v != nil {
+		_u.SetPaymentReference(*v)
+	}
+	return _u
+}
+
+// ClearPaymentReference clears the value of the "payment_reference" field.
+func (_u *PayeePayoutUpdateOne) ClearPaymentReference() *PayeePayoutUpdateOne {
+	_u.mutation.ClearPaymentReference()
+	return _u
+}
+
+// SetCompletedBy sets the "completed_by" field.
+func (_u *PayeePayoutUpdateOne) SetCompletedBy(v uuid.UUID) *PayeePayoutUpdateOne {
+	_u.mutation.SetCompletedBy(v)
+	return _u
+}
+
+// SetNillableCompletedBy sets the "completed_by" field if the given value is not nil.
+func (_u *PayeePayoutUpdateOne) SetNillableCompletedBy(v *uuid.UUID) *PayeePayoutUpdateOne {
+	if v != nil {
+		_u.SetCompletedBy(*v)
+	}
+	return _u
+}
+
+// ClearCompletedBy clears the value of the "completed_by" field.
+func (_u *PayeePayoutUpdateOne) ClearCompletedBy() *PayeePayoutUpdateOne {
+	_u.mutation.ClearCompletedBy()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *PayeePayoutUpdateOne) SetCompletedAt(v time.Time) *PayeePayoutUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *PayeePayoutUpdateOne) SetNillableCompletedAt(v *time.Time) *PayeePayoutUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *PayeePayoutUpdateOne) ClearCompletedAt() *PayeePayoutUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_u *PayeePayoutUpdateOne) SetJournalEntryID(v uuid.UUID) *PayeePayoutUpdateOne {
+	_u.mutation.SetJournalEntryID(v)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_u *PayeePayoutUpdateOne) SetNillableJournalEntryID(v *uuid.UUID) *PayeePayoutUpdateOne {
+	if v != nil {
+		_u.SetJournalEntryID(*v)
+	}
+	return _u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (_u *PayeePayoutUpdateOne) ClearJournalEntryID() *PayeePayoutUpdateOne {
+	_u.mutation.ClearJournalEntryID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PayeePayoutUpdateOne) SetUpdatedAt(v time.Time) *PayeePayoutUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the PayeePayoutMutation object of the builder.
+func (_u *PayeePayoutUpdateOne) Mutation() *PayeePayoutMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PayeePayoutUpdate builder.
+func (_u *PayeePayoutUpdateOne) Where(ps ...predicate.PayeePayout) *PayeePayoutUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PayeePayoutUpdateOne) Select(field string, fields ...string) *PayeePayoutUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PayeePayout entity.
+func (_u *PayeePayoutUpdateOne) Save(ctx context.Context) (*PayeePayout, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PayeePayoutUpdateOne) SaveX(ctx context.Context) *PayeePayout {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PayeePayoutUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PayeePayoutUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PayeePayoutUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := payeepayout.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *PayeePayoutUpdateOne) sqlSave(ctx context.Context) (_node *PayeePayout, err error) {
+	_spec := sqlgraph.NewUpdateSpec(payeepayout.Table, payeepayout.Columns, sqlgraph.NewFieldSpec(payeepayout.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PayeePayout.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payeepayout.FieldID)
+		for _, f := range fields {
+			if !payeepayout.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != payeepayout.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(payeepayout.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Destination(); ok {
+		_spec.SetField(payeepayout.FieldDestination, field.TypeString, value)
+	}
+	if _u.mutation.DestinationCleared() {
+		_spec.ClearField(payeepayout.FieldDestination, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(payeepayout.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(payeepayout.FieldRequestID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RejectionCode(); ok {
+		_spec.SetField(payeepayout.FieldRejectionCode, field.TypeString, value)
+	}
+	if _u.mutation.RejectionCodeCleared() {
+		_spec.ClearField(payeepayout.FieldRejectionCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.RejectionReason(); ok {
+		_spec.SetField(payeepayout.FieldRejectionReason, field.TypeString, value)
+	}
+	if _u.mutation.RejectionReasonCleared() {
+		_spec.ClearField(payeepayout.FieldRejectionReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.PaymentReference(); ok {
+		_spec.SetField(payeepayout.FieldPaymentReference, field.TypeString, value)
+	}
+	if _u.mutation.PaymentReferenceCleared() {
+		_spec.ClearField(payeepayout.FieldPaymentReference, field.TypeString)
+	}
+	if _u.mutation.RequestedByCleared() {
+		_spec.ClearField(payeepayout.FieldRequestedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.CompletedBy(); ok {
+		_spec.SetField(payeepayout.FieldCompletedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CompletedByCleared() {
+		_spec.ClearField(payeepayout.FieldCompletedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(payeepayout.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(payeepayout.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.JournalEntryID(); ok {
+		_spec.SetField(payeepayout.FieldJournalEntryID, field.TypeUUID, value)
+	}
+	if _u.mutation.JournalEntryIDCleared() {
+		_spec.ClearField(payeepayout.FieldJournalEntryID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(payeepayout.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &PayeePayout{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payeepayout.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
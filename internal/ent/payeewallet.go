@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/payeewallet"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PayeeWallet is the model entity for the PayeeWallet schema.
+type PayeeWallet struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Rider or driver identifier in the logistics service
+	PayeeID string `json:"payee_id,omitempty"`
+	// Payee type: rider, driver
+	PayeeType string `json:"payee_type,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// M-Pesa number payouts are sent to
+	Phone string `json:"phone,omitempty"`
+	// ISO currency code
+	Currency string `json:"currency,omitempty"`
+	// Earnings less deductions and completed payouts; negative while advances exceed earnings
+	Balance decimal.Decimal `json:"balance,omitempty"`
+	// Balance held by pending payouts
+	ReservedAmount decimal.Decimal `json:"reserved_amount,omitempty"`
+	// TotalEarned holds the value of the "total_earned" field.
+	TotalEarned decimal.Decimal `json:"total_earned,omitempty"`
+	// TotalDeducted holds the value of the "total_deducted" field.
+	TotalDeducted decimal.Decimal `json:"total_deducted,omitempty"`
+	// TotalPaid holds the value of the "total_paid" field.
+	TotalPaid decimal.Decimal `json:"total_paid,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PayeeWallet) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case payeewallet.FieldBalance, payeewallet.FieldReservedAmount, payeewallet.FieldTotalEarned, payeewallet.FieldTotalDeducted, payeewallet.FieldTotalPaid:
+			values[i] = new(decimal.Decimal)
+		case payeewallet.FieldPayeeID, payeewallet.FieldPayeeType, payeewallet.FieldName, payeewallet.FieldPhone, payeewallet.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case payeewallet.FieldCreatedAt, payeewallet.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case payeewallet.FieldID, payeewallet.FieldTenantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PayeeWallet fields.
+func (_m *PayeeWallet) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case payeewallet.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case payeewallet.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case payeewallet.FieldPayeeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payee_id", values[i])
+			} else if value.Valid {
+				_m.PayeeID = value.String
+			}
+		case payeewallet.FieldPayeeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payee_type", values[i])
+			} else if value.Valid {
+				_m.PayeeType = value.String
+			}
+		case payeewallet.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case payeewallet.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				_m.Phone = value.String
+			}
+		case payeewallet.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case payeewallet.FieldBalance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value != nil {
+				_m.Balance = *value
+			}
+		case payeewallet.FieldReservedAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field reserved_amount", values[i])
+			} else if value != nil {
+				_m.ReservedAmount = *value
+			}
+		case payeewallet.FieldTotalEarned:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field total_earned", values[i])
+			} else if value != nil {
+				_m.TotalEarned = *value
+			}
+		case payeewallet.FieldTotalDeducted:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field total_deducted", values[i])
+			} else if value != nil {
+				_m.TotalDeducted = *value
+			}
+		case payeewallet.FieldTotalPaid:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field total_paid", values[i])
+			} else if value != nil {
+				_m.TotalPaid = *value
+			}
+		case payeewallet.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case payeewallet.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PayeeWallet.
+// This includes values selected through modifiers, order, etc.
+func (_m *PayeeWallet) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PayeeWallet.
+// Note that you need to call PayeeWallet.Unwrap() before calling this method if this PayeeWallet
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PayeeWallet) Update() *PayeeWalletUpdateOne {
+	return NewPayeeWalletClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PayeeWallet entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PayeeWallet) Unwrap() *PayeeWallet {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PayeeWallet is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PayeeWallet) String() string {
+	var builder strings.Builder
+	builder.WriteString("PayeeWallet(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("payee_id=")
+	builder.WriteString(_m.PayeeID)
+	builder.WriteString(", ")
+	builder.WriteString("payee_type=")
+	builder.WriteString(_m.PayeeType)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("phone=")
+	builder.WriteString(_m.Phone)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("balance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Balance))
+	builder.WriteString(", ")
+	builder.WriteString("reserved_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReservedAmount))
+	builder.WriteString(", ")
+	builder.WriteString("total_earned=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalEarned))
+	builder.WriteString(", ")
+	builder.WriteString("total_deducted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalDeducted))
+	builder.WriteString(", ")
+	builder.WriteString("total_paid=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalPaid))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PayeeWallets is a parsable slice of PayeeWallet.
+type PayeeWallets []*PayeeWallet
@@ -0,0 +1,163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payeewallet
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the payeewallet type in the database.
+	Label = "payee_wallet"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldPayeeID holds the string denoting the payee_id field in the database.
+	FieldPayeeID = "payee_id"
+	// FieldPayeeType holds the string denoting the payee_type field in the database.
+	FieldPayeeType = "payee_type"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPhone holds the string denoting the phone field in the database.
+	FieldPhone = "phone"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldBalance holds the string denoting the balance field in the database.
+	FieldBalance = "balance"
+	// FieldReservedAmount holds the string denoting the reserved_amount field in the database.
+	FieldReservedAmount = "reserved_amount"
+	// FieldTotalEarned holds the string denoting the total_earned field in the database.
+	FieldTotalEarned = "total_earned"
+	// FieldTotalDeducted holds the string denoting the total_deducted field in the database.
+	FieldTotalDeducted = "total_deducted"
+	// FieldTotalPaid holds the string denoting the total_paid field in the database.
+	FieldTotalPaid = "total_paid"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the payeewallet in the database.
+	Table = "payee_wallets"
+)
+
+// Columns holds all SQL columns for payeewallet fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldPayeeID,
+	FieldPayeeType,
+	FieldName,
+	FieldPhone,
+	FieldCurrency,
+	FieldBalance,
+	FieldReservedAmount,
+	FieldTotalEarned,
+	FieldTotalDeducted,
+	FieldTotalPaid,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PayeeIDValidator is a validator for the "payee_id" field. It is called by the builders before save.
+	PayeeIDValidator func(string) error
+	// DefaultPayeeType holds the default value on creation for the "payee_type" field.
+	DefaultPayeeType string
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the PayeeWallet queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByPayeeID orders the results by the payee_id field.
+func ByPayeeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayeeID, opts...).ToFunc()
+}
+
+// ByPayeeType orders the results by the payee_type field.
+func ByPayeeType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayeeType, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPhone orders the results by the phone field.
+func ByPhone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhone, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByBalance orders the results by the balance field.
+func ByBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBalance, opts...).ToFunc()
+}
+
+// ByReservedAmount orders the results by the reserved_amount field.
+func ByReservedAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReservedAmount, opts...).ToFunc()
+}
+
+// ByTotalEarned orders the results by the total_earned field.
+func ByTotalEarned(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalEarned, opts...).ToFunc()
+}
+
+// ByTotalDeducted orders the results by the total_deducted field.
+func ByTotalDeducted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalDeducted, opts...).ToFunc()
+}
+
+// ByTotalPaid orders the results by the total_paid field.
+func ByTotalPaid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalPaid, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
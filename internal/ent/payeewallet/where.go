@@ -0,0 +1,852 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payeewallet
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldTenantID, v))
+}
+
+// PayeeID applies equality check predicate on the "payee_id" field. It's identical to PayeeIDEQ.
+func PayeeID(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldPayeeID, v))
+}
+
+// PayeeType applies equality check predicate on the "payee_type" field. It's identical to PayeeTypeEQ.
+func PayeeType(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldPayeeType, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldName, v))
+}
+
+// Phone applies equality check predicate on the "phone" field. It's identical to PhoneEQ.
+func Phone(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldPhone, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Balance applies equality check predicate on the "balance" field. It's identical to BalanceEQ.
+func Balance(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldBalance, v))
+}
+
+// ReservedAmount applies equality check predicate on the "reserved_amount" field. It's identical to ReservedAmountEQ.
+func ReservedAmount(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldReservedAmount, v))
+}
+
+// TotalEarned applies equality check predicate on the "total_earned" field. It's identical to TotalEarnedEQ.
+func TotalEarned(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldTotalEarned, v))
+}
+
+// TotalDeducted applies equality check predicate on the "total_deducted" field. It's identical to TotalDeductedEQ.
+func TotalDeducted(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldTotalDeducted, v))
+}
+
+// TotalPaid applies equality check predicate on the "total_paid" field. It's identical to TotalPaidEQ.
+func TotalPaid(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldTotalPaid, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLTE(FieldTenantID, v))
+}
+
+// PayeeIDEQ applies the EQ predicate on the "payee_id" field.
+func PayeeIDEQ(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldPayeeID, v))
+}
+
+// PayeeIDNEQ applies the NEQ predicate on the "payee_id" field.
+func PayeeIDNEQ(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNEQ(FieldPayeeID, v))
+}
+
+// PayeeIDIn applies the In predicate on the "payee_id" field.
+func PayeeIDIn(vs ...string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIn(FieldPayeeID, vs...))
+}
+
+// PayeeIDNotIn applies the NotIn predicate on the "payee_id" field.
+func PayeeIDNotIn(vs ...string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotIn(FieldPayeeID, vs...))
+}
+
+// PayeeIDGT applies the GT predicate on the "payee_id" field.
+func PayeeIDGT(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGT(FieldPayeeID, v))
+}
+
+// PayeeIDGTE applies the GTE predicate on the "payee_id" field.
+func PayeeIDGTE(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGTE(FieldPayeeID, v))
+}
+
+// PayeeIDLT applies the LT predicate on the "payee_id" field.
+func PayeeIDLT(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLT(FieldPayeeID, v))
+}
+
+// PayeeIDLTE applies the LTE predicate on the "payee_id" field.
+func PayeeIDLTE(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLTE(FieldPayeeID, v))
+}
+
+// PayeeIDContains applies the Contains predicate on the "payee_id" field.
+func PayeeIDContains(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldContains(FieldPayeeID, v))
+}
+
+// PayeeIDHasPrefix applies the HasPrefix predicate on the "payee_id" field.
+func PayeeIDHasPrefix(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldHasPrefix(FieldPayeeID, v))
+}
+
+// PayeeIDHasSuffix applies the HasSuffix predicate on the "payee_id" field.
+func PayeeIDHasSuffix(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldHasSuffix(FieldPayeeID, v))
+}
+
+// PayeeIDEqualFold applies the EqualFold predicate on the "payee_id" field.
+func PayeeIDEqualFold(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEqualFold(FieldPayeeID, v))
+}
+
+// PayeeIDContainsFold applies the ContainsFold predicate on the "payee_id" field.
+func PayeeIDContainsFold(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldContainsFold(FieldPayeeID, v))
+}
+
+// PayeeTypeEQ applies the EQ predicate on the "payee_type" field.
+func PayeeTypeEQ(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldPayeeType, v))
+}
+
+// PayeeTypeNEQ applies the NEQ predicate on the "payee_type" field.
+func PayeeTypeNEQ(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNEQ(FieldPayeeType, v))
+}
+
+// PayeeTypeIn applies the In predicate on the "payee_type" field.
+func PayeeTypeIn(vs ...string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIn(FieldPayeeType, vs...))
+}
+
+// PayeeTypeNotIn applies the NotIn predicate on the "payee_type" field.
+func PayeeTypeNotIn(vs ...string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotIn(FieldPayeeType, vs...))
+}
+
+// PayeeTypeGT applies the GT predicate on the "payee_type" field.
+func PayeeTypeGT(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGT(FieldPayeeType, v))
+}
+
+// PayeeTypeGTE applies the GTE predicate on the "payee_type" field.
+func PayeeTypeGTE(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGTE(FieldPayeeType, v))
+}
+
+// PayeeTypeLT applies the LT predicate on the "payee_type" field.
+func PayeeTypeLT(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLT(FieldPayeeType, v))
+}
+
+// PayeeTypeLTE applies the LTE predicate on the "payee_type" field.
+func PayeeTypeLTE(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLTE(FieldPayeeType, v))
+}
+
+// PayeeTypeContains applies the Contains predicate on the "payee_type" field.
+func PayeeTypeContains(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldContains(FieldPayeeType, v))
+}
+
+// PayeeTypeHasPrefix applies the HasPrefix predicate on the "payee_type" field.
+func PayeeTypeHasPrefix(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldHasPrefix(FieldPayeeType, v))
+}
+
+// PayeeTypeHasSuffix applies the HasSuffix predicate on the "payee_type" field.
+func PayeeTypeHasSuffix(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldHasSuffix(FieldPayeeType, v))
+}
+
+// PayeeTypeEqualFold applies the EqualFold predicate on the "payee_type" field.
+func PayeeTypeEqualFold(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEqualFold(FieldPayeeType, v))
+}
+
+// PayeeTypeContainsFold applies the ContainsFold predicate on the "payee_type" field.
+func PayeeTypeContainsFold(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldContainsFold(FieldPayeeType, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldContainsFold(FieldName, v))
+}
+
+// PhoneEQ applies the EQ predicate on the "phone" field.
+func PhoneEQ(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldPhone, v))
+}
+
+// PhoneNEQ applies the NEQ predicate on the "phone" field.
+func PhoneNEQ(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNEQ(FieldPhone, v))
+}
+
+// PhoneIn applies the In predicate on the "phone" field.
+func PhoneIn(vs ...string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIn(FieldPhone, vs...))
+}
+
+// PhoneNotIn applies the NotIn predicate on the "phone" field.
+func PhoneNotIn(vs ...string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotIn(FieldPhone, vs...))
+}
+
+// PhoneGT applies the GT predicate on the "phone" field.
+func PhoneGT(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGT(FieldPhone, v))
+}
+
+// PhoneGTE applies the GTE predicate on the "phone" field.
+func PhoneGTE(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGTE(FieldPhone, v))
+}
+
+// PhoneLT applies the LT predicate on the "phone" field.
+func PhoneLT(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLT(FieldPhone, v))
+}
+
+// PhoneLTE applies the LTE predicate on the "phone" field.
+func PhoneLTE(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLTE(FieldPhone, v))
+}
+
+// PhoneContains applies the Contains predicate on the "phone" field.
+func PhoneContains(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldContains(FieldPhone, v))
+}
+
+// PhoneHasPrefix applies the HasPrefix predicate on the "phone" field.
+func PhoneHasPrefix(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldHasPrefix(FieldPhone, v))
+}
+
+// PhoneHasSuffix applies the HasSuffix predicate on the "phone" field.
+func PhoneHasSuffix(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldHasSuffix(FieldPhone, v))
+}
+
+// PhoneIsNil applies the IsNil predicate on the "phone" field.
+func PhoneIsNil() predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIsNull(FieldPhone))
+}
+
+// PhoneNotNil applies the NotNil predicate on the "phone" field.
+func PhoneNotNil() predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotNull(FieldPhone))
+}
+
+// PhoneEqualFold applies the EqualFold predicate on the "phone" field.
+func PhoneEqualFold(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEqualFold(FieldPhone, v))
+}
+
+// PhoneContainsFold applies the ContainsFold predicate on the "phone" field.
+func PhoneContainsFold(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldContainsFold(FieldPhone, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// BalanceEQ applies the EQ predicate on the "balance" field.
+func BalanceEQ(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldBalance, v))
+}
+
+// BalanceNEQ applies the NEQ predicate on the "balance" field.
+func BalanceNEQ(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNEQ(FieldBalance, v))
+}
+
+// BalanceIn applies the In predicate on the "balance" field.
+func BalanceIn(vs ...decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIn(FieldBalance, vs...))
+}
+
+// BalanceNotIn applies the NotIn predicate on the "balance" field.
+func BalanceNotIn(vs ...decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotIn(FieldBalance, vs...))
+}
+
+// BalanceGT applies the GT predicate on the "balance" field.
+func BalanceGT(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGT(FieldBalance, v))
+}
+
+// BalanceGTE applies the GTE predicate on the "balance" field.
+func BalanceGTE(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGTE(FieldBalance, v))
+}
+
+// BalanceLT applies the LT predicate on the "balance" field.
+func BalanceLT(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLT(FieldBalance, v))
+}
+
+// BalanceLTE applies the LTE predicate on the "balance" field.
+func BalanceLTE(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLTE(FieldBalance, v))
+}
+
+// BalanceIsNil applies the IsNil predicate on the "balance" field.
+func BalanceIsNil() predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIsNull(FieldBalance))
+}
+
+// BalanceNotNil applies the NotNil predicate on the "balance" field.
+func BalanceNotNil() predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotNull(FieldBalance))
+}
+
+// ReservedAmountEQ applies the EQ predicate on the "reserved_amount" field.
+func ReservedAmountEQ(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldReservedAmount, v))
+}
+
+// ReservedAmountNEQ applies the NEQ predicate on the "reserved_amount" field.
+func ReservedAmountNEQ(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNEQ(FieldReservedAmount, v))
+}
+
+// ReservedAmountIn applies the In predicate on the "reserved_amount" field.
+func ReservedAmountIn(vs ...decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIn(FieldReservedAmount, vs...))
+}
+
+// ReservedAmountNotIn applies the NotIn predicate on the "reserved_amount" field.
+func ReservedAmountNotIn(vs ...decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotIn(FieldReservedAmount, vs...))
+}
+
+// ReservedAmountGT applies the GT predicate on the "reserved_amount" field.
+func ReservedAmountGT(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGT(FieldReservedAmount, v))
+}
+
+// ReservedAmountGTE applies the GTE predicate on the "reserved_amount" field.
+func ReservedAmountGTE(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGTE(FieldReservedAmount, v))
+}
+
+// ReservedAmountLT applies the LT predicate on the "reserved_amount" field.
+func ReservedAmountLT(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLT(FieldReservedAmount, v))
+}
+
+// ReservedAmountLTE applies the LTE predicate on the "reserved_amount" field.
+func ReservedAmountLTE(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLTE(FieldReservedAmount, v))
+}
+
+// ReservedAmountIsNil applies the IsNil predicate on the "reserved_amount" field.
+func ReservedAmountIsNil() predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIsNull(FieldReservedAmount))
+}
+
+// ReservedAmountNotNil applies the NotNil predicate on the "reserved_amount" field.
+func ReservedAmountNotNil() predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotNull(FieldReservedAmount))
+}
+
+// TotalEarnedEQ applies the EQ predicate on the "total_earned" field.
+func TotalEarnedEQ(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldTotalEarned, v))
+}
+
+// TotalEarnedNEQ applies the NEQ predicate on the "total_earned" field.
+func TotalEarnedNEQ(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNEQ(FieldTotalEarned, v))
+}
+
+// TotalEarnedIn applies the In predicate on the "total_earned" field.
+func TotalEarnedIn(vs ...decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIn(FieldTotalEarned, vs...))
+}
+
+// TotalEarnedNotIn applies the NotIn predicate on the "total_earned" field.
+func TotalEarnedNotIn(vs ...decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotIn(FieldTotalEarned, vs...))
+}
+
+// TotalEarnedGT applies the GT predicate on the "total_earned" field.
+func TotalEarnedGT(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGT(FieldTotalEarned, v))
+}
+
+// TotalEarnedGTE applies the GTE predicate on the "total_earned" field.
+func TotalEarnedGTE(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGTE(FieldTotalEarned, v))
+}
+
+// TotalEarnedLT applies the LT predicate on the "total_earned" field.
+func TotalEarnedLT(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLT(FieldTotalEarned, v))
+}
+
+// TotalEarnedLTE applies the LTE predicate on the "total_earned" field.
+func TotalEarnedLTE(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLTE(FieldTotalEarned, v))
+}
+
+// TotalEarnedIsNil applies the IsNil predicate on the "total_earned" field.
+func TotalEarnedIsNil() predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIsNull(FieldTotalEarned))
+}
+
+// TotalEarnedNotNil applies the NotNil predicate on the "total_earned" field.
+func TotalEarnedNotNil() predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotNull(FieldTotalEarned))
+}
+
+// TotalDeductedEQ applies the EQ predicate on the "total_deducted" field.
+func TotalDeductedEQ(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldTotalDeducted, v))
+}
+
+// TotalDeductedNEQ applies the NEQ predicate on the "total_deducted" field.
+func TotalDeductedNEQ(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNEQ(FieldTotalDeducted, v))
+}
+
+// TotalDeductedIn applies the In predicate on the "total_deducted" field.
+func TotalDeductedIn(vs ...decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIn(FieldTotalDeducted, vs...))
+}
+
+// TotalDeductedNotIn applies the NotIn predicate on the "total_deducted" field.
+func TotalDeductedNotIn(vs ...decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotIn(FieldTotalDeducted, vs...))
+}
+
+// TotalDeductedGT applies the GT predicate on the "total_deducted" field.
+func TotalDeductedGT(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGT(FieldTotalDeducted, v))
+}
+
+// TotalDeductedGTE applies the GTE predicate on the "total_deducted" field.
+func TotalDeductedGTE(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGTE(FieldTotalDeducted, v))
+}
+
+// TotalDeductedLT applies the LT predicate on the "total_deducted" field.
+func TotalDeductedLT(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLT(FieldTotalDeducted, v))
+}
+
+// TotalDeductedLTE applies the LTE predicate on the "total_deducted" field.
+func TotalDeductedLTE(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLTE(FieldTotalDeducted, v))
+}
+
+// TotalDeductedIsNil applies the IsNil predicate on the "total_deducted" field.
+func TotalDeductedIsNil() predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIsNull(FieldTotalDeducted))
+}
+
+// TotalDeductedNotNil applies the NotNil predicate on the "total_deducted" field.
+func TotalDeductedNotNil() predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotNull(FieldTotalDeducted))
+}
+
+// TotalPaidEQ applies the EQ predicate on the "total_paid" field.
+func TotalPaidEQ(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldTotalPaid, v))
+}
+
+// TotalPaidNEQ applies the NEQ predicate on the "total_paid" field.
+func TotalPaidNEQ(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNEQ(FieldTotalPaid, v))
+}
+
+// TotalPaidIn applies the In predicate on the "total_paid" field.
+func TotalPaidIn(vs ...decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIn(FieldTotalPaid, vs...))
+}
+
+// TotalPaidNotIn applies the NotIn predicate on the "total_paid" field.
+func TotalPaidNotIn(vs ...decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotIn(FieldTotalPaid, vs...))
+}
+
+// TotalPaidGT applies the GT predicate on the "total_paid" field.
+func TotalPaidGT(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGT(FieldTotalPaid, v))
+}
+
+// TotalPaidGTE applies the GTE predicate on the "total_paid" field.
+func TotalPaidGTE(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGTE(FieldTotalPaid, v))
+}
+
+// TotalPaidLT applies the LT predicate on the "total_paid" field.
+func TotalPaidLT(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLT(FieldTotalPaid, v))
+}
+
+// TotalPaidLTE applies the LTE predicate on the "total_paid" field.
+func TotalPaidLTE(v decimal.Decimal) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLTE(FieldTotalPaid, v))
+}
+
+// TotalPaidIsNil applies the IsNil predicate on the "total_paid" field.
+func TotalPaidIsNil() predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIsNull(FieldTotalPaid))
+}
+
+// TotalPaidNotNil applies the NotNil predicate on the "total_paid" field.
+func TotalPaidNotNil() predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotNull(FieldTotalPaid))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PayeeWallet) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PayeeWallet) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PayeeWallet) predicate.PayeeWallet {
+	return predicate.PayeeWallet(sql.NotPredicates(p))
+}
@@ -0,0 +1,1372 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/payeewallet"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PayeeWalletCreate is the builder for creating a PayeeWallet entity.
+type PayeeWalletCreate struct {
+	config
+	mutation *PayeeWalletMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *PayeeWalletCreate) SetTenantID(v uuid.UUID) *PayeeWalletCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetPayeeID sets the "payee_id" field.
+func (_c *PayeeWalletCreate) SetPayeeID(v string) *PayeeWalletCreate {
+	_c.mutation.SetPayeeID(v)
+	return _c
+}
+
+// SetPayeeType sets the "payee_type" field.
+func (_c *PayeeWalletCreate) SetPayeeType(v string) *PayeeWalletCreate {
+	_c.mutation.SetPayeeType(v)
+	return _c
+}
+
+// SetNillablePayeeType sets the "payee_type" field if the given value is not nil.
+func (_c *PayeeWalletCreate) SetNillablePayeeType(v *string) *PayeeWalletCreate {
+	if v != nil {
+		_c.SetPayeeType(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *PayeeWalletCreate) SetName(v string) *PayeeWalletCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *PayeeWalletCreate) SetNillableName(v *string) *PayeeWalletCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetPhone sets the "phone" field.
+func (_c *PayeeWalletCreate) SetPhone(v string) *PayeeWalletCreate {
+	_c.mutation.SetPhone(v)
+	return _c
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_c *PayeeWalletCreate) SetNillablePhone(v *string) *PayeeWalletCreate {
+	if v != nil {
+		_c.SetPhone(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *PayeeWalletCreate) SetCurrency(v string) *PayeeWalletCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *PayeeWalletCreate) SetNillableCurrency(v *string) *PayeeWalletCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetBalance sets the "balance" field.
+func (_c *PayeeWalletCreate) SetBalance(v decimal.Decimal) *PayeeWalletCreate {
+	_c.mutation.SetBalance(v)
+	return _c
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_c *PayeeWalletCreate) SetNillableBalance(v *decimal.Decimal) *PayeeWalletCreate {
+	if v != nil {
+		_c.SetBalance(*v)
+	}
+	return _c
+}
+
+// SetReservedAmount sets the "reserved_amount" field.
+func (_c *PayeeWalletCreate) SetReservedAmount(v decimal.Decimal) *PayeeWalletCreate {
+	_c.mutation.SetReservedAmount(v)
+	return _c
+}
+
+// SetNillableReservedAmount sets the "reserved_amount" field if the given value is not nil.
+func (_c *PayeeWalletCreate) SetNillableReservedAmount(v *decimal.Decimal) *PayeeWalletCreate {
+	if v != nil {
+		_c.SetReservedAmount(*v)
+	}
+	return _c
+}
+
+// SetTotalEarned sets the "total_earned" field.
+func (_c *PayeeWalletCreate) SetTotalEarned(v decimal.Decimal) *PayeeWalletCreate {
+	_c.mutation.SetTotalEarned(v)
+	return _c
+}
+
+// SetNillableTotalEarned sets the "total_earned" field if the given value is not nil.
+func (_c *PayeeWalletCreate) SetNillableTotalEarned(v *decimal.Decimal) *PayeeWalletCreate {
+	if v != nil {
+		_c.SetTotalEarned(*v)
+	}
+	return _c
+}
+
+// SetTotalDeducted sets the "total_deducted" field.
+func (_c *PayeeWalletCreate) SetTotalDeducted(v decimal.Decimal) *PayeeWalletCreate {
+	_c.mutation.SetTotalDeducted(v)
+	return _c
+}
+
+// SetNillableTotalDeducted sets the "total_deducted" field if the given value is not nil.
+func (_c *PayeeWalletCreate) SetNillableTotalDeducted(v *decimal.Decimal) *PayeeWalletCreate {
+	if v != nil {
+		_c.SetTotalDeducted(*v)
+	}
+	return _c
+}
+
+// SetTotalPaid sets the "total_paid" field.
+func (_c *PayeeWalletCreate) SetTotalPaid(v decimal.Decimal) *PayeeWalletCreate {
+	_c.mutation.SetTotalPaid(v)
+	return _c
+}
+
+// SetNillableTotalPaid sets the "total_paid" field if the given value is not nil.
+func (_c *PayeeWalletCreate) SetNillableTotalPaid(v *decimal.Decimal) *PayeeWalletCreate {
+	if v != nil {
+		_c.SetTotalPaid(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PayeeWalletCreate) SetCreatedAt(v time.Time) *PayeeWalletCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PayeeWalletCreate) SetNillableCreatedAt(v *time.Time) *PayeeWalletCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PayeeWalletCreate) SetUpdatedAt(v time.Time) *PayeeWalletCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PayeeWalletCreate) SetNillableUpdatedAt(v *time.Time) *PayeeWalletCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PayeeWalletCreate) SetID(v uuid.UUID) *PayeeWalletCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PayeeWalletCreate) SetNillableID(v *uuid.UUID) *PayeeWalletCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PayeeWalletMutation object of the builder.
+func (_c *PayeeWalletCreate) Mutation() *PayeeWalletMutation {
+	return _c.mutation
+}
+
+// Save creates the PayeeWallet in the database.
+func (_c *PayeeWalletCreate) Save(ctx context.Context) (*PayeeWallet, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PayeeWalletCreate) SaveX(ctx context.Context) *PayeeWallet {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PayeeWalletCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PayeeWalletCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PayeeWalletCreate) defaults() {
+	if _, ok := _c.mutation.PayeeType(); !ok {
+		v := payeewallet.DefaultPayeeType
+		_c.mutation.SetPayeeType(v)
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := payeewallet.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := payeewallet.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := payeewallet.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := payeewallet.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PayeeWalletCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "PayeeWallet.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.PayeeID(); !ok {
+		return &ValidationError{Name: "payee_id", err: errors.New(`ent: missing required field "PayeeWallet.payee_id"`)}
+	}
+	if v, ok := _c.mutation.PayeeID(); ok {
+		if err := payeewallet.PayeeIDValidator(v); err != nil {
+			return &ValidationError{Name: "payee_id", err: fmt.Errorf(`ent: validator failed for field "PayeeWallet.payee_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PayeeType(); !ok {
+		return &ValidationError{Name: "payee_type", err: errors.New(`ent: missing required field "PayeeWallet.payee_type"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "PayeeWallet.currency"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PayeeWallet.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PayeeWallet.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *PayeeWalletCreate) sqlSave(ctx context.Context) (*PayeeWallet, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PayeeWalletCreate) createSpec() (*PayeeWallet, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PayeeWallet{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(payeewallet.Table, sqlgraph.NewFieldSpec(payeewallet.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(payeewallet.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.PayeeID(); ok {
+		_spec.SetField(payeewallet.FieldPayeeID, field.TypeString, value)
+		_node.PayeeID = value
+	}
+	if value, ok := _c.mutation.PayeeType(); ok {
+		_spec.SetField(payeewallet.FieldPayeeType, field.TypeString, value)
+		_node.PayeeType = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(payeewallet.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Phone(); ok {
+		_spec.SetField(payeewallet.FieldPhone, field.TypeString, value)
+		_node.Phone = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(payeewallet.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Balance(); ok {
+		_spec.SetField(payeewallet.FieldBalance, field.TypeFloat64, value)
+		_node.Balance = value
+	}
+	if value, ok := _c.mutation.ReservedAmount(); ok {
+		_spec.SetField(payeewallet.FieldReservedAmount, field.TypeFloat64, value)
+		_node.ReservedAmount = value
+	}
+	if value, ok := _c.mutation.TotalEarned(); ok {
+		_spec.SetField(payeewallet.FieldTotalEarned, field.TypeFloat64, value)
+		_node.TotalEarned = value
+	}
+	if value, ok := _c.mutation.TotalDeducted(); ok {
+		_spec.SetField(payeewallet.FieldTotalDeducted, field.TypeFloat64, value)
+		_node.TotalDeducted = value
+	}
+	if value, ok := _c.mutation.TotalPaid(); ok {
+		_spec.SetField(payeewallet.FieldTotalPaid, field.TypeFloat64, value)
+		_node.TotalPaid = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(payeewallet.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(payeewallet.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PayeeWallet.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PayeeWalletUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PayeeWalletCreate) OnConflict(opts ...sql.ConflictOption) *PayeeWalletUpsertOne {
+	_c.conflict = opts
+	return &PayeeWalletUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PayeeWallet.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PayeeWalletCreate) OnConflictColumns(columns ...string) *PayeeWalletUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PayeeWalletUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PayeeWalletUpsertOne is the builder for "upsert"-ing
+	//  one PayeeWallet node.
+	PayeeWalletUpsertOne struct {
+		create *PayeeWalletCreate
+	}
+
+	// PayeeWalletUpsert is the "OnConflict" setter.
+	PayeeWalletUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *PayeeWalletUpsert) SetTenantID(v uuid.UUID) *PayeeWalletUpsert {
+	u.Set(payeewallet.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *PayeeWalletUpsert) UpdateTenantID() *PayeeWalletUpsert {
+	u.SetExcluded(payeewallet.FieldTenantID)
+	return u
+}
+
+// SetPayeeType sets the "payee_type" field.
+func (u *PayeeWalletUpsert) SetPayeeType(v string) *PayeeWalletUpsert {
+	u.Set(payeewallet.FieldPayeeType, v)
+	return u
+}
+
+// UpdatePayeeType sets the "payee_type" field to the value that was provided on create.
+func (u *PayeeWalletUpsert) UpdatePayeeType() *PayeeWalletUpsert {
+	u.SetExcluded(payeewallet.FieldPayeeType)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PayeeWalletUpsert) SetName(v string) *PayeeWalletUpsert {
+	u.Set(payeewallet.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PayeeWalletUpsert) UpdateName() *PayeeWalletUpsert {
+	u.SetExcluded(payeewallet.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *PayeeWalletUpsert) ClearName() *PayeeWalletUpsert {
+	u.SetNull(payeewallet.FieldName)
+	return u
+}
+
+// SetPhone sets the "phone" field.
+func (u *PayeeWalletUpsert) SetPhone(v string) *PayeeWalletUpsert {
+	u.Set(payeewallet.FieldPhone, v)
+	return u
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *PayeeWalletUpsert) UpdatePhone() *PayeeWalletUpsert {
+	u.SetExcluded(payeewallet.FieldPhone)
+	return u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *PayeeWalletUpsert) ClearPhone() *PayeeWalletUpsert {
+	u.SetNull(payeewallet.FieldPhone)
+	return u
+}
+
+// SetBalance sets the "balance" field.
+func (u *PayeeWalletUpsert) SetBalance(v decimal.Decimal) *PayeeWalletUpsert {
+	u.Set(payeewallet.FieldBalance, v)
+	return u
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *PayeeWalletUpsert) UpdateBalance() *PayeeWalletUpsert {
+	u.SetExcluded(payeewallet.FieldBalance)
+	return u
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *PayeeWalletUpsert) AddBalance(v decimal.Decimal) *PayeeWalletUpsert {
+	u.Add(payeewallet.FieldBalance, v)
+	return u
+}
+
+// ClearBalance clears the value of the "balance" field.
+func (u *PayeeWalletUpsert) ClearBalance() *PayeeWalletUpsert {
+	u.SetNull(payeewallet.FieldBalance)
+	return u
+}
+
+// SetReservedAmount sets the "reserved_amount" field.
+func (u *PayeeWalletUpsert) SetReservedAmount(v decimal.Decimal) *PayeeWalletUpsert {
+	u.Set(payeewallet.FieldReservedAmount, v)
+	return u
+}
+
+// UpdateReservedAmount sets the "reserved_amount" field to the value that was provided on create.
+func (u *PayeeWalletUpsert) UpdateReservedAmount() *PayeeWalletUpsert {
+	u.SetExcluded(payeewallet.FieldReservedAmount)
+	return u
+}
+
+// AddReservedAmount adds v to the "reserved_amount" field.
+func (u *PayeeWalletUpsert) AddReservedAmount(v decimal.Decimal) *PayeeWalletUpsert {
+	u.Add(payeewallet.FieldReservedAmount, v)
+	return u
+}
+
+// ClearReservedAmount clears the value of the "reserved_amount" field.
+func (u *PayeeWalletUpsert) ClearReservedAmount() *PayeeWalletUpsert {
+	u.SetNull(payeewallet.FieldReservedAmount)
+	return u
+}
+
+// SetTotalEarned sets the "total_earned" field.
+func (u *PayeeWalletUpsert) SetTotalEarned(v decimal.Decimal) *PayeeWalletUpsert {
+	u.Set(payeewallet.FieldTotalEarned, v)
+	return u
+}
+
+// UpdateTotalEarned sets the "total_earned" field to the value that was provided on create.
+func (u *PayeeWalletUpsert) UpdateTotalEarned() *PayeeWalletUpsert {
+	u.SetExcluded(payeewallet.FieldTotalEarned)
+	return u
+}
+
+// AddTotalEarned adds v to the "total_earned" field.
+func (u *PayeeWalletUpsert) AddTotalEarned(v decimal.Decimal) *PayeeWalletUpsert {
+	u.Add(payeewallet.FieldTotalEarned, v)
+	return u
+}
+
+// ClearTotalEarned clears the value of the "total_earned" field.
+func (u *PayeeWalletUpsert) ClearTotalEarned() *PayeeWalletUpsert {
+	u.SetNull(payeewallet.FieldTotalEarned)
+	return u
+}
+
+// SetTotalDeducted sets the "total_deducted" field.
+func (u *PayeeWalletUpsert) SetTotalDeducted(v decimal.Decimal) *PayeeWalletUpsert {
+	u.Set(payeewallet.FieldTotalDeducted, v)
+	return u
+}
+
+// UpdateTotalDeducted sets the "total_deducted" field to the value that was provided on create.
+func (u *PayeeWalletUpsert) UpdateTotalDeducted() *PayeeWalletUpsert {
+	u.SetExcluded(payeewallet.FieldTotalDeducted)
+	return u
+}
+
+// AddTotalDeducted adds v to the "total_deducted" field.
+func (u *PayeeWalletUpsert) AddTotalDeducted(v decimal.Decimal) *PayeeWalletUpsert {
+	u.Add(payeewallet.FieldTotalDeducted, v)
+	return u
+}
+
+// ClearTotalDeducted clears the value of the "total_deducted" field.
+func (u *PayeeWalletUpsert) ClearTotalDeducted() *PayeeWalletUpsert {
+	u.SetNull(payeewallet.FieldTotalDeducted)
+	return u
+}
+
+// SetTotalPaid sets the "total_paid" field.
+func (u *PayeeWalletUpsert) SetTotalPaid(v decimal.Decimal) *PayeeWalletUpsert {
+	u.Set(payeewallet.FieldTotalPaid, v)
+	return u
+}
+
+// UpdateTotalPaid sets the "total_paid" field to the value that was provided on create.
+func (u *PayeeWalletUpsert) UpdateTotalPaid() *PayeeWalletUpsert {
+	u.SetExcluded(payeewallet.FieldTotalPaid)
+	return u
+}
+
+// AddTotalPaid adds v to the "total_paid" field.
+func (u *PayeeWalletUpsert) AddTotalPaid(v decimal.Decimal) *PayeeWalletUpsert {
+	u.Add(payeewallet.FieldTotalPaid, v)
+	return u
+}
+
+// ClearTotalPaid clears the value of the "total_paid" field.
+func (u *PayeeWalletUpsert) ClearTotalPaid() *PayeeWalletUpsert {
+	u.SetNull(payeewallet.FieldTotalPaid)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PayeeWalletUpsert) SetUpdatedAt(v time.Time) *PayeeWalletUpsert {
+	u.Set(payeewallet.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PayeeWalletUpsert) UpdateUpdatedAt() *PayeeWalletUpsert {
+	u.SetExcluded(payeewallet.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PayeeWallet.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(payeewallet.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PayeeWalletUpsertOne) UpdateNewValues() *PayeeWalletUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(payeewallet.FieldID)
+		}
+		if _, exists := u.create.mutation.PayeeID(); exists {
+			s.SetIgnore(payeewallet.FieldPayeeID)
+		}
+		if _, exists := u.create.mutation.Currency(); exists {
+			s.SetIgnore(payeewallet.FieldCurrency)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(payeewallet.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PayeeWallet.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PayeeWalletUpsertOne) Ignore() *PayeeWalletUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PayeeWalletUpsertOne) DoNothing() *PayeeWalletUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PayeeWalletCreate.OnConflict
+// documentation for more info.
+func (u *PayeeWalletUpsertOne) Update(set func(*PayeeWalletUpsert)) *PayeeWalletUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PayeeWalletUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *PayeeWalletUpsertOne) SetTenantID(v uuid.UUID) *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *PayeeWalletUpsertOne) UpdateTenantID() *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetPayeeType sets the "payee_type" field.
+func (u *PayeeWalletUpsertOne) SetPayeeType(v string) *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetPayeeType(v)
+	})
+}
+
+// UpdatePayeeType sets the "payee_type" field to the value that was provided on create.
+func (u *PayeeWalletUpsertOne) UpdatePayeeType() *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdatePayeeType()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PayeeWalletUpsertOne) SetName(v string) *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PayeeWalletUpsertOne) UpdateName() *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *PayeeWalletUpsertOne) ClearName() *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *PayeeWalletUpsertOne) SetPhone(v string) *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *PayeeWalletUpsertOne) UpdatePhone() *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *PayeeWalletUpsertOne) ClearPhone() *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.ClearPhone()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *PayeeWalletUpsertOne) SetBalance(v decimal.Decimal) *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *PayeeWalletUpsertOne) AddBalance(v decimal.Decimal) *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *PayeeWalletUpsertOne) UpdateBalance() *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// ClearBalance clears the value of the "balance" field.
+func (u *PayeeWalletUpsertOne) ClearBalance() *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.ClearBalance()
+	})
+}
+
+// SetReservedAmount sets the "reserved_amount" field.
+func (u *PayeeWalletUpsertOne) SetReservedAmount(v decimal.Decimal) *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetReservedAmount(v)
+	})
+}
+
+// AddReservedAmount adds v to the "reserved_amount" field.
+func (u *PayeeWalletUpsertOne) AddReservedAmount(v decimal.Decimal) *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.AddReservedAmount(v)
+	})
+}
+
+// UpdateReservedAmount sets the "reserved_amount" field to the value that was provided on create.
+func (u *PayeeWalletUpsertOne) UpdateReservedAmount() *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdateReservedAmount()
+	})
+}
+
+// ClearReservedAmount clears the value of the "reserved_amount" field.
+func (u *PayeeWalletUpsertOne) ClearReservedAmount() *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.ClearReservedAmount()
+	})
+}
+
+// SetTotalEarned sets the "total_earned" field.
+func (u *PayeeWalletUpsertOne) SetTotalEarned(v decimal.Decimal) *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetTotalEarned(v)
+	})
+}
+
+// AddTotalEarned adds v to the "total_earned" field.
+func (u *PayeeWalletUpsertOne) AddTotalEarned(v decimal.Decimal) *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.AddTotalEarned(v)
+	})
+}
+
+// UpdateTotalEarned sets the "total_earned" field to the value that was provided on create.
+func (u *PayeeWalletUpsertOne) UpdateTotalEarned() *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdateTotalEarned()
+	})
+}
+
+// ClearTotalEarned clears the value of the "total_earned" field.
+func (u *PayeeWalletUpsertOne) ClearTotalEarned() *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.ClearTotalEarned()
+	})
+}
+
+// SetTotalDeducted sets the "total_deducted" field.
+func (u *PayeeWalletUpsertOne) SetTotalDeducted(v decimal.Decimal) *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetTotalDeducted(v)
+	})
+}
+
+// AddTotalDeducted adds v to the "total_deducted" field.
+func (u *PayeeWalletUpsertOne) AddTotalDeducted(v decimal.Decimal) *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.AddTotalDeducted(v)
+	})
+}
+
+// UpdateTotalDeducted sets the "total_deducted" field to the value that was provided on create.
+func (u *PayeeWalletUpsertOne) UpdateTotalDeducted() *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdateTotalDeducted()
+	})
+}
+
+// ClearTotalDeducted clears the value of the "total_deducted" field.
+func (u *PayeeWalletUpsertOne) ClearTotalDeducted() *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.ClearTotalDeducted()
+	})
+}
+
+// SetTotalPaid sets the "total_paid" field.
+func (u *PayeeWalletUpsertOne) SetTotalPaid(v decimal.Decimal) *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetTotalPaid(v)
+	})
+}
+
+// AddTotalPaid adds v to the "total_paid" field.
+func (u *PayeeWalletUpsertOne) AddTotalPaid(v decimal.Decimal) *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.AddTotalPaid(v)
+	})
+}
+
+// UpdateTotalPaid sets the "total_paid" field to the value that was provided on create.
+func (u *PayeeWalletUpsertOne) UpdateTotalPaid() *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdateTotalPaid()
+	})
+}
+
+// ClearTotalPaid clears the value of the "total_paid" field.
+func (u *PayeeWalletUpsertOne) ClearTotalPaid() *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.ClearTotalPaid()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PayeeWalletUpsertOne) SetUpdatedAt(v time.Time) *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PayeeWalletUpsertOne) UpdateUpdatedAt() *PayeeWalletUpsertOne {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PayeeWalletUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PayeeWalletCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PayeeWalletUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PayeeWalletUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PayeeWalletUpsertOne.ID is not supported by MySQL driver. Use PayeeWalletUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PayeeWalletUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PayeeWalletCreateBulk is the builder for creating many PayeeWallet entities in bulk.
+type PayeeWalletCreateBulk struct {
+	config
+	err      error
+	builders []*PayeeWalletCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PayeeWallet entities in the database.
+func (_c *PayeeWalletCreateBulk) Save(ctx context.Context) ([]*PayeeWallet, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PayeeWallet, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PayeeWalletMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PayeeWalletCreateBulk) SaveX(ctx context.Context) []*PayeeWallet {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PayeeWalletCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PayeeWalletCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PayeeWallet.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PayeeWalletUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PayeeWalletCreateBulk) OnConflict(opts ...sql.ConflictOption) *PayeeWalletUpsertBulk {
+	_c.conflict = opts
+	return &PayeeWalletUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PayeeWallet.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PayeeWalletCreateBulk) OnConflictColumns(columns ...string) *PayeeWalletUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PayeeWalletUpsertBulk{
+		create: _c,
+	}
+}
+
+// PayeeWalletUpsertBulk is the builder for "upsert"-ing
+// a bulk of PayeeWallet nodes.
+type PayeeWalletUpsertBulk struct {
+	create *PayeeWalletCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PayeeWallet.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(payeewallet.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PayeeWalletUpsertBulk) UpdateNewValues() *PayeeWalletUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(payeewallet.FieldID)
+			}
+			if _, exists := b.mutation.PayeeID(); exists {
+				s.SetIgnore(payeewallet.FieldPayeeID)
+			}
+			if _, exists := b.mutation.Currency(); exists {
+				s.SetIgnore(payeewallet.FieldCurrency)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(payeewallet.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PayeeWallet.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PayeeWalletUpsertBulk) Ignore() *PayeeWalletUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PayeeWalletUpsertBulk) DoNothing() *PayeeWalletUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PayeeWalletCreateBulk.OnConflict
+// documentation for more info.
+func (u *PayeeWalletUpsertBulk) Update(set func(*PayeeWalletUpsert)) *PayeeWalletUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PayeeWalletUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *PayeeWalletUpsertBulk) SetTenantID(v uuid.UUID) *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *PayeeWalletUpsertBulk) UpdateTenantID() *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetPayeeType sets the "payee_type" field.
+func (u *PayeeWalletUpsertBulk) SetPayeeType(v string) *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetPayeeType(v)
+	})
+}
+
+// UpdatePayeeType sets the "payee_type" field to the value that was provided on create.
+func (u *PayeeWalletUpsertBulk) UpdatePayeeType() *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdatePayeeType()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PayeeWalletUpsertBulk) SetName(v string) *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PayeeWalletUpsertBulk) UpdateName() *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *PayeeWalletUpsertBulk) ClearName() *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *PayeeWalletUpsertBulk) SetPhone(v string) *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *PayeeWalletUpsertBulk) UpdatePhone() *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *PayeeWalletUpsertBulk) ClearPhone() *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.ClearPhone()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *PayeeWalletUpsertBulk) SetBalance(v decimal.Decimal) *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *PayeeWalletUpsertBulk) AddBalance(v decimal.Decimal) *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *PayeeWalletUpsertBulk) UpdateBalance() *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// ClearBalance clears the value of the "balance" field.
+func (u *PayeeWalletUpsertBulk) ClearBalance() *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.ClearBalance()
+	})
+}
+
+// SetReservedAmount sets the "reserved_amount" field.
+func (u *PayeeWalletUpsertBulk) SetReservedAmount(v decimal.Decimal) *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetReservedAmount(v)
+	})
+}
+
+// AddReservedAmount adds v to the "reserved_amount" field.
+func (u *PayeeWalletUpsertBulk) AddReservedAmount(v decimal.Decimal) *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.AddReservedAmount(v)
+	})
+}
+
+// UpdateReservedAmount sets the "reserved_amount" field to the value that was provided on create.
+func (u *PayeeWalletUpsertBulk) UpdateReservedAmount() *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdateReservedAmount()
+	})
+}
+
+// ClearReservedAmount clears the value of the "reserved_amount" field.
+func (u *PayeeWalletUpsertBulk) ClearReservedAmount() *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.ClearReservedAmount()
+	})
+}
+
+// SetTotalEarned sets the "total_earned" field.
+func (u *PayeeWalletUpsertBulk) SetTotalEarned(v decimal.Decimal) *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetTotalEarned(v)
+	})
+}
+
+// AddTotalEarned adds v to the "total_earned" field.
+func (u *PayeeWalletUpsertBulk) AddTotalEarned(v decimal.Decimal) *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.AddTotalEarned(v)
+	})
+}
+
+// UpdateTotalEarned sets the "total_earned" field to the value that was provided on create.
+func (u *PayeeWalletUpsertBulk) UpdateTotalEarned() *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdateTotalEarned()
+	})
+}
+
+// ClearTotalEarned clears the value of the "total_earned" field.
+func (u *PayeeWalletUpsertBulk) ClearTotalEarned() *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.ClearTotalEarned()
+	})
+}
+
+// SetTotalDeducted sets the "total_deducted" field.
+func (u *PayeeWalletUpsertBulk) SetTotalDeducted(v decimal.Decimal) *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetTotalDeducted(v)
+	})
+}
+
+// AddTotalDeducted adds v to the "total_deducted" field.
+func (u *PayeeWalletUpsertBulk) AddTotalDeducted(v decimal.Decimal) *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.AddTotalDeducted(v)
+	})
+}
+
+// UpdateTotalDeducted sets the "total_deducted" field to the value that was provided on create.
+func (u *PayeeWalletUpsertBulk) UpdateTotalDeducted() *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdateTotalDeducted()
+	})
+}
+
+// ClearTotalDeducted clears the value of the "total_deducted" field.
+func (u *PayeeWalletUpsertBulk) ClearTotalDeducted() *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.ClearTotalDeducted()
+	})
+}
+
+// SetTotalPaid sets the "total_paid" field.
+func (u *PayeeWalletUpsertBulk) SetTotalPaid(v decimal.Decimal) *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetTotalPaid(v)
+	})
+}
+
+// AddTotalPaid adds v to the "total_paid" field.
+func (u *PayeeWalletUpsertBulk) AddTotalPaid(v decimal.Decimal) *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.AddTotalPaid(v)
+	})
+}
+
+// UpdateTotalPaid sets the "total_paid" field to the value that was provided on create.
+func (u *PayeeWalletUpsertBulk) UpdateTotalPaid() *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdateTotalPaid()
+	})
+}
+
+// ClearTotalPaid clears the value of the "total_paid" field.
+func (u *PayeeWalletUpsertBulk) ClearTotalPaid() *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.ClearTotalPaid()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PayeeWalletUpsertBulk) SetUpdatedAt(v time.Time) *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PayeeWalletUpsertBulk) UpdateUpdatedAt() *PayeeWalletUpsertBulk {
+	return u.Update(func(s *PayeeWalletUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PayeeWalletUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PayeeWalletCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PayeeWalletCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PayeeWalletUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/payeewallet"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+)
+
+// PayeeWalletDelete is the builder for deleting a PayeeWallet entity.
+type PayeeWalletDelete struct {
+	config
+	hooks    []Hook
+	mutation *PayeeWalletMutation
+}
+
+// Where appends a list predicates to the PayeeWalletDelete builder.
+func (_d *PayeeWalletDelete) Where(ps ...predicate.PayeeWallet) *PayeeWalletDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *PayeeWalletDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PayeeWalletDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *PayeeWalletDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(payeewallet.Table, sqlgraph.NewFieldSpec(payeewallet.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// PayeeWalletDeleteOne is the builder for deleting a single PayeeWallet entity.
+type PayeeWalletDeleteOne struct {
+	_d *PayeeWalletDelete
+}
+
+// Where appends a list predicates to the PayeeWalletDelete builder.
+func (_d *PayeeWalletDeleteOne) Where(ps ...predicate.PayeeWallet) *PayeeWalletDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *PayeeWalletDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{payeewallet.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PayeeWalletDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,746 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/payeewallet"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PayeeWalletUpdate is the builder for updating PayeeWallet entities.
+type PayeeWalletUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PayeeWalletMutation
+}
+
+// Where appends a list predicates to the PayeeWalletUpdate builder.
+func (_u *PayeeWalletUpdate) Where(ps ...predicate.PayeeWallet) *PayeeWalletUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *PayeeWalletUpdate) SetTenantID(v uuid.UUID) *PayeeWalletUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *PayeeWalletUpdate) SetNillableTenantID(v *uuid.UUID) *PayeeWalletUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetPayeeType sets the "payee_type" field.
+func (_u *PayeeWalletUpdate) SetPayeeType(v string) *PayeeWalletUpdate {
+	_u.mutation.SetPayeeType(v)
+	return _u
+}
+
+// SetNillablePayeeType sets the "payee_type" field if the given value is not nil.
+func (_u *PayeeWalletUpdate) SetNillablePayeeType(v *string) *PayeeWalletUpdate {
+	if v != nil {
+		_u.SetPayeeType(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PayeeWalletUpdate) SetName(v string) *PayeeWalletUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PayeeWalletUpdate) SetNillableName(v *string) *PayeeWalletUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *PayeeWalletUpdate) ClearName() *PayeeWalletUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *PayeeWalletUpdate) SetPhone(v string) *PayeeWalletUpdate {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *PayeeWalletUpdate) SetNillablePhone(v *string) *PayeeWalletUpdate {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *PayeeWalletUpdate) ClearPhone() *PayeeWalletUpdate {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetBalance sets the "balance" field.
+func (_u *PayeeWalletUpdate) SetBalance(v decimal.Decimal) *PayeeWalletUpdate {
+	_u.mutation.ResetBalance()
+	_u.mutation.SetBalance(v)
+	return _u
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_u *PayeeWalletUpdate) SetNillableBalance(v *decimal.Decimal) *PayeeWalletUpdate {
+	if v != nil {
+		_u.SetBalance(*v)
+	}
+	return _u
+}
+
+// AddBalance adds value to the "balance" field.
+func (_u *PayeeWalletUpdate) AddBalance(v decimal.Decimal) *PayeeWalletUpdate {
+	_u.mutation.AddBalance(v)
+	return _u
+}
+
+// ClearBalance clears the value of the "balance" field.
+func (_u *PayeeWalletUpdate) ClearBalance() *PayeeWalletUpdate {
+	_u.mutation.ClearBalance()
+	return _u
+}
+
+// SetReservedAmount sets the "reserved_amount" field.
+func (_u *PayeeWalletUpdate) SetReservedAmount(v decimal.Decimal) *PayeeWalletUpdate {
+	_u.mutation.ResetReservedAmount()
+	_u.mutation.SetReservedAmount(v)
+	return _u
+}
+
+// SetNillableReservedAmount sets the "reserved_amount" field if the given value is not nil.
+func (_u *PayeeWalletUpdate) SetNillableReservedAmount(v *decimal.Decimal) *PayeeWalletUpdate {
+	if v != nil {
+		_u.SetReservedAmount(*v)
+	}
+	return _u
+}
+
+// AddReservedAmount adds value to the "reserved_amount" field.
+func (_u *PayeeWalletUpdate) AddReservedAmount(v decimal.Decimal) *PayeeWalletUpdate {
+	_u.mutation.AddReservedAmount(v)
+	return _u
+}
+
+// ClearReservedAmount clears the value of the "reserved_amount" field.
+func (_u *PayeeWalletUpdate) ClearReservedAmount() *PayeeWalletUpdate {
+	_u.mutation.ClearReservedAmount()
+	return _u
+}
+
+// SetTotalEarned sets the "total_earned" field.
+func (_u *PayeeWalletUpdate) SetTotalEarned(v decimal.Decimal) *PayeeWalletUpdate {
+	_u.mutation.ResetTotalEarned()
+	_u.mutation.SetTotalEarned(v)
+	return _u
+}
+
+// SetNillableTotalEarned sets the "total_earned" field if the given value is not nil.
+func (_u *PayeeWalletUpdate) SetNillableTotalEarned(v *decimal.Decimal) *PayeeWalletUpdate {
+	if v != nil {
+		_u.SetTotalEarned(*v)
+	}
+	return _u
+}
+
+// AddTotalEarned adds value to the "total_earned" field.
+func (_u *PayeeWalletUpdate) AddTotalEarned(v decimal.Decimal) *PayeeWalletUpdate {
+	_u.mutation.AddTotalEarned(v)
+	return _u
+}
+
+// ClearTotalEarned clears the value of the "total_earned" field.
+func (_u *PayeeWalletUpdate) ClearTotalEarned() *PayeeWalletUpdate {
+	_u.mutation.ClearTotalEarned()
+	return _u
+}
+
+// SetTotalDeducted sets the "total_deducted" field.
+func (_u *PayeeWalletUpdate) SetTotalDeducted(v decimal.Decimal) *PayeeWalletUpdate {
+	_u.mutation.ResetTotalDeducted()
+	_u.mutation.SetTotalDeducted(v)
+	return _u
+}
+
+// SetNillableTotalDeducted sets the "total_deducted" field if the given value is not nil.
+func (_u *PayeeWalletUpdate) SetNillableTotalDeducted(v *decimal.Decimal) *PayeeWalletUpdate {
+	if v != nil {
+		_u.SetTotalDeducted(*v)
+	}
+	return _u
+}
+
+// AddTotalDeducted adds value to the "total_deducted" field.
+func (_u *PayeeWalletUpdate) AddTotalDeducted(v decimal.Decimal) *PayeeWalletUpdate {
+	_u.mutation.AddTotalDeducted(v)
+	return _u
+}
+
+// ClearTotalDeducted clears the value of the "total_deducted" field.
+func (_u *PayeeWalletUpdate) ClearTotalDeducted() *PayeeWalletUpdate {
+	_u.mutation.ClearTotalDeducted()
+	return _u
+}
+
+// SetTotalPaid sets the "total_paid" field.
+func (_u *PayeeWalletUpdate) SetTotalPaid(v decimal.Decimal) *PayeeWalletUpdate {
+	_u.mutation.ResetTotalPaid()
+	_u.mutation.SetTotalPaid(v)
+	return _u
+}
+
+// SetNillableTotalPaid sets the "total_paid" field if the given value is not nil.
+func (_u *PayeeWalletUpdate) SetNillableTotalPaid(v *decimal.Decimal) *PayeeWalletUpdate {
+	if v != nil {
+		_u.SetTotalPaid(*v)
+	}
+	return _u
+}
+
+// AddTotalPaid adds value to the "total_paid" field.
+func (_u *PayeeWalletUpdate) AddTotalPaid(v decimal.Decimal) *PayeeWalletUpdate {
+	_u.mutation.AddTotalPaid(v)
+	return _u
+}
+
+// ClearTotalPaid clears the value of the "total_paid" field.
+func (_u *PayeeWalletUpdate) ClearTotalPaid() *PayeeWalletUpdate {
+	_u.mutation.ClearTotalPaid()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PayeeWalletUpdate) SetUpdatedAt(v time.Time) *PayeeWalletUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the PayeeWalletMutation object of the builder.
+func (_u *PayeeWalletUpdate) Mutation() *PayeeWalletMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PayeeWalletUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PayeeWalletUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PayeeWalletUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PayeeWalletUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PayeeWalletUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := payeewallet.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *PayeeWalletUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(payeewallet.Table, payeewallet.Columns, sqlgraph.NewFieldSpec(payeewallet.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(payeewallet.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PayeeType(); ok {
+		_spec.SetField(payeewallet.FieldPayeeType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(payeewallet.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(payeewallet.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(payeewallet.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(payeewallet.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.Balance(); ok {
+		_spec.SetField(payeewallet.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalance(); ok {
+		_spec.AddField(payeewallet.FieldBalance, field.TypeFloat64, value)
+	}
+	if _u.mutation.BalanceCleared() {
+		_spec.ClearField(payeewallet.FieldBalance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ReservedAmount(); ok {
+		_spec.SetField(payeewallet.FieldReservedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedReservedAmount(); ok {
+		_spec.AddField(payeewallet.FieldReservedAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.ReservedAmountCleared() {
+		_spec.ClearField(payeewallet.FieldReservedAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TotalEarned(); ok {
+		_spec.SetField(payeewallet.FieldTotalEarned, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalEarned(); ok {
+		_spec.AddField(payeewallet.FieldTotalEarned, field.TypeFloat64, value)
+	}
+	if _u.mutation.TotalEarnedCleared() {
+		_spec.ClearField(payeewallet.FieldTotalEarned, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TotalDeducted(); ok {
+		_spec.SetField(payeewallet.FieldTotalDeducted, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalDeducted(); ok {
+		_spec.AddField(payeewallet.FieldTotalDeducted, field.TypeFloat64, value)
+	}
+	if _u.mutation.TotalDeductedCleared() {
+		_spec.ClearField(payeewallet.FieldTotalDeducted, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TotalPaid(); ok {
+		_spec.SetField(payeewallet.FieldTotalPaid, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalPaid(); ok {
+		_spec.AddField(payeewallet.FieldTotalPaid, field.TypeFloat64, value)
+	}
+	if _u.mutation.TotalPaidCleared() {
+		_spec.ClearField(payeewallet.FieldTotalPaid, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(payeewallet.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payeewallet.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PayeeWalletUpdateOne is the builder for updating a single PayeeWallet entity.
+type PayeeWalletUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PayeeWalletMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *PayeeWalletUpdateOne) SetTenantID(v uuid.UUID) *PayeeWalletUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *PayeeWalletUpdateOne) SetNillableTenantID(v *uuid.UUID) *PayeeWalletUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetPayeeType sets the "payee_type" field.
+func (_u *PayeeWalletUpdateOne) SetPayeeType(v string) *PayeeWalletUpdateOne {
+	_u.mutation.SetPayeeType(v)
+	return _u
+}
+
+// SetNillablePayeeType sets the "payee_type" field if the given value is not nil.
+func (_u *PayeeWalletUpdateOne) SetNillablePayeeType(v *string) *PayeeWalletUpdateOne {
+	if v != nil {
+		_u.SetPayeeType(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PayeeWalletUpdateOne) SetName(v string) *PayeeWalletUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PayeeWalletUpdateOne) SetNillableName(v *string) *PayeeWalletUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *PayeeWalletUpdateOne) ClearName() *PayeeWalletUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *PayeeWalletUpdateOne) SetPhone(v string) *PayeeWalletUpdateOne {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *PayeeWalletUpdateOne) SetNillablePhone(v *string) *PayeeWalletUpdateOne {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *PayeeWalletUpdateOne) ClearPhone() *PayeeWalletUpdateOne {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetBalance sets the "balance" field.
+func (_u *PayeeWalletUpdateOne) SetBalance(v decimal.Decimal) *PayeeWalletUpdateOne {
+	_u.mutation.ResetBalance()
+	_u.mutation.SetBalance(v)
+	return _u
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_u *PayeeWalletUpdateOne) SetNillableBalance(v *decimal.Decimal) *PayeeWalletUpdateOne {
+	if v != nil {
+		_u.SetBalance(*v)
+	}
+	return _u
+}
+
+// AddBalance adds value to the "balance" field.
+func (_u *PayeeWalletUpdateOne) AddBalance(v decimal.Decimal) *PayeeWalletUpdateOne {
+	_u.mutation.AddBalance(v)
+	return _u
+}
+
+// ClearBalance clears the value of the "balance" field.
+func (_u *PayeeWalletUpdateOne) ClearBalance() *PayeeWalletUpdateOne {
+	_u.mutation.ClearBalance()
+	return _u
+}
+
+// SetReservedAmount sets the "reserved_amount" field.
+func (_u *PayeeWalletUpdateOne) SetReservedAmount(v decimal.Decimal) *PayeeWalletUpdateOne {
+	_u.mutation.ResetReservedAmount()
+	_u.mutation.SetReservedAmount(v)
+	return _u
+}
+
+// SetNillableReservedAmount sets the "reserved_amount" field if the given value is not nil.
+func (_u *PayeeWalletUpdateOne) SetNillableReservedAmount(v *decimal.Decimal) *PayeeWalletUpdateOne {
+	if v != nil {
+		_u.SetReservedAmount(*v)
+	}
+	return _u
+}
+
+// AddReservedAmount adds value to the "reserved_amount" field.
+func (_u *PayeeWalletUpdateOne) AddReservedAmount(v decimal.Decimal) *PayeeWalletUpdateOne {
+	_u.mutation.AddReservedAmount(v)
+	return _u
+}
+
+// ClearReservedAmount clears the value of the "reserved_amount" field.
+func (_u *PayeeWalletUpdateOne) ClearReservedAmount() *PayeeWalletUpdateOne {
+	_u.mutation.ClearReservedAmount()
+	return _u
+}
+
+// SetTotalEarned sets the "total_earned" field.
+func (_u *PayeeWalletUpdateOne) SetTotalEarned(v decimal.Decimal) *PayeeWalletUpdateOne {
+	_u.mutation.ResetTotalEarned()
+	_u.mutation.SetTotalEarned(v)
+	return _u
+}
+
+// SetNillableTotalEarned sets the "total_earned" field if the given value is not nil.
+func (_u *PayeeWalletUpdateOne) SetNillableTotalEarned(v *decimal.Decimal) *PayeeWalletUpdateOne {
+	if v != nil {
+		_u.SetTotalEarned(*v)
+	}
+	return _u
+}
+
+// AddTotalEarned adds value to the "total_earned" field.
+func (_u *PayeeWalletUpdateOne) AddTotalEarned(v decimal.Decimal) *PayeeWalletUpdateOne {
+	_u.mutation.AddTotalEarned(v)
+	return _u
+}
+
+// ClearTotalEarned clears the value of the "total_earned" field.
+func (_u *PayeeWalletUpdateOne) ClearTotalEarned() *PayeeWalletUpdateOne {
+	_u.mutation.ClearTotalEarned()
+	return _u
+}
+
+// SetTotalDeducted sets the "total_deducted" field.
+func (_u *PayeeWalletUpdateOne) SetTotalDeducted(v decimal.Decimal) *PayeeWalletUpdateOne {
+	_u.mutation.ResetTotalDeducted()
+	_u.mutation.SetTotalDeducted(v)
+	return _u
+}
+
+// SetNillableTotalDeducted sets the "total_deducted" field if the given value is not nil.
+func (_u *PayeeWalletUpdateOne) SetNillableTotalDeducted(v *decimal.Decimal) *PayeeWalletUpdateOne {
+	if v != nil {
+		_u.SetTotalDeducted(*v)
+	}
+	return _u
+}
+
+// AddTotalDeducted adds value to the "total_deducted" field.
+func (_u *PayeeWalletUpdateOne) AddTotalDeducted(v decimal.Decimal) *PayeeWalletUpdateOne {
+	_u.mutation.AddTotalDeducted(v)
+	return _u
+}
+
+// ClearTotalDeducted clears the value of the "total_deducted" field.
+func (_u *PayeeWalletUpdateOne) ClearTotalDeducted() *PayeeWalletUpdateOne {
+	_u.mutation.ClearTotalDeducted()
+	return _u
+}
+
+// SetTotalPaid sets the "total_paid" field.
+func (_u *PayeeWalletUpdateOne) SetTotalPaid(v decimal.Decimal) *PayeeWalletUpdateOne {
+	_u.mutation.ResetTotalPaid()
+	_u.mutation.SetTotalPaid(v)
+	return _u
+}
+
+// SetNillableTotalPaid sets the "total_paid" field if the given value is not nil.
+func (_u *PayeeWalletUpdateOne) SetNillableTotalPaid(v *decimal.Decimal) *PayeeWalletUpdateOne {
+	if v != nil {
+		_u.SetTotalPaid(*v)
+	}
+	return _u
+}
+
+// AddTotalPaid adds value to the "total_paid" field.
+func (_u *PayeeWalletUpdateOne) AddTotalPaid(v decimal.Decimal) *PayeeWalletUpdateOne {
+	_u.mutation.AddTotalPaid(v)
+	return _u
+}
+
+// ClearTotalPaid clears the value of the "total_paid" field.
+func (_u *PayeeWalletUpdateOne) ClearTotalPaid() *PayeeWalletUpdateOne {
+	_u.mutation.ClearTotalPaid()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PayeeWalletUpdateOne) SetUpdatedAt(v time.Time) *PayeeWalletUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the PayeeWalletMutation object of the builder.
+func (_u *PayeeWalletUpdateOne) Mutation() *PayeeWalletMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PayeeWalletUpdate builder.
+func (_u *PayeeWalletUpdateOne) Where(ps ...predicate.PayeeWallet) *PayeeWalletUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PayeeWalletUpdateOne) Select(field string, fields ...string) *PayeeWalletUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PayeeWallet entity.
+func (_u *PayeeWalletUpdateOne) Save(ctx context.Context) (*PayeeWallet, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PayeeWalletUpdateOne) SaveX(ctx context.Context) *PayeeWallet {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PayeeWalletUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PayeeWalletUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PayeeWalletUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := payeewallet.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *PayeeWalletUpdateOne) sqlSave(ctx context.Context) (_node *PayeeWallet, err error) {
+	_spec := sqlgraph.NewUpdateSpec(payeewallet.Table, payeewallet.Columns, sqlgraph.NewFieldSpec(payeewallet.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PayeeWallet.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payeewallet.FieldID)
+		for _, f := range fields {
+			if !payeewallet.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != payeewallet.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(payeewallet.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PayeeType(); ok {
+		_spec.SetField(payeewallet.FieldPayeeType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(payeewallet.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(payeewallet.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(payeewallet.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(payeewallet.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.Balance(); ok {
+		_spec.SetField(payeewallet.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalance(); ok {
+		_spec.AddField(payeewallet.FieldBalance, field.TypeFloat64, value)
+	}
+	if _u.mutation.BalanceCleared() {
+		_spec.ClearField(payeewallet.FieldBalance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ReservedAmount(); ok {
+		_spec.SetField(payeewallet.FieldReservedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedReservedAmount(); ok {
+		_spec.AddField(payeewallet.FieldReservedAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.ReservedAmountCleared() {
+		_spec.ClearField(payeewallet.FieldReservedAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TotalEarned(); ok {
+		_spec.SetField(payeewallet.FieldTotalEarned, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalEarned(); ok {
+		_spec.AddField(payeewallet.FieldTotalEarned, field.TypeFloat64, value)
+	}
+	if _u.mutation.TotalEarnedCleared() {
+		_spec.ClearField(payeewallet.FieldTotalEarned, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TotalDeducted(); ok {
+		_spec.SetField(payeewallet.FieldTotalDeducted, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalDeducted(); ok {
+		_spec.AddField(payeewallet.FieldTotalDeducted, field.TypeFloat64, value)
+	}
+	if _u.mutation.TotalDeductedCleared() {
+		_spec.ClearField(payeewallet.FieldTotalDeducted, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TotalPaid(); ok {
+		_spec.SetField(payeewallet.FieldTotalPaid, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalPaid(); ok {
+		_spec.AddField(payeewallet.FieldTotalPaid, field.TypeFloat64, value)
+	}
+	if _u.mutation.TotalPaidCleared() {
+		_spec.ClearField(payeewallet.FieldTotalPaid, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(payeewallet.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &PayeeWallet{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payeewallet.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/payeewalletentry"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PayeeWalletEntry is the model entity for the PayeeWalletEntry schema.
+type PayeeWalletEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// WalletID holds the value of the "wallet_id" field.
+	WalletID uuid.UUID `json:"wallet_id,omitempty"`
+	// Entry type: earning, deduction, payout
+	EntryType string `json:"entry_type,omitempty"`
+	// Deduction category: advance, fuel, equipment, penalty, other
+	Category string `json:"category,omitempty"`
+	// Signed effect on the balance
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Wallet balance after the entry
+	BalanceAfter decimal.Decimal `json:"balance_after,omitempty"`
+	// Earnings calculation or deduction reference in the logistics service
+	ReferenceID string `json:"reference_id,omitempty"`
+	// Payout of a payout entry
+	PayoutID uuid.UUID `json:"payout_id,omitempty"`
+	// Inbound event the entry was recorded from
+	EventID string `json:"event_id,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// JournalEntryID holds the value of the "journal_entry_id" field.
+	JournalEntryID uuid.UUID `json:"journal_entry_id,omitempty"`
+	// OccurredAt holds the value of the "occurred_at" field.
+	OccurredAt time.Time `json:"occurred_at,omitempty"`
+	// User who entered a manual deduction
+	CreatedBy uuid.UUID `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PayeeWalletEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case payeewalletentry.FieldAmount, payeewalletentry.FieldBalanceAfter:
+			values[i] = new(decimal.Decimal)
+		case payeewalletentry.FieldEntryType, payeewalletentry.FieldCategory, payeewalletentry.FieldReferenceID, payeewalletentry.FieldEventID, payeewalletentry.FieldDescription:
+			values[i] = new(sql.NullString)
+		case payeewalletentry.FieldOccurredAt, payeewalletentry.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case payeewalletentry.FieldID, payeewalletentry.FieldTenantID, payeewalletentry.FieldWalletID, payeewalletentry.FieldPayoutID, payeewalletentry.FieldJournalEntryID, payeewalletentry.FieldCreatedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PayeeWalletEntry fields.
+func (_m *PayeeWalletEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case payeewalletentry.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case payeewalletentry.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case payeewalletentry.FieldWalletID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field wallet_id", values[i])
+			} else if value != nil {
+				_m.WalletID = *value
+			}
+		case payeewalletentry.FieldEntryType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entry_type", values[i])
+			} else if value.Valid {
+				_m.EntryType = value.String
+			}
+		case payeewalletentry.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = value.String
+			}
+		case payeewalletentry.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case payeewalletentry.FieldBalanceAfter:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field balance_after", values[i])
+			} else if value != nil {
+				_m.BalanceAfter = *value
+			}
+		case payeewalletentry.FieldReferenceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference_id", values[i])
+			} else if value.Valid {
+				_m.ReferenceID = value.String
+			}
+		case payeewalletentry.FieldPayoutID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field payout_id", values[i])
+			} else if value != nil {
+				_m.PayoutID = *value
+			}
+		case payeewalletentry.FieldEventID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				_m.EventID = value.String
+			}
+		case payeewalletentry.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case payeewalletentry.FieldJournalEntryID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field journal_entry_id", values[i])
+			} else if value != nil {
+				_m.JournalEntryID = *value
+			}
+		case payeewalletentry.FieldOccurredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field occurred_at", values[i])
+			} else if value.Valid {
+				_m.OccurredAt = value.Time
+			}
+		case payeewalletentry.FieldCreatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value != nil {
+				_m.CreatedBy = *value
+			}
+		case payeewalletentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PayeeWalletEntry.
+// This includes values selected through modifiers, order, etc.
+func (_m *PayeeWalletEntry) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PayeeWalletEntry.
+// Note that you need to call PayeeWalletEntry.Unwrap() before calling this method if this PayeeWalletEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PayeeWalletEntry) Update() *PayeeWalletEntryUpdateOne {
+	return NewPayeeWalletEntryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PayeeWalletEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PayeeWalletEntry) Unwrap() *PayeeWalletEntry {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PayeeWalletEntry is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PayeeWalletEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("PayeeWalletEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("wallet_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WalletID))
+	builder.WriteString(", ")
+	builder.WriteString("entry_type=")
+	builder.WriteString(_m.EntryType)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(_m.Category)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("balance_after=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BalanceAfter))
+	builder.WriteString(", ")
+	builder.WriteString("reference_id=")
+	builder.WriteString(_m.ReferenceID)
+	builder.WriteString(", ")
+	builder.WriteString("payout_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PayoutID))
+	builder.WriteString(", ")
+	builder.WriteString("event_id=")
+	builder.WriteString(_m.EventID)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("journal_entry_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JournalEntryID))
+	builder.WriteString(", ")
+	builder.WriteString("occurred_at=")
+	builder.WriteString(_m.OccurredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PayeeWalletEntries is a parsable slice of PayeeWalletEntry.
+type PayeeWalletEntries []*PayeeWalletEntry
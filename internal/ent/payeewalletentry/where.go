@@ -0,0 +1,897 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payeewalletentry
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldTenantID, v))
+}
+
+// WalletID applies equality check predicate on the "wallet_id" field. It's identical to WalletIDEQ.
+func WalletID(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldWalletID, v))
+}
+
+// EntryType applies equality check predicate on the "entry_type" field. It's identical to EntryTypeEQ.
+func EntryType(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldEntryType, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldCategory, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldAmount, v))
+}
+
+// BalanceAfter applies equality check predicate on the "balance_after" field. It's identical to BalanceAfterEQ.
+func BalanceAfter(v decimal.Decimal) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldBalanceAfter, v))
+}
+
+// ReferenceID applies equality check predicate on the "reference_id" field. It's identical to ReferenceIDEQ.
+func ReferenceID(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldReferenceID, v))
+}
+
+// PayoutID applies equality check predicate on the "payout_id" field. It's identical to PayoutIDEQ.
+func PayoutID(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldPayoutID, v))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldEventID, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldDescription, v))
+}
+
+// JournalEntryID applies equality check predicate on the "journal_entry_id" field. It's identical to JournalEntryIDEQ.
+func JournalEntryID(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// OccurredAt applies equality check predicate on the "occurred_at" field. It's identical to OccurredAtEQ.
+func OccurredAt(v time.Time) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLTE(FieldTenantID, v))
+}
+
+// WalletIDEQ applies the EQ predicate on the "wallet_id" field.
+func WalletIDEQ(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldWalletID, v))
+}
+
+// WalletIDNEQ applies the NEQ predicate on the "wallet_id" field.
+func WalletIDNEQ(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNEQ(FieldWalletID, v))
+}
+
+// WalletIDIn applies the In predicate on the "wallet_id" field.
+func WalletIDIn(vs ...uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIn(FieldWalletID, vs...))
+}
+
+// WalletIDNotIn applies the NotIn predicate on the "wallet_id" field.
+func WalletIDNotIn(vs ...uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotIn(FieldWalletID, vs...))
+}
+
+// WalletIDGT applies the GT predicate on the "wallet_id" field.
+func WalletIDGT(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGT(FieldWalletID, v))
+}
+
+// WalletIDGTE applies the GTE predicate on the "wallet_id" field.
+func WalletIDGTE(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGTE(FieldWalletID, v))
+}
+
+// WalletIDLT applies the LT predicate on the "wallet_id" field.
+func WalletIDLT(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLT(FieldWalletID, v))
+}
+
+// WalletIDLTE applies the LTE predicate on the "wallet_id" field.
+func WalletIDLTE(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLTE(FieldWalletID, v))
+}
+
+// EntryTypeEQ applies the EQ predicate on the "entry_type" field.
+func EntryTypeEQ(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldEntryType, v))
+}
+
+// EntryTypeNEQ applies the NEQ predicate on the "entry_type" field.
+func EntryTypeNEQ(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNEQ(FieldEntryType, v))
+}
+
+// EntryTypeIn applies the In predicate on the "entry_type" field.
+func EntryTypeIn(vs ...string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIn(FieldEntryType, vs...))
+}
+
+// EntryTypeNotIn applies the NotIn predicate on the "entry_type" field.
+func EntryTypeNotIn(vs ...string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotIn(FieldEntryType, vs...))
+}
+
+// EntryTypeGT applies the GT predicate on the "entry_type" field.
+func EntryTypeGT(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGT(FieldEntryType, v))
+}
+
+// EntryTypeGTE applies the GTE predicate on the "entry_type" field.
+func EntryTypeGTE(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGTE(FieldEntryType, v))
+}
+
+// EntryTypeLT applies the LT predicate on the "entry_type" field.
+func EntryTypeLT(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLT(FieldEntryType, v))
+}
+
+// EntryTypeLTE applies the LTE predicate on the "entry_type" field.
+func EntryTypeLTE(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLTE(FieldEntryType, v))
+}
+
+// EntryTypeContains applies the Contains predicate on the "entry_type" field.
+func EntryTypeContains(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldContains(FieldEntryType, v))
+}
+
+// EntryTypeHasPrefix applies the HasPrefix predicate on the "entry_type" field.
+func EntryTypeHasPrefix(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldHasPrefix(FieldEntryType, v))
+}
+
+// EntryTypeHasSuffix applies the HasSuffix predicate on the "entry_type" field.
+func EntryTypeHasSuffix(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldHasSuffix(FieldEntryType, v))
+}
+
+// EntryTypeEqualFold applies the EqualFold predicate on the "entry_type" field.
+func EntryTypeEqualFold(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEqualFold(FieldEntryType, v))
+}
+
+// EntryTypeContainsFold applies the ContainsFold predicate on the "entry_type" field.
+func EntryTypeContainsFold(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldContainsFold(FieldEntryType, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryIsNil applies the IsNil predicate on the "category" field.
+func CategoryIsNil() predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIsNull(FieldCategory))
+}
+
+// CategoryNotNil applies the NotNil predicate on the "category" field.
+func CategoryNotNil() predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotNull(FieldCategory))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLTE(FieldAmount, v))
+}
+
+// BalanceAfterEQ applies the EQ predicate on the "balance_after" field.
+func BalanceAfterEQ(v decimal.Decimal) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldBalanceAfter, v))
+}
+
+// BalanceAfterNEQ applies the NEQ predicate on the "balance_after" field.
+func BalanceAfterNEQ(v decimal.Decimal) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNEQ(FieldBalanceAfter, v))
+}
+
+// BalanceAfterIn applies the In predicate on the "balance_after" field.
+func BalanceAfterIn(vs ...decimal.Decimal) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIn(FieldBalanceAfter, vs...))
+}
+
+// BalanceAfterNotIn applies the NotIn predicate on the "balance_after" field.
+func BalanceAfterNotIn(vs ...decimal.Decimal) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotIn(FieldBalanceAfter, vs...))
+}
+
+// BalanceAfterGT applies the GT predicate on the "balance_after" field.
+func BalanceAfterGT(v decimal.Decimal) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGT(FieldBalanceAfter, v))
+}
+
+// BalanceAfterGTE applies the GTE predicate on the "balance_after" field.
+func BalanceAfterGTE(v decimal.Decimal) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGTE(FieldBalanceAfter, v))
+}
+
+// BalanceAfterLT applies the LT predicate on the "balance_after" field.
+func BalanceAfterLT(v decimal.Decimal) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLT(FieldBalanceAfter, v))
+}
+
+// BalanceAfterLTE applies the LTE predicate on the "balance_after" field.
+func BalanceAfterLTE(v decimal.Decimal) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLTE(FieldBalanceAfter, v))
+}
+
+// ReferenceIDEQ applies the EQ predicate on the "reference_id" field.
+func ReferenceIDEQ(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldReferenceID, v))
+}
+
+// ReferenceIDNEQ applies the NEQ predicate on the "reference_id" field.
+func ReferenceIDNEQ(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNEQ(FieldReferenceID, v))
+}
+
+// ReferenceIDIn applies the In predicate on the "reference_id" field.
+func ReferenceIDIn(vs ...string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIn(FieldReferenceID, vs...))
+}
+
+// ReferenceIDNotIn applies the NotIn predicate on the "reference_id" field.
+func ReferenceIDNotIn(vs ...string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotIn(FieldReferenceID, vs...))
+}
+
+// ReferenceIDGT applies the GT predicate on the "reference_id" field.
+func ReferenceIDGT(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGT(FieldReferenceID, v))
+}
+
+// ReferenceIDGTE applies the GTE predicate on the "reference_id" field.
+func ReferenceIDGTE(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGTE(FieldReferenceID, v))
+}
+
+// ReferenceIDLT applies the LT predicate on the "reference_id" field.
+func ReferenceIDLT(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLT(FieldReferenceID, v))
+}
+
+// ReferenceIDLTE applies the LTE predicate on the "reference_id" field.
+func ReferenceIDLTE(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLTE(FieldReferenceID, v))
+}
+
+// ReferenceIDContains applies the Contains predicate on the "reference_id" field.
+func ReferenceIDContains(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldContains(FieldReferenceID, v))
+}
+
+// ReferenceIDHasPrefix applies the HasPrefix predicate on the "reference_id" field.
+func ReferenceIDHasPrefix(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldHasPrefix(FieldReferenceID, v))
+}
+
+// ReferenceIDHasSuffix applies the HasSuffix predicate on the "reference_id" field.
+func ReferenceIDHasSuffix(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldHasSuffix(FieldReferenceID, v))
+}
+
+// ReferenceIDIsNil applies the IsNil predicate on the "reference_id" field.
+func ReferenceIDIsNil() predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIsNull(FieldReferenceID))
+}
+
+// ReferenceIDNotNil applies the NotNil predicate on the "reference_id" field.
+func ReferenceIDNotNil() predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotNull(FieldReferenceID))
+}
+
+// ReferenceIDEqualFold applies the EqualFold predicate on the "reference_id" field.
+func ReferenceIDEqualFold(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEqualFold(FieldReferenceID, v))
+}
+
+// ReferenceIDContainsFold applies the ContainsFold predicate on the "reference_id" field.
+func ReferenceIDContainsFold(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldContainsFold(FieldReferenceID, v))
+}
+
+// PayoutIDEQ applies the EQ predicate on the "payout_id" field.
+func PayoutIDEQ(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldPayoutID, v))
+}
+
+// PayoutIDNEQ applies the NEQ predicate on the "payout_id" field.
+func PayoutIDNEQ(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNEQ(FieldPayoutID, v))
+}
+
+// PayoutIDIn applies the In predicate on the "payout_id" field.
+func PayoutIDIn(vs ...uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIn(FieldPayoutID, vs...))
+}
+
+// PayoutIDNotIn applies the NotIn predicate on the "payout_id" field.
+func PayoutIDNotIn(vs ...uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotIn(FieldPayoutID, vs...))
+}
+
+// PayoutIDGT applies the GT predicate on the "payout_id" field.
+func PayoutIDGT(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGT(FieldPayoutID, v))
+}
+
+// PayoutIDGTE applies the GTE predicate on the "payout_id" field.
+func PayoutIDGTE(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGTE(FieldPayoutID, v))
+}
+
+// PayoutIDLT applies the LT predicate on the "payout_id" field.
+func PayoutIDLT(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLT(FieldPayoutID, v))
+}
+
+// PayoutIDLTE applies the LTE predicate on the "payout_id" field.
+func PayoutIDLTE(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLTE(FieldPayoutID, v))
+}
+
+// PayoutIDIsNil applies the IsNil predicate on the "payout_id" field.
+func PayoutIDIsNil() predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIsNull(FieldPayoutID))
+}
+
+// PayoutIDNotNil applies the NotNil predicate on the "payout_id" field.
+func PayoutIDNotNil() predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotNull(FieldPayoutID))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGT(FieldEventID, v))
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGTE(FieldEventID, v))
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLT(FieldEventID, v))
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLTE(FieldEventID, v))
+}
+
+// EventIDContains applies the Contains predicate on the "event_id" field.
+func EventIDContains(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldContains(FieldEventID, v))
+}
+
+// EventIDHasPrefix applies the HasPrefix predicate on the "event_id" field.
+func EventIDHasPrefix(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldHasPrefix(FieldEventID, v))
+}
+
+// EventIDHasSuffix applies the HasSuffix predicate on the "event_id" field.
+func EventIDHasSuffix(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldHasSuffix(FieldEventID, v))
+}
+
+// EventIDIsNil applies the IsNil predicate on the "event_id" field.
+func EventIDIsNil() predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIsNull(FieldEventID))
+}
+
+// EventIDNotNil applies the NotNil predicate on the "event_id" field.
+func EventIDNotNil() predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotNull(FieldEventID))
+}
+
+// EventIDEqualFold applies the EqualFold predicate on the "event_id" field.
+func EventIDEqualFold(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEqualFold(FieldEventID, v))
+}
+
+// EventIDContainsFold applies the ContainsFold predicate on the "event_id" field.
+func EventIDContainsFold(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldContainsFold(FieldEventID, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// JournalEntryIDEQ applies the EQ predicate on the "journal_entry_id" field.
+func JournalEntryIDEQ(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDNEQ applies the NEQ predicate on the "journal_entry_id" field.
+func JournalEntryIDNEQ(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIn applies the In predicate on the "journal_entry_id" field.
+func JournalEntryIDIn(vs ...uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDNotIn applies the NotIn predicate on the "journal_entry_id" field.
+func JournalEntryIDNotIn(vs ...uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDGT applies the GT predicate on the "journal_entry_id" field.
+func JournalEntryIDGT(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGT(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDGTE applies the GTE predicate on the "journal_entry_id" field.
+func JournalEntryIDGTE(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGTE(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDLT applies the LT predicate on the "journal_entry_id" field.
+func JournalEntryIDLT(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLT(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDLTE applies the LTE predicate on the "journal_entry_id" field.
+func JournalEntryIDLTE(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLTE(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIsNil applies the IsNil predicate on the "journal_entry_id" field.
+func JournalEntryIDIsNil() predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIsNull(FieldJournalEntryID))
+}
+
+// JournalEntryIDNotNil applies the NotNil predicate on the "journal_entry_id" field.
+func JournalEntryIDNotNil() predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotNull(FieldJournalEntryID))
+}
+
+// OccurredAtEQ applies the EQ predicate on the "occurred_at" field.
+func OccurredAtEQ(v time.Time) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtNEQ applies the NEQ predicate on the "occurred_at" field.
+func OccurredAtNEQ(v time.Time) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtIn applies the In predicate on the "occurred_at" field.
+func OccurredAtIn(vs ...time.Time) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtNotIn applies the NotIn predicate on the "occurred_at" field.
+func OccurredAtNotIn(vs ...time.Time) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtGT applies the GT predicate on the "occurred_at" field.
+func OccurredAtGT(v time.Time) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGT(FieldOccurredAt, v))
+}
+
+// OccurredAtGTE applies the GTE predicate on the "occurred_at" field.
+func OccurredAtGTE(v time.Time) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGTE(FieldOccurredAt, v))
+}
+
+// OccurredAtLT applies the LT predicate on the "occurred_at" field.
+func OccurredAtLT(v time.Time) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLT(FieldOccurredAt, v))
+}
+
+// OccurredAtLTE applies the LTE predicate on the "occurred_at" field.
+func OccurredAtLTE(v time.Time) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLTE(FieldOccurredAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uuid.UUID) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PayeeWalletEntry) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PayeeWalletEntry) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PayeeWalletEntry) predicate.PayeeWalletEntry {
+	return predicate.PayeeWalletEntry(sql.NotPredicates(p))
+}
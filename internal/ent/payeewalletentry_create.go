@@ -0,0 +1,804 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/payeewalletentry"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PayeeWalletEntryCreate is the builder for creating a PayeeWalletEntry entity.
+type PayeeWalletEntryCreate struct {
+	config
+	mutation *PayeeWalletEntryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *PayeeWalletEntryCreate) SetTenantID(v uuid.UUID) *PayeeWalletEntryCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (_c *PayeeWalletEntryCreate) SetWalletID(v uuid.UUID) *PayeeWalletEntryCreate {
+	_c.mutation.SetWalletID(v)
+	return _c
+}
+
+// SetEntryType sets the "entry_type" field.
+func (_c *PayeeWalletEntryCreate) SetEntryType(v string) *PayeeWalletEntryCreate {
+	_c.mutation.SetEntryType(v)
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *PayeeWalletEntryCreate) SetCategory(v string) *PayeeWalletEntryCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_c *PayeeWalletEntryCreate) SetNillableCategory(v *string) *PayeeWalletEntryCreate {
+	if v != nil {
+		_c.SetCategory(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *PayeeWalletEntryCreate) SetAmount(v decimal.Decimal) *PayeeWalletEntryCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (_c *PayeeWalletEntryCreate) SetBalanceAfter(v decimal.Decimal) *PayeeWalletEntryCreate {
+	_c.mutation.SetBalanceAfter(v)
+	return _c
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (_c *PayeeWalletEntryCreate) SetReferenceID(v string) *PayeeWalletEntryCreate {
+	_c.mutation.SetReferenceID(v)
+	return _c
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (_c *PayeeWalletEntryCreate) SetNillableReferenceID(v *string) *PayeeWalletEntryCreate {
+	if v != nil {
+		_c.SetReferenceID(*v)
+	}
+	return _c
+}
+
+// SetPayoutID sets the "payout_id" field.
+func (_c *PayeeWalletEntryCreate) SetPayoutID(v uuid.UUID) *PayeeWalletEntryCreate {
+	_c.mutation.SetPayoutID(v)
+	return _c
+}
+
+// SetNillablePayoutID sets the "payout_id" field if the given value is not nil.
+func (_c *PayeeWalletEntryCreate) SetNillablePayoutID(v *uuid.UUID) *PayeeWalletEntryCreate {
+	if v != nil {
+		_c.SetPayoutID(*v)
+	}
+	return _c
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *PayeeWalletEntryCreate) SetEventID(v string) *PayeeWalletEntryCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_c *PayeeWalletEntryCreate) SetNillableEventID(v *string) *PayeeWalletEntryCreate {
+	if v != nil {
+		_c.SetEventID(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *PayeeWalletEntryCreate) SetDescription(v string) *PayeeWalletEntryCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *PayeeWalletEntryCreate) SetNillableDescription(v *string) *PayeeWalletEntryCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_c *PayeeWalletEntryCreate) SetJournalEntryID(v uuid.UUID) *PayeeWalletEntryCreate {
+	_c.mutation.SetJournalEntryID(v)
+	return _c
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_c *PayeeWalletEntryCreate) SetNillableJournalEntryID(v *uuid.UUID) *PayeeWalletEntryCreate {
+	if v != nil {
+		_c.SetJournalEntryID(*v)
+	}
+	return _c
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (_c *PayeeWalletEntryCreate) SetOccurredAt(v time.Time) *PayeeWalletEntryCreate {
+	_c.mutation.SetOccurredAt(v)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *PayeeWalletEntryCreate) SetCreatedBy(v uuid.UUID) *PayeeWalletEntryCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *PayeeWalletEntryCreate) SetNillableCreatedBy(v *uuid.UUID) *PayeeWalletEntryCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PayeeWalletEntryCreate) SetCreatedAt(v time.Time) *PayeeWalletEntryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PayeeWalletEntryCreate) SetNillableCreatedAt(v *time.Time) *PayeeWalletEntryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PayeeWalletEntryCreate) SetID(v uuid.UUID) *PayeeWalletEntryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PayeeWalletEntryCreate) SetNillableID(v *uuid.UUID) *PayeeWalletEntryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PayeeWalletEntryMutation object of the builder.
+func (_c *PayeeWalletEntryCreate) Mutation() *PayeeWalletEntryMutation {
+	return _c.mutation
+}
+
+// Save creates the PayeeWalletEntry in the database.
+func (_c *PayeeWalletEntryCreate) Save(ctx context.Context) (*PayeeWalletEntry, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PayeeWalletEntryCreate) SaveX(ctx context.Context) *PayeeWalletEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PayeeWalletEntryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PayeeWalletEntryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PayeeWalletEntryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := payeewalletentry.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := payeewalletentry.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PayeeWalletEntryCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "PayeeWalletEntry.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet_id", err: errors.New(`ent: missing required field "PayeeWalletEntry.wallet_id"`)}
+	}
+	if _, ok := _c.mutation.EntryType(); !ok {
+		return &ValidationError{Name: "entry_type", err: errors.New(`ent: missing required field "PayeeWalletEntry.entry_type"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "PayeeWalletEntry.amount"`)}
+	}
+	if _, ok := _c.mutation.BalanceAfter(); !ok {
+		return &ValidationError{Name: "balance_after", err: errors.New(`ent: missing required field "PayeeWalletEntry.balance_after"`)}
+	}
+	if _, ok := _c.mutation.OccurredAt(); !ok {
+		return &ValidationError{Name: "occurred_at", err: errors.New(`ent: missing required field "PayeeWalletEntry.occurred_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PayeeWalletEntry.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *PayeeWalletEntryCreate) sqlSave(ctx context.Context) (*PayeeWalletEntry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PayeeWalletEntryCreate) createSpec() (*PayeeWalletEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PayeeWalletEntry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(payeewalletentry.Table, sqlgraph.NewFieldSpec(payeewalletentry.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(payeewalletentry.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.WalletID(); ok {
+		_spec.SetField(payeewalletentry.FieldWalletID, field.TypeUUID, value)
+		_node.WalletID = value
+	}
+	if value, ok := _c.mutation.EntryType(); ok {
+		_spec.SetField(payeewalletentry.FieldEntryType, field.TypeString, value)
+		_node.EntryType = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(payeewalletentry.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(payeewalletentry.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.BalanceAfter(); ok {
+		_spec.SetField(payeewalletentry.FieldBalanceAfter, field.TypeFloat64, value)
+		_node.BalanceAfter = value
+	}
+	if value, ok := _c.mutation.ReferenceID(); ok {
+		_spec.SetField(payeewalletentry.FieldReferenceID, field.TypeString, value)
+		_node.ReferenceID = value
+	}
+	if value, ok := _c.mutation.PayoutID(); ok {
+		_spec.SetField(payeewalletentry.FieldPayoutID, field.TypeUUID, value)
+		_node.PayoutID = value
+	}
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(payeewalletentry.FieldEventID, field.TypeString, value)
+		_node.EventID = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(payeewalletentry.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.JournalEntryID(); ok {
+		_spec.SetField(payeewalletentry.FieldJournalEntryID, field.TypeUUID, value)
+		_node.JournalEntryID = value
+	}
+	if value, ok := _c.mutation.OccurredAt(); ok {
+		_spec.SetField(payeewalletentry.FieldOccurredAt, field.TypeTime, value)
+		_node.OccurredAt = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(payeewalletentry.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(payeewalletentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PayeeWalletEntry.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PayeeWalletEntryUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PayeeWalletEntryCreate) OnConflict(opts ...sql.ConflictOption) *PayeeWalletEntryUpsertOne {
+	_c.conflict = opts
+	return &PayeeWalletEntryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PayeeWalletEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PayeeWalletEntryCreate) OnConflictColumns(columns ...string) *PayeeWalletEntryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PayeeWalletEntryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PayeeWalletEntryUpsertOne is the builder for "upsert"-ing
+	//  one PayeeWalletEntry node.
+	PayeeWalletEntryUpsertOne struct {
+		create *PayeeWalletEntryCreate
+	}
+
+	// PayeeWalletEntryUpsert is the "OnConflict" setter.
+	PayeeWalletEntryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *PayeeWalletEntryUpsert) SetTenantID(v uuid.UUID) *PayeeWalletEntryUpsert {
+	u.Set(payeewalletentry.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *PayeeWalletEntryUpsert) UpdateTenantID() *PayeeWalletEntryUpsert {
+	u.SetExcluded(payeewalletentry.FieldTenantID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PayeeWalletEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(payeewalletentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PayeeWalletEntryUpsertOne) UpdateNewValues() *PayeeWalletEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(payeewalletentry.FieldID)
+		}
+		if _, exists := u.create.mutation.WalletID(); exists {
+			s.SetIgnore(payeewalletentry.FieldWalletID)
+		}
+		if _, exists := u.create.mutation.EntryType(); exists {
+			s.SetIgnore(payeewalletentry.FieldEntryType)
+		}
+		if _, exists := u.create.mutation.Category(); exists {
+			s.SetIgnore(payeewalletentry.FieldCategory)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(payeewalletentry.FieldAmount)
+		}
+		if _, exists := u.create.mutation.BalanceAfter(); exists {
+			s.SetIgnore(payeewalletentry.FieldBalanceAfter)
+		}
+		if _, exists := u.create.mutation.ReferenceID(); exists {
+			s.SetIgnore(payeewalletentry.FieldReferenceID)
+		}
+		if _, exists := u.create.mutation.PayoutID(); exists {
+			s.SetIgnore(payeewalletentry.FieldPayoutID)
+		}
+		if _, exists := u.create.mutation.EventID(); exists {
+			s.SetIgnore(payeewalletentry.FieldEventID)
+		}
+		if _, exists := u.create.mutation.Description(); exists {
+			s.SetIgnore(payeewalletentry.FieldDescription)
+		}
+		if _, exists := u.create.mutation.JournalEntryID(); exists {
+			s.SetIgnore(payeewalletentry.FieldJournalEntryID)
+		}
+		if _, exists := u.create.mutation.OccurredAt(); exists {
+			s.SetIgnore(payeewalletentry.FieldOccurredAt)
+		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(payeewalletentry.FieldCreatedBy)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(payeewalletentry.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PayeeWalletEntry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PayeeWalletEntryUpsertOne) Ignore() *PayeeWalletEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PayeeWalletEntryUpsertOne) DoNothing() *PayeeWalletEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PayeeWalletEntryCreate.OnConflict
+// documentation for more info.
+func (u *PayeeWalletEntryUpsertOne) Update(set func(*PayeeWalletEntryUpsert)) *PayeeWalletEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PayeeWalletEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *PayeeWalletEntryUpsertOne) SetTenantID(v uuid.UUID) *PayeeWalletEntryUpsertOne {
+	return u.Update(func(s *PayeeWalletEntryUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *PayeeWalletEntryUpsertOne) UpdateTenantID() *PayeeWalletEntryUpsertOne {
+	return u.Update(func(s *PayeeWalletEntryUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// Exec executes the query.
+func (u *PayeeWalletEntryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PayeeWalletEntryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PayeeWalletEntryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PayeeWalletEntryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PayeeWalletEntryUpsertOne.ID is not supported by MySQL driver. Use PayeeWalletEntryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PayeeWalletEntryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PayeeWalletEntryCreateBulk is the builder for creating many PayeeWalletEntry entities in bulk.
+type PayeeWalletEntryCreateBulk struct {
+	config
+	err      error
+	builders []*PayeeWalletEntryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PayeeWalletEntry entities in the database.
+func (_c *PayeeWalletEntryCreateBulk) Save(ctx context.Context) ([]*PayeeWalletEntry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PayeeWalletEntry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PayeeWalletEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PayeeWalletEntryCreateBulk) SaveX(ctx context.Context) []*PayeeWalletEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PayeeWalletEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PayeeWalletEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PayeeWalletEntry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PayeeWalletEntryUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PayeeWalletEntryCreateBulk) OnConflict(opts ...sql.ConflictOption) *PayeeWalletEntryUpsertBulk {
+	_c.conflict = opts
+	return &PayeeWalletEntryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PayeeWalletEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PayeeWalletEntryCreateBulk) OnConflictColumns(columns ...string) *PayeeWalletEntryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PayeeWalletEntryUpsertBulk{
+		create: _c,
+	}
+}
+
+// PayeeWalletEntryUpsertBulk is the builder for "upsert"-ing
+// a bulk of PayeeWalletEntry nodes.
+type PayeeWalletEntryUpsertBulk struct {
+	create *PayeeWalletEntryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PayeeWalletEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(payeewalletentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PayeeWalletEntryUpsertBulk) UpdateNewValues() *PayeeWalletEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(payeewalletentry.FieldID)
+			}
+			if _, exists := b.mutation.WalletID(); exists {
+				s.SetIgnore(payeewalletentry.FieldWalletID)
+			}
+			if _, exists := b.mutation.EntryType(); exists {
+				s.SetIgnore(payeewalletentry.FieldEntryType)
+			}
+			if _, exists := b.mutation.Category(); exists {
+				s.SetIgnore(payeewalletentry.FieldCategory)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(payeewalletentry.FieldAmount)
+			}
+			if _, exists := b.mutation.BalanceAfter(); exists {
+				s.SetIgnore(payeewalletentry.FieldBalanceAfter)
+			}
+			if _, exists := b.mutation.ReferenceID(); exists {
+				s.SetIgnore(payeewalletentry.FieldReferenceID)
+			}
+			if _, exists := b.mutation.PayoutID(); exists {
+				s.SetIgnore(payeewalletentry.FieldPayoutID)
+			}
+			if _, exists := b.mutation.EventID(); exists {
+				s.SetIgnore(payeewalletentry.FieldEventID)
+			}
+			if _, exists := b.mutation.Description(); exists {
+				s.SetIgnore(payeewalletentry.FieldDescription)
+			}
+			if _, exists := b.mutation.JournalEntryID(); exists {
+				s.SetIgnore(payeewalletentry.FieldJournalEntryID)
+			}
+			if _, exists := b.mutation.OccurredAt(); exists {
+				s.SetIgnore(payeewalletentry.FieldOccurredAt)
+			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(payeewalletentry.FieldCreatedBy)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(payeewalletentry.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PayeeWalletEntry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PayeeWalletEntryUpsertBulk) Ignore() *PayeeWalletEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PayeeWalletEntryUpsertBulk) DoNothing() *PayeeWalletEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PayeeWalletEntryCreateBulk.OnConflict
+// documentation for more info.
+func (u *PayeeWalletEntryUpsertBulk) Update(set func(*PayeeWalletEntryUpsert)) *PayeeWalletEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PayeeWalletEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *PayeeWalletEntryUpsertBulk) SetTenantID(v uuid.UUID) *PayeeWalletEntryUpsertBulk {
+	return u.Update(func(s *PayeeWalletEntryUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *PayeeWalletEntryUpsertBulk) UpdateTenantID() *PayeeWalletEntryUpsertBulk {
+	return u.Update(func(s *PayeeWalletEntryUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// Exec executes the query.
+func (u *PayeeWalletEntryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PayeeWalletEntryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PayeeWalletEntryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PayeeWalletEntryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
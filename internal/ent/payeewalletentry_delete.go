@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/payeewalletentry"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+)
+
+// PayeeWalletEntryDelete is the builder for deleting a PayeeWalletEntry entity.
+type PayeeWalletEntryDelete struct {
+	config
+	hooks    []Hook
+	mutation *PayeeWalletEntryMutation
+}
+
+// Where appends a list predicates to the PayeeWalletEntryDelete builder.
+func (_d *PayeeWalletEntryDelete) Where(ps ...predicate.PayeeWalletEntry) *PayeeWalletEntryDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *PayeeWalletEntryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PayeeWalletEntryDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *PayeeWalletEntryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(payeewalletentry.Table, sqlgraph.NewFieldSpec(payeewalletentry.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// PayeeWalletEntryDeleteOne is the builder for deleting a single PayeeWalletEntry entity.
+type PayeeWalletEntryDeleteOne struct {
+	_d *PayeeWalletEntryDelete
+}
+
+// Where appends a list predicates to the PayeeWalletEntryDelete builder.
+func (_d *PayeeWalletEntryDeleteOne) Where(ps ...predicate.PayeeWalletEntry) *PayeeWalletEntryDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *PayeeWalletEntryDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{payeewalletentry.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PayeeWalletEntryDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/payeewalletentry"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// PayeeWalletEntryUpdate is the builder for updating PayeeWalletEntry entities.
+type PayeeWalletEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PayeeWalletEntryMutation
+}
+
+// Where appends a list predicates to the PayeeWalletEntryUpdate builder.
+func (_u *PayeeWalletEntryUpdate) Where(ps ...predicate.PayeeWalletEntry) *PayeeWalletEntryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *PayeeWalletEntryUpdate) SetTenantID(v uuid.UUID) *PayeeWalletEntryUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *PayeeWalletEntryUpdate) SetNillableTenantID(v *uuid.UUID) *PayeeWalletEntryUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PayeeWalletEntryMutation object of the builder.
+func (_u *PayeeWalletEntryUpdate) Mutation() *PayeeWalletEntryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PayeeWalletEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PayeeWalletEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PayeeWalletEntryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PayeeWalletEntryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PayeeWalletEntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(payeewalletentry.Table, payeewalletentry.Columns, sqlgraph.NewFieldSpec(payeewalletentry.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(payeewalletentry.FieldTenantID, field.TypeUUID, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(payeewalletentry.FieldCategory, field.TypeString)
+	}
+	if _u.mutation.ReferenceIDCleared() {
+		_spec.ClearField(payeewalletentry.FieldReferenceID, field.TypeString)
+	}
+	if _u.mutation.PayoutIDCleared() {
+		_spec.ClearField(payeewalletentry.FieldPayoutID, field.TypeUUID)
+	}
+	if _u.mutation.EventIDCleared() {
+		_spec.ClearField(payeewalletentry.FieldEventID, field.TypeString)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(payeewalletentry.FieldDescription, field.TypeString)
+	}
+	if _u.mutation.JournalEntryIDCleared() {
+		_spec.ClearField(payeewalletentry.FieldJournalEntryID, field.TypeUUID)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(payeewalletentry.FieldCreatedBy, field.TypeUUID)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payeewalletentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PayeeWalletEntryUpdateOne is the builder for updating a single PayeeWalletEntry entity.
+type PayeeWalletEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PayeeWalletEntryMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *PayeeWalletEntryUpdateOne) SetTenantID(v uuid.UUID) *PayeeWalletEntryUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *PayeeWalletEntryUpdateOne) SetNillableTenantID(v *uuid.UUID) *PayeeWalletEntryUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PayeeWalletEntryMutation object of the builder.
+func (_u *PayeeWalletEntryUpdateOne) Mutation() *PayeeWalletEntryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PayeeWalletEntryUpdate builder.
+func (_u *PayeeWalletEntryUpdateOne) Where(ps ...predicate.PayeeWalletEntry) *PayeeWalletEntryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PayeeWalletEntryUpdateOne) Select(field string, fields ...string) *PayeeWalletEntryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PayeeWalletEntry entity.
+func (_u *PayeeWalletEntryUpdateOne) Save(ctx context.Context) (*PayeeWalletEntry, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PayeeWalletEntryUpdateOne) SaveX(ctx context.Context) *PayeeWalletEntry {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PayeeWalletEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PayeeWalletEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PayeeWalletEntryUpdateOne) sqlSave(ctx context.Context) (_node *PayeeWalletEntry, err error) {
+	_spec := sqlgraph.NewUpdateSpec(payeewalletentry.Table, payeewalletentry.Columns, sqlgraph.NewFieldSpec(payeewalletentry.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PayeeWalletEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payeewalletentry.FieldID)
+		for _, f := range fields {
+			if !payeewalletentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != payeewalletentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(payeewalletentry.FieldTenantID, field.TypeUUID, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(payeewalletentry.FieldCategory, field.TypeString)
+	}
+	if _u.mutation.ReferenceIDCleared() {
+		_spec.ClearField(payeewalletentry.FieldReferenceID, field.TypeString)
+	}
+	if _u.mutation.PayoutIDCleared() {
+		_spec.ClearField(payeewalletentry.FieldPayoutID, field.TypeUUID)
+	}
+	if _u.mutation.EventIDCleared() {
+		_spec.ClearField(payeewalletentry.FieldEventID, field.TypeString)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(payeewalletentry.FieldDescription, field.TypeString)
+	}
+	if _u.mutation.JournalEntryIDCleared() {
+		_spec.ClearField(payeewalletentry.FieldJournalEntryID, field.TypeUUID)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(payeewalletentry.FieldCreatedBy, field.TypeUUID)
+	}
+	_node = &PayeeWalletEntry{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payeewalletentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
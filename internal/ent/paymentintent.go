@@ -36,7 +36,7 @@ type PaymentIntent struct {
 	Status string `json:"status,omitempty"`
 	// Additional metadata
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
-	// Customer identifier (from auth-service)
+	// Customer identifier (treasury customer, or auth-service user)
 	CustomerID uuid.UUID `json:"customer_id,omitempty"`
 	// Payment description
 	Description string `json:"description,omitempty"`
@@ -0,0 +1,398 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrun"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRun is the model entity for the PaymentRun schema.
+type PaymentRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Sequential payment run number
+	RunNumber string `json:"run_number,omitempty"`
+	// Date the vendors are paid
+	PaymentDate time.Time `json:"payment_date,omitempty"`
+	// ISO currency code of every bill in the run
+	Currency string `json:"currency,omitempty"`
+	// Payment method: bank_transfer, mpesa_b2b
+	Method string `json:"method,omitempty"`
+	// Ledger account the payments are made from
+	PaymentAccount string `json:"payment_account,omitempty"`
+	// Bills due on or before this date were selected
+	DueBefore time.Time `json:"due_before,omitempty"`
+	// Vendors the selection was limited to (empty for all)
+	VendorIds []uuid.UUID `json:"vendor_ids,omitempty"`
+	// Status: draft, approved, executed, cancelled
+	Status string `json:"status,omitempty"`
+	// Total paid out (defaults to zero)
+	TotalAmount decimal.Decimal `json:"total_amount,omitempty"`
+	// Early-payment discounts taken (defaults to zero)
+	DiscountAmount decimal.Decimal `json:"discount_amount,omitempty"`
+	// Withholding tax deducted (defaults to zero)
+	WithholdingAmount decimal.Decimal `json:"withholding_amount,omitempty"`
+	// BillCount holds the value of the "bill_count" field.
+	BillCount int `json:"bill_count,omitempty"`
+	// Object storage key of the bank transfer or M-Pesa B2B file
+	FileKey string `json:"file_key,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes string `json:"notes,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy uuid.UUID `json:"created_by,omitempty"`
+	// ApprovedBy holds the value of the "approved_by" field.
+	ApprovedBy uuid.UUID `json:"approved_by,omitempty"`
+	// ApprovedAt holds the value of the "approved_at" field.
+	ApprovedAt time.Time `json:"approved_at,omitempty"`
+	// ExecutedBy holds the value of the "executed_by" field.
+	ExecutedBy uuid.UUID `json:"executed_by,omitempty"`
+	// ExecutedAt holds the value of the "executed_at" field.
+	ExecutedAt time.Time `json:"executed_at,omitempty"`
+	// CancelledAt holds the value of the "cancelled_at" field.
+	CancelledAt time.Time `json:"cancelled_at,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaymentRunQuery when eager-loading is set.
+	Edges        PaymentRunEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PaymentRunEdges holds the relations/edges for other nodes in the graph.
+type PaymentRunEdges struct {
+	// Items holds the value of the items edge.
+	Items []*PaymentRunItem `json:"items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ItemsOrErr returns the Items value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaymentRunEdges) ItemsOrErr() ([]*PaymentRunItem, error) {
+	if e.loadedTypes[0] {
+		return e.Items, nil
+	}
+	return nil, &NotLoadedError{edge: "items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentrun.FieldVendorIds, paymentrun.FieldMetadata:
+			values[i] = new([]byte)
+		case paymentrun.FieldTotalAmount, paymentrun.FieldDiscountAmount, paymentrun.FieldWithholdingAmount:
+			values[i] = new(decimal.Decimal)
+		case paymentrun.FieldBillCount:
+			values[i] = new(sql.NullInt64)
+		case paymentrun.FieldRunNumber, paymentrun.FieldCurrency, paymentrun.FieldMethod, paymentrun.FieldPaymentAccount, paymentrun.FieldStatus, paymentrun.FieldFileKey, paymentrun.FieldNotes:
+			values[i] = new(sql.NullString)
+		case paymentrun.FieldPaymentDate, paymentrun.FieldDueBefore, paymentrun.FieldApprovedAt, paymentrun.FieldExecutedAt, paymentrun.FieldCancelledAt, paymentrun.FieldCreatedAt, paymentrun.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case paymentrun.FieldID, paymentrun.FieldTenantID, paymentrun.FieldCreatedBy, paymentrun.FieldApprovedBy, paymentrun.FieldExecutedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentRun fields.
+func (_m *PaymentRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentrun.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case paymentrun.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case paymentrun.FieldRunNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field run_number", values[i])
+			} else if value.Valid {
+				_m.RunNumber = value.String
+			}
+		case paymentrun.FieldPaymentDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_date", values[i])
+			} else if value.Valid {
+				_m.PaymentDate = value.Time
+			}
+		case paymentrun.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case paymentrun.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				_m.Method = value.String
+			}
+		case paymentrun.FieldPaymentAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_account", values[i])
+			} else if value.Valid {
+				_m.PaymentAccount = value.String
+			}
+		case paymentrun.FieldDueBefore:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_before", values[i])
+			} else if value.Valid {
+				_m.DueBefore = value.Time
+			}
+		case paymentrun.FieldVendorIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field vendor_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.VendorIds); err != nil {
+					return fmt.Errorf("unmarshal field vendor_ids: %w", err)
+				}
+			}
+		case paymentrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case paymentrun.FieldTotalAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field total_amount", values[i])
+			} else if value != nil {
+				_m.TotalAmount = *value
+			}
+		case paymentrun.FieldDiscountAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_amount", values[i])
+			} else if value != nil {
+				_m.DiscountAmount = *value
+			}
+		case paymentrun.FieldWithholdingAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field withholding_amount", values[i])
+			} else if value != nil {
+				_m.WithholdingAmount = *value
+			}
+		case paymentrun.FieldBillCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bill_count", values[i])
+			} else if value.Valid {
+				_m.BillCount = int(value.Int64)
+			}
+		case paymentrun.FieldFileKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_key", values[i])
+			} else if value.Valid {
+				_m.FileKey = value.String
+			}
+		case paymentrun.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = value.String
+			}
+		case paymentrun.FieldCreatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value != nil {
+				_m.CreatedBy = *value
+			}
+		case paymentrun.FieldApprovedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_by", values[i])
+			} else if value != nil {
+				_m.ApprovedBy = *value
+			}
+		case paymentrun.FieldApprovedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_at", values[i])
+			} else if value.Valid {
+				_m.ApprovedAt = value.Time
+			}
+		case paymentrun.FieldExecutedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field executed_by", values[i])
+			} else if value != nil {
+				_m.ExecutedBy = *value
+			}
+		case paymentrun.FieldExecutedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field executed_at", values[i])
+			} else if value.Valid {
+				_m.ExecutedAt = value.Time
+			}
+		case paymentrun.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				_m.CancelledAt = value.Time
+			}
+		case paymentrun.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case paymentrun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case paymentrun.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *PaymentRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryItems queries the "items" edge of the PaymentRun entity.
+func (_m *PaymentRun) QueryItems() *PaymentRunItemQuery {
+	return NewPaymentRunClient(_m.config).QueryItems(_m)
+}
+
+// Update returns a builder for updating this PaymentRun.
+// Note that you need to call PaymentRun.Unwrap() before calling this method if this PaymentRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PaymentRun) Update() *PaymentRunUpdateOne {
+	return NewPaymentRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PaymentRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PaymentRun) Unwrap() *PaymentRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PaymentRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PaymentRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("run_number=")
+	builder.WriteString(_m.RunNumber)
+	builder.WriteString(", ")
+	builder.WriteString("payment_date=")
+	builder.WriteString(_m.PaymentDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("method=")
+	builder.WriteString(_m.Method)
+	builder.WriteString(", ")
+	builder.WriteString("payment_account=")
+	builder.WriteString(_m.PaymentAccount)
+	builder.WriteString(", ")
+	builder.WriteString("due_before=")
+	builder.WriteString(_m.DueBefore.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("vendor_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VendorIds))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("total_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalAmount))
+	builder.WriteString(", ")
+	builder.WriteString("discount_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DiscountAmount))
+	builder.WriteString(", ")
+	builder.WriteString("withholding_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WithholdingAmount))
+	builder.WriteString(", ")
+	builder.WriteString("bill_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BillCount))
+	builder.WriteString(", ")
+	builder.WriteString("file_key=")
+	builder.WriteString(_m.FileKey)
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(_m.Notes)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("approved_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ApprovedBy))
+	builder.WriteString(", ")
+	builder.WriteString("approved_at=")
+	builder.WriteString(_m.ApprovedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("executed_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExecutedBy))
+	builder.WriteString(", ")
+	builder.WriteString("executed_at=")
+	builder.WriteString(_m.ExecutedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("cancelled_at=")
+	builder.WriteString(_m.CancelledAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentRuns is a parsable slice of PaymentRun.
+type PaymentRuns []*PaymentRun
@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the paymentrun type in the database.
+	Label = "payment_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldRunNumber holds the string denoting the run_number field in the database.
+	FieldRunNumber = "run_number"
+	// FieldPaymentDate holds the string denoting the payment_date field in the database.
+	FieldPaymentDate = "payment_date"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldMethod holds the string denoting the method field in the database.
+	FieldMethod = "method"
+	// FieldPaymentAccount holds the string denoting the payment_account field in the database.
+	FieldPaymentAccount = "payment_account"
+	// FieldDueBefore holds the string denoting the due_before field in the database.
+	FieldDueBefore = "due_before"
+	// FieldVendorIds holds the string denoting the vendor_ids field in the database.
+	FieldVendorIds = "vendor_ids"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldTotalAmount holds the string denoting the total_amount field in the database.
+	FieldTotalAmount = "total_amount"
+	// FieldDiscountAmount holds the string denoting the discount_amount field in the database.
+	FieldDiscountAmount = "discount_amount"
+	// FieldWithholdingAmount holds the string denoting the withholding_amount field in the database.
+	FieldWithholdingAmount = "withholding_amount"
+	// FieldBillCount holds the string denoting the bill_count field in the database.
+	FieldBillCount = "bill_count"
+	// FieldFileKey holds the string denoting the file_key field in the database.
+	FieldFileKey = "file_key"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldApprovedBy holds the string denoting the approved_by field in the database.
+	FieldApprovedBy = "approved_by"
+	// FieldApprovedAt holds the string denoting the approved_at field in the database.
+	FieldApprovedAt = "approved_at"
+	// FieldExecutedBy holds the string denoting the executed_by field in the database.
+	FieldExecutedBy = "executed_by"
+	// FieldExecutedAt holds the string denoting the executed_at field in the database.
+	FieldExecutedAt = "executed_at"
+	// FieldCancelledAt holds the string denoting the cancelled_at field in the database.
+	FieldCancelledAt = "cancelled_at"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeItems holds the string denoting the items edge name in mutations.
+	EdgeItems = "items"
+	// Table holds the table name of the paymentrun in the database.
+	Table = "payment_runs"
+	// ItemsTable is the table that holds the items relation/edge.
+	ItemsTable = "payment_run_items"
+	// ItemsInverseTable is the table name for the PaymentRunItem entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentrunitem" package.
+	ItemsInverseTable = "payment_run_items"
+	// ItemsColumn is the table column denoting the items relation/edge.
+	ItemsColumn = "run_id"
+)
+
+// Columns holds all SQL columns for paymentrun fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldRunNumber,
+	FieldPaymentDate,
+	FieldCurrency,
+	FieldMethod,
+	FieldPaymentAccount,
+	FieldDueBefore,
+	FieldVendorIds,
+	FieldStatus,
+	FieldTotalAmount,
+	FieldDiscountAmount,
+	FieldWithholdingAmount,
+	FieldBillCount,
+	FieldFileKey,
+	FieldNotes,
+	FieldCreatedBy,
+	FieldApprovedBy,
+	FieldApprovedAt,
+	FieldExecutedBy,
+	FieldExecutedAt,
+	FieldCancelledAt,
+	FieldMetadata,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RunNumberValidator is a validator for the "run_number" field. It is called by the builders before save.
+	RunNumberValidator func(string) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultPaymentAccount holds the default value on creation for the "payment_account" field.
+	DefaultPaymentAccount string
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultBillCount holds the default value on creation for the "bill_count" field.
+	DefaultBillCount int
+	// DefaultMetadata holds the default value on creation for the "metadata" field.
+	DefaultMetadata map[string]interface{}
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the PaymentRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByRunNumber orders the results by the run_number field.
+func ByRunNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunNumber, opts...).ToFunc()
+}
+
+// ByPaymentDate orders the results by the payment_date field.
+func ByPaymentDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentDate, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByMethod orders the results by the method field.
+func ByMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMethod, opts...).ToFunc()
+}
+
+// ByPaymentAccount orders the results by the payment_account field.
+func ByPaymentAccount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentAccount, opts...).ToFunc()
+}
+
+// ByDueBefore orders the results by the due_before field.
+func ByDueBefore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueBefore, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByTotalAmount orders the results by the total_amount field.
+func ByTotalAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalAmount, opts...).ToFunc()
+}
+
+// ByDiscountAmount orders the results by the discount_amount field.
+func ByDiscountAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountAmount, opts...).ToFunc()
+}
+
+// ByWithholdingAmount orders the results by the withholding_amount field.
+func ByWithholdingAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWithholdingAmount, opts...).ToFunc()
+}
+
+// ByBillCount orders the results by the bill_count field.
+func ByBillCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillCount, opts...).ToFunc()
+}
+
+// ByFileKey orders the results by the file_key field.
+func ByFileKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileKey, opts...).ToFunc()
+}
+
+// ByNotes orders the results by the notes field.
+func ByNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotes, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByApprovedBy orders the results by the approved_by field.
+func ByApprovedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovedBy, opts...).ToFunc()
+}
+
+// ByApprovedAt orders the results by the approved_at field.
+func ByApprovedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovedAt, opts...).ToFunc()
+}
+
+// ByExecutedBy orders the results by the executed_by field.
+func ByExecutedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExecutedBy, opts...).ToFunc()
+}
+
+// ByExecutedAt orders the results by the executed_at field.
+func ByExecutedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExecutedAt, opts...).ToFunc()
+}
+
+// ByCancelledAt orders the results by the cancelled_at field.
+func ByCancelledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByItemsCount orders the results by items count.
+func ByItemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemsStep(), opts...)
+	}
+}
+
+// ByItems orders the results by items terms.
+func ByItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newItemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
+	)
+}
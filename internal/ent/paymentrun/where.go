@@ -0,0 +1,1391 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldTenantID, v))
+}
+
+// RunNumber applies equality check predicate on the "run_number" field. It's identical to RunNumberEQ.
+func RunNumber(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldRunNumber, v))
+}
+
+// PaymentDate applies equality check predicate on the "payment_date" field. It's identical to PaymentDateEQ.
+func PaymentDate(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldPaymentDate, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Method applies equality check predicate on the "method" field. It's identical to MethodEQ.
+func Method(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldMethod, v))
+}
+
+// PaymentAccount applies equality check predicate on the "payment_account" field. It's identical to PaymentAccountEQ.
+func PaymentAccount(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldPaymentAccount, v))
+}
+
+// DueBefore applies equality check predicate on the "due_before" field. It's identical to DueBeforeEQ.
+func DueBefore(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldDueBefore, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// TotalAmount applies equality check predicate on the "total_amount" field. It's identical to TotalAmountEQ.
+func TotalAmount(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldTotalAmount, v))
+}
+
+// DiscountAmount applies equality check predicate on the "discount_amount" field. It's identical to DiscountAmountEQ.
+func DiscountAmount(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
+// WithholdingAmount applies equality check predicate on the "withholding_amount" field. It's identical to WithholdingAmountEQ.
+func WithholdingAmount(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldWithholdingAmount, v))
+}
+
+// BillCount applies equality check predicate on the "bill_count" field. It's identical to BillCountEQ.
+func BillCount(v int) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldBillCount, v))
+}
+
+// FileKey applies equality check predicate on the "file_key" field. It's identical to FileKeyEQ.
+func FileKey(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldFileKey, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldNotes, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// ApprovedBy applies equality check predicate on the "approved_by" field. It's identical to ApprovedByEQ.
+func ApprovedBy(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldApprovedBy, v))
+}
+
+// ApprovedAt applies equality check predicate on the "approved_at" field. It's identical to ApprovedAtEQ.
+func ApprovedAt(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// ExecutedBy applies equality check predicate on the "executed_by" field. It's identical to ExecutedByEQ.
+func ExecutedBy(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldExecutedBy, v))
+}
+
+// ExecutedAt applies equality check predicate on the "executed_at" field. It's identical to ExecutedAtEQ.
+func ExecutedAt(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldExecutedAt, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldTenantID, v))
+}
+
+// RunNumberEQ applies the EQ predicate on the "run_number" field.
+func RunNumberEQ(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldRunNumber, v))
+}
+
+// RunNumberNEQ applies the NEQ predicate on the "run_number" field.
+func RunNumberNEQ(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldRunNumber, v))
+}
+
+// RunNumberIn applies the In predicate on the "run_number" field.
+func RunNumberIn(vs ...string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldRunNumber, vs...))
+}
+
+// RunNumberNotIn applies the NotIn predicate on the "run_number" field.
+func RunNumberNotIn(vs ...string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldRunNumber, vs...))
+}
+
+// RunNumberGT applies the GT predicate on the "run_number" field.
+func RunNumberGT(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldRunNumber, v))
+}
+
+// RunNumberGTE applies the GTE predicate on the "run_number" field.
+func RunNumberGTE(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldRunNumber, v))
+}
+
+// RunNumberLT applies the LT predicate on the "run_number" field.
+func RunNumberLT(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldRunNumber, v))
+}
+
+// RunNumberLTE applies the LTE predicate on the "run_number" field.
+func RunNumberLTE(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldRunNumber, v))
+}
+
+// RunNumberContains applies the Contains predicate on the "run_number" field.
+func RunNumberContains(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldContains(FieldRunNumber, v))
+}
+
+// RunNumberHasPrefix applies the HasPrefix predicate on the "run_number" field.
+func RunNumberHasPrefix(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldHasPrefix(FieldRunNumber, v))
+}
+
+// RunNumberHasSuffix applies the HasSuffix predicate on the "run_number" field.
+func RunNumberHasSuffix(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldHasSuffix(FieldRunNumber, v))
+}
+
+// RunNumberEqualFold applies the EqualFold predicate on the "run_number" field.
+func RunNumberEqualFold(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEqualFold(FieldRunNumber, v))
+}
+
+// RunNumberContainsFold applies the ContainsFold predicate on the "run_number" field.
+func RunNumberContainsFold(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldContainsFold(FieldRunNumber, v))
+}
+
+// PaymentDateEQ applies the EQ predicate on the "payment_date" field.
+func PaymentDateEQ(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldPaymentDate, v))
+}
+
+// PaymentDateNEQ applies the NEQ predicate on the "payment_date" field.
+func PaymentDateNEQ(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldPaymentDate, v))
+}
+
+// PaymentDateIn applies the In predicate on the "payment_date" field.
+func PaymentDateIn(vs ...time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldPaymentDate, vs...))
+}
+
+// PaymentDateNotIn applies the NotIn predicate on the "payment_date" field.
+func PaymentDateNotIn(vs ...time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldPaymentDate, vs...))
+}
+
+// PaymentDateGT applies the GT predicate on the "payment_date" field.
+func PaymentDateGT(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldPaymentDate, v))
+}
+
+// PaymentDateGTE applies the GTE predicate on the "payment_date" field.
+func PaymentDateGTE(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldPaymentDate, v))
+}
+
+// PaymentDateLT applies the LT predicate on the "payment_date" field.
+func PaymentDateLT(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldPaymentDate, v))
+}
+
+// PaymentDateLTE applies the LTE predicate on the "payment_date" field.
+func PaymentDateLTE(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldPaymentDate, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// MethodEQ applies the EQ predicate on the "method" field.
+func MethodEQ(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldMethod, v))
+}
+
+// MethodNEQ applies the NEQ predicate on the "method" field.
+func MethodNEQ(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldMethod, v))
+}
+
+// MethodIn applies the In predicate on the "method" field.
+func MethodIn(vs ...string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldMethod, vs...))
+}
+
+// MethodNotIn applies the NotIn predicate on the "method" field.
+func MethodNotIn(vs ...string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldMethod, vs...))
+}
+
+// MethodGT applies the GT predicate on the "method" field.
+func MethodGT(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldMethod, v))
+}
+
+// MethodGTE applies the GTE predicate on the "method" field.
+func MethodGTE(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldMethod, v))
+}
+
+// MethodLT applies the LT predicate on the "method" field.
+func MethodLT(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldMethod, v))
+}
+
+// MethodLTE applies the LTE predicate on the "method" field.
+func MethodLTE(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldMethod, v))
+}
+
+// MethodContains applies the Contains predicate on the "method" field.
+func MethodContains(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldContains(FieldMethod, v))
+}
+
+// MethodHasPrefix applies the HasPrefix predicate on the "method" field.
+func MethodHasPrefix(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldHasPrefix(FieldMethod, v))
+}
+
+// MethodHasSuffix applies the HasSuffix predicate on the "method" field.
+func MethodHasSuffix(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldHasSuffix(FieldMethod, v))
+}
+
+// MethodEqualFold applies the EqualFold predicate on the "method" field.
+func MethodEqualFold(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEqualFold(FieldMethod, v))
+}
+
+// MethodContainsFold applies the ContainsFold predicate on the "method" field.
+func MethodContainsFold(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldContainsFold(FieldMethod, v))
+}
+
+// PaymentAccountEQ applies the EQ predicate on the "payment_account" field.
+func PaymentAccountEQ(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldPaymentAccount, v))
+}
+
+// PaymentAccountNEQ applies the NEQ predicate on the "payment_account" field.
+func PaymentAccountNEQ(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldPaymentAccount, v))
+}
+
+// PaymentAccountIn applies the In predicate on the "payment_account" field.
+func PaymentAccountIn(vs ...string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldPaymentAccount, vs...))
+}
+
+// PaymentAccountNotIn applies the NotIn predicate on the "payment_account" field.
+func PaymentAccountNotIn(vs ...string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldPaymentAccount, vs...))
+}
+
+// PaymentAccountGT applies the GT predicate on the "payment_account" field.
+func PaymentAccountGT(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldPaymentAccount, v))
+}
+
+// PaymentAccountGTE applies the GTE predicate on the "payment_account" field.
+func PaymentAccountGTE(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldPaymentAccount, v))
+}
+
+// PaymentAccountLT applies the LT predicate on the "payment_account" field.
+func PaymentAccountLT(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldPaymentAccount, v))
+}
+
+// PaymentAccountLTE applies the LTE predicate on the "payment_account" field.
+func PaymentAccountLTE(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldPaymentAccount, v))
+}
+
+// PaymentAccountContains applies the Contains predicate on the "payment_account" field.
+func PaymentAccountContains(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldContains(FieldPaymentAccount, v))
+}
+
+// PaymentAccountHasPrefix applies the HasPrefix predicate on the "payment_account" field.
+func PaymentAccountHasPrefix(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldHasPrefix(FieldPaymentAccount, v))
+}
+
+// PaymentAccountHasSuffix applies the HasSuffix predicate on the "payment_account" field.
+func PaymentAccountHasSuffix(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldHasSuffix(FieldPaymentAccount, v))
+}
+
+// PaymentAccountEqualFold applies the EqualFold predicate on the "payment_account" field.
+func PaymentAccountEqualFold(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEqualFold(FieldPaymentAccount, v))
+}
+
+// PaymentAccountContainsFold applies the ContainsFold predicate on the "payment_account" field.
+func PaymentAccountContainsFold(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldContainsFold(FieldPaymentAccount, v))
+}
+
+// DueBeforeEQ applies the EQ predicate on the "due_before" field.
+func DueBeforeEQ(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldDueBefore, v))
+}
+
+// DueBeforeNEQ applies the NEQ predicate on the "due_before" field.
+func DueBeforeNEQ(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldDueBefore, v))
+}
+
+// DueBeforeIn applies the In predicate on the "due_before" field.
+func DueBeforeIn(vs ...time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldDueBefore, vs...))
+}
+
+// DueBeforeNotIn applies the NotIn predicate on the "due_before" field.
+func DueBeforeNotIn(vs ...time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldDueBefore, vs...))
+}
+
+// DueBeforeGT applies the GT predicate on the "due_before" field.
+func DueBeforeGT(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldDueBefore, v))
+}
+
+// DueBeforeGTE applies the GTE predicate on the "due_before" field.
+func DueBeforeGTE(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldDueBefore, v))
+}
+
+// DueBeforeLT applies the LT predicate on the "due_before" field.
+func DueBeforeLT(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldDueBefore, v))
+}
+
+// DueBeforeLTE applies the LTE predicate on the "due_before" field.
+func DueBeforeLTE(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldDueBefore, v))
+}
+
+// DueBeforeIsNil applies the IsNil predicate on the "due_before" field.
+func DueBeforeIsNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIsNull(FieldDueBefore))
+}
+
+// DueBeforeNotNil applies the NotNil predicate on the "due_before" field.
+func DueBeforeNotNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotNull(FieldDueBefore))
+}
+
+// VendorIdsIsNil applies the IsNil predicate on the "vendor_ids" field.
+func VendorIdsIsNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIsNull(FieldVendorIds))
+}
+
+// VendorIdsNotNil applies the NotNil predicate on the "vendor_ids" field.
+func VendorIdsNotNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotNull(FieldVendorIds))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// TotalAmountEQ applies the EQ predicate on the "total_amount" field.
+func TotalAmountEQ(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldTotalAmount, v))
+}
+
+// TotalAmountNEQ applies the NEQ predicate on the "total_amount" field.
+func TotalAmountNEQ(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldTotalAmount, v))
+}
+
+// TotalAmountIn applies the In predicate on the "total_amount" field.
+func TotalAmountIn(vs ...decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldTotalAmount, vs...))
+}
+
+// TotalAmountNotIn applies the NotIn predicate on the "total_amount" field.
+func TotalAmountNotIn(vs ...decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldTotalAmount, vs...))
+}
+
+// TotalAmountGT applies the GT predicate on the "total_amount" field.
+func TotalAmountGT(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldTotalAmount, v))
+}
+
+// TotalAmountGTE applies the GTE predicate on the "total_amount" field.
+func TotalAmountGTE(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldTotalAmount, v))
+}
+
+// TotalAmountLT applies the LT predicate on the "total_amount" field.
+func TotalAmountLT(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldTotalAmount, v))
+}
+
+// TotalAmountLTE applies the LTE predicate on the "total_amount" field.
+func TotalAmountLTE(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldTotalAmount, v))
+}
+
+// TotalAmountIsNil applies the IsNil predicate on the "total_amount" field.
+func TotalAmountIsNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIsNull(FieldTotalAmount))
+}
+
+// TotalAmountNotNil applies the NotNil predicate on the "total_amount" field.
+func TotalAmountNotNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotNull(FieldTotalAmount))
+}
+
+// DiscountAmountEQ applies the EQ predicate on the "discount_amount" field.
+func DiscountAmountEQ(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountNEQ applies the NEQ predicate on the "discount_amount" field.
+func DiscountAmountNEQ(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountIn applies the In predicate on the "discount_amount" field.
+func DiscountAmountIn(vs ...decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountNotIn applies the NotIn predicate on the "discount_amount" field.
+func DiscountAmountNotIn(vs ...decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountGT applies the GT predicate on the "discount_amount" field.
+func DiscountAmountGT(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountGTE applies the GTE predicate on the "discount_amount" field.
+func DiscountAmountGTE(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLT applies the LT predicate on the "discount_amount" field.
+func DiscountAmountLT(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLTE applies the LTE predicate on the "discount_amount" field.
+func DiscountAmountLTE(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldDiscountAmount, v))
+}
+
+// DiscountAmountIsNil applies the IsNil predicate on the "discount_amount" field.
+func DiscountAmountIsNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIsNull(FieldDiscountAmount))
+}
+
+// DiscountAmountNotNil applies the NotNil predicate on the "discount_amount" field.
+func DiscountAmountNotNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotNull(FieldDiscountAmount))
+}
+
+// WithholdingAmountEQ applies the EQ predicate on the "withholding_amount" field.
+func WithholdingAmountEQ(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountNEQ applies the NEQ predicate on the "withholding_amount" field.
+func WithholdingAmountNEQ(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountIn applies the In predicate on the "withholding_amount" field.
+func WithholdingAmountIn(vs ...decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldWithholdingAmount, vs...))
+}
+
+// WithholdingAmountNotIn applies the NotIn predicate on the "withholding_amount" field.
+func WithholdingAmountNotIn(vs ...decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldWithholdingAmount, vs...))
+}
+
+// WithholdingAmountGT applies the GT predicate on the "withholding_amount" field.
+func WithholdingAmountGT(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountGTE applies the GTE predicate on the "withholding_amount" field.
+func WithholdingAmountGTE(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountLT applies the LT predicate on the "withholding_amount" field.
+func WithholdingAmountLT(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountLTE applies the LTE predicate on the "withholding_amount" field.
+func WithholdingAmountLTE(v decimal.Decimal) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountIsNil applies the IsNil predicate on the "withholding_amount" field.
+func WithholdingAmountIsNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIsNull(FieldWithholdingAmount))
+}
+
+// WithholdingAmountNotNil applies the NotNil predicate on the "withholding_amount" field.
+func WithholdingAmountNotNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotNull(FieldWithholdingAmount))
+}
+
+// BillCountEQ applies the EQ predicate on the "bill_count" field.
+func BillCountEQ(v int) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldBillCount, v))
+}
+
+// BillCountNEQ applies the NEQ predicate on the "bill_count" field.
+func BillCountNEQ(v int) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldBillCount, v))
+}
+
+// BillCountIn applies the In predicate on the "bill_count" field.
+func BillCountIn(vs ...int) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldBillCount, vs...))
+}
+
+// BillCountNotIn applies the NotIn predicate on the "bill_count" field.
+func BillCountNotIn(vs ...int) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldBillCount, vs...))
+}
+
+// BillCountGT applies the GT predicate on the "bill_count" field.
+func BillCountGT(v int) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldBillCount, v))
+}
+
+// BillCountGTE applies the GTE predicate on the "bill_count" field.
+func BillCountGTE(v int) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldBillCount, v))
+}
+
+// BillCountLT applies the LT predicate on the "bill_count" field.
+func BillCountLT(v int) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldBillCount, v))
+}
+
+// BillCountLTE applies the LTE predicate on the "bill_count" field.
+func BillCountLTE(v int) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldBillCount, v))
+}
+
+// FileKeyEQ applies the EQ predicate on the "file_key" field.
+func FileKeyEQ(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldFileKey, v))
+}
+
+// FileKeyNEQ applies the NEQ predicate on the "file_key" field.
+func FileKeyNEQ(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldFileKey, v))
+}
+
+// FileKeyIn applies the In predicate on the "file_key" field.
+func FileKeyIn(vs ...string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldFileKey, vs...))
+}
+
+// FileKeyNotIn applies the NotIn predicate on the "file_key" field.
+func FileKeyNotIn(vs ...string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldFileKey, vs...))
+}
+
+// FileKeyGT applies the GT predicate on the "file_key" field.
+func FileKeyGT(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldFileKey, v))
+}
+
+// FileKeyGTE applies the GTE predicate on the "file_key" field.
+func FileKeyGTE(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldFileKey, v))
+}
+
+// FileKeyLT applies the LT predicate on the "file_key" field.
+func FileKeyLT(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldFileKey, v))
+}
+
+// FileKeyLTE applies the LTE predicate on the "file_key" field.
+func FileKeyLTE(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldFileKey, v))
+}
+
+// FileKeyContains applies the Contains predicate on the "file_key" field.
+func FileKeyContains(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldContains(FieldFileKey, v))
+}
+
+// FileKeyHasPrefix applies the HasPrefix predicate on the "file_key" field.
+func FileKeyHasPrefix(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldHasPrefix(FieldFileKey, v))
+}
+
+// FileKeyHasSuffix applies the HasSuffix predicate on the "file_key" field.
+func FileKeyHasSuffix(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldHasSuffix(FieldFileKey, v))
+}
+
+// FileKeyIsNil applies the IsNil predicate on the "file_key" field.
+func FileKeyIsNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIsNull(FieldFileKey))
+}
+
+// FileKeyNotNil applies the NotNil predicate on the "file_key" field.
+func FileKeyNotNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotNull(FieldFileKey))
+}
+
+// FileKeyEqualFold applies the EqualFold predicate on the "file_key" field.
+func FileKeyEqualFold(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEqualFold(FieldFileKey, v))
+}
+
+// FileKeyContainsFold applies the ContainsFold predicate on the "file_key" field.
+func FileKeyContainsFold(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldContainsFold(FieldFileKey, v))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIsNull(FieldNotes))
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotNull(FieldNotes))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// ApprovedByEQ applies the EQ predicate on the "approved_by" field.
+func ApprovedByEQ(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldApprovedBy, v))
+}
+
+// ApprovedByNEQ applies the NEQ predicate on the "approved_by" field.
+func ApprovedByNEQ(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldApprovedBy, v))
+}
+
+// ApprovedByIn applies the In predicate on the "approved_by" field.
+func ApprovedByIn(vs ...uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldApprovedBy, vs...))
+}
+
+// ApprovedByNotIn applies the NotIn predicate on the "approved_by" field.
+func ApprovedByNotIn(vs ...uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldApprovedBy, vs...))
+}
+
+// ApprovedByGT applies the GT predicate on the "approved_by" field.
+func ApprovedByGT(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldApprovedBy, v))
+}
+
+// ApprovedByGTE applies the GTE predicate on the "approved_by" field.
+func ApprovedByGTE(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldApprovedBy, v))
+}
+
+// ApprovedByLT applies the LT predicate on the "approved_by" field.
+func ApprovedByLT(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldApprovedBy, v))
+}
+
+// ApprovedByLTE applies the LTE predicate on the "approved_by" field.
+func ApprovedByLTE(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldApprovedBy, v))
+}
+
+// ApprovedByIsNil applies the IsNil predicate on the "approved_by" field.
+func ApprovedByIsNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIsNull(FieldApprovedBy))
+}
+
+// ApprovedByNotNil applies the NotNil predicate on the "approved_by" field.
+func ApprovedByNotNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotNull(FieldApprovedBy))
+}
+
+// ApprovedAtEQ applies the EQ predicate on the "approved_at" field.
+func ApprovedAtEQ(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtNEQ applies the NEQ predicate on the "approved_at" field.
+func ApprovedAtNEQ(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtIn applies the In predicate on the "approved_at" field.
+func ApprovedAtIn(vs ...time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtNotIn applies the NotIn predicate on the "approved_at" field.
+func ApprovedAtNotIn(vs ...time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtGT applies the GT predicate on the "approved_at" field.
+func ApprovedAtGT(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldApprovedAt, v))
+}
+
+// ApprovedAtGTE applies the GTE predicate on the "approved_at" field.
+func ApprovedAtGTE(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtLT applies the LT predicate on the "approved_at" field.
+func ApprovedAtLT(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldApprovedAt, v))
+}
+
+// ApprovedAtLTE applies the LTE predicate on the "approved_at" field.
+func ApprovedAtLTE(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtIsNil applies the IsNil predicate on the "approved_at" field.
+func ApprovedAtIsNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIsNull(FieldApprovedAt))
+}
+
+// ApprovedAtNotNil applies the NotNil predicate on the "approved_at" field.
+func ApprovedAtNotNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotNull(FieldApprovedAt))
+}
+
+// ExecutedByEQ applies the EQ predicate on the "executed_by" field.
+func ExecutedByEQ(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldExecutedBy, v))
+}
+
+// ExecutedByNEQ applies the NEQ predicate on the "executed_by" field.
+func ExecutedByNEQ(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldExecutedBy, v))
+}
+
+// ExecutedByIn applies the In predicate on the "executed_by" field.
+func ExecutedByIn(vs ...uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldExecutedBy, vs...))
+}
+
+// ExecutedByNotIn applies the NotIn predicate on the "executed_by" field.
+func ExecutedByNotIn(vs ...uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldExecutedBy, vs...))
+}
+
+// ExecutedByGT applies the GT predicate on the "executed_by" field.
+func ExecutedByGT(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldExecutedBy, v))
+}
+
+// ExecutedByGTE applies the GTE predicate on the "executed_by" field.
+func ExecutedByGTE(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldExecutedBy, v))
+}
+
+// ExecutedByLT applies the LT predicate on the "executed_by" field.
+func ExecutedByLT(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldExecutedBy, v))
+}
+
+// ExecutedByLTE applies the LTE predicate on the "executed_by" field.
+func ExecutedByLTE(v uuid.UUID) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldExecutedBy, v))
+}
+
+// ExecutedByIsNil applies the IsNil predicate on the "executed_by" field.
+func ExecutedByIsNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIsNull(FieldExecutedBy))
+}
+
+// ExecutedByNotNil applies the NotNil predicate on the "executed_by" field.
+func ExecutedByNotNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotNull(FieldExecutedBy))
+}
+
+// ExecutedAtEQ applies the EQ predicate on the "executed_at" field.
+func ExecutedAtEQ(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldExecutedAt, v))
+}
+
+// ExecutedAtNEQ applies the NEQ predicate on the "executed_at" field.
+func ExecutedAtNEQ(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldExecutedAt, v))
+}
+
+// ExecutedAtIn applies the In predicate on the "executed_at" field.
+func ExecutedAtIn(vs ...time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldExecutedAt, vs...))
+}
+
+// ExecutedAtNotIn applies the NotIn predicate on the "executed_at" field.
+func ExecutedAtNotIn(vs ...time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldExecutedAt, vs...))
+}
+
+// ExecutedAtGT applies the GT predicate on the "executed_at" field.
+func ExecutedAtGT(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldExecutedAt, v))
+}
+
+// ExecutedAtGTE applies the GTE predicate on the "executed_at" field.
+func ExecutedAtGTE(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldExecutedAt, v))
+}
+
+// ExecutedAtLT applies the LT predicate on the "executed_at" field.
+func ExecutedAtLT(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldExecutedAt, v))
+}
+
+// ExecutedAtLTE applies the LTE predicate on the "executed_at" field.
+func ExecutedAtLTE(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldExecutedAt, v))
+}
+
+// ExecutedAtIsNil applies the IsNil predicate on the "executed_at" field.
+func ExecutedAtIsNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIsNull(FieldExecutedAt))
+}
+
+// ExecutedAtNotNil applies the NotNil predicate on the "executed_at" field.
+func ExecutedAtNotNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotNull(FieldExecutedAt))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasItems applies the HasEdge predicate on the "items" edge.
+func HasItems() predicate.PaymentRun {
+	return predicate.PaymentRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemsWith applies the HasEdge predicate on the "items" edge with a given conditions (other predicates).
+func HasItemsWith(preds ...predicate.PaymentRunItem) predicate.PaymentRun {
+	return predicate.PaymentRun(func(s *sql.Selector) {
+		step := newItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaymentRun) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaymentRun) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaymentRun) predicate.PaymentRun {
+	return predicate.PaymentRun(sql.NotPredicates(p))
+}
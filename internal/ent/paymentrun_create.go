@@ -0,0 +1,2171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrun"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrunitem"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRunCreate is the builder for creating a PaymentRun entity.
+type PaymentRunCreate struct {
+	config
+	mutation *PaymentRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *PaymentRunCreate) SetTenantID(v uuid.UUID) *PaymentRunCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetRunNumber sets the "run_number" field.
+func (_c *PaymentRunCreate) SetRunNumber(v string) *PaymentRunCreate {
+	_c.mutation.SetRunNumber(v)
+	return _c
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (_c *PaymentRunCreate) SetPaymentDate(v time.Time) *PaymentRunCreate {
+	_c.mutation.SetPaymentDate(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *PaymentRunCreate) SetCurrency(v string) *PaymentRunCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableCurrency(v *string) *PaymentRunCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetMethod sets the "method" field.
+func (_c *PaymentRunCreate) SetMethod(v string) *PaymentRunCreate {
+	_c.mutation.SetMethod(v)
+	return _c
+}
+
+// SetPaymentAccount sets the "payment_account" field.
+func (_c *PaymentRunCreate) SetPaymentAccount(v string) *PaymentRunCreate {
+	_c.mutation.SetPaymentAccount(v)
+	return _c
+}
+
+// SetNillablePaymentAccount sets the "payment_account" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillablePaymentAccount(v *string) *PaymentRunCreate {
+	if v != nil {
+		_c.SetPaymentAccount(*v)
+	}
+	return _c
+}
+
+// SetDueBefore sets the "due_before" field.
+func (_c *PaymentRunCreate) SetDueBefore(v time.Time) *PaymentRunCreate {
+	_c.mutation.SetDueBefore(v)
+	return _c
+}
+
+// SetNillableDueBefore sets the "due_before" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableDueBefore(v *time.Time) *PaymentRunCreate {
+	if v != nil {
+		_c.SetDueBefore(*v)
+	}
+	return _c
+}
+
+// SetVendorIds sets the "vendor_ids" field.
+func (_c *PaymentRunCreate) SetVendorIds(v []uuid.UUID) *PaymentRunCreate {
+	_c.mutation.SetVendorIds(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *PaymentRunCreate) SetStatus(v string) *PaymentRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableStatus(v *string) *PaymentRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (_c *PaymentRunCreate) SetTotalAmount(v decimal.Decimal) *PaymentRunCreate {
+	_c.mutation.SetTotalAmount(v)
+	return _c
+}
+
+// SetNillableTotalAmount sets the "total_amount" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableTotalAmount(v *decimal.Decimal) *PaymentRunCreate {
+	if v != nil {
+		_c.SetTotalAmount(*v)
+	}
+	return _c
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_c *PaymentRunCreate) SetDiscountAmount(v decimal.Decimal) *PaymentRunCreate {
+	_c.mutation.SetDiscountAmount(v)
+	return _c
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableDiscountAmount(v *decimal.Decimal) *PaymentRunCreate {
+	if v != nil {
+		_c.SetDiscountAmount(*v)
+	}
+	return _c
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (_c *PaymentRunCreate) SetWithholdingAmount(v decimal.Decimal) *PaymentRunCreate {
+	_c.mutation.SetWithholdingAmount(v)
+	return _c
+}
+
+// SetNillableWithholdingAmount sets the "withholding_amount" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableWithholdingAmount(v *decimal.Decimal) *PaymentRunCreate {
+	if v != nil {
+		_c.SetWithholdingAmount(*v)
+	}
+	return _c
+}
+
+// SetBillCount sets the "bill_count" field.
+func (_c *PaymentRunCreate) SetBillCount(v int) *PaymentRunCreate {
+	_c.mutation.SetBillCount(v)
+	return _c
+}
+
+// SetNillableBillCount sets the "bill_count" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableBillCount(v *int) *PaymentRunCreate {
+	if v != nil {
+		_c.SetBillCount(*v)
+	}
+	return _c
+}
+
+// SetFileKey sets the "file_key" field.
+func (_c *PaymentRunCreate) SetFileKey(v string) *PaymentRunCreate {
+	_c.mutation.SetFileKey(v)
+	return _c
+}
+
+// SetNillableFileKey sets the "file_key" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableFileKey(v *string) *PaymentRunCreate {
+	if v != nil {
+		_c.SetFileKey(*v)
+	}
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *PaymentRunCreate) SetNotes(v string) *PaymentRunCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableNotes(v *string) *PaymentRunCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *PaymentRunCreate) SetCreatedBy(v uuid.UUID) *PaymentRunCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableCreatedBy(v *uuid.UUID) *PaymentRunCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_c *PaymentRunCreate) SetApprovedBy(v uuid.UUID) *PaymentRunCreate {
+	_c.mutation.SetApprovedBy(v)
+	return _c
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableApprovedBy(v *uuid.UUID) *PaymentRunCreate {
+	if v != nil {
+		_c.SetApprovedBy(*v)
+	}
+	return _c
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_c *PaymentRunCreate) SetApprovedAt(v time.Time) *PaymentRunCreate {
+	_c.mutation.SetApprovedAt(v)
+	return _c
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableApprovedAt(v *time.Time) *PaymentRunCreate {
+	if v != nil {
+		_c.SetApprovedAt(*v)
+	}
+	return _c
+}
+
+// SetExecutedBy sets the "executed_by" field.
+func (_c *PaymentRunCreate) SetExecutedBy(v uuid.UUID) *PaymentRunCreate {
+	_c.mutation.SetExecutedBy(v)
+	return _c
+}
+
+// SetNillableExecutedBy sets the "executed_by" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableExecutedBy(v *uuid.UUID) *PaymentRunCreate {
+	if v != nil {
+		_c.SetExecutedBy(*v)
+	}
+	return _c
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (_c *PaymentRunCreate) SetExecutedAt(v time.Time) *PaymentRunCreate {
+	_c.mutation.SetExecutedAt(v)
+	return _c
+}
+
+// SetNillableExecutedAt sets the "executed_at" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableExecutedAt(v *time.Time) *PaymentRunCreate {
+	if v != nil {
+		_c.SetExecutedAt(*v)
+	}
+	return _c
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_c *PaymentRunCreate) SetCancelledAt(v time.Time) *PaymentRunCreate {
+	_c.mutation.SetCancelledAt(v)
+	return _c
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableCancelledAt(v *time.Time) *PaymentRunCreate {
+	if v != nil {
+		_c.SetCancelledAt(*v)
+	}
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *PaymentRunCreate) SetMetadata(v map[string]interface{}) *PaymentRunCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PaymentRunCreate) SetCreatedAt(v time.Time) *PaymentRunCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableCreatedAt(v *time.Time) *PaymentRunCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PaymentRunCreate) SetUpdatedAt(v time.Time) *PaymentRunCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableUpdatedAt(v *time.Time) *PaymentRunCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PaymentRunCreate) SetID(v uuid.UUID) *PaymentRunCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PaymentRunCreate) SetNillableID(v *uuid.UUID) *PaymentRunCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddItemIDs adds the "items" edge to the PaymentRunItem entity by IDs.
+func (_c *PaymentRunCreate) AddItemIDs(ids ...uuid.UUID) *PaymentRunCreate {
+	_c.mutation.AddItemIDs(ids...)
+	return _c
+}
+
+// AddItems adds the "items" edges to the PaymentRunItem entity.
+func (_c *PaymentRunCreate) AddItems(v ...*PaymentRunItem) *PaymentRunCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddItemIDs(ids...)
+}
+
+// Mutation returns the PaymentRunMutation object of the builder.
+func (_c *PaymentRunCreate) Mutation() *PaymentRunMutation {
+	return _c.mutation
+}
+
+// Save creates the PaymentRun in the database.
+func (_c *PaymentRunCreate) Save(ctx context.Context) (*PaymentRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PaymentRunCreate) SaveX(ctx context.Context) *PaymentRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PaymentRunCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := paymentrun.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.PaymentAccount(); !ok {
+		v := paymentrun.DefaultPaymentAccount
+		_c.mutation.SetPaymentAccount(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := paymentrun.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.BillCount(); !ok {
+		v := paymentrun.DefaultBillCount
+		_c.mutation.SetBillCount(v)
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		v := paymentrun.DefaultMetadata
+		_c.mutation.SetMetadata(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := paymentrun.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := paymentrun.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := paymentrun.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PaymentRunCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "PaymentRun.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.RunNumber(); !ok {
+		return &ValidationError{Name: "run_number", err: errors.New(`ent: missing required field "PaymentRun.run_number"`)}
+	}
+	if v, ok := _c.mutation.RunNumber(); ok {
+		if err := paymentrun.RunNumberValidator(v); err != nil {
+			return &ValidationError{Name: "run_number", err: fmt.Errorf(`ent: validator failed for field "PaymentRun.run_number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PaymentDate(); !ok {
+		return &ValidationError{Name: "payment_date", err: errors.New(`ent: missing required field "PaymentRun.payment_date"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "PaymentRun.currency"`)}
+	}
+	if _, ok := _c.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`ent: missing required field "PaymentRun.method"`)}
+	}
+	if _, ok := _c.mutation.PaymentAccount(); !ok {
+		return &ValidationError{Name: "payment_account", err: errors.New(`ent: missing required field "PaymentRun.payment_account"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PaymentRun.status"`)}
+	}
+	if _, ok := _c.mutation.BillCount(); !ok {
+		return &ValidationError{Name: "bill_count", err: errors.New(`ent: missing required field "PaymentRun.bill_count"`)}
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		return &ValidationError{Name: "metadata", err: errors.New(`ent: missing required field "PaymentRun.metadata"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PaymentRun.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PaymentRun.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *PaymentRunCreate) sqlSave(ctx context.Context) (*PaymentRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PaymentRunCreate) createSpec() (*PaymentRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(paymentrun.Table, sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(paymentrun.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.RunNumber(); ok {
+		_spec.SetField(paymentrun.FieldRunNumber, field.TypeString, value)
+		_node.RunNumber = value
+	}
+	if value, ok := _c.mutation.PaymentDate(); ok {
+		_spec.SetField(paymentrun.FieldPaymentDate, field.TypeTime, value)
+		_node.PaymentDate = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(paymentrun.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Method(); ok {
+		_spec.SetField(paymentrun.FieldMethod, field.TypeString, value)
+		_node.Method = value
+	}
+	if value, ok := _c.mutation.PaymentAccount(); ok {
+		_spec.SetField(paymentrun.FieldPaymentAccount, field.TypeString, value)
+		_node.PaymentAccount = value
+	}
+	if value, ok := _c.mutation.DueBefore(); ok {
+		_spec.SetField(paymentrun.FieldDueBefore, field.TypeTime, value)
+		_node.DueBefore = value
+	}
+	if value, ok := _c.mutation.VendorIds(); ok {
+		_spec.SetField(paymentrun.FieldVendorIds, field.TypeJSON, value)
+		_node.VendorIds = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(paymentrun.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.TotalAmount(); ok {
+		_spec.SetField(paymentrun.FieldTotalAmount, field.TypeFloat64, value)
+		_node.TotalAmount = value
+	}
+	if value, ok := _c.mutation.DiscountAmount(); ok {
+		_spec.SetField(paymentrun.FieldDiscountAmount, field.TypeFloat64, value)
+		_node.DiscountAmount = value
+	}
+	if value, ok := _c.mutation.WithholdingAmount(); ok {
+		_spec.SetField(paymentrun.FieldWithholdingAmount, field.TypeFloat64, value)
+		_node.WithholdingAmount = value
+	}
+	if value, ok := _c.mutation.BillCount(); ok {
+		_spec.SetField(paymentrun.FieldBillCount, field.TypeInt, value)
+		_node.BillCount = value
+	}
+	if value, ok := _c.mutation.FileKey(); ok {
+		_spec.SetField(paymentrun.FieldFileKey, field.TypeString, value)
+		_node.FileKey = value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(paymentrun.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(paymentrun.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.ApprovedBy(); ok {
+		_spec.SetField(paymentrun.FieldApprovedBy, field.TypeUUID, value)
+		_node.ApprovedBy = value
+	}
+	if value, ok := _c.mutation.ApprovedAt(); ok {
+		_spec.SetField(paymentrun.FieldApprovedAt, field.TypeTime, value)
+		_node.ApprovedAt = value
+	}
+	if value, ok := _c.mutation.ExecutedBy(); ok {
+		_spec.SetField(paymentrun.FieldExecutedBy, field.TypeUUID, value)
+		_node.ExecutedBy = value
+	}
+	if value, ok := _c.mutation.ExecutedAt(); ok {
+		_spec.SetField(paymentrun.FieldExecutedAt, field.TypeTime, value)
+		_node.ExecutedAt = value
+	}
+	if value, ok := _c.mutation.CancelledAt(); ok {
+		_spec.SetField(paymentrun.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(paymentrun.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(paymentrun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentrun.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentrun.ItemsTable,
+			Columns: []string{paymentrun.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentRun.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentRunUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PaymentRunCreate) OnConflict(opts ...sql.ConflictOption) *PaymentRunUpsertOne {
+	_c.conflict = opts
+	return &PaymentRunUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PaymentRunCreate) OnConflictColumns(columns ...string) *PaymentRunUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PaymentRunUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PaymentRunUpsertOne is the builder for "upsert"-ing
+	//  one PaymentRun node.
+	PaymentRunUpsertOne struct {
+		create *PaymentRunCreate
+	}
+
+	// PaymentRunUpsert is the "OnConflict" setter.
+	PaymentRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *PaymentRunUpsert) SetTenantID(v uuid.UUID) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateTenantID() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldTenantID)
+	return u
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (u *PaymentRunUpsert) SetPaymentDate(v time.Time) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldPaymentDate, v)
+	return u
+}
+
+// UpdatePaymentDate sets the "payment_date" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdatePaymentDate() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldPaymentDate)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PaymentRunUpsert) SetCurrency(v string) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateCurrency() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldCurrency)
+	return u
+}
+
+// SetMethod sets the "method" field.
+func (u *PaymentRunUpsert) SetMethod(v string) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldMethod, v)
+	return u
+}
+
+// UpdateMethod sets the "method" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateMethod() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldMethod)
+	return u
+}
+
+// SetPaymentAccount sets the "payment_account" field.
+func (u *PaymentRunUpsert) SetPaymentAccount(v string) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldPaymentAccount, v)
+	return u
+}
+
+// UpdatePaymentAccount sets the "payment_account" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdatePaymentAccount() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldPaymentAccount)
+	return u
+}
+
+// SetDueBefore sets the "due_before" field.
+func (u *PaymentRunUpsert) SetDueBefore(v time.Time) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldDueBefore, v)
+	return u
+}
+
+// UpdateDueBefore sets the "due_before" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateDueBefore() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldDueBefore)
+	return u
+}
+
+// ClearDueBefore clears the value of the "due_before" field.
+func (u *PaymentRunUpsert) ClearDueBefore() *PaymentRunUpsert {
+	u.SetNull(paymentrun.FieldDueBefore)
+	return u
+}
+
+// SetVendorIds sets the "vendor_ids" field.
+func (u *PaymentRunUpsert) SetVendorIds(v []uuid.UUID) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldVendorIds, v)
+	return u
+}
+
+// UpdateVendorIds sets the "vendor_ids" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateVendorIds() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldVendorIds)
+	return u
+}
+
+// ClearVendorIds clears the value of the "vendor_ids" field.
+func (u *PaymentRunUpsert) ClearVendorIds() *PaymentRunUpsert {
+	u.SetNull(paymentrun.FieldVendorIds)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PaymentRunUpsert) SetStatus(v string) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateStatus() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldStatus)
+	return u
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (u *PaymentRunUpsert) SetTotalAmount(v decimal.Decimal) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldTotalAmount, v)
+	return u
+}
+
+// UpdateTotalAmount sets the "total_amount" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateTotalAmount() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldTotalAmount)
+	return u
+}
+
+// AddTotalAmount adds v to the "total_amount" field.
+func (u *PaymentRunUpsert) AddTotalAmount(v decimal.Decimal) *PaymentRunUpsert {
+	u.Add(paymentrun.FieldTotalAmount, v)
+	return u
+}
+
+// ClearTotalAmount clears the value of the "total_amount" field.
+func (u *PaymentRunUpsert) ClearTotalAmount() *PaymentRunUpsert {
+	u.SetNull(paymentrun.FieldTotalAmount)
+	return u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *PaymentRunUpsert) SetDiscountAmount(v decimal.Decimal) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldDiscountAmount, v)
+	return u
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateDiscountAmount() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldDiscountAmount)
+	return u
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *PaymentRunUpsert) AddDiscountAmount(v decimal.Decimal) *PaymentRunUpsert {
+	u.Add(paymentrun.FieldDiscountAmount, v)
+	return u
+}
+
+// ClearDiscountAmount clears the value of the "discount_amount" field.
+func (u *PaymentRunUpsert) ClearDiscountAmount() *PaymentRunUpsert {
+	u.SetNull(paymentrun.FieldDiscountAmount)
+	return u
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (u *PaymentRunUpsert) SetWithholdingAmount(v decimal.Decimal) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldWithholdingAmount, v)
+	return u
+}
+
+// UpdateWithholdingAmount sets the "withholding_amount" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateWithholdingAmount() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldWithholdingAmount)
+	return u
+}
+
+// AddWithholdingAmount adds v to the "withholding_amount" field.
+func (u *PaymentRunUpsert) AddWithholdingAmount(v decimal.Decimal) *PaymentRunUpsert {
+	u.Add(paymentrun.FieldWithholdingAmount, v)
+	return u
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (u *PaymentRunUpsert) ClearWithholdingAmount() *PaymentRunUpsert {
+	u.SetNull(paymentrun.FieldWithholdingAmount)
+	return u
+}
+
+// SetBillCount sets the "bill_count" field.
+func (u *PaymentRunUpsert) SetBillCount(v int) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldBillCount, v)
+	return u
+}
+
+// UpdateBillCount sets the "bill_count" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateBillCount() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldBillCount)
+	return u
+}
+
+// AddBillCount adds v to the "bill_count" field.
+func (u *PaymentRunUpsert) AddBillCount(v int) *PaymentRunUpsert {
+	u.Add(paymentrun.FieldBillCount, v)
+	return u
+}
+
+// SetFileKey sets the "file_key" field.
+func (u *PaymentRunUpsert) SetFileKey(v string) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldFileKey, v)
+	return u
+}
+
+// UpdateFileKey sets the "file_key" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateFileKey() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldFileKey)
+	return u
+}
+
+// ClearFileKey clears the value of the "file_key" field.
+func (u *PaymentRunUpsert) ClearFileKey() *PaymentRunUpsert {
+	u.SetNull(paymentrun.FieldFileKey)
+	return u
+}
+
+// SetNotes sets the "notes" field.
+func (u *PaymentRunUpsert) SetNotes(v string) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldNotes, v)
+	return u
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateNotes() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldNotes)
+	return u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *PaymentRunUpsert) ClearNotes() *PaymentRunUpsert {
+	u.SetNull(paymentrun.FieldNotes)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *PaymentRunUpsert) SetCreatedBy(v uuid.UUID) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateCreatedBy() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldCreatedBy)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *PaymentRunUpsert) ClearCreatedBy() *PaymentRunUpsert {
+	u.SetNull(paymentrun.FieldCreatedBy)
+	return u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *PaymentRunUpsert) SetApprovedBy(v uuid.UUID) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldApprovedBy, v)
+	return u
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateApprovedBy() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldApprovedBy)
+	return u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *PaymentRunUpsert) ClearApprovedBy() *PaymentRunUpsert {
+	u.SetNull(paymentrun.FieldApprovedBy)
+	return u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *PaymentRunUpsert) SetApprovedAt(v time.Time) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldApprovedAt, v)
+	return u
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateApprovedAt() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldApprovedAt)
+	return u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *PaymentRunUpsert) ClearApprovedAt() *PaymentRunUpsert {
+	u.SetNull(paymentrun.FieldApprovedAt)
+	return u
+}
+
+// SetExecutedBy sets the "executed_by" field.
+func (u *PaymentRunUpsert) SetExecutedBy(v uuid.UUID) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldExecutedBy, v)
+	return u
+}
+
+// UpdateExecutedBy sets the "executed_by" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateExecutedBy() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldExecutedBy)
+	return u
+}
+
+// ClearExecutedBy clears the value of the "executed_by" field.
+func (u *PaymentRunUpsert) ClearExecutedBy() *PaymentRunUpsert {
+	u.SetNull(paymentrun.FieldExecutedBy)
+	return u
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (u *PaymentRunUpsert) SetExecutedAt(v time.Time) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldExecutedAt, v)
+	return u
+}
+
+// UpdateExecutedAt sets the "executed_at" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateExecutedAt() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldExecutedAt)
+	return u
+}
+
+// ClearExecutedAt clears the value of the "executed_at" field.
+func (u *PaymentRunUpsert) ClearExecutedAt() *PaymentRunUpsert {
+	u.SetNull(paymentrun.FieldExecutedAt)
+	return u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *PaymentRunUpsert) SetCancelledAt(v time.Time) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldCancelledAt, v)
+	return u
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateCancelledAt() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldCancelledAt)
+	return u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *PaymentRunUpsert) ClearCancelledAt() *PaymentRunUpsert {
+	u.SetNull(paymentrun.FieldCancelledAt)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PaymentRunUpsert) SetMetadata(v map[string]interface{}) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateMetadata() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldMetadata)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentRunUpsert) SetUpdatedAt(v time.Time) *PaymentRunUpsert {
+	u.Set(paymentrun.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentRunUpsert) UpdateUpdatedAt() *PaymentRunUpsert {
+	u.SetExcluded(paymentrun.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PaymentRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(paymentrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PaymentRunUpsertOne) UpdateNewValues() *PaymentRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(paymentrun.FieldID)
+		}
+		if _, exists := u.create.mutation.RunNumber(); exists {
+			s.SetIgnore(paymentrun.FieldRunNumber)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(paymentrun.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PaymentRunUpsertOne) Ignore() *PaymentRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentRunUpsertOne) DoNothing() *PaymentRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentRunCreate.OnConflict
+// documentation for more info.
+func (u *PaymentRunUpsertOne) Update(set func(*PaymentRunUpsert)) *PaymentRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *PaymentRunUpsertOne) SetTenantID(v uuid.UUID) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateTenantID() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (u *PaymentRunUpsertOne) SetPaymentDate(v time.Time) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetPaymentDate(v)
+	})
+}
+
+// UpdatePaymentDate sets the "payment_date" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdatePaymentDate() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdatePaymentDate()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PaymentRunUpsertOne) SetCurrency(v string) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateCurrency() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetMethod sets the "method" field.
+func (u *PaymentRunUpsertOne) SetMethod(v string) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetMethod(v)
+	})
+}
+
+// UpdateMethod sets the "method" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateMethod() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateMethod()
+	})
+}
+
+// SetPaymentAccount sets the "payment_account" field.
+func (u *PaymentRunUpsertOne) SetPaymentAccount(v string) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetPaymentAccount(v)
+	})
+}
+
+// UpdatePaymentAccount sets the "payment_account" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdatePaymentAccount() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdatePaymentAccount()
+	})
+}
+
+// SetDueBefore sets the "due_before" field.
+func (u *PaymentRunUpsertOne) SetDueBefore(v time.Time) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetDueBefore(v)
+	})
+}
+
+// UpdateDueBefore sets the "due_before" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateDueBefore() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateDueBefore()
+	})
+}
+
+// ClearDueBefore clears the value of the "due_before" field.
+func (u *PaymentRunUpsertOne) ClearDueBefore() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearDueBefore()
+	})
+}
+
+// SetVendorIds sets the "vendor_ids" field.
+func (u *PaymentRunUpsertOne) SetVendorIds(v []uuid.UUID) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetVendorIds(v)
+	})
+}
+
+// UpdateVendorIds sets the "vendor_ids" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateVendorIds() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateVendorIds()
+	})
+}
+
+// ClearVendorIds clears the value of the "vendor_ids" field.
+func (u *PaymentRunUpsertOne) ClearVendorIds() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearVendorIds()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PaymentRunUpsertOne) SetStatus(v string) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateStatus() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (u *PaymentRunUpsertOne) SetTotalAmount(v decimal.Decimal) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetTotalAmount(v)
+	})
+}
+
+// AddTotalAmount adds v to the "total_amount" field.
+func (u *PaymentRunUpsertOne) AddTotalAmount(v decimal.Decimal) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.AddTotalAmount(v)
+	})
+}
+
+// UpdateTotalAmount sets the "total_amount" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateTotalAmount() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateTotalAmount()
+	})
+}
+
+// ClearTotalAmount clears the value of the "total_amount" field.
+func (u *PaymentRunUpsertOne) ClearTotalAmount() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearTotalAmount()
+	})
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *PaymentRunUpsertOne) SetDiscountAmount(v decimal.Decimal) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetDiscountAmount(v)
+	})
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *PaymentRunUpsertOne) AddDiscountAmount(v decimal.Decimal) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.AddDiscountAmount(v)
+	})
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateDiscountAmount() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateDiscountAmount()
+	})
+}
+
+// ClearDiscountAmount clears the value of the "discount_amount" field.
+func (u *PaymentRunUpsertOne) ClearDiscountAmount() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearDiscountAmount()
+	})
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (u *PaymentRunUpsertOne) SetWithholdingAmount(v decimal.Decimal) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetWithholdingAmount(v)
+	})
+}
+
+// AddWithholdingAmount adds v to the "withholding_amount" field.
+func (u *PaymentRunUpsertOne) AddWithholdingAmount(v decimal.Decimal) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.AddWithholdingAmount(v)
+	})
+}
+
+// UpdateWithholdingAmount sets the "withholding_amount" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateWithholdingAmount() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateWithholdingAmount()
+	})
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (u *PaymentRunUpsertOne) ClearWithholdingAmount() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearWithholdingAmount()
+	})
+}
+
+// SetBillCount sets the "bill_count" field.
+func (u *PaymentRunUpsertOne) SetBillCount(v int) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetBillCount(v)
+	})
+}
+
+// AddBillCount adds v to the "bill_count" field.
+func (u *PaymentRunUpsertOne) AddBillCount(v int) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.AddBillCount(v)
+	})
+}
+
+// UpdateBillCount sets the "bill_count" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateBillCount() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateBillCount()
+	})
+}
+
+// SetFileKey sets the "file_key" field.
+func (u *PaymentRunUpsertOne) SetFileKey(v string) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetFileKey(v)
+	})
+}
+
+// UpdateFileKey sets the "file_key" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateFileKey() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateFileKey()
+	})
+}
+
+// ClearFileKey clears the value of the "file_key" field.
+func (u *PaymentRunUpsertOne) ClearFileKey() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearFileKey()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *PaymentRunUpsertOne) SetNotes(v string) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateNotes() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *PaymentRunUpsertOne) ClearNotes() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *PaymentRunUpsertOne) SetCreatedBy(v uuid.UUID) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateCreatedBy() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *PaymentRunUpsertOne) ClearCreatedBy() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *PaymentRunUpsertOne) SetApprovedBy(v uuid.UUID) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetApprovedBy(v)
+	})
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateApprovedBy() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateApprovedBy()
+	})
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *PaymentRunUpsertOne) ClearApprovedBy() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearApprovedBy()
+	})
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *PaymentRunUpsertOne) SetApprovedAt(v time.Time) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetApprovedAt(v)
+	})
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateApprovedAt() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateApprovedAt()
+	})
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *PaymentRunUpsertOne) ClearApprovedAt() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearApprovedAt()
+	})
+}
+
+// SetExecutedBy sets the "executed_by" field.
+func (u *PaymentRunUpsertOne) SetExecutedBy(v uuid.UUID) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetExecutedBy(v)
+	})
+}
+
+// UpdateExecutedBy sets the "executed_by" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateExecutedBy() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateExecutedBy()
+	})
+}
+
+// ClearExecutedBy clears the value of the "executed_by" field.
+func (u *PaymentRunUpsertOne) ClearExecutedBy() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearExecutedBy()
+	})
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (u *PaymentRunUpsertOne) SetExecutedAt(v time.Time) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetExecutedAt(v)
+	})
+}
+
+// UpdateExecutedAt sets the "executed_at" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateExecutedAt() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateExecutedAt()
+	})
+}
+
+// ClearExecutedAt clears the value of the "executed_at" field.
+func (u *PaymentRunUpsertOne) ClearExecutedAt() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearExecutedAt()
+	})
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *PaymentRunUpsertOne) SetCancelledAt(v time.Time) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateCancelledAt() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *PaymentRunUpsertOne) ClearCancelledAt() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PaymentRunUpsertOne) SetMetadata(v map[string]interface{}) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateMetadata() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentRunUpsertOne) SetUpdatedAt(v time.Time) *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentRunUpsertOne) UpdateUpdatedAt() *PaymentRunUpsertOne {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PaymentRunUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PaymentRunUpsertOne.ID is not supported by MySQL driver. Use PaymentRunUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PaymentRunUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PaymentRunCreateBulk is the builder for creating many PaymentRun entities in bulk.
+type PaymentRunCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PaymentRun entities in the database.
+func (_c *PaymentRunCreateBulk) Save(ctx context.Context) ([]*PaymentRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PaymentRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PaymentRunCreateBulk) SaveX(ctx context.Context) []*PaymentRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentRunUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PaymentRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *PaymentRunUpsertBulk {
+	_c.conflict = opts
+	return &PaymentRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PaymentRunCreateBulk) OnConflictColumns(columns ...string) *PaymentRunUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PaymentRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// PaymentRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of PaymentRun nodes.
+type PaymentRunUpsertBulk struct {
+	create *PaymentRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PaymentRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(paymentrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PaymentRunUpsertBulk) UpdateNewValues() *PaymentRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(paymentrun.FieldID)
+			}
+			if _, exists := b.mutation.RunNumber(); exists {
+				s.SetIgnore(paymentrun.FieldRunNumber)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(paymentrun.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PaymentRunUpsertBulk) Ignore() *PaymentRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentRunUpsertBulk) DoNothing() *PaymentRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *PaymentRunUpsertBulk) Update(set func(*PaymentRunUpsert)) *PaymentRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *PaymentRunUpsertBulk) SetTenantID(v uuid.UUID) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateTenantID() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (u *PaymentRunUpsertBulk) SetPaymentDate(v time.Time) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetPaymentDate(v)
+	})
+}
+
+// UpdatePaymentDate sets the "payment_date" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdatePaymentDate() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdatePaymentDate()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PaymentRunUpsertBulk) SetCurrency(v string) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateCurrency() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetMethod sets the "method" field.
+func (u *PaymentRunUpsertBulk) SetMethod(v string) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetMethod(v)
+	})
+}
+
+// UpdateMethod sets the "method" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateMethod() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateMethod()
+	})
+}
+
+// SetPaymentAccount sets the "payment_account" field.
+func (u *PaymentRunUpsertBulk) SetPaymentAccount(v string) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetPaymentAccount(v)
+	})
+}
+
+// UpdatePaymentAccount sets the "payment_account" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdatePaymentAccount() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdatePaymentAccount()
+	})
+}
+
+// SetDueBefore sets the "due_before" field.
+func (u *PaymentRunUpsertBulk) SetDueBefore(v time.Time) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetDueBefore(v)
+	})
+}
+
+// UpdateDueBefore sets the "due_before" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateDueBefore() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateDueBefore()
+	})
+}
+
+// ClearDueBefore clears the value of the "due_before" field.
+func (u *PaymentRunUpsertBulk) ClearDueBefore() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearDueBefore()
+	})
+}
+
+// SetVendorIds sets the "vendor_ids" field.
+func (u *PaymentRunUpsertBulk) SetVendorIds(v []uuid.UUID) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetVendorIds(v)
+	})
+}
+
+// UpdateVendorIds sets the "vendor_ids" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateVendorIds() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateVendorIds()
+	})
+}
+
+// ClearVendorIds clears the value of the "vendor_ids" field.
+func (u *PaymentRunUpsertBulk) ClearVendorIds() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearVendorIds()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PaymentRunUpsertBulk) SetStatus(v string) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateStatus() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (u *PaymentRunUpsertBulk) SetTotalAmount(v decimal.Decimal) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetTotalAmount(v)
+	})
+}
+
+// AddTotalAmount adds v to the "total_amount" field.
+func (u *PaymentRunUpsertBulk) AddTotalAmount(v decimal.Decimal) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.AddTotalAmount(v)
+	})
+}
+
+// UpdateTotalAmount sets the "total_amount" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateTotalAmount() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateTotalAmount()
+	})
+}
+
+// ClearTotalAmount clears the value of the "total_amount" field.
+func (u *PaymentRunUpsertBulk) ClearTotalAmount() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearTotalAmount()
+	})
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *PaymentRunUpsertBulk) SetDiscountAmount(v decimal.Decimal) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetDiscountAmount(v)
+	})
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *PaymentRunUpsertBulk) AddDiscountAmount(v decimal.Decimal) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.AddDiscountAmount(v)
+	})
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateDiscountAmount() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateDiscountAmount()
+	})
+}
+
+// ClearDiscountAmount clears the value of the "discount_amount" field.
+func (u *PaymentRunUpsertBulk) ClearDiscountAmount() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearDiscountAmount()
+	})
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (u *PaymentRunUpsertBulk) SetWithholdingAmount(v decimal.Decimal) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetWithholdingAmount(v)
+	})
+}
+
+// AddWithholdingAmount adds v to the "withholding_amount" field.
+func (u *PaymentRunUpsertBulk) AddWithholdingAmount(v decimal.Decimal) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.AddWithholdingAmount(v)
+	})
+}
+
+// UpdateWithholdingAmount sets the "withholding_amount" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateWithholdingAmount() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateWithholdingAmount()
+	})
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (u *PaymentRunUpsertBulk) ClearWithholdingAmount() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearWithholdingAmount()
+	})
+}
+
+// SetBillCount sets the "bill_count" field.
+func (u *PaymentRunUpsertBulk) SetBillCount(v int) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetBillCount(v)
+	})
+}
+
+// AddBillCount adds v to the "bill_count" field.
+func (u *PaymentRunUpsertBulk) AddBillCount(v int) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.AddBillCount(v)
+	})
+}
+
+// UpdateBillCount sets the "bill_count" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateBillCount() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateBillCount()
+	})
+}
+
+// SetFileKey sets the "file_key" field.
+func (u *PaymentRunUpsertBulk) SetFileKey(v string) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetFileKey(v)
+	})
+}
+
+// UpdateFileKey sets the "file_key" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateFileKey() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateFileKey()
+	})
+}
+
+// ClearFileKey clears the value of the "file_key" field.
+func (u *PaymentRunUpsertBulk) ClearFileKey() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearFileKey()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *PaymentRunUpsertBulk) SetNotes(v string) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateNotes() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *PaymentRunUpsertBulk) ClearNotes() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *PaymentRunUpsertBulk) SetCreatedBy(v uuid.UUID) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateCreatedBy() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *PaymentRunUpsertBulk) ClearCreatedBy() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *PaymentRunUpsertBulk) SetApprovedBy(v uuid.UUID) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetApprovedBy(v)
+	})
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateApprovedBy() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateApprovedBy()
+	})
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *PaymentRunUpsertBulk) ClearApprovedBy() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearApprovedBy()
+	})
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *PaymentRunUpsertBulk) SetApprovedAt(v time.Time) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetApprovedAt(v)
+	})
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateApprovedAt() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateApprovedAt()
+	})
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *PaymentRunUpsertBulk) ClearApprovedAt() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearApprovedAt()
+	})
+}
+
+// SetExecutedBy sets the "executed_by" field.
+func (u *PaymentRunUpsertBulk) SetExecutedBy(v uuid.UUID) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetExecutedBy(v)
+	})
+}
+
+// UpdateExecutedBy sets the "executed_by" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateExecutedBy() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateExecutedBy()
+	})
+}
+
+// ClearExecutedBy clears the value of the "executed_by" field.
+func (u *PaymentRunUpsertBulk) ClearExecutedBy() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearExecutedBy()
+	})
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (u *PaymentRunUpsertBulk) SetExecutedAt(v time.Time) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetExecutedAt(v)
+	})
+}
+
+// UpdateExecutedAt sets the "executed_at" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateExecutedAt() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateExecutedAt()
+	})
+}
+
+// ClearExecutedAt clears the value of the "executed_at" field.
+func (u *PaymentRunUpsertBulk) ClearExecutedAt() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearExecutedAt()
+	})
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *PaymentRunUpsertBulk) SetCancelledAt(v time.Time) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateCancelledAt() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *PaymentRunUpsertBulk) ClearCancelledAt() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PaymentRunUpsertBulk) SetMetadata(v map[string]interface{}) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateMetadata() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentRunUpsertBulk) SetUpdatedAt(v time.Time) *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentRunUpsertBulk) UpdateUpdatedAt() *PaymentRunUpsertBulk {
+	return u.Update(func(s *PaymentRunUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PaymentRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
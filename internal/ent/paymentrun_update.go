@@ -0,0 +1,1396 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrun"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrunitem"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRunUpdate is the builder for updating PaymentRun entities.
+type PaymentRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentRunMutation
+}
+
+// Where appends a list predicates to the PaymentRunUpdate builder.
+func (_u *PaymentRunUpdate) Where(ps ...predicate.PaymentRun) *PaymentRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *PaymentRunUpdate) SetTenantID(v uuid.UUID) *PaymentRunUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableTenantID(v *uuid.UUID) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (_u *PaymentRunUpdate) SetPaymentDate(v time.Time) *PaymentRunUpdate {
+	_u.mutation.SetPaymentDate(v)
+	return _u
+}
+
+// SetNillablePaymentDate sets the "payment_date" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillablePaymentDate(v *time.Time) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetPaymentDate(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PaymentRunUpdate) SetCurrency(v string) *PaymentRunUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableCurrency(v *string) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetMethod sets the "method" field.
+func (_u *PaymentRunUpdate) SetMethod(v string) *PaymentRunUpdate {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableMethod(v *string) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// SetPaymentAccount sets the "payment_account" field.
+func (_u *PaymentRunUpdate) SetPaymentAccount(v string) *PaymentRunUpdate {
+	_u.mutation.SetPaymentAccount(v)
+	return _u
+}
+
+// SetNillablePaymentAccount sets the "payment_account" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillablePaymentAccount(v *string) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetPaymentAccount(*v)
+	}
+	return _u
+}
+
+// SetDueBefore sets the "due_before" field.
+func (_u *PaymentRunUpdate) SetDueBefore(v time.Time) *PaymentRunUpdate {
+	_u.mutation.SetDueBefore(v)
+	return _u
+}
+
+// SetNillableDueBefore sets the "due_before" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableDueBefore(v *time.Time) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetDueBefore(*v)
+	}
+	return _u
+}
+
+// ClearDueBefore clears the value of the "due_before" field.
+func (_u *PaymentRunUpdate) ClearDueBefore() *PaymentRunUpdate {
+	_u.mutation.ClearDueBefore()
+	return _u
+}
+
+// SetVendorIds sets the "vendor_ids" field.
+func (_u *PaymentRunUpdate) SetVendorIds(v []uuid.UUID) *PaymentRunUpdate {
+	_u.mutation.SetVendorIds(v)
+	return _u
+}
+
+// AppendVendorIds appends value to the "vendor_ids" field.
+func (_u *PaymentRunUpdate) AppendVendorIds(v []uuid.UUID) *PaymentRunUpdate {
+	_u.mutation.AppendVendorIds(v)
+	return _u
+}
+
+// ClearVendorIds clears the value of the "vendor_ids" field.
+func (_u *PaymentRunUpdate) ClearVendorIds() *PaymentRunUpdate {
+	_u.mutation.ClearVendorIds()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PaymentRunUpdate) SetStatus(v string) *PaymentRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableStatus(v *string) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (_u *PaymentRunUpdate) SetTotalAmount(v decimal.Decimal) *PaymentRunUpdate {
+	_u.mutation.ResetTotalAmount()
+	_u.mutation.SetTotalAmount(v)
+	return _u
+}
+
+// SetNillableTotalAmount sets the "total_amount" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableTotalAmount(v *decimal.Decimal) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetTotalAmount(*v)
+	}
+	return _u
+}
+
+// AddTotalAmount adds value to the "total_amount" field.
+func (_u *PaymentRunUpdate) AddTotalAmount(v decimal.Decimal) *PaymentRunUpdate {
+	_u.mutation.AddTotalAmount(v)
+	return _u
+}
+
+// ClearTotalAmount clears the value of the "total_amount" field.
+func (_u *PaymentRunUpdate) ClearTotalAmount() *PaymentRunUpdate {
+	_u.mutation.ClearTotalAmount()
+	return _u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *PaymentRunUpdate) SetDiscountAmount(v decimal.Decimal) *PaymentRunUpdate {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableDiscountAmount(v *decimal.Decimal) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *PaymentRunUpdate) AddDiscountAmount(v decimal.Decimal) *PaymentRunUpdate {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
+// ClearDiscountAmount clears the value of the "discount_amount" field.
+func (_u *PaymentRunUpdate) ClearDiscountAmount() *PaymentRunUpdate {
+	_u.mutation.ClearDiscountAmount()
+	return _u
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (_u *PaymentRunUpdate) SetWithholdingAmount(v decimal.Decimal) *PaymentRunUpdate {
+	_u.mutation.ResetWithholdingAmount()
+	_u.mutation.SetWithholdingAmount(v)
+	return _u
+}
+
+// SetNillableWithholdingAmount sets the "withholding_amount" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableWithholdingAmount(v *decimal.Decimal) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetWithholdingAmount(*v)
+	}
+	return _u
+}
+
+// AddWithholdingAmount adds value to the "withholding_amount" field.
+func (_u *PaymentRunUpdate) AddWithholdingAmount(v decimal.Decimal) *PaymentRunUpdate {
+	_u.mutation.AddWithholdingAmount(v)
+	return _u
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (_u *PaymentRunUpdate) ClearWithholdingAmount() *PaymentRunUpdate {
+	_u.mutation.ClearWithholdingAmount()
+	return _u
+}
+
+// SetBillCount sets the "bill_count" field.
+func (_u *PaymentRunUpdate) SetBillCount(v int) *PaymentRunUpdate {
+	_u.mutation.ResetBillCount()
+	_u.mutation.SetBillCount(v)
+	return _u
+}
+
+// SetNillableBillCount sets the "bill_count" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableBillCount(v *int) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetBillCount(*v)
+	}
+	return _u
+}
+
+// AddBillCount adds value to the "bill_count" field.
+func (_u *PaymentRunUpdate) AddBillCount(v int) *PaymentRunUpdate {
+	_u.mutation.AddBillCount(v)
+	return _u
+}
+
+// SetFileKey sets the "file_key" field.
+func (_u *PaymentRunUpdate) SetFileKey(v string) *PaymentRunUpdate {
+	_u.mutation.SetFileKey(v)
+	return _u
+}
+
+// SetNillableFileKey sets the "file_key" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableFileKey(v *string) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetFileKey(*v)
+	}
+	return _u
+}
+
+// ClearFileKey clears the value of the "file_key" field.
+func (_u *PaymentRunUpdate) ClearFileKey() *PaymentRunUpdate {
+	_u.mutation.ClearFileKey()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *PaymentRunUpdate) SetNotes(v string) *PaymentRunUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableNotes(v *string) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *PaymentRunUpdate) ClearNotes() *PaymentRunUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *PaymentRunUpdate) SetCreatedBy(v uuid.UUID) *PaymentRunUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableCreatedBy(v *uuid.UUID) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *PaymentRunUpdate) ClearCreatedBy() *PaymentRunUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_u *PaymentRunUpdate) SetApprovedBy(v uuid.UUID) *PaymentRunUpdate {
+	_u.mutation.SetApprovedBy(v)
+	return _u
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableApprovedBy(v *uuid.UUID) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetApprovedBy(*v)
+	}
+	return _u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (_u *PaymentRunUpdate) ClearApprovedBy() *PaymentRunUpdate {
+	_u.mutation.ClearApprovedBy()
+	return _u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_u *PaymentRunUpdate) SetApprovedAt(v time.Time) *PaymentRunUpdate {
+	_u.mutation.SetApprovedAt(v)
+	return _u
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableApprovedAt(v *time.Time) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetApprovedAt(*v)
+	}
+	return _u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (_u *PaymentRunUpdate) ClearApprovedAt() *PaymentRunUpdate {
+	_u.mutation.ClearApprovedAt()
+	return _u
+}
+
+// SetExecutedBy sets the "executed_by" field.
+func (_u *PaymentRunUpdate) SetExecutedBy(v uuid.UUID) *PaymentRunUpdate {
+	_u.mutation.SetExecutedBy(v)
+	return _u
+}
+
+// SetNillableExecutedBy sets the "executed_by" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableExecutedBy(v *uuid.UUID) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetExecutedBy(*v)
+	}
+	return _u
+}
+
+// ClearExecutedBy clears the value of the "executed_by" field.
+func (_u *PaymentRunUpdate) ClearExecutedBy() *PaymentRunUpdate {
+	_u.mutation.ClearExecutedBy()
+	return _u
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (_u *PaymentRunUpdate) SetExecutedAt(v time.Time) *PaymentRunUpdate {
+	_u.mutation.SetExecutedAt(v)
+	return _u
+}
+
+// SetNillableExecutedAt sets the "executed_at" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableExecutedAt(v *time.Time) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetExecutedAt(*v)
+	}
+	return _u
+}
+
+// ClearExecutedAt clears the value of the "executed_at" field.
+func (_u *PaymentRunUpdate) ClearExecutedAt() *PaymentRunUpdate {
+	_u.mutation.ClearExecutedAt()
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *PaymentRunUpdate) SetCancelledAt(v time.Time) *PaymentRunUpdate {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *PaymentRunUpdate) SetNillableCancelledAt(v *time.Time) *PaymentRunUpdate {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *PaymentRunUpdate) ClearCancelledAt() *PaymentRunUpdate {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *PaymentRunUpdate) SetMetadata(v map[string]interface{}) *PaymentRunUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PaymentRunUpdate) SetUpdatedAt(v time.Time) *PaymentRunUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddItemIDs adds the "items" edge to the PaymentRunItem entity by IDs.
+func (_u *PaymentRunUpdate) AddItemIDs(ids ...uuid.UUID) *PaymentRunUpdate {
+	_u.mutation.AddItemIDs(ids...)
+	return _u
+}
+
+// AddItems adds the "items" edges to the PaymentRunItem entity.
+func (_u *PaymentRunUpdate) AddItems(v ...*PaymentRunItem) *PaymentRunUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemIDs(ids...)
+}
+
+// Mutation returns the PaymentRunMutation object of the builder.
+func (_u *PaymentRunUpdate) Mutation() *PaymentRunMutation {
+	return _u.mutation
+}
+
+// ClearItems clears all "items" edges to the PaymentRunItem entity.
+func (_u *PaymentRunUpdate) ClearItems() *PaymentRunUpdate {
+	_u.mutation.ClearItems()
+	return _u
+}
+
+// RemoveItemIDs removes the "items" edge to PaymentRunItem entities by IDs.
+func (_u *PaymentRunUpdate) RemoveItemIDs(ids ...uuid.UUID) *PaymentRunUpdate {
+	_u.mutation.RemoveItemIDs(ids...)
+	return _u
+}
+
+// RemoveItems removes "items" edges to PaymentRunItem entities.
+func (_u *PaymentRunUpdate) RemoveItems(v ...*PaymentRunItem) *PaymentRunUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaymentRunUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaymentRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PaymentRunUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := paymentrun.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *PaymentRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(paymentrun.Table, paymentrun.Columns, sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(paymentrun.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PaymentDate(); ok {
+		_spec.SetField(paymentrun.FieldPaymentDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(paymentrun.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(paymentrun.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PaymentAccount(); ok {
+		_spec.SetField(paymentrun.FieldPaymentAccount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DueBefore(); ok {
+		_spec.SetField(paymentrun.FieldDueBefore, field.TypeTime, value)
+	}
+	if _u.mutation.DueBeforeCleared() {
+		_spec.ClearField(paymentrun.FieldDueBefore, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VendorIds(); ok {
+		_spec.SetField(paymentrun.FieldVendorIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVendorIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, paymentrun.FieldVendorIds, value)
+		})
+	}
+	if _u.mutation.VendorIdsCleared() {
+		_spec.ClearField(paymentrun.FieldVendorIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(paymentrun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TotalAmount(); ok {
+		_spec.SetField(paymentrun.FieldTotalAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalAmount(); ok {
+		_spec.AddField(paymentrun.FieldTotalAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.TotalAmountCleared() {
+		_spec.ClearField(paymentrun.FieldTotalAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(paymentrun.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(paymentrun.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.DiscountAmountCleared() {
+		_spec.ClearField(paymentrun.FieldDiscountAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.WithholdingAmount(); ok {
+		_spec.SetField(paymentrun.FieldWithholdingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWithholdingAmount(); ok {
+		_spec.AddField(paymentrun.FieldWithholdingAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.WithholdingAmountCleared() {
+		_spec.ClearField(paymentrun.FieldWithholdingAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.BillCount(); ok {
+		_spec.SetField(paymentrun.FieldBillCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBillCount(); ok {
+		_spec.AddField(paymentrun.FieldBillCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FileKey(); ok {
+		_spec.SetField(paymentrun.FieldFileKey, field.TypeString, value)
+	}
+	if _u.mutation.FileKeyCleared() {
+		_spec.ClearField(paymentrun.FieldFileKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(paymentrun.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(paymentrun.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(paymentrun.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(paymentrun.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ApprovedBy(); ok {
+		_spec.SetField(paymentrun.FieldApprovedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ApprovedByCleared() {
+		_spec.ClearField(paymentrun.FieldApprovedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ApprovedAt(); ok {
+		_spec.SetField(paymentrun.FieldApprovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ApprovedAtCleared() {
+		_spec.ClearField(paymentrun.FieldApprovedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExecutedBy(); ok {
+		_spec.SetField(paymentrun.FieldExecutedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ExecutedByCleared() {
+		_spec.ClearField(paymentrun.FieldExecutedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ExecutedAt(); ok {
+		_spec.SetField(paymentrun.FieldExecutedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExecutedAtCleared() {
+		_spec.ClearField(paymentrun.FieldExecutedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(paymentrun.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(paymentrun.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(paymentrun.FieldMetadata, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentrun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentrun.ItemsTable,
+			Columns: []string{paymentrun.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemsIDs(); len(nodes) > 0 && !_u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentrun.ItemsTable,
+			Columns: []string{paymentrun.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentrun.ItemsTable,
+			Columns: []string{paymentrun.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaymentRunUpdateOne is the builder for updating a single PaymentRun entity.
+type PaymentRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentRunMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *PaymentRunUpdateOne) SetTenantID(v uuid.UUID) *PaymentRunUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableTenantID(v *uuid.UUID) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (_u *PaymentRunUpdateOne) SetPaymentDate(v time.Time) *PaymentRunUpdateOne {
+	_u.mutation.SetPaymentDate(v)
+	return _u
+}
+
+// SetNillablePaymentDate sets the "payment_date" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillablePaymentDate(v *time.Time) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetPaymentDate(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PaymentRunUpdateOne) SetCurrency(v string) *PaymentRunUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableCurrency(v *string) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetMethod sets the "method" field.
+func (_u *PaymentRunUpdateOne) SetMethod(v string) *PaymentRunUpdateOne {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableMethod(v *string) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// SetPaymentAccount sets the "payment_account" field.
+func (_u *PaymentRunUpdateOne) SetPaymentAccount(v string) *PaymentRunUpdateOne {
+	_u.mutation.SetPaymentAccount(v)
+	return _u
+}
+
+// SetNillablePaymentAccount sets the "payment_account" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillablePaymentAccount(v *string) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetPaymentAccount(*v)
+	}
+	return _u
+}
+
+// SetDueBefore sets the "due_before" field.
+func (_u *PaymentRunUpdateOne) SetDueBefore(v time.Time) *PaymentRunUpdateOne {
+	_u.mutation.SetDueBefore(v)
+	return _u
+}
+
+// SetNillableDueBefore sets the "due_before" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableDueBefore(v *time.Time) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetDueBefore(*v)
+	}
+	return _u
+}
+
+// ClearDueBefore clears the value of the "due_before" field.
+func (_u *PaymentRunUpdateOne) ClearDueBefore() *PaymentRunUpdateOne {
+	_u.mutation.ClearDueBefore()
+	return _u
+}
+
+// SetVendorIds sets the "vendor_ids" field.
+func (_u *PaymentRunUpdateOne) SetVendorIds(v []uuid.UUID) *PaymentRunUpdateOne {
+	_u.mutation.SetVendorIds(v)
+	return _u
+}
+
+// AppendVendorIds appends value to the "vendor_ids" field.
+func (_u *PaymentRunUpdateOne) AppendVendorIds(v []uuid.UUID) *PaymentRunUpdateOne {
+	_u.mutation.AppendVendorIds(v)
+	return _u
+}
+
+// ClearVendorIds clears the value of the "vendor_ids" field.
+func (_u *PaymentRunUpdateOne) ClearVendorIds() *PaymentRunUpdateOne {
+	_u.mutation.ClearVendorIds()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PaymentRunUpdateOne) SetStatus(v string) *PaymentRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableStatus(v *string) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (_u *PaymentRunUpdateOne) SetTotalAmount(v decimal.Decimal) *PaymentRunUpdateOne {
+	_u.mutation.ResetTotalAmount()
+	_u.mutation.SetTotalAmount(v)
+	return _u
+}
+
+// SetNillableTotalAmount sets the "total_amount" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableTotalAmount(v *decimal.Decimal) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetTotalAmount(*v)
+	}
+	return _u
+}
+
+// AddTotalAmount adds value to the "total_amount" field.
+func (_u *PaymentRunUpdateOne) AddTotalAmount(v decimal.Decimal) *PaymentRunUpdateOne {
+	_u.mutation.AddTotalAmount(v)
+	return _u
+}
+
+// ClearTotalAmount clears the value of the "total_amount" field.
+func (_u *PaymentRunUpdateOne) ClearTotalAmount() *PaymentRunUpdateOne {
+	_u.mutation.ClearTotalAmount()
+	return _u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *PaymentRunUpdateOne) SetDiscountAmount(v decimal.Decimal) *PaymentRunUpdateOne {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableDiscountAmount(v *decimal.Decimal) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *PaymentRunUpdateOne) AddDiscountAmount(v decimal.Decimal) *PaymentRunUpdateOne {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
+// ClearDiscountAmount clears the value of the "discount_amount" field.
+func (_u *PaymentRunUpdateOne) ClearDiscountAmount() *PaymentRunUpdateOne {
+	_u.mutation.ClearDiscountAmount()
+	return _u
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (_u *PaymentRunUpdateOne) SetWithholdingAmount(v decimal.Decimal) *PaymentRunUpdateOne {
+	_u.mutation.ResetWithholdingAmount()
+	_u.mutation.SetWithholdingAmount(v)
+	return _u
+}
+
+// SetNillableWithholdingAmount sets the "withholding_amount" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableWithholdingAmount(v *decimal.Decimal) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetWithholdingAmount(*v)
+	}
+	return _u
+}
+
+// AddWithholdingAmount adds value to the "withholding_amount" field.
+func (_u *PaymentRunUpdateOne) AddWithholdingAmount(v decimal.Decimal) *PaymentRunUpdateOne {
+	_u.mutation.AddWithholdingAmount(v)
+	return _u
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (_u *PaymentRunUpdateOne) ClearWithholdingAmount() *PaymentRunUpdateOne {
+	_u.mutation.ClearWithholdingAmount()
+	return _u
+}
+
+// SetBillCount sets the "bill_count" field.
+func (_u *PaymentRunUpdateOne) SetBillCount(v int) *PaymentRunUpdateOne {
+	_u.mutation.ResetBillCount()
+	_u.mutation.SetBillCount(v)
+	return _u
+}
+
+// SetNillableBillCount sets the "bill_count" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableBillCount(v *int) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetBillCount(*v)
+	}
+	return _u
+}
+
+// AddBillCount adds value to the "bill_count" field.
+func (_u *PaymentRunUpdateOne) AddBillCount(v int) *PaymentRunUpdateOne {
+	_u.mutation.AddBillCount(v)
+	return _u
+}
+
+// SetFileKey sets the "file_key" field.
+func (_u *PaymentRunUpdateOne) SetFileKey(v string) *PaymentRunUpdateOne {
+	_u.mutation.SetFileKey(v)
+	return _u
+}
+
+// SetNillableFileKey sets the "file_key" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableFileKey(v *string) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetFileKey(*v)
+	}
+	return _u
+}
+
+// ClearFileKey clears the value of the "file_key" field.
+func (_u *PaymentRunUpdateOne) ClearFileKey() *PaymentRunUpdateOne {
+	_u.mutation.ClearFileKey()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *PaymentRunUpdateOne) SetNotes(v string) *PaymentRunUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableNotes(v *string) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *PaymentRunUpdateOne) ClearNotes() *PaymentRunUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *PaymentRunUpdateOne) SetCreatedBy(v uuid.UUID) *PaymentRunUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableCreatedBy(v *uuid.UUID) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *PaymentRunUpdateOne) ClearCreatedBy() *PaymentRunUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_u *PaymentRunUpdateOne) SetApprovedBy(v uuid.UUID) *PaymentRunUpdateOne {
+	_u.mutation.SetApprovedBy(v)
+	return _u
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableApprovedBy(v *uuid.UUID) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetApprovedBy(*v)
+	}
+	return _u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (_u *PaymentRunUpdateOne) ClearApprovedBy() *PaymentRunUpdateOne {
+	_u.mutation.ClearApprovedBy()
+	return _u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_u *PaymentRunUpdateOne) SetApprovedAt(v time.Time) *PaymentRunUpdateOne {
+	_u.mutation.SetApprovedAt(v)
+	return _u
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableApprovedAt(v *time.Time) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetApprovedAt(*v)
+	}
+	return _u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (_u *PaymentRunUpdateOne) ClearApprovedAt() *PaymentRunUpdateOne {
+	_u.mutation.ClearApprovedAt()
+	return _u
+}
+
+// SetExecutedBy sets the "executed_by" field.
+func (_u *PaymentRunUpdateOne) SetExecutedBy(v uuid.UUID) *PaymentRunUpdateOne {
+	_u.mutation.SetExecutedBy(v)
+	return _u
+}
+
+// SetNillableExecutedBy sets the "executed_by" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableExecutedBy(v *uuid.UUID) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetExecutedBy(*v)
+	}
+	return _u
+}
+
+// ClearExecutedBy clears the value of the "executed_by" field.
+func (_u *PaymentRunUpdateOne) ClearExecutedBy() *PaymentRunUpdateOne {
+	_u.mutation.ClearExecutedBy()
+	return _u
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (_u *PaymentRunUpdateOne) SetExecutedAt(v time.Time) *PaymentRunUpdateOne {
+	_u.mutation.SetExecutedAt(v)
+	return _u
+}
+
+// SetNillableExecutedAt sets the "executed_at" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableExecutedAt(v *time.Time) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetExecutedAt(*v)
+	}
+	return _u
+}
+
+// ClearExecutedAt clears the value of the "executed_at" field.
+func (_u *PaymentRunUpdateOne) ClearExecutedAt() *PaymentRunUpdateOne {
+	_u.mutation.ClearExecutedAt()
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *PaymentRunUpdateOne) SetCancelledAt(v time.Time) *PaymentRunUpdateOne {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *PaymentRunUpdateOne) SetNillableCancelledAt(v *time.Time) *PaymentRunUpdateOne {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *PaymentRunUpdateOne) ClearCancelledAt() *PaymentRunUpdateOne {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *PaymentRunUpdateOne) SetMetadata(v map[string]interface{}) *PaymentRunUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PaymentRunUpdateOne) SetUpdatedAt(v time.Time) *PaymentRunUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddItemIDs adds the "items" edge to the PaymentRunItem entity by IDs.
+func (_u *PaymentRunUpdateOne) AddItemIDs(ids ...uuid.UUID) *PaymentRunUpdateOne {
+	_u.mutation.AddItemIDs(ids...)
+	return _u
+}
+
+// AddItems adds the "items" edges to the PaymentRunItem entity.
+func (_u *PaymentRunUpdateOne) AddItems(v ...*PaymentRunItem) *PaymentRunUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemIDs(ids...)
+}
+
+// Mutation returns the PaymentRunMutation object of the builder.
+func (_u *PaymentRunUpdateOne) Mutation() *PaymentRunMutation {
+	return _u.mutation
+}
+
+// ClearItems clears all "items" edges to the PaymentRunItem entity.
+func (_u *PaymentRunUpdateOne) ClearItems() *PaymentRunUpdateOne {
+	_u.mutation.ClearItems()
+	return _u
+}
+
+// RemoveItemIDs removes the "items" edge to PaymentRunItem entities by IDs.
+func (_u *PaymentRunUpdateOne) RemoveItemIDs(ids ...uuid.UUID) *PaymentRunUpdateOne {
+	_u.mutation.RemoveItemIDs(ids...)
+	return _u
+}
+
+// RemoveItems removes "items" edges to PaymentRunItem entities.
+func (_u *PaymentRunUpdateOne) RemoveItems(v ...*PaymentRunItem) *PaymentRunUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemIDs(ids...)
+}
+
+// Where appends a list predicates to the PaymentRunUpdate builder.
+func (_u *PaymentRunUpdateOne) Where(ps ...predicate.PaymentRun) *PaymentRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaymentRunUpdateOne) Select(field string, fields ...string) *PaymentRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaymentRun entity.
+func (_u *PaymentRunUpdateOne) Save(ctx context.Context) (*PaymentRun, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentRunUpdateOne) SaveX(ctx context.Context) *PaymentRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaymentRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PaymentRunUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := paymentrun.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *PaymentRunUpdateOne) sqlSave(ctx context.Context) (_node *PaymentRun, err error) {
+	_spec := sqlgraph.NewUpdateSpec(paymentrun.Table, paymentrun.Columns, sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaymentRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentrun.FieldID)
+		for _, f := range fields {
+			if !paymentrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paymentrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(paymentrun.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PaymentDate(); ok {
+		_spec.SetField(paymentrun.FieldPaymentDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(paymentrun.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(paymentrun.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PaymentAccount(); ok {
+		_spec.SetField(paymentrun.FieldPaymentAccount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DueBefore(); ok {
+		_spec.SetField(paymentrun.FieldDueBefore, field.TypeTime, value)
+	}
+	if _u.mutation.DueBeforeCleared() {
+		_spec.ClearField(paymentrun.FieldDueBefore, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VendorIds(); ok {
+		_spec.SetField(paymentrun.FieldVendorIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVendorIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, paymentrun.FieldVendorIds, value)
+		})
+	}
+	if _u.mutation.VendorIdsCleared() {
+		_spec.ClearField(paymentrun.FieldVendorIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(paymentrun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TotalAmount(); ok {
+		_spec.SetField(paymentrun.FieldTotalAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalAmount(); ok {
+		_spec.AddField(paymentrun.FieldTotalAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.TotalAmountCleared() {
+		_spec.ClearField(paymentrun.FieldTotalAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(paymentrun.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(paymentrun.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.DiscountAmountCleared() {
+		_spec.ClearField(paymentrun.FieldDiscountAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.WithholdingAmount(); ok {
+		_spec.SetField(paymentrun.FieldWithholdingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWithholdingAmount(); ok {
+		_spec.AddField(paymentrun.FieldWithholdingAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.WithholdingAmountCleared() {
+		_spec.ClearField(paymentrun.FieldWithholdingAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.BillCount(); ok {
+		_spec.SetField(paymentrun.FieldBillCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBillCount(); ok {
+		_spec.AddField(paymentrun.FieldBillCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FileKey(); ok {
+		_spec.SetField(paymentrun.FieldFileKey, field.TypeString, value)
+	}
+	if _u.mutation.FileKeyCleared() {
+		_spec.ClearField(paymentrun.FieldFileKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(paymentrun.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(paymentrun.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(paymentrun.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(paymentrun.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ApprovedBy(); ok {
+		_spec.SetField(paymentrun.FieldApprovedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ApprovedByCleared() {
+		_spec.ClearField(paymentrun.FieldApprovedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ApprovedAt(); ok {
+		_spec.SetField(paymentrun.FieldApprovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ApprovedAtCleared() {
+		_spec.ClearField(paymentrun.FieldApprovedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExecutedBy(); ok {
+		_spec.SetField(paymentrun.FieldExecutedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ExecutedByCleared() {
+		_spec.ClearField(paymentrun.FieldExecutedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ExecutedAt(); ok {
+		_spec.SetField(paymentrun.FieldExecutedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExecutedAtCleared() {
+		_spec.ClearField(paymentrun.FieldExecutedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(paymentrun.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(paymentrun.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(paymentrun.FieldMetadata, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentrun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentrun.ItemsTable,
+			Columns: []string{paymentrun.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemsIDs(); len(nodes) > 0 && !_u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentrun.ItemsTable,
+			Columns: []string{paymentrun.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentrun.ItemsTable,
+			Columns: []string{paymentrun.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrunitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PaymentRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
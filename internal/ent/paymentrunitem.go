@@ -0,0 +1,282 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrun"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrunitem"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRunItem is the model entity for the PaymentRunItem schema.
+type PaymentRunItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Payment run identifier
+	RunID uuid.UUID `json:"run_id,omitempty"`
+	// Vendor bill identifier
+	BillID uuid.UUID `json:"bill_id,omitempty"`
+	// Vendor identifier
+	VendorID uuid.UUID `json:"vendor_id,omitempty"`
+	// BillNumber holds the value of the "bill_number" field.
+	BillNumber string `json:"bill_number,omitempty"`
+	// VendorReference holds the value of the "vendor_reference" field.
+	VendorReference string `json:"vendor_reference,omitempty"`
+	// DueDate holds the value of the "due_date" field.
+	DueDate time.Time `json:"due_date,omitempty"`
+	// Bill balance when proposed
+	Balance decimal.Decimal `json:"balance,omitempty"`
+	// Early-payment discount taken (defaults to zero)
+	DiscountAmount decimal.Decimal `json:"discount_amount,omitempty"`
+	// Withholding tax deducted (defaults to zero)
+	WithholdingAmount decimal.Decimal `json:"withholding_amount,omitempty"`
+	// Amount paid to the vendor: balance less discount and withholding tax
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Status: proposed, removed, paid, cancelled
+	Status string `json:"status,omitempty"`
+	// Object storage key of the vendor's remittance advice
+	RemittanceKey string `json:"remittance_key,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaymentRunItemQuery when eager-loading is set.
+	Edges        PaymentRunItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PaymentRunItemEdges holds the relations/edges for other nodes in the graph.
+type PaymentRunItemEdges struct {
+	// Run holds the value of the run edge.
+	Run *PaymentRun `json:"run,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RunOrErr returns the Run value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentRunItemEdges) RunOrErr() (*PaymentRun, error) {
+	if e.Run != nil {
+		return e.Run, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: paymentrun.Label}
+	}
+	return nil, &NotLoadedError{edge: "run"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentRunItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentrunitem.FieldBalance, paymentrunitem.FieldDiscountAmount, paymentrunitem.FieldWithholdingAmount, paymentrunitem.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case paymentrunitem.FieldBillNumber, paymentrunitem.FieldVendorReference, paymentrunitem.FieldStatus, paymentrunitem.FieldRemittanceKey:
+			values[i] = new(sql.NullString)
+		case paymentrunitem.FieldDueDate, paymentrunitem.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case paymentrunitem.FieldID, paymentrunitem.FieldTenantID, paymentrunitem.FieldRunID, paymentrunitem.FieldBillID, paymentrunitem.FieldVendorID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentRunItem fields.
+func (_m *PaymentRunItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentrunitem.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case paymentrunitem.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case paymentrunitem.FieldRunID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field run_id", values[i])
+			} else if value != nil {
+				_m.RunID = *value
+			}
+		case paymentrunitem.FieldBillID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field bill_id", values[i])
+			} else if value != nil {
+				_m.BillID = *value
+			}
+		case paymentrunitem.FieldVendorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field vendor_id", values[i])
+			} else if value != nil {
+				_m.VendorID = *value
+			}
+		case paymentrunitem.FieldBillNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bill_number", values[i])
+			} else if value.Valid {
+				_m.BillNumber = value.String
+			}
+		case paymentrunitem.FieldVendorReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vendor_reference", values[i])
+			} else if value.Valid {
+				_m.VendorReference = value.String
+			}
+		case paymentrunitem.FieldDueDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_date", values[i])
+			} else if value.Valid {
+				_m.DueDate = value.Time
+			}
+		case paymentrunitem.FieldBalance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value != nil {
+				_m.Balance = *value
+			}
+		case paymentrunitem.FieldDiscountAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_amount", values[i])
+			} else if value != nil {
+				_m.DiscountAmount = *value
+			}
+		case paymentrunitem.FieldWithholdingAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field withholding_amount", values[i])
+			} else if value != nil {
+				_m.WithholdingAmount = *value
+			}
+		case paymentrunitem.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case paymentrunitem.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case paymentrunitem.FieldRemittanceKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remittance_key", values[i])
+			} else if value.Valid {
+				_m.RemittanceKey = value.String
+			}
+		case paymentrunitem.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentRunItem.
+// This includes values selected through modifiers, order, etc.
+func (_m *PaymentRunItem) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRun queries the "run" edge of the PaymentRunItem entity.
+func (_m *PaymentRunItem) QueryRun() *PaymentRunQuery {
+	return NewPaymentRunItemClient(_m.config).QueryRun(_m)
+}
+
+// Update returns a builder for updating this PaymentRunItem.
+// Note that you need to call PaymentRunItem.Unwrap() before calling this method if this PaymentRunItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PaymentRunItem) Update() *PaymentRunItemUpdateOne {
+	return NewPaymentRunItemClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PaymentRunItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PaymentRunItem) Unwrap() *PaymentRunItem {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PaymentRunItem is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PaymentRunItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentRunItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("run_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RunID))
+	builder.WriteString(", ")
+	builder.WriteString("bill_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BillID))
+	builder.WriteString(", ")
+	builder.WriteString("vendor_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VendorID))
+	builder.WriteString(", ")
+	builder.WriteString("bill_number=")
+	builder.WriteString(_m.BillNumber)
+	builder.WriteString(", ")
+	builder.WriteString("vendor_reference=")
+	builder.WriteString(_m.VendorReference)
+	builder.WriteString(", ")
+	builder.WriteString("due_date=")
+	builder.WriteString(_m.DueDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("balance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Balance))
+	builder.WriteString(", ")
+	builder.WriteString("discount_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DiscountAmount))
+	builder.WriteString(", ")
+	builder.WriteString("withholding_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WithholdingAmount))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("remittance_key=")
+	builder.WriteString(_m.RemittanceKey)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentRunItems is a parsable slice of PaymentRunItem.
+type PaymentRunItems []*PaymentRunItem
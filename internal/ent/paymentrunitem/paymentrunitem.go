@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentrunitem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the paymentrunitem type in the database.
+	Label = "payment_run_item"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldRunID holds the string denoting the run_id field in the database.
+	FieldRunID = "run_id"
+	// FieldBillID holds the string denoting the bill_id field in the database.
+	FieldBillID = "bill_id"
+	// FieldVendorID holds the string denoting the vendor_id field in the database.
+	FieldVendorID = "vendor_id"
+	// FieldBillNumber holds the string denoting the bill_number field in the database.
+	FieldBillNumber = "bill_number"
+	// FieldVendorReference holds the string denoting the vendor_reference field in the database.
+	FieldVendorReference = "vendor_reference"
+	// FieldDueDate holds the string denoting the due_date field in the database.
+	FieldDueDate = "due_date"
+	// FieldBalance holds the string denoting the balance field in the database.
+	FieldBalance = "balance"
+	// FieldDiscountAmount holds the string denoting the discount_amount field in the database.
+	FieldDiscountAmount = "discount_amount"
+	// FieldWithholdingAmount holds the string denoting the withholding_amount field in the database.
+	FieldWithholdingAmount = "withholding_amount"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldRemittanceKey holds the string denoting the remittance_key field in the database.
+	FieldRemittanceKey = "remittance_key"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeRun holds the string denoting the run edge name in mutations.
+	EdgeRun = "run"
+	// Table holds the table name of the paymentrunitem in the database.
+	Table = "payment_run_items"
+	// RunTable is the table that holds the run relation/edge.
+	RunTable = "payment_run_items"
+	// RunInverseTable is the table name for the PaymentRun entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentrun" package.
+	RunInverseTable = "payment_runs"
+	// RunColumn is the table column denoting the run relation/edge.
+	RunColumn = "run_id"
+)
+
+// Columns holds all SQL columns for paymentrunitem fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldRunID,
+	FieldBillID,
+	FieldVendorID,
+	FieldBillNumber,
+	FieldVendorReference,
+	FieldDueDate,
+	FieldBalance,
+	FieldDiscountAmount,
+	FieldWithholdingAmount,
+	FieldAmount,
+	FieldStatus,
+	FieldRemittanceKey,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the PaymentRunItem queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByRunID orders the results by the run_id field.
+func ByRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunID, opts...).ToFunc()
+}
+
+// ByBillID orders the results by the bill_id field.
+func ByBillID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillID, opts...).ToFunc()
+}
+
+// ByVendorID orders the results by the vendor_id field.
+func ByVendorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVendorID, opts...).ToFunc()
+}
+
+// ByBillNumber orders the results by the bill_number field.
+func ByBillNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillNumber, opts...).ToFunc()
+}
+
+// ByVendorReference orders the results by the vendor_reference field.
+func ByVendorReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVendorReference, opts...).ToFunc()
+}
+
+// ByDueDate orders the results by the due_date field.
+func ByDueDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueDate, opts...).ToFunc()
+}
+
+// ByBalance orders the results by the balance field.
+func ByBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBalance, opts...).ToFunc()
+}
+
+// ByDiscountAmount orders the results by the discount_amount field.
+func ByDiscountAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountAmount, opts...).ToFunc()
+}
+
+// ByWithholdingAmount orders the results by the withholding_amount field.
+func ByWithholdingAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWithholdingAmount, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByRemittanceKey orders the results by the remittance_key field.
+func ByRemittanceKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemittanceKey, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRunField orders the results by run field.
+func ByRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+	)
+}
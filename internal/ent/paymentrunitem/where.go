@@ -0,0 +1,846 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentrunitem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldTenantID, v))
+}
+
+// RunID applies equality check predicate on the "run_id" field. It's identical to RunIDEQ.
+func RunID(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldRunID, v))
+}
+
+// BillID applies equality check predicate on the "bill_id" field. It's identical to BillIDEQ.
+func BillID(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldBillID, v))
+}
+
+// VendorID applies equality check predicate on the "vendor_id" field. It's identical to VendorIDEQ.
+func VendorID(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldVendorID, v))
+}
+
+// BillNumber applies equality check predicate on the "bill_number" field. It's identical to BillNumberEQ.
+func BillNumber(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldBillNumber, v))
+}
+
+// VendorReference applies equality check predicate on the "vendor_reference" field. It's identical to VendorReferenceEQ.
+func VendorReference(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldVendorReference, v))
+}
+
+// DueDate applies equality check predicate on the "due_date" field. It's identical to DueDateEQ.
+func DueDate(v time.Time) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldDueDate, v))
+}
+
+// Balance applies equality check predicate on the "balance" field. It's identical to BalanceEQ.
+func Balance(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldBalance, v))
+}
+
+// DiscountAmount applies equality check predicate on the "discount_amount" field. It's identical to DiscountAmountEQ.
+func DiscountAmount(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
+// WithholdingAmount applies equality check predicate on the "withholding_amount" field. It's identical to WithholdingAmountEQ.
+func WithholdingAmount(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldWithholdingAmount, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldAmount, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldStatus, v))
+}
+
+// RemittanceKey applies equality check predicate on the "remittance_key" field. It's identical to RemittanceKeyEQ.
+func RemittanceKey(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldRemittanceKey, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLTE(FieldTenantID, v))
+}
+
+// RunIDEQ applies the EQ predicate on the "run_id" field.
+func RunIDEQ(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldRunID, v))
+}
+
+// RunIDNEQ applies the NEQ predicate on the "run_id" field.
+func RunIDNEQ(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNEQ(FieldRunID, v))
+}
+
+// RunIDIn applies the In predicate on the "run_id" field.
+func RunIDIn(vs ...uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIn(FieldRunID, vs...))
+}
+
+// RunIDNotIn applies the NotIn predicate on the "run_id" field.
+func RunIDNotIn(vs ...uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotIn(FieldRunID, vs...))
+}
+
+// BillIDEQ applies the EQ predicate on the "bill_id" field.
+func BillIDEQ(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldBillID, v))
+}
+
+// BillIDNEQ applies the NEQ predicate on the "bill_id" field.
+func BillIDNEQ(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNEQ(FieldBillID, v))
+}
+
+// BillIDIn applies the In predicate on the "bill_id" field.
+func BillIDIn(vs ...uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIn(FieldBillID, vs...))
+}
+
+// BillIDNotIn applies the NotIn predicate on the "bill_id" field.
+func BillIDNotIn(vs ...uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotIn(FieldBillID, vs...))
+}
+
+// BillIDGT applies the GT predicate on the "bill_id" field.
+func BillIDGT(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGT(FieldBillID, v))
+}
+
+// BillIDGTE applies the GTE predicate on the "bill_id" field.
+func BillIDGTE(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGTE(FieldBillID, v))
+}
+
+// BillIDLT applies the LT predicate on the "bill_id" field.
+func BillIDLT(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLT(FieldBillID, v))
+}
+
+// BillIDLTE applies the LTE predicate on the "bill_id" field.
+func BillIDLTE(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLTE(FieldBillID, v))
+}
+
+// VendorIDEQ applies the EQ predicate on the "vendor_id" field.
+func VendorIDEQ(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldVendorID, v))
+}
+
+// VendorIDNEQ applies the NEQ predicate on the "vendor_id" field.
+func VendorIDNEQ(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNEQ(FieldVendorID, v))
+}
+
+// VendorIDIn applies the In predicate on the "vendor_id" field.
+func VendorIDIn(vs ...uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIn(FieldVendorID, vs...))
+}
+
+// VendorIDNotIn applies the NotIn predicate on the "vendor_id" field.
+func VendorIDNotIn(vs ...uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotIn(FieldVendorID, vs...))
+}
+
+// VendorIDGT applies the GT predicate on the "vendor_id" field.
+func VendorIDGT(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGT(FieldVendorID, v))
+}
+
+// VendorIDGTE applies the GTE predicate on the "vendor_id" field.
+func VendorIDGTE(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGTE(FieldVendorID, v))
+}
+
+// VendorIDLT applies the LT predicate on the "vendor_id" field.
+func VendorIDLT(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLT(FieldVendorID, v))
+}
+
+// VendorIDLTE applies the LTE predicate on the "vendor_id" field.
+func VendorIDLTE(v uuid.UUID) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLTE(FieldVendorID, v))
+}
+
+// BillNumberEQ applies the EQ predicate on the "bill_number" field.
+func BillNumberEQ(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldBillNumber, v))
+}
+
+// BillNumberNEQ applies the NEQ predicate on the "bill_number" field.
+func BillNumberNEQ(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNEQ(FieldBillNumber, v))
+}
+
+// BillNumberIn applies the In predicate on the "bill_number" field.
+func BillNumberIn(vs ...string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIn(FieldBillNumber, vs...))
+}
+
+// BillNumberNotIn applies the NotIn predicate on the "bill_number" field.
+func BillNumberNotIn(vs ...string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotIn(FieldBillNumber, vs...))
+}
+
+// BillNumberGT applies the GT predicate on the "bill_number" field.
+func BillNumberGT(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGT(FieldBillNumber, v))
+}
+
+// BillNumberGTE applies the GTE predicate on the "bill_number" field.
+func BillNumberGTE(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGTE(FieldBillNumber, v))
+}
+
+// BillNumberLT applies the LT predicate on the "bill_number" field.
+func BillNumberLT(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLT(FieldBillNumber, v))
+}
+
+// BillNumberLTE applies the LTE predicate on the "bill_number" field.
+func BillNumberLTE(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLTE(FieldBillNumber, v))
+}
+
+// BillNumberContains applies the Contains predicate on the "bill_number" field.
+func BillNumberContains(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldContains(FieldBillNumber, v))
+}
+
+// BillNumberHasPrefix applies the HasPrefix predicate on the "bill_number" field.
+func BillNumberHasPrefix(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldHasPrefix(FieldBillNumber, v))
+}
+
+// BillNumberHasSuffix applies the HasSuffix predicate on the "bill_number" field.
+func BillNumberHasSuffix(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldHasSuffix(FieldBillNumber, v))
+}
+
+// BillNumberEqualFold applies the EqualFold predicate on the "bill_number" field.
+func BillNumberEqualFold(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEqualFold(FieldBillNumber, v))
+}
+
+// BillNumberContainsFold applies the ContainsFold predicate on the "bill_number" field.
+func BillNumberContainsFold(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldContainsFold(FieldBillNumber, v))
+}
+
+// VendorReferenceEQ applies the EQ predicate on the "vendor_reference" field.
+func VendorReferenceEQ(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldVendorReference, v))
+}
+
+// VendorReferenceNEQ applies the NEQ predicate on the "vendor_reference" field.
+func VendorReferenceNEQ(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNEQ(FieldVendorReference, v))
+}
+
+// VendorReferenceIn applies the In predicate on the "vendor_reference" field.
+func VendorReferenceIn(vs ...string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIn(FieldVendorReference, vs...))
+}
+
+// VendorReferenceNotIn applies the NotIn predicate on the "vendor_reference" field.
+func VendorReferenceNotIn(vs ...string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotIn(FieldVendorReference, vs...))
+}
+
+// VendorReferenceGT applies the GT predicate on the "vendor_reference" field.
+func VendorReferenceGT(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGT(FieldVendorReference, v))
+}
+
+// VendorReferenceGTE applies the GTE predicate on the "vendor_reference" field.
+func VendorReferenceGTE(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGTE(FieldVendorReference, v))
+}
+
+// VendorReferenceLT applies the LT predicate on the "vendor_reference" field.
+func VendorReferenceLT(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLT(FieldVendorReference, v))
+}
+
+// VendorReferenceLTE applies the LTE predicate on the "vendor_reference" field.
+func VendorReferenceLTE(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLTE(FieldVendorReference, v))
+}
+
+// VendorReferenceContains applies the Contains predicate on the "vendor_reference" field.
+func VendorReferenceContains(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldContains(FieldVendorReference, v))
+}
+
+// VendorReferenceHasPrefix applies the HasPrefix predicate on the "vendor_reference" field.
+func VendorReferenceHasPrefix(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldHasPrefix(FieldVendorReference, v))
+}
+
+// VendorReferenceHasSuffix applies the HasSuffix predicate on the "vendor_reference" field.
+func VendorReferenceHasSuffix(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldHasSuffix(FieldVendorReference, v))
+}
+
+// VendorReferenceIsNil applies the IsNil predicate on the "vendor_reference" field.
+func VendorReferenceIsNil() predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIsNull(FieldVendorReference))
+}
+
+// VendorReferenceNotNil applies the NotNil predicate on the "vendor_reference" field.
+func VendorReferenceNotNil() predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotNull(FieldVendorReference))
+}
+
+// VendorReferenceEqualFold applies the EqualFold predicate on the "vendor_reference" field.
+func VendorReferenceEqualFold(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEqualFold(FieldVendorReference, v))
+}
+
+// VendorReferenceContainsFold applies the ContainsFold predicate on the "vendor_reference" field.
+func VendorReferenceContainsFold(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldContainsFold(FieldVendorReference, v))
+}
+
+// DueDateEQ applies the EQ predicate on the "due_date" field.
+func DueDateEQ(v time.Time) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldDueDate, v))
+}
+
+// DueDateNEQ applies the NEQ predicate on the "due_date" field.
+func DueDateNEQ(v time.Time) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNEQ(FieldDueDate, v))
+}
+
+// DueDateIn applies the In predicate on the "due_date" field.
+func DueDateIn(vs ...time.Time) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIn(FieldDueDate, vs...))
+}
+
+// DueDateNotIn applies the NotIn predicate on the "due_date" field.
+func DueDateNotIn(vs ...time.Time) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotIn(FieldDueDate, vs...))
+}
+
+// DueDateGT applies the GT predicate on the "due_date" field.
+func DueDateGT(v time.Time) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGT(FieldDueDate, v))
+}
+
+// DueDateGTE applies the GTE predicate on the "due_date" field.
+func DueDateGTE(v time.Time) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGTE(FieldDueDate, v))
+}
+
+// DueDateLT applies the LT predicate on the "due_date" field.
+func DueDateLT(v time.Time) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLT(FieldDueDate, v))
+}
+
+// DueDateLTE applies the LTE predicate on the "due_date" field.
+func DueDateLTE(v time.Time) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLTE(FieldDueDate, v))
+}
+
+// BalanceEQ applies the EQ predicate on the "balance" field.
+func BalanceEQ(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldBalance, v))
+}
+
+// BalanceNEQ applies the NEQ predicate on the "balance" field.
+func BalanceNEQ(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNEQ(FieldBalance, v))
+}
+
+// BalanceIn applies the In predicate on the "balance" field.
+func BalanceIn(vs ...decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIn(FieldBalance, vs...))
+}
+
+// BalanceNotIn applies the NotIn predicate on the "balance" field.
+func BalanceNotIn(vs ...decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotIn(FieldBalance, vs...))
+}
+
+// BalanceGT applies the GT predicate on the "balance" field.
+func BalanceGT(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGT(FieldBalance, v))
+}
+
+// BalanceGTE applies the GTE predicate on the "balance" field.
+func BalanceGTE(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGTE(FieldBalance, v))
+}
+
+// BalanceLT applies the LT predicate on the "balance" field.
+func BalanceLT(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLT(FieldBalance, v))
+}
+
+// BalanceLTE applies the LTE predicate on the "balance" field.
+func BalanceLTE(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLTE(FieldBalance, v))
+}
+
+// DiscountAmountEQ applies the EQ predicate on the "discount_amount" field.
+func DiscountAmountEQ(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountNEQ applies the NEQ predicate on the "discount_amount" field.
+func DiscountAmountNEQ(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountIn applies the In predicate on the "discount_amount" field.
+func DiscountAmountIn(vs ...decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountNotIn applies the NotIn predicate on the "discount_amount" field.
+func DiscountAmountNotIn(vs ...decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountGT applies the GT predicate on the "discount_amount" field.
+func DiscountAmountGT(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountGTE applies the GTE predicate on the "discount_amount" field.
+func DiscountAmountGTE(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGTE(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLT applies the LT predicate on the "discount_amount" field.
+func DiscountAmountLT(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLTE applies the LTE predicate on the "discount_amount" field.
+func DiscountAmountLTE(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLTE(FieldDiscountAmount, v))
+}
+
+// DiscountAmountIsNil applies the IsNil predicate on the "discount_amount" field.
+func DiscountAmountIsNil() predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIsNull(FieldDiscountAmount))
+}
+
+// DiscountAmountNotNil applies the NotNil predicate on the "discount_amount" field.
+func DiscountAmountNotNil() predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotNull(FieldDiscountAmount))
+}
+
+// WithholdingAmountEQ applies the EQ predicate on the "withholding_amount" field.
+func WithholdingAmountEQ(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountNEQ applies the NEQ predicate on the "withholding_amount" field.
+func WithholdingAmountNEQ(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNEQ(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountIn applies the In predicate on the "withholding_amount" field.
+func WithholdingAmountIn(vs ...decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIn(FieldWithholdingAmount, vs...))
+}
+
+// WithholdingAmountNotIn applies the NotIn predicate on the "withholding_amount" field.
+func WithholdingAmountNotIn(vs ...decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotIn(FieldWithholdingAmount, vs...))
+}
+
+// WithholdingAmountGT applies the GT predicate on the "withholding_amount" field.
+func WithholdingAmountGT(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGT(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountGTE applies the GTE predicate on the "withholding_amount" field.
+func WithholdingAmountGTE(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGTE(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountLT applies the LT predicate on the "withholding_amount" field.
+func WithholdingAmountLT(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLT(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountLTE applies the LTE predicate on the "withholding_amount" field.
+func WithholdingAmountLTE(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLTE(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountIsNil applies the IsNil predicate on the "withholding_amount" field.
+func WithholdingAmountIsNil() predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIsNull(FieldWithholdingAmount))
+}
+
+// WithholdingAmountNotNil applies the NotNil predicate on the "withholding_amount" field.
+func WithholdingAmountNotNil() predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotNull(FieldWithholdingAmount))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLTE(FieldAmount, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// RemittanceKeyEQ applies the EQ predicate on the "remittance_key" field.
+func RemittanceKeyEQ(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldRemittanceKey, v))
+}
+
+// RemittanceKeyNEQ applies the NEQ predicate on the "remittance_key" field.
+func RemittanceKeyNEQ(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNEQ(FieldRemittanceKey, v))
+}
+
+// RemittanceKeyIn applies the In predicate on the "remittance_key" field.
+func RemittanceKeyIn(vs ...string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIn(FieldRemittanceKey, vs...))
+}
+
+// RemittanceKeyNotIn applies the NotIn predicate on the "remittance_key" field.
+func RemittanceKeyNotIn(vs ...string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotIn(FieldRemittanceKey, vs...))
+}
+
+// RemittanceKeyGT applies the GT predicate on the "remittance_key" field.
+func RemittanceKeyGT(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGT(FieldRemittanceKey, v))
+}
+
+// RemittanceKeyGTE applies the GTE predicate on the "remittance_key" field.
+func RemittanceKeyGTE(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGTE(FieldRemittanceKey, v))
+}
+
+// RemittanceKeyLT applies the LT predicate on the "remittance_key" field.
+func RemittanceKeyLT(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLT(FieldRemittanceKey, v))
+}
+
+// RemittanceKeyLTE applies the LTE predicate on the "remittance_key" field.
+func RemittanceKeyLTE(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLTE(FieldRemittanceKey, v))
+}
+
+// RemittanceKeyContains applies the Contains predicate on the "remittance_key" field.
+func RemittanceKeyContains(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldContains(FieldRemittanceKey, v))
+}
+
+// RemittanceKeyHasPrefix applies the HasPrefix predicate on the "remittance_key" field.
+func RemittanceKeyHasPrefix(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldHasPrefix(FieldRemittanceKey, v))
+}
+
+// RemittanceKeyHasSuffix applies the HasSuffix predicate on the "remittance_key" field.
+func RemittanceKeyHasSuffix(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldHasSuffix(FieldRemittanceKey, v))
+}
+
+// RemittanceKeyIsNil applies the IsNil predicate on the "remittance_key" field.
+func RemittanceKeyIsNil() predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIsNull(FieldRemittanceKey))
+}
+
+// RemittanceKeyNotNil applies the NotNil predicate on the "remittance_key" field.
+func RemittanceKeyNotNil() predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotNull(FieldRemittanceKey))
+}
+
+// RemittanceKeyEqualFold applies the EqualFold predicate on the "remittance_key" field.
+func RemittanceKeyEqualFold(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEqualFold(FieldRemittanceKey, v))
+}
+
+// RemittanceKeyContainsFold applies the ContainsFold predicate on the "remittance_key" field.
+func RemittanceKeyContainsFold(v string) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldContainsFold(FieldRemittanceKey, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasRun applies the HasEdge predicate on the "run" edge.
+func HasRun() predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunWith applies the HasEdge predicate on the "run" edge with a given conditions (other predicates).
+func HasRunWith(preds ...predicate.PaymentRun) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(func(s *sql.Selector) {
+		step := newRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaymentRunItem) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaymentRunItem) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaymentRunItem) predicate.PaymentRunItem {
+	return predicate.PaymentRunItem(sql.NotPredicates(p))
+}
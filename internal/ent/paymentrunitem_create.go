@@ -0,0 +1,1391 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrun"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrunitem"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRunItemCreate is the builder for creating a PaymentRunItem entity.
+type PaymentRunItemCreate struct {
+	config
+	mutation *PaymentRunItemMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *PaymentRunItemCreate) SetTenantID(v uuid.UUID) *PaymentRunItemCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetRunID sets the "run_id" field.
+func (_c *PaymentRunItemCreate) SetRunID(v uuid.UUID) *PaymentRunItemCreate {
+	_c.mutation.SetRunID(v)
+	return _c
+}
+
+// SetBillID sets the "bill_id" field.
+func (_c *PaymentRunItemCreate) SetBillID(v uuid.UUID) *PaymentRunItemCreate {
+	_c.mutation.SetBillID(v)
+	return _c
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_c *PaymentRunItemCreate) SetVendorID(v uuid.UUID) *PaymentRunItemCreate {
+	_c.mutation.SetVendorID(v)
+	return _c
+}
+
+// SetBillNumber sets the "bill_number" field.
+func (_c *PaymentRunItemCreate) SetBillNumber(v string) *PaymentRunItemCreate {
+	_c.mutation.SetBillNumber(v)
+	return _c
+}
+
+// SetVendorReference sets the "vendor_reference" field.
+func (_c *PaymentRunItemCreate) SetVendorReference(v string) *PaymentRunItemCreate {
+	_c.mutation.SetVendorReference(v)
+	return _c
+}
+
+// SetNillableVendorReference sets the "vendor_reference" field if the given value is not nil.
+func (_c *PaymentRunItemCreate) SetNillableVendorReference(v *string) *PaymentRunItemCreate {
+	if v != nil {
+		_c.SetVendorReference(*v)
+	}
+	return _c
+}
+
+// SetDueDate sets the "due_date" field.
+func (_c *PaymentRunItemCreate) SetDueDate(v time.Time) *PaymentRunItemCreate {
+	_c.mutation.SetDueDate(v)
+	return _c
+}
+
+// SetBalance sets the "balance" field.
+func (_c *PaymentRunItemCreate) SetBalance(v decimal.Decimal) *PaymentRunItemCreate {
+	_c.mutation.SetBalance(v)
+	return _c
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_c *PaymentRunItemCreate) SetDiscountAmount(v decimal.Decimal) *PaymentRunItemCreate {
+	_c.mutation.SetDiscountAmount(v)
+	return _c
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_c *PaymentRunItemCreate) SetNillableDiscountAmount(v *decimal.Decimal) *PaymentRunItemCreate {
+	if v != nil {
+		_c.SetDiscountAmount(*v)
+	}
+	return _c
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (_c *PaymentRunItemCreate) SetWithholdingAmount(v decimal.Decimal) *PaymentRunItemCreate {
+	_c.mutation.SetWithholdingAmount(v)
+	return _c
+}
+
+// SetNillableWithholdingAmount sets the "withholding_amount" field if the given value is not nil.
+func (_c *PaymentRunItemCreate) SetNillableWithholdingAmount(v *decimal.Decimal) *PaymentRunItemCreate {
+	if v != nil {
+		_c.SetWithholdingAmount(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *PaymentRunItemCreate) SetAmount(v decimal.Decimal) *PaymentRunItemCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *PaymentRunItemCreate) SetStatus(v string) *PaymentRunItemCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PaymentRunItemCreate) SetNillableStatus(v *string) *PaymentRunItemCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetRemittanceKey sets the "remittance_key" field.
+func (_c *PaymentRunItemCreate) SetRemittanceKey(v string) *PaymentRunItemCreate {
+	_c.mutation.SetRemittanceKey(v)
+	return _c
+}
+
+// SetNillableRemittanceKey sets the "remittance_key" field if the given value is not nil.
+func (_c *PaymentRunItemCreate) SetNillableRemittanceKey(v *string) *PaymentRunItemCreate {
+	if v != nil {
+		_c.SetRemittanceKey(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PaymentRunItemCreate) SetCreatedAt(v time.Time) *PaymentRunItemCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PaymentRunItemCreate) SetNillableCreatedAt(v *time.Time) *PaymentRunItemCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PaymentRunItemCreate) SetID(v uuid.UUID) *PaymentRunItemCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PaymentRunItemCreate) SetNillableID(v *uuid.UUID) *PaymentRunItemCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetRun sets the "run" edge to the PaymentRun entity.
+func (_c *PaymentRunItemCreate) SetRun(v *PaymentRun) *PaymentRunItemCreate {
+	return _c.SetRunID(v.ID)
+}
+
+// Mutation returns the PaymentRunItemMutation object of the builder.
+func (_c *PaymentRunItemCreate) Mutation() *PaymentRunItemMutation {
+	return _c.mutation
+}
+
+// Save creates the PaymentRunItem in the database.
+func (_c *PaymentRunItemCreate) Save(ctx context.Context) (*PaymentRunItem, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PaymentRunItemCreate) SaveX(ctx context.Context) *PaymentRunItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentRunItemCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentRunItemCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PaymentRunItemCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := paymentrunitem.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := paymentrunitem.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := paymentrunitem.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PaymentRunItemCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "PaymentRunItem.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.RunID(); !ok {
+		return &ValidationError{Name: "run_id", err: errors.New(`ent: missing required field "PaymentRunItem.run_id"`)}
+	}
+	if _, ok := _c.mutation.BillID(); !ok {
+		return &ValidationError{Name: "bill_id", err: errors.New(`ent: missing required field "PaymentRunItem.bill_id"`)}
+	}
+	if _, ok := _c.mutation.VendorID(); !ok {
+		return &ValidationError{Name: "vendor_id", err: errors.New(`ent: missing required field "PaymentRunItem.vendor_id"`)}
+	}
+	if _, ok := _c.mutation.BillNumber(); !ok {
+		return &ValidationError{Name: "bill_number", err: errors.New(`ent: missing required field "PaymentRunItem.bill_number"`)}
+	}
+	if _, ok := _c.mutation.DueDate(); !ok {
+		return &ValidationError{Name: "due_date", err: errors.New(`ent: missing required field "PaymentRunItem.due_date"`)}
+	}
+	if _, ok := _c.mutation.Balance(); !ok {
+		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "PaymentRunItem.balance"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "PaymentRunItem.amount"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PaymentRunItem.status"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PaymentRunItem.created_at"`)}
+	}
+	if len(_c.mutation.RunIDs()) == 0 {
+		return &ValidationError{Name: "run", err: errors.New(`ent: missing required edge "PaymentRunItem.run"`)}
+	}
+	return nil
+}
+
+func (_c *PaymentRunItemCreate) sqlSave(ctx context.Context) (*PaymentRunItem, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PaymentRunItemCreate) createSpec() (*PaymentRunItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentRunItem{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(paymentrunitem.Table, sqlgraph.NewFieldSpec(paymentrunitem.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(paymentrunitem.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.BillID(); ok {
+		_spec.SetField(paymentrunitem.FieldBillID, field.TypeUUID, value)
+		_node.BillID = value
+	}
+	if value, ok := _c.mutation.VendorID(); ok {
+		_spec.SetField(paymentrunitem.FieldVendorID, field.TypeUUID, value)
+		_node.VendorID = value
+	}
+	if value, ok := _c.mutation.BillNumber(); ok {
+		_spec.SetField(paymentrunitem.FieldBillNumber, field.TypeString, value)
+		_node.BillNumber = value
+	}
+	if value, ok := _c.mutation.VendorReference(); ok {
+		_spec.SetField(paymentrunitem.FieldVendorReference, field.TypeString, value)
+		_node.VendorReference = value
+	}
+	if value, ok := _c.mutation.DueDate(); ok {
+		_spec.SetField(paymentrunitem.FieldDueDate, field.TypeTime, value)
+		_node.DueDate = value
+	}
+	if value, ok := _c.mutation.Balance(); ok {
+		_spec.SetField(paymentrunitem.FieldBalance, field.TypeFloat64, value)
+		_node.Balance = value
+	}
+	if value, ok := _c.mutation.DiscountAmount(); ok {
+		_spec.SetField(paymentrunitem.FieldDiscountAmount, field.TypeFloat64, value)
+		_node.DiscountAmount = value
+	}
+	if value, ok := _c.mutation.WithholdingAmount(); ok {
+		_spec.SetField(paymentrunitem.FieldWithholdingAmount, field.TypeFloat64, value)
+		_node.WithholdingAmount = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(paymentrunitem.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(paymentrunitem.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.RemittanceKey(); ok {
+		_spec.SetField(paymentrunitem.FieldRemittanceKey, field.TypeString, value)
+		_node.RemittanceKey = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(paymentrunitem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrunitem.RunTable,
+			Columns: []string{paymentrunitem.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RunID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentRunItem.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentRunItemUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PaymentRunItemCreate) OnConflict(opts ...sql.ConflictOption) *PaymentRunItemUpsertOne {
+	_c.conflict = opts
+	return &PaymentRunItemUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentRunItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PaymentRunItemCreate) OnConflictColumns(columns ...string) *PaymentRunItemUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PaymentRunItemUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PaymentRunItemUpsertOne is the builder for "upsert"-ing
+	//  one PaymentRunItem node.
+	PaymentRunItemUpsertOne struct {
+		create *PaymentRunItemCreate
+	}
+
+	// PaymentRunItemUpsert is the "OnConflict" setter.
+	PaymentRunItemUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *PaymentRunItemUpsert) SetTenantID(v uuid.UUID) *PaymentRunItemUpsert {
+	u.Set(paymentrunitem.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *PaymentRunItemUpsert) UpdateTenantID() *PaymentRunItemUpsert {
+	u.SetExcluded(paymentrunitem.FieldTenantID)
+	return u
+}
+
+// SetRunID sets the "run_id" field.
+func (u *PaymentRunItemUpsert) SetRunID(v uuid.UUID) *PaymentRunItemUpsert {
+	u.Set(paymentrunitem.FieldRunID, v)
+	return u
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *PaymentRunItemUpsert) UpdateRunID() *PaymentRunItemUpsert {
+	u.SetExcluded(paymentrunitem.FieldRunID)
+	return u
+}
+
+// SetBillID sets the "bill_id" field.
+func (u *PaymentRunItemUpsert) SetBillID(v uuid.UUID) *PaymentRunItemUpsert {
+	u.Set(paymentrunitem.FieldBillID, v)
+	return u
+}
+
+// UpdateBillID sets the "bill_id" field to the value that was provided on create.
+func (u *PaymentRunItemUpsert) UpdateBillID() *PaymentRunItemUpsert {
+	u.SetExcluded(paymentrunitem.FieldBillID)
+	return u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (u *PaymentRunItemUpsert) SetVendorID(v uuid.UUID) *PaymentRunItemUpsert {
+	u.Set(paymentrunitem.FieldVendorID, v)
+	return u
+}
+
+// UpdateVendorID sets the "vendor_id" field to the value that was provided on create.
+func (u *PaymentRunItemUpsert) UpdateVendorID() *PaymentRunItemUpsert {
+	u.SetExcluded(paymentrunitem.FieldVendorID)
+	return u
+}
+
+// SetBillNumber sets the "bill_number" field.
+func (u *PaymentRunItemUpsert) SetBillNumber(v string) *PaymentRunItemUpsert {
+	u.Set(paymentrunitem.FieldBillNumber, v)
+	return u
+}
+
+// UpdateBillNumber sets the "bill_number" field to the value that was provided on create.
+func (u *PaymentRunItemUpsert) UpdateBillNumber() *PaymentRunItemUpsert {
+	u.SetExcluded(paymentrunitem.FieldBillNumber)
+	return u
+}
+
+// SetVendorReference sets the "vendor_reference" field.
+func (u *PaymentRunItemUpsert) SetVendorReference(v string) *PaymentRunItemUpsert {
+	u.Set(paymentrunitem.FieldVendorReference, v)
+	return u
+}
+
+// UpdateVendorReference sets the "vendor_reference" field to the value that was provided on create.
+func (u *PaymentRunItemUpsert) UpdateVendorReference() *PaymentRunItemUpsert {
+	u.SetExcluded(paymentrunitem.FieldVendorReference)
+	return u
+}
+
+// ClearVendorReference clears the value of the "vendor_reference" field.
+func (u *PaymentRunItemUpsert) ClearVendorReference() *PaymentRunItemUpsert {
+	u.SetNull(paymentrunitem.FieldVendorReference)
+	return u
+}
+
+// SetDueDate sets the "due_date" field.
+func (u *PaymentRunItemUpsert) SetDueDate(v time.Time) *PaymentRunItemUpsert {
+	u.Set(paymentrunitem.FieldDueDate, v)
+	return u
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *PaymentRunItemUpsert) UpdateDueDate() *PaymentRunItemUpsert {
+	u.SetExcluded(paymentrunitem.FieldDueDate)
+	return u
+}
+
+// SetBalance sets the "balance" field.
+func (u *PaymentRunItemUpsert) SetBalance(v decimal.Decimal) *PaymentRunItemUpsert {
+	u.Set(paymentrunitem.FieldBalance, v)
+	return u
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *PaymentRunItemUpsert) UpdateBalance() *PaymentRunItemUpsert {
+	u.SetExcluded(paymentrunitem.FieldBalance)
+	return u
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *PaymentRunItemUpsert) AddBalance(v decimal.Decimal) *PaymentRunItemUpsert {
+	u.Add(paymentrunitem.FieldBalance, v)
+	return u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *PaymentRunItemUpsert) SetDiscountAmount(v decimal.Decimal) *PaymentRunItemUpsert {
+	u.Set(paymentrunitem.FieldDiscountAmount, v)
+	return u
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *PaymentRunItemUpsert) UpdateDiscountAmount() *PaymentRunItemUpsert {
+	u.SetExcluded(paymentrunitem.FieldDiscountAmount)
+	return u
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *PaymentRunItemUpsert) AddDiscountAmount(v decimal.Decimal) *PaymentRunItemUpsert {
+	u.Add(paymentrunitem.FieldDiscountAmount, v)
+	return u
+}
+
+// ClearDiscountAmount clears the value of the "discount_amount" field.
+func (u *PaymentRunItemUpsert) ClearDiscountAmount() *PaymentRunItemUpsert {
+	u.SetNull(paymentrunitem.FieldDiscountAmount)
+	return u
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (u *PaymentRunItemUpsert) SetWithholdingAmount(v decimal.Decimal) *PaymentRunItemUpsert {
+	u.Set(paymentrunitem.FieldWithholdingAmount, v)
+	return u
+}
+
+// UpdateWithholdingAmount sets the "withholding_amount" field to the value that was provided on create.
+func (u *PaymentRunItemUpsert) UpdateWithholdingAmount() *PaymentRunItemUpsert {
+	u.SetExcluded(paymentrunitem.FieldWithholdingAmount)
+	return u
+}
+
+// AddWithholdingAmount adds v to the "withholding_amount" field.
+func (u *PaymentRunItemUpsert) AddWithholdingAmount(v decimal.Decimal) *PaymentRunItemUpsert {
+	u.Add(paymentrunitem.FieldWithholdingAmount, v)
+	return u
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (u *PaymentRunItemUpsert) ClearWithholdingAmount() *PaymentRunItemUpsert {
+	u.SetNull(paymentrunitem.FieldWithholdingAmount)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *PaymentRunItemUpsert) SetAmount(v decimal.Decimal) *PaymentRunItemUpsert {
+	u.Set(paymentrunitem.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *PaymentRunItemUpsert) UpdateAmount() *PaymentRunItemUpsert {
+	u.SetExcluded(paymentrunitem.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *PaymentRunItemUpsert) AddAmount(v decimal.Decimal) *PaymentRunItemUpsert {
+	u.Add(paymentrunitem.FieldAmount, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PaymentRunItemUpsert) SetStatus(v string) *PaymentRunItemUpsert {
+	u.Set(paymentrunitem.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PaymentRunItemUpsert) UpdateStatus() *PaymentRunItemUpsert {
+	u.SetExcluded(paymentrunitem.FieldStatus)
+	return u
+}
+
+// SetRemittanceKey sets the "remittance_key" field.
+func (u *PaymentRunItemUpsert) SetRemittanceKey(v string) *PaymentRunItemUpsert {
+	u.Set(paymentrunitem.FieldRemittanceKey, v)
+	return u
+}
+
+// UpdateRemittanceKey sets the "remittance_key" field to the value that was provided on create.
+func (u *PaymentRunItemUpsert) UpdateRemittanceKey() *PaymentRunItemUpsert {
+	u.SetExcluded(paymentrunitem.FieldRemittanceKey)
+	return u
+}
+
+// ClearRemittanceKey clears the value of the "remittance_key" field.
+func (u *PaymentRunItemUpsert) ClearRemittanceKey() *PaymentRunItemUpsert {
+	u.SetNull(paymentrunitem.FieldRemittanceKey)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PaymentRunItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(paymentrunitem.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PaymentRunItemUpsertOne) UpdateNewValues() *PaymentRunItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(paymentrunitem.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(paymentrunitem.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentRunItem.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PaymentRunItemUpsertOne) Ignore() *PaymentRunItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentRunItemUpsertOne) DoNothing() *PaymentRunItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentRunItemCreate.OnConflict
+// documentation for more info.
+func (u *PaymentRunItemUpsertOne) Update(set func(*PaymentRunItemUpsert)) *PaymentRunItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentRunItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *PaymentRunItemUpsertOne) SetTenantID(v uuid.UUID) *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertOne) UpdateTenantID() *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetRunID sets the "run_id" field.
+func (u *PaymentRunItemUpsertOne) SetRunID(v uuid.UUID) *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertOne) UpdateRunID() *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// SetBillID sets the "bill_id" field.
+func (u *PaymentRunItemUpsertOne) SetBillID(v uuid.UUID) *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetBillID(v)
+	})
+}
+
+// UpdateBillID sets the "bill_id" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertOne) UpdateBillID() *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateBillID()
+	})
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (u *PaymentRunItemUpsertOne) SetVendorID(v uuid.UUID) *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetVendorID(v)
+	})
+}
+
+// UpdateVendorID sets the "vendor_id" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertOne) UpdateVendorID() *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateVendorID()
+	})
+}
+
+// SetBillNumber sets the "bill_number" field.
+func (u *PaymentRunItemUpsertOne) SetBillNumber(v string) *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetBillNumber(v)
+	})
+}
+
+// UpdateBillNumber sets the "bill_number" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertOne) UpdateBillNumber() *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateBillNumber()
+	})
+}
+
+// SetVendorReference sets the "vendor_reference" field.
+func (u *PaymentRunItemUpsertOne) SetVendorReference(v string) *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetVendorReference(v)
+	})
+}
+
+// UpdateVendorReference sets the "vendor_reference" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertOne) UpdateVendorReference() *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateVendorReference()
+	})
+}
+
+// ClearVendorReference clears the value of the "vendor_reference" field.
+func (u *PaymentRunItemUpsertOne) ClearVendorReference() *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.ClearVendorReference()
+	})
+}
+
+// SetDueDate sets the "due_date" field.
+func (u *PaymentRunItemUpsertOne) SetDueDate(v time.Time) *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetDueDate(v)
+	})
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertOne) UpdateDueDate() *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateDueDate()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *PaymentRunItemUpsertOne) SetBalance(v decimal.Decimal) *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *PaymentRunItemUpsertOne) AddBalance(v decimal.Decimal) *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertOne) UpdateBalance() *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *PaymentRunItemUpsertOne) SetDiscountAmount(v decimal.Decimal) *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetDiscountAmount(v)
+	})
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *PaymentRunItemUpsertOne) AddDiscountAmount(v decimal.Decimal) *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.AddDiscountAmount(v)
+	})
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertOne) UpdateDiscountAmount() *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateDiscountAmount()
+	})
+}
+
+// ClearDiscountAmount clears the value of the "discount_amount" field.
+func (u *PaymentRunItemUpsertOne) ClearDiscountAmount() *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.ClearDiscountAmount()
+	})
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (u *PaymentRunItemUpsertOne) SetWithholdingAmount(v decimal.Decimal) *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetWithholdingAmount(v)
+	})
+}
+
+// AddWithholdingAmount adds v to the "withholding_amount" field.
+func (u *PaymentRunItemUpsertOne) AddWithholdingAmount(v decimal.Decimal) *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.AddWithholdingAmount(v)
+	})
+}
+
+// UpdateWithholdingAmount sets the "withholding_amount" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertOne) UpdateWithholdingAmount() *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateWithholdingAmount()
+	})
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (u *PaymentRunItemUpsertOne) ClearWithholdingAmount() *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.ClearWithholdingAmount()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *PaymentRunItemUpsertOne) SetAmount(v decimal.Decimal) *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *PaymentRunItemUpsertOne) AddAmount(v decimal.Decimal) *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertOne) UpdateAmount() *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PaymentRunItemUpsertOne) SetStatus(v string) *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertOne) UpdateStatus() *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRemittanceKey sets the "remittance_key" field.
+func (u *PaymentRunItemUpsertOne) SetRemittanceKey(v string) *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetRemittanceKey(v)
+	})
+}
+
+// UpdateRemittanceKey sets the "remittance_key" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertOne) UpdateRemittanceKey() *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateRemittanceKey()
+	})
+}
+
+// ClearRemittanceKey clears the value of the "remittance_key" field.
+func (u *PaymentRunItemUpsertOne) ClearRemittanceKey() *PaymentRunItemUpsertOne {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.ClearRemittanceKey()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentRunItemUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentRunItemCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentRunItemUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PaymentRunItemUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PaymentRunItemUpsertOne.ID is not supported by MySQL driver. Use PaymentRunItemUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PaymentRunItemUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PaymentRunItemCreateBulk is the builder for creating many PaymentRunItem entities in bulk.
+type PaymentRunItemCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentRunItemCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PaymentRunItem entities in the database.
+func (_c *PaymentRunItemCreateBulk) Save(ctx context.Context) ([]*PaymentRunItem, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PaymentRunItem, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentRunItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PaymentRunItemCreateBulk) SaveX(ctx context.Context) []*PaymentRunItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentRunItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentRunItemCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentRunItem.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentRunItemUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PaymentRunItemCreateBulk) OnConflict(opts ...sql.ConflictOption) *PaymentRunItemUpsertBulk {
+	_c.conflict = opts
+	return &PaymentRunItemUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentRunItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PaymentRunItemCreateBulk) OnConflictColumns(columns ...string) *PaymentRunItemUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PaymentRunItemUpsertBulk{
+		create: _c,
+	}
+}
+
+// PaymentRunItemUpsertBulk is the builder for "upsert"-ing
+// a bulk of PaymentRunItem nodes.
+type PaymentRunItemUpsertBulk struct {
+	create *PaymentRunItemCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PaymentRunItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(paymentrunitem.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PaymentRunItemUpsertBulk) UpdateNewValues() *PaymentRunItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(paymentrunitem.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(paymentrunitem.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentRunItem.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PaymentRunItemUpsertBulk) Ignore() *PaymentRunItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentRunItemUpsertBulk) DoNothing() *PaymentRunItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentRunItemCreateBulk.OnConflict
+// documentation for more info.
+func (u *PaymentRunItemUpsertBulk) Update(set func(*PaymentRunItemUpsert)) *PaymentRunItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentRunItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *PaymentRunItemUpsertBulk) SetTenantID(v uuid.UUID) *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertBulk) UpdateTenantID() *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetRunID sets the "run_id" field.
+func (u *PaymentRunItemUpsertBulk) SetRunID(v uuid.UUID) *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertBulk) UpdateRunID() *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// SetBillID sets the "bill_id" field.
+func (u *PaymentRunItemUpsertBulk) SetBillID(v uuid.UUID) *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetBillID(v)
+	})
+}
+
+// UpdateBillID sets the "bill_id" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertBulk) UpdateBillID() *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateBillID()
+	})
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (u *PaymentRunItemUpsertBulk) SetVendorID(v uuid.UUID) *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetVendorID(v)
+	})
+}
+
+// UpdateVendorID sets the "vendor_id" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertBulk) UpdateVendorID() *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateVendorID()
+	})
+}
+
+// SetBillNumber sets the "bill_number" field.
+func (u *PaymentRunItemUpsertBulk) SetBillNumber(v string) *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetBillNumber(v)
+	})
+}
+
+// UpdateBillNumber sets the "bill_number" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertBulk) UpdateBillNumber() *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateBillNumber()
+	})
+}
+
+// SetVendorReference sets the "vendor_reference" field.
+func (u *PaymentRunItemUpsertBulk) SetVendorReference(v string) *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetVendorReference(v)
+	})
+}
+
+// UpdateVendorReference sets the "vendor_reference" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertBulk) UpdateVendorReference() *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateVendorReference()
+	})
+}
+
+// ClearVendorReference clears the value of the "vendor_reference" field.
+func (u *PaymentRunItemUpsertBulk) ClearVendorReference() *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.ClearVendorReference()
+	})
+}
+
+// SetDueDate sets the "due_date" field.
+func (u *PaymentRunItemUpsertBulk) SetDueDate(v time.Time) *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetDueDate(v)
+	})
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertBulk) UpdateDueDate() *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateDueDate()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *PaymentRunItemUpsertBulk) SetBalance(v decimal.Decimal) *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *PaymentRunItemUpsertBulk) AddBalance(v decimal.Decimal) *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertBulk) UpdateBalance() *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *PaymentRunItemUpsertBulk) SetDiscountAmount(v decimal.Decimal) *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetDiscountAmount(v)
+	})
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *PaymentRunItemUpsertBulk) AddDiscountAmount(v decimal.Decimal) *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.AddDiscountAmount(v)
+	})
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertBulk) UpdateDiscountAmount() *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateDiscountAmount()
+	})
+}
+
+// ClearDiscountAmount clears the value of the "discount_amount" field.
+func (u *PaymentRunItemUpsertBulk) ClearDiscountAmount() *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.ClearDiscountAmount()
+	})
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (u *PaymentRunItemUpsertBulk) SetWithholdingAmount(v decimal.Decimal) *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetWithholdingAmount(v)
+	})
+}
+
+// AddWithholdingAmount adds v to the "withholding_amount" field.
+func (u *PaymentRunItemUpsertBulk) AddWithholdingAmount(v decimal.Decimal) *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.AddWithholdingAmount(v)
+	})
+}
+
+// UpdateWithholdingAmount sets the "withholding_amount" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertBulk) UpdateWithholdingAmount() *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateWithholdingAmount()
+	})
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (u *PaymentRunItemUpsertBulk) ClearWithholdingAmount() *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.ClearWithholdingAmount()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *PaymentRunItemUpsertBulk) SetAmount(v decimal.Decimal) *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *PaymentRunItemUpsertBulk) AddAmount(v decimal.Decimal) *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertBulk) UpdateAmount() *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PaymentRunItemUpsertBulk) SetStatus(v string) *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertBulk) UpdateStatus() *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRemittanceKey sets the "remittance_key" field.
+func (u *PaymentRunItemUpsertBulk) SetRemittanceKey(v string) *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.SetRemittanceKey(v)
+	})
+}
+
+// UpdateRemittanceKey sets the "remittance_key" field to the value that was provided on create.
+func (u *PaymentRunItemUpsertBulk) UpdateRemittanceKey() *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.UpdateRemittanceKey()
+	})
+}
+
+// ClearRemittanceKey clears the value of the "remittance_key" field.
+func (u *PaymentRunItemUpsertBulk) ClearRemittanceKey() *PaymentRunItemUpsertBulk {
+	return u.Update(func(s *PaymentRunItemUpsert) {
+		s.ClearRemittanceKey()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentRunItemUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PaymentRunItemCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentRunItemCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentRunItemUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,869 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrun"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrunitem"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRunItemUpdate is the builder for updating PaymentRunItem entities.
+type PaymentRunItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentRunItemMutation
+}
+
+// Where appends a list predicates to the PaymentRunItemUpdate builder.
+func (_u *PaymentRunItemUpdate) Where(ps ...predicate.PaymentRunItem) *PaymentRunItemUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *PaymentRunItemUpdate) SetTenantID(v uuid.UUID) *PaymentRunItemUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *PaymentRunItemUpdate) SetNillableTenantID(v *uuid.UUID) *PaymentRunItemUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *PaymentRunItemUpdate) SetRunID(v uuid.UUID) *PaymentRunItemUpdate {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *PaymentRunItemUpdate) SetNillableRunID(v *uuid.UUID) *PaymentRunItemUpdate {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// SetBillID sets the "bill_id" field.
+func (_u *PaymentRunItemUpdate) SetBillID(v uuid.UUID) *PaymentRunItemUpdate {
+	_u.mutation.SetBillID(v)
+	return _u
+}
+
+// SetNillableBillID sets the "bill_id" field if the given value is not nil.
+func (_u *PaymentRunItemUpdate) SetNillableBillID(v *uuid.UUID) *PaymentRunItemUpdate {
+	if v != nil {
+		_u.SetBillID(*v)
+	}
+	return _u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_u *PaymentRunItemUpdate) SetVendorID(v uuid.UUID) *PaymentRunItemUpdate {
+	_u.mutation.SetVendorID(v)
+	return _u
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_u *PaymentRunItemUpdate) SetNillableVendorID(v *uuid.UUID) *PaymentRunItemUpdate {
+	if v != nil {
+		_u.SetVendorID(*v)
+	}
+	return _u
+}
+
+// SetBillNumber sets the "bill_number" field.
+func (_u *PaymentRunItemUpdate) SetBillNumber(v string) *PaymentRunItemUpdate {
+	_u.mutation.SetBillNumber(v)
+	return _u
+}
+
+// SetNillableBillNumber sets the "bill_number" field if the given value is not nil.
+func (_u *PaymentRunItemUpdate) SetNillableBillNumber(v *string) *PaymentRunItemUpdate {
+	if v != nil {
+		_u.SetBillNumber(*v)
+	}
+	return _u
+}
+
+// SetVendorReference sets the "vendor_reference" field.
+func (_u *PaymentRunItemUpdate) SetVendorReference(v string) *PaymentRunItemUpdate {
+	_u.mutation.SetVendorReference(v)
+	return _u
+}
+
+// SetNillableVendorReference sets the "vendor_reference" field if the given value is not nil.
+func (_u *PaymentRunItemUpdate) SetNillableVendorReference(v *string) *PaymentRunItemUpdate {
+	if v != nil {
+		_u.SetVendorReference(*v)
+	}
+	return _u
+}
+
+// ClearVendorReference clears the value of the "vendor_reference" field.
+func (_u *PaymentRunItemUpdate) ClearVendorReference() *PaymentRunItemUpdate {
+	_u.mutation.ClearVendorReference()
+	return _u
+}
+
+// SetDueDate sets the "due_date" field.
+func (_u *PaymentRunItemUpdate) SetDueDate(v time.Time) *PaymentRunItemUpdate {
+	_u.mutation.SetDueDate(v)
+	return _u
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (_u *PaymentRunItemUpdate) SetNillableDueDate(v *time.Time) *PaymentRunItemUpdate {
+	if v != nil {
+		_u.SetDueDate(*v)
+	}
+	return _u
+}
+
+// SetBalance sets the "balance" field.
+func (_u *PaymentRunItemUpdate) SetBalance(v decimal.Decimal) *PaymentRunItemUpdate {
+	_u.mutation.ResetBalance()
+	_u.mutation.SetBalance(v)
+	return _u
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_u *PaymentRunItemUpdate) SetNillableBalance(v *decimal.Decimal) *PaymentRunItemUpdate {
+	if v != nil {
+		_u.SetBalance(*v)
+	}
+	return _u
+}
+
+// AddBalance adds value to the "balance" field.
+func (_u *PaymentRunItemUpdate) AddBalance(v decimal.Decimal) *PaymentRunItemUpdate {
+	_u.mutation.AddBalance(v)
+	return _u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *PaymentRunItemUpdate) SetDiscountAmount(v decimal.Decimal) *PaymentRunItemUpdate {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *PaymentRunItemUpdate) SetNillableDiscountAmount(v *decimal.Decimal) *PaymentRunItemUpdate {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *PaymentRunItemUpdate) AddDiscountAmount(v decimal.Decimal) *PaymentRunItemUpdate {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
+// ClearDiscountAmount clears the value of the "discount_amount" field.
+func (_u *PaymentRunItemUpdate) ClearDiscountAmount() *PaymentRunItemUpdate {
+	_u.mutation.ClearDiscountAmount()
+	return _u
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (_u *PaymentRunItemUpdate) SetWithholdingAmount(v decimal.Decimal) *PaymentRunItemUpdate {
+	_u.mutation.ResetWithholdingAmount()
+	_u.mutation.SetWithholdingAmount(v)
+	return _u
+}
+
+// SetNillableWithholdingAmount sets the "withholding_amount" field if the given value is not nil.
+func (_u *PaymentRunItemUpdate) SetNillableWithholdingAmount(v *decimal.Decimal) *PaymentRunItemUpdate {
+	if v != nil {
+		_u.SetWithholdingAmount(*v)
+	}
+	return _u
+}
+
+// AddWithholdingAmount adds value to the "withholding_amount" field.
+func (_u *PaymentRunItemUpdate) AddWithholdingAmount(v decimal.Decimal) *PaymentRunItemUpdate {
+	_u.mutation.AddWithholdingAmount(v)
+	return _u
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (_u *PaymentRunItemUpdate) ClearWithholdingAmount() *PaymentRunItemUpdate {
+	_u.mutation.ClearWithholdingAmount()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *PaymentRunItemUpdate) SetAmount(v decimal.Decimal) *PaymentRunItemUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *PaymentRunItemUpdate) SetNillableAmount(v *decimal.Decimal) *PaymentRunItemUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *PaymentRunItemUpdate) AddAmount(v decimal.Decimal) *PaymentRunItemUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PaymentRunItemUpdate) SetStatus(v string) *PaymentRunItemUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PaymentRunItemUpdate) SetNillableStatus(v *string) *PaymentRunItemUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRemittanceKey sets the "remittance_key" field.
+func (_u *PaymentRunItemUpdate) SetRemittanceKey(v string) *PaymentRunItemUpdate {
+	_u.mutation.SetRemittanceKey(v)
+	return _u
+}
+
+// SetNillableRemittanceKey sets the "remittance_key" field if the given value is not nil.
+func (_u *PaymentRunItemUpdate) SetNillableRemittanceKey(v *string) *PaymentRunItemUpdate {
+	if v != nil {
+		_u.SetRemittanceKey(*v)
+	}
+	return _u
+}
+
+// ClearRemittanceKey clears the value of the "remittance_key" field.
+func (_u *PaymentRunItemUpdate) ClearRemittanceKey() *PaymentRunItemUpdate {
+	_u.mutation.ClearRemittanceKey()
+	return _u
+}
+
+// SetRun sets the "run" edge to the PaymentRun entity.
+func (_u *PaymentRunItemUpdate) SetRun(v *PaymentRun) *PaymentRunItemUpdate {
+	return _u.SetRunID(v.ID)
+}
+
+// Mutation returns the PaymentRunItemMutation object of the builder.
+func (_u *PaymentRunItemUpdate) Mutation() *PaymentRunItemMutation {
+	return _u.mutation
+}
+
+// ClearRun clears the "run" edge to the PaymentRun entity.
+func (_u *PaymentRunItemUpdate) ClearRun() *PaymentRunItemUpdate {
+	_u.mutation.ClearRun()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaymentRunItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentRunItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaymentRunItemUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentRunItemUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentRunItemUpdate) check() error {
+	if _u.mutation.RunCleared() && len(_u.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentRunItem.run"`)
+	}
+	return nil
+}
+
+func (_u *PaymentRunItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentrunitem.Table, paymentrunitem.Columns, sqlgraph.NewFieldSpec(paymentrunitem.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(paymentrunitem.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.BillID(); ok {
+		_spec.SetField(paymentrunitem.FieldBillID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.VendorID(); ok {
+		_spec.SetField(paymentrunitem.FieldVendorID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.BillNumber(); ok {
+		_spec.SetField(paymentrunitem.FieldBillNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VendorReference(); ok {
+		_spec.SetField(paymentrunitem.FieldVendorReference, field.TypeString, value)
+	}
+	if _u.mutation.VendorReferenceCleared() {
+		_spec.ClearField(paymentrunitem.FieldVendorReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.DueDate(); ok {
+		_spec.SetField(paymentrunitem.FieldDueDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Balance(); ok {
+		_spec.SetField(paymentrunitem.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalance(); ok {
+		_spec.AddField(paymentrunitem.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(paymentrunitem.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(paymentrunitem.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.DiscountAmountCleared() {
+		_spec.ClearField(paymentrunitem.FieldDiscountAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.WithholdingAmount(); ok {
+		_spec.SetField(paymentrunitem.FieldWithholdingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWithholdingAmount(); ok {
+		_spec.AddField(paymentrunitem.FieldWithholdingAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.WithholdingAmountCleared() {
+		_spec.ClearField(paymentrunitem.FieldWithholdingAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(paymentrunitem.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(paymentrunitem.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(paymentrunitem.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RemittanceKey(); ok {
+		_spec.SetField(paymentrunitem.FieldRemittanceKey, field.TypeString, value)
+	}
+	if _u.mutation.RemittanceKeyCleared() {
+		_spec.ClearField(paymentrunitem.FieldRemittanceKey, field.TypeString)
+	}
+	if _u.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrunitem.RunTable,
+			Columns: []string{paymentrunitem.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrunitem.RunTable,
+			Columns: []string{paymentrunitem.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentrunitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaymentRunItemUpdateOne is the builder for updating a single PaymentRunItem entity.
+type PaymentRunItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentRunItemMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *PaymentRunItemUpdateOne) SetTenantID(v uuid.UUID) *PaymentRunItemUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *PaymentRunItemUpdateOne) SetNillableTenantID(v *uuid.UUID) *PaymentRunItemUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *PaymentRunItemUpdateOne) SetRunID(v uuid.UUID) *PaymentRunItemUpdateOne {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *PaymentRunItemUpdateOne) SetNillableRunID(v *uuid.UUID) *PaymentRunItemUpdateOne {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// SetBillID sets the "bill_id" field.
+func (_u *PaymentRunItemUpdateOne) SetBillID(v uuid.UUID) *PaymentRunItemUpdateOne {
+	_u.mutation.SetBillID(v)
+	return _u
+}
+
+// SetNillableBillID sets the "bill_id" field if the given value is not nil.
+func (_u *PaymentRunItemUpdateOne) SetNillableBillID(v *uuid.UUID) *PaymentRunItemUpdateOne {
+	if v != nil {
+		_u.SetBillID(*v)
+	}
+	return _u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_u *PaymentRunItemUpdateOne) SetVendorID(v uuid.UUID) *PaymentRunItemUpdateOne {
+	_u.mutation.SetVendorID(v)
+	return _u
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_u *PaymentRunItemUpdateOne) SetNillableVendorID(v *uuid.UUID) *PaymentRunItemUpdateOne {
+	if v != nil {
+		_u.SetVendorID(*v)
+	}
+	return _u
+}
+
+// SetBillNumber sets the "bill_number" field.
+func (_u *PaymentRunItemUpdateOne) SetBillNumber(v string) *PaymentRunItemUpdateOne {
+	_u.mutation.SetBillNumber(v)
+	return _u
+}
+
+// SetNillableBillNumber sets the "bill_number" field if the given value is not nil.
+func (_u *PaymentRunItemUpdateOne) SetNillableBillNumber(v *string) *PaymentRunItemUpdateOne {
+	if v != nil {
+		_u.SetBillNumber(*v)
+	}
+	return _u
+}
+
+// SetVendorReference sets the "vendor_reference" field.
+func (_u *PaymentRunItemUpdateOne) SetVendorReference(v string) *PaymentRunItemUpdateOne {
+	_u.mutation.SetVendorReference(v)
+	return _u
+}
+
+// SetNillableVendorReference sets the "vendor_reference" field if the given value is not nil.
+func (_u *PaymentRunItemUpdateOne) SetNillableVendorReference(v *string) *PaymentRunItemUpdateOne {
+	if v != nil {
+		_u.SetVendorReference(*v)
+	}
+	return _u
+}
+
+// ClearVendorReference clears the value of the "vendor_reference" field.
+func (_u *PaymentRunItemUpdateOne) ClearVendorReference() *PaymentRunItemUpdateOne {
+	_u.mutation.ClearVendorReference()
+	return _u
+}
+
+// SetDueDate sets the "due_date" field.
+func (_u *PaymentRunItemUpdateOne) SetDueDate(v time.Time) *PaymentRunItemUpdateOne {
+	_u.mutation.SetDueDate(v)
+	return _u
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (_u *PaymentRunItemUpdateOne) SetNillableDueDate(v *time.Time) *PaymentRunItemUpdateOne {
+	if v != nil {
+		_u.SetDueDate(*v)
+	}
+	return _u
+}
+
+// SetBalance sets the "balance" field.
+func (_u *PaymentRunItemUpdateOne) SetBalance(v decimal.Decimal) *PaymentRunItemUpdateOne {
+	_u.mutation.ResetBalance()
+	_u.mutation.SetBalance(v)
+	return _u
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_u *PaymentRunItemUpdateOne) SetNillableBalance(v *decimal.Decimal) *PaymentRunItemUpdateOne {
+	if v != nil {
+		_u.SetBalance(*v)
+	}
+	return _u
+}
+
+// AddBalance adds value to the "balance" field.
+func (_u *PaymentRunItemUpdateOne) AddBalance(v decimal.Decimal) *PaymentRunItemUpdateOne {
+	_u.mutation.AddBalance(v)
+	return _u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *PaymentRunItemUpdateOne) SetDiscountAmount(v decimal.Decimal) *PaymentRunItemUpdateOne {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *PaymentRunItemUpdateOne) SetNillableDiscountAmount(v *decimal.Decimal) *PaymentRunItemUpdateOne {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *PaymentRunItemUpdateOne) AddDiscountAmount(v decimal.Decimal) *PaymentRunItemUpdateOne {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
+// ClearDiscountAmount clears the value of the "discount_amount" field.
+func (_u *PaymentRunItemUpdateOne) ClearDiscountAmount() *PaymentRunItemUpdateOne {
+	_u.mutation.ClearDiscountAmount()
+	return _u
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (_u *PaymentRunItemUpdateOne) SetWithholdingAmount(v decimal.Decimal) *PaymentRunItemUpdateOne {
+	_u.mutation.ResetWithholdingAmount()
+	_u.mutation.SetWithholdingAmount(v)
+	return _u
+}
+
+// SetNillableWithholdingAmount sets the "withholding_amount" field if the given value is not nil.
+func (_u *PaymentRunItemUpdateOne) SetNillableWithholdingAmount(v *decimal.Decimal) *PaymentRunItemUpdateOne {
+	if v != nil {
+		_u.SetWithholdingAmount(*v)
+	}
+	return _u
+}
+
+// AddWithholdingAmount adds value to the "withholding_amount" field.
+func (_u *PaymentRunItemUpdateOne) AddWithholdingAmount(v decimal.Decimal) *PaymentRunItemUpdateOne {
+	_u.mutation.AddWithholdingAmount(v)
+	return _u
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (_u *PaymentRunItemUpdateOne) ClearWithholdingAmount() *PaymentRunItemUpdateOne {
+	_u.mutation.ClearWithholdingAmount()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *PaymentRunItemUpdateOne) SetAmount(v decimal.Decimal) *PaymentRunItemUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *PaymentRunItemUpdateOne) SetNillableAmount(v *decimal.Decimal) *PaymentRunItemUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *PaymentRunItemUpdateOne) AddAmount(v decimal.Decimal) *PaymentRunItemUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PaymentRunItemUpdateOne) SetStatus(v string) *PaymentRunItemUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PaymentRunItemUpdateOne) SetNillableStatus(v *string) *PaymentRunItemUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRemittanceKey sets the "remittance_key" field.
+func (_u *PaymentRunItemUpdateOne) SetRemittanceKey(v string) *PaymentRunItemUpdateOne {
+	_u.mutation.SetRemittanceKey(v)
+	return _u
+}
+
+// SetNillableRemittanceKey sets the "remittance_key" field if the given value is not nil.
+func (_u *PaymentRunItemUpdateOne) SetNillableRemittanceKey(v *string) *PaymentRunItemUpdateOne {
+	if v != nil {
+		_u.SetRemittanceKey(*v)
+	}
+	return _u
+}
+
+// ClearRemittanceKey clears the value of the "remittance_key" field.
+func (_u *PaymentRunItemUpdateOne) ClearRemittanceKey() *PaymentRunItemUpdateOne {
+	_u.mutation.ClearRemittanceKey()
+	return _u
+}
+
+// SetRun sets the "run" edge to the PaymentRun entity.
+func (_u *PaymentRunItemUpdateOne) SetRun(v *PaymentRun) *PaymentRunItemUpdateOne {
+	return _u.SetRunID(v.ID)
+}
+
+// Mutation returns the PaymentRunItemMutation object of the builder.
+func (_u *PaymentRunItemUpdateOne) Mutation() *PaymentRunItemMutation {
+	return _u.mutation
+}
+
+// ClearRun clears the "run" edge to the PaymentRun entity.
+func (_u *PaymentRunItemUpdateOne) ClearRun() *PaymentRunItemUpdateOne {
+	_u.mutation.ClearRun()
+	return _u
+}
+
+// Where appends a list predicates to the PaymentRunItemUpdate builder.
+func (_u *PaymentRunItemUpdateOne) Where(ps ...predicate.PaymentRunItem) *PaymentRunItemUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaymentRunItemUpdateOne) Select(field string, fields ...string) *PaymentRunItemUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaymentRunItem entity.
+func (_u *PaymentRunItemUpdateOne) Save(ctx context.Context) (*PaymentRunItem, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentRunItemUpdateOne) SaveX(ctx context.Context) *PaymentRunItem {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaymentRunItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentRunItemUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentRunItemUpdateOne) check() error {
+	if _u.mutation.RunCleared() && len(_u.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaymentRunItem.run"`)
+	}
+	return nil
+}
+
+func (_u *PaymentRunItemUpdateOne) sqlSave(ctx context.Context) (_node *PaymentRunItem, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentrunitem.Table, paymentrunitem.Columns, sqlgraph.NewFieldSpec(paymentrunitem.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaymentRunItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentrunitem.FieldID)
+		for _, f := range fields {
+			if !paymentrunitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paymentrunitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(paymentrunitem.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.BillID(); ok {
+		_spec.SetField(paymentrunitem.FieldBillID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.VendorID(); ok {
+		_spec.SetField(paymentrunitem.FieldVendorID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.BillNumber(); ok {
+		_spec.SetField(paymentrunitem.FieldBillNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VendorReference(); ok {
+		_spec.SetField(paymentrunitem.FieldVendorReference, field.TypeString, value)
+	}
+	if _u.mutation.VendorReferenceCleared() {
+		_spec.ClearField(paymentrunitem.FieldVendorReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.DueDate(); ok {
+		_spec.SetField(paymentrunitem.FieldDueDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Balance(); ok {
+		_spec.SetField(paymentrunitem.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalance(); ok {
+		_spec.AddField(paymentrunitem.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(paymentrunitem.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(paymentrunitem.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.DiscountAmountCleared() {
+		_spec.ClearField(paymentrunitem.FieldDiscountAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.WithholdingAmount(); ok {
+		_spec.SetField(paymentrunitem.FieldWithholdingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWithholdingAmount(); ok {
+		_spec.AddField(paymentrunitem.FieldWithholdingAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.WithholdingAmountCleared() {
+		_spec.ClearField(paymentrunitem.FieldWithholdingAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(paymentrunitem.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(paymentrunitem.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(paymentrunitem.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RemittanceKey(); ok {
+		_spec.SetField(paymentrunitem.FieldRemittanceKey, field.TypeString, value)
+	}
+	if _u.mutation.RemittanceKeyCleared() {
+		_spec.ClearField(paymentrunitem.FieldRemittanceKey, field.TypeString)
+	}
+	if _u.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrunitem.RunTable,
+			Columns: []string{paymentrunitem.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paymentrunitem.RunTable,
+			Columns: []string{paymentrunitem.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PaymentRunItem{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentrunitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
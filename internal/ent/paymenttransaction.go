@@ -38,6 +38,8 @@ type PaymentTransaction struct {
 	Status string `json:"status,omitempty"`
 	// Processing timestamp
 	ProcessedAt time.Time `json:"processed_at,omitempty"`
+	// Ledger journal booking the succeeded transaction into unapplied receipts
+	ReceiptJournalID *uuid.UUID `json:"receipt_journal_id,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -52,6 +54,8 @@ func (*PaymentTransaction) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case paymenttransaction.FieldReceiptJournalID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case paymenttransaction.FieldMetadata:
 			values[i] = new([]byte)
 		case paymenttransaction.FieldAmount:
@@ -137,6 +141,13 @@ func (_m *PaymentTransaction) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				_m.ProcessedAt = value.Time
 			}
+		case paymenttransaction.FieldReceiptJournalID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field receipt_journal_id", values[i])
+			} else if value.Valid {
+				_m.ReceiptJournalID = new(uuid.UUID)
+				*_m.ReceiptJournalID = *value.S.(*uuid.UUID)
+			}
 		case paymenttransaction.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -220,6 +231,11 @@ func (_m *PaymentTransaction) String() string {
 	builder.WriteString("processed_at=")
 	builder.WriteString(_m.ProcessedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := _m.ReceiptJournalID; v != nil {
+		builder.WriteString("receipt_journal_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
 	builder.WriteString(", ")
@@ -32,6 +32,8 @@ const (
 	FieldStatus = "status"
 	// FieldProcessedAt holds the string denoting the processed_at field in the database.
 	FieldProcessedAt = "processed_at"
+	// FieldReceiptJournalID holds the string denoting the receipt_journal_id field in the database.
+	FieldReceiptJournalID = "receipt_journal_id"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -54,6 +56,7 @@ var Columns = []string{
 	FieldProviderReference,
 	FieldStatus,
 	FieldProcessedAt,
+	FieldReceiptJournalID,
 	FieldMetadata,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -145,6 +148,11 @@ func ByProcessedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProcessedAt, opts...).ToFunc()
 }
 
+// ByReceiptJournalID orders the results by the receipt_journal_id field.
+func ByReceiptJournalID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceiptJournalID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -101,6 +101,11 @@ func ProcessedAt(v time.Time) predicate.PaymentTransaction {
 	return predicate.PaymentTransaction(sql.FieldEQ(FieldProcessedAt, v))
 }
 
+// ReceiptJournalID applies equality check predicate on the "receipt_journal_id" field. It's identical to ReceiptJournalIDEQ.
+func ReceiptJournalID(v uuid.UUID) predicate.PaymentTransaction {
+	return predicate.PaymentTransaction(sql.FieldEQ(FieldReceiptJournalID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.PaymentTransaction {
 	return predicate.PaymentTransaction(sql.FieldEQ(FieldCreatedAt, v))
@@ -606,6 +611,56 @@ func ProcessedAtNotNil() predicate.PaymentTransaction {
 	return predicate.PaymentTransaction(sql.FieldNotNull(FieldProcessedAt))
 }
 
+// ReceiptJournalIDEQ applies the EQ predicate on the "receipt_journal_id" field.
+func ReceiptJournalIDEQ(v uuid.UUID) predicate.PaymentTransaction {
+	return predicate.PaymentTransaction(sql.FieldEQ(FieldReceiptJournalID, v))
+}
+
+// ReceiptJournalIDNEQ applies the NEQ predicate on the "receipt_journal_id" field.
+func ReceiptJournalIDNEQ(v uuid.UUID) predicate.PaymentTransaction {
+	return predicate.PaymentTransaction(sql.FieldNEQ(FieldReceiptJournalID, v))
+}
+
+// ReceiptJournalIDIn applies the In predicate on the "receipt_journal_id" field.
+func ReceiptJournalIDIn(vs ...uuid.UUID) predicate.PaymentTransaction {
+	return predicate.PaymentTransaction(sql.FieldIn(FieldReceiptJournalID, vs...))
+}
+
+// ReceiptJournalIDNotIn applies the NotIn predicate on the "receipt_journal_id" field.
+func ReceiptJournalIDNotIn(vs ...uuid.UUID) predicate.PaymentTransaction {
+	return predicate.PaymentTransaction(sql.FieldNotIn(FieldReceiptJournalID, vs...))
+}
+
+// ReceiptJournalIDGT applies the GT predicate on the "receipt_journal_id" field.
+func ReceiptJournalIDGT(v uuid.UUID) predicate.PaymentTransaction {
+	return predicate.PaymentTransaction(sql.FieldGT(FieldReceiptJournalID, v))
+}
+
+// ReceiptJournalIDGTE applies the GTE predicate on the "receipt_journal_id" field.
+func ReceiptJournalIDGTE(v uuid.UUID) predicate.PaymentTransaction {
+	return predicate.PaymentTransaction(sql.FieldGTE(FieldReceiptJournalID, v))
+}
+
+// ReceiptJournalIDLT applies the LT predicate on the "receipt_journal_id" field.
+func ReceiptJournalIDLT(v uuid.UUID) predicate.PaymentTransaction {
+	return predicate.PaymentTransaction(sql.FieldLT(FieldReceiptJournalID, v))
+}
+
+// ReceiptJournalIDLTE applies the LTE predicate on the "receipt_journal_id" field.
+func ReceiptJournalIDLTE(v uuid.UUID) predicate.PaymentTransaction {
+	return predicate.PaymentTransaction(sql.FieldLTE(FieldReceiptJournalID, v))
+}
+
+// ReceiptJournalIDIsNil applies the IsNil predicate on the "receipt_journal_id" field.
+func ReceiptJournalIDIsNil() predicate.PaymentTransaction {
+	return predicate.PaymentTransaction(sql.FieldIsNull(FieldReceiptJournalID))
+}
+
+// ReceiptJournalIDNotNil applies the NotNil predicate on the "receipt_journal_id" field.
+func ReceiptJournalIDNotNil() predicate.PaymentTransaction {
+	return predicate.PaymentTransaction(sql.FieldNotNull(FieldReceiptJournalID))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.PaymentTransaction {
 	return predicate.PaymentTransaction(sql.FieldEQ(FieldCreatedAt, v))
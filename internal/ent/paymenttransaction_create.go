@@ -103,6 +103,20 @@ func (_c *PaymentTransactionCreate) SetNillableProcessedAt(v *time.Time) *Paymen
 	return _c
 }
 
+// SetReceiptJournalID sets the "receipt_journal_id" field.
+func (_c *PaymentTransactionCreate) SetReceiptJournalID(v uuid.UUID) *PaymentTransactionCreate {
+	_c.mutation.SetReceiptJournalID(v)
+	return _c
+}
+
+// SetNillableReceiptJournalID sets the "receipt_journal_id" field if the given value is not nil.
+func (_c *PaymentTransactionCreate) SetNillableReceiptJournalID(v *uuid.UUID) *PaymentTransactionCreate {
+	if v != nil {
+		_c.SetReceiptJournalID(*v)
+	}
+	return _c
+}
+
 // SetMetadata sets the "metadata" field.
 func (_c *PaymentTransactionCreate) SetMetadata(v map[string]interface{}) *PaymentTransactionCreate {
 	_c.mutation.SetMetadata(v)
@@ -334,6 +348,10 @@ func (_c *PaymentTransactionCreate) createSpec() (*PaymentTransaction, *sqlgraph
 		_spec.SetField(paymenttransaction.FieldProcessedAt, field.TypeTime, value)
 		_node.ProcessedAt = value
 	}
+	if value, ok := _c.mutation.ReceiptJournalID(); ok {
+		_spec.SetField(paymenttransaction.FieldReceiptJournalID, field.TypeUUID, value)
+		_node.ReceiptJournalID = &value
+	}
 	if value, ok := _c.mutation.Metadata(); ok {
 		_spec.SetField(paymenttransaction.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -518,6 +536,24 @@ func (u *PaymentTransactionUpsert) ClearProcessedAt() *PaymentTransactionUpsert
 	return u
 }
 
+// SetReceiptJournalID sets the "receipt_journal_id" field.
+func (u *PaymentTransactionUpsert) SetReceiptJournalID(v uuid.UUID) *PaymentTransactionUpsert {
+	u.Set(paymenttransaction.FieldReceiptJournalID, v)
+	return u
+}
+
+// UpdateReceiptJournalID sets the "receipt_journal_id" field to the value that was provided on create.
+func (u *PaymentTransactionUpsert) UpdateReceiptJournalID() *PaymentTransactionUpsert {
+	u.SetExcluded(paymenttransaction.FieldReceiptJournalID)
+	return u
+}
+
+// ClearReceiptJournalID clears the value of the "receipt_journal_id" field.
+func (u *PaymentTransactionUpsert) ClearReceiptJournalID() *PaymentTransactionUpsert {
+	u.SetNull(paymenttransaction.FieldReceiptJournalID)
+	return u
+}
+
 // SetMetadata sets the "metadata" field.
 func (u *PaymentTransactionUpsert) SetMetadata(v map[string]interface{}) *PaymentTransactionUpsert {
 	u.Set(paymenttransaction.FieldMetadata, v)
@@ -733,6 +769,27 @@ func (u *PaymentTransactionUpsertOne) ClearProcessedAt() *PaymentTransactionUpse
 	})
 }
 
+// SetReceiptJournalID sets the "receipt_journal_id" field.
+func (u *PaymentTransactionUpsertOne) SetReceiptJournalID(v uuid.UUID) *PaymentTransactionUpsertOne {
+	return u.Update(func(s *PaymentTransactionUpsert) {
+		s.SetReceiptJournalID(v)
+	})
+}
+
+// UpdateReceiptJournalID sets the "receipt_journal_id" field to the value that was provided on create.
+func (u *PaymentTransactionUpsertOne) UpdateReceiptJournalID() *PaymentTransactionUpsertOne {
+	return u.Update(func(s *PaymentTransactionUpsert) {
+		s.UpdateReceiptJournalID()
+	})
+}
+
+// ClearReceiptJournalID clears the value of the "receipt_journal_id" field.
+func (u *PaymentTransactionUpsertOne) ClearReceiptJournalID() *PaymentTransactionUpsertOne {
+	return u.Update(func(s *PaymentTransactionUpsert) {
+		s.ClearReceiptJournalID()
+	})
+}
+
 // SetMetadata sets the "metadata" field.
 func (u *PaymentTransactionUpsertOne) SetMetadata(v map[string]interface{}) *PaymentTransactionUpsertOne {
 	return u.Update(func(s *PaymentTransactionUpsert) {
@@ -1119,6 +1176,27 @@ func (u *PaymentTransactionUpsertBulk) ClearProcessedAt() *PaymentTransactionUps
 	})
 }
 
+// SetReceiptJournalID sets the "receipt_journal_id" field.
+func (u *PaymentTransactionUpsertBulk) SetReceiptJournalID(v uuid.UUID) *PaymentTransactionUpsertBulk {
+	return u.Update(func(s *PaymentTransactionUpsert) {
+		s.SetReceiptJournalID(v)
+	})
+}
+
+// UpdateReceiptJournalID sets the "receipt_journal_id" field to the value that was provided on create.
+func (u *PaymentTransactionUpsertBulk) UpdateReceiptJournalID() *PaymentTransactionUpsertBulk {
+	return u.Update(func(s *PaymentTransactionUpsert) {
+		s.UpdateReceiptJournalID()
+	})
+}
+
+// ClearReceiptJournalID clears the value of the "receipt_journal_id" field.
+func (u *PaymentTransactionUpsertBulk) ClearReceiptJournalID() *PaymentTransactionUpsertBulk {
+	return u.Update(func(s *PaymentTransactionUpsert) {
+		s.ClearReceiptJournalID()
+	})
+}
+
 // SetMetadata sets the "metadata" field.
 func (u *PaymentTransactionUpsertBulk) SetMetadata(v map[string]interface{}) *PaymentTransactionUpsertBulk {
 	return u.Update(func(s *PaymentTransactionUpsert) {
@@ -169,6 +169,26 @@ func (_u *PaymentTransactionUpdate) ClearProcessedAt() *PaymentTransactionUpdate
 	return _u
 }
 
+// SetReceiptJournalID sets the "receipt_journal_id" field.
+func (_u *PaymentTransactionUpdate) SetReceiptJournalID(v uuid.UUID) *PaymentTransactionUpdate {
+	_u.mutation.SetReceiptJournalID(v)
+	return _u
+}
+
+// SetNillableReceiptJournalID sets the "receipt_journal_id" field if the given value is not nil.
+func (_u *PaymentTransactionUpdate) SetNillableReceiptJournalID(v *uuid.UUID) *PaymentTransactionUpdate {
+	if v != nil {
+		_u.SetReceiptJournalID(*v)
+	}
+	return _u
+}
+
+// ClearReceiptJournalID clears the value of the "receipt_journal_id" field.
+func (_u *PaymentTransactionUpdate) ClearReceiptJournalID() *PaymentTransactionUpdate {
+	_u.mutation.ClearReceiptJournalID()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *PaymentTransactionUpdate) SetMetadata(v map[string]interface{}) *PaymentTransactionUpdate {
 	_u.mutation.SetMetadata(v)
@@ -287,6 +307,12 @@ func (_u *PaymentTransactionUpdate) sqlSave(ctx context.Context) (_node int, err
 	if _u.mutation.ProcessedAtCleared() {
 		_spec.ClearField(paymenttransaction.FieldProcessedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.ReceiptJournalID(); ok {
+		_spec.SetField(paymenttransaction.FieldReceiptJournalID, field.TypeUUID, value)
+	}
+	if _u.mutation.ReceiptJournalIDCleared() {
+		_spec.ClearField(paymenttransaction.FieldReceiptJournalID, field.TypeUUID)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(paymenttransaction.FieldMetadata, field.TypeJSON, value)
 	}
@@ -452,6 +478,26 @@ func (_u *PaymentTransactionUpdateOne) ClearProcessedAt() *PaymentTransactionUpd
 	return _u
 }
 
+// SetReceiptJournalID sets the "receipt_journal_id" field.
+func (_u *PaymentTransactionUpdateOne) SetReceiptJournalID(v uuid.UUID) *PaymentTransactionUpdateOne {
+	_u.mutation.SetReceiptJournalID(v)
+	return _u
+}
+
+// SetNillableReceiptJournalID sets the "receipt_journal_id" field if the given value is not nil.
+func (_u *PaymentTransactionUpdateOne) SetNillableReceiptJournalID(v *uuid.UUID) *PaymentTransactionUpdateOne {
+	if v != nil {
+		_u.SetReceiptJournalID(*v)
+	}
+	return _u
+}
+
+// ClearReceiptJournalID clears the value of the "receipt_journal_id" field.
+func (_u *PaymentTransactionUpdateOne) ClearReceiptJournalID() *PaymentTransactionUpdateOne {
+	_u.mutation.ClearReceiptJournalID()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *PaymentTransactionUpdateOne) SetMetadata(v map[string]interface{}) *PaymentTransactionUpdateOne {
 	_u.mutation.SetMetadata(v)
@@ -600,6 +646,12 @@ func (_u *PaymentTransactionUpdateOne) sqlSave(ctx context.Context) (_node *Paym
 	if _u.mutation.ProcessedAtCleared() {
 		_spec.ClearField(paymenttransaction.FieldProcessedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.ReceiptJournalID(); ok {
+		_spec.SetField(paymenttransaction.FieldReceiptJournalID, field.TypeUUID, value)
+	}
+	if _u.mutation.ReceiptJournalIDCleared() {
+		_spec.ClearField(paymenttransaction.FieldReceiptJournalID, field.TypeUUID)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(paymenttransaction.FieldMetadata, field.TypeJSON, value)
 	}
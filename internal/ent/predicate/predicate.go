@@ -6,27 +6,147 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// BankAccount is the predicate function for bankaccount builders.
+type BankAccount func(*sql.Selector)
+
+// BankStatement is the predicate function for bankstatement builders.
+type BankStatement func(*sql.Selector)
+
+// BankStatementProfile is the predicate function for bankstatementprofile builders.
+type BankStatementProfile func(*sql.Selector)
+
+// BankTransaction is the predicate function for banktransaction builders.
+type BankTransaction func(*sql.Selector)
+
+// BillingCycle is the predicate function for billingcycle builders.
+type BillingCycle func(*sql.Selector)
+
+// CashDrawerCount is the predicate function for cashdrawercount builders.
+type CashDrawerCount func(*sql.Selector)
+
+// CashDrawerSession is the predicate function for cashdrawersession builders.
+type CashDrawerSession func(*sql.Selector)
+
+// CashDrawerSetting is the predicate function for cashdrawersetting builders.
+type CashDrawerSetting func(*sql.Selector)
+
 // ChartOfAccount is the predicate function for chartofaccount builders.
 type ChartOfAccount func(*sql.Selector)
 
+// CreditOverride is the predicate function for creditoverride builders.
+type CreditOverride func(*sql.Selector)
+
+// Customer is the predicate function for customer builders.
+type Customer func(*sql.Selector)
+
+// CustomerStatement is the predicate function for customerstatement builders.
+type CustomerStatement func(*sql.Selector)
+
+// DocumentSequence is the predicate function for documentsequence builders.
+type DocumentSequence func(*sql.Selector)
+
+// DunningNotice is the predicate function for dunningnotice builders.
+type DunningNotice func(*sql.Selector)
+
+// DunningPause is the predicate function for dunningpause builders.
+type DunningPause func(*sql.Selector)
+
+// DunningStep is the predicate function for dunningstep builders.
+type DunningStep func(*sql.Selector)
+
+// EarningsSetting is the predicate function for earningssetting builders.
+type EarningsSetting func(*sql.Selector)
+
+// GoodsReceipt is the predicate function for goodsreceipt builders.
+type GoodsReceipt func(*sql.Selector)
+
+// GoodsReceiptLine is the predicate function for goodsreceiptline builders.
+type GoodsReceiptLine func(*sql.Selector)
+
 // Invoice is the predicate function for invoice builders.
 type Invoice func(*sql.Selector)
 
+// InvoiceLine is the predicate function for invoiceline builders.
+type InvoiceLine func(*sql.Selector)
+
+// InvoicePayment is the predicate function for invoicepayment builders.
+type InvoicePayment func(*sql.Selector)
+
+// InvoiceSetting is the predicate function for invoicesetting builders.
+type InvoiceSetting func(*sql.Selector)
+
 // LedgerTransaction is the predicate function for ledgertransaction builders.
 type LedgerTransaction func(*sql.Selector)
 
 // OutboxEvent is the predicate function for outboxevent builders.
 type OutboxEvent func(*sql.Selector)
 
+// PayableSetting is the predicate function for payablesetting builders.
+type PayableSetting func(*sql.Selector)
+
+// PayeePayout is the predicate function for payeepayout builders.
+type PayeePayout func(*sql.Selector)
+
+// PayeeWallet is the predicate function for payeewallet builders.
+type PayeeWallet func(*sql.Selector)
+
+// PayeeWalletEntry is the predicate function for payeewalletentry builders.
+type PayeeWalletEntry func(*sql.Selector)
+
 // PaymentIntent is the predicate function for paymentintent builders.
 type PaymentIntent func(*sql.Selector)
 
+// PaymentRun is the predicate function for paymentrun builders.
+type PaymentRun func(*sql.Selector)
+
+// PaymentRunItem is the predicate function for paymentrunitem builders.
+type PaymentRunItem func(*sql.Selector)
+
 // PaymentTransaction is the predicate function for paymenttransaction builders.
 type PaymentTransaction func(*sql.Selector)
 
+// ProvisionPolicy is the predicate function for provisionpolicy builders.
+type ProvisionPolicy func(*sql.Selector)
+
+// ProvisionRun is the predicate function for provisionrun builders.
+type ProvisionRun func(*sql.Selector)
+
+// Reconciliation is the predicate function for reconciliation builders.
+type Reconciliation func(*sql.Selector)
+
+// ReconciliationMatch is the predicate function for reconciliationmatch builders.
+type ReconciliationMatch func(*sql.Selector)
+
+// ReconciliationMatchItem is the predicate function for reconciliationmatchitem builders.
+type ReconciliationMatchItem func(*sql.Selector)
+
+// ReconciliationRule is the predicate function for reconciliationrule builders.
+type ReconciliationRule func(*sql.Selector)
+
 // RolePermission is the predicate function for rolepermission builders.
 type RolePermission func(*sql.Selector)
 
+// SettlementBatch is the predicate function for settlementbatch builders.
+type SettlementBatch func(*sql.Selector)
+
+// SettlementDisbursement is the predicate function for settlementdisbursement builders.
+type SettlementDisbursement func(*sql.Selector)
+
+// SettlementItem is the predicate function for settlementitem builders.
+type SettlementItem func(*sql.Selector)
+
+// SettlementSetting is the predicate function for settlementsetting builders.
+type SettlementSetting func(*sql.Selector)
+
+// Subscription is the predicate function for subscription builders.
+type Subscription func(*sql.Selector)
+
+// SubscriptionAdjustment is the predicate function for subscriptionadjustment builders.
+type SubscriptionAdjustment func(*sql.Selector)
+
+// SubscriptionMeter is the predicate function for subscriptionmeter builders.
+type SubscriptionMeter func(*sql.Selector)
+
 // TreasuryPermission is the predicate function for treasurypermission builders.
 type TreasuryPermission func(*sql.Selector)
 
@@ -36,5 +156,38 @@ type TreasuryRole func(*sql.Selector)
 // TreasuryUser is the predicate function for treasuryuser builders.
 type TreasuryUser func(*sql.Selector)
 
+// UsageRecord is the predicate function for usagerecord builders.
+type UsageRecord func(*sql.Selector)
+
 // UserRoleAssignment is the predicate function for userroleassignment builders.
 type UserRoleAssignment func(*sql.Selector)
+
+// Vendor is the predicate function for vendor builders.
+type Vendor func(*sql.Selector)
+
+// VendorBill is the predicate function for vendorbill builders.
+type VendorBill func(*sql.Selector)
+
+// VendorBillLine is the predicate function for vendorbillline builders.
+type VendorBillLine func(*sql.Selector)
+
+// Wallet is the predicate function for wallet builders.
+type Wallet func(*sql.Selector)
+
+// WalletHold is the predicate function for wallethold builders.
+type WalletHold func(*sql.Selector)
+
+// WalletTransaction is the predicate function for wallettransaction builders.
+type WalletTransaction func(*sql.Selector)
+
+// WithholdingCertificate is the predicate function for withholdingcertificate builders.
+type WithholdingCertificate func(*sql.Selector)
+
+// WithholdingRate is the predicate function for withholdingrate builders.
+type WithholdingRate func(*sql.Selector)
+
+// WriteOff is the predicate function for writeoff builders.
+type WriteOff func(*sql.Selector)
+
+// WriteOffRecovery is the predicate function for writeoffrecovery builders.
+type WriteOffRecovery func(*sql.Selector)
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/provisionpolicy"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ProvisionPolicy is the model entity for the ProvisionPolicy schema.
+type ProvisionPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Aging bucket boundaries in days past due
+	Boundaries []int `json:"boundaries,omitempty"`
+	// Loss rate per bucket: current, then one per boundary, then the last bucket
+	LossRates []decimal.Decimal `json:"loss_rates,omitempty"`
+	// Whether the month-end provisioning job runs for the tenant
+	Enabled bool `json:"enabled,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy uuid.UUID `json:"updated_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProvisionPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case provisionpolicy.FieldBoundaries, provisionpolicy.FieldLossRates:
+			values[i] = new([]byte)
+		case provisionpolicy.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case provisionpolicy.FieldCreatedAt, provisionpolicy.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case provisionpolicy.FieldID, provisionpolicy.FieldTenantID, provisionpolicy.FieldUpdatedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProvisionPolicy fields.
+func (_m *ProvisionPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case provisionpolicy.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case provisionpolicy.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case provisionpolicy.FieldBoundaries:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field boundaries", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Boundaries); err != nil {
+					return fmt.Errorf("unmarshal field boundaries: %w", err)
+				}
+			}
+		case provisionpolicy.FieldLossRates:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field loss_rates", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LossRates); err != nil {
+					return fmt.Errorf("unmarshal field loss_rates: %w", err)
+				}
+			}
+		case provisionpolicy.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case provisionpolicy.FieldUpdatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value != nil {
+				_m.UpdatedBy = *value
+			}
+		case provisionpolicy.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case provisionpolicy.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProvisionPolicy.
+// This includes values selected through modifiers, order, etc.
+func (_m *ProvisionPolicy) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ProvisionPolicy.
+// Note that you need to call ProvisionPolicy.Unwrap() before calling this method if this ProvisionPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ProvisionPolicy) Update() *ProvisionPolicyUpdateOne {
+	return NewProvisionPolicyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ProvisionPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ProvisionPolicy) Unwrap() *ProvisionPolicy {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProvisionPolicy is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ProvisionPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProvisionPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("boundaries=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Boundaries))
+	builder.WriteString(", ")
+	builder.WriteString("loss_rates=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LossRates))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProvisionPolicies is a parsable slice of ProvisionPolicy.
+type ProvisionPolicies []*ProvisionPolicy
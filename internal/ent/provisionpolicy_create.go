@@ -0,0 +1,855 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/provisionpolicy"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ProvisionPolicyCreate is the builder for creating a ProvisionPolicy entity.
+type ProvisionPolicyCreate struct {
+	config
+	mutation *ProvisionPolicyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ProvisionPolicyCreate) SetTenantID(v uuid.UUID) *ProvisionPolicyCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetBoundaries sets the "boundaries" field.
+func (_c *ProvisionPolicyCreate) SetBoundaries(v []int) *ProvisionPolicyCreate {
+	_c.mutation.SetBoundaries(v)
+	return _c
+}
+
+// SetLossRates sets the "loss_rates" field.
+func (_c *ProvisionPolicyCreate) SetLossRates(v []decimal.Decimal) *ProvisionPolicyCreate {
+	_c.mutation.SetLossRates(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *ProvisionPolicyCreate) SetEnabled(v bool) *ProvisionPolicyCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *ProvisionPolicyCreate) SetNillableEnabled(v *bool) *ProvisionPolicyCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *ProvisionPolicyCreate) SetUpdatedBy(v uuid.UUID) *ProvisionPolicyCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *ProvisionPolicyCreate) SetNillableUpdatedBy(v *uuid.UUID) *ProvisionPolicyCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ProvisionPolicyCreate) SetCreatedAt(v time.Time) *ProvisionPolicyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ProvisionPolicyCreate) SetNillableCreatedAt(v *time.Time) *ProvisionPolicyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ProvisionPolicyCreate) SetUpdatedAt(v time.Time) *ProvisionPolicyCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ProvisionPolicyCreate) SetNillableUpdatedAt(v *time.Time) *ProvisionPolicyCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ProvisionPolicyCreate) SetID(v uuid.UUID) *ProvisionPolicyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ProvisionPolicyCreate) SetNillableID(v *uuid.UUID) *ProvisionPolicyCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ProvisionPolicyMutation object of the builder.
+func (_c *ProvisionPolicyCreate) Mutation() *ProvisionPolicyMutation {
+	return _c.mutation
+}
+
+// Save creates the ProvisionPolicy in the database.
+func (_c *ProvisionPolicyCreate) Save(ctx context.Context) (*ProvisionPolicy, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProvisionPolicyCreate) SaveX(ctx context.Context) *ProvisionPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProvisionPolicyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProvisionPolicyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProvisionPolicyCreate) defaults() {
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := provisionpolicy.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := provisionpolicy.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := provisionpolicy.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := provisionpolicy.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProvisionPolicyCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ProvisionPolicy.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.Boundaries(); !ok {
+		return &ValidationError{Name: "boundaries", err: errors.New(`ent: missing required field "ProvisionPolicy.boundaries"`)}
+	}
+	if _, ok := _c.mutation.LossRates(); !ok {
+		return &ValidationError{Name: "loss_rates", err: errors.New(`ent: missing required field "ProvisionPolicy.loss_rates"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "ProvisionPolicy.enabled"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProvisionPolicy.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProvisionPolicy.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ProvisionPolicyCreate) sqlSave(ctx context.Context) (*ProvisionPolicy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProvisionPolicyCreate) createSpec() (*ProvisionPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProvisionPolicy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(provisionpolicy.Table, sqlgraph.NewFieldSpec(provisionpolicy.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(provisionpolicy.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Boundaries(); ok {
+		_spec.SetField(provisionpolicy.FieldBoundaries, field.TypeJSON, value)
+		_node.Boundaries = value
+	}
+	if value, ok := _c.mutation.LossRates(); ok {
+		_spec.SetField(provisionpolicy.FieldLossRates, field.TypeJSON, value)
+		_node.LossRates = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(provisionpolicy.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(provisionpolicy.FieldUpdatedBy, field.TypeUUID, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(provisionpolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(provisionpolicy.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProvisionPolicy.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProvisionPolicyUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ProvisionPolicyCreate) OnConflict(opts ...sql.ConflictOption) *ProvisionPolicyUpsertOne {
+	_c.conflict = opts
+	return &ProvisionPolicyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProvisionPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ProvisionPolicyCreate) OnConflictColumns(columns ...string) *ProvisionPolicyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ProvisionPolicyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ProvisionPolicyUpsertOne is the builder for "upsert"-ing
+	//  one ProvisionPolicy node.
+	ProvisionPolicyUpsertOne struct {
+		create *ProvisionPolicyCreate
+	}
+
+	// ProvisionPolicyUpsert is the "OnConflict" setter.
+	ProvisionPolicyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *ProvisionPolicyUpsert) SetTenantID(v uuid.UUID) *ProvisionPolicyUpsert {
+	u.Set(provisionpolicy.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *ProvisionPolicyUpsert) UpdateTenantID() *ProvisionPolicyUpsert {
+	u.SetExcluded(provisionpolicy.FieldTenantID)
+	return u
+}
+
+// SetBoundaries sets the "boundaries" field.
+func (u *ProvisionPolicyUpsert) SetBoundaries(v []int) *ProvisionPolicyUpsert {
+	u.Set(provisionpolicy.FieldBoundaries, v)
+	return u
+}
+
+// UpdateBoundaries sets the "boundaries" field to the value that was provided on create.
+func (u *ProvisionPolicyUpsert) UpdateBoundaries() *ProvisionPolicyUpsert {
+	u.SetExcluded(provisionpolicy.FieldBoundaries)
+	return u
+}
+
+// SetLossRates sets the "loss_rates" field.
+func (u *ProvisionPolicyUpsert) SetLossRates(v []decimal.Decimal) *ProvisionPolicyUpsert {
+	u.Set(provisionpolicy.FieldLossRates, v)
+	return u
+}
+
+// UpdateLossRates sets the "loss_rates" field to the value that was provided on create.
+func (u *ProvisionPolicyUpsert) UpdateLossRates() *ProvisionPolicyUpsert {
+	u.SetExcluded(provisionpolicy.FieldLossRates)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ProvisionPolicyUpsert) SetEnabled(v bool) *ProvisionPolicyUpsert {
+	u.Set(provisionpolicy.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ProvisionPolicyUpsert) UpdateEnabled() *ProvisionPolicyUpsert {
+	u.SetExcluded(provisionpolicy.FieldEnabled)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *ProvisionPolicyUpsert) SetUpdatedBy(v uuid.UUID) *ProvisionPolicyUpsert {
+	u.Set(provisionpolicy.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *ProvisionPolicyUpsert) UpdateUpdatedBy() *ProvisionPolicyUpsert {
+	u.SetExcluded(provisionpolicy.FieldUpdatedBy)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *ProvisionPolicyUpsert) ClearUpdatedBy() *ProvisionPolicyUpsert {
+	u.SetNull(provisionpolicy.FieldUpdatedBy)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProvisionPolicyUpsert) SetUpdatedAt(v time.Time) *ProvisionPolicyUpsert {
+	u.Set(provisionpolicy.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProvisionPolicyUpsert) UpdateUpdatedAt() *ProvisionPolicyUpsert {
+	u.SetExcluded(provisionpolicy.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProvisionPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(provisionpolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProvisionPolicyUpsertOne) UpdateNewValues() *ProvisionPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(provisionpolicy.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(provisionpolicy.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProvisionPolicy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProvisionPolicyUpsertOne) Ignore() *ProvisionPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProvisionPolicyUpsertOne) DoNothing() *ProvisionPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProvisionPolicyCreate.OnConflict
+// documentation for more info.
+func (u *ProvisionPolicyUpsertOne) Update(set func(*ProvisionPolicyUpsert)) *ProvisionPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProvisionPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *ProvisionPolicyUpsertOne) SetTenantID(v uuid.UUID) *ProvisionPolicyUpsertOne {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *ProvisionPolicyUpsertOne) UpdateTenantID() *ProvisionPolicyUpsertOne {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetBoundaries sets the "boundaries" field.
+func (u *ProvisionPolicyUpsertOne) SetBoundaries(v []int) *ProvisionPolicyUpsertOne {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.SetBoundaries(v)
+	})
+}
+
+// UpdateBoundaries sets the "boundaries" field to the value that was provided on create.
+func (u *ProvisionPolicyUpsertOne) UpdateBoundaries() *ProvisionPolicyUpsertOne {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.UpdateBoundaries()
+	})
+}
+
+// SetLossRates sets the "loss_rates" field.
+func (u *ProvisionPolicyUpsertOne) SetLossRates(v []decimal.Decimal) *ProvisionPolicyUpsertOne {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.SetLossRates(v)
+	})
+}
+
+// UpdateLossRates sets the "loss_rates" field to the value that was provided on create.
+func (u *ProvisionPolicyUpsertOne) UpdateLossRates() *ProvisionPolicyUpsertOne {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.UpdateLossRates()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ProvisionPolicyUpsertOne) SetEnabled(v bool) *ProvisionPolicyUpsertOne {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ProvisionPolicyUpsertOne) UpdateEnabled() *ProvisionPolicyUpsertOne {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *ProvisionPolicyUpsertOne) SetUpdatedBy(v uuid.UUID) *ProvisionPolicyUpsertOne {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *ProvisionPolicyUpsertOne) UpdateUpdatedBy() *ProvisionPolicyUpsertOne {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *ProvisionPolicyUpsertOne) ClearUpdatedBy() *ProvisionPolicyUpsertOne {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProvisionPolicyUpsertOne) SetUpdatedAt(v time.Time) *ProvisionPolicyUpsertOne {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProvisionPolicyUpsertOne) UpdateUpdatedAt() *ProvisionPolicyUpsertOne {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProvisionPolicyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProvisionPolicyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProvisionPolicyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProvisionPolicyUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProvisionPolicyUpsertOne.ID is not supported by MySQL driver. Use ProvisionPolicyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProvisionPolicyUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProvisionPolicyCreateBulk is the builder for creating many ProvisionPolicy entities in bulk.
+type ProvisionPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*ProvisionPolicyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProvisionPolicy entities in the database.
+func (_c *ProvisionPolicyCreateBulk) Save(ctx context.Context) ([]*ProvisionPolicy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ProvisionPolicy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProvisionPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProvisionPolicyCreateBulk) SaveX(ctx context.Context) []*ProvisionPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProvisionPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProvisionPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProvisionPolicy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProvisionPolicyUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ProvisionPolicyCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProvisionPolicyUpsertBulk {
+	_c.conflict = opts
+	return &ProvisionPolicyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProvisionPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ProvisionPolicyCreateBulk) OnConflictColumns(columns ...string) *ProvisionPolicyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ProvisionPolicyUpsertBulk{
+		create: _c,
+	}
+}
+
+// ProvisionPolicyUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProvisionPolicy nodes.
+type ProvisionPolicyUpsertBulk struct {
+	create *ProvisionPolicyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProvisionPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(provisionpolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProvisionPolicyUpsertBulk) UpdateNewValues() *ProvisionPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(provisionpolicy.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(provisionpolicy.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProvisionPolicy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProvisionPolicyUpsertBulk) Ignore() *ProvisionPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProvisionPolicyUpsertBulk) DoNothing() *ProvisionPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProvisionPolicyCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProvisionPolicyUpsertBulk) Update(set func(*ProvisionPolicyUpsert)) *ProvisionPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProvisionPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *ProvisionPolicyUpsertBulk) SetTenantID(v uuid.UUID) *ProvisionPolicyUpsertBulk {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *ProvisionPolicyUpsertBulk) UpdateTenantID() *ProvisionPolicyUpsertBulk {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetBoundaries sets the "boundaries" field.
+func (u *ProvisionPolicyUpsertBulk) SetBoundaries(v []int) *ProvisionPolicyUpsertBulk {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.SetBoundaries(v)
+	})
+}
+
+// UpdateBoundaries sets the "boundaries" field to the value that was provided on create.
+func (u *ProvisionPolicyUpsertBulk) UpdateBoundaries() *ProvisionPolicyUpsertBulk {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.UpdateBoundaries()
+	})
+}
+
+// SetLossRates sets the "loss_rates" field.
+func (u *ProvisionPolicyUpsertBulk) SetLossRates(v []decimal.Decimal) *ProvisionPolicyUpsertBulk {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.SetLossRates(v)
+	})
+}
+
+// UpdateLossRates sets the "loss_rates" field to the value that was provided on create.
+func (u *ProvisionPolicyUpsertBulk) UpdateLossRates() *ProvisionPolicyUpsertBulk {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.UpdateLossRates()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ProvisionPolicyUpsertBulk) SetEnabled(v bool) *ProvisionPolicyUpsertBulk {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ProvisionPolicyUpsertBulk) UpdateEnabled() *ProvisionPolicyUpsertBulk {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *ProvisionPolicyUpsertBulk) SetUpdatedBy(v uuid.UUID) *ProvisionPolicyUpsertBulk {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *ProvisionPolicyUpsertBulk) UpdateUpdatedBy() *ProvisionPolicyUpsertBulk {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *ProvisionPolicyUpsertBulk) ClearUpdatedBy() *ProvisionPolicyUpsertBulk {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProvisionPolicyUpsertBulk) SetUpdatedAt(v time.Time) *ProvisionPolicyUpsertBulk {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProvisionPolicyUpsertBulk) UpdateUpdatedAt() *ProvisionPolicyUpsertBulk {
+	return u.Update(func(s *ProvisionPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProvisionPolicyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProvisionPolicyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProvisionPolicyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProvisionPolicyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
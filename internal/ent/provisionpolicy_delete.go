@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/provisionpolicy"
+)
+
+// ProvisionPolicyDelete is the builder for deleting a ProvisionPolicy entity.
+type ProvisionPolicyDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProvisionPolicyMutation
+}
+
+// Where appends a list predicates to the ProvisionPolicyDelete builder.
+func (_d *ProvisionPolicyDelete) Where(ps ...predicate.ProvisionPolicy) *ProvisionPolicyDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ProvisionPolicyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ProvisionPolicyDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ProvisionPolicyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(provisionpolicy.Table, sqlgraph.NewFieldSpec(provisionpolicy.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ProvisionPolicyDeleteOne is the builder for deleting a single ProvisionPolicy entity.
+type ProvisionPolicyDeleteOne struct {
+	_d *ProvisionPolicyDelete
+}
+
+// Where appends a list predicates to the ProvisionPolicyDelete builder.
+func (_d *ProvisionPolicyDeleteOne) Where(ps ...predicate.ProvisionPolicy) *ProvisionPolicyDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ProvisionPolicyDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{provisionpolicy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ProvisionPolicyDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
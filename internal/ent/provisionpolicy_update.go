@@ -0,0 +1,415 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/provisionpolicy"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ProvisionPolicyUpdate is the builder for updating ProvisionPolicy entities.
+type ProvisionPolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProvisionPolicyMutation
+}
+
+// Where appends a list predicates to the ProvisionPolicyUpdate builder.
+func (_u *ProvisionPolicyUpdate) Where(ps ...predicate.ProvisionPolicy) *ProvisionPolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ProvisionPolicyUpdate) SetTenantID(v uuid.UUID) *ProvisionPolicyUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ProvisionPolicyUpdate) SetNillableTenantID(v *uuid.UUID) *ProvisionPolicyUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetBoundaries sets the "boundaries" field.
+func (_u *ProvisionPolicyUpdate) SetBoundaries(v []int) *ProvisionPolicyUpdate {
+	_u.mutation.SetBoundaries(v)
+	return _u
+}
+
+// AppendBoundaries appends value to the "boundaries" field.
+func (_u *ProvisionPolicyUpdate) AppendBoundaries(v []int) *ProvisionPolicyUpdate {
+	_u.mutation.AppendBoundaries(v)
+	return _u
+}
+
+// SetLossRates sets the "loss_rates" field.
+func (_u *ProvisionPolicyUpdate) SetLossRates(v []decimal.Decimal) *ProvisionPolicyUpdate {
+	_u.mutation.SetLossRates(v)
+	return _u
+}
+
+// AppendLossRates appends value to the "loss_rates" field.
+func (_u *ProvisionPolicyUpdate) AppendLossRates(v []decimal.Decimal) *ProvisionPolicyUpdate {
+	_u.mutation.AppendLossRates(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ProvisionPolicyUpdate) SetEnabled(v bool) *ProvisionPolicyUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ProvisionPolicyUpdate) SetNillableEnabled(v *bool) *ProvisionPolicyUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *ProvisionPolicyUpdate) SetUpdatedBy(v uuid.UUID) *ProvisionPolicyUpdate {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *ProvisionPolicyUpdate) SetNillableUpdatedBy(v *uuid.UUID) *ProvisionPolicyUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *ProvisionPolicyUpdate) ClearUpdatedBy() *ProvisionPolicyUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProvisionPolicyUpdate) SetUpdatedAt(v time.Time) *ProvisionPolicyUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ProvisionPolicyMutation object of the builder.
+func (_u *ProvisionPolicyUpdate) Mutation() *ProvisionPolicyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProvisionPolicyUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProvisionPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProvisionPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProvisionPolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProvisionPolicyUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := provisionpolicy.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ProvisionPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(provisionpolicy.Table, provisionpolicy.Columns, sqlgraph.NewFieldSpec(provisionpolicy.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(provisionpolicy.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Boundaries(); ok {
+		_spec.SetField(provisionpolicy.FieldBoundaries, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBoundaries(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, provisionpolicy.FieldBoundaries, value)
+		})
+	}
+	if value, ok := _u.mutation.LossRates(); ok {
+		_spec.SetField(provisionpolicy.FieldLossRates, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLossRates(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, provisionpolicy.FieldLossRates, value)
+		})
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(provisionpolicy.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(provisionpolicy.FieldUpdatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(provisionpolicy.FieldUpdatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(provisionpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{provisionpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProvisionPolicyUpdateOne is the builder for updating a single ProvisionPolicy entity.
+type ProvisionPolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProvisionPolicyMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ProvisionPolicyUpdateOne) SetTenantID(v uuid.UUID) *ProvisionPolicyUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ProvisionPolicyUpdateOne) SetNillableTenantID(v *uuid.UUID) *ProvisionPolicyUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetBoundaries sets the "boundaries" field.
+func (_u *ProvisionPolicyUpdateOne) SetBoundaries(v []int) *ProvisionPolicyUpdateOne {
+	_u.mutation.SetBoundaries(v)
+	return _u
+}
+
+// AppendBoundaries appends value to the "boundaries" field.
+func (_u *ProvisionPolicyUpdateOne) AppendBoundaries(v []int) *ProvisionPolicyUpdateOne {
+	_u.mutation.AppendBoundaries(v)
+	return _u
+}
+
+// SetLossRates sets the "loss_rates" field.
+func (_u *ProvisionPolicyUpdateOne) SetLossRates(v []decimal.Decimal) *ProvisionPolicyUpdateOne {
+	_u.mutation.SetLossRates(v)
+	return _u
+}
+
+// AppendLossRates appends value to the "loss_rates" field.
+func (_u *ProvisionPolicyUpdateOne) AppendLossRates(v []decimal.Decimal) *ProvisionPolicyUpdateOne {
+	_u.mutation.AppendLossRates(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ProvisionPolicyUpdateOne) SetEnabled(v bool) *ProvisionPolicyUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ProvisionPolicyUpdateOne) SetNillableEnabled(v *bool) *ProvisionPolicyUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *ProvisionPolicyUpdateOne) SetUpdatedBy(v uuid.UUID) *ProvisionPolicyUpdateOne {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *ProvisionPolicyUpdateOne) SetNillableUpdatedBy(v *uuid.UUID) *ProvisionPolicyUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *ProvisionPolicyUpdateOne) ClearUpdatedBy() *ProvisionPolicyUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProvisionPolicyUpdateOne) SetUpdatedAt(v time.Time) *ProvisionPolicyUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ProvisionPolicyMutation object of the builder.
+func (_u *ProvisionPolicyUpdateOne) Mutation() *ProvisionPolicyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ProvisionPolicyUpdate builder.
+func (_u *ProvisionPolicyUpdateOne) Where(ps ...predicate.ProvisionPolicy) *ProvisionPolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProvisionPolicyUpdateOne) Select(field string, fields ...string) *ProvisionPolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ProvisionPolicy entity.
+func (_u *ProvisionPolicyUpdateOne) Save(ctx context.Context) (*ProvisionPolicy, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProvisionPolicyUpdateOne) SaveX(ctx context.Context) *ProvisionPolicy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProvisionPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProvisionPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProvisionPolicyUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := provisionpolicy.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ProvisionPolicyUpdateOne) sqlSave(ctx context.Context) (_node *ProvisionPolicy, err error) {
+	_spec := sqlgraph.NewUpdateSpec(provisionpolicy.Table, provisionpolicy.Columns, sqlgraph.NewFieldSpec(provisionpolicy.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProvisionPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, provisionpolicy.FieldID)
+		for _, f := range fields {
+			if !provisionpolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != provisionpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(provisionpolicy.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Boundaries(); ok {
+		_spec.SetField(provisionpolicy.FieldBoundaries, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBoundaries(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, provisionpolicy.FieldBoundaries, value)
+		})
+	}
+	if value, ok := _u.mutation.LossRates(); ok {
+		_spec.SetField(provisionpolicy.FieldLossRates, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLossRates(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, provisionpolicy.FieldLossRates, value)
+		})
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(provisionpolicy.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(provisionpolicy.FieldUpdatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(provisionpolicy.FieldUpdatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(provisionpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ProvisionPolicy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{provisionpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
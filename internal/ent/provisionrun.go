@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/provisionrun"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ProvisionRun is the model entity for the ProvisionRun schema.
+type ProvisionRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// ISO currency code
+	Currency string `json:"currency,omitempty"`
+	// Aging date the allowance was calculated at
+	AsOf time.Time `json:"as_of,omitempty"`
+	// Per bucket exposure, loss rate and allowance
+	Buckets []map[string]interface{} `json:"buckets,omitempty"`
+	// RequiredAllowance holds the value of the "required_allowance" field.
+	RequiredAllowance decimal.Decimal `json:"required_allowance,omitempty"`
+	// PreviousAllowance holds the value of the "previous_allowance" field.
+	PreviousAllowance decimal.Decimal `json:"previous_allowance,omitempty"`
+	// Required less previous allowance; positive charges bad debt expense
+	Adjustment decimal.Decimal `json:"adjustment,omitempty"`
+	// Adjustment journal (empty when no adjustment was needed)
+	JournalEntryID uuid.UUID `json:"journal_entry_id,omitempty"`
+	// Trigger: scheduled, manual
+	Trigger string `json:"trigger,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy uuid.UUID `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProvisionRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case provisionrun.FieldBuckets:
+			values[i] = new([]byte)
+		case provisionrun.FieldRequiredAllowance, provisionrun.FieldPreviousAllowance, provisionrun.FieldAdjustment:
+			values[i] = new(decimal.Decimal)
+		case provisionrun.FieldCurrency, provisionrun.FieldTrigger:
+			values[i] = new(sql.NullString)
+		case provisionrun.FieldAsOf, provisionrun.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case provisionrun.FieldID, provisionrun.FieldTenantID, provisionrun.FieldJournalEntryID, provisionrun.FieldCreatedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProvisionRun fields.
+func (_m *ProvisionRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case provisionrun.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case provisionrun.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case provisionrun.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case provisionrun.FieldAsOf:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field as_of", values[i])
+			} else if value.Valid {
+				_m.AsOf = value.Time
+			}
+		case provisionrun.FieldBuckets:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field buckets", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Buckets); err != nil {
+					return fmt.Errorf("unmarshal field buckets: %w", err)
+				}
+			}
+		case provisionrun.FieldRequiredAllowance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field required_allowance", values[i])
+			} else if value != nil {
+				_m.RequiredAllowance = *value
+			}
+		case provisionrun.FieldPreviousAllowance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_allowance", values[i])
+			} else if value != nil {
+				_m.PreviousAllowance = *value
+			}
+		case provisionrun.FieldAdjustment:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field adjustment", values[i])
+			} else if value != nil {
+				_m.Adjustment = *value
+			}
+		case provisionrun.FieldJournalEntryID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field journal_entry_id", values[i])
+			} else if value != nil {
+				_m.JournalEntryID = *value
+			}
+		case provisionrun.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				_m.Trigger = value.String
+			}
+		case provisionrun.FieldCreatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value != nil {
+				_m.CreatedBy = *value
+			}
+		case provisionrun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProvisionRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *ProvisionRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ProvisionRun.
+// Note that you need to call ProvisionRun.Unwrap() before calling this method if this ProvisionRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ProvisionRun) Update() *ProvisionRunUpdateOne {
+	return NewProvisionRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ProvisionRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ProvisionRun) Unwrap() *ProvisionRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProvisionRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ProvisionRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProvisionRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("as_of=")
+	builder.WriteString(_m.AsOf.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("buckets=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Buckets))
+	builder.WriteString(", ")
+	builder.WriteString("required_allowance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequiredAllowance))
+	builder.WriteString(", ")
+	builder.WriteString("previous_allowance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PreviousAllowance))
+	builder.WriteString(", ")
+	builder.WriteString("adjustment=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Adjustment))
+	builder.WriteString(", ")
+	builder.WriteString("journal_entry_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JournalEntryID))
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(_m.Trigger)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProvisionRuns is a parsable slice of ProvisionRun.
+type ProvisionRuns []*ProvisionRun
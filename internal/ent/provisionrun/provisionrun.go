@@ -0,0 +1,134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package provisionrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the provisionrun type in the database.
+	Label = "provision_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldAsOf holds the string denoting the as_of field in the database.
+	FieldAsOf = "as_of"
+	// FieldBuckets holds the string denoting the buckets field in the database.
+	FieldBuckets = "buckets"
+	// FieldRequiredAllowance holds the string denoting the required_allowance field in the database.
+	FieldRequiredAllowance = "required_allowance"
+	// FieldPreviousAllowance holds the string denoting the previous_allowance field in the database.
+	FieldPreviousAllowance = "previous_allowance"
+	// FieldAdjustment holds the string denoting the adjustment field in the database.
+	FieldAdjustment = "adjustment"
+	// FieldJournalEntryID holds the string denoting the journal_entry_id field in the database.
+	FieldJournalEntryID = "journal_entry_id"
+	// FieldTrigger holds the string denoting the trigger field in the database.
+	FieldTrigger = "trigger"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the provisionrun in the database.
+	Table = "provision_runs"
+)
+
+// Columns holds all SQL columns for provisionrun fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCurrency,
+	FieldAsOf,
+	FieldBuckets,
+	FieldRequiredAllowance,
+	FieldPreviousAllowance,
+	FieldAdjustment,
+	FieldJournalEntryID,
+	FieldTrigger,
+	FieldCreatedBy,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTrigger holds the default value on creation for the "trigger" field.
+	DefaultTrigger string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ProvisionRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByAsOf orders the results by the as_of field.
+func ByAsOf(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAsOf, opts...).ToFunc()
+}
+
+// ByRequiredAllowance orders the results by the required_allowance field.
+func ByRequiredAllowance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequiredAllowance, opts...).ToFunc()
+}
+
+// ByPreviousAllowance orders the results by the previous_allowance field.
+func ByPreviousAllowance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousAllowance, opts...).ToFunc()
+}
+
+// ByAdjustment orders the results by the adjustment field.
+func ByAdjustment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdjustment, opts...).ToFunc()
+}
+
+// ByJournalEntryID orders the results by the journal_entry_id field.
+func ByJournalEntryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJournalEntryID, opts...).ToFunc()
+}
+
+// ByTrigger orders the results by the trigger field.
+func ByTrigger(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrigger, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
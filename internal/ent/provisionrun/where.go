@@ -0,0 +1,592 @@
+// Code generated by ent, DO NOT EDIT.
+
+package provisionrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldCurrency, v))
+}
+
+// AsOf applies equality check predicate on the "as_of" field. It's identical to AsOfEQ.
+func AsOf(v time.Time) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldAsOf, v))
+}
+
+// RequiredAllowance applies equality check predicate on the "required_allowance" field. It's identical to RequiredAllowanceEQ.
+func RequiredAllowance(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldRequiredAllowance, v))
+}
+
+// PreviousAllowance applies equality check predicate on the "previous_allowance" field. It's identical to PreviousAllowanceEQ.
+func PreviousAllowance(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldPreviousAllowance, v))
+}
+
+// Adjustment applies equality check predicate on the "adjustment" field. It's identical to AdjustmentEQ.
+func Adjustment(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldAdjustment, v))
+}
+
+// JournalEntryID applies equality check predicate on the "journal_entry_id" field. It's identical to JournalEntryIDEQ.
+func JournalEntryID(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// Trigger applies equality check predicate on the "trigger" field. It's identical to TriggerEQ.
+func Trigger(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldTrigger, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLTE(FieldTenantID, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// AsOfEQ applies the EQ predicate on the "as_of" field.
+func AsOfEQ(v time.Time) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldAsOf, v))
+}
+
+// AsOfNEQ applies the NEQ predicate on the "as_of" field.
+func AsOfNEQ(v time.Time) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNEQ(FieldAsOf, v))
+}
+
+// AsOfIn applies the In predicate on the "as_of" field.
+func AsOfIn(vs ...time.Time) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldIn(FieldAsOf, vs...))
+}
+
+// AsOfNotIn applies the NotIn predicate on the "as_of" field.
+func AsOfNotIn(vs ...time.Time) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNotIn(FieldAsOf, vs...))
+}
+
+// AsOfGT applies the GT predicate on the "as_of" field.
+func AsOfGT(v time.Time) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGT(FieldAsOf, v))
+}
+
+// AsOfGTE applies the GTE predicate on the "as_of" field.
+func AsOfGTE(v time.Time) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGTE(FieldAsOf, v))
+}
+
+// AsOfLT applies the LT predicate on the "as_of" field.
+func AsOfLT(v time.Time) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLT(FieldAsOf, v))
+}
+
+// AsOfLTE applies the LTE predicate on the "as_of" field.
+func AsOfLTE(v time.Time) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLTE(FieldAsOf, v))
+}
+
+// RequiredAllowanceEQ applies the EQ predicate on the "required_allowance" field.
+func RequiredAllowanceEQ(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldRequiredAllowance, v))
+}
+
+// RequiredAllowanceNEQ applies the NEQ predicate on the "required_allowance" field.
+func RequiredAllowanceNEQ(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNEQ(FieldRequiredAllowance, v))
+}
+
+// RequiredAllowanceIn applies the In predicate on the "required_allowance" field.
+func RequiredAllowanceIn(vs ...decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldIn(FieldRequiredAllowance, vs...))
+}
+
+// RequiredAllowanceNotIn applies the NotIn predicate on the "required_allowance" field.
+func RequiredAllowanceNotIn(vs ...decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNotIn(FieldRequiredAllowance, vs...))
+}
+
+// RequiredAllowanceGT applies the GT predicate on the "required_allowance" field.
+func RequiredAllowanceGT(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGT(FieldRequiredAllowance, v))
+}
+
+// RequiredAllowanceGTE applies the GTE predicate on the "required_allowance" field.
+func RequiredAllowanceGTE(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGTE(FieldRequiredAllowance, v))
+}
+
+// RequiredAllowanceLT applies the LT predicate on the "required_allowance" field.
+func RequiredAllowanceLT(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLT(FieldRequiredAllowance, v))
+}
+
+// RequiredAllowanceLTE applies the LTE predicate on the "required_allowance" field.
+func RequiredAllowanceLTE(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLTE(FieldRequiredAllowance, v))
+}
+
+// PreviousAllowanceEQ applies the EQ predicate on the "previous_allowance" field.
+func PreviousAllowanceEQ(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldPreviousAllowance, v))
+}
+
+// PreviousAllowanceNEQ applies the NEQ predicate on the "previous_allowance" field.
+func PreviousAllowanceNEQ(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNEQ(FieldPreviousAllowance, v))
+}
+
+// PreviousAllowanceIn applies the In predicate on the "previous_allowance" field.
+func PreviousAllowanceIn(vs ...decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldIn(FieldPreviousAllowance, vs...))
+}
+
+// PreviousAllowanceNotIn applies the NotIn predicate on the "previous_allowance" field.
+func PreviousAllowanceNotIn(vs ...decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNotIn(FieldPreviousAllowance, vs...))
+}
+
+// PreviousAllowanceGT applies the GT predicate on the "previous_allowance" field.
+func PreviousAllowanceGT(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGT(FieldPreviousAllowance, v))
+}
+
+// PreviousAllowanceGTE applies the GTE predicate on the "previous_allowance" field.
+func PreviousAllowanceGTE(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGTE(FieldPreviousAllowance, v))
+}
+
+// PreviousAllowanceLT applies the LT predicate on the "previous_allowance" field.
+func PreviousAllowanceLT(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLT(FieldPreviousAllowance, v))
+}
+
+// PreviousAllowanceLTE applies the LTE predicate on the "previous_allowance" field.
+func PreviousAllowanceLTE(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLTE(FieldPreviousAllowance, v))
+}
+
+// AdjustmentEQ applies the EQ predicate on the "adjustment" field.
+func AdjustmentEQ(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldAdjustment, v))
+}
+
+// AdjustmentNEQ applies the NEQ predicate on the "adjustment" field.
+func AdjustmentNEQ(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNEQ(FieldAdjustment, v))
+}
+
+// AdjustmentIn applies the In predicate on the "adjustment" field.
+func AdjustmentIn(vs ...decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldIn(FieldAdjustment, vs...))
+}
+
+// AdjustmentNotIn applies the NotIn predicate on the "adjustment" field.
+func AdjustmentNotIn(vs ...decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNotIn(FieldAdjustment, vs...))
+}
+
+// AdjustmentGT applies the GT predicate on the "adjustment" field.
+func AdjustmentGT(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGT(FieldAdjustment, v))
+}
+
+// AdjustmentGTE applies the GTE predicate on the "adjustment" field.
+func AdjustmentGTE(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGTE(FieldAdjustment, v))
+}
+
+// AdjustmentLT applies the LT predicate on the "adjustment" field.
+func AdjustmentLT(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLT(FieldAdjustment, v))
+}
+
+// AdjustmentLTE applies the LTE predicate on the "adjustment" field.
+func AdjustmentLTE(v decimal.Decimal) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLTE(FieldAdjustment, v))
+}
+
+// JournalEntryIDEQ applies the EQ predicate on the "journal_entry_id" field.
+func JournalEntryIDEQ(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDNEQ applies the NEQ predicate on the "journal_entry_id" field.
+func JournalEntryIDNEQ(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIn applies the In predicate on the "journal_entry_id" field.
+func JournalEntryIDIn(vs ...uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDNotIn applies the NotIn predicate on the "journal_entry_id" field.
+func JournalEntryIDNotIn(vs ...uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNotIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDGT applies the GT predicate on the "journal_entry_id" field.
+func JournalEntryIDGT(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGT(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDGTE applies the GTE predicate on the "journal_entry_id" field.
+func JournalEntryIDGTE(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGTE(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDLT applies the LT predicate on the "journal_entry_id" field.
+func JournalEntryIDLT(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLT(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDLTE applies the LTE predicate on the "journal_entry_id" field.
+func JournalEntryIDLTE(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLTE(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIsNil applies the IsNil predicate on the "journal_entry_id" field.
+func JournalEntryIDIsNil() predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldIsNull(FieldJournalEntryID))
+}
+
+// JournalEntryIDNotNil applies the NotNil predicate on the "journal_entry_id" field.
+func JournalEntryIDNotNil() predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNotNull(FieldJournalEntryID))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// TriggerGT applies the GT predicate on the "trigger" field.
+func TriggerGT(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGT(FieldTrigger, v))
+}
+
+// TriggerGTE applies the GTE predicate on the "trigger" field.
+func TriggerGTE(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGTE(FieldTrigger, v))
+}
+
+// TriggerLT applies the LT predicate on the "trigger" field.
+func TriggerLT(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLT(FieldTrigger, v))
+}
+
+// TriggerLTE applies the LTE predicate on the "trigger" field.
+func TriggerLTE(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLTE(FieldTrigger, v))
+}
+
+// TriggerContains applies the Contains predicate on the "trigger" field.
+func TriggerContains(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldContains(FieldTrigger, v))
+}
+
+// TriggerHasPrefix applies the HasPrefix predicate on the "trigger" field.
+func TriggerHasPrefix(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldHasPrefix(FieldTrigger, v))
+}
+
+// TriggerHasSuffix applies the HasSuffix predicate on the "trigger" field.
+func TriggerHasSuffix(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldHasSuffix(FieldTrigger, v))
+}
+
+// TriggerEqualFold applies the EqualFold predicate on the "trigger" field.
+func TriggerEqualFold(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEqualFold(FieldTrigger, v))
+}
+
+// TriggerContainsFold applies the ContainsFold predicate on the "trigger" field.
+func TriggerContainsFold(v string) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldContainsFold(FieldTrigger, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uuid.UUID) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProvisionRun) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProvisionRun) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProvisionRun) predicate.ProvisionRun {
+	return predicate.ProvisionRun(sql.NotPredicates(p))
+}
@@ -0,0 +1,1140 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/provisionrun"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ProvisionRunCreate is the builder for creating a ProvisionRun entity.
+type ProvisionRunCreate struct {
+	config
+	mutation *ProvisionRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ProvisionRunCreate) SetTenantID(v uuid.UUID) *ProvisionRunCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *ProvisionRunCreate) SetCurrency(v string) *ProvisionRunCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetAsOf sets the "as_of" field.
+func (_c *ProvisionRunCreate) SetAsOf(v time.Time) *ProvisionRunCreate {
+	_c.mutation.SetAsOf(v)
+	return _c
+}
+
+// SetBuckets sets the "buckets" field.
+func (_c *ProvisionRunCreate) SetBuckets(v []map[string]interface{}) *ProvisionRunCreate {
+	_c.mutation.SetBuckets(v)
+	return _c
+}
+
+// SetRequiredAllowance sets the "required_allowance" field.
+func (_c *ProvisionRunCreate) SetRequiredAllowance(v decimal.Decimal) *ProvisionRunCreate {
+	_c.mutation.SetRequiredAllowance(v)
+	return _c
+}
+
+// SetPreviousAllowance sets the "previous_allowance" field.
+func (_c *ProvisionRunCreate) SetPreviousAllowance(v decimal.Decimal) *ProvisionRunCreate {
+	_c.mutation.SetPreviousAllowance(v)
+	return _c
+}
+
+// SetAdjustment sets the "adjustment" field.
+func (_c *ProvisionRunCreate) SetAdjustment(v decimal.Decimal) *ProvisionRunCreate {
+	_c.mutation.SetAdjustment(v)
+	return _c
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_c *ProvisionRunCreate) SetJournalEntryID(v uuid.UUID) *ProvisionRunCreate {
+	_c.mutation.SetJournalEntryID(v)
+	return _c
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_c *ProvisionRunCreate) SetNillableJournalEntryID(v *uuid.UUID) *ProvisionRunCreate {
+	if v != nil {
+		_c.SetJournalEntryID(*v)
+	}
+	return _c
+}
+
+// SetTrigger sets the "trigger" field.
+func (_c *ProvisionRunCreate) SetTrigger(v string) *ProvisionRunCreate {
+	_c.mutation.SetTrigger(v)
+	return _c
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_c *ProvisionRunCreate) SetNillableTrigger(v *string) *ProvisionRunCreate {
+	if v != nil {
+		_c.SetTrigger(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *ProvisionRunCreate) SetCreatedBy(v uuid.UUID) *ProvisionRunCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *ProvisionRunCreate) SetNillableCreatedBy(v *uuid.UUID) *ProvisionRunCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ProvisionRunCreate) SetCreatedAt(v time.Time) *ProvisionRunCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ProvisionRunCreate) SetNillableCreatedAt(v *time.Time) *ProvisionRunCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ProvisionRunCreate) SetID(v uuid.UUID) *ProvisionRunCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ProvisionRunCreate) SetNillableID(v *uuid.UUID) *ProvisionRunCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ProvisionRunMutation object of the builder.
+func (_c *ProvisionRunCreate) Mutation() *ProvisionRunMutation {
+	return _c.mutation
+}
+
+// Save creates the ProvisionRun in the database.
+func (_c *ProvisionRunCreate) Save(ctx context.Context) (*ProvisionRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProvisionRunCreate) SaveX(ctx context.Context) *ProvisionRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProvisionRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProvisionRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProvisionRunCreate) defaults() {
+	if _, ok := _c.mutation.Trigger(); !ok {
+		v := provisionrun.DefaultTrigger
+		_c.mutation.SetTrigger(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := provisionrun.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := provisionrun.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProvisionRunCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ProvisionRun.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "ProvisionRun.currency"`)}
+	}
+	if _, ok := _c.mutation.AsOf(); !ok {
+		return &ValidationError{Name: "as_of", err: errors.New(`ent: missing required field "ProvisionRun.as_of"`)}
+	}
+	if _, ok := _c.mutation.Buckets(); !ok {
+		return &ValidationError{Name: "buckets", err: errors.New(`ent: missing required field "ProvisionRun.buckets"`)}
+	}
+	if _, ok := _c.mutation.RequiredAllowance(); !ok {
+		return &ValidationError{Name: "required_allowance", err: errors.New(`ent: missing required field "ProvisionRun.required_allowance"`)}
+	}
+	if _, ok := _c.mutation.PreviousAllowance(); !ok {
+		return &ValidationError{Name: "previous_allowance", err: errors.New(`ent: missing required field "ProvisionRun.previous_allowance"`)}
+	}
+	if _, ok := _c.mutation.Adjustment(); !ok {
+		return &ValidationError{Name: "adjustment", err: errors.New(`ent: missing required field "ProvisionRun.adjustment"`)}
+	}
+	if _, ok := _c.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "ProvisionRun.trigger"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProvisionRun.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ProvisionRunCreate) sqlSave(ctx context.Context) (*ProvisionRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProvisionRunCreate) createSpec() (*ProvisionRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProvisionRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(provisionrun.Table, sqlgraph.NewFieldSpec(provisionrun.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(provisionrun.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(provisionrun.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.AsOf(); ok {
+		_spec.SetField(provisionrun.FieldAsOf, field.TypeTime, value)
+		_node.AsOf = value
+	}
+	if value, ok := _c.mutation.Buckets(); ok {
+		_spec.SetField(provisionrun.FieldBuckets, field.TypeJSON, value)
+		_node.Buckets = value
+	}
+	if value, ok := _c.mutation.RequiredAllowance(); ok {
+		_spec.SetField(provisionrun.FieldRequiredAllowance, field.TypeFloat64, value)
+		_node.RequiredAllowance = value
+	}
+	if value, ok := _c.mutation.PreviousAllowance(); ok {
+		_spec.SetField(provisionrun.FieldPreviousAllowance, field.TypeFloat64, value)
+		_node.PreviousAllowance = value
+	}
+	if value, ok := _c.mutation.Adjustment(); ok {
+		_spec.SetField(provisionrun.FieldAdjustment, field.TypeFloat64, value)
+		_node.Adjustment = value
+	}
+	if value, ok := _c.mutation.JournalEntryID(); ok {
+		_spec.SetField(provisionrun.FieldJournalEntryID, field.TypeUUID, value)
+		_node.JournalEntryID = value
+	}
+	if value, ok := _c.mutation.Trigger(); ok {
+		_spec.SetField(provisionrun.FieldTrigger, field.TypeString, value)
+		_node.Trigger = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(provisionrun.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(provisionrun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProvisionRun.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProvisionRunUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ProvisionRunCreate) OnConflict(opts ...sql.ConflictOption) *ProvisionRunUpsertOne {
+	_c.conflict = opts
+	return &ProvisionRunUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProvisionRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ProvisionRunCreate) OnConflictColumns(columns ...string) *ProvisionRunUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ProvisionRunUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ProvisionRunUpsertOne is the builder for "upsert"-ing
+	//  one ProvisionRun node.
+	ProvisionRunUpsertOne struct {
+		create *ProvisionRunCreate
+	}
+
+	// ProvisionRunUpsert is the "OnConflict" setter.
+	ProvisionRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *ProvisionRunUpsert) SetTenantID(v uuid.UUID) *ProvisionRunUpsert {
+	u.Set(provisionrun.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *ProvisionRunUpsert) UpdateTenantID() *ProvisionRunUpsert {
+	u.SetExcluded(provisionrun.FieldTenantID)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *ProvisionRunUpsert) SetCurrency(v string) *ProvisionRunUpsert {
+	u.Set(provisionrun.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ProvisionRunUpsert) UpdateCurrency() *ProvisionRunUpsert {
+	u.SetExcluded(provisionrun.FieldCurrency)
+	return u
+}
+
+// SetAsOf sets the "as_of" field.
+func (u *ProvisionRunUpsert) SetAsOf(v time.Time) *ProvisionRunUpsert {
+	u.Set(provisionrun.FieldAsOf, v)
+	return u
+}
+
+// UpdateAsOf sets the "as_of" field to the value that was provided on create.
+func (u *ProvisionRunUpsert) UpdateAsOf() *ProvisionRunUpsert {
+	u.SetExcluded(provisionrun.FieldAsOf)
+	return u
+}
+
+// SetBuckets sets the "buckets" field.
+func (u *ProvisionRunUpsert) SetBuckets(v []map[string]interface{}) *ProvisionRunUpsert {
+	u.Set(provisionrun.FieldBuckets, v)
+	return u
+}
+
+// UpdateBuckets sets the "buckets" field to the value that was provided on create.
+func (u *ProvisionRunUpsert) UpdateBuckets() *ProvisionRunUpsert {
+	u.SetExcluded(provisionrun.FieldBuckets)
+	return u
+}
+
+// SetRequiredAllowance sets the "required_allowance" field.
+func (u *ProvisionRunUpsert) SetRequiredAllowance(v decimal.Decimal) *ProvisionRunUpsert {
+	u.Set(provisionrun.FieldRequiredAllowance, v)
+	return u
+}
+
+// UpdateRequiredAllowance sets the "required_allowance" field to the value that was provided on create.
+func (u *ProvisionRunUpsert) UpdateRequiredAllowance() *ProvisionRunUpsert {
+	u.SetExcluded(provisionrun.FieldRequiredAllowance)
+	return u
+}
+
+// AddRequiredAllowance adds v to the "required_allowance" field.
+func (u *ProvisionRunUpsert) AddRequiredAllowance(v decimal.Decimal) *ProvisionRunUpsert {
+	u.Add(provisionrun.FieldRequiredAllowance, v)
+	return u
+}
+
+// SetPreviousAllowance sets the "previous_allowance" field.
+func (u *ProvisionRunUpsert) SetPreviousAllowance(v decimal.Decimal) *ProvisionRunUpsert {
+	u.Set(provisionrun.FieldPreviousAllowance, v)
+	return u
+}
+
+// UpdatePreviousAllowance sets the "previous_allowance" field to the value that was provided on create.
+func (u *ProvisionRunUpsert) UpdatePreviousAllowance() *ProvisionRunUpsert {
+	u.SetExcluded(provisionrun.FieldPreviousAllowance)
+	return u
+}
+
+// AddPreviousAllowance adds v to the "previous_allowance" field.
+func (u *ProvisionRunUpsert) AddPreviousAllowance(v decimal.Decimal) *ProvisionRunUpsert {
+	u.Add(provisionrun.FieldPreviousAllowance, v)
+	return u
+}
+
+// SetAdjustment sets the "adjustment" field.
+func (u *ProvisionRunUpsert) SetAdjustment(v decimal.Decimal) *ProvisionRunUpsert {
+	u.Set(provisionrun.FieldAdjustment, v)
+	return u
+}
+
+// UpdateAdjustment sets the "adjustment" field to the value that was provided on create.
+func (u *ProvisionRunUpsert) UpdateAdjustment() *ProvisionRunUpsert {
+	u.SetExcluded(provisionrun.FieldAdjustment)
+	return u
+}
+
+// AddAdjustment adds v to the "adjustment" field.
+func (u *ProvisionRunUpsert) AddAdjustment(v decimal.Decimal) *ProvisionRunUpsert {
+	u.Add(provisionrun.FieldAdjustment, v)
+	return u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *ProvisionRunUpsert) SetJournalEntryID(v uuid.UUID) *ProvisionRunUpsert {
+	u.Set(provisionrun.FieldJournalEntryID, v)
+	return u
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *ProvisionRunUpsert) UpdateJournalEntryID() *ProvisionRunUpsert {
+	u.SetExcluded(provisionrun.FieldJournalEntryID)
+	return u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *ProvisionRunUpsert) ClearJournalEntryID() *ProvisionRunUpsert {
+	u.SetNull(provisionrun.FieldJournalEntryID)
+	return u
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *ProvisionRunUpsert) SetTrigger(v string) *ProvisionRunUpsert {
+	u.Set(provisionrun.FieldTrigger, v)
+	return u
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *ProvisionRunUpsert) UpdateTrigger() *ProvisionRunUpsert {
+	u.SetExcluded(provisionrun.FieldTrigger)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ProvisionRunUpsert) SetCreatedBy(v uuid.UUID) *ProvisionRunUpsert {
+	u.Set(provisionrun.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ProvisionRunUpsert) UpdateCreatedBy() *ProvisionRunUpsert {
+	u.SetExcluded(provisionrun.FieldCreatedBy)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ProvisionRunUpsert) ClearCreatedBy() *ProvisionRunUpsert {
+	u.SetNull(provisionrun.FieldCreatedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProvisionRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(provisionrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProvisionRunUpsertOne) UpdateNewValues() *ProvisionRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(provisionrun.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(provisionrun.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProvisionRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProvisionRunUpsertOne) Ignore() *ProvisionRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProvisionRunUpsertOne) DoNothing() *ProvisionRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProvisionRunCreate.OnConflict
+// documentation for more info.
+func (u *ProvisionRunUpsertOne) Update(set func(*ProvisionRunUpsert)) *ProvisionRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProvisionRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *ProvisionRunUpsertOne) SetTenantID(v uuid.UUID) *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *ProvisionRunUpsertOne) UpdateTenantID() *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *ProvisionRunUpsertOne) SetCurrency(v string) *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ProvisionRunUpsertOne) UpdateCurrency() *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetAsOf sets the "as_of" field.
+func (u *ProvisionRunUpsertOne) SetAsOf(v time.Time) *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetAsOf(v)
+	})
+}
+
+// UpdateAsOf sets the "as_of" field to the value that was provided on create.
+func (u *ProvisionRunUpsertOne) UpdateAsOf() *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdateAsOf()
+	})
+}
+
+// SetBuckets sets the "buckets" field.
+func (u *ProvisionRunUpsertOne) SetBuckets(v []map[string]interface{}) *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetBuckets(v)
+	})
+}
+
+// UpdateBuckets sets the "buckets" field to the value that was provided on create.
+func (u *ProvisionRunUpsertOne) UpdateBuckets() *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdateBuckets()
+	})
+}
+
+// SetRequiredAllowance sets the "required_allowance" field.
+func (u *ProvisionRunUpsertOne) SetRequiredAllowance(v decimal.Decimal) *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetRequiredAllowance(v)
+	})
+}
+
+// AddRequiredAllowance adds v to the "required_allowance" field.
+func (u *ProvisionRunUpsertOne) AddRequiredAllowance(v decimal.Decimal) *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.AddRequiredAllowance(v)
+	})
+}
+
+// UpdateRequiredAllowance sets the "required_allowance" field to the value that was provided on create.
+func (u *ProvisionRunUpsertOne) UpdateRequiredAllowance() *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdateRequiredAllowance()
+	})
+}
+
+// SetPreviousAllowance sets the "previous_allowance" field.
+func (u *ProvisionRunUpsertOne) SetPreviousAllowance(v decimal.Decimal) *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetPreviousAllowance(v)
+	})
+}
+
+// AddPreviousAllowance adds v to the "previous_allowance" field.
+func (u *ProvisionRunUpsertOne) AddPreviousAllowance(v decimal.Decimal) *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.AddPreviousAllowance(v)
+	})
+}
+
+// UpdatePreviousAllowance sets the "previous_allowance" field to the value that was provided on create.
+func (u *ProvisionRunUpsertOne) UpdatePreviousAllowance() *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdatePreviousAllowance()
+	})
+}
+
+// SetAdjustment sets the "adjustment" field.
+func (u *ProvisionRunUpsertOne) SetAdjustment(v decimal.Decimal) *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetAdjustment(v)
+	})
+}
+
+// AddAdjustment adds v to the "adjustment" field.
+func (u *ProvisionRunUpsertOne) AddAdjustment(v decimal.Decimal) *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.AddAdjustment(v)
+	})
+}
+
+// UpdateAdjustment sets the "adjustment" field to the value that was provided on create.
+func (u *ProvisionRunUpsertOne) UpdateAdjustment() *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdateAdjustment()
+	})
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *ProvisionRunUpsertOne) SetJournalEntryID(v uuid.UUID) *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetJournalEntryID(v)
+	})
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *ProvisionRunUpsertOne) UpdateJournalEntryID() *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdateJournalEntryID()
+	})
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *ProvisionRunUpsertOne) ClearJournalEntryID() *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.ClearJournalEntryID()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *ProvisionRunUpsertOne) SetTrigger(v string) *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *ProvisionRunUpsertOne) UpdateTrigger() *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ProvisionRunUpsertOne) SetCreatedBy(v uuid.UUID) *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ProvisionRunUpsertOne) UpdateCreatedBy() *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ProvisionRunUpsertOne) ClearCreatedBy() *ProvisionRunUpsertOne {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *ProvisionRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProvisionRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProvisionRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProvisionRunUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProvisionRunUpsertOne.ID is not supported by MySQL driver. Use ProvisionRunUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProvisionRunUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProvisionRunCreateBulk is the builder for creating many ProvisionRun entities in bulk.
+type ProvisionRunCreateBulk struct {
+	config
+	err      error
+	builders []*ProvisionRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProvisionRun entities in the database.
+func (_c *ProvisionRunCreateBulk) Save(ctx context.Context) ([]*ProvisionRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ProvisionRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProvisionRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProvisionRunCreateBulk) SaveX(ctx context.Context) []*ProvisionRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProvisionRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProvisionRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProvisionRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProvisionRunUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ProvisionRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProvisionRunUpsertBulk {
+	_c.conflict = opts
+	return &ProvisionRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProvisionRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ProvisionRunCreateBulk) OnConflictColumns(columns ...string) *ProvisionRunUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ProvisionRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// ProvisionRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProvisionRun nodes.
+type ProvisionRunUpsertBulk struct {
+	create *ProvisionRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProvisionRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(provisionrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProvisionRunUpsertBulk) UpdateNewValues() *ProvisionRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(provisionrun.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(provisionrun.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProvisionRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProvisionRunUpsertBulk) Ignore() *ProvisionRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProvisionRunUpsertBulk) DoNothing() *ProvisionRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProvisionRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProvisionRunUpsertBulk) Update(set func(*ProvisionRunUpsert)) *ProvisionRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProvisionRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *ProvisionRunUpsertBulk) SetTenantID(v uuid.UUID) *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *ProvisionRunUpsertBulk) UpdateTenantID() *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *ProvisionRunUpsertBulk) SetCurrency(v string) *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ProvisionRunUpsertBulk) UpdateCurrency() *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetAsOf sets the "as_of" field.
+func (u *ProvisionRunUpsertBulk) SetAsOf(v time.Time) *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetAsOf(v)
+	})
+}
+
+// UpdateAsOf sets the "as_of" field to the value that was provided on create.
+func (u *ProvisionRunUpsertBulk) UpdateAsOf() *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdateAsOf()
+	})
+}
+
+// SetBuckets sets the "buckets" field.
+func (u *ProvisionRunUpsertBulk) SetBuckets(v []map[string]interface{}) *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetBuckets(v)
+	})
+}
+
+// UpdateBuckets sets the "buckets" field to the value that was provided on create.
+func (u *ProvisionRunUpsertBulk) UpdateBuckets() *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdateBuckets()
+	})
+}
+
+// SetRequiredAllowance sets the "required_allowance" field.
+func (u *ProvisionRunUpsertBulk) SetRequiredAllowance(v decimal.Decimal) *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetRequiredAllowance(v)
+	})
+}
+
+// AddRequiredAllowance adds v to the "required_allowance" field.
+func (u *ProvisionRunUpsertBulk) AddRequiredAllowance(v decimal.Decimal) *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.AddRequiredAllowance(v)
+	})
+}
+
+// UpdateRequiredAllowance sets the "required_allowance" field to the value that was provided on create.
+func (u *ProvisionRunUpsertBulk) UpdateRequiredAllowance() *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdateRequiredAllowance()
+	})
+}
+
+// SetPreviousAllowance sets the "previous_allowance" field.
+func (u *ProvisionRunUpsertBulk) SetPreviousAllowance(v decimal.Decimal) *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetPreviousAllowance(v)
+	})
+}
+
+// AddPreviousAllowance adds v to the "previous_allowance" field.
+func (u *ProvisionRunUpsertBulk) AddPreviousAllowance(v decimal.Decimal) *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.AddPreviousAllowance(v)
+	})
+}
+
+// UpdatePreviousAllowance sets the "previous_allowance" field to the value that was provided on create.
+func (u *ProvisionRunUpsertBulk) UpdatePreviousAllowance() *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdatePreviousAllowance()
+	})
+}
+
+// SetAdjustment sets the "adjustment" field.
+func (u *ProvisionRunUpsertBulk) SetAdjustment(v decimal.Decimal) *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetAdjustment(v)
+	})
+}
+
+// AddAdjustment adds v to the "adjustment" field.
+func (u *ProvisionRunUpsertBulk) AddAdjustment(v decimal.Decimal) *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.AddAdjustment(v)
+	})
+}
+
+// UpdateAdjustment sets the "adjustment" field to the value that was provided on create.
+func (u *ProvisionRunUpsertBulk) UpdateAdjustment() *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdateAdjustment()
+	})
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *ProvisionRunUpsertBulk) SetJournalEntryID(v uuid.UUID) *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetJournalEntryID(v)
+	})
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *ProvisionRunUpsertBulk) UpdateJournalEntryID() *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdateJournalEntryID()
+	})
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *ProvisionRunUpsertBulk) ClearJournalEntryID() *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.ClearJournalEntryID()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *ProvisionRunUpsertBulk) SetTrigger(v string) *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *ProvisionRunUpsertBulk) UpdateTrigger() *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ProvisionRunUpsertBulk) SetCreatedBy(v uuid.UUID) *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ProvisionRunUpsertBulk) UpdateCreatedBy() *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ProvisionRunUpsertBulk) ClearCreatedBy() *ProvisionRunUpsertBulk {
+	return u.Update(func(s *ProvisionRunUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *ProvisionRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProvisionRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProvisionRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProvisionRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
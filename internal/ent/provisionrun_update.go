@@ -0,0 +1,621 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/provisionrun"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ProvisionRunUpdate is the builder for updating ProvisionRun entities.
+type ProvisionRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProvisionRunMutation
+}
+
+// Where appends a list predicates to the ProvisionRunUpdate builder.
+func (_u *ProvisionRunUpdate) Where(ps ...predicate.ProvisionRun) *ProvisionRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ProvisionRunUpdate) SetTenantID(v uuid.UUID) *ProvisionRunUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ProvisionRunUpdate) SetNillableTenantID(v *uuid.UUID) *ProvisionRunUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *ProvisionRunUpdate) SetCurrency(v string) *ProvisionRunUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *ProvisionRunUpdate) SetNillableCurrency(v *string) *ProvisionRunUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetAsOf sets the "as_of" field.
+func (_u *ProvisionRunUpdate) SetAsOf(v time.Time) *ProvisionRunUpdate {
+	_u.mutation.SetAsOf(v)
+	return _u
+}
+
+// SetNillableAsOf sets the "as_of" field if the given value is not nil.
+func (_u *ProvisionRunUpdate) SetNillableAsOf(v *time.Time) *ProvisionRunUpdate {
+	if v != nil {
+		_u.SetAsOf(*v)
+	}
+	return _u
+}
+
+// SetBuckets sets the "buckets" field.
+func (_u *ProvisionRunUpdate) SetBuckets(v []map[string]interface{}) *ProvisionRunUpdate {
+	_u.mutation.SetBuckets(v)
+	return _u
+}
+
+// AppendBuckets appends value to the "buckets" field.
+func (_u *ProvisionRunUpdate) AppendBuckets(v []map[string]interface{}) *ProvisionRunUpdate {
+	_u.mutation.AppendBuckets(v)
+	return _u
+}
+
+// SetRequiredAllowance sets the "required_allowance" field.
+func (_u *ProvisionRunUpdate) SetRequiredAllowance(v decimal.Decimal) *ProvisionRunUpdate {
+	_u.mutation.ResetRequiredAllowance()
+	_u.mutation.SetRequiredAllowance(v)
+	return _u
+}
+
+// SetNillableRequiredAllowance sets the "required_allowance" field if the given value is not nil.
+func (_u *ProvisionRunUpdate) SetNillableRequiredAllowance(v *decimal.Decimal) *ProvisionRunUpdate {
+	if v != nil {
+		_u.SetRequiredAllowance(*v)
+	}
+	return _u
+}
+
+// AddRequiredAllowance adds value to the "required_allowance" field.
+func (_u *ProvisionRunUpdate) AddRequiredAllowance(v decimal.Decimal) *ProvisionRunUpdate {
+	_u.mutation.AddRequiredAllowance(v)
+	return _u
+}
+
+// SetPreviousAllowance sets the "previous_allowance" field.
+func (_u *ProvisionRunUpdate) SetPreviousAllowance(v decimal.Decimal) *ProvisionRunUpdate {
+	_u.mutation.ResetPreviousAllowance()
+	_u.mutation.SetPreviousAllowance(v)
+	return _u
+}
+
+// SetNillablePreviousAllowance sets the "previous_allowance" field if the given value is not nil.
+func (_u *ProvisionRunUpdate) SetNillablePreviousAllowance(v *decimal.Decimal) *ProvisionRunUpdate {
+	if v != nil {
+		_u.SetPreviousAllowance(*v)
+	}
+	return _u
+}
+
+// AddPreviousAllowance adds value to the "previous_allowance" field.
+func (_u *ProvisionRunUpdate) AddPreviousAllowance(v decimal.Decimal) *ProvisionRunUpdate {
+	_u.mutation.AddPreviousAllowance(v)
+	return _u
+}
+
+// SetAdjustment sets the "adjustment" field.
+func (_u *ProvisionRunUpdate) SetAdjustment(v decimal.Decimal) *ProvisionRunUpdate {
+	_u.mutation.ResetAdjustment()
+	_u.mutation.SetAdjustment(v)
+	return _u
+}
+
+// SetNillableAdjustment sets the "adjustment" field if the given value is not nil.
+func (_u *ProvisionRunUpdate) SetNillableAdjustment(v *decimal.Decimal) *ProvisionRunUpdate {
+	if v != nil {
+		_u.SetAdjustment(*v)
+	}
+	return _u
+}
+
+// AddAdjustment adds value to the "adjustment" field.
+func (_u *ProvisionRunUpdate) AddAdjustment(v decimal.Decimal) *ProvisionRunUpdate {
+	_u.mutation.AddAdjustment(v)
+	return _u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_u *ProvisionRunUpdate) SetJournalEntryID(v uuid.UUID) *ProvisionRunUpdate {
+	_u.mutation.SetJournalEntryID(v)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_u *ProvisionRunUpdate) SetNillableJournalEntryID(v *uuid.UUID) *ProvisionRunUpdate {
+	if v != nil {
+		_u.SetJournalEntryID(*v)
+	}
+	return _u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (_u *ProvisionRunUpdate) ClearJournalEntryID() *ProvisionRunUpdate {
+	_u.mutation.ClearJournalEntryID()
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *ProvisionRunUpdate) SetTrigger(v string) *ProvisionRunUpdate {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *ProvisionRunUpdate) SetNillableTrigger(v *string) *ProvisionRunUpdate {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ProvisionRunUpdate) SetCreatedBy(v uuid.UUID) *ProvisionRunUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ProvisionRunUpdate) SetNillableCreatedBy(v *uuid.UUID) *ProvisionRunUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *ProvisionRunUpdate) ClearCreatedBy() *ProvisionRunUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// Mutation returns the ProvisionRunMutation object of the builder.
+func (_u *ProvisionRunUpdate) Mutation() *ProvisionRunMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProvisionRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProvisionRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProvisionRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProvisionRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ProvisionRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(provisionrun.Table, provisionrun.Columns, sqlgraph.NewFieldSpec(provisionrun.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(provisionrun.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(provisionrun.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AsOf(); ok {
+		_spec.SetField(provisionrun.FieldAsOf, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Buckets(); ok {
+		_spec.SetField(provisionrun.FieldBuckets, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBuckets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, provisionrun.FieldBuckets, value)
+		})
+	}
+	if value, ok := _u.mutation.RequiredAllowance(); ok {
+		_spec.SetField(provisionrun.FieldRequiredAllowance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRequiredAllowance(); ok {
+		_spec.AddField(provisionrun.FieldRequiredAllowance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PreviousAllowance(); ok {
+		_spec.SetField(provisionrun.FieldPreviousAllowance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPreviousAllowance(); ok {
+		_spec.AddField(provisionrun.FieldPreviousAllowance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Adjustment(); ok {
+		_spec.SetField(provisionrun.FieldAdjustment, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAdjustment(); ok {
+		_spec.AddField(provisionrun.FieldAdjustment, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.JournalEntryID(); ok {
+		_spec.SetField(provisionrun.FieldJournalEntryID, field.TypeUUID, value)
+	}
+	if _u.mutation.JournalEntryIDCleared() {
+		_spec.ClearField(provisionrun.FieldJournalEntryID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(provisionrun.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(provisionrun.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(provisionrun.FieldCreatedBy, field.TypeUUID)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{provisionrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProvisionRunUpdateOne is the builder for updating a single ProvisionRun entity.
+type ProvisionRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProvisionRunMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ProvisionRunUpdateOne) SetTenantID(v uuid.UUID) *ProvisionRunUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ProvisionRunUpdateOne) SetNillableTenantID(v *uuid.UUID) *ProvisionRunUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *ProvisionRunUpdateOne) SetCurrency(v string) *ProvisionRunUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *ProvisionRunUpdateOne) SetNillableCurrency(v *string) *ProvisionRunUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetAsOf sets the "as_of" field.
+func (_u *ProvisionRunUpdateOne) SetAsOf(v time.Time) *ProvisionRunUpdateOne {
+	_u.mutation.SetAsOf(v)
+	return _u
+}
+
+// SetNillableAsOf sets the "as_of" field if the given value is not nil.
+func (_u *ProvisionRunUpdateOne) SetNillableAsOf(v *time.Time) *ProvisionRunUpdateOne {
+	if v != nil {
+		_u.SetAsOf(*v)
+	}
+	return _u
+}
+
+// SetBuckets sets the "buckets" field.
+func (_u *ProvisionRunUpdateOne) SetBuckets(v []map[string]interface{}) *ProvisionRunUpdateOne {
+	_u.mutation.SetBuckets(v)
+	return _u
+}
+
+// AppendBuckets appends value to the "buckets" field.
+func (_u *ProvisionRunUpdateOne) AppendBuckets(v []map[string]interface{}) *ProvisionRunUpdateOne {
+	_u.mutation.AppendBuckets(v)
+	return _u
+}
+
+// SetRequiredAllowance sets the "required_allowance" field.
+func (_u *ProvisionRunUpdateOne) SetRequiredAllowance(v decimal.Decimal) *ProvisionRunUpdateOne {
+	_u.mutation.ResetRequiredAllowance()
+	_u.mutation.SetRequiredAllowance(v)
+	return _u
+}
+
+// SetNillableRequiredAllowance sets the "required_allowance" field if the given value is not nil.
+func (_u *ProvisionRunUpdateOne) SetNillableRequiredAllowance(v *decimal.Decimal) *ProvisionRunUpdateOne {
+	if v != nil {
+		_u.SetRequiredAllowance(*v)
+	}
+	return _u
+}
+
+// AddRequiredAllowance adds value to the "required_allowance" field.
+func (_u *ProvisionRunUpdateOne) AddRequiredAllowance(v decimal.Decimal) *ProvisionRunUpdateOne {
+	_u.mutation.AddRequiredAllowance(v)
+	return _u
+}
+
+// SetPreviousAllowance sets the "previous_allowance" field.
+func (_u *ProvisionRunUpdateOne) SetPreviousAllowance(v decimal.Decimal) *ProvisionRunUpdateOne {
+	_u.mutation.ResetPreviousAllowance()
+	_u.mutation.SetPreviousAllowance(v)
+	return _u
+}
+
+// SetNillablePreviousAllowance sets the "previous_allowance" field if the given value is not nil.
+func (_u *ProvisionRunUpdateOne) SetNillablePreviousAllowance(v *decimal.Decimal) *ProvisionRunUpdateOne {
+	if v != nil {
+		_u.SetPreviousAllowance(*v)
+	}
+	return _u
+}
+
+// AddPreviousAllowance adds value to the "previous_allowance" field.
+func (_u *ProvisionRunUpdateOne) AddPreviousAllowance(v decimal.Decimal) *ProvisionRunUpdateOne {
+	_u.mutation.AddPreviousAllowance(v)
+	return _u
+}
+
+// SetAdjustment sets the "adjustment" field.
+func (_u *ProvisionRunUpdateOne) SetAdjustment(v decimal.Decimal) *ProvisionRunUpdateOne {
+	_u.mutation.ResetAdjustment()
+	_u.mutation.SetAdjustment(v)
+	return _u
+}
+
+// SetNillableAdjustment sets the "adjustment" field if the given value is not nil.
+func (_u *ProvisionRunUpdateOne) SetNillableAdjustment(v *decimal.Decimal) *ProvisionRunUpdateOne {
+	if v != nil {
+		_u.SetAdjustment(*v)
+	}
+	return _u
+}
+
+// AddAdjustment adds value to the "adjustment" field.
+func (_u *ProvisionRunUpdateOne) AddAdjustment(v decimal.Decimal) *ProvisionRunUpdateOne {
+	_u.mutation.AddAdjustment(v)
+	return _u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_u *ProvisionRunUpdateOne) SetJournalEntryID(v uuid.UUID) *ProvisionRunUpdateOne {
+	_u.mutation.SetJournalEntryID(v)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_u *ProvisionRunUpdateOne) SetNillableJournalEntryID(v *uuid.UUID) *ProvisionRunUpdateOne {
+	if v != nil {
+		_u.SetJournalEntryID(*v)
+	}
+	return _u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (_u *ProvisionRunUpdateOne) ClearJournalEntryID() *ProvisionRunUpdateOne {
+	_u.mutation.ClearJournalEntryID()
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *ProvisionRunUpdateOne) SetTrigger(v string) *ProvisionRunUpdateOne {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *ProvisionRunUpdateOne) SetNillableTrigger(v *string) *ProvisionRunUpdateOne {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ProvisionRunUpdateOne) SetCreatedBy(v uuid.UUID) *ProvisionRunUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ProvisionRunUpdateOne) SetNillableCreatedBy(v *uuid.UUID) *ProvisionRunUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *ProvisionRunUpdateOne) ClearCreatedBy() *ProvisionRunUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// Mutation returns the ProvisionRunMutation object of the builder.
+func (_u *ProvisionRunUpdateOne) Mutation() *ProvisionRunMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ProvisionRunUpdate builder.
+func (_u *ProvisionRunUpdateOne) Where(ps ...predicate.ProvisionRun) *ProvisionRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProvisionRunUpdateOne) Select(field string, fields ...string) *ProvisionRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ProvisionRun entity.
+func (_u *ProvisionRunUpdateOne) Save(ctx context.Context) (*ProvisionRun, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProvisionRunUpdateOne) SaveX(ctx context.Context) *ProvisionRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProvisionRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProvisionRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ProvisionRunUpdateOne) sqlSave(ctx context.Context) (_node *ProvisionRun, err error) {
+	_spec := sqlgraph.NewUpdateSpec(provisionrun.Table, provisionrun.Columns, sqlgraph.NewFieldSpec(provisionrun.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProvisionRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, provisionrun.FieldID)
+		for _, f := range fields {
+			if !provisionrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != provisionrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(provisionrun.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(provisionrun.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AsOf(); ok {
+		_spec.SetField(provisionrun.FieldAsOf, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Buckets(); ok {
+		_spec.SetField(provisionrun.FieldBuckets, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBuckets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, provisionrun.FieldBuckets, value)
+		})
+	}
+	if value, ok := _u.mutation.RequiredAllowance(); ok {
+		_spec.SetField(provisionrun.FieldRequiredAllowance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRequiredAllowance(); ok {
+		_spec.AddField(provisionrun.FieldRequiredAllowance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PreviousAllowance(); ok {
+		_spec.SetField(provisionrun.FieldPreviousAllowance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPreviousAllowance(); ok {
+		_spec.AddField(provisionrun.FieldPreviousAllowance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Adjustment(); ok {
+		_spec.SetField(provisionrun.FieldAdjustment, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAdjustment(); ok {
+		_spec.AddField(provisionrun.FieldAdjustment, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.JournalEntryID(); ok {
+		_spec.SetField(provisionrun.FieldJournalEntryID, field.TypeUUID, value)
+	}
+	if _u.mutation.JournalEntryIDCleared() {
+		_spec.ClearField(provisionrun.FieldJournalEntryID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(provisionrun.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(provisionrun.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(provisionrun.FieldCreatedBy, field.TypeUUID)
+	}
+	_node = &ProvisionRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{provisionrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliation"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Reconciliation is the model entity for the Reconciliation schema.
+type Reconciliation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Bank account identifier
+	BankAccountID uuid.UUID `json:"bank_account_id,omitempty"`
+	// Status: open, finalised
+	Status string `json:"status,omitempty"`
+	// Day after the previous finalised period; empty for the first
+	PeriodStart *time.Time `json:"period_start,omitempty"`
+	// Last day of the finalised period
+	PeriodEnd *time.Time `json:"period_end,omitempty"`
+	// Bank statement balance at the end of the period
+	StatementBalance *decimal.Decimal `json:"statement_balance,omitempty"`
+	// Ledger cash account balance at the end of the period
+	BookBalance *decimal.Decimal `json:"book_balance,omitempty"`
+	// Money in booked but not yet on the statement
+	OutstandingDeposits *decimal.Decimal `json:"outstanding_deposits,omitempty"`
+	// Money out booked but not yet on the statement
+	OutstandingPayments *decimal.Decimal `json:"outstanding_payments,omitempty"`
+	// Net of the statement lines not matched to the books
+	UnrecordedAmount *decimal.Decimal `json:"unrecorded_amount,omitempty"`
+	// Adjusted statement balance less adjusted book balance
+	Difference *decimal.Decimal `json:"difference,omitempty"`
+	// OutstandingItems holds the value of the "outstanding_items" field.
+	OutstandingItems []map[string]interface{} `json:"outstanding_items,omitempty"`
+	// UnrecordedItems holds the value of the "unrecorded_items" field.
+	UnrecordedItems []map[string]interface{} `json:"unrecorded_items,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+	// FinalisedBy holds the value of the "finalised_by" field.
+	FinalisedBy *uuid.UUID `json:"finalised_by,omitempty"`
+	// FinalisedAt holds the value of the "finalised_at" field.
+	FinalisedAt *time.Time `json:"finalised_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReconciliationQuery when eager-loading is set.
+	Edges        ReconciliationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReconciliationEdges holds the relations/edges for other nodes in the graph.
+type ReconciliationEdges struct {
+	// Matches holds the value of the matches edge.
+	Matches []*ReconciliationMatch `json:"matches,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MatchesOrErr returns the Matches value or an error if the edge
+// was not loaded in eager-loading.
+func (e ReconciliationEdges) MatchesOrErr() ([]*ReconciliationMatch, error) {
+	if e.loadedTypes[0] {
+		return e.Matches, nil
+	}
+	return nil, &NotLoadedError{edge: "matches"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Reconciliation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reconciliation.FieldStatementBalance, reconciliation.FieldBookBalance, reconciliation.FieldOutstandingDeposits, reconciliation.FieldOutstandingPayments, reconciliation.FieldUnrecordedAmount, reconciliation.FieldDifference:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case reconciliation.FieldCreatedBy, reconciliation.FieldFinalisedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case reconciliation.FieldOutstandingItems, reconciliation.FieldUnrecordedItems:
+			values[i] = new([]byte)
+		case reconciliation.FieldStatus:
+			values[i] = new(sql.NullString)
+		case reconciliation.FieldPeriodStart, reconciliation.FieldPeriodEnd, reconciliation.FieldFinalisedAt, reconciliation.FieldCreatedAt, reconciliation.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case reconciliation.FieldID, reconciliation.FieldTenantID, reconciliation.FieldBankAccountID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Reconciliation fields.
+func (_m *Reconciliation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reconciliation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case reconciliation.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case reconciliation.FieldBankAccountID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field bank_account_id", values[i])
+			} else if value != nil {
+				_m.BankAccountID = *value
+			}
+		case reconciliation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case reconciliation.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				_m.PeriodStart = new(time.Time)
+				*_m.PeriodStart = value.Time
+			}
+		case reconciliation.FieldPeriodEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_end", values[i])
+			} else if value.Valid {
+				_m.PeriodEnd = new(time.Time)
+				*_m.PeriodEnd = value.Time
+			}
+		case reconciliation.FieldStatementBalance:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field statement_balance", values[i])
+			} else if value.Valid {
+				_m.StatementBalance = new(decimal.Decimal)
+				*_m.StatementBalance = *value.S.(*decimal.Decimal)
+			}
+		case reconciliation.FieldBookBalance:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field book_balance", values[i])
+			} else if value.Valid {
+				_m.BookBalance = new(decimal.Decimal)
+				*_m.BookBalance = *value.S.(*decimal.Decimal)
+			}
+		case reconciliation.FieldOutstandingDeposits:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field outstanding_deposits", values[i])
+			} else if value.Valid {
+				_m.OutstandingDeposits = new(decimal.Decimal)
+				*_m.OutstandingDeposits = *value.S.(*decimal.Decimal)
+			}
+		case reconciliation.FieldOutstandingPayments:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field outstanding_payments", values[i])
+			} else if value.Valid {
+				_m.OutstandingPayments = new(decimal.Decimal)
+				*_m.OutstandingPayments = *value.S.(*decimal.Decimal)
+			}
+		case reconciliation.FieldUnrecordedAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field unrecorded_amount", values[i])
+			} else if value.Valid {
+				_m.UnrecordedAmount = new(decimal.Decimal)
+				*_m.UnrecordedAmount = *value.S.(*decimal.Decimal)
+			}
+		case reconciliation.FieldDifference:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field difference", values[i])
+			} else if value.Valid {
+				_m.Difference = new(decimal.Decimal)
+				*_m.Difference = *value.S.(*decimal.Decimal)
+			}
+		case reconciliation.FieldOutstandingItems:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field outstanding_items", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.OutstandingItems); err != nil {
+					return fmt.Errorf("unmarshal field outstanding_items: %w", err)
+				}
+			}
+		case reconciliation.FieldUnrecordedItems:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field unrecorded_items", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.UnrecordedItems); err != nil {
+					return fmt.Errorf("unmarshal field unrecorded_items: %w", err)
+				}
+			}
+		case reconciliation.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(uuid.UUID)
+				*_m.CreatedBy = *value.S.(*uuid.UUID)
+			}
+		case reconciliation.FieldFinalisedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field finalised_by", values[i])
+			} else if value.Valid {
+				_m.FinalisedBy = new(uuid.UUID)
+				*_m.FinalisedBy = *value.S.(*uuid.UUID)
+			}
+		case reconciliation.FieldFinalisedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finalised_at", values[i])
+			} else if value.Valid {
+				_m.FinalisedAt = new(time.Time)
+				*_m.FinalisedAt = value.Time
+			}
+		case reconciliation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case reconciliation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Reconciliation.
+// This includes values selected through modifiers, order, etc.
+func (_m *Reconciliation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMatches queries the "matches" edge of the Reconciliation entity.
+func (_m *Reconciliation) QueryMatches() *ReconciliationMatchQuery {
+	return NewReconciliationClient(_m.config).QueryMatches(_m)
+}
+
+// Update returns a builder for updating this Reconciliation.
+// Note that you need to call Reconciliation.Unwrap() before calling this method if this Reconciliation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Reconciliation) Update() *ReconciliationUpdateOne {
+	return NewReconciliationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Reconciliation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Reconciliation) Unwrap() *Reconciliation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Reconciliation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Reconciliation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Reconciliation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("bank_account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BankAccountID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.PeriodStart; v != nil {
+		builder.WriteString("period_start=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.PeriodEnd; v != nil {
+		builder.WriteString("period_end=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.StatementBalance; v != nil {
+		builder.WriteString("statement_balance=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.BookBalance; v != nil {
+		builder.WriteString("book_balance=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.OutstandingDeposits; v != nil {
+		builder.WriteString("outstanding_deposits=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.OutstandingPayments; v != nil {
+		builder.WriteString("outstanding_payments=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UnrecordedAmount; v != nil {
+		builder.WriteString("unrecorded_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Difference; v != nil {
+		builder.WriteString("difference=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("outstanding_items=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OutstandingItems))
+	builder.WriteString(", ")
+	builder.WriteString("unrecorded_items=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UnrecordedItems))
+	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.FinalisedBy; v != nil {
+		builder.WriteString("finalised_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.FinalisedAt; v != nil {
+		builder.WriteString("finalised_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reconciliations is a parsable slice of Reconciliation.
+type Reconciliations []*Reconciliation
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reconciliation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the reconciliation type in the database.
+	Label = "reconciliation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldBankAccountID holds the string denoting the bank_account_id field in the database.
+	FieldBankAccountID = "bank_account_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldPeriodStart holds the string denoting the period_start field in the database.
+	FieldPeriodStart = "period_start"
+	// FieldPeriodEnd holds the string denoting the period_end field in the database.
+	FieldPeriodEnd = "period_end"
+	// FieldStatementBalance holds the string denoting the statement_balance field in the database.
+	FieldStatementBalance = "statement_balance"
+	// FieldBookBalance holds the string denoting the book_balance field in the database.
+	FieldBookBalance = "book_balance"
+	// FieldOutstandingDeposits holds the string denoting the outstanding_deposits field in the database.
+	FieldOutstandingDeposits = "outstanding_deposits"
+	// FieldOutstandingPayments holds the string denoting the outstanding_payments field in the database.
+	FieldOutstandingPayments = "outstanding_payments"
+	// FieldUnrecordedAmount holds the string denoting the unrecorded_amount field in the database.
+	FieldUnrecordedAmount = "unrecorded_amount"
+	// FieldDifference holds the string denoting the difference field in the database.
+	FieldDifference = "difference"
+	// FieldOutstandingItems holds the string denoting the outstanding_items field in the database.
+	FieldOutstandingItems = "outstanding_items"
+	// FieldUnrecordedItems holds the string denoting the unrecorded_items field in the database.
+	FieldUnrecordedItems = "unrecorded_items"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldFinalisedBy holds the string denoting the finalised_by field in the database.
+	FieldFinalisedBy = "finalised_by"
+	// FieldFinalisedAt holds the string denoting the finalised_at field in the database.
+	FieldFinalisedAt = "finalised_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeMatches holds the string denoting the matches edge name in mutations.
+	EdgeMatches = "matches"
+	// Table holds the table name of the reconciliation in the database.
+	Table = "reconciliations"
+	// MatchesTable is the table that holds the matches relation/edge.
+	MatchesTable = "reconciliation_matches"
+	// MatchesInverseTable is the table name for the ReconciliationMatch entity.
+	// It exists in this package in order to avoid circular dependency with the "reconciliationmatch" package.
+	MatchesInverseTable = "reconciliation_matches"
+	// MatchesColumn is the table column denoting the matches relation/edge.
+	MatchesColumn = "reconciliation_id"
+)
+
+// Columns holds all SQL columns for reconciliation fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldBankAccountID,
+	FieldStatus,
+	FieldPeriodStart,
+	FieldPeriodEnd,
+	FieldStatementBalance,
+	FieldBookBalance,
+	FieldOutstandingDeposits,
+	FieldOutstandingPayments,
+	FieldUnrecordedAmount,
+	FieldDifference,
+	FieldOutstandingItems,
+	FieldUnrecordedItems,
+	FieldCreatedBy,
+	FieldFinalisedBy,
+	FieldFinalisedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Reconciliation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByBankAccountID orders the results by the bank_account_id field.
+func ByBankAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBankAccountID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByPeriodStart orders the results by the period_start field.
+func ByPeriodStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodStart, opts...).ToFunc()
+}
+
+// ByPeriodEnd orders the results by the period_end field.
+func ByPeriodEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodEnd, opts...).ToFunc()
+}
+
+// ByStatementBalance orders the results by the statement_balance field.
+func ByStatementBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatementBalance, opts...).ToFunc()
+}
+
+// ByBookBalance orders the results by the book_balance field.
+func ByBookBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBookBalance, opts...).ToFunc()
+}
+
+// ByOutstandingDeposits orders the results by the outstanding_deposits field.
+func ByOutstandingDeposits(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutstandingDeposits, opts...).ToFunc()
+}
+
+// ByOutstandingPayments orders the results by the outstanding_payments field.
+func ByOutstandingPayments(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutstandingPayments, opts...).ToFunc()
+}
+
+// ByUnrecordedAmount orders the results by the unrecorded_amount field.
+func ByUnrecordedAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnrecordedAmount, opts...).ToFunc()
+}
+
+// ByDifference orders the results by the difference field.
+func ByDifference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDifference, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByFinalisedBy orders the results by the finalised_by field.
+func ByFinalisedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinalisedBy, opts...).ToFunc()
+}
+
+// ByFinalisedAt orders the results by the finalised_at field.
+func ByFinalisedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinalisedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMatchesCount orders the results by matches count.
+func ByMatchesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMatchesStep(), opts...)
+	}
+}
+
+// ByMatches orders the results by matches terms.
+func ByMatches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMatchesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newMatchesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MatchesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MatchesTable, MatchesColumn),
+	)
+}
@@ -0,0 +1,971 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reconciliation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldTenantID, v))
+}
+
+// BankAccountID applies equality check predicate on the "bank_account_id" field. It's identical to BankAccountIDEQ.
+func BankAccountID(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldBankAccountID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldStatus, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodEnd applies equality check predicate on the "period_end" field. It's identical to PeriodEndEQ.
+func PeriodEnd(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// StatementBalance applies equality check predicate on the "statement_balance" field. It's identical to StatementBalanceEQ.
+func StatementBalance(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldStatementBalance, v))
+}
+
+// BookBalance applies equality check predicate on the "book_balance" field. It's identical to BookBalanceEQ.
+func BookBalance(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldBookBalance, v))
+}
+
+// OutstandingDeposits applies equality check predicate on the "outstanding_deposits" field. It's identical to OutstandingDepositsEQ.
+func OutstandingDeposits(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldOutstandingDeposits, v))
+}
+
+// OutstandingPayments applies equality check predicate on the "outstanding_payments" field. It's identical to OutstandingPaymentsEQ.
+func OutstandingPayments(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldOutstandingPayments, v))
+}
+
+// UnrecordedAmount applies equality check predicate on the "unrecorded_amount" field. It's identical to UnrecordedAmountEQ.
+func UnrecordedAmount(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldUnrecordedAmount, v))
+}
+
+// Difference applies equality check predicate on the "difference" field. It's identical to DifferenceEQ.
+func Difference(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldDifference, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// FinalisedBy applies equality check predicate on the "finalised_by" field. It's identical to FinalisedByEQ.
+func FinalisedBy(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldFinalisedBy, v))
+}
+
+// FinalisedAt applies equality check predicate on the "finalised_at" field. It's identical to FinalisedAtEQ.
+func FinalisedAt(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldFinalisedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLTE(FieldTenantID, v))
+}
+
+// BankAccountIDEQ applies the EQ predicate on the "bank_account_id" field.
+func BankAccountIDEQ(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldBankAccountID, v))
+}
+
+// BankAccountIDNEQ applies the NEQ predicate on the "bank_account_id" field.
+func BankAccountIDNEQ(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNEQ(FieldBankAccountID, v))
+}
+
+// BankAccountIDIn applies the In predicate on the "bank_account_id" field.
+func BankAccountIDIn(vs ...uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIn(FieldBankAccountID, vs...))
+}
+
+// BankAccountIDNotIn applies the NotIn predicate on the "bank_account_id" field.
+func BankAccountIDNotIn(vs ...uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotIn(FieldBankAccountID, vs...))
+}
+
+// BankAccountIDGT applies the GT predicate on the "bank_account_id" field.
+func BankAccountIDGT(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGT(FieldBankAccountID, v))
+}
+
+// BankAccountIDGTE applies the GTE predicate on the "bank_account_id" field.
+func BankAccountIDGTE(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGTE(FieldBankAccountID, v))
+}
+
+// BankAccountIDLT applies the LT predicate on the "bank_account_id" field.
+func BankAccountIDLT(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLT(FieldBankAccountID, v))
+}
+
+// BankAccountIDLTE applies the LTE predicate on the "bank_account_id" field.
+func BankAccountIDLTE(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLTE(FieldBankAccountID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// PeriodStartIsNil applies the IsNil predicate on the "period_start" field.
+func PeriodStartIsNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIsNull(FieldPeriodStart))
+}
+
+// PeriodStartNotNil applies the NotNil predicate on the "period_start" field.
+func PeriodStartNotNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotNull(FieldPeriodStart))
+}
+
+// PeriodEndEQ applies the EQ predicate on the "period_end" field.
+func PeriodEndEQ(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndNEQ applies the NEQ predicate on the "period_end" field.
+func PeriodEndNEQ(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndIn applies the In predicate on the "period_end" field.
+func PeriodEndIn(vs ...time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndNotIn applies the NotIn predicate on the "period_end" field.
+func PeriodEndNotIn(vs ...time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndGT applies the GT predicate on the "period_end" field.
+func PeriodEndGT(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGT(FieldPeriodEnd, v))
+}
+
+// PeriodEndGTE applies the GTE predicate on the "period_end" field.
+func PeriodEndGTE(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndLT applies the LT predicate on the "period_end" field.
+func PeriodEndLT(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLT(FieldPeriodEnd, v))
+}
+
+// PeriodEndLTE applies the LTE predicate on the "period_end" field.
+func PeriodEndLTE(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndIsNil applies the IsNil predicate on the "period_end" field.
+func PeriodEndIsNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIsNull(FieldPeriodEnd))
+}
+
+// PeriodEndNotNil applies the NotNil predicate on the "period_end" field.
+func PeriodEndNotNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotNull(FieldPeriodEnd))
+}
+
+// StatementBalanceEQ applies the EQ predicate on the "statement_balance" field.
+func StatementBalanceEQ(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldStatementBalance, v))
+}
+
+// StatementBalanceNEQ applies the NEQ predicate on the "statement_balance" field.
+func StatementBalanceNEQ(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNEQ(FieldStatementBalance, v))
+}
+
+// StatementBalanceIn applies the In predicate on the "statement_balance" field.
+func StatementBalanceIn(vs ...decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIn(FieldStatementBalance, vs...))
+}
+
+// StatementBalanceNotIn applies the NotIn predicate on the "statement_balance" field.
+func StatementBalanceNotIn(vs ...decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotIn(FieldStatementBalance, vs...))
+}
+
+// StatementBalanceGT applies the GT predicate on the "statement_balance" field.
+func StatementBalanceGT(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGT(FieldStatementBalance, v))
+}
+
+// StatementBalanceGTE applies the GTE predicate on the "statement_balance" field.
+func StatementBalanceGTE(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGTE(FieldStatementBalance, v))
+}
+
+// StatementBalanceLT applies the LT predicate on the "statement_balance" field.
+func StatementBalanceLT(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLT(FieldStatementBalance, v))
+}
+
+// StatementBalanceLTE applies the LTE predicate on the "statement_balance" field.
+func StatementBalanceLTE(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLTE(FieldStatementBalance, v))
+}
+
+// StatementBalanceIsNil applies the IsNil predicate on the "statement_balance" field.
+func StatementBalanceIsNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIsNull(FieldStatementBalance))
+}
+
+// StatementBalanceNotNil applies the NotNil predicate on the "statement_balance" field.
+func StatementBalanceNotNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotNull(FieldStatementBalance))
+}
+
+// BookBalanceEQ applies the EQ predicate on the "book_balance" field.
+func BookBalanceEQ(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldBookBalance, v))
+}
+
+// BookBalanceNEQ applies the NEQ predicate on the "book_balance" field.
+func BookBalanceNEQ(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNEQ(FieldBookBalance, v))
+}
+
+// BookBalanceIn applies the In predicate on the "book_balance" field.
+func BookBalanceIn(vs ...decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIn(FieldBookBalance, vs...))
+}
+
+// BookBalanceNotIn applies the NotIn predicate on the "book_balance" field.
+func BookBalanceNotIn(vs ...decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotIn(FieldBookBalance, vs...))
+}
+
+// BookBalanceGT applies the GT predicate on the "book_balance" field.
+func BookBalanceGT(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGT(FieldBookBalance, v))
+}
+
+// BookBalanceGTE applies the GTE predicate on the "book_balance" field.
+func BookBalanceGTE(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGTE(FieldBookBalance, v))
+}
+
+// BookBalanceLT applies the LT predicate on the "book_balance" field.
+func BookBalanceLT(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLT(FieldBookBalance, v))
+}
+
+// BookBalanceLTE applies the LTE predicate on the "book_balance" field.
+func BookBalanceLTE(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLTE(FieldBookBalance, v))
+}
+
+// BookBalanceIsNil applies the IsNil predicate on the "book_balance" field.
+func BookBalanceIsNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIsNull(FieldBookBalance))
+}
+
+// BookBalanceNotNil applies the NotNil predicate on the "book_balance" field.
+func BookBalanceNotNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotNull(FieldBookBalance))
+}
+
+// OutstandingDepositsEQ applies the EQ predicate on the "outstanding_deposits" field.
+func OutstandingDepositsEQ(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldOutstandingDeposits, v))
+}
+
+// OutstandingDepositsNEQ applies the NEQ predicate on the "outstanding_deposits" field.
+func OutstandingDepositsNEQ(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNEQ(FieldOutstandingDeposits, v))
+}
+
+// OutstandingDepositsIn applies the In predicate on the "outstanding_deposits" field.
+func OutstandingDepositsIn(vs ...decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIn(FieldOutstandingDeposits, vs...))
+}
+
+// OutstandingDepositsNotIn applies the NotIn predicate on the "outstanding_deposits" field.
+func OutstandingDepositsNotIn(vs ...decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotIn(FieldOutstandingDeposits, vs...))
+}
+
+// OutstandingDepositsGT applies the GT predicate on the "outstanding_deposits" field.
+func OutstandingDepositsGT(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGT(FieldOutstandingDeposits, v))
+}
+
+// OutstandingDepositsGTE applies the GTE predicate on the "outstanding_deposits" field.
+func OutstandingDepositsGTE(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGTE(FieldOutstandingDeposits, v))
+}
+
+// OutstandingDepositsLT applies the LT predicate on the "outstanding_deposits" field.
+func OutstandingDepositsLT(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLT(FieldOutstandingDeposits, v))
+}
+
+// OutstandingDepositsLTE applies the LTE predicate on the "outstanding_deposits" field.
+func OutstandingDepositsLTE(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLTE(FieldOutstandingDeposits, v))
+}
+
+// OutstandingDepositsIsNil applies the IsNil predicate on the "outstanding_deposits" field.
+func OutstandingDepositsIsNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIsNull(FieldOutstandingDeposits))
+}
+
+// OutstandingDepositsNotNil applies the NotNil predicate on the "outstanding_deposits" field.
+func OutstandingDepositsNotNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotNull(FieldOutstandingDeposits))
+}
+
+// OutstandingPaymentsEQ applies the EQ predicate on the "outstanding_payments" field.
+func OutstandingPaymentsEQ(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldOutstandingPayments, v))
+}
+
+// OutstandingPaymentsNEQ applies the NEQ predicate on the "outstanding_payments" field.
+func OutstandingPaymentsNEQ(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNEQ(FieldOutstandingPayments, v))
+}
+
+// OutstandingPaymentsIn applies the In predicate on the "outstanding_payments" field.
+func OutstandingPaymentsIn(vs ...decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIn(FieldOutstandingPayments, vs...))
+}
+
+// OutstandingPaymentsNotIn applies the NotIn predicate on the "outstanding_payments" field.
+func OutstandingPaymentsNotIn(vs ...decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotIn(FieldOutstandingPayments, vs...))
+}
+
+// OutstandingPaymentsGT applies the GT predicate on the "outstanding_payments" field.
+func OutstandingPaymentsGT(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGT(FieldOutstandingPayments, v))
+}
+
+// OutstandingPaymentsGTE applies the GTE predicate on the "outstanding_payments" field.
+func OutstandingPaymentsGTE(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGTE(FieldOutstandingPayments, v))
+}
+
+// OutstandingPaymentsLT applies the LT predicate on the "outstanding_payments" field.
+func OutstandingPaymentsLT(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLT(FieldOutstandingPayments, v))
+}
+
+// OutstandingPaymentsLTE applies the LTE predicate on the "outstanding_payments" field.
+func OutstandingPaymentsLTE(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLTE(FieldOutstandingPayments, v))
+}
+
+// OutstandingPaymentsIsNil applies the IsNil predicate on the "outstanding_payments" field.
+func OutstandingPaymentsIsNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIsNull(FieldOutstandingPayments))
+}
+
+// OutstandingPaymentsNotNil applies the NotNil predicate on the "outstanding_payments" field.
+func OutstandingPaymentsNotNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotNull(FieldOutstandingPayments))
+}
+
+// UnrecordedAmountEQ applies the EQ predicate on the "unrecorded_amount" field.
+func UnrecordedAmountEQ(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldUnrecordedAmount, v))
+}
+
+// UnrecordedAmountNEQ applies the NEQ predicate on the "unrecorded_amount" field.
+func UnrecordedAmountNEQ(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNEQ(FieldUnrecordedAmount, v))
+}
+
+// UnrecordedAmountIn applies the In predicate on the "unrecorded_amount" field.
+func UnrecordedAmountIn(vs ...decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIn(FieldUnrecordedAmount, vs...))
+}
+
+// UnrecordedAmountNotIn applies the NotIn predicate on the "unrecorded_amount" field.
+func UnrecordedAmountNotIn(vs ...decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotIn(FieldUnrecordedAmount, vs...))
+}
+
+// UnrecordedAmountGT applies the GT predicate on the "unrecorded_amount" field.
+func UnrecordedAmountGT(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGT(FieldUnrecordedAmount, v))
+}
+
+// UnrecordedAmountGTE applies the GTE predicate on the "unrecorded_amount" field.
+func UnrecordedAmountGTE(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGTE(FieldUnrecordedAmount, v))
+}
+
+// UnrecordedAmountLT applies the LT predicate on the "unrecorded_amount" field.
+func UnrecordedAmountLT(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLT(FieldUnrecordedAmount, v))
+}
+
+// UnrecordedAmountLTE applies the LTE predicate on the "unrecorded_amount" field.
+func UnrecordedAmountLTE(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLTE(FieldUnrecordedAmount, v))
+}
+
+// UnrecordedAmountIsNil applies the IsNil predicate on the "unrecorded_amount" field.
+func UnrecordedAmountIsNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIsNull(FieldUnrecordedAmount))
+}
+
+// UnrecordedAmountNotNil applies the NotNil predicate on the "unrecorded_amount" field.
+func UnrecordedAmountNotNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotNull(FieldUnrecordedAmount))
+}
+
+// DifferenceEQ applies the EQ predicate on the "difference" field.
+func DifferenceEQ(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldDifference, v))
+}
+
+// DifferenceNEQ applies the NEQ predicate on the "difference" field.
+func DifferenceNEQ(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNEQ(FieldDifference, v))
+}
+
+// DifferenceIn applies the In predicate on the "difference" field.
+func DifferenceIn(vs ...decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIn(FieldDifference, vs...))
+}
+
+// DifferenceNotIn applies the NotIn predicate on the "difference" field.
+func DifferenceNotIn(vs ...decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotIn(FieldDifference, vs...))
+}
+
+// DifferenceGT applies the GT predicate on the "difference" field.
+func DifferenceGT(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGT(FieldDifference, v))
+}
+
+// DifferenceGTE applies the GTE predicate on the "difference" field.
+func DifferenceGTE(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGTE(FieldDifference, v))
+}
+
+// DifferenceLT applies the LT predicate on the "difference" field.
+func DifferenceLT(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLT(FieldDifference, v))
+}
+
+// DifferenceLTE applies the LTE predicate on the "difference" field.
+func DifferenceLTE(v decimal.Decimal) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLTE(FieldDifference, v))
+}
+
+// DifferenceIsNil applies the IsNil predicate on the "difference" field.
+func DifferenceIsNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIsNull(FieldDifference))
+}
+
+// DifferenceNotNil applies the NotNil predicate on the "difference" field.
+func DifferenceNotNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotNull(FieldDifference))
+}
+
+// OutstandingItemsIsNil applies the IsNil predicate on the "outstanding_items" field.
+func OutstandingItemsIsNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIsNull(FieldOutstandingItems))
+}
+
+// OutstandingItemsNotNil applies the NotNil predicate on the "outstanding_items" field.
+func OutstandingItemsNotNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotNull(FieldOutstandingItems))
+}
+
+// UnrecordedItemsIsNil applies the IsNil predicate on the "unrecorded_items" field.
+func UnrecordedItemsIsNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIsNull(FieldUnrecordedItems))
+}
+
+// UnrecordedItemsNotNil applies the NotNil predicate on the "unrecorded_items" field.
+func UnrecordedItemsNotNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotNull(FieldUnrecordedItems))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// FinalisedByEQ applies the EQ predicate on the "finalised_by" field.
+func FinalisedByEQ(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldFinalisedBy, v))
+}
+
+// FinalisedByNEQ applies the NEQ predicate on the "finalised_by" field.
+func FinalisedByNEQ(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNEQ(FieldFinalisedBy, v))
+}
+
+// FinalisedByIn applies the In predicate on the "finalised_by" field.
+func FinalisedByIn(vs ...uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIn(FieldFinalisedBy, vs...))
+}
+
+// FinalisedByNotIn applies the NotIn predicate on the "finalised_by" field.
+func FinalisedByNotIn(vs ...uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotIn(FieldFinalisedBy, vs...))
+}
+
+// FinalisedByGT applies the GT predicate on the "finalised_by" field.
+func FinalisedByGT(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGT(FieldFinalisedBy, v))
+}
+
+// FinalisedByGTE applies the GTE predicate on the "finalised_by" field.
+func FinalisedByGTE(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGTE(FieldFinalisedBy, v))
+}
+
+// FinalisedByLT applies the LT predicate on the "finalised_by" field.
+func FinalisedByLT(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLT(FieldFinalisedBy, v))
+}
+
+// FinalisedByLTE applies the LTE predicate on the "finalised_by" field.
+func FinalisedByLTE(v uuid.UUID) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLTE(FieldFinalisedBy, v))
+}
+
+// FinalisedByIsNil applies the IsNil predicate on the "finalised_by" field.
+func FinalisedByIsNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIsNull(FieldFinalisedBy))
+}
+
+// FinalisedByNotNil applies the NotNil predicate on the "finalised_by" field.
+func FinalisedByNotNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotNull(FieldFinalisedBy))
+}
+
+// FinalisedAtEQ applies the EQ predicate on the "finalised_at" field.
+func FinalisedAtEQ(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldFinalisedAt, v))
+}
+
+// FinalisedAtNEQ applies the NEQ predicate on the "finalised_at" field.
+func FinalisedAtNEQ(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNEQ(FieldFinalisedAt, v))
+}
+
+// FinalisedAtIn applies the In predicate on the "finalised_at" field.
+func FinalisedAtIn(vs ...time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIn(FieldFinalisedAt, vs...))
+}
+
+// FinalisedAtNotIn applies the NotIn predicate on the "finalised_at" field.
+func FinalisedAtNotIn(vs ...time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotIn(FieldFinalisedAt, vs...))
+}
+
+// FinalisedAtGT applies the GT predicate on the "finalised_at" field.
+func FinalisedAtGT(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGT(FieldFinalisedAt, v))
+}
+
+// FinalisedAtGTE applies the GTE predicate on the "finalised_at" field.
+func FinalisedAtGTE(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGTE(FieldFinalisedAt, v))
+}
+
+// FinalisedAtLT applies the LT predicate on the "finalised_at" field.
+func FinalisedAtLT(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLT(FieldFinalisedAt, v))
+}
+
+// FinalisedAtLTE applies the LTE predicate on the "finalised_at" field.
+func FinalisedAtLTE(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLTE(FieldFinalisedAt, v))
+}
+
+// FinalisedAtIsNil applies the IsNil predicate on the "finalised_at" field.
+func FinalisedAtIsNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIsNull(FieldFinalisedAt))
+}
+
+// FinalisedAtNotNil applies the NotNil predicate on the "finalised_at" field.
+func FinalisedAtNotNil() predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotNull(FieldFinalisedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasMatches applies the HasEdge predicate on the "matches" edge.
+func HasMatches() predicate.Reconciliation {
+	return predicate.Reconciliation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MatchesTable, MatchesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMatchesWith applies the HasEdge predicate on the "matches" edge with a given conditions (other predicates).
+func HasMatchesWith(preds ...predicate.ReconciliationMatch) predicate.Reconciliation {
+	return predicate.Reconciliation(func(s *sql.Selector) {
+		step := newMatchesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Reconciliation) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Reconciliation) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Reconciliation) predicate.Reconciliation {
+	return predicate.Reconciliation(sql.NotPredicates(p))
+}
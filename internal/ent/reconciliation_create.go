@@ -0,0 +1,1874 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliation"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatch"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationCreate is the builder for creating a Reconciliation entity.
+type ReconciliationCreate struct {
+	config
+	mutation *ReconciliationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ReconciliationCreate) SetTenantID(v uuid.UUID) *ReconciliationCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (_c *ReconciliationCreate) SetBankAccountID(v uuid.UUID) *ReconciliationCreate {
+	_c.mutation.SetBankAccountID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ReconciliationCreate) SetStatus(v string) *ReconciliationCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ReconciliationCreate) SetNillableStatus(v *string) *ReconciliationCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_c *ReconciliationCreate) SetPeriodStart(v time.Time) *ReconciliationCreate {
+	_c.mutation.SetPeriodStart(v)
+	return _c
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_c *ReconciliationCreate) SetNillablePeriodStart(v *time.Time) *ReconciliationCreate {
+	if v != nil {
+		_c.SetPeriodStart(*v)
+	}
+	return _c
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_c *ReconciliationCreate) SetPeriodEnd(v time.Time) *ReconciliationCreate {
+	_c.mutation.SetPeriodEnd(v)
+	return _c
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (_c *ReconciliationCreate) SetNillablePeriodEnd(v *time.Time) *ReconciliationCreate {
+	if v != nil {
+		_c.SetPeriodEnd(*v)
+	}
+	return _c
+}
+
+// SetStatementBalance sets the "statement_balance" field.
+func (_c *ReconciliationCreate) SetStatementBalance(v decimal.Decimal) *ReconciliationCreate {
+	_c.mutation.SetStatementBalance(v)
+	return _c
+}
+
+// SetNillableStatementBalance sets the "statement_balance" field if the given value is not nil.
+func (_c *ReconciliationCreate) SetNillableStatementBalance(v *decimal.Decimal) *ReconciliationCreate {
+	if v != nil {
+		_c.SetStatementBalance(*v)
+	}
+	return _c
+}
+
+// SetBookBalance sets the "book_balance" field.
+func (_c *ReconciliationCreate) SetBookBalance(v decimal.Decimal) *ReconciliationCreate {
+	_c.mutation.SetBookBalance(v)
+	return _c
+}
+
+// SetNillableBookBalance sets the "book_balance" field if the given value is not nil.
+func (_c *ReconciliationCreate) SetNillableBookBalance(v *decimal.Decimal) *ReconciliationCreate {
+	if v != nil {
+		_c.SetBookBalance(*v)
+	}
+	return _c
+}
+
+// SetOutstandingDeposits sets the "outstanding_deposits" field.
+func (_c *ReconciliationCreate) SetOutstandingDeposits(v decimal.Decimal) *ReconciliationCreate {
+	_c.mutation.SetOutstandingDeposits(v)
+	return _c
+}
+
+// SetNillableOutstandingDeposits sets the "outstanding_deposits" field if the given value is not nil.
+func (_c *ReconciliationCreate) SetNillableOutstandingDeposits(v *decimal.Decimal) *ReconciliationCreate {
+	if v != nil {
+		_c.SetOutstandingDeposits(*v)
+	}
+	return _c
+}
+
+// SetOutstandingPayments sets the "outstanding_payments" field.
+func (_c *ReconciliationCreate) SetOutstandingPayments(v decimal.Decimal) *ReconciliationCreate {
+	_c.mutation.SetOutstandingPayments(v)
+	return _c
+}
+
+// SetNillableOutstandingPayments sets the "outstanding_payments" field if the given value is not nil.
+func (_c *ReconciliationCreate) SetNillableOutstandingPayments(v *decimal.Decimal) *ReconciliationCreate {
+	if v != nil {
+		_c.SetOutstandingPayments(*v)
+	}
+	return _c
+}
+
+// SetUnrecordedAmount sets the "unrecorded_amount" field.
+func (_c *ReconciliationCreate) SetUnrecordedAmount(v decimal.Decimal) *ReconciliationCreate {
+	_c.mutation.SetUnrecordedAmount(v)
+	return _c
+}
+
+// SetNillableUnrecordedAmount sets the "unrecorded_amount" field if the given value is not nil.
+func (_c *ReconciliationCreate) SetNillableUnrecordedAmount(v *decimal.Decimal) *ReconciliationCreate {
+	if v != nil {
+		_c.SetUnrecordedAmount(*v)
+	}
+	return _c
+}
+
+// SetDifference sets the "difference" field.
+func (_c *ReconciliationCreate) SetDifference(v decimal.Decimal) *ReconciliationCreate {
+	_c.mutation.SetDifference(v)
+	return _c
+}
+
+// SetNillableDifference sets the "difference" field if the given value is not nil.
+func (_c *ReconciliationCreate) SetNillableDifference(v *decimal.Decimal) *ReconciliationCreate {
+	if v != nil {
+		_c.SetDifference(*v)
+	}
+	return _c
+}
+
+// SetOutstandingItems sets the "outstanding_items" field.
+func (_c *ReconciliationCreate) SetOutstandingItems(v []map[string]interface{}) *ReconciliationCreate {
+	_c.mutation.SetOutstandingItems(v)
+	return _c
+}
+
+// SetUnrecordedItems sets the "unrecorded_items" field.
+func (_c *ReconciliationCreate) SetUnrecordedItems(v []map[string]interface{}) *ReconciliationCreate {
+	_c.mutation.SetUnrecordedItems(v)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *ReconciliationCreate) SetCreatedBy(v uuid.UUID) *ReconciliationCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *ReconciliationCreate) SetNillableCreatedBy(v *uuid.UUID) *ReconciliationCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetFinalisedBy sets the "finalised_by" field.
+func (_c *ReconciliationCreate) SetFinalisedBy(v uuid.UUID) *ReconciliationCreate {
+	_c.mutation.SetFinalisedBy(v)
+	return _c
+}
+
+// SetNillableFinalisedBy sets the "finalised_by" field if the given value is not nil.
+func (_c *ReconciliationCreate) SetNillableFinalisedBy(v *uuid.UUID) *ReconciliationCreate {
+	if v != nil {
+		_c.SetFinalisedBy(*v)
+	}
+	return _c
+}
+
+// SetFinalisedAt sets the "finalised_at" field.
+func (_c *ReconciliationCreate) SetFinalisedAt(v time.Time) *ReconciliationCreate {
+	_c.mutation.SetFinalisedAt(v)
+	return _c
+}
+
+// SetNillableFinalisedAt sets the "finalised_at" field if the given value is not nil.
+func (_c *ReconciliationCreate) SetNillableFinalisedAt(v *time.Time) *ReconciliationCreate {
+	if v != nil {
+		_c.SetFinalisedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReconciliationCreate) SetCreatedAt(v time.Time) *ReconciliationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReconciliationCreate) SetNillableCreatedAt(v *time.Time) *ReconciliationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ReconciliationCreate) SetUpdatedAt(v time.Time) *ReconciliationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ReconciliationCreate) SetNillableUpdatedAt(v *time.Time) *ReconciliationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReconciliationCreate) SetID(v uuid.UUID) *ReconciliationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ReconciliationCreate) SetNillableID(v *uuid.UUID) *ReconciliationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddMatchIDs adds the "matches" edge to the ReconciliationMatch entity by IDs.
+func (_c *ReconciliationCreate) AddMatchIDs(ids ...uuid.UUID) *ReconciliationCreate {
+	_c.mutation.AddMatchIDs(ids...)
+	return _c
+}
+
+// AddMatches adds the "matches" edges to the ReconciliationMatch entity.
+func (_c *ReconciliationCreate) AddMatches(v ...*ReconciliationMatch) *ReconciliationCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMatchIDs(ids...)
+}
+
+// Mutation returns the ReconciliationMutation object of the builder.
+func (_c *ReconciliationCreate) Mutation() *ReconciliationMutation {
+	return _c.mutation
+}
+
+// Save creates the Reconciliation in the database.
+func (_c *ReconciliationCreate) Save(ctx context.Context) (*Reconciliation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReconciliationCreate) SaveX(ctx context.Context) *Reconciliation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReconciliationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReconciliationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReconciliationCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := reconciliation.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := reconciliation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := reconciliation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := reconciliation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReconciliationCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Reconciliation.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.BankAccountID(); !ok {
+		return &ValidationError{Name: "bank_account_id", err: errors.New(`ent: missing required field "Reconciliation.bank_account_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Reconciliation.status"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Reconciliation.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Reconciliation.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ReconciliationCreate) sqlSave(ctx context.Context) (*Reconciliation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReconciliationCreate) createSpec() (*Reconciliation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Reconciliation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(reconciliation.Table, sqlgraph.NewFieldSpec(reconciliation.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(reconciliation.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.BankAccountID(); ok {
+		_spec.SetField(reconciliation.FieldBankAccountID, field.TypeUUID, value)
+		_node.BankAccountID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(reconciliation.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.PeriodStart(); ok {
+		_spec.SetField(reconciliation.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = &value
+	}
+	if value, ok := _c.mutation.PeriodEnd(); ok {
+		_spec.SetField(reconciliation.FieldPeriodEnd, field.TypeTime, value)
+		_node.PeriodEnd = &value
+	}
+	if value, ok := _c.mutation.StatementBalance(); ok {
+		_spec.SetField(reconciliation.FieldStatementBalance, field.TypeFloat64, value)
+		_node.StatementBalance = &value
+	}
+	if value, ok := _c.mutation.BookBalance(); ok {
+		_spec.SetField(reconciliation.FieldBookBalance, field.TypeFloat64, value)
+		_node.BookBalance = &value
+	}
+	if value, ok := _c.mutation.OutstandingDeposits(); ok {
+		_spec.SetField(reconciliation.FieldOutstandingDeposits, field.TypeFloat64, value)
+		_node.OutstandingDeposits = &value
+	}
+	if value, ok := _c.mutation.OutstandingPayments(); ok {
+		_spec.SetField(reconciliation.FieldOutstandingPayments, field.TypeFloat64, value)
+		_node.OutstandingPayments = &value
+	}
+	if value, ok := _c.mutation.UnrecordedAmount(); ok {
+		_spec.SetField(reconciliation.FieldUnrecordedAmount, field.TypeFloat64, value)
+		_node.UnrecordedAmount = &value
+	}
+	if value, ok := _c.mutation.Difference(); ok {
+		_spec.SetField(reconciliation.FieldDifference, field.TypeFloat64, value)
+		_node.Difference = &value
+	}
+	if value, ok := _c.mutation.OutstandingItems(); ok {
+		_spec.SetField(reconciliation.FieldOutstandingItems, field.TypeJSON, value)
+		_node.OutstandingItems = value
+	}
+	if value, ok := _c.mutation.UnrecordedItems(); ok {
+		_spec.SetField(reconciliation.FieldUnrecordedItems, field.TypeJSON, value)
+		_node.UnrecordedItems = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(reconciliation.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.FinalisedBy(); ok {
+		_spec.SetField(reconciliation.FieldFinalisedBy, field.TypeUUID, value)
+		_node.FinalisedBy = &value
+	}
+	if value, ok := _c.mutation.FinalisedAt(); ok {
+		_spec.SetField(reconciliation.FieldFinalisedAt, field.TypeTime, value)
+		_node.FinalisedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(reconciliation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(reconciliation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.MatchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliation.MatchesTable,
+			Columns: []string{reconciliation.MatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Reconciliation.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReconciliationUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReconciliationCreate) OnConflict(opts ...sql.ConflictOption) *ReconciliationUpsertOne {
+	_c.conflict = opts
+	return &ReconciliationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Reconciliation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReconciliationCreate) OnConflictColumns(columns ...string) *ReconciliationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReconciliationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReconciliationUpsertOne is the builder for "upsert"-ing
+	//  one Reconciliation node.
+	ReconciliationUpsertOne struct {
+		create *ReconciliationCreate
+	}
+
+	// ReconciliationUpsert is the "OnConflict" setter.
+	ReconciliationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *ReconciliationUpsert) SetTenantID(v uuid.UUID) *ReconciliationUpsert {
+	u.Set(reconciliation.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *ReconciliationUpsert) UpdateTenantID() *ReconciliationUpsert {
+	u.SetExcluded(reconciliation.FieldTenantID)
+	return u
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (u *ReconciliationUpsert) SetBankAccountID(v uuid.UUID) *ReconciliationUpsert {
+	u.Set(reconciliation.FieldBankAccountID, v)
+	return u
+}
+
+// UpdateBankAccountID sets the "bank_account_id" field to the value that was provided on create.
+func (u *ReconciliationUpsert) UpdateBankAccountID() *ReconciliationUpsert {
+	u.SetExcluded(reconciliation.FieldBankAccountID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReconciliationUpsert) SetStatus(v string) *ReconciliationUpsert {
+	u.Set(reconciliation.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReconciliationUpsert) UpdateStatus() *ReconciliationUpsert {
+	u.SetExcluded(reconciliation.FieldStatus)
+	return u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *ReconciliationUpsert) SetPeriodStart(v time.Time) *ReconciliationUpsert {
+	u.Set(reconciliation.FieldPeriodStart, v)
+	return u
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *ReconciliationUpsert) UpdatePeriodStart() *ReconciliationUpsert {
+	u.SetExcluded(reconciliation.FieldPeriodStart)
+	return u
+}
+
+// ClearPeriodStart clears the value of the "period_start" field.
+func (u *ReconciliationUpsert) ClearPeriodStart() *ReconciliationUpsert {
+	u.SetNull(reconciliation.FieldPeriodStart)
+	return u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *ReconciliationUpsert) SetPeriodEnd(v time.Time) *ReconciliationUpsert {
+	u.Set(reconciliation.FieldPeriodEnd, v)
+	return u
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *ReconciliationUpsert) UpdatePeriodEnd() *ReconciliationUpsert {
+	u.SetExcluded(reconciliation.FieldPeriodEnd)
+	return u
+}
+
+// ClearPeriodEnd clears the value of the "period_end" field.
+func (u *ReconciliationUpsert) ClearPeriodEnd() *ReconciliationUpsert {
+	u.SetNull(reconciliation.FieldPeriodEnd)
+	return u
+}
+
+// SetStatementBalance sets the "statement_balance" field.
+func (u *ReconciliationUpsert) SetStatementBalance(v decimal.Decimal) *ReconciliationUpsert {
+	u.Set(reconciliation.FieldStatementBalance, v)
+	return u
+}
+
+// UpdateStatementBalance sets the "statement_balance" field to the value that was provided on create.
+func (u *ReconciliationUpsert) UpdateStatementBalance() *ReconciliationUpsert {
+	u.SetExcluded(reconciliation.FieldStatementBalance)
+	return u
+}
+
+// AddStatementBalance adds v to the "statement_balance" field.
+func (u *ReconciliationUpsert) AddStatementBalance(v decimal.Decimal) *ReconciliationUpsert {
+	u.Add(reconciliation.FieldStatementBalance, v)
+	return u
+}
+
+// ClearStatementBalance clears the value of the "statement_balance" field.
+func (u *ReconciliationUpsert) ClearStatementBalance() *ReconciliationUpsert {
+	u.SetNull(reconciliation.FieldStatementBalance)
+	return u
+}
+
+// SetBookBalance sets the "book_balance" field.
+func (u *ReconciliationUpsert) SetBookBalance(v decimal.Decimal) *ReconciliationUpsert {
+	u.Set(reconciliation.FieldBookBalance, v)
+	return u
+}
+
+// UpdateBookBalance sets the "book_balance" field to the value that was provided on create.
+func (u *ReconciliationUpsert) UpdateBookBalance() *ReconciliationUpsert {
+	u.SetExcluded(reconciliation.FieldBookBalance)
+	return u
+}
+
+// AddBookBalance adds v to the "book_balance" field.
+func (u *ReconciliationUpsert) AddBookBalance(v decimal.Decimal) *ReconciliationUpsert {
+	u.Add(reconciliation.FieldBookBalance, v)
+	return u
+}
+
+// ClearBookBalance clears the value of the "book_balance" field.
+func (u *ReconciliationUpsert) ClearBookBalance() *ReconciliationUpsert {
+	u.SetNull(reconciliation.FieldBookBalance)
+	return u
+}
+
+// SetOutstandingDeposits sets the "outstanding_deposits" field.
+func (u *ReconciliationUpsert) SetOutstandingDeposits(v decimal.Decimal) *ReconciliationUpsert {
+	u.Set(reconciliation.FieldOutstandingDeposits, v)
+	return u
+}
+
+// UpdateOutstandingDeposits sets the "outstanding_deposits" field to the value that was provided on create.
+func (u *ReconciliationUpsert) UpdateOutstandingDeposits() *ReconciliationUpsert {
+	u.SetExcluded(reconciliation.FieldOutstandingDeposits)
+	return u
+}
+
+// AddOutstandingDeposits adds v to the "outstanding_deposits" field.
+func (u *ReconciliationUpsert) AddOutstandingDeposits(v decimal.Decimal) *ReconciliationUpsert {
+	u.Add(reconciliation.FieldOutstandingDeposits, v)
+	return u
+}
+
+// ClearOutstandingDeposits clears the value of the "outstanding_deposits" field.
+func (u *ReconciliationUpsert) ClearOutstandingDeposits() *ReconciliationUpsert {
+	u.SetNull(reconciliation.FieldOutstandingDeposits)
+	return u
+}
+
+// SetOutstandingPayments sets the "outstanding_payments" field.
+func (u *ReconciliationUpsert) SetOutstandingPayments(v decimal.Decimal) *ReconciliationUpsert {
+	u.Set(reconciliation.FieldOutstandingPayments, v)
+	return u
+}
+
+// UpdateOutstandingPayments sets the "outstanding_payments" field to the value that was provided on create.
+func (u *ReconciliationUpsert) UpdateOutstandingPayments() *ReconciliationUpsert {
+	u.SetExcluded(reconciliation.FieldOutstandingPayments)
+	return u
+}
+
+// AddOutstandingPayments adds v to the "outstanding_payments" field.
+func (u *ReconciliationUpsert) AddOutstandingPayments(v decimal.Decimal) *ReconciliationUpsert {
+	u.Add(reconciliation.FieldOutstandingPayments, v)
+	return u
+}
+
+// ClearOutstandingPayments clears the value of the "outstanding_payments" field.
+func (u *ReconciliationUpsert) ClearOutstandingPayments() *ReconciliationUpsert {
+	u.SetNull(reconciliation.FieldOutstandingPayments)
+	return u
+}
+
+// SetUnrecordedAmount sets the "unrecorded_amount" field.
+func (u *ReconciliationUpsert) SetUnrecordedAmount(v decimal.Decimal) *ReconciliationUpsert {
+	u.Set(reconciliation.FieldUnrecordedAmount, v)
+	return u
+}
+
+// UpdateUnrecordedAmount sets the "unrecorded_amount" field to the value that was provided on create.
+func (u *ReconciliationUpsert) UpdateUnrecordedAmount() *ReconciliationUpsert {
+	u.SetExcluded(reconciliation.FieldUnrecordedAmount)
+	return u
+}
+
+// AddUnrecordedAmount adds v to the "unrecorded_amount" field.
+func (u *ReconciliationUpsert) AddUnrecordedAmount(v decimal.Decimal) *ReconciliationUpsert {
+	u.Add(reconciliation.FieldUnrecordedAmount, v)
+	return u
+}
+
+// ClearUnrecordedAmount clears the value of the "unrecorded_amount" field.
+func (u *ReconciliationUpsert) ClearUnrecordedAmount() *ReconciliationUpsert {
+	u.SetNull(reconciliation.FieldUnrecordedAmount)
+	return u
+}
+
+// SetDifference sets the "difference" field.
+func (u *ReconciliationUpsert) SetDifference(v decimal.Decimal) *ReconciliationUpsert {
+	u.Set(reconciliation.FieldDifference, v)
+	return u
+}
+
+// UpdateDifference sets the "difference" field to the value that was provided on create.
+func (u *ReconciliationUpsert) UpdateDifference() *ReconciliationUpsert {
+	u.SetExcluded(reconciliation.FieldDifference)
+	return u
+}
+
+// AddDifference adds v to the "difference" field.
+func (u *ReconciliationUpsert) AddDifference(v decimal.Decimal) *ReconciliationUpsert {
+	u.Add(reconciliation.FieldDifference, v)
+	return u
+}
+
+// ClearDifference clears the value of the "difference" field.
+func (u *ReconciliationUpsert) ClearDifference() *ReconciliationUpsert {
+	u.SetNull(reconciliation.FieldDifference)
+	return u
+}
+
+// SetOutstandingItems sets the "outstanding_items" field.
+func (u *ReconciliationUpsert) SetOutstandingItems(v []map[string]interface{}) *ReconciliationUpsert {
+	u.Set(reconciliation.FieldOutstandingItems, v)
+	return u
+}
+
+// UpdateOutstandingItems sets the "outstanding_items" field to the value that was provided on create.
+func (u *ReconciliationUpsert) UpdateOutstandingItems() *ReconciliationUpsert {
+	u.SetExcluded(reconciliation.FieldOutstandingItems)
+	return u
+}
+
+// ClearOutstandingItems clears the value of the "outstanding_items" field.
+func (u *ReconciliationUpsert) ClearOutstandingItems() *ReconciliationUpsert {
+	u.SetNull(reconciliation.FieldOutstandingItems)
+	return u
+}
+
+// SetUnrecordedItems sets the "unrecorded_items" field.
+func (u *ReconciliationUpsert) SetUnrecordedItems(v []map[string]interface{}) *ReconciliationUpsert {
+	u.Set(reconciliation.FieldUnrecordedItems, v)
+	return u
+}
+
+// UpdateUnrecordedItems sets the "unrecorded_items" field to the value that was provided on create.
+func (u *ReconciliationUpsert) UpdateUnrecordedItems() *ReconciliationUpsert {
+	u.SetExcluded(reconciliation.FieldUnrecordedItems)
+	return u
+}
+
+// ClearUnrecordedItems clears the value of the "unrecorded_items" field.
+func (u *ReconciliationUpsert) ClearUnrecordedItems() *ReconciliationUpsert {
+	u.SetNull(reconciliation.FieldUnrecordedItems)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ReconciliationUpsert) SetCreatedBy(v uuid.UUID) *ReconciliationUpsert {
+	u.Set(reconciliation.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ReconciliationUpsert) UpdateCreatedBy() *ReconciliationUpsert {
+	u.SetExcluded(reconciliation.FieldCreatedBy)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ReconciliationUpsert) ClearCreatedBy() *ReconciliationUpsert {
+	u.SetNull(reconciliation.FieldCreatedBy)
+	return u
+}
+
+// SetFinalisedBy sets the "finalised_by" field.
+func (u *ReconciliationUpsert) SetFinalisedBy(v uuid.UUID) *ReconciliationUpsert {
+	u.Set(reconciliation.FieldFinalisedBy, v)
+	return u
+}
+
+// UpdateFinalisedBy sets the "finalised_by" field to the value that was provided on create.
+func (u *ReconciliationUpsert) UpdateFinalisedBy() *ReconciliationUpsert {
+	u.SetExcluded(reconciliation.FieldFinalisedBy)
+	return u
+}
+
+// ClearFinalisedBy clears the value of the "finalised_by" field.
+func (u *ReconciliationUpsert) ClearFinalisedBy() *ReconciliationUpsert {
+	u.SetNull(reconciliation.FieldFinalisedBy)
+	return u
+}
+
+// SetFinalisedAt sets the "finalised_at" field.
+func (u *ReconciliationUpsert) SetFinalisedAt(v time.Time) *ReconciliationUpsert {
+	u.Set(reconciliation.FieldFinalisedAt, v)
+	return u
+}
+
+// UpdateFinalisedAt sets the "finalised_at" field to the value that was provided on create.
+func (u *ReconciliationUpsert) UpdateFinalisedAt() *ReconciliationUpsert {
+	u.SetExcluded(reconciliation.FieldFinalisedAt)
+	return u
+}
+
+// ClearFinalisedAt clears the value of the "finalised_at" field.
+func (u *ReconciliationUpsert) ClearFinalisedAt() *ReconciliationUpsert {
+	u.SetNull(reconciliation.FieldFinalisedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReconciliationUpsert) SetUpdatedAt(v time.Time) *ReconciliationUpsert {
+	u.Set(reconciliation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReconciliationUpsert) UpdateUpdatedAt() *ReconciliationUpsert {
+	u.SetExcluded(reconciliation.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Reconciliation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reconciliation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReconciliationUpsertOne) UpdateNewValues() *ReconciliationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reconciliation.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(reconciliation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Reconciliation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReconciliationUpsertOne) Ignore() *ReconciliationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReconciliationUpsertOne) DoNothing() *ReconciliationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReconciliationCreate.OnConflict
+// documentation for more info.
+func (u *ReconciliationUpsertOne) Update(set func(*ReconciliationUpsert)) *ReconciliationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReconciliationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *ReconciliationUpsertOne) SetTenantID(v uuid.UUID) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *ReconciliationUpsertOne) UpdateTenantID() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (u *ReconciliationUpsertOne) SetBankAccountID(v uuid.UUID) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetBankAccountID(v)
+	})
+}
+
+// UpdateBankAccountID sets the "bank_account_id" field to the value that was provided on create.
+func (u *ReconciliationUpsertOne) UpdateBankAccountID() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateBankAccountID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReconciliationUpsertOne) SetStatus(v string) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReconciliationUpsertOne) UpdateStatus() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *ReconciliationUpsertOne) SetPeriodStart(v time.Time) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *ReconciliationUpsertOne) UpdatePeriodStart() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// ClearPeriodStart clears the value of the "period_start" field.
+func (u *ReconciliationUpsertOne) ClearPeriodStart() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearPeriodStart()
+	})
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *ReconciliationUpsertOne) SetPeriodEnd(v time.Time) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetPeriodEnd(v)
+	})
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *ReconciliationUpsertOne) UpdatePeriodEnd() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdatePeriodEnd()
+	})
+}
+
+// ClearPeriodEnd clears the value of the "period_end" field.
+func (u *ReconciliationUpsertOne) ClearPeriodEnd() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearPeriodEnd()
+	})
+}
+
+// SetStatementBalance sets the "statement_balance" field.
+func (u *ReconciliationUpsertOne) SetStatementBalance(v decimal.Decimal) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetStatementBalance(v)
+	})
+}
+
+// AddStatementBalance adds v to the "statement_balance" field.
+func (u *ReconciliationUpsertOne) AddStatementBalance(v decimal.Decimal) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.AddStatementBalance(v)
+	})
+}
+
+// UpdateStatementBalance sets the "statement_balance" field to the value that was provided on create.
+func (u *ReconciliationUpsertOne) UpdateStatementBalance() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateStatementBalance()
+	})
+}
+
+// ClearStatementBalance clears the value of the "statement_balance" field.
+func (u *ReconciliationUpsertOne) ClearStatementBalance() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearStatementBalance()
+	})
+}
+
+// SetBookBalance sets the "book_balance" field.
+func (u *ReconciliationUpsertOne) SetBookBalance(v decimal.Decimal) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetBookBalance(v)
+	})
+}
+
+// AddBookBalance adds v to the "book_balance" field.
+func (u *ReconciliationUpsertOne) AddBookBalance(v decimal.Decimal) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.AddBookBalance(v)
+	})
+}
+
+// UpdateBookBalance sets the "book_balance" field to the value that was provided on create.
+func (u *ReconciliationUpsertOne) UpdateBookBalance() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateBookBalance()
+	})
+}
+
+// ClearBookBalance clears the value of the "book_balance" field.
+func (u *ReconciliationUpsertOne) ClearBookBalance() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearBookBalance()
+	})
+}
+
+// SetOutstandingDeposits sets the "outstanding_deposits" field.
+func (u *ReconciliationUpsertOne) SetOutstandingDeposits(v decimal.Decimal) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetOutstandingDeposits(v)
+	})
+}
+
+// AddOutstandingDeposits adds v to the "outstanding_deposits" field.
+func (u *ReconciliationUpsertOne) AddOutstandingDeposits(v decimal.Decimal) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.AddOutstandingDeposits(v)
+	})
+}
+
+// UpdateOutstandingDeposits sets the "outstanding_deposits" field to the value that was provided on create.
+func (u *ReconciliationUpsertOne) UpdateOutstandingDeposits() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateOutstandingDeposits()
+	})
+}
+
+// ClearOutstandingDeposits clears the value of the "outstanding_deposits" field.
+func (u *ReconciliationUpsertOne) ClearOutstandingDeposits() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearOutstandingDeposits()
+	})
+}
+
+// SetOutstandingPayments sets the "outstanding_payments" field.
+func (u *ReconciliationUpsertOne) SetOutstandingPayments(v decimal.Decimal) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetOutstandingPayments(v)
+	})
+}
+
+// AddOutstandingPayments adds v to the "outstanding_payments" field.
+func (u *ReconciliationUpsertOne) AddOutstandingPayments(v decimal.Decimal) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.AddOutstandingPayments(v)
+	})
+}
+
+// UpdateOutstandingPayments sets the "outstanding_payments" field to the value that was provided on create.
+func (u *ReconciliationUpsertOne) UpdateOutstandingPayments() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateOutstandingPayments()
+	})
+}
+
+// ClearOutstandingPayments clears the value of the "outstanding_payments" field.
+func (u *ReconciliationUpsertOne) ClearOutstandingPayments() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearOutstandingPayments()
+	})
+}
+
+// SetUnrecordedAmount sets the "unrecorded_amount" field.
+func (u *ReconciliationUpsertOne) SetUnrecordedAmount(v decimal.Decimal) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetUnrecordedAmount(v)
+	})
+}
+
+// AddUnrecordedAmount adds v to the "unrecorded_amount" field.
+func (u *ReconciliationUpsertOne) AddUnrecordedAmount(v decimal.Decimal) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.AddUnrecordedAmount(v)
+	})
+}
+
+// UpdateUnrecordedAmount sets the "unrecorded_amount" field to the value that was provided on create.
+func (u *ReconciliationUpsertOne) UpdateUnrecordedAmount() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateUnrecordedAmount()
+	})
+}
+
+// ClearUnrecordedAmount clears the value of the "unrecorded_amount" field.
+func (u *ReconciliationUpsertOne) ClearUnrecordedAmount() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearUnrecordedAmount()
+	})
+}
+
+// SetDifference sets the "difference" field.
+func (u *ReconciliationUpsertOne) SetDifference(v decimal.Decimal) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetDifference(v)
+	})
+}
+
+// AddDifference adds v to the "difference" field.
+func (u *ReconciliationUpsertOne) AddDifference(v decimal.Decimal) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.AddDifference(v)
+	})
+}
+
+// UpdateDifference sets the "difference" field to the value that was provided on create.
+func (u *ReconciliationUpsertOne) UpdateDifference() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateDifference()
+	})
+}
+
+// ClearDifference clears the value of the "difference" field.
+func (u *ReconciliationUpsertOne) ClearDifference() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearDifference()
+	})
+}
+
+// SetOutstandingItems sets the "outstanding_items" field.
+func (u *ReconciliationUpsertOne) SetOutstandingItems(v []map[string]interface{}) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetOutstandingItems(v)
+	})
+}
+
+// UpdateOutstandingItems sets the "outstanding_items" field to the value that was provided on create.
+func (u *ReconciliationUpsertOne) UpdateOutstandingItems() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateOutstandingItems()
+	})
+}
+
+// ClearOutstandingItems clears the value of the "outstanding_items" field.
+func (u *ReconciliationUpsertOne) ClearOutstandingItems() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearOutstandingItems()
+	})
+}
+
+// SetUnrecordedItems sets the "unrecorded_items" field.
+func (u *ReconciliationUpsertOne) SetUnrecordedItems(v []map[string]interface{}) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetUnrecordedItems(v)
+	})
+}
+
+// UpdateUnrecordedItems sets the "unrecorded_items" field to the value that was provided on create.
+func (u *ReconciliationUpsertOne) UpdateUnrecordedItems() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateUnrecordedItems()
+	})
+}
+
+// ClearUnrecordedItems clears the value of the "unrecorded_items" field.
+func (u *ReconciliationUpsertOne) ClearUnrecordedItems() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearUnrecordedItems()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ReconciliationUpsertOne) SetCreatedBy(v uuid.UUID) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ReconciliationUpsertOne) UpdateCreatedBy() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ReconciliationUpsertOne) ClearCreatedBy() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetFinalisedBy sets the "finalised_by" field.
+func (u *ReconciliationUpsertOne) SetFinalisedBy(v uuid.UUID) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetFinalisedBy(v)
+	})
+}
+
+// UpdateFinalisedBy sets the "finalised_by" field to the value that was provided on create.
+func (u *ReconciliationUpsertOne) UpdateFinalisedBy() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateFinalisedBy()
+	})
+}
+
+// ClearFinalisedBy clears the value of the "finalised_by" field.
+func (u *ReconciliationUpsertOne) ClearFinalisedBy() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearFinalisedBy()
+	})
+}
+
+// SetFinalisedAt sets the "finalised_at" field.
+func (u *ReconciliationUpsertOne) SetFinalisedAt(v time.Time) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetFinalisedAt(v)
+	})
+}
+
+// UpdateFinalisedAt sets the "finalised_at" field to the value that was provided on create.
+func (u *ReconciliationUpsertOne) UpdateFinalisedAt() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateFinalisedAt()
+	})
+}
+
+// ClearFinalisedAt clears the value of the "finalised_at" field.
+func (u *ReconciliationUpsertOne) ClearFinalisedAt() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearFinalisedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReconciliationUpsertOne) SetUpdatedAt(v time.Time) *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReconciliationUpsertOne) UpdateUpdatedAt() *ReconciliationUpsertOne {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReconciliationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReconciliationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReconciliationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReconciliationUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReconciliationUpsertOne.ID is not supported by MySQL driver. Use ReconciliationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReconciliationUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReconciliationCreateBulk is the builder for creating many Reconciliation entities in bulk.
+type ReconciliationCreateBulk struct {
+	config
+	err      error
+	builders []*ReconciliationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Reconciliation entities in the database.
+func (_c *ReconciliationCreateBulk) Save(ctx context.Context) ([]*Reconciliation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Reconciliation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReconciliationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReconciliationCreateBulk) SaveX(ctx context.Context) []*Reconciliation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReconciliationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReconciliationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Reconciliation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReconciliationUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReconciliationCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReconciliationUpsertBulk {
+	_c.conflict = opts
+	return &ReconciliationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Reconciliation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReconciliationCreateBulk) OnConflictColumns(columns ...string) *ReconciliationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReconciliationUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReconciliationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Reconciliation nodes.
+type ReconciliationUpsertBulk struct {
+	create *ReconciliationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Reconciliation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reconciliation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReconciliationUpsertBulk) UpdateNewValues() *ReconciliationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reconciliation.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(reconciliation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Reconciliation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReconciliationUpsertBulk) Ignore() *ReconciliationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReconciliationUpsertBulk) DoNothing() *ReconciliationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReconciliationCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReconciliationUpsertBulk) Update(set func(*ReconciliationUpsert)) *ReconciliationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReconciliationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *ReconciliationUpsertBulk) SetTenantID(v uuid.UUID) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *ReconciliationUpsertBulk) UpdateTenantID() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (u *ReconciliationUpsertBulk) SetBankAccountID(v uuid.UUID) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetBankAccountID(v)
+	})
+}
+
+// UpdateBankAccountID sets the "bank_account_id" field to the value that was provided on create.
+func (u *ReconciliationUpsertBulk) UpdateBankAccountID() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateBankAccountID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReconciliationUpsertBulk) SetStatus(v string) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReconciliationUpsertBulk) UpdateStatus() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *ReconciliationUpsertBulk) SetPeriodStart(v time.Time) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *ReconciliationUpsertBulk) UpdatePeriodStart() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// ClearPeriodStart clears the value of the "period_start" field.
+func (u *ReconciliationUpsertBulk) ClearPeriodStart() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearPeriodStart()
+	})
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *ReconciliationUpsertBulk) SetPeriodEnd(v time.Time) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetPeriodEnd(v)
+	})
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *ReconciliationUpsertBulk) UpdatePeriodEnd() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdatePeriodEnd()
+	})
+}
+
+// ClearPeriodEnd clears the value of the "period_end" field.
+func (u *ReconciliationUpsertBulk) ClearPeriodEnd() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearPeriodEnd()
+	})
+}
+
+// SetStatementBalance sets the "statement_balance" field.
+func (u *ReconciliationUpsertBulk) SetStatementBalance(v decimal.Decimal) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetStatementBalance(v)
+	})
+}
+
+// AddStatementBalance adds v to the "statement_balance" field.
+func (u *ReconciliationUpsertBulk) AddStatementBalance(v decimal.Decimal) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.AddStatementBalance(v)
+	})
+}
+
+// UpdateStatementBalance sets the "statement_balance" field to the value that was provided on create.
+func (u *ReconciliationUpsertBulk) UpdateStatementBalance() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateStatementBalance()
+	})
+}
+
+// ClearStatementBalance clears the value of the "statement_balance" field.
+func (u *ReconciliationUpsertBulk) ClearStatementBalance() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearStatementBalance()
+	})
+}
+
+// SetBookBalance sets the "book_balance" field.
+func (u *ReconciliationUpsertBulk) SetBookBalance(v decimal.Decimal) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetBookBalance(v)
+	})
+}
+
+// AddBookBalance adds v to the "book_balance" field.
+func (u *ReconciliationUpsertBulk) AddBookBalance(v decimal.Decimal) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.AddBookBalance(v)
+	})
+}
+
+// UpdateBookBalance sets the "book_balance" field to the value that was provided on create.
+func (u *ReconciliationUpsertBulk) UpdateBookBalance() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateBookBalance()
+	})
+}
+
+// ClearBookBalance clears the value of the "book_balance" field.
+func (u *ReconciliationUpsertBulk) ClearBookBalance() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearBookBalance()
+	})
+}
+
+// SetOutstandingDeposits sets the "outstanding_deposits" field.
+func (u *ReconciliationUpsertBulk) SetOutstandingDeposits(v decimal.Decimal) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetOutstandingDeposits(v)
+	})
+}
+
+// AddOutstandingDeposits adds v to the "outstanding_deposits" field.
+func (u *ReconciliationUpsertBulk) AddOutstandingDeposits(v decimal.Decimal) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.AddOutstandingDeposits(v)
+	})
+}
+
+// UpdateOutstandingDeposits sets the "outstanding_deposits" field to the value that was provided on create.
+func (u *ReconciliationUpsertBulk) UpdateOutstandingDeposits() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateOutstandingDeposits()
+	})
+}
+
+// ClearOutstandingDeposits clears the value of the "outstanding_deposits" field.
+func (u *ReconciliationUpsertBulk) ClearOutstandingDeposits() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearOutstandingDeposits()
+	})
+}
+
+// SetOutstandingPayments sets the "outstanding_payments" field.
+func (u *ReconciliationUpsertBulk) SetOutstandingPayments(v decimal.Decimal) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetOutstandingPayments(v)
+	})
+}
+
+// AddOutstandingPayments adds v to the "outstanding_payments" field.
+func (u *ReconciliationUpsertBulk) AddOutstandingPayments(v decimal.Decimal) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.AddOutstandingPayments(v)
+	})
+}
+
+// UpdateOutstandingPayments sets the "outstanding_payments" field to the value that was provided on create.
+func (u *ReconciliationUpsertBulk) UpdateOutstandingPayments() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateOutstandingPayments()
+	})
+}
+
+// ClearOutstandingPayments clears the value of the "outstanding_payments" field.
+func (u *ReconciliationUpsertBulk) ClearOutstandingPayments() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearOutstandingPayments()
+	})
+}
+
+// SetUnrecordedAmount sets the "unrecorded_amount" field.
+func (u *ReconciliationUpsertBulk) SetUnrecordedAmount(v decimal.Decimal) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetUnrecordedAmount(v)
+	})
+}
+
+// AddUnrecordedAmount adds v to the "unrecorded_amount" field.
+func (u *ReconciliationUpsertBulk) AddUnrecordedAmount(v decimal.Decimal) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.AddUnrecordedAmount(v)
+	})
+}
+
+// UpdateUnrecordedAmount sets the "unrecorded_amount" field to the value that was provided on create.
+func (u *ReconciliationUpsertBulk) UpdateUnrecordedAmount() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateUnrecordedAmount()
+	})
+}
+
+// ClearUnrecordedAmount clears the value of the "unrecorded_amount" field.
+func (u *ReconciliationUpsertBulk) ClearUnrecordedAmount() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearUnrecordedAmount()
+	})
+}
+
+// SetDifference sets the "difference" field.
+func (u *ReconciliationUpsertBulk) SetDifference(v decimal.Decimal) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetDifference(v)
+	})
+}
+
+// AddDifference adds v to the "difference" field.
+func (u *ReconciliationUpsertBulk) AddDifference(v decimal.Decimal) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.AddDifference(v)
+	})
+}
+
+// UpdateDifference sets the "difference" field to the value that was provided on create.
+func (u *ReconciliationUpsertBulk) UpdateDifference() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateDifference()
+	})
+}
+
+// ClearDifference clears the value of the "difference" field.
+func (u *ReconciliationUpsertBulk) ClearDifference() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearDifference()
+	})
+}
+
+// SetOutstandingItems sets the "outstanding_items" field.
+func (u *ReconciliationUpsertBulk) SetOutstandingItems(v []map[string]interface{}) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetOutstandingItems(v)
+	})
+}
+
+// UpdateOutstandingItems sets the "outstanding_items" field to the value that was provided on create.
+func (u *ReconciliationUpsertBulk) UpdateOutstandingItems() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateOutstandingItems()
+	})
+}
+
+// ClearOutstandingItems clears the value of the "outstanding_items" field.
+func (u *ReconciliationUpsertBulk) ClearOutstandingItems() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearOutstandingItems()
+	})
+}
+
+// SetUnrecordedItems sets the "unrecorded_items" field.
+func (u *ReconciliationUpsertBulk) SetUnrecordedItems(v []map[string]interface{}) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetUnrecordedItems(v)
+	})
+}
+
+// UpdateUnrecordedItems sets the "unrecorded_items" field to the value that was provided on create.
+func (u *ReconciliationUpsertBulk) UpdateUnrecordedItems() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateUnrecordedItems()
+	})
+}
+
+// ClearUnrecordedItems clears the value of the "unrecorded_items" field.
+func (u *ReconciliationUpsertBulk) ClearUnrecordedItems() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearUnrecordedItems()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ReconciliationUpsertBulk) SetCreatedBy(v uuid.UUID) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ReconciliationUpsertBulk) UpdateCreatedBy() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ReconciliationUpsertBulk) ClearCreatedBy() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetFinalisedBy sets the "finalised_by" field.
+func (u *ReconciliationUpsertBulk) SetFinalisedBy(v uuid.UUID) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetFinalisedBy(v)
+	})
+}
+
+// UpdateFinalisedBy sets the "finalised_by" field to the value that was provided on create.
+func (u *ReconciliationUpsertBulk) UpdateFinalisedBy() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateFinalisedBy()
+	})
+}
+
+// ClearFinalisedBy clears the value of the "finalised_by" field.
+func (u *ReconciliationUpsertBulk) ClearFinalisedBy() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearFinalisedBy()
+	})
+}
+
+// SetFinalisedAt sets the "finalised_at" field.
+func (u *ReconciliationUpsertBulk) SetFinalisedAt(v time.Time) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetFinalisedAt(v)
+	})
+}
+
+// UpdateFinalisedAt sets the "finalised_at" field to the value that was provided on create.
+func (u *ReconciliationUpsertBulk) UpdateFinalisedAt() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateFinalisedAt()
+	})
+}
+
+// ClearFinalisedAt clears the value of the "finalised_at" field.
+func (u *ReconciliationUpsertBulk) ClearFinalisedAt() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.ClearFinalisedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReconciliationUpsertBulk) SetUpdatedAt(v time.Time) *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReconciliationUpsertBulk) UpdateUpdatedAt() *ReconciliationUpsertBulk {
+	return u.Update(func(s *ReconciliationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReconciliationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReconciliationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReconciliationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReconciliationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
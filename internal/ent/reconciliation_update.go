@@ -0,0 +1,1288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliation"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatch"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationUpdate is the builder for updating Reconciliation entities.
+type ReconciliationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReconciliationMutation
+}
+
+// Where appends a list predicates to the ReconciliationUpdate builder.
+func (_u *ReconciliationUpdate) Where(ps ...predicate.Reconciliation) *ReconciliationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ReconciliationUpdate) SetTenantID(v uuid.UUID) *ReconciliationUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ReconciliationUpdate) SetNillableTenantID(v *uuid.UUID) *ReconciliationUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (_u *ReconciliationUpdate) SetBankAccountID(v uuid.UUID) *ReconciliationUpdate {
+	_u.mutation.SetBankAccountID(v)
+	return _u
+}
+
+// SetNillableBankAccountID sets the "bank_account_id" field if the given value is not nil.
+func (_u *ReconciliationUpdate) SetNillableBankAccountID(v *uuid.UUID) *ReconciliationUpdate {
+	if v != nil {
+		_u.SetBankAccountID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReconciliationUpdate) SetStatus(v string) *ReconciliationUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReconciliationUpdate) SetNillableStatus(v *string) *ReconciliationUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *ReconciliationUpdate) SetPeriodStart(v time.Time) *ReconciliationUpdate {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *ReconciliationUpdate) SetNillablePeriodStart(v *time.Time) *ReconciliationUpdate {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// ClearPeriodStart clears the value of the "period_start" field.
+func (_u *ReconciliationUpdate) ClearPeriodStart() *ReconciliationUpdate {
+	_u.mutation.ClearPeriodStart()
+	return _u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_u *ReconciliationUpdate) SetPeriodEnd(v time.Time) *ReconciliationUpdate {
+	_u.mutation.SetPeriodEnd(v)
+	return _u
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (_u *ReconciliationUpdate) SetNillablePeriodEnd(v *time.Time) *ReconciliationUpdate {
+	if v != nil {
+		_u.SetPeriodEnd(*v)
+	}
+	return _u
+}
+
+// ClearPeriodEnd clears the value of the "period_end" field.
+func (_u *ReconciliationUpdate) ClearPeriodEnd() *ReconciliationUpdate {
+	_u.mutation.ClearPeriodEnd()
+	return _u
+}
+
+// SetStatementBalance sets the "statement_balance" field.
+func (_u *ReconciliationUpdate) SetStatementBalance(v decimal.Decimal) *ReconciliationUpdate {
+	_u.mutation.ResetStatementBalance()
+	_u.mutation.SetStatementBalance(v)
+	return _u
+}
+
+// SetNillableStatementBalance sets the "statement_balance" field if the given value is not nil.
+func (_u *ReconciliationUpdate) SetNillableStatementBalance(v *decimal.Decimal) *ReconciliationUpdate {
+	if v != nil {
+		_u.SetStatementBalance(*v)
+	}
+	return _u
+}
+
+// AddStatementBalance adds value to the "statement_balance" field.
+func (_u *ReconciliationUpdate) AddStatementBalance(v decimal.Decimal) *ReconciliationUpdate {
+	_u.mutation.AddStatementBalance(v)
+	return _u
+}
+
+// ClearStatementBalance clears the value of the "statement_balance" field.
+func (_u *ReconciliationUpdate) ClearStatementBalance() *ReconciliationUpdate {
+	_u.mutation.ClearStatementBalance()
+	return _u
+}
+
+// SetBookBalance sets the "book_balance" field.
+func (_u *ReconciliationUpdate) SetBookBalance(v decimal.Decimal) *ReconciliationUpdate {
+	_u.mutation.ResetBookBalance()
+	_u.mutation.SetBookBalance(v)
+	return _u
+}
+
+// SetNillableBookBalance sets the "book_balance" field if the given value is not nil.
+func (_u *ReconciliationUpdate) SetNillableBookBalance(v *decimal.Decimal) *ReconciliationUpdate {
+	if v != nil {
+		_u.SetBookBalance(*v)
+	}
+	return _u
+}
+
+// AddBookBalance adds value to the "book_balance" field.
+func (_u *ReconciliationUpdate) AddBookBalance(v decimal.Decimal) *ReconciliationUpdate {
+	_u.mutation.AddBookBalance(v)
+	return _u
+}
+
+// ClearBookBalance clears the value of the "book_balance" field.
+func (_u *ReconciliationUpdate) ClearBookBalance() *ReconciliationUpdate {
+	_u.mutation.ClearBookBalance()
+	return _u
+}
+
+// SetOutstandingDeposits sets the "outstanding_deposits" field.
+func (_u *ReconciliationUpdate) SetOutstandingDeposits(v decimal.Decimal) *ReconciliationUpdate {
+	_u.mutation.ResetOutstandingDeposits()
+	_u.mutation.SetOutstandingDeposits(v)
+	return _u
+}
+
+// SetNillableOutstandingDeposits sets the "outstanding_deposits" field if the given value is not nil.
+func (_u *ReconciliationUpdate) SetNillableOutstandingDeposits(v *decimal.Decimal) *ReconciliationUpdate {
+	if v != nil {
+		_u.SetOutstandingDeposits(*v)
+	}
+	return _u
+}
+
+// AddOutstandingDeposits adds value to the "outstanding_deposits" field.
+func (_u *ReconciliationUpdate) AddOutstandingDeposits(v decimal.Decimal) *ReconciliationUpdate {
+	_u.mutation.AddOutstandingDeposits(v)
+	return _u
+}
+
+// ClearOutstandingDeposits clears the value of the "outstanding_deposits" field.
+func (_u *ReconciliationUpdate) ClearOutstandingDeposits() *ReconciliationUpdate {
+	_u.mutation.ClearOutstandingDeposits()
+	return _u
+}
+
+// SetOutstandingPayments sets the "outstanding_payments" field.
+func (_u *ReconciliationUpdate) SetOutstandingPayments(v decimal.Decimal) *ReconciliationUpdate {
+	_u.mutation.ResetOutstandingPayments()
+	_u.mutation.SetOutstandingPayments(v)
+	return _u
+}
+
+// SetNillableOutstandingPayments sets the "outstanding_payments" field if the given value is not nil.
+func (_u *ReconciliationUpdate) SetNillableOutstandingPayments(v *decimal.Decimal) *ReconciliationUpdate {
+	if v != nil {
+		_u.SetOutstandingPayments(*v)
+	}
+	return _u
+}
+
+// AddOutstandingPayments adds value to the "outstanding_payments" field.
+func (_u *ReconciliationUpdate) AddOutstandingPayments(v decimal.Decimal) *ReconciliationUpdate {
+	_u.mutation.AddOutstandingPayments(v)
+	return _u
+}
+
+// ClearOutstandingPayments clears the value of the "outstanding_payments" field.
+func (_u *ReconciliationUpdate) ClearOutstandingPayments() *ReconciliationUpdate {
+	_u.mutation.ClearOutstandingPayments()
+	return _u
+}
+
+// SetUnrecordedAmount sets the "unrecorded_amount" field.
+func (_u *ReconciliationUpdate) SetUnrecordedAmount(v decimal.Decimal) *ReconciliationUpdate {
+	_u.mutation.ResetUnrecordedAmount()
+	_u.mutation.SetUnrecordedAmount(v)
+	return _u
+}
+
+// SetNillableUnrecordedAmount sets the "unrecorded_amount" field if the given value is not nil.
+func (_u *ReconciliationUpdate) SetNillableUnrecordedAmount(v *decimal.Decimal) *ReconciliationUpdate {
+	if v != nil {
+		_u.SetUnrecordedAmount(*v)
+	}
+	return _u
+}
+
+// AddUnrecordedAmount adds value to the "unrecorded_amount" field.
+func (_u *ReconciliationUpdate) AddUnrecordedAmount(v decimal.Decimal) *ReconciliationUpdate {
+	_u.mutation.AddUnrecordedAmount(v)
+	return _u
+}
+
+// ClearUnrecordedAmount clears the value of the "unrecorded_amount" field.
+func (_u *ReconciliationUpdate) ClearUnrecordedAmount() *ReconciliationUpdate {
+	_u.mutation.ClearUnrecordedAmount()
+	return _u
+}
+
+// SetDifference sets the "difference" field.
+func (_u *ReconciliationUpdate) SetDifference(v decimal.Decimal) *ReconciliationUpdate {
+	_u.mutation.ResetDifference()
+	_u.mutation.SetDifference(v)
+	return _u
+}
+
+// SetNillableDifference sets the "difference" field if the given value is not nil.
+func (_u *ReconciliationUpdate) SetNillableDifference(v *decimal.Decimal) *ReconciliationUpdate {
+	if v != nil {
+		_u.SetDifference(*v)
+	}
+	return _u
+}
+
+// AddDifference adds value to the "difference" field.
+func (_u *ReconciliationUpdate) AddDifference(v decimal.Decimal) *ReconciliationUpdate {
+	_u.mutation.AddDifference(v)
+	return _u
+}
+
+// ClearDifference clears the value of the "difference" field.
+func (_u *ReconciliationUpdate) ClearDifference() *ReconciliationUpdate {
+	_u.mutation.ClearDifference()
+	return _u
+}
+
+// SetOutstandingItems sets the "outstanding_items" field.
+func (_u *ReconciliationUpdate) SetOutstandingItems(v []map[string]interface{}) *ReconciliationUpdate {
+	_u.mutation.SetOutstandingItems(v)
+	return _u
+}
+
+// AppendOutstandingItems appends value to the "outstanding_items" field.
+func (_u *ReconciliationUpdate) AppendOutstandingItems(v []map[string]interface{}) *ReconciliationUpdate {
+	_u.mutation.AppendOutstandingItems(v)
+	return _u
+}
+
+// ClearOutstandingItems clears the value of the "outstanding_items" field.
+func (_u *ReconciliationUpdate) ClearOutstandingItems() *ReconciliationUpdate {
+	_u.mutation.ClearOutstandingItems()
+	return _u
+}
+
+// SetUnrecordedItems sets the "unrecorded_items" field.
+func (_u *ReconciliationUpdate) SetUnrecordedItems(v []map[string]interface{}) *ReconciliationUpdate {
+	_u.mutation.SetUnrecordedItems(v)
+	return _u
+}
+
+// AppendUnrecordedItems appends value to the "unrecorded_items" field.
+func (_u *ReconciliationUpdate) AppendUnrecordedItems(v []map[string]interface{}) *ReconciliationUpdate {
+	_u.mutation.AppendUnrecordedItems(v)
+	return _u
+}
+
+// ClearUnrecordedItems clears the value of the "unrecorded_items" field.
+func (_u *ReconciliationUpdate) ClearUnrecordedItems() *ReconciliationUpdate {
+	_u.mutation.ClearUnrecordedItems()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ReconciliationUpdate) SetCreatedBy(v uuid.UUID) *ReconciliationUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ReconciliationUpdate) SetNillableCreatedBy(v *uuid.UUID) *ReconciliationUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *ReconciliationUpdate) ClearCreatedBy() *ReconciliationUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetFinalisedBy sets the "finalised_by" field.
+func (_u *ReconciliationUpdate) SetFinalisedBy(v uuid.UUID) *ReconciliationUpdate {
+	_u.mutation.SetFinalisedBy(v)
+	return _u
+}
+
+// SetNillableFinalisedBy sets the "finalised_by" field if the given value is not nil.
+func (_u *ReconciliationUpdate) SetNillableFinalisedBy(v *uuid.UUID) *ReconciliationUpdate {
+	if v != nil {
+		_u.SetFinalisedBy(*v)
+	}
+	return _u
+}
+
+// ClearFinalisedBy clears the value of the "finalised_by" field.
+func (_u *ReconciliationUpdate) ClearFinalisedBy() *ReconciliationUpdate {
+	_u.mutation.ClearFinalisedBy()
+	return _u
+}
+
+// SetFinalisedAt sets the "finalised_at" field.
+func (_u *ReconciliationUpdate) SetFinalisedAt(v time.Time) *ReconciliationUpdate {
+	_u.mutation.SetFinalisedAt(v)
+	return _u
+}
+
+// SetNillableFinalisedAt sets the "finalised_at" field if the given value is not nil.
+func (_u *ReconciliationUpdate) SetNillableFinalisedAt(v *time.Time) *ReconciliationUpdate {
+	if v != nil {
+		_u.SetFinalisedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinalisedAt clears the value of the "finalised_at" field.
+func (_u *ReconciliationUpdate) ClearFinalisedAt() *ReconciliationUpdate {
+	_u.mutation.ClearFinalisedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReconciliationUpdate) SetUpdatedAt(v time.Time) *ReconciliationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddMatchIDs adds the "matches" edge to the ReconciliationMatch entity by IDs.
+func (_u *ReconciliationUpdate) AddMatchIDs(ids ...uuid.UUID) *ReconciliationUpdate {
+	_u.mutation.AddMatchIDs(ids...)
+	return _u
+}
+
+// AddMatches adds the "matches" edges to the ReconciliationMatch entity.
+func (_u *ReconciliationUpdate) AddMatches(v ...*ReconciliationMatch) *ReconciliationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMatchIDs(ids...)
+}
+
+// Mutation returns the ReconciliationMutation object of the builder.
+func (_u *ReconciliationUpdate) Mutation() *ReconciliationMutation {
+	return _u.mutation
+}
+
+// ClearMatches clears all "matches" edges to the ReconciliationMatch entity.
+func (_u *ReconciliationUpdate) ClearMatches() *ReconciliationUpdate {
+	_u.mutation.ClearMatches()
+	return _u
+}
+
+// RemoveMatchIDs removes the "matches" edge to ReconciliationMatch entities by IDs.
+func (_u *ReconciliationUpdate) RemoveMatchIDs(ids ...uuid.UUID) *ReconciliationUpdate {
+	_u.mutation.RemoveMatchIDs(ids...)
+	return _u
+}
+
+// RemoveMatches removes "matches" edges to ReconciliationMatch entities.
+func (_u *ReconciliationUpdate) RemoveMatches(v ...*ReconciliationMatch) *ReconciliationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMatchIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReconciliationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReconciliationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReconciliationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReconciliationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReconciliationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := reconciliation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ReconciliationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(reconciliation.Table, reconciliation.Columns, sqlgraph.NewFieldSpec(reconciliation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(reconciliation.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.BankAccountID(); ok {
+		_spec.SetField(reconciliation.FieldBankAccountID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(reconciliation.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(reconciliation.FieldPeriodStart, field.TypeTime, value)
+	}
+	if _u.mutation.PeriodStartCleared() {
+		_spec.ClearField(reconciliation.FieldPeriodStart, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PeriodEnd(); ok {
+		_spec.SetField(reconciliation.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if _u.mutation.PeriodEndCleared() {
+		_spec.ClearField(reconciliation.FieldPeriodEnd, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StatementBalance(); ok {
+		_spec.SetField(reconciliation.FieldStatementBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedStatementBalance(); ok {
+		_spec.AddField(reconciliation.FieldStatementBalance, field.TypeFloat64, value)
+	}
+	if _u.mutation.StatementBalanceCleared() {
+		_spec.ClearField(reconciliation.FieldStatementBalance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.BookBalance(); ok {
+		_spec.SetField(reconciliation.FieldBookBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBookBalance(); ok {
+		_spec.AddField(reconciliation.FieldBookBalance, field.TypeFloat64, value)
+	}
+	if _u.mutation.BookBalanceCleared() {
+		_spec.ClearField(reconciliation.FieldBookBalance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.OutstandingDeposits(); ok {
+		_spec.SetField(reconciliation.FieldOutstandingDeposits, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOutstandingDeposits(); ok {
+		_spec.AddField(reconciliation.FieldOutstandingDeposits, field.TypeFloat64, value)
+	}
+	if _u.mutation.OutstandingDepositsCleared() {
+		_spec.ClearField(reconciliation.FieldOutstandingDeposits, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.OutstandingPayments(); ok {
+		_spec.SetField(reconciliation.FieldOutstandingPayments, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOutstandingPayments(); ok {
+		_spec.AddField(reconciliation.FieldOutstandingPayments, field.TypeFloat64, value)
+	}
+	if _u.mutation.OutstandingPaymentsCleared() {
+		_spec.ClearField(reconciliation.FieldOutstandingPayments, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.UnrecordedAmount(); ok {
+		_spec.SetField(reconciliation.FieldUnrecordedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedUnrecordedAmount(); ok {
+		_spec.AddField(reconciliation.FieldUnrecordedAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.UnrecordedAmountCleared() {
+		_spec.ClearField(reconciliation.FieldUnrecordedAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Difference(); ok {
+		_spec.SetField(reconciliation.FieldDifference, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDifference(); ok {
+		_spec.AddField(reconciliation.FieldDifference, field.TypeFloat64, value)
+	}
+	if _u.mutation.DifferenceCleared() {
+		_spec.ClearField(reconciliation.FieldDifference, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.OutstandingItems(); ok {
+		_spec.SetField(reconciliation.FieldOutstandingItems, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOutstandingItems(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reconciliation.FieldOutstandingItems, value)
+		})
+	}
+	if _u.mutation.OutstandingItemsCleared() {
+		_spec.ClearField(reconciliation.FieldOutstandingItems, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UnrecordedItems(); ok {
+		_spec.SetField(reconciliation.FieldUnrecordedItems, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUnrecordedItems(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reconciliation.FieldUnrecordedItems, value)
+		})
+	}
+	if _u.mutation.UnrecordedItemsCleared() {
+		_spec.ClearField(reconciliation.FieldUnrecordedItems, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(reconciliation.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(reconciliation.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.FinalisedBy(); ok {
+		_spec.SetField(reconciliation.FieldFinalisedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.FinalisedByCleared() {
+		_spec.ClearField(reconciliation.FieldFinalisedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.FinalisedAt(); ok {
+		_spec.SetField(reconciliation.FieldFinalisedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinalisedAtCleared() {
+		_spec.ClearField(reconciliation.FieldFinalisedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(reconciliation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.MatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliation.MatchesTable,
+			Columns: []string{reconciliation.MatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMatchesIDs(); len(nodes) > 0 && !_u.mutation.MatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliation.MatchesTable,
+			Columns: []string{reconciliation.MatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MatchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliation.MatchesTable,
+			Columns: []string{reconciliation.MatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reconciliation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReconciliationUpdateOne is the builder for updating a single Reconciliation entity.
+type ReconciliationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReconciliationMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ReconciliationUpdateOne) SetTenantID(v uuid.UUID) *ReconciliationUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ReconciliationUpdateOne) SetNillableTenantID(v *uuid.UUID) *ReconciliationUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (_u *ReconciliationUpdateOne) SetBankAccountID(v uuid.UUID) *ReconciliationUpdateOne {
+	_u.mutation.SetBankAccountID(v)
+	return _u
+}
+
+// SetNillableBankAccountID sets the "bank_account_id" field if the given value is not nil.
+func (_u *ReconciliationUpdateOne) SetNillableBankAccountID(v *uuid.UUID) *ReconciliationUpdateOne {
+	if v != nil {
+		_u.SetBankAccountID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReconciliationUpdateOne) SetStatus(v string) *ReconciliationUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReconciliationUpdateOne) SetNillableStatus(v *string) *ReconciliationUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *ReconciliationUpdateOne) SetPeriodStart(v time.Time) *ReconciliationUpdateOne {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *ReconciliationUpdateOne) SetNillablePeriodStart(v *time.Time) *ReconciliationUpdateOne {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// ClearPeriodStart clears the value of the "period_start" field.
+func (_u *ReconciliationUpdateOne) ClearPeriodStart() *ReconciliationUpdateOne {
+	_u.mutation.ClearPeriodStart()
+	return _u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_u *ReconciliationUpdateOne) SetPeriodEnd(v time.Time) *ReconciliationUpdateOne {
+	_u.mutation.SetPeriodEnd(v)
+	return _u
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (_u *ReconciliationUpdateOne) SetNillablePeriodEnd(v *time.Time) *ReconciliationUpdateOne {
+	if v != nil {
+		_u.SetPeriodEnd(*v)
+	}
+	return _u
+}
+
+// ClearPeriodEnd clears the value of the "period_end" field.
+func (_u *ReconciliationUpdateOne) ClearPeriodEnd() *ReconciliationUpdateOne {
+	_u.mutation.ClearPeriodEnd()
+	return _u
+}
+
+// SetStatementBalance sets the "statement_balance" field.
+func (_u *ReconciliationUpdateOne) SetStatementBalance(v decimal.Decimal) *ReconciliationUpdateOne {
+	_u.mutation.ResetStatementBalance()
+	_u.mutation.SetStatementBalance(v)
+	return _u
+}
+
+// SetNillableStatementBalance sets the "statement_balance" field if the given value is not nil.
+func (_u *ReconciliationUpdateOne) SetNillableStatementBalance(v *decimal.Decimal) *ReconciliationUpdateOne {
+	if v != nil {
+		_u.SetStatementBalance(*v)
+	}
+	return _u
+}
+
+// AddStatementBalance adds value to the "statement_balance" field.
+func (_u *ReconciliationUpdateOne) AddStatementBalance(v decimal.Decimal) *ReconciliationUpdateOne {
+	_u.mutation.AddStatementBalance(v)
+	return _u
+}
+
+// ClearStatementBalance clears the value of the "statement_balance" field.
+func (_u *ReconciliationUpdateOne) ClearStatementBalance() *ReconciliationUpdateOne {
+	_u.mutation.ClearStatementBalance()
+	return _u
+}
+
+// SetBookBalance sets the "book_balance" field.
+func (_u *ReconciliationUpdateOne) SetBookBalance(v decimal.Decimal) *ReconciliationUpdateOne {
+	_u.mutation.ResetBookBalance()
+	_u.mutation.SetBookBalance(v)
+	return _u
+}
+
+// SetNillableBookBalance sets the "book_balance" field if the given value is not nil.
+func (_u *ReconciliationUpdateOne) SetNillableBookBalance(v *decimal.Decimal) *ReconciliationUpdateOne {
+	if v != nil {
+		_u.SetBookBalance(*v)
+	}
+	return _u
+}
+
+// AddBookBalance adds value to the "book_balance" field.
+func (_u *ReconciliationUpdateOne) AddBookBalance(v decimal.Decimal) *ReconciliationUpdateOne {
+	_u.mutation.AddBookBalance(v)
+	return _u
+}
+
+// ClearBookBalance clears the value of the "book_balance" field.
+func (_u *ReconciliationUpdateOne) ClearBookBalance() *ReconciliationUpdateOne {
+	_u.mutation.ClearBookBalance()
+	return _u
+}
+
+// SetOutstandingDeposits sets the "outstanding_deposits" field.
+func (_u *ReconciliationUpdateOne) SetOutstandingDeposits(v decimal.Decimal) *ReconciliationUpdateOne {
+	_u.mutation.ResetOutstandingDeposits()
+	_u.mutation.SetOutstandingDeposits(v)
+	return _u
+}
+
+// SetNillableOutstandingDeposits sets the "outstanding_deposits" field if the given value is not nil.
+func (_u *ReconciliationUpdateOne) SetNillableOutstandingDeposits(v *decimal.Decimal) *ReconciliationUpdateOne {
+	if v != nil {
+		_u.SetOutstandingDeposits(*v)
+	}
+	return _u
+}
+
+// AddOutstandingDeposits adds value to the "outstanding_deposits" field.
+func (_u *ReconciliationUpdateOne) AddOutstandingDeposits(v decimal.Decimal) *ReconciliationUpdateOne {
+	_u.mutation.AddOutstandingDeposits(v)
+	return _u
+}
+
+// ClearOutstandingDeposits clears the value of the "outstanding_deposits" field.
+func (_u *ReconciliationUpdateOne) ClearOutstandingDeposits() *ReconciliationUpdateOne {
+	_u.mutation.ClearOutstandingDeposits()
+	return _u
+}
+
+// SetOutstandingPayments sets the "outstanding_payments" field.
+func (_u *ReconciliationUpdateOne) SetOutstandingPayments(v decimal.Decimal) *ReconciliationUpdateOne {
+	_u.mutation.ResetOutstandingPayments()
+	_u.mutation.SetOutstandingPayments(v)
+	return _u
+}
+
+// SetNillableOutstandingPayments sets the "outstanding_payments" field if the given value is not nil.
+func (_u *ReconciliationUpdateOne) SetNillableOutstandingPayments(v *decimal.Decimal) *ReconciliationUpdateOne {
+	if v != nil {
+		_u.SetOutstandingPayments(*v)
+	}
+	return _u
+}
+
+// AddOutstandingPayments adds value to the "outstanding_payments" field.
+func (_u *ReconciliationUpdateOne) AddOutstandingPayments(v decimal.Decimal) *ReconciliationUpdateOne {
+	_u.mutation.AddOutstandingPayments(v)
+	return _u
+}
+
+// ClearOutstandingPayments clears the value of the "outstanding_payments" field.
+func (_u *ReconciliationUpdateOne) ClearOutstandingPayments() *ReconciliationUpdateOne {
+	_u.mutation.ClearOutstandingPayments()
+	return _u
+}
+
+// SetUnrecordedAmount sets the "unrecorded_amount" field.
+func (_u *ReconciliationUpdateOne) SetUnrecordedAmount(v decimal.Decimal) *ReconciliationUpdateOne {
+	_u.mutation.ResetUnrecordedAmount()
+	_u.mutation.SetUnrecordedAmount(v)
+	return _u
+}
+
+// SetNillableUnrecordedAmount sets the "unrecorded_amount" field if the given value is not nil.
+func (_u *ReconciliationUpdateOne) SetNillableUnrecordedAmount(v *decimal.Decimal) *ReconciliationUpdateOne {
+	if v != nil {
+		_u.SetUnrecordedAmount(*v)
+	}
+	return _u
+}
+
+// AddUnrecordedAmount adds value to the "unrecorded_amount" field.
+func (_u *ReconciliationUpdateOne) AddUnrecordedAmount(v decimal.Decimal) *ReconciliationUpdateOne {
+	_u.mutation.AddUnrecordedAmount(v)
+	return _u
+}
+
+// ClearUnrecordedAmount clears the value of the "unrecorded_amount" field.
+func (_u *ReconciliationUpdateOne) ClearUnrecordedAmount() *ReconciliationUpdateOne {
+	_u.mutation.ClearUnrecordedAmount()
+	return _u
+}
+
+// SetDifference sets the "difference" field.
+func (_u *ReconciliationUpdateOne) SetDifference(v decimal.Decimal) *ReconciliationUpdateOne {
+	_u.mutation.ResetDifference()
+	_u.mutation.SetDifference(v)
+	return _u
+}
+
+// SetNillableDifference sets the "difference" field if the given value is not nil.
+func (_u *ReconciliationUpdateOne) SetNillableDifference(v *decimal.Decimal) *ReconciliationUpdateOne {
+	if v != nil {
+		_u.SetDifference(*v)
+	}
+	return _u
+}
+
+// AddDifference adds value to the "difference" field.
+func (_u *ReconciliationUpdateOne) AddDifference(v decimal.Decimal) *ReconciliationUpdateOne {
+	_u.mutation.AddDifference(v)
+	return _u
+}
+
+// ClearDifference clears the value of the "difference" field.
+func (_u *ReconciliationUpdateOne) ClearDifference() *ReconciliationUpdateOne {
+	_u.mutation.ClearDifference()
+	return _u
+}
+
+// SetOutstandingItems sets the "outstanding_items" field.
+func (_u *ReconciliationUpdateOne) SetOutstandingItems(v []map[string]interface{}) *ReconciliationUpdateOne {
+	_u.mutation.SetOutstandingItems(v)
+	return _u
+}
+
+// AppendOutstandingItems appends value to the "outstanding_items" field.
+func (_u *ReconciliationUpdateOne) AppendOutstandingItems(v []map[string]interface{}) *ReconciliationUpdateOne {
+	_u.mutation.AppendOutstandingItems(v)
+	return _u
+}
+
+// ClearOutstandingItems clears the value of the "outstanding_items" field.
+func (_u *ReconciliationUpdateOne) ClearOutstandingItems() *ReconciliationUpdateOne {
+	_u.mutation.ClearOutstandingItems()
+	return _u
+}
+
+// SetUnrecordedItems sets the "unrecorded_items" field.
+func (_u *ReconciliationUpdateOne) SetUnrecordedItems(v []map[string]interface{}) *ReconciliationUpdateOne {
+	_u.mutation.SetUnrecordedItems(v)
+	return _u
+}
+
+// AppendUnrecordedItems appends value to the "unrecorded_items" field.
+func (_u *ReconciliationUpdateOne) AppendUnrecordedItems(v []map[string]interface{}) *ReconciliationUpdateOne {
+	_u.mutation.AppendUnrecordedItems(v)
+	return _u
+}
+
+// ClearUnrecordedItems clears the value of the "unrecorded_items" field.
+func (_u *ReconciliationUpdateOne) ClearUnrecordedItems() *ReconciliationUpdateOne {
+	_u.mutation.ClearUnrecordedItems()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ReconciliationUpdateOne) SetCreatedBy(v uuid.UUID) *ReconciliationUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ReconciliationUpdateOne) SetNillableCreatedBy(v *uuid.UUID) *ReconciliationUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *ReconciliationUpdateOne) ClearCreatedBy() *ReconciliationUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetFinalisedBy sets the "finalised_by" field.
+func (_u *ReconciliationUpdateOne) SetFinalisedBy(v uuid.UUID) *ReconciliationUpdateOne {
+	_u.mutation.SetFinalisedBy(v)
+	return _u
+}
+
+// SetNillableFinalisedBy sets the "finalised_by" field if the given value is not nil.
+func (_u *ReconciliationUpdateOne) SetNillableFinalisedBy(v *uuid.UUID) *ReconciliationUpdateOne {
+	if v != nil {
+		_u.SetFinalisedBy(*v)
+	}
+	return _u
+}
+
+// ClearFinalisedBy clears the value of the "finalised_by" field.
+func (_u *ReconciliationUpdateOne) ClearFinalisedBy() *ReconciliationUpdateOne {
+	_u.mutation.ClearFinalisedBy()
+	return _u
+}
+
+// SetFinalisedAt sets the "finalised_at" field.
+func (_u *ReconciliationUpdateOne) SetFinalisedAt(v time.Time) *ReconciliationUpdateOne {
+	_u.mutation.SetFinalisedAt(v)
+	return _u
+}
+
+// SetNillableFinalisedAt sets the "finalised_at" field if the given value is not nil.
+func (_u *ReconciliationUpdateOne) SetNillableFinalisedAt(v *time.Time) *ReconciliationUpdateOne {
+	if v != nil {
+		_u.SetFinalisedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinalisedAt clears the value of the "finalised_at" field.
+func (_u *ReconciliationUpdateOne) ClearFinalisedAt() *ReconciliationUpdateOne {
+	_u.mutation.ClearFinalisedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReconciliationUpdateOne) SetUpdatedAt(v time.Time) *ReconciliationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddMatchIDs adds the "matches" edge to the ReconciliationMatch entity by IDs.
+func (_u *ReconciliationUpdateOne) AddMatchIDs(ids ...uuid.UUID) *ReconciliationUpdateOne {
+	_u.mutation.AddMatchIDs(ids...)
+	return _u
+}
+
+// AddMatches adds the "matches" edges to the ReconciliationMatch entity.
+func (_u *ReconciliationUpdateOne) AddMatches(v ...*ReconciliationMatch) *ReconciliationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMatchIDs(ids...)
+}
+
+// Mutation returns the ReconciliationMutation object of the builder.
+func (_u *ReconciliationUpdateOne) Mutation() *ReconciliationMutation {
+	return _u.mutation
+}
+
+// ClearMatches clears all "matches" edges to the ReconciliationMatch entity.
+func (_u *ReconciliationUpdateOne) ClearMatches() *ReconciliationUpdateOne {
+	_u.mutation.ClearMatches()
+	return _u
+}
+
+// RemoveMatchIDs removes the "matches" edge to ReconciliationMatch entities by IDs.
+func (_u *ReconciliationUpdateOne) RemoveMatchIDs(ids ...uuid.UUID) *ReconciliationUpdateOne {
+	_u.mutation.RemoveMatchIDs(ids...)
+	return _u
+}
+
+// RemoveMatches removes "matches" edges to ReconciliationMatch entities.
+func (_u *ReconciliationUpdateOne) RemoveMatches(v ...*ReconciliationMatch) *ReconciliationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMatchIDs(ids...)
+}
+
+// Where appends a list predicates to the ReconciliationUpdate builder.
+func (_u *ReconciliationUpdateOne) Where(ps ...predicate.Reconciliation) *ReconciliationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReconciliationUpdateOne) Select(field string, fields ...string) *ReconciliationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Reconciliation entity.
+func (_u *ReconciliationUpdateOne) Save(ctx context.Context) (*Reconciliation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReconciliationUpdateOne) SaveX(ctx context.Context) *Reconciliation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReconciliationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReconciliationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReconciliationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := reconciliation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ReconciliationUpdateOne) sqlSave(ctx context.Context) (_node *Reconciliation, err error) {
+	_spec := sqlgraph.NewUpdateSpec(reconciliation.Table, reconciliation.Columns, sqlgraph.NewFieldSpec(reconciliation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Reconciliation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reconciliation.FieldID)
+		for _, f := range fields {
+			if !reconciliation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reconciliation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(reconciliation.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.BankAccountID(); ok {
+		_spec.SetField(reconciliation.FieldBankAccountID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(reconciliation.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(reconciliation.FieldPeriodStart, field.TypeTime, value)
+	}
+	if _u.mutation.PeriodStartCleared() {
+		_spec.ClearField(reconciliation.FieldPeriodStart, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PeriodEnd(); ok {
+		_spec.SetField(reconciliation.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if _u.mutation.PeriodEndCleared() {
+		_spec.ClearField(reconciliation.FieldPeriodEnd, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StatementBalance(); ok {
+		_spec.SetField(reconciliation.FieldStatementBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedStatementBalance(); ok {
+		_spec.AddField(reconciliation.FieldStatementBalance, field.TypeFloat64, value)
+	}
+	if _u.mutation.StatementBalanceCleared() {
+		_spec.ClearField(reconciliation.FieldStatementBalance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.BookBalance(); ok {
+		_spec.SetField(reconciliation.FieldBookBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBookBalance(); ok {
+		_spec.AddField(reconciliation.FieldBookBalance, field.TypeFloat64, value)
+	}
+	if _u.mutation.BookBalanceCleared() {
+		_spec.ClearField(reconciliation.FieldBookBalance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.OutstandingDeposits(); ok {
+		_spec.SetField(reconciliation.FieldOutstandingDeposits, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOutstandingDeposits(); ok {
+		_spec.AddField(reconciliation.FieldOutstandingDeposits, field.TypeFloat64, value)
+	}
+	if _u.mutation.OutstandingDepositsCleared() {
+		_spec.ClearField(reconciliation.FieldOutstandingDeposits, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.OutstandingPayments(); ok {
+		_spec.SetField(reconciliation.FieldOutstandingPayments, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOutstandingPayments(); ok {
+		_spec.AddField(reconciliation.FieldOutstandingPayments, field.TypeFloat64, value)
+	}
+	if _u.mutation.OutstandingPaymentsCleared() {
+		_spec.ClearField(reconciliation.FieldOutstandingPayments, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.UnrecordedAmount(); ok {
+		_spec.SetField(reconciliation.FieldUnrecordedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedUnrecordedAmount(); ok {
+		_spec.AddField(reconciliation.FieldUnrecordedAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.UnrecordedAmountCleared() {
+		_spec.ClearField(reconciliation.FieldUnrecordedAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Difference(); ok {
+		_spec.SetField(reconciliation.FieldDifference, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDifference(); ok {
+		_spec.AddField(reconciliation.FieldDifference, field.TypeFloat64, value)
+	}
+	if _u.mutation.DifferenceCleared() {
+		_spec.ClearField(reconciliation.FieldDifference, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.OutstandingItems(); ok {
+		_spec.SetField(reconciliation.FieldOutstandingItems, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOutstandingItems(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reconciliation.FieldOutstandingItems, value)
+		})
+	}
+	if _u.mutation.OutstandingItemsCleared() {
+		_spec.ClearField(reconciliation.FieldOutstandingItems, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UnrecordedItems(); ok {
+		_spec.SetField(reconciliation.FieldUnrecordedItems, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUnrecordedItems(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reconciliation.FieldUnrecordedItems, value)
+		})
+	}
+	if _u.mutation.UnrecordedItemsCleared() {
+		_spec.ClearField(reconciliation.FieldUnrecordedItems, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(reconciliation.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(reconciliation.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.FinalisedBy(); ok {
+		_spec.SetField(reconciliation.FieldFinalisedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.FinalisedByCleared() {
+		_spec.ClearField(reconciliation.FieldFinalisedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.FinalisedAt(); ok {
+		_spec.SetField(reconciliation.FieldFinalisedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinalisedAtCleared() {
+		_spec.ClearField(reconciliation.FieldFinalisedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(reconciliation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.MatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliation.MatchesTable,
+			Columns: []string{reconciliation.MatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMatchesIDs(); len(nodes) > 0 && !_u.mutation.MatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliation.MatchesTable,
+			Columns: []string{reconciliation.MatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MatchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliation.MatchesTable,
+			Columns: []string{reconciliation.MatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Reconciliation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reconciliation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,408 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliation"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatch"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationMatch is the model entity for the ReconciliationMatch schema.
+type ReconciliationMatch struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Bank account identifier
+	BankAccountID uuid.UUID `json:"bank_account_id,omitempty"`
+	// Reconciliation the match is locked into once matched
+	ReconciliationID *uuid.UUID `json:"reconciliation_id,omitempty"`
+	// Rule that proposed the match: exact_reference, amount_date, many_to_one, one_to_many or custom; manual or adjustment when matched by hand
+	Rule string `json:"rule,omitempty"`
+	// Custom reconciliation rule that proposed the match
+	RuleID *uuid.UUID `json:"rule_id,omitempty"`
+	// Match type: automatic, manual
+	MatchType string `json:"match_type,omitempty"`
+	// Match confidence score (0-100)
+	Confidence int `json:"confidence,omitempty"`
+	// Status: suggested, matched, rejected, unmatched
+	Status string `json:"status,omitempty"`
+	// Sum of the matched bank lines
+	BankAmount decimal.Decimal `json:"bank_amount,omitempty"`
+	// Sum of the matched ledger lines or payment transactions
+	BookAmount decimal.Decimal `json:"book_amount,omitempty"`
+	// Bank amount less book amount, within a custom rule's tolerance
+	Difference decimal.Decimal `json:"difference,omitempty"`
+	// SHA-256 of the matched item identifiers; a rejected grouping is not suggested again
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// Reasons holds the value of the "reasons" field.
+	Reasons []string `json:"reasons,omitempty"`
+	// User who accepted the match; empty when matched automatically
+	MatchedBy *uuid.UUID `json:"matched_by,omitempty"`
+	// MatchedAt holds the value of the "matched_at" field.
+	MatchedAt *time.Time `json:"matched_at,omitempty"`
+	// RejectedBy holds the value of the "rejected_by" field.
+	RejectedBy *uuid.UUID `json:"rejected_by,omitempty"`
+	// RejectedAt holds the value of the "rejected_at" field.
+	RejectedAt *time.Time `json:"rejected_at,omitempty"`
+	// UnmatchedBy holds the value of the "unmatched_by" field.
+	UnmatchedBy *uuid.UUID `json:"unmatched_by,omitempty"`
+	// UnmatchedAt holds the value of the "unmatched_at" field.
+	UnmatchedAt *time.Time `json:"unmatched_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReconciliationMatchQuery when eager-loading is set.
+	Edges        ReconciliationMatchEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReconciliationMatchEdges holds the relations/edges for other nodes in the graph.
+type ReconciliationMatchEdges struct {
+	// Reconciliation holds the value of the reconciliation edge.
+	Reconciliation *Reconciliation `json:"reconciliation,omitempty"`
+	// Items holds the value of the items edge.
+	Items []*ReconciliationMatchItem `json:"items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ReconciliationOrErr returns the Reconciliation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReconciliationMatchEdges) ReconciliationOrErr() (*Reconciliation, error) {
+	if e.Reconciliation != nil {
+		return e.Reconciliation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: reconciliation.Label}
+	}
+	return nil, &NotLoadedError{edge: "reconciliation"}
+}
+
+// ItemsOrErr returns the Items value or an error if the edge
+// was not loaded in eager-loading.
+func (e ReconciliationMatchEdges) ItemsOrErr() ([]*ReconciliationMatchItem, error) {
+	if e.loadedTypes[1] {
+		return e.Items, nil
+	}
+	return nil, &NotLoadedError{edge: "items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReconciliationMatch) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reconciliationmatch.FieldReconciliationID, reconciliationmatch.FieldRuleID, reconciliationmatch.FieldMatchedBy, reconciliationmatch.FieldRejectedBy, reconciliationmatch.FieldUnmatchedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case reconciliationmatch.FieldReasons:
+			values[i] = new([]byte)
+		case reconciliationmatch.FieldBankAmount, reconciliationmatch.FieldBookAmount, reconciliationmatch.FieldDifference:
+			values[i] = new(decimal.Decimal)
+		case reconciliationmatch.FieldConfidence:
+			values[i] = new(sql.NullInt64)
+		case reconciliationmatch.FieldRule, reconciliationmatch.FieldMatchType, reconciliationmatch.FieldStatus, reconciliationmatch.FieldFingerprint:
+			values[i] = new(sql.NullString)
+		case reconciliationmatch.FieldMatchedAt, reconciliationmatch.FieldRejectedAt, reconciliationmatch.FieldUnmatchedAt, reconciliationmatch.FieldCreatedAt, reconciliationmatch.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case reconciliationmatch.FieldID, reconciliationmatch.FieldTenantID, reconciliationmatch.FieldBankAccountID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReconciliationMatch fields.
+func (_m *ReconciliationMatch) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reconciliationmatch.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case reconciliationmatch.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case reconciliationmatch.FieldBankAccountID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field bank_account_id", values[i])
+			} else if value != nil {
+				_m.BankAccountID = *value
+			}
+		case reconciliationmatch.FieldReconciliationID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field reconciliation_id", values[i])
+			} else if value.Valid {
+				_m.ReconciliationID = new(uuid.UUID)
+				*_m.ReconciliationID = *value.S.(*uuid.UUID)
+			}
+		case reconciliationmatch.FieldRule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule", values[i])
+			} else if value.Valid {
+				_m.Rule = value.String
+			}
+		case reconciliationmatch.FieldRuleID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_id", values[i])
+			} else if value.Valid {
+				_m.RuleID = new(uuid.UUID)
+				*_m.RuleID = *value.S.(*uuid.UUID)
+			}
+		case reconciliationmatch.FieldMatchType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field match_type", values[i])
+			} else if value.Valid {
+				_m.MatchType = value.String
+			}
+		case reconciliationmatch.FieldConfidence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field confidence", values[i])
+			} else if value.Valid {
+				_m.Confidence = int(value.Int64)
+			}
+		case reconciliationmatch.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case reconciliationmatch.FieldBankAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field bank_amount", values[i])
+			} else if value != nil {
+				_m.BankAmount = *value
+			}
+		case reconciliationmatch.FieldBookAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field book_amount", values[i])
+			} else if value != nil {
+				_m.BookAmount = *value
+			}
+		case reconciliationmatch.FieldDifference:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field difference", values[i])
+			} else if value != nil {
+				_m.Difference = *value
+			}
+		case reconciliationmatch.FieldFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				_m.Fingerprint = value.String
+			}
+		case reconciliationmatch.FieldReasons:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field reasons", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Reasons); err != nil {
+					return fmt.Errorf("unmarshal field reasons: %w", err)
+				}
+			}
+		case reconciliationmatch.FieldMatchedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field matched_by", values[i])
+			} else if value.Valid {
+				_m.MatchedBy = new(uuid.UUID)
+				*_m.MatchedBy = *value.S.(*uuid.UUID)
+			}
+		case reconciliationmatch.FieldMatchedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field matched_at", values[i])
+			} else if value.Valid {
+				_m.MatchedAt = new(time.Time)
+				*_m.MatchedAt = value.Time
+			}
+		case reconciliationmatch.FieldRejectedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field rejected_by", values[i])
+			} else if value.Valid {
+				_m.RejectedBy = new(uuid.UUID)
+				*_m.RejectedBy = *value.S.(*uuid.UUID)
+			}
+		case reconciliationmatch.FieldRejectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rejected_at", values[i])
+			} else if value.Valid {
+				_m.RejectedAt = new(time.Time)
+				*_m.RejectedAt = value.Time
+			}
+		case reconciliationmatch.FieldUnmatchedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field unmatched_by", values[i])
+			} else if value.Valid {
+				_m.UnmatchedBy = new(uuid.UUID)
+				*_m.UnmatchedBy = *value.S.(*uuid.UUID)
+			}
+		case reconciliationmatch.FieldUnmatchedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field unmatched_at", values[i])
+			} else if value.Valid {
+				_m.UnmatchedAt = new(time.Time)
+				*_m.UnmatchedAt = value.Time
+			}
+		case reconciliationmatch.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case reconciliationmatch.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReconciliationMatch.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReconciliationMatch) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryReconciliation queries the "reconciliation" edge of the ReconciliationMatch entity.
+func (_m *ReconciliationMatch) QueryReconciliation() *ReconciliationQuery {
+	return NewReconciliationMatchClient(_m.config).QueryReconciliation(_m)
+}
+
+// QueryItems queries the "items" edge of the ReconciliationMatch entity.
+func (_m *ReconciliationMatch) QueryItems() *ReconciliationMatchItemQuery {
+	return NewReconciliationMatchClient(_m.config).QueryItems(_m)
+}
+
+// Update returns a builder for updating this ReconciliationMatch.
+// Note that you need to call ReconciliationMatch.Unwrap() before calling this method if this ReconciliationMatch
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReconciliationMatch) Update() *ReconciliationMatchUpdateOne {
+	return NewReconciliationMatchClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReconciliationMatch entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReconciliationMatch) Unwrap() *ReconciliationMatch {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReconciliationMatch is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReconciliationMatch) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReconciliationMatch(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("bank_account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BankAccountID))
+	builder.WriteString(", ")
+	if v := _m.ReconciliationID; v != nil {
+		builder.WriteString("reconciliation_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("rule=")
+	builder.WriteString(_m.Rule)
+	builder.WriteString(", ")
+	if v := _m.RuleID; v != nil {
+		builder.WriteString("rule_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("match_type=")
+	builder.WriteString(_m.MatchType)
+	builder.WriteString(", ")
+	builder.WriteString("confidence=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Confidence))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("bank_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BankAmount))
+	builder.WriteString(", ")
+	builder.WriteString("book_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BookAmount))
+	builder.WriteString(", ")
+	builder.WriteString("difference=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Difference))
+	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(_m.Fingerprint)
+	builder.WriteString(", ")
+	builder.WriteString("reasons=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Reasons))
+	builder.WriteString(", ")
+	if v := _m.MatchedBy; v != nil {
+		builder.WriteString("matched_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MatchedAt; v != nil {
+		builder.WriteString("matched_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RejectedBy; v != nil {
+		builder.WriteString("rejected_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RejectedAt; v != nil {
+		builder.WriteString("rejected_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UnmatchedBy; v != nil {
+		builder.WriteString("unmatched_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UnmatchedAt; v != nil {
+		builder.WriteString("unmatched_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReconciliationMatches is a parsable slice of ReconciliationMatch.
+type ReconciliationMatches []*ReconciliationMatch
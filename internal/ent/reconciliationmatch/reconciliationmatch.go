@@ -0,0 +1,274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reconciliationmatch
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the reconciliationmatch type in the database.
+	Label = "reconciliation_match"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldBankAccountID holds the string denoting the bank_account_id field in the database.
+	FieldBankAccountID = "bank_account_id"
+	// FieldReconciliationID holds the string denoting the reconciliation_id field in the database.
+	FieldReconciliationID = "reconciliation_id"
+	// FieldRule holds the string denoting the rule field in the database.
+	FieldRule = "rule"
+	// FieldRuleID holds the string denoting the rule_id field in the database.
+	FieldRuleID = "rule_id"
+	// FieldMatchType holds the string denoting the match_type field in the database.
+	FieldMatchType = "match_type"
+	// FieldConfidence holds the string denoting the confidence field in the database.
+	FieldConfidence = "confidence"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldBankAmount holds the string denoting the bank_amount field in the database.
+	FieldBankAmount = "bank_amount"
+	// FieldBookAmount holds the string denoting the book_amount field in the database.
+	FieldBookAmount = "book_amount"
+	// FieldDifference holds the string denoting the difference field in the database.
+	FieldDifference = "difference"
+	// FieldFingerprint holds the string denoting the fingerprint field in the database.
+	FieldFingerprint = "fingerprint"
+	// FieldReasons holds the string denoting the reasons field in the database.
+	FieldReasons = "reasons"
+	// FieldMatchedBy holds the string denoting the matched_by field in the database.
+	FieldMatchedBy = "matched_by"
+	// FieldMatchedAt holds the string denoting the matched_at field in the database.
+	FieldMatchedAt = "matched_at"
+	// FieldRejectedBy holds the string denoting the rejected_by field in the database.
+	FieldRejectedBy = "rejected_by"
+	// FieldRejectedAt holds the string denoting the rejected_at field in the database.
+	FieldRejectedAt = "rejected_at"
+	// FieldUnmatchedBy holds the string denoting the unmatched_by field in the database.
+	FieldUnmatchedBy = "unmatched_by"
+	// FieldUnmatchedAt holds the string denoting the unmatched_at field in the database.
+	FieldUnmatchedAt = "unmatched_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeReconciliation holds the string denoting the reconciliation edge name in mutations.
+	EdgeReconciliation = "reconciliation"
+	// EdgeItems holds the string denoting the items edge name in mutations.
+	EdgeItems = "items"
+	// Table holds the table name of the reconciliationmatch in the database.
+	Table = "reconciliation_matches"
+	// ReconciliationTable is the table that holds the reconciliation relation/edge.
+	ReconciliationTable = "reconciliation_matches"
+	// ReconciliationInverseTable is the table name for the Reconciliation entity.
+	// It exists in this package in order to avoid circular dependency with the "reconciliation" package.
+	ReconciliationInverseTable = "reconciliations"
+	// ReconciliationColumn is the table column denoting the reconciliation relation/edge.
+	ReconciliationColumn = "reconciliation_id"
+	// ItemsTable is the table that holds the items relation/edge.
+	ItemsTable = "reconciliation_match_items"
+	// ItemsInverseTable is the table name for the ReconciliationMatchItem entity.
+	// It exists in this package in order to avoid circular dependency with the "reconciliationmatchitem" package.
+	ItemsInverseTable = "reconciliation_match_items"
+	// ItemsColumn is the table column denoting the items relation/edge.
+	ItemsColumn = "match_id"
+)
+
+// Columns holds all SQL columns for reconciliationmatch fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldBankAccountID,
+	FieldReconciliationID,
+	FieldRule,
+	FieldRuleID,
+	FieldMatchType,
+	FieldConfidence,
+	FieldStatus,
+	FieldBankAmount,
+	FieldBookAmount,
+	FieldDifference,
+	FieldFingerprint,
+	FieldReasons,
+	FieldMatchedBy,
+	FieldMatchedAt,
+	FieldRejectedBy,
+	FieldRejectedAt,
+	FieldUnmatchedBy,
+	FieldUnmatchedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultMatchType holds the default value on creation for the "match_type" field.
+	DefaultMatchType string
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ReconciliationMatch queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByBankAccountID orders the results by the bank_account_id field.
+func ByBankAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBankAccountID, opts...).ToFunc()
+}
+
+// ByReconciliationID orders the results by the reconciliation_id field.
+func ByReconciliationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReconciliationID, opts...).ToFunc()
+}
+
+// ByRule orders the results by the rule field.
+func ByRule(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRule, opts...).ToFunc()
+}
+
+// ByRuleID orders the results by the rule_id field.
+func ByRuleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRuleID, opts...).ToFunc()
+}
+
+// ByMatchType orders the results by the match_type field.
+func ByMatchType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatchType, opts...).ToFunc()
+}
+
+// ByConfidence orders the results by the confidence field.
+func ByConfidence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConfidence, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByBankAmount orders the results by the bank_amount field.
+func ByBankAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBankAmount, opts...).ToFunc()
+}
+
+// ByBookAmount orders the results by the book_amount field.
+func ByBookAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBookAmount, opts...).ToFunc()
+}
+
+// ByDifference orders the results by the difference field.
+func ByDifference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDifference, opts...).ToFunc()
+}
+
+// ByFingerprint orders the results by the fingerprint field.
+func ByFingerprint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFingerprint, opts...).ToFunc()
+}
+
+// ByMatchedBy orders the results by the matched_by field.
+func ByMatchedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatchedBy, opts...).ToFunc()
+}
+
+// ByMatchedAt orders the results by the matched_at field.
+func ByMatchedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatchedAt, opts...).ToFunc()
+}
+
+// ByRejectedBy orders the results by the rejected_by field.
+func ByRejectedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRejectedBy, opts...).ToFunc()
+}
+
+// ByRejectedAt orders the results by the rejected_at field.
+func ByRejectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRejectedAt, opts...).ToFunc()
+}
+
+// ByUnmatchedBy orders the results by the unmatched_by field.
+func ByUnmatchedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnmatchedBy, opts...).ToFunc()
+}
+
+// ByUnmatchedAt orders the results by the unmatched_at field.
+func ByUnmatchedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnmatchedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByReconciliationField orders the results by reconciliation field.
+func ByReconciliationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReconciliationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByItemsCount orders the results by items count.
+func ByItemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemsStep(), opts...)
+	}
+}
+
+// ByItems orders the results by items terms.
+func ByItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newReconciliationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReconciliationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReconciliationTable, ReconciliationColumn),
+	)
+}
+func newItemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
+	)
+}
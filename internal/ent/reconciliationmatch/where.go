@@ -0,0 +1,1189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reconciliationmatch
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldTenantID, v))
+}
+
+// BankAccountID applies equality check predicate on the "bank_account_id" field. It's identical to BankAccountIDEQ.
+func BankAccountID(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldBankAccountID, v))
+}
+
+// ReconciliationID applies equality check predicate on the "reconciliation_id" field. It's identical to ReconciliationIDEQ.
+func ReconciliationID(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldReconciliationID, v))
+}
+
+// Rule applies equality check predicate on the "rule" field. It's identical to RuleEQ.
+func Rule(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldRule, v))
+}
+
+// RuleID applies equality check predicate on the "rule_id" field. It's identical to RuleIDEQ.
+func RuleID(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldRuleID, v))
+}
+
+// MatchType applies equality check predicate on the "match_type" field. It's identical to MatchTypeEQ.
+func MatchType(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldMatchType, v))
+}
+
+// Confidence applies equality check predicate on the "confidence" field. It's identical to ConfidenceEQ.
+func Confidence(v int) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldConfidence, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldStatus, v))
+}
+
+// BankAmount applies equality check predicate on the "bank_amount" field. It's identical to BankAmountEQ.
+func BankAmount(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldBankAmount, v))
+}
+
+// BookAmount applies equality check predicate on the "book_amount" field. It's identical to BookAmountEQ.
+func BookAmount(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldBookAmount, v))
+}
+
+// Difference applies equality check predicate on the "difference" field. It's identical to DifferenceEQ.
+func Difference(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldDifference, v))
+}
+
+// Fingerprint applies equality check predicate on the "fingerprint" field. It's identical to FingerprintEQ.
+func Fingerprint(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// MatchedBy applies equality check predicate on the "matched_by" field. It's identical to MatchedByEQ.
+func MatchedBy(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldMatchedBy, v))
+}
+
+// MatchedAt applies equality check predicate on the "matched_at" field. It's identical to MatchedAtEQ.
+func MatchedAt(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldMatchedAt, v))
+}
+
+// RejectedBy applies equality check predicate on the "rejected_by" field. It's identical to RejectedByEQ.
+func RejectedBy(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldRejectedBy, v))
+}
+
+// RejectedAt applies equality check predicate on the "rejected_at" field. It's identical to RejectedAtEQ.
+func RejectedAt(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldRejectedAt, v))
+}
+
+// UnmatchedBy applies equality check predicate on the "unmatched_by" field. It's identical to UnmatchedByEQ.
+func UnmatchedBy(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldUnmatchedBy, v))
+}
+
+// UnmatchedAt applies equality check predicate on the "unmatched_at" field. It's identical to UnmatchedAtEQ.
+func UnmatchedAt(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldUnmatchedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldTenantID, v))
+}
+
+// BankAccountIDEQ applies the EQ predicate on the "bank_account_id" field.
+func BankAccountIDEQ(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldBankAccountID, v))
+}
+
+// BankAccountIDNEQ applies the NEQ predicate on the "bank_account_id" field.
+func BankAccountIDNEQ(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldBankAccountID, v))
+}
+
+// BankAccountIDIn applies the In predicate on the "bank_account_id" field.
+func BankAccountIDIn(vs ...uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldBankAccountID, vs...))
+}
+
+// BankAccountIDNotIn applies the NotIn predicate on the "bank_account_id" field.
+func BankAccountIDNotIn(vs ...uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldBankAccountID, vs...))
+}
+
+// BankAccountIDGT applies the GT predicate on the "bank_account_id" field.
+func BankAccountIDGT(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldBankAccountID, v))
+}
+
+// BankAccountIDGTE applies the GTE predicate on the "bank_account_id" field.
+func BankAccountIDGTE(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldBankAccountID, v))
+}
+
+// BankAccountIDLT applies the LT predicate on the "bank_account_id" field.
+func BankAccountIDLT(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldBankAccountID, v))
+}
+
+// BankAccountIDLTE applies the LTE predicate on the "bank_account_id" field.
+func BankAccountIDLTE(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldBankAccountID, v))
+}
+
+// ReconciliationIDEQ applies the EQ predicate on the "reconciliation_id" field.
+func ReconciliationIDEQ(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldReconciliationID, v))
+}
+
+// ReconciliationIDNEQ applies the NEQ predicate on the "reconciliation_id" field.
+func ReconciliationIDNEQ(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldReconciliationID, v))
+}
+
+// ReconciliationIDIn applies the In predicate on the "reconciliation_id" field.
+func ReconciliationIDIn(vs ...uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldReconciliationID, vs...))
+}
+
+// ReconciliationIDNotIn applies the NotIn predicate on the "reconciliation_id" field.
+func ReconciliationIDNotIn(vs ...uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldReconciliationID, vs...))
+}
+
+// ReconciliationIDIsNil applies the IsNil predicate on the "reconciliation_id" field.
+func ReconciliationIDIsNil() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIsNull(FieldReconciliationID))
+}
+
+// ReconciliationIDNotNil applies the NotNil predicate on the "reconciliation_id" field.
+func ReconciliationIDNotNil() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotNull(FieldReconciliationID))
+}
+
+// RuleEQ applies the EQ predicate on the "rule" field.
+func RuleEQ(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldRule, v))
+}
+
+// RuleNEQ applies the NEQ predicate on the "rule" field.
+func RuleNEQ(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldRule, v))
+}
+
+// RuleIn applies the In predicate on the "rule" field.
+func RuleIn(vs ...string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldRule, vs...))
+}
+
+// RuleNotIn applies the NotIn predicate on the "rule" field.
+func RuleNotIn(vs ...string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldRule, vs...))
+}
+
+// RuleGT applies the GT predicate on the "rule" field.
+func RuleGT(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldRule, v))
+}
+
+// RuleGTE applies the GTE predicate on the "rule" field.
+func RuleGTE(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldRule, v))
+}
+
+// RuleLT applies the LT predicate on the "rule" field.
+func RuleLT(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldRule, v))
+}
+
+// RuleLTE applies the LTE predicate on the "rule" field.
+func RuleLTE(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldRule, v))
+}
+
+// RuleContains applies the Contains predicate on the "rule" field.
+func RuleContains(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldContains(FieldRule, v))
+}
+
+// RuleHasPrefix applies the HasPrefix predicate on the "rule" field.
+func RuleHasPrefix(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldHasPrefix(FieldRule, v))
+}
+
+// RuleHasSuffix applies the HasSuffix predicate on the "rule" field.
+func RuleHasSuffix(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldHasSuffix(FieldRule, v))
+}
+
+// RuleEqualFold applies the EqualFold predicate on the "rule" field.
+func RuleEqualFold(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEqualFold(FieldRule, v))
+}
+
+// RuleContainsFold applies the ContainsFold predicate on the "rule" field.
+func RuleContainsFold(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldContainsFold(FieldRule, v))
+}
+
+// RuleIDEQ applies the EQ predicate on the "rule_id" field.
+func RuleIDEQ(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldRuleID, v))
+}
+
+// RuleIDNEQ applies the NEQ predicate on the "rule_id" field.
+func RuleIDNEQ(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldRuleID, v))
+}
+
+// RuleIDIn applies the In predicate on the "rule_id" field.
+func RuleIDIn(vs ...uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldRuleID, vs...))
+}
+
+// RuleIDNotIn applies the NotIn predicate on the "rule_id" field.
+func RuleIDNotIn(vs ...uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldRuleID, vs...))
+}
+
+// RuleIDGT applies the GT predicate on the "rule_id" field.
+func RuleIDGT(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldRuleID, v))
+}
+
+// RuleIDGTE applies the GTE predicate on the "rule_id" field.
+func RuleIDGTE(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldRuleID, v))
+}
+
+// RuleIDLT applies the LT predicate on the "rule_id" field.
+func RuleIDLT(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldRuleID, v))
+}
+
+// RuleIDLTE applies the LTE predicate on the "rule_id" field.
+func RuleIDLTE(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldRuleID, v))
+}
+
+// RuleIDIsNil applies the IsNil predicate on the "rule_id" field.
+func RuleIDIsNil() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIsNull(FieldRuleID))
+}
+
+// RuleIDNotNil applies the NotNil predicate on the "rule_id" field.
+func RuleIDNotNil() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotNull(FieldRuleID))
+}
+
+// MatchTypeEQ applies the EQ predicate on the "match_type" field.
+func MatchTypeEQ(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldMatchType, v))
+}
+
+// MatchTypeNEQ applies the NEQ predicate on the "match_type" field.
+func MatchTypeNEQ(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldMatchType, v))
+}
+
+// MatchTypeIn applies the In predicate on the "match_type" field.
+func MatchTypeIn(vs ...string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldMatchType, vs...))
+}
+
+// MatchTypeNotIn applies the NotIn predicate on the "match_type" field.
+func MatchTypeNotIn(vs ...string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldMatchType, vs...))
+}
+
+// MatchTypeGT applies the GT predicate on the "match_type" field.
+func MatchTypeGT(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldMatchType, v))
+}
+
+// MatchTypeGTE applies the GTE predicate on the "match_type" field.
+func MatchTypeGTE(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldMatchType, v))
+}
+
+// MatchTypeLT applies the LT predicate on the "match_type" field.
+func MatchTypeLT(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldMatchType, v))
+}
+
+// MatchTypeLTE applies the LTE predicate on the "match_type" field.
+func MatchTypeLTE(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldMatchType, v))
+}
+
+// MatchTypeContains applies the Contains predicate on the "match_type" field.
+func MatchTypeContains(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldContains(FieldMatchType, v))
+}
+
+// MatchTypeHasPrefix applies the HasPrefix predicate on the "match_type" field.
+func MatchTypeHasPrefix(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldHasPrefix(FieldMatchType, v))
+}
+
+// MatchTypeHasSuffix applies the HasSuffix predicate on the "match_type" field.
+func MatchTypeHasSuffix(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldHasSuffix(FieldMatchType, v))
+}
+
+// MatchTypeEqualFold applies the EqualFold predicate on the "match_type" field.
+func MatchTypeEqualFold(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEqualFold(FieldMatchType, v))
+}
+
+// MatchTypeContainsFold applies the ContainsFold predicate on the "match_type" field.
+func MatchTypeContainsFold(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldContainsFold(FieldMatchType, v))
+}
+
+// ConfidenceEQ applies the EQ predicate on the "confidence" field.
+func ConfidenceEQ(v int) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldConfidence, v))
+}
+
+// ConfidenceNEQ applies the NEQ predicate on the "confidence" field.
+func ConfidenceNEQ(v int) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldConfidence, v))
+}
+
+// ConfidenceIn applies the In predicate on the "confidence" field.
+func ConfidenceIn(vs ...int) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldConfidence, vs...))
+}
+
+// ConfidenceNotIn applies the NotIn predicate on the "confidence" field.
+func ConfidenceNotIn(vs ...int) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldConfidence, vs...))
+}
+
+// ConfidenceGT applies the GT predicate on the "confidence" field.
+func ConfidenceGT(v int) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldConfidence, v))
+}
+
+// ConfidenceGTE applies the GTE predicate on the "confidence" field.
+func ConfidenceGTE(v int) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldConfidence, v))
+}
+
+// ConfidenceLT applies the LT predicate on the "confidence" field.
+func ConfidenceLT(v int) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldConfidence, v))
+}
+
+// ConfidenceLTE applies the LTE predicate on the "confidence" field.
+func ConfidenceLTE(v int) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldConfidence, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// BankAmountEQ applies the EQ predicate on the "bank_amount" field.
+func BankAmountEQ(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldBankAmount, v))
+}
+
+// BankAmountNEQ applies the NEQ predicate on the "bank_amount" field.
+func BankAmountNEQ(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldBankAmount, v))
+}
+
+// BankAmountIn applies the In predicate on the "bank_amount" field.
+func BankAmountIn(vs ...decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldBankAmount, vs...))
+}
+
+// BankAmountNotIn applies the NotIn predicate on the "bank_amount" field.
+func BankAmountNotIn(vs ...decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldBankAmount, vs...))
+}
+
+// BankAmountGT applies the GT predicate on the "bank_amount" field.
+func BankAmountGT(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldBankAmount, v))
+}
+
+// BankAmountGTE applies the GTE predicate on the "bank_amount" field.
+func BankAmountGTE(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldBankAmount, v))
+}
+
+// BankAmountLT applies the LT predicate on the "bank_amount" field.
+func BankAmountLT(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldBankAmount, v))
+}
+
+// BankAmountLTE applies the LTE predicate on the "bank_amount" field.
+func BankAmountLTE(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldBankAmount, v))
+}
+
+// BookAmountEQ applies the EQ predicate on the "book_amount" field.
+func BookAmountEQ(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldBookAmount, v))
+}
+
+// BookAmountNEQ applies the NEQ predicate on the "book_amount" field.
+func BookAmountNEQ(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldBookAmount, v))
+}
+
+// BookAmountIn applies the In predicate on the "book_amount" field.
+func BookAmountIn(vs ...decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldBookAmount, vs...))
+}
+
+// BookAmountNotIn applies the NotIn predicate on the "book_amount" field.
+func BookAmountNotIn(vs ...decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldBookAmount, vs...))
+}
+
+// BookAmountGT applies the GT predicate on the "book_amount" field.
+func BookAmountGT(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldBookAmount, v))
+}
+
+// BookAmountGTE applies the GTE predicate on the "book_amount" field.
+func BookAmountGTE(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldBookAmount, v))
+}
+
+// BookAmountLT applies the LT predicate on the "book_amount" field.
+func BookAmountLT(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldBookAmount, v))
+}
+
+// BookAmountLTE applies the LTE predicate on the "book_amount" field.
+func BookAmountLTE(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldBookAmount, v))
+}
+
+// DifferenceEQ applies the EQ predicate on the "difference" field.
+func DifferenceEQ(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldDifference, v))
+}
+
+// DifferenceNEQ applies the NEQ predicate on the "difference" field.
+func DifferenceNEQ(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldDifference, v))
+}
+
+// DifferenceIn applies the In predicate on the "difference" field.
+func DifferenceIn(vs ...decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldDifference, vs...))
+}
+
+// DifferenceNotIn applies the NotIn predicate on the "difference" field.
+func DifferenceNotIn(vs ...decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldDifference, vs...))
+}
+
+// DifferenceGT applies the GT predicate on the "difference" field.
+func DifferenceGT(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldDifference, v))
+}
+
+// DifferenceGTE applies the GTE predicate on the "difference" field.
+func DifferenceGTE(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldDifference, v))
+}
+
+// DifferenceLT applies the LT predicate on the "difference" field.
+func DifferenceLT(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldDifference, v))
+}
+
+// DifferenceLTE applies the LTE predicate on the "difference" field.
+func DifferenceLTE(v decimal.Decimal) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldDifference, v))
+}
+
+// FingerprintEQ applies the EQ predicate on the "fingerprint" field.
+func FingerprintEQ(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// FingerprintNEQ applies the NEQ predicate on the "fingerprint" field.
+func FingerprintNEQ(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldFingerprint, v))
+}
+
+// FingerprintIn applies the In predicate on the "fingerprint" field.
+func FingerprintIn(vs ...string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldFingerprint, vs...))
+}
+
+// FingerprintNotIn applies the NotIn predicate on the "fingerprint" field.
+func FingerprintNotIn(vs ...string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldFingerprint, vs...))
+}
+
+// FingerprintGT applies the GT predicate on the "fingerprint" field.
+func FingerprintGT(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldFingerprint, v))
+}
+
+// FingerprintGTE applies the GTE predicate on the "fingerprint" field.
+func FingerprintGTE(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldFingerprint, v))
+}
+
+// FingerprintLT applies the LT predicate on the "fingerprint" field.
+func FingerprintLT(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldFingerprint, v))
+}
+
+// FingerprintLTE applies the LTE predicate on the "fingerprint" field.
+func FingerprintLTE(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldFingerprint, v))
+}
+
+// FingerprintContains applies the Contains predicate on the "fingerprint" field.
+func FingerprintContains(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldContains(FieldFingerprint, v))
+}
+
+// FingerprintHasPrefix applies the HasPrefix predicate on the "fingerprint" field.
+func FingerprintHasPrefix(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldHasPrefix(FieldFingerprint, v))
+}
+
+// FingerprintHasSuffix applies the HasSuffix predicate on the "fingerprint" field.
+func FingerprintHasSuffix(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldHasSuffix(FieldFingerprint, v))
+}
+
+// FingerprintEqualFold applies the EqualFold predicate on the "fingerprint" field.
+func FingerprintEqualFold(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEqualFold(FieldFingerprint, v))
+}
+
+// FingerprintContainsFold applies the ContainsFold predicate on the "fingerprint" field.
+func FingerprintContainsFold(v string) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldContainsFold(FieldFingerprint, v))
+}
+
+// ReasonsIsNil applies the IsNil predicate on the "reasons" field.
+func ReasonsIsNil() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIsNull(FieldReasons))
+}
+
+// ReasonsNotNil applies the NotNil predicate on the "reasons" field.
+func ReasonsNotNil() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotNull(FieldReasons))
+}
+
+// MatchedByEQ applies the EQ predicate on the "matched_by" field.
+func MatchedByEQ(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldMatchedBy, v))
+}
+
+// MatchedByNEQ applies the NEQ predicate on the "matched_by" field.
+func MatchedByNEQ(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldMatchedBy, v))
+}
+
+// MatchedByIn applies the In predicate on the "matched_by" field.
+func MatchedByIn(vs ...uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldMatchedBy, vs...))
+}
+
+// MatchedByNotIn applies the NotIn predicate on the "matched_by" field.
+func MatchedByNotIn(vs ...uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldMatchedBy, vs...))
+}
+
+// MatchedByGT applies the GT predicate on the "matched_by" field.
+func MatchedByGT(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldMatchedBy, v))
+}
+
+// MatchedByGTE applies the GTE predicate on the "matched_by" field.
+func MatchedByGTE(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldMatchedBy, v))
+}
+
+// MatchedByLT applies the LT predicate on the "matched_by" field.
+func MatchedByLT(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldMatchedBy, v))
+}
+
+// MatchedByLTE applies the LTE predicate on the "matched_by" field.
+func MatchedByLTE(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldMatchedBy, v))
+}
+
+// MatchedByIsNil applies the IsNil predicate on the "matched_by" field.
+func MatchedByIsNil() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIsNull(FieldMatchedBy))
+}
+
+// MatchedByNotNil applies the NotNil predicate on the "matched_by" field.
+func MatchedByNotNil() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotNull(FieldMatchedBy))
+}
+
+// MatchedAtEQ applies the EQ predicate on the "matched_at" field.
+func MatchedAtEQ(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldMatchedAt, v))
+}
+
+// MatchedAtNEQ applies the NEQ predicate on the "matched_at" field.
+func MatchedAtNEQ(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldMatchedAt, v))
+}
+
+// MatchedAtIn applies the In predicate on the "matched_at" field.
+func MatchedAtIn(vs ...time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldMatchedAt, vs...))
+}
+
+// MatchedAtNotIn applies the NotIn predicate on the "matched_at" field.
+func MatchedAtNotIn(vs ...time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldMatchedAt, vs...))
+}
+
+// MatchedAtGT applies the GT predicate on the "matched_at" field.
+func MatchedAtGT(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldMatchedAt, v))
+}
+
+// MatchedAtGTE applies the GTE predicate on the "matched_at" field.
+func MatchedAtGTE(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldMatchedAt, v))
+}
+
+// MatchedAtLT applies the LT predicate on the "matched_at" field.
+func MatchedAtLT(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldMatchedAt, v))
+}
+
+// MatchedAtLTE applies the LTE predicate on the "matched_at" field.
+func MatchedAtLTE(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldMatchedAt, v))
+}
+
+// MatchedAtIsNil applies the IsNil predicate on the "matched_at" field.
+func MatchedAtIsNil() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIsNull(FieldMatchedAt))
+}
+
+// MatchedAtNotNil applies the NotNil predicate on the "matched_at" field.
+func MatchedAtNotNil() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotNull(FieldMatchedAt))
+}
+
+// RejectedByEQ applies the EQ predicate on the "rejected_by" field.
+func RejectedByEQ(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldRejectedBy, v))
+}
+
+// RejectedByNEQ applies the NEQ predicate on the "rejected_by" field.
+func RejectedByNEQ(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldRejectedBy, v))
+}
+
+// RejectedByIn applies the In predicate on the "rejected_by" field.
+func RejectedByIn(vs ...uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldRejectedBy, vs...))
+}
+
+// RejectedByNotIn applies the NotIn predicate on the "rejected_by" field.
+func RejectedByNotIn(vs ...uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldRejectedBy, vs...))
+}
+
+// RejectedByGT applies the GT predicate on the "rejected_by" field.
+func RejectedByGT(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldRejectedBy, v))
+}
+
+// RejectedByGTE applies the GTE predicate on the "rejected_by" field.
+func RejectedByGTE(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldRejectedBy, v))
+}
+
+// RejectedByLT applies the LT predicate on the "rejected_by" field.
+func RejectedByLT(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldRejectedBy, v))
+}
+
+// RejectedByLTE applies the LTE predicate on the "rejected_by" field.
+func RejectedByLTE(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldRejectedBy, v))
+}
+
+// RejectedByIsNil applies the IsNil predicate on the "rejected_by" field.
+func RejectedByIsNil() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIsNull(FieldRejectedBy))
+}
+
+// RejectedByNotNil applies the NotNil predicate on the "rejected_by" field.
+func RejectedByNotNil() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotNull(FieldRejectedBy))
+}
+
+// RejectedAtEQ applies the EQ predicate on the "rejected_at" field.
+func RejectedAtEQ(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldRejectedAt, v))
+}
+
+// RejectedAtNEQ applies the NEQ predicate on the "rejected_at" field.
+func RejectedAtNEQ(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldRejectedAt, v))
+}
+
+// RejectedAtIn applies the In predicate on the "rejected_at" field.
+func RejectedAtIn(vs ...time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldRejectedAt, vs...))
+}
+
+// RejectedAtNotIn applies the NotIn predicate on the "rejected_at" field.
+func RejectedAtNotIn(vs ...time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldRejectedAt, vs...))
+}
+
+// RejectedAtGT applies the GT predicate on the "rejected_at" field.
+func RejectedAtGT(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldRejectedAt, v))
+}
+
+// RejectedAtGTE applies the GTE predicate on the "rejected_at" field.
+func RejectedAtGTE(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldRejectedAt, v))
+}
+
+// RejectedAtLT applies the LT predicate on the "rejected_at" field.
+func RejectedAtLT(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldRejectedAt, v))
+}
+
+// RejectedAtLTE applies the LTE predicate on the "rejected_at" field.
+func RejectedAtLTE(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldRejectedAt, v))
+}
+
+// RejectedAtIsNil applies the IsNil predicate on the "rejected_at" field.
+func RejectedAtIsNil() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIsNull(FieldRejectedAt))
+}
+
+// RejectedAtNotNil applies the NotNil predicate on the "rejected_at" field.
+func RejectedAtNotNil() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotNull(FieldRejectedAt))
+}
+
+// UnmatchedByEQ applies the EQ predicate on the "unmatched_by" field.
+func UnmatchedByEQ(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldUnmatchedBy, v))
+}
+
+// UnmatchedByNEQ applies the NEQ predicate on the "unmatched_by" field.
+func UnmatchedByNEQ(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldUnmatchedBy, v))
+}
+
+// UnmatchedByIn applies the In predicate on the "unmatched_by" field.
+func UnmatchedByIn(vs ...uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldUnmatchedBy, vs...))
+}
+
+// UnmatchedByNotIn applies the NotIn predicate on the "unmatched_by" field.
+func UnmatchedByNotIn(vs ...uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldUnmatchedBy, vs...))
+}
+
+// UnmatchedByGT applies the GT predicate on the "unmatched_by" field.
+func UnmatchedByGT(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldUnmatchedBy, v))
+}
+
+// UnmatchedByGTE applies the GTE predicate on the "unmatched_by" field.
+func UnmatchedByGTE(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldUnmatchedBy, v))
+}
+
+// UnmatchedByLT applies the LT predicate on the "unmatched_by" field.
+func UnmatchedByLT(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldUnmatchedBy, v))
+}
+
+// UnmatchedByLTE applies the LTE predicate on the "unmatched_by" field.
+func UnmatchedByLTE(v uuid.UUID) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldUnmatchedBy, v))
+}
+
+// UnmatchedByIsNil applies the IsNil predicate on the "unmatched_by" field.
+func UnmatchedByIsNil() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIsNull(FieldUnmatchedBy))
+}
+
+// UnmatchedByNotNil applies the NotNil predicate on the "unmatched_by" field.
+func UnmatchedByNotNil() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotNull(FieldUnmatchedBy))
+}
+
+// UnmatchedAtEQ applies the EQ predicate on the "unmatched_at" field.
+func UnmatchedAtEQ(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldUnmatchedAt, v))
+}
+
+// UnmatchedAtNEQ applies the NEQ predicate on the "unmatched_at" field.
+func UnmatchedAtNEQ(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldUnmatchedAt, v))
+}
+
+// UnmatchedAtIn applies the In predicate on the "unmatched_at" field.
+func UnmatchedAtIn(vs ...time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldUnmatchedAt, vs...))
+}
+
+// UnmatchedAtNotIn applies the NotIn predicate on the "unmatched_at" field.
+func UnmatchedAtNotIn(vs ...time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldUnmatchedAt, vs...))
+}
+
+// UnmatchedAtGT applies the GT predicate on the "unmatched_at" field.
+func UnmatchedAtGT(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldUnmatchedAt, v))
+}
+
+// UnmatchedAtGTE applies the GTE predicate on the "unmatched_at" field.
+func UnmatchedAtGTE(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldUnmatchedAt, v))
+}
+
+// UnmatchedAtLT applies the LT predicate on the "unmatched_at" field.
+func UnmatchedAtLT(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldUnmatchedAt, v))
+}
+
+// UnmatchedAtLTE applies the LTE predicate on the "unmatched_at" field.
+func UnmatchedAtLTE(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldUnmatchedAt, v))
+}
+
+// UnmatchedAtIsNil applies the IsNil predicate on the "unmatched_at" field.
+func UnmatchedAtIsNil() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIsNull(FieldUnmatchedAt))
+}
+
+// UnmatchedAtNotNil applies the NotNil predicate on the "unmatched_at" field.
+func UnmatchedAtNotNil() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotNull(FieldUnmatchedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasReconciliation applies the HasEdge predicate on the "reconciliation" edge.
+func HasReconciliation() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReconciliationTable, ReconciliationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReconciliationWith applies the HasEdge predicate on the "reconciliation" edge with a given conditions (other predicates).
+func HasReconciliationWith(preds ...predicate.Reconciliation) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(func(s *sql.Selector) {
+		step := newReconciliationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItems applies the HasEdge predicate on the "items" edge.
+func HasItems() predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemsWith applies the HasEdge predicate on the "items" edge with a given conditions (other predicates).
+func HasItemsWith(preds ...predicate.ReconciliationMatchItem) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(func(s *sql.Selector) {
+		step := newItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReconciliationMatch) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReconciliationMatch) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReconciliationMatch) predicate.ReconciliationMatch {
+	return predicate.ReconciliationMatch(sql.NotPredicates(p))
+}
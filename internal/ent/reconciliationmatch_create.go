@@ -0,0 +1,1932 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliation"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatch"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatchitem"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationMatchCreate is the builder for creating a ReconciliationMatch entity.
+type ReconciliationMatchCreate struct {
+	config
+	mutation *ReconciliationMatchMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ReconciliationMatchCreate) SetTenantID(v uuid.UUID) *ReconciliationMatchCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (_c *ReconciliationMatchCreate) SetBankAccountID(v uuid.UUID) *ReconciliationMatchCreate {
+	_c.mutation.SetBankAccountID(v)
+	return _c
+}
+
+// SetReconciliationID sets the "reconciliation_id" field.
+func (_c *ReconciliationMatchCreate) SetReconciliationID(v uuid.UUID) *ReconciliationMatchCreate {
+	_c.mutation.SetReconciliationID(v)
+	return _c
+}
+
+// SetNillableReconciliationID sets the "reconciliation_id" field if the given value is not nil.
+func (_c *ReconciliationMatchCreate) SetNillableReconciliationID(v *uuid.UUID) *ReconciliationMatchCreate {
+	if v != nil {
+		_c.SetReconciliationID(*v)
+	}
+	return _c
+}
+
+// SetRule sets the "rule" field.
+func (_c *ReconciliationMatchCreate) SetRule(v string) *ReconciliationMatchCreate {
+	_c.mutation.SetRule(v)
+	return _c
+}
+
+// SetRuleID sets the "rule_id" field.
+func (_c *ReconciliationMatchCreate) SetRuleID(v uuid.UUID) *ReconciliationMatchCreate {
+	_c.mutation.SetRuleID(v)
+	return _c
+}
+
+// SetNillableRuleID sets the "rule_id" field if the given value is not nil.
+func (_c *ReconciliationMatchCreate) SetNillableRuleID(v *uuid.UUID) *ReconciliationMatchCreate {
+	if v != nil {
+		_c.SetRuleID(*v)
+	}
+	return _c
+}
+
+// SetMatchType sets the "match_type" field.
+func (_c *ReconciliationMatchCreate) SetMatchType(v string) *ReconciliationMatchCreate {
+	_c.mutation.SetMatchType(v)
+	return _c
+}
+
+// SetNillableMatchType sets the "match_type" field if the given value is not nil.
+func (_c *ReconciliationMatchCreate) SetNillableMatchType(v *string) *ReconciliationMatchCreate {
+	if v != nil {
+		_c.SetMatchType(*v)
+	}
+	return _c
+}
+
+// SetConfidence sets the "confidence" field.
+func (_c *ReconciliationMatchCreate) SetConfidence(v int) *ReconciliationMatchCreate {
+	_c.mutation.SetConfidence(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ReconciliationMatchCreate) SetStatus(v string) *ReconciliationMatchCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ReconciliationMatchCreate) SetNillableStatus(v *string) *ReconciliationMatchCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetBankAmount sets the "bank_amount" field.
+func (_c *ReconciliationMatchCreate) SetBankAmount(v decimal.Decimal) *ReconciliationMatchCreate {
+	_c.mutation.SetBankAmount(v)
+	return _c
+}
+
+// SetBookAmount sets the "book_amount" field.
+func (_c *ReconciliationMatchCreate) SetBookAmount(v decimal.Decimal) *ReconciliationMatchCreate {
+	_c.mutation.SetBookAmount(v)
+	return _c
+}
+
+// SetDifference sets the "difference" field.
+func (_c *ReconciliationMatchCreate) SetDifference(v decimal.Decimal) *ReconciliationMatchCreate {
+	_c.mutation.SetDifference(v)
+	return _c
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (_c *ReconciliationMatchCreate) SetFingerprint(v string) *ReconciliationMatchCreate {
+	_c.mutation.SetFingerprint(v)
+	return _c
+}
+
+// SetReasons sets the "reasons" field.
+func (_c *ReconciliationMatchCreate) SetReasons(v []string) *ReconciliationMatchCreate {
+	_c.mutation.SetReasons(v)
+	return _c
+}
+
+// SetMatchedBy sets the "matched_by" field.
+func (_c *ReconciliationMatchCreate) SetMatchedBy(v uuid.UUID) *ReconciliationMatchCreate {
+	_c.mutation.SetMatchedBy(v)
+	return _c
+}
+
+// SetNillableMatchedBy sets the "matched_by" field if the given value is not nil.
+func (_c *ReconciliationMatchCreate) SetNillableMatchedBy(v *uuid.UUID) *ReconciliationMatchCreate {
+	if v != nil {
+		_c.SetMatchedBy(*v)
+	}
+	return _c
+}
+
+// SetMatchedAt sets the "matched_at" field.
+func (_c *ReconciliationMatchCreate) SetMatchedAt(v time.Time) *ReconciliationMatchCreate {
+	_c.mutation.SetMatchedAt(v)
+	return _c
+}
+
+// SetNillableMatchedAt sets the "matched_at" field if the given value is not nil.
+func (_c *ReconciliationMatchCreate) SetNillableMatchedAt(v *time.Time) *ReconciliationMatchCreate {
+	if v != nil {
+		_c.SetMatchedAt(*v)
+	}
+	return _c
+}
+
+// SetRejectedBy sets the "rejected_by" field.
+func (_c *ReconciliationMatchCreate) SetRejectedBy(v uuid.UUID) *ReconciliationMatchCreate {
+	_c.mutation.SetRejectedBy(v)
+	return _c
+}
+
+// SetNillableRejectedBy sets the "rejected_by" field if the given value is not nil.
+func (_c *ReconciliationMatchCreate) SetNillableRejectedBy(v *uuid.UUID) *ReconciliationMatchCreate {
+	if v != nil {
+		_c.SetRejectedBy(*v)
+	}
+	return _c
+}
+
+// SetRejectedAt sets the "rejected_at" field.
+func (_c *ReconciliationMatchCreate) SetRejectedAt(v time.Time) *ReconciliationMatchCreate {
+	_c.mutation.SetRejectedAt(v)
+	return _c
+}
+
+// SetNillableRejectedAt sets the "rejected_at" field if the given value is not nil.
+func (_c *ReconciliationMatchCreate) SetNillableRejectedAt(v *time.Time) *ReconciliationMatchCreate {
+	if v != nil {
+		_c.SetRejectedAt(*v)
+	}
+	return _c
+}
+
+// SetUnmatchedBy sets the "unmatched_by" field.
+func (_c *ReconciliationMatchCreate) SetUnmatchedBy(v uuid.UUID) *ReconciliationMatchCreate {
+	_c.mutation.SetUnmatchedBy(v)
+	return _c
+}
+
+// SetNillableUnmatchedBy sets the "unmatched_by" field if the given value is not nil.
+func (_c *ReconciliationMatchCreate) SetNillableUnmatchedBy(v *uuid.UUID) *ReconciliationMatchCreate {
+	if v != nil {
+		_c.SetUnmatchedBy(*v)
+	}
+	return _c
+}
+
+// SetUnmatchedAt sets the "unmatched_at" field.
+func (_c *ReconciliationMatchCreate) SetUnmatchedAt(v time.Time) *ReconciliationMatchCreate {
+	_c.mutation.SetUnmatchedAt(v)
+	return _c
+}
+
+// SetNillableUnmatchedAt sets the "unmatched_at" field if the given value is not nil.
+func (_c *ReconciliationMatchCreate) SetNillableUnmatchedAt(v *time.Time) *ReconciliationMatchCreate {
+	if v != nil {
+		_c.SetUnmatchedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReconciliationMatchCreate) SetCreatedAt(v time.Time) *ReconciliationMatchCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReconciliationMatchCreate) SetNillableCreatedAt(v *time.Time) *ReconciliationMatchCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ReconciliationMatchCreate) SetUpdatedAt(v time.Time) *ReconciliationMatchCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ReconciliationMatchCreate) SetNillableUpdatedAt(v *time.Time) *ReconciliationMatchCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReconciliationMatchCreate) SetID(v uuid.UUID) *ReconciliationMatchCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ReconciliationMatchCreate) SetNillableID(v *uuid.UUID) *ReconciliationMatchCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetReconciliation sets the "reconciliation" edge to the Reconciliation entity.
+func (_c *ReconciliationMatchCreate) SetReconciliation(v *Reconciliation) *ReconciliationMatchCreate {
+	return _c.SetReconciliationID(v.ID)
+}
+
+// AddItemIDs adds the "items" edge to the ReconciliationMatchItem entity by IDs.
+func (_c *ReconciliationMatchCreate) AddItemIDs(ids ...uuid.UUID) *ReconciliationMatchCreate {
+	_c.mutation.AddItemIDs(ids...)
+	return _c
+}
+
+// AddItems adds the "items" edges to the ReconciliationMatchItem entity.
+func (_c *ReconciliationMatchCreate) AddItems(v ...*ReconciliationMatchItem) *ReconciliationMatchCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddItemIDs(ids...)
+}
+
+// Mutation returns the ReconciliationMatchMutation object of the builder.
+func (_c *ReconciliationMatchCreate) Mutation() *ReconciliationMatchMutation {
+	return _c.mutation
+}
+
+// Save creates the ReconciliationMatch in the database.
+func (_c *ReconciliationMatchCreate) Save(ctx context.Context) (*ReconciliationMatch, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReconciliationMatchCreate) SaveX(ctx context.Context) *ReconciliationMatch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReconciliationMatchCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReconciliationMatchCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReconciliationMatchCreate) defaults() {
+	if _, ok := _c.mutation.MatchType(); !ok {
+		v := reconciliationmatch.DefaultMatchType
+		_c.mutation.SetMatchType(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := reconciliationmatch.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := reconciliationmatch.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := reconciliationmatch.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := reconciliationmatch.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReconciliationMatchCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ReconciliationMatch.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.BankAccountID(); !ok {
+		return &ValidationError{Name: "bank_account_id", err: errors.New(`ent: missing required field "ReconciliationMatch.bank_account_id"`)}
+	}
+	if _, ok := _c.mutation.Rule(); !ok {
+		return &ValidationError{Name: "rule", err: errors.New(`ent: missing required field "ReconciliationMatch.rule"`)}
+	}
+	if _, ok := _c.mutation.MatchType(); !ok {
+		return &ValidationError{Name: "match_type", err: errors.New(`ent: missing required field "ReconciliationMatch.match_type"`)}
+	}
+	if _, ok := _c.mutation.Confidence(); !ok {
+		return &ValidationError{Name: "confidence", err: errors.New(`ent: missing required field "ReconciliationMatch.confidence"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ReconciliationMatch.status"`)}
+	}
+	if _, ok := _c.mutation.BankAmount(); !ok {
+		return &ValidationError{Name: "bank_amount", err: errors.New(`ent: missing required field "ReconciliationMatch.bank_amount"`)}
+	}
+	if _, ok := _c.mutation.BookAmount(); !ok {
+		return &ValidationError{Name: "book_amount", err: errors.New(`ent: missing required field "ReconciliationMatch.book_amount"`)}
+	}
+	if _, ok := _c.mutation.Difference(); !ok {
+		return &ValidationError{Name: "difference", err: errors.New(`ent: missing required field "ReconciliationMatch.difference"`)}
+	}
+	if _, ok := _c.mutation.Fingerprint(); !ok {
+		return &ValidationError{Name: "fingerprint", err: errors.New(`ent: missing required field "ReconciliationMatch.fingerprint"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReconciliationMatch.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReconciliationMatch.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ReconciliationMatchCreate) sqlSave(ctx context.Context) (*ReconciliationMatch, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReconciliationMatchCreate) createSpec() (*ReconciliationMatch, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReconciliationMatch{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(reconciliationmatch.Table, sqlgraph.NewFieldSpec(reconciliationmatch.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(reconciliationmatch.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.BankAccountID(); ok {
+		_spec.SetField(reconciliationmatch.FieldBankAccountID, field.TypeUUID, value)
+		_node.BankAccountID = value
+	}
+	if value, ok := _c.mutation.Rule(); ok {
+		_spec.SetField(reconciliationmatch.FieldRule, field.TypeString, value)
+		_node.Rule = value
+	}
+	if value, ok := _c.mutation.RuleID(); ok {
+		_spec.SetField(reconciliationmatch.FieldRuleID, field.TypeUUID, value)
+		_node.RuleID = &value
+	}
+	if value, ok := _c.mutation.MatchType(); ok {
+		_spec.SetField(reconciliationmatch.FieldMatchType, field.TypeString, value)
+		_node.MatchType = value
+	}
+	if value, ok := _c.mutation.Confidence(); ok {
+		_spec.SetField(reconciliationmatch.FieldConfidence, field.TypeInt, value)
+		_node.Confidence = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(reconciliationmatch.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.BankAmount(); ok {
+		_spec.SetField(reconciliationmatch.FieldBankAmount, field.TypeFloat64, value)
+		_node.BankAmount = value
+	}
+	if value, ok := _c.mutation.BookAmount(); ok {
+		_spec.SetField(reconciliationmatch.FieldBookAmount, field.TypeFloat64, value)
+		_node.BookAmount = value
+	}
+	if value, ok := _c.mutation.Difference(); ok {
+		_spec.SetField(reconciliationmatch.FieldDifference, field.TypeFloat64, value)
+		_node.Difference = value
+	}
+	if value, ok := _c.mutation.Fingerprint(); ok {
+		_spec.SetField(reconciliationmatch.FieldFingerprint, field.TypeString, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := _c.mutation.Reasons(); ok {
+		_spec.SetField(reconciliationmatch.FieldReasons, field.TypeJSON, value)
+		_node.Reasons = value
+	}
+	if value, ok := _c.mutation.MatchedBy(); ok {
+		_spec.SetField(reconciliationmatch.FieldMatchedBy, field.TypeUUID, value)
+		_node.MatchedBy = &value
+	}
+	if value, ok := _c.mutation.MatchedAt(); ok {
+		_spec.SetField(reconciliationmatch.FieldMatchedAt, field.TypeTime, value)
+		_node.MatchedAt = &value
+	}
+	if value, ok := _c.mutation.RejectedBy(); ok {
+		_spec.SetField(reconciliationmatch.FieldRejectedBy, field.TypeUUID, value)
+		_node.RejectedBy = &value
+	}
+	if value, ok := _c.mutation.RejectedAt(); ok {
+		_spec.SetField(reconciliationmatch.FieldRejectedAt, field.TypeTime, value)
+		_node.RejectedAt = &value
+	}
+	if value, ok := _c.mutation.UnmatchedBy(); ok {
+		_spec.SetField(reconciliationmatch.FieldUnmatchedBy, field.TypeUUID, value)
+		_node.UnmatchedBy = &value
+	}
+	if value, ok := _c.mutation.UnmatchedAt(); ok {
+		_spec.SetField(reconciliationmatch.FieldUnmatchedAt, field.TypeTime, value)
+		_node.UnmatchedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(reconciliationmatch.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(reconciliationmatch.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.ReconciliationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationmatch.ReconciliationTable,
+			Columns: []string{reconciliationmatch.ReconciliationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReconciliationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliationmatch.ItemsTable,
+			Columns: []string{reconciliationmatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatchitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReconciliationMatch.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReconciliationMatchUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReconciliationMatchCreate) OnConflict(opts ...sql.ConflictOption) *ReconciliationMatchUpsertOne {
+	_c.conflict = opts
+	return &ReconciliationMatchUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReconciliationMatch.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReconciliationMatchCreate) OnConflictColumns(columns ...string) *ReconciliationMatchUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReconciliationMatchUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReconciliationMatchUpsertOne is the builder for "upsert"-ing
+	//  one ReconciliationMatch node.
+	ReconciliationMatchUpsertOne struct {
+		create *ReconciliationMatchCreate
+	}
+
+	// ReconciliationMatchUpsert is the "OnConflict" setter.
+	ReconciliationMatchUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *ReconciliationMatchUpsert) SetTenantID(v uuid.UUID) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateTenantID() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldTenantID)
+	return u
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (u *ReconciliationMatchUpsert) SetBankAccountID(v uuid.UUID) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldBankAccountID, v)
+	return u
+}
+
+// UpdateBankAccountID sets the "bank_account_id" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateBankAccountID() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldBankAccountID)
+	return u
+}
+
+// SetReconciliationID sets the "reconciliation_id" field.
+func (u *ReconciliationMatchUpsert) SetReconciliationID(v uuid.UUID) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldReconciliationID, v)
+	return u
+}
+
+// UpdateReconciliationID sets the "reconciliation_id" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateReconciliationID() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldReconciliationID)
+	return u
+}
+
+// ClearReconciliationID clears the value of the "reconciliation_id" field.
+func (u *ReconciliationMatchUpsert) ClearReconciliationID() *ReconciliationMatchUpsert {
+	u.SetNull(reconciliationmatch.FieldReconciliationID)
+	return u
+}
+
+// SetRule sets the "rule" field.
+func (u *ReconciliationMatchUpsert) SetRule(v string) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldRule, v)
+	return u
+}
+
+// UpdateRule sets the "rule" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateRule() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldRule)
+	return u
+}
+
+// SetRuleID sets the "rule_id" field.
+func (u *ReconciliationMatchUpsert) SetRuleID(v uuid.UUID) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldRuleID, v)
+	return u
+}
+
+// UpdateRuleID sets the "rule_id" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateRuleID() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldRuleID)
+	return u
+}
+
+// ClearRuleID clears the value of the "rule_id" field.
+func (u *ReconciliationMatchUpsert) ClearRuleID() *ReconciliationMatchUpsert {
+	u.SetNull(reconciliationmatch.FieldRuleID)
+	return u
+}
+
+// SetMatchType sets the "match_type" field.
+func (u *ReconciliationMatchUpsert) SetMatchType(v string) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldMatchType, v)
+	return u
+}
+
+// UpdateMatchType sets the "match_type" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateMatchType() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldMatchType)
+	return u
+}
+
+// SetConfidence sets the "confidence" field.
+func (u *ReconciliationMatchUpsert) SetConfidence(v int) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldConfidence, v)
+	return u
+}
+
+// UpdateConfidence sets the "confidence" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateConfidence() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldConfidence)
+	return u
+}
+
+// AddConfidence adds v to the "confidence" field.
+func (u *ReconciliationMatchUpsert) AddConfidence(v int) *ReconciliationMatchUpsert {
+	u.Add(reconciliationmatch.FieldConfidence, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReconciliationMatchUpsert) SetStatus(v string) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateStatus() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldStatus)
+	return u
+}
+
+// SetBankAmount sets the "bank_amount" field.
+func (u *ReconciliationMatchUpsert) SetBankAmount(v decimal.Decimal) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldBankAmount, v)
+	return u
+}
+
+// UpdateBankAmount sets the "bank_amount" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateBankAmount() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldBankAmount)
+	return u
+}
+
+// AddBankAmount adds v to the "bank_amount" field.
+func (u *ReconciliationMatchUpsert) AddBankAmount(v decimal.Decimal) *ReconciliationMatchUpsert {
+	u.Add(reconciliationmatch.FieldBankAmount, v)
+	return u
+}
+
+// SetBookAmount sets the "book_amount" field.
+func (u *ReconciliationMatchUpsert) SetBookAmount(v decimal.Decimal) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldBookAmount, v)
+	return u
+}
+
+// UpdateBookAmount sets the "book_amount" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateBookAmount() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldBookAmount)
+	return u
+}
+
+// AddBookAmount adds v to the "book_amount" field.
+func (u *ReconciliationMatchUpsert) AddBookAmount(v decimal.Decimal) *ReconciliationMatchUpsert {
+	u.Add(reconciliationmatch.FieldBookAmount, v)
+	return u
+}
+
+// SetDifference sets the "difference" field.
+func (u *ReconciliationMatchUpsert) SetDifference(v decimal.Decimal) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldDifference, v)
+	return u
+}
+
+// UpdateDifference sets the "difference" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateDifference() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldDifference)
+	return u
+}
+
+// AddDifference adds v to the "difference" field.
+func (u *ReconciliationMatchUpsert) AddDifference(v decimal.Decimal) *ReconciliationMatchUpsert {
+	u.Add(reconciliationmatch.FieldDifference, v)
+	return u
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (u *ReconciliationMatchUpsert) SetFingerprint(v string) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldFingerprint, v)
+	return u
+}
+
+// UpdateFingerprint sets the "fingerprint" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateFingerprint() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldFingerprint)
+	return u
+}
+
+// SetReasons sets the "reasons" field.
+func (u *ReconciliationMatchUpsert) SetReasons(v []string) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldReasons, v)
+	return u
+}
+
+// UpdateReasons sets the "reasons" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateReasons() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldReasons)
+	return u
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (u *ReconciliationMatchUpsert) ClearReasons() *ReconciliationMatchUpsert {
+	u.SetNull(reconciliationmatch.FieldReasons)
+	return u
+}
+
+// SetMatchedBy sets the "matched_by" field.
+func (u *ReconciliationMatchUpsert) SetMatchedBy(v uuid.UUID) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldMatchedBy, v)
+	return u
+}
+
+// UpdateMatchedBy sets the "matched_by" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateMatchedBy() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldMatchedBy)
+	return u
+}
+
+// ClearMatchedBy clears the value of the "matched_by" field.
+func (u *ReconciliationMatchUpsert) ClearMatchedBy() *ReconciliationMatchUpsert {
+	u.SetNull(reconciliationmatch.FieldMatchedBy)
+	return u
+}
+
+// SetMatchedAt sets the "matched_at" field.
+func (u *ReconciliationMatchUpsert) SetMatchedAt(v time.Time) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldMatchedAt, v)
+	return u
+}
+
+// UpdateMatchedAt sets the "matched_at" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateMatchedAt() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldMatchedAt)
+	return u
+}
+
+// ClearMatchedAt clears the value of the "matched_at" field.
+func (u *ReconciliationMatchUpsert) ClearMatchedAt() *ReconciliationMatchUpsert {
+	u.SetNull(reconciliationmatch.FieldMatchedAt)
+	return u
+}
+
+// SetRejectedBy sets the "rejected_by" field.
+func (u *ReconciliationMatchUpsert) SetRejectedBy(v uuid.UUID) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldRejectedBy, v)
+	return u
+}
+
+// UpdateRejectedBy sets the "rejected_by" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateRejectedBy() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldRejectedBy)
+	return u
+}
+
+// ClearRejectedBy clears the value of the "rejected_by" field.
+func (u *ReconciliationMatchUpsert) ClearRejectedBy() *ReconciliationMatchUpsert {
+	u.SetNull(reconciliationmatch.FieldRejectedBy)
+	return u
+}
+
+// SetRejectedAt sets the "rejected_at" field.
+func (u *ReconciliationMatchUpsert) SetRejectedAt(v time.Time) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldRejectedAt, v)
+	return u
+}
+
+// UpdateRejectedAt sets the "rejected_at" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateRejectedAt() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldRejectedAt)
+	return u
+}
+
+// ClearRejectedAt clears the value of the "rejected_at" field.
+func (u *ReconciliationMatchUpsert) ClearRejectedAt() *ReconciliationMatchUpsert {
+	u.SetNull(reconciliationmatch.FieldRejectedAt)
+	return u
+}
+
+// SetUnmatchedBy sets the "unmatched_by" field.
+func (u *ReconciliationMatchUpsert) SetUnmatchedBy(v uuid.UUID) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldUnmatchedBy, v)
+	return u
+}
+
+// UpdateUnmatchedBy sets the "unmatched_by" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateUnmatchedBy() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldUnmatchedBy)
+	return u
+}
+
+// ClearUnmatchedBy clears the value of the "unmatched_by" field.
+func (u *ReconciliationMatchUpsert) ClearUnmatchedBy() *ReconciliationMatchUpsert {
+	u.SetNull(reconciliationmatch.FieldUnmatchedBy)
+	return u
+}
+
+// SetUnmatchedAt sets the "unmatched_at" field.
+func (u *ReconciliationMatchUpsert) SetUnmatchedAt(v time.Time) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldUnmatchedAt, v)
+	return u
+}
+
+// UpdateUnmatchedAt sets the "unmatched_at" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateUnmatchedAt() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldUnmatchedAt)
+	return u
+}
+
+// ClearUnmatchedAt clears the value of the "unmatched_at" field.
+func (u *ReconciliationMatchUpsert) ClearUnmatchedAt() *ReconciliationMatchUpsert {
+	u.SetNull(reconciliationmatch.FieldUnmatchedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReconciliationMatchUpsert) SetUpdatedAt(v time.Time) *ReconciliationMatchUpsert {
+	u.Set(reconciliationmatch.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsert) UpdateUpdatedAt() *ReconciliationMatchUpsert {
+	u.SetExcluded(reconciliationmatch.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReconciliationMatch.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reconciliationmatch.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReconciliationMatchUpsertOne) UpdateNewValues() *ReconciliationMatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reconciliationmatch.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(reconciliationmatch.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReconciliationMatch.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReconciliationMatchUpsertOne) Ignore() *ReconciliationMatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReconciliationMatchUpsertOne) DoNothing() *ReconciliationMatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReconciliationMatchCreate.OnConflict
+// documentation for more info.
+func (u *ReconciliationMatchUpsertOne) Update(set func(*ReconciliationMatchUpsert)) *ReconciliationMatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReconciliationMatchUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *ReconciliationMatchUpsertOne) SetTenantID(v uuid.UUID) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateTenantID() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (u *ReconciliationMatchUpsertOne) SetBankAccountID(v uuid.UUID) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetBankAccountID(v)
+	})
+}
+
+// UpdateBankAccountID sets the "bank_account_id" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateBankAccountID() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateBankAccountID()
+	})
+}
+
+// SetReconciliationID sets the "reconciliation_id" field.
+func (u *ReconciliationMatchUpsertOne) SetReconciliationID(v uuid.UUID) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetReconciliationID(v)
+	})
+}
+
+// UpdateReconciliationID sets the "reconciliation_id" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateReconciliationID() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateReconciliationID()
+	})
+}
+
+// ClearReconciliationID clears the value of the "reconciliation_id" field.
+func (u *ReconciliationMatchUpsertOne) ClearReconciliationID() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.ClearReconciliationID()
+	})
+}
+
+// SetRule sets the "rule" field.
+func (u *ReconciliationMatchUpsertOne) SetRule(v string) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetRule(v)
+	})
+}
+
+// UpdateRule sets the "rule" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateRule() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateRule()
+	})
+}
+
+// SetRuleID sets the "rule_id" field.
+func (u *ReconciliationMatchUpsertOne) SetRuleID(v uuid.UUID) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetRuleID(v)
+	})
+}
+
+// UpdateRuleID sets the "rule_id" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateRuleID() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateRuleID()
+	})
+}
+
+// ClearRuleID clears the value of the "rule_id" field.
+func (u *ReconciliationMatchUpsertOne) ClearRuleID() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.ClearRuleID()
+	})
+}
+
+// SetMatchType sets the "match_type" field.
+func (u *ReconciliationMatchUpsertOne) SetMatchType(v string) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetMatchType(v)
+	})
+}
+
+// UpdateMatchType sets the "match_type" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateMatchType() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateMatchType()
+	})
+}
+
+// SetConfidence sets the "confidence" field.
+func (u *ReconciliationMatchUpsertOne) SetConfidence(v int) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetConfidence(v)
+	})
+}
+
+// AddConfidence adds v to the "confidence" field.
+func (u *ReconciliationMatchUpsertOne) AddConfidence(v int) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.AddConfidence(v)
+	})
+}
+
+// UpdateConfidence sets the "confidence" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateConfidence() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateConfidence()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReconciliationMatchUpsertOne) SetStatus(v string) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateStatus() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetBankAmount sets the "bank_amount" field.
+func (u *ReconciliationMatchUpsertOne) SetBankAmount(v decimal.Decimal) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetBankAmount(v)
+	})
+}
+
+// AddBankAmount adds v to the "bank_amount" field.
+func (u *ReconciliationMatchUpsertOne) AddBankAmount(v decimal.Decimal) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.AddBankAmount(v)
+	})
+}
+
+// UpdateBankAmount sets the "bank_amount" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateBankAmount() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateBankAmount()
+	})
+}
+
+// SetBookAmount sets the "book_amount" field.
+func (u *ReconciliationMatchUpsertOne) SetBookAmount(v decimal.Decimal) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetBookAmount(v)
+	})
+}
+
+// AddBookAmount adds v to the "book_amount" field.
+func (u *ReconciliationMatchUpsertOne) AddBookAmount(v decimal.Decimal) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.AddBookAmount(v)
+	})
+}
+
+// UpdateBookAmount sets the "book_amount" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateBookAmount() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateBookAmount()
+	})
+}
+
+// SetDifference sets the "difference" field.
+func (u *ReconciliationMatchUpsertOne) SetDifference(v decimal.Decimal) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetDifference(v)
+	})
+}
+
+// AddDifference adds v to the "difference" field.
+func (u *ReconciliationMatchUpsertOne) AddDifference(v decimal.Decimal) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.AddDifference(v)
+	})
+}
+
+// UpdateDifference sets the "difference" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateDifference() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateDifference()
+	})
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (u *ReconciliationMatchUpsertOne) SetFingerprint(v string) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetFingerprint(v)
+	})
+}
+
+// UpdateFingerprint sets the "fingerprint" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateFingerprint() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateFingerprint()
+	})
+}
+
+// SetReasons sets the "reasons" field.
+func (u *ReconciliationMatchUpsertOne) SetReasons(v []string) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetReasons(v)
+	})
+}
+
+// UpdateReasons sets the "reasons" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateReasons() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateReasons()
+	})
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (u *ReconciliationMatchUpsertOne) ClearReasons() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.ClearReasons()
+	})
+}
+
+// SetMatchedBy sets the "matched_by" field.
+func (u *ReconciliationMatchUpsertOne) SetMatchedBy(v uuid.UUID) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetMatchedBy(v)
+	})
+}
+
+// UpdateMatchedBy sets the "matched_by" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateMatchedBy() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateMatchedBy()
+	})
+}
+
+// ClearMatchedBy clears the value of the "matched_by" field.
+func (u *ReconciliationMatchUpsertOne) ClearMatchedBy() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.ClearMatchedBy()
+	})
+}
+
+// SetMatchedAt sets the "matched_at" field.
+func (u *ReconciliationMatchUpsertOne) SetMatchedAt(v time.Time) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetMatchedAt(v)
+	})
+}
+
+// UpdateMatchedAt sets the "matched_at" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateMatchedAt() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateMatchedAt()
+	})
+}
+
+// ClearMatchedAt clears the value of the "matched_at" field.
+func (u *ReconciliationMatchUpsertOne) ClearMatchedAt() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.ClearMatchedAt()
+	})
+}
+
+// SetRejectedBy sets the "rejected_by" field.
+func (u *ReconciliationMatchUpsertOne) SetRejectedBy(v uuid.UUID) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetRejectedBy(v)
+	})
+}
+
+// UpdateRejectedBy sets the "rejected_by" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateRejectedBy() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateRejectedBy()
+	})
+}
+
+// ClearRejectedBy clears the value of the "rejected_by" field.
+func (u *ReconciliationMatchUpsertOne) ClearRejectedBy() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.ClearRejectedBy()
+	})
+}
+
+// SetRejectedAt sets the "rejected_at" field.
+func (u *ReconciliationMatchUpsertOne) SetRejectedAt(v time.Time) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetRejectedAt(v)
+	})
+}
+
+// UpdateRejectedAt sets the "rejected_at" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateRejectedAt() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateRejectedAt()
+	})
+}
+
+// ClearRejectedAt clears the value of the "rejected_at" field.
+func (u *ReconciliationMatchUpsertOne) ClearRejectedAt() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.ClearRejectedAt()
+	})
+}
+
+// SetUnmatchedBy sets the "unmatched_by" field.
+func (u *ReconciliationMatchUpsertOne) SetUnmatchedBy(v uuid.UUID) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetUnmatchedBy(v)
+	})
+}
+
+// UpdateUnmatchedBy sets the "unmatched_by" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateUnmatchedBy() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateUnmatchedBy()
+	})
+}
+
+// ClearUnmatchedBy clears the value of the "unmatched_by" field.
+func (u *ReconciliationMatchUpsertOne) ClearUnmatchedBy() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.ClearUnmatchedBy()
+	})
+}
+
+// SetUnmatchedAt sets the "unmatched_at" field.
+func (u *ReconciliationMatchUpsertOne) SetUnmatchedAt(v time.Time) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetUnmatchedAt(v)
+	})
+}
+
+// UpdateUnmatchedAt sets the "unmatched_at" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateUnmatchedAt() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateUnmatchedAt()
+	})
+}
+
+// ClearUnmatchedAt clears the value of the "unmatched_at" field.
+func (u *ReconciliationMatchUpsertOne) ClearUnmatchedAt() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.ClearUnmatchedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReconciliationMatchUpsertOne) SetUpdatedAt(v time.Time) *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertOne) UpdateUpdatedAt() *ReconciliationMatchUpsertOne {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReconciliationMatchUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReconciliationMatchCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReconciliationMatchUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReconciliationMatchUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReconciliationMatchUpsertOne.ID is not supported by MySQL driver. Use ReconciliationMatchUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReconciliationMatchUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReconciliationMatchCreateBulk is the builder for creating many ReconciliationMatch entities in bulk.
+type ReconciliationMatchCreateBulk struct {
+	config
+	err      error
+	builders []*ReconciliationMatchCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReconciliationMatch entities in the database.
+func (_c *ReconciliationMatchCreateBulk) Save(ctx context.Context) ([]*ReconciliationMatch, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReconciliationMatch, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReconciliationMatchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReconciliationMatchCreateBulk) SaveX(ctx context.Context) []*ReconciliationMatch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReconciliationMatchCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReconciliationMatchCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReconciliationMatch.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReconciliationMatchUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReconciliationMatchCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReconciliationMatchUpsertBulk {
+	_c.conflict = opts
+	return &ReconciliationMatchUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReconciliationMatch.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReconciliationMatchCreateBulk) OnConflictColumns(columns ...string) *ReconciliationMatchUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReconciliationMatchUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReconciliationMatchUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReconciliationMatch nodes.
+type ReconciliationMatchUpsertBulk struct {
+	create *ReconciliationMatchCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReconciliationMatch.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reconciliationmatch.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReconciliationMatchUpsertBulk) UpdateNewValues() *ReconciliationMatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reconciliationmatch.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(reconciliationmatch.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReconciliationMatch.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReconciliationMatchUpsertBulk) Ignore() *ReconciliationMatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReconciliationMatchUpsertBulk) DoNothing() *ReconciliationMatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReconciliationMatchCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReconciliationMatchUpsertBulk) Update(set func(*ReconciliationMatchUpsert)) *ReconciliationMatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReconciliationMatchUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *ReconciliationMatchUpsertBulk) SetTenantID(v uuid.UUID) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateTenantID() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (u *ReconciliationMatchUpsertBulk) SetBankAccountID(v uuid.UUID) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetBankAccountID(v)
+	})
+}
+
+// UpdateBankAccountID sets the "bank_account_id" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateBankAccountID() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateBankAccountID()
+	})
+}
+
+// SetReconciliationID sets the "reconciliation_id" field.
+func (u *ReconciliationMatchUpsertBulk) SetReconciliationID(v uuid.UUID) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetReconciliationID(v)
+	})
+}
+
+// UpdateReconciliationID sets the "reconciliation_id" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateReconciliationID() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateReconciliationID()
+	})
+}
+
+// ClearReconciliationID clears the value of the "reconciliation_id" field.
+func (u *ReconciliationMatchUpsertBulk) ClearReconciliationID() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.ClearReconciliationID()
+	})
+}
+
+// SetRule sets the "rule" field.
+func (u *ReconciliationMatchUpsertBulk) SetRule(v string) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetRule(v)
+	})
+}
+
+// UpdateRule sets the "rule" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateRule() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateRule()
+	})
+}
+
+// SetRuleID sets the "rule_id" field.
+func (u *ReconciliationMatchUpsertBulk) SetRuleID(v uuid.UUID) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetRuleID(v)
+	})
+}
+
+// UpdateRuleID sets the "rule_id" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateRuleID() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateRuleID()
+	})
+}
+
+// ClearRuleID clears the value of the "rule_id" field.
+func (u *ReconciliationMatchUpsertBulk) ClearRuleID() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.ClearRuleID()
+	})
+}
+
+// SetMatchType sets the "match_type" field.
+func (u *ReconciliationMatchUpsertBulk) SetMatchType(v string) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetMatchType(v)
+	})
+}
+
+// UpdateMatchType sets the "match_type" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateMatchType() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateMatchType()
+	})
+}
+
+// SetConfidence sets the "confidence" field.
+func (u *ReconciliationMatchUpsertBulk) SetConfidence(v int) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetConfidence(v)
+	})
+}
+
+// AddConfidence adds v to the "confidence" field.
+func (u *ReconciliationMatchUpsertBulk) AddConfidence(v int) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.AddConfidence(v)
+	})
+}
+
+// UpdateConfidence sets the "confidence" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateConfidence() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateConfidence()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReconciliationMatchUpsertBulk) SetStatus(v string) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateStatus() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetBankAmount sets the "bank_amount" field.
+func (u *ReconciliationMatchUpsertBulk) SetBankAmount(v decimal.Decimal) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetBankAmount(v)
+	})
+}
+
+// AddBankAmount adds v to the "bank_amount" field.
+func (u *ReconciliationMatchUpsertBulk) AddBankAmount(v decimal.Decimal) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.AddBankAmount(v)
+	})
+}
+
+// UpdateBankAmount sets the "bank_amount" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateBankAmount() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateBankAmount()
+	})
+}
+
+// SetBookAmount sets the "book_amount" field.
+func (u *ReconciliationMatchUpsertBulk) SetBookAmount(v decimal.Decimal) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetBookAmount(v)
+	})
+}
+
+// AddBookAmount adds v to the "book_amount" field.
+func (u *ReconciliationMatchUpsertBulk) AddBookAmount(v decimal.Decimal) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.AddBookAmount(v)
+	})
+}
+
+// UpdateBookAmount sets the "book_amount" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateBookAmount() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateBookAmount()
+	})
+}
+
+// SetDifference sets the "difference" field.
+func (u *ReconciliationMatchUpsertBulk) SetDifference(v decimal.Decimal) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetDifference(v)
+	})
+}
+
+// AddDifference adds v to the "difference" field.
+func (u *ReconciliationMatchUpsertBulk) AddDifference(v decimal.Decimal) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.AddDifference(v)
+	})
+}
+
+// UpdateDifference sets the "difference" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateDifference() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateDifference()
+	})
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (u *ReconciliationMatchUpsertBulk) SetFingerprint(v string) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetFingerprint(v)
+	})
+}
+
+// UpdateFingerprint sets the "fingerprint" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateFingerprint() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateFingerprint()
+	})
+}
+
+// SetReasons sets the "reasons" field.
+func (u *ReconciliationMatchUpsertBulk) SetReasons(v []string) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetReasons(v)
+	})
+}
+
+// UpdateReasons sets the "reasons" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateReasons() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateReasons()
+	})
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (u *ReconciliationMatchUpsertBulk) ClearReasons() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.ClearReasons()
+	})
+}
+
+// SetMatchedBy sets the "matched_by" field.
+func (u *ReconciliationMatchUpsertBulk) SetMatchedBy(v uuid.UUID) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetMatchedBy(v)
+	})
+}
+
+// UpdateMatchedBy sets the "matched_by" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateMatchedBy() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateMatchedBy()
+	})
+}
+
+// ClearMatchedBy clears the value of the "matched_by" field.
+func (u *ReconciliationMatchUpsertBulk) ClearMatchedBy() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.ClearMatchedBy()
+	})
+}
+
+// SetMatchedAt sets the "matched_at" field.
+func (u *ReconciliationMatchUpsertBulk) SetMatchedAt(v time.Time) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetMatchedAt(v)
+	})
+}
+
+// UpdateMatchedAt sets the "matched_at" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateMatchedAt() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateMatchedAt()
+	})
+}
+
+// ClearMatchedAt clears the value of the "matched_at" field.
+func (u *ReconciliationMatchUpsertBulk) ClearMatchedAt() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.ClearMatchedAt()
+	})
+}
+
+// SetRejectedBy sets the "rejected_by" field.
+func (u *ReconciliationMatchUpsertBulk) SetRejectedBy(v uuid.UUID) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetRejectedBy(v)
+	})
+}
+
+// UpdateRejectedBy sets the "rejected_by" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateRejectedBy() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateRejectedBy()
+	})
+}
+
+// ClearRejectedBy clears the value of the "rejected_by" field.
+func (u *ReconciliationMatchUpsertBulk) ClearRejectedBy() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.ClearRejectedBy()
+	})
+}
+
+// SetRejectedAt sets the "rejected_at" field.
+func (u *ReconciliationMatchUpsertBulk) SetRejectedAt(v time.Time) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetRejectedAt(v)
+	})
+}
+
+// UpdateRejectedAt sets the "rejected_at" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateRejectedAt() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateRejectedAt()
+	})
+}
+
+// ClearRejectedAt clears the value of the "rejected_at" field.
+func (u *ReconciliationMatchUpsertBulk) ClearRejectedAt() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.ClearRejectedAt()
+	})
+}
+
+// SetUnmatchedBy sets the "unmatched_by" field.
+func (u *ReconciliationMatchUpsertBulk) SetUnmatchedBy(v uuid.UUID) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetUnmatchedBy(v)
+	})
+}
+
+// UpdateUnmatchedBy sets the "unmatched_by" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateUnmatchedBy() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateUnmatchedBy()
+	})
+}
+
+// ClearUnmatchedBy clears the value of the "unmatched_by" field.
+func (u *ReconciliationMatchUpsertBulk) ClearUnmatchedBy() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.ClearUnmatchedBy()
+	})
+}
+
+// SetUnmatchedAt sets the "unmatched_at" field.
+func (u *ReconciliationMatchUpsertBulk) SetUnmatchedAt(v time.Time) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetUnmatchedAt(v)
+	})
+}
+
+// UpdateUnmatchedAt sets the "unmatched_at" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateUnmatchedAt() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateUnmatchedAt()
+	})
+}
+
+// ClearUnmatchedAt clears the value of the "unmatched_at" field.
+func (u *ReconciliationMatchUpsertBulk) ClearUnmatchedAt() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.ClearUnmatchedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReconciliationMatchUpsertBulk) SetUpdatedAt(v time.Time) *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReconciliationMatchUpsertBulk) UpdateUpdatedAt() *ReconciliationMatchUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReconciliationMatchUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReconciliationMatchCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReconciliationMatchCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReconciliationMatchUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatch"
+)
+
+// ReconciliationMatchDelete is the builder for deleting a ReconciliationMatch entity.
+type ReconciliationMatchDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReconciliationMatchMutation
+}
+
+// Where appends a list predicates to the ReconciliationMatchDelete builder.
+func (_d *ReconciliationMatchDelete) Where(ps ...predicate.ReconciliationMatch) *ReconciliationMatchDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ReconciliationMatchDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ReconciliationMatchDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ReconciliationMatchDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(reconciliationmatch.Table, sqlgraph.NewFieldSpec(reconciliationmatch.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ReconciliationMatchDeleteOne is the builder for deleting a single ReconciliationMatch entity.
+type ReconciliationMatchDeleteOne struct {
+	_d *ReconciliationMatchDelete
+}
+
+// Where appends a list predicates to the ReconciliationMatchDelete builder.
+func (_d *ReconciliationMatchDeleteOne) Where(ps ...predicate.ReconciliationMatch) *ReconciliationMatchDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ReconciliationMatchDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{reconciliationmatch.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ReconciliationMatchDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
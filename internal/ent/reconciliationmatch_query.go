@@ -0,0 +1,722 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliation"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatch"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatchitem"
+	"github.com/google/uuid"
+)
+
+// ReconciliationMatchQuery is the builder for querying ReconciliationMatch entities.
+type ReconciliationMatchQuery struct {
+	config
+	ctx                *QueryContext
+	order              []reconciliationmatch.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.ReconciliationMatch
+	withReconciliation *ReconciliationQuery
+	withItems          *ReconciliationMatchItemQuery
+	modifiers          []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReconciliationMatchQuery builder.
+func (_q *ReconciliationMatchQuery) Where(ps ...predicate.ReconciliationMatch) *ReconciliationMatchQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *ReconciliationMatchQuery) Limit(limit int) *ReconciliationMatchQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *ReconciliationMatchQuery) Offset(offset int) *ReconciliationMatchQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *ReconciliationMatchQuery) Unique(unique bool) *ReconciliationMatchQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *ReconciliationMatchQuery) Order(o ...reconciliationmatch.OrderOption) *ReconciliationMatchQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryReconciliation chains the current query on the "reconciliation" edge.
+func (_q *ReconciliationMatchQuery) QueryReconciliation() *ReconciliationQuery {
+	query := (&ReconciliationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reconciliationmatch.Table, reconciliationmatch.FieldID, selector),
+			sqlgraph.To(reconciliation.Table, reconciliation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reconciliationmatch.ReconciliationTable, reconciliationmatch.ReconciliationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryItems chains the current query on the "items" edge.
+func (_q *ReconciliationMatchQuery) QueryItems() *ReconciliationMatchItemQuery {
+	query := (&ReconciliationMatchItemClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reconciliationmatch.Table, reconciliationmatch.FieldID, selector),
+			sqlgraph.To(reconciliationmatchitem.Table, reconciliationmatchitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reconciliationmatch.ItemsTable, reconciliationmatch.ItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ReconciliationMatch entity from the query.
+// Returns a *NotFoundError when no ReconciliationMatch was found.
+func (_q *ReconciliationMatchQuery) First(ctx context.Context) (*ReconciliationMatch, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{reconciliationmatch.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *ReconciliationMatchQuery) FirstX(ctx context.Context) *ReconciliationMatch {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ReconciliationMatch ID from the query.
+// Returns a *NotFoundError when no ReconciliationMatch ID was found.
+func (_q *ReconciliationMatchQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{reconciliationmatch.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *ReconciliationMatchQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ReconciliationMatch entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ReconciliationMatch entity is found.
+// Returns a *NotFoundError when no ReconciliationMatch entities are found.
+func (_q *ReconciliationMatchQuery) Only(ctx context.Context) (*ReconciliationMatch, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{reconciliationmatch.Label}
+	default:
+		return nil, &NotSingularError{reconciliationmatch.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *ReconciliationMatchQuery) OnlyX(ctx context.Context) *ReconciliationMatch {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ReconciliationMatch ID in the query.
+// Returns a *NotSingularError when more than one ReconciliationMatch ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *ReconciliationMatchQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{reconciliationmatch.Label}
+	default:
+		err = &NotSingularError{reconciliationmatch.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *ReconciliationMatchQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ReconciliationMatches.
+func (_q *ReconciliationMatchQuery) All(ctx context.Context) ([]*ReconciliationMatch, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ReconciliationMatch, *ReconciliationMatchQuery]()
+	return withInterceptors[[]*ReconciliationMatch](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *ReconciliationMatchQuery) AllX(ctx context.Context) []*ReconciliationMatch {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ReconciliationMatch IDs.
+func (_q *ReconciliationMatchQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(reconciliationmatch.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *ReconciliationMatchQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *ReconciliationMatchQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*ReconciliationMatchQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *ReconciliationMatchQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *ReconciliationMatchQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *ReconciliationMatchQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReconciliationMatchQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *ReconciliationMatchQuery) Clone() *ReconciliationMatchQuery {
+	if _q == nil {
+		return nil
+	}
+	return &ReconciliationMatchQuery{
+		config:             _q.config,
+		ctx:                _q.ctx.Clone(),
+		order:              append([]reconciliationmatch.OrderOption{}, _q.order...),
+		inters:             append([]Interceptor{}, _q.inters...),
+		predicates:         append([]predicate.ReconciliationMatch{}, _q.predicates...),
+		withReconciliation: _q.withReconciliation.Clone(),
+		withItems:          _q.withItems.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithReconciliation tells the query-builder to eager-load the nodes that are connected to
+// the "reconciliation" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ReconciliationMatchQuery) WithReconciliation(opts ...func(*ReconciliationQuery)) *ReconciliationMatchQuery {
+	query := (&ReconciliationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReconciliation = query
+	return _q
+}
+
+// WithItems tells the query-builder to eager-load the nodes that are connected to
+// the "items" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ReconciliationMatchQuery) WithItems(opts ...func(*ReconciliationMatchItemQuery)) *ReconciliationMatchQuery {
+	query := (&ReconciliationMatchItemClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withItems = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID uuid.UUID `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ReconciliationMatch.Query().
+//		GroupBy(reconciliationmatch.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *ReconciliationMatchQuery) GroupBy(field string, fields ...string) *ReconciliationMatchGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ReconciliationMatchGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = reconciliationmatch.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID uuid.UUID `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.ReconciliationMatch.Query().
+//		Select(reconciliationmatch.FieldTenantID).
+//		Scan(ctx, &v)
+func (_q *ReconciliationMatchQuery) Select(fields ...string) *ReconciliationMatchSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &ReconciliationMatchSelect{ReconciliationMatchQuery: _q}
+	sbuild.label = reconciliationmatch.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ReconciliationMatchSelect configured with the given aggregations.
+func (_q *ReconciliationMatchQuery) Aggregate(fns ...AggregateFunc) *ReconciliationMatchSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *ReconciliationMatchQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !reconciliationmatch.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *ReconciliationMatchQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ReconciliationMatch, error) {
+	var (
+		nodes       = []*ReconciliationMatch{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withReconciliation != nil,
+			_q.withItems != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ReconciliationMatch).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ReconciliationMatch{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withReconciliation; query != nil {
+		if err := _q.loadReconciliation(ctx, query, nodes, nil,
+			func(n *ReconciliationMatch, e *Reconciliation) { n.Edges.Reconciliation = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withItems; query != nil {
+		if err := _q.loadItems(ctx, query, nodes,
+			func(n *ReconciliationMatch) { n.Edges.Items = []*ReconciliationMatchItem{} },
+			func(n *ReconciliationMatch, e *ReconciliationMatchItem) { n.Edges.Items = append(n.Edges.Items, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *ReconciliationMatchQuery) loadReconciliation(ctx context.Context, query *ReconciliationQuery, nodes []*ReconciliationMatch, init func(*ReconciliationMatch), assign func(*ReconciliationMatch, *Reconciliation)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ReconciliationMatch)
+	for i := range nodes {
+		if nodes[i].ReconciliationID == nil {
+			continue
+		}
+		fk := *nodes[i].ReconciliationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(reconciliation.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "reconciliation_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *ReconciliationMatchQuery) loadItems(ctx context.Context, query *ReconciliationMatchItemQuery, nodes []*ReconciliationMatch, init func(*ReconciliationMatch), assign func(*ReconciliationMatch, *ReconciliationMatchItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*ReconciliationMatch)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(reconciliationmatchitem.FieldMatchID)
+	}
+	query.Where(predicate.ReconciliationMatchItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(reconciliationmatch.ItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MatchID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "match_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *ReconciliationMatchQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *ReconciliationMatchQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(reconciliationmatch.Table, reconciliationmatch.Columns, sqlgraph.NewFieldSpec(reconciliationmatch.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reconciliationmatch.FieldID)
+		for i := range fields {
+			if fields[i] != reconciliationmatch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withReconciliation != nil {
+			_spec.Node.AddColumnOnce(reconciliationmatch.FieldReconciliationID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *ReconciliationMatchQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(reconciliationmatch.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = reconciliationmatch.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *ReconciliationMatchQuery) ForUpdate(opts ...sql.LockOption) *ReconciliationMatchQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *ReconciliationMatchQuery) ForShare(opts ...sql.LockOption) *ReconciliationMatchQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// ReconciliationMatchGroupBy is the group-by builder for ReconciliationMatch entities.
+type ReconciliationMatchGroupBy struct {
+	selector
+	build *ReconciliationMatchQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *ReconciliationMatchGroupBy) Aggregate(fns ...AggregateFunc) *ReconciliationMatchGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *ReconciliationMatchGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReconciliationMatchQuery, *ReconciliationMatchGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *ReconciliationMatchGroupBy) sqlScan(ctx context.Context, root *ReconciliationMatchQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ReconciliationMatchSelect is the builder for selecting fields of ReconciliationMatch entities.
+type ReconciliationMatchSelect struct {
+	*ReconciliationMatchQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *ReconciliationMatchSelect) Aggregate(fns ...AggregateFunc) *ReconciliationMatchSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *ReconciliationMatchSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReconciliationMatchQuery, *ReconciliationMatchSelect](ctx, _s.ReconciliationMatchQuery, _s, _s.inters, v)
+}
+
+func (_s *ReconciliationMatchSelect) sqlScan(ctx context.Context, root *ReconciliationMatchQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
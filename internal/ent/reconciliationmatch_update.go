@@ -0,0 +1,1341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliation"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatch"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatchitem"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationMatchUpdate is the builder for updating ReconciliationMatch entities.
+type ReconciliationMatchUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReconciliationMatchMutation
+}
+
+// Where appends a list predicates to the ReconciliationMatchUpdate builder.
+func (_u *ReconciliationMatchUpdate) Where(ps ...predicate.ReconciliationMatch) *ReconciliationMatchUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ReconciliationMatchUpdate) SetTenantID(v uuid.UUID) *ReconciliationMatchUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdate) SetNillableTenantID(v *uuid.UUID) *ReconciliationMatchUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (_u *ReconciliationMatchUpdate) SetBankAccountID(v uuid.UUID) *ReconciliationMatchUpdate {
+	_u.mutation.SetBankAccountID(v)
+	return _u
+}
+
+// SetNillableBankAccountID sets the "bank_account_id" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdate) SetNillableBankAccountID(v *uuid.UUID) *ReconciliationMatchUpdate {
+	if v != nil {
+		_u.SetBankAccountID(*v)
+	}
+	return _u
+}
+
+// SetReconciliationID sets the "reconciliation_id" field.
+func (_u *ReconciliationMatchUpdate) SetReconciliationID(v uuid.UUID) *ReconciliationMatchUpdate {
+	_u.mutation.SetReconciliationID(v)
+	return _u
+}
+
+// SetNillableReconciliationID sets the "reconciliation_id" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdate) SetNillableReconciliationID(v *uuid.UUID) *ReconciliationMatchUpdate {
+	if v != nil {
+		_u.SetReconciliationID(*v)
+	}
+	return _u
+}
+
+// ClearReconciliationID clears the value of the "reconciliation_id" field.
+func (_u *ReconciliationMatchUpdate) ClearReconciliationID() *ReconciliationMatchUpdate {
+	_u.mutation.ClearReconciliationID()
+	return _u
+}
+
+// SetRule sets the "rule" field.
+func (_u *ReconciliationMatchUpdate) SetRule(v string) *ReconciliationMatchUpdate {
+	_u.mutation.SetRule(v)
+	return _u
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdate) SetNillableRule(v *string) *ReconciliationMatchUpdate {
+	if v != nil {
+		_u.SetRule(*v)
+	}
+	return _u
+}
+
+// SetRuleID sets the "rule_id" field.
+func (_u *ReconciliationMatchUpdate) SetRuleID(v uuid.UUID) *ReconciliationMatchUpdate {
+	_u.mutation.SetRuleID(v)
+	return _u
+}
+
+// SetNillableRuleID sets the "rule_id" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdate) SetNillableRuleID(v *uuid.UUID) *ReconciliationMatchUpdate {
+	if v != nil {
+		_u.SetRuleID(*v)
+	}
+	return _u
+}
+
+// ClearRuleID clears the value of the "rule_id" field.
+func (_u *ReconciliationMatchUpdate) ClearRuleID() *ReconciliationMatchUpdate {
+	_u.mutation.ClearRuleID()
+	return _u
+}
+
+// SetMatchType sets the "match_type" field.
+func (_u *ReconciliationMatchUpdate) SetMatchType(v string) *ReconciliationMatchUpdate {
+	_u.mutation.SetMatchType(v)
+	return _u
+}
+
+// SetNillableMatchType sets the "match_type" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdate) SetNillableMatchType(v *string) *ReconciliationMatchUpdate {
+	if v != nil {
+		_u.SetMatchType(*v)
+	}
+	return _u
+}
+
+// SetConfidence sets the "confidence" field.
+func (_u *ReconciliationMatchUpdate) SetConfidence(v int) *ReconciliationMatchUpdate {
+	_u.mutation.ResetConfidence()
+	_u.mutation.SetConfidence(v)
+	return _u
+}
+
+// SetNillableConfidence sets the "confidence" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdate) SetNillableConfidence(v *int) *ReconciliationMatchUpdate {
+	if v != nil {
+		_u.SetConfidence(*v)
+	}
+	return _u
+}
+
+// AddConfidence adds value to the "confidence" field.
+func (_u *ReconciliationMatchUpdate) AddConfidence(v int) *ReconciliationMatchUpdate {
+	_u.mutation.AddConfidence(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReconciliationMatchUpdate) SetStatus(v string) *ReconciliationMatchUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdate) SetNillableStatus(v *string) *ReconciliationMatchUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetBankAmount sets the "bank_amount" field.
+func (_u *ReconciliationMatchUpdate) SetBankAmount(v decimal.Decimal) *ReconciliationMatchUpdate {
+	_u.mutation.ResetBankAmount()
+	_u.mutation.SetBankAmount(v)
+	return _u
+}
+
+// SetNillableBankAmount sets the "bank_amount" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdate) SetNillableBankAmount(v *decimal.Decimal) *ReconciliationMatchUpdate {
+	if v != nil {
+		_u.SetBankAmount(*v)
+	}
+	return _u
+}
+
+// AddBankAmount adds value to the "bank_amount" field.
+func (_u *ReconciliationMatchUpdate) AddBankAmount(v decimal.Decimal) *ReconciliationMatchUpdate {
+	_u.mutation.AddBankAmount(v)
+	return _u
+}
+
+// SetBookAmount sets the "book_amount" field.
+func (_u *ReconciliationMatchUpdate) SetBookAmount(v decimal.Decimal) *ReconciliationMatchUpdate {
+	_u.mutation.ResetBookAmount()
+	_u.mutation.SetBookAmount(v)
+	return _u
+}
+
+// SetNillableBookAmount sets the "book_amount" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdate) SetNillableBookAmount(v *decimal.Decimal) *ReconciliationMatchUpdate {
+	if v != nil {
+		_u.SetBookAmount(*v)
+	}
+	return _u
+}
+
+// AddBookAmount adds value to the "book_amount" field.
+func (_u *ReconciliationMatchUpdate) AddBookAmount(v decimal.Decimal) *ReconciliationMatchUpdate {
+	_u.mutation.AddBookAmount(v)
+	return _u
+}
+
+// SetDifference sets the "difference" field.
+func (_u *ReconciliationMatchUpdate) SetDifference(v decimal.Decimal) *ReconciliationMatchUpdate {
+	_u.mutation.ResetDifference()
+	_u.mutation.SetDifference(v)
+	return _u
+}
+
+// SetNillableDifference sets the "difference" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdate) SetNillableDifference(v *decimal.Decimal) *ReconciliationMatchUpdate {
+	if v != nil {
+		_u.SetDifference(*v)
+	}
+	return _u
+}
+
+// AddDifference adds value to the "difference" field.
+func (_u *ReconciliationMatchUpdate) AddDifference(v decimal.Decimal) *ReconciliationMatchUpdate {
+	_u.mutation.AddDifference(v)
+	return _u
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (_u *ReconciliationMatchUpdate) SetFingerprint(v string) *ReconciliationMatchUpdate {
+	_u.mutation.SetFingerprint(v)
+	return _u
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdate) SetNillableFingerprint(v *string) *ReconciliationMatchUpdate {
+	if v != nil {
+		_u.SetFingerprint(*v)
+	}
+	return _u
+}
+
+// SetReasons sets the "reasons" field.
+func (_u *ReconciliationMatchUpdate) SetReasons(v []string) *ReconciliationMatchUpdate {
+	_u.mutation.SetReasons(v)
+	return _u
+}
+
+// AppendReasons appends value to the "reasons" field.
+func (_u *ReconciliationMatchUpdate) AppendReasons(v []string) *ReconciliationMatchUpdate {
+	_u.mutation.AppendReasons(v)
+	return _u
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (_u *ReconciliationMatchUpdate) ClearReasons() *ReconciliationMatchUpdate {
+	_u.mutation.ClearReasons()
+	return _u
+}
+
+// SetMatchedBy sets the "matched_by" field.
+func (_u *ReconciliationMatchUpdate) SetMatchedBy(v uuid.UUID) *ReconciliationMatchUpdate {
+	_u.mutation.SetMatchedBy(v)
+	return _u
+}
+
+// SetNillableMatchedBy sets the "matched_by" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdate) SetNillableMatchedBy(v *uuid.UUID) *ReconciliationMatchUpdate {
+	if v != nil {
+		_u.SetMatchedBy(*v)
+	}
+	return _u
+}
+
+// ClearMatchedBy clears the value of the "matched_by" field.
+func (_u *ReconciliationMatchUpdate) ClearMatchedBy() *ReconciliationMatchUpdate {
+	_u.mutation.ClearMatchedBy()
+	return _u
+}
+
+// SetMatchedAt sets the "matched_at" field.
+func (_u *ReconciliationMatchUpdate) SetMatchedAt(v time.Time) *ReconciliationMatchUpdate {
+	_u.mutation.SetMatchedAt(v)
+	return _u
+}
+
+// SetNillableMatchedAt sets the "matched_at" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdate) SetNillableMatchedAt(v *time.Time) *ReconciliationMatchUpdate {
+	if v != nil {
+		_u.SetMatchedAt(*v)
+	}
+	return _u
+}
+
+// ClearMatchedAt clears the value of the "matched_at" field.
+func (_u *ReconciliationMatchUpdate) ClearMatchedAt() *ReconciliationMatchUpdate {
+	_u.mutation.ClearMatchedAt()
+	return _u
+}
+
+// SetRejectedBy sets the "rejected_by" field.
+func (_u *ReconciliationMatchUpdate) SetRejectedBy(v uuid.UUID) *ReconciliationMatchUpdate {
+	_u.mutation.SetRejectedBy(v)
+	return _u
+}
+
+// SetNillableRejectedBy sets the "rejected_by" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdate) SetNillableRejectedBy(v *uuid.UUID) *ReconciliationMatchUpdate {
+	if v != nil {
+		_u.SetRejectedBy(*v)
+	}
+	return _u
+}
+
+// ClearRejectedBy clears the value of the "rejected_by" field.
+func (_u *ReconciliationMatchUpdate) ClearRejectedBy() *ReconciliationMatchUpdate {
+	_u.mutation.ClearRejectedBy()
+	return _u
+}
+
+// SetRejectedAt sets the "rejected_at" field.
+func (_u *ReconciliationMatchUpdate) SetRejectedAt(v time.Time) *ReconciliationMatchUpdate {
+	_u.mutation.SetRejectedAt(v)
+	return _u
+}
+
+// SetNillableRejectedAt sets the "rejected_at" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdate) SetNillableRejectedAt(v *time.Time) *ReconciliationMatchUpdate {
+	if v != nil {
+		_u.SetRejectedAt(*v)
+	}
+	return _u
+}
+
+// ClearRejectedAt clears the value of the "rejected_at" field.
+func (_u *ReconciliationMatchUpdate) ClearRejectedAt() *ReconciliationMatchUpdate {
+	_u.mutation.ClearRejectedAt()
+	return _u
+}
+
+// SetUnmatchedBy sets the "unmatched_by" field.
+func (_u *ReconciliationMatchUpdate) SetUnmatchedBy(v uuid.UUID) *ReconciliationMatchUpdate {
+	_u.mutation.SetUnmatchedBy(v)
+	return _u
+}
+
+// SetNillableUnmatchedBy sets the "unmatched_by" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdate) SetNillableUnmatchedBy(v *uuid.UUID) *ReconciliationMatchUpdate {
+	if v != nil {
+		_u.SetUnmatchedBy(*v)
+	}
+	return _u
+}
+
+// ClearUnmatchedBy clears the value of the "unmatched_by" field.
+func (_u *ReconciliationMatchUpdate) ClearUnmatchedBy() *ReconciliationMatchUpdate {
+	_u.mutation.ClearUnmatchedBy()
+	return _u
+}
+
+// SetUnmatchedAt sets the "unmatched_at" field.
+func (_u *ReconciliationMatchUpdate) SetUnmatchedAt(v time.Time) *ReconciliationMatchUpdate {
+	_u.mutation.SetUnmatchedAt(v)
+	return _u
+}
+
+// SetNillableUnmatchedAt sets the "unmatched_at" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdate) SetNillableUnmatchedAt(v *time.Time) *ReconciliationMatchUpdate {
+	if v != nil {
+		_u.SetUnmatchedAt(*v)
+	}
+	return _u
+}
+
+// ClearUnmatchedAt clears the value of the "unmatched_at" field.
+func (_u *ReconciliationMatchUpdate) ClearUnmatchedAt() *ReconciliationMatchUpdate {
+	_u.mutation.ClearUnmatchedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReconciliationMatchUpdate) SetUpdatedAt(v time.Time) *ReconciliationMatchUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetReconciliation sets the "reconciliation" edge to the Reconciliation entity.
+func (_u *ReconciliationMatchUpdate) SetReconciliation(v *Reconciliation) *ReconciliationMatchUpdate {
+	return _u.SetReconciliationID(v.ID)
+}
+
+// AddItemIDs adds the "items" edge to the ReconciliationMatchItem entity by IDs.
+func (_u *ReconciliationMatchUpdate) AddItemIDs(ids ...uuid.UUID) *ReconciliationMatchUpdate {
+	_u.mutation.AddItemIDs(ids...)
+	return _u
+}
+
+// AddItems adds the "items" edges to the ReconciliationMatchItem entity.
+func (_u *ReconciliationMatchUpdate) AddItems(v ...*ReconciliationMatchItem) *ReconciliationMatchUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemIDs(ids...)
+}
+
+// Mutation returns the ReconciliationMatchMutation object of the builder.
+func (_u *ReconciliationMatchUpdate) Mutation() *ReconciliationMatchMutation {
+	return _u.mutation
+}
+
+// ClearReconciliation clears the "reconciliation" edge to the Reconciliation entity.
+func (_u *ReconciliationMatchUpdate) ClearReconciliation() *ReconciliationMatchUpdate {
+	_u.mutation.ClearReconciliation()
+	return _u
+}
+
+// ClearItems clears all "items" edges to the ReconciliationMatchItem entity.
+func (_u *ReconciliationMatchUpdate) ClearItems() *ReconciliationMatchUpdate {
+	_u.mutation.ClearItems()
+	return _u
+}
+
+// RemoveItemIDs removes the "items" edge to ReconciliationMatchItem entities by IDs.
+func (_u *ReconciliationMatchUpdate) RemoveItemIDs(ids ...uuid.UUID) *ReconciliationMatchUpdate {
+	_u.mutation.RemoveItemIDs(ids...)
+	return _u
+}
+
+// RemoveItems removes "items" edges to ReconciliationMatchItem entities.
+func (_u *ReconciliationMatchUpdate) RemoveItems(v ...*ReconciliationMatchItem) *ReconciliationMatchUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReconciliationMatchUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReconciliationMatchUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReconciliationMatchUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReconciliationMatchUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReconciliationMatchUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := reconciliationmatch.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ReconciliationMatchUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(reconciliationmatch.Table, reconciliationmatch.Columns, sqlgraph.NewFieldSpec(reconciliationmatch.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(reconciliationmatch.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.BankAccountID(); ok {
+		_spec.SetField(reconciliationmatch.FieldBankAccountID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Rule(); ok {
+		_spec.SetField(reconciliationmatch.FieldRule, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RuleID(); ok {
+		_spec.SetField(reconciliationmatch.FieldRuleID, field.TypeUUID, value)
+	}
+	if _u.mutation.RuleIDCleared() {
+		_spec.ClearField(reconciliationmatch.FieldRuleID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.MatchType(); ok {
+		_spec.SetField(reconciliationmatch.FieldMatchType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Confidence(); ok {
+		_spec.SetField(reconciliationmatch.FieldConfidence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConfidence(); ok {
+		_spec.AddField(reconciliationmatch.FieldConfidence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(reconciliationmatch.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BankAmount(); ok {
+		_spec.SetField(reconciliationmatch.FieldBankAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBankAmount(); ok {
+		_spec.AddField(reconciliationmatch.FieldBankAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.BookAmount(); ok {
+		_spec.SetField(reconciliationmatch.FieldBookAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBookAmount(); ok {
+		_spec.AddField(reconciliationmatch.FieldBookAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Difference(); ok {
+		_spec.SetField(reconciliationmatch.FieldDifference, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDifference(); ok {
+		_spec.AddField(reconciliationmatch.FieldDifference, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Fingerprint(); ok {
+		_spec.SetField(reconciliationmatch.FieldFingerprint, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reasons(); ok {
+		_spec.SetField(reconciliationmatch.FieldReasons, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedReasons(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reconciliationmatch.FieldReasons, value)
+		})
+	}
+	if _u.mutation.ReasonsCleared() {
+		_spec.ClearField(reconciliationmatch.FieldReasons, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MatchedBy(); ok {
+		_spec.SetField(reconciliationmatch.FieldMatchedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.MatchedByCleared() {
+		_spec.ClearField(reconciliationmatch.FieldMatchedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.MatchedAt(); ok {
+		_spec.SetField(reconciliationmatch.FieldMatchedAt, field.TypeTime, value)
+	}
+	if _u.mutation.MatchedAtCleared() {
+		_spec.ClearField(reconciliationmatch.FieldMatchedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RejectedBy(); ok {
+		_spec.SetField(reconciliationmatch.FieldRejectedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.RejectedByCleared() {
+		_spec.ClearField(reconciliationmatch.FieldRejectedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.RejectedAt(); ok {
+		_spec.SetField(reconciliationmatch.FieldRejectedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RejectedAtCleared() {
+		_spec.ClearField(reconciliationmatch.FieldRejectedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UnmatchedBy(); ok {
+		_spec.SetField(reconciliationmatch.FieldUnmatchedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.UnmatchedByCleared() {
+		_spec.ClearField(reconciliationmatch.FieldUnmatchedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UnmatchedAt(); ok {
+		_spec.SetField(reconciliationmatch.FieldUnmatchedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UnmatchedAtCleared() {
+		_spec.ClearField(reconciliationmatch.FieldUnmatchedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(reconciliationmatch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReconciliationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationmatch.ReconciliationTable,
+			Columns: []string{reconciliationmatch.ReconciliationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReconciliationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationmatch.ReconciliationTable,
+			Columns: []string{reconciliationmatch.ReconciliationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliationmatch.ItemsTable,
+			Columns: []string{reconciliationmatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatchitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemsIDs(); len(nodes) > 0 && !_u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliationmatch.ItemsTable,
+			Columns: []string{reconciliationmatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatchitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliationmatch.ItemsTable,
+			Columns: []string{reconciliationmatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatchitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reconciliationmatch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReconciliationMatchUpdateOne is the builder for updating a single ReconciliationMatch entity.
+type ReconciliationMatchUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReconciliationMatchMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ReconciliationMatchUpdateOne) SetTenantID(v uuid.UUID) *ReconciliationMatchUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdateOne) SetNillableTenantID(v *uuid.UUID) *ReconciliationMatchUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (_u *ReconciliationMatchUpdateOne) SetBankAccountID(v uuid.UUID) *ReconciliationMatchUpdateOne {
+	_u.mutation.SetBankAccountID(v)
+	return _u
+}
+
+// SetNillableBankAccountID sets the "bank_account_id" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdateOne) SetNillableBankAccountID(v *uuid.UUID) *ReconciliationMatchUpdateOne {
+	if v != nil {
+		_u.SetBankAccountID(*v)
+	}
+	return _u
+}
+
+// SetReconciliationID sets the "reconciliation_id" field.
+func (_u *ReconciliationMatchUpdateOne) SetReconciliationID(v uuid.UUID) *ReconciliationMatchUpdateOne {
+	_u.mutation.SetReconciliationID(v)
+	return _u
+}
+
+// SetNillableReconciliationID sets the "reconciliation_id" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdateOne) SetNillableReconciliationID(v *uuid.UUID) *ReconciliationMatchUpdateOne {
+	if v != nil {
+		_u.SetReconciliationID(*v)
+	}
+	return _u
+}
+
+// ClearReconciliationID clears the value of the "reconciliation_id" field.
+func (_u *ReconciliationMatchUpdateOne) ClearReconciliationID() *ReconciliationMatchUpdateOne {
+	_u.mutation.ClearReconciliationID()
+	return _u
+}
+
+// SetRule sets the "rule" field.
+func (_u *ReconciliationMatchUpdateOne) SetRule(v string) *ReconciliationMatchUpdateOne {
+	_u.mutation.SetRule(v)
+	return _u
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdateOne) SetNillableRule(v *string) *ReconciliationMatchUpdateOne {
+	if v != nil {
+		_u.SetRule(*v)
+	}
+	return _u
+}
+
+// SetRuleID sets the "rule_id" field.
+func (_u *ReconciliationMatchUpdateOne) SetRuleID(v uuid.UUID) *ReconciliationMatchUpdateOne {
+	_u.mutation.SetRuleID(v)
+	return _u
+}
+
+// SetNillableRuleID sets the "rule_id" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdateOne) SetNillableRuleID(v *uuid.UUID) *ReconciliationMatchUpdateOne {
+	if v != nil {
+		_u.SetRuleID(*v)
+	}
+	return _u
+}
+
+// ClearRuleID clears the value of the "rule_id" field.
+func (_u *ReconciliationMatchUpdateOne) ClearRuleID() *ReconciliationMatchUpdateOne {
+	_u.mutation.ClearRuleID()
+	return _u
+}
+
+// SetMatchType sets the "match_type" field.
+func (_u *ReconciliationMatchUpdateOne) SetMatchType(v string) *ReconciliationMatchUpdateOne {
+	_u.mutation.SetMatchType(v)
+	return _u
+}
+
+// SetNillableMatchType sets the "match_type" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdateOne) SetNillableMatchType(v *string) *ReconciliationMatchUpdateOne {
+	if v != nil {
+		_u.SetMatchType(*v)
+	}
+	return _u
+}
+
+// SetConfidence sets the "confidence" field.
+func (_u *ReconciliationMatchUpdateOne) SetConfidence(v int) *ReconciliationMatchUpdateOne {
+	_u.mutation.ResetConfidence()
+	_u.mutation.SetConfidence(v)
+	return _u
+}
+
+// SetNillableConfidence sets the "confidence" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdateOne) SetNillableConfidence(v *int) *ReconciliationMatchUpdateOne {
+	if v != nil {
+		_u.SetConfidence(*v)
+	}
+	return _u
+}
+
+// AddConfidence adds value to the "confidence" field.
+func (_u *ReconciliationMatchUpdateOne) AddConfidence(v int) *ReconciliationMatchUpdateOne {
+	_u.mutation.AddConfidence(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReconciliationMatchUpdateOne) SetStatus(v string) *ReconciliationMatchUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdateOne) SetNillableStatus(v *string) *ReconciliationMatchUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetBankAmount sets the "bank_amount" field.
+func (_u *ReconciliationMatchUpdateOne) SetBankAmount(v decimal.Decimal) *ReconciliationMatchUpdateOne {
+	_u.mutation.ResetBankAmount()
+	_u.mutation.SetBankAmount(v)
+	return _u
+}
+
+// SetNillableBankAmount sets the "bank_amount" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdateOne) SetNillableBankAmount(v *decimal.Decimal) *ReconciliationMatchUpdateOne {
+	if v != nil {
+		_u.SetBankAmount(*v)
+	}
+	return _u
+}
+
+// AddBankAmount adds value to the "bank_amount" field.
+func (_u *ReconciliationMatchUpdateOne) AddBankAmount(v decimal.Decimal) *ReconciliationMatchUpdateOne {
+	_u.mutation.AddBankAmount(v)
+	return _u
+}
+
+// SetBookAmount sets the "book_amount" field.
+func (_u *ReconciliationMatchUpdateOne) SetBookAmount(v decimal.Decimal) *ReconciliationMatchUpdateOne {
+	_u.mutation.ResetBookAmount()
+	_u.mutation.SetBookAmount(v)
+	return _u
+}
+
+// SetNillableBookAmount sets the "book_amount" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdateOne) SetNillableBookAmount(v *decimal.Decimal) *ReconciliationMatchUpdateOne {
+	if v != nil {
+		_u.SetBookAmount(*v)
+	}
+	return _u
+}
+
+// AddBookAmount adds value to the "book_amount" field.
+func (_u *ReconciliationMatchUpdateOne) AddBookAmount(v decimal.Decimal) *ReconciliationMatchUpdateOne {
+	_u.mutation.AddBookAmount(v)
+	return _u
+}
+
+// SetDifference sets the "difference" field.
+func (_u *ReconciliationMatchUpdateOne) SetDifference(v decimal.Decimal) *ReconciliationMatchUpdateOne {
+	_u.mutation.ResetDifference()
+	_u.mutation.SetDifference(v)
+	return _u
+}
+
+// SetNillableDifference sets the "difference" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdateOne) SetNillableDifference(v *decimal.Decimal) *ReconciliationMatchUpdateOne {
+	if v != nil {
+		_u.SetDifference(*v)
+	}
+	return _u
+}
+
+// AddDifference adds value to the "difference" field.
+func (_u *ReconciliationMatchUpdateOne) AddDifference(v decimal.Decimal) *ReconciliationMatchUpdateOne {
+	_u.mutation.AddDifference(v)
+	return _u
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (_u *ReconciliationMatchUpdateOne) SetFingerprint(v string) *ReconciliationMatchUpdateOne {
+	_u.mutation.SetFingerprint(v)
+	return _u
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdateOne) SetNillableFingerprint(v *string) *ReconciliationMatchUpdateOne {
+	if v != nil {
+		_u.SetFingerprint(*v)
+	}
+	return _u
+}
+
+// SetReasons sets the "reasons" field.
+func (_u *ReconciliationMatchUpdateOne) SetReasons(v []string) *ReconciliationMatchUpdateOne {
+	_u.mutation.SetReasons(v)
+	return _u
+}
+
+// AppendReasons appends value to the "reasons" field.
+func (_u *ReconciliationMatchUpdateOne) AppendReasons(v []string) *ReconciliationMatchUpdateOne {
+	_u.mutation.AppendReasons(v)
+	return _u
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (_u *ReconciliationMatchUpdateOne) ClearReasons() *ReconciliationMatchUpdateOne {
+	_u.mutation.ClearReasons()
+	return _u
+}
+
+// SetMatchedBy sets the "matched_by" field.
+func (_u *ReconciliationMatchUpdateOne) SetMatchedBy(v uuid.UUID) *ReconciliationMatchUpdateOne {
+	_u.mutation.SetMatchedBy(v)
+	return _u
+}
+
+// SetNillableMatchedBy sets the "matched_by" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdateOne) SetNillableMatchedBy(v *uuid.UUID) *ReconciliationMatchUpdateOne {
+	if v != nil {
+		_u.SetMatchedBy(*v)
+	}
+	return _u
+}
+
+// ClearMatchedBy clears the value of the "matched_by" field.
+func (_u *ReconciliationMatchUpdateOne) ClearMatchedBy() *ReconciliationMatchUpdateOne {
+	_u.mutation.ClearMatchedBy()
+	return _u
+}
+
+// SetMatchedAt sets the "matched_at" field.
+func (_u *ReconciliationMatchUpdateOne) SetMatchedAt(v time.Time) *ReconciliationMatchUpdateOne {
+	_u.mutation.SetMatchedAt(v)
+	return _u
+}
+
+// SetNillableMatchedAt sets the "matched_at" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdateOne) SetNillableMatchedAt(v *time.Time) *ReconciliationMatchUpdateOne {
+	if v != nil {
+		_u.SetMatchedAt(*v)
+	}
+	return _u
+}
+
+// ClearMatchedAt clears the value of the "matched_at" field.
+func (_u *ReconciliationMatchUpdateOne) ClearMatchedAt() *ReconciliationMatchUpdateOne {
+	_u.mutation.ClearMatchedAt()
+	return _u
+}
+
+// SetRejectedBy sets the "rejected_by" field.
+func (_u *ReconciliationMatchUpdateOne) SetRejectedBy(v uuid.UUID) *ReconciliationMatchUpdateOne {
+	_u.mutation.SetRejectedBy(v)
+	return _u
+}
+
+// SetNillableRejectedBy sets the "rejected_by" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdateOne) SetNillableRejectedBy(v *uuid.UUID) *ReconciliationMatchUpdateOne {
+	if v != nil {
+		_u.SetRejectedBy(*v)
+	}
+	return _u
+}
+
+// ClearRejectedBy clears the value of the "rejected_by" field.
+func (_u *ReconciliationMatchUpdateOne) ClearRejectedBy() *ReconciliationMatchUpdateOne {
+	_u.mutation.ClearRejectedBy()
+	return _u
+}
+
+// SetRejectedAt sets the "rejected_at" field.
+func (_u *ReconciliationMatchUpdateOne) SetRejectedAt(v time.Time) *ReconciliationMatchUpdateOne {
+	_u.mutation.SetRejectedAt(v)
+	return _u
+}
+
+// SetNillableRejectedAt sets the "rejected_at" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdateOne) SetNillableRejectedAt(v *time.Time) *ReconciliationMatchUpdateOne {
+	if v != nil {
+		_u.SetRejectedAt(*v)
+	}
+	return _u
+}
+
+// ClearRejectedAt clears the value of the "rejected_at" field.
+func (_u *ReconciliationMatchUpdateOne) ClearRejectedAt() *ReconciliationMatchUpdateOne {
+	_u.mutation.ClearRejectedAt()
+	return _u
+}
+
+// SetUnmatchedBy sets the "unmatched_by" field.
+func (_u *ReconciliationMatchUpdateOne) SetUnmatchedBy(v uuid.UUID) *ReconciliationMatchUpdateOne {
+	_u.mutation.SetUnmatchedBy(v)
+	return _u
+}
+
+// SetNillableUnmatchedBy sets the "unmatched_by" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdateOne) SetNillableUnmatchedBy(v *uuid.UUID) *ReconciliationMatchUpdateOne {
+	if v != nil {
+		_u.SetUnmatchedBy(*v)
+	}
+	return _u
+}
+
+// ClearUnmatchedBy clears the value of the "unmatched_by" field.
+func (_u *ReconciliationMatchUpdateOne) ClearUnmatchedBy() *ReconciliationMatchUpdateOne {
+	_u.mutation.ClearUnmatchedBy()
+	return _u
+}
+
+// SetUnmatchedAt sets the "unmatched_at" field.
+func (_u *ReconciliationMatchUpdateOne) SetUnmatchedAt(v time.Time) *ReconciliationMatchUpdateOne {
+	_u.mutation.SetUnmatchedAt(v)
+	return _u
+}
+
+// SetNillableUnmatchedAt sets the "unmatched_at" field if the given value is not nil.
+func (_u *ReconciliationMatchUpdateOne) SetNillableUnmatchedAt(v *time.Time) *ReconciliationMatchUpdateOne {
+	if v != nil {
+		_u.SetUnmatchedAt(*v)
+	}
+	return _u
+}
+
+// ClearUnmatchedAt clears the value of the "unmatched_at" field.
+func (_u *ReconciliationMatchUpdateOne) ClearUnmatchedAt() *ReconciliationMatchUpdateOne {
+	_u.mutation.ClearUnmatchedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReconciliationMatchUpdateOne) SetUpdatedAt(v time.Time) *ReconciliationMatchUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetReconciliation sets the "reconciliation" edge to the Reconciliation entity.
+func (_u *ReconciliationMatchUpdateOne) SetReconciliation(v *Reconciliation) *ReconciliationMatchUpdateOne {
+	return _u.SetReconciliationID(v.ID)
+}
+
+// AddItemIDs adds the "items" edge to the ReconciliationMatchItem entity by IDs.
+func (_u *ReconciliationMatchUpdateOne) AddItemIDs(ids ...uuid.UUID) *ReconciliationMatchUpdateOne {
+	_u.mutation.AddItemIDs(ids...)
+	return _u
+}
+
+// AddItems adds the "items" edges to the ReconciliationMatchItem entity.
+func (_u *ReconciliationMatchUpdateOne) AddItems(v ...*ReconciliationMatchItem) *ReconciliationMatchUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemIDs(ids...)
+}
+
+// Mutation returns the ReconciliationMatchMutation object of the builder.
+func (_u *ReconciliationMatchUpdateOne) Mutation() *ReconciliationMatchMutation {
+	return _u.mutation
+}
+
+// ClearReconciliation clears the "reconciliation" edge to the Reconciliation entity.
+func (_u *ReconciliationMatchUpdateOne) ClearReconciliation() *ReconciliationMatchUpdateOne {
+	_u.mutation.ClearReconciliation()
+	return _u
+}
+
+// ClearItems clears all "items" edges to the ReconciliationMatchItem entity.
+func (_u *ReconciliationMatchUpdateOne) ClearItems() *ReconciliationMatchUpdateOne {
+	_u.mutation.ClearItems()
+	return _u
+}
+
+// RemoveItemIDs removes the "items" edge to ReconciliationMatchItem entities by IDs.
+func (_u *ReconciliationMatchUpdateOne) RemoveItemIDs(ids ...uuid.UUID) *ReconciliationMatchUpdateOne {
+	_u.mutation.RemoveItemIDs(ids...)
+	return _u
+}
+
+// RemoveItems removes "items" edges to ReconciliationMatchItem entities.
+func (_u *ReconciliationMatchUpdateOne) RemoveItems(v ...*ReconciliationMatchItem) *ReconciliationMatchUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemIDs(ids...)
+}
+
+// Where appends a list predicates to the ReconciliationMatchUpdate builder.
+func (_u *ReconciliationMatchUpdateOne) Where(ps ...predicate.ReconciliationMatch) *ReconciliationMatchUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReconciliationMatchUpdateOne) Select(field string, fields ...string) *ReconciliationMatchUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReconciliationMatch entity.
+func (_u *ReconciliationMatchUpdateOne) Save(ctx context.Context) (*ReconciliationMatch, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReconciliationMatchUpdateOne) SaveX(ctx context.Context) *ReconciliationMatch {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReconciliationMatchUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReconciliationMatchUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReconciliationMatchUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := reconciliationmatch.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ReconciliationMatchUpdateOne) sqlSave(ctx context.Context) (_node *ReconciliationMatch, err error) {
+	_spec := sqlgraph.NewUpdateSpec(reconciliationmatch.Table, reconciliationmatch.Columns, sqlgraph.NewFieldSpec(reconciliationmatch.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReconciliationMatch.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reconciliationmatch.FieldID)
+		for _, f := range fields {
+			if !reconciliationmatch.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reconciliationmatch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(reconciliationmatch.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.BankAccountID(); ok {
+		_spec.SetField(reconciliationmatch.FieldBankAccountID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Rule(); ok {
+		_spec.SetField(reconciliationmatch.FieldRule, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RuleID(); ok {
+		_spec.SetField(reconciliationmatch.FieldRuleID, field.TypeUUID, value)
+	}
+	if _u.mutation.RuleIDCleared() {
+		_spec.ClearField(reconciliationmatch.FieldRuleID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.MatchType(); ok {
+		_spec.SetField(reconciliationmatch.FieldMatchType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Confidence(); ok {
+		_spec.SetField(reconciliationmatch.FieldConfidence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConfidence(); ok {
+		_spec.AddField(reconciliationmatch.FieldConfidence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(reconciliationmatch.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BankAmount(); ok {
+		_spec.SetField(reconciliationmatch.FieldBankAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBankAmount(); ok {
+		_spec.AddField(reconciliationmatch.FieldBankAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.BookAmount(); ok {
+		_spec.SetField(reconciliationmatch.FieldBookAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBookAmount(); ok {
+		_spec.AddField(reconciliationmatch.FieldBookAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Difference(); ok {
+		_spec.SetField(reconciliationmatch.FieldDifference, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDifference(); ok {
+		_spec.AddField(reconciliationmatch.FieldDifference, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Fingerprint(); ok {
+		_spec.SetField(reconciliationmatch.FieldFingerprint, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reasons(); ok {
+		_spec.SetField(reconciliationmatch.FieldReasons, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedReasons(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reconciliationmatch.FieldReasons, value)
+		})
+	}
+	if _u.mutation.ReasonsCleared() {
+		_spec.ClearField(reconciliationmatch.FieldReasons, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MatchedBy(); ok {
+		_spec.SetField(reconciliationmatch.FieldMatchedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.MatchedByCleared() {
+		_spec.ClearField(reconciliationmatch.FieldMatchedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.MatchedAt(); ok {
+		_spec.SetField(reconciliationmatch.FieldMatchedAt, field.TypeTime, value)
+	}
+	if _u.mutation.MatchedAtCleared() {
+		_spec.ClearField(reconciliationmatch.FieldMatchedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RejectedBy(); ok {
+		_spec.SetField(reconciliationmatch.FieldRejectedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.RejectedByCleared() {
+		_spec.ClearField(reconciliationmatch.FieldRejectedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.RejectedAt(); ok {
+		_spec.SetField(reconciliationmatch.FieldRejectedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RejectedAtCleared() {
+		_spec.ClearField(reconciliationmatch.FieldRejectedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UnmatchedBy(); ok {
+		_spec.SetField(reconciliationmatch.FieldUnmatchedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.UnmatchedByCleared() {
+		_spec.ClearField(reconciliationmatch.FieldUnmatchedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UnmatchedAt(); ok {
+		_spec.SetField(reconciliationmatch.FieldUnmatchedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UnmatchedAtCleared() {
+		_spec.ClearField(reconciliationmatch.FieldUnmatchedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(reconciliationmatch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReconciliationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationmatch.ReconciliationTable,
+			Columns: []string{reconciliationmatch.ReconciliationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReconciliationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationmatch.ReconciliationTable,
+			Columns: []string{reconciliationmatch.ReconciliationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliationmatch.ItemsTable,
+			Columns: []string{reconciliationmatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatchitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemsIDs(); len(nodes) > 0 && !_u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliationmatch.ItemsTable,
+			Columns: []string{reconciliationmatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatchitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reconciliationmatch.ItemsTable,
+			Columns: []string{reconciliationmatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatchitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReconciliationMatch{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reconciliationmatch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatch"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatchitem"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationMatchItem is the model entity for the ReconciliationMatchItem schema.
+type ReconciliationMatchItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Reconciliation match identifier
+	MatchID uuid.UUID `json:"match_id,omitempty"`
+	// Item type: bank, ledger, payment
+	ItemType string `json:"item_type,omitempty"`
+	// Bank transaction, ledger transaction or payment transaction identifier
+	ItemID uuid.UUID `json:"item_id,omitempty"`
+	// Signed amount: positive for money in, negative for money out
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// TransactionDate holds the value of the "transaction_date" field.
+	TransactionDate time.Time `json:"transaction_date,omitempty"`
+	// Reference holds the value of the "reference" field.
+	Reference string `json:"reference,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Status: suggested, matched, released
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReconciliationMatchItemQuery when eager-loading is set.
+	Edges        ReconciliationMatchItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReconciliationMatchItemEdges holds the relations/edges for other nodes in the graph.
+type ReconciliationMatchItemEdges struct {
+	// Match holds the value of the match edge.
+	Match *ReconciliationMatch `json:"match,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MatchOrErr returns the Match value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReconciliationMatchItemEdges) MatchOrErr() (*ReconciliationMatch, error) {
+	if e.Match != nil {
+		return e.Match, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: reconciliationmatch.Label}
+	}
+	return nil, &NotLoadedError{edge: "match"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReconciliationMatchItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reconciliationmatchitem.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case reconciliationmatchitem.FieldItemType, reconciliationmatchitem.FieldReference, reconciliationmatchitem.FieldDescription, reconciliationmatchitem.FieldStatus:
+			values[i] = new(sql.NullString)
+		case reconciliationmatchitem.FieldTransactionDate, reconciliationmatchitem.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case reconciliationmatchitem.FieldID, reconciliationmatchitem.FieldTenantID, reconciliationmatchitem.FieldMatchID, reconciliationmatchitem.FieldItemID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReconciliationMatchItem fields.
+func (_m *ReconciliationMatchItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reconciliationmatchitem.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case reconciliationmatchitem.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case reconciliationmatchitem.FieldMatchID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field match_id", values[i])
+			} else if value != nil {
+				_m.MatchID = *value
+			}
+		case reconciliationmatchitem.FieldItemType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_type", values[i])
+			} else if value.Valid {
+				_m.ItemType = value.String
+			}
+		case reconciliationmatchitem.FieldItemID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value != nil {
+				_m.ItemID = *value
+			}
+		case reconciliationmatchitem.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case reconciliationmatchitem.FieldTransactionDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_date", values[i])
+			} else if value.Valid {
+				_m.TransactionDate = value.Time
+			}
+		case reconciliationmatchitem.FieldReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference", values[i])
+			} else if value.Valid {
+				_m.Reference = value.String
+			}
+		case reconciliationmatchitem.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case reconciliationmatchitem.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case reconciliationmatchitem.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReconciliationMatchItem.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReconciliationMatchItem) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMatch queries the "match" edge of the ReconciliationMatchItem entity.
+func (_m *ReconciliationMatchItem) QueryMatch() *ReconciliationMatchQuery {
+	return NewReconciliationMatchItemClient(_m.config).QueryMatch(_m)
+}
+
+// Update returns a builder for updating this ReconciliationMatchItem.
+// Note that you need to call ReconciliationMatchItem.Unwrap() before calling this method if this ReconciliationMatchItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReconciliationMatchItem) Update() *ReconciliationMatchItemUpdateOne {
+	return NewReconciliationMatchItemClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReconciliationMatchItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReconciliationMatchItem) Unwrap() *ReconciliationMatchItem {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReconciliationMatchItem is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReconciliationMatchItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReconciliationMatchItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("match_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MatchID))
+	builder.WriteString(", ")
+	builder.WriteString("item_type=")
+	builder.WriteString(_m.ItemType)
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ItemID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("transaction_date=")
+	builder.WriteString(_m.TransactionDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("reference=")
+	builder.WriteString(_m.Reference)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReconciliationMatchItems is a parsable slice of ReconciliationMatchItem.
+type ReconciliationMatchItems []*ReconciliationMatchItem
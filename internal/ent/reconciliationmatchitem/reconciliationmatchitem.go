@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reconciliationmatchitem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the reconciliationmatchitem type in the database.
+	Label = "reconciliation_match_item"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldMatchID holds the string denoting the match_id field in the database.
+	FieldMatchID = "match_id"
+	// FieldItemType holds the string denoting the item_type field in the database.
+	FieldItemType = "item_type"
+	// FieldItemID holds the string denoting the item_id field in the database.
+	FieldItemID = "item_id"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldTransactionDate holds the string denoting the transaction_date field in the database.
+	FieldTransactionDate = "transaction_date"
+	// FieldReference holds the string denoting the reference field in the database.
+	FieldReference = "reference"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeMatch holds the string denoting the match edge name in mutations.
+	EdgeMatch = "match"
+	// Table holds the table name of the reconciliationmatchitem in the database.
+	Table = "reconciliation_match_items"
+	// MatchTable is the table that holds the match relation/edge.
+	MatchTable = "reconciliation_match_items"
+	// MatchInverseTable is the table name for the ReconciliationMatch entity.
+	// It exists in this package in order to avoid circular dependency with the "reconciliationmatch" package.
+	MatchInverseTable = "reconciliation_matches"
+	// MatchColumn is the table column denoting the match relation/edge.
+	MatchColumn = "match_id"
+)
+
+// Columns holds all SQL columns for reconciliationmatchitem fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldMatchID,
+	FieldItemType,
+	FieldItemID,
+	FieldAmount,
+	FieldTransactionDate,
+	FieldReference,
+	FieldDescription,
+	FieldStatus,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ReconciliationMatchItem queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByMatchID orders the results by the match_id field.
+func ByMatchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatchID, opts...).ToFunc()
+}
+
+// ByItemType orders the results by the item_type field.
+func ByItemType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemType, opts...).ToFunc()
+}
+
+// ByItemID orders the results by the item_id field.
+func ByItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemID, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByTransactionDate orders the results by the transaction_date field.
+func ByTransactionDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransactionDate, opts...).ToFunc()
+}
+
+// ByReference orders the results by the reference field.
+func ByReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReference, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByMatchField orders the results by match field.
+func ByMatchField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMatchStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newMatchStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MatchInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MatchTable, MatchColumn),
+	)
+}
@@ -0,0 +1,646 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reconciliationmatchitem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldTenantID, v))
+}
+
+// MatchID applies equality check predicate on the "match_id" field. It's identical to MatchIDEQ.
+func MatchID(v uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldMatchID, v))
+}
+
+// ItemType applies equality check predicate on the "item_type" field. It's identical to ItemTypeEQ.
+func ItemType(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldItemType, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldItemID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldAmount, v))
+}
+
+// TransactionDate applies equality check predicate on the "transaction_date" field. It's identical to TransactionDateEQ.
+func TransactionDate(v time.Time) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldTransactionDate, v))
+}
+
+// Reference applies equality check predicate on the "reference" field. It's identical to ReferenceEQ.
+func Reference(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldReference, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldDescription, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLTE(FieldTenantID, v))
+}
+
+// MatchIDEQ applies the EQ predicate on the "match_id" field.
+func MatchIDEQ(v uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldMatchID, v))
+}
+
+// MatchIDNEQ applies the NEQ predicate on the "match_id" field.
+func MatchIDNEQ(v uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNEQ(FieldMatchID, v))
+}
+
+// MatchIDIn applies the In predicate on the "match_id" field.
+func MatchIDIn(vs ...uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldIn(FieldMatchID, vs...))
+}
+
+// MatchIDNotIn applies the NotIn predicate on the "match_id" field.
+func MatchIDNotIn(vs ...uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNotIn(FieldMatchID, vs...))
+}
+
+// ItemTypeEQ applies the EQ predicate on the "item_type" field.
+func ItemTypeEQ(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldItemType, v))
+}
+
+// ItemTypeNEQ applies the NEQ predicate on the "item_type" field.
+func ItemTypeNEQ(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNEQ(FieldItemType, v))
+}
+
+// ItemTypeIn applies the In predicate on the "item_type" field.
+func ItemTypeIn(vs ...string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldIn(FieldItemType, vs...))
+}
+
+// ItemTypeNotIn applies the NotIn predicate on the "item_type" field.
+func ItemTypeNotIn(vs ...string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNotIn(FieldItemType, vs...))
+}
+
+// ItemTypeGT applies the GT predicate on the "item_type" field.
+func ItemTypeGT(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGT(FieldItemType, v))
+}
+
+// ItemTypeGTE applies the GTE predicate on the "item_type" field.
+func ItemTypeGTE(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGTE(FieldItemType, v))
+}
+
+// ItemTypeLT applies the LT predicate on the "item_type" field.
+func ItemTypeLT(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLT(FieldItemType, v))
+}
+
+// ItemTypeLTE applies the LTE predicate on the "item_type" field.
+func ItemTypeLTE(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLTE(FieldItemType, v))
+}
+
+// ItemTypeContains applies the Contains predicate on the "item_type" field.
+func ItemTypeContains(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldContains(FieldItemType, v))
+}
+
+// ItemTypeHasPrefix applies the HasPrefix predicate on the "item_type" field.
+func ItemTypeHasPrefix(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldHasPrefix(FieldItemType, v))
+}
+
+// ItemTypeHasSuffix applies the HasSuffix predicate on the "item_type" field.
+func ItemTypeHasSuffix(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldHasSuffix(FieldItemType, v))
+}
+
+// ItemTypeEqualFold applies the EqualFold predicate on the "item_type" field.
+func ItemTypeEqualFold(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEqualFold(FieldItemType, v))
+}
+
+// ItemTypeContainsFold applies the ContainsFold predicate on the "item_type" field.
+func ItemTypeContainsFold(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldContainsFold(FieldItemType, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v uuid.UUID) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLTE(FieldItemID, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLTE(FieldAmount, v))
+}
+
+// TransactionDateEQ applies the EQ predicate on the "transaction_date" field.
+func TransactionDateEQ(v time.Time) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldTransactionDate, v))
+}
+
+// TransactionDateNEQ applies the NEQ predicate on the "transaction_date" field.
+func TransactionDateNEQ(v time.Time) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNEQ(FieldTransactionDate, v))
+}
+
+// TransactionDateIn applies the In predicate on the "transaction_date" field.
+func TransactionDateIn(vs ...time.Time) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldIn(FieldTransactionDate, vs...))
+}
+
+// TransactionDateNotIn applies the NotIn predicate on the "transaction_date" field.
+func TransactionDateNotIn(vs ...time.Time) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNotIn(FieldTransactionDate, vs...))
+}
+
+// TransactionDateGT applies the GT predicate on the "transaction_date" field.
+func TransactionDateGT(v time.Time) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGT(FieldTransactionDate, v))
+}
+
+// TransactionDateGTE applies the GTE predicate on the "transaction_date" field.
+func TransactionDateGTE(v time.Time) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGTE(FieldTransactionDate, v))
+}
+
+// TransactionDateLT applies the LT predicate on the "transaction_date" field.
+func TransactionDateLT(v time.Time) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLT(FieldTransactionDate, v))
+}
+
+// TransactionDateLTE applies the LTE predicate on the "transaction_date" field.
+func TransactionDateLTE(v time.Time) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLTE(FieldTransactionDate, v))
+}
+
+// ReferenceEQ applies the EQ predicate on the "reference" field.
+func ReferenceEQ(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldReference, v))
+}
+
+// ReferenceNEQ applies the NEQ predicate on the "reference" field.
+func ReferenceNEQ(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNEQ(FieldReference, v))
+}
+
+// ReferenceIn applies the In predicate on the "reference" field.
+func ReferenceIn(vs ...string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldIn(FieldReference, vs...))
+}
+
+// ReferenceNotIn applies the NotIn predicate on the "reference" field.
+func ReferenceNotIn(vs ...string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNotIn(FieldReference, vs...))
+}
+
+// ReferenceGT applies the GT predicate on the "reference" field.
+func ReferenceGT(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGT(FieldReference, v))
+}
+
+// ReferenceGTE applies the GTE predicate on the "reference" field.
+func ReferenceGTE(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGTE(FieldReference, v))
+}
+
+// ReferenceLT applies the LT predicate on the "reference" field.
+func ReferenceLT(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLT(FieldReference, v))
+}
+
+// ReferenceLTE applies the LTE predicate on the "reference" field.
+func ReferenceLTE(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLTE(FieldReference, v))
+}
+
+// ReferenceContains applies the Contains predicate on the "reference" field.
+func ReferenceContains(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldContains(FieldReference, v))
+}
+
+// ReferenceHasPrefix applies the HasPrefix predicate on the "reference" field.
+func ReferenceHasPrefix(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldHasPrefix(FieldReference, v))
+}
+
+// ReferenceHasSuffix applies the HasSuffix predicate on the "reference" field.
+func ReferenceHasSuffix(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldHasSuffix(FieldReference, v))
+}
+
+// ReferenceIsNil applies the IsNil predicate on the "reference" field.
+func ReferenceIsNil() predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldIsNull(FieldReference))
+}
+
+// ReferenceNotNil applies the NotNil predicate on the "reference" field.
+func ReferenceNotNil() predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNotNull(FieldReference))
+}
+
+// ReferenceEqualFold applies the EqualFold predicate on the "reference" field.
+func ReferenceEqualFold(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEqualFold(FieldReference, v))
+}
+
+// ReferenceContainsFold applies the ContainsFold predicate on the "reference" field.
+func ReferenceContainsFold(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldContainsFold(FieldReference, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasMatch applies the HasEdge predicate on the "match" edge.
+func HasMatch() predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MatchTable, MatchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMatchWith applies the HasEdge predicate on the "match" edge with a given conditions (other predicates).
+func HasMatchWith(preds ...predicate.ReconciliationMatch) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(func(s *sql.Selector) {
+		step := newMatchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReconciliationMatchItem) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReconciliationMatchItem) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReconciliationMatchItem) predicate.ReconciliationMatchItem {
+	return predicate.ReconciliationMatchItem(sql.NotPredicates(p))
+}
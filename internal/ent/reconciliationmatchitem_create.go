@@ -0,0 +1,1069 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatch"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatchitem"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationMatchItemCreate is the builder for creating a ReconciliationMatchItem entity.
+type ReconciliationMatchItemCreate struct {
+	config
+	mutation *ReconciliationMatchItemMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ReconciliationMatchItemCreate) SetTenantID(v uuid.UUID) *ReconciliationMatchItemCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetMatchID sets the "match_id" field.
+func (_c *ReconciliationMatchItemCreate) SetMatchID(v uuid.UUID) *ReconciliationMatchItemCreate {
+	_c.mutation.SetMatchID(v)
+	return _c
+}
+
+// SetItemType sets the "item_type" field.
+func (_c *ReconciliationMatchItemCreate) SetItemType(v string) *ReconciliationMatchItemCreate {
+	_c.mutation.SetItemType(v)
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *ReconciliationMatchItemCreate) SetItemID(v uuid.UUID) *ReconciliationMatchItemCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *ReconciliationMatchItemCreate) SetAmount(v decimal.Decimal) *ReconciliationMatchItemCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (_c *ReconciliationMatchItemCreate) SetTransactionDate(v time.Time) *ReconciliationMatchItemCreate {
+	_c.mutation.SetTransactionDate(v)
+	return _c
+}
+
+// SetReference sets the "reference" field.
+func (_c *ReconciliationMatchItemCreate) SetReference(v string) *ReconciliationMatchItemCreate {
+	_c.mutation.SetReference(v)
+	return _c
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_c *ReconciliationMatchItemCreate) SetNillableReference(v *string) *ReconciliationMatchItemCreate {
+	if v != nil {
+		_c.SetReference(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *ReconciliationMatchItemCreate) SetDescription(v string) *ReconciliationMatchItemCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *ReconciliationMatchItemCreate) SetNillableDescription(v *string) *ReconciliationMatchItemCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ReconciliationMatchItemCreate) SetStatus(v string) *ReconciliationMatchItemCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ReconciliationMatchItemCreate) SetNillableStatus(v *string) *ReconciliationMatchItemCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReconciliationMatchItemCreate) SetCreatedAt(v time.Time) *ReconciliationMatchItemCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReconciliationMatchItemCreate) SetNillableCreatedAt(v *time.Time) *ReconciliationMatchItemCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReconciliationMatchItemCreate) SetID(v uuid.UUID) *ReconciliationMatchItemCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ReconciliationMatchItemCreate) SetNillableID(v *uuid.UUID) *ReconciliationMatchItemCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetMatch sets the "match" edge to the ReconciliationMatch entity.
+func (_c *ReconciliationMatchItemCreate) SetMatch(v *ReconciliationMatch) *ReconciliationMatchItemCreate {
+	return _c.SetMatchID(v.ID)
+}
+
+// Mutation returns the ReconciliationMatchItemMutation object of the builder.
+func (_c *ReconciliationMatchItemCreate) Mutation() *ReconciliationMatchItemMutation {
+	return _c.mutation
+}
+
+// Save creates the ReconciliationMatchItem in the database.
+func (_c *ReconciliationMatchItemCreate) Save(ctx context.Context) (*ReconciliationMatchItem, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReconciliationMatchItemCreate) SaveX(ctx context.Context) *ReconciliationMatchItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReconciliationMatchItemCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReconciliationMatchItemCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReconciliationMatchItemCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := reconciliationmatchitem.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := reconciliationmatchitem.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := reconciliationmatchitem.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReconciliationMatchItemCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ReconciliationMatchItem.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.MatchID(); !ok {
+		return &ValidationError{Name: "match_id", err: errors.New(`ent: missing required field "ReconciliationMatchItem.match_id"`)}
+	}
+	if _, ok := _c.mutation.ItemType(); !ok {
+		return &ValidationError{Name: "item_type", err: errors.New(`ent: missing required field "ReconciliationMatchItem.item_type"`)}
+	}
+	if _, ok := _c.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "ReconciliationMatchItem.item_id"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "ReconciliationMatchItem.amount"`)}
+	}
+	if _, ok := _c.mutation.TransactionDate(); !ok {
+		return &ValidationError{Name: "transaction_date", err: errors.New(`ent: missing required field "ReconciliationMatchItem.transaction_date"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ReconciliationMatchItem.status"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReconciliationMatchItem.created_at"`)}
+	}
+	if len(_c.mutation.MatchIDs()) == 0 {
+		return &ValidationError{Name: "match", err: errors.New(`ent: missing required edge "ReconciliationMatchItem.match"`)}
+	}
+	return nil
+}
+
+func (_c *ReconciliationMatchItemCreate) sqlSave(ctx context.Context) (*ReconciliationMatchItem, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReconciliationMatchItemCreate) createSpec() (*ReconciliationMatchItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReconciliationMatchItem{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(reconciliationmatchitem.Table, sqlgraph.NewFieldSpec(reconciliationmatchitem.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.ItemType(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldItemType, field.TypeString, value)
+		_node.ItemType = value
+	}
+	if value, ok := _c.mutation.ItemID(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldItemID, field.TypeUUID, value)
+		_node.ItemID = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.TransactionDate(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldTransactionDate, field.TypeTime, value)
+		_node.TransactionDate = value
+	}
+	if value, ok := _c.mutation.Reference(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldReference, field.TypeString, value)
+		_node.Reference = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.MatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationmatchitem.MatchTable,
+			Columns: []string{reconciliationmatchitem.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MatchID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReconciliationMatchItem.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReconciliationMatchItemUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReconciliationMatchItemCreate) OnConflict(opts ...sql.ConflictOption) *ReconciliationMatchItemUpsertOne {
+	_c.conflict = opts
+	return &ReconciliationMatchItemUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReconciliationMatchItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReconciliationMatchItemCreate) OnConflictColumns(columns ...string) *ReconciliationMatchItemUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReconciliationMatchItemUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReconciliationMatchItemUpsertOne is the builder for "upsert"-ing
+	//  one ReconciliationMatchItem node.
+	ReconciliationMatchItemUpsertOne struct {
+		create *ReconciliationMatchItemCreate
+	}
+
+	// ReconciliationMatchItemUpsert is the "OnConflict" setter.
+	ReconciliationMatchItemUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *ReconciliationMatchItemUpsert) SetTenantID(v uuid.UUID) *ReconciliationMatchItemUpsert {
+	u.Set(reconciliationmatchitem.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsert) UpdateTenantID() *ReconciliationMatchItemUpsert {
+	u.SetExcluded(reconciliationmatchitem.FieldTenantID)
+	return u
+}
+
+// SetMatchID sets the "match_id" field.
+func (u *ReconciliationMatchItemUpsert) SetMatchID(v uuid.UUID) *ReconciliationMatchItemUpsert {
+	u.Set(reconciliationmatchitem.FieldMatchID, v)
+	return u
+}
+
+// UpdateMatchID sets the "match_id" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsert) UpdateMatchID() *ReconciliationMatchItemUpsert {
+	u.SetExcluded(reconciliationmatchitem.FieldMatchID)
+	return u
+}
+
+// SetItemType sets the "item_type" field.
+func (u *ReconciliationMatchItemUpsert) SetItemType(v string) *ReconciliationMatchItemUpsert {
+	u.Set(reconciliationmatchitem.FieldItemType, v)
+	return u
+}
+
+// UpdateItemType sets the "item_type" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsert) UpdateItemType() *ReconciliationMatchItemUpsert {
+	u.SetExcluded(reconciliationmatchitem.FieldItemType)
+	return u
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ReconciliationMatchItemUpsert) SetItemID(v uuid.UUID) *ReconciliationMatchItemUpsert {
+	u.Set(reconciliationmatchitem.FieldItemID, v)
+	return u
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsert) UpdateItemID() *ReconciliationMatchItemUpsert {
+	u.SetExcluded(reconciliationmatchitem.FieldItemID)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *ReconciliationMatchItemUpsert) SetAmount(v decimal.Decimal) *ReconciliationMatchItemUpsert {
+	u.Set(reconciliationmatchitem.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsert) UpdateAmount() *ReconciliationMatchItemUpsert {
+	u.SetExcluded(reconciliationmatchitem.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *ReconciliationMatchItemUpsert) AddAmount(v decimal.Decimal) *ReconciliationMatchItemUpsert {
+	u.Add(reconciliationmatchitem.FieldAmount, v)
+	return u
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (u *ReconciliationMatchItemUpsert) SetTransactionDate(v time.Time) *ReconciliationMatchItemUpsert {
+	u.Set(reconciliationmatchitem.FieldTransactionDate, v)
+	return u
+}
+
+// UpdateTransactionDate sets the "transaction_date" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsert) UpdateTransactionDate() *ReconciliationMatchItemUpsert {
+	u.SetExcluded(reconciliationmatchitem.FieldTransactionDate)
+	return u
+}
+
+// SetReference sets the "reference" field.
+func (u *ReconciliationMatchItemUpsert) SetReference(v string) *ReconciliationMatchItemUpsert {
+	u.Set(reconciliationmatchitem.FieldReference, v)
+	return u
+}
+
+// UpdateReference sets the "reference" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsert) UpdateReference() *ReconciliationMatchItemUpsert {
+	u.SetExcluded(reconciliationmatchitem.FieldReference)
+	return u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (u *ReconciliationMatchItemUpsert) ClearReference() *ReconciliationMatchItemUpsert {
+	u.SetNull(reconciliationmatchitem.FieldReference)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ReconciliationMatchItemUpsert) SetDescription(v string) *ReconciliationMatchItemUpsert {
+	u.Set(reconciliationmatchitem.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsert) UpdateDescription() *ReconciliationMatchItemUpsert {
+	u.SetExcluded(reconciliationmatchitem.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ReconciliationMatchItemUpsert) ClearDescription() *ReconciliationMatchItemUpsert {
+	u.SetNull(reconciliationmatchitem.FieldDescription)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReconciliationMatchItemUpsert) SetStatus(v string) *ReconciliationMatchItemUpsert {
+	u.Set(reconciliationmatchitem.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsert) UpdateStatus() *ReconciliationMatchItemUpsert {
+	u.SetExcluded(reconciliationmatchitem.FieldStatus)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReconciliationMatchItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reconciliationmatchitem.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReconciliationMatchItemUpsertOne) UpdateNewValues() *ReconciliationMatchItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reconciliationmatchitem.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(reconciliationmatchitem.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReconciliationMatchItem.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReconciliationMatchItemUpsertOne) Ignore() *ReconciliationMatchItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReconciliationMatchItemUpsertOne) DoNothing() *ReconciliationMatchItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReconciliationMatchItemCreate.OnConflict
+// documentation for more info.
+func (u *ReconciliationMatchItemUpsertOne) Update(set func(*ReconciliationMatchItemUpsert)) *ReconciliationMatchItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReconciliationMatchItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *ReconciliationMatchItemUpsertOne) SetTenantID(v uuid.UUID) *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsertOne) UpdateTenantID() *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetMatchID sets the "match_id" field.
+func (u *ReconciliationMatchItemUpsertOne) SetMatchID(v uuid.UUID) *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.SetMatchID(v)
+	})
+}
+
+// UpdateMatchID sets the "match_id" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsertOne) UpdateMatchID() *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.UpdateMatchID()
+	})
+}
+
+// SetItemType sets the "item_type" field.
+func (u *ReconciliationMatchItemUpsertOne) SetItemType(v string) *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.SetItemType(v)
+	})
+}
+
+// UpdateItemType sets the "item_type" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsertOne) UpdateItemType() *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.UpdateItemType()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ReconciliationMatchItemUpsertOne) SetItemID(v uuid.UUID) *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsertOne) UpdateItemID() *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *ReconciliationMatchItemUpsertOne) SetAmount(v decimal.Decimal) *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *ReconciliationMatchItemUpsertOne) AddAmount(v decimal.Decimal) *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsertOne) UpdateAmount() *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (u *ReconciliationMatchItemUpsertOne) SetTransactionDate(v time.Time) *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.SetTransactionDate(v)
+	})
+}
+
+// UpdateTransactionDate sets the "transaction_date" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsertOne) UpdateTransactionDate() *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.UpdateTransactionDate()
+	})
+}
+
+// SetReference sets the "reference" field.
+func (u *ReconciliationMatchItemUpsertOne) SetReference(v string) *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.SetReference(v)
+	})
+}
+
+// UpdateReference sets the "reference" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsertOne) UpdateReference() *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.UpdateReference()
+	})
+}
+
+// ClearReference clears the value of the "reference" field.
+func (u *ReconciliationMatchItemUpsertOne) ClearReference() *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.ClearReference()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ReconciliationMatchItemUpsertOne) SetDescription(v string) *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsertOne) UpdateDescription() *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ReconciliationMatchItemUpsertOne) ClearDescription() *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReconciliationMatchItemUpsertOne) SetStatus(v string) *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsertOne) UpdateStatus() *ReconciliationMatchItemUpsertOne {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *ReconciliationMatchItemUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReconciliationMatchItemCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReconciliationMatchItemUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReconciliationMatchItemUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReconciliationMatchItemUpsertOne.ID is not supported by MySQL driver. Use ReconciliationMatchItemUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReconciliationMatchItemUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReconciliationMatchItemCreateBulk is the builder for creating many ReconciliationMatchItem entities in bulk.
+type ReconciliationMatchItemCreateBulk struct {
+	config
+	err      error
+	builders []*ReconciliationMatchItemCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReconciliationMatchItem entities in the database.
+func (_c *ReconciliationMatchItemCreateBulk) Save(ctx context.Context) ([]*ReconciliationMatchItem, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReconciliationMatchItem, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReconciliationMatchItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReconciliationMatchItemCreateBulk) SaveX(ctx context.Context) []*ReconciliationMatchItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReconciliationMatchItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReconciliationMatchItemCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReconciliationMatchItem.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReconciliationMatchItemUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReconciliationMatchItemCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReconciliationMatchItemUpsertBulk {
+	_c.conflict = opts
+	return &ReconciliationMatchItemUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReconciliationMatchItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReconciliationMatchItemCreateBulk) OnConflictColumns(columns ...string) *ReconciliationMatchItemUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReconciliationMatchItemUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReconciliationMatchItemUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReconciliationMatchItem nodes.
+type ReconciliationMatchItemUpsertBulk struct {
+	create *ReconciliationMatchItemCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReconciliationMatchItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reconciliationmatchitem.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReconciliationMatchItemUpsertBulk) UpdateNewValues() *ReconciliationMatchItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reconciliationmatchitem.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(reconciliationmatchitem.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReconciliationMatchItem.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReconciliationMatchItemUpsertBulk) Ignore() *ReconciliationMatchItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReconciliationMatchItemUpsertBulk) DoNothing() *ReconciliationMatchItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReconciliationMatchItemCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReconciliationMatchItemUpsertBulk) Update(set func(*ReconciliationMatchItemUpsert)) *ReconciliationMatchItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReconciliationMatchItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *ReconciliationMatchItemUpsertBulk) SetTenantID(v uuid.UUID) *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsertBulk) UpdateTenantID() *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetMatchID sets the "match_id" field.
+func (u *ReconciliationMatchItemUpsertBulk) SetMatchID(v uuid.UUID) *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.SetMatchID(v)
+	})
+}
+
+// UpdateMatchID sets the "match_id" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsertBulk) UpdateMatchID() *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.UpdateMatchID()
+	})
+}
+
+// SetItemType sets the "item_type" field.
+func (u *ReconciliationMatchItemUpsertBulk) SetItemType(v string) *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.SetItemType(v)
+	})
+}
+
+// UpdateItemType sets the "item_type" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsertBulk) UpdateItemType() *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.UpdateItemType()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ReconciliationMatchItemUpsertBulk) SetItemID(v uuid.UUID) *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsertBulk) UpdateItemID() *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *ReconciliationMatchItemUpsertBulk) SetAmount(v decimal.Decimal) *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *ReconciliationMatchItemUpsertBulk) AddAmount(v decimal.Decimal) *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsertBulk) UpdateAmount() *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (u *ReconciliationMatchItemUpsertBulk) SetTransactionDate(v time.Time) *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.SetTransactionDate(v)
+	})
+}
+
+// UpdateTransactionDate sets the "transaction_date" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsertBulk) UpdateTransactionDate() *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.UpdateTransactionDate()
+	})
+}
+
+// SetReference sets the "reference" field.
+func (u *ReconciliationMatchItemUpsertBulk) SetReference(v string) *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.SetReference(v)
+	})
+}
+
+// UpdateReference sets the "reference" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsertBulk) UpdateReference() *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.UpdateReference()
+	})
+}
+
+// ClearReference clears the value of the "reference" field.
+func (u *ReconciliationMatchItemUpsertBulk) ClearReference() *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.ClearReference()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ReconciliationMatchItemUpsertBulk) SetDescription(v string) *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsertBulk) UpdateDescription() *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ReconciliationMatchItemUpsertBulk) ClearDescription() *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReconciliationMatchItemUpsertBulk) SetStatus(v string) *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReconciliationMatchItemUpsertBulk) UpdateStatus() *ReconciliationMatchItemUpsertBulk {
+	return u.Update(func(s *ReconciliationMatchItemUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *ReconciliationMatchItemUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReconciliationMatchItemCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReconciliationMatchItemCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReconciliationMatchItemUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatchitem"
+)
+
+// ReconciliationMatchItemDelete is the builder for deleting a ReconciliationMatchItem entity.
+type ReconciliationMatchItemDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReconciliationMatchItemMutation
+}
+
+// Where appends a list predicates to the ReconciliationMatchItemDelete builder.
+func (_d *ReconciliationMatchItemDelete) Where(ps ...predicate.ReconciliationMatchItem) *ReconciliationMatchItemDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ReconciliationMatchItemDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ReconciliationMatchItemDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ReconciliationMatchItemDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(reconciliationmatchitem.Table, sqlgraph.NewFieldSpec(reconciliationmatchitem.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ReconciliationMatchItemDeleteOne is the builder for deleting a single ReconciliationMatchItem entity.
+type ReconciliationMatchItemDeleteOne struct {
+	_d *ReconciliationMatchItemDelete
+}
+
+// Where appends a list predicates to the ReconciliationMatchItemDelete builder.
+func (_d *ReconciliationMatchItemDeleteOne) Where(ps ...predicate.ReconciliationMatchItem) *ReconciliationMatchItemDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ReconciliationMatchItemDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{reconciliationmatchitem.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ReconciliationMatchItemDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
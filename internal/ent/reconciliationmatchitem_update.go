@@ -0,0 +1,637 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatch"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatchitem"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationMatchItemUpdate is the builder for updating ReconciliationMatchItem entities.
+type ReconciliationMatchItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReconciliationMatchItemMutation
+}
+
+// Where appends a list predicates to the ReconciliationMatchItemUpdate builder.
+func (_u *ReconciliationMatchItemUpdate) Where(ps ...predicate.ReconciliationMatchItem) *ReconciliationMatchItemUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ReconciliationMatchItemUpdate) SetTenantID(v uuid.UUID) *ReconciliationMatchItemUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ReconciliationMatchItemUpdate) SetNillableTenantID(v *uuid.UUID) *ReconciliationMatchItemUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetMatchID sets the "match_id" field.
+func (_u *ReconciliationMatchItemUpdate) SetMatchID(v uuid.UUID) *ReconciliationMatchItemUpdate {
+	_u.mutation.SetMatchID(v)
+	return _u
+}
+
+// SetNillableMatchID sets the "match_id" field if the given value is not nil.
+func (_u *ReconciliationMatchItemUpdate) SetNillableMatchID(v *uuid.UUID) *ReconciliationMatchItemUpdate {
+	if v != nil {
+		_u.SetMatchID(*v)
+	}
+	return _u
+}
+
+// SetItemType sets the "item_type" field.
+func (_u *ReconciliationMatchItemUpdate) SetItemType(v string) *ReconciliationMatchItemUpdate {
+	_u.mutation.SetItemType(v)
+	return _u
+}
+
+// SetNillableItemType sets the "item_type" field if the given value is not nil.
+func (_u *ReconciliationMatchItemUpdate) SetNillableItemType(v *string) *ReconciliationMatchItemUpdate {
+	if v != nil {
+		_u.SetItemType(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ReconciliationMatchItemUpdate) SetItemID(v uuid.UUID) *ReconciliationMatchItemUpdate {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ReconciliationMatchItemUpdate) SetNillableItemID(v *uuid.UUID) *ReconciliationMatchItemUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *ReconciliationMatchItemUpdate) SetAmount(v decimal.Decimal) *ReconciliationMatchItemUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *ReconciliationMatchItemUpdate) SetNillableAmount(v *decimal.Decimal) *ReconciliationMatchItemUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *ReconciliationMatchItemUpdate) AddAmount(v decimal.Decimal) *ReconciliationMatchItemUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (_u *ReconciliationMatchItemUpdate) SetTransactionDate(v time.Time) *ReconciliationMatchItemUpdate {
+	_u.mutation.SetTransactionDate(v)
+	return _u
+}
+
+// SetNillableTransactionDate sets the "transaction_date" field if the given value is not nil.
+func (_u *ReconciliationMatchItemUpdate) SetNillableTransactionDate(v *time.Time) *ReconciliationMatchItemUpdate {
+	if v != nil {
+		_u.SetTransactionDate(*v)
+	}
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *ReconciliationMatchItemUpdate) SetReference(v string) *ReconciliationMatchItemUpdate {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *ReconciliationMatchItemUpdate) SetNillableReference(v *string) *ReconciliationMatchItemUpdate {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *ReconciliationMatchItemUpdate) ClearReference() *ReconciliationMatchItemUpdate {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ReconciliationMatchItemUpdate) SetDescription(v string) *ReconciliationMatchItemUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ReconciliationMatchItemUpdate) SetNillableDescription(v *string) *ReconciliationMatchItemUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ReconciliationMatchItemUpdate) ClearDescription() *ReconciliationMatchItemUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReconciliationMatchItemUpdate) SetStatus(v string) *ReconciliationMatchItemUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReconciliationMatchItemUpdate) SetNillableStatus(v *string) *ReconciliationMatchItemUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMatch sets the "match" edge to the ReconciliationMatch entity.
+func (_u *ReconciliationMatchItemUpdate) SetMatch(v *ReconciliationMatch) *ReconciliationMatchItemUpdate {
+	return _u.SetMatchID(v.ID)
+}
+
+// Mutation returns the ReconciliationMatchItemMutation object of the builder.
+func (_u *ReconciliationMatchItemUpdate) Mutation() *ReconciliationMatchItemMutation {
+	return _u.mutation
+}
+
+// ClearMatch clears the "match" edge to the ReconciliationMatch entity.
+func (_u *ReconciliationMatchItemUpdate) ClearMatch() *ReconciliationMatchItemUpdate {
+	_u.mutation.ClearMatch()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReconciliationMatchItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReconciliationMatchItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReconciliationMatchItemUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReconciliationMatchItemUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReconciliationMatchItemUpdate) check() error {
+	if _u.mutation.MatchCleared() && len(_u.mutation.MatchIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReconciliationMatchItem.match"`)
+	}
+	return nil
+}
+
+func (_u *ReconciliationMatchItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reconciliationmatchitem.Table, reconciliationmatchitem.Columns, sqlgraph.NewFieldSpec(reconciliationmatchitem.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ItemType(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldItemType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldItemID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(reconciliationmatchitem.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TransactionDate(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldTransactionDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(reconciliationmatchitem.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(reconciliationmatchitem.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.MatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationmatchitem.MatchTable,
+			Columns: []string{reconciliationmatchitem.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationmatchitem.MatchTable,
+			Columns: []string{reconciliationmatchitem.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reconciliationmatchitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReconciliationMatchItemUpdateOne is the builder for updating a single ReconciliationMatchItem entity.
+type ReconciliationMatchItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReconciliationMatchItemMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ReconciliationMatchItemUpdateOne) SetTenantID(v uuid.UUID) *ReconciliationMatchItemUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ReconciliationMatchItemUpdateOne) SetNillableTenantID(v *uuid.UUID) *ReconciliationMatchItemUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetMatchID sets the "match_id" field.
+func (_u *ReconciliationMatchItemUpdateOne) SetMatchID(v uuid.UUID) *ReconciliationMatchItemUpdateOne {
+	_u.mutation.SetMatchID(v)
+	return _u
+}
+
+// SetNillableMatchID sets the "match_id" field if the given value is not nil.
+func (_u *ReconciliationMatchItemUpdateOne) SetNillableMatchID(v *uuid.UUID) *ReconciliationMatchItemUpdateOne {
+	if v != nil {
+		_u.SetMatchID(*v)
+	}
+	return _u
+}
+
+// SetItemType sets the "item_type" field.
+func (_u *ReconciliationMatchItemUpdateOne) SetItemType(v string) *ReconciliationMatchItemUpdateOne {
+	_u.mutation.SetItemType(v)
+	return _u
+}
+
+// SetNillableItemType sets the "item_type" field if the given value is not nil.
+func (_u *ReconciliationMatchItemUpdateOne) SetNillableItemType(v *string) *ReconciliationMatchItemUpdateOne {
+	if v != nil {
+		_u.SetItemType(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ReconciliationMatchItemUpdateOne) SetItemID(v uuid.UUID) *ReconciliationMatchItemUpdateOne {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ReconciliationMatchItemUpdateOne) SetNillableItemID(v *uuid.UUID) *ReconciliationMatchItemUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *ReconciliationMatchItemUpdateOne) SetAmount(v decimal.Decimal) *ReconciliationMatchItemUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *ReconciliationMatchItemUpdateOne) SetNillableAmount(v *decimal.Decimal) *ReconciliationMatchItemUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *ReconciliationMatchItemUpdateOne) AddAmount(v decimal.Decimal) *ReconciliationMatchItemUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (_u *ReconciliationMatchItemUpdateOne) SetTransactionDate(v time.Time) *ReconciliationMatchItemUpdateOne {
+	_u.mutation.SetTransactionDate(v)
+	return _u
+}
+
+// SetNillableTransactionDate sets the "transaction_date" field if the given value is not nil.
+func (_u *ReconciliationMatchItemUpdateOne) SetNillableTransactionDate(v *time.Time) *ReconciliationMatchItemUpdateOne {
+	if v != nil {
+		_u.SetTransactionDate(*v)
+	}
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *ReconciliationMatchItemUpdateOne) SetReference(v string) *ReconciliationMatchItemUpdateOne {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *ReconciliationMatchItemUpdateOne) SetNillableReference(v *string) *ReconciliationMatchItemUpdateOne {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *ReconciliationMatchItemUpdateOne) ClearReference() *ReconciliationMatchItemUpdateOne {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ReconciliationMatchItemUpdateOne) SetDescription(v string) *ReconciliationMatchItemUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ReconciliationMatchItemUpdateOne) SetNillableDescription(v *string) *ReconciliationMatchItemUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ReconciliationMatchItemUpdateOne) ClearDescription() *ReconciliationMatchItemUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReconciliationMatchItemUpdateOne) SetStatus(v string) *ReconciliationMatchItemUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReconciliationMatchItemUpdateOne) SetNillableStatus(v *string) *ReconciliationMatchItemUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMatch sets the "match" edge to the ReconciliationMatch entity.
+func (_u *ReconciliationMatchItemUpdateOne) SetMatch(v *ReconciliationMatch) *ReconciliationMatchItemUpdateOne {
+	return _u.SetMatchID(v.ID)
+}
+
+// Mutation returns the ReconciliationMatchItemMutation object of the builder.
+func (_u *ReconciliationMatchItemUpdateOne) Mutation() *ReconciliationMatchItemMutation {
+	return _u.mutation
+}
+
+// ClearMatch clears the "match" edge to the ReconciliationMatch entity.
+func (_u *ReconciliationMatchItemUpdateOne) ClearMatch() *ReconciliationMatchItemUpdateOne {
+	_u.mutation.ClearMatch()
+	return _u
+}
+
+// Where appends a list predicates to the ReconciliationMatchItemUpdate builder.
+func (_u *ReconciliationMatchItemUpdateOne) Where(ps ...predicate.ReconciliationMatchItem) *ReconciliationMatchItemUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReconciliationMatchItemUpdateOne) Select(field string, fields ...string) *ReconciliationMatchItemUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReconciliationMatchItem entity.
+func (_u *ReconciliationMatchItemUpdateOne) Save(ctx context.Context) (*ReconciliationMatchItem, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReconciliationMatchItemUpdateOne) SaveX(ctx context.Context) *ReconciliationMatchItem {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReconciliationMatchItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReconciliationMatchItemUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReconciliationMatchItemUpdateOne) check() error {
+	if _u.mutation.MatchCleared() && len(_u.mutation.MatchIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReconciliationMatchItem.match"`)
+	}
+	return nil
+}
+
+func (_u *ReconciliationMatchItemUpdateOne) sqlSave(ctx context.Context) (_node *ReconciliationMatchItem, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reconciliationmatchitem.Table, reconciliationmatchitem.Columns, sqlgraph.NewFieldSpec(reconciliationmatchitem.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReconciliationMatchItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reconciliationmatchitem.FieldID)
+		for _, f := range fields {
+			if !reconciliationmatchitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reconciliationmatchitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ItemType(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldItemType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldItemID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(reconciliationmatchitem.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TransactionDate(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldTransactionDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(reconciliationmatchitem.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(reconciliationmatchitem.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(reconciliationmatchitem.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.MatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationmatchitem.MatchTable,
+			Columns: []string{reconciliationmatchitem.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reconciliationmatchitem.MatchTable,
+			Columns: []string{reconciliationmatchitem.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reconciliationmatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReconciliationMatchItem{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reconciliationmatchitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,287 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationrule"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationRule is the model entity for the ReconciliationRule schema.
+type ReconciliationRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Bank account the rule applies to; empty for every account
+	BankAccountID *uuid.UUID `json:"bank_account_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Rules run in ascending priority
+	Priority int `json:"priority,omitempty"`
+	// Bank line field the pattern is matched against: reference, description, counterparty
+	MatchField string `json:"match_field,omitempty"`
+	// Case-insensitive regular expression the bank line must match
+	Pattern string `json:"pattern,omitempty"`
+	// Side matched against: ledger, payment
+	Counterpart string `json:"counterpart,omitempty"`
+	// Case-insensitive regular expression the counterpart's reference or description must match
+	CounterpartPattern string `json:"counterpart_pattern,omitempty"`
+	// Largest accepted difference between the bank and book amounts (defaults to zero)
+	AmountTolerance decimal.Decimal `json:"amount_tolerance,omitempty"`
+	// DateWindowDays holds the value of the "date_window_days" field.
+	DateWindowDays int `json:"date_window_days,omitempty"`
+	// Confidence score given to the rule's matches (0-100)
+	Confidence int `json:"confidence,omitempty"`
+	// Lock the rule's matches into the reconciliation without review
+	AutoMatch bool `json:"auto_match,omitempty"`
+	// Active holds the value of the "active" field.
+	Active bool `json:"active,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReconciliationRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reconciliationrule.FieldBankAccountID, reconciliationrule.FieldCreatedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case reconciliationrule.FieldAmountTolerance:
+			values[i] = new(decimal.Decimal)
+		case reconciliationrule.FieldAutoMatch, reconciliationrule.FieldActive:
+			values[i] = new(sql.NullBool)
+		case reconciliationrule.FieldPriority, reconciliationrule.FieldDateWindowDays, reconciliationrule.FieldConfidence:
+			values[i] = new(sql.NullInt64)
+		case reconciliationrule.FieldName, reconciliationrule.FieldMatchField, reconciliationrule.FieldPattern, reconciliationrule.FieldCounterpart, reconciliationrule.FieldCounterpartPattern:
+			values[i] = new(sql.NullString)
+		case reconciliationrule.FieldCreatedAt, reconciliationrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case reconciliationrule.FieldID, reconciliationrule.FieldTenantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReconciliationRule fields.
+func (_m *ReconciliationRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reconciliationrule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case reconciliationrule.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case reconciliationrule.FieldBankAccountID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field bank_account_id", values[i])
+			} else if value.Valid {
+				_m.BankAccountID = new(uuid.UUID)
+				*_m.BankAccountID = *value.S.(*uuid.UUID)
+			}
+		case reconciliationrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case reconciliationrule.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = int(value.Int64)
+			}
+		case reconciliationrule.FieldMatchField:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field match_field", values[i])
+			} else if value.Valid {
+				_m.MatchField = value.String
+			}
+		case reconciliationrule.FieldPattern:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pattern", values[i])
+			} else if value.Valid {
+				_m.Pattern = value.String
+			}
+		case reconciliationrule.FieldCounterpart:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field counterpart", values[i])
+			} else if value.Valid {
+				_m.Counterpart = value.String
+			}
+		case reconciliationrule.FieldCounterpartPattern:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field counterpart_pattern", values[i])
+			} else if value.Valid {
+				_m.CounterpartPattern = value.String
+			}
+		case reconciliationrule.FieldAmountTolerance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_tolerance", values[i])
+			} else if value != nil {
+				_m.AmountTolerance = *value
+			}
+		case reconciliationrule.FieldDateWindowDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field date_window_days", values[i])
+			} else if value.Valid {
+				_m.DateWindowDays = int(value.Int64)
+			}
+		case reconciliationrule.FieldConfidence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field confidence", values[i])
+			} else if value.Valid {
+				_m.Confidence = int(value.Int64)
+			}
+		case reconciliationrule.FieldAutoMatch:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_match", values[i])
+			} else if value.Valid {
+				_m.AutoMatch = value.Bool
+			}
+		case reconciliationrule.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				_m.Active = value.Bool
+			}
+		case reconciliationrule.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(uuid.UUID)
+				*_m.CreatedBy = *value.S.(*uuid.UUID)
+			}
+		case reconciliationrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case reconciliationrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReconciliationRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReconciliationRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ReconciliationRule.
+// Note that you need to call ReconciliationRule.Unwrap() before calling this method if this ReconciliationRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReconciliationRule) Update() *ReconciliationRuleUpdateOne {
+	return NewReconciliationRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReconciliationRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReconciliationRule) Unwrap() *ReconciliationRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReconciliationRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReconciliationRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReconciliationRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	if v := _m.BankAccountID; v != nil {
+		builder.WriteString("bank_account_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("match_field=")
+	builder.WriteString(_m.MatchField)
+	builder.WriteString(", ")
+	builder.WriteString("pattern=")
+	builder.WriteString(_m.Pattern)
+	builder.WriteString(", ")
+	builder.WriteString("counterpart=")
+	builder.WriteString(_m.Counterpart)
+	builder.WriteString(", ")
+	builder.WriteString("counterpart_pattern=")
+	builder.WriteString(_m.CounterpartPattern)
+	builder.WriteString(", ")
+	builder.WriteString("amount_tolerance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AmountTolerance))
+	builder.WriteString(", ")
+	builder.WriteString("date_window_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DateWindowDays))
+	builder.WriteString(", ")
+	builder.WriteString("confidence=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Confidence))
+	builder.WriteString(", ")
+	builder.WriteString("auto_match=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoMatch))
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Active))
+	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReconciliationRules is a parsable slice of ReconciliationRule.
+type ReconciliationRules []*ReconciliationRule
@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reconciliationrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the reconciliationrule type in the database.
+	Label = "reconciliation_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldBankAccountID holds the string denoting the bank_account_id field in the database.
+	FieldBankAccountID = "bank_account_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldMatchField holds the string denoting the match_field field in the database.
+	FieldMatchField = "match_field"
+	// FieldPattern holds the string denoting the pattern field in the database.
+	FieldPattern = "pattern"
+	// FieldCounterpart holds the string denoting the counterpart field in the database.
+	FieldCounterpart = "counterpart"
+	// FieldCounterpartPattern holds the string denoting the counterpart_pattern field in the database.
+	FieldCounterpartPattern = "counterpart_pattern"
+	// FieldAmountTolerance holds the string denoting the amount_tolerance field in the database.
+	FieldAmountTolerance = "amount_tolerance"
+	// FieldDateWindowDays holds the string denoting the date_window_days field in the database.
+	FieldDateWindowDays = "date_window_days"
+	// FieldConfidence holds the string denoting the confidence field in the database.
+	FieldConfidence = "confidence"
+	// FieldAutoMatch holds the string denoting the auto_match field in the database.
+	FieldAutoMatch = "auto_match"
+	// FieldActive holds the string denoting the active field in the database.
+	FieldActive = "active"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the reconciliationrule in the database.
+	Table = "reconciliation_rules"
+)
+
+// Columns holds all SQL columns for reconciliationrule fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldBankAccountID,
+	FieldName,
+	FieldPriority,
+	FieldMatchField,
+	FieldPattern,
+	FieldCounterpart,
+	FieldCounterpartPattern,
+	FieldAmountTolerance,
+	FieldDateWindowDays,
+	FieldConfidence,
+	FieldAutoMatch,
+	FieldActive,
+	FieldCreatedBy,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+	// DefaultMatchField holds the default value on creation for the "match_field" field.
+	DefaultMatchField string
+	// DefaultCounterpart holds the default value on creation for the "counterpart" field.
+	DefaultCounterpart string
+	// DefaultDateWindowDays holds the default value on creation for the "date_window_days" field.
+	DefaultDateWindowDays int
+	// DefaultConfidence holds the default value on creation for the "confidence" field.
+	DefaultConfidence int
+	// DefaultAutoMatch holds the default value on creation for the "auto_match" field.
+	DefaultAutoMatch bool
+	// DefaultActive holds the default value on creation for the "active" field.
+	DefaultActive bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ReconciliationRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByBankAccountID orders the results by the bank_account_id field.
+func ByBankAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBankAccountID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByMatchField orders the results by the match_field field.
+func ByMatchField(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatchField, opts...).ToFunc()
+}
+
+// ByPattern orders the results by the pattern field.
+func ByPattern(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPattern, opts...).ToFunc()
+}
+
+// ByCounterpart orders the results by the counterpart field.
+func ByCounterpart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCounterpart, opts...).ToFunc()
+}
+
+// ByCounterpartPattern orders the results by the counterpart_pattern field.
+func ByCounterpartPattern(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCounterpartPattern, opts...).ToFunc()
+}
+
+// ByAmountTolerance orders the results by the amount_tolerance field.
+func ByAmountTolerance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountTolerance, opts...).ToFunc()
+}
+
+// ByDateWindowDays orders the results by the date_window_days field.
+func ByDateWindowDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDateWindowDays, opts...).ToFunc()
+}
+
+// ByConfidence orders the results by the confidence field.
+func ByConfidence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConfidence, opts...).ToFunc()
+}
+
+// ByAutoMatch orders the results by the auto_match field.
+func ByAutoMatch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoMatch, opts...).ToFunc()
+}
+
+// ByActive orders the results by the active field.
+func ByActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActive, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
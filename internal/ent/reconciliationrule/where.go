@@ -0,0 +1,897 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reconciliationrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldTenantID, v))
+}
+
+// BankAccountID applies equality check predicate on the "bank_account_id" field. It's identical to BankAccountIDEQ.
+func BankAccountID(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldBankAccountID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldName, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldPriority, v))
+}
+
+// MatchField applies equality check predicate on the "match_field" field. It's identical to MatchFieldEQ.
+func MatchField(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldMatchField, v))
+}
+
+// Pattern applies equality check predicate on the "pattern" field. It's identical to PatternEQ.
+func Pattern(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldPattern, v))
+}
+
+// Counterpart applies equality check predicate on the "counterpart" field. It's identical to CounterpartEQ.
+func Counterpart(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldCounterpart, v))
+}
+
+// CounterpartPattern applies equality check predicate on the "counterpart_pattern" field. It's identical to CounterpartPatternEQ.
+func CounterpartPattern(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldCounterpartPattern, v))
+}
+
+// AmountTolerance applies equality check predicate on the "amount_tolerance" field. It's identical to AmountToleranceEQ.
+func AmountTolerance(v decimal.Decimal) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldAmountTolerance, v))
+}
+
+// DateWindowDays applies equality check predicate on the "date_window_days" field. It's identical to DateWindowDaysEQ.
+func DateWindowDays(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldDateWindowDays, v))
+}
+
+// Confidence applies equality check predicate on the "confidence" field. It's identical to ConfidenceEQ.
+func Confidence(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldConfidence, v))
+}
+
+// AutoMatch applies equality check predicate on the "auto_match" field. It's identical to AutoMatchEQ.
+func AutoMatch(v bool) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldAutoMatch, v))
+}
+
+// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
+func Active(v bool) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldActive, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLTE(FieldTenantID, v))
+}
+
+// BankAccountIDEQ applies the EQ predicate on the "bank_account_id" field.
+func BankAccountIDEQ(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldBankAccountID, v))
+}
+
+// BankAccountIDNEQ applies the NEQ predicate on the "bank_account_id" field.
+func BankAccountIDNEQ(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNEQ(FieldBankAccountID, v))
+}
+
+// BankAccountIDIn applies the In predicate on the "bank_account_id" field.
+func BankAccountIDIn(vs ...uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIn(FieldBankAccountID, vs...))
+}
+
+// BankAccountIDNotIn applies the NotIn predicate on the "bank_account_id" field.
+func BankAccountIDNotIn(vs ...uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotIn(FieldBankAccountID, vs...))
+}
+
+// BankAccountIDGT applies the GT predicate on the "bank_account_id" field.
+func BankAccountIDGT(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGT(FieldBankAccountID, v))
+}
+
+// BankAccountIDGTE applies the GTE predicate on the "bank_account_id" field.
+func BankAccountIDGTE(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGTE(FieldBankAccountID, v))
+}
+
+// BankAccountIDLT applies the LT predicate on the "bank_account_id" field.
+func BankAccountIDLT(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLT(FieldBankAccountID, v))
+}
+
+// BankAccountIDLTE applies the LTE predicate on the "bank_account_id" field.
+func BankAccountIDLTE(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLTE(FieldBankAccountID, v))
+}
+
+// BankAccountIDIsNil applies the IsNil predicate on the "bank_account_id" field.
+func BankAccountIDIsNil() predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIsNull(FieldBankAccountID))
+}
+
+// BankAccountIDNotNil applies the NotNil predicate on the "bank_account_id" field.
+func BankAccountIDNotNil() predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotNull(FieldBankAccountID))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLTE(FieldPriority, v))
+}
+
+// MatchFieldEQ applies the EQ predicate on the "match_field" field.
+func MatchFieldEQ(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldMatchField, v))
+}
+
+// MatchFieldNEQ applies the NEQ predicate on the "match_field" field.
+func MatchFieldNEQ(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNEQ(FieldMatchField, v))
+}
+
+// MatchFieldIn applies the In predicate on the "match_field" field.
+func MatchFieldIn(vs ...string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIn(FieldMatchField, vs...))
+}
+
+// MatchFieldNotIn applies the NotIn predicate on the "match_field" field.
+func MatchFieldNotIn(vs ...string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotIn(FieldMatchField, vs...))
+}
+
+// MatchFieldGT applies the GT predicate on the "match_field" field.
+func MatchFieldGT(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGT(FieldMatchField, v))
+}
+
+// MatchFieldGTE applies the GTE predicate on the "match_field" field.
+func MatchFieldGTE(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGTE(FieldMatchField, v))
+}
+
+// MatchFieldLT applies the LT predicate on the "match_field" field.
+func MatchFieldLT(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLT(FieldMatchField, v))
+}
+
+// MatchFieldLTE applies the LTE predicate on the "match_field" field.
+func MatchFieldLTE(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLTE(FieldMatchField, v))
+}
+
+// MatchFieldContains applies the Contains predicate on the "match_field" field.
+func MatchFieldContains(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldContains(FieldMatchField, v))
+}
+
+// MatchFieldHasPrefix applies the HasPrefix predicate on the "match_field" field.
+func MatchFieldHasPrefix(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldHasPrefix(FieldMatchField, v))
+}
+
+// MatchFieldHasSuffix applies the HasSuffix predicate on the "match_field" field.
+func MatchFieldHasSuffix(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldHasSuffix(FieldMatchField, v))
+}
+
+// MatchFieldEqualFold applies the EqualFold predicate on the "match_field" field.
+func MatchFieldEqualFold(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEqualFold(FieldMatchField, v))
+}
+
+// MatchFieldContainsFold applies the ContainsFold predicate on the "match_field" field.
+func MatchFieldContainsFold(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldContainsFold(FieldMatchField, v))
+}
+
+// PatternEQ applies the EQ predicate on the "pattern" field.
+func PatternEQ(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldPattern, v))
+}
+
+// PatternNEQ applies the NEQ predicate on the "pattern" field.
+func PatternNEQ(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNEQ(FieldPattern, v))
+}
+
+// PatternIn applies the In predicate on the "pattern" field.
+func PatternIn(vs ...string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIn(FieldPattern, vs...))
+}
+
+// PatternNotIn applies the NotIn predicate on the "pattern" field.
+func PatternNotIn(vs ...string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotIn(FieldPattern, vs...))
+}
+
+// PatternGT applies the GT predicate on the "pattern" field.
+func PatternGT(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGT(FieldPattern, v))
+}
+
+// PatternGTE applies the GTE predicate on the "pattern" field.
+func PatternGTE(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGTE(FieldPattern, v))
+}
+
+// PatternLT applies the LT predicate on the "pattern" field.
+func PatternLT(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLT(FieldPattern, v))
+}
+
+// PatternLTE applies the LTE predicate on the "pattern" field.
+func PatternLTE(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLTE(FieldPattern, v))
+}
+
+// PatternContains applies the Contains predicate on the "pattern" field.
+func PatternContains(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldContains(FieldPattern, v))
+}
+
+// PatternHasPrefix applies the HasPrefix predicate on the "pattern" field.
+func PatternHasPrefix(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldHasPrefix(FieldPattern, v))
+}
+
+// PatternHasSuffix applies the HasSuffix predicate on the "pattern" field.
+func PatternHasSuffix(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldHasSuffix(FieldPattern, v))
+}
+
+// PatternEqualFold applies the EqualFold predicate on the "pattern" field.
+func PatternEqualFold(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEqualFold(FieldPattern, v))
+}
+
+// PatternContainsFold applies the ContainsFold predicate on the "pattern" field.
+func PatternContainsFold(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldContainsFold(FieldPattern, v))
+}
+
+// CounterpartEQ applies the EQ predicate on the "counterpart" field.
+func CounterpartEQ(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldCounterpart, v))
+}
+
+// CounterpartNEQ applies the NEQ predicate on the "counterpart" field.
+func CounterpartNEQ(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNEQ(FieldCounterpart, v))
+}
+
+// CounterpartIn applies the In predicate on the "counterpart" field.
+func CounterpartIn(vs ...string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIn(FieldCounterpart, vs...))
+}
+
+// CounterpartNotIn applies the NotIn predicate on the "counterpart" field.
+func CounterpartNotIn(vs ...string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotIn(FieldCounterpart, vs...))
+}
+
+// CounterpartGT applies the GT predicate on the "counterpart" field.
+func CounterpartGT(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGT(FieldCounterpart, v))
+}
+
+// CounterpartGTE applies the GTE predicate on the "counterpart" field.
+func CounterpartGTE(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGTE(FieldCounterpart, v))
+}
+
+// CounterpartLT applies the LT predicate on the "counterpart" field.
+func CounterpartLT(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLT(FieldCounterpart, v))
+}
+
+// CounterpartLTE applies the LTE predicate on the "counterpart" field.
+func CounterpartLTE(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLTE(FieldCounterpart, v))
+}
+
+// CounterpartContains applies the Contains predicate on the "counterpart" field.
+func CounterpartContains(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldContains(FieldCounterpart, v))
+}
+
+// CounterpartHasPrefix applies the HasPrefix predicate on the "counterpart" field.
+func CounterpartHasPrefix(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldHasPrefix(FieldCounterpart, v))
+}
+
+// CounterpartHasSuffix applies the HasSuffix predicate on the "counterpart" field.
+func CounterpartHasSuffix(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldHasSuffix(FieldCounterpart, v))
+}
+
+// CounterpartEqualFold applies the EqualFold predicate on the "counterpart" field.
+func CounterpartEqualFold(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEqualFold(FieldCounterpart, v))
+}
+
+// CounterpartContainsFold applies the ContainsFold predicate on the "counterpart" field.
+func CounterpartContainsFold(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldContainsFold(FieldCounterpart, v))
+}
+
+// CounterpartPatternEQ applies the EQ predicate on the "counterpart_pattern" field.
+func CounterpartPatternEQ(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldCounterpartPattern, v))
+}
+
+// CounterpartPatternNEQ applies the NEQ predicate on the "counterpart_pattern" field.
+func CounterpartPatternNEQ(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNEQ(FieldCounterpartPattern, v))
+}
+
+// CounterpartPatternIn applies the In predicate on the "counterpart_pattern" field.
+func CounterpartPatternIn(vs ...string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIn(FieldCounterpartPattern, vs...))
+}
+
+// CounterpartPatternNotIn applies the NotIn predicate on the "counterpart_pattern" field.
+func CounterpartPatternNotIn(vs ...string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotIn(FieldCounterpartPattern, vs...))
+}
+
+// CounterpartPatternGT applies the GT predicate on the "counterpart_pattern" field.
+func CounterpartPatternGT(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGT(FieldCounterpartPattern, v))
+}
+
+// CounterpartPatternGTE applies the GTE predicate on the "counterpart_pattern" field.
+func CounterpartPatternGTE(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGTE(FieldCounterpartPattern, v))
+}
+
+// CounterpartPatternLT applies the LT predicate on the "counterpart_pattern" field.
+func CounterpartPatternLT(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLT(FieldCounterpartPattern, v))
+}
+
+// CounterpartPatternLTE applies the LTE predicate on the "counterpart_pattern" field.
+func CounterpartPatternLTE(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLTE(FieldCounterpartPattern, v))
+}
+
+// CounterpartPatternContains applies the Contains predicate on the "counterpart_pattern" field.
+func CounterpartPatternContains(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldContains(FieldCounterpartPattern, v))
+}
+
+// CounterpartPatternHasPrefix applies the HasPrefix predicate on the "counterpart_pattern" field.
+func CounterpartPatternHasPrefix(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldHasPrefix(FieldCounterpartPattern, v))
+}
+
+// CounterpartPatternHasSuffix applies the HasSuffix predicate on the "counterpart_pattern" field.
+func CounterpartPatternHasSuffix(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldHasSuffix(FieldCounterpartPattern, v))
+}
+
+// CounterpartPatternIsNil applies the IsNil predicate on the "counterpart_pattern" field.
+func CounterpartPatternIsNil() predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIsNull(FieldCounterpartPattern))
+}
+
+// CounterpartPatternNotNil applies the NotNil predicate on the "counterpart_pattern" field.
+func CounterpartPatternNotNil() predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotNull(FieldCounterpartPattern))
+}
+
+// CounterpartPatternEqualFold applies the EqualFold predicate on the "counterpart_pattern" field.
+func CounterpartPatternEqualFold(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEqualFold(FieldCounterpartPattern, v))
+}
+
+// CounterpartPatternContainsFold applies the ContainsFold predicate on the "counterpart_pattern" field.
+func CounterpartPatternContainsFold(v string) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldContainsFold(FieldCounterpartPattern, v))
+}
+
+// AmountToleranceEQ applies the EQ predicate on the "amount_tolerance" field.
+func AmountToleranceEQ(v decimal.Decimal) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldAmountTolerance, v))
+}
+
+// AmountToleranceNEQ applies the NEQ predicate on the "amount_tolerance" field.
+func AmountToleranceNEQ(v decimal.Decimal) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNEQ(FieldAmountTolerance, v))
+}
+
+// AmountToleranceIn applies the In predicate on the "amount_tolerance" field.
+func AmountToleranceIn(vs ...decimal.Decimal) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIn(FieldAmountTolerance, vs...))
+}
+
+// AmountToleranceNotIn applies the NotIn predicate on the "amount_tolerance" field.
+func AmountToleranceNotIn(vs ...decimal.Decimal) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotIn(FieldAmountTolerance, vs...))
+}
+
+// AmountToleranceGT applies the GT predicate on the "amount_tolerance" field.
+func AmountToleranceGT(v decimal.Decimal) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGT(FieldAmountTolerance, v))
+}
+
+// AmountToleranceGTE applies the GTE predicate on the "amount_tolerance" field.
+func AmountToleranceGTE(v decimal.Decimal) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGTE(FieldAmountTolerance, v))
+}
+
+// AmountToleranceLT applies the LT predicate on the "amount_tolerance" field.
+func AmountToleranceLT(v decimal.Decimal) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLT(FieldAmountTolerance, v))
+}
+
+// AmountToleranceLTE applies the LTE predicate on the "amount_tolerance" field.
+func AmountToleranceLTE(v decimal.Decimal) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLTE(FieldAmountTolerance, v))
+}
+
+// AmountToleranceIsNil applies the IsNil predicate on the "amount_tolerance" field.
+func AmountToleranceIsNil() predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIsNull(FieldAmountTolerance))
+}
+
+// AmountToleranceNotNil applies the NotNil predicate on the "amount_tolerance" field.
+func AmountToleranceNotNil() predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotNull(FieldAmountTolerance))
+}
+
+// DateWindowDaysEQ applies the EQ predicate on the "date_window_days" field.
+func DateWindowDaysEQ(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldDateWindowDays, v))
+}
+
+// DateWindowDaysNEQ applies the NEQ predicate on the "date_window_days" field.
+func DateWindowDaysNEQ(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNEQ(FieldDateWindowDays, v))
+}
+
+// DateWindowDaysIn applies the In predicate on the "date_window_days" field.
+func DateWindowDaysIn(vs ...int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIn(FieldDateWindowDays, vs...))
+}
+
+// DateWindowDaysNotIn applies the NotIn predicate on the "date_window_days" field.
+func DateWindowDaysNotIn(vs ...int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotIn(FieldDateWindowDays, vs...))
+}
+
+// DateWindowDaysGT applies the GT predicate on the "date_window_days" field.
+func DateWindowDaysGT(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGT(FieldDateWindowDays, v))
+}
+
+// DateWindowDaysGTE applies the GTE predicate on the "date_window_days" field.
+func DateWindowDaysGTE(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGTE(FieldDateWindowDays, v))
+}
+
+// DateWindowDaysLT applies the LT predicate on the "date_window_days" field.
+func DateWindowDaysLT(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLT(FieldDateWindowDays, v))
+}
+
+// DateWindowDaysLTE applies the LTE predicate on the "date_window_days" field.
+func DateWindowDaysLTE(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLTE(FieldDateWindowDays, v))
+}
+
+// ConfidenceEQ applies the EQ predicate on the "confidence" field.
+func ConfidenceEQ(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldConfidence, v))
+}
+
+// ConfidenceNEQ applies the NEQ predicate on the "confidence" field.
+func ConfidenceNEQ(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNEQ(FieldConfidence, v))
+}
+
+// ConfidenceIn applies the In predicate on the "confidence" field.
+func ConfidenceIn(vs ...int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIn(FieldConfidence, vs...))
+}
+
+// ConfidenceNotIn applies the NotIn predicate on the "confidence" field.
+func ConfidenceNotIn(vs ...int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotIn(FieldConfidence, vs...))
+}
+
+// ConfidenceGT applies the GT predicate on the "confidence" field.
+func ConfidenceGT(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGT(FieldConfidence, v))
+}
+
+// ConfidenceGTE applies the GTE predicate on the "confidence" field.
+func ConfidenceGTE(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGTE(FieldConfidence, v))
+}
+
+// ConfidenceLT applies the LT predicate on the "confidence" field.
+func ConfidenceLT(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLT(FieldConfidence, v))
+}
+
+// ConfidenceLTE applies the LTE predicate on the "confidence" field.
+func ConfidenceLTE(v int) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLTE(FieldConfidence, v))
+}
+
+// AutoMatchEQ applies the EQ predicate on the "auto_match" field.
+func AutoMatchEQ(v bool) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldAutoMatch, v))
+}
+
+// AutoMatchNEQ applies the NEQ predicate on the "auto_match" field.
+func AutoMatchNEQ(v bool) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNEQ(FieldAutoMatch, v))
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldActive, v))
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNEQ(FieldActive, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uuid.UUID) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReconciliationRule) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReconciliationRule) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReconciliationRule) predicate.ReconciliationRule {
+	return predicate.ReconciliationRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,1564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationrule"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationRuleCreate is the builder for creating a ReconciliationRule entity.
+type ReconciliationRuleCreate struct {
+	config
+	mutation *ReconciliationRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ReconciliationRuleCreate) SetTenantID(v uuid.UUID) *ReconciliationRuleCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (_c *ReconciliationRuleCreate) SetBankAccountID(v uuid.UUID) *ReconciliationRuleCreate {
+	_c.mutation.SetBankAccountID(v)
+	return _c
+}
+
+// SetNillableBankAccountID sets the "bank_account_id" field if the given value is not nil.
+func (_c *ReconciliationRuleCreate) SetNillableBankAccountID(v *uuid.UUID) *ReconciliationRuleCreate {
+	if v != nil {
+		_c.SetBankAccountID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ReconciliationRuleCreate) SetName(v string) *ReconciliationRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *ReconciliationRuleCreate) SetPriority(v int) *ReconciliationRuleCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *ReconciliationRuleCreate) SetNillablePriority(v *int) *ReconciliationRuleCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetMatchField sets the "match_field" field.
+func (_c *ReconciliationRuleCreate) SetMatchField(v string) *ReconciliationRuleCreate {
+	_c.mutation.SetMatchField(v)
+	return _c
+}
+
+// SetNillableMatchField sets the "match_field" field if the given value is not nil.
+func (_c *ReconciliationRuleCreate) SetNillableMatchField(v *string) *ReconciliationRuleCreate {
+	if v != nil {
+		_c.SetMatchField(*v)
+	}
+	return _c
+}
+
+// SetPattern sets the "pattern" field.
+func (_c *ReconciliationRuleCreate) SetPattern(v string) *ReconciliationRuleCreate {
+	_c.mutation.SetPattern(v)
+	return _c
+}
+
+// SetCounterpart sets the "counterpart" field.
+func (_c *ReconciliationRuleCreate) SetCounterpart(v string) *ReconciliationRuleCreate {
+	_c.mutation.SetCounterpart(v)
+	return _c
+}
+
+// SetNillableCounterpart sets the "counterpart" field if the given value is not nil.
+func (_c *ReconciliationRuleCreate) SetNillableCounterpart(v *string) *ReconciliationRuleCreate {
+	if v != nil {
+		_c.SetCounterpart(*v)
+	}
+	return _c
+}
+
+// SetCounterpartPattern sets the "counterpart_pattern" field.
+func (_c *ReconciliationRuleCreate) SetCounterpartPattern(v string) *ReconciliationRuleCreate {
+	_c.mutation.SetCounterpartPattern(v)
+	return _c
+}
+
+// SetNillableCounterpartPattern sets the "counterpart_pattern" field if the given value is not nil.
+func (_c *ReconciliationRuleCreate) SetNillableCounterpartPattern(v *string) *ReconciliationRuleCreate {
+	if v != nil {
+		_c.SetCounterpartPattern(*v)
+	}
+	return _c
+}
+
+// SetAmountTolerance sets the "amount_tolerance" field.
+func (_c *ReconciliationRuleCreate) SetAmountTolerance(v decimal.Decimal) *ReconciliationRuleCreate {
+	_c.mutation.SetAmountTolerance(v)
+	return _c
+}
+
+// SetNillableAmountTolerance sets the "amount_tolerance" field if the given value is not nil.
+func (_c *ReconciliationRuleCreate) SetNillableAmountTolerance(v *decimal.Decimal) *ReconciliationRuleCreate {
+	if v != nil {
+		_c.SetAmountTolerance(*v)
+	}
+	return _c
+}
+
+// SetDateWindowDays sets the "date_window_days" field.
+func (_c *ReconciliationRuleCreate) SetDateWindowDays(v int) *ReconciliationRuleCreate {
+	_c.mutation.SetDateWindowDays(v)
+	return _c
+}
+
+// SetNillableDateWindowDays sets the "date_window_days" field if the given value is not nil.
+func (_c *ReconciliationRuleCreate) SetNillableDateWindowDays(v *int) *ReconciliationRuleCreate {
+	if v != nil {
+		_c.SetDateWindowDays(*v)
+	}
+	return _c
+}
+
+// SetConfidence sets the "confidence" field.
+func (_c *ReconciliationRuleCreate) SetConfidence(v int) *ReconciliationRuleCreate {
+	_c.mutation.SetConfidence(v)
+	return _c
+}
+
+// SetNillableConfidence sets the "confidence" field if the given value is not nil.
+func (_c *ReconciliationRuleCreate) SetNillableConfidence(v *int) *ReconciliationRuleCreate {
+	if v != nil {
+		_c.SetConfidence(*v)
+	}
+	return _c
+}
+
+// SetAutoMatch sets the "auto_match" field.
+func (_c *ReconciliationRuleCreate) SetAutoMatch(v bool) *ReconciliationRuleCreate {
+	_c.mutation.SetAutoMatch(v)
+	return _c
+}
+
+// SetNillableAutoMatch sets the "auto_match" field if the given value is not nil.
+func (_c *ReconciliationRuleCreate) SetNillableAutoMatch(v *bool) *ReconciliationRuleCreate {
+	if v != nil {
+		_c.SetAutoMatch(*v)
+	}
+	return _c
+}
+
+// SetActive sets the "active" field.
+func (_c *ReconciliationRuleCreate) SetActive(v bool) *ReconciliationRuleCreate {
+	_c.mutation.SetActive(v)
+	return _c
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_c *ReconciliationRuleCreate) SetNillableActive(v *bool) *ReconciliationRuleCreate {
+	if v != nil {
+		_c.SetActive(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *ReconciliationRuleCreate) SetCreatedBy(v uuid.UUID) *ReconciliationRuleCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *ReconciliationRuleCreate) SetNillableCreatedBy(v *uuid.UUID) *ReconciliationRuleCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReconciliationRuleCreate) SetCreatedAt(v time.Time) *ReconciliationRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReconciliationRuleCreate) SetNillableCreatedAt(v *time.Time) *ReconciliationRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ReconciliationRuleCreate) SetUpdatedAt(v time.Time) *ReconciliationRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ReconciliationRuleCreate) SetNillableUpdatedAt(v *time.Time) *ReconciliationRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReconciliationRuleCreate) SetID(v uuid.UUID) *ReconciliationRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ReconciliationRuleCreate) SetNillableID(v *uuid.UUID) *ReconciliationRuleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ReconciliationRuleMutation object of the builder.
+func (_c *ReconciliationRuleCreate) Mutation() *ReconciliationRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the ReconciliationRule in the database.
+func (_c *ReconciliationRuleCreate) Save(ctx context.Context) (*ReconciliationRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReconciliationRuleCreate) SaveX(ctx context.Context) *ReconciliationRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReconciliationRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReconciliationRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReconciliationRuleCreate) defaults() {
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := reconciliationrule.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.MatchField(); !ok {
+		v := reconciliationrule.DefaultMatchField
+		_c.mutation.SetMatchField(v)
+	}
+	if _, ok := _c.mutation.Counterpart(); !ok {
+		v := reconciliationrule.DefaultCounterpart
+		_c.mutation.SetCounterpart(v)
+	}
+	if _, ok := _c.mutation.DateWindowDays(); !ok {
+		v := reconciliationrule.DefaultDateWindowDays
+		_c.mutation.SetDateWindowDays(v)
+	}
+	if _, ok := _c.mutation.Confidence(); !ok {
+		v := reconciliationrule.DefaultConfidence
+		_c.mutation.SetConfidence(v)
+	}
+	if _, ok := _c.mutation.AutoMatch(); !ok {
+		v := reconciliationrule.DefaultAutoMatch
+		_c.mutation.SetAutoMatch(v)
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		v := reconciliationrule.DefaultActive
+		_c.mutation.SetActive(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := reconciliationrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := reconciliationrule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := reconciliationrule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReconciliationRuleCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ReconciliationRule.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ReconciliationRule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := reconciliationrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ReconciliationRule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "ReconciliationRule.priority"`)}
+	}
+	if _, ok := _c.mutation.MatchField(); !ok {
+		return &ValidationError{Name: "match_field", err: errors.New(`ent: missing required field "ReconciliationRule.match_field"`)}
+	}
+	if _, ok := _c.mutation.Pattern(); !ok {
+		return &ValidationError{Name: "pattern", err: errors.New(`ent: missing required field "ReconciliationRule.pattern"`)}
+	}
+	if _, ok := _c.mutation.Counterpart(); !ok {
+		return &ValidationError{Name: "counterpart", err: errors.New(`ent: missing required field "ReconciliationRule.counterpart"`)}
+	}
+	if _, ok := _c.mutation.DateWindowDays(); !ok {
+		return &ValidationError{Name: "date_window_days", err: errors.New(`ent: missing required field "ReconciliationRule.date_window_days"`)}
+	}
+	if _, ok := _c.mutation.Confidence(); !ok {
+		return &ValidationError{Name: "confidence", err: errors.New(`ent: missing required field "ReconciliationRule.confidence"`)}
+	}
+	if _, ok := _c.mutation.AutoMatch(); !ok {
+		return &ValidationError{Name: "auto_match", err: errors.New(`ent: missing required field "ReconciliationRule.auto_match"`)}
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "ReconciliationRule.active"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReconciliationRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReconciliationRule.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ReconciliationRuleCreate) sqlSave(ctx context.Context) (*ReconciliationRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReconciliationRuleCreate) createSpec() (*ReconciliationRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReconciliationRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(reconciliationrule.Table, sqlgraph.NewFieldSpec(reconciliationrule.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(reconciliationrule.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.BankAccountID(); ok {
+		_spec.SetField(reconciliationrule.FieldBankAccountID, field.TypeUUID, value)
+		_node.BankAccountID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(reconciliationrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(reconciliationrule.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.MatchField(); ok {
+		_spec.SetField(reconciliationrule.FieldMatchField, field.TypeString, value)
+		_node.MatchField = value
+	}
+	if value, ok := _c.mutation.Pattern(); ok {
+		_spec.SetField(reconciliationrule.FieldPattern, field.TypeString, value)
+		_node.Pattern = value
+	}
+	if value, ok := _c.mutation.Counterpart(); ok {
+		_spec.SetField(reconciliationrule.FieldCounterpart, field.TypeString, value)
+		_node.Counterpart = value
+	}
+	if value, ok := _c.mutation.CounterpartPattern(); ok {
+		_spec.SetField(reconciliationrule.FieldCounterpartPattern, field.TypeString, value)
+		_node.CounterpartPattern = value
+	}
+	if value, ok := _c.mutation.AmountTolerance(); ok {
+		_spec.SetField(reconciliationrule.FieldAmountTolerance, field.TypeFloat64, value)
+		_node.AmountTolerance = value
+	}
+	if value, ok := _c.mutation.DateWindowDays(); ok {
+		_spec.SetField(reconciliationrule.FieldDateWindowDays, field.TypeInt, value)
+		_node.DateWindowDays = value
+	}
+	if value, ok := _c.mutation.Confidence(); ok {
+		_spec.SetField(reconciliationrule.FieldConfidence, field.TypeInt, value)
+		_node.Confidence = value
+	}
+	if value, ok := _c.mutation.AutoMatch(); ok {
+		_spec.SetField(reconciliationrule.FieldAutoMatch, field.TypeBool, value)
+		_node.AutoMatch = value
+	}
+	if value, ok := _c.mutation.Active(); ok {
+		_spec.SetField(reconciliationrule.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(reconciliationrule.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(reconciliationrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(reconciliationrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReconciliationRule.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReconciliationRuleUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReconciliationRuleCreate) OnConflict(opts ...sql.ConflictOption) *ReconciliationRuleUpsertOne {
+	_c.conflict = opts
+	return &ReconciliationRuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReconciliationRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReconciliationRuleCreate) OnConflictColumns(columns ...string) *ReconciliationRuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReconciliationRuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReconciliationRuleUpsertOne is the builder for "upsert"-ing
+	//  one ReconciliationRule node.
+	ReconciliationRuleUpsertOne struct {
+		create *ReconciliationRuleCreate
+	}
+
+	// ReconciliationRuleUpsert is the "OnConflict" setter.
+	ReconciliationRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *ReconciliationRuleUpsert) SetTenantID(v uuid.UUID) *ReconciliationRuleUpsert {
+	u.Set(reconciliationrule.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsert) UpdateTenantID() *ReconciliationRuleUpsert {
+	u.SetExcluded(reconciliationrule.FieldTenantID)
+	return u
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (u *ReconciliationRuleUpsert) SetBankAccountID(v uuid.UUID) *ReconciliationRuleUpsert {
+	u.Set(reconciliationrule.FieldBankAccountID, v)
+	return u
+}
+
+// UpdateBankAccountID sets the "bank_account_id" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsert) UpdateBankAccountID() *ReconciliationRuleUpsert {
+	u.SetExcluded(reconciliationrule.FieldBankAccountID)
+	return u
+}
+
+// ClearBankAccountID clears the value of the "bank_account_id" field.
+func (u *ReconciliationRuleUpsert) ClearBankAccountID() *ReconciliationRuleUpsert {
+	u.SetNull(reconciliationrule.FieldBankAccountID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ReconciliationRuleUpsert) SetName(v string) *ReconciliationRuleUpsert {
+	u.Set(reconciliationrule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsert) UpdateName() *ReconciliationRuleUpsert {
+	u.SetExcluded(reconciliationrule.FieldName)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *ReconciliationRuleUpsert) SetPriority(v int) *ReconciliationRuleUpsert {
+	u.Set(reconciliationrule.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsert) UpdatePriority() *ReconciliationRuleUpsert {
+	u.SetExcluded(reconciliationrule.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *ReconciliationRuleUpsert) AddPriority(v int) *ReconciliationRuleUpsert {
+	u.Add(reconciliationrule.FieldPriority, v)
+	return u
+}
+
+// SetMatchField sets the "match_field" field.
+func (u *ReconciliationRuleUpsert) SetMatchField(v string) *ReconciliationRuleUpsert {
+	u.Set(reconciliationrule.FieldMatchField, v)
+	return u
+}
+
+// UpdateMatchField sets the "match_field" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsert) UpdateMatchField() *ReconciliationRuleUpsert {
+	u.SetExcluded(reconciliationrule.FieldMatchField)
+	return u
+}
+
+// SetPattern sets the "pattern" field.
+func (u *ReconciliationRuleUpsert) SetPattern(v string) *ReconciliationRuleUpsert {
+	u.Set(reconciliationrule.FieldPattern, v)
+	return u
+}
+
+// UpdatePattern sets the "pattern" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsert) UpdatePattern() *ReconciliationRuleUpsert {
+	u.SetExcluded(reconciliationrule.FieldPattern)
+	return u
+}
+
+// SetCounterpart sets the "counterpart" field.
+func (u *ReconciliationRuleUpsert) SetCounterpart(v string) *ReconciliationRuleUpsert {
+	u.Set(reconciliationrule.FieldCounterpart, v)
+	return u
+}
+
+// UpdateCounterpart sets the "counterpart" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsert) UpdateCounterpart() *ReconciliationRuleUpsert {
+	u.SetExcluded(reconciliationrule.FieldCounterpart)
+	return u
+}
+
+// SetCounterpartPattern sets the "counterpart_pattern" field.
+func (u *ReconciliationRuleUpsert) SetCounterpartPattern(v string) *ReconciliationRuleUpsert {
+	u.Set(reconciliationrule.FieldCounterpartPattern, v)
+	return u
+}
+
+// UpdateCounterpartPattern sets the "counterpart_pattern" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsert) UpdateCounterpartPattern() *ReconciliationRuleUpsert {
+	u.SetExcluded(reconciliationrule.FieldCounterpartPattern)
+	return u
+}
+
+// ClearCounterpartPattern clears the value of the "counterpart_pattern" field.
+func (u *ReconciliationRuleUpsert) ClearCounterpartPattern() *ReconciliationRuleUpsert {
+	u.SetNull(reconciliationrule.FieldCounterpartPattern)
+	return u
+}
+
+// SetAmountTolerance sets the "amount_tolerance" field.
+func (u *ReconciliationRuleUpsert) SetAmountTolerance(v decimal.Decimal) *ReconciliationRuleUpsert {
+	u.Set(reconciliationrule.FieldAmountTolerance, v)
+	return u
+}
+
+// UpdateAmountTolerance sets the "amount_tolerance" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsert) UpdateAmountTolerance() *ReconciliationRuleUpsert {
+	u.SetExcluded(reconciliationrule.FieldAmountTolerance)
+	return u
+}
+
+// AddAmountTolerance adds v to the "amount_tolerance" field.
+func (u *ReconciliationRuleUpsert) AddAmountTolerance(v decimal.Decimal) *ReconciliationRuleUpsert {
+	u.Add(reconciliationrule.FieldAmountTolerance, v)
+	return u
+}
+
+// ClearAmountTolerance clears the value of the "amount_tolerance" field.
+func (u *ReconciliationRuleUpsert) ClearAmountTolerance() *ReconciliationRuleUpsert {
+	u.SetNull(reconciliationrule.FieldAmountTolerance)
+	return u
+}
+
+// SetDateWindowDays sets the "date_window_days" field.
+func (u *ReconciliationRuleUpsert) SetDateWindowDays(v int) *ReconciliationRuleUpsert {
+	u.Set(reconciliationrule.FieldDateWindowDays, v)
+	return u
+}
+
+// UpdateDateWindowDays sets the "date_window_days" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsert) UpdateDateWindowDays() *ReconciliationRuleUpsert {
+	u.SetExcluded(reconciliationrule.FieldDateWindowDays)
+	return u
+}
+
+// AddDateWindowDays adds v to the "date_window_days" field.
+func (u *ReconciliationRuleUpsert) AddDateWindowDays(v int) *ReconciliationRuleUpsert {
+	u.Add(reconciliationrule.FieldDateWindowDays, v)
+	return u
+}
+
+// SetConfidence sets the "confidence" field.
+func (u *ReconciliationRuleUpsert) SetConfidence(v int) *ReconciliationRuleUpsert {
+	u.Set(reconciliationrule.FieldConfidence, v)
+	return u
+}
+
+// UpdateConfidence sets the "confidence" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsert) UpdateConfidence() *ReconciliationRuleUpsert {
+	u.SetExcluded(reconciliationrule.FieldConfidence)
+	return u
+}
+
+// AddConfidence adds v to the "confidence" field.
+func (u *ReconciliationRuleUpsert) AddConfidence(v int) *ReconciliationRuleUpsert {
+	u.Add(reconciliationrule.FieldConfidence, v)
+	return u
+}
+
+// SetAutoMatch sets the "auto_match" field.
+func (u *ReconciliationRuleUpsert) SetAutoMatch(v bool) *ReconciliationRuleUpsert {
+	u.Set(reconciliationrule.FieldAutoMatch, v)
+	return u
+}
+
+// UpdateAutoMatch sets the "auto_match" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsert) UpdateAutoMatch() *ReconciliationRuleUpsert {
+	u.SetExcluded(reconciliationrule.FieldAutoMatch)
+	return u
+}
+
+// SetActive sets the "active" field.
+func (u *ReconciliationRuleUpsert) SetActive(v bool) *ReconciliationRuleUpsert {
+	u.Set(reconciliationrule.FieldActive, v)
+	return u
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsert) UpdateActive() *ReconciliationRuleUpsert {
+	u.SetExcluded(reconciliationrule.FieldActive)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ReconciliationRuleUpsert) SetCreatedBy(v uuid.UUID) *ReconciliationRuleUpsert {
+	u.Set(reconciliationrule.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsert) UpdateCreatedBy() *ReconciliationRuleUpsert {
+	u.SetExcluded(reconciliationrule.FieldCreatedBy)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ReconciliationRuleUpsert) ClearCreatedBy() *ReconciliationRuleUpsert {
+	u.SetNull(reconciliationrule.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReconciliationRuleUpsert) SetUpdatedAt(v time.Time) *ReconciliationRuleUpsert {
+	u.Set(reconciliationrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsert) UpdateUpdatedAt() *ReconciliationRuleUpsert {
+	u.SetExcluded(reconciliationrule.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReconciliationRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reconciliationrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReconciliationRuleUpsertOne) UpdateNewValues() *ReconciliationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reconciliationrule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(reconciliationrule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReconciliationRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReconciliationRuleUpsertOne) Ignore() *ReconciliationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReconciliationRuleUpsertOne) DoNothing() *ReconciliationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReconciliationRuleCreate.OnConflict
+// documentation for more info.
+func (u *ReconciliationRuleUpsertOne) Update(set func(*ReconciliationRuleUpsert)) *ReconciliationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReconciliationRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *ReconciliationRuleUpsertOne) SetTenantID(v uuid.UUID) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertOne) UpdateTenantID() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (u *ReconciliationRuleUpsertOne) SetBankAccountID(v uuid.UUID) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetBankAccountID(v)
+	})
+}
+
+// UpdateBankAccountID sets the "bank_account_id" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertOne) UpdateBankAccountID() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateBankAccountID()
+	})
+}
+
+// ClearBankAccountID clears the value of the "bank_account_id" field.
+func (u *ReconciliationRuleUpsertOne) ClearBankAccountID() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.ClearBankAccountID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ReconciliationRuleUpsertOne) SetName(v string) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertOne) UpdateName() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *ReconciliationRuleUpsertOne) SetPriority(v int) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *ReconciliationRuleUpsertOne) AddPriority(v int) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertOne) UpdatePriority() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetMatchField sets the "match_field" field.
+func (u *ReconciliationRuleUpsertOne) SetMatchField(v string) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetMatchField(v)
+	})
+}
+
+// UpdateMatchField sets the "match_field" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertOne) UpdateMatchField() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateMatchField()
+	})
+}
+
+// SetPattern sets the "pattern" field.
+func (u *ReconciliationRuleUpsertOne) SetPattern(v string) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetPattern(v)
+	})
+}
+
+// UpdatePattern sets the "pattern" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertOne) UpdatePattern() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdatePattern()
+	})
+}
+
+// SetCounterpart sets the "counterpart" field.
+func (u *ReconciliationRuleUpsertOne) SetCounterpart(v string) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetCounterpart(v)
+	})
+}
+
+// UpdateCounterpart sets the "counterpart" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertOne) UpdateCounterpart() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateCounterpart()
+	})
+}
+
+// SetCounterpartPattern sets the "counterpart_pattern" field.
+func (u *ReconciliationRuleUpsertOne) SetCounterpartPattern(v string) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetCounterpartPattern(v)
+	})
+}
+
+// UpdateCounterpartPattern sets the "counterpart_pattern" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertOne) UpdateCounterpartPattern() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateCounterpartPattern()
+	})
+}
+
+// ClearCounterpartPattern clears the value of the "counterpart_pattern" field.
+func (u *ReconciliationRuleUpsertOne) ClearCounterpartPattern() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.ClearCounterpartPattern()
+	})
+}
+
+// SetAmountTolerance sets the "amount_tolerance" field.
+func (u *ReconciliationRuleUpsertOne) SetAmountTolerance(v decimal.Decimal) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetAmountTolerance(v)
+	})
+}
+
+// AddAmountTolerance adds v to the "amount_tolerance" field.
+func (u *ReconciliationRuleUpsertOne) AddAmountTolerance(v decimal.Decimal) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.AddAmountTolerance(v)
+	})
+}
+
+// UpdateAmountTolerance sets the "amount_tolerance" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertOne) UpdateAmountTolerance() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateAmountTolerance()
+	})
+}
+
+// ClearAmountTolerance clears the value of the "amount_tolerance" field.
+func (u *ReconciliationRuleUpsertOne) ClearAmountTolerance() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.ClearAmountTolerance()
+	})
+}
+
+// SetDateWindowDays sets the "date_window_days" field.
+func (u *ReconciliationRuleUpsertOne) SetDateWindowDays(v int) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetDateWindowDays(v)
+	})
+}
+
+// AddDateWindowDays adds v to the "date_window_days" field.
+func (u *ReconciliationRuleUpsertOne) AddDateWindowDays(v int) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.AddDateWindowDays(v)
+	})
+}
+
+// UpdateDateWindowDays sets the "date_window_days" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertOne) UpdateDateWindowDays() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateDateWindowDays()
+	})
+}
+
+// SetConfidence sets the "confidence" field.
+func (u *ReconciliationRuleUpsertOne) SetConfidence(v int) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetConfidence(v)
+	})
+}
+
+// AddConfidence adds v to the "confidence" field.
+func (u *ReconciliationRuleUpsertOne) AddConfidence(v int) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.AddConfidence(v)
+	})
+}
+
+// UpdateConfidence sets the "confidence" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertOne) UpdateConfidence() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateConfidence()
+	})
+}
+
+// SetAutoMatch sets the "auto_match" field.
+func (u *ReconciliationRuleUpsertOne) SetAutoMatch(v bool) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetAutoMatch(v)
+	})
+}
+
+// UpdateAutoMatch sets the "auto_match" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertOne) UpdateAutoMatch() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateAutoMatch()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *ReconciliationRuleUpsertOne) SetActive(v bool) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertOne) UpdateActive() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ReconciliationRuleUpsertOne) SetCreatedBy(v uuid.UUID) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertOne) UpdateCreatedBy() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ReconciliationRuleUpsertOne) ClearCreatedBy() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReconciliationRuleUpsertOne) SetUpdatedAt(v time.Time) *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertOne) UpdateUpdatedAt() *ReconciliationRuleUpsertOne {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReconciliationRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReconciliationRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReconciliationRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReconciliationRuleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReconciliationRuleUpsertOne.ID is not supported by MySQL driver. Use ReconciliationRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReconciliationRuleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReconciliationRuleCreateBulk is the builder for creating many ReconciliationRule entities in bulk.
+type ReconciliationRuleCreateBulk struct {
+	config
+	err      error
+	builders []*ReconciliationRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReconciliationRule entities in the database.
+func (_c *ReconciliationRuleCreateBulk) Save(ctx context.Context) ([]*ReconciliationRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReconciliationRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReconciliationRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReconciliationRuleCreateBulk) SaveX(ctx context.Context) []*ReconciliationRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReconciliationRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReconciliationRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReconciliationRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReconciliationRuleUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReconciliationRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReconciliationRuleUpsertBulk {
+	_c.conflict = opts
+	return &ReconciliationRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReconciliationRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReconciliationRuleCreateBulk) OnConflictColumns(columns ...string) *ReconciliationRuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReconciliationRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReconciliationRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReconciliationRule nodes.
+type ReconciliationRuleUpsertBulk struct {
+	create *ReconciliationRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReconciliationRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reconciliationrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReconciliationRuleUpsertBulk) UpdateNewValues() *ReconciliationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reconciliationrule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(reconciliationrule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReconciliationRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReconciliationRuleUpsertBulk) Ignore() *ReconciliationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReconciliationRuleUpsertBulk) DoNothing() *ReconciliationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReconciliationRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReconciliationRuleUpsertBulk) Update(set func(*ReconciliationRuleUpsert)) *ReconciliationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReconciliationRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *ReconciliationRuleUpsertBulk) SetTenantID(v uuid.UUID) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertBulk) UpdateTenantID() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (u *ReconciliationRuleUpsertBulk) SetBankAccountID(v uuid.UUID) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetBankAccountID(v)
+	})
+}
+
+// UpdateBankAccountID sets the "bank_account_id" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertBulk) UpdateBankAccountID() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateBankAccountID()
+	})
+}
+
+// ClearBankAccountID clears the value of the "bank_account_id" field.
+func (u *ReconciliationRuleUpsertBulk) ClearBankAccountID() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.ClearBankAccountID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ReconciliationRuleUpsertBulk) SetName(v string) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertBulk) UpdateName() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *ReconciliationRuleUpsertBulk) SetPriority(v int) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *ReconciliationRuleUpsertBulk) AddPriority(v int) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertBulk) UpdatePriority() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetMatchField sets the "match_field" field.
+func (u *ReconciliationRuleUpsertBulk) SetMatchField(v string) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetMatchField(v)
+	})
+}
+
+// UpdateMatchField sets the "match_field" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertBulk) UpdateMatchField() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateMatchField()
+	})
+}
+
+// SetPattern sets the "pattern" field.
+func (u *ReconciliationRuleUpsertBulk) SetPattern(v string) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetPattern(v)
+	})
+}
+
+// UpdatePattern sets the "pattern" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertBulk) UpdatePattern() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdatePattern()
+	})
+}
+
+// SetCounterpart sets the "counterpart" field.
+func (u *ReconciliationRuleUpsertBulk) SetCounterpart(v string) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetCounterpart(v)
+	})
+}
+
+// UpdateCounterpart sets the "counterpart" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertBulk) UpdateCounterpart() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateCounterpart()
+	})
+}
+
+// SetCounterpartPattern sets the "counterpart_pattern" field.
+func (u *ReconciliationRuleUpsertBulk) SetCounterpartPattern(v string) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetCounterpartPattern(v)
+	})
+}
+
+// UpdateCounterpartPattern sets the "counterpart_pattern" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertBulk) UpdateCounterpartPattern() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateCounterpartPattern()
+	})
+}
+
+// ClearCounterpartPattern clears the value of the "counterpart_pattern" field.
+func (u *ReconciliationRuleUpsertBulk) ClearCounterpartPattern() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.ClearCounterpartPattern()
+	})
+}
+
+// SetAmountTolerance sets the "amount_tolerance" field.
+func (u *ReconciliationRuleUpsertBulk) SetAmountTolerance(v decimal.Decimal) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetAmountTolerance(v)
+	})
+}
+
+// AddAmountTolerance adds v to the "amount_tolerance" field.
+func (u *ReconciliationRuleUpsertBulk) AddAmountTolerance(v decimal.Decimal) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.AddAmountTolerance(v)
+	})
+}
+
+// UpdateAmountTolerance sets the "amount_tolerance" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertBulk) UpdateAmountTolerance() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateAmountTolerance()
+	})
+}
+
+// ClearAmountTolerance clears the value of the "amount_tolerance" field.
+func (u *ReconciliationRuleUpsertBulk) ClearAmountTolerance() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.ClearAmountTolerance()
+	})
+}
+
+// SetDateWindowDays sets the "date_window_days" field.
+func (u *ReconciliationRuleUpsertBulk) SetDateWindowDays(v int) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetDateWindowDays(v)
+	})
+}
+
+// AddDateWindowDays adds v to the "date_window_days" field.
+func (u *ReconciliationRuleUpsertBulk) AddDateWindowDays(v int) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.AddDateWindowDays(v)
+	})
+}
+
+// UpdateDateWindowDays sets the "date_window_days" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertBulk) UpdateDateWindowDays() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateDateWindowDays()
+	})
+}
+
+// SetConfidence sets the "confidence" field.
+func (u *ReconciliationRuleUpsertBulk) SetConfidence(v int) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetConfidence(v)
+	})
+}
+
+// AddConfidence adds v to the "confidence" field.
+func (u *ReconciliationRuleUpsertBulk) AddConfidence(v int) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.AddConfidence(v)
+	})
+}
+
+// UpdateConfidence sets the "confidence" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertBulk) UpdateConfidence() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateConfidence()
+	})
+}
+
+// SetAutoMatch sets the "auto_match" field.
+func (u *ReconciliationRuleUpsertBulk) SetAutoMatch(v bool) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetAutoMatch(v)
+	})
+}
+
+// UpdateAutoMatch sets the "auto_match" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertBulk) UpdateAutoMatch() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateAutoMatch()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *ReconciliationRuleUpsertBulk) SetActive(v bool) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertBulk) UpdateActive() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ReconciliationRuleUpsertBulk) SetCreatedBy(v uuid.UUID) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertBulk) UpdateCreatedBy() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ReconciliationRuleUpsertBulk) ClearCreatedBy() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReconciliationRuleUpsertBulk) SetUpdatedAt(v time.Time) *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReconciliationRuleUpsertBulk) UpdateUpdatedAt() *ReconciliationRuleUpsertBulk {
+	return u.Update(func(s *ReconciliationRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReconciliationRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReconciliationRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReconciliationRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReconciliationRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
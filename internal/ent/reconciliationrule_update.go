@@ -0,0 +1,868 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationrule"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationRuleUpdate is the builder for updating ReconciliationRule entities.
+type ReconciliationRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReconciliationRuleMutation
+}
+
+// Where appends a list predicates to the ReconciliationRuleUpdate builder.
+func (_u *ReconciliationRuleUpdate) Where(ps ...predicate.ReconciliationRule) *ReconciliationRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ReconciliationRuleUpdate) SetTenantID(v uuid.UUID) *ReconciliationRuleUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdate) SetNillableTenantID(v *uuid.UUID) *ReconciliationRuleUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (_u *ReconciliationRuleUpdate) SetBankAccountID(v uuid.UUID) *ReconciliationRuleUpdate {
+	_u.mutation.SetBankAccountID(v)
+	return _u
+}
+
+// SetNillableBankAccountID sets the "bank_account_id" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdate) SetNillableBankAccountID(v *uuid.UUID) *ReconciliationRuleUpdate {
+	if v != nil {
+		_u.SetBankAccountID(*v)
+	}
+	return _u
+}
+
+// ClearBankAccountID clears the value of the "bank_account_id" field.
+func (_u *ReconciliationRuleUpdate) ClearBankAccountID() *ReconciliationRuleUpdate {
+	_u.mutation.ClearBankAccountID()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ReconciliationRuleUpdate) SetName(v string) *ReconciliationRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdate) SetNillableName(v *string) *ReconciliationRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *ReconciliationRuleUpdate) SetPriority(v int) *ReconciliationRuleUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdate) SetNillablePriority(v *int) *ReconciliationRuleUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *ReconciliationRuleUpdate) AddPriority(v int) *ReconciliationRuleUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetMatchField sets the "match_field" field.
+func (_u *ReconciliationRuleUpdate) SetMatchField(v string) *ReconciliationRuleUpdate {
+	_u.mutation.SetMatchField(v)
+	return _u
+}
+
+// SetNillableMatchField sets the "match_field" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdate) SetNillableMatchField(v *string) *ReconciliationRuleUpdate {
+	if v != nil {
+		_u.SetMatchField(*v)
+	}
+	return _u
+}
+
+// SetPattern sets the "pattern" field.
+func (_u *ReconciliationRuleUpdate) SetPattern(v string) *ReconciliationRuleUpdate {
+	_u.mutation.SetPattern(v)
+	return _u
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdate) SetNillablePattern(v *string) *ReconciliationRuleUpdate {
+	if v != nil {
+		_u.SetPattern(*v)
+	}
+	return _u
+}
+
+// SetCounterpart sets the "counterpart" field.
+func (_u *ReconciliationRuleUpdate) SetCounterpart(v string) *ReconciliationRuleUpdate {
+	_u.mutation.SetCounterpart(v)
+	return _u
+}
+
+// SetNillableCounterpart sets the "counterpart" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdate) SetNillableCounterpart(v *string) *ReconciliationRuleUpdate {
+	if v != nil {
+		_u.SetCounterpart(*v)
+	}
+	return _u
+}
+
+// SetCounterpartPattern sets the "counterpart_pattern" field.
+func (_u *ReconciliationRuleUpdate) SetCounterpartPattern(v string) *ReconciliationRuleUpdate {
+	_u.mutation.SetCounterpartPattern(v)
+	return _u
+}
+
+// SetNillableCounterpartPattern sets the "counterpart_pattern" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdate) SetNillableCounterpartPattern(v *string) *ReconciliationRuleUpdate {
+	if v != nil {
+		_u.SetCounterpartPattern(*v)
+	}
+	return _u
+}
+
+// ClearCounterpartPattern clears the value of the "counterpart_pattern" field.
+func (_u *ReconciliationRuleUpdate) ClearCounterpartPattern() *ReconciliationRuleUpdate {
+	_u.mutation.ClearCounterpartPattern()
+	return _u
+}
+
+// SetAmountTolerance sets the "amount_tolerance" field.
+func (_u *ReconciliationRuleUpdate) SetAmountTolerance(v decimal.Decimal) *ReconciliationRuleUpdate {
+	_u.mutation.ResetAmountTolerance()
+	_u.mutation.SetAmountTolerance(v)
+	return _u
+}
+
+// SetNillableAmountTolerance sets the "amount_tolerance" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdate) SetNillableAmountTolerance(v *decimal.Decimal) *ReconciliationRuleUpdate {
+	if v != nil {
+		_u.SetAmountTolerance(*v)
+	}
+	return _u
+}
+
+// AddAmountTolerance adds value to the "amount_tolerance" field.
+func (_u *ReconciliationRuleUpdate) AddAmountTolerance(v decimal.Decimal) *ReconciliationRuleUpdate {
+	_u.mutation.AddAmountTolerance(v)
+	return _u
+}
+
+// ClearAmountTolerance clears the value of the "amount_tolerance" field.
+func (_u *ReconciliationRuleUpdate) ClearAmountTolerance() *ReconciliationRuleUpdate {
+	_u.mutation.ClearAmountTolerance()
+	return _u
+}
+
+// SetDateWindowDays sets the "date_window_days" field.
+func (_u *ReconciliationRuleUpdate) SetDateWindowDays(v int) *ReconciliationRuleUpdate {
+	_u.mutation.ResetDateWindowDays()
+	_u.mutation.SetDateWindowDays(v)
+	return _u
+}
+
+// SetNillableDateWindowDays sets the "date_window_days" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdate) SetNillableDateWindowDays(v *int) *ReconciliationRuleUpdate {
+	if v != nil {
+		_u.SetDateWindowDays(*v)
+	}
+	return _u
+}
+
+// AddDateWindowDays adds value to the "date_window_days" field.
+func (_u *ReconciliationRuleUpdate) AddDateWindowDays(v int) *ReconciliationRuleUpdate {
+	_u.mutation.AddDateWindowDays(v)
+	return _u
+}
+
+// SetConfidence sets the "confidence" field.
+func (_u *ReconciliationRuleUpdate) SetConfidence(v int) *ReconciliationRuleUpdate {
+	_u.mutation.ResetConfidence()
+	_u.mutation.SetConfidence(v)
+	return _u
+}
+
+// SetNillableConfidence sets the "confidence" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdate) SetNillableConfidence(v *int) *ReconciliationRuleUpdate {
+	if v != nil {
+		_u.SetConfidence(*v)
+	}
+	return _u
+}
+
+// AddConfidence adds value to the "confidence" field.
+func (_u *ReconciliationRuleUpdate) AddConfidence(v int) *ReconciliationRuleUpdate {
+	_u.mutation.AddConfidence(v)
+	return _u
+}
+
+// SetAutoMatch sets the "auto_match" field.
+func (_u *ReconciliationRuleUpdate) SetAutoMatch(v bool) *ReconciliationRuleUpdate {
+	_u.mutation.SetAutoMatch(v)
+	return _u
+}
+
+// SetNillableAutoMatch sets the "auto_match" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdate) SetNillableAutoMatch(v *bool) *ReconciliationRuleUpdate {
+	if v != nil {
+		_u.SetAutoMatch(*v)
+	}
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *ReconciliationRuleUpdate) SetActive(v bool) *ReconciliationRuleUpdate {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdate) SetNillableActive(v *bool) *ReconciliationRuleUpdate {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ReconciliationRuleUpdate) SetCreatedBy(v uuid.UUID) *ReconciliationRuleUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdate) SetNillableCreatedBy(v *uuid.UUID) *ReconciliationRuleUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *ReconciliationRuleUpdate) ClearCreatedBy() *ReconciliationRuleUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReconciliationRuleUpdate) SetUpdatedAt(v time.Time) *ReconciliationRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ReconciliationRuleMutation object of the builder.
+func (_u *ReconciliationRuleUpdate) Mutation() *ReconciliationRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReconciliationRuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReconciliationRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReconciliationRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReconciliationRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReconciliationRuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := reconciliationrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReconciliationRuleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := reconciliationrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ReconciliationRule.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ReconciliationRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reconciliationrule.Table, reconciliationrule.Columns, sqlgraph.NewFieldSpec(reconciliationrule.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(reconciliationrule.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.BankAccountID(); ok {
+		_spec.SetField(reconciliationrule.FieldBankAccountID, field.TypeUUID, value)
+	}
+	if _u.mutation.BankAccountIDCleared() {
+		_spec.ClearField(reconciliationrule.FieldBankAccountID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(reconciliationrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(reconciliationrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(reconciliationrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MatchField(); ok {
+		_spec.SetField(reconciliationrule.FieldMatchField, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Pattern(); ok {
+		_spec.SetField(reconciliationrule.FieldPattern, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Counterpart(); ok {
+		_spec.SetField(reconciliationrule.FieldCounterpart, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CounterpartPattern(); ok {
+		_spec.SetField(reconciliationrule.FieldCounterpartPattern, field.TypeString, value)
+	}
+	if _u.mutation.CounterpartPatternCleared() {
+		_spec.ClearField(reconciliationrule.FieldCounterpartPattern, field.TypeString)
+	}
+	if value, ok := _u.mutation.AmountTolerance(); ok {
+		_spec.SetField(reconciliationrule.FieldAmountTolerance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountTolerance(); ok {
+		_spec.AddField(reconciliationrule.FieldAmountTolerance, field.TypeFloat64, value)
+	}
+	if _u.mutation.AmountToleranceCleared() {
+		_spec.ClearField(reconciliationrule.FieldAmountTolerance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.DateWindowDays(); ok {
+		_spec.SetField(reconciliationrule.FieldDateWindowDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDateWindowDays(); ok {
+		_spec.AddField(reconciliationrule.FieldDateWindowDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Confidence(); ok {
+		_spec.SetField(reconciliationrule.FieldConfidence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConfidence(); ok {
+		_spec.AddField(reconciliationrule.FieldConfidence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AutoMatch(); ok {
+		_spec.SetField(reconciliationrule.FieldAutoMatch, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(reconciliationrule.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(reconciliationrule.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(reconciliationrule.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(reconciliationrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reconciliationrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReconciliationRuleUpdateOne is the builder for updating a single ReconciliationRule entity.
+type ReconciliationRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReconciliationRuleMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ReconciliationRuleUpdateOne) SetTenantID(v uuid.UUID) *ReconciliationRuleUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdateOne) SetNillableTenantID(v *uuid.UUID) *ReconciliationRuleUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetBankAccountID sets the "bank_account_id" field.
+func (_u *ReconciliationRuleUpdateOne) SetBankAccountID(v uuid.UUID) *ReconciliationRuleUpdateOne {
+	_u.mutation.SetBankAccountID(v)
+	return _u
+}
+
+// SetNillableBankAccountID sets the "bank_account_id" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdateOne) SetNillableBankAccountID(v *uuid.UUID) *ReconciliationRuleUpdateOne {
+	if v != nil {
+		_u.SetBankAccountID(*v)
+	}
+	return _u
+}
+
+// ClearBankAccountID clears the value of the "bank_account_id" field.
+func (_u *ReconciliationRuleUpdateOne) ClearBankAccountID() *ReconciliationRuleUpdateOne {
+	_u.mutation.ClearBankAccountID()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ReconciliationRuleUpdateOne) SetName(v string) *ReconciliationRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdateOne) SetNillableName(v *string) *ReconciliationRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *ReconciliationRuleUpdateOne) SetPriority(v int) *ReconciliationRuleUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdateOne) SetNillablePriority(v *int) *ReconciliationRuleUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *ReconciliationRuleUpdateOne) AddPriority(v int) *ReconciliationRuleUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetMatchField sets the "match_field" field.
+func (_u *ReconciliationRuleUpdateOne) SetMatchField(v string) *ReconciliationRuleUpdateOne {
+	_u.mutation.SetMatchField(v)
+	return _u
+}
+
+// SetNillableMatchField sets the "match_field" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdateOne) SetNillableMatchField(v *string) *ReconciliationRuleUpdateOne {
+	if v != nil {
+		_u.SetMatchField(*v)
+	}
+	return _u
+}
+
+// SetPattern sets the "pattern" field.
+func (_u *ReconciliationRuleUpdateOne) SetPattern(v string) *ReconciliationRuleUpdateOne {
+	_u.mutation.SetPattern(v)
+	return _u
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdateOne) SetNillablePattern(v *string) *ReconciliationRuleUpdateOne {
+	if v != nil {
+		_u.SetPattern(*v)
+	}
+	return _u
+}
+
+// SetCounterpart sets the "counterpart" field.
+func (_u *ReconciliationRuleUpdateOne) SetCounterpart(v string) *ReconciliationRuleUpdateOne {
+	_u.mutation.SetCounterpart(v)
+	return _u
+}
+
+// SetNillableCounterpart sets the "counterpart" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdateOne) SetNillableCounterpart(v *string) *ReconciliationRuleUpdateOne {
+	if v != nil {
+		_u.SetCounterpart(*v)
+	}
+	return _u
+}
+
+// SetCounterpartPattern sets the "counterpart_pattern" field.
+func (_u *ReconciliationRuleUpdateOne) SetCounterpartPattern(v string) *ReconciliationRuleUpdateOne {
+	_u.mutation.SetCounterpartPattern(v)
+	return _u
+}
+
+// SetNillableCounterpartPattern sets the "counterpart_pattern" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdateOne) SetNillableCounterpartPattern(v *string) *ReconciliationRuleUpdateOne {
+	if v != nil {
+		_u.SetCounterpartPattern(*v)
+	}
+	return _u
+}
+
+// ClearCounterpartPattern clears the value of the "counterpart_pattern" field.
+func (_u *ReconciliationRuleUpdateOne) ClearCounterpartPattern() *ReconciliationRuleUpdateOne {
+	_u.mutation.ClearCounterpartPattern()
+	return _u
+}
+
+// SetAmountTolerance sets the "amount_tolerance" field.
+func (_u *ReconciliationRuleUpdateOne) SetAmountTolerance(v decimal.Decimal) *ReconciliationRuleUpdateOne {
+	_u.mutation.ResetAmountTolerance()
+	_u.mutation.SetAmountTolerance(v)
+	return _u
+}
+
+// SetNillableAmountTolerance sets the "amount_tolerance" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdateOne) SetNillableAmountTolerance(v *decimal.Decimal) *ReconciliationRuleUpdateOne {
+	if v != nil {
+		_u.SetAmountTolerance(*v)
+	}
+	return _u
+}
+
+// AddAmountTolerance adds value to the "amount_tolerance" field.
+func (_u *ReconciliationRuleUpdateOne) AddAmountTolerance(v decimal.Decimal) *ReconciliationRuleUpdateOne {
+	_u.mutation.AddAmountTolerance(v)
+	return _u
+}
+
+// ClearAmountTolerance clears the value of the "amount_tolerance" field.
+func (_u *ReconciliationRuleUpdateOne) ClearAmountTolerance() *ReconciliationRuleUpdateOne {
+	_u.mutation.ClearAmountTolerance()
+	return _u
+}
+
+// SetDateWindowDays sets the "date_window_days" field.
+func (_u *ReconciliationRuleUpdateOne) SetDateWindowDays(v int) *ReconciliationRuleUpdateOne {
+	_u.mutation.ResetDateWindowDays()
+	_u.mutation.SetDateWindowDays(v)
+	return _u
+}
+
+// SetNillableDateWindowDays sets the "date_window_days" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdateOne) SetNillableDateWindowDays(v *int) *ReconciliationRuleUpdateOne {
+	if v != nil {
+		_u.SetDateWindowDays(*v)
+	}
+	return _u
+}
+
+// AddDateWindowDays adds value to the "date_window_days" field.
+func (_u *ReconciliationRuleUpdateOne) AddDateWindowDays(v int) *ReconciliationRuleUpdateOne {
+	_u.mutation.AddDateWindowDays(v)
+	return _u
+}
+
+// SetConfidence sets the "confidence" field.
+func (_u *ReconciliationRuleUpdateOne) SetConfidence(v int) *ReconciliationRuleUpdateOne {
+	_u.mutation.ResetConfidence()
+	_u.mutation.SetConfidence(v)
+	return _u
+}
+
+// SetNillableConfidence sets the "confidence" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdateOne) SetNillableConfidence(v *int) *ReconciliationRuleUpdateOne {
+	if v != nil {
+		_u.SetConfidence(*v)
+	}
+	return _u
+}
+
+// AddConfidence adds value to the "confidence" field.
+func (_u *ReconciliationRuleUpdateOne) AddConfidence(v int) *ReconciliationRuleUpdateOne {
+	_u.mutation.AddConfidence(v)
+	return _u
+}
+
+// SetAutoMatch sets the "auto_match" field.
+func (_u *ReconciliationRuleUpdateOne) SetAutoMatch(v bool) *ReconciliationRuleUpdateOne {
+	_u.mutation.SetAutoMatch(v)
+	return _u
+}
+
+// SetNillableAutoMatch sets the "auto_match" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdateOne) SetNillableAutoMatch(v *bool) *ReconciliationRuleUpdateOne {
+	if v != nil {
+		_u.SetAutoMatch(*v)
+	}
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *ReconciliationRuleUpdateOne) SetActive(v bool) *ReconciliationRuleUpdateOne {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdateOne) SetNillableActive(v *bool) *ReconciliationRuleUpdateOne {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ReconciliationRuleUpdateOne) SetCreatedBy(v uuid.UUID) *ReconciliationRuleUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ReconciliationRuleUpdateOne) SetNillableCreatedBy(v *uuid.UUID) *ReconciliationRuleUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *ReconciliationRuleUpdateOne) ClearCreatedBy() *ReconciliationRuleUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReconciliationRuleUpdateOne) SetUpdatedAt(v time.Time) *ReconciliationRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ReconciliationRuleMutation object of the builder.
+func (_u *ReconciliationRuleUpdateOne) Mutation() *ReconciliationRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ReconciliationRuleUpdate builder.
+func (_u *ReconciliationRuleUpdateOne) Where(ps ...predicate.ReconciliationRule) *ReconciliationRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReconciliationRuleUpdateOne) Select(field string, fields ...string) *ReconciliationRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReconciliationRule entity.
+func (_u *ReconciliationRuleUpdateOne) Save(ctx context.Context) (*ReconciliationRule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReconciliationRuleUpdateOne) SaveX(ctx context.Context) *ReconciliationRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReconciliationRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReconciliationRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReconciliationRuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := reconciliationrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReconciliationRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := reconciliationrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ReconciliationRule.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ReconciliationRuleUpdateOne) sqlSave(ctx context.Context) (_node *ReconciliationRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reconciliationrule.Table, reconciliationrule.Columns, sqlgraph.NewFieldSpec(reconciliationrule.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReconciliationRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reconciliationrule.FieldID)
+		for _, f := range fields {
+			if !reconciliationrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reconciliationrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(reconciliationrule.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.BankAccountID(); ok {
+		_spec.SetField(reconciliationrule.FieldBankAccountID, field.TypeUUID, value)
+	}
+	if _u.mutation.BankAccountIDCleared() {
+		_spec.ClearField(reconciliationrule.FieldBankAccountID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(reconciliationrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(reconciliationrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(reconciliationrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MatchField(); ok {
+		_spec.SetField(reconciliationrule.FieldMatchField, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Pattern(); ok {
+		_spec.SetField(reconciliationrule.FieldPattern, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Counterpart(); ok {
+		_spec.SetField(reconciliationrule.FieldCounterpart, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CounterpartPattern(); ok {
+		_spec.SetField(reconciliationrule.FieldCounterpartPattern, field.TypeString, value)
+	}
+	if _u.mutation.CounterpartPatternCleared() {
+		_spec.ClearField(reconciliationrule.FieldCounterpartPattern, field.TypeString)
+	}
+	if value, ok := _u.mutation.AmountTolerance(); ok {
+		_spec.SetField(reconciliationrule.FieldAmountTolerance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountTolerance(); ok {
+		_spec.AddField(reconciliationrule.FieldAmountTolerance, field.TypeFloat64, value)
+	}
+	if _u.mutation.AmountToleranceCleared() {
+		_spec.ClearField(reconciliationrule.FieldAmountTolerance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.DateWindowDays(); ok {
+		_spec.SetField(reconciliationrule.FieldDateWindowDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDateWindowDays(); ok {
+		_spec.AddField(reconciliationrule.FieldDateWindowDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Confidence(); ok {
+		_spec.SetField(reconciliationrule.FieldConfidence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConfidence(); ok {
+		_spec.AddField(reconciliationrule.FieldConfidence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AutoMatch(); ok {
+		_spec.SetField(reconciliationrule.FieldAutoMatch, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(reconciliationrule.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(reconciliationrule.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(reconciliationrule.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(reconciliationrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ReconciliationRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reconciliationrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -5,17 +5,68 @@ package ent
 import (
 	"time"
 
+	"github.com/bengobox/treasury-api/internal/ent/bankaccount"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatement"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatementprofile"
+	"github.com/bengobox/treasury-api/internal/ent/banktransaction"
+	"github.com/bengobox/treasury-api/internal/ent/billingcycle"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawercount"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawersession"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawersetting"
 	"github.com/bengobox/treasury-api/internal/ent/chartofaccount"
+	"github.com/bengobox/treasury-api/internal/ent/creditoverride"
+	"github.com/bengobox/treasury-api/internal/ent/customer"
+	"github.com/bengobox/treasury-api/internal/ent/customerstatement"
+	"github.com/bengobox/treasury-api/internal/ent/documentsequence"
+	"github.com/bengobox/treasury-api/internal/ent/dunningnotice"
+	"github.com/bengobox/treasury-api/internal/ent/dunningpause"
+	"github.com/bengobox/treasury-api/internal/ent/dunningstep"
+	"github.com/bengobox/treasury-api/internal/ent/earningssetting"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceipt"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceiptline"
 	"github.com/bengobox/treasury-api/internal/ent/invoice"
+	"github.com/bengobox/treasury-api/internal/ent/invoiceline"
+	"github.com/bengobox/treasury-api/internal/ent/invoicepayment"
+	"github.com/bengobox/treasury-api/internal/ent/invoicesetting"
 	"github.com/bengobox/treasury-api/internal/ent/ledgertransaction"
 	"github.com/bengobox/treasury-api/internal/ent/outboxevent"
+	"github.com/bengobox/treasury-api/internal/ent/payablesetting"
+	"github.com/bengobox/treasury-api/internal/ent/payeepayout"
+	"github.com/bengobox/treasury-api/internal/ent/payeewallet"
+	"github.com/bengobox/treasury-api/internal/ent/payeewalletentry"
 	"github.com/bengobox/treasury-api/internal/ent/paymentintent"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrun"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrunitem"
 	"github.com/bengobox/treasury-api/internal/ent/paymenttransaction"
+	"github.com/bengobox/treasury-api/internal/ent/provisionpolicy"
+	"github.com/bengobox/treasury-api/internal/ent/provisionrun"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliation"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatch"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatchitem"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationrule"
 	"github.com/bengobox/treasury-api/internal/ent/schema"
+	"github.com/bengobox/treasury-api/internal/ent/settlementbatch"
+	"github.com/bengobox/treasury-api/internal/ent/settlementdisbursement"
+	"github.com/bengobox/treasury-api/internal/ent/settlementitem"
+	"github.com/bengobox/treasury-api/internal/ent/settlementsetting"
+	"github.com/bengobox/treasury-api/internal/ent/subscription"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionadjustment"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionmeter"
 	"github.com/bengobox/treasury-api/internal/ent/treasurypermission"
 	"github.com/bengobox/treasury-api/internal/ent/treasuryrole"
 	"github.com/bengobox/treasury-api/internal/ent/treasuryuser"
+	"github.com/bengobox/treasury-api/internal/ent/usagerecord"
 	"github.com/bengobox/treasury-api/internal/ent/userroleassignment"
+	"github.com/bengobox/treasury-api/internal/ent/vendor"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbill"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbillline"
+	"github.com/bengobox/treasury-api/internal/ent/wallet"
+	"github.com/bengobox/treasury-api/internal/ent/wallethold"
+	"github.com/bengobox/treasury-api/internal/ent/wallettransaction"
+	"github.com/bengobox/treasury-api/internal/ent/withholdingcertificate"
+	"github.com/bengobox/treasury-api/internal/ent/withholdingrate"
+	"github.com/bengobox/treasury-api/internal/ent/writeoff"
+	"github.com/bengobox/treasury-api/internal/ent/writeoffrecovery"
 	"github.com/google/uuid"
 )
 
@@ -23,6 +74,216 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	bankaccountFields := schema.BankAccount{}.Fields()
+	_ = bankaccountFields
+	// bankaccountDescAccountName is the schema descriptor for account_name field.
+	bankaccountDescAccountName := bankaccountFields[2].Descriptor()
+	// bankaccount.AccountNameValidator is a validator for the "account_name" field. It is called by the builders before save.
+	bankaccount.AccountNameValidator = bankaccountDescAccountName.Validators[0].(func(string) error)
+	// bankaccountDescBankName is the schema descriptor for bank_name field.
+	bankaccountDescBankName := bankaccountFields[3].Descriptor()
+	// bankaccount.BankNameValidator is a validator for the "bank_name" field. It is called by the builders before save.
+	bankaccount.BankNameValidator = bankaccountDescBankName.Validators[0].(func(string) error)
+	// bankaccountDescAccountType is the schema descriptor for account_type field.
+	bankaccountDescAccountType := bankaccountFields[5].Descriptor()
+	// bankaccount.AccountTypeValidator is a validator for the "account_type" field. It is called by the builders before save.
+	bankaccount.AccountTypeValidator = bankaccountDescAccountType.Validators[0].(func(string) error)
+	// bankaccountDescAccountNumberMasked is the schema descriptor for account_number_masked field.
+	bankaccountDescAccountNumberMasked := bankaccountFields[6].Descriptor()
+	// bankaccount.AccountNumberMaskedValidator is a validator for the "account_number_masked" field. It is called by the builders before save.
+	bankaccount.AccountNumberMaskedValidator = bankaccountDescAccountNumberMasked.Validators[0].(func(string) error)
+	// bankaccountDescAccountNumberHash is the schema descriptor for account_number_hash field.
+	bankaccountDescAccountNumberHash := bankaccountFields[7].Descriptor()
+	// bankaccount.AccountNumberHashValidator is a validator for the "account_number_hash" field. It is called by the builders before save.
+	bankaccount.AccountNumberHashValidator = bankaccountDescAccountNumberHash.Validators[0].(func(string) error)
+	// bankaccountDescCurrency is the schema descriptor for currency field.
+	bankaccountDescCurrency := bankaccountFields[8].Descriptor()
+	// bankaccount.DefaultCurrency holds the default value on creation for the currency field.
+	bankaccount.DefaultCurrency = bankaccountDescCurrency.Default.(string)
+	// bankaccountDescLedgerAccountCode is the schema descriptor for ledger_account_code field.
+	bankaccountDescLedgerAccountCode := bankaccountFields[9].Descriptor()
+	// bankaccount.LedgerAccountCodeValidator is a validator for the "ledger_account_code" field. It is called by the builders before save.
+	bankaccount.LedgerAccountCodeValidator = bankaccountDescLedgerAccountCode.Validators[0].(func(string) error)
+	// bankaccountDescStatus is the schema descriptor for status field.
+	bankaccountDescStatus := bankaccountFields[12].Descriptor()
+	// bankaccount.DefaultStatus holds the default value on creation for the status field.
+	bankaccount.DefaultStatus = bankaccountDescStatus.Default.(string)
+	// bankaccountDescMetadata is the schema descriptor for metadata field.
+	bankaccountDescMetadata := bankaccountFields[13].Descriptor()
+	// bankaccount.DefaultMetadata holds the default value on creation for the metadata field.
+	bankaccount.DefaultMetadata = bankaccountDescMetadata.Default.(map[string]interface{})
+	// bankaccountDescCreatedAt is the schema descriptor for created_at field.
+	bankaccountDescCreatedAt := bankaccountFields[15].Descriptor()
+	// bankaccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bankaccount.DefaultCreatedAt = bankaccountDescCreatedAt.Default.(func() time.Time)
+	// bankaccountDescUpdatedAt is the schema descriptor for updated_at field.
+	bankaccountDescUpdatedAt := bankaccountFields[16].Descriptor()
+	// bankaccount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	bankaccount.DefaultUpdatedAt = bankaccountDescUpdatedAt.Default.(func() time.Time)
+	// bankaccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	bankaccount.UpdateDefaultUpdatedAt = bankaccountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// bankaccountDescID is the schema descriptor for id field.
+	bankaccountDescID := bankaccountFields[0].Descriptor()
+	// bankaccount.DefaultID holds the default value on creation for the id field.
+	bankaccount.DefaultID = bankaccountDescID.Default.(func() uuid.UUID)
+	bankstatementFields := schema.BankStatement{}.Fields()
+	_ = bankstatementFields
+	// bankstatementDescCurrency is the schema descriptor for currency field.
+	bankstatementDescCurrency := bankstatementFields[8].Descriptor()
+	// bankstatement.DefaultCurrency holds the default value on creation for the currency field.
+	bankstatement.DefaultCurrency = bankstatementDescCurrency.Default.(string)
+	// bankstatementDescLinkedCount is the schema descriptor for linked_count field.
+	bankstatementDescLinkedCount := bankstatementFields[17].Descriptor()
+	// bankstatement.DefaultLinkedCount holds the default value on creation for the linked_count field.
+	bankstatement.DefaultLinkedCount = bankstatementDescLinkedCount.Default.(int)
+	// bankstatementDescCreatedAt is the schema descriptor for created_at field.
+	bankstatementDescCreatedAt := bankstatementFields[19].Descriptor()
+	// bankstatement.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bankstatement.DefaultCreatedAt = bankstatementDescCreatedAt.Default.(func() time.Time)
+	// bankstatementDescID is the schema descriptor for id field.
+	bankstatementDescID := bankstatementFields[0].Descriptor()
+	// bankstatement.DefaultID holds the default value on creation for the id field.
+	bankstatement.DefaultID = bankstatementDescID.Default.(func() uuid.UUID)
+	bankstatementprofileFields := schema.BankStatementProfile{}.Fields()
+	_ = bankstatementprofileFields
+	// bankstatementprofileDescName is the schema descriptor for name field.
+	bankstatementprofileDescName := bankstatementprofileFields[2].Descriptor()
+	// bankstatementprofile.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bankstatementprofile.NameValidator = bankstatementprofileDescName.Validators[0].(func(string) error)
+	// bankstatementprofileDescDelimiter is the schema descriptor for delimiter field.
+	bankstatementprofileDescDelimiter := bankstatementprofileFields[3].Descriptor()
+	// bankstatementprofile.DefaultDelimiter holds the default value on creation for the delimiter field.
+	bankstatementprofile.DefaultDelimiter = bankstatementprofileDescDelimiter.Default.(string)
+	// bankstatementprofileDescDateColumn is the schema descriptor for date_column field.
+	bankstatementprofileDescDateColumn := bankstatementprofileFields[5].Descriptor()
+	// bankstatementprofile.DateColumnValidator is a validator for the "date_column" field. It is called by the builders before save.
+	bankstatementprofile.DateColumnValidator = bankstatementprofileDescDateColumn.Validators[0].(func(string) error)
+	// bankstatementprofileDescDateFormat is the schema descriptor for date_format field.
+	bankstatementprofileDescDateFormat := bankstatementprofileFields[6].Descriptor()
+	// bankstatementprofile.DateFormatValidator is a validator for the "date_format" field. It is called by the builders before save.
+	bankstatementprofile.DateFormatValidator = bankstatementprofileDescDateFormat.Validators[0].(func(string) error)
+	// bankstatementprofileDescCreatedAt is the schema descriptor for created_at field.
+	bankstatementprofileDescCreatedAt := bankstatementprofileFields[16].Descriptor()
+	// bankstatementprofile.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bankstatementprofile.DefaultCreatedAt = bankstatementprofileDescCreatedAt.Default.(func() time.Time)
+	// bankstatementprofileDescUpdatedAt is the schema descriptor for updated_at field.
+	bankstatementprofileDescUpdatedAt := bankstatementprofileFields[17].Descriptor()
+	// bankstatementprofile.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	bankstatementprofile.DefaultUpdatedAt = bankstatementprofileDescUpdatedAt.Default.(func() time.Time)
+	// bankstatementprofile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	bankstatementprofile.UpdateDefaultUpdatedAt = bankstatementprofileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// bankstatementprofileDescID is the schema descriptor for id field.
+	bankstatementprofileDescID := bankstatementprofileFields[0].Descriptor()
+	// bankstatementprofile.DefaultID holds the default value on creation for the id field.
+	bankstatementprofile.DefaultID = bankstatementprofileDescID.Default.(func() uuid.UUID)
+	banktransactionFields := schema.BankTransaction{}.Fields()
+	_ = banktransactionFields
+	// banktransactionDescCurrency is the schema descriptor for currency field.
+	banktransactionDescCurrency := banktransactionFields[7].Descriptor()
+	// banktransaction.DefaultCurrency holds the default value on creation for the currency field.
+	banktransaction.DefaultCurrency = banktransactionDescCurrency.Default.(string)
+	// banktransactionDescReconciliationStatus is the schema descriptor for reconciliation_status field.
+	banktransactionDescReconciliationStatus := banktransactionFields[15].Descriptor()
+	// banktransaction.DefaultReconciliationStatus holds the default value on creation for the reconciliation_status field.
+	banktransaction.DefaultReconciliationStatus = banktransactionDescReconciliationStatus.Default.(string)
+	// banktransactionDescMetadata is the schema descriptor for metadata field.
+	banktransactionDescMetadata := banktransactionFields[16].Descriptor()
+	// banktransaction.DefaultMetadata holds the default value on creation for the metadata field.
+	banktransaction.DefaultMetadata = banktransactionDescMetadata.Default.(map[string]interface{})
+	// banktransactionDescCreatedAt is the schema descriptor for created_at field.
+	banktransactionDescCreatedAt := banktransactionFields[17].Descriptor()
+	// banktransaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	banktransaction.DefaultCreatedAt = banktransactionDescCreatedAt.Default.(func() time.Time)
+	// banktransactionDescID is the schema descriptor for id field.
+	banktransactionDescID := banktransactionFields[0].Descriptor()
+	// banktransaction.DefaultID holds the default value on creation for the id field.
+	banktransaction.DefaultID = banktransactionDescID.Default.(func() uuid.UUID)
+	billingcycleFields := schema.BillingCycle{}.Fields()
+	_ = billingcycleFields
+	// billingcycleDescStatus is the schema descriptor for status field.
+	billingcycleDescStatus := billingcycleFields[6].Descriptor()
+	// billingcycle.DefaultStatus holds the default value on creation for the status field.
+	billingcycle.DefaultStatus = billingcycleDescStatus.Default.(string)
+	// billingcycleDescMetadata is the schema descriptor for metadata field.
+	billingcycleDescMetadata := billingcycleFields[9].Descriptor()
+	// billingcycle.DefaultMetadata holds the default value on creation for the metadata field.
+	billingcycle.DefaultMetadata = billingcycleDescMetadata.Default.(map[string]interface{})
+	// billingcycleDescCreatedAt is the schema descriptor for created_at field.
+	billingcycleDescCreatedAt := billingcycleFields[10].Descriptor()
+	// billingcycle.DefaultCreatedAt holds the default value on creation for the created_at field.
+	billingcycle.DefaultCreatedAt = billingcycleDescCreatedAt.Default.(func() time.Time)
+	// billingcycleDescUpdatedAt is the schema descriptor for updated_at field.
+	billingcycleDescUpdatedAt := billingcycleFields[11].Descriptor()
+	// billingcycle.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	billingcycle.DefaultUpdatedAt = billingcycleDescUpdatedAt.Default.(func() time.Time)
+	// billingcycle.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	billingcycle.UpdateDefaultUpdatedAt = billingcycleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// billingcycleDescID is the schema descriptor for id field.
+	billingcycleDescID := billingcycleFields[0].Descriptor()
+	// billingcycle.DefaultID holds the default value on creation for the id field.
+	billingcycle.DefaultID = billingcycleDescID.Default.(func() uuid.UUID)
+	cashdrawercountFields := schema.CashDrawerCount{}.Fields()
+	_ = cashdrawercountFields
+	// cashdrawercountDescQuantity is the schema descriptor for quantity field.
+	cashdrawercountDescQuantity := cashdrawercountFields[4].Descriptor()
+	// cashdrawercount.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	cashdrawercount.QuantityValidator = cashdrawercountDescQuantity.Validators[0].(func(int) error)
+	// cashdrawercountDescCreatedAt is the schema descriptor for created_at field.
+	cashdrawercountDescCreatedAt := cashdrawercountFields[6].Descriptor()
+	// cashdrawercount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	cashdrawercount.DefaultCreatedAt = cashdrawercountDescCreatedAt.Default.(func() time.Time)
+	// cashdrawercountDescID is the schema descriptor for id field.
+	cashdrawercountDescID := cashdrawercountFields[0].Descriptor()
+	// cashdrawercount.DefaultID holds the default value on creation for the id field.
+	cashdrawercount.DefaultID = cashdrawercountDescID.Default.(func() uuid.UUID)
+	cashdrawersessionFields := schema.CashDrawerSession{}.Fields()
+	_ = cashdrawersessionFields
+	// cashdrawersessionDescPosSessionID is the schema descriptor for pos_session_id field.
+	cashdrawersessionDescPosSessionID := cashdrawersessionFields[2].Descriptor()
+	// cashdrawersession.PosSessionIDValidator is a validator for the "pos_session_id" field. It is called by the builders before save.
+	cashdrawersession.PosSessionIDValidator = cashdrawersessionDescPosSessionID.Validators[0].(func(string) error)
+	// cashdrawersessionDescDrawerID is the schema descriptor for drawer_id field.
+	cashdrawersessionDescDrawerID := cashdrawersessionFields[3].Descriptor()
+	// cashdrawersession.DrawerIDValidator is a validator for the "drawer_id" field. It is called by the builders before save.
+	cashdrawersession.DrawerIDValidator = cashdrawersessionDescDrawerID.Validators[0].(func(string) error)
+	// cashdrawersessionDescCurrency is the schema descriptor for currency field.
+	cashdrawersessionDescCurrency := cashdrawersessionFields[6].Descriptor()
+	// cashdrawersession.DefaultCurrency holds the default value on creation for the currency field.
+	cashdrawersession.DefaultCurrency = cashdrawersessionDescCurrency.Default.(string)
+	// cashdrawersessionDescStatus is the schema descriptor for status field.
+	cashdrawersessionDescStatus := cashdrawersessionFields[16].Descriptor()
+	// cashdrawersession.DefaultStatus holds the default value on creation for the status field.
+	cashdrawersession.DefaultStatus = cashdrawersessionDescStatus.Default.(string)
+	// cashdrawersessionDescCreatedAt is the schema descriptor for created_at field.
+	cashdrawersessionDescCreatedAt := cashdrawersessionFields[23].Descriptor()
+	// cashdrawersession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	cashdrawersession.DefaultCreatedAt = cashdrawersessionDescCreatedAt.Default.(func() time.Time)
+	// cashdrawersessionDescUpdatedAt is the schema descriptor for updated_at field.
+	cashdrawersessionDescUpdatedAt := cashdrawersessionFields[24].Descriptor()
+	// cashdrawersession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	cashdrawersession.DefaultUpdatedAt = cashdrawersessionDescUpdatedAt.Default.(func() time.Time)
+	// cashdrawersession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	cashdrawersession.UpdateDefaultUpdatedAt = cashdrawersessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// cashdrawersessionDescID is the schema descriptor for id field.
+	cashdrawersessionDescID := cashdrawersessionFields[0].Descriptor()
+	// cashdrawersession.DefaultID holds the default value on creation for the id field.
+	cashdrawersession.DefaultID = cashdrawersessionDescID.Default.(func() uuid.UUID)
+	cashdrawersettingFields := schema.CashDrawerSetting{}.Fields()
+	_ = cashdrawersettingFields
+	// cashdrawersettingDescCreatedAt is the schema descriptor for created_at field.
+	cashdrawersettingDescCreatedAt := cashdrawersettingFields[3].Descriptor()
+	// cashdrawersetting.DefaultCreatedAt holds the default value on creation for the created_at field.
+	cashdrawersetting.DefaultCreatedAt = cashdrawersettingDescCreatedAt.Default.(func() time.Time)
+	// cashdrawersettingDescUpdatedAt is the schema descriptor for updated_at field.
+	cashdrawersettingDescUpdatedAt := cashdrawersettingFields[4].Descriptor()
+	// cashdrawersetting.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	cashdrawersetting.DefaultUpdatedAt = cashdrawersettingDescUpdatedAt.Default.(func() time.Time)
+	// cashdrawersetting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	cashdrawersetting.UpdateDefaultUpdatedAt = cashdrawersettingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// cashdrawersettingDescID is the schema descriptor for id field.
+	cashdrawersettingDescID := cashdrawersettingFields[0].Descriptor()
+	// cashdrawersetting.DefaultID holds the default value on creation for the id field.
+	cashdrawersetting.DefaultID = cashdrawersettingDescID.Default.(func() uuid.UUID)
 	chartofaccountFields := schema.ChartOfAccount{}.Fields()
 	_ = chartofaccountFields
 	// chartofaccountDescAccountCode is the schema descriptor for account_code field.
@@ -59,6 +320,192 @@ func init() {
 	chartofaccountDescID := chartofaccountFields[0].Descriptor()
 	// chartofaccount.DefaultID holds the default value on creation for the id field.
 	chartofaccount.DefaultID = chartofaccountDescID.Default.(func() uuid.UUID)
+	creditoverrideFields := schema.CreditOverride{}.Fields()
+	_ = creditoverrideFields
+	// creditoverrideDescReason is the schema descriptor for reason field.
+	creditoverrideDescReason := creditoverrideFields[5].Descriptor()
+	// creditoverride.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	creditoverride.ReasonValidator = creditoverrideDescReason.Validators[0].(func(string) error)
+	// creditoverrideDescStatus is the schema descriptor for status field.
+	creditoverrideDescStatus := creditoverrideFields[8].Descriptor()
+	// creditoverride.DefaultStatus holds the default value on creation for the status field.
+	creditoverride.DefaultStatus = creditoverrideDescStatus.Default.(string)
+	// creditoverrideDescCreatedAt is the schema descriptor for created_at field.
+	creditoverrideDescCreatedAt := creditoverrideFields[14].Descriptor()
+	// creditoverride.DefaultCreatedAt holds the default value on creation for the created_at field.
+	creditoverride.DefaultCreatedAt = creditoverrideDescCreatedAt.Default.(func() time.Time)
+	// creditoverrideDescID is the schema descriptor for id field.
+	creditoverrideDescID := creditoverrideFields[0].Descriptor()
+	// creditoverride.DefaultID holds the default value on creation for the id field.
+	creditoverride.DefaultID = creditoverrideDescID.Default.(func() uuid.UUID)
+	customerFields := schema.Customer{}.Fields()
+	_ = customerFields
+	// customerDescCustomerNumber is the schema descriptor for customer_number field.
+	customerDescCustomerNumber := customerFields[2].Descriptor()
+	// customer.CustomerNumberValidator is a validator for the "customer_number" field. It is called by the builders before save.
+	customer.CustomerNumberValidator = customerDescCustomerNumber.Validators[0].(func(string) error)
+	// customerDescLegalName is the schema descriptor for legal_name field.
+	customerDescLegalName := customerFields[3].Descriptor()
+	// customer.LegalNameValidator is a validator for the "legal_name" field. It is called by the builders before save.
+	customer.LegalNameValidator = customerDescLegalName.Validators[0].(func(string) error)
+	// customerDescDefaultCurrency is the schema descriptor for default_currency field.
+	customerDescDefaultCurrency := customerFields[10].Descriptor()
+	// customer.DefaultDefaultCurrency holds the default value on creation for the default_currency field.
+	customer.DefaultDefaultCurrency = customerDescDefaultCurrency.Default.(string)
+	// customerDescPaymentTermsDays is the schema descriptor for payment_terms_days field.
+	customerDescPaymentTermsDays := customerFields[11].Descriptor()
+	// customer.DefaultPaymentTermsDays holds the default value on creation for the payment_terms_days field.
+	customer.DefaultPaymentTermsDays = customerDescPaymentTermsDays.Default.(int)
+	// customerDescCreditHold is the schema descriptor for credit_hold field.
+	customerDescCreditHold := customerFields[13].Descriptor()
+	// customer.DefaultCreditHold holds the default value on creation for the credit_hold field.
+	customer.DefaultCreditHold = customerDescCreditHold.Default.(bool)
+	// customerDescStatus is the schema descriptor for status field.
+	customerDescStatus := customerFields[18].Descriptor()
+	// customer.DefaultStatus holds the default value on creation for the status field.
+	customer.DefaultStatus = customerDescStatus.Default.(string)
+	// customerDescMetadata is the schema descriptor for metadata field.
+	customerDescMetadata := customerFields[19].Descriptor()
+	// customer.DefaultMetadata holds the default value on creation for the metadata field.
+	customer.DefaultMetadata = customerDescMetadata.Default.(map[string]interface{})
+	// customerDescCreatedAt is the schema descriptor for created_at field.
+	customerDescCreatedAt := customerFields[20].Descriptor()
+	// customer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	customer.DefaultCreatedAt = customerDescCreatedAt.Default.(func() time.Time)
+	// customerDescUpdatedAt is the schema descriptor for updated_at field.
+	customerDescUpdatedAt := customerFields[21].Descriptor()
+	// customer.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	customer.DefaultUpdatedAt = customerDescUpdatedAt.Default.(func() time.Time)
+	// customer.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	customer.UpdateDefaultUpdatedAt = customerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// customerDescID is the schema descriptor for id field.
+	customerDescID := customerFields[0].Descriptor()
+	// customer.DefaultID holds the default value on creation for the id field.
+	customer.DefaultID = customerDescID.Default.(func() uuid.UUID)
+	customerstatementFields := schema.CustomerStatement{}.Fields()
+	_ = customerstatementFields
+	// customerstatementDescTrigger is the schema descriptor for trigger field.
+	customerstatementDescTrigger := customerstatementFields[9].Descriptor()
+	// customerstatement.DefaultTrigger holds the default value on creation for the trigger field.
+	customerstatement.DefaultTrigger = customerstatementDescTrigger.Default.(string)
+	// customerstatementDescGeneratedAt is the schema descriptor for generated_at field.
+	customerstatementDescGeneratedAt := customerstatementFields[10].Descriptor()
+	// customerstatement.DefaultGeneratedAt holds the default value on creation for the generated_at field.
+	customerstatement.DefaultGeneratedAt = customerstatementDescGeneratedAt.Default.(func() time.Time)
+	// customerstatementDescID is the schema descriptor for id field.
+	customerstatementDescID := customerstatementFields[0].Descriptor()
+	// customerstatement.DefaultID holds the default value on creation for the id field.
+	customerstatement.DefaultID = customerstatementDescID.Default.(func() uuid.UUID)
+	documentsequenceFields := schema.DocumentSequence{}.Fields()
+	_ = documentsequenceFields
+	// documentsequenceDescName is the schema descriptor for name field.
+	documentsequenceDescName := documentsequenceFields[2].Descriptor()
+	// documentsequence.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	documentsequence.NameValidator = documentsequenceDescName.Validators[0].(func(string) error)
+	// documentsequenceDescLastValue is the schema descriptor for last_value field.
+	documentsequenceDescLastValue := documentsequenceFields[3].Descriptor()
+	// documentsequence.DefaultLastValue holds the default value on creation for the last_value field.
+	documentsequence.DefaultLastValue = documentsequenceDescLastValue.Default.(int64)
+	// documentsequenceDescUpdatedAt is the schema descriptor for updated_at field.
+	documentsequenceDescUpdatedAt := documentsequenceFields[4].Descriptor()
+	// documentsequence.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	documentsequence.DefaultUpdatedAt = documentsequenceDescUpdatedAt.Default.(func() time.Time)
+	// documentsequence.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	documentsequence.UpdateDefaultUpdatedAt = documentsequenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// documentsequenceDescID is the schema descriptor for id field.
+	documentsequenceDescID := documentsequenceFields[0].Descriptor()
+	// documentsequence.DefaultID holds the default value on creation for the id field.
+	documentsequence.DefaultID = documentsequenceDescID.Default.(func() uuid.UUID)
+	dunningnoticeFields := schema.DunningNotice{}.Fields()
+	_ = dunningnoticeFields
+	// dunningnoticeDescExecutedAt is the schema descriptor for executed_at field.
+	dunningnoticeDescExecutedAt := dunningnoticeFields[8].Descriptor()
+	// dunningnotice.DefaultExecutedAt holds the default value on creation for the executed_at field.
+	dunningnotice.DefaultExecutedAt = dunningnoticeDescExecutedAt.Default.(func() time.Time)
+	// dunningnoticeDescID is the schema descriptor for id field.
+	dunningnoticeDescID := dunningnoticeFields[0].Descriptor()
+	// dunningnotice.DefaultID holds the default value on creation for the id field.
+	dunningnotice.DefaultID = dunningnoticeDescID.Default.(func() uuid.UUID)
+	dunningpauseFields := schema.DunningPause{}.Fields()
+	_ = dunningpauseFields
+	// dunningpauseDescCreatedAt is the schema descriptor for created_at field.
+	dunningpauseDescCreatedAt := dunningpauseFields[6].Descriptor()
+	// dunningpause.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dunningpause.DefaultCreatedAt = dunningpauseDescCreatedAt.Default.(func() time.Time)
+	// dunningpauseDescID is the schema descriptor for id field.
+	dunningpauseDescID := dunningpauseFields[0].Descriptor()
+	// dunningpause.DefaultID holds the default value on creation for the id field.
+	dunningpause.DefaultID = dunningpauseDescID.Default.(func() uuid.UUID)
+	dunningstepFields := schema.DunningStep{}.Fields()
+	_ = dunningstepFields
+	// dunningstepDescAction is the schema descriptor for action field.
+	dunningstepDescAction := dunningstepFields[3].Descriptor()
+	// dunningstep.DefaultAction holds the default value on creation for the action field.
+	dunningstep.DefaultAction = dunningstepDescAction.Default.(string)
+	// dunningstepDescCreatedAt is the schema descriptor for created_at field.
+	dunningstepDescCreatedAt := dunningstepFields[8].Descriptor()
+	// dunningstep.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dunningstep.DefaultCreatedAt = dunningstepDescCreatedAt.Default.(func() time.Time)
+	// dunningstepDescID is the schema descriptor for id field.
+	dunningstepDescID := dunningstepFields[0].Descriptor()
+	// dunningstep.DefaultID holds the default value on creation for the id field.
+	dunningstep.DefaultID = dunningstepDescID.Default.(func() uuid.UUID)
+	earningssettingFields := schema.EarningsSetting{}.Fields()
+	_ = earningssettingFields
+	// earningssettingDescCreatedAt is the schema descriptor for created_at field.
+	earningssettingDescCreatedAt := earningssettingFields[3].Descriptor()
+	// earningssetting.DefaultCreatedAt holds the default value on creation for the created_at field.
+	earningssetting.DefaultCreatedAt = earningssettingDescCreatedAt.Default.(func() time.Time)
+	// earningssettingDescUpdatedAt is the schema descriptor for updated_at field.
+	earningssettingDescUpdatedAt := earningssettingFields[4].Descriptor()
+	// earningssetting.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	earningssetting.DefaultUpdatedAt = earningssettingDescUpdatedAt.Default.(func() time.Time)
+	// earningssetting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	earningssetting.UpdateDefaultUpdatedAt = earningssettingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// earningssettingDescID is the schema descriptor for id field.
+	earningssettingDescID := earningssettingFields[0].Descriptor()
+	// earningssetting.DefaultID holds the default value on creation for the id field.
+	earningssetting.DefaultID = earningssettingDescID.Default.(func() uuid.UUID)
+	goodsreceiptFields := schema.GoodsReceipt{}.Fields()
+	_ = goodsreceiptFields
+	// goodsreceiptDescEventID is the schema descriptor for event_id field.
+	goodsreceiptDescEventID := goodsreceiptFields[2].Descriptor()
+	// goodsreceipt.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	goodsreceipt.EventIDValidator = goodsreceiptDescEventID.Validators[0].(func(string) error)
+	// goodsreceiptDescPoNumber is the schema descriptor for po_number field.
+	goodsreceiptDescPoNumber := goodsreceiptFields[4].Descriptor()
+	// goodsreceipt.PoNumberValidator is a validator for the "po_number" field. It is called by the builders before save.
+	goodsreceipt.PoNumberValidator = goodsreceiptDescPoNumber.Validators[0].(func(string) error)
+	// goodsreceiptDescGrnID is the schema descriptor for grn_id field.
+	goodsreceiptDescGrnID := goodsreceiptFields[5].Descriptor()
+	// goodsreceipt.GrnIDValidator is a validator for the "grn_id" field. It is called by the builders before save.
+	goodsreceipt.GrnIDValidator = goodsreceiptDescGrnID.Validators[0].(func(string) error)
+	// goodsreceiptDescCurrency is the schema descriptor for currency field.
+	goodsreceiptDescCurrency := goodsreceiptFields[8].Descriptor()
+	// goodsreceipt.DefaultCurrency holds the default value on creation for the currency field.
+	goodsreceipt.DefaultCurrency = goodsreceiptDescCurrency.Default.(string)
+	// goodsreceiptDescMetadata is the schema descriptor for metadata field.
+	goodsreceiptDescMetadata := goodsreceiptFields[10].Descriptor()
+	// goodsreceipt.DefaultMetadata holds the default value on creation for the metadata field.
+	goodsreceipt.DefaultMetadata = goodsreceiptDescMetadata.Default.(map[string]interface{})
+	// goodsreceiptDescCreatedAt is the schema descriptor for created_at field.
+	goodsreceiptDescCreatedAt := goodsreceiptFields[11].Descriptor()
+	// goodsreceipt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	goodsreceipt.DefaultCreatedAt = goodsreceiptDescCreatedAt.Default.(func() time.Time)
+	// goodsreceiptDescID is the schema descriptor for id field.
+	goodsreceiptDescID := goodsreceiptFields[0].Descriptor()
+	// goodsreceipt.DefaultID holds the default value on creation for the id field.
+	goodsreceipt.DefaultID = goodsreceiptDescID.Default.(func() uuid.UUID)
+	goodsreceiptlineFields := schema.GoodsReceiptLine{}.Fields()
+	_ = goodsreceiptlineFields
+	// goodsreceiptlineDescItemCode is the schema descriptor for item_code field.
+	goodsreceiptlineDescItemCode := goodsreceiptlineFields[4].Descriptor()
+	// goodsreceiptline.ItemCodeValidator is a validator for the "item_code" field. It is called by the builders before save.
+	goodsreceiptline.ItemCodeValidator = goodsreceiptlineDescItemCode.Validators[0].(func(string) error)
+	// goodsreceiptlineDescID is the schema descriptor for id field.
+	goodsreceiptlineDescID := goodsreceiptlineFields[0].Descriptor()
+	// goodsreceiptline.DefaultID holds the default value on creation for the id field.
+	goodsreceiptline.DefaultID = goodsreceiptlineDescID.Default.(func() uuid.UUID)
 	invoiceFields := schema.Invoice{}.Fields()
 	_ = invoiceFields
 	// invoiceDescInvoiceNumber is the schema descriptor for invoice_number field.
@@ -82,15 +529,15 @@ func init() {
 	// invoice.DefaultPaymentStatus holds the default value on creation for the payment_status field.
 	invoice.DefaultPaymentStatus = invoiceDescPaymentStatus.Default.(string)
 	// invoiceDescMetadata is the schema descriptor for metadata field.
-	invoiceDescMetadata := invoiceFields[15].Descriptor()
+	invoiceDescMetadata := invoiceFields[16].Descriptor()
 	// invoice.DefaultMetadata holds the default value on creation for the metadata field.
 	invoice.DefaultMetadata = invoiceDescMetadata.Default.(map[string]interface{})
 	// invoiceDescCreatedAt is the schema descriptor for created_at field.
-	invoiceDescCreatedAt := invoiceFields[16].Descriptor()
+	invoiceDescCreatedAt := invoiceFields[17].Descriptor()
 	// invoice.DefaultCreatedAt holds the default value on creation for the created_at field.
 	invoice.DefaultCreatedAt = invoiceDescCreatedAt.Default.(func() time.Time)
 	// invoiceDescUpdatedAt is the schema descriptor for updated_at field.
-	invoiceDescUpdatedAt := invoiceFields[17].Descriptor()
+	invoiceDescUpdatedAt := invoiceFields[18].Descriptor()
 	// invoice.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	invoice.DefaultUpdatedAt = invoiceDescUpdatedAt.Default.(func() time.Time)
 	// invoice.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -99,6 +546,92 @@ func init() {
 	invoiceDescID := invoiceFields[0].Descriptor()
 	// invoice.DefaultID holds the default value on creation for the id field.
 	invoice.DefaultID = invoiceDescID.Default.(func() uuid.UUID)
+	invoicelineFields := schema.InvoiceLine{}.Fields()
+	_ = invoicelineFields
+	// invoicelineDescDescription is the schema descriptor for description field.
+	invoicelineDescDescription := invoicelineFields[4].Descriptor()
+	// invoiceline.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	invoiceline.DescriptionValidator = invoicelineDescDescription.Validators[0].(func(string) error)
+	// invoicelineDescMetadata is the schema descriptor for metadata field.
+	invoicelineDescMetadata := invoicelineFields[13].Descriptor()
+	// invoiceline.DefaultMetadata holds the default value on creation for the metadata field.
+	invoiceline.DefaultMetadata = invoicelineDescMetadata.Default.(map[string]interface{})
+	// invoicelineDescCreatedAt is the schema descriptor for created_at field.
+	invoicelineDescCreatedAt := invoicelineFields[14].Descriptor()
+	// invoiceline.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invoiceline.DefaultCreatedAt = invoicelineDescCreatedAt.Default.(func() time.Time)
+	// invoicelineDescID is the schema descriptor for id field.
+	invoicelineDescID := invoicelineFields[0].Descriptor()
+	// invoiceline.DefaultID holds the default value on creation for the id field.
+	invoiceline.DefaultID = invoicelineDescID.Default.(func() uuid.UUID)
+	invoicepaymentFields := schema.InvoicePayment{}.Fields()
+	_ = invoicepaymentFields
+	// invoicepaymentDescCurrency is the schema descriptor for currency field.
+	invoicepaymentDescCurrency := invoicepaymentFields[6].Descriptor()
+	// invoicepayment.DefaultCurrency holds the default value on creation for the currency field.
+	invoicepayment.DefaultCurrency = invoicepaymentDescCurrency.Default.(string)
+	// invoicepaymentDescAllocationMethod is the schema descriptor for allocation_method field.
+	invoicepaymentDescAllocationMethod := invoicepaymentFields[7].Descriptor()
+	// invoicepayment.DefaultAllocationMethod holds the default value on creation for the allocation_method field.
+	invoicepayment.DefaultAllocationMethod = invoicepaymentDescAllocationMethod.Default.(string)
+	// invoicepaymentDescAppliedAt is the schema descriptor for applied_at field.
+	invoicepaymentDescAppliedAt := invoicepaymentFields[10].Descriptor()
+	// invoicepayment.DefaultAppliedAt holds the default value on creation for the applied_at field.
+	invoicepayment.DefaultAppliedAt = invoicepaymentDescAppliedAt.Default.(func() time.Time)
+	// invoicepaymentDescMetadata is the schema descriptor for metadata field.
+	invoicepaymentDescMetadata := invoicepaymentFields[11].Descriptor()
+	// invoicepayment.DefaultMetadata holds the default value on creation for the metadata field.
+	invoicepayment.DefaultMetadata = invoicepaymentDescMetadata.Default.(map[string]interface{})
+	// invoicepaymentDescCreatedAt is the schema descriptor for created_at field.
+	invoicepaymentDescCreatedAt := invoicepaymentFields[12].Descriptor()
+	// invoicepayment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invoicepayment.DefaultCreatedAt = invoicepaymentDescCreatedAt.Default.(func() time.Time)
+	// invoicepaymentDescID is the schema descriptor for id field.
+	invoicepaymentDescID := invoicepaymentFields[0].Descriptor()
+	// invoicepayment.DefaultID holds the default value on creation for the id field.
+	invoicepayment.DefaultID = invoicepaymentDescID.Default.(func() uuid.UUID)
+	invoicesettingFields := schema.InvoiceSetting{}.Fields()
+	_ = invoicesettingFields
+	// invoicesettingDescAutoIssueOrders is the schema descriptor for auto_issue_orders field.
+	invoicesettingDescAutoIssueOrders := invoicesettingFields[2].Descriptor()
+	// invoicesetting.DefaultAutoIssueOrders holds the default value on creation for the auto_issue_orders field.
+	invoicesetting.DefaultAutoIssueOrders = invoicesettingDescAutoIssueOrders.Default.(bool)
+	// invoicesettingDescAutoIssueMilestones is the schema descriptor for auto_issue_milestones field.
+	invoicesettingDescAutoIssueMilestones := invoicesettingFields[3].Descriptor()
+	// invoicesetting.DefaultAutoIssueMilestones holds the default value on creation for the auto_issue_milestones field.
+	invoicesetting.DefaultAutoIssueMilestones = invoicesettingDescAutoIssueMilestones.Default.(bool)
+	// invoicesettingDescOrderPaymentTermsDays is the schema descriptor for order_payment_terms_days field.
+	invoicesettingDescOrderPaymentTermsDays := invoicesettingFields[4].Descriptor()
+	// invoicesetting.DefaultOrderPaymentTermsDays holds the default value on creation for the order_payment_terms_days field.
+	invoicesetting.DefaultOrderPaymentTermsDays = invoicesettingDescOrderPaymentTermsDays.Default.(int)
+	// invoicesetting.OrderPaymentTermsDaysValidator is a validator for the "order_payment_terms_days" field. It is called by the builders before save.
+	invoicesetting.OrderPaymentTermsDaysValidator = invoicesettingDescOrderPaymentTermsDays.Validators[0].(func(int) error)
+	// invoicesettingDescMilestonePaymentTermsDays is the schema descriptor for milestone_payment_terms_days field.
+	invoicesettingDescMilestonePaymentTermsDays := invoicesettingFields[5].Descriptor()
+	// invoicesetting.DefaultMilestonePaymentTermsDays holds the default value on creation for the milestone_payment_terms_days field.
+	invoicesetting.DefaultMilestonePaymentTermsDays = invoicesettingDescMilestonePaymentTermsDays.Default.(int)
+	// invoicesetting.MilestonePaymentTermsDaysValidator is a validator for the "milestone_payment_terms_days" field. It is called by the builders before save.
+	invoicesetting.MilestonePaymentTermsDaysValidator = invoicesettingDescMilestonePaymentTermsDays.Validators[0].(func(int) error)
+	// invoicesettingDescCreditHoldDays is the schema descriptor for credit_hold_days field.
+	invoicesettingDescCreditHoldDays := invoicesettingFields[6].Descriptor()
+	// invoicesetting.DefaultCreditHoldDays holds the default value on creation for the credit_hold_days field.
+	invoicesetting.DefaultCreditHoldDays = invoicesettingDescCreditHoldDays.Default.(int)
+	// invoicesetting.CreditHoldDaysValidator is a validator for the "credit_hold_days" field. It is called by the builders before save.
+	invoicesetting.CreditHoldDaysValidator = invoicesettingDescCreditHoldDays.Validators[0].(func(int) error)
+	// invoicesettingDescCreatedAt is the schema descriptor for created_at field.
+	invoicesettingDescCreatedAt := invoicesettingFields[8].Descriptor()
+	// invoicesetting.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invoicesetting.DefaultCreatedAt = invoicesettingDescCreatedAt.Default.(func() time.Time)
+	// invoicesettingDescUpdatedAt is the schema descriptor for updated_at field.
+	invoicesettingDescUpdatedAt := invoicesettingFields[9].Descriptor()
+	// invoicesetting.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	invoicesetting.DefaultUpdatedAt = invoicesettingDescUpdatedAt.Default.(func() time.Time)
+	// invoicesetting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	invoicesetting.UpdateDefaultUpdatedAt = invoicesettingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// invoicesettingDescID is the schema descriptor for id field.
+	invoicesettingDescID := invoicesettingFields[0].Descriptor()
+	// invoicesetting.DefaultID holds the default value on creation for the id field.
+	invoicesetting.DefaultID = invoicesettingDescID.Default.(func() uuid.UUID)
 	ledgertransactionFields := schema.LedgerTransaction{}.Fields()
 	_ = ledgertransactionFields
 	// ledgertransactionDescCurrency is the schema descriptor for currency field.
@@ -143,6 +676,88 @@ func init() {
 	outboxeventDescID := outboxeventFields[0].Descriptor()
 	// outboxevent.DefaultID holds the default value on creation for the id field.
 	outboxevent.DefaultID = outboxeventDescID.Default.(func() uuid.UUID)
+	payablesettingFields := schema.PayableSetting{}.Fields()
+	_ = payablesettingFields
+	// payablesettingDescCreatedAt is the schema descriptor for created_at field.
+	payablesettingDescCreatedAt := payablesettingFields[4].Descriptor()
+	// payablesetting.DefaultCreatedAt holds the default value on creation for the created_at field.
+	payablesetting.DefaultCreatedAt = payablesettingDescCreatedAt.Default.(func() time.Time)
+	// payablesettingDescUpdatedAt is the schema descriptor for updated_at field.
+	payablesettingDescUpdatedAt := payablesettingFields[5].Descriptor()
+	// payablesetting.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	payablesetting.DefaultUpdatedAt = payablesettingDescUpdatedAt.Default.(func() time.Time)
+	// payablesetting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	payablesetting.UpdateDefaultUpdatedAt = payablesettingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// payablesettingDescID is the schema descriptor for id field.
+	payablesettingDescID := payablesettingFields[0].Descriptor()
+	// payablesetting.DefaultID holds the default value on creation for the id field.
+	payablesetting.DefaultID = payablesettingDescID.Default.(func() uuid.UUID)
+	payeepayoutFields := schema.PayeePayout{}.Fields()
+	_ = payeepayoutFields
+	// payeepayoutDescPayoutNumber is the schema descriptor for payout_number field.
+	payeepayoutDescPayoutNumber := payeepayoutFields[3].Descriptor()
+	// payeepayout.PayoutNumberValidator is a validator for the "payout_number" field. It is called by the builders before save.
+	payeepayout.PayoutNumberValidator = payeepayoutDescPayoutNumber.Validators[0].(func(string) error)
+	// payeepayoutDescCurrency is the schema descriptor for currency field.
+	payeepayoutDescCurrency := payeepayoutFields[5].Descriptor()
+	// payeepayout.DefaultCurrency holds the default value on creation for the currency field.
+	payeepayout.DefaultCurrency = payeepayoutDescCurrency.Default.(string)
+	// payeepayoutDescStatus is the schema descriptor for status field.
+	payeepayoutDescStatus := payeepayoutFields[7].Descriptor()
+	// payeepayout.DefaultStatus holds the default value on creation for the status field.
+	payeepayout.DefaultStatus = payeepayoutDescStatus.Default.(string)
+	// payeepayoutDescCreatedAt is the schema descriptor for created_at field.
+	payeepayoutDescCreatedAt := payeepayoutFields[16].Descriptor()
+	// payeepayout.DefaultCreatedAt holds the default value on creation for the created_at field.
+	payeepayout.DefaultCreatedAt = payeepayoutDescCreatedAt.Default.(func() time.Time)
+	// payeepayoutDescUpdatedAt is the schema descriptor for updated_at field.
+	payeepayoutDescUpdatedAt := payeepayoutFields[17].Descriptor()
+	// payeepayout.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	payeepayout.DefaultUpdatedAt = payeepayoutDescUpdatedAt.Default.(func() time.Time)
+	// payeepayout.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	payeepayout.UpdateDefaultUpdatedAt = payeepayoutDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// payeepayoutDescID is the schema descriptor for id field.
+	payeepayoutDescID := payeepayoutFields[0].Descriptor()
+	// payeepayout.DefaultID holds the default value on creation for the id field.
+	payeepayout.DefaultID = payeepayoutDescID.Default.(func() uuid.UUID)
+	payeewalletFields := schema.PayeeWallet{}.Fields()
+	_ = payeewalletFields
+	// payeewalletDescPayeeID is the schema descriptor for payee_id field.
+	payeewalletDescPayeeID := payeewalletFields[2].Descriptor()
+	// payeewallet.PayeeIDValidator is a validator for the "payee_id" field. It is called by the builders before save.
+	payeewallet.PayeeIDValidator = payeewalletDescPayeeID.Validators[0].(func(string) error)
+	// payeewalletDescPayeeType is the schema descriptor for payee_type field.
+	payeewalletDescPayeeType := payeewalletFields[3].Descriptor()
+	// payeewallet.DefaultPayeeType holds the default value on creation for the payee_type field.
+	payeewallet.DefaultPayeeType = payeewalletDescPayeeType.Default.(string)
+	// payeewalletDescCurrency is the schema descriptor for currency field.
+	payeewalletDescCurrency := payeewalletFields[6].Descriptor()
+	// payeewallet.DefaultCurrency holds the default value on creation for the currency field.
+	payeewallet.DefaultCurrency = payeewalletDescCurrency.Default.(string)
+	// payeewalletDescCreatedAt is the schema descriptor for created_at field.
+	payeewalletDescCreatedAt := payeewalletFields[12].Descriptor()
+	// payeewallet.DefaultCreatedAt holds the default value on creation for the created_at field.
+	payeewallet.DefaultCreatedAt = payeewalletDescCreatedAt.Default.(func() time.Time)
+	// payeewalletDescUpdatedAt is the schema descriptor for updated_at field.
+	payeewalletDescUpdatedAt := payeewalletFields[13].Descriptor()
+	// payeewallet.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	payeewallet.DefaultUpdatedAt = payeewalletDescUpdatedAt.Default.(func() time.Time)
+	// payeewallet.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	payeewallet.UpdateDefaultUpdatedAt = payeewalletDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// payeewalletDescID is the schema descriptor for id field.
+	payeewalletDescID := payeewalletFields[0].Descriptor()
+	// payeewallet.DefaultID holds the default value on creation for the id field.
+	payeewallet.DefaultID = payeewalletDescID.Default.(func() uuid.UUID)
+	payeewalletentryFields := schema.PayeeWalletEntry{}.Fields()
+	_ = payeewalletentryFields
+	// payeewalletentryDescCreatedAt is the schema descriptor for created_at field.
+	payeewalletentryDescCreatedAt := payeewalletentryFields[14].Descriptor()
+	// payeewalletentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	payeewalletentry.DefaultCreatedAt = payeewalletentryDescCreatedAt.Default.(func() time.Time)
+	// payeewalletentryDescID is the schema descriptor for id field.
+	payeewalletentryDescID := payeewalletentryFields[0].Descriptor()
+	// payeewalletentry.DefaultID holds the default value on creation for the id field.
+	payeewalletentry.DefaultID = payeewalletentryDescID.Default.(func() uuid.UUID)
 	paymentintentFields := schema.PaymentIntent{}.Fields()
 	_ = paymentintentFields
 	// paymentintentDescReferenceID is the schema descriptor for reference_id field.
@@ -183,6 +798,60 @@ func init() {
 	paymentintentDescID := paymentintentFields[0].Descriptor()
 	// paymentintent.DefaultID holds the default value on creation for the id field.
 	paymentintent.DefaultID = paymentintentDescID.Default.(func() uuid.UUID)
+	paymentrunFields := schema.PaymentRun{}.Fields()
+	_ = paymentrunFields
+	// paymentrunDescRunNumber is the schema descriptor for run_number field.
+	paymentrunDescRunNumber := paymentrunFields[2].Descriptor()
+	// paymentrun.RunNumberValidator is a validator for the "run_number" field. It is called by the builders before save.
+	paymentrun.RunNumberValidator = paymentrunDescRunNumber.Validators[0].(func(string) error)
+	// paymentrunDescCurrency is the schema descriptor for currency field.
+	paymentrunDescCurrency := paymentrunFields[4].Descriptor()
+	// paymentrun.DefaultCurrency holds the default value on creation for the currency field.
+	paymentrun.DefaultCurrency = paymentrunDescCurrency.Default.(string)
+	// paymentrunDescPaymentAccount is the schema descriptor for payment_account field.
+	paymentrunDescPaymentAccount := paymentrunFields[6].Descriptor()
+	// paymentrun.DefaultPaymentAccount holds the default value on creation for the payment_account field.
+	paymentrun.DefaultPaymentAccount = paymentrunDescPaymentAccount.Default.(string)
+	// paymentrunDescStatus is the schema descriptor for status field.
+	paymentrunDescStatus := paymentrunFields[9].Descriptor()
+	// paymentrun.DefaultStatus holds the default value on creation for the status field.
+	paymentrun.DefaultStatus = paymentrunDescStatus.Default.(string)
+	// paymentrunDescBillCount is the schema descriptor for bill_count field.
+	paymentrunDescBillCount := paymentrunFields[13].Descriptor()
+	// paymentrun.DefaultBillCount holds the default value on creation for the bill_count field.
+	paymentrun.DefaultBillCount = paymentrunDescBillCount.Default.(int)
+	// paymentrunDescMetadata is the schema descriptor for metadata field.
+	paymentrunDescMetadata := paymentrunFields[22].Descriptor()
+	// paymentrun.DefaultMetadata holds the default value on creation for the metadata field.
+	paymentrun.DefaultMetadata = paymentrunDescMetadata.Default.(map[string]interface{})
+	// paymentrunDescCreatedAt is the schema descriptor for created_at field.
+	paymentrunDescCreatedAt := paymentrunFields[23].Descriptor()
+	// paymentrun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	paymentrun.DefaultCreatedAt = paymentrunDescCreatedAt.Default.(func() time.Time)
+	// paymentrunDescUpdatedAt is the schema descriptor for updated_at field.
+	paymentrunDescUpdatedAt := paymentrunFields[24].Descriptor()
+	// paymentrun.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	paymentrun.DefaultUpdatedAt = paymentrunDescUpdatedAt.Default.(func() time.Time)
+	// paymentrun.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	paymentrun.UpdateDefaultUpdatedAt = paymentrunDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// paymentrunDescID is the schema descriptor for id field.
+	paymentrunDescID := paymentrunFields[0].Descriptor()
+	// paymentrun.DefaultID holds the default value on creation for the id field.
+	paymentrun.DefaultID = paymentrunDescID.Default.(func() uuid.UUID)
+	paymentrunitemFields := schema.PaymentRunItem{}.Fields()
+	_ = paymentrunitemFields
+	// paymentrunitemDescStatus is the schema descriptor for status field.
+	paymentrunitemDescStatus := paymentrunitemFields[12].Descriptor()
+	// paymentrunitem.DefaultStatus holds the default value on creation for the status field.
+	paymentrunitem.DefaultStatus = paymentrunitemDescStatus.Default.(string)
+	// paymentrunitemDescCreatedAt is the schema descriptor for created_at field.
+	paymentrunitemDescCreatedAt := paymentrunitemFields[14].Descriptor()
+	// paymentrunitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	paymentrunitem.DefaultCreatedAt = paymentrunitemDescCreatedAt.Default.(func() time.Time)
+	// paymentrunitemDescID is the schema descriptor for id field.
+	paymentrunitemDescID := paymentrunitemFields[0].Descriptor()
+	// paymentrunitem.DefaultID holds the default value on creation for the id field.
+	paymentrunitem.DefaultID = paymentrunitemDescID.Default.(func() uuid.UUID)
 	paymenttransactionFields := schema.PaymentTransaction{}.Fields()
 	_ = paymenttransactionFields
 	// paymenttransactionDescTransactionType is the schema descriptor for transaction_type field.
@@ -206,15 +875,15 @@ func init() {
 	// paymenttransaction.DefaultStatus holds the default value on creation for the status field.
 	paymenttransaction.DefaultStatus = paymenttransactionDescStatus.Default.(string)
 	// paymenttransactionDescMetadata is the schema descriptor for metadata field.
-	paymenttransactionDescMetadata := paymenttransactionFields[10].Descriptor()
+	paymenttransactionDescMetadata := paymenttransactionFields[11].Descriptor()
 	// paymenttransaction.DefaultMetadata holds the default value on creation for the metadata field.
 	paymenttransaction.DefaultMetadata = paymenttransactionDescMetadata.Default.(map[string]interface{})
 	// paymenttransactionDescCreatedAt is the schema descriptor for created_at field.
-	paymenttransactionDescCreatedAt := paymenttransactionFields[11].Descriptor()
+	paymenttransactionDescCreatedAt := paymenttransactionFields[12].Descriptor()
 	// paymenttransaction.DefaultCreatedAt holds the default value on creation for the created_at field.
 	paymenttransaction.DefaultCreatedAt = paymenttransactionDescCreatedAt.Default.(func() time.Time)
 	// paymenttransactionDescUpdatedAt is the schema descriptor for updated_at field.
-	paymenttransactionDescUpdatedAt := paymenttransactionFields[12].Descriptor()
+	paymenttransactionDescUpdatedAt := paymenttransactionFields[13].Descriptor()
 	// paymenttransaction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	paymenttransaction.DefaultUpdatedAt = paymenttransactionDescUpdatedAt.Default.(func() time.Time)
 	// paymenttransaction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -223,6 +892,380 @@ func init() {
 	paymenttransactionDescID := paymenttransactionFields[0].Descriptor()
 	// paymenttransaction.DefaultID holds the default value on creation for the id field.
 	paymenttransaction.DefaultID = paymenttransactionDescID.Default.(func() uuid.UUID)
+	provisionpolicyFields := schema.ProvisionPolicy{}.Fields()
+	_ = provisionpolicyFields
+	// provisionpolicyDescEnabled is the schema descriptor for enabled field.
+	provisionpolicyDescEnabled := provisionpolicyFields[4].Descriptor()
+	// provisionpolicy.DefaultEnabled holds the default value on creation for the enabled field.
+	provisionpolicy.DefaultEnabled = provisionpolicyDescEnabled.Default.(bool)
+	// provisionpolicyDescCreatedAt is the schema descriptor for created_at field.
+	provisionpolicyDescCreatedAt := provisionpolicyFields[6].Descriptor()
+	// provisionpolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	provisionpolicy.DefaultCreatedAt = provisionpolicyDescCreatedAt.Default.(func() time.Time)
+	// provisionpolicyDescUpdatedAt is the schema descriptor for updated_at field.
+	provisionpolicyDescUpdatedAt := provisionpolicyFields[7].Descriptor()
+	// provisionpolicy.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	provisionpolicy.DefaultUpdatedAt = provisionpolicyDescUpdatedAt.Default.(func() time.Time)
+	// provisionpolicy.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	provisionpolicy.UpdateDefaultUpdatedAt = provisionpolicyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// provisionpolicyDescID is the schema descriptor for id field.
+	provisionpolicyDescID := provisionpolicyFields[0].Descriptor()
+	// provisionpolicy.DefaultID holds the default value on creation for the id field.
+	provisionpolicy.DefaultID = provisionpolicyDescID.Default.(func() uuid.UUID)
+	provisionrunFields := schema.ProvisionRun{}.Fields()
+	_ = provisionrunFields
+	// provisionrunDescTrigger is the schema descriptor for trigger field.
+	provisionrunDescTrigger := provisionrunFields[9].Descriptor()
+	// provisionrun.DefaultTrigger holds the default value on creation for the trigger field.
+	provisionrun.DefaultTrigger = provisionrunDescTrigger.Default.(string)
+	// provisionrunDescCreatedAt is the schema descriptor for created_at field.
+	provisionrunDescCreatedAt := provisionrunFields[11].Descriptor()
+	// provisionrun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	provisionrun.DefaultCreatedAt = provisionrunDescCreatedAt.Default.(func() time.Time)
+	// provisionrunDescID is the schema descriptor for id field.
+	provisionrunDescID := provisionrunFields[0].Descriptor()
+	// provisionrun.DefaultID holds the default value on creation for the id field.
+	provisionrun.DefaultID = provisionrunDescID.Default.(func() uuid.UUID)
+	reconciliationFields := schema.Reconciliation{}.Fields()
+	_ = reconciliationFields
+	// reconciliationDescStatus is the schema descriptor for status field.
+	reconciliationDescStatus := reconciliationFields[3].Descriptor()
+	// reconciliation.DefaultStatus holds the default value on creation for the status field.
+	reconciliation.DefaultStatus = reconciliationDescStatus.Default.(string)
+	// reconciliationDescCreatedAt is the schema descriptor for created_at field.
+	reconciliationDescCreatedAt := reconciliationFields[17].Descriptor()
+	// reconciliation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reconciliation.DefaultCreatedAt = reconciliationDescCreatedAt.Default.(func() time.Time)
+	// reconciliationDescUpdatedAt is the schema descriptor for updated_at field.
+	reconciliationDescUpdatedAt := reconciliationFields[18].Descriptor()
+	// reconciliation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reconciliation.DefaultUpdatedAt = reconciliationDescUpdatedAt.Default.(func() time.Time)
+	// reconciliation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reconciliation.UpdateDefaultUpdatedAt = reconciliationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// reconciliationDescID is the schema descriptor for id field.
+	reconciliationDescID := reconciliationFields[0].Descriptor()
+	// reconciliation.DefaultID holds the default value on creation for the id field.
+	reconciliation.DefaultID = reconciliationDescID.Default.(func() uuid.UUID)
+	reconciliationmatchFields := schema.ReconciliationMatch{}.Fields()
+	_ = reconciliationmatchFields
+	// reconciliationmatchDescMatchType is the schema descriptor for match_type field.
+	reconciliationmatchDescMatchType := reconciliationmatchFields[6].Descriptor()
+	// reconciliationmatch.DefaultMatchType holds the default value on creation for the match_type field.
+	reconciliationmatch.DefaultMatchType = reconciliationmatchDescMatchType.Default.(string)
+	// reconciliationmatchDescStatus is the schema descriptor for status field.
+	reconciliationmatchDescStatus := reconciliationmatchFields[8].Descriptor()
+	// reconciliationmatch.DefaultStatus holds the default value on creation for the status field.
+	reconciliationmatch.DefaultStatus = reconciliationmatchDescStatus.Default.(string)
+	// reconciliationmatchDescCreatedAt is the schema descriptor for created_at field.
+	reconciliationmatchDescCreatedAt := reconciliationmatchFields[20].Descriptor()
+	// reconciliationmatch.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reconciliationmatch.DefaultCreatedAt = reconciliationmatchDescCreatedAt.Default.(func() time.Time)
+	// reconciliationmatchDescUpdatedAt is the schema descriptor for updated_at field.
+	reconciliationmatchDescUpdatedAt := reconciliationmatchFields[21].Descriptor()
+	// reconciliationmatch.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reconciliationmatch.DefaultUpdatedAt = reconciliationmatchDescUpdatedAt.Default.(func() time.Time)
+	// reconciliationmatch.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reconciliationmatch.UpdateDefaultUpdatedAt = reconciliationmatchDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// reconciliationmatchDescID is the schema descriptor for id field.
+	reconciliationmatchDescID := reconciliationmatchFields[0].Descriptor()
+	// reconciliationmatch.DefaultID holds the default value on creation for the id field.
+	reconciliationmatch.DefaultID = reconciliationmatchDescID.Default.(func() uuid.UUID)
+	reconciliationmatchitemFields := schema.ReconciliationMatchItem{}.Fields()
+	_ = reconciliationmatchitemFields
+	// reconciliationmatchitemDescStatus is the schema descriptor for status field.
+	reconciliationmatchitemDescStatus := reconciliationmatchitemFields[9].Descriptor()
+	// reconciliationmatchitem.DefaultStatus holds the default value on creation for the status field.
+	reconciliationmatchitem.DefaultStatus = reconciliationmatchitemDescStatus.Default.(string)
+	// reconciliationmatchitemDescCreatedAt is the schema descriptor for created_at field.
+	reconciliationmatchitemDescCreatedAt := reconciliationmatchitemFields[10].Descriptor()
+	// reconciliationmatchitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reconciliationmatchitem.DefaultCreatedAt = reconciliationmatchitemDescCreatedAt.Default.(func() time.Time)
+	// reconciliationmatchitemDescID is the schema descriptor for id field.
+	reconciliationmatchitemDescID := reconciliationmatchitemFields[0].Descriptor()
+	// reconciliationmatchitem.DefaultID holds the default value on creation for the id field.
+	reconciliationmatchitem.DefaultID = reconciliationmatchitemDescID.Default.(func() uuid.UUID)
+	reconciliationruleFields := schema.ReconciliationRule{}.Fields()
+	_ = reconciliationruleFields
+	// reconciliationruleDescName is the schema descriptor for name field.
+	reconciliationruleDescName := reconciliationruleFields[3].Descriptor()
+	// reconciliationrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	reconciliationrule.NameValidator = reconciliationruleDescName.Validators[0].(func(string) error)
+	// reconciliationruleDescPriority is the schema descriptor for priority field.
+	reconciliationruleDescPriority := reconciliationruleFields[4].Descriptor()
+	// reconciliationrule.DefaultPriority holds the default value on creation for the priority field.
+	reconciliationrule.DefaultPriority = reconciliationruleDescPriority.Default.(int)
+	// reconciliationruleDescMatchField is the schema descriptor for match_field field.
+	reconciliationruleDescMatchField := reconciliationruleFields[5].Descriptor()
+	// reconciliationrule.DefaultMatchField holds the default value on creation for the match_field field.
+	reconciliationrule.DefaultMatchField = reconciliationruleDescMatchField.Default.(string)
+	// reconciliationruleDescCounterpart is the schema descriptor for counterpart field.
+	reconciliationruleDescCounterpart := reconciliationruleFields[7].Descriptor()
+	// reconciliationrule.DefaultCounterpart holds the default value on creation for the counterpart field.
+	reconciliationrule.DefaultCounterpart = reconciliationruleDescCounterpart.Default.(string)
+	// reconciliationruleDescDateWindowDays is the schema descriptor for date_window_days field.
+	reconciliationruleDescDateWindowDays := reconciliationruleFields[10].Descriptor()
+	// reconciliationrule.DefaultDateWindowDays holds the default value on creation for the date_window_days field.
+	reconciliationrule.DefaultDateWindowDays = reconciliationruleDescDateWindowDays.Default.(int)
+	// reconciliationruleDescConfidence is the schema descriptor for confidence field.
+	reconciliationruleDescConfidence := reconciliationruleFields[11].Descriptor()
+	// reconciliationrule.DefaultConfidence holds the default value on creation for the confidence field.
+	reconciliationrule.DefaultConfidence = reconciliationruleDescConfidence.Default.(int)
+	// reconciliationruleDescAutoMatch is the schema descriptor for auto_match field.
+	reconciliationruleDescAutoMatch := reconciliationruleFields[12].Descriptor()
+	// reconciliationrule.DefaultAutoMatch holds the default value on creation for the auto_match field.
+	reconciliationrule.DefaultAutoMatch = reconciliationruleDescAutoMatch.Default.(bool)
+	// reconciliationruleDescActive is the schema descriptor for active field.
+	reconciliationruleDescActive := reconciliationruleFields[13].Descriptor()
+	// reconciliationrule.DefaultActive holds the default value on creation for the active field.
+	reconciliationrule.DefaultActive = reconciliationruleDescActive.Default.(bool)
+	// reconciliationruleDescCreatedAt is the schema descriptor for created_at field.
+	reconciliationruleDescCreatedAt := reconciliationruleFields[15].Descriptor()
+	// reconciliationrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reconciliationrule.DefaultCreatedAt = reconciliationruleDescCreatedAt.Default.(func() time.Time)
+	// reconciliationruleDescUpdatedAt is the schema descriptor for updated_at field.
+	reconciliationruleDescUpdatedAt := reconciliationruleFields[16].Descriptor()
+	// reconciliationrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reconciliationrule.DefaultUpdatedAt = reconciliationruleDescUpdatedAt.Default.(func() time.Time)
+	// reconciliationrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reconciliationrule.UpdateDefaultUpdatedAt = reconciliationruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// reconciliationruleDescID is the schema descriptor for id field.
+	reconciliationruleDescID := reconciliationruleFields[0].Descriptor()
+	// reconciliationrule.DefaultID holds the default value on creation for the id field.
+	reconciliationrule.DefaultID = reconciliationruleDescID.Default.(func() uuid.UUID)
+	settlementbatchFields := schema.SettlementBatch{}.Fields()
+	_ = settlementbatchFields
+	// settlementbatchDescBatchNumber is the schema descriptor for batch_number field.
+	settlementbatchDescBatchNumber := settlementbatchFields[2].Descriptor()
+	// settlementbatch.BatchNumberValidator is a validator for the "batch_number" field. It is called by the builders before save.
+	settlementbatch.BatchNumberValidator = settlementbatchDescBatchNumber.Validators[0].(func(string) error)
+	// settlementbatchDescCurrency is the schema descriptor for currency field.
+	settlementbatchDescCurrency := settlementbatchFields[4].Descriptor()
+	// settlementbatch.DefaultCurrency holds the default value on creation for the currency field.
+	settlementbatch.DefaultCurrency = settlementbatchDescCurrency.Default.(string)
+	// settlementbatchDescStatus is the schema descriptor for status field.
+	settlementbatchDescStatus := settlementbatchFields[7].Descriptor()
+	// settlementbatch.DefaultStatus holds the default value on creation for the status field.
+	settlementbatch.DefaultStatus = settlementbatchDescStatus.Default.(string)
+	// settlementbatchDescTransactionCount is the schema descriptor for transaction_count field.
+	settlementbatchDescTransactionCount := settlementbatchFields[14].Descriptor()
+	// settlementbatch.DefaultTransactionCount holds the default value on creation for the transaction_count field.
+	settlementbatch.DefaultTransactionCount = settlementbatchDescTransactionCount.Default.(int)
+	// settlementbatchDescCreatedAt is the schema descriptor for created_at field.
+	settlementbatchDescCreatedAt := settlementbatchFields[21].Descriptor()
+	// settlementbatch.DefaultCreatedAt holds the default value on creation for the created_at field.
+	settlementbatch.DefaultCreatedAt = settlementbatchDescCreatedAt.Default.(func() time.Time)
+	// settlementbatchDescUpdatedAt is the schema descriptor for updated_at field.
+	settlementbatchDescUpdatedAt := settlementbatchFields[22].Descriptor()
+	// settlementbatch.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	settlementbatch.DefaultUpdatedAt = settlementbatchDescUpdatedAt.Default.(func() time.Time)
+	// settlementbatch.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	settlementbatch.UpdateDefaultUpdatedAt = settlementbatchDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// settlementbatchDescID is the schema descriptor for id field.
+	settlementbatchDescID := settlementbatchFields[0].Descriptor()
+	// settlementbatch.DefaultID holds the default value on creation for the id field.
+	settlementbatch.DefaultID = settlementbatchDescID.Default.(func() uuid.UUID)
+	settlementdisbursementFields := schema.SettlementDisbursement{}.Fields()
+	_ = settlementdisbursementFields
+	// settlementdisbursementDescCurrency is the schema descriptor for currency field.
+	settlementdisbursementDescCurrency := settlementdisbursementFields[7].Descriptor()
+	// settlementdisbursement.DefaultCurrency holds the default value on creation for the currency field.
+	settlementdisbursement.DefaultCurrency = settlementdisbursementDescCurrency.Default.(string)
+	// settlementdisbursementDescSequence is the schema descriptor for sequence field.
+	settlementdisbursementDescSequence := settlementdisbursementFields[8].Descriptor()
+	// settlementdisbursement.DefaultSequence holds the default value on creation for the sequence field.
+	settlementdisbursement.DefaultSequence = settlementdisbursementDescSequence.Default.(int)
+	// settlementdisbursementDescOriginatorConversationID is the schema descriptor for originator_conversation_id field.
+	settlementdisbursementDescOriginatorConversationID := settlementdisbursementFields[9].Descriptor()
+	// settlementdisbursement.OriginatorConversationIDValidator is a validator for the "originator_conversation_id" field. It is called by the builders before save.
+	settlementdisbursement.OriginatorConversationIDValidator = settlementdisbursementDescOriginatorConversationID.Validators[0].(func(string) error)
+	// settlementdisbursementDescStatus is the schema descriptor for status field.
+	settlementdisbursementDescStatus := settlementdisbursementFields[11].Descriptor()
+	// settlementdisbursement.DefaultStatus holds the default value on creation for the status field.
+	settlementdisbursement.DefaultStatus = settlementdisbursementDescStatus.Default.(string)
+	// settlementdisbursementDescAttempts is the schema descriptor for attempts field.
+	settlementdisbursementDescAttempts := settlementdisbursementFields[12].Descriptor()
+	// settlementdisbursement.DefaultAttempts holds the default value on creation for the attempts field.
+	settlementdisbursement.DefaultAttempts = settlementdisbursementDescAttempts.Default.(int)
+	// settlementdisbursementDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	settlementdisbursementDescNextAttemptAt := settlementdisbursementFields[13].Descriptor()
+	// settlementdisbursement.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	settlementdisbursement.DefaultNextAttemptAt = settlementdisbursementDescNextAttemptAt.Default.(func() time.Time)
+	// settlementdisbursementDescCreatedAt is the schema descriptor for created_at field.
+	settlementdisbursementDescCreatedAt := settlementdisbursementFields[21].Descriptor()
+	// settlementdisbursement.DefaultCreatedAt holds the default value on creation for the created_at field.
+	settlementdisbursement.DefaultCreatedAt = settlementdisbursementDescCreatedAt.Default.(func() time.Time)
+	// settlementdisbursementDescUpdatedAt is the schema descriptor for updated_at field.
+	settlementdisbursementDescUpdatedAt := settlementdisbursementFields[22].Descriptor()
+	// settlementdisbursement.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	settlementdisbursement.DefaultUpdatedAt = settlementdisbursementDescUpdatedAt.Default.(func() time.Time)
+	// settlementdisbursement.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	settlementdisbursement.UpdateDefaultUpdatedAt = settlementdisbursementDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// settlementdisbursementDescID is the schema descriptor for id field.
+	settlementdisbursementDescID := settlementdisbursementFields[0].Descriptor()
+	// settlementdisbursement.DefaultID holds the default value on creation for the id field.
+	settlementdisbursement.DefaultID = settlementdisbursementDescID.Default.(func() uuid.UUID)
+	settlementitemFields := schema.SettlementItem{}.Fields()
+	_ = settlementitemFields
+	// settlementitemDescStatus is the schema descriptor for status field.
+	settlementitemDescStatus := settlementitemFields[9].Descriptor()
+	// settlementitem.DefaultStatus holds the default value on creation for the status field.
+	settlementitem.DefaultStatus = settlementitemDescStatus.Default.(string)
+	// settlementitemDescCreatedAt is the schema descriptor for created_at field.
+	settlementitemDescCreatedAt := settlementitemFields[11].Descriptor()
+	// settlementitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	settlementitem.DefaultCreatedAt = settlementitemDescCreatedAt.Default.(func() time.Time)
+	// settlementitemDescID is the schema descriptor for id field.
+	settlementitemDescID := settlementitemFields[0].Descriptor()
+	// settlementitem.DefaultID holds the default value on creation for the id field.
+	settlementitem.DefaultID = settlementitemDescID.Default.(func() uuid.UUID)
+	settlementsettingFields := schema.SettlementSetting{}.Fields()
+	_ = settlementsettingFields
+	// settlementsettingDescCreatedAt is the schema descriptor for created_at field.
+	settlementsettingDescCreatedAt := settlementsettingFields[8].Descriptor()
+	// settlementsetting.DefaultCreatedAt holds the default value on creation for the created_at field.
+	settlementsetting.DefaultCreatedAt = settlementsettingDescCreatedAt.Default.(func() time.Time)
+	// settlementsettingDescUpdatedAt is the schema descriptor for updated_at field.
+	settlementsettingDescUpdatedAt := settlementsettingFields[9].Descriptor()
+	// settlementsetting.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	settlementsetting.DefaultUpdatedAt = settlementsettingDescUpdatedAt.Default.(func() time.Time)
+	// settlementsetting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	settlementsetting.UpdateDefaultUpdatedAt = settlementsettingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// settlementsettingDescID is the schema descriptor for id field.
+	settlementsettingDescID := settlementsettingFields[0].Descriptor()
+	// settlementsetting.DefaultID holds the default value on creation for the id field.
+	settlementsetting.DefaultID = settlementsettingDescID.Default.(func() uuid.UUID)
+	subscriptionFields := schema.Subscription{}.Fields()
+	_ = subscriptionFields
+	// subscriptionDescPlanCode is the schema descriptor for plan_code field.
+	subscriptionDescPlanCode := subscriptionFields[3].Descriptor()
+	// subscription.PlanCodeValidator is a validator for the "plan_code" field. It is called by the builders before save.
+	subscription.PlanCodeValidator = subscriptionDescPlanCode.Validators[0].(func(string) error)
+	// subscriptionDescCurrency is the schema descriptor for currency field.
+	subscriptionDescCurrency := subscriptionFields[6].Descriptor()
+	// subscription.DefaultCurrency holds the default value on creation for the currency field.
+	subscription.DefaultCurrency = subscriptionDescCurrency.Default.(string)
+	// subscriptionDescBillingInterval is the schema descriptor for billing_interval field.
+	subscriptionDescBillingInterval := subscriptionFields[7].Descriptor()
+	// subscription.DefaultBillingInterval holds the default value on creation for the billing_interval field.
+	subscription.DefaultBillingInterval = subscriptionDescBillingInterval.Default.(string)
+	// subscriptionDescIntervalCount is the schema descriptor for interval_count field.
+	subscriptionDescIntervalCount := subscriptionFields[8].Descriptor()
+	// subscription.DefaultIntervalCount holds the default value on creation for the interval_count field.
+	subscription.DefaultIntervalCount = subscriptionDescIntervalCount.Default.(int)
+	// subscription.IntervalCountValidator is a validator for the "interval_count" field. It is called by the builders before save.
+	subscription.IntervalCountValidator = subscriptionDescIntervalCount.Validators[0].(func(int) error)
+	// subscriptionDescBillingAnchorDay is the schema descriptor for billing_anchor_day field.
+	subscriptionDescBillingAnchorDay := subscriptionFields[9].Descriptor()
+	// subscription.BillingAnchorDayValidator is a validator for the "billing_anchor_day" field. It is called by the builders before save.
+	subscription.BillingAnchorDayValidator = func() func(int) error {
+		validators := subscriptionDescBillingAnchorDay.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(billing_anchor_day int) error {
+			for _, fn := range fns {
+				if err := fn(billing_anchor_day); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// subscriptionDescDaysUntilDue is the schema descriptor for days_until_due field.
+	subscriptionDescDaysUntilDue := subscriptionFields[10].Descriptor()
+	// subscription.DefaultDaysUntilDue holds the default value on creation for the days_until_due field.
+	subscription.DefaultDaysUntilDue = subscriptionDescDaysUntilDue.Default.(int)
+	// subscription.DaysUntilDueValidator is a validator for the "days_until_due" field. It is called by the builders before save.
+	subscription.DaysUntilDueValidator = subscriptionDescDaysUntilDue.Validators[0].(func(int) error)
+	// subscriptionDescStatus is the schema descriptor for status field.
+	subscriptionDescStatus := subscriptionFields[11].Descriptor()
+	// subscription.DefaultStatus holds the default value on creation for the status field.
+	subscription.DefaultStatus = subscriptionDescStatus.Default.(string)
+	// subscriptionDescCancelAtPeriodEnd is the schema descriptor for cancel_at_period_end field.
+	subscriptionDescCancelAtPeriodEnd := subscriptionFields[16].Descriptor()
+	// subscription.DefaultCancelAtPeriodEnd holds the default value on creation for the cancel_at_period_end field.
+	subscription.DefaultCancelAtPeriodEnd = subscriptionDescCancelAtPeriodEnd.Default.(bool)
+	// subscriptionDescMetadata is the schema descriptor for metadata field.
+	subscriptionDescMetadata := subscriptionFields[20].Descriptor()
+	// subscription.DefaultMetadata holds the default value on creation for the metadata field.
+	subscription.DefaultMetadata = subscriptionDescMetadata.Default.(map[string]interface{})
+	// subscriptionDescCreatedAt is the schema descriptor for created_at field.
+	subscriptionDescCreatedAt := subscriptionFields[21].Descriptor()
+	// subscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subscription.DefaultCreatedAt = subscriptionDescCreatedAt.Default.(func() time.Time)
+	// subscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	subscriptionDescUpdatedAt := subscriptionFields[22].Descriptor()
+	// subscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	subscription.DefaultUpdatedAt = subscriptionDescUpdatedAt.Default.(func() time.Time)
+	// subscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	subscription.UpdateDefaultUpdatedAt = subscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// subscriptionDescID is the schema descriptor for id field.
+	subscriptionDescID := subscriptionFields[0].Descriptor()
+	// subscription.DefaultID holds the default value on creation for the id field.
+	subscription.DefaultID = subscriptionDescID.Default.(func() uuid.UUID)
+	subscriptionadjustmentFields := schema.SubscriptionAdjustment{}.Fields()
+	_ = subscriptionadjustmentFields
+	// subscriptionadjustmentDescKind is the schema descriptor for kind field.
+	subscriptionadjustmentDescKind := subscriptionadjustmentFields[3].Descriptor()
+	// subscriptionadjustment.DefaultKind holds the default value on creation for the kind field.
+	subscriptionadjustment.DefaultKind = subscriptionadjustmentDescKind.Default.(string)
+	// subscriptionadjustmentDescDescription is the schema descriptor for description field.
+	subscriptionadjustmentDescDescription := subscriptionadjustmentFields[4].Descriptor()
+	// subscriptionadjustment.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	subscriptionadjustment.DescriptionValidator = subscriptionadjustmentDescDescription.Validators[0].(func(string) error)
+	// subscriptionadjustmentDescCurrency is the schema descriptor for currency field.
+	subscriptionadjustmentDescCurrency := subscriptionadjustmentFields[6].Descriptor()
+	// subscriptionadjustment.DefaultCurrency holds the default value on creation for the currency field.
+	subscriptionadjustment.DefaultCurrency = subscriptionadjustmentDescCurrency.Default.(string)
+	// subscriptionadjustmentDescMetadata is the schema descriptor for metadata field.
+	subscriptionadjustmentDescMetadata := subscriptionadjustmentFields[8].Descriptor()
+	// subscriptionadjustment.DefaultMetadata holds the default value on creation for the metadata field.
+	subscriptionadjustment.DefaultMetadata = subscriptionadjustmentDescMetadata.Default.(map[string]interface{})
+	// subscriptionadjustmentDescCreatedAt is the schema descriptor for created_at field.
+	subscriptionadjustmentDescCreatedAt := subscriptionadjustmentFields[9].Descriptor()
+	// subscriptionadjustment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subscriptionadjustment.DefaultCreatedAt = subscriptionadjustmentDescCreatedAt.Default.(func() time.Time)
+	// subscriptionadjustmentDescID is the schema descriptor for id field.
+	subscriptionadjustmentDescID := subscriptionadjustmentFields[0].Descriptor()
+	// subscriptionadjustment.DefaultID holds the default value on creation for the id field.
+	subscriptionadjustment.DefaultID = subscriptionadjustmentDescID.Default.(func() uuid.UUID)
+	subscriptionmeterFields := schema.SubscriptionMeter{}.Fields()
+	_ = subscriptionmeterFields
+	// subscriptionmeterDescCode is the schema descriptor for code field.
+	subscriptionmeterDescCode := subscriptionmeterFields[3].Descriptor()
+	// subscriptionmeter.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	subscriptionmeter.CodeValidator = subscriptionmeterDescCode.Validators[0].(func(string) error)
+	// subscriptionmeterDescAggregation is the schema descriptor for aggregation field.
+	subscriptionmeterDescAggregation := subscriptionmeterFields[6].Descriptor()
+	// subscriptionmeter.DefaultAggregation holds the default value on creation for the aggregation field.
+	subscriptionmeter.DefaultAggregation = subscriptionmeterDescAggregation.Default.(string)
+	// subscriptionmeterDescPricingModel is the schema descriptor for pricing_model field.
+	subscriptionmeterDescPricingModel := subscriptionmeterFields[7].Descriptor()
+	// subscriptionmeter.DefaultPricingModel holds the default value on creation for the pricing_model field.
+	subscriptionmeter.DefaultPricingModel = subscriptionmeterDescPricingModel.Default.(string)
+	// subscriptionmeterDescActive is the schema descriptor for active field.
+	subscriptionmeterDescActive := subscriptionmeterFields[10].Descriptor()
+	// subscriptionmeter.DefaultActive holds the default value on creation for the active field.
+	subscriptionmeter.DefaultActive = subscriptionmeterDescActive.Default.(bool)
+	// subscriptionmeterDescMetadata is the schema descriptor for metadata field.
+	subscriptionmeterDescMetadata := subscriptionmeterFields[11].Descriptor()
+	// subscriptionmeter.DefaultMetadata holds the default value on creation for the metadata field.
+	subscriptionmeter.DefaultMetadata = subscriptionmeterDescMetadata.Default.(map[string]interface{})
+	// subscriptionmeterDescCreatedAt is the schema descriptor for created_at field.
+	subscriptionmeterDescCreatedAt := subscriptionmeterFields[12].Descriptor()
+	// subscriptionmeter.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subscriptionmeter.DefaultCreatedAt = subscriptionmeterDescCreatedAt.Default.(func() time.Time)
+	// subscriptionmeterDescUpdatedAt is the schema descriptor for updated_at field.
+	subscriptionmeterDescUpdatedAt := subscriptionmeterFields[13].Descriptor()
+	// subscriptionmeter.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	subscriptionmeter.DefaultUpdatedAt = subscriptionmeterDescUpdatedAt.Default.(func() time.Time)
+	// subscriptionmeter.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	subscriptionmeter.UpdateDefaultUpdatedAt = subscriptionmeterDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// subscriptionmeterDescID is the schema descriptor for id field.
+	subscriptionmeterDescID := subscriptionmeterFields[0].Descriptor()
+	// subscriptionmeter.DefaultID holds the default value on creation for the id field.
+	subscriptionmeter.DefaultID = subscriptionmeterDescID.Default.(func() uuid.UUID)
 	treasurypermissionFields := schema.TreasuryPermission{}.Fields()
 	_ = treasurypermissionFields
 	// treasurypermissionDescPermissionCode is the schema descriptor for permission_code field.
@@ -305,6 +1348,24 @@ func init() {
 	treasuryuserDescID := treasuryuserFields[0].Descriptor()
 	// treasuryuser.DefaultID holds the default value on creation for the id field.
 	treasuryuser.DefaultID = treasuryuserDescID.Default.(func() uuid.UUID)
+	usagerecordFields := schema.UsageRecord{}.Fields()
+	_ = usagerecordFields
+	// usagerecordDescEventID is the schema descriptor for event_id field.
+	usagerecordDescEventID := usagerecordFields[4].Descriptor()
+	// usagerecord.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	usagerecord.EventIDValidator = usagerecordDescEventID.Validators[0].(func(string) error)
+	// usagerecordDescMetadata is the schema descriptor for metadata field.
+	usagerecordDescMetadata := usagerecordFields[8].Descriptor()
+	// usagerecord.DefaultMetadata holds the default value on creation for the metadata field.
+	usagerecord.DefaultMetadata = usagerecordDescMetadata.Default.(map[string]interface{})
+	// usagerecordDescCreatedAt is the schema descriptor for created_at field.
+	usagerecordDescCreatedAt := usagerecordFields[9].Descriptor()
+	// usagerecord.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usagerecord.DefaultCreatedAt = usagerecordDescCreatedAt.Default.(func() time.Time)
+	// usagerecordDescID is the schema descriptor for id field.
+	usagerecordDescID := usagerecordFields[0].Descriptor()
+	// usagerecord.DefaultID holds the default value on creation for the id field.
+	usagerecord.DefaultID = usagerecordDescID.Default.(func() uuid.UUID)
 	userroleassignmentFields := schema.UserRoleAssignment{}.Fields()
 	_ = userroleassignmentFields
 	// userroleassignmentDescAssignedAt is the schema descriptor for assigned_at field.
@@ -315,4 +1376,252 @@ func init() {
 	userroleassignmentDescID := userroleassignmentFields[0].Descriptor()
 	// userroleassignment.DefaultID holds the default value on creation for the id field.
 	userroleassignment.DefaultID = userroleassignmentDescID.Default.(func() uuid.UUID)
+	vendorFields := schema.Vendor{}.Fields()
+	_ = vendorFields
+	// vendorDescVendorNumber is the schema descriptor for vendor_number field.
+	vendorDescVendorNumber := vendorFields[2].Descriptor()
+	// vendor.VendorNumberValidator is a validator for the "vendor_number" field. It is called by the builders before save.
+	vendor.VendorNumberValidator = vendorDescVendorNumber.Validators[0].(func(string) error)
+	// vendorDescLegalName is the schema descriptor for legal_name field.
+	vendorDescLegalName := vendorFields[3].Descriptor()
+	// vendor.LegalNameValidator is a validator for the "legal_name" field. It is called by the builders before save.
+	vendor.LegalNameValidator = vendorDescLegalName.Validators[0].(func(string) error)
+	// vendorDescDefaultCurrency is the schema descriptor for default_currency field.
+	vendorDescDefaultCurrency := vendorFields[10].Descriptor()
+	// vendor.DefaultDefaultCurrency holds the default value on creation for the default_currency field.
+	vendor.DefaultDefaultCurrency = vendorDescDefaultCurrency.Default.(string)
+	// vendorDescPaymentTermsDays is the schema descriptor for payment_terms_days field.
+	vendorDescPaymentTermsDays := vendorFields[11].Descriptor()
+	// vendor.DefaultPaymentTermsDays holds the default value on creation for the payment_terms_days field.
+	vendor.DefaultPaymentTermsDays = vendorDescPaymentTermsDays.Default.(int)
+	// vendorDescStatus is the schema descriptor for status field.
+	vendorDescStatus := vendorFields[18].Descriptor()
+	// vendor.DefaultStatus holds the default value on creation for the status field.
+	vendor.DefaultStatus = vendorDescStatus.Default.(string)
+	// vendorDescMetadata is the schema descriptor for metadata field.
+	vendorDescMetadata := vendorFields[19].Descriptor()
+	// vendor.DefaultMetadata holds the default value on creation for the metadata field.
+	vendor.DefaultMetadata = vendorDescMetadata.Default.(map[string]interface{})
+	// vendorDescCreatedAt is the schema descriptor for created_at field.
+	vendorDescCreatedAt := vendorFields[20].Descriptor()
+	// vendor.DefaultCreatedAt holds the default value on creation for the created_at field.
+	vendor.DefaultCreatedAt = vendorDescCreatedAt.Default.(func() time.Time)
+	// vendorDescUpdatedAt is the schema descriptor for updated_at field.
+	vendorDescUpdatedAt := vendorFields[21].Descriptor()
+	// vendor.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	vendor.DefaultUpdatedAt = vendorDescUpdatedAt.Default.(func() time.Time)
+	// vendor.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	vendor.UpdateDefaultUpdatedAt = vendorDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// vendorDescID is the schema descriptor for id field.
+	vendorDescID := vendorFields[0].Descriptor()
+	// vendor.DefaultID holds the default value on creation for the id field.
+	vendor.DefaultID = vendorDescID.Default.(func() uuid.UUID)
+	vendorbillFields := schema.VendorBill{}.Fields()
+	_ = vendorbillFields
+	// vendorbillDescBillNumber is the schema descriptor for bill_number field.
+	vendorbillDescBillNumber := vendorbillFields[3].Descriptor()
+	// vendorbill.BillNumberValidator is a validator for the "bill_number" field. It is called by the builders before save.
+	vendorbill.BillNumberValidator = vendorbillDescBillNumber.Validators[0].(func(string) error)
+	// vendorbillDescCurrency is the schema descriptor for currency field.
+	vendorbillDescCurrency := vendorbillFields[7].Descriptor()
+	// vendorbill.DefaultCurrency holds the default value on creation for the currency field.
+	vendorbill.DefaultCurrency = vendorbillDescCurrency.Default.(string)
+	// vendorbillDescStatus is the schema descriptor for status field.
+	vendorbillDescStatus := vendorbillFields[17].Descriptor()
+	// vendorbill.DefaultStatus holds the default value on creation for the status field.
+	vendorbill.DefaultStatus = vendorbillDescStatus.Default.(string)
+	// vendorbillDescMetadata is the schema descriptor for metadata field.
+	vendorbillDescMetadata := vendorbillFields[34].Descriptor()
+	// vendorbill.DefaultMetadata holds the default value on creation for the metadata field.
+	vendorbill.DefaultMetadata = vendorbillDescMetadata.Default.(map[string]interface{})
+	// vendorbillDescCreatedAt is the schema descriptor for created_at field.
+	vendorbillDescCreatedAt := vendorbillFields[35].Descriptor()
+	// vendorbill.DefaultCreatedAt holds the default value on creation for the created_at field.
+	vendorbill.DefaultCreatedAt = vendorbillDescCreatedAt.Default.(func() time.Time)
+	// vendorbillDescUpdatedAt is the schema descriptor for updated_at field.
+	vendorbillDescUpdatedAt := vendorbillFields[36].Descriptor()
+	// vendorbill.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	vendorbill.DefaultUpdatedAt = vendorbillDescUpdatedAt.Default.(func() time.Time)
+	// vendorbill.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	vendorbill.UpdateDefaultUpdatedAt = vendorbillDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// vendorbillDescID is the schema descriptor for id field.
+	vendorbillDescID := vendorbillFields[0].Descriptor()
+	// vendorbill.DefaultID holds the default value on creation for the id field.
+	vendorbill.DefaultID = vendorbillDescID.Default.(func() uuid.UUID)
+	vendorbilllineFields := schema.VendorBillLine{}.Fields()
+	_ = vendorbilllineFields
+	// vendorbilllineDescDescription is the schema descriptor for description field.
+	vendorbilllineDescDescription := vendorbilllineFields[5].Descriptor()
+	// vendorbillline.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	vendorbillline.DescriptionValidator = vendorbilllineDescDescription.Validators[0].(func(string) error)
+	// vendorbilllineDescAccountCode is the schema descriptor for account_code field.
+	vendorbilllineDescAccountCode := vendorbilllineFields[8].Descriptor()
+	// vendorbillline.AccountCodeValidator is a validator for the "account_code" field. It is called by the builders before save.
+	vendorbillline.AccountCodeValidator = vendorbilllineDescAccountCode.Validators[0].(func(string) error)
+	// vendorbilllineDescMetadata is the schema descriptor for metadata field.
+	vendorbilllineDescMetadata := vendorbilllineFields[19].Descriptor()
+	// vendorbillline.DefaultMetadata holds the default value on creation for the metadata field.
+	vendorbillline.DefaultMetadata = vendorbilllineDescMetadata.Default.(map[string]interface{})
+	// vendorbilllineDescCreatedAt is the schema descriptor for created_at field.
+	vendorbilllineDescCreatedAt := vendorbilllineFields[20].Descriptor()
+	// vendorbillline.DefaultCreatedAt holds the default value on creation for the created_at field.
+	vendorbillline.DefaultCreatedAt = vendorbilllineDescCreatedAt.Default.(func() time.Time)
+	// vendorbilllineDescID is the schema descriptor for id field.
+	vendorbilllineDescID := vendorbilllineFields[0].Descriptor()
+	// vendorbillline.DefaultID holds the default value on creation for the id field.
+	vendorbillline.DefaultID = vendorbilllineDescID.Default.(func() uuid.UUID)
+	walletFields := schema.Wallet{}.Fields()
+	_ = walletFields
+	// walletDescOwnerID is the schema descriptor for owner_id field.
+	walletDescOwnerID := walletFields[3].Descriptor()
+	// wallet.OwnerIDValidator is a validator for the "owner_id" field. It is called by the builders before save.
+	wallet.OwnerIDValidator = walletDescOwnerID.Validators[0].(func(string) error)
+	// walletDescCurrency is the schema descriptor for currency field.
+	walletDescCurrency := walletFields[5].Descriptor()
+	// wallet.DefaultCurrency holds the default value on creation for the currency field.
+	wallet.DefaultCurrency = walletDescCurrency.Default.(string)
+	// walletDescCreatedAt is the schema descriptor for created_at field.
+	walletDescCreatedAt := walletFields[8].Descriptor()
+	// wallet.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wallet.DefaultCreatedAt = walletDescCreatedAt.Default.(func() time.Time)
+	// walletDescUpdatedAt is the schema descriptor for updated_at field.
+	walletDescUpdatedAt := walletFields[9].Descriptor()
+	// wallet.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	wallet.DefaultUpdatedAt = walletDescUpdatedAt.Default.(func() time.Time)
+	// wallet.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	wallet.UpdateDefaultUpdatedAt = walletDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// walletDescID is the schema descriptor for id field.
+	walletDescID := walletFields[0].Descriptor()
+	// wallet.DefaultID holds the default value on creation for the id field.
+	wallet.DefaultID = walletDescID.Default.(func() uuid.UUID)
+	walletholdFields := schema.WalletHold{}.Fields()
+	_ = walletholdFields
+	// walletholdDescStatus is the schema descriptor for status field.
+	walletholdDescStatus := walletholdFields[5].Descriptor()
+	// wallethold.DefaultStatus holds the default value on creation for the status field.
+	wallethold.DefaultStatus = walletholdDescStatus.Default.(string)
+	// walletholdDescCreatedAt is the schema descriptor for created_at field.
+	walletholdDescCreatedAt := walletholdFields[11].Descriptor()
+	// wallethold.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wallethold.DefaultCreatedAt = walletholdDescCreatedAt.Default.(func() time.Time)
+	// walletholdDescUpdatedAt is the schema descriptor for updated_at field.
+	walletholdDescUpdatedAt := walletholdFields[12].Descriptor()
+	// wallethold.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	wallethold.DefaultUpdatedAt = walletholdDescUpdatedAt.Default.(func() time.Time)
+	// wallethold.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	wallethold.UpdateDefaultUpdatedAt = walletholdDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// walletholdDescID is the schema descriptor for id field.
+	walletholdDescID := walletholdFields[0].Descriptor()
+	// wallethold.DefaultID holds the default value on creation for the id field.
+	wallethold.DefaultID = walletholdDescID.Default.(func() uuid.UUID)
+	wallettransactionFields := schema.WalletTransaction{}.Fields()
+	_ = wallettransactionFields
+	// wallettransactionDescCurrency is the schema descriptor for currency field.
+	wallettransactionDescCurrency := wallettransactionFields[7].Descriptor()
+	// wallettransaction.DefaultCurrency holds the default value on creation for the currency field.
+	wallettransaction.DefaultCurrency = wallettransactionDescCurrency.Default.(string)
+	// wallettransactionDescCreatedAt is the schema descriptor for created_at field.
+	wallettransactionDescCreatedAt := wallettransactionFields[13].Descriptor()
+	// wallettransaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wallettransaction.DefaultCreatedAt = wallettransactionDescCreatedAt.Default.(func() time.Time)
+	// wallettransactionDescID is the schema descriptor for id field.
+	wallettransactionDescID := wallettransactionFields[0].Descriptor()
+	// wallettransaction.DefaultID holds the default value on creation for the id field.
+	wallettransaction.DefaultID = wallettransactionDescID.Default.(func() uuid.UUID)
+	withholdingcertificateFields := schema.WithholdingCertificate{}.Fields()
+	_ = withholdingcertificateFields
+	// withholdingcertificateDescCertificateNumber is the schema descriptor for certificate_number field.
+	withholdingcertificateDescCertificateNumber := withholdingcertificateFields[2].Descriptor()
+	// withholdingcertificate.CertificateNumberValidator is a validator for the "certificate_number" field. It is called by the builders before save.
+	withholdingcertificate.CertificateNumberValidator = withholdingcertificateDescCertificateNumber.Validators[0].(func(string) error)
+	// withholdingcertificateDescVendorName is the schema descriptor for vendor_name field.
+	withholdingcertificateDescVendorName := withholdingcertificateFields[4].Descriptor()
+	// withholdingcertificate.VendorNameValidator is a validator for the "vendor_name" field. It is called by the builders before save.
+	withholdingcertificate.VendorNameValidator = withholdingcertificateDescVendorName.Validators[0].(func(string) error)
+	// withholdingcertificateDescBillNumber is the schema descriptor for bill_number field.
+	withholdingcertificateDescBillNumber := withholdingcertificateFields[7].Descriptor()
+	// withholdingcertificate.BillNumberValidator is a validator for the "bill_number" field. It is called by the builders before save.
+	withholdingcertificate.BillNumberValidator = withholdingcertificateDescBillNumber.Validators[0].(func(string) error)
+	// withholdingcertificateDescCategory is the schema descriptor for category field.
+	withholdingcertificateDescCategory := withholdingcertificateFields[9].Descriptor()
+	// withholdingcertificate.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	withholdingcertificate.CategoryValidator = withholdingcertificateDescCategory.Validators[0].(func(string) error)
+	// withholdingcertificateDescCategoryName is the schema descriptor for category_name field.
+	withholdingcertificateDescCategoryName := withholdingcertificateFields[10].Descriptor()
+	// withholdingcertificate.CategoryNameValidator is a validator for the "category_name" field. It is called by the builders before save.
+	withholdingcertificate.CategoryNameValidator = withholdingcertificateDescCategoryName.Validators[0].(func(string) error)
+	// withholdingcertificateDescCurrency is the schema descriptor for currency field.
+	withholdingcertificateDescCurrency := withholdingcertificateFields[12].Descriptor()
+	// withholdingcertificate.DefaultCurrency holds the default value on creation for the currency field.
+	withholdingcertificate.DefaultCurrency = withholdingcertificateDescCurrency.Default.(string)
+	// withholdingcertificateDescCreatedAt is the schema descriptor for created_at field.
+	withholdingcertificateDescCreatedAt := withholdingcertificateFields[17].Descriptor()
+	// withholdingcertificate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	withholdingcertificate.DefaultCreatedAt = withholdingcertificateDescCreatedAt.Default.(func() time.Time)
+	// withholdingcertificateDescID is the schema descriptor for id field.
+	withholdingcertificateDescID := withholdingcertificateFields[0].Descriptor()
+	// withholdingcertificate.DefaultID holds the default value on creation for the id field.
+	withholdingcertificate.DefaultID = withholdingcertificateDescID.Default.(func() uuid.UUID)
+	withholdingrateFields := schema.WithholdingRate{}.Fields()
+	_ = withholdingrateFields
+	// withholdingrateDescCategory is the schema descriptor for category field.
+	withholdingrateDescCategory := withholdingrateFields[2].Descriptor()
+	// withholdingrate.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	withholdingrate.CategoryValidator = withholdingrateDescCategory.Validators[0].(func(string) error)
+	// withholdingrateDescName is the schema descriptor for name field.
+	withholdingrateDescName := withholdingrateFields[3].Descriptor()
+	// withholdingrate.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	withholdingrate.NameValidator = withholdingrateDescName.Validators[0].(func(string) error)
+	// withholdingrateDescActive is the schema descriptor for active field.
+	withholdingrateDescActive := withholdingrateFields[5].Descriptor()
+	// withholdingrate.DefaultActive holds the default value on creation for the active field.
+	withholdingrate.DefaultActive = withholdingrateDescActive.Default.(bool)
+	// withholdingrateDescCreatedAt is the schema descriptor for created_at field.
+	withholdingrateDescCreatedAt := withholdingrateFields[7].Descriptor()
+	// withholdingrate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	withholdingrate.DefaultCreatedAt = withholdingrateDescCreatedAt.Default.(func() time.Time)
+	// withholdingrateDescUpdatedAt is the schema descriptor for updated_at field.
+	withholdingrateDescUpdatedAt := withholdingrateFields[8].Descriptor()
+	// withholdingrate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	withholdingrate.DefaultUpdatedAt = withholdingrateDescUpdatedAt.Default.(func() time.Time)
+	// withholdingrate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	withholdingrate.UpdateDefaultUpdatedAt = withholdingrateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// withholdingrateDescID is the schema descriptor for id field.
+	withholdingrateDescID := withholdingrateFields[0].Descriptor()
+	// withholdingrate.DefaultID holds the default value on creation for the id field.
+	withholdingrate.DefaultID = withholdingrateDescID.Default.(func() uuid.UUID)
+	writeoffFields := schema.WriteOff{}.Fields()
+	_ = writeoffFields
+	// writeoffDescReason is the schema descriptor for reason field.
+	writeoffDescReason := writeoffFields[7].Descriptor()
+	// writeoff.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	writeoff.ReasonValidator = writeoffDescReason.Validators[0].(func(string) error)
+	// writeoffDescStatus is the schema descriptor for status field.
+	writeoffDescStatus := writeoffFields[8].Descriptor()
+	// writeoff.DefaultStatus holds the default value on creation for the status field.
+	writeoff.DefaultStatus = writeoffDescStatus.Default.(string)
+	// writeoffDescCreatedAt is the schema descriptor for created_at field.
+	writeoffDescCreatedAt := writeoffFields[14].Descriptor()
+	// writeoff.DefaultCreatedAt holds the default value on creation for the created_at field.
+	writeoff.DefaultCreatedAt = writeoffDescCreatedAt.Default.(func() time.Time)
+	// writeoffDescUpdatedAt is the schema descriptor for updated_at field.
+	writeoffDescUpdatedAt := writeoffFields[15].Descriptor()
+	// writeoff.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	writeoff.DefaultUpdatedAt = writeoffDescUpdatedAt.Default.(func() time.Time)
+	// writeoff.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	writeoff.UpdateDefaultUpdatedAt = writeoffDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// writeoffDescID is the schema descriptor for id field.
+	writeoffDescID := writeoffFields[0].Descriptor()
+	// writeoff.DefaultID holds the default value on creation for the id field.
+	writeoff.DefaultID = writeoffDescID.Default.(func() uuid.UUID)
+	writeoffrecoveryFields := schema.WriteOffRecovery{}.Fields()
+	_ = writeoffrecoveryFields
+	// writeoffrecoveryDescRecoveredAt is the schema descriptor for recovered_at field.
+	writeoffrecoveryDescRecoveredAt := writeoffrecoveryFields[8].Descriptor()
+	// writeoffrecovery.DefaultRecoveredAt holds the default value on creation for the recovered_at field.
+	writeoffrecovery.DefaultRecoveredAt = writeoffrecoveryDescRecoveredAt.Default.(func() time.Time)
+	// writeoffrecoveryDescID is the schema descriptor for id field.
+	writeoffrecoveryDescID := writeoffrecoveryFields[0].Descriptor()
+	// writeoffrecovery.DefaultID holds the default value on creation for the id field.
+	writeoffrecovery.DefaultID = writeoffrecoveryDescID.Default.(func() uuid.UUID)
 }
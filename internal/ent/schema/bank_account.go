@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BankAccount holds the schema definition for a bank account, M-Pesa
+// shortcode or float wallet the tenant holds money in. The full account
+// number is never stored; only a masked form for display and a fingerprint
+// for matching statements and rejecting duplicates.
+type BankAccount struct {
+	ent.Schema
+}
+
+// Fields of the BankAccount.
+func (BankAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.String("account_name").
+			NotEmpty().
+			Comment("Name the account is known by, e.g. KCB Operating"),
+		field.String("bank_name").
+			NotEmpty().
+			Comment("Bank or provider, e.g. KCB, Safaricom"),
+		field.String("branch").
+			Optional(),
+		field.String("account_type").
+			NotEmpty().
+			Comment("Account type: bank, mpesa_paybill, mpesa_till, float_wallet"),
+		field.String("account_number_masked").
+			NotEmpty().
+			Comment("Account number with all but the last four characters masked"),
+		field.String("account_number_hash").
+			NotEmpty().
+			Sensitive().
+			Comment("SHA-256 fingerprint of the normalised account number"),
+		field.String("currency").
+			Default("KES").
+			Comment("ISO currency code"),
+		field.String("ledger_account_code").
+			NotEmpty().
+			Comment("Cash account in the chart of accounts the account is booked to"),
+		field.Float("statement_balance").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable().
+			Comment("Closing balance of the last imported statement"),
+		field.Time("statement_date").
+			Optional().
+			Nillable().
+			Comment("Date of the last imported statement balance"),
+		field.String("status").
+			Default("active").
+			Comment("Status: active, inactive, closed"),
+		field.JSON("metadata", map[string]any{}).
+			Default(map[string]any{}),
+		field.UUID("created_by", uuid.UUID{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the BankAccount.
+func (BankAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "account_number_hash").Unique(),
+		index.Fields("tenant_id", "ledger_account_code"),
+		index.Fields("tenant_id", "status"),
+	}
+}
@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BankStatement holds the schema definition for a statement file imported
+// for a bank account.
+type BankStatement struct {
+	ent.Schema
+}
+
+// Fields of the BankStatement.
+func (BankStatement) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("bank_account_id", uuid.UUID{}).
+			Comment("Bank account identifier"),
+		field.String("format").
+			Comment("File format: csv, mt940, camt053, mpesa"),
+		field.String("profile").
+			Optional().
+			Comment("CSV column mapping profile used"),
+		field.String("file_name").
+			Optional(),
+		field.String("file_hash").
+			Comment("SHA-256 of the imported file"),
+		field.String("statement_reference").
+			Optional().
+			Comment("Statement identifier assigned by the bank"),
+		field.String("currency").
+			Default("KES").
+			Comment("ISO currency code"),
+		field.Time("period_start").
+			Comment("Date of the first day covered"),
+		field.Time("period_end").
+			Comment("Date of the last day covered"),
+		field.Float("opening_balance").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable().
+			Comment("Balance at the start of the period, when the file states it"),
+		field.Float("closing_balance").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable().
+			Comment("Balance at the end of the period, when the file states it"),
+		field.String("continuity").
+			Comment("Opening balance check against the previous statement: continuous, first, gap, unverified"),
+		field.Float("expected_opening_balance").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable().
+			Comment("Opening balance implied by the previous statement"),
+		field.Int("transaction_count").
+			Comment("Transactions imported"),
+		field.Int("duplicate_count").
+			Comment("Transactions skipped as already imported"),
+		field.Int("linked_count").
+			Default(0).
+			Comment("Transactions linked to payment transactions by provider reference"),
+		field.UUID("imported_by", uuid.UUID{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the BankStatement.
+func (BankStatement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("transactions", BankTransaction.Type),
+	}
+}
+
+// Indexes of the BankStatement.
+func (BankStatement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("bank_account_id", "period_end"),
+		index.Fields("bank_account_id", "file_hash"),
+		index.Fields("tenant_id", "created_at"),
+	}
+}
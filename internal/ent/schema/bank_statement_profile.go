@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// BankStatementProfile holds the schema definition for a tenant's column
+// mapping of a bank's CSV statement export. Columns are named by their
+// header text.
+type BankStatementProfile struct {
+	ent.Schema
+}
+
+// Fields of the BankStatementProfile.
+func (BankStatementProfile) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.String("name").
+			NotEmpty().
+			Immutable().
+			Comment("Profile name, e.g. kcb or equity"),
+		field.String("delimiter").
+			Default(",").
+			Comment("Field separator"),
+		field.Int("skip_rows").
+			Optional().
+			Comment("Lines before the header row"),
+		field.String("date_column").
+			NotEmpty(),
+		field.String("date_format").
+			NotEmpty().
+			Comment("Go reference layout of the dates, e.g. 02/01/2006"),
+		field.String("value_date_column").
+			Optional(),
+		field.String("description_column").
+			Optional(),
+		field.String("reference_column").
+			Optional(),
+		field.String("counterparty_column").
+			Optional(),
+		field.String("amount_column").
+			Optional().
+			Comment("Signed amount column; used instead of debit and credit columns"),
+		field.String("debit_column").
+			Optional(),
+		field.String("credit_column").
+			Optional(),
+		field.String("balance_column").
+			Optional().
+			Comment("Running balance column the opening and closing balances are derived from"),
+		field.UUID("updated_by", uuid.UUID{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the BankStatementProfile.
+func (BankStatementProfile) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").Unique(),
+	}
+}
@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BankTransaction holds the schema definition for a transaction on a bank
+// account, as imported from a statement.
+type BankTransaction struct {
+	ent.Schema
+}
+
+// Fields of the BankTransaction.
+func (BankTransaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("bank_account_id", uuid.UUID{}).
+			Comment("Bank account identifier"),
+		field.UUID("statement_id", uuid.UUID{}).
+			Comment("Statement the transaction was first imported from"),
+		field.Time("transaction_date").
+			Comment("Booking date"),
+		field.Time("value_date").
+			Optional().
+			Nillable(),
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			Comment("Signed amount: positive for money in, negative for money out"),
+		field.String("currency").
+			Default("KES").
+			Comment("ISO currency code"),
+		field.String("transaction_type").
+			Comment("Transaction type: credit, debit"),
+		field.String("reference").
+			Optional().
+			Comment("Bank or payment reference"),
+		field.Text("description").
+			Optional(),
+		field.String("counterparty").
+			Optional().
+			Comment("Payer or payee named on the statement"),
+		field.Float("running_balance").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable().
+			Comment("Account balance after the transaction, when the statement shows it"),
+		field.String("dedupe_hash").
+			Comment("SHA-256 of date, amount, reference and occurrence used to skip overlapping imports"),
+		field.UUID("payment_transaction_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Payment transaction whose provider reference matches the line, linked on import"),
+		field.String("reconciliation_status").
+			Default("unreconciled").
+			Comment("Status: unreconciled, matched, reconciled"),
+		field.JSON("metadata", map[string]any{}).
+			Default(map[string]any{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the BankTransaction.
+func (BankTransaction) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("statement", BankStatement.Type).
+			Ref("transactions").
+			Field("statement_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the BankTransaction.
+func (BankTransaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("bank_account_id", "dedupe_hash").Unique(),
+		index.Fields("bank_account_id", "transaction_date"),
+		index.Fields("tenant_id", "reconciliation_status"),
+		index.Fields("reference"),
+		index.Fields("tenant_id", "payment_transaction_id"),
+	}
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BillingCycle holds the schema definition for billed subscription periods.
+type BillingCycle struct {
+	ent.Schema
+}
+
+// Fields of the BillingCycle.
+func (BillingCycle) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("subscription_id", uuid.UUID{}).
+			Comment("Subscription identifier"),
+		field.Time("cycle_start").
+			Comment("Cycle start"),
+		field.Time("cycle_end").
+			Comment("Cycle end (exclusive)"),
+		field.UUID("invoice_id", uuid.UUID{}).
+			Optional().
+			Comment("Generated invoice identifier"),
+		field.String("status").
+			Default("pending").
+			Comment("Status: pending, invoiced, failed"),
+		field.Float("usage_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Usage-based billing amount"),
+		field.Float("billing_amount").
+			GoType(decimal.Decimal{}).
+			Comment("Total billed amount"),
+		field.JSON("metadata", map[string]any{}).
+			Default(map[string]any{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the BillingCycle.
+func (BillingCycle) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("subscription", Subscription.Type).
+			Ref("billing_cycles").
+			Field("subscription_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the BillingCycle.
+func (BillingCycle) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("subscription_id", "cycle_start").Unique(), // One invoice per cycle
+		index.Fields("invoice_id"),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CashDrawerCount holds the schema definition for the count of one note or
+// coin denomination in a closed cash drawer.
+type CashDrawerCount struct {
+	ent.Schema
+}
+
+// Fields of the CashDrawerCount.
+func (CashDrawerCount) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("session_id", uuid.UUID{}).
+			Immutable(),
+		field.Float("denomination").
+			GoType(decimal.Decimal{}).
+			Immutable().
+			Comment("Face value of the note or coin"),
+		field.Int("quantity").
+			NonNegative().
+			Immutable(),
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			Immutable().
+			Comment("Denomination times quantity"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the CashDrawerCount.
+func (CashDrawerCount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("session_id"),
+	}
+}
@@ -0,0 +1,118 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CashDrawerSession holds the schema definition for a POS cash drawer session
+// reconciled when the drawer is closed: the cash expected in the drawer
+// against the cash counted.
+type CashDrawerSession struct {
+	ent.Schema
+}
+
+// Fields of the CashDrawerSession.
+func (CashDrawerSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.String("pos_session_id").
+			NotEmpty().
+			Immutable().
+			Comment("Drawer session identifier in the POS service"),
+		field.String("drawer_id").
+			NotEmpty().
+			Immutable().
+			Comment("Cash drawer identifier in the POS service"),
+		field.String("outlet_id").
+			Optional().
+			Immutable(),
+		field.String("cashier_id").
+			Optional().
+			Immutable().
+			Comment("POS user who ran the session"),
+		field.String("currency").
+			Default("KES").
+			Immutable(),
+		field.Time("opened_at").
+			Immutable(),
+		field.Time("closed_at").
+			Immutable(),
+		field.Float("opening_float").
+			GoType(decimal.Decimal{}).
+			Immutable(),
+		field.Float("cash_sales").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Cash payments taken into the drawer during the session"),
+		field.Float("cash_refunds").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Cash refunds paid out of the drawer during the session"),
+		field.Int("cash_transactions").
+			Optional().
+			Comment("Number of cash payments and refunds in the session"),
+		field.Float("expected_cash").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Opening float plus cash sales less cash refunds"),
+		field.Float("counted_cash").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Total of the denomination counts"),
+		field.Float("variance").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Counted less expected cash: positive when over, negative when short"),
+		field.String("status").
+			Default("pending_approval").
+			Comment("Status: balanced, posted, pending_approval, approved, rejected"),
+		field.String("notes").
+			Optional(),
+		field.String("rejection_reason").
+			Optional(),
+		field.UUID("reviewed_by", uuid.UUID{}).
+			Optional().
+			Comment("Supervisor who approved or rejected the variance"),
+		field.Time("reviewed_at").
+			Optional(),
+		field.UUID("journal_entry_id", uuid.UUID{}).
+			Optional().
+			Comment("Over/short journal of the variance"),
+		field.String("event_id").
+			Optional().
+			Immutable().
+			Comment("pos.cash.drawer.closed event the session was recorded from"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the CashDrawerSession.
+func (CashDrawerSession) Indexes() []ent.Index {
+	return []ent.Index{
+		// A POS drawer session is reconciled once.
+		index.Fields("tenant_id", "pos_session_id").Unique(),
+		index.Fields("tenant_id", "status"),
+		index.Fields("tenant_id", "drawer_id", "closed_at"),
+	}
+}
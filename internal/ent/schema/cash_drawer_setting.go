@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CashDrawerSetting holds the schema definition for a tenant's cash drawer
+// reconciliation rules.
+type CashDrawerSetting struct {
+	ent.Schema
+}
+
+// Fields of the CashDrawerSetting.
+func (CashDrawerSetting) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.Float("approval_threshold").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Largest variance posted without supervisor approval (defaults to zero)"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the CashDrawerSetting.
+func (CashDrawerSetting) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id").Unique(),
+	}
+}
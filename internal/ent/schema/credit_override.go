@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CreditOverride holds the schema definition for a one-off authorisation to
+// invoice or sell on account to a customer beyond their credit limit or while
+// on credit hold. Overrides are the audit trail of who allowed what and why.
+type CreditOverride struct {
+	ent.Schema
+}
+
+// Fields of the CreditOverride.
+func (CreditOverride) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("customer_id", uuid.UUID{}).
+			Comment("Customer identifier"),
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			Comment("Largest document the override covers"),
+		field.String("currency").
+			Comment("ISO currency code"),
+		field.String("reason").
+			NotEmpty(),
+		field.UUID("granted_by", uuid.UUID{}).
+			Optional(),
+		field.Time("expires_at").
+			Comment("The override lapses if unused by this time"),
+		field.String("status").
+			Default("active").
+			Comment("Status: active, used, revoked"),
+		field.String("document_type").
+			Optional().
+			Comment("Document the override was used for: invoice, payment_intent"),
+		field.UUID("document_id", uuid.UUID{}).
+			Optional(),
+		field.Float("document_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable().
+			Comment("Amount of the document the override was used for"),
+		field.JSON("credit_snapshot", map[string]any{}).
+			Optional().
+			Comment("Credit position (limit, exposure, hold) when the override was used"),
+		field.Time("used_at").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the CreditOverride.
+func (CreditOverride) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "customer_id", "status"),
+	}
+}
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/customers/profile"
+)
+
+// Customer holds the schema definition for a billing customer owned by
+// treasury. Invoices and payment intents reference it through customer_id.
+type Customer struct {
+	ent.Schema
+}
+
+// Fields of the Customer.
+func (Customer) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.String("customer_number").
+			NotEmpty().
+			Immutable().
+			Comment("Sequential customer number"),
+		field.String("legal_name").
+			NotEmpty().
+			Comment("Registered legal name"),
+		field.String("trading_name").
+			Optional(),
+		field.String("kra_pin").
+			Optional().
+			Comment("KRA PIN (tax identification number)"),
+		field.String("email").
+			Optional().
+			Comment("Billing email"),
+		field.String("phone").
+			Optional().
+			Comment("Billing phone"),
+		field.JSON("billing_addresses", []profile.Address{}).
+			Optional(),
+		field.JSON("contacts", []profile.Contact{}).
+			Optional(),
+		field.String("default_currency").
+			Default("KES").
+			Comment("ISO currency code used for new invoices"),
+		field.Int("payment_terms_days").
+			Default(30).
+			Comment("Days from invoice date to due date"),
+		field.Float("credit_limit").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable().
+			Comment("Credit limit in the default currency (empty = no limit)"),
+		field.Bool("credit_hold").
+			Default(false).
+			Comment("New invoices and on-account sales are blocked while on hold"),
+		field.String("credit_hold_source").
+			Optional().
+			Comment("Hold source: auto (overdue invoices), manual"),
+		field.String("credit_hold_reason").
+			Optional(),
+		field.Time("credit_hold_at").
+			Optional(),
+		field.UUID("auth_user_id", uuid.UUID{}).
+			Optional().
+			Comment("Linked auth-service user, for customers who log in"),
+		field.String("status").
+			Default("active").
+			Comment("Status: active, inactive"),
+		field.JSON("metadata", map[string]any{}).
+			Default(map[string]any{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the Customer.
+func (Customer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "customer_number").Unique(),
+		index.Fields("tenant_id", "legal_name"),
+		index.Fields("tenant_id", "kra_pin"),
+		index.Fields("tenant_id", "auth_user_id"),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CustomerStatement holds the schema definition for a generated customer
+// statement stored in object storage. The unique period key keeps scheduled
+// runs from generating a statement twice.
+type CustomerStatement struct {
+	ent.Schema
+}
+
+// Fields of the CustomerStatement.
+func (CustomerStatement) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("customer_id", uuid.UUID{}).
+			Comment("Customer identifier"),
+		field.String("currency").
+			Comment("ISO currency code"),
+		field.Time("period_start").
+			Comment("First day of the statement period"),
+		field.Time("period_end").
+			Comment("Last day of the statement period"),
+		field.Float("opening_balance").
+			GoType(decimal.Decimal{}),
+		field.Float("closing_balance").
+			GoType(decimal.Decimal{}),
+		field.String("object_key").
+			Comment("Object storage key of the PDF"),
+		field.String("trigger").
+			Default("scheduled").
+			Comment("Trigger: scheduled, manual"),
+		field.Time("generated_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the CustomerStatement.
+func (CustomerStatement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "customer_id"),
+		index.Fields("customer_id", "currency", "period_start", "period_end").Unique(),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DocumentSequence holds the schema definition for per-tenant document number sequences.
+type DocumentSequence struct {
+	ent.Schema
+}
+
+// Fields of the DocumentSequence.
+func (DocumentSequence) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.String("name").
+			NotEmpty().
+			Comment("Sequence name (invoice, bill, statement)"),
+		field.Int64("last_value").
+			Default(0).
+			Comment("Last number handed out"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the DocumentSequence.
+func (DocumentSequence) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").Unique(),
+	}
+}
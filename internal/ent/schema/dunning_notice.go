@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DunningNotice holds the schema definition for a dunning step executed for an
+// invoice. The unique step key keeps every step to a single execution.
+type DunningNotice struct {
+	ent.Schema
+}
+
+// Fields of the DunningNotice.
+func (DunningNotice) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("invoice_id", uuid.UUID{}).
+			Comment("Invoice identifier"),
+		field.UUID("customer_id", uuid.UUID{}).
+			Optional().
+			Comment("Customer identifier"),
+		field.Int("offset_days").
+			Comment("Step offset relative to the due date"),
+		field.String("action").
+			Comment("Action: reminder, late_fee"),
+		field.String("channel").
+			Optional(),
+		field.UUID("late_fee_invoice_id", uuid.UUID{}).
+			Optional().
+			Comment("Invoice raised for a late fee step"),
+		field.Time("executed_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the DunningNotice.
+func (DunningNotice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("invoice_id", "offset_days", "action").Unique(),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DunningPause holds the schema definition for a customer whose dunning is
+// paused (e.g. while a dispute or payment plan is in progress).
+type DunningPause struct {
+	ent.Schema
+}
+
+// Fields of the DunningPause.
+func (DunningPause) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("customer_id", uuid.UUID{}).
+			Comment("Customer identifier"),
+		field.String("reason").
+			Optional(),
+		field.UUID("paused_by", uuid.UUID{}).
+			Optional().
+			Comment("User who paused dunning"),
+		field.Time("paused_until").
+			Optional().
+			Comment("Dunning resumes automatically after this time (empty = until removed)"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the DunningPause.
+func (DunningPause) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "customer_id").Unique(),
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// DunningStep holds the schema definition for one step of a tenant's dunning
+// sequence, relative to an invoice's due date.
+type DunningStep struct {
+	ent.Schema
+}
+
+// Fields of the DunningStep.
+func (DunningStep) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.Int("offset_days").
+			Comment("Days relative to the due date (negative = before due)"),
+		field.String("action").
+			Default("reminder").
+			Comment("Action: reminder, late_fee"),
+		field.String("channel").
+			Optional().
+			Comment("Notification channel hint for reminders (email, sms, whatsapp)"),
+		field.String("template").
+			Optional().
+			Comment("Notification template code for reminders"),
+		field.String("late_fee_type").
+			Optional().
+			Comment("Late fee type: flat, percent"),
+		field.Float("late_fee_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Flat fee, or the fraction of the outstanding balance for percent fees"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the DunningStep.
+func (DunningStep) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "offset_days", "action").Unique(),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// EarningsSetting holds the schema definition for a tenant's payee payout
+// rules.
+type EarningsSetting struct {
+	ent.Schema
+}
+
+// Fields of the EarningsSetting.
+func (EarningsSetting) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.Float("minimum_payout").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Smallest payout a payee may request (defaults to zero)"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the EarningsSetting.
+func (EarningsSetting) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id").Unique(),
+	}
+}
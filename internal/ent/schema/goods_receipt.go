@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// GoodsReceipt holds the schema definition for a goods received note against
+// a purchase order, recorded from inventory.po.received for three-way
+// matching of vendor bills.
+type GoodsReceipt struct {
+	ent.Schema
+}
+
+// Fields of the GoodsReceipt.
+func (GoodsReceipt) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.String("event_id").
+			NotEmpty().
+			Immutable().
+			Comment("Inbound event the receipt was recorded from"),
+		field.String("po_id").
+			Optional().
+			Comment("Purchase order identifier in the inventory service"),
+		field.String("po_number").
+			NotEmpty().
+			Comment("Purchase order number quoted on vendor bills"),
+		field.String("grn_id").
+			NotEmpty().
+			Comment("Goods received note identifier in the inventory service"),
+		field.String("grn_number").
+			Optional(),
+		field.UUID("vendor_id", uuid.UUID{}).
+			Optional().
+			Comment("Treasury vendor the goods were received from"),
+		field.String("currency").
+			Default("KES").
+			Comment("ISO currency code of the purchase order"),
+		field.Time("received_at"),
+		field.JSON("metadata", map[string]any{}).
+			Default(map[string]any{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the GoodsReceipt.
+func (GoodsReceipt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("lines", GoodsReceiptLine.Type),
+	}
+}
+
+// Indexes of the GoodsReceipt.
+func (GoodsReceipt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "grn_id").Unique(),
+		index.Fields("tenant_id", "po_number"),
+	}
+}
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// GoodsReceiptLine holds the schema definition for an item received on a
+// goods received note.
+type GoodsReceiptLine struct {
+	ent.Schema
+}
+
+// Fields of the GoodsReceiptLine.
+func (GoodsReceiptLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("receipt_id", uuid.UUID{}).
+			Comment("Goods receipt identifier"),
+		field.Int("line_number"),
+		field.String("item_code").
+			NotEmpty().
+			Comment("Item code (SKU) as ordered"),
+		field.Text("description").
+			Optional(),
+		field.Float("ordered_quantity").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Quantity on the purchase order (defaults to zero)"),
+		field.Float("received_quantity").
+			GoType(decimal.Decimal{}).
+			Comment("Quantity received on this note"),
+		field.Float("unit_price").
+			GoType(decimal.Decimal{}).
+			Comment("Purchase order unit price before tax"),
+	}
+}
+
+// Edges of the GoodsReceiptLine.
+func (GoodsReceiptLine) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("receipt", GoodsReceipt.Type).
+			Ref("lines").
+			Field("receipt_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the GoodsReceiptLine.
+func (GoodsReceiptLine) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("receipt_id", "line_number").Unique(),
+		index.Fields("tenant_id", "item_code"),
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
@@ -28,7 +30,7 @@ func (Invoice) Fields() []ent.Field {
 			Comment("Sequential invoice number"),
 		field.UUID("customer_id", uuid.UUID{}).
 			Optional().
-			Comment("Customer identifier (from auth-service)"),
+			Comment("Customer identifier (treasury customer, or auth-service user)"),
 		field.String("invoice_type").
 			Default("standard").
 			Comment("Invoice type: standard, tax, proforma, recurring, credit_note, debit_note"),
@@ -51,10 +53,15 @@ func (Invoice) Fields() []ent.Field {
 			Comment("ISO currency code"),
 		field.String("status").
 			Default("draft").
-			Comment("Status: draft, sent, paid, overdue, cancelled"),
+			Comment("Status: draft, sent, paid, overdue, cancelled, written_off"),
 		field.String("payment_status").
 			Default("unpaid").
 			Comment("Payment status: unpaid, partial, paid, overpaid"),
+		field.Float("written_off_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Amount written off as bad debt, net of recoveries (defaults to zero)"),
 		field.UUID("reference_id", uuid.UUID{}).
 			Optional().
 			Comment("Reference ID (e.g., order_id, subscription_id)"),
@@ -72,6 +79,13 @@ func (Invoice) Fields() []ent.Field {
 	}
 }
 
+// Edges of the Invoice.
+func (Invoice) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("lines", InvoiceLine.Type),
+	}
+}
+
 // Indexes of the Invoice.
 func (Invoice) Indexes() []ent.Index {
 	return []ent.Index{
@@ -83,6 +97,11 @@ func (Invoice) Indexes() []ent.Index {
 		index.Fields("invoice_date"),
 		index.Fields("due_date"),
 		index.Fields("tenant_id", "status"),
+		// Orders and milestones are invoiced once; other references (e.g.
+		// subscriptions) produce an invoice per cycle.
+		index.Fields("tenant_id", "reference_type", "reference_id").
+			Unique().
+			Annotations(entsql.IndexWhere("reference_type IN ('order', 'milestone')")),
 	}
 }
 
@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceLine holds the schema definition for invoice line items.
+type InvoiceLine struct {
+	ent.Schema
+}
+
+// Fields of the InvoiceLine.
+func (InvoiceLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("invoice_id", uuid.UUID{}).
+			Comment("Invoice identifier"),
+		field.Int("line_number").
+			Comment("Line sequence number"),
+		field.Text("description").
+			NotEmpty(),
+		field.Float("quantity").
+			GoType(decimal.Decimal{}).
+			Comment("Quantity"),
+		field.Float("unit_price").
+			GoType(decimal.Decimal{}).
+			Comment("Unit price"),
+		field.Float("tax_rate").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Tax rate as a fraction (0.16 for 16% VAT)"),
+		field.Float("tax_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Tax amount (defaults to zero)"),
+		field.Float("line_total").
+			GoType(decimal.Decimal{}).
+			Comment("Line total before tax (quantity * unit_price)"),
+		field.String("revenue_account").
+			Optional().
+			Comment("Ledger account code credited for the line"),
+		field.String("reference_type").
+			Optional().
+			Comment("Source of the line (subscription, adjustment, meter)"),
+		field.UUID("reference_id", uuid.UUID{}).
+			Optional().
+			Comment("Source entity identifier"),
+		field.JSON("metadata", map[string]any{}).
+			Default(map[string]any{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the InvoiceLine.
+func (InvoiceLine) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("invoice", Invoice.Type).
+			Ref("lines").
+			Field("invoice_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the InvoiceLine.
+func (InvoiceLine) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("invoice_id"),
+		index.Fields("tenant_id"),
+		index.Fields("reference_type", "reference_id"),
+	}
+}
@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// InvoicePayment holds the schema definition for payment applications to invoices.
+type InvoicePayment struct {
+	ent.Schema
+}
+
+// Fields of the InvoicePayment.
+func (InvoicePayment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("invoice_id", uuid.UUID{}).
+			Comment("Invoice the payment is applied to"),
+		field.UUID("payment_transaction_id", uuid.UUID{}).
+			Comment("Payment transaction being applied"),
+		field.UUID("customer_id", uuid.UUID{}).
+			Optional().
+			Comment("Customer identifier copied from the invoice"),
+		field.Float("amount_applied").
+			GoType(decimal.Decimal{}).
+			Comment("Amount applied to the invoice"),
+		field.String("currency").
+			Default("KES").
+			Comment("ISO currency code"),
+		field.String("allocation_method").
+			Default("manual").
+			Comment("Allocation method: auto, manual"),
+		field.UUID("allocated_by", uuid.UUID{}).
+			Optional().
+			Comment("User who allocated the payment (empty for system allocations)"),
+		field.UUID("journal_entry_id", uuid.UUID{}).
+			Optional().
+			Comment("Ledger journal posted for the allocation"),
+		field.Time("applied_at").
+			Default(time.Now).
+			Comment("Application timestamp"),
+		field.JSON("metadata", map[string]any{}).
+			Default(map[string]any{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the InvoicePayment.
+func (InvoicePayment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("invoice", Invoice.Type).
+			Field("invoice_id").
+			Required().
+			Unique(),
+		edge.To("payment", PaymentTransaction.Type).
+			Field("payment_transaction_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the InvoicePayment.
+func (InvoicePayment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("invoice_id"),
+		index.Fields("payment_transaction_id"),
+		index.Fields("tenant_id", "customer_id"),
+		index.Fields("applied_at"),
+	}
+}
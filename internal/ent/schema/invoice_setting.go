@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceSetting holds the schema definition for a tenant's invoicing
+// preferences, including how invoices generated from upstream events are
+// handled.
+type InvoiceSetting struct {
+	ent.Schema
+}
+
+// Fields of the InvoiceSetting.
+func (InvoiceSetting) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.Bool("auto_issue_orders").
+			Default(false).
+			Comment("Issue invoices from cafe orders immediately instead of leaving drafts"),
+		field.Bool("auto_issue_milestones").
+			Default(false).
+			Comment("Issue invoices from project milestones immediately instead of leaving drafts"),
+		field.Int("order_payment_terms_days").
+			Default(0).
+			NonNegative().
+			Comment("Days until order invoices are due"),
+		field.Int("milestone_payment_terms_days").
+			Default(30).
+			NonNegative().
+			Comment("Days until milestone invoices are due"),
+		field.Int("credit_hold_days").
+			Default(0).
+			NonNegative().
+			Comment("Put customers on credit hold once an invoice is this many days overdue (0 = never)"),
+		field.Float("default_tax_rate").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Tax rate applied to itemised lines that do not carry one"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the InvoiceSetting.
+func (InvoiceSetting) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id").Unique(),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PayableSetting holds the schema definition for a tenant's accounts payable
+// preferences, including the tolerances used when matching vendor bills to
+// goods receipts.
+type PayableSetting struct {
+	ent.Schema
+}
+
+// Fields of the PayableSetting.
+func (PayableSetting) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.Float("quantity_tolerance").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Fraction a billed quantity may exceed the unbilled received quantity by (defaults to zero)"),
+		field.Float("price_tolerance").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Fraction a billed unit price may differ from the purchase order price by (defaults to zero)"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the PayableSetting.
+func (PayableSetting) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id").Unique(),
+	}
+}
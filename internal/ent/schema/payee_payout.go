@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PayeePayout holds the schema definition for a payout of a payee wallet's
+// balance to the rider or driver.
+type PayeePayout struct {
+	ent.Schema
+}
+
+// Fields of the PayeePayout.
+func (PayeePayout) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("wallet_id", uuid.UUID{}).
+			Immutable(),
+		field.String("payout_number").
+			NotEmpty().
+			Immutable(),
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			Immutable(),
+		field.String("currency").
+			Default("KES").
+			Immutable(),
+		field.String("destination").
+			Optional().
+			Comment("M-Pesa number the payout is sent to"),
+		field.String("status").
+			Default("pending").
+			Comment("Status: pending, completed, rejected"),
+		field.String("request_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Payout request identifier in the logistics service"),
+		field.String("rejection_code").
+			Optional().
+			Comment("Reason code of a rejected payout"),
+		field.String("rejection_reason").
+			Optional(),
+		field.String("payment_reference").
+			Optional().
+			Comment("M-Pesa receipt or bank reference of the payment"),
+		field.UUID("requested_by", uuid.UUID{}).
+			Optional().
+			Immutable().
+			Comment("User who requested the payout; empty when requested by the logistics service"),
+		field.UUID("completed_by", uuid.UUID{}).
+			Optional(),
+		field.Time("completed_at").
+			Optional(),
+		field.UUID("journal_entry_id", uuid.UUID{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the PayeePayout.
+func (PayeePayout) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "payout_number").Unique(),
+		// A logistics payout request is recorded once.
+		index.Fields("tenant_id", "request_id").Unique(),
+		index.Fields("tenant_id", "status"),
+		index.Fields("wallet_id"),
+	}
+}
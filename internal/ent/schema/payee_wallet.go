@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PayeeWallet holds the schema definition for the earnings wallet of a rider
+// or driver paid through the logistics service.
+type PayeeWallet struct {
+	ent.Schema
+}
+
+// Fields of the PayeeWallet.
+func (PayeeWallet) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.String("payee_id").
+			NotEmpty().
+			Immutable().
+			Comment("Rider or driver identifier in the logistics service"),
+		field.String("payee_type").
+			Default("rider").
+			Comment("Payee type: rider, driver"),
+		field.String("name").
+			Optional(),
+		field.String("phone").
+			Optional().
+			Comment("M-Pesa number payouts are sent to"),
+		field.String("currency").
+			Default("KES").
+			Immutable().
+			Comment("ISO currency code"),
+		field.Float("balance").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Earnings less deductions and completed payouts; negative while advances exceed earnings"),
+		field.Float("reserved_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Balance held by pending payouts"),
+		field.Float("total_earned").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")),
+		field.Float("total_deducted").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")),
+		field.Float("total_paid").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the PayeeWallet.
+func (PayeeWallet) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "payee_id", "currency").Unique(),
+		index.Fields("tenant_id", "payee_type"),
+	}
+}
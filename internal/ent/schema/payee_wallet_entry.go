@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PayeeWalletEntry holds the schema definition for a movement of a payee
+// wallet's balance: an earning, a deduction or a payout.
+type PayeeWalletEntry struct {
+	ent.Schema
+}
+
+// Fields of the PayeeWalletEntry.
+func (PayeeWalletEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("wallet_id", uuid.UUID{}).
+			Immutable(),
+		field.String("entry_type").
+			Immutable().
+			Comment("Entry type: earning, deduction, payout"),
+		field.String("category").
+			Optional().
+			Immutable().
+			Comment("Deduction category: advance, fuel, equipment, penalty, other"),
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			Immutable().
+			Comment("Signed effect on the balance"),
+		field.Float("balance_after").
+			GoType(decimal.Decimal{}).
+			Immutable().
+			Comment("Wallet balance after the entry"),
+		field.String("reference_id").
+			Optional().
+			Immutable().
+			Comment("Earnings calculation or deduction reference in the logistics service"),
+		field.UUID("payout_id", uuid.UUID{}).
+			Optional().
+			Immutable().
+			Comment("Payout of a payout entry"),
+		field.String("event_id").
+			Optional().
+			Immutable().
+			Comment("Inbound event the entry was recorded from"),
+		field.String("description").
+			Optional().
+			Immutable(),
+		field.UUID("journal_entry_id", uuid.UUID{}).
+			Optional().
+			Immutable(),
+		field.Time("occurred_at").
+			Immutable(),
+		field.UUID("created_by", uuid.UUID{}).
+			Optional().
+			Immutable().
+			Comment("User who entered a manual deduction"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the PayeeWalletEntry.
+func (PayeeWalletEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("wallet_id", "created_at"),
+		// An earnings calculation is credited once.
+		index.Fields("tenant_id", "reference_id").
+			Unique().
+			Annotations(entsql.IndexWhere("entry_type = 'earning'")),
+	}
+}
@@ -46,7 +46,7 @@ func (PaymentIntent) Fields() []ent.Field {
 			Comment("Additional metadata"),
 		field.UUID("customer_id", uuid.UUID{}).
 			Optional().
-			Comment("Customer identifier (from auth-service)"),
+			Comment("Customer identifier (treasury customer, or auth-service user)"),
 		field.String("description").
 			Optional().
 			Comment("Payment description"),
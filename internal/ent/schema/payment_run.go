@@ -0,0 +1,110 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRun holds the schema definition for a batch of vendor bills paid
+// together on one payment date.
+type PaymentRun struct {
+	ent.Schema
+}
+
+// Fields of the PaymentRun.
+func (PaymentRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.String("run_number").
+			NotEmpty().
+			Immutable().
+			Comment("Sequential payment run number"),
+		field.Time("payment_date").
+			Comment("Date the vendors are paid"),
+		field.String("currency").
+			Default("KES").
+			Comment("ISO currency code of every bill in the run"),
+		field.String("method").
+			Comment("Payment method: bank_transfer, mpesa_b2b"),
+		field.String("payment_account").
+			Default("1000").
+			Comment("Ledger account the payments are made from"),
+		field.Time("due_before").
+			Optional().
+			Comment("Bills due on or before this date were selected"),
+		field.JSON("vendor_ids", []uuid.UUID{}).
+			Optional().
+			Comment("Vendors the selection was limited to (empty for all)"),
+		field.String("status").
+			Default("draft").
+			Comment("Status: draft, approved, executed, cancelled"),
+		field.Float("total_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Total paid out (defaults to zero)"),
+		field.Float("discount_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Early-payment discounts taken (defaults to zero)"),
+		field.Float("withholding_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Withholding tax deducted (defaults to zero)"),
+		field.Int("bill_count").
+			Default(0),
+		field.String("file_key").
+			Optional().
+			Comment("Object storage key of the bank transfer or M-Pesa B2B file"),
+		field.Text("notes").
+			Optional(),
+		field.UUID("created_by", uuid.UUID{}).
+			Optional(),
+		field.UUID("approved_by", uuid.UUID{}).
+			Optional(),
+		field.Time("approved_at").
+			Optional(),
+		field.UUID("executed_by", uuid.UUID{}).
+			Optional(),
+		field.Time("executed_at").
+			Optional(),
+		field.Time("cancelled_at").
+			Optional(),
+		field.JSON("metadata", map[string]any{}).
+			Default(map[string]any{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the PaymentRun.
+func (PaymentRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("items", PaymentRunItem.Type),
+	}
+}
+
+// Indexes of the PaymentRun.
+func (PaymentRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "run_number").Unique(),
+		index.Fields("tenant_id", "status", "payment_date"),
+	}
+}
@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentRunItem holds the schema definition for a vendor bill proposed for
+// payment in a payment run.
+type PaymentRunItem struct {
+	ent.Schema
+}
+
+// Fields of the PaymentRunItem.
+func (PaymentRunItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("run_id", uuid.UUID{}).
+			Comment("Payment run identifier"),
+		field.UUID("bill_id", uuid.UUID{}).
+			Comment("Vendor bill identifier"),
+		field.UUID("vendor_id", uuid.UUID{}).
+			Comment("Vendor identifier"),
+		field.String("bill_number"),
+		field.String("vendor_reference").
+			Optional(),
+		field.Time("due_date"),
+		field.Float("balance").
+			GoType(decimal.Decimal{}).
+			Comment("Bill balance when proposed"),
+		field.Float("discount_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Early-payment discount taken (defaults to zero)"),
+		field.Float("withholding_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Withholding tax deducted (defaults to zero)"),
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			Comment("Amount paid to the vendor: balance less discount and withholding tax"),
+		field.String("status").
+			Default("proposed").
+			Comment("Status: proposed, removed, paid, cancelled"),
+		field.String("remittance_key").
+			Optional().
+			Comment("Object storage key of the vendor's remittance advice"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PaymentRunItem.
+func (PaymentRunItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("run", PaymentRun.Type).
+			Ref("items").
+			Field("run_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the PaymentRunItem.
+func (PaymentRunItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("run_id", "vendor_id"),
+		// A bill is proposed in at most one open payment run.
+		index.Fields("bill_id").
+			Unique().
+			Annotations(entsql.IndexWhere("status = 'proposed'")),
+	}
+}
@@ -46,6 +46,10 @@ func (PaymentTransaction) Fields() []ent.Field {
 		field.Time("processed_at").
 			Optional().
 			Comment("Processing timestamp"),
+		field.UUID("receipt_journal_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Ledger journal booking the succeeded transaction into unapplied receipts"),
 		field.JSON("metadata", map[string]any{}).
 			Default(map[string]any{}),
 		field.Time("created_at").
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ProvisionPolicy holds the schema definition for a tenant's expected credit
+// loss rates, one per receivables aging bucket.
+type ProvisionPolicy struct {
+	ent.Schema
+}
+
+// Fields of the ProvisionPolicy.
+func (ProvisionPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.JSON("boundaries", []int{}).
+			Comment("Aging bucket boundaries in days past due"),
+		field.JSON("loss_rates", []decimal.Decimal{}).
+			Comment("Loss rate per bucket: current, then one per boundary, then the last bucket"),
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether the month-end provisioning job runs for the tenant"),
+		field.UUID("updated_by", uuid.UUID{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the ProvisionPolicy.
+func (ProvisionPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id").Unique(),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ProvisionRun holds the schema definition for one calculation of the
+// allowance for doubtful debts and the adjustment journal it posted.
+type ProvisionRun struct {
+	ent.Schema
+}
+
+// Fields of the ProvisionRun.
+func (ProvisionRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.String("currency").
+			Comment("ISO currency code"),
+		field.Time("as_of").
+			Comment("Aging date the allowance was calculated at"),
+		field.JSON("buckets", []map[string]any{}).
+			Comment("Per bucket exposure, loss rate and allowance"),
+		field.Float("required_allowance").
+			GoType(decimal.Decimal{}),
+		field.Float("previous_allowance").
+			GoType(decimal.Decimal{}),
+		field.Float("adjustment").
+			GoType(decimal.Decimal{}).
+			Comment("Required less previous allowance; positive charges bad debt expense"),
+		field.UUID("journal_entry_id", uuid.UUID{}).
+			Optional().
+			Comment("Adjustment journal (empty when no adjustment was needed)"),
+		field.String("trigger").
+			Default("scheduled").
+			Comment("Trigger: scheduled, manual"),
+		field.UUID("created_by", uuid.UUID{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the ProvisionRun.
+func (ProvisionRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "currency", "as_of").Unique(),
+	}
+}
@@ -0,0 +1,107 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Reconciliation holds the schema definition for a bank account's
+// reconciliation, the record matched bank and book entries are locked into.
+// Finalising it for a period stores the reconciliation report, which does not
+// change afterwards.
+type Reconciliation struct {
+	ent.Schema
+}
+
+// Fields of the Reconciliation.
+func (Reconciliation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("bank_account_id", uuid.UUID{}).
+			Comment("Bank account identifier"),
+		field.String("status").
+			Default("open").
+			Comment("Status: open, finalised"),
+		field.Time("period_start").
+			Optional().
+			Nillable().
+			Comment("Day after the previous finalised period; empty for the first"),
+		field.Time("period_end").
+			Optional().
+			Nillable().
+			Comment("Last day of the finalised period"),
+		field.Float("statement_balance").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable().
+			Comment("Bank statement balance at the end of the period"),
+		field.Float("book_balance").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable().
+			Comment("Ledger cash account balance at the end of the period"),
+		field.Float("outstanding_deposits").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable().
+			Comment("Money in booked but not yet on the statement"),
+		field.Float("outstanding_payments").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable().
+			Comment("Money out booked but not yet on the statement"),
+		field.Float("unrecorded_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable().
+			Comment("Net of the statement lines not matched to the books"),
+		field.Float("difference").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable().
+			Comment("Adjusted statement balance less adjusted book balance"),
+		field.JSON("outstanding_items", []map[string]any{}).
+			Optional(),
+		field.JSON("unrecorded_items", []map[string]any{}).
+			Optional(),
+		field.UUID("created_by", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.UUID("finalised_by", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Time("finalised_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Reconciliation.
+func (Reconciliation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("matches", ReconciliationMatch.Type),
+	}
+}
+
+// Indexes of the Reconciliation.
+func (Reconciliation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "bank_account_id", "status"),
+	}
+}
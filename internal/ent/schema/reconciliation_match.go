@@ -0,0 +1,108 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationMatch holds the schema definition for a group of bank lines
+// matched to ledger cash lines or payment transactions, either suggested by
+// the matching engine or locked into a reconciliation.
+type ReconciliationMatch struct {
+	ent.Schema
+}
+
+// Fields of the ReconciliationMatch.
+func (ReconciliationMatch) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("bank_account_id", uuid.UUID{}).
+			Comment("Bank account identifier"),
+		field.UUID("reconciliation_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Reconciliation the match is locked into once matched"),
+		field.String("rule").
+			Comment("Rule that proposed the match: exact_reference, amount_date, many_to_one, one_to_many or custom; manual or adjustment when matched by hand"),
+		field.UUID("rule_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Custom reconciliation rule that proposed the match"),
+		field.String("match_type").
+			Default("automatic").
+			Comment("Match type: automatic, manual"),
+		field.Int("confidence").
+			Comment("Match confidence score (0-100)"),
+		field.String("status").
+			Default("suggested").
+			Comment("Status: suggested, matched, rejected, unmatched"),
+		field.Float("bank_amount").
+			GoType(decimal.Decimal{}).
+			Comment("Sum of the matched bank lines"),
+		field.Float("book_amount").
+			GoType(decimal.Decimal{}).
+			Comment("Sum of the matched ledger lines or payment transactions"),
+		field.Float("difference").
+			GoType(decimal.Decimal{}).
+			Comment("Bank amount less book amount, within a custom rule's tolerance"),
+		field.String("fingerprint").
+			Comment("SHA-256 of the matched item identifiers; a rejected grouping is not suggested again"),
+		field.JSON("reasons", []string{}).
+			Optional(),
+		field.UUID("matched_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("User who accepted the match; empty when matched automatically"),
+		field.Time("matched_at").
+			Optional().
+			Nillable(),
+		field.UUID("rejected_by", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Time("rejected_at").
+			Optional().
+			Nillable(),
+		field.UUID("unmatched_by", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Time("unmatched_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ReconciliationMatch.
+func (ReconciliationMatch) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("reconciliation", Reconciliation.Type).
+			Ref("matches").
+			Field("reconciliation_id").
+			Unique(),
+		edge.To("items", ReconciliationMatchItem.Type),
+	}
+}
+
+// Indexes of the ReconciliationMatch.
+func (ReconciliationMatch) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "bank_account_id", "status"),
+		index.Fields("bank_account_id", "fingerprint"),
+		index.Fields("reconciliation_id"),
+	}
+}
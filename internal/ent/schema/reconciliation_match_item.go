@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationMatchItem holds the schema definition for a bank line, ledger
+// cash line or payment transaction taking part in a reconciliation match.
+type ReconciliationMatchItem struct {
+	ent.Schema
+}
+
+// Fields of the ReconciliationMatchItem.
+func (ReconciliationMatchItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("match_id", uuid.UUID{}).
+			Comment("Reconciliation match identifier"),
+		field.String("item_type").
+			Comment("Item type: bank, ledger, payment"),
+		field.UUID("item_id", uuid.UUID{}).
+			Comment("Bank transaction, ledger transaction or payment transaction identifier"),
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			Comment("Signed amount: positive for money in, negative for money out"),
+		field.Time("transaction_date"),
+		field.String("reference").
+			Optional(),
+		field.Text("description").
+			Optional(),
+		field.String("status").
+			Default("suggested").
+			Comment("Status: suggested, matched, released"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ReconciliationMatchItem.
+func (ReconciliationMatchItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("match", ReconciliationMatch.Type).
+			Ref("items").
+			Field("match_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the ReconciliationMatchItem.
+func (ReconciliationMatchItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("match_id"),
+		// An item takes part in at most one suggested or matched match.
+		index.Fields("item_type", "item_id").
+			Unique().
+			Annotations(entsql.IndexWhere("status <> 'released'")),
+	}
+}
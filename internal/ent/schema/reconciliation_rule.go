@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ReconciliationRule holds the schema definition for a tenant's custom
+// matching rule, run by the reconciliation engine before the built-in rules.
+type ReconciliationRule struct {
+	ent.Schema
+}
+
+// Fields of the ReconciliationRule.
+func (ReconciliationRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("bank_account_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Bank account the rule applies to; empty for every account"),
+		field.String("name").
+			NotEmpty(),
+		field.Int("priority").
+			Default(100).
+			Comment("Rules run in ascending priority"),
+		field.String("match_field").
+			Default("description").
+			Comment("Bank line field the pattern is matched against: reference, description, counterparty"),
+		field.String("pattern").
+			Comment("Case-insensitive regular expression the bank line must match"),
+		field.String("counterpart").
+			Default("ledger").
+			Comment("Side matched against: ledger, payment"),
+		field.String("counterpart_pattern").
+			Optional().
+			Comment("Case-insensitive regular expression the counterpart's reference or description must match"),
+		field.Float("amount_tolerance").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Largest accepted difference between the bank and book amounts (defaults to zero)"),
+		field.Int("date_window_days").
+			Default(3),
+		field.Int("confidence").
+			Default(90).
+			Comment("Confidence score given to the rule's matches (0-100)"),
+		field.Bool("auto_match").
+			Default(false).
+			Comment("Lock the rule's matches into the reconciliation without review"),
+		field.Bool("active").
+			Default(true),
+		field.UUID("created_by", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the ReconciliationRule.
+func (ReconciliationRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "active", "priority"),
+	}
+}
@@ -0,0 +1,120 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SettlementBatch holds the schema definition for the funds collected for a
+// tenant through one channel in a settlement window, less refunds, fees and
+// adjustments.
+type SettlementBatch struct {
+	ent.Schema
+}
+
+// Fields of the SettlementBatch.
+func (SettlementBatch) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.String("batch_number").
+			NotEmpty().
+			Immutable().
+			Comment("Sequential settlement batch number"),
+		field.String("channel").
+			Immutable().
+			Comment("Collection channel: mpesa, card, bank"),
+		field.String("currency").
+			Default("KES").
+			Immutable().
+			Comment("ISO currency code"),
+		field.Time("window_start").
+			Immutable().
+			Comment("Start of the settlement window"),
+		field.Time("window_end").
+			Immutable().
+			Comment("End of the settlement window (exclusive)"),
+		field.String("status").
+			Default("draft").
+			Comment("Status: draft, pending_approval, approved, processing, completed, failed"),
+		field.Float("fee_rate").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Fraction of gross collections charged as the settlement fee (defaults to zero)"),
+		field.Float("gross_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Payments collected (defaults to zero)"),
+		field.Float("refund_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Refunds and chargebacks deducted (defaults to zero)"),
+		field.Float("fee_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Settlement fee deducted (defaults to zero)"),
+		field.Float("adjustment_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Net operator adjustments (defaults to zero)"),
+		field.Float("net_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Amount to disburse: gross less refunds and fees plus adjustments"),
+		field.Int("transaction_count").
+			Default(0),
+		field.UUID("created_by", uuid.UUID{}).
+			Optional().
+			Comment("User who generated the batch; empty when generated by the worker"),
+		field.UUID("submitted_by", uuid.UUID{}).
+			Optional(),
+		field.Time("submitted_at").
+			Optional(),
+		field.UUID("approved_by", uuid.UUID{}).
+			Optional(),
+		field.Time("approved_at").
+			Optional(),
+		field.String("rejection_reason").
+			Optional().
+			Comment("Why the batch was last sent back to draft"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the SettlementBatch.
+func (SettlementBatch) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("items", SettlementItem.Type),
+	}
+}
+
+// Indexes of the SettlementBatch.
+func (SettlementBatch) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "batch_number").Unique(),
+		// One batch per channel and currency for each settlement window.
+		index.Fields("tenant_id", "channel", "currency", "window_end").Unique(),
+		index.Fields("tenant_id", "status"),
+	}
+}
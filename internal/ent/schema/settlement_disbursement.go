@@ -0,0 +1,99 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SettlementDisbursement holds the schema definition for the payout of an
+// approved settlement batch to the tenant through M-Pesa.
+type SettlementDisbursement struct {
+	ent.Schema
+}
+
+// Fields of the SettlementDisbursement.
+func (SettlementDisbursement) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("batch_id", uuid.UUID{}).
+			Immutable().
+			Comment("Settlement batch paid out"),
+		field.String("method").
+			Immutable().
+			Comment("Payout method: mpesa_b2c, mpesa_b2b"),
+		field.String("party").
+			Immutable().
+			Comment("Phone number (B2C) or paybill/till number (B2B) paid"),
+		field.String("account_reference").
+			Optional().
+			Immutable(),
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			Immutable().
+			Comment("Amount paid out: the batch's net amount"),
+		field.String("currency").
+			Default("KES").
+			Immutable(),
+		field.Int("sequence").
+			Default(1).
+			Comment("Payout request number; a new request is made after a queue timeout or a manual retry"),
+		field.String("originator_conversation_id").
+			NotEmpty().
+			Comment("Idempotency key of the current payout request"),
+		field.String("conversation_id").
+			Optional().
+			Comment("M-Pesa conversation of the current payout request"),
+		field.String("status").
+			Default("pending").
+			Comment("Status: pending, submitted, succeeded, failed"),
+		field.Int("attempts").
+			Default(0).
+			Comment("Times the current payout request was sent"),
+		field.Time("next_attempt_at").
+			Default(time.Now).
+			Comment("When a pending payout request is sent next"),
+		field.String("result_code").
+			Optional(),
+		field.String("result_description").
+			Optional().
+			Comment("Last result or error reported for the payout"),
+		field.String("failure_reason").
+			Optional().
+			Comment("Reason code of a failed payout"),
+		field.String("transaction_id").
+			Optional().
+			Comment("M-Pesa receipt of a succeeded payout"),
+		field.UUID("journal_entry_id", uuid.UUID{}).
+			Optional().
+			Comment("Journal posted when the payout succeeded"),
+		field.Time("submitted_at").
+			Optional(),
+		field.Time("completed_at").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the SettlementDisbursement.
+func (SettlementDisbursement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("batch_id").Unique(),
+		index.Fields("originator_conversation_id").Unique(),
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("tenant_id", "status"),
+	}
+}
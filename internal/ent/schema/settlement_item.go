@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SettlementItem holds the schema definition for a line of a settlement
+// batch: a payment transaction collected or refunded, the settlement fee or
+// an operator adjustment.
+type SettlementItem struct {
+	ent.Schema
+}
+
+// Fields of the SettlementItem.
+func (SettlementItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("batch_id", uuid.UUID{}).
+			Comment("Settlement batch identifier"),
+		field.String("item_type").
+			Immutable().
+			Comment("Item type: payment, refund, chargeback, fee, adjustment"),
+		field.UUID("payment_transaction_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Payment transaction settled (payment, refund and chargeback items)"),
+		field.String("provider_reference").
+			Optional(),
+		field.Time("transaction_date").
+			Optional().
+			Comment("When the provider processed the transaction"),
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			Comment("Signed effect on the amount disbursed"),
+		field.String("description").
+			Optional(),
+		field.String("status").
+			Default("included").
+			Comment("Status: included, removed"),
+		field.UUID("created_by", uuid.UUID{}).
+			Optional().
+			Comment("User who added the adjustment"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the SettlementItem.
+func (SettlementItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("batch", SettlementBatch.Type).
+			Ref("items").
+			Field("batch_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the SettlementItem.
+func (SettlementItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("batch_id", "item_type"),
+		// A transaction is settled in at most one batch.
+		index.Fields("payment_transaction_id").
+			Unique().
+			Annotations(entsql.IndexWhere("status = 'included'")),
+	}
+}
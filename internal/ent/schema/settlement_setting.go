@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SettlementSetting holds the schema definition for a tenant's settlement
+// fees per collection channel and where its settlements are paid out.
+type SettlementSetting struct {
+	ent.Schema
+}
+
+// Fields of the SettlementSetting.
+func (SettlementSetting) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.Float("mpesa_fee_rate").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Fraction of M-Pesa collections charged on settlement (defaults to zero)"),
+		field.Float("card_fee_rate").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Fraction of card collections charged on settlement (defaults to zero)"),
+		field.Float("bank_fee_rate").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Fraction of bank transfer collections charged on settlement (defaults to zero)"),
+		field.String("payout_method").
+			Optional().
+			Comment("How approved batches are paid out: mpesa_b2c, mpesa_b2b"),
+		field.String("payout_party").
+			Optional().
+			Comment("Phone number (B2C) or paybill/till number (B2B) paid"),
+		field.String("payout_account_reference").
+			Optional().
+			Comment("Paybill account number for B2B payouts; empty pays a till"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the SettlementSetting.
+func (SettlementSetting) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id").Unique(),
+	}
+}
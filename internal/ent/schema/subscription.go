@@ -0,0 +1,110 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Subscription holds the schema definition for recurring billing subscriptions.
+type Subscription struct {
+	ent.Schema
+}
+
+// Fields of the Subscription.
+func (Subscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("customer_id", uuid.UUID{}).
+			Comment("Customer identifier"),
+		field.String("plan_code").
+			NotEmpty().
+			Comment("Plan code from the subscribing service"),
+		field.String("plan_name").
+			Optional().
+			Comment("Plan display name used on invoice lines"),
+		field.Float("price").
+			GoType(decimal.Decimal{}).
+			Comment("Price per billing interval"),
+		field.String("currency").
+			Default("KES").
+			Comment("ISO currency code"),
+		field.String("billing_interval").
+			Default("month").
+			Comment("Billing interval: day, week, month, year"),
+		field.Int("interval_count").
+			Default(1).
+			Positive().
+			Comment("Number of intervals per billing cycle"),
+		field.Int("billing_anchor_day").
+			Optional().
+			Min(1).
+			Max(31).
+			Comment("Day of month cycles renew on (monthly/yearly intervals)"),
+		field.Int("days_until_due").
+			Default(0).
+			NonNegative().
+			Comment("Payment terms for generated invoices"),
+		field.String("status").
+			Default("active").
+			Comment("Status: trialing, active, cancelled"),
+		field.Time("trial_end").
+			Optional().
+			Comment("Trial period end"),
+		field.Time("current_period_start").
+			Comment("Current billing period start"),
+		field.Time("current_period_end").
+			Comment("Current billing period end (exclusive)"),
+		field.Time("next_billing_at").
+			Comment("When the billing worker next needs to act on the subscription"),
+		field.Bool("cancel_at_period_end").
+			Default(false).
+			Comment("Cancel when the current period ends instead of renewing"),
+		field.Time("cancelled_at").
+			Optional().
+			Comment("When cancellation was requested"),
+		field.Time("ended_at").
+			Optional().
+			Comment("When the subscription stopped renewing"),
+		field.String("reference_id").
+			Optional().
+			Comment("Subscription identifier in the subscribing service"),
+		field.JSON("metadata", map[string]any{}).
+			Default(map[string]any{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Subscription.
+func (Subscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("billing_cycles", BillingCycle.Type),
+		edge.To("adjustments", SubscriptionAdjustment.Type),
+		edge.To("meters", SubscriptionMeter.Type),
+	}
+}
+
+// Indexes of the Subscription.
+func (Subscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("customer_id"),
+		index.Fields("status"),
+		index.Fields("status", "next_billing_at"),
+		index.Fields("tenant_id", "reference_id"),
+	}
+}
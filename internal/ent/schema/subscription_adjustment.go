@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionAdjustment holds the schema definition for one-off charges and
+// credits (e.g. proration) billed on a subscription's next invoice.
+type SubscriptionAdjustment struct {
+	ent.Schema
+}
+
+// Fields of the SubscriptionAdjustment.
+func (SubscriptionAdjustment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("subscription_id", uuid.UUID{}).
+			Comment("Subscription identifier"),
+		field.String("kind").
+			Default("proration").
+			Comment("Kind: proration, charge, credit"),
+		field.String("description").
+			NotEmpty(),
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			Comment("Signed amount; negative values are credits"),
+		field.String("currency").
+			Default("KES").
+			Comment("ISO currency code"),
+		field.UUID("billing_cycle_id", uuid.UUID{}).
+			Optional().
+			Comment("Cycle the adjustment was billed on (empty while pending)"),
+		field.JSON("metadata", map[string]any{}).
+			Default(map[string]any{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the SubscriptionAdjustment.
+func (SubscriptionAdjustment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("subscription", Subscription.Type).
+			Ref("adjustments").
+			Field("subscription_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the SubscriptionAdjustment.
+func (SubscriptionAdjustment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("subscription_id", "billing_cycle_id"),
+	}
+}
@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/metering/pricing"
+)
+
+// SubscriptionMeter holds the schema definition for a metered component of a
+// subscription, priced from the usage recorded against it.
+type SubscriptionMeter struct {
+	ent.Schema
+}
+
+// Fields of the SubscriptionMeter.
+func (SubscriptionMeter) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("subscription_id", uuid.UUID{}).
+			Comment("Subscription identifier"),
+		field.String("code").
+			NotEmpty().
+			Comment("Meter code sent by the subscribing service (e.g. api_calls)"),
+		field.String("name").
+			Optional().
+			Comment("Display name used on invoice lines"),
+		field.String("unit").
+			Optional().
+			Comment("Unit label (calls, GB, orders)"),
+		field.String("aggregation").
+			Default("sum").
+			Comment("Aggregation: sum, max, last"),
+		field.String("pricing_model").
+			Default("per_unit").
+			Comment("Pricing model: per_unit, tiered, volume, graduated"),
+		field.Float("unit_price").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Unit price for the per_unit model"),
+		field.JSON("tiers", []pricing.Tier{}).
+			Optional().
+			Comment("Price tiers for the tiered, volume and graduated models"),
+		field.Bool("active").
+			Default(true),
+		field.JSON("metadata", map[string]any{}).
+			Default(map[string]any{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the SubscriptionMeter.
+func (SubscriptionMeter) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("subscription", Subscription.Type).
+			Ref("meters").
+			Field("subscription_id").
+			Required().
+			Unique(),
+		edge.To("usage_records", UsageRecord.Type),
+	}
+}
+
+// Indexes of the SubscriptionMeter.
+func (SubscriptionMeter) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("subscription_id", "code").Unique(),
+	}
+}
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// UsageRecord holds the schema definition for a single usage report against a
+// subscription meter.
+type UsageRecord struct {
+	ent.Schema
+}
+
+// Fields of the UsageRecord.
+func (UsageRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("subscription_id", uuid.UUID{}).
+			Comment("Subscription identifier"),
+		field.UUID("meter_id", uuid.UUID{}).
+			Comment("Meter identifier"),
+		field.String("event_id").
+			NotEmpty().
+			Comment("Source event identifier used for deduplication"),
+		field.Float("quantity").
+			GoType(decimal.Decimal{}).
+			Comment("Reported quantity"),
+		field.Time("occurred_at").
+			Comment("When the usage happened"),
+		field.UUID("billing_cycle_id", uuid.UUID{}).
+			Optional().
+			Comment("Cycle the usage was billed on (empty while unbilled)"),
+		field.JSON("metadata", map[string]any{}).
+			Default(map[string]any{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the UsageRecord.
+func (UsageRecord) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("meter", SubscriptionMeter.Type).
+			Ref("usage_records").
+			Field("meter_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the UsageRecord.
+func (UsageRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "event_id").Unique(), // Redelivered events are ignored
+		index.Fields("subscription_id", "billing_cycle_id"),
+		index.Fields("meter_id", "occurred_at"),
+	}
+}
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/customers/profile"
+)
+
+// Vendor holds the schema definition for a supplier the tenant is billed by.
+type Vendor struct {
+	ent.Schema
+}
+
+// Fields of the Vendor.
+func (Vendor) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.String("vendor_number").
+			NotEmpty().
+			Immutable().
+			Comment("Sequential vendor number"),
+		field.String("legal_name").
+			NotEmpty().
+			Comment("Registered legal name"),
+		field.String("trading_name").
+			Optional(),
+		field.String("kra_pin").
+			Optional().
+			Comment("KRA PIN (tax identification number)"),
+		field.String("email").
+			Optional().
+			Comment("Accounts email"),
+		field.String("phone").
+			Optional(),
+		field.JSON("addresses", []profile.Address{}).
+			Optional(),
+		field.JSON("contacts", []profile.Contact{}).
+			Optional(),
+		field.String("default_currency").
+			Default("KES").
+			Comment("ISO currency code used for new bills"),
+		field.Int("payment_terms_days").
+			Default(30).
+			Comment("Days from bill date to due date"),
+		field.Float("early_payment_discount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Discount offered for early payment as a fraction, e.g. 0.02 (defaults to zero)"),
+		field.Int("early_payment_days").
+			Optional().
+			Comment("Days from bill date within which the early-payment discount applies"),
+		field.String("withholding_category").
+			Optional().
+			Comment("Service category withholding tax is deducted under; empty when not subject to withholding"),
+		field.String("default_expense_account").
+			Optional().
+			Comment("Ledger account code bill lines are coded to by default"),
+		field.String("payment_method").
+			Optional().
+			Comment("Preferred payment method: bank_transfer, mpesa, cheque, cash"),
+		field.JSON("payment_details", map[string]any{}).
+			Optional().
+			Comment("Bank account, paybill or till details for paying the vendor"),
+		field.String("status").
+			Default("active").
+			Comment("Status: active, inactive"),
+		field.JSON("metadata", map[string]any{}).
+			Default(map[string]any{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the Vendor.
+func (Vendor) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "vendor_number").Unique(),
+		index.Fields("tenant_id", "legal_name"),
+		index.Fields("tenant_id", "kra_pin"),
+	}
+}
@@ -0,0 +1,160 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// VendorBill holds the schema definition for a supplier invoice owed by the
+// tenant (accounts payable).
+type VendorBill struct {
+	ent.Schema
+}
+
+// Fields of the VendorBill.
+func (VendorBill) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("vendor_id", uuid.UUID{}).
+			Comment("Vendor identifier"),
+		field.String("bill_number").
+			NotEmpty().
+			Immutable().
+			Comment("Sequential bill number"),
+		field.String("vendor_reference").
+			Optional().
+			Comment("The vendor's own invoice number"),
+		field.Time("bill_date"),
+		field.Time("due_date"),
+		field.String("currency").
+			Default("KES").
+			Comment("ISO currency code"),
+		field.Float("subtotal").
+			GoType(decimal.Decimal{}).
+			Comment("Total before tax"),
+		field.Float("tax_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Input tax (defaults to zero)"),
+		field.Float("total_amount").
+			GoType(decimal.Decimal{}).
+			Comment("Total owed to the vendor"),
+		field.Float("paid_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Amount paid (defaults to zero)"),
+		field.Float("discount_rate").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Vendor early-payment discount captured when the bill was entered (defaults to zero)"),
+		field.Time("discount_until").
+			Optional().
+			Comment("Last day the early-payment discount can be taken"),
+		field.Float("discount_taken").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Early-payment discount deducted when the bill was paid (defaults to zero)"),
+		field.Float("withholding_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Withholding tax to deduct on payment, from the lines (defaults to zero)"),
+		field.Float("withholding_taken").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Withholding tax deducted when the bill was paid (defaults to zero)"),
+		field.String("status").
+			Default("draft").
+			Comment("Status: draft, approved, scheduled, paid, cancelled"),
+		field.JSON("attachments", []string{}).
+			Optional().
+			Comment("Object storage keys or URLs of the scanned bill and supporting documents"),
+		field.String("reference_type").
+			Optional().
+			Comment("Source of the bill (e.g., logistics_expense)"),
+		field.String("reference_id").
+			Optional().
+			Comment("Source identifier in the originating service"),
+		field.String("po_number").
+			Optional().
+			Comment("Purchase order the bill is matched against"),
+		field.String("match_status").
+			Optional().
+			Comment("Three-way match: awaiting_receipt, matched, variance, accepted (empty when no PO)"),
+		field.Time("matched_at").
+			Optional().
+			Comment("When the bill was last matched to goods receipts"),
+		field.UUID("variance_accepted_by", uuid.UUID{}).
+			Optional(),
+		field.Time("variance_accepted_at").
+			Optional(),
+		field.Text("variance_reason").
+			Optional().
+			Comment("Why the match variances were accepted"),
+		field.Text("notes").
+			Optional(),
+		field.UUID("created_by", uuid.UUID{}).
+			Optional(),
+		field.UUID("approved_by", uuid.UUID{}).
+			Optional(),
+		field.Time("approved_at").
+			Optional(),
+		field.Time("scheduled_for").
+			Optional().
+			Comment("Date the bill is scheduled to be paid"),
+		field.Time("paid_at").
+			Optional(),
+		field.UUID("journal_entry_id", uuid.UUID{}).
+			Optional().
+			Comment("Journal recognising the liability on approval"),
+		field.JSON("metadata", map[string]any{}).
+			Default(map[string]any{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the VendorBill.
+func (VendorBill) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("lines", VendorBillLine.Type),
+	}
+}
+
+// Indexes of the VendorBill.
+func (VendorBill) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "bill_number").Unique(),
+		index.Fields("tenant_id", "status", "due_date"),
+		index.Fields("vendor_id"),
+		index.Fields("tenant_id", "po_number"),
+		// A vendor's invoice is only entered once.
+		index.Fields("tenant_id", "vendor_id", "vendor_reference").
+			Unique().
+			Annotations(entsql.IndexWhere("vendor_reference IS NOT NULL AND vendor_reference <> '' AND status <> 'cancelled'")),
+		// Bills pushed by other services are created once per source document.
+		index.Fields("tenant_id", "reference_type", "reference_id").
+			Unique().
+			Annotations(entsql.IndexWhere("reference_id IS NOT NULL AND reference_id <> ''")),
+	}
+}
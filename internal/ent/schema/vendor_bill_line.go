@@ -0,0 +1,111 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// VendorBillLine holds the schema definition for a line on a vendor bill,
+// coded to the expense or asset account it is charged to.
+type VendorBillLine struct {
+	ent.Schema
+}
+
+// Fields of the VendorBillLine.
+func (VendorBillLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("bill_id", uuid.UUID{}).
+			Comment("Vendor bill identifier"),
+		field.Int("line_number").
+			Comment("Line sequence number"),
+		field.String("item_code").
+			Optional().
+			Comment("Item code matched against purchase order receipt lines"),
+		field.Text("description").
+			NotEmpty(),
+		field.Float("quantity").
+			GoType(decimal.Decimal{}),
+		field.Float("unit_price").
+			GoType(decimal.Decimal{}),
+		field.String("account_code").
+			NotEmpty().
+			Comment("Expense or asset account debited for the line"),
+		field.String("tax_code").
+			Optional().
+			Comment("Tax code: VAT16, VAT8, ZERO, EXEMPT"),
+		field.Float("tax_rate").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Tax rate as a fraction (0.16 for 16% VAT)"),
+		field.Float("tax_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Tax amount (defaults to zero)"),
+		field.Float("line_total").
+			GoType(decimal.Decimal{}).
+			Comment("Line total before tax (quantity * unit_price)"),
+		field.String("withholding_category").
+			Optional().
+			Comment("Service category withholding tax is deducted under"),
+		field.Float("withholding_rate").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Withholding tax rate as a fraction (defaults to zero)"),
+		field.Float("withholding_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Withholding tax on the line total (defaults to zero)"),
+		field.String("match_status").
+			Optional().
+			Comment("Line match: matched, quantity_variance, price_variance, not_received"),
+		field.Float("received_quantity").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable().
+			Comment("Quantity received and not yet billed when last matched"),
+		field.Float("order_unit_price").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable().
+			Comment("Purchase order unit price when last matched"),
+		field.JSON("metadata", map[string]any{}).
+			Default(map[string]any{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the VendorBillLine.
+func (VendorBillLine) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("bill", VendorBill.Type).
+			Ref("lines").
+			Field("bill_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the VendorBillLine.
+func (VendorBillLine) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("bill_id", "line_number").Unique(),
+	}
+}
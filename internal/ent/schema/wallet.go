@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Wallet holds the schema definition for a ledger-backed stored-value wallet
+// of the tenant, one of its outlets or one of its customers.
+type Wallet struct {
+	ent.Schema
+}
+
+// Fields of the Wallet.
+func (Wallet) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.String("owner_type").
+			Immutable().
+			Comment("Owner type: tenant, outlet, customer"),
+		field.String("owner_id").
+			NotEmpty().
+			Immutable().
+			Comment("Outlet or customer identifier; the tenant ID for the tenant's own wallet"),
+		field.String("name").
+			Optional(),
+		field.String("currency").
+			Default("KES").
+			Immutable().
+			Comment("ISO currency code"),
+		field.Float("balance").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Funds in the wallet, including held funds"),
+		field.Float("held_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Funds held by active holds"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the Wallet.
+func (Wallet) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "owner_type", "owner_id", "currency").Unique(),
+	}
+}
+
+// Annotations of the Wallet.
+func (Wallet) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// Backstop to the row locks taken by every movement: the database
+		// refuses a negative balance or holds exceeding it.
+		entsql.Checks(map[string]string{
+			"wallets_balance_not_negative": "balance >= 0",
+			"wallets_holds_covered":        "held_amount >= 0 AND held_amount <= balance",
+		}),
+	}
+}
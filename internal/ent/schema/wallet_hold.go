@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WalletHold holds the schema definition for funds held in a wallet until
+// they are captured or released.
+type WalletHold struct {
+	ent.Schema
+}
+
+// Fields of the WalletHold.
+func (WalletHold) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("wallet_id", uuid.UUID{}).
+			Immutable(),
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			Immutable(),
+		field.Float("captured_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Amount captured; the rest was released"),
+		field.String("status").
+			Default("active").
+			Comment("Status: active, captured, released"),
+		field.String("reference").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Caller's reference, such as an order ID; a hold is placed once per reference"),
+		field.String("description").
+			Optional(),
+		field.UUID("transaction_id", uuid.UUID{}).
+			Optional().
+			Comment("Wallet transaction of the capture"),
+		field.UUID("created_by", uuid.UUID{}).
+			Optional().
+			Immutable(),
+		field.Time("closed_at").
+			Optional().
+			Comment("When the hold was captured or released"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the WalletHold.
+func (WalletHold) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "reference").Unique(),
+		index.Fields("wallet_id", "status"),
+	}
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WalletTransaction holds the schema definition for a movement of funds into,
+// out of or between wallets, posted to the ledger as one balanced journal.
+type WalletTransaction struct {
+	ent.Schema
+}
+
+// Fields of the WalletTransaction.
+func (WalletTransaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.String("transaction_type").
+			Immutable().
+			Comment("Transaction type: credit, debit, transfer, capture"),
+		field.UUID("from_wallet_id", uuid.UUID{}).
+			Optional().
+			Immutable().
+			Comment("Wallet debited; empty for a credit"),
+		field.UUID("to_wallet_id", uuid.UUID{}).
+			Optional().
+			Immutable().
+			Comment("Wallet credited; empty for a debit or a capture to an account"),
+		field.String("account_code").
+			Optional().
+			Immutable().
+			Comment("Ledger account on the other side of a credit, debit or capture"),
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			Immutable(),
+		field.String("currency").
+			Default("KES").
+			Immutable(),
+		field.UUID("hold_id", uuid.UUID{}).
+			Optional().
+			Immutable().
+			Comment("Hold captured"),
+		field.String("reference").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Caller's reference; a transaction is posted once per reference"),
+		field.String("description").
+			Optional().
+			Immutable(),
+		field.UUID("journal_entry_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("created_by", uuid.UUID{}).
+			Optional().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the WalletTransaction.
+func (WalletTransaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "reference").Unique(),
+		index.Fields("from_wallet_id", "created_at"),
+		index.Fields("to_wallet_id", "created_at"),
+	}
+}
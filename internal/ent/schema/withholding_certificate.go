@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WithholdingCertificate holds the schema definition for the certificate of
+// withholding tax deducted from a vendor bill payment in one service category.
+type WithholdingCertificate struct {
+	ent.Schema
+}
+
+// Fields of the WithholdingCertificate.
+func (WithholdingCertificate) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.String("certificate_number").
+			NotEmpty().
+			Immutable().
+			Comment("Sequential certificate number"),
+		field.UUID("vendor_id", uuid.UUID{}).
+			Comment("Vendor the tax was withheld from"),
+		field.String("vendor_name").
+			NotEmpty().
+			Comment("Vendor legal name when the tax was withheld"),
+		field.String("vendor_pin").
+			Optional().
+			Comment("Vendor KRA PIN when the tax was withheld"),
+		field.UUID("bill_id", uuid.UUID{}).
+			Comment("Vendor bill paid"),
+		field.String("bill_number").
+			NotEmpty(),
+		field.String("vendor_reference").
+			Optional().
+			Comment("The vendor's own invoice number"),
+		field.String("category").
+			NotEmpty().
+			Comment("Service category code"),
+		field.String("category_name").
+			NotEmpty().
+			Comment("Nature of the payment"),
+		field.Float("rate").
+			GoType(decimal.Decimal{}).
+			Comment("Rate applied as a fraction"),
+		field.String("currency").
+			Default("KES"),
+		field.Float("gross_amount").
+			GoType(decimal.Decimal{}).
+			Comment("Amount before VAT the tax was computed on"),
+		field.Float("tax_amount").
+			GoType(decimal.Decimal{}).
+			Comment("Tax withheld"),
+		field.Time("payment_date").
+			Comment("Date the bill was paid and the tax deducted"),
+		field.String("payment_reference").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the WithholdingCertificate.
+func (WithholdingCertificate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "certificate_number").Unique(),
+		index.Fields("tenant_id", "payment_date"),
+		index.Fields("tenant_id", "vendor_id"),
+		index.Fields("bill_id"),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WithholdingRate holds the schema definition for the withholding tax rate a
+// tenant deducts from vendor payments in a service category.
+type WithholdingRate struct {
+	ent.Schema
+}
+
+// Fields of the WithholdingRate.
+func (WithholdingRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.String("category").
+			NotEmpty().
+			Immutable().
+			Comment("Service category code, e.g. professional_fees"),
+		field.String("name").
+			NotEmpty().
+			Comment("Nature of the payment as reported on the withholding schedule"),
+		field.Float("rate").
+			GoType(decimal.Decimal{}).
+			Comment("Rate as a fraction of the amount before VAT, e.g. 0.05"),
+		field.Bool("active").
+			Default(true).
+			Comment("Inactive categories cannot be used on new bills"),
+		field.UUID("updated_by", uuid.UUID{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the WithholdingRate.
+func (WithholdingRate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "category").Unique(),
+	}
+}
@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WriteOff holds the schema definition for a bad debt write-off of an
+// invoice balance. Write-offs are requested and then approved by a second
+// user before they post.
+type WriteOff struct {
+	ent.Schema
+}
+
+// Fields of the WriteOff.
+func (WriteOff) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("invoice_id", uuid.UUID{}).
+			Comment("Invoice being written off"),
+		field.UUID("customer_id", uuid.UUID{}).
+			Optional().
+			Comment("Customer identifier copied from the invoice"),
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			Comment("Amount written off"),
+		field.Float("recovered_amount").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Annotations(entsql.Default("0")).
+			Comment("Amount recovered after the write-off (defaults to zero)"),
+		field.String("currency").
+			Comment("ISO currency code"),
+		field.String("reason").
+			NotEmpty(),
+		field.String("status").
+			Default("pending").
+			Comment("Status: pending, approved, rejected"),
+		field.UUID("requested_by", uuid.UUID{}).
+			Optional(),
+		field.UUID("decided_by", uuid.UUID{}).
+			Optional().
+			Comment("User who approved or rejected the write-off"),
+		field.Time("decided_at").
+			Optional(),
+		field.String("decision_note").
+			Optional(),
+		field.UUID("journal_entry_id", uuid.UUID{}).
+			Optional().
+			Comment("Write-off journal, set on approval"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the WriteOff.
+func (WriteOff) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "status"),
+		index.Fields("invoice_id"),
+		index.Fields("tenant_id", "customer_id"),
+	}
+}
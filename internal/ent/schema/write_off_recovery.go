@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WriteOffRecovery holds the schema definition for an amount of a written-off
+// balance reinstated on the invoice because the customer is paying it after
+// all.
+type WriteOffRecovery struct {
+	ent.Schema
+}
+
+// Fields of the WriteOffRecovery.
+func (WriteOffRecovery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("tenant_id", uuid.UUID{}).
+			Comment("Tenant identifier"),
+		field.UUID("write_off_id", uuid.UUID{}).
+			Comment("Write-off being recovered"),
+		field.UUID("invoice_id", uuid.UUID{}).
+			Comment("Invoice the balance is reinstated on"),
+		field.Float("amount").
+			GoType(decimal.Decimal{}),
+		field.String("note").
+			Optional(),
+		field.UUID("journal_entry_id", uuid.UUID{}).
+			Optional(),
+		field.UUID("recovered_by", uuid.UUID{}).
+			Optional(),
+		field.Time("recovered_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the WriteOffRecovery.
+func (WriteOffRecovery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("write_off_id"),
+		index.Fields("tenant_id", "invoice_id"),
+	}
+}
@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/settlementbatch"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SettlementBatch is the model entity for the SettlementBatch schema.
+type SettlementBatch struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Sequential settlement batch number
+	BatchNumber string `json:"batch_number,omitempty"`
+	// Collection channel: mpesa, card, bank
+	Channel string `json:"channel,omitempty"`
+	// ISO currency code
+	Currency string `json:"currency,omitempty"`
+	// Start of the settlement window
+	WindowStart time.Time `json:"window_start,omitempty"`
+	// End of the settlement window (exclusive)
+	WindowEnd time.Time `json:"window_end,omitempty"`
+	// Status: draft, pending_approval, approved, processing, completed, failed
+	Status string `json:"status,omitempty"`
+	// Fraction of gross collections charged as the settlement fee (defaults to zero)
+	FeeRate decimal.Decimal `json:"fee_rate,omitempty"`
+	// Payments collected (defaults to zero)
+	GrossAmount decimal.Decimal `json:"gross_amount,omitempty"`
+	// Refunds and chargebacks deducted (defaults to zero)
+	RefundAmount decimal.Decimal `json:"refund_amount,omitempty"`
+	// Settlement fee deducted (defaults to zero)
+	FeeAmount decimal.Decimal `json:"fee_amount,omitempty"`
+	// Net operator adjustments (defaults to zero)
+	AdjustmentAmount decimal.Decimal `json:"adjustment_amount,omitempty"`
+	// Amount to disburse: gross less refunds and fees plus adjustments
+	NetAmount decimal.Decimal `json:"net_amount,omitempty"`
+	// TransactionCount holds the value of the "transaction_count" field.
+	TransactionCount int `json:"transaction_count,omitempty"`
+	// User who generated the batch; empty when generated by the worker
+	CreatedBy uuid.UUID `json:"created_by,omitempty"`
+	// SubmittedBy holds the value of the "submitted_by" field.
+	SubmittedBy uuid.UUID `json:"submitted_by,omitempty"`
+	// SubmittedAt holds the value of the "submitted_at" field.
+	SubmittedAt time.Time `json:"submitted_at,omitempty"`
+	// ApprovedBy holds the value of the "approved_by" field.
+	ApprovedBy uuid.UUID `json:"approved_by,omitempty"`
+	// ApprovedAt holds the value of the "approved_at" field.
+	ApprovedAt time.Time `json:"approved_at,omitempty"`
+	// Why the batch was last sent back to draft
+	RejectionReason string `json:"rejection_reason,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SettlementBatchQuery when eager-loading is set.
+	Edges        SettlementBatchEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SettlementBatchEdges holds the relations/edges for other nodes in the graph.
+type SettlementBatchEdges struct {
+	// Items holds the value of the items edge.
+	Items []*SettlementItem `json:"items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ItemsOrErr returns the Items value or an error if the edge
+// was not loaded in eager-loading.
+func (e SettlementBatchEdges) ItemsOrErr() ([]*SettlementItem, error) {
+	if e.loadedTypes[0] {
+		return e.Items, nil
+	}
+	return nil, &NotLoadedError{edge: "items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SettlementBatch) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case settlementbatch.FieldFeeRate, settlementbatch.FieldGrossAmount, settlementbatch.FieldRefundAmount, settlementbatch.FieldFeeAmount, settlementbatch.FieldAdjustmentAmount, settlementbatch.FieldNetAmount:
+			values[i] = new(decimal.Decimal)
+		case settlementbatch.FieldTransactionCount:
+			values[i] = new(sql.NullInt64)
+		case settlementbatch.FieldBatchNumber, settlementbatch.FieldChannel, settlementbatch.FieldCurrency, settlementbatch.FieldStatus, settlementbatch.FieldRejectionReason:
+			values[i] = new(sql.NullString)
+		case settlementbatch.FieldWindowStart, settlementbatch.FieldWindowEnd, settlementbatch.FieldSubmittedAt, settlementbatch.FieldApprovedAt, settlementbatch.FieldCreatedAt, settlementbatch.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case settlementbatch.FieldID, settlementbatch.FieldTenantID, settlementbatch.FieldCreatedBy, settlementbatch.FieldSubmittedBy, settlementbatch.FieldApprovedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SettlementBatch fields.
+func (_m *SettlementBatch) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case settlementbatch.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case settlementbatch.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case settlementbatch.FieldBatchNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_number", values[i])
+			} else if value.Valid {
+				_m.BatchNumber = value.String
+			}
+		case settlementbatch.FieldChannel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field channel", values[i])
+			} else if value.Valid {
+				_m.Channel = value.String
+			}
+		case settlementbatch.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case settlementbatch.FieldWindowStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field window_start", values[i])
+			} else if value.Valid {
+				_m.WindowStart = value.Time
+			}
+		case settlementbatch.FieldWindowEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field window_end", values[i])
+			} else if value.Valid {
+				_m.WindowEnd = value.Time
+			}
+		case settlementbatch.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case settlementbatch.FieldFeeRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field fee_rate", values[i])
+			} else if value != nil {
+				_m.FeeRate = *value
+			}
+		case settlementbatch.FieldGrossAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field gross_amount", values[i])
+			} else if value != nil {
+				_m.GrossAmount = *value
+			}
+		case settlementbatch.FieldRefundAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field refund_amount", values[i])
+			} else if value != nil {
+				_m.RefundAmount = *value
+			}
+		case settlementbatch.FieldFeeAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field fee_amount", values[i])
+			} else if value != nil {
+				_m.FeeAmount = *value
+			}
+		case settlementbatch.FieldAdjustmentAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field adjustment_amount", values[i])
+			} else if value != nil {
+				_m.AdjustmentAmount = *value
+			}
+		case settlementbatch.FieldNetAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field net_amount", values[i])
+			} else if value != nil {
+				_m.NetAmount = *value
+			}
+		case settlementbatch.FieldTransactionCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_count", values[i])
+			} else if value.Valid {
+				_m.TransactionCount = int(value.Int64)
+			}
+		case settlementbatch.FieldCreatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value != nil {
+				_m.CreatedBy = *value
+			}
+		case settlementbatch.FieldSubmittedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_by", values[i])
+			} else if value != nil {
+				_m.SubmittedBy = *value
+			}
+		case settlementbatch.FieldSubmittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_at", values[i])
+			} else if value.Valid {
+				_m.SubmittedAt = value.Time
+			}
+		case settlementbatch.FieldApprovedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_by", values[i])
+			} else if value != nil {
+				_m.ApprovedBy = *value
+			}
+		case settlementbatch.FieldApprovedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_at", values[i])
+			} else if value.Valid {
+				_m.ApprovedAt = value.Time
+			}
+		case settlementbatch.FieldRejectionReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rejection_reason", values[i])
+			} else if value.Valid {
+				_m.RejectionReason = value.String
+			}
+		case settlementbatch.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case settlementbatch.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SettlementBatch.
+// This includes values selected through modifiers, order, etc.
+func (_m *SettlementBatch) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryItems queries the "items" edge of the SettlementBatch entity.
+func (_m *SettlementBatch) QueryItems() *SettlementItemQuery {
+	return NewSettlementBatchClient(_m.config).QueryItems(_m)
+}
+
+// Update returns a builder for updating this SettlementBatch.
+// Note that you need to call SettlementBatch.Unwrap() before calling this method if this SettlementBatch
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SettlementBatch) Update() *SettlementBatchUpdateOne {
+	return NewSettlementBatchClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SettlementBatch entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SettlementBatch) Unwrap() *SettlementBatch {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SettlementBatch is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SettlementBatch) String() string {
+	var builder strings.Builder
+	builder.WriteString("SettlementBatch(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("batch_number=")
+	builder.WriteString(_m.BatchNumber)
+	builder.WriteString(", ")
+	builder.WriteString("channel=")
+	builder.WriteString(_m.Channel)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("window_start=")
+	builder.WriteString(_m.WindowStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("window_end=")
+	builder.WriteString(_m.WindowEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("fee_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FeeRate))
+	builder.WriteString(", ")
+	builder.WriteString("gross_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GrossAmount))
+	builder.WriteString(", ")
+	builder.WriteString("refund_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RefundAmount))
+	builder.WriteString(", ")
+	builder.WriteString("fee_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FeeAmount))
+	builder.WriteString(", ")
+	builder.WriteString("adjustment_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AdjustmentAmount))
+	builder.WriteString(", ")
+	builder.WriteString("net_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NetAmount))
+	builder.WriteString(", ")
+	builder.WriteString("transaction_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TransactionCount))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("submitted_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SubmittedBy))
+	builder.WriteString(", ")
+	builder.WriteString("submitted_at=")
+	builder.WriteString(_m.SubmittedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("approved_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ApprovedBy))
+	builder.WriteString(", ")
+	builder.WriteString("approved_at=")
+	builder.WriteString(_m.ApprovedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("rejection_reason=")
+	builder.WriteString(_m.RejectionReason)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SettlementBatches is a parsable slice of SettlementBatch.
+type SettlementBatches []*SettlementBatch
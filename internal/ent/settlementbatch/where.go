@@ -0,0 +1,1331 @@
+// Code generated by ent, DO NOT EDIT.
+
+package settlementbatch
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldTenantID, v))
+}
+
+// BatchNumber applies equality check predicate on the "batch_number" field. It's identical to BatchNumberEQ.
+func BatchNumber(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldBatchNumber, v))
+}
+
+// Channel applies equality check predicate on the "channel" field. It's identical to ChannelEQ.
+func Channel(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldChannel, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldCurrency, v))
+}
+
+// WindowStart applies equality check predicate on the "window_start" field. It's identical to WindowStartEQ.
+func WindowStart(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldWindowStart, v))
+}
+
+// WindowEnd applies equality check predicate on the "window_end" field. It's identical to WindowEndEQ.
+func WindowEnd(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldWindowEnd, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldStatus, v))
+}
+
+// FeeRate applies equality check predicate on the "fee_rate" field. It's identical to FeeRateEQ.
+func FeeRate(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldFeeRate, v))
+}
+
+// GrossAmount applies equality check predicate on the "gross_amount" field. It's identical to GrossAmountEQ.
+func GrossAmount(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldGrossAmount, v))
+}
+
+// RefundAmount applies equality check predicate on the "refund_amount" field. It's identical to RefundAmountEQ.
+func RefundAmount(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldRefundAmount, v))
+}
+
+// FeeAmount applies equality check predicate on the "fee_amount" field. It's identical to FeeAmountEQ.
+func FeeAmount(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldFeeAmount, v))
+}
+
+// AdjustmentAmount applies equality check predicate on the "adjustment_amount" field. It's identical to AdjustmentAmountEQ.
+func AdjustmentAmount(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldAdjustmentAmount, v))
+}
+
+// NetAmount applies equality check predicate on the "net_amount" field. It's identical to NetAmountEQ.
+func NetAmount(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldNetAmount, v))
+}
+
+// TransactionCount applies equality check predicate on the "transaction_count" field. It's identical to TransactionCountEQ.
+func TransactionCount(v int) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldTransactionCount, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// SubmittedBy applies equality check predicate on the "submitted_by" field. It's identical to SubmittedByEQ.
+func SubmittedBy(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldSubmittedBy, v))
+}
+
+// SubmittedAt applies equality check predicate on the "submitted_at" field. It's identical to SubmittedAtEQ.
+func SubmittedAt(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// ApprovedBy applies equality check predicate on the "approved_by" field. It's identical to ApprovedByEQ.
+func ApprovedBy(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldApprovedBy, v))
+}
+
+// ApprovedAt applies equality check predicate on the "approved_at" field. It's identical to ApprovedAtEQ.
+func ApprovedAt(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// RejectionReason applies equality check predicate on the "rejection_reason" field. It's identical to RejectionReasonEQ.
+func RejectionReason(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldRejectionReason, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldTenantID, v))
+}
+
+// BatchNumberEQ applies the EQ predicate on the "batch_number" field.
+func BatchNumberEQ(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldBatchNumber, v))
+}
+
+// BatchNumberNEQ applies the NEQ predicate on the "batch_number" field.
+func BatchNumberNEQ(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldBatchNumber, v))
+}
+
+// BatchNumberIn applies the In predicate on the "batch_number" field.
+func BatchNumberIn(vs ...string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldBatchNumber, vs...))
+}
+
+// BatchNumberNotIn applies the NotIn predicate on the "batch_number" field.
+func BatchNumberNotIn(vs ...string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldBatchNumber, vs...))
+}
+
+// BatchNumberGT applies the GT predicate on the "batch_number" field.
+func BatchNumberGT(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldBatchNumber, v))
+}
+
+// BatchNumberGTE applies the GTE predicate on the "batch_number" field.
+func BatchNumberGTE(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldBatchNumber, v))
+}
+
+// BatchNumberLT applies the LT predicate on the "batch_number" field.
+func BatchNumberLT(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldBatchNumber, v))
+}
+
+// BatchNumberLTE applies the LTE predicate on the "batch_number" field.
+func BatchNumberLTE(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldBatchNumber, v))
+}
+
+// BatchNumberContains applies the Contains predicate on the "batch_number" field.
+func BatchNumberContains(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldContains(FieldBatchNumber, v))
+}
+
+// BatchNumberHasPrefix applies the HasPrefix predicate on the "batch_number" field.
+func BatchNumberHasPrefix(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldHasPrefix(FieldBatchNumber, v))
+}
+
+// BatchNumberHasSuffix applies the HasSuffix predicate on the "batch_number" field.
+func BatchNumberHasSuffix(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldHasSuffix(FieldBatchNumber, v))
+}
+
+// BatchNumberEqualFold applies the EqualFold predicate on the "batch_number" field.
+func BatchNumberEqualFold(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEqualFold(FieldBatchNumber, v))
+}
+
+// BatchNumberContainsFold applies the ContainsFold predicate on the "batch_number" field.
+func BatchNumberContainsFold(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldContainsFold(FieldBatchNumber, v))
+}
+
+// ChannelEQ applies the EQ predicate on the "channel" field.
+func ChannelEQ(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldChannel, v))
+}
+
+// ChannelNEQ applies the NEQ predicate on the "channel" field.
+func ChannelNEQ(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldChannel, v))
+}
+
+// ChannelIn applies the In predicate on the "channel" field.
+func ChannelIn(vs ...string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldChannel, vs...))
+}
+
+// ChannelNotIn applies the NotIn predicate on the "channel" field.
+func ChannelNotIn(vs ...string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldChannel, vs...))
+}
+
+// ChannelGT applies the GT predicate on the "channel" field.
+func ChannelGT(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldChannel, v))
+}
+
+// ChannelGTE applies the GTE predicate on the "channel" field.
+func ChannelGTE(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldChannel, v))
+}
+
+// ChannelLT applies the LT predicate on the "channel" field.
+func ChannelLT(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldChannel, v))
+}
+
+// ChannelLTE applies the LTE predicate on the "channel" field.
+func ChannelLTE(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldChannel, v))
+}
+
+// ChannelContains applies the Contains predicate on the "channel" field.
+func ChannelContains(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldContains(FieldChannel, v))
+}
+
+// ChannelHasPrefix applies the HasPrefix predicate on the "channel" field.
+func ChannelHasPrefix(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldHasPrefix(FieldChannel, v))
+}
+
+// ChannelHasSuffix applies the HasSuffix predicate on the "channel" field.
+func ChannelHasSuffix(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldHasSuffix(FieldChannel, v))
+}
+
+// ChannelEqualFold applies the EqualFold predicate on the "channel" field.
+func ChannelEqualFold(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEqualFold(FieldChannel, v))
+}
+
+// ChannelContainsFold applies the ContainsFold predicate on the "channel" field.
+func ChannelContainsFold(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldContainsFold(FieldChannel, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// WindowStartEQ applies the EQ predicate on the "window_start" field.
+func WindowStartEQ(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldWindowStart, v))
+}
+
+// WindowStartNEQ applies the NEQ predicate on the "window_start" field.
+func WindowStartNEQ(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldWindowStart, v))
+}
+
+// WindowStartIn applies the In predicate on the "window_start" field.
+func WindowStartIn(vs ...time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldWindowStart, vs...))
+}
+
+// WindowStartNotIn applies the NotIn predicate on the "window_start" field.
+func WindowStartNotIn(vs ...time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldWindowStart, vs...))
+}
+
+// WindowStartGT applies the GT predicate on the "window_start" field.
+func WindowStartGT(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldWindowStart, v))
+}
+
+// WindowStartGTE applies the GTE predicate on the "window_start" field.
+func WindowStartGTE(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldWindowStart, v))
+}
+
+// WindowStartLT applies the LT predicate on the "window_start" field.
+func WindowStartLT(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldWindowStart, v))
+}
+
+// WindowStartLTE applies the LTE predicate on the "window_start" field.
+func WindowStartLTE(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldWindowStart, v))
+}
+
+// WindowEndEQ applies the EQ predicate on the "window_end" field.
+func WindowEndEQ(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldWindowEnd, v))
+}
+
+// WindowEndNEQ applies the NEQ predicate on the "window_end" field.
+func WindowEndNEQ(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldWindowEnd, v))
+}
+
+// WindowEndIn applies the In predicate on the "window_end" field.
+func WindowEndIn(vs ...time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldWindowEnd, vs...))
+}
+
+// WindowEndNotIn applies the NotIn predicate on the "window_end" field.
+func WindowEndNotIn(vs ...time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldWindowEnd, vs...))
+}
+
+// WindowEndGT applies the GT predicate on the "window_end" field.
+func WindowEndGT(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldWindowEnd, v))
+}
+
+// WindowEndGTE applies the GTE predicate on the "window_end" field.
+func WindowEndGTE(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldWindowEnd, v))
+}
+
+// WindowEndLT applies the LT predicate on the "window_end" field.
+func WindowEndLT(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldWindowEnd, v))
+}
+
+// WindowEndLTE applies the LTE predicate on the "window_end" field.
+func WindowEndLTE(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldWindowEnd, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// FeeRateEQ applies the EQ predicate on the "fee_rate" field.
+func FeeRateEQ(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldFeeRate, v))
+}
+
+// FeeRateNEQ applies the NEQ predicate on the "fee_rate" field.
+func FeeRateNEQ(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldFeeRate, v))
+}
+
+// FeeRateIn applies the In predicate on the "fee_rate" field.
+func FeeRateIn(vs ...decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldFeeRate, vs...))
+}
+
+// FeeRateNotIn applies the NotIn predicate on the "fee_rate" field.
+func FeeRateNotIn(vs ...decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldFeeRate, vs...))
+}
+
+// FeeRateGT applies the GT predicate on the "fee_rate" field.
+func FeeRateGT(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldFeeRate, v))
+}
+
+// FeeRateGTE applies the GTE predicate on the "fee_rate" field.
+func FeeRateGTE(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldFeeRate, v))
+}
+
+// FeeRateLT applies the LT predicate on the "fee_rate" field.
+func FeeRateLT(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldFeeRate, v))
+}
+
+// FeeRateLTE applies the LTE predicate on the "fee_rate" field.
+func FeeRateLTE(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldFeeRate, v))
+}
+
+// FeeRateIsNil applies the IsNil predicate on the "fee_rate" field.
+func FeeRateIsNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIsNull(FieldFeeRate))
+}
+
+// FeeRateNotNil applies the NotNil predicate on the "fee_rate" field.
+func FeeRateNotNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotNull(FieldFeeRate))
+}
+
+// GrossAmountEQ applies the EQ predicate on the "gross_amount" field.
+func GrossAmountEQ(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldGrossAmount, v))
+}
+
+// GrossAmountNEQ applies the NEQ predicate on the "gross_amount" field.
+func GrossAmountNEQ(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldGrossAmount, v))
+}
+
+// GrossAmountIn applies the In predicate on the "gross_amount" field.
+func GrossAmountIn(vs ...decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldGrossAmount, vs...))
+}
+
+// GrossAmountNotIn applies the NotIn predicate on the "gross_amount" field.
+func GrossAmountNotIn(vs ...decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldGrossAmount, vs...))
+}
+
+// GrossAmountGT applies the GT predicate on the "gross_amount" field.
+func GrossAmountGT(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldGrossAmount, v))
+}
+
+// GrossAmountGTE applies the GTE predicate on the "gross_amount" field.
+func GrossAmountGTE(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldGrossAmount, v))
+}
+
+// GrossAmountLT applies the LT predicate on the "gross_amount" field.
+func GrossAmountLT(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldGrossAmount, v))
+}
+
+// GrossAmountLTE applies the LTE predicate on the "gross_amount" field.
+func GrossAmountLTE(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldGrossAmount, v))
+}
+
+// GrossAmountIsNil applies the IsNil predicate on the "gross_amount" field.
+func GrossAmountIsNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIsNull(FieldGrossAmount))
+}
+
+// GrossAmountNotNil applies the NotNil predicate on the "gross_amount" field.
+func GrossAmountNotNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotNull(FieldGrossAmount))
+}
+
+// RefundAmountEQ applies the EQ predicate on the "refund_amount" field.
+func RefundAmountEQ(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldRefundAmount, v))
+}
+
+// RefundAmountNEQ applies the NEQ predicate on the "refund_amount" field.
+func RefundAmountNEQ(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldRefundAmount, v))
+}
+
+// RefundAmountIn applies the In predicate on the "refund_amount" field.
+func RefundAmountIn(vs ...decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldRefundAmount, vs...))
+}
+
+// RefundAmountNotIn applies the NotIn predicate on the "refund_amount" field.
+func RefundAmountNotIn(vs ...decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldRefundAmount, vs...))
+}
+
+// RefundAmountGT applies the GT predicate on the "refund_amount" field.
+func RefundAmountGT(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldRefundAmount, v))
+}
+
+// RefundAmountGTE applies the GTE predicate on the "refund_amount" field.
+func RefundAmountGTE(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldRefundAmount, v))
+}
+
+// RefundAmountLT applies the LT predicate on the "refund_amount" field.
+func RefundAmountLT(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldRefundAmount, v))
+}
+
+// RefundAmountLTE applies the LTE predicate on the "refund_amount" field.
+func RefundAmountLTE(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldRefundAmount, v))
+}
+
+// RefundAmountIsNil applies the IsNil predicate on the "refund_amount" field.
+func RefundAmountIsNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIsNull(FieldRefundAmount))
+}
+
+// RefundAmountNotNil applies the NotNil predicate on the "refund_amount" field.
+func RefundAmountNotNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotNull(FieldRefundAmount))
+}
+
+// FeeAmountEQ applies the EQ predicate on the "fee_amount" field.
+func FeeAmountEQ(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldFeeAmount, v))
+}
+
+// FeeAmountNEQ applies the NEQ predicate on the "fee_amount" field.
+func FeeAmountNEQ(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldFeeAmount, v))
+}
+
+// FeeAmountIn applies the In predicate on the "fee_amount" field.
+func FeeAmountIn(vs ...decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldFeeAmount, vs...))
+}
+
+// FeeAmountNotIn applies the NotIn predicate on the "fee_amount" field.
+func FeeAmountNotIn(vs ...decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldFeeAmount, vs...))
+}
+
+// FeeAmountGT applies the GT predicate on the "fee_amount" field.
+func FeeAmountGT(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldFeeAmount, v))
+}
+
+// FeeAmountGTE applies the GTE predicate on the "fee_amount" field.
+func FeeAmountGTE(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldFeeAmount, v))
+}
+
+// FeeAmountLT applies the LT predicate on the "fee_amount" field.
+func FeeAmountLT(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldFeeAmount, v))
+}
+
+// FeeAmountLTE applies the LTE predicate on the "fee_amount" field.
+func FeeAmountLTE(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldFeeAmount, v))
+}
+
+// FeeAmountIsNil applies the IsNil predicate on the "fee_amount" field.
+func FeeAmountIsNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIsNull(FieldFeeAmount))
+}
+
+// FeeAmountNotNil applies the NotNil predicate on the "fee_amount" field.
+func FeeAmountNotNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotNull(FieldFeeAmount))
+}
+
+// AdjustmentAmountEQ applies the EQ predicate on the "adjustment_amount" field.
+func AdjustmentAmountEQ(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldAdjustmentAmount, v))
+}
+
+// AdjustmentAmountNEQ applies the NEQ predicate on the "adjustment_amount" field.
+func AdjustmentAmountNEQ(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldAdjustmentAmount, v))
+}
+
+// AdjustmentAmountIn applies the In predicate on the "adjustment_amount" field.
+func AdjustmentAmountIn(vs ...decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldAdjustmentAmount, vs...))
+}
+
+// AdjustmentAmountNotIn applies the NotIn predicate on the "adjustment_amount" field.
+func AdjustmentAmountNotIn(vs ...decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldAdjustmentAmount, vs...))
+}
+
+// AdjustmentAmountGT applies the GT predicate on the "adjustment_amount" field.
+func AdjustmentAmountGT(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldAdjustmentAmount, v))
+}
+
+// AdjustmentAmountGTE applies the GTE predicate on the "adjustment_amount" field.
+func AdjustmentAmountGTE(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldAdjustmentAmount, v))
+}
+
+// AdjustmentAmountLT applies the LT predicate on the "adjustment_amount" field.
+func AdjustmentAmountLT(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldAdjustmentAmount, v))
+}
+
+// AdjustmentAmountLTE applies the LTE predicate on the "adjustment_amount" field.
+func AdjustmentAmountLTE(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldAdjustmentAmount, v))
+}
+
+// AdjustmentAmountIsNil applies the IsNil predicate on the "adjustment_amount" field.
+func AdjustmentAmountIsNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIsNull(FieldAdjustmentAmount))
+}
+
+// AdjustmentAmountNotNil applies the NotNil predicate on the "adjustment_amount" field.
+func AdjustmentAmountNotNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotNull(FieldAdjustmentAmount))
+}
+
+// NetAmountEQ applies the EQ predicate on the "net_amount" field.
+func NetAmountEQ(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldNetAmount, v))
+}
+
+// NetAmountNEQ applies the NEQ predicate on the "net_amount" field.
+func NetAmountNEQ(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldNetAmount, v))
+}
+
+// NetAmountIn applies the In predicate on the "net_amount" field.
+func NetAmountIn(vs ...decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldNetAmount, vs...))
+}
+
+// NetAmountNotIn applies the NotIn predicate on the "net_amount" field.
+func NetAmountNotIn(vs ...decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldNetAmount, vs...))
+}
+
+// NetAmountGT applies the GT predicate on the "net_amount" field.
+func NetAmountGT(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldNetAmount, v))
+}
+
+// NetAmountGTE applies the GTE predicate on the "net_amount" field.
+func NetAmountGTE(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldNetAmount, v))
+}
+
+// NetAmountLT applies the LT predicate on the "net_amount" field.
+func NetAmountLT(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldNetAmount, v))
+}
+
+// NetAmountLTE applies the LTE predicate on the "net_amount" field.
+func NetAmountLTE(v decimal.Decimal) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldNetAmount, v))
+}
+
+// NetAmountIsNil applies the IsNil predicate on the "net_amount" field.
+func NetAmountIsNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIsNull(FieldNetAmount))
+}
+
+// NetAmountNotNil applies the NotNil predicate on the "net_amount" field.
+func NetAmountNotNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotNull(FieldNetAmount))
+}
+
+// TransactionCountEQ applies the EQ predicate on the "transaction_count" field.
+func TransactionCountEQ(v int) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldTransactionCount, v))
+}
+
+// TransactionCountNEQ applies the NEQ predicate on the "transaction_count" field.
+func TransactionCountNEQ(v int) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldTransactionCount, v))
+}
+
+// TransactionCountIn applies the In predicate on the "transaction_count" field.
+func TransactionCountIn(vs ...int) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldTransactionCount, vs...))
+}
+
+// TransactionCountNotIn applies the NotIn predicate on the "transaction_count" field.
+func TransactionCountNotIn(vs ...int) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldTransactionCount, vs...))
+}
+
+// TransactionCountGT applies the GT predicate on the "transaction_count" field.
+func TransactionCountGT(v int) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldTransactionCount, v))
+}
+
+// TransactionCountGTE applies the GTE predicate on the "transaction_count" field.
+func TransactionCountGTE(v int) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldTransactionCount, v))
+}
+
+// TransactionCountLT applies the LT predicate on the "transaction_count" field.
+func TransactionCountLT(v int) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldTransactionCount, v))
+}
+
+// TransactionCountLTE applies the LTE predicate on the "transaction_count" field.
+func TransactionCountLTE(v int) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldTransactionCount, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// SubmittedByEQ applies the EQ predicate on the "submitted_by" field.
+func SubmittedByEQ(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldSubmittedBy, v))
+}
+
+// SubmittedByNEQ applies the NEQ predicate on the "submitted_by" field.
+func SubmittedByNEQ(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldSubmittedBy, v))
+}
+
+// SubmittedByIn applies the In predicate on the "submitted_by" field.
+func SubmittedByIn(vs ...uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldSubmittedBy, vs...))
+}
+
+// SubmittedByNotIn applies the NotIn predicate on the "submitted_by" field.
+func SubmittedByNotIn(vs ...uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldSubmittedBy, vs...))
+}
+
+// SubmittedByGT applies the GT predicate on the "submitted_by" field.
+func SubmittedByGT(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldSubmittedBy, v))
+}
+
+// SubmittedByGTE applies the GTE predicate on the "submitted_by" field.
+func SubmittedByGTE(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldSubmittedBy, v))
+}
+
+// SubmittedByLT applies the LT predicate on the "submitted_by" field.
+func SubmittedByLT(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldSubmittedBy, v))
+}
+
+// SubmittedByLTE applies the LTE predicate on the "submitted_by" field.
+func SubmittedByLTE(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldSubmittedBy, v))
+}
+
+// SubmittedByIsNil applies the IsNil predicate on the "submitted_by" field.
+func SubmittedByIsNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIsNull(FieldSubmittedBy))
+}
+
+// SubmittedByNotNil applies the NotNil predicate on the "submitted_by" field.
+func SubmittedByNotNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotNull(FieldSubmittedBy))
+}
+
+// SubmittedAtEQ applies the EQ predicate on the "submitted_at" field.
+func SubmittedAtEQ(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtNEQ applies the NEQ predicate on the "submitted_at" field.
+func SubmittedAtNEQ(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIn applies the In predicate on the "submitted_at" field.
+func SubmittedAtIn(vs ...time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtNotIn applies the NotIn predicate on the "submitted_at" field.
+func SubmittedAtNotIn(vs ...time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtGT applies the GT predicate on the "submitted_at" field.
+func SubmittedAtGT(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtGTE applies the GTE predicate on the "submitted_at" field.
+func SubmittedAtGTE(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLT applies the LT predicate on the "submitted_at" field.
+func SubmittedAtLT(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLTE applies the LTE predicate on the "submitted_at" field.
+func SubmittedAtLTE(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIsNil applies the IsNil predicate on the "submitted_at" field.
+func SubmittedAtIsNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIsNull(FieldSubmittedAt))
+}
+
+// SubmittedAtNotNil applies the NotNil predicate on the "submitted_at" field.
+func SubmittedAtNotNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotNull(FieldSubmittedAt))
+}
+
+// ApprovedByEQ applies the EQ predicate on the "approved_by" field.
+func ApprovedByEQ(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldApprovedBy, v))
+}
+
+// ApprovedByNEQ applies the NEQ predicate on the "approved_by" field.
+func ApprovedByNEQ(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldApprovedBy, v))
+}
+
+// ApprovedByIn applies the In predicate on the "approved_by" field.
+func ApprovedByIn(vs ...uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldApprovedBy, vs...))
+}
+
+// ApprovedByNotIn applies the NotIn predicate on the "approved_by" field.
+func ApprovedByNotIn(vs ...uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldApprovedBy, vs...))
+}
+
+// ApprovedByGT applies the GT predicate on the "approved_by" field.
+func ApprovedByGT(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldApprovedBy, v))
+}
+
+// ApprovedByGTE applies the GTE predicate on the "approved_by" field.
+func ApprovedByGTE(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldApprovedBy, v))
+}
+
+// ApprovedByLT applies the LT predicate on the "approved_by" field.
+func ApprovedByLT(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldApprovedBy, v))
+}
+
+// ApprovedByLTE applies the LTE predicate on the "approved_by" field.
+func ApprovedByLTE(v uuid.UUID) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldApprovedBy, v))
+}
+
+// ApprovedByIsNil applies the IsNil predicate on the "approved_by" field.
+func ApprovedByIsNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIsNull(FieldApprovedBy))
+}
+
+// ApprovedByNotNil applies the NotNil predicate on the "approved_by" field.
+func ApprovedByNotNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotNull(FieldApprovedBy))
+}
+
+// ApprovedAtEQ applies the EQ predicate on the "approved_at" field.
+func ApprovedAtEQ(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtNEQ applies the NEQ predicate on the "approved_at" field.
+func ApprovedAtNEQ(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtIn applies the In predicate on the "approved_at" field.
+func ApprovedAtIn(vs ...time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtNotIn applies the NotIn predicate on the "approved_at" field.
+func ApprovedAtNotIn(vs ...time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtGT applies the GT predicate on the "approved_at" field.
+func ApprovedAtGT(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldApprovedAt, v))
+}
+
+// ApprovedAtGTE applies the GTE predicate on the "approved_at" field.
+func ApprovedAtGTE(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtLT applies the LT predicate on the "approved_at" field.
+func ApprovedAtLT(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldApprovedAt, v))
+}
+
+// ApprovedAtLTE applies the LTE predicate on the "approved_at" field.
+func ApprovedAtLTE(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtIsNil applies the IsNil predicate on the "approved_at" field.
+func ApprovedAtIsNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIsNull(FieldApprovedAt))
+}
+
+// ApprovedAtNotNil applies the NotNil predicate on the "approved_at" field.
+func ApprovedAtNotNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotNull(FieldApprovedAt))
+}
+
+// RejectionReasonEQ applies the EQ predicate on the "rejection_reason" field.
+func RejectionReasonEQ(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldRejectionReason, v))
+}
+
+// RejectionReasonNEQ applies the NEQ predicate on the "rejection_reason" field.
+func RejectionReasonNEQ(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldRejectionReason, v))
+}
+
+// RejectionReasonIn applies the In predicate on the "rejection_reason" field.
+func RejectionReasonIn(vs ...string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldRejectionReason, vs...))
+}
+
+// RejectionReasonNotIn applies the NotIn predicate on the "rejection_reason" field.
+func RejectionReasonNotIn(vs ...string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldRejectionReason, vs...))
+}
+
+// RejectionReasonGT applies the GT predicate on the "rejection_reason" field.
+func RejectionReasonGT(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldRejectionReason, v))
+}
+
+// RejectionReasonGTE applies the GTE predicate on the "rejection_reason" field.
+func RejectionReasonGTE(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldRejectionReason, v))
+}
+
+// RejectionReasonLT applies the LT predicate on the "rejection_reason" field.
+func RejectionReasonLT(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldRejectionReason, v))
+}
+
+// RejectionReasonLTE applies the LTE predicate on the "rejection_reason" field.
+func RejectionReasonLTE(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldRejectionReason, v))
+}
+
+// RejectionReasonContains applies the Contains predicate on the "rejection_reason" field.
+func RejectionReasonContains(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldContains(FieldRejectionReason, v))
+}
+
+// RejectionReasonHasPrefix applies the HasPrefix predicate on the "rejection_reason" field.
+func RejectionReasonHasPrefix(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldHasPrefix(FieldRejectionReason, v))
+}
+
+// RejectionReasonHasSuffix applies the HasSuffix predicate on the "rejection_reason" field.
+func RejectionReasonHasSuffix(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldHasSuffix(FieldRejectionReason, v))
+}
+
+// RejectionReasonIsNil applies the IsNil predicate on the "rejection_reason" field.
+func RejectionReasonIsNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIsNull(FieldRejectionReason))
+}
+
+// RejectionReasonNotNil applies the NotNil predicate on the "rejection_reason" field.
+func RejectionReasonNotNil() predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotNull(FieldRejectionReason))
+}
+
+// RejectionReasonEqualFold applies the EqualFold predicate on the "rejection_reason" field.
+func RejectionReasonEqualFold(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEqualFold(FieldRejectionReason, v))
+}
+
+// RejectionReasonContainsFold applies the ContainsFold predicate on the "rejection_reason" field.
+func RejectionReasonContainsFold(v string) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldContainsFold(FieldRejectionReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasItems applies the HasEdge predicate on the "items" edge.
+func HasItems() predicate.SettlementBatch {
+	return predicate.SettlementBatch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemsWith applies the HasEdge predicate on the "items" edge with a given conditions (other predicates).
+func HasItemsWith(preds ...predicate.SettlementItem) predicate.SettlementBatch {
+	return predicate.SettlementBatch(func(s *sql.Selector) {
+		step := newItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SettlementBatch) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SettlementBatch) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SettlementBatch) predicate.SettlementBatch {
+	return predicate.SettlementBatch(sql.NotPredicates(p))
+}
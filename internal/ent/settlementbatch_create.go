@@ -0,0 +1,1956 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/settlementbatch"
+	"github.com/bengobox/treasury-api/internal/ent/settlementitem"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SettlementBatchCreate is the builder for creating a SettlementBatch entity.
+type SettlementBatchCreate struct {
+	config
+	mutation *SettlementBatchMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *SettlementBatchCreate) SetTenantID(v uuid.UUID) *SettlementBatchCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetBatchNumber sets the "batch_number" field.
+func (_c *SettlementBatchCreate) SetBatchNumber(v string) *SettlementBatchCreate {
+	_c.mutation.SetBatchNumber(v)
+	return _c
+}
+
+// SetChannel sets the "channel" field.
+func (_c *SettlementBatchCreate) SetChannel(v string) *SettlementBatchCreate {
+	_c.mutation.SetChannel(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *SettlementBatchCreate) SetCurrency(v string) *SettlementBatchCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *SettlementBatchCreate) SetNillableCurrency(v *string) *SettlementBatchCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetWindowStart sets the "window_start" field.
+func (_c *SettlementBatchCreate) SetWindowStart(v time.Time) *SettlementBatchCreate {
+	_c.mutation.SetWindowStart(v)
+	return _c
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (_c *SettlementBatchCreate) SetWindowEnd(v time.Time) *SettlementBatchCreate {
+	_c.mutation.SetWindowEnd(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *SettlementBatchCreate) SetStatus(v string) *SettlementBatchCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *SettlementBatchCreate) SetNillableStatus(v *string) *SettlementBatchCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetFeeRate sets the "fee_rate" field.
+func (_c *SettlementBatchCreate) SetFeeRate(v decimal.Decimal) *SettlementBatchCreate {
+	_c.mutation.SetFeeRate(v)
+	return _c
+}
+
+// SetNillableFeeRate sets the "fee_rate" field if the given value is not nil.
+func (_c *SettlementBatchCreate) SetNillableFeeRate(v *decimal.Decimal) *SettlementBatchCreate {
+	if v != nil {
+		_c.SetFeeRate(*v)
+	}
+	return _c
+}
+
+// SetGrossAmount sets the "gross_amount" field.
+func (_c *SettlementBatchCreate) SetGrossAmount(v decimal.Decimal) *SettlementBatchCreate {
+	_c.mutation.SetGrossAmount(v)
+	return _c
+}
+
+// SetNillableGrossAmount sets the "gross_amount" field if the given value is not nil.
+func (_c *SettlementBatchCreate) SetNillableGrossAmount(v *decimal.Decimal) *SettlementBatchCreate {
+	if v != nil {
+		_c.SetGrossAmount(*v)
+	}
+	return _c
+}
+
+// SetRefundAmount sets the "refund_amount" field.
+func (_c *SettlementBatchCreate) SetRefundAmount(v decimal.Decimal) *SettlementBatchCreate {
+	_c.mutation.SetRefundAmount(v)
+	return _c
+}
+
+// SetNillableRefundAmount sets the "refund_amount" field if the given value is not nil.
+func (_c *SettlementBatchCreate) SetNillableRefundAmount(v *decimal.Decimal) *SettlementBatchCreate {
+	if v != nil {
+		_c.SetRefundAmount(*v)
+	}
+	return _c
+}
+
+// SetFeeAmount sets the "fee_amount" field.
+func (_c *SettlementBatchCreate) SetFeeAmount(v decimal.Decimal) *SettlementBatchCreate {
+	_c.mutation.SetFeeAmount(v)
+	return _c
+}
+
+// SetNillableFeeAmount sets the "fee_amount" field if the given value is not nil.
+func (_c *SettlementBatchCreate) SetNillableFeeAmount(v *decimal.Decimal) *SettlementBatchCreate {
+	if v != nil {
+		_c.SetFeeAmount(*v)
+	}
+	return _c
+}
+
+// SetAdjustmentAmount sets the "adjustment_amount" field.
+func (_c *SettlementBatchCreate) SetAdjustmentAmount(v decimal.Decimal) *SettlementBatchCreate {
+	_c.mutation.SetAdjustmentAmount(v)
+	return _c
+}
+
+// SetNillableAdjustmentAmount sets the "adjustment_amount" field if the given value is not nil.
+func (_c *SettlementBatchCreate) SetNillableAdjustmentAmount(v *decimal.Decimal) *SettlementBatchCreate {
+	if v != nil {
+		_c.SetAdjustmentAmount(*v)
+	}
+	return _c
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (_c *SettlementBatchCreate) SetNetAmount(v decimal.Decimal) *SettlementBatchCreate {
+	_c.mutation.SetNetAmount(v)
+	return _c
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (_c *SettlementBatchCreate) SetNillableNetAmount(v *decimal.Decimal) *SettlementBatchCreate {
+	if v != nil {
+		_c.SetNetAmount(*v)
+	}
+	return _c
+}
+
+// SetTransactionCount sets the "transaction_count" field.
+func (_c *SettlementBatchCreate) SetTransactionCount(v int) *SettlementBatchCreate {
+	_c.mutation.SetTransactionCount(v)
+	return _c
+}
+
+// SetNillableTransactionCount sets the "transaction_count" field if the given value is not nil.
+func (_c *SettlementBatchCreate) SetNillableTransactionCount(v *int) *SettlementBatchCreate {
+	if v != nil {
+		_c.SetTransactionCount(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *SettlementBatchCreate) SetCreatedBy(v uuid.UUID) *SettlementBatchCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *SettlementBatchCreate) SetNillableCreatedBy(v *uuid.UUID) *SettlementBatchCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (_c *SettlementBatchCreate) SetSubmittedBy(v uuid.UUID) *SettlementBatchCreate {
+	_c.mutation.SetSubmittedBy(v)
+	return _c
+}
+
+// SetNillableSubmittedBy sets the "submitted_by" field if the given value is not nil.
+func (_c *SettlementBatchCreate) SetNillableSubmittedBy(v *uuid.UUID) *SettlementBatchCreate {
+	if v != nil {
+		_c.SetSubmittedBy(*v)
+	}
+	return _c
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_c *SettlementBatchCreate) SetSubmittedAt(v time.Time) *SettlementBatchCreate {
+	_c.mutation.SetSubmittedAt(v)
+	return _c
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_c *SettlementBatchCreate) SetNillableSubmittedAt(v *time.Time) *SettlementBatchCreate {
+	if v != nil {
+		_c.SetSubmittedAt(*v)
+	}
+	return _c
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_c *SettlementBatchCreate) SetApprovedBy(v uuid.UUID) *SettlementBatchCreate {
+	_c.mutation.SetApprovedBy(v)
+	return _c
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_c *SettlementBatchCreate) SetNillableApprovedBy(v *uuid.UUID) *SettlementBatchCreate {
+	if v != nil {
+		_c.SetApprovedBy(*v)
+	}
+	return _c
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_c *SettlementBatchCreate) SetApprovedAt(v time.Time) *SettlementBatchCreate {
+	_c.mutation.SetApprovedAt(v)
+	return _c
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_c *SettlementBatchCreate) SetNillableApprovedAt(v *time.Time) *SettlementBatchCreate {
+	if v != nil {
+		_c.SetApprovedAt(*v)
+	}
+	return _c
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_c *SettlementBatchCreate) SetRejectionReason(v string) *SettlementBatchCreate {
+	_c.mutation.SetRejectionReason(v)
+	return _c
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_c *SettlementBatchCreate) SetNillableRejectionReason(v *string) *SettlementBatchCreate {
+	if v != nil {
+		_c.SetRejectionReason(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SettlementBatchCreate) SetCreatedAt(v time.Time) *SettlementBatchCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SettlementBatchCreate) SetNillableCreatedAt(v *time.Time) *SettlementBatchCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SettlementBatchCreate) SetUpdatedAt(v time.Time) *SettlementBatchCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SettlementBatchCreate) SetNillableUpdatedAt(v *time.Time) *SettlementBatchCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SettlementBatchCreate) SetID(v uuid.UUID) *SettlementBatchCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SettlementBatchCreate) SetNillableID(v *uuid.UUID) *SettlementBatchCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddItemIDs adds the "items" edge to the SettlementItem entity by IDs.
+func (_c *SettlementBatchCreate) AddItemIDs(ids ...uuid.UUID) *SettlementBatchCreate {
+	_c.mutation.AddItemIDs(ids...)
+	return _c
+}
+
+// AddItems adds the "items" edges to the SettlementItem entity.
+func (_c *SettlementBatchCreate) AddItems(v ...*SettlementItem) *SettlementBatchCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddItemIDs(ids...)
+}
+
+// Mutation returns the SettlementBatchMutation object of the builder.
+func (_c *SettlementBatchCreate) Mutation() *SettlementBatchMutation {
+	return _c.mutation
+}
+
+// Save creates the SettlementBatch in the database.
+func (_c *SettlementBatchCreate) Save(ctx context.Context) (*SettlementBatch, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SettlementBatchCreate) SaveX(ctx context.Context) *SettlementBatch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SettlementBatchCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SettlementBatchCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SettlementBatchCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := settlementbatch.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := settlementbatch.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.TransactionCount(); !ok {
+		v := settlementbatch.DefaultTransactionCount
+		_c.mutation.SetTransactionCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := settlementbatch.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := settlementbatch.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := settlementbatch.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SettlementBatchCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "SettlementBatch.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.BatchNumber(); !ok {
+		return &ValidationError{Name: "batch_number", err: errors.New(`ent: missing required field "SettlementBatch.batch_number"`)}
+	}
+	if v, ok := _c.mutation.BatchNumber(); ok {
+		if err := settlementbatch.BatchNumberValidator(v); err != nil {
+			return &ValidationError{Name: "batch_number", err: fmt.Errorf(`ent: validator failed for field "SettlementBatch.batch_number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Channel(); !ok {
+		return &ValidationError{Name: "channel", err: errors.New(`ent: missing required field "SettlementBatch.channel"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "SettlementBatch.currency"`)}
+	}
+	if _, ok := _c.mutation.WindowStart(); !ok {
+		return &ValidationError{Name: "window_start", err: errors.New(`ent: missing required field "SettlementBatch.window_start"`)}
+	}
+	if _, ok := _c.mutation.WindowEnd(); !ok {
+		return &ValidationError{Name: "window_end", err: errors.New(`ent: missing required field "SettlementBatch.window_end"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SettlementBatch.status"`)}
+	}
+	if _, ok := _c.mutation.TransactionCount(); !ok {
+		return &ValidationError{Name: "transaction_count", err: errors.New(`ent: missing required field "SettlementBatch.transaction_count"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SettlementBatch.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SettlementBatch.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *SettlementBatchCreate) sqlSave(ctx context.Context) (*SettlementBatch, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SettlementBatchCreate) createSpec() (*SettlementBatch, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SettlementBatch{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(settlementbatch.Table, sqlgraph.NewFieldSpec(settlementbatch.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(settlementbatch.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.BatchNumber(); ok {
+		_spec.SetField(settlementbatch.FieldBatchNumber, field.TypeString, value)
+		_node.BatchNumber = value
+	}
+	if value, ok := _c.mutation.Channel(); ok {
+		_spec.SetField(settlementbatch.FieldChannel, field.TypeString, value)
+		_node.Channel = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(settlementbatch.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.WindowStart(); ok {
+		_spec.SetField(settlementbatch.FieldWindowStart, field.TypeTime, value)
+		_node.WindowStart = value
+	}
+	if value, ok := _c.mutation.WindowEnd(); ok {
+		_spec.SetField(settlementbatch.FieldWindowEnd, field.TypeTime, value)
+		_node.WindowEnd = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(settlementbatch.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.FeeRate(); ok {
+		_spec.SetField(settlementbatch.FieldFeeRate, field.TypeFloat64, value)
+		_node.FeeRate = value
+	}
+	if value, ok := _c.mutation.GrossAmount(); ok {
+		_spec.SetField(settlementbatch.FieldGrossAmount, field.TypeFloat64, value)
+		_node.GrossAmount = value
+	}
+	if value, ok := _c.mutation.RefundAmount(); ok {
+		_spec.SetField(settlementbatch.FieldRefundAmount, field.TypeFloat64, value)
+		_node.RefundAmount = value
+	}
+	if value, ok := _c.mutation.FeeAmount(); ok {
+		_spec.SetField(settlementbatch.FieldFeeAmount, field.TypeFloat64, value)
+		_node.FeeAmount = value
+	}
+	if value, ok := _c.mutation.AdjustmentAmount(); ok {
+		_spec.SetField(settlementbatch.FieldAdjustmentAmount, field.TypeFloat64, value)
+		_node.AdjustmentAmount = value
+	}
+	if value, ok := _c.mutation.NetAmount(); ok {
+		_spec.SetField(settlementbatch.FieldNetAmount, field.TypeFloat64, value)
+		_node.NetAmount = value
+	}
+	if value, ok := _c.mutation.TransactionCount(); ok {
+		_spec.SetField(settlementbatch.FieldTransactionCount, field.TypeInt, value)
+		_node.TransactionCount = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(settlementbatch.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.SubmittedBy(); ok {
+		_spec.SetField(settlementbatch.FieldSubmittedBy, field.TypeUUID, value)
+		_node.SubmittedBy = value
+	}
+	if value, ok := _c.mutation.SubmittedAt(); ok {
+		_spec.SetField(settlementbatch.FieldSubmittedAt, field.TypeTime, value)
+		_node.SubmittedAt = value
+	}
+	if value, ok := _c.mutation.ApprovedBy(); ok {
+		_spec.SetField(settlementbatch.FieldApprovedBy, field.TypeUUID, value)
+		_node.ApprovedBy = value
+	}
+	if value, ok := _c.mutation.ApprovedAt(); ok {
+		_spec.SetField(settlementbatch.FieldApprovedAt, field.TypeTime, value)
+		_node.ApprovedAt = value
+	}
+	if value, ok := _c.mutation.RejectionReason(); ok {
+		_spec.SetField(settlementbatch.FieldRejectionReason, field.TypeString, value)
+		_node.RejectionReason = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(settlementbatch.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(settlementbatch.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlementbatch.ItemsTable,
+			Columns: []string{settlementbatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlementitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SettlementBatch.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SettlementBatchUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SettlementBatchCreate) OnConflict(opts ...sql.ConflictOption) *SettlementBatchUpsertOne {
+	_c.conflict = opts
+	return &SettlementBatchUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SettlementBatch.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SettlementBatchCreate) OnConflictColumns(columns ...string) *SettlementBatchUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SettlementBatchUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SettlementBatchUpsertOne is the builder for "upsert"-ing
+	//  one SettlementBatch node.
+	SettlementBatchUpsertOne struct {
+		create *SettlementBatchCreate
+	}
+
+	// SettlementBatchUpsert is the "OnConflict" setter.
+	SettlementBatchUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SettlementBatchUpsert) SetTenantID(v uuid.UUID) *SettlementBatchUpsert {
+	u.Set(settlementbatch.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SettlementBatchUpsert) UpdateTenantID() *SettlementBatchUpsert {
+	u.SetExcluded(settlementbatch.FieldTenantID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *SettlementBatchUpsert) SetStatus(v string) *SettlementBatchUpsert {
+	u.Set(settlementbatch.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SettlementBatchUpsert) UpdateStatus() *SettlementBatchUpsert {
+	u.SetExcluded(settlementbatch.FieldStatus)
+	return u
+}
+
+// SetFeeRate sets the "fee_rate" field.
+func (u *SettlementBatchUpsert) SetFeeRate(v decimal.Decimal) *SettlementBatchUpsert {
+	u.Set(settlementbatch.FieldFeeRate, v)
+	return u
+}
+
+// UpdateFeeRate sets the "fee_rate" field to the value that was provided on create.
+func (u *SettlementBatchUpsert) UpdateFeeRate() *SettlementBatchUpsert {
+	u.SetExcluded(settlementbatch.FieldFeeRate)
+	return u
+}
+
+// AddFeeRate adds v to the "fee_rate" field.
+func (u *SettlementBatchUpsert) AddFeeRate(v decimal.Decimal) *SettlementBatchUpsert {
+	u.Add(settlementbatch.FieldFeeRate, v)
+	return u
+}
+
+// ClearFeeRate clears the value of the "fee_rate" field.
+func (u *SettlementBatchUpsert) ClearFeeRate() *SettlementBatchUpsert {
+	u.SetNull(settlementbatch.FieldFeeRate)
+	return u
+}
+
+// SetGrossAmount sets the "gross_amount" field.
+func (u *SettlementBatchUpsert) SetGrossAmount(v decimal.Decimal) *SettlementBatchUpsert {
+	u.Set(settlementbatch.FieldGrossAmount, v)
+	return u
+}
+
+// UpdateGrossAmount sets the "gross_amount" field to the value that was provided on create.
+func (u *SettlementBatchUpsert) UpdateGrossAmount() *SettlementBatchUpsert {
+	u.SetExcluded(settlementbatch.FieldGrossAmount)
+	return u
+}
+
+// AddGrossAmount adds v to the "gross_amount" field.
+func (u *SettlementBatchUpsert) AddGrossAmount(v decimal.Decimal) *SettlementBatchUpsert {
+	u.Add(settlementbatch.FieldGrossAmount, v)
+	return u
+}
+
+// ClearGrossAmount clears the value of the "gross_amount" field.
+func (u *SettlementBatchUpsert) ClearGrossAmount() *SettlementBatchUpsert {
+	u.SetNull(settlementbatch.FieldGrossAmount)
+	return u
+}
+
+// SetRefundAmount sets the "refund_amount" field.
+func (u *SettlementBatchUpsert) SetRefundAmount(v decimal.Decimal) *SettlementBatchUpsert {
+	u.Set(settlementbatch.FieldRefundAmount, v)
+	return u
+}
+
+// UpdateRefundAmount sets the "refund_amount" field to the value that was provided on create.
+func (u *SettlementBatchUpsert) UpdateRefundAmount() *SettlementBatchUpsert {
+	u.SetExcluded(settlementbatch.FieldRefundAmount)
+	return u
+}
+
+// AddRefundAmount adds v to the "refund_amount" field.
+func (u *SettlementBatchUpsert) AddRefundAmount(v decimal.Decimal) *SettlementBatchUpsert {
+	u.Add(settlementbatch.FieldRefundAmount, v)
+	return u
+}
+
+// ClearRefundAmount clears the value of the "refund_amount" field.
+func (u *SettlementBatchUpsert) ClearRefundAmount() *SettlementBatchUpsert {
+	u.SetNull(settlementbatch.FieldRefundAmount)
+	return u
+}
+
+// SetFeeAmount sets the "fee_amount" field.
+func (u *SettlementBatchUpsert) SetFeeAmount(v decimal.Decimal) *SettlementBatchUpsert {
+	u.Set(settlementbatch.FieldFeeAmount, v)
+	return u
+}
+
+// UpdateFeeAmount sets the "fee_amount" field to the value that was provided on create.
+func (u *SettlementBatchUpsert) UpdateFeeAmount() *SettlementBatchUpsert {
+	u.SetExcluded(settlementbatch.FieldFeeAmount)
+	return u
+}
+
+// AddFeeAmount adds v to the "fee_amount" field.
+func (u *SettlementBatchUpsert) AddFeeAmount(v decimal.Decimal) *SettlementBatchUpsert {
+	u.Add(settlementbatch.FieldFeeAmount, v)
+	return u
+}
+
+// ClearFeeAmount clears the value of the "fee_amount" field.
+func (u *SettlementBatchUpsert) ClearFeeAmount() *SettlementBatchUpsert {
+	u.SetNull(settlementbatch.FieldFeeAmount)
+	return u
+}
+
+// SetAdjustmentAmount sets the "adjustment_amount" field.
+func (u *SettlementBatchUpsert) SetAdjustmentAmount(v decimal.Decimal) *SettlementBatchUpsert {
+	u.Set(settlementbatch.FieldAdjustmentAmount, v)
+	return u
+}
+
+// UpdateAdjustmentAmount sets the "adjustment_amount" field to the value that was provided on create.
+func (u *SettlementBatchUpsert) UpdateAdjustmentAmount() *SettlementBatchUpsert {
+	u.SetExcluded(settlementbatch.FieldAdjustmentAmount)
+	return u
+}
+
+// AddAdjustmentAmount adds v to the "adjustment_amount" field.
+func (u *SettlementBatchUpsert) AddAdjustmentAmount(v decimal.Decimal) *SettlementBatchUpsert {
+	u.Add(settlementbatch.FieldAdjustmentAmount, v)
+	return u
+}
+
+// ClearAdjustmentAmount clears the value of the "adjustment_amount" field.
+func (u *SettlementBatchUpsert) ClearAdjustmentAmount() *SettlementBatchUpsert {
+	u.SetNull(settlementbatch.FieldAdjustmentAmount)
+	return u
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (u *SettlementBatchUpsert) SetNetAmount(v decimal.Decimal) *SettlementBatchUpsert {
+	u.Set(settlementbatch.FieldNetAmount, v)
+	return u
+}
+
+// UpdateNetAmount sets the "net_amount" field to the value that was provided on create.
+func (u *SettlementBatchUpsert) UpdateNetAmount() *SettlementBatchUpsert {
+	u.SetExcluded(settlementbatch.FieldNetAmount)
+	return u
+}
+
+// AddNetAmount adds v to the "net_amount" field.
+func (u *SettlementBatchUpsert) AddNetAmount(v decimal.Decimal) *SettlementBatchUpsert {
+	u.Add(settlementbatch.FieldNetAmount, v)
+	return u
+}
+
+// ClearNetAmount clears the value of the "net_amount" field.
+func (u *SettlementBatchUpsert) ClearNetAmount() *SettlementBatchUpsert {
+	u.SetNull(settlementbatch.FieldNetAmount)
+	return u
+}
+
+// SetTransactionCount sets the "transaction_count" field.
+func (u *SettlementBatchUpsert) SetTransactionCount(v int) *SettlementBatchUpsert {
+	u.Set(settlementbatch.FieldTransactionCount, v)
+	return u
+}
+
+// UpdateTransactionCount sets the "transaction_count" field to the value that was provided on create.
+func (u *SettlementBatchUpsert) UpdateTransactionCount() *SettlementBatchUpsert {
+	u.SetExcluded(settlementbatch.FieldTransactionCount)
+	return u
+}
+
+// AddTransactionCount adds v to the "transaction_count" field.
+func (u *SettlementBatchUpsert) AddTransactionCount(v int) *SettlementBatchUpsert {
+	u.Add(settlementbatch.FieldTransactionCount, v)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *SettlementBatchUpsert) SetCreatedBy(v uuid.UUID) *SettlementBatchUpsert {
+	u.Set(settlementbatch.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *SettlementBatchUpsert) UpdateCreatedBy() *SettlementBatchUpsert {
+	u.SetExcluded(settlementbatch.FieldCreatedBy)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *SettlementBatchUpsert) ClearCreatedBy() *SettlementBatchUpsert {
+	u.SetNull(settlementbatch.FieldCreatedBy)
+	return u
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (u *SettlementBatchUpsert) SetSubmittedBy(v uuid.UUID) *SettlementBatchUpsert {
+	u.Set(settlementbatch.FieldSubmittedBy, v)
+	return u
+}
+
+// UpdateSubmittedBy sets the "submitted_by" field to the value that was provided on create.
+func (u *SettlementBatchUpsert) UpdateSubmittedBy() *SettlementBatchUpsert {
+	u.SetExcluded(settlementbatch.FieldSubmittedBy)
+	return u
+}
+
+// ClearSubmittedBy clears the value of the "submitted_by" field.
+func (u *SettlementBatchUpsert) ClearSubmittedBy() *SettlementBatchUpsert {
+	u.SetNull(settlementbatch.FieldSubmittedBy)
+	return u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *SettlementBatchUpsert) SetSubmittedAt(v time.Time) *SettlementBatchUpsert {
+	u.Set(settlementbatch.FieldSubmittedAt, v)
+	return u
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *SettlementBatchUpsert) UpdateSubmittedAt() *SettlementBatchUpsert {
+	u.SetExcluded(settlementbatch.FieldSubmittedAt)
+	return u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (u *SettlementBatchUpsert) ClearSubmittedAt() *SettlementBatchUpsert {
+	u.SetNull(settlementbatch.FieldSubmittedAt)
+	return u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *SettlementBatchUpsert) SetApprovedBy(v uuid.UUID) *SettlementBatchUpsert {
+	u.Set(settlementbatch.FieldApprovedBy, v)
+	return u
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *SettlementBatchUpsert) UpdateApprovedBy() *SettlementBatchUpsert {
+	u.SetExcluded(settlementbatch.FieldApprovedBy)
+	return u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *SettlementBatchUpsert) ClearApprovedBy() *SettlementBatchUpsert {
+	u.SetNull(settlementbatch.FieldApprovedBy)
+	return u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *SettlementBatchUpsert) SetApprovedAt(v time.Time) *SettlementBatchUpsert {
+	u.Set(settlementbatch.FieldApprovedAt, v)
+	return u
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *SettlementBatchUpsert) UpdateApprovedAt() *SettlementBatchUpsert {
+	u.SetExcluded(settlementbatch.FieldApprovedAt)
+	return u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *SettlementBatchUpsert) ClearApprovedAt() *SettlementBatchUpsert {
+	u.SetNull(settlementbatch.FieldApprovedAt)
+	return u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *SettlementBatchUpsert) SetRejectionReason(v string) *SettlementBatchUpsert {
+	u.Set(settlementbatch.FieldRejectionReason, v)
+	return u
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *SettlementBatchUpsert) UpdateRejectionReason() *SettlementBatchUpsert {
+	u.SetExcluded(settlementbatch.FieldRejectionReason)
+	return u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *SettlementBatchUpsert) ClearRejectionReason() *SettlementBatchUpsert {
+	u.SetNull(settlementbatch.FieldRejectionReason)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SettlementBatchUpsert) SetUpdatedAt(v time.Time) *SettlementBatchUpsert {
+	u.Set(settlementbatch.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SettlementBatchUpsert) UpdateUpdatedAt() *SettlementBatchUpsert {
+	u.SetExcluded(settlementbatch.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SettlementBatch.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(settlementbatch.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SettlementBatchUpsertOne) UpdateNewValues() *SettlementBatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(settlementbatch.FieldID)
+		}
+		if _, exists := u.create.mutation.BatchNumber(); exists {
+			s.SetIgnore(settlementbatch.FieldBatchNumber)
+		}
+		if _, exists := u.create.mutation.Channel(); exists {
+			s.SetIgnore(settlementbatch.FieldChannel)
+		}
+		if _, exists := u.create.mutation.Currency(); exists {
+			s.SetIgnore(settlementbatch.FieldCurrency)
+		}
+		if _, exists := u.create.mutation.WindowStart(); exists {
+			s.SetIgnore(settlementbatch.FieldWindowStart)
+		}
+		if _, exists := u.create.mutation.WindowEnd(); exists {
+			s.SetIgnore(settlementbatch.FieldWindowEnd)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(settlementbatch.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SettlementBatch.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SettlementBatchUpsertOne) Ignore() *SettlementBatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SettlementBatchUpsertOne) DoNothing() *SettlementBatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SettlementBatchCreate.OnConflict
+// documentation for more info.
+func (u *SettlementBatchUpsertOne) Update(set func(*SettlementBatchUpsert)) *SettlementBatchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SettlementBatchUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SettlementBatchUpsertOne) SetTenantID(v uuid.UUID) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SettlementBatchUpsertOne) UpdateTenantID() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SettlementBatchUpsertOne) SetStatus(v string) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SettlementBatchUpsertOne) UpdateStatus() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetFeeRate sets the "fee_rate" field.
+func (u *SettlementBatchUpsertOne) SetFeeRate(v decimal.Decimal) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetFeeRate(v)
+	})
+}
+
+// AddFeeRate adds v to the "fee_rate" field.
+func (u *SettlementBatchUpsertOne) AddFeeRate(v decimal.Decimal) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.AddFeeRate(v)
+	})
+}
+
+// UpdateFeeRate sets the "fee_rate" field to the value that was provided on create.
+func (u *SettlementBatchUpsertOne) UpdateFeeRate() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateFeeRate()
+	})
+}
+
+// ClearFeeRate clears the value of the "fee_rate" field.
+func (u *SettlementBatchUpsertOne) ClearFeeRate() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearFeeRate()
+	})
+}
+
+// SetGrossAmount sets the "gross_amount" field.
+func (u *SettlementBatchUpsertOne) SetGrossAmount(v decimal.Decimal) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetGrossAmount(v)
+	})
+}
+
+// AddGrossAmount adds v to the "gross_amount" field.
+func (u *SettlementBatchUpsertOne) AddGrossAmount(v decimal.Decimal) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.AddGrossAmount(v)
+	})
+}
+
+// UpdateGrossAmount sets the "gross_amount" field to the value that was provided on create.
+func (u *SettlementBatchUpsertOne) UpdateGrossAmount() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateGrossAmount()
+	})
+}
+
+// ClearGrossAmount clears the value of the "gross_amount" field.
+func (u *SettlementBatchUpsertOne) ClearGrossAmount() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearGrossAmount()
+	})
+}
+
+// SetRefundAmount sets the "refund_amount" field.
+func (u *SettlementBatchUpsertOne) SetRefundAmount(v decimal.Decimal) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetRefundAmount(v)
+	})
+}
+
+// AddRefundAmount adds v to the "refund_amount" field.
+func (u *SettlementBatchUpsertOne) AddRefundAmount(v decimal.Decimal) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.AddRefundAmount(v)
+	})
+}
+
+// UpdateRefundAmount sets the "refund_amount" field to the value that was provided on create.
+func (u *SettlementBatchUpsertOne) UpdateRefundAmount() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateRefundAmount()
+	})
+}
+
+// ClearRefundAmount clears the value of the "refund_amount" field.
+func (u *SettlementBatchUpsertOne) ClearRefundAmount() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearRefundAmount()
+	})
+}
+
+// SetFeeAmount sets the "fee_amount" field.
+func (u *SettlementBatchUpsertOne) SetFeeAmount(v decimal.Decimal) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetFeeAmount(v)
+	})
+}
+
+// AddFeeAmount adds v to the "fee_amount" field.
+func (u *SettlementBatchUpsertOne) AddFeeAmount(v decimal.Decimal) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.AddFeeAmount(v)
+	})
+}
+
+// UpdateFeeAmount sets the "fee_amount" field to the value that was provided on create.
+func (u *SettlementBatchUpsertOne) UpdateFeeAmount() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateFeeAmount()
+	})
+}
+
+// ClearFeeAmount clears the value of the "fee_amount" field.
+func (u *SettlementBatchUpsertOne) ClearFeeAmount() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearFeeAmount()
+	})
+}
+
+// SetAdjustmentAmount sets the "adjustment_amount" field.
+func (u *SettlementBatchUpsertOne) SetAdjustmentAmount(v decimal.Decimal) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetAdjustmentAmount(v)
+	})
+}
+
+// AddAdjustmentAmount adds v to the "adjustment_amount" field.
+func (u *SettlementBatchUpsertOne) AddAdjustmentAmount(v decimal.Decimal) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.AddAdjustmentAmount(v)
+	})
+}
+
+// UpdateAdjustmentAmount sets the "adjustment_amount" field to the value that was provided on create.
+func (u *SettlementBatchUpsertOne) UpdateAdjustmentAmount() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateAdjustmentAmount()
+	})
+}
+
+// ClearAdjustmentAmount clears the value of the "adjustment_amount" field.
+func (u *SettlementBatchUpsertOne) ClearAdjustmentAmount() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearAdjustmentAmount()
+	})
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (u *SettlementBatchUpsertOne) SetNetAmount(v decimal.Decimal) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetNetAmount(v)
+	})
+}
+
+// AddNetAmount adds v to the "net_amount" field.
+func (u *SettlementBatchUpsertOne) AddNetAmount(v decimal.Decimal) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.AddNetAmount(v)
+	})
+}
+
+// UpdateNetAmount sets the "net_amount" field to the value that was provided on create.
+func (u *SettlementBatchUpsertOne) UpdateNetAmount() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateNetAmount()
+	})
+}
+
+// ClearNetAmount clears the value of the "net_amount" field.
+func (u *SettlementBatchUpsertOne) ClearNetAmount() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearNetAmount()
+	})
+}
+
+// SetTransactionCount sets the "transaction_count" field.
+func (u *SettlementBatchUpsertOne) SetTransactionCount(v int) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetTransactionCount(v)
+	})
+}
+
+// AddTransactionCount adds v to the "transaction_count" field.
+func (u *SettlementBatchUpsertOne) AddTransactionCount(v int) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.AddTransactionCount(v)
+	})
+}
+
+// UpdateTransactionCount sets the "transaction_count" field to the value that was provided on create.
+func (u *SettlementBatchUpsertOne) UpdateTransactionCount() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateTransactionCount()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *SettlementBatchUpsertOne) SetCreatedBy(v uuid.UUID) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *SettlementBatchUpsertOne) UpdateCreatedBy() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *SettlementBatchUpsertOne) ClearCreatedBy() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (u *SettlementBatchUpsertOne) SetSubmittedBy(v uuid.UUID) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetSubmittedBy(v)
+	})
+}
+
+// UpdateSubmittedBy sets the "submitted_by" field to the value that was provided on create.
+func (u *SettlementBatchUpsertOne) UpdateSubmittedBy() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateSubmittedBy()
+	})
+}
+
+// ClearSubmittedBy clears the value of the "submitted_by" field.
+func (u *SettlementBatchUpsertOne) ClearSubmittedBy() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearSubmittedBy()
+	})
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *SettlementBatchUpsertOne) SetSubmittedAt(v time.Time) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetSubmittedAt(v)
+	})
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *SettlementBatchUpsertOne) UpdateSubmittedAt() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateSubmittedAt()
+	})
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (u *SettlementBatchUpsertOne) ClearSubmittedAt() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearSubmittedAt()
+	})
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *SettlementBatchUpsertOne) SetApprovedBy(v uuid.UUID) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetApprovedBy(v)
+	})
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *SettlementBatchUpsertOne) UpdateApprovedBy() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateApprovedBy()
+	})
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *SettlementBatchUpsertOne) ClearApprovedBy() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearApprovedBy()
+	})
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *SettlementBatchUpsertOne) SetApprovedAt(v time.Time) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetApprovedAt(v)
+	})
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *SettlementBatchUpsertOne) UpdateApprovedAt() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateApprovedAt()
+	})
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *SettlementBatchUpsertOne) ClearApprovedAt() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearApprovedAt()
+	})
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *SettlementBatchUpsertOne) SetRejectionReason(v string) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetRejectionReason(v)
+	})
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *SettlementBatchUpsertOne) UpdateRejectionReason() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateRejectionReason()
+	})
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *SettlementBatchUpsertOne) ClearRejectionReason() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearRejectionReason()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SettlementBatchUpsertOne) SetUpdatedAt(v time.Time) *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SettlementBatchUpsertOne) UpdateUpdatedAt() *SettlementBatchUpsertOne {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SettlementBatchUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SettlementBatchCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SettlementBatchUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SettlementBatchUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SettlementBatchUpsertOne.ID is not supported by MySQL driver. Use SettlementBatchUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SettlementBatchUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SettlementBatchCreateBulk is the builder for creating many SettlementBatch entities in bulk.
+type SettlementBatchCreateBulk struct {
+	config
+	err      error
+	builders []*SettlementBatchCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SettlementBatch entities in the database.
+func (_c *SettlementBatchCreateBulk) Save(ctx context.Context) ([]*SettlementBatch, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SettlementBatch, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SettlementBatchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SettlementBatchCreateBulk) SaveX(ctx context.Context) []*SettlementBatch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SettlementBatchCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SettlementBatchCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SettlementBatch.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SettlementBatchUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SettlementBatchCreateBulk) OnConflict(opts ...sql.ConflictOption) *SettlementBatchUpsertBulk {
+	_c.conflict = opts
+	return &SettlementBatchUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SettlementBatch.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SettlementBatchCreateBulk) OnConflictColumns(columns ...string) *SettlementBatchUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SettlementBatchUpsertBulk{
+		create: _c,
+	}
+}
+
+// SettlementBatchUpsertBulk is the builder for "upsert"-ing
+// a bulk of SettlementBatch nodes.
+type SettlementBatchUpsertBulk struct {
+	create *SettlementBatchCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SettlementBatch.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(settlementbatch.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SettlementBatchUpsertBulk) UpdateNewValues() *SettlementBatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(settlementbatch.FieldID)
+			}
+			if _, exists := b.mutation.BatchNumber(); exists {
+				s.SetIgnore(settlementbatch.FieldBatchNumber)
+			}
+			if _, exists := b.mutation.Channel(); exists {
+				s.SetIgnore(settlementbatch.FieldChannel)
+			}
+			if _, exists := b.mutation.Currency(); exists {
+				s.SetIgnore(settlementbatch.FieldCurrency)
+			}
+			if _, exists := b.mutation.WindowStart(); exists {
+				s.SetIgnore(settlementbatch.FieldWindowStart)
+			}
+			if _, exists := b.mutation.WindowEnd(); exists {
+				s.SetIgnore(settlementbatch.FieldWindowEnd)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(settlementbatch.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SettlementBatch.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SettlementBatchUpsertBulk) Ignore() *SettlementBatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SettlementBatchUpsertBulk) DoNothing() *SettlementBatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SettlementBatchCreateBulk.OnConflict
+// documentation for more info.
+func (u *SettlementBatchUpsertBulk) Update(set func(*SettlementBatchUpsert)) *SettlementBatchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SettlementBatchUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SettlementBatchUpsertBulk) SetTenantID(v uuid.UUID) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SettlementBatchUpsertBulk) UpdateTenantID() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SettlementBatchUpsertBulk) SetStatus(v string) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SettlementBatchUpsertBulk) UpdateStatus() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetFeeRate sets the "fee_rate" field.
+func (u *SettlementBatchUpsertBulk) SetFeeRate(v decimal.Decimal) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetFeeRate(v)
+	})
+}
+
+// AddFeeRate adds v to the "fee_rate" field.
+func (u *SettlementBatchUpsertBulk) AddFeeRate(v decimal.Decimal) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.AddFeeRate(v)
+	})
+}
+
+// UpdateFeeRate sets the "fee_rate" field to the value that was provided on create.
+func (u *SettlementBatchUpsertBulk) UpdateFeeRate() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateFeeRate()
+	})
+}
+
+// ClearFeeRate clears the value of the "fee_rate" field.
+func (u *SettlementBatchUpsertBulk) ClearFeeRate() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearFeeRate()
+	})
+}
+
+// SetGrossAmount sets the "gross_amount" field.
+func (u *SettlementBatchUpsertBulk) SetGrossAmount(v decimal.Decimal) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetGrossAmount(v)
+	})
+}
+
+// AddGrossAmount adds v to the "gross_amount" field.
+func (u *SettlementBatchUpsertBulk) AddGrossAmount(v decimal.Decimal) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.AddGrossAmount(v)
+	})
+}
+
+// UpdateGrossAmount sets the "gross_amount" field to the value that was provided on create.
+func (u *SettlementBatchUpsertBulk) UpdateGrossAmount() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateGrossAmount()
+	})
+}
+
+// ClearGrossAmount clears the value of the "gross_amount" field.
+func (u *SettlementBatchUpsertBulk) ClearGrossAmount() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearGrossAmount()
+	})
+}
+
+// SetRefundAmount sets the "refund_amount" field.
+func (u *SettlementBatchUpsertBulk) SetRefundAmount(v decimal.Decimal) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetRefundAmount(v)
+	})
+}
+
+// AddRefundAmount adds v to the "refund_amount" field.
+func (u *SettlementBatchUpsertBulk) AddRefundAmount(v decimal.Decimal) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.AddRefundAmount(v)
+	})
+}
+
+// UpdateRefundAmount sets the "refund_amount" field to the value that was provided on create.
+func (u *SettlementBatchUpsertBulk) UpdateRefundAmount() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateRefundAmount()
+	})
+}
+
+// ClearRefundAmount clears the value of the "refund_amount" field.
+func (u *SettlementBatchUpsertBulk) ClearRefundAmount() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearRefundAmount()
+	})
+}
+
+// SetFeeAmount sets the "fee_amount" field.
+func (u *SettlementBatchUpsertBulk) SetFeeAmount(v decimal.Decimal) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetFeeAmount(v)
+	})
+}
+
+// AddFeeAmount adds v to the "fee_amount" field.
+func (u *SettlementBatchUpsertBulk) AddFeeAmount(v decimal.Decimal) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.AddFeeAmount(v)
+	})
+}
+
+// UpdateFeeAmount sets the "fee_amount" field to the value that was provided on create.
+func (u *SettlementBatchUpsertBulk) UpdateFeeAmount() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateFeeAmount()
+	})
+}
+
+// ClearFeeAmount clears the value of the "fee_amount" field.
+func (u *SettlementBatchUpsertBulk) ClearFeeAmount() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearFeeAmount()
+	})
+}
+
+// SetAdjustmentAmount sets the "adjustment_amount" field.
+func (u *SettlementBatchUpsertBulk) SetAdjustmentAmount(v decimal.Decimal) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetAdjustmentAmount(v)
+	})
+}
+
+// AddAdjustmentAmount adds v to the "adjustment_amount" field.
+func (u *SettlementBatchUpsertBulk) AddAdjustmentAmount(v decimal.Decimal) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.AddAdjustmentAmount(v)
+	})
+}
+
+// UpdateAdjustmentAmount sets the "adjustment_amount" field to the value that was provided on create.
+func (u *SettlementBatchUpsertBulk) UpdateAdjustmentAmount() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateAdjustmentAmount()
+	})
+}
+
+// ClearAdjustmentAmount clears the value of the "adjustment_amount" field.
+func (u *SettlementBatchUpsertBulk) ClearAdjustmentAmount() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearAdjustmentAmount()
+	})
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (u *SettlementBatchUpsertBulk) SetNetAmount(v decimal.Decimal) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetNetAmount(v)
+	})
+}
+
+// AddNetAmount adds v to the "net_amount" field.
+func (u *SettlementBatchUpsertBulk) AddNetAmount(v decimal.Decimal) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.AddNetAmount(v)
+	})
+}
+
+// UpdateNetAmount sets the "net_amount" field to the value that was provided on create.
+func (u *SettlementBatchUpsertBulk) UpdateNetAmount() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateNetAmount()
+	})
+}
+
+// ClearNetAmount clears the value of the "net_amount" field.
+func (u *SettlementBatchUpsertBulk) ClearNetAmount() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearNetAmount()
+	})
+}
+
+// SetTransactionCount sets the "transaction_count" field.
+func (u *SettlementBatchUpsertBulk) SetTransactionCount(v int) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetTransactionCount(v)
+	})
+}
+
+// AddTransactionCount adds v to the "transaction_count" field.
+func (u *SettlementBatchUpsertBulk) AddTransactionCount(v int) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.AddTransactionCount(v)
+	})
+}
+
+// UpdateTransactionCount sets the "transaction_count" field to the value that was provided on create.
+func (u *SettlementBatchUpsertBulk) UpdateTransactionCount() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateTransactionCount()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *SettlementBatchUpsertBulk) SetCreatedBy(v uuid.UUID) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *SettlementBatchUpsertBulk) UpdateCreatedBy() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *SettlementBatchUpsertBulk) ClearCreatedBy() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (u *SettlementBatchUpsertBulk) SetSubmittedBy(v uuid.UUID) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetSubmittedBy(v)
+	})
+}
+
+// UpdateSubmittedBy sets the "submitted_by" field to the value that was provided on create.
+func (u *SettlementBatchUpsertBulk) UpdateSubmittedBy() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateSubmittedBy()
+	})
+}
+
+// ClearSubmittedBy clears the value of the "submitted_by" field.
+func (u *SettlementBatchUpsertBulk) ClearSubmittedBy() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearSubmittedBy()
+	})
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *SettlementBatchUpsertBulk) SetSubmittedAt(v time.Time) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetSubmittedAt(v)
+	})
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *SettlementBatchUpsertBulk) UpdateSubmittedAt() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateSubmittedAt()
+	})
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (u *SettlementBatchUpsertBulk) ClearSubmittedAt() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearSubmittedAt()
+	})
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *SettlementBatchUpsertBulk) SetApprovedBy(v uuid.UUID) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetApprovedBy(v)
+	})
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *SettlementBatchUpsertBulk) UpdateApprovedBy() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateApprovedBy()
+	})
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *SettlementBatchUpsertBulk) ClearApprovedBy() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearApprovedBy()
+	})
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *SettlementBatchUpsertBulk) SetApprovedAt(v time.Time) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetApprovedAt(v)
+	})
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *SettlementBatchUpsertBulk) UpdateApprovedAt() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateApprovedAt()
+	})
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *SettlementBatchUpsertBulk) ClearApprovedAt() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearApprovedAt()
+	})
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (u *SettlementBatchUpsertBulk) SetRejectionReason(v string) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetRejectionReason(v)
+	})
+}
+
+// UpdateRejectionReason sets the "rejection_reason" field to the value that was provided on create.
+func (u *SettlementBatchUpsertBulk) UpdateRejectionReason() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateRejectionReason()
+	})
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (u *SettlementBatchUpsertBulk) ClearRejectionReason() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.ClearRejectionReason()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SettlementBatchUpsertBulk) SetUpdatedAt(v time.Time) *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SettlementBatchUpsertBulk) UpdateUpdatedAt() *SettlementBatchUpsertBulk {
+	return u.Update(func(s *SettlementBatchUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SettlementBatchUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SettlementBatchCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SettlementBatchCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SettlementBatchUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
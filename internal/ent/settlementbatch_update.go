@@ -0,0 +1,1243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/settlementbatch"
+	"github.com/bengobox/treasury-api/internal/ent/settlementitem"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SettlementBatchUpdate is the builder for updating SettlementBatch entities.
+type SettlementBatchUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SettlementBatchMutation
+}
+
+// Where appends a list predicates to the SettlementBatchUpdate builder.
+func (_u *SettlementBatchUpdate) Where(ps ...predicate.SettlementBatch) *SettlementBatchUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *SettlementBatchUpdate) SetTenantID(v uuid.UUID) *SettlementBatchUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *SettlementBatchUpdate) SetNillableTenantID(v *uuid.UUID) *SettlementBatchUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SettlementBatchUpdate) SetStatus(v string) *SettlementBatchUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SettlementBatchUpdate) SetNillableStatus(v *string) *SettlementBatchUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetFeeRate sets the "fee_rate" field.
+func (_u *SettlementBatchUpdate) SetFeeRate(v decimal.Decimal) *SettlementBatchUpdate {
+	_u.mutation.ResetFeeRate()
+	_u.mutation.SetFeeRate(v)
+	return _u
+}
+
+// SetNillableFeeRate sets the "fee_rate" field if the given value is not nil.
+func (_u *SettlementBatchUpdate) SetNillableFeeRate(v *decimal.Decimal) *SettlementBatchUpdate {
+	if v != nil {
+		_u.SetFeeRate(*v)
+	}
+	return _u
+}
+
+// AddFeeRate adds value to the "fee_rate" field.
+func (_u *SettlementBatchUpdate) AddFeeRate(v decimal.Decimal) *SettlementBatchUpdate {
+	_u.mutation.AddFeeRate(v)
+	return _u
+}
+
+// ClearFeeRate clears the value of the "fee_rate" field.
+func (_u *SettlementBatchUpdate) ClearFeeRate() *SettlementBatchUpdate {
+	_u.mutation.ClearFeeRate()
+	return _u
+}
+
+// SetGrossAmount sets the "gross_amount" field.
+func (_u *SettlementBatchUpdate) SetGrossAmount(v decimal.Decimal) *SettlementBatchUpdate {
+	_u.mutation.ResetGrossAmount()
+	_u.mutation.SetGrossAmount(v)
+	return _u
+}
+
+// SetNillableGrossAmount sets the "gross_amount" field if the given value is not nil.
+func (_u *SettlementBatchUpdate) SetNillableGrossAmount(v *decimal.Decimal) *SettlementBatchUpdate {
+	if v != nil {
+		_u.SetGrossAmount(*v)
+	}
+	return _u
+}
+
+// AddGrossAmount adds value to the "gross_amount" field.
+func (_u *SettlementBatchUpdate) AddGrossAmount(v decimal.Decimal) *SettlementBatchUpdate {
+	_u.mutation.AddGrossAmount(v)
+	return _u
+}
+
+// ClearGrossAmount clears the value of the "gross_amount" field.
+func (_u *SettlementBatchUpdate) ClearGrossAmount() *SettlementBatchUpdate {
+	_u.mutation.ClearGrossAmount()
+	return _u
+}
+
+// SetRefundAmount sets the "refund_amount" field.
+func (_u *SettlementBatchUpdate) SetRefundAmount(v decimal.Decimal) *SettlementBatchUpdate {
+	_u.mutation.ResetRefundAmount()
+	_u.mutation.SetRefundAmount(v)
+	return _u
+}
+
+// SetNillableRefundAmount sets the "refund_amount" field if the given value is not nil.
+func (_u *SettlementBatchUpdate) SetNillableRefundAmount(v *decimal.Decimal) *SettlementBatchUpdate {
+	if v != nil {
+		_u.SetRefundAmount(*v)
+	}
+	return _u
+}
+
+// AddRefundAmount adds value to the "refund_amount" field.
+func (_u *SettlementBatchUpdate) AddRefundAmount(v decimal.Decimal) *SettlementBatchUpdate {
+	_u.mutation.AddRefundAmount(v)
+	return _u
+}
+
+// ClearRefundAmount clears the value of the "refund_amount" field.
+func (_u *SettlementBatchUpdate) ClearRefundAmount() *SettlementBatchUpdate {
+	_u.mutation.ClearRefundAmount()
+	return _u
+}
+
+// SetFeeAmount sets the "fee_amount" field.
+func (_u *SettlementBatchUpdate) SetFeeAmount(v decimal.Decimal) *SettlementBatchUpdate {
+	_u.mutation.ResetFeeAmount()
+	_u.mutation.SetFeeAmount(v)
+	return _u
+}
+
+// SetNillableFeeAmount sets the "fee_amount" field if the given value is not nil.
+func (_u *SettlementBatchUpdate) SetNillableFeeAmount(v *decimal.Decimal) *SettlementBatchUpdate {
+	if v != nil {
+		_u.SetFeeAmount(*v)
+	}
+	return _u
+}
+
+// AddFeeAmount adds value to the "fee_amount" field.
+func (_u *SettlementBatchUpdate) AddFeeAmount(v decimal.Decimal) *SettlementBatchUpdate {
+	_u.mutation.AddFeeAmount(v)
+	return _u
+}
+
+// ClearFeeAmount clears the value of the "fee_amount" field.
+func (_u *SettlementBatchUpdate) ClearFeeAmount() *SettlementBatchUpdate {
+	_u.mutation.ClearFeeAmount()
+	return _u
+}
+
+// SetAdjustmentAmount sets the "adjustment_amount" field.
+func (_u *SettlementBatchUpdate) SetAdjustmentAmount(v decimal.Decimal) *SettlementBatchUpdate {
+	_u.mutation.ResetAdjustmentAmount()
+	_u.mutation.SetAdjustmentAmount(v)
+	return _u
+}
+
+// SetNillableAdjustmentAmount sets the "adjustment_amount" field if the given value is not nil.
+func (_u *SettlementBatchUpdate) SetNillableAdjustmentAmount(v *decimal.Decimal) *SettlementBatchUpdate {
+	if v != nil {
+		_u.SetAdjustmentAmount(*v)
+	}
+	return _u
+}
+
+// AddAdjustmentAmount adds value to the "adjustment_amount" field.
+func (_u *SettlementBatchUpdate) AddAdjustmentAmount(v decimal.Decimal) *SettlementBatchUpdate {
+	_u.mutation.AddAdjustmentAmount(v)
+	return _u
+}
+
+// ClearAdjustmentAmount clears the value of the "adjustment_amount" field.
+func (_u *SettlementBatchUpdate) ClearAdjustmentAmount() *SettlementBatchUpdate {
+	_u.mutation.ClearAdjustmentAmount()
+	return _u
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (_u *SettlementBatchUpdate) SetNetAmount(v decimal.Decimal) *SettlementBatchUpdate {
+	_u.mutation.ResetNetAmount()
+	_u.mutation.SetNetAmount(v)
+	return _u
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (_u *SettlementBatchUpdate) SetNillableNetAmount(v *decimal.Decimal) *SettlementBatchUpdate {
+	if v != nil {
+		_u.SetNetAmount(*v)
+	}
+	return _u
+}
+
+// AddNetAmount adds value to the "net_amount" field.
+func (_u *SettlementBatchUpdate) AddNetAmount(v decimal.Decimal) *SettlementBatchUpdate {
+	_u.mutation.AddNetAmount(v)
+	return _u
+}
+
+// ClearNetAmount clears the value of the "net_amount" field.
+func (_u *SettlementBatchUpdate) ClearNetAmount() *SettlementBatchUpdate {
+	_u.mutation.ClearNetAmount()
+	return _u
+}
+
+// SetTransactionCount sets the "transaction_count" field.
+func (_u *SettlementBatchUpdate) SetTransactionCount(v int) *SettlementBatchUpdate {
+	_u.mutation.ResetTransactionCount()
+	_u.mutation.SetTransactionCount(v)
+	return _u
+}
+
+// SetNillableTransactionCount sets the "transaction_count" field if the given value is not nil.
+func (_u *SettlementBatchUpdate) SetNillableTransactionCount(v *int) *SettlementBatchUpdate {
+	if v != nil {
+		_u.SetTransactionCount(*v)
+	}
+	return _u
+}
+
+// AddTransactionCount adds value to the "transaction_count" field.
+func (_u *SettlementBatchUpdate) AddTransactionCount(v int) *SettlementBatchUpdate {
+	_u.mutation.AddTransactionCount(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *SettlementBatchUpdate) SetCreatedBy(v uuid.UUID) *SettlementBatchUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *SettlementBatchUpdate) SetNillableCreatedBy(v *uuid.UUID) *SettlementBatchUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *SettlementBatchUpdate) ClearCreatedBy() *SettlementBatchUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (_u *SettlementBatchUpdate) SetSubmittedBy(v uuid.UUID) *SettlementBatchUpdate {
+	_u.mutation.SetSubmittedBy(v)
+	return _u
+}
+
+// SetNillableSubmittedBy sets the "submitted_by" field if the given value is not nil.
+func (_u *SettlementBatchUpdate) SetNillableSubmittedBy(v *uuid.UUID) *SettlementBatchUpdate {
+	if v != nil {
+		_u.SetSubmittedBy(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedBy clears the value of the "submitted_by" field.
+func (_u *SettlementBatchUpdate) ClearSubmittedBy() *SettlementBatchUpdate {
+	_u.mutation.ClearSubmittedBy()
+	return _u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_u *SettlementBatchUpdate) SetSubmittedAt(v time.Time) *SettlementBatchUpdate {
+	_u.mutation.SetSubmittedAt(v)
+	return _u
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_u *SettlementBatchUpdate) SetNillableSubmittedAt(v *time.Time) *SettlementBatchUpdate {
+	if v != nil {
+		_u.SetSubmittedAt(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (_u *SettlementBatchUpdate) ClearSubmittedAt() *SettlementBatchUpdate {
+	_u.mutation.ClearSubmittedAt()
+	return _u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_u *SettlementBatchUpdate) SetApprovedBy(v uuid.UUID) *SettlementBatchUpdate {
+	_u.mutation.SetApprovedBy(v)
+	return _u
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_u *SettlementBatchUpdate) SetNillableApprovedBy(v *uuid.UUID) *SettlementBatchUpdate {
+	if v != nil {
+		_u.SetApprovedBy(*v)
+	}
+	return _u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (_u *SettlementBatchUpdate) ClearApprovedBy() *SettlementBatchUpdate {
+	_u.mutation.ClearApprovedBy()
+	return _u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_u *SettlementBatchUpdate) SetApprovedAt(v time.Time) *SettlementBatchUpdate {
+	_u.mutation.SetApprovedAt(v)
+	return _u
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_u *SettlementBatchUpdate) SetNillableApprovedAt(v *time.Time) *SettlementBatchUpdate {
+	if v != nil {
+		_u.SetApprovedAt(*v)
+	}
+	return _u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (_u *SettlementBatchUpdate) ClearApprovedAt() *SettlementBatchUpdate {
+	_u.mutation.ClearApprovedAt()
+	return _u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_u *SettlementBatchUpdate) SetRejectionReason(v string) *SettlementBatchUpdate {
+	_u.mutation.SetRejectionReason(v)
+	return _u
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_u *SettlementBatchUpdate) SetNillableRejectionReason(v *string) *SettlementBatchUpdate {
+	if v != nil {
+		_u.SetRejectionReason(*v)
+	}
+	return _u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (_u *SettlementBatchUpdate) ClearRejectionReason() *SettlementBatchUpdate {
+	_u.mutation.ClearRejectionReason()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SettlementBatchUpdate) SetUpdatedAt(v time.Time) *SettlementBatchUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddItemIDs adds the "items" edge to the SettlementItem entity by IDs.
+func (_u *SettlementBatchUpdate) AddItemIDs(ids ...uuid.UUID) *SettlementBatchUpdate {
+	_u.mutation.AddItemIDs(ids...)
+	return _u
+}
+
+// AddItems adds the "items" edges to the SettlementItem entity.
+func (_u *SettlementBatchUpdate) AddItems(v ...*SettlementItem) *SettlementBatchUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemIDs(ids...)
+}
+
+// Mutation returns the SettlementBatchMutation object of the builder.
+func (_u *SettlementBatchUpdate) Mutation() *SettlementBatchMutation {
+	return _u.mutation
+}
+
+// ClearItems clears all "items" edges to the SettlementItem entity.
+func (_u *SettlementBatchUpdate) ClearItems() *SettlementBatchUpdate {
+	_u.mutation.ClearItems()
+	return _u
+}
+
+// RemoveItemIDs removes the "items" edge to SettlementItem entities by IDs.
+func (_u *SettlementBatchUpdate) RemoveItemIDs(ids ...uuid.UUID) *SettlementBatchUpdate {
+	_u.mutation.RemoveItemIDs(ids...)
+	return _u
+}
+
+// RemoveItems removes "items" edges to SettlementItem entities.
+func (_u *SettlementBatchUpdate) RemoveItems(v ...*SettlementItem) *SettlementBatchUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SettlementBatchUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SettlementBatchUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SettlementBatchUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SettlementBatchUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SettlementBatchUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := settlementbatch.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *SettlementBatchUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(settlementbatch.Table, settlementbatch.Columns, sqlgraph.NewFieldSpec(settlementbatch.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(settlementbatch.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(settlementbatch.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FeeRate(); ok {
+		_spec.SetField(settlementbatch.FieldFeeRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFeeRate(); ok {
+		_spec.AddField(settlementbatch.FieldFeeRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.FeeRateCleared() {
+		_spec.ClearField(settlementbatch.FieldFeeRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.GrossAmount(); ok {
+		_spec.SetField(settlementbatch.FieldGrossAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedGrossAmount(); ok {
+		_spec.AddField(settlementbatch.FieldGrossAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.GrossAmountCleared() {
+		_spec.ClearField(settlementbatch.FieldGrossAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.RefundAmount(); ok {
+		_spec.SetField(settlementbatch.FieldRefundAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRefundAmount(); ok {
+		_spec.AddField(settlementbatch.FieldRefundAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.RefundAmountCleared() {
+		_spec.ClearField(settlementbatch.FieldRefundAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.FeeAmount(); ok {
+		_spec.SetField(settlementbatch.FieldFeeAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFeeAmount(); ok {
+		_spec.AddField(settlementbatch.FieldFeeAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.FeeAmountCleared() {
+		_spec.ClearField(settlementbatch.FieldFeeAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.AdjustmentAmount(); ok {
+		_spec.SetField(settlementbatch.FieldAdjustmentAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAdjustmentAmount(); ok {
+		_spec.AddField(settlementbatch.FieldAdjustmentAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.AdjustmentAmountCleared() {
+		_spec.ClearField(settlementbatch.FieldAdjustmentAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.NetAmount(); ok {
+		_spec.SetField(settlementbatch.FieldNetAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedNetAmount(); ok {
+		_spec.AddField(settlementbatch.FieldNetAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.NetAmountCleared() {
+		_spec.ClearField(settlementbatch.FieldNetAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TransactionCount(); ok {
+		_spec.SetField(settlementbatch.FieldTransactionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTransactionCount(); ok {
+		_spec.AddField(settlementbatch.FieldTransactionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(settlementbatch.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(settlementbatch.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.SubmittedBy(); ok {
+		_spec.SetField(settlementbatch.FieldSubmittedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.SubmittedByCleared() {
+		_spec.ClearField(settlementbatch.FieldSubmittedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.SubmittedAt(); ok {
+		_spec.SetField(settlementbatch.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubmittedAtCleared() {
+		_spec.ClearField(settlementbatch.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ApprovedBy(); ok {
+		_spec.SetField(settlementbatch.FieldApprovedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ApprovedByCleared() {
+		_spec.ClearField(settlementbatch.FieldApprovedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ApprovedAt(); ok {
+		_spec.SetField(settlementbatch.FieldApprovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ApprovedAtCleared() {
+		_spec.ClearField(settlementbatch.FieldApprovedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RejectionReason(); ok {
+		_spec.SetField(settlementbatch.FieldRejectionReason, field.TypeString, value)
+	}
+	if _u.mutation.RejectionReasonCleared() {
+		_spec.ClearField(settlementbatch.FieldRejectionReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(settlementbatch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlementbatch.ItemsTable,
+			Columns: []string{settlementbatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlementitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemsIDs(); len(nodes) > 0 && !_u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlementbatch.ItemsTable,
+			Columns: []string{settlementbatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlementitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlementbatch.ItemsTable,
+			Columns: []string{settlementbatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlementitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{settlementbatch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SettlementBatchUpdateOne is the builder for updating a single SettlementBatch entity.
+type SettlementBatchUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SettlementBatchMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *SettlementBatchUpdateOne) SetTenantID(v uuid.UUID) *SettlementBatchUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *SettlementBatchUpdateOne) SetNillableTenantID(v *uuid.UUID) *SettlementBatchUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SettlementBatchUpdateOne) SetStatus(v string) *SettlementBatchUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SettlementBatchUpdateOne) SetNillableStatus(v *string) *SettlementBatchUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetFeeRate sets the "fee_rate" field.
+func (_u *SettlementBatchUpdateOne) SetFeeRate(v decimal.Decimal) *SettlementBatchUpdateOne {
+	_u.mutation.ResetFeeRate()
+	_u.mutation.SetFeeRate(v)
+	return _u
+}
+
+// SetNillableFeeRate sets the "fee_rate" field if the given value is not nil.
+func (_u *SettlementBatchUpdateOne) SetNillableFeeRate(v *decimal.Decimal) *SettlementBatchUpdateOne {
+	if v != nil {
+		_u.SetFeeRate(*v)
+	}
+	return _u
+}
+
+// AddFeeRate adds value to the "fee_rate" field.
+func (_u *SettlementBatchUpdateOne) AddFeeRate(v decimal.Decimal) *SettlementBatchUpdateOne {
+	_u.mutation.AddFeeRate(v)
+	return _u
+}
+
+// ClearFeeRate clears the value of the "fee_rate" field.
+func (_u *SettlementBatchUpdateOne) ClearFeeRate() *SettlementBatchUpdateOne {
+	_u.mutation.ClearFeeRate()
+	return _u
+}
+
+// SetGrossAmount sets the "gross_amount" field.
+func (_u *SettlementBatchUpdateOne) SetGrossAmount(v decimal.Decimal) *SettlementBatchUpdateOne {
+	_u.mutation.ResetGrossAmount()
+	_u.mutation.SetGrossAmount(v)
+	return _u
+}
+
+// SetNillableGrossAmount sets the "gross_amount" field if the given value is not nil.
+func (_u *SettlementBatchUpdateOne) SetNillableGrossAmount(v *decimal.Decimal) *SettlementBatchUpdateOne {
+	if v != nil {
+		_u.SetGrossAmount(*v)
+	}
+	return _u
+}
+
+// AddGrossAmount adds value to the "gross_amount" field.
+func (_u *SettlementBatchUpdateOne) AddGrossAmount(v decimal.Decimal) *SettlementBatchUpdateOne {
+	_u.mutation.AddGrossAmount(v)
+	return _u
+}
+
+// ClearGrossAmount clears the value of the "gross_amount" field.
+func (_u *SettlementBatchUpdateOne) ClearGrossAmount() *SettlementBatchUpdateOne {
+	_u.mutation.ClearGrossAmount()
+	return _u
+}
+
+// SetRefundAmount sets the "refund_amount" field.
+func (_u *SettlementBatchUpdateOne) SetRefundAmount(v decimal.Decimal) *SettlementBatchUpdateOne {
+	_u.mutation.ResetRefundAmount()
+	_u.mutation.SetRefundAmount(v)
+	return _u
+}
+
+// SetNillableRefundAmount sets the "refund_amount" field if the given value is not nil.
+func (_u *SettlementBatchUpdateOne) SetNillableRefundAmount(v *decimal.Decimal) *SettlementBatchUpdateOne {
+	if v != nil {
+		_u.SetRefundAmount(*v)
+	}
+	return _u
+}
+
+// AddRefundAmount adds value to the "refund_amount" field.
+func (_u *SettlementBatchUpdateOne) AddRefundAmount(v decimal.Decimal) *SettlementBatchUpdateOne {
+	_u.mutation.AddRefundAmount(v)
+	return _u
+}
+
+// ClearRefundAmount clears the value of the "refund_amount" field.
+func (_u *SettlementBatchUpdateOne) ClearRefundAmount() *SettlementBatchUpdateOne {
+	_u.mutation.ClearRefundAmount()
+	return _u
+}
+
+// SetFeeAmount sets the "fee_amount" field.
+func (_u *SettlementBatchUpdateOne) SetFeeAmount(v decimal.Decimal) *SettlementBatchUpdateOne {
+	_u.mutation.ResetFeeAmount()
+	_u.mutation.SetFeeAmount(v)
+	return _u
+}
+
+// SetNillableFeeAmount sets the "fee_amount" field if the given value is not nil.
+func (_u *SettlementBatchUpdateOne) SetNillableFeeAmount(v *decimal.Decimal) *SettlementBatchUpdateOne {
+	if v != nil {
+		_u.SetFeeAmount(*v)
+	}
+	return _u
+}
+
+// AddFeeAmount adds value to the "fee_amount" field.
+func (_u *SettlementBatchUpdateOne) AddFeeAmount(v decimal.Decimal) *SettlementBatchUpdateOne {
+	_u.mutation.AddFeeAmount(v)
+	return _u
+}
+
+// ClearFeeAmount clears the value of the "fee_amount" field.
+func (_u *SettlementBatchUpdateOne) ClearFeeAmount() *SettlementBatchUpdateOne {
+	_u.mutation.ClearFeeAmount()
+	return _u
+}
+
+// SetAdjustmentAmount sets the "adjustment_amount" field.
+func (_u *SettlementBatchUpdateOne) SetAdjustmentAmount(v decimal.Decimal) *SettlementBatchUpdateOne {
+	_u.mutation.ResetAdjustmentAmount()
+	_u.mutation.SetAdjustmentAmount(v)
+	return _u
+}
+
+// SetNillableAdjustmentAmount sets the "adjustment_amount" field if the given value is not nil.
+func (_u *SettlementBatchUpdateOne) SetNillableAdjustmentAmount(v *decimal.Decimal) *SettlementBatchUpdateOne {
+	if v != nil {
+		_u.SetAdjustmentAmount(*v)
+	}
+	return _u
+}
+
+// AddAdjustmentAmount adds value to the "adjustment_amount" field.
+func (_u *SettlementBatchUpdateOne) AddAdjustmentAmount(v decimal.Decimal) *SettlementBatchUpdateOne {
+	_u.mutation.AddAdjustmentAmount(v)
+	return _u
+}
+
+// ClearAdjustmentAmount clears the value of the "adjustment_amount" field.
+func (_u *SettlementBatchUpdateOne) ClearAdjustmentAmount() *SettlementBatchUpdateOne {
+	_u.mutation.ClearAdjustmentAmount()
+	return _u
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (_u *SettlementBatchUpdateOne) SetNetAmount(v decimal.Decimal) *SettlementBatchUpdateOne {
+	_u.mutation.ResetNetAmount()
+	_u.mutation.SetNetAmount(v)
+	return _u
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (_u *SettlementBatchUpdateOne) SetNillableNetAmount(v *decimal.Decimal) *SettlementBatchUpdateOne {
+	if v != nil {
+		_u.SetNetAmount(*v)
+	}
+	return _u
+}
+
+// AddNetAmount adds value to the "net_amount" field.
+func (_u *SettlementBatchUpdateOne) AddNetAmount(v decimal.Decimal) *SettlementBatchUpdateOne {
+	_u.mutation.AddNetAmount(v)
+	return _u
+}
+
+// ClearNetAmount clears the value of the "net_amount" field.
+func (_u *SettlementBatchUpdateOne) ClearNetAmount() *SettlementBatchUpdateOne {
+	_u.mutation.ClearNetAmount()
+	return _u
+}
+
+// SetTransactionCount sets the "transaction_count" field.
+func (_u *SettlementBatchUpdateOne) SetTransactionCount(v int) *SettlementBatchUpdateOne {
+	_u.mutation.ResetTransactionCount()
+	_u.mutation.SetTransactionCount(v)
+	return _u
+}
+
+// SetNillableTransactionCount sets the "transaction_count" field if the given value is not nil.
+func (_u *SettlementBatchUpdateOne) SetNillableTransactionCount(v *int) *SettlementBatchUpdateOne {
+	if v != nil {
+		_u.SetTransactionCount(*v)
+	}
+	return _u
+}
+
+// AddTransactionCount adds value to the "transaction_count" field.
+func (_u *SettlementBatchUpdateOne) AddTransactionCount(v int) *SettlementBatchUpdateOne {
+	_u.mutation.AddTransactionCount(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *SettlementBatchUpdateOne) SetCreatedBy(v uuid.UUID) *SettlementBatchUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *SettlementBatchUpdateOne) SetNillableCreatedBy(v *uuid.UUID) *SettlementBatchUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *SettlementBatchUpdateOne) ClearCreatedBy() *SettlementBatchUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (_u *SettlementBatchUpdateOne) SetSubmittedBy(v uuid.UUID) *SettlementBatchUpdateOne {
+	_u.mutation.SetSubmittedBy(v)
+	return _u
+}
+
+// SetNillableSubmittedBy sets the "submitted_by" field if the given value is not nil.
+func (_u *SettlementBatchUpdateOne) SetNillableSubmittedBy(v *uuid.UUID) *SettlementBatchUpdateOne {
+	if v != nil {
+		_u.SetSubmittedBy(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedBy clears the value of the "submitted_by" field.
+func (_u *SettlementBatchUpdateOne) ClearSubmittedBy() *SettlementBatchUpdateOne {
+	_u.mutation.ClearSubmittedBy()
+	return _u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_u *SettlementBatchUpdateOne) SetSubmittedAt(v time.Time) *SettlementBatchUpdateOne {
+	_u.mutation.SetSubmittedAt(v)
+	return _u
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_u *SettlementBatchUpdateOne) SetNillableSubmittedAt(v *time.Time) *SettlementBatchUpdateOne {
+	if v != nil {
+		_u.SetSubmittedAt(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (_u *SettlementBatchUpdateOne) ClearSubmittedAt() *SettlementBatchUpdateOne {
+	_u.mutation.ClearSubmittedAt()
+	return _u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_u *SettlementBatchUpdateOne) SetApprovedBy(v uuid.UUID) *SettlementBatchUpdateOne {
+	_u.mutation.SetApprovedBy(v)
+	return _u
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_u *SettlementBatchUpdateOne) SetNillableApprovedBy(v *uuid.UUID) *SettlementBatchUpdateOne {
+	if v != nil {
+		_u.SetApprovedBy(*v)
+	}
+	return _u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (_u *SettlementBatchUpdateOne) ClearApprovedBy() *SettlementBatchUpdateOne {
+	_u.mutation.ClearApprovedBy()
+	return _u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_u *SettlementBatchUpdateOne) SetApprovedAt(v time.Time) *SettlementBatchUpdateOne {
+	_u.mutation.SetApprovedAt(v)
+	return _u
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_u *SettlementBatchUpdateOne) SetNillableApprovedAt(v *time.Time) *SettlementBatchUpdateOne {
+	if v != nil {
+		_u.SetApprovedAt(*v)
+	}
+	return _u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (_u *SettlementBatchUpdateOne) ClearApprovedAt() *SettlementBatchUpdateOne {
+	_u.mutation.ClearApprovedAt()
+	return _u
+}
+
+// SetRejectionReason sets the "rejection_reason" field.
+func (_u *SettlementBatchUpdateOne) SetRejectionReason(v string) *SettlementBatchUpdateOne {
+	_u.mutation.SetRejectionReason(v)
+	return _u
+}
+
+// SetNillableRejectionReason sets the "rejection_reason" field if the given value is not nil.
+func (_u *SettlementBatchUpdateOne) SetNillableRejectionReason(v *string) *SettlementBatchUpdateOne {
+	if v != nil {
+		_u.SetRejectionReason(*v)
+	}
+	return _u
+}
+
+// ClearRejectionReason clears the value of the "rejection_reason" field.
+func (_u *SettlementBatchUpdateOne) ClearRejectionReason() *SettlementBatchUpdateOne {
+	_u.mutation.ClearRejectionReason()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SettlementBatchUpdateOne) SetUpdatedAt(v time.Time) *SettlementBatchUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddItemIDs adds the "items" edge to the SettlementItem entity by IDs.
+func (_u *SettlementBatchUpdateOne) AddItemIDs(ids ...uuid.UUID) *SettlementBatchUpdateOne {
+	_u.mutation.AddItemIDs(ids...)
+	return _u
+}
+
+// AddItems adds the "items" edges to the SettlementItem entity.
+func (_u *SettlementBatchUpdateOne) AddItems(v ...*SettlementItem) *SettlementBatchUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemIDs(ids...)
+}
+
+// Mutation returns the SettlementBatchMutation object of the builder.
+func (_u *SettlementBatchUpdateOne) Mutation() *SettlementBatchMutation {
+	return _u.mutation
+}
+
+// ClearItems clears all "items" edges to the SettlementItem entity.
+func (_u *SettlementBatchUpdateOne) ClearItems() *SettlementBatchUpdateOne {
+	_u.mutation.ClearItems()
+	return _u
+}
+
+// RemoveItemIDs removes the "items" edge to SettlementItem entities by IDs.
+func (_u *SettlementBatchUpdateOne) RemoveItemIDs(ids ...uuid.UUID) *SettlementBatchUpdateOne {
+	_u.mutation.RemoveItemIDs(ids...)
+	return _u
+}
+
+// RemoveItems removes "items" edges to SettlementItem entities.
+func (_u *SettlementBatchUpdateOne) RemoveItems(v ...*SettlementItem) *SettlementBatchUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemIDs(ids...)
+}
+
+// Where appends a list predicates to the SettlementBatchUpdate builder.
+func (_u *SettlementBatchUpdateOne) Where(ps ...predicate.SettlementBatch) *SettlementBatchUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SettlementBatchUpdateOne) Select(field string, fields ...string) *SettlementBatchUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SettlementBatch entity.
+func (_u *SettlementBatchUpdateOne) Save(ctx context.Context) (*SettlementBatch, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SettlementBatchUpdateOne) SaveX(ctx context.Context) *SettlementBatch {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SettlementBatchUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SettlementBatchUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SettlementBatchUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := settlementbatch.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *SettlementBatchUpdateOne) sqlSave(ctx context.Context) (_node *SettlementBatch, err error) {
+	_spec := sqlgraph.NewUpdateSpec(settlementbatch.Table, settlementbatch.Columns, sqlgraph.NewFieldSpec(settlementbatch.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SettlementBatch.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, settlementbatch.FieldID)
+		for _, f := range fields {
+			if !settlementbatch.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != settlementbatch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(settlementbatch.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(settlementbatch.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FeeRate(); ok {
+		_spec.SetField(settlementbatch.FieldFeeRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFeeRate(); ok {
+		_spec.AddField(settlementbatch.FieldFeeRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.FeeRateCleared() {
+		_spec.ClearField(settlementbatch.FieldFeeRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.GrossAmount(); ok {
+		_spec.SetField(settlementbatch.FieldGrossAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedGrossAmount(); ok {
+		_spec.AddField(settlementbatch.FieldGrossAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.GrossAmountCleared() {
+		_spec.ClearField(settlementbatch.FieldGrossAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.RefundAmount(); ok {
+		_spec.SetField(settlementbatch.FieldRefundAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRefundAmount(); ok {
+		_spec.AddField(settlementbatch.FieldRefundAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.RefundAmountCleared() {
+		_spec.ClearField(settlementbatch.FieldRefundAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.FeeAmount(); ok {
+		_spec.SetField(settlementbatch.FieldFeeAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFeeAmount(); ok {
+		_spec.AddField(settlementbatch.FieldFeeAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.FeeAmountCleared() {
+		_spec.ClearField(settlementbatch.FieldFeeAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.AdjustmentAmount(); ok {
+		_spec.SetField(settlementbatch.FieldAdjustmentAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAdjustmentAmount(); ok {
+		_spec.AddField(settlementbatch.FieldAdjustmentAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.AdjustmentAmountCleared() {
+		_spec.ClearField(settlementbatch.FieldAdjustmentAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.NetAmount(); ok {
+		_spec.SetField(settlementbatch.FieldNetAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedNetAmount(); ok {
+		_spec.AddField(settlementbatch.FieldNetAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.NetAmountCleared() {
+		_spec.ClearField(settlementbatch.FieldNetAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TransactionCount(); ok {
+		_spec.SetField(settlementbatch.FieldTransactionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTransactionCount(); ok {
+		_spec.AddField(settlementbatch.FieldTransactionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(settlementbatch.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(settlementbatch.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.SubmittedBy(); ok {
+		_spec.SetField(settlementbatch.FieldSubmittedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.SubmittedByCleared() {
+		_spec.ClearField(settlementbatch.FieldSubmittedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.SubmittedAt(); ok {
+		_spec.SetField(settlementbatch.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubmittedAtCleared() {
+		_spec.ClearField(settlementbatch.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ApprovedBy(); ok {
+		_spec.SetField(settlementbatch.FieldApprovedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ApprovedByCleared() {
+		_spec.ClearField(settlementbatch.FieldApprovedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ApprovedAt(); ok {
+		_spec.SetField(settlementbatch.FieldApprovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ApprovedAtCleared() {
+		_spec.ClearField(settlementbatch.FieldApprovedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RejectionReason(); ok {
+		_spec.SetField(settlementbatch.FieldRejectionReason, field.TypeString, value)
+	}
+	if _u.mutation.RejectionReasonCleared() {
+		_spec.ClearField(settlementbatch.FieldRejectionReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(settlementbatch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlementbatch.ItemsTable,
+			Columns: []string{settlementbatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlementitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemsIDs(); len(nodes) > 0 && !_u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlementbatch.ItemsTable,
+			Columns: []string{settlementbatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlementitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlementbatch.ItemsTable,
+			Columns: []string{settlementbatch.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlementitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SettlementBatch{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{settlementbatch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/settlementdisbursement"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SettlementDisbursement is the model entity for the SettlementDisbursement schema.
+type SettlementDisbursement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Settlement batch paid out
+	BatchID uuid.UUID `json:"batch_id,omitempty"`
+	// Payout method: mpesa_b2c, mpesa_b2b
+	Method string `json:"method,omitempty"`
+	// Phone number (B2C) or paybill/till number (B2B) paid
+	Party string `json:"party,omitempty"`
+	// AccountReference holds the value of the "account_reference" field.
+	AccountReference string `json:"account_reference,omitempty"`
+	// Amount paid out: the batch's net amount
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Payout request number; a new request is made after a queue timeout or a manual retry
+	Sequence int `json:"sequence,omitempty"`
+	// Idempotency key of the current payout request
+	OriginatorConversationID string `json:"originator_conversation_id,omitempty"`
+	// M-Pesa conversation of the current payout request
+	ConversationID string `json:"conversation_id,omitempty"`
+	// Status: pending, submitted, succeeded, failed
+	Status string `json:"status,omitempty"`
+	// Times the current payout request was sent
+	Attempts int `json:"attempts,omitempty"`
+	// When a pending payout request is sent next
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+	// ResultCode holds the value of the "result_code" field.
+	ResultCode string `json:"result_code,omitempty"`
+	// Last result or error reported for the payout
+	ResultDescription string `json:"result_description,omitempty"`
+	// Reason code of a failed payout
+	FailureReason string `json:"failure_reason,omitempty"`
+	// M-Pesa receipt of a succeeded payout
+	TransactionID string `json:"transaction_id,omitempty"`
+	// Journal posted when the payout succeeded
+	JournalEntryID uuid.UUID `json:"journal_entry_id,omitempty"`
+	// SubmittedAt holds the value of the "submitted_at" field.
+	SubmittedAt time.Time `json:"submitted_at,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt time.Time `json:"completed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SettlementDisbursement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case settlementdisbursement.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case settlementdisbursement.FieldSequence, settlementdisbursement.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case settlementdisbursement.FieldMethod, settlementdisbursement.FieldParty, settlementdisbursement.FieldAccountReference, settlementdisbursement.FieldCurrency, settlementdisbursement.FieldOriginatorConversationID, settlementdisbursement.FieldConversationID, settlementdisbursement.FieldStatus, settlementdisbursement.FieldResultCode, settlementdisbursement.FieldResultDescription, settlementdisbursement.FieldFailureReason, settlementdisbursement.FieldTransactionID:
+			values[i] = new(sql.NullString)
+		case settlementdisbursement.FieldNextAttemptAt, settlementdisbursement.FieldSubmittedAt, settlementdisbursement.FieldCompletedAt, settlementdisbursement.FieldCreatedAt, settlementdisbursement.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case settlementdisbursement.FieldID, settlementdisbursement.FieldTenantID, settlementdisbursement.FieldBatchID, settlementdisbursement.FieldJournalEntryID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SettlementDisbursement fields.
+func (_m *SettlementDisbursement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case settlementdisbursement.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case settlementdisbursement.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case settlementdisbursement.FieldBatchID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_id", values[i])
+			} else if value != nil {
+				_m.BatchID = *value
+			}
+		case settlementdisbursement.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				_m.Method = value.String
+			}
+		case settlementdisbursement.FieldParty:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field party", values[i])
+			} else if value.Valid {
+				_m.Party = value.String
+			}
+		case settlementdisbursement.FieldAccountReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_reference", values[i])
+			} else if value.Valid {
+				_m.AccountReference = value.String
+			}
+		case settlementdisbursement.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case settlementdisbursement.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case settlementdisbursement.FieldSequence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sequence", values[i])
+			} else if value.Valid {
+				_m.Sequence = int(value.Int64)
+			}
+		case settlementdisbursement.FieldOriginatorConversationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field originator_conversation_id", values[i])
+			} else if value.Valid {
+				_m.OriginatorConversationID = value.String
+			}
+		case settlementdisbursement.FieldConversationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conversation_id", values[i])
+			} else if value.Valid {
+				_m.ConversationID = value.String
+			}
+		case settlementdisbursement.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case settlementdisbursement.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case settlementdisbursement.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				_m.NextAttemptAt = value.Time
+			}
+		case settlementdisbursement.FieldResultCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field result_code", values[i])
+			} else if value.Valid {
+				_m.ResultCode = value.String
+			}
+		case settlementdisbursement.FieldResultDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field result_description", values[i])
+			} else if value.Valid {
+				_m.ResultDescription = value.String
+			}
+		case settlementdisbursement.FieldFailureReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_reason", values[i])
+			} else if value.Valid {
+				_m.FailureReason = value.String
+			}
+		case settlementdisbursement.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				_m.TransactionID = value.String
+			}
+		case settlementdisbursement.FieldJournalEntryID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field journal_entry_id", values[i])
+			} else if value != nil {
+				_m.JournalEntryID = *value
+			}
+		case settlementdisbursement.FieldSubmittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_at", values[i])
+			} else if value.Valid {
+				_m.SubmittedAt = value.Time
+			}
+		case settlementdisbursement.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = value.Time
+			}
+		case settlementdisbursement.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case settlementdisbursement.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SettlementDisbursement.
+// This includes values selected through modifiers, order, etc.
+func (_m *SettlementDisbursement) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SettlementDisbursement.
+// Note that you need to call SettlementDisbursement.Unwrap() before calling this method if this SettlementDisbursement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SettlementDisbursement) Update() *SettlementDisbursementUpdateOne {
+	return NewSettlementDisbursementClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SettlementDisbursement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SettlementDisbursement) Unwrap() *SettlementDisbursement {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SettlementDisbursement is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SettlementDisbursement) String() string {
+	var builder strings.Builder
+	builder.WriteString("SettlementDisbursement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("batch_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BatchID))
+	builder.WriteString(", ")
+	builder.WriteString("method=")
+	builder.WriteString(_m.Method)
+	builder.WriteString(", ")
+	builder.WriteString("party=")
+	builder.WriteString(_m.Party)
+	builder.WriteString(", ")
+	builder.WriteString("account_reference=")
+	builder.WriteString(_m.AccountReference)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("sequence=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sequence))
+	builder.WriteString(", ")
+	builder.WriteString("originator_conversation_id=")
+	builder.WriteString(_m.OriginatorConversationID)
+	builder.WriteString(", ")
+	builder.WriteString("conversation_id=")
+	builder.WriteString(_m.ConversationID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("next_attempt_at=")
+	builder.WriteString(_m.NextAttemptAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("result_code=")
+	builder.WriteString(_m.ResultCode)
+	builder.WriteString(", ")
+	builder.WriteString("result_description=")
+	builder.WriteString(_m.ResultDescription)
+	builder.WriteString(", ")
+	builder.WriteString("failure_reason=")
+	builder.WriteString(_m.FailureReason)
+	builder.WriteString(", ")
+	builder.WriteString("transaction_id=")
+	builder.WriteString(_m.TransactionID)
+	builder.WriteString(", ")
+	builder.WriteString("journal_entry_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JournalEntryID))
+	builder.WriteString(", ")
+	builder.WriteString("submitted_at=")
+	builder.WriteString(_m.SubmittedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("completed_at=")
+	builder.WriteString(_m.CompletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SettlementDisbursements is a parsable slice of SettlementDisbursement.
+type SettlementDisbursements []*SettlementDisbursement
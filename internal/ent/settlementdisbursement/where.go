@@ -0,0 +1,1427 @@
+// Code generated by ent, DO NOT EDIT.
+
+package settlementdisbursement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldTenantID, v))
+}
+
+// BatchID applies equality check predicate on the "batch_id" field. It's identical to BatchIDEQ.
+func BatchID(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldBatchID, v))
+}
+
+// Method applies equality check predicate on the "method" field. It's identical to MethodEQ.
+func Method(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldMethod, v))
+}
+
+// Party applies equality check predicate on the "party" field. It's identical to PartyEQ.
+func Party(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldParty, v))
+}
+
+// AccountReference applies equality check predicate on the "account_reference" field. It's identical to AccountReferenceEQ.
+func AccountReference(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldAccountReference, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldAmount, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Sequence applies equality check predicate on the "sequence" field. It's identical to SequenceEQ.
+func Sequence(v int) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldSequence, v))
+}
+
+// OriginatorConversationID applies equality check predicate on the "originator_conversation_id" field. It's identical to OriginatorConversationIDEQ.
+func OriginatorConversationID(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldOriginatorConversationID, v))
+}
+
+// ConversationID applies equality check predicate on the "conversation_id" field. It's identical to ConversationIDEQ.
+func ConversationID(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldConversationID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldStatus, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldAttempts, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// ResultCode applies equality check predicate on the "result_code" field. It's identical to ResultCodeEQ.
+func ResultCode(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldResultCode, v))
+}
+
+// ResultDescription applies equality check predicate on the "result_description" field. It's identical to ResultDescriptionEQ.
+func ResultDescription(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldResultDescription, v))
+}
+
+// FailureReason applies equality check predicate on the "failure_reason" field. It's identical to FailureReasonEQ.
+func FailureReason(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldFailureReason, v))
+}
+
+// TransactionID applies equality check predicate on the "transaction_id" field. It's identical to TransactionIDEQ.
+func TransactionID(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// JournalEntryID applies equality check predicate on the "journal_entry_id" field. It's identical to JournalEntryIDEQ.
+func JournalEntryID(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// SubmittedAt applies equality check predicate on the "submitted_at" field. It's identical to SubmittedAtEQ.
+func SubmittedAt(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldTenantID, v))
+}
+
+// BatchIDEQ applies the EQ predicate on the "batch_id" field.
+func BatchIDEQ(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldBatchID, v))
+}
+
+// BatchIDNEQ applies the NEQ predicate on the "batch_id" field.
+func BatchIDNEQ(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldBatchID, v))
+}
+
+// BatchIDIn applies the In predicate on the "batch_id" field.
+func BatchIDIn(vs ...uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldBatchID, vs...))
+}
+
+// BatchIDNotIn applies the NotIn predicate on the "batch_id" field.
+func BatchIDNotIn(vs ...uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldBatchID, vs...))
+}
+
+// BatchIDGT applies the GT predicate on the "batch_id" field.
+func BatchIDGT(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldBatchID, v))
+}
+
+// BatchIDGTE applies the GTE predicate on the "batch_id" field.
+func BatchIDGTE(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldBatchID, v))
+}
+
+// BatchIDLT applies the LT predicate on the "batch_id" field.
+func BatchIDLT(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldBatchID, v))
+}
+
+// BatchIDLTE applies the LTE predicate on the "batch_id" field.
+func BatchIDLTE(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldBatchID, v))
+}
+
+// MethodEQ applies the EQ predicate on the "method" field.
+func MethodEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldMethod, v))
+}
+
+// MethodNEQ applies the NEQ predicate on the "method" field.
+func MethodNEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldMethod, v))
+}
+
+// MethodIn applies the In predicate on the "method" field.
+func MethodIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldMethod, vs...))
+}
+
+// MethodNotIn applies the NotIn predicate on the "method" field.
+func MethodNotIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldMethod, vs...))
+}
+
+// MethodGT applies the GT predicate on the "method" field.
+func MethodGT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldMethod, v))
+}
+
+// MethodGTE applies the GTE predicate on the "method" field.
+func MethodGTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldMethod, v))
+}
+
+// MethodLT applies the LT predicate on the "method" field.
+func MethodLT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldMethod, v))
+}
+
+// MethodLTE applies the LTE predicate on the "method" field.
+func MethodLTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldMethod, v))
+}
+
+// MethodContains applies the Contains predicate on the "method" field.
+func MethodContains(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContains(FieldMethod, v))
+}
+
+// MethodHasPrefix applies the HasPrefix predicate on the "method" field.
+func MethodHasPrefix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasPrefix(FieldMethod, v))
+}
+
+// MethodHasSuffix applies the HasSuffix predicate on the "method" field.
+func MethodHasSuffix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasSuffix(FieldMethod, v))
+}
+
+// MethodEqualFold applies the EqualFold predicate on the "method" field.
+func MethodEqualFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEqualFold(FieldMethod, v))
+}
+
+// MethodContainsFold applies the ContainsFold predicate on the "method" field.
+func MethodContainsFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContainsFold(FieldMethod, v))
+}
+
+// PartyEQ applies the EQ predicate on the "party" field.
+func PartyEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldParty, v))
+}
+
+// PartyNEQ applies the NEQ predicate on the "party" field.
+func PartyNEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldParty, v))
+}
+
+// PartyIn applies the In predicate on the "party" field.
+func PartyIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldParty, vs...))
+}
+
+// PartyNotIn applies the NotIn predicate on the "party" field.
+func PartyNotIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldParty, vs...))
+}
+
+// PartyGT applies the GT predicate on the "party" field.
+func PartyGT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldParty, v))
+}
+
+// PartyGTE applies the GTE predicate on the "party" field.
+func PartyGTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldParty, v))
+}
+
+// PartyLT applies the LT predicate on the "party" field.
+func PartyLT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldParty, v))
+}
+
+// PartyLTE applies the LTE predicate on the "party" field.
+func PartyLTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldParty, v))
+}
+
+// PartyContains applies the Contains predicate on the "party" field.
+func PartyContains(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContains(FieldParty, v))
+}
+
+// PartyHasPrefix applies the HasPrefix predicate on the "party" field.
+func PartyHasPrefix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasPrefix(FieldParty, v))
+}
+
+// PartyHasSuffix applies the HasSuffix predicate on the "party" field.
+func PartyHasSuffix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasSuffix(FieldParty, v))
+}
+
+// PartyEqualFold applies the EqualFold predicate on the "party" field.
+func PartyEqualFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEqualFold(FieldParty, v))
+}
+
+// PartyContainsFold applies the ContainsFold predicate on the "party" field.
+func PartyContainsFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContainsFold(FieldParty, v))
+}
+
+// AccountReferenceEQ applies the EQ predicate on the "account_reference" field.
+func AccountReferenceEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldAccountReference, v))
+}
+
+// AccountReferenceNEQ applies the NEQ predicate on the "account_reference" field.
+func AccountReferenceNEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldAccountReference, v))
+}
+
+// AccountReferenceIn applies the In predicate on the "account_reference" field.
+func AccountReferenceIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldAccountReference, vs...))
+}
+
+// AccountReferenceNotIn applies the NotIn predicate on the "account_reference" field.
+func AccountReferenceNotIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldAccountReference, vs...))
+}
+
+// AccountReferenceGT applies the GT predicate on the "account_reference" field.
+func AccountReferenceGT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldAccountReference, v))
+}
+
+// AccountReferenceGTE applies the GTE predicate on the "account_reference" field.
+func AccountReferenceGTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldAccountReference, v))
+}
+
+// AccountReferenceLT applies the LT predicate on the "account_reference" field.
+func AccountReferenceLT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldAccountReference, v))
+}
+
+// AccountReferenceLTE applies the LTE predicate on the "account_reference" field.
+func AccountReferenceLTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldAccountReference, v))
+}
+
+// AccountReferenceContains applies the Contains predicate on the "account_reference" field.
+func AccountReferenceContains(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContains(FieldAccountReference, v))
+}
+
+// AccountReferenceHasPrefix applies the HasPrefix predicate on the "account_reference" field.
+func AccountReferenceHasPrefix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasPrefix(FieldAccountReference, v))
+}
+
+// AccountReferenceHasSuffix applies the HasSuffix predicate on the "account_reference" field.
+func AccountReferenceHasSuffix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasSuffix(FieldAccountReference, v))
+}
+
+// AccountReferenceIsNil applies the IsNil predicate on the "account_reference" field.
+func AccountReferenceIsNil() predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIsNull(FieldAccountReference))
+}
+
+// AccountReferenceNotNil applies the NotNil predicate on the "account_reference" field.
+func AccountReferenceNotNil() predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotNull(FieldAccountReference))
+}
+
+// AccountReferenceEqualFold applies the EqualFold predicate on the "account_reference" field.
+func AccountReferenceEqualFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEqualFold(FieldAccountReference, v))
+}
+
+// AccountReferenceContainsFold applies the ContainsFold predicate on the "account_reference" field.
+func AccountReferenceContainsFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContainsFold(FieldAccountReference, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldAmount, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// SequenceEQ applies the EQ predicate on the "sequence" field.
+func SequenceEQ(v int) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldSequence, v))
+}
+
+// SequenceNEQ applies the NEQ predicate on the "sequence" field.
+func SequenceNEQ(v int) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldSequence, v))
+}
+
+// SequenceIn applies the In predicate on the "sequence" field.
+func SequenceIn(vs ...int) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldSequence, vs...))
+}
+
+// SequenceNotIn applies the NotIn predicate on the "sequence" field.
+func SequenceNotIn(vs ...int) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldSequence, vs...))
+}
+
+// SequenceGT applies the GT predicate on the "sequence" field.
+func SequenceGT(v int) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldSequence, v))
+}
+
+// SequenceGTE applies the GTE predicate on the "sequence" field.
+func SequenceGTE(v int) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldSequence, v))
+}
+
+// SequenceLT applies the LT predicate on the "sequence" field.
+func SequenceLT(v int) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldSequence, v))
+}
+
+// SequenceLTE applies the LTE predicate on the "sequence" field.
+func SequenceLTE(v int) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldSequence, v))
+}
+
+// OriginatorConversationIDEQ applies the EQ predicate on the "originator_conversation_id" field.
+func OriginatorConversationIDEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldOriginatorConversationID, v))
+}
+
+// OriginatorConversationIDNEQ applies the NEQ predicate on the "originator_conversation_id" field.
+func OriginatorConversationIDNEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldOriginatorConversationID, v))
+}
+
+// OriginatorConversationIDIn applies the In predicate on the "originator_conversation_id" field.
+func OriginatorConversationIDIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldOriginatorConversationID, vs...))
+}
+
+// OriginatorConversationIDNotIn applies the NotIn predicate on the "originator_conversation_id" field.
+func OriginatorConversationIDNotIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldOriginatorConversationID, vs...))
+}
+
+// OriginatorConversationIDGT applies the GT predicate on the "originator_conversation_id" field.
+func OriginatorConversationIDGT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldOriginatorConversationID, v))
+}
+
+// OriginatorConversationIDGTE applies the GTE predicate on the "originator_conversation_id" field.
+func OriginatorConversationIDGTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldOriginatorConversationID, v))
+}
+
+// OriginatorConversationIDLT applies the LT predicate on the "originator_conversation_id" field.
+func OriginatorConversationIDLT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldOriginatorConversationID, v))
+}
+
+// OriginatorConversationIDLTE applies the LTE predicate on the "originator_conversation_id" field.
+func OriginatorConversationIDLTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldOriginatorConversationID, v))
+}
+
+// OriginatorConversationIDContains applies the Contains predicate on the "originator_conversation_id" field.
+func OriginatorConversationIDContains(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContains(FieldOriginatorConversationID, v))
+}
+
+// OriginatorConversationIDHasPrefix applies the HasPrefix predicate on the "originator_conversation_id" field.
+func OriginatorConversationIDHasPrefix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasPrefix(FieldOriginatorConversationID, v))
+}
+
+// OriginatorConversationIDHasSuffix applies the HasSuffix predicate on the "originator_conversation_id" field.
+func OriginatorConversationIDHasSuffix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasSuffix(FieldOriginatorConversationID, v))
+}
+
+// OriginatorConversationIDEqualFold applies the EqualFold predicate on the "originator_conversation_id" field.
+func OriginatorConversationIDEqualFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEqualFold(FieldOriginatorConversationID, v))
+}
+
+// OriginatorConversationIDContainsFold applies the ContainsFold predicate on the "originator_conversation_id" field.
+func OriginatorConversationIDContainsFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContainsFold(FieldOriginatorConversationID, v))
+}
+
+// ConversationIDEQ applies the EQ predicate on the "conversation_id" field.
+func ConversationIDEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldConversationID, v))
+}
+
+// ConversationIDNEQ applies the NEQ predicate on the "conversation_id" field.
+func ConversationIDNEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldConversationID, v))
+}
+
+// ConversationIDIn applies the In predicate on the "conversation_id" field.
+func ConversationIDIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldConversationID, vs...))
+}
+
+// ConversationIDNotIn applies the NotIn predicate on the "conversation_id" field.
+func ConversationIDNotIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldConversationID, vs...))
+}
+
+// ConversationIDGT applies the GT predicate on the "conversation_id" field.
+func ConversationIDGT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldConversationID, v))
+}
+
+// ConversationIDGTE applies the GTE predicate on the "conversation_id" field.
+func ConversationIDGTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldConversationID, v))
+}
+
+// ConversationIDLT applies the LT predicate on the "conversation_id" field.
+func ConversationIDLT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldConversationID, v))
+}
+
+// ConversationIDLTE applies the LTE predicate on the "conversation_id" field.
+func ConversationIDLTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldConversationID, v))
+}
+
+// ConversationIDContains applies the Contains predicate on the "conversation_id" field.
+func ConversationIDContains(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContains(FieldConversationID, v))
+}
+
+// ConversationIDHasPrefix applies the HasPrefix predicate on the "conversation_id" field.
+func ConversationIDHasPrefix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasPrefix(FieldConversationID, v))
+}
+
+// ConversationIDHasSuffix applies the HasSuffix predicate on the "conversation_id" field.
+func ConversationIDHasSuffix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasSuffix(FieldConversationID, v))
+}
+
+// ConversationIDIsNil applies the IsNil predicate on the "conversation_id" field.
+func ConversationIDIsNil() predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIsNull(FieldConversationID))
+}
+
+// ConversationIDNotNil applies the NotNil predicate on the "conversation_id" field.
+func ConversationIDNotNil() predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotNull(FieldConversationID))
+}
+
+// ConversationIDEqualFold applies the EqualFold predicate on the "conversation_id" field.
+func ConversationIDEqualFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEqualFold(FieldConversationID, v))
+}
+
+// ConversationIDContainsFold applies the ContainsFold predicate on the "conversation_id" field.
+func ConversationIDContainsFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContainsFold(FieldConversationID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldAttempts, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// ResultCodeEQ applies the EQ predicate on the "result_code" field.
+func ResultCodeEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldResultCode, v))
+}
+
+// ResultCodeNEQ applies the NEQ predicate on the "result_code" field.
+func ResultCodeNEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldResultCode, v))
+}
+
+// ResultCodeIn applies the In predicate on the "result_code" field.
+func ResultCodeIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldResultCode, vs...))
+}
+
+// ResultCodeNotIn applies the NotIn predicate on the "result_code" field.
+func ResultCodeNotIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldResultCode, vs...))
+}
+
+// ResultCodeGT applies the GT predicate on the "result_code" field.
+func ResultCodeGT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldResultCode, v))
+}
+
+// ResultCodeGTE applies the GTE predicate on the "result_code" field.
+func ResultCodeGTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldResultCode, v))
+}
+
+// ResultCodeLT applies the LT predicate on the "result_code" field.
+func ResultCodeLT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldResultCode, v))
+}
+
+// ResultCodeLTE applies the LTE predicate on the "result_code" field.
+func ResultCodeLTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldResultCode, v))
+}
+
+// ResultCodeContains applies the Contains predicate on the "result_code" field.
+func ResultCodeContains(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContains(FieldResultCode, v))
+}
+
+// ResultCodeHasPrefix applies the HasPrefix predicate on the "result_code" field.
+func ResultCodeHasPrefix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasPrefix(FieldResultCode, v))
+}
+
+// ResultCodeHasSuffix applies the HasSuffix predicate on the "result_code" field.
+func ResultCodeHasSuffix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasSuffix(FieldResultCode, v))
+}
+
+// ResultCodeIsNil applies the IsNil predicate on the "result_code" field.
+func ResultCodeIsNil() predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIsNull(FieldResultCode))
+}
+
+// ResultCodeNotNil applies the NotNil predicate on the "result_code" field.
+func ResultCodeNotNil() predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotNull(FieldResultCode))
+}
+
+// ResultCodeEqualFold applies the EqualFold predicate on the "result_code" field.
+func ResultCodeEqualFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEqualFold(FieldResultCode, v))
+}
+
+// ResultCodeContainsFold applies the ContainsFold predicate on the "result_code" field.
+func ResultCodeContainsFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContainsFold(FieldResultCode, v))
+}
+
+// ResultDescriptionEQ applies the EQ predicate on the "result_description" field.
+func ResultDescriptionEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldResultDescription, v))
+}
+
+// ResultDescriptionNEQ applies the NEQ predicate on the "result_description" field.
+func ResultDescriptionNEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldResultDescription, v))
+}
+
+// ResultDescriptionIn applies the In predicate on the "result_description" field.
+func ResultDescriptionIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldResultDescription, vs...))
+}
+
+// ResultDescriptionNotIn applies the NotIn predicate on the "result_description" field.
+func ResultDescriptionNotIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldResultDescription, vs...))
+}
+
+// ResultDescriptionGT applies the GT predicate on the "result_description" field.
+func ResultDescriptionGT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldResultDescription, v))
+}
+
+// ResultDescriptionGTE applies the GTE predicate on the "result_description" field.
+func ResultDescriptionGTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldResultDescription, v))
+}
+
+// ResultDescriptionLT applies the LT predicate on the "result_description" field.
+func ResultDescriptionLT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldResultDescription, v))
+}
+
+// ResultDescriptionLTE applies the LTE predicate on the "result_description" field.
+func ResultDescriptionLTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldResultDescription, v))
+}
+
+// ResultDescriptionContains applies the Contains predicate on the "result_description" field.
+func ResultDescriptionContains(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContains(FieldResultDescription, v))
+}
+
+// ResultDescriptionHasPrefix applies the HasPrefix predicate on the "result_description" field.
+func ResultDescriptionHasPrefix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasPrefix(FieldResultDescription, v))
+}
+
+// ResultDescriptionHasSuffix applies the HasSuffix predicate on the "result_description" field.
+func ResultDescriptionHasSuffix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasSuffix(FieldResultDescription, v))
+}
+
+// ResultDescriptionIsNil applies the IsNil predicate on the "result_description" field.
+func ResultDescriptionIsNil() predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIsNull(FieldResultDescription))
+}
+
+// ResultDescriptionNotNil applies the NotNil predicate on the "result_description" field.
+func ResultDescriptionNotNil() predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotNull(FieldResultDescription))
+}
+
+// ResultDescriptionEqualFold applies the EqualFold predicate on the "result_description" field.
+func ResultDescriptionEqualFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEqualFold(FieldResultDescription, v))
+}
+
+// ResultDescriptionContainsFold applies the ContainsFold predicate on the "result_description" field.
+func ResultDescriptionContainsFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContainsFold(FieldResultDescription, v))
+}
+
+// FailureReasonEQ applies the EQ predicate on the "failure_reason" field.
+func FailureReasonEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldFailureReason, v))
+}
+
+// FailureReasonNEQ applies the NEQ predicate on the "failure_reason" field.
+func FailureReasonNEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldFailureReason, v))
+}
+
+// FailureReasonIn applies the In predicate on the "failure_reason" field.
+func FailureReasonIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldFailureReason, vs...))
+}
+
+// FailureReasonNotIn applies the NotIn predicate on the "failure_reason" field.
+func FailureReasonNotIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldFailureReason, vs...))
+}
+
+// FailureReasonGT applies the GT predicate on the "failure_reason" field.
+func FailureReasonGT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldFailureReason, v))
+}
+
+// FailureReasonGTE applies the GTE predicate on the "failure_reason" field.
+func FailureReasonGTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldFailureReason, v))
+}
+
+// FailureReasonLT applies the LT predicate on the "failure_reason" field.
+func FailureReasonLT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldFailureReason, v))
+}
+
+// FailureReasonLTE applies the LTE predicate on the "failure_reason" field.
+func FailureReasonLTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldFailureReason, v))
+}
+
+// FailureReasonContains applies the Contains predicate on the "failure_reason" field.
+func FailureReasonContains(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContains(FieldFailureReason, v))
+}
+
+// FailureReasonHasPrefix applies the HasPrefix predicate on the "failure_reason" field.
+func FailureReasonHasPrefix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasPrefix(FieldFailureReason, v))
+}
+
+// FailureReasonHasSuffix applies the HasSuffix predicate on the "failure_reason" field.
+func FailureReasonHasSuffix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasSuffix(FieldFailureReason, v))
+}
+
+// FailureReasonIsNil applies the IsNil predicate on the "failure_reason" field.
+func FailureReasonIsNil() predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIsNull(FieldFailureReason))
+}
+
+// FailureReasonNotNil applies the NotNil predicate on the "failure_reason" field.
+func FailureReasonNotNil() predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotNull(FieldFailureReason))
+}
+
+// FailureReasonEqualFold applies the EqualFold predicate on the "failure_reason" field.
+func FailureReasonEqualFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEqualFold(FieldFailureReason, v))
+}
+
+// FailureReasonContainsFold applies the ContainsFold predicate on the "failure_reason" field.
+func FailureReasonContainsFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContainsFold(FieldFailureReason, v))
+}
+
+// TransactionIDEQ applies the EQ predicate on the "transaction_id" field.
+func TransactionIDEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// TransactionIDNEQ applies the NEQ predicate on the "transaction_id" field.
+func TransactionIDNEQ(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldTransactionID, v))
+}
+
+// TransactionIDIn applies the In predicate on the "transaction_id" field.
+func TransactionIDIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDNotIn applies the NotIn predicate on the "transaction_id" field.
+func TransactionIDNotIn(vs ...string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDGT applies the GT predicate on the "transaction_id" field.
+func TransactionIDGT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldTransactionID, v))
+}
+
+// TransactionIDGTE applies the GTE predicate on the "transaction_id" field.
+func TransactionIDGTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldTransactionID, v))
+}
+
+// TransactionIDLT applies the LT predicate on the "transaction_id" field.
+func TransactionIDLT(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldTransactionID, v))
+}
+
+// TransactionIDLTE applies the LTE predicate on the "transaction_id" field.
+func TransactionIDLTE(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldTransactionID, v))
+}
+
+// TransactionIDContains applies the Contains predicate on the "transaction_id" field.
+func TransactionIDContains(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContains(FieldTransactionID, v))
+}
+
+// TransactionIDHasPrefix applies the HasPrefix predicate on the "transaction_id" field.
+func TransactionIDHasPrefix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasPrefix(FieldTransactionID, v))
+}
+
+// TransactionIDHasSuffix applies the HasSuffix predicate on the "transaction_id" field.
+func TransactionIDHasSuffix(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldHasSuffix(FieldTransactionID, v))
+}
+
+// TransactionIDIsNil applies the IsNil predicate on the "transaction_id" field.
+func TransactionIDIsNil() predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIsNull(FieldTransactionID))
+}
+
+// TransactionIDNotNil applies the NotNil predicate on the "transaction_id" field.
+func TransactionIDNotNil() predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotNull(FieldTransactionID))
+}
+
+// TransactionIDEqualFold applies the EqualFold predicate on the "transaction_id" field.
+func TransactionIDEqualFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEqualFold(FieldTransactionID, v))
+}
+
+// TransactionIDContainsFold applies the ContainsFold predicate on the "transaction_id" field.
+func TransactionIDContainsFold(v string) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldContainsFold(FieldTransactionID, v))
+}
+
+// JournalEntryIDEQ applies the EQ predicate on the "journal_entry_id" field.
+func JournalEntryIDEQ(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDNEQ applies the NEQ predicate on the "journal_entry_id" field.
+func JournalEntryIDNEQ(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIn applies the In predicate on the "journal_entry_id" field.
+func JournalEntryIDIn(vs ...uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDNotIn applies the NotIn predicate on the "journal_entry_id" field.
+func JournalEntryIDNotIn(vs ...uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDGT applies the GT predicate on the "journal_entry_id" field.
+func JournalEntryIDGT(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDGTE applies the GTE predicate on the "journal_entry_id" field.
+func JournalEntryIDGTE(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDLT applies the LT predicate on the "journal_entry_id" field.
+func JournalEntryIDLT(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDLTE applies the LTE predicate on the "journal_entry_id" field.
+func JournalEntryIDLTE(v uuid.UUID) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIsNil applies the IsNil predicate on the "journal_entry_id" field.
+func JournalEntryIDIsNil() predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIsNull(FieldJournalEntryID))
+}
+
+// JournalEntryIDNotNil applies the NotNil predicate on the "journal_entry_id" field.
+func JournalEntryIDNotNil() predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotNull(FieldJournalEntryID))
+}
+
+// SubmittedAtEQ applies the EQ predicate on the "submitted_at" field.
+func SubmittedAtEQ(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtNEQ applies the NEQ predicate on the "submitted_at" field.
+func SubmittedAtNEQ(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIn applies the In predicate on the "submitted_at" field.
+func SubmittedAtIn(vs ...time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtNotIn applies the NotIn predicate on the "submitted_at" field.
+func SubmittedAtNotIn(vs ...time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtGT applies the GT predicate on the "submitted_at" field.
+func SubmittedAtGT(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtGTE applies the GTE predicate on the "submitted_at" field.
+func SubmittedAtGTE(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLT applies the LT predicate on the "submitted_at" field.
+func SubmittedAtLT(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLTE applies the LTE predicate on the "submitted_at" field.
+func SubmittedAtLTE(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIsNil applies the IsNil predicate on the "submitted_at" field.
+func SubmittedAtIsNil() predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIsNull(FieldSubmittedAt))
+}
+
+// SubmittedAtNotNil applies the NotNil predicate on the "submitted_at" field.
+func SubmittedAtNotNil() predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotNull(FieldSubmittedAt))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SettlementDisbursement) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SettlementDisbursement) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SettlementDisbursement) predicate.SettlementDisbursement {
+	return predicate.SettlementDisbursement(sql.NotPredicates(p))
+}
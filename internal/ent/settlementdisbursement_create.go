@@ -0,0 +1,1719 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/settlementdisbursement"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SettlementDisbursementCreate is the builder for creating a SettlementDisbursement entity.
+type SettlementDisbursementCreate struct {
+	config
+	mutation *SettlementDisbursementMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *SettlementDisbursementCreate) SetTenantID(v uuid.UUID) *SettlementDisbursementCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetBatchID sets the "batch_id" field.
+func (_c *SettlementDisbursementCreate) SetBatchID(v uuid.UUID) *SettlementDisbursementCreate {
+	_c.mutation.SetBatchID(v)
+	return _c
+}
+
+// SetMethod sets the "method" field.
+func (_c *SettlementDisbursementCreate) SetMethod(v string) *SettlementDisbursementCreate {
+	_c.mutation.SetMethod(v)
+	return _c
+}
+
+// SetParty sets the "party" field.
+func (_c *SettlementDisbursementCreate) SetParty(v string) *SettlementDisbursementCreate {
+	_c.mutation.SetParty(v)
+	return _c
+}
+
+// SetAccountReference sets the "account_reference" field.
+func (_c *SettlementDisbursementCreate) SetAccountReference(v string) *SettlementDisbursementCreate {
+	_c.mutation.SetAccountReference(v)
+	return _c
+}
+
+// SetNillableAccountReference sets the "account_reference" field if the given value is not nil.
+func (_c *SettlementDisbursementCreate) SetNillableAccountReference(v *string) *SettlementDisbursementCreate {
+	if v != nil {
+		_c.SetAccountReference(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *SettlementDisbursementCreate) SetAmount(v decimal.Decimal) *SettlementDisbursementCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *SettlementDisbursementCreate) SetCurrency(v string) *SettlementDisbursementCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *SettlementDisbursementCreate) SetNillableCurrency(v *string) *SettlementDisbursementCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetSequence sets the "sequence" field.
+func (_c *SettlementDisbursementCreate) SetSequence(v int) *SettlementDisbursementCreate {
+	_c.mutation.SetSequence(v)
+	return _c
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (_c *SettlementDisbursementCreate) SetNillableSequence(v *int) *SettlementDisbursementCreate {
+	if v != nil {
+		_c.SetSequence(*v)
+	}
+	return _c
+}
+
+// SetOriginatorConversationID sets the "originator_conversation_id" field.
+func (_c *SettlementDisbursementCreate) SetOriginatorConversationID(v string) *SettlementDisbursementCreate {
+	_c.mutation.SetOriginatorConversationID(v)
+	return _c
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_c *SettlementDisbursementCreate) SetConversationID(v string) *SettlementDisbursementCreate {
+	_c.mutation.SetConversationID(v)
+	return _c
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (_c *SettlementDisbursementCreate) SetNillableConversationID(v *string) *SettlementDisbursementCreate {
+	if v != nil {
+		_c.SetConversationID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *SettlementDisbursementCreate) SetStatus(v string) *SettlementDisbursementCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *SettlementDisbursementCreate) SetNillableStatus(v *string) *SettlementDisbursementCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *SettlementDisbursementCreate) SetAttempts(v int) *SettlementDisbursementCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *SettlementDisbursementCreate) SetNillableAttempts(v *int) *SettlementDisbursementCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *SettlementDisbursementCreate) SetNextAttemptAt(v time.Time) *SettlementDisbursementCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *SettlementDisbursementCreate) SetNillableNextAttemptAt(v *time.Time) *SettlementDisbursementCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetResultCode sets the "result_code" field.
+func (_c *SettlementDisbursementCreate) SetResultCode(v string) *SettlementDisbursementCreate {
+	_c.mutation.SetResultCode(v)
+	return _c
+}
+
+// SetNillableResultCode sets the "result_code" field if the given value is not nil.
+func (_c *SettlementDisbursementCreate) SetNillableResultCode(v *string) *SettlementDisbursementCreate {
+	if v != nil {
+		_c.SetResultCode(*v)
+	}
+	return _c
+}
+
+// SetResultDescription sets the "result_description" field.
+func (_c *SettlementDisbursementCreate) SetResultDescription(v string) *SettlementDisbursementCreate {
+	_c.mutation.SetResultDescription(v)
+	return _c
+}
+
+// SetNillableResultDescription sets the "result_description" field if the given value is not nil.
+func (_c *SettlementDisbursementCreate) SetNillableResultDescription(v *string) *SettlementDisbursementCreate {
+	if v != nil {
+		_c.SetResultDescription(*v)
+	}
+	return _c
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_c *SettlementDisbursementCreate) SetFailureReason(v string) *SettlementDisbursementCreate {
+	_c.mutation.SetFailureReason(v)
+	return _c
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_c *SettlementDisbursementCreate) SetNillableFailureReason(v *string) *SettlementDisbursementCreate {
+	if v != nil {
+		_c.SetFailureReason(*v)
+	}
+	return _c
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_c *SettlementDisbursementCreate) SetTransactionID(v string) *SettlementDisbursementCreate {
+	_c.mutation.SetTransactionID(v)
+	return _c
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_c *SettlementDisbursementCreate) SetNillableTransactionID(v *string) *SettlementDisbursementCreate {
+	if v != nil {
+		_c.SetTransactionID(*v)
+	}
+	return _c
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_c *SettlementDisbursementCreate) SetJournalEntryID(v uuid.UUID) *SettlementDisbursementCreate {
+	_c.mutation.SetJournalEntryID(v)
+	return _c
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_c *SettlementDisbursementCreate) SetNillableJournalEntryID(v *uuid.UUID) *SettlementDisbursementCreate {
+	if v != nil {
+		_c.SetJournalEntryID(*v)
+	}
+	return _c
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_c *SettlementDisbursementCreate) SetSubmittedAt(v time.Time) *SettlementDisbursementCreate {
+	_c.mutation.SetSubmittedAt(v)
+	return _c
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_c *SettlementDisbursementCreate) SetNillableSubmittedAt(v *time.Time) *SettlementDisbursementCreate {
+	if v != nil {
+		_c.SetSubmittedAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *SettlementDisbursementCreate) SetCompletedAt(v time.Time) *SettlementDisbursementCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *SettlementDisbursementCreate) SetNillableCompletedAt(v *time.Time) *SettlementDisbursementCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SettlementDisbursementCreate) SetCreatedAt(v time.Time) *SettlementDisbursementCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SettlementDisbursementCreate) SetNillableCreatedAt(v *time.Time) *SettlementDisbursementCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SettlementDisbursementCreate) SetUpdatedAt(v time.Time) *SettlementDisbursementCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SettlementDisbursementCreate) SetNillableUpdatedAt(v *time.Time) *SettlementDisbursementCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SettlementDisbursementCreate) SetID(v uuid.UUID) *SettlementDisbursementCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SettlementDisbursementCreate) SetNillableID(v *uuid.UUID) *SettlementDisbursementCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SettlementDisbursementMutation object of the builder.
+func (_c *SettlementDisbursementCreate) Mutation() *SettlementDisbursementMutation {
+	return _c.mutation
+}
+
+// Save creates the SettlementDisbursement in the database.
+func (_c *SettlementDisbursementCreate) Save(ctx context.Context) (*SettlementDisbursement, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SettlementDisbursementCreate) SaveX(ctx context.Context) *SettlementDisbursement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SettlementDisbursementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SettlementDisbursementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SettlementDisbursementCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := settlementdisbursement.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.Sequence(); !ok {
+		v := settlementdisbursement.DefaultSequence
+		_c.mutation.SetSequence(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := settlementdisbursement.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := settlementdisbursement.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		v := settlementdisbursement.DefaultNextAttemptAt()
+		_c.mutation.SetNextAttemptAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := settlementdisbursement.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := settlementdisbursement.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := settlementdisbursement.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SettlementDisbursementCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "SettlementDisbursement.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.BatchID(); !ok {
+		return &ValidationError{Name: "batch_id", err: errors.New(`ent: missing required field "SettlementDisbursement.batch_id"`)}
+	}
+	if _, ok := _c.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`ent: missing required field "SettlementDisbursement.method"`)}
+	}
+	if _, ok := _c.mutation.Party(); !ok {
+		return &ValidationError{Name: "party", err: errors.New(`ent: missing required field "SettlementDisbursement.party"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "SettlementDisbursement.amount"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "SettlementDisbursement.currency"`)}
+	}
+	if _, ok := _c.mutation.Sequence(); !ok {
+		return &ValidationError{Name: "sequence", err: errors.New(`ent: missing required field "SettlementDisbursement.sequence"`)}
+	}
+	if _, ok := _c.mutation.OriginatorConversationID(); !ok {
+		return &ValidationError{Name: "originator_conversation_id", err: errors.New(`ent: missing required field "SettlementDisbursement.originator_conversation_id"`)}
+	}
+	if v, ok := _c.mutation.OriginatorConversationID(); ok {
+		if err := settlementdisbursement.OriginatorConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "originator_conversation_id", err: fmt.Errorf(`ent: validator failed for field "SettlementDisbursement.originator_conversation_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SettlementDisbursement.status"`)}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "SettlementDisbursement.attempts"`)}
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`ent: missing required field "SettlementDisbursement.next_attempt_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SettlementDisbursement.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SettlementDisbursement.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *SettlementDisbursementCreate) sqlSave(ctx context.Context) (*SettlementDisbursement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SettlementDisbursementCreate) createSpec() (*SettlementDisbursement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SettlementDisbursement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(settlementdisbursement.Table, sqlgraph.NewFieldSpec(settlementdisbursement.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(settlementdisbursement.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.BatchID(); ok {
+		_spec.SetField(settlementdisbursement.FieldBatchID, field.TypeUUID, value)
+		_node.BatchID = value
+	}
+	if value, ok := _c.mutation.Method(); ok {
+		_spec.SetField(settlementdisbursement.FieldMethod, field.TypeString, value)
+		_node.Method = value
+	}
+	if value, ok := _c.mutation.Party(); ok {
+		_spec.SetField(settlementdisbursement.FieldParty, field.TypeString, value)
+		_node.Party = value
+	}
+	if value, ok := _c.mutation.AccountReference(); ok {
+		_spec.SetField(settlementdisbursement.FieldAccountReference, field.TypeString, value)
+		_node.AccountReference = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(settlementdisbursement.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(settlementdisbursement.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Sequence(); ok {
+		_spec.SetField(settlementdisbursement.FieldSequence, field.TypeInt, value)
+		_node.Sequence = value
+	}
+	if value, ok := _c.mutation.OriginatorConversationID(); ok {
+		_spec.SetField(settlementdisbursement.FieldOriginatorConversationID, field.TypeString, value)
+		_node.OriginatorConversationID = value
+	}
+	if value, ok := _c.mutation.ConversationID(); ok {
+		_spec.SetField(settlementdisbursement.FieldConversationID, field.TypeString, value)
+		_node.ConversationID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(settlementdisbursement.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(settlementdisbursement.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(settlementdisbursement.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := _c.mutation.ResultCode(); ok {
+		_spec.SetField(settlementdisbursement.FieldResultCode, field.TypeString, value)
+		_node.ResultCode = value
+	}
+	if value, ok := _c.mutation.ResultDescription(); ok {
+		_spec.SetField(settlementdisbursement.FieldResultDescription, field.TypeString, value)
+		_node.ResultDescription = value
+	}
+	if value, ok := _c.mutation.FailureReason(); ok {
+		_spec.SetField(settlementdisbursement.FieldFailureReason, field.TypeString, value)
+		_node.FailureReason = value
+	}
+	if value, ok := _c.mutation.TransactionID(); ok {
+		_spec.SetField(settlementdisbursement.FieldTransactionID, field.TypeString, value)
+		_node.TransactionID = value
+	}
+	if value, ok := _c.mutation.JournalEntryID(); ok {
+		_spec.SetField(settlementdisbursement.FieldJournalEntryID, field.TypeUUID, value)
+		_node.JournalEntryID = value
+	}
+	if value, ok := _c.mutation.SubmittedAt(); ok {
+		_spec.SetField(settlementdisbursement.FieldSubmittedAt, field.TypeTime, value)
+		_node.SubmittedAt = value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(settlementdisbursement.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(settlementdisbursement.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(settlementdisbursement.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SettlementDisbursement.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SettlementDisbursementUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SettlementDisbursementCreate) OnConflict(opts ...sql.ConflictOption) *SettlementDisbursementUpsertOne {
+	_c.conflict = opts
+	return &SettlementDisbursementUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SettlementDisbursement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SettlementDisbursementCreate) OnConflictColumns(columns ...string) *SettlementDisbursementUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SettlementDisbursementUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SettlementDisbursementUpsertOne is the builder for "upsert"-ing
+	//  one SettlementDisbursement node.
+	SettlementDisbursementUpsertOne struct {
+		create *SettlementDisbursementCreate
+	}
+
+	// SettlementDisbursementUpsert is the "OnConflict" setter.
+	SettlementDisbursementUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SettlementDisbursementUpsert) SetTenantID(v uuid.UUID) *SettlementDisbursementUpsert {
+	u.Set(settlementdisbursement.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsert) UpdateTenantID() *SettlementDisbursementUpsert {
+	u.SetExcluded(settlementdisbursement.FieldTenantID)
+	return u
+}
+
+// SetSequence sets the "sequence" field.
+func (u *SettlementDisbursementUpsert) SetSequence(v int) *SettlementDisbursementUpsert {
+	u.Set(settlementdisbursement.FieldSequence, v)
+	return u
+}
+
+// UpdateSequence sets the "sequence" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsert) UpdateSequence() *SettlementDisbursementUpsert {
+	u.SetExcluded(settlementdisbursement.FieldSequence)
+	return u
+}
+
+// AddSequence adds v to the "sequence" field.
+func (u *SettlementDisbursementUpsert) AddSequence(v int) *SettlementDisbursementUpsert {
+	u.Add(settlementdisbursement.FieldSequence, v)
+	return u
+}
+
+// SetOriginatorConversationID sets the "originator_conversation_id" field.
+func (u *SettlementDisbursementUpsert) SetOriginatorConversationID(v string) *SettlementDisbursementUpsert {
+	u.Set(settlementdisbursement.FieldOriginatorConversationID, v)
+	return u
+}
+
+// UpdateOriginatorConversationID sets the "originator_conversation_id" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsert) UpdateOriginatorConversationID() *SettlementDisbursementUpsert {
+	u.SetExcluded(settlementdisbursement.FieldOriginatorConversationID)
+	return u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *SettlementDisbursementUpsert) SetConversationID(v string) *SettlementDisbursementUpsert {
+	u.Set(settlementdisbursement.FieldConversationID, v)
+	return u
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsert) UpdateConversationID() *SettlementDisbursementUpsert {
+	u.SetExcluded(settlementdisbursement.FieldConversationID)
+	return u
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (u *SettlementDisbursementUpsert) ClearConversationID() *SettlementDisbursementUpsert {
+	u.SetNull(settlementdisbursement.FieldConversationID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *SettlementDisbursementUpsert) SetStatus(v string) *SettlementDisbursementUpsert {
+	u.Set(settlementdisbursement.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsert) UpdateStatus() *SettlementDisbursementUpsert {
+	u.SetExcluded(settlementdisbursement.FieldStatus)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *SettlementDisbursementUpsert) SetAttempts(v int) *SettlementDisbursementUpsert {
+	u.Set(settlementdisbursement.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsert) UpdateAttempts() *SettlementDisbursementUpsert {
+	u.SetExcluded(settlementdisbursement.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *SettlementDisbursementUpsert) AddAttempts(v int) *SettlementDisbursementUpsert {
+	u.Add(settlementdisbursement.FieldAttempts, v)
+	return u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *SettlementDisbursementUpsert) SetNextAttemptAt(v time.Time) *SettlementDisbursementUpsert {
+	u.Set(settlementdisbursement.FieldNextAttemptAt, v)
+	return u
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsert) UpdateNextAttemptAt() *SettlementDisbursementUpsert {
+	u.SetExcluded(settlementdisbursement.FieldNextAttemptAt)
+	return u
+}
+
+// SetResultCode sets the "result_code" field.
+func (u *SettlementDisbursementUpsert) SetResultCode(v string) *SettlementDisbursementUpsert {
+	u.Set(settlementdisbursement.FieldResultCode, v)
+	return u
+}
+
+// UpdateResultCode sets the "result_code" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsert) UpdateResultCode() *SettlementDisbursementUpsert {
+	u.SetExcluded(settlementdisbursement.FieldResultCode)
+	return u
+}
+
+// ClearResultCode clears the value of the "result_code" field.
+func (u *SettlementDisbursementUpsert) ClearResultCode() *SettlementDisbursementUpsert {
+	u.SetNull(settlementdisbursement.FieldResultCode)
+	return u
+}
+
+// SetResultDescription sets the "result_description" field.
+func (u *SettlementDisbursementUpsert) SetResultDescription(v string) *SettlementDisbursementUpsert {
+	u.Set(settlementdisbursement.FieldResultDescription, v)
+	return u
+}
+
+// UpdateResultDescription sets the "result_description" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsert) UpdateResultDescription() *SettlementDisbursementUpsert {
+	u.SetExcluded(settlementdisbursement.FieldResultDescription)
+	return u
+}
+
+// ClearResultDescription clears the value of the "result_description" field.
+func (u *SettlementDisbursementUpsert) ClearResultDescription() *SettlementDisbursementUpsert {
+	u.SetNull(settlementdisbursement.FieldResultDescription)
+	return u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *SettlementDisbursementUpsert) SetFailureReason(v string) *SettlementDisbursementUpsert {
+	u.Set(settlementdisbursement.FieldFailureReason, v)
+	return u
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsert) UpdateFailureReason() *SettlementDisbursementUpsert {
+	u.SetExcluded(settlementdisbursement.FieldFailureReason)
+	return u
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (u *SettlementDisbursementUpsert) ClearFailureReason() *SettlementDisbursementUpsert {
+	u.SetNull(settlementdisbursement.FieldFailureReason)
+	return u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *SettlementDisbursementUpsert) SetTransactionID(v string) *SettlementDisbursementUpsert {
+	u.Set(settlementdisbursement.FieldTransactionID, v)
+	return u
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsert) UpdateTransactionID() *SettlementDisbursementUpsert {
+	u.SetExcluded(settlementdisbursement.FieldTransactionID)
+	return u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *SettlementDisbursementUpsert) ClearTransactionID() *SettlementDisbursementUpsert {
+	u.SetNull(settlementdisbursement.FieldTransactionID)
+	return u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *SettlementDisbursementUpsert) SetJournalEntryID(v uuid.UUID) *SettlementDisbursementUpsert {
+	u.Set(settlementdisbursement.FieldJournalEntryID, v)
+	return u
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsert) UpdateJournalEntryID() *SettlementDisbursementUpsert {
+	u.SetExcluded(settlementdisbursement.FieldJournalEntryID)
+	return u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *SettlementDisbursementUpsert) ClearJournalEntryID() *SettlementDisbursementUpsert {
+	u.SetNull(settlementdisbursement.FieldJournalEntryID)
+	return u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *SettlementDisbursementUpsert) SetSubmittedAt(v time.Time) *SettlementDisbursementUpsert {
+	u.Set(settlementdisbursement.FieldSubmittedAt, v)
+	return u
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsert) UpdateSubmittedAt() *SettlementDisbursementUpsert {
+	u.SetExcluded(settlementdisbursement.FieldSubmittedAt)
+	return u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (u *SettlementDisbursementUpsert) ClearSubmittedAt() *SettlementDisbursementUpsert {
+	u.SetNull(settlementdisbursement.FieldSubmittedAt)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *SettlementDisbursementUpsert) SetCompletedAt(v time.Time) *SettlementDisbursementUpsert {
+	u.Set(settlementdisbursement.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsert) UpdateCompletedAt() *SettlementDisbursementUpsert {
+	u.SetExcluded(settlementdisbursement.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *SettlementDisbursementUpsert) ClearCompletedAt() *SettlementDisbursementUpsert {
+	u.SetNull(settlementdisbursement.FieldCompletedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SettlementDisbursementUpsert) SetUpdatedAt(v time.Time) *SettlementDisbursementUpsert {
+	u.Set(settlementdisbursement.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsert) UpdateUpdatedAt() *SettlementDisbursementUpsert {
+	u.SetExcluded(settlementdisbursement.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SettlementDisbursement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(settlementdisbursement.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SettlementDisbursementUpsertOne) UpdateNewValues() *SettlementDisbursementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(settlementdisbursement.FieldID)
+		}
+		if _, exists := u.create.mutation.BatchID(); exists {
+			s.SetIgnore(settlementdisbursement.FieldBatchID)
+		}
+		if _, exists := u.create.mutation.Method(); exists {
+			s.SetIgnore(settlementdisbursement.FieldMethod)
+		}
+		if _, exists := u.create.mutation.Party(); exists {
+			s.SetIgnore(settlementdisbursement.FieldParty)
+		}
+		if _, exists := u.create.mutation.AccountReference(); exists {
+			s.SetIgnore(settlementdisbursement.FieldAccountReference)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(settlementdisbursement.FieldAmount)
+		}
+		if _, exists := u.create.mutation.Currency(); exists {
+			s.SetIgnore(settlementdisbursement.FieldCurrency)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(settlementdisbursement.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SettlementDisbursement.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SettlementDisbursementUpsertOne) Ignore() *SettlementDisbursementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SettlementDisbursementUpsertOne) DoNothing() *SettlementDisbursementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SettlementDisbursementCreate.OnConflict
+// documentation for more info.
+func (u *SettlementDisbursementUpsertOne) Update(set func(*SettlementDisbursementUpsert)) *SettlementDisbursementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SettlementDisbursementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SettlementDisbursementUpsertOne) SetTenantID(v uuid.UUID) *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertOne) UpdateTenantID() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetSequence sets the "sequence" field.
+func (u *SettlementDisbursementUpsertOne) SetSequence(v int) *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetSequence(v)
+	})
+}
+
+// AddSequence adds v to the "sequence" field.
+func (u *SettlementDisbursementUpsertOne) AddSequence(v int) *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.AddSequence(v)
+	})
+}
+
+// UpdateSequence sets the "sequence" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertOne) UpdateSequence() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateSequence()
+	})
+}
+
+// SetOriginatorConversationID sets the "originator_conversation_id" field.
+func (u *SettlementDisbursementUpsertOne) SetOriginatorConversationID(v string) *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetOriginatorConversationID(v)
+	})
+}
+
+// UpdateOriginatorConversationID sets the "originator_conversation_id" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertOne) UpdateOriginatorConversationID() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateOriginatorConversationID()
+	})
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *SettlementDisbursementUpsertOne) SetConversationID(v string) *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertOne) UpdateConversationID() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (u *SettlementDisbursementUpsertOne) ClearConversationID() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.ClearConversationID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SettlementDisbursementUpsertOne) SetStatus(v string) *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertOne) UpdateStatus() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *SettlementDisbursementUpsertOne) SetAttempts(v int) *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *SettlementDisbursementUpsertOne) AddAttempts(v int) *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertOne) UpdateAttempts() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *SettlementDisbursementUpsertOne) SetNextAttemptAt(v time.Time) *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertOne) UpdateNextAttemptAt() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// SetResultCode sets the "result_code" field.
+func (u *SettlementDisbursementUpsertOne) SetResultCode(v string) *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetResultCode(v)
+	})
+}
+
+// UpdateResultCode sets the "result_code" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertOne) UpdateResultCode() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateResultCode()
+	})
+}
+
+// ClearResultCode clears the value of the "result_code" field.
+func (u *SettlementDisbursementUpsertOne) ClearResultCode() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.ClearResultCode()
+	})
+}
+
+// SetResultDescription sets the "result_description" field.
+func (u *SettlementDisbursementUpsertOne) SetResultDescription(v string) *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetResultDescription(v)
+	})
+}
+
+// UpdateResultDescription sets the "result_description" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertOne) UpdateResultDescription() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateResultDescription()
+	})
+}
+
+// ClearResultDescription clears the value of the "result_description" field.
+func (u *SettlementDisbursementUpsertOne) ClearResultDescription() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.ClearResultDescription()
+	})
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *SettlementDisbursementUpsertOne) SetFailureReason(v string) *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetFailureReason(v)
+	})
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertOne) UpdateFailureReason() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateFailureReason()
+	})
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (u *SettlementDisbursementUpsertOne) ClearFailureReason() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.ClearFailureReason()
+	})
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *SettlementDisbursementUpsertOne) SetTransactionID(v string) *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertOne) UpdateTransactionID() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *SettlementDisbursementUpsertOne) ClearTransactionID() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.ClearTransactionID()
+	})
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *SettlementDisbursementUpsertOne) SetJournalEntryID(v uuid.UUID) *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetJournalEntryID(v)
+	})
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertOne) UpdateJournalEntryID() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateJournalEntryID()
+	})
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *SettlementDisbursementUpsertOne) ClearJournalEntryID() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.ClearJournalEntryID()
+	})
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *SettlementDisbursementUpsertOne) SetSubmittedAt(v time.Time) *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetSubmittedAt(v)
+	})
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertOne) UpdateSubmittedAt() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateSubmittedAt()
+	})
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (u *SettlementDisbursementUpsertOne) ClearSubmittedAt() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.ClearSubmittedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *SettlementDisbursementUpsertOne) SetCompletedAt(v time.Time) *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertOne) UpdateCompletedAt() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *SettlementDisbursementUpsertOne) ClearCompletedAt() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SettlementDisbursementUpsertOne) SetUpdatedAt(v time.Time) *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertOne) UpdateUpdatedAt() *SettlementDisbursementUpsertOne {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SettlementDisbursementUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SettlementDisbursementCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SettlementDisbursementUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SettlementDisbursementUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SettlementDisbursementUpsertOne.ID is not supported by MySQL driver. Use SettlementDisbursementUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SettlementDisbursementUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SettlementDisbursementCreateBulk is the builder for creating many SettlementDisbursement entities in bulk.
+type SettlementDisbursementCreateBulk struct {
+	config
+	err      error
+	builders []*SettlementDisbursementCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SettlementDisbursement entities in the database.
+func (_c *SettlementDisbursementCreateBulk) Save(ctx context.Context) ([]*SettlementDisbursement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SettlementDisbursement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SettlementDisbursementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SettlementDisbursementCreateBulk) SaveX(ctx context.Context) []*SettlementDisbursement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SettlementDisbursementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SettlementDisbursementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SettlementDisbursement.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SettlementDisbursementUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SettlementDisbursementCreateBulk) OnConflict(opts ...sql.ConflictOption) *SettlementDisbursementUpsertBulk {
+	_c.conflict = opts
+	return &SettlementDisbursementUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SettlementDisbursement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SettlementDisbursementCreateBulk) OnConflictColumns(columns ...string) *SettlementDisbursementUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SettlementDisbursementUpsertBulk{
+		create: _c,
+	}
+}
+
+// SettlementDisbursementUpsertBulk is the builder for "upsert"-ing
+// a bulk of SettlementDisbursement nodes.
+type SettlementDisbursementUpsertBulk struct {
+	create *SettlementDisbursementCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SettlementDisbursement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(settlementdisbursement.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SettlementDisbursementUpsertBulk) UpdateNewValues() *SettlementDisbursementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(settlementdisbursement.FieldID)
+			}
+			if _, exists := b.mutation.BatchID(); exists {
+				s.SetIgnore(settlementdisbursement.FieldBatchID)
+			}
+			if _, exists := b.mutation.Method(); exists {
+				s.SetIgnore(settlementdisbursement.FieldMethod)
+			}
+			if _, exists := b.mutation.Party(); exists {
+				s.SetIgnore(settlementdisbursement.FieldParty)
+			}
+			if _, exists := b.mutation.AccountReference(); exists {
+				s.SetIgnore(settlementdisbursement.FieldAccountReference)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(settlementdisbursement.FieldAmount)
+			}
+			if _, exists := b.mutation.Currency(); exists {
+				s.SetIgnore(settlementdisbursement.FieldCurrency)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(settlementdisbursement.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SettlementDisbursement.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SettlementDisbursementUpsertBulk) Ignore() *SettlementDisbursementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SettlementDisbursementUpsertBulk) DoNothing() *SettlementDisbursementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SettlementDisbursementCreateBulk.OnConflict
+// documentation for more info.
+func (u *SettlementDisbursementUpsertBulk) Update(set func(*SettlementDisbursementUpsert)) *SettlementDisbursementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SettlementDisbursementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SettlementDisbursementUpsertBulk) SetTenantID(v uuid.UUID) *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertBulk) UpdateTenantID() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetSequence sets the "sequence" field.
+func (u *SettlementDisbursementUpsertBulk) SetSequence(v int) *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetSequence(v)
+	})
+}
+
+// AddSequence adds v to the "sequence" field.
+func (u *SettlementDisbursementUpsertBulk) AddSequence(v int) *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.AddSequence(v)
+	})
+}
+
+// UpdateSequence sets the "sequence" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertBulk) UpdateSequence() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateSequence()
+	})
+}
+
+// SetOriginatorConversationID sets the "originator_conversation_id" field.
+func (u *SettlementDisbursementUpsertBulk) SetOriginatorConversationID(v string) *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetOriginatorConversationID(v)
+	})
+}
+
+// UpdateOriginatorConversationID sets the "originator_conversation_id" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertBulk) UpdateOriginatorConversationID() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateOriginatorConversationID()
+	})
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *SettlementDisbursementUpsertBulk) SetConversationID(v string) *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertBulk) UpdateConversationID() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (u *SettlementDisbursementUpsertBulk) ClearConversationID() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.ClearConversationID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SettlementDisbursementUpsertBulk) SetStatus(v string) *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertBulk) UpdateStatus() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *SettlementDisbursementUpsertBulk) SetAttempts(v int) *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *SettlementDisbursementUpsertBulk) AddAttempts(v int) *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertBulk) UpdateAttempts() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *SettlementDisbursementUpsertBulk) SetNextAttemptAt(v time.Time) *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertBulk) UpdateNextAttemptAt() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// SetResultCode sets the "result_code" field.
+func (u *SettlementDisbursementUpsertBulk) SetResultCode(v string) *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetResultCode(v)
+	})
+}
+
+// UpdateResultCode sets the "result_code" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertBulk) UpdateResultCode() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateResultCode()
+	})
+}
+
+// ClearResultCode clears the value of the "result_code" field.
+func (u *SettlementDisbursementUpsertBulk) ClearResultCode() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.ClearResultCode()
+	})
+}
+
+// SetResultDescription sets the "result_description" field.
+func (u *SettlementDisbursementUpsertBulk) SetResultDescription(v string) *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetResultDescription(v)
+	})
+}
+
+// UpdateResultDescription sets the "result_description" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertBulk) UpdateResultDescription() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateResultDescription()
+	})
+}
+
+// ClearResultDescription clears the value of the "result_description" field.
+func (u *SettlementDisbursementUpsertBulk) ClearResultDescription() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.ClearResultDescription()
+	})
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *SettlementDisbursementUpsertBulk) SetFailureReason(v string) *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetFailureReason(v)
+	})
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertBulk) UpdateFailureReason() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateFailureReason()
+	})
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (u *SettlementDisbursementUpsertBulk) ClearFailureReason() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.ClearFailureReason()
+	})
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *SettlementDisbursementUpsertBulk) SetTransactionID(v string) *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertBulk) UpdateTransactionID() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *SettlementDisbursementUpsertBulk) ClearTransactionID() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.ClearTransactionID()
+	})
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *SettlementDisbursementUpsertBulk) SetJournalEntryID(v uuid.UUID) *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetJournalEntryID(v)
+	})
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertBulk) UpdateJournalEntryID() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateJournalEntryID()
+	})
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *SettlementDisbursementUpsertBulk) ClearJournalEntryID() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.ClearJournalEntryID()
+	})
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *SettlementDisbursementUpsertBulk) SetSubmittedAt(v time.Time) *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetSubmittedAt(v)
+	})
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertBulk) UpdateSubmittedAt() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateSubmittedAt()
+	})
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (u *SettlementDisbursementUpsertBulk) ClearSubmittedAt() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.ClearSubmittedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *SettlementDisbursementUpsertBulk) SetCompletedAt(v time.Time) *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertBulk) UpdateCompletedAt() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *SettlementDisbursementUpsertBulk) ClearCompletedAt() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SettlementDisbursementUpsertBulk) SetUpdatedAt(v time.Time) *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SettlementDisbursementUpsertBulk) UpdateUpdatedAt() *SettlementDisbursementUpsertBulk {
+	return u.Update(func(s *SettlementDisbursementUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SettlementDisbursementUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SettlementDisbursementCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SettlementDisbursementCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SettlementDisbursementUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/settlementdisbursement"
+)
+
+// SettlementDisbursementDelete is the builder for deleting a SettlementDisbursement entity.
+type SettlementDisbursementDelete struct {
+	config
+	hooks    []Hook
+	mutation *SettlementDisbursementMutation
+}
+
+// Where appends a list predicates to the SettlementDisbursementDelete builder.
+func (_d *SettlementDisbursementDelete) Where(ps ...predicate.SettlementDisbursement) *SettlementDisbursementDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *SettlementDisbursementDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SettlementDisbursementDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *SettlementDisbursementDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(settlementdisbursement.Table, sqlgraph.NewFieldSpec(settlementdisbursement.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// SettlementDisbursementDeleteOne is the builder for deleting a single SettlementDisbursement entity.
+type SettlementDisbursementDeleteOne struct {
+	_d *SettlementDisbursementDelete
+}
+
+// Where appends a list predicates to the SettlementDisbursementDelete builder.
+func (_d *SettlementDisbursementDeleteOne) Where(ps ...predicate.SettlementDisbursement) *SettlementDisbursementDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *SettlementDisbursementDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{settlementdisbursement.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SettlementDisbursementDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
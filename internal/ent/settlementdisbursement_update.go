@@ -0,0 +1,905 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/settlementdisbursement"
+	"github.com/google/uuid"
+)
+
+// SettlementDisbursementUpdate is the builder for updating SettlementDisbursement entities.
+type SettlementDisbursementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SettlementDisbursementMutation
+}
+
+// Where appends a list predicates to the SettlementDisbursementUpdate builder.
+func (_u *SettlementDisbursementUpdate) Where(ps ...predicate.SettlementDisbursement) *SettlementDisbursementUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *SettlementDisbursementUpdate) SetTenantID(v uuid.UUID) *SettlementDisbursementUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdate) SetNillableTenantID(v *uuid.UUID) *SettlementDisbursementUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetSequence sets the "sequence" field.
+func (_u *SettlementDisbursementUpdate) SetSequence(v int) *SettlementDisbursementUpdate {
+	_u.mutation.ResetSequence()
+	_u.mutation.SetSequence(v)
+	return _u
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdate) SetNillableSequence(v *int) *SettlementDisbursementUpdate {
+	if v != nil {
+		_u.SetSequence(*v)
+	}
+	return _u
+}
+
+// AddSequence adds value to the "sequence" field.
+func (_u *SettlementDisbursementUpdate) AddSequence(v int) *SettlementDisbursementUpdate {
+	_u.mutation.AddSequence(v)
+	return _u
+}
+
+// SetOriginatorConversationID sets the "originator_conversation_id" field.
+func (_u *SettlementDisbursementUpdate) SetOriginatorConversationID(v string) *SettlementDisbursementUpdate {
+	_u.mutation.SetOriginatorConversationID(v)
+	return _u
+}
+
+// SetNillableOriginatorConversationID sets the "originator_conversation_id" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdate) SetNillableOriginatorConversationID(v *string) *SettlementDisbursementUpdate {
+	if v != nil {
+		_u.SetOriginatorConversationID(*v)
+	}
+	return _u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_u *SettlementDisbursementUpdate) SetConversationID(v string) *SettlementDisbursementUpdate {
+	_u.mutation.SetConversationID(v)
+	return _u
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdate) SetNillableConversationID(v *string) *SettlementDisbursementUpdate {
+	if v != nil {
+		_u.SetConversationID(*v)
+	}
+	return _u
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (_u *SettlementDisbursementUpdate) ClearConversationID() *SettlementDisbursementUpdate {
+	_u.mutation.ClearConversationID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SettlementDisbursementUpdate) SetStatus(v string) *SettlementDisbursementUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdate) SetNillableStatus(v *string) *SettlementDisbursementUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *SettlementDisbursementUpdate) SetAttempts(v int) *SettlementDisbursementUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdate) SetNillableAttempts(v *int) *SettlementDisbursementUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *SettlementDisbursementUpdate) AddAttempts(v int) *SettlementDisbursementUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *SettlementDisbursementUpdate) SetNextAttemptAt(v time.Time) *SettlementDisbursementUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdate) SetNillableNextAttemptAt(v *time.Time) *SettlementDisbursementUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetResultCode sets the "result_code" field.
+func (_u *SettlementDisbursementUpdate) SetResultCode(v string) *SettlementDisbursementUpdate {
+	_u.mutation.SetResultCode(v)
+	return _u
+}
+
+// SetNillableResultCode sets the "result_code" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdate) SetNillableResultCode(v *string) *SettlementDisbursementUpdate {
+	if v != nil {
+		_u.SetResultCode(*v)
+	}
+	return _u
+}
+
+// ClearResultCode clears the value of the "result_code" field.
+func (_u *SettlementDisbursementUpdate) ClearResultCode() *SettlementDisbursementUpdate {
+	_u.mutation.ClearResultCode()
+	return _u
+}
+
+// SetResultDescription sets the "result_description" field.
+func (_u *SettlementDisbursementUpdate) SetResultDescription(v string) *SettlementDisbursementUpdate {
+	_u.mutation.SetResultDescription(v)
+	return _u
+}
+
+// SetNillableResultDescription sets the "result_description" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdate) SetNillableResultDescription(v *string) *SettlementDisbursementUpdate {
+	if v != nil {
+		_u.SetResultDescription(*v)
+	}
+	return _u
+}
+
+// ClearResultDescription clears the value of the "result_description" field.
+func (_u *SettlementDisbursementUpdate) ClearResultDescription() *SettlementDisbursementUpdate {
+	_u.mutation.ClearResultDescription()
+	return _u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_u *SettlementDisbursementUpdate) SetFailureReason(v string) *SettlementDisbursementUpdate {
+	_u.mutation.SetFailureReason(v)
+	return _u
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdate) SetNillableFailureReason(v *string) *SettlementDisbursementUpdate {
+	if v != nil {
+		_u.SetFailureReason(*v)
+	}
+	return _u
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (_u *SettlementDisbursementUpdate) ClearFailureReason() *SettlementDisbursementUpdate {
+	_u.mutation.ClearFailureReason()
+	return _u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_u *SettlementDisbursementUpdate) SetTransactionID(v string) *SettlementDisbursementUpdate {
+	_u.mutation.SetTransactionID(v)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdate) SetNillableTransactionID(v *string) *SettlementDisbursementUpdate {
+	if v != nil {
+		_u.SetTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (_u *SettlementDisbursementUpdate) ClearTransactionID() *SettlementDisbursementUpdate {
+	_u.mutation.ClearTransactionID()
+	return _u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_u *SettlementDisbursementUpdate) SetJournalEntryID(v uuid.UUID) *SettlementDisbursementUpdate {
+	_u.mutation.SetJournalEntryID(v)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdate) SetNillableJournalEntryID(v *uuid.UUID) *SettlementDisbursementUpdate {
+	if v != nil {
+		_u.SetJournalEntryID(*v)
+	}
+	return _u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (_u *SettlementDisbursementUpdate) ClearJournalEntryID() *SettlementDisbursementUpdate {
+	_u.mutation.ClearJournalEntryID()
+	return _u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_u *SettlementDisbursementUpdate) SetSubmittedAt(v time.Time) *SettlementDisbursementUpdate {
+	_u.mutation.SetSubmittedAt(v)
+	return _u
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdate) SetNillableSubmittedAt(v *time.Time) *SettlementDisbursementUpdate {
+	if v != nil {
+		_u.SetSubmittedAt(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (_u *SettlementDisbursementUpdate) ClearSubmittedAt() *SettlementDisbursementUpdate {
+	_u.mutation.ClearSubmittedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *SettlementDisbursementUpdate) SetCompletedAt(v time.Time) *SettlementDisbursementUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdate) SetNillableCompletedAt(v *time.Time) *SettlementDisbursementUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *SettlementDisbursementUpdate) ClearCompletedAt() *SettlementDisbursementUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SettlementDisbursementUpdate) SetUpdatedAt(v time.Time) *SettlementDisbursementUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SettlementDisbursementMutation object of the builder.
+func (_u *SettlementDisbursementUpdate) Mutation() *SettlementDisbursementMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SettlementDisbursementUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SettlementDisbursementUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SettlementDisbursementUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SettlementDisbursementUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SettlementDisbursementUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := settlementdisbursement.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SettlementDisbursementUpdate) check() error {
+	if v, ok := _u.mutation.OriginatorConversationID(); ok {
+		if err := settlementdisbursement.OriginatorConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "originator_conversation_id", err: fmt.Errorf(`ent: validator failed for field "SettlementDisbursement.originator_conversation_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SettlementDisbursementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(settlementdisbursement.Table, settlementdisbursement.Columns, sqlgraph.NewFieldSpec(settlementdisbursement.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(settlementdisbursement.FieldTenantID, field.TypeUUID, value)
+	}
+	if _u.mutation.AccountReferenceCleared() {
+		_spec.ClearField(settlementdisbursement.FieldAccountReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sequence(); ok {
+		_spec.SetField(settlementdisbursement.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSequence(); ok {
+		_spec.AddField(settlementdisbursement.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OriginatorConversationID(); ok {
+		_spec.SetField(settlementdisbursement.FieldOriginatorConversationID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ConversationID(); ok {
+		_spec.SetField(settlementdisbursement.FieldConversationID, field.TypeString, value)
+	}
+	if _u.mutation.ConversationIDCleared() {
+		_spec.ClearField(settlementdisbursement.FieldConversationID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(settlementdisbursement.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(settlementdisbursement.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(settlementdisbursement.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(settlementdisbursement.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResultCode(); ok {
+		_spec.SetField(settlementdisbursement.FieldResultCode, field.TypeString, value)
+	}
+	if _u.mutation.ResultCodeCleared() {
+		_spec.ClearField(settlementdisbursement.FieldResultCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResultDescription(); ok {
+		_spec.SetField(settlementdisbursement.FieldResultDescription, field.TypeString, value)
+	}
+	if _u.mutation.ResultDescriptionCleared() {
+		_spec.ClearField(settlementdisbursement.FieldResultDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.FailureReason(); ok {
+		_spec.SetField(settlementdisbursement.FieldFailureReason, field.TypeString, value)
+	}
+	if _u.mutation.FailureReasonCleared() {
+		_spec.ClearField(settlementdisbursement.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.TransactionID(); ok {
+		_spec.SetField(settlementdisbursement.FieldTransactionID, field.TypeString, value)
+	}
+	if _u.mutation.TransactionIDCleared() {
+		_spec.ClearField(settlementdisbursement.FieldTransactionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.JournalEntryID(); ok {
+		_spec.SetField(settlementdisbursement.FieldJournalEntryID, field.TypeUUID, value)
+	}
+	if _u.mutation.JournalEntryIDCleared() {
+		_spec.ClearField(settlementdisbursement.FieldJournalEntryID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.SubmittedAt(); ok {
+		_spec.SetField(settlementdisbursement.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubmittedAtCleared() {
+		_spec.ClearField(settlementdisbursement.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(settlementdisbursement.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(settlementdisbursement.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(settlementdisbursement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{settlementdisbursement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SettlementDisbursementUpdateOne is the builder for updating a single SettlementDisbursement entity.
+type SettlementDisbursementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SettlementDisbursementMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *SettlementDisbursementUpdateOne) SetTenantID(v uuid.UUID) *SettlementDisbursementUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdateOne) SetNillableTenantID(v *uuid.UUID) *SettlementDisbursementUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetSequence sets the "sequence" field.
+func (_u *SettlementDisbursementUpdateOne) SetSequence(v int) *SettlementDisbursementUpdateOne {
+	_u.mutation.ResetSequence()
+	_u.mutation.SetSequence(v)
+	return _u
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdateOne) SetNillableSequence(v *int) *SettlementDisbursementUpdateOne {
+	if v != nil {
+		_u.SetSequence(*v)
+	}
+	return _u
+}
+
+// AddSequence adds value to the "sequence" field.
+func (_u *SettlementDisbursementUpdateOne) AddSequence(v int) *SettlementDisbursementUpdateOne {
+	_u.mutation.AddSequence(v)
+	return _u
+}
+
+// SetOriginatorConversationID sets the "originator_conversation_id" field.
+func (_u *SettlementDisbursementUpdateOne) SetOriginatorConversationID(v string) *SettlementDisbursementUpdateOne {
+	_u.mutation.SetOriginatorConversationID(v)
+	return _u
+}
+
+// SetNillableOriginatorConversationID sets the "originator_conversation_id" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdateOne) SetNillableOriginatorConversationID(v *string) *SettlementDisbursementUpdateOne {
+	if v != nil {
+		_u.SetOriginatorConversationID(*v)
+	}
+	return _u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_u *SettlementDisbursementUpdateOne) SetConversationID(v string) *SettlementDisbursementUpdateOne {
+	_u.mutation.SetConversationID(v)
+	return _u
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdateOne) SetNillableConversationID(v *string) *SettlementDisbursementUpdateOne {
+	if v != nil {
+		_u.SetConversationID(*v)
+	}
+	return _u
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (_u *SettlementDisbursementUpdateOne) ClearConversationID() *SettlementDisbursementUpdateOne {
+	_u.mutation.ClearConversationID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SettlementDisbursementUpdateOne) SetStatus(v string) *SettlementDisbursementUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdateOne) SetNillableStatus(v *string) *SettlementDisbursementUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *SettlementDisbursementUpdateOne) SetAttempts(v int) *SettlementDisbursementUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdateOne) SetNillableAttempts(v *int) *SettlementDisbursementUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *SettlementDisbursementUpdateOne) AddAttempts(v int) *SettlementDisbursementUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *SettlementDisbursementUpdateOne) SetNextAttemptAt(v time.Time) *SettlementDisbursementUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdateOne) SetNillableNextAttemptAt(v *time.Time) *SettlementDisbursementUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetResultCode sets the "result_code" field.
+func (_u *SettlementDisbursementUpdateOne) SetResultCode(v string) *SettlementDisbursementUpdateOne {
+	_u.mutation.SetResultCode(v)
+	return _u
+}
+
+// SetNillableResultCode sets the "result_code" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdateOne) SetNillableResultCode(v *string) *SettlementDisbursementUpdateOne {
+	if v != nil {
+		_u.SetResultCode(*v)
+	}
+	return _u
+}
+
+// ClearResultCode clears the value of the "result_code" field.
+func (_u *SettlementDisbursementUpdateOne) ClearResultCode() *SettlementDisbursementUpdateOne {
+	_u.mutation.ClearResultCode()
+	return _u
+}
+
+// SetResultDescription sets the "result_description" field.
+func (_u *SettlementDisbursementUpdateOne) SetResultDescription(v string) *SettlementDisbursementUpdateOne {
+	_u.mutation.SetResultDescription(v)
+	return _u
+}
+
+// SetNillableResultDescription sets the "result_description" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdateOne) SetNillableResultDescription(v *string) *SettlementDisbursementUpdateOne {
+	if v != nil {
+		_u.SetResultDescription(*v)
+	}
+	return _u
+}
+
+// ClearResultDescription clears the value of the "result_description" field.
+func (_u *SettlementDisbursementUpdateOne) ClearResultDescription() *SettlementDisbursementUpdateOne {
+	_u.mutation.ClearResultDescription()
+	return _u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_u *SettlementDisbursementUpdateOne) SetFailureReason(v string) *SettlementDisbursementUpdateOne {
+	_u.mutation.SetFailureReason(v)
+	return _u
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdateOne) SetNillableFailureReason(v *string) *SettlementDisbursementUpdateOne {
+	if v != nil {
+		_u.SetFailureReason(*v)
+	}
+	return _u
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (_u *SettlementDisbursementUpdateOne) ClearFailureReason() *SettlementDisbursementUpdateOne {
+	_u.mutation.ClearFailureReason()
+	return _u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_u *SettlementDisbursementUpdateOne) SetTransactionID(v string) *SettlementDisbursementUpdateOne {
+	_u.mutation.SetTransactionID(v)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdateOne) SetNillableTransactionID(v *string) *SettlementDisbursementUpdateOne {
+	if v != nil {
+		_u.SetTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (_u *SettlementDisbursementUpdateOne) ClearTransactionID() *SettlementDisbursementUpdateOne {
+	_u.mutation.ClearTransactionID()
+	return _u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_u *SettlementDisbursementUpdateOne) SetJournalEntryID(v uuid.UUID) *SettlementDisbursementUpdateOne {
+	_u.mutation.SetJournalEntryID(v)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdateOne) SetNillableJournalEntryID(v *uuid.UUID) *SettlementDisbursementUpdateOne {
+	if v != nil {
+		_u.SetJournalEntryID(*v)
+	}
+	return _u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (_u *SettlementDisbursementUpdateOne) ClearJournalEntryID() *SettlementDisbursementUpdateOne {
+	_u.mutation.ClearJournalEntryID()
+	return _u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_u *SettlementDisbursementUpdateOne) SetSubmittedAt(v time.Time) *SettlementDisbursementUpdateOne {
+	_u.mutation.SetSubmittedAt(v)
+	return _u
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdateOne) SetNillableSubmittedAt(v *time.Time) *SettlementDisbursementUpdateOne {
+	if v != nil {
+		_u.SetSubmittedAt(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (_u *SettlementDisbursementUpdateOne) ClearSubmittedAt() *SettlementDisbursementUpdateOne {
+	_u.mutation.ClearSubmittedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *SettlementDisbursementUpdateOne) SetCompletedAt(v time.Time) *SettlementDisbursementUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *SettlementDisbursementUpdateOne) SetNillableCompletedAt(v *time.Time) *SettlementDisbursementUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *SettlementDisbursementUpdateOne) ClearCompletedAt() *SettlementDisbursementUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SettlementDisbursementUpdateOne) SetUpdatedAt(v time.Time) *SettlementDisbursementUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SettlementDisbursementMutation object of the builder.
+func (_u *SettlementDisbursementUpdateOne) Mutation() *SettlementDisbursementMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SettlementDisbursementUpdate builder.
+func (_u *SettlementDisbursementUpdateOne) Where(ps ...predicate.SettlementDisbursement) *SettlementDisbursementUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SettlementDisbursementUpdateOne) Select(field string, fields ...string) *SettlementDisbursementUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SettlementDisbursement entity.
+func (_u *SettlementDisbursementUpdateOne) Save(ctx context.Context) (*SettlementDisbursement, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SettlementDisbursementUpdateOne) SaveX(ctx context.Context) *SettlementDisbursement {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SettlementDisbursementUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SettlementDisbursementUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SettlementDisbursementUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := settlementdisbursement.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SettlementDisbursementUpdateOne) check() error {
+	if v, ok := _u.mutation.OriginatorConversationID(); ok {
+		if err := settlementdisbursement.OriginatorConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "originator_conversation_id", err: fmt.Errorf(`ent: validator failed for field "SettlementDisbursement.originator_conversation_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SettlementDisbursementUpdateOne) sqlSave(ctx context.Context) (_node *SettlementDisbursement, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(settlementdisbursement.Table, settlementdisbursement.Columns, sqlgraph.NewFieldSpec(settlementdisbursement.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SettlementDisbursement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, settlementdisbursement.FieldID)
+		for _, f := range fields {
+			if !settlementdisbursement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != settlementdisbursement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(settlementdisbursement.FieldTenantID, field.TypeUUID, value)
+	}
+	if _u.mutation.AccountReferenceCleared() {
+		_spec.ClearField(settlementdisbursement.FieldAccountReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sequence(); ok {
+		_spec.SetField(settlementdisbursement.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSequence(); ok {
+		_spec.AddField(settlementdisbursement.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OriginatorConversationID(); ok {
+		_spec.SetField(settlementdisbursement.FieldOriginatorConversationID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ConversationID(); ok {
+		_spec.SetField(settlementdisbursement.FieldConversationID, field.TypeString, value)
+	}
+	if _u.mutation.ConversationIDCleared() {
+		_spec.ClearField(settlementdisbursement.FieldConversationID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(settlementdisbursement.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(settlementdisbursement.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(settlementdisbursement.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(settlementdisbursement.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResultCode(); ok {
+		_spec.SetField(settlementdisbursement.FieldResultCode, field.TypeString, value)
+	}
+	if _u.mutation.ResultCodeCleared() {
+		_spec.ClearField(settlementdisbursement.FieldResultCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResultDescription(); ok {
+		_spec.SetField(settlementdisbursement.FieldResultDescription, field.TypeString, value)
+	}
+	if _u.mutation.ResultDescriptionCleared() {
+		_spec.ClearField(settlementdisbursement.FieldResultDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.FailureReason(); ok {
+		_spec.SetField(settlementdisbursement.FieldFailureReason, field.TypeString, value)
+	}
+	if _u.mutation.FailureReasonCleared() {
+		_spec.ClearField(settlementdisbursement.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.TransactionID(); ok {
+		_spec.SetField(settlementdisbursement.FieldTransactionID, field.TypeString, value)
+	}
+	if _u.mutation.TransactionIDCleared() {
+		_spec.ClearField(settlementdisbursement.FieldTransactionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.JournalEntryID(); ok {
+		_spec.SetField(settlementdisbursement.FieldJournalEntryID, field.TypeUUID, value)
+	}
+	if _u.mutation.JournalEntryIDCleared() {
+		_spec.ClearField(settlementdisbursement.FieldJournalEntryID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.SubmittedAt(); ok {
+		_spec.SetField(settlementdisbursement.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubmittedAtCleared() {
+		_spec.ClearField(settlementdisbursement.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(settlementdisbursement.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(settlementdisbursement.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(settlementdisbursement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &SettlementDisbursement{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{settlementdisbursement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
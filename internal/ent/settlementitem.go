@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/settlementbatch"
+	"github.com/bengobox/treasury-api/internal/ent/settlementitem"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SettlementItem is the model entity for the SettlementItem schema.
+type SettlementItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Settlement batch identifier
+	BatchID uuid.UUID `json:"batch_id,omitempty"`
+	// Item type: payment, refund, chargeback, fee, adjustment
+	ItemType string `json:"item_type,omitempty"`
+	// Payment transaction settled (payment, refund and chargeback items)
+	PaymentTransactionID *uuid.UUID `json:"payment_transaction_id,omitempty"`
+	// ProviderReference holds the value of the "provider_reference" field.
+	ProviderReference string `json:"provider_reference,omitempty"`
+	// When the provider processed the transaction
+	TransactionDate time.Time `json:"transaction_date,omitempty"`
+	// Signed effect on the amount disbursed
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Status: included, removed
+	Status string `json:"status,omitempty"`
+	// User who added the adjustment
+	CreatedBy uuid.UUID `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SettlementItemQuery when eager-loading is set.
+	Edges        SettlementItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SettlementItemEdges holds the relations/edges for other nodes in the graph.
+type SettlementItemEdges struct {
+	// Batch holds the value of the batch edge.
+	Batch *SettlementBatch `json:"batch,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BatchOrErr returns the Batch value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SettlementItemEdges) BatchOrErr() (*SettlementBatch, error) {
+	if e.Batch != nil {
+		return e.Batch, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: settlementbatch.Label}
+	}
+	return nil, &NotLoadedError{edge: "batch"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SettlementItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case settlementitem.FieldPaymentTransactionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case settlementitem.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case settlementitem.FieldItemType, settlementitem.FieldProviderReference, settlementitem.FieldDescription, settlementitem.FieldStatus:
+			values[i] = new(sql.NullString)
+		case settlementitem.FieldTransactionDate, settlementitem.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case settlementitem.FieldID, settlementitem.FieldTenantID, settlementitem.FieldBatchID, settlementitem.FieldCreatedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SettlementItem fields.
+func (_m *SettlementItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case settlementitem.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case settlementitem.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case settlementitem.FieldBatchID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_id", values[i])
+			} else if value != nil {
+				_m.BatchID = *value
+			}
+		case settlementitem.FieldItemType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_type", values[i])
+			} else if value.Valid {
+				_m.ItemType = value.String
+			}
+		case settlementitem.FieldPaymentTransactionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_transaction_id", values[i])
+			} else if value.Valid {
+				_m.PaymentTransactionID = new(uuid.UUID)
+				*_m.PaymentTransactionID = *value.S.(*uuid.UUID)
+			}
+		case settlementitem.FieldProviderReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_reference", values[i])
+			} else if value.Valid {
+				_m.ProviderReference = value.String
+			}
+		case settlementitem.FieldTransactionDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_date", values[i])
+			} else if value.Valid {
+				_m.TransactionDate = value.Time
+			}
+		case settlementitem.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case settlementitem.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case settlementitem.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case settlementitem.FieldCreatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value != nil {
+				_m.CreatedBy = *value
+			}
+		case settlementitem.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SettlementItem.
+// This includes values selected through modifiers, order, etc.
+func (_m *SettlementItem) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBatch queries the "batch" edge of the SettlementItem entity.
+func (_m *SettlementItem) QueryBatch() *SettlementBatchQuery {
+	return NewSettlementItemClient(_m.config).QueryBatch(_m)
+}
+
+// Update returns a builder for updating this SettlementItem.
+// Note that you need to call SettlementItem.Unwrap() before calling this method if this SettlementItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SettlementItem) Update() *SettlementItemUpdateOne {
+	return NewSettlementItemClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SettlementItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SettlementItem) Unwrap() *SettlementItem {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SettlementItem is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SettlementItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("SettlementItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("batch_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BatchID))
+	builder.WriteString(", ")
+	builder.WriteString("item_type=")
+	builder.WriteString(_m.ItemType)
+	builder.WriteString(", ")
+	if v := _m.PaymentTransactionID; v != nil {
+		builder.WriteString("payment_transaction_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("provider_reference=")
+	builder.WriteString(_m.ProviderReference)
+	builder.WriteString(", ")
+	builder.WriteString("transaction_date=")
+	builder.WriteString(_m.TransactionDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SettlementItems is a parsable slice of SettlementItem.
+type SettlementItems []*SettlementItem
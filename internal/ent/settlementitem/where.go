@@ -0,0 +1,721 @@
+// Code generated by ent, DO NOT EDIT.
+
+package settlementitem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldTenantID, v))
+}
+
+// BatchID applies equality check predicate on the "batch_id" field. It's identical to BatchIDEQ.
+func BatchID(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldBatchID, v))
+}
+
+// ItemType applies equality check predicate on the "item_type" field. It's identical to ItemTypeEQ.
+func ItemType(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldItemType, v))
+}
+
+// PaymentTransactionID applies equality check predicate on the "payment_transaction_id" field. It's identical to PaymentTransactionIDEQ.
+func PaymentTransactionID(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldPaymentTransactionID, v))
+}
+
+// ProviderReference applies equality check predicate on the "provider_reference" field. It's identical to ProviderReferenceEQ.
+func ProviderReference(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldProviderReference, v))
+}
+
+// TransactionDate applies equality check predicate on the "transaction_date" field. It's identical to TransactionDateEQ.
+func TransactionDate(v time.Time) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldTransactionDate, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldAmount, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldDescription, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLTE(FieldTenantID, v))
+}
+
+// BatchIDEQ applies the EQ predicate on the "batch_id" field.
+func BatchIDEQ(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldBatchID, v))
+}
+
+// BatchIDNEQ applies the NEQ predicate on the "batch_id" field.
+func BatchIDNEQ(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNEQ(FieldBatchID, v))
+}
+
+// BatchIDIn applies the In predicate on the "batch_id" field.
+func BatchIDIn(vs ...uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldIn(FieldBatchID, vs...))
+}
+
+// BatchIDNotIn applies the NotIn predicate on the "batch_id" field.
+func BatchIDNotIn(vs ...uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNotIn(FieldBatchID, vs...))
+}
+
+// ItemTypeEQ applies the EQ predicate on the "item_type" field.
+func ItemTypeEQ(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldItemType, v))
+}
+
+// ItemTypeNEQ applies the NEQ predicate on the "item_type" field.
+func ItemTypeNEQ(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNEQ(FieldItemType, v))
+}
+
+// ItemTypeIn applies the In predicate on the "item_type" field.
+func ItemTypeIn(vs ...string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldIn(FieldItemType, vs...))
+}
+
+// ItemTypeNotIn applies the NotIn predicate on the "item_type" field.
+func ItemTypeNotIn(vs ...string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNotIn(FieldItemType, vs...))
+}
+
+// ItemTypeGT applies the GT predicate on the "item_type" field.
+func ItemTypeGT(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGT(FieldItemType, v))
+}
+
+// ItemTypeGTE applies the GTE predicate on the "item_type" field.
+func ItemTypeGTE(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGTE(FieldItemType, v))
+}
+
+// ItemTypeLT applies the LT predicate on the "item_type" field.
+func ItemTypeLT(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLT(FieldItemType, v))
+}
+
+// ItemTypeLTE applies the LTE predicate on the "item_type" field.
+func ItemTypeLTE(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLTE(FieldItemType, v))
+}
+
+// ItemTypeContains applies the Contains predicate on the "item_type" field.
+func ItemTypeContains(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldContains(FieldItemType, v))
+}
+
+// ItemTypeHasPrefix applies the HasPrefix predicate on the "item_type" field.
+func ItemTypeHasPrefix(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldHasPrefix(FieldItemType, v))
+}
+
+// ItemTypeHasSuffix applies the HasSuffix predicate on the "item_type" field.
+func ItemTypeHasSuffix(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldHasSuffix(FieldItemType, v))
+}
+
+// ItemTypeEqualFold applies the EqualFold predicate on the "item_type" field.
+func ItemTypeEqualFold(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEqualFold(FieldItemType, v))
+}
+
+// ItemTypeContainsFold applies the ContainsFold predicate on the "item_type" field.
+func ItemTypeContainsFold(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldContainsFold(FieldItemType, v))
+}
+
+// PaymentTransactionIDEQ applies the EQ predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDEQ(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldPaymentTransactionID, v))
+}
+
+// PaymentTransactionIDNEQ applies the NEQ predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDNEQ(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNEQ(FieldPaymentTransactionID, v))
+}
+
+// PaymentTransactionIDIn applies the In predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDIn(vs ...uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldIn(FieldPaymentTransactionID, vs...))
+}
+
+// PaymentTransactionIDNotIn applies the NotIn predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDNotIn(vs ...uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNotIn(FieldPaymentTransactionID, vs...))
+}
+
+// PaymentTransactionIDGT applies the GT predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDGT(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGT(FieldPaymentTransactionID, v))
+}
+
+// PaymentTransactionIDGTE applies the GTE predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDGTE(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGTE(FieldPaymentTransactionID, v))
+}
+
+// PaymentTransactionIDLT applies the LT predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDLT(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLT(FieldPaymentTransactionID, v))
+}
+
+// PaymentTransactionIDLTE applies the LTE predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDLTE(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLTE(FieldPaymentTransactionID, v))
+}
+
+// PaymentTransactionIDIsNil applies the IsNil predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDIsNil() predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldIsNull(FieldPaymentTransactionID))
+}
+
+// PaymentTransactionIDNotNil applies the NotNil predicate on the "payment_transaction_id" field.
+func PaymentTransactionIDNotNil() predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNotNull(FieldPaymentTransactionID))
+}
+
+// ProviderReferenceEQ applies the EQ predicate on the "provider_reference" field.
+func ProviderReferenceEQ(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldProviderReference, v))
+}
+
+// ProviderReferenceNEQ applies the NEQ predicate on the "provider_reference" field.
+func ProviderReferenceNEQ(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNEQ(FieldProviderReference, v))
+}
+
+// ProviderReferenceIn applies the In predicate on the "provider_reference" field.
+func ProviderReferenceIn(vs ...string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldIn(FieldProviderReference, vs...))
+}
+
+// ProviderReferenceNotIn applies the NotIn predicate on the "provider_reference" field.
+func ProviderReferenceNotIn(vs ...string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNotIn(FieldProviderReference, vs...))
+}
+
+// ProviderReferenceGT applies the GT predicate on the "provider_reference" field.
+func ProviderReferenceGT(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGT(FieldProviderReference, v))
+}
+
+// ProviderReferenceGTE applies the GTE predicate on the "provider_reference" field.
+func ProviderReferenceGTE(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGTE(FieldProviderReference, v))
+}
+
+// ProviderReferenceLT applies the LT predicate on the "provider_reference" field.
+func ProviderReferenceLT(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLT(FieldProviderReference, v))
+}
+
+// ProviderReferenceLTE applies the LTE predicate on the "provider_reference" field.
+func ProviderReferenceLTE(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLTE(FieldProviderReference, v))
+}
+
+// ProviderReferenceContains applies the Contains predicate on the "provider_reference" field.
+func ProviderReferenceContains(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldContains(FieldProviderReference, v))
+}
+
+// ProviderReferenceHasPrefix applies the HasPrefix predicate on the "provider_reference" field.
+func ProviderReferenceHasPrefix(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldHasPrefix(FieldProviderReference, v))
+}
+
+// ProviderReferenceHasSuffix applies the HasSuffix predicate on the "provider_reference" field.
+func ProviderReferenceHasSuffix(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldHasSuffix(FieldProviderReference, v))
+}
+
+// ProviderReferenceIsNil applies the IsNil predicate on the "provider_reference" field.
+func ProviderReferenceIsNil() predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldIsNull(FieldProviderReference))
+}
+
+// ProviderReferenceNotNil applies the NotNil predicate on the "provider_reference" field.
+func ProviderReferenceNotNil() predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNotNull(FieldProviderReference))
+}
+
+// ProviderReferenceEqualFold applies the EqualFold predicate on the "provider_reference" field.
+func ProviderReferenceEqualFold(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEqualFold(FieldProviderReference, v))
+}
+
+// ProviderReferenceContainsFold applies the ContainsFold predicate on the "provider_reference" field.
+func ProviderReferenceContainsFold(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldContainsFold(FieldProviderReference, v))
+}
+
+// TransactionDateEQ applies the EQ predicate on the "transaction_date" field.
+func TransactionDateEQ(v time.Time) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldTransactionDate, v))
+}
+
+// TransactionDateNEQ applies the NEQ predicate on the "transaction_date" field.
+func TransactionDateNEQ(v time.Time) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNEQ(FieldTransactionDate, v))
+}
+
+// TransactionDateIn applies the In predicate on the "transaction_date" field.
+func TransactionDateIn(vs ...time.Time) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldIn(FieldTransactionDate, vs...))
+}
+
+// TransactionDateNotIn applies the NotIn predicate on the "transaction_date" field.
+func TransactionDateNotIn(vs ...time.Time) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNotIn(FieldTransactionDate, vs...))
+}
+
+// TransactionDateGT applies the GT predicate on the "transaction_date" field.
+func TransactionDateGT(v time.Time) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGT(FieldTransactionDate, v))
+}
+
+// TransactionDateGTE applies the GTE predicate on the "transaction_date" field.
+func TransactionDateGTE(v time.Time) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGTE(FieldTransactionDate, v))
+}
+
+// TransactionDateLT applies the LT predicate on the "transaction_date" field.
+func TransactionDateLT(v time.Time) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLT(FieldTransactionDate, v))
+}
+
+// TransactionDateLTE applies the LTE predicate on the "transaction_date" field.
+func TransactionDateLTE(v time.Time) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLTE(FieldTransactionDate, v))
+}
+
+// TransactionDateIsNil applies the IsNil predicate on the "transaction_date" field.
+func TransactionDateIsNil() predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldIsNull(FieldTransactionDate))
+}
+
+// TransactionDateNotNil applies the NotNil predicate on the "transaction_date" field.
+func TransactionDateNotNil() predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNotNull(FieldTransactionDate))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLTE(FieldAmount, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uuid.UUID) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasBatch applies the HasEdge predicate on the "batch" edge.
+func HasBatch() predicate.SettlementItem {
+	return predicate.SettlementItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BatchTable, BatchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBatchWith applies the HasEdge predicate on the "batch" edge with a given conditions (other predicates).
+func HasBatchWith(preds ...predicate.SettlementBatch) predicate.SettlementItem {
+	return predicate.SettlementItem(func(s *sql.Selector) {
+		step := newBatchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SettlementItem) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SettlementItem) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SettlementItem) predicate.SettlementItem {
+	return predicate.SettlementItem(sql.NotPredicates(p))
+}
@@ -0,0 +1,1109 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/settlementbatch"
+	"github.com/bengobox/treasury-api/internal/ent/settlementitem"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SettlementItemCreate is the builder for creating a SettlementItem entity.
+type SettlementItemCreate struct {
+	config
+	mutation *SettlementItemMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *SettlementItemCreate) SetTenantID(v uuid.UUID) *SettlementItemCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetBatchID sets the "batch_id" field.
+func (_c *SettlementItemCreate) SetBatchID(v uuid.UUID) *SettlementItemCreate {
+	_c.mutation.SetBatchID(v)
+	return _c
+}
+
+// SetItemType sets the "item_type" field.
+func (_c *SettlementItemCreate) SetItemType(v string) *SettlementItemCreate {
+	_c.mutation.SetItemType(v)
+	return _c
+}
+
+// SetPaymentTransactionID sets the "payment_transaction_id" field.
+func (_c *SettlementItemCreate) SetPaymentTransactionID(v uuid.UUID) *SettlementItemCreate {
+	_c.mutation.SetPaymentTransactionID(v)
+	return _c
+}
+
+// SetNillablePaymentTransactionID sets the "payment_transaction_id" field if the given value is not nil.
+func (_c *SettlementItemCreate) SetNillablePaymentTransactionID(v *uuid.UUID) *SettlementItemCreate {
+	if v != nil {
+		_c.SetPaymentTransactionID(*v)
+	}
+	return _c
+}
+
+// SetProviderReference sets the "provider_reference" field.
+func (_c *SettlementItemCreate) SetProviderReference(v string) *SettlementItemCreate {
+	_c.mutation.SetProviderReference(v)
+	return _c
+}
+
+// SetNillableProviderReference sets the "provider_reference" field if the given value is not nil.
+func (_c *SettlementItemCreate) SetNillableProviderReference(v *string) *SettlementItemCreate {
+	if v != nil {
+		_c.SetProviderReference(*v)
+	}
+	return _c
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (_c *SettlementItemCreate) SetTransactionDate(v time.Time) *SettlementItemCreate {
+	_c.mutation.SetTransactionDate(v)
+	return _c
+}
+
+// SetNillableTransactionDate sets the "transaction_date" field if the given value is not nil.
+func (_c *SettlementItemCreate) SetNillableTransactionDate(v *time.Time) *SettlementItemCreate {
+	if v != nil {
+		_c.SetTransactionDate(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *SettlementItemCreate) SetAmount(v decimal.Decimal) *SettlementItemCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *SettlementItemCreate) SetDescription(v string) *SettlementItemCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *SettlementItemCreate) SetNillableDescription(v *string) *SettlementItemCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *SettlementItemCreate) SetStatus(v string) *SettlementItemCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *SettlementItemCreate) SetNillableStatus(v *string) *SettlementItemCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *SettlementItemCreate) SetCreatedBy(v uuid.UUID) *SettlementItemCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *SettlementItemCreate) SetNillableCreatedBy(v *uuid.UUID) *SettlementItemCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SettlementItemCreate) SetCreatedAt(v time.Time) *SettlementItemCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SettlementItemCreate) SetNillableCreatedAt(v *time.Time) *SettlementItemCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SettlementItemCreate) SetID(v uuid.UUID) *SettlementItemCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SettlementItemCreate) SetNillableID(v *uuid.UUID) *SettlementItemCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetBatch sets the "batch" edge to the SettlementBatch entity.
+func (_c *SettlementItemCreate) SetBatch(v *SettlementBatch) *SettlementItemCreate {
+	return _c.SetBatchID(v.ID)
+}
+
+// Mutation returns the SettlementItemMutation object of the builder.
+func (_c *SettlementItemCreate) Mutation() *SettlementItemMutation {
+	return _c.mutation
+}
+
+// Save creates the SettlementItem in the database.
+func (_c *SettlementItemCreate) Save(ctx context.Context) (*SettlementItem, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SettlementItemCreate) SaveX(ctx context.Context) *SettlementItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SettlementItemCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SettlementItemCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SettlementItemCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := settlementitem.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := settlementitem.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := settlementitem.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SettlementItemCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "SettlementItem.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.BatchID(); !ok {
+		return &ValidationError{Name: "batch_id", err: errors.New(`ent: missing required field "SettlementItem.batch_id"`)}
+	}
+	if _, ok := _c.mutation.ItemType(); !ok {
+		return &ValidationError{Name: "item_type", err: errors.New(`ent: missing required field "SettlementItem.item_type"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "SettlementItem.amount"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SettlementItem.status"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SettlementItem.created_at"`)}
+	}
+	if len(_c.mutation.BatchIDs()) == 0 {
+		return &ValidationError{Name: "batch", err: errors.New(`ent: missing required edge "SettlementItem.batch"`)}
+	}
+	return nil
+}
+
+func (_c *SettlementItemCreate) sqlSave(ctx context.Context) (*SettlementItem, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SettlementItemCreate) createSpec() (*SettlementItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SettlementItem{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(settlementitem.Table, sqlgraph.NewFieldSpec(settlementitem.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(settlementitem.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.ItemType(); ok {
+		_spec.SetField(settlementitem.FieldItemType, field.TypeString, value)
+		_node.ItemType = value
+	}
+	if value, ok := _c.mutation.PaymentTransactionID(); ok {
+		_spec.SetField(settlementitem.FieldPaymentTransactionID, field.TypeUUID, value)
+		_node.PaymentTransactionID = &value
+	}
+	if value, ok := _c.mutation.ProviderReference(); ok {
+		_spec.SetField(settlementitem.FieldProviderReference, field.TypeString, value)
+		_node.ProviderReference = value
+	}
+	if value, ok := _c.mutation.TransactionDate(); ok {
+		_spec.SetField(settlementitem.FieldTransactionDate, field.TypeTime, value)
+		_node.TransactionDate = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(settlementitem.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(settlementitem.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(settlementitem.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(settlementitem.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(settlementitem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.BatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlementitem.BatchTable,
+			Columns: []string{settlementitem.BatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlementbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BatchID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SettlementItem.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SettlementItemUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SettlementItemCreate) OnConflict(opts ...sql.ConflictOption) *SettlementItemUpsertOne {
+	_c.conflict = opts
+	return &SettlementItemUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SettlementItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SettlementItemCreate) OnConflictColumns(columns ...string) *SettlementItemUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SettlementItemUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SettlementItemUpsertOne is the builder for "upsert"-ing
+	//  one SettlementItem node.
+	SettlementItemUpsertOne struct {
+		create *SettlementItemCreate
+	}
+
+	// SettlementItemUpsert is the "OnConflict" setter.
+	SettlementItemUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SettlementItemUpsert) SetTenantID(v uuid.UUID) *SettlementItemUpsert {
+	u.Set(settlementitem.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SettlementItemUpsert) UpdateTenantID() *SettlementItemUpsert {
+	u.SetExcluded(settlementitem.FieldTenantID)
+	return u
+}
+
+// SetBatchID sets the "batch_id" field.
+func (u *SettlementItemUpsert) SetBatchID(v uuid.UUID) *SettlementItemUpsert {
+	u.Set(settlementitem.FieldBatchID, v)
+	return u
+}
+
+// UpdateBatchID sets the "batch_id" field to the value that was provided on create.
+func (u *SettlementItemUpsert) UpdateBatchID() *SettlementItemUpsert {
+	u.SetExcluded(settlementitem.FieldBatchID)
+	return u
+}
+
+// SetProviderReference sets the "provider_reference" field.
+func (u *SettlementItemUpsert) SetProviderReference(v string) *SettlementItemUpsert {
+	u.Set(settlementitem.FieldProviderReference, v)
+	return u
+}
+
+// UpdateProviderReference sets the "provider_reference" field to the value that was provided on create.
+func (u *SettlementItemUpsert) UpdateProviderReference() *SettlementItemUpsert {
+	u.SetExcluded(settlementitem.FieldProviderReference)
+	return u
+}
+
+// ClearProviderReference clears the value of the "provider_reference" field.
+func (u *SettlementItemUpsert) ClearProviderReference() *SettlementItemUpsert {
+	u.SetNull(settlementitem.FieldProviderReference)
+	return u
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (u *SettlementItemUpsert) SetTransactionDate(v time.Time) *SettlementItemUpsert {
+	u.Set(settlementitem.FieldTransactionDate, v)
+	return u
+}
+
+// UpdateTransactionDate sets the "transaction_date" field to the value that was provided on create.
+func (u *SettlementItemUpsert) UpdateTransactionDate() *SettlementItemUpsert {
+	u.SetExcluded(settlementitem.FieldTransactionDate)
+	return u
+}
+
+// ClearTransactionDate clears the value of the "transaction_date" field.
+func (u *SettlementItemUpsert) ClearTransactionDate() *SettlementItemUpsert {
+	u.SetNull(settlementitem.FieldTransactionDate)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *SettlementItemUpsert) SetAmount(v decimal.Decimal) *SettlementItemUpsert {
+	u.Set(settlementitem.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *SettlementItemUpsert) UpdateAmount() *SettlementItemUpsert {
+	u.SetExcluded(settlementitem.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *SettlementItemUpsert) AddAmount(v decimal.Decimal) *SettlementItemUpsert {
+	u.Add(settlementitem.FieldAmount, v)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *SettlementItemUpsert) SetDescription(v string) *SettlementItemUpsert {
+	u.Set(settlementitem.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SettlementItemUpsert) UpdateDescription() *SettlementItemUpsert {
+	u.SetExcluded(settlementitem.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SettlementItemUpsert) ClearDescription() *SettlementItemUpsert {
+	u.SetNull(settlementitem.FieldDescription)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *SettlementItemUpsert) SetStatus(v string) *SettlementItemUpsert {
+	u.Set(settlementitem.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SettlementItemUpsert) UpdateStatus() *SettlementItemUpsert {
+	u.SetExcluded(settlementitem.FieldStatus)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *SettlementItemUpsert) SetCreatedBy(v uuid.UUID) *SettlementItemUpsert {
+	u.Set(settlementitem.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *SettlementItemUpsert) UpdateCreatedBy() *SettlementItemUpsert {
+	u.SetExcluded(settlementitem.FieldCreatedBy)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *SettlementItemUpsert) ClearCreatedBy() *SettlementItemUpsert {
+	u.SetNull(settlementitem.FieldCreatedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SettlementItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(settlementitem.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SettlementItemUpsertOne) UpdateNewValues() *SettlementItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(settlementitem.FieldID)
+		}
+		if _, exists := u.create.mutation.ItemType(); exists {
+			s.SetIgnore(settlementitem.FieldItemType)
+		}
+		if _, exists := u.create.mutation.PaymentTransactionID(); exists {
+			s.SetIgnore(settlementitem.FieldPaymentTransactionID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(settlementitem.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SettlementItem.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SettlementItemUpsertOne) Ignore() *SettlementItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SettlementItemUpsertOne) DoNothing() *SettlementItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SettlementItemCreate.OnConflict
+// documentation for more info.
+func (u *SettlementItemUpsertOne) Update(set func(*SettlementItemUpsert)) *SettlementItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SettlementItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SettlementItemUpsertOne) SetTenantID(v uuid.UUID) *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SettlementItemUpsertOne) UpdateTenantID() *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetBatchID sets the "batch_id" field.
+func (u *SettlementItemUpsertOne) SetBatchID(v uuid.UUID) *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.SetBatchID(v)
+	})
+}
+
+// UpdateBatchID sets the "batch_id" field to the value that was provided on create.
+func (u *SettlementItemUpsertOne) UpdateBatchID() *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.UpdateBatchID()
+	})
+}
+
+// SetProviderReference sets the "provider_reference" field.
+func (u *SettlementItemUpsertOne) SetProviderReference(v string) *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.SetProviderReference(v)
+	})
+}
+
+// UpdateProviderReference sets the "provider_reference" field to the value that was provided on create.
+func (u *SettlementItemUpsertOne) UpdateProviderReference() *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.UpdateProviderReference()
+	})
+}
+
+// ClearProviderReference clears the value of the "provider_reference" field.
+func (u *SettlementItemUpsertOne) ClearProviderReference() *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.ClearProviderReference()
+	})
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (u *SettlementItemUpsertOne) SetTransactionDate(v time.Time) *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.SetTransactionDate(v)
+	})
+}
+
+// UpdateTransactionDate sets the "transaction_date" field to the value that was provided on create.
+func (u *SettlementItemUpsertOne) UpdateTransactionDate() *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.UpdateTransactionDate()
+	})
+}
+
+// ClearTransactionDate clears the value of the "transaction_date" field.
+func (u *SettlementItemUpsertOne) ClearTransactionDate() *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.ClearTransactionDate()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *SettlementItemUpsertOne) SetAmount(v decimal.Decimal) *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *SettlementItemUpsertOne) AddAmount(v decimal.Decimal) *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *SettlementItemUpsertOne) UpdateAmount() *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *SettlementItemUpsertOne) SetDescription(v string) *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SettlementItemUpsertOne) UpdateDescription() *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SettlementItemUpsertOne) ClearDescription() *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SettlementItemUpsertOne) SetStatus(v string) *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SettlementItemUpsertOne) UpdateStatus() *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *SettlementItemUpsertOne) SetCreatedBy(v uuid.UUID) *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *SettlementItemUpsertOne) UpdateCreatedBy() *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *SettlementItemUpsertOne) ClearCreatedBy() *SettlementItemUpsertOne {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *SettlementItemUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SettlementItemCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SettlementItemUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SettlementItemUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SettlementItemUpsertOne.ID is not supported by MySQL driver. Use SettlementItemUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SettlementItemUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SettlementItemCreateBulk is the builder for creating many SettlementItem entities in bulk.
+type SettlementItemCreateBulk struct {
+	config
+	err      error
+	builders []*SettlementItemCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SettlementItem entities in the database.
+func (_c *SettlementItemCreateBulk) Save(ctx context.Context) ([]*SettlementItem, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SettlementItem, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SettlementItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SettlementItemCreateBulk) SaveX(ctx context.Context) []*SettlementItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SettlementItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SettlementItemCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SettlementItem.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SettlementItemUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SettlementItemCreateBulk) OnConflict(opts ...sql.ConflictOption) *SettlementItemUpsertBulk {
+	_c.conflict = opts
+	return &SettlementItemUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SettlementItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SettlementItemCreateBulk) OnConflictColumns(columns ...string) *SettlementItemUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SettlementItemUpsertBulk{
+		create: _c,
+	}
+}
+
+// SettlementItemUpsertBulk is the builder for "upsert"-ing
+// a bulk of SettlementItem nodes.
+type SettlementItemUpsertBulk struct {
+	create *SettlementItemCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SettlementItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(settlementitem.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SettlementItemUpsertBulk) UpdateNewValues() *SettlementItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(settlementitem.FieldID)
+			}
+			if _, exists := b.mutation.ItemType(); exists {
+				s.SetIgnore(settlementitem.FieldItemType)
+			}
+			if _, exists := b.mutation.PaymentTransactionID(); exists {
+				s.SetIgnore(settlementitem.FieldPaymentTransactionID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(settlementitem.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SettlementItem.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SettlementItemUpsertBulk) Ignore() *SettlementItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SettlementItemUpsertBulk) DoNothing() *SettlementItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SettlementItemCreateBulk.OnConflict
+// documentation for more info.
+func (u *SettlementItemUpsertBulk) Update(set func(*SettlementItemUpsert)) *SettlementItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SettlementItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SettlementItemUpsertBulk) SetTenantID(v uuid.UUID) *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SettlementItemUpsertBulk) UpdateTenantID() *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetBatchID sets the "batch_id" field.
+func (u *SettlementItemUpsertBulk) SetBatchID(v uuid.UUID) *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.SetBatchID(v)
+	})
+}
+
+// UpdateBatchID sets the "batch_id" field to the value that was provided on create.
+func (u *SettlementItemUpsertBulk) UpdateBatchID() *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.UpdateBatchID()
+	})
+}
+
+// SetProviderReference sets the "provider_reference" field.
+func (u *SettlementItemUpsertBulk) SetProviderReference(v string) *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.SetProviderReference(v)
+	})
+}
+
+// UpdateProviderReference sets the "provider_reference" field to the value that was provided on create.
+func (u *SettlementItemUpsertBulk) UpdateProviderReference() *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.UpdateProviderReference()
+	})
+}
+
+// ClearProviderReference clears the value of the "provider_reference" field.
+func (u *SettlementItemUpsertBulk) ClearProviderReference() *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.ClearProviderReference()
+	})
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (u *SettlementItemUpsertBulk) SetTransactionDate(v time.Time) *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.SetTransactionDate(v)
+	})
+}
+
+// UpdateTransactionDate sets the "transaction_date" field to the value that was provided on create.
+func (u *SettlementItemUpsertBulk) UpdateTransactionDate() *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.UpdateTransactionDate()
+	})
+}
+
+// ClearTransactionDate clears the value of the "transaction_date" field.
+func (u *SettlementItemUpsertBulk) ClearTransactionDate() *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.ClearTransactionDate()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *SettlementItemUpsertBulk) SetAmount(v decimal.Decimal) *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *SettlementItemUpsertBulk) AddAmount(v decimal.Decimal) *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *SettlementItemUpsertBulk) UpdateAmount() *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *SettlementItemUpsertBulk) SetDescription(v string) *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SettlementItemUpsertBulk) UpdateDescription() *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SettlementItemUpsertBulk) ClearDescription() *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SettlementItemUpsertBulk) SetStatus(v string) *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SettlementItemUpsertBulk) UpdateStatus() *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *SettlementItemUpsertBulk) SetCreatedBy(v uuid.UUID) *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *SettlementItemUpsertBulk) UpdateCreatedBy() *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *SettlementItemUpsertBulk) ClearCreatedBy() *SettlementItemUpsertBulk {
+	return u.Update(func(s *SettlementItemUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *SettlementItemUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SettlementItemCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SettlementItemCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SettlementItemUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,645 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/settlementbatch"
+	"github.com/bengobox/treasury-api/internal/ent/settlementitem"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SettlementItemUpdate is the builder for updating SettlementItem entities.
+type SettlementItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SettlementItemMutation
+}
+
+// Where appends a list predicates to the SettlementItemUpdate builder.
+func (_u *SettlementItemUpdate) Where(ps ...predicate.SettlementItem) *SettlementItemUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *SettlementItemUpdate) SetTenantID(v uuid.UUID) *SettlementItemUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *SettlementItemUpdate) SetNillableTenantID(v *uuid.UUID) *SettlementItemUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetBatchID sets the "batch_id" field.
+func (_u *SettlementItemUpdate) SetBatchID(v uuid.UUID) *SettlementItemUpdate {
+	_u.mutation.SetBatchID(v)
+	return _u
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (_u *SettlementItemUpdate) SetNillableBatchID(v *uuid.UUID) *SettlementItemUpdate {
+	if v != nil {
+		_u.SetBatchID(*v)
+	}
+	return _u
+}
+
+// SetProviderReference sets the "provider_reference" field.
+func (_u *SettlementItemUpdate) SetProviderReference(v string) *SettlementItemUpdate {
+	_u.mutation.SetProviderReference(v)
+	return _u
+}
+
+// SetNillableProviderReference sets the "provider_reference" field if the given value is not nil.
+func (_u *SettlementItemUpdate) SetNillableProviderReference(v *string) *SettlementItemUpdate {
+	if v != nil {
+		_u.SetProviderReference(*v)
+	}
+	return _u
+}
+
+// ClearProviderReference clears the value of the "provider_reference" field.
+func (_u *SettlementItemUpdate) ClearProviderReference() *SettlementItemUpdate {
+	_u.mutation.ClearProviderReference()
+	return _u
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (_u *SettlementItemUpdate) SetTransactionDate(v time.Time) *SettlementItemUpdate {
+	_u.mutation.SetTransactionDate(v)
+	return _u
+}
+
+// SetNillableTransactionDate sets the "transaction_date" field if the given value is not nil.
+func (_u *SettlementItemUpdate) SetNillableTransactionDate(v *time.Time) *SettlementItemUpdate {
+	if v != nil {
+		_u.SetTransactionDate(*v)
+	}
+	return _u
+}
+
+// ClearTransactionDate clears the value of the "transaction_date" field.
+func (_u *SettlementItemUpdate) ClearTransactionDate() *SettlementItemUpdate {
+	_u.mutation.ClearTransactionDate()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *SettlementItemUpdate) SetAmount(v decimal.Decimal) *SettlementItemUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *SettlementItemUpdate) SetNillableAmount(v *decimal.Decimal) *SettlementItemUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *SettlementItemUpdate) AddAmount(v decimal.Decimal) *SettlementItemUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *SettlementItemUpdate) SetDescription(v string) *SettlementItemUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *SettlementItemUpdate) SetNillableDescription(v *string) *SettlementItemUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *SettlementItemUpdate) ClearDescription() *SettlementItemUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SettlementItemUpdate) SetStatus(v string) *SettlementItemUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SettlementItemUpdate) SetNillableStatus(v *string) *SettlementItemUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *SettlementItemUpdate) SetCreatedBy(v uuid.UUID) *SettlementItemUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *SettlementItemUpdate) SetNillableCreatedBy(v *uuid.UUID) *SettlementItemUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *SettlementItemUpdate) ClearCreatedBy() *SettlementItemUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetBatch sets the "batch" edge to the SettlementBatch entity.
+func (_u *SettlementItemUpdate) SetBatch(v *SettlementBatch) *SettlementItemUpdate {
+	return _u.SetBatchID(v.ID)
+}
+
+// Mutation returns the SettlementItemMutation object of the builder.
+func (_u *SettlementItemUpdate) Mutation() *SettlementItemMutation {
+	return _u.mutation
+}
+
+// ClearBatch clears the "batch" edge to the SettlementBatch entity.
+func (_u *SettlementItemUpdate) ClearBatch() *SettlementItemUpdate {
+	_u.mutation.ClearBatch()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SettlementItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SettlementItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SettlementItemUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SettlementItemUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SettlementItemUpdate) check() error {
+	if _u.mutation.BatchCleared() && len(_u.mutation.BatchIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SettlementItem.batch"`)
+	}
+	return nil
+}
+
+func (_u *SettlementItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(settlementitem.Table, settlementitem.Columns, sqlgraph.NewFieldSpec(settlementitem.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(settlementitem.FieldTenantID, field.TypeUUID, value)
+	}
+	if _u.mutation.PaymentTransactionIDCleared() {
+		_spec.ClearField(settlementitem.FieldPaymentTransactionID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ProviderReference(); ok {
+		_spec.SetField(settlementitem.FieldProviderReference, field.TypeString, value)
+	}
+	if _u.mutation.ProviderReferenceCleared() {
+		_spec.ClearField(settlementitem.FieldProviderReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.TransactionDate(); ok {
+		_spec.SetField(settlementitem.FieldTransactionDate, field.TypeTime, value)
+	}
+	if _u.mutation.TransactionDateCleared() {
+		_spec.ClearField(settlementitem.FieldTransactionDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(settlementitem.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(settlementitem.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(settlementitem.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(settlementitem.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(settlementitem.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(settlementitem.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(settlementitem.FieldCreatedBy, field.TypeUUID)
+	}
+	if _u.mutation.BatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlementitem.BatchTable,
+			Columns: []string{settlementitem.BatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlementbatch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlementitem.BatchTable,
+			Columns: []string{settlementitem.BatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlementbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{settlementitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SettlementItemUpdateOne is the builder for updating a single SettlementItem entity.
+type SettlementItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SettlementItemMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *SettlementItemUpdateOne) SetTenantID(v uuid.UUID) *SettlementItemUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *SettlementItemUpdateOne) SetNillableTenantID(v *uuid.UUID) *SettlementItemUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetBatchID sets the "batch_id" field.
+func (_u *SettlementItemUpdateOne) SetBatchID(v uuid.UUID) *SettlementItemUpdateOne {
+	_u.mutation.SetBatchID(v)
+	return _u
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (_u *SettlementItemUpdateOne) SetNillableBatchID(v *uuid.UUID) *SettlementItemUpdateOne {
+	if v != nil {
+		_u.SetBatchID(*v)
+	}
+	return _u
+}
+
+// SetProviderReference sets the "provider_reference" field.
+func (_u *SettlementItemUpdateOne) SetProviderReference(v string) *SettlementItemUpdateOne {
+	_u.mutation.SetProviderReference(v)
+	return _u
+}
+
+// SetNillableProviderReference sets the "provider_reference" field if the given value is not nil.
+func (_u *SettlementItemUpdateOne) SetNillableProviderReference(v *string) *SettlementItemUpdateOne {
+	if v != nil {
+		_u.SetProviderReference(*v)
+	}
+	return _u
+}
+
+// ClearProviderReference clears the value of the "provider_reference" field.
+func (_u *SettlementItemUpdateOne) ClearProviderReference() *SettlementItemUpdateOne {
+	_u.mutation.ClearProviderReference()
+	return _u
+}
+
+// SetTransactionDate sets the "transaction_date" field.
+func (_u *SettlementItemUpdateOne) SetTransactionDate(v time.Time) *SettlementItemUpdateOne {
+	_u.mutation.SetTransactionDate(v)
+	return _u
+}
+
+// SetNillableTransactionDate sets the "transaction_date" field if the given value is not nil.
+func (_u *SettlementItemUpdateOne) SetNillableTransactionDate(v *time.Time) *SettlementItemUpdateOne {
+	if v != nil {
+		_u.SetTransactionDate(*v)
+	}
+	return _u
+}
+
+// ClearTransactionDate clears the value of the "transaction_date" field.
+func (_u *SettlementItemUpdateOne) ClearTransactionDate() *SettlementItemUpdateOne {
+	_u.mutation.ClearTransactionDate()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *SettlementItemUpdateOne) SetAmount(v decimal.Decimal) *SettlementItemUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *SettlementItemUpdateOne) SetNillableAmount(v *decimal.Decimal) *SettlementItemUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *SettlementItemUpdateOne) AddAmount(v decimal.Decimal) *SettlementItemUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *SettlementItemUpdateOne) SetDescription(v string) *SettlementItemUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *SettlementItemUpdateOne) SetNillableDescription(v *string) *SettlementItemUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *SettlementItemUpdateOne) ClearDescription() *SettlementItemUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SettlementItemUpdateOne) SetStatus(v string) *SettlementItemUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SettlementItemUpdateOne) SetNillableStatus(v *string) *SettlementItemUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *SettlementItemUpdateOne) SetCreatedBy(v uuid.UUID) *SettlementItemUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *SettlementItemUpdateOne) SetNillableCreatedBy(v *uuid.UUID) *SettlementItemUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *SettlementItemUpdateOne) ClearCreatedBy() *SettlementItemUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetBatch sets the "batch" edge to the SettlementBatch entity.
+func (_u *SettlementItemUpdateOne) SetBatch(v *SettlementBatch) *SettlementItemUpdateOne {
+	return _u.SetBatchID(v.ID)
+}
+
+// Mutation returns the SettlementItemMutation object of the builder.
+func (_u *SettlementItemUpdateOne) Mutation() *SettlementItemMutation {
+	return _u.mutation
+}
+
+// ClearBatch clears the "batch" edge to the SettlementBatch entity.
+func (_u *SettlementItemUpdateOne) ClearBatch() *SettlementItemUpdateOne {
+	_u.mutation.ClearBatch()
+	return _u
+}
+
+// Where appends a list predicates to the SettlementItemUpdate builder.
+func (_u *SettlementItemUpdateOne) Where(ps ...predicate.SettlementItem) *SettlementItemUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SettlementItemUpdateOne) Select(field string, fields ...string) *SettlementItemUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SettlementItem entity.
+func (_u *SettlementItemUpdateOne) Save(ctx context.Context) (*SettlementItem, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SettlementItemUpdateOne) SaveX(ctx context.Context) *SettlementItem {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SettlementItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SettlementItemUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SettlementItemUpdateOne) check() error {
+	if _u.mutation.BatchCleared() && len(_u.mutation.BatchIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SettlementItem.batch"`)
+	}
+	return nil
+}
+
+func (_u *SettlementItemUpdateOne) sqlSave(ctx context.Context) (_node *SettlementItem, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(settlementitem.Table, settlementitem.Columns, sqlgraph.NewFieldSpec(settlementitem.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SettlementItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, settlementitem.FieldID)
+		for _, f := range fields {
+			if !settlementitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != settlementitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(settlementitem.FieldTenantID, field.TypeUUID, value)
+	}
+	if _u.mutation.PaymentTransactionIDCleared() {
+		_spec.ClearField(settlementitem.FieldPaymentTransactionID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ProviderReference(); ok {
+		_spec.SetField(settlementitem.FieldProviderReference, field.TypeString, value)
+	}
+	if _u.mutation.ProviderReferenceCleared() {
+		_spec.ClearField(settlementitem.FieldProviderReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.TransactionDate(); ok {
+		_spec.SetField(settlementitem.FieldTransactionDate, field.TypeTime, value)
+	}
+	if _u.mutation.TransactionDateCleared() {
+		_spec.ClearField(settlementitem.FieldTransactionDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(settlementitem.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(settlementitem.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(settlementitem.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(settlementitem.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(settlementitem.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(settlementitem.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(settlementitem.FieldCreatedBy, field.TypeUUID)
+	}
+	if _u.mutation.BatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlementitem.BatchTable,
+			Columns: []string{settlementitem.BatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlementbatch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlementitem.BatchTable,
+			Columns: []string{settlementitem.BatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlementbatch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SettlementItem{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{settlementitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/settlementsetting"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SettlementSetting is the model entity for the SettlementSetting schema.
+type SettlementSetting struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Fraction of M-Pesa collections charged on settlement (defaults to zero)
+	MpesaFeeRate decimal.Decimal `json:"mpesa_fee_rate,omitempty"`
+	// Fraction of card collections charged on settlement (defaults to zero)
+	CardFeeRate decimal.Decimal `json:"card_fee_rate,omitempty"`
+	// Fraction of bank transfer collections charged on settlement (defaults to zero)
+	BankFeeRate decimal.Decimal `json:"bank_fee_rate,omitempty"`
+	// How approved batches are paid out: mpesa_b2c, mpesa_b2b
+	PayoutMethod string `json:"payout_method,omitempty"`
+	// Phone number (B2C) or paybill/till number (B2B) paid
+	PayoutParty string `json:"payout_party,omitempty"`
+	// Paybill account number for B2B payouts; empty pays a till
+	PayoutAccountReference string `json:"payout_account_reference,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SettlementSetting) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case settlementsetting.FieldMpesaFeeRate, settlementsetting.FieldCardFeeRate, settlementsetting.FieldBankFeeRate:
+			values[i] = new(decimal.Decimal)
+		case settlementsetting.FieldPayoutMethod, settlementsetting.FieldPayoutParty, settlementsetting.FieldPayoutAccountReference:
+			values[i] = new(sql.NullString)
+		case settlementsetting.FieldCreatedAt, settlementsetting.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case settlementsetting.FieldID, settlementsetting.FieldTenantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SettlementSetting fields.
+func (_m *SettlementSetting) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case settlementsetting.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case settlementsetting.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case settlementsetting.FieldMpesaFeeRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field mpesa_fee_rate", values[i])
+			} else if value != nil {
+				_m.MpesaFeeRate = *value
+			}
+		case settlementsetting.FieldCardFeeRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field card_fee_rate", values[i])
+			} else if value != nil {
+				_m.CardFeeRate = *value
+			}
+		case settlementsetting.FieldBankFeeRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field bank_fee_rate", values[i])
+			} else if value != nil {
+				_m.BankFeeRate = *value
+			}
+		case settlementsetting.FieldPayoutMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payout_method", values[i])
+			} else if value.Valid {
+				_m.PayoutMethod = value.String
+			}
+		case settlementsetting.FieldPayoutParty:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payout_party", values[i])
+			} else if value.Valid {
+				_m.PayoutParty = value.String
+			}
+		case settlementsetting.FieldPayoutAccountReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payout_account_reference", values[i])
+			} else if value.Valid {
+				_m.PayoutAccountReference = value.String
+			}
+		case settlementsetting.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case settlementsetting.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SettlementSetting.
+// This includes values selected through modifiers, order, etc.
+func (_m *SettlementSetting) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SettlementSetting.
+// Note that you need to call SettlementSetting.Unwrap() before calling this method if this SettlementSetting
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SettlementSetting) Update() *SettlementSettingUpdateOne {
+	return NewSettlementSettingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SettlementSetting entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SettlementSetting) Unwrap() *SettlementSetting {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SettlementSetting is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SettlementSetting) String() string {
+	var builder strings.Builder
+	builder.WriteString("SettlementSetting(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("mpesa_fee_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MpesaFeeRate))
+	builder.WriteString(", ")
+	builder.WriteString("card_fee_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CardFeeRate))
+	builder.WriteString(", ")
+	builder.WriteString("bank_fee_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BankFeeRate))
+	builder.WriteString(", ")
+	builder.WriteString("payout_method=")
+	builder.WriteString(_m.PayoutMethod)
+	builder.WriteString(", ")
+	builder.WriteString("payout_party=")
+	builder.WriteString(_m.PayoutParty)
+	builder.WriteString(", ")
+	builder.WriteString("payout_account_reference=")
+	builder.WriteString(_m.PayoutAccountReference)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SettlementSettings is a parsable slice of SettlementSetting.
+type SettlementSettings []*SettlementSetting
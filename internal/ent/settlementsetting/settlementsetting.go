@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package settlementsetting
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the settlementsetting type in the database.
+	Label = "settlement_setting"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldMpesaFeeRate holds the string denoting the mpesa_fee_rate field in the database.
+	FieldMpesaFeeRate = "mpesa_fee_rate"
+	// FieldCardFeeRate holds the string denoting the card_fee_rate field in the database.
+	FieldCardFeeRate = "card_fee_rate"
+	// FieldBankFeeRate holds the string denoting the bank_fee_rate field in the database.
+	FieldBankFeeRate = "bank_fee_rate"
+	// FieldPayoutMethod holds the string denoting the payout_method field in the database.
+	FieldPayoutMethod = "payout_method"
+	// FieldPayoutParty holds the string denoting the payout_party field in the database.
+	FieldPayoutParty = "payout_party"
+	// FieldPayoutAccountReference holds the string denoting the payout_account_reference field in the database.
+	FieldPayoutAccountReference = "payout_account_reference"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the settlementsetting in the database.
+	Table = "settlement_settings"
+)
+
+// Columns holds all SQL columns for settlementsetting fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldMpesaFeeRate,
+	FieldCardFeeRate,
+	FieldBankFeeRate,
+	FieldPayoutMethod,
+	FieldPayoutParty,
+	FieldPayoutAccountReference,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the SettlementSetting queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByMpesaFeeRate orders the results by the mpesa_fee_rate field.
+func ByMpesaFeeRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMpesaFeeRate, opts...).ToFunc()
+}
+
+// ByCardFeeRate orders the results by the card_fee_rate field.
+func ByCardFeeRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCardFeeRate, opts...).ToFunc()
+}
+
+// ByBankFeeRate orders the results by the bank_fee_rate field.
+func ByBankFeeRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBankFeeRate, opts...).ToFunc()
+}
+
+// ByPayoutMethod orders the results by the payout_method field.
+func ByPayoutMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayoutMethod, opts...).ToFunc()
+}
+
+// ByPayoutParty orders the results by the payout_party field.
+func ByPayoutParty(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayoutParty, opts...).ToFunc()
+}
+
+// ByPayoutAccountReference orders the results by the payout_account_reference field.
+func ByPayoutAccountReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayoutAccountReference, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
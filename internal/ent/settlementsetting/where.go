@@ -0,0 +1,612 @@
+// Code generated by ent, DO NOT EDIT.
+
+package settlementsetting
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldTenantID, v))
+}
+
+// MpesaFeeRate applies equality check predicate on the "mpesa_fee_rate" field. It's identical to MpesaFeeRateEQ.
+func MpesaFeeRate(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldMpesaFeeRate, v))
+}
+
+// CardFeeRate applies equality check predicate on the "card_fee_rate" field. It's identical to CardFeeRateEQ.
+func CardFeeRate(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldCardFeeRate, v))
+}
+
+// BankFeeRate applies equality check predicate on the "bank_fee_rate" field. It's identical to BankFeeRateEQ.
+func BankFeeRate(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldBankFeeRate, v))
+}
+
+// PayoutMethod applies equality check predicate on the "payout_method" field. It's identical to PayoutMethodEQ.
+func PayoutMethod(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldPayoutMethod, v))
+}
+
+// PayoutParty applies equality check predicate on the "payout_party" field. It's identical to PayoutPartyEQ.
+func PayoutParty(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldPayoutParty, v))
+}
+
+// PayoutAccountReference applies equality check predicate on the "payout_account_reference" field. It's identical to PayoutAccountReferenceEQ.
+func PayoutAccountReference(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldPayoutAccountReference, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLTE(FieldTenantID, v))
+}
+
+// MpesaFeeRateEQ applies the EQ predicate on the "mpesa_fee_rate" field.
+func MpesaFeeRateEQ(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldMpesaFeeRate, v))
+}
+
+// MpesaFeeRateNEQ applies the NEQ predicate on the "mpesa_fee_rate" field.
+func MpesaFeeRateNEQ(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNEQ(FieldMpesaFeeRate, v))
+}
+
+// MpesaFeeRateIn applies the In predicate on the "mpesa_fee_rate" field.
+func MpesaFeeRateIn(vs ...decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldIn(FieldMpesaFeeRate, vs...))
+}
+
+// MpesaFeeRateNotIn applies the NotIn predicate on the "mpesa_fee_rate" field.
+func MpesaFeeRateNotIn(vs ...decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNotIn(FieldMpesaFeeRate, vs...))
+}
+
+// MpesaFeeRateGT applies the GT predicate on the "mpesa_fee_rate" field.
+func MpesaFeeRateGT(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGT(FieldMpesaFeeRate, v))
+}
+
+// MpesaFeeRateGTE applies the GTE predicate on the "mpesa_fee_rate" field.
+func MpesaFeeRateGTE(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGTE(FieldMpesaFeeRate, v))
+}
+
+// MpesaFeeRateLT applies the LT predicate on the "mpesa_fee_rate" field.
+func MpesaFeeRateLT(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLT(FieldMpesaFeeRate, v))
+}
+
+// MpesaFeeRateLTE applies the LTE predicate on the "mpesa_fee_rate" field.
+func MpesaFeeRateLTE(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLTE(FieldMpesaFeeRate, v))
+}
+
+// MpesaFeeRateIsNil applies the IsNil predicate on the "mpesa_fee_rate" field.
+func MpesaFeeRateIsNil() predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldIsNull(FieldMpesaFeeRate))
+}
+
+// MpesaFeeRateNotNil applies the NotNil predicate on the "mpesa_fee_rate" field.
+func MpesaFeeRateNotNil() predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNotNull(FieldMpesaFeeRate))
+}
+
+// CardFeeRateEQ applies the EQ predicate on the "card_fee_rate" field.
+func CardFeeRateEQ(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldCardFeeRate, v))
+}
+
+// CardFeeRateNEQ applies the NEQ predicate on the "card_fee_rate" field.
+func CardFeeRateNEQ(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNEQ(FieldCardFeeRate, v))
+}
+
+// CardFeeRateIn applies the In predicate on the "card_fee_rate" field.
+func CardFeeRateIn(vs ...decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldIn(FieldCardFeeRate, vs...))
+}
+
+// CardFeeRateNotIn applies the NotIn predicate on the "card_fee_rate" field.
+func CardFeeRateNotIn(vs ...decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNotIn(FieldCardFeeRate, vs...))
+}
+
+// CardFeeRateGT applies the GT predicate on the "card_fee_rate" field.
+func CardFeeRateGT(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGT(FieldCardFeeRate, v))
+}
+
+// CardFeeRateGTE applies the GTE predicate on the "card_fee_rate" field.
+func CardFeeRateGTE(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGTE(FieldCardFeeRate, v))
+}
+
+// CardFeeRateLT applies the LT predicate on the "card_fee_rate" field.
+func CardFeeRateLT(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLT(FieldCardFeeRate, v))
+}
+
+// CardFeeRateLTE applies the LTE predicate on the "card_fee_rate" field.
+func CardFeeRateLTE(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLTE(FieldCardFeeRate, v))
+}
+
+// CardFeeRateIsNil applies the IsNil predicate on the "card_fee_rate" field.
+func CardFeeRateIsNil() predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldIsNull(FieldCardFeeRate))
+}
+
+// CardFeeRateNotNil applies the NotNil predicate on the "card_fee_rate" field.
+func CardFeeRateNotNil() predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNotNull(FieldCardFeeRate))
+}
+
+// BankFeeRateEQ applies the EQ predicate on the "bank_fee_rate" field.
+func BankFeeRateEQ(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldBankFeeRate, v))
+}
+
+// BankFeeRateNEQ applies the NEQ predicate on the "bank_fee_rate" field.
+func BankFeeRateNEQ(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNEQ(FieldBankFeeRate, v))
+}
+
+// BankFeeRateIn applies the In predicate on the "bank_fee_rate" field.
+func BankFeeRateIn(vs ...decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldIn(FieldBankFeeRate, vs...))
+}
+
+// BankFeeRateNotIn applies the NotIn predicate on the "bank_fee_rate" field.
+func BankFeeRateNotIn(vs ...decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNotIn(FieldBankFeeRate, vs...))
+}
+
+// BankFeeRateGT applies the GT predicate on the "bank_fee_rate" field.
+func BankFeeRateGT(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGT(FieldBankFeeRate, v))
+}
+
+// BankFeeRateGTE applies the GTE predicate on the "bank_fee_rate" field.
+func BankFeeRateGTE(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGTE(FieldBankFeeRate, v))
+}
+
+// BankFeeRateLT applies the LT predicate on the "bank_fee_rate" field.
+func BankFeeRateLT(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLT(FieldBankFeeRate, v))
+}
+
+// BankFeeRateLTE applies the LTE predicate on the "bank_fee_rate" field.
+func BankFeeRateLTE(v decimal.Decimal) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLTE(FieldBankFeeRate, v))
+}
+
+// BankFeeRateIsNil applies the IsNil predicate on the "bank_fee_rate" field.
+func BankFeeRateIsNil() predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldIsNull(FieldBankFeeRate))
+}
+
+// BankFeeRateNotNil applies the NotNil predicate on the "bank_fee_rate" field.
+func BankFeeRateNotNil() predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNotNull(FieldBankFeeRate))
+}
+
+// PayoutMethodEQ applies the EQ predicate on the "payout_method" field.
+func PayoutMethodEQ(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldPayoutMethod, v))
+}
+
+// PayoutMethodNEQ applies the NEQ predicate on the "payout_method" field.
+func PayoutMethodNEQ(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNEQ(FieldPayoutMethod, v))
+}
+
+// PayoutMethodIn applies the In predicate on the "payout_method" field.
+func PayoutMethodIn(vs ...string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldIn(FieldPayoutMethod, vs...))
+}
+
+// PayoutMethodNotIn applies the NotIn predicate on the "payout_method" field.
+func PayoutMethodNotIn(vs ...string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNotIn(FieldPayoutMethod, vs...))
+}
+
+// PayoutMethodGT applies the GT predicate on the "payout_method" field.
+func PayoutMethodGT(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGT(FieldPayoutMethod, v))
+}
+
+// PayoutMethodGTE applies the GTE predicate on the "payout_method" field.
+func PayoutMethodGTE(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGTE(FieldPayoutMethod, v))
+}
+
+// PayoutMethodLT applies the LT predicate on the "payout_method" field.
+func PayoutMethodLT(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLT(FieldPayoutMethod, v))
+}
+
+// PayoutMethodLTE applies the LTE predicate on the "payout_method" field.
+func PayoutMethodLTE(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLTE(FieldPayoutMethod, v))
+}
+
+// PayoutMethodContains applies the Contains predicate on the "payout_method" field.
+func PayoutMethodContains(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldContains(FieldPayoutMethod, v))
+}
+
+// PayoutMethodHasPrefix applies the HasPrefix predicate on the "payout_method" field.
+func PayoutMethodHasPrefix(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldHasPrefix(FieldPayoutMethod, v))
+}
+
+// PayoutMethodHasSuffix applies the HasSuffix predicate on the "payout_method" field.
+func PayoutMethodHasSuffix(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldHasSuffix(FieldPayoutMethod, v))
+}
+
+// PayoutMethodIsNil applies the IsNil predicate on the "payout_method" field.
+func PayoutMethodIsNil() predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldIsNull(FieldPayoutMethod))
+}
+
+// PayoutMethodNotNil applies the NotNil predicate on the "payout_method" field.
+func PayoutMethodNotNil() predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNotNull(FieldPayoutMethod))
+}
+
+// PayoutMethodEqualFold applies the EqualFold predicate on the "payout_method" field.
+func PayoutMethodEqualFold(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEqualFold(FieldPayoutMethod, v))
+}
+
+// PayoutMethodContainsFold applies the ContainsFold predicate on the "payout_method" field.
+func PayoutMethodContainsFold(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldContainsFold(FieldPayoutMethod, v))
+}
+
+// PayoutPartyEQ applies the EQ predicate on the "payout_party" field.
+func PayoutPartyEQ(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldPayoutParty, v))
+}
+
+// PayoutPartyNEQ applies the NEQ predicate on the "payout_party" field.
+func PayoutPartyNEQ(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNEQ(FieldPayoutParty, v))
+}
+
+// PayoutPartyIn applies the In predicate on the "payout_party" field.
+func PayoutPartyIn(vs ...string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldIn(FieldPayoutParty, vs...))
+}
+
+// PayoutPartyNotIn applies the NotIn predicate on the "payout_party" field.
+func PayoutPartyNotIn(vs ...string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNotIn(FieldPayoutParty, vs...))
+}
+
+// PayoutPartyGT applies the GT predicate on the "payout_party" field.
+func PayoutPartyGT(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGT(FieldPayoutParty, v))
+}
+
+// PayoutPartyGTE applies the GTE predicate on the "payout_party" field.
+func PayoutPartyGTE(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGTE(FieldPayoutParty, v))
+}
+
+// PayoutPartyLT applies the LT predicate on the "payout_party" field.
+func PayoutPartyLT(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLT(FieldPayoutParty, v))
+}
+
+// PayoutPartyLTE applies the LTE predicate on the "payout_party" field.
+func PayoutPartyLTE(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLTE(FieldPayoutParty, v))
+}
+
+// PayoutPartyContains applies the Contains predicate on the "payout_party" field.
+func PayoutPartyContains(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldContains(FieldPayoutParty, v))
+}
+
+// PayoutPartyHasPrefix applies the HasPrefix predicate on the "payout_party" field.
+func PayoutPartyHasPrefix(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldHasPrefix(FieldPayoutParty, v))
+}
+
+// PayoutPartyHasSuffix applies the HasSuffix predicate on the "payout_party" field.
+func PayoutPartyHasSuffix(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldHasSuffix(FieldPayoutParty, v))
+}
+
+// PayoutPartyIsNil applies the IsNil predicate on the "payout_party" field.
+func PayoutPartyIsNil() predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldIsNull(FieldPayoutParty))
+}
+
+// PayoutPartyNotNil applies the NotNil predicate on the "payout_party" field.
+func PayoutPartyNotNil() predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNotNull(FieldPayoutParty))
+}
+
+// PayoutPartyEqualFold applies the EqualFold predicate on the "payout_party" field.
+func PayoutPartyEqualFold(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEqualFold(FieldPayoutParty, v))
+}
+
+// PayoutPartyContainsFold applies the ContainsFold predicate on the "payout_party" field.
+func PayoutPartyContainsFold(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldContainsFold(FieldPayoutParty, v))
+}
+
+// PayoutAccountReferenceEQ applies the EQ predicate on the "payout_account_reference" field.
+func PayoutAccountReferenceEQ(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldPayoutAccountReference, v))
+}
+
+// PayoutAccountReferenceNEQ applies the NEQ predicate on the "payout_account_reference" field.
+func PayoutAccountReferenceNEQ(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNEQ(FieldPayoutAccountReference, v))
+}
+
+// PayoutAccountReferenceIn applies the In predicate on the "payout_account_reference" field.
+func PayoutAccountReferenceIn(vs ...string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldIn(FieldPayoutAccountReference, vs...))
+}
+
+// PayoutAccountReferenceNotIn applies the NotIn predicate on the "payout_account_reference" field.
+func PayoutAccountReferenceNotIn(vs ...string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNotIn(FieldPayoutAccountReference, vs...))
+}
+
+// PayoutAccountReferenceGT applies the GT predicate on the "payout_account_reference" field.
+func PayoutAccountReferenceGT(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGT(FieldPayoutAccountReference, v))
+}
+
+// PayoutAccountReferenceGTE applies the GTE predicate on the "payout_account_reference" field.
+func PayoutAccountReferenceGTE(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGTE(FieldPayoutAccountReference, v))
+}
+
+// PayoutAccountReferenceLT applies the LT predicate on the "payout_account_reference" field.
+func PayoutAccountReferenceLT(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLT(FieldPayoutAccountReference, v))
+}
+
+// PayoutAccountReferenceLTE applies the LTE predicate on the "payout_account_reference" field.
+func PayoutAccountReferenceLTE(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLTE(FieldPayoutAccountReference, v))
+}
+
+// PayoutAccountReferenceContains applies the Contains predicate on the "payout_account_reference" field.
+func PayoutAccountReferenceContains(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldContains(FieldPayoutAccountReference, v))
+}
+
+// PayoutAccountReferenceHasPrefix applies the HasPrefix predicate on the "payout_account_reference" field.
+func PayoutAccountReferenceHasPrefix(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldHasPrefix(FieldPayoutAccountReference, v))
+}
+
+// PayoutAccountReferenceHasSuffix applies the HasSuffix predicate on the "payout_account_reference" field.
+func PayoutAccountReferenceHasSuffix(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldHasSuffix(FieldPayoutAccountReference, v))
+}
+
+// PayoutAccountReferenceIsNil applies the IsNil predicate on the "payout_account_reference" field.
+func PayoutAccountReferenceIsNil() predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldIsNull(FieldPayoutAccountReference))
+}
+
+// PayoutAccountReferenceNotNil applies the NotNil predicate on the "payout_account_reference" field.
+func PayoutAccountReferenceNotNil() predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNotNull(FieldPayoutAccountReference))
+}
+
+// PayoutAccountReferenceEqualFold applies the EqualFold predicate on the "payout_account_reference" field.
+func PayoutAccountReferenceEqualFold(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEqualFold(FieldPayoutAccountReference, v))
+}
+
+// PayoutAccountReferenceContainsFold applies the ContainsFold predicate on the "payout_account_reference" field.
+func PayoutAccountReferenceContainsFold(v string) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldContainsFold(FieldPayoutAccountReference, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SettlementSetting) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SettlementSetting) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SettlementSetting) predicate.SettlementSetting {
+	return predicate.SettlementSetting(sql.NotPredicates(p))
+}
@@ -0,0 +1,1134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/settlementsetting"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SettlementSettingCreate is the builder for creating a SettlementSetting entity.
+type SettlementSettingCreate struct {
+	config
+	mutation *SettlementSettingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *SettlementSettingCreate) SetTenantID(v uuid.UUID) *SettlementSettingCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetMpesaFeeRate sets the "mpesa_fee_rate" field.
+func (_c *SettlementSettingCreate) SetMpesaFeeRate(v decimal.Decimal) *SettlementSettingCreate {
+	_c.mutation.SetMpesaFeeRate(v)
+	return _c
+}
+
+// SetNillableMpesaFeeRate sets the "mpesa_fee_rate" field if the given value is not nil.
+func (_c *SettlementSettingCreate) SetNillableMpesaFeeRate(v *decimal.Decimal) *SettlementSettingCreate {
+	if v != nil {
+		_c.SetMpesaFeeRate(*v)
+	}
+	return _c
+}
+
+// SetCardFeeRate sets the "card_fee_rate" field.
+func (_c *SettlementSettingCreate) SetCardFeeRate(v decimal.Decimal) *SettlementSettingCreate {
+	_c.mutation.SetCardFeeRate(v)
+	return _c
+}
+
+// SetNillableCardFeeRate sets the "card_fee_rate" field if the given value is not nil.
+func (_c *SettlementSettingCreate) SetNillableCardFeeRate(v *decimal.Decimal) *SettlementSettingCreate {
+	if v != nil {
+		_c.SetCardFeeRate(*v)
+	}
+	return _c
+}
+
+// SetBankFeeRate sets the "bank_fee_rate" field.
+func (_c *SettlementSettingCreate) SetBankFeeRate(v decimal.Decimal) *SettlementSettingCreate {
+	_c.mutation.SetBankFeeRate(v)
+	return _c
+}
+
+// SetNillableBankFeeRate sets the "bank_fee_rate" field if the given value is not nil.
+func (_c *SettlementSettingCreate) SetNillableBankFeeRate(v *decimal.Decimal) *SettlementSettingCreate {
+	if v != nil {
+		_c.SetBankFeeRate(*v)
+	}
+	return _c
+}
+
+// SetPayoutMethod sets the "payout_method" field.
+func (_c *SettlementSettingCreate) SetPayoutMethod(v string) *SettlementSettingCreate {
+	_c.mutation.SetPayoutMethod(v)
+	return _c
+}
+
+// SetNillablePayoutMethod sets the "payout_method" field if the given value is not nil.
+func (_c *SettlementSettingCreate) SetNillablePayoutMethod(v *string) *SettlementSettingCreate {
+	if v != nil {
+		_c.SetPayoutMethod(*v)
+	}
+	return _c
+}
+
+// SetPayoutParty sets the "payout_party" field.
+func (_c *SettlementSettingCreate) SetPayoutParty(v string) *SettlementSettingCreate {
+	_c.mutation.SetPayoutParty(v)
+	return _c
+}
+
+// SetNillablePayoutParty sets the "payout_party" field if the given value is not nil.
+func (_c *SettlementSettingCreate) SetNillablePayoutParty(v *string) *SettlementSettingCreate {
+	if v != nil {
+		_c.SetPayoutParty(*v)
+	}
+	return _c
+}
+
+// SetPayoutAccountReference sets the "payout_account_reference" field.
+func (_c *SettlementSettingCreate) SetPayoutAccountReference(v string) *SettlementSettingCreate {
+	_c.mutation.SetPayoutAccountReference(v)
+	return _c
+}
+
+// SetNillablePayoutAccountReference sets the "payout_account_reference" field if the given value is not nil.
+func (_c *SettlementSettingCreate) SetNillablePayoutAccountReference(v *string) *SettlementSettingCreate {
+	if v != nil {
+		_c.SetPayoutAccountReference(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SettlementSettingCreate) SetCreatedAt(v time.Time) *SettlementSettingCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SettlementSettingCreate) SetNillableCreatedAt(v *time.Time) *SettlementSettingCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SettlementSettingCreate) SetUpdatedAt(v time.Time) *SettlementSettingCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SettlementSettingCreate) SetNillableUpdatedAt(v *time.Time) *SettlementSettingCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SettlementSettingCreate) SetID(v uuid.UUID) *SettlementSettingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SettlementSettingCreate) SetNillableID(v *uuid.UUID) *SettlementSettingCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SettlementSettingMutation object of the builder.
+func (_c *SettlementSettingCreate) Mutation() *SettlementSettingMutation {
+	return _c.mutation
+}
+
+// Save creates the SettlementSetting in the database.
+func (_c *SettlementSettingCreate) Save(ctx context.Context) (*SettlementSetting, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SettlementSettingCreate) SaveX(ctx context.Context) *SettlementSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SettlementSettingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SettlementSettingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SettlementSettingCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := settlementsetting.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := settlementsetting.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := settlementsetting.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SettlementSettingCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "SettlementSetting.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SettlementSetting.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SettlementSetting.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *SettlementSettingCreate) sqlSave(ctx context.Context) (*SettlementSetting, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SettlementSettingCreate) createSpec() (*SettlementSetting, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SettlementSetting{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(settlementsetting.Table, sqlgraph.NewFieldSpec(settlementsetting.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(settlementsetting.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.MpesaFeeRate(); ok {
+		_spec.SetField(settlementsetting.FieldMpesaFeeRate, field.TypeFloat64, value)
+		_node.MpesaFeeRate = value
+	}
+	if value, ok := _c.mutation.CardFeeRate(); ok {
+		_spec.SetField(settlementsetting.FieldCardFeeRate, field.TypeFloat64, value)
+		_node.CardFeeRate = value
+	}
+	if value, ok := _c.mutation.BankFeeRate(); ok {
+		_spec.SetField(settlementsetting.FieldBankFeeRate, field.TypeFloat64, value)
+		_node.BankFeeRate = value
+	}
+	if value, ok := _c.mutation.PayoutMethod(); ok {
+		_spec.SetField(settlementsetting.FieldPayoutMethod, field.TypeString, value)
+		_node.PayoutMethod = value
+	}
+	if value, ok := _c.mutation.PayoutParty(); ok {
+		_spec.SetField(settlementsetting.FieldPayoutParty, field.TypeString, value)
+		_node.PayoutParty = value
+	}
+	if value, ok := _c.mutation.PayoutAccountReference(); ok {
+		_spec.SetField(settlementsetting.FieldPayoutAccountReference, field.TypeString, value)
+		_node.PayoutAccountReference = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(settlementsetting.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(settlementsetting.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SettlementSetting.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SettlementSettingUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SettlementSettingCreate) OnConflict(opts ...sql.ConflictOption) *SettlementSettingUpsertOne {
+	_c.conflict = opts
+	return &SettlementSettingUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SettlementSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SettlementSettingCreate) OnConflictColumns(columns ...string) *SettlementSettingUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SettlementSettingUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SettlementSettingUpsertOne is the builder for "upsert"-ing
+	//  one SettlementSetting node.
+	SettlementSettingUpsertOne struct {
+		create *SettlementSettingCreate
+	}
+
+	// SettlementSettingUpsert is the "OnConflict" setter.
+	SettlementSettingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SettlementSettingUpsert) SetTenantID(v uuid.UUID) *SettlementSettingUpsert {
+	u.Set(settlementsetting.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SettlementSettingUpsert) UpdateTenantID() *SettlementSettingUpsert {
+	u.SetExcluded(settlementsetting.FieldTenantID)
+	return u
+}
+
+// SetMpesaFeeRate sets the "mpesa_fee_rate" field.
+func (u *SettlementSettingUpsert) SetMpesaFeeRate(v decimal.Decimal) *SettlementSettingUpsert {
+	u.Set(settlementsetting.FieldMpesaFeeRate, v)
+	return u
+}
+
+// UpdateMpesaFeeRate sets the "mpesa_fee_rate" field to the value that was provided on create.
+func (u *SettlementSettingUpsert) UpdateMpesaFeeRate() *SettlementSettingUpsert {
+	u.SetExcluded(settlementsetting.FieldMpesaFeeRate)
+	return u
+}
+
+// AddMpesaFeeRate adds v to the "mpesa_fee_rate" field.
+func (u *SettlementSettingUpsert) AddMpesaFeeRate(v decimal.Decimal) *SettlementSettingUpsert {
+	u.Add(settlementsetting.FieldMpesaFeeRate, v)
+	return u
+}
+
+// ClearMpesaFeeRate clears the value of the "mpesa_fee_rate" field.
+func (u *SettlementSettingUpsert) ClearMpesaFeeRate() *SettlementSettingUpsert {
+	u.SetNull(settlementsetting.FieldMpesaFeeRate)
+	return u
+}
+
+// SetCardFeeRate sets the "card_fee_rate" field.
+func (u *SettlementSettingUpsert) SetCardFeeRate(v decimal.Decimal) *SettlementSettingUpsert {
+	u.Set(settlementsetting.FieldCardFeeRate, v)
+	return u
+}
+
+// UpdateCardFeeRate sets the "card_fee_rate" field to the value that was provided on create.
+func (u *SettlementSettingUpsert) UpdateCardFeeRate() *SettlementSettingUpsert {
+	u.SetExcluded(settlementsetting.FieldCardFeeRate)
+	return u
+}
+
+// AddCardFeeRate adds v to the "card_fee_rate" field.
+func (u *SettlementSettingUpsert) AddCardFeeRate(v decimal.Decimal) *SettlementSettingUpsert {
+	u.Add(settlementsetting.FieldCardFeeRate, v)
+	return u
+}
+
+// ClearCardFeeRate clears the value of the "card_fee_rate" field.
+func (u *SettlementSettingUpsert) ClearCardFeeRate() *SettlementSettingUpsert {
+	u.SetNull(settlementsetting.FieldCardFeeRate)
+	return u
+}
+
+// SetBankFeeRate sets the "bank_fee_rate" field.
+func (u *SettlementSettingUpsert) SetBankFeeRate(v decimal.Decimal) *SettlementSettingUpsert {
+	u.Set(settlementsetting.FieldBankFeeRate, v)
+	return u
+}
+
+// UpdateBankFeeRate sets the "bank_fee_rate" field to the value that was provided on create.
+func (u *SettlementSettingUpsert) UpdateBankFeeRate() *SettlementSettingUpsert {
+	u.SetExcluded(settlementsetting.FieldBankFeeRate)
+	return u
+}
+
+// AddBankFeeRate adds v to the "bank_fee_rate" field.
+func (u *SettlementSettingUpsert) AddBankFeeRate(v decimal.Decimal) *SettlementSettingUpsert {
+	u.Add(settlementsetting.FieldBankFeeRate, v)
+	return u
+}
+
+// ClearBankFeeRate clears the value of the "bank_fee_rate" field.
+func (u *SettlementSettingUpsert) ClearBankFeeRate() *SettlementSettingUpsert {
+	u.SetNull(settlementsetting.FieldBankFeeRate)
+	return u
+}
+
+// SetPayoutMethod sets the "payout_method" field.
+func (u *SettlementSettingUpsert) SetPayoutMethod(v string) *SettlementSettingUpsert {
+	u.Set(settlementsetting.FieldPayoutMethod, v)
+	return u
+}
+
+// UpdatePayoutMethod sets the "payout_method" field to the value that was provided on create.
+func (u *SettlementSettingUpsert) UpdatePayoutMethod() *SettlementSettingUpsert {
+	u.SetExcluded(settlementsetting.FieldPayoutMethod)
+	return u
+}
+
+// ClearPayoutMethod clears the value of the "payout_method" field.
+func (u *SettlementSettingUpsert) ClearPayoutMethod() *SettlementSettingUpsert {
+	u.SetNull(settlementsetting.FieldPayoutMethod)
+	return u
+}
+
+// SetPayoutParty sets the "payout_party" field.
+func (u *SettlementSettingUpsert) SetPayoutParty(v string) *SettlementSettingUpsert {
+	u.Set(settlementsetting.FieldPayoutParty, v)
+	return u
+}
+
+// UpdatePayoutParty sets the "payout_party" field to the value that was provided on create.
+func (u *SettlementSettingUpsert) UpdatePayoutParty() *SettlementSettingUpsert {
+	u.SetExcluded(settlementsetting.FieldPayoutParty)
+	return u
+}
+
+// ClearPayoutParty clears the value of the "payout_party" field.
+func (u *SettlementSettingUpsert) ClearPayoutParty() *SettlementSettingUpsert {
+	u.SetNull(settlementsetting.FieldPayoutParty)
+	return u
+}
+
+// SetPayoutAccountReference sets the "payout_account_reference" field.
+func (u *SettlementSettingUpsert) SetPayoutAccountReference(v string) *SettlementSettingUpsert {
+	u.Set(settlementsetting.FieldPayoutAccountReference, v)
+	return u
+}
+
+// UpdatePayoutAccountReference sets the "payout_account_reference" field to the value that was provided on create.
+func (u *SettlementSettingUpsert) UpdatePayoutAccountReference() *SettlementSettingUpsert {
+	u.SetExcluded(settlementsetting.FieldPayoutAccountReference)
+	return u
+}
+
+// ClearPayoutAccountReference clears the value of the "payout_account_reference" field.
+func (u *SettlementSettingUpsert) ClearPayoutAccountReference() *SettlementSettingUpsert {
+	u.SetNull(settlementsetting.FieldPayoutAccountReference)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SettlementSettingUpsert) SetUpdatedAt(v time.Time) *SettlementSettingUpsert {
+	u.Set(settlementsetting.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SettlementSettingUpsert) UpdateUpdatedAt() *SettlementSettingUpsert {
+	u.SetExcluded(settlementsetting.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SettlementSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(settlementsetting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SettlementSettingUpsertOne) UpdateNewValues() *SettlementSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(settlementsetting.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(settlementsetting.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SettlementSetting.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SettlementSettingUpsertOne) Ignore() *SettlementSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SettlementSettingUpsertOne) DoNothing() *SettlementSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SettlementSettingCreate.OnConflict
+// documentation for more info.
+func (u *SettlementSettingUpsertOne) Update(set func(*SettlementSettingUpsert)) *SettlementSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SettlementSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SettlementSettingUpsertOne) SetTenantID(v uuid.UUID) *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SettlementSettingUpsertOne) UpdateTenantID() *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetMpesaFeeRate sets the "mpesa_fee_rate" field.
+func (u *SettlementSettingUpsertOne) SetMpesaFeeRate(v decimal.Decimal) *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.SetMpesaFeeRate(v)
+	})
+}
+
+// AddMpesaFeeRate adds v to the "mpesa_fee_rate" field.
+func (u *SettlementSettingUpsertOne) AddMpesaFeeRate(v decimal.Decimal) *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.AddMpesaFeeRate(v)
+	})
+}
+
+// UpdateMpesaFeeRate sets the "mpesa_fee_rate" field to the value that was provided on create.
+func (u *SettlementSettingUpsertOne) UpdateMpesaFeeRate() *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.UpdateMpesaFeeRate()
+	})
+}
+
+// ClearMpesaFeeRate clears the value of the "mpesa_fee_rate" field.
+func (u *SettlementSettingUpsertOne) ClearMpesaFeeRate() *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.ClearMpesaFeeRate()
+	})
+}
+
+// SetCardFeeRate sets the "card_fee_rate" field.
+func (u *SettlementSettingUpsertOne) SetCardFeeRate(v decimal.Decimal) *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.SetCardFeeRate(v)
+	})
+}
+
+// AddCardFeeRate adds v to the "card_fee_rate" field.
+func (u *SettlementSettingUpsertOne) AddCardFeeRate(v decimal.Decimal) *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.AddCardFeeRate(v)
+	})
+}
+
+// UpdateCardFeeRate sets the "card_fee_rate" field to the value that was provided on create.
+func (u *SettlementSettingUpsertOne) UpdateCardFeeRate() *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.UpdateCardFeeRate()
+	})
+}
+
+// ClearCardFeeRate clears the value of the "card_fee_rate" field.
+func (u *SettlementSettingUpsertOne) ClearCardFeeRate() *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.ClearCardFeeRate()
+	})
+}
+
+// SetBankFeeRate sets the "bank_fee_rate" field.
+func (u *SettlementSettingUpsertOne) SetBankFeeRate(v decimal.Decimal) *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.SetBankFeeRate(v)
+	})
+}
+
+// AddBankFeeRate adds v to the "bank_fee_rate" field.
+func (u *SettlementSettingUpsertOne) AddBankFeeRate(v decimal.Decimal) *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.AddBankFeeRate(v)
+	})
+}
+
+// UpdateBankFeeRate sets the "bank_fee_rate" field to the value that was provided on create.
+func (u *SettlementSettingUpsertOne) UpdateBankFeeRate() *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.UpdateBankFeeRate()
+	})
+}
+
+// ClearBankFeeRate clears the value of the "bank_fee_rate" field.
+func (u *SettlementSettingUpsertOne) ClearBankFeeRate() *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.ClearBankFeeRate()
+	})
+}
+
+// SetPayoutMethod sets the "payout_method" field.
+func (u *SettlementSettingUpsertOne) SetPayoutMethod(v string) *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.SetPayoutMethod(v)
+	})
+}
+
+// UpdatePayoutMethod sets the "payout_method" field to the value that was provided on create.
+func (u *SettlementSettingUpsertOne) UpdatePayoutMethod() *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.UpdatePayoutMethod()
+	})
+}
+
+// ClearPayoutMethod clears the value of the "payout_method" field.
+func (u *SettlementSettingUpsertOne) ClearPayoutMethod() *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.ClearPayoutMethod()
+	})
+}
+
+// SetPayoutParty sets the "payout_party" field.
+func (u *SettlementSettingUpsertOne) SetPayoutParty(v string) *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.SetPayoutParty(v)
+	})
+}
+
+// UpdatePayoutParty sets the "payout_party" field to the value that was provided on create.
+func (u *SettlementSettingUpsertOne) UpdatePayoutParty() *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.UpdatePayoutParty()
+	})
+}
+
+// ClearPayoutParty clears the value of the "payout_party" field.
+func (u *SettlementSettingUpsertOne) ClearPayoutParty() *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.ClearPayoutParty()
+	})
+}
+
+// SetPayoutAccountReference sets the "payout_account_reference" field.
+func (u *SettlementSettingUpsertOne) SetPayoutAccountReference(v string) *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.SetPayoutAccountReference(v)
+	})
+}
+
+// UpdatePayoutAccountReference sets the "payout_account_reference" field to the value that was provided on create.
+func (u *SettlementSettingUpsertOne) UpdatePayoutAccountReference() *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.UpdatePayoutAccountReference()
+	})
+}
+
+// ClearPayoutAccountReference clears the value of the "payout_account_reference" field.
+func (u *SettlementSettingUpsertOne) ClearPayoutAccountReference() *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.ClearPayoutAccountReference()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SettlementSettingUpsertOne) SetUpdatedAt(v time.Time) *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SettlementSettingUpsertOne) UpdateUpdatedAt() *SettlementSettingUpsertOne {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SettlementSettingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SettlementSettingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SettlementSettingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SettlementSettingUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SettlementSettingUpsertOne.ID is not supported by MySQL driver. Use SettlementSettingUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SettlementSettingUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SettlementSettingCreateBulk is the builder for creating many SettlementSetting entities in bulk.
+type SettlementSettingCreateBulk struct {
+	config
+	err      error
+	builders []*SettlementSettingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SettlementSetting entities in the database.
+func (_c *SettlementSettingCreateBulk) Save(ctx context.Context) ([]*SettlementSetting, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SettlementSetting, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SettlementSettingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SettlementSettingCreateBulk) SaveX(ctx context.Context) []*SettlementSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SettlementSettingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SettlementSettingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SettlementSetting.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SettlementSettingUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SettlementSettingCreateBulk) OnConflict(opts ...sql.ConflictOption) *SettlementSettingUpsertBulk {
+	_c.conflict = opts
+	return &SettlementSettingUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SettlementSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SettlementSettingCreateBulk) OnConflictColumns(columns ...string) *SettlementSettingUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SettlementSettingUpsertBulk{
+		create: _c,
+	}
+}
+
+// SettlementSettingUpsertBulk is the builder for "upsert"-ing
+// a bulk of SettlementSetting nodes.
+type SettlementSettingUpsertBulk struct {
+	create *SettlementSettingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SettlementSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(settlementsetting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SettlementSettingUpsertBulk) UpdateNewValues() *SettlementSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(settlementsetting.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(settlementsetting.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SettlementSetting.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SettlementSettingUpsertBulk) Ignore() *SettlementSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SettlementSettingUpsertBulk) DoNothing() *SettlementSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SettlementSettingCreateBulk.OnConflict
+// documentation for more info.
+func (u *SettlementSettingUpsertBulk) Update(set func(*SettlementSettingUpsert)) *SettlementSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SettlementSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SettlementSettingUpsertBulk) SetTenantID(v uuid.UUID) *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SettlementSettingUpsertBulk) UpdateTenantID() *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetMpesaFeeRate sets the "mpesa_fee_rate" field.
+func (u *SettlementSettingUpsertBulk) SetMpesaFeeRate(v decimal.Decimal) *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.SetMpesaFeeRate(v)
+	})
+}
+
+// AddMpesaFeeRate adds v to the "mpesa_fee_rate" field.
+func (u *SettlementSettingUpsertBulk) AddMpesaFeeRate(v decimal.Decimal) *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.AddMpesaFeeRate(v)
+	})
+}
+
+// UpdateMpesaFeeRate sets the "mpesa_fee_rate" field to the value that was provided on create.
+func (u *SettlementSettingUpsertBulk) UpdateMpesaFeeRate() *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.UpdateMpesaFeeRate()
+	})
+}
+
+// ClearMpesaFeeRate clears the value of the "mpesa_fee_rate" field.
+func (u *SettlementSettingUpsertBulk) ClearMpesaFeeRate() *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.ClearMpesaFeeRate()
+	})
+}
+
+// SetCardFeeRate sets the "card_fee_rate" field.
+func (u *SettlementSettingUpsertBulk) SetCardFeeRate(v decimal.Decimal) *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.SetCardFeeRate(v)
+	})
+}
+
+// AddCardFeeRate adds v to the "card_fee_rate" field.
+func (u *SettlementSettingUpsertBulk) AddCardFeeRate(v decimal.Decimal) *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.AddCardFeeRate(v)
+	})
+}
+
+// UpdateCardFeeRate sets the "card_fee_rate" field to the value that was provided on create.
+func (u *SettlementSettingUpsertBulk) UpdateCardFeeRate() *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.UpdateCardFeeRate()
+	})
+}
+
+// ClearCardFeeRate clears the value of the "card_fee_rate" field.
+func (u *SettlementSettingUpsertBulk) ClearCardFeeRate() *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.ClearCardFeeRate()
+	})
+}
+
+// SetBankFeeRate sets the "bank_fee_rate" field.
+func (u *SettlementSettingUpsertBulk) SetBankFeeRate(v decimal.Decimal) *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.SetBankFeeRate(v)
+	})
+}
+
+// AddBankFeeRate adds v to the "bank_fee_rate" field.
+func (u *SettlementSettingUpsertBulk) AddBankFeeRate(v decimal.Decimal) *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.AddBankFeeRate(v)
+	})
+}
+
+// UpdateBankFeeRate sets the "bank_fee_rate" field to the value that was provided on create.
+func (u *SettlementSettingUpsertBulk) UpdateBankFeeRate() *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.UpdateBankFeeRate()
+	})
+}
+
+// ClearBankFeeRate clears the value of the "bank_fee_rate" field.
+func (u *SettlementSettingUpsertBulk) ClearBankFeeRate() *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.ClearBankFeeRate()
+	})
+}
+
+// SetPayoutMethod sets the "payout_method" field.
+func (u *SettlementSettingUpsertBulk) SetPayoutMethod(v string) *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.SetPayoutMethod(v)
+	})
+}
+
+// UpdatePayoutMethod sets the "payout_method" field to the value that was provided on create.
+func (u *SettlementSettingUpsertBulk) UpdatePayoutMethod() *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.UpdatePayoutMethod()
+	})
+}
+
+// ClearPayoutMethod clears the value of the "payout_method" field.
+func (u *SettlementSettingUpsertBulk) ClearPayoutMethod() *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.ClearPayoutMethod()
+	})
+}
+
+// SetPayoutParty sets the "payout_party" field.
+func (u *SettlementSettingUpsertBulk) SetPayoutParty(v string) *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.SetPayoutParty(v)
+	})
+}
+
+// UpdatePayoutParty sets the "payout_party" field to the value that was provided on create.
+func (u *SettlementSettingUpsertBulk) UpdatePayoutParty() *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.UpdatePayoutParty()
+	})
+}
+
+// ClearPayoutParty clears the value of the "payout_party" field.
+func (u *SettlementSettingUpsertBulk) ClearPayoutParty() *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.ClearPayoutParty()
+	})
+}
+
+// SetPayoutAccountReference sets the "payout_account_reference" field.
+func (u *SettlementSettingUpsertBulk) SetPayoutAccountReference(v string) *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.SetPayoutAccountReference(v)
+	})
+}
+
+// UpdatePayoutAccountReference sets the "payout_account_reference" field to the value that was provided on create.
+func (u *SettlementSettingUpsertBulk) UpdatePayoutAccountReference() *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.UpdatePayoutAccountReference()
+	})
+}
+
+// ClearPayoutAccountReference clears the value of the "payout_account_reference" field.
+func (u *SettlementSettingUpsertBulk) ClearPayoutAccountReference() *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.ClearPayoutAccountReference()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SettlementSettingUpsertBulk) SetUpdatedAt(v time.Time) *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SettlementSettingUpsertBulk) UpdateUpdatedAt() *SettlementSettingUpsertBulk {
+	return u.Update(func(s *SettlementSettingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SettlementSettingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SettlementSettingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SettlementSettingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SettlementSettingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
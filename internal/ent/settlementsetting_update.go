@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/settlementsetting"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SettlementSettingUpdate is the builder for updating SettlementSetting entities.
+type SettlementSettingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SettlementSettingMutation
+}
+
+// Where appends a list predicates to the SettlementSettingUpdate builder.
+func (_u *SettlementSettingUpdate) Where(ps ...predicate.SettlementSetting) *SettlementSettingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *SettlementSettingUpdate) SetTenantID(v uuid.UUID) *SettlementSettingUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *SettlementSettingUpdate) SetNillableTenantID(v *uuid.UUID) *SettlementSettingUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetMpesaFeeRate sets the "mpesa_fee_rate" field.
+func (_u *SettlementSettingUpdate) SetMpesaFeeRate(v decimal.Decimal) *SettlementSettingUpdate {
+	_u.mutation.ResetMpesaFeeRate()
+	_u.mutation.SetMpesaFeeRate(v)
+	return _u
+}
+
+// SetNillableMpesaFeeRate sets the "mpesa_fee_rate" field if the given value is not nil.
+func (_u *SettlementSettingUpdate) SetNillableMpesaFeeRate(v *decimal.Decimal) *SettlementSettingUpdate {
+	if v != nil {
+		_u.SetMpesaFeeRate(*v)
+	}
+	return _u
+}
+
+// AddMpesaFeeRate adds value to the "mpesa_fee_rate" field.
+func (_u *SettlementSettingUpdate) AddMpesaFeeRate(v decimal.Decimal) *SettlementSettingUpdate {
+	_u.mutation.AddMpesaFeeRate(v)
+	return _u
+}
+
+// ClearMpesaFeeRate clears the value of the "mpesa_fee_rate" field.
+func (_u *SettlementSettingUpdate) ClearMpesaFeeRate() *SettlementSettingUpdate {
+	_u.mutation.ClearMpesaFeeRate()
+	return _u
+}
+
+// SetCardFeeRate sets the "card_fee_rate" field.
+func (_u *SettlementSettingUpdate) SetCardFeeRate(v decimal.Decimal) *SettlementSettingUpdate {
+	_u.mutation.ResetCardFeeRate()
+	_u.mutation.SetCardFeeRate(v)
+	return _u
+}
+
+// SetNillableCardFeeRate sets the "card_fee_rate" field if the given value is not nil.
+func (_u *SettlementSettingUpdate) SetNillableCardFeeRate(v *decimal.Decimal) *SettlementSettingUpdate {
+	if v != nil {
+		_u.SetCardFeeRate(*v)
+	}
+	return _u
+}
+
+// AddCardFeeRate adds value to the "card_fee_rate" field.
+func (_u *SettlementSettingUpdate) AddCardFeeRate(v decimal.Decimal) *SettlementSettingUpdate {
+	_u.mutation.AddCardFeeRate(v)
+	return _u
+}
+
+// ClearCardFeeRate clears the value of the "card_fee_rate" field.
+func (_u *SettlementSettingUpdate) ClearCardFeeRate() *SettlementSettingUpdate {
+	_u.mutation.ClearCardFeeRate()
+	return _u
+}
+
+// SetBankFeeRate sets the "bank_fee_rate" field.
+func (_u *SettlementSettingUpdate) SetBankFeeRate(v decimal.Decimal) *SettlementSettingUpdate {
+	_u.mutation.ResetBankFeeRate()
+	_u.mutation.SetBankFeeRate(v)
+	return _u
+}
+
+// SetNillableBankFeeRate sets the "bank_fee_rate" field if the given value is not nil.
+func (_u *SettlementSettingUpdate) SetNillableBankFeeRate(v *decimal.Decimal) *SettlementSettingUpdate {
+	if v != nil {
+		_u.SetBankFeeRate(*v)
+	}
+	return _u
+}
+
+// AddBankFeeRate adds value to the "bank_fee_rate" field.
+func (_u *SettlementSettingUpdate) AddBankFeeRate(v decimal.Decimal) *SettlementSettingUpdate {
+	_u.mutation.AddBankFeeRate(v)
+	return _u
+}
+
+// ClearBankFeeRate clears the value of the "bank_fee_rate" field.
+func (_u *SettlementSettingUpdate) ClearBankFeeRate() *SettlementSettingUpdate {
+	_u.mutation.ClearBankFeeRate()
+	return _u
+}
+
+// SetPayoutMethod sets the "payout_method" field.
+func (_u *SettlementSettingUpdate) SetPayoutMethod(v string) *SettlementSettingUpdate {
+	_u.mutation.SetPayoutMethod(v)
+	return _u
+}
+
+// SetNillablePayoutMethod sets the "payout_method" field if the given value is not nil.
+func (_u *SettlementSettingUpdate) SetNillablePayoutMethod(v *string) *SettlementSettingUpdate {
+	if v != nil {
+		_u.SetPayoutMethod(*v)
+	}
+	return _u
+}
+
+// ClearPayoutMethod clears the value of the "payout_method" field.
+func (_u *SettlementSettingUpdate) ClearPayoutMethod() *SettlementSettingUpdate {
+	_u.mutation.ClearPayoutMethod()
+	return _u
+}
+
+// SetPayoutParty sets the "payout_party" field.
+func (_u *SettlementSettingUpdate) SetPayoutParty(v string) *SettlementSettingUpdate {
+	_u.mutation.SetPayoutParty(v)
+	return _u
+}
+
+// SetNillablePayoutParty sets the "payout_party" field if the given value is not nil.
+func (_u *SettlementSettingUpdate) SetNillablePayoutParty(v *string) *SettlementSettingUpdate {
+	if v != nil {
+		_u.SetPayoutParty(*v)
+	}
+	return _u
+}
+
+// ClearPayoutParty clears the value of the "payout_party" field.
+func (_u *SettlementSettingUpdate) ClearPayoutParty() *SettlementSettingUpdate {
+	_u.mutation.ClearPayoutParty()
+	return _u
+}
+
+// SetPayoutAccountReference sets the "payout_account_reference" field.
+func (_u *SettlementSettingUpdate) SetPayoutAccountReference(v string) *SettlementSettingUpdate {
+	_u.mutation.SetPayoutAccountReference(v)
+	return _u
+}
+
+// SetNillablePayoutAccountReference sets the "payout_account_reference" field if the given value is not nil.
+func (_u *SettlementSettingUpdate) SetNillablePayoutAccountReference(v *string) *SettlementSettingUpdate {
+	if v != nil {
+		_u.SetPayoutAccountReference(*v)
+	}
+	return _u
+}
+
+// ClearPayoutAccountReference clears the value of the "payout_account_reference" field.
+func (_u *SettlementSettingUpdate) ClearPayoutAccountReference() *SettlementSettingUpdate {
+	_u.mutation.ClearPayoutAccountReference()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SettlementSettingUpdate) SetUpdatedAt(v time.Time) *SettlementSettingUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SettlementSettingMutation object of the builder.
+func (_u *SettlementSettingUpdate) Mutation() *SettlementSettingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SettlementSettingUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SettlementSettingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SettlementSettingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SettlementSettingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SettlementSettingUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := settlementsetting.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *SettlementSettingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(settlementsetting.Table, settlementsetting.Columns, sqlgraph.NewFieldSpec(settlementsetting.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(settlementsetting.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.MpesaFeeRate(); ok {
+		_spec.SetField(settlementsetting.FieldMpesaFeeRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMpesaFeeRate(); ok {
+		_spec.AddField(settlementsetting.FieldMpesaFeeRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.MpesaFeeRateCleared() {
+		_spec.ClearField(settlementsetting.FieldMpesaFeeRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CardFeeRate(); ok {
+		_spec.SetField(settlementsetting.FieldCardFeeRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCardFeeRate(); ok {
+		_spec.AddField(settlementsetting.FieldCardFeeRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.CardFeeRateCleared() {
+		_spec.ClearField(settlementsetting.FieldCardFeeRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.BankFeeRate(); ok {
+		_spec.SetField(settlementsetting.FieldBankFeeRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBankFeeRate(); ok {
+		_spec.AddField(settlementsetting.FieldBankFeeRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.BankFeeRateCleared() {
+		_spec.ClearField(settlementsetting.FieldBankFeeRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.PayoutMethod(); ok {
+		_spec.SetField(settlementsetting.FieldPayoutMethod, field.TypeString, value)
+	}
+	if _u.mutation.PayoutMethodCleared() {
+		_spec.ClearField(settlementsetting.FieldPayoutMethod, field.TypeString)
+	}
+	if value, ok := _u.mutation.PayoutParty(); ok {
+		_spec.SetField(settlementsetting.FieldPayoutParty, field.TypeString, value)
+	}
+	if _u.mutation.PayoutPartyCleared() {
+		_spec.ClearField(settlementsetting.FieldPayoutParty, field.TypeString)
+	}
+	if value, ok := _u.mutation.PayoutAccountReference(); ok {
+		_spec.SetField(settlementsetting.FieldPayoutAccountReference, field.TypeString, value)
+	}
+	if _u.mutation.PayoutAccountReferenceCleared() {
+		_spec.ClearField(settlementsetting.FieldPayoutAccountReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(settlementsetting.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{settlementsetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SettlementSettingUpdateOne is the builder for updating a single SettlementSetting entity.
+type SettlementSettingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SettlementSettingMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *SettlementSettingUpdateOne) SetTenantID(v uuid.UUID) *SettlementSettingUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *SettlementSettingUpdateOne) SetNillableTenantID(v *uuid.UUID) *SettlementSettingUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetMpesaFeeRate sets the "mpesa_fee_rate" field.
+func (_u *SettlementSettingUpdateOne) SetMpesaFeeRate(v decimal.Decimal) *SettlementSettingUpdateOne {
+	_u.mutation.ResetMpesaFeeRate()
+	_u.mutation.SetMpesaFeeRate(v)
+	return _u
+}
+
+// SetNillableMpesaFeeRate sets the "mpesa_fee_rate" field if the given value is not nil.
+func (_u *SettlementSettingUpdateOne) SetNillableMpesaFeeRate(v *decimal.Decimal) *SettlementSettingUpdateOne {
+	if v != nil {
+		_u.SetMpesaFeeRate(*v)
+	}
+	return _u
+}
+
+// AddMpesaFeeRate adds value to the "mpesa_fee_rate" field.
+func (_u *SettlementSettingUpdateOne) AddMpesaFeeRate(v decimal.Decimal) *SettlementSettingUpdateOne {
+	_u.mutation.AddMpesaFeeRate(v)
+	return _u
+}
+
+// ClearMpesaFeeRate clears the value of the "mpesa_fee_rate" field.
+func (_u *SettlementSettingUpdateOne) ClearMpesaFeeRate() *SettlementSettingUpdateOne {
+	_u.mutation.ClearMpesaFeeRate()
+	return _u
+}
+
+// SetCardFeeRate sets the "card_fee_rate" field.
+func (_u *SettlementSettingUpdateOne) SetCardFeeRate(v decimal.Decimal) *SettlementSettingUpdateOne {
+	_u.mutation.ResetCardFeeRate()
+	_u.mutation.SetCardFeeRate(v)
+	return _u
+}
+
+// SetNillableCardFeeRate sets the "card_fee_rate" field if the given value is not nil.
+func (_u *SettlementSettingUpdateOne) SetNillableCardFeeRate(v *decimal.Decimal) *SettlementSettingUpdateOne {
+	if v != nil {
+		_u.SetCardFeeRate(*v)
+	}
+	return _u
+}
+
+// AddCardFeeRate adds value to the "card_fee_rate" field.
+func (_u *SettlementSettingUpdateOne) AddCardFeeRate(v decimal.Decimal) *SettlementSettingUpdateOne {
+	_u.mutation.AddCardFeeRate(v)
+	return _u
+}
+
+// ClearCardFeeRate clears the value of the "card_fee_rate" field.
+func (_u *SettlementSettingUpdateOne) ClearCardFeeRate() *SettlementSettingUpdateOne {
+	_u.mutation.ClearCardFeeRate()
+	return _u
+}
+
+// SetBankFeeRate sets the "bank_fee_rate" field.
+func (_u *SettlementSettingUpdateOne) SetBankFeeRate(v decimal.Decimal) *SettlementSettingUpdateOne {
+	_u.mutation.ResetBankFeeRate()
+	_u.mutation.SetBankFeeRate(v)
+	return _u
+}
+
+// SetNillableBankFeeRate sets the "bank_fee_rate" field if the given value is not nil.
+func (_u *SettlementSettingUpdateOne) SetNillableBankFeeRate(v *decimal.Decimal) *SettlementSettingUpdateOne {
+	if v != nil {
+		_u.SetBankFeeRate(*v)
+	}
+	return _u
+}
+
+// AddBankFeeRate adds value to the "bank_fee_rate" field.
+func (_u *SettlementSettingUpdateOne) AddBankFeeRate(v decimal.Decimal) *SettlementSettingUpdateOne {
+	_u.mutation.AddBankFeeRate(v)
+	return _u
+}
+
+// ClearBankFeeRate clears the value of the "bank_fee_rate" field.
+func (_u *SettlementSettingUpdateOne) ClearBankFeeRate() *SettlementSettingUpdateOne {
+	_u.mutation.ClearBankFeeRate()
+	return _u
+}
+
+// SetPayoutMethod sets the "payout_method" field.
+func (_u *SettlementSettingUpdateOne) SetPayoutMethod(v string) *SettlementSettingUpdateOne {
+	_u.mutation.SetPayoutMethod(v)
+	return _u
+}
+
+// SetNillablePayoutMethod sets the "payout_method" field if the given value is not nil.
+func (_u *SettlementSettingUpdateOne) SetNillablePayoutMethod(v *string) *SettlementSettingUpdateOne {
+	if v != nil {
+		_u.SetPayoutMethod(*v)
+	}
+	return _u
+}
+
+// ClearPayoutMethod clears the value of the "payout_method" field.
+func (_u *SettlementSettingUpdateOne) ClearPayoutMethod() *SettlementSettingUpdateOne {
+	_u.mutation.ClearPayoutMethod()
+	return _u
+}
+
+// SetPayoutParty sets the "payout_party" field.
+func (_u *SettlementSettingUpdateOne) SetPayoutParty(v string) *SettlementSettingUpdateOne {
+	_u.mutation.SetPayoutParty(v)
+	return _u
+}
+
+// SetNillablePayoutParty sets the "payout_party" field if the given value is not nil.
+func (_u *SettlementSettingUpdateOne) SetNillablePayoutParty(v *string) *SettlementSettingUpdateOne {
+	if v != nil {
+		_u.SetPayoutParty(*v)
+	}
+	return _u
+}
+
+// ClearPayoutParty clears the value of the "payout_party" field.
+func (_u *SettlementSettingUpdateOne) ClearPayoutParty() *SettlementSettingUpdateOne {
+	_u.mutation.ClearPayoutParty()
+	return _u
+}
+
+// SetPayoutAccountReference sets the "payout_account_reference" field.
+func (_u *SettlementSettingUpdateOne) SetPayoutAccountReference(v string) *SettlementSettingUpdateOne {
+	_u.mutation.SetPayoutAccountReference(v)
+	return _u
+}
+
+// SetNillablePayoutAccountReference sets the "payout_account_reference" field if the given value is not nil.
+func (_u *SettlementSettingUpdateOne) SetNillablePayoutAccountReference(v *string) *SettlementSettingUpdateOne {
+	if v != nil {
+		_u.SetPayoutAccountReference(*v)
+	}
+	return _u
+}
+
+// ClearPayoutAccountReference clears the value of the "payout_account_reference" field.
+func (_u *SettlementSettingUpdateOne) ClearPayoutAccountReference() *SettlementSettingUpdateOne {
+	_u.mutation.ClearPayoutAccountReference()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SettlementSettingUpdateOne) SetUpdatedAt(v time.Time) *SettlementSettingUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SettlementSettingMutation object of the builder.
+func (_u *SettlementSettingUpdateOne) Mutation() *SettlementSettingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SettlementSettingUpdate builder.
+func (_u *SettlementSettingUpdateOne) Where(ps ...predicate.SettlementSetting) *SettlementSettingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SettlementSettingUpdateOne) Select(field string, fields ...string) *SettlementSettingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SettlementSetting entity.
+func (_u *SettlementSettingUpdateOne) Save(ctx context.Context) (*SettlementSetting, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SettlementSettingUpdateOne) SaveX(ctx context.Context) *SettlementSetting {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SettlementSettingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SettlementSettingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SettlementSettingUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := settlementsetting.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *SettlementSettingUpdateOne) sqlSave(ctx context.Context) (_node *SettlementSetting, err error) {
+	_spec := sqlgraph.NewUpdateSpec(settlementsetting.Table, settlementsetting.Columns, sqlgraph.NewFieldSpec(settlementsetting.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SettlementSetting.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, settlementsetting.FieldID)
+		for _, f := range fields {
+			if !settlementsetting.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != settlementsetting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(settlementsetting.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.MpesaFeeRate(); ok {
+		_spec.SetField(settlementsetting.FieldMpesaFeeRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMpesaFeeRate(); ok {
+		_spec.AddField(settlementsetting.FieldMpesaFeeRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.MpesaFeeRateCleared() {
+		_spec.ClearField(settlementsetting.FieldMpesaFeeRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CardFeeRate(); ok {
+		_spec.SetField(settlementsetting.FieldCardFeeRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCardFeeRate(); ok {
+		_spec.AddField(settlementsetting.FieldCardFeeRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.CardFeeRateCleared() {
+		_spec.ClearField(settlementsetting.FieldCardFeeRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.BankFeeRate(); ok {
+		_spec.SetField(settlementsetting.FieldBankFeeRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBankFeeRate(); ok {
+		_spec.AddField(settlementsetting.FieldBankFeeRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.BankFeeRateCleared() {
+		_spec.ClearField(settlementsetting.FieldBankFeeRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.PayoutMethod(); ok {
+		_spec.SetField(settlementsetting.FieldPayoutMethod, field.TypeString, value)
+	}
+	if _u.mutation.PayoutMethodCleared() {
+		_spec.ClearField(settlementsetting.FieldPayoutMethod, field.TypeString)
+	}
+	if value, ok := _u.mutation.PayoutParty(); ok {
+		_spec.SetField(settlementsetting.FieldPayoutParty, field.TypeString, value)
+	}
+	if _u.mutation.PayoutPartyCleared() {
+		_spec.ClearField(settlementsetting.FieldPayoutParty, field.TypeString)
+	}
+	if value, ok := _u.mutation.PayoutAccountReference(); ok {
+		_spec.SetField(settlementsetting.FieldPayoutAccountReference, field.TypeString, value)
+	}
+	if _u.mutation.PayoutAccountReferenceCleared() {
+		_spec.ClearField(settlementsetting.FieldPayoutAccountReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(settlementsetting.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &SettlementSetting{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{settlementsetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
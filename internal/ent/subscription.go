@@ -0,0 +1,408 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/subscription"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Subscription is the model entity for the Subscription schema.
+type Subscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Customer identifier
+	CustomerID uuid.UUID `json:"customer_id,omitempty"`
+	// Plan code from the subscribing service
+	PlanCode string `json:"plan_code,omitempty"`
+	// Plan display name used on invoice lines
+	PlanName string `json:"plan_name,omitempty"`
+	// Price per billing interval
+	Price decimal.Decimal `json:"price,omitempty"`
+	// ISO currency code
+	Currency string `json:"currency,omitempty"`
+	// Billing interval: day, week, month, year
+	BillingInterval string `json:"billing_interval,omitempty"`
+	// Number of intervals per billing cycle
+	IntervalCount int `json:"interval_count,omitempty"`
+	// Day of month cycles renew on (monthly/yearly intervals)
+	BillingAnchorDay int `json:"billing_anchor_day,omitempty"`
+	// Payment terms for generated invoices
+	DaysUntilDue int `json:"days_until_due,omitempty"`
+	// Status: trialing, active, cancelled
+	Status string `json:"status,omitempty"`
+	// Trial period end
+	TrialEnd time.Time `json:"trial_end,omitempty"`
+	// Current billing period start
+	CurrentPeriodStart time.Time `json:"current_period_start,omitempty"`
+	// Current billing period end (exclusive)
+	CurrentPeriodEnd time.Time `json:"current_period_end,omitempty"`
+	// When the billing worker next needs to act on the subscription
+	NextBillingAt time.Time `json:"next_billing_at,omitempty"`
+	// Cancel when the current period ends instead of renewing
+	CancelAtPeriodEnd bool `json:"cancel_at_period_end,omitempty"`
+	// When cancellation was requested
+	CancelledAt time.Time `json:"cancelled_at,omitempty"`
+	// When the subscription stopped renewing
+	EndedAt time.Time `json:"ended_at,omitempty"`
+	// Subscription identifier in the subscribing service
+	ReferenceID string `json:"reference_id,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SubscriptionQuery when eager-loading is set.
+	Edges        SubscriptionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SubscriptionEdges holds the relations/edges for other nodes in the graph.
+type SubscriptionEdges struct {
+	// BillingCycles holds the value of the billing_cycles edge.
+	BillingCycles []*BillingCycle `json:"billing_cycles,omitempty"`
+	// Adjustments holds the value of the adjustments edge.
+	Adjustments []*SubscriptionAdjustment `json:"adjustments,omitempty"`
+	// Meters holds the value of the meters edge.
+	Meters []*SubscriptionMeter `json:"meters,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// BillingCyclesOrErr returns the BillingCycles value or an error if the edge
+// was not loaded in eager-loading.
+func (e SubscriptionEdges) BillingCyclesOrErr() ([]*BillingCycle, error) {
+	if e.loadedTypes[0] {
+		return e.BillingCycles, nil
+	}
+	return nil, &NotLoadedError{edge: "billing_cycles"}
+}
+
+// AdjustmentsOrErr returns the Adjustments value or an error if the edge
+// was not loaded in eager-loading.
+func (e SubscriptionEdges) AdjustmentsOrErr() ([]*SubscriptionAdjustment, error) {
+	if e.loadedTypes[1] {
+		return e.Adjustments, nil
+	}
+	return nil, &NotLoadedError{edge: "adjustments"}
+}
+
+// MetersOrErr returns the Meters value or an error if the edge
+// was not loaded in eager-loading.
+func (e SubscriptionEdges) MetersOrErr() ([]*SubscriptionMeter, error) {
+	if e.loadedTypes[2] {
+		return e.Meters, nil
+	}
+	return nil, &NotLoadedError{edge: "meters"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Subscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case subscription.FieldMetadata:
+			values[i] = new([]byte)
+		case subscription.FieldPrice:
+			values[i] = new(decimal.Decimal)
+		case subscription.FieldCancelAtPeriodEnd:
+			values[i] = new(sql.NullBool)
+		case subscription.FieldIntervalCount, subscription.FieldBillingAnchorDay, subscription.FieldDaysUntilDue:
+			values[i] = new(sql.NullInt64)
+		case subscription.FieldPlanCode, subscription.FieldPlanName, subscription.FieldCurrency, subscription.FieldBillingInterval, subscription.FieldStatus, subscription.FieldReferenceID:
+			values[i] = new(sql.NullString)
+		case subscription.FieldTrialEnd, subscription.FieldCurrentPeriodStart, subscription.FieldCurrentPeriodEnd, subscription.FieldNextBillingAt, subscription.FieldCancelledAt, subscription.FieldEndedAt, subscription.FieldCreatedAt, subscription.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case subscription.FieldID, subscription.FieldTenantID, subscription.FieldCustomerID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Subscription fields.
+func (_m *Subscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case subscription.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case subscription.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case subscription.FieldCustomerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value != nil {
+				_m.CustomerID = *value
+			}
+		case subscription.FieldPlanCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_code", values[i])
+			} else if value.Valid {
+				_m.PlanCode = value.String
+			}
+		case subscription.FieldPlanName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_name", values[i])
+			} else if value.Valid {
+				_m.PlanName = value.String
+			}
+		case subscription.FieldPrice:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value != nil {
+				_m.Price = *value
+			}
+		case subscription.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case subscription.FieldBillingInterval:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_interval", values[i])
+			} else if value.Valid {
+				_m.BillingInterval = value.String
+			}
+		case subscription.FieldIntervalCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field interval_count", values[i])
+			} else if value.Valid {
+				_m.IntervalCount = int(value.Int64)
+			}
+		case subscription.FieldBillingAnchorDay:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_anchor_day", values[i])
+			} else if value.Valid {
+				_m.BillingAnchorDay = int(value.Int64)
+			}
+		case subscription.FieldDaysUntilDue:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field days_until_due", values[i])
+			} else if value.Valid {
+				_m.DaysUntilDue = int(value.Int64)
+			}
+		case subscription.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case subscription.FieldTrialEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field trial_end", values[i])
+			} else if value.Valid {
+				_m.TrialEnd = value.Time
+			}
+		case subscription.FieldCurrentPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field current_period_start", values[i])
+			} else if value.Valid {
+				_m.CurrentPeriodStart = value.Time
+			}
+		case subscription.FieldCurrentPeriodEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field current_period_end", values[i])
+			} else if value.Valid {
+				_m.CurrentPeriodEnd = value.Time
+			}
+		case subscription.FieldNextBillingAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_billing_at", values[i])
+			} else if value.Valid {
+				_m.NextBillingAt = value.Time
+			}
+		case subscription.FieldCancelAtPeriodEnd:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cancel_at_period_end", values[i])
+			} else if value.Valid {
+				_m.CancelAtPeriodEnd = value.Bool
+			}
+		case subscription.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				_m.CancelledAt = value.Time
+			}
+		case subscription.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				_m.EndedAt = value.Time
+			}
+		case subscription.FieldReferenceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference_id", values[i])
+			} else if value.Valid {
+				_m.ReferenceID = value.String
+			}
+		case subscription.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case subscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case subscription.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Subscription.
+// This includes values selected through modifiers, order, etc.
+func (_m *Subscription) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBillingCycles queries the "billing_cycles" edge of the Subscription entity.
+func (_m *Subscription) QueryBillingCycles() *BillingCycleQuery {
+	return NewSubscriptionClient(_m.config).QueryBillingCycles(_m)
+}
+
+// QueryAdjustments queries the "adjustments" edge of the Subscription entity.
+func (_m *Subscription) QueryAdjustments() *SubscriptionAdjustmentQuery {
+	return NewSubscriptionClient(_m.config).QueryAdjustments(_m)
+}
+
+// QueryMeters queries the "meters" edge of the Subscription entity.
+func (_m *Subscription) QueryMeters() *SubscriptionMeterQuery {
+	return NewSubscriptionClient(_m.config).QueryMeters(_m)
+}
+
+// Update returns a builder for updating this Subscription.
+// Note that you need to call Subscription.Unwrap() before calling this method if this Subscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Subscription) Update() *SubscriptionUpdateOne {
+	return NewSubscriptionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Subscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Subscription) Unwrap() *Subscription {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Subscription is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Subscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("Subscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("customer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CustomerID))
+	builder.WriteString(", ")
+	builder.WriteString("plan_code=")
+	builder.WriteString(_m.PlanCode)
+	builder.WriteString(", ")
+	builder.WriteString("plan_name=")
+	builder.WriteString(_m.PlanName)
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Price))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("billing_interval=")
+	builder.WriteString(_m.BillingInterval)
+	builder.WriteString(", ")
+	builder.WriteString("interval_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IntervalCount))
+	builder.WriteString(", ")
+	builder.WriteString("billing_anchor_day=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BillingAnchorDay))
+	builder.WriteString(", ")
+	builder.WriteString("days_until_due=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DaysUntilDue))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("trial_end=")
+	builder.WriteString(_m.TrialEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("current_period_start=")
+	builder.WriteString(_m.CurrentPeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("current_period_end=")
+	builder.WriteString(_m.CurrentPeriodEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("next_billing_at=")
+	builder.WriteString(_m.NextBillingAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("cancel_at_period_end=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CancelAtPeriodEnd))
+	builder.WriteString(", ")
+	builder.WriteString("cancelled_at=")
+	builder.WriteString(_m.CancelledAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ended_at=")
+	builder.WriteString(_m.EndedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("reference_id=")
+	builder.WriteString(_m.ReferenceID)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Subscriptions is a parsable slice of Subscription.
+type Subscriptions []*Subscription
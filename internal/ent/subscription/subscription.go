@@ -0,0 +1,337 @@
+// Code generated by ent, DO NOT EDIT.
+
+package subscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the subscription type in the database.
+	Label = "subscription"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCustomerID holds the string denoting the customer_id field in the database.
+	FieldCustomerID = "customer_id"
+	// FieldPlanCode holds the string denoting the plan_code field in the database.
+	FieldPlanCode = "plan_code"
+	// FieldPlanName holds the string denoting the plan_name field in the database.
+	FieldPlanName = "plan_name"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldBillingInterval holds the string denoting the billing_interval field in the database.
+	FieldBillingInterval = "billing_interval"
+	// FieldIntervalCount holds the string denoting the interval_count field in the database.
+	FieldIntervalCount = "interval_count"
+	// FieldBillingAnchorDay holds the string denoting the billing_anchor_day field in the database.
+	FieldBillingAnchorDay = "billing_anchor_day"
+	// FieldDaysUntilDue holds the string denoting the days_until_due field in the database.
+	FieldDaysUntilDue = "days_until_due"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldTrialEnd holds the string denoting the trial_end field in the database.
+	FieldTrialEnd = "trial_end"
+	// FieldCurrentPeriodStart holds the string denoting the current_period_start field in the database.
+	FieldCurrentPeriodStart = "current_period_start"
+	// FieldCurrentPeriodEnd holds the string denoting the current_period_end field in the database.
+	FieldCurrentPeriodEnd = "current_period_end"
+	// FieldNextBillingAt holds the string denoting the next_billing_at field in the database.
+	FieldNextBillingAt = "next_billing_at"
+	// FieldCancelAtPeriodEnd holds the string denoting the cancel_at_period_end field in the database.
+	FieldCancelAtPeriodEnd = "cancel_at_period_end"
+	// FieldCancelledAt holds the string denoting the cancelled_at field in the database.
+	FieldCancelledAt = "cancelled_at"
+	// FieldEndedAt holds the string denoting the ended_at field in the database.
+	FieldEndedAt = "ended_at"
+	// FieldReferenceID holds the string denoting the reference_id field in the database.
+	FieldReferenceID = "reference_id"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeBillingCycles holds the string denoting the billing_cycles edge name in mutations.
+	EdgeBillingCycles = "billing_cycles"
+	// EdgeAdjustments holds the string denoting the adjustments edge name in mutations.
+	EdgeAdjustments = "adjustments"
+	// EdgeMeters holds the string denoting the meters edge name in mutations.
+	EdgeMeters = "meters"
+	// Table holds the table name of the subscription in the database.
+	Table = "subscriptions"
+	// BillingCyclesTable is the table that holds the billing_cycles relation/edge.
+	BillingCyclesTable = "billing_cycles"
+	// BillingCyclesInverseTable is the table name for the BillingCycle entity.
+	// It exists in this package in order to avoid circular dependency with the "billingcycle" package.
+	BillingCyclesInverseTable = "billing_cycles"
+	// BillingCyclesColumn is the table column denoting the billing_cycles relation/edge.
+	BillingCyclesColumn = "subscription_id"
+	// AdjustmentsTable is the table that holds the adjustments relation/edge.
+	AdjustmentsTable = "subscription_adjustments"
+	// AdjustmentsInverseTable is the table name for the SubscriptionAdjustment entity.
+	// It exists in this package in order to avoid circular dependency with the "subscriptionadjustment" package.
+	AdjustmentsInverseTable = "subscription_adjustments"
+	// AdjustmentsColumn is the table column denoting the adjustments relation/edge.
+	AdjustmentsColumn = "subscription_id"
+	// MetersTable is the table that holds the meters relation/edge.
+	MetersTable = "subscription_meters"
+	// MetersInverseTable is the table name for the SubscriptionMeter entity.
+	// It exists in this package in order to avoid circular dependency with the "subscriptionmeter" package.
+	MetersInverseTable = "subscription_meters"
+	// MetersColumn is the table column denoting the meters relation/edge.
+	MetersColumn = "subscription_id"
+)
+
+// Columns holds all SQL columns for subscription fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCustomerID,
+	FieldPlanCode,
+	FieldPlanName,
+	FieldPrice,
+	FieldCurrency,
+	FieldBillingInterval,
+	FieldIntervalCount,
+	FieldBillingAnchorDay,
+	FieldDaysUntilDue,
+	FieldStatus,
+	FieldTrialEnd,
+	FieldCurrentPeriodStart,
+	FieldCurrentPeriodEnd,
+	FieldNextBillingAt,
+	FieldCancelAtPeriodEnd,
+	FieldCancelledAt,
+	FieldEndedAt,
+	FieldReferenceID,
+	FieldMetadata,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PlanCodeValidator is a validator for the "plan_code" field. It is called by the builders before save.
+	PlanCodeValidator func(string) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultBillingInterval holds the default value on creation for the "billing_interval" field.
+	DefaultBillingInterval string
+	// DefaultIntervalCount holds the default value on creation for the "interval_count" field.
+	DefaultIntervalCount int
+	// IntervalCountValidator is a validator for the "interval_count" field. It is called by the builders before save.
+	IntervalCountValidator func(int) error
+	// BillingAnchorDayValidator is a validator for the "billing_anchor_day" field. It is called by the builders before save.
+	BillingAnchorDayValidator func(int) error
+	// DefaultDaysUntilDue holds the default value on creation for the "days_until_due" field.
+	DefaultDaysUntilDue int
+	// DaysUntilDueValidator is a validator for the "days_until_due" field. It is called by the builders before save.
+	DaysUntilDueValidator func(int) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCancelAtPeriodEnd holds the default value on creation for the "cancel_at_period_end" field.
+	DefaultCancelAtPeriodEnd bool
+	// DefaultMetadata holds the default value on creation for the "metadata" field.
+	DefaultMetadata map[string]interface{}
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Subscription queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByCustomerID orders the results by the customer_id field.
+func ByCustomerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCustomerID, opts...).ToFunc()
+}
+
+// ByPlanCode orders the results by the plan_code field.
+func ByPlanCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlanCode, opts...).ToFunc()
+}
+
+// ByPlanName orders the results by the plan_name field.
+func ByPlanName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlanName, opts...).ToFunc()
+}
+
+// ByPrice orders the results by the price field.
+func ByPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrice, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByBillingInterval orders the results by the billing_interval field.
+func ByBillingInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillingInterval, opts...).ToFunc()
+}
+
+// ByIntervalCount orders the results by the interval_count field.
+func ByIntervalCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIntervalCount, opts...).ToFunc()
+}
+
+// ByBillingAnchorDay orders the results by the billing_anchor_day field.
+func ByBillingAnchorDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillingAnchorDay, opts...).ToFunc()
+}
+
+// ByDaysUntilDue orders the results by the days_until_due field.
+func ByDaysUntilDue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDaysUntilDue, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByTrialEnd orders the results by the trial_end field.
+func ByTrialEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrialEnd, opts...).ToFunc()
+}
+
+// ByCurrentPeriodStart orders the results by the current_period_start field.
+func ByCurrentPeriodStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrentPeriodStart, opts...).ToFunc()
+}
+
+// ByCurrentPeriodEnd orders the results by the current_period_end field.
+func ByCurrentPeriodEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrentPeriodEnd, opts...).ToFunc()
+}
+
+// ByNextBillingAt orders the results by the next_billing_at field.
+func ByNextBillingAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextBillingAt, opts...).ToFunc()
+}
+
+// ByCancelAtPeriodEnd orders the results by the cancel_at_period_end field.
+func ByCancelAtPeriodEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelAtPeriodEnd, opts...).ToFunc()
+}
+
+// ByCancelledAt orders the results by the cancelled_at field.
+func ByCancelledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledAt, opts...).ToFunc()
+}
+
+// ByEndedAt orders the results by the ended_at field.
+func ByEndedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndedAt, opts...).ToFunc()
+}
+
+// ByReferenceID orders the results by the reference_id field.
+func ByReferenceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferenceID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByBillingCyclesCount orders the results by billing_cycles count.
+func ByBillingCyclesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBillingCyclesStep(), opts...)
+	}
+}
+
+// ByBillingCycles orders the results by billing_cycles terms.
+func ByBillingCycles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBillingCyclesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAdjustmentsCount orders the results by adjustments count.
+func ByAdjustmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAdjustmentsStep(), opts...)
+	}
+}
+
+// ByAdjustments orders the results by adjustments terms.
+func ByAdjustments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAdjustmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMetersCount orders the results by meters count.
+func ByMetersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMetersStep(), opts...)
+	}
+}
+
+// ByMeters orders the results by meters terms.
+func ByMeters(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMetersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newBillingCyclesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BillingCyclesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BillingCyclesTable, BillingCyclesColumn),
+	)
+}
+func newAdjustmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AdjustmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AdjustmentsTable, AdjustmentsColumn),
+	)
+}
+func newMetersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MetersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MetersTable, MetersColumn),
+	)
+}
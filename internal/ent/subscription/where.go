@@ -0,0 +1,1267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package subscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// PlanCode applies equality check predicate on the "plan_code" field. It's identical to PlanCodeEQ.
+func PlanCode(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPlanCode, v))
+}
+
+// PlanName applies equality check predicate on the "plan_name" field. It's identical to PlanNameEQ.
+func PlanName(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPlanName, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPrice, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrency, v))
+}
+
+// BillingInterval applies equality check predicate on the "billing_interval" field. It's identical to BillingIntervalEQ.
+func BillingInterval(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldBillingInterval, v))
+}
+
+// IntervalCount applies equality check predicate on the "interval_count" field. It's identical to IntervalCountEQ.
+func IntervalCount(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldIntervalCount, v))
+}
+
+// BillingAnchorDay applies equality check predicate on the "billing_anchor_day" field. It's identical to BillingAnchorDayEQ.
+func BillingAnchorDay(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldBillingAnchorDay, v))
+}
+
+// DaysUntilDue applies equality check predicate on the "days_until_due" field. It's identical to DaysUntilDueEQ.
+func DaysUntilDue(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldDaysUntilDue, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldStatus, v))
+}
+
+// TrialEnd applies equality check predicate on the "trial_end" field. It's identical to TrialEndEQ.
+func TrialEnd(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldTrialEnd, v))
+}
+
+// CurrentPeriodStart applies equality check predicate on the "current_period_start" field. It's identical to CurrentPeriodStartEQ.
+func CurrentPeriodStart(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrentPeriodStart, v))
+}
+
+// CurrentPeriodEnd applies equality check predicate on the "current_period_end" field. It's identical to CurrentPeriodEndEQ.
+func CurrentPeriodEnd(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrentPeriodEnd, v))
+}
+
+// NextBillingAt applies equality check predicate on the "next_billing_at" field. It's identical to NextBillingAtEQ.
+func NextBillingAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldNextBillingAt, v))
+}
+
+// CancelAtPeriodEnd applies equality check predicate on the "cancel_at_period_end" field. It's identical to CancelAtPeriodEndEQ.
+func CancelAtPeriodEnd(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCancelAtPeriodEnd, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// ReferenceID applies equality check predicate on the "reference_id" field. It's identical to ReferenceIDEQ.
+func ReferenceID(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldReferenceID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldTenantID, v))
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCustomerID, v))
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDGT applies the GT predicate on the "customer_id" field.
+func CustomerIDGT(v uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCustomerID, v))
+}
+
+// CustomerIDGTE applies the GTE predicate on the "customer_id" field.
+func CustomerIDGTE(v uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCustomerID, v))
+}
+
+// CustomerIDLT applies the LT predicate on the "customer_id" field.
+func CustomerIDLT(v uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCustomerID, v))
+}
+
+// CustomerIDLTE applies the LTE predicate on the "customer_id" field.
+func CustomerIDLTE(v uuid.UUID) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCustomerID, v))
+}
+
+// PlanCodeEQ applies the EQ predicate on the "plan_code" field.
+func PlanCodeEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPlanCode, v))
+}
+
+// PlanCodeNEQ applies the NEQ predicate on the "plan_code" field.
+func PlanCodeNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldPlanCode, v))
+}
+
+// PlanCodeIn applies the In predicate on the "plan_code" field.
+func PlanCodeIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldPlanCode, vs...))
+}
+
+// PlanCodeNotIn applies the NotIn predicate on the "plan_code" field.
+func PlanCodeNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldPlanCode, vs...))
+}
+
+// PlanCodeGT applies the GT predicate on the "plan_code" field.
+func PlanCodeGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldPlanCode, v))
+}
+
+// PlanCodeGTE applies the GTE predicate on the "plan_code" field.
+func PlanCodeGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldPlanCode, v))
+}
+
+// PlanCodeLT applies the LT predicate on the "plan_code" field.
+func PlanCodeLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldPlanCode, v))
+}
+
+// PlanCodeLTE applies the LTE predicate on the "plan_code" field.
+func PlanCodeLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldPlanCode, v))
+}
+
+// PlanCodeContains applies the Contains predicate on the "plan_code" field.
+func PlanCodeContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldPlanCode, v))
+}
+
+// PlanCodeHasPrefix applies the HasPrefix predicate on the "plan_code" field.
+func PlanCodeHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldPlanCode, v))
+}
+
+// PlanCodeHasSuffix applies the HasSuffix predicate on the "plan_code" field.
+func PlanCodeHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldPlanCode, v))
+}
+
+// PlanCodeEqualFold applies the EqualFold predicate on the "plan_code" field.
+func PlanCodeEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldPlanCode, v))
+}
+
+// PlanCodeContainsFold applies the ContainsFold predicate on the "plan_code" field.
+func PlanCodeContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldPlanCode, v))
+}
+
+// PlanNameEQ applies the EQ predicate on the "plan_name" field.
+func PlanNameEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPlanName, v))
+}
+
+// PlanNameNEQ applies the NEQ predicate on the "plan_name" field.
+func PlanNameNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldPlanName, v))
+}
+
+// PlanNameIn applies the In predicate on the "plan_name" field.
+func PlanNameIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldPlanName, vs...))
+}
+
+// PlanNameNotIn applies the NotIn predicate on the "plan_name" field.
+func PlanNameNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldPlanName, vs...))
+}
+
+// PlanNameGT applies the GT predicate on the "plan_name" field.
+func PlanNameGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldPlanName, v))
+}
+
+// PlanNameGTE applies the GTE predicate on the "plan_name" field.
+func PlanNameGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldPlanName, v))
+}
+
+// PlanNameLT applies the LT predicate on the "plan_name" field.
+func PlanNameLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldPlanName, v))
+}
+
+// PlanNameLTE applies the LTE predicate on the "plan_name" field.
+func PlanNameLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldPlanName, v))
+}
+
+// PlanNameContains applies the Contains predicate on the "plan_name" field.
+func PlanNameContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldPlanName, v))
+}
+
+// PlanNameHasPrefix applies the HasPrefix predicate on the "plan_name" field.
+func PlanNameHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldPlanName, v))
+}
+
+// PlanNameHasSuffix applies the HasSuffix predicate on the "plan_name" field.
+func PlanNameHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldPlanName, v))
+}
+
+// PlanNameIsNil applies the IsNil predicate on the "plan_name" field.
+func PlanNameIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldPlanName))
+}
+
+// PlanNameNotNil applies the NotNil predicate on the "plan_name" field.
+func PlanNameNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldPlanName))
+}
+
+// PlanNameEqualFold applies the EqualFold predicate on the "plan_name" field.
+func PlanNameEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldPlanName, v))
+}
+
+// PlanNameContainsFold applies the ContainsFold predicate on the "plan_name" field.
+func PlanNameContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldPlanName, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v decimal.Decimal) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldPrice, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// BillingIntervalEQ applies the EQ predicate on the "billing_interval" field.
+func BillingIntervalEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldBillingInterval, v))
+}
+
+// BillingIntervalNEQ applies the NEQ predicate on the "billing_interval" field.
+func BillingIntervalNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldBillingInterval, v))
+}
+
+// BillingIntervalIn applies the In predicate on the "billing_interval" field.
+func BillingIntervalIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldBillingInterval, vs...))
+}
+
+// BillingIntervalNotIn applies the NotIn predicate on the "billing_interval" field.
+func BillingIntervalNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldBillingInterval, vs...))
+}
+
+// BillingIntervalGT applies the GT predicate on the "billing_interval" field.
+func BillingIntervalGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldBillingInterval, v))
+}
+
+// BillingIntervalGTE applies the GTE predicate on the "billing_interval" field.
+func BillingIntervalGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldBillingInterval, v))
+}
+
+// BillingIntervalLT applies the LT predicate on the "billing_interval" field.
+func BillingIntervalLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldBillingInterval, v))
+}
+
+// BillingIntervalLTE applies the LTE predicate on the "billing_interval" field.
+func BillingIntervalLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldBillingInterval, v))
+}
+
+// BillingIntervalContains applies the Contains predicate on the "billing_interval" field.
+func BillingIntervalContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldBillingInterval, v))
+}
+
+// BillingIntervalHasPrefix applies the HasPrefix predicate on the "billing_interval" field.
+func BillingIntervalHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldBillingInterval, v))
+}
+
+// BillingIntervalHasSuffix applies the HasSuffix predicate on the "billing_interval" field.
+func BillingIntervalHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldBillingInterval, v))
+}
+
+// BillingIntervalEqualFold applies the EqualFold predicate on the "billing_interval" field.
+func BillingIntervalEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldBillingInterval, v))
+}
+
+// BillingIntervalContainsFold applies the ContainsFold predicate on the "billing_interval" field.
+func BillingIntervalContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldBillingInterval, v))
+}
+
+// IntervalCountEQ applies the EQ predicate on the "interval_count" field.
+func IntervalCountEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldIntervalCount, v))
+}
+
+// IntervalCountNEQ applies the NEQ predicate on the "interval_count" field.
+func IntervalCountNEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldIntervalCount, v))
+}
+
+// IntervalCountIn applies the In predicate on the "interval_count" field.
+func IntervalCountIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldIntervalCount, vs...))
+}
+
+// IntervalCountNotIn applies the NotIn predicate on the "interval_count" field.
+func IntervalCountNotIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldIntervalCount, vs...))
+}
+
+// IntervalCountGT applies the GT predicate on the "interval_count" field.
+func IntervalCountGT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldIntervalCount, v))
+}
+
+// IntervalCountGTE applies the GTE predicate on the "interval_count" field.
+func IntervalCountGTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldIntervalCount, v))
+}
+
+// IntervalCountLT applies the LT predicate on the "interval_count" field.
+func IntervalCountLT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldIntervalCount, v))
+}
+
+// IntervalCountLTE applies the LTE predicate on the "interval_count" field.
+func IntervalCountLTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldIntervalCount, v))
+}
+
+// BillingAnchorDayEQ applies the EQ predicate on the "billing_anchor_day" field.
+func BillingAnchorDayEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldBillingAnchorDay, v))
+}
+
+// BillingAnchorDayNEQ applies the NEQ predicate on the "billing_anchor_day" field.
+func BillingAnchorDayNEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldBillingAnchorDay, v))
+}
+
+// BillingAnchorDayIn applies the In predicate on the "billing_anchor_day" field.
+func BillingAnchorDayIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldBillingAnchorDay, vs...))
+}
+
+// BillingAnchorDayNotIn applies the NotIn predicate on the "billing_anchor_day" field.
+func BillingAnchorDayNotIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldBillingAnchorDay, vs...))
+}
+
+// BillingAnchorDayGT applies the GT predicate on the "billing_anchor_day" field.
+func BillingAnchorDayGT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldBillingAnchorDay, v))
+}
+
+// BillingAnchorDayGTE applies the GTE predicate on the "billing_anchor_day" field.
+func BillingAnchorDayGTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldBillingAnchorDay, v))
+}
+
+// BillingAnchorDayLT applies the LT predicate on the "billing_anchor_day" field.
+func BillingAnchorDayLT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldBillingAnchorDay, v))
+}
+
+// BillingAnchorDayLTE applies the LTE predicate on the "billing_anchor_day" field.
+func BillingAnchorDayLTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldBillingAnchorDay, v))
+}
+
+// BillingAnchorDayIsNil applies the IsNil predicate on the "billing_anchor_day" field.
+func BillingAnchorDayIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldBillingAnchorDay))
+}
+
+// BillingAnchorDayNotNil applies the NotNil predicate on the "billing_anchor_day" field.
+func BillingAnchorDayNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldBillingAnchorDay))
+}
+
+// DaysUntilDueEQ applies the EQ predicate on the "days_until_due" field.
+func DaysUntilDueEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldDaysUntilDue, v))
+}
+
+// DaysUntilDueNEQ applies the NEQ predicate on the "days_until_due" field.
+func DaysUntilDueNEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldDaysUntilDue, v))
+}
+
+// DaysUntilDueIn applies the In predicate on the "days_until_due" field.
+func DaysUntilDueIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldDaysUntilDue, vs...))
+}
+
+// DaysUntilDueNotIn applies the NotIn predicate on the "days_until_due" field.
+func DaysUntilDueNotIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldDaysUntilDue, vs...))
+}
+
+// DaysUntilDueGT applies the GT predicate on the "days_until_due" field.
+func DaysUntilDueGT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldDaysUntilDue, v))
+}
+
+// DaysUntilDueGTE applies the GTE predicate on the "days_until_due" field.
+func DaysUntilDueGTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldDaysUntilDue, v))
+}
+
+// DaysUntilDueLT applies the LT predicate on the "days_until_due" field.
+func DaysUntilDueLT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldDaysUntilDue, v))
+}
+
+// DaysUntilDueLTE applies the LTE predicate on the "days_until_due" field.
+func DaysUntilDueLTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldDaysUntilDue, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// TrialEndEQ applies the EQ predicate on the "trial_end" field.
+func TrialEndEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldTrialEnd, v))
+}
+
+// TrialEndNEQ applies the NEQ predicate on the "trial_end" field.
+func TrialEndNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldTrialEnd, v))
+}
+
+// TrialEndIn applies the In predicate on the "trial_end" field.
+func TrialEndIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldTrialEnd, vs...))
+}
+
+// TrialEndNotIn applies the NotIn predicate on the "trial_end" field.
+func TrialEndNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldTrialEnd, vs...))
+}
+
+// TrialEndGT applies the GT predicate on the "trial_end" field.
+func TrialEndGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldTrialEnd, v))
+}
+
+// TrialEndGTE applies the GTE predicate on the "trial_end" field.
+func TrialEndGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldTrialEnd, v))
+}
+
+// TrialEndLT applies the LT predicate on the "trial_end" field.
+func TrialEndLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldTrialEnd, v))
+}
+
+// TrialEndLTE applies the LTE predicate on the "trial_end" field.
+func TrialEndLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldTrialEnd, v))
+}
+
+// TrialEndIsNil applies the IsNil predicate on the "trial_end" field.
+func TrialEndIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldTrialEnd))
+}
+
+// TrialEndNotNil applies the NotNil predicate on the "trial_end" field.
+func TrialEndNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldTrialEnd))
+}
+
+// CurrentPeriodStartEQ applies the EQ predicate on the "current_period_start" field.
+func CurrentPeriodStartEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrentPeriodStart, v))
+}
+
+// CurrentPeriodStartNEQ applies the NEQ predicate on the "current_period_start" field.
+func CurrentPeriodStartNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCurrentPeriodStart, v))
+}
+
+// CurrentPeriodStartIn applies the In predicate on the "current_period_start" field.
+func CurrentPeriodStartIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCurrentPeriodStart, vs...))
+}
+
+// CurrentPeriodStartNotIn applies the NotIn predicate on the "current_period_start" field.
+func CurrentPeriodStartNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCurrentPeriodStart, vs...))
+}
+
+// CurrentPeriodStartGT applies the GT predicate on the "current_period_start" field.
+func CurrentPeriodStartGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCurrentPeriodStart, v))
+}
+
+// CurrentPeriodStartGTE applies the GTE predicate on the "current_period_start" field.
+func CurrentPeriodStartGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCurrentPeriodStart, v))
+}
+
+// CurrentPeriodStartLT applies the LT predicate on the "current_period_start" field.
+func CurrentPeriodStartLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCurrentPeriodStart, v))
+}
+
+// CurrentPeriodStartLTE applies the LTE predicate on the "current_period_start" field.
+func CurrentPeriodStartLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCurrentPeriodStart, v))
+}
+
+// CurrentPeriodEndEQ applies the EQ predicate on the "current_period_end" field.
+func CurrentPeriodEndEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndNEQ applies the NEQ predicate on the "current_period_end" field.
+func CurrentPeriodEndNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndIn applies the In predicate on the "current_period_end" field.
+func CurrentPeriodEndIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCurrentPeriodEnd, vs...))
+}
+
+// CurrentPeriodEndNotIn applies the NotIn predicate on the "current_period_end" field.
+func CurrentPeriodEndNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCurrentPeriodEnd, vs...))
+}
+
+// CurrentPeriodEndGT applies the GT predicate on the "current_period_end" field.
+func CurrentPeriodEndGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndGTE applies the GTE predicate on the "current_period_end" field.
+func CurrentPeriodEndGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndLT applies the LT predicate on the "current_period_end" field.
+func CurrentPeriodEndLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndLTE applies the LTE predicate on the "current_period_end" field.
+func CurrentPeriodEndLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCurrentPeriodEnd, v))
+}
+
+// NextBillingAtEQ applies the EQ predicate on the "next_billing_at" field.
+func NextBillingAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldNextBillingAt, v))
+}
+
+// NextBillingAtNEQ applies the NEQ predicate on the "next_billing_at" field.
+func NextBillingAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldNextBillingAt, v))
+}
+
+// NextBillingAtIn applies the In predicate on the "next_billing_at" field.
+func NextBillingAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldNextBillingAt, vs...))
+}
+
+// NextBillingAtNotIn applies the NotIn predicate on the "next_billing_at" field.
+func NextBillingAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldNextBillingAt, vs...))
+}
+
+// NextBillingAtGT applies the GT predicate on the "next_billing_at" field.
+func NextBillingAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldNextBillingAt, v))
+}
+
+// NextBillingAtGTE applies the GTE predicate on the "next_billing_at" field.
+func NextBillingAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldNextBillingAt, v))
+}
+
+// NextBillingAtLT applies the LT predicate on the "next_billing_at" field.
+func NextBillingAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldNextBillingAt, v))
+}
+
+// NextBillingAtLTE applies the LTE predicate on the "next_billing_at" field.
+func NextBillingAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldNextBillingAt, v))
+}
+
+// CancelAtPeriodEndEQ applies the EQ predicate on the "cancel_at_period_end" field.
+func CancelAtPeriodEndEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCancelAtPeriodEnd, v))
+}
+
+// CancelAtPeriodEndNEQ applies the NEQ predicate on the "cancel_at_period_end" field.
+func CancelAtPeriodEndNEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCancelAtPeriodEnd, v))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldEndedAt))
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldEndedAt))
+}
+
+// ReferenceIDEQ applies the EQ predicate on the "reference_id" field.
+func ReferenceIDEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldReferenceID, v))
+}
+
+// ReferenceIDNEQ applies the NEQ predicate on the "reference_id" field.
+func ReferenceIDNEQ(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldReferenceID, v))
+}
+
+// ReferenceIDIn applies the In predicate on the "reference_id" field.
+func ReferenceIDIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldReferenceID, vs...))
+}
+
+// ReferenceIDNotIn applies the NotIn predicate on the "reference_id" field.
+func ReferenceIDNotIn(vs ...string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldReferenceID, vs...))
+}
+
+// ReferenceIDGT applies the GT predicate on the "reference_id" field.
+func ReferenceIDGT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldReferenceID, v))
+}
+
+// ReferenceIDGTE applies the GTE predicate on the "reference_id" field.
+func ReferenceIDGTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldReferenceID, v))
+}
+
+// ReferenceIDLT applies the LT predicate on the "reference_id" field.
+func ReferenceIDLT(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldReferenceID, v))
+}
+
+// ReferenceIDLTE applies the LTE predicate on the "reference_id" field.
+func ReferenceIDLTE(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldReferenceID, v))
+}
+
+// ReferenceIDContains applies the Contains predicate on the "reference_id" field.
+func ReferenceIDContains(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContains(FieldReferenceID, v))
+}
+
+// ReferenceIDHasPrefix applies the HasPrefix predicate on the "reference_id" field.
+func ReferenceIDHasPrefix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasPrefix(FieldReferenceID, v))
+}
+
+// ReferenceIDHasSuffix applies the HasSuffix predicate on the "reference_id" field.
+func ReferenceIDHasSuffix(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldHasSuffix(FieldReferenceID, v))
+}
+
+// ReferenceIDIsNil applies the IsNil predicate on the "reference_id" field.
+func ReferenceIDIsNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldIsNull(FieldReferenceID))
+}
+
+// ReferenceIDNotNil applies the NotNil predicate on the "reference_id" field.
+func ReferenceIDNotNil() predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotNull(FieldReferenceID))
+}
+
+// ReferenceIDEqualFold applies the EqualFold predicate on the "reference_id" field.
+func ReferenceIDEqualFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEqualFold(FieldReferenceID, v))
+}
+
+// ReferenceIDContainsFold applies the ContainsFold predicate on the "reference_id" field.
+func ReferenceIDContainsFold(v string) predicate.Subscription {
+	return predicate.Subscription(sql.FieldContainsFold(FieldReferenceID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasBillingCycles applies the HasEdge predicate on the "billing_cycles" edge.
+func HasBillingCycles() predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BillingCyclesTable, BillingCyclesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBillingCyclesWith applies the HasEdge predicate on the "billing_cycles" edge with a given conditions (other predicates).
+func HasBillingCyclesWith(preds ...predicate.BillingCycle) predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := newBillingCyclesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAdjustments applies the HasEdge predicate on the "adjustments" edge.
+func HasAdjustments() predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AdjustmentsTable, AdjustmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAdjustmentsWith applies the HasEdge predicate on the "adjustments" edge with a given conditions (other predicates).
+func HasAdjustmentsWith(preds ...predicate.SubscriptionAdjustment) predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := newAdjustmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMeters applies the HasEdge predicate on the "meters" edge.
+func HasMeters() predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MetersTable, MetersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMetersWith applies the HasEdge predicate on the "meters" edge with a given conditions (other predicates).
+func HasMetersWith(preds ...predicate.SubscriptionMeter) predicate.Subscription {
+	return predicate.Subscription(func(s *sql.Selector) {
+		step := newMetersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Subscription) predicate.Subscription {
+	return predicate.Subscription(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Subscription) predicate.Subscription {
+	return predicate.Subscription(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Subscription) predicate.Subscription {
+	return predicate.Subscription(sql.NotPredicates(p))
+}
@@ -0,0 +1,2035 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/billingcycle"
+	"github.com/bengobox/treasury-api/internal/ent/subscription"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionadjustment"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionmeter"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionCreate is the builder for creating a Subscription entity.
+type SubscriptionCreate struct {
+	config
+	mutation *SubscriptionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *SubscriptionCreate) SetTenantID(v uuid.UUID) *SubscriptionCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_c *SubscriptionCreate) SetCustomerID(v uuid.UUID) *SubscriptionCreate {
+	_c.mutation.SetCustomerID(v)
+	return _c
+}
+
+// SetPlanCode sets the "plan_code" field.
+func (_c *SubscriptionCreate) SetPlanCode(v string) *SubscriptionCreate {
+	_c.mutation.SetPlanCode(v)
+	return _c
+}
+
+// SetPlanName sets the "plan_name" field.
+func (_c *SubscriptionCreate) SetPlanName(v string) *SubscriptionCreate {
+	_c.mutation.SetPlanName(v)
+	return _c
+}
+
+// SetNillablePlanName sets the "plan_name" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillablePlanName(v *string) *SubscriptionCreate {
+	if v != nil {
+		_c.SetPlanName(*v)
+	}
+	return _c
+}
+
+// SetPrice sets the "price" field.
+func (_c *SubscriptionCreate) SetPrice(v decimal.Decimal) *SubscriptionCreate {
+	_c.mutation.SetPrice(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *SubscriptionCreate) SetCurrency(v string) *SubscriptionCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableCurrency(v *string) *SubscriptionCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetBillingInterval sets the "billing_interval" field.
+func (_c *SubscriptionCreate) SetBillingInterval(v string) *SubscriptionCreate {
+	_c.mutation.SetBillingInterval(v)
+	return _c
+}
+
+// SetNillableBillingInterval sets the "billing_interval" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableBillingInterval(v *string) *SubscriptionCreate {
+	if v != nil {
+		_c.SetBillingInterval(*v)
+	}
+	return _c
+}
+
+// SetIntervalCount sets the "interval_count" field.
+func (_c *SubscriptionCreate) SetIntervalCount(v int) *SubscriptionCreate {
+	_c.mutation.SetIntervalCount(v)
+	return _c
+}
+
+// SetNillableIntervalCount sets the "interval_count" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableIntervalCount(v *int) *SubscriptionCreate {
+	if v != nil {
+		_c.SetIntervalCount(*v)
+	}
+	return _c
+}
+
+// SetBillingAnchorDay sets the "billing_anchor_day" field.
+func (_c *SubscriptionCreate) SetBillingAnchorDay(v int) *SubscriptionCreate {
+	_c.mutation.SetBillingAnchorDay(v)
+	return _c
+}
+
+// SetNillableBillingAnchorDay sets the "billing_anchor_day" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableBillingAnchorDay(v *int) *SubscriptionCreate {
+	if v != nil {
+		_c.SetBillingAnchorDay(*v)
+	}
+	return _c
+}
+
+// SetDaysUntilDue sets the "days_until_due" field.
+func (_c *SubscriptionCreate) SetDaysUntilDue(v int) *SubscriptionCreate {
+	_c.mutation.SetDaysUntilDue(v)
+	return _c
+}
+
+// SetNillableDaysUntilDue sets the "days_until_due" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableDaysUntilDue(v *int) *SubscriptionCreate {
+	if v != nil {
+		_c.SetDaysUntilDue(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *SubscriptionCreate) SetStatus(v string) *SubscriptionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableStatus(v *string) *SubscriptionCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetTrialEnd sets the "trial_end" field.
+func (_c *SubscriptionCreate) SetTrialEnd(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetTrialEnd(v)
+	return _c
+}
+
+// SetNillableTrialEnd sets the "trial_end" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableTrialEnd(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetTrialEnd(*v)
+	}
+	return _c
+}
+
+// SetCurrentPeriodStart sets the "current_period_start" field.
+func (_c *SubscriptionCreate) SetCurrentPeriodStart(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetCurrentPeriodStart(v)
+	return _c
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (_c *SubscriptionCreate) SetCurrentPeriodEnd(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetCurrentPeriodEnd(v)
+	return _c
+}
+
+// SetNextBillingAt sets the "next_billing_at" field.
+func (_c *SubscriptionCreate) SetNextBillingAt(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetNextBillingAt(v)
+	return _c
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (_c *SubscriptionCreate) SetCancelAtPeriodEnd(v bool) *SubscriptionCreate {
+	_c.mutation.SetCancelAtPeriodEnd(v)
+	return _c
+}
+
+// SetNillableCancelAtPeriodEnd sets the "cancel_at_period_end" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableCancelAtPeriodEnd(v *bool) *SubscriptionCreate {
+	if v != nil {
+		_c.SetCancelAtPeriodEnd(*v)
+	}
+	return _c
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_c *SubscriptionCreate) SetCancelledAt(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetCancelledAt(v)
+	return _c
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableCancelledAt(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetCancelledAt(*v)
+	}
+	return _c
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_c *SubscriptionCreate) SetEndedAt(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetEndedAt(v)
+	return _c
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableEndedAt(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetEndedAt(*v)
+	}
+	return _c
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (_c *SubscriptionCreate) SetReferenceID(v string) *SubscriptionCreate {
+	_c.mutation.SetReferenceID(v)
+	return _c
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableReferenceID(v *string) *SubscriptionCreate {
+	if v != nil {
+		_c.SetReferenceID(*v)
+	}
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *SubscriptionCreate) SetMetadata(v map[string]interface{}) *SubscriptionCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SubscriptionCreate) SetCreatedAt(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableCreatedAt(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SubscriptionCreate) SetUpdatedAt(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableUpdatedAt(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SubscriptionCreate) SetID(v uuid.UUID) *SubscriptionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableID(v *uuid.UUID) *SubscriptionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddBillingCycleIDs adds the "billing_cycles" edge to the BillingCycle entity by IDs.
+func (_c *SubscriptionCreate) AddBillingCycleIDs(ids ...uuid.UUID) *SubscriptionCreate {
+	_c.mutation.AddBillingCycleIDs(ids...)
+	return _c
+}
+
+// AddBillingCycles adds the "billing_cycles" edges to the BillingCycle entity.
+func (_c *SubscriptionCreate) AddBillingCycles(v ...*BillingCycle) *SubscriptionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBillingCycleIDs(ids...)
+}
+
+// AddAdjustmentIDs adds the "adjustments" edge to the SubscriptionAdjustment entity by IDs.
+func (_c *SubscriptionCreate) AddAdjustmentIDs(ids ...uuid.UUID) *SubscriptionCreate {
+	_c.mutation.AddAdjustmentIDs(ids...)
+	return _c
+}
+
+// AddAdjustments adds the "adjustments" edges to the SubscriptionAdjustment entity.
+func (_c *SubscriptionCreate) AddAdjustments(v ...*SubscriptionAdjustment) *SubscriptionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAdjustmentIDs(ids...)
+}
+
+// AddMeterIDs adds the "meters" edge to the SubscriptionMeter entity by IDs.
+func (_c *SubscriptionCreate) AddMeterIDs(ids ...uuid.UUID) *SubscriptionCreate {
+	_c.mutation.AddMeterIDs(ids...)
+	return _c
+}
+
+// AddMeters adds the "meters" edges to the SubscriptionMeter entity.
+func (_c *SubscriptionCreate) AddMeters(v ...*SubscriptionMeter) *SubscriptionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMeterIDs(ids...)
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (_c *SubscriptionCreate) Mutation() *SubscriptionMutation {
+	return _c.mutation
+}
+
+// Save creates the Subscription in the database.
+func (_c *SubscriptionCreate) Save(ctx context.Context) (*Subscription, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SubscriptionCreate) SaveX(ctx context.Context) *Subscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SubscriptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SubscriptionCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := subscription.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.BillingInterval(); !ok {
+		v := subscription.DefaultBillingInterval
+		_c.mutation.SetBillingInterval(v)
+	}
+	if _, ok := _c.mutation.IntervalCount(); !ok {
+		v := subscription.DefaultIntervalCount
+		_c.mutation.SetIntervalCount(v)
+	}
+	if _, ok := _c.mutation.DaysUntilDue(); !ok {
+		v := subscription.DefaultDaysUntilDue
+		_c.mutation.SetDaysUntilDue(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := subscription.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CancelAtPeriodEnd(); !ok {
+		v := subscription.DefaultCancelAtPeriodEnd
+		_c.mutation.SetCancelAtPeriodEnd(v)
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		v := subscription.DefaultMetadata
+		_c.mutation.SetMetadata(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := subscription.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := subscription.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := subscription.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SubscriptionCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Subscription.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CustomerID(); !ok {
+		return &ValidationError{Name: "customer_id", err: errors.New(`ent: missing required field "Subscription.customer_id"`)}
+	}
+	if _, ok := _c.mutation.PlanCode(); !ok {
+		return &ValidationError{Name: "plan_code", err: errors.New(`ent: missing required field "Subscription.plan_code"`)}
+	}
+	if v, ok := _c.mutation.PlanCode(); ok {
+		if err := subscription.PlanCodeValidator(v); err != nil {
+			return &ValidationError{Name: "plan_code", err: fmt.Errorf(`ent: validator failed for field "Subscription.plan_code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "Subscription.price"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Subscription.currency"`)}
+	}
+	if _, ok := _c.mutation.BillingInterval(); !ok {
+		return &ValidationError{Name: "billing_interval", err: errors.New(`ent: missing required field "Subscription.billing_interval"`)}
+	}
+	if _, ok := _c.mutation.IntervalCount(); !ok {
+		return &ValidationError{Name: "interval_count", err: errors.New(`ent: missing required field "Subscription.interval_count"`)}
+	}
+	if v, ok := _c.mutation.IntervalCount(); ok {
+		if err := subscription.IntervalCountValidator(v); err != nil {
+			return &ValidationError{Name: "interval_count", err: fmt.Errorf(`ent: validator failed for field "Subscription.interval_count": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.BillingAnchorDay(); ok {
+		if err := subscription.BillingAnchorDayValidator(v); err != nil {
+			return &ValidationError{Name: "billing_anchor_day", err: fmt.Errorf(`ent: validator failed for field "Subscription.billing_anchor_day": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DaysUntilDue(); !ok {
+		return &ValidationError{Name: "days_until_due", err: errors.New(`ent: missing required field "Subscription.days_until_due"`)}
+	}
+	if v, ok := _c.mutation.DaysUntilDue(); ok {
+		if err := subscription.DaysUntilDueValidator(v); err != nil {
+			return &ValidationError{Name: "days_until_due", err: fmt.Errorf(`ent: validator failed for field "Subscription.days_until_due": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Subscription.status"`)}
+	}
+	if _, ok := _c.mutation.CurrentPeriodStart(); !ok {
+		return &ValidationError{Name: "current_period_start", err: errors.New(`ent: missing required field "Subscription.current_period_start"`)}
+	}
+	if _, ok := _c.mutation.CurrentPeriodEnd(); !ok {
+		return &ValidationError{Name: "current_period_end", err: errors.New(`ent: missing required field "Subscription.current_period_end"`)}
+	}
+	if _, ok := _c.mutation.NextBillingAt(); !ok {
+		return &ValidationError{Name: "next_billing_at", err: errors.New(`ent: missing required field "Subscription.next_billing_at"`)}
+	}
+	if _, ok := _c.mutation.CancelAtPeriodEnd(); !ok {
+		return &ValidationError{Name: "cancel_at_period_end", err: errors.New(`ent: missing required field "Subscription.cancel_at_period_end"`)}
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		return &ValidationError{Name: "metadata", err: errors.New(`ent: missing required field "Subscription.metadata"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Subscription.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Subscription.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *SubscriptionCreate) sqlSave(ctx context.Context) (*Subscription, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Subscription{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(subscription.Table, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(subscription.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CustomerID(); ok {
+		_spec.SetField(subscription.FieldCustomerID, field.TypeUUID, value)
+		_node.CustomerID = value
+	}
+	if value, ok := _c.mutation.PlanCode(); ok {
+		_spec.SetField(subscription.FieldPlanCode, field.TypeString, value)
+		_node.PlanCode = value
+	}
+	if value, ok := _c.mutation.PlanName(); ok {
+		_spec.SetField(subscription.FieldPlanName, field.TypeString, value)
+		_node.PlanName = value
+	}
+	if value, ok := _c.mutation.Price(); ok {
+		_spec.SetField(subscription.FieldPrice, field.TypeFloat64, value)
+		_node.Price = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(subscription.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.BillingInterval(); ok {
+		_spec.SetField(subscription.FieldBillingInterval, field.TypeString, value)
+		_node.BillingInterval = value
+	}
+	if value, ok := _c.mutation.IntervalCount(); ok {
+		_spec.SetField(subscription.FieldIntervalCount, field.TypeInt, value)
+		_node.IntervalCount = value
+	}
+	if value, ok := _c.mutation.BillingAnchorDay(); ok {
+		_spec.SetField(subscription.FieldBillingAnchorDay, field.TypeInt, value)
+		_node.BillingAnchorDay = value
+	}
+	if value, ok := _c.mutation.DaysUntilDue(); ok {
+		_spec.SetField(subscription.FieldDaysUntilDue, field.TypeInt, value)
+		_node.DaysUntilDue = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(subscription.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.TrialEnd(); ok {
+		_spec.SetField(subscription.FieldTrialEnd, field.TypeTime, value)
+		_node.TrialEnd = value
+	}
+	if value, ok := _c.mutation.CurrentPeriodStart(); ok {
+		_spec.SetField(subscription.FieldCurrentPeriodStart, field.TypeTime, value)
+		_node.CurrentPeriodStart = value
+	}
+	if value, ok := _c.mutation.CurrentPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCurrentPeriodEnd, field.TypeTime, value)
+		_node.CurrentPeriodEnd = value
+	}
+	if value, ok := _c.mutation.NextBillingAt(); ok {
+		_spec.SetField(subscription.FieldNextBillingAt, field.TypeTime, value)
+		_node.NextBillingAt = value
+	}
+	if value, ok := _c.mutation.CancelAtPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCancelAtPeriodEnd, field.TypeBool, value)
+		_node.CancelAtPeriodEnd = value
+	}
+	if value, ok := _c.mutation.CancelledAt(); ok {
+		_spec.SetField(subscription.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = value
+	}
+	if value, ok := _c.mutation.EndedAt(); ok {
+		_spec.SetField(subscription.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = value
+	}
+	if value, ok := _c.mutation.ReferenceID(); ok {
+		_spec.SetField(subscription.FieldReferenceID, field.TypeString, value)
+		_node.ReferenceID = value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(subscription.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(subscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.BillingCyclesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.BillingCyclesTable,
+			Columns: []string{subscription.BillingCyclesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingcycle.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AdjustmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.AdjustmentsTable,
+			Columns: []string{subscription.AdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionadjustment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MetersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.MetersTable,
+			Columns: []string{subscription.MetersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionmeter.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Subscription.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SubscriptionUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SubscriptionCreate) OnConflict(opts ...sql.ConflictOption) *SubscriptionUpsertOne {
+	_c.conflict = opts
+	return &SubscriptionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Subscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SubscriptionCreate) OnConflictColumns(columns ...string) *SubscriptionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SubscriptionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SubscriptionUpsertOne is the builder for "upsert"-ing
+	//  one Subscription node.
+	SubscriptionUpsertOne struct {
+		create *SubscriptionCreate
+	}
+
+	// SubscriptionUpsert is the "OnConflict" setter.
+	SubscriptionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SubscriptionUpsert) SetTenantID(v uuid.UUID) *SubscriptionUpsert {
+	u.Set(subscription.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateTenantID() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldTenantID)
+	return u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *SubscriptionUpsert) SetCustomerID(v uuid.UUID) *SubscriptionUpsert {
+	u.Set(subscription.FieldCustomerID, v)
+	return u
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateCustomerID() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldCustomerID)
+	return u
+}
+
+// SetPlanCode sets the "plan_code" field.
+func (u *SubscriptionUpsert) SetPlanCode(v string) *SubscriptionUpsert {
+	u.Set(subscription.FieldPlanCode, v)
+	return u
+}
+
+// UpdatePlanCode sets the "plan_code" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdatePlanCode() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldPlanCode)
+	return u
+}
+
+// SetPlanName sets the "plan_name" field.
+func (u *SubscriptionUpsert) SetPlanName(v string) *SubscriptionUpsert {
+	u.Set(subscription.FieldPlanName, v)
+	return u
+}
+
+// UpdatePlanName sets the "plan_name" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdatePlanName() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldPlanName)
+	return u
+}
+
+// ClearPlanName clears the value of the "plan_name" field.
+func (u *SubscriptionUpsert) ClearPlanName() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldPlanName)
+	return u
+}
+
+// SetPrice sets the "price" field.
+func (u *SubscriptionUpsert) SetPrice(v decimal.Decimal) *SubscriptionUpsert {
+	u.Set(subscription.FieldPrice, v)
+	return u
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdatePrice() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldPrice)
+	return u
+}
+
+// AddPrice adds v to the "price" field.
+func (u *SubscriptionUpsert) AddPrice(v decimal.Decimal) *SubscriptionUpsert {
+	u.Add(subscription.FieldPrice, v)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *SubscriptionUpsert) SetCurrency(v string) *SubscriptionUpsert {
+	u.Set(subscription.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateCurrency() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldCurrency)
+	return u
+}
+
+// SetBillingInterval sets the "billing_interval" field.
+func (u *SubscriptionUpsert) SetBillingInterval(v string) *SubscriptionUpsert {
+	u.Set(subscription.FieldBillingInterval, v)
+	return u
+}
+
+// UpdateBillingInterval sets the "billing_interval" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateBillingInterval() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldBillingInterval)
+	return u
+}
+
+// SetIntervalCount sets the "interval_count" field.
+func (u *SubscriptionUpsert) SetIntervalCount(v int) *SubscriptionUpsert {
+	u.Set(subscription.FieldIntervalCount, v)
+	return u
+}
+
+// UpdateIntervalCount sets the "interval_count" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateIntervalCount() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldIntervalCount)
+	return u
+}
+
+// AddIntervalCount adds v to the "interval_count" field.
+func (u *SubscriptionUpsert) AddIntervalCount(v int) *SubscriptionUpsert {
+	u.Add(subscription.FieldIntervalCount, v)
+	return u
+}
+
+// SetBillingAnchorDay sets the "billing_anchor_day" field.
+func (u *SubscriptionUpsert) SetBillingAnchorDay(v int) *SubscriptionUpsert {
+	u.Set(subscription.FieldBillingAnchorDay, v)
+	return u
+}
+
+// UpdateBillingAnchorDay sets the "billing_anchor_day" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateBillingAnchorDay() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldBillingAnchorDay)
+	return u
+}
+
+// AddBillingAnchorDay adds v to the "billing_anchor_day" field.
+func (u *SubscriptionUpsert) AddBillingAnchorDay(v int) *SubscriptionUpsert {
+	u.Add(subscription.FieldBillingAnchorDay, v)
+	return u
+}
+
+// ClearBillingAnchorDay clears the value of the "billing_anchor_day" field.
+func (u *SubscriptionUpsert) ClearBillingAnchorDay() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldBillingAnchorDay)
+	return u
+}
+
+// SetDaysUntilDue sets the "days_until_due" field.
+func (u *SubscriptionUpsert) SetDaysUntilDue(v int) *SubscriptionUpsert {
+	u.Set(subscription.FieldDaysUntilDue, v)
+	return u
+}
+
+// UpdateDaysUntilDue sets the "days_until_due" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateDaysUntilDue() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldDaysUntilDue)
+	return u
+}
+
+// AddDaysUntilDue adds v to the "days_until_due" field.
+func (u *SubscriptionUpsert) AddDaysUntilDue(v int) *SubscriptionUpsert {
+	u.Add(subscription.FieldDaysUntilDue, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *SubscriptionUpsert) SetStatus(v string) *SubscriptionUpsert {
+	u.Set(subscription.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateStatus() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldStatus)
+	return u
+}
+
+// SetTrialEnd sets the "trial_end" field.
+func (u *SubscriptionUpsert) SetTrialEnd(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldTrialEnd, v)
+	return u
+}
+
+// UpdateTrialEnd sets the "trial_end" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateTrialEnd() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldTrialEnd)
+	return u
+}
+
+// ClearTrialEnd clears the value of the "trial_end" field.
+func (u *SubscriptionUpsert) ClearTrialEnd() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldTrialEnd)
+	return u
+}
+
+// SetCurrentPeriodStart sets the "current_period_start" field.
+func (u *SubscriptionUpsert) SetCurrentPeriodStart(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldCurrentPeriodStart, v)
+	return u
+}
+
+// UpdateCurrentPeriodStart sets the "current_period_start" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateCurrentPeriodStart() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldCurrentPeriodStart)
+	return u
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (u *SubscriptionUpsert) SetCurrentPeriodEnd(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldCurrentPeriodEnd, v)
+	return u
+}
+
+// UpdateCurrentPeriodEnd sets the "current_period_end" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateCurrentPeriodEnd() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldCurrentPeriodEnd)
+	return u
+}
+
+// SetNextBillingAt sets the "next_billing_at" field.
+func (u *SubscriptionUpsert) SetNextBillingAt(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldNextBillingAt, v)
+	return u
+}
+
+// UpdateNextBillingAt sets the "next_billing_at" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateNextBillingAt() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldNextBillingAt)
+	return u
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (u *SubscriptionUpsert) SetCancelAtPeriodEnd(v bool) *SubscriptionUpsert {
+	u.Set(subscription.FieldCancelAtPeriodEnd, v)
+	return u
+}
+
+// UpdateCancelAtPeriodEnd sets the "cancel_at_period_end" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateCancelAtPeriodEnd() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldCancelAtPeriodEnd)
+	return u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *SubscriptionUpsert) SetCancelledAt(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldCancelledAt, v)
+	return u
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateCancelledAt() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldCancelledAt)
+	return u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *SubscriptionUpsert) ClearCancelledAt() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldCancelledAt)
+	return u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *SubscriptionUpsert) SetEndedAt(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldEndedAt, v)
+	return u
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateEndedAt() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldEndedAt)
+	return u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *SubscriptionUpsert) ClearEndedAt() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldEndedAt)
+	return u
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (u *SubscriptionUpsert) SetReferenceID(v string) *SubscriptionUpsert {
+	u.Set(subscription.FieldReferenceID, v)
+	return u
+}
+
+// UpdateReferenceID sets the "reference_id" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateReferenceID() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldReferenceID)
+	return u
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (u *SubscriptionUpsert) ClearReferenceID() *SubscriptionUpsert {
+	u.SetNull(subscription.FieldReferenceID)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *SubscriptionUpsert) SetMetadata(v map[string]interface{}) *SubscriptionUpsert {
+	u.Set(subscription.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateMetadata() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldMetadata)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubscriptionUpsert) SetUpdatedAt(v time.Time) *SubscriptionUpsert {
+	u.Set(subscription.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubscriptionUpsert) UpdateUpdatedAt() *SubscriptionUpsert {
+	u.SetExcluded(subscription.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Subscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(subscription.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SubscriptionUpsertOne) UpdateNewValues() *SubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(subscription.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(subscription.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Subscription.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SubscriptionUpsertOne) Ignore() *SubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SubscriptionUpsertOne) DoNothing() *SubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SubscriptionCreate.OnConflict
+// documentation for more info.
+func (u *SubscriptionUpsertOne) Update(set func(*SubscriptionUpsert)) *SubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SubscriptionUpsertOne) SetTenantID(v uuid.UUID) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateTenantID() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *SubscriptionUpsertOne) SetCustomerID(v uuid.UUID) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCustomerID(v)
+	})
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateCustomerID() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCustomerID()
+	})
+}
+
+// SetPlanCode sets the "plan_code" field.
+func (u *SubscriptionUpsertOne) SetPlanCode(v string) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetPlanCode(v)
+	})
+}
+
+// UpdatePlanCode sets the "plan_code" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdatePlanCode() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdatePlanCode()
+	})
+}
+
+// SetPlanName sets the "plan_name" field.
+func (u *SubscriptionUpsertOne) SetPlanName(v string) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetPlanName(v)
+	})
+}
+
+// UpdatePlanName sets the "plan_name" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdatePlanName() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdatePlanName()
+	})
+}
+
+// ClearPlanName clears the value of the "plan_name" field.
+func (u *SubscriptionUpsertOne) ClearPlanName() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearPlanName()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *SubscriptionUpsertOne) SetPrice(v decimal.Decimal) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *SubscriptionUpsertOne) AddPrice(v decimal.Decimal) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdatePrice() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *SubscriptionUpsertOne) SetCurrency(v string) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateCurrency() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetBillingInterval sets the "billing_interval" field.
+func (u *SubscriptionUpsertOne) SetBillingInterval(v string) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetBillingInterval(v)
+	})
+}
+
+// UpdateBillingInterval sets the "billing_interval" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateBillingInterval() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateBillingInterval()
+	})
+}
+
+// SetIntervalCount sets the "interval_count" field.
+func (u *SubscriptionUpsertOne) SetIntervalCount(v int) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetIntervalCount(v)
+	})
+}
+
+// AddIntervalCount adds v to the "interval_count" field.
+func (u *SubscriptionUpsertOne) AddIntervalCount(v int) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.AddIntervalCount(v)
+	})
+}
+
+// UpdateIntervalCount sets the "interval_count" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateIntervalCount() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateIntervalCount()
+	})
+}
+
+// SetBillingAnchorDay sets the "billing_anchor_day" field.
+func (u *SubscriptionUpsertOne) SetBillingAnchorDay(v int) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetBillingAnchorDay(v)
+	})
+}
+
+// AddBillingAnchorDay adds v to the "billing_anchor_day" field.
+func (u *SubscriptionUpsertOne) AddBillingAnchorDay(v int) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.AddBillingAnchorDay(v)
+	})
+}
+
+// UpdateBillingAnchorDay sets the "billing_anchor_day" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateBillingAnchorDay() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateBillingAnchorDay()
+	})
+}
+
+// ClearBillingAnchorDay clears the value of the "billing_anchor_day" field.
+func (u *SubscriptionUpsertOne) ClearBillingAnchorDay() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearBillingAnchorDay()
+	})
+}
+
+// SetDaysUntilDue sets the "days_until_due" field.
+func (u *SubscriptionUpsertOne) SetDaysUntilDue(v int) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetDaysUntilDue(v)
+	})
+}
+
+// AddDaysUntilDue adds v to the "days_until_due" field.
+func (u *SubscriptionUpsertOne) AddDaysUntilDue(v int) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.AddDaysUntilDue(v)
+	})
+}
+
+// UpdateDaysUntilDue sets the "days_until_due" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateDaysUntilDue() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateDaysUntilDue()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SubscriptionUpsertOne) SetStatus(v string) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateStatus() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetTrialEnd sets the "trial_end" field.
+func (u *SubscriptionUpsertOne) SetTrialEnd(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetTrialEnd(v)
+	})
+}
+
+// UpdateTrialEnd sets the "trial_end" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateTrialEnd() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateTrialEnd()
+	})
+}
+
+// ClearTrialEnd clears the value of the "trial_end" field.
+func (u *SubscriptionUpsertOne) ClearTrialEnd() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearTrialEnd()
+	})
+}
+
+// SetCurrentPeriodStart sets the "current_period_start" field.
+func (u *SubscriptionUpsertOne) SetCurrentPeriodStart(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCurrentPeriodStart(v)
+	})
+}
+
+// UpdateCurrentPeriodStart sets the "current_period_start" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateCurrentPeriodStart() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCurrentPeriodStart()
+	})
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (u *SubscriptionUpsertOne) SetCurrentPeriodEnd(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCurrentPeriodEnd(v)
+	})
+}
+
+// UpdateCurrentPeriodEnd sets the "current_period_end" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateCurrentPeriodEnd() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCurrentPeriodEnd()
+	})
+}
+
+// SetNextBillingAt sets the "next_billing_at" field.
+func (u *SubscriptionUpsertOne) SetNextBillingAt(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetNextBillingAt(v)
+	})
+}
+
+// UpdateNextBillingAt sets the "next_billing_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateNextBillingAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateNextBillingAt()
+	})
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (u *SubscriptionUpsertOne) SetCancelAtPeriodEnd(v bool) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCancelAtPeriodEnd(v)
+	})
+}
+
+// UpdateCancelAtPeriodEnd sets the "cancel_at_period_end" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateCancelAtPeriodEnd() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCancelAtPeriodEnd()
+	})
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *SubscriptionUpsertOne) SetCancelledAt(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateCancelledAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *SubscriptionUpsertOne) ClearCancelledAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *SubscriptionUpsertOne) SetEndedAt(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateEndedAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *SubscriptionUpsertOne) ClearEndedAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearEndedAt()
+	})
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (u *SubscriptionUpsertOne) SetReferenceID(v string) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetReferenceID(v)
+	})
+}
+
+// UpdateReferenceID sets the "reference_id" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateReferenceID() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateReferenceID()
+	})
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (u *SubscriptionUpsertOne) ClearReferenceID() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearReferenceID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *SubscriptionUpsertOne) SetMetadata(v map[string]interface{}) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateMetadata() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubscriptionUpsertOne) SetUpdatedAt(v time.Time) *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertOne) UpdateUpdatedAt() *SubscriptionUpsertOne {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SubscriptionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SubscriptionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SubscriptionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SubscriptionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SubscriptionUpsertOne.ID is not supported by MySQL driver. Use SubscriptionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SubscriptionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SubscriptionCreateBulk is the builder for creating many Subscription entities in bulk.
+type SubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*SubscriptionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Subscription entities in the database.
+func (_c *SubscriptionCreateBulk) Save(ctx context.Context) ([]*Subscription, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Subscription, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SubscriptionCreateBulk) SaveX(ctx context.Context) []*Subscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Subscription.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SubscriptionUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SubscriptionCreateBulk) OnConflict(opts ...sql.ConflictOption) *SubscriptionUpsertBulk {
+	_c.conflict = opts
+	return &SubscriptionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Subscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SubscriptionCreateBulk) OnConflictColumns(columns ...string) *SubscriptionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SubscriptionUpsertBulk{
+		create: _c,
+	}
+}
+
+// SubscriptionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Subscription nodes.
+type SubscriptionUpsertBulk struct {
+	create *SubscriptionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Subscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(subscription.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SubscriptionUpsertBulk) UpdateNewValues() *SubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(subscription.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(subscription.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Subscription.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SubscriptionUpsertBulk) Ignore() *SubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SubscriptionUpsertBulk) DoNothing() *SubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SubscriptionCreateBulk.OnConflict
+// documentation for more info.
+func (u *SubscriptionUpsertBulk) Update(set func(*SubscriptionUpsert)) *SubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SubscriptionUpsertBulk) SetTenantID(v uuid.UUID) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateTenantID() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *SubscriptionUpsertBulk) SetCustomerID(v uuid.UUID) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCustomerID(v)
+	})
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateCustomerID() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCustomerID()
+	})
+}
+
+// SetPlanCode sets the "plan_code" field.
+func (u *SubscriptionUpsertBulk) SetPlanCode(v string) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetPlanCode(v)
+	})
+}
+
+// UpdatePlanCode sets the "plan_code" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdatePlanCode() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdatePlanCode()
+	})
+}
+
+// SetPlanName sets the "plan_name" field.
+func (u *SubscriptionUpsertBulk) SetPlanName(v string) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetPlanName(v)
+	})
+}
+
+// UpdatePlanName sets the "plan_name" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdatePlanName() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdatePlanName()
+	})
+}
+
+// ClearPlanName clears the value of the "plan_name" field.
+func (u *SubscriptionUpsertBulk) ClearPlanName() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearPlanName()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *SubscriptionUpsertBulk) SetPrice(v decimal.Decimal) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *SubscriptionUpsertBulk) AddPrice(v decimal.Decimal) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdatePrice() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *SubscriptionUpsertBulk) SetCurrency(v string) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateCurrency() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetBillingInterval sets the "billing_interval" field.
+func (u *SubscriptionUpsertBulk) SetBillingInterval(v string) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetBillingInterval(v)
+	})
+}
+
+// UpdateBillingInterval sets the "billing_interval" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateBillingInterval() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateBillingInterval()
+	})
+}
+
+// SetIntervalCount sets the "interval_count" field.
+func (u *SubscriptionUpsertBulk) SetIntervalCount(v int) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetIntervalCount(v)
+	})
+}
+
+// AddIntervalCount adds v to the "interval_count" field.
+func (u *SubscriptionUpsertBulk) AddIntervalCount(v int) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.AddIntervalCount(v)
+	})
+}
+
+// UpdateIntervalCount sets the "interval_count" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateIntervalCount() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateIntervalCount()
+	})
+}
+
+// SetBillingAnchorDay sets the "billing_anchor_day" field.
+func (u *SubscriptionUpsertBulk) SetBillingAnchorDay(v int) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetBillingAnchorDay(v)
+	})
+}
+
+// AddBillingAnchorDay adds v to the "billing_anchor_day" field.
+func (u *SubscriptionUpsertBulk) AddBillingAnchorDay(v int) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.AddBillingAnchorDay(v)
+	})
+}
+
+// UpdateBillingAnchorDay sets the "billing_anchor_day" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateBillingAnchorDay() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateBillingAnchorDay()
+	})
+}
+
+// ClearBillingAnchorDay clears the value of the "billing_anchor_day" field.
+func (u *SubscriptionUpsertBulk) ClearBillingAnchorDay() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearBillingAnchorDay()
+	})
+}
+
+// SetDaysUntilDue sets the "days_until_due" field.
+func (u *SubscriptionUpsertBulk) SetDaysUntilDue(v int) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetDaysUntilDue(v)
+	})
+}
+
+// AddDaysUntilDue adds v to the "days_until_due" field.
+func (u *SubscriptionUpsertBulk) AddDaysUntilDue(v int) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.AddDaysUntilDue(v)
+	})
+}
+
+// UpdateDaysUntilDue sets the "days_until_due" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateDaysUntilDue() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateDaysUntilDue()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SubscriptionUpsertBulk) SetStatus(v string) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateStatus() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetTrialEnd sets the "trial_end" field.
+func (u *SubscriptionUpsertBulk) SetTrialEnd(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetTrialEnd(v)
+	})
+}
+
+// UpdateTrialEnd sets the "trial_end" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateTrialEnd() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateTrialEnd()
+	})
+}
+
+// ClearTrialEnd clears the value of the "trial_end" field.
+func (u *SubscriptionUpsertBulk) ClearTrialEnd() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearTrialEnd()
+	})
+}
+
+// SetCurrentPeriodStart sets the "current_period_start" field.
+func (u *SubscriptionUpsertBulk) SetCurrentPeriodStart(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCurrentPeriodStart(v)
+	})
+}
+
+// UpdateCurrentPeriodStart sets the "current_period_start" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateCurrentPeriodStart() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCurrentPeriodStart()
+	})
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (u *SubscriptionUpsertBulk) SetCurrentPeriodEnd(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCurrentPeriodEnd(v)
+	})
+}
+
+// UpdateCurrentPeriodEnd sets the "current_period_end" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateCurrentPeriodEnd() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCurrentPeriodEnd()
+	})
+}
+
+// SetNextBillingAt sets the "next_billing_at" field.
+func (u *SubscriptionUpsertBulk) SetNextBillingAt(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetNextBillingAt(v)
+	})
+}
+
+// UpdateNextBillingAt sets the "next_billing_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateNextBillingAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateNextBillingAt()
+	})
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (u *SubscriptionUpsertBulk) SetCancelAtPeriodEnd(v bool) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCancelAtPeriodEnd(v)
+	})
+}
+
+// UpdateCancelAtPeriodEnd sets the "cancel_at_period_end" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateCancelAtPeriodEnd() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCancelAtPeriodEnd()
+	})
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (u *SubscriptionUpsertBulk) SetCancelledAt(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetCancelledAt(v)
+	})
+}
+
+// UpdateCancelledAt sets the "cancelled_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateCancelledAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateCancelledAt()
+	})
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (u *SubscriptionUpsertBulk) ClearCancelledAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearCancelledAt()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *SubscriptionUpsertBulk) SetEndedAt(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateEndedAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *SubscriptionUpsertBulk) ClearEndedAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearEndedAt()
+	})
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (u *SubscriptionUpsertBulk) SetReferenceID(v string) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetReferenceID(v)
+	})
+}
+
+// UpdateReferenceID sets the "reference_id" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateReferenceID() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateReferenceID()
+	})
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (u *SubscriptionUpsertBulk) ClearReferenceID() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.ClearReferenceID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *SubscriptionUpsertBulk) SetMetadata(v map[string]interface{}) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateMetadata() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubscriptionUpsertBulk) SetUpdatedAt(v time.Time) *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubscriptionUpsertBulk) UpdateUpdatedAt() *SubscriptionUpsertBulk {
+	return u.Update(func(s *SubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SubscriptionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SubscriptionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SubscriptionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SubscriptionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1611 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/billingcycle"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/subscription"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionadjustment"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionmeter"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionUpdate is the builder for updating Subscription entities.
+type SubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionMutation
+}
+
+// Where appends a list predicates to the SubscriptionUpdate builder.
+func (_u *SubscriptionUpdate) Where(ps ...predicate.Subscription) *SubscriptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *SubscriptionUpdate) SetTenantID(v uuid.UUID) *SubscriptionUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableTenantID(v *uuid.UUID) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *SubscriptionUpdate) SetCustomerID(v uuid.UUID) *SubscriptionUpdate {
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableCustomerID(v *uuid.UUID) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// SetPlanCode sets the "plan_code" field.
+func (_u *SubscriptionUpdate) SetPlanCode(v string) *SubscriptionUpdate {
+	_u.mutation.SetPlanCode(v)
+	return _u
+}
+
+// SetNillablePlanCode sets the "plan_code" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillablePlanCode(v *string) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetPlanCode(*v)
+	}
+	return _u
+}
+
+// SetPlanName sets the "plan_name" field.
+func (_u *SubscriptionUpdate) SetPlanName(v string) *SubscriptionUpdate {
+	_u.mutation.SetPlanName(v)
+	return _u
+}
+
+// SetNillablePlanName sets the "plan_name" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillablePlanName(v *string) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetPlanName(*v)
+	}
+	return _u
+}
+
+// ClearPlanName clears the value of the "plan_name" field.
+func (_u *SubscriptionUpdate) ClearPlanName() *SubscriptionUpdate {
+	_u.mutation.ClearPlanName()
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *SubscriptionUpdate) SetPrice(v decimal.Decimal) *SubscriptionUpdate {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillablePrice(v *decimal.Decimal) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *SubscriptionUpdate) AddPrice(v decimal.Decimal) *SubscriptionUpdate {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *SubscriptionUpdate) SetCurrency(v string) *SubscriptionUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableCurrency(v *string) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetBillingInterval sets the "billing_interval" field.
+func (_u *SubscriptionUpdate) SetBillingInterval(v string) *SubscriptionUpdate {
+	_u.mutation.SetBillingInterval(v)
+	return _u
+}
+
+// SetNillableBillingInterval sets the "billing_interval" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableBillingInterval(v *string) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetBillingInterval(*v)
+	}
+	return _u
+}
+
+// SetIntervalCount sets the "interval_count" field.
+func (_u *SubscriptionUpdate) SetIntervalCount(v int) *SubscriptionUpdate {
+	_u.mutation.ResetIntervalCount()
+	_u.mutation.SetIntervalCount(v)
+	return _u
+}
+
+// SetNillableIntervalCount sets the "interval_count" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableIntervalCount(v *int) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetIntervalCount(*v)
+	}
+	return _u
+}
+
+// AddIntervalCount adds value to the "interval_count" field.
+func (_u *SubscriptionUpdate) AddIntervalCount(v int) *SubscriptionUpdate {
+	_u.mutation.AddIntervalCount(v)
+	return _u
+}
+
+// SetBillingAnchorDay sets the "billing_anchor_day" field.
+func (_u *SubscriptionUpdate) SetBillingAnchorDay(v int) *SubscriptionUpdate {
+	_u.mutation.ResetBillingAnchorDay()
+	_u.mutation.SetBillingAnchorDay(v)
+	return _u
+}
+
+// SetNillableBillingAnchorDay sets the "billing_anchor_day" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableBillingAnchorDay(v *int) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetBillingAnchorDay(*v)
+	}
+	return _u
+}
+
+// AddBillingAnchorDay adds value to the "billing_anchor_day" field.
+func (_u *SubscriptionUpdate) AddBillingAnchorDay(v int) *SubscriptionUpdate {
+	_u.mutation.AddBillingAnchorDay(v)
+	return _u
+}
+
+// ClearBillingAnchorDay clears the value of the "billing_anchor_day" field.
+func (_u *SubscriptionUpdate) ClearBillingAnchorDay() *SubscriptionUpdate {
+	_u.mutation.ClearBillingAnchorDay()
+	return _u
+}
+
+// SetDaysUntilDue sets the "days_until_due" field.
+func (_u *SubscriptionUpdate) SetDaysUntilDue(v int) *SubscriptionUpdate {
+	_u.mutation.ResetDaysUntilDue()
+	_u.mutation.SetDaysUntilDue(v)
+	return _u
+}
+
+// SetNillableDaysUntilDue sets the "days_until_due" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableDaysUntilDue(v *int) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetDaysUntilDue(*v)
+	}
+	return _u
+}
+
+// AddDaysUntilDue adds value to the "days_until_due" field.
+func (_u *SubscriptionUpdate) AddDaysUntilDue(v int) *SubscriptionUpdate {
+	_u.mutation.AddDaysUntilDue(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SubscriptionUpdate) SetStatus(v string) *SubscriptionUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableStatus(v *string) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTrialEnd sets the "trial_end" field.
+func (_u *SubscriptionUpdate) SetTrialEnd(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetTrialEnd(v)
+	return _u
+}
+
+// SetNillableTrialEnd sets the "trial_end" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableTrialEnd(v *time.Time) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetTrialEnd(*v)
+	}
+	return _u
+}
+
+// ClearTrialEnd clears the value of the "trial_end" field.
+func (_u *SubscriptionUpdate) ClearTrialEnd() *SubscriptionUpdate {
+	_u.mutation.ClearTrialEnd()
+	return _u
+}
+
+// SetCurrentPeriodStart sets the "current_period_start" field.
+func (_u *SubscriptionUpdate) SetCurrentPeriodStart(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetCurrentPeriodStart(v)
+	return _u
+}
+
+// SetNillableCurrentPeriodStart sets the "current_period_start" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableCurrentPeriodStart(v *time.Time) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetCurrentPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (_u *SubscriptionUpdate) SetCurrentPeriodEnd(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetCurrentPeriodEnd(v)
+	return _u
+}
+
+// SetNillableCurrentPeriodEnd sets the "current_period_end" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableCurrentPeriodEnd(v *time.Time) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetCurrentPeriodEnd(*v)
+	}
+	return _u
+}
+
+// SetNextBillingAt sets the "next_billing_at" field.
+func (_u *SubscriptionUpdate) SetNextBillingAt(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetNextBillingAt(v)
+	return _u
+}
+
+// SetNillableNextBillingAt sets the "next_billing_at" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableNextBillingAt(v *time.Time) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetNextBillingAt(*v)
+	}
+	return _u
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (_u *SubscriptionUpdate) SetCancelAtPeriodEnd(v bool) *SubscriptionUpdate {
+	_u.mutation.SetCancelAtPeriodEnd(v)
+	return _u
+}
+
+// SetNillableCancelAtPeriodEnd sets the "cancel_at_period_end" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableCancelAtPeriodEnd(v *bool) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetCancelAtPeriodEnd(*v)
+	}
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *SubscriptionUpdate) SetCancelledAt(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableCancelledAt(v *time.Time) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *SubscriptionUpdate) ClearCancelledAt() *SubscriptionUpdate {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *SubscriptionUpdate) SetEndedAt(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableEndedAt(v *time.Time) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *SubscriptionUpdate) ClearEndedAt() *SubscriptionUpdate {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (_u *SubscriptionUpdate) SetReferenceID(v string) *SubscriptionUpdate {
+	_u.mutation.SetReferenceID(v)
+	return _u
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableReferenceID(v *string) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetReferenceID(*v)
+	}
+	return _u
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (_u *SubscriptionUpdate) ClearReferenceID() *SubscriptionUpdate {
+	_u.mutation.ClearReferenceID()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *SubscriptionUpdate) SetMetadata(v map[string]interface{}) *SubscriptionUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SubscriptionUpdate) SetUpdatedAt(v time.Time) *SubscriptionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddBillingCycleIDs adds the "billing_cycles" edge to the BillingCycle entity by IDs.
+func (_u *SubscriptionUpdate) AddBillingCycleIDs(ids ...uuid.UUID) *SubscriptionUpdate {
+	_u.mutation.AddBillingCycleIDs(ids...)
+	return _u
+}
+
+// AddBillingCycles adds the "billing_cycles" edges to the BillingCycle entity.
+func (_u *SubscriptionUpdate) AddBillingCycles(v ...*BillingCycle) *SubscriptionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBillingCycleIDs(ids...)
+}
+
+// AddAdjustmentIDs adds the "adjustments" edge to the SubscriptionAdjustment entity by IDs.
+func (_u *SubscriptionUpdate) AddAdjustmentIDs(ids ...uuid.UUID) *SubscriptionUpdate {
+	_u.mutation.AddAdjustmentIDs(ids...)
+	return _u
+}
+
+// AddAdjustments adds the "adjustments" edges to the SubscriptionAdjustment entity.
+func (_u *SubscriptionUpdate) AddAdjustments(v ...*SubscriptionAdjustment) *SubscriptionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAdjustmentIDs(ids...)
+}
+
+// AddMeterIDs adds the "meters" edge to the SubscriptionMeter entity by IDs.
+func (_u *SubscriptionUpdate) AddMeterIDs(ids ...uuid.UUID) *SubscriptionUpdate {
+	_u.mutation.AddMeterIDs(ids...)
+	return _u
+}
+
+// AddMeters adds the "meters" edges to the SubscriptionMeter entity.
+func (_u *SubscriptionUpdate) AddMeters(v ...*SubscriptionMeter) *SubscriptionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMeterIDs(ids...)
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (_u *SubscriptionUpdate) Mutation() *SubscriptionMutation {
+	return _u.mutation
+}
+
+// ClearBillingCycles clears all "billing_cycles" edges to the BillingCycle entity.
+func (_u *SubscriptionUpdate) ClearBillingCycles() *SubscriptionUpdate {
+	_u.mutation.ClearBillingCycles()
+	return _u
+}
+
+// RemoveBillingCycleIDs removes the "billing_cycles" edge to BillingCycle entities by IDs.
+func (_u *SubscriptionUpdate) RemoveBillingCycleIDs(ids ...uuid.UUID) *SubscriptionUpdate {
+	_u.mutation.RemoveBillingCycleIDs(ids...)
+	return _u
+}
+
+// RemoveBillingCycles removes "billing_cycles" edges to BillingCycle entities.
+func (_u *SubscriptionUpdate) RemoveBillingCycles(v ...*BillingCycle) *SubscriptionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBillingCycleIDs(ids...)
+}
+
+// ClearAdjustments clears all "adjustments" edges to the SubscriptionAdjustment entity.
+func (_u *SubscriptionUpdate) ClearAdjustments() *SubscriptionUpdate {
+	_u.mutation.ClearAdjustments()
+	return _u
+}
+
+// RemoveAdjustmentIDs removes the "adjustments" edge to SubscriptionAdjustment entities by IDs.
+func (_u *SubscriptionUpdate) RemoveAdjustmentIDs(ids ...uuid.UUID) *SubscriptionUpdate {
+	_u.mutation.RemoveAdjustmentIDs(ids...)
+	return _u
+}
+
+// RemoveAdjustments removes "adjustments" edges to SubscriptionAdjustment entities.
+func (_u *SubscriptionUpdate) RemoveAdjustments(v ...*SubscriptionAdjustment) *SubscriptionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAdjustmentIDs(ids...)
+}
+
+// ClearMeters clears all "meters" edges to the SubscriptionMeter entity.
+func (_u *SubscriptionUpdate) ClearMeters() *SubscriptionUpdate {
+	_u.mutation.ClearMeters()
+	return _u
+}
+
+// RemoveMeterIDs removes the "meters" edge to SubscriptionMeter entities by IDs.
+func (_u *SubscriptionUpdate) RemoveMeterIDs(ids ...uuid.UUID) *SubscriptionUpdate {
+	_u.mutation.RemoveMeterIDs(ids...)
+	return _u
+}
+
+// RemoveMeters removes "meters" edges to SubscriptionMeter entities.
+func (_u *SubscriptionUpdate) RemoveMeters(v ...*SubscriptionMeter) *SubscriptionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMeterIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SubscriptionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := subscription.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SubscriptionUpdate) check() error {
+	if v, ok := _u.mutation.PlanCode(); ok {
+		if err := subscription.PlanCodeValidator(v); err != nil {
+			return &ValidationError{Name: "plan_code", err: fmt.Errorf(`ent: validator failed for field "Subscription.plan_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IntervalCount(); ok {
+		if err := subscription.IntervalCountValidator(v); err != nil {
+			return &ValidationError{Name: "interval_count", err: fmt.Errorf(`ent: validator failed for field "Subscription.interval_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BillingAnchorDay(); ok {
+		if err := subscription.BillingAnchorDayValidator(v); err != nil {
+			return &ValidationError{Name: "billing_anchor_day", err: fmt.Errorf(`ent: validator failed for field "Subscription.billing_anchor_day": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DaysUntilDue(); ok {
+		if err := subscription.DaysUntilDueValidator(v); err != nil {
+			return &ValidationError{Name: "days_until_due", err: fmt.Errorf(`ent: validator failed for field "Subscription.days_until_due": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SubscriptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(subscription.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(subscription.FieldCustomerID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PlanCode(); ok {
+		_spec.SetField(subscription.FieldPlanCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PlanName(); ok {
+		_spec.SetField(subscription.FieldPlanName, field.TypeString, value)
+	}
+	if _u.mutation.PlanNameCleared() {
+		_spec.ClearField(subscription.FieldPlanName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(subscription.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(subscription.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(subscription.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BillingInterval(); ok {
+		_spec.SetField(subscription.FieldBillingInterval, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IntervalCount(); ok {
+		_spec.SetField(subscription.FieldIntervalCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIntervalCount(); ok {
+		_spec.AddField(subscription.FieldIntervalCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BillingAnchorDay(); ok {
+		_spec.SetField(subscription.FieldBillingAnchorDay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBillingAnchorDay(); ok {
+		_spec.AddField(subscription.FieldBillingAnchorDay, field.TypeInt, value)
+	}
+	if _u.mutation.BillingAnchorDayCleared() {
+		_spec.ClearField(subscription.FieldBillingAnchorDay, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DaysUntilDue(); ok {
+		_spec.SetField(subscription.FieldDaysUntilDue, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDaysUntilDue(); ok {
+		_spec.AddField(subscription.FieldDaysUntilDue, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(subscription.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TrialEnd(); ok {
+		_spec.SetField(subscription.FieldTrialEnd, field.TypeTime, value)
+	}
+	if _u.mutation.TrialEndCleared() {
+		_spec.ClearField(subscription.FieldTrialEnd, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CurrentPeriodStart(); ok {
+		_spec.SetField(subscription.FieldCurrentPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CurrentPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCurrentPeriodEnd, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.NextBillingAt(); ok {
+		_spec.SetField(subscription.FieldNextBillingAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CancelAtPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCancelAtPeriodEnd, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(subscription.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(subscription.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(subscription.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(subscription.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReferenceID(); ok {
+		_spec.SetField(subscription.FieldReferenceID, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceIDCleared() {
+		_spec.ClearField(subscription.FieldReferenceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(subscription.FieldMetadata, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.BillingCyclesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.BillingCyclesTable,
+			Columns: []string{subscription.BillingCyclesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingcycle.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBillingCyclesIDs(); len(nodes) > 0 && !_u.mutation.BillingCyclesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.BillingCyclesTable,
+			Columns: []string{subscription.BillingCyclesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingcycle.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BillingCyclesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.BillingCyclesTable,
+			Columns: []string{subscription.BillingCyclesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingcycle.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.AdjustmentsTable,
+			Columns: []string{subscription.AdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionadjustment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAdjustmentsIDs(); len(nodes) > 0 && !_u.mutation.AdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.AdjustmentsTable,
+			Columns: []string{subscription.AdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionadjustment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AdjustmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.AdjustmentsTable,
+			Columns: []string{subscription.AdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionadjustment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MetersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.MetersTable,
+			Columns: []string{subscription.MetersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionmeter.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMetersIDs(); len(nodes) > 0 && !_u.mutation.MetersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.MetersTable,
+			Columns: []string{subscription.MetersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionmeter.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MetersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.MetersTable,
+			Columns: []string{subscription.MetersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionmeter.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SubscriptionUpdateOne is the builder for updating a single Subscription entity.
+type SubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubscriptionMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *SubscriptionUpdateOne) SetTenantID(v uuid.UUID) *SubscriptionUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableTenantID(v *uuid.UUID) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *SubscriptionUpdateOne) SetCustomerID(v uuid.UUID) *SubscriptionUpdateOne {
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableCustomerID(v *uuid.UUID) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// SetPlanCode sets the "plan_code" field.
+func (_u *SubscriptionUpdateOne) SetPlanCode(v string) *SubscriptionUpdateOne {
+	_u.mutation.SetPlanCode(v)
+	return _u
+}
+
+// SetNillablePlanCode sets the "plan_code" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillablePlanCode(v *string) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetPlanCode(*v)
+	}
+	return _u
+}
+
+// SetPlanName sets the "plan_name" field.
+func (_u *SubscriptionUpdateOne) SetPlanName(v string) *SubscriptionUpdateOne {
+	_u.mutation.SetPlanName(v)
+	return _u
+}
+
+// SetNillablePlanName sets the "plan_name" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillablePlanName(v *string) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetPlanName(*v)
+	}
+	return _u
+}
+
+// ClearPlanName clears the value of the "plan_name" field.
+func (_u *SubscriptionUpdateOne) ClearPlanName() *SubscriptionUpdateOne {
+	_u.mutation.ClearPlanName()
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *SubscriptionUpdateOne) SetPrice(v decimal.Decimal) *SubscriptionUpdateOne {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillablePrice(v *decimal.Decimal) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *SubscriptionUpdateOne) AddPrice(v decimal.Decimal) *SubscriptionUpdateOne {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *SubscriptionUpdateOne) SetCurrency(v string) *SubscriptionUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableCurrency(v *string) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetBillingInterval sets the "billing_interval" field.
+func (_u *SubscriptionUpdateOne) SetBillingInterval(v string) *SubscriptionUpdateOne {
+	_u.mutation.SetBillingInterval(v)
+	return _u
+}
+
+// SetNillableBillingInterval sets the "billing_interval" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableBillingInterval(v *string) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetBillingInterval(*v)
+	}
+	return _u
+}
+
+// SetIntervalCount sets the "interval_count" field.
+func (_u *SubscriptionUpdateOne) SetIntervalCount(v int) *SubscriptionUpdateOne {
+	_u.mutation.ResetIntervalCount()
+	_u.mutation.SetIntervalCount(v)
+	return _u
+}
+
+// SetNillableIntervalCount sets the "interval_count" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableIntervalCount(v *int) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetIntervalCount(*v)
+	}
+	return _u
+}
+
+// AddIntervalCount adds value to the "interval_count" field.
+func (_u *SubscriptionUpdateOne) AddIntervalCount(v int) *SubscriptionUpdateOne {
+	_u.mutation.AddIntervalCount(v)
+	return _u
+}
+
+// SetBillingAnchorDay sets the "billing_anchor_day" field.
+func (_u *SubscriptionUpdateOne) SetBillingAnchorDay(v int) *SubscriptionUpdateOne {
+	_u.mutation.ResetBillingAnchorDay()
+	_u.mutation.SetBillingAnchorDay(v)
+	return _u
+}
+
+// SetNillableBillingAnchorDay sets the "billing_anchor_day" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableBillingAnchorDay(v *int) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetBillingAnchorDay(*v)
+	}
+	return _u
+}
+
+// AddBillingAnchorDay adds value to the "billing_anchor_day" field.
+func (_u *SubscriptionUpdateOne) AddBillingAnchorDay(v int) *SubscriptionUpdateOne {
+	_u.mutation.AddBillingAnchorDay(v)
+	return _u
+}
+
+// ClearBillingAnchorDay clears the value of the "billing_anchor_day" field.
+func (_u *SubscriptionUpdateOne) ClearBillingAnchorDay() *SubscriptionUpdateOne {
+	_u.mutation.ClearBillingAnchorDay()
+	return _u
+}
+
+// SetDaysUntilDue sets the "days_until_due" field.
+func (_u *SubscriptionUpdateOne) SetDaysUntilDue(v int) *SubscriptionUpdateOne {
+	_u.mutation.ResetDaysUntilDue()
+	_u.mutation.SetDaysUntilDue(v)
+	return _u
+}
+
+// SetNillableDaysUntilDue sets the "days_until_due" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableDaysUntilDue(v *int) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetDaysUntilDue(*v)
+	}
+	return _u
+}
+
+// AddDaysUntilDue adds value to the "days_until_due" field.
+func (_u *SubscriptionUpdateOne) AddDaysUntilDue(v int) *SubscriptionUpdateOne {
+	_u.mutation.AddDaysUntilDue(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SubscriptionUpdateOne) SetStatus(v string) *SubscriptionUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableStatus(v *string) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTrialEnd sets the "trial_end" field.
+func (_u *SubscriptionUpdateOne) SetTrialEnd(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetTrialEnd(v)
+	return _u
+}
+
+// SetNillableTrialEnd sets the "trial_end" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableTrialEnd(v *time.Time) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetTrialEnd(*v)
+	}
+	return _u
+}
+
+// ClearTrialEnd clears the value of the "trial_end" field.
+func (_u *SubscriptionUpdateOne) ClearTrialEnd() *SubscriptionUpdateOne {
+	_u.mutation.ClearTrialEnd()
+	return _u
+}
+
+// SetCurrentPeriodStart sets the "current_period_start" field.
+func (_u *SubscriptionUpdateOne) SetCurrentPeriodStart(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetCurrentPeriodStart(v)
+	return _u
+}
+
+// SetNillableCurrentPeriodStart sets the "current_period_start" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableCurrentPeriodStart(v *time.Time) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetCurrentPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (_u *SubscriptionUpdateOne) SetCurrentPeriodEnd(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetCurrentPeriodEnd(v)
+	return _u
+}
+
+// SetNillableCurrentPeriodEnd sets the "current_period_end" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableCurrentPeriodEnd(v *time.Time) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetCurrentPeriodEnd(*v)
+	}
+	return _u
+}
+
+// SetNextBillingAt sets the "next_billing_at" field.
+func (_u *SubscriptionUpdateOne) SetNextBillingAt(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetNextBillingAt(v)
+	return _u
+}
+
+// SetNillableNextBillingAt sets the "next_billing_at" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableNextBillingAt(v *time.Time) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetNextBillingAt(*v)
+	}
+	return _u
+}
+
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (_u *SubscriptionUpdateOne) SetCancelAtPeriodEnd(v bool) *SubscriptionUpdateOne {
+	_u.mutation.SetCancelAtPeriodEnd(v)
+	return _u
+}
+
+// SetNillableCancelAtPeriodEnd sets the "cancel_at_period_end" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableCancelAtPeriodEnd(v *bool) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetCancelAtPeriodEnd(*v)
+	}
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *SubscriptionUpdateOne) SetCancelledAt(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableCancelledAt(v *time.Time) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *SubscriptionUpdateOne) ClearCancelledAt() *SubscriptionUpdateOne {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *SubscriptionUpdateOne) SetEndedAt(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableEndedAt(v *time.Time) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *SubscriptionUpdateOne) ClearEndedAt() *SubscriptionUpdateOne {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (_u *SubscriptionUpdateOne) SetReferenceID(v string) *SubscriptionUpdateOne {
+	_u.mutation.SetReferenceID(v)
+	return _u
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableReferenceID(v *string) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetReferenceID(*v)
+	}
+	return _u
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (_u *SubscriptionUpdateOne) ClearReferenceID() *SubscriptionUpdateOne {
+	_u.mutation.ClearReferenceID()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *SubscriptionUpdateOne) SetMetadata(v map[string]interface{}) *SubscriptionUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SubscriptionUpdateOne) SetUpdatedAt(v time.Time) *SubscriptionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddBillingCycleIDs adds the "billing_cycles" edge to the BillingCycle entity by IDs.
+func (_u *SubscriptionUpdateOne) AddBillingCycleIDs(ids ...uuid.UUID) *SubscriptionUpdateOne {
+	_u.mutation.AddBillingCycleIDs(ids...)
+	return _u
+}
+
+// AddBillingCycles adds the "billing_cycles" edges to the BillingCycle entity.
+func (_u *SubscriptionUpdateOne) AddBillingCycles(v ...*BillingCycle) *SubscriptionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBillingCycleIDs(ids...)
+}
+
+// AddAdjustmentIDs adds the "adjustments" edge to the SubscriptionAdjustment entity by IDs.
+func (_u *SubscriptionUpdateOne) AddAdjustmentIDs(ids ...uuid.UUID) *SubscriptionUpdateOne {
+	_u.mutation.AddAdjustmentIDs(ids...)
+	return _u
+}
+
+// AddAdjustments adds the "adjustments" edges to the SubscriptionAdjustment entity.
+func (_u *SubscriptionUpdateOne) AddAdjustments(v ...*SubscriptionAdjustment) *SubscriptionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAdjustmentIDs(ids...)
+}
+
+// AddMeterIDs adds the "meters" edge to the SubscriptionMeter entity by IDs.
+func (_u *SubscriptionUpdateOne) AddMeterIDs(ids ...uuid.UUID) *SubscriptionUpdateOne {
+	_u.mutation.AddMeterIDs(ids...)
+	return _u
+}
+
+// AddMeters adds the "meters" edges to the SubscriptionMeter entity.
+func (_u *SubscriptionUpdateOne) AddMeters(v ...*SubscriptionMeter) *SubscriptionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMeterIDs(ids...)
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (_u *SubscriptionUpdateOne) Mutation() *SubscriptionMutation {
+	return _u.mutation
+}
+
+// ClearBillingCycles clears all "billing_cycles" edges to the BillingCycle entity.
+func (_u *SubscriptionUpdateOne) ClearBillingCycles() *SubscriptionUpdateOne {
+	_u.mutation.ClearBillingCycles()
+	return _u
+}
+
+// RemoveBillingCycleIDs removes the "billing_cycles" edge to BillingCycle entities by IDs.
+func (_u *SubscriptionUpdateOne) RemoveBillingCycleIDs(ids ...uuid.UUID) *SubscriptionUpdateOne {
+	_u.mutation.RemoveBillingCycleIDs(ids...)
+	return _u
+}
+
+// RemoveBillingCycles removes "billing_cycles" edges to BillingCycle entities.
+func (_u *SubscriptionUpdateOne) RemoveBillingCycles(v ...*BillingCycle) *SubscriptionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBillingCycleIDs(ids...)
+}
+
+// ClearAdjustments clears all "adjustments" edges to the SubscriptionAdjustment entity.
+func (_u *SubscriptionUpdateOne) ClearAdjustments() *SubscriptionUpdateOne {
+	_u.mutation.ClearAdjustments()
+	return _u
+}
+
+// RemoveAdjustmentIDs removes the "adjustments" edge to SubscriptionAdjustment entities by IDs.
+func (_u *SubscriptionUpdateOne) RemoveAdjustmentIDs(ids ...uuid.UUID) *SubscriptionUpdateOne {
+	_u.mutation.RemoveAdjustmentIDs(ids...)
+	return _u
+}
+
+// RemoveAdjustments removes "adjustments" edges to SubscriptionAdjustment entities.
+func (_u *SubscriptionUpdateOne) RemoveAdjustments(v ...*SubscriptionAdjustment) *SubscriptionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAdjustmentIDs(ids...)
+}
+
+// ClearMeters clears all "meters" edges to the SubscriptionMeter entity.
+func (_u *SubscriptionUpdateOne) ClearMeters() *SubscriptionUpdateOne {
+	_u.mutation.ClearMeters()
+	return _u
+}
+
+// RemoveMeterIDs removes the "meters" edge to SubscriptionMeter entities by IDs.
+func (_u *SubscriptionUpdateOne) RemoveMeterIDs(ids ...uuid.UUID) *SubscriptionUpdateOne {
+	_u.mutation.RemoveMeterIDs(ids...)
+	return _u
+}
+
+// RemoveMeters removes "meters" edges to SubscriptionMeter entities.
+func (_u *SubscriptionUpdateOne) RemoveMeters(v ...*SubscriptionMeter) *SubscriptionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMeterIDs(ids...)
+}
+
+// Where appends a list predicates to the SubscriptionUpdate builder.
+func (_u *SubscriptionUpdateOne) Where(ps ...predicate.Subscription) *SubscriptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SubscriptionUpdateOne) Select(field string, fields ...string) *SubscriptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Subscription entity.
+func (_u *SubscriptionUpdateOne) Save(ctx context.Context) (*Subscription, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SubscriptionUpdateOne) SaveX(ctx context.Context) *Subscription {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SubscriptionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := subscription.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SubscriptionUpdateOne) check() error {
+	if v, ok := _u.mutation.PlanCode(); ok {
+		if err := subscription.PlanCodeValidator(v); err != nil {
+			return &ValidationError{Name: "plan_code", err: fmt.Errorf(`ent: validator failed for field "Subscription.plan_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IntervalCount(); ok {
+		if err := subscription.IntervalCountValidator(v); err != nil {
+			return &ValidationError{Name: "interval_count", err: fmt.Errorf(`ent: validator failed for field "Subscription.interval_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BillingAnchorDay(); ok {
+		if err := subscription.BillingAnchorDayValidator(v); err != nil {
+			return &ValidationError{Name: "billing_anchor_day", err: fmt.Errorf(`ent: validator failed for field "Subscription.billing_anchor_day": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DaysUntilDue(); ok {
+		if err := subscription.DaysUntilDueValidator(v); err != nil {
+			return &ValidationError{Name: "days_until_due", err: fmt.Errorf(`ent: validator failed for field "Subscription.days_until_due": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscription, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscription.Table, subscription.Columns, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Subscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscription.FieldID)
+		for _, f := range fields {
+			if !subscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != subscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(subscription.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(subscription.FieldCustomerID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PlanCode(); ok {
+		_spec.SetField(subscription.FieldPlanCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PlanName(); ok {
+		_spec.SetField(subscription.FieldPlanName, field.TypeString, value)
+	}
+	if _u.mutation.PlanNameCleared() {
+		_spec.ClearField(subscription.FieldPlanName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(subscription.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(subscription.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(subscription.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BillingInterval(); ok {
+		_spec.SetField(subscription.FieldBillingInterval, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IntervalCount(); ok {
+		_spec.SetField(subscription.FieldIntervalCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIntervalCount(); ok {
+		_spec.AddField(subscription.FieldIntervalCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BillingAnchorDay(); ok {
+		_spec.SetField(subscription.FieldBillingAnchorDay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBillingAnchorDay(); ok {
+		_spec.AddField(subscription.FieldBillingAnchorDay, field.TypeInt, value)
+	}
+	if _u.mutation.BillingAnchorDayCleared() {
+		_spec.ClearField(subscription.FieldBillingAnchorDay, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DaysUntilDue(); ok {
+		_spec.SetField(subscription.FieldDaysUntilDue, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDaysUntilDue(); ok {
+		_spec.AddField(subscription.FieldDaysUntilDue, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(subscription.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TrialEnd(); ok {
+		_spec.SetField(subscription.FieldTrialEnd, field.TypeTime, value)
+	}
+	if _u.mutation.TrialEndCleared() {
+		_spec.ClearField(subscription.FieldTrialEnd, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CurrentPeriodStart(); ok {
+		_spec.SetField(subscription.FieldCurrentPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CurrentPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCurrentPeriodEnd, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.NextBillingAt(); ok {
+		_spec.SetField(subscription.FieldNextBillingAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CancelAtPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCancelAtPeriodEnd, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(subscription.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(subscription.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(subscription.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(subscription.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReferenceID(); ok {
+		_spec.SetField(subscription.FieldReferenceID, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceIDCleared() {
+		_spec.ClearField(subscription.FieldReferenceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(subscription.FieldMetadata, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.BillingCyclesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.BillingCyclesTable,
+			Columns: []string{subscription.BillingCyclesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingcycle.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBillingCyclesIDs(); len(nodes) > 0 && !_u.mutation.BillingCyclesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.BillingCyclesTable,
+			Columns: []string{subscription.BillingCyclesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingcycle.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BillingCyclesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.BillingCyclesTable,
+			Columns: []string{subscription.BillingCyclesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(billingcycle.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.AdjustmentsTable,
+			Columns: []string{subscription.AdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionadjustment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAdjustmentsIDs(); len(nodes) > 0 && !_u.mutation.AdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.AdjustmentsTable,
+			Columns: []string{subscription.AdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionadjustment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AdjustmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.AdjustmentsTable,
+			Columns: []string{subscription.AdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionadjustment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MetersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.MetersTable,
+			Columns: []string{subscription.MetersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionmeter.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMetersIDs(); len(nodes) > 0 && !_u.mutation.MetersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.MetersTable,
+			Columns: []string{subscription.MetersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionmeter.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MetersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscription.MetersTable,
+			Columns: []string{subscription.MetersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionmeter.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Subscription{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/subscription"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionadjustment"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionAdjustment is the model entity for the SubscriptionAdjustment schema.
+type SubscriptionAdjustment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Subscription identifier
+	SubscriptionID uuid.UUID `json:"subscription_id,omitempty"`
+	// Kind: proration, charge, credit
+	Kind string `json:"kind,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Signed amount; negative values are credits
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// ISO currency code
+	Currency string `json:"currency,omitempty"`
+	// Cycle the adjustment was billed on (empty while pending)
+	BillingCycleID uuid.UUID `json:"billing_cycle_id,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SubscriptionAdjustmentQuery when eager-loading is set.
+	Edges        SubscriptionAdjustmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SubscriptionAdjustmentEdges holds the relations/edges for other nodes in the graph.
+type SubscriptionAdjustmentEdges struct {
+	// Subscription holds the value of the subscription edge.
+	Subscription *Subscription `json:"subscription,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SubscriptionOrErr returns the Subscription value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SubscriptionAdjustmentEdges) SubscriptionOrErr() (*Subscription, error) {
+	if e.Subscription != nil {
+		return e.Subscription, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: subscription.Label}
+	}
+	return nil, &NotLoadedError{edge: "subscription"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SubscriptionAdjustment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case subscriptionadjustment.FieldMetadata:
+			values[i] = new([]byte)
+		case subscriptionadjustment.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case subscriptionadjustment.FieldKind, subscriptionadjustment.FieldDescription, subscriptionadjustment.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case subscriptionadjustment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case subscriptionadjustment.FieldID, subscriptionadjustment.FieldTenantID, subscriptionadjustment.FieldSubscriptionID, subscriptionadjustment.FieldBillingCycleID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SubscriptionAdjustment fields.
+func (_m *SubscriptionAdjustment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case subscriptionadjustment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case subscriptionadjustment.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case subscriptionadjustment.FieldSubscriptionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value != nil {
+				_m.SubscriptionID = *value
+			}
+		case subscriptionadjustment.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case subscriptionadjustment.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case subscriptionadjustment.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case subscriptionadjustment.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case subscriptionadjustment.FieldBillingCycleID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_cycle_id", values[i])
+			} else if value != nil {
+				_m.BillingCycleID = *value
+			}
+		case subscriptionadjustment.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case subscriptionadjustment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SubscriptionAdjustment.
+// This includes values selected through modifiers, order, etc.
+func (_m *SubscriptionAdjustment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySubscription queries the "subscription" edge of the SubscriptionAdjustment entity.
+func (_m *SubscriptionAdjustment) QuerySubscription() *SubscriptionQuery {
+	return NewSubscriptionAdjustmentClient(_m.config).QuerySubscription(_m)
+}
+
+// Update returns a builder for updating this SubscriptionAdjustment.
+// Note that you need to call SubscriptionAdjustment.Unwrap() before calling this method if this SubscriptionAdjustment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SubscriptionAdjustment) Update() *SubscriptionAdjustmentUpdateOne {
+	return NewSubscriptionAdjustmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SubscriptionAdjustment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SubscriptionAdjustment) Unwrap() *SubscriptionAdjustment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SubscriptionAdjustment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SubscriptionAdjustment) String() string {
+	var builder strings.Builder
+	builder.WriteString("SubscriptionAdjustment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("subscription_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SubscriptionID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("billing_cycle_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BillingCycleID))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SubscriptionAdjustments is a parsable slice of SubscriptionAdjustment.
+type SubscriptionAdjustments []*SubscriptionAdjustment
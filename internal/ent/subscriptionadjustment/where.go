@@ -0,0 +1,521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package subscriptionadjustment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEQ(FieldTenantID, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEQ(FieldKind, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEQ(FieldDescription, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEQ(FieldAmount, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEQ(FieldCurrency, v))
+}
+
+// BillingCycleID applies equality check predicate on the "billing_cycle_id" field. It's identical to BillingCycleIDEQ.
+func BillingCycleID(v uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEQ(FieldBillingCycleID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldLTE(FieldTenantID, v))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldContainsFold(FieldKind, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldLTE(FieldAmount, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// BillingCycleIDEQ applies the EQ predicate on the "billing_cycle_id" field.
+func BillingCycleIDEQ(v uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEQ(FieldBillingCycleID, v))
+}
+
+// BillingCycleIDNEQ applies the NEQ predicate on the "billing_cycle_id" field.
+func BillingCycleIDNEQ(v uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNEQ(FieldBillingCycleID, v))
+}
+
+// BillingCycleIDIn applies the In predicate on the "billing_cycle_id" field.
+func BillingCycleIDIn(vs ...uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldIn(FieldBillingCycleID, vs...))
+}
+
+// BillingCycleIDNotIn applies the NotIn predicate on the "billing_cycle_id" field.
+func BillingCycleIDNotIn(vs ...uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNotIn(FieldBillingCycleID, vs...))
+}
+
+// BillingCycleIDGT applies the GT predicate on the "billing_cycle_id" field.
+func BillingCycleIDGT(v uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldGT(FieldBillingCycleID, v))
+}
+
+// BillingCycleIDGTE applies the GTE predicate on the "billing_cycle_id" field.
+func BillingCycleIDGTE(v uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldGTE(FieldBillingCycleID, v))
+}
+
+// BillingCycleIDLT applies the LT predicate on the "billing_cycle_id" field.
+func BillingCycleIDLT(v uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldLT(FieldBillingCycleID, v))
+}
+
+// BillingCycleIDLTE applies the LTE predicate on the "billing_cycle_id" field.
+func BillingCycleIDLTE(v uuid.UUID) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldLTE(FieldBillingCycleID, v))
+}
+
+// BillingCycleIDIsNil applies the IsNil predicate on the "billing_cycle_id" field.
+func BillingCycleIDIsNil() predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldIsNull(FieldBillingCycleID))
+}
+
+// BillingCycleIDNotNil applies the NotNil predicate on the "billing_cycle_id" field.
+func BillingCycleIDNotNil() predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNotNull(FieldBillingCycleID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasSubscription applies the HasEdge predicate on the "subscription" edge.
+func HasSubscription() predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SubscriptionTable, SubscriptionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubscriptionWith applies the HasEdge predicate on the "subscription" edge with a given conditions (other predicates).
+func HasSubscriptionWith(preds ...predicate.Subscription) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(func(s *sql.Selector) {
+		step := newSubscriptionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SubscriptionAdjustment) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SubscriptionAdjustment) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SubscriptionAdjustment) predicate.SubscriptionAdjustment {
+	return predicate.SubscriptionAdjustment(sql.NotPredicates(p))
+}
@@ -0,0 +1,1012 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/subscription"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionadjustment"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionAdjustmentCreate is the builder for creating a SubscriptionAdjustment entity.
+type SubscriptionAdjustmentCreate struct {
+	config
+	mutation *SubscriptionAdjustmentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *SubscriptionAdjustmentCreate) SetTenantID(v uuid.UUID) *SubscriptionAdjustmentCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_c *SubscriptionAdjustmentCreate) SetSubscriptionID(v uuid.UUID) *SubscriptionAdjustmentCreate {
+	_c.mutation.SetSubscriptionID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *SubscriptionAdjustmentCreate) SetKind(v string) *SubscriptionAdjustmentCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *SubscriptionAdjustmentCreate) SetNillableKind(v *string) *SubscriptionAdjustmentCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *SubscriptionAdjustmentCreate) SetDescription(v string) *SubscriptionAdjustmentCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *SubscriptionAdjustmentCreate) SetAmount(v decimal.Decimal) *SubscriptionAdjustmentCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *SubscriptionAdjustmentCreate) SetCurrency(v string) *SubscriptionAdjustmentCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *SubscriptionAdjustmentCreate) SetNillableCurrency(v *string) *SubscriptionAdjustmentCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetBillingCycleID sets the "billing_cycle_id" field.
+func (_c *SubscriptionAdjustmentCreate) SetBillingCycleID(v uuid.UUID) *SubscriptionAdjustmentCreate {
+	_c.mutation.SetBillingCycleID(v)
+	return _c
+}
+
+// SetNillableBillingCycleID sets the "billing_cycle_id" field if the given value is not nil.
+func (_c *SubscriptionAdjustmentCreate) SetNillableBillingCycleID(v *uuid.UUID) *SubscriptionAdjustmentCreate {
+	if v != nil {
+		_c.SetBillingCycleID(*v)
+	}
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *SubscriptionAdjustmentCreate) SetMetadata(v map[string]interface{}) *SubscriptionAdjustmentCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SubscriptionAdjustmentCreate) SetCreatedAt(v time.Time) *SubscriptionAdjustmentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SubscriptionAdjustmentCreate) SetNillableCreatedAt(v *time.Time) *SubscriptionAdjustmentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SubscriptionAdjustmentCreate) SetID(v uuid.UUID) *SubscriptionAdjustmentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SubscriptionAdjustmentCreate) SetNillableID(v *uuid.UUID) *SubscriptionAdjustmentCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetSubscription sets the "subscription" edge to the Subscription entity.
+func (_c *SubscriptionAdjustmentCreate) SetSubscription(v *Subscription) *SubscriptionAdjustmentCreate {
+	return _c.SetSubscriptionID(v.ID)
+}
+
+// Mutation returns the SubscriptionAdjustmentMutation object of the builder.
+func (_c *SubscriptionAdjustmentCreate) Mutation() *SubscriptionAdjustmentMutation {
+	return _c.mutation
+}
+
+// Save creates the SubscriptionAdjustment in the database.
+func (_c *SubscriptionAdjustmentCreate) Save(ctx context.Context) (*SubscriptionAdjustment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SubscriptionAdjustmentCreate) SaveX(ctx context.Context) *SubscriptionAdjustment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SubscriptionAdjustmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SubscriptionAdjustmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SubscriptionAdjustmentCreate) defaults() {
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := subscriptionadjustment.DefaultKind
+		_c.mutation.SetKind(v)
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := subscriptionadjustment.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		v := subscriptionadjustment.DefaultMetadata
+		_c.mutation.SetMetadata(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := subscriptionadjustment.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := subscriptionadjustment.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SubscriptionAdjustmentCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "SubscriptionAdjustment.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.SubscriptionID(); !ok {
+		return &ValidationError{Name: "subscription_id", err: errors.New(`ent: missing required field "SubscriptionAdjustment.subscription_id"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "SubscriptionAdjustment.kind"`)}
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "SubscriptionAdjustment.description"`)}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := subscriptionadjustment.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "SubscriptionAdjustment.description": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "SubscriptionAdjustment.amount"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "SubscriptionAdjustment.currency"`)}
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		return &ValidationError{Name: "metadata", err: errors.New(`ent: missing required field "SubscriptionAdjustment.metadata"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SubscriptionAdjustment.created_at"`)}
+	}
+	if len(_c.mutation.SubscriptionIDs()) == 0 {
+		return &ValidationError{Name: "subscription", err: errors.New(`ent: missing required edge "SubscriptionAdjustment.subscription"`)}
+	}
+	return nil
+}
+
+func (_c *SubscriptionAdjustmentCreate) sqlSave(ctx context.Context) (*SubscriptionAdjustment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SubscriptionAdjustmentCreate) createSpec() (*SubscriptionAdjustment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SubscriptionAdjustment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(subscriptionadjustment.Table, sqlgraph.NewFieldSpec(subscriptionadjustment.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(subscriptionadjustment.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(subscriptionadjustment.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(subscriptionadjustment.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(subscriptionadjustment.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(subscriptionadjustment.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.BillingCycleID(); ok {
+		_spec.SetField(subscriptionadjustment.FieldBillingCycleID, field.TypeUUID, value)
+		_node.BillingCycleID = value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(subscriptionadjustment.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(subscriptionadjustment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscriptionadjustment.SubscriptionTable,
+			Columns: []string{subscriptionadjustment.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SubscriptionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SubscriptionAdjustment.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SubscriptionAdjustmentUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SubscriptionAdjustmentCreate) OnConflict(opts ...sql.ConflictOption) *SubscriptionAdjustmentUpsertOne {
+	_c.conflict = opts
+	return &SubscriptionAdjustmentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SubscriptionAdjustment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SubscriptionAdjustmentCreate) OnConflictColumns(columns ...string) *SubscriptionAdjustmentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SubscriptionAdjustmentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SubscriptionAdjustmentUpsertOne is the builder for "upsert"-ing
+	//  one SubscriptionAdjustment node.
+	SubscriptionAdjustmentUpsertOne struct {
+		create *SubscriptionAdjustmentCreate
+	}
+
+	// SubscriptionAdjustmentUpsert is the "OnConflict" setter.
+	SubscriptionAdjustmentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SubscriptionAdjustmentUpsert) SetTenantID(v uuid.UUID) *SubscriptionAdjustmentUpsert {
+	u.Set(subscriptionadjustment.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsert) UpdateTenantID() *SubscriptionAdjustmentUpsert {
+	u.SetExcluded(subscriptionadjustment.FieldTenantID)
+	return u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *SubscriptionAdjustmentUpsert) SetSubscriptionID(v uuid.UUID) *SubscriptionAdjustmentUpsert {
+	u.Set(subscriptionadjustment.FieldSubscriptionID, v)
+	return u
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsert) UpdateSubscriptionID() *SubscriptionAdjustmentUpsert {
+	u.SetExcluded(subscriptionadjustment.FieldSubscriptionID)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *SubscriptionAdjustmentUpsert) SetKind(v string) *SubscriptionAdjustmentUpsert {
+	u.Set(subscriptionadjustment.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsert) UpdateKind() *SubscriptionAdjustmentUpsert {
+	u.SetExcluded(subscriptionadjustment.FieldKind)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *SubscriptionAdjustmentUpsert) SetDescription(v string) *SubscriptionAdjustmentUpsert {
+	u.Set(subscriptionadjustment.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsert) UpdateDescription() *SubscriptionAdjustmentUpsert {
+	u.SetExcluded(subscriptionadjustment.FieldDescription)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *SubscriptionAdjustmentUpsert) SetAmount(v decimal.Decimal) *SubscriptionAdjustmentUpsert {
+	u.Set(subscriptionadjustment.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsert) UpdateAmount() *SubscriptionAdjustmentUpsert {
+	u.SetExcluded(subscriptionadjustment.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *SubscriptionAdjustmentUpsert) AddAmount(v decimal.Decimal) *SubscriptionAdjustmentUpsert {
+	u.Add(subscriptionadjustment.FieldAmount, v)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *SubscriptionAdjustmentUpsert) SetCurrency(v string) *SubscriptionAdjustmentUpsert {
+	u.Set(subscriptionadjustment.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsert) UpdateCurrency() *SubscriptionAdjustmentUpsert {
+	u.SetExcluded(subscriptionadjustment.FieldCurrency)
+	return u
+}
+
+// SetBillingCycleID sets the "billing_cycle_id" field.
+func (u *SubscriptionAdjustmentUpsert) SetBillingCycleID(v uuid.UUID) *SubscriptionAdjustmentUpsert {
+	u.Set(subscriptionadjustment.FieldBillingCycleID, v)
+	return u
+}
+
+// UpdateBillingCycleID sets the "billing_cycle_id" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsert) UpdateBillingCycleID() *SubscriptionAdjustmentUpsert {
+	u.SetExcluded(subscriptionadjustment.FieldBillingCycleID)
+	return u
+}
+
+// ClearBillingCycleID clears the value of the "billing_cycle_id" field.
+func (u *SubscriptionAdjustmentUpsert) ClearBillingCycleID() *SubscriptionAdjustmentUpsert {
+	u.SetNull(subscriptionadjustment.FieldBillingCycleID)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *SubscriptionAdjustmentUpsert) SetMetadata(v map[string]interface{}) *SubscriptionAdjustmentUpsert {
+	u.Set(subscriptionadjustment.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsert) UpdateMetadata() *SubscriptionAdjustmentUpsert {
+	u.SetExcluded(subscriptionadjustment.FieldMetadata)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SubscriptionAdjustment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(subscriptionadjustment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SubscriptionAdjustmentUpsertOne) UpdateNewValues() *SubscriptionAdjustmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(subscriptionadjustment.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(subscriptionadjustment.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SubscriptionAdjustment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SubscriptionAdjustmentUpsertOne) Ignore() *SubscriptionAdjustmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SubscriptionAdjustmentUpsertOne) DoNothing() *SubscriptionAdjustmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SubscriptionAdjustmentCreate.OnConflict
+// documentation for more info.
+func (u *SubscriptionAdjustmentUpsertOne) Update(set func(*SubscriptionAdjustmentUpsert)) *SubscriptionAdjustmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SubscriptionAdjustmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SubscriptionAdjustmentUpsertOne) SetTenantID(v uuid.UUID) *SubscriptionAdjustmentUpsertOne {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsertOne) UpdateTenantID() *SubscriptionAdjustmentUpsertOne {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *SubscriptionAdjustmentUpsertOne) SetSubscriptionID(v uuid.UUID) *SubscriptionAdjustmentUpsertOne {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.SetSubscriptionID(v)
+	})
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsertOne) UpdateSubscriptionID() *SubscriptionAdjustmentUpsertOne {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.UpdateSubscriptionID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *SubscriptionAdjustmentUpsertOne) SetKind(v string) *SubscriptionAdjustmentUpsertOne {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsertOne) UpdateKind() *SubscriptionAdjustmentUpsertOne {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *SubscriptionAdjustmentUpsertOne) SetDescription(v string) *SubscriptionAdjustmentUpsertOne {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsertOne) UpdateDescription() *SubscriptionAdjustmentUpsertOne {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *SubscriptionAdjustmentUpsertOne) SetAmount(v decimal.Decimal) *SubscriptionAdjustmentUpsertOne {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *SubscriptionAdjustmentUpsertOne) AddAmount(v decimal.Decimal) *SubscriptionAdjustmentUpsertOne {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsertOne) UpdateAmount() *SubscriptionAdjustmentUpsertOne {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *SubscriptionAdjustmentUpsertOne) SetCurrency(v string) *SubscriptionAdjustmentUpsertOne {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsertOne) UpdateCurrency() *SubscriptionAdjustmentUpsertOne {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetBillingCycleID sets the "billing_cycle_id" field.
+func (u *SubscriptionAdjustmentUpsertOne) SetBillingCycleID(v uuid.UUID) *SubscriptionAdjustmentUpsertOne {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.SetBillingCycleID(v)
+	})
+}
+
+// UpdateBillingCycleID sets the "billing_cycle_id" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsertOne) UpdateBillingCycleID() *SubscriptionAdjustmentUpsertOne {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.UpdateBillingCycleID()
+	})
+}
+
+// ClearBillingCycleID clears the value of the "billing_cycle_id" field.
+func (u *SubscriptionAdjustmentUpsertOne) ClearBillingCycleID() *SubscriptionAdjustmentUpsertOne {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.ClearBillingCycleID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *SubscriptionAdjustmentUpsertOne) SetMetadata(v map[string]interface{}) *SubscriptionAdjustmentUpsertOne {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsertOne) UpdateMetadata() *SubscriptionAdjustmentUpsertOne {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *SubscriptionAdjustmentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SubscriptionAdjustmentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SubscriptionAdjustmentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SubscriptionAdjustmentUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SubscriptionAdjustmentUpsertOne.ID is not supported by MySQL driver. Use SubscriptionAdjustmentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SubscriptionAdjustmentUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SubscriptionAdjustmentCreateBulk is the builder for creating many SubscriptionAdjustment entities in bulk.
+type SubscriptionAdjustmentCreateBulk struct {
+	config
+	err      error
+	builders []*SubscriptionAdjustmentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SubscriptionAdjustment entities in the database.
+func (_c *SubscriptionAdjustmentCreateBulk) Save(ctx context.Context) ([]*SubscriptionAdjustment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SubscriptionAdjustment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionAdjustmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SubscriptionAdjustmentCreateBulk) SaveX(ctx context.Context) []*SubscriptionAdjustment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SubscriptionAdjustmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SubscriptionAdjustmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SubscriptionAdjustment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SubscriptionAdjustmentUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SubscriptionAdjustmentCreateBulk) OnConflict(opts ...sql.ConflictOption) *SubscriptionAdjustmentUpsertBulk {
+	_c.conflict = opts
+	return &SubscriptionAdjustmentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SubscriptionAdjustment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SubscriptionAdjustmentCreateBulk) OnConflictColumns(columns ...string) *SubscriptionAdjustmentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SubscriptionAdjustmentUpsertBulk{
+		create: _c,
+	}
+}
+
+// SubscriptionAdjustmentUpsertBulk is the builder for "upsert"-ing
+// a bulk of SubscriptionAdjustment nodes.
+type SubscriptionAdjustmentUpsertBulk struct {
+	create *SubscriptionAdjustmentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SubscriptionAdjustment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(subscriptionadjustment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SubscriptionAdjustmentUpsertBulk) UpdateNewValues() *SubscriptionAdjustmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(subscriptionadjustment.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(subscriptionadjustment.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SubscriptionAdjustment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SubscriptionAdjustmentUpsertBulk) Ignore() *SubscriptionAdjustmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SubscriptionAdjustmentUpsertBulk) DoNothing() *SubscriptionAdjustmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SubscriptionAdjustmentCreateBulk.OnConflict
+// documentation for more info.
+func (u *SubscriptionAdjustmentUpsertBulk) Update(set func(*SubscriptionAdjustmentUpsert)) *SubscriptionAdjustmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SubscriptionAdjustmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SubscriptionAdjustmentUpsertBulk) SetTenantID(v uuid.UUID) *SubscriptionAdjustmentUpsertBulk {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsertBulk) UpdateTenantID() *SubscriptionAdjustmentUpsertBulk {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *SubscriptionAdjustmentUpsertBulk) SetSubscriptionID(v uuid.UUID) *SubscriptionAdjustmentUpsertBulk {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.SetSubscriptionID(v)
+	})
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsertBulk) UpdateSubscriptionID() *SubscriptionAdjustmentUpsertBulk {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.UpdateSubscriptionID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *SubscriptionAdjustmentUpsertBulk) SetKind(v string) *SubscriptionAdjustmentUpsertBulk {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsertBulk) UpdateKind() *SubscriptionAdjustmentUpsertBulk {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *SubscriptionAdjustmentUpsertBulk) SetDescription(v string) *SubscriptionAdjustmentUpsertBulk {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsertBulk) UpdateDescription() *SubscriptionAdjustmentUpsertBulk {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *SubscriptionAdjustmentUpsertBulk) SetAmount(v decimal.Decimal) *SubscriptionAdjustmentUpsertBulk {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *SubscriptionAdjustmentUpsertBulk) AddAmount(v decimal.Decimal) *SubscriptionAdjustmentUpsertBulk {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsertBulk) UpdateAmount() *SubscriptionAdjustmentUpsertBulk {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *SubscriptionAdjustmentUpsertBulk) SetCurrency(v string) *SubscriptionAdjustmentUpsertBulk {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsertBulk) UpdateCurrency() *SubscriptionAdjustmentUpsertBulk {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetBillingCycleID sets the "billing_cycle_id" field.
+func (u *SubscriptionAdjustmentUpsertBulk) SetBillingCycleID(v uuid.UUID) *SubscriptionAdjustmentUpsertBulk {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.SetBillingCycleID(v)
+	})
+}
+
+// UpdateBillingCycleID sets the "billing_cycle_id" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsertBulk) UpdateBillingCycleID() *SubscriptionAdjustmentUpsertBulk {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.UpdateBillingCycleID()
+	})
+}
+
+// ClearBillingCycleID clears the value of the "billing_cycle_id" field.
+func (u *SubscriptionAdjustmentUpsertBulk) ClearBillingCycleID() *SubscriptionAdjustmentUpsertBulk {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.ClearBillingCycleID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *SubscriptionAdjustmentUpsertBulk) SetMetadata(v map[string]interface{}) *SubscriptionAdjustmentUpsertBulk {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *SubscriptionAdjustmentUpsertBulk) UpdateMetadata() *SubscriptionAdjustmentUpsertBulk {
+	return u.Update(func(s *SubscriptionAdjustmentUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *SubscriptionAdjustmentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SubscriptionAdjustmentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SubscriptionAdjustmentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SubscriptionAdjustmentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionadjustment"
+)
+
+// SubscriptionAdjustmentDelete is the builder for deleting a SubscriptionAdjustment entity.
+type SubscriptionAdjustmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionAdjustmentMutation
+}
+
+// Where appends a list predicates to the SubscriptionAdjustmentDelete builder.
+func (_d *SubscriptionAdjustmentDelete) Where(ps ...predicate.SubscriptionAdjustment) *SubscriptionAdjustmentDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *SubscriptionAdjustmentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SubscriptionAdjustmentDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *SubscriptionAdjustmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(subscriptionadjustment.Table, sqlgraph.NewFieldSpec(subscriptionadjustment.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// SubscriptionAdjustmentDeleteOne is the builder for deleting a single SubscriptionAdjustment entity.
+type SubscriptionAdjustmentDeleteOne struct {
+	_d *SubscriptionAdjustmentDelete
+}
+
+// Where appends a list predicates to the SubscriptionAdjustmentDelete builder.
+func (_d *SubscriptionAdjustmentDeleteOne) Where(ps ...predicate.SubscriptionAdjustment) *SubscriptionAdjustmentDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *SubscriptionAdjustmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{subscriptionadjustment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SubscriptionAdjustmentDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/subscription"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionadjustment"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionAdjustmentUpdate is the builder for updating SubscriptionAdjustment entities.
+type SubscriptionAdjustmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionAdjustmentMutation
+}
+
+// Where appends a list predicates to the SubscriptionAdjustmentUpdate builder.
+func (_u *SubscriptionAdjustmentUpdate) Where(ps ...predicate.SubscriptionAdjustment) *SubscriptionAdjustmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *SubscriptionAdjustmentUpdate) SetTenantID(v uuid.UUID) *SubscriptionAdjustmentUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *SubscriptionAdjustmentUpdate) SetNillableTenantID(v *uuid.UUID) *SubscriptionAdjustmentUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_u *SubscriptionAdjustmentUpdate) SetSubscriptionID(v uuid.UUID) *SubscriptionAdjustmentUpdate {
+	_u.mutation.SetSubscriptionID(v)
+	return _u
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_u *SubscriptionAdjustmentUpdate) SetNillableSubscriptionID(v *uuid.UUID) *SubscriptionAdjustmentUpdate {
+	if v != nil {
+		_u.SetSubscriptionID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *SubscriptionAdjustmentUpdate) SetKind(v string) *SubscriptionAdjustmentUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *SubscriptionAdjustmentUpdate) SetNillableKind(v *string) *SubscriptionAdjustmentUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *SubscriptionAdjustmentUpdate) SetDescription(v string) *SubscriptionAdjustmentUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *SubscriptionAdjustmentUpdate) SetNillableDescription(v *string) *SubscriptionAdjustmentUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *SubscriptionAdjustmentUpdate) SetAmount(v decimal.Decimal) *SubscriptionAdjustmentUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *SubscriptionAdjustmentUpdate) SetNillableAmount(v *decimal.Decimal) *SubscriptionAdjustmentUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *SubscriptionAdjustmentUpdate) AddAmount(v decimal.Decimal) *SubscriptionAdjustmentUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *SubscriptionAdjustmentUpdate) SetCurrency(v string) *SubscriptionAdjustmentUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *SubscriptionAdjustmentUpdate) SetNillableCurrency(v *string) *SubscriptionAdjustmentUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetBillingCycleID sets the "billing_cycle_id" field.
+func (_u *SubscriptionAdjustmentUpdate) SetBillingCycleID(v uuid.UUID) *SubscriptionAdjustmentUpdate {
+	_u.mutation.SetBillingCycleID(v)
+	return _u
+}
+
+// SetNillableBillingCycleID sets the "billing_cycle_id" field if the given value is not nil.
+func (_u *SubscriptionAdjustmentUpdate) SetNillableBillingCycleID(v *uuid.UUID) *SubscriptionAdjustmentUpdate {
+	if v != nil {
+		_u.SetBillingCycleID(*v)
+	}
+	return _u
+}
+
+// ClearBillingCycleID clears the value of the "billing_cycle_id" field.
+func (_u *SubscriptionAdjustmentUpdate) ClearBillingCycleID() *SubscriptionAdjustmentUpdate {
+	_u.mutation.ClearBillingCycleID()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *SubscriptionAdjustmentUpdate) SetMetadata(v map[string]interface{}) *SubscriptionAdjustmentUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetSubscription sets the "subscription" edge to the Subscription entity.
+func (_u *SubscriptionAdjustmentUpdate) SetSubscription(v *Subscription) *SubscriptionAdjustmentUpdate {
+	return _u.SetSubscriptionID(v.ID)
+}
+
+// Mutation returns the SubscriptionAdjustmentMutation object of the builder.
+func (_u *SubscriptionAdjustmentUpdate) Mutation() *SubscriptionAdjustmentMutation {
+	return _u.mutation
+}
+
+// ClearSubscription clears the "subscription" edge to the Subscription entity.
+func (_u *SubscriptionAdjustmentUpdate) ClearSubscription() *SubscriptionAdjustmentUpdate {
+	_u.mutation.ClearSubscription()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SubscriptionAdjustmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SubscriptionAdjustmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SubscriptionAdjustmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SubscriptionAdjustmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SubscriptionAdjustmentUpdate) check() error {
+	if v, ok := _u.mutation.Description(); ok {
+		if err := subscriptionadjustment.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "SubscriptionAdjustment.description": %w`, err)}
+		}
+	}
+	if _u.mutation.SubscriptionCleared() && len(_u.mutation.SubscriptionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SubscriptionAdjustment.subscription"`)
+	}
+	return nil
+}
+
+func (_u *SubscriptionAdjustmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscriptionadjustment.Table, subscriptionadjustment.Columns, sqlgraph.NewFieldSpec(subscriptionadjustment.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(subscriptionadjustment.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(subscriptionadjustment.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(subscriptionadjustment.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(subscriptionadjustment.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(subscriptionadjustment.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(subscriptionadjustment.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BillingCycleID(); ok {
+		_spec.SetField(subscriptionadjustment.FieldBillingCycleID, field.TypeUUID, value)
+	}
+	if _u.mutation.BillingCycleIDCleared() {
+		_spec.ClearField(subscriptionadjustment.FieldBillingCycleID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(subscriptionadjustment.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.SubscriptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscriptionadjustment.SubscriptionTable,
+			Columns: []string{subscriptionadjustment.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscriptionadjustment.SubscriptionTable,
+			Columns: []string{subscriptionadjustment.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionadjustment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SubscriptionAdjustmentUpdateOne is the builder for updating a single SubscriptionAdjustment entity.
+type SubscriptionAdjustmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubscriptionAdjustmentMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *SubscriptionAdjustmentUpdateOne) SetTenantID(v uuid.UUID) *SubscriptionAdjustmentUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *SubscriptionAdjustmentUpdateOne) SetNillableTenantID(v *uuid.UUID) *SubscriptionAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_u *SubscriptionAdjustmentUpdateOne) SetSubscriptionID(v uuid.UUID) *SubscriptionAdjustmentUpdateOne {
+	_u.mutation.SetSubscriptionID(v)
+	return _u
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_u *SubscriptionAdjustmentUpdateOne) SetNillableSubscriptionID(v *uuid.UUID) *SubscriptionAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetSubscriptionID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *SubscriptionAdjustmentUpdateOne) SetKind(v string) *SubscriptionAdjustmentUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *SubscriptionAdjustmentUpdateOne) SetNillableKind(v *string) *SubscriptionAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *SubscriptionAdjustmentUpdateOne) SetDescription(v string) *SubscriptionAdjustmentUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *SubscriptionAdjustmentUpdateOne) SetNillableDescription(v *string) *SubscriptionAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *SubscriptionAdjustmentUpdateOne) SetAmount(v decimal.Decimal) *SubscriptionAdjustmentUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *SubscriptionAdjustmentUpdateOne) SetNillableAmount(v *decimal.Decimal) *SubscriptionAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *SubscriptionAdjustmentUpdateOne) AddAmount(v decimal.Decimal) *SubscriptionAdjustmentUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *SubscriptionAdjustmentUpdateOne) SetCurrency(v string) *SubscriptionAdjustmentUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *SubscriptionAdjustmentUpdateOne) SetNillableCurrency(v *string) *SubscriptionAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetBillingCycleID sets the "billing_cycle_id" field.
+func (_u *SubscriptionAdjustmentUpdateOne) SetBillingCycleID(v uuid.UUID) *SubscriptionAdjustmentUpdateOne {
+	_u.mutation.SetBillingCycleID(v)
+	return _u
+}
+
+// SetNillableBillingCycleID sets the "billing_cycle_id" field if the given value is not nil.
+func (_u *SubscriptionAdjustmentUpdateOne) SetNillableBillingCycleID(v *uuid.UUID) *SubscriptionAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetBillingCycleID(*v)
+	}
+	return _u
+}
+
+// ClearBillingCycleID clears the value of the "billing_cycle_id" field.
+func (_u *SubscriptionAdjustmentUpdateOne) ClearBillingCycleID() *SubscriptionAdjustmentUpdateOne {
+	_u.mutation.ClearBillingCycleID()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *SubscriptionAdjustmentUpdateOne) SetMetadata(v map[string]interface{}) *SubscriptionAdjustmentUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetSubscription sets the "subscription" edge to the Subscription entity.
+func (_u *SubscriptionAdjustmentUpdateOne) SetSubscription(v *Subscription) *SubscriptionAdjustmentUpdateOne {
+	return _u.SetSubscriptionID(v.ID)
+}
+
+// Mutation returns the SubscriptionAdjustmentMutation object of the builder.
+func (_u *SubscriptionAdjustmentUpdateOne) Mutation() *SubscriptionAdjustmentMutation {
+	return _u.mutation
+}
+
+// ClearSubscription clears the "subscription" edge to the Subscription entity.
+func (_u *SubscriptionAdjustmentUpdateOne) ClearSubscription() *SubscriptionAdjustmentUpdateOne {
+	_u.mutation.ClearSubscription()
+	return _u
+}
+
+// Where appends a list predicates to the SubscriptionAdjustmentUpdate builder.
+func (_u *SubscriptionAdjustmentUpdateOne) Where(ps ...predicate.SubscriptionAdjustment) *SubscriptionAdjustmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SubscriptionAdjustmentUpdateOne) Select(field string, fields ...string) *SubscriptionAdjustmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SubscriptionAdjustment entity.
+func (_u *SubscriptionAdjustmentUpdateOne) Save(ctx context.Context) (*SubscriptionAdjustment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SubscriptionAdjustmentUpdateOne) SaveX(ctx context.Context) *SubscriptionAdjustment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SubscriptionAdjustmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SubscriptionAdjustmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SubscriptionAdjustmentUpdateOne) check() error {
+	if v, ok := _u.mutation.Description(); ok {
+		if err := subscriptionadjustment.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "SubscriptionAdjustment.description": %w`, err)}
+		}
+	}
+	if _u.mutation.SubscriptionCleared() && len(_u.mutation.SubscriptionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SubscriptionAdjustment.subscription"`)
+	}
+	return nil
+}
+
+func (_u *SubscriptionAdjustmentUpdateOne) sqlSave(ctx context.Context) (_node *SubscriptionAdjustment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscriptionadjustment.Table, subscriptionadjustment.Columns, sqlgraph.NewFieldSpec(subscriptionadjustment.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SubscriptionAdjustment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscriptionadjustment.FieldID)
+		for _, f := range fields {
+			if !subscriptionadjustment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != subscriptionadjustment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(subscriptionadjustment.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(subscriptionadjustment.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(subscriptionadjustment.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(subscriptionadjustment.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(subscriptionadjustment.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(subscriptionadjustment.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BillingCycleID(); ok {
+		_spec.SetField(subscriptionadjustment.FieldBillingCycleID, field.TypeUUID, value)
+	}
+	if _u.mutation.BillingCycleIDCleared() {
+		_spec.ClearField(subscriptionadjustment.FieldBillingCycleID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(subscriptionadjustment.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.SubscriptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscriptionadjustment.SubscriptionTable,
+			Columns: []string{subscriptionadjustment.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscriptionadjustment.SubscriptionTable,
+			Columns: []string{subscriptionadjustment.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SubscriptionAdjustment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionadjustment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,297 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/subscription"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionmeter"
+	"github.com/bengobox/treasury-api/internal/modules/metering/pricing"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionMeter is the model entity for the SubscriptionMeter schema.
+type SubscriptionMeter struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Subscription identifier
+	SubscriptionID uuid.UUID `json:"subscription_id,omitempty"`
+	// Meter code sent by the subscribing service (e.g. api_calls)
+	Code string `json:"code,omitempty"`
+	// Display name used on invoice lines
+	Name string `json:"name,omitempty"`
+	// Unit label (calls, GB, orders)
+	Unit string `json:"unit,omitempty"`
+	// Aggregation: sum, max, last
+	Aggregation string `json:"aggregation,omitempty"`
+	// Pricing model: per_unit, tiered, volume, graduated
+	PricingModel string `json:"pricing_model,omitempty"`
+	// Unit price for the per_unit model
+	UnitPrice decimal.Decimal `json:"unit_price,omitempty"`
+	// Price tiers for the tiered, volume and graduated models
+	Tiers []pricing.Tier `json:"tiers,omitempty"`
+	// Active holds the value of the "active" field.
+	Active bool `json:"active,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SubscriptionMeterQuery when eager-loading is set.
+	Edges        SubscriptionMeterEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SubscriptionMeterEdges holds the relations/edges for other nodes in the graph.
+type SubscriptionMeterEdges struct {
+	// Subscription holds the value of the subscription edge.
+	Subscription *Subscription `json:"subscription,omitempty"`
+	// UsageRecords holds the value of the usage_records edge.
+	UsageRecords []*UsageRecord `json:"usage_records,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// SubscriptionOrErr returns the Subscription value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SubscriptionMeterEdges) SubscriptionOrErr() (*Subscription, error) {
+	if e.Subscription != nil {
+		return e.Subscription, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: subscription.Label}
+	}
+	return nil, &NotLoadedError{edge: "subscription"}
+}
+
+// UsageRecordsOrErr returns the UsageRecords value or an error if the edge
+// was not loaded in eager-loading.
+func (e SubscriptionMeterEdges) UsageRecordsOrErr() ([]*UsageRecord, error) {
+	if e.loadedTypes[1] {
+		return e.UsageRecords, nil
+	}
+	return nil, &NotLoadedError{edge: "usage_records"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SubscriptionMeter) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case subscriptionmeter.FieldTiers, subscriptionmeter.FieldMetadata:
+			values[i] = new([]byte)
+		case subscriptionmeter.FieldUnitPrice:
+			values[i] = new(decimal.Decimal)
+		case subscriptionmeter.FieldActive:
+			values[i] = new(sql.NullBool)
+		case subscriptionmeter.FieldCode, subscriptionmeter.FieldName, subscriptionmeter.FieldUnit, subscriptionmeter.FieldAggregation, subscriptionmeter.FieldPricingModel:
+			values[i] = new(sql.NullString)
+		case subscriptionmeter.FieldCreatedAt, subscriptionmeter.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case subscriptionmeter.FieldID, subscriptionmeter.FieldTenantID, subscriptionmeter.FieldSubscriptionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SubscriptionMeter fields.
+func (_m *SubscriptionMeter) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case subscriptionmeter.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case subscriptionmeter.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case subscriptionmeter.FieldSubscriptionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value != nil {
+				_m.SubscriptionID = *value
+			}
+		case subscriptionmeter.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				_m.Code = value.String
+			}
+		case subscriptionmeter.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case subscriptionmeter.FieldUnit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field unit", values[i])
+			} else if value.Valid {
+				_m.Unit = value.String
+			}
+		case subscriptionmeter.FieldAggregation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field aggregation", values[i])
+			} else if value.Valid {
+				_m.Aggregation = value.String
+			}
+		case subscriptionmeter.FieldPricingModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pricing_model", values[i])
+			} else if value.Valid {
+				_m.PricingModel = value.String
+			}
+		case subscriptionmeter.FieldUnitPrice:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_price", values[i])
+			} else if value != nil {
+				_m.UnitPrice = *value
+			}
+		case subscriptionmeter.FieldTiers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tiers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Tiers); err != nil {
+					return fmt.Errorf("unmarshal field tiers: %w", err)
+				}
+			}
+		case subscriptionmeter.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				_m.Active = value.Bool
+			}
+		case subscriptionmeter.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case subscriptionmeter.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case subscriptionmeter.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SubscriptionMeter.
+// This includes values selected through modifiers, order, etc.
+func (_m *SubscriptionMeter) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySubscription queries the "subscription" edge of the SubscriptionMeter entity.
+func (_m *SubscriptionMeter) QuerySubscription() *SubscriptionQuery {
+	return NewSubscriptionMeterClient(_m.config).QuerySubscription(_m)
+}
+
+// QueryUsageRecords queries the "usage_records" edge of the SubscriptionMeter entity.
+func (_m *SubscriptionMeter) QueryUsageRecords() *UsageRecordQuery {
+	return NewSubscriptionMeterClient(_m.config).QueryUsageRecords(_m)
+}
+
+// Update returns a builder for updating this SubscriptionMeter.
+// Note that you need to call SubscriptionMeter.Unwrap() before calling this method if this SubscriptionMeter
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SubscriptionMeter) Update() *SubscriptionMeterUpdateOne {
+	return NewSubscriptionMeterClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SubscriptionMeter entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SubscriptionMeter) Unwrap() *SubscriptionMeter {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SubscriptionMeter is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SubscriptionMeter) String() string {
+	var builder strings.Builder
+	builder.WriteString("SubscriptionMeter(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("subscription_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SubscriptionID))
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(_m.Code)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("unit=")
+	builder.WriteString(_m.Unit)
+	builder.WriteString(", ")
+	builder.WriteString("aggregation=")
+	builder.WriteString(_m.Aggregation)
+	builder.WriteString(", ")
+	builder.WriteString("pricing_model=")
+	builder.WriteString(_m.PricingModel)
+	builder.WriteString(", ")
+	builder.WriteString("unit_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UnitPrice))
+	builder.WriteString(", ")
+	builder.WriteString("tiers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tiers))
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Active))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SubscriptionMeters is a parsable slice of SubscriptionMeter.
+type SubscriptionMeters []*SubscriptionMeter
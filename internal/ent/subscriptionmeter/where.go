@@ -0,0 +1,729 @@
+// Code generated by ent, DO NOT EDIT.
+
+package subscriptionmeter
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldTenantID, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldCode, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldName, v))
+}
+
+// Unit applies equality check predicate on the "unit" field. It's identical to UnitEQ.
+func Unit(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldUnit, v))
+}
+
+// Aggregation applies equality check predicate on the "aggregation" field. It's identical to AggregationEQ.
+func Aggregation(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldAggregation, v))
+}
+
+// PricingModel applies equality check predicate on the "pricing_model" field. It's identical to PricingModelEQ.
+func PricingModel(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldPricingModel, v))
+}
+
+// UnitPrice applies equality check predicate on the "unit_price" field. It's identical to UnitPriceEQ.
+func UnitPrice(v decimal.Decimal) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
+func Active(v bool) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldActive, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLTE(FieldTenantID, v))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...uuid.UUID) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldContainsFold(FieldCode, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldContainsFold(FieldName, v))
+}
+
+// UnitEQ applies the EQ predicate on the "unit" field.
+func UnitEQ(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldUnit, v))
+}
+
+// UnitNEQ applies the NEQ predicate on the "unit" field.
+func UnitNEQ(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNEQ(FieldUnit, v))
+}
+
+// UnitIn applies the In predicate on the "unit" field.
+func UnitIn(vs ...string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldIn(FieldUnit, vs...))
+}
+
+// UnitNotIn applies the NotIn predicate on the "unit" field.
+func UnitNotIn(vs ...string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNotIn(FieldUnit, vs...))
+}
+
+// UnitGT applies the GT predicate on the "unit" field.
+func UnitGT(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGT(FieldUnit, v))
+}
+
+// UnitGTE applies the GTE predicate on the "unit" field.
+func UnitGTE(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGTE(FieldUnit, v))
+}
+
+// UnitLT applies the LT predicate on the "unit" field.
+func UnitLT(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLT(FieldUnit, v))
+}
+
+// UnitLTE applies the LTE predicate on the "unit" field.
+func UnitLTE(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLTE(FieldUnit, v))
+}
+
+// UnitContains applies the Contains predicate on the "unit" field.
+func UnitContains(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldContains(FieldUnit, v))
+}
+
+// UnitHasPrefix applies the HasPrefix predicate on the "unit" field.
+func UnitHasPrefix(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldHasPrefix(FieldUnit, v))
+}
+
+// UnitHasSuffix applies the HasSuffix predicate on the "unit" field.
+func UnitHasSuffix(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldHasSuffix(FieldUnit, v))
+}
+
+// UnitIsNil applies the IsNil predicate on the "unit" field.
+func UnitIsNil() predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldIsNull(FieldUnit))
+}
+
+// UnitNotNil applies the NotNil predicate on the "unit" field.
+func UnitNotNil() predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNotNull(FieldUnit))
+}
+
+// UnitEqualFold applies the EqualFold predicate on the "unit" field.
+func UnitEqualFold(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEqualFold(FieldUnit, v))
+}
+
+// UnitContainsFold applies the ContainsFold predicate on the "unit" field.
+func UnitContainsFold(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldContainsFold(FieldUnit, v))
+}
+
+// AggregationEQ applies the EQ predicate on the "aggregation" field.
+func AggregationEQ(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldAggregation, v))
+}
+
+// AggregationNEQ applies the NEQ predicate on the "aggregation" field.
+func AggregationNEQ(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNEQ(FieldAggregation, v))
+}
+
+// AggregationIn applies the In predicate on the "aggregation" field.
+func AggregationIn(vs ...string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldIn(FieldAggregation, vs...))
+}
+
+// AggregationNotIn applies the NotIn predicate on the "aggregation" field.
+func AggregationNotIn(vs ...string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNotIn(FieldAggregation, vs...))
+}
+
+// AggregationGT applies the GT predicate on the "aggregation" field.
+func AggregationGT(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGT(FieldAggregation, v))
+}
+
+// AggregationGTE applies the GTE predicate on the "aggregation" field.
+func AggregationGTE(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGTE(FieldAggregation, v))
+}
+
+// AggregationLT applies the LT predicate on the "aggregation" field.
+func AggregationLT(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLT(FieldAggregation, v))
+}
+
+// AggregationLTE applies the LTE predicate on the "aggregation" field.
+func AggregationLTE(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLTE(FieldAggregation, v))
+}
+
+// AggregationContains applies the Contains predicate on the "aggregation" field.
+func AggregationContains(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldContains(FieldAggregation, v))
+}
+
+// AggregationHasPrefix applies the HasPrefix predicate on the "aggregation" field.
+func AggregationHasPrefix(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldHasPrefix(FieldAggregation, v))
+}
+
+// AggregationHasSuffix applies the HasSuffix predicate on the "aggregation" field.
+func AggregationHasSuffix(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldHasSuffix(FieldAggregation, v))
+}
+
+// AggregationEqualFold applies the EqualFold predicate on the "aggregation" field.
+func AggregationEqualFold(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEqualFold(FieldAggregation, v))
+}
+
+// AggregationContainsFold applies the ContainsFold predicate on the "aggregation" field.
+func AggregationContainsFold(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldContainsFold(FieldAggregation, v))
+}
+
+// PricingModelEQ applies the EQ predicate on the "pricing_model" field.
+func PricingModelEQ(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldPricingModel, v))
+}
+
+// PricingModelNEQ applies the NEQ predicate on the "pricing_model" field.
+func PricingModelNEQ(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNEQ(FieldPricingModel, v))
+}
+
+// PricingModelIn applies the In predicate on the "pricing_model" field.
+func PricingModelIn(vs ...string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldIn(FieldPricingModel, vs...))
+}
+
+// PricingModelNotIn applies the NotIn predicate on the "pricing_model" field.
+func PricingModelNotIn(vs ...string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNotIn(FieldPricingModel, vs...))
+}
+
+// PricingModelGT applies the GT predicate on the "pricing_model" field.
+func PricingModelGT(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGT(FieldPricingModel, v))
+}
+
+// PricingModelGTE applies the GTE predicate on the "pricing_model" field.
+func PricingModelGTE(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGTE(FieldPricingModel, v))
+}
+
+// PricingModelLT applies the LT predicate on the "pricing_model" field.
+func PricingModelLT(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLT(FieldPricingModel, v))
+}
+
+// PricingModelLTE applies the LTE predicate on the "pricing_model" field.
+func PricingModelLTE(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLTE(FieldPricingModel, v))
+}
+
+// PricingModelContains applies the Contains predicate on the "pricing_model" field.
+func PricingModelContains(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldContains(FieldPricingModel, v))
+}
+
+// PricingModelHasPrefix applies the HasPrefix predicate on the "pricing_model" field.
+func PricingModelHasPrefix(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldHasPrefix(FieldPricingModel, v))
+}
+
+// PricingModelHasSuffix applies the HasSuffix predicate on the "pricing_model" field.
+func PricingModelHasSuffix(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldHasSuffix(FieldPricingModel, v))
+}
+
+// PricingModelEqualFold applies the EqualFold predicate on the "pricing_model" field.
+func PricingModelEqualFold(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEqualFold(FieldPricingModel, v))
+}
+
+// PricingModelContainsFold applies the ContainsFold predicate on the "pricing_model" field.
+func PricingModelContainsFold(v string) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldContainsFold(FieldPricingModel, v))
+}
+
+// UnitPriceEQ applies the EQ predicate on the "unit_price" field.
+func UnitPriceEQ(v decimal.Decimal) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceNEQ applies the NEQ predicate on the "unit_price" field.
+func UnitPriceNEQ(v decimal.Decimal) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceIn applies the In predicate on the "unit_price" field.
+func UnitPriceIn(vs ...decimal.Decimal) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceNotIn applies the NotIn predicate on the "unit_price" field.
+func UnitPriceNotIn(vs ...decimal.Decimal) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNotIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceGT applies the GT predicate on the "unit_price" field.
+func UnitPriceGT(v decimal.Decimal) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGT(FieldUnitPrice, v))
+}
+
+// UnitPriceGTE applies the GTE predicate on the "unit_price" field.
+func UnitPriceGTE(v decimal.Decimal) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGTE(FieldUnitPrice, v))
+}
+
+// UnitPriceLT applies the LT predicate on the "unit_price" field.
+func UnitPriceLT(v decimal.Decimal) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLT(FieldUnitPrice, v))
+}
+
+// UnitPriceLTE applies the LTE predicate on the "unit_price" field.
+func UnitPriceLTE(v decimal.Decimal) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLTE(FieldUnitPrice, v))
+}
+
+// UnitPriceIsNil applies the IsNil predicate on the "unit_price" field.
+func UnitPriceIsNil() predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldIsNull(FieldUnitPrice))
+}
+
+// UnitPriceNotNil applies the NotNil predicate on the "unit_price" field.
+func UnitPriceNotNil() predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNotNull(FieldUnitPrice))
+}
+
+// TiersIsNil applies the IsNil predicate on the "tiers" field.
+func TiersIsNil() predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldIsNull(FieldTiers))
+}
+
+// TiersNotNil applies the NotNil predicate on the "tiers" field.
+func TiersNotNil() predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNotNull(FieldTiers))
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldActive, v))
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNEQ(FieldActive, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasSubscription applies the HasEdge predicate on the "subscription" edge.
+func HasSubscription() predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SubscriptionTable, SubscriptionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubscriptionWith applies the HasEdge predicate on the "subscription" edge with a given conditions (other predicates).
+func HasSubscriptionWith(preds ...predicate.Subscription) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(func(s *sql.Selector) {
+		step := newSubscriptionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUsageRecords applies the HasEdge predicate on the "usage_records" edge.
+func HasUsageRecords() predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UsageRecordsTable, UsageRecordsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUsageRecordsWith applies the HasEdge predicate on the "usage_records" edge with a given conditions (other predicates).
+func HasUsageRecordsWith(preds ...predicate.UsageRecord) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(func(s *sql.Selector) {
+		step := newUsageRecordsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SubscriptionMeter) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SubscriptionMeter) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SubscriptionMeter) predicate.SubscriptionMeter {
+	return predicate.SubscriptionMeter(sql.NotPredicates(p))
+}
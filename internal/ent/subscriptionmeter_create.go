@@ -0,0 +1,1348 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/subscription"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionmeter"
+	"github.com/bengobox/treasury-api/internal/ent/usagerecord"
+	"github.com/bengobox/treasury-api/internal/modules/metering/pricing"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionMeterCreate is the builder for creating a SubscriptionMeter entity.
+type SubscriptionMeterCreate struct {
+	config
+	mutation *SubscriptionMeterMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *SubscriptionMeterCreate) SetTenantID(v uuid.UUID) *SubscriptionMeterCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_c *SubscriptionMeterCreate) SetSubscriptionID(v uuid.UUID) *SubscriptionMeterCreate {
+	_c.mutation.SetSubscriptionID(v)
+	return _c
+}
+
+// SetCode sets the "code" field.
+func (_c *SubscriptionMeterCreate) SetCode(v string) *SubscriptionMeterCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *SubscriptionMeterCreate) SetName(v string) *SubscriptionMeterCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *SubscriptionMeterCreate) SetNillableName(v *string) *SubscriptionMeterCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetUnit sets the "unit" field.
+func (_c *SubscriptionMeterCreate) SetUnit(v string) *SubscriptionMeterCreate {
+	_c.mutation.SetUnit(v)
+	return _c
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (_c *SubscriptionMeterCreate) SetNillableUnit(v *string) *SubscriptionMeterCreate {
+	if v != nil {
+		_c.SetUnit(*v)
+	}
+	return _c
+}
+
+// SetAggregation sets the "aggregation" field.
+func (_c *SubscriptionMeterCreate) SetAggregation(v string) *SubscriptionMeterCreate {
+	_c.mutation.SetAggregation(v)
+	return _c
+}
+
+// SetNillableAggregation sets the "aggregation" field if the given value is not nil.
+func (_c *SubscriptionMeterCreate) SetNillableAggregation(v *string) *SubscriptionMeterCreate {
+	if v != nil {
+		_c.SetAggregation(*v)
+	}
+	return _c
+}
+
+// SetPricingModel sets the "pricing_model" field.
+func (_c *SubscriptionMeterCreate) SetPricingModel(v string) *SubscriptionMeterCreate {
+	_c.mutation.SetPricingModel(v)
+	return _c
+}
+
+// SetNillablePricingModel sets the "pricing_model" field if the given value is not nil.
+func (_c *SubscriptionMeterCreate) SetNillablePricingModel(v *string) *SubscriptionMeterCreate {
+	if v != nil {
+		_c.SetPricingModel(*v)
+	}
+	return _c
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_c *SubscriptionMeterCreate) SetUnitPrice(v decimal.Decimal) *SubscriptionMeterCreate {
+	_c.mutation.SetUnitPrice(v)
+	return _c
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_c *SubscriptionMeterCreate) SetNillableUnitPrice(v *decimal.Decimal) *SubscriptionMeterCreate {
+	if v != nil {
+		_c.SetUnitPrice(*v)
+	}
+	return _c
+}
+
+// SetTiers sets the "tiers" field.
+func (_c *SubscriptionMeterCreate) SetTiers(v []pricing.Tier) *SubscriptionMeterCreate {
+	_c.mutation.SetTiers(v)
+	return _c
+}
+
+// SetActive sets the "active" field.
+func (_c *SubscriptionMeterCreate) SetActive(v bool) *SubscriptionMeterCreate {
+	_c.mutation.SetActive(v)
+	return _c
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_c *SubscriptionMeterCreate) SetNillableActive(v *bool) *SubscriptionMeterCreate {
+	if v != nil {
+		_c.SetActive(*v)
+	}
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *SubscriptionMeterCreate) SetMetadata(v map[string]interface{}) *SubscriptionMeterCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SubscriptionMeterCreate) SetCreatedAt(v time.Time) *SubscriptionMeterCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SubscriptionMeterCreate) SetNillableCreatedAt(v *time.Time) *SubscriptionMeterCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SubscriptionMeterCreate) SetUpdatedAt(v time.Time) *SubscriptionMeterCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SubscriptionMeterCreate) SetNillableUpdatedAt(v *time.Time) *SubscriptionMeterCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SubscriptionMeterCreate) SetID(v uuid.UUID) *SubscriptionMeterCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SubscriptionMeterCreate) SetNillableID(v *uuid.UUID) *SubscriptionMeterCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetSubscription sets the "subscription" edge to the Subscription entity.
+func (_c *SubscriptionMeterCreate) SetSubscription(v *Subscription) *SubscriptionMeterCreate {
+	return _c.SetSubscriptionID(v.ID)
+}
+
+// AddUsageRecordIDs adds the "usage_records" edge to the UsageRecord entity by IDs.
+func (_c *SubscriptionMeterCreate) AddUsageRecordIDs(ids ...uuid.UUID) *SubscriptionMeterCreate {
+	_c.mutation.AddUsageRecordIDs(ids...)
+	return _c
+}
+
+// AddUsageRecords adds the "usage_records" edges to the UsageRecord entity.
+func (_c *SubscriptionMeterCreate) AddUsageRecords(v ...*UsageRecord) *SubscriptionMeterCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUsageRecordIDs(ids...)
+}
+
+// Mutation returns the SubscriptionMeterMutation object of the builder.
+func (_c *SubscriptionMeterCreate) Mutation() *SubscriptionMeterMutation {
+	return _c.mutation
+}
+
+// Save creates the SubscriptionMeter in the database.
+func (_c *SubscriptionMeterCreate) Save(ctx context.Context) (*SubscriptionMeter, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SubscriptionMeterCreate) SaveX(ctx context.Context) *SubscriptionMeter {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SubscriptionMeterCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SubscriptionMeterCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SubscriptionMeterCreate) defaults() {
+	if _, ok := _c.mutation.Aggregation(); !ok {
+		v := subscriptionmeter.DefaultAggregation
+		_c.mutation.SetAggregation(v)
+	}
+	if _, ok := _c.mutation.PricingModel(); !ok {
+		v := subscriptionmeter.DefaultPricingModel
+		_c.mutation.SetPricingModel(v)
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		v := subscriptionmeter.DefaultActive
+		_c.mutation.SetActive(v)
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		v := subscriptionmeter.DefaultMetadata
+		_c.mutation.SetMetadata(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := subscriptionmeter.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := subscriptionmeter.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := subscriptionmeter.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SubscriptionMeterCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "SubscriptionMeter.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.SubscriptionID(); !ok {
+		return &ValidationError{Name: "subscription_id", err: errors.New(`ent: missing required field "SubscriptionMeter.subscription_id"`)}
+	}
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "SubscriptionMeter.code"`)}
+	}
+	if v, ok := _c.mutation.Code(); ok {
+		if err := subscriptionmeter.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "SubscriptionMeter.code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Aggregation(); !ok {
+		return &ValidationError{Name: "aggregation", err: errors.New(`ent: missing required field "SubscriptionMeter.aggregation"`)}
+	}
+	if _, ok := _c.mutation.PricingModel(); !ok {
+		return &ValidationError{Name: "pricing_model", err: errors.New(`ent: missing required field "SubscriptionMeter.pricing_model"`)}
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "SubscriptionMeter.active"`)}
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		return &ValidationError{Name: "metadata", err: errors.New(`ent: missing required field "SubscriptionMeter.metadata"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SubscriptionMeter.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SubscriptionMeter.updated_at"`)}
+	}
+	if len(_c.mutation.SubscriptionIDs()) == 0 {
+		return &ValidationError{Name: "subscription", err: errors.New(`ent: missing required edge "SubscriptionMeter.subscription"`)}
+	}
+	return nil
+}
+
+func (_c *SubscriptionMeterCreate) sqlSave(ctx context.Context) (*SubscriptionMeter, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SubscriptionMeterCreate) createSpec() (*SubscriptionMeter, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SubscriptionMeter{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(subscriptionmeter.Table, sqlgraph.NewFieldSpec(subscriptionmeter.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(subscriptionmeter.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(subscriptionmeter.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(subscriptionmeter.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Unit(); ok {
+		_spec.SetField(subscriptionmeter.FieldUnit, field.TypeString, value)
+		_node.Unit = value
+	}
+	if value, ok := _c.mutation.Aggregation(); ok {
+		_spec.SetField(subscriptionmeter.FieldAggregation, field.TypeString, value)
+		_node.Aggregation = value
+	}
+	if value, ok := _c.mutation.PricingModel(); ok {
+		_spec.SetField(subscriptionmeter.FieldPricingModel, field.TypeString, value)
+		_node.PricingModel = value
+	}
+	if value, ok := _c.mutation.UnitPrice(); ok {
+		_spec.SetField(subscriptionmeter.FieldUnitPrice, field.TypeFloat64, value)
+		_node.UnitPrice = value
+	}
+	if value, ok := _c.mutation.Tiers(); ok {
+		_spec.SetField(subscriptionmeter.FieldTiers, field.TypeJSON, value)
+		_node.Tiers = value
+	}
+	if value, ok := _c.mutation.Active(); ok {
+		_spec.SetField(subscriptionmeter.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(subscriptionmeter.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(subscriptionmeter.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionmeter.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscriptionmeter.SubscriptionTable,
+			Columns: []string{subscriptionmeter.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SubscriptionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UsageRecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscriptionmeter.UsageRecordsTable,
+			Columns: []string{subscriptionmeter.UsageRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SubscriptionMeter.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SubscriptionMeterUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SubscriptionMeterCreate) OnConflict(opts ...sql.ConflictOption) *SubscriptionMeterUpsertOne {
+	_c.conflict = opts
+	return &SubscriptionMeterUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SubscriptionMeter.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SubscriptionMeterCreate) OnConflictColumns(columns ...string) *SubscriptionMeterUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SubscriptionMeterUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SubscriptionMeterUpsertOne is the builder for "upsert"-ing
+	//  one SubscriptionMeter node.
+	SubscriptionMeterUpsertOne struct {
+		create *SubscriptionMeterCreate
+	}
+
+	// SubscriptionMeterUpsert is the "OnConflict" setter.
+	SubscriptionMeterUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SubscriptionMeterUpsert) SetTenantID(v uuid.UUID) *SubscriptionMeterUpsert {
+	u.Set(subscriptionmeter.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsert) UpdateTenantID() *SubscriptionMeterUpsert {
+	u.SetExcluded(subscriptionmeter.FieldTenantID)
+	return u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *SubscriptionMeterUpsert) SetSubscriptionID(v uuid.UUID) *SubscriptionMeterUpsert {
+	u.Set(subscriptionmeter.FieldSubscriptionID, v)
+	return u
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsert) UpdateSubscriptionID() *SubscriptionMeterUpsert {
+	u.SetExcluded(subscriptionmeter.FieldSubscriptionID)
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *SubscriptionMeterUpsert) SetCode(v string) *SubscriptionMeterUpsert {
+	u.Set(subscriptionmeter.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsert) UpdateCode() *SubscriptionMeterUpsert {
+	u.SetExcluded(subscriptionmeter.FieldCode)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SubscriptionMeterUpsert) SetName(v string) *SubscriptionMeterUpsert {
+	u.Set(subscriptionmeter.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsert) UpdateName() *SubscriptionMeterUpsert {
+	u.SetExcluded(subscriptionmeter.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *SubscriptionMeterUpsert) ClearName() *SubscriptionMeterUpsert {
+	u.SetNull(subscriptionmeter.FieldName)
+	return u
+}
+
+// SetUnit sets the "unit" field.
+func (u *SubscriptionMeterUpsert) SetUnit(v string) *SubscriptionMeterUpsert {
+	u.Set(subscriptionmeter.FieldUnit, v)
+	return u
+}
+
+// UpdateUnit sets the "unit" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsert) UpdateUnit() *SubscriptionMeterUpsert {
+	u.SetExcluded(subscriptionmeter.FieldUnit)
+	return u
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (u *SubscriptionMeterUpsert) ClearUnit() *SubscriptionMeterUpsert {
+	u.SetNull(subscriptionmeter.FieldUnit)
+	return u
+}
+
+// SetAggregation sets the "aggregation" field.
+func (u *SubscriptionMeterUpsert) SetAggregation(v string) *SubscriptionMeterUpsert {
+	u.Set(subscriptionmeter.FieldAggregation, v)
+	return u
+}
+
+// UpdateAggregation sets the "aggregation" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsert) UpdateAggregation() *SubscriptionMeterUpsert {
+	u.SetExcluded(subscriptionmeter.FieldAggregation)
+	return u
+}
+
+// SetPricingModel sets the "pricing_model" field.
+func (u *SubscriptionMeterUpsert) SetPricingModel(v string) *SubscriptionMeterUpsert {
+	u.Set(subscriptionmeter.FieldPricingModel, v)
+	return u
+}
+
+// UpdatePricingModel sets the "pricing_model" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsert) UpdatePricingModel() *SubscriptionMeterUpsert {
+	u.SetExcluded(subscriptionmeter.FieldPricingModel)
+	return u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (u *SubscriptionMeterUpsert) SetUnitPrice(v decimal.Decimal) *SubscriptionMeterUpsert {
+	u.Set(subscriptionmeter.FieldUnitPrice, v)
+	return u
+}
+
+// UpdateUnitPrice sets the "unit_price" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsert) UpdateUnitPrice() *SubscriptionMeterUpsert {
+	u.SetExcluded(subscriptionmeter.FieldUnitPrice)
+	return u
+}
+
+// AddUnitPrice adds v to the "unit_price" field.
+func (u *SubscriptionMeterUpsert) AddUnitPrice(v decimal.Decimal) *SubscriptionMeterUpsert {
+	u.Add(subscriptionmeter.FieldUnitPrice, v)
+	return u
+}
+
+// ClearUnitPrice clears the value of the "unit_price" field.
+func (u *SubscriptionMeterUpsert) ClearUnitPrice() *SubscriptionMeterUpsert {
+	u.SetNull(subscriptionmeter.FieldUnitPrice)
+	return u
+}
+
+// SetTiers sets the "tiers" field.
+func (u *SubscriptionMeterUpsert) SetTiers(v []pricing.Tier) *SubscriptionMeterUpsert {
+	u.Set(subscriptionmeter.FieldTiers, v)
+	return u
+}
+
+// UpdateTiers sets the "tiers" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsert) UpdateTiers() *SubscriptionMeterUpsert {
+	u.SetExcluded(subscriptionmeter.FieldTiers)
+	return u
+}
+
+// ClearTiers clears the value of the "tiers" field.
+func (u *SubscriptionMeterUpsert) ClearTiers() *SubscriptionMeterUpsert {
+	u.SetNull(subscriptionmeter.FieldTiers)
+	return u
+}
+
+// SetActive sets the "active" field.
+func (u *SubscriptionMeterUpsert) SetActive(v bool) *SubscriptionMeterUpsert {
+	u.Set(subscriptionmeter.FieldActive, v)
+	return u
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsert) UpdateActive() *SubscriptionMeterUpsert {
+	u.SetExcluded(subscriptionmeter.FieldActive)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *SubscriptionMeterUpsert) SetMetadata(v map[string]interface{}) *SubscriptionMeterUpsert {
+	u.Set(subscriptionmeter.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsert) UpdateMetadata() *SubscriptionMeterUpsert {
+	u.SetExcluded(subscriptionmeter.FieldMetadata)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubscriptionMeterUpsert) SetUpdatedAt(v time.Time) *SubscriptionMeterUpsert {
+	u.Set(subscriptionmeter.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsert) UpdateUpdatedAt() *SubscriptionMeterUpsert {
+	u.SetExcluded(subscriptionmeter.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SubscriptionMeter.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(subscriptionmeter.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SubscriptionMeterUpsertOne) UpdateNewValues() *SubscriptionMeterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(subscriptionmeter.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(subscriptionmeter.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SubscriptionMeter.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SubscriptionMeterUpsertOne) Ignore() *SubscriptionMeterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SubscriptionMeterUpsertOne) DoNothing() *SubscriptionMeterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SubscriptionMeterCreate.OnConflict
+// documentation for more info.
+func (u *SubscriptionMeterUpsertOne) Update(set func(*SubscriptionMeterUpsert)) *SubscriptionMeterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SubscriptionMeterUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SubscriptionMeterUpsertOne) SetTenantID(v uuid.UUID) *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertOne) UpdateTenantID() *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *SubscriptionMeterUpsertOne) SetSubscriptionID(v uuid.UUID) *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetSubscriptionID(v)
+	})
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertOne) UpdateSubscriptionID() *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateSubscriptionID()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *SubscriptionMeterUpsertOne) SetCode(v string) *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertOne) UpdateCode() *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SubscriptionMeterUpsertOne) SetName(v string) *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertOne) UpdateName() *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *SubscriptionMeterUpsertOne) ClearName() *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetUnit sets the "unit" field.
+func (u *SubscriptionMeterUpsertOne) SetUnit(v string) *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetUnit(v)
+	})
+}
+
+// UpdateUnit sets the "unit" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertOne) UpdateUnit() *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateUnit()
+	})
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (u *SubscriptionMeterUpsertOne) ClearUnit() *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.ClearUnit()
+	})
+}
+
+// SetAggregation sets the "aggregation" field.
+func (u *SubscriptionMeterUpsertOne) SetAggregation(v string) *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetAggregation(v)
+	})
+}
+
+// UpdateAggregation sets the "aggregation" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertOne) UpdateAggregation() *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateAggregation()
+	})
+}
+
+// SetPricingModel sets the "pricing_model" field.
+func (u *SubscriptionMeterUpsertOne) SetPricingModel(v string) *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetPricingModel(v)
+	})
+}
+
+// UpdatePricingModel sets the "pricing_model" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertOne) UpdatePricingModel() *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdatePricingModel()
+	})
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (u *SubscriptionMeterUpsertOne) SetUnitPrice(v decimal.Decimal) *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetUnitPrice(v)
+	})
+}
+
+// AddUnitPrice adds v to the "unit_price" field.
+func (u *SubscriptionMeterUpsertOne) AddUnitPrice(v decimal.Decimal) *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.AddUnitPrice(v)
+	})
+}
+
+// UpdateUnitPrice sets the "unit_price" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertOne) UpdateUnitPrice() *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateUnitPrice()
+	})
+}
+
+// ClearUnitPrice clears the value of the "unit_price" field.
+func (u *SubscriptionMeterUpsertOne) ClearUnitPrice() *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.ClearUnitPrice()
+	})
+}
+
+// SetTiers sets the "tiers" field.
+func (u *SubscriptionMeterUpsertOne) SetTiers(v []pricing.Tier) *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetTiers(v)
+	})
+}
+
+// UpdateTiers sets the "tiers" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertOne) UpdateTiers() *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateTiers()
+	})
+}
+
+// ClearTiers clears the value of the "tiers" field.
+func (u *SubscriptionMeterUpsertOne) ClearTiers() *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.ClearTiers()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *SubscriptionMeterUpsertOne) SetActive(v bool) *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertOne) UpdateActive() *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *SubscriptionMeterUpsertOne) SetMetadata(v map[string]interface{}) *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertOne) UpdateMetadata() *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubscriptionMeterUpsertOne) SetUpdatedAt(v time.Time) *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertOne) UpdateUpdatedAt() *SubscriptionMeterUpsertOne {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SubscriptionMeterUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SubscriptionMeterCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SubscriptionMeterUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SubscriptionMeterUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SubscriptionMeterUpsertOne.ID is not supported by MySQL driver. Use SubscriptionMeterUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SubscriptionMeterUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SubscriptionMeterCreateBulk is the builder for creating many SubscriptionMeter entities in bulk.
+type SubscriptionMeterCreateBulk struct {
+	config
+	err      error
+	builders []*SubscriptionMeterCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SubscriptionMeter entities in the database.
+func (_c *SubscriptionMeterCreateBulk) Save(ctx context.Context) ([]*SubscriptionMeter, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SubscriptionMeter, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionMeterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SubscriptionMeterCreateBulk) SaveX(ctx context.Context) []*SubscriptionMeter {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SubscriptionMeterCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SubscriptionMeterCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SubscriptionMeter.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SubscriptionMeterUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SubscriptionMeterCreateBulk) OnConflict(opts ...sql.ConflictOption) *SubscriptionMeterUpsertBulk {
+	_c.conflict = opts
+	return &SubscriptionMeterUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SubscriptionMeter.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SubscriptionMeterCreateBulk) OnConflictColumns(columns ...string) *SubscriptionMeterUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SubscriptionMeterUpsertBulk{
+		create: _c,
+	}
+}
+
+// SubscriptionMeterUpsertBulk is the builder for "upsert"-ing
+// a bulk of SubscriptionMeter nodes.
+type SubscriptionMeterUpsertBulk struct {
+	create *SubscriptionMeterCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SubscriptionMeter.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(subscriptionmeter.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SubscriptionMeterUpsertBulk) UpdateNewValues() *SubscriptionMeterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(subscriptionmeter.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(subscriptionmeter.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SubscriptionMeter.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SubscriptionMeterUpsertBulk) Ignore() *SubscriptionMeterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SubscriptionMeterUpsertBulk) DoNothing() *SubscriptionMeterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SubscriptionMeterCreateBulk.OnConflict
+// documentation for more info.
+func (u *SubscriptionMeterUpsertBulk) Update(set func(*SubscriptionMeterUpsert)) *SubscriptionMeterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SubscriptionMeterUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *SubscriptionMeterUpsertBulk) SetTenantID(v uuid.UUID) *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertBulk) UpdateTenantID() *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *SubscriptionMeterUpsertBulk) SetSubscriptionID(v uuid.UUID) *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetSubscriptionID(v)
+	})
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertBulk) UpdateSubscriptionID() *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateSubscriptionID()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *SubscriptionMeterUpsertBulk) SetCode(v string) *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertBulk) UpdateCode() *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SubscriptionMeterUpsertBulk) SetName(v string) *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertBulk) UpdateName() *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *SubscriptionMeterUpsertBulk) ClearName() *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetUnit sets the "unit" field.
+func (u *SubscriptionMeterUpsertBulk) SetUnit(v string) *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetUnit(v)
+	})
+}
+
+// UpdateUnit sets the "unit" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertBulk) UpdateUnit() *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateUnit()
+	})
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (u *SubscriptionMeterUpsertBulk) ClearUnit() *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.ClearUnit()
+	})
+}
+
+// SetAggregation sets the "aggregation" field.
+func (u *SubscriptionMeterUpsertBulk) SetAggregation(v string) *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetAggregation(v)
+	})
+}
+
+// UpdateAggregation sets the "aggregation" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertBulk) UpdateAggregation() *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateAggregation()
+	})
+}
+
+// SetPricingModel sets the "pricing_model" field.
+func (u *SubscriptionMeterUpsertBulk) SetPricingModel(v string) *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetPricingModel(v)
+	})
+}
+
+// UpdatePricingModel sets the "pricing_model" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertBulk) UpdatePricingModel() *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdatePricingModel()
+	})
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (u *SubscriptionMeterUpsertBulk) SetUnitPrice(v decimal.Decimal) *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetUnitPrice(v)
+	})
+}
+
+// AddUnitPrice adds v to the "unit_price" field.
+func (u *SubscriptionMeterUpsertBulk) AddUnitPrice(v decimal.Decimal) *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.AddUnitPrice(v)
+	})
+}
+
+// UpdateUnitPrice sets the "unit_price" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertBulk) UpdateUnitPrice() *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateUnitPrice()
+	})
+}
+
+// ClearUnitPrice clears the value of the "unit_price" field.
+func (u *SubscriptionMeterUpsertBulk) ClearUnitPrice() *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.ClearUnitPrice()
+	})
+}
+
+// SetTiers sets the "tiers" field.
+func (u *SubscriptionMeterUpsertBulk) SetTiers(v []pricing.Tier) *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetTiers(v)
+	})
+}
+
+// UpdateTiers sets the "tiers" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertBulk) UpdateTiers() *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateTiers()
+	})
+}
+
+// ClearTiers clears the value of the "tiers" field.
+func (u *SubscriptionMeterUpsertBulk) ClearTiers() *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.ClearTiers()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *SubscriptionMeterUpsertBulk) SetActive(v bool) *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertBulk) UpdateActive() *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *SubscriptionMeterUpsertBulk) SetMetadata(v map[string]interface{}) *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertBulk) UpdateMetadata() *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubscriptionMeterUpsertBulk) SetUpdatedAt(v time.Time) *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubscriptionMeterUpsertBulk) UpdateUpdatedAt() *SubscriptionMeterUpsertBulk {
+	return u.Update(func(s *SubscriptionMeterUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SubscriptionMeterUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SubscriptionMeterCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SubscriptionMeterCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SubscriptionMeterUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,942 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/subscription"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionmeter"
+	"github.com/bengobox/treasury-api/internal/ent/usagerecord"
+	"github.com/bengobox/treasury-api/internal/modules/metering/pricing"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SubscriptionMeterUpdate is the builder for updating SubscriptionMeter entities.
+type SubscriptionMeterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionMeterMutation
+}
+
+// Where appends a list predicates to the SubscriptionMeterUpdate builder.
+func (_u *SubscriptionMeterUpdate) Where(ps ...predicate.SubscriptionMeter) *SubscriptionMeterUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *SubscriptionMeterUpdate) SetTenantID(v uuid.UUID) *SubscriptionMeterUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *SubscriptionMeterUpdate) SetNillableTenantID(v *uuid.UUID) *SubscriptionMeterUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_u *SubscriptionMeterUpdate) SetSubscriptionID(v uuid.UUID) *SubscriptionMeterUpdate {
+	_u.mutation.SetSubscriptionID(v)
+	return _u
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_u *SubscriptionMeterUpdate) SetNillableSubscriptionID(v *uuid.UUID) *SubscriptionMeterUpdate {
+	if v != nil {
+		_u.SetSubscriptionID(*v)
+	}
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *SubscriptionMeterUpdate) SetCode(v string) *SubscriptionMeterUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *SubscriptionMeterUpdate) SetNillableCode(v *string) *SubscriptionMeterUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SubscriptionMeterUpdate) SetName(v string) *SubscriptionMeterUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SubscriptionMeterUpdate) SetNillableName(v *string) *SubscriptionMeterUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *SubscriptionMeterUpdate) ClearName() *SubscriptionMeterUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetUnit sets the "unit" field.
+func (_u *SubscriptionMeterUpdate) SetUnit(v string) *SubscriptionMeterUpdate {
+	_u.mutation.SetUnit(v)
+	return _u
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (_u *SubscriptionMeterUpdate) SetNillableUnit(v *string) *SubscriptionMeterUpdate {
+	if v != nil {
+		_u.SetUnit(*v)
+	}
+	return _u
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (_u *SubscriptionMeterUpdate) ClearUnit() *SubscriptionMeterUpdate {
+	_u.mutation.ClearUnit()
+	return _u
+}
+
+// SetAggregation sets the "aggregation" field.
+func (_u *SubscriptionMeterUpdate) SetAggregation(v string) *SubscriptionMeterUpdate {
+	_u.mutation.SetAggregation(v)
+	return _u
+}
+
+// SetNillableAggregation sets the "aggregation" field if the given value is not nil.
+func (_u *SubscriptionMeterUpdate) SetNillableAggregation(v *string) *SubscriptionMeterUpdate {
+	if v != nil {
+		_u.SetAggregation(*v)
+	}
+	return _u
+}
+
+// SetPricingModel sets the "pricing_model" field.
+func (_u *SubscriptionMeterUpdate) SetPricingModel(v string) *SubscriptionMeterUpdate {
+	_u.mutation.SetPricingModel(v)
+	return _u
+}
+
+// SetNillablePricingModel sets the "pricing_model" field if the given value is not nil.
+func (_u *SubscriptionMeterUpdate) SetNillablePricingModel(v *string) *SubscriptionMeterUpdate {
+	if v != nil {
+		_u.SetPricingModel(*v)
+	}
+	return _u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_u *SubscriptionMeterUpdate) SetUnitPrice(v decimal.Decimal) *SubscriptionMeterUpdate {
+	_u.mutation.ResetUnitPrice()
+	_u.mutation.SetUnitPrice(v)
+	return _u
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_u *SubscriptionMeterUpdate) SetNillableUnitPrice(v *decimal.Decimal) *SubscriptionMeterUpdate {
+	if v != nil {
+		_u.SetUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddUnitPrice adds value to the "unit_price" field.
+func (_u *SubscriptionMeterUpdate) AddUnitPrice(v decimal.Decimal) *SubscriptionMeterUpdate {
+	_u.mutation.AddUnitPrice(v)
+	return _u
+}
+
+// ClearUnitPrice clears the value of the "unit_price" field.
+func (_u *SubscriptionMeterUpdate) ClearUnitPrice() *SubscriptionMeterUpdate {
+	_u.mutation.ClearUnitPrice()
+	return _u
+}
+
+// SetTiers sets the "tiers" field.
+func (_u *SubscriptionMeterUpdate) SetTiers(v []pricing.Tier) *SubscriptionMeterUpdate {
+	_u.mutation.SetTiers(v)
+	return _u
+}
+
+// AppendTiers appends value to the "tiers" field.
+func (_u *SubscriptionMeterUpdate) AppendTiers(v []pricing.Tier) *SubscriptionMeterUpdate {
+	_u.mutation.AppendTiers(v)
+	return _u
+}
+
+// ClearTiers clears the value of the "tiers" field.
+func (_u *SubscriptionMeterUpdate) ClearTiers() *SubscriptionMeterUpdate {
+	_u.mutation.ClearTiers()
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *SubscriptionMeterUpdate) SetActive(v bool) *SubscriptionMeterUpdate {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *SubscriptionMeterUpdate) SetNillableActive(v *bool) *SubscriptionMeterUpdate {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *SubscriptionMeterUpdate) SetMetadata(v map[string]interface{}) *SubscriptionMeterUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SubscriptionMeterUpdate) SetUpdatedAt(v time.Time) *SubscriptionMeterUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSubscription sets the "subscription" edge to the Subscription entity.
+func (_u *SubscriptionMeterUpdate) SetSubscription(v *Subscription) *SubscriptionMeterUpdate {
+	return _u.SetSubscriptionID(v.ID)
+}
+
+// AddUsageRecordIDs adds the "usage_records" edge to the UsageRecord entity by IDs.
+func (_u *SubscriptionMeterUpdate) AddUsageRecordIDs(ids ...uuid.UUID) *SubscriptionMeterUpdate {
+	_u.mutation.AddUsageRecordIDs(ids...)
+	return _u
+}
+
+// AddUsageRecords adds the "usage_records" edges to the UsageRecord entity.
+func (_u *SubscriptionMeterUpdate) AddUsageRecords(v ...*UsageRecord) *SubscriptionMeterUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUsageRecordIDs(ids...)
+}
+
+// Mutation returns the SubscriptionMeterMutation object of the builder.
+func (_u *SubscriptionMeterUpdate) Mutation() *SubscriptionMeterMutation {
+	return _u.mutation
+}
+
+// ClearSubscription clears the "subscription" edge to the Subscription entity.
+func (_u *SubscriptionMeterUpdate) ClearSubscription() *SubscriptionMeterUpdate {
+	_u.mutation.ClearSubscription()
+	return _u
+}
+
+// ClearUsageRecords clears all "usage_records" edges to the UsageRecord entity.
+func (_u *SubscriptionMeterUpdate) ClearUsageRecords() *SubscriptionMeterUpdate {
+	_u.mutation.ClearUsageRecords()
+	return _u
+}
+
+// RemoveUsageRecordIDs removes the "usage_records" edge to UsageRecord entities by IDs.
+func (_u *SubscriptionMeterUpdate) RemoveUsageRecordIDs(ids ...uuid.UUID) *SubscriptionMeterUpdate {
+	_u.mutation.RemoveUsageRecordIDs(ids...)
+	return _u
+}
+
+// RemoveUsageRecords removes "usage_records" edges to UsageRecord entities.
+func (_u *SubscriptionMeterUpdate) RemoveUsageRecords(v ...*UsageRecord) *SubscriptionMeterUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUsageRecordIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SubscriptionMeterUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SubscriptionMeterUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SubscriptionMeterUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SubscriptionMeterUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SubscriptionMeterUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := subscriptionmeter.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SubscriptionMeterUpdate) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := subscriptionmeter.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "SubscriptionMeter.code": %w`, err)}
+		}
+	}
+	if _u.mutation.SubscriptionCleared() && len(_u.mutation.SubscriptionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SubscriptionMeter.subscription"`)
+	}
+	return nil
+}
+
+func (_u *SubscriptionMeterUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscriptionmeter.Table, subscriptionmeter.Columns, sqlgraph.NewFieldSpec(subscriptionmeter.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(subscriptionmeter.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(subscriptionmeter.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(subscriptionmeter.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(subscriptionmeter.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Unit(); ok {
+		_spec.SetField(subscriptionmeter.FieldUnit, field.TypeString, value)
+	}
+	if _u.mutation.UnitCleared() {
+		_spec.ClearField(subscriptionmeter.FieldUnit, field.TypeString)
+	}
+	if value, ok := _u.mutation.Aggregation(); ok {
+		_spec.SetField(subscriptionmeter.FieldAggregation, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PricingModel(); ok {
+		_spec.SetField(subscriptionmeter.FieldPricingModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UnitPrice(); ok {
+		_spec.SetField(subscriptionmeter.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedUnitPrice(); ok {
+		_spec.AddField(subscriptionmeter.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.UnitPriceCleared() {
+		_spec.ClearField(subscriptionmeter.FieldUnitPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Tiers(); ok {
+		_spec.SetField(subscriptionmeter.FieldTiers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTiers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscriptionmeter.FieldTiers, value)
+		})
+	}
+	if _u.mutation.TiersCleared() {
+		_spec.ClearField(subscriptionmeter.FieldTiers, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(subscriptionmeter.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(subscriptionmeter.FieldMetadata, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionmeter.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubscriptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscriptionmeter.SubscriptionTable,
+			Columns: []string{subscriptionmeter.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscriptionmeter.SubscriptionTable,
+			Columns: []string{subscriptionmeter.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UsageRecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscriptionmeter.UsageRecordsTable,
+			Columns: []string{subscriptionmeter.UsageRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUsageRecordsIDs(); len(nodes) > 0 && !_u.mutation.UsageRecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscriptionmeter.UsageRecordsTable,
+			Columns: []string{subscriptionmeter.UsageRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UsageRecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscriptionmeter.UsageRecordsTable,
+			Columns: []string{subscriptionmeter.UsageRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionmeter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SubscriptionMeterUpdateOne is the builder for updating a single SubscriptionMeter entity.
+type SubscriptionMeterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubscriptionMeterMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *SubscriptionMeterUpdateOne) SetTenantID(v uuid.UUID) *SubscriptionMeterUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *SubscriptionMeterUpdateOne) SetNillableTenantID(v *uuid.UUID) *SubscriptionMeterUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_u *SubscriptionMeterUpdateOne) SetSubscriptionID(v uuid.UUID) *SubscriptionMeterUpdateOne {
+	_u.mutation.SetSubscriptionID(v)
+	return _u
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_u *SubscriptionMeterUpdateOne) SetNillableSubscriptionID(v *uuid.UUID) *SubscriptionMeterUpdateOne {
+	if v != nil {
+		_u.SetSubscriptionID(*v)
+	}
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *SubscriptionMeterUpdateOne) SetCode(v string) *SubscriptionMeterUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *SubscriptionMeterUpdateOne) SetNillableCode(v *string) *SubscriptionMeterUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SubscriptionMeterUpdateOne) SetName(v string) *SubscriptionMeterUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SubscriptionMeterUpdateOne) SetNillableName(v *string) *SubscriptionMeterUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *SubscriptionMeterUpdateOne) ClearName() *SubscriptionMeterUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetUnit sets the "unit" field.
+func (_u *SubscriptionMeterUpdateOne) SetUnit(v string) *SubscriptionMeterUpdateOne {
+	_u.mutation.SetUnit(v)
+	return _u
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (_u *SubscriptionMeterUpdateOne) SetNillableUnit(v *string) *SubscriptionMeterUpdateOne {
+	if v != nil {
+		_u.SetUnit(*v)
+	}
+	return _u
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (_u *SubscriptionMeterUpdateOne) ClearUnit() *SubscriptionMeterUpdateOne {
+	_u.mutation.ClearUnit()
+	return _u
+}
+
+// SetAggregation sets the "aggregation" field.
+func (_u *SubscriptionMeterUpdateOne) SetAggregation(v string) *SubscriptionMeterUpdateOne {
+	_u.mutation.SetAggregation(v)
+	return _u
+}
+
+// SetNillableAggregation sets the "aggregation" field if the given value is not nil.
+func (_u *SubscriptionMeterUpdateOne) SetNillableAggregation(v *string) *SubscriptionMeterUpdateOne {
+	if v != nil {
+		_u.SetAggregation(*v)
+	}
+	return _u
+}
+
+// SetPricingModel sets the "pricing_model" field.
+func (_u *SubscriptionMeterUpdateOne) SetPricingModel(v string) *SubscriptionMeterUpdateOne {
+	_u.mutation.SetPricingModel(v)
+	return _u
+}
+
+// SetNillablePricingModel sets the "pricing_model" field if the given value is not nil.
+func (_u *SubscriptionMeterUpdateOne) SetNillablePricingModel(v *string) *SubscriptionMeterUpdateOne {
+	if v != nil {
+		_u.SetPricingModel(*v)
+	}
+	return _u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_u *SubscriptionMeterUpdateOne) SetUnitPrice(v decimal.Decimal) *SubscriptionMeterUpdateOne {
+	_u.mutation.ResetUnitPrice()
+	_u.mutation.SetUnitPrice(v)
+	return _u
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_u *SubscriptionMeterUpdateOne) SetNillableUnitPrice(v *decimal.Decimal) *SubscriptionMeterUpdateOne {
+	if v != nil {
+		_u.SetUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddUnitPrice adds value to the "unit_price" field.
+func (_u *SubscriptionMeterUpdateOne) AddUnitPrice(v decimal.Decimal) *SubscriptionMeterUpdateOne {
+	_u.mutation.AddUnitPrice(v)
+	return _u
+}
+
+// ClearUnitPrice clears the value of the "unit_price" field.
+func (_u *SubscriptionMeterUpdateOne) ClearUnitPrice() *SubscriptionMeterUpdateOne {
+	_u.mutation.ClearUnitPrice()
+	return _u
+}
+
+// SetTiers sets the "tiers" field.
+func (_u *SubscriptionMeterUpdateOne) SetTiers(v []pricing.Tier) *SubscriptionMeterUpdateOne {
+	_u.mutation.SetTiers(v)
+	return _u
+}
+
+// AppendTiers appends value to the "tiers" field.
+func (_u *SubscriptionMeterUpdateOne) AppendTiers(v []pricing.Tier) *SubscriptionMeterUpdateOne {
+	_u.mutation.AppendTiers(v)
+	return _u
+}
+
+// ClearTiers clears the value of the "tiers" field.
+func (_u *SubscriptionMeterUpdateOne) ClearTiers() *SubscriptionMeterUpdateOne {
+	_u.mutation.ClearTiers()
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *SubscriptionMeterUpdateOne) SetActive(v bool) *SubscriptionMeterUpdateOne {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *SubscriptionMeterUpdateOne) SetNillableActive(v *bool) *SubscriptionMeterUpdateOne {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *SubscriptionMeterUpdateOne) SetMetadata(v map[string]interface{}) *SubscriptionMeterUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SubscriptionMeterUpdateOne) SetUpdatedAt(v time.Time) *SubscriptionMeterUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSubscription sets the "subscription" edge to the Subscription entity.
+func (_u *SubscriptionMeterUpdateOne) SetSubscription(v *Subscription) *SubscriptionMeterUpdateOne {
+	return _u.SetSubscriptionID(v.ID)
+}
+
+// AddUsageRecordIDs adds the "usage_records" edge to the UsageRecord entity by IDs.
+func (_u *SubscriptionMeterUpdateOne) AddUsageRecordIDs(ids ...uuid.UUID) *SubscriptionMeterUpdateOne {
+	_u.mutation.AddUsageRecordIDs(ids...)
+	return _u
+}
+
+// AddUsageRecords adds the "usage_records" edges to the UsageRecord entity.
+func (_u *SubscriptionMeterUpdateOne) AddUsageRecords(v ...*UsageRecord) *SubscriptionMeterUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUsageRecordIDs(ids...)
+}
+
+// Mutation returns the SubscriptionMeterMutation object of the builder.
+func (_u *SubscriptionMeterUpdateOne) Mutation() *SubscriptionMeterMutation {
+	return _u.mutation
+}
+
+// ClearSubscription clears the "subscription" edge to the Subscription entity.
+func (_u *SubscriptionMeterUpdateOne) ClearSubscription() *SubscriptionMeterUpdateOne {
+	_u.mutation.ClearSubscription()
+	return _u
+}
+
+// ClearUsageRecords clears all "usage_records" edges to the UsageRecord entity.
+func (_u *SubscriptionMeterUpdateOne) ClearUsageRecords() *SubscriptionMeterUpdateOne {
+	_u.mutation.ClearUsageRecords()
+	return _u
+}
+
+// RemoveUsageRecordIDs removes the "usage_records" edge to UsageRecord entities by IDs.
+func (_u *SubscriptionMeterUpdateOne) RemoveUsageRecordIDs(ids ...uuid.UUID) *SubscriptionMeterUpdateOne {
+	_u.mutation.RemoveUsageRecordIDs(ids...)
+	return _u
+}
+
+// RemoveUsageRecords removes "usage_records" edges to UsageRecord entities.
+func (_u *SubscriptionMeterUpdateOne) RemoveUsageRecords(v ...*UsageRecord) *SubscriptionMeterUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUsageRecordIDs(ids...)
+}
+
+// Where appends a list predicates to the SubscriptionMeterUpdate builder.
+func (_u *SubscriptionMeterUpdateOne) Where(ps ...predicate.SubscriptionMeter) *SubscriptionMeterUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SubscriptionMeterUpdateOne) Select(field string, fields ...string) *SubscriptionMeterUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SubscriptionMeter entity.
+func (_u *SubscriptionMeterUpdateOne) Save(ctx context.Context) (*SubscriptionMeter, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SubscriptionMeterUpdateOne) SaveX(ctx context.Context) *SubscriptionMeter {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SubscriptionMeterUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SubscriptionMeterUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SubscriptionMeterUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := subscriptionmeter.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SubscriptionMeterUpdateOne) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := subscriptionmeter.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "SubscriptionMeter.code": %w`, err)}
+		}
+	}
+	if _u.mutation.SubscriptionCleared() && len(_u.mutation.SubscriptionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SubscriptionMeter.subscription"`)
+	}
+	return nil
+}
+
+func (_u *SubscriptionMeterUpdateOne) sqlSave(ctx context.Context) (_node *SubscriptionMeter, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscriptionmeter.Table, subscriptionmeter.Columns, sqlgraph.NewFieldSpec(subscriptionmeter.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SubscriptionMeter.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscriptionmeter.FieldID)
+		for _, f := range fields {
+			if !subscriptionmeter.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != subscriptionmeter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(subscriptionmeter.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(subscriptionmeter.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(subscriptionmeter.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(subscriptionmeter.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Unit(); ok {
+		_spec.SetField(subscriptionmeter.FieldUnit, field.TypeString, value)
+	}
+	if _u.mutation.UnitCleared() {
+		_spec.ClearField(subscriptionmeter.FieldUnit, field.TypeString)
+	}
+	if value, ok := _u.mutation.Aggregation(); ok {
+		_spec.SetField(subscriptionmeter.FieldAggregation, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PricingModel(); ok {
+		_spec.SetField(subscriptionmeter.FieldPricingModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UnitPrice(); ok {
+		_spec.SetField(subscriptionmeter.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedUnitPrice(); ok {
+		_spec.AddField(subscriptionmeter.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.UnitPriceCleared() {
+		_spec.ClearField(subscriptionmeter.FieldUnitPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Tiers(); ok {
+		_spec.SetField(subscriptionmeter.FieldTiers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTiers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, subscriptionmeter.FieldTiers, value)
+		})
+	}
+	if _u.mutation.TiersCleared() {
+		_spec.ClearField(subscriptionmeter.FieldTiers, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(subscriptionmeter.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(subscriptionmeter.FieldMetadata, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionmeter.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubscriptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscriptionmeter.SubscriptionTable,
+			Columns: []string{subscriptionmeter.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscriptionmeter.SubscriptionTable,
+			Columns: []string{subscriptionmeter.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UsageRecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscriptionmeter.UsageRecordsTable,
+			Columns: []string{subscriptionmeter.UsageRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUsageRecordsIDs(); len(nodes) > 0 && !_u.mutation.UsageRecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscriptionmeter.UsageRecordsTable,
+			Columns: []string{subscriptionmeter.UsageRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UsageRecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   subscriptionmeter.UsageRecordsTable,
+			Columns: []string{subscriptionmeter.UsageRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SubscriptionMeter{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionmeter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
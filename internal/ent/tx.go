@@ -12,28 +12,130 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// BankAccount is the client for interacting with the BankAccount builders.
+	BankAccount *BankAccountClient
+	// BankStatement is the client for interacting with the BankStatement builders.
+	BankStatement *BankStatementClient
+	// BankStatementProfile is the client for interacting with the BankStatementProfile builders.
+	BankStatementProfile *BankStatementProfileClient
+	// BankTransaction is the client for interacting with the BankTransaction builders.
+	BankTransaction *BankTransactionClient
+	// BillingCycle is the client for interacting with the BillingCycle builders.
+	BillingCycle *BillingCycleClient
+	// CashDrawerCount is the client for interacting with the CashDrawerCount builders.
+	CashDrawerCount *CashDrawerCountClient
+	// CashDrawerSession is the client for interacting with the CashDrawerSession builders.
+	CashDrawerSession *CashDrawerSessionClient
+	// CashDrawerSetting is the client for interacting with the CashDrawerSetting builders.
+	CashDrawerSetting *CashDrawerSettingClient
 	// ChartOfAccount is the client for interacting with the ChartOfAccount builders.
 	ChartOfAccount *ChartOfAccountClient
+	// CreditOverride is the client for interacting with the CreditOverride builders.
+	CreditOverride *CreditOverrideClient
+	// Customer is the client for interacting with the Customer builders.
+	Customer *CustomerClient
+	// CustomerStatement is the client for interacting with the CustomerStatement builders.
+	CustomerStatement *CustomerStatementClient
+	// DocumentSequence is the client for interacting with the DocumentSequence builders.
+	DocumentSequence *DocumentSequenceClient
+	// DunningNotice is the client for interacting with the DunningNotice builders.
+	DunningNotice *DunningNoticeClient
+	// DunningPause is the client for interacting with the DunningPause builders.
+	DunningPause *DunningPauseClient
+	// DunningStep is the client for interacting with the DunningStep builders.
+	DunningStep *DunningStepClient
+	// EarningsSetting is the client for interacting with the EarningsSetting builders.
+	EarningsSetting *EarningsSettingClient
+	// GoodsReceipt is the client for interacting with the GoodsReceipt builders.
+	GoodsReceipt *GoodsReceiptClient
+	// GoodsReceiptLine is the client for interacting with the GoodsReceiptLine builders.
+	GoodsReceiptLine *GoodsReceiptLineClient
 	// Invoice is the client for interacting with the Invoice builders.
 	Invoice *InvoiceClient
+	// InvoiceLine is the client for interacting with the InvoiceLine builders.
+	InvoiceLine *InvoiceLineClient
+	// InvoicePayment is the client for interacting with the InvoicePayment builders.
+	InvoicePayment *InvoicePaymentClient
+	// InvoiceSetting is the client for interacting with the InvoiceSetting builders.
+	InvoiceSetting *InvoiceSettingClient
 	// LedgerTransaction is the client for interacting with the LedgerTransaction builders.
 	LedgerTransaction *LedgerTransactionClient
 	// OutboxEvent is the client for interacting with the OutboxEvent builders.
 	OutboxEvent *OutboxEventClient
+	// PayableSetting is the client for interacting with the PayableSetting builders.
+	PayableSetting *PayableSettingClient
+	// PayeePayout is the client for interacting with the PayeePayout builders.
+	PayeePayout *PayeePayoutClient
+	// PayeeWallet is the client for interacting with the PayeeWallet builders.
+	PayeeWallet *PayeeWalletClient
+	// PayeeWalletEntry is the client for interacting with the PayeeWalletEntry builders.
+	PayeeWalletEntry *PayeeWalletEntryClient
 	// PaymentIntent is the client for interacting with the PaymentIntent builders.
 	PaymentIntent *PaymentIntentClient
+	// PaymentRun is the client for interacting with the PaymentRun builders.
+	PaymentRun *PaymentRunClient
+	// PaymentRunItem is the client for interacting with the PaymentRunItem builders.
+	PaymentRunItem *PaymentRunItemClient
 	// PaymentTransaction is the client for interacting with the PaymentTransaction builders.
 	PaymentTransaction *PaymentTransactionClient
+	// ProvisionPolicy is the client for interacting with the ProvisionPolicy builders.
+	ProvisionPolicy *ProvisionPolicyClient
+	// ProvisionRun is the client for interacting with the ProvisionRun builders.
+	ProvisionRun *ProvisionRunClient
+	// Reconciliation is the client for interacting with the Reconciliation builders.
+	Reconciliation *ReconciliationClient
+	// ReconciliationMatch is the client for interacting with the ReconciliationMatch builders.
+	ReconciliationMatch *ReconciliationMatchClient
+	// ReconciliationMatchItem is the client for interacting with the ReconciliationMatchItem builders.
+	ReconciliationMatchItem *ReconciliationMatchItemClient
+	// ReconciliationRule is the client for interacting with the ReconciliationRule builders.
+	ReconciliationRule *ReconciliationRuleClient
 	// RolePermission is the client for interacting with the RolePermission builders.
 	RolePermission *RolePermissionClient
+	// SettlementBatch is the client for interacting with the SettlementBatch builders.
+	SettlementBatch *SettlementBatchClient
+	// SettlementDisbursement is the client for interacting with the SettlementDisbursement builders.
+	SettlementDisbursement *SettlementDisbursementClient
+	// SettlementItem is the client for interacting with the SettlementItem builders.
+	SettlementItem *SettlementItemClient
+	// SettlementSetting is the client for interacting with the SettlementSetting builders.
+	SettlementSetting *SettlementSettingClient
+	// Subscription is the client for interacting with the Subscription builders.
+	Subscription *SubscriptionClient
+	// SubscriptionAdjustment is the client for interacting with the SubscriptionAdjustment builders.
+	SubscriptionAdjustment *SubscriptionAdjustmentClient
+	// SubscriptionMeter is the client for interacting with the SubscriptionMeter builders.
+	SubscriptionMeter *SubscriptionMeterClient
 	// TreasuryPermission is the client for interacting with the TreasuryPermission builders.
 	TreasuryPermission *TreasuryPermissionClient
 	// TreasuryRole is the client for interacting with the TreasuryRole builders.
 	TreasuryRole *TreasuryRoleClient
 	// TreasuryUser is the client for interacting with the TreasuryUser builders.
 	TreasuryUser *TreasuryUserClient
+	// UsageRecord is the client for interacting with the UsageRecord builders.
+	UsageRecord *UsageRecordClient
 	// UserRoleAssignment is the client for interacting with the UserRoleAssignment builders.
 	UserRoleAssignment *UserRoleAssignmentClient
+	// Vendor is the client for interacting with the Vendor builders.
+	Vendor *VendorClient
+	// VendorBill is the client for interacting with the VendorBill builders.
+	VendorBill *VendorBillClient
+	// VendorBillLine is the client for interacting with the VendorBillLine builders.
+	VendorBillLine *VendorBillLineClient
+	// Wallet is the client for interacting with the Wallet builders.
+	Wallet *WalletClient
+	// WalletHold is the client for interacting with the WalletHold builders.
+	WalletHold *WalletHoldClient
+	// WalletTransaction is the client for interacting with the WalletTransaction builders.
+	WalletTransaction *WalletTransactionClient
+	// WithholdingCertificate is the client for interacting with the WithholdingCertificate builders.
+	WithholdingCertificate *WithholdingCertificateClient
+	// WithholdingRate is the client for interacting with the WithholdingRate builders.
+	WithholdingRate *WithholdingRateClient
+	// WriteOff is the client for interacting with the WriteOff builders.
+	WriteOff *WriteOffClient
+	// WriteOffRecovery is the client for interacting with the WriteOffRecovery builders.
+	WriteOffRecovery *WriteOffRecoveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -165,17 +267,68 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.BankAccount = NewBankAccountClient(tx.config)
+	tx.BankStatement = NewBankStatementClient(tx.config)
+	tx.BankStatementProfile = NewBankStatementProfileClient(tx.config)
+	tx.BankTransaction = NewBankTransactionClient(tx.config)
+	tx.BillingCycle = NewBillingCycleClient(tx.config)
+	tx.CashDrawerCount = NewCashDrawerCountClient(tx.config)
+	tx.CashDrawerSession = NewCashDrawerSessionClient(tx.config)
+	tx.CashDrawerSetting = NewCashDrawerSettingClient(tx.config)
 	tx.ChartOfAccount = NewChartOfAccountClient(tx.config)
+	tx.CreditOverride = NewCreditOverrideClient(tx.config)
+	tx.Customer = NewCustomerClient(tx.config)
+	tx.CustomerStatement = NewCustomerStatementClient(tx.config)
+	tx.DocumentSequence = NewDocumentSequenceClient(tx.config)
+	tx.DunningNotice = NewDunningNoticeClient(tx.config)
+	tx.DunningPause = NewDunningPauseClient(tx.config)
+	tx.DunningStep = NewDunningStepClient(tx.config)
+	tx.EarningsSetting = NewEarningsSettingClient(tx.config)
+	tx.GoodsReceipt = NewGoodsReceiptClient(tx.config)
+	tx.GoodsReceiptLine = NewGoodsReceiptLineClient(tx.config)
 	tx.Invoice = NewInvoiceClient(tx.config)
+	tx.InvoiceLine = NewInvoiceLineClient(tx.config)
+	tx.InvoicePayment = NewInvoicePaymentClient(tx.config)
+	tx.InvoiceSetting = NewInvoiceSettingClient(tx.config)
 	tx.LedgerTransaction = NewLedgerTransactionClient(tx.config)
 	tx.OutboxEvent = NewOutboxEventClient(tx.config)
+	tx.PayableSetting = NewPayableSettingClient(tx.config)
+	tx.PayeePayout = NewPayeePayoutClient(tx.config)
+	tx.PayeeWallet = NewPayeeWalletClient(tx.config)
+	tx.PayeeWalletEntry = NewPayeeWalletEntryClient(tx.config)
 	tx.PaymentIntent = NewPaymentIntentClient(tx.config)
+	tx.PaymentRun = NewPaymentRunClient(tx.config)
+	tx.PaymentRunItem = NewPaymentRunItemClient(tx.config)
 	tx.PaymentTransaction = NewPaymentTransactionClient(tx.config)
+	tx.ProvisionPolicy = NewProvisionPolicyClient(tx.config)
+	tx.ProvisionRun = NewProvisionRunClient(tx.config)
+	tx.Reconciliation = NewReconciliationClient(tx.config)
+	tx.ReconciliationMatch = NewReconciliationMatchClient(tx.config)
+	tx.ReconciliationMatchItem = NewReconciliationMatchItemClient(tx.config)
+	tx.ReconciliationRule = NewReconciliationRuleClient(tx.config)
 	tx.RolePermission = NewRolePermissionClient(tx.config)
+	tx.SettlementBatch = NewSettlementBatchClient(tx.config)
+	tx.SettlementDisbursement = NewSettlementDisbursementClient(tx.config)
+	tx.SettlementItem = NewSettlementItemClient(tx.config)
+	tx.SettlementSetting = NewSettlementSettingClient(tx.config)
+	tx.Subscription = NewSubscriptionClient(tx.config)
+	tx.SubscriptionAdjustment = NewSubscriptionAdjustmentClient(tx.config)
+	tx.SubscriptionMeter = NewSubscriptionMeterClient(tx.config)
 	tx.TreasuryPermission = NewTreasuryPermissionClient(tx.config)
 	tx.TreasuryRole = NewTreasuryRoleClient(tx.config)
 	tx.TreasuryUser = NewTreasuryUserClient(tx.config)
+	tx.UsageRecord = NewUsageRecordClient(tx.config)
 	tx.UserRoleAssignment = NewUserRoleAssignmentClient(tx.config)
+	tx.Vendor = NewVendorClient(tx.config)
+	tx.VendorBill = NewVendorBillClient(tx.config)
+	tx.VendorBillLine = NewVendorBillLineClient(tx.config)
+	tx.Wallet = NewWalletClient(tx.config)
+	tx.WalletHold = NewWalletHoldClient(tx.config)
+	tx.WalletTransaction = NewWalletTransactionClient(tx.config)
+	tx.WithholdingCertificate = NewWithholdingCertificateClient(tx.config)
+	tx.WithholdingRate = NewWithholdingRateClient(tx.config)
+	tx.WriteOff = NewWriteOffClient(tx.config)
+	tx.WriteOffRecovery = NewWriteOffRecoveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -185,7 +338,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: ChartOfAccount.QueryXXX(), the query will be executed
+// applies a query, for example: BankAccount.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
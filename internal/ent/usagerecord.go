@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionmeter"
+	"github.com/bengobox/treasury-api/internal/ent/usagerecord"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// UsageRecord is the model entity for the UsageRecord schema.
+type UsageRecord struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Subscription identifier
+	SubscriptionID uuid.UUID `json:"subscription_id,omitempty"`
+	// Meter identifier
+	MeterID uuid.UUID `json:"meter_id,omitempty"`
+	// Source event identifier used for deduplication
+	EventID string `json:"event_id,omitempty"`
+	// Reported quantity
+	Quantity decimal.Decimal `json:"quantity,omitempty"`
+	// When the usage happened
+	OccurredAt time.Time `json:"occurred_at,omitempty"`
+	// Cycle the usage was billed on (empty while unbilled)
+	BillingCycleID uuid.UUID `json:"billing_cycle_id,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UsageRecordQuery when eager-loading is set.
+	Edges        UsageRecordEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UsageRecordEdges holds the relations/edges for other nodes in the graph.
+type UsageRecordEdges struct {
+	// Meter holds the value of the meter edge.
+	Meter *SubscriptionMeter `json:"meter,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MeterOrErr returns the Meter value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UsageRecordEdges) MeterOrErr() (*SubscriptionMeter, error) {
+	if e.Meter != nil {
+		return e.Meter, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: subscriptionmeter.Label}
+	}
+	return nil, &NotLoadedError{edge: "meter"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UsageRecord) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usagerecord.FieldMetadata:
+			values[i] = new([]byte)
+		case usagerecord.FieldQuantity:
+			values[i] = new(decimal.Decimal)
+		case usagerecord.FieldEventID:
+			values[i] = new(sql.NullString)
+		case usagerecord.FieldOccurredAt, usagerecord.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case usagerecord.FieldID, usagerecord.FieldTenantID, usagerecord.FieldSubscriptionID, usagerecord.FieldMeterID, usagerecord.FieldBillingCycleID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UsageRecord fields.
+func (_m *UsageRecord) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usagerecord.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case usagerecord.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case usagerecord.FieldSubscriptionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value != nil {
+				_m.SubscriptionID = *value
+			}
+		case usagerecord.FieldMeterID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field meter_id", values[i])
+			} else if value != nil {
+				_m.MeterID = *value
+			}
+		case usagerecord.FieldEventID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				_m.EventID = value.String
+			}
+		case usagerecord.FieldQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value != nil {
+				_m.Quantity = *value
+			}
+		case usagerecord.FieldOccurredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field occurred_at", values[i])
+			} else if value.Valid {
+				_m.OccurredAt = value.Time
+			}
+		case usagerecord.FieldBillingCycleID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_cycle_id", values[i])
+			} else if value != nil {
+				_m.BillingCycleID = *value
+			}
+		case usagerecord.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case usagerecord.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UsageRecord.
+// This includes values selected through modifiers, order, etc.
+func (_m *UsageRecord) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMeter queries the "meter" edge of the UsageRecord entity.
+func (_m *UsageRecord) QueryMeter() *SubscriptionMeterQuery {
+	return NewUsageRecordClient(_m.config).QueryMeter(_m)
+}
+
+// Update returns a builder for updating this UsageRecord.
+// Note that you need to call UsageRecord.Unwrap() before calling this method if this UsageRecord
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UsageRecord) Update() *UsageRecordUpdateOne {
+	return NewUsageRecordClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UsageRecord entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UsageRecord) Unwrap() *UsageRecord {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UsageRecord is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UsageRecord) String() string {
+	var builder strings.Builder
+	builder.WriteString("UsageRecord(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("subscription_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SubscriptionID))
+	builder.WriteString(", ")
+	builder.WriteString("meter_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MeterID))
+	builder.WriteString(", ")
+	builder.WriteString("event_id=")
+	builder.WriteString(_m.EventID)
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("occurred_at=")
+	builder.WriteString(_m.OccurredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("billing_cycle_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BillingCycleID))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UsageRecords is a parsable slice of UsageRecord.
+type UsageRecords []*UsageRecord
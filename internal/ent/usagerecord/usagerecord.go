@@ -0,0 +1,144 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usagerecord
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the usagerecord type in the database.
+	Label = "usage_record"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldSubscriptionID holds the string denoting the subscription_id field in the database.
+	FieldSubscriptionID = "subscription_id"
+	// FieldMeterID holds the string denoting the meter_id field in the database.
+	FieldMeterID = "meter_id"
+	// FieldEventID holds the string denoting the event_id field in the database.
+	FieldEventID = "event_id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldOccurredAt holds the string denoting the occurred_at field in the database.
+	FieldOccurredAt = "occurred_at"
+	// FieldBillingCycleID holds the string denoting the billing_cycle_id field in the database.
+	FieldBillingCycleID = "billing_cycle_id"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeMeter holds the string denoting the meter edge name in mutations.
+	EdgeMeter = "meter"
+	// Table holds the table name of the usagerecord in the database.
+	Table = "usage_records"
+	// MeterTable is the table that holds the meter relation/edge.
+	MeterTable = "usage_records"
+	// MeterInverseTable is the table name for the SubscriptionMeter entity.
+	// It exists in this package in order to avoid circular dependency with the "subscriptionmeter" package.
+	MeterInverseTable = "subscription_meters"
+	// MeterColumn is the table column denoting the meter relation/edge.
+	MeterColumn = "meter_id"
+)
+
+// Columns holds all SQL columns for usagerecord fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldSubscriptionID,
+	FieldMeterID,
+	FieldEventID,
+	FieldQuantity,
+	FieldOccurredAt,
+	FieldBillingCycleID,
+	FieldMetadata,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	EventIDValidator func(string) error
+	// DefaultMetadata holds the default value on creation for the "metadata" field.
+	DefaultMetadata map[string]interface{}
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the UsageRecord queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// BySubscriptionID orders the results by the subscription_id field.
+func BySubscriptionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubscriptionID, opts...).ToFunc()
+}
+
+// ByMeterID orders the results by the meter_id field.
+func ByMeterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMeterID, opts...).ToFunc()
+}
+
+// ByEventID orders the results by the event_id field.
+func ByEventID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventID, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByOccurredAt orders the results by the occurred_at field.
+func ByOccurredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOccurredAt, opts...).ToFunc()
+}
+
+// ByBillingCycleID orders the results by the billing_cycle_id field.
+func ByBillingCycleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillingCycleID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByMeterField orders the results by meter field.
+func ByMeterField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMeterStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newMeterStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MeterInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MeterTable, MeterColumn),
+	)
+}
@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usagerecord
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldTenantID, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// MeterID applies equality check predicate on the "meter_id" field. It's identical to MeterIDEQ.
+func MeterID(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldMeterID, v))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldEventID, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v decimal.Decimal) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldQuantity, v))
+}
+
+// OccurredAt applies equality check predicate on the "occurred_at" field. It's identical to OccurredAtEQ.
+func OccurredAt(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// BillingCycleID applies equality check predicate on the "billing_cycle_id" field. It's identical to BillingCycleIDEQ.
+func BillingCycleID(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldBillingCycleID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldTenantID, v))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDGT applies the GT predicate on the "subscription_id" field.
+func SubscriptionIDGT(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDGTE applies the GTE predicate on the "subscription_id" field.
+func SubscriptionIDGTE(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLT applies the LT predicate on the "subscription_id" field.
+func SubscriptionIDLT(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLTE applies the LTE predicate on the "subscription_id" field.
+func SubscriptionIDLTE(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldSubscriptionID, v))
+}
+
+// MeterIDEQ applies the EQ predicate on the "meter_id" field.
+func MeterIDEQ(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldMeterID, v))
+}
+
+// MeterIDNEQ applies the NEQ predicate on the "meter_id" field.
+func MeterIDNEQ(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldMeterID, v))
+}
+
+// MeterIDIn applies the In predicate on the "meter_id" field.
+func MeterIDIn(vs ...uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldMeterID, vs...))
+}
+
+// MeterIDNotIn applies the NotIn predicate on the "meter_id" field.
+func MeterIDNotIn(vs ...uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldMeterID, vs...))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldEventID, v))
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldEventID, v))
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldEventID, v))
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldEventID, v))
+}
+
+// EventIDContains applies the Contains predicate on the "event_id" field.
+func EventIDContains(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldContains(FieldEventID, v))
+}
+
+// EventIDHasPrefix applies the HasPrefix predicate on the "event_id" field.
+func EventIDHasPrefix(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldHasPrefix(FieldEventID, v))
+}
+
+// EventIDHasSuffix applies the HasSuffix predicate on the "event_id" field.
+func EventIDHasSuffix(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldHasSuffix(FieldEventID, v))
+}
+
+// EventIDEqualFold applies the EqualFold predicate on the "event_id" field.
+func EventIDEqualFold(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEqualFold(FieldEventID, v))
+}
+
+// EventIDContainsFold applies the ContainsFold predicate on the "event_id" field.
+func EventIDContainsFold(v string) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldContainsFold(FieldEventID, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v decimal.Decimal) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v decimal.Decimal) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...decimal.Decimal) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...decimal.Decimal) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v decimal.Decimal) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v decimal.Decimal) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v decimal.Decimal) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v decimal.Decimal) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldQuantity, v))
+}
+
+// OccurredAtEQ applies the EQ predicate on the "occurred_at" field.
+func OccurredAtEQ(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtNEQ applies the NEQ predicate on the "occurred_at" field.
+func OccurredAtNEQ(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtIn applies the In predicate on the "occurred_at" field.
+func OccurredAtIn(vs ...time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtNotIn applies the NotIn predicate on the "occurred_at" field.
+func OccurredAtNotIn(vs ...time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtGT applies the GT predicate on the "occurred_at" field.
+func OccurredAtGT(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldOccurredAt, v))
+}
+
+// OccurredAtGTE applies the GTE predicate on the "occurred_at" field.
+func OccurredAtGTE(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldOccurredAt, v))
+}
+
+// OccurredAtLT applies the LT predicate on the "occurred_at" field.
+func OccurredAtLT(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldOccurredAt, v))
+}
+
+// OccurredAtLTE applies the LTE predicate on the "occurred_at" field.
+func OccurredAtLTE(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldOccurredAt, v))
+}
+
+// BillingCycleIDEQ applies the EQ predicate on the "billing_cycle_id" field.
+func BillingCycleIDEQ(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldBillingCycleID, v))
+}
+
+// BillingCycleIDNEQ applies the NEQ predicate on the "billing_cycle_id" field.
+func BillingCycleIDNEQ(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldBillingCycleID, v))
+}
+
+// BillingCycleIDIn applies the In predicate on the "billing_cycle_id" field.
+func BillingCycleIDIn(vs ...uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldBillingCycleID, vs...))
+}
+
+// BillingCycleIDNotIn applies the NotIn predicate on the "billing_cycle_id" field.
+func BillingCycleIDNotIn(vs ...uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldBillingCycleID, vs...))
+}
+
+// BillingCycleIDGT applies the GT predicate on the "billing_cycle_id" field.
+func BillingCycleIDGT(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldBillingCycleID, v))
+}
+
+// BillingCycleIDGTE applies the GTE predicate on the "billing_cycle_id" field.
+func BillingCycleIDGTE(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldBillingCycleID, v))
+}
+
+// BillingCycleIDLT applies the LT predicate on the "billing_cycle_id" field.
+func BillingCycleIDLT(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldBillingCycleID, v))
+}
+
+// BillingCycleIDLTE applies the LTE predicate on the "billing_cycle_id" field.
+func BillingCycleIDLTE(v uuid.UUID) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldBillingCycleID, v))
+}
+
+// BillingCycleIDIsNil applies the IsNil predicate on the "billing_cycle_id" field.
+func BillingCycleIDIsNil() predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIsNull(FieldBillingCycleID))
+}
+
+// BillingCycleIDNotNil applies the NotNil predicate on the "billing_cycle_id" field.
+func BillingCycleIDNotNil() predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotNull(FieldBillingCycleID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasMeter applies the HasEdge predicate on the "meter" edge.
+func HasMeter() predicate.UsageRecord {
+	return predicate.UsageRecord(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MeterTable, MeterColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMeterWith applies the HasEdge predicate on the "meter" edge with a given conditions (other predicates).
+func HasMeterWith(preds ...predicate.SubscriptionMeter) predicate.UsageRecord {
+	return predicate.UsageRecord(func(s *sql.Selector) {
+		step := newMeterStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UsageRecord) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UsageRecord) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UsageRecord) predicate.UsageRecord {
+	return predicate.UsageRecord(sql.NotPredicates(p))
+}
@@ -0,0 +1,988 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionmeter"
+	"github.com/bengobox/treasury-api/internal/ent/usagerecord"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// UsageRecordCreate is the builder for creating a UsageRecord entity.
+type UsageRecordCreate struct {
+	config
+	mutation *UsageRecordMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *UsageRecordCreate) SetTenantID(v uuid.UUID) *UsageRecordCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_c *UsageRecordCreate) SetSubscriptionID(v uuid.UUID) *UsageRecordCreate {
+	_c.mutation.SetSubscriptionID(v)
+	return _c
+}
+
+// SetMeterID sets the "meter_id" field.
+func (_c *UsageRecordCreate) SetMeterID(v uuid.UUID) *UsageRecordCreate {
+	_c.mutation.SetMeterID(v)
+	return _c
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *UsageRecordCreate) SetEventID(v string) *UsageRecordCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *UsageRecordCreate) SetQuantity(v decimal.Decimal) *UsageRecordCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (_c *UsageRecordCreate) SetOccurredAt(v time.Time) *UsageRecordCreate {
+	_c.mutation.SetOccurredAt(v)
+	return _c
+}
+
+// SetBillingCycleID sets the "billing_cycle_id" field.
+func (_c *UsageRecordCreate) SetBillingCycleID(v uuid.UUID) *UsageRecordCreate {
+	_c.mutation.SetBillingCycleID(v)
+	return _c
+}
+
+// SetNillableBillingCycleID sets the "billing_cycle_id" field if the given value is not nil.
+func (_c *UsageRecordCreate) SetNillableBillingCycleID(v *uuid.UUID) *UsageRecordCreate {
+	if v != nil {
+		_c.SetBillingCycleID(*v)
+	}
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *UsageRecordCreate) SetMetadata(v map[string]interface{}) *UsageRecordCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UsageRecordCreate) SetCreatedAt(v time.Time) *UsageRecordCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UsageRecordCreate) SetNillableCreatedAt(v *time.Time) *UsageRecordCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UsageRecordCreate) SetID(v uuid.UUID) *UsageRecordCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *UsageRecordCreate) SetNillableID(v *uuid.UUID) *UsageRecordCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetMeter sets the "meter" edge to the SubscriptionMeter entity.
+func (_c *UsageRecordCreate) SetMeter(v *SubscriptionMeter) *UsageRecordCreate {
+	return _c.SetMeterID(v.ID)
+}
+
+// Mutation returns the UsageRecordMutation object of the builder.
+func (_c *UsageRecordCreate) Mutation() *UsageRecordMutation {
+	return _c.mutation
+}
+
+// Save creates the UsageRecord in the database.
+func (_c *UsageRecordCreate) Save(ctx context.Context) (*UsageRecord, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UsageRecordCreate) SaveX(ctx context.Context) *UsageRecord {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UsageRecordCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UsageRecordCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UsageRecordCreate) defaults() {
+	if _, ok := _c.mutation.Metadata(); !ok {
+		v := usagerecord.DefaultMetadata
+		_c.mutation.SetMetadata(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := usagerecord.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := usagerecord.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UsageRecordCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "UsageRecord.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.SubscriptionID(); !ok {
+		return &ValidationError{Name: "subscription_id", err: errors.New(`ent: missing required field "UsageRecord.subscription_id"`)}
+	}
+	if _, ok := _c.mutation.MeterID(); !ok {
+		return &ValidationError{Name: "meter_id", err: errors.New(`ent: missing required field "UsageRecord.meter_id"`)}
+	}
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "UsageRecord.event_id"`)}
+	}
+	if v, ok := _c.mutation.EventID(); ok {
+		if err := usagerecord.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "UsageRecord.event_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "UsageRecord.quantity"`)}
+	}
+	if _, ok := _c.mutation.OccurredAt(); !ok {
+		return &ValidationError{Name: "occurred_at", err: errors.New(`ent: missing required field "UsageRecord.occurred_at"`)}
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		return &ValidationError{Name: "metadata", err: errors.New(`ent: missing required field "UsageRecord.metadata"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UsageRecord.created_at"`)}
+	}
+	if len(_c.mutation.MeterIDs()) == 0 {
+		return &ValidationError{Name: "meter", err: errors.New(`ent: missing required edge "UsageRecord.meter"`)}
+	}
+	return nil
+}
+
+func (_c *UsageRecordCreate) sqlSave(ctx context.Context) (*UsageRecord, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UsageRecordCreate) createSpec() (*UsageRecord, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UsageRecord{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(usagerecord.Table, sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(usagerecord.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.SubscriptionID(); ok {
+		_spec.SetField(usagerecord.FieldSubscriptionID, field.TypeUUID, value)
+		_node.SubscriptionID = value
+	}
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(usagerecord.FieldEventID, field.TypeString, value)
+		_node.EventID = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(usagerecord.FieldQuantity, field.TypeFloat64, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.OccurredAt(); ok {
+		_spec.SetField(usagerecord.FieldOccurredAt, field.TypeTime, value)
+		_node.OccurredAt = value
+	}
+	if value, ok := _c.mutation.BillingCycleID(); ok {
+		_spec.SetField(usagerecord.FieldBillingCycleID, field.TypeUUID, value)
+		_node.BillingCycleID = value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(usagerecord.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(usagerecord.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.MeterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usagerecord.MeterTable,
+			Columns: []string{usagerecord.MeterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionmeter.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MeterID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UsageRecord.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UsageRecordUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UsageRecordCreate) OnConflict(opts ...sql.ConflictOption) *UsageRecordUpsertOne {
+	_c.conflict = opts
+	return &UsageRecordUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UsageRecord.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UsageRecordCreate) OnConflictColumns(columns ...string) *UsageRecordUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UsageRecordUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UsageRecordUpsertOne is the builder for "upsert"-ing
+	//  one UsageRecord node.
+	UsageRecordUpsertOne struct {
+		create *UsageRecordCreate
+	}
+
+	// UsageRecordUpsert is the "OnConflict" setter.
+	UsageRecordUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *UsageRecordUpsert) SetTenantID(v uuid.UUID) *UsageRecordUpsert {
+	u.Set(usagerecord.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *UsageRecordUpsert) UpdateTenantID() *UsageRecordUpsert {
+	u.SetExcluded(usagerecord.FieldTenantID)
+	return u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *UsageRecordUpsert) SetSubscriptionID(v uuid.UUID) *UsageRecordUpsert {
+	u.Set(usagerecord.FieldSubscriptionID, v)
+	return u
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *UsageRecordUpsert) UpdateSubscriptionID() *UsageRecordUpsert {
+	u.SetExcluded(usagerecord.FieldSubscriptionID)
+	return u
+}
+
+// SetMeterID sets the "meter_id" field.
+func (u *UsageRecordUpsert) SetMeterID(v uuid.UUID) *UsageRecordUpsert {
+	u.Set(usagerecord.FieldMeterID, v)
+	return u
+}
+
+// UpdateMeterID sets the "meter_id" field to the value that was provided on create.
+func (u *UsageRecordUpsert) UpdateMeterID() *UsageRecordUpsert {
+	u.SetExcluded(usagerecord.FieldMeterID)
+	return u
+}
+
+// SetEventID sets the "event_id" field.
+func (u *UsageRecordUpsert) SetEventID(v string) *UsageRecordUpsert {
+	u.Set(usagerecord.FieldEventID, v)
+	return u
+}
+
+// UpdateEventID sets the "event_id" field to the value that was provided on create.
+func (u *UsageRecordUpsert) UpdateEventID() *UsageRecordUpsert {
+	u.SetExcluded(usagerecord.FieldEventID)
+	return u
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *UsageRecordUpsert) SetQuantity(v decimal.Decimal) *UsageRecordUpsert {
+	u.Set(usagerecord.FieldQuantity, v)
+	return u
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *UsageRecordUpsert) UpdateQuantity() *UsageRecordUpsert {
+	u.SetExcluded(usagerecord.FieldQuantity)
+	return u
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *UsageRecordUpsert) AddQuantity(v decimal.Decimal) *UsageRecordUpsert {
+	u.Add(usagerecord.FieldQuantity, v)
+	return u
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (u *UsageRecordUpsert) SetOccurredAt(v time.Time) *UsageRecordUpsert {
+	u.Set(usagerecord.FieldOccurredAt, v)
+	return u
+}
+
+// UpdateOccurredAt sets the "occurred_at" field to the value that was provided on create.
+func (u *UsageRecordUpsert) UpdateOccurredAt() *UsageRecordUpsert {
+	u.SetExcluded(usagerecord.FieldOccurredAt)
+	return u
+}
+
+// SetBillingCycleID sets the "billing_cycle_id" field.
+func (u *UsageRecordUpsert) SetBillingCycleID(v uuid.UUID) *UsageRecordUpsert {
+	u.Set(usagerecord.FieldBillingCycleID, v)
+	return u
+}
+
+// UpdateBillingCycleID sets the "billing_cycle_id" field to the value that was provided on create.
+func (u *UsageRecordUpsert) UpdateBillingCycleID() *UsageRecordUpsert {
+	u.SetExcluded(usagerecord.FieldBillingCycleID)
+	return u
+}
+
+// ClearBillingCycleID clears the value of the "billing_cycle_id" field.
+func (u *UsageRecordUpsert) ClearBillingCycleID() *UsageRecordUpsert {
+	u.SetNull(usagerecord.FieldBillingCycleID)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *UsageRecordUpsert) SetMetadata(v map[string]interface{}) *UsageRecordUpsert {
+	u.Set(usagerecord.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *UsageRecordUpsert) UpdateMetadata() *UsageRecordUpsert {
+	u.SetExcluded(usagerecord.FieldMetadata)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UsageRecord.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(usagerecord.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UsageRecordUpsertOne) UpdateNewValues() *UsageRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(usagerecord.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(usagerecord.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UsageRecord.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UsageRecordUpsertOne) Ignore() *UsageRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UsageRecordUpsertOne) DoNothing() *UsageRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UsageRecordCreate.OnConflict
+// documentation for more info.
+func (u *UsageRecordUpsertOne) Update(set func(*UsageRecordUpsert)) *UsageRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UsageRecordUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *UsageRecordUpsertOne) SetTenantID(v uuid.UUID) *UsageRecordUpsertOne {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *UsageRecordUpsertOne) UpdateTenantID() *UsageRecordUpsertOne {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *UsageRecordUpsertOne) SetSubscriptionID(v uuid.UUID) *UsageRecordUpsertOne {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.SetSubscriptionID(v)
+	})
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *UsageRecordUpsertOne) UpdateSubscriptionID() *UsageRecordUpsertOne {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.UpdateSubscriptionID()
+	})
+}
+
+// SetMeterID sets the "meter_id" field.
+func (u *UsageRecordUpsertOne) SetMeterID(v uuid.UUID) *UsageRecordUpsertOne {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.SetMeterID(v)
+	})
+}
+
+// UpdateMeterID sets the "meter_id" field to the value that was provided on create.
+func (u *UsageRecordUpsertOne) UpdateMeterID() *UsageRecordUpsertOne {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.UpdateMeterID()
+	})
+}
+
+// SetEventID sets the "event_id" field.
+func (u *UsageRecordUpsertOne) SetEventID(v string) *UsageRecordUpsertOne {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.SetEventID(v)
+	})
+}
+
+// UpdateEventID sets the "event_id" field to the value that was provided on create.
+func (u *UsageRecordUpsertOne) UpdateEventID() *UsageRecordUpsertOne {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.UpdateEventID()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *UsageRecordUpsertOne) SetQuantity(v decimal.Decimal) *UsageRecordUpsertOne {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *UsageRecordUpsertOne) AddQuantity(v decimal.Decimal) *UsageRecordUpsertOne {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *UsageRecordUpsertOne) UpdateQuantity() *UsageRecordUpsertOne {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (u *UsageRecordUpsertOne) SetOccurredAt(v time.Time) *UsageRecordUpsertOne {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.SetOccurredAt(v)
+	})
+}
+
+// UpdateOccurredAt sets the "occurred_at" field to the value that was provided on create.
+func (u *UsageRecordUpsertOne) UpdateOccurredAt() *UsageRecordUpsertOne {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.UpdateOccurredAt()
+	})
+}
+
+// SetBillingCycleID sets the "billing_cycle_id" field.
+func (u *UsageRecordUpsertOne) SetBillingCycleID(v uuid.UUID) *UsageRecordUpsertOne {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.SetBillingCycleID(v)
+	})
+}
+
+// UpdateBillingCycleID sets the "billing_cycle_id" field to the value that was provided on create.
+func (u *UsageRecordUpsertOne) UpdateBillingCycleID() *UsageRecordUpsertOne {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.UpdateBillingCycleID()
+	})
+}
+
+// ClearBillingCycleID clears the value of the "billing_cycle_id" field.
+func (u *UsageRecordUpsertOne) ClearBillingCycleID() *UsageRecordUpsertOne {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.ClearBillingCycleID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *UsageRecordUpsertOne) SetMetadata(v map[string]interface{}) *UsageRecordUpsertOne {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *UsageRecordUpsertOne) UpdateMetadata() *UsageRecordUpsertOne {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *UsageRecordUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UsageRecordCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UsageRecordUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UsageRecordUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: UsageRecordUpsertOne.ID is not supported by MySQL driver. Use UsageRecordUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UsageRecordUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UsageRecordCreateBulk is the builder for creating many UsageRecord entities in bulk.
+type UsageRecordCreateBulk struct {
+	config
+	err      error
+	builders []*UsageRecordCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UsageRecord entities in the database.
+func (_c *UsageRecordCreateBulk) Save(ctx context.Context) ([]*UsageRecord, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UsageRecord, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsageRecordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UsageRecordCreateBulk) SaveX(ctx context.Context) []*UsageRecord {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UsageRecordCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UsageRecordCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UsageRecord.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UsageRecordUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UsageRecordCreateBulk) OnConflict(opts ...sql.ConflictOption) *UsageRecordUpsertBulk {
+	_c.conflict = opts
+	return &UsageRecordUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UsageRecord.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UsageRecordCreateBulk) OnConflictColumns(columns ...string) *UsageRecordUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UsageRecordUpsertBulk{
+		create: _c,
+	}
+}
+
+// UsageRecordUpsertBulk is the builder for "upsert"-ing
+// a bulk of UsageRecord nodes.
+type UsageRecordUpsertBulk struct {
+	create *UsageRecordCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UsageRecord.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(usagerecord.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UsageRecordUpsertBulk) UpdateNewValues() *UsageRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(usagerecord.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(usagerecord.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UsageRecord.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UsageRecordUpsertBulk) Ignore() *UsageRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UsageRecordUpsertBulk) DoNothing() *UsageRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UsageRecordCreateBulk.OnConflict
+// documentation for more info.
+func (u *UsageRecordUpsertBulk) Update(set func(*UsageRecordUpsert)) *UsageRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UsageRecordUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *UsageRecordUpsertBulk) SetTenantID(v uuid.UUID) *UsageRecordUpsertBulk {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *UsageRecordUpsertBulk) UpdateTenantID() *UsageRecordUpsertBulk {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *UsageRecordUpsertBulk) SetSubscriptionID(v uuid.UUID) *UsageRecordUpsertBulk {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.SetSubscriptionID(v)
+	})
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *UsageRecordUpsertBulk) UpdateSubscriptionID() *UsageRecordUpsertBulk {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.UpdateSubscriptionID()
+	})
+}
+
+// SetMeterID sets the "meter_id" field.
+func (u *UsageRecordUpsertBulk) SetMeterID(v uuid.UUID) *UsageRecordUpsertBulk {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.SetMeterID(v)
+	})
+}
+
+// UpdateMeterID sets the "meter_id" field to the value that was provided on create.
+func (u *UsageRecordUpsertBulk) UpdateMeterID() *UsageRecordUpsertBulk {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.UpdateMeterID()
+	})
+}
+
+// SetEventID sets the "event_id" field.
+func (u *UsageRecordUpsertBulk) SetEventID(v string) *UsageRecordUpsertBulk {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.SetEventID(v)
+	})
+}
+
+// UpdateEventID sets the "event_id" field to the value that was provided on create.
+func (u *UsageRecordUpsertBulk) UpdateEventID() *UsageRecordUpsertBulk {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.UpdateEventID()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *UsageRecordUpsertBulk) SetQuantity(v decimal.Decimal) *UsageRecordUpsertBulk {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *UsageRecordUpsertBulk) AddQuantity(v decimal.Decimal) *UsageRecordUpsertBulk {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *UsageRecordUpsertBulk) UpdateQuantity() *UsageRecordUpsertBulk {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (u *UsageRecordUpsertBulk) SetOccurredAt(v time.Time) *UsageRecordUpsertBulk {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.SetOccurredAt(v)
+	})
+}
+
+// UpdateOccurredAt sets the "occurred_at" field to the value that was provided on create.
+func (u *UsageRecordUpsertBulk) UpdateOccurredAt() *UsageRecordUpsertBulk {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.UpdateOccurredAt()
+	})
+}
+
+// SetBillingCycleID sets the "billing_cycle_id" field.
+func (u *UsageRecordUpsertBulk) SetBillingCycleID(v uuid.UUID) *UsageRecordUpsertBulk {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.SetBillingCycleID(v)
+	})
+}
+
+// UpdateBillingCycleID sets the "billing_cycle_id" field to the value that was provided on create.
+func (u *UsageRecordUpsertBulk) UpdateBillingCycleID() *UsageRecordUpsertBulk {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.UpdateBillingCycleID()
+	})
+}
+
+// ClearBillingCycleID clears the value of the "billing_cycle_id" field.
+func (u *UsageRecordUpsertBulk) ClearBillingCycleID() *UsageRecordUpsertBulk {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.ClearBillingCycleID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *UsageRecordUpsertBulk) SetMetadata(v map[string]interface{}) *UsageRecordUpsertBulk {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *UsageRecordUpsertBulk) UpdateMetadata() *UsageRecordUpsertBulk {
+	return u.Update(func(s *UsageRecordUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *UsageRecordUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UsageRecordCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UsageRecordCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UsageRecordUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
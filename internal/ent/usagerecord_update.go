@@ -0,0 +1,579 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionmeter"
+	"github.com/bengobox/treasury-api/internal/ent/usagerecord"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// UsageRecordUpdate is the builder for updating UsageRecord entities.
+type UsageRecordUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UsageRecordMutation
+}
+
+// Where appends a list predicates to the UsageRecordUpdate builder.
+func (_u *UsageRecordUpdate) Where(ps ...predicate.UsageRecord) *UsageRecordUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *UsageRecordUpdate) SetTenantID(v uuid.UUID) *UsageRecordUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableTenantID(v *uuid.UUID) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_u *UsageRecordUpdate) SetSubscriptionID(v uuid.UUID) *UsageRecordUpdate {
+	_u.mutation.SetSubscriptionID(v)
+	return _u
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableSubscriptionID(v *uuid.UUID) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetSubscriptionID(*v)
+	}
+	return _u
+}
+
+// SetMeterID sets the "meter_id" field.
+func (_u *UsageRecordUpdate) SetMeterID(v uuid.UUID) *UsageRecordUpdate {
+	_u.mutation.SetMeterID(v)
+	return _u
+}
+
+// SetNillableMeterID sets the "meter_id" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableMeterID(v *uuid.UUID) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetMeterID(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *UsageRecordUpdate) SetEventID(v string) *UsageRecordUpdate {
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableEventID(v *string) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *UsageRecordUpdate) SetQuantity(v decimal.Decimal) *UsageRecordUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableQuantity(v *decimal.Decimal) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *UsageRecordUpdate) AddQuantity(v decimal.Decimal) *UsageRecordUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (_u *UsageRecordUpdate) SetOccurredAt(v time.Time) *UsageRecordUpdate {
+	_u.mutation.SetOccurredAt(v)
+	return _u
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableOccurredAt(v *time.Time) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetOccurredAt(*v)
+	}
+	return _u
+}
+
+// SetBillingCycleID sets the "billing_cycle_id" field.
+func (_u *UsageRecordUpdate) SetBillingCycleID(v uuid.UUID) *UsageRecordUpdate {
+	_u.mutation.SetBillingCycleID(v)
+	return _u
+}
+
+// SetNillableBillingCycleID sets the "billing_cycle_id" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableBillingCycleID(v *uuid.UUID) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetBillingCycleID(*v)
+	}
+	return _u
+}
+
+// ClearBillingCycleID clears the value of the "billing_cycle_id" field.
+func (_u *UsageRecordUpdate) ClearBillingCycleID() *UsageRecordUpdate {
+	_u.mutation.ClearBillingCycleID()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *UsageRecordUpdate) SetMetadata(v map[string]interface{}) *UsageRecordUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetMeter sets the "meter" edge to the SubscriptionMeter entity.
+func (_u *UsageRecordUpdate) SetMeter(v *SubscriptionMeter) *UsageRecordUpdate {
+	return _u.SetMeterID(v.ID)
+}
+
+// Mutation returns the UsageRecordMutation object of the builder.
+func (_u *UsageRecordUpdate) Mutation() *UsageRecordMutation {
+	return _u.mutation
+}
+
+// ClearMeter clears the "meter" edge to the SubscriptionMeter entity.
+func (_u *UsageRecordUpdate) ClearMeter() *UsageRecordUpdate {
+	_u.mutation.ClearMeter()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UsageRecordUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageRecordUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UsageRecordUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageRecordUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UsageRecordUpdate) check() error {
+	if v, ok := _u.mutation.EventID(); ok {
+		if err := usagerecord.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "UsageRecord.event_id": %w`, err)}
+		}
+	}
+	if _u.mutation.MeterCleared() && len(_u.mutation.MeterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UsageRecord.meter"`)
+	}
+	return nil
+}
+
+func (_u *UsageRecordUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usagerecord.Table, usagerecord.Columns, sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(usagerecord.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.SubscriptionID(); ok {
+		_spec.SetField(usagerecord.FieldSubscriptionID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(usagerecord.FieldEventID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(usagerecord.FieldQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(usagerecord.FieldQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.OccurredAt(); ok {
+		_spec.SetField(usagerecord.FieldOccurredAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.BillingCycleID(); ok {
+		_spec.SetField(usagerecord.FieldBillingCycleID, field.TypeUUID, value)
+	}
+	if _u.mutation.BillingCycleIDCleared() {
+		_spec.ClearField(usagerecord.FieldBillingCycleID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(usagerecord.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MeterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usagerecord.MeterTable,
+			Columns: []string{usagerecord.MeterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionmeter.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MeterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usagerecord.MeterTable,
+			Columns: []string{usagerecord.MeterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionmeter.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagerecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UsageRecordUpdateOne is the builder for updating a single UsageRecord entity.
+type UsageRecordUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UsageRecordMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *UsageRecordUpdateOne) SetTenantID(v uuid.UUID) *UsageRecordUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableTenantID(v *uuid.UUID) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_u *UsageRecordUpdateOne) SetSubscriptionID(v uuid.UUID) *UsageRecordUpdateOne {
+	_u.mutation.SetSubscriptionID(v)
+	return _u
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableSubscriptionID(v *uuid.UUID) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetSubscriptionID(*v)
+	}
+	return _u
+}
+
+// SetMeterID sets the "meter_id" field.
+func (_u *UsageRecordUpdateOne) SetMeterID(v uuid.UUID) *UsageRecordUpdateOne {
+	_u.mutation.SetMeterID(v)
+	return _u
+}
+
+// SetNillableMeterID sets the "meter_id" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableMeterID(v *uuid.UUID) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetMeterID(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *UsageRecordUpdateOne) SetEventID(v string) *UsageRecordUpdateOne {
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableEventID(v *string) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *UsageRecordUpdateOne) SetQuantity(v decimal.Decimal) *UsageRecordUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableQuantity(v *decimal.Decimal) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *UsageRecordUpdateOne) AddQuantity(v decimal.Decimal) *UsageRecordUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (_u *UsageRecordUpdateOne) SetOccurredAt(v time.Time) *UsageRecordUpdateOne {
+	_u.mutation.SetOccurredAt(v)
+	return _u
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableOccurredAt(v *time.Time) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetOccurredAt(*v)
+	}
+	return _u
+}
+
+// SetBillingCycleID sets the "billing_cycle_id" field.
+func (_u *UsageRecordUpdateOne) SetBillingCycleID(v uuid.UUID) *UsageRecordUpdateOne {
+	_u.mutation.SetBillingCycleID(v)
+	return _u
+}
+
+// SetNillableBillingCycleID sets the "billing_cycle_id" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableBillingCycleID(v *uuid.UUID) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetBillingCycleID(*v)
+	}
+	return _u
+}
+
+// ClearBillingCycleID clears the value of the "billing_cycle_id" field.
+func (_u *UsageRecordUpdateOne) ClearBillingCycleID() *UsageRecordUpdateOne {
+	_u.mutation.ClearBillingCycleID()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *UsageRecordUpdateOne) SetMetadata(v map[string]interface{}) *UsageRecordUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetMeter sets the "meter" edge to the SubscriptionMeter entity.
+func (_u *UsageRecordUpdateOne) SetMeter(v *SubscriptionMeter) *UsageRecordUpdateOne {
+	return _u.SetMeterID(v.ID)
+}
+
+// Mutation returns the UsageRecordMutation object of the builder.
+func (_u *UsageRecordUpdateOne) Mutation() *UsageRecordMutation {
+	return _u.mutation
+}
+
+// ClearMeter clears the "meter" edge to the SubscriptionMeter entity.
+func (_u *UsageRecordUpdateOne) ClearMeter() *UsageRecordUpdateOne {
+	_u.mutation.ClearMeter()
+	return _u
+}
+
+// Where appends a list predicates to the UsageRecordUpdate builder.
+func (_u *UsageRecordUpdateOne) Where(ps ...predicate.UsageRecord) *UsageRecordUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UsageRecordUpdateOne) Select(field string, fields ...string) *UsageRecordUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UsageRecord entity.
+func (_u *UsageRecordUpdateOne) Save(ctx context.Context) (*UsageRecord, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageRecordUpdateOne) SaveX(ctx context.Context) *UsageRecord {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UsageRecordUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageRecordUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UsageRecordUpdateOne) check() error {
+	if v, ok := _u.mutation.EventID(); ok {
+		if err := usagerecord.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "UsageRecord.event_id": %w`, err)}
+		}
+	}
+	if _u.mutation.MeterCleared() && len(_u.mutation.MeterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UsageRecord.meter"`)
+	}
+	return nil
+}
+
+func (_u *UsageRecordUpdateOne) sqlSave(ctx context.Context) (_node *UsageRecord, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usagerecord.Table, usagerecord.Columns, sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UsageRecord.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usagerecord.FieldID)
+		for _, f := range fields {
+			if !usagerecord.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usagerecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(usagerecord.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.SubscriptionID(); ok {
+		_spec.SetField(usagerecord.FieldSubscriptionID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(usagerecord.FieldEventID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(usagerecord.FieldQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(usagerecord.FieldQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.OccurredAt(); ok {
+		_spec.SetField(usagerecord.FieldOccurredAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.BillingCycleID(); ok {
+		_spec.SetField(usagerecord.FieldBillingCycleID, field.TypeUUID, value)
+	}
+	if _u.mutation.BillingCycleIDCleared() {
+		_spec.ClearField(usagerecord.FieldBillingCycleID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(usagerecord.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MeterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usagerecord.MeterTable,
+			Columns: []string{usagerecord.MeterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionmeter.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MeterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usagerecord.MeterTable,
+			Columns: []string{usagerecord.MeterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(subscriptionmeter.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &UsageRecord{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagerecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,344 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/vendor"
+	"github.com/bengobox/treasury-api/internal/modules/customers/profile"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Vendor is the model entity for the Vendor schema.
+type Vendor struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Sequential vendor number
+	VendorNumber string `json:"vendor_number,omitempty"`
+	// Registered legal name
+	LegalName string `json:"legal_name,omitempty"`
+	// TradingName holds the value of the "trading_name" field.
+	TradingName string `json:"trading_name,omitempty"`
+	// KRA PIN (tax identification number)
+	KraPin string `json:"kra_pin,omitempty"`
+	// Accounts email
+	Email string `json:"email,omitempty"`
+	// Phone holds the value of the "phone" field.
+	Phone string `json:"phone,omitempty"`
+	// Addresses holds the value of the "addresses" field.
+	Addresses []profile.Address `json:"addresses,omitempty"`
+	// Contacts holds the value of the "contacts" field.
+	Contacts []profile.Contact `json:"contacts,omitempty"`
+	// ISO currency code used for new bills
+	DefaultCurrency string `json:"default_currency,omitempty"`
+	// Days from bill date to due date
+	PaymentTermsDays int `json:"payment_terms_days,omitempty"`
+	// Discount offered for early payment as a fraction, e.g. 0.02 (defaults to zero)
+	EarlyPaymentDiscount decimal.Decimal `json:"early_payment_discount,omitempty"`
+	// Days from bill date within which the early-payment discount applies
+	EarlyPaymentDays int `json:"early_payment_days,omitempty"`
+	// Service category withholding tax is deducted under; empty when not subject to withholding
+	WithholdingCategory string `json:"withholding_category,omitempty"`
+	// Ledger account code bill lines are coded to by default
+	DefaultExpenseAccount string `json:"default_expense_account,omitempty"`
+	// Preferred payment method: bank_transfer, mpesa, cheque, cash
+	PaymentMethod string `json:"payment_method,omitempty"`
+	// Bank account, paybill or till details for paying the vendor
+	PaymentDetails map[string]interface{} `json:"payment_details,omitempty"`
+	// Status: active, inactive
+	Status string `json:"status,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Vendor) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vendor.FieldAddresses, vendor.FieldContacts, vendor.FieldPaymentDetails, vendor.FieldMetadata:
+			values[i] = new([]byte)
+		case vendor.FieldEarlyPaymentDiscount:
+			values[i] = new(decimal.Decimal)
+		case vendor.FieldPaymentTermsDays, vendor.FieldEarlyPaymentDays:
+			values[i] = new(sql.NullInt64)
+		case vendor.FieldVendorNumber, vendor.FieldLegalName, vendor.FieldTradingName, vendor.FieldKraPin, vendor.FieldEmail, vendor.FieldPhone, vendor.FieldDefaultCurrency, vendor.FieldWithholdingCategory, vendor.FieldDefaultExpenseAccount, vendor.FieldPaymentMethod, vendor.FieldStatus:
+			values[i] = new(sql.NullString)
+		case vendor.FieldCreatedAt, vendor.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case vendor.FieldID, vendor.FieldTenantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Vendor fields.
+func (_m *Vendor) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vendor.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case vendor.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case vendor.FieldVendorNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vendor_number", values[i])
+			} else if value.Valid {
+				_m.VendorNumber = value.String
+			}
+		case vendor.FieldLegalName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field legal_name", values[i])
+			} else if value.Valid {
+				_m.LegalName = value.String
+			}
+		case vendor.FieldTradingName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trading_name", values[i])
+			} else if value.Valid {
+				_m.TradingName = value.String
+			}
+		case vendor.FieldKraPin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kra_pin", values[i])
+			} else if value.Valid {
+				_m.KraPin = value.String
+			}
+		case vendor.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = value.String
+			}
+		case vendor.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				_m.Phone = value.String
+			}
+		case vendor.FieldAddresses:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field addresses", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Addresses); err != nil {
+					return fmt.Errorf("unmarshal field addresses: %w", err)
+				}
+			}
+		case vendor.FieldContacts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field contacts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Contacts); err != nil {
+					return fmt.Errorf("unmarshal field contacts: %w", err)
+				}
+			}
+		case vendor.FieldDefaultCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field default_currency", values[i])
+			} else if value.Valid {
+				_m.DefaultCurrency = value.String
+			}
+		case vendor.FieldPaymentTermsDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_terms_days", values[i])
+			} else if value.Valid {
+				_m.PaymentTermsDays = int(value.Int64)
+			}
+		case vendor.FieldEarlyPaymentDiscount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field early_payment_discount", values[i])
+			} else if value != nil {
+				_m.EarlyPaymentDiscount = *value
+			}
+		case vendor.FieldEarlyPaymentDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field early_payment_days", values[i])
+			} else if value.Valid {
+				_m.EarlyPaymentDays = int(value.Int64)
+			}
+		case vendor.FieldWithholdingCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field withholding_category", values[i])
+			} else if value.Valid {
+				_m.WithholdingCategory = value.String
+			}
+		case vendor.FieldDefaultExpenseAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field default_expense_account", values[i])
+			} else if value.Valid {
+				_m.DefaultExpenseAccount = value.String
+			}
+		case vendor.FieldPaymentMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_method", values[i])
+			} else if value.Valid {
+				_m.PaymentMethod = value.String
+			}
+		case vendor.FieldPaymentDetails:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_details", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PaymentDetails); err != nil {
+					return fmt.Errorf("unmarshal field payment_details: %w", err)
+				}
+			}
+		case vendor.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case vendor.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case vendor.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case vendor.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Vendor.
+// This includes values selected through modifiers, order, etc.
+func (_m *Vendor) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Vendor.
+// Note that you need to call Vendor.Unwrap() before calling this method if this Vendor
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Vendor) Update() *VendorUpdateOne {
+	return NewVendorClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Vendor entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Vendor) Unwrap() *Vendor {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Vendor is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Vendor) String() string {
+	var builder strings.Builder
+	builder.WriteString("Vendor(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("vendor_number=")
+	builder.WriteString(_m.VendorNumber)
+	builder.WriteString(", ")
+	builder.WriteString("legal_name=")
+	builder.WriteString(_m.LegalName)
+	builder.WriteString(", ")
+	builder.WriteString("trading_name=")
+	builder.WriteString(_m.TradingName)
+	builder.WriteString(", ")
+	builder.WriteString("kra_pin=")
+	builder.WriteString(_m.KraPin)
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(_m.Email)
+	builder.WriteString(", ")
+	builder.WriteString("phone=")
+	builder.WriteString(_m.Phone)
+	builder.WriteString(", ")
+	builder.WriteString("addresses=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Addresses))
+	builder.WriteString(", ")
+	builder.WriteString("contacts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Contacts))
+	builder.WriteString(", ")
+	builder.WriteString("default_currency=")
+	builder.WriteString(_m.DefaultCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("payment_terms_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaymentTermsDays))
+	builder.WriteString(", ")
+	builder.WriteString("early_payment_discount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EarlyPaymentDiscount))
+	builder.WriteString(", ")
+	builder.WriteString("early_payment_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EarlyPaymentDays))
+	builder.WriteString(", ")
+	builder.WriteString("withholding_category=")
+	builder.WriteString(_m.WithholdingCategory)
+	builder.WriteString(", ")
+	builder.WriteString("default_expense_account=")
+	builder.WriteString(_m.DefaultExpenseAccount)
+	builder.WriteString(", ")
+	builder.WriteString("payment_method=")
+	builder.WriteString(_m.PaymentMethod)
+	builder.WriteString(", ")
+	builder.WriteString("payment_details=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaymentDetails))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Vendors is a parsable slice of Vendor.
+type Vendors []*Vendor
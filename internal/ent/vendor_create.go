@@ -0,0 +1,1913 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/vendor"
+	"github.com/bengobox/treasury-api/internal/modules/customers/profile"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// VendorCreate is the builder for creating a Vendor entity.
+type VendorCreate struct {
+	config
+	mutation *VendorMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *VendorCreate) SetTenantID(v uuid.UUID) *VendorCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetVendorNumber sets the "vendor_number" field.
+func (_c *VendorCreate) SetVendorNumber(v string) *VendorCreate {
+	_c.mutation.SetVendorNumber(v)
+	return _c
+}
+
+// SetLegalName sets the "legal_name" field.
+func (_c *VendorCreate) SetLegalName(v string) *VendorCreate {
+	_c.mutation.SetLegalName(v)
+	return _c
+}
+
+// SetTradingName sets the "trading_name" field.
+func (_c *VendorCreate) SetTradingName(v string) *VendorCreate {
+	_c.mutation.SetTradingName(v)
+	return _c
+}
+
+// SetNillableTradingName sets the "trading_name" field if the given value is not nil.
+func (_c *VendorCreate) SetNillableTradingName(v *string) *VendorCreate {
+	if v != nil {
+		_c.SetTradingName(*v)
+	}
+	return _c
+}
+
+// SetKraPin sets the "kra_pin" field.
+func (_c *VendorCreate) SetKraPin(v string) *VendorCreate {
+	_c.mutation.SetKraPin(v)
+	return _c
+}
+
+// SetNillableKraPin sets the "kra_pin" field if the given value is not nil.
+func (_c *VendorCreate) SetNillableKraPin(v *string) *VendorCreate {
+	if v != nil {
+		_c.SetKraPin(*v)
+	}
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *VendorCreate) SetEmail(v string) *VendorCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_c *VendorCreate) SetNillableEmail(v *string) *VendorCreate {
+	if v != nil {
+		_c.SetEmail(*v)
+	}
+	return _c
+}
+
+// SetPhone sets the "phone" field.
+func (_c *VendorCreate) SetPhone(v string) *VendorCreate {
+	_c.mutation.SetPhone(v)
+	return _c
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_c *VendorCreate) SetNillablePhone(v *string) *VendorCreate {
+	if v != nil {
+		_c.SetPhone(*v)
+	}
+	return _c
+}
+
+// SetAddresses sets the "addresses" field.
+func (_c *VendorCreate) SetAddresses(v []profile.Address) *VendorCreate {
+	_c.mutation.SetAddresses(v)
+	return _c
+}
+
+// SetContacts sets the "contacts" field.
+func (_c *VendorCreate) SetContacts(v []profile.Contact) *VendorCreate {
+	_c.mutation.SetContacts(v)
+	return _c
+}
+
+// SetDefaultCurrency sets the "default_currency" field.
+func (_c *VendorCreate) SetDefaultCurrency(v string) *VendorCreate {
+	_c.mutation.SetDefaultCurrency(v)
+	return _c
+}
+
+// SetNillableDefaultCurrency sets the "default_currency" field if the given value is not nil.
+func (_c *VendorCreate) SetNillableDefaultCurrency(v *string) *VendorCreate {
+	if v != nil {
+		_c.SetDefaultCurrency(*v)
+	}
+	return _c
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (_c *VendorCreate) SetPaymentTermsDays(v int) *VendorCreate {
+	_c.mutation.SetPaymentTermsDays(v)
+	return _c
+}
+
+// SetNillablePaymentTermsDays sets the "payment_terms_days" field if the given value is not nil.
+func (_c *VendorCreate) SetNillablePaymentTermsDays(v *int) *VendorCreate {
+	if v != nil {
+		_c.SetPaymentTermsDays(*v)
+	}
+	return _c
+}
+
+// SetEarlyPaymentDiscount sets the "early_payment_discount" field.
+func (_c *VendorCreate) SetEarlyPaymentDiscount(v decimal.Decimal) *VendorCreate {
+	_c.mutation.SetEarlyPaymentDiscount(v)
+	return _c
+}
+
+// SetNillableEarlyPaymentDiscount sets the "early_payment_discount" field if the given value is not nil.
+func (_c *VendorCreate) SetNillableEarlyPaymentDiscount(v *decimal.Decimal) *VendorCreate {
+	if v != nil {
+		_c.SetEarlyPaymentDiscount(*v)
+	}
+	return _c
+}
+
+// SetEarlyPaymentDays sets the "early_payment_days" field.
+func (_c *VendorCreate) SetEarlyPaymentDays(v int) *VendorCreate {
+	_c.mutation.SetEarlyPaymentDays(v)
+	return _c
+}
+
+// SetNillableEarlyPaymentDays sets the "early_payment_days" field if the given value is not nil.
+func (_c *VendorCreate) SetNillableEarlyPaymentDays(v *int) *VendorCreate {
+	if v != nil {
+		_c.SetEarlyPaymentDays(*v)
+	}
+	return _c
+}
+
+// SetWithholdingCategory sets the "withholding_category" field.
+func (_c *VendorCreate) SetWithholdingCategory(v string) *VendorCreate {
+	_c.mutation.SetWithholdingCategory(v)
+	return _c
+}
+
+// SetNillableWithholdingCategory sets the "withholding_category" field if the given value is not nil.
+func (_c *VendorCreate) SetNillableWithholdingCategory(v *string) *VendorCreate {
+	if v != nil {
+		_c.SetWithholdingCategory(*v)
+	}
+	return _c
+}
+
+// SetDefaultExpenseAccount sets the "default_expense_account" field.
+func (_c *VendorCreate) SetDefaultExpenseAccount(v string) *VendorCreate {
+	_c.mutation.SetDefaultExpenseAccount(v)
+	return _c
+}
+
+// SetNillableDefaultExpenseAccount sets the "default_expense_account" field if the given value is not nil.
+func (_c *VendorCreate) SetNillableDefaultExpenseAccount(v *string) *VendorCreate {
+	if v != nil {
+		_c.SetDefaultExpenseAccount(*v)
+	}
+	return _c
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (_c *VendorCreate) SetPaymentMethod(v string) *VendorCreate {
+	_c.mutation.SetPaymentMethod(v)
+	return _c
+}
+
+// SetNillablePaymentMethod sets the "payment_method" field if the given value is not nil.
+func (_c *VendorCreate) SetNillablePaymentMethod(v *string) *VendorCreate {
+	if v != nil {
+		_c.SetPaymentMethod(*v)
+	}
+	return _c
+}
+
+// SetPaymentDetails sets the "payment_details" field.
+func (_c *VendorCreate) SetPaymentDetails(v map[string]interface{}) *VendorCreate {
+	_c.mutation.SetPaymentDetails(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *VendorCreate) SetStatus(v string) *VendorCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *VendorCreate) SetNillableStatus(v *string) *VendorCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *VendorCreate) SetMetadata(v map[string]interface{}) *VendorCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VendorCreate) SetCreatedAt(v time.Time) *VendorCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *VendorCreate) SetNillableCreatedAt(v *time.Time) *VendorCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *VendorCreate) SetUpdatedAt(v time.Time) *VendorCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *VendorCreate) SetNillableUpdatedAt(v *time.Time) *VendorCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *VendorCreate) SetID(v uuid.UUID) *VendorCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *VendorCreate) SetNillableID(v *uuid.UUID) *VendorCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the VendorMutation object of the builder.
+func (_c *VendorCreate) Mutation() *VendorMutation {
+	return _c.mutation
+}
+
+// Save creates the Vendor in the database.
+func (_c *VendorCreate) Save(ctx context.Context) (*Vendor, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VendorCreate) SaveX(ctx context.Context) *Vendor {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VendorCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VendorCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VendorCreate) defaults() {
+	if _, ok := _c.mutation.DefaultCurrency(); !ok {
+		v := vendor.DefaultDefaultCurrency
+		_c.mutation.SetDefaultCurrency(v)
+	}
+	if _, ok := _c.mutation.PaymentTermsDays(); !ok {
+		v := vendor.DefaultPaymentTermsDays
+		_c.mutation.SetPaymentTermsDays(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := vendor.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		v := vendor.DefaultMetadata
+		_c.mutation.SetMetadata(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := vendor.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := vendor.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := vendor.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VendorCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Vendor.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.VendorNumber(); !ok {
+		return &ValidationError{Name: "vendor_number", err: errors.New(`ent: missing required field "Vendor.vendor_number"`)}
+	}
+	if v, ok := _c.mutation.VendorNumber(); ok {
+		if err := vendor.VendorNumberValidator(v); err != nil {
+			return &ValidationError{Name: "vendor_number", err: fmt.Errorf(`ent: validator failed for field "Vendor.vendor_number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LegalName(); !ok {
+		return &ValidationError{Name: "legal_name", err: errors.New(`ent: missing required field "Vendor.legal_name"`)}
+	}
+	if v, ok := _c.mutation.LegalName(); ok {
+		if err := vendor.LegalNameValidator(v); err != nil {
+			return &ValidationError{Name: "legal_name", err: fmt.Errorf(`ent: validator failed for field "Vendor.legal_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DefaultCurrency(); !ok {
+		return &ValidationError{Name: "default_currency", err: errors.New(`ent: missing required field "Vendor.default_currency"`)}
+	}
+	if _, ok := _c.mutation.PaymentTermsDays(); !ok {
+		return &ValidationError{Name: "payment_terms_days", err: errors.New(`ent: missing required field "Vendor.payment_terms_days"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Vendor.status"`)}
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		return &ValidationError{Name: "metadata", err: errors.New(`ent: missing required field "Vendor.metadata"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Vendor.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Vendor.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *VendorCreate) sqlSave(ctx context.Context) (*Vendor, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VendorCreate) createSpec() (*Vendor, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Vendor{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(vendor.Table, sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(vendor.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.VendorNumber(); ok {
+		_spec.SetField(vendor.FieldVendorNumber, field.TypeString, value)
+		_node.VendorNumber = value
+	}
+	if value, ok := _c.mutation.LegalName(); ok {
+		_spec.SetField(vendor.FieldLegalName, field.TypeString, value)
+		_node.LegalName = value
+	}
+	if value, ok := _c.mutation.TradingName(); ok {
+		_spec.SetField(vendor.FieldTradingName, field.TypeString, value)
+		_node.TradingName = value
+	}
+	if value, ok := _c.mutation.KraPin(); ok {
+		_spec.SetField(vendor.FieldKraPin, field.TypeString, value)
+		_node.KraPin = value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(vendor.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.Phone(); ok {
+		_spec.SetField(vendor.FieldPhone, field.TypeString, value)
+		_node.Phone = value
+	}
+	if value, ok := _c.mutation.Addresses(); ok {
+		_spec.SetField(vendor.FieldAddresses, field.TypeJSON, value)
+		_node.Addresses = value
+	}
+	if value, ok := _c.mutation.Contacts(); ok {
+		_spec.SetField(vendor.FieldContacts, field.TypeJSON, value)
+		_node.Contacts = value
+	}
+	if value, ok := _c.mutation.DefaultCurrency(); ok {
+		_spec.SetField(vendor.FieldDefaultCurrency, field.TypeString, value)
+		_node.DefaultCurrency = value
+	}
+	if value, ok := _c.mutation.PaymentTermsDays(); ok {
+		_spec.SetField(vendor.FieldPaymentTermsDays, field.TypeInt, value)
+		_node.PaymentTermsDays = value
+	}
+	if value, ok := _c.mutation.EarlyPaymentDiscount(); ok {
+		_spec.SetField(vendor.FieldEarlyPaymentDiscount, field.TypeFloat64, value)
+		_node.EarlyPaymentDiscount = value
+	}
+	if value, ok := _c.mutation.EarlyPaymentDays(); ok {
+		_spec.SetField(vendor.FieldEarlyPaymentDays, field.TypeInt, value)
+		_node.EarlyPaymentDays = value
+	}
+	if value, ok := _c.mutation.WithholdingCategory(); ok {
+		_spec.SetField(vendor.FieldWithholdingCategory, field.TypeString, value)
+		_node.WithholdingCategory = value
+	}
+	if value, ok := _c.mutation.DefaultExpenseAccount(); ok {
+		_spec.SetField(vendor.FieldDefaultExpenseAccount, field.TypeString, value)
+		_node.DefaultExpenseAccount = value
+	}
+	if value, ok := _c.mutation.PaymentMethod(); ok {
+		_spec.SetField(vendor.FieldPaymentMethod, field.TypeString, value)
+		_node.PaymentMethod = value
+	}
+	if value, ok := _c.mutation.PaymentDetails(); ok {
+		_spec.SetField(vendor.FieldPaymentDetails, field.TypeJSON, value)
+		_node.PaymentDetails = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(vendor.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(vendor.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(vendor.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(vendor.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Vendor.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VendorUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *VendorCreate) OnConflict(opts ...sql.ConflictOption) *VendorUpsertOne {
+	_c.conflict = opts
+	return &VendorUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Vendor.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *VendorCreate) OnConflictColumns(columns ...string) *VendorUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &VendorUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// VendorUpsertOne is the builder for "upsert"-ing
+	//  one Vendor node.
+	VendorUpsertOne struct {
+		create *VendorCreate
+	}
+
+	// VendorUpsert is the "OnConflict" setter.
+	VendorUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *VendorUpsert) SetTenantID(v uuid.UUID) *VendorUpsert {
+	u.Set(vendor.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *VendorUpsert) UpdateTenantID() *VendorUpsert {
+	u.SetExcluded(vendor.FieldTenantID)
+	return u
+}
+
+// SetLegalName sets the "legal_name" field.
+func (u *VendorUpsert) SetLegalName(v string) *VendorUpsert {
+	u.Set(vendor.FieldLegalName, v)
+	return u
+}
+
+// UpdateLegalName sets the "legal_name" field to the value that was provided on create.
+func (u *VendorUpsert) UpdateLegalName() *VendorUpsert {
+	u.SetExcluded(vendor.FieldLegalName)
+	return u
+}
+
+// SetTradingName sets the "trading_name" field.
+func (u *VendorUpsert) SetTradingName(v string) *VendorUpsert {
+	u.Set(vendor.FieldTradingName, v)
+	return u
+}
+
+// UpdateTradingName sets the "trading_name" field to the value that was provided on create.
+func (u *VendorUpsert) UpdateTradingName() *VendorUpsert {
+	u.SetExcluded(vendor.FieldTradingName)
+	return u
+}
+
+// ClearTradingName clears the value of the "trading_name" field.
+func (u *VendorUpsert) ClearTradingName() *VendorUpsert {
+	u.SetNull(vendor.FieldTradingName)
+	return u
+}
+
+// SetKraPin sets the "kra_pin" field.
+func (u *VendorUpsert) SetKraPin(v string) *VendorUpsert {
+	u.Set(vendor.FieldKraPin, v)
+	return u
+}
+
+// UpdateKraPin sets the "kra_pin" field to the value that was provided on create.
+func (u *VendorUpsert) UpdateKraPin() *VendorUpsert {
+	u.SetExcluded(vendor.FieldKraPin)
+	return u
+}
+
+// ClearKraPin clears the value of the "kra_pin" field.
+func (u *VendorUpsert) ClearKraPin() *VendorUpsert {
+	u.SetNull(vendor.FieldKraPin)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *VendorUpsert) SetEmail(v string) *VendorUpsert {
+	u.Set(vendor.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *VendorUpsert) UpdateEmail() *VendorUpsert {
+	u.SetExcluded(vendor.FieldEmail)
+	return u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *VendorUpsert) ClearEmail() *VendorUpsert {
+	u.SetNull(vendor.FieldEmail)
+	return u
+}
+
+// SetPhone sets the "phone" field.
+func (u *VendorUpsert) SetPhone(v string) *VendorUpsert {
+	u.Set(vendor.FieldPhone, v)
+	return u
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *VendorUpsert) UpdatePhone() *VendorUpsert {
+	u.SetExcluded(vendor.FieldPhone)
+	return u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *VendorUpsert) ClearPhone() *VendorUpsert {
+	u.SetNull(vendor.FieldPhone)
+	return u
+}
+
+// SetAddresses sets the "addresses" field.
+func (u *VendorUpsert) SetAddresses(v []profile.Address) *VendorUpsert {
+	u.Set(vendor.FieldAddresses, v)
+	return u
+}
+
+// UpdateAddresses sets the "addresses" field to the value that was provided on create.
+func (u *VendorUpsert) UpdateAddresses() *VendorUpsert {
+	u.SetExcluded(vendor.FieldAddresses)
+	return u
+}
+
+// ClearAddresses clears the value of the "addresses" field.
+func (u *VendorUpsert) ClearAddresses() *VendorUpsert {
+	u.SetNull(vendor.FieldAddresses)
+	return u
+}
+
+// SetContacts sets the "contacts" field.
+func (u *VendorUpsert) SetContacts(v []profile.Contact) *VendorUpsert {
+	u.Set(vendor.FieldContacts, v)
+	return u
+}
+
+// UpdateContacts sets the "contacts" field to the value that was provided on create.
+func (u *VendorUpsert) UpdateContacts() *VendorUpsert {
+	u.SetExcluded(vendor.FieldContacts)
+	return u
+}
+
+// ClearContacts clears the value of the "contacts" field.
+func (u *VendorUpsert) ClearContacts() *VendorUpsert {
+	u.SetNull(vendor.FieldContacts)
+	return u
+}
+
+// SetDefaultCurrency sets the "default_currency" field.
+func (u *VendorUpsert) SetDefaultCurrency(v string) *VendorUpsert {
+	u.Set(vendor.FieldDefaultCurrency, v)
+	return u
+}
+
+// UpdateDefaultCurrency sets the "default_currency" field to the value that was provided on create.
+func (u *VendorUpsert) UpdateDefaultCurrency() *VendorUpsert {
+	u.SetExcluded(vendor.FieldDefaultCurrency)
+	return u
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (u *VendorUpsert) SetPaymentTermsDays(v int) *VendorUpsert {
+	u.Set(vendor.FieldPaymentTermsDays, v)
+	return u
+}
+
+// UpdatePaymentTermsDays sets the "payment_terms_days" field to the value that was provided on create.
+func (u *VendorUpsert) UpdatePaymentTermsDays() *VendorUpsert {
+	u.SetExcluded(vendor.FieldPaymentTermsDays)
+	return u
+}
+
+// AddPaymentTermsDays adds v to the "payment_terms_days" field.
+func (u *VendorUpsert) AddPaymentTermsDays(v int) *VendorUpsert {
+	u.Add(vendor.FieldPaymentTermsDays, v)
+	return u
+}
+
+// SetEarlyPaymentDiscount sets the "early_payment_discount" field.
+func (u *VendorUpsert) SetEarlyPaymentDiscount(v decimal.Decimal) *VendorUpsert {
+	u.Set(vendor.FieldEarlyPaymentDiscount, v)
+	return u
+}
+
+// UpdateEarlyPaymentDiscount sets the "early_payment_discount" field to the value that was provided on create.
+func (u *VendorUpsert) UpdateEarlyPaymentDiscount() *VendorUpsert {
+	u.SetExcluded(vendor.FieldEarlyPaymentDiscount)
+	return u
+}
+
+// AddEarlyPaymentDiscount adds v to the "early_payment_discount" field.
+func (u *VendorUpsert) AddEarlyPaymentDiscount(v decimal.Decimal) *VendorUpsert {
+	u.Add(vendor.FieldEarlyPaymentDiscount, v)
+	return u
+}
+
+// ClearEarlyPaymentDiscount clears the value of the "early_payment_discount" field.
+func (u *VendorUpsert) ClearEarlyPaymentDiscount() *VendorUpsert {
+	u.SetNull(vendor.FieldEarlyPaymentDiscount)
+	return u
+}
+
+// SetEarlyPaymentDays sets the "early_payment_days" field.
+func (u *VendorUpsert) SetEarlyPaymentDays(v int) *VendorUpsert {
+	u.Set(vendor.FieldEarlyPaymentDays, v)
+	return u
+}
+
+// UpdateEarlyPaymentDays sets the "early_payment_days" field to the value that was provided on create.
+func (u *VendorUpsert) UpdateEarlyPaymentDays() *VendorUpsert {
+	u.SetExcluded(vendor.FieldEarlyPaymentDays)
+	return u
+}
+
+// AddEarlyPaymentDays adds v to the "early_payment_days" field.
+func (u *VendorUpsert) AddEarlyPaymentDays(v int) *VendorUpsert {
+	u.Add(vendor.FieldEarlyPaymentDays, v)
+	return u
+}
+
+// ClearEarlyPaymentDays clears the value of the "early_payment_days" field.
+func (u *VendorUpsert) ClearEarlyPaymentDays() *VendorUpsert {
+	u.SetNull(vendor.FieldEarlyPaymentDays)
+	return u
+}
+
+// SetWithholdingCategory sets the "withholding_category" field.
+func (u *VendorUpsert) SetWithholdingCategory(v string) *VendorUpsert {
+	u.Set(vendor.FieldWithholdingCategory, v)
+	return u
+}
+
+// UpdateWithholdingCategory sets the "withholding_category" field to the value that was provided on create.
+func (u *VendorUpsert) UpdateWithholdingCategory() *VendorUpsert {
+	u.SetExcluded(vendor.FieldWithholdingCategory)
+	return u
+}
+
+// ClearWithholdingCategory clears the value of the "withholding_category" field.
+func (u *VendorUpsert) ClearWithholdingCategory() *VendorUpsert {
+	u.SetNull(vendor.FieldWithholdingCategory)
+	return u
+}
+
+// SetDefaultExpenseAccount sets the "default_expense_account" field.
+func (u *VendorUpsert) SetDefaultExpenseAccount(v string) *VendorUpsert {
+	u.Set(vendor.FieldDefaultExpenseAccount, v)
+	return u
+}
+
+// UpdateDefaultExpenseAccount sets the "default_expense_account" field to the value that was provided on create.
+func (u *VendorUpsert) UpdateDefaultExpenseAccount() *VendorUpsert {
+	u.SetExcluded(vendor.FieldDefaultExpenseAccount)
+	return u
+}
+
+// ClearDefaultExpenseAccount clears the value of the "default_expense_account" field.
+func (u *VendorUpsert) ClearDefaultExpenseAccount() *VendorUpsert {
+	u.SetNull(vendor.FieldDefaultExpenseAccount)
+	return u
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (u *VendorUpsert) SetPaymentMethod(v string) *VendorUpsert {
+	u.Set(vendor.FieldPaymentMethod, v)
+	return u
+}
+
+// UpdatePaymentMethod sets the "payment_method" field to the value that was provided on create.
+func (u *VendorUpsert) UpdatePaymentMethod() *VendorUpsert {
+	u.SetExcluded(vendor.FieldPaymentMethod)
+	return u
+}
+
+// ClearPaymentMethod clears the value of the "payment_method" field.
+func (u *VendorUpsert) ClearPaymentMethod() *VendorUpsert {
+	u.SetNull(vendor.FieldPaymentMethod)
+	return u
+}
+
+// SetPaymentDetails sets the "payment_details" field.
+func (u *VendorUpsert) SetPaymentDetails(v map[string]interface{}) *VendorUpsert {
+	u.Set(vendor.FieldPaymentDetails, v)
+	return u
+}
+
+// UpdatePaymentDetails sets the "payment_details" field to the value that was provided on create.
+func (u *VendorUpsert) UpdatePaymentDetails() *VendorUpsert {
+	u.SetExcluded(vendor.FieldPaymentDetails)
+	return u
+}
+
+// ClearPaymentDetails clears the value of the "payment_details" field.
+func (u *VendorUpsert) ClearPaymentDetails() *VendorUpsert {
+	u.SetNull(vendor.FieldPaymentDetails)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *VendorUpsert) SetStatus(v string) *VendorUpsert {
+	u.Set(vendor.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *VendorUpsert) UpdateStatus() *VendorUpsert {
+	u.SetExcluded(vendor.FieldStatus)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *VendorUpsert) SetMetadata(v map[string]interface{}) *VendorUpsert {
+	u.Set(vendor.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *VendorUpsert) UpdateMetadata() *VendorUpsert {
+	u.SetExcluded(vendor.FieldMetadata)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VendorUpsert) SetUpdatedAt(v time.Time) *VendorUpsert {
+	u.Set(vendor.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VendorUpsert) UpdateUpdatedAt() *VendorUpsert {
+	u.SetExcluded(vendor.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Vendor.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(vendor.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VendorUpsertOne) UpdateNewValues() *VendorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(vendor.FieldID)
+		}
+		if _, exists := u.create.mutation.VendorNumber(); exists {
+			s.SetIgnore(vendor.FieldVendorNumber)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(vendor.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Vendor.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *VendorUpsertOne) Ignore() *VendorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VendorUpsertOne) DoNothing() *VendorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VendorCreate.OnConflict
+// documentation for more info.
+func (u *VendorUpsertOne) Update(set func(*VendorUpsert)) *VendorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VendorUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *VendorUpsertOne) SetTenantID(v uuid.UUID) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdateTenantID() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetLegalName sets the "legal_name" field.
+func (u *VendorUpsertOne) SetLegalName(v string) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetLegalName(v)
+	})
+}
+
+// UpdateLegalName sets the "legal_name" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdateLegalName() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateLegalName()
+	})
+}
+
+// SetTradingName sets the "trading_name" field.
+func (u *VendorUpsertOne) SetTradingName(v string) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetTradingName(v)
+	})
+}
+
+// UpdateTradingName sets the "trading_name" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdateTradingName() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateTradingName()
+	})
+}
+
+// ClearTradingName clears the value of the "trading_name" field.
+func (u *VendorUpsertOne) ClearTradingName() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearTradingName()
+	})
+}
+
+// SetKraPin sets the "kra_pin" field.
+func (u *VendorUpsertOne) SetKraPin(v string) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetKraPin(v)
+	})
+}
+
+// UpdateKraPin sets the "kra_pin" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdateKraPin() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateKraPin()
+	})
+}
+
+// ClearKraPin clears the value of the "kra_pin" field.
+func (u *VendorUpsertOne) ClearKraPin() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearKraPin()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *VendorUpsertOne) SetEmail(v string) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdateEmail() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *VendorUpsertOne) ClearEmail() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *VendorUpsertOne) SetPhone(v string) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdatePhone() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *VendorUpsertOne) ClearPhone() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearPhone()
+	})
+}
+
+// SetAddresses sets the "addresses" field.
+func (u *VendorUpsertOne) SetAddresses(v []profile.Address) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetAddresses(v)
+	})
+}
+
+// UpdateAddresses sets the "addresses" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdateAddresses() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateAddresses()
+	})
+}
+
+// ClearAddresses clears the value of the "addresses" field.
+func (u *VendorUpsertOne) ClearAddresses() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearAddresses()
+	})
+}
+
+// SetContacts sets the "contacts" field.
+func (u *VendorUpsertOne) SetContacts(v []profile.Contact) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetContacts(v)
+	})
+}
+
+// UpdateContacts sets the "contacts" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdateContacts() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateContacts()
+	})
+}
+
+// ClearContacts clears the value of the "contacts" field.
+func (u *VendorUpsertOne) ClearContacts() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearContacts()
+	})
+}
+
+// SetDefaultCurrency sets the "default_currency" field.
+func (u *VendorUpsertOne) SetDefaultCurrency(v string) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetDefaultCurrency(v)
+	})
+}
+
+// UpdateDefaultCurrency sets the "default_currency" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdateDefaultCurrency() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateDefaultCurrency()
+	})
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (u *VendorUpsertOne) SetPaymentTermsDays(v int) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetPaymentTermsDays(v)
+	})
+}
+
+// AddPaymentTermsDays adds v to the "payment_terms_days" field.
+func (u *VendorUpsertOne) AddPaymentTermsDays(v int) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.AddPaymentTermsDays(v)
+	})
+}
+
+// UpdatePaymentTermsDays sets the "payment_terms_days" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdatePaymentTermsDays() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdatePaymentTermsDays()
+	})
+}
+
+// SetEarlyPaymentDiscount sets the "early_payment_discount" field.
+func (u *VendorUpsertOne) SetEarlyPaymentDiscount(v decimal.Decimal) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetEarlyPaymentDiscount(v)
+	})
+}
+
+// AddEarlyPaymentDiscount adds v to the "early_payment_discount" field.
+func (u *VendorUpsertOne) AddEarlyPaymentDiscount(v decimal.Decimal) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.AddEarlyPaymentDiscount(v)
+	})
+}
+
+// UpdateEarlyPaymentDiscount sets the "early_payment_discount" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdateEarlyPaymentDiscount() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateEarlyPaymentDiscount()
+	})
+}
+
+// ClearEarlyPaymentDiscount clears the value of the "early_payment_discount" field.
+func (u *VendorUpsertOne) ClearEarlyPaymentDiscount() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearEarlyPaymentDiscount()
+	})
+}
+
+// SetEarlyPaymentDays sets the "early_payment_days" field.
+func (u *VendorUpsertOne) SetEarlyPaymentDays(v int) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetEarlyPaymentDays(v)
+	})
+}
+
+// AddEarlyPaymentDays adds v to the "early_payment_days" field.
+func (u *VendorUpsertOne) AddEarlyPaymentDays(v int) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.AddEarlyPaymentDays(v)
+	})
+}
+
+// UpdateEarlyPaymentDays sets the "early_payment_days" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdateEarlyPaymentDays() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateEarlyPaymentDays()
+	})
+}
+
+// ClearEarlyPaymentDays clears the value of the "early_payment_days" field.
+func (u *VendorUpsertOne) ClearEarlyPaymentDays() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearEarlyPaymentDays()
+	})
+}
+
+// SetWithholdingCategory sets the "withholding_category" field.
+func (u *VendorUpsertOne) SetWithholdingCategory(v string) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetWithholdingCategory(v)
+	})
+}
+
+// UpdateWithholdingCategory sets the "withholding_category" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdateWithholdingCategory() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateWithholdingCategory()
+	})
+}
+
+// ClearWithholdingCategory clears the value of the "withholding_category" field.
+func (u *VendorUpsertOne) ClearWithholdingCategory() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearWithholdingCategory()
+	})
+}
+
+// SetDefaultExpenseAccount sets the "default_expense_account" field.
+func (u *VendorUpsertOne) SetDefaultExpenseAccount(v string) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetDefaultExpenseAccount(v)
+	})
+}
+
+// UpdateDefaultExpenseAccount sets the "default_expense_account" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdateDefaultExpenseAccount() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateDefaultExpenseAccount()
+	})
+}
+
+// ClearDefaultExpenseAccount clears the value of the "default_expense_account" field.
+func (u *VendorUpsertOne) ClearDefaultExpenseAccount() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearDefaultExpenseAccount()
+	})
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (u *VendorUpsertOne) SetPaymentMethod(v string) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetPaymentMethod(v)
+	})
+}
+
+// UpdatePaymentMethod sets the "payment_method" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdatePaymentMethod() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdatePaymentMethod()
+	})
+}
+
+// ClearPaymentMethod clears the value of the "payment_method" field.
+func (u *VendorUpsertOne) ClearPaymentMethod() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearPaymentMethod()
+	})
+}
+
+// SetPaymentDetails sets the "payment_details" field.
+func (u *VendorUpsertOne) SetPaymentDetails(v map[string]interface{}) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetPaymentDetails(v)
+	})
+}
+
+// UpdatePaymentDetails sets the "payment_details" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdatePaymentDetails() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdatePaymentDetails()
+	})
+}
+
+// ClearPaymentDetails clears the value of the "payment_details" field.
+func (u *VendorUpsertOne) ClearPaymentDetails() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearPaymentDetails()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *VendorUpsertOne) SetStatus(v string) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdateStatus() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *VendorUpsertOne) SetMetadata(v map[string]interface{}) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdateMetadata() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VendorUpsertOne) SetUpdatedAt(v time.Time) *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VendorUpsertOne) UpdateUpdatedAt() *VendorUpsertOne {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *VendorUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VendorCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VendorUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *VendorUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: VendorUpsertOne.ID is not supported by MySQL driver. Use VendorUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *VendorUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// VendorCreateBulk is the builder for creating many Vendor entities in bulk.
+type VendorCreateBulk struct {
+	config
+	err      error
+	builders []*VendorCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Vendor entities in the database.
+func (_c *VendorCreateBulk) Save(ctx context.Context) ([]*Vendor, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Vendor, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VendorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VendorCreateBulk) SaveX(ctx context.Context) []*Vendor {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VendorCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VendorCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Vendor.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VendorUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *VendorCreateBulk) OnConflict(opts ...sql.ConflictOption) *VendorUpsertBulk {
+	_c.conflict = opts
+	return &VendorUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Vendor.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *VendorCreateBulk) OnConflictColumns(columns ...string) *VendorUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &VendorUpsertBulk{
+		create: _c,
+	}
+}
+
+// VendorUpsertBulk is the builder for "upsert"-ing
+// a bulk of Vendor nodes.
+type VendorUpsertBulk struct {
+	create *VendorCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Vendor.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(vendor.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VendorUpsertBulk) UpdateNewValues() *VendorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(vendor.FieldID)
+			}
+			if _, exists := b.mutation.VendorNumber(); exists {
+				s.SetIgnore(vendor.FieldVendorNumber)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(vendor.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Vendor.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *VendorUpsertBulk) Ignore() *VendorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VendorUpsertBulk) DoNothing() *VendorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VendorCreateBulk.OnConflict
+// documentation for more info.
+func (u *VendorUpsertBulk) Update(set func(*VendorUpsert)) *VendorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VendorUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *VendorUpsertBulk) SetTenantID(v uuid.UUID) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdateTenantID() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetLegalName sets the "legal_name" field.
+func (u *VendorUpsertBulk) SetLegalName(v string) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetLegalName(v)
+	})
+}
+
+// UpdateLegalName sets the "legal_name" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdateLegalName() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateLegalName()
+	})
+}
+
+// SetTradingName sets the "trading_name" field.
+func (u *VendorUpsertBulk) SetTradingName(v string) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetTradingName(v)
+	})
+}
+
+// UpdateTradingName sets the "trading_name" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdateTradingName() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateTradingName()
+	})
+}
+
+// ClearTradingName clears the value of the "trading_name" field.
+func (u *VendorUpsertBulk) ClearTradingName() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearTradingName()
+	})
+}
+
+// SetKraPin sets the "kra_pin" field.
+func (u *VendorUpsertBulk) SetKraPin(v string) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetKraPin(v)
+	})
+}
+
+// UpdateKraPin sets the "kra_pin" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdateKraPin() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateKraPin()
+	})
+}
+
+// ClearKraPin clears the value of the "kra_pin" field.
+func (u *VendorUpsertBulk) ClearKraPin() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearKraPin()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *VendorUpsertBulk) SetEmail(v string) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdateEmail() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *VendorUpsertBulk) ClearEmail() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *VendorUpsertBulk) SetPhone(v string) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdatePhone() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *VendorUpsertBulk) ClearPhone() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearPhone()
+	})
+}
+
+// SetAddresses sets the "addresses" field.
+func (u *VendorUpsertBulk) SetAddresses(v []profile.Address) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetAddresses(v)
+	})
+}
+
+// UpdateAddresses sets the "addresses" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdateAddresses() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateAddresses()
+	})
+}
+
+// ClearAddresses clears the value of the "addresses" field.
+func (u *VendorUpsertBulk) ClearAddresses() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearAddresses()
+	})
+}
+
+// SetContacts sets the "contacts" field.
+func (u *VendorUpsertBulk) SetContacts(v []profile.Contact) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetContacts(v)
+	})
+}
+
+// UpdateContacts sets the "contacts" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdateContacts() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateContacts()
+	})
+}
+
+// ClearContacts clears the value of the "contacts" field.
+func (u *VendorUpsertBulk) ClearContacts() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearContacts()
+	})
+}
+
+// SetDefaultCurrency sets the "default_currency" field.
+func (u *VendorUpsertBulk) SetDefaultCurrency(v string) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetDefaultCurrency(v)
+	})
+}
+
+// UpdateDefaultCurrency sets the "default_currency" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdateDefaultCurrency() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateDefaultCurrency()
+	})
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (u *VendorUpsertBulk) SetPaymentTermsDays(v int) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetPaymentTermsDays(v)
+	})
+}
+
+// AddPaymentTermsDays adds v to the "payment_terms_days" field.
+func (u *VendorUpsertBulk) AddPaymentTermsDays(v int) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.AddPaymentTermsDays(v)
+	})
+}
+
+// UpdatePaymentTermsDays sets the "payment_terms_days" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdatePaymentTermsDays() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdatePaymentTermsDays()
+	})
+}
+
+// SetEarlyPaymentDiscount sets the "early_payment_discount" field.
+func (u *VendorUpsertBulk) SetEarlyPaymentDiscount(v decimal.Decimal) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetEarlyPaymentDiscount(v)
+	})
+}
+
+// AddEarlyPaymentDiscount adds v to the "early_payment_discount" field.
+func (u *VendorUpsertBulk) AddEarlyPaymentDiscount(v decimal.Decimal) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.AddEarlyPaymentDiscount(v)
+	})
+}
+
+// UpdateEarlyPaymentDiscount sets the "early_payment_discount" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdateEarlyPaymentDiscount() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateEarlyPaymentDiscount()
+	})
+}
+
+// ClearEarlyPaymentDiscount clears the value of the "early_payment_discount" field.
+func (u *VendorUpsertBulk) ClearEarlyPaymentDiscount() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearEarlyPaymentDiscount()
+	})
+}
+
+// SetEarlyPaymentDays sets the "early_payment_days" field.
+func (u *VendorUpsertBulk) SetEarlyPaymentDays(v int) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetEarlyPaymentDays(v)
+	})
+}
+
+// AddEarlyPaymentDays adds v to the "early_payment_days" field.
+func (u *VendorUpsertBulk) AddEarlyPaymentDays(v int) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.AddEarlyPaymentDays(v)
+	})
+}
+
+// UpdateEarlyPaymentDays sets the "early_payment_days" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdateEarlyPaymentDays() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateEarlyPaymentDays()
+	})
+}
+
+// ClearEarlyPaymentDays clears the value of the "early_payment_days" field.
+func (u *VendorUpsertBulk) ClearEarlyPaymentDays() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearEarlyPaymentDays()
+	})
+}
+
+// SetWithholdingCategory sets the "withholding_category" field.
+func (u *VendorUpsertBulk) SetWithholdingCategory(v string) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetWithholdingCategory(v)
+	})
+}
+
+// UpdateWithholdingCategory sets the "withholding_category" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdateWithholdingCategory() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateWithholdingCategory()
+	})
+}
+
+// ClearWithholdingCategory clears the value of the "withholding_category" field.
+func (u *VendorUpsertBulk) ClearWithholdingCategory() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearWithholdingCategory()
+	})
+}
+
+// SetDefaultExpenseAccount sets the "default_expense_account" field.
+func (u *VendorUpsertBulk) SetDefaultExpenseAccount(v string) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetDefaultExpenseAccount(v)
+	})
+}
+
+// UpdateDefaultExpenseAccount sets the "default_expense_account" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdateDefaultExpenseAccount() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateDefaultExpenseAccount()
+	})
+}
+
+// ClearDefaultExpenseAccount clears the value of the "default_expense_account" field.
+func (u *VendorUpsertBulk) ClearDefaultExpenseAccount() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearDefaultExpenseAccount()
+	})
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (u *VendorUpsertBulk) SetPaymentMethod(v string) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetPaymentMethod(v)
+	})
+}
+
+// UpdatePaymentMethod sets the "payment_method" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdatePaymentMethod() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdatePaymentMethod()
+	})
+}
+
+// ClearPaymentMethod clears the value of the "payment_method" field.
+func (u *VendorUpsertBulk) ClearPaymentMethod() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearPaymentMethod()
+	})
+}
+
+// SetPaymentDetails sets the "payment_details" field.
+func (u *VendorUpsertBulk) SetPaymentDetails(v map[string]interface{}) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetPaymentDetails(v)
+	})
+}
+
+// UpdatePaymentDetails sets the "payment_details" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdatePaymentDetails() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdatePaymentDetails()
+	})
+}
+
+// ClearPaymentDetails clears the value of the "payment_details" field.
+func (u *VendorUpsertBulk) ClearPaymentDetails() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.ClearPaymentDetails()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *VendorUpsertBulk) SetStatus(v string) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdateStatus() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *VendorUpsertBulk) SetMetadata(v map[string]interface{}) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdateMetadata() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VendorUpsertBulk) SetUpdatedAt(v time.Time) *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VendorUpsertBulk) UpdateUpdatedAt() *VendorUpsertBulk {
+	return u.Update(func(s *VendorUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *VendorUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the VendorCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VendorCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VendorUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
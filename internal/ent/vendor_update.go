@@ -0,0 +1,1110 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/vendor"
+	"github.com/bengobox/treasury-api/internal/modules/customers/profile"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// VendorUpdate is the builder for updating Vendor entities.
+type VendorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VendorMutation
+}
+
+// Where appends a list predicates to the VendorUpdate builder.
+func (_u *VendorUpdate) Where(ps ...predicate.Vendor) *VendorUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *VendorUpdate) SetTenantID(v uuid.UUID) *VendorUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *VendorUpdate) SetNillableTenantID(v *uuid.UUID) *VendorUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetLegalName sets the "legal_name" field.
+func (_u *VendorUpdate) SetLegalName(v string) *VendorUpdate {
+	_u.mutation.SetLegalName(v)
+	return _u
+}
+
+// SetNillableLegalName sets the "legal_name" field if the given value is not nil.
+func (_u *VendorUpdate) SetNillableLegalName(v *string) *VendorUpdate {
+	if v != nil {
+		_u.SetLegalName(*v)
+	}
+	return _u
+}
+
+// SetTradingName sets the "trading_name" field.
+func (_u *VendorUpdate) SetTradingName(v string) *VendorUpdate {
+	_u.mutation.SetTradingName(v)
+	return _u
+}
+
+// SetNillableTradingName sets the "trading_name" field if the given value is not nil.
+func (_u *VendorUpdate) SetNillableTradingName(v *string) *VendorUpdate {
+	if v != nil {
+		_u.SetTradingName(*v)
+	}
+	return _u
+}
+
+// ClearTradingName clears the value of the "trading_name" field.
+func (_u *VendorUpdate) ClearTradingName() *VendorUpdate {
+	_u.mutation.ClearTradingName()
+	return _u
+}
+
+// SetKraPin sets the "kra_pin" field.
+func (_u *VendorUpdate) SetKraPin(v string) *VendorUpdate {
+	_u.mutation.SetKraPin(v)
+	return _u
+}
+
+// SetNillableKraPin sets the "kra_pin" field if the given value is not nil.
+func (_u *VendorUpdate) SetNillableKraPin(v *string) *VendorUpdate {
+	if v != nil {
+		_u.SetKraPin(*v)
+	}
+	return _u
+}
+
+// ClearKraPin clears the value of the "kra_pin" field.
+func (_u *VendorUpdate) ClearKraPin() *VendorUpdate {
+	_u.mutation.ClearKraPin()
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *VendorUpdate) SetEmail(v string) *VendorUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *VendorUpdate) SetNillableEmail(v *string) *VendorUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *VendorUpdate) ClearEmail() *VendorUpdate {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *VendorUpdate) SetPhone(v string) *VendorUpdate {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *VendorUpdate) SetNillablePhone(v *string) *VendorUpdate {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *VendorUpdate) ClearPhone() *VendorUpdate {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetAddresses sets the "addresses" field.
+func (_u *VendorUpdate) SetAddresses(v []profile.Address) *VendorUpdate {
+	_u.mutation.SetAddresses(v)
+	return _u
+}
+
+// AppendAddresses appends value to the "addresses" field.
+func (_u *VendorUpdate) AppendAddresses(v []profile.Address) *VendorUpdate {
+	_u.mutation.AppendAddresses(v)
+	return _u
+}
+
+// ClearAddresses clears the value of the "addresses" field.
+func (_u *VendorUpdate) ClearAddresses() *VendorUpdate {
+	_u.mutation.ClearAddresses()
+	return _u
+}
+
+// SetContacts sets the "contacts" field.
+func (_u *VendorUpdate) SetContacts(v []profile.Contact) *VendorUpdate {
+	_u.mutation.SetContacts(v)
+	return _u
+}
+
+// AppendContacts appends value to the "contacts" field.
+func (_u *VendorUpdate) AppendContacts(v []profile.Contact) *VendorUpdate {
+	_u.mutation.AppendContacts(v)
+	return _u
+}
+
+// ClearContacts clears the value of the "contacts" field.
+func (_u *VendorUpdate) ClearContacts() *VendorUpdate {
+	_u.mutation.ClearContacts()
+	return _u
+}
+
+// SetDefaultCurrency sets the "default_currency" field.
+func (_u *VendorUpdate) SetDefaultCurrency(v string) *VendorUpdate {
+	_u.mutation.SetDefaultCurrency(v)
+	return _u
+}
+
+// SetNillableDefaultCurrency sets the "default_currency" field if the given value is not nil.
+func (_u *VendorUpdate) SetNillableDefaultCurrency(v *string) *VendorUpdate {
+	if v != nil {
+		_u.SetDefaultCurrency(*v)
+	}
+	return _u
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (_u *VendorUpdate) SetPaymentTermsDays(v int) *VendorUpdate {
+	_u.mutation.ResetPaymentTermsDays()
+	_u.mutation.SetPaymentTermsDays(v)
+	return _u
+}
+
+// SetNillablePaymentTermsDays sets the "payment_terms_days" field if the given value is not nil.
+func (_u *VendorUpdate) SetNillablePaymentTermsDays(v *int) *VendorUpdate {
+	if v != nil {
+		_u.SetPaymentTermsDays(*v)
+	}
+	return _u
+}
+
+// AddPaymentTermsDays adds value to the "payment_terms_days" field.
+func (_u *VendorUpdate) AddPaymentTermsDays(v int) *VendorUpdate {
+	_u.mutation.AddPaymentTermsDays(v)
+	return _u
+}
+
+// SetEarlyPaymentDiscount sets the "early_payment_discount" field.
+func (_u *VendorUpdate) SetEarlyPaymentDiscount(v decimal.Decimal) *VendorUpdate {
+	_u.mutation.ResetEarlyPaymentDiscount()
+	_u.mutation.SetEarlyPaymentDiscount(v)
+	return _u
+}
+
+// SetNillableEarlyPaymentDiscount sets the "early_payment_discount" field if the given value is not nil.
+func (_u *VendorUpdate) SetNillableEarlyPaymentDiscount(v *decimal.Decimal) *VendorUpdate {
+	if v != nil {
+		_u.SetEarlyPaymentDiscount(*v)
+	}
+	return _u
+}
+
+// AddEarlyPaymentDiscount adds value to the "early_payment_discount" field.
+func (_u *VendorUpdate) AddEarlyPaymentDiscount(v decimal.Decimal) *VendorUpdate {
+	_u.mutation.AddEarlyPaymentDiscount(v)
+	return _u
+}
+
+// ClearEarlyPaymentDiscount clears the value of the "early_payment_discount" field.
+func (_u *VendorUpdate) ClearEarlyPaymentDiscount() *VendorUpdate {
+	_u.mutation.ClearEarlyPaymentDiscount()
+	return _u
+}
+
+// SetEarlyPaymentDays sets the "early_payment_days" field.
+func (_u *VendorUpdate) SetEarlyPaymentDays(v int) *VendorUpdate {
+	_u.mutation.ResetEarlyPaymentDays()
+	_u.mutation.SetEarlyPaymentDays(v)
+	return _u
+}
+
+// SetNillableEarlyPaymentDays sets the "early_payment_days" field if the given value is not nil.
+func (_u *VendorUpdate) SetNillableEarlyPaymentDays(v *int) *VendorUpdate {
+	if v != nil {
+		_u.SetEarlyPaymentDays(*v)
+	}
+	return _u
+}
+
+// AddEarlyPaymentDays adds value to the "early_payment_days" field.
+func (_u *VendorUpdate) AddEarlyPaymentDays(v int) *VendorUpdate {
+	_u.mutation.AddEarlyPaymentDays(v)
+	return _u
+}
+
+// ClearEarlyPaymentDays clears the value of the "early_payment_days" field.
+func (_u *VendorUpdate) ClearEarlyPaymentDays() *VendorUpdate {
+	_u.mutation.ClearEarlyPaymentDays()
+	return _u
+}
+
+// SetWithholdingCategory sets the "withholding_category" field.
+func (_u *VendorUpdate) SetWithholdingCategory(v string) *VendorUpdate {
+	_u.mutation.SetWithholdingCategory(v)
+	return _u
+}
+
+// SetNillableWithholdingCategory sets the "withholding_category" field if the given value is not nil.
+func (_u *VendorUpdate) SetNillableWithholdingCategory(v *string) *VendorUpdate {
+	if v != nil {
+		_u.SetWithholdingCategory(*v)
+	}
+	return _u
+}
+
+// ClearWithholdingCategory clears the value of the "withholding_category" field.
+func (_u *VendorUpdate) ClearWithholdingCategory() *VendorUpdate {
+	_u.mutation.ClearWithholdingCategory()
+	return _u
+}
+
+// SetDefaultExpenseAccount sets the "default_expense_account" field.
+func (_u *VendorUpdate) SetDefaultExpenseAccount(v string) *VendorUpdate {
+	_u.mutation.SetDefaultExpenseAccount(v)
+	return _u
+}
+
+// SetNillableDefaultExpenseAccount sets the "default_expense_account" field if the given value is not nil.
+func (_u *VendorUpdate) SetNillableDefaultExpenseAccount(v *string) *VendorUpdate {
+	if v != nil {
+		_u.SetDefaultExpenseAccount(*v)
+	}
+	return _u
+}
+
+// ClearDefaultExpenseAccount clears the value of the "default_expense_account" field.
+func (_u *VendorUpdate) ClearDefaultExpenseAccount() *VendorUpdate {
+	_u.mutation.ClearDefaultExpenseAccount()
+	return _u
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (_u *VendorUpdate) SetPaymentMethod(v string) *VendorUpdate {
+	_u.mutation.SetPaymentMethod(v)
+	return _u
+}
+
+// SetNillablePaymentMethod sets the "payment_method" field if the given value is not nil.
+func (_u *VendorUpdate) SetNillablePaymentMethod(v *string) *VendorUpdate {
+	if v != nil {
+		_u.SetPaymentMethod(*v)
+	}
+	return _u
+}
+
+// ClearPaymentMethod clears the value of the "payment_method" field.
+func (_u *VendorUpdate) ClearPaymentMethod() *VendorUpdate {
+	_u.mutation.ClearPaymentMethod()
+	return _u
+}
+
+// SetPaymentDetails sets the "payment_details" field.
+func (_u *VendorUpdate) SetPaymentDetails(v map[string]interface{}) *VendorUpdate {
+	_u.mutation.SetPaymentDetails(v)
+	return _u
+}
+
+// ClearPaymentDetails clears the value of the "payment_details" field.
+func (_u *VendorUpdate) ClearPaymentDetails() *VendorUpdate {
+	_u.mutation.ClearPaymentDetails()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *VendorUpdate) SetStatus(v string) *VendorUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *VendorUpdate) SetNillableStatus(v *string) *VendorUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *VendorUpdate) SetMetadata(v map[string]interface{}) *VendorUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VendorUpdate) SetUpdatedAt(v time.Time) *VendorUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the VendorMutation object of the builder.
+func (_u *VendorUpdate) Mutation() *VendorMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VendorUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VendorUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VendorUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VendorUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VendorUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := vendor.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VendorUpdate) check() error {
+	if v, ok := _u.mutation.LegalName(); ok {
+		if err := vendor.LegalNameValidator(v); err != nil {
+			return &ValidationError{Name: "legal_name", err: fmt.Errorf(`ent: validator failed for field "Vendor.legal_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *VendorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vendor.Table, vendor.Columns, sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(vendor.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.LegalName(); ok {
+		_spec.SetField(vendor.FieldLegalName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TradingName(); ok {
+		_spec.SetField(vendor.FieldTradingName, field.TypeString, value)
+	}
+	if _u.mutation.TradingNameCleared() {
+		_spec.ClearField(vendor.FieldTradingName, field.TypeString)
+	}
+	if value, ok := _u.mutation.KraPin(); ok {
+		_spec.SetField(vendor.FieldKraPin, field.TypeString, value)
+	}
+	if _u.mutation.KraPinCleared() {
+		_spec.ClearField(vendor.FieldKraPin, field.TypeString)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(vendor.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(vendor.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(vendor.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(vendor.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.Addresses(); ok {
+		_spec.SetField(vendor.FieldAddresses, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAddresses(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vendor.FieldAddresses, value)
+		})
+	}
+	if _u.mutation.AddressesCleared() {
+		_spec.ClearField(vendor.FieldAddresses, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Contacts(); ok {
+		_spec.SetField(vendor.FieldContacts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedContacts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vendor.FieldContacts, value)
+		})
+	}
+	if _u.mutation.ContactsCleared() {
+		_spec.ClearField(vendor.FieldContacts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DefaultCurrency(); ok {
+		_spec.SetField(vendor.FieldDefaultCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PaymentTermsDays(); ok {
+		_spec.SetField(vendor.FieldPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPaymentTermsDays(); ok {
+		_spec.AddField(vendor.FieldPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EarlyPaymentDiscount(); ok {
+		_spec.SetField(vendor.FieldEarlyPaymentDiscount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEarlyPaymentDiscount(); ok {
+		_spec.AddField(vendor.FieldEarlyPaymentDiscount, field.TypeFloat64, value)
+	}
+	if _u.mutation.EarlyPaymentDiscountCleared() {
+		_spec.ClearField(vendor.FieldEarlyPaymentDiscount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EarlyPaymentDays(); ok {
+		_spec.SetField(vendor.FieldEarlyPaymentDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEarlyPaymentDays(); ok {
+		_spec.AddField(vendor.FieldEarlyPaymentDays, field.TypeInt, value)
+	}
+	if _u.mutation.EarlyPaymentDaysCleared() {
+		_spec.ClearField(vendor.FieldEarlyPaymentDays, field.TypeInt)
+	}
+	if value, ok := _u.mutation.WithholdingCategory(); ok {
+		_spec.SetField(vendor.FieldWithholdingCategory, field.TypeString, value)
+	}
+	if _u.mutation.WithholdingCategoryCleared() {
+		_spec.ClearField(vendor.FieldWithholdingCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.DefaultExpenseAccount(); ok {
+		_spec.SetField(vendor.FieldDefaultExpenseAccount, field.TypeString, value)
+	}
+	if _u.mutation.DefaultExpenseAccountCleared() {
+		_spec.ClearField(vendor.FieldDefaultExpenseAccount, field.TypeString)
+	}
+	if value, ok := _u.mutation.PaymentMethod(); ok {
+		_spec.SetField(vendor.FieldPaymentMethod, field.TypeString, value)
+	}
+	if _u.mutation.PaymentMethodCleared() {
+		_spec.ClearField(vendor.FieldPaymentMethod, field.TypeString)
+	}
+	if value, ok := _u.mutation.PaymentDetails(); ok {
+		_spec.SetField(vendor.FieldPaymentDetails, field.TypeJSON, value)
+	}
+	if _u.mutation.PaymentDetailsCleared() {
+		_spec.ClearField(vendor.FieldPaymentDetails, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(vendor.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(vendor.FieldMetadata, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(vendor.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vendor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VendorUpdateOne is the builder for updating a single Vendor entity.
+type VendorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VendorMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *VendorUpdateOne) SetTenantID(v uuid.UUID) *VendorUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *VendorUpdateOne) SetNillableTenantID(v *uuid.UUID) *VendorUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetLegalName sets the "legal_name" field.
+func (_u *VendorUpdateOne) SetLegalName(v string) *VendorUpdateOne {
+	_u.mutation.SetLegalName(v)
+	return _u
+}
+
+// SetNillableLegalName sets the "legal_name" field if the given value is not nil.
+func (_u *VendorUpdateOne) SetNillableLegalName(v *string) *VendorUpdateOne {
+	if v != nil {
+		_u.SetLegalName(*v)
+	}
+	return _u
+}
+
+// SetTradingName sets the "trading_name" field.
+func (_u *VendorUpdateOne) SetTradingName(v string) *VendorUpdateOne {
+	_u.mutation.SetTradingName(v)
+	return _u
+}
+
+// SetNillableTradingName sets the "trading_name" field if the given value is not nil.
+func (_u *VendorUpdateOne) SetNillableTradingName(v *string) *VendorUpdateOne {
+	if v != nil {
+		_u.SetTradingName(*v)
+	}
+	return _u
+}
+
+// ClearTradingName clears the value of the "trading_name" field.
+func (_u *VendorUpdateOne) ClearTradingName() *VendorUpdateOne {
+	_u.mutation.ClearTradingName()
+	return _u
+}
+
+// SetKraPin sets the "kra_pin" field.
+func (_u *VendorUpdateOne) SetKraPin(v string) *VendorUpdateOne {
+	_u.mutation.SetKraPin(v)
+	return _u
+}
+
+// SetNillableKraPin sets the "kra_pin" field if the given value is not nil.
+func (_u *VendorUpdateOne) SetNillableKraPin(v *string) *VendorUpdateOne {
+	if v != nil {
+		_u.SetKraPin(*v)
+	}
+	return _u
+}
+
+// ClearKraPin clears the value of the "kra_pin" field.
+func (_u *VendorUpdateOne) ClearKraPin() *VendorUpdateOne {
+	_u.mutation.ClearKraPin()
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *VendorUpdateOne) SetEmail(v string) *VendorUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *VendorUpdateOne) SetNillableEmail(v *string) *VendorUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *VendorUpdateOne) ClearEmail() *VendorUpdateOne {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *VendorUpdateOne) SetPhone(v string) *VendorUpdateOne {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *VendorUpdateOne) SetNillablePhone(v *string) *VendorUpdateOne {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *VendorUpdateOne) ClearPhone() *VendorUpdateOne {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetAddresses sets the "addresses" field.
+func (_u *VendorUpdateOne) SetAddresses(v []profile.Address) *VendorUpdateOne {
+	_u.mutation.SetAddresses(v)
+	return _u
+}
+
+// AppendAddresses appends value to the "addresses" field.
+func (_u *VendorUpdateOne) AppendAddresses(v []profile.Address) *VendorUpdateOne {
+	_u.mutation.AppendAddresses(v)
+	return _u
+}
+
+// ClearAddresses clears the value of the "addresses" field.
+func (_u *VendorUpdateOne) ClearAddresses() *VendorUpdateOne {
+	_u.mutation.ClearAddresses()
+	return _u
+}
+
+// SetContacts sets the "contacts" field.
+func (_u *VendorUpdateOne) SetContacts(v []profile.Contact) *VendorUpdateOne {
+	_u.mutation.SetContacts(v)
+	return _u
+}
+
+// AppendContacts appends value to the "contacts" field.
+func (_u *VendorUpdateOne) AppendContacts(v []profile.Contact) *VendorUpdateOne {
+	_u.mutation.AppendContacts(v)
+	return _u
+}
+
+// ClearContacts clears the value of the "contacts" field.
+func (_u *VendorUpdateOne) ClearContacts() *VendorUpdateOne {
+	_u.mutation.ClearContacts()
+	return _u
+}
+
+// SetDefaultCurrency sets the "default_currency" field.
+func (_u *VendorUpdateOne) SetDefaultCurrency(v string) *VendorUpdateOne {
+	_u.mutation.SetDefaultCurrency(v)
+	return _u
+}
+
+// SetNillableDefaultCurrency sets the "default_currency" field if the given value is not nil.
+func (_u *VendorUpdateOne) SetNillableDefaultCurrency(v *string) *VendorUpdateOne {
+	if v != nil {
+		_u.SetDefaultCurrency(*v)
+	}
+	return _u
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (_u *VendorUpdateOne) SetPaymentTermsDays(v int) *VendorUpdateOne {
+	_u.mutation.ResetPaymentTermsDays()
+	_u.mutation.SetPaymentTermsDays(v)
+	return _u
+}
+
+// SetNillablePaymentTermsDays sets the "payment_terms_days" field if the given value is not nil.
+func (_u *VendorUpdateOne) SetNillablePaymentTermsDays(v *int) *VendorUpdateOne {
+	if v != nil {
+		_u.SetPaymentTermsDays(*v)
+	}
+	return _u
+}
+
+// AddPaymentTermsDays adds value to the "payment_terms_days" field.
+func (_u *VendorUpdateOne) AddPaymentTermsDays(v int) *VendorUpdateOne {
+	_u.mutation.AddPaymentTermsDays(v)
+	return _u
+}
+
+// SetEarlyPaymentDiscount sets the "early_payment_discount" field.
+func (_u *VendorUpdateOne) SetEarlyPaymentDiscount(v decimal.Decimal) *VendorUpdateOne {
+	_u.mutation.ResetEarlyPaymentDiscount()
+	_u.mutation.SetEarlyPaymentDiscount(v)
+	return _u
+}
+
+// SetNillableEarlyPaymentDiscount sets the "early_payment_discount" field if the given value is not nil.
+func (_u *VendorUpdateOne) SetNillableEarlyPaymentDiscount(v *decimal.Decimal) *VendorUpdateOne {
+	if v != nil {
+		_u.SetEarlyPaymentDiscount(*v)
+	}
+	return _u
+}
+
+// AddEarlyPaymentDiscount adds value to the "early_payment_discount" field.
+func (_u *VendorUpdateOne) AddEarlyPaymentDiscount(v decimal.Decimal) *VendorUpdateOne {
+	_u.mutation.AddEarlyPaymentDiscount(v)
+	return _u
+}
+
+// ClearEarlyPaymentDiscount clears the value of the "early_payment_discount" field.
+func (_u *VendorUpdateOne) ClearEarlyPaymentDiscount() *VendorUpdateOne {
+	_u.mutation.ClearEarlyPaymentDiscount()
+	return _u
+}
+
+// SetEarlyPaymentDays sets the "early_payment_days" field.
+func (_u *VendorUpdateOne) SetEarlyPaymentDays(v int) *VendorUpdateOne {
+	_u.mutation.ResetEarlyPaymentDays()
+	_u.mutation.SetEarlyPaymentDays(v)
+	return _u
+}
+
+// SetNillableEarlyPaymentDays sets the "early_payment_days" field if the given value is not nil.
+func (_u *VendorUpdateOne) SetNillableEarlyPaymentDays(v *int) *VendorUpdateOne {
+	if v != nil {
+		_u.SetEarlyPaymentDays(*v)
+	}
+	return _u
+}
+
+// AddEarlyPaymentDays adds value to the "early_payment_days" field.
+func (_u *VendorUpdateOne) AddEarlyPaymentDays(v int) *VendorUpdateOne {
+	_u.mutation.AddEarlyPaymentDays(v)
+	return _u
+}
+
+// ClearEarlyPaymentDays clears the value of the "early_payment_days" field.
+func (_u *VendorUpdateOne) ClearEarlyPaymentDays() *VendorUpdateOne {
+	_u.mutation.ClearEarlyPaymentDays()
+	return _u
+}
+
+// SetWithholdingCategory sets the "withholding_category" field.
+func (_u *VendorUpdateOne) SetWithholdingCategory(v string) *VendorUpdateOne {
+	_u.mutation.SetWithholdingCategory(v)
+	return _u
+}
+
+// SetNillableWithholdingCategory sets the "withholding_category" field if the given value is not nil.
+func (_u *VendorUpdateOne) SetNillableWithholdingCategory(v *string) *VendorUpdateOne {
+	if v != nil {
+		_u.SetWithholdingCategory(*v)
+	}
+	return _u
+}
+
+// ClearWithholdingCategory clears the value of the "withholding_category" field.
+func (_u *VendorUpdateOne) ClearWithholdingCategory() *VendorUpdateOne {
+	_u.mutation.ClearWithholdingCategory()
+	return _u
+}
+
+// SetDefaultExpenseAccount sets the "default_expense_account" field.
+func (_u *VendorUpdateOne) SetDefaultExpenseAccount(v string) *VendorUpdateOne {
+	_u.mutation.SetDefaultExpenseAccount(v)
+	return _u
+}
+
+// SetNillableDefaultExpenseAccount sets the "default_expense_account" field if the given value is not nil.
+func (_u *VendorUpdateOne) SetNillableDefaultExpenseAccount(v *string) *VendorUpdateOne {
+	if v != nil {
+		_u.SetDefaultExpenseAccount(*v)
+	}
+	return _u
+}
+
+// ClearDefaultExpenseAccount clears the value of the "default_expense_account" field.
+func (_u *VendorUpdateOne) ClearDefaultExpenseAccount() *VendorUpdateOne {
+	_u.mutation.ClearDefaultExpenseAccount()
+	return _u
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (_u *VendorUpdateOne) SetPaymentMethod(v string) *VendorUpdateOne {
+	_u.mutation.SetPaymentMethod(v)
+	return _u
+}
+
+// SetNillablePaymentMethod sets the "payment_method" field if the given value is not nil.
+func (_u *VendorUpdateOne) SetNillablePaymentMethod(v *string) *VendorUpdateOne {
+	if v != nil {
+		_u.SetPaymentMethod(*v)
+	}
+	return _u
+}
+
+// ClearPaymentMethod clears the value of the "payment_method" field.
+func (_u *VendorUpdateOne) ClearPaymentMethod() *VendorUpdateOne {
+	_u.mutation.ClearPaymentMethod()
+	return _u
+}
+
+// SetPaymentDetails sets the "payment_details" field.
+func (_u *VendorUpdateOne) SetPaymentDetails(v map[string]interface{}) *VendorUpdateOne {
+	_u.mutation.SetPaymentDetails(v)
+	return _u
+}
+
+// ClearPaymentDetails clears the value of the "payment_details" field.
+func (_u *VendorUpdateOne) ClearPaymentDetails() *VendorUpdateOne {
+	_u.mutation.ClearPaymentDetails()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *VendorUpdateOne) SetStatus(v string) *VendorUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *VendorUpdateOne) SetNillableStatus(v *string) *VendorUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *VendorUpdateOne) SetMetadata(v map[string]interface{}) *VendorUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VendorUpdateOne) SetUpdatedAt(v time.Time) *VendorUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the VendorMutation object of the builder.
+func (_u *VendorUpdateOne) Mutation() *VendorMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the VendorUpdate builder.
+func (_u *VendorUpdateOne) Where(ps ...predicate.Vendor) *VendorUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VendorUpdateOne) Select(field string, fields ...string) *VendorUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Vendor entity.
+func (_u *VendorUpdateOne) Save(ctx context.Context) (*Vendor, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VendorUpdateOne) SaveX(ctx context.Context) *Vendor {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VendorUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VendorUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VendorUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := vendor.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VendorUpdateOne) check() error {
+	if v, ok := _u.mutation.LegalName(); ok {
+		if err := vendor.LegalNameValidator(v); err != nil {
+			return &ValidationError{Name: "legal_name", err: fmt.Errorf(`ent: validator failed for field "Vendor.legal_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *VendorUpdateOne) sqlSave(ctx context.Context) (_node *Vendor, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vendor.Table, vendor.Columns, sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Vendor.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vendor.FieldID)
+		for _, f := range fields {
+			if !vendor.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vendor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(vendor.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.LegalName(); ok {
+		_spec.SetField(vendor.FieldLegalName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TradingName(); ok {
+		_spec.SetField(vendor.FieldTradingName, field.TypeString, value)
+	}
+	if _u.mutation.TradingNameCleared() {
+		_spec.ClearField(vendor.FieldTradingName, field.TypeString)
+	}
+	if value, ok := _u.mutation.KraPin(); ok {
+		_spec.SetField(vendor.FieldKraPin, field.TypeString, value)
+	}
+	if _u.mutation.KraPinCleared() {
+		_spec.ClearField(vendor.FieldKraPin, field.TypeString)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(vendor.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(vendor.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(vendor.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(vendor.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.Addresses(); ok {
+		_spec.SetField(vendor.FieldAddresses, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAddresses(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vendor.FieldAddresses, value)
+		})
+	}
+	if _u.mutation.AddressesCleared() {
+		_spec.ClearField(vendor.FieldAddresses, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Contacts(); ok {
+		_spec.SetField(vendor.FieldContacts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedContacts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vendor.FieldContacts, value)
+		})
+	}
+	if _u.mutation.ContactsCleared() {
+		_spec.ClearField(vendor.FieldContacts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DefaultCurrency(); ok {
+		_spec.SetField(vendor.FieldDefaultCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PaymentTermsDays(); ok {
+		_spec.SetField(vendor.FieldPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPaymentTermsDays(); ok {
+		_spec.AddField(vendor.FieldPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EarlyPaymentDiscount(); ok {
+		_spec.SetField(vendor.FieldEarlyPaymentDiscount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEarlyPaymentDiscount(); ok {
+		_spec.AddField(vendor.FieldEarlyPaymentDiscount, field.TypeFloat64, value)
+	}
+	if _u.mutation.EarlyPaymentDiscountCleared() {
+		_spec.ClearField(vendor.FieldEarlyPaymentDiscount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EarlyPaymentDays(); ok {
+		_spec.SetField(vendor.FieldEarlyPaymentDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEarlyPaymentDays(); ok {
+		_spec.AddField(vendor.FieldEarlyPaymentDays, field.TypeInt, value)
+	}
+	if _u.mutation.EarlyPaymentDaysCleared() {
+		_spec.ClearField(vendor.FieldEarlyPaymentDays, field.TypeInt)
+	}
+	if value, ok := _u.mutation.WithholdingCategory(); ok {
+		_spec.SetField(vendor.FieldWithholdingCategory, field.TypeString, value)
+	}
+	if _u.mutation.WithholdingCategoryCleared() {
+		_spec.ClearField(vendor.FieldWithholdingCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.DefaultExpenseAccount(); ok {
+		_spec.SetField(vendor.FieldDefaultExpenseAccount, field.TypeString, value)
+	}
+	if _u.mutation.DefaultExpenseAccountCleared() {
+		_spec.ClearField(vendor.FieldDefaultExpenseAccount, field.TypeString)
+	}
+	if value, ok := _u.mutation.PaymentMethod(); ok {
+		_spec.SetField(vendor.FieldPaymentMethod, field.TypeString, value)
+	}
+	if _u.mutation.PaymentMethodCleared() {
+		_spec.ClearField(vendor.FieldPaymentMethod, field.TypeString)
+	}
+	if value, ok := _u.mutation.PaymentDetails(); ok {
+		_spec.SetField(vendor.FieldPaymentDetails, field.TypeJSON, value)
+	}
+	if _u.mutation.PaymentDetailsCleared() {
+		_spec.ClearField(vendor.FieldPaymentDetails, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(vendor.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(vendor.FieldMetadata, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(vendor.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Vendor{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vendor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
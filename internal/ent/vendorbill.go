@@ -0,0 +1,528 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbill"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// VendorBill is the model entity for the VendorBill schema.
+type VendorBill struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Vendor identifier
+	VendorID uuid.UUID `json:"vendor_id,omitempty"`
+	// Sequential bill number
+	BillNumber string `json:"bill_number,omitempty"`
+	// The vendor's own invoice number
+	VendorReference string `json:"vendor_reference,omitempty"`
+	// BillDate holds the value of the "bill_date" field.
+	BillDate time.Time `json:"bill_date,omitempty"`
+	// DueDate holds the value of the "due_date" field.
+	DueDate time.Time `json:"due_date,omitempty"`
+	// ISO currency code
+	Currency string `json:"currency,omitempty"`
+	// Total before tax
+	Subtotal decimal.Decimal `json:"subtotal,omitempty"`
+	// Input tax (defaults to zero)
+	TaxAmount decimal.Decimal `json:"tax_amount,omitempty"`
+	// Total owed to the vendor
+	TotalAmount decimal.Decimal `json:"total_amount,omitempty"`
+	// Amount paid (defaults to zero)
+	PaidAmount decimal.Decimal `json:"paid_amount,omitempty"`
+	// Vendor early-payment discount captured when the bill was entered (defaults to zero)
+	DiscountRate decimal.Decimal `json:"discount_rate,omitempty"`
+	// Last day the early-payment discount can be taken
+	DiscountUntil time.Time `json:"discount_until,omitempty"`
+	// Early-payment discount deducted when the bill was paid (defaults to zero)
+	DiscountTaken decimal.Decimal `json:"discount_taken,omitempty"`
+	// Withholding tax to deduct on payment, from the lines (defaults to zero)
+	WithholdingAmount decimal.Decimal `json:"withholding_amount,omitempty"`
+	// Withholding tax deducted when the bill was paid (defaults to zero)
+	WithholdingTaken decimal.Decimal `json:"withholding_taken,omitempty"`
+	// Status: draft, approved, scheduled, paid, cancelled
+	Status string `json:"status,omitempty"`
+	// Object storage keys or URLs of the scanned bill and supporting documents
+	Attachments []string `json:"attachments,omitempty"`
+	// Source of the bill (e.g., logistics_expense)
+	ReferenceType string `json:"reference_type,omitempty"`
+	// Source identifier in the originating service
+	ReferenceID string `json:"reference_id,omitempty"`
+	// Purchase order the bill is matched against
+	PoNumber string `json:"po_number,omitempty"`
+	// Three-way match: awaiting_receipt, matched, variance, accepted (empty when no PO)
+	MatchStatus string `json:"match_status,omitempty"`
+	// When the bill was last matched to goods receipts
+	MatchedAt time.Time `json:"matched_at,omitempty"`
+	// VarianceAcceptedBy holds the value of the "variance_accepted_by" field.
+	VarianceAcceptedBy uuid.UUID `json:"variance_accepted_by,omitempty"`
+	// VarianceAcceptedAt holds the value of the "variance_accepted_at" field.
+	VarianceAcceptedAt time.Time `json:"variance_accepted_at,omitempty"`
+	// Why the match variances were accepted
+	VarianceReason string `json:"variance_reason,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes string `json:"notes,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy uuid.UUID `json:"created_by,omitempty"`
+	// ApprovedBy holds the value of the "approved_by" field.
+	ApprovedBy uuid.UUID `json:"approved_by,omitempty"`
+	// ApprovedAt holds the value of the "approved_at" field.
+	ApprovedAt time.Time `json:"approved_at,omitempty"`
+	// Date the bill is scheduled to be paid
+	ScheduledFor time.Time `json:"scheduled_for,omitempty"`
+	// PaidAt holds the value of the "paid_at" field.
+	PaidAt time.Time `json:"paid_at,omitempty"`
+	// Journal recognising the liability on approval
+	JournalEntryID uuid.UUID `json:"journal_entry_id,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the VendorBillQuery when eager-loading is set.
+	Edges        VendorBillEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// VendorBillEdges holds the relations/edges for other nodes in the graph.
+type VendorBillEdges struct {
+	// Lines holds the value of the lines edge.
+	Lines []*VendorBillLine `json:"lines,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LinesOrErr returns the Lines value or an error if the edge
+// was not loaded in eager-loading.
+func (e VendorBillEdges) LinesOrErr() ([]*VendorBillLine, error) {
+	if e.loadedTypes[0] {
+		return e.Lines, nil
+	}
+	return nil, &NotLoadedError{edge: "lines"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VendorBill) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vendorbill.FieldAttachments, vendorbill.FieldMetadata:
+			values[i] = new([]byte)
+		case vendorbill.FieldSubtotal, vendorbill.FieldTaxAmount, vendorbill.FieldTotalAmount, vendorbill.FieldPaidAmount, vendorbill.FieldDiscountRate, vendorbill.FieldDiscountTaken, vendorbill.FieldWithholdingAmount, vendorbill.FieldWithholdingTaken:
+			values[i] = new(decimal.Decimal)
+		case vendorbill.FieldBillNumber, vendorbill.FieldVendorReference, vendorbill.FieldCurrency, vendorbill.FieldStatus, vendorbill.FieldReferenceType, vendorbill.FieldReferenceID, vendorbill.FieldPoNumber, vendorbill.FieldMatchStatus, vendorbill.FieldVarianceReason, vendorbill.FieldNotes:
+			values[i] = new(sql.NullString)
+		case vendorbill.FieldBillDate, vendorbill.FieldDueDate, vendorbill.FieldDiscountUntil, vendorbill.FieldMatchedAt, vendorbill.FieldVarianceAcceptedAt, vendorbill.FieldApprovedAt, vendorbill.FieldScheduledFor, vendorbill.FieldPaidAt, vendorbill.FieldCreatedAt, vendorbill.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case vendorbill.FieldID, vendorbill.FieldTenantID, vendorbill.FieldVendorID, vendorbill.FieldVarianceAcceptedBy, vendorbill.FieldCreatedBy, vendorbill.FieldApprovedBy, vendorbill.FieldJournalEntryID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VendorBill fields.
+func (_m *VendorBill) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vendorbill.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case vendorbill.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case vendorbill.FieldVendorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field vendor_id", values[i])
+			} else if value != nil {
+				_m.VendorID = *value
+			}
+		case vendorbill.FieldBillNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bill_number", values[i])
+			} else if value.Valid {
+				_m.BillNumber = value.String
+			}
+		case vendorbill.FieldVendorReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vendor_reference", values[i])
+			} else if value.Valid {
+				_m.VendorReference = value.String
+			}
+		case vendorbill.FieldBillDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field bill_date", values[i])
+			} else if value.Valid {
+				_m.BillDate = value.Time
+			}
+		case vendorbill.FieldDueDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_date", values[i])
+			} else if value.Valid {
+				_m.DueDate = value.Time
+			}
+		case vendorbill.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case vendorbill.FieldSubtotal:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field subtotal", values[i])
+			} else if value != nil {
+				_m.Subtotal = *value
+			}
+		case vendorbill.FieldTaxAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_amount", values[i])
+			} else if value != nil {
+				_m.TaxAmount = *value
+			}
+		case vendorbill.FieldTotalAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field total_amount", values[i])
+			} else if value != nil {
+				_m.TotalAmount = *value
+			}
+		case vendorbill.FieldPaidAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field paid_amount", values[i])
+			} else if value != nil {
+				_m.PaidAmount = *value
+			}
+		case vendorbill.FieldDiscountRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_rate", values[i])
+			} else if value != nil {
+				_m.DiscountRate = *value
+			}
+		case vendorbill.FieldDiscountUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_until", values[i])
+			} else if value.Valid {
+				_m.DiscountUntil = value.Time
+			}
+		case vendorbill.FieldDiscountTaken:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_taken", values[i])
+			} else if value != nil {
+				_m.DiscountTaken = *value
+			}
+		case vendorbill.FieldWithholdingAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field withholding_amount", values[i])
+			} else if value != nil {
+				_m.WithholdingAmount = *value
+			}
+		case vendorbill.FieldWithholdingTaken:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field withholding_taken", values[i])
+			} else if value != nil {
+				_m.WithholdingTaken = *value
+			}
+		case vendorbill.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case vendorbill.FieldAttachments:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attachments", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Attachments); err != nil {
+					return fmt.Errorf("unmarshal field attachments: %w", err)
+				}
+			}
+		case vendorbill.FieldReferenceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference_type", values[i])
+			} else if value.Valid {
+				_m.ReferenceType = value.String
+			}
+		case vendorbill.FieldReferenceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference_id", values[i])
+			} else if value.Valid {
+				_m.ReferenceID = value.String
+			}
+		case vendorbill.FieldPoNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field po_number", values[i])
+			} else if value.Valid {
+				_m.PoNumber = value.String
+			}
+		case vendorbill.FieldMatchStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field match_status", values[i])
+			} else if value.Valid {
+				_m.MatchStatus = value.String
+			}
+		case vendorbill.FieldMatchedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field matched_at", values[i])
+			} else if value.Valid {
+				_m.MatchedAt = value.Time
+			}
+		case vendorbill.FieldVarianceAcceptedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field variance_accepted_by", values[i])
+			} else if value != nil {
+				_m.VarianceAcceptedBy = *value
+			}
+		case vendorbill.FieldVarianceAcceptedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field variance_accepted_at", values[i])
+			} else if value.Valid {
+				_m.VarianceAcceptedAt = value.Time
+			}
+		case vendorbill.FieldVarianceReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field variance_reason", values[i])
+			} else if value.Valid {
+				_m.VarianceReason = value.String
+			}
+		case vendorbill.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = value.String
+			}
+		case vendorbill.FieldCreatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value != nil {
+				_m.CreatedBy = *value
+			}
+		case vendorbill.FieldApprovedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_by", values[i])
+			} else if value != nil {
+				_m.ApprovedBy = *value
+			}
+		case vendorbill.FieldApprovedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_at", values[i])
+			} else if value.Valid {
+				_m.ApprovedAt = value.Time
+			}
+		case vendorbill.FieldScheduledFor:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_for", values[i])
+			} else if value.Valid {
+				_m.ScheduledFor = value.Time
+			}
+		case vendorbill.FieldPaidAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paid_at", values[i])
+			} else if value.Valid {
+				_m.PaidAt = value.Time
+			}
+		case vendorbill.FieldJournalEntryID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field journal_entry_id", values[i])
+			} else if value != nil {
+				_m.JournalEntryID = *value
+			}
+		case vendorbill.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case vendorbill.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case vendorbill.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VendorBill.
+// This includes values selected through modifiers, order, etc.
+func (_m *VendorBill) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLines queries the "lines" edge of the VendorBill entity.
+func (_m *VendorBill) QueryLines() *VendorBillLineQuery {
+	return NewVendorBillClient(_m.config).QueryLines(_m)
+}
+
+// Update returns a builder for updating this VendorBill.
+// Note that you need to call VendorBill.Unwrap() before calling this method if this VendorBill
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *VendorBill) Update() *VendorBillUpdateOne {
+	return NewVendorBillClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the VendorBill entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *VendorBill) Unwrap() *VendorBill {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VendorBill is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *VendorBill) String() string {
+	var builder strings.Builder
+	builder.WriteString("VendorBill(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("vendor_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VendorID))
+	builder.WriteString(", ")
+	builder.WriteString("bill_number=")
+	builder.WriteString(_m.BillNumber)
+	builder.WriteString(", ")
+	builder.WriteString("vendor_reference=")
+	builder.WriteString(_m.VendorReference)
+	builder.WriteString(", ")
+	builder.WriteString("bill_date=")
+	builder.WriteString(_m.BillDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("due_date=")
+	builder.WriteString(_m.DueDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("subtotal=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Subtotal))
+	builder.WriteString(", ")
+	builder.WriteString("tax_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxAmount))
+	builder.WriteString(", ")
+	builder.WriteString("total_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalAmount))
+	builder.WriteString(", ")
+	builder.WriteString("paid_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaidAmount))
+	builder.WriteString(", ")
+	builder.WriteString("discount_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DiscountRate))
+	builder.WriteString(", ")
+	builder.WriteString("discount_until=")
+	builder.WriteString(_m.DiscountUntil.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("discount_taken=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DiscountTaken))
+	builder.WriteString(", ")
+	builder.WriteString("withholding_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WithholdingAmount))
+	builder.WriteString(", ")
+	builder.WriteString("withholding_taken=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WithholdingTaken))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("attachments=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attachments))
+	builder.WriteString(", ")
+	builder.WriteString("reference_type=")
+	builder.WriteString(_m.ReferenceType)
+	builder.WriteString(", ")
+	builder.WriteString("reference_id=")
+	builder.WriteString(_m.ReferenceID)
+	builder.WriteString(", ")
+	builder.WriteString("po_number=")
+	builder.WriteString(_m.PoNumber)
+	builder.WriteString(", ")
+	builder.WriteString("match_status=")
+	builder.WriteString(_m.MatchStatus)
+	builder.WriteString(", ")
+	builder.WriteString("matched_at=")
+	builder.WriteString(_m.MatchedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("variance_accepted_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VarianceAcceptedBy))
+	builder.WriteString(", ")
+	builder.WriteString("variance_accepted_at=")
+	builder.WriteString(_m.VarianceAcceptedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("variance_reason=")
+	builder.WriteString(_m.VarianceReason)
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(_m.Notes)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("approved_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ApprovedBy))
+	builder.WriteString(", ")
+	builder.WriteString("approved_at=")
+	builder.WriteString(_m.ApprovedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("scheduled_for=")
+	builder.WriteString(_m.ScheduledFor.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("paid_at=")
+	builder.WriteString(_m.PaidAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("journal_entry_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JournalEntryID))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VendorBills is a parsable slice of VendorBill.
+type VendorBills []*VendorBill
@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vendorbill
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the vendorbill type in the database.
+	Label = "vendor_bill"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldVendorID holds the string denoting the vendor_id field in the database.
+	FieldVendorID = "vendor_id"
+	// FieldBillNumber holds the string denoting the bill_number field in the database.
+	FieldBillNumber = "bill_number"
+	// FieldVendorReference holds the string denoting the vendor_reference field in the database.
+	FieldVendorReference = "vendor_reference"
+	// FieldBillDate holds the string denoting the bill_date field in the database.
+	FieldBillDate = "bill_date"
+	// FieldDueDate holds the string denoting the due_date field in the database.
+	FieldDueDate = "due_date"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldSubtotal holds the string denoting the subtotal field in the database.
+	FieldSubtotal = "subtotal"
+	// FieldTaxAmount holds the string denoting the tax_amount field in the database.
+	FieldTaxAmount = "tax_amount"
+	// FieldTotalAmount holds the string denoting the total_amount field in the database.
+	FieldTotalAmount = "total_amount"
+	// FieldPaidAmount holds the string denoting the paid_amount field in the database.
+	FieldPaidAmount = "paid_amount"
+	// FieldDiscountRate holds the string denoting the discount_rate field in the database.
+	FieldDiscountRate = "discount_rate"
+	// FieldDiscountUntil holds the string denoting the discount_until field in the database.
+	FieldDiscountUntil = "discount_until"
+	// FieldDiscountTaken holds the string denoting the discount_taken field in the database.
+	FieldDiscountTaken = "discount_taken"
+	// FieldWithholdingAmount holds the string denoting the withholding_amount field in the database.
+	FieldWithholdingAmount = "withholding_amount"
+	// FieldWithholdingTaken holds the string denoting the withholding_taken field in the database.
+	FieldWithholdingTaken = "withholding_taken"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAttachments holds the string denoting the attachments field in the database.
+	FieldAttachments = "attachments"
+	// FieldReferenceType holds the string denoting the reference_type field in the database.
+	FieldReferenceType = "reference_type"
+	// FieldReferenceID holds the string denoting the reference_id field in the database.
+	FieldReferenceID = "reference_id"
+	// FieldPoNumber holds the string denoting the po_number field in the database.
+	FieldPoNumber = "po_number"
+	// FieldMatchStatus holds the string denoting the match_status field in the database.
+	FieldMatchStatus = "match_status"
+	// FieldMatchedAt holds the string denoting the matched_at field in the database.
+	FieldMatchedAt = "matched_at"
+	// FieldVarianceAcceptedBy holds the string denoting the variance_accepted_by field in the database.
+	FieldVarianceAcceptedBy = "variance_accepted_by"
+	// FieldVarianceAcceptedAt holds the string denoting the variance_accepted_at field in the database.
+	FieldVarianceAcceptedAt = "variance_accepted_at"
+	// FieldVarianceReason holds the string denoting the variance_reason field in the database.
+	FieldVarianceReason = "variance_reason"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldApprovedBy holds the string denoting the approved_by field in the database.
+	FieldApprovedBy = "approved_by"
+	// FieldApprovedAt holds the string denoting the approved_at field in the database.
+	FieldApprovedAt = "approved_at"
+	// FieldScheduledFor holds the string denoting the scheduled_for field in the database.
+	FieldScheduledFor = "scheduled_for"
+	// FieldPaidAt holds the string denoting the paid_at field in the database.
+	FieldPaidAt = "paid_at"
+	// FieldJournalEntryID holds the string denoting the journal_entry_id field in the database.
+	FieldJournalEntryID = "journal_entry_id"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeLines holds the string denoting the lines edge name in mutations.
+	EdgeLines = "lines"
+	// Table holds the table name of the vendorbill in the database.
+	Table = "vendor_bills"
+	// LinesTable is the table that holds the lines relation/edge.
+	LinesTable = "vendor_bill_lines"
+	// LinesInverseTable is the table name for the VendorBillLine entity.
+	// It exists in this package in order to avoid circular dependency with the "vendorbillline" package.
+	LinesInverseTable = "vendor_bill_lines"
+	// LinesColumn is the table column denoting the lines relation/edge.
+	LinesColumn = "bill_id"
+)
+
+// Columns holds all SQL columns for vendorbill fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldVendorID,
+	FieldBillNumber,
+	FieldVendorReference,
+	FieldBillDate,
+	FieldDueDate,
+	FieldCurrency,
+	FieldSubtotal,
+	FieldTaxAmount,
+	FieldTotalAmount,
+	FieldPaidAmount,
+	FieldDiscountRate,
+	FieldDiscountUntil,
+	FieldDiscountTaken,
+	FieldWithholdingAmount,
+	FieldWithholdingTaken,
+	FieldStatus,
+	FieldAttachments,
+	FieldReferenceType,
+	FieldReferenceID,
+	FieldPoNumber,
+	FieldMatchStatus,
+	FieldMatchedAt,
+	FieldVarianceAcceptedBy,
+	FieldVarianceAcceptedAt,
+	FieldVarianceReason,
+	FieldNotes,
+	FieldCreatedBy,
+	FieldApprovedBy,
+	FieldApprovedAt,
+	FieldScheduledFor,
+	FieldPaidAt,
+	FieldJournalEntryID,
+	FieldMetadata,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// BillNumberValidator is a validator for the "bill_number" field. It is called by the builders before save.
+	BillNumberValidator func(string) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultMetadata holds the default value on creation for the "metadata" field.
+	DefaultMetadata map[string]interface{}
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the VendorBill queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByVendorID orders the results by the vendor_id field.
+func ByVendorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVendorID, opts...).ToFunc()
+}
+
+// ByBillNumber orders the results by the bill_number field.
+func ByBillNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillNumber, opts...).ToFunc()
+}
+
+// ByVendorReference orders the results by the vendor_reference field.
+func ByVendorReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVendorReference, opts...).ToFunc()
+}
+
+// ByBillDate orders the results by the bill_date field.
+func ByBillDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillDate, opts...).ToFunc()
+}
+
+// ByDueDate orders the results by the due_date field.
+func ByDueDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueDate, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// BySubtotal orders the results by the subtotal field.
+func BySubtotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubtotal, opts...).ToFunc()
+}
+
+// ByTaxAmount orders the results by the tax_amount field.
+func ByTaxAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxAmount, opts...).ToFunc()
+}
+
+// ByTotalAmount orders the results by the total_amount field.
+func ByTotalAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalAmount, opts...).ToFunc()
+}
+
+// ByPaidAmount orders the results by the paid_amount field.
+func ByPaidAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaidAmount, opts...).ToFunc()
+}
+
+// ByDiscountRate orders the results by the discount_rate field.
+func ByDiscountRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountRate, opts...).ToFunc()
+}
+
+// ByDiscountUntil orders the results by the discount_until field.
+func ByDiscountUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountUntil, opts...).ToFunc()
+}
+
+// ByDiscountTaken orders the results by the discount_taken field.
+func ByDiscountTaken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountTaken, opts...).ToFunc()
+}
+
+// ByWithholdingAmount orders the results by the withholding_amount field.
+func ByWithholdingAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWithholdingAmount, opts...).ToFunc()
+}
+
+// ByWithholdingTaken orders the results by the withholding_taken field.
+func ByWithholdingTaken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWithholdingTaken, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReferenceType orders the results by the reference_type field.
+func ByReferenceType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferenceType, opts...).ToFunc()
+}
+
+// ByReferenceID orders the results by the reference_id field.
+func ByReferenceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferenceID, opts...).ToFunc()
+}
+
+// ByPoNumber orders the results by the po_number field.
+func ByPoNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPoNumber, opts...).ToFunc()
+}
+
+// ByMatchStatus orders the results by the match_status field.
+func ByMatchStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatchStatus, opts...).ToFunc()
+}
+
+// ByMatchedAt orders the results by the matched_at field.
+func ByMatchedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatchedAt, opts...).ToFunc()
+}
+
+// ByVarianceAcceptedBy orders the results by the variance_accepted_by field.
+func ByVarianceAcceptedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVarianceAcceptedBy, opts...).ToFunc()
+}
+
+// ByVarianceAcceptedAt orders the results by the variance_accepted_at field.
+func ByVarianceAcceptedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVarianceAcceptedAt, opts...).ToFunc()
+}
+
+// ByVarianceReason orders the results by the variance_reason field.
+func ByVarianceReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVarianceReason, opts...).ToFunc()
+}
+
+// ByNotes orders the results by the notes field.
+func ByNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotes, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByApprovedBy orders the results by the approved_by field.
+func ByApprovedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovedBy, opts...).ToFunc()
+}
+
+// ByApprovedAt orders the results by the approved_at field.
+func ByApprovedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovedAt, opts...).ToFunc()
+}
+
+// ByScheduledFor orders the results by the scheduled_for field.
+func ByScheduledFor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScheduledFor, opts...).ToFunc()
+}
+
+// ByPaidAt orders the results by the paid_at field.
+func ByPaidAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaidAt, opts...).ToFunc()
+}
+
+// ByJournalEntryID orders the results by the journal_entry_id field.
+func ByJournalEntryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJournalEntryID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByLinesCount orders the results by lines count.
+func ByLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLinesStep(), opts...)
+	}
+}
+
+// ByLines orders the results by lines terms.
+func ByLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LinesTable, LinesColumn),
+	)
+}
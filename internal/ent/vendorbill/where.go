@@ -0,0 +1,2116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vendorbill
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldTenantID, v))
+}
+
+// VendorID applies equality check predicate on the "vendor_id" field. It's identical to VendorIDEQ.
+func VendorID(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldVendorID, v))
+}
+
+// BillNumber applies equality check predicate on the "bill_number" field. It's identical to BillNumberEQ.
+func BillNumber(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldBillNumber, v))
+}
+
+// VendorReference applies equality check predicate on the "vendor_reference" field. It's identical to VendorReferenceEQ.
+func VendorReference(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldVendorReference, v))
+}
+
+// BillDate applies equality check predicate on the "bill_date" field. It's identical to BillDateEQ.
+func BillDate(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldBillDate, v))
+}
+
+// DueDate applies equality check predicate on the "due_date" field. It's identical to DueDateEQ.
+func DueDate(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldDueDate, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Subtotal applies equality check predicate on the "subtotal" field. It's identical to SubtotalEQ.
+func Subtotal(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldSubtotal, v))
+}
+
+// TaxAmount applies equality check predicate on the "tax_amount" field. It's identical to TaxAmountEQ.
+func TaxAmount(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldTaxAmount, v))
+}
+
+// TotalAmount applies equality check predicate on the "total_amount" field. It's identical to TotalAmountEQ.
+func TotalAmount(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldTotalAmount, v))
+}
+
+// PaidAmount applies equality check predicate on the "paid_amount" field. It's identical to PaidAmountEQ.
+func PaidAmount(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldPaidAmount, v))
+}
+
+// DiscountRate applies equality check predicate on the "discount_rate" field. It's identical to DiscountRateEQ.
+func DiscountRate(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldDiscountRate, v))
+}
+
+// DiscountUntil applies equality check predicate on the "discount_until" field. It's identical to DiscountUntilEQ.
+func DiscountUntil(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldDiscountUntil, v))
+}
+
+// DiscountTaken applies equality check predicate on the "discount_taken" field. It's identical to DiscountTakenEQ.
+func DiscountTaken(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldDiscountTaken, v))
+}
+
+// WithholdingAmount applies equality check predicate on the "withholding_amount" field. It's identical to WithholdingAmountEQ.
+func WithholdingAmount(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldWithholdingAmount, v))
+}
+
+// WithholdingTaken applies equality check predicate on the "withholding_taken" field. It's identical to WithholdingTakenEQ.
+func WithholdingTaken(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldWithholdingTaken, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldStatus, v))
+}
+
+// ReferenceType applies equality check predicate on the "reference_type" field. It's identical to ReferenceTypeEQ.
+func ReferenceType(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldReferenceType, v))
+}
+
+// ReferenceID applies equality check predicate on the "reference_id" field. It's identical to ReferenceIDEQ.
+func ReferenceID(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldReferenceID, v))
+}
+
+// PoNumber applies equality check predicate on the "po_number" field. It's identical to PoNumberEQ.
+func PoNumber(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldPoNumber, v))
+}
+
+// MatchStatus applies equality check predicate on the "match_status" field. It's identical to MatchStatusEQ.
+func MatchStatus(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldMatchStatus, v))
+}
+
+// MatchedAt applies equality check predicate on the "matched_at" field. It's identical to MatchedAtEQ.
+func MatchedAt(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldMatchedAt, v))
+}
+
+// VarianceAcceptedBy applies equality check predicate on the "variance_accepted_by" field. It's identical to VarianceAcceptedByEQ.
+func VarianceAcceptedBy(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldVarianceAcceptedBy, v))
+}
+
+// VarianceAcceptedAt applies equality check predicate on the "variance_accepted_at" field. It's identical to VarianceAcceptedAtEQ.
+func VarianceAcceptedAt(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldVarianceAcceptedAt, v))
+}
+
+// VarianceReason applies equality check predicate on the "variance_reason" field. It's identical to VarianceReasonEQ.
+func VarianceReason(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldVarianceReason, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldNotes, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// ApprovedBy applies equality check predicate on the "approved_by" field. It's identical to ApprovedByEQ.
+func ApprovedBy(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldApprovedBy, v))
+}
+
+// ApprovedAt applies equality check predicate on the "approved_at" field. It's identical to ApprovedAtEQ.
+func ApprovedAt(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// ScheduledFor applies equality check predicate on the "scheduled_for" field. It's identical to ScheduledForEQ.
+func ScheduledFor(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldScheduledFor, v))
+}
+
+// PaidAt applies equality check predicate on the "paid_at" field. It's identical to PaidAtEQ.
+func PaidAt(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldPaidAt, v))
+}
+
+// JournalEntryID applies equality check predicate on the "journal_entry_id" field. It's identical to JournalEntryIDEQ.
+func JournalEntryID(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldTenantID, v))
+}
+
+// VendorIDEQ applies the EQ predicate on the "vendor_id" field.
+func VendorIDEQ(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldVendorID, v))
+}
+
+// VendorIDNEQ applies the NEQ predicate on the "vendor_id" field.
+func VendorIDNEQ(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldVendorID, v))
+}
+
+// VendorIDIn applies the In predicate on the "vendor_id" field.
+func VendorIDIn(vs ...uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldVendorID, vs...))
+}
+
+// VendorIDNotIn applies the NotIn predicate on the "vendor_id" field.
+func VendorIDNotIn(vs ...uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldVendorID, vs...))
+}
+
+// VendorIDGT applies the GT predicate on the "vendor_id" field.
+func VendorIDGT(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldVendorID, v))
+}
+
+// VendorIDGTE applies the GTE predicate on the "vendor_id" field.
+func VendorIDGTE(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldVendorID, v))
+}
+
+// VendorIDLT applies the LT predicate on the "vendor_id" field.
+func VendorIDLT(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldVendorID, v))
+}
+
+// VendorIDLTE applies the LTE predicate on the "vendor_id" field.
+func VendorIDLTE(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldVendorID, v))
+}
+
+// BillNumberEQ applies the EQ predicate on the "bill_number" field.
+func BillNumberEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldBillNumber, v))
+}
+
+// BillNumberNEQ applies the NEQ predicate on the "bill_number" field.
+func BillNumberNEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldBillNumber, v))
+}
+
+// BillNumberIn applies the In predicate on the "bill_number" field.
+func BillNumberIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldBillNumber, vs...))
+}
+
+// BillNumberNotIn applies the NotIn predicate on the "bill_number" field.
+func BillNumberNotIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldBillNumber, vs...))
+}
+
+// BillNumberGT applies the GT predicate on the "bill_number" field.
+func BillNumberGT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldBillNumber, v))
+}
+
+// BillNumberGTE applies the GTE predicate on the "bill_number" field.
+func BillNumberGTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldBillNumber, v))
+}
+
+// BillNumberLT applies the LT predicate on the "bill_number" field.
+func BillNumberLT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldBillNumber, v))
+}
+
+// BillNumberLTE applies the LTE predicate on the "bill_number" field.
+func BillNumberLTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldBillNumber, v))
+}
+
+// BillNumberContains applies the Contains predicate on the "bill_number" field.
+func BillNumberContains(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContains(FieldBillNumber, v))
+}
+
+// BillNumberHasPrefix applies the HasPrefix predicate on the "bill_number" field.
+func BillNumberHasPrefix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasPrefix(FieldBillNumber, v))
+}
+
+// BillNumberHasSuffix applies the HasSuffix predicate on the "bill_number" field.
+func BillNumberHasSuffix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasSuffix(FieldBillNumber, v))
+}
+
+// BillNumberEqualFold applies the EqualFold predicate on the "bill_number" field.
+func BillNumberEqualFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEqualFold(FieldBillNumber, v))
+}
+
+// BillNumberContainsFold applies the ContainsFold predicate on the "bill_number" field.
+func BillNumberContainsFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContainsFold(FieldBillNumber, v))
+}
+
+// VendorReferenceEQ applies the EQ predicate on the "vendor_reference" field.
+func VendorReferenceEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldVendorReference, v))
+}
+
+// VendorReferenceNEQ applies the NEQ predicate on the "vendor_reference" field.
+func VendorReferenceNEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldVendorReference, v))
+}
+
+// VendorReferenceIn applies the In predicate on the "vendor_reference" field.
+func VendorReferenceIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldVendorReference, vs...))
+}
+
+// VendorReferenceNotIn applies the NotIn predicate on the "vendor_reference" field.
+func VendorReferenceNotIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldVendorReference, vs...))
+}
+
+// VendorReferenceGT applies the GT predicate on the "vendor_reference" field.
+func VendorReferenceGT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldVendorReference, v))
+}
+
+// VendorReferenceGTE applies the GTE predicate on the "vendor_reference" field.
+func VendorReferenceGTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldVendorReference, v))
+}
+
+// VendorReferenceLT applies the LT predicate on the "vendor_reference" field.
+func VendorReferenceLT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldVendorReference, v))
+}
+
+// VendorReferenceLTE applies the LTE predicate on the "vendor_reference" field.
+func VendorReferenceLTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldVendorReference, v))
+}
+
+// VendorReferenceContains applies the Contains predicate on the "vendor_reference" field.
+func VendorReferenceContains(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContains(FieldVendorReference, v))
+}
+
+// VendorReferenceHasPrefix applies the HasPrefix predicate on the "vendor_reference" field.
+func VendorReferenceHasPrefix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasPrefix(FieldVendorReference, v))
+}
+
+// VendorReferenceHasSuffix applies the HasSuffix predicate on the "vendor_reference" field.
+func VendorReferenceHasSuffix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasSuffix(FieldVendorReference, v))
+}
+
+// VendorReferenceIsNil applies the IsNil predicate on the "vendor_reference" field.
+func VendorReferenceIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldVendorReference))
+}
+
+// VendorReferenceNotNil applies the NotNil predicate on the "vendor_reference" field.
+func VendorReferenceNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldVendorReference))
+}
+
+// VendorReferenceEqualFold applies the EqualFold predicate on the "vendor_reference" field.
+func VendorReferenceEqualFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEqualFold(FieldVendorReference, v))
+}
+
+// VendorReferenceContainsFold applies the ContainsFold predicate on the "vendor_reference" field.
+func VendorReferenceContainsFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContainsFold(FieldVendorReference, v))
+}
+
+// BillDateEQ applies the EQ predicate on the "bill_date" field.
+func BillDateEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldBillDate, v))
+}
+
+// BillDateNEQ applies the NEQ predicate on the "bill_date" field.
+func BillDateNEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldBillDate, v))
+}
+
+// BillDateIn applies the In predicate on the "bill_date" field.
+func BillDateIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldBillDate, vs...))
+}
+
+// BillDateNotIn applies the NotIn predicate on the "bill_date" field.
+func BillDateNotIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldBillDate, vs...))
+}
+
+// BillDateGT applies the GT predicate on the "bill_date" field.
+func BillDateGT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldBillDate, v))
+}
+
+// BillDateGTE applies the GTE predicate on the "bill_date" field.
+func BillDateGTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldBillDate, v))
+}
+
+// BillDateLT applies the LT predicate on the "bill_date" field.
+func BillDateLT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldBillDate, v))
+}
+
+// BillDateLTE applies the LTE predicate on the "bill_date" field.
+func BillDateLTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldBillDate, v))
+}
+
+// DueDateEQ applies the EQ predicate on the "due_date" field.
+func DueDateEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldDueDate, v))
+}
+
+// DueDateNEQ applies the NEQ predicate on the "due_date" field.
+func DueDateNEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldDueDate, v))
+}
+
+// DueDateIn applies the In predicate on the "due_date" field.
+func DueDateIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldDueDate, vs...))
+}
+
+// DueDateNotIn applies the NotIn predicate on the "due_date" field.
+func DueDateNotIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldDueDate, vs...))
+}
+
+// DueDateGT applies the GT predicate on the "due_date" field.
+func DueDateGT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldDueDate, v))
+}
+
+// DueDateGTE applies the GTE predicate on the "due_date" field.
+func DueDateGTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldDueDate, v))
+}
+
+// DueDateLT applies the LT predicate on the "due_date" field.
+func DueDateLT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldDueDate, v))
+}
+
+// DueDateLTE applies the LTE predicate on the "due_date" field.
+func DueDateLTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldDueDate, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// SubtotalEQ applies the EQ predicate on the "subtotal" field.
+func SubtotalEQ(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldSubtotal, v))
+}
+
+// SubtotalNEQ applies the NEQ predicate on the "subtotal" field.
+func SubtotalNEQ(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldSubtotal, v))
+}
+
+// SubtotalIn applies the In predicate on the "subtotal" field.
+func SubtotalIn(vs ...decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldSubtotal, vs...))
+}
+
+// SubtotalNotIn applies the NotIn predicate on the "subtotal" field.
+func SubtotalNotIn(vs ...decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldSubtotal, vs...))
+}
+
+// SubtotalGT applies the GT predicate on the "subtotal" field.
+func SubtotalGT(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldSubtotal, v))
+}
+
+// SubtotalGTE applies the GTE predicate on the "subtotal" field.
+func SubtotalGTE(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldSubtotal, v))
+}
+
+// SubtotalLT applies the LT predicate on the "subtotal" field.
+func SubtotalLT(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldSubtotal, v))
+}
+
+// SubtotalLTE applies the LTE predicate on the "subtotal" field.
+func SubtotalLTE(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldSubtotal, v))
+}
+
+// TaxAmountEQ applies the EQ predicate on the "tax_amount" field.
+func TaxAmountEQ(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldTaxAmount, v))
+}
+
+// TaxAmountNEQ applies the NEQ predicate on the "tax_amount" field.
+func TaxAmountNEQ(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldTaxAmount, v))
+}
+
+// TaxAmountIn applies the In predicate on the "tax_amount" field.
+func TaxAmountIn(vs ...decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldTaxAmount, vs...))
+}
+
+// TaxAmountNotIn applies the NotIn predicate on the "tax_amount" field.
+func TaxAmountNotIn(vs ...decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldTaxAmount, vs...))
+}
+
+// TaxAmountGT applies the GT predicate on the "tax_amount" field.
+func TaxAmountGT(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldTaxAmount, v))
+}
+
+// TaxAmountGTE applies the GTE predicate on the "tax_amount" field.
+func TaxAmountGTE(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldTaxAmount, v))
+}
+
+// TaxAmountLT applies the LT predicate on the "tax_amount" field.
+func TaxAmountLT(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldTaxAmount, v))
+}
+
+// TaxAmountLTE applies the LTE predicate on the "tax_amount" field.
+func TaxAmountLTE(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldTaxAmount, v))
+}
+
+// TaxAmountIsNil applies the IsNil predicate on the "tax_amount" field.
+func TaxAmountIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldTaxAmount))
+}
+
+// TaxAmountNotNil applies the NotNil predicate on the "tax_amount" field.
+func TaxAmountNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldTaxAmount))
+}
+
+// TotalAmountEQ applies the EQ predicate on the "total_amount" field.
+func TotalAmountEQ(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldTotalAmount, v))
+}
+
+// TotalAmountNEQ applies the NEQ predicate on the "total_amount" field.
+func TotalAmountNEQ(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldTotalAmount, v))
+}
+
+// TotalAmountIn applies the In predicate on the "total_amount" field.
+func TotalAmountIn(vs ...decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldTotalAmount, vs...))
+}
+
+// TotalAmountNotIn applies the NotIn predicate on the "total_amount" field.
+func TotalAmountNotIn(vs ...decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldTotalAmount, vs...))
+}
+
+// TotalAmountGT applies the GT predicate on the "total_amount" field.
+func TotalAmountGT(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldTotalAmount, v))
+}
+
+// TotalAmountGTE applies the GTE predicate on the "total_amount" field.
+func TotalAmountGTE(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldTotalAmount, v))
+}
+
+// TotalAmountLT applies the LT predicate on the "total_amount" field.
+func TotalAmountLT(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldTotalAmount, v))
+}
+
+// TotalAmountLTE applies the LTE predicate on the "total_amount" field.
+func TotalAmountLTE(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldTotalAmount, v))
+}
+
+// PaidAmountEQ applies the EQ predicate on the "paid_amount" field.
+func PaidAmountEQ(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldPaidAmount, v))
+}
+
+// PaidAmountNEQ applies the NEQ predicate on the "paid_amount" field.
+func PaidAmountNEQ(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldPaidAmount, v))
+}
+
+// PaidAmountIn applies the In predicate on the "paid_amount" field.
+func PaidAmountIn(vs ...decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldPaidAmount, vs...))
+}
+
+// PaidAmountNotIn applies the NotIn predicate on the "paid_amount" field.
+func PaidAmountNotIn(vs ...decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldPaidAmount, vs...))
+}
+
+// PaidAmountGT applies the GT predicate on the "paid_amount" field.
+func PaidAmountGT(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldPaidAmount, v))
+}
+
+// PaidAmountGTE applies the GTE predicate on the "paid_amount" field.
+func PaidAmountGTE(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldPaidAmount, v))
+}
+
+// PaidAmountLT applies the LT predicate on the "paid_amount" field.
+func PaidAmountLT(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldPaidAmount, v))
+}
+
+// PaidAmountLTE applies the LTE predicate on the "paid_amount" field.
+func PaidAmountLTE(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldPaidAmount, v))
+}
+
+// PaidAmountIsNil applies the IsNil predicate on the "paid_amount" field.
+func PaidAmountIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldPaidAmount))
+}
+
+// PaidAmountNotNil applies the NotNil predicate on the "paid_amount" field.
+func PaidAmountNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldPaidAmount))
+}
+
+// DiscountRateEQ applies the EQ predicate on the "discount_rate" field.
+func DiscountRateEQ(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldDiscountRate, v))
+}
+
+// DiscountRateNEQ applies the NEQ predicate on the "discount_rate" field.
+func DiscountRateNEQ(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldDiscountRate, v))
+}
+
+// DiscountRateIn applies the In predicate on the "discount_rate" field.
+func DiscountRateIn(vs ...decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldDiscountRate, vs...))
+}
+
+// DiscountRateNotIn applies the NotIn predicate on the "discount_rate" field.
+func DiscountRateNotIn(vs ...decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldDiscountRate, vs...))
+}
+
+// DiscountRateGT applies the GT predicate on the "discount_rate" field.
+func DiscountRateGT(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldDiscountRate, v))
+}
+
+// DiscountRateGTE applies the GTE predicate on the "discount_rate" field.
+func DiscountRateGTE(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldDiscountRate, v))
+}
+
+// DiscountRateLT applies the LT predicate on the "discount_rate" field.
+func DiscountRateLT(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldDiscountRate, v))
+}
+
+// DiscountRateLTE applies the LTE predicate on the "discount_rate" field.
+func DiscountRateLTE(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldDiscountRate, v))
+}
+
+// DiscountRateIsNil applies the IsNil predicate on the "discount_rate" field.
+func DiscountRateIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldDiscountRate))
+}
+
+// DiscountRateNotNil applies the NotNil predicate on the "discount_rate" field.
+func DiscountRateNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldDiscountRate))
+}
+
+// DiscountUntilEQ applies the EQ predicate on the "discount_until" field.
+func DiscountUntilEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldDiscountUntil, v))
+}
+
+// DiscountUntilNEQ applies the NEQ predicate on the "discount_until" field.
+func DiscountUntilNEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldDiscountUntil, v))
+}
+
+// DiscountUntilIn applies the In predicate on the "discount_until" field.
+func DiscountUntilIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldDiscountUntil, vs...))
+}
+
+// DiscountUntilNotIn applies the NotIn predicate on the "discount_until" field.
+func DiscountUntilNotIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldDiscountUntil, vs...))
+}
+
+// DiscountUntilGT applies the GT predicate on the "discount_until" field.
+func DiscountUntilGT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldDiscountUntil, v))
+}
+
+// DiscountUntilGTE applies the GTE predicate on the "discount_until" field.
+func DiscountUntilGTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldDiscountUntil, v))
+}
+
+// DiscountUntilLT applies the LT predicate on the "discount_until" field.
+func DiscountUntilLT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldDiscountUntil, v))
+}
+
+// DiscountUntilLTE applies the LTE predicate on the "discount_until" field.
+func DiscountUntilLTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldDiscountUntil, v))
+}
+
+// DiscountUntilIsNil applies the IsNil predicate on the "discount_until" field.
+func DiscountUntilIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldDiscountUntil))
+}
+
+// DiscountUntilNotNil applies the NotNil predicate on the "discount_until" field.
+func DiscountUntilNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldDiscountUntil))
+}
+
+// DiscountTakenEQ applies the EQ predicate on the "discount_taken" field.
+func DiscountTakenEQ(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldDiscountTaken, v))
+}
+
+// DiscountTakenNEQ applies the NEQ predicate on the "discount_taken" field.
+func DiscountTakenNEQ(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldDiscountTaken, v))
+}
+
+// DiscountTakenIn applies the In predicate on the "discount_taken" field.
+func DiscountTakenIn(vs ...decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldDiscountTaken, vs...))
+}
+
+// DiscountTakenNotIn applies the NotIn predicate on the "discount_taken" field.
+func DiscountTakenNotIn(vs ...decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldDiscountTaken, vs...))
+}
+
+// DiscountTakenGT applies the GT predicate on the "discount_taken" field.
+func DiscountTakenGT(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldDiscountTaken, v))
+}
+
+// DiscountTakenGTE applies the GTE predicate on the "discount_taken" field.
+func DiscountTakenGTE(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldDiscountTaken, v))
+}
+
+// DiscountTakenLT applies the LT predicate on the "discount_taken" field.
+func DiscountTakenLT(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldDiscountTaken, v))
+}
+
+// DiscountTakenLTE applies the LTE predicate on the "discount_taken" field.
+func DiscountTakenLTE(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldDiscountTaken, v))
+}
+
+// DiscountTakenIsNil applies the IsNil predicate on the "discount_taken" field.
+func DiscountTakenIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldDiscountTaken))
+}
+
+// DiscountTakenNotNil applies the NotNil predicate on the "discount_taken" field.
+func DiscountTakenNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldDiscountTaken))
+}
+
+// WithholdingAmountEQ applies the EQ predicate on the "withholding_amount" field.
+func WithholdingAmountEQ(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountNEQ applies the NEQ predicate on the "withholding_amount" field.
+func WithholdingAmountNEQ(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountIn applies the In predicate on the "withholding_amount" field.
+func WithholdingAmountIn(vs ...decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldWithholdingAmount, vs...))
+}
+
+// WithholdingAmountNotIn applies the NotIn predicate on the "withholding_amount" field.
+func WithholdingAmountNotIn(vs ...decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldWithholdingAmount, vs...))
+}
+
+// WithholdingAmountGT applies the GT predicate on the "withholding_amount" field.
+func WithholdingAmountGT(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountGTE applies the GTE predicate on the "withholding_amount" field.
+func WithholdingAmountGTE(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountLT applies the LT predicate on the "withholding_amount" field.
+func WithholdingAmountLT(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountLTE applies the LTE predicate on the "withholding_amount" field.
+func WithholdingAmountLTE(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountIsNil applies the IsNil predicate on the "withholding_amount" field.
+func WithholdingAmountIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldWithholdingAmount))
+}
+
+// WithholdingAmountNotNil applies the NotNil predicate on the "withholding_amount" field.
+func WithholdingAmountNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldWithholdingAmount))
+}
+
+// WithholdingTakenEQ applies the EQ predicate on the "withholding_taken" field.
+func WithholdingTakenEQ(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldWithholdingTaken, v))
+}
+
+// WithholdingTakenNEQ applies the NEQ predicate on the "withholding_taken" field.
+func WithholdingTakenNEQ(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldWithholdingTaken, v))
+}
+
+// WithholdingTakenIn applies the In predicate on the "withholding_taken" field.
+func WithholdingTakenIn(vs ...decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldWithholdingTaken, vs...))
+}
+
+// WithholdingTakenNotIn applies the NotIn predicate on the "withholding_taken" field.
+func WithholdingTakenNotIn(vs ...decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldWithholdingTaken, vs...))
+}
+
+// WithholdingTakenGT applies the GT predicate on the "withholding_taken" field.
+func WithholdingTakenGT(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldWithholdingTaken, v))
+}
+
+// WithholdingTakenGTE applies the GTE predicate on the "withholding_taken" field.
+func WithholdingTakenGTE(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldWithholdingTaken, v))
+}
+
+// WithholdingTakenLT applies the LT predicate on the "withholding_taken" field.
+func WithholdingTakenLT(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldWithholdingTaken, v))
+}
+
+// WithholdingTakenLTE applies the LTE predicate on the "withholding_taken" field.
+func WithholdingTakenLTE(v decimal.Decimal) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldWithholdingTaken, v))
+}
+
+// WithholdingTakenIsNil applies the IsNil predicate on the "withholding_taken" field.
+func WithholdingTakenIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldWithholdingTaken))
+}
+
+// WithholdingTakenNotNil applies the NotNil predicate on the "withholding_taken" field.
+func WithholdingTakenNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldWithholdingTaken))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// AttachmentsIsNil applies the IsNil predicate on the "attachments" field.
+func AttachmentsIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldAttachments))
+}
+
+// AttachmentsNotNil applies the NotNil predicate on the "attachments" field.
+func AttachmentsNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldAttachments))
+}
+
+// ReferenceTypeEQ applies the EQ predicate on the "reference_type" field.
+func ReferenceTypeEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldReferenceType, v))
+}
+
+// ReferenceTypeNEQ applies the NEQ predicate on the "reference_type" field.
+func ReferenceTypeNEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldReferenceType, v))
+}
+
+// ReferenceTypeIn applies the In predicate on the "reference_type" field.
+func ReferenceTypeIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldReferenceType, vs...))
+}
+
+// ReferenceTypeNotIn applies the NotIn predicate on the "reference_type" field.
+func ReferenceTypeNotIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldReferenceType, vs...))
+}
+
+// ReferenceTypeGT applies the GT predicate on the "reference_type" field.
+func ReferenceTypeGT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldReferenceType, v))
+}
+
+// ReferenceTypeGTE applies the GTE predicate on the "reference_type" field.
+func ReferenceTypeGTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldReferenceType, v))
+}
+
+// ReferenceTypeLT applies the LT predicate on the "reference_type" field.
+func ReferenceTypeLT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldReferenceType, v))
+}
+
+// ReferenceTypeLTE applies the LTE predicate on the "reference_type" field.
+func ReferenceTypeLTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldReferenceType, v))
+}
+
+// ReferenceTypeContains applies the Contains predicate on the "reference_type" field.
+func ReferenceTypeContains(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContains(FieldReferenceType, v))
+}
+
+// ReferenceTypeHasPrefix applies the HasPrefix predicate on the "reference_type" field.
+func ReferenceTypeHasPrefix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasPrefix(FieldReferenceType, v))
+}
+
+// ReferenceTypeHasSuffix applies the HasSuffix predicate on the "reference_type" field.
+func ReferenceTypeHasSuffix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasSuffix(FieldReferenceType, v))
+}
+
+// ReferenceTypeIsNil applies the IsNil predicate on the "reference_type" field.
+func ReferenceTypeIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldReferenceType))
+}
+
+// ReferenceTypeNotNil applies the NotNil predicate on the "reference_type" field.
+func ReferenceTypeNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldReferenceType))
+}
+
+// ReferenceTypeEqualFold applies the EqualFold predicate on the "reference_type" field.
+func ReferenceTypeEqualFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEqualFold(FieldReferenceType, v))
+}
+
+// ReferenceTypeContainsFold applies the ContainsFold predicate on the "reference_type" field.
+func ReferenceTypeContainsFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContainsFold(FieldReferenceType, v))
+}
+
+// ReferenceIDEQ applies the EQ predicate on the "reference_id" field.
+func ReferenceIDEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldReferenceID, v))
+}
+
+// ReferenceIDNEQ applies the NEQ predicate on the "reference_id" field.
+func ReferenceIDNEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldReferenceID, v))
+}
+
+// ReferenceIDIn applies the In predicate on the "reference_id" field.
+func ReferenceIDIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldReferenceID, vs...))
+}
+
+// ReferenceIDNotIn applies the NotIn predicate on the "reference_id" field.
+func ReferenceIDNotIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldReferenceID, vs...))
+}
+
+// ReferenceIDGT applies the GT predicate on the "reference_id" field.
+func ReferenceIDGT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldReferenceID, v))
+}
+
+// ReferenceIDGTE applies the GTE predicate on the "reference_id" field.
+func ReferenceIDGTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldReferenceID, v))
+}
+
+// ReferenceIDLT applies the LT predicate on the "reference_id" field.
+func ReferenceIDLT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldReferenceID, v))
+}
+
+// ReferenceIDLTE applies the LTE predicate on the "reference_id" field.
+func ReferenceIDLTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldReferenceID, v))
+}
+
+// ReferenceIDContains applies the Contains predicate on the "reference_id" field.
+func ReferenceIDContains(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContains(FieldReferenceID, v))
+}
+
+// ReferenceIDHasPrefix applies the HasPrefix predicate on the "reference_id" field.
+func ReferenceIDHasPrefix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasPrefix(FieldReferenceID, v))
+}
+
+// ReferenceIDHasSuffix applies the HasSuffix predicate on the "reference_id" field.
+func ReferenceIDHasSuffix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasSuffix(FieldReferenceID, v))
+}
+
+// ReferenceIDIsNil applies the IsNil predicate on the "reference_id" field.
+func ReferenceIDIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldReferenceID))
+}
+
+// ReferenceIDNotNil applies the NotNil predicate on the "reference_id" field.
+func ReferenceIDNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldReferenceID))
+}
+
+// ReferenceIDEqualFold applies the EqualFold predicate on the "reference_id" field.
+func ReferenceIDEqualFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEqualFold(FieldReferenceID, v))
+}
+
+// ReferenceIDContainsFold applies the ContainsFold predicate on the "reference_id" field.
+func ReferenceIDContainsFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContainsFold(FieldReferenceID, v))
+}
+
+// PoNumberEQ applies the EQ predicate on the "po_number" field.
+func PoNumberEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldPoNumber, v))
+}
+
+// PoNumberNEQ applies the NEQ predicate on the "po_number" field.
+func PoNumberNEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldPoNumber, v))
+}
+
+// PoNumberIn applies the In predicate on the "po_number" field.
+func PoNumberIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldPoNumber, vs...))
+}
+
+// PoNumberNotIn applies the NotIn predicate on the "po_number" field.
+func PoNumberNotIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldPoNumber, vs...))
+}
+
+// PoNumberGT applies the GT predicate on the "po_number" field.
+func PoNumberGT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldPoNumber, v))
+}
+
+// PoNumberGTE applies the GTE predicate on the "po_number" field.
+func PoNumberGTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldPoNumber, v))
+}
+
+// PoNumberLT applies the LT predicate on the "po_number" field.
+func PoNumberLT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldPoNumber, v))
+}
+
+// PoNumberLTE applies the LTE predicate on the "po_number" field.
+func PoNumberLTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldPoNumber, v))
+}
+
+// PoNumberContains applies the Contains predicate on the "po_number" field.
+func PoNumberContains(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContains(FieldPoNumber, v))
+}
+
+// PoNumberHasPrefix applies the HasPrefix predicate on the "po_number" field.
+func PoNumberHasPrefix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasPrefix(FieldPoNumber, v))
+}
+
+// PoNumberHasSuffix applies the HasSuffix predicate on the "po_number" field.
+func PoNumberHasSuffix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasSuffix(FieldPoNumber, v))
+}
+
+// PoNumberIsNil applies the IsNil predicate on the "po_number" field.
+func PoNumberIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldPoNumber))
+}
+
+// PoNumberNotNil applies the NotNil predicate on the "po_number" field.
+func PoNumberNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldPoNumber))
+}
+
+// PoNumberEqualFold applies the EqualFold predicate on the "po_number" field.
+func PoNumberEqualFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEqualFold(FieldPoNumber, v))
+}
+
+// PoNumberContainsFold applies the ContainsFold predicate on the "po_number" field.
+func PoNumberContainsFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContainsFold(FieldPoNumber, v))
+}
+
+// MatchStatusEQ applies the EQ predicate on the "match_status" field.
+func MatchStatusEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldMatchStatus, v))
+}
+
+// MatchStatusNEQ applies the NEQ predicate on the "match_status" field.
+func MatchStatusNEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldMatchStatus, v))
+}
+
+// MatchStatusIn applies the In predicate on the "match_status" field.
+func MatchStatusIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldMatchStatus, vs...))
+}
+
+// MatchStatusNotIn applies the NotIn predicate on the "match_status" field.
+func MatchStatusNotIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldMatchStatus, vs...))
+}
+
+// MatchStatusGT applies the GT predicate on the "match_status" field.
+func MatchStatusGT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldMatchStatus, v))
+}
+
+// MatchStatusGTE applies the GTE predicate on the "match_status" field.
+func MatchStatusGTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldMatchStatus, v))
+}
+
+// MatchStatusLT applies the LT predicate on the "match_status" field.
+func MatchStatusLT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldMatchStatus, v))
+}
+
+// MatchStatusLTE applies the LTE predicate on the "match_status" field.
+func MatchStatusLTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldMatchStatus, v))
+}
+
+// MatchStatusContains applies the Contains predicate on the "match_status" field.
+func MatchStatusContains(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContains(FieldMatchStatus, v))
+}
+
+// MatchStatusHasPrefix applies the HasPrefix predicate on the "match_status" field.
+func MatchStatusHasPrefix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasPrefix(FieldMatchStatus, v))
+}
+
+// MatchStatusHasSuffix applies the HasSuffix predicate on the "match_status" field.
+func MatchStatusHasSuffix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasSuffix(FieldMatchStatus, v))
+}
+
+// MatchStatusIsNil applies the IsNil predicate on the "match_status" field.
+func MatchStatusIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldMatchStatus))
+}
+
+// MatchStatusNotNil applies the NotNil predicate on the "match_status" field.
+func MatchStatusNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldMatchStatus))
+}
+
+// MatchStatusEqualFold applies the EqualFold predicate on the "match_status" field.
+func MatchStatusEqualFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEqualFold(FieldMatchStatus, v))
+}
+
+// MatchStatusContainsFold applies the ContainsFold predicate on the "match_status" field.
+func MatchStatusContainsFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContainsFold(FieldMatchStatus, v))
+}
+
+// MatchedAtEQ applies the EQ predicate on the "matched_at" field.
+func MatchedAtEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldMatchedAt, v))
+}
+
+// MatchedAtNEQ applies the NEQ predicate on the "matched_at" field.
+func MatchedAtNEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldMatchedAt, v))
+}
+
+// MatchedAtIn applies the In predicate on the "matched_at" field.
+func MatchedAtIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldMatchedAt, vs...))
+}
+
+// MatchedAtNotIn applies the NotIn predicate on the "matched_at" field.
+func MatchedAtNotIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldMatchedAt, vs...))
+}
+
+// MatchedAtGT applies the GT predicate on the "matched_at" field.
+func MatchedAtGT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldMatchedAt, v))
+}
+
+// MatchedAtGTE applies the GTE predicate on the "matched_at" field.
+func MatchedAtGTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldMatchedAt, v))
+}
+
+// MatchedAtLT applies the LT predicate on the "matched_at" field.
+func MatchedAtLT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldMatchedAt, v))
+}
+
+// MatchedAtLTE applies the LTE predicate on the "matched_at" field.
+func MatchedAtLTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldMatchedAt, v))
+}
+
+// MatchedAtIsNil applies the IsNil predicate on the "matched_at" field.
+func MatchedAtIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldMatchedAt))
+}
+
+// MatchedAtNotNil applies the NotNil predicate on the "matched_at" field.
+func MatchedAtNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldMatchedAt))
+}
+
+// VarianceAcceptedByEQ applies the EQ predicate on the "variance_accepted_by" field.
+func VarianceAcceptedByEQ(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldVarianceAcceptedBy, v))
+}
+
+// VarianceAcceptedByNEQ applies the NEQ predicate on the "variance_accepted_by" field.
+func VarianceAcceptedByNEQ(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldVarianceAcceptedBy, v))
+}
+
+// VarianceAcceptedByIn applies the In predicate on the "variance_accepted_by" field.
+func VarianceAcceptedByIn(vs ...uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldVarianceAcceptedBy, vs...))
+}
+
+// VarianceAcceptedByNotIn applies the NotIn predicate on the "variance_accepted_by" field.
+func VarianceAcceptedByNotIn(vs ...uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldVarianceAcceptedBy, vs...))
+}
+
+// VarianceAcceptedByGT applies the GT predicate on the "variance_accepted_by" field.
+func VarianceAcceptedByGT(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldVarianceAcceptedBy, v))
+}
+
+// VarianceAcceptedByGTE applies the GTE predicate on the "variance_accepted_by" field.
+func VarianceAcceptedByGTE(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldVarianceAcceptedBy, v))
+}
+
+// VarianceAcceptedByLT applies the LT predicate on the "variance_accepted_by" field.
+func VarianceAcceptedByLT(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldVarianceAcceptedBy, v))
+}
+
+// VarianceAcceptedByLTE applies the LTE predicate on the "variance_accepted_by" field.
+func VarianceAcceptedByLTE(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldVarianceAcceptedBy, v))
+}
+
+// VarianceAcceptedByIsNil applies the IsNil predicate on the "variance_accepted_by" field.
+func VarianceAcceptedByIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldVarianceAcceptedBy))
+}
+
+// VarianceAcceptedByNotNil applies the NotNil predicate on the "variance_accepted_by" field.
+func VarianceAcceptedByNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldVarianceAcceptedBy))
+}
+
+// VarianceAcceptedAtEQ applies the EQ predicate on the "variance_accepted_at" field.
+func VarianceAcceptedAtEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldVarianceAcceptedAt, v))
+}
+
+// VarianceAcceptedAtNEQ applies the NEQ predicate on the "variance_accepted_at" field.
+func VarianceAcceptedAtNEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldVarianceAcceptedAt, v))
+}
+
+// VarianceAcceptedAtIn applies the In predicate on the "variance_accepted_at" field.
+func VarianceAcceptedAtIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldVarianceAcceptedAt, vs...))
+}
+
+// VarianceAcceptedAtNotIn applies the NotIn predicate on the "variance_accepted_at" field.
+func VarianceAcceptedAtNotIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldVarianceAcceptedAt, vs...))
+}
+
+// VarianceAcceptedAtGT applies the GT predicate on the "variance_accepted_at" field.
+func VarianceAcceptedAtGT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldVarianceAcceptedAt, v))
+}
+
+// VarianceAcceptedAtGTE applies the GTE predicate on the "variance_accepted_at" field.
+func VarianceAcceptedAtGTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldVarianceAcceptedAt, v))
+}
+
+// VarianceAcceptedAtLT applies the LT predicate on the "variance_accepted_at" field.
+func VarianceAcceptedAtLT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldVarianceAcceptedAt, v))
+}
+
+// VarianceAcceptedAtLTE applies the LTE predicate on the "variance_accepted_at" field.
+func VarianceAcceptedAtLTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldVarianceAcceptedAt, v))
+}
+
+// VarianceAcceptedAtIsNil applies the IsNil predicate on the "variance_accepted_at" field.
+func VarianceAcceptedAtIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldVarianceAcceptedAt))
+}
+
+// VarianceAcceptedAtNotNil applies the NotNil predicate on the "variance_accepted_at" field.
+func VarianceAcceptedAtNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldVarianceAcceptedAt))
+}
+
+// VarianceReasonEQ applies the EQ predicate on the "variance_reason" field.
+func VarianceReasonEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldVarianceReason, v))
+}
+
+// VarianceReasonNEQ applies the NEQ predicate on the "variance_reason" field.
+func VarianceReasonNEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldVarianceReason, v))
+}
+
+// VarianceReasonIn applies the In predicate on the "variance_reason" field.
+func VarianceReasonIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldVarianceReason, vs...))
+}
+
+// VarianceReasonNotIn applies the NotIn predicate on the "variance_reason" field.
+func VarianceReasonNotIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldVarianceReason, vs...))
+}
+
+// VarianceReasonGT applies the GT predicate on the "variance_reason" field.
+func VarianceReasonGT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldVarianceReason, v))
+}
+
+// VarianceReasonGTE applies the GTE predicate on the "variance_reason" field.
+func VarianceReasonGTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldVarianceReason, v))
+}
+
+// VarianceReasonLT applies the LT predicate on the "variance_reason" field.
+func VarianceReasonLT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldVarianceReason, v))
+}
+
+// VarianceReasonLTE applies the LTE predicate on the "variance_reason" field.
+func VarianceReasonLTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldVarianceReason, v))
+}
+
+// VarianceReasonContains applies the Contains predicate on the "variance_reason" field.
+func VarianceReasonContains(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContains(FieldVarianceReason, v))
+}
+
+// VarianceReasonHasPrefix applies the HasPrefix predicate on the "variance_reason" field.
+func VarianceReasonHasPrefix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasPrefix(FieldVarianceReason, v))
+}
+
+// VarianceReasonHasSuffix applies the HasSuffix predicate on the "variance_reason" field.
+func VarianceReasonHasSuffix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasSuffix(FieldVarianceReason, v))
+}
+
+// VarianceReasonIsNil applies the IsNil predicate on the "variance_reason" field.
+func VarianceReasonIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldVarianceReason))
+}
+
+// VarianceReasonNotNil applies the NotNil predicate on the "variance_reason" field.
+func VarianceReasonNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldVarianceReason))
+}
+
+// VarianceReasonEqualFold applies the EqualFold predicate on the "variance_reason" field.
+func VarianceReasonEqualFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEqualFold(FieldVarianceReason, v))
+}
+
+// VarianceReasonContainsFold applies the ContainsFold predicate on the "variance_reason" field.
+func VarianceReasonContainsFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContainsFold(FieldVarianceReason, v))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldNotes))
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldNotes))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// ApprovedByEQ applies the EQ predicate on the "approved_by" field.
+func ApprovedByEQ(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldApprovedBy, v))
+}
+
+// ApprovedByNEQ applies the NEQ predicate on the "approved_by" field.
+func ApprovedByNEQ(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldApprovedBy, v))
+}
+
+// ApprovedByIn applies the In predicate on the "approved_by" field.
+func ApprovedByIn(vs ...uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldApprovedBy, vs...))
+}
+
+// ApprovedByNotIn applies the NotIn predicate on the "approved_by" field.
+func ApprovedByNotIn(vs ...uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldApprovedBy, vs...))
+}
+
+// ApprovedByGT applies the GT predicate on the "approved_by" field.
+func ApprovedByGT(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldApprovedBy, v))
+}
+
+// ApprovedByGTE applies the GTE predicate on the "approved_by" field.
+func ApprovedByGTE(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldApprovedBy, v))
+}
+
+// ApprovedByLT applies the LT predicate on the "approved_by" field.
+func ApprovedByLT(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldApprovedBy, v))
+}
+
+// ApprovedByLTE applies the LTE predicate on the "approved_by" field.
+func ApprovedByLTE(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldApprovedBy, v))
+}
+
+// ApprovedByIsNil applies the IsNil predicate on the "approved_by" field.
+func ApprovedByIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldApprovedBy))
+}
+
+// ApprovedByNotNil applies the NotNil predicate on the "approved_by" field.
+func ApprovedByNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldApprovedBy))
+}
+
+// ApprovedAtEQ applies the EQ predicate on the "approved_at" field.
+func ApprovedAtEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtNEQ applies the NEQ predicate on the "approved_at" field.
+func ApprovedAtNEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtIn applies the In predicate on the "approved_at" field.
+func ApprovedAtIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtNotIn applies the NotIn predicate on the "approved_at" field.
+func ApprovedAtNotIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtGT applies the GT predicate on the "approved_at" field.
+func ApprovedAtGT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldApprovedAt, v))
+}
+
+// ApprovedAtGTE applies the GTE predicate on the "approved_at" field.
+func ApprovedAtGTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtLT applies the LT predicate on the "approved_at" field.
+func ApprovedAtLT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldApprovedAt, v))
+}
+
+// ApprovedAtLTE applies the LTE predicate on the "approved_at" field.
+func ApprovedAtLTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtIsNil applies the IsNil predicate on the "approved_at" field.
+func ApprovedAtIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldApprovedAt))
+}
+
+// ApprovedAtNotNil applies the NotNil predicate on the "approved_at" field.
+func ApprovedAtNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldApprovedAt))
+}
+
+// ScheduledForEQ applies the EQ predicate on the "scheduled_for" field.
+func ScheduledForEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldScheduledFor, v))
+}
+
+// ScheduledForNEQ applies the NEQ predicate on the "scheduled_for" field.
+func ScheduledForNEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldScheduledFor, v))
+}
+
+// ScheduledForIn applies the In predicate on the "scheduled_for" field.
+func ScheduledForIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldScheduledFor, vs...))
+}
+
+// ScheduledForNotIn applies the NotIn predicate on the "scheduled_for" field.
+func ScheduledForNotIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldScheduledFor, vs...))
+}
+
+// ScheduledForGT applies the GT predicate on the "scheduled_for" field.
+func ScheduledForGT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldScheduledFor, v))
+}
+
+// ScheduledForGTE applies the GTE predicate on the "scheduled_for" field.
+func ScheduledForGTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldScheduledFor, v))
+}
+
+// ScheduledForLT applies the LT predicate on the "scheduled_for" field.
+func ScheduledForLT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldScheduledFor, v))
+}
+
+// ScheduledForLTE applies the LTE predicate on the "scheduled_for" field.
+func ScheduledForLTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldScheduledFor, v))
+}
+
+// ScheduledForIsNil applies the IsNil predicate on the "scheduled_for" field.
+func ScheduledForIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldScheduledFor))
+}
+
+// ScheduledForNotNil applies the NotNil predicate on the "scheduled_for" field.
+func ScheduledForNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldScheduledFor))
+}
+
+// PaidAtEQ applies the EQ predicate on the "paid_at" field.
+func PaidAtEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldPaidAt, v))
+}
+
+// PaidAtNEQ applies the NEQ predicate on the "paid_at" field.
+func PaidAtNEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldPaidAt, v))
+}
+
+// PaidAtIn applies the In predicate on the "paid_at" field.
+func PaidAtIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldPaidAt, vs...))
+}
+
+// PaidAtNotIn applies the NotIn predicate on the "paid_at" field.
+func PaidAtNotIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldPaidAt, vs...))
+}
+
+// PaidAtGT applies the GT predicate on the "paid_at" field.
+func PaidAtGT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldPaidAt, v))
+}
+
+// PaidAtGTE applies the GTE predicate on the "paid_at" field.
+func PaidAtGTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldPaidAt, v))
+}
+
+// PaidAtLT applies the LT predicate on the "paid_at" field.
+func PaidAtLT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldPaidAt, v))
+}
+
+// PaidAtLTE applies the LTE predicate on the "paid_at" field.
+func PaidAtLTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldPaidAt, v))
+}
+
+// PaidAtIsNil applies the IsNil predicate on the "paid_at" field.
+func PaidAtIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldPaidAt))
+}
+
+// PaidAtNotNil applies the NotNil predicate on the "paid_at" field.
+func PaidAtNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldPaidAt))
+}
+
+// JournalEntryIDEQ applies the EQ predicate on the "journal_entry_id" field.
+func JournalEntryIDEQ(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDNEQ applies the NEQ predicate on the "journal_entry_id" field.
+func JournalEntryIDNEQ(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIn applies the In predicate on the "journal_entry_id" field.
+func JournalEntryIDIn(vs ...uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDNotIn applies the NotIn predicate on the "journal_entry_id" field.
+func JournalEntryIDNotIn(vs ...uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDGT applies the GT predicate on the "journal_entry_id" field.
+func JournalEntryIDGT(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDGTE applies the GTE predicate on the "journal_entry_id" field.
+func JournalEntryIDGTE(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDLT applies the LT predicate on the "journal_entry_id" field.
+func JournalEntryIDLT(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDLTE applies the LTE predicate on the "journal_entry_id" field.
+func JournalEntryIDLTE(v uuid.UUID) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIsNil applies the IsNil predicate on the "journal_entry_id" field.
+func JournalEntryIDIsNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIsNull(FieldJournalEntryID))
+}
+
+// JournalEntryIDNotNil applies the NotNil predicate on the "journal_entry_id" field.
+func JournalEntryIDNotNil() predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotNull(FieldJournalEntryID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.VendorBill {
+	return predicate.VendorBill(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasLines applies the HasEdge predicate on the "lines" edge.
+func HasLines() predicate.VendorBill {
+	return predicate.VendorBill(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LinesTable, LinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinesWith applies the HasEdge predicate on the "lines" edge with a given conditions (other predicates).
+func HasLinesWith(preds ...predicate.VendorBillLine) predicate.VendorBill {
+	return predicate.VendorBill(func(s *sql.Selector) {
+		step := newLinesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VendorBill) predicate.VendorBill {
+	return predicate.VendorBill(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VendorBill) predicate.VendorBill {
+	return predicate.VendorBill(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VendorBill) predicate.VendorBill {
+	return predicate.VendorBill(sql.NotPredicates(p))
+}
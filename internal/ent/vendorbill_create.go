@@ -0,0 +1,3138 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbill"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbillline"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// VendorBillCreate is the builder for creating a VendorBill entity.
+type VendorBillCreate struct {
+	config
+	mutation *VendorBillMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *VendorBillCreate) SetTenantID(v uuid.UUID) *VendorBillCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_c *VendorBillCreate) SetVendorID(v uuid.UUID) *VendorBillCreate {
+	_c.mutation.SetVendorID(v)
+	return _c
+}
+
+// SetBillNumber sets the "bill_number" field.
+func (_c *VendorBillCreate) SetBillNumber(v string) *VendorBillCreate {
+	_c.mutation.SetBillNumber(v)
+	return _c
+}
+
+// SetVendorReference sets the "vendor_reference" field.
+func (_c *VendorBillCreate) SetVendorReference(v string) *VendorBillCreate {
+	_c.mutation.SetVendorReference(v)
+	return _c
+}
+
+// SetNillableVendorReference sets the "vendor_reference" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableVendorReference(v *string) *VendorBillCreate {
+	if v != nil {
+		_c.SetVendorReference(*v)
+	}
+	return _c
+}
+
+// SetBillDate sets the "bill_date" field.
+func (_c *VendorBillCreate) SetBillDate(v time.Time) *VendorBillCreate {
+	_c.mutation.SetBillDate(v)
+	return _c
+}
+
+// SetDueDate sets the "due_date" field.
+func (_c *VendorBillCreate) SetDueDate(v time.Time) *VendorBillCreate {
+	_c.mutation.SetDueDate(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *VendorBillCreate) SetCurrency(v string) *VendorBillCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableCurrency(v *string) *VendorBillCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (_c *VendorBillCreate) SetSubtotal(v decimal.Decimal) *VendorBillCreate {
+	_c.mutation.SetSubtotal(v)
+	return _c
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (_c *VendorBillCreate) SetTaxAmount(v decimal.Decimal) *VendorBillCreate {
+	_c.mutation.SetTaxAmount(v)
+	return _c
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableTaxAmount(v *decimal.Decimal) *VendorBillCreate {
+	if v != nil {
+		_c.SetTaxAmount(*v)
+	}
+	return _c
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (_c *VendorBillCreate) SetTotalAmount(v decimal.Decimal) *VendorBillCreate {
+	_c.mutation.SetTotalAmount(v)
+	return _c
+}
+
+// SetPaidAmount sets the "paid_amount" field.
+func (_c *VendorBillCreate) SetPaidAmount(v decimal.Decimal) *VendorBillCreate {
+	_c.mutation.SetPaidAmount(v)
+	return _c
+}
+
+// SetNillablePaidAmount sets the "paid_amount" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillablePaidAmount(v *decimal.Decimal) *VendorBillCreate {
+	if v != nil {
+		_c.SetPaidAmount(*v)
+	}
+	return _c
+}
+
+// SetDiscountRate sets the "discount_rate" field.
+func (_c *VendorBillCreate) SetDiscountRate(v decimal.Decimal) *VendorBillCreate {
+	_c.mutation.SetDiscountRate(v)
+	return _c
+}
+
+// SetNillableDiscountRate sets the "discount_rate" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableDiscountRate(v *decimal.Decimal) *VendorBillCreate {
+	if v != nil {
+		_c.SetDiscountRate(*v)
+	}
+	return _c
+}
+
+// SetDiscountUntil sets the "discount_until" field.
+func (_c *VendorBillCreate) SetDiscountUntil(v time.Time) *VendorBillCreate {
+	_c.mutation.SetDiscountUntil(v)
+	return _c
+}
+
+// SetNillableDiscountUntil sets the "discount_until" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableDiscountUntil(v *time.Time) *VendorBillCreate {
+	if v != nil {
+		_c.SetDiscountUntil(*v)
+	}
+	return _c
+}
+
+// SetDiscountTaken sets the "discount_taken" field.
+func (_c *VendorBillCreate) SetDiscountTaken(v decimal.Decimal) *VendorBillCreate {
+	_c.mutation.SetDiscountTaken(v)
+	return _c
+}
+
+// SetNillableDiscountTaken sets the "discount_taken" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableDiscountTaken(v *decimal.Decimal) *VendorBillCreate {
+	if v != nil {
+		_c.SetDiscountTaken(*v)
+	}
+	return _c
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (_c *VendorBillCreate) SetWithholdingAmount(v decimal.Decimal) *VendorBillCreate {
+	_c.mutation.SetWithholdingAmount(v)
+	return _c
+}
+
+// SetNillableWithholdingAmount sets the "withholding_amount" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableWithholdingAmount(v *decimal.Decimal) *VendorBillCreate {
+	if v != nil {
+		_c.SetWithholdingAmount(*v)
+	}
+	return _c
+}
+
+// SetWithholdingTaken sets the "withholding_taken" field.
+func (_c *VendorBillCreate) SetWithholdingTaken(v decimal.Decimal) *VendorBillCreate {
+	_c.mutation.SetWithholdingTaken(v)
+	return _c
+}
+
+// SetNillableWithholdingTaken sets the "withholding_taken" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableWithholdingTaken(v *decimal.Decimal) *VendorBillCreate {
+	if v != nil {
+		_c.SetWithholdingTaken(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *VendorBillCreate) SetStatus(v string) *VendorBillCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableStatus(v *string) *VendorBillCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttachments sets the "attachments" field.
+func (_c *VendorBillCreate) SetAttachments(v []string) *VendorBillCreate {
+	_c.mutation.SetAttachments(v)
+	return _c
+}
+
+// SetReferenceType sets the "reference_type" field.
+func (_c *VendorBillCreate) SetReferenceType(v string) *VendorBillCreate {
+	_c.mutation.SetReferenceType(v)
+	return _c
+}
+
+// SetNillableReferenceType sets the "reference_type" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableReferenceType(v *string) *VendorBillCreate {
+	if v != nil {
+		_c.SetReferenceType(*v)
+	}
+	return _c
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (_c *VendorBillCreate) SetReferenceID(v string) *VendorBillCreate {
+	_c.mutation.SetReferenceID(v)
+	return _c
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableReferenceID(v *string) *VendorBillCreate {
+	if v != nil {
+		_c.SetReferenceID(*v)
+	}
+	return _c
+}
+
+// SetPoNumber sets the "po_number" field.
+func (_c *VendorBillCreate) SetPoNumber(v string) *VendorBillCreate {
+	_c.mutation.SetPoNumber(v)
+	return _c
+}
+
+// SetNillablePoNumber sets the "po_number" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillablePoNumber(v *string) *VendorBillCreate {
+	if v != nil {
+		_c.SetPoNumber(*v)
+	}
+	return _c
+}
+
+// SetMatchStatus sets the "match_status" field.
+func (_c *VendorBillCreate) SetMatchStatus(v string) *VendorBillCreate {
+	_c.mutation.SetMatchStatus(v)
+	return _c
+}
+
+// SetNillableMatchStatus sets the "match_status" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableMatchStatus(v *string) *VendorBillCreate {
+	if v != nil {
+		_c.SetMatchStatus(*v)
+	}
+	return _c
+}
+
+// SetMatchedAt sets the "matched_at" field.
+func (_c *VendorBillCreate) SetMatchedAt(v time.Time) *VendorBillCreate {
+	_c.mutation.SetMatchedAt(v)
+	return _c
+}
+
+// SetNillableMatchedAt sets the "matched_at" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableMatchedAt(v *time.Time) *VendorBillCreate {
+	if v != nil {
+		_c.SetMatchedAt(*v)
+	}
+	return _c
+}
+
+// SetVarianceAcceptedBy sets the "variance_accepted_by" field.
+func (_c *VendorBillCreate) SetVarianceAcceptedBy(v uuid.UUID) *VendorBillCreate {
+	_c.mutation.SetVarianceAcceptedBy(v)
+	return _c
+}
+
+// SetNillableVarianceAcceptedBy sets the "variance_accepted_by" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableVarianceAcceptedBy(v *uuid.UUID) *VendorBillCreate {
+	if v != nil {
+		_c.SetVarianceAcceptedBy(*v)
+	}
+	return _c
+}
+
+// SetVarianceAcceptedAt sets the "variance_accepted_at" field.
+func (_c *VendorBillCreate) SetVarianceAcceptedAt(v time.Time) *VendorBillCreate {
+	_c.mutation.SetVarianceAcceptedAt(v)
+	return _c
+}
+
+// SetNillableVarianceAcceptedAt sets the "variance_accepted_at" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableVarianceAcceptedAt(v *time.Time) *VendorBillCreate {
+	if v != nil {
+		_c.SetVarianceAcceptedAt(*v)
+	}
+	return _c
+}
+
+// SetVarianceReason sets the "variance_reason" field.
+func (_c *VendorBillCreate) SetVarianceReason(v string) *VendorBillCreate {
+	_c.mutation.SetVarianceReason(v)
+	return _c
+}
+
+// SetNillableVarianceReason sets the "variance_reason" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableVarianceReason(v *string) *VendorBillCreate {
+	if v != nil {
+		_c.SetVarianceReason(*v)
+	}
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *VendorBillCreate) SetNotes(v string) *VendorBillCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableNotes(v *string) *VendorBillCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *VendorBillCreate) SetCreatedBy(v uuid.UUID) *VendorBillCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableCreatedBy(v *uuid.UUID) *VendorBillCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_c *VendorBillCreate) SetApprovedBy(v uuid.UUID) *VendorBillCreate {
+	_c.mutation.SetApprovedBy(v)
+	return _c
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableApprovedBy(v *uuid.UUID) *VendorBillCreate {
+	if v != nil {
+		_c.SetApprovedBy(*v)
+	}
+	return _c
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_c *VendorBillCreate) SetApprovedAt(v time.Time) *VendorBillCreate {
+	_c.mutation.SetApprovedAt(v)
+	return _c
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableApprovedAt(v *time.Time) *VendorBillCreate {
+	if v != nil {
+		_c.SetApprovedAt(*v)
+	}
+	return _c
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (_c *VendorBillCreate) SetScheduledFor(v time.Time) *VendorBillCreate {
+	_c.mutation.SetScheduledFor(v)
+	return _c
+}
+
+// SetNillableScheduledFor sets the "scheduled_for" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableScheduledFor(v *time.Time) *VendorBillCreate {
+	if v != nil {
+		_c.SetScheduledFor(*v)
+	}
+	return _c
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_c *VendorBillCreate) SetPaidAt(v time.Time) *VendorBillCreate {
+	_c.mutation.SetPaidAt(v)
+	return _c
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillablePaidAt(v *time.Time) *VendorBillCreate {
+	if v != nil {
+		_c.SetPaidAt(*v)
+	}
+	return _c
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_c *VendorBillCreate) SetJournalEntryID(v uuid.UUID) *VendorBillCreate {
+	_c.mutation.SetJournalEntryID(v)
+	return _c
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableJournalEntryID(v *uuid.UUID) *VendorBillCreate {
+	if v != nil {
+		_c.SetJournalEntryID(*v)
+	}
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *VendorBillCreate) SetMetadata(v map[string]interface{}) *VendorBillCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VendorBillCreate) SetCreatedAt(v time.Time) *VendorBillCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableCreatedAt(v *time.Time) *VendorBillCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *VendorBillCreate) SetUpdatedAt(v time.Time) *VendorBillCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableUpdatedAt(v *time.Time) *VendorBillCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *VendorBillCreate) SetID(v uuid.UUID) *VendorBillCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *VendorBillCreate) SetNillableID(v *uuid.UUID) *VendorBillCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddLineIDs adds the "lines" edge to the VendorBillLine entity by IDs.
+func (_c *VendorBillCreate) AddLineIDs(ids ...uuid.UUID) *VendorBillCreate {
+	_c.mutation.AddLineIDs(ids...)
+	return _c
+}
+
+// AddLines adds the "lines" edges to the VendorBillLine entity.
+func (_c *VendorBillCreate) AddLines(v ...*VendorBillLine) *VendorBillCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLineIDs(ids...)
+}
+
+// Mutation returns the VendorBillMutation object of the builder.
+func (_c *VendorBillCreate) Mutation() *VendorBillMutation {
+	return _c.mutation
+}
+
+// Save creates the VendorBill in the database.
+func (_c *VendorBillCreate) Save(ctx context.Context) (*VendorBill, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VendorBillCreate) SaveX(ctx context.Context) *VendorBill {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VendorBillCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VendorBillCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VendorBillCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := vendorbill.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := vendorbill.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		v := vendorbill.DefaultMetadata
+		_c.mutation.SetMetadata(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := vendorbill.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := vendorbill.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := vendorbill.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VendorBillCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "VendorBill.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.VendorID(); !ok {
+		return &ValidationError{Name: "vendor_id", err: errors.New(`ent: missing required field "VendorBill.vendor_id"`)}
+	}
+	if _, ok := _c.mutation.BillNumber(); !ok {
+		return &ValidationError{Name: "bill_number", err: errors.New(`ent: missing required field "VendorBill.bill_number"`)}
+	}
+	if v, ok := _c.mutation.BillNumber(); ok {
+		if err := vendorbill.BillNumberValidator(v); err != nil {
+			return &ValidationError{Name: "bill_number", err: fmt.Errorf(`ent: validator failed for field "VendorBill.bill_number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BillDate(); !ok {
+		return &ValidationError{Name: "bill_date", err: errors.New(`ent: missing required field "VendorBill.bill_date"`)}
+	}
+	if _, ok := _c.mutation.DueDate(); !ok {
+		return &ValidationError{Name: "due_date", err: errors.New(`ent: missing required field "VendorBill.due_date"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "VendorBill.currency"`)}
+	}
+	if _, ok := _c.mutation.Subtotal(); !ok {
+		return &ValidationError{Name: "subtotal", err: errors.New(`ent: missing required field "VendorBill.subtotal"`)}
+	}
+	if _, ok := _c.mutation.TotalAmount(); !ok {
+		return &ValidationError{Name: "total_amount", err: errors.New(`ent: missing required field "VendorBill.total_amount"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "VendorBill.status"`)}
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		return &ValidationError{Name: "metadata", err: errors.New(`ent: missing required field "VendorBill.metadata"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VendorBill.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "VendorBill.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *VendorBillCreate) sqlSave(ctx context.Context) (*VendorBill, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VendorBillCreate) createSpec() (*VendorBill, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VendorBill{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(vendorbill.Table, sqlgraph.NewFieldSpec(vendorbill.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(vendorbill.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.VendorID(); ok {
+		_spec.SetField(vendorbill.FieldVendorID, field.TypeUUID, value)
+		_node.VendorID = value
+	}
+	if value, ok := _c.mutation.BillNumber(); ok {
+		_spec.SetField(vendorbill.FieldBillNumber, field.TypeString, value)
+		_node.BillNumber = value
+	}
+	if value, ok := _c.mutation.VendorReference(); ok {
+		_spec.SetField(vendorbill.FieldVendorReference, field.TypeString, value)
+		_node.VendorReference = value
+	}
+	if value, ok := _c.mutation.BillDate(); ok {
+		_spec.SetField(vendorbill.FieldBillDate, field.TypeTime, value)
+		_node.BillDate = value
+	}
+	if value, ok := _c.mutation.DueDate(); ok {
+		_spec.SetField(vendorbill.FieldDueDate, field.TypeTime, value)
+		_node.DueDate = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(vendorbill.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Subtotal(); ok {
+		_spec.SetField(vendorbill.FieldSubtotal, field.TypeFloat64, value)
+		_node.Subtotal = value
+	}
+	if value, ok := _c.mutation.TaxAmount(); ok {
+		_spec.SetField(vendorbill.FieldTaxAmount, field.TypeFloat64, value)
+		_node.TaxAmount = value
+	}
+	if value, ok := _c.mutation.TotalAmount(); ok {
+		_spec.SetField(vendorbill.FieldTotalAmount, field.TypeFloat64, value)
+		_node.TotalAmount = value
+	}
+	if value, ok := _c.mutation.PaidAmount(); ok {
+		_spec.SetField(vendorbill.FieldPaidAmount, field.TypeFloat64, value)
+		_node.PaidAmount = value
+	}
+	if value, ok := _c.mutation.DiscountRate(); ok {
+		_spec.SetField(vendorbill.FieldDiscountRate, field.TypeFloat64, value)
+		_node.DiscountRate = value
+	}
+	if value, ok := _c.mutation.DiscountUntil(); ok {
+		_spec.SetField(vendorbill.FieldDiscountUntil, field.TypeTime, value)
+		_node.DiscountUntil = value
+	}
+	if value, ok := _c.mutation.DiscountTaken(); ok {
+		_spec.SetField(vendorbill.FieldDiscountTaken, field.TypeFloat64, value)
+		_node.DiscountTaken = value
+	}
+	if value, ok := _c.mutation.WithholdingAmount(); ok {
+		_spec.SetField(vendorbill.FieldWithholdingAmount, field.TypeFloat64, value)
+		_node.WithholdingAmount = value
+	}
+	if value, ok := _c.mutation.WithholdingTaken(); ok {
+		_spec.SetField(vendorbill.FieldWithholdingTaken, field.TypeFloat64, value)
+		_node.WithholdingTaken = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(vendorbill.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attachments(); ok {
+		_spec.SetField(vendorbill.FieldAttachments, field.TypeJSON, value)
+		_node.Attachments = value
+	}
+	if value, ok := _c.mutation.ReferenceType(); ok {
+		_spec.SetField(vendorbill.FieldReferenceType, field.TypeString, value)
+		_node.ReferenceType = value
+	}
+	if value, ok := _c.mutation.ReferenceID(); ok {
+		_spec.SetField(vendorbill.FieldReferenceID, field.TypeString, value)
+		_node.ReferenceID = value
+	}
+	if value, ok := _c.mutation.PoNumber(); ok {
+		_spec.SetField(vendorbill.FieldPoNumber, field.TypeString, value)
+		_node.PoNumber = value
+	}
+	if value, ok := _c.mutation.MatchStatus(); ok {
+		_spec.SetField(vendorbill.FieldMatchStatus, field.TypeString, value)
+		_node.MatchStatus = value
+	}
+	if value, ok := _c.mutation.MatchedAt(); ok {
+		_spec.SetField(vendorbill.FieldMatchedAt, field.TypeTime, value)
+		_node.MatchedAt = value
+	}
+	if value, ok := _c.mutation.VarianceAcceptedBy(); ok {
+		_spec.SetField(vendorbill.FieldVarianceAcceptedBy, field.TypeUUID, value)
+		_node.VarianceAcceptedBy = value
+	}
+	if value, ok := _c.mutation.VarianceAcceptedAt(); ok {
+		_spec.SetField(vendorbill.FieldVarianceAcceptedAt, field.TypeTime, value)
+		_node.VarianceAcceptedAt = value
+	}
+	if value, ok := _c.mutation.VarianceReason(); ok {
+		_spec.SetField(vendorbill.FieldVarianceReason, field.TypeString, value)
+		_node.VarianceReason = value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(vendorbill.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(vendorbill.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.ApprovedBy(); ok {
+		_spec.SetField(vendorbill.FieldApprovedBy, field.TypeUUID, value)
+		_node.ApprovedBy = value
+	}
+	if value, ok := _c.mutation.ApprovedAt(); ok {
+		_spec.SetField(vendorbill.FieldApprovedAt, field.TypeTime, value)
+		_node.ApprovedAt = value
+	}
+	if value, ok := _c.mutation.ScheduledFor(); ok {
+		_spec.SetField(vendorbill.FieldScheduledFor, field.TypeTime, value)
+		_node.ScheduledFor = value
+	}
+	if value, ok := _c.mutation.PaidAt(); ok {
+		_spec.SetField(vendorbill.FieldPaidAt, field.TypeTime, value)
+		_node.PaidAt = value
+	}
+	if value, ok := _c.mutation.JournalEntryID(); ok {
+		_spec.SetField(vendorbill.FieldJournalEntryID, field.TypeUUID, value)
+		_node.JournalEntryID = value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(vendorbill.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(vendorbill.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(vendorbill.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vendorbill.LinesTable,
+			Columns: []string{vendorbill.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendorbillline.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VendorBill.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VendorBillUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *VendorBillCreate) OnConflict(opts ...sql.ConflictOption) *VendorBillUpsertOne {
+	_c.conflict = opts
+	return &VendorBillUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VendorBill.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *VendorBillCreate) OnConflictColumns(columns ...string) *VendorBillUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &VendorBillUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// VendorBillUpsertOne is the builder for "upsert"-ing
+	//  one VendorBill node.
+	VendorBillUpsertOne struct {
+		create *VendorBillCreate
+	}
+
+	// VendorBillUpsert is the "OnConflict" setter.
+	VendorBillUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *VendorBillUpsert) SetTenantID(v uuid.UUID) *VendorBillUpsert {
+	u.Set(vendorbill.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateTenantID() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldTenantID)
+	return u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (u *VendorBillUpsert) SetVendorID(v uuid.UUID) *VendorBillUpsert {
+	u.Set(vendorbill.FieldVendorID, v)
+	return u
+}
+
+// UpdateVendorID sets the "vendor_id" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateVendorID() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldVendorID)
+	return u
+}
+
+// SetVendorReference sets the "vendor_reference" field.
+func (u *VendorBillUpsert) SetVendorReference(v string) *VendorBillUpsert {
+	u.Set(vendorbill.FieldVendorReference, v)
+	return u
+}
+
+// UpdateVendorReference sets the "vendor_reference" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateVendorReference() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldVendorReference)
+	return u
+}
+
+// ClearVendorReference clears the value of the "vendor_reference" field.
+func (u *VendorBillUpsert) ClearVendorReference() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldVendorReference)
+	return u
+}
+
+// SetBillDate sets the "bill_date" field.
+func (u *VendorBillUpsert) SetBillDate(v time.Time) *VendorBillUpsert {
+	u.Set(vendorbill.FieldBillDate, v)
+	return u
+}
+
+// UpdateBillDate sets the "bill_date" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateBillDate() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldBillDate)
+	return u
+}
+
+// SetDueDate sets the "due_date" field.
+func (u *VendorBillUpsert) SetDueDate(v time.Time) *VendorBillUpsert {
+	u.Set(vendorbill.FieldDueDate, v)
+	return u
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateDueDate() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldDueDate)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *VendorBillUpsert) SetCurrency(v string) *VendorBillUpsert {
+	u.Set(vendorbill.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateCurrency() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldCurrency)
+	return u
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (u *VendorBillUpsert) SetSubtotal(v decimal.Decimal) *VendorBillUpsert {
+	u.Set(vendorbill.FieldSubtotal, v)
+	return u
+}
+
+// UpdateSubtotal sets the "subtotal" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateSubtotal() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldSubtotal)
+	return u
+}
+
+// AddSubtotal adds v to the "subtotal" field.
+func (u *VendorBillUpsert) AddSubtotal(v decimal.Decimal) *VendorBillUpsert {
+	u.Add(vendorbill.FieldSubtotal, v)
+	return u
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *VendorBillUpsert) SetTaxAmount(v decimal.Decimal) *VendorBillUpsert {
+	u.Set(vendorbill.FieldTaxAmount, v)
+	return u
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateTaxAmount() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldTaxAmount)
+	return u
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *VendorBillUpsert) AddTaxAmount(v decimal.Decimal) *VendorBillUpsert {
+	u.Add(vendorbill.FieldTaxAmount, v)
+	return u
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (u *VendorBillUpsert) ClearTaxAmount() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldTaxAmount)
+	return u
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (u *VendorBillUpsert) SetTotalAmount(v decimal.Decimal) *VendorBillUpsert {
+	u.Set(vendorbill.FieldTotalAmount, v)
+	return u
+}
+
+// UpdateTotalAmount sets the "total_amount" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateTotalAmount() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldTotalAmount)
+	return u
+}
+
+// AddTotalAmount adds v to the "total_amount" field.
+func (u *VendorBillUpsert) AddTotalAmount(v decimal.Decimal) *VendorBillUpsert {
+	u.Add(vendorbill.FieldTotalAmount, v)
+	return u
+}
+
+// SetPaidAmount sets the "paid_amount" field.
+func (u *VendorBillUpsert) SetPaidAmount(v decimal.Decimal) *VendorBillUpsert {
+	u.Set(vendorbill.FieldPaidAmount, v)
+	return u
+}
+
+// UpdatePaidAmount sets the "paid_amount" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdatePaidAmount() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldPaidAmount)
+	return u
+}
+
+// AddPaidAmount adds v to the "paid_amount" field.
+func (u *VendorBillUpsert) AddPaidAmount(v decimal.Decimal) *VendorBillUpsert {
+	u.Add(vendorbill.FieldPaidAmount, v)
+	return u
+}
+
+// ClearPaidAmount clears the value of the "paid_amount" field.
+func (u *VendorBillUpsert) ClearPaidAmount() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldPaidAmount)
+	return u
+}
+
+// SetDiscountRate sets the "discount_rate" field.
+func (u *VendorBillUpsert) SetDiscountRate(v decimal.Decimal) *VendorBillUpsert {
+	u.Set(vendorbill.FieldDiscountRate, v)
+	return u
+}
+
+// UpdateDiscountRate sets the "discount_rate" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateDiscountRate() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldDiscountRate)
+	return u
+}
+
+// AddDiscountRate adds v to the "discount_rate" field.
+func (u *VendorBillUpsert) AddDiscountRate(v decimal.Decimal) *VendorBillUpsert {
+	u.Add(vendorbill.FieldDiscountRate, v)
+	return u
+}
+
+// ClearDiscountRate clears the value of the "discount_rate" field.
+func (u *VendorBillUpsert) ClearDiscountRate() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldDiscountRate)
+	return u
+}
+
+// SetDiscountUntil sets the "discount_until" field.
+func (u *VendorBillUpsert) SetDiscountUntil(v time.Time) *VendorBillUpsert {
+	u.Set(vendorbill.FieldDiscountUntil, v)
+	return u
+}
+
+// UpdateDiscountUntil sets the "discount_until" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateDiscountUntil() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldDiscountUntil)
+	return u
+}
+
+// ClearDiscountUntil clears the value of the "discount_until" field.
+func (u *VendorBillUpsert) ClearDiscountUntil() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldDiscountUntil)
+	return u
+}
+
+// SetDiscountTaken sets the "discount_taken" field.
+func (u *VendorBillUpsert) SetDiscountTaken(v decimal.Decimal) *VendorBillUpsert {
+	u.Set(vendorbill.FieldDiscountTaken, v)
+	return u
+}
+
+// UpdateDiscountTaken sets the "discount_taken" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateDiscountTaken() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldDiscountTaken)
+	return u
+}
+
+// AddDiscountTaken adds v to the "discount_taken" field.
+func (u *VendorBillUpsert) AddDiscountTaken(v decimal.Decimal) *VendorBillUpsert {
+	u.Add(vendorbill.FieldDiscountTaken, v)
+	return u
+}
+
+// ClearDiscountTaken clears the value of the "discount_taken" field.
+func (u *VendorBillUpsert) ClearDiscountTaken() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldDiscountTaken)
+	return u
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (u *VendorBillUpsert) SetWithholdingAmount(v decimal.Decimal) *VendorBillUpsert {
+	u.Set(vendorbill.FieldWithholdingAmount, v)
+	return u
+}
+
+// UpdateWithholdingAmount sets the "withholding_amount" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateWithholdingAmount() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldWithholdingAmount)
+	return u
+}
+
+// AddWithholdingAmount adds v to the "withholding_amount" field.
+func (u *VendorBillUpsert) AddWithholdingAmount(v decimal.Decimal) *VendorBillUpsert {
+	u.Add(vendorbill.FieldWithholdingAmount, v)
+	return u
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (u *VendorBillUpsert) ClearWithholdingAmount() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldWithholdingAmount)
+	return u
+}
+
+// SetWithholdingTaken sets the "withholding_taken" field.
+func (u *VendorBillUpsert) SetWithholdingTaken(v decimal.Decimal) *VendorBillUpsert {
+	u.Set(vendorbill.FieldWithholdingTaken, v)
+	return u
+}
+
+// UpdateWithholdingTaken sets the "withholding_taken" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateWithholdingTaken() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldWithholdingTaken)
+	return u
+}
+
+// AddWithholdingTaken adds v to the "withholding_taken" field.
+func (u *VendorBillUpsert) AddWithholdingTaken(v decimal.Decimal) *VendorBillUpsert {
+	u.Add(vendorbill.FieldWithholdingTaken, v)
+	return u
+}
+
+// ClearWithholdingTaken clears the value of the "withholding_taken" field.
+func (u *VendorBillUpsert) ClearWithholdingTaken() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldWithholdingTaken)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *VendorBillUpsert) SetStatus(v string) *VendorBillUpsert {
+	u.Set(vendorbill.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateStatus() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldStatus)
+	return u
+}
+
+// SetAttachments sets the "attachments" field.
+func (u *VendorBillUpsert) SetAttachments(v []string) *VendorBillUpsert {
+	u.Set(vendorbill.FieldAttachments, v)
+	return u
+}
+
+// UpdateAttachments sets the "attachments" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateAttachments() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldAttachments)
+	return u
+}
+
+// ClearAttachments clears the value of the "attachments" field.
+func (u *VendorBillUpsert) ClearAttachments() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldAttachments)
+	return u
+}
+
+// SetReferenceType sets the "reference_type" field.
+func (u *VendorBillUpsert) SetReferenceType(v string) *VendorBillUpsert {
+	u.Set(vendorbill.FieldReferenceType, v)
+	return u
+}
+
+// UpdateReferenceType sets the "reference_type" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateReferenceType() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldReferenceType)
+	return u
+}
+
+// ClearReferenceType clears the value of the "reference_type" field.
+func (u *VendorBillUpsert) ClearReferenceType() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldReferenceType)
+	return u
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (u *VendorBillUpsert) SetReferenceID(v string) *VendorBillUpsert {
+	u.Set(vendorbill.FieldReferenceID, v)
+	return u
+}
+
+// UpdateReferenceID sets the "reference_id" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateReferenceID() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldReferenceID)
+	return u
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (u *VendorBillUpsert) ClearReferenceID() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldReferenceID)
+	return u
+}
+
+// SetPoNumber sets the "po_number" field.
+func (u *VendorBillUpsert) SetPoNumber(v string) *VendorBillUpsert {
+	u.Set(vendorbill.FieldPoNumber, v)
+	return u
+}
+
+// UpdatePoNumber sets the "po_number" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdatePoNumber() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldPoNumber)
+	return u
+}
+
+// ClearPoNumber clears the value of the "po_number" field.
+func (u *VendorBillUpsert) ClearPoNumber() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldPoNumber)
+	return u
+}
+
+// SetMatchStatus sets the "match_status" field.
+func (u *VendorBillUpsert) SetMatchStatus(v string) *VendorBillUpsert {
+	u.Set(vendorbill.FieldMatchStatus, v)
+	return u
+}
+
+// UpdateMatchStatus sets the "match_status" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateMatchStatus() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldMatchStatus)
+	return u
+}
+
+// ClearMatchStatus clears the value of the "match_status" field.
+func (u *VendorBillUpsert) ClearMatchStatus() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldMatchStatus)
+	return u
+}
+
+// SetMatchedAt sets the "matched_at" field.
+func (u *VendorBillUpsert) SetMatchedAt(v time.Time) *VendorBillUpsert {
+	u.Set(vendorbill.FieldMatchedAt, v)
+	return u
+}
+
+// UpdateMatchedAt sets the "matched_at" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateMatchedAt() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldMatchedAt)
+	return u
+}
+
+// ClearMatchedAt clears the value of the "matched_at" field.
+func (u *VendorBillUpsert) ClearMatchedAt() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldMatchedAt)
+	return u
+}
+
+// SetVarianceAcceptedBy sets the "variance_accepted_by" field.
+func (u *VendorBillUpsert) SetVarianceAcceptedBy(v uuid.UUID) *VendorBillUpsert {
+	u.Set(vendorbill.FieldVarianceAcceptedBy, v)
+	return u
+}
+
+// UpdateVarianceAcceptedBy sets the "variance_accepted_by" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateVarianceAcceptedBy() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldVarianceAcceptedBy)
+	return u
+}
+
+// ClearVarianceAcceptedBy clears the value of the "variance_accepted_by" field.
+func (u *VendorBillUpsert) ClearVarianceAcceptedBy() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldVarianceAcceptedBy)
+	return u
+}
+
+// SetVarianceAcceptedAt sets the "variance_accepted_at" field.
+func (u *VendorBillUpsert) SetVarianceAcceptedAt(v time.Time) *VendorBillUpsert {
+	u.Set(vendorbill.FieldVarianceAcceptedAt, v)
+	return u
+}
+
+// UpdateVarianceAcceptedAt sets the "variance_accepted_at" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateVarianceAcceptedAt() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldVarianceAcceptedAt)
+	return u
+}
+
+// ClearVarianceAcceptedAt clears the value of the "variance_accepted_at" field.
+func (u *VendorBillUpsert) ClearVarianceAcceptedAt() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldVarianceAcceptedAt)
+	return u
+}
+
+// SetVarianceReason sets the "variance_reason" field.
+func (u *VendorBillUpsert) SetVarianceReason(v string) *VendorBillUpsert {
+	u.Set(vendorbill.FieldVarianceReason, v)
+	return u
+}
+
+// UpdateVarianceReason sets the "variance_reason" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateVarianceReason() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldVarianceReason)
+	return u
+}
+
+// ClearVarianceReason clears the value of the "variance_reason" field.
+func (u *VendorBillUpsert) ClearVarianceReason() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldVarianceReason)
+	return u
+}
+
+// SetNotes sets the "notes" field.
+func (u *VendorBillUpsert) SetNotes(v string) *VendorBillUpsert {
+	u.Set(vendorbill.FieldNotes, v)
+	return u
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateNotes() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldNotes)
+	return u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *VendorBillUpsert) ClearNotes() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldNotes)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *VendorBillUpsert) SetCreatedBy(v uuid.UUID) *VendorBillUpsert {
+	u.Set(vendorbill.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateCreatedBy() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldCreatedBy)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *VendorBillUpsert) ClearCreatedBy() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldCreatedBy)
+	return u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *VendorBillUpsert) SetApprovedBy(v uuid.UUID) *VendorBillUpsert {
+	u.Set(vendorbill.FieldApprovedBy, v)
+	return u
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateApprovedBy() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldApprovedBy)
+	return u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *VendorBillUpsert) ClearApprovedBy() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldApprovedBy)
+	return u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *VendorBillUpsert) SetApprovedAt(v time.Time) *VendorBillUpsert {
+	u.Set(vendorbill.FieldApprovedAt, v)
+	return u
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateApprovedAt() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldApprovedAt)
+	return u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *VendorBillUpsert) ClearApprovedAt() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldApprovedAt)
+	return u
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (u *VendorBillUpsert) SetScheduledFor(v time.Time) *VendorBillUpsert {
+	u.Set(vendorbill.FieldScheduledFor, v)
+	return u
+}
+
+// UpdateScheduledFor sets the "scheduled_for" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateScheduledFor() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldScheduledFor)
+	return u
+}
+
+// ClearScheduledFor clears the value of the "scheduled_for" field.
+func (u *VendorBillUpsert) ClearScheduledFor() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldScheduledFor)
+	return u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (u *VendorBillUpsert) SetPaidAt(v time.Time) *VendorBillUpsert {
+	u.Set(vendorbill.FieldPaidAt, v)
+	return u
+}
+
+// UpdatePaidAt sets the "paid_at" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdatePaidAt() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldPaidAt)
+	return u
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (u *VendorBillUpsert) ClearPaidAt() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldPaidAt)
+	return u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *VendorBillUpsert) SetJournalEntryID(v uuid.UUID) *VendorBillUpsert {
+	u.Set(vendorbill.FieldJournalEntryID, v)
+	return u
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateJournalEntryID() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldJournalEntryID)
+	return u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *VendorBillUpsert) ClearJournalEntryID() *VendorBillUpsert {
+	u.SetNull(vendorbill.FieldJournalEntryID)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *VendorBillUpsert) SetMetadata(v map[string]interface{}) *VendorBillUpsert {
+	u.Set(vendorbill.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateMetadata() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldMetadata)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VendorBillUpsert) SetUpdatedAt(v time.Time) *VendorBillUpsert {
+	u.Set(vendorbill.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VendorBillUpsert) UpdateUpdatedAt() *VendorBillUpsert {
+	u.SetExcluded(vendorbill.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.VendorBill.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(vendorbill.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VendorBillUpsertOne) UpdateNewValues() *VendorBillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(vendorbill.FieldID)
+		}
+		if _, exists := u.create.mutation.BillNumber(); exists {
+			s.SetIgnore(vendorbill.FieldBillNumber)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(vendorbill.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VendorBill.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *VendorBillUpsertOne) Ignore() *VendorBillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VendorBillUpsertOne) DoNothing() *VendorBillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VendorBillCreate.OnConflict
+// documentation for more info.
+func (u *VendorBillUpsertOne) Update(set func(*VendorBillUpsert)) *VendorBillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VendorBillUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *VendorBillUpsertOne) SetTenantID(v uuid.UUID) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateTenantID() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (u *VendorBillUpsertOne) SetVendorID(v uuid.UUID) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetVendorID(v)
+	})
+}
+
+// UpdateVendorID sets the "vendor_id" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateVendorID() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateVendorID()
+	})
+}
+
+// SetVendorReference sets the "vendor_reference" field.
+func (u *VendorBillUpsertOne) SetVendorReference(v string) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetVendorReference(v)
+	})
+}
+
+// UpdateVendorReference sets the "vendor_reference" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateVendorReference() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateVendorReference()
+	})
+}
+
+// ClearVendorReference clears the value of the "vendor_reference" field.
+func (u *VendorBillUpsertOne) ClearVendorReference() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearVendorReference()
+	})
+}
+
+// SetBillDate sets the "bill_date" field.
+func (u *VendorBillUpsertOne) SetBillDate(v time.Time) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetBillDate(v)
+	})
+}
+
+// UpdateBillDate sets the "bill_date" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateBillDate() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateBillDate()
+	})
+}
+
+// SetDueDate sets the "due_date" field.
+func (u *VendorBillUpsertOne) SetDueDate(v time.Time) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetDueDate(v)
+	})
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateDueDate() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateDueDate()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *VendorBillUpsertOne) SetCurrency(v string) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateCurrency() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (u *VendorBillUpsertOne) SetSubtotal(v decimal.Decimal) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetSubtotal(v)
+	})
+}
+
+// AddSubtotal adds v to the "subtotal" field.
+func (u *VendorBillUpsertOne) AddSubtotal(v decimal.Decimal) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.AddSubtotal(v)
+	})
+}
+
+// UpdateSubtotal sets the "subtotal" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateSubtotal() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateSubtotal()
+	})
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *VendorBillUpsertOne) SetTaxAmount(v decimal.Decimal) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetTaxAmount(v)
+	})
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *VendorBillUpsertOne) AddTaxAmount(v decimal.Decimal) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.AddTaxAmount(v)
+	})
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateTaxAmount() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateTaxAmount()
+	})
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (u *VendorBillUpsertOne) ClearTaxAmount() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearTaxAmount()
+	})
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (u *VendorBillUpsertOne) SetTotalAmount(v decimal.Decimal) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetTotalAmount(v)
+	})
+}
+
+// AddTotalAmount adds v to the "total_amount" field.
+func (u *VendorBillUpsertOne) AddTotalAmount(v decimal.Decimal) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.AddTotalAmount(v)
+	})
+}
+
+// UpdateTotalAmount sets the "total_amount" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateTotalAmount() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateTotalAmount()
+	})
+}
+
+// SetPaidAmount sets the "paid_amount" field.
+func (u *VendorBillUpsertOne) SetPaidAmount(v decimal.Decimal) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetPaidAmount(v)
+	})
+}
+
+// AddPaidAmount adds v to the "paid_amount" field.
+func (u *VendorBillUpsertOne) AddPaidAmount(v decimal.Decimal) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.AddPaidAmount(v)
+	})
+}
+
+// UpdatePaidAmount sets the "paid_amount" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdatePaidAmount() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdatePaidAmount()
+	})
+}
+
+// ClearPaidAmount clears the value of the "paid_amount" field.
+func (u *VendorBillUpsertOne) ClearPaidAmount() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearPaidAmount()
+	})
+}
+
+// SetDiscountRate sets the "discount_rate" field.
+func (u *VendorBillUpsertOne) SetDiscountRate(v decimal.Decimal) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetDiscountRate(v)
+	})
+}
+
+// AddDiscountRate adds v to the "discount_rate" field.
+func (u *VendorBillUpsertOne) AddDiscountRate(v decimal.Decimal) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.AddDiscountRate(v)
+	})
+}
+
+// UpdateDiscountRate sets the "discount_rate" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateDiscountRate() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateDiscountRate()
+	})
+}
+
+// ClearDiscountRate clears the value of the "discount_rate" field.
+func (u *VendorBillUpsertOne) ClearDiscountRate() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearDiscountRate()
+	})
+}
+
+// SetDiscountUntil sets the "discount_until" field.
+func (u *VendorBillUpsertOne) SetDiscountUntil(v time.Time) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetDiscountUntil(v)
+	})
+}
+
+// UpdateDiscountUntil sets the "discount_until" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateDiscountUntil() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateDiscountUntil()
+	})
+}
+
+// ClearDiscountUntil clears the value of the "discount_until" field.
+func (u *VendorBillUpsertOne) ClearDiscountUntil() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearDiscountUntil()
+	})
+}
+
+// SetDiscountTaken sets the "discount_taken" field.
+func (u *VendorBillUpsertOne) SetDiscountTaken(v decimal.Decimal) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetDiscountTaken(v)
+	})
+}
+
+// AddDiscountTaken adds v to the "discount_taken" field.
+func (u *VendorBillUpsertOne) AddDiscountTaken(v decimal.Decimal) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.AddDiscountTaken(v)
+	})
+}
+
+// UpdateDiscountTaken sets the "discount_taken" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateDiscountTaken() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateDiscountTaken()
+	})
+}
+
+// ClearDiscountTaken clears the value of the "discount_taken" field.
+func (u *VendorBillUpsertOne) ClearDiscountTaken() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearDiscountTaken()
+	})
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (u *VendorBillUpsertOne) SetWithholdingAmount(v decimal.Decimal) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetWithholdingAmount(v)
+	})
+}
+
+// AddWithholdingAmount adds v to the "withholding_amount" field.
+func (u *VendorBillUpsertOne) AddWithholdingAmount(v decimal.Decimal) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.AddWithholdingAmount(v)
+	})
+}
+
+// UpdateWithholdingAmount sets the "withholding_amount" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateWithholdingAmount() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateWithholdingAmount()
+	})
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (u *VendorBillUpsertOne) ClearWithholdingAmount() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearWithholdingAmount()
+	})
+}
+
+// SetWithholdingTaken sets the "withholding_taken" field.
+func (u *VendorBillUpsertOne) SetWithholdingTaken(v decimal.Decimal) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetWithholdingTaken(v)
+	})
+}
+
+// AddWithholdingTaken adds v to the "withholding_taken" field.
+func (u *VendorBillUpsertOne) AddWithholdingTaken(v decimal.Decimal) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.AddWithholdingTaken(v)
+	})
+}
+
+// UpdateWithholdingTaken sets the "withholding_taken" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateWithholdingTaken() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateWithholdingTaken()
+	})
+}
+
+// ClearWithholdingTaken clears the value of the "withholding_taken" field.
+func (u *VendorBillUpsertOne) ClearWithholdingTaken() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearWithholdingTaken()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *VendorBillUpsertOne) SetStatus(v string) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateStatus() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttachments sets the "attachments" field.
+func (u *VendorBillUpsertOne) SetAttachments(v []string) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetAttachments(v)
+	})
+}
+
+// UpdateAttachments sets the "attachments" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateAttachments() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateAttachments()
+	})
+}
+
+// ClearAttachments clears the value of the "attachments" field.
+func (u *VendorBillUpsertOne) ClearAttachments() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearAttachments()
+	})
+}
+
+// SetReferenceType sets the "reference_type" field.
+func (u *VendorBillUpsertOne) SetReferenceType(v string) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetReferenceType(v)
+	})
+}
+
+// UpdateReferenceType sets the "reference_type" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateReferenceType() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateReferenceType()
+	})
+}
+
+// ClearReferenceType clears the value of the "reference_type" field.
+func (u *VendorBillUpsertOne) ClearReferenceType() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearReferenceType()
+	})
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (u *VendorBillUpsertOne) SetReferenceID(v string) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetReferenceID(v)
+	})
+}
+
+// UpdateReferenceID sets the "reference_id" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateReferenceID() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateReferenceID()
+	})
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (u *VendorBillUpsertOne) ClearReferenceID() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearReferenceID()
+	})
+}
+
+// SetPoNumber sets the "po_number" field.
+func (u *VendorBillUpsertOne) SetPoNumber(v string) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetPoNumber(v)
+	})
+}
+
+// UpdatePoNumber sets the "po_number" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdatePoNumber() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdatePoNumber()
+	})
+}
+
+// ClearPoNumber clears the value of the "po_number" field.
+func (u *VendorBillUpsertOne) ClearPoNumber() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearPoNumber()
+	})
+}
+
+// SetMatchStatus sets the "match_status" field.
+func (u *VendorBillUpsertOne) SetMatchStatus(v string) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetMatchStatus(v)
+	})
+}
+
+// UpdateMatchStatus sets the "match_status" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateMatchStatus() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateMatchStatus()
+	})
+}
+
+// ClearMatchStatus clears the value of the "match_status" field.
+func (u *VendorBillUpsertOne) ClearMatchStatus() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearMatchStatus()
+	})
+}
+
+// SetMatchedAt sets the "matched_at" field.
+func (u *VendorBillUpsertOne) SetMatchedAt(v time.Time) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetMatchedAt(v)
+	})
+}
+
+// UpdateMatchedAt sets the "matched_at" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateMatchedAt() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateMatchedAt()
+	})
+}
+
+// ClearMatchedAt clears the value of the "matched_at" field.
+func (u *VendorBillUpsertOne) ClearMatchedAt() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearMatchedAt()
+	})
+}
+
+// SetVarianceAcceptedBy sets the "variance_accepted_by" field.
+func (u *VendorBillUpsertOne) SetVarianceAcceptedBy(v uuid.UUID) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetVarianceAcceptedBy(v)
+	})
+}
+
+// UpdateVarianceAcceptedBy sets the "variance_accepted_by" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateVarianceAcceptedBy() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateVarianceAcceptedBy()
+	})
+}
+
+// ClearVarianceAcceptedBy clears the value of the "variance_accepted_by" field.
+func (u *VendorBillUpsertOne) ClearVarianceAcceptedBy() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearVarianceAcceptedBy()
+	})
+}
+
+// SetVarianceAcceptedAt sets the "variance_accepted_at" field.
+func (u *VendorBillUpsertOne) SetVarianceAcceptedAt(v time.Time) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetVarianceAcceptedAt(v)
+	})
+}
+
+// UpdateVarianceAcceptedAt sets the "variance_accepted_at" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateVarianceAcceptedAt() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateVarianceAcceptedAt()
+	})
+}
+
+// ClearVarianceAcceptedAt clears the value of the "variance_accepted_at" field.
+func (u *VendorBillUpsertOne) ClearVarianceAcceptedAt() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearVarianceAcceptedAt()
+	})
+}
+
+// SetVarianceReason sets the "variance_reason" field.
+func (u *VendorBillUpsertOne) SetVarianceReason(v string) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetVarianceReason(v)
+	})
+}
+
+// UpdateVarianceReason sets the "variance_reason" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateVarianceReason() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateVarianceReason()
+	})
+}
+
+// ClearVarianceReason clears the value of the "variance_reason" field.
+func (u *VendorBillUpsertOne) ClearVarianceReason() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearVarianceReason()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *VendorBillUpsertOne) SetNotes(v string) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateNotes() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *VendorBillUpsertOne) ClearNotes() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *VendorBillUpsertOne) SetCreatedBy(v uuid.UUID) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateCreatedBy() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *VendorBillUpsertOne) ClearCreatedBy() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *VendorBillUpsertOne) SetApprovedBy(v uuid.UUID) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetApprovedBy(v)
+	})
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateApprovedBy() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateApprovedBy()
+	})
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *VendorBillUpsertOne) ClearApprovedBy() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearApprovedBy()
+	})
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *VendorBillUpsertOne) SetApprovedAt(v time.Time) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetApprovedAt(v)
+	})
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateApprovedAt() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateApprovedAt()
+	})
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *VendorBillUpsertOne) ClearApprovedAt() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearApprovedAt()
+	})
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (u *VendorBillUpsertOne) SetScheduledFor(v time.Time) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetScheduledFor(v)
+	})
+}
+
+// UpdateScheduledFor sets the "scheduled_for" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateScheduledFor() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateScheduledFor()
+	})
+}
+
+// ClearScheduledFor clears the value of the "scheduled_for" field.
+func (u *VendorBillUpsertOne) ClearScheduledFor() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearScheduledFor()
+	})
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (u *VendorBillUpsertOne) SetPaidAt(v time.Time) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetPaidAt(v)
+	})
+}
+
+// UpdatePaidAt sets the "paid_at" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdatePaidAt() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdatePaidAt()
+	})
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (u *VendorBillUpsertOne) ClearPaidAt() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearPaidAt()
+	})
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *VendorBillUpsertOne) SetJournalEntryID(v uuid.UUID) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetJournalEntryID(v)
+	})
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateJournalEntryID() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateJournalEntryID()
+	})
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *VendorBillUpsertOne) ClearJournalEntryID() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearJournalEntryID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *VendorBillUpsertOne) SetMetadata(v map[string]interface{}) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateMetadata() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VendorBillUpsertOne) SetUpdatedAt(v time.Time) *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VendorBillUpsertOne) UpdateUpdatedAt() *VendorBillUpsertOne {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *VendorBillUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VendorBillCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VendorBillUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *VendorBillUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: VendorBillUpsertOne.ID is not supported by MySQL driver. Use VendorBillUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *VendorBillUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// VendorBillCreateBulk is the builder for creating many VendorBill entities in bulk.
+type VendorBillCreateBulk struct {
+	config
+	err      error
+	builders []*VendorBillCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the VendorBill entities in the database.
+func (_c *VendorBillCreateBulk) Save(ctx context.Context) ([]*VendorBill, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*VendorBill, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VendorBillMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VendorBillCreateBulk) SaveX(ctx context.Context) []*VendorBill {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VendorBillCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VendorBillCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VendorBill.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VendorBillUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *VendorBillCreateBulk) OnConflict(opts ...sql.ConflictOption) *VendorBillUpsertBulk {
+	_c.conflict = opts
+	return &VendorBillUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VendorBill.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *VendorBillCreateBulk) OnConflictColumns(columns ...string) *VendorBillUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &VendorBillUpsertBulk{
+		create: _c,
+	}
+}
+
+// VendorBillUpsertBulk is the builder for "upsert"-ing
+// a bulk of VendorBill nodes.
+type VendorBillUpsertBulk struct {
+	create *VendorBillCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.VendorBill.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(vendorbill.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VendorBillUpsertBulk) UpdateNewValues() *VendorBillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(vendorbill.FieldID)
+			}
+			if _, exists := b.mutation.BillNumber(); exists {
+				s.SetIgnore(vendorbill.FieldBillNumber)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(vendorbill.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VendorBill.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *VendorBillUpsertBulk) Ignore() *VendorBillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VendorBillUpsertBulk) DoNothing() *VendorBillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VendorBillCreateBulk.OnConflict
+// documentation for more info.
+func (u *VendorBillUpsertBulk) Update(set func(*VendorBillUpsert)) *VendorBillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VendorBillUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *VendorBillUpsertBulk) SetTenantID(v uuid.UUID) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateTenantID() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (u *VendorBillUpsertBulk) SetVendorID(v uuid.UUID) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetVendorID(v)
+	})
+}
+
+// UpdateVendorID sets the "vendor_id" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateVendorID() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateVendorID()
+	})
+}
+
+// SetVendorReference sets the "vendor_reference" field.
+func (u *VendorBillUpsertBulk) SetVendorReference(v string) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetVendorReference(v)
+	})
+}
+
+// UpdateVendorReference sets the "vendor_reference" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateVendorReference() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateVendorReference()
+	})
+}
+
+// ClearVendorReference clears the value of the "vendor_reference" field.
+func (u *VendorBillUpsertBulk) ClearVendorReference() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearVendorReference()
+	})
+}
+
+// SetBillDate sets the "bill_date" field.
+func (u *VendorBillUpsertBulk) SetBillDate(v time.Time) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetBillDate(v)
+	})
+}
+
+// UpdateBillDate sets the "bill_date" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateBillDate() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateBillDate()
+	})
+}
+
+// SetDueDate sets the "due_date" field.
+func (u *VendorBillUpsertBulk) SetDueDate(v time.Time) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetDueDate(v)
+	})
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateDueDate() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateDueDate()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *VendorBillUpsertBulk) SetCurrency(v string) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateCurrency() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (u *VendorBillUpsertBulk) SetSubtotal(v decimal.Decimal) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetSubtotal(v)
+	})
+}
+
+// AddSubtotal adds v to the "subtotal" field.
+func (u *VendorBillUpsertBulk) AddSubtotal(v decimal.Decimal) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.AddSubtotal(v)
+	})
+}
+
+// UpdateSubtotal sets the "subtotal" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateSubtotal() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateSubtotal()
+	})
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *VendorBillUpsertBulk) SetTaxAmount(v decimal.Decimal) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetTaxAmount(v)
+	})
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *VendorBillUpsertBulk) AddTaxAmount(v decimal.Decimal) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.AddTaxAmount(v)
+	})
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateTaxAmount() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateTaxAmount()
+	})
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (u *VendorBillUpsertBulk) ClearTaxAmount() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearTaxAmount()
+	})
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (u *VendorBillUpsertBulk) SetTotalAmount(v decimal.Decimal) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetTotalAmount(v)
+	})
+}
+
+// AddTotalAmount adds v to the "total_amount" field.
+func (u *VendorBillUpsertBulk) AddTotalAmount(v decimal.Decimal) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.AddTotalAmount(v)
+	})
+}
+
+// UpdateTotalAmount sets the "total_amount" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateTotalAmount() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateTotalAmount()
+	})
+}
+
+// SetPaidAmount sets the "paid_amount" field.
+func (u *VendorBillUpsertBulk) SetPaidAmount(v decimal.Decimal) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetPaidAmount(v)
+	})
+}
+
+// AddPaidAmount adds v to the "paid_amount" field.
+func (u *VendorBillUpsertBulk) AddPaidAmount(v decimal.Decimal) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.AddPaidAmount(v)
+	})
+}
+
+// UpdatePaidAmount sets the "paid_amount" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdatePaidAmount() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdatePaidAmount()
+	})
+}
+
+// ClearPaidAmount clears the value of the "paid_amount" field.
+func (u *VendorBillUpsertBulk) ClearPaidAmount() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearPaidAmount()
+	})
+}
+
+// SetDiscountRate sets the "discount_rate" field.
+func (u *VendorBillUpsertBulk) SetDiscountRate(v decimal.Decimal) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetDiscountRate(v)
+	})
+}
+
+// AddDiscountRate adds v to the "discount_rate" field.
+func (u *VendorBillUpsertBulk) AddDiscountRate(v decimal.Decimal) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.AddDiscountRate(v)
+	})
+}
+
+// UpdateDiscountRate sets the "discount_rate" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateDiscountRate() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateDiscountRate()
+	})
+}
+
+// ClearDiscountRate clears the value of the "discount_rate" field.
+func (u *VendorBillUpsertBulk) ClearDiscountRate() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearDiscountRate()
+	})
+}
+
+// SetDiscountUntil sets the "discount_until" field.
+func (u *VendorBillUpsertBulk) SetDiscountUntil(v time.Time) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetDiscountUntil(v)
+	})
+}
+
+// UpdateDiscountUntil sets the "discount_until" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateDiscountUntil() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateDiscountUntil()
+	})
+}
+
+// ClearDiscountUntil clears the value of the "discount_until" field.
+func (u *VendorBillUpsertBulk) ClearDiscountUntil() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearDiscountUntil()
+	})
+}
+
+// SetDiscountTaken sets the "discount_taken" field.
+func (u *VendorBillUpsertBulk) SetDiscountTaken(v decimal.Decimal) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetDiscountTaken(v)
+	})
+}
+
+// AddDiscountTaken adds v to the "discount_taken" field.
+func (u *VendorBillUpsertBulk) AddDiscountTaken(v decimal.Decimal) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.AddDiscountTaken(v)
+	})
+}
+
+// UpdateDiscountTaken sets the "discount_taken" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateDiscountTaken() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateDiscountTaken()
+	})
+}
+
+// ClearDiscountTaken clears the value of the "discount_taken" field.
+func (u *VendorBillUpsertBulk) ClearDiscountTaken() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearDiscountTaken()
+	})
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (u *VendorBillUpsertBulk) SetWithholdingAmount(v decimal.Decimal) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetWithholdingAmount(v)
+	})
+}
+
+// AddWithholdingAmount adds v to the "withholding_amount" field.
+func (u *VendorBillUpsertBulk) AddWithholdingAmount(v decimal.Decimal) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.AddWithholdingAmount(v)
+	})
+}
+
+// UpdateWithholdingAmount sets the "withholding_amount" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateWithholdingAmount() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateWithholdingAmount()
+	})
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (u *VendorBillUpsertBulk) ClearWithholdingAmount() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearWithholdingAmount()
+	})
+}
+
+// SetWithholdingTaken sets the "withholding_taken" field.
+func (u *VendorBillUpsertBulk) SetWithholdingTaken(v decimal.Decimal) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetWithholdingTaken(v)
+	})
+}
+
+// AddWithholdingTaken adds v to the "withholding_taken" field.
+func (u *VendorBillUpsertBulk) AddWithholdingTaken(v decimal.Decimal) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.AddWithholdingTaken(v)
+	})
+}
+
+// UpdateWithholdingTaken sets the "withholding_taken" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateWithholdingTaken() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateWithholdingTaken()
+	})
+}
+
+// ClearWithholdingTaken clears the value of the "withholding_taken" field.
+func (u *VendorBillUpsertBulk) ClearWithholdingTaken() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearWithholdingTaken()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *VendorBillUpsertBulk) SetStatus(v string) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateStatus() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttachments sets the "attachments" field.
+func (u *VendorBillUpsertBulk) SetAttachments(v []string) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetAttachments(v)
+	})
+}
+
+// UpdateAttachments sets the "attachments" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateAttachments() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateAttachments()
+	})
+}
+
+// ClearAttachments clears the value of the "attachments" field.
+func (u *VendorBillUpsertBulk) ClearAttachments() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearAttachments()
+	})
+}
+
+// SetReferenceType sets the "reference_type" field.
+func (u *VendorBillUpsertBulk) SetReferenceType(v string) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetReferenceType(v)
+	})
+}
+
+// UpdateReferenceType sets the "reference_type" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateReferenceType() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateReferenceType()
+	})
+}
+
+// ClearReferenceType clears the value of the "reference_type" field.
+func (u *VendorBillUpsertBulk) ClearReferenceType() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearReferenceType()
+	})
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (u *VendorBillUpsertBulk) SetReferenceID(v string) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetReferenceID(v)
+	})
+}
+
+// UpdateReferenceID sets the "reference_id" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateReferenceID() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateReferenceID()
+	})
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (u *VendorBillUpsertBulk) ClearReferenceID() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearReferenceID()
+	})
+}
+
+// SetPoNumber sets the "po_number" field.
+func (u *VendorBillUpsertBulk) SetPoNumber(v string) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetPoNumber(v)
+	})
+}
+
+// UpdatePoNumber sets the "po_number" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdatePoNumber() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdatePoNumber()
+	})
+}
+
+// ClearPoNumber clears the value of the "po_number" field.
+func (u *VendorBillUpsertBulk) ClearPoNumber() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearPoNumber()
+	})
+}
+
+// SetMatchStatus sets the "match_status" field.
+func (u *VendorBillUpsertBulk) SetMatchStatus(v string) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetMatchStatus(v)
+	})
+}
+
+// UpdateMatchStatus sets the "match_status" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateMatchStatus() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateMatchStatus()
+	})
+}
+
+// ClearMatchStatus clears the value of the "match_status" field.
+func (u *VendorBillUpsertBulk) ClearMatchStatus() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearMatchStatus()
+	})
+}
+
+// SetMatchedAt sets the "matched_at" field.
+func (u *VendorBillUpsertBulk) SetMatchedAt(v time.Time) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetMatchedAt(v)
+	})
+}
+
+// UpdateMatchedAt sets the "matched_at" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateMatchedAt() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateMatchedAt()
+	})
+}
+
+// ClearMatchedAt clears the value of the "matched_at" field.
+func (u *VendorBillUpsertBulk) ClearMatchedAt() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearMatchedAt()
+	})
+}
+
+// SetVarianceAcceptedBy sets the "variance_accepted_by" field.
+func (u *VendorBillUpsertBulk) SetVarianceAcceptedBy(v uuid.UUID) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetVarianceAcceptedBy(v)
+	})
+}
+
+// UpdateVarianceAcceptedBy sets the "variance_accepted_by" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateVarianceAcceptedBy() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateVarianceAcceptedBy()
+	})
+}
+
+// ClearVarianceAcceptedBy clears the value of the "variance_accepted_by" field.
+func (u *VendorBillUpsertBulk) ClearVarianceAcceptedBy() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearVarianceAcceptedBy()
+	})
+}
+
+// SetVarianceAcceptedAt sets the "variance_accepted_at" field.
+func (u *VendorBillUpsertBulk) SetVarianceAcceptedAt(v time.Time) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetVarianceAcceptedAt(v)
+	})
+}
+
+// UpdateVarianceAcceptedAt sets the "variance_accepted_at" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateVarianceAcceptedAt() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateVarianceAcceptedAt()
+	})
+}
+
+// ClearVarianceAcceptedAt clears the value of the "variance_accepted_at" field.
+func (u *VendorBillUpsertBulk) ClearVarianceAcceptedAt() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearVarianceAcceptedAt()
+	})
+}
+
+// SetVarianceReason sets the "variance_reason" field.
+func (u *VendorBillUpsertBulk) SetVarianceReason(v string) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetVarianceReason(v)
+	})
+}
+
+// UpdateVarianceReason sets the "variance_reason" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateVarianceReason() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateVarianceReason()
+	})
+}
+
+// ClearVarianceReason clears the value of the "variance_reason" field.
+func (u *VendorBillUpsertBulk) ClearVarianceReason() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearVarianceReason()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *VendorBillUpsertBulk) SetNotes(v string) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateNotes() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *VendorBillUpsertBulk) ClearNotes() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *VendorBillUpsertBulk) SetCreatedBy(v uuid.UUID) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateCreatedBy() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *VendorBillUpsertBulk) ClearCreatedBy() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *VendorBillUpsertBulk) SetApprovedBy(v uuid.UUID) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetApprovedBy(v)
+	})
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateApprovedBy() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateApprovedBy()
+	})
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *VendorBillUpsertBulk) ClearApprovedBy() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearApprovedBy()
+	})
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *VendorBillUpsertBulk) SetApprovedAt(v time.Time) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetApprovedAt(v)
+	})
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateApprovedAt() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateApprovedAt()
+	})
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *VendorBillUpsertBulk) ClearApprovedAt() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearApprovedAt()
+	})
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (u *VendorBillUpsertBulk) SetScheduledFor(v time.Time) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetScheduledFor(v)
+	})
+}
+
+// UpdateScheduledFor sets the "scheduled_for" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateScheduledFor() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateScheduledFor()
+	})
+}
+
+// ClearScheduledFor clears the value of the "scheduled_for" field.
+func (u *VendorBillUpsertBulk) ClearScheduledFor() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearScheduledFor()
+	})
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (u *VendorBillUpsertBulk) SetPaidAt(v time.Time) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetPaidAt(v)
+	})
+}
+
+// UpdatePaidAt sets the "paid_at" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdatePaidAt() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdatePaidAt()
+	})
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (u *VendorBillUpsertBulk) ClearPaidAt() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearPaidAt()
+	})
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *VendorBillUpsertBulk) SetJournalEntryID(v uuid.UUID) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetJournalEntryID(v)
+	})
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateJournalEntryID() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateJournalEntryID()
+	})
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *VendorBillUpsertBulk) ClearJournalEntryID() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.ClearJournalEntryID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *VendorBillUpsertBulk) SetMetadata(v map[string]interface{}) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateMetadata() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VendorBillUpsertBulk) SetUpdatedAt(v time.Time) *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VendorBillUpsertBulk) UpdateUpdatedAt() *VendorBillUpsertBulk {
+	return u.Update(func(s *VendorBillUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *VendorBillUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the VendorBillCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VendorBillCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VendorBillUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
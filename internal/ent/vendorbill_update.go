@@ -0,0 +1,2082 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbill"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbillline"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// VendorBillUpdate is the builder for updating VendorBill entities.
+type VendorBillUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VendorBillMutation
+}
+
+// Where appends a list predicates to the VendorBillUpdate builder.
+func (_u *VendorBillUpdate) Where(ps ...predicate.VendorBill) *VendorBillUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *VendorBillUpdate) SetTenantID(v uuid.UUID) *VendorBillUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableTenantID(v *uuid.UUID) *VendorBillUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_u *VendorBillUpdate) SetVendorID(v uuid.UUID) *VendorBillUpdate {
+	_u.mutation.SetVendorID(v)
+	return _u
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableVendorID(v *uuid.UUID) *VendorBillUpdate {
+	if v != nil {
+		_u.SetVendorID(*v)
+	}
+	return _u
+}
+
+// SetVendorReference sets the "vendor_reference" field.
+func (_u *VendorBillUpdate) SetVendorReference(v string) *VendorBillUpdate {
+	_u.mutation.SetVendorReference(v)
+	return _u
+}
+
+// SetNillableVendorReference sets the "vendor_reference" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableVendorReference(v *string) *VendorBillUpdate {
+	if v != nil {
+		_u.SetVendorReference(*v)
+	}
+	return _u
+}
+
+// ClearVendorReference clears the value of the "vendor_reference" field.
+func (_u *VendorBillUpdate) ClearVendorReference() *VendorBillUpdate {
+	_u.mutation.ClearVendorReference()
+	return _u
+}
+
+// SetBillDate sets the "bill_date" field.
+func (_u *VendorBillUpdate) SetBillDate(v time.Time) *VendorBillUpdate {
+	_u.mutation.SetBillDate(v)
+	return _u
+}
+
+// SetNillableBillDate sets the "bill_date" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableBillDate(v *time.Time) *VendorBillUpdate {
+	if v != nil {
+		_u.SetBillDate(*v)
+	}
+	return _u
+}
+
+// SetDueDate sets the "due_date" field.
+func (_u *VendorBillUpdate) SetDueDate(v time.Time) *VendorBillUpdate {
+	_u.mutation.SetDueDate(v)
+	return _u
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableDueDate(v *time.Time) *VendorBillUpdate {
+	if v != nil {
+		_u.SetDueDate(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *VendorBillUpdate) SetCurrency(v string) *VendorBillUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableCurrency(v *string) *VendorBillUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (_u *VendorBillUpdate) SetSubtotal(v decimal.Decimal) *VendorBillUpdate {
+	_u.mutation.ResetSubtotal()
+	_u.mutation.SetSubtotal(v)
+	return _u
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableSubtotal(v *decimal.Decimal) *VendorBillUpdate {
+	if v != nil {
+		_u.SetSubtotal(*v)
+	}
+	return _u
+}
+
+// AddSubtotal adds value to the "subtotal" field.
+func (_u *VendorBillUpdate) AddSubtotal(v decimal.Decimal) *VendorBillUpdate {
+	_u.mutation.AddSubtotal(v)
+	return _u
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (_u *VendorBillUpdate) SetTaxAmount(v decimal.Decimal) *VendorBillUpdate {
+	_u.mutation.ResetTaxAmount()
+	_u.mutation.SetTaxAmount(v)
+	return _u
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableTaxAmount(v *decimal.Decimal) *VendorBillUpdate {
+	if v != nil {
+		_u.SetTaxAmount(*v)
+	}
+	return _u
+}
+
+// AddTaxAmount adds value to the "tax_amount" field.
+func (_u *VendorBillUpdate) AddTaxAmount(v decimal.Decimal) *VendorBillUpdate {
+	_u.mutation.AddTaxAmount(v)
+	return _u
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (_u *VendorBillUpdate) ClearTaxAmount() *VendorBillUpdate {
+	_u.mutation.ClearTaxAmount()
+	return _u
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (_u *VendorBillUpdate) SetTotalAmount(v decimal.Decimal) *VendorBillUpdate {
+	_u.mutation.ResetTotalAmount()
+	_u.mutation.SetTotalAmount(v)
+	return _u
+}
+
+// SetNillableTotalAmount sets the "total_amount" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableTotalAmount(v *decimal.Decimal) *VendorBillUpdate {
+	if v != nil {
+		_u.SetTotalAmount(*v)
+	}
+	return _u
+}
+
+// AddTotalAmount adds value to the "total_amount" field.
+func (_u *VendorBillUpdate) AddTotalAmount(v decimal.Decimal) *VendorBillUpdate {
+	_u.mutation.AddTotalAmount(v)
+	return _u
+}
+
+// SetPaidAmount sets the "paid_amount" field.
+func (_u *VendorBillUpdate) SetPaidAmount(v decimal.Decimal) *VendorBillUpdate {
+	_u.mutation.ResetPaidAmount()
+	_u.mutation.SetPaidAmount(v)
+	return _u
+}
+
+// SetNillablePaidAmount sets the "paid_amount" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillablePaidAmount(v *decimal.Decimal) *VendorBillUpdate {
+	if v != nil {
+		_u.SetPaidAmount(*v)
+	}
+	return _u
+}
+
+// AddPaidAmount adds value to the "paid_amount" field.
+func (_u *VendorBillUpdate) AddPaidAmount(v decimal.Decimal) *VendorBillUpdate {
+	_u.mutation.AddPaidAmount(v)
+	return _u
+}
+
+// ClearPaidAmount clears the value of the "paid_amount" field.
+func (_u *VendorBillUpdate) ClearPaidAmount() *VendorBillUpdate {
+	_u.mutation.ClearPaidAmount()
+	return _u
+}
+
+// SetDiscountRate sets the "discount_rate" field.
+func (_u *VendorBillUpdate) SetDiscountRate(v decimal.Decimal) *VendorBillUpdate {
+	_u.mutation.ResetDiscountRate()
+	_u.mutation.SetDiscountRate(v)
+	return _u
+}
+
+// SetNillableDiscountRate sets the "discount_rate" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableDiscountRate(v *decimal.Decimal) *VendorBillUpdate {
+	if v != nil {
+		_u.SetDiscountRate(*v)
+	}
+	return _u
+}
+
+// AddDiscountRate adds value to the "discount_rate" field.
+func (_u *VendorBillUpdate) AddDiscountRate(v decimal.Decimal) *VendorBillUpdate {
+	_u.mutation.AddDiscountRate(v)
+	return _u
+}
+
+// ClearDiscountRate clears the value of the "discount_rate" field.
+func (_u *VendorBillUpdate) ClearDiscountRate() *VendorBillUpdate {
+	_u.mutation.ClearDiscountRate()
+	return _u
+}
+
+// SetDiscountUntil sets the "discount_until" field.
+func (_u *VendorBillUpdate) SetDiscountUntil(v time.Time) *VendorBillUpdate {
+	_u.mutation.SetDiscountUntil(v)
+	return _u
+}
+
+// SetNillableDiscountUntil sets the "discount_until" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableDiscountUntil(v *time.Time) *VendorBillUpdate {
+	if v != nil {
+		_u.SetDiscountUntil(*v)
+	}
+	return _u
+}
+
+// ClearDiscountUntil clears the value of the "discount_until" field.
+func (_u *VendorBillUpdate) ClearDiscountUntil() *VendorBillUpdate {
+	_u.mutation.ClearDiscountUntil()
+	return _u
+}
+
+// SetDiscountTaken sets the "discount_taken" field.
+func (_u *VendorBillUpdate) SetDiscountTaken(v decimal.Decimal) *VendorBillUpdate {
+	_u.mutation.ResetDiscountTaken()
+	_u.mutation.SetDiscountTaken(v)
+	return _u
+}
+
+// SetNillableDiscountTaken sets the "discount_taken" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableDiscountTaken(v *decimal.Decimal) *VendorBillUpdate {
+	if v != nil {
+		_u.SetDiscountTaken(*v)
+	}
+	return _u
+}
+
+// AddDiscountTaken adds value to the "discount_taken" field.
+func (_u *VendorBillUpdate) AddDiscountTaken(v decimal.Decimal) *VendorBillUpdate {
+	_u.mutation.AddDiscountTaken(v)
+	return _u
+}
+
+// ClearDiscountTaken clears the value of the "discount_taken" field.
+func (_u *VendorBillUpdate) ClearDiscountTaken() *VendorBillUpdate {
+	_u.mutation.ClearDiscountTaken()
+	return _u
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (_u *VendorBillUpdate) SetWithholdingAmount(v decimal.Decimal) *VendorBillUpdate {
+	_u.mutation.ResetWithholdingAmount()
+	_u.mutation.SetWithholdingAmount(v)
+	return _u
+}
+
+// SetNillableWithholdingAmount sets the "withholding_amount" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableWithholdingAmount(v *decimal.Decimal) *VendorBillUpdate {
+	if v != nil {
+		_u.SetWithholdingAmount(*v)
+	}
+	return _u
+}
+
+// AddWithholdingAmount adds value to the "withholding_amount" field.
+func (_u *VendorBillUpdate) AddWithholdingAmount(v decimal.Decimal) *VendorBillUpdate {
+	_u.mutation.AddWithholdingAmount(v)
+	return _u
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (_u *VendorBillUpdate) ClearWithholdingAmount() *VendorBillUpdate {
+	_u.mutation.ClearWithholdingAmount()
+	return _u
+}
+
+// SetWithholdingTaken sets the "withholding_taken" field.
+func (_u *VendorBillUpdate) SetWithholdingTaken(v decimal.Decimal) *VendorBillUpdate {
+	_u.mutation.ResetWithholdingTaken()
+	_u.mutation.SetWithholdingTaken(v)
+	return _u
+}
+
+// SetNillableWithholdingTaken sets the "withholding_taken" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableWithholdingTaken(v *decimal.Decimal) *VendorBillUpdate {
+	if v != nil {
+		_u.SetWithholdingTaken(*v)
+	}
+	return _u
+}
+
+// AddWithholdingTaken adds value to the "withholding_taken" field.
+func (_u *VendorBillUpdate) AddWithholdingTaken(v decimal.Decimal) *VendorBillUpdate {
+	_u.mutation.AddWithholdingTaken(v)
+	return _u
+}
+
+// ClearWithholdingTaken clears the value of the "withholding_taken" field.
+func (_u *VendorBillUpdate) ClearWithholdingTaken() *VendorBillUpdate {
+	_u.mutation.ClearWithholdingTaken()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *VendorBillUpdate) SetStatus(v string) *VendorBillUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableStatus(v *string) *VendorBillUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttachments sets the "attachments" field.
+func (_u *VendorBillUpdate) SetAttachments(v []string) *VendorBillUpdate {
+	_u.mutation.SetAttachments(v)
+	return _u
+}
+
+// AppendAttachments appends value to the "attachments" field.
+func (_u *VendorBillUpdate) AppendAttachments(v []string) *VendorBillUpdate {
+	_u.mutation.AppendAttachments(v)
+	return _u
+}
+
+// ClearAttachments clears the value of the "attachments" field.
+func (_u *VendorBillUpdate) ClearAttachments() *VendorBillUpdate {
+	_u.mutation.ClearAttachments()
+	return _u
+}
+
+// SetReferenceType sets the "reference_type" field.
+func (_u *VendorBillUpdate) SetReferenceType(v string) *VendorBillUpdate {
+	_u.mutation.SetReferenceType(v)
+	return _u
+}
+
+// SetNillableReferenceType sets the "reference_type" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableReferenceType(v *string) *VendorBillUpdate {
+	if v != nil {
+		_u.SetReferenceType(*v)
+	}
+	return _u
+}
+
+// ClearReferenceType clears the value of the "reference_type" field.
+func (_u *VendorBillUpdate) ClearReferenceType() *VendorBillUpdate {
+	_u.mutation.ClearReferenceType()
+	return _u
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (_u *VendorBillUpdate) SetReferenceID(v string) *VendorBillUpdate {
+	_u.mutation.SetReferenceID(v)
+	return _u
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableReferenceID(v *string) *VendorBillUpdate {
+	if v != nil {
+		_u.SetReferenceID(*v)
+	}
+	return _u
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (_u *VendorBillUpdate) ClearReferenceID() *VendorBillUpdate {
+	_u.mutation.ClearReferenceID()
+	return _u
+}
+
+// SetPoNumber sets the "po_number" field.
+func (_u *VendorBillUpdate) SetPoNumber(v string) *VendorBillUpdate {
+	_u.mutation.SetPoNumber(v)
+	return _u
+}
+
+// SetNillablePoNumber sets the "po_number" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillablePoNumber(v *string) *VendorBillUpdate {
+	if v != nil {
+		_u.SetPoNumber(*v)
+	}
+	return _u
+}
+
+// ClearPoNumber clears the value of the "po_number" field.
+func (_u *VendorBillUpdate) ClearPoNumber() *VendorBillUpdate {
+	_u.mutation.ClearPoNumber()
+	return _u
+}
+
+// SetMatchStatus sets the "match_status" field.
+func (_u *VendorBillUpdate) SetMatchStatus(v string) *VendorBillUpdate {
+	_u.mutation.SetMatchStatus(v)
+	return _u
+}
+
+// SetNillableMatchStatus sets the "match_status" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableMatchStatus(v *string) *VendorBillUpdate {
+	if v != nil {
+		_u.SetMatchStatus(*v)
+	}
+	return _u
+}
+
+// ClearMatchStatus clears the value of the "match_status" field.
+func (_u *VendorBillUpdate) ClearMatchStatus() *VendorBillUpdate {
+	_u.mutation.ClearMatchStatus()
+	return _u
+}
+
+// SetMatchedAt sets the "matched_at" field.
+func (_u *VendorBillUpdate) SetMatchedAt(v time.Time) *VendorBillUpdate {
+	_u.mutation.SetMatchedAt(v)
+	return _u
+}
+
+// SetNillableMatchedAt sets the "matched_at" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableMatchedAt(v *time.Time) *VendorBillUpdate {
+	if v != nil {
+		_u.SetMatchedAt(*v)
+	}
+	return _u
+}
+
+// ClearMatchedAt clears the value of the "matched_at" field.
+func (_u *VendorBillUpdate) ClearMatchedAt() *VendorBillUpdate {
+	_u.mutation.ClearMatchedAt()
+	return _u
+}
+
+// SetVarianceAcceptedBy sets the "variance_accepted_by" field.
+func (_u *VendorBillUpdate) SetVarianceAcceptedBy(v uuid.UUID) *VendorBillUpdate {
+	_u.mutation.SetVarianceAcceptedBy(v)
+	return _u
+}
+
+// SetNillableVarianceAcceptedBy sets the "variance_accepted_by" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableVarianceAcceptedBy(v *uuid.UUID) *VendorBillUpdate {
+	if v != nil {
+		_u.SetVarianceAcceptedBy(*v)
+	}
+	return _u
+}
+
+// ClearVarianceAcceptedBy clears the value of the "variance_accepted_by" field.
+func (_u *VendorBillUpdate) ClearVarianceAcceptedBy() *VendorBillUpdate {
+	_u.mutation.ClearVarianceAcceptedBy()
+	return _u
+}
+
+// SetVarianceAcceptedAt sets the "variance_accepted_at" field.
+func (_u *VendorBillUpdate) SetVarianceAcceptedAt(v time.Time) *VendorBillUpdate {
+	_u.mutation.SetVarianceAcceptedAt(v)
+	return _u
+}
+
+// SetNillableVarianceAcceptedAt sets the "variance_accepted_at" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableVarianceAcceptedAt(v *time.Time) *VendorBillUpdate {
+	if v != nil {
+		_u.SetVarianceAcceptedAt(*v)
+	}
+	return _u
+}
+
+// ClearVarianceAcceptedAt clears the value of the "variance_accepted_at" field.
+func (_u *VendorBillUpdate) ClearVarianceAcceptedAt() *VendorBillUpdate {
+	_u.mutation.ClearVarianceAcceptedAt()
+	return _u
+}
+
+// SetVarianceReason sets the "variance_reason" field.
+func (_u *VendorBillUpdate) SetVarianceReason(v string) *VendorBillUpdate {
+	_u.mutation.SetVarianceReason(v)
+	return _u
+}
+
+// SetNillableVarianceReason sets the "variance_reason" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableVarianceReason(v *string) *VendorBillUpdate {
+	if v != nil {
+		_u.SetVarianceReason(*v)
+	}
+	return _u
+}
+
+// ClearVarianceReason clears the value of the "variance_reason" field.
+func (_u *VendorBillUpdate) ClearVarianceReason() *VendorBillUpdate {
+	_u.mutation.ClearVarianceReason()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *VendorBillUpdate) SetNotes(v string) *VendorBillUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableNotes(v *string) *VendorBillUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *VendorBillUpdate) ClearNotes() *VendorBillUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *VendorBillUpdate) SetCreatedBy(v uuid.UUID) *VendorBillUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableCreatedBy(v *uuid.UUID) *VendorBillUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *VendorBillUpdate) ClearCreatedBy() *VendorBillUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_u *VendorBillUpdate) SetApprovedBy(v uuid.UUID) *VendorBillUpdate {
+	_u.mutation.SetApprovedBy(v)
+	return _u
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableApprovedBy(v *uuid.UUID) *VendorBillUpdate {
+	if v != nil {
+		_u.SetApprovedBy(*v)
+	}
+	return _u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (_u *VendorBillUpdate) ClearApprovedBy() *VendorBillUpdate {
+	_u.mutation.ClearApprovedBy()
+	return _u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_u *VendorBillUpdate) SetApprovedAt(v time.Time) *VendorBillUpdate {
+	_u.mutation.SetApprovedAt(v)
+	return _u
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableApprovedAt(v *time.Time) *VendorBillUpdate {
+	if v != nil {
+		_u.SetApprovedAt(*v)
+	}
+	return _u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (_u *VendorBillUpdate) ClearApprovedAt() *VendorBillUpdate {
+	_u.mutation.ClearApprovedAt()
+	return _u
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (_u *VendorBillUpdate) SetScheduledFor(v time.Time) *VendorBillUpdate {
+	_u.mutation.SetScheduledFor(v)
+	return _u
+}
+
+// SetNillableScheduledFor sets the "scheduled_for" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableScheduledFor(v *time.Time) *VendorBillUpdate {
+	if v != nil {
+		_u.SetScheduledFor(*v)
+	}
+	return _u
+}
+
+// ClearScheduledFor clears the value of the "scheduled_for" field.
+func (_u *VendorBillUpdate) ClearScheduledFor() *VendorBillUpdate {
+	_u.mutation.ClearScheduledFor()
+	return _u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_u *VendorBillUpdate) SetPaidAt(v time.Time) *VendorBillUpdate {
+	_u.mutation.SetPaidAt(v)
+	return _u
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillablePaidAt(v *time.Time) *VendorBillUpdate {
+	if v != nil {
+		_u.SetPaidAt(*v)
+	}
+	return _u
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (_u *VendorBillUpdate) ClearPaidAt() *VendorBillUpdate {
+	_u.mutation.ClearPaidAt()
+	return _u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_u *VendorBillUpdate) SetJournalEntryID(v uuid.UUID) *VendorBillUpdate {
+	_u.mutation.SetJournalEntryID(v)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_u *VendorBillUpdate) SetNillableJournalEntryID(v *uuid.UUID) *VendorBillUpdate {
+	if v != nil {
+		_u.SetJournalEntryID(*v)
+	}
+	return _u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (_u *VendorBillUpdate) ClearJournalEntryID() *VendorBillUpdate {
+	_u.mutation.ClearJournalEntryID()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *VendorBillUpdate) SetMetadata(v map[string]interface{}) *VendorBillUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VendorBillUpdate) SetUpdatedAt(v time.Time) *VendorBillUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddLineIDs adds the "lines" edge to the VendorBillLine entity by IDs.
+func (_u *VendorBillUpdate) AddLineIDs(ids ...uuid.UUID) *VendorBillUpdate {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the VendorBillLine entity.
+func (_u *VendorBillUpdate) AddLines(v ...*VendorBillLine) *VendorBillUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
+// Mutation returns the VendorBillMutation object of the builder.
+func (_u *VendorBillUpdate) Mutation() *VendorBillMutation {
+	return _u.mutation
+}
+
+// ClearLines clears all "lines" edges to the VendorBillLine entity.
+func (_u *VendorBillUpdate) ClearLines() *VendorBillUpdate {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to VendorBillLine entities by IDs.
+func (_u *VendorBillUpdate) RemoveLineIDs(ids ...uuid.UUID) *VendorBillUpdate {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to VendorBillLine entities.
+func (_u *VendorBillUpdate) RemoveLines(v ...*VendorBillLine) *VendorBillUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VendorBillUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VendorBillUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VendorBillUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VendorBillUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VendorBillUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := vendorbill.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *VendorBillUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(vendorbill.Table, vendorbill.Columns, sqlgraph.NewFieldSpec(vendorbill.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(vendorbill.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.VendorID(); ok {
+		_spec.SetField(vendorbill.FieldVendorID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.VendorReference(); ok {
+		_spec.SetField(vendorbill.FieldVendorReference, field.TypeString, value)
+	}
+	if _u.mutation.VendorReferenceCleared() {
+		_spec.ClearField(vendorbill.FieldVendorReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.BillDate(); ok {
+		_spec.SetField(vendorbill.FieldBillDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DueDate(); ok {
+		_spec.SetField(vendorbill.FieldDueDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(vendorbill.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subtotal(); ok {
+		_spec.SetField(vendorbill.FieldSubtotal, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSubtotal(); ok {
+		_spec.AddField(vendorbill.FieldSubtotal, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TaxAmount(); ok {
+		_spec.SetField(vendorbill.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTaxAmount(); ok {
+		_spec.AddField(vendorbill.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.TaxAmountCleared() {
+		_spec.ClearField(vendorbill.FieldTaxAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TotalAmount(); ok {
+		_spec.SetField(vendorbill.FieldTotalAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalAmount(); ok {
+		_spec.AddField(vendorbill.FieldTotalAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PaidAmount(); ok {
+		_spec.SetField(vendorbill.FieldPaidAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPaidAmount(); ok {
+		_spec.AddField(vendorbill.FieldPaidAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.PaidAmountCleared() {
+		_spec.ClearField(vendorbill.FieldPaidAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.DiscountRate(); ok {
+		_spec.SetField(vendorbill.FieldDiscountRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountRate(); ok {
+		_spec.AddField(vendorbill.FieldDiscountRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.DiscountRateCleared() {
+		_spec.ClearField(vendorbill.FieldDiscountRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.DiscountUntil(); ok {
+		_spec.SetField(vendorbill.FieldDiscountUntil, field.TypeTime, value)
+	}
+	if _u.mutation.DiscountUntilCleared() {
+		_spec.ClearField(vendorbill.FieldDiscountUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DiscountTaken(); ok {
+		_spec.SetField(vendorbill.FieldDiscountTaken, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountTaken(); ok {
+		_spec.AddField(vendorbill.FieldDiscountTaken, field.TypeFloat64, value)
+	}
+	if _u.mutation.DiscountTakenCleared() {
+		_spec.ClearField(vendorbill.FieldDiscountTaken, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.WithholdingAmount(); ok {
+		_spec.SetField(vendorbill.FieldWithholdingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWithholdingAmount(); ok {
+		_spec.AddField(vendorbill.FieldWithholdingAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.WithholdingAmountCleared() {
+		_spec.ClearField(vendorbill.FieldWithholdingAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.WithholdingTaken(); ok {
+		_spec.SetField(vendorbill.FieldWithholdingTaken, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWithholdingTaken(); ok {
+		_spec.AddField(vendorbill.FieldWithholdingTaken, field.TypeFloat64, value)
+	}
+	if _u.mutation.WithholdingTakenCleared() {
+		_spec.ClearField(vendorbill.FieldWithholdingTaken, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(vendorbill.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attachments(); ok {
+		_spec.SetField(vendorbill.FieldAttachments, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttachments(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vendorbill.FieldAttachments, value)
+		})
+	}
+	if _u.mutation.AttachmentsCleared() {
+		_spec.ClearField(vendorbill.FieldAttachments, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ReferenceType(); ok {
+		_spec.SetField(vendorbill.FieldReferenceType, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceTypeCleared() {
+		_spec.ClearField(vendorbill.FieldReferenceType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferenceID(); ok {
+		_spec.SetField(vendorbill.FieldReferenceID, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceIDCleared() {
+		_spec.ClearField(vendorbill.FieldReferenceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PoNumber(); ok {
+		_spec.SetField(vendorbill.FieldPoNumber, field.TypeString, value)
+	}
+	if _u.mutation.PoNumberCleared() {
+		_spec.ClearField(vendorbill.FieldPoNumber, field.TypeString)
+	}
+	if value, ok := _u.mutation.MatchStatus(); ok {
+		_spec.SetField(vendorbill.FieldMatchStatus, field.TypeString, value)
+	}
+	if _u.mutation.MatchStatusCleared() {
+		_spec.ClearField(vendorbill.FieldMatchStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.MatchedAt(); ok {
+		_spec.SetField(vendorbill.FieldMatchedAt, field.TypeTime, value)
+	}
+	if _u.mutation.MatchedAtCleared() {
+		_spec.ClearField(vendorbill.FieldMatchedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VarianceAcceptedBy(); ok {
+		_spec.SetField(vendorbill.FieldVarianceAcceptedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.VarianceAcceptedByCleared() {
+		_spec.ClearField(vendorbill.FieldVarianceAcceptedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.VarianceAcceptedAt(); ok {
+		_spec.SetField(vendorbill.FieldVarianceAcceptedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VarianceAcceptedAtCleared() {
+		_spec.ClearField(vendorbill.FieldVarianceAcceptedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VarianceReason(); ok {
+		_spec.SetField(vendorbill.FieldVarianceReason, field.TypeString, value)
+	}
+	if _u.mutation.VarianceReasonCleared() {
+		_spec.ClearField(vendorbill.FieldVarianceReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(vendorbill.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(vendorbill.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(vendorbill.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(vendorbill.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ApprovedBy(); ok {
+		_spec.SetField(vendorbill.FieldApprovedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ApprovedByCleared() {
+		_spec.ClearField(vendorbill.FieldApprovedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ApprovedAt(); ok {
+		_spec.SetField(vendorbill.FieldApprovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ApprovedAtCleared() {
+		_spec.ClearField(vendorbill.FieldApprovedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ScheduledFor(); ok {
+		_spec.SetField(vendorbill.FieldScheduledFor, field.TypeTime, value)
+	}
+	if _u.mutation.ScheduledForCleared() {
+		_spec.ClearField(vendorbill.FieldScheduledFor, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PaidAt(); ok {
+		_spec.SetField(vendorbill.FieldPaidAt, field.TypeTime, value)
+	}
+	if _u.mutation.PaidAtCleared() {
+		_spec.ClearField(vendorbill.FieldPaidAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.JournalEntryID(); ok {
+		_spec.SetField(vendorbill.FieldJournalEntryID, field.TypeUUID, value)
+	}
+	if _u.mutation.JournalEntryIDCleared() {
+		_spec.ClearField(vendorbill.FieldJournalEntryID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(vendorbill.FieldMetadata, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(vendorbill.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vendorbill.LinesTable,
+			Columns: []string{vendorbill.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendorbillline.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vendorbill.LinesTable,
+			Columns: []string{vendorbill.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendorbillline.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vendorbill.LinesTable,
+			Columns: []string{vendorbill.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendorbillline.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vendorbill.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VendorBillUpdateOne is the builder for updating a single VendorBill entity.
+type VendorBillUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VendorBillMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *VendorBillUpdateOne) SetTenantID(v uuid.UUID) *VendorBillUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableTenantID(v *uuid.UUID) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_u *VendorBillUpdateOne) SetVendorID(v uuid.UUID) *VendorBillUpdateOne {
+	_u.mutation.SetVendorID(v)
+	return _u
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableVendorID(v *uuid.UUID) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetVendorID(*v)
+	}
+	return _u
+}
+
+// SetVendorReference sets the "vendor_reference" field.
+func (_u *VendorBillUpdateOne) SetVendorReference(v string) *VendorBillUpdateOne {
+	_u.mutation.SetVendorReference(v)
+	return _u
+}
+
+// SetNillableVendorReference sets the "vendor_reference" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableVendorReference(v *string) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetVendorReference(*v)
+	}
+	return _u
+}
+
+// ClearVendorReference clears the value of the "vendor_reference" field.
+func (_u *VendorBillUpdateOne) ClearVendorReference() *VendorBillUpdateOne {
+	_u.mutation.ClearVendorReference()
+	return _u
+}
+
+// SetBillDate sets the "bill_date" field.
+func (_u *VendorBillUpdateOne) SetBillDate(v time.Time) *VendorBillUpdateOne {
+	_u.mutation.SetBillDate(v)
+	return _u
+}
+
+// SetNillableBillDate sets the "bill_date" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableBillDate(v *time.Time) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetBillDate(*v)
+	}
+	return _u
+}
+
+// SetDueDate sets the "due_date" field.
+func (_u *VendorBillUpdateOne) SetDueDate(v time.Time) *VendorBillUpdateOne {
+	_u.mutation.SetDueDate(v)
+	return _u
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableDueDate(v *time.Time) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetDueDate(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *VendorBillUpdateOne) SetCurrency(v string) *VendorBillUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableCurrency(v *string) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (_u *VendorBillUpdateOne) SetSubtotal(v decimal.Decimal) *VendorBillUpdateOne {
+	_u.mutation.ResetSubtotal()
+	_u.mutation.SetSubtotal(v)
+	return _u
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableSubtotal(v *decimal.Decimal) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetSubtotal(*v)
+	}
+	return _u
+}
+
+// AddSubtotal adds value to the "subtotal" field.
+func (_u *VendorBillUpdateOne) AddSubtotal(v decimal.Decimal) *VendorBillUpdateOne {
+	_u.mutation.AddSubtotal(v)
+	return _u
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (_u *VendorBillUpdateOne) SetTaxAmount(v decimal.Decimal) *VendorBillUpdateOne {
+	_u.mutation.ResetTaxAmount()
+	_u.mutation.SetTaxAmount(v)
+	return _u
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableTaxAmount(v *decimal.Decimal) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetTaxAmount(*v)
+	}
+	return _u
+}
+
+// AddTaxAmount adds value to the "tax_amount" field.
+func (_u *VendorBillUpdateOne) AddTaxAmount(v decimal.Decimal) *VendorBillUpdateOne {
+	_u.mutation.AddTaxAmount(v)
+	return _u
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (_u *VendorBillUpdateOne) ClearTaxAmount() *VendorBillUpdateOne {
+	_u.mutation.ClearTaxAmount()
+	return _u
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (_u *VendorBillUpdateOne) SetTotalAmount(v decimal.Decimal) *VendorBillUpdateOne {
+	_u.mutation.ResetTotalAmount()
+	_u.mutation.SetTotalAmount(v)
+	return _u
+}
+
+// SetNillableTotalAmount sets the "total_amount" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableTotalAmount(v *decimal.Decimal) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetTotalAmount(*v)
+	}
+	return _u
+}
+
+// AddTotalAmount adds value to the "total_amount" field.
+func (_u *VendorBillUpdateOne) AddTotalAmount(v decimal.Decimal) *VendorBillUpdateOne {
+	_u.mutation.AddTotalAmount(v)
+	return _u
+}
+
+// SetPaidAmount sets the "paid_amount" field.
+func (_u *VendorBillUpdateOne) SetPaidAmount(v decimal.Decimal) *VendorBillUpdateOne {
+	_u.mutation.ResetPaidAmount()
+	_u.mutation.SetPaidAmount(v)
+	return _u
+}
+
+// SetNillablePaidAmount sets the "paid_amount" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillablePaidAmount(v *decimal.Decimal) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetPaidAmount(*v)
+	}
+	return _u
+}
+
+// AddPaidAmount adds value to the "paid_amount" field.
+func (_u *VendorBillUpdateOne) AddPaidAmount(v decimal.Decimal) *VendorBillUpdateOne {
+	_u.mutation.AddPaidAmount(v)
+	return _u
+}
+
+// ClearPaidAmount clears the value of the "paid_amount" field.
+func (_u *VendorBillUpdateOne) ClearPaidAmount() *VendorBillUpdateOne {
+	_u.mutation.ClearPaidAmount()
+	return _u
+}
+
+// SetDiscountRate sets the "discount_rate" field.
+func (_u *VendorBillUpdateOne) SetDiscountRate(v decimal.Decimal) *VendorBillUpdateOne {
+	_u.mutation.ResetDiscountRate()
+	_u.mutation.SetDiscountRate(v)
+	return _u
+}
+
+// SetNillableDiscountRate sets the "discount_rate" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableDiscountRate(v *decimal.Decimal) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetDiscountRate(*v)
+	}
+	return _u
+}
+
+// AddDiscountRate adds value to the "discount_rate" field.
+func (_u *VendorBillUpdateOne) AddDiscountRate(v decimal.Decimal) *VendorBillUpdateOne {
+	_u.mutation.AddDiscountRate(v)
+	return _u
+}
+
+// ClearDiscountRate clears the value of the "discount_rate" field.
+func (_u *VendorBillUpdateOne) ClearDiscountRate() *VendorBillUpdateOne {
+	_u.mutation.ClearDiscountRate()
+	return _u
+}
+
+// SetDiscountUntil sets the "discount_until" field.
+func (_u *VendorBillUpdateOne) SetDiscountUntil(v time.Time) *VendorBillUpdateOne {
+	_u.mutation.SetDiscountUntil(v)
+	return _u
+}
+
+// SetNillableDiscountUntil sets the "discount_until" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableDiscountUntil(v *time.Time) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetDiscountUntil(*v)
+	}
+	return _u
+}
+
+// ClearDiscountUntil clears the value of the "discount_until" field.
+func (_u *VendorBillUpdateOne) ClearDiscountUntil() *VendorBillUpdateOne {
+	_u.mutation.ClearDiscountUntil()
+	return _u
+}
+
+// SetDiscountTaken sets the "discount_taken" field.
+func (_u *VendorBillUpdateOne) SetDiscountTaken(v decimal.Decimal) *VendorBillUpdateOne {
+	_u.mutation.ResetDiscountTaken()
+	_u.mutation.SetDiscountTaken(v)
+	return _u
+}
+
+// SetNillableDiscountTaken sets the "discount_taken" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableDiscountTaken(v *decimal.Decimal) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetDiscountTaken(*v)
+	}
+	return _u
+}
+
+// AddDiscountTaken adds value to the "discount_taken" field.
+func (_u *VendorBillUpdateOne) AddDiscountTaken(v decimal.Decimal) *VendorBillUpdateOne {
+	_u.mutation.AddDiscountTaken(v)
+	return _u
+}
+
+// ClearDiscountTaken clears the value of the "discount_taken" field.
+func (_u *VendorBillUpdateOne) ClearDiscountTaken() *VendorBillUpdateOne {
+	_u.mutation.ClearDiscountTaken()
+	return _u
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (_u *VendorBillUpdateOne) SetWithholdingAmount(v decimal.Decimal) *VendorBillUpdateOne {
+	_u.mutation.ResetWithholdingAmount()
+	_u.mutation.SetWithholdingAmount(v)
+	return _u
+}
+
+// SetNillableWithholdingAmount sets the "withholding_amount" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableWithholdingAmount(v *decimal.Decimal) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetWithholdingAmount(*v)
+	}
+	return _u
+}
+
+// AddWithholdingAmount adds value to the "withholding_amount" field.
+func (_u *VendorBillUpdateOne) AddWithholdingAmount(v decimal.Decimal) *VendorBillUpdateOne {
+	_u.mutation.AddWithholdingAmount(v)
+	return _u
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (_u *VendorBillUpdateOne) ClearWithholdingAmount() *VendorBillUpdateOne {
+	_u.mutation.ClearWithholdingAmount()
+	return _u
+}
+
+// SetWithholdingTaken sets the "withholding_taken" field.
+func (_u *VendorBillUpdateOne) SetWithholdingTaken(v decimal.Decimal) *VendorBillUpdateOne {
+	_u.mutation.ResetWithholdingTaken()
+	_u.mutation.SetWithholdingTaken(v)
+	return _u
+}
+
+// SetNillableWithholdingTaken sets the "withholding_taken" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableWithholdingTaken(v *decimal.Decimal) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetWithholdingTaken(*v)
+	}
+	return _u
+}
+
+// AddWithholdingTaken adds value to the "withholding_taken" field.
+func (_u *VendorBillUpdateOne) AddWithholdingTaken(v decimal.Decimal) *VendorBillUpdateOne {
+	_u.mutation.AddWithholdingTaken(v)
+	return _u
+}
+
+// ClearWithholdingTaken clears the value of the "withholding_taken" field.
+func (_u *VendorBillUpdateOne) ClearWithholdingTaken() *VendorBillUpdateOne {
+	_u.mutation.ClearWithholdingTaken()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *VendorBillUpdateOne) SetStatus(v string) *VendorBillUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableStatus(v *string) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttachments sets the "attachments" field.
+func (_u *VendorBillUpdateOne) SetAttachments(v []string) *VendorBillUpdateOne {
+	_u.mutation.SetAttachments(v)
+	return _u
+}
+
+// AppendAttachments appends value to the "attachments" field.
+func (_u *VendorBillUpdateOne) AppendAttachments(v []string) *VendorBillUpdateOne {
+	_u.mutation.AppendAttachments(v)
+	return _u
+}
+
+// ClearAttachments clears the value of the "attachments" field.
+func (_u *VendorBillUpdateOne) ClearAttachments() *VendorBillUpdateOne {
+	_u.mutation.ClearAttachments()
+	return _u
+}
+
+// SetReferenceType sets the "reference_type" field.
+func (_u *VendorBillUpdateOne) SetReferenceType(v string) *VendorBillUpdateOne {
+	_u.mutation.SetReferenceType(v)
+	return _u
+}
+
+// SetNillableReferenceType sets the "reference_type" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableReferenceType(v *string) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetReferenceType(*v)
+	}
+	return _u
+}
+
+// ClearReferenceType clears the value of the "reference_type" field.
+func (_u *VendorBillUpdateOne) ClearReferenceType() *VendorBillUpdateOne {
+	_u.mutation.ClearReferenceType()
+	return _u
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (_u *VendorBillUpdateOne) SetReferenceID(v string) *VendorBillUpdateOne {
+	_u.mutation.SetReferenceID(v)
+	return _u
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableReferenceID(v *string) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetReferenceID(*v)
+	}
+	return _u
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (_u *VendorBillUpdateOne) ClearReferenceID() *VendorBillUpdateOne {
+	_u.mutation.ClearReferenceID()
+	return _u
+}
+
+// SetPoNumber sets the "po_number" field.
+func (_u *VendorBillUpdateOne) SetPoNumber(v string) *VendorBillUpdateOne {
+	_u.mutation.SetPoNumber(v)
+	return _u
+}
+
+// SetNillablePoNumber sets the "po_number" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillablePoNumber(v *string) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetPoNumber(*v)
+	}
+	return _u
+}
+
+// ClearPoNumber clears the value of the "po_number" field.
+func (_u *VendorBillUpdateOne) ClearPoNumber() *VendorBillUpdateOne {
+	_u.mutation.ClearPoNumber()
+	return _u
+}
+
+// SetMatchStatus sets the "match_status" field.
+func (_u *VendorBillUpdateOne) SetMatchStatus(v string) *VendorBillUpdateOne {
+	_u.mutation.SetMatchStatus(v)
+	return _u
+}
+
+// SetNillableMatchStatus sets the "match_status" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableMatchStatus(v *string) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetMatchStatus(*v)
+	}
+	return _u
+}
+
+// ClearMatchStatus clears the value of the "match_status" field.
+func (_u *VendorBillUpdateOne) ClearMatchStatus() *VendorBillUpdateOne {
+	_u.mutation.ClearMatchStatus()
+	return _u
+}
+
+// SetMatchedAt sets the "matched_at" field.
+func (_u *VendorBillUpdateOne) SetMatchedAt(v time.Time) *VendorBillUpdateOne {
+	_u.mutation.SetMatchedAt(v)
+	return _u
+}
+
+// SetNillableMatchedAt sets the "matched_at" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableMatchedAt(v *time.Time) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetMatchedAt(*v)
+	}
+	return _u
+}
+
+// ClearMatchedAt clears the value of the "matched_at" field.
+func (_u *VendorBillUpdateOne) ClearMatchedAt() *VendorBillUpdateOne {
+	_u.mutation.ClearMatchedAt()
+	return _u
+}
+
+// SetVarianceAcceptedBy sets the "variance_accepted_by" field.
+func (_u *VendorBillUpdateOne) SetVarianceAcceptedBy(v uuid.UUID) *VendorBillUpdateOne {
+	_u.mutation.SetVarianceAcceptedBy(v)
+	return _u
+}
+
+// SetNillableVarianceAcceptedBy sets the "variance_accepted_by" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableVarianceAcceptedBy(v *uuid.UUID) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetVarianceAcceptedBy(*v)
+	}
+	return _u
+}
+
+// ClearVarianceAcceptedBy clears the value of the "variance_accepted_by" field.
+func (_u *VendorBillUpdateOne) ClearVarianceAcceptedBy() *VendorBillUpdateOne {
+	_u.mutation.ClearVarianceAcceptedBy()
+	return _u
+}
+
+// SetVarianceAcceptedAt sets the "variance_accepted_at" field.
+func (_u *VendorBillUpdateOne) SetVarianceAcceptedAt(v time.Time) *VendorBillUpdateOne {
+	_u.mutation.SetVarianceAcceptedAt(v)
+	return _u
+}
+
+// SetNillableVarianceAcceptedAt sets the "variance_accepted_at" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableVarianceAcceptedAt(v *time.Time) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetVarianceAcceptedAt(*v)
+	}
+	return _u
+}
+
+// ClearVarianceAcceptedAt clears the value of the "variance_accepted_at" field.
+func (_u *VendorBillUpdateOne) ClearVarianceAcceptedAt() *VendorBillUpdateOne {
+	_u.mutation.ClearVarianceAcceptedAt()
+	return _u
+}
+
+// SetVarianceReason sets the "variance_reason" field.
+func (_u *VendorBillUpdateOne) SetVarianceReason(v string) *VendorBillUpdateOne {
+	_u.mutation.SetVarianceReason(v)
+	return _u
+}
+
+// SetNillableVarianceReason sets the "variance_reason" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableVarianceReason(v *string) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetVarianceReason(*v)
+	}
+	return _u
+}
+
+// ClearVarianceReason clears the value of the "variance_reason" field.
+func (_u *VendorBillUpdateOne) ClearVarianceReason() *VendorBillUpdateOne {
+	_u.mutation.ClearVarianceReason()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *VendorBillUpdateOne) SetNotes(v string) *VendorBillUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableNotes(v *string) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *VendorBillUpdateOne) ClearNotes() *VendorBillUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *VendorBillUpdateOne) SetCreatedBy(v uuid.UUID) *VendorBillUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableCreatedBy(v *uuid.UUID) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *VendorBillUpdateOne) ClearCreatedBy() *VendorBillUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_u *VendorBillUpdateOne) SetApprovedBy(v uuid.UUID) *VendorBillUpdateOne {
+	_u.mutation.SetApprovedBy(v)
+	return _u
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableApprovedBy(v *uuid.UUID) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetApprovedBy(*v)
+	}
+	return _u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (_u *VendorBillUpdateOne) ClearApprovedBy() *VendorBillUpdateOne {
+	_u.mutation.ClearApprovedBy()
+	return _u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_u *VendorBillUpdateOne) SetApprovedAt(v time.Time) *VendorBillUpdateOne {
+	_u.mutation.SetApprovedAt(v)
+	return _u
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableApprovedAt(v *time.Time) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetApprovedAt(*v)
+	}
+	return _u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (_u *VendorBillUpdateOne) ClearApprovedAt() *VendorBillUpdateOne {
+	_u.mutation.ClearApprovedAt()
+	return _u
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (_u *VendorBillUpdateOne) SetScheduledFor(v time.Time) *VendorBillUpdateOne {
+	_u.mutation.SetScheduledFor(v)
+	return _u
+}
+
+// SetNillableScheduledFor sets the "scheduled_for" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableScheduledFor(v *time.Time) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetScheduledFor(*v)
+	}
+	return _u
+}
+
+// ClearScheduledFor clears the value of the "scheduled_for" field.
+func (_u *VendorBillUpdateOne) ClearScheduledFor() *VendorBillUpdateOne {
+	_u.mutation.ClearScheduledFor()
+	return _u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_u *VendorBillUpdateOne) SetPaidAt(v time.Time) *VendorBillUpdateOne {
+	_u.mutation.SetPaidAt(v)
+	return _u
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillablePaidAt(v *time.Time) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetPaidAt(*v)
+	}
+	return _u
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (_u *VendorBillUpdateOne) ClearPaidAt() *VendorBillUpdateOne {
+	_u.mutation.ClearPaidAt()
+	return _u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_u *VendorBillUpdateOne) SetJournalEntryID(v uuid.UUID) *VendorBillUpdateOne {
+	_u.mutation.SetJournalEntryID(v)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_u *VendorBillUpdateOne) SetNillableJournalEntryID(v *uuid.UUID) *VendorBillUpdateOne {
+	if v != nil {
+		_u.SetJournalEntryID(*v)
+	}
+	return _u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (_u *VendorBillUpdateOne) ClearJournalEntryID() *VendorBillUpdateOne {
+	_u.mutation.ClearJournalEntryID()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *VendorBillUpdateOne) SetMetadata(v map[string]interface{}) *VendorBillUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VendorBillUpdateOne) SetUpdatedAt(v time.Time) *VendorBillUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddLineIDs adds the "lines" edge to the VendorBillLine entity by IDs.
+func (_u *VendorBillUpdateOne) AddLineIDs(ids ...uuid.UUID) *VendorBillUpdateOne {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the VendorBillLine entity.
+func (_u *VendorBillUpdateOne) AddLines(v ...*VendorBillLine) *VendorBillUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
+// Mutation returns the VendorBillMutation object of the builder.
+func (_u *VendorBillUpdateOne) Mutation() *VendorBillMutation {
+	return _u.mutation
+}
+
+// ClearLines clears all "lines" edges to the VendorBillLine entity.
+func (_u *VendorBillUpdateOne) ClearLines() *VendorBillUpdateOne {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to VendorBillLine entities by IDs.
+func (_u *VendorBillUpdateOne) RemoveLineIDs(ids ...uuid.UUID) *VendorBillUpdateOne {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to VendorBillLine entities.
+func (_u *VendorBillUpdateOne) RemoveLines(v ...*VendorBillLine) *VendorBillUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
+// Where appends a list predicates to the VendorBillUpdate builder.
+func (_u *VendorBillUpdateOne) Where(ps ...predicate.VendorBill) *VendorBillUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VendorBillUpdateOne) Select(field string, fields ...string) *VendorBillUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated VendorBill entity.
+func (_u *VendorBillUpdateOne) Save(ctx context.Context) (*VendorBill, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VendorBillUpdateOne) SaveX(ctx context.Context) *VendorBill {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VendorBillUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VendorBillUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VendorBillUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := vendorbill.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *VendorBillUpdateOne) sqlSave(ctx context.Context) (_node *VendorBill, err error) {
+	_spec := sqlgraph.NewUpdateSpec(vendorbill.Table, vendorbill.Columns, sqlgraph.NewFieldSpec(vendorbill.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VendorBill.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vendorbill.FieldID)
+		for _, f := range fields {
+			if !vendorbill.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vendorbill.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(vendorbill.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.VendorID(); ok {
+		_spec.SetField(vendorbill.FieldVendorID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.VendorReference(); ok {
+		_spec.SetField(vendorbill.FieldVendorReference, field.TypeString, value)
+	}
+	if _u.mutation.VendorReferenceCleared() {
+		_spec.ClearField(vendorbill.FieldVendorReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.BillDate(); ok {
+		_spec.SetField(vendorbill.FieldBillDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DueDate(); ok {
+		_spec.SetField(vendorbill.FieldDueDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(vendorbill.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subtotal(); ok {
+		_spec.SetField(vendorbill.FieldSubtotal, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSubtotal(); ok {
+		_spec.AddField(vendorbill.FieldSubtotal, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TaxAmount(); ok {
+		_spec.SetField(vendorbill.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTaxAmount(); ok {
+		_spec.AddField(vendorbill.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.TaxAmountCleared() {
+		_spec.ClearField(vendorbill.FieldTaxAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TotalAmount(); ok {
+		_spec.SetField(vendorbill.FieldTotalAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalAmount(); ok {
+		_spec.AddField(vendorbill.FieldTotalAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PaidAmount(); ok {
+		_spec.SetField(vendorbill.FieldPaidAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPaidAmount(); ok {
+		_spec.AddField(vendorbill.FieldPaidAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.PaidAmountCleared() {
+		_spec.ClearField(vendorbill.FieldPaidAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.DiscountRate(); ok {
+		_spec.SetField(vendorbill.FieldDiscountRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountRate(); ok {
+		_spec.AddField(vendorbill.FieldDiscountRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.DiscountRateCleared() {
+		_spec.ClearField(vendorbill.FieldDiscountRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.DiscountUntil(); ok {
+		_spec.SetField(vendorbill.FieldDiscountUntil, field.TypeTime, value)
+	}
+	if _u.mutation.DiscountUntilCleared() {
+		_spec.ClearField(vendorbill.FieldDiscountUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DiscountTaken(); ok {
+		_spec.SetField(vendorbill.FieldDiscountTaken, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountTaken(); ok {
+		_spec.AddField(vendorbill.FieldDiscountTaken, field.TypeFloat64, value)
+	}
+	if _u.mutation.DiscountTakenCleared() {
+		_spec.ClearField(vendorbill.FieldDiscountTaken, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.WithholdingAmount(); ok {
+		_spec.SetField(vendorbill.FieldWithholdingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWithholdingAmount(); ok {
+		_spec.AddField(vendorbill.FieldWithholdingAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.WithholdingAmountCleared() {
+		_spec.ClearField(vendorbill.FieldWithholdingAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.WithholdingTaken(); ok {
+		_spec.SetField(vendorbill.FieldWithholdingTaken, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWithholdingTaken(); ok {
+		_spec.AddField(vendorbill.FieldWithholdingTaken, field.TypeFloat64, value)
+	}
+	if _u.mutation.WithholdingTakenCleared() {
+		_spec.ClearField(vendorbill.FieldWithholdingTaken, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(vendorbill.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attachments(); ok {
+		_spec.SetField(vendorbill.FieldAttachments, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttachments(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vendorbill.FieldAttachments, value)
+		})
+	}
+	if _u.mutation.AttachmentsCleared() {
+		_spec.ClearField(vendorbill.FieldAttachments, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ReferenceType(); ok {
+		_spec.SetField(vendorbill.FieldReferenceType, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceTypeCleared() {
+		_spec.ClearField(vendorbill.FieldReferenceType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferenceID(); ok {
+		_spec.SetField(vendorbill.FieldReferenceID, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceIDCleared() {
+		_spec.ClearField(vendorbill.FieldReferenceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PoNumber(); ok {
+		_spec.SetField(vendorbill.FieldPoNumber, field.TypeString, value)
+	}
+	if _u.mutation.PoNumberCleared() {
+		_spec.ClearField(vendorbill.FieldPoNumber, field.TypeString)
+	}
+	if value, ok := _u.mutation.MatchStatus(); ok {
+		_spec.SetField(vendorbill.FieldMatchStatus, field.TypeString, value)
+	}
+	if _u.mutation.MatchStatusCleared() {
+		_spec.ClearField(vendorbill.FieldMatchStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.MatchedAt(); ok {
+		_spec.SetField(vendorbill.FieldMatchedAt, field.TypeTime, value)
+	}
+	if _u.mutation.MatchedAtCleared() {
+		_spec.ClearField(vendorbill.FieldMatchedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VarianceAcceptedBy(); ok {
+		_spec.SetField(vendorbill.FieldVarianceAcceptedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.VarianceAcceptedByCleared() {
+		_spec.ClearField(vendorbill.FieldVarianceAcceptedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.VarianceAcceptedAt(); ok {
+		_spec.SetField(vendorbill.FieldVarianceAcceptedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VarianceAcceptedAtCleared() {
+		_spec.ClearField(vendorbill.FieldVarianceAcceptedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VarianceReason(); ok {
+		_spec.SetField(vendorbill.FieldVarianceReason, field.TypeString, value)
+	}
+	if _u.mutation.VarianceReasonCleared() {
+		_spec.ClearField(vendorbill.FieldVarianceReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(vendorbill.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(vendorbill.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(vendorbill.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(vendorbill.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ApprovedBy(); ok {
+		_spec.SetField(vendorbill.FieldApprovedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ApprovedByCleared() {
+		_spec.ClearField(vendorbill.FieldApprovedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ApprovedAt(); ok {
+		_spec.SetField(vendorbill.FieldApprovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ApprovedAtCleared() {
+		_spec.ClearField(vendorbill.FieldApprovedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ScheduledFor(); ok {
+		_spec.SetField(vendorbill.FieldScheduledFor, field.TypeTime, value)
+	}
+	if _u.mutation.ScheduledForCleared() {
+		_spec.ClearField(vendorbill.FieldScheduledFor, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PaidAt(); ok {
+		_spec.SetField(vendorbill.FieldPaidAt, field.TypeTime, value)
+	}
+	if _u.mutation.PaidAtCleared() {
+		_spec.ClearField(vendorbill.FieldPaidAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.JournalEntryID(); ok {
+		_spec.SetField(vendorbill.FieldJournalEntryID, field.TypeUUID, value)
+	}
+	if _u.mutation.JournalEntryIDCleared() {
+		_spec.ClearField(vendorbill.FieldJournalEntryID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(vendorbill.FieldMetadata, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(vendorbill.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vendorbill.LinesTable,
+			Columns: []string{vendorbill.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendorbillline.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vendorbill.LinesTable,
+			Columns: []string{vendorbill.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendorbillline.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vendorbill.LinesTable,
+			Columns: []string{vendorbill.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendorbillline.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &VendorBill{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vendorbill.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,363 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbill"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbillline"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// VendorBillLine is the model entity for the VendorBillLine schema.
+type VendorBillLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Vendor bill identifier
+	BillID uuid.UUID `json:"bill_id,omitempty"`
+	// Line sequence number
+	LineNumber int `json:"line_number,omitempty"`
+	// Item code matched against purchase order receipt lines
+	ItemCode string `json:"item_code,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity decimal.Decimal `json:"quantity,omitempty"`
+	// UnitPrice holds the value of the "unit_price" field.
+	UnitPrice decimal.Decimal `json:"unit_price,omitempty"`
+	// Expense or asset account debited for the line
+	AccountCode string `json:"account_code,omitempty"`
+	// Tax code: VAT16, VAT8, ZERO, EXEMPT
+	TaxCode string `json:"tax_code,omitempty"`
+	// Tax rate as a fraction (0.16 for 16% VAT)
+	TaxRate decimal.Decimal `json:"tax_rate,omitempty"`
+	// Tax amount (defaults to zero)
+	TaxAmount decimal.Decimal `json:"tax_amount,omitempty"`
+	// Line total before tax (quantity * unit_price)
+	LineTotal decimal.Decimal `json:"line_total,omitempty"`
+	// Service category withholding tax is deducted under
+	WithholdingCategory string `json:"withholding_category,omitempty"`
+	// Withholding tax rate as a fraction (defaults to zero)
+	WithholdingRate decimal.Decimal `json:"withholding_rate,omitempty"`
+	// Withholding tax on the line total (defaults to zero)
+	WithholdingAmount decimal.Decimal `json:"withholding_amount,omitempty"`
+	// Line match: matched, quantity_variance, price_variance, not_received
+	MatchStatus string `json:"match_status,omitempty"`
+	// Quantity received and not yet billed when last matched
+	ReceivedQuantity *decimal.Decimal `json:"received_quantity,omitempty"`
+	// Purchase order unit price when last matched
+	OrderUnitPrice *decimal.Decimal `json:"order_unit_price,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the VendorBillLineQuery when eager-loading is set.
+	Edges        VendorBillLineEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// VendorBillLineEdges holds the relations/edges for other nodes in the graph.
+type VendorBillLineEdges struct {
+	// Bill holds the value of the bill edge.
+	Bill *VendorBill `json:"bill,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BillOrErr returns the Bill value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VendorBillLineEdges) BillOrErr() (*VendorBill, error) {
+	if e.Bill != nil {
+		return e.Bill, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: vendorbill.Label}
+	}
+	return nil, &NotLoadedError{edge: "bill"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VendorBillLine) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vendorbillline.FieldReceivedQuantity, vendorbillline.FieldOrderUnitPrice:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case vendorbillline.FieldMetadata:
+			values[i] = new([]byte)
+		case vendorbillline.FieldQuantity, vendorbillline.FieldUnitPrice, vendorbillline.FieldTaxRate, vendorbillline.FieldTaxAmount, vendorbillline.FieldLineTotal, vendorbillline.FieldWithholdingRate, vendorbillline.FieldWithholdingAmount:
+			values[i] = new(decimal.Decimal)
+		case vendorbillline.FieldLineNumber:
+			values[i] = new(sql.NullInt64)
+		case vendorbillline.FieldItemCode, vendorbillline.FieldDescription, vendorbillline.FieldAccountCode, vendorbillline.FieldTaxCode, vendorbillline.FieldWithholdingCategory, vendorbillline.FieldMatchStatus:
+			values[i] = new(sql.NullString)
+		case vendorbillline.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case vendorbillline.FieldID, vendorbillline.FieldTenantID, vendorbillline.FieldBillID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VendorBillLine fields.
+func (_m *VendorBillLine) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vendorbillline.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case vendorbillline.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case vendorbillline.FieldBillID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field bill_id", values[i])
+			} else if value != nil {
+				_m.BillID = *value
+			}
+		case vendorbillline.FieldLineNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field line_number", values[i])
+			} else if value.Valid {
+				_m.LineNumber = int(value.Int64)
+			}
+		case vendorbillline.FieldItemCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_code", values[i])
+			} else if value.Valid {
+				_m.ItemCode = value.String
+			}
+		case vendorbillline.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case vendorbillline.FieldQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value != nil {
+				_m.Quantity = *value
+			}
+		case vendorbillline.FieldUnitPrice:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_price", values[i])
+			} else if value != nil {
+				_m.UnitPrice = *value
+			}
+		case vendorbillline.FieldAccountCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_code", values[i])
+			} else if value.Valid {
+				_m.AccountCode = value.String
+			}
+		case vendorbillline.FieldTaxCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_code", values[i])
+			} else if value.Valid {
+				_m.TaxCode = value.String
+			}
+		case vendorbillline.FieldTaxRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_rate", values[i])
+			} else if value != nil {
+				_m.TaxRate = *value
+			}
+		case vendorbillline.FieldTaxAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_amount", values[i])
+			} else if value != nil {
+				_m.TaxAmount = *value
+			}
+		case vendorbillline.FieldLineTotal:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field line_total", values[i])
+			} else if value != nil {
+				_m.LineTotal = *value
+			}
+		case vendorbillline.FieldWithholdingCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field withholding_category", values[i])
+			} else if value.Valid {
+				_m.WithholdingCategory = value.String
+			}
+		case vendorbillline.FieldWithholdingRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field withholding_rate", values[i])
+			} else if value != nil {
+				_m.WithholdingRate = *value
+			}
+		case vendorbillline.FieldWithholdingAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field withholding_amount", values[i])
+			} else if value != nil {
+				_m.WithholdingAmount = *value
+			}
+		case vendorbillline.FieldMatchStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field match_status", values[i])
+			} else if value.Valid {
+				_m.MatchStatus = value.String
+			}
+		case vendorbillline.FieldReceivedQuantity:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field received_quantity", values[i])
+			} else if value.Valid {
+				_m.ReceivedQuantity = new(decimal.Decimal)
+				*_m.ReceivedQuantity = *value.S.(*decimal.Decimal)
+			}
+		case vendorbillline.FieldOrderUnitPrice:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field order_unit_price", values[i])
+			} else if value.Valid {
+				_m.OrderUnitPrice = new(decimal.Decimal)
+				*_m.OrderUnitPrice = *value.S.(*decimal.Decimal)
+			}
+		case vendorbillline.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case vendorbillline.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VendorBillLine.
+// This includes values selected through modifiers, order, etc.
+func (_m *VendorBillLine) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBill queries the "bill" edge of the VendorBillLine entity.
+func (_m *VendorBillLine) QueryBill() *VendorBillQuery {
+	return NewVendorBillLineClient(_m.config).QueryBill(_m)
+}
+
+// Update returns a builder for updating this VendorBillLine.
+// Note that you need to call VendorBillLine.Unwrap() before calling this method if this VendorBillLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *VendorBillLine) Update() *VendorBillLineUpdateOne {
+	return NewVendorBillLineClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the VendorBillLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *VendorBillLine) Unwrap() *VendorBillLine {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VendorBillLine is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *VendorBillLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("VendorBillLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("bill_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BillID))
+	builder.WriteString(", ")
+	builder.WriteString("line_number=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LineNumber))
+	builder.WriteString(", ")
+	builder.WriteString("item_code=")
+	builder.WriteString(_m.ItemCode)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("unit_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UnitPrice))
+	builder.WriteString(", ")
+	builder.WriteString("account_code=")
+	builder.WriteString(_m.AccountCode)
+	builder.WriteString(", ")
+	builder.WriteString("tax_code=")
+	builder.WriteString(_m.TaxCode)
+	builder.WriteString(", ")
+	builder.WriteString("tax_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxRate))
+	builder.WriteString(", ")
+	builder.WriteString("tax_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxAmount))
+	builder.WriteString(", ")
+	builder.WriteString("line_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LineTotal))
+	builder.WriteString(", ")
+	builder.WriteString("withholding_category=")
+	builder.WriteString(_m.WithholdingCategory)
+	builder.WriteString(", ")
+	builder.WriteString("withholding_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WithholdingRate))
+	builder.WriteString(", ")
+	builder.WriteString("withholding_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WithholdingAmount))
+	builder.WriteString(", ")
+	builder.WriteString("match_status=")
+	builder.WriteString(_m.MatchStatus)
+	builder.WriteString(", ")
+	if v := _m.ReceivedQuantity; v != nil {
+		builder.WriteString("received_quantity=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.OrderUnitPrice; v != nil {
+		builder.WriteString("order_unit_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VendorBillLines is a parsable slice of VendorBillLine.
+type VendorBillLines []*VendorBillLine
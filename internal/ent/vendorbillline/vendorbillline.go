@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vendorbillline
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the vendorbillline type in the database.
+	Label = "vendor_bill_line"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldBillID holds the string denoting the bill_id field in the database.
+	FieldBillID = "bill_id"
+	// FieldLineNumber holds the string denoting the line_number field in the database.
+	FieldLineNumber = "line_number"
+	// FieldItemCode holds the string denoting the item_code field in the database.
+	FieldItemCode = "item_code"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldUnitPrice holds the string denoting the unit_price field in the database.
+	FieldUnitPrice = "unit_price"
+	// FieldAccountCode holds the string denoting the account_code field in the database.
+	FieldAccountCode = "account_code"
+	// FieldTaxCode holds the string denoting the tax_code field in the database.
+	FieldTaxCode = "tax_code"
+	// FieldTaxRate holds the string denoting the tax_rate field in the database.
+	FieldTaxRate = "tax_rate"
+	// FieldTaxAmount holds the string denoting the tax_amount field in the database.
+	FieldTaxAmount = "tax_amount"
+	// FieldLineTotal holds the string denoting the line_total field in the database.
+	FieldLineTotal = "line_total"
+	// FieldWithholdingCategory holds the string denoting the withholding_category field in the database.
+	FieldWithholdingCategory = "withholding_category"
+	// FieldWithholdingRate holds the string denoting the withholding_rate field in the database.
+	FieldWithholdingRate = "withholding_rate"
+	// FieldWithholdingAmount holds the string denoting the withholding_amount field in the database.
+	FieldWithholdingAmount = "withholding_amount"
+	// FieldMatchStatus holds the string denoting the match_status field in the database.
+	FieldMatchStatus = "match_status"
+	// FieldReceivedQuantity holds the string denoting the received_quantity field in the database.
+	FieldReceivedQuantity = "received_quantity"
+	// FieldOrderUnitPrice holds the string denoting the order_unit_price field in the database.
+	FieldOrderUnitPrice = "order_unit_price"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeBill holds the string denoting the bill edge name in mutations.
+	EdgeBill = "bill"
+	// Table holds the table name of the vendorbillline in the database.
+	Table = "vendor_bill_lines"
+	// BillTable is the table that holds the bill relation/edge.
+	BillTable = "vendor_bill_lines"
+	// BillInverseTable is the table name for the VendorBill entity.
+	// It exists in this package in order to avoid circular dependency with the "vendorbill" package.
+	BillInverseTable = "vendor_bills"
+	// BillColumn is the table column denoting the bill relation/edge.
+	BillColumn = "bill_id"
+)
+
+// Columns holds all SQL columns for vendorbillline fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldBillID,
+	FieldLineNumber,
+	FieldItemCode,
+	FieldDescription,
+	FieldQuantity,
+	FieldUnitPrice,
+	FieldAccountCode,
+	FieldTaxCode,
+	FieldTaxRate,
+	FieldTaxAmount,
+	FieldLineTotal,
+	FieldWithholdingCategory,
+	FieldWithholdingRate,
+	FieldWithholdingAmount,
+	FieldMatchStatus,
+	FieldReceivedQuantity,
+	FieldOrderUnitPrice,
+	FieldMetadata,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	DescriptionValidator func(string) error
+	// AccountCodeValidator is a validator for the "account_code" field. It is called by the builders before save.
+	AccountCodeValidator func(string) error
+	// DefaultMetadata holds the default value on creation for the "metadata" field.
+	DefaultMetadata map[string]interface{}
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the VendorBillLine queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByBillID orders the results by the bill_id field.
+func ByBillID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillID, opts...).ToFunc()
+}
+
+// ByLineNumber orders the results by the line_number field.
+func ByLineNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLineNumber, opts...).ToFunc()
+}
+
+// ByItemCode orders the results by the item_code field.
+func ByItemCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemCode, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByUnitPrice orders the results by the unit_price field.
+func ByUnitPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnitPrice, opts...).ToFunc()
+}
+
+// ByAccountCode orders the results by the account_code field.
+func ByAccountCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountCode, opts...).ToFunc()
+}
+
+// ByTaxCode orders the results by the tax_code field.
+func ByTaxCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxCode, opts...).ToFunc()
+}
+
+// ByTaxRate orders the results by the tax_rate field.
+func ByTaxRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxRate, opts...).ToFunc()
+}
+
+// ByTaxAmount orders the results by the tax_amount field.
+func ByTaxAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxAmount, opts...).ToFunc()
+}
+
+// ByLineTotal orders the results by the line_total field.
+func ByLineTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLineTotal, opts...).ToFunc()
+}
+
+// ByWithholdingCategory orders the results by the withholding_category field.
+func ByWithholdingCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWithholdingCategory, opts...).ToFunc()
+}
+
+// ByWithholdingRate orders the results by the withholding_rate field.
+func ByWithholdingRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWithholdingRate, opts...).ToFunc()
+}
+
+// ByWithholdingAmount orders the results by the withholding_amount field.
+func ByWithholdingAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWithholdingAmount, opts...).ToFunc()
+}
+
+// ByMatchStatus orders the results by the match_status field.
+func ByMatchStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatchStatus, opts...).ToFunc()
+}
+
+// ByReceivedQuantity orders the results by the received_quantity field.
+func ByReceivedQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedQuantity, opts...).ToFunc()
+}
+
+// ByOrderUnitPrice orders the results by the order_unit_price field.
+func ByOrderUnitPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderUnitPrice, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByBillField orders the results by bill field.
+func ByBillField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBillStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBillStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BillInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BillTable, BillColumn),
+	)
+}
@@ -0,0 +1,1181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vendorbillline
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldTenantID, v))
+}
+
+// BillID applies equality check predicate on the "bill_id" field. It's identical to BillIDEQ.
+func BillID(v uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldBillID, v))
+}
+
+// LineNumber applies equality check predicate on the "line_number" field. It's identical to LineNumberEQ.
+func LineNumber(v int) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldLineNumber, v))
+}
+
+// ItemCode applies equality check predicate on the "item_code" field. It's identical to ItemCodeEQ.
+func ItemCode(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldItemCode, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldDescription, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldQuantity, v))
+}
+
+// UnitPrice applies equality check predicate on the "unit_price" field. It's identical to UnitPriceEQ.
+func UnitPrice(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// AccountCode applies equality check predicate on the "account_code" field. It's identical to AccountCodeEQ.
+func AccountCode(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldAccountCode, v))
+}
+
+// TaxCode applies equality check predicate on the "tax_code" field. It's identical to TaxCodeEQ.
+func TaxCode(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldTaxCode, v))
+}
+
+// TaxRate applies equality check predicate on the "tax_rate" field. It's identical to TaxRateEQ.
+func TaxRate(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldTaxRate, v))
+}
+
+// TaxAmount applies equality check predicate on the "tax_amount" field. It's identical to TaxAmountEQ.
+func TaxAmount(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldTaxAmount, v))
+}
+
+// LineTotal applies equality check predicate on the "line_total" field. It's identical to LineTotalEQ.
+func LineTotal(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldLineTotal, v))
+}
+
+// WithholdingCategory applies equality check predicate on the "withholding_category" field. It's identical to WithholdingCategoryEQ.
+func WithholdingCategory(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldWithholdingCategory, v))
+}
+
+// WithholdingRate applies equality check predicate on the "withholding_rate" field. It's identical to WithholdingRateEQ.
+func WithholdingRate(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldWithholdingRate, v))
+}
+
+// WithholdingAmount applies equality check predicate on the "withholding_amount" field. It's identical to WithholdingAmountEQ.
+func WithholdingAmount(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldWithholdingAmount, v))
+}
+
+// MatchStatus applies equality check predicate on the "match_status" field. It's identical to MatchStatusEQ.
+func MatchStatus(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldMatchStatus, v))
+}
+
+// ReceivedQuantity applies equality check predicate on the "received_quantity" field. It's identical to ReceivedQuantityEQ.
+func ReceivedQuantity(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldReceivedQuantity, v))
+}
+
+// OrderUnitPrice applies equality check predicate on the "order_unit_price" field. It's identical to OrderUnitPriceEQ.
+func OrderUnitPrice(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldOrderUnitPrice, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldTenantID, v))
+}
+
+// BillIDEQ applies the EQ predicate on the "bill_id" field.
+func BillIDEQ(v uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldBillID, v))
+}
+
+// BillIDNEQ applies the NEQ predicate on the "bill_id" field.
+func BillIDNEQ(v uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldBillID, v))
+}
+
+// BillIDIn applies the In predicate on the "bill_id" field.
+func BillIDIn(vs ...uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldBillID, vs...))
+}
+
+// BillIDNotIn applies the NotIn predicate on the "bill_id" field.
+func BillIDNotIn(vs ...uuid.UUID) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldBillID, vs...))
+}
+
+// LineNumberEQ applies the EQ predicate on the "line_number" field.
+func LineNumberEQ(v int) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldLineNumber, v))
+}
+
+// LineNumberNEQ applies the NEQ predicate on the "line_number" field.
+func LineNumberNEQ(v int) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldLineNumber, v))
+}
+
+// LineNumberIn applies the In predicate on the "line_number" field.
+func LineNumberIn(vs ...int) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldLineNumber, vs...))
+}
+
+// LineNumberNotIn applies the NotIn predicate on the "line_number" field.
+func LineNumberNotIn(vs ...int) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldLineNumber, vs...))
+}
+
+// LineNumberGT applies the GT predicate on the "line_number" field.
+func LineNumberGT(v int) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldLineNumber, v))
+}
+
+// LineNumberGTE applies the GTE predicate on the "line_number" field.
+func LineNumberGTE(v int) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldLineNumber, v))
+}
+
+// LineNumberLT applies the LT predicate on the "line_number" field.
+func LineNumberLT(v int) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldLineNumber, v))
+}
+
+// LineNumberLTE applies the LTE predicate on the "line_number" field.
+func LineNumberLTE(v int) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldLineNumber, v))
+}
+
+// ItemCodeEQ applies the EQ predicate on the "item_code" field.
+func ItemCodeEQ(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldItemCode, v))
+}
+
+// ItemCodeNEQ applies the NEQ predicate on the "item_code" field.
+func ItemCodeNEQ(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldItemCode, v))
+}
+
+// ItemCodeIn applies the In predicate on the "item_code" field.
+func ItemCodeIn(vs ...string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldItemCode, vs...))
+}
+
+// ItemCodeNotIn applies the NotIn predicate on the "item_code" field.
+func ItemCodeNotIn(vs ...string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldItemCode, vs...))
+}
+
+// ItemCodeGT applies the GT predicate on the "item_code" field.
+func ItemCodeGT(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldItemCode, v))
+}
+
+// ItemCodeGTE applies the GTE predicate on the "item_code" field.
+func ItemCodeGTE(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldItemCode, v))
+}
+
+// ItemCodeLT applies the LT predicate on the "item_code" field.
+func ItemCodeLT(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldItemCode, v))
+}
+
+// ItemCodeLTE applies the LTE predicate on the "item_code" field.
+func ItemCodeLTE(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldItemCode, v))
+}
+
+// ItemCodeContains applies the Contains predicate on the "item_code" field.
+func ItemCodeContains(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldContains(FieldItemCode, v))
+}
+
+// ItemCodeHasPrefix applies the HasPrefix predicate on the "item_code" field.
+func ItemCodeHasPrefix(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldHasPrefix(FieldItemCode, v))
+}
+
+// ItemCodeHasSuffix applies the HasSuffix predicate on the "item_code" field.
+func ItemCodeHasSuffix(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldHasSuffix(FieldItemCode, v))
+}
+
+// ItemCodeIsNil applies the IsNil predicate on the "item_code" field.
+func ItemCodeIsNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIsNull(FieldItemCode))
+}
+
+// ItemCodeNotNil applies the NotNil predicate on the "item_code" field.
+func ItemCodeNotNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotNull(FieldItemCode))
+}
+
+// ItemCodeEqualFold applies the EqualFold predicate on the "item_code" field.
+func ItemCodeEqualFold(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEqualFold(FieldItemCode, v))
+}
+
+// ItemCodeContainsFold applies the ContainsFold predicate on the "item_code" field.
+func ItemCodeContainsFold(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldContainsFold(FieldItemCode, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldQuantity, v))
+}
+
+// UnitPriceEQ applies the EQ predicate on the "unit_price" field.
+func UnitPriceEQ(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceNEQ applies the NEQ predicate on the "unit_price" field.
+func UnitPriceNEQ(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceIn applies the In predicate on the "unit_price" field.
+func UnitPriceIn(vs ...decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceNotIn applies the NotIn predicate on the "unit_price" field.
+func UnitPriceNotIn(vs ...decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceGT applies the GT predicate on the "unit_price" field.
+func UnitPriceGT(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldUnitPrice, v))
+}
+
+// UnitPriceGTE applies the GTE predicate on the "unit_price" field.
+func UnitPriceGTE(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldUnitPrice, v))
+}
+
+// UnitPriceLT applies the LT predicate on the "unit_price" field.
+func UnitPriceLT(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldUnitPrice, v))
+}
+
+// UnitPriceLTE applies the LTE predicate on the "unit_price" field.
+func UnitPriceLTE(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldUnitPrice, v))
+}
+
+// AccountCodeEQ applies the EQ predicate on the "account_code" field.
+func AccountCodeEQ(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldAccountCode, v))
+}
+
+// AccountCodeNEQ applies the NEQ predicate on the "account_code" field.
+func AccountCodeNEQ(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldAccountCode, v))
+}
+
+// AccountCodeIn applies the In predicate on the "account_code" field.
+func AccountCodeIn(vs ...string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldAccountCode, vs...))
+}
+
+// AccountCodeNotIn applies the NotIn predicate on the "account_code" field.
+func AccountCodeNotIn(vs ...string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldAccountCode, vs...))
+}
+
+// AccountCodeGT applies the GT predicate on the "account_code" field.
+func AccountCodeGT(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldAccountCode, v))
+}
+
+// AccountCodeGTE applies the GTE predicate on the "account_code" field.
+func AccountCodeGTE(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldAccountCode, v))
+}
+
+// AccountCodeLT applies the LT predicate on the "account_code" field.
+func AccountCodeLT(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldAccountCode, v))
+}
+
+// AccountCodeLTE applies the LTE predicate on the "account_code" field.
+func AccountCodeLTE(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldAccountCode, v))
+}
+
+// AccountCodeContains applies the Contains predicate on the "account_code" field.
+func AccountCodeContains(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldContains(FieldAccountCode, v))
+}
+
+// AccountCodeHasPrefix applies the HasPrefix predicate on the "account_code" field.
+func AccountCodeHasPrefix(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldHasPrefix(FieldAccountCode, v))
+}
+
+// AccountCodeHasSuffix applies the HasSuffix predicate on the "account_code" field.
+func AccountCodeHasSuffix(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldHasSuffix(FieldAccountCode, v))
+}
+
+// AccountCodeEqualFold applies the EqualFold predicate on the "account_code" field.
+func AccountCodeEqualFold(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEqualFold(FieldAccountCode, v))
+}
+
+// AccountCodeContainsFold applies the ContainsFold predicate on the "account_code" field.
+func AccountCodeContainsFold(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldContainsFold(FieldAccountCode, v))
+}
+
+// TaxCodeEQ applies the EQ predicate on the "tax_code" field.
+func TaxCodeEQ(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldTaxCode, v))
+}
+
+// TaxCodeNEQ applies the NEQ predicate on the "tax_code" field.
+func TaxCodeNEQ(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldTaxCode, v))
+}
+
+// TaxCodeIn applies the In predicate on the "tax_code" field.
+func TaxCodeIn(vs ...string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldTaxCode, vs...))
+}
+
+// TaxCodeNotIn applies the NotIn predicate on the "tax_code" field.
+func TaxCodeNotIn(vs ...string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldTaxCode, vs...))
+}
+
+// TaxCodeGT applies the GT predicate on the "tax_code" field.
+func TaxCodeGT(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldTaxCode, v))
+}
+
+// TaxCodeGTE applies the GTE predicate on the "tax_code" field.
+func TaxCodeGTE(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldTaxCode, v))
+}
+
+// TaxCodeLT applies the LT predicate on the "tax_code" field.
+func TaxCodeLT(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldTaxCode, v))
+}
+
+// TaxCodeLTE applies the LTE predicate on the "tax_code" field.
+func TaxCodeLTE(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldTaxCode, v))
+}
+
+// TaxCodeContains applies the Contains predicate on the "tax_code" field.
+func TaxCodeContains(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldContains(FieldTaxCode, v))
+}
+
+// TaxCodeHasPrefix applies the HasPrefix predicate on the "tax_code" field.
+func TaxCodeHasPrefix(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldHasPrefix(FieldTaxCode, v))
+}
+
+// TaxCodeHasSuffix applies the HasSuffix predicate on the "tax_code" field.
+func TaxCodeHasSuffix(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldHasSuffix(FieldTaxCode, v))
+}
+
+// TaxCodeIsNil applies the IsNil predicate on the "tax_code" field.
+func TaxCodeIsNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIsNull(FieldTaxCode))
+}
+
+// TaxCodeNotNil applies the NotNil predicate on the "tax_code" field.
+func TaxCodeNotNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotNull(FieldTaxCode))
+}
+
+// TaxCodeEqualFold applies the EqualFold predicate on the "tax_code" field.
+func TaxCodeEqualFold(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEqualFold(FieldTaxCode, v))
+}
+
+// TaxCodeContainsFold applies the ContainsFold predicate on the "tax_code" field.
+func TaxCodeContainsFold(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldContainsFold(FieldTaxCode, v))
+}
+
+// TaxRateEQ applies the EQ predicate on the "tax_rate" field.
+func TaxRateEQ(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldTaxRate, v))
+}
+
+// TaxRateNEQ applies the NEQ predicate on the "tax_rate" field.
+func TaxRateNEQ(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldTaxRate, v))
+}
+
+// TaxRateIn applies the In predicate on the "tax_rate" field.
+func TaxRateIn(vs ...decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldTaxRate, vs...))
+}
+
+// TaxRateNotIn applies the NotIn predicate on the "tax_rate" field.
+func TaxRateNotIn(vs ...decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldTaxRate, vs...))
+}
+
+// TaxRateGT applies the GT predicate on the "tax_rate" field.
+func TaxRateGT(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldTaxRate, v))
+}
+
+// TaxRateGTE applies the GTE predicate on the "tax_rate" field.
+func TaxRateGTE(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldTaxRate, v))
+}
+
+// TaxRateLT applies the LT predicate on the "tax_rate" field.
+func TaxRateLT(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldTaxRate, v))
+}
+
+// TaxRateLTE applies the LTE predicate on the "tax_rate" field.
+func TaxRateLTE(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldTaxRate, v))
+}
+
+// TaxRateIsNil applies the IsNil predicate on the "tax_rate" field.
+func TaxRateIsNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIsNull(FieldTaxRate))
+}
+
+// TaxRateNotNil applies the NotNil predicate on the "tax_rate" field.
+func TaxRateNotNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotNull(FieldTaxRate))
+}
+
+// TaxAmountEQ applies the EQ predicate on the "tax_amount" field.
+func TaxAmountEQ(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldTaxAmount, v))
+}
+
+// TaxAmountNEQ applies the NEQ predicate on the "tax_amount" field.
+func TaxAmountNEQ(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldTaxAmount, v))
+}
+
+// TaxAmountIn applies the In predicate on the "tax_amount" field.
+func TaxAmountIn(vs ...decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldTaxAmount, vs...))
+}
+
+// TaxAmountNotIn applies the NotIn predicate on the "tax_amount" field.
+func TaxAmountNotIn(vs ...decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldTaxAmount, vs...))
+}
+
+// TaxAmountGT applies the GT predicate on the "tax_amount" field.
+func TaxAmountGT(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldTaxAmount, v))
+}
+
+// TaxAmountGTE applies the GTE predicate on the "tax_amount" field.
+func TaxAmountGTE(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldTaxAmount, v))
+}
+
+// TaxAmountLT applies the LT predicate on the "tax_amount" field.
+func TaxAmountLT(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldTaxAmount, v))
+}
+
+// TaxAmountLTE applies the LTE predicate on the "tax_amount" field.
+func TaxAmountLTE(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldTaxAmount, v))
+}
+
+// TaxAmountIsNil applies the IsNil predicate on the "tax_amount" field.
+func TaxAmountIsNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIsNull(FieldTaxAmount))
+}
+
+// TaxAmountNotNil applies the NotNil predicate on the "tax_amount" field.
+func TaxAmountNotNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotNull(FieldTaxAmount))
+}
+
+// LineTotalEQ applies the EQ predicate on the "line_total" field.
+func LineTotalEQ(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldLineTotal, v))
+}
+
+// LineTotalNEQ applies the NEQ predicate on the "line_total" field.
+func LineTotalNEQ(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldLineTotal, v))
+}
+
+// LineTotalIn applies the In predicate on the "line_total" field.
+func LineTotalIn(vs ...decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldLineTotal, vs...))
+}
+
+// LineTotalNotIn applies the NotIn predicate on the "line_total" field.
+func LineTotalNotIn(vs ...decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldLineTotal, vs...))
+}
+
+// LineTotalGT applies the GT predicate on the "line_total" field.
+func LineTotalGT(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldLineTotal, v))
+}
+
+// LineTotalGTE applies the GTE predicate on the "line_total" field.
+func LineTotalGTE(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldLineTotal, v))
+}
+
+// LineTotalLT applies the LT predicate on the "line_total" field.
+func LineTotalLT(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldLineTotal, v))
+}
+
+// LineTotalLTE applies the LTE predicate on the "line_total" field.
+func LineTotalLTE(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldLineTotal, v))
+}
+
+// WithholdingCategoryEQ applies the EQ predicate on the "withholding_category" field.
+func WithholdingCategoryEQ(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldWithholdingCategory, v))
+}
+
+// WithholdingCategoryNEQ applies the NEQ predicate on the "withholding_category" field.
+func WithholdingCategoryNEQ(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldWithholdingCategory, v))
+}
+
+// WithholdingCategoryIn applies the In predicate on the "withholding_category" field.
+func WithholdingCategoryIn(vs ...string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldWithholdingCategory, vs...))
+}
+
+// WithholdingCategoryNotIn applies the NotIn predicate on the "withholding_category" field.
+func WithholdingCategoryNotIn(vs ...string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldWithholdingCategory, vs...))
+}
+
+// WithholdingCategoryGT applies the GT predicate on the "withholding_category" field.
+func WithholdingCategoryGT(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldWithholdingCategory, v))
+}
+
+// WithholdingCategoryGTE applies the GTE predicate on the "withholding_category" field.
+func WithholdingCategoryGTE(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldWithholdingCategory, v))
+}
+
+// WithholdingCategoryLT applies the LT predicate on the "withholding_category" field.
+func WithholdingCategoryLT(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldWithholdingCategory, v))
+}
+
+// WithholdingCategoryLTE applies the LTE predicate on the "withholding_category" field.
+func WithholdingCategoryLTE(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldWithholdingCategory, v))
+}
+
+// WithholdingCategoryContains applies the Contains predicate on the "withholding_category" field.
+func WithholdingCategoryContains(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldContains(FieldWithholdingCategory, v))
+}
+
+// WithholdingCategoryHasPrefix applies the HasPrefix predicate on the "withholding_category" field.
+func WithholdingCategoryHasPrefix(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldHasPrefix(FieldWithholdingCategory, v))
+}
+
+// WithholdingCategoryHasSuffix applies the HasSuffix predicate on the "withholding_category" field.
+func WithholdingCategoryHasSuffix(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldHasSuffix(FieldWithholdingCategory, v))
+}
+
+// WithholdingCategoryIsNil applies the IsNil predicate on the "withholding_category" field.
+func WithholdingCategoryIsNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIsNull(FieldWithholdingCategory))
+}
+
+// WithholdingCategoryNotNil applies the NotNil predicate on the "withholding_category" field.
+func WithholdingCategoryNotNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotNull(FieldWithholdingCategory))
+}
+
+// WithholdingCategoryEqualFold applies the EqualFold predicate on the "withholding_category" field.
+func WithholdingCategoryEqualFold(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEqualFold(FieldWithholdingCategory, v))
+}
+
+// WithholdingCategoryContainsFold applies the ContainsFold predicate on the "withholding_category" field.
+func WithholdingCategoryContainsFold(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldContainsFold(FieldWithholdingCategory, v))
+}
+
+// WithholdingRateEQ applies the EQ predicate on the "withholding_rate" field.
+func WithholdingRateEQ(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldWithholdingRate, v))
+}
+
+// WithholdingRateNEQ applies the NEQ predicate on the "withholding_rate" field.
+func WithholdingRateNEQ(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldWithholdingRate, v))
+}
+
+// WithholdingRateIn applies the In predicate on the "withholding_rate" field.
+func WithholdingRateIn(vs ...decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldWithholdingRate, vs...))
+}
+
+// WithholdingRateNotIn applies the NotIn predicate on the "withholding_rate" field.
+func WithholdingRateNotIn(vs ...decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldWithholdingRate, vs...))
+}
+
+// WithholdingRateGT applies the GT predicate on the "withholding_rate" field.
+func WithholdingRateGT(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldWithholdingRate, v))
+}
+
+// WithholdingRateGTE applies the GTE predicate on the "withholding_rate" field.
+func WithholdingRateGTE(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldWithholdingRate, v))
+}
+
+// WithholdingRateLT applies the LT predicate on the "withholding_rate" field.
+func WithholdingRateLT(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldWithholdingRate, v))
+}
+
+// WithholdingRateLTE applies the LTE predicate on the "withholding_rate" field.
+func WithholdingRateLTE(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldWithholdingRate, v))
+}
+
+// WithholdingRateIsNil applies the IsNil predicate on the "withholding_rate" field.
+func WithholdingRateIsNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIsNull(FieldWithholdingRate))
+}
+
+// WithholdingRateNotNil applies the NotNil predicate on the "withholding_rate" field.
+func WithholdingRateNotNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotNull(FieldWithholdingRate))
+}
+
+// WithholdingAmountEQ applies the EQ predicate on the "withholding_amount" field.
+func WithholdingAmountEQ(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountNEQ applies the NEQ predicate on the "withholding_amount" field.
+func WithholdingAmountNEQ(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountIn applies the In predicate on the "withholding_amount" field.
+func WithholdingAmountIn(vs ...decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldWithholdingAmount, vs...))
+}
+
+// WithholdingAmountNotIn applies the NotIn predicate on the "withholding_amount" field.
+func WithholdingAmountNotIn(vs ...decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldWithholdingAmount, vs...))
+}
+
+// WithholdingAmountGT applies the GT predicate on the "withholding_amount" field.
+func WithholdingAmountGT(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountGTE applies the GTE predicate on the "withholding_amount" field.
+func WithholdingAmountGTE(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountLT applies the LT predicate on the "withholding_amount" field.
+func WithholdingAmountLT(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountLTE applies the LTE predicate on the "withholding_amount" field.
+func WithholdingAmountLTE(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldWithholdingAmount, v))
+}
+
+// WithholdingAmountIsNil applies the IsNil predicate on the "withholding_amount" field.
+func WithholdingAmountIsNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIsNull(FieldWithholdingAmount))
+}
+
+// WithholdingAmountNotNil applies the NotNil predicate on the "withholding_amount" field.
+func WithholdingAmountNotNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotNull(FieldWithholdingAmount))
+}
+
+// MatchStatusEQ applies the EQ predicate on the "match_status" field.
+func MatchStatusEQ(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldMatchStatus, v))
+}
+
+// MatchStatusNEQ applies the NEQ predicate on the "match_status" field.
+func MatchStatusNEQ(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldMatchStatus, v))
+}
+
+// MatchStatusIn applies the In predicate on the "match_status" field.
+func MatchStatusIn(vs ...string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldMatchStatus, vs...))
+}
+
+// MatchStatusNotIn applies the NotIn predicate on the "match_status" field.
+func MatchStatusNotIn(vs ...string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldMatchStatus, vs...))
+}
+
+// MatchStatusGT applies the GT predicate on the "match_status" field.
+func MatchStatusGT(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldMatchStatus, v))
+}
+
+// MatchStatusGTE applies the GTE predicate on the "match_status" field.
+func MatchStatusGTE(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldMatchStatus, v))
+}
+
+// MatchStatusLT applies the LT predicate on the "match_status" field.
+func MatchStatusLT(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldMatchStatus, v))
+}
+
+// MatchStatusLTE applies the LTE predicate on the "match_status" field.
+func MatchStatusLTE(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldMatchStatus, v))
+}
+
+// MatchStatusContains applies the Contains predicate on the "match_status" field.
+func MatchStatusContains(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldContains(FieldMatchStatus, v))
+}
+
+// MatchStatusHasPrefix applies the HasPrefix predicate on the "match_status" field.
+func MatchStatusHasPrefix(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldHasPrefix(FieldMatchStatus, v))
+}
+
+// MatchStatusHasSuffix applies the HasSuffix predicate on the "match_status" field.
+func MatchStatusHasSuffix(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldHasSuffix(FieldMatchStatus, v))
+}
+
+// MatchStatusIsNil applies the IsNil predicate on the "match_status" field.
+func MatchStatusIsNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIsNull(FieldMatchStatus))
+}
+
+// MatchStatusNotNil applies the NotNil predicate on the "match_status" field.
+func MatchStatusNotNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotNull(FieldMatchStatus))
+}
+
+// MatchStatusEqualFold applies the EqualFold predicate on the "match_status" field.
+func MatchStatusEqualFold(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEqualFold(FieldMatchStatus, v))
+}
+
+// MatchStatusContainsFold applies the ContainsFold predicate on the "match_status" field.
+func MatchStatusContainsFold(v string) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldContainsFold(FieldMatchStatus, v))
+}
+
+// ReceivedQuantityEQ applies the EQ predicate on the "received_quantity" field.
+func ReceivedQuantityEQ(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldReceivedQuantity, v))
+}
+
+// ReceivedQuantityNEQ applies the NEQ predicate on the "received_quantity" field.
+func ReceivedQuantityNEQ(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldReceivedQuantity, v))
+}
+
+// ReceivedQuantityIn applies the In predicate on the "received_quantity" field.
+func ReceivedQuantityIn(vs ...decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldReceivedQuantity, vs...))
+}
+
+// ReceivedQuantityNotIn applies the NotIn predicate on the "received_quantity" field.
+func ReceivedQuantityNotIn(vs ...decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldReceivedQuantity, vs...))
+}
+
+// ReceivedQuantityGT applies the GT predicate on the "received_quantity" field.
+func ReceivedQuantityGT(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldReceivedQuantity, v))
+}
+
+// ReceivedQuantityGTE applies the GTE predicate on the "received_quantity" field.
+func ReceivedQuantityGTE(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldReceivedQuantity, v))
+}
+
+// ReceivedQuantityLT applies the LT predicate on the "received_quantity" field.
+func ReceivedQuantityLT(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldReceivedQuantity, v))
+}
+
+// ReceivedQuantityLTE applies the LTE predicate on the "received_quantity" field.
+func ReceivedQuantityLTE(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldReceivedQuantity, v))
+}
+
+// ReceivedQuantityIsNil applies the IsNil predicate on the "received_quantity" field.
+func ReceivedQuantityIsNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIsNull(FieldReceivedQuantity))
+}
+
+// ReceivedQuantityNotNil applies the NotNil predicate on the "received_quantity" field.
+func ReceivedQuantityNotNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotNull(FieldReceivedQuantity))
+}
+
+// OrderUnitPriceEQ applies the EQ predicate on the "order_unit_price" field.
+func OrderUnitPriceEQ(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldOrderUnitPrice, v))
+}
+
+// OrderUnitPriceNEQ applies the NEQ predicate on the "order_unit_price" field.
+func OrderUnitPriceNEQ(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldOrderUnitPrice, v))
+}
+
+// OrderUnitPriceIn applies the In predicate on the "order_unit_price" field.
+func OrderUnitPriceIn(vs ...decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldOrderUnitPrice, vs...))
+}
+
+// OrderUnitPriceNotIn applies the NotIn predicate on the "order_unit_price" field.
+func OrderUnitPriceNotIn(vs ...decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldOrderUnitPrice, vs...))
+}
+
+// OrderUnitPriceGT applies the GT predicate on the "order_unit_price" field.
+func OrderUnitPriceGT(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldOrderUnitPrice, v))
+}
+
+// OrderUnitPriceGTE applies the GTE predicate on the "order_unit_price" field.
+func OrderUnitPriceGTE(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldOrderUnitPrice, v))
+}
+
+// OrderUnitPriceLT applies the LT predicate on the "order_unit_price" field.
+func OrderUnitPriceLT(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldOrderUnitPrice, v))
+}
+
+// OrderUnitPriceLTE applies the LTE predicate on the "order_unit_price" field.
+func OrderUnitPriceLTE(v decimal.Decimal) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldOrderUnitPrice, v))
+}
+
+// OrderUnitPriceIsNil applies the IsNil predicate on the "order_unit_price" field.
+func OrderUnitPriceIsNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIsNull(FieldOrderUnitPrice))
+}
+
+// OrderUnitPriceNotNil applies the NotNil predicate on the "order_unit_price" field.
+func OrderUnitPriceNotNil() predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotNull(FieldOrderUnitPrice))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasBill applies the HasEdge predicate on the "bill" edge.
+func HasBill() predicate.VendorBillLine {
+	return predicate.VendorBillLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BillTable, BillColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBillWith applies the HasEdge predicate on the "bill" edge with a given conditions (other predicates).
+func HasBillWith(preds ...predicate.VendorBill) predicate.VendorBillLine {
+	return predicate.VendorBillLine(func(s *sql.Selector) {
+		step := newBillStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VendorBillLine) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VendorBillLine) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VendorBillLine) predicate.VendorBillLine {
+	return predicate.VendorBillLine(sql.NotPredicates(p))
+}
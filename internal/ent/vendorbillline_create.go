@@ -0,0 +1,1981 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbill"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbillline"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// VendorBillLineCreate is the builder for creating a VendorBillLine entity.
+type VendorBillLineCreate struct {
+	config
+	mutation *VendorBillLineMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *VendorBillLineCreate) SetTenantID(v uuid.UUID) *VendorBillLineCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetBillID sets the "bill_id" field.
+func (_c *VendorBillLineCreate) SetBillID(v uuid.UUID) *VendorBillLineCreate {
+	_c.mutation.SetBillID(v)
+	return _c
+}
+
+// SetLineNumber sets the "line_number" field.
+func (_c *VendorBillLineCreate) SetLineNumber(v int) *VendorBillLineCreate {
+	_c.mutation.SetLineNumber(v)
+	return _c
+}
+
+// SetItemCode sets the "item_code" field.
+func (_c *VendorBillLineCreate) SetItemCode(v string) *VendorBillLineCreate {
+	_c.mutation.SetItemCode(v)
+	return _c
+}
+
+// SetNillableItemCode sets the "item_code" field if the given value is not nil.
+func (_c *VendorBillLineCreate) SetNillableItemCode(v *string) *VendorBillLineCreate {
+	if v != nil {
+		_c.SetItemCode(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *VendorBillLineCreate) SetDescription(v string) *VendorBillLineCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *VendorBillLineCreate) SetQuantity(v decimal.Decimal) *VendorBillLineCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_c *VendorBillLineCreate) SetUnitPrice(v decimal.Decimal) *VendorBillLineCreate {
+	_c.mutation.SetUnitPrice(v)
+	return _c
+}
+
+// SetAccountCode sets the "account_code" field.
+func (_c *VendorBillLineCreate) SetAccountCode(v string) *VendorBillLineCreate {
+	_c.mutation.SetAccountCode(v)
+	return _c
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (_c *VendorBillLineCreate) SetTaxCode(v string) *VendorBillLineCreate {
+	_c.mutation.SetTaxCode(v)
+	return _c
+}
+
+// SetNillableTaxCode sets the "tax_code" field if the given value is not nil.
+func (_c *VendorBillLineCreate) SetNillableTaxCode(v *string) *VendorBillLineCreate {
+	if v != nil {
+		_c.SetTaxCode(*v)
+	}
+	return _c
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (_c *VendorBillLineCreate) SetTaxRate(v decimal.Decimal) *VendorBillLineCreate {
+	_c.mutation.SetTaxRate(v)
+	return _c
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (_c *VendorBillLineCreate) SetNillableTaxRate(v *decimal.Decimal) *VendorBillLineCreate {
+	if v != nil {
+		_c.SetTaxRate(*v)
+	}
+	return _c
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (_c *VendorBillLineCreate) SetTaxAmount(v decimal.Decimal) *VendorBillLineCreate {
+	_c.mutation.SetTaxAmount(v)
+	return _c
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (_c *VendorBillLineCreate) SetNillableTaxAmount(v *decimal.Decimal) *VendorBillLineCreate {
+	if v != nil {
+		_c.SetTaxAmount(*v)
+	}
+	return _c
+}
+
+// SetLineTotal sets the "line_total" field.
+func (_c *VendorBillLineCreate) SetLineTotal(v decimal.Decimal) *VendorBillLineCreate {
+	_c.mutation.SetLineTotal(v)
+	return _c
+}
+
+// SetWithholdingCategory sets the "withholding_category" field.
+func (_c *VendorBillLineCreate) SetWithholdingCategory(v string) *VendorBillLineCreate {
+	_c.mutation.SetWithholdingCategory(v)
+	return _c
+}
+
+// SetNillableWithholdingCategory sets the "withholding_category" field if the given value is not nil.
+func (_c *VendorBillLineCreate) SetNillableWithholdingCategory(v *string) *VendorBillLineCreate {
+	if v != nil {
+		_c.SetWithholdingCategory(*v)
+	}
+	return _c
+}
+
+// SetWithholdingRate sets the "withholding_rate" field.
+func (_c *VendorBillLineCreate) SetWithholdingRate(v decimal.Decimal) *VendorBillLineCreate {
+	_c.mutation.SetWithholdingRate(v)
+	return _c
+}
+
+// SetNillableWithholdingRate sets the "withholding_rate" field if the given value is not nil.
+func (_c *VendorBillLineCreate) SetNillableWithholdingRate(v *decimal.Decimal) *VendorBillLineCreate {
+	if v != nil {
+		_c.SetWithholdingRate(*v)
+	}
+	return _c
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (_c *VendorBillLineCreate) SetWithholdingAmount(v decimal.Decimal) *VendorBillLineCreate {
+	_c.mutation.SetWithholdingAmount(v)
+	return _c
+}
+
+// SetNillableWithholdingAmount sets the "withholding_amount" field if the given value is not nil.
+func (_c *VendorBillLineCreate) SetNillableWithholdingAmount(v *decimal.Decimal) *VendorBillLineCreate {
+	if v != nil {
+		_c.SetWithholdingAmount(*v)
+	}
+	return _c
+}
+
+// SetMatchStatus sets the "match_status" field.
+func (_c *VendorBillLineCreate) SetMatchStatus(v string) *VendorBillLineCreate {
+	_c.mutation.SetMatchStatus(v)
+	return _c
+}
+
+// SetNillableMatchStatus sets the "match_status" field if the given value is not nil.
+func (_c *VendorBillLineCreate) SetNillableMatchStatus(v *string) *VendorBillLineCreate {
+	if v != nil {
+		_c.SetMatchStatus(*v)
+	}
+	return _c
+}
+
+// SetReceivedQuantity sets the "received_quantity" field.
+func (_c *VendorBillLineCreate) SetReceivedQuantity(v decimal.Decimal) *VendorBillLineCreate {
+	_c.mutation.SetReceivedQuantity(v)
+	return _c
+}
+
+// SetNillableReceivedQuantity sets the "received_quantity" field if the given value is not nil.
+func (_c *VendorBillLineCreate) SetNillableReceivedQuantity(v *decimal.Decimal) *VendorBillLineCreate {
+	if v != nil {
+		_c.SetReceivedQuantity(*v)
+	}
+	return _c
+}
+
+// SetOrderUnitPrice sets the "order_unit_price" field.
+func (_c *VendorBillLineCreate) SetOrderUnitPrice(v decimal.Decimal) *VendorBillLineCreate {
+	_c.mutation.SetOrderUnitPrice(v)
+	return _c
+}
+
+// SetNillableOrderUnitPrice sets the "order_unit_price" field if the given value is not nil.
+func (_c *VendorBillLineCreate) SetNillableOrderUnitPrice(v *decimal.Decimal) *VendorBillLineCreate {
+	if v != nil {
+		_c.SetOrderUnitPrice(*v)
+	}
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *VendorBillLineCreate) SetMetadata(v map[string]interface{}) *VendorBillLineCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VendorBillLineCreate) SetCreatedAt(v time.Time) *VendorBillLineCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *VendorBillLineCreate) SetNillableCreatedAt(v *time.Time) *VendorBillLineCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *VendorBillLineCreate) SetID(v uuid.UUID) *VendorBillLineCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *VendorBillLineCreate) SetNillableID(v *uuid.UUID) *VendorBillLineCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetBill sets the "bill" edge to the VendorBill entity.
+func (_c *VendorBillLineCreate) SetBill(v *VendorBill) *VendorBillLineCreate {
+	return _c.SetBillID(v.ID)
+}
+
+// Mutation returns the VendorBillLineMutation object of the builder.
+func (_c *VendorBillLineCreate) Mutation() *VendorBillLineMutation {
+	return _c.mutation
+}
+
+// Save creates the VendorBillLine in the database.
+func (_c *VendorBillLineCreate) Save(ctx context.Context) (*VendorBillLine, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VendorBillLineCreate) SaveX(ctx context.Context) *VendorBillLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VendorBillLineCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VendorBillLineCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VendorBillLineCreate) defaults() {
+	if _, ok := _c.mutation.Metadata(); !ok {
+		v := vendorbillline.DefaultMetadata
+		_c.mutation.SetMetadata(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := vendorbillline.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := vendorbillline.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VendorBillLineCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "VendorBillLine.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.BillID(); !ok {
+		return &ValidationError{Name: "bill_id", err: errors.New(`ent: missing required field "VendorBillLine.bill_id"`)}
+	}
+	if _, ok := _c.mutation.LineNumber(); !ok {
+		return &ValidationError{Name: "line_number", err: errors.New(`ent: missing required field "VendorBillLine.line_number"`)}
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "VendorBillLine.description"`)}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := vendorbillline.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "VendorBillLine.description": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "VendorBillLine.quantity"`)}
+	}
+	if _, ok := _c.mutation.UnitPrice(); !ok {
+		return &ValidationError{Name: "unit_price", err: errors.New(`ent: missing required field "VendorBillLine.unit_price"`)}
+	}
+	if _, ok := _c.mutation.AccountCode(); !ok {
+		return &ValidationError{Name: "account_code", err: errors.New(`ent: missing required field "VendorBillLine.account_code"`)}
+	}
+	if v, ok := _c.mutation.AccountCode(); ok {
+		if err := vendorbillline.AccountCodeValidator(v); err != nil {
+			return &ValidationError{Name: "account_code", err: fmt.Errorf(`ent: validator failed for field "VendorBillLine.account_code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LineTotal(); !ok {
+		return &ValidationError{Name: "line_total", err: errors.New(`ent: missing required field "VendorBillLine.line_total"`)}
+	}
+	if _, ok := _c.mutation.Metadata(); !ok {
+		return &ValidationError{Name: "metadata", err: errors.New(`ent: missing required field "VendorBillLine.metadata"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VendorBillLine.created_at"`)}
+	}
+	if len(_c.mutation.BillIDs()) == 0 {
+		return &ValidationError{Name: "bill", err: errors.New(`ent: missing required edge "VendorBillLine.bill"`)}
+	}
+	return nil
+}
+
+func (_c *VendorBillLineCreate) sqlSave(ctx context.Context) (*VendorBillLine, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VendorBillLineCreate) createSpec() (*VendorBillLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VendorBillLine{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(vendorbillline.Table, sqlgraph.NewFieldSpec(vendorbillline.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(vendorbillline.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.LineNumber(); ok {
+		_spec.SetField(vendorbillline.FieldLineNumber, field.TypeInt, value)
+		_node.LineNumber = value
+	}
+	if value, ok := _c.mutation.ItemCode(); ok {
+		_spec.SetField(vendorbillline.FieldItemCode, field.TypeString, value)
+		_node.ItemCode = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(vendorbillline.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(vendorbillline.FieldQuantity, field.TypeFloat64, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.UnitPrice(); ok {
+		_spec.SetField(vendorbillline.FieldUnitPrice, field.TypeFloat64, value)
+		_node.UnitPrice = value
+	}
+	if value, ok := _c.mutation.AccountCode(); ok {
+		_spec.SetField(vendorbillline.FieldAccountCode, field.TypeString, value)
+		_node.AccountCode = value
+	}
+	if value, ok := _c.mutation.TaxCode(); ok {
+		_spec.SetField(vendorbillline.FieldTaxCode, field.TypeString, value)
+		_node.TaxCode = value
+	}
+	if value, ok := _c.mutation.TaxRate(); ok {
+		_spec.SetField(vendorbillline.FieldTaxRate, field.TypeFloat64, value)
+		_node.TaxRate = value
+	}
+	if value, ok := _c.mutation.TaxAmount(); ok {
+		_spec.SetField(vendorbillline.FieldTaxAmount, field.TypeFloat64, value)
+		_node.TaxAmount = value
+	}
+	if value, ok := _c.mutation.LineTotal(); ok {
+		_spec.SetField(vendorbillline.FieldLineTotal, field.TypeFloat64, value)
+		_node.LineTotal = value
+	}
+	if value, ok := _c.mutation.WithholdingCategory(); ok {
+		_spec.SetField(vendorbillline.FieldWithholdingCategory, field.TypeString, value)
+		_node.WithholdingCategory = value
+	}
+	if value, ok := _c.mutation.WithholdingRate(); ok {
+		_spec.SetField(vendorbillline.FieldWithholdingRate, field.TypeFloat64, value)
+		_node.WithholdingRate = value
+	}
+	if value, ok := _c.mutation.WithholdingAmount(); ok {
+		_spec.SetField(vendorbillline.FieldWithholdingAmount, field.TypeFloat64, value)
+		_node.WithholdingAmount = value
+	}
+	if value, ok := _c.mutation.MatchStatus(); ok {
+		_spec.SetField(vendorbillline.FieldMatchStatus, field.TypeString, value)
+		_node.MatchStatus = value
+	}
+	if value, ok := _c.mutation.ReceivedQuantity(); ok {
+		_spec.SetField(vendorbillline.FieldReceivedQuantity, field.TypeFloat64, value)
+		_node.ReceivedQuantity = &value
+	}
+	if value, ok := _c.mutation.OrderUnitPrice(); ok {
+		_spec.SetField(vendorbillline.FieldOrderUnitPrice, field.TypeFloat64, value)
+		_node.OrderUnitPrice = &value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(vendorbillline.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(vendorbillline.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.BillIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vendorbillline.BillTable,
+			Columns: []string{vendorbillline.BillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendorbill.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BillID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VendorBillLine.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VendorBillLineUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *VendorBillLineCreate) OnConflict(opts ...sql.ConflictOption) *VendorBillLineUpsertOne {
+	_c.conflict = opts
+	return &VendorBillLineUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VendorBillLine.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *VendorBillLineCreate) OnConflictColumns(columns ...string) *VendorBillLineUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &VendorBillLineUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// VendorBillLineUpsertOne is the builder for "upsert"-ing
+	//  one VendorBillLine node.
+	VendorBillLineUpsertOne struct {
+		create *VendorBillLineCreate
+	}
+
+	// VendorBillLineUpsert is the "OnConflict" setter.
+	VendorBillLineUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *VendorBillLineUpsert) SetTenantID(v uuid.UUID) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateTenantID() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldTenantID)
+	return u
+}
+
+// SetBillID sets the "bill_id" field.
+func (u *VendorBillLineUpsert) SetBillID(v uuid.UUID) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldBillID, v)
+	return u
+}
+
+// UpdateBillID sets the "bill_id" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateBillID() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldBillID)
+	return u
+}
+
+// SetLineNumber sets the "line_number" field.
+func (u *VendorBillLineUpsert) SetLineNumber(v int) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldLineNumber, v)
+	return u
+}
+
+// UpdateLineNumber sets the "line_number" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateLineNumber() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldLineNumber)
+	return u
+}
+
+// AddLineNumber adds v to the "line_number" field.
+func (u *VendorBillLineUpsert) AddLineNumber(v int) *VendorBillLineUpsert {
+	u.Add(vendorbillline.FieldLineNumber, v)
+	return u
+}
+
+// SetItemCode sets the "item_code" field.
+func (u *VendorBillLineUpsert) SetItemCode(v string) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldItemCode, v)
+	return u
+}
+
+// UpdateItemCode sets the "item_code" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateItemCode() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldItemCode)
+	return u
+}
+
+// ClearItemCode clears the value of the "item_code" field.
+func (u *VendorBillLineUpsert) ClearItemCode() *VendorBillLineUpsert {
+	u.SetNull(vendorbillline.FieldItemCode)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *VendorBillLineUpsert) SetDescription(v string) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateDescription() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldDescription)
+	return u
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *VendorBillLineUpsert) SetQuantity(v decimal.Decimal) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldQuantity, v)
+	return u
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateQuantity() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldQuantity)
+	return u
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *VendorBillLineUpsert) AddQuantity(v decimal.Decimal) *VendorBillLineUpsert {
+	u.Add(vendorbillline.FieldQuantity, v)
+	return u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (u *VendorBillLineUpsert) SetUnitPrice(v decimal.Decimal) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldUnitPrice, v)
+	return u
+}
+
+// UpdateUnitPrice sets the "unit_price" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateUnitPrice() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldUnitPrice)
+	return u
+}
+
+// AddUnitPrice adds v to the "unit_price" field.
+func (u *VendorBillLineUpsert) AddUnitPrice(v decimal.Decimal) *VendorBillLineUpsert {
+	u.Add(vendorbillline.FieldUnitPrice, v)
+	return u
+}
+
+// SetAccountCode sets the "account_code" field.
+func (u *VendorBillLineUpsert) SetAccountCode(v string) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldAccountCode, v)
+	return u
+}
+
+// UpdateAccountCode sets the "account_code" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateAccountCode() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldAccountCode)
+	return u
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (u *VendorBillLineUpsert) SetTaxCode(v string) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldTaxCode, v)
+	return u
+}
+
+// UpdateTaxCode sets the "tax_code" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateTaxCode() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldTaxCode)
+	return u
+}
+
+// ClearTaxCode clears the value of the "tax_code" field.
+func (u *VendorBillLineUpsert) ClearTaxCode() *VendorBillLineUpsert {
+	u.SetNull(vendorbillline.FieldTaxCode)
+	return u
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (u *VendorBillLineUpsert) SetTaxRate(v decimal.Decimal) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldTaxRate, v)
+	return u
+}
+
+// UpdateTaxRate sets the "tax_rate" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateTaxRate() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldTaxRate)
+	return u
+}
+
+// AddTaxRate adds v to the "tax_rate" field.
+func (u *VendorBillLineUpsert) AddTaxRate(v decimal.Decimal) *VendorBillLineUpsert {
+	u.Add(vendorbillline.FieldTaxRate, v)
+	return u
+}
+
+// ClearTaxRate clears the value of the "tax_rate" field.
+func (u *VendorBillLineUpsert) ClearTaxRate() *VendorBillLineUpsert {
+	u.SetNull(vendorbillline.FieldTaxRate)
+	return u
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *VendorBillLineUpsert) SetTaxAmount(v decimal.Decimal) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldTaxAmount, v)
+	return u
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateTaxAmount() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldTaxAmount)
+	return u
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *VendorBillLineUpsert) AddTaxAmount(v decimal.Decimal) *VendorBillLineUpsert {
+	u.Add(vendorbillline.FieldTaxAmount, v)
+	return u
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (u *VendorBillLineUpsert) ClearTaxAmount() *VendorBillLineUpsert {
+	u.SetNull(vendorbillline.FieldTaxAmount)
+	return u
+}
+
+// SetLineTotal sets the "line_total" field.
+func (u *VendorBillLineUpsert) SetLineTotal(v decimal.Decimal) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldLineTotal, v)
+	return u
+}
+
+// UpdateLineTotal sets the "line_total" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateLineTotal() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldLineTotal)
+	return u
+}
+
+// AddLineTotal adds v to the "line_total" field.
+func (u *VendorBillLineUpsert) AddLineTotal(v decimal.Decimal) *VendorBillLineUpsert {
+	u.Add(vendorbillline.FieldLineTotal, v)
+	return u
+}
+
+// SetWithholdingCategory sets the "withholding_category" field.
+func (u *VendorBillLineUpsert) SetWithholdingCategory(v string) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldWithholdingCategory, v)
+	return u
+}
+
+// UpdateWithholdingCategory sets the "withholding_category" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateWithholdingCategory() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldWithholdingCategory)
+	return u
+}
+
+// ClearWithholdingCategory clears the value of the "withholding_category" field.
+func (u *VendorBillLineUpsert) ClearWithholdingCategory() *VendorBillLineUpsert {
+	u.SetNull(vendorbillline.FieldWithholdingCategory)
+	return u
+}
+
+// SetWithholdingRate sets the "withholding_rate" field.
+func (u *VendorBillLineUpsert) SetWithholdingRate(v decimal.Decimal) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldWithholdingRate, v)
+	return u
+}
+
+// UpdateWithholdingRate sets the "withholding_rate" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateWithholdingRate() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldWithholdingRate)
+	return u
+}
+
+// AddWithholdingRate adds v to the "withholding_rate" field.
+func (u *VendorBillLineUpsert) AddWithholdingRate(v decimal.Decimal) *VendorBillLineUpsert {
+	u.Add(vendorbillline.FieldWithholdingRate, v)
+	return u
+}
+
+// ClearWithholdingRate clears the value of the "withholding_rate" field.
+func (u *VendorBillLineUpsert) ClearWithholdingRate() *VendorBillLineUpsert {
+	u.SetNull(vendorbillline.FieldWithholdingRate)
+	return u
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (u *VendorBillLineUpsert) SetWithholdingAmount(v decimal.Decimal) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldWithholdingAmount, v)
+	return u
+}
+
+// UpdateWithholdingAmount sets the "withholding_amount" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateWithholdingAmount() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldWithholdingAmount)
+	return u
+}
+
+// AddWithholdingAmount adds v to the "withholding_amount" field.
+func (u *VendorBillLineUpsert) AddWithholdingAmount(v decimal.Decimal) *VendorBillLineUpsert {
+	u.Add(vendorbillline.FieldWithholdingAmount, v)
+	return u
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (u *VendorBillLineUpsert) ClearWithholdingAmount() *VendorBillLineUpsert {
+	u.SetNull(vendorbillline.FieldWithholdingAmount)
+	return u
+}
+
+// SetMatchStatus sets the "match_status" field.
+func (u *VendorBillLineUpsert) SetMatchStatus(v string) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldMatchStatus, v)
+	return u
+}
+
+// UpdateMatchStatus sets the "match_status" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateMatchStatus() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldMatchStatus)
+	return u
+}
+
+// ClearMatchStatus clears the value of the "match_status" field.
+func (u *VendorBillLineUpsert) ClearMatchStatus() *VendorBillLineUpsert {
+	u.SetNull(vendorbillline.FieldMatchStatus)
+	return u
+}
+
+// SetReceivedQuantity sets the "received_quantity" field.
+func (u *VendorBillLineUpsert) SetReceivedQuantity(v decimal.Decimal) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldReceivedQuantity, v)
+	return u
+}
+
+// UpdateReceivedQuantity sets the "received_quantity" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateReceivedQuantity() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldReceivedQuantity)
+	return u
+}
+
+// AddReceivedQuantity adds v to the "received_quantity" field.
+func (u *VendorBillLineUpsert) AddReceivedQuantity(v decimal.Decimal) *VendorBillLineUpsert {
+	u.Add(vendorbillline.FieldReceivedQuantity, v)
+	return u
+}
+
+// ClearReceivedQuantity clears the value of the "received_quantity" field.
+func (u *VendorBillLineUpsert) ClearReceivedQuantity() *VendorBillLineUpsert {
+	u.SetNull(vendorbillline.FieldReceivedQuantity)
+	return u
+}
+
+// SetOrderUnitPrice sets the "order_unit_price" field.
+func (u *VendorBillLineUpsert) SetOrderUnitPrice(v decimal.Decimal) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldOrderUnitPrice, v)
+	return u
+}
+
+// UpdateOrderUnitPrice sets the "order_unit_price" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateOrderUnitPrice() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldOrderUnitPrice)
+	return u
+}
+
+// AddOrderUnitPrice adds v to the "order_unit_price" field.
+func (u *VendorBillLineUpsert) AddOrderUnitPrice(v decimal.Decimal) *VendorBillLineUpsert {
+	u.Add(vendorbillline.FieldOrderUnitPrice, v)
+	return u
+}
+
+// ClearOrderUnitPrice clears the value of the "order_unit_price" field.
+func (u *VendorBillLineUpsert) ClearOrderUnitPrice() *VendorBillLineUpsert {
+	u.SetNull(vendorbillline.FieldOrderUnitPrice)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *VendorBillLineUpsert) SetMetadata(v map[string]interface{}) *VendorBillLineUpsert {
+	u.Set(vendorbillline.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *VendorBillLineUpsert) UpdateMetadata() *VendorBillLineUpsert {
+	u.SetExcluded(vendorbillline.FieldMetadata)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.VendorBillLine.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(vendorbillline.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VendorBillLineUpsertOne) UpdateNewValues() *VendorBillLineUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(vendorbillline.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(vendorbillline.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VendorBillLine.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *VendorBillLineUpsertOne) Ignore() *VendorBillLineUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VendorBillLineUpsertOne) DoNothing() *VendorBillLineUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VendorBillLineCreate.OnConflict
+// documentation for more info.
+func (u *VendorBillLineUpsertOne) Update(set func(*VendorBillLineUpsert)) *VendorBillLineUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VendorBillLineUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *VendorBillLineUpsertOne) SetTenantID(v uuid.UUID) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateTenantID() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetBillID sets the "bill_id" field.
+func (u *VendorBillLineUpsertOne) SetBillID(v uuid.UUID) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetBillID(v)
+	})
+}
+
+// UpdateBillID sets the "bill_id" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateBillID() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateBillID()
+	})
+}
+
+// SetLineNumber sets the "line_number" field.
+func (u *VendorBillLineUpsertOne) SetLineNumber(v int) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetLineNumber(v)
+	})
+}
+
+// AddLineNumber adds v to the "line_number" field.
+func (u *VendorBillLineUpsertOne) AddLineNumber(v int) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddLineNumber(v)
+	})
+}
+
+// UpdateLineNumber sets the "line_number" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateLineNumber() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateLineNumber()
+	})
+}
+
+// SetItemCode sets the "item_code" field.
+func (u *VendorBillLineUpsertOne) SetItemCode(v string) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetItemCode(v)
+	})
+}
+
+// UpdateItemCode sets the "item_code" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateItemCode() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateItemCode()
+	})
+}
+
+// ClearItemCode clears the value of the "item_code" field.
+func (u *VendorBillLineUpsertOne) ClearItemCode() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearItemCode()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *VendorBillLineUpsertOne) SetDescription(v string) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateDescription() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *VendorBillLineUpsertOne) SetQuantity(v decimal.Decimal) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *VendorBillLineUpsertOne) AddQuantity(v decimal.Decimal) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateQuantity() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (u *VendorBillLineUpsertOne) SetUnitPrice(v decimal.Decimal) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetUnitPrice(v)
+	})
+}
+
+// AddUnitPrice adds v to the "unit_price" field.
+func (u *VendorBillLineUpsertOne) AddUnitPrice(v decimal.Decimal) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddUnitPrice(v)
+	})
+}
+
+// UpdateUnitPrice sets the "unit_price" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateUnitPrice() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateUnitPrice()
+	})
+}
+
+// SetAccountCode sets the "account_code" field.
+func (u *VendorBillLineUpsertOne) SetAccountCode(v string) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetAccountCode(v)
+	})
+}
+
+// UpdateAccountCode sets the "account_code" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateAccountCode() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateAccountCode()
+	})
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (u *VendorBillLineUpsertOne) SetTaxCode(v string) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetTaxCode(v)
+	})
+}
+
+// UpdateTaxCode sets the "tax_code" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateTaxCode() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateTaxCode()
+	})
+}
+
+// ClearTaxCode clears the value of the "tax_code" field.
+func (u *VendorBillLineUpsertOne) ClearTaxCode() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearTaxCode()
+	})
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (u *VendorBillLineUpsertOne) SetTaxRate(v decimal.Decimal) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetTaxRate(v)
+	})
+}
+
+// AddTaxRate adds v to the "tax_rate" field.
+func (u *VendorBillLineUpsertOne) AddTaxRate(v decimal.Decimal) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddTaxRate(v)
+	})
+}
+
+// UpdateTaxRate sets the "tax_rate" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateTaxRate() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateTaxRate()
+	})
+}
+
+// ClearTaxRate clears the value of the "tax_rate" field.
+func (u *VendorBillLineUpsertOne) ClearTaxRate() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearTaxRate()
+	})
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *VendorBillLineUpsertOne) SetTaxAmount(v decimal.Decimal) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetTaxAmount(v)
+	})
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *VendorBillLineUpsertOne) AddTaxAmount(v decimal.Decimal) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddTaxAmount(v)
+	})
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateTaxAmount() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateTaxAmount()
+	})
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (u *VendorBillLineUpsertOne) ClearTaxAmount() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearTaxAmount()
+	})
+}
+
+// SetLineTotal sets the "line_total" field.
+func (u *VendorBillLineUpsertOne) SetLineTotal(v decimal.Decimal) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetLineTotal(v)
+	})
+}
+
+// AddLineTotal adds v to the "line_total" field.
+func (u *VendorBillLineUpsertOne) AddLineTotal(v decimal.Decimal) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddLineTotal(v)
+	})
+}
+
+// UpdateLineTotal sets the "line_total" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateLineTotal() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateLineTotal()
+	})
+}
+
+// SetWithholdingCategory sets the "withholding_category" field.
+func (u *VendorBillLineUpsertOne) SetWithholdingCategory(v string) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetWithholdingCategory(v)
+	})
+}
+
+// UpdateWithholdingCategory sets the "withholding_category" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateWithholdingCategory() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateWithholdingCategory()
+	})
+}
+
+// ClearWithholdingCategory clears the value of the "withholding_category" field.
+func (u *VendorBillLineUpsertOne) ClearWithholdingCategory() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearWithholdingCategory()
+	})
+}
+
+// SetWithholdingRate sets the "withholding_rate" field.
+func (u *VendorBillLineUpsertOne) SetWithholdingRate(v decimal.Decimal) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetWithholdingRate(v)
+	})
+}
+
+// AddWithholdingRate adds v to the "withholding_rate" field.
+func (u *VendorBillLineUpsertOne) AddWithholdingRate(v decimal.Decimal) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddWithholdingRate(v)
+	})
+}
+
+// UpdateWithholdingRate sets the "withholding_rate" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateWithholdingRate() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateWithholdingRate()
+	})
+}
+
+// ClearWithholdingRate clears the value of the "withholding_rate" field.
+func (u *VendorBillLineUpsertOne) ClearWithholdingRate() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearWithholdingRate()
+	})
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (u *VendorBillLineUpsertOne) SetWithholdingAmount(v decimal.Decimal) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetWithholdingAmount(v)
+	})
+}
+
+// AddWithholdingAmount adds v to the "withholding_amount" field.
+func (u *VendorBillLineUpsertOne) AddWithholdingAmount(v decimal.Decimal) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddWithholdingAmount(v)
+	})
+}
+
+// UpdateWithholdingAmount sets the "withholding_amount" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateWithholdingAmount() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateWithholdingAmount()
+	})
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (u *VendorBillLineUpsertOne) ClearWithholdingAmount() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearWithholdingAmount()
+	})
+}
+
+// SetMatchStatus sets the "match_status" field.
+func (u *VendorBillLineUpsertOne) SetMatchStatus(v string) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetMatchStatus(v)
+	})
+}
+
+// UpdateMatchStatus sets the "match_status" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateMatchStatus() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateMatchStatus()
+	})
+}
+
+// ClearMatchStatus clears the value of the "match_status" field.
+func (u *VendorBillLineUpsertOne) ClearMatchStatus() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearMatchStatus()
+	})
+}
+
+// SetReceivedQuantity sets the "received_quantity" field.
+func (u *VendorBillLineUpsertOne) SetReceivedQuantity(v decimal.Decimal) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetReceivedQuantity(v)
+	})
+}
+
+// AddReceivedQuantity adds v to the "received_quantity" field.
+func (u *VendorBillLineUpsertOne) AddReceivedQuantity(v decimal.Decimal) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddReceivedQuantity(v)
+	})
+}
+
+// UpdateReceivedQuantity sets the "received_quantity" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateReceivedQuantity() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateReceivedQuantity()
+	})
+}
+
+// ClearReceivedQuantity clears the value of the "received_quantity" field.
+func (u *VendorBillLineUpsertOne) ClearReceivedQuantity() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearReceivedQuantity()
+	})
+}
+
+// SetOrderUnitPrice sets the "order_unit_price" field.
+func (u *VendorBillLineUpsertOne) SetOrderUnitPrice(v decimal.Decimal) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetOrderUnitPrice(v)
+	})
+}
+
+// AddOrderUnitPrice adds v to the "order_unit_price" field.
+func (u *VendorBillLineUpsertOne) AddOrderUnitPrice(v decimal.Decimal) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddOrderUnitPrice(v)
+	})
+}
+
+// UpdateOrderUnitPrice sets the "order_unit_price" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateOrderUnitPrice() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateOrderUnitPrice()
+	})
+}
+
+// ClearOrderUnitPrice clears the value of the "order_unit_price" field.
+func (u *VendorBillLineUpsertOne) ClearOrderUnitPrice() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearOrderUnitPrice()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *VendorBillLineUpsertOne) SetMetadata(v map[string]interface{}) *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *VendorBillLineUpsertOne) UpdateMetadata() *VendorBillLineUpsertOne {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *VendorBillLineUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VendorBillLineCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VendorBillLineUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *VendorBillLineUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: VendorBillLineUpsertOne.ID is not supported by MySQL driver. Use VendorBillLineUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *VendorBillLineUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// VendorBillLineCreateBulk is the builder for creating many VendorBillLine entities in bulk.
+type VendorBillLineCreateBulk struct {
+	config
+	err      error
+	builders []*VendorBillLineCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the VendorBillLine entities in the database.
+func (_c *VendorBillLineCreateBulk) Save(ctx context.Context) ([]*VendorBillLine, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*VendorBillLine, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VendorBillLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VendorBillLineCreateBulk) SaveX(ctx context.Context) []*VendorBillLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VendorBillLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VendorBillLineCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VendorBillLine.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VendorBillLineUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *VendorBillLineCreateBulk) OnConflict(opts ...sql.ConflictOption) *VendorBillLineUpsertBulk {
+	_c.conflict = opts
+	return &VendorBillLineUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VendorBillLine.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *VendorBillLineCreateBulk) OnConflictColumns(columns ...string) *VendorBillLineUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &VendorBillLineUpsertBulk{
+		create: _c,
+	}
+}
+
+// VendorBillLineUpsertBulk is the builder for "upsert"-ing
+// a bulk of VendorBillLine nodes.
+type VendorBillLineUpsertBulk struct {
+	create *VendorBillLineCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.VendorBillLine.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(vendorbillline.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VendorBillLineUpsertBulk) UpdateNewValues() *VendorBillLineUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(vendorbillline.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(vendorbillline.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VendorBillLine.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *VendorBillLineUpsertBulk) Ignore() *VendorBillLineUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VendorBillLineUpsertBulk) DoNothing() *VendorBillLineUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VendorBillLineCreateBulk.OnConflict
+// documentation for more info.
+func (u *VendorBillLineUpsertBulk) Update(set func(*VendorBillLineUpsert)) *VendorBillLineUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VendorBillLineUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *VendorBillLineUpsertBulk) SetTenantID(v uuid.UUID) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateTenantID() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetBillID sets the "bill_id" field.
+func (u *VendorBillLineUpsertBulk) SetBillID(v uuid.UUID) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetBillID(v)
+	})
+}
+
+// UpdateBillID sets the "bill_id" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateBillID() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateBillID()
+	})
+}
+
+// SetLineNumber sets the "line_number" field.
+func (u *VendorBillLineUpsertBulk) SetLineNumber(v int) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetLineNumber(v)
+	})
+}
+
+// AddLineNumber adds v to the "line_number" field.
+func (u *VendorBillLineUpsertBulk) AddLineNumber(v int) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddLineNumber(v)
+	})
+}
+
+// UpdateLineNumber sets the "line_number" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateLineNumber() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateLineNumber()
+	})
+}
+
+// SetItemCode sets the "item_code" field.
+func (u *VendorBillLineUpsertBulk) SetItemCode(v string) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetItemCode(v)
+	})
+}
+
+// UpdateItemCode sets the "item_code" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateItemCode() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateItemCode()
+	})
+}
+
+// ClearItemCode clears the value of the "item_code" field.
+func (u *VendorBillLineUpsertBulk) ClearItemCode() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearItemCode()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *VendorBillLineUpsertBulk) SetDescription(v string) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateDescription() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *VendorBillLineUpsertBulk) SetQuantity(v decimal.Decimal) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *VendorBillLineUpsertBulk) AddQuantity(v decimal.Decimal) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateQuantity() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (u *VendorBillLineUpsertBulk) SetUnitPrice(v decimal.Decimal) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetUnitPrice(v)
+	})
+}
+
+// AddUnitPrice adds v to the "unit_price" field.
+func (u *VendorBillLineUpsertBulk) AddUnitPrice(v decimal.Decimal) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddUnitPrice(v)
+	})
+}
+
+// UpdateUnitPrice sets the "unit_price" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateUnitPrice() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateUnitPrice()
+	})
+}
+
+// SetAccountCode sets the "account_code" field.
+func (u *VendorBillLineUpsertBulk) SetAccountCode(v string) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetAccountCode(v)
+	})
+}
+
+// UpdateAccountCode sets the "account_code" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateAccountCode() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateAccountCode()
+	})
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (u *VendorBillLineUpsertBulk) SetTaxCode(v string) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetTaxCode(v)
+	})
+}
+
+// UpdateTaxCode sets the "tax_code" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateTaxCode() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateTaxCode()
+	})
+}
+
+// ClearTaxCode clears the value of the "tax_code" field.
+func (u *VendorBillLineUpsertBulk) ClearTaxCode() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearTaxCode()
+	})
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (u *VendorBillLineUpsertBulk) SetTaxRate(v decimal.Decimal) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetTaxRate(v)
+	})
+}
+
+// AddTaxRate adds v to the "tax_rate" field.
+func (u *VendorBillLineUpsertBulk) AddTaxRate(v decimal.Decimal) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddTaxRate(v)
+	})
+}
+
+// UpdateTaxRate sets the "tax_rate" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateTaxRate() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateTaxRate()
+	})
+}
+
+// ClearTaxRate clears the value of the "tax_rate" field.
+func (u *VendorBillLineUpsertBulk) ClearTaxRate() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearTaxRate()
+	})
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *VendorBillLineUpsertBulk) SetTaxAmount(v decimal.Decimal) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetTaxAmount(v)
+	})
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *VendorBillLineUpsertBulk) AddTaxAmount(v decimal.Decimal) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddTaxAmount(v)
+	})
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateTaxAmount() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateTaxAmount()
+	})
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (u *VendorBillLineUpsertBulk) ClearTaxAmount() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearTaxAmount()
+	})
+}
+
+// SetLineTotal sets the "line_total" field.
+func (u *VendorBillLineUpsertBulk) SetLineTotal(v decimal.Decimal) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetLineTotal(v)
+	})
+}
+
+// AddLineTotal adds v to the "line_total" field.
+func (u *VendorBillLineUpsertBulk) AddLineTotal(v decimal.Decimal) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddLineTotal(v)
+	})
+}
+
+// UpdateLineTotal sets the "line_total" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateLineTotal() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateLineTotal()
+	})
+}
+
+// SetWithholdingCategory sets the "withholding_category" field.
+func (u *VendorBillLineUpsertBulk) SetWithholdingCategory(v string) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetWithholdingCategory(v)
+	})
+}
+
+// UpdateWithholdingCategory sets the "withholding_category" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateWithholdingCategory() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateWithholdingCategory()
+	})
+}
+
+// ClearWithholdingCategory clears the value of the "withholding_category" field.
+func (u *VendorBillLineUpsertBulk) ClearWithholdingCategory() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearWithholdingCategory()
+	})
+}
+
+// SetWithholdingRate sets the "withholding_rate" field.
+func (u *VendorBillLineUpsertBulk) SetWithholdingRate(v decimal.Decimal) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetWithholdingRate(v)
+	})
+}
+
+// AddWithholdingRate adds v to the "withholding_rate" field.
+func (u *VendorBillLineUpsertBulk) AddWithholdingRate(v decimal.Decimal) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddWithholdingRate(v)
+	})
+}
+
+// UpdateWithholdingRate sets the "withholding_rate" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateWithholdingRate() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateWithholdingRate()
+	})
+}
+
+// ClearWithholdingRate clears the value of the "withholding_rate" field.
+func (u *VendorBillLineUpsertBulk) ClearWithholdingRate() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearWithholdingRate()
+	})
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (u *VendorBillLineUpsertBulk) SetWithholdingAmount(v decimal.Decimal) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetWithholdingAmount(v)
+	})
+}
+
+// AddWithholdingAmount adds v to the "withholding_amount" field.
+func (u *VendorBillLineUpsertBulk) AddWithholdingAmount(v decimal.Decimal) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddWithholdingAmount(v)
+	})
+}
+
+// UpdateWithholdingAmount sets the "withholding_amount" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateWithholdingAmount() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateWithholdingAmount()
+	})
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (u *VendorBillLineUpsertBulk) ClearWithholdingAmount() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearWithholdingAmount()
+	})
+}
+
+// SetMatchStatus sets the "match_status" field.
+func (u *VendorBillLineUpsertBulk) SetMatchStatus(v string) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetMatchStatus(v)
+	})
+}
+
+// UpdateMatchStatus sets the "match_status" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateMatchStatus() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateMatchStatus()
+	})
+}
+
+// ClearMatchStatus clears the value of the "match_status" field.
+func (u *VendorBillLineUpsertBulk) ClearMatchStatus() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearMatchStatus()
+	})
+}
+
+// SetReceivedQuantity sets the "received_quantity" field.
+func (u *VendorBillLineUpsertBulk) SetReceivedQuantity(v decimal.Decimal) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetReceivedQuantity(v)
+	})
+}
+
+// AddReceivedQuantity adds v to the "received_quantity" field.
+func (u *VendorBillLineUpsertBulk) AddReceivedQuantity(v decimal.Decimal) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddReceivedQuantity(v)
+	})
+}
+
+// UpdateReceivedQuantity sets the "received_quantity" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateReceivedQuantity() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateReceivedQuantity()
+	})
+}
+
+// ClearReceivedQuantity clears the value of the "received_quantity" field.
+func (u *VendorBillLineUpsertBulk) ClearReceivedQuantity() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearReceivedQuantity()
+	})
+}
+
+// SetOrderUnitPrice sets the "order_unit_price" field.
+func (u *VendorBillLineUpsertBulk) SetOrderUnitPrice(v decimal.Decimal) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetOrderUnitPrice(v)
+	})
+}
+
+// AddOrderUnitPrice adds v to the "order_unit_price" field.
+func (u *VendorBillLineUpsertBulk) AddOrderUnitPrice(v decimal.Decimal) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.AddOrderUnitPrice(v)
+	})
+}
+
+// UpdateOrderUnitPrice sets the "order_unit_price" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateOrderUnitPrice() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateOrderUnitPrice()
+	})
+}
+
+// ClearOrderUnitPrice clears the value of the "order_unit_price" field.
+func (u *VendorBillLineUpsertBulk) ClearOrderUnitPrice() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.ClearOrderUnitPrice()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *VendorBillLineUpsertBulk) SetMetadata(v map[string]interface{}) *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *VendorBillLineUpsertBulk) UpdateMetadata() *VendorBillLineUpsertBulk {
+	return u.Update(func(s *VendorBillLineUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *VendorBillLineUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the VendorBillLineCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VendorBillLineCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VendorBillLineUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
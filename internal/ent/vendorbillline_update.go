@@ -0,0 +1,1304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbill"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbillline"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// VendorBillLineUpdate is the builder for updating VendorBillLine entities.
+type VendorBillLineUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VendorBillLineMutation
+}
+
+// Where appends a list predicates to the VendorBillLineUpdate builder.
+func (_u *VendorBillLineUpdate) Where(ps ...predicate.VendorBillLine) *VendorBillLineUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *VendorBillLineUpdate) SetTenantID(v uuid.UUID) *VendorBillLineUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *VendorBillLineUpdate) SetNillableTenantID(v *uuid.UUID) *VendorBillLineUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetBillID sets the "bill_id" field.
+func (_u *VendorBillLineUpdate) SetBillID(v uuid.UUID) *VendorBillLineUpdate {
+	_u.mutation.SetBillID(v)
+	return _u
+}
+
+// SetNillableBillID sets the "bill_id" field if the given value is not nil.
+func (_u *VendorBillLineUpdate) SetNillableBillID(v *uuid.UUID) *VendorBillLineUpdate {
+	if v != nil {
+		_u.SetBillID(*v)
+	}
+	return _u
+}
+
+// SetLineNumber sets the "line_number" field.
+func (_u *VendorBillLineUpdate) SetLineNumber(v int) *VendorBillLineUpdate {
+	_u.mutation.ResetLineNumber()
+	_u.mutation.SetLineNumber(v)
+	return _u
+}
+
+// SetNillableLineNumber sets the "line_number" field if the given value is not nil.
+func (_u *VendorBillLineUpdate) SetNillableLineNumber(v *int) *VendorBillLineUpdate {
+	if v != nil {
+		_u.SetLineNumber(*v)
+	}
+	return _u
+}
+
+// AddLineNumber adds value to the "line_number" field.
+func (_u *VendorBillLineUpdate) AddLineNumber(v int) *VendorBillLineUpdate {
+	_u.mutation.AddLineNumber(v)
+	return _u
+}
+
+// SetItemCode sets the "item_code" field.
+func (_u *VendorBillLineUpdate) SetItemCode(v string) *VendorBillLineUpdate {
+	_u.mutation.SetItemCode(v)
+	return _u
+}
+
+// SetNillableItemCode sets the "item_code" field if the given value is not nil.
+func (_u *VendorBillLineUpdate) SetNillableItemCode(v *string) *VendorBillLineUpdate {
+	if v != nil {
+		_u.SetItemCode(*v)
+	}
+	return _u
+}
+
+// ClearItemCode clears the value of the "item_code" field.
+func (_u *VendorBillLineUpdate) ClearItemCode() *VendorBillLineUpdate {
+	_u.mutation.ClearItemCode()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *VendorBillLineUpdate) SetDescription(v string) *VendorBillLineUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *VendorBillLineUpdate) SetNillableDescription(v *string) *VendorBillLineUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *VendorBillLineUpdate) SetQuantity(v decimal.Decimal) *VendorBillLineUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *VendorBillLineUpdate) SetNillableQuantity(v *decimal.Decimal) *VendorBillLineUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *VendorBillLineUpdate) AddQuantity(v decimal.Decimal) *VendorBillLineUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_u *VendorBillLineUpdate) SetUnitPrice(v decimal.Decimal) *VendorBillLineUpdate {
+	_u.mutation.ResetUnitPrice()
+	_u.mutation.SetUnitPrice(v)
+	return _u
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_u *VendorBillLineUpdate) SetNillableUnitPrice(v *decimal.Decimal) *VendorBillLineUpdate {
+	if v != nil {
+		_u.SetUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddUnitPrice adds value to the "unit_price" field.
+func (_u *VendorBillLineUpdate) AddUnitPrice(v decimal.Decimal) *VendorBillLineUpdate {
+	_u.mutation.AddUnitPrice(v)
+	return _u
+}
+
+// SetAccountCode sets the "account_code" field.
+func (_u *VendorBillLineUpdate) SetAccountCode(v string) *VendorBillLineUpdate {
+	_u.mutation.SetAccountCode(v)
+	return _u
+}
+
+// SetNillableAccountCode sets the "account_code" field if the given value is not nil.
+func (_u *VendorBillLineUpdate) SetNillableAccountCode(v *string) *VendorBillLineUpdate {
+	if v != nil {
+		_u.SetAccountCode(*v)
+	}
+	return _u
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (_u *VendorBillLineUpdate) SetTaxCode(v string) *VendorBillLineUpdate {
+	_u.mutation.SetTaxCode(v)
+	return _u
+}
+
+// SetNillableTaxCode sets the "tax_code" field if the given value is not nil.
+func (_u *VendorBillLineUpdate) SetNillableTaxCode(v *string) *VendorBillLineUpdate {
+	if v != nil {
+		_u.SetTaxCode(*v)
+	}
+	return _u
+}
+
+// ClearTaxCode clears the value of the "tax_code" field.
+func (_u *VendorBillLineUpdate) ClearTaxCode() *VendorBillLineUpdate {
+	_u.mutation.ClearTaxCode()
+	return _u
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (_u *VendorBillLineUpdate) SetTaxRate(v decimal.Decimal) *VendorBillLineUpdate {
+	_u.mutation.ResetTaxRate()
+	_u.mutation.SetTaxRate(v)
+	return _u
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (_u *VendorBillLineUpdate) SetNillableTaxRate(v *decimal.Decimal) *VendorBillLineUpdate {
+	if v != nil {
+		_u.SetTaxRate(*v)
+	}
+	return _u
+}
+
+// AddTaxRate adds value to the "tax_rate" field.
+func (_u *VendorBillLineUpdate) AddTaxRate(v decimal.Decimal) *VendorBillLineUpdate {
+	_u.mutation.AddTaxRate(v)
+	return _u
+}
+
+// ClearTaxRate clears the value of the "tax_rate" field.
+func (_u *VendorBillLineUpdate) ClearTaxRate() *VendorBillLineUpdate {
+	_u.mutation.ClearTaxRate()
+	return _u
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (_u *VendorBillLineUpdate) SetTaxAmount(v decimal.Decimal) *VendorBillLineUpdate {
+	_u.mutation.ResetTaxAmount()
+	_u.mutation.SetTaxAmount(v)
+	return _u
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (_u *VendorBillLineUpdate) SetNillableTaxAmount(v *decimal.Decimal) *VendorBillLineUpdate {
+	if v != nil {
+		_u.SetTaxAmount(*v)
+	}
+	return _u
+}
+
+// AddTaxAmount adds value to the "tax_amount" field.
+func (_u *VendorBillLineUpdate) AddTaxAmount(v decimal.Decimal) *VendorBillLineUpdate {
+	_u.mutation.AddTaxAmount(v)
+	return _u
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (_u *VendorBillLineUpdate) ClearTaxAmount() *VendorBillLineUpdate {
+	_u.mutation.ClearTaxAmount()
+	return _u
+}
+
+// SetLineTotal sets the "line_total" field.
+func (_u *VendorBillLineUpdate) SetLineTotal(v decimal.Decimal) *VendorBillLineUpdate {
+	_u.mutation.ResetLineTotal()
+	_u.mutation.SetLineTotal(v)
+	return _u
+}
+
+// SetNillableLineTotal sets the "line_total" field if the given value is not nil.
+func (_u *VendorBillLineUpdate) SetNillableLineTotal(v *decimal.Decimal) *VendorBillLineUpdate {
+	if v != nil {
+		_u.SetLineTotal(*v)
+	}
+	return _u
+}
+
+// AddLineTotal adds value to the "line_total" field.
+func (_u *VendorBillLineUpdate) AddLineTotal(v decimal.Decimal) *VendorBillLineUpdate {
+	_u.mutation.AddLineTotal(v)
+	return _u
+}
+
+// SetWithholdingCategory sets the "withholding_category" field.
+func (_u *VendorBillLineUpdate) SetWithholdingCategory(v string) *VendorBillLineUpdate {
+	_u.mutation.SetWithholdingCategory(v)
+	return _u
+}
+
+// SetNillableWithholdingCategory sets the "withholding_category" field if the given value is not nil.
+func (_u *VendorBillLineUpdate) SetNillableWithholdingCategory(v *string) *VendorBillLineUpdate {
+	if v != nil {
+		_u.SetWithholdingCategory(*v)
+	}
+	return _u
+}
+
+// ClearWithholdingCategory clears the value of the "withholding_category" field.
+func (_u *VendorBillLineUpdate) ClearWithholdingCategory() *VendorBillLineUpdate {
+	_u.mutation.ClearWithholdingCategory()
+	return _u
+}
+
+// SetWithholdingRate sets the "withholding_rate" field.
+func (_u *VendorBillLineUpdate) SetWithholdingRate(v decimal.Decimal) *VendorBillLineUpdate {
+	_u.mutation.ResetWithholdingRate()
+	_u.mutation.SetWithholdingRate(v)
+	return _u
+}
+
+// SetNillableWithholdingRate sets the "withholding_rate" field if the given value is not nil.
+func (_u *VendorBillLineUpdate) SetNillableWithholdingRate(v *decimal.Decimal) *VendorBillLineUpdate {
+	if v != nil {
+		_u.SetWithholdingRate(*v)
+	}
+	return _u
+}
+
+// AddWithholdingRate adds value to the "withholding_rate" field.
+func (_u *VendorBillLineUpdate) AddWithholdingRate(v decimal.Decimal) *VendorBillLineUpdate {
+	_u.mutation.AddWithholdingRate(v)
+	return _u
+}
+
+// ClearWithholdingRate clears the value of the "withholding_rate" field.
+func (_u *VendorBillLineUpdate) ClearWithholdingRate() *VendorBillLineUpdate {
+	_u.mutation.ClearWithholdingRate()
+	return _u
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (_u *VendorBillLineUpdate) SetWithholdingAmount(v decimal.Decimal) *VendorBillLineUpdate {
+	_u.mutation.ResetWithholdingAmount()
+	_u.mutation.SetWithholdingAmount(v)
+	return _u
+}
+
+// SetNillableWithholdingAmount sets the "withholding_amount" field if the given value is not nil.
+func (_u *VendorBillLineUpdate) SetNillableWithholdingAmount(v *decimal.Decimal) *VendorBillLineUpdate {
+	if v != nil {
+		_u.SetWithholdingAmount(*v)
+	}
+	return _u
+}
+
+// AddWithholdingAmount adds value to the "withholding_amount" field.
+func (_u *VendorBillLineUpdate) AddWithholdingAmount(v decimal.Decimal) *VendorBillLineUpdate {
+	_u.mutation.AddWithholdingAmount(v)
+	return _u
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (_u *VendorBillLineUpdate) ClearWithholdingAmount() *VendorBillLineUpdate {
+	_u.mutation.ClearWithholdingAmount()
+	return _u
+}
+
+// SetMatchStatus sets the "match_status" field.
+func (_u *VendorBillLineUpdate) SetMatchStatus(v string) *VendorBillLineUpdate {
+	_u.mutation.SetMatchStatus(v)
+	return _u
+}
+
+// SetNillableMatchStatus sets the "match_status" field if the given value is not nil.
+func (_u *VendorBillLineUpdate) SetNillableMatchStatus(v *string) *VendorBillLineUpdate {
+	if v != nil {
+		_u.SetMatchStatus(*v)
+	}
+	return _u
+}
+
+// ClearMatchStatus clears the value of the "match_status" field.
+func (_u *VendorBillLineUpdate) ClearMatchStatus() *VendorBillLineUpdate {
+	_u.mutation.ClearMatchStatus()
+	return _u
+}
+
+// SetReceivedQuantity sets the "received_quantity" field.
+func (_u *VendorBillLineUpdate) SetReceivedQuantity(v decimal.Decimal) *VendorBillLineUpdate {
+	_u.mutation.ResetReceivedQuantity()
+	_u.mutation.SetReceivedQuantity(v)
+	return _u
+}
+
+// SetNillableReceivedQuantity sets the "received_quantity" field if the given value is not nil.
+func (_u *VendorBillLineUpdate) SetNillableReceivedQuantity(v *decimal.Decimal) *VendorBillLineUpdate {
+	if v != nil {
+		_u.SetReceivedQuantity(*v)
+	}
+	return _u
+}
+
+// AddReceivedQuantity adds value to the "received_quantity" field.
+func (_u *VendorBillLineUpdate) AddReceivedQuantity(v decimal.Decimal) *VendorBillLineUpdate {
+	_u.mutation.AddReceivedQuantity(v)
+	return _u
+}
+
+// ClearReceivedQuantity clears the value of the "received_quantity" field.
+func (_u *VendorBillLineUpdate) ClearReceivedQuantity() *VendorBillLineUpdate {
+	_u.mutation.ClearReceivedQuantity()
+	return _u
+}
+
+// SetOrderUnitPrice sets the "order_unit_price" field.
+func (_u *VendorBillLineUpdate) SetOrderUnitPrice(v decimal.Decimal) *VendorBillLineUpdate {
+	_u.mutation.ResetOrderUnitPrice()
+	_u.mutation.SetOrderUnitPrice(v)
+	return _u
+}
+
+// SetNillableOrderUnitPrice sets the "order_unit_price" field if the given value is not nil.
+func (_u *VendorBillLineUpdate) SetNillableOrderUnitPrice(v *decimal.Decimal) *VendorBillLineUpdate {
+	if v != nil {
+		_u.SetOrderUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddOrderUnitPrice adds value to the "order_unit_price" field.
+func (_u *VendorBillLineUpdate) AddOrderUnitPrice(v decimal.Decimal) *VendorBillLineUpdate {
+	_u.mutation.AddOrderUnitPrice(v)
+	return _u
+}
+
+// ClearOrderUnitPrice clears the value of the "order_unit_price" field.
+func (_u *VendorBillLineUpdate) ClearOrderUnitPrice() *VendorBillLineUpdate {
+	_u.mutation.ClearOrderUnitPrice()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *VendorBillLineUpdate) SetMetadata(v map[string]interface{}) *VendorBillLineUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetBill sets the "bill" edge to the VendorBill entity.
+func (_u *VendorBillLineUpdate) SetBill(v *VendorBill) *VendorBillLineUpdate {
+	return _u.SetBillID(v.ID)
+}
+
+// Mutation returns the VendorBillLineMutation object of the builder.
+func (_u *VendorBillLineUpdate) Mutation() *VendorBillLineMutation {
+	return _u.mutation
+}
+
+// ClearBill clears the "bill" edge to the VendorBill entity.
+func (_u *VendorBillLineUpdate) ClearBill() *VendorBillLineUpdate {
+	_u.mutation.ClearBill()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VendorBillLineUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VendorBillLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VendorBillLineUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VendorBillLineUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VendorBillLineUpdate) check() error {
+	if v, ok := _u.mutation.Description(); ok {
+		if err := vendorbillline.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "VendorBillLine.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountCode(); ok {
+		if err := vendorbillline.AccountCodeValidator(v); err != nil {
+			return &ValidationError{Name: "account_code", err: fmt.Errorf(`ent: validator failed for field "VendorBillLine.account_code": %w`, err)}
+		}
+	}
+	if _u.mutation.BillCleared() && len(_u.mutation.BillIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "VendorBillLine.bill"`)
+	}
+	return nil
+}
+
+func (_u *VendorBillLineUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vendorbillline.Table, vendorbillline.Columns, sqlgraph.NewFieldSpec(vendorbillline.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(vendorbillline.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.LineNumber(); ok {
+		_spec.SetField(vendorbillline.FieldLineNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLineNumber(); ok {
+		_spec.AddField(vendorbillline.FieldLineNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ItemCode(); ok {
+		_spec.SetField(vendorbillline.FieldItemCode, field.TypeString, value)
+	}
+	if _u.mutation.ItemCodeCleared() {
+		_spec.ClearField(vendorbillline.FieldItemCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(vendorbillline.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(vendorbillline.FieldQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(vendorbillline.FieldQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.UnitPrice(); ok {
+		_spec.SetField(vendorbillline.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedUnitPrice(); ok {
+		_spec.AddField(vendorbillline.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AccountCode(); ok {
+		_spec.SetField(vendorbillline.FieldAccountCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TaxCode(); ok {
+		_spec.SetField(vendorbillline.FieldTaxCode, field.TypeString, value)
+	}
+	if _u.mutation.TaxCodeCleared() {
+		_spec.ClearField(vendorbillline.FieldTaxCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.TaxRate(); ok {
+		_spec.SetField(vendorbillline.FieldTaxRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTaxRate(); ok {
+		_spec.AddField(vendorbillline.FieldTaxRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.TaxRateCleared() {
+		_spec.ClearField(vendorbillline.FieldTaxRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TaxAmount(); ok {
+		_spec.SetField(vendorbillline.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTaxAmount(); ok {
+		_spec.AddField(vendorbillline.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.TaxAmountCleared() {
+		_spec.ClearField(vendorbillline.FieldTaxAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.LineTotal(); ok {
+		_spec.SetField(vendorbillline.FieldLineTotal, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLineTotal(); ok {
+		_spec.AddField(vendorbillline.FieldLineTotal, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.WithholdingCategory(); ok {
+		_spec.SetField(vendorbillline.FieldWithholdingCategory, field.TypeString, value)
+	}
+	if _u.mutation.WithholdingCategoryCleared() {
+		_spec.ClearField(vendorbillline.FieldWithholdingCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.WithholdingRate(); ok {
+		_spec.SetField(vendorbillline.FieldWithholdingRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWithholdingRate(); ok {
+		_spec.AddField(vendorbillline.FieldWithholdingRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.WithholdingRateCleared() {
+		_spec.ClearField(vendorbillline.FieldWithholdingRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.WithholdingAmount(); ok {
+		_spec.SetField(vendorbillline.FieldWithholdingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWithholdingAmount(); ok {
+		_spec.AddField(vendorbillline.FieldWithholdingAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.WithholdingAmountCleared() {
+		_spec.ClearField(vendorbillline.FieldWithholdingAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.MatchStatus(); ok {
+		_spec.SetField(vendorbillline.FieldMatchStatus, field.TypeString, value)
+	}
+	if _u.mutation.MatchStatusCleared() {
+		_spec.ClearField(vendorbillline.FieldMatchStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReceivedQuantity(); ok {
+		_spec.SetField(vendorbillline.FieldReceivedQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedReceivedQuantity(); ok {
+		_spec.AddField(vendorbillline.FieldReceivedQuantity, field.TypeFloat64, value)
+	}
+	if _u.mutation.ReceivedQuantityCleared() {
+		_spec.ClearField(vendorbillline.FieldReceivedQuantity, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.OrderUnitPrice(); ok {
+		_spec.SetField(vendorbillline.FieldOrderUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOrderUnitPrice(); ok {
+		_spec.AddField(vendorbillline.FieldOrderUnitPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.OrderUnitPriceCleared() {
+		_spec.ClearField(vendorbillline.FieldOrderUnitPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(vendorbillline.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.BillCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vendorbillline.BillTable,
+			Columns: []string{vendorbillline.BillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendorbill.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BillIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vendorbillline.BillTable,
+			Columns: []string{vendorbillline.BillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendorbill.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vendorbillline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VendorBillLineUpdateOne is the builder for updating a single VendorBillLine entity.
+type VendorBillLineUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VendorBillLineMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *VendorBillLineUpdateOne) SetTenantID(v uuid.UUID) *VendorBillLineUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *VendorBillLineUpdateOne) SetNillableTenantID(v *uuid.UUID) *VendorBillLineUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetBillID sets the "bill_id" field.
+func (_u *VendorBillLineUpdateOne) SetBillID(v uuid.UUID) *VendorBillLineUpdateOne {
+	_u.mutation.SetBillID(v)
+	return _u
+}
+
+// SetNillableBillID sets the "bill_id" field if the given value is not nil.
+func (_u *VendorBillLineUpdateOne) SetNillableBillID(v *uuid.UUID) *VendorBillLineUpdateOne {
+	if v != nil {
+		_u.SetBillID(*v)
+	}
+	return _u
+}
+
+// SetLineNumber sets the "line_number" field.
+func (_u *VendorBillLineUpdateOne) SetLineNumber(v int) *VendorBillLineUpdateOne {
+	_u.mutation.ResetLineNumber()
+	_u.mutation.SetLineNumber(v)
+	return _u
+}
+
+// SetNillableLineNumber sets the "line_number" field if the given value is not nil.
+func (_u *VendorBillLineUpdateOne) SetNillableLineNumber(v *int) *VendorBillLineUpdateOne {
+	if v != nil {
+		_u.SetLineNumber(*v)
+	}
+	return _u
+}
+
+// AddLineNumber adds value to the "line_number" field.
+func (_u *VendorBillLineUpdateOne) AddLineNumber(v int) *VendorBillLineUpdateOne {
+	_u.mutation.AddLineNumber(v)
+	return _u
+}
+
+// SetItemCode sets the "item_code" field.
+func (_u *VendorBillLineUpdateOne) SetItemCode(v string) *VendorBillLineUpdateOne {
+	_u.mutation.SetItemCode(v)
+	return _u
+}
+
+// SetNillableItemCode sets the "item_code" field if the given value is not nil.
+func (_u *VendorBillLineUpdateOne) SetNillableItemCode(v *string) *VendorBillLineUpdateOne {
+	if v != nil {
+		_u.SetItemCode(*v)
+	}
+	return _u
+}
+
+// ClearItemCode clears the value of the "item_code" field.
+func (_u *VendorBillLineUpdateOne) ClearItemCode() *VendorBillLineUpdateOne {
+	_u.mutation.ClearItemCode()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *VendorBillLineUpdateOne) SetDescription(v string) *VendorBillLineUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *VendorBillLineUpdateOne) SetNillableDescription(v *string) *VendorBillLineUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *VendorBillLineUpdateOne) SetQuantity(v decimal.Decimal) *VendorBillLineUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *VendorBillLineUpdateOne) SetNillableQuantity(v *decimal.Decimal) *VendorBillLineUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *VendorBillLineUpdateOne) AddQuantity(v decimal.Decimal) *VendorBillLineUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_u *VendorBillLineUpdateOne) SetUnitPrice(v decimal.Decimal) *VendorBillLineUpdateOne {
+	_u.mutation.ResetUnitPrice()
+	_u.mutation.SetUnitPrice(v)
+	return _u
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_u *VendorBillLineUpdateOne) SetNillableUnitPrice(v *decimal.Decimal) *VendorBillLineUpdateOne {
+	if v != nil {
+		_u.SetUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddUnitPrice adds value to the "unit_price" field.
+func (_u *VendorBillLineUpdateOne) AddUnitPrice(v decimal.Decimal) *VendorBillLineUpdateOne {
+	_u.mutation.AddUnitPrice(v)
+	return _u
+}
+
+// SetAccountCode sets the "account_code" field.
+func (_u *VendorBillLineUpdateOne) SetAccountCode(v string) *VendorBillLineUpdateOne {
+	_u.mutation.SetAccountCode(v)
+	return _u
+}
+
+// SetNillableAccountCode sets the "account_code" field if the given value is not nil.
+func (_u *VendorBillLineUpdateOne) SetNillableAccountCode(v *string) *VendorBillLineUpdateOne {
+	if v != nil {
+		_u.SetAccountCode(*v)
+	}
+	return _u
+}
+
+// SetTaxCode sets the "tax_code" field.
+func (_u *VendorBillLineUpdateOne) SetTaxCode(v string) *VendorBillLineUpdateOne {
+	_u.mutation.SetTaxCode(v)
+	return _u
+}
+
+// SetNillableTaxCode sets the "tax_code" field if the given value is not nil.
+func (_u *VendorBillLineUpdateOne) SetNillableTaxCode(v *string) *VendorBillLineUpdateOne {
+	if v != nil {
+		_u.SetTaxCode(*v)
+	}
+	return _u
+}
+
+// ClearTaxCode clears the value of the "tax_code" field.
+func (_u *VendorBillLineUpdateOne) ClearTaxCode() *VendorBillLineUpdateOne {
+	_u.mutation.ClearTaxCode()
+	return _u
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (_u *VendorBillLineUpdateOne) SetTaxRate(v decimal.Decimal) *VendorBillLineUpdateOne {
+	_u.mutation.ResetTaxRate()
+	_u.mutation.SetTaxRate(v)
+	return _u
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (_u *VendorBillLineUpdateOne) SetNillableTaxRate(v *decimal.Decimal) *VendorBillLineUpdateOne {
+	if v != nil {
+		_u.SetTaxRate(*v)
+	}
+	return _u
+}
+
+// AddTaxRate adds value to the "tax_rate" field.
+func (_u *VendorBillLineUpdateOne) AddTaxRate(v decimal.Decimal) *VendorBillLineUpdateOne {
+	_u.mutation.AddTaxRate(v)
+	return _u
+}
+
+// ClearTaxRate clears the value of the "tax_rate" field.
+func (_u *VendorBillLineUpdateOne) ClearTaxRate() *VendorBillLineUpdateOne {
+	_u.mutation.ClearTaxRate()
+	return _u
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (_u *VendorBillLineUpdateOne) SetTaxAmount(v decimal.Decimal) *VendorBillLineUpdateOne {
+	_u.mutation.ResetTaxAmount()
+	_u.mutation.SetTaxAmount(v)
+	return _u
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (_u *VendorBillLineUpdateOne) SetNillableTaxAmount(v *decimal.Decimal) *VendorBillLineUpdateOne {
+	if v != nil {
+		_u.SetTaxAmount(*v)
+	}
+	return _u
+}
+
+// AddTaxAmount adds value to the "tax_amount" field.
+func (_u *VendorBillLineUpdateOne) AddTaxAmount(v decimal.Decimal) *VendorBillLineUpdateOne {
+	_u.mutation.AddTaxAmount(v)
+	return _u
+}
+
+// ClearTaxAmount clears the value of the "tax_amount" field.
+func (_u *VendorBillLineUpdateOne) ClearTaxAmount() *VendorBillLineUpdateOne {
+	_u.mutation.ClearTaxAmount()
+	return _u
+}
+
+// SetLineTotal sets the "line_total" field.
+func (_u *VendorBillLineUpdateOne) SetLineTotal(v decimal.Decimal) *VendorBillLineUpdateOne {
+	_u.mutation.ResetLineTotal()
+	_u.mutation.SetLineTotal(v)
+	return _u
+}
+
+// SetNillableLineTotal sets the "line_total" field if the given value is not nil.
+func (_u *VendorBillLineUpdateOne) SetNillableLineTotal(v *decimal.Decimal) *VendorBillLineUpdateOne {
+	if v != nil {
+		_u.SetLineTotal(*v)
+	}
+	return _u
+}
+
+// AddLineTotal adds value to the "line_total" field.
+func (_u *VendorBillLineUpdateOne) AddLineTotal(v decimal.Decimal) *VendorBillLineUpdateOne {
+	_u.mutation.AddLineTotal(v)
+	return _u
+}
+
+// SetWithholdingCategory sets the "withholding_category" field.
+func (_u *VendorBillLineUpdateOne) SetWithholdingCategory(v string) *VendorBillLineUpdateOne {
+	_u.mutation.SetWithholdingCategory(v)
+	return _u
+}
+
+// SetNillableWithholdingCategory sets the "withholding_category" field if the given value is not nil.
+func (_u *VendorBillLineUpdateOne) SetNillableWithholdingCategory(v *string) *VendorBillLineUpdateOne {
+	if v != nil {
+		_u.SetWithholdingCategory(*v)
+	}
+	return _u
+}
+
+// ClearWithholdingCategory clears the value of the "withholding_category" field.
+func (_u *VendorBillLineUpdateOne) ClearWithholdingCategory() *VendorBillLineUpdateOne {
+	_u.mutation.ClearWithholdingCategory()
+	return _u
+}
+
+// SetWithholdingRate sets the "withholding_rate" field.
+func (_u *VendorBillLineUpdateOne) SetWithholdingRate(v decimal.Decimal) *VendorBillLineUpdateOne {
+	_u.mutation.ResetWithholdingRate()
+	_u.mutation.SetWithholdingRate(v)
+	return _u
+}
+
+// SetNillableWithholdingRate sets the "withholding_rate" field if the given value is not nil.
+func (_u *VendorBillLineUpdateOne) SetNillableWithholdingRate(v *decimal.Decimal) *VendorBillLineUpdateOne {
+	if v != nil {
+		_u.SetWithholdingRate(*v)
+	}
+	return _u
+}
+
+// AddWithholdingRate adds value to the "withholding_rate" field.
+func (_u *VendorBillLineUpdateOne) AddWithholdingRate(v decimal.Decimal) *VendorBillLineUpdateOne {
+	_u.mutation.AddWithholdingRate(v)
+	return _u
+}
+
+// ClearWithholdingRate clears the value of the "withholding_rate" field.
+func (_u *VendorBillLineUpdateOne) ClearWithholdingRate() *VendorBillLineUpdateOne {
+	_u.mutation.ClearWithholdingRate()
+	return _u
+}
+
+// SetWithholdingAmount sets the "withholding_amount" field.
+func (_u *VendorBillLineUpdateOne) SetWithholdingAmount(v decimal.Decimal) *VendorBillLineUpdateOne {
+	_u.mutation.ResetWithholdingAmount()
+	_u.mutation.SetWithholdingAmount(v)
+	return _u
+}
+
+// SetNillableWithholdingAmount sets the "withholding_amount" field if the given value is not nil.
+func (_u *VendorBillLineUpdateOne) SetNillableWithholdingAmount(v *decimal.Decimal) *VendorBillLineUpdateOne {
+	if v != nil {
+		_u.SetWithholdingAmount(*v)
+	}
+	return _u
+}
+
+// AddWithholdingAmount adds value to the "withholding_amount" field.
+func (_u *VendorBillLineUpdateOne) AddWithholdingAmount(v decimal.Decimal) *VendorBillLineUpdateOne {
+	_u.mutation.AddWithholdingAmount(v)
+	return _u
+}
+
+// ClearWithholdingAmount clears the value of the "withholding_amount" field.
+func (_u *VendorBillLineUpdateOne) ClearWithholdingAmount() *VendorBillLineUpdateOne {
+	_u.mutation.ClearWithholdingAmount()
+	return _u
+}
+
+// SetMatchStatus sets the "match_status" field.
+func (_u *VendorBillLineUpdateOne) SetMatchStatus(v string) *VendorBillLineUpdateOne {
+	_u.mutation.SetMatchStatus(v)
+	return _u
+}
+
+// SetNillableMatchStatus sets the "match_status" field if the given value is not nil.
+func (_u *VendorBillLineUpdateOne) SetNillableMatchStatus(v *string) *VendorBillLineUpdateOne {
+	if v != nil {
+		_u.SetMatchStatus(*v)
+	}
+	return _u
+}
+
+// ClearMatchStatus clears the value of the "match_status" field.
+func (_u *VendorBillLineUpdateOne) ClearMatchStatus() *VendorBillLineUpdateOne {
+	_u.mutation.ClearMatchStatus()
+	return _u
+}
+
+// SetReceivedQuantity sets the "received_quantity" field.
+func (_u *VendorBillLineUpdateOne) SetReceivedQuantity(v decimal.Decimal) *VendorBillLineUpdateOne {
+	_u.mutation.ResetReceivedQuantity()
+	_u.mutation.SetReceivedQuantity(v)
+	return _u
+}
+
+// SetNillableReceivedQuantity sets the "received_quantity" field if the given value is not nil.
+func (_u *VendorBillLineUpdateOne) SetNillableReceivedQuantity(v *decimal.Decimal) *VendorBillLineUpdateOne {
+	if v != nil {
+		_u.SetReceivedQuantity(*v)
+	}
+	return _u
+}
+
+// AddReceivedQuantity adds value to the "received_quantity" field.
+func (_u *VendorBillLineUpdateOne) AddReceivedQuantity(v decimal.Decimal) *VendorBillLineUpdateOne {
+	_u.mutation.AddReceivedQuantity(v)
+	return _u
+}
+
+// ClearReceivedQuantity clears the value of the "received_quantity" field.
+func (_u *VendorBillLineUpdateOne) ClearReceivedQuantity() *VendorBillLineUpdateOne {
+	_u.mutation.ClearReceivedQuantity()
+	return _u
+}
+
+// SetOrderUnitPrice sets the "order_unit_price" field.
+func (_u *VendorBillLineUpdateOne) SetOrderUnitPrice(v decimal.Decimal) *VendorBillLineUpdateOne {
+	_u.mutation.ResetOrderUnitPrice()
+	_u.mutation.SetOrderUnitPrice(v)
+	return _u
+}
+
+// SetNillableOrderUnitPrice sets the "order_unit_price" field if the given value is not nil.
+func (_u *VendorBillLineUpdateOne) SetNillableOrderUnitPrice(v *decimal.Decimal) *VendorBillLineUpdateOne {
+	if v != nil {
+		_u.SetOrderUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddOrderUnitPrice adds value to the "order_unit_price" field.
+func (_u *VendorBillLineUpdateOne) AddOrderUnitPrice(v decimal.Decimal) *VendorBillLineUpdateOne {
+	_u.mutation.AddOrderUnitPrice(v)
+	return _u
+}
+
+// ClearOrderUnitPrice clears the value of the "order_unit_price" field.
+func (_u *VendorBillLineUpdateOne) ClearOrderUnitPrice() *VendorBillLineUpdateOne {
+	_u.mutation.ClearOrderUnitPrice()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *VendorBillLineUpdateOne) SetMetadata(v map[string]interface{}) *VendorBillLineUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetBill sets the "bill" edge to the VendorBill entity.
+func (_u *VendorBillLineUpdateOne) SetBill(v *VendorBill) *VendorBillLineUpdateOne {
+	return _u.SetBillID(v.ID)
+}
+
+// Mutation returns the VendorBillLineMutation object of the builder.
+func (_u *VendorBillLineUpdateOne) Mutation() *VendorBillLineMutation {
+	return _u.mutation
+}
+
+// ClearBill clears the "bill" edge to the VendorBill entity.
+func (_u *VendorBillLineUpdateOne) ClearBill() *VendorBillLineUpdateOne {
+	_u.mutation.ClearBill()
+	return _u
+}
+
+// Where appends a list predicates to the VendorBillLineUpdate builder.
+func (_u *VendorBillLineUpdateOne) Where(ps ...predicate.VendorBillLine) *VendorBillLineUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VendorBillLineUpdateOne) Select(field string, fields ...string) *VendorBillLineUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated VendorBillLine entity.
+func (_u *VendorBillLineUpdateOne) Save(ctx context.Context) (*VendorBillLine, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VendorBillLineUpdateOne) SaveX(ctx context.Context) *VendorBillLine {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VendorBillLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VendorBillLineUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VendorBillLineUpdateOne) check() error {
+	if v, ok := _u.mutation.Description(); ok {
+		if err := vendorbillline.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "VendorBillLine.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountCode(); ok {
+		if err := vendorbillline.AccountCodeValidator(v); err != nil {
+			return &ValidationError{Name: "account_code", err: fmt.Errorf(`ent: validator failed for field "VendorBillLine.account_code": %w`, err)}
+		}
+	}
+	if _u.mutation.BillCleared() && len(_u.mutation.BillIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "VendorBillLine.bill"`)
+	}
+	return nil
+}
+
+func (_u *VendorBillLineUpdateOne) sqlSave(ctx context.Context) (_node *VendorBillLine, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vendorbillline.Table, vendorbillline.Columns, sqlgraph.NewFieldSpec(vendorbillline.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VendorBillLine.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vendorbillline.FieldID)
+		for _, f := range fields {
+			if !vendorbillline.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vendorbillline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(vendorbillline.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.LineNumber(); ok {
+		_spec.SetField(vendorbillline.FieldLineNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLineNumber(); ok {
+		_spec.AddField(vendorbillline.FieldLineNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ItemCode(); ok {
+		_spec.SetField(vendorbillline.FieldItemCode, field.TypeString, value)
+	}
+	if _u.mutation.ItemCodeCleared() {
+		_spec.ClearField(vendorbillline.FieldItemCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(vendorbillline.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(vendorbillline.FieldQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(vendorbillline.FieldQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.UnitPrice(); ok {
+		_spec.SetField(vendorbillline.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedUnitPrice(); ok {
+		_spec.AddField(vendorbillline.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AccountCode(); ok {
+		_spec.SetField(vendorbillline.FieldAccountCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TaxCode(); ok {
+		_spec.SetField(vendorbillline.FieldTaxCode, field.TypeString, value)
+	}
+	if _u.mutation.TaxCodeCleared() {
+		_spec.ClearField(vendorbillline.FieldTaxCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.TaxRate(); ok {
+		_spec.SetField(vendorbillline.FieldTaxRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTaxRate(); ok {
+		_spec.AddField(vendorbillline.FieldTaxRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.TaxRateCleared() {
+		_spec.ClearField(vendorbillline.FieldTaxRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TaxAmount(); ok {
+		_spec.SetField(vendorbillline.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTaxAmount(); ok {
+		_spec.AddField(vendorbillline.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.TaxAmountCleared() {
+		_spec.ClearField(vendorbillline.FieldTaxAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.LineTotal(); ok {
+		_spec.SetField(vendorbillline.FieldLineTotal, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLineTotal(); ok {
+		_spec.AddField(vendorbillline.FieldLineTotal, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.WithholdingCategory(); ok {
+		_spec.SetField(vendorbillline.FieldWithholdingCategory, field.TypeString, value)
+	}
+	if _u.mutation.WithholdingCategoryCleared() {
+		_spec.ClearField(vendorbillline.FieldWithholdingCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.WithholdingRate(); ok {
+		_spec.SetField(vendorbillline.FieldWithholdingRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWithholdingRate(); ok {
+		_spec.AddField(vendorbillline.FieldWithholdingRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.WithholdingRateCleared() {
+		_spec.ClearField(vendorbillline.FieldWithholdingRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.WithholdingAmount(); ok {
+		_spec.SetField(vendorbillline.FieldWithholdingAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWithholdingAmount(); ok {
+		_spec.AddField(vendorbillline.FieldWithholdingAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.WithholdingAmountCleared() {
+		_spec.ClearField(vendorbillline.FieldWithholdingAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.MatchStatus(); ok {
+		_spec.SetField(vendorbillline.FieldMatchStatus, field.TypeString, value)
+	}
+	if _u.mutation.MatchStatusCleared() {
+		_spec.ClearField(vendorbillline.FieldMatchStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReceivedQuantity(); ok {
+		_spec.SetField(vendorbillline.FieldReceivedQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedReceivedQuantity(); ok {
+		_spec.AddField(vendorbillline.FieldReceivedQuantity, field.TypeFloat64, value)
+	}
+	if _u.mutation.ReceivedQuantityCleared() {
+		_spec.ClearField(vendorbillline.FieldReceivedQuantity, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.OrderUnitPrice(); ok {
+		_spec.SetField(vendorbillline.FieldOrderUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOrderUnitPrice(); ok {
+		_spec.AddField(vendorbillline.FieldOrderUnitPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.OrderUnitPriceCleared() {
+		_spec.ClearField(vendorbillline.FieldOrderUnitPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(vendorbillline.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.BillCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vendorbillline.BillTable,
+			Columns: []string{vendorbillline.BillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendorbill.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BillIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vendorbillline.BillTable,
+			Columns: []string{vendorbillline.BillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendorbill.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &VendorBillLine{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vendorbillline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,607 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wallet
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldTenantID, v))
+}
+
+// OwnerType applies equality check predicate on the "owner_type" field. It's identical to OwnerTypeEQ.
+func OwnerType(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldOwnerType, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldName, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Balance applies equality check predicate on the "balance" field. It's identical to BalanceEQ.
+func Balance(v decimal.Decimal) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldBalance, v))
+}
+
+// HeldAmount applies equality check predicate on the "held_amount" field. It's identical to HeldAmountEQ.
+func HeldAmount(v decimal.Decimal) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldHeldAmount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldTenantID, v))
+}
+
+// OwnerTypeEQ applies the EQ predicate on the "owner_type" field.
+func OwnerTypeEQ(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldOwnerType, v))
+}
+
+// OwnerTypeNEQ applies the NEQ predicate on the "owner_type" field.
+func OwnerTypeNEQ(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldOwnerType, v))
+}
+
+// OwnerTypeIn applies the In predicate on the "owner_type" field.
+func OwnerTypeIn(vs ...string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldOwnerType, vs...))
+}
+
+// OwnerTypeNotIn applies the NotIn predicate on the "owner_type" field.
+func OwnerTypeNotIn(vs ...string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldOwnerType, vs...))
+}
+
+// OwnerTypeGT applies the GT predicate on the "owner_type" field.
+func OwnerTypeGT(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldOwnerType, v))
+}
+
+// OwnerTypeGTE applies the GTE predicate on the "owner_type" field.
+func OwnerTypeGTE(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldOwnerType, v))
+}
+
+// OwnerTypeLT applies the LT predicate on the "owner_type" field.
+func OwnerTypeLT(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldOwnerType, v))
+}
+
+// OwnerTypeLTE applies the LTE predicate on the "owner_type" field.
+func OwnerTypeLTE(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldOwnerType, v))
+}
+
+// OwnerTypeContains applies the Contains predicate on the "owner_type" field.
+func OwnerTypeContains(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldContains(FieldOwnerType, v))
+}
+
+// OwnerTypeHasPrefix applies the HasPrefix predicate on the "owner_type" field.
+func OwnerTypeHasPrefix(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldHasPrefix(FieldOwnerType, v))
+}
+
+// OwnerTypeHasSuffix applies the HasSuffix predicate on the "owner_type" field.
+func OwnerTypeHasSuffix(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldHasSuffix(FieldOwnerType, v))
+}
+
+// OwnerTypeEqualFold applies the EqualFold predicate on the "owner_type" field.
+func OwnerTypeEqualFold(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEqualFold(FieldOwnerType, v))
+}
+
+// OwnerTypeContainsFold applies the ContainsFold predicate on the "owner_type" field.
+func OwnerTypeContainsFold(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldContainsFold(FieldOwnerType, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldContains(FieldOwnerID, v))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldHasPrefix(FieldOwnerID, v))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldHasSuffix(FieldOwnerID, v))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEqualFold(FieldOwnerID, v))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldContainsFold(FieldOwnerID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldContainsFold(FieldName, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Wallet {
+	return predicate.Wallet(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// BalanceEQ applies the EQ predicate on the "balance" field.
+func BalanceEQ(v decimal.Decimal) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldBalance, v))
+}
+
+// BalanceNEQ applies the NEQ predicate on the "balance" field.
+func BalanceNEQ(v decimal.Decimal) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldBalance, v))
+}
+
+// BalanceIn applies the In predicate on the "balance" field.
+func BalanceIn(vs ...decimal.Decimal) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldBalance, vs...))
+}
+
+// BalanceNotIn applies the NotIn predicate on the "balance" field.
+func BalanceNotIn(vs ...decimal.Decimal) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldBalance, vs...))
+}
+
+// BalanceGT applies the GT predicate on the "balance" field.
+func BalanceGT(v decimal.Decimal) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldBalance, v))
+}
+
+// BalanceGTE applies the GTE predicate on the "balance" field.
+func BalanceGTE(v decimal.Decimal) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldBalance, v))
+}
+
+// BalanceLT applies the LT predicate on the "balance" field.
+func BalanceLT(v decimal.Decimal) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldBalance, v))
+}
+
+// BalanceLTE applies the LTE predicate on the "balance" field.
+func BalanceLTE(v decimal.Decimal) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldBalance, v))
+}
+
+// BalanceIsNil applies the IsNil predicate on the "balance" field.
+func BalanceIsNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldIsNull(FieldBalance))
+}
+
+// BalanceNotNil applies the NotNil predicate on the "balance" field.
+func BalanceNotNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotNull(FieldBalance))
+}
+
+// HeldAmountEQ applies the EQ predicate on the "held_amount" field.
+func HeldAmountEQ(v decimal.Decimal) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldHeldAmount, v))
+}
+
+// HeldAmountNEQ applies the NEQ predicate on the "held_amount" field.
+func HeldAmountNEQ(v decimal.Decimal) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldHeldAmount, v))
+}
+
+// HeldAmountIn applies the In predicate on the "held_amount" field.
+func HeldAmountIn(vs ...decimal.Decimal) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldHeldAmount, vs...))
+}
+
+// HeldAmountNotIn applies the NotIn predicate on the "held_amount" field.
+func HeldAmountNotIn(vs ...decimal.Decimal) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldHeldAmount, vs...))
+}
+
+// HeldAmountGT applies the GT predicate on the "held_amount" field.
+func HeldAmountGT(v decimal.Decimal) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldHeldAmount, v))
+}
+
+// HeldAmountGTE applies the GTE predicate on the "held_amount" field.
+func HeldAmountGTE(v decimal.Decimal) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldHeldAmount, v))
+}
+
+// HeldAmountLT applies the LT predicate on the "held_amount" field.
+func HeldAmountLT(v decimal.Decimal) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldHeldAmount, v))
+}
+
+// HeldAmountLTE applies the LTE predicate on the "held_amount" field.
+func HeldAmountLTE(v decimal.Decimal) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldHeldAmount, v))
+}
+
+// HeldAmountIsNil applies the IsNil predicate on the "held_amount" field.
+func HeldAmountIsNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldIsNull(FieldHeldAmount))
+}
+
+// HeldAmountNotNil applies the NotNil predicate on the "held_amount" field.
+func HeldAmountNotNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotNull(FieldHeldAmount))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Wallet) predicate.Wallet {
+	return predicate.Wallet(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Wallet) predicate.Wallet {
+	return predicate.Wallet(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Wallet) predicate.Wallet {
+	return predicate.Wallet(sql.NotPredicates(p))
+}
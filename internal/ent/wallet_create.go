@@ -0,0 +1,954 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/wallet"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WalletCreate is the builder for creating a Wallet entity.
+type WalletCreate struct {
+	config
+	mutation *WalletMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *WalletCreate) SetTenantID(v uuid.UUID) *WalletCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetOwnerType sets the "owner_type" field.
+func (_c *WalletCreate) SetOwnerType(v string) *WalletCreate {
+	_c.mutation.SetOwnerType(v)
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *WalletCreate) SetOwnerID(v string) *WalletCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *WalletCreate) SetName(v string) *WalletCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableName(v *string) *WalletCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *WalletCreate) SetCurrency(v string) *WalletCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableCurrency(v *string) *WalletCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetBalance sets the "balance" field.
+func (_c *WalletCreate) SetBalance(v decimal.Decimal) *WalletCreate {
+	_c.mutation.SetBalance(v)
+	return _c
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableBalance(v *decimal.Decimal) *WalletCreate {
+	if v != nil {
+		_c.SetBalance(*v)
+	}
+	return _c
+}
+
+// SetHeldAmount sets the "held_amount" field.
+func (_c *WalletCreate) SetHeldAmount(v decimal.Decimal) *WalletCreate {
+	_c.mutation.SetHeldAmount(v)
+	return _c
+}
+
+// SetNillableHeldAmount sets the "held_amount" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableHeldAmount(v *decimal.Decimal) *WalletCreate {
+	if v != nil {
+		_c.SetHeldAmount(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WalletCreate) SetCreatedAt(v time.Time) *WalletCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableCreatedAt(v *time.Time) *WalletCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WalletCreate) SetUpdatedAt(v time.Time) *WalletCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableUpdatedAt(v *time.Time) *WalletCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WalletCreate) SetID(v uuid.UUID) *WalletCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableID(v *uuid.UUID) *WalletCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the WalletMutation object of the builder.
+func (_c *WalletCreate) Mutation() *WalletMutation {
+	return _c.mutation
+}
+
+// Save creates the Wallet in the database.
+func (_c *WalletCreate) Save(ctx context.Context) (*Wallet, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WalletCreate) SaveX(ctx context.Context) *Wallet {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WalletCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WalletCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WalletCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := wallet.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := wallet.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := wallet.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := wallet.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WalletCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Wallet.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.OwnerType(); !ok {
+		return &ValidationError{Name: "owner_type", err: errors.New(`ent: missing required field "Wallet.owner_type"`)}
+	}
+	if _, ok := _c.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "Wallet.owner_id"`)}
+	}
+	if v, ok := _c.mutation.OwnerID(); ok {
+		if err := wallet.OwnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`ent: validator failed for field "Wallet.owner_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Wallet.currency"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Wallet.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Wallet.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *WalletCreate) sqlSave(ctx context.Context) (*Wallet, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WalletCreate) createSpec() (*Wallet, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Wallet{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(wallet.Table, sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(wallet.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.OwnerType(); ok {
+		_spec.SetField(wallet.FieldOwnerType, field.TypeString, value)
+		_node.OwnerType = value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(wallet.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(wallet.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(wallet.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Balance(); ok {
+		_spec.SetField(wallet.FieldBalance, field.TypeFloat64, value)
+		_node.Balance = value
+	}
+	if value, ok := _c.mutation.HeldAmount(); ok {
+		_spec.SetField(wallet.FieldHeldAmount, field.TypeFloat64, value)
+		_node.HeldAmount = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(wallet.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(wallet.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Wallet.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WalletUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WalletCreate) OnConflict(opts ...sql.ConflictOption) *WalletUpsertOne {
+	_c.conflict = opts
+	return &WalletUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Wallet.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WalletCreate) OnConflictColumns(columns ...string) *WalletUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WalletUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WalletUpsertOne is the builder for "upsert"-ing
+	//  one Wallet node.
+	WalletUpsertOne struct {
+		create *WalletCreate
+	}
+
+	// WalletUpsert is the "OnConflict" setter.
+	WalletUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WalletUpsert) SetTenantID(v uuid.UUID) *WalletUpsert {
+	u.Set(wallet.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WalletUpsert) UpdateTenantID() *WalletUpsert {
+	u.SetExcluded(wallet.FieldTenantID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *WalletUpsert) SetName(v string) *WalletUpsert {
+	u.Set(wallet.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WalletUpsert) UpdateName() *WalletUpsert {
+	u.SetExcluded(wallet.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *WalletUpsert) ClearName() *WalletUpsert {
+	u.SetNull(wallet.FieldName)
+	return u
+}
+
+// SetBalance sets the "balance" field.
+func (u *WalletUpsert) SetBalance(v decimal.Decimal) *WalletUpsert {
+	u.Set(wallet.FieldBalance, v)
+	return u
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *WalletUpsert) UpdateBalance() *WalletUpsert {
+	u.SetExcluded(wallet.FieldBalance)
+	return u
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *WalletUpsert) AddBalance(v decimal.Decimal) *WalletUpsert {
+	u.Add(wallet.FieldBalance, v)
+	return u
+}
+
+// ClearBalance clears the value of the "balance" field.
+func (u *WalletUpsert) ClearBalance() *WalletUpsert {
+	u.SetNull(wallet.FieldBalance)
+	return u
+}
+
+// SetHeldAmount sets the "held_amount" field.
+func (u *WalletUpsert) SetHeldAmount(v decimal.Decimal) *WalletUpsert {
+	u.Set(wallet.FieldHeldAmount, v)
+	return u
+}
+
+// UpdateHeldAmount sets the "held_amount" field to the value that was provided on create.
+func (u *WalletUpsert) UpdateHeldAmount() *WalletUpsert {
+	u.SetExcluded(wallet.FieldHeldAmount)
+	return u
+}
+
+// AddHeldAmount adds v to the "held_amount" field.
+func (u *WalletUpsert) AddHeldAmount(v decimal.Decimal) *WalletUpsert {
+	u.Add(wallet.FieldHeldAmount, v)
+	return u
+}
+
+// ClearHeldAmount clears the value of the "held_amount" field.
+func (u *WalletUpsert) ClearHeldAmount() *WalletUpsert {
+	u.SetNull(wallet.FieldHeldAmount)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WalletUpsert) SetUpdatedAt(v time.Time) *WalletUpsert {
+	u.Set(wallet.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WalletUpsert) UpdateUpdatedAt() *WalletUpsert {
+	u.SetExcluded(wallet.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Wallet.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(wallet.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WalletUpsertOne) UpdateNewValues() *WalletUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(wallet.FieldID)
+		}
+		if _, exists := u.create.mutation.OwnerType(); exists {
+			s.SetIgnore(wallet.FieldOwnerType)
+		}
+		if _, exists := u.create.mutation.OwnerID(); exists {
+			s.SetIgnore(wallet.FieldOwnerID)
+		}
+		if _, exists := u.create.mutation.Currency(); exists {
+			s.SetIgnore(wallet.FieldCurrency)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(wallet.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Wallet.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WalletUpsertOne) Ignore() *WalletUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WalletUpsertOne) DoNothing() *WalletUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WalletCreate.OnConflict
+// documentation for more info.
+func (u *WalletUpsertOne) Update(set func(*WalletUpsert)) *WalletUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WalletUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WalletUpsertOne) SetTenantID(v uuid.UUID) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WalletUpsertOne) UpdateTenantID() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *WalletUpsertOne) SetName(v string) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WalletUpsertOne) UpdateName() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *WalletUpsertOne) ClearName() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *WalletUpsertOne) SetBalance(v decimal.Decimal) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *WalletUpsertOne) AddBalance(v decimal.Decimal) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *WalletUpsertOne) UpdateBalance() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// ClearBalance clears the value of the "balance" field.
+func (u *WalletUpsertOne) ClearBalance() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.ClearBalance()
+	})
+}
+
+// SetHeldAmount sets the "held_amount" field.
+func (u *WalletUpsertOne) SetHeldAmount(v decimal.Decimal) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetHeldAmount(v)
+	})
+}
+
+// AddHeldAmount adds v to the "held_amount" field.
+func (u *WalletUpsertOne) AddHeldAmount(v decimal.Decimal) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.AddHeldAmount(v)
+	})
+}
+
+// UpdateHeldAmount sets the "held_amount" field to the value that was provided on create.
+func (u *WalletUpsertOne) UpdateHeldAmount() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateHeldAmount()
+	})
+}
+
+// ClearHeldAmount clears the value of the "held_amount" field.
+func (u *WalletUpsertOne) ClearHeldAmount() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.ClearHeldAmount()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WalletUpsertOne) SetUpdatedAt(v time.Time) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WalletUpsertOne) UpdateUpdatedAt() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WalletUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WalletCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WalletUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WalletUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WalletUpsertOne.ID is not supported by MySQL driver. Use WalletUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WalletUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WalletCreateBulk is the builder for creating many Wallet entities in bulk.
+type WalletCreateBulk struct {
+	config
+	err      error
+	builders []*WalletCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Wallet entities in the database.
+func (_c *WalletCreateBulk) Save(ctx context.Context) ([]*Wallet, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Wallet, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WalletMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WalletCreateBulk) SaveX(ctx context.Context) []*Wallet {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WalletCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WalletCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Wallet.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WalletUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WalletCreateBulk) OnConflict(opts ...sql.ConflictOption) *WalletUpsertBulk {
+	_c.conflict = opts
+	return &WalletUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Wallet.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WalletCreateBulk) OnConflictColumns(columns ...string) *WalletUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WalletUpsertBulk{
+		create: _c,
+	}
+}
+
+// WalletUpsertBulk is the builder for "upsert"-ing
+// a bulk of Wallet nodes.
+type WalletUpsertBulk struct {
+	create *WalletCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Wallet.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(wallet.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WalletUpsertBulk) UpdateNewValues() *WalletUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(wallet.FieldID)
+			}
+			if _, exists := b.mutation.OwnerType(); exists {
+				s.SetIgnore(wallet.FieldOwnerType)
+			}
+			if _, exists := b.mutation.OwnerID(); exists {
+				s.SetIgnore(wallet.FieldOwnerID)
+			}
+			if _, exists := b.mutation.Currency(); exists {
+				s.SetIgnore(wallet.FieldCurrency)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(wallet.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Wallet.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WalletUpsertBulk) Ignore() *WalletUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WalletUpsertBulk) DoNothing() *WalletUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WalletCreateBulk.OnConflict
+// documentation for more info.
+func (u *WalletUpsertBulk) Update(set func(*WalletUpsert)) *WalletUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WalletUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WalletUpsertBulk) SetTenantID(v uuid.UUID) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WalletUpsertBulk) UpdateTenantID() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *WalletUpsertBulk) SetName(v string) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WalletUpsertBulk) UpdateName() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *WalletUpsertBulk) ClearName() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *WalletUpsertBulk) SetBalance(v decimal.Decimal) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *WalletUpsertBulk) AddBalance(v decimal.Decimal) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *WalletUpsertBulk) UpdateBalance() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// ClearBalance clears the value of the "balance" field.
+func (u *WalletUpsertBulk) ClearBalance() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.ClearBalance()
+	})
+}
+
+// SetHeldAmount sets the "held_amount" field.
+func (u *WalletUpsertBulk) SetHeldAmount(v decimal.Decimal) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetHeldAmount(v)
+	})
+}
+
+// AddHeldAmount adds v to the "held_amount" field.
+func (u *WalletUpsertBulk) AddHeldAmount(v decimal.Decimal) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.AddHeldAmount(v)
+	})
+}
+
+// UpdateHeldAmount sets the "held_amount" field to the value that was provided on create.
+func (u *WalletUpsertBulk) UpdateHeldAmount() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateHeldAmount()
+	})
+}
+
+// ClearHeldAmount clears the value of the "held_amount" field.
+func (u *WalletUpsertBulk) ClearHeldAmount() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.ClearHeldAmount()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WalletUpsertBulk) SetUpdatedAt(v time.Time) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WalletUpsertBulk) UpdateUpdatedAt() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WalletUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WalletCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WalletCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WalletUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
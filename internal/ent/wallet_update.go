@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/wallet"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WalletUpdate is the builder for updating Wallet entities.
+type WalletUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WalletMutation
+}
+
+// Where appends a list predicates to the WalletUpdate builder.
+func (_u *WalletUpdate) Where(ps ...predicate.Wallet) *WalletUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *WalletUpdate) SetTenantID(v uuid.UUID) *WalletUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *WalletUpdate) SetNillableTenantID(v *uuid.UUID) *WalletUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *WalletUpdate) SetName(v string) *WalletUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *WalletUpdate) SetNillableName(v *string) *WalletUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *WalletUpdate) ClearName() *WalletUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetBalance sets the "balance" field.
+func (_u *WalletUpdate) SetBalance(v decimal.Decimal) *WalletUpdate {
+	_u.mutation.ResetBalance()
+	_u.mutation.SetBalance(v)
+	return _u
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_u *WalletUpdate) SetNillableBalance(v *decimal.Decimal) *WalletUpdate {
+	if v != nil {
+		_u.SetBalance(*v)
+	}
+	return _u
+}
+
+// AddBalance adds value to the "balance" field.
+func (_u *WalletUpdate) AddBalance(v decimal.Decimal) *WalletUpdate {
+	_u.mutation.AddBalance(v)
+	return _u
+}
+
+// ClearBalance clears the value of the "balance" field.
+func (_u *WalletUpdate) ClearBalance() *WalletUpdate {
+	_u.mutation.ClearBalance()
+	return _u
+}
+
+// SetHeldAmount sets the "held_amount" field.
+func (_u *WalletUpdate) SetHeldAmount(v decimal.Decimal) *WalletUpdate {
+	_u.mutation.ResetHeldAmount()
+	_u.mutation.SetHeldAmount(v)
+	return _u
+}
+
+// SetNillableHeldAmount sets the "held_amount" field if the given value is not nil.
+func (_u *WalletUpdate) SetNillableHeldAmount(v *decimal.Decimal) *WalletUpdate {
+	if v != nil {
+		_u.SetHeldAmount(*v)
+	}
+	return _u
+}
+
+// AddHeldAmount adds value to the "held_amount" field.
+func (_u *WalletUpdate) AddHeldAmount(v decimal.Decimal) *WalletUpdate {
+	_u.mutation.AddHeldAmount(v)
+	return _u
+}
+
+// ClearHeldAmount clears the value of the "held_amount" field.
+func (_u *WalletUpdate) ClearHeldAmount() *WalletUpdate {
+	_u.mutation.ClearHeldAmount()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WalletUpdate) SetUpdatedAt(v time.Time) *WalletUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the WalletMutation object of the builder.
+func (_u *WalletUpdate) Mutation() *WalletMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WalletUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WalletUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WalletUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WalletUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WalletUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := wallet.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *WalletUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wallet.Table, wallet.Columns, sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(wallet.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(wallet.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(wallet.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Balance(); ok {
+		_spec.SetField(wallet.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalance(); ok {
+		_spec.AddField(wallet.FieldBalance, field.TypeFloat64, value)
+	}
+	if _u.mutation.BalanceCleared() {
+		_spec.ClearField(wallet.FieldBalance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.HeldAmount(); ok {
+		_spec.SetField(wallet.FieldHeldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeldAmount(); ok {
+		_spec.AddField(wallet.FieldHeldAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeldAmountCleared() {
+		_spec.ClearField(wallet.FieldHeldAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(wallet.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wallet.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WalletUpdateOne is the builder for updating a single Wallet entity.
+type WalletUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WalletMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *WalletUpdateOne) SetTenantID(v uuid.UUID) *WalletUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *WalletUpdateOne) SetNillableTenantID(v *uuid.UUID) *WalletUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *WalletUpdateOne) SetName(v string) *WalletUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *WalletUpdateOne) SetNillableName(v *string) *WalletUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *WalletUpdateOne) ClearName() *WalletUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetBalance sets the "balance" field.
+func (_u *WalletUpdateOne) SetBalance(v decimal.Decimal) *WalletUpdateOne {
+	_u.mutation.ResetBalance()
+	_u.mutation.SetBalance(v)
+	return _u
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_u *WalletUpdateOne) SetNillableBalance(v *decimal.Decimal) *WalletUpdateOne {
+	if v != nil {
+		_u.SetBalance(*v)
+	}
+	return _u
+}
+
+// AddBalance adds value to the "balance" field.
+func (_u *WalletUpdateOne) AddBalance(v decimal.Decimal) *WalletUpdateOne {
+	_u.mutation.AddBalance(v)
+	return _u
+}
+
+// ClearBalance clears the value of the "balance" field.
+func (_u *WalletUpdateOne) ClearBalance() *WalletUpdateOne {
+	_u.mutation.ClearBalance()
+	return _u
+}
+
+// SetHeldAmount sets the "held_amount" field.
+func (_u *WalletUpdateOne) SetHeldAmount(v decimal.Decimal) *WalletUpdateOne {
+	_u.mutation.ResetHeldAmount()
+	_u.mutation.SetHeldAmount(v)
+	return _u
+}
+
+// SetNillableHeldAmount sets the "held_amount" field if the given value is not nil.
+func (_u *WalletUpdateOne) SetNillableHeldAmount(v *decimal.Decimal) *WalletUpdateOne {
+	if v != nil {
+		_u.SetHeldAmount(*v)
+	}
+	return _u
+}
+
+// AddHeldAmount adds value to the "held_amount" field.
+func (_u *WalletUpdateOne) AddHeldAmount(v decimal.Decimal) *WalletUpdateOne {
+	_u.mutation.AddHeldAmount(v)
+	return _u
+}
+
+// ClearHeldAmount clears the value of the "held_amount" field.
+func (_u *WalletUpdateOne) ClearHeldAmount() *WalletUpdateOne {
+	_u.mutation.ClearHeldAmount()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WalletUpdateOne) SetUpdatedAt(v time.Time) *WalletUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the WalletMutation object of the builder.
+func (_u *WalletUpdateOne) Mutation() *WalletMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WalletUpdate builder.
+func (_u *WalletUpdateOne) Where(ps ...predicate.Wallet) *WalletUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WalletUpdateOne) Select(field string, fields ...string) *WalletUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Wallet entity.
+func (_u *WalletUpdateOne) Save(ctx context.Context) (*Wallet, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WalletUpdateOne) SaveX(ctx context.Context) *Wallet {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WalletUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WalletUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WalletUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := wallet.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *WalletUpdateOne) sqlSave(ctx context.Context) (_node *Wallet, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wallet.Table, wallet.Columns, sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Wallet.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wallet.FieldID)
+		for _, f := range fields {
+			if !wallet.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wallet.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(wallet.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(wallet.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(wallet.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Balance(); ok {
+		_spec.SetField(wallet.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalance(); ok {
+		_spec.AddField(wallet.FieldBalance, field.TypeFloat64, value)
+	}
+	if _u.mutation.BalanceCleared() {
+		_spec.ClearField(wallet.FieldBalance, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.HeldAmount(); ok {
+		_spec.SetField(wallet.FieldHeldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeldAmount(); ok {
+		_spec.AddField(wallet.FieldHeldAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeldAmountCleared() {
+		_spec.ClearField(wallet.FieldHeldAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(wallet.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Wallet{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wallet.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
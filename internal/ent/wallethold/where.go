@@ -0,0 +1,747 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wallethold
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldTenantID, v))
+}
+
+// WalletID applies equality check predicate on the "wallet_id" field. It's identical to WalletIDEQ.
+func WalletID(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldWalletID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldAmount, v))
+}
+
+// CapturedAmount applies equality check predicate on the "captured_amount" field. It's identical to CapturedAmountEQ.
+func CapturedAmount(v decimal.Decimal) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldCapturedAmount, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldStatus, v))
+}
+
+// Reference applies equality check predicate on the "reference" field. It's identical to ReferenceEQ.
+func Reference(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldReference, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldDescription, v))
+}
+
+// TransactionID applies equality check predicate on the "transaction_id" field. It's identical to TransactionIDEQ.
+func TransactionID(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// ClosedAt applies equality check predicate on the "closed_at" field. It's identical to ClosedAtEQ.
+func ClosedAt(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLTE(FieldTenantID, v))
+}
+
+// WalletIDEQ applies the EQ predicate on the "wallet_id" field.
+func WalletIDEQ(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldWalletID, v))
+}
+
+// WalletIDNEQ applies the NEQ predicate on the "wallet_id" field.
+func WalletIDNEQ(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNEQ(FieldWalletID, v))
+}
+
+// WalletIDIn applies the In predicate on the "wallet_id" field.
+func WalletIDIn(vs ...uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIn(FieldWalletID, vs...))
+}
+
+// WalletIDNotIn applies the NotIn predicate on the "wallet_id" field.
+func WalletIDNotIn(vs ...uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotIn(FieldWalletID, vs...))
+}
+
+// WalletIDGT applies the GT predicate on the "wallet_id" field.
+func WalletIDGT(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGT(FieldWalletID, v))
+}
+
+// WalletIDGTE applies the GTE predicate on the "wallet_id" field.
+func WalletIDGTE(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGTE(FieldWalletID, v))
+}
+
+// WalletIDLT applies the LT predicate on the "wallet_id" field.
+func WalletIDLT(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLT(FieldWalletID, v))
+}
+
+// WalletIDLTE applies the LTE predicate on the "wallet_id" field.
+func WalletIDLTE(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLTE(FieldWalletID, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLTE(FieldAmount, v))
+}
+
+// CapturedAmountEQ applies the EQ predicate on the "captured_amount" field.
+func CapturedAmountEQ(v decimal.Decimal) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldCapturedAmount, v))
+}
+
+// CapturedAmountNEQ applies the NEQ predicate on the "captured_amount" field.
+func CapturedAmountNEQ(v decimal.Decimal) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNEQ(FieldCapturedAmount, v))
+}
+
+// CapturedAmountIn applies the In predicate on the "captured_amount" field.
+func CapturedAmountIn(vs ...decimal.Decimal) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIn(FieldCapturedAmount, vs...))
+}
+
+// CapturedAmountNotIn applies the NotIn predicate on the "captured_amount" field.
+func CapturedAmountNotIn(vs ...decimal.Decimal) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotIn(FieldCapturedAmount, vs...))
+}
+
+// CapturedAmountGT applies the GT predicate on the "captured_amount" field.
+func CapturedAmountGT(v decimal.Decimal) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGT(FieldCapturedAmount, v))
+}
+
+// CapturedAmountGTE applies the GTE predicate on the "captured_amount" field.
+func CapturedAmountGTE(v decimal.Decimal) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGTE(FieldCapturedAmount, v))
+}
+
+// CapturedAmountLT applies the LT predicate on the "captured_amount" field.
+func CapturedAmountLT(v decimal.Decimal) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLT(FieldCapturedAmount, v))
+}
+
+// CapturedAmountLTE applies the LTE predicate on the "captured_amount" field.
+func CapturedAmountLTE(v decimal.Decimal) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLTE(FieldCapturedAmount, v))
+}
+
+// CapturedAmountIsNil applies the IsNil predicate on the "captured_amount" field.
+func CapturedAmountIsNil() predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIsNull(FieldCapturedAmount))
+}
+
+// CapturedAmountNotNil applies the NotNil predicate on the "captured_amount" field.
+func CapturedAmountNotNil() predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotNull(FieldCapturedAmount))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ReferenceEQ applies the EQ predicate on the "reference" field.
+func ReferenceEQ(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldReference, v))
+}
+
+// ReferenceNEQ applies the NEQ predicate on the "reference" field.
+func ReferenceNEQ(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNEQ(FieldReference, v))
+}
+
+// ReferenceIn applies the In predicate on the "reference" field.
+func ReferenceIn(vs ...string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIn(FieldReference, vs...))
+}
+
+// ReferenceNotIn applies the NotIn predicate on the "reference" field.
+func ReferenceNotIn(vs ...string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotIn(FieldReference, vs...))
+}
+
+// ReferenceGT applies the GT predicate on the "reference" field.
+func ReferenceGT(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGT(FieldReference, v))
+}
+
+// ReferenceGTE applies the GTE predicate on the "reference" field.
+func ReferenceGTE(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGTE(FieldReference, v))
+}
+
+// ReferenceLT applies the LT predicate on the "reference" field.
+func ReferenceLT(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLT(FieldReference, v))
+}
+
+// ReferenceLTE applies the LTE predicate on the "reference" field.
+func ReferenceLTE(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLTE(FieldReference, v))
+}
+
+// ReferenceContains applies the Contains predicate on the "reference" field.
+func ReferenceContains(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldContains(FieldReference, v))
+}
+
+// ReferenceHasPrefix applies the HasPrefix predicate on the "reference" field.
+func ReferenceHasPrefix(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldHasPrefix(FieldReference, v))
+}
+
+// ReferenceHasSuffix applies the HasSuffix predicate on the "reference" field.
+func ReferenceHasSuffix(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldHasSuffix(FieldReference, v))
+}
+
+// ReferenceIsNil applies the IsNil predicate on the "reference" field.
+func ReferenceIsNil() predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIsNull(FieldReference))
+}
+
+// ReferenceNotNil applies the NotNil predicate on the "reference" field.
+func ReferenceNotNil() predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotNull(FieldReference))
+}
+
+// ReferenceEqualFold applies the EqualFold predicate on the "reference" field.
+func ReferenceEqualFold(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEqualFold(FieldReference, v))
+}
+
+// ReferenceContainsFold applies the ContainsFold predicate on the "reference" field.
+func ReferenceContainsFold(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldContainsFold(FieldReference, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// TransactionIDEQ applies the EQ predicate on the "transaction_id" field.
+func TransactionIDEQ(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// TransactionIDNEQ applies the NEQ predicate on the "transaction_id" field.
+func TransactionIDNEQ(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNEQ(FieldTransactionID, v))
+}
+
+// TransactionIDIn applies the In predicate on the "transaction_id" field.
+func TransactionIDIn(vs ...uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDNotIn applies the NotIn predicate on the "transaction_id" field.
+func TransactionIDNotIn(vs ...uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDGT applies the GT predicate on the "transaction_id" field.
+func TransactionIDGT(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGT(FieldTransactionID, v))
+}
+
+// TransactionIDGTE applies the GTE predicate on the "transaction_id" field.
+func TransactionIDGTE(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGTE(FieldTransactionID, v))
+}
+
+// TransactionIDLT applies the LT predicate on the "transaction_id" field.
+func TransactionIDLT(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLT(FieldTransactionID, v))
+}
+
+// TransactionIDLTE applies the LTE predicate on the "transaction_id" field.
+func TransactionIDLTE(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLTE(FieldTransactionID, v))
+}
+
+// TransactionIDIsNil applies the IsNil predicate on the "transaction_id" field.
+func TransactionIDIsNil() predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIsNull(FieldTransactionID))
+}
+
+// TransactionIDNotNil applies the NotNil predicate on the "transaction_id" field.
+func TransactionIDNotNil() predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotNull(FieldTransactionID))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uuid.UUID) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// ClosedAtEQ applies the EQ predicate on the "closed_at" field.
+func ClosedAtEQ(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// ClosedAtNEQ applies the NEQ predicate on the "closed_at" field.
+func ClosedAtNEQ(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNEQ(FieldClosedAt, v))
+}
+
+// ClosedAtIn applies the In predicate on the "closed_at" field.
+func ClosedAtIn(vs ...time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtNotIn applies the NotIn predicate on the "closed_at" field.
+func ClosedAtNotIn(vs ...time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtGT applies the GT predicate on the "closed_at" field.
+func ClosedAtGT(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGT(FieldClosedAt, v))
+}
+
+// ClosedAtGTE applies the GTE predicate on the "closed_at" field.
+func ClosedAtGTE(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGTE(FieldClosedAt, v))
+}
+
+// ClosedAtLT applies the LT predicate on the "closed_at" field.
+func ClosedAtLT(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLT(FieldClosedAt, v))
+}
+
+// ClosedAtLTE applies the LTE predicate on the "closed_at" field.
+func ClosedAtLTE(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLTE(FieldClosedAt, v))
+}
+
+// ClosedAtIsNil applies the IsNil predicate on the "closed_at" field.
+func ClosedAtIsNil() predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIsNull(FieldClosedAt))
+}
+
+// ClosedAtNotNil applies the NotNil predicate on the "closed_at" field.
+func ClosedAtNotNil() predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotNull(FieldClosedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.WalletHold {
+	return predicate.WalletHold(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WalletHold) predicate.WalletHold {
+	return predicate.WalletHold(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WalletHold) predicate.WalletHold {
+	return predicate.WalletHold(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WalletHold) predicate.WalletHold {
+	return predicate.WalletHold(sql.NotPredicates(p))
+}
@@ -0,0 +1,1089 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/wallethold"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WalletHoldCreate is the builder for creating a WalletHold entity.
+type WalletHoldCreate struct {
+	config
+	mutation *WalletHoldMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *WalletHoldCreate) SetTenantID(v uuid.UUID) *WalletHoldCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (_c *WalletHoldCreate) SetWalletID(v uuid.UUID) *WalletHoldCreate {
+	_c.mutation.SetWalletID(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *WalletHoldCreate) SetAmount(v decimal.Decimal) *WalletHoldCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (_c *WalletHoldCreate) SetCapturedAmount(v decimal.Decimal) *WalletHoldCreate {
+	_c.mutation.SetCapturedAmount(v)
+	return _c
+}
+
+// SetNillableCapturedAmount sets the "captured_amount" field if the given value is not nil.
+func (_c *WalletHoldCreate) SetNillableCapturedAmount(v *decimal.Decimal) *WalletHoldCreate {
+	if v != nil {
+		_c.SetCapturedAmount(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *WalletHoldCreate) SetStatus(v string) *WalletHoldCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *WalletHoldCreate) SetNillableStatus(v *string) *WalletHoldCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetReference sets the "reference" field.
+func (_c *WalletHoldCreate) SetReference(v string) *WalletHoldCreate {
+	_c.mutation.SetReference(v)
+	return _c
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_c *WalletHoldCreate) SetNillableReference(v *string) *WalletHoldCreate {
+	if v != nil {
+		_c.SetReference(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *WalletHoldCreate) SetDescription(v string) *WalletHoldCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *WalletHoldCreate) SetNillableDescription(v *string) *WalletHoldCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_c *WalletHoldCreate) SetTransactionID(v uuid.UUID) *WalletHoldCreate {
+	_c.mutation.SetTransactionID(v)
+	return _c
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_c *WalletHoldCreate) SetNillableTransactionID(v *uuid.UUID) *WalletHoldCreate {
+	if v != nil {
+		_c.SetTransactionID(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *WalletHoldCreate) SetCreatedBy(v uuid.UUID) *WalletHoldCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *WalletHoldCreate) SetNillableCreatedBy(v *uuid.UUID) *WalletHoldCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_c *WalletHoldCreate) SetClosedAt(v time.Time) *WalletHoldCreate {
+	_c.mutation.SetClosedAt(v)
+	return _c
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_c *WalletHoldCreate) SetNillableClosedAt(v *time.Time) *WalletHoldCreate {
+	if v != nil {
+		_c.SetClosedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WalletHoldCreate) SetCreatedAt(v time.Time) *WalletHoldCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WalletHoldCreate) SetNillableCreatedAt(v *time.Time) *WalletHoldCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WalletHoldCreate) SetUpdatedAt(v time.Time) *WalletHoldCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WalletHoldCreate) SetNillableUpdatedAt(v *time.Time) *WalletHoldCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WalletHoldCreate) SetID(v uuid.UUID) *WalletHoldCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WalletHoldCreate) SetNillableID(v *uuid.UUID) *WalletHoldCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the WalletHoldMutation object of the builder.
+func (_c *WalletHoldCreate) Mutation() *WalletHoldMutation {
+	return _c.mutation
+}
+
+// Save creates the WalletHold in the database.
+func (_c *WalletHoldCreate) Save(ctx context.Context) (*WalletHold, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WalletHoldCreate) SaveX(ctx context.Context) *WalletHold {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WalletHoldCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WalletHoldCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WalletHoldCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := wallethold.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := wallethold.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := wallethold.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := wallethold.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WalletHoldCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "WalletHold.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet_id", err: errors.New(`ent: missing required field "WalletHold.wallet_id"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "WalletHold.amount"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WalletHold.status"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WalletHold.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WalletHold.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *WalletHoldCreate) sqlSave(ctx context.Context) (*WalletHold, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WalletHoldCreate) createSpec() (*WalletHold, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WalletHold{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(wallethold.Table, sqlgraph.NewFieldSpec(wallethold.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(wallethold.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.WalletID(); ok {
+		_spec.SetField(wallethold.FieldWalletID, field.TypeUUID, value)
+		_node.WalletID = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(wallethold.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.CapturedAmount(); ok {
+		_spec.SetField(wallethold.FieldCapturedAmount, field.TypeFloat64, value)
+		_node.CapturedAmount = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(wallethold.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Reference(); ok {
+		_spec.SetField(wallethold.FieldReference, field.TypeString, value)
+		_node.Reference = &value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(wallethold.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.TransactionID(); ok {
+		_spec.SetField(wallethold.FieldTransactionID, field.TypeUUID, value)
+		_node.TransactionID = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(wallethold.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.ClosedAt(); ok {
+		_spec.SetField(wallethold.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(wallethold.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(wallethold.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WalletHold.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WalletHoldUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WalletHoldCreate) OnConflict(opts ...sql.ConflictOption) *WalletHoldUpsertOne {
+	_c.conflict = opts
+	return &WalletHoldUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WalletHold.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WalletHoldCreate) OnConflictColumns(columns ...string) *WalletHoldUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WalletHoldUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WalletHoldUpsertOne is the builder for "upsert"-ing
+	//  one WalletHold node.
+	WalletHoldUpsertOne struct {
+		create *WalletHoldCreate
+	}
+
+	// WalletHoldUpsert is the "OnConflict" setter.
+	WalletHoldUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WalletHoldUpsert) SetTenantID(v uuid.UUID) *WalletHoldUpsert {
+	u.Set(wallethold.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WalletHoldUpsert) UpdateTenantID() *WalletHoldUpsert {
+	u.SetExcluded(wallethold.FieldTenantID)
+	return u
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (u *WalletHoldUpsert) SetCapturedAmount(v decimal.Decimal) *WalletHoldUpsert {
+	u.Set(wallethold.FieldCapturedAmount, v)
+	return u
+}
+
+// UpdateCapturedAmount sets the "captured_amount" field to the value that was provided on create.
+func (u *WalletHoldUpsert) UpdateCapturedAmount() *WalletHoldUpsert {
+	u.SetExcluded(wallethold.FieldCapturedAmount)
+	return u
+}
+
+// AddCapturedAmount adds v to the "captured_amount" field.
+func (u *WalletHoldUpsert) AddCapturedAmount(v decimal.Decimal) *WalletHoldUpsert {
+	u.Add(wallethold.FieldCapturedAmount, v)
+	return u
+}
+
+// ClearCapturedAmount clears the value of the "captured_amount" field.
+func (u *WalletHoldUpsert) ClearCapturedAmount() *WalletHoldUpsert {
+	u.SetNull(wallethold.FieldCapturedAmount)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *WalletHoldUpsert) SetStatus(v string) *WalletHoldUpsert {
+	u.Set(wallethold.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WalletHoldUpsert) UpdateStatus() *WalletHoldUpsert {
+	u.SetExcluded(wallethold.FieldStatus)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *WalletHoldUpsert) SetDescription(v string) *WalletHoldUpsert {
+	u.Set(wallethold.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *WalletHoldUpsert) UpdateDescription() *WalletHoldUpsert {
+	u.SetExcluded(wallethold.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *WalletHoldUpsert) ClearDescription() *WalletHoldUpsert {
+	u.SetNull(wallethold.FieldDescription)
+	return u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *WalletHoldUpsert) SetTransactionID(v uuid.UUID) *WalletHoldUpsert {
+	u.Set(wallethold.FieldTransactionID, v)
+	return u
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *WalletHoldUpsert) UpdateTransactionID() *WalletHoldUpsert {
+	u.SetExcluded(wallethold.FieldTransactionID)
+	return u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *WalletHoldUpsert) ClearTransactionID() *WalletHoldUpsert {
+	u.SetNull(wallethold.FieldTransactionID)
+	return u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *WalletHoldUpsert) SetClosedAt(v time.Time) *WalletHoldUpsert {
+	u.Set(wallethold.FieldClosedAt, v)
+	return u
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *WalletHoldUpsert) UpdateClosedAt() *WalletHoldUpsert {
+	u.SetExcluded(wallethold.FieldClosedAt)
+	return u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *WalletHoldUpsert) ClearClosedAt() *WalletHoldUpsert {
+	u.SetNull(wallethold.FieldClosedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WalletHoldUpsert) SetUpdatedAt(v time.Time) *WalletHoldUpsert {
+	u.Set(wallethold.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WalletHoldUpsert) UpdateUpdatedAt() *WalletHoldUpsert {
+	u.SetExcluded(wallethold.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WalletHold.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(wallethold.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WalletHoldUpsertOne) UpdateNewValues() *WalletHoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(wallethold.FieldID)
+		}
+		if _, exists := u.create.mutation.WalletID(); exists {
+			s.SetIgnore(wallethold.FieldWalletID)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(wallethold.FieldAmount)
+		}
+		if _, exists := u.create.mutation.Reference(); exists {
+			s.SetIgnore(wallethold.FieldReference)
+		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(wallethold.FieldCreatedBy)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(wallethold.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WalletHold.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WalletHoldUpsertOne) Ignore() *WalletHoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WalletHoldUpsertOne) DoNothing() *WalletHoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WalletHoldCreate.OnConflict
+// documentation for more info.
+func (u *WalletHoldUpsertOne) Update(set func(*WalletHoldUpsert)) *WalletHoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WalletHoldUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WalletHoldUpsertOne) SetTenantID(v uuid.UUID) *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WalletHoldUpsertOne) UpdateTenantID() *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (u *WalletHoldUpsertOne) SetCapturedAmount(v decimal.Decimal) *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.SetCapturedAmount(v)
+	})
+}
+
+// AddCapturedAmount adds v to the "captured_amount" field.
+func (u *WalletHoldUpsertOne) AddCapturedAmount(v decimal.Decimal) *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.AddCapturedAmount(v)
+	})
+}
+
+// UpdateCapturedAmount sets the "captured_amount" field to the value that was provided on create.
+func (u *WalletHoldUpsertOne) UpdateCapturedAmount() *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.UpdateCapturedAmount()
+	})
+}
+
+// ClearCapturedAmount clears the value of the "captured_amount" field.
+func (u *WalletHoldUpsertOne) ClearCapturedAmount() *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.ClearCapturedAmount()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WalletHoldUpsertOne) SetStatus(v string) *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WalletHoldUpsertOne) UpdateStatus() *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *WalletHoldUpsertOne) SetDescription(v string) *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *WalletHoldUpsertOne) UpdateDescription() *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *WalletHoldUpsertOne) ClearDescription() *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *WalletHoldUpsertOne) SetTransactionID(v uuid.UUID) *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *WalletHoldUpsertOne) UpdateTransactionID() *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *WalletHoldUpsertOne) ClearTransactionID() *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.ClearTransactionID()
+	})
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *WalletHoldUpsertOne) SetClosedAt(v time.Time) *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.SetClosedAt(v)
+	})
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *WalletHoldUpsertOne) UpdateClosedAt() *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.UpdateClosedAt()
+	})
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *WalletHoldUpsertOne) ClearClosedAt() *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.ClearClosedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WalletHoldUpsertOne) SetUpdatedAt(v time.Time) *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WalletHoldUpsertOne) UpdateUpdatedAt() *WalletHoldUpsertOne {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WalletHoldUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WalletHoldCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WalletHoldUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WalletHoldUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WalletHoldUpsertOne.ID is not supported by MySQL driver. Use WalletHoldUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WalletHoldUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WalletHoldCreateBulk is the builder for creating many WalletHold entities in bulk.
+type WalletHoldCreateBulk struct {
+	config
+	err      error
+	builders []*WalletHoldCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WalletHold entities in the database.
+func (_c *WalletHoldCreateBulk) Save(ctx context.Context) ([]*WalletHold, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WalletHold, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WalletHoldMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WalletHoldCreateBulk) SaveX(ctx context.Context) []*WalletHold {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WalletHoldCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WalletHoldCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WalletHold.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WalletHoldUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WalletHoldCreateBulk) OnConflict(opts ...sql.ConflictOption) *WalletHoldUpsertBulk {
+	_c.conflict = opts
+	return &WalletHoldUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WalletHold.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WalletHoldCreateBulk) OnConflictColumns(columns ...string) *WalletHoldUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WalletHoldUpsertBulk{
+		create: _c,
+	}
+}
+
+// WalletHoldUpsertBulk is the builder for "upsert"-ing
+// a bulk of WalletHold nodes.
+type WalletHoldUpsertBulk struct {
+	create *WalletHoldCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WalletHold.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(wallethold.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WalletHoldUpsertBulk) UpdateNewValues() *WalletHoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(wallethold.FieldID)
+			}
+			if _, exists := b.mutation.WalletID(); exists {
+				s.SetIgnore(wallethold.FieldWalletID)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(wallethold.FieldAmount)
+			}
+			if _, exists := b.mutation.Reference(); exists {
+				s.SetIgnore(wallethold.FieldReference)
+			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(wallethold.FieldCreatedBy)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(wallethold.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WalletHold.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WalletHoldUpsertBulk) Ignore() *WalletHoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WalletHoldUpsertBulk) DoNothing() *WalletHoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WalletHoldCreateBulk.OnConflict
+// documentation for more info.
+func (u *WalletHoldUpsertBulk) Update(set func(*WalletHoldUpsert)) *WalletHoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WalletHoldUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WalletHoldUpsertBulk) SetTenantID(v uuid.UUID) *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WalletHoldUpsertBulk) UpdateTenantID() *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (u *WalletHoldUpsertBulk) SetCapturedAmount(v decimal.Decimal) *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.SetCapturedAmount(v)
+	})
+}
+
+// AddCapturedAmount adds v to the "captured_amount" field.
+func (u *WalletHoldUpsertBulk) AddCapturedAmount(v decimal.Decimal) *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.AddCapturedAmount(v)
+	})
+}
+
+// UpdateCapturedAmount sets the "captured_amount" field to the value that was provided on create.
+func (u *WalletHoldUpsertBulk) UpdateCapturedAmount() *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.UpdateCapturedAmount()
+	})
+}
+
+// ClearCapturedAmount clears the value of the "captured_amount" field.
+func (u *WalletHoldUpsertBulk) ClearCapturedAmount() *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.ClearCapturedAmount()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WalletHoldUpsertBulk) SetStatus(v string) *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WalletHoldUpsertBulk) UpdateStatus() *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *WalletHoldUpsertBulk) SetDescription(v string) *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *WalletHoldUpsertBulk) UpdateDescription() *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *WalletHoldUpsertBulk) ClearDescription() *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *WalletHoldUpsertBulk) SetTransactionID(v uuid.UUID) *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *WalletHoldUpsertBulk) UpdateTransactionID() *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *WalletHoldUpsertBulk) ClearTransactionID() *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.ClearTransactionID()
+	})
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *WalletHoldUpsertBulk) SetClosedAt(v time.Time) *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.SetClosedAt(v)
+	})
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *WalletHoldUpsertBulk) UpdateClosedAt() *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.UpdateClosedAt()
+	})
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *WalletHoldUpsertBulk) ClearClosedAt() *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.ClearClosedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WalletHoldUpsertBulk) SetUpdatedAt(v time.Time) *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WalletHoldUpsertBulk) UpdateUpdatedAt() *WalletHoldUpsertBulk {
+	return u.Update(func(s *WalletHoldUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WalletHoldUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WalletHoldCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WalletHoldCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WalletHoldUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,522 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/wallethold"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WalletHoldUpdate is the builder for updating WalletHold entities.
+type WalletHoldUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WalletHoldMutation
+}
+
+// Where appends a list predicates to the WalletHoldUpdate builder.
+func (_u *WalletHoldUpdate) Where(ps ...predicate.WalletHold) *WalletHoldUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *WalletHoldUpdate) SetTenantID(v uuid.UUID) *WalletHoldUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *WalletHoldUpdate) SetNillableTenantID(v *uuid.UUID) *WalletHoldUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (_u *WalletHoldUpdate) SetCapturedAmount(v decimal.Decimal) *WalletHoldUpdate {
+	_u.mutation.ResetCapturedAmount()
+	_u.mutation.SetCapturedAmount(v)
+	return _u
+}
+
+// SetNillableCapturedAmount sets the "captured_amount" field if the given value is not nil.
+func (_u *WalletHoldUpdate) SetNillableCapturedAmount(v *decimal.Decimal) *WalletHoldUpdate {
+	if v != nil {
+		_u.SetCapturedAmount(*v)
+	}
+	return _u
+}
+
+// AddCapturedAmount adds value to the "captured_amount" field.
+func (_u *WalletHoldUpdate) AddCapturedAmount(v decimal.Decimal) *WalletHoldUpdate {
+	_u.mutation.AddCapturedAmount(v)
+	return _u
+}
+
+// ClearCapturedAmount clears the value of the "captured_amount" field.
+func (_u *WalletHoldUpdate) ClearCapturedAmount() *WalletHoldUpdate {
+	_u.mutation.ClearCapturedAmount()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WalletHoldUpdate) SetStatus(v string) *WalletHoldUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WalletHoldUpdate) SetNillableStatus(v *string) *WalletHoldUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *WalletHoldUpdate) SetDescription(v string) *WalletHoldUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *WalletHoldUpdate) SetNillableDescription(v *string) *WalletHoldUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *WalletHoldUpdate) ClearDescription() *WalletHoldUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_u *WalletHoldUpdate) SetTransactionID(v uuid.UUID) *WalletHoldUpdate {
+	_u.mutation.SetTransactionID(v)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_u *WalletHoldUpdate) SetNillableTransactionID(v *uuid.UUID) *WalletHoldUpdate {
+	if v != nil {
+		_u.SetTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (_u *WalletHoldUpdate) ClearTransactionID() *WalletHoldUpdate {
+	_u.mutation.ClearTransactionID()
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *WalletHoldUpdate) SetClosedAt(v time.Time) *WalletHoldUpdate {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *WalletHoldUpdate) SetNillableClosedAt(v *time.Time) *WalletHoldUpdate {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *WalletHoldUpdate) ClearClosedAt() *WalletHoldUpdate {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WalletHoldUpdate) SetUpdatedAt(v time.Time) *WalletHoldUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the WalletHoldMutation object of the builder.
+func (_u *WalletHoldUpdate) Mutation() *WalletHoldMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WalletHoldUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WalletHoldUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WalletHoldUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WalletHoldUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WalletHoldUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := wallethold.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *WalletHoldUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wallethold.Table, wallethold.Columns, sqlgraph.NewFieldSpec(wallethold.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(wallethold.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.CapturedAmount(); ok {
+		_spec.SetField(wallethold.FieldCapturedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCapturedAmount(); ok {
+		_spec.AddField(wallethold.FieldCapturedAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.CapturedAmountCleared() {
+		_spec.ClearField(wallethold.FieldCapturedAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(wallethold.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(wallethold.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(wallethold.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(wallethold.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.TransactionID(); ok {
+		_spec.SetField(wallethold.FieldTransactionID, field.TypeUUID, value)
+	}
+	if _u.mutation.TransactionIDCleared() {
+		_spec.ClearField(wallethold.FieldTransactionID, field.TypeUUID)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(wallethold.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(wallethold.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(wallethold.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(wallethold.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wallethold.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WalletHoldUpdateOne is the builder for updating a single WalletHold entity.
+type WalletHoldUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WalletHoldMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *WalletHoldUpdateOne) SetTenantID(v uuid.UUID) *WalletHoldUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *WalletHoldUpdateOne) SetNillableTenantID(v *uuid.UUID) *WalletHoldUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (_u *WalletHoldUpdateOne) SetCapturedAmount(v decimal.Decimal) *WalletHoldUpdateOne {
+	_u.mutation.ResetCapturedAmount()
+	_u.mutation.SetCapturedAmount(v)
+	return _u
+}
+
+// SetNillableCapturedAmount sets the "captured_amount" field if the given value is not nil.
+func (_u *WalletHoldUpdateOne) SetNillableCapturedAmount(v *decimal.Decimal) *WalletHoldUpdateOne {
+	if v != nil {
+		_u.SetCapturedAmount(*v)
+	}
+	return _u
+}
+
+// AddCapturedAmount adds value to the "captured_amount" field.
+func (_u *WalletHoldUpdateOne) AddCapturedAmount(v decimal.Decimal) *WalletHoldUpdateOne {
+	_u.mutation.AddCapturedAmount(v)
+	return _u
+}
+
+// ClearCapturedAmount clears the value of the "captured_amount" field.
+func (_u *WalletHoldUpdateOne) ClearCapturedAmount() *WalletHoldUpdateOne {
+	_u.mutation.ClearCapturedAmount()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WalletHoldUpdateOne) SetStatus(v string) *WalletHoldUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WalletHoldUpdateOne) SetNillableStatus(v *string) *WalletHoldUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *WalletHoldUpdateOne) SetDescription(v string) *WalletHoldUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *WalletHoldUpdateOne) SetNillableDescription(v *string) *WalletHoldUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *WalletHoldUpdateOne) ClearDescription() *WalletHoldUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_u *WalletHoldUpdateOne) SetTransactionID(v uuid.UUID) *WalletHoldUpdateOne {
+	_u.mutation.SetTransactionID(v)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_u *WalletHoldUpdateOne) SetNillableTransactionID(v *uuid.UUID) *WalletHoldUpdateOne {
+	if v != nil {
+		_u.SetTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (_u *WalletHoldUpdateOne) ClearTransactionID() *WalletHoldUpdateOne {
+	_u.mutation.ClearTransactionID()
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *WalletHoldUpdateOne) SetClosedAt(v time.Time) *WalletHoldUpdateOne {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *WalletHoldUpdateOne) SetNillableClosedAt(v *time.Time) *WalletHoldUpdateOne {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *WalletHoldUpdateOne) ClearClosedAt() *WalletHoldUpdateOne {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WalletHoldUpdateOne) SetUpdatedAt(v time.Time) *WalletHoldUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the WalletHoldMutation object of the builder.
+func (_u *WalletHoldUpdateOne) Mutation() *WalletHoldMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WalletHoldUpdate builder.
+func (_u *WalletHoldUpdateOne) Where(ps ...predicate.WalletHold) *WalletHoldUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WalletHoldUpdateOne) Select(field string, fields ...string) *WalletHoldUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WalletHold entity.
+func (_u *WalletHoldUpdateOne) Save(ctx context.Context) (*WalletHold, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WalletHoldUpdateOne) SaveX(ctx context.Context) *WalletHold {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WalletHoldUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WalletHoldUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WalletHoldUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := wallethold.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *WalletHoldUpdateOne) sqlSave(ctx context.Context) (_node *WalletHold, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wallethold.Table, wallethold.Columns, sqlgraph.NewFieldSpec(wallethold.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WalletHold.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wallethold.FieldID)
+		for _, f := range fields {
+			if !wallethold.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wallethold.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(wallethold.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.CapturedAmount(); ok {
+		_spec.SetField(wallethold.FieldCapturedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCapturedAmount(); ok {
+		_spec.AddField(wallethold.FieldCapturedAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.CapturedAmountCleared() {
+		_spec.ClearField(wallethold.FieldCapturedAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(wallethold.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(wallethold.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(wallethold.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(wallethold.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.TransactionID(); ok {
+		_spec.SetField(wallethold.FieldTransactionID, field.TypeUUID, value)
+	}
+	if _u.mutation.TransactionIDCleared() {
+		_spec.ClearField(wallethold.FieldTransactionID, field.TypeUUID)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(wallethold.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(wallethold.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(wallethold.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(wallethold.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &WalletHold{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wallethold.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/wallettransaction"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WalletTransaction is the model entity for the WalletTransaction schema.
+type WalletTransaction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Transaction type: credit, debit, transfer, capture
+	TransactionType string `json:"transaction_type,omitempty"`
+	// Wallet debited; empty for a credit
+	FromWalletID uuid.UUID `json:"from_wallet_id,omitempty"`
+	// Wallet credited; empty for a debit or a capture to an account
+	ToWalletID uuid.UUID `json:"to_wallet_id,omitempty"`
+	// Ledger account on the other side of a credit, debit or capture
+	AccountCode string `json:"account_code,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Hold captured
+	HoldID uuid.UUID `json:"hold_id,omitempty"`
+	// Caller's reference; a transaction is posted once per reference
+	Reference *string `json:"reference,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// JournalEntryID holds the value of the "journal_entry_id" field.
+	JournalEntryID uuid.UUID `json:"journal_entry_id,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy uuid.UUID `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WalletTransaction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wallettransaction.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case wallettransaction.FieldTransactionType, wallettransaction.FieldAccountCode, wallettransaction.FieldCurrency, wallettransaction.FieldReference, wallettransaction.FieldDescription:
+			values[i] = new(sql.NullString)
+		case wallettransaction.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case wallettransaction.FieldID, wallettransaction.FieldTenantID, wallettransaction.FieldFromWalletID, wallettransaction.FieldToWalletID, wallettransaction.FieldHoldID, wallettransaction.FieldJournalEntryID, wallettransaction.FieldCreatedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WalletTransaction fields.
+func (_m *WalletTransaction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wallettransaction.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case wallettransaction.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case wallettransaction.FieldTransactionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_type", values[i])
+			} else if value.Valid {
+				_m.TransactionType = value.String
+			}
+		case wallettransaction.FieldFromWalletID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field from_wallet_id", values[i])
+			} else if value != nil {
+				_m.FromWalletID = *value
+			}
+		case wallettransaction.FieldToWalletID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field to_wallet_id", values[i])
+			} else if value != nil {
+				_m.ToWalletID = *value
+			}
+		case wallettransaction.FieldAccountCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_code", values[i])
+			} else if value.Valid {
+				_m.AccountCode = value.String
+			}
+		case wallettransaction.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case wallettransaction.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case wallettransaction.FieldHoldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field hold_id", values[i])
+			} else if value != nil {
+				_m.HoldID = *value
+			}
+		case wallettransaction.FieldReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference", values[i])
+			} else if value.Valid {
+				_m.Reference = new(string)
+				*_m.Reference = value.String
+			}
+		case wallettransaction.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case wallettransaction.FieldJournalEntryID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field journal_entry_id", values[i])
+			} else if value != nil {
+				_m.JournalEntryID = *value
+			}
+		case wallettransaction.FieldCreatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value != nil {
+				_m.CreatedBy = *value
+			}
+		case wallettransaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WalletTransaction.
+// This includes values selected through modifiers, order, etc.
+func (_m *WalletTransaction) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WalletTransaction.
+// Note that you need to call WalletTransaction.Unwrap() before calling this method if this WalletTransaction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WalletTransaction) Update() *WalletTransactionUpdateOne {
+	return NewWalletTransactionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WalletTransaction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WalletTransaction) Unwrap() *WalletTransaction {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WalletTransaction is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WalletTransaction) String() string {
+	var builder strings.Builder
+	builder.WriteString("WalletTransaction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("transaction_type=")
+	builder.WriteString(_m.TransactionType)
+	builder.WriteString(", ")
+	builder.WriteString("from_wallet_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FromWalletID))
+	builder.WriteString(", ")
+	builder.WriteString("to_wallet_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ToWalletID))
+	builder.WriteString(", ")
+	builder.WriteString("account_code=")
+	builder.WriteString(_m.AccountCode)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("hold_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HoldID))
+	builder.WriteString(", ")
+	if v := _m.Reference; v != nil {
+		builder.WriteString("reference=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("journal_entry_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JournalEntryID))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WalletTransactions is a parsable slice of WalletTransaction.
+type WalletTransactions []*WalletTransaction
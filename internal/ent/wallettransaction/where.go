@@ -0,0 +1,852 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wallettransaction
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TransactionType applies equality check predicate on the "transaction_type" field. It's identical to TransactionTypeEQ.
+func TransactionType(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldTransactionType, v))
+}
+
+// FromWalletID applies equality check predicate on the "from_wallet_id" field. It's identical to FromWalletIDEQ.
+func FromWalletID(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldFromWalletID, v))
+}
+
+// ToWalletID applies equality check predicate on the "to_wallet_id" field. It's identical to ToWalletIDEQ.
+func ToWalletID(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldToWalletID, v))
+}
+
+// AccountCode applies equality check predicate on the "account_code" field. It's identical to AccountCodeEQ.
+func AccountCode(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldAccountCode, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldAmount, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldCurrency, v))
+}
+
+// HoldID applies equality check predicate on the "hold_id" field. It's identical to HoldIDEQ.
+func HoldID(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldHoldID, v))
+}
+
+// Reference applies equality check predicate on the "reference" field. It's identical to ReferenceEQ.
+func Reference(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldReference, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldDescription, v))
+}
+
+// JournalEntryID applies equality check predicate on the "journal_entry_id" field. It's identical to JournalEntryIDEQ.
+func JournalEntryID(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TransactionTypeEQ applies the EQ predicate on the "transaction_type" field.
+func TransactionTypeEQ(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldTransactionType, v))
+}
+
+// TransactionTypeNEQ applies the NEQ predicate on the "transaction_type" field.
+func TransactionTypeNEQ(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNEQ(FieldTransactionType, v))
+}
+
+// TransactionTypeIn applies the In predicate on the "transaction_type" field.
+func TransactionTypeIn(vs ...string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIn(FieldTransactionType, vs...))
+}
+
+// TransactionTypeNotIn applies the NotIn predicate on the "transaction_type" field.
+func TransactionTypeNotIn(vs ...string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotIn(FieldTransactionType, vs...))
+}
+
+// TransactionTypeGT applies the GT predicate on the "transaction_type" field.
+func TransactionTypeGT(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGT(FieldTransactionType, v))
+}
+
+// TransactionTypeGTE applies the GTE predicate on the "transaction_type" field.
+func TransactionTypeGTE(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGTE(FieldTransactionType, v))
+}
+
+// TransactionTypeLT applies the LT predicate on the "transaction_type" field.
+func TransactionTypeLT(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLT(FieldTransactionType, v))
+}
+
+// TransactionTypeLTE applies the LTE predicate on the "transaction_type" field.
+func TransactionTypeLTE(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLTE(FieldTransactionType, v))
+}
+
+// TransactionTypeContains applies the Contains predicate on the "transaction_type" field.
+func TransactionTypeContains(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldContains(FieldTransactionType, v))
+}
+
+// TransactionTypeHasPrefix applies the HasPrefix predicate on the "transaction_type" field.
+func TransactionTypeHasPrefix(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldHasPrefix(FieldTransactionType, v))
+}
+
+// TransactionTypeHasSuffix applies the HasSuffix predicate on the "transaction_type" field.
+func TransactionTypeHasSuffix(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldHasSuffix(FieldTransactionType, v))
+}
+
+// TransactionTypeEqualFold applies the EqualFold predicate on the "transaction_type" field.
+func TransactionTypeEqualFold(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEqualFold(FieldTransactionType, v))
+}
+
+// TransactionTypeContainsFold applies the ContainsFold predicate on the "transaction_type" field.
+func TransactionTypeContainsFold(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldContainsFold(FieldTransactionType, v))
+}
+
+// FromWalletIDEQ applies the EQ predicate on the "from_wallet_id" field.
+func FromWalletIDEQ(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldFromWalletID, v))
+}
+
+// FromWalletIDNEQ applies the NEQ predicate on the "from_wallet_id" field.
+func FromWalletIDNEQ(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNEQ(FieldFromWalletID, v))
+}
+
+// FromWalletIDIn applies the In predicate on the "from_wallet_id" field.
+func FromWalletIDIn(vs ...uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIn(FieldFromWalletID, vs...))
+}
+
+// FromWalletIDNotIn applies the NotIn predicate on the "from_wallet_id" field.
+func FromWalletIDNotIn(vs ...uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotIn(FieldFromWalletID, vs...))
+}
+
+// FromWalletIDGT applies the GT predicate on the "from_wallet_id" field.
+func FromWalletIDGT(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGT(FieldFromWalletID, v))
+}
+
+// FromWalletIDGTE applies the GTE predicate on the "from_wallet_id" field.
+func FromWalletIDGTE(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGTE(FieldFromWalletID, v))
+}
+
+// FromWalletIDLT applies the LT predicate on the "from_wallet_id" field.
+func FromWalletIDLT(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLT(FieldFromWalletID, v))
+}
+
+// FromWalletIDLTE applies the LTE predicate on the "from_wallet_id" field.
+func FromWalletIDLTE(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLTE(FieldFromWalletID, v))
+}
+
+// FromWalletIDIsNil applies the IsNil predicate on the "from_wallet_id" field.
+func FromWalletIDIsNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIsNull(FieldFromWalletID))
+}
+
+// FromWalletIDNotNil applies the NotNil predicate on the "from_wallet_id" field.
+func FromWalletIDNotNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotNull(FieldFromWalletID))
+}
+
+// ToWalletIDEQ applies the EQ predicate on the "to_wallet_id" field.
+func ToWalletIDEQ(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldToWalletID, v))
+}
+
+// ToWalletIDNEQ applies the NEQ predicate on the "to_wallet_id" field.
+func ToWalletIDNEQ(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNEQ(FieldToWalletID, v))
+}
+
+// ToWalletIDIn applies the In predicate on the "to_wallet_id" field.
+func ToWalletIDIn(vs ...uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIn(FieldToWalletID, vs...))
+}
+
+// ToWalletIDNotIn applies the NotIn predicate on the "to_wallet_id" field.
+func ToWalletIDNotIn(vs ...uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotIn(FieldToWalletID, vs...))
+}
+
+// ToWalletIDGT applies the GT predicate on the "to_wallet_id" field.
+func ToWalletIDGT(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGT(FieldToWalletID, v))
+}
+
+// ToWalletIDGTE applies the GTE predicate on the "to_wallet_id" field.
+func ToWalletIDGTE(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGTE(FieldToWalletID, v))
+}
+
+// ToWalletIDLT applies the LT predicate on the "to_wallet_id" field.
+func ToWalletIDLT(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLT(FieldToWalletID, v))
+}
+
+// ToWalletIDLTE applies the LTE predicate on the "to_wallet_id" field.
+func ToWalletIDLTE(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLTE(FieldToWalletID, v))
+}
+
+// ToWalletIDIsNil applies the IsNil predicate on the "to_wallet_id" field.
+func ToWalletIDIsNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIsNull(FieldToWalletID))
+}
+
+// ToWalletIDNotNil applies the NotNil predicate on the "to_wallet_id" field.
+func ToWalletIDNotNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotNull(FieldToWalletID))
+}
+
+// AccountCodeEQ applies the EQ predicate on the "account_code" field.
+func AccountCodeEQ(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldAccountCode, v))
+}
+
+// AccountCodeNEQ applies the NEQ predicate on the "account_code" field.
+func AccountCodeNEQ(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNEQ(FieldAccountCode, v))
+}
+
+// AccountCodeIn applies the In predicate on the "account_code" field.
+func AccountCodeIn(vs ...string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIn(FieldAccountCode, vs...))
+}
+
+// AccountCodeNotIn applies the NotIn predicate on the "account_code" field.
+func AccountCodeNotIn(vs ...string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotIn(FieldAccountCode, vs...))
+}
+
+// AccountCodeGT applies the GT predicate on the "account_code" field.
+func AccountCodeGT(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGT(FieldAccountCode, v))
+}
+
+// AccountCodeGTE applies the GTE predicate on the "account_code" field.
+func AccountCodeGTE(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGTE(FieldAccountCode, v))
+}
+
+// AccountCodeLT applies the LT predicate on the "account_code" field.
+func AccountCodeLT(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLT(FieldAccountCode, v))
+}
+
+// AccountCodeLTE applies the LTE predicate on the "account_code" field.
+func AccountCodeLTE(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLTE(FieldAccountCode, v))
+}
+
+// AccountCodeContains applies the Contains predicate on the "account_code" field.
+func AccountCodeContains(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldContains(FieldAccountCode, v))
+}
+
+// AccountCodeHasPrefix applies the HasPrefix predicate on the "account_code" field.
+func AccountCodeHasPrefix(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldHasPrefix(FieldAccountCode, v))
+}
+
+// AccountCodeHasSuffix applies the HasSuffix predicate on the "account_code" field.
+func AccountCodeHasSuffix(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldHasSuffix(FieldAccountCode, v))
+}
+
+// AccountCodeIsNil applies the IsNil predicate on the "account_code" field.
+func AccountCodeIsNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIsNull(FieldAccountCode))
+}
+
+// AccountCodeNotNil applies the NotNil predicate on the "account_code" field.
+func AccountCodeNotNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotNull(FieldAccountCode))
+}
+
+// AccountCodeEqualFold applies the EqualFold predicate on the "account_code" field.
+func AccountCodeEqualFold(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEqualFold(FieldAccountCode, v))
+}
+
+// AccountCodeContainsFold applies the ContainsFold predicate on the "account_code" field.
+func AccountCodeContainsFold(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldContainsFold(FieldAccountCode, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLTE(FieldAmount, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// HoldIDEQ applies the EQ predicate on the "hold_id" field.
+func HoldIDEQ(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldHoldID, v))
+}
+
+// HoldIDNEQ applies the NEQ predicate on the "hold_id" field.
+func HoldIDNEQ(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNEQ(FieldHoldID, v))
+}
+
+// HoldIDIn applies the In predicate on the "hold_id" field.
+func HoldIDIn(vs ...uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIn(FieldHoldID, vs...))
+}
+
+// HoldIDNotIn applies the NotIn predicate on the "hold_id" field.
+func HoldIDNotIn(vs ...uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotIn(FieldHoldID, vs...))
+}
+
+// HoldIDGT applies the GT predicate on the "hold_id" field.
+func HoldIDGT(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGT(FieldHoldID, v))
+}
+
+// HoldIDGTE applies the GTE predicate on the "hold_id" field.
+func HoldIDGTE(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGTE(FieldHoldID, v))
+}
+
+// HoldIDLT applies the LT predicate on the "hold_id" field.
+func HoldIDLT(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLT(FieldHoldID, v))
+}
+
+// HoldIDLTE applies the LTE predicate on the "hold_id" field.
+func HoldIDLTE(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLTE(FieldHoldID, v))
+}
+
+// HoldIDIsNil applies the IsNil predicate on the "hold_id" field.
+func HoldIDIsNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIsNull(FieldHoldID))
+}
+
+// HoldIDNotNil applies the NotNil predicate on the "hold_id" field.
+func HoldIDNotNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotNull(FieldHoldID))
+}
+
+// ReferenceEQ applies the EQ predicate on the "reference" field.
+func ReferenceEQ(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldReference, v))
+}
+
+// ReferenceNEQ applies the NEQ predicate on the "reference" field.
+func ReferenceNEQ(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNEQ(FieldReference, v))
+}
+
+// ReferenceIn applies the In predicate on the "reference" field.
+func ReferenceIn(vs ...string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIn(FieldReference, vs...))
+}
+
+// ReferenceNotIn applies the NotIn predicate on the "reference" field.
+func ReferenceNotIn(vs ...string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotIn(FieldReference, vs...))
+}
+
+// ReferenceGT applies the GT predicate on the "reference" field.
+func ReferenceGT(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGT(FieldReference, v))
+}
+
+// ReferenceGTE applies the GTE predicate on the "reference" field.
+func ReferenceGTE(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGTE(FieldReference, v))
+}
+
+// ReferenceLT applies the LT predicate on the "reference" field.
+func ReferenceLT(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLT(FieldReference, v))
+}
+
+// ReferenceLTE applies the LTE predicate on the "reference" field.
+func ReferenceLTE(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLTE(FieldReference, v))
+}
+
+// ReferenceContains applies the Contains predicate on the "reference" field.
+func ReferenceContains(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldContains(FieldReference, v))
+}
+
+// ReferenceHasPrefix applies the HasPrefix predicate on the "reference" field.
+func ReferenceHasPrefix(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldHasPrefix(FieldReference, v))
+}
+
+// ReferenceHasSuffix applies the HasSuffix predicate on the "reference" field.
+func ReferenceHasSuffix(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldHasSuffix(FieldReference, v))
+}
+
+// ReferenceIsNil applies the IsNil predicate on the "reference" field.
+func ReferenceIsNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIsNull(FieldReference))
+}
+
+// ReferenceNotNil applies the NotNil predicate on the "reference" field.
+func ReferenceNotNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotNull(FieldReference))
+}
+
+// ReferenceEqualFold applies the EqualFold predicate on the "reference" field.
+func ReferenceEqualFold(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEqualFold(FieldReference, v))
+}
+
+// ReferenceContainsFold applies the ContainsFold predicate on the "reference" field.
+func ReferenceContainsFold(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldContainsFold(FieldReference, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// JournalEntryIDEQ applies the EQ predicate on the "journal_entry_id" field.
+func JournalEntryIDEQ(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDNEQ applies the NEQ predicate on the "journal_entry_id" field.
+func JournalEntryIDNEQ(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIn applies the In predicate on the "journal_entry_id" field.
+func JournalEntryIDIn(vs ...uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDNotIn applies the NotIn predicate on the "journal_entry_id" field.
+func JournalEntryIDNotIn(vs ...uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDGT applies the GT predicate on the "journal_entry_id" field.
+func JournalEntryIDGT(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGT(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDGTE applies the GTE predicate on the "journal_entry_id" field.
+func JournalEntryIDGTE(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGTE(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDLT applies the LT predicate on the "journal_entry_id" field.
+func JournalEntryIDLT(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLT(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDLTE applies the LTE predicate on the "journal_entry_id" field.
+func JournalEntryIDLTE(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLTE(FieldJournalEntryID, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uuid.UUID) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WalletTransaction) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WalletTransaction) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WalletTransaction) predicate.WalletTransaction {
+	return predicate.WalletTransaction(sql.NotPredicates(p))
+}
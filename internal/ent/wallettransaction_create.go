@@ -0,0 +1,797 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/wallettransaction"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WalletTransactionCreate is the builder for creating a WalletTransaction entity.
+type WalletTransactionCreate struct {
+	config
+	mutation *WalletTransactionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *WalletTransactionCreate) SetTenantID(v uuid.UUID) *WalletTransactionCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (_c *WalletTransactionCreate) SetTransactionType(v string) *WalletTransactionCreate {
+	_c.mutation.SetTransactionType(v)
+	return _c
+}
+
+// SetFromWalletID sets the "from_wallet_id" field.
+func (_c *WalletTransactionCreate) SetFromWalletID(v uuid.UUID) *WalletTransactionCreate {
+	_c.mutation.SetFromWalletID(v)
+	return _c
+}
+
+// SetNillableFromWalletID sets the "from_wallet_id" field if the given value is not nil.
+func (_c *WalletTransactionCreate) SetNillableFromWalletID(v *uuid.UUID) *WalletTransactionCreate {
+	if v != nil {
+		_c.SetFromWalletID(*v)
+	}
+	return _c
+}
+
+// SetToWalletID sets the "to_wallet_id" field.
+func (_c *WalletTransactionCreate) SetToWalletID(v uuid.UUID) *WalletTransactionCreate {
+	_c.mutation.SetToWalletID(v)
+	return _c
+}
+
+// SetNillableToWalletID sets the "to_wallet_id" field if the given value is not nil.
+func (_c *WalletTransactionCreate) SetNillableToWalletID(v *uuid.UUID) *WalletTransactionCreate {
+	if v != nil {
+		_c.SetToWalletID(*v)
+	}
+	return _c
+}
+
+// SetAccountCode sets the "account_code" field.
+func (_c *WalletTransactionCreate) SetAccountCode(v string) *WalletTransactionCreate {
+	_c.mutation.SetAccountCode(v)
+	return _c
+}
+
+// SetNillableAccountCode sets the "account_code" field if the given value is not nil.
+func (_c *WalletTransactionCreate) SetNillableAccountCode(v *string) *WalletTransactionCreate {
+	if v != nil {
+		_c.SetAccountCode(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *WalletTransactionCreate) SetAmount(v decimal.Decimal) *WalletTransactionCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *WalletTransactionCreate) SetCurrency(v string) *WalletTransactionCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *WalletTransactionCreate) SetNillableCurrency(v *string) *WalletTransactionCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetHoldID sets the "hold_id" field.
+func (_c *WalletTransactionCreate) SetHoldID(v uuid.UUID) *WalletTransactionCreate {
+	_c.mutation.SetHoldID(v)
+	return _c
+}
+
+// SetNillableHoldID sets the "hold_id" field if the given value is not nil.
+func (_c *WalletTransactionCreate) SetNillableHoldID(v *uuid.UUID) *WalletTransactionCreate {
+	if v != nil {
+		_c.SetHoldID(*v)
+	}
+	return _c
+}
+
+// SetReference sets the "reference" field.
+func (_c *WalletTransactionCreate) SetReference(v string) *WalletTransactionCreate {
+	_c.mutation.SetReference(v)
+	return _c
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_c *WalletTransactionCreate) SetNillableReference(v *string) *WalletTransactionCreate {
+	if v != nil {
+		_c.SetReference(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *WalletTransactionCreate) SetDescription(v string) *WalletTransactionCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *WalletTransactionCreate) SetNillableDescription(v *string) *WalletTransactionCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_c *WalletTransactionCreate) SetJournalEntryID(v uuid.UUID) *WalletTransactionCreate {
+	_c.mutation.SetJournalEntryID(v)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *WalletTransactionCreate) SetCreatedBy(v uuid.UUID) *WalletTransactionCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *WalletTransactionCreate) SetNillableCreatedBy(v *uuid.UUID) *WalletTransactionCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WalletTransactionCreate) SetCreatedAt(v time.Time) *WalletTransactionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WalletTransactionCreate) SetNillableCreatedAt(v *time.Time) *WalletTransactionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WalletTransactionCreate) SetID(v uuid.UUID) *WalletTransactionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WalletTransactionCreate) SetNillableID(v *uuid.UUID) *WalletTransactionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the WalletTransactionMutation object of the builder.
+func (_c *WalletTransactionCreate) Mutation() *WalletTransactionMutation {
+	return _c.mutation
+}
+
+// Save creates the WalletTransaction in the database.
+func (_c *WalletTransactionCreate) Save(ctx context.Context) (*WalletTransaction, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WalletTransactionCreate) SaveX(ctx context.Context) *WalletTransaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WalletTransactionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WalletTransactionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WalletTransactionCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := wallettransaction.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := wallettransaction.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := wallettransaction.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WalletTransactionCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "WalletTransaction.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.TransactionType(); !ok {
+		return &ValidationError{Name: "transaction_type", err: errors.New(`ent: missing required field "WalletTransaction.transaction_type"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "WalletTransaction.amount"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "WalletTransaction.currency"`)}
+	}
+	if _, ok := _c.mutation.JournalEntryID(); !ok {
+		return &ValidationError{Name: "journal_entry_id", err: errors.New(`ent: missing required field "WalletTransaction.journal_entry_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WalletTransaction.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *WalletTransactionCreate) sqlSave(ctx context.Context) (*WalletTransaction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WalletTransactionCreate) createSpec() (*WalletTransaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WalletTransaction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(wallettransaction.Table, sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(wallettransaction.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.TransactionType(); ok {
+		_spec.SetField(wallettransaction.FieldTransactionType, field.TypeString, value)
+		_node.TransactionType = value
+	}
+	if value, ok := _c.mutation.FromWalletID(); ok {
+		_spec.SetField(wallettransaction.FieldFromWalletID, field.TypeUUID, value)
+		_node.FromWalletID = value
+	}
+	if value, ok := _c.mutation.ToWalletID(); ok {
+		_spec.SetField(wallettransaction.FieldToWalletID, field.TypeUUID, value)
+		_node.ToWalletID = value
+	}
+	if value, ok := _c.mutation.AccountCode(); ok {
+		_spec.SetField(wallettransaction.FieldAccountCode, field.TypeString, value)
+		_node.AccountCode = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(wallettransaction.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(wallettransaction.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.HoldID(); ok {
+		_spec.SetField(wallettransaction.FieldHoldID, field.TypeUUID, value)
+		_node.HoldID = value
+	}
+	if value, ok := _c.mutation.Reference(); ok {
+		_spec.SetField(wallettransaction.FieldReference, field.TypeString, value)
+		_node.Reference = &value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(wallettransaction.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.JournalEntryID(); ok {
+		_spec.SetField(wallettransaction.FieldJournalEntryID, field.TypeUUID, value)
+		_node.JournalEntryID = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(wallettransaction.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(wallettransaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WalletTransaction.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WalletTransactionUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WalletTransactionCreate) OnConflict(opts ...sql.ConflictOption) *WalletTransactionUpsertOne {
+	_c.conflict = opts
+	return &WalletTransactionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WalletTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WalletTransactionCreate) OnConflictColumns(columns ...string) *WalletTransactionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WalletTransactionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WalletTransactionUpsertOne is the builder for "upsert"-ing
+	//  one WalletTransaction node.
+	WalletTransactionUpsertOne struct {
+		create *WalletTransactionCreate
+	}
+
+	// WalletTransactionUpsert is the "OnConflict" setter.
+	WalletTransactionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WalletTransactionUpsert) SetTenantID(v uuid.UUID) *WalletTransactionUpsert {
+	u.Set(wallettransaction.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WalletTransactionUpsert) UpdateTenantID() *WalletTransactionUpsert {
+	u.SetExcluded(wallettransaction.FieldTenantID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WalletTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(wallettransaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WalletTransactionUpsertOne) UpdateNewValues() *WalletTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(wallettransaction.FieldID)
+		}
+		if _, exists := u.create.mutation.TransactionType(); exists {
+			s.SetIgnore(wallettransaction.FieldTransactionType)
+		}
+		if _, exists := u.create.mutation.FromWalletID(); exists {
+			s.SetIgnore(wallettransaction.FieldFromWalletID)
+		}
+		if _, exists := u.create.mutation.ToWalletID(); exists {
+			s.SetIgnore(wallettransaction.FieldToWalletID)
+		}
+		if _, exists := u.create.mutation.AccountCode(); exists {
+			s.SetIgnore(wallettransaction.FieldAccountCode)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(wallettransaction.FieldAmount)
+		}
+		if _, exists := u.create.mutation.Currency(); exists {
+			s.SetIgnore(wallettransaction.FieldCurrency)
+		}
+		if _, exists := u.create.mutation.HoldID(); exists {
+			s.SetIgnore(wallettransaction.FieldHoldID)
+		}
+		if _, exists := u.create.mutation.Reference(); exists {
+			s.SetIgnore(wallettransaction.FieldReference)
+		}
+		if _, exists := u.create.mutation.Description(); exists {
+			s.SetIgnore(wallettransaction.FieldDescription)
+		}
+		if _, exists := u.create.mutation.JournalEntryID(); exists {
+			s.SetIgnore(wallettransaction.FieldJournalEntryID)
+		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(wallettransaction.FieldCreatedBy)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(wallettransaction.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WalletTransaction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WalletTransactionUpsertOne) Ignore() *WalletTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WalletTransactionUpsertOne) DoNothing() *WalletTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WalletTransactionCreate.OnConflict
+// documentation for more info.
+func (u *WalletTransactionUpsertOne) Update(set func(*WalletTransactionUpsert)) *WalletTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WalletTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WalletTransactionUpsertOne) SetTenantID(v uuid.UUID) *WalletTransactionUpsertOne {
+	return u.Update(func(s *WalletTransactionUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WalletTransactionUpsertOne) UpdateTenantID() *WalletTransactionUpsertOne {
+	return u.Update(func(s *WalletTransactionUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// Exec executes the query.
+func (u *WalletTransactionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WalletTransactionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WalletTransactionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WalletTransactionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WalletTransactionUpsertOne.ID is not supported by MySQL driver. Use WalletTransactionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WalletTransactionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WalletTransactionCreateBulk is the builder for creating many WalletTransaction entities in bulk.
+type WalletTransactionCreateBulk struct {
+	config
+	err      error
+	builders []*WalletTransactionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WalletTransaction entities in the database.
+func (_c *WalletTransactionCreateBulk) Save(ctx context.Context) ([]*WalletTransaction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WalletTransaction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WalletTransactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WalletTransactionCreateBulk) SaveX(ctx context.Context) []*WalletTransaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WalletTransactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WalletTransactionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WalletTransaction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WalletTransactionUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WalletTransactionCreateBulk) OnConflict(opts ...sql.ConflictOption) *WalletTransactionUpsertBulk {
+	_c.conflict = opts
+	return &WalletTransactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WalletTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WalletTransactionCreateBulk) OnConflictColumns(columns ...string) *WalletTransactionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WalletTransactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// WalletTransactionUpsertBulk is the builder for "upsert"-ing
+// a bulk of WalletTransaction nodes.
+type WalletTransactionUpsertBulk struct {
+	create *WalletTransactionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WalletTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(wallettransaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WalletTransactionUpsertBulk) UpdateNewValues() *WalletTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(wallettransaction.FieldID)
+			}
+			if _, exists := b.mutation.TransactionType(); exists {
+				s.SetIgnore(wallettransaction.FieldTransactionType)
+			}
+			if _, exists := b.mutation.FromWalletID(); exists {
+				s.SetIgnore(wallettransaction.FieldFromWalletID)
+			}
+			if _, exists := b.mutation.ToWalletID(); exists {
+				s.SetIgnore(wallettransaction.FieldToWalletID)
+			}
+			if _, exists := b.mutation.AccountCode(); exists {
+				s.SetIgnore(wallettransaction.FieldAccountCode)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(wallettransaction.FieldAmount)
+			}
+			if _, exists := b.mutation.Currency(); exists {
+				s.SetIgnore(wallettransaction.FieldCurrency)
+			}
+			if _, exists := b.mutation.HoldID(); exists {
+				s.SetIgnore(wallettransaction.FieldHoldID)
+			}
+			if _, exists := b.mutation.Reference(); exists {
+				s.SetIgnore(wallettransaction.FieldReference)
+			}
+			if _, exists := b.mutation.Description(); exists {
+				s.SetIgnore(wallettransaction.FieldDescription)
+			}
+			if _, exists := b.mutation.JournalEntryID(); exists {
+				s.SetIgnore(wallettransaction.FieldJournalEntryID)
+			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(wallettransaction.FieldCreatedBy)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(wallettransaction.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WalletTransaction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WalletTransactionUpsertBulk) Ignore() *WalletTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WalletTransactionUpsertBulk) DoNothing() *WalletTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WalletTransactionCreateBulk.OnConflict
+// documentation for more info.
+func (u *WalletTransactionUpsertBulk) Update(set func(*WalletTransactionUpsert)) *WalletTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WalletTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WalletTransactionUpsertBulk) SetTenantID(v uuid.UUID) *WalletTransactionUpsertBulk {
+	return u.Update(func(s *WalletTransactionUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WalletTransactionUpsertBulk) UpdateTenantID() *WalletTransactionUpsertBulk {
+	return u.Update(func(s *WalletTransactionUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// Exec executes the query.
+func (u *WalletTransactionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WalletTransactionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WalletTransactionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WalletTransactionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
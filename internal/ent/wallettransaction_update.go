@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/wallettransaction"
+	"github.com/google/uuid"
+)
+
+// WalletTransactionUpdate is the builder for updating WalletTransaction entities.
+type WalletTransactionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WalletTransactionMutation
+}
+
+// Where appends a list predicates to the WalletTransactionUpdate builder.
+func (_u *WalletTransactionUpdate) Where(ps ...predicate.WalletTransaction) *WalletTransactionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *WalletTransactionUpdate) SetTenantID(v uuid.UUID) *WalletTransactionUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *WalletTransactionUpdate) SetNillableTenantID(v *uuid.UUID) *WalletTransactionUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the WalletTransactionMutation object of the builder.
+func (_u *WalletTransactionUpdate) Mutation() *WalletTransactionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WalletTransactionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WalletTransactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WalletTransactionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WalletTransactionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *WalletTransactionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wallettransaction.Table, wallettransaction.Columns, sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(wallettransaction.FieldTenantID, field.TypeUUID, value)
+	}
+	if _u.mutation.FromWalletIDCleared() {
+		_spec.ClearField(wallettransaction.FieldFromWalletID, field.TypeUUID)
+	}
+	if _u.mutation.ToWalletIDCleared() {
+		_spec.ClearField(wallettransaction.FieldToWalletID, field.TypeUUID)
+	}
+	if _u.mutation.AccountCodeCleared() {
+		_spec.ClearField(wallettransaction.FieldAccountCode, field.TypeString)
+	}
+	if _u.mutation.HoldIDCleared() {
+		_spec.ClearField(wallettransaction.FieldHoldID, field.TypeUUID)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(wallettransaction.FieldReference, field.TypeString)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(wallettransaction.FieldDescription, field.TypeString)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(wallettransaction.FieldCreatedBy, field.TypeUUID)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wallettransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WalletTransactionUpdateOne is the builder for updating a single WalletTransaction entity.
+type WalletTransactionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WalletTransactionMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *WalletTransactionUpdateOne) SetTenantID(v uuid.UUID) *WalletTransactionUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *WalletTransactionUpdateOne) SetNillableTenantID(v *uuid.UUID) *WalletTransactionUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the WalletTransactionMutation object of the builder.
+func (_u *WalletTransactionUpdateOne) Mutation() *WalletTransactionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WalletTransactionUpdate builder.
+func (_u *WalletTransactionUpdateOne) Where(ps ...predicate.WalletTransaction) *WalletTransactionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WalletTransactionUpdateOne) Select(field string, fields ...string) *WalletTransactionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WalletTransaction entity.
+func (_u *WalletTransactionUpdateOne) Save(ctx context.Context) (*WalletTransaction, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WalletTransactionUpdateOne) SaveX(ctx context.Context) *WalletTransaction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WalletTransactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WalletTransactionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *WalletTransactionUpdateOne) sqlSave(ctx context.Context) (_node *WalletTransaction, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wallettransaction.Table, wallettransaction.Columns, sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WalletTransaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wallettransaction.FieldID)
+		for _, f := range fields {
+			if !wallettransaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wallettransaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(wallettransaction.FieldTenantID, field.TypeUUID, value)
+	}
+	if _u.mutation.FromWalletIDCleared() {
+		_spec.ClearField(wallettransaction.FieldFromWalletID, field.TypeUUID)
+	}
+	if _u.mutation.ToWalletIDCleared() {
+		_spec.ClearField(wallettransaction.FieldToWalletID, field.TypeUUID)
+	}
+	if _u.mutation.AccountCodeCleared() {
+		_spec.ClearField(wallettransaction.FieldAccountCode, field.TypeString)
+	}
+	if _u.mutation.HoldIDCleared() {
+		_spec.ClearField(wallettransaction.FieldHoldID, field.TypeUUID)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(wallettransaction.FieldReference, field.TypeString)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(wallettransaction.FieldDescription, field.TypeString)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(wallettransaction.FieldCreatedBy, field.TypeUUID)
+	}
+	_node = &WalletTransaction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wallettransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,286 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/withholdingcertificate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WithholdingCertificate is the model entity for the WithholdingCertificate schema.
+type WithholdingCertificate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Sequential certificate number
+	CertificateNumber string `json:"certificate_number,omitempty"`
+	// Vendor the tax was withheld from
+	VendorID uuid.UUID `json:"vendor_id,omitempty"`
+	// Vendor legal name when the tax was withheld
+	VendorName string `json:"vendor_name,omitempty"`
+	// Vendor KRA PIN when the tax was withheld
+	VendorPin string `json:"vendor_pin,omitempty"`
+	// Vendor bill paid
+	BillID uuid.UUID `json:"bill_id,omitempty"`
+	// BillNumber holds the value of the "bill_number" field.
+	BillNumber string `json:"bill_number,omitempty"`
+	// The vendor's own invoice number
+	VendorReference string `json:"vendor_reference,omitempty"`
+	// Service category code
+	Category string `json:"category,omitempty"`
+	// Nature of the payment
+	CategoryName string `json:"category_name,omitempty"`
+	// Rate applied as a fraction
+	Rate decimal.Decimal `json:"rate,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Amount before VAT the tax was computed on
+	GrossAmount decimal.Decimal `json:"gross_amount,omitempty"`
+	// Tax withheld
+	TaxAmount decimal.Decimal `json:"tax_amount,omitempty"`
+	// Date the bill was paid and the tax deducted
+	PaymentDate time.Time `json:"payment_date,omitempty"`
+	// PaymentReference holds the value of the "payment_reference" field.
+	PaymentReference string `json:"payment_reference,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WithholdingCertificate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case withholdingcertificate.FieldRate, withholdingcertificate.FieldGrossAmount, withholdingcertificate.FieldTaxAmount:
+			values[i] = new(decimal.Decimal)
+		case withholdingcertificate.FieldCertificateNumber, withholdingcertificate.FieldVendorName, withholdingcertificate.FieldVendorPin, withholdingcertificate.FieldBillNumber, withholdingcertificate.FieldVendorReference, withholdingcertificate.FieldCategory, withholdingcertificate.FieldCategoryName, withholdingcertificate.FieldCurrency, withholdingcertificate.FieldPaymentReference:
+			values[i] = new(sql.NullString)
+		case withholdingcertificate.FieldPaymentDate, withholdingcertificate.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case withholdingcertificate.FieldID, withholdingcertificate.FieldTenantID, withholdingcertificate.FieldVendorID, withholdingcertificate.FieldBillID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WithholdingCertificate fields.
+func (_m *WithholdingCertificate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case withholdingcertificate.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case withholdingcertificate.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case withholdingcertificate.FieldCertificateNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate_number", values[i])
+			} else if value.Valid {
+				_m.CertificateNumber = value.String
+			}
+		case withholdingcertificate.FieldVendorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field vendor_id", values[i])
+			} else if value != nil {
+				_m.VendorID = *value
+			}
+		case withholdingcertificate.FieldVendorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vendor_name", values[i])
+			} else if value.Valid {
+				_m.VendorName = value.String
+			}
+		case withholdingcertificate.FieldVendorPin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vendor_pin", values[i])
+			} else if value.Valid {
+				_m.VendorPin = value.String
+			}
+		case withholdingcertificate.FieldBillID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field bill_id", values[i])
+			} else if value != nil {
+				_m.BillID = *value
+			}
+		case withholdingcertificate.FieldBillNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bill_number", values[i])
+			} else if value.Valid {
+				_m.BillNumber = value.String
+			}
+		case withholdingcertificate.FieldVendorReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vendor_reference", values[i])
+			} else if value.Valid {
+				_m.VendorReference = value.String
+			}
+		case withholdingcertificate.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = value.String
+			}
+		case withholdingcertificate.FieldCategoryName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category_name", values[i])
+			} else if value.Valid {
+				_m.CategoryName = value.String
+			}
+		case withholdingcertificate.FieldRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value != nil {
+				_m.Rate = *value
+			}
+		case withholdingcertificate.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case withholdingcertificate.FieldGrossAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field gross_amount", values[i])
+			} else if value != nil {
+				_m.GrossAmount = *value
+			}
+		case withholdingcertificate.FieldTaxAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_amount", values[i])
+			} else if value != nil {
+				_m.TaxAmount = *value
+			}
+		case withholdingcertificate.FieldPaymentDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_date", values[i])
+			} else if value.Valid {
+				_m.PaymentDate = value.Time
+			}
+		case withholdingcertificate.FieldPaymentReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_reference", values[i])
+			} else if value.Valid {
+				_m.PaymentReference = value.String
+			}
+		case withholdingcertificate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WithholdingCertificate.
+// This includes values selected through modifiers, order, etc.
+func (_m *WithholdingCertificate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WithholdingCertificate.
+// Note that you need to call WithholdingCertificate.Unwrap() before calling this method if this WithholdingCertificate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WithholdingCertificate) Update() *WithholdingCertificateUpdateOne {
+	return NewWithholdingCertificateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WithholdingCertificate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WithholdingCertificate) Unwrap() *WithholdingCertificate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WithholdingCertificate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WithholdingCertificate) String() string {
+	var builder strings.Builder
+	builder.WriteString("WithholdingCertificate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("certificate_number=")
+	builder.WriteString(_m.CertificateNumber)
+	builder.WriteString(", ")
+	builder.WriteString("vendor_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VendorID))
+	builder.WriteString(", ")
+	builder.WriteString("vendor_name=")
+	builder.WriteString(_m.VendorName)
+	builder.WriteString(", ")
+	builder.WriteString("vendor_pin=")
+	builder.WriteString(_m.VendorPin)
+	builder.WriteString(", ")
+	builder.WriteString("bill_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BillID))
+	builder.WriteString(", ")
+	builder.WriteString("bill_number=")
+	builder.WriteString(_m.BillNumber)
+	builder.WriteString(", ")
+	builder.WriteString("vendor_reference=")
+	builder.WriteString(_m.VendorReference)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(_m.Category)
+	builder.WriteString(", ")
+	builder.WriteString("category_name=")
+	builder.WriteString(_m.CategoryName)
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("gross_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GrossAmount))
+	builder.WriteString(", ")
+	builder.WriteString("tax_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaxAmount))
+	builder.WriteString(", ")
+	builder.WriteString("payment_date=")
+	builder.WriteString(_m.PaymentDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("payment_reference=")
+	builder.WriteString(_m.PaymentReference)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WithholdingCertificates is a parsable slice of WithholdingCertificate.
+type WithholdingCertificates []*WithholdingCertificate
@@ -0,0 +1,1092 @@
+// Code generated by ent, DO NOT EDIT.
+
+package withholdingcertificate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CertificateNumber applies equality check predicate on the "certificate_number" field. It's identical to CertificateNumberEQ.
+func CertificateNumber(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldCertificateNumber, v))
+}
+
+// VendorID applies equality check predicate on the "vendor_id" field. It's identical to VendorIDEQ.
+func VendorID(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldVendorID, v))
+}
+
+// VendorName applies equality check predicate on the "vendor_name" field. It's identical to VendorNameEQ.
+func VendorName(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldVendorName, v))
+}
+
+// VendorPin applies equality check predicate on the "vendor_pin" field. It's identical to VendorPinEQ.
+func VendorPin(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldVendorPin, v))
+}
+
+// BillID applies equality check predicate on the "bill_id" field. It's identical to BillIDEQ.
+func BillID(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldBillID, v))
+}
+
+// BillNumber applies equality check predicate on the "bill_number" field. It's identical to BillNumberEQ.
+func BillNumber(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldBillNumber, v))
+}
+
+// VendorReference applies equality check predicate on the "vendor_reference" field. It's identical to VendorReferenceEQ.
+func VendorReference(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldVendorReference, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryName applies equality check predicate on the "category_name" field. It's identical to CategoryNameEQ.
+func CategoryName(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldCategoryName, v))
+}
+
+// Rate applies equality check predicate on the "rate" field. It's identical to RateEQ.
+func Rate(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldRate, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldCurrency, v))
+}
+
+// GrossAmount applies equality check predicate on the "gross_amount" field. It's identical to GrossAmountEQ.
+func GrossAmount(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldGrossAmount, v))
+}
+
+// TaxAmount applies equality check predicate on the "tax_amount" field. It's identical to TaxAmountEQ.
+func TaxAmount(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldTaxAmount, v))
+}
+
+// PaymentDate applies equality check predicate on the "payment_date" field. It's identical to PaymentDateEQ.
+func PaymentDate(v time.Time) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldPaymentDate, v))
+}
+
+// PaymentReference applies equality check predicate on the "payment_reference" field. It's identical to PaymentReferenceEQ.
+func PaymentReference(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldPaymentReference, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLTE(FieldTenantID, v))
+}
+
+// CertificateNumberEQ applies the EQ predicate on the "certificate_number" field.
+func CertificateNumberEQ(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldCertificateNumber, v))
+}
+
+// CertificateNumberNEQ applies the NEQ predicate on the "certificate_number" field.
+func CertificateNumberNEQ(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNEQ(FieldCertificateNumber, v))
+}
+
+// CertificateNumberIn applies the In predicate on the "certificate_number" field.
+func CertificateNumberIn(vs ...string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIn(FieldCertificateNumber, vs...))
+}
+
+// CertificateNumberNotIn applies the NotIn predicate on the "certificate_number" field.
+func CertificateNumberNotIn(vs ...string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotIn(FieldCertificateNumber, vs...))
+}
+
+// CertificateNumberGT applies the GT predicate on the "certificate_number" field.
+func CertificateNumberGT(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGT(FieldCertificateNumber, v))
+}
+
+// CertificateNumberGTE applies the GTE predicate on the "certificate_number" field.
+func CertificateNumberGTE(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGTE(FieldCertificateNumber, v))
+}
+
+// CertificateNumberLT applies the LT predicate on the "certificate_number" field.
+func CertificateNumberLT(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLT(FieldCertificateNumber, v))
+}
+
+// CertificateNumberLTE applies the LTE predicate on the "certificate_number" field.
+func CertificateNumberLTE(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLTE(FieldCertificateNumber, v))
+}
+
+// CertificateNumberContains applies the Contains predicate on the "certificate_number" field.
+func CertificateNumberContains(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldContains(FieldCertificateNumber, v))
+}
+
+// CertificateNumberHasPrefix applies the HasPrefix predicate on the "certificate_number" field.
+func CertificateNumberHasPrefix(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldHasPrefix(FieldCertificateNumber, v))
+}
+
+// CertificateNumberHasSuffix applies the HasSuffix predicate on the "certificate_number" field.
+func CertificateNumberHasSuffix(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldHasSuffix(FieldCertificateNumber, v))
+}
+
+// CertificateNumberEqualFold applies the EqualFold predicate on the "certificate_number" field.
+func CertificateNumberEqualFold(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEqualFold(FieldCertificateNumber, v))
+}
+
+// CertificateNumberContainsFold applies the ContainsFold predicate on the "certificate_number" field.
+func CertificateNumberContainsFold(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldContainsFold(FieldCertificateNumber, v))
+}
+
+// VendorIDEQ applies the EQ predicate on the "vendor_id" field.
+func VendorIDEQ(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldVendorID, v))
+}
+
+// VendorIDNEQ applies the NEQ predicate on the "vendor_id" field.
+func VendorIDNEQ(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNEQ(FieldVendorID, v))
+}
+
+// VendorIDIn applies the In predicate on the "vendor_id" field.
+func VendorIDIn(vs ...uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIn(FieldVendorID, vs...))
+}
+
+// VendorIDNotIn applies the NotIn predicate on the "vendor_id" field.
+func VendorIDNotIn(vs ...uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotIn(FieldVendorID, vs...))
+}
+
+// VendorIDGT applies the GT predicate on the "vendor_id" field.
+func VendorIDGT(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGT(FieldVendorID, v))
+}
+
+// VendorIDGTE applies the GTE predicate on the "vendor_id" field.
+func VendorIDGTE(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGTE(FieldVendorID, v))
+}
+
+// VendorIDLT applies the LT predicate on the "vendor_id" field.
+func VendorIDLT(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLT(FieldVendorID, v))
+}
+
+// VendorIDLTE applies the LTE predicate on the "vendor_id" field.
+func VendorIDLTE(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLTE(FieldVendorID, v))
+}
+
+// VendorNameEQ applies the EQ predicate on the "vendor_name" field.
+func VendorNameEQ(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldVendorName, v))
+}
+
+// VendorNameNEQ applies the NEQ predicate on the "vendor_name" field.
+func VendorNameNEQ(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNEQ(FieldVendorName, v))
+}
+
+// VendorNameIn applies the In predicate on the "vendor_name" field.
+func VendorNameIn(vs ...string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIn(FieldVendorName, vs...))
+}
+
+// VendorNameNotIn applies the NotIn predicate on the "vendor_name" field.
+func VendorNameNotIn(vs ...string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotIn(FieldVendorName, vs...))
+}
+
+// VendorNameGT applies the GT predicate on the "vendor_name" field.
+func VendorNameGT(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGT(FieldVendorName, v))
+}
+
+// VendorNameGTE applies the GTE predicate on the "vendor_name" field.
+func VendorNameGTE(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGTE(FieldVendorName, v))
+}
+
+// VendorNameLT applies the LT predicate on the "vendor_name" field.
+func VendorNameLT(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLT(FieldVendorName, v))
+}
+
+// VendorNameLTE applies the LTE predicate on the "vendor_name" field.
+func VendorNameLTE(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLTE(FieldVendorName, v))
+}
+
+// VendorNameContains applies the Contains predicate on the "vendor_name" field.
+func VendorNameContains(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldContains(FieldVendorName, v))
+}
+
+// VendorNameHasPrefix applies the HasPrefix predicate on the "vendor_name" field.
+func VendorNameHasPrefix(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldHasPrefix(FieldVendorName, v))
+}
+
+// VendorNameHasSuffix applies the HasSuffix predicate on the "vendor_name" field.
+func VendorNameHasSuffix(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldHasSuffix(FieldVendorName, v))
+}
+
+// VendorNameEqualFold applies the EqualFold predicate on the "vendor_name" field.
+func VendorNameEqualFold(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEqualFold(FieldVendorName, v))
+}
+
+// VendorNameContainsFold applies the ContainsFold predicate on the "vendor_name" field.
+func VendorNameContainsFold(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldContainsFold(FieldVendorName, v))
+}
+
+// VendorPinEQ applies the EQ predicate on the "vendor_pin" field.
+func VendorPinEQ(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldVendorPin, v))
+}
+
+// VendorPinNEQ applies the NEQ predicate on the "vendor_pin" field.
+func VendorPinNEQ(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNEQ(FieldVendorPin, v))
+}
+
+// VendorPinIn applies the In predicate on the "vendor_pin" field.
+func VendorPinIn(vs ...string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIn(FieldVendorPin, vs...))
+}
+
+// VendorPinNotIn applies the NotIn predicate on the "vendor_pin" field.
+func VendorPinNotIn(vs ...string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotIn(FieldVendorPin, vs...))
+}
+
+// VendorPinGT applies the GT predicate on the "vendor_pin" field.
+func VendorPinGT(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGT(FieldVendorPin, v))
+}
+
+// VendorPinGTE applies the GTE predicate on the "vendor_pin" field.
+func VendorPinGTE(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGTE(FieldVendorPin, v))
+}
+
+// VendorPinLT applies the LT predicate on the "vendor_pin" field.
+func VendorPinLT(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLT(FieldVendorPin, v))
+}
+
+// VendorPinLTE applies the LTE predicate on the "vendor_pin" field.
+func VendorPinLTE(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLTE(FieldVendorPin, v))
+}
+
+// VendorPinContains applies the Contains predicate on the "vendor_pin" field.
+func VendorPinContains(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldContains(FieldVendorPin, v))
+}
+
+// VendorPinHasPrefix applies the HasPrefix predicate on the "vendor_pin" field.
+func VendorPinHasPrefix(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldHasPrefix(FieldVendorPin, v))
+}
+
+// VendorPinHasSuffix applies the HasSuffix predicate on the "vendor_pin" field.
+func VendorPinHasSuffix(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldHasSuffix(FieldVendorPin, v))
+}
+
+// VendorPinIsNil applies the IsNil predicate on the "vendor_pin" field.
+func VendorPinIsNil() predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIsNull(FieldVendorPin))
+}
+
+// VendorPinNotNil applies the NotNil predicate on the "vendor_pin" field.
+func VendorPinNotNil() predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotNull(FieldVendorPin))
+}
+
+// VendorPinEqualFold applies the EqualFold predicate on the "vendor_pin" field.
+func VendorPinEqualFold(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEqualFold(FieldVendorPin, v))
+}
+
+// VendorPinContainsFold applies the ContainsFold predicate on the "vendor_pin" field.
+func VendorPinContainsFold(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldContainsFold(FieldVendorPin, v))
+}
+
+// BillIDEQ applies the EQ predicate on the "bill_id" field.
+func BillIDEQ(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldBillID, v))
+}
+
+// BillIDNEQ applies the NEQ predicate on the "bill_id" field.
+func BillIDNEQ(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNEQ(FieldBillID, v))
+}
+
+// BillIDIn applies the In predicate on the "bill_id" field.
+func BillIDIn(vs ...uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIn(FieldBillID, vs...))
+}
+
+// BillIDNotIn applies the NotIn predicate on the "bill_id" field.
+func BillIDNotIn(vs ...uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotIn(FieldBillID, vs...))
+}
+
+// BillIDGT applies the GT predicate on the "bill_id" field.
+func BillIDGT(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGT(FieldBillID, v))
+}
+
+// BillIDGTE applies the GTE predicate on the "bill_id" field.
+func BillIDGTE(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGTE(FieldBillID, v))
+}
+
+// BillIDLT applies the LT predicate on the "bill_id" field.
+func BillIDLT(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLT(FieldBillID, v))
+}
+
+// BillIDLTE applies the LTE predicate on the "bill_id" field.
+func BillIDLTE(v uuid.UUID) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLTE(FieldBillID, v))
+}
+
+// BillNumberEQ applies the EQ predicate on the "bill_number" field.
+func BillNumberEQ(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldBillNumber, v))
+}
+
+// BillNumberNEQ applies the NEQ predicate on the "bill_number" field.
+func BillNumberNEQ(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNEQ(FieldBillNumber, v))
+}
+
+// BillNumberIn applies the In predicate on the "bill_number" field.
+func BillNumberIn(vs ...string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIn(FieldBillNumber, vs...))
+}
+
+// BillNumberNotIn applies the NotIn predicate on the "bill_number" field.
+func BillNumberNotIn(vs ...string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotIn(FieldBillNumber, vs...))
+}
+
+// BillNumberGT applies the GT predicate on the "bill_number" field.
+func BillNumberGT(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGT(FieldBillNumber, v))
+}
+
+// BillNumberGTE applies the GTE predicate on the "bill_number" field.
+func BillNumberGTE(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGTE(FieldBillNumber, v))
+}
+
+// BillNumberLT applies the LT predicate on the "bill_number" field.
+func BillNumberLT(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLT(FieldBillNumber, v))
+}
+
+// BillNumberLTE applies the LTE predicate on the "bill_number" field.
+func BillNumberLTE(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLTE(FieldBillNumber, v))
+}
+
+// BillNumberContains applies the Contains predicate on the "bill_number" field.
+func BillNumberContains(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldContains(FieldBillNumber, v))
+}
+
+// BillNumberHasPrefix applies the HasPrefix predicate on the "bill_number" field.
+func BillNumberHasPrefix(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldHasPrefix(FieldBillNumber, v))
+}
+
+// BillNumberHasSuffix applies the HasSuffix predicate on the "bill_number" field.
+func BillNumberHasSuffix(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldHasSuffix(FieldBillNumber, v))
+}
+
+// BillNumberEqualFold applies the EqualFold predicate on the "bill_number" field.
+func BillNumberEqualFold(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEqualFold(FieldBillNumber, v))
+}
+
+// BillNumberContainsFold applies the ContainsFold predicate on the "bill_number" field.
+func BillNumberContainsFold(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldContainsFold(FieldBillNumber, v))
+}
+
+// VendorReferenceEQ applies the EQ predicate on the "vendor_reference" field.
+func VendorReferenceEQ(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldVendorReference, v))
+}
+
+// VendorReferenceNEQ applies the NEQ predicate on the "vendor_reference" field.
+func VendorReferenceNEQ(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNEQ(FieldVendorReference, v))
+}
+
+// VendorReferenceIn applies the In predicate on the "vendor_reference" field.
+func VendorReferenceIn(vs ...string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIn(FieldVendorReference, vs...))
+}
+
+// VendorReferenceNotIn applies the NotIn predicate on the "vendor_reference" field.
+func VendorReferenceNotIn(vs ...string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotIn(FieldVendorReference, vs...))
+}
+
+// VendorReferenceGT applies the GT predicate on the "vendor_reference" field.
+func VendorReferenceGT(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGT(FieldVendorReference, v))
+}
+
+// VendorReferenceGTE applies the GTE predicate on the "vendor_reference" field.
+func VendorReferenceGTE(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGTE(FieldVendorReference, v))
+}
+
+// VendorReferenceLT applies the LT predicate on the "vendor_reference" field.
+func VendorReferenceLT(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLT(FieldVendorReference, v))
+}
+
+// VendorReferenceLTE applies the LTE predicate on the "vendor_reference" field.
+func VendorReferenceLTE(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLTE(FieldVendorReference, v))
+}
+
+// VendorReferenceContains applies the Contains predicate on the "vendor_reference" field.
+func VendorReferenceContains(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldContains(FieldVendorReference, v))
+}
+
+// VendorReferenceHasPrefix applies the HasPrefix predicate on the "vendor_reference" field.
+func VendorReferenceHasPrefix(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldHasPrefix(FieldVendorReference, v))
+}
+
+// VendorReferenceHasSuffix applies the HasSuffix predicate on the "vendor_reference" field.
+func VendorReferenceHasSuffix(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldHasSuffix(FieldVendorReference, v))
+}
+
+// VendorReferenceIsNil applies the IsNil predicate on the "vendor_reference" field.
+func VendorReferenceIsNil() predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIsNull(FieldVendorReference))
+}
+
+// VendorReferenceNotNil applies the NotNil predicate on the "vendor_reference" field.
+func VendorReferenceNotNil() predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotNull(FieldVendorReference))
+}
+
+// VendorReferenceEqualFold applies the EqualFold predicate on the "vendor_reference" field.
+func VendorReferenceEqualFold(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEqualFold(FieldVendorReference, v))
+}
+
+// VendorReferenceContainsFold applies the ContainsFold predicate on the "vendor_reference" field.
+func VendorReferenceContainsFold(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldContainsFold(FieldVendorReference, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// CategoryNameEQ applies the EQ predicate on the "category_name" field.
+func CategoryNameEQ(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldCategoryName, v))
+}
+
+// CategoryNameNEQ applies the NEQ predicate on the "category_name" field.
+func CategoryNameNEQ(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNEQ(FieldCategoryName, v))
+}
+
+// CategoryNameIn applies the In predicate on the "category_name" field.
+func CategoryNameIn(vs ...string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIn(FieldCategoryName, vs...))
+}
+
+// CategoryNameNotIn applies the NotIn predicate on the "category_name" field.
+func CategoryNameNotIn(vs ...string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotIn(FieldCategoryName, vs...))
+}
+
+// CategoryNameGT applies the GT predicate on the "category_name" field.
+func CategoryNameGT(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGT(FieldCategoryName, v))
+}
+
+// CategoryNameGTE applies the GTE predicate on the "category_name" field.
+func CategoryNameGTE(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGTE(FieldCategoryName, v))
+}
+
+// CategoryNameLT applies the LT predicate on the "category_name" field.
+func CategoryNameLT(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLT(FieldCategoryName, v))
+}
+
+// CategoryNameLTE applies the LTE predicate on the "category_name" field.
+func CategoryNameLTE(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLTE(FieldCategoryName, v))
+}
+
+// CategoryNameContains applies the Contains predicate on the "category_name" field.
+func CategoryNameContains(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldContains(FieldCategoryName, v))
+}
+
+// CategoryNameHasPrefix applies the HasPrefix predicate on the "category_name" field.
+func CategoryNameHasPrefix(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldHasPrefix(FieldCategoryName, v))
+}
+
+// CategoryNameHasSuffix applies the HasSuffix predicate on the "category_name" field.
+func CategoryNameHasSuffix(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldHasSuffix(FieldCategoryName, v))
+}
+
+// CategoryNameEqualFold applies the EqualFold predicate on the "category_name" field.
+func CategoryNameEqualFold(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEqualFold(FieldCategoryName, v))
+}
+
+// CategoryNameContainsFold applies the ContainsFold predicate on the "category_name" field.
+func CategoryNameContainsFold(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldContainsFold(FieldCategoryName, v))
+}
+
+// RateEQ applies the EQ predicate on the "rate" field.
+func RateEQ(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldRate, v))
+}
+
+// RateNEQ applies the NEQ predicate on the "rate" field.
+func RateNEQ(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNEQ(FieldRate, v))
+}
+
+// RateIn applies the In predicate on the "rate" field.
+func RateIn(vs ...decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIn(FieldRate, vs...))
+}
+
+// RateNotIn applies the NotIn predicate on the "rate" field.
+func RateNotIn(vs ...decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotIn(FieldRate, vs...))
+}
+
+// RateGT applies the GT predicate on the "rate" field.
+func RateGT(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGT(FieldRate, v))
+}
+
+// RateGTE applies the GTE predicate on the "rate" field.
+func RateGTE(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGTE(FieldRate, v))
+}
+
+// RateLT applies the LT predicate on the "rate" field.
+func RateLT(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLT(FieldRate, v))
+}
+
+// RateLTE applies the LTE predicate on the "rate" field.
+func RateLTE(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLTE(FieldRate, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// GrossAmountEQ applies the EQ predicate on the "gross_amount" field.
+func GrossAmountEQ(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldGrossAmount, v))
+}
+
+// GrossAmountNEQ applies the NEQ predicate on the "gross_amount" field.
+func GrossAmountNEQ(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNEQ(FieldGrossAmount, v))
+}
+
+// GrossAmountIn applies the In predicate on the "gross_amount" field.
+func GrossAmountIn(vs ...decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIn(FieldGrossAmount, vs...))
+}
+
+// GrossAmountNotIn applies the NotIn predicate on the "gross_amount" field.
+func GrossAmountNotIn(vs ...decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotIn(FieldGrossAmount, vs...))
+}
+
+// GrossAmountGT applies the GT predicate on the "gross_amount" field.
+func GrossAmountGT(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGT(FieldGrossAmount, v))
+}
+
+// GrossAmountGTE applies the GTE predicate on the "gross_amount" field.
+func GrossAmountGTE(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGTE(FieldGrossAmount, v))
+}
+
+// GrossAmountLT applies the LT predicate on the "gross_amount" field.
+func GrossAmountLT(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLT(FieldGrossAmount, v))
+}
+
+// GrossAmountLTE applies the LTE predicate on the "gross_amount" field.
+func GrossAmountLTE(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLTE(FieldGrossAmount, v))
+}
+
+// TaxAmountEQ applies the EQ predicate on the "tax_amount" field.
+func TaxAmountEQ(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldTaxAmount, v))
+}
+
+// TaxAmountNEQ applies the NEQ predicate on the "tax_amount" field.
+func TaxAmountNEQ(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNEQ(FieldTaxAmount, v))
+}
+
+// TaxAmountIn applies the In predicate on the "tax_amount" field.
+func TaxAmountIn(vs ...decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIn(FieldTaxAmount, vs...))
+}
+
+// TaxAmountNotIn applies the NotIn predicate on the "tax_amount" field.
+func TaxAmountNotIn(vs ...decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotIn(FieldTaxAmount, vs...))
+}
+
+// TaxAmountGT applies the GT predicate on the "tax_amount" field.
+func TaxAmountGT(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGT(FieldTaxAmount, v))
+}
+
+// TaxAmountGTE applies the GTE predicate on the "tax_amount" field.
+func TaxAmountGTE(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGTE(FieldTaxAmount, v))
+}
+
+// TaxAmountLT applies the LT predicate on the "tax_amount" field.
+func TaxAmountLT(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLT(FieldTaxAmount, v))
+}
+
+// TaxAmountLTE applies the LTE predicate on the "tax_amount" field.
+func TaxAmountLTE(v decimal.Decimal) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLTE(FieldTaxAmount, v))
+}
+
+// PaymentDateEQ applies the EQ predicate on the "payment_date" field.
+func PaymentDateEQ(v time.Time) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldPaymentDate, v))
+}
+
+// PaymentDateNEQ applies the NEQ predicate on the "payment_date" field.
+func PaymentDateNEQ(v time.Time) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNEQ(FieldPaymentDate, v))
+}
+
+// PaymentDateIn applies the In predicate on the "payment_date" field.
+func PaymentDateIn(vs ...time.Time) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIn(FieldPaymentDate, vs...))
+}
+
+// PaymentDateNotIn applies the NotIn predicate on the "payment_date" field.
+func PaymentDateNotIn(vs ...time.Time) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotIn(FieldPaymentDate, vs...))
+}
+
+// PaymentDateGT applies the GT predicate on the "payment_date" field.
+func PaymentDateGT(v time.Time) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGT(FieldPaymentDate, v))
+}
+
+// PaymentDateGTE applies the GTE predicate on the "payment_date" field.
+func PaymentDateGTE(v time.Time) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGTE(FieldPaymentDate, v))
+}
+
+// PaymentDateLT applies the LT predicate on the "payment_date" field.
+func PaymentDateLT(v time.Time) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLT(FieldPaymentDate, v))
+}
+
+// PaymentDateLTE applies the LTE predicate on the "payment_date" field.
+func PaymentDateLTE(v time.Time) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLTE(FieldPaymentDate, v))
+}
+
+// PaymentReferenceEQ applies the EQ predicate on the "payment_reference" field.
+func PaymentReferenceEQ(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldPaymentReference, v))
+}
+
+// PaymentReferenceNEQ applies the NEQ predicate on the "payment_reference" field.
+func PaymentReferenceNEQ(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNEQ(FieldPaymentReference, v))
+}
+
+// PaymentReferenceIn applies the In predicate on the "payment_reference" field.
+func PaymentReferenceIn(vs ...string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIn(FieldPaymentReference, vs...))
+}
+
+// PaymentReferenceNotIn applies the NotIn predicate on the "payment_reference" field.
+func PaymentReferenceNotIn(vs ...string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotIn(FieldPaymentReference, vs...))
+}
+
+// PaymentReferenceGT applies the GT predicate on the "payment_reference" field.
+func PaymentReferenceGT(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGT(FieldPaymentReference, v))
+}
+
+// PaymentReferenceGTE applies the GTE predicate on the "payment_reference" field.
+func PaymentReferenceGTE(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGTE(FieldPaymentReference, v))
+}
+
+// PaymentReferenceLT applies the LT predicate on the "payment_reference" field.
+func PaymentReferenceLT(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLT(FieldPaymentReference, v))
+}
+
+// PaymentReferenceLTE applies the LTE predicate on the "payment_reference" field.
+func PaymentReferenceLTE(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLTE(FieldPaymentReference, v))
+}
+
+// PaymentReferenceContains applies the Contains predicate on the "payment_reference" field.
+func PaymentReferenceContains(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldContains(FieldPaymentReference, v))
+}
+
+// PaymentReferenceHasPrefix applies the HasPrefix predicate on the "payment_reference" field.
+func PaymentReferenceHasPrefix(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldHasPrefix(FieldPaymentReference, v))
+}
+
+// PaymentReferenceHasSuffix applies the HasSuffix predicate on the "payment_reference" field.
+func PaymentReferenceHasSuffix(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldHasSuffix(FieldPaymentReference, v))
+}
+
+// PaymentReferenceIsNil applies the IsNil predicate on the "payment_reference" field.
+func PaymentReferenceIsNil() predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIsNull(FieldPaymentReference))
+}
+
+// PaymentReferenceNotNil applies the NotNil predicate on the "payment_reference" field.
+func PaymentReferenceNotNil() predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotNull(FieldPaymentReference))
+}
+
+// PaymentReferenceEqualFold applies the EqualFold predicate on the "payment_reference" field.
+func PaymentReferenceEqualFold(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEqualFold(FieldPaymentReference, v))
+}
+
+// PaymentReferenceContainsFold applies the ContainsFold predicate on the "payment_reference" field.
+func PaymentReferenceContainsFold(v string) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldContainsFold(FieldPaymentReference, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WithholdingCertificate) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WithholdingCertificate) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WithholdingCertificate) predicate.WithholdingCertificate {
+	return predicate.WithholdingCertificate(sql.NotPredicates(p))
+}
@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package withholdingcertificate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the withholdingcertificate type in the database.
+	Label = "withholding_certificate"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCertificateNumber holds the string denoting the certificate_number field in the database.
+	FieldCertificateNumber = "certificate_number"
+	// FieldVendorID holds the string denoting the vendor_id field in the database.
+	FieldVendorID = "vendor_id"
+	// FieldVendorName holds the string denoting the vendor_name field in the database.
+	FieldVendorName = "vendor_name"
+	// FieldVendorPin holds the string denoting the vendor_pin field in the database.
+	FieldVendorPin = "vendor_pin"
+	// FieldBillID holds the string denoting the bill_id field in the database.
+	FieldBillID = "bill_id"
+	// FieldBillNumber holds the string denoting the bill_number field in the database.
+	FieldBillNumber = "bill_number"
+	// FieldVendorReference holds the string denoting the vendor_reference field in the database.
+	FieldVendorReference = "vendor_reference"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldCategoryName holds the string denoting the category_name field in the database.
+	FieldCategoryName = "category_name"
+	// FieldRate holds the string denoting the rate field in the database.
+	FieldRate = "rate"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldGrossAmount holds the string denoting the gross_amount field in the database.
+	FieldGrossAmount = "gross_amount"
+	// FieldTaxAmount holds the string denoting the tax_amount field in the database.
+	FieldTaxAmount = "tax_amount"
+	// FieldPaymentDate holds the string denoting the payment_date field in the database.
+	FieldPaymentDate = "payment_date"
+	// FieldPaymentReference holds the string denoting the payment_reference field in the database.
+	FieldPaymentReference = "payment_reference"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the withholdingcertificate in the database.
+	Table = "withholding_certificates"
+)
+
+// Columns holds all SQL columns for withholdingcertificate fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCertificateNumber,
+	FieldVendorID,
+	FieldVendorName,
+	FieldVendorPin,
+	FieldBillID,
+	FieldBillNumber,
+	FieldVendorReference,
+	FieldCategory,
+	FieldCategoryName,
+	FieldRate,
+	FieldCurrency,
+	FieldGrossAmount,
+	FieldTaxAmount,
+	FieldPaymentDate,
+	FieldPaymentReference,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CertificateNumberValidator is a validator for the "certificate_number" field. It is called by the builders before save.
+	CertificateNumberValidator func(string) error
+	// VendorNameValidator is a validator for the "vendor_name" field. It is called by the builders before save.
+	VendorNameValidator func(string) error
+	// BillNumberValidator is a validator for the "bill_number" field. It is called by the builders before save.
+	BillNumberValidator func(string) error
+	// CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	CategoryValidator func(string) error
+	// CategoryNameValidator is a validator for the "category_name" field. It is called by the builders before save.
+	CategoryNameValidator func(string) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the WithholdingCertificate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByCertificateNumber orders the results by the certificate_number field.
+func ByCertificateNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificateNumber, opts...).ToFunc()
+}
+
+// ByVendorID orders the results by the vendor_id field.
+func ByVendorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVendorID, opts...).ToFunc()
+}
+
+// ByVendorName orders the results by the vendor_name field.
+func ByVendorName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVendorName, opts...).ToFunc()
+}
+
+// ByVendorPin orders the results by the vendor_pin field.
+func ByVendorPin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVendorPin, opts...).ToFunc()
+}
+
+// ByBillID orders the results by the bill_id field.
+func ByBillID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillID, opts...).ToFunc()
+}
+
+// ByBillNumber orders the results by the bill_number field.
+func ByBillNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillNumber, opts...).ToFunc()
+}
+
+// ByVendorReference orders the results by the vendor_reference field.
+func ByVendorReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVendorReference, opts...).ToFunc()
+}
+
+// ByCategory orders the results by the category field.
+func ByCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategory, opts...).ToFunc()
+}
+
+// ByCategoryName orders the results by the category_name field.
+func ByCategoryName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoryName, opts...).ToFunc()
+}
+
+// ByRate orders the results by the rate field.
+func ByRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRate, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByGrossAmount orders the results by the gross_amount field.
+func ByGrossAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGrossAmount, opts...).ToFunc()
+}
+
+// ByTaxAmount orders the results by the tax_amount field.
+func ByTaxAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxAmount, opts...).ToFunc()
+}
+
+// ByPaymentDate orders the results by the payment_date field.
+func ByPaymentDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentDate, opts...).ToFunc()
+}
+
+// ByPaymentReference orders the results by the payment_reference field.
+func ByPaymentReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentReference, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,1474 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/withholdingcertificate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WithholdingCertificateCreate is the builder for creating a WithholdingCertificate entity.
+type WithholdingCertificateCreate struct {
+	config
+	mutation *WithholdingCertificateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *WithholdingCertificateCreate) SetTenantID(v uuid.UUID) *WithholdingCertificateCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCertificateNumber sets the "certificate_number" field.
+func (_c *WithholdingCertificateCreate) SetCertificateNumber(v string) *WithholdingCertificateCreate {
+	_c.mutation.SetCertificateNumber(v)
+	return _c
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_c *WithholdingCertificateCreate) SetVendorID(v uuid.UUID) *WithholdingCertificateCreate {
+	_c.mutation.SetVendorID(v)
+	return _c
+}
+
+// SetVendorName sets the "vendor_name" field.
+func (_c *WithholdingCertificateCreate) SetVendorName(v string) *WithholdingCertificateCreate {
+	_c.mutation.SetVendorName(v)
+	return _c
+}
+
+// SetVendorPin sets the "vendor_pin" field.
+func (_c *WithholdingCertificateCreate) SetVendorPin(v string) *WithholdingCertificateCreate {
+	_c.mutation.SetVendorPin(v)
+	return _c
+}
+
+// SetNillableVendorPin sets the "vendor_pin" field if the given value is not nil.
+func (_c *WithholdingCertificateCreate) SetNillableVendorPin(v *string) *WithholdingCertificateCreate {
+	if v != nil {
+		_c.SetVendorPin(*v)
+	}
+	return _c
+}
+
+// SetBillID sets the "bill_id" field.
+func (_c *WithholdingCertificateCreate) SetBillID(v uuid.UUID) *WithholdingCertificateCreate {
+	_c.mutation.SetBillID(v)
+	return _c
+}
+
+// SetBillNumber sets the "bill_number" field.
+func (_c *WithholdingCertificateCreate) SetBillNumber(v string) *WithholdingCertificateCreate {
+	_c.mutation.SetBillNumber(v)
+	return _c
+}
+
+// SetVendorReference sets the "vendor_reference" field.
+func (_c *WithholdingCertificateCreate) SetVendorReference(v string) *WithholdingCertificateCreate {
+	_c.mutation.SetVendorReference(v)
+	return _c
+}
+
+// SetNillableVendorReference sets the "vendor_reference" field if the given value is not nil.
+func (_c *WithholdingCertificateCreate) SetNillableVendorReference(v *string) *WithholdingCertificateCreate {
+	if v != nil {
+		_c.SetVendorReference(*v)
+	}
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *WithholdingCertificateCreate) SetCategory(v string) *WithholdingCertificateCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetCategoryName sets the "category_name" field.
+func (_c *WithholdingCertificateCreate) SetCategoryName(v string) *WithholdingCertificateCreate {
+	_c.mutation.SetCategoryName(v)
+	return _c
+}
+
+// SetRate sets the "rate" field.
+func (_c *WithholdingCertificateCreate) SetRate(v decimal.Decimal) *WithholdingCertificateCreate {
+	_c.mutation.SetRate(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *WithholdingCertificateCreate) SetCurrency(v string) *WithholdingCertificateCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *WithholdingCertificateCreate) SetNillableCurrency(v *string) *WithholdingCertificateCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetGrossAmount sets the "gross_amount" field.
+func (_c *WithholdingCertificateCreate) SetGrossAmount(v decimal.Decimal) *WithholdingCertificateCreate {
+	_c.mutation.SetGrossAmount(v)
+	return _c
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (_c *WithholdingCertificateCreate) SetTaxAmount(v decimal.Decimal) *WithholdingCertificateCreate {
+	_c.mutation.SetTaxAmount(v)
+	return _c
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (_c *WithholdingCertificateCreate) SetPaymentDate(v time.Time) *WithholdingCertificateCreate {
+	_c.mutation.SetPaymentDate(v)
+	return _c
+}
+
+// SetPaymentReference sets the "payment_reference" field.
+func (_c *WithholdingCertificateCreate) SetPaymentReference(v string) *WithholdingCertificateCreate {
+	_c.mutation.SetPaymentReference(v)
+	return _c
+}
+
+// SetNillablePaymentReference sets the "payment_reference" field if the given value is not nil.
+func (_c *WithholdingCertificateCreate) SetNillablePaymentReference(v *string) *WithholdingCertificateCreate {
+	if v != nil {
+		_c.SetPaymentReference(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WithholdingCertificateCreate) SetCreatedAt(v time.Time) *WithholdingCertificateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WithholdingCertificateCreate) SetNillableCreatedAt(v *time.Time) *WithholdingCertificateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WithholdingCertificateCreate) SetID(v uuid.UUID) *WithholdingCertificateCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WithholdingCertificateCreate) SetNillableID(v *uuid.UUID) *WithholdingCertificateCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the WithholdingCertificateMutation object of the builder.
+func (_c *WithholdingCertificateCreate) Mutation() *WithholdingCertificateMutation {
+	return _c.mutation
+}
+
+// Save creates the WithholdingCertificate in the database.
+func (_c *WithholdingCertificateCreate) Save(ctx context.Context) (*WithholdingCertificate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WithholdingCertificateCreate) SaveX(ctx context.Context) *WithholdingCertificate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WithholdingCertificateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WithholdingCertificateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WithholdingCertificateCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := withholdingcertificate.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := withholdingcertificate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := withholdingcertificate.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WithholdingCertificateCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "WithholdingCertificate.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CertificateNumber(); !ok {
+		return &ValidationError{Name: "certificate_number", err: errors.New(`ent: missing required field "WithholdingCertificate.certificate_number"`)}
+	}
+	if v, ok := _c.mutation.CertificateNumber(); ok {
+		if err := withholdingcertificate.CertificateNumberValidator(v); err != nil {
+			return &ValidationError{Name: "certificate_number", err: fmt.Errorf(`ent: validator failed for field "WithholdingCertificate.certificate_number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.VendorID(); !ok {
+		return &ValidationError{Name: "vendor_id", err: errors.New(`ent: missing required field "WithholdingCertificate.vendor_id"`)}
+	}
+	if _, ok := _c.mutation.VendorName(); !ok {
+		return &ValidationError{Name: "vendor_name", err: errors.New(`ent: missing required field "WithholdingCertificate.vendor_name"`)}
+	}
+	if v, ok := _c.mutation.VendorName(); ok {
+		if err := withholdingcertificate.VendorNameValidator(v); err != nil {
+			return &ValidationError{Name: "vendor_name", err: fmt.Errorf(`ent: validator failed for field "WithholdingCertificate.vendor_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BillID(); !ok {
+		return &ValidationError{Name: "bill_id", err: errors.New(`ent: missing required field "WithholdingCertificate.bill_id"`)}
+	}
+	if _, ok := _c.mutation.BillNumber(); !ok {
+		return &ValidationError{Name: "bill_number", err: errors.New(`ent: missing required field "WithholdingCertificate.bill_number"`)}
+	}
+	if v, ok := _c.mutation.BillNumber(); ok {
+		if err := withholdingcertificate.BillNumberValidator(v); err != nil {
+			return &ValidationError{Name: "bill_number", err: fmt.Errorf(`ent: validator failed for field "WithholdingCertificate.bill_number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "WithholdingCertificate.category"`)}
+	}
+	if v, ok := _c.mutation.Category(); ok {
+		if err := withholdingcertificate.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "WithholdingCertificate.category": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CategoryName(); !ok {
+		return &ValidationError{Name: "category_name", err: errors.New(`ent: missing required field "WithholdingCertificate.category_name"`)}
+	}
+	if v, ok := _c.mutation.CategoryName(); ok {
+		if err := withholdingcertificate.CategoryNameValidator(v); err != nil {
+			return &ValidationError{Name: "category_name", err: fmt.Errorf(`ent: validator failed for field "WithholdingCertificate.category_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "WithholdingCertificate.rate"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "WithholdingCertificate.currency"`)}
+	}
+	if _, ok := _c.mutation.GrossAmount(); !ok {
+		return &ValidationError{Name: "gross_amount", err: errors.New(`ent: missing required field "WithholdingCertificate.gross_amount"`)}
+	}
+	if _, ok := _c.mutation.TaxAmount(); !ok {
+		return &ValidationError{Name: "tax_amount", err: errors.New(`ent: missing required field "WithholdingCertificate.tax_amount"`)}
+	}
+	if _, ok := _c.mutation.PaymentDate(); !ok {
+		return &ValidationError{Name: "payment_date", err: errors.New(`ent: missing required field "WithholdingCertificate.payment_date"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WithholdingCertificate.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *WithholdingCertificateCreate) sqlSave(ctx context.Context) (*WithholdingCertificate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WithholdingCertificateCreate) createSpec() (*WithholdingCertificate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WithholdingCertificate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(withholdingcertificate.Table, sqlgraph.NewFieldSpec(withholdingcertificate.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(withholdingcertificate.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CertificateNumber(); ok {
+		_spec.SetField(withholdingcertificate.FieldCertificateNumber, field.TypeString, value)
+		_node.CertificateNumber = value
+	}
+	if value, ok := _c.mutation.VendorID(); ok {
+		_spec.SetField(withholdingcertificate.FieldVendorID, field.TypeUUID, value)
+		_node.VendorID = value
+	}
+	if value, ok := _c.mutation.VendorName(); ok {
+		_spec.SetField(withholdingcertificate.FieldVendorName, field.TypeString, value)
+		_node.VendorName = value
+	}
+	if value, ok := _c.mutation.VendorPin(); ok {
+		_spec.SetField(withholdingcertificate.FieldVendorPin, field.TypeString, value)
+		_node.VendorPin = value
+	}
+	if value, ok := _c.mutation.BillID(); ok {
+		_spec.SetField(withholdingcertificate.FieldBillID, field.TypeUUID, value)
+		_node.BillID = value
+	}
+	if value, ok := _c.mutation.BillNumber(); ok {
+		_spec.SetField(withholdingcertificate.FieldBillNumber, field.TypeString, value)
+		_node.BillNumber = value
+	}
+	if value, ok := _c.mutation.VendorReference(); ok {
+		_spec.SetField(withholdingcertificate.FieldVendorReference, field.TypeString, value)
+		_node.VendorReference = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(withholdingcertificate.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := _c.mutation.CategoryName(); ok {
+		_spec.SetField(withholdingcertificate.FieldCategoryName, field.TypeString, value)
+		_node.CategoryName = value
+	}
+	if value, ok := _c.mutation.Rate(); ok {
+		_spec.SetField(withholdingcertificate.FieldRate, field.TypeFloat64, value)
+		_node.Rate = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(withholdingcertificate.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.GrossAmount(); ok {
+		_spec.SetField(withholdingcertificate.FieldGrossAmount, field.TypeFloat64, value)
+		_node.GrossAmount = value
+	}
+	if value, ok := _c.mutation.TaxAmount(); ok {
+		_spec.SetField(withholdingcertificate.FieldTaxAmount, field.TypeFloat64, value)
+		_node.TaxAmount = value
+	}
+	if value, ok := _c.mutation.PaymentDate(); ok {
+		_spec.SetField(withholdingcertificate.FieldPaymentDate, field.TypeTime, value)
+		_node.PaymentDate = value
+	}
+	if value, ok := _c.mutation.PaymentReference(); ok {
+		_spec.SetField(withholdingcertificate.FieldPaymentReference, field.TypeString, value)
+		_node.PaymentReference = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(withholdingcertificate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WithholdingCertificate.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WithholdingCertificateUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WithholdingCertificateCreate) OnConflict(opts ...sql.ConflictOption) *WithholdingCertificateUpsertOne {
+	_c.conflict = opts
+	return &WithholdingCertificateUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WithholdingCertificate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WithholdingCertificateCreate) OnConflictColumns(columns ...string) *WithholdingCertificateUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WithholdingCertificateUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WithholdingCertificateUpsertOne is the builder for "upsert"-ing
+	//  one WithholdingCertificate node.
+	WithholdingCertificateUpsertOne struct {
+		create *WithholdingCertificateCreate
+	}
+
+	// WithholdingCertificateUpsert is the "OnConflict" setter.
+	WithholdingCertificateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WithholdingCertificateUpsert) SetTenantID(v uuid.UUID) *WithholdingCertificateUpsert {
+	u.Set(withholdingcertificate.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsert) UpdateTenantID() *WithholdingCertificateUpsert {
+	u.SetExcluded(withholdingcertificate.FieldTenantID)
+	return u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (u *WithholdingCertificateUpsert) SetVendorID(v uuid.UUID) *WithholdingCertificateUpsert {
+	u.Set(withholdingcertificate.FieldVendorID, v)
+	return u
+}
+
+// UpdateVendorID sets the "vendor_id" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsert) UpdateVendorID() *WithholdingCertificateUpsert {
+	u.SetExcluded(withholdingcertificate.FieldVendorID)
+	return u
+}
+
+// SetVendorName sets the "vendor_name" field.
+func (u *WithholdingCertificateUpsert) SetVendorName(v string) *WithholdingCertificateUpsert {
+	u.Set(withholdingcertificate.FieldVendorName, v)
+	return u
+}
+
+// UpdateVendorName sets the "vendor_name" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsert) UpdateVendorName() *WithholdingCertificateUpsert {
+	u.SetExcluded(withholdingcertificate.FieldVendorName)
+	return u
+}
+
+// SetVendorPin sets the "vendor_pin" field.
+func (u *WithholdingCertificateUpsert) SetVendorPin(v string) *WithholdingCertificateUpsert {
+	u.Set(withholdingcertificate.FieldVendorPin, v)
+	return u
+}
+
+// UpdateVendorPin sets the "vendor_pin" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsert) UpdateVendorPin() *WithholdingCertificateUpsert {
+	u.SetExcluded(withholdingcertificate.FieldVendorPin)
+	return u
+}
+
+// ClearVendorPin clears the value of the "vendor_pin" field.
+func (u *WithholdingCertificateUpsert) ClearVendorPin() *WithholdingCertificateUpsert {
+	u.SetNull(withholdingcertificate.FieldVendorPin)
+	return u
+}
+
+// SetBillID sets the "bill_id" field.
+func (u *WithholdingCertificateUpsert) SetBillID(v uuid.UUID) *WithholdingCertificateUpsert {
+	u.Set(withholdingcertificate.FieldBillID, v)
+	return u
+}
+
+// UpdateBillID sets the "bill_id" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsert) UpdateBillID() *WithholdingCertificateUpsert {
+	u.SetExcluded(withholdingcertificate.FieldBillID)
+	return u
+}
+
+// SetBillNumber sets the "bill_number" field.
+func (u *WithholdingCertificateUpsert) SetBillNumber(v string) *WithholdingCertificateUpsert {
+	u.Set(withholdingcertificate.FieldBillNumber, v)
+	return u
+}
+
+// UpdateBillNumber sets the "bill_number" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsert) UpdateBillNumber() *WithholdingCertificateUpsert {
+	u.SetExcluded(withholdingcertificate.FieldBillNumber)
+	return u
+}
+
+// SetVendorReference sets the "vendor_reference" field.
+func (u *WithholdingCertificateUpsert) SetVendorReference(v string) *WithholdingCertificateUpsert {
+	u.Set(withholdingcertificate.FieldVendorReference, v)
+	return u
+}
+
+// UpdateVendorReference sets the "vendor_reference" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsert) UpdateVendorReference() *WithholdingCertificateUpsert {
+	u.SetExcluded(withholdingcertificate.FieldVendorReference)
+	return u
+}
+
+// ClearVendorReference clears the value of the "vendor_reference" field.
+func (u *WithholdingCertificateUpsert) ClearVendorReference() *WithholdingCertificateUpsert {
+	u.SetNull(withholdingcertificate.FieldVendorReference)
+	return u
+}
+
+// SetCategory sets the "category" field.
+func (u *WithholdingCertificateUpsert) SetCategory(v string) *WithholdingCertificateUpsert {
+	u.Set(withholdingcertificate.FieldCategory, v)
+	return u
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsert) UpdateCategory() *WithholdingCertificateUpsert {
+	u.SetExcluded(withholdingcertificate.FieldCategory)
+	return u
+}
+
+// SetCategoryName sets the "category_name" field.
+func (u *WithholdingCertificateUpsert) SetCategoryName(v string) *WithholdingCertificateUpsert {
+	u.Set(withholdingcertificate.FieldCategoryName, v)
+	return u
+}
+
+// UpdateCategoryName sets the "category_name" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsert) UpdateCategoryName() *WithholdingCertificateUpsert {
+	u.SetExcluded(withholdingcertificate.FieldCategoryName)
+	return u
+}
+
+// SetRate sets the "rate" field.
+func (u *WithholdingCertificateUpsert) SetRate(v decimal.Decimal) *WithholdingCertificateUpsert {
+	u.Set(withholdingcertificate.FieldRate, v)
+	return u
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsert) UpdateRate() *WithholdingCertificateUpsert {
+	u.SetExcluded(withholdingcertificate.FieldRate)
+	return u
+}
+
+// AddRate adds v to the "rate" field.
+func (u *WithholdingCertificateUpsert) AddRate(v decimal.Decimal) *WithholdingCertificateUpsert {
+	u.Add(withholdingcertificate.FieldRate, v)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *WithholdingCertificateUpsert) SetCurrency(v string) *WithholdingCertificateUpsert {
+	u.Set(withholdingcertificate.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsert) UpdateCurrency() *WithholdingCertificateUpsert {
+	u.SetExcluded(withholdingcertificate.FieldCurrency)
+	return u
+}
+
+// SetGrossAmount sets the "gross_amount" field.
+func (u *WithholdingCertificateUpsert) SetGrossAmount(v decimal.Decimal) *WithholdingCertificateUpsert {
+	u.Set(withholdingcertificate.FieldGrossAmount, v)
+	return u
+}
+
+// UpdateGrossAmount sets the "gross_amount" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsert) UpdateGrossAmount() *WithholdingCertificateUpsert {
+	u.SetExcluded(withholdingcertificate.FieldGrossAmount)
+	return u
+}
+
+// AddGrossAmount adds v to the "gross_amount" field.
+func (u *WithholdingCertificateUpsert) AddGrossAmount(v decimal.Decimal) *WithholdingCertificateUpsert {
+	u.Add(withholdingcertificate.FieldGrossAmount, v)
+	return u
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *WithholdingCertificateUpsert) SetTaxAmount(v decimal.Decimal) *WithholdingCertificateUpsert {
+	u.Set(withholdingcertificate.FieldTaxAmount, v)
+	return u
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsert) UpdateTaxAmount() *WithholdingCertificateUpsert {
+	u.SetExcluded(withholdingcertificate.FieldTaxAmount)
+	return u
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *WithholdingCertificateUpsert) AddTaxAmount(v decimal.Decimal) *WithholdingCertificateUpsert {
+	u.Add(withholdingcertificate.FieldTaxAmount, v)
+	return u
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (u *WithholdingCertificateUpsert) SetPaymentDate(v time.Time) *WithholdingCertificateUpsert {
+	u.Set(withholdingcertificate.FieldPaymentDate, v)
+	return u
+}
+
+// UpdatePaymentDate sets the "payment_date" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsert) UpdatePaymentDate() *WithholdingCertificateUpsert {
+	u.SetExcluded(withholdingcertificate.FieldPaymentDate)
+	return u
+}
+
+// SetPaymentReference sets the "payment_reference" field.
+func (u *WithholdingCertificateUpsert) SetPaymentReference(v string) *WithholdingCertificateUpsert {
+	u.Set(withholdingcertificate.FieldPaymentReference, v)
+	return u
+}
+
+// UpdatePaymentReference sets the "payment_reference" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsert) UpdatePaymentReference() *WithholdingCertificateUpsert {
+	u.SetExcluded(withholdingcertificate.FieldPaymentReference)
+	return u
+}
+
+// ClearPaymentReference clears the value of the "payment_reference" field.
+func (u *WithholdingCertificateUpsert) ClearPaymentReference() *WithholdingCertificateUpsert {
+	u.SetNull(withholdingcertificate.FieldPaymentReference)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WithholdingCertificate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(withholdingcertificate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WithholdingCertificateUpsertOne) UpdateNewValues() *WithholdingCertificateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(withholdingcertificate.FieldID)
+		}
+		if _, exists := u.create.mutation.CertificateNumber(); exists {
+			s.SetIgnore(withholdingcertificate.FieldCertificateNumber)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(withholdingcertificate.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WithholdingCertificate.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WithholdingCertificateUpsertOne) Ignore() *WithholdingCertificateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WithholdingCertificateUpsertOne) DoNothing() *WithholdingCertificateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WithholdingCertificateCreate.OnConflict
+// documentation for more info.
+func (u *WithholdingCertificateUpsertOne) Update(set func(*WithholdingCertificateUpsert)) *WithholdingCertificateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WithholdingCertificateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WithholdingCertificateUpsertOne) SetTenantID(v uuid.UUID) *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertOne) UpdateTenantID() *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (u *WithholdingCertificateUpsertOne) SetVendorID(v uuid.UUID) *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetVendorID(v)
+	})
+}
+
+// UpdateVendorID sets the "vendor_id" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertOne) UpdateVendorID() *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateVendorID()
+	})
+}
+
+// SetVendorName sets the "vendor_name" field.
+func (u *WithholdingCertificateUpsertOne) SetVendorName(v string) *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetVendorName(v)
+	})
+}
+
+// UpdateVendorName sets the "vendor_name" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertOne) UpdateVendorName() *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateVendorName()
+	})
+}
+
+// SetVendorPin sets the "vendor_pin" field.
+func (u *WithholdingCertificateUpsertOne) SetVendorPin(v string) *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetVendorPin(v)
+	})
+}
+
+// UpdateVendorPin sets the "vendor_pin" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertOne) UpdateVendorPin() *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateVendorPin()
+	})
+}
+
+// ClearVendorPin clears the value of the "vendor_pin" field.
+func (u *WithholdingCertificateUpsertOne) ClearVendorPin() *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.ClearVendorPin()
+	})
+}
+
+// SetBillID sets the "bill_id" field.
+func (u *WithholdingCertificateUpsertOne) SetBillID(v uuid.UUID) *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetBillID(v)
+	})
+}
+
+// UpdateBillID sets the "bill_id" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertOne) UpdateBillID() *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateBillID()
+	})
+}
+
+// SetBillNumber sets the "bill_number" field.
+func (u *WithholdingCertificateUpsertOne) SetBillNumber(v string) *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetBillNumber(v)
+	})
+}
+
+// UpdateBillNumber sets the "bill_number" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertOne) UpdateBillNumber() *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateBillNumber()
+	})
+}
+
+// SetVendorReference sets the "vendor_reference" field.
+func (u *WithholdingCertificateUpsertOne) SetVendorReference(v string) *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetVendorReference(v)
+	})
+}
+
+// UpdateVendorReference sets the "vendor_reference" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertOne) UpdateVendorReference() *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateVendorReference()
+	})
+}
+
+// ClearVendorReference clears the value of the "vendor_reference" field.
+func (u *WithholdingCertificateUpsertOne) ClearVendorReference() *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.ClearVendorReference()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *WithholdingCertificateUpsertOne) SetCategory(v string) *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertOne) UpdateCategory() *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// SetCategoryName sets the "category_name" field.
+func (u *WithholdingCertificateUpsertOne) SetCategoryName(v string) *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetCategoryName(v)
+	})
+}
+
+// UpdateCategoryName sets the "category_name" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertOne) UpdateCategoryName() *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateCategoryName()
+	})
+}
+
+// SetRate sets the "rate" field.
+func (u *WithholdingCertificateUpsertOne) SetRate(v decimal.Decimal) *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetRate(v)
+	})
+}
+
+// AddRate adds v to the "rate" field.
+func (u *WithholdingCertificateUpsertOne) AddRate(v decimal.Decimal) *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.AddRate(v)
+	})
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertOne) UpdateRate() *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateRate()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *WithholdingCertificateUpsertOne) SetCurrency(v string) *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertOne) UpdateCurrency() *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetGrossAmount sets the "gross_amount" field.
+func (u *WithholdingCertificateUpsertOne) SetGrossAmount(v decimal.Decimal) *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetGrossAmount(v)
+	})
+}
+
+// AddGrossAmount adds v to the "gross_amount" field.
+func (u *WithholdingCertificateUpsertOne) AddGrossAmount(v decimal.Decimal) *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.AddGrossAmount(v)
+	})
+}
+
+// UpdateGrossAmount sets the "gross_amount" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertOne) UpdateGrossAmount() *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateGrossAmount()
+	})
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *WithholdingCertificateUpsertOne) SetTaxAmount(v decimal.Decimal) *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetTaxAmount(v)
+	})
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *WithholdingCertificateUpsertOne) AddTaxAmount(v decimal.Decimal) *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.AddTaxAmount(v)
+	})
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertOne) UpdateTaxAmount() *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateTaxAmount()
+	})
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (u *WithholdingCertificateUpsertOne) SetPaymentDate(v time.Time) *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetPaymentDate(v)
+	})
+}
+
+// UpdatePaymentDate sets the "payment_date" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertOne) UpdatePaymentDate() *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdatePaymentDate()
+	})
+}
+
+// SetPaymentReference sets the "payment_reference" field.
+func (u *WithholdingCertificateUpsertOne) SetPaymentReference(v string) *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetPaymentReference(v)
+	})
+}
+
+// UpdatePaymentReference sets the "payment_reference" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertOne) UpdatePaymentReference() *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdatePaymentReference()
+	})
+}
+
+// ClearPaymentReference clears the value of the "payment_reference" field.
+func (u *WithholdingCertificateUpsertOne) ClearPaymentReference() *WithholdingCertificateUpsertOne {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.ClearPaymentReference()
+	})
+}
+
+// Exec executes the query.
+func (u *WithholdingCertificateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WithholdingCertificateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WithholdingCertificateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WithholdingCertificateUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WithholdingCertificateUpsertOne.ID is not supported by MySQL driver. Use WithholdingCertificateUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WithholdingCertificateUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WithholdingCertificateCreateBulk is the builder for creating many WithholdingCertificate entities in bulk.
+type WithholdingCertificateCreateBulk struct {
+	config
+	err      error
+	builders []*WithholdingCertificateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WithholdingCertificate entities in the database.
+func (_c *WithholdingCertificateCreateBulk) Save(ctx context.Context) ([]*WithholdingCertificate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WithholdingCertificate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WithholdingCertificateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WithholdingCertificateCreateBulk) SaveX(ctx context.Context) []*WithholdingCertificate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WithholdingCertificateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WithholdingCertificateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WithholdingCertificate.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WithholdingCertificateUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WithholdingCertificateCreateBulk) OnConflict(opts ...sql.ConflictOption) *WithholdingCertificateUpsertBulk {
+	_c.conflict = opts
+	return &WithholdingCertificateUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WithholdingCertificate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WithholdingCertificateCreateBulk) OnConflictColumns(columns ...string) *WithholdingCertificateUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WithholdingCertificateUpsertBulk{
+		create: _c,
+	}
+}
+
+// WithholdingCertificateUpsertBulk is the builder for "upsert"-ing
+// a bulk of WithholdingCertificate nodes.
+type WithholdingCertificateUpsertBulk struct {
+	create *WithholdingCertificateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WithholdingCertificate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(withholdingcertificate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WithholdingCertificateUpsertBulk) UpdateNewValues() *WithholdingCertificateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(withholdingcertificate.FieldID)
+			}
+			if _, exists := b.mutation.CertificateNumber(); exists {
+				s.SetIgnore(withholdingcertificate.FieldCertificateNumber)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(withholdingcertificate.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WithholdingCertificate.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WithholdingCertificateUpsertBulk) Ignore() *WithholdingCertificateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WithholdingCertificateUpsertBulk) DoNothing() *WithholdingCertificateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WithholdingCertificateCreateBulk.OnConflict
+// documentation for more info.
+func (u *WithholdingCertificateUpsertBulk) Update(set func(*WithholdingCertificateUpsert)) *WithholdingCertificateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WithholdingCertificateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WithholdingCertificateUpsertBulk) SetTenantID(v uuid.UUID) *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertBulk) UpdateTenantID() *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (u *WithholdingCertificateUpsertBulk) SetVendorID(v uuid.UUID) *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetVendorID(v)
+	})
+}
+
+// UpdateVendorID sets the "vendor_id" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertBulk) UpdateVendorID() *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateVendorID()
+	})
+}
+
+// SetVendorName sets the "vendor_name" field.
+func (u *WithholdingCertificateUpsertBulk) SetVendorName(v string) *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetVendorName(v)
+	})
+}
+
+// UpdateVendorName sets the "vendor_name" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertBulk) UpdateVendorName() *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateVendorName()
+	})
+}
+
+// SetVendorPin sets the "vendor_pin" field.
+func (u *WithholdingCertificateUpsertBulk) SetVendorPin(v string) *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetVendorPin(v)
+	})
+}
+
+// UpdateVendorPin sets the "vendor_pin" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertBulk) UpdateVendorPin() *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateVendorPin()
+	})
+}
+
+// ClearVendorPin clears the value of the "vendor_pin" field.
+func (u *WithholdingCertificateUpsertBulk) ClearVendorPin() *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.ClearVendorPin()
+	})
+}
+
+// SetBillID sets the "bill_id" field.
+func (u *WithholdingCertificateUpsertBulk) SetBillID(v uuid.UUID) *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetBillID(v)
+	})
+}
+
+// UpdateBillID sets the "bill_id" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertBulk) UpdateBillID() *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateBillID()
+	})
+}
+
+// SetBillNumber sets the "bill_number" field.
+func (u *WithholdingCertificateUpsertBulk) SetBillNumber(v string) *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetBillNumber(v)
+	})
+}
+
+// UpdateBillNumber sets the "bill_number" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertBulk) UpdateBillNumber() *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateBillNumber()
+	})
+}
+
+// SetVendorReference sets the "vendor_reference" field.
+func (u *WithholdingCertificateUpsertBulk) SetVendorReference(v string) *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetVendorReference(v)
+	})
+}
+
+// UpdateVendorReference sets the "vendor_reference" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertBulk) UpdateVendorReference() *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateVendorReference()
+	})
+}
+
+// ClearVendorReference clears the value of the "vendor_reference" field.
+func (u *WithholdingCertificateUpsertBulk) ClearVendorReference() *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.ClearVendorReference()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *WithholdingCertificateUpsertBulk) SetCategory(v string) *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertBulk) UpdateCategory() *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// SetCategoryName sets the "category_name" field.
+func (u *WithholdingCertificateUpsertBulk) SetCategoryName(v string) *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetCategoryName(v)
+	})
+}
+
+// UpdateCategoryName sets the "category_name" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertBulk) UpdateCategoryName() *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateCategoryName()
+	})
+}
+
+// SetRate sets the "rate" field.
+func (u *WithholdingCertificateUpsertBulk) SetRate(v decimal.Decimal) *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetRate(v)
+	})
+}
+
+// AddRate adds v to the "rate" field.
+func (u *WithholdingCertificateUpsertBulk) AddRate(v decimal.Decimal) *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.AddRate(v)
+	})
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertBulk) UpdateRate() *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateRate()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *WithholdingCertificateUpsertBulk) SetCurrency(v string) *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertBulk) UpdateCurrency() *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetGrossAmount sets the "gross_amount" field.
+func (u *WithholdingCertificateUpsertBulk) SetGrossAmount(v decimal.Decimal) *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetGrossAmount(v)
+	})
+}
+
+// AddGrossAmount adds v to the "gross_amount" field.
+func (u *WithholdingCertificateUpsertBulk) AddGrossAmount(v decimal.Decimal) *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.AddGrossAmount(v)
+	})
+}
+
+// UpdateGrossAmount sets the "gross_amount" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertBulk) UpdateGrossAmount() *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateGrossAmount()
+	})
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *WithholdingCertificateUpsertBulk) SetTaxAmount(v decimal.Decimal) *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetTaxAmount(v)
+	})
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *WithholdingCertificateUpsertBulk) AddTaxAmount(v decimal.Decimal) *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.AddTaxAmount(v)
+	})
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertBulk) UpdateTaxAmount() *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdateTaxAmount()
+	})
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (u *WithholdingCertificateUpsertBulk) SetPaymentDate(v time.Time) *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetPaymentDate(v)
+	})
+}
+
+// UpdatePaymentDate sets the "payment_date" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertBulk) UpdatePaymentDate() *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdatePaymentDate()
+	})
+}
+
+// SetPaymentReference sets the "payment_reference" field.
+func (u *WithholdingCertificateUpsertBulk) SetPaymentReference(v string) *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.SetPaymentReference(v)
+	})
+}
+
+// UpdatePaymentReference sets the "payment_reference" field to the value that was provided on create.
+func (u *WithholdingCertificateUpsertBulk) UpdatePaymentReference() *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.UpdatePaymentReference()
+	})
+}
+
+// ClearPaymentReference clears the value of the "payment_reference" field.
+func (u *WithholdingCertificateUpsertBulk) ClearPaymentReference() *WithholdingCertificateUpsertBulk {
+	return u.Update(func(s *WithholdingCertificateUpsert) {
+		s.ClearPaymentReference()
+	})
+}
+
+// Exec executes the query.
+func (u *WithholdingCertificateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WithholdingCertificateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WithholdingCertificateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WithholdingCertificateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/withholdingcertificate"
+)
+
+// WithholdingCertificateDelete is the builder for deleting a WithholdingCertificate entity.
+type WithholdingCertificateDelete struct {
+	config
+	hooks    []Hook
+	mutation *WithholdingCertificateMutation
+}
+
+// Where appends a list predicates to the WithholdingCertificateDelete builder.
+func (_d *WithholdingCertificateDelete) Where(ps ...predicate.WithholdingCertificate) *WithholdingCertificateDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *WithholdingCertificateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *WithholdingCertificateDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *WithholdingCertificateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(withholdingcertificate.Table, sqlgraph.NewFieldSpec(withholdingcertificate.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// WithholdingCertificateDeleteOne is the builder for deleting a single WithholdingCertificate entity.
+type WithholdingCertificateDeleteOne struct {
+	_d *WithholdingCertificateDelete
+}
+
+// Where appends a list predicates to the WithholdingCertificateDelete builder.
+func (_d *WithholdingCertificateDeleteOne) Where(ps ...predicate.WithholdingCertificate) *WithholdingCertificateDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *WithholdingCertificateDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{withholdingcertificate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *WithholdingCertificateDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
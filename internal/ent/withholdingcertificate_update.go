@@ -0,0 +1,858 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/withholdingcertificate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WithholdingCertificateUpdate is the builder for updating WithholdingCertificate entities.
+type WithholdingCertificateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WithholdingCertificateMutation
+}
+
+// Where appends a list predicates to the WithholdingCertificateUpdate builder.
+func (_u *WithholdingCertificateUpdate) Where(ps ...predicate.WithholdingCertificate) *WithholdingCertificateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *WithholdingCertificateUpdate) SetTenantID(v uuid.UUID) *WithholdingCertificateUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdate) SetNillableTenantID(v *uuid.UUID) *WithholdingCertificateUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_u *WithholdingCertificateUpdate) SetVendorID(v uuid.UUID) *WithholdingCertificateUpdate {
+	_u.mutation.SetVendorID(v)
+	return _u
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdate) SetNillableVendorID(v *uuid.UUID) *WithholdingCertificateUpdate {
+	if v != nil {
+		_u.SetVendorID(*v)
+	}
+	return _u
+}
+
+// SetVendorName sets the "vendor_name" field.
+func (_u *WithholdingCertificateUpdate) SetVendorName(v string) *WithholdingCertificateUpdate {
+	_u.mutation.SetVendorName(v)
+	return _u
+}
+
+// SetNillableVendorName sets the "vendor_name" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdate) SetNillableVendorName(v *string) *WithholdingCertificateUpdate {
+	if v != nil {
+		_u.SetVendorName(*v)
+	}
+	return _u
+}
+
+// SetVendorPin sets the "vendor_pin" field.
+func (_u *WithholdingCertificateUpdate) SetVendorPin(v string) *WithholdingCertificateUpdate {
+	_u.mutation.SetVendorPin(v)
+	return _u
+}
+
+// SetNillableVendorPin sets the "vendor_pin" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdate) SetNillableVendorPin(v *string) *WithholdingCertificateUpdate {
+	if v != nil {
+		_u.SetVendorPin(*v)
+	}
+	return _u
+}
+
+// ClearVendorPin clears the value of the "vendor_pin" field.
+func (_u *WithholdingCertificateUpdate) ClearVendorPin() *WithholdingCertificateUpdate {
+	_u.mutation.ClearVendorPin()
+	return _u
+}
+
+// SetBillID sets the "bill_id" field.
+func (_u *WithholdingCertificateUpdate) SetBillID(v uuid.UUID) *WithholdingCertificateUpdate {
+	_u.mutation.SetBillID(v)
+	return _u
+}
+
+// SetNillableBillID sets the "bill_id" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdate) SetNillableBillID(v *uuid.UUID) *WithholdingCertificateUpdate {
+	if v != nil {
+		_u.SetBillID(*v)
+	}
+	return _u
+}
+
+// SetBillNumber sets the "bill_number" field.
+func (_u *WithholdingCertificateUpdate) SetBillNumber(v string) *WithholdingCertificateUpdate {
+	_u.mutation.SetBillNumber(v)
+	return _u
+}
+
+// SetNillableBillNumber sets the "bill_number" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdate) SetNillableBillNumber(v *string) *WithholdingCertificateUpdate {
+	if v != nil {
+		_u.SetBillNumber(*v)
+	}
+	return _u
+}
+
+// SetVendorReference sets the "vendor_reference" field.
+func (_u *WithholdingCertificateUpdate) SetVendorReference(v string) *WithholdingCertificateUpdate {
+	_u.mutation.SetVendorReference(v)
+	return _u
+}
+
+// SetNillableVendorReference sets the "vendor_reference" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdate) SetNillableVendorReference(v *string) *WithholdingCertificateUpdate {
+	if v != nil {
+		_u.SetVendorReference(*v)
+	}
+	return _u
+}
+
+// ClearVendorReference clears the value of the "vendor_reference" field.
+func (_u *WithholdingCertificateUpdate) ClearVendorReference() *WithholdingCertificateUpdate {
+	_u.mutation.ClearVendorReference()
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *WithholdingCertificateUpdate) SetCategory(v string) *WithholdingCertificateUpdate {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdate) SetNillableCategory(v *string) *WithholdingCertificateUpdate {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetCategoryName sets the "category_name" field.
+func (_u *WithholdingCertificateUpdate) SetCategoryName(v string) *WithholdingCertificateUpdate {
+	_u.mutation.SetCategoryName(v)
+	return _u
+}
+
+// SetNillableCategoryName sets the "category_name" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdate) SetNillableCategoryName(v *string) *WithholdingCertificateUpdate {
+	if v != nil {
+		_u.SetCategoryName(*v)
+	}
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *WithholdingCertificateUpdate) SetRate(v decimal.Decimal) *WithholdingCertificateUpdate {
+	_u.mutation.ResetRate()
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdate) SetNillableRate(v *decimal.Decimal) *WithholdingCertificateUpdate {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// AddRate adds value to the "rate" field.
+func (_u *WithholdingCertificateUpdate) AddRate(v decimal.Decimal) *WithholdingCertificateUpdate {
+	_u.mutation.AddRate(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *WithholdingCertificateUpdate) SetCurrency(v string) *WithholdingCertificateUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdate) SetNillableCurrency(v *string) *WithholdingCertificateUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetGrossAmount sets the "gross_amount" field.
+func (_u *WithholdingCertificateUpdate) SetGrossAmount(v decimal.Decimal) *WithholdingCertificateUpdate {
+	_u.mutation.ResetGrossAmount()
+	_u.mutation.SetGrossAmount(v)
+	return _u
+}
+
+// SetNillableGrossAmount sets the "gross_amount" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdate) SetNillableGrossAmount(v *decimal.Decimal) *WithholdingCertificateUpdate {
+	if v != nil {
+		_u.SetGrossAmount(*v)
+	}
+	return _u
+}
+
+// AddGrossAmount adds value to the "gross_amount" field.
+func (_u *WithholdingCertificateUpdate) AddGrossAmount(v decimal.Decimal) *WithholdingCertificateUpdate {
+	_u.mutation.AddGrossAmount(v)
+	return _u
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (_u *WithholdingCertificateUpdate) SetTaxAmount(v decimal.Decimal) *WithholdingCertificateUpdate {
+	_u.mutation.ResetTaxAmount()
+	_u.mutation.SetTaxAmount(v)
+	return _u
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdate) SetNillableTaxAmount(v *decimal.Decimal) *WithholdingCertificateUpdate {
+	if v != nil {
+		_u.SetTaxAmount(*v)
+	}
+	return _u
+}
+
+// AddTaxAmount adds value to the "tax_amount" field.
+func (_u *WithholdingCertificateUpdate) AddTaxAmount(v decimal.Decimal) *WithholdingCertificateUpdate {
+	_u.mutation.AddTaxAmount(v)
+	return _u
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (_u *WithholdingCertificateUpdate) SetPaymentDate(v time.Time) *WithholdingCertificateUpdate {
+	_u.mutation.SetPaymentDate(v)
+	return _u
+}
+
+// SetNillablePaymentDate sets the "payment_date" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdate) SetNillablePaymentDate(v *time.Time) *WithholdingCertificateUpdate {
+	if v != nil {
+		_u.SetPaymentDate(*v)
+	}
+	return _u
+}
+
+// SetPaymentReference sets the "payment_reference" field.
+func (_u *WithholdingCertificateUpdate) SetPaymentReference(v string) *WithholdingCertificateUpdate {
+	_u.mutation.SetPaymentReference(v)
+	return _u
+}
+
+// SetNillablePaymentReference sets the "payment_reference" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdate) SetNillablePaymentReference(v *string) *WithholdingCertificateUpdate {
+	if v != nil {
+		_u.SetPaymentReference(*v)
+	}
+	return _u
+}
+
+// ClearPaymentReference clears the value of the "payment_reference" field.
+func (_u *WithholdingCertificateUpdate) ClearPaymentReference() *WithholdingCertificateUpdate {
+	_u.mutation.ClearPaymentReference()
+	return _u
+}
+
+// Mutation returns the WithholdingCertificateMutation object of the builder.
+func (_u *WithholdingCertificateUpdate) Mutation() *WithholdingCertificateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WithholdingCertificateUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WithholdingCertificateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WithholdingCertificateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WithholdingCertificateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WithholdingCertificateUpdate) check() error {
+	if v, ok := _u.mutation.VendorName(); ok {
+		if err := withholdingcertificate.VendorNameValidator(v); err != nil {
+			return &ValidationError{Name: "vendor_name", err: fmt.Errorf(`ent: validator failed for field "WithholdingCertificate.vendor_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BillNumber(); ok {
+		if err := withholdingcertificate.BillNumberValidator(v); err != nil {
+			return &ValidationError{Name: "bill_number", err: fmt.Errorf(`ent: validator failed for field "WithholdingCertificate.bill_number": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := withholdingcertificate.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "WithholdingCertificate.category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CategoryName(); ok {
+		if err := withholdingcertificate.CategoryNameValidator(v); err != nil {
+			return &ValidationError{Name: "category_name", err: fmt.Errorf(`ent: validator failed for field "WithholdingCertificate.category_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WithholdingCertificateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(withholdingcertificate.Table, withholdingcertificate.Columns, sqlgraph.NewFieldSpec(withholdingcertificate.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(withholdingcertificate.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.VendorID(); ok {
+		_spec.SetField(withholdingcertificate.FieldVendorID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.VendorName(); ok {
+		_spec.SetField(withholdingcertificate.FieldVendorName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VendorPin(); ok {
+		_spec.SetField(withholdingcertificate.FieldVendorPin, field.TypeString, value)
+	}
+	if _u.mutation.VendorPinCleared() {
+		_spec.ClearField(withholdingcertificate.FieldVendorPin, field.TypeString)
+	}
+	if value, ok := _u.mutation.BillID(); ok {
+		_spec.SetField(withholdingcertificate.FieldBillID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.BillNumber(); ok {
+		_spec.SetField(withholdingcertificate.FieldBillNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VendorReference(); ok {
+		_spec.SetField(withholdingcertificate.FieldVendorReference, field.TypeString, value)
+	}
+	if _u.mutation.VendorReferenceCleared() {
+		_spec.ClearField(withholdingcertificate.FieldVendorReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(withholdingcertificate.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CategoryName(); ok {
+		_spec.SetField(withholdingcertificate.FieldCategoryName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(withholdingcertificate.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRate(); ok {
+		_spec.AddField(withholdingcertificate.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(withholdingcertificate.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GrossAmount(); ok {
+		_spec.SetField(withholdingcertificate.FieldGrossAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedGrossAmount(); ok {
+		_spec.AddField(withholdingcertificate.FieldGrossAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TaxAmount(); ok {
+		_spec.SetField(withholdingcertificate.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTaxAmount(); ok {
+		_spec.AddField(withholdingcertificate.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PaymentDate(); ok {
+		_spec.SetField(withholdingcertificate.FieldPaymentDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PaymentReference(); ok {
+		_spec.SetField(withholdingcertificate.FieldPaymentReference, field.TypeString, value)
+	}
+	if _u.mutation.PaymentReferenceCleared() {
+		_spec.ClearField(withholdingcertificate.FieldPaymentReference, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{withholdingcertificate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WithholdingCertificateUpdateOne is the builder for updating a single WithholdingCertificate entity.
+type WithholdingCertificateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WithholdingCertificateMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *WithholdingCertificateUpdateOne) SetTenantID(v uuid.UUID) *WithholdingCertificateUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdateOne) SetNillableTenantID(v *uuid.UUID) *WithholdingCertificateUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_u *WithholdingCertificateUpdateOne) SetVendorID(v uuid.UUID) *WithholdingCertificateUpdateOne {
+	_u.mutation.SetVendorID(v)
+	return _u
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdateOne) SetNillableVendorID(v *uuid.UUID) *WithholdingCertificateUpdateOne {
+	if v != nil {
+		_u.SetVendorID(*v)
+	}
+	return _u
+}
+
+// SetVendorName sets the "vendor_name" field.
+func (_u *WithholdingCertificateUpdateOne) SetVendorName(v string) *WithholdingCertificateUpdateOne {
+	_u.mutation.SetVendorName(v)
+	return _u
+}
+
+// SetNillableVendorName sets the "vendor_name" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdateOne) SetNillableVendorName(v *string) *WithholdingCertificateUpdateOne {
+	if v != nil {
+		_u.SetVendorName(*v)
+	}
+	return _u
+}
+
+// SetVendorPin sets the "vendor_pin" field.
+func (_u *WithholdingCertificateUpdateOne) SetVendorPin(v string) *WithholdingCertificateUpdateOne {
+	_u.mutation.SetVendorPin(v)
+	return _u
+}
+
+// SetNillableVendorPin sets the "vendor_pin" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdateOne) SetNillableVendorPin(v *string) *WithholdingCertificateUpdateOne {
+	if v != nil {
+		_u.SetVendorPin(*v)
+	}
+	return _u
+}
+
+// ClearVendorPin clears the value of the "vendor_pin" field.
+func (_u *WithholdingCertificateUpdateOne) ClearVendorPin() *WithholdingCertificateUpdateOne {
+	_u.mutation.ClearVendorPin()
+	return _u
+}
+
+// SetBillID sets the "bill_id" field.
+func (_u *WithholdingCertificateUpdateOne) SetBillID(v uuid.UUID) *WithholdingCertificateUpdateOne {
+	_u.mutation.SetBillID(v)
+	return _u
+}
+
+// SetNillableBillID sets the "bill_id" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdateOne) SetNillableBillID(v *uuid.UUID) *WithholdingCertificateUpdateOne {
+	if v != nil {
+		_u.SetBillID(*v)
+	}
+	return _u
+}
+
+// SetBillNumber sets the "bill_number" field.
+func (_u *WithholdingCertificateUpdateOne) SetBillNumber(v string) *WithholdingCertificateUpdateOne {
+	_u.mutation.SetBillNumber(v)
+	return _u
+}
+
+// SetNillableBillNumber sets the "bill_number" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdateOne) SetNillableBillNumber(v *string) *WithholdingCertificateUpdateOne {
+	if v != nil {
+		_u.SetBillNumber(*v)
+	}
+	return _u
+}
+
+// SetVendorReference sets the "vendor_reference" field.
+func (_u *WithholdingCertificateUpdateOne) SetVendorReference(v string) *WithholdingCertificateUpdateOne {
+	_u.mutation.SetVendorReference(v)
+	return _u
+}
+
+// SetNillableVendorReference sets the "vendor_reference" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdateOne) SetNillableVendorReference(v *string) *WithholdingCertificateUpdateOne {
+	if v != nil {
+		_u.SetVendorReference(*v)
+	}
+	return _u
+}
+
+// ClearVendorReference clears the value of the "vendor_reference" field.
+func (_u *WithholdingCertificateUpdateOne) ClearVendorReference() *WithholdingCertificateUpdateOne {
+	_u.mutation.ClearVendorReference()
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *WithholdingCertificateUpdateOne) SetCategory(v string) *WithholdingCertificateUpdateOne {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdateOne) SetNillableCategory(v *string) *WithholdingCertificateUpdateOne {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetCategoryName sets the "category_name" field.
+func (_u *WithholdingCertificateUpdateOne) SetCategoryName(v string) *WithholdingCertificateUpdateOne {
+	_u.mutation.SetCategoryName(v)
+	return _u
+}
+
+// SetNillableCategoryName sets the "category_name" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdateOne) SetNillableCategoryName(v *string) *WithholdingCertificateUpdateOne {
+	if v != nil {
+		_u.SetCategoryName(*v)
+	}
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *WithholdingCertificateUpdateOne) SetRate(v decimal.Decimal) *WithholdingCertificateUpdateOne {
+	_u.mutation.ResetRate()
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdateOne) SetNillableRate(v *decimal.Decimal) *WithholdingCertificateUpdateOne {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// AddRate adds value to the "rate" field.
+func (_u *WithholdingCertificateUpdateOne) AddRate(v decimal.Decimal) *WithholdingCertificateUpdateOne {
+	_u.mutation.AddRate(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *WithholdingCertificateUpdateOne) SetCurrency(v string) *WithholdingCertificateUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdateOne) SetNillableCurrency(v *string) *WithholdingCertificateUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetGrossAmount sets the "gross_amount" field.
+func (_u *WithholdingCertificateUpdateOne) SetGrossAmount(v decimal.Decimal) *WithholdingCertificateUpdateOne {
+	_u.mutation.ResetGrossAmount()
+	_u.mutation.SetGrossAmount(v)
+	return _u
+}
+
+// SetNillableGrossAmount sets the "gross_amount" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdateOne) SetNillableGrossAmount(v *decimal.Decimal) *WithholdingCertificateUpdateOne {
+	if v != nil {
+		_u.SetGrossAmount(*v)
+	}
+	return _u
+}
+
+// AddGrossAmount adds value to the "gross_amount" field.
+func (_u *WithholdingCertificateUpdateOne) AddGrossAmount(v decimal.Decimal) *WithholdingCertificateUpdateOne {
+	_u.mutation.AddGrossAmount(v)
+	return _u
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (_u *WithholdingCertificateUpdateOne) SetTaxAmount(v decimal.Decimal) *WithholdingCertificateUpdateOne {
+	_u.mutation.ResetTaxAmount()
+	_u.mutation.SetTaxAmount(v)
+	return _u
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdateOne) SetNillableTaxAmount(v *decimal.Decimal) *WithholdingCertificateUpdateOne {
+	if v != nil {
+		_u.SetTaxAmount(*v)
+	}
+	return _u
+}
+
+// AddTaxAmount adds value to the "tax_amount" field.
+func (_u *WithholdingCertificateUpdateOne) AddTaxAmount(v decimal.Decimal) *WithholdingCertificateUpdateOne {
+	_u.mutation.AddTaxAmount(v)
+	return _u
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (_u *WithholdingCertificateUpdateOne) SetPaymentDate(v time.Time) *WithholdingCertificateUpdateOne {
+	_u.mutation.SetPaymentDate(v)
+	return _u
+}
+
+// SetNillablePaymentDate sets the "payment_date" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdateOne) SetNillablePaymentDate(v *time.Time) *WithholdingCertificateUpdateOne {
+	if v != nil {
+		_u.SetPaymentDate(*v)
+	}
+	return _u
+}
+
+// SetPaymentReference sets the "payment_reference" field.
+func (_u *WithholdingCertificateUpdateOne) SetPaymentReference(v string) *WithholdingCertificateUpdateOne {
+	_u.mutation.SetPaymentReference(v)
+	return _u
+}
+
+// SetNillablePaymentReference sets the "payment_reference" field if the given value is not nil.
+func (_u *WithholdingCertificateUpdateOne) SetNillablePaymentReference(v *string) *WithholdingCertificateUpdateOne {
+	if v != nil {
+		_u.SetPaymentReference(*v)
+	}
+	return _u
+}
+
+// ClearPaymentReference clears the value of the "payment_reference" field.
+func (_u *WithholdingCertificateUpdateOne) ClearPaymentReference() *WithholdingCertificateUpdateOne {
+	_u.mutation.ClearPaymentReference()
+	return _u
+}
+
+// Mutation returns the WithholdingCertificateMutation object of the builder.
+func (_u *WithholdingCertificateUpdateOne) Mutation() *WithholdingCertificateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WithholdingCertificateUpdate builder.
+func (_u *WithholdingCertificateUpdateOne) Where(ps ...predicate.WithholdingCertificate) *WithholdingCertificateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WithholdingCertificateUpdateOne) Select(field string, fields ...string) *WithholdingCertificateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WithholdingCertificate entity.
+func (_u *WithholdingCertificateUpdateOne) Save(ctx context.Context) (*WithholdingCertificate, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WithholdingCertificateUpdateOne) SaveX(ctx context.Context) *WithholdingCertificate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WithholdingCertificateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WithholdingCertificateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WithholdingCertificateUpdateOne) check() error {
+	if v, ok := _u.mutation.VendorName(); ok {
+		if err := withholdingcertificate.VendorNameValidator(v); err != nil {
+			return &ValidationError{Name: "vendor_name", err: fmt.Errorf(`ent: validator failed for field "WithholdingCertificate.vendor_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BillNumber(); ok {
+		if err := withholdingcertificate.BillNumberValidator(v); err != nil {
+			return &ValidationError{Name: "bill_number", err: fmt.Errorf(`ent: validator failed for field "WithholdingCertificate.bill_number": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := withholdingcertificate.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "WithholdingCertificate.category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CategoryName(); ok {
+		if err := withholdingcertificate.CategoryNameValidator(v); err != nil {
+			return &ValidationError{Name: "category_name", err: fmt.Errorf(`ent: validator failed for field "WithholdingCertificate.category_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WithholdingCertificateUpdateOne) sqlSave(ctx context.Context) (_node *WithholdingCertificate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(withholdingcertificate.Table, withholdingcertificate.Columns, sqlgraph.NewFieldSpec(withholdingcertificate.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WithholdingCertificate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, withholdingcertificate.FieldID)
+		for _, f := range fields {
+			if !withholdingcertificate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != withholdingcertificate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(withholdingcertificate.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.VendorID(); ok {
+		_spec.SetField(withholdingcertificate.FieldVendorID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.VendorName(); ok {
+		_spec.SetField(withholdingcertificate.FieldVendorName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VendorPin(); ok {
+		_spec.SetField(withholdingcertificate.FieldVendorPin, field.TypeString, value)
+	}
+	if _u.mutation.VendorPinCleared() {
+		_spec.ClearField(withholdingcertificate.FieldVendorPin, field.TypeString)
+	}
+	if value, ok := _u.mutation.BillID(); ok {
+		_spec.SetField(withholdingcertificate.FieldBillID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.BillNumber(); ok {
+		_spec.SetField(withholdingcertificate.FieldBillNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VendorReference(); ok {
+		_spec.SetField(withholdingcertificate.FieldVendorReference, field.TypeString, value)
+	}
+	if _u.mutation.VendorReferenceCleared() {
+		_spec.ClearField(withholdingcertificate.FieldVendorReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(withholdingcertificate.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CategoryName(); ok {
+		_spec.SetField(withholdingcertificate.FieldCategoryName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(withholdingcertificate.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRate(); ok {
+		_spec.AddField(withholdingcertificate.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(withholdingcertificate.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GrossAmount(); ok {
+		_spec.SetField(withholdingcertificate.FieldGrossAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedGrossAmount(); ok {
+		_spec.AddField(withholdingcertificate.FieldGrossAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TaxAmount(); ok {
+		_spec.SetField(withholdingcertificate.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTaxAmount(); ok {
+		_spec.AddField(withholdingcertificate.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PaymentDate(); ok {
+		_spec.SetField(withholdingcertificate.FieldPaymentDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PaymentReference(); ok {
+		_spec.SetField(withholdingcertificate.FieldPaymentReference, field.TypeString, value)
+	}
+	if _u.mutation.PaymentReferenceCleared() {
+		_spec.ClearField(withholdingcertificate.FieldPaymentReference, field.TypeString)
+	}
+	_node = &WithholdingCertificate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{withholdingcertificate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,904 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/withholdingrate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WithholdingRateCreate is the builder for creating a WithholdingRate entity.
+type WithholdingRateCreate struct {
+	config
+	mutation *WithholdingRateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *WithholdingRateCreate) SetTenantID(v uuid.UUID) *WithholdingRateCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *WithholdingRateCreate) SetCategory(v string) *WithholdingRateCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *WithholdingRateCreate) SetName(v string) *WithholdingRateCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetRate sets the "rate" field.
+func (_c *WithholdingRateCreate) SetRate(v decimal.Decimal) *WithholdingRateCreate {
+	_c.mutation.SetRate(v)
+	return _c
+}
+
+// SetActive sets the "active" field.
+func (_c *WithholdingRateCreate) SetActive(v bool) *WithholdingRateCreate {
+	_c.mutation.SetActive(v)
+	return _c
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_c *WithholdingRateCreate) SetNillableActive(v *bool) *WithholdingRateCreate {
+	if v != nil {
+		_c.SetActive(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *WithholdingRateCreate) SetUpdatedBy(v uuid.UUID) *WithholdingRateCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *WithholdingRateCreate) SetNillableUpdatedBy(v *uuid.UUID) *WithholdingRateCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WithholdingRateCreate) SetCreatedAt(v time.Time) *WithholdingRateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WithholdingRateCreate) SetNillableCreatedAt(v *time.Time) *WithholdingRateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WithholdingRateCreate) SetUpdatedAt(v time.Time) *WithholdingRateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WithholdingRateCreate) SetNillableUpdatedAt(v *time.Time) *WithholdingRateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WithholdingRateCreate) SetID(v uuid.UUID) *WithholdingRateCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WithholdingRateCreate) SetNillableID(v *uuid.UUID) *WithholdingRateCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the WithholdingRateMutation object of the builder.
+func (_c *WithholdingRateCreate) Mutation() *WithholdingRateMutation {
+	return _c.mutation
+}
+
+// Save creates the WithholdingRate in the database.
+func (_c *WithholdingRateCreate) Save(ctx context.Context) (*WithholdingRate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WithholdingRateCreate) SaveX(ctx context.Context) *WithholdingRate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WithholdingRateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WithholdingRateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WithholdingRateCreate) defaults() {
+	if _, ok := _c.mutation.Active(); !ok {
+		v := withholdingrate.DefaultActive
+		_c.mutation.SetActive(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := withholdingrate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := withholdingrate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := withholdingrate.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WithholdingRateCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "WithholdingRate.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "WithholdingRate.category"`)}
+	}
+	if v, ok := _c.mutation.Category(); ok {
+		if err := withholdingrate.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "WithholdingRate.category": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "WithholdingRate.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := withholdingrate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WithholdingRate.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "WithholdingRate.rate"`)}
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "WithholdingRate.active"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WithholdingRate.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WithholdingRate.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *WithholdingRateCreate) sqlSave(ctx context.Context) (*WithholdingRate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WithholdingRateCreate) createSpec() (*WithholdingRate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WithholdingRate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(withholdingrate.Table, sqlgraph.NewFieldSpec(withholdingrate.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(withholdingrate.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(withholdingrate.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(withholdingrate.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Rate(); ok {
+		_spec.SetField(withholdingrate.FieldRate, field.TypeFloat64, value)
+		_node.Rate = value
+	}
+	if value, ok := _c.mutation.Active(); ok {
+		_spec.SetField(withholdingrate.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(withholdingrate.FieldUpdatedBy, field.TypeUUID, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(withholdingrate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(withholdingrate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WithholdingRate.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WithholdingRateUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WithholdingRateCreate) OnConflict(opts ...sql.ConflictOption) *WithholdingRateUpsertOne {
+	_c.conflict = opts
+	return &WithholdingRateUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WithholdingRate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WithholdingRateCreate) OnConflictColumns(columns ...string) *WithholdingRateUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WithholdingRateUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WithholdingRateUpsertOne is the builder for "upsert"-ing
+	//  one WithholdingRate node.
+	WithholdingRateUpsertOne struct {
+		create *WithholdingRateCreate
+	}
+
+	// WithholdingRateUpsert is the "OnConflict" setter.
+	WithholdingRateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WithholdingRateUpsert) SetTenantID(v uuid.UUID) *WithholdingRateUpsert {
+	u.Set(withholdingrate.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WithholdingRateUpsert) UpdateTenantID() *WithholdingRateUpsert {
+	u.SetExcluded(withholdingrate.FieldTenantID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *WithholdingRateUpsert) SetName(v string) *WithholdingRateUpsert {
+	u.Set(withholdingrate.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WithholdingRateUpsert) UpdateName() *WithholdingRateUpsert {
+	u.SetExcluded(withholdingrate.FieldName)
+	return u
+}
+
+// SetRate sets the "rate" field.
+func (u *WithholdingRateUpsert) SetRate(v decimal.Decimal) *WithholdingRateUpsert {
+	u.Set(withholdingrate.FieldRate, v)
+	return u
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *WithholdingRateUpsert) UpdateRate() *WithholdingRateUpsert {
+	u.SetExcluded(withholdingrate.FieldRate)
+	return u
+}
+
+// AddRate adds v to the "rate" field.
+func (u *WithholdingRateUpsert) AddRate(v decimal.Decimal) *WithholdingRateUpsert {
+	u.Add(withholdingrate.FieldRate, v)
+	return u
+}
+
+// SetActive sets the "active" field.
+func (u *WithholdingRateUpsert) SetActive(v bool) *WithholdingRateUpsert {
+	u.Set(withholdingrate.FieldActive, v)
+	return u
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *WithholdingRateUpsert) UpdateActive() *WithholdingRateUpsert {
+	u.SetExcluded(withholdingrate.FieldActive)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *WithholdingRateUpsert) SetUpdatedBy(v uuid.UUID) *WithholdingRateUpsert {
+	u.Set(withholdingrate.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *WithholdingRateUpsert) UpdateUpdatedBy() *WithholdingRateUpsert {
+	u.SetExcluded(withholdingrate.FieldUpdatedBy)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *WithholdingRateUpsert) ClearUpdatedBy() *WithholdingRateUpsert {
+	u.SetNull(withholdingrate.FieldUpdatedBy)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WithholdingRateUpsert) SetUpdatedAt(v time.Time) *WithholdingRateUpsert {
+	u.Set(withholdingrate.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WithholdingRateUpsert) UpdateUpdatedAt() *WithholdingRateUpsert {
+	u.SetExcluded(withholdingrate.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WithholdingRate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(withholdingrate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WithholdingRateUpsertOne) UpdateNewValues() *WithholdingRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(withholdingrate.FieldID)
+		}
+		if _, exists := u.create.mutation.Category(); exists {
+			s.SetIgnore(withholdingrate.FieldCategory)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(withholdingrate.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WithholdingRate.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WithholdingRateUpsertOne) Ignore() *WithholdingRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WithholdingRateUpsertOne) DoNothing() *WithholdingRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WithholdingRateCreate.OnConflict
+// documentation for more info.
+func (u *WithholdingRateUpsertOne) Update(set func(*WithholdingRateUpsert)) *WithholdingRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WithholdingRateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WithholdingRateUpsertOne) SetTenantID(v uuid.UUID) *WithholdingRateUpsertOne {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WithholdingRateUpsertOne) UpdateTenantID() *WithholdingRateUpsertOne {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *WithholdingRateUpsertOne) SetName(v string) *WithholdingRateUpsertOne {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WithholdingRateUpsertOne) UpdateName() *WithholdingRateUpsertOne {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetRate sets the "rate" field.
+func (u *WithholdingRateUpsertOne) SetRate(v decimal.Decimal) *WithholdingRateUpsertOne {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.SetRate(v)
+	})
+}
+
+// AddRate adds v to the "rate" field.
+func (u *WithholdingRateUpsertOne) AddRate(v decimal.Decimal) *WithholdingRateUpsertOne {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.AddRate(v)
+	})
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *WithholdingRateUpsertOne) UpdateRate() *WithholdingRateUpsertOne {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.UpdateRate()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *WithholdingRateUpsertOne) SetActive(v bool) *WithholdingRateUpsertOne {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *WithholdingRateUpsertOne) UpdateActive() *WithholdingRateUpsertOne {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *WithholdingRateUpsertOne) SetUpdatedBy(v uuid.UUID) *WithholdingRateUpsertOne {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *WithholdingRateUpsertOne) UpdateUpdatedBy() *WithholdingRateUpsertOne {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *WithholdingRateUpsertOne) ClearUpdatedBy() *WithholdingRateUpsertOne {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WithholdingRateUpsertOne) SetUpdatedAt(v time.Time) *WithholdingRateUpsertOne {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WithholdingRateUpsertOne) UpdateUpdatedAt() *WithholdingRateUpsertOne {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WithholdingRateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WithholdingRateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WithholdingRateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WithholdingRateUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WithholdingRateUpsertOne.ID is not supported by MySQL driver. Use WithholdingRateUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WithholdingRateUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WithholdingRateCreateBulk is the builder for creating many WithholdingRate entities in bulk.
+type WithholdingRateCreateBulk struct {
+	config
+	err      error
+	builders []*WithholdingRateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WithholdingRate entities in the database.
+func (_c *WithholdingRateCreateBulk) Save(ctx context.Context) ([]*WithholdingRate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WithholdingRate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WithholdingRateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WithholdingRateCreateBulk) SaveX(ctx context.Context) []*WithholdingRate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WithholdingRateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WithholdingRateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WithholdingRate.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WithholdingRateUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WithholdingRateCreateBulk) OnConflict(opts ...sql.ConflictOption) *WithholdingRateUpsertBulk {
+	_c.conflict = opts
+	return &WithholdingRateUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WithholdingRate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WithholdingRateCreateBulk) OnConflictColumns(columns ...string) *WithholdingRateUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WithholdingRateUpsertBulk{
+		create: _c,
+	}
+}
+
+// WithholdingRateUpsertBulk is the builder for "upsert"-ing
+// a bulk of WithholdingRate nodes.
+type WithholdingRateUpsertBulk struct {
+	create *WithholdingRateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WithholdingRate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(withholdingrate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WithholdingRateUpsertBulk) UpdateNewValues() *WithholdingRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(withholdingrate.FieldID)
+			}
+			if _, exists := b.mutation.Category(); exists {
+				s.SetIgnore(withholdingrate.FieldCategory)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(withholdingrate.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WithholdingRate.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WithholdingRateUpsertBulk) Ignore() *WithholdingRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WithholdingRateUpsertBulk) DoNothing() *WithholdingRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WithholdingRateCreateBulk.OnConflict
+// documentation for more info.
+func (u *WithholdingRateUpsertBulk) Update(set func(*WithholdingRateUpsert)) *WithholdingRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WithholdingRateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WithholdingRateUpsertBulk) SetTenantID(v uuid.UUID) *WithholdingRateUpsertBulk {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WithholdingRateUpsertBulk) UpdateTenantID() *WithholdingRateUpsertBulk {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *WithholdingRateUpsertBulk) SetName(v string) *WithholdingRateUpsertBulk {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WithholdingRateUpsertBulk) UpdateName() *WithholdingRateUpsertBulk {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetRate sets the "rate" field.
+func (u *WithholdingRateUpsertBulk) SetRate(v decimal.Decimal) *WithholdingRateUpsertBulk {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.SetRate(v)
+	})
+}
+
+// AddRate adds v to the "rate" field.
+func (u *WithholdingRateUpsertBulk) AddRate(v decimal.Decimal) *WithholdingRateUpsertBulk {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.AddRate(v)
+	})
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *WithholdingRateUpsertBulk) UpdateRate() *WithholdingRateUpsertBulk {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.UpdateRate()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *WithholdingRateUpsertBulk) SetActive(v bool) *WithholdingRateUpsertBulk {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *WithholdingRateUpsertBulk) UpdateActive() *WithholdingRateUpsertBulk {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *WithholdingRateUpsertBulk) SetUpdatedBy(v uuid.UUID) *WithholdingRateUpsertBulk {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *WithholdingRateUpsertBulk) UpdateUpdatedBy() *WithholdingRateUpsertBulk {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *WithholdingRateUpsertBulk) ClearUpdatedBy() *WithholdingRateUpsertBulk {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WithholdingRateUpsertBulk) SetUpdatedAt(v time.Time) *WithholdingRateUpsertBulk {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WithholdingRateUpsertBulk) UpdateUpdatedAt() *WithholdingRateUpsertBulk {
+	return u.Update(func(s *WithholdingRateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WithholdingRateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WithholdingRateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WithholdingRateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WithholdingRateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
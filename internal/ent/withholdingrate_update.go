@@ -0,0 +1,448 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/withholdingrate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WithholdingRateUpdate is the builder for updating WithholdingRate entities.
+type WithholdingRateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WithholdingRateMutation
+}
+
+// Where appends a list predicates to the WithholdingRateUpdate builder.
+func (_u *WithholdingRateUpdate) Where(ps ...predicate.WithholdingRate) *WithholdingRateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *WithholdingRateUpdate) SetTenantID(v uuid.UUID) *WithholdingRateUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *WithholdingRateUpdate) SetNillableTenantID(v *uuid.UUID) *WithholdingRateUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *WithholdingRateUpdate) SetName(v string) *WithholdingRateUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *WithholdingRateUpdate) SetNillableName(v *string) *WithholdingRateUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *WithholdingRateUpdate) SetRate(v decimal.Decimal) *WithholdingRateUpdate {
+	_u.mutation.ResetRate()
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *WithholdingRateUpdate) SetNillableRate(v *decimal.Decimal) *WithholdingRateUpdate {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// AddRate adds value to the "rate" field.
+func (_u *WithholdingRateUpdate) AddRate(v decimal.Decimal) *WithholdingRateUpdate {
+	_u.mutation.AddRate(v)
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *WithholdingRateUpdate) SetActive(v bool) *WithholdingRateUpdate {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *WithholdingRateUpdate) SetNillableActive(v *bool) *WithholdingRateUpdate {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *WithholdingRateUpdate) SetUpdatedBy(v uuid.UUID) *WithholdingRateUpdate {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *WithholdingRateUpdate) SetNillableUpdatedBy(v *uuid.UUID) *WithholdingRateUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *WithholdingRateUpdate) ClearUpdatedBy() *WithholdingRateUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WithholdingRateUpdate) SetUpdatedAt(v time.Time) *WithholdingRateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the WithholdingRateMutation object of the builder.
+func (_u *WithholdingRateUpdate) Mutation() *WithholdingRateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WithholdingRateUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WithholdingRateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WithholdingRateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WithholdingRateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WithholdingRateUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := withholdingrate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WithholdingRateUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := withholdingrate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WithholdingRate.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WithholdingRateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(withholdingrate.Table, withholdingrate.Columns, sqlgraph.NewFieldSpec(withholdingrate.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(withholdingrate.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(withholdingrate.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(withholdingrate.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRate(); ok {
+		_spec.AddField(withholdingrate.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(withholdingrate.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(withholdingrate.FieldUpdatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(withholdingrate.FieldUpdatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(withholdingrate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{withholdingrate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WithholdingRateUpdateOne is the builder for updating a single WithholdingRate entity.
+type WithholdingRateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WithholdingRateMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *WithholdingRateUpdateOne) SetTenantID(v uuid.UUID) *WithholdingRateUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *WithholdingRateUpdateOne) SetNillableTenantID(v *uuid.UUID) *WithholdingRateUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *WithholdingRateUpdateOne) SetName(v string) *WithholdingRateUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *WithholdingRateUpdateOne) SetNillableName(v *string) *WithholdingRateUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *WithholdingRateUpdateOne) SetRate(v decimal.Decimal) *WithholdingRateUpdateOne {
+	_u.mutation.ResetRate()
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *WithholdingRateUpdateOne) SetNillableRate(v *decimal.Decimal) *WithholdingRateUpdateOne {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// AddRate adds value to the "rate" field.
+func (_u *WithholdingRateUpdateOne) AddRate(v decimal.Decimal) *WithholdingRateUpdateOne {
+	_u.mutation.AddRate(v)
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *WithholdingRateUpdateOne) SetActive(v bool) *WithholdingRateUpdateOne {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *WithholdingRateUpdateOne) SetNillableActive(v *bool) *WithholdingRateUpdateOne {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *WithholdingRateUpdateOne) SetUpdatedBy(v uuid.UUID) *WithholdingRateUpdateOne {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *WithholdingRateUpdateOne) SetNillableUpdatedBy(v *uuid.UUID) *WithholdingRateUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *WithholdingRateUpdateOne) ClearUpdatedBy() *WithholdingRateUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WithholdingRateUpdateOne) SetUpdatedAt(v time.Time) *WithholdingRateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the WithholdingRateMutation object of the builder.
+func (_u *WithholdingRateUpdateOne) Mutation() *WithholdingRateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WithholdingRateUpdate builder.
+func (_u *WithholdingRateUpdateOne) Where(ps ...predicate.WithholdingRate) *WithholdingRateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WithholdingRateUpdateOne) Select(field string, fields ...string) *WithholdingRateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WithholdingRate entity.
+func (_u *WithholdingRateUpdateOne) Save(ctx context.Context) (*WithholdingRate, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WithholdingRateUpdateOne) SaveX(ctx context.Context) *WithholdingRate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WithholdingRateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WithholdingRateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WithholdingRateUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := withholdingrate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WithholdingRateUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := withholdingrate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WithholdingRate.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WithholdingRateUpdateOne) sqlSave(ctx context.Context) (_node *WithholdingRate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(withholdingrate.Table, withholdingrate.Columns, sqlgraph.NewFieldSpec(withholdingrate.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WithholdingRate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, withholdingrate.FieldID)
+		for _, f := range fields {
+			if !withholdingrate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != withholdingrate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(withholdingrate.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(withholdingrate.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(withholdingrate.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRate(); ok {
+		_spec.AddField(withholdingrate.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(withholdingrate.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(withholdingrate.FieldUpdatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(withholdingrate.FieldUpdatedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(withholdingrate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &WithholdingRate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{withholdingrate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
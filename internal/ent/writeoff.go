@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/writeoff"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WriteOff is the model entity for the WriteOff schema.
+type WriteOff struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Invoice being written off
+	InvoiceID uuid.UUID `json:"invoice_id,omitempty"`
+	// Customer identifier copied from the invoice
+	CustomerID uuid.UUID `json:"customer_id,omitempty"`
+	// Amount written off
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Amount recovered after the write-off (defaults to zero)
+	RecoveredAmount decimal.Decimal `json:"recovered_amount,omitempty"`
+	// ISO currency code
+	Currency string `json:"currency,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Status: pending, approved, rejected
+	Status string `json:"status,omitempty"`
+	// RequestedBy holds the value of the "requested_by" field.
+	RequestedBy uuid.UUID `json:"requested_by,omitempty"`
+	// User who approved or rejected the write-off
+	DecidedBy uuid.UUID `json:"decided_by,omitempty"`
+	// DecidedAt holds the value of the "decided_at" field.
+	DecidedAt time.Time `json:"decided_at,omitempty"`
+	// DecisionNote holds the value of the "decision_note" field.
+	DecisionNote string `json:"decision_note,omitempty"`
+	// Write-off journal, set on approval
+	JournalEntryID uuid.UUID `json:"journal_entry_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WriteOff) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case writeoff.FieldAmount, writeoff.FieldRecoveredAmount:
+			values[i] = new(decimal.Decimal)
+		case writeoff.FieldCurrency, writeoff.FieldReason, writeoff.FieldStatus, writeoff.FieldDecisionNote:
+			values[i] = new(sql.NullString)
+		case writeoff.FieldDecidedAt, writeoff.FieldCreatedAt, writeoff.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case writeoff.FieldID, writeoff.FieldTenantID, writeoff.FieldInvoiceID, writeoff.FieldCustomerID, writeoff.FieldRequestedBy, writeoff.FieldDecidedBy, writeoff.FieldJournalEntryID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WriteOff fields.
+func (_m *WriteOff) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case writeoff.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case writeoff.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case writeoff.FieldInvoiceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value != nil {
+				_m.InvoiceID = *value
+			}
+		case writeoff.FieldCustomerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value != nil {
+				_m.CustomerID = *value
+			}
+		case writeoff.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case writeoff.FieldRecoveredAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field recovered_amount", values[i])
+			} else if value != nil {
+				_m.RecoveredAmount = *value
+			}
+		case writeoff.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case writeoff.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case writeoff.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case writeoff.FieldRequestedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_by", values[i])
+			} else if value != nil {
+				_m.RequestedBy = *value
+			}
+		case writeoff.FieldDecidedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field decided_by", values[i])
+			} else if value != nil {
+				_m.DecidedBy = *value
+			}
+		case writeoff.FieldDecidedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field decided_at", values[i])
+			} else if value.Valid {
+				_m.DecidedAt = value.Time
+			}
+		case writeoff.FieldDecisionNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field decision_note", values[i])
+			} else if value.Valid {
+				_m.DecisionNote = value.String
+			}
+		case writeoff.FieldJournalEntryID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field journal_entry_id", values[i])
+			} else if value != nil {
+				_m.JournalEntryID = *value
+			}
+		case writeoff.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case writeoff.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WriteOff.
+// This includes values selected through modifiers, order, etc.
+func (_m *WriteOff) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WriteOff.
+// Note that you need to call WriteOff.Unwrap() before calling this method if this WriteOff
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WriteOff) Update() *WriteOffUpdateOne {
+	return NewWriteOffClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WriteOff entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WriteOff) Unwrap() *WriteOff {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WriteOff is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WriteOff) String() string {
+	var builder strings.Builder
+	builder.WriteString("WriteOff(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("invoice_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InvoiceID))
+	builder.WriteString(", ")
+	builder.WriteString("customer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CustomerID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("recovered_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RecoveredAmount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("requested_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequestedBy))
+	builder.WriteString(", ")
+	builder.WriteString("decided_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DecidedBy))
+	builder.WriteString(", ")
+	builder.WriteString("decided_at=")
+	builder.WriteString(_m.DecidedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("decision_note=")
+	builder.WriteString(_m.DecisionNote)
+	builder.WriteString(", ")
+	builder.WriteString("journal_entry_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JournalEntryID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WriteOffs is a parsable slice of WriteOff.
+type WriteOffs []*WriteOff
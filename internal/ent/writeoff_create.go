@@ -0,0 +1,1474 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/writeoff"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WriteOffCreate is the builder for creating a WriteOff entity.
+type WriteOffCreate struct {
+	config
+	mutation *WriteOffMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *WriteOffCreate) SetTenantID(v uuid.UUID) *WriteOffCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_c *WriteOffCreate) SetInvoiceID(v uuid.UUID) *WriteOffCreate {
+	_c.mutation.SetInvoiceID(v)
+	return _c
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_c *WriteOffCreate) SetCustomerID(v uuid.UUID) *WriteOffCreate {
+	_c.mutation.SetCustomerID(v)
+	return _c
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_c *WriteOffCreate) SetNillableCustomerID(v *uuid.UUID) *WriteOffCreate {
+	if v != nil {
+		_c.SetCustomerID(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *WriteOffCreate) SetAmount(v decimal.Decimal) *WriteOffCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetRecoveredAmount sets the "recovered_amount" field.
+func (_c *WriteOffCreate) SetRecoveredAmount(v decimal.Decimal) *WriteOffCreate {
+	_c.mutation.SetRecoveredAmount(v)
+	return _c
+}
+
+// SetNillableRecoveredAmount sets the "recovered_amount" field if the given value is not nil.
+func (_c *WriteOffCreate) SetNillableRecoveredAmount(v *decimal.Decimal) *WriteOffCreate {
+	if v != nil {
+		_c.SetRecoveredAmount(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *WriteOffCreate) SetCurrency(v string) *WriteOffCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *WriteOffCreate) SetReason(v string) *WriteOffCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *WriteOffCreate) SetStatus(v string) *WriteOffCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *WriteOffCreate) SetNillableStatus(v *string) *WriteOffCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (_c *WriteOffCreate) SetRequestedBy(v uuid.UUID) *WriteOffCreate {
+	_c.mutation.SetRequestedBy(v)
+	return _c
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (_c *WriteOffCreate) SetNillableRequestedBy(v *uuid.UUID) *WriteOffCreate {
+	if v != nil {
+		_c.SetRequestedBy(*v)
+	}
+	return _c
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (_c *WriteOffCreate) SetDecidedBy(v uuid.UUID) *WriteOffCreate {
+	_c.mutation.SetDecidedBy(v)
+	return _c
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (_c *WriteOffCreate) SetNillableDecidedBy(v *uuid.UUID) *WriteOffCreate {
+	if v != nil {
+		_c.SetDecidedBy(*v)
+	}
+	return _c
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (_c *WriteOffCreate) SetDecidedAt(v time.Time) *WriteOffCreate {
+	_c.mutation.SetDecidedAt(v)
+	return _c
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (_c *WriteOffCreate) SetNillableDecidedAt(v *time.Time) *WriteOffCreate {
+	if v != nil {
+		_c.SetDecidedAt(*v)
+	}
+	return _c
+}
+
+// SetDecisionNote sets the "decision_note" field.
+func (_c *WriteOffCreate) SetDecisionNote(v string) *WriteOffCreate {
+	_c.mutation.SetDecisionNote(v)
+	return _c
+}
+
+// SetNillableDecisionNote sets the "decision_note" field if the given value is not nil.
+func (_c *WriteOffCreate) SetNillableDecisionNote(v *string) *WriteOffCreate {
+	if v != nil {
+		_c.SetDecisionNote(*v)
+	}
+	return _c
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_c *WriteOffCreate) SetJournalEntryID(v uuid.UUID) *WriteOffCreate {
+	_c.mutation.SetJournalEntryID(v)
+	return _c
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_c *WriteOffCreate) SetNillableJournalEntryID(v *uuid.UUID) *WriteOffCreate {
+	if v != nil {
+		_c.SetJournalEntryID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WriteOffCreate) SetCreatedAt(v time.Time) *WriteOffCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WriteOffCreate) SetNillableCreatedAt(v *time.Time) *WriteOffCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WriteOffCreate) SetUpdatedAt(v time.Time) *WriteOffCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WriteOffCreate) SetNillableUpdatedAt(v *time.Time) *WriteOffCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WriteOffCreate) SetID(v uuid.UUID) *WriteOffCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WriteOffCreate) SetNillableID(v *uuid.UUID) *WriteOffCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the WriteOffMutation object of the builder.
+func (_c *WriteOffCreate) Mutation() *WriteOffMutation {
+	return _c.mutation
+}
+
+// Save creates the WriteOff in the database.
+func (_c *WriteOffCreate) Save(ctx context.Context) (*WriteOff, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WriteOffCreate) SaveX(ctx context.Context) *WriteOff {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WriteOffCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WriteOffCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WriteOffCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := writeoff.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := writeoff.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := writeoff.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := writeoff.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WriteOffCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "WriteOff.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.InvoiceID(); !ok {
+		return &ValidationError{Name: "invoice_id", err: errors.New(`ent: missing required field "WriteOff.invoice_id"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "WriteOff.amount"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "WriteOff.currency"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "WriteOff.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := writeoff.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "WriteOff.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WriteOff.status"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WriteOff.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WriteOff.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *WriteOffCreate) sqlSave(ctx context.Context) (*WriteOff, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WriteOffCreate) createSpec() (*WriteOff, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WriteOff{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(writeoff.Table, sqlgraph.NewFieldSpec(writeoff.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(writeoff.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.InvoiceID(); ok {
+		_spec.SetField(writeoff.FieldInvoiceID, field.TypeUUID, value)
+		_node.InvoiceID = value
+	}
+	if value, ok := _c.mutation.CustomerID(); ok {
+		_spec.SetField(writeoff.FieldCustomerID, field.TypeUUID, value)
+		_node.CustomerID = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(writeoff.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.RecoveredAmount(); ok {
+		_spec.SetField(writeoff.FieldRecoveredAmount, field.TypeFloat64, value)
+		_node.RecoveredAmount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(writeoff.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(writeoff.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(writeoff.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.RequestedBy(); ok {
+		_spec.SetField(writeoff.FieldRequestedBy, field.TypeUUID, value)
+		_node.RequestedBy = value
+	}
+	if value, ok := _c.mutation.DecidedBy(); ok {
+		_spec.SetField(writeoff.FieldDecidedBy, field.TypeUUID, value)
+		_node.DecidedBy = value
+	}
+	if value, ok := _c.mutation.DecidedAt(); ok {
+		_spec.SetField(writeoff.FieldDecidedAt, field.TypeTime, value)
+		_node.DecidedAt = value
+	}
+	if value, ok := _c.mutation.DecisionNote(); ok {
+		_spec.SetField(writeoff.FieldDecisionNote, field.TypeString, value)
+		_node.DecisionNote = value
+	}
+	if value, ok := _c.mutation.JournalEntryID(); ok {
+		_spec.SetField(writeoff.FieldJournalEntryID, field.TypeUUID, value)
+		_node.JournalEntryID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(writeoff.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(writeoff.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WriteOff.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WriteOffUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WriteOffCreate) OnConflict(opts ...sql.ConflictOption) *WriteOffUpsertOne {
+	_c.conflict = opts
+	return &WriteOffUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WriteOff.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WriteOffCreate) OnConflictColumns(columns ...string) *WriteOffUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WriteOffUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WriteOffUpsertOne is the builder for "upsert"-ing
+	//  one WriteOff node.
+	WriteOffUpsertOne struct {
+		create *WriteOffCreate
+	}
+
+	// WriteOffUpsert is the "OnConflict" setter.
+	WriteOffUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WriteOffUpsert) SetTenantID(v uuid.UUID) *WriteOffUpsert {
+	u.Set(writeoff.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WriteOffUpsert) UpdateTenantID() *WriteOffUpsert {
+	u.SetExcluded(writeoff.FieldTenantID)
+	return u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *WriteOffUpsert) SetInvoiceID(v uuid.UUID) *WriteOffUpsert {
+	u.Set(writeoff.FieldInvoiceID, v)
+	return u
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *WriteOffUpsert) UpdateInvoiceID() *WriteOffUpsert {
+	u.SetExcluded(writeoff.FieldInvoiceID)
+	return u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *WriteOffUpsert) SetCustomerID(v uuid.UUID) *WriteOffUpsert {
+	u.Set(writeoff.FieldCustomerID, v)
+	return u
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *WriteOffUpsert) UpdateCustomerID() *WriteOffUpsert {
+	u.SetExcluded(writeoff.FieldCustomerID)
+	return u
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (u *WriteOffUpsert) ClearCustomerID() *WriteOffUpsert {
+	u.SetNull(writeoff.FieldCustomerID)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *WriteOffUpsert) SetAmount(v decimal.Decimal) *WriteOffUpsert {
+	u.Set(writeoff.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *WriteOffUpsert) UpdateAmount() *WriteOffUpsert {
+	u.SetExcluded(writeoff.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *WriteOffUpsert) AddAmount(v decimal.Decimal) *WriteOffUpsert {
+	u.Add(writeoff.FieldAmount, v)
+	return u
+}
+
+// SetRecoveredAmount sets the "recovered_amount" field.
+func (u *WriteOffUpsert) SetRecoveredAmount(v decimal.Decimal) *WriteOffUpsert {
+	u.Set(writeoff.FieldRecoveredAmount, v)
+	return u
+}
+
+// UpdateRecoveredAmount sets the "recovered_amount" field to the value that was provided on create.
+func (u *WriteOffUpsert) UpdateRecoveredAmount() *WriteOffUpsert {
+	u.SetExcluded(writeoff.FieldRecoveredAmount)
+	return u
+}
+
+// AddRecoveredAmount adds v to the "recovered_amount" field.
+func (u *WriteOffUpsert) AddRecoveredAmount(v decimal.Decimal) *WriteOffUpsert {
+	u.Add(writeoff.FieldRecoveredAmount, v)
+	return u
+}
+
+// ClearRecoveredAmount clears the value of the "recovered_amount" field.
+func (u *WriteOffUpsert) ClearRecoveredAmount() *WriteOffUpsert {
+	u.SetNull(writeoff.FieldRecoveredAmount)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *WriteOffUpsert) SetCurrency(v string) *WriteOffUpsert {
+	u.Set(writeoff.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *WriteOffUpsert) UpdateCurrency() *WriteOffUpsert {
+	u.SetExcluded(writeoff.FieldCurrency)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *WriteOffUpsert) SetReason(v string) *WriteOffUpsert {
+	u.Set(writeoff.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *WriteOffUpsert) UpdateReason() *WriteOffUpsert {
+	u.SetExcluded(writeoff.FieldReason)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *WriteOffUpsert) SetStatus(v string) *WriteOffUpsert {
+	u.Set(writeoff.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WriteOffUpsert) UpdateStatus() *WriteOffUpsert {
+	u.SetExcluded(writeoff.FieldStatus)
+	return u
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (u *WriteOffUpsert) SetRequestedBy(v uuid.UUID) *WriteOffUpsert {
+	u.Set(writeoff.FieldRequestedBy, v)
+	return u
+}
+
+// UpdateRequestedBy sets the "requested_by" field to the value that was provided on create.
+func (u *WriteOffUpsert) UpdateRequestedBy() *WriteOffUpsert {
+	u.SetExcluded(writeoff.FieldRequestedBy)
+	return u
+}
+
+// ClearRequestedBy clears the value of the "requested_by" field.
+func (u *WriteOffUpsert) ClearRequestedBy() *WriteOffUpsert {
+	u.SetNull(writeoff.FieldRequestedBy)
+	return u
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (u *WriteOffUpsert) SetDecidedBy(v uuid.UUID) *WriteOffUpsert {
+	u.Set(writeoff.FieldDecidedBy, v)
+	return u
+}
+
+// UpdateDecidedBy sets the "decided_by" field to the value that was provided on create.
+func (u *WriteOffUpsert) UpdateDecidedBy() *WriteOffUpsert {
+	u.SetExcluded(writeoff.FieldDecidedBy)
+	return u
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (u *WriteOffUpsert) ClearDecidedBy() *WriteOffUpsert {
+	u.SetNull(writeoff.FieldDecidedBy)
+	return u
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (u *WriteOffUpsert) SetDecidedAt(v time.Time) *WriteOffUpsert {
+	u.Set(writeoff.FieldDecidedAt, v)
+	return u
+}
+
+// UpdateDecidedAt sets the "decided_at" field to the value that was provided on create.
+func (u *WriteOffUpsert) UpdateDecidedAt() *WriteOffUpsert {
+	u.SetExcluded(writeoff.FieldDecidedAt)
+	return u
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (u *WriteOffUpsert) ClearDecidedAt() *WriteOffUpsert {
+	u.SetNull(writeoff.FieldDecidedAt)
+	return u
+}
+
+// SetDecisionNote sets the "decision_note" field.
+func (u *WriteOffUpsert) SetDecisionNote(v string) *WriteOffUpsert {
+	u.Set(writeoff.FieldDecisionNote, v)
+	return u
+}
+
+// UpdateDecisionNote sets the "decision_note" field to the value that was provided on create.
+func (u *WriteOffUpsert) UpdateDecisionNote() *WriteOffUpsert {
+	u.SetExcluded(writeoff.FieldDecisionNote)
+	return u
+}
+
+// ClearDecisionNote clears the value of the "decision_note" field.
+func (u *WriteOffUpsert) ClearDecisionNote() *WriteOffUpsert {
+	u.SetNull(writeoff.FieldDecisionNote)
+	return u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *WriteOffUpsert) SetJournalEntryID(v uuid.UUID) *WriteOffUpsert {
+	u.Set(writeoff.FieldJournalEntryID, v)
+	return u
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *WriteOffUpsert) UpdateJournalEntryID() *WriteOffUpsert {
+	u.SetExcluded(writeoff.FieldJournalEntryID)
+	return u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *WriteOffUpsert) ClearJournalEntryID() *WriteOffUpsert {
+	u.SetNull(writeoff.FieldJournalEntryID)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WriteOffUpsert) SetUpdatedAt(v time.Time) *WriteOffUpsert {
+	u.Set(writeoff.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WriteOffUpsert) UpdateUpdatedAt() *WriteOffUpsert {
+	u.SetExcluded(writeoff.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WriteOff.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(writeoff.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WriteOffUpsertOne) UpdateNewValues() *WriteOffUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(writeoff.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(writeoff.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WriteOff.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WriteOffUpsertOne) Ignore() *WriteOffUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WriteOffUpsertOne) DoNothing() *WriteOffUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WriteOffCreate.OnConflict
+// documentation for more info.
+func (u *WriteOffUpsertOne) Update(set func(*WriteOffUpsert)) *WriteOffUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WriteOffUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WriteOffUpsertOne) SetTenantID(v uuid.UUID) *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WriteOffUpsertOne) UpdateTenantID() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *WriteOffUpsertOne) SetInvoiceID(v uuid.UUID) *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetInvoiceID(v)
+	})
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *WriteOffUpsertOne) UpdateInvoiceID() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateInvoiceID()
+	})
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *WriteOffUpsertOne) SetCustomerID(v uuid.UUID) *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetCustomerID(v)
+	})
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *WriteOffUpsertOne) UpdateCustomerID() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateCustomerID()
+	})
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (u *WriteOffUpsertOne) ClearCustomerID() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.ClearCustomerID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *WriteOffUpsertOne) SetAmount(v decimal.Decimal) *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *WriteOffUpsertOne) AddAmount(v decimal.Decimal) *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *WriteOffUpsertOne) UpdateAmount() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetRecoveredAmount sets the "recovered_amount" field.
+func (u *WriteOffUpsertOne) SetRecoveredAmount(v decimal.Decimal) *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetRecoveredAmount(v)
+	})
+}
+
+// AddRecoveredAmount adds v to the "recovered_amount" field.
+func (u *WriteOffUpsertOne) AddRecoveredAmount(v decimal.Decimal) *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.AddRecoveredAmount(v)
+	})
+}
+
+// UpdateRecoveredAmount sets the "recovered_amount" field to the value that was provided on create.
+func (u *WriteOffUpsertOne) UpdateRecoveredAmount() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateRecoveredAmount()
+	})
+}
+
+// ClearRecoveredAmount clears the value of the "recovered_amount" field.
+func (u *WriteOffUpsertOne) ClearRecoveredAmount() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.ClearRecoveredAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *WriteOffUpsertOne) SetCurrency(v string) *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *WriteOffUpsertOne) UpdateCurrency() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *WriteOffUpsertOne) SetReason(v string) *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *WriteOffUpsertOne) UpdateReason() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WriteOffUpsertOne) SetStatus(v string) *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WriteOffUpsertOne) UpdateStatus() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (u *WriteOffUpsertOne) SetRequestedBy(v uuid.UUID) *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetRequestedBy(v)
+	})
+}
+
+// UpdateRequestedBy sets the "requested_by" field to the value that was provided on create.
+func (u *WriteOffUpsertOne) UpdateRequestedBy() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateRequestedBy()
+	})
+}
+
+// ClearRequestedBy clears the value of the "requested_by" field.
+func (u *WriteOffUpsertOne) ClearRequestedBy() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.ClearRequestedBy()
+	})
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (u *WriteOffUpsertOne) SetDecidedBy(v uuid.UUID) *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetDecidedBy(v)
+	})
+}
+
+// UpdateDecidedBy sets the "decided_by" field to the value that was provided on create.
+func (u *WriteOffUpsertOne) UpdateDecidedBy() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateDecidedBy()
+	})
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (u *WriteOffUpsertOne) ClearDecidedBy() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.ClearDecidedBy()
+	})
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (u *WriteOffUpsertOne) SetDecidedAt(v time.Time) *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetDecidedAt(v)
+	})
+}
+
+// UpdateDecidedAt sets the "decided_at" field to the value that was provided on create.
+func (u *WriteOffUpsertOne) UpdateDecidedAt() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateDecidedAt()
+	})
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (u *WriteOffUpsertOne) ClearDecidedAt() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.ClearDecidedAt()
+	})
+}
+
+// SetDecisionNote sets the "decision_note" field.
+func (u *WriteOffUpsertOne) SetDecisionNote(v string) *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetDecisionNote(v)
+	})
+}
+
+// UpdateDecisionNote sets the "decision_note" field to the value that was provided on create.
+func (u *WriteOffUpsertOne) UpdateDecisionNote() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateDecisionNote()
+	})
+}
+
+// ClearDecisionNote clears the value of the "decision_note" field.
+func (u *WriteOffUpsertOne) ClearDecisionNote() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.ClearDecisionNote()
+	})
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *WriteOffUpsertOne) SetJournalEntryID(v uuid.UUID) *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetJournalEntryID(v)
+	})
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *WriteOffUpsertOne) UpdateJournalEntryID() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateJournalEntryID()
+	})
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *WriteOffUpsertOne) ClearJournalEntryID() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.ClearJournalEntryID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WriteOffUpsertOne) SetUpdatedAt(v time.Time) *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WriteOffUpsertOne) UpdateUpdatedAt() *WriteOffUpsertOne {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WriteOffUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WriteOffCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WriteOffUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WriteOffUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WriteOffUpsertOne.ID is not supported by MySQL driver. Use WriteOffUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WriteOffUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WriteOffCreateBulk is the builder for creating many WriteOff entities in bulk.
+type WriteOffCreateBulk struct {
+	config
+	err      error
+	builders []*WriteOffCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WriteOff entities in the database.
+func (_c *WriteOffCreateBulk) Save(ctx context.Context) ([]*WriteOff, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WriteOff, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WriteOffMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WriteOffCreateBulk) SaveX(ctx context.Context) []*WriteOff {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WriteOffCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WriteOffCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WriteOff.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WriteOffUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WriteOffCreateBulk) OnConflict(opts ...sql.ConflictOption) *WriteOffUpsertBulk {
+	_c.conflict = opts
+	return &WriteOffUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WriteOff.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WriteOffCreateBulk) OnConflictColumns(columns ...string) *WriteOffUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WriteOffUpsertBulk{
+		create: _c,
+	}
+}
+
+// WriteOffUpsertBulk is the builder for "upsert"-ing
+// a bulk of WriteOff nodes.
+type WriteOffUpsertBulk struct {
+	create *WriteOffCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WriteOff.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(writeoff.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WriteOffUpsertBulk) UpdateNewValues() *WriteOffUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(writeoff.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(writeoff.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WriteOff.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WriteOffUpsertBulk) Ignore() *WriteOffUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WriteOffUpsertBulk) DoNothing() *WriteOffUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WriteOffCreateBulk.OnConflict
+// documentation for more info.
+func (u *WriteOffUpsertBulk) Update(set func(*WriteOffUpsert)) *WriteOffUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WriteOffUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WriteOffUpsertBulk) SetTenantID(v uuid.UUID) *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WriteOffUpsertBulk) UpdateTenantID() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *WriteOffUpsertBulk) SetInvoiceID(v uuid.UUID) *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetInvoiceID(v)
+	})
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *WriteOffUpsertBulk) UpdateInvoiceID() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateInvoiceID()
+	})
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (u *WriteOffUpsertBulk) SetCustomerID(v uuid.UUID) *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetCustomerID(v)
+	})
+}
+
+// UpdateCustomerID sets the "customer_id" field to the value that was provided on create.
+func (u *WriteOffUpsertBulk) UpdateCustomerID() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateCustomerID()
+	})
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (u *WriteOffUpsertBulk) ClearCustomerID() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.ClearCustomerID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *WriteOffUpsertBulk) SetAmount(v decimal.Decimal) *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *WriteOffUpsertBulk) AddAmount(v decimal.Decimal) *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *WriteOffUpsertBulk) UpdateAmount() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetRecoveredAmount sets the "recovered_amount" field.
+func (u *WriteOffUpsertBulk) SetRecoveredAmount(v decimal.Decimal) *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetRecoveredAmount(v)
+	})
+}
+
+// AddRecoveredAmount adds v to the "recovered_amount" field.
+func (u *WriteOffUpsertBulk) AddRecoveredAmount(v decimal.Decimal) *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.AddRecoveredAmount(v)
+	})
+}
+
+// UpdateRecoveredAmount sets the "recovered_amount" field to the value that was provided on create.
+func (u *WriteOffUpsertBulk) UpdateRecoveredAmount() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateRecoveredAmount()
+	})
+}
+
+// ClearRecoveredAmount clears the value of the "recovered_amount" field.
+func (u *WriteOffUpsertBulk) ClearRecoveredAmount() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.ClearRecoveredAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *WriteOffUpsertBulk) SetCurrency(v string) *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *WriteOffUpsertBulk) UpdateCurrency() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *WriteOffUpsertBulk) SetReason(v string) *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *WriteOffUpsertBulk) UpdateReason() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WriteOffUpsertBulk) SetStatus(v string) *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WriteOffUpsertBulk) UpdateStatus() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (u *WriteOffUpsertBulk) SetRequestedBy(v uuid.UUID) *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetRequestedBy(v)
+	})
+}
+
+// UpdateRequestedBy sets the "requested_by" field to the value that was provided on create.
+func (u *WriteOffUpsertBulk) UpdateRequestedBy() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateRequestedBy()
+	})
+}
+
+// ClearRequestedBy clears the value of the "requested_by" field.
+func (u *WriteOffUpsertBulk) ClearRequestedBy() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.ClearRequestedBy()
+	})
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (u *WriteOffUpsertBulk) SetDecidedBy(v uuid.UUID) *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetDecidedBy(v)
+	})
+}
+
+// UpdateDecidedBy sets the "decided_by" field to the value that was provided on create.
+func (u *WriteOffUpsertBulk) UpdateDecidedBy() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateDecidedBy()
+	})
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (u *WriteOffUpsertBulk) ClearDecidedBy() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.ClearDecidedBy()
+	})
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (u *WriteOffUpsertBulk) SetDecidedAt(v time.Time) *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetDecidedAt(v)
+	})
+}
+
+// UpdateDecidedAt sets the "decided_at" field to the value that was provided on create.
+func (u *WriteOffUpsertBulk) UpdateDecidedAt() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateDecidedAt()
+	})
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (u *WriteOffUpsertBulk) ClearDecidedAt() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.ClearDecidedAt()
+	})
+}
+
+// SetDecisionNote sets the "decision_note" field.
+func (u *WriteOffUpsertBulk) SetDecisionNote(v string) *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetDecisionNote(v)
+	})
+}
+
+// UpdateDecisionNote sets the "decision_note" field to the value that was provided on create.
+func (u *WriteOffUpsertBulk) UpdateDecisionNote() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateDecisionNote()
+	})
+}
+
+// ClearDecisionNote clears the value of the "decision_note" field.
+func (u *WriteOffUpsertBulk) ClearDecisionNote() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.ClearDecisionNote()
+	})
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *WriteOffUpsertBulk) SetJournalEntryID(v uuid.UUID) *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetJournalEntryID(v)
+	})
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *WriteOffUpsertBulk) UpdateJournalEntryID() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateJournalEntryID()
+	})
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *WriteOffUpsertBulk) ClearJournalEntryID() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.ClearJournalEntryID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WriteOffUpsertBulk) SetUpdatedAt(v time.Time) *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WriteOffUpsertBulk) UpdateUpdatedAt() *WriteOffUpsertBulk {
+	return u.Update(func(s *WriteOffUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WriteOffUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WriteOffCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WriteOffCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WriteOffUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
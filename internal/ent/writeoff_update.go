@@ -0,0 +1,848 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/writeoff"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WriteOffUpdate is the builder for updating WriteOff entities.
+type WriteOffUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WriteOffMutation
+}
+
+// Where appends a list predicates to the WriteOffUpdate builder.
+func (_u *WriteOffUpdate) Where(ps ...predicate.WriteOff) *WriteOffUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *WriteOffUpdate) SetTenantID(v uuid.UUID) *WriteOffUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *WriteOffUpdate) SetNillableTenantID(v *uuid.UUID) *WriteOffUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *WriteOffUpdate) SetInvoiceID(v uuid.UUID) *WriteOffUpdate {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *WriteOffUpdate) SetNillableInvoiceID(v *uuid.UUID) *WriteOffUpdate {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *WriteOffUpdate) SetCustomerID(v uuid.UUID) *WriteOffUpdate {
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *WriteOffUpdate) SetNillableCustomerID(v *uuid.UUID) *WriteOffUpdate {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (_u *WriteOffUpdate) ClearCustomerID() *WriteOffUpdate {
+	_u.mutation.ClearCustomerID()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *WriteOffUpdate) SetAmount(v decimal.Decimal) *WriteOffUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *WriteOffUpdate) SetNillableAmount(v *decimal.Decimal) *WriteOffUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *WriteOffUpdate) AddAmount(v decimal.Decimal) *WriteOffUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetRecoveredAmount sets the "recovered_amount" field.
+func (_u *WriteOffUpdate) SetRecoveredAmount(v decimal.Decimal) *WriteOffUpdate {
+	_u.mutation.ResetRecoveredAmount()
+	_u.mutation.SetRecoveredAmount(v)
+	return _u
+}
+
+// SetNillableRecoveredAmount sets the "recovered_amount" field if the given value is not nil.
+func (_u *WriteOffUpdate) SetNillableRecoveredAmount(v *decimal.Decimal) *WriteOffUpdate {
+	if v != nil {
+		_u.SetRecoveredAmount(*v)
+	}
+	return _u
+}
+
+// AddRecoveredAmount adds value to the "recovered_amount" field.
+func (_u *WriteOffUpdate) AddRecoveredAmount(v decimal.Decimal) *WriteOffUpdate {
+	_u.mutation.AddRecoveredAmount(v)
+	return _u
+}
+
+// ClearRecoveredAmount clears the value of the "recovered_amount" field.
+func (_u *WriteOffUpdate) ClearRecoveredAmount() *WriteOffUpdate {
+	_u.mutation.ClearRecoveredAmount()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *WriteOffUpdate) SetCurrency(v string) *WriteOffUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *WriteOffUpdate) SetNillableCurrency(v *string) *WriteOffUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *WriteOffUpdate) SetReason(v string) *WriteOffUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *WriteOffUpdate) SetNillableReason(v *string) *WriteOffUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WriteOffUpdate) SetStatus(v string) *WriteOffUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WriteOffUpdate) SetNillableStatus(v *string) *WriteOffUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (_u *WriteOffUpdate) SetRequestedBy(v uuid.UUID) *WriteOffUpdate {
+	_u.mutation.SetRequestedBy(v)
+	return _u
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (_u *WriteOffUpdate) SetNillableRequestedBy(v *uuid.UUID) *WriteOffUpdate {
+	if v != nil {
+		_u.SetRequestedBy(*v)
+	}
+	return _u
+}
+
+// ClearRequestedBy clears the value of the "requested_by" field.
+func (_u *WriteOffUpdate) ClearRequestedBy() *WriteOffUpdate {
+	_u.mutation.ClearRequestedBy()
+	return _u
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (_u *WriteOffUpdate) SetDecidedBy(v uuid.UUID) *WriteOffUpdate {
+	_u.mutation.SetDecidedBy(v)
+	return _u
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (_u *WriteOffUpdate) SetNillableDecidedBy(v *uuid.UUID) *WriteOffUpdate {
+	if v != nil {
+		_u.SetDecidedBy(*v)
+	}
+	return _u
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (_u *WriteOffUpdate) ClearDecidedBy() *WriteOffUpdate {
+	_u.mutation.ClearDecidedBy()
+	return _u
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (_u *WriteOffUpdate) SetDecidedAt(v time.Time) *WriteOffUpdate {
+	_u.mutation.SetDecidedAt(v)
+	return _u
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (_u *WriteOffUpdate) SetNillableDecidedAt(v *time.Time) *WriteOffUpdate {
+	if v != nil {
+		_u.SetDecidedAt(*v)
+	}
+	return _u
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (_u *WriteOffUpdate) ClearDecidedAt() *WriteOffUpdate {
+	_u.mutation.ClearDecidedAt()
+	return _u
+}
+
+// SetDecisionNote sets the "decision_note" field.
+func (_u *WriteOffUpdate) SetDecisionNote(v string) *WriteOffUpdate {
+	_u.mutation.SetDecisionNote(v)
+	return _u
+}
+
+// SetNillableDecisionNote sets the "decision_note" field if the given value is not nil.
+func (_u *WriteOffUpdate) SetNillableDecisionNote(v *string) *WriteOffUpdate {
+	if v != nil {
+		_u.SetDecisionNote(*v)
+	}
+	return _u
+}
+
+// ClearDecisionNote clears the value of the "decision_note" field.
+func (_u *WriteOffUpdate) ClearDecisionNote() *WriteOffUpdate {
+	_u.mutation.ClearDecisionNote()
+	return _u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_u *WriteOffUpdate) SetJournalEntryID(v uuid.UUID) *WriteOffUpdate {
+	_u.mutation.SetJournalEntryID(v)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_u *WriteOffUpdate) SetNillableJournalEntryID(v *uuid.UUID) *WriteOffUpdate {
+	if v != nil {
+		_u.SetJournalEntryID(*v)
+	}
+	return _u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (_u *WriteOffUpdate) ClearJournalEntryID() *WriteOffUpdate {
+	_u.mutation.ClearJournalEntryID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WriteOffUpdate) SetUpdatedAt(v time.Time) *WriteOffUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the WriteOffMutation object of the builder.
+func (_u *WriteOffUpdate) Mutation() *WriteOffMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WriteOffUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WriteOffUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WriteOffUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WriteOffUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WriteOffUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := writeoff.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WriteOffUpdate) check() error {
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := writeoff.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "WriteOff.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WriteOffUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(writeoff.Table, writeoff.Columns, sqlgraph.NewFieldSpec(writeoff.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(writeoff.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.InvoiceID(); ok {
+		_spec.SetField(writeoff.FieldInvoiceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(writeoff.FieldCustomerID, field.TypeUUID, value)
+	}
+	if _u.mutation.CustomerIDCleared() {
+		_spec.ClearField(writeoff.FieldCustomerID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(writeoff.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(writeoff.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RecoveredAmount(); ok {
+		_spec.SetField(writeoff.FieldRecoveredAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRecoveredAmount(); ok {
+		_spec.AddField(writeoff.FieldRecoveredAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.RecoveredAmountCleared() {
+		_spec.ClearField(writeoff.FieldRecoveredAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(writeoff.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(writeoff.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(writeoff.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RequestedBy(); ok {
+		_spec.SetField(writeoff.FieldRequestedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.RequestedByCleared() {
+		_spec.ClearField(writeoff.FieldRequestedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DecidedBy(); ok {
+		_spec.SetField(writeoff.FieldDecidedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.DecidedByCleared() {
+		_spec.ClearField(writeoff.FieldDecidedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DecidedAt(); ok {
+		_spec.SetField(writeoff.FieldDecidedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DecidedAtCleared() {
+		_spec.ClearField(writeoff.FieldDecidedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DecisionNote(); ok {
+		_spec.SetField(writeoff.FieldDecisionNote, field.TypeString, value)
+	}
+	if _u.mutation.DecisionNoteCleared() {
+		_spec.ClearField(writeoff.FieldDecisionNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.JournalEntryID(); ok {
+		_spec.SetField(writeoff.FieldJournalEntryID, field.TypeUUID, value)
+	}
+	if _u.mutation.JournalEntryIDCleared() {
+		_spec.ClearField(writeoff.FieldJournalEntryID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(writeoff.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{writeoff.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WriteOffUpdateOne is the builder for updating a single WriteOff entity.
+type WriteOffUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WriteOffMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *WriteOffUpdateOne) SetTenantID(v uuid.UUID) *WriteOffUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *WriteOffUpdateOne) SetNillableTenantID(v *uuid.UUID) *WriteOffUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *WriteOffUpdateOne) SetInvoiceID(v uuid.UUID) *WriteOffUpdateOne {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *WriteOffUpdateOne) SetNillableInvoiceID(v *uuid.UUID) *WriteOffUpdateOne {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *WriteOffUpdateOne) SetCustomerID(v uuid.UUID) *WriteOffUpdateOne {
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *WriteOffUpdateOne) SetNillableCustomerID(v *uuid.UUID) *WriteOffUpdateOne {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (_u *WriteOffUpdateOne) ClearCustomerID() *WriteOffUpdateOne {
+	_u.mutation.ClearCustomerID()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *WriteOffUpdateOne) SetAmount(v decimal.Decimal) *WriteOffUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *WriteOffUpdateOne) SetNillableAmount(v *decimal.Decimal) *WriteOffUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *WriteOffUpdateOne) AddAmount(v decimal.Decimal) *WriteOffUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetRecoveredAmount sets the "recovered_amount" field.
+func (_u *WriteOffUpdateOne) SetRecoveredAmount(v decimal.Decimal) *WriteOffUpdateOne {
+	_u.mutation.ResetRecoveredAmount()
+	_u.mutation.SetRecoveredAmount(v)
+	return _u
+}
+
+// SetNillableRecoveredAmount sets the "recovered_amount" field if the given value is not nil.
+func (_u *WriteOffUpdateOne) SetNillableRecoveredAmount(v *decimal.Decimal) *WriteOffUpdateOne {
+	if v != nil {
+		_u.SetRecoveredAmount(*v)
+	}
+	return _u
+}
+
+// AddRecoveredAmount adds value to the "recovered_amount" field.
+func (_u *WriteOffUpdateOne) AddRecoveredAmount(v decimal.Decimal) *WriteOffUpdateOne {
+	_u.mutation.AddRecoveredAmount(v)
+	return _u
+}
+
+// ClearRecoveredAmount clears the value of the "recovered_amount" field.
+func (_u *WriteOffUpdateOne) ClearRecoveredAmount() *WriteOffUpdateOne {
+	_u.mutation.ClearRecoveredAmount()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *WriteOffUpdateOne) SetCurrency(v string) *WriteOffUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *WriteOffUpdateOne) SetNillableCurrency(v *string) *WriteOffUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *WriteOffUpdateOne) SetReason(v string) *WriteOffUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *WriteOffUpdateOne) SetNillableReason(v *string) *WriteOffUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WriteOffUpdateOne) SetStatus(v string) *WriteOffUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WriteOffUpdateOne) SetNillableStatus(v *string) *WriteOffUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (_u *WriteOffUpdateOne) SetRequestedBy(v uuid.UUID) *WriteOffUpdateOne {
+	_u.mutation.SetRequestedBy(v)
+	return _u
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (_u *WriteOffUpdateOne) SetNillableRequestedBy(v *uuid.UUID) *WriteOffUpdateOne {
+	if v != nil {
+		_u.SetRequestedBy(*v)
+	}
+	return _u
+}
+
+// ClearRequestedBy clears the value of the "requested_by" field.
+func (_u *WriteOffUpdateOne) ClearRequestedBy() *WriteOffUpdateOne {
+	_u.mutation.ClearRequestedBy()
+	return _u
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (_u *WriteOffUpdateOne) SetDecidedBy(v uuid.UUID) *WriteOffUpdateOne {
+	_u.mutation.SetDecidedBy(v)
+	return _u
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (_u *WriteOffUpdateOne) SetNillableDecidedBy(v *uuid.UUID) *WriteOffUpdateOne {
+	if v != nil {
+		_u.SetDecidedBy(*v)
+	}
+	return _u
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (_u *WriteOffUpdateOne) ClearDecidedBy() *WriteOffUpdateOne {
+	_u.mutation.ClearDecidedBy()
+	return _u
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (_u *WriteOffUpdateOne) SetDecidedAt(v time.Time) *WriteOffUpdateOne {
+	_u.mutation.SetDecidedAt(v)
+	return _u
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (_u *WriteOffUpdateOne) SetNillableDecidedAt(v *time.Time) *WriteOffUpdateOne {
+	if v != nil {
+		_u.SetDecidedAt(*v)
+	}
+	return _u
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (_u *WriteOffUpdateOne) ClearDecidedAt() *WriteOffUpdateOne {
+	_u.mutation.ClearDecidedAt()
+	return _u
+}
+
+// SetDecisionNote sets the "decision_note" field.
+func (_u *WriteOffUpdateOne) SetDecisionNote(v string) *WriteOffUpdateOne {
+	_u.mutation.SetDecisionNote(v)
+	return _u
+}
+
+// SetNillableDecisionNote sets the "decision_note" field if the given value is not nil.
+func (_u *WriteOffUpdateOne) SetNillableDecisionNote(v *string) *WriteOffUpdateOne {
+	if v != nil {
+		_u.SetDecisionNote(*v)
+	}
+	return _u
+}
+
+// ClearDecisionNote clears the value of the "decision_note" field.
+func (_u *WriteOffUpdateOne) ClearDecisionNote() *WriteOffUpdateOne {
+	_u.mutation.ClearDecisionNote()
+	return _u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_u *WriteOffUpdateOne) SetJournalEntryID(v uuid.UUID) *WriteOffUpdateOne {
+	_u.mutation.SetJournalEntryID(v)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_u *WriteOffUpdateOne) SetNillableJournalEntryID(v *uuid.UUID) *WriteOffUpdateOne {
+	if v != nil {
+		_u.SetJournalEntryID(*v)
+	}
+	return _u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (_u *WriteOffUpdateOne) ClearJournalEntryID() *WriteOffUpdateOne {
+	_u.mutation.ClearJournalEntryID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WriteOffUpdateOne) SetUpdatedAt(v time.Time) *WriteOffUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the WriteOffMutation object of the builder.
+func (_u *WriteOffUpdateOne) Mutation() *WriteOffMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WriteOffUpdate builder.
+func (_u *WriteOffUpdateOne) Where(ps ...predicate.WriteOff) *WriteOffUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WriteOffUpdateOne) Select(field string, fields ...string) *WriteOffUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WriteOff entity.
+func (_u *WriteOffUpdateOne) Save(ctx context.Context) (*WriteOff, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WriteOffUpdateOne) SaveX(ctx context.Context) *WriteOff {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WriteOffUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WriteOffUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WriteOffUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := writeoff.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WriteOffUpdateOne) check() error {
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := writeoff.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "WriteOff.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WriteOffUpdateOne) sqlSave(ctx context.Context) (_node *WriteOff, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(writeoff.Table, writeoff.Columns, sqlgraph.NewFieldSpec(writeoff.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WriteOff.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, writeoff.FieldID)
+		for _, f := range fields {
+			if !writeoff.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != writeoff.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(writeoff.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.InvoiceID(); ok {
+		_spec.SetField(writeoff.FieldInvoiceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(writeoff.FieldCustomerID, field.TypeUUID, value)
+	}
+	if _u.mutation.CustomerIDCleared() {
+		_spec.ClearField(writeoff.FieldCustomerID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(writeoff.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(writeoff.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RecoveredAmount(); ok {
+		_spec.SetField(writeoff.FieldRecoveredAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRecoveredAmount(); ok {
+		_spec.AddField(writeoff.FieldRecoveredAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.RecoveredAmountCleared() {
+		_spec.ClearField(writeoff.FieldRecoveredAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(writeoff.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(writeoff.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(writeoff.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RequestedBy(); ok {
+		_spec.SetField(writeoff.FieldRequestedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.RequestedByCleared() {
+		_spec.ClearField(writeoff.FieldRequestedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DecidedBy(); ok {
+		_spec.SetField(writeoff.FieldDecidedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.DecidedByCleared() {
+		_spec.ClearField(writeoff.FieldDecidedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DecidedAt(); ok {
+		_spec.SetField(writeoff.FieldDecidedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DecidedAtCleared() {
+		_spec.ClearField(writeoff.FieldDecidedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DecisionNote(); ok {
+		_spec.SetField(writeoff.FieldDecisionNote, field.TypeString, value)
+	}
+	if _u.mutation.DecisionNoteCleared() {
+		_spec.ClearField(writeoff.FieldDecisionNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.JournalEntryID(); ok {
+		_spec.SetField(writeoff.FieldJournalEntryID, field.TypeUUID, value)
+	}
+	if _u.mutation.JournalEntryIDCleared() {
+		_spec.ClearField(writeoff.FieldJournalEntryID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(writeoff.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &WriteOff{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{writeoff.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/writeoffrecovery"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WriteOffRecovery is the model entity for the WriteOffRecovery schema.
+type WriteOffRecovery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant identifier
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Write-off being recovered
+	WriteOffID uuid.UUID `json:"write_off_id,omitempty"`
+	// Invoice the balance is reinstated on
+	InvoiceID uuid.UUID `json:"invoice_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// JournalEntryID holds the value of the "journal_entry_id" field.
+	JournalEntryID uuid.UUID `json:"journal_entry_id,omitempty"`
+	// RecoveredBy holds the value of the "recovered_by" field.
+	RecoveredBy uuid.UUID `json:"recovered_by,omitempty"`
+	// RecoveredAt holds the value of the "recovered_at" field.
+	RecoveredAt  time.Time `json:"recovered_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WriteOffRecovery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case writeoffrecovery.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case writeoffrecovery.FieldNote:
+			values[i] = new(sql.NullString)
+		case writeoffrecovery.FieldRecoveredAt:
+			values[i] = new(sql.NullTime)
+		case writeoffrecovery.FieldID, writeoffrecovery.FieldTenantID, writeoffrecovery.FieldWriteOffID, writeoffrecovery.FieldInvoiceID, writeoffrecovery.FieldJournalEntryID, writeoffrecovery.FieldRecoveredBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WriteOffRecovery fields.
+func (_m *WriteOffRecovery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case writeoffrecovery.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case writeoffrecovery.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case writeoffrecovery.FieldWriteOffID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field write_off_id", values[i])
+			} else if value != nil {
+				_m.WriteOffID = *value
+			}
+		case writeoffrecovery.FieldInvoiceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value != nil {
+				_m.InvoiceID = *value
+			}
+		case writeoffrecovery.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case writeoffrecovery.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case writeoffrecovery.FieldJournalEntryID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field journal_entry_id", values[i])
+			} else if value != nil {
+				_m.JournalEntryID = *value
+			}
+		case writeoffrecovery.FieldRecoveredBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field recovered_by", values[i])
+			} else if value != nil {
+				_m.RecoveredBy = *value
+			}
+		case writeoffrecovery.FieldRecoveredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field recovered_at", values[i])
+			} else if value.Valid {
+				_m.RecoveredAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WriteOffRecovery.
+// This includes values selected through modifiers, order, etc.
+func (_m *WriteOffRecovery) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WriteOffRecovery.
+// Note that you need to call WriteOffRecovery.Unwrap() before calling this method if this WriteOffRecovery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WriteOffRecovery) Update() *WriteOffRecoveryUpdateOne {
+	return NewWriteOffRecoveryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WriteOffRecovery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WriteOffRecovery) Unwrap() *WriteOffRecovery {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WriteOffRecovery is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WriteOffRecovery) String() string {
+	var builder strings.Builder
+	builder.WriteString("WriteOffRecovery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("write_off_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WriteOffID))
+	builder.WriteString(", ")
+	builder.WriteString("invoice_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InvoiceID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("journal_entry_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JournalEntryID))
+	builder.WriteString(", ")
+	builder.WriteString("recovered_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RecoveredBy))
+	builder.WriteString(", ")
+	builder.WriteString("recovered_at=")
+	builder.WriteString(_m.RecoveredAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WriteOffRecoveries is a parsable slice of WriteOffRecovery.
+type WriteOffRecoveries []*WriteOffRecovery
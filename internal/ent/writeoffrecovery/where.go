@@ -0,0 +1,487 @@
+// Code generated by ent, DO NOT EDIT.
+
+package writeoffrecovery
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEQ(FieldTenantID, v))
+}
+
+// WriteOffID applies equality check predicate on the "write_off_id" field. It's identical to WriteOffIDEQ.
+func WriteOffID(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEQ(FieldWriteOffID, v))
+}
+
+// InvoiceID applies equality check predicate on the "invoice_id" field. It's identical to InvoiceIDEQ.
+func InvoiceID(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEQ(FieldAmount, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEQ(FieldNote, v))
+}
+
+// JournalEntryID applies equality check predicate on the "journal_entry_id" field. It's identical to JournalEntryIDEQ.
+func JournalEntryID(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// RecoveredBy applies equality check predicate on the "recovered_by" field. It's identical to RecoveredByEQ.
+func RecoveredBy(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEQ(FieldRecoveredBy, v))
+}
+
+// RecoveredAt applies equality check predicate on the "recovered_at" field. It's identical to RecoveredAtEQ.
+func RecoveredAt(v time.Time) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEQ(FieldRecoveredAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldLTE(FieldTenantID, v))
+}
+
+// WriteOffIDEQ applies the EQ predicate on the "write_off_id" field.
+func WriteOffIDEQ(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEQ(FieldWriteOffID, v))
+}
+
+// WriteOffIDNEQ applies the NEQ predicate on the "write_off_id" field.
+func WriteOffIDNEQ(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNEQ(FieldWriteOffID, v))
+}
+
+// WriteOffIDIn applies the In predicate on the "write_off_id" field.
+func WriteOffIDIn(vs ...uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldIn(FieldWriteOffID, vs...))
+}
+
+// WriteOffIDNotIn applies the NotIn predicate on the "write_off_id" field.
+func WriteOffIDNotIn(vs ...uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNotIn(FieldWriteOffID, vs...))
+}
+
+// WriteOffIDGT applies the GT predicate on the "write_off_id" field.
+func WriteOffIDGT(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldGT(FieldWriteOffID, v))
+}
+
+// WriteOffIDGTE applies the GTE predicate on the "write_off_id" field.
+func WriteOffIDGTE(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldGTE(FieldWriteOffID, v))
+}
+
+// WriteOffIDLT applies the LT predicate on the "write_off_id" field.
+func WriteOffIDLT(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldLT(FieldWriteOffID, v))
+}
+
+// WriteOffIDLTE applies the LTE predicate on the "write_off_id" field.
+func WriteOffIDLTE(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldLTE(FieldWriteOffID, v))
+}
+
+// InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
+func InvoiceIDEQ(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDNEQ applies the NEQ predicate on the "invoice_id" field.
+func InvoiceIDNEQ(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDIn applies the In predicate on the "invoice_id" field.
+func InvoiceIDIn(vs ...uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDNotIn applies the NotIn predicate on the "invoice_id" field.
+func InvoiceIDNotIn(vs ...uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNotIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDGT applies the GT predicate on the "invoice_id" field.
+func InvoiceIDGT(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldGT(FieldInvoiceID, v))
+}
+
+// InvoiceIDGTE applies the GTE predicate on the "invoice_id" field.
+func InvoiceIDGTE(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldGTE(FieldInvoiceID, v))
+}
+
+// InvoiceIDLT applies the LT predicate on the "invoice_id" field.
+func InvoiceIDLT(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldLT(FieldInvoiceID, v))
+}
+
+// InvoiceIDLTE applies the LTE predicate on the "invoice_id" field.
+func InvoiceIDLTE(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldLTE(FieldInvoiceID, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldLTE(FieldAmount, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldContainsFold(FieldNote, v))
+}
+
+// JournalEntryIDEQ applies the EQ predicate on the "journal_entry_id" field.
+func JournalEntryIDEQ(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDNEQ applies the NEQ predicate on the "journal_entry_id" field.
+func JournalEntryIDNEQ(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIn applies the In predicate on the "journal_entry_id" field.
+func JournalEntryIDIn(vs ...uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDNotIn applies the NotIn predicate on the "journal_entry_id" field.
+func JournalEntryIDNotIn(vs ...uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNotIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDGT applies the GT predicate on the "journal_entry_id" field.
+func JournalEntryIDGT(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldGT(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDGTE applies the GTE predicate on the "journal_entry_id" field.
+func JournalEntryIDGTE(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldGTE(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDLT applies the LT predicate on the "journal_entry_id" field.
+func JournalEntryIDLT(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldLT(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDLTE applies the LTE predicate on the "journal_entry_id" field.
+func JournalEntryIDLTE(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldLTE(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIsNil applies the IsNil predicate on the "journal_entry_id" field.
+func JournalEntryIDIsNil() predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldIsNull(FieldJournalEntryID))
+}
+
+// JournalEntryIDNotNil applies the NotNil predicate on the "journal_entry_id" field.
+func JournalEntryIDNotNil() predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNotNull(FieldJournalEntryID))
+}
+
+// RecoveredByEQ applies the EQ predicate on the "recovered_by" field.
+func RecoveredByEQ(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEQ(FieldRecoveredBy, v))
+}
+
+// RecoveredByNEQ applies the NEQ predicate on the "recovered_by" field.
+func RecoveredByNEQ(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNEQ(FieldRecoveredBy, v))
+}
+
+// RecoveredByIn applies the In predicate on the "recovered_by" field.
+func RecoveredByIn(vs ...uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldIn(FieldRecoveredBy, vs...))
+}
+
+// RecoveredByNotIn applies the NotIn predicate on the "recovered_by" field.
+func RecoveredByNotIn(vs ...uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNotIn(FieldRecoveredBy, vs...))
+}
+
+// RecoveredByGT applies the GT predicate on the "recovered_by" field.
+func RecoveredByGT(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldGT(FieldRecoveredBy, v))
+}
+
+// RecoveredByGTE applies the GTE predicate on the "recovered_by" field.
+func RecoveredByGTE(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldGTE(FieldRecoveredBy, v))
+}
+
+// RecoveredByLT applies the LT predicate on the "recovered_by" field.
+func RecoveredByLT(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldLT(FieldRecoveredBy, v))
+}
+
+// RecoveredByLTE applies the LTE predicate on the "recovered_by" field.
+func RecoveredByLTE(v uuid.UUID) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldLTE(FieldRecoveredBy, v))
+}
+
+// RecoveredByIsNil applies the IsNil predicate on the "recovered_by" field.
+func RecoveredByIsNil() predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldIsNull(FieldRecoveredBy))
+}
+
+// RecoveredByNotNil applies the NotNil predicate on the "recovered_by" field.
+func RecoveredByNotNil() predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNotNull(FieldRecoveredBy))
+}
+
+// RecoveredAtEQ applies the EQ predicate on the "recovered_at" field.
+func RecoveredAtEQ(v time.Time) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldEQ(FieldRecoveredAt, v))
+}
+
+// RecoveredAtNEQ applies the NEQ predicate on the "recovered_at" field.
+func RecoveredAtNEQ(v time.Time) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNEQ(FieldRecoveredAt, v))
+}
+
+// RecoveredAtIn applies the In predicate on the "recovered_at" field.
+func RecoveredAtIn(vs ...time.Time) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldIn(FieldRecoveredAt, vs...))
+}
+
+// RecoveredAtNotIn applies the NotIn predicate on the "recovered_at" field.
+func RecoveredAtNotIn(vs ...time.Time) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldNotIn(FieldRecoveredAt, vs...))
+}
+
+// RecoveredAtGT applies the GT predicate on the "recovered_at" field.
+func RecoveredAtGT(v time.Time) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldGT(FieldRecoveredAt, v))
+}
+
+// RecoveredAtGTE applies the GTE predicate on the "recovered_at" field.
+func RecoveredAtGTE(v time.Time) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldGTE(FieldRecoveredAt, v))
+}
+
+// RecoveredAtLT applies the LT predicate on the "recovered_at" field.
+func RecoveredAtLT(v time.Time) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldLT(FieldRecoveredAt, v))
+}
+
+// RecoveredAtLTE applies the LTE predicate on the "recovered_at" field.
+func RecoveredAtLTE(v time.Time) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.FieldLTE(FieldRecoveredAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WriteOffRecovery) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WriteOffRecovery) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WriteOffRecovery) predicate.WriteOffRecovery {
+	return predicate.WriteOffRecovery(sql.NotPredicates(p))
+}
@@ -0,0 +1,113 @@
+// Code generated by ent, DO NOT EDIT.
+
+package writeoffrecovery
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the writeoffrecovery type in the database.
+	Label = "write_off_recovery"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldWriteOffID holds the string denoting the write_off_id field in the database.
+	FieldWriteOffID = "write_off_id"
+	// FieldInvoiceID holds the string denoting the invoice_id field in the database.
+	FieldInvoiceID = "invoice_id"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldJournalEntryID holds the string denoting the journal_entry_id field in the database.
+	FieldJournalEntryID = "journal_entry_id"
+	// FieldRecoveredBy holds the string denoting the recovered_by field in the database.
+	FieldRecoveredBy = "recovered_by"
+	// FieldRecoveredAt holds the string denoting the recovered_at field in the database.
+	FieldRecoveredAt = "recovered_at"
+	// Table holds the table name of the writeoffrecovery in the database.
+	Table = "write_off_recoveries"
+)
+
+// Columns holds all SQL columns for writeoffrecovery fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldWriteOffID,
+	FieldInvoiceID,
+	FieldAmount,
+	FieldNote,
+	FieldJournalEntryID,
+	FieldRecoveredBy,
+	FieldRecoveredAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultRecoveredAt holds the default value on creation for the "recovered_at" field.
+	DefaultRecoveredAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the WriteOffRecovery queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByWriteOffID orders the results by the write_off_id field.
+func ByWriteOffID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWriteOffID, opts...).ToFunc()
+}
+
+// ByInvoiceID orders the results by the invoice_id field.
+func ByInvoiceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceID, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByJournalEntryID orders the results by the journal_entry_id field.
+func ByJournalEntryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJournalEntryID, opts...).ToFunc()
+}
+
+// ByRecoveredBy orders the results by the recovered_by field.
+func ByRecoveredBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecoveredBy, opts...).ToFunc()
+}
+
+// ByRecoveredAt orders the results by the recovered_at field.
+func ByRecoveredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecoveredAt, opts...).ToFunc()
+}
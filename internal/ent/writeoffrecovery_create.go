@@ -0,0 +1,954 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/writeoffrecovery"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WriteOffRecoveryCreate is the builder for creating a WriteOffRecovery entity.
+type WriteOffRecoveryCreate struct {
+	config
+	mutation *WriteOffRecoveryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *WriteOffRecoveryCreate) SetTenantID(v uuid.UUID) *WriteOffRecoveryCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetWriteOffID sets the "write_off_id" field.
+func (_c *WriteOffRecoveryCreate) SetWriteOffID(v uuid.UUID) *WriteOffRecoveryCreate {
+	_c.mutation.SetWriteOffID(v)
+	return _c
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_c *WriteOffRecoveryCreate) SetInvoiceID(v uuid.UUID) *WriteOffRecoveryCreate {
+	_c.mutation.SetInvoiceID(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *WriteOffRecoveryCreate) SetAmount(v decimal.Decimal) *WriteOffRecoveryCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *WriteOffRecoveryCreate) SetNote(v string) *WriteOffRecoveryCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *WriteOffRecoveryCreate) SetNillableNote(v *string) *WriteOffRecoveryCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_c *WriteOffRecoveryCreate) SetJournalEntryID(v uuid.UUID) *WriteOffRecoveryCreate {
+	_c.mutation.SetJournalEntryID(v)
+	return _c
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_c *WriteOffRecoveryCreate) SetNillableJournalEntryID(v *uuid.UUID) *WriteOffRecoveryCreate {
+	if v != nil {
+		_c.SetJournalEntryID(*v)
+	}
+	return _c
+}
+
+// SetRecoveredBy sets the "recovered_by" field.
+func (_c *WriteOffRecoveryCreate) SetRecoveredBy(v uuid.UUID) *WriteOffRecoveryCreate {
+	_c.mutation.SetRecoveredBy(v)
+	return _c
+}
+
+// SetNillableRecoveredBy sets the "recovered_by" field if the given value is not nil.
+func (_c *WriteOffRecoveryCreate) SetNillableRecoveredBy(v *uuid.UUID) *WriteOffRecoveryCreate {
+	if v != nil {
+		_c.SetRecoveredBy(*v)
+	}
+	return _c
+}
+
+// SetRecoveredAt sets the "recovered_at" field.
+func (_c *WriteOffRecoveryCreate) SetRecoveredAt(v time.Time) *WriteOffRecoveryCreate {
+	_c.mutation.SetRecoveredAt(v)
+	return _c
+}
+
+// SetNillableRecoveredAt sets the "recovered_at" field if the given value is not nil.
+func (_c *WriteOffRecoveryCreate) SetNillableRecoveredAt(v *time.Time) *WriteOffRecoveryCreate {
+	if v != nil {
+		_c.SetRecoveredAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WriteOffRecoveryCreate) SetID(v uuid.UUID) *WriteOffRecoveryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WriteOffRecoveryCreate) SetNillableID(v *uuid.UUID) *WriteOffRecoveryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the WriteOffRecoveryMutation object of the builder.
+func (_c *WriteOffRecoveryCreate) Mutation() *WriteOffRecoveryMutation {
+	return _c.mutation
+}
+
+// Save creates the WriteOffRecovery in the database.
+func (_c *WriteOffRecoveryCreate) Save(ctx context.Context) (*WriteOffRecovery, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WriteOffRecoveryCreate) SaveX(ctx context.Context) *WriteOffRecovery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WriteOffRecoveryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WriteOffRecoveryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WriteOffRecoveryCreate) defaults() {
+	if _, ok := _c.mutation.RecoveredAt(); !ok {
+		v := writeoffrecovery.DefaultRecoveredAt()
+		_c.mutation.SetRecoveredAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := writeoffrecovery.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WriteOffRecoveryCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "WriteOffRecovery.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.WriteOffID(); !ok {
+		return &ValidationError{Name: "write_off_id", err: errors.New(`ent: missing required field "WriteOffRecovery.write_off_id"`)}
+	}
+	if _, ok := _c.mutation.InvoiceID(); !ok {
+		return &ValidationError{Name: "invoice_id", err: errors.New(`ent: missing required field "WriteOffRecovery.invoice_id"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "WriteOffRecovery.amount"`)}
+	}
+	if _, ok := _c.mutation.RecoveredAt(); !ok {
+		return &ValidationError{Name: "recovered_at", err: errors.New(`ent: missing required field "WriteOffRecovery.recovered_at"`)}
+	}
+	return nil
+}
+
+func (_c *WriteOffRecoveryCreate) sqlSave(ctx context.Context) (*WriteOffRecovery, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WriteOffRecoveryCreate) createSpec() (*WriteOffRecovery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WriteOffRecovery{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(writeoffrecovery.Table, sqlgraph.NewFieldSpec(writeoffrecovery.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(writeoffrecovery.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.WriteOffID(); ok {
+		_spec.SetField(writeoffrecovery.FieldWriteOffID, field.TypeUUID, value)
+		_node.WriteOffID = value
+	}
+	if value, ok := _c.mutation.InvoiceID(); ok {
+		_spec.SetField(writeoffrecovery.FieldInvoiceID, field.TypeUUID, value)
+		_node.InvoiceID = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(writeoffrecovery.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(writeoffrecovery.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.JournalEntryID(); ok {
+		_spec.SetField(writeoffrecovery.FieldJournalEntryID, field.TypeUUID, value)
+		_node.JournalEntryID = value
+	}
+	if value, ok := _c.mutation.RecoveredBy(); ok {
+		_spec.SetField(writeoffrecovery.FieldRecoveredBy, field.TypeUUID, value)
+		_node.RecoveredBy = value
+	}
+	if value, ok := _c.mutation.RecoveredAt(); ok {
+		_spec.SetField(writeoffrecovery.FieldRecoveredAt, field.TypeTime, value)
+		_node.RecoveredAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WriteOffRecovery.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WriteOffRecoveryUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WriteOffRecoveryCreate) OnConflict(opts ...sql.ConflictOption) *WriteOffRecoveryUpsertOne {
+	_c.conflict = opts
+	return &WriteOffRecoveryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WriteOffRecovery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WriteOffRecoveryCreate) OnConflictColumns(columns ...string) *WriteOffRecoveryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WriteOffRecoveryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WriteOffRecoveryUpsertOne is the builder for "upsert"-ing
+	//  one WriteOffRecovery node.
+	WriteOffRecoveryUpsertOne struct {
+		create *WriteOffRecoveryCreate
+	}
+
+	// WriteOffRecoveryUpsert is the "OnConflict" setter.
+	WriteOffRecoveryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WriteOffRecoveryUpsert) SetTenantID(v uuid.UUID) *WriteOffRecoveryUpsert {
+	u.Set(writeoffrecovery.FieldTenantID, v)
+	return u
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsert) UpdateTenantID() *WriteOffRecoveryUpsert {
+	u.SetExcluded(writeoffrecovery.FieldTenantID)
+	return u
+}
+
+// SetWriteOffID sets the "write_off_id" field.
+func (u *WriteOffRecoveryUpsert) SetWriteOffID(v uuid.UUID) *WriteOffRecoveryUpsert {
+	u.Set(writeoffrecovery.FieldWriteOffID, v)
+	return u
+}
+
+// UpdateWriteOffID sets the "write_off_id" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsert) UpdateWriteOffID() *WriteOffRecoveryUpsert {
+	u.SetExcluded(writeoffrecovery.FieldWriteOffID)
+	return u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *WriteOffRecoveryUpsert) SetInvoiceID(v uuid.UUID) *WriteOffRecoveryUpsert {
+	u.Set(writeoffrecovery.FieldInvoiceID, v)
+	return u
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsert) UpdateInvoiceID() *WriteOffRecoveryUpsert {
+	u.SetExcluded(writeoffrecovery.FieldInvoiceID)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *WriteOffRecoveryUpsert) SetAmount(v decimal.Decimal) *WriteOffRecoveryUpsert {
+	u.Set(writeoffrecovery.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsert) UpdateAmount() *WriteOffRecoveryUpsert {
+	u.SetExcluded(writeoffrecovery.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *WriteOffRecoveryUpsert) AddAmount(v decimal.Decimal) *WriteOffRecoveryUpsert {
+	u.Add(writeoffrecovery.FieldAmount, v)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *WriteOffRecoveryUpsert) SetNote(v string) *WriteOffRecoveryUpsert {
+	u.Set(writeoffrecovery.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsert) UpdateNote() *WriteOffRecoveryUpsert {
+	u.SetExcluded(writeoffrecovery.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *WriteOffRecoveryUpsert) ClearNote() *WriteOffRecoveryUpsert {
+	u.SetNull(writeoffrecovery.FieldNote)
+	return u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *WriteOffRecoveryUpsert) SetJournalEntryID(v uuid.UUID) *WriteOffRecoveryUpsert {
+	u.Set(writeoffrecovery.FieldJournalEntryID, v)
+	return u
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsert) UpdateJournalEntryID() *WriteOffRecoveryUpsert {
+	u.SetExcluded(writeoffrecovery.FieldJournalEntryID)
+	return u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *WriteOffRecoveryUpsert) ClearJournalEntryID() *WriteOffRecoveryUpsert {
+	u.SetNull(writeoffrecovery.FieldJournalEntryID)
+	return u
+}
+
+// SetRecoveredBy sets the "recovered_by" field.
+func (u *WriteOffRecoveryUpsert) SetRecoveredBy(v uuid.UUID) *WriteOffRecoveryUpsert {
+	u.Set(writeoffrecovery.FieldRecoveredBy, v)
+	return u
+}
+
+// UpdateRecoveredBy sets the "recovered_by" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsert) UpdateRecoveredBy() *WriteOffRecoveryUpsert {
+	u.SetExcluded(writeoffrecovery.FieldRecoveredBy)
+	return u
+}
+
+// ClearRecoveredBy clears the value of the "recovered_by" field.
+func (u *WriteOffRecoveryUpsert) ClearRecoveredBy() *WriteOffRecoveryUpsert {
+	u.SetNull(writeoffrecovery.FieldRecoveredBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WriteOffRecovery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(writeoffrecovery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WriteOffRecoveryUpsertOne) UpdateNewValues() *WriteOffRecoveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(writeoffrecovery.FieldID)
+		}
+		if _, exists := u.create.mutation.RecoveredAt(); exists {
+			s.SetIgnore(writeoffrecovery.FieldRecoveredAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WriteOffRecovery.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WriteOffRecoveryUpsertOne) Ignore() *WriteOffRecoveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WriteOffRecoveryUpsertOne) DoNothing() *WriteOffRecoveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WriteOffRecoveryCreate.OnConflict
+// documentation for more info.
+func (u *WriteOffRecoveryUpsertOne) Update(set func(*WriteOffRecoveryUpsert)) *WriteOffRecoveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WriteOffRecoveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WriteOffRecoveryUpsertOne) SetTenantID(v uuid.UUID) *WriteOffRecoveryUpsertOne {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsertOne) UpdateTenantID() *WriteOffRecoveryUpsertOne {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetWriteOffID sets the "write_off_id" field.
+func (u *WriteOffRecoveryUpsertOne) SetWriteOffID(v uuid.UUID) *WriteOffRecoveryUpsertOne {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.SetWriteOffID(v)
+	})
+}
+
+// UpdateWriteOffID sets the "write_off_id" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsertOne) UpdateWriteOffID() *WriteOffRecoveryUpsertOne {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.UpdateWriteOffID()
+	})
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *WriteOffRecoveryUpsertOne) SetInvoiceID(v uuid.UUID) *WriteOffRecoveryUpsertOne {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.SetInvoiceID(v)
+	})
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsertOne) UpdateInvoiceID() *WriteOffRecoveryUpsertOne {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.UpdateInvoiceID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *WriteOffRecoveryUpsertOne) SetAmount(v decimal.Decimal) *WriteOffRecoveryUpsertOne {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *WriteOffRecoveryUpsertOne) AddAmount(v decimal.Decimal) *WriteOffRecoveryUpsertOne {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsertOne) UpdateAmount() *WriteOffRecoveryUpsertOne {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *WriteOffRecoveryUpsertOne) SetNote(v string) *WriteOffRecoveryUpsertOne {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsertOne) UpdateNote() *WriteOffRecoveryUpsertOne {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *WriteOffRecoveryUpsertOne) ClearNote() *WriteOffRecoveryUpsertOne {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *WriteOffRecoveryUpsertOne) SetJournalEntryID(v uuid.UUID) *WriteOffRecoveryUpsertOne {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.SetJournalEntryID(v)
+	})
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsertOne) UpdateJournalEntryID() *WriteOffRecoveryUpsertOne {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.UpdateJournalEntryID()
+	})
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *WriteOffRecoveryUpsertOne) ClearJournalEntryID() *WriteOffRecoveryUpsertOne {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.ClearJournalEntryID()
+	})
+}
+
+// SetRecoveredBy sets the "recovered_by" field.
+func (u *WriteOffRecoveryUpsertOne) SetRecoveredBy(v uuid.UUID) *WriteOffRecoveryUpsertOne {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.SetRecoveredBy(v)
+	})
+}
+
+// UpdateRecoveredBy sets the "recovered_by" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsertOne) UpdateRecoveredBy() *WriteOffRecoveryUpsertOne {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.UpdateRecoveredBy()
+	})
+}
+
+// ClearRecoveredBy clears the value of the "recovered_by" field.
+func (u *WriteOffRecoveryUpsertOne) ClearRecoveredBy() *WriteOffRecoveryUpsertOne {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.ClearRecoveredBy()
+	})
+}
+
+// Exec executes the query.
+func (u *WriteOffRecoveryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WriteOffRecoveryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WriteOffRecoveryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WriteOffRecoveryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WriteOffRecoveryUpsertOne.ID is not supported by MySQL driver. Use WriteOffRecoveryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WriteOffRecoveryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WriteOffRecoveryCreateBulk is the builder for creating many WriteOffRecovery entities in bulk.
+type WriteOffRecoveryCreateBulk struct {
+	config
+	err      error
+	builders []*WriteOffRecoveryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WriteOffRecovery entities in the database.
+func (_c *WriteOffRecoveryCreateBulk) Save(ctx context.Context) ([]*WriteOffRecovery, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WriteOffRecovery, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WriteOffRecoveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WriteOffRecoveryCreateBulk) SaveX(ctx context.Context) []*WriteOffRecovery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WriteOffRecoveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WriteOffRecoveryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WriteOffRecovery.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WriteOffRecoveryUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WriteOffRecoveryCreateBulk) OnConflict(opts ...sql.ConflictOption) *WriteOffRecoveryUpsertBulk {
+	_c.conflict = opts
+	return &WriteOffRecoveryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WriteOffRecovery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WriteOffRecoveryCreateBulk) OnConflictColumns(columns ...string) *WriteOffRecoveryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WriteOffRecoveryUpsertBulk{
+		create: _c,
+	}
+}
+
+// WriteOffRecoveryUpsertBulk is the builder for "upsert"-ing
+// a bulk of WriteOffRecovery nodes.
+type WriteOffRecoveryUpsertBulk struct {
+	create *WriteOffRecoveryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WriteOffRecovery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(writeoffrecovery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WriteOffRecoveryUpsertBulk) UpdateNewValues() *WriteOffRecoveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(writeoffrecovery.FieldID)
+			}
+			if _, exists := b.mutation.RecoveredAt(); exists {
+				s.SetIgnore(writeoffrecovery.FieldRecoveredAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WriteOffRecovery.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WriteOffRecoveryUpsertBulk) Ignore() *WriteOffRecoveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WriteOffRecoveryUpsertBulk) DoNothing() *WriteOffRecoveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WriteOffRecoveryCreateBulk.OnConflict
+// documentation for more info.
+func (u *WriteOffRecoveryUpsertBulk) Update(set func(*WriteOffRecoveryUpsert)) *WriteOffRecoveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WriteOffRecoveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (u *WriteOffRecoveryUpsertBulk) SetTenantID(v uuid.UUID) *WriteOffRecoveryUpsertBulk {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.SetTenantID(v)
+	})
+}
+
+// UpdateTenantID sets the "tenant_id" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsertBulk) UpdateTenantID() *WriteOffRecoveryUpsertBulk {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.UpdateTenantID()
+	})
+}
+
+// SetWriteOffID sets the "write_off_id" field.
+func (u *WriteOffRecoveryUpsertBulk) SetWriteOffID(v uuid.UUID) *WriteOffRecoveryUpsertBulk {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.SetWriteOffID(v)
+	})
+}
+
+// UpdateWriteOffID sets the "write_off_id" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsertBulk) UpdateWriteOffID() *WriteOffRecoveryUpsertBulk {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.UpdateWriteOffID()
+	})
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *WriteOffRecoveryUpsertBulk) SetInvoiceID(v uuid.UUID) *WriteOffRecoveryUpsertBulk {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.SetInvoiceID(v)
+	})
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsertBulk) UpdateInvoiceID() *WriteOffRecoveryUpsertBulk {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.UpdateInvoiceID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *WriteOffRecoveryUpsertBulk) SetAmount(v decimal.Decimal) *WriteOffRecoveryUpsertBulk {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *WriteOffRecoveryUpsertBulk) AddAmount(v decimal.Decimal) *WriteOffRecoveryUpsertBulk {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsertBulk) UpdateAmount() *WriteOffRecoveryUpsertBulk {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *WriteOffRecoveryUpsertBulk) SetNote(v string) *WriteOffRecoveryUpsertBulk {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsertBulk) UpdateNote() *WriteOffRecoveryUpsertBulk {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *WriteOffRecoveryUpsertBulk) ClearNote() *WriteOffRecoveryUpsertBulk {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *WriteOffRecoveryUpsertBulk) SetJournalEntryID(v uuid.UUID) *WriteOffRecoveryUpsertBulk {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.SetJournalEntryID(v)
+	})
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsertBulk) UpdateJournalEntryID() *WriteOffRecoveryUpsertBulk {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.UpdateJournalEntryID()
+	})
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *WriteOffRecoveryUpsertBulk) ClearJournalEntryID() *WriteOffRecoveryUpsertBulk {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.ClearJournalEntryID()
+	})
+}
+
+// SetRecoveredBy sets the "recovered_by" field.
+func (u *WriteOffRecoveryUpsertBulk) SetRecoveredBy(v uuid.UUID) *WriteOffRecoveryUpsertBulk {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.SetRecoveredBy(v)
+	})
+}
+
+// UpdateRecoveredBy sets the "recovered_by" field to the value that was provided on create.
+func (u *WriteOffRecoveryUpsertBulk) UpdateRecoveredBy() *WriteOffRecoveryUpsertBulk {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.UpdateRecoveredBy()
+	})
+}
+
+// ClearRecoveredBy clears the value of the "recovered_by" field.
+func (u *WriteOffRecoveryUpsertBulk) ClearRecoveredBy() *WriteOffRecoveryUpsertBulk {
+	return u.Update(func(s *WriteOffRecoveryUpsert) {
+		s.ClearRecoveredBy()
+	})
+}
+
+// Exec executes the query.
+func (u *WriteOffRecoveryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WriteOffRecoveryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WriteOffRecoveryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WriteOffRecoveryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
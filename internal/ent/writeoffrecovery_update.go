@@ -0,0 +1,489 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/writeoffrecovery"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WriteOffRecoveryUpdate is the builder for updating WriteOffRecovery entities.
+type WriteOffRecoveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WriteOffRecoveryMutation
+}
+
+// Where appends a list predicates to the WriteOffRecoveryUpdate builder.
+func (_u *WriteOffRecoveryUpdate) Where(ps ...predicate.WriteOffRecovery) *WriteOffRecoveryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *WriteOffRecoveryUpdate) SetTenantID(v uuid.UUID) *WriteOffRecoveryUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *WriteOffRecoveryUpdate) SetNillableTenantID(v *uuid.UUID) *WriteOffRecoveryUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetWriteOffID sets the "write_off_id" field.
+func (_u *WriteOffRecoveryUpdate) SetWriteOffID(v uuid.UUID) *WriteOffRecoveryUpdate {
+	_u.mutation.SetWriteOffID(v)
+	return _u
+}
+
+// SetNillableWriteOffID sets the "write_off_id" field if the given value is not nil.
+func (_u *WriteOffRecoveryUpdate) SetNillableWriteOffID(v *uuid.UUID) *WriteOffRecoveryUpdate {
+	if v != nil {
+		_u.SetWriteOffID(*v)
+	}
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *WriteOffRecoveryUpdate) SetInvoiceID(v uuid.UUID) *WriteOffRecoveryUpdate {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *WriteOffRecoveryUpdate) SetNillableInvoiceID(v *uuid.UUID) *WriteOffRecoveryUpdate {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *WriteOffRecoveryUpdate) SetAmount(v decimal.Decimal) *WriteOffRecoveryUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *WriteOffRecoveryUpdate) SetNillableAmount(v *decimal.Decimal) *WriteOffRecoveryUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *WriteOffRecoveryUpdate) AddAmount(v decimal.Decimal) *WriteOffRecoveryUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *WriteOffRecoveryUpdate) SetNote(v string) *WriteOffRecoveryUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *WriteOffRecoveryUpdate) SetNillableNote(v *string) *WriteOffRecoveryUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *WriteOffRecoveryUpdate) ClearNote() *WriteOffRecoveryUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_u *WriteOffRecoveryUpdate) SetJournalEntryID(v uuid.UUID) *WriteOffRecoveryUpdate {
+	_u.mutation.SetJournalEntryID(v)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_u *WriteOffRecoveryUpdate) SetNillableJournalEntryID(v *uuid.UUID) *WriteOffRecoveryUpdate {
+	if v != nil {
+		_u.SetJournalEntryID(*v)
+	}
+	return _u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (_u *WriteOffRecoveryUpdate) ClearJournalEntryID() *WriteOffRecoveryUpdate {
+	_u.mutation.ClearJournalEntryID()
+	return _u
+}
+
+// SetRecoveredBy sets the "recovered_by" field.
+func (_u *WriteOffRecoveryUpdate) SetRecoveredBy(v uuid.UUID) *WriteOffRecoveryUpdate {
+	_u.mutation.SetRecoveredBy(v)
+	return _u
+}
+
+// SetNillableRecoveredBy sets the "recovered_by" field if the given value is not nil.
+func (_u *WriteOffRecoveryUpdate) SetNillableRecoveredBy(v *uuid.UUID) *WriteOffRecoveryUpdate {
+	if v != nil {
+		_u.SetRecoveredBy(*v)
+	}
+	return _u
+}
+
+// ClearRecoveredBy clears the value of the "recovered_by" field.
+func (_u *WriteOffRecoveryUpdate) ClearRecoveredBy() *WriteOffRecoveryUpdate {
+	_u.mutation.ClearRecoveredBy()
+	return _u
+}
+
+// Mutation returns the WriteOffRecoveryMutation object of the builder.
+func (_u *WriteOffRecoveryUpdate) Mutation() *WriteOffRecoveryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WriteOffRecoveryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WriteOffRecoveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WriteOffRecoveryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WriteOffRecoveryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *WriteOffRecoveryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(writeoffrecovery.Table, writeoffrecovery.Columns, sqlgraph.NewFieldSpec(writeoffrecovery.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(writeoffrecovery.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.WriteOffID(); ok {
+		_spec.SetField(writeoffrecovery.FieldWriteOffID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.InvoiceID(); ok {
+		_spec.SetField(writeoffrecovery.FieldInvoiceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(writeoffrecovery.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(writeoffrecovery.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(writeoffrecovery.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(writeoffrecovery.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.JournalEntryID(); ok {
+		_spec.SetField(writeoffrecovery.FieldJournalEntryID, field.TypeUUID, value)
+	}
+	if _u.mutation.JournalEntryIDCleared() {
+		_spec.ClearField(writeoffrecovery.FieldJournalEntryID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.RecoveredBy(); ok {
+		_spec.SetField(writeoffrecovery.FieldRecoveredBy, field.TypeUUID, value)
+	}
+	if _u.mutation.RecoveredByCleared() {
+		_spec.ClearField(writeoffrecovery.FieldRecoveredBy, field.TypeUUID)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{writeoffrecovery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WriteOffRecoveryUpdateOne is the builder for updating a single WriteOffRecovery entity.
+type WriteOffRecoveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WriteOffRecoveryMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *WriteOffRecoveryUpdateOne) SetTenantID(v uuid.UUID) *WriteOffRecoveryUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *WriteOffRecoveryUpdateOne) SetNillableTenantID(v *uuid.UUID) *WriteOffRecoveryUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetWriteOffID sets the "write_off_id" field.
+func (_u *WriteOffRecoveryUpdateOne) SetWriteOffID(v uuid.UUID) *WriteOffRecoveryUpdateOne {
+	_u.mutation.SetWriteOffID(v)
+	return _u
+}
+
+// SetNillableWriteOffID sets the "write_off_id" field if the given value is not nil.
+func (_u *WriteOffRecoveryUpdateOne) SetNillableWriteOffID(v *uuid.UUID) *WriteOffRecoveryUpdateOne {
+	if v != nil {
+		_u.SetWriteOffID(*v)
+	}
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *WriteOffRecoveryUpdateOne) SetInvoiceID(v uuid.UUID) *WriteOffRecoveryUpdateOne {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *WriteOffRecoveryUpdateOne) SetNillableInvoiceID(v *uuid.UUID) *WriteOffRecoveryUpdateOne {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *WriteOffRecoveryUpdateOne) SetAmount(v decimal.Decimal) *WriteOffRecoveryUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *WriteOffRecoveryUpdateOne) SetNillableAmount(v *decimal.Decimal) *WriteOffRecoveryUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *WriteOffRecoveryUpdateOne) AddAmount(v decimal.Decimal) *WriteOffRecoveryUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *WriteOffRecoveryUpdateOne) SetNote(v string) *WriteOffRecoveryUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *WriteOffRecoveryUpdateOne) SetNillableNote(v *string) *WriteOffRecoveryUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *WriteOffRecoveryUpdateOne) ClearNote() *WriteOffRecoveryUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_u *WriteOffRecoveryUpdateOne) SetJournalEntryID(v uuid.UUID) *WriteOffRecoveryUpdateOne {
+	_u.mutation.SetJournalEntryID(v)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (_u *WriteOffRecoveryUpdateOne) SetNillableJournalEntryID(v *uuid.UUID) *WriteOffRecoveryUpdateOne {
+	if v != nil {
+		_u.SetJournalEntryID(*v)
+	}
+	return _u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (_u *WriteOffRecoveryUpdateOne) ClearJournalEntryID() *WriteOffRecoveryUpdateOne {
+	_u.mutation.ClearJournalEntryID()
+	return _u
+}
+
+// SetRecoveredBy sets the "recovered_by" field.
+func (_u *WriteOffRecoveryUpdateOne) SetRecoveredBy(v uuid.UUID) *WriteOffRecoveryUpdateOne {
+	_u.mutation.SetRecoveredBy(v)
+	return _u
+}
+
+// SetNillableRecoveredBy sets the "recovered_by" field if the given value is not nil.
+func (_u *WriteOffRecoveryUpdateOne) SetNillableRecoveredBy(v *uuid.UUID) *WriteOffRecoveryUpdateOne {
+	if v != nil {
+		_u.SetRecoveredBy(*v)
+	}
+	return _u
+}
+
+// ClearRecoveredBy clears the value of the "recovered_by" field.
+func (_u *WriteOffRecoveryUpdateOne) ClearRecoveredBy() *WriteOffRecoveryUpdateOne {
+	_u.mutation.ClearRecoveredBy()
+	return _u
+}
+
+// Mutation returns the WriteOffRecoveryMutation object of the builder.
+func (_u *WriteOffRecoveryUpdateOne) Mutation() *WriteOffRecoveryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WriteOffRecoveryUpdate builder.
+func (_u *WriteOffRecoveryUpdateOne) Where(ps ...predicate.WriteOffRecovery) *WriteOffRecoveryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WriteOffRecoveryUpdateOne) Select(field string, fields ...string) *WriteOffRecoveryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WriteOffRecovery entity.
+func (_u *WriteOffRecoveryUpdateOne) Save(ctx context.Context) (*WriteOffRecovery, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WriteOffRecoveryUpdateOne) SaveX(ctx context.Context) *WriteOffRecovery {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WriteOffRecoveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WriteOffRecoveryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *WriteOffRecoveryUpdateOne) sqlSave(ctx context.Context) (_node *WriteOffRecovery, err error) {
+	_spec := sqlgraph.NewUpdateSpec(writeoffrecovery.Table, writeoffrecovery.Columns, sqlgraph.NewFieldSpec(writeoffrecovery.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WriteOffRecovery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, writeoffrecovery.FieldID)
+		for _, f := range fields {
+			if !writeoffrecovery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != writeoffrecovery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(writeoffrecovery.FieldTenantID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.WriteOffID(); ok {
+		_spec.SetField(writeoffrecovery.FieldWriteOffID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.InvoiceID(); ok {
+		_spec.SetField(writeoffrecovery.FieldInvoiceID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(writeoffrecovery.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(writeoffrecovery.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(writeoffrecovery.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(writeoffrecovery.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.JournalEntryID(); ok {
+		_spec.SetField(writeoffrecovery.FieldJournalEntryID, field.TypeUUID, value)
+	}
+	if _u.mutation.JournalEntryIDCleared() {
+		_spec.ClearField(writeoffrecovery.FieldJournalEntryID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.RecoveredBy(); ok {
+		_spec.SetField(writeoffrecovery.FieldRecoveredBy, field.TypeUUID, value)
+	}
+	if _u.mutation.RecoveredByCleared() {
+		_spec.ClearField(writeoffrecovery.FieldRecoveredBy, field.TypeUUID)
+	}
+	_node = &WriteOffRecovery{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{writeoffrecovery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/aging"
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// Aging handles aging reports.
+type Aging struct {
+	logger      *zap.Logger
+	service     *aging.Service
+	rbacService *rbac.Service
+}
+
+// NewAging creates a new aging report handler.
+func NewAging(logger *zap.Logger, service *aging.Service, rbacService *rbac.Service) *Aging {
+	return &Aging{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// ReceivablesAging returns the AR aging report as JSON, or CSV with format=csv.
+func (h *Aging) ReceivablesAging(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	opts, ok := agingOptions(w, r)
+	if !ok {
+		return
+	}
+
+	report, err := h.service.Receivables(r.Context(), tenantID, opts)
+	if err != nil {
+		h.respondServiceError(w, "failed to build AR aging report", err)
+		return
+	}
+
+	h.respondReport(w, r, "ar-aging", report)
+}
+
+// PayablesAging returns the AP aging report as JSON, or CSV with format=csv.
+// vendor_id restricts the report to one vendor.
+func (h *Aging) PayablesAging(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	opts, ok := agingOptions(w, r)
+	if !ok {
+		return
+	}
+	if raw := r.URL.Query().Get("vendor_id"); raw != "" {
+		vendorID, err := uuid.Parse(raw)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "invalid vendor_id")
+			return
+		}
+		opts.VendorIDs = []uuid.UUID{vendorID}
+	}
+
+	report, err := h.service.Payables(r.Context(), tenantID, opts)
+	if err != nil {
+		h.respondServiceError(w, "failed to build AP aging report", err)
+		return
+	}
+
+	h.respondReport(w, r, "ap-aging", report)
+}
+
+// CashRequirements returns the weekly forecast of outgoing vendor payments as
+// JSON, or CSV with format=csv.
+func (h *Aging) CashRequirements(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	from, err := dateQuery(r, "from", time.Now().UTC())
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var weeks int
+	if raw := r.URL.Query().Get("weeks"); raw != "" {
+		weeks, err = strconv.Atoi(raw)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "invalid weeks")
+			return
+		}
+	}
+
+	forecast, err := h.service.CashRequirements(r.Context(), tenantID, aging.CashOptions{
+		From:     from,
+		Weeks:    weeks,
+		Currency: r.URL.Query().Get("currency"),
+		Mode:     r.URL.Query().Get("mode"),
+	})
+	if err != nil {
+		h.respondServiceError(w, "failed to build cash requirements", err)
+		return
+	}
+
+	switch r.URL.Query().Get("format") {
+	case "", "json":
+		respondJSON(w, http.StatusOK, forecast)
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("cash-requirements-%s.csv", forecast.From.Format(dateLayout))))
+		w.WriteHeader(http.StatusOK)
+		if err := forecast.WriteCSV(w); err != nil {
+			h.logger.Error("failed to write cash requirements csv", zap.Error(err))
+		}
+	default:
+		respondError(w, http.StatusBadRequest, "invalid format: expected json or csv")
+	}
+}
+
+func (h *Aging) respondReport(w http.ResponseWriter, r *http.Request, name string, report *aging.Report) {
+	switch r.URL.Query().Get("format") {
+	case "", "json":
+		respondJSON(w, http.StatusOK, report)
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s-%s.csv", name, report.AsOf.Format(dateLayout))))
+		w.WriteHeader(http.StatusOK)
+		if err := report.WriteCSV(w); err != nil {
+			h.logger.Error("failed to write aging report csv", zap.Error(err))
+		}
+	default:
+		respondError(w, http.StatusBadRequest, "invalid format: expected json or csv")
+	}
+}
+
+func (h *Aging) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, aging.ErrInvalidOptions):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// agingOptions parses the as_of, buckets, basis, group_by and mode query parameters.
+func agingOptions(w http.ResponseWriter, r *http.Request) (aging.Options, bool) {
+	asOf, err := dateQuery(r, "as_of", time.Now().UTC())
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return aging.Options{}, false
+	}
+
+	boundaries, err := aging.ParseBoundaries(r.URL.Query().Get("buckets"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return aging.Options{}, false
+	}
+
+	return aging.Options{
+		AsOf:       asOf,
+		Boundaries: boundaries,
+		Basis:      r.URL.Query().Get("basis"),
+		GroupBy:    r.URL.Query().Get("group_by"),
+		Mode:       r.URL.Query().Get("mode"),
+	}, true
+}
+
+// RegisterRoutes registers aging report routes.
+func (h *Aging) RegisterRoutes(r chi.Router) {
+	receivablesView := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.view")
+	payablesView := middleware.RequirePermission(h.rbacService, h.logger, "treasury.bills.view")
+
+	r.With(receivablesView).Get("/reports/ar-aging", h.ReceivablesAging)
+	r.With(payablesView).Get("/reports/ap-aging", h.PayablesAging)
+	r.With(payablesView).Get("/reports/cash-requirements", h.CashRequirements)
+}
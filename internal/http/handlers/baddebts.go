@@ -0,0 +1,314 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/baddebts"
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// BadDebts handles invoice write-offs, recoveries and doubtful debt provisioning.
+type BadDebts struct {
+	logger      *zap.Logger
+	service     *baddebts.Service
+	rbacService *rbac.Service
+}
+
+// NewBadDebts creates a new bad debt handler.
+func NewBadDebts(logger *zap.Logger, service *baddebts.Service, rbacService *rbac.Service) *BadDebts {
+	return &BadDebts{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// RequestWriteOff requests approval to write off an invoice balance.
+func (h *BadDebts) RequestWriteOff(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	invoiceID, err := uuidParam(r, "invoiceID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid invoice ID")
+		return
+	}
+
+	var req baddebts.WriteOffRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	writeOff, err := h.service.RequestWriteOff(r.Context(), tenantID, invoiceID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to request write-off", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, writeOff)
+}
+
+// ListWriteOffs returns the tenant's write-offs, optionally filtered by status.
+func (h *BadDebts) ListWriteOffs(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	writeOffs, err := h.service.ListWriteOffs(r.Context(), tenantID, r.URL.Query().Get("status"))
+	if err != nil {
+		h.respondServiceError(w, "failed to list write-offs", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"write_offs": writeOffs})
+}
+
+// GetWriteOff returns a write-off with its recoveries.
+func (h *BadDebts) GetWriteOff(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	writeOffID, err := uuidParam(r, "writeOffID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid write-off ID")
+		return
+	}
+
+	writeOff, err := h.service.GetWriteOff(r.Context(), tenantID, writeOffID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get write-off", err)
+		return
+	}
+
+	recoveries, err := h.service.ListRecoveries(r.Context(), tenantID, writeOffID)
+	if err != nil {
+		h.respondServiceError(w, "failed to list write-off recoveries", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"write_off":  writeOff,
+		"recoveries": recoveries,
+	})
+}
+
+// ApproveWriteOff approves a pending write-off and posts it to the ledger.
+func (h *BadDebts) ApproveWriteOff(w http.ResponseWriter, r *http.Request) {
+	h.decide(w, r, h.service.ApproveWriteOff, "failed to approve write-off")
+}
+
+// RejectWriteOff rejects a pending write-off.
+func (h *BadDebts) RejectWriteOff(w http.ResponseWriter, r *http.Request) {
+	h.decide(w, r, h.service.RejectWriteOff, "failed to reject write-off")
+}
+
+// RecoverWriteOff reinstates part of a written-off balance on its invoice.
+func (h *BadDebts) RecoverWriteOff(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	writeOffID, err := uuidParam(r, "writeOffID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid write-off ID")
+		return
+	}
+
+	var req baddebts.RecoveryRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	recovery, err := h.service.RecoverWriteOff(r.Context(), tenantID, writeOffID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to recover write-off", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, recovery)
+}
+
+// GetPolicy returns the tenant's provisioning policy.
+func (h *BadDebts) GetPolicy(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	policy, err := h.service.GetPolicy(r.Context(), tenantID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get provisioning policy", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, policy)
+}
+
+// SavePolicy replaces the tenant's provisioning policy.
+func (h *BadDebts) SavePolicy(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req baddebts.PolicyRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	policy, err := h.service.SavePolicy(r.Context(), tenantID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to save provisioning policy", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, policy)
+}
+
+// ListRuns returns the tenant's provisioning runs.
+func (h *BadDebts) ListRuns(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	runs, err := h.service.ListRuns(r.Context(), tenantID, r.URL.Query().Get("currency"))
+	if err != nil {
+		h.respondServiceError(w, "failed to list provisioning runs", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"runs": runs})
+}
+
+// Provision calculates the allowance for doubtful debts as of a date and posts
+// the adjustment.
+func (h *BadDebts) Provision(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req struct {
+		AsOf string `json:"as_of"`
+	}
+	if r.ContentLength != 0 {
+		if err := decodeJSON(r, &req); err != nil {
+			respondError(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+	}
+
+	asOf := time.Now().UTC()
+	if req.AsOf != "" {
+		asOf, err = time.Parse(dateLayout, req.AsOf)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "as_of must be a date (YYYY-MM-DD)")
+			return
+		}
+	}
+
+	runs, err := h.service.Provision(r.Context(), tenantID, asOf, baddebts.TriggerManual, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to provision doubtful debts", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, map[string]interface{}{"runs": runs})
+}
+
+// decide applies an approval or rejection to a pending write-off.
+func (h *BadDebts) decide(w http.ResponseWriter, r *http.Request, apply func(context.Context, uuid.UUID, uuid.UUID, baddebts.Decision, *uuid.UUID) (*baddebts.WriteOff, error), message string) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	writeOffID, err := uuidParam(r, "writeOffID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid write-off ID")
+		return
+	}
+
+	var decision baddebts.Decision
+	if r.ContentLength != 0 {
+		if err := decodeJSON(r, &decision); err != nil {
+			respondError(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+	}
+
+	writeOff, err := apply(r.Context(), tenantID, writeOffID, decision, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, message, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, writeOff)
+}
+
+func (h *BadDebts) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, baddebts.ErrWriteOffNotFound), errors.Is(err, baddebts.ErrInvoiceNotFound),
+		errors.Is(err, baddebts.ErrPolicyNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, baddebts.ErrWriteOffPending), errors.Is(err, baddebts.ErrWriteOffDecided),
+		errors.Is(err, baddebts.ErrProvisionExists):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, baddebts.ErrSelfApproval):
+		respondError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, baddebts.ErrInvalidWriteOff), errors.Is(err, baddebts.ErrInvalidPolicy):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers write-off and provisioning routes.
+func (h *BadDebts) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.view")
+	edit := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.edit")
+	approve := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.approve")
+	configView := middleware.RequirePermission(h.rbacService, h.logger, "treasury.config.view")
+	configManage := middleware.RequirePermission(h.rbacService, h.logger, "treasury.config.manage")
+	ledgerView := middleware.RequirePermission(h.rbacService, h.logger, "treasury.ledger.view")
+	ledgerPost := middleware.RequirePermission(h.rbacService, h.logger, "treasury.ledger.post")
+
+	r.With(edit).Post("/invoices/{invoiceID}/write-offs", h.RequestWriteOff)
+	r.With(view).Get("/write-offs", h.ListWriteOffs)
+	r.With(view).Get("/write-offs/{writeOffID}", h.GetWriteOff)
+	r.With(approve).Post("/write-offs/{writeOffID}/approve", h.ApproveWriteOff)
+	r.With(approve).Post("/write-offs/{writeOffID}/reject", h.RejectWriteOff)
+	r.With(edit).Post("/write-offs/{writeOffID}/recoveries", h.RecoverWriteOff)
+	r.With(configView).Get("/provisioning/policy", h.GetPolicy)
+	r.With(configManage).Put("/provisioning/policy", h.SavePolicy)
+	r.With(ledgerView).Get("/provisioning/runs", h.ListRuns)
+	r.With(ledgerPost).Post("/provisioning/runs", h.Provision)
+}
@@ -0,0 +1,438 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/banking"
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// maxStatementFileSize bounds statement uploads.
+const maxStatementFileSize = 20 << 20
+
+// Banking handles the tenant's bank accounts and statement imports.
+type Banking struct {
+	logger      *zap.Logger
+	service     *banking.Service
+	rbacService *rbac.Service
+}
+
+// NewBanking creates a new banking handler.
+func NewBanking(logger *zap.Logger, service *banking.Service, rbacService *rbac.Service) *Banking {
+	return &Banking{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// CreateBankAccount registers a bank account.
+func (h *Banking) CreateBankAccount(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req banking.CreateRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	account, err := h.service.Create(r.Context(), tenantID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to create bank account", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, account)
+}
+
+// ListBankAccounts lists bank accounts, optionally filtered by type, status
+// and currency.
+func (h *Banking) ListBankAccounts(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	accounts, err := h.service.List(r.Context(), tenantID, banking.ListFilter{
+		AccountType: r.URL.Query().Get("account_type"),
+		Status:      r.URL.Query().Get("status"),
+		Currency:    r.URL.Query().Get("currency"),
+	})
+	if err != nil {
+		h.respondServiceError(w, "failed to list bank accounts", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"bank_accounts": accounts})
+}
+
+// GetBankAccount returns a bank account.
+func (h *Banking) GetBankAccount(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	bankAccountID, err := uuidParam(r, "bankAccountID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid bank account ID")
+		return
+	}
+
+	account, err := h.service.Get(r.Context(), tenantID, bankAccountID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get bank account", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, account)
+}
+
+// UpdateBankAccount changes a bank account's details.
+func (h *Banking) UpdateBankAccount(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	bankAccountID, err := uuidParam(r, "bankAccountID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid bank account ID")
+		return
+	}
+
+	var req banking.UpdateRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	account, err := h.service.Update(r.Context(), tenantID, bankAccountID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to update bank account", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, account)
+}
+
+// CloseBankAccount closes a bank account with a zero book balance. Closed
+// accounts are kept so their statements and reconciliations stay readable.
+func (h *Banking) CloseBankAccount(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	bankAccountID, err := uuidParam(r, "bankAccountID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid bank account ID")
+		return
+	}
+
+	account, err := h.service.Close(r.Context(), tenantID, bankAccountID)
+	if err != nil {
+		h.respondServiceError(w, "failed to close bank account", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, account)
+}
+
+// GetBankAccountBalance compares the book balance at the end of as_of
+// (default today) with the last imported statement balance.
+func (h *Banking) GetBankAccountBalance(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	bankAccountID, err := uuidParam(r, "bankAccountID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid bank account ID")
+		return
+	}
+
+	asOf, err := dateQuery(r, "as_of", time.Now().UTC())
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	balance, err := h.service.Balance(r.Context(), tenantID, bankAccountID, asOf)
+	if err != nil {
+		h.respondServiceError(w, "failed to get bank account balance", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, balance)
+}
+
+// ListStatementProfiles lists the tenant's CSV statement column mappings.
+func (h *Banking) ListStatementProfiles(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	profiles, err := h.service.ListProfiles(r.Context(), tenantID)
+	if err != nil {
+		h.respondServiceError(w, "failed to list statement profiles", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"profiles": profiles})
+}
+
+// SaveStatementProfile creates or replaces a CSV statement column mapping.
+func (h *Banking) SaveStatementProfile(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req banking.SaveProfileRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	profile, err := h.service.SaveProfile(r.Context(), tenantID, chi.URLParam(r, "name"), req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to save statement profile", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, profile)
+}
+
+// ImportStatement imports a statement file uploaded as multipart form data:
+// the file in "file", its "format" (csv, mt940, camt053 or mpesa for M-Pesa
+// org portal CSV and XLSX exports), the CSV "profile", and "allow_gap=true" to accept an opening balance that does not
+// follow on from the previous statement.
+func (h *Banking) ImportStatement(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	bankAccountID, err := uuidParam(r, "bankAccountID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid bank account ID")
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxStatementFileSize)
+	if err := r.ParseMultipartForm(maxStatementFileSize); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid upload: expected multipart form data up to 20 MB")
+		return
+	}
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "file is required")
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "failed to read file")
+		return
+	}
+
+	allowGap := false
+	if value := r.FormValue("allow_gap"); value != "" {
+		allowGap, err = strconv.ParseBool(value)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "invalid allow_gap")
+			return
+		}
+	}
+
+	statement, err := h.service.Import(r.Context(), tenantID, bankAccountID, banking.ImportRequest{
+		Format:   r.FormValue("format"),
+		Profile:  r.FormValue("profile"),
+		FileName: header.Filename,
+		AllowGap: allowGap,
+	}, data, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to import bank statement", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, statement)
+}
+
+// ListStatements lists a bank account's imported statements.
+func (h *Banking) ListStatements(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	bankAccountID, err := uuidParam(r, "bankAccountID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid bank account ID")
+		return
+	}
+
+	limit, offset, err := pageQuery(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	statements, err := h.service.ListStatements(r.Context(), tenantID, bankAccountID, limit, offset)
+	if err != nil {
+		h.respondServiceError(w, "failed to list bank statements", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"statements": statements})
+}
+
+// GetStatement returns an imported statement.
+func (h *Banking) GetStatement(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	statementID, err := uuidParam(r, "statementID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid statement ID")
+		return
+	}
+
+	statement, err := h.service.GetStatement(r.Context(), tenantID, statementID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get bank statement", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, statement)
+}
+
+// ListTransactions lists imported bank transactions, optionally filtered by
+// bank account, statement, reconciliation status and a from/to date range
+// (inclusive).
+func (h *Banking) ListTransactions(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	limit, offset, err := pageQuery(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filter := banking.TransactionFilter{
+		ReconciliationStatus: r.URL.Query().Get("status"),
+		Limit:                limit,
+		Offset:               offset,
+	}
+	if value := r.URL.Query().Get("bank_account_id"); value != "" {
+		bankAccountID, err := uuid.Parse(value)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "invalid bank_account_id")
+			return
+		}
+		filter.BankAccountID = &bankAccountID
+	}
+	if value := r.URL.Query().Get("statement_id"); value != "" {
+		statementID, err := uuid.Parse(value)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "invalid statement_id")
+			return
+		}
+		filter.StatementID = &statementID
+	}
+	if r.URL.Query().Get("from") != "" {
+		from, err := dateQuery(r, "from", time.Time{})
+		if err != nil {
+			respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		filter.From = &from
+	}
+	if r.URL.Query().Get("to") != "" {
+		to, err := dateQuery(r, "to", time.Time{})
+		if err != nil {
+			respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		to = to.AddDate(0, 0, 1)
+		filter.To = &to
+	}
+
+	txns, err := h.service.ListTransactions(r.Context(), tenantID, filter)
+	if err != nil {
+		h.respondServiceError(w, "failed to list bank transactions", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"transactions": txns})
+}
+
+func (h *Banking) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, banking.ErrBankAccountNotFound), errors.Is(err, banking.ErrProfileNotFound),
+		errors.Is(err, banking.ErrStatementNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, banking.ErrDuplicateBankAccount), errors.Is(err, banking.ErrBankAccountState),
+		errors.Is(err, banking.ErrDuplicateStatement), errors.Is(err, banking.ErrBalanceContinuity):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, banking.ErrInvalidBankAccount), errors.Is(err, banking.ErrInvalidProfile),
+		errors.Is(err, banking.ErrInvalidStatement):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers banking routes.
+func (h *Banking) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.banking.view")
+	importStatements := middleware.RequirePermission(h.rbacService, h.logger, "treasury.banking.import")
+	configManage := middleware.RequirePermission(h.rbacService, h.logger, "treasury.config.manage")
+
+	r.With(view).Get("/bank-accounts", h.ListBankAccounts)
+	r.With(configManage).Post("/bank-accounts", h.CreateBankAccount)
+	r.With(view).Get("/bank-accounts/{bankAccountID}", h.GetBankAccount)
+	r.With(configManage).Put("/bank-accounts/{bankAccountID}", h.UpdateBankAccount)
+	r.With(configManage).Delete("/bank-accounts/{bankAccountID}", h.CloseBankAccount)
+	r.With(view).Get("/bank-accounts/{bankAccountID}/balance", h.GetBankAccountBalance)
+	r.With(importStatements).Post("/bank-accounts/{bankAccountID}/statements", h.ImportStatement)
+	r.With(view).Get("/bank-accounts/{bankAccountID}/statements", h.ListStatements)
+	r.With(view).Get("/bank-statements/{statementID}", h.GetStatement)
+	r.With(view).Get("/bank-transactions", h.ListTransactions)
+	r.With(view).Get("/bank-statement-profiles", h.ListStatementProfiles)
+	r.With(importStatements).Put("/bank-statement-profiles/{name}", h.SaveStatementProfile)
+}
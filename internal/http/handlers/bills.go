@@ -0,0 +1,310 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/bills"
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/modules/vendors"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// Bills handles vendor bills (accounts payable).
+type Bills struct {
+	logger      *zap.Logger
+	service     *bills.Service
+	rbacService *rbac.Service
+}
+
+// NewBills creates a new bills handler.
+func NewBills(logger *zap.Logger, service *bills.Service, rbacService *rbac.Service) *Bills {
+	return &Bills{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// CreateBill enters a draft vendor bill.
+func (h *Bills) CreateBill(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req bills.CreateRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	bill, err := h.service.Create(r.Context(), tenantID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to create bill", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, bill)
+}
+
+// ListBills lists bills, optionally filtered by status, vendor and due date.
+func (h *Bills) ListBills(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	limit, offset, err := pageQuery(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filter := bills.ListFilter{
+		Status: r.URL.Query().Get("status"),
+		Limit:  limit,
+		Offset: offset,
+	}
+	if value := r.URL.Query().Get("vendor_id"); value != "" {
+		vendorID, err := uuid.Parse(value)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "invalid vendor_id")
+			return
+		}
+		filter.VendorID = &vendorID
+	}
+	if r.URL.Query().Get("due_before") != "" {
+		dueBefore, err := dateQuery(r, "due_before", time.Time{})
+		if err != nil {
+			respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		filter.DueBefore = &dueBefore
+	}
+
+	list, err := h.service.List(r.Context(), tenantID, filter)
+	if err != nil {
+		h.respondServiceError(w, "failed to list bills", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"bills": list})
+}
+
+// GetBill returns a bill with its lines.
+func (h *Bills) GetBill(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	billID, err := uuidParam(r, "billID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid bill ID")
+		return
+	}
+
+	bill, err := h.service.Get(r.Context(), tenantID, billID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get bill", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, bill)
+}
+
+// ApproveBill posts a draft bill to accounts payable.
+func (h *Bills) ApproveBill(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, "failed to approve bill", func(ctx context.Context, tenantID, billID uuid.UUID) (*bills.Bill, error) {
+		return h.service.Approve(ctx, tenantID, billID, actorID(r))
+	})
+}
+
+// ScheduleBill sets the payment date of an approved bill.
+func (h *Bills) ScheduleBill(w http.ResponseWriter, r *http.Request) {
+	var req bills.ScheduleRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	h.transition(w, r, "failed to schedule bill", func(ctx context.Context, tenantID, billID uuid.UUID) (*bills.Bill, error) {
+		return h.service.Schedule(ctx, tenantID, billID, req)
+	})
+}
+
+// PayBill records payment of an approved or scheduled bill.
+func (h *Bills) PayBill(w http.ResponseWriter, r *http.Request) {
+	var req bills.PayRequest
+	if r.ContentLength != 0 {
+		if err := decodeJSON(r, &req); err != nil {
+			respondError(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+	}
+
+	h.transition(w, r, "failed to pay bill", func(ctx context.Context, tenantID, billID uuid.UUID) (*bills.Bill, error) {
+		return h.service.Pay(ctx, tenantID, billID, req)
+	})
+}
+
+// MatchBill matches a draft bill to its purchase order's goods receipts again.
+func (h *Bills) MatchBill(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, "failed to match bill", h.service.Match)
+}
+
+// AcceptVariance releases a bill with match variances for approval.
+func (h *Bills) AcceptVariance(w http.ResponseWriter, r *http.Request) {
+	var req bills.AcceptVarianceRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	h.transition(w, r, "failed to accept bill variance", func(ctx context.Context, tenantID, billID uuid.UUID) (*bills.Bill, error) {
+		return h.service.AcceptVariance(ctx, tenantID, billID, req, actorID(r))
+	})
+}
+
+// CancelBill voids a draft bill.
+func (h *Bills) CancelBill(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, "failed to cancel bill", h.service.Cancel)
+}
+
+// ListGoodsReceipts lists goods receipts recorded from the inventory service,
+// optionally for one purchase order.
+func (h *Bills) ListGoodsReceipts(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	limit, offset, err := pageQuery(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	receipts, err := h.service.ListReceipts(r.Context(), tenantID, r.URL.Query().Get("po_number"), limit, offset)
+	if err != nil {
+		h.respondServiceError(w, "failed to list goods receipts", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"goods_receipts": receipts})
+}
+
+// GetSettings returns the tenant's accounts payable settings.
+func (h *Bills) GetSettings(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	settings, err := h.service.GetSettings(r.Context(), tenantID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get payables settings", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, settings)
+}
+
+// UpdateSettings changes the tenant's accounts payable settings.
+func (h *Bills) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req bills.UpdateSettingsRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	settings, err := h.service.UpdateSettings(r.Context(), tenantID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to update payables settings", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, settings)
+}
+
+func (h *Bills) transition(w http.ResponseWriter, r *http.Request, message string, apply func(ctx context.Context, tenantID, billID uuid.UUID) (*bills.Bill, error)) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	billID, err := uuidParam(r, "billID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid bill ID")
+		return
+	}
+
+	bill, err := apply(r.Context(), tenantID, billID)
+	if err != nil {
+		h.respondServiceError(w, message, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, bill)
+}
+
+func (h *Bills) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, bills.ErrBillNotFound), errors.Is(err, vendors.ErrVendorNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, bills.ErrDuplicateBill), errors.Is(err, bills.ErrBillStatus),
+		errors.Is(err, bills.ErrMatchVariance):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, bills.ErrSelfApproval):
+		respondError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, bills.ErrInvalidBill), errors.Is(err, bills.ErrInvalidSettings):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers bill routes.
+func (h *Bills) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.bills.view")
+	create := middleware.RequirePermission(h.rbacService, h.logger, "treasury.bills.create")
+	edit := middleware.RequirePermission(h.rbacService, h.logger, "treasury.bills.edit")
+	approve := middleware.RequirePermission(h.rbacService, h.logger, "treasury.bills.approve")
+	acceptVariance := middleware.RequirePermission(h.rbacService, h.logger, "treasury.bills.accept_variance")
+	pay := middleware.RequirePermission(h.rbacService, h.logger, "treasury.bills.pay")
+	configView := middleware.RequirePermission(h.rbacService, h.logger, "treasury.config.view")
+	configManage := middleware.RequirePermission(h.rbacService, h.logger, "treasury.config.manage")
+
+	r.With(view).Get("/bills", h.ListBills)
+	r.With(create).Post("/bills", h.CreateBill)
+	r.With(view).Get("/bills/{billID}", h.GetBill)
+	r.With(edit).Post("/bills/{billID}/match", h.MatchBill)
+	r.With(acceptVariance).Post("/bills/{billID}/accept-variance", h.AcceptVariance)
+	r.With(approve).Post("/bills/{billID}/approve", h.ApproveBill)
+	r.With(pay).Post("/bills/{billID}/schedule", h.ScheduleBill)
+	r.With(pay).Post("/bills/{billID}/pay", h.PayBill)
+	r.With(create).Post("/bills/{billID}/cancel", h.CancelBill)
+	r.With(view).Get("/goods-receipts", h.ListGoodsReceipts)
+	r.With(configView).Get("/payables/settings", h.GetSettings)
+	r.With(configManage).Put("/payables/settings", h.UpdateSettings)
+}
@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/cashdrawers"
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// CashDrawers handles POS cash drawer sessions and their variance review.
+type CashDrawers struct {
+	logger      *zap.Logger
+	service     *cashdrawers.Service
+	rbacService *rbac.Service
+}
+
+// NewCashDrawers creates a new cash drawers handler.
+func NewCashDrawers(logger *zap.Logger, service *cashdrawers.Service, rbacService *rbac.Service) *CashDrawers {
+	return &CashDrawers{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// ListSessions lists cash drawer sessions, optionally filtered by status,
+// drawer, outlet and close date.
+func (h *CashDrawers) ListSessions(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	limit, offset, err := pageQuery(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filter := cashdrawers.ListFilter{
+		Status:   r.URL.Query().Get("status"),
+		DrawerID: r.URL.Query().Get("drawer_id"),
+		OutletID: r.URL.Query().Get("outlet_id"),
+		Limit:    limit,
+		Offset:   offset,
+	}
+	if r.URL.Query().Get("from") != "" {
+		from, err := dateQuery(r, "from", time.Time{})
+		if err != nil {
+			respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		filter.From = &from
+	}
+	if r.URL.Query().Get("to") != "" {
+		to, err := dateQuery(r, "to", time.Time{})
+		if err != nil {
+			respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		to = to.AddDate(0, 0, 1)
+		filter.To = &to
+	}
+
+	sessions, err := h.service.List(r.Context(), tenantID, filter)
+	if err != nil {
+		h.respondServiceError(w, "failed to list cash drawer sessions", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"sessions": sessions})
+}
+
+// GetSession returns a cash drawer session with its denomination counts.
+func (h *CashDrawers) GetSession(w http.ResponseWriter, r *http.Request) {
+	h.session(w, r, "failed to get cash drawer session", h.service.Get)
+}
+
+// Recount replaces the counts of a session awaiting approval or rejected.
+func (h *CashDrawers) Recount(w http.ResponseWriter, r *http.Request) {
+	var req cashdrawers.RecountRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	h.session(w, r, "failed to recount cash drawer session", func(ctx context.Context, tenantID, sessionID uuid.UUID) (*cashdrawers.Session, error) {
+		return h.service.Recount(ctx, tenantID, sessionID, req)
+	})
+}
+
+// Approve posts the variance of a session awaiting a supervisor.
+func (h *CashDrawers) Approve(w http.ResponseWriter, r *http.Request) {
+	h.session(w, r, "failed to approve cash drawer session", func(ctx context.Context, tenantID, sessionID uuid.UUID) (*cashdrawers.Session, error) {
+		return h.service.Approve(ctx, tenantID, sessionID, actorID(r))
+	})
+}
+
+// Reject sends a session awaiting a supervisor back to be recounted.
+func (h *CashDrawers) Reject(w http.ResponseWriter, r *http.Request) {
+	var req cashdrawers.RejectRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	h.session(w, r, "failed to reject cash drawer session", func(ctx context.Context, tenantID, sessionID uuid.UUID) (*cashdrawers.Session, error) {
+		return h.service.Reject(ctx, tenantID, sessionID, req, actorID(r))
+	})
+}
+
+// GetSettings returns the tenant's variance approval threshold.
+func (h *CashDrawers) GetSettings(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	settings, err := h.service.GetSettings(r.Context(), tenantID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get cash drawer settings", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, settings)
+}
+
+// UpdateSettings changes the tenant's variance approval threshold.
+func (h *CashDrawers) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req cashdrawers.UpdateSettingsRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	settings, err := h.service.UpdateSettings(r.Context(), tenantID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to update cash drawer settings", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, settings)
+}
+
+func (h *CashDrawers) session(w http.ResponseWriter, r *http.Request, message string, apply func(ctx context.Context, tenantID, sessionID uuid.UUID) (*cashdrawers.Session, error)) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	sessionID, err := uuidParam(r, "sessionID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid cash drawer session ID")
+		return
+	}
+
+	session, err := apply(r.Context(), tenantID, sessionID)
+	if err != nil {
+		h.respondServiceError(w, message, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, session)
+}
+
+func (h *CashDrawers) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, cashdrawers.ErrSessionNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, cashdrawers.ErrSessionStatus):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, cashdrawers.ErrSelfApproval):
+		respondError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, cashdrawers.ErrInvalidSession), errors.Is(err, cashdrawers.ErrInvalidCount),
+		errors.Is(err, cashdrawers.ErrInvalidSettings):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers cash drawer session routes.
+func (h *CashDrawers) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.cash_drawers.view")
+	manage := middleware.RequirePermission(h.rbacService, h.logger, "treasury.cash_drawers.manage")
+	approve := middleware.RequirePermission(h.rbacService, h.logger, "treasury.cash_drawers.approve")
+	configView := middleware.RequirePermission(h.rbacService, h.logger, "treasury.config.view")
+	configManage := middleware.RequirePermission(h.rbacService, h.logger, "treasury.config.manage")
+
+	r.With(configView).Get("/cash-drawer-sessions/settings", h.GetSettings)
+	r.With(configManage).Put("/cash-drawer-sessions/settings", h.UpdateSettings)
+	r.With(view).Get("/cash-drawer-sessions", h.ListSessions)
+	r.With(view).Get("/cash-drawer-sessions/{sessionID}", h.GetSession)
+	r.With(manage).Post("/cash-drawer-sessions/{sessionID}/recount", h.Recount)
+	r.With(approve).Post("/cash-drawer-sessions/{sessionID}/approve", h.Approve)
+	r.With(approve).Post("/cash-drawer-sessions/{sessionID}/reject", h.Reject)
+}
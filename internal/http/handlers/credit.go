@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/credit"
+	"github.com/bengobox/treasury-api/internal/modules/customers"
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// Credit handles customer credit status, credit holds and credit overrides.
+type Credit struct {
+	logger      *zap.Logger
+	service     *credit.Service
+	rbacService *rbac.Service
+}
+
+// NewCredit creates a new credit control handler.
+func NewCredit(logger *zap.Logger, service *credit.Service, rbacService *rbac.Service) *Credit {
+	return &Credit{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// Status returns a customer's available credit and credit hold status. POS
+// and cafe call it before accepting a sale on account.
+func (h *Credit) Status(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	customerID, err := uuidParam(r, "customerID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+
+	status, err := h.service.Status(r.Context(), tenantID, customerID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get credit status", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, status)
+}
+
+// PlaceHold puts a customer on manual credit hold.
+func (h *Credit) PlaceHold(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	customerID, err := uuidParam(r, "customerID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+
+	var req credit.HoldRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	status, err := h.service.PlaceHold(r.Context(), tenantID, customerID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to place credit hold", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, status)
+}
+
+// ReleaseHold lifts a customer's credit hold.
+func (h *Credit) ReleaseHold(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	customerID, err := uuidParam(r, "customerID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+
+	var req credit.HoldRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	status, err := h.service.ReleaseHold(r.Context(), tenantID, customerID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to release credit hold", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, status)
+}
+
+// GrantOverride authorises one sale on account beyond the customer's credit
+// limit or credit hold.
+func (h *Credit) GrantOverride(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	customerID, err := uuidParam(r, "customerID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+
+	var req credit.OverrideRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	override, err := h.service.GrantOverride(r.Context(), tenantID, customerID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to grant credit override", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, override)
+}
+
+// ListOverrides returns the overrides granted for a customer.
+func (h *Credit) ListOverrides(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	customerID, err := uuidParam(r, "customerID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+
+	overrides, err := h.service.ListOverrides(r.Context(), tenantID, customerID)
+	if err != nil {
+		h.respondServiceError(w, "failed to list credit overrides", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"overrides": overrides})
+}
+
+func (h *Credit) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, customers.ErrCustomerNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, credit.ErrNotOnHold):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, credit.ErrInvalidOverride):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers credit control routes.
+func (h *Credit) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.view")
+	edit := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.edit")
+	override := middleware.RequirePermission(h.rbacService, h.logger, "treasury.credit.override")
+
+	r.With(view).Get("/customers/{customerID}/credit-status", h.Status)
+	r.With(edit).Put("/customers/{customerID}/credit-hold", h.PlaceHold)
+	r.With(override).Delete("/customers/{customerID}/credit-hold", h.ReleaseHold)
+	r.With(view).Get("/customers/{customerID}/credit-overrides", h.ListOverrides)
+	r.With(override).Post("/customers/{customerID}/credit-overrides", h.GrantOverride)
+}
@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/customers"
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// Customers handles customer master records and the customer sub-ledger.
+type Customers struct {
+	logger      *zap.Logger
+	service     *customers.Service
+	rbacService *rbac.Service
+}
+
+// NewCustomers creates a new customers handler.
+func NewCustomers(logger *zap.Logger, service *customers.Service, rbacService *rbac.Service) *Customers {
+	return &Customers{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// CreateCustomer creates a customer.
+func (h *Customers) CreateCustomer(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req customers.CreateRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	c, err := h.service.Create(r.Context(), tenantID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to create customer", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, c)
+}
+
+// ListCustomers lists customers, optionally filtered by status and a search term.
+func (h *Customers) ListCustomers(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	filter := customers.ListFilter{
+		Search: r.URL.Query().Get("search"),
+		Status: r.URL.Query().Get("status"),
+		Limit:  50,
+	}
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > 500 {
+			respondError(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		filter.Limit = limit
+	}
+	if raw := r.URL.Query().Get("offset"); raw != "" {
+		offset, err := strconv.Atoi(raw)
+		if err != nil || offset < 0 {
+			respondError(w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+		filter.Offset = offset
+	}
+
+	list, err := h.service.List(r.Context(), tenantID, filter)
+	if err != nil {
+		h.respondServiceError(w, "failed to list customers", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"customers": list})
+}
+
+// GetCustomer returns a customer.
+func (h *Customers) GetCustomer(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	customerID, err := uuidParam(r, "customerID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+
+	c, err := h.service.Get(r.Context(), tenantID, customerID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get customer", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, c)
+}
+
+// UpdateCustomer changes a customer's details.
+func (h *Customers) UpdateCustomer(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	customerID, err := uuidParam(r, "customerID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+
+	var req customers.UpdateRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	c, err := h.service.Update(r.Context(), tenantID, customerID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to update customer", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, c)
+}
+
+// CustomerLedger returns the customer's invoices, credit notes and payments
+// with running balances.
+func (h *Customers) CustomerLedger(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	customerID, err := uuidParam(r, "customerID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+
+	filter := customers.LedgerFilter{Currency: r.URL.Query().Get("currency")}
+	from, err := dateQuery(r, "from", time.Time{})
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	to, err := dateQuery(r, "to", time.Time{})
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if !from.IsZero() {
+		filter.From = &from
+	}
+	if !to.IsZero() {
+		filter.To = &to
+	}
+
+	ledger, err := h.service.Ledger(r.Context(), tenantID, customerID, filter)
+	if err != nil {
+		h.respondServiceError(w, "failed to get customer ledger", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, ledger)
+}
+
+func (h *Customers) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, customers.ErrCustomerNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, customers.ErrDuplicateCustomer):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, customers.ErrInvalidCustomer):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers customer routes.
+func (h *Customers) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.view")
+	create := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.create")
+	edit := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.edit")
+
+	r.With(view).Get("/customers", h.ListCustomers)
+	r.With(create).Post("/customers", h.CreateCustomer)
+	r.With(view).Get("/customers/{customerID}", h.GetCustomer)
+	r.With(edit).Put("/customers/{customerID}", h.UpdateCustomer)
+	r.With(view).Get("/customers/{customerID}/ledger", h.CustomerLedger)
+}
@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/dunning"
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// Dunning handles dunning sequences, customer pauses and invoice dunning history.
+type Dunning struct {
+	logger      *zap.Logger
+	service     *dunning.Service
+	rbacService *rbac.Service
+}
+
+// NewDunning creates a new dunning handler.
+func NewDunning(logger *zap.Logger, service *dunning.Service, rbacService *rbac.Service) *Dunning {
+	return &Dunning{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// ListSteps returns the tenant's dunning sequence.
+func (h *Dunning) ListSteps(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	steps, err := h.service.ListSteps(r.Context(), tenantID)
+	if err != nil {
+		h.respondServiceError(w, "failed to list dunning steps", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"steps": steps})
+}
+
+// ReplaceSteps replaces the tenant's dunning sequence.
+func (h *Dunning) ReplaceSteps(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req struct {
+		Steps []dunning.Step `json:"steps"`
+	}
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	steps, err := h.service.ReplaceSteps(r.Context(), tenantID, req.Steps)
+	if err != nil {
+		h.respondServiceError(w, "failed to update dunning steps", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"steps": steps})
+}
+
+// ListPauses returns the tenant's customers with paused dunning.
+func (h *Dunning) ListPauses(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	pauses, err := h.service.ListPauses(r.Context(), tenantID)
+	if err != nil {
+		h.respondServiceError(w, "failed to list dunning pauses", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"pauses": pauses})
+}
+
+// PauseCustomer suspends dunning for a customer.
+func (h *Dunning) PauseCustomer(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	customerID, err := uuidParam(r, "customerID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+
+	var req dunning.PauseRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	pause, err := h.service.PauseCustomer(r.Context(), tenantID, customerID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to pause dunning", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, pause)
+}
+
+// ResumeCustomer removes a customer's dunning pause.
+func (h *Dunning) ResumeCustomer(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	customerID, err := uuidParam(r, "customerID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+
+	if err := h.service.ResumeCustomer(r.Context(), tenantID, customerID); err != nil {
+		h.respondServiceError(w, "failed to resume dunning", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListNotices returns the dunning history of an invoice.
+func (h *Dunning) ListNotices(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	invoiceID, err := uuidParam(r, "invoiceID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid invoice ID")
+		return
+	}
+
+	notices, err := h.service.ListNotices(r.Context(), tenantID, invoiceID)
+	if err != nil {
+		h.respondServiceError(w, "failed to list dunning notices", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"notices": notices})
+}
+
+func (h *Dunning) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, dunning.ErrInvoiceNotFound), errors.Is(err, dunning.ErrPauseNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, dunning.ErrInvalidSequence):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers dunning routes.
+func (h *Dunning) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.view")
+	edit := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.edit")
+	configView := middleware.RequirePermission(h.rbacService, h.logger, "treasury.config.view")
+	configManage := middleware.RequirePermission(h.rbacService, h.logger, "treasury.config.manage")
+
+	r.With(configView).Get("/dunning/steps", h.ListSteps)
+	r.With(configManage).Put("/dunning/steps", h.ReplaceSteps)
+	r.With(view).Get("/dunning/pauses", h.ListPauses)
+	r.With(edit).Put("/customers/{customerID}/dunning-pause", h.PauseCustomer)
+	r.With(edit).Delete("/customers/{customerID}/dunning-pause", h.ResumeCustomer)
+	r.With(view).Get("/invoices/{invoiceID}/dunning", h.ListNotices)
+}
@@ -0,0 +1,341 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/earnings"
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// Earnings handles riders' and drivers' earnings wallets and their payouts.
+type Earnings struct {
+	logger      *zap.Logger
+	service     *earnings.Service
+	rbacService *rbac.Service
+}
+
+// NewEarnings creates a new earnings handler.
+func NewEarnings(logger *zap.Logger, service *earnings.Service, rbacService *rbac.Service) *Earnings {
+	return &Earnings{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// ListWallets lists payee wallets, optionally filtered by payee type and ID.
+func (h *Earnings) ListWallets(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	limit, offset, err := pageQuery(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	wallets, err := h.service.ListWallets(r.Context(), tenantID, earnings.WalletFilter{
+		PayeeType: r.URL.Query().Get("payee_type"),
+		PayeeID:   r.URL.Query().Get("payee_id"),
+		Limit:     limit,
+		Offset:    offset,
+	})
+	if err != nil {
+		h.respondServiceError(w, "failed to list payee wallets", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"wallets": wallets})
+}
+
+// GetWallet returns a payee wallet with its balances.
+func (h *Earnings) GetWallet(w http.ResponseWriter, r *http.Request) {
+	h.wallet(w, r, "failed to get payee wallet", h.service.GetWallet)
+}
+
+// AddDeduction deducts an advance, fuel or other charge from a wallet.
+func (h *Earnings) AddDeduction(w http.ResponseWriter, r *http.Request) {
+	var req earnings.Deduction
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	h.wallet(w, r, "failed to add payee deduction", func(ctx context.Context, tenantID, walletID uuid.UUID) (*earnings.Wallet, error) {
+		return h.service.AddDeduction(ctx, tenantID, walletID, req, actorID(r))
+	})
+}
+
+// GetStatement returns a wallet's earnings statement for the from and to
+// dates as JSON, or CSV with format=csv.
+func (h *Earnings) GetStatement(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	walletID, err := uuidParam(r, "walletID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid payee wallet ID")
+		return
+	}
+
+	from, err := dateQuery(r, "from", time.Time{})
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	to, err := dateQuery(r, "to", time.Time{})
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	statement, err := h.service.Statement(r.Context(), tenantID, walletID, from, to)
+	if err != nil {
+		h.respondServiceError(w, "failed to build earnings statement", err)
+		return
+	}
+
+	switch r.URL.Query().Get("format") {
+	case "", "json":
+		respondJSON(w, http.StatusOK, statement)
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("earnings-%s-%s.csv", statement.Wallet.PayeeID, statement.To.Format(dateLayout))))
+		w.WriteHeader(http.StatusOK)
+		if err := statement.WriteCSV(w); err != nil {
+			h.logger.Error("failed to write earnings statement csv", zap.Error(err))
+		}
+	default:
+		respondError(w, http.StatusBadRequest, "invalid format: expected json or csv")
+	}
+}
+
+// RequestPayout reserves a payout from a payee's available balance.
+func (h *Earnings) RequestPayout(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req earnings.PayoutRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	payout, err := h.service.RequestPayout(r.Context(), tenantID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to request payout", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, payout)
+}
+
+// ListPayouts lists payouts; status=pending gives the queue of payouts to
+// pay.
+func (h *Earnings) ListPayouts(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	limit, offset, err := pageQuery(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filter := earnings.PayoutFilter{
+		Status: r.URL.Query().Get("status"),
+		Limit:  limit,
+		Offset: offset,
+	}
+	if raw := r.URL.Query().Get("wallet_id"); raw != "" {
+		walletID, err := uuid.Parse(raw)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "invalid wallet_id")
+			return
+		}
+		filter.WalletID = &walletID
+	}
+
+	payouts, err := h.service.ListPayouts(r.Context(), tenantID, filter)
+	if err != nil {
+		h.respondServiceError(w, "failed to list payouts", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"payouts": payouts})
+}
+
+// GetPayout returns a payout.
+func (h *Earnings) GetPayout(w http.ResponseWriter, r *http.Request) {
+	h.payout(w, r, "failed to get payout", h.service.GetPayout)
+}
+
+// CompletePayout records the payment of a pending payout.
+func (h *Earnings) CompletePayout(w http.ResponseWriter, r *http.Request) {
+	var req earnings.CompletePayoutRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	h.payout(w, r, "failed to complete payout", func(ctx context.Context, tenantID, payoutID uuid.UUID) (*earnings.Payout, error) {
+		return h.service.CompletePayout(ctx, tenantID, payoutID, req, actorID(r))
+	})
+}
+
+// RejectPayout declines a pending payout.
+func (h *Earnings) RejectPayout(w http.ResponseWriter, r *http.Request) {
+	var req earnings.RejectPayoutRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	h.payout(w, r, "failed to reject payout", func(ctx context.Context, tenantID, payoutID uuid.UUID) (*earnings.Payout, error) {
+		return h.service.RejectPayout(ctx, tenantID, payoutID, req)
+	})
+}
+
+// GetSettings returns the tenant's minimum payout.
+func (h *Earnings) GetSettings(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	settings, err := h.service.GetSettings(r.Context(), tenantID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get earnings settings", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, settings)
+}
+
+// UpdateSettings changes the tenant's minimum payout.
+func (h *Earnings) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req earnings.UpdateSettingsRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	settings, err := h.service.UpdateSettings(r.Context(), tenantID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to update earnings settings", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, settings)
+}
+
+func (h *Earnings) wallet(w http.ResponseWriter, r *http.Request, message string, apply func(ctx context.Context, tenantID, walletID uuid.UUID) (*earnings.Wallet, error)) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	walletID, err := uuidParam(r, "walletID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid payee wallet ID")
+		return
+	}
+
+	wallet, err := apply(r.Context(), tenantID, walletID)
+	if err != nil {
+		h.respondServiceError(w, message, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, wallet)
+}
+
+func (h *Earnings) payout(w http.ResponseWriter, r *http.Request, message string, apply func(ctx context.Context, tenantID, payoutID uuid.UUID) (*earnings.Payout, error)) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	payoutID, err := uuidParam(r, "payoutID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid payout ID")
+		return
+	}
+
+	payout, err := apply(r.Context(), tenantID, payoutID)
+	if err != nil {
+		h.respondServiceError(w, message, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, payout)
+}
+
+func (h *Earnings) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, earnings.ErrWalletNotFound), errors.Is(err, earnings.ErrPayoutNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, earnings.ErrPayoutStatus), errors.Is(err, earnings.ErrPayoutExists):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, earnings.ErrSelfApproval):
+		respondError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, earnings.ErrInvalidEntry), errors.Is(err, earnings.ErrInvalidPayout),
+		errors.Is(err, earnings.ErrBelowMinimum), errors.Is(err, earnings.ErrInsufficientBalance),
+		errors.Is(err, earnings.ErrInvalidPeriod), errors.Is(err, earnings.ErrInvalidSettings):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers payee wallet and payout routes.
+func (h *Earnings) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.payouts.view")
+	manage := middleware.RequirePermission(h.rbacService, h.logger, "treasury.payouts.manage")
+	approve := middleware.RequirePermission(h.rbacService, h.logger, "treasury.payouts.approve")
+	configView := middleware.RequirePermission(h.rbacService, h.logger, "treasury.config.view")
+	configManage := middleware.RequirePermission(h.rbacService, h.logger, "treasury.config.manage")
+
+	r.With(configView).Get("/payee-wallets/settings", h.GetSettings)
+	r.With(configManage).Put("/payee-wallets/settings", h.UpdateSettings)
+	r.With(view).Get("/payee-wallets", h.ListWallets)
+	r.With(view).Get("/payee-wallets/{walletID}", h.GetWallet)
+	r.With(manage).Post("/payee-wallets/{walletID}/deductions", h.AddDeduction)
+	r.With(view).Get("/payee-wallets/{walletID}/statement", h.GetStatement)
+	r.With(view).Get("/payouts", h.ListPayouts)
+	r.With(manage).Post("/payouts", h.RequestPayout)
+	r.With(view).Get("/payouts/{payoutID}", h.GetPayout)
+	r.With(approve).Post("/payouts/{payoutID}/complete", h.CompletePayout)
+	r.With(approve).Post("/payouts/{payoutID}/reject", h.RejectPayout)
+}
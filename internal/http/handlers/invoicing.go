@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/credit"
+	"github.com/bengobox/treasury-api/internal/modules/invoices"
+	"github.com/bengobox/treasury-api/internal/modules/invoicing"
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// Invoicing handles invoice retrieval, approval and invoicing settings.
+type Invoicing struct {
+	logger      *zap.Logger
+	service     *invoicing.Service
+	rbacService *rbac.Service
+}
+
+// NewInvoicing creates a new invoicing handler.
+func NewInvoicing(logger *zap.Logger, service *invoicing.Service, rbacService *rbac.Service) *Invoicing {
+	return &Invoicing{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// GetInvoice returns an invoice with its lines.
+func (h *Invoicing) GetInvoice(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	invoiceID, err := uuidParam(r, "invoiceID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid invoice ID")
+		return
+	}
+
+	inv, err := h.service.GetInvoice(r.Context(), tenantID, invoiceID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get invoice", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, inv)
+}
+
+// IssueInvoice approves a draft invoice.
+func (h *Invoicing) IssueInvoice(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	invoiceID, err := uuidParam(r, "invoiceID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid invoice ID")
+		return
+	}
+
+	inv, err := h.service.IssueInvoice(r.Context(), tenantID, invoiceID, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to issue invoice", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, inv)
+}
+
+// GetSettings returns the tenant's invoicing settings.
+func (h *Invoicing) GetSettings(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	settings, err := h.service.GetSettings(r.Context(), tenantID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get invoice settings", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, settings)
+}
+
+// UpdateSettings changes the tenant's invoicing settings.
+func (h *Invoicing) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req invoicing.UpdateSettingsRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	settings, err := h.service.UpdateSettings(r.Context(), tenantID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to update invoice settings", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, settings)
+}
+
+func (h *Invoicing) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, invoices.ErrInvoiceNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, invoices.ErrInvoiceNotDraft),
+		errors.Is(err, credit.ErrCreditHold), errors.Is(err, credit.ErrCreditLimitExceeded):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, invoicing.ErrInvalidSettings):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers invoicing routes.
+func (h *Invoicing) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.view")
+	approve := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.approve")
+	configView := middleware.RequirePermission(h.rbacService, h.logger, "treasury.config.view")
+	configManage := middleware.RequirePermission(h.rbacService, h.logger, "treasury.config.manage")
+
+	r.With(view).Get("/invoices/{invoiceID}", h.GetInvoice)
+	r.With(approve).Post("/invoices/{invoiceID}/issue", h.IssueInvoice)
+	r.With(configView).Get("/invoicing/settings", h.GetSettings)
+	r.With(configManage).Put("/invoicing/settings", h.UpdateSettings)
+}
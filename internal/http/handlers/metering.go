@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/metering"
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// Metering handles subscription meters and usage reporting.
+type Metering struct {
+	logger      *zap.Logger
+	service     *metering.Service
+	rbacService *rbac.Service
+}
+
+// NewMetering creates a new metering handler.
+func NewMetering(logger *zap.Logger, service *metering.Service, rbacService *rbac.Service) *Metering {
+	return &Metering{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// CreateMeter adds a meter to a subscription.
+func (h *Metering) CreateMeter(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	subscriptionID, err := uuidParam(r, "subscriptionID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid subscription ID")
+		return
+	}
+
+	var req metering.CreateMeterRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	meter, err := h.service.CreateMeter(r.Context(), tenantID, subscriptionID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to create meter", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, meter)
+}
+
+// ListMeters lists a subscription's meters.
+func (h *Metering) ListMeters(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	subscriptionID, err := uuidParam(r, "subscriptionID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid subscription ID")
+		return
+	}
+
+	meters, err := h.service.ListMeters(r.Context(), tenantID, subscriptionID)
+	if err != nil {
+		h.respondServiceError(w, "failed to list meters", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"meters": meters})
+}
+
+// RecordUsage reports usage for a subscription meter. Reports are idempotent
+// per event_id.
+func (h *Metering) RecordUsage(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	subscriptionID, err := uuidParam(r, "subscriptionID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid subscription ID")
+		return
+	}
+
+	var req metering.RecordUsageRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	req.SubscriptionID = &subscriptionID
+	req.ReferenceID = ""
+
+	record, duplicate, err := h.service.RecordUsage(r.Context(), tenantID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to record usage", err)
+		return
+	}
+
+	status := http.StatusCreated
+	if duplicate {
+		status = http.StatusOK
+	}
+	respondJSON(w, status, record)
+}
+
+// PendingUsage previews the unbilled usage charges of a subscription.
+func (h *Metering) PendingUsage(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	subscriptionID, err := uuidParam(r, "subscriptionID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid subscription ID")
+		return
+	}
+
+	charges, err := h.service.PendingCharges(r.Context(), tenantID, subscriptionID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get pending usage", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"charges": charges})
+}
+
+func (h *Metering) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, metering.ErrSubscriptionNotFound),
+		errors.Is(err, metering.ErrMeterNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, metering.ErrMeterExists):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, metering.ErrInvalidMeter),
+		errors.Is(err, metering.ErrInvalidUsage),
+		errors.Is(err, metering.ErrSubscriptionEnded):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers metering routes.
+func (h *Metering) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.view")
+	edit := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.edit")
+
+	r.With(view).Get("/subscriptions/{subscriptionID}/meters", h.ListMeters)
+	r.With(edit).Post("/subscriptions/{subscriptionID}/meters", h.CreateMeter)
+	r.With(view).Get("/subscriptions/{subscriptionID}/usage", h.PendingUsage)
+	r.With(edit).Post("/subscriptions/{subscriptionID}/usage", h.RecordUsage)
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	authclient "github.com/Bengo-Hub/shared-auth-client"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+const dateLayout = "2006-01-02"
+
+// tenantIDParam parses the tenant identifier from the route.
+func tenantIDParam(r *http.Request) (uuid.UUID, error) {
+	return uuid.Parse(chi.URLParam(r, "tenantID"))
+}
+
+// uuidParam parses a UUID route parameter.
+func uuidParam(r *http.Request, name string) (uuid.UUID, error) {
+	return uuid.Parse(chi.URLParam(r, name))
+}
+
+// actorID returns the authenticated user, if any.
+func actorID(r *http.Request) *uuid.UUID {
+	claims, ok := authclient.ClaimsFromContext(r.Context())
+	if !ok {
+		return nil
+	}
+
+	userID, err := claims.UserID()
+	if err != nil || userID == uuid.Nil {
+		return nil
+	}
+	return &userID
+}
+
+// decodeJSON decodes the request body into v, rejecting unknown fields.
+func decodeJSON(r *http.Request, v any) error {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+// dateQuery parses an optional YYYY-MM-DD query parameter, falling back to def.
+func dateQuery(r *http.Request, name string, def time.Time) (time.Time, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return def, nil
+	}
+
+	parsed, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, errors.New("invalid " + name + ": expected YYYY-MM-DD")
+	}
+	return parsed, nil
+}
+
+// pageQuery parses the optional limit (1-500, default 50) and offset query
+// parameters.
+func pageQuery(r *http.Request) (limit, offset int, err error) {
+	limit = 50
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > 500 {
+			return 0, 0, errors.New("invalid limit")
+		}
+	}
+	if raw := r.URL.Query().Get("offset"); raw != "" {
+		offset, err = strconv.Atoi(raw)
+		if err != nil || offset < 0 {
+			return 0, 0, errors.New("invalid offset")
+		}
+	}
+	return limit, offset, nil
+}
+
+// optionalUUIDQuery parses an optional UUID query parameter, returning nil
+// when it is absent.
+func optionalUUIDQuery(r *http.Request, name string) (*uuid.UUID, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := uuid.Parse(value)
+	if err != nil {
+		return nil, errors.New("invalid " + name)
+	}
+	return &parsed, nil
+}
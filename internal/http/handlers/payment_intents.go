@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/credit"
+	"github.com/bengobox/treasury-api/internal/modules/payments"
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// PaymentIntents handles payment intent registration.
+type PaymentIntents struct {
+	logger      *zap.Logger
+	service     *payments.Service
+	rbacService *rbac.Service
+}
+
+// NewPaymentIntents creates a new payment intent handler.
+func NewPaymentIntents(logger *zap.Logger, service *payments.Service, rbacService *rbac.Service) *PaymentIntents {
+	return &PaymentIntents{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// Create registers a payment intent. On-account intents are refused when the
+// customer is on credit hold or over their credit limit, unless an override
+// covers them.
+func (h *PaymentIntents) Create(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req payments.CreateIntentRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	intent, err := h.service.CreateIntent(r.Context(), tenantID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to create payment intent", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, intent)
+}
+
+func (h *PaymentIntents) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, payments.ErrDuplicateReference),
+		errors.Is(err, credit.ErrCreditHold), errors.Is(err, credit.ErrCreditLimitExceeded):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, payments.ErrInvalidIntent):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers payment intent routes.
+func (h *PaymentIntents) RegisterRoutes(r chi.Router) {
+	create := middleware.RequirePermission(h.rbacService, h.logger, "treasury.payments.create")
+
+	r.With(create).Post("/payments/intents", h.Create)
+}
@@ -0,0 +1,237 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/bills"
+	"github.com/bengobox/treasury-api/internal/modules/paymentruns"
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/modules/vendors"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// PaymentRuns handles batch payment of vendor bills.
+type PaymentRuns struct {
+	logger      *zap.Logger
+	service     *paymentruns.Service
+	rbacService *rbac.Service
+}
+
+// NewPaymentRuns creates a new payment runs handler.
+func NewPaymentRuns(logger *zap.Logger, service *paymentruns.Service, rbacService *rbac.Service) *PaymentRuns {
+	return &PaymentRuns{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// CreateRun proposes a payment run for the approved bills matching the request.
+func (h *PaymentRuns) CreateRun(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req paymentruns.CreateRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	run, err := h.service.Create(r.Context(), tenantID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to create payment run", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, run)
+}
+
+// ListRuns lists payment runs, optionally filtered by status.
+func (h *PaymentRuns) ListRuns(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	limit, offset, err := pageQuery(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	runs, err := h.service.List(r.Context(), tenantID, paymentruns.ListFilter{
+		Status: r.URL.Query().Get("status"),
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		h.respondServiceError(w, "failed to list payment runs", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"payment_runs": runs})
+}
+
+// GetRun returns a payment run with its bills.
+func (h *PaymentRuns) GetRun(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, "failed to get payment run", h.service.Get)
+}
+
+// RemoveItem drops a bill from a draft payment run.
+func (h *PaymentRuns) RemoveItem(w http.ResponseWriter, r *http.Request) {
+	itemID, err := uuidParam(r, "itemID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid item ID")
+		return
+	}
+
+	h.transition(w, r, "failed to remove payment run item", func(ctx context.Context, tenantID, runID uuid.UUID) (*paymentruns.Run, error) {
+		return h.service.RemoveItem(ctx, tenantID, runID, itemID)
+	})
+}
+
+// ApproveRun approves a draft payment run.
+func (h *PaymentRuns) ApproveRun(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, "failed to approve payment run", func(ctx context.Context, tenantID, runID uuid.UUID) (*paymentruns.Run, error) {
+		return h.service.Approve(ctx, tenantID, runID, actorID(r))
+	})
+}
+
+// ExecuteRun pays the bills of an approved payment run.
+func (h *PaymentRuns) ExecuteRun(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, "failed to execute payment run", func(ctx context.Context, tenantID, runID uuid.UUID) (*paymentruns.Run, error) {
+		return h.service.Execute(ctx, tenantID, runID, actorID(r))
+	})
+}
+
+// CancelRun cancels a draft or approved payment run.
+func (h *PaymentRuns) CancelRun(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, "failed to cancel payment run", h.service.Cancel)
+}
+
+// GetPaymentFile downloads the run's bank transfer or M-Pesa B2B file.
+func (h *PaymentRuns) GetPaymentFile(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	runID, err := uuidParam(r, "runID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid payment run ID")
+		return
+	}
+
+	run, file, err := h.service.PaymentFile(r.Context(), tenantID, runID)
+	if err != nil {
+		h.respondServiceError(w, "failed to build payment file", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s-%s.csv", run.RunNumber, run.Method)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(file)
+}
+
+// GetRemittance downloads a vendor's remittance advice as PDF.
+func (h *PaymentRuns) GetRemittance(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	runID, err := uuidParam(r, "runID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid payment run ID")
+		return
+	}
+
+	vendorID, err := uuidParam(r, "vendorID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid vendor ID")
+		return
+	}
+
+	rem, err := h.service.Remittance(r.Context(), tenantID, runID, vendorID)
+	if err != nil {
+		h.respondServiceError(w, "failed to build remittance advice", err)
+		return
+	}
+
+	filename := fmt.Sprintf("remittance-%s-%s.pdf", rem.RunNumber, rem.Vendor.VendorNumber)
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(paymentruns.RenderRemittance(rem))
+}
+
+func (h *PaymentRuns) transition(w http.ResponseWriter, r *http.Request, message string, apply func(ctx context.Context, tenantID, runID uuid.UUID) (*paymentruns.Run, error)) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	runID, err := uuidParam(r, "runID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid payment run ID")
+		return
+	}
+
+	run, err := apply(r.Context(), tenantID, runID)
+	if err != nil {
+		h.respondServiceError(w, message, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, run)
+}
+
+func (h *PaymentRuns) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, paymentruns.ErrRunNotFound), errors.Is(err, paymentruns.ErrItemNotFound),
+		errors.Is(err, vendors.ErrVendorNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, paymentruns.ErrRunStatus), errors.Is(err, paymentruns.ErrBillChanged),
+		errors.Is(err, paymentruns.ErrNoBills):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, paymentruns.ErrSelfApproval):
+		respondError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, paymentruns.ErrInvalidRun), errors.Is(err, bills.ErrInvalidBill):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers payment run routes.
+func (h *PaymentRuns) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.bills.view")
+	approve := middleware.RequirePermission(h.rbacService, h.logger, "treasury.bills.approve")
+	pay := middleware.RequirePermission(h.rbacService, h.logger, "treasury.bills.pay")
+
+	r.With(view).Get("/payment-runs", h.ListRuns)
+	r.With(pay).Post("/payment-runs", h.CreateRun)
+	r.With(view).Get("/payment-runs/{runID}", h.GetRun)
+	r.With(pay).Delete("/payment-runs/{runID}/items/{itemID}", h.RemoveItem)
+	r.With(approve).Post("/payment-runs/{runID}/approve", h.ApproveRun)
+	r.With(pay).Post("/payment-runs/{runID}/execute", h.ExecuteRun)
+	r.With(pay).Post("/payment-runs/{runID}/cancel", h.CancelRun)
+	r.With(pay).Get("/payment-runs/{runID}/file", h.GetPaymentFile)
+	r.With(view).Get("/payment-runs/{runID}/remittances/{vendorID}", h.GetRemittance)
+}
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/modules/receivables"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// Receivables handles payment application to invoices.
+type Receivables struct {
+	logger      *zap.Logger
+	service     *receivables.Service
+	rbacService *rbac.Service
+}
+
+// NewReceivables creates a new receivables handler.
+func NewReceivables(logger *zap.Logger, service *receivables.Service, rbacService *rbac.Service) *Receivables {
+	return &Receivables{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// AllocatePaymentRequest represents a manual allocation request.
+type AllocatePaymentRequest struct {
+	Allocations []receivables.AllocationRequest `json:"allocations"`
+}
+
+// AllocatePayment applies a payment to explicitly chosen invoices.
+func (h *Receivables) AllocatePayment(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	paymentID, err := uuidParam(r, "paymentID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid payment ID")
+		return
+	}
+
+	var req AllocatePaymentRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	allocations, err := h.service.Allocate(r.Context(), tenantID, paymentID, req.Allocations, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to allocate payment", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, map[string]interface{}{"allocations": allocations})
+}
+
+// AutoAllocatePayment applies a payment to the customer's oldest open invoices.
+func (h *Receivables) AutoAllocatePayment(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	paymentID, err := uuidParam(r, "paymentID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid payment ID")
+		return
+	}
+
+	allocations, err := h.service.AutoAllocate(r.Context(), tenantID, paymentID, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to allocate payment", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, map[string]interface{}{"allocations": allocations})
+}
+
+// ListPaymentAllocations lists the invoices a payment has been applied to.
+func (h *Receivables) ListPaymentAllocations(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	paymentID, err := uuidParam(r, "paymentID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid payment ID")
+		return
+	}
+
+	allocations, err := h.service.ListPaymentAllocations(r.Context(), tenantID, paymentID)
+	if err != nil {
+		h.respondServiceError(w, "failed to list allocations", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"allocations": allocations})
+}
+
+// ListInvoiceAllocations lists the payments applied to an invoice.
+func (h *Receivables) ListInvoiceAllocations(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	invoiceID, err := uuidParam(r, "invoiceID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid invoice ID")
+		return
+	}
+
+	allocations, err := h.service.ListInvoiceAllocations(r.Context(), tenantID, invoiceID)
+	if err != nil {
+		h.respondServiceError(w, "failed to list allocations", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"allocations": allocations})
+}
+
+// DeleteAllocation removes an allocation and reopens the invoice balance.
+func (h *Receivables) DeleteAllocation(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	allocationID, err := uuidParam(r, "allocationID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid allocation ID")
+		return
+	}
+
+	allocation, err := h.service.Unallocate(r.Context(), tenantID, allocationID)
+	if err != nil {
+		h.respondServiceError(w, "failed to remove allocation", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, allocation)
+}
+
+// CustomerCredit returns a customer's unallocated payment balances.
+func (h *Receivables) CustomerCredit(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	customerID, err := uuidParam(r, "customerID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+
+	credits, err := h.service.GetCustomerCredit(r.Context(), tenantID, customerID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get customer credit", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"credits": credits})
+}
+
+func (h *Receivables) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, receivables.ErrPaymentNotFound),
+		errors.Is(err, receivables.ErrInvoiceNotFound),
+		errors.Is(err, receivables.ErrAllocationNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, receivables.ErrOverAllocation):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, receivables.ErrPaymentNotAllocatable),
+		errors.Is(err, receivables.ErrInvoiceNotOpen),
+		errors.Is(err, receivables.ErrCurrencyMismatch),
+		errors.Is(err, receivables.ErrCustomerMismatch),
+		errors.Is(err, receivables.ErrExceedsOutstanding),
+		errors.Is(err, receivables.ErrInvalidAllocation),
+		errors.Is(err, receivables.ErrNoCustomer):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers payment allocation routes.
+func (h *Receivables) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.payments.view")
+	process := middleware.RequirePermission(h.rbacService, h.logger, "treasury.payments.process")
+
+	r.With(view).Get("/payments/transactions/{paymentID}/allocations", h.ListPaymentAllocations)
+	r.With(process).Post("/payments/transactions/{paymentID}/allocations", h.AllocatePayment)
+	r.With(process).Post("/payments/transactions/{paymentID}/allocations/auto", h.AutoAllocatePayment)
+	r.With(process).Delete("/payments/allocations/{allocationID}", h.DeleteAllocation)
+	r.With(view).Get("/invoices/{invoiceID}/allocations", h.ListInvoiceAllocations)
+	r.With(view).Get("/customers/{customerID}/credit", h.CustomerCredit)
+}
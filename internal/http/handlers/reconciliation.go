@@ -0,0 +1,515 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/banking"
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/modules/reconciliation"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// Reconciliation handles bank reconciliation matching runs, the suggestions
+// queue, the manual matching workspace, reconciliation reports and custom
+// matching rules.
+type Reconciliation struct {
+	logger      *zap.Logger
+	service     *reconciliation.Service
+	rbacService *rbac.Service
+}
+
+// NewReconciliation creates a new reconciliation handler.
+func NewReconciliation(logger *zap.Logger, service *reconciliation.Service, rbacService *rbac.Service) *Reconciliation {
+	return &Reconciliation{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// RunMatching matches a bank account's unreconciled lines. The body may set
+// date_window_days and max_group_size.
+func (h *Reconciliation) RunMatching(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	bankAccountID, err := uuidParam(r, "bankAccountID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid bank account ID")
+		return
+	}
+
+	var req reconciliation.RunRequest
+	if r.ContentLength != 0 {
+		if err := decodeJSON(r, &req); err != nil {
+			respondError(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+	}
+
+	result, err := h.service.Run(r.Context(), tenantID, bankAccountID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to run reconciliation matching", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, result)
+}
+
+// ListReconciliations lists reconciliations, optionally for one bank account.
+func (h *Reconciliation) ListReconciliations(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	bankAccountID, err := optionalUUIDQuery(r, "bank_account_id")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	reconciliations, err := h.service.ListReconciliations(r.Context(), tenantID, bankAccountID)
+	if err != nil {
+		h.respondServiceError(w, "failed to list reconciliations", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"reconciliations": reconciliations})
+}
+
+// GetReconciliation returns a reconciliation.
+func (h *Reconciliation) GetReconciliation(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	reconciliationID, err := uuidParam(r, "reconciliationID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid reconciliation ID")
+		return
+	}
+
+	result, err := h.service.GetReconciliation(r.Context(), tenantID, reconciliationID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get reconciliation", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, result)
+}
+
+// ListMatches lists matches, optionally filtered by bank account,
+// reconciliation and status; status=suggested gives the suggestions queue.
+func (h *Reconciliation) ListMatches(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	limit, offset, err := pageQuery(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filter := reconciliation.MatchFilter{
+		Status: r.URL.Query().Get("status"),
+		Limit:  limit,
+		Offset: offset,
+	}
+	if filter.BankAccountID, err = optionalUUIDQuery(r, "bank_account_id"); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if filter.ReconciliationID, err = optionalUUIDQuery(r, "reconciliation_id"); err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	matches, err := h.service.ListMatches(r.Context(), tenantID, filter)
+	if err != nil {
+		h.respondServiceError(w, "failed to list reconciliation matches", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"matches": matches})
+}
+
+// GetMatch returns a match with its items.
+func (h *Reconciliation) GetMatch(w http.ResponseWriter, r *http.Request) {
+	h.matchAction(w, r, "failed to get reconciliation match", func(ctx context.Context, tenantID, matchID uuid.UUID) (*reconciliation.Match, error) {
+		return h.service.GetMatch(ctx, tenantID, matchID)
+	})
+}
+
+// AcceptMatch locks a suggested match into the open reconciliation.
+func (h *Reconciliation) AcceptMatch(w http.ResponseWriter, r *http.Request) {
+	h.matchAction(w, r, "failed to accept reconciliation match", func(ctx context.Context, tenantID, matchID uuid.UUID) (*reconciliation.Match, error) {
+		return h.service.AcceptMatch(ctx, tenantID, matchID, actorID(r))
+	})
+}
+
+// RejectMatch rejects a suggested match.
+func (h *Reconciliation) RejectMatch(w http.ResponseWriter, r *http.Request) {
+	h.matchAction(w, r, "failed to reject reconciliation match", func(ctx context.Context, tenantID, matchID uuid.UUID) (*reconciliation.Match, error) {
+		return h.service.RejectMatch(ctx, tenantID, matchID, actorID(r))
+	})
+}
+
+func (h *Reconciliation) matchAction(w http.ResponseWriter, r *http.Request, message string, action func(ctx context.Context, tenantID, matchID uuid.UUID) (*reconciliation.Match, error)) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	matchID, err := uuidParam(r, "matchID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid match ID")
+		return
+	}
+
+	match, err := action(r.Context(), tenantID, matchID)
+	if err != nil {
+		h.respondServiceError(w, message, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, match)
+}
+
+// UnmatchMatch releases a matched match so its entries can be matched again.
+func (h *Reconciliation) UnmatchMatch(w http.ResponseWriter, r *http.Request) {
+	h.matchAction(w, r, "failed to unmatch reconciliation match", func(ctx context.Context, tenantID, matchID uuid.UUID) (*reconciliation.Match, error) {
+		return h.service.UnmatchMatch(ctx, tenantID, matchID, actorID(r))
+	})
+}
+
+// CreateMatch matches bank lines to ledger cash lines or payment
+// transactions by hand.
+func (h *Reconciliation) CreateMatch(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req reconciliation.ManualMatchRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	match, err := h.service.ManualMatch(r.Context(), tenantID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to create reconciliation match", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, match)
+}
+
+// ListUnmatched lists a bank account's unmatched bank lines, ledger cash
+// lines and payment transactions between from and to (default the last 30
+// days).
+func (h *Reconciliation) ListUnmatched(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	bankAccountID, err := uuidParam(r, "bankAccountID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid bank account ID")
+		return
+	}
+
+	to, err := dateQuery(r, "to", time.Now().UTC())
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	from, err := dateQuery(r, "from", to.AddDate(0, 0, -30))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	unmatched, err := h.service.Unmatched(r.Context(), tenantID, bankAccountID, from, to)
+	if err != nil {
+		h.respondServiceError(w, "failed to list unmatched entries", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, unmatched)
+}
+
+// CreateAdjustment books an unmatched bank line with an adjusting journal.
+func (h *Reconciliation) CreateAdjustment(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req reconciliation.AdjustmentRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	adjustment, err := h.service.Adjust(r.Context(), tenantID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to post bank adjustment", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, adjustment)
+}
+
+// GetReport returns a finalised reconciliation's report, or previews an open
+// one's as at period_end, optionally with statement_balance.
+func (h *Reconciliation) GetReport(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	reconciliationID, err := uuidParam(r, "reconciliationID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid reconciliation ID")
+		return
+	}
+
+	var periodEnd *time.Time
+	if r.URL.Query().Get("period_end") != "" {
+		parsed, err := dateQuery(r, "period_end", time.Time{})
+		if err != nil {
+			respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		periodEnd = &parsed
+	}
+	var statementBalance *decimal.Decimal
+	if raw := r.URL.Query().Get("statement_balance"); raw != "" {
+		parsed, err := decimal.NewFromString(raw)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "invalid statement_balance")
+			return
+		}
+		statementBalance = &parsed
+	}
+
+	report, err := h.service.Report(r.Context(), tenantID, reconciliationID, periodEnd, statementBalance)
+	if err != nil {
+		h.respondServiceError(w, "failed to get reconciliation report", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, report)
+}
+
+// FinaliseReconciliation closes the open reconciliation at period_end.
+func (h *Reconciliation) FinaliseReconciliation(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	reconciliationID, err := uuidParam(r, "reconciliationID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid reconciliation ID")
+		return
+	}
+
+	var req reconciliation.FinaliseRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	report, err := h.service.Finalise(r.Context(), tenantID, reconciliationID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to finalise reconciliation", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, report)
+}
+
+// ListRules lists the tenant's custom matching rules in priority order.
+func (h *Reconciliation) ListRules(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	rules, err := h.service.ListRules(r.Context(), tenantID)
+	if err != nil {
+		h.respondServiceError(w, "failed to list reconciliation rules", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"rules": rules})
+}
+
+// CreateRule creates a custom matching rule.
+func (h *Reconciliation) CreateRule(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req reconciliation.RuleRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	rule, err := h.service.CreateRule(r.Context(), tenantID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to create reconciliation rule", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, rule)
+}
+
+// GetRule returns a custom matching rule.
+func (h *Reconciliation) GetRule(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	ruleID, err := uuidParam(r, "ruleID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid rule ID")
+		return
+	}
+
+	rule, err := h.service.GetRule(r.Context(), tenantID, ruleID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get reconciliation rule", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, rule)
+}
+
+// UpdateRule replaces a custom matching rule's settings.
+func (h *Reconciliation) UpdateRule(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	ruleID, err := uuidParam(r, "ruleID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid rule ID")
+		return
+	}
+
+	var req reconciliation.RuleRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	rule, err := h.service.UpdateRule(r.Context(), tenantID, ruleID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to update reconciliation rule", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, rule)
+}
+
+// DeleteRule removes a custom matching rule.
+func (h *Reconciliation) DeleteRule(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	ruleID, err := uuidParam(r, "ruleID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid rule ID")
+		return
+	}
+
+	if err := h.service.DeleteRule(r.Context(), tenantID, ruleID); err != nil {
+		h.respondServiceError(w, "failed to delete reconciliation rule", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Reconciliation) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, banking.ErrBankAccountNotFound), errors.Is(err, reconciliation.ErrReconciliationNotFound),
+		errors.Is(err, reconciliation.ErrMatchNotFound), errors.Is(err, reconciliation.ErrRuleNotFound),
+		errors.Is(err, reconciliation.ErrBankLineNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, banking.ErrBankAccountState), errors.Is(err, reconciliation.ErrMatchState),
+		errors.Is(err, reconciliation.ErrReconciliationState):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, reconciliation.ErrInvalidRule), errors.Is(err, reconciliation.ErrInvalidRun),
+		errors.Is(err, reconciliation.ErrInvalidMatch), errors.Is(err, reconciliation.ErrInvalidPeriod):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers reconciliation routes.
+func (h *Reconciliation) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.banking.view")
+	reconcile := middleware.RequirePermission(h.rbacService, h.logger, "treasury.banking.reconcile")
+	configManage := middleware.RequirePermission(h.rbacService, h.logger, "treasury.config.manage")
+
+	r.With(reconcile).Post("/bank-accounts/{bankAccountID}/reconciliation-runs", h.RunMatching)
+	r.With(view).Get("/bank-accounts/{bankAccountID}/unmatched", h.ListUnmatched)
+	r.With(view).Get("/reconciliations", h.ListReconciliations)
+	r.With(view).Get("/reconciliations/{reconciliationID}", h.GetReconciliation)
+	r.With(view).Get("/reconciliations/{reconciliationID}/report", h.GetReport)
+	r.With(reconcile).Post("/reconciliations/{reconciliationID}/finalise", h.FinaliseReconciliation)
+	r.With(view).Get("/reconciliation-matches", h.ListMatches)
+	r.With(reconcile).Post("/reconciliation-matches", h.CreateMatch)
+	r.With(view).Get("/reconciliation-matches/{matchID}", h.GetMatch)
+	r.With(reconcile).Post("/reconciliation-matches/{matchID}/accept", h.AcceptMatch)
+	r.With(reconcile).Post("/reconciliation-matches/{matchID}/reject", h.RejectMatch)
+	r.With(reconcile).Post("/reconciliation-matches/{matchID}/unmatch", h.UnmatchMatch)
+	r.With(reconcile).Post("/reconciliation-adjustments", h.CreateAdjustment)
+	r.With(view).Get("/reconciliation-rules", h.ListRules)
+	r.With(configManage).Post("/reconciliation-rules", h.CreateRule)
+	r.With(view).Get("/reconciliation-rules/{ruleID}", h.GetRule)
+	r.With(configManage).Put("/reconciliation-rules/{ruleID}", h.UpdateRule)
+	r.With(configManage).Delete("/reconciliation-rules/{ruleID}", h.DeleteRule)
+}
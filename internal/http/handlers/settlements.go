@@ -0,0 +1,382 @@
+package handlers
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/modules/settlements"
+	"github.com/bengobox/treasury-api/internal/platform/mpesa"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// Settlements handles settlement batches of tenants' collections and the
+// M-Pesa callbacks of their payouts.
+type Settlements struct {
+	logger        *zap.Logger
+	service       *settlements.Service
+	rbacService   *rbac.Service
+	callbackToken string
+}
+
+// NewSettlements creates a new settlements handler. M-Pesa callbacks must
+// carry callbackToken; none are accepted when it is empty.
+func NewSettlements(logger *zap.Logger, service *settlements.Service, rbacService *rbac.Service, callbackToken string) *Settlements {
+	return &Settlements{
+		logger:        logger,
+		service:       service,
+		rbacService:   rbacService,
+		callbackToken: callbackToken,
+	}
+}
+
+// GenerateBatches batches the tenant's unsettled collections for a window.
+func (h *Settlements) GenerateBatches(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req settlements.GenerateRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	batches, err := h.service.Generate(r.Context(), tenantID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to generate settlement batches", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, map[string]interface{}{"settlement_batches": batches})
+}
+
+// ListBatches lists settlement batches, optionally filtered by status and
+// channel.
+func (h *Settlements) ListBatches(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	limit, offset, err := pageQuery(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	batches, err := h.service.List(r.Context(), tenantID, settlements.ListFilter{
+		Status:  r.URL.Query().Get("status"),
+		Channel: r.URL.Query().Get("channel"),
+		Limit:   limit,
+		Offset:  offset,
+	})
+	if err != nil {
+		h.respondServiceError(w, "failed to list settlement batches", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"settlement_batches": batches})
+}
+
+// GetBatch returns a settlement batch with its items.
+func (h *Settlements) GetBatch(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, "failed to get settlement batch", h.service.Get)
+}
+
+// AddAdjustment adds an adjustment line to a batch under review.
+func (h *Settlements) AddAdjustment(w http.ResponseWriter, r *http.Request) {
+	var req settlements.AdjustmentRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	h.transition(w, r, "failed to add settlement adjustment", func(ctx context.Context, tenantID, batchID uuid.UUID) (*settlements.Batch, error) {
+		return h.service.AddAdjustment(ctx, tenantID, batchID, req, actorID(r))
+	})
+}
+
+// RemoveItem drops a transaction or adjustment from a batch under review.
+func (h *Settlements) RemoveItem(w http.ResponseWriter, r *http.Request) {
+	itemID, err := uuidParam(r, "itemID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid item ID")
+		return
+	}
+
+	h.transition(w, r, "failed to remove settlement item", func(ctx context.Context, tenantID, batchID uuid.UUID) (*settlements.Batch, error) {
+		return h.service.RemoveItem(ctx, tenantID, batchID, itemID)
+	})
+}
+
+// SubmitBatch confirms a draft batch's funding and sends it for approval.
+func (h *Settlements) SubmitBatch(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, "failed to submit settlement batch", func(ctx context.Context, tenantID, batchID uuid.UUID) (*settlements.Batch, error) {
+		return h.service.Submit(ctx, tenantID, batchID, actorID(r))
+	})
+}
+
+// ApproveBatch approves a pending batch for disbursement.
+func (h *Settlements) ApproveBatch(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, "failed to approve settlement batch", func(ctx context.Context, tenantID, batchID uuid.UUID) (*settlements.Batch, error) {
+		return h.service.Approve(ctx, tenantID, batchID, actorID(r))
+	})
+}
+
+// RejectBatch sends a pending batch back to draft.
+func (h *Settlements) RejectBatch(w http.ResponseWriter, r *http.Request) {
+	var req settlements.RejectRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	h.transition(w, r, "failed to reject settlement batch", func(ctx context.Context, tenantID, batchID uuid.UUID) (*settlements.Batch, error) {
+		return h.service.Reject(ctx, tenantID, batchID, req)
+	})
+}
+
+// GetSettings returns the tenant's settlement fees.
+func (h *Settlements) GetSettings(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	settings, err := h.service.GetSettings(r.Context(), tenantID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get settlement settings", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, settings)
+}
+
+// UpdateSettings changes the tenant's settlement fees.
+func (h *Settlements) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req settlements.UpdateSettingsRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	settings, err := h.service.UpdateSettings(r.Context(), tenantID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to update settlement settings", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, settings)
+}
+
+// ListDisbursements lists settlement payouts; status=failed gives the queue
+// of payouts needing attention.
+func (h *Settlements) ListDisbursements(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	limit, offset, err := pageQuery(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	disbursements, err := h.service.ListDisbursements(r.Context(), tenantID, settlements.DisbursementFilter{
+		Status: r.URL.Query().Get("status"),
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		h.respondServiceError(w, "failed to list settlement disbursements", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"disbursements": disbursements})
+}
+
+// GetDisbursement returns a settlement payout.
+func (h *Settlements) GetDisbursement(w http.ResponseWriter, r *http.Request) {
+	h.disbursement(w, r, "failed to get settlement disbursement", h.service.GetDisbursement)
+}
+
+// RetryDisbursement sends a failed settlement payout again.
+func (h *Settlements) RetryDisbursement(w http.ResponseWriter, r *http.Request) {
+	h.disbursement(w, r, "failed to retry settlement disbursement", h.service.RetryDisbursement)
+}
+
+// MpesaResult receives the result of a payout request from M-Pesa.
+func (h *Settlements) MpesaResult(w http.ResponseWriter, r *http.Request) {
+	result, ok := h.decodeCallback(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.service.HandleResult(r.Context(), settlements.PayoutResult{
+		OriginatorConversationID: result.OriginatorConversationID,
+		ConversationID:           result.ConversationID,
+		ResultCode:               string(result.ResultCode),
+		ResultDescription:        result.ResultDesc,
+		TransactionID:            result.TransactionID,
+	})
+	h.acknowledge(w, result, "failed to handle M-Pesa payout result", err)
+}
+
+// MpesaTimeout receives M-Pesa's notice that a payout request timed out in
+// its queue.
+func (h *Settlements) MpesaTimeout(w http.ResponseWriter, r *http.Request) {
+	result, ok := h.decodeCallback(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.service.HandleQueueTimeout(r.Context(), result.OriginatorConversationID)
+	h.acknowledge(w, result, "failed to handle M-Pesa payout timeout", err)
+}
+
+// decodeCallback authenticates an M-Pesa callback by the token in its URL
+// and decodes its result.
+func (h *Settlements) decodeCallback(w http.ResponseWriter, r *http.Request) (*mpesa.CallbackResult, bool) {
+	token := r.URL.Query().Get("token")
+	if h.callbackToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(h.callbackToken)) != 1 {
+		respondError(w, http.StatusUnauthorized, "invalid callback token")
+		return nil, false
+	}
+
+	var callback mpesa.Callback
+	if err := json.NewDecoder(io.LimitReader(r.Body, 64*1024)).Decode(&callback); err != nil || callback.Result.OriginatorConversationID == "" {
+		respondError(w, http.StatusBadRequest, "invalid callback body")
+		return nil, false
+	}
+	return &callback.Result, true
+}
+
+// acknowledge answers M-Pesa. Callbacks for unknown requests are
+// acknowledged too: resending them cannot help.
+func (h *Settlements) acknowledge(w http.ResponseWriter, result *mpesa.CallbackResult, message string, err error) {
+	if err != nil && !errors.Is(err, settlements.ErrDisbursementNotFound) {
+		h.logger.Error(message, zap.String("originator_conversation_id", result.OriginatorConversationID), zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+		return
+	}
+	if err != nil {
+		h.logger.Warn("M-Pesa callback for unknown payout request",
+			zap.String("originator_conversation_id", result.OriginatorConversationID),
+		)
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"ResultCode": 0, "ResultDesc": "Accepted"})
+}
+
+func (h *Settlements) disbursement(w http.ResponseWriter, r *http.Request, message string, apply func(ctx context.Context, tenantID, disbursementID uuid.UUID) (*settlements.Disbursement, error)) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	disbursementID, err := uuidParam(r, "disbursementID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid settlement disbursement ID")
+		return
+	}
+
+	disbursement, err := apply(r.Context(), tenantID, disbursementID)
+	if err != nil {
+		h.respondServiceError(w, message, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, disbursement)
+}
+
+func (h *Settlements) transition(w http.ResponseWriter, r *http.Request, message string, apply func(ctx context.Context, tenantID, batchID uuid.UUID) (*settlements.Batch, error)) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	batchID, err := uuidParam(r, "batchID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid settlement batch ID")
+		return
+	}
+
+	batch, err := apply(r.Context(), tenantID, batchID)
+	if err != nil {
+		h.respondServiceError(w, message, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, batch)
+}
+
+func (h *Settlements) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, settlements.ErrBatchNotFound), errors.Is(err, settlements.ErrItemNotFound),
+		errors.Is(err, settlements.ErrDisbursementNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, settlements.ErrBatchStatus), errors.Is(err, settlements.ErrBatchExists),
+		errors.Is(err, settlements.ErrNothingToSettle), errors.Is(err, settlements.ErrDisbursementStatus):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, settlements.ErrSelfApproval):
+		respondError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, settlements.ErrInvalidBatch), errors.Is(err, settlements.ErrInvalidSettings):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers settlement routes.
+func (h *Settlements) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.settlements.view")
+	manage := middleware.RequirePermission(h.rbacService, h.logger, "treasury.settlements.manage")
+	approve := middleware.RequirePermission(h.rbacService, h.logger, "treasury.settlements.approve")
+	configView := middleware.RequirePermission(h.rbacService, h.logger, "treasury.config.view")
+	configManage := middleware.RequirePermission(h.rbacService, h.logger, "treasury.config.manage")
+
+	r.With(configView).Get("/settlements/settings", h.GetSettings)
+	r.With(configManage).Put("/settlements/settings", h.UpdateSettings)
+	r.With(view).Get("/settlements/disbursements", h.ListDisbursements)
+	r.With(view).Get("/settlements/disbursements/{disbursementID}", h.GetDisbursement)
+	r.With(approve).Post("/settlements/disbursements/{disbursementID}/retry", h.RetryDisbursement)
+	r.With(view).Get("/settlements", h.ListBatches)
+	r.With(manage).Post("/settlements", h.GenerateBatches)
+	r.With(view).Get("/settlements/{batchID}", h.GetBatch)
+	r.With(manage).Post("/settlements/{batchID}/adjustments", h.AddAdjustment)
+	r.With(manage).Delete("/settlements/{batchID}/items/{itemID}", h.RemoveItem)
+	r.With(manage).Post("/settlements/{batchID}/submit", h.SubmitBatch)
+	r.With(approve).Post("/settlements/{batchID}/approve", h.ApproveBatch)
+	r.With(approve).Post("/settlements/{batchID}/reject", h.RejectBatch)
+}
+
+// RegisterPublicRoutes registers the M-Pesa payout callbacks, authenticated
+// by the callback token instead of a user session.
+func (h *Settlements) RegisterPublicRoutes(r chi.Router) {
+	r.Post("/callbacks/mpesa/result", h.MpesaResult)
+	r.Post("/callbacks/mpesa/timeout", h.MpesaTimeout)
+}
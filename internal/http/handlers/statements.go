@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/customers"
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/modules/statements"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// Statements handles customer statements.
+type Statements struct {
+	logger      *zap.Logger
+	service     *statements.Service
+	rbacService *rbac.Service
+}
+
+// NewStatements creates a new statements handler.
+func NewStatements(logger *zap.Logger, service *statements.Service, rbacService *rbac.Service) *Statements {
+	return &Statements{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// GetStatement returns a customer's statement for a period as JSON, or as a
+// PDF download with format=pdf.
+func (h *Statements) GetStatement(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	customerID, err := uuidParam(r, "customerID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+
+	req, err := statementRequest(r.URL.Query().Get("currency"), r.URL.Query().Get("from"), r.URL.Query().Get("to"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	st, err := h.service.Statement(r.Context(), tenantID, customerID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to build statement", err)
+		return
+	}
+
+	switch r.URL.Query().Get("format") {
+	case "", "json":
+		respondJSON(w, http.StatusOK, st)
+	case "pdf":
+		filename := fmt.Sprintf("statement-%s-%s.pdf", st.CustomerNumber, st.To.Format(dateLayout))
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(statements.RenderPDF(st))
+	default:
+		respondError(w, http.StatusBadRequest, "invalid format: expected json or pdf")
+	}
+}
+
+// GenerateStatement stores a customer's statement PDF and publishes
+// treasury.statement.generated for delivery.
+func (h *Statements) GenerateStatement(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	customerID, err := uuidParam(r, "customerID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+
+	var body struct {
+		Currency string `json:"currency"`
+		From     string `json:"from"`
+		To       string `json:"to"`
+	}
+	if err := decodeJSON(r, &body); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	req, err := statementRequest(body.Currency, body.From, body.To)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	record, err := h.service.Generate(r.Context(), tenantID, customerID, req, statements.TriggerManual)
+	if err != nil {
+		h.respondServiceError(w, "failed to generate statement", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, record)
+}
+
+// ListStatements lists a customer's stored statements.
+func (h *Statements) ListStatements(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	customerID, err := uuidParam(r, "customerID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+
+	records, err := h.service.List(r.Context(), tenantID, customerID)
+	if err != nil {
+		h.respondServiceError(w, "failed to list statements", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"statements": records})
+}
+
+func (h *Statements) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, customers.ErrCustomerNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, statements.ErrInvalidRequest), errors.Is(err, customers.ErrInvalidCustomer):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// statementRequest parses the optional currency and YYYY-MM-DD period bounds.
+func statementRequest(currency, from, to string) (statements.Request, error) {
+	req := statements.Request{Currency: currency}
+	if from != "" {
+		parsed, err := time.Parse(dateLayout, from)
+		if err != nil {
+			return req, errors.New("invalid from: expected YYYY-MM-DD")
+		}
+		req.From = &parsed
+	}
+	if to != "" {
+		parsed, err := time.Parse(dateLayout, to)
+		if err != nil {
+			return req, errors.New("invalid to: expected YYYY-MM-DD")
+		}
+		req.To = &parsed
+	}
+	return req, nil
+}
+
+// RegisterRoutes registers statement routes.
+func (h *Statements) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.view")
+	send := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.send")
+
+	r.With(view).Get("/customers/{customerID}/statement", h.GetStatement)
+	r.With(view).Get("/customers/{customerID}/statements", h.ListStatements)
+	r.With(send).Post("/customers/{customerID}/statements", h.GenerateStatement)
+}
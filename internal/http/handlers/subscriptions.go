@@ -0,0 +1,225 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/modules/subscriptions"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// Subscriptions handles subscription management and billing cycle queries.
+type Subscriptions struct {
+	logger      *zap.Logger
+	service     *subscriptions.Service
+	rbacService *rbac.Service
+}
+
+// NewSubscriptions creates a new subscriptions handler.
+func NewSubscriptions(logger *zap.Logger, service *subscriptions.Service, rbacService *rbac.Service) *Subscriptions {
+	return &Subscriptions{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// CreateSubscription starts a subscription.
+func (h *Subscriptions) CreateSubscription(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req subscriptions.CreateRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	sub, err := h.service.Create(r.Context(), tenantID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to create subscription", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, sub)
+}
+
+// ListSubscriptions lists subscriptions, optionally filtered by customer and status.
+func (h *Subscriptions) ListSubscriptions(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	filter := subscriptions.ListFilter{
+		Status: r.URL.Query().Get("status"),
+		Limit:  50,
+	}
+	if raw := r.URL.Query().Get("customer_id"); raw != "" {
+		customerID, err := uuid.Parse(raw)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "invalid customer_id")
+			return
+		}
+		filter.CustomerID = &customerID
+	}
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > 500 {
+			respondError(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		filter.Limit = limit
+	}
+	if raw := r.URL.Query().Get("offset"); raw != "" {
+		offset, err := strconv.Atoi(raw)
+		if err != nil || offset < 0 {
+			respondError(w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+		filter.Offset = offset
+	}
+
+	subs, err := h.service.List(r.Context(), tenantID, filter)
+	if err != nil {
+		h.respondServiceError(w, "failed to list subscriptions", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"subscriptions": subs})
+}
+
+// GetSubscription returns a subscription.
+func (h *Subscriptions) GetSubscription(w http.ResponseWriter, r *http.Request) {
+	tenantID, subscriptionID, ok := h.subscriptionParams(w, r)
+	if !ok {
+		return
+	}
+
+	sub, err := h.service.Get(r.Context(), tenantID, subscriptionID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get subscription", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, sub)
+}
+
+// ChangePlan moves a subscription to another plan.
+func (h *Subscriptions) ChangePlan(w http.ResponseWriter, r *http.Request) {
+	tenantID, subscriptionID, ok := h.subscriptionParams(w, r)
+	if !ok {
+		return
+	}
+
+	var req subscriptions.ChangePlanRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	sub, adjustments, err := h.service.ChangePlan(r.Context(), tenantID, subscriptionID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to change subscription plan", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"subscription": sub,
+		"adjustments":  adjustments,
+	})
+}
+
+// CancelSubscription cancels a subscription now or at period end.
+func (h *Subscriptions) CancelSubscription(w http.ResponseWriter, r *http.Request) {
+	tenantID, subscriptionID, ok := h.subscriptionParams(w, r)
+	if !ok {
+		return
+	}
+
+	var req subscriptions.CancelRequest
+	if r.ContentLength != 0 {
+		if err := decodeJSON(r, &req); err != nil {
+			respondError(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+	}
+
+	sub, err := h.service.Cancel(r.Context(), tenantID, subscriptionID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to cancel subscription", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, sub)
+}
+
+// ListBillingCycles lists a subscription's billing cycles.
+func (h *Subscriptions) ListBillingCycles(w http.ResponseWriter, r *http.Request) {
+	tenantID, subscriptionID, ok := h.subscriptionParams(w, r)
+	if !ok {
+		return
+	}
+
+	cycles, err := h.service.ListCycles(r.Context(), tenantID, subscriptionID)
+	if err != nil {
+		h.respondServiceError(w, "failed to list billing cycles", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"billing_cycles": cycles})
+}
+
+func (h *Subscriptions) subscriptionParams(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	subscriptionID, err := uuidParam(r, "subscriptionID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid subscription ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return tenantID, subscriptionID, true
+}
+
+func (h *Subscriptions) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, subscriptions.ErrSubscriptionNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, subscriptions.ErrSubscriptionCancelled):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, subscriptions.ErrInvalidSubscription):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers subscription routes.
+func (h *Subscriptions) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.view")
+	create := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.create")
+	edit := middleware.RequirePermission(h.rbacService, h.logger, "treasury.invoices.edit")
+
+	r.With(view).Get("/subscriptions", h.ListSubscriptions)
+	r.With(create).Post("/subscriptions", h.CreateSubscription)
+	r.With(view).Get("/subscriptions/{subscriptionID}", h.GetSubscription)
+	r.With(edit).Post("/subscriptions/{subscriptionID}/change-plan", h.ChangePlan)
+	r.With(edit).Post("/subscriptions/{subscriptionID}/cancel", h.CancelSubscription)
+	r.With(view).Get("/subscriptions/{subscriptionID}/cycles", h.ListBillingCycles)
+}
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/modules/vendors"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// Vendors handles vendor master records.
+type Vendors struct {
+	logger      *zap.Logger
+	service     *vendors.Service
+	rbacService *rbac.Service
+}
+
+// NewVendors creates a new vendors handler.
+func NewVendors(logger *zap.Logger, service *vendors.Service, rbacService *rbac.Service) *Vendors {
+	return &Vendors{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// CreateVendor creates a vendor.
+func (h *Vendors) CreateVendor(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req vendors.CreateRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v, err := h.service.Create(r.Context(), tenantID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to create vendor", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, v)
+}
+
+// ListVendors lists vendors, optionally filtered by status and a search term.
+func (h *Vendors) ListVendors(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	limit, offset, err := pageQuery(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	list, err := h.service.List(r.Context(), tenantID, vendors.ListFilter{
+		Search: r.URL.Query().Get("search"),
+		Status: r.URL.Query().Get("status"),
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		h.respondServiceError(w, "failed to list vendors", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"vendors": list})
+}
+
+// GetVendor returns a vendor.
+func (h *Vendors) GetVendor(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	vendorID, err := uuidParam(r, "vendorID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid vendor ID")
+		return
+	}
+
+	v, err := h.service.Get(r.Context(), tenantID, vendorID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get vendor", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, v)
+}
+
+// UpdateVendor changes a vendor's details.
+func (h *Vendors) UpdateVendor(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	vendorID, err := uuidParam(r, "vendorID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid vendor ID")
+		return
+	}
+
+	var req vendors.UpdateRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v, err := h.service.Update(r.Context(), tenantID, vendorID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to update vendor", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, v)
+}
+
+func (h *Vendors) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, vendors.ErrVendorNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, vendors.ErrDuplicateVendor):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, vendors.ErrInvalidVendor):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers vendor routes.
+func (h *Vendors) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.bills.view")
+	create := middleware.RequirePermission(h.rbacService, h.logger, "treasury.bills.create")
+	edit := middleware.RequirePermission(h.rbacService, h.logger, "treasury.bills.edit")
+
+	r.With(view).Get("/vendors", h.ListVendors)
+	r.With(create).Post("/vendors", h.CreateVendor)
+	r.With(view).Get("/vendors/{vendorID}", h.GetVendor)
+	r.With(edit).Put("/vendors/{vendorID}", h.UpdateVendor)
+}
@@ -0,0 +1,341 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/modules/wallets"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// Wallets handles stored-value wallets of tenants, outlets and customers.
+type Wallets struct {
+	logger      *zap.Logger
+	service     *wallets.Service
+	rbacService *rbac.Service
+}
+
+// NewWallets creates a new wallets handler.
+func NewWallets(logger *zap.Logger, service *wallets.Service, rbacService *rbac.Service) *Wallets {
+	return &Wallets{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// CreateWallet opens a wallet.
+func (h *Wallets) CreateWallet(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req wallets.CreateWalletRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	wallet, err := h.service.CreateWallet(r.Context(), tenantID, req)
+	if err != nil {
+		h.respondServiceError(w, "failed to create wallet", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, wallet)
+}
+
+// ListWallets lists wallets, optionally filtered by owner type and ID.
+func (h *Wallets) ListWallets(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	limit, offset, err := pageQuery(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	list, err := h.service.ListWallets(r.Context(), tenantID, wallets.WalletFilter{
+		OwnerType: r.URL.Query().Get("owner_type"),
+		OwnerID:   r.URL.Query().Get("owner_id"),
+		Limit:     limit,
+		Offset:    offset,
+	})
+	if err != nil {
+		h.respondServiceError(w, "failed to list wallets", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"wallets": list})
+}
+
+// GetWallet returns a wallet with its balance, held and available amounts.
+func (h *Wallets) GetWallet(w http.ResponseWriter, r *http.Request) {
+	tenantID, walletID, ok := h.walletParams(w, r)
+	if !ok {
+		return
+	}
+
+	wallet, err := h.service.GetWallet(r.Context(), tenantID, walletID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get wallet", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, wallet)
+}
+
+// Credit funds a wallet from a ledger account.
+func (h *Wallets) Credit(w http.ResponseWriter, r *http.Request) {
+	h.movement(w, r, "failed to credit wallet", h.service.Credit)
+}
+
+// Debit withdraws from a wallet to a ledger account.
+func (h *Wallets) Debit(w http.ResponseWriter, r *http.Request) {
+	h.movement(w, r, "failed to debit wallet", h.service.Debit)
+}
+
+// Transfer moves funds between two wallets.
+func (h *Wallets) Transfer(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req wallets.TransferRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	transaction, err := h.service.Transfer(r.Context(), tenantID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to transfer between wallets", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, transaction)
+}
+
+// ListTransactions lists the transactions into or out of a wallet.
+func (h *Wallets) ListTransactions(w http.ResponseWriter, r *http.Request) {
+	tenantID, walletID, ok := h.walletParams(w, r)
+	if !ok {
+		return
+	}
+
+	limit, offset, err := pageQuery(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	transactions, err := h.service.ListTransactions(r.Context(), tenantID, walletID, limit, offset)
+	if err != nil {
+		h.respondServiceError(w, "failed to list wallet transactions", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"transactions": transactions})
+}
+
+// GetTransaction returns a wallet transaction.
+func (h *Wallets) GetTransaction(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	transactionID, err := uuidParam(r, "transactionID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid wallet transaction ID")
+		return
+	}
+
+	transaction, err := h.service.GetTransaction(r.Context(), tenantID, transactionID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get wallet transaction", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, transaction)
+}
+
+// PlaceHold holds funds in a wallet.
+func (h *Wallets) PlaceHold(w http.ResponseWriter, r *http.Request) {
+	tenantID, walletID, ok := h.walletParams(w, r)
+	if !ok {
+		return
+	}
+
+	var req wallets.HoldRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	hold, err := h.service.PlaceHold(r.Context(), tenantID, walletID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to place wallet hold", err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, hold)
+}
+
+// ListHolds lists a wallet's holds, optionally filtered by status.
+func (h *Wallets) ListHolds(w http.ResponseWriter, r *http.Request) {
+	tenantID, walletID, ok := h.walletParams(w, r)
+	if !ok {
+		return
+	}
+
+	limit, offset, err := pageQuery(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	holds, err := h.service.ListHolds(r.Context(), tenantID, walletID, wallets.HoldFilter{
+		Status: r.URL.Query().Get("status"),
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		h.respondServiceError(w, "failed to list wallet holds", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"holds": holds})
+}
+
+// GetHold returns a hold on a wallet.
+func (h *Wallets) GetHold(w http.ResponseWriter, r *http.Request) {
+	h.hold(w, r, "failed to get wallet hold", h.service.GetHold)
+}
+
+// CaptureHold captures held funds into another wallet or a ledger account.
+func (h *Wallets) CaptureHold(w http.ResponseWriter, r *http.Request) {
+	var req wallets.CaptureRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	h.hold(w, r, "failed to capture wallet hold", func(ctx context.Context, tenantID, walletID, holdID uuid.UUID) (*wallets.Hold, error) {
+		return h.service.CaptureHold(ctx, tenantID, walletID, holdID, req, actorID(r))
+	})
+}
+
+// ReleaseHold returns held funds to a wallet's available balance.
+func (h *Wallets) ReleaseHold(w http.ResponseWriter, r *http.Request) {
+	h.hold(w, r, "failed to release wallet hold", h.service.ReleaseHold)
+}
+
+func (h *Wallets) walletParams(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	walletID, err := uuidParam(r, "walletID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid wallet ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+	return tenantID, walletID, true
+}
+
+func (h *Wallets) movement(w http.ResponseWriter, r *http.Request, message string, apply func(ctx context.Context, tenantID, walletID uuid.UUID, req wallets.MovementRequest, createdBy *uuid.UUID) (*wallets.Transaction, error)) {
+	tenantID, walletID, ok := h.walletParams(w, r)
+	if !ok {
+		return
+	}
+
+	var req wallets.MovementRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	transaction, err := apply(r.Context(), tenantID, walletID, req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, message, err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, transaction)
+}
+
+func (h *Wallets) hold(w http.ResponseWriter, r *http.Request, message string, apply func(ctx context.Context, tenantID, walletID, holdID uuid.UUID) (*wallets.Hold, error)) {
+	tenantID, walletID, ok := h.walletParams(w, r)
+	if !ok {
+		return
+	}
+
+	holdID, err := uuidParam(r, "holdID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid wallet hold ID")
+		return
+	}
+
+	hold, err := apply(r.Context(), tenantID, walletID, holdID)
+	if err != nil {
+		h.respondServiceError(w, message, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, hold)
+}
+
+func (h *Wallets) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, wallets.ErrWalletNotFound), errors.Is(err, wallets.ErrHoldNotFound),
+		errors.Is(err, wallets.ErrTransactionNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, wallets.ErrWalletExists), errors.Is(err, wallets.ErrHoldStatus),
+		errors.Is(err, wallets.ErrDuplicateReference):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, wallets.ErrInvalidWallet), errors.Is(err, wallets.ErrInvalidTransaction),
+		errors.Is(err, wallets.ErrInsufficientFunds):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers wallet routes.
+func (h *Wallets) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.wallets.view")
+	manage := middleware.RequirePermission(h.rbacService, h.logger, "treasury.wallets.manage")
+
+	r.With(view).Get("/wallets", h.ListWallets)
+	r.With(manage).Post("/wallets", h.CreateWallet)
+	r.With(view).Get("/wallets/{walletID}", h.GetWallet)
+	r.With(manage).Post("/wallets/{walletID}/credits", h.Credit)
+	r.With(manage).Post("/wallets/{walletID}/debits", h.Debit)
+	r.With(view).Get("/wallets/{walletID}/transactions", h.ListTransactions)
+	r.With(view).Get("/wallets/{walletID}/holds", h.ListHolds)
+	r.With(manage).Post("/wallets/{walletID}/holds", h.PlaceHold)
+	r.With(view).Get("/wallets/{walletID}/holds/{holdID}", h.GetHold)
+	r.With(manage).Post("/wallets/{walletID}/holds/{holdID}/capture", h.CaptureHold)
+	r.With(manage).Post("/wallets/{walletID}/holds/{holdID}/release", h.ReleaseHold)
+	r.With(manage).Post("/wallet-transfers", h.Transfer)
+	r.With(view).Get("/wallet-transactions/{transactionID}", h.GetTransaction)
+}
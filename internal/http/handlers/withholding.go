@@ -0,0 +1,220 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/rbac"
+	"github.com/bengobox/treasury-api/internal/modules/withholding"
+	"github.com/bengobox/treasury-api/internal/shared/middleware"
+)
+
+// Withholding handles withholding tax rates, certificates and schedules.
+type Withholding struct {
+	logger      *zap.Logger
+	service     *withholding.Service
+	rbacService *rbac.Service
+}
+
+// NewWithholding creates a new withholding tax handler.
+func NewWithholding(logger *zap.Logger, service *withholding.Service, rbacService *rbac.Service) *Withholding {
+	return &Withholding{
+		logger:      logger,
+		service:     service,
+		rbacService: rbacService,
+	}
+}
+
+// ListRates lists the tenant's withholding rates by service category.
+func (h *Withholding) ListRates(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	rates, err := h.service.ListRates(r.Context(), tenantID)
+	if err != nil {
+		h.respondServiceError(w, "failed to list withholding rates", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"rates": rates})
+}
+
+// SaveRate creates or changes the rate of a service category.
+func (h *Withholding) SaveRate(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	var req withholding.SaveRateRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	rate, err := h.service.SaveRate(r.Context(), tenantID, chi.URLParam(r, "category"), req, actorID(r))
+	if err != nil {
+		h.respondServiceError(w, "failed to save withholding rate", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, rate)
+}
+
+// ListCertificates lists withholding certificates, optionally filtered by
+// vendor, bill and payment dates from and to inclusive.
+func (h *Withholding) ListCertificates(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	limit, offset, err := pageQuery(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filter := withholding.ListFilter{Limit: limit, Offset: offset}
+	if value := r.URL.Query().Get("vendor_id"); value != "" {
+		vendorID, err := uuid.Parse(value)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "invalid vendor_id")
+			return
+		}
+		filter.VendorID = &vendorID
+	}
+	if value := r.URL.Query().Get("bill_id"); value != "" {
+		billID, err := uuid.Parse(value)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "invalid bill_id")
+			return
+		}
+		filter.BillID = &billID
+	}
+	if r.URL.Query().Get("from") != "" {
+		from, err := dateQuery(r, "from", time.Time{})
+		if err != nil {
+			respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		filter.From = &from
+	}
+	if r.URL.Query().Get("to") != "" {
+		to, err := dateQuery(r, "to", time.Time{})
+		if err != nil {
+			respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		to = to.AddDate(0, 0, 1)
+		filter.To = &to
+	}
+
+	certs, err := h.service.ListCertificates(r.Context(), tenantID, filter)
+	if err != nil {
+		h.respondServiceError(w, "failed to list withholding certificates", err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"certificates": certs})
+}
+
+// GetCertificate returns a withholding certificate as JSON, or as a PDF with
+// format=pdf.
+func (h *Withholding) GetCertificate(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	certificateID, err := uuidParam(r, "certificateID")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid certificate ID")
+		return
+	}
+
+	cert, err := h.service.GetCertificate(r.Context(), tenantID, certificateID)
+	if err != nil {
+		h.respondServiceError(w, "failed to get withholding certificate", err)
+		return
+	}
+
+	switch r.URL.Query().Get("format") {
+	case "", "json":
+		respondJSON(w, http.StatusOK, cert)
+	case "pdf":
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", cert.CertificateNumber+".pdf"))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(withholding.RenderCertificate(cert))
+	default:
+		respondError(w, http.StatusBadRequest, "invalid format: expected json or pdf")
+	}
+}
+
+// GetSchedule returns the month's withholding tax schedule as JSON, or CSV
+// with format=csv.
+func (h *Withholding) GetSchedule(w http.ResponseWriter, r *http.Request) {
+	tenantID, err := tenantIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid tenant ID")
+		return
+	}
+
+	schedule, err := h.service.Schedule(r.Context(), tenantID, r.URL.Query().Get("month"))
+	if err != nil {
+		h.respondServiceError(w, "failed to build withholding schedule", err)
+		return
+	}
+
+	switch r.URL.Query().Get("format") {
+	case "", "json":
+		respondJSON(w, http.StatusOK, schedule)
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("wht-schedule-%s.csv", schedule.Month)))
+		w.WriteHeader(http.StatusOK)
+		if err := schedule.WriteCSV(w); err != nil {
+			h.logger.Error("failed to write withholding schedule csv", zap.Error(err))
+		}
+	default:
+		respondError(w, http.StatusBadRequest, "invalid format: expected json or csv")
+	}
+}
+
+func (h *Withholding) respondServiceError(w http.ResponseWriter, message string, err error) {
+	switch {
+	case errors.Is(err, withholding.ErrRateNotFound), errors.Is(err, withholding.ErrCertificateNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, withholding.ErrInvalidRate), errors.Is(err, withholding.ErrInvalidSchedule):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, zap.Error(err))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// RegisterRoutes registers withholding tax routes.
+func (h *Withholding) RegisterRoutes(r chi.Router) {
+	view := middleware.RequirePermission(h.rbacService, h.logger, "treasury.bills.view")
+	configView := middleware.RequirePermission(h.rbacService, h.logger, "treasury.config.view")
+	configManage := middleware.RequirePermission(h.rbacService, h.logger, "treasury.config.manage")
+
+	r.With(configView).Get("/withholding/rates", h.ListRates)
+	r.With(configManage).Put("/withholding/rates/{category}", h.SaveRate)
+	r.With(view).Get("/withholding/certificates", h.ListCertificates)
+	r.With(view).Get("/withholding/certificates/{certificateID}", h.GetCertificate)
+	r.With(view).Get("/withholding/schedule", h.GetSchedule)
+}
@@ -14,7 +14,20 @@ import (
 	handlers "github.com/bengobox/treasury-api/internal/http/handlers"
 )
 
-func New(log *zap.Logger, health *handlers.Health, ledger *handlers.Ledger, payments *handlers.Payments, authMiddleware *authclient.AuthMiddleware) http.Handler {
+// RouteRegistrar mounts a module's tenant-scoped routes.
+type RouteRegistrar interface {
+	RegisterRoutes(r chi.Router)
+}
+
+// PublicRouteRegistrar is implemented by modules that also receive calls
+// from outside the platform, such as provider callbacks. Those routes are
+// mounted at the root without authentication; the module authenticates them
+// itself.
+type PublicRouteRegistrar interface {
+	RegisterPublicRoutes(r chi.Router)
+}
+
+func New(log *zap.Logger, health *handlers.Health, ledger *handlers.Ledger, payments *handlers.Payments, authMiddleware *authclient.AuthMiddleware, modules ...RouteRegistrar) http.Handler {
 	r := chi.NewRouter()
 
 	r.Use(middleware.RealIP)
@@ -37,6 +50,12 @@ func New(log *zap.Logger, health *handlers.Health, ledger *handlers.Ledger, paym
 	r.Get("/metrics", health.Metrics)
 	r.Get("/v1/docs/*", handlers.SwaggerUI)
 
+	for _, module := range modules {
+		if public, ok := module.(PublicRouteRegistrar); ok {
+			public.RegisterPublicRoutes(r)
+		}
+	}
+
 	r.Route("/api/v1", func(api chi.Router) {
 		// Serve OpenAPI spec (public, no auth required)
 		api.Get("/openapi.json", handlers.OpenAPIJSON)
@@ -54,6 +73,10 @@ func New(log *zap.Logger, health *handlers.Health, ledger *handlers.Ledger, paym
 			tenant.Route("/payments", func(paymentsRouter chi.Router) {
 				paymentsRouter.Get("/intents", payments.Intents)
 			})
+
+			for _, module := range modules {
+				module.RegisterRoutes(tenant)
+			}
 		})
 	})
 
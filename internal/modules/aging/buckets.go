@@ -0,0 +1,74 @@
+package aging
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// DefaultBoundaries produce the current, 1-30, 31-60, 61-90 and 90+ buckets.
+var DefaultBoundaries = []int{30, 60, 90}
+
+// ParseBoundaries parses a comma separated list of bucket boundaries
+// (e.g. "30,60,90").
+func ParseBoundaries(raw string) ([]int, error) {
+	if strings.TrimSpace(raw) == "" {
+		return DefaultBoundaries, nil
+	}
+
+	var boundaries []int
+	for _, part := range strings.Split(raw, ",") {
+		days, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("%w: bucket boundary %q is not a number", ErrInvalidOptions, part)
+		}
+		boundaries = append(boundaries, days)
+	}
+
+	if err := ValidateBoundaries(boundaries); err != nil {
+		return nil, err
+	}
+	return boundaries, nil
+}
+
+// ValidateBoundaries checks that bucket boundaries are positive and strictly
+// ascending.
+func ValidateBoundaries(boundaries []int) error {
+	if len(boundaries) == 0 || len(boundaries) > 10 {
+		return fmt.Errorf("%w: between 1 and 10 bucket boundaries are required", ErrInvalidOptions)
+	}
+	if boundaries[0] < 1 {
+		return fmt.Errorf("%w: bucket boundaries must be positive", ErrInvalidOptions)
+	}
+	if !slices.IsSorted(boundaries) || len(slices.Compact(slices.Clone(boundaries))) != len(boundaries) {
+		return fmt.Errorf("%w: bucket boundaries must be strictly ascending", ErrInvalidOptions)
+	}
+	return nil
+}
+
+// bucketLabels names the buckets for the boundaries.
+func bucketLabels(boundaries []int) []string {
+	labels := make([]string, 0, len(boundaries)+2)
+	labels = append(labels, "current")
+	lower := 1
+	for _, upper := range boundaries {
+		labels = append(labels, fmt.Sprintf("%d-%d", lower, upper))
+		lower = upper + 1
+	}
+	return append(labels, fmt.Sprintf("%d+", boundaries[len(boundaries)-1]))
+}
+
+// bucketIndex returns the bucket of a document days past due. Documents not
+// yet due are current.
+func bucketIndex(boundaries []int, daysPastDue int) int {
+	if daysPastDue <= 0 {
+		return 0
+	}
+	for i, upper := range boundaries {
+		if daysPastDue <= upper {
+			return i + 1
+		}
+	}
+	return len(boundaries) + 1
+}
@@ -0,0 +1,146 @@
+package aging
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultCashWeeks is the horizon of a cash requirements forecast: a quarter.
+const DefaultCashWeeks = 13
+
+// BuildCashRequirements places the expected payments into weekly buckets
+// starting opts.From, one row per currency.
+func BuildCashRequirements(opts CashOptions, payments []Payment) (*CashRequirements, error) {
+	if err := validateCashOptions(opts); err != nil {
+		return nil, err
+	}
+
+	from := startOfDay(opts.From)
+	forecast := &CashRequirements{
+		From:  from,
+		Weeks: make([]time.Time, opts.Weeks),
+		Mode:  opts.Mode,
+		Rows:  []CashRow{},
+	}
+	for i := range forecast.Weeks {
+		forecast.Weeks[i] = from.AddDate(0, 0, 7*i)
+	}
+
+	rows := map[string]*CashRow{}
+	for _, payment := range payments {
+		if !payment.Amount.IsPositive() {
+			continue
+		}
+		if opts.Currency != "" && payment.Currency != opts.Currency {
+			continue
+		}
+
+		row := rows[payment.Currency]
+		if row == nil {
+			row = &CashRow{
+				Currency: payment.Currency,
+				Overdue:  decimal.Zero,
+				Weeks:    make([]decimal.Decimal, opts.Weeks),
+				Later:    decimal.Zero,
+				Total:    decimal.Zero,
+			}
+			for i := range row.Weeks {
+				row.Weeks[i] = decimal.Zero
+			}
+			rows[payment.Currency] = row
+		}
+
+		days := int(startOfDay(payment.PaymentDate).Sub(from).Hours() / 24)
+		switch week := days / 7; {
+		case days < 0:
+			row.Overdue = row.Overdue.Add(payment.Amount)
+		case week < opts.Weeks:
+			row.Weeks[week] = row.Weeks[week].Add(payment.Amount)
+		default:
+			row.Later = row.Later.Add(payment.Amount)
+		}
+		row.Total = row.Total.Add(payment.Amount)
+
+		if opts.Mode == ModeDetail {
+			forecast.Payments = append(forecast.Payments, payment)
+		}
+	}
+
+	for _, row := range rows {
+		forecast.Rows = append(forecast.Rows, *row)
+	}
+	sort.Slice(forecast.Rows, func(i, j int) bool { return forecast.Rows[i].Currency < forecast.Rows[j].Currency })
+	sort.SliceStable(forecast.Payments, func(i, j int) bool {
+		return forecast.Payments[i].PaymentDate.Before(forecast.Payments[j].PaymentDate)
+	})
+
+	return forecast, nil
+}
+
+// WriteCSV writes the forecast as CSV: one line per currency in summary mode,
+// one line per payment in detail mode.
+func (c *CashRequirements) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	if c.Mode == ModeDetail {
+		header := []string{"payment_date", "source", "run_number", "vendor", "bill_number", "due_date", "currency", "amount"}
+		if err := writer.Write(header); err != nil {
+			return err
+		}
+		for _, payment := range c.Payments {
+			vendor := payment.VendorName
+			if vendor == "" {
+				vendor = payment.VendorID.String()
+			}
+			record := []string{
+				formatDate(payment.PaymentDate), payment.Source, payment.RunNumber, vendor,
+				payment.BillNumber, formatDate(payment.DueDate), payment.Currency, payment.Amount.StringFixed(2),
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+	} else {
+		header := []string{"currency", "overdue"}
+		for _, week := range c.Weeks {
+			header = append(header, "week_of_"+formatDate(week))
+		}
+		header = append(header, "later", "total")
+		if err := writer.Write(header); err != nil {
+			return err
+		}
+		for _, row := range c.Rows {
+			record := []string{row.Currency, row.Overdue.StringFixed(2)}
+			for _, amount := range row.Weeks {
+				record = append(record, amount.StringFixed(2))
+			}
+			record = append(record, row.Later.StringFixed(2), row.Total.StringFixed(2))
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func validateCashOptions(opts CashOptions) error {
+	if opts.From.IsZero() {
+		return fmt.Errorf("%w: from is required", ErrInvalidOptions)
+	}
+	if opts.Weeks < 1 || opts.Weeks > 52 {
+		return fmt.Errorf("%w: weeks must be between 1 and 52", ErrInvalidOptions)
+	}
+	switch opts.Mode {
+	case ModeSummary, ModeDetail:
+	default:
+		return fmt.Errorf("%w: mode must be summary or detail", ErrInvalidOptions)
+	}
+	return nil
+}
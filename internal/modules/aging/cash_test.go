@@ -0,0 +1,74 @@
+package aging
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestBuildCashRequirements(t *testing.T) {
+	from := time.Date(2024, 7, 1, 9, 0, 0, 0, time.UTC)
+	day := func(m time.Month, d int) time.Time { return time.Date(2024, m, d, 0, 0, 0, 0, time.UTC) }
+	vendor := uuid.New()
+	amount := decimal.RequireFromString
+
+	payments := []Payment{
+		{VendorID: vendor, Currency: "KES", PaymentDate: day(6, 28), Source: SourceDueDate, Amount: amount("100")},
+		{VendorID: vendor, Currency: "KES", PaymentDate: day(7, 1), Source: SourcePaymentRun, RunNumber: "PRUN-1", Amount: amount("250")},
+		{VendorID: vendor, Currency: "KES", PaymentDate: day(7, 7), Source: SourceScheduled, Amount: amount("50")},
+		{VendorID: vendor, Currency: "KES", PaymentDate: day(7, 8), Source: SourceDueDate, Amount: amount("75")},
+		{VendorID: vendor, Currency: "KES", PaymentDate: day(8, 30), Source: SourceDueDate, Amount: amount("400")},
+		{VendorID: vendor, Currency: "USD", PaymentDate: day(7, 2), Source: SourceDueDate, Amount: amount("20")},
+		{VendorID: vendor, Currency: "KES", PaymentDate: day(7, 2), Source: SourceDueDate, Amount: decimal.Zero},
+	}
+
+	forecast, err := BuildCashRequirements(CashOptions{From: from, Weeks: 4, Mode: ModeSummary}, payments)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if len(forecast.Weeks) != 4 || !forecast.Weeks[1].Equal(day(7, 8)) {
+		t.Fatalf("unexpected weeks %v", forecast.Weeks)
+	}
+	if len(forecast.Rows) != 2 || forecast.Rows[0].Currency != "KES" {
+		t.Fatalf("expected KES and USD rows, got %+v", forecast.Rows)
+	}
+
+	kes := forecast.Rows[0]
+	if !kes.Overdue.Equal(amount("100")) {
+		t.Fatalf("expected overdue 100, got %s", kes.Overdue)
+	}
+	if !kes.Weeks[0].Equal(amount("300")) || !kes.Weeks[1].Equal(amount("75")) || !kes.Weeks[2].IsZero() {
+		t.Fatalf("unexpected weekly amounts %v", kes.Weeks)
+	}
+	if !kes.Later.Equal(amount("400")) || !kes.Total.Equal(amount("875")) {
+		t.Fatalf("unexpected later %s / total %s", kes.Later, kes.Total)
+	}
+	if len(forecast.Payments) != 0 {
+		t.Fatal("summary mode should not list payments")
+	}
+
+	detail, err := BuildCashRequirements(CashOptions{From: from, Weeks: 4, Currency: "USD", Mode: ModeDetail}, payments)
+	if err != nil {
+		t.Fatalf("build detail: %v", err)
+	}
+	if len(detail.Rows) != 1 || len(detail.Payments) != 1 || detail.Payments[0].Currency != "USD" {
+		t.Fatalf("expected only USD in detail, got %+v", detail)
+	}
+
+	var buf bytes.Buffer
+	if err := forecast.WriteCSV(&buf); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "currency,overdue,week_of_2024-07-01") {
+		t.Fatalf("unexpected csv %q", buf.String())
+	}
+
+	if _, err := BuildCashRequirements(CashOptions{From: from, Weeks: 60, Mode: ModeSummary}, nil); err == nil {
+		t.Fatal("expected weeks above 52 to be rejected")
+	}
+}
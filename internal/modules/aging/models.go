@@ -0,0 +1,149 @@
+package aging
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Aging bases: the date days outstanding are counted from.
+const (
+	BasisDueDate     = "due_date"
+	BasisInvoiceDate = "invoice_date"
+)
+
+// Report groupings. Customer, vendor and outlet groups are split per
+// currency. Receivables group by customer, currency or outlet; payables by
+// vendor or currency.
+const (
+	GroupByCustomer = "customer"
+	GroupByVendor   = "vendor"
+	GroupByCurrency = "currency"
+	GroupByOutlet   = "outlet"
+)
+
+// Report modes.
+const (
+	ModeSummary = "summary"
+	ModeDetail  = "detail"
+)
+
+// Document types on an aging report.
+const (
+	DocumentInvoice          = "invoice"
+	DocumentCreditNote       = "credit_note"
+	DocumentUnappliedPayment = "unapplied_payment"
+	DocumentBill             = "bill"
+)
+
+// Options controls an aging report.
+type Options struct {
+	AsOf time.Time
+	// Boundaries are the inclusive upper day limits of the overdue buckets,
+	// e.g. 30, 60, 90 for current, 1-30, 31-60, 61-90 and 90+.
+	Boundaries []int
+	Basis      string
+	GroupBy    string
+	Mode       string
+	// CustomerIDs restricts the report to documents referencing these IDs.
+	CustomerIDs []uuid.UUID
+	// VendorIDs restricts a payables report to these vendors' bills.
+	VendorIDs []uuid.UUID
+}
+
+// Item is an open document as of the report date. Credits (credit notes and
+// unapplied payments) are not aged; they reduce the group's balance.
+type Item struct {
+	DocumentType string          `json:"document_type"`
+	DocumentID   uuid.UUID       `json:"document_id"`
+	Reference    string          `json:"reference,omitempty"`
+	PartyID      *uuid.UUID      `json:"party_id,omitempty"`
+	PartyName    string          `json:"party_name,omitempty"`
+	OutletID     *uuid.UUID      `json:"outlet_id,omitempty"`
+	Currency     string          `json:"currency"`
+	DocumentDate time.Time       `json:"document_date"`
+	DueDate      time.Time       `json:"due_date"`
+	DaysPastDue  int             `json:"days_past_due"`
+	Bucket       string          `json:"bucket,omitempty"`
+	Outstanding  decimal.Decimal `json:"outstanding"`
+	Credit       bool            `json:"credit,omitempty"`
+}
+
+// Row is one group of an aging report.
+type Row struct {
+	Key      string            `json:"key"`
+	PartyID  *uuid.UUID        `json:"party_id,omitempty"`
+	Label    string            `json:"label,omitempty"`
+	Currency string            `json:"currency"`
+	Buckets  []decimal.Decimal `json:"buckets"`
+	Credits  decimal.Decimal   `json:"credits"`
+	Balance  decimal.Decimal   `json:"balance"`
+	Items    []Item            `json:"items,omitempty"`
+}
+
+// Report is an aging report as of a date.
+type Report struct {
+	AsOf    time.Time `json:"as_of"`
+	Basis   string    `json:"basis"`
+	GroupBy string    `json:"group_by"`
+	Mode    string    `json:"mode"`
+	Buckets []string  `json:"buckets"`
+	Rows    []Row     `json:"rows"`
+	// Totals holds one row per currency.
+	Totals []Row `json:"totals"`
+}
+
+// Sources of the expected payment date of a bill on a cash requirements
+// forecast.
+const (
+	SourceDueDate    = "due_date"
+	SourceScheduled  = "scheduled"
+	SourcePaymentRun = "payment_run"
+)
+
+// Payment is an expected outgoing payment of an open vendor bill: on the
+// payment date of the approved payment run it is in, else on the date the
+// bill is scheduled for, else on its due date.
+type Payment struct {
+	BillID      uuid.UUID       `json:"bill_id"`
+	BillNumber  string          `json:"bill_number"`
+	VendorID    uuid.UUID       `json:"vendor_id"`
+	VendorName  string          `json:"vendor_name,omitempty"`
+	Currency    string          `json:"currency"`
+	DueDate     time.Time       `json:"due_date"`
+	PaymentDate time.Time       `json:"payment_date"`
+	Source      string          `json:"source"` // due_date, scheduled, payment_run
+	RunNumber   string          `json:"run_number,omitempty"`
+	Amount      decimal.Decimal `json:"amount"`
+}
+
+// CashOptions controls a cash requirements forecast.
+type CashOptions struct {
+	// From is the first day of the forecast; weeks start on its weekday.
+	From  time.Time
+	Weeks int
+	// Currency restricts the forecast to one currency.
+	Currency string
+	Mode     string
+}
+
+// CashRow is the payments falling due in one currency: overdue before the
+// forecast starts, in each week, and after the last week.
+type CashRow struct {
+	Currency string            `json:"currency"`
+	Overdue  decimal.Decimal   `json:"overdue"`
+	Weeks    []decimal.Decimal `json:"weeks"`
+	Later    decimal.Decimal   `json:"later"`
+	Total    decimal.Decimal   `json:"total"`
+}
+
+// CashRequirements projects outgoing vendor payments by week.
+type CashRequirements struct {
+	From time.Time `json:"from"`
+	// Weeks holds the first day of each week.
+	Weeks    []time.Time `json:"weeks"`
+	Mode     string      `json:"mode"`
+	Rows     []CashRow   `json:"rows"`
+	Payments []Payment   `json:"payments,omitempty"`
+}
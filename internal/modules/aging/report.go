@@ -0,0 +1,237 @@
+package aging
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrInvalidOptions is returned when report options are malformed.
+var ErrInvalidOptions = errors.New("invalid aging report options")
+
+// Build ages the open items as of opts.AsOf and groups them into rows.
+func Build(opts Options, items []Item) (*Report, error) {
+	if err := validateOptions(opts); err != nil {
+		return nil, err
+	}
+
+	labels := bucketLabels(opts.Boundaries)
+	asOf := startOfDay(opts.AsOf)
+	report := &Report{
+		AsOf:    asOf,
+		Basis:   opts.Basis,
+		GroupBy: opts.GroupBy,
+		Mode:    opts.Mode,
+		Buckets: labels,
+		Rows:    []Row{},
+		Totals:  []Row{},
+	}
+
+	rows := map[string]*Row{}
+	totals := map[string]*Row{}
+	for _, item := range items {
+		if !item.Outstanding.IsPositive() {
+			continue
+		}
+
+		basisDate := item.DueDate
+		if opts.Basis == BasisInvoiceDate || basisDate.IsZero() {
+			basisDate = item.DocumentDate
+		}
+		item.DaysPastDue = int(asOf.Sub(startOfDay(basisDate)).Hours() / 24)
+
+		key, label := groupKey(opts.GroupBy, item)
+		row := rows[key]
+		if row == nil {
+			row = newRow(key, label, item.Currency, len(labels))
+			if opts.GroupBy == GroupByCustomer || opts.GroupBy == GroupByVendor {
+				row.PartyID = item.PartyID
+			}
+			rows[key] = row
+		}
+		total := totals[item.Currency]
+		if total == nil {
+			total = newRow(item.Currency, item.Currency, item.Currency, len(labels))
+			totals[item.Currency] = total
+		}
+
+		if item.Credit {
+			item.DaysPastDue = 0
+			row.Credits = row.Credits.Add(item.Outstanding)
+			total.Credits = total.Credits.Add(item.Outstanding)
+			row.Balance = row.Balance.Sub(item.Outstanding)
+			total.Balance = total.Balance.Sub(item.Outstanding)
+		} else {
+			bucket := bucketIndex(opts.Boundaries, item.DaysPastDue)
+			item.Bucket = labels[bucket]
+			row.Buckets[bucket] = row.Buckets[bucket].Add(item.Outstanding)
+			total.Buckets[bucket] = total.Buckets[bucket].Add(item.Outstanding)
+			row.Balance = row.Balance.Add(item.Outstanding)
+			total.Balance = total.Balance.Add(item.Outstanding)
+		}
+
+		if opts.Mode == ModeDetail {
+			row.Items = append(row.Items, item)
+		}
+	}
+
+	for _, row := range rows {
+		sort.SliceStable(row.Items, func(i, j int) bool {
+			return row.Items[i].DaysPastDue > row.Items[j].DaysPastDue
+		})
+		report.Rows = append(report.Rows, *row)
+	}
+	sort.SliceStable(report.Rows, func(i, j int) bool {
+		if report.Rows[i].Currency != report.Rows[j].Currency {
+			return report.Rows[i].Currency < report.Rows[j].Currency
+		}
+		if !report.Rows[i].Balance.Equal(report.Rows[j].Balance) {
+			return report.Rows[i].Balance.GreaterThan(report.Rows[j].Balance)
+		}
+		return report.Rows[i].Key < report.Rows[j].Key
+	})
+
+	for _, total := range totals {
+		report.Totals = append(report.Totals, *total)
+	}
+	sort.Slice(report.Totals, func(i, j int) bool { return report.Totals[i].Currency < report.Totals[j].Currency })
+
+	return report, nil
+}
+
+// WriteCSV writes the report as CSV: one line per group in summary mode, one
+// line per document in detail mode.
+func (r *Report) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	if r.Mode == ModeDetail {
+		header := []string{"group", "currency", "document_type", "reference", "document_date", "due_date", "days_past_due", "bucket", "outstanding"}
+		if err := writer.Write(header); err != nil {
+			return err
+		}
+		for _, row := range r.Rows {
+			for _, item := range row.Items {
+				amount := item.Outstanding
+				if item.Credit {
+					amount = amount.Neg()
+				}
+				record := []string{
+					rowName(row), item.Currency, item.DocumentType, item.Reference,
+					item.DocumentDate.Format(time.DateOnly), formatDate(item.DueDate),
+					fmt.Sprint(item.DaysPastDue), item.Bucket, amount.StringFixed(2),
+				}
+				if err := writer.Write(record); err != nil {
+					return err
+				}
+			}
+		}
+	} else {
+		header := append([]string{"group", "currency"}, r.Buckets...)
+		header = append(header, "credits", "balance")
+		if err := writer.Write(header); err != nil {
+			return err
+		}
+		for _, row := range r.Rows {
+			if err := writer.Write(summaryRecord(rowName(row), row)); err != nil {
+				return err
+			}
+		}
+		for _, total := range r.Totals {
+			if err := writer.Write(summaryRecord("TOTAL", total)); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func validateOptions(opts Options) error {
+	if opts.AsOf.IsZero() {
+		return fmt.Errorf("%w: as_of is required", ErrInvalidOptions)
+	}
+	if err := ValidateBoundaries(opts.Boundaries); err != nil {
+		return err
+	}
+	switch opts.Basis {
+	case BasisDueDate, BasisInvoiceDate:
+	default:
+		return fmt.Errorf("%w: basis must be due_date or invoice_date", ErrInvalidOptions)
+	}
+	switch opts.GroupBy {
+	case GroupByCustomer, GroupByVendor, GroupByCurrency, GroupByOutlet:
+	default:
+		return fmt.Errorf("%w: group_by must be customer, vendor, currency or outlet", ErrInvalidOptions)
+	}
+	switch opts.Mode {
+	case ModeSummary, ModeDetail:
+	default:
+		return fmt.Errorf("%w: mode must be summary or detail", ErrInvalidOptions)
+	}
+	return nil
+}
+
+func groupKey(groupBy string, item Item) (key, label string) {
+	switch groupBy {
+	case GroupByCurrency:
+		return item.Currency, item.Currency
+	case GroupByOutlet:
+		if item.OutletID == nil {
+			return "none/" + item.Currency, ""
+		}
+		return item.OutletID.String() + "/" + item.Currency, ""
+	default:
+		if item.PartyID == nil {
+			return "none/" + item.Currency, ""
+		}
+		return item.PartyID.String() + "/" + item.Currency, item.PartyName
+	}
+}
+
+func newRow(key, label, currency string, buckets int) *Row {
+	row := &Row{
+		Key:      key,
+		Label:    label,
+		Currency: currency,
+		Buckets:  make([]decimal.Decimal, buckets),
+		Credits:  decimal.Zero,
+		Balance:  decimal.Zero,
+	}
+	for i := range row.Buckets {
+		row.Buckets[i] = decimal.Zero
+	}
+	return row
+}
+
+func rowName(row Row) string {
+	if row.Label != "" {
+		return row.Label
+	}
+	return row.Key
+}
+
+func summaryRecord(name string, row Row) []string {
+	record := []string{name, row.Currency}
+	for _, amount := range row.Buckets {
+		record = append(record, amount.StringFixed(2))
+	}
+	return append(record, row.Credits.StringFixed(2), row.Balance.StringFixed(2))
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
+
+func startOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
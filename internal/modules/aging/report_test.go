@@ -0,0 +1,87 @@
+package aging
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestBucketLabelsAndIndex(t *testing.T) {
+	labels := bucketLabels(DefaultBoundaries)
+	want := []string{"current", "1-30", "31-60", "61-90", "90+"}
+	if strings.Join(labels, ",") != strings.Join(want, ",") {
+		t.Fatalf("unexpected labels %v", labels)
+	}
+
+	cases := map[int]int{-5: 0, 0: 0, 1: 1, 30: 1, 31: 2, 90: 3, 91: 4, 400: 4}
+	for days, want := range cases {
+		if got := bucketIndex(DefaultBoundaries, days); got != want {
+			t.Fatalf("%d days: expected bucket %d, got %d", days, want, got)
+		}
+	}
+
+	if _, err := ParseBoundaries("15,45,30"); err == nil {
+		t.Fatal("expected unsorted boundaries to be rejected")
+	}
+	if got, err := ParseBoundaries("7, 14"); err != nil || len(got) != 2 || got[1] != 14 {
+		t.Fatalf("unexpected boundaries %v (%v)", got, err)
+	}
+}
+
+func TestBuild(t *testing.T) {
+	asOf := time.Date(2024, 6, 30, 15, 0, 0, 0, time.UTC)
+	day := func(m time.Month, d int) time.Time { return time.Date(2024, m, d, 0, 0, 0, 0, time.UTC) }
+	acme, globex := uuid.New(), uuid.New()
+	amount := decimal.RequireFromString
+
+	items := []Item{
+		{DocumentType: DocumentInvoice, PartyID: &acme, PartyName: "Acme", Currency: "KES", DocumentDate: day(6, 1), DueDate: day(7, 1), Outstanding: amount("1000")},
+		{DocumentType: DocumentInvoice, PartyID: &acme, PartyName: "Acme", Currency: "KES", DocumentDate: day(4, 1), DueDate: day(5, 15), Outstanding: amount("500")},
+		{DocumentType: DocumentUnappliedPayment, PartyID: &acme, PartyName: "Acme", Currency: "KES", DocumentDate: day(6, 20), Outstanding: amount("200"), Credit: true},
+		{DocumentType: DocumentInvoice, PartyID: &globex, PartyName: "Globex", Currency: "KES", DocumentDate: day(1, 10), DueDate: day(2, 10), Outstanding: amount("3000")},
+		{DocumentType: DocumentInvoice, PartyID: &globex, PartyName: "Globex", Currency: "USD", DocumentDate: day(6, 1), DueDate: day(6, 10), Outstanding: amount("40")},
+		{DocumentType: DocumentInvoice, PartyID: &globex, Currency: "KES", DocumentDate: day(6, 1), DueDate: day(6, 10), Outstanding: decimal.Zero},
+	}
+
+	report, err := Build(Options{AsOf: asOf, Boundaries: DefaultBoundaries, Basis: BasisDueDate, GroupBy: GroupByCustomer, Mode: ModeDetail}, items)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+
+	if len(report.Rows) != 3 {
+		t.Fatalf("expected 3 customer/currency rows, got %d", len(report.Rows))
+	}
+	globexKES := report.Rows[0]
+	if globexKES.Label != "Globex" || !globexKES.Buckets[4].Equal(amount("3000")) {
+		t.Fatalf("expected Globex KES 3000 in 90+, got %s %v", globexKES.Label, globexKES.Buckets)
+	}
+
+	acmeKES := report.Rows[1]
+	if !acmeKES.Buckets[0].Equal(amount("1000")) || !acmeKES.Buckets[2].Equal(amount("500")) {
+		t.Fatalf("unexpected Acme buckets %v", acmeKES.Buckets)
+	}
+	if !acmeKES.Credits.Equal(amount("200")) || !acmeKES.Balance.Equal(amount("1300")) {
+		t.Fatalf("expected Acme credits 200 and balance 1300, got %s and %s", acmeKES.Credits, acmeKES.Balance)
+	}
+	if len(acmeKES.Items) != 3 || acmeKES.Items[0].DaysPastDue != 46 || acmeKES.Items[0].Bucket != "31-60" {
+		t.Fatalf("expected oldest Acme item first at 46 days, got %+v", acmeKES.Items[0])
+	}
+
+	if len(report.Totals) != 2 || report.Totals[0].Currency != "KES" || !report.Totals[0].Balance.Equal(amount("4300")) {
+		t.Fatalf("unexpected totals %+v", report.Totals)
+	}
+
+	var buf bytes.Buffer
+	report.Mode = ModeSummary
+	if err := report.WriteCSV(&buf); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if lines[0] != "group,currency,current,1-30,31-60,61-90,90+,credits,balance" || len(lines) != 6 {
+		t.Fatalf("unexpected csv:\n%s", buf.String())
+	}
+}
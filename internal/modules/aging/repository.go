@@ -0,0 +1,22 @@
+package aging
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository loads the open documents an aging report is built from.
+type Repository interface {
+	// ReceivableItems returns invoices with an outstanding balance and the
+	// unapplied customer credits as they stood at the end of asOf, optionally
+	// restricted to documents referencing customerIDs.
+	ReceivableItems(ctx context.Context, tenantID uuid.UUID, asOf time.Time, customerIDs []uuid.UUID) ([]Item, error)
+	// PayableItems returns the vendor bills approved by the end of asOf that
+	// were still unpaid then, optionally restricted to vendorIDs.
+	PayableItems(ctx context.Context, tenantID uuid.UUID, asOf time.Time, vendorIDs []uuid.UUID) ([]Item, error)
+	// PayablePayments returns the expected payment of every open approved or
+	// scheduled vendor bill.
+	PayablePayments(ctx context.Context, tenantID uuid.UUID) ([]Payment, error)
+}
@@ -0,0 +1,500 @@
+package aging
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/customer"
+	"github.com/bengobox/treasury-api/internal/ent/invoice"
+	"github.com/bengobox/treasury-api/internal/ent/invoicepayment"
+	"github.com/bengobox/treasury-api/internal/ent/paymentintent"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrun"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrunitem"
+	"github.com/bengobox/treasury-api/internal/ent/paymenttransaction"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/vendor"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbill"
+	"github.com/bengobox/treasury-api/internal/ent/writeoff"
+	"github.com/bengobox/treasury-api/internal/ent/writeoffrecovery"
+)
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// ReceivableItems reconstructs open receivables at the end of asOf: documents
+// issued by then, less payment allocations applied and bad debt written off by
+// then.
+func (r *EntRepository) ReceivableItems(ctx context.Context, tenantID uuid.UUID, asOf time.Time, customerIDs []uuid.UUID) ([]Item, error) {
+	cutoff := startOfDay(asOf).AddDate(0, 0, 1)
+
+	// Invoices settled after the cutoff were still open on the report date.
+	settledLater, err := r.client.InvoicePayment.Query().
+		Where(
+			invoicepayment.TenantID(tenantID),
+			invoicepayment.AppliedAtGTE(cutoff),
+		).
+		Select(invoicepayment.FieldInvoiceID).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list later allocations: %w", err)
+	}
+	reopened := make([]uuid.UUID, len(settledLater))
+	for i, allocation := range settledLater {
+		reopened[i] = allocation.InvoiceID
+	}
+
+	invoiceQuery := r.client.Invoice.Query()
+	if len(customerIDs) > 0 {
+		invoiceQuery = invoiceQuery.Where(invoice.CustomerIDIn(customerIDs...))
+	}
+	entInvoices, err := invoiceQuery.
+		Where(
+			invoice.TenantID(tenantID),
+			invoice.InvoiceDateLT(cutoff),
+			invoice.StatusNotIn("draft", "cancelled"),
+			invoice.InvoiceTypeNEQ("proforma"),
+			invoice.Or(
+				invoice.PaymentStatusIn("unpaid", "partial"),
+				invoice.InvoiceType("credit_note"),
+				invoice.IDIn(reopened...),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list open invoices: %w", err)
+	}
+
+	invoiceIDs := make([]uuid.UUID, len(entInvoices))
+	for i, inv := range entInvoices {
+		invoiceIDs[i] = inv.ID
+	}
+	paid, err := appliedBefore(ctx, r.client, cutoff, invoicepayment.InvoiceIDIn(invoiceIDs...), func(a *ent.InvoicePayment) uuid.UUID { return a.InvoiceID })
+	if err != nil {
+		return nil, err
+	}
+	writtenOff, err := writtenOffBefore(ctx, r.client, tenantID, cutoff, invoiceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Item, 0, len(entInvoices))
+	for _, inv := range entInvoices {
+		item := Item{
+			DocumentType: DocumentInvoice,
+			DocumentID:   inv.ID,
+			Reference:    inv.InvoiceNumber,
+			OutletID:     metadataUUID(inv.Metadata, "outlet_id"),
+			Currency:     inv.Currency,
+			DocumentDate: inv.InvoiceDate,
+			DueDate:      inv.DueDate,
+			Outstanding:  inv.TotalAmount.Sub(paid[inv.ID]).Sub(writtenOff[inv.ID]),
+		}
+		if inv.InvoiceType == "credit_note" {
+			item.DocumentType = DocumentCreditNote
+			item.Outstanding = inv.TotalAmount.Abs()
+			item.Credit = true
+		}
+		if inv.CustomerID != uuid.Nil {
+			item.PartyID = &inv.CustomerID
+		}
+		items = append(items, item)
+	}
+
+	payments, err := r.unappliedPayments(ctx, tenantID, cutoff, customerIDs)
+	if err != nil {
+		return nil, err
+	}
+	items = append(items, payments...)
+
+	if err := r.resolveCustomers(ctx, tenantID, items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// unappliedPayments returns succeeded payments received before the cutoff
+// with the part not yet allocated to invoices by then.
+func (r *EntRepository) unappliedPayments(ctx context.Context, tenantID uuid.UUID, cutoff time.Time, customerIDs []uuid.UUID) ([]Item, error) {
+	paymentQuery := r.client.PaymentTransaction.Query()
+	if len(customerIDs) > 0 {
+		intentIDs, err := r.client.PaymentIntent.Query().
+			Where(
+				paymentintent.TenantID(tenantID),
+				paymentintent.CustomerIDIn(customerIDs...),
+			).
+			IDs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list customer payment intents: %w", err)
+		}
+		if len(intentIDs) == 0 {
+			return nil, nil
+		}
+		paymentQuery = paymentQuery.Where(paymenttransaction.PaymentIntentIDIn(intentIDs...))
+	}
+
+	payments, err := paymentQuery.
+		Where(
+			paymenttransaction.TenantID(tenantID),
+			paymenttransaction.TransactionType("payment"),
+			paymenttransaction.Status("succeeded"),
+			paymenttransaction.Or(
+				paymenttransaction.ProcessedAtLT(cutoff),
+				paymenttransaction.And(
+					paymenttransaction.ProcessedAtIsNil(),
+					paymenttransaction.CreatedAtLT(cutoff),
+				),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list payments: %w", err)
+	}
+	if len(payments) == 0 {
+		return nil, nil
+	}
+
+	paymentIDs := make([]uuid.UUID, len(payments))
+	intentIDs := make([]uuid.UUID, len(payments))
+	for i, payment := range payments {
+		paymentIDs[i] = payment.ID
+		intentIDs[i] = payment.PaymentIntentID
+	}
+
+	allocated, err := appliedBefore(ctx, r.client, cutoff, invoicepayment.PaymentTransactionIDIn(paymentIDs...), func(a *ent.InvoicePayment) uuid.UUID { return a.PaymentTransactionID })
+	if err != nil {
+		return nil, err
+	}
+
+	intents, err := r.client.PaymentIntent.Query().
+		Where(paymentintent.IDIn(intentIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list payment intents: %w", err)
+	}
+	intentByID := make(map[uuid.UUID]*ent.PaymentIntent, len(intents))
+	for _, intent := range intents {
+		intentByID[intent.ID] = intent
+	}
+
+	var items []Item
+	for _, payment := range payments {
+		unapplied := payment.Amount.Sub(allocated[payment.ID])
+		if !unapplied.IsPositive() {
+			continue
+		}
+
+		received := payment.CreatedAt
+		if !payment.ProcessedAt.IsZero() {
+			received = payment.ProcessedAt
+		}
+		item := Item{
+			DocumentType: DocumentUnappliedPayment,
+			DocumentID:   payment.ID,
+			Reference:    payment.ProviderReference,
+			Currency:     payment.Currency,
+			DocumentDate: received,
+			Outstanding:  unapplied,
+			Credit:       true,
+		}
+		if intent := intentByID[payment.PaymentIntentID]; intent != nil {
+			if intent.CustomerID != uuid.Nil {
+				item.PartyID = &intent.CustomerID
+			}
+			item.OutletID = metadataUUID(intent.Metadata, "outlet_id")
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// resolveCustomers names the customer of each item, folding documents that
+// reference a customer's linked auth user into that customer.
+func (r *EntRepository) resolveCustomers(ctx context.Context, tenantID uuid.UUID, items []Item) error {
+	seen := map[uuid.UUID]bool{}
+	var ids []uuid.UUID
+	for _, item := range items {
+		if item.PartyID != nil && !seen[*item.PartyID] {
+			seen[*item.PartyID] = true
+			ids = append(ids, *item.PartyID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	entCustomers, err := r.client.Customer.Query().
+		Where(
+			customer.TenantID(tenantID),
+			customer.Or(
+				customer.IDIn(ids...),
+				customer.AuthUserIDIn(ids...),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("list customers: %w", err)
+	}
+
+	byIdentity := make(map[uuid.UUID]*ent.Customer, len(entCustomers)*2)
+	for _, entCustomer := range entCustomers {
+		byIdentity[entCustomer.ID] = entCustomer
+		if entCustomer.AuthUserID != uuid.Nil {
+			byIdentity[entCustomer.AuthUserID] = entCustomer
+		}
+	}
+
+	for i := range items {
+		if items[i].PartyID == nil {
+			continue
+		}
+		if entCustomer := byIdentity[*items[i].PartyID]; entCustomer != nil {
+			id := entCustomer.ID
+			items[i].PartyID = &id
+			items[i].PartyName = entCustomer.LegalName
+		}
+	}
+	return nil
+}
+
+// PayableItems reconstructs open payables at the end of asOf: bills approved
+// by then that were not paid by then. Bills are settled in full, so a bill
+// paid after the cutoff was owed in full on the report date.
+func (r *EntRepository) PayableItems(ctx context.Context, tenantID uuid.UUID, asOf time.Time, vendorIDs []uuid.UUID) ([]Item, error) {
+	cutoff := startOfDay(asOf).AddDate(0, 0, 1)
+
+	query := r.client.VendorBill.Query()
+	if len(vendorIDs) > 0 {
+		query = query.Where(vendorbill.VendorIDIn(vendorIDs...))
+	}
+	entBills, err := query.
+		Where(
+			vendorbill.TenantID(tenantID),
+			vendorbill.StatusIn("approved", "scheduled", "paid"),
+			vendorbill.ApprovedAtLT(cutoff),
+			vendorbill.Or(
+				vendorbill.PaidAtIsNil(),
+				vendorbill.PaidAtGTE(cutoff),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list open bills: %w", err)
+	}
+
+	items := make([]Item, len(entBills))
+	for i, entBill := range entBills {
+		outstanding := entBill.TotalAmount
+		if entBill.PaidAt.IsZero() {
+			outstanding = outstanding.Sub(entBill.PaidAmount).Sub(entBill.DiscountTaken).Sub(entBill.WithholdingTaken)
+		}
+		vendorID := entBill.VendorID
+		items[i] = Item{
+			DocumentType: DocumentBill,
+			DocumentID:   entBill.ID,
+			Reference:    entBill.BillNumber,
+			PartyID:      &vendorID,
+			Currency:     entBill.Currency,
+			DocumentDate: entBill.BillDate,
+			DueDate:      entBill.DueDate,
+			Outstanding:  outstanding,
+		}
+	}
+
+	names, err := r.vendorNames(ctx, tenantID, vendorIDsOf(entBills))
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		items[i].PartyName = names[*items[i].PartyID]
+	}
+
+	return items, nil
+}
+
+// PayablePayments returns the open approved and scheduled bills with the
+// date each is expected to be paid. Bills in an approved payment run are paid
+// on the run's payment date, net of any early-payment discount. Withholding
+// tax is included as it still has to be funded when remitted.
+func (r *EntRepository) PayablePayments(ctx context.Context, tenantID uuid.UUID) ([]Payment, error) {
+	entBills, err := r.client.VendorBill.Query().
+		Where(
+			vendorbill.TenantID(tenantID),
+			vendorbill.StatusIn("approved", "scheduled"),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list open bills: %w", err)
+	}
+
+	entRuns, err := r.client.PaymentRun.Query().
+		Where(
+			paymentrun.TenantID(tenantID),
+			paymentrun.Status("approved"),
+		).
+		WithItems(func(q *ent.PaymentRunItemQuery) {
+			q.Where(paymentrunitem.Status("proposed"))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list approved payment runs: %w", err)
+	}
+	type runPayment struct {
+		run  *ent.PaymentRun
+		item *ent.PaymentRunItem
+	}
+	inRun := map[uuid.UUID]runPayment{}
+	for _, entRun := range entRuns {
+		for _, entItem := range entRun.Edges.Items {
+			inRun[entItem.BillID] = runPayment{run: entRun, item: entItem}
+		}
+	}
+
+	names, err := r.vendorNames(ctx, tenantID, vendorIDsOf(entBills))
+	if err != nil {
+		return nil, err
+	}
+
+	payments := make([]Payment, 0, len(entBills))
+	for _, entBill := range entBills {
+		payment := Payment{
+			BillID:      entBill.ID,
+			BillNumber:  entBill.BillNumber,
+			VendorID:    entBill.VendorID,
+			VendorName:  names[entBill.VendorID],
+			Currency:    entBill.Currency,
+			DueDate:     entBill.DueDate,
+			PaymentDate: entBill.DueDate,
+			Source:      SourceDueDate,
+			Amount:      entBill.TotalAmount.Sub(entBill.PaidAmount).Sub(entBill.DiscountTaken).Sub(entBill.WithholdingTaken),
+		}
+		if scheduled, ok := inRun[entBill.ID]; ok {
+			payment.PaymentDate = scheduled.run.PaymentDate
+			payment.Source = SourcePaymentRun
+			payment.RunNumber = scheduled.run.RunNumber
+			payment.Amount = scheduled.item.Amount.Add(scheduled.item.WithholdingAmount)
+		} else if !entBill.ScheduledFor.IsZero() {
+			payment.PaymentDate = entBill.ScheduledFor
+			payment.Source = SourceScheduled
+		}
+		payments = append(payments, payment)
+	}
+
+	return payments, nil
+}
+
+// vendorNames returns the legal names of the vendors.
+func (r *EntRepository) vendorNames(ctx context.Context, tenantID uuid.UUID, vendorIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	if len(vendorIDs) == 0 {
+		return nil, nil
+	}
+
+	entVendors, err := r.client.Vendor.Query().
+		Where(
+			vendor.TenantID(tenantID),
+			vendor.IDIn(vendorIDs...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list vendors: %w", err)
+	}
+
+	names := make(map[uuid.UUID]string, len(entVendors))
+	for _, entVendor := range entVendors {
+		names[entVendor.ID] = entVendor.LegalName
+	}
+	return names, nil
+}
+
+func vendorIDsOf(entBills []*ent.VendorBill) []uuid.UUID {
+	seen := map[uuid.UUID]bool{}
+	var ids []uuid.UUID
+	for _, entBill := range entBills {
+		if !seen[entBill.VendorID] {
+			seen[entBill.VendorID] = true
+			ids = append(ids, entBill.VendorID)
+		}
+	}
+	return ids
+}
+
+// appliedBefore sums allocations applied before the cutoff, keyed by key.
+func appliedBefore(ctx context.Context, client *ent.Client, cutoff time.Time, filter predicate.InvoicePayment, key func(*ent.InvoicePayment) uuid.UUID) (map[uuid.UUID]decimal.Decimal, error) {
+	entAllocations, err := client.InvoicePayment.Query().
+		Where(filter, invoicepayment.AppliedAtLT(cutoff)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list allocations: %w", err)
+	}
+
+	sums := map[uuid.UUID]decimal.Decimal{}
+	for _, allocation := range entAllocations {
+		sums[key(allocation)] = sums[key(allocation)].Add(allocation.AmountApplied)
+	}
+	return sums, nil
+}
+
+// writtenOffBefore sums the bad debt written off each invoice before the
+// cutoff, net of the amounts recovered by then.
+func writtenOffBefore(ctx context.Context, client *ent.Client, tenantID uuid.UUID, cutoff time.Time, invoiceIDs []uuid.UUID) (map[uuid.UUID]decimal.Decimal, error) {
+	entWriteOffs, err := client.WriteOff.Query().
+		Where(
+			writeoff.TenantID(tenantID),
+			writeoff.InvoiceIDIn(invoiceIDs...),
+			writeoff.Status("approved"),
+			writeoff.DecidedAtLT(cutoff),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list write-offs: %w", err)
+	}
+	if len(entWriteOffs) == 0 {
+		return nil, nil
+	}
+
+	sums := map[uuid.UUID]decimal.Decimal{}
+	for _, entWriteOff := range entWriteOffs {
+		sums[entWriteOff.InvoiceID] = sums[entWriteOff.InvoiceID].Add(entWriteOff.Amount)
+	}
+
+	entRecoveries, err := client.WriteOffRecovery.Query().
+		Where(
+			writeoffrecovery.TenantID(tenantID),
+			writeoffrecovery.InvoiceIDIn(invoiceIDs...),
+			writeoffrecovery.RecoveredAtLT(cutoff),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list write-off recoveries: %w", err)
+	}
+	for _, recovery := range entRecoveries {
+		sums[recovery.InvoiceID] = sums[recovery.InvoiceID].Sub(recovery.Amount)
+	}
+	return sums, nil
+}
+
+// metadataUUID reads a UUID stored as a string in document metadata.
+func metadataUUID(metadata map[string]any, key string) *uuid.UUID {
+	raw, ok := metadata[key].(string)
+	if !ok {
+		return nil
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
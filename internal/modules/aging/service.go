@@ -0,0 +1,110 @@
+package aging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Service produces aging reports.
+type Service struct {
+	repo   Repository
+	logger *zap.Logger
+}
+
+// NewService creates a new aging service.
+func NewService(repo Repository, logger *zap.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Receivables returns the AR aging report as of opts.AsOf.
+func (s *Service) Receivables(ctx context.Context, tenantID uuid.UUID, opts Options) (*Report, error) {
+	opts = withDefaults(opts)
+	if opts.GroupBy == GroupByVendor {
+		return nil, fmt.Errorf("%w: receivables group_by must be customer, currency or outlet", ErrInvalidOptions)
+	}
+	if err := validateOptions(opts); err != nil {
+		return nil, err
+	}
+
+	items, err := s.repo.ReceivableItems(ctx, tenantID, opts.AsOf, opts.CustomerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return Build(opts, items)
+}
+
+// Payables returns the AP aging report as of opts.AsOf, grouped by vendor
+// unless grouped by currency.
+func (s *Service) Payables(ctx context.Context, tenantID uuid.UUID, opts Options) (*Report, error) {
+	if opts.GroupBy == "" {
+		opts.GroupBy = GroupByVendor
+	}
+	opts = withDefaults(opts)
+	if opts.GroupBy != GroupByVendor && opts.GroupBy != GroupByCurrency {
+		return nil, fmt.Errorf("%w: payables group_by must be vendor or currency", ErrInvalidOptions)
+	}
+	if err := validateOptions(opts); err != nil {
+		return nil, err
+	}
+
+	items, err := s.repo.PayableItems(ctx, tenantID, opts.AsOf, opts.VendorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return Build(opts, items)
+}
+
+// CashRequirements projects the outgoing payments of open vendor bills by
+// week so funding can be planned. Payments expected before opts.From are
+// reported as overdue.
+func (s *Service) CashRequirements(ctx context.Context, tenantID uuid.UUID, opts CashOptions) (*CashRequirements, error) {
+	if opts.From.IsZero() {
+		opts.From = time.Now().UTC()
+	}
+	if opts.Weeks == 0 {
+		opts.Weeks = DefaultCashWeeks
+	}
+	if opts.Mode == "" {
+		opts.Mode = ModeSummary
+	}
+	opts.Currency = strings.ToUpper(strings.TrimSpace(opts.Currency))
+	if err := validateCashOptions(opts); err != nil {
+		return nil, err
+	}
+
+	payments, err := s.repo.PayablePayments(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return BuildCashRequirements(opts, payments)
+}
+
+func withDefaults(opts Options) Options {
+	if opts.AsOf.IsZero() {
+		opts.AsOf = time.Now().UTC()
+	}
+	if len(opts.Boundaries) == 0 {
+		opts.Boundaries = DefaultBoundaries
+	}
+	if opts.Basis == "" {
+		opts.Basis = BasisDueDate
+	}
+	if opts.GroupBy == "" {
+		opts.GroupBy = GroupByCustomer
+	}
+	if opts.Mode == "" {
+		opts.Mode = ModeSummary
+	}
+	return opts
+}
@@ -0,0 +1,130 @@
+package baddebts
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Write-off statuses.
+const (
+	StatusPending  = "pending"
+	StatusApproved = "approved"
+	StatusRejected = "rejected"
+)
+
+// Provisioning run triggers.
+const (
+	TriggerScheduled = "scheduled"
+	TriggerManual    = "manual"
+)
+
+// Outbox events published by the bad debt module.
+const (
+	EventInvoiceWrittenOff = "treasury.invoice.written_off"
+	EventWriteOffRecovered = "treasury.invoice.write_off_recovered"
+	referenceTypeWriteOff  = "write_off"
+	referenceTypeRecovery  = "write_off_recovery"
+	referenceTypeProvision = "provision"
+)
+
+// WriteOff is a request to write off an invoice balance as bad debt.
+type WriteOff struct {
+	ID              uuid.UUID       `json:"id"`
+	TenantID        uuid.UUID       `json:"tenant_id"`
+	InvoiceID       uuid.UUID       `json:"invoice_id"`
+	CustomerID      *uuid.UUID      `json:"customer_id,omitempty"`
+	Amount          decimal.Decimal `json:"amount"`
+	RecoveredAmount decimal.Decimal `json:"recovered_amount"`
+	Currency        string          `json:"currency"`
+	Reason          string          `json:"reason"`
+	Status          string          `json:"status"` // pending, approved, rejected
+	RequestedBy     *uuid.UUID      `json:"requested_by,omitempty"`
+	DecidedBy       *uuid.UUID      `json:"decided_by,omitempty"`
+	DecidedAt       *time.Time      `json:"decided_at,omitempty"`
+	DecisionNote    string          `json:"decision_note,omitempty"`
+	JournalEntryID  *uuid.UUID      `json:"journal_entry_id,omitempty"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
+// Recoverable returns the written-off amount that can still be reinstated.
+func (w *WriteOff) Recoverable() decimal.Decimal {
+	if w.Status != StatusApproved {
+		return decimal.Zero
+	}
+	return w.Amount.Sub(w.RecoveredAmount)
+}
+
+// WriteOffRequest asks for an invoice balance to be written off. A nil amount
+// writes off the whole outstanding balance.
+type WriteOffRequest struct {
+	Amount *decimal.Decimal `json:"amount,omitempty"`
+	Reason string           `json:"reason"`
+}
+
+// Decision approves or rejects a pending write-off.
+type Decision struct {
+	Note string `json:"note,omitempty"`
+}
+
+// Recovery reinstates part of a written-off balance on its invoice.
+type Recovery struct {
+	ID             uuid.UUID       `json:"id"`
+	WriteOffID     uuid.UUID       `json:"write_off_id"`
+	InvoiceID      uuid.UUID       `json:"invoice_id"`
+	Amount         decimal.Decimal `json:"amount"`
+	Note           string          `json:"note,omitempty"`
+	JournalEntryID *uuid.UUID      `json:"journal_entry_id,omitempty"`
+	RecoveredBy    *uuid.UUID      `json:"recovered_by,omitempty"`
+	RecoveredAt    time.Time       `json:"recovered_at"`
+}
+
+// RecoveryRequest asks for a written-off amount to be reinstated.
+type RecoveryRequest struct {
+	Amount decimal.Decimal `json:"amount"`
+	Note   string          `json:"note,omitempty"`
+}
+
+// Policy holds a tenant's expected credit loss rate per aging bucket.
+type Policy struct {
+	TenantID   uuid.UUID         `json:"tenant_id"`
+	Boundaries []int             `json:"boundaries"`
+	LossRates  []decimal.Decimal `json:"loss_rates"`
+	Enabled    bool              `json:"enabled"`
+	UpdatedBy  *uuid.UUID        `json:"updated_by,omitempty"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+}
+
+// PolicyRequest replaces a tenant's provisioning policy.
+type PolicyRequest struct {
+	Boundaries []int             `json:"boundaries"`
+	LossRates  []decimal.Decimal `json:"loss_rates"`
+	Enabled    *bool             `json:"enabled,omitempty"`
+}
+
+// BucketProvision is the allowance required for one aging bucket.
+type BucketProvision struct {
+	Bucket    string          `json:"bucket"`
+	Exposure  decimal.Decimal `json:"exposure"`
+	LossRate  decimal.Decimal `json:"loss_rate"`
+	Allowance decimal.Decimal `json:"allowance"`
+}
+
+// ProvisionRun records an allowance calculation for one currency and the
+// adjustment journal it posted.
+type ProvisionRun struct {
+	ID                uuid.UUID         `json:"id"`
+	TenantID          uuid.UUID         `json:"tenant_id"`
+	Currency          string            `json:"currency"`
+	AsOf              time.Time         `json:"as_of"`
+	Buckets           []BucketProvision `json:"buckets"`
+	RequiredAllowance decimal.Decimal   `json:"required_allowance"`
+	PreviousAllowance decimal.Decimal   `json:"previous_allowance"`
+	Adjustment        decimal.Decimal   `json:"adjustment"`
+	JournalEntryID    *uuid.UUID        `json:"journal_entry_id,omitempty"`
+	Trigger           string            `json:"trigger"`
+	CreatedBy         *uuid.UUID        `json:"created_by,omitempty"`
+	CreatedAt         time.Time         `json:"created_at"`
+}
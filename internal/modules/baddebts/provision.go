@@ -0,0 +1,57 @@
+package baddebts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/aging"
+)
+
+// validatePolicy checks that there is one loss rate between 0 and 1 for every
+// aging bucket the boundaries produce.
+func validatePolicy(req PolicyRequest) error {
+	if err := aging.ValidateBoundaries(req.Boundaries); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidPolicy, err)
+	}
+	if len(req.LossRates) != len(req.Boundaries)+2 {
+		return fmt.Errorf("%w: %d boundaries need %d loss rates", ErrInvalidPolicy, len(req.Boundaries), len(req.Boundaries)+2)
+	}
+	for _, rate := range req.LossRates {
+		if rate.IsNegative() || rate.GreaterThan(decimal.NewFromInt(1)) {
+			return fmt.Errorf("%w: loss rate %s must be between 0 and 1", ErrInvalidPolicy, rate)
+		}
+	}
+	return nil
+}
+
+// allowance applies the loss rates to the exposure in each bucket and returns
+// the per bucket provisions and the total allowance required.
+func allowance(labels []string, exposures, rates []decimal.Decimal) ([]BucketProvision, decimal.Decimal) {
+	buckets := make([]BucketProvision, len(labels))
+	required := decimal.Zero
+	for i, label := range labels {
+		bucket := BucketProvision{Bucket: label, Exposure: decimal.Zero, LossRate: decimal.Zero, Allowance: decimal.Zero}
+		if i < len(exposures) {
+			bucket.Exposure = exposures[i]
+		}
+		if i < len(rates) {
+			bucket.LossRate = rates[i]
+		}
+		bucket.Allowance = bucket.Exposure.Mul(bucket.LossRate).Round(2)
+		required = required.Add(bucket.Allowance)
+		buckets[i] = bucket
+	}
+	return buckets, required
+}
+
+// previousMonthEnd returns the last day of the month before now.
+func previousMonthEnd(now time.Time) time.Time {
+	firstOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	return firstOfMonth.AddDate(0, 0, -1)
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,73 @@
+package baddebts
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func rates(values ...string) []decimal.Decimal {
+	out := make([]decimal.Decimal, len(values))
+	for i, v := range values {
+		out[i] = decimal.RequireFromString(v)
+	}
+	return out
+}
+
+func TestAllowance(t *testing.T) {
+	labels := []string{"current", "1-30", "31-60", "61-90", "90+"}
+	exposures := rates("100000", "40000", "15000", "8000", "12345.67")
+	lossRates := rates("0.01", "0.05", "0.2", "0.5", "1")
+
+	buckets, required := allowance(labels, exposures, lossRates)
+	if len(buckets) != 5 {
+		t.Fatalf("expected 5 buckets, got %d", len(buckets))
+	}
+	if !buckets[2].Allowance.Equal(decimal.NewFromInt(3000)) {
+		t.Fatalf("expected 31-60 allowance of 3000, got %s", buckets[2].Allowance)
+	}
+	// 1000 + 2000 + 3000 + 4000 + 12345.67
+	if !required.Equal(decimal.RequireFromString("22345.67")) {
+		t.Fatalf("expected allowance of 22345.67, got %s", required)
+	}
+
+	// A currency with no receivables left requires no allowance.
+	buckets, required = allowance(labels, nil, lossRates)
+	if !required.IsZero() || !buckets[4].Exposure.IsZero() {
+		t.Fatalf("expected empty exposure to need no allowance, got %s", required)
+	}
+}
+
+func TestValidatePolicy(t *testing.T) {
+	valid := PolicyRequest{Boundaries: []int{30, 60, 90}, LossRates: rates("0.01", "0.05", "0.2", "0.5", "1")}
+	if err := validatePolicy(valid); err != nil {
+		t.Fatalf("expected valid policy, got %v", err)
+	}
+
+	cases := map[string]PolicyRequest{
+		"missing rate":   {Boundaries: []int{30, 60, 90}, LossRates: rates("0.01", "0.05", "0.2", "0.5")},
+		"rate above one": {Boundaries: []int{30}, LossRates: rates("0", "0.5", "1.5")},
+		"negative rate":  {Boundaries: []int{30}, LossRates: rates("0", "-0.1", "1")},
+		"bad boundaries": {Boundaries: []int{60, 30}, LossRates: rates("0", "0.1", "0.5", "1")},
+		"no boundaries":  {LossRates: rates("0", "1")},
+	}
+	for name, req := range cases {
+		if err := validatePolicy(req); !errors.Is(err, ErrInvalidPolicy) {
+			t.Errorf("%s: expected ErrInvalidPolicy, got %v", name, err)
+		}
+	}
+}
+
+func TestPreviousMonthEnd(t *testing.T) {
+	got := previousMonthEnd(time.Date(2024, 3, 5, 14, 0, 0, 0, time.UTC))
+	if !got.Equal(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected 2024-02-29, got %s", got)
+	}
+
+	got = previousMonthEnd(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	if !got.Equal(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected 2023-12-31, got %s", got)
+	}
+}
@@ -0,0 +1,57 @@
+package baddebts
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrWriteOffNotFound is returned when a write-off does not exist.
+	ErrWriteOffNotFound = errors.New("write-off not found")
+	// ErrInvoiceNotFound is returned when an invoice does not exist.
+	ErrInvoiceNotFound = errors.New("invoice not found")
+	// ErrInvalidWriteOff is returned when a write-off or recovery request is malformed
+	// or the invoice cannot be written off.
+	ErrInvalidWriteOff = errors.New("invalid write-off request")
+	// ErrWriteOffPending is returned when the invoice already has a pending write-off.
+	ErrWriteOffPending = errors.New("invoice already has a pending write-off")
+	// ErrWriteOffDecided is returned when a write-off is no longer pending.
+	ErrWriteOffDecided = errors.New("write-off has already been decided")
+	// ErrSelfApproval is returned when the requester tries to decide their own write-off.
+	ErrSelfApproval = errors.New("write-off must be decided by another user")
+	// ErrPolicyNotFound is returned when the tenant has no provisioning policy.
+	ErrPolicyNotFound = errors.New("provisioning policy not found")
+	// ErrInvalidPolicy is returned when a provisioning policy is malformed.
+	ErrInvalidPolicy = errors.New("invalid provisioning policy")
+	// ErrProvisionExists is returned when the allowance was already calculated
+	// at or after the requested date.
+	ErrProvisionExists = errors.New("provision already calculated for this date")
+)
+
+// Repository abstracts persistence for write-offs and doubtful debt provisions.
+type Repository interface {
+	// CreateWriteOff records a pending write-off of the invoice balance.
+	CreateWriteOff(ctx context.Context, tenantID, invoiceID uuid.UUID, req WriteOffRequest, requestedBy *uuid.UUID) (*WriteOff, error)
+	GetWriteOff(ctx context.Context, tenantID, id uuid.UUID) (*WriteOff, error)
+	ListWriteOffs(ctx context.Context, tenantID uuid.UUID, status string) ([]*WriteOff, error)
+	// ApproveWriteOff posts the write-off journal, reduces the invoice balance
+	// and enqueues the written-off event.
+	ApproveWriteOff(ctx context.Context, tenantID, id uuid.UUID, decision Decision, decidedBy *uuid.UUID) (*WriteOff, error)
+	RejectWriteOff(ctx context.Context, tenantID, id uuid.UUID, decision Decision, decidedBy *uuid.UUID) (*WriteOff, error)
+	// RecoverWriteOff reinstates part of an approved write-off on its invoice
+	// and posts the recovery journal.
+	RecoverWriteOff(ctx context.Context, tenantID, id uuid.UUID, req RecoveryRequest, recoveredBy *uuid.UUID) (*Recovery, error)
+	ListRecoveries(ctx context.Context, tenantID, writeOffID uuid.UUID) ([]*Recovery, error)
+
+	GetPolicy(ctx context.Context, tenantID uuid.UUID) (*Policy, error)
+	SavePolicy(ctx context.Context, tenantID uuid.UUID, req PolicyRequest, updatedBy *uuid.UUID) (*Policy, error)
+	// ProvisionTenants returns the tenants with an enabled provisioning policy.
+	ProvisionTenants(ctx context.Context) ([]uuid.UUID, error)
+	// LatestRuns returns the most recent provisioning run per currency.
+	LatestRuns(ctx context.Context, tenantID uuid.UUID) (map[string]*ProvisionRun, error)
+	// SaveRun posts the run's adjustment journal, if any, and records the run.
+	SaveRun(ctx context.Context, run *ProvisionRun) (*ProvisionRun, error)
+	ListRuns(ctx context.Context, tenantID uuid.UUID, currency string) ([]*ProvisionRun, error)
+}
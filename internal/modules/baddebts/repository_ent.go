@@ -0,0 +1,756 @@
+package baddebts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/invoice"
+	"github.com/bengobox/treasury-api/internal/ent/invoicepayment"
+	"github.com/bengobox/treasury-api/internal/ent/provisionpolicy"
+	"github.com/bengobox/treasury-api/internal/ent/provisionrun"
+	"github.com/bengobox/treasury-api/internal/ent/writeoff"
+	"github.com/bengobox/treasury-api/internal/ent/writeoffrecovery"
+	"github.com/bengobox/treasury-api/internal/modules/invoices"
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+	"github.com/bengobox/treasury-api/internal/modules/outbox"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+)
+
+// Invoice types that carry no receivable to write off.
+var exemptInvoiceTypes = []string{"credit_note", "proforma"}
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// CreateWriteOff records a pending write-off of at most the invoice's
+// outstanding balance.
+func (r *EntRepository) CreateWriteOff(ctx context.Context, tenantID, invoiceID uuid.UUID, req WriteOffRequest, requestedBy *uuid.UUID) (*WriteOff, error) {
+	var created *ent.WriteOff
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		inv, balance, err := lockInvoice(ctx, tx, tenantID, invoiceID)
+		if err != nil {
+			return err
+		}
+
+		pending, err := tx.WriteOff.Query().
+			Where(
+				writeoff.InvoiceID(invoiceID),
+				writeoff.Status(StatusPending),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check pending write-offs: %w", err)
+		}
+		if pending {
+			return ErrWriteOffPending
+		}
+
+		amount := balance
+		if req.Amount != nil {
+			amount = *req.Amount
+		}
+		if !amount.IsPositive() {
+			return fmt.Errorf("%w: invoice %s has no outstanding balance", ErrInvalidWriteOff, inv.InvoiceNumber)
+		}
+		if amount.GreaterThan(balance) {
+			return fmt.Errorf("%w: amount %s exceeds outstanding balance %s", ErrInvalidWriteOff, amount, balance)
+		}
+
+		builder := tx.WriteOff.Create().
+			SetTenantID(tenantID).
+			SetInvoiceID(invoiceID).
+			SetAmount(amount).
+			SetRecoveredAmount(decimal.Zero).
+			SetCurrency(inv.Currency).
+			SetReason(req.Reason).
+			SetStatus(StatusPending).
+			SetNillableRequestedBy(requestedBy)
+		if inv.CustomerID != uuid.Nil {
+			builder.SetCustomerID(inv.CustomerID)
+		}
+
+		created, err = builder.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create write-off: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntWriteOff(created), nil
+}
+
+// GetWriteOff returns a write-off.
+func (r *EntRepository) GetWriteOff(ctx context.Context, tenantID, id uuid.UUID) (*WriteOff, error) {
+	entWriteOff, err := r.client.WriteOff.Query().
+		Where(
+			writeoff.ID(id),
+			writeoff.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrWriteOffNotFound
+		}
+		return nil, fmt.Errorf("get write-off: %w", err)
+	}
+	return mapEntWriteOff(entWriteOff), nil
+}
+
+// ListWriteOffs lists the tenant's write-offs, newest first, optionally
+// filtered by status.
+func (r *EntRepository) ListWriteOffs(ctx context.Context, tenantID uuid.UUID, status string) ([]*WriteOff, error) {
+	query := r.client.WriteOff.Query().
+		Where(writeoff.TenantID(tenantID))
+	if status != "" {
+		query = query.Where(writeoff.Status(status))
+	}
+
+	entWriteOffs, err := query.
+		Order(ent.Desc(writeoff.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list write-offs: %w", err)
+	}
+
+	writeOffs := make([]*WriteOff, len(entWriteOffs))
+	for i, entWriteOff := range entWriteOffs {
+		writeOffs[i] = mapEntWriteOff(entWriteOff)
+	}
+	return writeOffs, nil
+}
+
+// ApproveWriteOff posts Dr bad debt expense / Cr receivables, reduces the
+// invoice balance and marks fully written-off invoices as written_off.
+func (r *EntRepository) ApproveWriteOff(ctx context.Context, tenantID, id uuid.UUID, decision Decision, decidedBy *uuid.UUID) (*WriteOff, error) {
+	var approved *ent.WriteOff
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entWriteOff, err := lockPendingWriteOff(ctx, tx, tenantID, id, decidedBy)
+		if err != nil {
+			return err
+		}
+
+		inv, balance, err := lockInvoice(ctx, tx, tenantID, entWriteOff.InvoiceID)
+		if err != nil {
+			return err
+		}
+		if entWriteOff.Amount.GreaterThan(balance) {
+			return fmt.Errorf("%w: invoice %s balance is now %s", ErrInvalidWriteOff, inv.InvoiceNumber, balance)
+		}
+
+		now := time.Now()
+		expense := ledger.Debit(ledger.AccountBadDebtExpense, entWriteOff.Amount)
+		expense.Description = "Bad debt written off: " + entWriteOff.Reason
+		journalID, err := ledger.PostJournal(ctx, tx, ledger.Journal{
+			TenantID:      tenantID,
+			EntryDate:     now,
+			Currency:      inv.Currency,
+			ReferenceType: referenceTypeWriteOff,
+			ReferenceID:   entWriteOff.ID,
+			Description:   "Write-off of invoice " + inv.InvoiceNumber,
+			Lines: []ledger.Line{
+				expense,
+				ledger.Credit(ledger.AccountReceivable, entWriteOff.Amount),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("post write-off journal: %w", err)
+		}
+
+		update := tx.Invoice.UpdateOneID(inv.ID).
+			SetWrittenOffAmount(inv.WrittenOffAmount.Add(entWriteOff.Amount))
+		if entWriteOff.Amount.Equal(balance) {
+			update.SetStatus(invoices.StatusWrittenOff)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("update invoice: %w", err)
+		}
+
+		approved, err = tx.WriteOff.UpdateOneID(entWriteOff.ID).
+			SetStatus(StatusApproved).
+			SetNillableDecidedBy(decidedBy).
+			SetDecidedAt(now).
+			SetDecisionNote(decision.Note).
+			SetJournalEntryID(journalID).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("approve write-off: %w", err)
+		}
+
+		payload := writeOffPayload(inv, approved)
+		payload["remaining_balance"] = balance.Sub(approved.Amount).String()
+		return enqueueInvoiceEvent(ctx, tx, inv, EventInvoiceWrittenOff, payload)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntWriteOff(approved), nil
+}
+
+// RejectWriteOff closes a pending write-off without posting.
+func (r *EntRepository) RejectWriteOff(ctx context.Context, tenantID, id uuid.UUID, decision Decision, decidedBy *uuid.UUID) (*WriteOff, error) {
+	var rejected *ent.WriteOff
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entWriteOff, err := lockPendingWriteOff(ctx, tx, tenantID, id, decidedBy)
+		if err != nil {
+			return err
+		}
+
+		rejected, err = tx.WriteOff.UpdateOneID(entWriteOff.ID).
+			SetStatus(StatusRejected).
+			SetNillableDecidedBy(decidedBy).
+			SetDecidedAt(time.Now()).
+			SetDecisionNote(decision.Note).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("reject write-off: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntWriteOff(rejected), nil
+}
+
+// RecoverWriteOff reinstates the amount on the invoice (Dr receivables /
+// Cr bad debts recovered) so the customer's payment can be allocated to it.
+func (r *EntRepository) RecoverWriteOff(ctx context.Context, tenantID, id uuid.UUID, req RecoveryRequest, recoveredBy *uuid.UUID) (*Recovery, error) {
+	var created *ent.WriteOffRecovery
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entWriteOff, err := tx.WriteOff.Query().
+			Where(
+				writeoff.ID(id),
+				writeoff.TenantID(tenantID),
+			).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrWriteOffNotFound
+			}
+			return fmt.Errorf("lock write-off: %w", err)
+		}
+
+		recoverable := mapEntWriteOff(entWriteOff).Recoverable()
+		if req.Amount.GreaterThan(recoverable) {
+			return fmt.Errorf("%w: amount %s exceeds recoverable %s", ErrInvalidWriteOff, req.Amount, recoverable)
+		}
+
+		inv, err := tx.Invoice.Query().
+			Where(invoice.ID(entWriteOff.InvoiceID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("lock invoice: %w", err)
+		}
+
+		now := time.Now()
+		recovery := ledger.Credit(ledger.AccountBadDebtRecovered, req.Amount)
+		recovery.Description = "Bad debt recovered"
+		journalID, err := ledger.PostJournal(ctx, tx, ledger.Journal{
+			TenantID:      tenantID,
+			EntryDate:     now,
+			Currency:      inv.Currency,
+			ReferenceType: referenceTypeRecovery,
+			ReferenceID:   entWriteOff.ID,
+			Description:   "Written-off balance reinstated on invoice " + inv.InvoiceNumber,
+			Lines: []ledger.Line{
+				ledger.Debit(ledger.AccountReceivable, req.Amount),
+				recovery,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("post recovery journal: %w", err)
+		}
+
+		update := tx.Invoice.UpdateOneID(inv.ID).
+			SetWrittenOffAmount(inv.WrittenOffAmount.Sub(req.Amount))
+		if inv.Status == invoices.StatusWrittenOff {
+			update.SetStatus(reopenedStatus(inv.DueDate, now))
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("update invoice: %w", err)
+		}
+
+		if err := tx.WriteOff.UpdateOneID(entWriteOff.ID).
+			SetRecoveredAmount(entWriteOff.RecoveredAmount.Add(req.Amount)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("update write-off: %w", err)
+		}
+
+		created, err = tx.WriteOffRecovery.Create().
+			SetTenantID(tenantID).
+			SetWriteOffID(entWriteOff.ID).
+			SetInvoiceID(inv.ID).
+			SetAmount(req.Amount).
+			SetNote(req.Note).
+			SetJournalEntryID(journalID).
+			SetNillableRecoveredBy(recoveredBy).
+			SetRecoveredAt(now).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create write-off recovery: %w", err)
+		}
+
+		payload := writeOffPayload(inv, entWriteOff)
+		payload["recovery_id"] = created.ID.String()
+		payload["recovered_amount"] = req.Amount.String()
+		return enqueueInvoiceEvent(ctx, tx, inv, EventWriteOffRecovered, payload)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntRecovery(created), nil
+}
+
+// ListRecoveries lists the recoveries of a write-off.
+func (r *EntRepository) ListRecoveries(ctx context.Context, tenantID, writeOffID uuid.UUID) ([]*Recovery, error) {
+	entRecoveries, err := r.client.WriteOffRecovery.Query().
+		Where(
+			writeoffrecovery.TenantID(tenantID),
+			writeoffrecovery.WriteOffID(writeOffID),
+		).
+		Order(ent.Asc(writeoffrecovery.FieldRecoveredAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list write-off recoveries: %w", err)
+	}
+
+	recoveries := make([]*Recovery, len(entRecoveries))
+	for i, entRecovery := range entRecoveries {
+		recoveries[i] = mapEntRecovery(entRecovery)
+	}
+	return recoveries, nil
+}
+
+// GetPolicy returns the tenant's provisioning policy.
+func (r *EntRepository) GetPolicy(ctx context.Context, tenantID uuid.UUID) (*Policy, error) {
+	entPolicy, err := r.client.ProvisionPolicy.Query().
+		Where(provisionpolicy.TenantID(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrPolicyNotFound
+		}
+		return nil, fmt.Errorf("get provisioning policy: %w", err)
+	}
+	return mapEntPolicy(entPolicy), nil
+}
+
+// SavePolicy creates or replaces the tenant's provisioning policy.
+func (r *EntRepository) SavePolicy(ctx context.Context, tenantID uuid.UUID, req PolicyRequest, updatedBy *uuid.UUID) (*Policy, error) {
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	id, err := r.client.ProvisionPolicy.Create().
+		SetTenantID(tenantID).
+		SetBoundaries(req.Boundaries).
+		SetLossRates(req.LossRates).
+		SetEnabled(enabled).
+		SetNillableUpdatedBy(updatedBy).
+		OnConflictColumns(provisionpolicy.FieldTenantID).
+		UpdateNewValues().
+		ID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("save provisioning policy: %w", err)
+	}
+
+	entPolicy, err := r.client.ProvisionPolicy.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get provisioning policy: %w", err)
+	}
+	return mapEntPolicy(entPolicy), nil
+}
+
+// ProvisionTenants returns the tenants with an enabled provisioning policy.
+func (r *EntRepository) ProvisionTenants(ctx context.Context) ([]uuid.UUID, error) {
+	var rows []struct {
+		TenantID uuid.UUID `json:"tenant_id"`
+	}
+	err := r.client.ProvisionPolicy.Query().
+		Where(provisionpolicy.Enabled(true)).
+		Select(provisionpolicy.FieldTenantID).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("list provisioning tenants: %w", err)
+	}
+
+	tenantIDs := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		tenantIDs[i] = row.TenantID
+	}
+	return tenantIDs, nil
+}
+
+// LatestRuns returns the most recent provisioning run per currency.
+func (r *EntRepository) LatestRuns(ctx context.Context, tenantID uuid.UUID) (map[string]*ProvisionRun, error) {
+	entRuns, err := r.client.ProvisionRun.Query().
+		Where(provisionrun.TenantID(tenantID)).
+		Order(ent.Asc(provisionrun.FieldAsOf)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list provisioning runs: %w", err)
+	}
+
+	latest := map[string]*ProvisionRun{}
+	for _, entRun := range entRuns {
+		latest[entRun.Currency] = mapEntRun(entRun)
+	}
+	return latest, nil
+}
+
+// SaveRun posts Dr bad debt expense / Cr allowance for an increase in the
+// allowance (reversed for a release) and records the run.
+func (r *EntRepository) SaveRun(ctx context.Context, run *ProvisionRun) (*ProvisionRun, error) {
+	var saved *ent.ProvisionRun
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		exists, err := tx.ProvisionRun.Query().
+			Where(
+				provisionrun.TenantID(run.TenantID),
+				provisionrun.Currency(run.Currency),
+				provisionrun.AsOfGTE(run.AsOf),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check provisioning runs: %w", err)
+		}
+		if exists {
+			return ErrProvisionExists
+		}
+
+		id := uuid.New()
+		create := tx.ProvisionRun.Create().
+			SetID(id).
+			SetTenantID(run.TenantID).
+			SetCurrency(run.Currency).
+			SetAsOf(run.AsOf).
+			SetBuckets(bucketsJSON(run.Buckets)).
+			SetRequiredAllowance(run.RequiredAllowance).
+			SetPreviousAllowance(run.PreviousAllowance).
+			SetAdjustment(run.Adjustment).
+			SetTrigger(run.Trigger).
+			SetNillableCreatedBy(run.CreatedBy)
+
+		if !run.Adjustment.IsZero() {
+			amount := run.Adjustment.Abs()
+			lines := []ledger.Line{
+				ledger.Debit(ledger.AccountBadDebtExpense, amount),
+				ledger.Credit(ledger.AccountDoubtfulDebts, amount),
+			}
+			description := "Increase in allowance for doubtful debts"
+			if run.Adjustment.IsNegative() {
+				lines = []ledger.Line{
+					ledger.Debit(ledger.AccountDoubtfulDebts, amount),
+					ledger.Credit(ledger.AccountBadDebtExpense, amount),
+				}
+				description = "Release of allowance for doubtful debts"
+			}
+
+			journalID, err := ledger.PostJournal(ctx, tx, ledger.Journal{
+				TenantID:      run.TenantID,
+				EntryDate:     run.AsOf,
+				Currency:      run.Currency,
+				ReferenceType: referenceTypeProvision,
+				ReferenceID:   id,
+				Description:   fmt.Sprintf("%s as of %s", description, run.AsOf.Format(time.DateOnly)),
+				Lines:         lines,
+			})
+			if err != nil {
+				return fmt.Errorf("post provision journal: %w", err)
+			}
+			create.SetJournalEntryID(journalID)
+		}
+
+		saved, err = create.Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return ErrProvisionExists
+			}
+			return fmt.Errorf("create provisioning run: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntRun(saved), nil
+}
+
+// ListRuns lists the tenant's provisioning runs, newest first.
+func (r *EntRepository) ListRuns(ctx context.Context, tenantID uuid.UUID, currency string) ([]*ProvisionRun, error) {
+	query := r.client.ProvisionRun.Query().
+		Where(provisionrun.TenantID(tenantID))
+	if currency != "" {
+		query = query.Where(provisionrun.Currency(currency))
+	}
+
+	entRuns, err := query.
+		Order(ent.Desc(provisionrun.FieldAsOf), ent.Asc(provisionrun.FieldCurrency)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list provisioning runs: %w", err)
+	}
+
+	runs := make([]*ProvisionRun, len(entRuns))
+	for i, entRun := range entRuns {
+		runs[i] = mapEntRun(entRun)
+	}
+	return runs, nil
+}
+
+// lockInvoice locks an invoice that can be written off and returns its
+// outstanding balance.
+func lockInvoice(ctx context.Context, tx *ent.Tx, tenantID, invoiceID uuid.UUID) (*ent.Invoice, decimal.Decimal, error) {
+	inv, err := tx.Invoice.Query().
+		Where(
+			invoice.ID(invoiceID),
+			invoice.TenantID(tenantID),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, decimal.Zero, ErrInvoiceNotFound
+		}
+		return nil, decimal.Zero, fmt.Errorf("lock invoice: %w", err)
+	}
+
+	if inv.Status != invoices.StatusSent && inv.Status != invoices.StatusOverdue {
+		return nil, decimal.Zero, fmt.Errorf("%w: invoice %s is %s", ErrInvalidWriteOff, inv.InvoiceNumber, inv.Status)
+	}
+	for _, exempt := range exemptInvoiceTypes {
+		if inv.InvoiceType == exempt {
+			return nil, decimal.Zero, fmt.Errorf("%w: %s invoices cannot be written off", ErrInvalidWriteOff, exempt)
+		}
+	}
+
+	entAllocations, err := tx.InvoicePayment.Query().
+		Where(invoicepayment.InvoiceID(inv.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, decimal.Zero, fmt.Errorf("list invoice allocations: %w", err)
+	}
+	balance := inv.TotalAmount.Sub(inv.WrittenOffAmount)
+	for _, entAllocation := range entAllocations {
+		balance = balance.Sub(entAllocation.AmountApplied)
+	}
+	if balance.IsNegative() {
+		balance = decimal.Zero
+	}
+
+	return inv, balance, nil
+}
+
+// lockPendingWriteOff locks a write-off awaiting a decision by someone other
+// than its requester.
+func lockPendingWriteOff(ctx context.Context, tx *ent.Tx, tenantID, id uuid.UUID, decidedBy *uuid.UUID) (*ent.WriteOff, error) {
+	entWriteOff, err := tx.WriteOff.Query().
+		Where(
+			writeoff.ID(id),
+			writeoff.TenantID(tenantID),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrWriteOffNotFound
+		}
+		return nil, fmt.Errorf("lock write-off: %w", err)
+	}
+
+	if entWriteOff.Status != StatusPending {
+		return nil, ErrWriteOffDecided
+	}
+	if decidedBy != nil && entWriteOff.RequestedBy == *decidedBy {
+		return nil, ErrSelfApproval
+	}
+	return entWriteOff, nil
+}
+
+// reopenedStatus returns the status of a written-off invoice that has a
+// balance again.
+func reopenedStatus(dueDate, now time.Time) string {
+	if dueDate.Before(now) {
+		return invoices.StatusOverdue
+	}
+	return invoices.StatusSent
+}
+
+func writeOffPayload(inv *ent.Invoice, entWriteOff *ent.WriteOff) map[string]any {
+	payload := map[string]any{
+		"write_off_id":   entWriteOff.ID.String(),
+		"invoice_id":     inv.ID.String(),
+		"invoice_number": inv.InvoiceNumber,
+		"amount":         entWriteOff.Amount.String(),
+		"currency":       inv.Currency,
+		"reason":         entWriteOff.Reason,
+	}
+	if inv.CustomerID != uuid.Nil {
+		payload["customer_id"] = inv.CustomerID.String()
+	}
+	return payload
+}
+
+func enqueueInvoiceEvent(ctx context.Context, tx *ent.Tx, inv *ent.Invoice, eventType string, payload map[string]any) error {
+	return outbox.Enqueue(ctx, tx, outbox.Event{
+		TenantID:      inv.TenantID,
+		AggregateType: "invoice",
+		AggregateID:   inv.ID,
+		EventType:     eventType,
+		Payload:       payload,
+	})
+}
+
+func bucketsJSON(buckets []BucketProvision) []map[string]any {
+	rows := make([]map[string]any, len(buckets))
+	for i, bucket := range buckets {
+		rows[i] = map[string]any{
+			"bucket":    bucket.Bucket,
+			"exposure":  bucket.Exposure.String(),
+			"loss_rate": bucket.LossRate.String(),
+			"allowance": bucket.Allowance.String(),
+		}
+	}
+	return rows
+}
+
+func bucketsFromJSON(rows []map[string]any) []BucketProvision {
+	buckets := make([]BucketProvision, len(rows))
+	for i, row := range rows {
+		label, _ := row["bucket"].(string)
+		buckets[i] = BucketProvision{
+			Bucket:    label,
+			Exposure:  jsonDecimal(row["exposure"]),
+			LossRate:  jsonDecimal(row["loss_rate"]),
+			Allowance: jsonDecimal(row["allowance"]),
+		}
+	}
+	return buckets
+}
+
+func jsonDecimal(value any) decimal.Decimal {
+	raw, ok := value.(string)
+	if !ok {
+		return decimal.Zero
+	}
+	d, err := decimal.NewFromString(raw)
+	if err != nil {
+		return decimal.Zero
+	}
+	return d
+}
+
+func mapEntWriteOff(entWriteOff *ent.WriteOff) *WriteOff {
+	w := &WriteOff{
+		ID:              entWriteOff.ID,
+		TenantID:        entWriteOff.TenantID,
+		InvoiceID:       entWriteOff.InvoiceID,
+		Amount:          entWriteOff.Amount,
+		RecoveredAmount: entWriteOff.RecoveredAmount,
+		Currency:        entWriteOff.Currency,
+		Reason:          entWriteOff.Reason,
+		Status:          entWriteOff.Status,
+		DecisionNote:    entWriteOff.DecisionNote,
+		CreatedAt:       entWriteOff.CreatedAt,
+		UpdatedAt:       entWriteOff.UpdatedAt,
+	}
+
+	// Optional fields - check for zero values
+	if entWriteOff.CustomerID != uuid.Nil {
+		w.CustomerID = &entWriteOff.CustomerID
+	}
+	if entWriteOff.RequestedBy != uuid.Nil {
+		w.RequestedBy = &entWriteOff.RequestedBy
+	}
+	if entWriteOff.DecidedBy != uuid.Nil {
+		w.DecidedBy = &entWriteOff.DecidedBy
+	}
+	if !entWriteOff.DecidedAt.IsZero() {
+		w.DecidedAt = &entWriteOff.DecidedAt
+	}
+	if entWriteOff.JournalEntryID != uuid.Nil {
+		w.JournalEntryID = &entWriteOff.JournalEntryID
+	}
+
+	return w
+}
+
+func mapEntRecovery(entRecovery *ent.WriteOffRecovery) *Recovery {
+	recovery := &Recovery{
+		ID:          entRecovery.ID,
+		WriteOffID:  entRecovery.WriteOffID,
+		InvoiceID:   entRecovery.InvoiceID,
+		Amount:      entRecovery.Amount,
+		Note:        entRecovery.Note,
+		RecoveredAt: entRecovery.RecoveredAt,
+	}
+	if entRecovery.JournalEntryID != uuid.Nil {
+		recovery.JournalEntryID = &entRecovery.JournalEntryID
+	}
+	if entRecovery.RecoveredBy != uuid.Nil {
+		recovery.RecoveredBy = &entRecovery.RecoveredBy
+	}
+	return recovery
+}
+
+func mapEntPolicy(entPolicy *ent.ProvisionPolicy) *Policy {
+	policy := &Policy{
+		TenantID:   entPolicy.TenantID,
+		Boundaries: entPolicy.Boundaries,
+		LossRates:  entPolicy.LossRates,
+		Enabled:    entPolicy.Enabled,
+		UpdatedAt:  entPolicy.UpdatedAt,
+	}
+	if entPolicy.UpdatedBy != uuid.Nil {
+		policy.UpdatedBy = &entPolicy.UpdatedBy
+	}
+	return policy
+}
+
+func mapEntRun(entRun *ent.ProvisionRun) *ProvisionRun {
+	run := &ProvisionRun{
+		ID:                entRun.ID,
+		TenantID:          entRun.TenantID,
+		Currency:          entRun.Currency,
+		AsOf:              entRun.AsOf,
+		Buckets:           bucketsFromJSON(entRun.Buckets),
+		RequiredAllowance: entRun.RequiredAllowance,
+		PreviousAllowance: entRun.PreviousAllowance,
+		Adjustment:        entRun.Adjustment,
+		Trigger:           entRun.Trigger,
+		CreatedAt:         entRun.CreatedAt,
+	}
+	if entRun.JournalEntryID != uuid.Nil {
+		run.JournalEntryID = &entRun.JournalEntryID
+	}
+	if entRun.CreatedBy != uuid.Nil {
+		run.CreatedBy = &entRun.CreatedBy
+	}
+	return run
+}
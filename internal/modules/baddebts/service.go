@@ -0,0 +1,288 @@
+package baddebts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/aging"
+)
+
+// Service manages bad debt write-offs and the allowance for doubtful debts.
+type Service struct {
+	repo   Repository
+	aging  *aging.Service
+	logger *zap.Logger
+}
+
+// NewService creates a new bad debt service.
+func NewService(repo Repository, agingService *aging.Service, logger *zap.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		aging:  agingService,
+		logger: logger,
+	}
+}
+
+// RequestWriteOff records a write-off awaiting approval.
+func (s *Service) RequestWriteOff(ctx context.Context, tenantID, invoiceID uuid.UUID, req WriteOffRequest, requestedBy *uuid.UUID) (*WriteOff, error) {
+	req.Reason = strings.TrimSpace(req.Reason)
+	if req.Reason == "" {
+		return nil, fmt.Errorf("%w: reason is required", ErrInvalidWriteOff)
+	}
+	if req.Amount != nil && !req.Amount.IsPositive() {
+		return nil, fmt.Errorf("%w: amount must be positive", ErrInvalidWriteOff)
+	}
+
+	writeOff, err := s.repo.CreateWriteOff(ctx, tenantID, invoiceID, req, requestedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("write-off requested",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("invoice_id", invoiceID.String()),
+		zap.String("write_off_id", writeOff.ID.String()),
+		zap.String("amount", writeOff.Amount.String()),
+	)
+
+	return writeOff, nil
+}
+
+// GetWriteOff returns a write-off.
+func (s *Service) GetWriteOff(ctx context.Context, tenantID, id uuid.UUID) (*WriteOff, error) {
+	return s.repo.GetWriteOff(ctx, tenantID, id)
+}
+
+// ListWriteOffs lists the tenant's write-offs, optionally filtered by status.
+func (s *Service) ListWriteOffs(ctx context.Context, tenantID uuid.UUID, status string) ([]*WriteOff, error) {
+	switch status {
+	case "", StatusPending, StatusApproved, StatusRejected:
+	default:
+		return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidWriteOff, status)
+	}
+	return s.repo.ListWriteOffs(ctx, tenantID, status)
+}
+
+// ApproveWriteOff approves a pending write-off and posts it.
+func (s *Service) ApproveWriteOff(ctx context.Context, tenantID, id uuid.UUID, decision Decision, decidedBy *uuid.UUID) (*WriteOff, error) {
+	writeOff, err := s.repo.ApproveWriteOff(ctx, tenantID, id, decision, decidedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("write-off approved",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("invoice_id", writeOff.InvoiceID.String()),
+		zap.String("write_off_id", writeOff.ID.String()),
+		zap.String("amount", writeOff.Amount.String()),
+	)
+
+	return writeOff, nil
+}
+
+// RejectWriteOff rejects a pending write-off.
+func (s *Service) RejectWriteOff(ctx context.Context, tenantID, id uuid.UUID, decision Decision, decidedBy *uuid.UUID) (*WriteOff, error) {
+	writeOff, err := s.repo.RejectWriteOff(ctx, tenantID, id, decision, decidedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("write-off rejected",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("write_off_id", writeOff.ID.String()),
+	)
+
+	return writeOff, nil
+}
+
+// RecoverWriteOff reinstates part of a written-off balance on its invoice.
+func (s *Service) RecoverWriteOff(ctx context.Context, tenantID, id uuid.UUID, req RecoveryRequest, recoveredBy *uuid.UUID) (*Recovery, error) {
+	if !req.Amount.IsPositive() {
+		return nil, fmt.Errorf("%w: amount must be positive", ErrInvalidWriteOff)
+	}
+
+	recovery, err := s.repo.RecoverWriteOff(ctx, tenantID, id, req, recoveredBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("written-off balance recovered",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("write_off_id", id.String()),
+		zap.String("amount", recovery.Amount.String()),
+	)
+
+	return recovery, nil
+}
+
+// ListRecoveries lists the recoveries of a write-off.
+func (s *Service) ListRecoveries(ctx context.Context, tenantID, writeOffID uuid.UUID) ([]*Recovery, error) {
+	if _, err := s.repo.GetWriteOff(ctx, tenantID, writeOffID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListRecoveries(ctx, tenantID, writeOffID)
+}
+
+// GetPolicy returns the tenant's provisioning policy.
+func (s *Service) GetPolicy(ctx context.Context, tenantID uuid.UUID) (*Policy, error) {
+	return s.repo.GetPolicy(ctx, tenantID)
+}
+
+// SavePolicy validates and saves the tenant's provisioning policy.
+func (s *Service) SavePolicy(ctx context.Context, tenantID uuid.UUID, req PolicyRequest, updatedBy *uuid.UUID) (*Policy, error) {
+	if err := validatePolicy(req); err != nil {
+		return nil, err
+	}
+
+	policy, err := s.repo.SavePolicy(ctx, tenantID, req, updatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("provisioning policy updated",
+		zap.String("tenant_id", tenantID.String()),
+		zap.Bool("enabled", policy.Enabled),
+	)
+
+	return policy, nil
+}
+
+// ListRuns lists the tenant's provisioning runs, optionally for one currency.
+func (s *Service) ListRuns(ctx context.Context, tenantID uuid.UUID, currency string) ([]*ProvisionRun, error) {
+	return s.repo.ListRuns(ctx, tenantID, strings.ToUpper(currency))
+}
+
+// Provision calculates the expected credit loss allowance from the
+// receivables aging at asOf and posts the adjustment against the previous
+// allowance, per currency. Currencies provisioned before but no longer
+// receivable have their allowance released.
+func (s *Service) Provision(ctx context.Context, tenantID uuid.UUID, asOf time.Time, trigger string, createdBy *uuid.UUID) ([]*ProvisionRun, error) {
+	policy, err := s.repo.GetPolicy(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	asOf = startOfDay(asOf)
+
+	latest, err := s.repo.LatestRuns(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	for _, run := range latest {
+		if !run.AsOf.Before(asOf) {
+			return nil, fmt.Errorf("%w: %s was provisioned as of %s", ErrProvisionExists, run.Currency, run.AsOf.Format(time.DateOnly))
+		}
+	}
+
+	report, err := s.aging.Receivables(ctx, tenantID, aging.Options{
+		AsOf:       asOf,
+		Boundaries: policy.Boundaries,
+		Basis:      aging.BasisDueDate,
+		GroupBy:    aging.GroupByCurrency,
+		Mode:       aging.ModeSummary,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exposures := map[string][]decimal.Decimal{}
+	for _, total := range report.Totals {
+		exposures[total.Currency] = total.Buckets
+	}
+	for currency := range latest {
+		if _, ok := exposures[currency]; !ok {
+			exposures[currency] = nil
+		}
+	}
+	currencies := make([]string, 0, len(exposures))
+	for currency := range exposures {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+
+	runs := []*ProvisionRun{}
+	for _, currency := range currencies {
+		buckets, required := allowance(report.Buckets, exposures[currency], policy.LossRates)
+		previous := decimal.Zero
+		if run := latest[currency]; run != nil {
+			previous = run.RequiredAllowance
+		}
+		if required.IsZero() && previous.IsZero() {
+			continue
+		}
+
+		run, err := s.repo.SaveRun(ctx, &ProvisionRun{
+			TenantID:          tenantID,
+			Currency:          currency,
+			AsOf:              asOf,
+			Buckets:           buckets,
+			RequiredAllowance: required,
+			PreviousAllowance: previous,
+			Adjustment:        required.Sub(previous),
+			Trigger:           trigger,
+			CreatedBy:         createdBy,
+		})
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+
+		s.logger.Info("doubtful debt provision posted",
+			zap.String("tenant_id", tenantID.String()),
+			zap.String("currency", currency),
+			zap.String("as_of", asOf.Format(time.DateOnly)),
+			zap.String("required", required.String()),
+			zap.String("adjustment", run.Adjustment.String()),
+		)
+	}
+
+	return runs, nil
+}
+
+// RunMonthEnd provisions every tenant with an enabled policy as of the end of
+// the previous month. Tenants already provisioned for that date are skipped.
+func (s *Service) RunMonthEnd(ctx context.Context) error {
+	asOf := previousMonthEnd(time.Now().UTC())
+
+	tenantIDs, err := s.repo.ProvisionTenants(ctx)
+	if err != nil {
+		return err
+	}
+
+	provisioned, failed := 0, 0
+	for _, tenantID := range tenantIDs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if _, err := s.Provision(ctx, tenantID, asOf, TriggerScheduled, nil); err != nil {
+			if errors.Is(err, ErrProvisionExists) {
+				continue
+			}
+			failed++
+			s.logger.Warn("month-end provisioning failed",
+				zap.String("tenant_id", tenantID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		provisioned++
+	}
+
+	if provisioned > 0 || failed > 0 {
+		s.logger.Info("month-end provisioning run completed",
+			zap.String("as_of", asOf.Format(time.DateOnly)),
+			zap.Int("provisioned", provisioned),
+			zap.Int("failed", failed),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package bankfile
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// camtDocument is the subset of an ISO 20022 camt.053 bank-to-customer
+// statement that is read. Elements are matched by local name so any message
+// version parses.
+type camtDocument struct {
+	Statements []camtStatement `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtStatement struct {
+	ID      string `xml:"Id"`
+	Account struct {
+		IBAN  string `xml:"Id>IBAN"`
+		Other string `xml:"Id>Othr>Id"`
+		Ccy   string `xml:"Ccy"`
+	} `xml:"Acct"`
+	Balances []camtBalance `xml:"Bal"`
+	Entries  []camtEntry   `xml:"Ntry"`
+}
+
+type camtBalance struct {
+	Code   string     `xml:"Tp>CdOrPrtry>Cd"`
+	Amount camtAmount `xml:"Amt"`
+	Mark   string     `xml:"CdtDbtInd"`
+	Date   camtDate   `xml:"Dt"`
+}
+
+type camtAmount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type camtDate struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+// camtStatus holds the entry status, a code element from version 8 and
+// plain text before it.
+type camtStatus struct {
+	Code string `xml:"Cd"`
+	Text string `xml:",chardata"`
+}
+
+type camtEntry struct {
+	Amount        camtAmount   `xml:"Amt"`
+	Mark          string       `xml:"CdtDbtInd"`
+	Status        camtStatus   `xml:"Sts"`
+	BookingDate   camtDate     `xml:"BookgDt"`
+	ValueDate     camtDate     `xml:"ValDt"`
+	ServicerRef   string       `xml:"AcctSvcrRef"`
+	AdditionalInf string       `xml:"AddtlNtryInf"`
+	Details       []camtDetail `xml:"NtryDtls>TxDtls"`
+}
+
+type camtDetail struct {
+	EndToEndID   string    `xml:"Refs>EndToEndId"`
+	ServicerRef  string    `xml:"Refs>AcctSvcrRef"`
+	Unstructured []string  `xml:"RmtInf>Ustrd"`
+	Debtor       camtParty `xml:"RltdPties>Dbtr"`
+	Creditor     camtParty `xml:"RltdPties>Cdtr"`
+}
+
+// camtParty holds a party name, nested under Pty from version 8.
+type camtParty struct {
+	Name      string `xml:"Nm"`
+	PartyName string `xml:"Pty>Nm"`
+}
+
+func (p camtParty) name() string {
+	if p.Name != "" {
+		return p.Name
+	}
+	return p.PartyName
+}
+
+// ParseCAMT053 reads an ISO 20022 camt.053 statement. Several statements in
+// one file are read as one when they are for the same account. Only booked
+// entries are taken; pending entries can still change.
+func ParseCAMT053(data []byte) (*Statement, error) {
+	var doc camtDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrMalformed, err.Error())
+	}
+	if len(doc.Statements) == 0 {
+		return nil, fmt.Errorf("%w: no camt.053 statement found", ErrMalformed)
+	}
+
+	statement := &Statement{Reference: doc.Statements[0].ID}
+	var closingDate time.Time
+	for _, stmt := range doc.Statements {
+		account := stmt.Account.IBAN
+		if account == "" {
+			account = stmt.Account.Other
+		}
+		if statement.AccountNumber != "" && statement.AccountNumber != account {
+			return nil, fmt.Errorf("%w: file holds statements for more than one account", ErrMalformed)
+		}
+		statement.AccountNumber = account
+		if stmt.Account.Ccy != "" {
+			statement.Currency = stmt.Account.Ccy
+		}
+
+		for _, bal := range stmt.Balances {
+			amount, err := camtSigned(bal.Amount, bal.Mark)
+			if err != nil {
+				return nil, err
+			}
+			switch bal.Code {
+			case "OPBD", "PRCD":
+				if statement.OpeningBalance == nil {
+					statement.OpeningBalance = &amount
+				}
+			case "CLBD":
+				date, err := bal.Date.parse()
+				if err != nil {
+					return nil, fmt.Errorf("%w: closing balance: %s", ErrMalformed, err.Error())
+				}
+				if !date.Before(closingDate) {
+					statement.ClosingBalance = &amount
+					closingDate = date
+				}
+			}
+			if statement.Currency == "" {
+				statement.Currency = bal.Amount.Currency
+			}
+		}
+
+		for i, entry := range stmt.Entries {
+			status := strings.TrimSpace(entry.Status.Code + entry.Status.Text)
+			if status != "" && status != "BOOK" {
+				continue
+			}
+			txn, err := camtTransaction(entry)
+			if err != nil {
+				return nil, fmt.Errorf("%w: entry %d: %s", ErrMalformed, i+1, err.Error())
+			}
+			statement.Transactions = append(statement.Transactions, txn)
+		}
+	}
+
+	if statement.OpeningBalance == nil || statement.ClosingBalance == nil {
+		return nil, fmt.Errorf("%w: opening (OPBD) and closing (CLBD) balances are required", ErrMalformed)
+	}
+	statement.PeriodEnd = closingDate
+	if len(statement.Transactions) == 0 {
+		statement.PeriodStart = closingDate
+	}
+	if err := statement.finish(); err != nil {
+		return nil, err
+	}
+	return statement, nil
+}
+
+func camtTransaction(entry camtEntry) (Transaction, error) {
+	amount, err := camtSigned(entry.Amount, entry.Mark)
+	if err != nil {
+		return Transaction{}, err
+	}
+	date, err := entry.BookingDate.parse()
+	if err != nil {
+		return Transaction{}, fmt.Errorf("booking date: %s", err.Error())
+	}
+
+	txn := Transaction{
+		Date:        date,
+		Amount:      amount,
+		Reference:   entry.ServicerRef,
+		Description: strings.TrimSpace(entry.AdditionalInf),
+		Metadata:    map[string]any{},
+	}
+	if entry.ValueDate.Date != "" || entry.ValueDate.DateTime != "" {
+		valueDate, err := entry.ValueDate.parse()
+		if err != nil {
+			return Transaction{}, fmt.Errorf("value date: %s", err.Error())
+		}
+		txn.ValueDate = &valueDate
+	}
+
+	if len(entry.Details) > 0 {
+		detail := entry.Details[0]
+		if endToEnd := strings.TrimSpace(detail.EndToEndID); endToEnd != "" && endToEnd != "NOTPROVIDED" {
+			txn.Metadata["end_to_end_id"] = endToEnd
+			if txn.Reference == "" {
+				txn.Reference = endToEnd
+			}
+		}
+		if txn.Reference == "" {
+			txn.Reference = detail.ServicerRef
+		}
+		if txn.Description == "" {
+			txn.Description = strings.TrimSpace(strings.Join(detail.Unstructured, " "))
+		}
+		// The counterparty is the payer of money in and the payee of money out.
+		if amount.IsPositive() {
+			txn.Counterparty = detail.Debtor.name()
+		} else {
+			txn.Counterparty = detail.Creditor.name()
+		}
+	}
+	return txn, nil
+}
+
+func camtSigned(amount camtAmount, mark string) (decimal.Decimal, error) {
+	value, err := decimal.NewFromString(strings.TrimSpace(amount.Value))
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("%w: invalid amount %q", ErrMalformed, amount.Value)
+	}
+	if mark == "DBIT" {
+		value = value.Neg()
+	}
+	return value, nil
+}
+
+func (d camtDate) parse() (time.Time, error) {
+	if d.Date != "" {
+		return parseISODate(d.Date)
+	}
+	return parseISODate(d.DateTime)
+}
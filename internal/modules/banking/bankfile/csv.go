@@ -0,0 +1,241 @@
+package bankfile
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Profile maps a bank's CSV export onto statement lines. Columns are named by
+// their header text, matched case-insensitively. Amounts come from
+// AmountColumn (signed, money in positive) or from DebitColumn and
+// CreditColumn.
+type Profile struct {
+	Delimiter          string
+	SkipRows           int
+	DateColumn         string
+	DateFormat         string
+	ValueDateColumn    string
+	DescriptionColumn  string
+	ReferenceColumn    string
+	CounterpartyColumn string
+	AmountColumn       string
+	DebitColumn        string
+	CreditColumn       string
+	BalanceColumn      string
+}
+
+// Validate checks the profile names the columns a statement needs.
+func (p Profile) Validate() error {
+	if len([]rune(p.Delimiter)) > 1 {
+		return errors.New("delimiter must be a single character")
+	}
+	if p.SkipRows < 0 {
+		return errors.New("skip_rows cannot be negative")
+	}
+	if strings.TrimSpace(p.DateColumn) == "" || strings.TrimSpace(p.DateFormat) == "" {
+		return errors.New("date_column and date_format are required")
+	}
+	if p.AmountColumn == "" && p.DebitColumn == "" && p.CreditColumn == "" {
+		return errors.New("amount_column or debit_column and credit_column are required")
+	}
+	if p.AmountColumn != "" && (p.DebitColumn != "" || p.CreditColumn != "") {
+		return errors.New("use either amount_column or debit_column and credit_column")
+	}
+	return nil
+}
+
+// ParseCSV reads a CSV statement export using the profile's column mapping.
+// Rows without a date, such as totals or blank lines at the end, are skipped.
+// When the profile has a balance column the opening and closing balances are
+// derived from the first and last running balances.
+func ParseCSV(data []byte, profile Profile) (*Statement, error) {
+	if err := profile.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrMalformed, err.Error())
+	}
+
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	if profile.Delimiter != "" {
+		reader.Comma = []rune(profile.Delimiter)[0]
+	}
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	for i := 0; i < profile.SkipRows; i++ {
+		if _, err := reader.Read(); err != nil {
+			return nil, fmt.Errorf("%w: file ends before the header row", ErrMalformed)
+		}
+	}
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: missing header row", ErrMalformed)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	var missing error
+	column := func(name string) int {
+		if name == "" {
+			return -1
+		}
+		i, ok := columns[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			if missing == nil {
+				missing = fmt.Errorf("%w: column %q not found in header", ErrMalformed, name)
+			}
+			return -1
+		}
+		return i
+	}
+	dateCol := column(profile.DateColumn)
+	valueDateCol := column(profile.ValueDateColumn)
+	descriptionCol := column(profile.DescriptionColumn)
+	referenceCol := column(profile.ReferenceColumn)
+	counterpartyCol := column(profile.CounterpartyColumn)
+	amountCol := column(profile.AmountColumn)
+	debitCol := column(profile.DebitColumn)
+	creditCol := column(profile.CreditColumn)
+	balanceCol := column(profile.BalanceColumn)
+	if missing != nil {
+		return nil, missing
+	}
+
+	cell := func(record []string, i int) string {
+		if i < 0 || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	statement := &Statement{}
+	line := profile.SkipRows + 1
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %s", ErrMalformed, line, err.Error())
+		}
+
+		rawDate := cell(record, dateCol)
+		if rawDate == "" {
+			continue
+		}
+		date, err := time.Parse(profile.DateFormat, rawDate)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: date %q does not match %s", ErrMalformed, line, rawDate, profile.DateFormat)
+		}
+
+		txn := Transaction{
+			Date:         dateOf(date),
+			Description:  cell(record, descriptionCol),
+			Reference:    cell(record, referenceCol),
+			Counterparty: cell(record, counterpartyCol),
+		}
+		if raw := cell(record, valueDateCol); raw != "" {
+			valueDate, err := time.Parse(profile.DateFormat, raw)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: value date %q does not match %s", ErrMalformed, line, raw, profile.DateFormat)
+			}
+			valueDate = dateOf(valueDate)
+			txn.ValueDate = &valueDate
+		}
+
+		if amountCol >= 0 {
+			txn.Amount, err = parseAmount(cell(record, amountCol))
+		} else {
+			txn.Amount, err = debitCredit(cell(record, debitCol), cell(record, creditCol))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %s", ErrMalformed, line, err.Error())
+		}
+
+		if raw := cell(record, balanceCol); raw != "" {
+			balance, err := parseAmount(raw)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %s", ErrMalformed, line, err.Error())
+			}
+			txn.Balance = &balance
+		}
+
+		statement.Transactions = append(statement.Transactions, txn)
+	}
+
+	orderOldestFirst(statement.Transactions)
+	if balanceCol >= 0 && len(statement.Transactions) > 0 {
+		first, last := statement.Transactions[0], statement.Transactions[len(statement.Transactions)-1]
+		if first.Balance != nil && last.Balance != nil {
+			opening := first.Balance.Sub(first.Amount)
+			statement.OpeningBalance = &opening
+			statement.ClosingBalance = last.Balance
+		}
+	}
+
+	if err := statement.finish(); err != nil {
+		return nil, err
+	}
+	return statement, nil
+}
+
+// debitCredit combines separate debit and credit cells into a signed amount.
+// Banks print debits both with and without a minus sign.
+func debitCredit(debit, credit string) (decimal.Decimal, error) {
+	out, err := parseAmount(debit)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	in, err := parseAmount(credit)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return in.Abs().Sub(out.Abs()), nil
+}
+
+// orderOldestFirst reverses exports listed newest first. The order is read
+// from the dates, or from the running balances when every line shares a date.
+func orderOldestFirst(txns []Transaction) {
+	if len(txns) < 2 {
+		return
+	}
+
+	first, last := txns[0], txns[len(txns)-1]
+	newestFirst := first.Date.After(last.Date)
+	if first.Date.Equal(last.Date) {
+		newestFirst = !balancesChain(txns) && balancesChain(reversed(txns))
+	}
+	if newestFirst {
+		copy(txns, reversed(txns))
+	}
+}
+
+// balancesChain reports whether each running balance is the previous one
+// plus the line's amount.
+func balancesChain(txns []Transaction) bool {
+	for i := 1; i < len(txns); i++ {
+		if txns[i].Balance == nil || txns[i-1].Balance == nil {
+			return false
+		}
+		if !txns[i-1].Balance.Add(txns[i].Amount).Equal(*txns[i].Balance) {
+			return false
+		}
+	}
+	return true
+}
+
+func reversed(txns []Transaction) []Transaction {
+	out := make([]Transaction, len(txns))
+	for i, txn := range txns {
+		out[len(txns)-1-i] = txn
+	}
+	return out
+}
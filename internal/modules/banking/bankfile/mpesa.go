@@ -0,0 +1,208 @@
+package bankfile
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// mpesaTimeLayouts are the completion time layouts seen in M-Pesa
+// organisation portal exports across portal versions and spreadsheet locales.
+var mpesaTimeLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"02-01-2006 15:04:05",
+	"02/01/2006 15:04:05",
+	"02/01/2006 3:04:05 PM",
+	"2006-01-02 15:04",
+	"02-01-2006 15:04",
+	"02/01/2006 15:04",
+}
+
+// ParseMpesa reads an M-Pesa organisation statement exported from the M-Pesa
+// org portal as CSV or XLSX. The receipt number becomes the reference and
+// Paid In and Withdrawn the signed amount; lines whose status is not
+// Completed are skipped. The export is listed newest first and its balances
+// are in KES.
+func ParseMpesa(data []byte) (*Statement, error) {
+	var (
+		rows [][]string
+		err  error
+	)
+	if isXLSX(data) {
+		rows, err = xlsxRows(data)
+	} else {
+		reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+		reader.FieldsPerRecord = -1
+		reader.LazyQuotes = true
+		rows, err = reader.ReadAll()
+		if err != nil {
+			err = fmt.Errorf("%w: %s", ErrMalformed, err.Error())
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	statement := &Statement{Currency: "KES"}
+	headerRow := -1
+	for i, row := range rows {
+		if mpesaHeaderIndex(row, "receipt no", "receipt number") >= 0 {
+			headerRow = i
+			break
+		}
+		if code := mpesaShortCode(row); code != "" {
+			statement.AccountNumber = code
+		}
+	}
+	if headerRow < 0 {
+		return nil, fmt.Errorf("%w: no Receipt No. header found; is this an M-Pesa organisation statement?", ErrMalformed)
+	}
+
+	header := rows[headerRow]
+	receiptCol := mpesaHeaderIndex(header, "receipt no", "receipt number")
+	completedCol := mpesaHeaderIndex(header, "completion time")
+	detailsCol := mpesaHeaderIndex(header, "details")
+	statusCol := mpesaHeaderIndex(header, "transaction status")
+	paidInCol := mpesaHeaderIndex(header, "paid in")
+	withdrawnCol := mpesaHeaderIndex(header, "withdrawn")
+	balanceCol := mpesaHeaderIndex(header, "balance")
+	reasonCol := mpesaHeaderIndex(header, "reason type")
+	otherPartyCol := mpesaHeaderIndex(header, "other party info")
+	linkedCol := mpesaHeaderIndex(header, "linked transaction id")
+	accountRefCol := mpesaHeaderIndex(header, "a/c no", "account no")
+	if completedCol < 0 || paidInCol < 0 || withdrawnCol < 0 {
+		return nil, fmt.Errorf("%w: Completion Time, Paid In and Withdrawn columns are required", ErrMalformed)
+	}
+
+	cell := func(record []string, i int) string {
+		if i < 0 || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	for i, record := range rows[headerRow+1:] {
+		line := headerRow + i + 2
+		receipt := cell(record, receiptCol)
+		rawTime := cell(record, completedCol)
+		if receipt == "" || rawTime == "" {
+			continue
+		}
+		if status := cell(record, statusCol); status != "" && !strings.EqualFold(status, "completed") {
+			continue
+		}
+
+		completed, err := mpesaTime(rawTime)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %s", ErrMalformed, line, err.Error())
+		}
+		amount, err := debitCredit(cell(record, withdrawnCol), cell(record, paidInCol))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %s", ErrMalformed, line, err.Error())
+		}
+
+		// Date keeps the completion time until the lines are ordered.
+		txn := Transaction{
+			Date:         completed,
+			Amount:       amount,
+			Reference:    receipt,
+			Description:  cell(record, detailsCol),
+			Counterparty: cell(record, otherPartyCol),
+			Metadata: map[string]any{
+				"completion_time": completed.Format("2006-01-02 15:04:05"),
+			},
+		}
+		if raw := cell(record, balanceCol); raw != "" {
+			balance, err := parseAmount(raw)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %s", ErrMalformed, line, err.Error())
+			}
+			txn.Balance = &balance
+		}
+		for key, col := range map[string]int{
+			"reason_type":           reasonCol,
+			"linked_transaction_id": linkedCol,
+			"account_reference":     accountRefCol,
+		} {
+			if value := cell(record, col); value != "" {
+				txn.Metadata[key] = value
+			}
+		}
+
+		statement.Transactions = append(statement.Transactions, txn)
+	}
+
+	orderOldestFirst(statement.Transactions)
+	for i := range statement.Transactions {
+		statement.Transactions[i].Date = dateOf(statement.Transactions[i].Date)
+	}
+	if balanceCol >= 0 && len(statement.Transactions) > 0 {
+		first, last := statement.Transactions[0], statement.Transactions[len(statement.Transactions)-1]
+		if first.Balance != nil && last.Balance != nil {
+			opening := first.Balance.Sub(first.Amount)
+			statement.OpeningBalance = &opening
+			statement.ClosingBalance = last.Balance
+		}
+	}
+
+	if err := statement.finish(); err != nil {
+		return nil, err
+	}
+	return statement, nil
+}
+
+// mpesaHeaderIndex returns the column whose header matches one of the names,
+// ignoring case and trailing dots and colons, or -1.
+func mpesaHeaderIndex(row []string, names ...string) int {
+	for i, value := range row {
+		value = strings.ToLower(strings.TrimRight(strings.TrimSpace(value), ".:"))
+		for _, name := range names {
+			if value == name {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// mpesaShortCode reads the paybill or till number from a "Short Code" line
+// of the export's preamble, written either as one cell or as a label cell
+// followed by the value.
+func mpesaShortCode(row []string) string {
+	for i, value := range row {
+		label, rest, _ := strings.Cut(strings.TrimSpace(value), ":")
+		if !strings.EqualFold(strings.ReplaceAll(label, " ", ""), "shortcode") {
+			continue
+		}
+		if rest = strings.TrimSpace(rest); rest != "" {
+			return rest
+		}
+		for _, next := range row[i+1:] {
+			if next = strings.TrimSpace(next); next != "" {
+				return next
+			}
+		}
+	}
+	return ""
+}
+
+// mpesaTime reads a completion time as text or, from XLSX exports, as an
+// Excel serial date number.
+func mpesaTime(value string) (time.Time, error) {
+	for _, layout := range mpesaTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	if serial, err := strconv.ParseFloat(value, 64); err == nil && serial > 0 {
+		days, fraction := math.Modf(serial)
+		t := time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC).AddDate(0, 0, int(days))
+		return t.Add(time.Duration(math.Round(fraction*86400)) * time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid completion time %q", value)
+}
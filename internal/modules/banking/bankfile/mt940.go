@@ -0,0 +1,229 @@
+package bankfile
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// mt940Tag matches the start of a field, e.g. ":61:" or ":60F:".
+	mt940Tag = regexp.MustCompile(`^:(\d{2}[A-Z]?):`)
+	// mt940Balance matches :60a:/:62a: balances: mark, date, currency, amount.
+	mt940Balance = regexp.MustCompile(`^([CD])(\d{6})([A-Z]{3})(\d+,\d*)$`)
+	// mt940Line matches the first line of a :61: statement line: value date,
+	// optional entry date, mark, optional funds code, amount, transaction
+	// type, then the customer and bank references.
+	mt940Line = regexp.MustCompile(`^(\d{6})(\d{4})?(R?[CD])([A-Z])?(\d+,\d*)([NSF][A-Z0-9]{3})(.*)$`)
+)
+
+type mt940Field struct {
+	tag   string
+	value string
+	line  int
+}
+
+// ParseMT940 reads a SWIFT MT940 customer statement. Files holding several
+// messages for the same account, such as a statement split over pages, are
+// read as one statement from the first opening balance to the last closing
+// balance.
+func ParseMT940(data []byte) (*Statement, error) {
+	fields, err := mt940Fields(data)
+	if err != nil {
+		return nil, err
+	}
+
+	statement := &Statement{}
+	var current *Transaction
+	for _, f := range fields {
+		switch f.tag {
+		case "20":
+			if statement.Reference == "" {
+				statement.Reference = f.value
+			}
+		case "25":
+			account := f.value
+			if i := strings.LastIndex(account, "/"); i >= 0 {
+				account = account[i+1:]
+			}
+			if statement.AccountNumber != "" && statement.AccountNumber != account {
+				return nil, fmt.Errorf("%w: line %d: file holds statements for more than one account", ErrMalformed, f.line)
+			}
+			statement.AccountNumber = account
+		case "60F", "60M":
+			if statement.OpeningBalance != nil {
+				continue
+			}
+			_, currency, amount, err := mt940ParseBalance(f.value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %s", ErrMalformed, f.line, err.Error())
+			}
+			statement.OpeningBalance = &amount
+			statement.Currency = currency
+		case "61":
+			txn, err := mt940ParseLine(f.value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %s", ErrMalformed, f.line, err.Error())
+			}
+			statement.Transactions = append(statement.Transactions, txn)
+			current = &statement.Transactions[len(statement.Transactions)-1]
+		case "86":
+			if current != nil {
+				current.Description = strings.Join(strings.Fields(f.value), " ")
+				current = nil
+			}
+		case "62F", "62M":
+			date, currency, amount, err := mt940ParseBalance(f.value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %s", ErrMalformed, f.line, err.Error())
+			}
+			statement.ClosingBalance = &amount
+			statement.Currency = currency
+			statement.PeriodEnd = date
+			current = nil
+		}
+	}
+
+	if statement.OpeningBalance == nil || statement.ClosingBalance == nil {
+		return nil, fmt.Errorf("%w: opening (:60F:) and closing (:62F:) balances are required", ErrMalformed)
+	}
+	// Banks date the opening balance either on the first day or on the day
+	// before it, so the period starts with the first transaction; a statement
+	// without movement covers its closing date only.
+	if len(statement.Transactions) == 0 {
+		statement.PeriodStart = statement.PeriodEnd
+	}
+	if err := statement.finish(); err != nil {
+		return nil, err
+	}
+	return statement, nil
+}
+
+// mt940Fields splits the message text into tagged fields, joining
+// continuation lines to the field they belong to. Block headers and the
+// end-of-message "-" line are ignored.
+func mt940Fields(data []byte) ([]mt940Field, error) {
+	var fields []mt940Field
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimRight(scanner.Text(), "\r ")
+		text = strings.TrimPrefix(text, "{4:")
+		if text == "" || text == "-" || text == "-}" || strings.HasPrefix(text, "{1:") {
+			continue
+		}
+
+		if m := mt940Tag.FindStringSubmatch(text); m != nil {
+			fields = append(fields, mt940Field{tag: m[1], value: text[len(m[0]):], line: line})
+			continue
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		fields[len(fields)-1].value += "\n" + text
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrMalformed, err.Error())
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%w: no MT940 fields found", ErrMalformed)
+	}
+	return fields, nil
+}
+
+func mt940ParseBalance(value string) (time.Time, string, decimal.Decimal, error) {
+	m := mt940Balance.FindStringSubmatch(strings.TrimSpace(value))
+	if m == nil {
+		return time.Time{}, "", decimal.Zero, fmt.Errorf("invalid balance %q", value)
+	}
+	date, err := time.Parse("060102", m[2])
+	if err != nil {
+		return time.Time{}, "", decimal.Zero, fmt.Errorf("invalid balance date %q", m[2])
+	}
+	amount, err := mt940Amount(m[4])
+	if err != nil {
+		return time.Time{}, "", decimal.Zero, err
+	}
+	if m[1] == "D" {
+		amount = amount.Neg()
+	}
+	return date, m[3], amount, nil
+}
+
+// mt940ParseLine reads a :61: statement line and its optional supplementary
+// details line.
+func mt940ParseLine(value string) (Transaction, error) {
+	first, supplementary, _ := strings.Cut(value, "\n")
+	m := mt940Line.FindStringSubmatch(first)
+	if m == nil {
+		return Transaction{}, fmt.Errorf("invalid statement line %q", first)
+	}
+
+	valueDate, err := time.Parse("060102", m[1])
+	if err != nil {
+		return Transaction{}, fmt.Errorf("invalid value date %q", m[1])
+	}
+	date := valueDate
+	if m[2] != "" {
+		entry, err := time.Parse("0102", m[2])
+		if err != nil {
+			return Transaction{}, fmt.Errorf("invalid entry date %q", m[2])
+		}
+		// The entry date has no year; it can fall either side of a year end
+		// from the value date.
+		date = time.Date(valueDate.Year(), entry.Month(), entry.Day(), 0, 0, 0, 0, time.UTC)
+		switch {
+		case date.Sub(valueDate) > 180*24*time.Hour:
+			date = date.AddDate(-1, 0, 0)
+		case valueDate.Sub(date) > 180*24*time.Hour:
+			date = date.AddDate(1, 0, 0)
+		}
+	}
+
+	amount, err := mt940Amount(m[5])
+	if err != nil {
+		return Transaction{}, err
+	}
+	// Debits and reversed credits take money out.
+	if m[3] == "D" || m[3] == "RC" {
+		amount = amount.Neg()
+	}
+
+	customerRef, bankRef, _ := strings.Cut(m[7], "//")
+	customerRef, bankRef = strings.TrimSpace(customerRef), strings.TrimSpace(bankRef)
+	reference := customerRef
+	if reference == "" || reference == "NONREF" {
+		reference = bankRef
+	}
+
+	txn := Transaction{
+		Date:      date,
+		ValueDate: &valueDate,
+		Amount:    amount,
+		Reference: reference,
+		Metadata:  map[string]any{"transaction_type": m[6]},
+	}
+	if bankRef != "" {
+		txn.Metadata["bank_reference"] = bankRef
+	}
+	if supplementary = strings.TrimSpace(supplementary); supplementary != "" {
+		txn.Metadata["supplementary_details"] = supplementary
+	}
+	return txn, nil
+}
+
+// mt940Amount reads an amount written with a decimal comma, e.g. "1500,00"
+// or "1500,".
+func mt940Amount(value string) (decimal.Decimal, error) {
+	amount, err := decimal.NewFromString(strings.TrimSuffix(strings.Replace(value, ",", ".", 1), "."))
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid amount %q", value)
+	}
+	return amount, nil
+}
@@ -0,0 +1,266 @@
+package bankfile
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestParseCSV(t *testing.T) {
+	// Exported newest first with separate debit and credit columns.
+	data := []byte("Account statement,,,,\n" +
+		"Txn Date,Narrative,Ref,Debit,Credit,Balance\n" +
+		"03/10/2024,Bank charges,FT003,30.00,,\"12,470.00\"\n" +
+		"02/10/2024,Supplier payment,FT002,\"2,500.00\",,\"12,500.00\"\n" +
+		"01/10/2024,Customer receipt,FT001,,\"5,000.00\",\"15,000.00\"\n" +
+		",Total,,\"2,530.00\",\"5,000.00\",\n")
+	profile := Profile{
+		SkipRows:          1,
+		DateColumn:        "txn date",
+		DateFormat:        "02/01/2006",
+		DescriptionColumn: "Narrative",
+		ReferenceColumn:   "Ref",
+		DebitColumn:       "Debit",
+		CreditColumn:      "Credit",
+		BalanceColumn:     "Balance",
+	}
+
+	statement, err := ParseCSV(data, profile)
+	if err != nil {
+		t.Fatalf("ParseCSV: %v", err)
+	}
+	if len(statement.Transactions) != 3 {
+		t.Fatalf("transactions = %d, want 3", len(statement.Transactions))
+	}
+	first := statement.Transactions[0]
+	if first.Reference != "FT001" || !first.Amount.Equal(decimal.NewFromInt(5000)) {
+		t.Errorf("first transaction = %s %s, want FT001 5000", first.Reference, first.Amount)
+	}
+	if !statement.Transactions[2].Amount.Equal(decimal.NewFromInt(-30)) {
+		t.Errorf("charge amount = %s, want -30", statement.Transactions[2].Amount)
+	}
+	if !statement.OpeningBalance.Equal(decimal.NewFromInt(10000)) || !statement.ClosingBalance.Equal(decimal.NewFromInt(12470)) {
+		t.Errorf("balances = %s to %s, want 10000 to 12470", statement.OpeningBalance, statement.ClosingBalance)
+	}
+	if !statement.PeriodStart.Equal(date(2024, 10, 1)) || !statement.PeriodEnd.Equal(date(2024, 10, 3)) {
+		t.Errorf("period = %s to %s", statement.PeriodStart, statement.PeriodEnd)
+	}
+
+	profile.BalanceColumn = "Running Balance"
+	if _, err := ParseCSV(data, profile); !errors.Is(err, ErrMalformed) {
+		t.Errorf("missing column error = %v, want ErrMalformed", err)
+	}
+}
+
+func TestParseMT940(t *testing.T) {
+	data := []byte(`{1:F01KCBLKENXAXXX0000000000}{2:O9401200241002KCBLKENXAXXX00000000002410021200N}{4:
+:20:STMT241002
+:25:KCBLKENX/1234567890
+:28C:00045/001
+:60F:C241001KES10000,00
+:61:2410011001C5000,00NTRFINV-1001//FT24275ABC
+:86:RECEIPT FROM ACME LTD
+ INV-1001
+:61:2410021002D2500,NCHKNONREF//CHQ000123
+:86:CHEQUE 000123
+:62F:C241002KES12500,00
+-}`)
+
+	statement, err := ParseMT940(data)
+	if err != nil {
+		t.Fatalf("ParseMT940: %v", err)
+	}
+	if statement.AccountNumber != "1234567890" || statement.Currency != "KES" || statement.Reference != "STMT241002" {
+		t.Errorf("header = %q %q %q", statement.AccountNumber, statement.Currency, statement.Reference)
+	}
+	if len(statement.Transactions) != 2 {
+		t.Fatalf("transactions = %d, want 2", len(statement.Transactions))
+	}
+
+	receipt, cheque := statement.Transactions[0], statement.Transactions[1]
+	if receipt.Reference != "INV-1001" || receipt.Description != "RECEIPT FROM ACME LTD INV-1001" || !receipt.Amount.Equal(decimal.NewFromInt(5000)) {
+		t.Errorf("receipt = %q %q %s", receipt.Reference, receipt.Description, receipt.Amount)
+	}
+	if cheque.Reference != "CHQ000123" || !cheque.Amount.Equal(decimal.NewFromInt(-2500)) {
+		t.Errorf("cheque = %q %s, want CHQ000123 -2500", cheque.Reference, cheque.Amount)
+	}
+	if !statement.OpeningBalance.Add(statement.Movement()).Equal(*statement.ClosingBalance) {
+		t.Errorf("balances do not add up: %s + %s != %s", statement.OpeningBalance, statement.Movement(), statement.ClosingBalance)
+	}
+	if !statement.PeriodEnd.Equal(date(2024, 10, 2)) {
+		t.Errorf("period end = %s, want 2024-10-02", statement.PeriodEnd)
+	}
+}
+
+func TestParseMT940EntryDateAcrossYearEnd(t *testing.T) {
+	txn, err := mt940ParseLine("2312310102C100,00NTRFNONREF//X1")
+	if err != nil {
+		t.Fatalf("mt940ParseLine: %v", err)
+	}
+	if !txn.Date.Equal(date(2024, 1, 2)) {
+		t.Errorf("booking date = %s, want 2024-01-02", txn.Date)
+	}
+}
+
+func TestParseCAMT053(t *testing.T) {
+	data := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08">
+  <BkToCstmrStmt>
+    <Stmt>
+      <Id>STMT-2024-10-02</Id>
+      <Acct><Id><Othr><Id>1234567890</Id></Othr></Id><Ccy>KES</Ccy></Acct>
+      <Bal><Tp><CdOrPrtry><Cd>OPBD</Cd></CdOrPrtry></Tp><Amt Ccy="KES">10000.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Dt><Dt>2024-10-01</Dt></Dt></Bal>
+      <Bal><Tp><CdOrPrtry><Cd>CLBD</Cd></CdOrPrtry></Tp><Amt Ccy="KES">12500.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Dt><Dt>2024-10-02</Dt></Dt></Bal>
+      <Ntry>
+        <Amt Ccy="KES">5000.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts><Cd>BOOK</Cd></Sts>
+        <BookgDt><Dt>2024-10-01</Dt></BookgDt><ValDt><Dt>2024-10-01</Dt></ValDt>
+        <NtryDtls><TxDtls>
+          <Refs><EndToEndId>INV-1001</EndToEndId></Refs>
+          <RltdPties><Dbtr><Pty><Nm>Acme Ltd</Nm></Pty></Dbtr></RltdPties>
+          <RmtInf><Ustrd>Payment for INV-1001</Ustrd></RmtInf>
+        </TxDtls></NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="KES">2500.00</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts><Cd>BOOK</Cd></Sts>
+        <BookgDt><DtTm>2024-10-02T09:30:00+03:00</DtTm></BookgDt>
+        <AcctSvcrRef>FT24276XYZ</AcctSvcrRef>
+        <AddtlNtryInf>Supplier payment</AddtlNtryInf>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="KES">99.00</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts><Cd>PDNG</Cd></Sts>
+        <BookgDt><Dt>2024-10-02</Dt></BookgDt>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>`)
+
+	statement, err := ParseCAMT053(data)
+	if err != nil {
+		t.Fatalf("ParseCAMT053: %v", err)
+	}
+	if statement.AccountNumber != "1234567890" || statement.Currency != "KES" {
+		t.Errorf("account = %q %q", statement.AccountNumber, statement.Currency)
+	}
+	if len(statement.Transactions) != 2 {
+		t.Fatalf("transactions = %d, want 2 booked entries", len(statement.Transactions))
+	}
+
+	receipt, payment := statement.Transactions[0], statement.Transactions[1]
+	if receipt.Reference != "INV-1001" || receipt.Counterparty != "Acme Ltd" || receipt.Description != "Payment for INV-1001" {
+		t.Errorf("receipt = %q %q %q", receipt.Reference, receipt.Counterparty, receipt.Description)
+	}
+	if payment.Reference != "FT24276XYZ" || !payment.Amount.Equal(decimal.NewFromInt(-2500)) || !payment.Date.Equal(date(2024, 10, 2)) {
+		t.Errorf("payment = %q %s %s", payment.Reference, payment.Amount, payment.Date)
+	}
+	if !statement.OpeningBalance.Equal(decimal.NewFromInt(10000)) || !statement.ClosingBalance.Equal(decimal.NewFromInt(12500)) {
+		t.Errorf("balances = %s to %s", statement.OpeningBalance, statement.ClosingBalance)
+	}
+}
+
+func TestParseMpesaCSV(t *testing.T) {
+	data := []byte(`Organization Name:,ACME LTD
+Short Code:,600100
+Time Period:,01-10-2024 to 02-10-2024
+Receipt No.,Completion Time,Initiation Time,Details,Transaction Status,Paid In,Withdrawn,Balance,Balance Confirmed,Reason Type,Other Party Info,Linked Transaction ID,A/C No.
+SJ25XYZ003,2024-10-02 09:15:40,2024-10-02 09:15:39,Business Pay Bill Charge,Completed,,-22.00,"7,478.00",true,Pay Bill Charge,,SJ25XYZ002,
+SJ25XYZ002,2024-10-02 09:15:40,2024-10-02 09:15:39,Pay Bill to 400200 - KPLC,Completed,,"-2,500.00","7,500.00",true,Business Pay Bill,400200 - KPLC,,ACC-77
+SJ15XYZ009,2024-10-01 18:02:11,2024-10-01 18:02:11,Pay Bill from 254712345678 - JANE W,Failed,500.00,,,false,Pay Bill Online,254712345678 - JANE W,,INV-1
+SJ15XYZ001,2024-10-01 18:02:10,2024-10-01 18:02:10,Pay Bill from 254712345678 - JANE W,Completed,"5,000.00",,"10,000.00",true,Pay Bill Online,254712345678 - JANE W,,INV-1
+`)
+
+	statement, err := ParseMpesa(data)
+	if err != nil {
+		t.Fatalf("ParseMpesa: %v", err)
+	}
+	if statement.AccountNumber != "600100" || statement.Currency != "KES" {
+		t.Errorf("account = %q %q, want 600100 KES", statement.AccountNumber, statement.Currency)
+	}
+	if len(statement.Transactions) != 3 {
+		t.Fatalf("transactions = %d, want 3 completed lines", len(statement.Transactions))
+	}
+
+	receipt := statement.Transactions[0]
+	if receipt.Reference != "SJ15XYZ001" || receipt.Counterparty != "254712345678 - JANE W" || !receipt.Amount.Equal(decimal.NewFromInt(5000)) {
+		t.Errorf("receipt = %q %q %s", receipt.Reference, receipt.Counterparty, receipt.Amount)
+	}
+	if receipt.Metadata["account_reference"] != "INV-1" || !receipt.Date.Equal(date(2024, 10, 1)) {
+		t.Errorf("receipt metadata = %v, date = %s", receipt.Metadata, receipt.Date)
+	}
+	charge := statement.Transactions[2]
+	if charge.Reference != "SJ25XYZ003" || !charge.Amount.Equal(decimal.NewFromInt(-22)) || charge.Metadata["linked_transaction_id"] != "SJ25XYZ002" {
+		t.Errorf("charge = %q %s %v", charge.Reference, charge.Amount, charge.Metadata)
+	}
+	if !statement.OpeningBalance.Equal(decimal.NewFromInt(5000)) || !statement.ClosingBalance.Equal(decimal.NewFromInt(7478)) {
+		t.Errorf("balances = %s to %s, want 5000 to 7478", statement.OpeningBalance, statement.ClosingBalance)
+	}
+}
+
+func TestParseMpesaXLSX(t *testing.T) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for name, body := range map[string]string{
+		"xl/workbook.xml":            `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Statement" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Target="worksheets/sheet1.xml"/></Relationships>`,
+		"xl/sharedStrings.xml":       `<sst><si><t>Receipt No.</t></si><si><t>Completion Time</t></si><si><t>Paid In</t></si><si><t>Withdrawn</t></si><si><t>Balance</t></si><si><r><t>SJ15</t></r><r><t>XYZ001</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet><sheetData>
+<row r="1"><c r="A1" t="inlineStr"><is><t>Short Code</t></is></c><c r="B1"><v>600100</v></c></row>
+<row r="3"><c r="A3" t="s"><v>0</v></c><c r="B3" t="s"><v>1</v></c><c r="C3" t="s"><v>2</v></c><c r="D3" t="s"><v>3</v></c><c r="E3" t="s"><v>4</v></c></row>
+<row r="4"><c r="A4" t="s"><v>5</v></c><c r="B4"><v>45566.75</v></c><c r="C4"><v>5000</v></c><c r="E4"><v>10000</v></c></row>
+</sheetData></worksheet>`,
+	} {
+		w, err := archive.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	statement, err := ParseMpesa(buf.Bytes())
+	if err != nil {
+		t.Fatalf("ParseMpesa: %v", err)
+	}
+	if statement.AccountNumber != "600100" || len(statement.Transactions) != 1 {
+		t.Fatalf("account = %q, transactions = %d", statement.AccountNumber, len(statement.Transactions))
+	}
+	txn := statement.Transactions[0]
+	if txn.Reference != "SJ15XYZ001" || !txn.Amount.Equal(decimal.NewFromInt(5000)) || !txn.Date.Equal(date(2024, 10, 1)) {
+		t.Errorf("transaction = %q %s %s", txn.Reference, txn.Amount, txn.Date)
+	}
+	if txn.Metadata["completion_time"] != "2024-10-01 18:00:00" {
+		t.Errorf("completion time = %v, want 2024-10-01 18:00:00", txn.Metadata["completion_time"])
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := map[string]string{
+		"1,234.50":   "1234.5",
+		"(1,234.50)": "-1234.5",
+		"-30":        "-30",
+		"500.00 DR":  "-500",
+		"500.00CR":   "500",
+		"":           "0",
+	}
+	for input, want := range tests {
+		got, err := parseAmount(input)
+		if err != nil {
+			t.Errorf("parseAmount(%q): %v", input, err)
+			continue
+		}
+		if !got.Equal(decimal.RequireFromString(want)) {
+			t.Errorf("parseAmount(%q) = %s, want %s", input, got, want)
+		}
+	}
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
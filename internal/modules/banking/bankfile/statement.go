@@ -0,0 +1,118 @@
+// Package bankfile parses bank statement files into a common statement shape.
+package bankfile
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrMalformed is returned when a statement file cannot be read in the
+// expected format.
+var ErrMalformed = errors.New("malformed statement file")
+
+// Statement is a bank statement read from a file. Balances are nil when the
+// file does not state them.
+type Statement struct {
+	Reference      string
+	AccountNumber  string
+	Currency       string
+	PeriodStart    time.Time
+	PeriodEnd      time.Time
+	OpeningBalance *decimal.Decimal
+	ClosingBalance *decimal.Decimal
+	Transactions   []Transaction
+}
+
+// Transaction is a statement line. Amount is positive for money in and
+// negative for money out.
+type Transaction struct {
+	Date         time.Time
+	ValueDate    *time.Time
+	Amount       decimal.Decimal
+	Reference    string
+	Description  string
+	Counterparty string
+	// Balance is the running balance after the transaction, when shown.
+	Balance *decimal.Decimal
+	// Metadata carries format-specific details worth keeping.
+	Metadata map[string]any
+}
+
+// Movement returns the sum of the statement's transactions.
+func (s *Statement) Movement() decimal.Decimal {
+	total := decimal.Zero
+	for _, txn := range s.Transactions {
+		total = total.Add(txn.Amount)
+	}
+	return total
+}
+
+// finish fills the period from the transaction dates where the file does not
+// state it and rejects statements with nothing in them.
+func (s *Statement) finish() error {
+	if len(s.Transactions) == 0 && s.PeriodStart.IsZero() {
+		return fmt.Errorf("%w: no transactions found", ErrMalformed)
+	}
+	for _, txn := range s.Transactions {
+		if s.PeriodStart.IsZero() || txn.Date.Before(s.PeriodStart) {
+			s.PeriodStart = txn.Date
+		}
+		if txn.Date.After(s.PeriodEnd) {
+			s.PeriodEnd = txn.Date
+		}
+	}
+	if s.PeriodEnd.IsZero() {
+		s.PeriodEnd = s.PeriodStart
+	}
+	return nil
+}
+
+// parseAmount reads an amount as banks print it: with thousands separators,
+// negative in parentheses or with a leading minus, or with a trailing CR/DR
+// marker. Empty values are zero.
+func parseAmount(value string) (decimal.Decimal, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if value == "" || value == "-" {
+		return decimal.Zero, nil
+	}
+
+	negative := false
+	switch {
+	case strings.HasSuffix(value, "DR"):
+		negative, value = true, strings.TrimSpace(strings.TrimSuffix(value, "DR"))
+	case strings.HasSuffix(value, "CR"):
+		value = strings.TrimSpace(strings.TrimSuffix(value, "CR"))
+	}
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		negative, value = true, value[1:len(value)-1]
+	}
+	value = strings.NewReplacer(",", "", " ", "").Replace(value)
+
+	amount, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid amount %q", value)
+	}
+	if negative {
+		amount = amount.Neg()
+	}
+	return amount, nil
+}
+
+// parseISODate reads an ISO 8601 date or date-time, keeping the calendar date.
+func parseISODate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range []string{time.DateOnly, time.RFC3339, "2006-01-02T15:04:05"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return dateOf(t), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,175 @@
+package bankfile
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// isXLSX reports whether data is a zip archive, the container of an XLSX
+// workbook.
+func isXLSX(data []byte) bool {
+	return bytes.HasPrefix(data, []byte("PK\x03\x04"))
+}
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// xlsxText is a shared or inline string, either plain or split into
+// formatted runs.
+type xlsxText struct {
+	Text string   `xml:"t"`
+	Runs []string `xml:"r>t"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) > 0 {
+		return strings.Join(t.Runs, "")
+	}
+	return t.Text
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+type xlsxSheet struct {
+	Rows []struct {
+		Cells []struct {
+			Ref    string   `xml:"r,attr"`
+			Type   string   `xml:"t,attr"`
+			Value  string   `xml:"v"`
+			Inline xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// xlsxRows reads the cell text of the first worksheet of an XLSX workbook.
+// Numbers are returned as stored, so dates come back as Excel serial numbers.
+func xlsxRows(data []byte) ([][]string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrMalformed, err.Error())
+	}
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, f := range archive.File {
+		files[f.Name] = f
+	}
+	read := func(name string, v any) error {
+		f, ok := files[name]
+		if !ok {
+			return fmt.Errorf("%w: workbook has no %s", ErrMalformed, name)
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrMalformed, err.Error())
+		}
+		defer rc.Close()
+		body, err := io.ReadAll(rc)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrMalformed, err.Error())
+		}
+		if err := xml.Unmarshal(body, v); err != nil {
+			return fmt.Errorf("%w: %s: %s", ErrMalformed, name, err.Error())
+		}
+		return nil
+	}
+
+	sheetName, err := xlsxFirstSheet(read)
+	if err != nil {
+		return nil, err
+	}
+
+	var shared xlsxSharedStrings
+	if _, ok := files["xl/sharedStrings.xml"]; ok {
+		if err := read("xl/sharedStrings.xml", &shared); err != nil {
+			return nil, err
+		}
+	}
+
+	var sheet xlsxSheet
+	if err := read(sheetName, &sheet); err != nil {
+		return nil, err
+	}
+
+	rows := make([][]string, 0, len(sheet.Rows))
+	for _, row := range sheet.Rows {
+		var record []string
+		for i, c := range row.Cells {
+			col := xlsxColumn(c.Ref)
+			if col < 0 {
+				col = i
+			}
+			for len(record) <= col {
+				record = append(record, "")
+			}
+			switch c.Type {
+			case "s":
+				index, err := strconv.Atoi(c.Value)
+				if err != nil || index < 0 || index >= len(shared.Items) {
+					return nil, fmt.Errorf("%w: cell %s refers to a missing shared string", ErrMalformed, c.Ref)
+				}
+				record[col] = shared.Items[index].String()
+			case "inlineStr":
+				record[col] = c.Inline.String()
+			default:
+				record[col] = c.Value
+			}
+		}
+		rows = append(rows, record)
+	}
+	return rows, nil
+}
+
+// xlsxFirstSheet resolves the part name of the workbook's first sheet.
+func xlsxFirstSheet(read func(name string, v any) error) (string, error) {
+	var workbook xlsxWorkbook
+	if err := read("xl/workbook.xml", &workbook); err != nil {
+		return "", err
+	}
+	if len(workbook.Sheets) == 0 {
+		return "", fmt.Errorf("%w: workbook has no sheets", ErrMalformed)
+	}
+	var rels xlsxRelationships
+	if err := read("xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return "", err
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID != workbook.Sheets[0].RelID {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+	return "", fmt.Errorf("%w: first sheet not found in workbook", ErrMalformed)
+}
+
+// xlsxColumn returns the zero-based column of a cell reference such as
+// "C12", or -1 when the reference is missing.
+func xlsxColumn(ref string) int {
+	col := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		col = col*26 + int(r-'A'+1)
+	}
+	return col - 1
+}
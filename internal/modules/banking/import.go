@@ -0,0 +1,78 @@
+package banking
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/banking/bankfile"
+)
+
+// transactionHashes returns the duplicate-detection hash of each statement
+// line: its date, amount and reference (the description when it has none),
+// and how many identical lines precede it in the file. Counting repeats keeps
+// genuine twins, such as two equal charges on one day, while the same lines
+// in an overlapping file hash the same and are skipped.
+func transactionHashes(bankAccountID uuid.UUID, txns []bankfile.Transaction) []string {
+	seen := make(map[string]int, len(txns))
+	hashes := make([]string, len(txns))
+	for i, txn := range txns {
+		reference := strings.ToUpper(strings.TrimSpace(txn.Reference))
+		if reference == "" {
+			reference = strings.ToUpper(strings.Join(strings.Fields(txn.Description), " "))
+		}
+		key := strings.Join([]string{
+			bankAccountID.String(),
+			txn.Date.Format(time.DateOnly),
+			txn.Amount.StringFixed(2),
+			reference,
+		}, "|")
+
+		occurrence := seen[key]
+		seen[key] = occurrence + 1
+
+		sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%d", key, occurrence)))
+		hashes[i] = hex.EncodeToString(sum[:])
+	}
+	return hashes
+}
+
+// checkTotals verifies the statement's transactions take the opening balance
+// to the closing balance, when the file states both.
+func checkTotals(statement *bankfile.Statement) error {
+	if statement.OpeningBalance == nil || statement.ClosingBalance == nil {
+		return nil
+	}
+	movement := statement.Movement()
+	if !statement.OpeningBalance.Add(movement).Equal(*statement.ClosingBalance) {
+		return fmt.Errorf("%w: opening balance %s plus transactions %s does not equal closing balance %s",
+			ErrInvalidStatement, statement.OpeningBalance.StringFixed(2), movement.StringFixed(2), statement.ClosingBalance.StringFixed(2))
+	}
+	return nil
+}
+
+// continuity compares a statement's opening balance with the one implied by
+// the previous statement. Without an opening balance there is nothing to
+// check; without a previous statement the statement starts the history.
+func continuity(opening, expected *decimal.Decimal) string {
+	switch {
+	case opening == nil:
+		return ContinuityUnverified
+	case expected == nil:
+		return ContinuityFirst
+	case opening.Equal(*expected):
+		return ContinuityContinuous
+	default:
+		return ContinuityGap
+	}
+}
+
+func fileHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
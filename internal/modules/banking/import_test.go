@@ -0,0 +1,87 @@
+package banking
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/banking/bankfile"
+)
+
+func TestTransactionHashes(t *testing.T) {
+	accountID := uuid.New()
+	day := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	charge := bankfile.Transaction{Date: day, Amount: decimal.NewFromInt(-30), Description: "Ledger fee"}
+	receipt := bankfile.Transaction{Date: day, Amount: decimal.NewFromInt(5000), Reference: "FT001"}
+
+	first := transactionHashes(accountID, []bankfile.Transaction{charge, receipt, charge})
+	if first[0] == first[2] {
+		t.Fatal("repeated lines in one file hash the same")
+	}
+
+	// An overlapping file carrying the same day hashes the same lines alike.
+	second := transactionHashes(accountID, []bankfile.Transaction{receipt, charge, charge})
+	if second[0] != first[1] || second[1] != first[0] || second[2] != first[2] {
+		t.Error("overlapping file hashes differ from the first import")
+	}
+
+	// The reference is compared without case or surrounding spaces.
+	receipt.Reference = " ft001 "
+	if transactionHashes(accountID, []bankfile.Transaction{receipt})[0] != first[1] {
+		t.Error("reference formatting changed the hash")
+	}
+
+	if transactionHashes(uuid.New(), []bankfile.Transaction{charge})[0] == first[0] {
+		t.Error("hashes are shared across bank accounts")
+	}
+}
+
+func TestCheckTotals(t *testing.T) {
+	opening, closing := decimal.NewFromInt(1000), decimal.NewFromInt(1470)
+	statement := &bankfile.Statement{
+		OpeningBalance: &opening,
+		ClosingBalance: &closing,
+		Transactions: []bankfile.Transaction{
+			{Amount: decimal.NewFromInt(500)},
+			{Amount: decimal.NewFromInt(-30)},
+		},
+	}
+	if err := checkTotals(statement); err != nil {
+		t.Fatalf("checkTotals: %v", err)
+	}
+
+	statement.Transactions = statement.Transactions[:1]
+	if err := checkTotals(statement); !errors.Is(err, ErrInvalidStatement) {
+		t.Errorf("checkTotals with a missing line = %v, want ErrInvalidStatement", err)
+	}
+
+	statement.OpeningBalance = nil
+	if err := checkTotals(statement); err != nil {
+		t.Errorf("checkTotals without an opening balance = %v, want nil", err)
+	}
+}
+
+func TestContinuity(t *testing.T) {
+	balance := decimal.NewFromInt(1000)
+	other := decimal.NewFromInt(990)
+
+	tests := []struct {
+		name     string
+		opening  *decimal.Decimal
+		expected *decimal.Decimal
+		want     string
+	}{
+		{"no opening balance", nil, &balance, ContinuityUnverified},
+		{"no previous statement", &balance, nil, ContinuityFirst},
+		{"follows on", &balance, &balance, ContinuityContinuous},
+		{"gap", &balance, &other, ContinuityGap},
+	}
+	for _, tt := range tests {
+		if got := continuity(tt.opening, tt.expected); got != tt.want {
+			t.Errorf("%s: continuity = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
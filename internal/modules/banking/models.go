@@ -0,0 +1,263 @@
+package banking
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/banking/bankfile"
+)
+
+// Bank account types.
+const (
+	TypeBank         = "bank"
+	TypeMpesaPaybill = "mpesa_paybill"
+	TypeMpesaTill    = "mpesa_till"
+	TypeFloatWallet  = "float_wallet"
+)
+
+// Statement file formats.
+const (
+	FormatCSV     = "csv"
+	FormatMT940   = "mt940"
+	FormatCAMT053 = "camt053"
+	FormatMpesa   = "mpesa"
+)
+
+// Continuity outcomes of checking a statement's opening balance against the
+// previous statement.
+const (
+	ContinuityContinuous = "continuous"
+	ContinuityFirst      = "first"
+	ContinuityGap        = "gap"
+	ContinuityUnverified = "unverified"
+)
+
+// Bank transaction reconciliation statuses.
+const (
+	ReconciliationUnreconciled = "unreconciled"
+	ReconciliationMatched      = "matched"
+	ReconciliationReconciled   = "reconciled"
+)
+
+// EventStatementImported is published when a bank statement file is imported.
+const EventStatementImported = "treasury.bank_statement.imported"
+
+// Bank account statuses.
+const (
+	StatusActive   = "active"
+	StatusInactive = "inactive"
+	StatusClosed   = "closed"
+)
+
+// BankAccount is an account the tenant holds money in, booked to a cash
+// account in the chart of accounts.
+type BankAccount struct {
+	ID                  uuid.UUID        `json:"id"`
+	TenantID            uuid.UUID        `json:"tenant_id"`
+	AccountName         string           `json:"account_name"`
+	BankName            string           `json:"bank_name"`
+	Branch              string           `json:"branch,omitempty"`
+	AccountType         string           `json:"account_type"`
+	AccountNumberMasked string           `json:"account_number_masked"`
+	AccountNumberHash   string           `json:"-"`
+	Currency            string           `json:"currency"`
+	LedgerAccountCode   string           `json:"ledger_account_code"`
+	StatementBalance    *decimal.Decimal `json:"statement_balance,omitempty"`
+	StatementDate       *time.Time       `json:"statement_date,omitempty"`
+	Status              string           `json:"status"`
+	Metadata            map[string]any   `json:"metadata,omitempty"`
+	CreatedBy           *uuid.UUID       `json:"created_by,omitempty"`
+	CreatedAt           time.Time        `json:"created_at"`
+	UpdatedAt           time.Time        `json:"updated_at"`
+}
+
+// CreateRequest registers a bank account. AccountNumber is the account
+// number, paybill or till number; only its masked form is kept.
+type CreateRequest struct {
+	AccountName       string         `json:"account_name"`
+	BankName          string         `json:"bank_name"`
+	Branch            string         `json:"branch"`
+	AccountType       string         `json:"account_type"`
+	AccountNumber     string         `json:"account_number"`
+	Currency          string         `json:"currency"`
+	LedgerAccountCode string         `json:"ledger_account_code"`
+	Metadata          map[string]any `json:"metadata"`
+}
+
+// UpdateRequest changes the provided fields of a bank account. Accounts are
+// closed with Close rather than by setting the status.
+type UpdateRequest struct {
+	AccountName       *string        `json:"account_name"`
+	BankName          *string        `json:"bank_name"`
+	Branch            *string        `json:"branch"`
+	AccountNumber     *string        `json:"account_number"`
+	LedgerAccountCode *string        `json:"ledger_account_code"`
+	Status            *string        `json:"status"`
+	Metadata          map[string]any `json:"metadata"`
+}
+
+// ListFilter narrows bank account listings.
+type ListFilter struct {
+	AccountType string
+	Status      string
+	Currency    string
+}
+
+// Balance compares the ledger's view of a bank account with the bank's.
+// BookBalance is the balance of the linked cash account at the end of AsOf.
+// When a statement has been imported, BookAtStatement is the book balance at
+// the end of the statement date and Difference is StatementBalance less
+// BookAtStatement: the amount still to be reconciled.
+type Balance struct {
+	BankAccountID     uuid.UUID        `json:"bank_account_id"`
+	LedgerAccountCode string           `json:"ledger_account_code"`
+	Currency          string           `json:"currency"`
+	AsOf              time.Time        `json:"as_of"`
+	BookBalance       decimal.Decimal  `json:"book_balance"`
+	StatementBalance  *decimal.Decimal `json:"statement_balance,omitempty"`
+	StatementDate     *time.Time       `json:"statement_date,omitempty"`
+	BookAtStatement   *decimal.Decimal `json:"book_balance_at_statement,omitempty"`
+	Difference        *decimal.Decimal `json:"difference,omitempty"`
+}
+
+// Statement is an imported statement file. Continuity records how its opening
+// balance compared with the balance implied by the previous statement.
+type Statement struct {
+	ID                     uuid.UUID        `json:"id"`
+	TenantID               uuid.UUID        `json:"tenant_id"`
+	BankAccountID          uuid.UUID        `json:"bank_account_id"`
+	Format                 string           `json:"format"`
+	Profile                string           `json:"profile,omitempty"`
+	FileName               string           `json:"file_name,omitempty"`
+	StatementReference     string           `json:"statement_reference,omitempty"`
+	Currency               string           `json:"currency"`
+	PeriodStart            time.Time        `json:"period_start"`
+	PeriodEnd              time.Time        `json:"period_end"`
+	OpeningBalance         *decimal.Decimal `json:"opening_balance,omitempty"`
+	ClosingBalance         *decimal.Decimal `json:"closing_balance,omitempty"`
+	Continuity             string           `json:"continuity"`
+	ExpectedOpeningBalance *decimal.Decimal `json:"expected_opening_balance,omitempty"`
+	TransactionCount       int              `json:"transaction_count"`
+	DuplicateCount         int              `json:"duplicate_count"`
+	LinkedCount            int              `json:"linked_count"`
+	ImportedBy             *uuid.UUID       `json:"imported_by,omitempty"`
+	CreatedAt              time.Time        `json:"created_at"`
+}
+
+// Transaction is a transaction on a bank account, as imported from a
+// statement. Amount is positive for money in and negative for money out.
+type Transaction struct {
+	ID                   uuid.UUID        `json:"id"`
+	TenantID             uuid.UUID        `json:"tenant_id"`
+	BankAccountID        uuid.UUID        `json:"bank_account_id"`
+	StatementID          uuid.UUID        `json:"statement_id"`
+	TransactionDate      time.Time        `json:"transaction_date"`
+	ValueDate            *time.Time       `json:"value_date,omitempty"`
+	Amount               decimal.Decimal  `json:"amount"`
+	Currency             string           `json:"currency"`
+	TransactionType      string           `json:"transaction_type"`
+	Reference            string           `json:"reference,omitempty"`
+	Description          string           `json:"description,omitempty"`
+	Counterparty         string           `json:"counterparty,omitempty"`
+	RunningBalance       *decimal.Decimal `json:"running_balance,omitempty"`
+	PaymentTransactionID *uuid.UUID       `json:"payment_transaction_id,omitempty"`
+	ReconciliationStatus string           `json:"reconciliation_status"`
+	Metadata             map[string]any   `json:"metadata,omitempty"`
+	CreatedAt            time.Time        `json:"created_at"`
+}
+
+// ImportRequest describes a statement file being imported. Profile names the
+// CSV column mapping and is required for CSV files. AllowGap accepts a
+// statement whose opening balance does not follow on from the previous one.
+type ImportRequest struct {
+	Format   string
+	Profile  string
+	FileName string
+	AllowGap bool
+}
+
+// StatementImport is a parsed statement ready to be stored. Hashes holds the
+// duplicate-detection hash of each parsed transaction.
+type StatementImport struct {
+	BankAccountID uuid.UUID
+	Format        string
+	Profile       string
+	FileName      string
+	FileHash      string
+	Parsed        *bankfile.Statement
+	Hashes        []string
+	AllowGap      bool
+	ImportedBy    *uuid.UUID
+}
+
+// Profile maps a bank's CSV statement export onto statement lines. Columns
+// are named by their header text; DateFormat is a Go reference layout such
+// as 02/01/2006.
+type Profile struct {
+	ID                 uuid.UUID  `json:"id"`
+	TenantID           uuid.UUID  `json:"tenant_id"`
+	Name               string     `json:"name"`
+	Delimiter          string     `json:"delimiter"`
+	SkipRows           int        `json:"skip_rows"`
+	DateColumn         string     `json:"date_column"`
+	DateFormat         string     `json:"date_format"`
+	ValueDateColumn    string     `json:"value_date_column,omitempty"`
+	DescriptionColumn  string     `json:"description_column,omitempty"`
+	ReferenceColumn    string     `json:"reference_column,omitempty"`
+	CounterpartyColumn string     `json:"counterparty_column,omitempty"`
+	AmountColumn       string     `json:"amount_column,omitempty"`
+	DebitColumn        string     `json:"debit_column,omitempty"`
+	CreditColumn       string     `json:"credit_column,omitempty"`
+	BalanceColumn      string     `json:"balance_column,omitempty"`
+	UpdatedBy          *uuid.UUID `json:"updated_by,omitempty"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+}
+
+// SaveProfileRequest creates or replaces a CSV column mapping profile.
+type SaveProfileRequest struct {
+	Delimiter          string `json:"delimiter"`
+	SkipRows           int    `json:"skip_rows"`
+	DateColumn         string `json:"date_column"`
+	DateFormat         string `json:"date_format"`
+	ValueDateColumn    string `json:"value_date_column"`
+	DescriptionColumn  string `json:"description_column"`
+	ReferenceColumn    string `json:"reference_column"`
+	CounterpartyColumn string `json:"counterparty_column"`
+	AmountColumn       string `json:"amount_column"`
+	DebitColumn        string `json:"debit_column"`
+	CreditColumn       string `json:"credit_column"`
+	BalanceColumn      string `json:"balance_column"`
+}
+
+// mapping returns the parser's view of the profile.
+func (p *Profile) mapping() bankfile.Profile {
+	return bankfile.Profile{
+		Delimiter:          p.Delimiter,
+		SkipRows:           p.SkipRows,
+		DateColumn:         p.DateColumn,
+		DateFormat:         p.DateFormat,
+		ValueDateColumn:    p.ValueDateColumn,
+		DescriptionColumn:  p.DescriptionColumn,
+		ReferenceColumn:    p.ReferenceColumn,
+		CounterpartyColumn: p.CounterpartyColumn,
+		AmountColumn:       p.AmountColumn,
+		DebitColumn:        p.DebitColumn,
+		CreditColumn:       p.CreditColumn,
+		BalanceColumn:      p.BalanceColumn,
+	}
+}
+
+// TransactionFilter narrows bank transaction listings by transaction date,
+// From inclusive and To exclusive.
+type TransactionFilter struct {
+	BankAccountID        *uuid.UUID
+	StatementID          *uuid.UUID
+	ReconciliationStatus string
+	From                 *time.Time
+	To                   *time.Time
+	Limit                int
+	Offset               int
+}
@@ -0,0 +1,41 @@
+package banking
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// visibleDigits is how many trailing characters of an account number stay
+// readable once masked.
+const visibleDigits = 4
+
+// NormalizeAccountNumber strips the spaces, dashes and slashes banks print
+// account numbers with and upper-cases any letters.
+func NormalizeAccountNumber(number string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(number) {
+		switch r {
+		case ' ', '-', '/', '.':
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// MaskAccountNumber hides all but the last four characters of a normalised
+// account number. Numbers of four characters or fewer are masked entirely.
+func MaskAccountNumber(number string) string {
+	if len(number) <= visibleDigits {
+		return strings.Repeat("*", len(number))
+	}
+	return strings.Repeat("*", len(number)-visibleDigits) + number[len(number)-visibleDigits:]
+}
+
+// FingerprintAccountNumber returns the SHA-256 hex digest of a normalised
+// account number, used to find the account again without storing it.
+func FingerprintAccountNumber(number string) string {
+	sum := sha256.Sum256([]byte(number))
+	return hex.EncodeToString(sum[:])
+}
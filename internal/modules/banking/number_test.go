@@ -0,0 +1,47 @@
+package banking
+
+import "testing"
+
+func TestNormalizeAccountNumber(t *testing.T) {
+	tests := map[string]string{
+		"1234 5678 90":     "1234567890",
+		"01-108-123456-00": "0110812345600",
+		"ke12/abcd.0001":   "KE12ABCD0001",
+		" 522522 ":         "522522",
+		"":                 "",
+	}
+	for input, want := range tests {
+		if got := NormalizeAccountNumber(input); got != want {
+			t.Errorf("NormalizeAccountNumber(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestMaskAccountNumber(t *testing.T) {
+	tests := map[string]string{
+		"1234567890": "******7890",
+		"522522":     "**2522",
+		"12345":      "*2345",
+		"1234":       "****",
+		"":           "",
+	}
+	for input, want := range tests {
+		if got := MaskAccountNumber(input); got != want {
+			t.Errorf("MaskAccountNumber(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestFingerprintAccountNumber(t *testing.T) {
+	a := FingerprintAccountNumber(NormalizeAccountNumber("0110 8123 4560 0"))
+	b := FingerprintAccountNumber(NormalizeAccountNumber("01-108-123456-00"))
+	if a != b {
+		t.Fatalf("fingerprints of the same number differ: %s != %s", a, b)
+	}
+	if len(a) != 64 {
+		t.Fatalf("fingerprint length = %d, want 64", len(a))
+	}
+	if a == FingerprintAccountNumber("0110812345601") {
+		t.Fatal("fingerprints of different numbers match")
+	}
+}
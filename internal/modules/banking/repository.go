@@ -0,0 +1,62 @@
+package banking
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrBankAccountNotFound is returned when a bank account does not exist.
+	ErrBankAccountNotFound = errors.New("bank account not found")
+	// ErrDuplicateBankAccount is returned when the account number or the
+	// ledger cash account is already registered to another bank account.
+	ErrDuplicateBankAccount = errors.New("bank account already exists")
+	// ErrInvalidBankAccount is returned when bank account details are malformed.
+	ErrInvalidBankAccount = errors.New("invalid bank account")
+	// ErrBankAccountState is returned when the account cannot be changed in
+	// its current state, such as closing an account that still holds money.
+	ErrBankAccountState = errors.New("bank account cannot be changed in its current state")
+	// ErrProfileNotFound is returned when a CSV statement profile does not exist.
+	ErrProfileNotFound = errors.New("statement profile not found")
+	// ErrInvalidProfile is returned when a CSV statement profile is malformed.
+	ErrInvalidProfile = errors.New("invalid statement profile")
+	// ErrStatementNotFound is returned when a bank statement does not exist.
+	ErrStatementNotFound = errors.New("bank statement not found")
+	// ErrInvalidStatement is returned when a statement file cannot be read,
+	// is for another account or its balances do not add up.
+	ErrInvalidStatement = errors.New("invalid bank statement")
+	// ErrDuplicateStatement is returned when the same file was already imported.
+	ErrDuplicateStatement = errors.New("bank statement already imported")
+	// ErrBalanceContinuity is returned when a statement's opening balance does
+	// not follow on from the previous statement's closing balance.
+	ErrBalanceContinuity = errors.New("statement opening balance does not follow the previous statement")
+)
+
+// Repository abstracts persistence for bank accounts.
+type Repository interface {
+	Create(ctx context.Context, tenantID uuid.UUID, req CreateRequest, createdBy *uuid.UUID) (*BankAccount, error)
+	Get(ctx context.Context, tenantID uuid.UUID, bankAccountID uuid.UUID) (*BankAccount, error)
+	List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*BankAccount, error)
+	Update(ctx context.Context, tenantID uuid.UUID, bankAccountID uuid.UUID, req UpdateRequest) (*BankAccount, error)
+	Close(ctx context.Context, tenantID uuid.UUID, bankAccountID uuid.UUID) (*BankAccount, error)
+	// BookBalance sums the ledger postings to the cash account dated before
+	// the given time.
+	BookBalance(ctx context.Context, tenantID uuid.UUID, ledgerAccountCode string, before time.Time) (decimal.Decimal, error)
+
+	SaveProfile(ctx context.Context, tenantID uuid.UUID, name string, req SaveProfileRequest, updatedBy *uuid.UUID) (*Profile, error)
+	GetProfile(ctx context.Context, tenantID uuid.UUID, name string) (*Profile, error)
+	ListProfiles(ctx context.Context, tenantID uuid.UUID) ([]*Profile, error)
+
+	// SaveStatement checks the statement's continuity with the previous one,
+	// stores it with the transactions not already imported and moves the
+	// account's statement balance forward. M-Pesa lines are linked to the
+	// payment transactions recorded with their receipt number.
+	SaveStatement(ctx context.Context, tenantID uuid.UUID, imp StatementImport) (*Statement, error)
+	GetStatement(ctx context.Context, tenantID uuid.UUID, statementID uuid.UUID) (*Statement, error)
+	ListStatements(ctx context.Context, tenantID uuid.UUID, bankAccountID uuid.UUID, limit, offset int) ([]*Statement, error)
+	ListTransactions(ctx context.Context, tenantID uuid.UUID, filter TransactionFilter) ([]*Transaction, error)
+}
@@ -0,0 +1,879 @@
+package banking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/bankaccount"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatement"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatementprofile"
+	"github.com/bengobox/treasury-api/internal/ent/banktransaction"
+	"github.com/bengobox/treasury-api/internal/ent/chartofaccount"
+	"github.com/bengobox/treasury-api/internal/ent/ledgertransaction"
+	"github.com/bengobox/treasury-api/internal/ent/paymenttransaction"
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+	"github.com/bengobox/treasury-api/internal/modules/outbox"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+)
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// Create stores a new bank account, keeping only the masked account number
+// and its fingerprint.
+func (r *EntRepository) Create(ctx context.Context, tenantID uuid.UUID, req CreateRequest, createdBy *uuid.UUID) (*BankAccount, error) {
+	var created *ent.BankAccount
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		fingerprint := FingerprintAccountNumber(req.AccountNumber)
+		if err := checkNumberUnique(ctx, tx, tenantID, uuid.Nil, fingerprint); err != nil {
+			return err
+		}
+		if err := checkLedgerAccount(ctx, tx, tenantID, uuid.Nil, req.LedgerAccountCode); err != nil {
+			return err
+		}
+
+		builder := tx.BankAccount.Create().
+			SetTenantID(tenantID).
+			SetAccountName(req.AccountName).
+			SetBankName(req.BankName).
+			SetBranch(req.Branch).
+			SetAccountType(req.AccountType).
+			SetAccountNumberMasked(MaskAccountNumber(req.AccountNumber)).
+			SetAccountNumberHash(fingerprint).
+			SetCurrency(req.Currency).
+			SetLedgerAccountCode(req.LedgerAccountCode).
+			SetNillableCreatedBy(createdBy)
+		if req.Metadata != nil {
+			builder.SetMetadata(req.Metadata)
+		}
+
+		var err error
+		created, err = builder.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create bank account: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntBankAccount(created), nil
+}
+
+// Get retrieves a bank account.
+func (r *EntRepository) Get(ctx context.Context, tenantID uuid.UUID, bankAccountID uuid.UUID) (*BankAccount, error) {
+	entAccount, err := r.client.BankAccount.Query().
+		Where(
+			bankaccount.ID(bankAccountID),
+			bankaccount.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrBankAccountNotFound
+		}
+		return nil, fmt.Errorf("get bank account: %w", err)
+	}
+
+	return mapEntBankAccount(entAccount), nil
+}
+
+// List returns the tenant's bank accounts ordered by name.
+func (r *EntRepository) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*BankAccount, error) {
+	query := r.client.BankAccount.Query().
+		Where(bankaccount.TenantID(tenantID))
+	if filter.AccountType != "" {
+		query = query.Where(bankaccount.AccountType(filter.AccountType))
+	}
+	if filter.Status != "" {
+		query = query.Where(bankaccount.Status(filter.Status))
+	}
+	if filter.Currency != "" {
+		query = query.Where(bankaccount.Currency(filter.Currency))
+	}
+
+	entAccounts, err := query.
+		Order(ent.Asc(bankaccount.FieldAccountName), ent.Asc(bankaccount.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list bank accounts: %w", err)
+	}
+
+	accounts := make([]*BankAccount, len(entAccounts))
+	for i, entAccount := range entAccounts {
+		accounts[i] = mapEntBankAccount(entAccount)
+	}
+	return accounts, nil
+}
+
+// Update applies the provided fields to a bank account that is not closed.
+func (r *EntRepository) Update(ctx context.Context, tenantID uuid.UUID, bankAccountID uuid.UUID, req UpdateRequest) (*BankAccount, error) {
+	var updated *ent.BankAccount
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		current, err := lockBankAccount(ctx, tx, tenantID, bankAccountID)
+		if err != nil {
+			return err
+		}
+		if current.Status == StatusClosed {
+			return fmt.Errorf("%w: account is closed", ErrBankAccountState)
+		}
+
+		update := current.Update().
+			SetNillableAccountName(req.AccountName).
+			SetNillableBankName(req.BankName).
+			SetNillableBranch(req.Branch).
+			SetNillableStatus(req.Status)
+		if req.AccountNumber != nil {
+			fingerprint := FingerprintAccountNumber(*req.AccountNumber)
+			if err := checkNumberUnique(ctx, tx, tenantID, bankAccountID, fingerprint); err != nil {
+				return err
+			}
+			update.SetAccountNumberMasked(MaskAccountNumber(*req.AccountNumber)).
+				SetAccountNumberHash(fingerprint)
+		}
+		if req.LedgerAccountCode != nil && *req.LedgerAccountCode != current.LedgerAccountCode {
+			if err := checkLedgerAccount(ctx, tx, tenantID, bankAccountID, *req.LedgerAccountCode); err != nil {
+				return err
+			}
+			update.SetLedgerAccountCode(*req.LedgerAccountCode)
+		}
+		if req.Metadata != nil {
+			update.SetMetadata(req.Metadata)
+		}
+
+		updated, err = update.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("update bank account: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntBankAccount(updated), nil
+}
+
+// Close marks a bank account closed once its cash account holds nothing.
+func (r *EntRepository) Close(ctx context.Context, tenantID uuid.UUID, bankAccountID uuid.UUID) (*BankAccount, error) {
+	var closed *ent.BankAccount
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		current, err := lockBankAccount(ctx, tx, tenantID, bankAccountID)
+		if err != nil {
+			return err
+		}
+		if current.Status == StatusClosed {
+			return fmt.Errorf("%w: account is already closed", ErrBankAccountState)
+		}
+
+		balance, err := bookBalance(ctx, tx.Client(), tenantID, current.LedgerAccountCode, time.Now())
+		if err != nil {
+			return err
+		}
+		if !balance.IsZero() {
+			return fmt.Errorf("%w: book balance is %s %s", ErrBankAccountState, current.Currency, balance.StringFixed(2))
+		}
+
+		closed, err = current.Update().
+			SetStatus(StatusClosed).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("close bank account: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntBankAccount(closed), nil
+}
+
+// BookBalance sums the ledger postings to the cash account dated before the
+// given time.
+func (r *EntRepository) BookBalance(ctx context.Context, tenantID uuid.UUID, ledgerAccountCode string, before time.Time) (decimal.Decimal, error) {
+	return bookBalance(ctx, r.client, tenantID, ledgerAccountCode, before)
+}
+
+func bookBalance(ctx context.Context, client *ent.Client, tenantID uuid.UUID, code string, before time.Time) (decimal.Decimal, error) {
+	lines, err := client.LedgerTransaction.Query().
+		Where(
+			ledgertransaction.TenantID(tenantID),
+			ledgertransaction.HasAccountWith(
+				chartofaccount.TenantID(tenantID),
+				chartofaccount.AccountCode(code),
+			),
+			ledgertransaction.TransactionDateLT(before),
+		).
+		Select(ledgertransaction.FieldDebitAmount, ledgertransaction.FieldCreditAmount).
+		All(ctx)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("load cash account postings: %w", err)
+	}
+
+	balance := decimal.Zero
+	for _, line := range lines {
+		balance = balance.Add(line.DebitAmount).Sub(line.CreditAmount)
+	}
+	return balance, nil
+}
+
+func lockBankAccount(ctx context.Context, tx *ent.Tx, tenantID, bankAccountID uuid.UUID) (*ent.BankAccount, error) {
+	entAccount, err := tx.BankAccount.Query().
+		Where(
+			bankaccount.ID(bankAccountID),
+			bankaccount.TenantID(tenantID),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrBankAccountNotFound
+		}
+		return nil, fmt.Errorf("lock bank account: %w", err)
+	}
+	return entAccount, nil
+}
+
+// checkNumberUnique rejects an account number already registered by the tenant.
+func checkNumberUnique(ctx context.Context, tx *ent.Tx, tenantID, bankAccountID uuid.UUID, fingerprint string) error {
+	existing, err := tx.BankAccount.Query().
+		Where(
+			bankaccount.TenantID(tenantID),
+			bankaccount.IDNEQ(bankAccountID),
+			bankaccount.AccountNumberHash(fingerprint),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("check bank account uniqueness: %w", err)
+	}
+
+	return fmt.Errorf("%w: %s has the same account number", ErrDuplicateBankAccount, existing.AccountName)
+}
+
+// checkLedgerAccount verifies the cash account is an active asset account in
+// the tenant's chart (system accounts count as present as they are
+// provisioned on first posting) and is not booked to by another open bank
+// account, so each account's book balance is its own.
+func checkLedgerAccount(ctx context.Context, tx *ent.Tx, tenantID, bankAccountID uuid.UUID, code string) error {
+	entAccount, err := tx.ChartOfAccount.Query().
+		Where(
+			chartofaccount.TenantID(tenantID),
+			chartofaccount.AccountCode(code),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("load cash account: %w", err)
+	}
+
+	var accountType string
+	switch {
+	case entAccount != nil && !entAccount.IsActive:
+		return fmt.Errorf("%w: account %s is inactive", ErrInvalidBankAccount, code)
+	case entAccount != nil:
+		accountType = entAccount.AccountType
+	default:
+		var ok bool
+		if accountType, ok = ledger.SystemAccountType(code); !ok {
+			return fmt.Errorf("%w: account %s is not in the chart of accounts", ErrInvalidBankAccount, code)
+		}
+	}
+	if accountType != "asset" {
+		return fmt.Errorf("%w: account %s is not an asset account", ErrInvalidBankAccount, code)
+	}
+
+	existing, err := tx.BankAccount.Query().
+		Where(
+			bankaccount.TenantID(tenantID),
+			bankaccount.IDNEQ(bankAccountID),
+			bankaccount.LedgerAccountCode(code),
+			bankaccount.StatusNEQ(StatusClosed),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("check cash account usage: %w", err)
+	}
+
+	return fmt.Errorf("%w: account %s is already linked to %s", ErrDuplicateBankAccount, code, existing.AccountName)
+}
+
+func mapEntBankAccount(entAccount *ent.BankAccount) *BankAccount {
+	a := &BankAccount{
+		ID:                  entAccount.ID,
+		TenantID:            entAccount.TenantID,
+		AccountName:         entAccount.AccountName,
+		BankName:            entAccount.BankName,
+		Branch:              entAccount.Branch,
+		AccountType:         entAccount.AccountType,
+		AccountNumberMasked: entAccount.AccountNumberMasked,
+		AccountNumberHash:   entAccount.AccountNumberHash,
+		Currency:            entAccount.Currency,
+		LedgerAccountCode:   entAccount.LedgerAccountCode,
+		StatementBalance:    entAccount.StatementBalance,
+		StatementDate:       entAccount.StatementDate,
+		Status:              entAccount.Status,
+		Metadata:            entAccount.Metadata,
+		CreatedAt:           entAccount.CreatedAt,
+		UpdatedAt:           entAccount.UpdatedAt,
+	}
+
+	// Optional fields - check for zero values
+	if entAccount.CreatedBy != uuid.Nil {
+		createdBy := entAccount.CreatedBy
+		a.CreatedBy = &createdBy
+	}
+
+	return a
+}
+
+// SaveProfile creates the named CSV profile or replaces its mapping.
+func (r *EntRepository) SaveProfile(ctx context.Context, tenantID uuid.UUID, name string, req SaveProfileRequest, updatedBy *uuid.UUID) (*Profile, error) {
+	var profileID uuid.UUID
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		existing, err := tx.BankStatementProfile.Query().
+			Where(
+				bankstatementprofile.TenantID(tenantID),
+				bankstatementprofile.Name(name),
+			).
+			ForUpdate().
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("lock statement profile: %w", err)
+		}
+
+		if existing == nil {
+			created, err := tx.BankStatementProfile.Create().
+				SetTenantID(tenantID).
+				SetName(name).
+				SetDelimiter(req.Delimiter).
+				SetSkipRows(req.SkipRows).
+				SetDateColumn(req.DateColumn).
+				SetDateFormat(req.DateFormat).
+				SetValueDateColumn(req.ValueDateColumn).
+				SetDescriptionColumn(req.DescriptionColumn).
+				SetReferenceColumn(req.ReferenceColumn).
+				SetCounterpartyColumn(req.CounterpartyColumn).
+				SetAmountColumn(req.AmountColumn).
+				SetDebitColumn(req.DebitColumn).
+				SetCreditColumn(req.CreditColumn).
+				SetBalanceColumn(req.BalanceColumn).
+				SetNillableUpdatedBy(updatedBy).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("create statement profile: %w", err)
+			}
+			profileID = created.ID
+			return nil
+		}
+
+		err = tx.BankStatementProfile.UpdateOneID(existing.ID).
+			SetDelimiter(req.Delimiter).
+			SetSkipRows(req.SkipRows).
+			SetDateColumn(req.DateColumn).
+			SetDateFormat(req.DateFormat).
+			SetValueDateColumn(req.ValueDateColumn).
+			SetDescriptionColumn(req.DescriptionColumn).
+			SetReferenceColumn(req.ReferenceColumn).
+			SetCounterpartyColumn(req.CounterpartyColumn).
+			SetAmountColumn(req.AmountColumn).
+			SetDebitColumn(req.DebitColumn).
+			SetCreditColumn(req.CreditColumn).
+			SetBalanceColumn(req.BalanceColumn).
+			SetNillableUpdatedBy(updatedBy).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("update statement profile: %w", err)
+		}
+		profileID = existing.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entProfile, err := r.client.BankStatementProfile.Get(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("get statement profile: %w", err)
+	}
+	return mapEntProfile(entProfile), nil
+}
+
+// GetProfile retrieves a CSV profile by name.
+func (r *EntRepository) GetProfile(ctx context.Context, tenantID uuid.UUID, name string) (*Profile, error) {
+	entProfile, err := r.client.BankStatementProfile.Query().
+		Where(
+			bankstatementprofile.TenantID(tenantID),
+			bankstatementprofile.Name(name),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrProfileNotFound
+		}
+		return nil, fmt.Errorf("get statement profile: %w", err)
+	}
+
+	return mapEntProfile(entProfile), nil
+}
+
+// ListProfiles returns the tenant's CSV profiles ordered by name.
+func (r *EntRepository) ListProfiles(ctx context.Context, tenantID uuid.UUID) ([]*Profile, error) {
+	entProfiles, err := r.client.BankStatementProfile.Query().
+		Where(bankstatementprofile.TenantID(tenantID)).
+		Order(ent.Asc(bankstatementprofile.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list statement profiles: %w", err)
+	}
+
+	profiles := make([]*Profile, len(entProfiles))
+	for i, entProfile := range entProfiles {
+		profiles[i] = mapEntProfile(entProfile)
+	}
+	return profiles, nil
+}
+
+// statementBatchSize bounds the rows written or looked up per query so large
+// statements stay within the database's parameter limit.
+const statementBatchSize = 1000
+
+// SaveStatement stores an imported statement. Imports for an account are
+// serialised on the account row so continuity and duplicate checks see every
+// earlier import.
+func (r *EntRepository) SaveStatement(ctx context.Context, tenantID uuid.UUID, imp StatementImport) (*Statement, error) {
+	var saved *ent.BankStatement
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entAccount, err := lockBankAccount(ctx, tx, tenantID, imp.BankAccountID)
+		if err != nil {
+			return err
+		}
+
+		imported, err := tx.BankStatement.Query().
+			Where(
+				bankstatement.BankAccountID(entAccount.ID),
+				bankstatement.FileHash(imp.FileHash),
+			).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("check statement file: %w", err)
+		}
+		if imported != nil {
+			return fmt.Errorf("%w: the same file was imported on %s", ErrDuplicateStatement, imported.CreatedAt.Format(time.DateOnly))
+		}
+
+		parsed := imp.Parsed
+		expected, err := expectedOpening(ctx, tx, entAccount.ID, parsed.PeriodStart)
+		if err != nil {
+			return err
+		}
+		outcome := continuity(parsed.OpeningBalance, expected)
+		if outcome == ContinuityGap && !imp.AllowGap {
+			return fmt.Errorf("%w: opening balance is %s but the previous statement implies %s",
+				ErrBalanceContinuity, parsed.OpeningBalance.StringFixed(2), expected.StringFixed(2))
+		}
+
+		existing, err := existingHashes(ctx, tx, entAccount.ID, imp.Hashes)
+		if err != nil {
+			return err
+		}
+		duplicates := 0
+		fresh := make([]int, 0, len(parsed.Transactions))
+		for i := range parsed.Transactions {
+			if existing[imp.Hashes[i]] {
+				duplicates++
+				continue
+			}
+			fresh = append(fresh, i)
+		}
+
+		var links map[string]uuid.UUID
+		if imp.Format == FormatMpesa {
+			receipts := make([]string, 0, len(fresh))
+			for _, i := range fresh {
+				receipts = append(receipts, parsed.Transactions[i].Reference)
+			}
+			links, err = paymentLinks(ctx, tx, tenantID, receipts)
+			if err != nil {
+				return err
+			}
+		}
+		linked := 0
+		for _, i := range fresh {
+			if _, ok := links[parsed.Transactions[i].Reference]; ok {
+				linked++
+			}
+		}
+
+		saved, err = tx.BankStatement.Create().
+			SetTenantID(tenantID).
+			SetBankAccountID(entAccount.ID).
+			SetFormat(imp.Format).
+			SetProfile(imp.Profile).
+			SetFileName(imp.FileName).
+			SetFileHash(imp.FileHash).
+			SetStatementReference(parsed.Reference).
+			SetCurrency(entAccount.Currency).
+			SetPeriodStart(parsed.PeriodStart).
+			SetPeriodEnd(parsed.PeriodEnd).
+			SetNillableOpeningBalance(parsed.OpeningBalance).
+			SetNillableClosingBalance(parsed.ClosingBalance).
+			SetContinuity(outcome).
+			SetNillableExpectedOpeningBalance(expected).
+			SetTransactionCount(len(fresh)).
+			SetDuplicateCount(duplicates).
+			SetLinkedCount(linked).
+			SetNillableImportedBy(imp.ImportedBy).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create bank statement: %w", err)
+		}
+
+		for start := 0; start < len(fresh); start += statementBatchSize {
+			end := min(start+statementBatchSize, len(fresh))
+			builders := make([]*ent.BankTransactionCreate, 0, end-start)
+			for _, i := range fresh[start:end] {
+				txn := parsed.Transactions[i]
+				transactionType := "credit"
+				if txn.Amount.IsNegative() {
+					transactionType = "debit"
+				}
+				builder := tx.BankTransaction.Create().
+					SetTenantID(tenantID).
+					SetBankAccountID(entAccount.ID).
+					SetStatementID(saved.ID).
+					SetTransactionDate(txn.Date).
+					SetNillableValueDate(txn.ValueDate).
+					SetAmount(txn.Amount).
+					SetCurrency(entAccount.Currency).
+					SetTransactionType(transactionType).
+					SetReference(txn.Reference).
+					SetDescription(txn.Description).
+					SetCounterparty(txn.Counterparty).
+					SetNillableRunningBalance(txn.Balance).
+					SetDedupeHash(imp.Hashes[i])
+				if paymentID, ok := links[txn.Reference]; ok {
+					builder.SetPaymentTransactionID(paymentID)
+				}
+				if txn.Metadata != nil {
+					builder.SetMetadata(txn.Metadata)
+				}
+				builders = append(builders, builder)
+			}
+			if _, err := tx.BankTransaction.CreateBulk(builders...).Save(ctx); err != nil {
+				return fmt.Errorf("create bank transactions: %w", err)
+			}
+		}
+
+		// The account shows the latest statement balance; importing an older
+		// statement to fill a gap leaves it alone.
+		if parsed.ClosingBalance != nil && (entAccount.StatementDate == nil || !parsed.PeriodEnd.Before(*entAccount.StatementDate)) {
+			err := entAccount.Update().
+				SetStatementBalance(*parsed.ClosingBalance).
+				SetStatementDate(parsed.PeriodEnd).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("update statement balance: %w", err)
+			}
+		}
+
+		payload := map[string]any{
+			"statement_id":      saved.ID.String(),
+			"bank_account_id":   entAccount.ID.String(),
+			"format":            saved.Format,
+			"currency":          saved.Currency,
+			"period_start":      saved.PeriodStart.Format(time.DateOnly),
+			"period_end":        saved.PeriodEnd.Format(time.DateOnly),
+			"continuity":        saved.Continuity,
+			"transaction_count": saved.TransactionCount,
+			"duplicate_count":   saved.DuplicateCount,
+			"linked_count":      saved.LinkedCount,
+		}
+		if saved.OpeningBalance != nil {
+			payload["opening_balance"] = saved.OpeningBalance.String()
+		}
+		if saved.ClosingBalance != nil {
+			payload["closing_balance"] = saved.ClosingBalance.String()
+		}
+		return outbox.Enqueue(ctx, tx, outbox.Event{
+			TenantID:      tenantID,
+			AggregateType: "bank_statement",
+			AggregateID:   saved.ID,
+			EventType:     EventStatementImported,
+			Payload:       payload,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntStatement(saved), nil
+}
+
+// expectedOpening derives the opening balance a statement starting on the
+// given day should have from the latest earlier statement with a closing
+// balance: its closing balance less any already-imported movement from the
+// start day to the end of that statement, which overlapping files share.
+func expectedOpening(ctx context.Context, tx *ent.Tx, bankAccountID uuid.UUID, periodStart time.Time) (*decimal.Decimal, error) {
+	previous, err := tx.BankStatement.Query().
+		Where(
+			bankstatement.BankAccountID(bankAccountID),
+			bankstatement.PeriodStartLTE(periodStart),
+			bankstatement.ClosingBalanceNotNil(),
+		).
+		Order(ent.Desc(bankstatement.FieldPeriodEnd), ent.Desc(bankstatement.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("load previous statement: %w", err)
+	}
+
+	overlap, err := tx.BankTransaction.Query().
+		Where(
+			banktransaction.BankAccountID(bankAccountID),
+			banktransaction.TransactionDateGTE(periodStart),
+			banktransaction.TransactionDateLTE(previous.PeriodEnd),
+		).
+		Select(banktransaction.FieldAmount).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load overlapping transactions: %w", err)
+	}
+
+	expected := *previous.ClosingBalance
+	for _, txn := range overlap {
+		expected = expected.Sub(txn.Amount)
+	}
+	return &expected, nil
+}
+
+// paymentLinks maps M-Pesa receipt numbers to the payment transactions
+// recorded with them as provider reference.
+func paymentLinks(ctx context.Context, tx *ent.Tx, tenantID uuid.UUID, receipts []string) (map[string]uuid.UUID, error) {
+	links := make(map[string]uuid.UUID)
+	for start := 0; start < len(receipts); start += statementBatchSize {
+		end := min(start+statementBatchSize, len(receipts))
+		payments, err := tx.PaymentTransaction.Query().
+			Where(
+				paymenttransaction.TenantID(tenantID),
+				paymenttransaction.Provider(FormatMpesa),
+				paymenttransaction.ProviderReferenceIn(receipts[start:end]...),
+			).
+			Select(paymenttransaction.FieldID, paymenttransaction.FieldProviderReference).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("link payment transactions: %w", err)
+		}
+		for _, payment := range payments {
+			links[payment.ProviderReference] = payment.ID
+		}
+	}
+	return links, nil
+}
+
+// existingHashes returns which of the hashes are already imported for the
+// account.
+func existingHashes(ctx context.Context, tx *ent.Tx, bankAccountID uuid.UUID, hashes []string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	for start := 0; start < len(hashes); start += statementBatchSize {
+		end := min(start+statementBatchSize, len(hashes))
+		found, err := tx.BankTransaction.Query().
+			Where(
+				banktransaction.BankAccountID(bankAccountID),
+				banktransaction.DedupeHashIn(hashes[start:end]...),
+			).
+			Select(banktransaction.FieldDedupeHash).
+			Strings(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("check duplicate transactions: %w", err)
+		}
+		for _, hash := range found {
+			existing[hash] = true
+		}
+	}
+	return existing, nil
+}
+
+// GetStatement retrieves an imported statement.
+func (r *EntRepository) GetStatement(ctx context.Context, tenantID uuid.UUID, statementID uuid.UUID) (*Statement, error) {
+	entStatement, err := r.client.BankStatement.Query().
+		Where(
+			bankstatement.ID(statementID),
+			bankstatement.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrStatementNotFound
+		}
+		return nil, fmt.Errorf("get bank statement: %w", err)
+	}
+
+	return mapEntStatement(entStatement), nil
+}
+
+// ListStatements returns a bank account's statements, latest period first.
+func (r *EntRepository) ListStatements(ctx context.Context, tenantID uuid.UUID, bankAccountID uuid.UUID, limit, offset int) ([]*Statement, error) {
+	entStatements, err := r.client.BankStatement.Query().
+		Where(
+			bankstatement.TenantID(tenantID),
+			bankstatement.BankAccountID(bankAccountID),
+		).
+		Order(ent.Desc(bankstatement.FieldPeriodEnd), ent.Desc(bankstatement.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list bank statements: %w", err)
+	}
+
+	statements := make([]*Statement, len(entStatements))
+	for i, entStatement := range entStatements {
+		statements[i] = mapEntStatement(entStatement)
+	}
+	return statements, nil
+}
+
+// ListTransactions returns bank transactions in date order.
+func (r *EntRepository) ListTransactions(ctx context.Context, tenantID uuid.UUID, filter TransactionFilter) ([]*Transaction, error) {
+	query := r.client.BankTransaction.Query().
+		Where(banktransaction.TenantID(tenantID))
+	if filter.BankAccountID != nil {
+		query = query.Where(banktransaction.BankAccountID(*filter.BankAccountID))
+	}
+	if filter.StatementID != nil {
+		query = query.Where(banktransaction.StatementID(*filter.StatementID))
+	}
+	if filter.ReconciliationStatus != "" {
+		query = query.Where(banktransaction.ReconciliationStatus(filter.ReconciliationStatus))
+	}
+	if filter.From != nil {
+		query = query.Where(banktransaction.TransactionDateGTE(*filter.From))
+	}
+	if filter.To != nil {
+		query = query.Where(banktransaction.TransactionDateLT(*filter.To))
+	}
+
+	entTxns, err := query.
+		Order(ent.Asc(banktransaction.FieldTransactionDate), ent.Asc(banktransaction.FieldCreatedAt)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list bank transactions: %w", err)
+	}
+
+	txns := make([]*Transaction, len(entTxns))
+	for i, entTxn := range entTxns {
+		txns[i] = mapEntTransaction(entTxn)
+	}
+	return txns, nil
+}
+
+func mapEntProfile(entProfile *ent.BankStatementProfile) *Profile {
+	p := &Profile{
+		ID:                 entProfile.ID,
+		TenantID:           entProfile.TenantID,
+		Name:               entProfile.Name,
+		Delimiter:          entProfile.Delimiter,
+		SkipRows:           entProfile.SkipRows,
+		DateColumn:         entProfile.DateColumn,
+		DateFormat:         entProfile.DateFormat,
+		ValueDateColumn:    entProfile.ValueDateColumn,
+		DescriptionColumn:  entProfile.DescriptionColumn,
+		ReferenceColumn:    entProfile.ReferenceColumn,
+		CounterpartyColumn: entProfile.CounterpartyColumn,
+		AmountColumn:       entProfile.AmountColumn,
+		DebitColumn:        entProfile.DebitColumn,
+		CreditColumn:       entProfile.CreditColumn,
+		BalanceColumn:      entProfile.BalanceColumn,
+		CreatedAt:          entProfile.CreatedAt,
+		UpdatedAt:          entProfile.UpdatedAt,
+	}
+
+	// Optional fields - check for zero values
+	if entProfile.UpdatedBy != uuid.Nil {
+		updatedBy := entProfile.UpdatedBy
+		p.UpdatedBy = &updatedBy
+	}
+
+	return p
+}
+
+func mapEntStatement(entStatement *ent.BankStatement) *Statement {
+	s := &Statement{
+		ID:                     entStatement.ID,
+		TenantID:               entStatement.TenantID,
+		BankAccountID:          entStatement.BankAccountID,
+		Format:                 entStatement.Format,
+		Profile:                entStatement.Profile,
+		FileName:               entStatement.FileName,
+		StatementReference:     entStatement.StatementReference,
+		Currency:               entStatement.Currency,
+		PeriodStart:            entStatement.PeriodStart,
+		PeriodEnd:              entStatement.PeriodEnd,
+		OpeningBalance:         entStatement.OpeningBalance,
+		ClosingBalance:         entStatement.ClosingBalance,
+		Continuity:             entStatement.Continuity,
+		ExpectedOpeningBalance: entStatement.ExpectedOpeningBalance,
+		TransactionCount:       entStatement.TransactionCount,
+		DuplicateCount:         entStatement.DuplicateCount,
+		LinkedCount:            entStatement.LinkedCount,
+		CreatedAt:              entStatement.CreatedAt,
+	}
+
+	// Optional fields - check for zero values
+	if entStatement.ImportedBy != uuid.Nil {
+		importedBy := entStatement.ImportedBy
+		s.ImportedBy = &importedBy
+	}
+
+	return s
+}
+
+func mapEntTransaction(entTxn *ent.BankTransaction) *Transaction {
+	return &Transaction{
+		ID:                   entTxn.ID,
+		TenantID:             entTxn.TenantID,
+		BankAccountID:        entTxn.BankAccountID,
+		StatementID:          entTxn.StatementID,
+		TransactionDate:      entTxn.TransactionDate,
+		ValueDate:            entTxn.ValueDate,
+		Amount:               entTxn.Amount,
+		Currency:             entTxn.Currency,
+		TransactionType:      entTxn.TransactionType,
+		Reference:            entTxn.Reference,
+		Description:          entTxn.Description,
+		Counterparty:         entTxn.Counterparty,
+		RunningBalance:       entTxn.RunningBalance,
+		PaymentTransactionID: entTxn.PaymentTransactionID,
+		ReconciliationStatus: entTxn.ReconciliationStatus,
+		Metadata:             entTxn.Metadata,
+		CreatedAt:            entTxn.CreatedAt,
+	}
+}
@@ -0,0 +1,373 @@
+package banking
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/banking/bankfile"
+)
+
+// accountTypes are the kinds of account money can be held in.
+var accountTypes = map[string]bool{
+	TypeBank:         true,
+	TypeMpesaPaybill: true,
+	TypeMpesaTill:    true,
+	TypeFloatWallet:  true,
+}
+
+// Service manages the tenant's bank accounts.
+type Service struct {
+	repo   Repository
+	logger *zap.Logger
+}
+
+// NewService creates a new banking service.
+func NewService(repo Repository, logger *zap.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Create validates and registers a bank account.
+func (s *Service) Create(ctx context.Context, tenantID uuid.UUID, req CreateRequest, createdBy *uuid.UUID) (*BankAccount, error) {
+	req.AccountName = strings.TrimSpace(req.AccountName)
+	req.BankName = strings.TrimSpace(req.BankName)
+	req.Branch = strings.TrimSpace(req.Branch)
+	req.AccountType = strings.ToLower(strings.TrimSpace(req.AccountType))
+	req.AccountNumber = NormalizeAccountNumber(req.AccountNumber)
+	req.Currency = strings.ToUpper(strings.TrimSpace(req.Currency))
+	req.LedgerAccountCode = strings.TrimSpace(req.LedgerAccountCode)
+	if req.AccountType == "" {
+		req.AccountType = TypeBank
+	}
+	if req.Currency == "" {
+		req.Currency = "KES"
+	}
+
+	if req.AccountName == "" {
+		return nil, fmt.Errorf("%w: account_name is required", ErrInvalidBankAccount)
+	}
+	if req.BankName == "" {
+		return nil, fmt.Errorf("%w: bank_name is required", ErrInvalidBankAccount)
+	}
+	if !accountTypes[req.AccountType] {
+		return nil, fmt.Errorf("%w: account_type must be bank, mpesa_paybill, mpesa_till or float_wallet", ErrInvalidBankAccount)
+	}
+	if req.AccountNumber == "" {
+		return nil, fmt.Errorf("%w: account_number is required", ErrInvalidBankAccount)
+	}
+	if len(req.Currency) != 3 {
+		return nil, fmt.Errorf("%w: currency must be an ISO currency code", ErrInvalidBankAccount)
+	}
+	if req.LedgerAccountCode == "" {
+		return nil, fmt.Errorf("%w: ledger_account_code is required", ErrInvalidBankAccount)
+	}
+
+	account, err := s.repo.Create(ctx, tenantID, req, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("bank account created",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("bank_account_id", account.ID.String()),
+		zap.String("account_type", account.AccountType),
+		zap.String("ledger_account_code", account.LedgerAccountCode),
+	)
+
+	return account, nil
+}
+
+// Get retrieves a bank account.
+func (s *Service) Get(ctx context.Context, tenantID uuid.UUID, bankAccountID uuid.UUID) (*BankAccount, error) {
+	return s.repo.Get(ctx, tenantID, bankAccountID)
+}
+
+// List returns the tenant's bank accounts.
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*BankAccount, error) {
+	filter.Currency = strings.ToUpper(filter.Currency)
+	return s.repo.List(ctx, tenantID, filter)
+}
+
+// Update validates and applies the provided fields to a bank account.
+func (s *Service) Update(ctx context.Context, tenantID uuid.UUID, bankAccountID uuid.UUID, req UpdateRequest) (*BankAccount, error) {
+	if req.AccountName != nil {
+		name := strings.TrimSpace(*req.AccountName)
+		if name == "" {
+			return nil, fmt.Errorf("%w: account_name is required", ErrInvalidBankAccount)
+		}
+		req.AccountName = &name
+	}
+	if req.BankName != nil {
+		name := strings.TrimSpace(*req.BankName)
+		if name == "" {
+			return nil, fmt.Errorf("%w: bank_name is required", ErrInvalidBankAccount)
+		}
+		req.BankName = &name
+	}
+	if req.AccountNumber != nil {
+		number := NormalizeAccountNumber(*req.AccountNumber)
+		if number == "" {
+			return nil, fmt.Errorf("%w: account_number is required", ErrInvalidBankAccount)
+		}
+		req.AccountNumber = &number
+	}
+	if req.LedgerAccountCode != nil {
+		code := strings.TrimSpace(*req.LedgerAccountCode)
+		if code == "" {
+			return nil, fmt.Errorf("%w: ledger_account_code is required", ErrInvalidBankAccount)
+		}
+		req.LedgerAccountCode = &code
+	}
+	if req.Status != nil && *req.Status != StatusActive && *req.Status != StatusInactive {
+		return nil, fmt.Errorf("%w: status must be active or inactive", ErrInvalidBankAccount)
+	}
+
+	account, err := s.repo.Update(ctx, tenantID, bankAccountID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := []zap.Field{
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("bank_account_id", bankAccountID.String()),
+	}
+	if req.AccountNumber != nil || req.LedgerAccountCode != nil {
+		fields = append(fields, zap.Bool("account_details_changed", true))
+	}
+	s.logger.Info("bank account updated", fields...)
+
+	return account, nil
+}
+
+// Close closes a bank account whose cash account holds nothing.
+func (s *Service) Close(ctx context.Context, tenantID uuid.UUID, bankAccountID uuid.UUID) (*BankAccount, error) {
+	account, err := s.repo.Close(ctx, tenantID, bankAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("bank account closed",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("bank_account_id", bankAccountID.String()),
+	)
+
+	return account, nil
+}
+
+// Balance compares the book balance of a bank account at the end of asOf with
+// its last imported statement balance.
+func (s *Service) Balance(ctx context.Context, tenantID uuid.UUID, bankAccountID uuid.UUID, asOf time.Time) (*Balance, error) {
+	account, err := s.repo.Get(ctx, tenantID, bankAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	asOf = startOfDay(asOf)
+	book, err := s.repo.BookBalance(ctx, tenantID, account.LedgerAccountCode, asOf.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	balance := &Balance{
+		BankAccountID:     account.ID,
+		LedgerAccountCode: account.LedgerAccountCode,
+		Currency:          account.Currency,
+		AsOf:              asOf,
+		BookBalance:       book,
+		StatementBalance:  account.StatementBalance,
+		StatementDate:     account.StatementDate,
+	}
+	if account.StatementBalance == nil || account.StatementDate == nil {
+		return balance, nil
+	}
+
+	atStatement, err := s.repo.BookBalance(ctx, tenantID, account.LedgerAccountCode, startOfDay(*account.StatementDate).AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	difference := account.StatementBalance.Sub(atStatement)
+	balance.BookAtStatement = &atStatement
+	balance.Difference = &difference
+
+	return balance, nil
+}
+
+// SaveProfile validates and stores a CSV column mapping profile under a
+// lower-case name.
+func (s *Service) SaveProfile(ctx context.Context, tenantID uuid.UUID, name string, req SaveProfileRequest, updatedBy *uuid.UUID) (*Profile, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidProfile)
+	}
+	if req.Delimiter == "" {
+		req.Delimiter = ","
+	}
+
+	candidate := Profile{
+		Delimiter:          req.Delimiter,
+		SkipRows:           req.SkipRows,
+		DateColumn:         req.DateColumn,
+		DateFormat:         req.DateFormat,
+		ValueDateColumn:    req.ValueDateColumn,
+		DescriptionColumn:  req.DescriptionColumn,
+		ReferenceColumn:    req.ReferenceColumn,
+		CounterpartyColumn: req.CounterpartyColumn,
+		AmountColumn:       req.AmountColumn,
+		DebitColumn:        req.DebitColumn,
+		CreditColumn:       req.CreditColumn,
+		BalanceColumn:      req.BalanceColumn,
+	}
+	if err := candidate.mapping().Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidProfile, err.Error())
+	}
+
+	profile, err := s.repo.SaveProfile(ctx, tenantID, name, req, updatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("statement profile saved",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("profile", name),
+	)
+
+	return profile, nil
+}
+
+// ListProfiles returns the tenant's CSV statement profiles.
+func (s *Service) ListProfiles(ctx context.Context, tenantID uuid.UUID) ([]*Profile, error) {
+	return s.repo.ListProfiles(ctx, tenantID)
+}
+
+// Import parses a statement file and stores its transactions against the
+// bank account. The file must be for the account and in its currency, and
+// its balances must add up. Lines already imported from an overlapping file
+// are skipped, and the opening balance must follow on from the previous
+// statement unless req.AllowGap is set.
+func (s *Service) Import(ctx context.Context, tenantID uuid.UUID, bankAccountID uuid.UUID, req ImportRequest, data []byte, importedBy *uuid.UUID) (*Statement, error) {
+	account, err := s.repo.Get(ctx, tenantID, bankAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if account.Status == StatusClosed {
+		return nil, fmt.Errorf("%w: account is closed", ErrBankAccountState)
+	}
+
+	req.Format = strings.ToLower(strings.TrimSpace(req.Format))
+	req.Profile = strings.ToLower(strings.TrimSpace(req.Profile))
+	if req.Format == FormatMpesa && account.AccountType != TypeMpesaPaybill && account.AccountType != TypeMpesaTill {
+		return nil, fmt.Errorf("%w: M-Pesa statements can only be imported to paybill and till accounts", ErrInvalidStatement)
+	}
+	parsed, err := s.parse(ctx, tenantID, req, data)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAccount(account, parsed); err != nil {
+		return nil, err
+	}
+	if err := checkTotals(parsed); err != nil {
+		return nil, err
+	}
+
+	statement, err := s.repo.SaveStatement(ctx, tenantID, StatementImport{
+		BankAccountID: account.ID,
+		Format:        req.Format,
+		Profile:       req.Profile,
+		FileName:      req.FileName,
+		FileHash:      fileHash(data),
+		Parsed:        parsed,
+		Hashes:        transactionHashes(account.ID, parsed.Transactions),
+		AllowGap:      req.AllowGap,
+		ImportedBy:    importedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("bank statement imported",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("bank_account_id", account.ID.String()),
+		zap.String("statement_id", statement.ID.String()),
+		zap.String("format", statement.Format),
+		zap.String("continuity", statement.Continuity),
+		zap.Int("transactions", statement.TransactionCount),
+		zap.Int("duplicates", statement.DuplicateCount),
+		zap.Int("linked", statement.LinkedCount),
+	)
+
+	return statement, nil
+}
+
+func (s *Service) parse(ctx context.Context, tenantID uuid.UUID, req ImportRequest, data []byte) (*bankfile.Statement, error) {
+	var (
+		parsed *bankfile.Statement
+		err    error
+	)
+	switch req.Format {
+	case FormatCSV:
+		if req.Profile == "" {
+			return nil, fmt.Errorf("%w: profile is required for CSV statements", ErrInvalidStatement)
+		}
+		profile, perr := s.repo.GetProfile(ctx, tenantID, req.Profile)
+		if perr != nil {
+			return nil, perr
+		}
+		parsed, err = bankfile.ParseCSV(data, profile.mapping())
+	case FormatMT940:
+		parsed, err = bankfile.ParseMT940(data)
+	case FormatCAMT053:
+		parsed, err = bankfile.ParseCAMT053(data)
+	case FormatMpesa:
+		parsed, err = bankfile.ParseMpesa(data)
+	default:
+		return nil, fmt.Errorf("%w: format must be csv, mt940, camt053 or mpesa", ErrInvalidStatement)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidStatement, err.Error())
+	}
+	return parsed, nil
+}
+
+// checkAccount rejects a statement naming another account number or
+// currency. Files that name neither are taken to be for the account.
+func checkAccount(account *BankAccount, parsed *bankfile.Statement) error {
+	if parsed.AccountNumber != "" {
+		number := NormalizeAccountNumber(parsed.AccountNumber)
+		if FingerprintAccountNumber(number) != account.AccountNumberHash {
+			return fmt.Errorf("%w: statement is for account %s, not %s", ErrInvalidStatement, MaskAccountNumber(number), account.AccountNumberMasked)
+		}
+	}
+	if parsed.Currency != "" && !strings.EqualFold(parsed.Currency, account.Currency) {
+		return fmt.Errorf("%w: statement is in %s, the account is in %s", ErrInvalidStatement, parsed.Currency, account.Currency)
+	}
+	return nil
+}
+
+// GetStatement retrieves an imported statement.
+func (s *Service) GetStatement(ctx context.Context, tenantID uuid.UUID, statementID uuid.UUID) (*Statement, error) {
+	return s.repo.GetStatement(ctx, tenantID, statementID)
+}
+
+// ListStatements returns a bank account's imported statements.
+func (s *Service) ListStatements(ctx context.Context, tenantID uuid.UUID, bankAccountID uuid.UUID, limit, offset int) ([]*Statement, error) {
+	if _, err := s.repo.Get(ctx, tenantID, bankAccountID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListStatements(ctx, tenantID, bankAccountID, limit, offset)
+}
+
+// ListTransactions returns imported bank transactions.
+func (s *Service) ListTransactions(ctx context.Context, tenantID uuid.UUID, filter TransactionFilter) ([]*Transaction, error) {
+	return s.repo.ListTransactions(ctx, tenantID, filter)
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
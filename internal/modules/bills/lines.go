@@ -0,0 +1,139 @@
+package bills
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/invoices"
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+	"github.com/bengobox/treasury-api/internal/modules/withholding"
+)
+
+// taxRates are the rates of the supported tax codes. Input VAT on VAT16 and
+// VAT8 lines is recoverable; ZERO and EXEMPT lines carry no tax.
+var taxRates = map[string]decimal.Decimal{
+	TaxVAT16:  decimal.RequireFromString("0.16"),
+	TaxVAT8:   decimal.RequireFromString("0.08"),
+	TaxZero:   decimal.Zero,
+	TaxExempt: decimal.Zero,
+}
+
+// buildLines validates the requested lines and prices them. Lines without an
+// account are coded to defaultAccount.
+func buildLines(reqs []LineRequest, defaultAccount string) (lines []*Line, subtotal, tax decimal.Decimal, err error) {
+	if len(reqs) == 0 {
+		return nil, decimal.Zero, decimal.Zero, fmt.Errorf("%w: at least one line is required", ErrInvalidBill)
+	}
+
+	subtotal, tax = decimal.Zero, decimal.Zero
+	for i, req := range reqs {
+		description := strings.TrimSpace(req.Description)
+		if description == "" {
+			return nil, decimal.Zero, decimal.Zero, fmt.Errorf("%w: line %d description is required", ErrInvalidBill, i+1)
+		}
+		if !req.Quantity.IsPositive() {
+			return nil, decimal.Zero, decimal.Zero, fmt.Errorf("%w: line %d quantity must be positive", ErrInvalidBill, i+1)
+		}
+		if req.UnitPrice.IsNegative() {
+			return nil, decimal.Zero, decimal.Zero, fmt.Errorf("%w: line %d unit_price cannot be negative", ErrInvalidBill, i+1)
+		}
+
+		taxCode := strings.ToUpper(strings.TrimSpace(req.TaxCode))
+		if taxCode == "" {
+			taxCode = TaxExempt
+		}
+		rate, ok := taxRates[taxCode]
+		if !ok {
+			return nil, decimal.Zero, decimal.Zero, fmt.Errorf("%w: line %d has unknown tax_code %q", ErrInvalidBill, i+1, req.TaxCode)
+		}
+
+		account := strings.TrimSpace(req.AccountCode)
+		if account == "" {
+			account = defaultAccount
+		}
+
+		lineTotal, lineTax := invoices.LineAmounts(req.Quantity, req.UnitPrice, rate)
+		subtotal = subtotal.Add(lineTotal)
+		tax = tax.Add(lineTax)
+
+		lines = append(lines, &Line{
+			LineNumber:  i + 1,
+			ItemCode:    strings.TrimSpace(req.ItemCode),
+			Description: description,
+			Quantity:    req.Quantity,
+			UnitPrice:   req.UnitPrice,
+			AccountCode: account,
+			TaxCode:     taxCode,
+			TaxRate:     rate,
+			TaxAmount:   lineTax,
+			LineTotal:   lineTotal,
+			Metadata:    req.Metadata,
+		})
+	}
+
+	return lines, subtotal, tax, nil
+}
+
+// withholdingCategories returns the withholding category of each requested
+// line: its own, or the vendor's when it names none.
+func withholdingCategories(reqs []LineRequest, vendorCategory string) []string {
+	categories := make([]string, len(reqs))
+	for i, req := range reqs {
+		categories[i] = withholding.NormalizeCategory(req.WithholdingCategory)
+		if categories[i] == "" {
+			categories[i] = vendorCategory
+		}
+	}
+	return categories
+}
+
+// applyWithholding sets the withholding tax of each line from its category's
+// rate, computed on the line total before VAT, and returns the bill's total.
+// Lines without a category are not subject to withholding.
+func applyWithholding(lines []*Line, categories []string, rates map[string]decimal.Decimal) decimal.Decimal {
+	total := decimal.Zero
+	for i, line := range lines {
+		if categories[i] == "" {
+			continue
+		}
+		rate := rates[categories[i]]
+		line.WithholdingCategory = categories[i]
+		line.WithholdingRate = rate
+		line.WithholdingAmount = withholding.Withhold(line.LineTotal, rate)
+		total = total.Add(line.WithholdingAmount)
+	}
+	return total
+}
+
+// approvalJournalLines builds the journal that recognises an approved bill:
+// each expense or asset account is debited with its lines' net amount, input
+// VAT with the tax and accounts payable is credited with the total.
+func approvalJournalLines(lines []*Line) []ledger.Line {
+	net := map[string]decimal.Decimal{}
+	tax, total := decimal.Zero, decimal.Zero
+	for _, line := range lines {
+		net[line.AccountCode] = net[line.AccountCode].Add(line.LineTotal)
+		tax = tax.Add(line.TaxAmount)
+		total = total.Add(line.LineTotal).Add(line.TaxAmount)
+	}
+
+	accounts := make([]string, 0, len(net))
+	for account := range net {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+
+	journal := make([]ledger.Line, 0, len(accounts)+2)
+	for _, account := range accounts {
+		if net[account].IsPositive() {
+			journal = append(journal, ledger.Debit(account, net[account]))
+		}
+	}
+	if tax.IsPositive() {
+		journal = append(journal, ledger.Debit(ledger.AccountVATInput, tax))
+	}
+	return append(journal, ledger.Credit(ledger.AccountPayable, total))
+}
@@ -0,0 +1,108 @@
+package bills
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+)
+
+func amount(v string) decimal.Decimal {
+	return decimal.RequireFromString(v)
+}
+
+func TestBuildLines(t *testing.T) {
+	lines, subtotal, tax, err := buildLines([]LineRequest{
+		{Description: "Diesel", Quantity: amount("120"), UnitPrice: amount("180.50"), AccountCode: "6200", TaxCode: "vat8"},
+		{Description: "Tyre repair", Quantity: amount("1"), UnitPrice: amount("2500"), TaxCode: TaxVAT16},
+		{Description: "Toll", Quantity: amount("2"), UnitPrice: amount("300")},
+	}, ledger.AccountGeneralExpenses)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !subtotal.Equal(amount("24760")) {
+		t.Fatalf("expected subtotal 24760, got %s", subtotal)
+	}
+	if !tax.Equal(amount("2132.8")) {
+		t.Fatalf("expected tax 2132.80, got %s", tax)
+	}
+	if lines[1].AccountCode != ledger.AccountGeneralExpenses {
+		t.Fatalf("expected default account, got %s", lines[1].AccountCode)
+	}
+	if lines[2].TaxCode != TaxExempt || !lines[2].TaxAmount.IsZero() {
+		t.Fatalf("expected untaxed line to be exempt, got %s %s", lines[2].TaxCode, lines[2].TaxAmount)
+	}
+}
+
+func TestBuildLinesRejectsUnknownTaxCode(t *testing.T) {
+	_, _, _, err := buildLines([]LineRequest{
+		{Description: "Stationery", Quantity: amount("1"), UnitPrice: amount("100"), TaxCode: "VAT14"},
+	}, ledger.AccountGeneralExpenses)
+	if !errors.Is(err, ErrInvalidBill) {
+		t.Fatalf("expected ErrInvalidBill, got %v", err)
+	}
+}
+
+func TestApplyWithholding(t *testing.T) {
+	reqs := []LineRequest{
+		{Description: "Audit fees", Quantity: amount("1"), UnitPrice: amount("150000"), TaxCode: TaxVAT16},
+		{Description: "Site works", Quantity: amount("1"), UnitPrice: amount("33333.33"), WithholdingCategory: "Contractual Fees"},
+	}
+	lines, _, _, err := buildLines(reqs, ledger.AccountGeneralExpenses)
+	if err != nil {
+		t.Fatalf("build lines: %v", err)
+	}
+
+	if categories := withholdingCategories(reqs, ""); categories[0] != "" || categories[1] != "contractual_fees" {
+		t.Fatalf("unexpected categories without a vendor category %v", categories)
+	}
+	categories := withholdingCategories(reqs, "professional_fees")
+	if categories[0] != "professional_fees" {
+		t.Fatalf("expected the vendor's category by default, got %v", categories)
+	}
+
+	total := applyWithholding(lines, categories, map[string]decimal.Decimal{
+		"professional_fees": amount("0.05"),
+		"contractual_fees":  amount("0.03"),
+	})
+	if !lines[0].WithholdingAmount.Equal(amount("7500")) {
+		t.Fatalf("expected withholding on the amount before VAT, got %s", lines[0].WithholdingAmount)
+	}
+	if !lines[1].WithholdingAmount.Equal(amount("1000")) {
+		t.Fatalf("expected withholding rounded to cents, got %s", lines[1].WithholdingAmount)
+	}
+	if !total.Equal(amount("8500")) {
+		t.Fatalf("expected 8500 withheld, got %s", total)
+	}
+}
+
+func TestApprovalJournalLinesBalance(t *testing.T) {
+	lines, _, _, err := buildLines([]LineRequest{
+		{Description: "Laptop", Quantity: amount("2"), UnitPrice: amount("85000"), AccountCode: "1500", TaxCode: TaxVAT16},
+		{Description: "Courier", Quantity: amount("1"), UnitPrice: amount("450"), TaxCode: TaxVAT16},
+		{Description: "Printing", Quantity: amount("1"), UnitPrice: amount("1200")},
+	}, ledger.AccountGeneralExpenses)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	journal := approvalJournalLines(lines)
+	if err := (ledger.Journal{TenantID: uuid.New(), Lines: journal}).Validate(); err != nil {
+		t.Fatalf("expected balanced journal, got %v", err)
+	}
+
+	debits := map[string]decimal.Decimal{}
+	for _, line := range journal {
+		debits[line.AccountCode] = debits[line.AccountCode].Add(line.Debit)
+	}
+	if !debits[ledger.AccountGeneralExpenses].Equal(amount("1650")) {
+		t.Fatalf("expected 1650 to general expenses, got %s", debits[ledger.AccountGeneralExpenses])
+	}
+	if !debits[ledger.AccountVATInput].Equal(amount("27272")) {
+		t.Fatalf("expected 27272 input VAT, got %s", debits[ledger.AccountVATInput])
+	}
+}
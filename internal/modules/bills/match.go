@@ -0,0 +1,76 @@
+package bills
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// receivedItem is what remains billable of an item across a purchase order's
+// goods receipts.
+type receivedItem struct {
+	Quantity  decimal.Decimal
+	UnitPrice decimal.Decimal
+}
+
+// unbilledItems totals the received quantity of each item and deducts the
+// quantity already billed on the order's other bills. The unit price is the
+// order price on the latest receipt.
+func unbilledItems(receipts []*GoodsReceipt, billed map[string]decimal.Decimal) map[string]*receivedItem {
+	items := map[string]*receivedItem{}
+	for _, receipt := range receipts {
+		for _, line := range receipt.Lines {
+			item, ok := items[line.ItemCode]
+			if !ok {
+				item = &receivedItem{Quantity: decimal.Zero}
+				items[line.ItemCode] = item
+			}
+			item.Quantity = item.Quantity.Add(line.ReceivedQuantity)
+			item.UnitPrice = line.UnitPrice
+		}
+	}
+	for code, quantity := range billed {
+		if item, ok := items[code]; ok {
+			item.Quantity = decimal.Max(item.Quantity.Sub(quantity), decimal.Zero)
+		}
+	}
+	return items
+}
+
+// matchLines compares each bill line with the unbilled received items, setting
+// the line's match status, and returns the bill's match status. Lines for the
+// same item draw down its received quantity in line order.
+func matchLines(lines []*Line, items map[string]*receivedItem, settings *Settings) string {
+	one := decimal.NewFromInt(1)
+	remaining := make(map[string]decimal.Decimal, len(items))
+	for code, item := range items {
+		remaining[code] = item.Quantity
+	}
+
+	status := MatchMatched
+	for _, line := range lines {
+		item, ok := items[line.ItemCode]
+		if line.ItemCode == "" || !ok {
+			line.MatchStatus = LineNotReceived
+			line.ReceivedQuantity, line.OrderUnitPrice = nil, nil
+			status = MatchVariance
+			continue
+		}
+
+		received := remaining[line.ItemCode]
+		price := item.UnitPrice
+		line.ReceivedQuantity, line.OrderUnitPrice = &received, &price
+		remaining[line.ItemCode] = decimal.Max(received.Sub(line.Quantity), decimal.Zero)
+
+		switch {
+		case line.Quantity.GreaterThan(received.Mul(one.Add(settings.QuantityTolerance))):
+			line.MatchStatus = LineQuantityVariance
+		case line.UnitPrice.Sub(price).Abs().GreaterThan(price.Mul(settings.PriceTolerance)):
+			line.MatchStatus = LinePriceVariance
+		default:
+			line.MatchStatus = LineMatched
+		}
+		if line.MatchStatus != LineMatched {
+			status = MatchVariance
+		}
+	}
+	return status
+}
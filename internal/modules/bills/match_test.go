@@ -0,0 +1,51 @@
+package bills
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMatchLines(t *testing.T) {
+	receipts := []*GoodsReceipt{
+		{Lines: []*ReceiptLine{
+			{ItemCode: "FLOUR-50KG", ReceivedQuantity: amount("20"), UnitPrice: amount("4200")},
+			{ItemCode: "SUGAR-25KG", ReceivedQuantity: amount("10"), UnitPrice: amount("3100")},
+		}},
+		{Lines: []*ReceiptLine{
+			{ItemCode: "FLOUR-50KG", ReceivedQuantity: amount("10"), UnitPrice: amount("4200")},
+		}},
+	}
+	items := unbilledItems(receipts, map[string]decimal.Decimal{"FLOUR-50KG": amount("5")})
+	if !items["FLOUR-50KG"].Quantity.Equal(amount("25")) {
+		t.Fatalf("expected 25 unbilled bags of flour, got %s", items["FLOUR-50KG"].Quantity)
+	}
+
+	settings := &Settings{QuantityTolerance: decimal.Zero, PriceTolerance: amount("0.02")}
+	lines := []*Line{
+		{ItemCode: "FLOUR-50KG", Quantity: amount("25"), UnitPrice: amount("4250")},
+		{ItemCode: "SUGAR-25KG", Quantity: amount("10"), UnitPrice: amount("3100")},
+	}
+	if status := matchLines(lines, items, settings); status != MatchMatched {
+		t.Fatalf("expected bill within tolerance to match, got %s (%s, %s)", status, lines[0].MatchStatus, lines[1].MatchStatus)
+	}
+
+	lines = []*Line{
+		{ItemCode: "FLOUR-50KG", Quantity: amount("20"), UnitPrice: amount("4200")},
+		{ItemCode: "FLOUR-50KG", Quantity: amount("6"), UnitPrice: amount("4200")},
+		{ItemCode: "SUGAR-25KG", Quantity: amount("10"), UnitPrice: amount("3200")},
+		{ItemCode: "SALT-1KG", Quantity: amount("1"), UnitPrice: amount("50")},
+	}
+	if status := matchLines(lines, items, settings); status != MatchVariance {
+		t.Fatalf("expected variance, got %s", status)
+	}
+	want := []string{LineMatched, LineQuantityVariance, LinePriceVariance, LineNotReceived}
+	for i, line := range lines {
+		if line.MatchStatus != want[i] {
+			t.Fatalf("line %d: expected %s, got %s", i+1, want[i], line.MatchStatus)
+		}
+	}
+	if !lines[1].ReceivedQuantity.Equal(amount("5")) {
+		t.Fatalf("expected second flour line to see 5 remaining, got %s", lines[1].ReceivedQuantity)
+	}
+}
@@ -0,0 +1,288 @@
+package bills
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Bill statuses.
+const (
+	StatusDraft     = "draft"
+	StatusApproved  = "approved"
+	StatusScheduled = "scheduled"
+	StatusPaid      = "paid"
+	StatusCancelled = "cancelled"
+)
+
+// Tax codes for bill lines.
+const (
+	TaxVAT16  = "VAT16"
+	TaxVAT8   = "VAT8"
+	TaxZero   = "ZERO"
+	TaxExempt = "EXEMPT"
+)
+
+// Three-way match statuses of a bill quoting a purchase order.
+const (
+	MatchAwaitingReceipt = "awaiting_receipt"
+	MatchMatched         = "matched"
+	MatchVariance        = "variance"
+	MatchAccepted        = "accepted"
+)
+
+// Match statuses of a bill line.
+const (
+	LineMatched          = "matched"
+	LineQuantityVariance = "quantity_variance"
+	LinePriceVariance    = "price_variance"
+	LineNotReceived      = "not_received"
+)
+
+// Outbox events published by the bills module.
+const (
+	EventBillApproved        = "treasury.bill.approved"
+	EventBillPaid            = "treasury.bill.paid"
+	referenceTypeBill        = "bill"
+	referenceTypeBillPayment = "bill_payment"
+)
+
+// EventPOReceived is published by the inventory service when goods are
+// received against a purchase order.
+const EventPOReceived = "inventory.po.received"
+
+// Bill is a vendor invoice owed by the tenant.
+type Bill struct {
+	ID                 uuid.UUID       `json:"id"`
+	TenantID           uuid.UUID       `json:"tenant_id"`
+	VendorID           uuid.UUID       `json:"vendor_id"`
+	BillNumber         string          `json:"bill_number"`
+	VendorReference    string          `json:"vendor_reference,omitempty"`
+	BillDate           time.Time       `json:"bill_date"`
+	DueDate            time.Time       `json:"due_date"`
+	Currency           string          `json:"currency"`
+	Subtotal           decimal.Decimal `json:"subtotal"`
+	TaxAmount          decimal.Decimal `json:"tax_amount"`
+	TotalAmount        decimal.Decimal `json:"total_amount"`
+	PaidAmount         decimal.Decimal `json:"paid_amount"`
+	DiscountRate       decimal.Decimal `json:"discount_rate"`
+	DiscountUntil      *time.Time      `json:"discount_until,omitempty"`
+	DiscountTaken      decimal.Decimal `json:"discount_taken"`
+	WithholdingAmount  decimal.Decimal `json:"withholding_amount"`
+	WithholdingTaken   decimal.Decimal `json:"withholding_taken"`
+	Status             string          `json:"status"` // draft, approved, scheduled, paid, cancelled
+	Attachments        []string        `json:"attachments"`
+	ReferenceType      string          `json:"reference_type,omitempty"`
+	ReferenceID        string          `json:"reference_id,omitempty"`
+	PONumber           string          `json:"po_number,omitempty"`
+	MatchStatus        string          `json:"match_status,omitempty"` // awaiting_receipt, matched, variance, accepted; empty without a PO
+	MatchedAt          *time.Time      `json:"matched_at,omitempty"`
+	VarianceAcceptedBy *uuid.UUID      `json:"variance_accepted_by,omitempty"`
+	VarianceAcceptedAt *time.Time      `json:"variance_accepted_at,omitempty"`
+	VarianceReason     string          `json:"variance_reason,omitempty"`
+	Notes              string          `json:"notes,omitempty"`
+	CreatedBy          *uuid.UUID      `json:"created_by,omitempty"`
+	ApprovedBy         *uuid.UUID      `json:"approved_by,omitempty"`
+	ApprovedAt         *time.Time      `json:"approved_at,omitempty"`
+	ScheduledFor       *time.Time      `json:"scheduled_for,omitempty"`
+	PaidAt             *time.Time      `json:"paid_at,omitempty"`
+	JournalEntryID     *uuid.UUID      `json:"journal_entry_id,omitempty"`
+	Metadata           map[string]any  `json:"metadata,omitempty"`
+	Lines              []*Line         `json:"lines,omitempty"`
+	CreatedAt          time.Time       `json:"created_at"`
+	UpdatedAt          time.Time       `json:"updated_at"`
+}
+
+// Balance returns the amount still owed on the bill.
+func (b *Bill) Balance() decimal.Decimal {
+	return b.TotalAmount.Sub(b.PaidAmount).Sub(b.DiscountTaken).Sub(b.WithholdingTaken)
+}
+
+// WithholdingDue returns the withholding tax still to be deducted when the
+// bill is paid.
+func (b *Bill) WithholdingDue() decimal.Decimal {
+	return b.WithholdingAmount.Sub(b.WithholdingTaken)
+}
+
+// EarlyPaymentDiscount returns the discount earned by paying the balance on
+// the given day.
+func (b *Bill) EarlyPaymentDiscount(paidOn time.Time) decimal.Decimal {
+	var until time.Time
+	if b.DiscountUntil != nil {
+		until = *b.DiscountUntil
+	}
+	return EarlyPaymentDiscount(b.Balance(), b.DiscountRate, until, paidOn)
+}
+
+// Line is a bill line coded to an expense or asset account.
+type Line struct {
+	ID                  uuid.UUID        `json:"id"`
+	LineNumber          int              `json:"line_number"`
+	ItemCode            string           `json:"item_code,omitempty"`
+	Description         string           `json:"description"`
+	Quantity            decimal.Decimal  `json:"quantity"`
+	UnitPrice           decimal.Decimal  `json:"unit_price"`
+	AccountCode         string           `json:"account_code"`
+	TaxCode             string           `json:"tax_code,omitempty"`
+	TaxRate             decimal.Decimal  `json:"tax_rate"`
+	TaxAmount           decimal.Decimal  `json:"tax_amount"`
+	LineTotal           decimal.Decimal  `json:"line_total"`
+	WithholdingCategory string           `json:"withholding_category,omitempty"`
+	WithholdingRate     decimal.Decimal  `json:"withholding_rate"`
+	WithholdingAmount   decimal.Decimal  `json:"withholding_amount"`
+	MatchStatus         string           `json:"match_status,omitempty"` // matched, quantity_variance, price_variance, not_received
+	ReceivedQuantity    *decimal.Decimal `json:"received_quantity,omitempty"`
+	OrderUnitPrice      *decimal.Decimal `json:"order_unit_price,omitempty"`
+	Metadata            map[string]any   `json:"metadata,omitempty"`
+}
+
+// CreateRequest enters a vendor bill as a draft. The bill date defaults to
+// today, the due date to the vendor's payment terms and the currency to the
+// vendor's default currency. Withholding tax is computed on each line in the
+// vendor's withholding category unless the line names its own. Bills quoting a purchase order are matched to its
+// goods receipts and cannot be approved until they match or the variances are
+// accepted.
+type CreateRequest struct {
+	VendorID        uuid.UUID      `json:"vendor_id"`
+	VendorReference string         `json:"vendor_reference"`
+	BillDate        *time.Time     `json:"bill_date"`
+	DueDate         *time.Time     `json:"due_date"`
+	Currency        string         `json:"currency"`
+	Lines           []LineRequest  `json:"lines"`
+	Attachments     []string       `json:"attachments"`
+	ReferenceType   string         `json:"reference_type"`
+	ReferenceID     string         `json:"reference_id"`
+	PONumber        string         `json:"po_number"`
+	Notes           string         `json:"notes"`
+	Metadata        map[string]any `json:"metadata"`
+}
+
+// LineRequest describes a bill line. AccountCode defaults to the vendor's
+// default expense account and WithholdingCategory to the vendor's withholding
+// category.
+type LineRequest struct {
+	ItemCode            string          `json:"item_code"`
+	Description         string          `json:"description"`
+	Quantity            decimal.Decimal `json:"quantity"`
+	UnitPrice           decimal.Decimal `json:"unit_price"`
+	AccountCode         string          `json:"account_code"`
+	TaxCode             string          `json:"tax_code"`
+	WithholdingCategory string          `json:"withholding_category"`
+	Metadata            map[string]any  `json:"metadata"`
+}
+
+// ScheduleRequest sets the date an approved bill will be paid.
+type ScheduleRequest struct {
+	ScheduledFor time.Time `json:"scheduled_for"`
+}
+
+// PayRequest records payment of a bill's balance less withholding tax.
+// PaymentAccount defaults to cash (1000) and PaidAt to now.
+type PayRequest struct {
+	PaidAt           *time.Time `json:"paid_at"`
+	PaymentAccount   string     `json:"payment_account"`
+	PaymentReference string     `json:"payment_reference"`
+}
+
+// Settlement pays off a bill's balance: Amount leaves the payment account,
+// Discount is the early-payment discount taken and Withholding the withholding
+// tax deducted for remittance to KRA. Together they must equal the balance.
+type Settlement struct {
+	Amount           decimal.Decimal
+	Discount         decimal.Decimal
+	Withholding      decimal.Decimal
+	PaidAt           time.Time
+	PaymentAccount   string
+	PaymentReference string
+	// Metadata is recorded on the payment journal and event.
+	Metadata map[string]any
+}
+
+// AcceptVarianceRequest releases a bill with match variances for approval.
+type AcceptVarianceRequest struct {
+	Reason string `json:"reason"`
+}
+
+// Settings are a tenant's accounts payable preferences. Tolerances are
+// fractions: 0.05 lets a billed quantity exceed the received quantity, or a
+// billed price differ from the order price, by 5%.
+type Settings struct {
+	TenantID          uuid.UUID       `json:"tenant_id"`
+	QuantityTolerance decimal.Decimal `json:"quantity_tolerance"`
+	PriceTolerance    decimal.Decimal `json:"price_tolerance"`
+	UpdatedAt         *time.Time      `json:"updated_at,omitempty"`
+}
+
+// DefaultSettings are used until a tenant saves its own: bills must match
+// receipts exactly.
+func DefaultSettings(tenantID uuid.UUID) *Settings {
+	return &Settings{
+		TenantID:          tenantID,
+		QuantityTolerance: decimal.Zero,
+		PriceTolerance:    decimal.Zero,
+	}
+}
+
+// UpdateSettingsRequest changes the provided settings fields.
+type UpdateSettingsRequest struct {
+	QuantityTolerance *decimal.Decimal `json:"quantity_tolerance"`
+	PriceTolerance    *decimal.Decimal `json:"price_tolerance"`
+}
+
+// GoodsReceipt is a goods received note against a purchase order.
+type GoodsReceipt struct {
+	ID         uuid.UUID      `json:"id"`
+	TenantID   uuid.UUID      `json:"tenant_id"`
+	EventID    string         `json:"event_id"`
+	POID       string         `json:"po_id,omitempty"`
+	PONumber   string         `json:"po_number"`
+	GRNID      string         `json:"grn_id"`
+	GRNNumber  string         `json:"grn_number,omitempty"`
+	VendorID   *uuid.UUID     `json:"vendor_id,omitempty"`
+	Currency   string         `json:"currency"`
+	ReceivedAt time.Time      `json:"received_at"`
+	Lines      []*ReceiptLine `json:"lines"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+// ReceiptLine is an item received on a goods received note.
+type ReceiptLine struct {
+	LineNumber       int             `json:"line_number"`
+	ItemCode         string          `json:"item_code"`
+	Description      string          `json:"description,omitempty"`
+	OrderedQuantity  decimal.Decimal `json:"ordered_quantity"`
+	ReceivedQuantity decimal.Decimal `json:"received_quantity"`
+	UnitPrice        decimal.Decimal `json:"unit_price"`
+}
+
+// POReceived is the data of inventory.po.received.
+type POReceived struct {
+	POID       string           `json:"po_id"`
+	PONumber   string           `json:"po_number"`
+	GRNID      string           `json:"grn_id"`
+	GRNNumber  string           `json:"grn_number"`
+	VendorID   *uuid.UUID       `json:"vendor_id"`
+	Currency   string           `json:"currency"`
+	ReceivedAt *time.Time       `json:"received_at"`
+	Lines      []POReceivedLine `json:"lines"`
+}
+
+// POReceivedLine is an item on inventory.po.received.
+type POReceivedLine struct {
+	ItemCode         string          `json:"item_code"`
+	Description      string          `json:"description"`
+	OrderedQuantity  decimal.Decimal `json:"ordered_quantity"`
+	ReceivedQuantity decimal.Decimal `json:"received_quantity"`
+	UnitPrice        decimal.Decimal `json:"unit_price"`
+}
+
+// ListFilter narrows bill listings.
+type ListFilter struct {
+	Status   string
+	VendorID *uuid.UUID
+	// DueBefore selects bills due before the date.
+	DueBefore *time.Time
+	Limit     int
+	Offset    int
+}
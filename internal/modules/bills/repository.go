@@ -0,0 +1,61 @@
+package bills
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrBillNotFound is returned when a bill does not exist.
+	ErrBillNotFound = errors.New("bill not found")
+	// ErrInvalidBill is returned when a bill or its lines are malformed.
+	ErrInvalidBill = errors.New("invalid bill")
+	// ErrDuplicateBill is returned when the vendor reference or source reference
+	// was already billed.
+	ErrDuplicateBill = errors.New("bill already exists")
+	// ErrBillStatus is returned when the bill's status does not allow the action.
+	ErrBillStatus = errors.New("bill status does not allow this action")
+	// ErrSelfApproval is returned when the user who entered a bill tries to approve it.
+	ErrSelfApproval = errors.New("bill must be approved by another user")
+	// ErrMatchVariance is returned when approving a bill that does not match
+	// its purchase order receipts and whose variances were not accepted.
+	ErrMatchVariance = errors.New("bill does not match purchase order receipts")
+	// ErrInvalidReceipt is returned when a goods receipt event is malformed.
+	ErrInvalidReceipt = errors.New("invalid goods receipt")
+	// ErrInvalidSettings is returned when payables settings are out of range.
+	ErrInvalidSettings = errors.New("invalid payables settings")
+)
+
+// Repository abstracts persistence for vendor bills.
+type Repository interface {
+	// Create numbers and stores a draft bill after checking every line is
+	// coded to an expense or asset account in the chart.
+	Create(ctx context.Context, bill *Bill) (*Bill, error)
+	Get(ctx context.Context, tenantID, billID uuid.UUID) (*Bill, error)
+	List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Bill, error)
+	// Approve posts the accounts payable journal for a draft bill and enqueues
+	// the approved event.
+	Approve(ctx context.Context, tenantID, billID uuid.UUID, approvedBy *uuid.UUID, now time.Time) (*Bill, error)
+	Schedule(ctx context.Context, tenantID, billID uuid.UUID, scheduledFor time.Time) (*Bill, error)
+	// Pay posts the payment journal settling the bill's balance and enqueues
+	// the paid event.
+	Pay(ctx context.Context, tenantID, billID uuid.UUID, req PayRequest, paidAt time.Time) (*Bill, error)
+	Cancel(ctx context.Context, tenantID, billID uuid.UUID) (*Bill, error)
+	// Match compares a draft bill's lines with the unbilled quantities on its
+	// purchase order's goods receipts and records the result.
+	Match(ctx context.Context, tenantID, billID uuid.UUID, settings *Settings, now time.Time) (*Bill, error)
+	// AcceptVariance releases a draft bill with match variances for approval.
+	AcceptVariance(ctx context.Context, tenantID, billID uuid.UUID, reason string, acceptedBy *uuid.UUID, now time.Time) (*Bill, error)
+	// UnmatchedBills returns the draft bills quoting the purchase order that
+	// are awaiting receipt or have variances.
+	UnmatchedBills(ctx context.Context, tenantID uuid.UUID, poNumber string) ([]uuid.UUID, error)
+	// RecordReceipt stores a goods receipt once per GRN and reports whether it
+	// was new.
+	RecordReceipt(ctx context.Context, receipt *GoodsReceipt) (bool, error)
+	ListReceipts(ctx context.Context, tenantID uuid.UUID, poNumber string, limit, offset int) ([]*GoodsReceipt, error)
+	GetSettings(ctx context.Context, tenantID uuid.UUID) (*Settings, error)
+	SaveSettings(ctx context.Context, settings *Settings) (*Settings, error)
+}
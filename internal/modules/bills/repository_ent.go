@@ -0,0 +1,821 @@
+package bills
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/chartofaccount"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceipt"
+	"github.com/bengobox/treasury-api/internal/ent/goodsreceiptline"
+	"github.com/bengobox/treasury-api/internal/ent/payablesetting"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbill"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbillline"
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+	"github.com/bengobox/treasury-api/internal/modules/numbering"
+	"github.com/bengobox/treasury-api/internal/modules/outbox"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+)
+
+// billableAccountTypes are the account types bill lines may be coded to.
+var billableAccountTypes = map[string]bool{"expense": true, "asset": true}
+
+// errReceiptRecorded aborts the insert of a goods receipt already recorded by
+// a concurrent delivery.
+var errReceiptRecorded = errors.New("goods receipt already recorded")
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// Create numbers and stores a draft bill with its lines.
+func (r *EntRepository) Create(ctx context.Context, bill *Bill) (*Bill, error) {
+	var created *ent.VendorBill
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		if err := checkAccounts(ctx, tx, bill.TenantID, bill.Lines); err != nil {
+			return err
+		}
+
+		number, err := numbering.Next(ctx, tx, bill.TenantID, numbering.SequenceBill, numbering.PrefixBill)
+		if err != nil {
+			return err
+		}
+
+		builder := tx.VendorBill.Create().
+			SetTenantID(bill.TenantID).
+			SetVendorID(bill.VendorID).
+			SetBillNumber(number).
+			SetVendorReference(bill.VendorReference).
+			SetBillDate(bill.BillDate).
+			SetDueDate(bill.DueDate).
+			SetCurrency(bill.Currency).
+			SetSubtotal(bill.Subtotal).
+			SetTaxAmount(bill.TaxAmount).
+			SetTotalAmount(bill.TotalAmount).
+			SetPaidAmount(decimal.Zero).
+			SetDiscountRate(bill.DiscountRate).
+			SetNillableDiscountUntil(bill.DiscountUntil).
+			SetDiscountTaken(decimal.Zero).
+			SetWithholdingAmount(bill.WithholdingAmount).
+			SetWithholdingTaken(decimal.Zero).
+			SetStatus(StatusDraft).
+			SetAttachments(bill.Attachments).
+			SetReferenceType(bill.ReferenceType).
+			SetReferenceID(bill.ReferenceID).
+			SetNotes(bill.Notes).
+			SetNillableCreatedBy(bill.CreatedBy)
+		if bill.PONumber != "" {
+			builder.SetPoNumber(bill.PONumber).SetMatchStatus(MatchAwaitingReceipt)
+		}
+		if bill.Metadata != nil {
+			builder.SetMetadata(bill.Metadata)
+		}
+
+		created, err = builder.Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return ErrDuplicateBill
+			}
+			return fmt.Errorf("create bill: %w", err)
+		}
+
+		lineBuilders := make([]*ent.VendorBillLineCreate, len(bill.Lines))
+		for i, line := range bill.Lines {
+			lineBuilder := tx.VendorBillLine.Create().
+				SetTenantID(bill.TenantID).
+				SetBill(created).
+				SetLineNumber(line.LineNumber).
+				SetItemCode(line.ItemCode).
+				SetDescription(line.Description).
+				SetQuantity(line.Quantity).
+				SetUnitPrice(line.UnitPrice).
+				SetAccountCode(line.AccountCode).
+				SetTaxCode(line.TaxCode).
+				SetTaxRate(line.TaxRate).
+				SetTaxAmount(line.TaxAmount).
+				SetLineTotal(line.LineTotal).
+				SetWithholdingCategory(line.WithholdingCategory).
+				SetWithholdingRate(line.WithholdingRate).
+				SetWithholdingAmount(line.WithholdingAmount)
+			if line.Metadata != nil {
+				lineBuilder.SetMetadata(line.Metadata)
+			}
+			lineBuilders[i] = lineBuilder
+		}
+
+		created.Edges.Lines, err = tx.VendorBillLine.CreateBulk(lineBuilders...).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create bill lines: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntBill(created), nil
+}
+
+// Get retrieves a bill with its lines.
+func (r *EntRepository) Get(ctx context.Context, tenantID, billID uuid.UUID) (*Bill, error) {
+	entBill, err := r.client.VendorBill.Query().
+		Where(
+			vendorbill.ID(billID),
+			vendorbill.TenantID(tenantID),
+		).
+		WithLines(func(q *ent.VendorBillLineQuery) {
+			q.Order(ent.Asc(vendorbillline.FieldLineNumber))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrBillNotFound
+		}
+		return nil, fmt.Errorf("get bill: %w", err)
+	}
+
+	return mapEntBill(entBill), nil
+}
+
+// List returns the tenant's bills ordered by due date.
+func (r *EntRepository) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Bill, error) {
+	query := r.client.VendorBill.Query().
+		Where(vendorbill.TenantID(tenantID))
+	if filter.Status != "" {
+		query = query.Where(vendorbill.Status(filter.Status))
+	}
+	if filter.VendorID != nil {
+		query = query.Where(vendorbill.VendorID(*filter.VendorID))
+	}
+	if filter.DueBefore != nil {
+		query = query.Where(vendorbill.DueDateLT(*filter.DueBefore))
+	}
+
+	entBills, err := query.
+		Order(ent.Asc(vendorbill.FieldDueDate), ent.Asc(vendorbill.FieldBillNumber)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list bills: %w", err)
+	}
+
+	bills := make([]*Bill, len(entBills))
+	for i, entBill := range entBills {
+		bills[i] = mapEntBill(entBill)
+	}
+	return bills, nil
+}
+
+// Approve recognises the liability (Dr expense or asset accounts and input
+// VAT / Cr accounts payable) and enqueues treasury.bill.approved.
+func (r *EntRepository) Approve(ctx context.Context, tenantID, billID uuid.UUID, approvedBy *uuid.UUID, now time.Time) (*Bill, error) {
+	var approved *ent.VendorBill
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entBill, err := lockBill(ctx, tx, tenantID, billID, StatusDraft)
+		if err != nil {
+			return err
+		}
+		if approvedBy != nil && entBill.CreatedBy == *approvedBy {
+			return ErrSelfApproval
+		}
+		if entBill.MatchStatus == MatchAwaitingReceipt || entBill.MatchStatus == MatchVariance {
+			return fmt.Errorf("%w: match status is %s", ErrMatchVariance, entBill.MatchStatus)
+		}
+
+		entLines, err := entBill.QueryLines().All(ctx)
+		if err != nil {
+			return fmt.Errorf("load bill lines: %w", err)
+		}
+		lines := make([]*Line, len(entLines))
+		for i, entLine := range entLines {
+			lines[i] = mapEntLine(entLine)
+		}
+
+		journalID, err := ledger.PostJournal(ctx, tx, ledger.Journal{
+			TenantID:      tenantID,
+			EntryDate:     entBill.BillDate,
+			Currency:      entBill.Currency,
+			ReferenceType: referenceTypeBill,
+			ReferenceID:   entBill.ID,
+			Description:   "Vendor bill " + entBill.BillNumber,
+			Lines:         approvalJournalLines(lines),
+		})
+		if err != nil {
+			return fmt.Errorf("post bill journal: %w", err)
+		}
+
+		approved, err = tx.VendorBill.UpdateOneID(entBill.ID).
+			SetStatus(StatusApproved).
+			SetNillableApprovedBy(approvedBy).
+			SetApprovedAt(now).
+			SetJournalEntryID(journalID).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("approve bill: %w", err)
+		}
+
+		return enqueueBillEvent(ctx, tx, approved, EventBillApproved, map[string]any{
+			"approved_at": now.UTC().Format(time.RFC3339),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, tenantID, approved.ID)
+}
+
+// Schedule sets the payment date of an approved or already scheduled bill.
+func (r *EntRepository) Schedule(ctx context.Context, tenantID, billID uuid.UUID, scheduledFor time.Time) (*Bill, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entBill, err := lockBill(ctx, tx, tenantID, billID, StatusApproved, StatusScheduled)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.VendorBill.UpdateOneID(entBill.ID).
+			SetStatus(StatusScheduled).
+			SetScheduledFor(scheduledFor).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("schedule bill: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, tenantID, billID)
+}
+
+// Pay settles the bill's balance (Dr accounts payable / Cr payment account)
+// and enqueues treasury.bill.paid.
+func (r *EntRepository) Pay(ctx context.Context, tenantID, billID uuid.UUID, req PayRequest, paidAt time.Time) (*Bill, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entBill, err := lockBill(ctx, tx, tenantID, billID, StatusApproved, StatusScheduled)
+		if err != nil {
+			return err
+		}
+
+		withheld := entBill.WithholdingAmount.Sub(entBill.WithholdingTaken)
+		return settle(ctx, tx, entBill, Settlement{
+			Amount:           OpenBalance(entBill).Sub(withheld),
+			Withholding:      withheld,
+			PaidAt:           paidAt,
+			PaymentAccount:   req.PaymentAccount,
+			PaymentReference: req.PaymentReference,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, tenantID, billID)
+}
+
+// Cancel voids a draft bill. Approved bills have posted to the ledger and
+// cannot be cancelled.
+func (r *EntRepository) Cancel(ctx context.Context, tenantID, billID uuid.UUID) (*Bill, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entBill, err := lockBill(ctx, tx, tenantID, billID, StatusDraft)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.VendorBill.UpdateOneID(entBill.ID).
+			SetStatus(StatusCancelled).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("cancel bill: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, tenantID, billID)
+}
+
+// Match records how a draft bill's lines compare with the goods received on
+// its purchase order, net of quantities billed on the order's other bills.
+// Bills whose variances were accepted are left as they are.
+func (r *EntRepository) Match(ctx context.Context, tenantID, billID uuid.UUID, settings *Settings, now time.Time) (*Bill, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entBill, err := lockBill(ctx, tx, tenantID, billID, StatusDraft)
+		if err != nil {
+			return err
+		}
+		if entBill.PoNumber == "" {
+			return fmt.Errorf("%w: bill has no po_number", ErrInvalidBill)
+		}
+		if entBill.MatchStatus == MatchAccepted {
+			return nil
+		}
+
+		entReceipts, err := tx.GoodsReceipt.Query().
+			Where(
+				goodsreceipt.TenantID(tenantID),
+				goodsreceipt.PoNumber(entBill.PoNumber),
+				goodsreceipt.Currency(entBill.Currency),
+				goodsreceipt.Or(
+					goodsreceipt.VendorIDIsNil(),
+					goodsreceipt.VendorID(entBill.VendorID),
+				),
+			).
+			WithLines().
+			Order(ent.Asc(goodsreceipt.FieldReceivedAt)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("load goods receipts: %w", err)
+		}
+
+		entLines, err := entBill.QueryLines().
+			Order(ent.Asc(vendorbillline.FieldLineNumber)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("load bill lines: %w", err)
+		}
+		lines := make([]*Line, len(entLines))
+		for i, entLine := range entLines {
+			lines[i] = mapEntLine(entLine)
+		}
+
+		status := MatchAwaitingReceipt
+		if len(entReceipts) > 0 {
+			billed, err := billedElsewhere(ctx, tx, entBill)
+			if err != nil {
+				return err
+			}
+			receipts := make([]*GoodsReceipt, len(entReceipts))
+			for i, entReceipt := range entReceipts {
+				receipts[i] = mapEntReceipt(entReceipt)
+			}
+			status = matchLines(lines, unbilledItems(receipts, billed), settings)
+		}
+
+		for _, line := range lines {
+			update := tx.VendorBillLine.UpdateOneID(line.ID).
+				SetMatchStatus(line.MatchStatus).
+				SetNillableReceivedQuantity(line.ReceivedQuantity).
+				SetNillableOrderUnitPrice(line.OrderUnitPrice)
+			if line.ReceivedQuantity == nil {
+				update.ClearReceivedQuantity().ClearOrderUnitPrice()
+			}
+			if err := update.Exec(ctx); err != nil {
+				return fmt.Errorf("update bill line match: %w", err)
+			}
+		}
+
+		if err := tx.VendorBill.UpdateOneID(entBill.ID).
+			SetMatchStatus(status).
+			SetMatchedAt(now).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("update bill match: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, tenantID, billID)
+}
+
+// AcceptVariance marks a draft bill's match variances as accepted so it can
+// be approved.
+func (r *EntRepository) AcceptVariance(ctx context.Context, tenantID, billID uuid.UUID, reason string, acceptedBy *uuid.UUID, now time.Time) (*Bill, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entBill, err := lockBill(ctx, tx, tenantID, billID, StatusDraft)
+		if err != nil {
+			return err
+		}
+		if entBill.MatchStatus != MatchVariance && entBill.MatchStatus != MatchAwaitingReceipt {
+			return fmt.Errorf("%w: bill has no match variances", ErrBillStatus)
+		}
+
+		if err := tx.VendorBill.UpdateOneID(entBill.ID).
+			SetMatchStatus(MatchAccepted).
+			SetNillableVarianceAcceptedBy(acceptedBy).
+			SetVarianceAcceptedAt(now).
+			SetVarianceReason(reason).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("accept bill variance: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, tenantID, billID)
+}
+
+// UnmatchedBills returns the draft bills on the purchase order still blocked
+// by matching.
+func (r *EntRepository) UnmatchedBills(ctx context.Context, tenantID uuid.UUID, poNumber string) ([]uuid.UUID, error) {
+	ids, err := r.client.VendorBill.Query().
+		Where(
+			vendorbill.TenantID(tenantID),
+			vendorbill.PoNumber(poNumber),
+			vendorbill.Status(StatusDraft),
+			vendorbill.MatchStatusIn(MatchAwaitingReceipt, MatchVariance),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list unmatched bills: %w", err)
+	}
+	return ids, nil
+}
+
+// RecordReceipt stores a goods receipt with its lines. A receipt already
+// recorded for the GRN returns false.
+func (r *EntRepository) RecordReceipt(ctx context.Context, receipt *GoodsReceipt) (bool, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		exists, err := tx.GoodsReceipt.Query().
+			Where(
+				goodsreceipt.TenantID(receipt.TenantID),
+				goodsreceipt.GrnID(receipt.GRNID),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check goods receipt: %w", err)
+		}
+		if exists {
+			return errReceiptRecorded
+		}
+
+		entReceipt, err := tx.GoodsReceipt.Create().
+			SetTenantID(receipt.TenantID).
+			SetEventID(receipt.EventID).
+			SetPoID(receipt.POID).
+			SetPoNumber(receipt.PONumber).
+			SetGrnID(receipt.GRNID).
+			SetGrnNumber(receipt.GRNNumber).
+			SetNillableVendorID(receipt.VendorID).
+			SetCurrency(receipt.Currency).
+			SetReceivedAt(receipt.ReceivedAt).
+			Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return errReceiptRecorded
+			}
+			return fmt.Errorf("create goods receipt: %w", err)
+		}
+
+		builders := make([]*ent.GoodsReceiptLineCreate, len(receipt.Lines))
+		for i, line := range receipt.Lines {
+			builders[i] = tx.GoodsReceiptLine.Create().
+				SetTenantID(receipt.TenantID).
+				SetReceipt(entReceipt).
+				SetLineNumber(line.LineNumber).
+				SetItemCode(line.ItemCode).
+				SetDescription(line.Description).
+				SetOrderedQuantity(line.OrderedQuantity).
+				SetReceivedQuantity(line.ReceivedQuantity).
+				SetUnitPrice(line.UnitPrice)
+		}
+		if _, err := tx.GoodsReceiptLine.CreateBulk(builders...).Save(ctx); err != nil {
+			return fmt.Errorf("create goods receipt lines: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, errReceiptRecorded) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ListReceipts returns goods receipts, newest first, optionally for one
+// purchase order.
+func (r *EntRepository) ListReceipts(ctx context.Context, tenantID uuid.UUID, poNumber string, limit, offset int) ([]*GoodsReceipt, error) {
+	query := r.client.GoodsReceipt.Query().
+		Where(goodsreceipt.TenantID(tenantID))
+	if poNumber != "" {
+		query = query.Where(goodsreceipt.PoNumber(poNumber))
+	}
+
+	entReceipts, err := query.
+		WithLines(func(q *ent.GoodsReceiptLineQuery) {
+			q.Order(ent.Asc(goodsreceiptline.FieldLineNumber))
+		}).
+		Order(ent.Desc(goodsreceipt.FieldReceivedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list goods receipts: %w", err)
+	}
+
+	receipts := make([]*GoodsReceipt, len(entReceipts))
+	for i, entReceipt := range entReceipts {
+		receipts[i] = mapEntReceipt(entReceipt)
+	}
+	return receipts, nil
+}
+
+// GetSettings returns the tenant's settings, or the defaults if none were saved.
+func (r *EntRepository) GetSettings(ctx context.Context, tenantID uuid.UUID) (*Settings, error) {
+	entSettings, err := r.client.PayableSetting.Query().
+		Where(payablesetting.TenantID(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DefaultSettings(tenantID), nil
+		}
+		return nil, fmt.Errorf("get payables settings: %w", err)
+	}
+
+	return mapEntSettings(entSettings), nil
+}
+
+// SaveSettings creates or replaces the tenant's settings.
+func (r *EntRepository) SaveSettings(ctx context.Context, settings *Settings) (*Settings, error) {
+	id, err := r.client.PayableSetting.Create().
+		SetTenantID(settings.TenantID).
+		SetQuantityTolerance(settings.QuantityTolerance).
+		SetPriceTolerance(settings.PriceTolerance).
+		OnConflictColumns(payablesetting.FieldTenantID).
+		UpdateNewValues().
+		ID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("save payables settings: %w", err)
+	}
+
+	entSettings, err := r.client.PayableSetting.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get payables settings: %w", err)
+	}
+
+	return mapEntSettings(entSettings), nil
+}
+
+// billedElsewhere totals, per item, the quantities on the purchase order's
+// other bills that were not cancelled.
+func billedElsewhere(ctx context.Context, tx *ent.Tx, entBill *ent.VendorBill) (map[string]decimal.Decimal, error) {
+	entLines, err := tx.VendorBillLine.Query().
+		Where(
+			vendorbillline.TenantID(entBill.TenantID),
+			vendorbillline.ItemCodeNEQ(""),
+			vendorbillline.HasBillWith(
+				vendorbill.PoNumber(entBill.PoNumber),
+				vendorbill.VendorID(entBill.VendorID),
+				vendorbill.IDNEQ(entBill.ID),
+				vendorbill.StatusNEQ(StatusCancelled),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load billed quantities: %w", err)
+	}
+
+	billed := map[string]decimal.Decimal{}
+	for _, entLine := range entLines {
+		billed[entLine.ItemCode] = billed[entLine.ItemCode].Add(entLine.Quantity)
+	}
+	return billed, nil
+}
+
+// lockBill locks a bill that is in one of the given statuses.
+func lockBill(ctx context.Context, tx *ent.Tx, tenantID, billID uuid.UUID, statuses ...string) (*ent.VendorBill, error) {
+	entBill, err := tx.VendorBill.Query().
+		Where(
+			vendorbill.ID(billID),
+			vendorbill.TenantID(tenantID),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrBillNotFound
+		}
+		return nil, fmt.Errorf("lock bill: %w", err)
+	}
+
+	for _, status := range statuses {
+		if entBill.Status == status {
+			return entBill, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: bill is %s", ErrBillStatus, entBill.Status)
+}
+
+// checkAccounts verifies every line is coded to an expense or asset account
+// in the tenant's chart. System accounts count as present as they are
+// provisioned on first posting.
+func checkAccounts(ctx context.Context, tx *ent.Tx, tenantID uuid.UUID, lines []*Line) error {
+	seen := map[string]bool{}
+	codes := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if !seen[line.AccountCode] {
+			seen[line.AccountCode] = true
+			codes = append(codes, line.AccountCode)
+		}
+	}
+	sort.Strings(codes)
+
+	entAccounts, err := tx.ChartOfAccount.Query().
+		Where(
+			chartofaccount.TenantID(tenantID),
+			chartofaccount.AccountCodeIn(codes...),
+			chartofaccount.IsActive(true),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("load bill accounts: %w", err)
+	}
+	types := make(map[string]string, len(entAccounts))
+	for _, entAccount := range entAccounts {
+		types[entAccount.AccountCode] = entAccount.AccountType
+	}
+
+	for _, code := range codes {
+		accountType, ok := types[code]
+		if !ok {
+			accountType, ok = ledger.SystemAccountType(code)
+		}
+		if !ok {
+			return fmt.Errorf("%w: account %s is not in the chart of accounts", ErrInvalidBill, code)
+		}
+		if !billableAccountTypes[accountType] {
+			return fmt.Errorf("%w: account %s is not an expense or asset account", ErrInvalidBill, code)
+		}
+	}
+	return nil
+}
+
+func enqueueBillEvent(ctx context.Context, tx *ent.Tx, entBill *ent.VendorBill, eventType string, payload map[string]any) error {
+	payload["bill_id"] = entBill.ID.String()
+	payload["bill_number"] = entBill.BillNumber
+	payload["vendor_id"] = entBill.VendorID.String()
+	payload["vendor_reference"] = entBill.VendorReference
+	payload["currency"] = entBill.Currency
+	payload["total_amount"] = entBill.TotalAmount.String()
+	payload["due_date"] = entBill.DueDate.Format(time.DateOnly)
+	if entBill.ReferenceID != "" {
+		payload["reference_type"] = entBill.ReferenceType
+		payload["reference_id"] = entBill.ReferenceID
+	}
+
+	return outbox.Enqueue(ctx, tx, outbox.Event{
+		TenantID:      entBill.TenantID,
+		AggregateType: "vendor_bill",
+		AggregateID:   entBill.ID,
+		EventType:     eventType,
+		Payload:       payload,
+	})
+}
+
+func mapEntBill(entBill *ent.VendorBill) *Bill {
+	b := &Bill{
+		ID:                entBill.ID,
+		TenantID:          entBill.TenantID,
+		VendorID:          entBill.VendorID,
+		BillNumber:        entBill.BillNumber,
+		VendorReference:   entBill.VendorReference,
+		BillDate:          entBill.BillDate,
+		DueDate:           entBill.DueDate,
+		Currency:          entBill.Currency,
+		Subtotal:          entBill.Subtotal,
+		TaxAmount:         entBill.TaxAmount,
+		TotalAmount:       entBill.TotalAmount,
+		PaidAmount:        entBill.PaidAmount,
+		DiscountRate:      entBill.DiscountRate,
+		DiscountTaken:     entBill.DiscountTaken,
+		WithholdingAmount: entBill.WithholdingAmount,
+		WithholdingTaken:  entBill.WithholdingTaken,
+		Status:            entBill.Status,
+		Attachments:       entBill.Attachments,
+		ReferenceType:     entBill.ReferenceType,
+		ReferenceID:       entBill.ReferenceID,
+		PONumber:          entBill.PoNumber,
+		MatchStatus:       entBill.MatchStatus,
+		VarianceReason:    entBill.VarianceReason,
+		Notes:             entBill.Notes,
+		Metadata:          entBill.Metadata,
+		CreatedAt:         entBill.CreatedAt,
+		UpdatedAt:         entBill.UpdatedAt,
+	}
+	if b.Attachments == nil {
+		b.Attachments = []string{}
+	}
+
+	// Optional fields - check for zero values
+	if entBill.CreatedBy != uuid.Nil {
+		b.CreatedBy = &entBill.CreatedBy
+	}
+	if entBill.ApprovedBy != uuid.Nil {
+		b.ApprovedBy = &entBill.ApprovedBy
+	}
+	if !entBill.ApprovedAt.IsZero() {
+		b.ApprovedAt = &entBill.ApprovedAt
+	}
+	if !entBill.ScheduledFor.IsZero() {
+		b.ScheduledFor = &entBill.ScheduledFor
+	}
+	if !entBill.PaidAt.IsZero() {
+		b.PaidAt = &entBill.PaidAt
+	}
+	if !entBill.DiscountUntil.IsZero() {
+		b.DiscountUntil = &entBill.DiscountUntil
+	}
+	if entBill.JournalEntryID != uuid.Nil {
+		b.JournalEntryID = &entBill.JournalEntryID
+	}
+	if !entBill.MatchedAt.IsZero() {
+		b.MatchedAt = &entBill.MatchedAt
+	}
+	if entBill.VarianceAcceptedBy != uuid.Nil {
+		b.VarianceAcceptedBy = &entBill.VarianceAcceptedBy
+	}
+	if !entBill.VarianceAcceptedAt.IsZero() {
+		b.VarianceAcceptedAt = &entBill.VarianceAcceptedAt
+	}
+
+	for _, entLine := range entBill.Edges.Lines {
+		b.Lines = append(b.Lines, mapEntLine(entLine))
+	}
+	return b
+}
+
+func mapEntLine(entLine *ent.VendorBillLine) *Line {
+	return &Line{
+		ID:                  entLine.ID,
+		LineNumber:          entLine.LineNumber,
+		ItemCode:            entLine.ItemCode,
+		Description:         entLine.Description,
+		Quantity:            entLine.Quantity,
+		UnitPrice:           entLine.UnitPrice,
+		AccountCode:         entLine.AccountCode,
+		TaxCode:             entLine.TaxCode,
+		TaxRate:             entLine.TaxRate,
+		TaxAmount:           entLine.TaxAmount,
+		LineTotal:           entLine.LineTotal,
+		WithholdingCategory: entLine.WithholdingCategory,
+		WithholdingRate:     entLine.WithholdingRate,
+		WithholdingAmount:   entLine.WithholdingAmount,
+		MatchStatus:         entLine.MatchStatus,
+		ReceivedQuantity:    entLine.ReceivedQuantity,
+		OrderUnitPrice:      entLine.OrderUnitPrice,
+		Metadata:            entLine.Metadata,
+	}
+}
+
+func mapEntReceipt(entReceipt *ent.GoodsReceipt) *GoodsReceipt {
+	receipt := &GoodsReceipt{
+		ID:         entReceipt.ID,
+		TenantID:   entReceipt.TenantID,
+		EventID:    entReceipt.EventID,
+		POID:       entReceipt.PoID,
+		PONumber:   entReceipt.PoNumber,
+		GRNID:      entReceipt.GrnID,
+		GRNNumber:  entReceipt.GrnNumber,
+		Currency:   entReceipt.Currency,
+		ReceivedAt: entReceipt.ReceivedAt,
+		Lines:      make([]*ReceiptLine, len(entReceipt.Edges.Lines)),
+		CreatedAt:  entReceipt.CreatedAt,
+	}
+	if entReceipt.VendorID != uuid.Nil {
+		receipt.VendorID = &entReceipt.VendorID
+	}
+
+	for i, entLine := range entReceipt.Edges.Lines {
+		receipt.Lines[i] = &ReceiptLine{
+			LineNumber:       entLine.LineNumber,
+			ItemCode:         entLine.ItemCode,
+			Description:      entLine.Description,
+			OrderedQuantity:  entLine.OrderedQuantity,
+			ReceivedQuantity: entLine.ReceivedQuantity,
+			UnitPrice:        entLine.UnitPrice,
+		}
+	}
+	return receipt
+}
+
+func mapEntSettings(entSettings *ent.PayableSetting) *Settings {
+	updatedAt := entSettings.UpdatedAt
+	return &Settings{
+		TenantID:          entSettings.TenantID,
+		QuantityTolerance: entSettings.QuantityTolerance,
+		PriceTolerance:    entSettings.PriceTolerance,
+		UpdatedAt:         &updatedAt,
+	}
+}
@@ -0,0 +1,413 @@
+package bills
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+	"github.com/bengobox/treasury-api/internal/modules/vendors"
+	"github.com/bengobox/treasury-api/internal/modules/withholding"
+	"github.com/bengobox/treasury-api/internal/platform/events"
+)
+
+// Service manages vendor bills through approval and payment.
+type Service struct {
+	repo        Repository
+	vendors     *vendors.Service
+	withholding *withholding.Service
+	logger      *zap.Logger
+}
+
+// NewService creates a new bills service.
+func NewService(repo Repository, vendorService *vendors.Service, withholdingService *withholding.Service, logger *zap.Logger) *Service {
+	return &Service{
+		repo:        repo,
+		vendors:     vendorService,
+		withholding: withholdingService,
+		logger:      logger,
+	}
+}
+
+// Create enters a draft bill for an active vendor, applying the vendor's
+// currency, payment terms, default expense account and withholding category
+// where not given and the vendor's early-payment discount. Bills
+// quoting a purchase order are matched to its goods receipts straight away.
+func (s *Service) Create(ctx context.Context, tenantID uuid.UUID, req CreateRequest, createdBy *uuid.UUID) (*Bill, error) {
+	if req.VendorID == uuid.Nil {
+		return nil, fmt.Errorf("%w: vendor_id is required", ErrInvalidBill)
+	}
+	req.ReferenceType = strings.TrimSpace(req.ReferenceType)
+	req.ReferenceID = strings.TrimSpace(req.ReferenceID)
+	if (req.ReferenceType == "") != (req.ReferenceID == "") {
+		return nil, fmt.Errorf("%w: reference_type and reference_id must be given together", ErrInvalidBill)
+	}
+
+	v, err := s.vendors.Get(ctx, tenantID, req.VendorID)
+	if err != nil {
+		return nil, err
+	}
+	if v.Status != vendors.StatusActive {
+		return nil, fmt.Errorf("%w: vendor %s is %s", ErrInvalidBill, v.VendorNumber, v.Status)
+	}
+
+	defaultAccount := v.DefaultExpenseAccount
+	if defaultAccount == "" {
+		defaultAccount = ledger.AccountGeneralExpenses
+	}
+	lines, subtotal, tax, err := buildLines(req.Lines, defaultAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := withholdingCategories(req.Lines, v.WithholdingCategory)
+	rates := map[string]decimal.Decimal{}
+	for _, category := range categories {
+		if _, ok := rates[category]; ok || category == "" {
+			continue
+		}
+		rate, err := s.withholding.ActiveRate(ctx, tenantID, category)
+		if err != nil {
+			if errors.Is(err, withholding.ErrRateNotFound) {
+				return nil, fmt.Errorf("%w: no active withholding rate for category %q", ErrInvalidBill, category)
+			}
+			return nil, err
+		}
+		rates[category] = rate.Rate
+	}
+	withheld := applyWithholding(lines, categories, rates)
+
+	billDate := time.Now().UTC().Truncate(24 * time.Hour)
+	if req.BillDate != nil {
+		billDate = *req.BillDate
+	}
+	dueDate := billDate.AddDate(0, 0, v.PaymentTermsDays)
+	if req.DueDate != nil {
+		dueDate = *req.DueDate
+	}
+	if dueDate.Before(billDate) {
+		return nil, fmt.Errorf("%w: due_date cannot be before bill_date", ErrInvalidBill)
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(req.Currency))
+	if currency == "" {
+		currency = v.DefaultCurrency
+	}
+	if len(currency) != 3 {
+		return nil, fmt.Errorf("%w: currency must be an ISO currency code", ErrInvalidBill)
+	}
+
+	// Early-payment terms are captured on the bill so later changes to the
+	// vendor do not alter discounts already offered.
+	var discountUntil *time.Time
+	if v.EarlyPaymentDiscount.IsPositive() {
+		until := billDate.AddDate(0, 0, v.EarlyPaymentDays)
+		discountUntil = &until
+	}
+
+	b, err := s.repo.Create(ctx, &Bill{
+		TenantID:          tenantID,
+		VendorID:          v.ID,
+		VendorReference:   strings.TrimSpace(req.VendorReference),
+		BillDate:          billDate,
+		DueDate:           dueDate,
+		Currency:          currency,
+		Subtotal:          subtotal,
+		TaxAmount:         tax,
+		TotalAmount:       subtotal.Add(tax),
+		DiscountRate:      v.EarlyPaymentDiscount,
+		DiscountUntil:     discountUntil,
+		WithholdingAmount: withheld,
+		Attachments:       req.Attachments,
+		ReferenceType:     req.ReferenceType,
+		ReferenceID:       req.ReferenceID,
+		PONumber:          strings.TrimSpace(req.PONumber),
+		Notes:             req.Notes,
+		CreatedBy:         createdBy,
+		Metadata:          req.Metadata,
+		Lines:             lines,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("vendor bill created",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("bill_id", b.ID.String()),
+		zap.String("bill_number", b.BillNumber),
+		zap.String("vendor_id", b.VendorID.String()),
+		zap.String("total", b.TotalAmount.String()),
+	)
+
+	if b.PONumber != "" {
+		return s.Match(ctx, tenantID, b.ID)
+	}
+	return b, nil
+}
+
+// Get retrieves a bill with its lines.
+func (s *Service) Get(ctx context.Context, tenantID, billID uuid.UUID) (*Bill, error) {
+	return s.repo.Get(ctx, tenantID, billID)
+}
+
+// List returns the tenant's bills.
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Bill, error) {
+	return s.repo.List(ctx, tenantID, filter)
+}
+
+// Approve posts a draft bill to accounts payable. The user who entered the
+// bill cannot approve it.
+func (s *Service) Approve(ctx context.Context, tenantID, billID uuid.UUID, approvedBy *uuid.UUID) (*Bill, error) {
+	b, err := s.repo.Approve(ctx, tenantID, billID, approvedBy, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("vendor bill approved",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("bill_id", b.ID.String()),
+		zap.String("bill_number", b.BillNumber),
+		zap.String("total", b.TotalAmount.String()),
+	)
+
+	return b, nil
+}
+
+// Schedule sets the date an approved bill will be paid.
+func (s *Service) Schedule(ctx context.Context, tenantID, billID uuid.UUID, req ScheduleRequest) (*Bill, error) {
+	if req.ScheduledFor.IsZero() {
+		return nil, fmt.Errorf("%w: scheduled_for is required", ErrInvalidBill)
+	}
+	return s.repo.Schedule(ctx, tenantID, billID, req.ScheduledFor)
+}
+
+// Pay records payment of an approved or scheduled bill from the payment
+// account, cash by default. The withholding tax on the bill is deducted and a
+// certificate issued for it.
+func (s *Service) Pay(ctx context.Context, tenantID, billID uuid.UUID, req PayRequest) (*Bill, error) {
+	req.PaymentAccount = strings.TrimSpace(req.PaymentAccount)
+	if req.PaymentAccount == "" {
+		req.PaymentAccount = ledger.AccountCash
+	}
+	if req.PaymentAccount == ledger.AccountPayable {
+		return nil, fmt.Errorf("%w: payment_account cannot be accounts payable", ErrInvalidBill)
+	}
+
+	paidAt := time.Now().UTC()
+	if req.PaidAt != nil {
+		paidAt = *req.PaidAt
+	}
+
+	b, err := s.repo.Pay(ctx, tenantID, billID, req, paidAt)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("vendor bill paid",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("bill_id", b.ID.String()),
+		zap.String("bill_number", b.BillNumber),
+		zap.String("payment_account", req.PaymentAccount),
+	)
+
+	return b, nil
+}
+
+// Cancel voids a draft bill.
+func (s *Service) Cancel(ctx context.Context, tenantID, billID uuid.UUID) (*Bill, error) {
+	return s.repo.Cancel(ctx, tenantID, billID)
+}
+
+// Match compares a draft bill quoting a purchase order with the goods received
+// on the order, within the tenant's tolerances.
+func (s *Service) Match(ctx context.Context, tenantID, billID uuid.UUID) (*Bill, error) {
+	settings, err := s.repo.GetSettings(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := s.repo.Match(ctx, tenantID, billID, settings, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	if b.MatchStatus != MatchMatched && b.MatchStatus != MatchAccepted {
+		s.logger.Info("vendor bill blocked by three-way match",
+			zap.String("tenant_id", tenantID.String()),
+			zap.String("bill_id", b.ID.String()),
+			zap.String("po_number", b.PONumber),
+			zap.String("match_status", b.MatchStatus),
+		)
+	}
+
+	return b, nil
+}
+
+// AcceptVariance lets a bill that does not match its purchase order receipts
+// be approved.
+func (s *Service) AcceptVariance(ctx context.Context, tenantID, billID uuid.UUID, req AcceptVarianceRequest, acceptedBy *uuid.UUID) (*Bill, error) {
+	req.Reason = strings.TrimSpace(req.Reason)
+	if req.Reason == "" {
+		return nil, fmt.Errorf("%w: reason is required", ErrInvalidBill)
+	}
+
+	b, err := s.repo.AcceptVariance(ctx, tenantID, billID, req.Reason, acceptedBy, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	fields := []zap.Field{
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("bill_id", b.ID.String()),
+		zap.String("reason", req.Reason),
+	}
+	if acceptedBy != nil {
+		fields = append(fields, zap.String("accepted_by", acceptedBy.String()))
+	}
+	s.logger.Info("vendor bill match variance accepted", fields...)
+
+	return b, nil
+}
+
+// ListReceipts returns the goods receipts recorded for matching.
+func (s *Service) ListReceipts(ctx context.Context, tenantID uuid.UUID, poNumber string, limit, offset int) ([]*GoodsReceipt, error) {
+	return s.repo.ListReceipts(ctx, tenantID, strings.TrimSpace(poNumber), limit, offset)
+}
+
+// HandlePOReceived consumes inventory.po.received: the goods receipt is
+// recorded once per GRN and the order's unmatched draft bills are matched
+// again. Malformed receipts fail permanently.
+func (s *Service) HandlePOReceived(ctx context.Context, env events.Envelope) error {
+	var data POReceived
+	if err := env.DecodeData(&data); err != nil {
+		return err
+	}
+
+	receipt, err := receiptFromEvent(env, data)
+	if err != nil {
+		return events.Permanent(err)
+	}
+
+	recorded, err := s.repo.RecordReceipt(ctx, receipt)
+	if err != nil {
+		return err
+	}
+	if recorded {
+		s.logger.Info("goods receipt recorded",
+			zap.String("tenant_id", receipt.TenantID.String()),
+			zap.String("po_number", receipt.PONumber),
+			zap.String("grn_id", receipt.GRNID),
+		)
+	}
+
+	billIDs, err := s.repo.UnmatchedBills(ctx, receipt.TenantID, receipt.PONumber)
+	if err != nil {
+		return err
+	}
+	for _, billID := range billIDs {
+		if _, err := s.Match(ctx, receipt.TenantID, billID); err != nil && !errors.Is(err, ErrBillStatus) {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSettings returns the tenant's accounts payable settings.
+func (s *Service) GetSettings(ctx context.Context, tenantID uuid.UUID) (*Settings, error) {
+	return s.repo.GetSettings(ctx, tenantID)
+}
+
+// UpdateSettings changes the tenant's matching tolerances.
+func (s *Service) UpdateSettings(ctx context.Context, tenantID uuid.UUID, req UpdateSettingsRequest) (*Settings, error) {
+	settings, err := s.repo.GetSettings(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.QuantityTolerance != nil {
+		settings.QuantityTolerance = *req.QuantityTolerance
+	}
+	if req.PriceTolerance != nil {
+		settings.PriceTolerance = *req.PriceTolerance
+	}
+
+	one := decimal.NewFromInt(1)
+	if settings.QuantityTolerance.IsNegative() || settings.QuantityTolerance.GreaterThan(one) {
+		return nil, fmt.Errorf("%w: quantity_tolerance must be a fraction between 0 and 1", ErrInvalidSettings)
+	}
+	if settings.PriceTolerance.IsNegative() || settings.PriceTolerance.GreaterThan(one) {
+		return nil, fmt.Errorf("%w: price_tolerance must be a fraction between 0 and 1", ErrInvalidSettings)
+	}
+
+	saved, err := s.repo.SaveSettings(ctx, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("payables settings updated",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("quantity_tolerance", saved.QuantityTolerance.String()),
+		zap.String("price_tolerance", saved.PriceTolerance.String()),
+	)
+
+	return saved, nil
+}
+
+func receiptFromEvent(env events.Envelope, data POReceived) (*GoodsReceipt, error) {
+	data.PONumber = strings.TrimSpace(data.PONumber)
+	data.GRNID = strings.TrimSpace(data.GRNID)
+	if data.PONumber == "" || data.GRNID == "" {
+		return nil, fmt.Errorf("%w: po_number and grn_id are required", ErrInvalidReceipt)
+	}
+	if len(data.Lines) == 0 {
+		return nil, fmt.Errorf("%w: at least one line is required", ErrInvalidReceipt)
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(data.Currency))
+	if currency == "" {
+		currency = "KES"
+	}
+	receivedAt := env.Timestamp
+	if data.ReceivedAt != nil {
+		receivedAt = *data.ReceivedAt
+	}
+	if receivedAt.IsZero() {
+		receivedAt = time.Now().UTC()
+	}
+
+	receipt := &GoodsReceipt{
+		TenantID:   env.TenantID,
+		EventID:    env.EventID,
+		POID:       data.POID,
+		PONumber:   data.PONumber,
+		GRNID:      data.GRNID,
+		GRNNumber:  data.GRNNumber,
+		VendorID:   data.VendorID,
+		Currency:   currency,
+		ReceivedAt: receivedAt,
+	}
+	for i, line := range data.Lines {
+		itemCode := strings.TrimSpace(line.ItemCode)
+		if itemCode == "" {
+			return nil, fmt.Errorf("%w: line %d item_code is required", ErrInvalidReceipt, i+1)
+		}
+		if line.ReceivedQuantity.IsNegative() || line.UnitPrice.IsNegative() {
+			return nil, fmt.Errorf("%w: line %d quantities and prices cannot be negative", ErrInvalidReceipt, i+1)
+		}
+		receipt.Lines = append(receipt.Lines, &ReceiptLine{
+			LineNumber:       i + 1,
+			ItemCode:         itemCode,
+			Description:      line.Description,
+			OrderedQuantity:  line.OrderedQuantity,
+			ReceivedQuantity: line.ReceivedQuantity,
+			UnitPrice:        line.UnitPrice,
+		})
+	}
+	return receipt, nil
+}
@@ -0,0 +1,205 @@
+package bills
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbillline"
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+	"github.com/bengobox/treasury-api/internal/modules/withholding"
+)
+
+// EarlyPaymentDiscount returns the discount earned by paying balance on
+// paidOn: the rate applied to the balance while paidOn falls on or before
+// until, zero afterwards or when no discount was offered.
+func EarlyPaymentDiscount(balance, rate decimal.Decimal, until, paidOn time.Time) decimal.Decimal {
+	if !rate.IsPositive() || !balance.IsPositive() || until.IsZero() {
+		return decimal.Zero
+	}
+	if paidOn.Truncate(24 * time.Hour).After(until.Truncate(24 * time.Hour)) {
+		return decimal.Zero
+	}
+	return balance.Mul(rate).Round(2)
+}
+
+// OpenBalance returns the amount still owed on a stored bill.
+func OpenBalance(entBill *ent.VendorBill) decimal.Decimal {
+	return entBill.TotalAmount.Sub(entBill.PaidAmount).Sub(entBill.DiscountTaken).Sub(entBill.WithholdingTaken)
+}
+
+// Settle pays a bill inside the caller's transaction, for callers such as
+// payment runs that settle several bills at once. The bill must be approved
+// or scheduled, the settlement must cover its balance exactly and it must
+// deduct the bill's withholding tax.
+func Settle(ctx context.Context, tx *ent.Tx, tenantID, billID uuid.UUID, s Settlement) error {
+	entBill, err := lockBill(ctx, tx, tenantID, billID, StatusApproved, StatusScheduled)
+	if err != nil {
+		return err
+	}
+
+	balance := OpenBalance(entBill)
+	if !s.Amount.Add(s.Discount).Add(s.Withholding).Equal(balance) {
+		return fmt.Errorf("%w: bill %s balance is %s", ErrBillStatus, entBill.BillNumber, balance)
+	}
+	if withheld := entBill.WithholdingAmount.Sub(entBill.WithholdingTaken); !s.Withholding.Equal(withheld) {
+		return fmt.Errorf("%w: bill %s withholding tax is %s", ErrBillStatus, entBill.BillNumber, withheld)
+	}
+	return settle(ctx, tx, entBill, s)
+}
+
+// settle posts the payment of a locked bill, marks it paid, issues the
+// withholding tax certificates and enqueues the paid event.
+func settle(ctx context.Context, tx *ent.Tx, entBill *ent.VendorBill, s Settlement) error {
+	if s.Discount.IsNegative() || s.Amount.IsNegative() || s.Withholding.IsNegative() {
+		return fmt.Errorf("%w: payment, discount and withholding cannot be negative", ErrInvalidBill)
+	}
+
+	metadata := map[string]any{"payment_reference": s.PaymentReference}
+	for k, v := range s.Metadata {
+		metadata[k] = v
+	}
+
+	if lines := settlementJournalLines(s); len(lines) > 0 {
+		if _, err := ledger.PostJournal(ctx, tx, ledger.Journal{
+			TenantID:      entBill.TenantID,
+			EntryDate:     s.PaidAt,
+			Currency:      entBill.Currency,
+			ReferenceType: referenceTypeBillPayment,
+			ReferenceID:   entBill.ID,
+			Description:   "Payment of vendor bill " + entBill.BillNumber,
+			Metadata:      metadata,
+			Lines:         lines,
+		}); err != nil {
+			if errors.Is(err, ledger.ErrAccountNotFound) {
+				return fmt.Errorf("%w: payment_account %s is not in the chart of accounts", ErrInvalidBill, s.PaymentAccount)
+			}
+			return fmt.Errorf("post bill payment journal: %w", err)
+		}
+	}
+
+	paid, err := tx.VendorBill.UpdateOneID(entBill.ID).
+		SetStatus(StatusPaid).
+		SetPaidAmount(entBill.PaidAmount.Add(s.Amount)).
+		SetDiscountTaken(entBill.DiscountTaken.Add(s.Discount)).
+		SetWithholdingTaken(entBill.WithholdingTaken.Add(s.Withholding)).
+		SetPaidAt(s.PaidAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("pay bill: %w", err)
+	}
+
+	var certificates []string
+	if s.Withholding.IsPositive() {
+		certificates, err = issueCertificates(ctx, tx, paid, s)
+		if err != nil {
+			return err
+		}
+	}
+
+	payload := map[string]any{
+		"paid_amount":       s.Amount.String(),
+		"paid_at":           s.PaidAt.UTC().Format(time.RFC3339),
+		"payment_account":   s.PaymentAccount,
+		"payment_reference": s.PaymentReference,
+	}
+	if s.Discount.IsPositive() {
+		payload["discount_taken"] = s.Discount.String()
+	}
+	if s.Withholding.IsPositive() {
+		payload["withholding_amount"] = s.Withholding.String()
+		payload["withholding_certificates"] = certificates
+	}
+	for k, v := range s.Metadata {
+		payload[k] = v
+	}
+	return enqueueBillEvent(ctx, tx, paid, EventBillPaid, payload)
+}
+
+// settlementJournalLines clears the bill's balance from accounts payable
+// against the payment account, crediting any discount taken to discounts
+// received and withholding tax deducted to withholding tax payable until it
+// is remitted. Nothing is posted when nothing is owed.
+func settlementJournalLines(s Settlement) []ledger.Line {
+	cleared := s.Amount.Add(s.Discount).Add(s.Withholding)
+	if !cleared.IsPositive() {
+		return nil
+	}
+
+	lines := []ledger.Line{ledger.Debit(ledger.AccountPayable, cleared)}
+	if s.Amount.IsPositive() {
+		lines = append(lines, ledger.Credit(s.PaymentAccount, s.Amount))
+	}
+	if s.Discount.IsPositive() {
+		lines = append(lines, ledger.Credit(ledger.AccountDiscountsReceived, s.Discount))
+	}
+	if s.Withholding.IsPositive() {
+		lines = append(lines, ledger.Credit(ledger.AccountWithholdingPayable, s.Withholding))
+	}
+	return lines
+}
+
+// issueCertificates issues a withholding tax certificate for each category
+// withheld on the bill's lines and returns their numbers.
+func issueCertificates(ctx context.Context, tx *ent.Tx, entBill *ent.VendorBill, s Settlement) ([]string, error) {
+	entLines, err := tx.VendorBillLine.Query().
+		Where(
+			vendorbillline.BillID(entBill.ID),
+			vendorbillline.WithholdingCategoryNEQ(""),
+		).
+		Order(ent.Asc(vendorbillline.FieldLineNumber)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load bill lines: %w", err)
+	}
+
+	var numbers []string
+	for _, d := range deductions(entLines) {
+		d.TenantID = entBill.TenantID
+		d.VendorID = entBill.VendorID
+		d.BillID = entBill.ID
+		d.BillNumber = entBill.BillNumber
+		d.VendorReference = entBill.VendorReference
+		d.Currency = entBill.Currency
+		d.PaymentDate = s.PaidAt
+		d.PaymentReference = s.PaymentReference
+
+		cert, err := withholding.Issue(ctx, tx, d)
+		if err != nil {
+			return nil, err
+		}
+		numbers = append(numbers, cert.CertificateNumber)
+	}
+	return numbers, nil
+}
+
+// deductions totals the withholding tax on the lines per category, in the
+// order the categories first appear.
+func deductions(entLines []*ent.VendorBillLine) []withholding.Deduction {
+	var result []withholding.Deduction
+	index := map[string]int{}
+	for _, entLine := range entLines {
+		if !entLine.WithholdingAmount.IsPositive() {
+			continue
+		}
+		i, ok := index[entLine.WithholdingCategory]
+		if !ok {
+			i = len(result)
+			index[entLine.WithholdingCategory] = i
+			result = append(result, withholding.Deduction{
+				Category:    entLine.WithholdingCategory,
+				Rate:        entLine.WithholdingRate,
+				GrossAmount: decimal.Zero,
+				TaxAmount:   decimal.Zero,
+			})
+		}
+		result[i].GrossAmount = result[i].GrossAmount.Add(entLine.LineTotal)
+		result[i].TaxAmount = result[i].TaxAmount.Add(entLine.WithholdingAmount)
+	}
+	return result
+}
@@ -0,0 +1,78 @@
+package bills
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+)
+
+func TestEarlyPaymentDiscount(t *testing.T) {
+	until := time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)
+
+	onLastDay := time.Date(2026, 3, 11, 16, 30, 0, 0, time.UTC)
+	if got := EarlyPaymentDiscount(amount("11600"), amount("0.02"), until, onLastDay); !got.Equal(amount("232")) {
+		t.Fatalf("expected 232 discount on the last day of the window, got %s", got)
+	}
+	if got := EarlyPaymentDiscount(amount("11600"), amount("0.02"), until, until.AddDate(0, 0, 1)); !got.IsZero() {
+		t.Fatalf("expected no discount after the window, got %s", got)
+	}
+	if got := EarlyPaymentDiscount(amount("11600"), amount("0"), until, until); !got.IsZero() {
+		t.Fatalf("expected no discount without a rate, got %s", got)
+	}
+	if got := EarlyPaymentDiscount(amount("333.33"), amount("0.025"), until, until); !got.Equal(amount("8.33")) {
+		t.Fatalf("expected discount rounded to cents, got %s", got)
+	}
+}
+
+func TestSettlementJournalLines(t *testing.T) {
+	lines := settlementJournalLines(Settlement{Amount: amount("11368"), Discount: amount("232"), PaymentAccount: "1010"})
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 journal lines, got %d", len(lines))
+	}
+	if lines[0].AccountCode != ledger.AccountPayable || !lines[0].Debit.Equal(amount("11600")) {
+		t.Fatalf("expected payables debited with the full balance, got %+v", lines[0])
+	}
+	if lines[1].AccountCode != "1010" || !lines[1].Credit.Equal(amount("11368")) {
+		t.Fatalf("expected payment account credited with the payment, got %+v", lines[1])
+	}
+	if lines[2].AccountCode != ledger.AccountDiscountsReceived || !lines[2].Credit.Equal(amount("232")) {
+		t.Fatalf("expected discounts received credited with the discount, got %+v", lines[2])
+	}
+
+	if lines := settlementJournalLines(Settlement{Amount: amount("500"), PaymentAccount: ledger.AccountCash}); len(lines) != 2 {
+		t.Fatalf("expected 2 journal lines without a discount, got %d", len(lines))
+	}
+}
+
+func TestSettlementJournalLinesWithholding(t *testing.T) {
+	lines := settlementJournalLines(Settlement{Amount: amount("108500"), Withholding: amount("7500"), PaymentAccount: ledger.AccountCash})
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 journal lines, got %d", len(lines))
+	}
+	if !lines[0].Debit.Equal(amount("116000")) {
+		t.Fatalf("expected payables debited with the full balance, got %+v", lines[0])
+	}
+	if lines[2].AccountCode != ledger.AccountWithholdingPayable || !lines[2].Credit.Equal(amount("7500")) {
+		t.Fatalf("expected withholding tax payable credited, got %+v", lines[2])
+	}
+}
+
+func TestDeductions(t *testing.T) {
+	got := deductions([]*ent.VendorBillLine{
+		{WithholdingCategory: "professional_fees", WithholdingRate: amount("0.05"), LineTotal: amount("100000"), WithholdingAmount: amount("5000")},
+		{WithholdingCategory: "contractual_fees", WithholdingRate: amount("0.03"), LineTotal: amount("20000"), WithholdingAmount: amount("600")},
+		{WithholdingCategory: "professional_fees", WithholdingRate: amount("0.05"), LineTotal: amount("50000"), WithholdingAmount: amount("2500")},
+		{WithholdingCategory: "contractual_fees", WithholdingRate: amount("0.03"), LineTotal: amount("0"), WithholdingAmount: amount("0")},
+	})
+	if len(got) != 2 || got[0].Category != "professional_fees" {
+		t.Fatalf("expected one deduction per category, got %+v", got)
+	}
+	if !got[0].GrossAmount.Equal(amount("150000")) || !got[0].TaxAmount.Equal(amount("7500")) {
+		t.Fatalf("unexpected professional fees deduction %+v", got[0])
+	}
+	if !got[1].TaxAmount.Equal(amount("600")) {
+		t.Fatalf("unexpected contractual fees deduction %+v", got[1])
+	}
+}
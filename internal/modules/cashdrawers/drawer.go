@@ -0,0 +1,101 @@
+package cashdrawers
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+)
+
+// Payment transaction types and provider counted in a drawer.
+const (
+	providerCash      = "cash"
+	transactionPay    = "payment"
+	transactionRefund = "refund"
+)
+
+// cashPayment is a succeeded cash payment transaction taken during a session.
+type cashPayment struct {
+	TransactionType string
+	Amount          decimal.Decimal
+	DrawerID        string
+}
+
+// tally sums the drawer's cash payments and refunds. Transactions of other
+// drawers, and other transaction types, are ignored.
+func tally(drawerID string, payments []cashPayment) (sales, refunds decimal.Decimal, n int) {
+	sales, refunds = decimal.Zero, decimal.Zero
+	for _, payment := range payments {
+		if payment.DrawerID != drawerID {
+			continue
+		}
+		switch payment.TransactionType {
+		case transactionPay:
+			sales = sales.Add(payment.Amount.Abs())
+		case transactionRefund:
+			refunds = refunds.Add(payment.Amount.Abs())
+		default:
+			continue
+		}
+		n++
+	}
+	return sales, refunds, n
+}
+
+// countCash validates the denomination counts and returns them with their
+// amounts.
+func countCash(counts []Count) ([]Count, error) {
+	if len(counts) == 0 {
+		return nil, fmt.Errorf("%w: counts are required", ErrInvalidCount)
+	}
+
+	seen := make(map[string]bool, len(counts))
+	counted := make([]Count, 0, len(counts))
+	for i, count := range counts {
+		if !count.Denomination.IsPositive() || !count.Denomination.Equal(count.Denomination.Round(2)) {
+			return nil, fmt.Errorf("%w: count %d: denomination must be positive with at most two decimal places", ErrInvalidCount, i+1)
+		}
+		if count.Quantity < 0 {
+			return nil, fmt.Errorf("%w: count %d: quantity cannot be negative", ErrInvalidCount, i+1)
+		}
+		key := count.Denomination.StringFixed(2)
+		if seen[key] {
+			return nil, fmt.Errorf("%w: denomination %s is counted twice", ErrInvalidCount, count.Denomination)
+		}
+		seen[key] = true
+
+		count.Amount = count.Denomination.Mul(decimal.NewFromInt(int64(count.Quantity)))
+		counted = append(counted, count)
+	}
+	return counted, nil
+}
+
+// review returns the status of a session with the given variance: variances
+// above the threshold either way wait for a supervisor.
+func review(variance, threshold decimal.Decimal) string {
+	switch {
+	case variance.IsZero():
+		return StatusBalanced
+	case variance.Abs().GreaterThan(threshold):
+		return StatusPendingApproval
+	default:
+		return StatusPosted
+	}
+}
+
+// varianceJournalLines posts cash over to, and cash short from, 6500 Cash
+// Over and Short against 1000 Cash.
+func varianceJournalLines(variance decimal.Decimal) []ledger.Line {
+	amount := variance.Abs()
+	if variance.IsPositive() {
+		return []ledger.Line{
+			ledger.Debit(ledger.AccountCash, amount),
+			ledger.Credit(ledger.AccountCashOverShort, amount),
+		}
+	}
+	return []ledger.Line{
+		ledger.Debit(ledger.AccountCashOverShort, amount),
+		ledger.Credit(ledger.AccountCash, amount),
+	}
+}
@@ -0,0 +1,96 @@
+package cashdrawers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+)
+
+func TestTally(t *testing.T) {
+	amount := func(v string) decimal.Decimal { return decimal.RequireFromString(v) }
+	payments := []cashPayment{
+		{TransactionType: transactionPay, Amount: amount("1200"), DrawerID: "drawer-1"},
+		{TransactionType: transactionPay, Amount: amount("350.50"), DrawerID: "drawer-1"},
+		{TransactionType: transactionRefund, Amount: amount("-200"), DrawerID: "drawer-1"},
+		{TransactionType: transactionPay, Amount: amount("999"), DrawerID: "drawer-2"},
+		{TransactionType: transactionPay, Amount: amount("50"), DrawerID: ""},
+		{TransactionType: "adjustment", Amount: amount("10"), DrawerID: "drawer-1"},
+	}
+
+	sales, refunds, n := tally("drawer-1", payments)
+	if !sales.Equal(amount("1550.50")) {
+		t.Errorf("sales = %s, want 1550.50", sales)
+	}
+	if !refunds.Equal(amount("200")) {
+		t.Errorf("refunds = %s, want 200", refunds)
+	}
+	if n != 3 {
+		t.Errorf("transactions = %d, want 3", n)
+	}
+}
+
+func TestCountCash(t *testing.T) {
+	counts, err := countCash([]Count{
+		{Denomination: decimal.NewFromInt(1000), Quantity: 3},
+		{Denomination: decimal.NewFromInt(50), Quantity: 7},
+		{Denomination: decimal.RequireFromString("0.50"), Quantity: 0},
+	})
+	if err != nil {
+		t.Fatalf("countCash: %v", err)
+	}
+	if !counts[0].Amount.Equal(decimal.NewFromInt(3000)) || !counts[1].Amount.Equal(decimal.NewFromInt(350)) || !counts[2].Amount.IsZero() {
+		t.Errorf("amounts = %s, %s, %s, want 3000, 350, 0", counts[0].Amount, counts[1].Amount, counts[2].Amount)
+	}
+
+	invalid := map[string][]Count{
+		"empty":          nil,
+		"zero":           {{Denomination: decimal.Zero, Quantity: 1}},
+		"fraction":       {{Denomination: decimal.RequireFromString("0.005"), Quantity: 1}},
+		"negative count": {{Denomination: decimal.NewFromInt(100), Quantity: -1}},
+		"duplicate":      {{Denomination: decimal.NewFromInt(100), Quantity: 1}, {Denomination: decimal.RequireFromString("100.00"), Quantity: 2}},
+	}
+	for name, counts := range invalid {
+		if _, err := countCash(counts); !errors.Is(err, ErrInvalidCount) {
+			t.Errorf("%s: err = %v, want ErrInvalidCount", name, err)
+		}
+	}
+}
+
+func TestReview(t *testing.T) {
+	threshold := decimal.NewFromInt(100)
+	cases := []struct {
+		variance string
+		want     string
+	}{
+		{"0", StatusBalanced},
+		{"100", StatusPosted},
+		{"-100", StatusPosted},
+		{"100.01", StatusPendingApproval},
+		{"-250", StatusPendingApproval},
+	}
+	for _, c := range cases {
+		if got := review(decimal.RequireFromString(c.variance), threshold); got != c.want {
+			t.Errorf("review(%s) = %s, want %s", c.variance, got, c.want)
+		}
+	}
+	if got := review(decimal.RequireFromString("0.01"), decimal.Zero); got != StatusPendingApproval {
+		t.Errorf("review at zero threshold = %s, want %s", got, StatusPendingApproval)
+	}
+}
+
+func TestVarianceJournalLines(t *testing.T) {
+	over := varianceJournalLines(decimal.NewFromInt(40))
+	if over[0].AccountCode != ledger.AccountCash || !over[0].Debit.Equal(decimal.NewFromInt(40)) ||
+		over[1].AccountCode != ledger.AccountCashOverShort || !over[1].Credit.Equal(decimal.NewFromInt(40)) {
+		t.Errorf("over lines = %+v, want Dr 1000 / Cr 6500 for 40", over)
+	}
+
+	short := varianceJournalLines(decimal.NewFromInt(-25))
+	if short[0].AccountCode != ledger.AccountCashOverShort || !short[0].Debit.Equal(decimal.NewFromInt(25)) ||
+		short[1].AccountCode != ledger.AccountCash || !short[1].Credit.Equal(decimal.NewFromInt(25)) {
+		t.Errorf("short lines = %+v, want Dr 6500 / Cr 1000 for 25", short)
+	}
+}
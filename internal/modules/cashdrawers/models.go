@@ -0,0 +1,122 @@
+package cashdrawers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Session statuses.
+const (
+	// StatusBalanced: the count matched the expected cash; nothing is posted.
+	StatusBalanced = "balanced"
+	// StatusPosted: the variance was within the approval threshold and posted.
+	StatusPosted          = "posted"
+	StatusPendingApproval = "pending_approval"
+	StatusApproved        = "approved"
+	// StatusRejected: a supervisor sent the drawer back to be recounted.
+	StatusRejected = "rejected"
+)
+
+// POS event consumed and cash drawer events published.
+const (
+	EventDrawerClosed     = "pos.cash.drawer.closed"
+	EventApprovalRequired = "treasury.cash_drawer.approval_required"
+	EventReconciled       = "treasury.cash_drawer.reconciled"
+)
+
+// Session is a POS cash drawer session from opening to close, with the cash
+// expected in the drawer and the cash counted.
+type Session struct {
+	ID               uuid.UUID       `json:"id"`
+	TenantID         uuid.UUID       `json:"tenant_id"`
+	POSSessionID     string          `json:"pos_session_id"`
+	DrawerID         string          `json:"drawer_id"`
+	OutletID         string          `json:"outlet_id,omitempty"`
+	CashierID        string          `json:"cashier_id,omitempty"`
+	Currency         string          `json:"currency"`
+	OpenedAt         time.Time       `json:"opened_at"`
+	ClosedAt         time.Time       `json:"closed_at"`
+	OpeningFloat     decimal.Decimal `json:"opening_float"`
+	CashSales        decimal.Decimal `json:"cash_sales"`
+	CashRefunds      decimal.Decimal `json:"cash_refunds"`
+	CashTransactions int             `json:"cash_transactions"`
+	ExpectedCash     decimal.Decimal `json:"expected_cash"`
+	CountedCash      decimal.Decimal `json:"counted_cash"`
+	Variance         decimal.Decimal `json:"variance"`
+	Status           string          `json:"status"` // balanced, posted, pending_approval, approved, rejected
+	Counts           []Count         `json:"counts,omitempty"`
+	Notes            string          `json:"notes,omitempty"`
+	RejectionReason  string          `json:"rejection_reason,omitempty"`
+	ReviewedBy       *uuid.UUID      `json:"reviewed_by,omitempty"`
+	ReviewedAt       *time.Time      `json:"reviewed_at,omitempty"`
+	JournalEntryID   *uuid.UUID      `json:"journal_entry_id,omitempty"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+}
+
+// Count is the number of notes or coins of one denomination in the drawer.
+type Count struct {
+	Denomination decimal.Decimal `json:"denomination"`
+	Quantity     int             `json:"quantity"`
+	Amount       decimal.Decimal `json:"amount"`
+}
+
+// DrawerClosed is the data of a pos.cash.drawer.closed event.
+type DrawerClosed struct {
+	SessionID    string          `json:"session_id"`
+	DrawerID     string          `json:"drawer_id"`
+	OutletID     string          `json:"outlet_id"`
+	CashierID    string          `json:"cashier_id"`
+	Currency     string          `json:"currency"`
+	OpenedAt     time.Time       `json:"opened_at"`
+	ClosedAt     time.Time       `json:"closed_at"`
+	OpeningFloat decimal.Decimal `json:"opening_float"`
+	Counts       []Count         `json:"counts"`
+	Notes        string          `json:"notes"`
+}
+
+// RecountRequest replaces the counts of a session awaiting approval or
+// rejected.
+type RecountRequest struct {
+	Counts []Count `json:"counts"`
+	Notes  string  `json:"notes"`
+}
+
+// RejectRequest sends a session back to be recounted.
+type RejectRequest struct {
+	Reason string `json:"reason"`
+}
+
+// Settings are a tenant's cash drawer reconciliation rules.
+type Settings struct {
+	TenantID          uuid.UUID       `json:"tenant_id"`
+	ApprovalThreshold decimal.Decimal `json:"approval_threshold"`
+	UpdatedAt         *time.Time      `json:"updated_at,omitempty"`
+}
+
+// DefaultSettings are used until a tenant saves its own: every variance
+// needs a supervisor's approval.
+func DefaultSettings(tenantID uuid.UUID) *Settings {
+	return &Settings{
+		TenantID:          tenantID,
+		ApprovalThreshold: decimal.Zero,
+	}
+}
+
+// UpdateSettingsRequest changes the provided settings fields.
+type UpdateSettingsRequest struct {
+	ApprovalThreshold *decimal.Decimal `json:"approval_threshold"`
+}
+
+// ListFilter narrows session listings.
+type ListFilter struct {
+	Status   string
+	DrawerID string
+	OutletID string
+	From     *time.Time
+	To       *time.Time
+	Limit    int
+	Offset   int
+}
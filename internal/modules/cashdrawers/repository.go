@@ -0,0 +1,45 @@
+package cashdrawers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrSessionNotFound is returned when a cash drawer session does not exist for the tenant.
+	ErrSessionNotFound = errors.New("cash drawer session not found")
+	// ErrInvalidSession is returned when a closed drawer session is malformed.
+	ErrInvalidSession = errors.New("invalid cash drawer session")
+	// ErrInvalidCount is returned when denomination counts are malformed.
+	ErrInvalidCount = errors.New("invalid cash count")
+	// ErrInvalidSettings is returned when cash drawer settings are out of range.
+	ErrInvalidSettings = errors.New("invalid cash drawer settings")
+	// ErrSessionStatus is returned when the session's status does not allow the action.
+	ErrSessionStatus = errors.New("cash drawer session status does not allow this action")
+	// ErrSelfApproval is returned when the cashier who ran a session tries to review its variance.
+	ErrSelfApproval = errors.New("variance must be reviewed by another user")
+)
+
+// Repository abstracts persistence for cash drawer sessions.
+type Repository interface {
+	// RecordSession reconciles a closed drawer session against the cash
+	// payments taken during it, posts a variance within the threshold and
+	// enqueues the session's event. Sessions already recorded return false.
+	RecordSession(ctx context.Context, session *Session, eventID string, threshold decimal.Decimal) (*Session, bool, error)
+	// Recount replaces the counts of a session awaiting approval or rejected
+	// and reconciles it again.
+	Recount(ctx context.Context, tenantID, sessionID uuid.UUID, counts []Count, notes string, threshold decimal.Decimal) (*Session, error)
+	// Approve posts the variance of a session awaiting approval.
+	Approve(ctx context.Context, tenantID, sessionID uuid.UUID, reviewedBy *uuid.UUID, now time.Time) (*Session, error)
+	// Reject sends a session awaiting approval back to be recounted.
+	Reject(ctx context.Context, tenantID, sessionID uuid.UUID, reason string, reviewedBy *uuid.UUID, now time.Time) (*Session, error)
+	Get(ctx context.Context, tenantID, sessionID uuid.UUID) (*Session, error)
+	List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Session, error)
+
+	GetSettings(ctx context.Context, tenantID uuid.UUID) (*Settings, error)
+	SaveSettings(ctx context.Context, settings *Settings) (*Settings, error)
+}
@@ -0,0 +1,556 @@
+package cashdrawers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawercount"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawersession"
+	"github.com/bengobox/treasury-api/internal/ent/cashdrawersetting"
+	"github.com/bengobox/treasury-api/internal/ent/paymenttransaction"
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+	"github.com/bengobox/treasury-api/internal/modules/outbox"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+)
+
+// errRecorded rolls back a session whose POS session was already recorded.
+var errRecorded = errors.New("cash drawer session already recorded")
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// RecordSession creates the session and reconciles it in one transaction, so
+// a redelivered close is recorded once.
+func (r *EntRepository) RecordSession(ctx context.Context, session *Session, eventID string, threshold decimal.Decimal) (*Session, bool, error) {
+	var sessionID uuid.UUID
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		exists, err := tx.CashDrawerSession.Query().
+			Where(
+				cashdrawersession.TenantID(session.TenantID),
+				cashdrawersession.PosSessionID(session.POSSessionID),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check cash drawer session: %w", err)
+		}
+		if exists {
+			return errRecorded
+		}
+
+		entSession, err := tx.CashDrawerSession.Create().
+			SetTenantID(session.TenantID).
+			SetPosSessionID(session.POSSessionID).
+			SetDrawerID(session.DrawerID).
+			SetOutletID(session.OutletID).
+			SetCashierID(session.CashierID).
+			SetCurrency(session.Currency).
+			SetOpenedAt(session.OpenedAt).
+			SetClosedAt(session.ClosedAt).
+			SetOpeningFloat(session.OpeningFloat).
+			SetCashSales(decimal.Zero).
+			SetCashRefunds(decimal.Zero).
+			SetExpectedCash(decimal.Zero).
+			SetCountedCash(decimal.Zero).
+			SetVariance(decimal.Zero).
+			SetEventID(eventID).
+			Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return errRecorded
+			}
+			return fmt.Errorf("create cash drawer session: %w", err)
+		}
+		sessionID = entSession.ID
+
+		return reconcile(ctx, tx, entSession, session.Counts, session.Notes, threshold)
+	})
+	if errors.Is(err, errRecorded) {
+		recorded, err := r.find(ctx, session.TenantID, session.POSSessionID)
+		return recorded, false, err
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	recorded, err := r.Get(ctx, session.TenantID, sessionID)
+	if err != nil {
+		return nil, false, err
+	}
+	return recorded, true, nil
+}
+
+// Recount reconciles the session again with the new counts, picking up cash
+// payments synced since the drawer was closed.
+func (r *EntRepository) Recount(ctx context.Context, tenantID, sessionID uuid.UUID, counts []Count, notes string, threshold decimal.Decimal) (*Session, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entSession, err := lockSession(ctx, tx, tenantID, sessionID, StatusPendingApproval, StatusRejected)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.CashDrawerCount.Delete().
+			Where(cashdrawercount.SessionID(entSession.ID)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("delete cash counts: %w", err)
+		}
+		return reconcile(ctx, tx, entSession, counts, notes, threshold)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, tenantID, sessionID)
+}
+
+// Approve posts the variance as counted.
+func (r *EntRepository) Approve(ctx context.Context, tenantID, sessionID uuid.UUID, reviewedBy *uuid.UUID, now time.Time) (*Session, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entSession, err := lockSession(ctx, tx, tenantID, sessionID, StatusPendingApproval)
+		if err != nil {
+			return err
+		}
+		if err := checkReviewer(entSession, reviewedBy); err != nil {
+			return err
+		}
+
+		journalID, err := postVariance(ctx, tx, entSession)
+		if err != nil {
+			return err
+		}
+
+		update := entSession.Update().
+			SetStatus(StatusApproved).
+			SetReviewedAt(now).
+			SetJournalEntryID(journalID)
+		if reviewedBy != nil {
+			update.SetReviewedBy(*reviewedBy)
+		}
+		approved, err := update.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("approve cash drawer session: %w", err)
+		}
+
+		return enqueueSession(ctx, tx, approved)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, tenantID, sessionID)
+}
+
+// Reject leaves the variance unposted until the drawer is recounted.
+func (r *EntRepository) Reject(ctx context.Context, tenantID, sessionID uuid.UUID, reason string, reviewedBy *uuid.UUID, now time.Time) (*Session, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entSession, err := lockSession(ctx, tx, tenantID, sessionID, StatusPendingApproval)
+		if err != nil {
+			return err
+		}
+		if err := checkReviewer(entSession, reviewedBy); err != nil {
+			return err
+		}
+
+		update := entSession.Update().
+			SetStatus(StatusRejected).
+			SetRejectionReason(reason).
+			SetReviewedAt(now)
+		if reviewedBy != nil {
+			update.SetReviewedBy(*reviewedBy)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("reject cash drawer session: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, tenantID, sessionID)
+}
+
+// Get retrieves a cash drawer session with its counts.
+func (r *EntRepository) Get(ctx context.Context, tenantID, sessionID uuid.UUID) (*Session, error) {
+	entSession, err := r.client.CashDrawerSession.Query().
+		Where(
+			cashdrawersession.ID(sessionID),
+			cashdrawersession.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("get cash drawer session: %w", err)
+	}
+
+	return r.withCounts(ctx, entSession)
+}
+
+// find retrieves the session recorded for a POS session.
+func (r *EntRepository) find(ctx context.Context, tenantID uuid.UUID, posSessionID string) (*Session, error) {
+	entSession, err := r.client.CashDrawerSession.Query().
+		Where(
+			cashdrawersession.TenantID(tenantID),
+			cashdrawersession.PosSessionID(posSessionID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("find cash drawer session: %w", err)
+	}
+
+	return r.withCounts(ctx, entSession)
+}
+
+// List returns the tenant's cash drawer sessions, most recently closed first.
+func (r *EntRepository) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Session, error) {
+	query := r.client.CashDrawerSession.Query().
+		Where(cashdrawersession.TenantID(tenantID))
+	if filter.Status != "" {
+		query = query.Where(cashdrawersession.Status(filter.Status))
+	}
+	if filter.DrawerID != "" {
+		query = query.Where(cashdrawersession.DrawerID(filter.DrawerID))
+	}
+	if filter.OutletID != "" {
+		query = query.Where(cashdrawersession.OutletID(filter.OutletID))
+	}
+	if filter.From != nil {
+		query = query.Where(cashdrawersession.ClosedAtGTE(*filter.From))
+	}
+	if filter.To != nil {
+		query = query.Where(cashdrawersession.ClosedAtLT(*filter.To))
+	}
+
+	entSessions, err := query.
+		Order(ent.Desc(cashdrawersession.FieldClosedAt)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list cash drawer sessions: %w", err)
+	}
+
+	sessions := make([]*Session, len(entSessions))
+	for i, entSession := range entSessions {
+		sessions[i] = mapEntSession(entSession)
+	}
+	return sessions, nil
+}
+
+// GetSettings returns the tenant's settings, or the defaults if none are saved.
+func (r *EntRepository) GetSettings(ctx context.Context, tenantID uuid.UUID) (*Settings, error) {
+	entSettings, err := r.client.CashDrawerSetting.Query().
+		Where(cashdrawersetting.TenantID(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DefaultSettings(tenantID), nil
+		}
+		return nil, fmt.Errorf("get cash drawer settings: %w", err)
+	}
+
+	return mapEntSettings(entSettings), nil
+}
+
+// SaveSettings creates or replaces the tenant's settings.
+func (r *EntRepository) SaveSettings(ctx context.Context, settings *Settings) (*Settings, error) {
+	id, err := r.client.CashDrawerSetting.Create().
+		SetTenantID(settings.TenantID).
+		SetApprovalThreshold(settings.ApprovalThreshold).
+		OnConflictColumns(cashdrawersetting.FieldTenantID).
+		UpdateNewValues().
+		ID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("save cash drawer settings: %w", err)
+	}
+
+	entSettings, err := r.client.CashDrawerSetting.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get cash drawer settings: %w", err)
+	}
+
+	return mapEntSettings(entSettings), nil
+}
+
+// reconcile records the counts, compares them with the opening float plus the
+// drawer's cash payments less its cash refunds, and posts a variance within
+// the threshold straight away.
+func reconcile(ctx context.Context, tx *ent.Tx, entSession *ent.CashDrawerSession, counts []Count, notes string, threshold decimal.Decimal) error {
+	payments, err := cashPayments(ctx, tx, entSession)
+	if err != nil {
+		return err
+	}
+	sales, refunds, n := tally(entSession.DrawerID, payments)
+	expected := entSession.OpeningFloat.Add(sales).Sub(refunds)
+
+	counted := decimal.Zero
+	builders := make([]*ent.CashDrawerCountCreate, len(counts))
+	for i, count := range counts {
+		counted = counted.Add(count.Amount)
+		builders[i] = tx.CashDrawerCount.Create().
+			SetTenantID(entSession.TenantID).
+			SetSessionID(entSession.ID).
+			SetDenomination(count.Denomination).
+			SetQuantity(count.Quantity).
+			SetAmount(count.Amount)
+	}
+	if err := tx.CashDrawerCount.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("create cash counts: %w", err)
+	}
+
+	variance := counted.Sub(expected)
+	status := review(variance, threshold)
+	entSession, err = entSession.Update().
+		SetCashSales(sales).
+		SetCashRefunds(refunds).
+		SetCashTransactions(n).
+		SetExpectedCash(expected).
+		SetCountedCash(counted).
+		SetVariance(variance).
+		SetStatus(status).
+		SetNotes(notes).
+		ClearRejectionReason().
+		ClearReviewedBy().
+		ClearReviewedAt().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update cash drawer session: %w", err)
+	}
+
+	if status == StatusPosted {
+		journalID, err := postVariance(ctx, tx, entSession)
+		if err != nil {
+			return err
+		}
+		entSession, err = entSession.Update().
+			SetJournalEntryID(journalID).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("update cash drawer session: %w", err)
+		}
+	}
+
+	return enqueueSession(ctx, tx, entSession)
+}
+
+// cashPayments loads the tenant's succeeded cash payments and refunds
+// processed while the drawer was open, with the drawer each was taken at.
+func cashPayments(ctx context.Context, tx *ent.Tx, entSession *ent.CashDrawerSession) ([]cashPayment, error) {
+	entPayments, err := tx.PaymentTransaction.Query().
+		Where(
+			paymenttransaction.TenantID(entSession.TenantID),
+			paymenttransaction.Provider(providerCash),
+			paymenttransaction.Status("succeeded"),
+			paymenttransaction.Currency(entSession.Currency),
+			paymenttransaction.TransactionTypeIn(transactionPay, transactionRefund),
+			paymenttransaction.Or(
+				paymenttransaction.And(
+					paymenttransaction.ProcessedAtGTE(entSession.OpenedAt),
+					paymenttransaction.ProcessedAtLTE(entSession.ClosedAt),
+				),
+				paymenttransaction.And(
+					paymenttransaction.ProcessedAtIsNil(),
+					paymenttransaction.CreatedAtGTE(entSession.OpenedAt),
+					paymenttransaction.CreatedAtLTE(entSession.ClosedAt),
+				),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load cash payments: %w", err)
+	}
+
+	payments := make([]cashPayment, len(entPayments))
+	for i, entPayment := range entPayments {
+		drawerID, _ := entPayment.Metadata["drawer_id"].(string)
+		payments[i] = cashPayment{
+			TransactionType: entPayment.TransactionType,
+			Amount:          entPayment.Amount,
+			DrawerID:        drawerID,
+		}
+	}
+	return payments, nil
+}
+
+// postVariance posts the session's over or short on the day the drawer was
+// closed.
+func postVariance(ctx context.Context, tx *ent.Tx, entSession *ent.CashDrawerSession) (uuid.UUID, error) {
+	direction := "short"
+	if entSession.Variance.IsPositive() {
+		direction = "over"
+	}
+
+	journalID, err := ledger.PostJournal(ctx, tx, ledger.Journal{
+		TenantID:      entSession.TenantID,
+		EntryDate:     entSession.ClosedAt,
+		Currency:      entSession.Currency,
+		ReferenceType: "cash_drawer_session",
+		ReferenceID:   entSession.ID,
+		Description:   fmt.Sprintf("Cash %s: drawer %s session %s", direction, entSession.DrawerID, entSession.PosSessionID),
+		Metadata: map[string]any{
+			"pos_session_id": entSession.PosSessionID,
+			"drawer_id":      entSession.DrawerID,
+			"outlet_id":      entSession.OutletID,
+			"expected_cash":  entSession.ExpectedCash.String(),
+			"counted_cash":   entSession.CountedCash.String(),
+		},
+		Lines: varianceJournalLines(entSession.Variance),
+	})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("post cash variance journal: %w", err)
+	}
+	return journalID, nil
+}
+
+// enqueueSession tells the POS service a supervisor must review the session,
+// or that it is reconciled.
+func enqueueSession(ctx context.Context, tx *ent.Tx, entSession *ent.CashDrawerSession) error {
+	eventType := EventReconciled
+	if entSession.Status == StatusPendingApproval {
+		eventType = EventApprovalRequired
+	}
+
+	payload := map[string]any{
+		"cash_drawer_session_id": entSession.ID.String(),
+		"pos_session_id":         entSession.PosSessionID,
+		"drawer_id":              entSession.DrawerID,
+		"outlet_id":              entSession.OutletID,
+		"cashier_id":             entSession.CashierID,
+		"currency":               entSession.Currency,
+		"opening_float":          entSession.OpeningFloat.String(),
+		"cash_sales":             entSession.CashSales.String(),
+		"cash_refunds":           entSession.CashRefunds.String(),
+		"expected_cash":          entSession.ExpectedCash.String(),
+		"counted_cash":           entSession.CountedCash.String(),
+		"variance":               entSession.Variance.String(),
+		"status":                 entSession.Status,
+		"closed_at":              entSession.ClosedAt.UTC().Format(time.RFC3339),
+	}
+	if entSession.JournalEntryID != uuid.Nil {
+		payload["journal_entry_id"] = entSession.JournalEntryID.String()
+	}
+
+	return outbox.Enqueue(ctx, tx, outbox.Event{
+		TenantID:      entSession.TenantID,
+		AggregateType: "cash_drawer_session",
+		AggregateID:   entSession.ID,
+		EventType:     eventType,
+		Payload:       payload,
+	})
+}
+
+// lockSession locks a session in one of the given statuses.
+func lockSession(ctx context.Context, tx *ent.Tx, tenantID, sessionID uuid.UUID, statuses ...string) (*ent.CashDrawerSession, error) {
+	entSession, err := tx.CashDrawerSession.Query().
+		Where(
+			cashdrawersession.ID(sessionID),
+			cashdrawersession.TenantID(tenantID),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("lock cash drawer session: %w", err)
+	}
+	for _, status := range statuses {
+		if entSession.Status == status {
+			return entSession, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: session is %s", ErrSessionStatus, entSession.Status)
+}
+
+// checkReviewer refuses a review by the cashier who ran the session.
+func checkReviewer(entSession *ent.CashDrawerSession, reviewedBy *uuid.UUID) error {
+	if reviewedBy != nil && entSession.CashierID == reviewedBy.String() {
+		return ErrSelfApproval
+	}
+	return nil
+}
+
+func (r *EntRepository) withCounts(ctx context.Context, entSession *ent.CashDrawerSession) (*Session, error) {
+	entCounts, err := r.client.CashDrawerCount.Query().
+		Where(cashdrawercount.SessionID(entSession.ID)).
+		Order(ent.Desc(cashdrawercount.FieldDenomination)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load cash counts: %w", err)
+	}
+
+	session := mapEntSession(entSession)
+	session.Counts = make([]Count, len(entCounts))
+	for i, entCount := range entCounts {
+		session.Counts[i] = Count{
+			Denomination: entCount.Denomination,
+			Quantity:     entCount.Quantity,
+			Amount:       entCount.Amount,
+		}
+	}
+	return session, nil
+}
+
+func mapEntSession(entSession *ent.CashDrawerSession) *Session {
+	session := &Session{
+		ID:               entSession.ID,
+		TenantID:         entSession.TenantID,
+		POSSessionID:     entSession.PosSessionID,
+		DrawerID:         entSession.DrawerID,
+		OutletID:         entSession.OutletID,
+		CashierID:        entSession.CashierID,
+		Currency:         entSession.Currency,
+		OpenedAt:         entSession.OpenedAt,
+		ClosedAt:         entSession.ClosedAt,
+		OpeningFloat:     entSession.OpeningFloat,
+		CashSales:        entSession.CashSales,
+		CashRefunds:      entSession.CashRefunds,
+		CashTransactions: entSession.CashTransactions,
+		ExpectedCash:     entSession.ExpectedCash,
+		CountedCash:      entSession.CountedCash,
+		Variance:         entSession.Variance,
+		Status:           entSession.Status,
+		Notes:            entSession.Notes,
+		RejectionReason:  entSession.RejectionReason,
+		CreatedAt:        entSession.CreatedAt,
+		UpdatedAt:        entSession.UpdatedAt,
+	}
+
+	// Optional fields - check for zero values
+	if entSession.ReviewedBy != uuid.Nil {
+		session.ReviewedBy = &entSession.ReviewedBy
+	}
+	if !entSession.ReviewedAt.IsZero() {
+		session.ReviewedAt = &entSession.ReviewedAt
+	}
+	if entSession.JournalEntryID != uuid.Nil {
+		session.JournalEntryID = &entSession.JournalEntryID
+	}
+	return session
+}
+
+func mapEntSettings(entSettings *ent.CashDrawerSetting) *Settings {
+	updatedAt := entSettings.UpdatedAt
+	return &Settings{
+		TenantID:          entSettings.TenantID,
+		ApprovalThreshold: entSettings.ApprovalThreshold,
+		UpdatedAt:         &updatedAt,
+	}
+}
@@ -0,0 +1,198 @@
+package cashdrawers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/platform/events"
+)
+
+// Service reconciles POS cash drawer sessions: the opening float plus cash
+// taken less cash refunded is compared with the cash counted, and the over
+// or short is posted once within the tenant's threshold or approved by a
+// supervisor.
+type Service struct {
+	repo   Repository
+	logger *zap.Logger
+}
+
+// NewService creates a new cash drawers service.
+func NewService(repo Repository, logger *zap.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// HandleDrawerClosed consumes pos.cash.drawer.closed, reconciling the
+// session. Redelivered closes are ignored.
+func (s *Service) HandleDrawerClosed(ctx context.Context, env events.Envelope) error {
+	var data DrawerClosed
+	if err := env.DecodeData(&data); err != nil {
+		return err
+	}
+
+	session, err := sessionFromEvent(env, data)
+	if err != nil {
+		return events.Permanent(err)
+	}
+
+	settings, err := s.repo.GetSettings(ctx, env.TenantID)
+	if err != nil {
+		return err
+	}
+
+	recorded, created, err := s.repo.RecordSession(ctx, session, env.EventID, settings.ApprovalThreshold)
+	if err != nil {
+		return err
+	}
+	if created {
+		s.logger.Info("cash drawer session reconciled",
+			zap.String("tenant_id", recorded.TenantID.String()),
+			zap.String("pos_session_id", recorded.POSSessionID),
+			zap.String("drawer_id", recorded.DrawerID),
+			zap.String("expected_cash", recorded.ExpectedCash.String()),
+			zap.String("counted_cash", recorded.CountedCash.String()),
+			zap.String("variance", recorded.Variance.String()),
+			zap.String("status", recorded.Status),
+		)
+	}
+	return nil
+}
+
+// Recount replaces the counts of a session awaiting approval or rejected, and
+// reconciles it again against the current threshold.
+func (s *Service) Recount(ctx context.Context, tenantID, sessionID uuid.UUID, req RecountRequest) (*Session, error) {
+	counts, err := countCash(req.Counts)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.repo.GetSettings(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.repo.Recount(ctx, tenantID, sessionID, counts, strings.TrimSpace(req.Notes), settings.ApprovalThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("cash drawer session recounted",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("session_id", sessionID.String()),
+		zap.String("variance", session.Variance.String()),
+		zap.String("status", session.Status),
+	)
+	return session, nil
+}
+
+// Approve posts the variance of a session awaiting a supervisor.
+func (s *Service) Approve(ctx context.Context, tenantID, sessionID uuid.UUID, reviewedBy *uuid.UUID) (*Session, error) {
+	session, err := s.repo.Approve(ctx, tenantID, sessionID, reviewedBy, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("cash drawer variance approved",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("session_id", sessionID.String()),
+		zap.String("variance", session.Variance.String()),
+	)
+	return session, nil
+}
+
+// Reject sends a session awaiting a supervisor back to be recounted.
+func (s *Service) Reject(ctx context.Context, tenantID, sessionID uuid.UUID, req RejectRequest, reviewedBy *uuid.UUID) (*Session, error) {
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, fmt.Errorf("%w: reason is required", ErrInvalidSession)
+	}
+
+	session, err := s.repo.Reject(ctx, tenantID, sessionID, reason, reviewedBy, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("cash drawer variance rejected",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("session_id", sessionID.String()),
+		zap.String("reason", reason),
+	)
+	return session, nil
+}
+
+// Get retrieves a cash drawer session with its counts.
+func (s *Service) Get(ctx context.Context, tenantID, sessionID uuid.UUID) (*Session, error) {
+	return s.repo.Get(ctx, tenantID, sessionID)
+}
+
+// List returns the tenant's cash drawer sessions.
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Session, error) {
+	return s.repo.List(ctx, tenantID, filter)
+}
+
+// GetSettings returns the tenant's approval threshold.
+func (s *Service) GetSettings(ctx context.Context, tenantID uuid.UUID) (*Settings, error) {
+	return s.repo.GetSettings(ctx, tenantID)
+}
+
+// UpdateSettings changes the tenant's approval threshold. It applies to
+// sessions closed or recounted afterwards.
+func (s *Service) UpdateSettings(ctx context.Context, tenantID uuid.UUID, req UpdateSettingsRequest) (*Settings, error) {
+	settings, err := s.repo.GetSettings(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ApprovalThreshold != nil {
+		settings.ApprovalThreshold = *req.ApprovalThreshold
+	}
+	if settings.ApprovalThreshold.IsNegative() {
+		return nil, fmt.Errorf("%w: approval_threshold cannot be negative", ErrInvalidSettings)
+	}
+
+	return s.repo.SaveSettings(ctx, settings)
+}
+
+func sessionFromEvent(env events.Envelope, data DrawerClosed) (*Session, error) {
+	data.SessionID = strings.TrimSpace(data.SessionID)
+	data.DrawerID = strings.TrimSpace(data.DrawerID)
+	if data.SessionID == "" || data.DrawerID == "" {
+		return nil, fmt.Errorf("%w: session_id and drawer_id are required", ErrInvalidSession)
+	}
+	if data.OpenedAt.IsZero() || data.ClosedAt.IsZero() || data.ClosedAt.Before(data.OpenedAt) {
+		return nil, fmt.Errorf("%w: closed_at must not be before opened_at", ErrInvalidSession)
+	}
+	if data.OpeningFloat.IsNegative() {
+		return nil, fmt.Errorf("%w: opening_float cannot be negative", ErrInvalidSession)
+	}
+	currency := strings.ToUpper(strings.TrimSpace(data.Currency))
+	if currency == "" {
+		currency = "KES"
+	}
+
+	counts, err := countCash(data.Counts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Session{
+		TenantID:     env.TenantID,
+		POSSessionID: data.SessionID,
+		DrawerID:     data.DrawerID,
+		OutletID:     strings.TrimSpace(data.OutletID),
+		CashierID:    strings.TrimSpace(data.CashierID),
+		Currency:     currency,
+		OpenedAt:     data.OpenedAt,
+		ClosedAt:     data.ClosedAt,
+		OpeningFloat: data.OpeningFloat,
+		Counts:       counts,
+		Notes:        strings.TrimSpace(data.Notes),
+	}, nil
+}
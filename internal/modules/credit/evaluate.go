@@ -0,0 +1,55 @@
+package credit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// evaluate decides whether a sale on account may proceed. A credit hold blocks
+// every sale; the credit limit applies to sales in the customer's default
+// currency, where the sale must fit within the available credit.
+func evaluate(status *Status, currency string, amount decimal.Decimal) error {
+	if status.OnHold {
+		if status.HoldReason != "" {
+			return fmt.Errorf("%w: %s", ErrCreditHold, status.HoldReason)
+		}
+		return ErrCreditHold
+	}
+	if status.Available == nil || currency != status.Currency {
+		return nil
+	}
+	if amount.GreaterThan(*status.Available) {
+		return fmt.Errorf("%w: %s %s requested, %s available", ErrCreditLimitExceeded, currency, amount.StringFixed(2), status.Available.StringFixed(2))
+	}
+	return nil
+}
+
+// available returns the credit left under a limit. A balance in the
+// customer's favour does not increase it beyond the limit.
+func available(limit *decimal.Decimal, exposure decimal.Decimal) *decimal.Decimal {
+	if limit == nil {
+		return nil
+	}
+	left := limit.Sub(decimal.Max(exposure, decimal.Zero))
+	return &left
+}
+
+// overdueDays returns the whole days between the oldest overdue due date and
+// today.
+func overdueDays(oldestDue *time.Time, today time.Time) int {
+	if oldestDue == nil {
+		return 0
+	}
+	days := int(startOfDay(today).Sub(startOfDay(*oldestDue)).Hours() / 24)
+	if days < 0 {
+		return 0
+	}
+	return days
+}
+
+func startOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
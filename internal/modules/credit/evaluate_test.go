@@ -0,0 +1,67 @@
+package credit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func amount(v string) decimal.Decimal {
+	return decimal.RequireFromString(v)
+}
+
+func TestEvaluate(t *testing.T) {
+	limit := amount("50000")
+	status := &Status{
+		Currency:    "KES",
+		CreditLimit: &limit,
+		Exposure:    amount("42000"),
+		Available:   available(&limit, amount("42000")),
+	}
+
+	if err := evaluate(status, "KES", amount("8000")); err != nil {
+		t.Fatalf("expected sale within available credit to pass, got %v", err)
+	}
+	if err := evaluate(status, "KES", amount("8000.01")); !errors.Is(err, ErrCreditLimitExceeded) {
+		t.Fatalf("expected ErrCreditLimitExceeded, got %v", err)
+	}
+	if err := evaluate(status, "USD", amount("100000")); err != nil {
+		t.Fatalf("expected limit to apply to the default currency only, got %v", err)
+	}
+
+	status.OnHold = true
+	status.HoldReason = "invoices more than 30 days overdue"
+	if err := evaluate(status, "USD", amount("1")); !errors.Is(err, ErrCreditHold) {
+		t.Fatalf("expected hold to block every sale, got %v", err)
+	}
+}
+
+func TestEvaluateWithoutLimit(t *testing.T) {
+	status := &Status{Currency: "KES", Exposure: amount("1000000")}
+	if err := evaluate(status, "KES", amount("500000")); err != nil {
+		t.Fatalf("expected customer without a limit to pass, got %v", err)
+	}
+}
+
+func TestAvailableIgnoresCreditBalance(t *testing.T) {
+	limit := amount("10000")
+	if got := available(&limit, amount("-2500")); !got.Equal(limit) {
+		t.Fatalf("expected credit balance not to raise available credit, got %s", got)
+	}
+	if got := available(&limit, amount("12500")); !got.Equal(amount("-2500")) {
+		t.Fatalf("expected negative available credit when over limit, got %s", got)
+	}
+}
+
+func TestOverdueDays(t *testing.T) {
+	today := time.Date(2026, 3, 31, 15, 0, 0, 0, time.UTC)
+	due := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	if got := overdueDays(&due, today); got != 30 {
+		t.Fatalf("expected 30 days overdue, got %d", got)
+	}
+	if got := overdueDays(nil, today); got != 0 {
+		t.Fatalf("expected 0 days without overdue invoices, got %d", got)
+	}
+}
@@ -0,0 +1,109 @@
+package credit
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Credit hold sources.
+const (
+	HoldAuto   = "auto"
+	HoldManual = "manual"
+)
+
+// Override statuses.
+const (
+	OverrideActive  = "active"
+	OverrideUsed    = "used"
+	OverrideRevoked = "revoked"
+)
+
+// Documents a credit authorisation can be requested for.
+const (
+	DocumentInvoice       = "invoice"
+	DocumentPaymentIntent = "payment_intent"
+)
+
+// Outbox events published by the credit module.
+const (
+	EventCreditHoldPlaced   = "treasury.customer.credit_hold_placed"
+	EventCreditHoldReleased = "treasury.customer.credit_hold_released"
+)
+
+// Status is a customer's credit position. Exposure is the customer's balance
+// in their default currency; the credit limit only applies to that currency.
+type Status struct {
+	CustomerID  uuid.UUID        `json:"customer_id"`
+	Currency    string           `json:"currency"`
+	CreditLimit *decimal.Decimal `json:"credit_limit,omitempty"`
+	Exposure    decimal.Decimal  `json:"exposure"`
+	// Available is nil when the customer has no credit limit.
+	Available   *decimal.Decimal `json:"available,omitempty"`
+	OnHold      bool             `json:"on_hold"`
+	HoldSource  string           `json:"hold_source,omitempty"` // auto, manual
+	HoldReason  string           `json:"hold_reason,omitempty"`
+	HoldSince   *time.Time       `json:"hold_since,omitempty"`
+	OverdueDays int              `json:"overdue_days"`
+}
+
+// Snapshot returns the credit position recorded on an override when it is used.
+func (s *Status) Snapshot() map[string]any {
+	snapshot := map[string]any{
+		"currency": s.Currency,
+		"exposure": s.Exposure.String(),
+		"on_hold":  s.OnHold,
+	}
+	if s.CreditLimit != nil {
+		snapshot["credit_limit"] = s.CreditLimit.String()
+	}
+	if s.OnHold {
+		snapshot["hold_source"] = s.HoldSource
+		snapshot["hold_reason"] = s.HoldReason
+	}
+	return snapshot
+}
+
+// Document is a sale on account awaiting credit authorisation.
+type Document struct {
+	Type     string // invoice, payment_intent
+	ID       uuid.UUID
+	Currency string
+	Amount   decimal.Decimal
+}
+
+// Override is a one-off authorisation to sell to a customer beyond their
+// credit limit or while on credit hold.
+type Override struct {
+	ID             uuid.UUID        `json:"id"`
+	TenantID       uuid.UUID        `json:"tenant_id"`
+	CustomerID     uuid.UUID        `json:"customer_id"`
+	Amount         decimal.Decimal  `json:"amount"`
+	Currency       string           `json:"currency"`
+	Reason         string           `json:"reason"`
+	GrantedBy      *uuid.UUID       `json:"granted_by,omitempty"`
+	ExpiresAt      time.Time        `json:"expires_at"`
+	Status         string           `json:"status"` // active, used, revoked
+	DocumentType   string           `json:"document_type,omitempty"`
+	DocumentID     *uuid.UUID       `json:"document_id,omitempty"`
+	DocumentAmount *decimal.Decimal `json:"document_amount,omitempty"`
+	CreditSnapshot map[string]any   `json:"credit_snapshot,omitempty"`
+	UsedAt         *time.Time       `json:"used_at,omitempty"`
+	CreatedAt      time.Time        `json:"created_at"`
+}
+
+// OverrideRequest grants an override covering one document up to Amount.
+// Currency defaults to the customer's default currency and ExpiresAt to 24
+// hours from now.
+type OverrideRequest struct {
+	Amount    decimal.Decimal `json:"amount"`
+	Currency  string          `json:"currency"`
+	Reason    string          `json:"reason"`
+	ExpiresAt *time.Time      `json:"expires_at"`
+}
+
+// HoldRequest places a customer on manual credit hold.
+type HoldRequest struct {
+	Reason string `json:"reason"`
+}
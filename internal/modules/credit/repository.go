@@ -0,0 +1,52 @@
+package credit
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrCreditHold is returned when a customer on credit hold buys on account.
+	ErrCreditHold = errors.New("customer is on credit hold")
+	// ErrCreditLimitExceeded is returned when a sale would take the customer
+	// beyond their credit limit.
+	ErrCreditLimitExceeded = errors.New("credit limit exceeded")
+	// ErrInvalidOverride is returned when an override or hold request is malformed.
+	ErrInvalidOverride = errors.New("invalid credit override request")
+	// ErrOverrideNotFound is returned when no usable override covers a sale.
+	ErrOverrideNotFound = errors.New("credit override not found")
+	// ErrNotOnHold is returned when releasing a customer who is not on credit hold.
+	ErrNotOnHold = errors.New("customer is not on credit hold")
+)
+
+// Repository abstracts persistence for credit holds and overrides.
+type Repository interface {
+	// OldestOverdue returns the earliest due date of the open invoices billed
+	// to any of the identities that fell due before today, or nil.
+	OldestOverdue(ctx context.Context, tenantID uuid.UUID, identities []uuid.UUID, today time.Time) (*time.Time, error)
+	// PlaceHold puts a customer on credit hold and enqueues the hold event.
+	// Customers already on hold are left unchanged and false is returned.
+	PlaceHold(ctx context.Context, tenantID, customerID uuid.UUID, source, reason string, now time.Time) (bool, error)
+	// ReleaseHold lifts a customer's credit hold and enqueues the release event.
+	// A non-empty source only releases holds placed by that source.
+	ReleaseHold(ctx context.Context, tenantID, customerID uuid.UUID, source, reason string) (bool, error)
+	CreateOverride(ctx context.Context, override *Override) (*Override, error)
+	ListOverrides(ctx context.Context, tenantID, customerID uuid.UUID) ([]*Override, error)
+	// ConsumeOverride marks the smallest active, unexpired override covering
+	// the document as used, or returns ErrOverrideNotFound.
+	ConsumeOverride(ctx context.Context, tenantID, customerID uuid.UUID, doc Document, snapshot map[string]any, now time.Time) (*Override, error)
+	// RestoreOverride makes the override used for the document active again
+	// and returns false when none was used for it.
+	RestoreOverride(ctx context.Context, tenantID uuid.UUID, docType string, docID uuid.UUID) (bool, error)
+	// AutoHoldTenants returns the credit hold threshold of every tenant that
+	// enabled automatic credit holds.
+	AutoHoldTenants(ctx context.Context) (map[uuid.UUID]int, error)
+	// OverdueCustomers returns the customers with open invoices that fell due
+	// before the cutoff.
+	OverdueCustomers(ctx context.Context, tenantID uuid.UUID, cutoff time.Time) ([]uuid.UUID, error)
+	// AutoHeldCustomers returns the customers on automatic credit hold.
+	AutoHeldCustomers(ctx context.Context, tenantID uuid.UUID) ([]uuid.UUID, error)
+}
@@ -0,0 +1,371 @@
+package credit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/creditoverride"
+	"github.com/bengobox/treasury-api/internal/ent/customer"
+	"github.com/bengobox/treasury-api/internal/ent/invoice"
+	"github.com/bengobox/treasury-api/internal/ent/invoicesetting"
+	"github.com/bengobox/treasury-api/internal/modules/customers"
+	"github.com/bengobox/treasury-api/internal/modules/invoices"
+	"github.com/bengobox/treasury-api/internal/modules/outbox"
+	"github.com/bengobox/treasury-api/internal/modules/receivables"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+)
+
+// exemptInvoiceTypes never put a customer on credit hold.
+var exemptInvoiceTypes = []string{"credit_note", "proforma"}
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// OldestOverdue returns the earliest due date among the identities' overdue
+// open invoices.
+func (r *EntRepository) OldestOverdue(ctx context.Context, tenantID uuid.UUID, identities []uuid.UUID, today time.Time) (*time.Time, error) {
+	entInvoice, err := openInvoices(r.client, tenantID, startOfDay(today)).
+		Where(invoice.CustomerIDIn(identities...)).
+		Order(ent.Asc(invoice.FieldDueDate)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find oldest overdue invoice: %w", err)
+	}
+	return &entInvoice.DueDate, nil
+}
+
+// PlaceHold puts a customer on credit hold and enqueues
+// treasury.customer.credit_hold_placed.
+func (r *EntRepository) PlaceHold(ctx context.Context, tenantID, customerID uuid.UUID, source, reason string, now time.Time) (bool, error) {
+	var placed bool
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entCustomer, err := lockCustomer(ctx, tx, tenantID, customerID)
+		if err != nil {
+			return err
+		}
+		if entCustomer.CreditHold {
+			return nil
+		}
+
+		if err := tx.Customer.UpdateOneID(customerID).
+			SetCreditHold(true).
+			SetCreditHoldSource(source).
+			SetCreditHoldReason(reason).
+			SetCreditHoldAt(now).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("place credit hold: %w", err)
+		}
+
+		placed = true
+		return enqueueHoldEvent(ctx, tx, entCustomer, EventCreditHoldPlaced, map[string]any{
+			"source":    source,
+			"reason":    reason,
+			"placed_at": now.UTC().Format(time.RFC3339),
+		})
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return placed, nil
+}
+
+// ReleaseHold lifts a customer's credit hold and enqueues
+// treasury.customer.credit_hold_released.
+func (r *EntRepository) ReleaseHold(ctx context.Context, tenantID, customerID uuid.UUID, source, reason string) (bool, error) {
+	var released bool
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entCustomer, err := lockCustomer(ctx, tx, tenantID, customerID)
+		if err != nil {
+			return err
+		}
+		if !entCustomer.CreditHold || (source != "" && entCustomer.CreditHoldSource != source) {
+			return nil
+		}
+
+		if err := tx.Customer.UpdateOneID(customerID).
+			SetCreditHold(false).
+			ClearCreditHoldSource().
+			ClearCreditHoldReason().
+			ClearCreditHoldAt().
+			Exec(ctx); err != nil {
+			return fmt.Errorf("release credit hold: %w", err)
+		}
+
+		released = true
+		return enqueueHoldEvent(ctx, tx, entCustomer, EventCreditHoldReleased, map[string]any{
+			"source": entCustomer.CreditHoldSource,
+			"reason": reason,
+		})
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return released, nil
+}
+
+// CreateOverride stores a granted override.
+func (r *EntRepository) CreateOverride(ctx context.Context, override *Override) (*Override, error) {
+	entOverride, err := r.client.CreditOverride.Create().
+		SetTenantID(override.TenantID).
+		SetCustomerID(override.CustomerID).
+		SetAmount(override.Amount).
+		SetCurrency(override.Currency).
+		SetReason(override.Reason).
+		SetNillableGrantedBy(override.GrantedBy).
+		SetExpiresAt(override.ExpiresAt).
+		SetStatus(OverrideActive).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create credit override: %w", err)
+	}
+	return mapEntOverride(entOverride), nil
+}
+
+// ListOverrides returns a customer's overrides, newest first.
+func (r *EntRepository) ListOverrides(ctx context.Context, tenantID, customerID uuid.UUID) ([]*Override, error) {
+	entOverrides, err := r.client.CreditOverride.Query().
+		Where(
+			creditoverride.TenantID(tenantID),
+			creditoverride.CustomerID(customerID),
+		).
+		Order(ent.Desc(creditoverride.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list credit overrides: %w", err)
+	}
+
+	overrides := make([]*Override, len(entOverrides))
+	for i, entOverride := range entOverrides {
+		overrides[i] = mapEntOverride(entOverride)
+	}
+	return overrides, nil
+}
+
+// ConsumeOverride uses the smallest override covering the document, recording
+// the document and the credit position it was used against.
+func (r *EntRepository) ConsumeOverride(ctx context.Context, tenantID, customerID uuid.UUID, doc Document, snapshot map[string]any, now time.Time) (*Override, error) {
+	var used *ent.CreditOverride
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entOverride, err := tx.CreditOverride.Query().
+			Where(
+				creditoverride.TenantID(tenantID),
+				creditoverride.CustomerID(customerID),
+				creditoverride.Status(OverrideActive),
+				creditoverride.Currency(doc.Currency),
+				creditoverride.AmountGTE(doc.Amount),
+				creditoverride.ExpiresAtGT(now),
+			).
+			Order(ent.Asc(creditoverride.FieldAmount), ent.Asc(creditoverride.FieldExpiresAt)).
+			ForUpdate().
+			First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrOverrideNotFound
+			}
+			return fmt.Errorf("find credit override: %w", err)
+		}
+
+		used, err = tx.CreditOverride.UpdateOne(entOverride).
+			SetStatus(OverrideUsed).
+			SetDocumentType(doc.Type).
+			SetDocumentID(doc.ID).
+			SetDocumentAmount(doc.Amount).
+			SetCreditSnapshot(snapshot).
+			SetUsedAt(now).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("use credit override: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntOverride(used), nil
+}
+
+// RestoreOverride clears the document from the override used for it.
+func (r *EntRepository) RestoreOverride(ctx context.Context, tenantID uuid.UUID, docType string, docID uuid.UUID) (bool, error) {
+	restored, err := r.client.CreditOverride.Update().
+		Where(
+			creditoverride.TenantID(tenantID),
+			creditoverride.Status(OverrideUsed),
+			creditoverride.DocumentType(docType),
+			creditoverride.DocumentID(docID),
+		).
+		SetStatus(OverrideActive).
+		ClearDocumentType().
+		ClearDocumentID().
+		ClearDocumentAmount().
+		ClearCreditSnapshot().
+		ClearUsedAt().
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("restore credit override: %w", err)
+	}
+
+	return restored > 0, nil
+}
+
+// AutoHoldTenants returns the tenants whose invoice settings set a credit hold
+// threshold.
+func (r *EntRepository) AutoHoldTenants(ctx context.Context) (map[uuid.UUID]int, error) {
+	entSettings, err := r.client.InvoiceSetting.Query().
+		Where(invoicesetting.CreditHoldDaysGT(0)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list credit hold tenants: %w", err)
+	}
+
+	thresholds := make(map[uuid.UUID]int, len(entSettings))
+	for _, entSetting := range entSettings {
+		thresholds[entSetting.TenantID] = entSetting.CreditHoldDays
+	}
+	return thresholds, nil
+}
+
+// OverdueCustomers returns the customers billed, by customer ID or auth user
+// ID, on open invoices that fell due before the cutoff.
+func (r *EntRepository) OverdueCustomers(ctx context.Context, tenantID uuid.UUID, cutoff time.Time) ([]uuid.UUID, error) {
+	var rows []struct {
+		CustomerID uuid.UUID `json:"customer_id"`
+	}
+	if err := openInvoices(r.client, tenantID, cutoff).
+		Where(invoice.CustomerIDNotNil()).
+		GroupBy(invoice.FieldCustomerID).
+		Scan(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("list overdue invoice customers: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	identities := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		identities[i] = row.CustomerID
+	}
+
+	customerIDs, err := r.client.Customer.Query().
+		Where(
+			customer.TenantID(tenantID),
+			customer.Or(
+				customer.IDIn(identities...),
+				customer.AuthUserIDIn(identities...),
+			),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list overdue customers: %w", err)
+	}
+	return customerIDs, nil
+}
+
+// AutoHeldCustomers returns the customers the credit hold job put on hold.
+func (r *EntRepository) AutoHeldCustomers(ctx context.Context, tenantID uuid.UUID) ([]uuid.UUID, error) {
+	customerIDs, err := r.client.Customer.Query().
+		Where(
+			customer.TenantID(tenantID),
+			customer.CreditHold(true),
+			customer.CreditHoldSource(HoldAuto),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list auto-held customers: %w", err)
+	}
+	return customerIDs, nil
+}
+
+// openInvoices selects issued invoices with a balance that fell due before the
+// cutoff.
+func openInvoices(client *ent.Client, tenantID uuid.UUID, cutoff time.Time) *ent.InvoiceQuery {
+	return client.Invoice.Query().
+		Where(
+			invoice.TenantID(tenantID),
+			invoice.StatusIn(invoices.StatusSent, invoices.StatusOverdue),
+			invoice.PaymentStatusIn(receivables.PaymentStatusUnpaid, receivables.PaymentStatusPartial),
+			invoice.InvoiceTypeNotIn(exemptInvoiceTypes...),
+			invoice.DueDateLT(cutoff),
+		)
+}
+
+func lockCustomer(ctx context.Context, tx *ent.Tx, tenantID, customerID uuid.UUID) (*ent.Customer, error) {
+	entCustomer, err := tx.Customer.Query().
+		Where(
+			customer.ID(customerID),
+			customer.TenantID(tenantID),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, customers.ErrCustomerNotFound
+		}
+		return nil, fmt.Errorf("load customer: %w", err)
+	}
+	return entCustomer, nil
+}
+
+func enqueueHoldEvent(ctx context.Context, tx *ent.Tx, entCustomer *ent.Customer, eventType string, payload map[string]any) error {
+	payload["customer_id"] = entCustomer.ID.String()
+	payload["customer_number"] = entCustomer.CustomerNumber
+	if entCustomer.AuthUserID != uuid.Nil {
+		payload["auth_user_id"] = entCustomer.AuthUserID.String()
+	}
+
+	return outbox.Enqueue(ctx, tx, outbox.Event{
+		TenantID:      entCustomer.TenantID,
+		AggregateType: "customer",
+		AggregateID:   entCustomer.ID,
+		EventType:     eventType,
+		Payload:       payload,
+	})
+}
+
+func mapEntOverride(entOverride *ent.CreditOverride) *Override {
+	o := &Override{
+		ID:             entOverride.ID,
+		TenantID:       entOverride.TenantID,
+		CustomerID:     entOverride.CustomerID,
+		Amount:         entOverride.Amount,
+		Currency:       entOverride.Currency,
+		Reason:         entOverride.Reason,
+		ExpiresAt:      entOverride.ExpiresAt,
+		Status:         entOverride.Status,
+		DocumentType:   entOverride.DocumentType,
+		DocumentAmount: entOverride.DocumentAmount,
+		CreditSnapshot: entOverride.CreditSnapshot,
+		CreatedAt:      entOverride.CreatedAt,
+	}
+
+	// Optional fields - check for zero values
+	if entOverride.GrantedBy != uuid.Nil {
+		o.GrantedBy = &entOverride.GrantedBy
+	}
+	if entOverride.DocumentID != uuid.Nil {
+		o.DocumentID = &entOverride.DocumentID
+	}
+	if !entOverride.UsedAt.IsZero() {
+		o.UsedAt = &entOverride.UsedAt
+	}
+	return o
+}
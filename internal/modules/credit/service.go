@@ -0,0 +1,367 @@
+package credit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/customers"
+)
+
+// defaultOverrideTTL is how long an override stays usable when no expiry is given.
+const defaultOverrideTTL = 24 * time.Hour
+
+// Service enforces customer credit limits and credit holds.
+type Service struct {
+	repo      Repository
+	customers *customers.Service
+	logger    *zap.Logger
+}
+
+// NewService creates a new credit control service.
+func NewService(repo Repository, customerService *customers.Service, logger *zap.Logger) *Service {
+	return &Service{
+		repo:      repo,
+		customers: customerService,
+		logger:    logger,
+	}
+}
+
+// Status computes a customer's credit position: the balance owed in their
+// default currency, the credit left under their limit and any credit hold.
+func (s *Service) Status(ctx context.Context, tenantID, customerID uuid.UUID) (*Status, error) {
+	c, err := s.customers.Get(ctx, tenantID, customerID)
+	if err != nil {
+		return nil, err
+	}
+	return s.status(ctx, c)
+}
+
+// Check reports whether a sale on account may proceed without an override.
+// Identities without a customer record are not credit controlled. An empty
+// currency is the invoice default, KES.
+func (s *Service) Check(ctx context.Context, tenantID, identity uuid.UUID, currency string, amount decimal.Decimal) error {
+	c, err := s.customers.Resolve(ctx, tenantID, identity)
+	if err != nil {
+		if errors.Is(err, customers.ErrCustomerNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	status, err := s.status(ctx, c)
+	if err != nil {
+		return err
+	}
+	return evaluate(status, documentCurrency(currency), amount)
+}
+
+// Authorize checks a sale on account and, when credit controls block it, uses
+// an override granted for the customer that covers the document. Callers
+// restore the override when the document then fails to save.
+func (s *Service) Authorize(ctx context.Context, tenantID, identity uuid.UUID, docType string, docID uuid.UUID, currency string, amount decimal.Decimal) error {
+	c, err := s.customers.Resolve(ctx, tenantID, identity)
+	if err != nil {
+		if errors.Is(err, customers.ErrCustomerNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	status, err := s.status(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	doc := Document{Type: docType, ID: docID, Currency: documentCurrency(currency), Amount: amount}
+	blocked := evaluate(status, doc.Currency, doc.Amount)
+	if blocked == nil {
+		return nil
+	}
+
+	override, err := s.repo.ConsumeOverride(ctx, tenantID, c.ID, doc, status.Snapshot(), time.Now().UTC())
+	if err != nil {
+		if errors.Is(err, ErrOverrideNotFound) {
+			return blocked
+		}
+		return err
+	}
+
+	s.logger.Info("credit override used",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("customer_id", c.ID.String()),
+		zap.String("override_id", override.ID.String()),
+		zap.String("document_type", doc.Type),
+		zap.String("document_id", doc.ID.String()),
+		zap.String("amount", doc.Amount.String()),
+		zap.NamedError("blocked_by", blocked),
+	)
+
+	return nil
+}
+
+// RestoreOverride returns the override Authorize used for a document that
+// could not be saved, so it can cover the customer's next attempt.
+func (s *Service) RestoreOverride(ctx context.Context, tenantID uuid.UUID, docType string, docID uuid.UUID) error {
+	restored, err := s.repo.RestoreOverride(ctx, tenantID, docType, docID)
+	if err != nil {
+		return err
+	}
+
+	if restored {
+		s.logger.Info("credit override restored",
+			zap.String("tenant_id", tenantID.String()),
+			zap.String("document_type", docType),
+			zap.String("document_id", docID.String()),
+		)
+	}
+
+	return nil
+}
+
+// PlaceHold puts a customer on manual credit hold.
+func (s *Service) PlaceHold(ctx context.Context, tenantID, customerID uuid.UUID, req HoldRequest, placedBy *uuid.UUID) (*Status, error) {
+	req.Reason = strings.TrimSpace(req.Reason)
+	if req.Reason == "" {
+		return nil, fmt.Errorf("%w: reason is required", ErrInvalidOverride)
+	}
+
+	c, err := s.customers.Get(ctx, tenantID, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	placed, err := s.repo.PlaceHold(ctx, tenantID, c.ID, HoldManual, req.Reason, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	if placed {
+		fields := []zap.Field{
+			zap.String("tenant_id", tenantID.String()),
+			zap.String("customer_id", customerID.String()),
+			zap.String("reason", req.Reason),
+		}
+		if placedBy != nil {
+			fields = append(fields, zap.String("placed_by", placedBy.String()))
+		}
+		s.logger.Info("customer placed on credit hold", fields...)
+	}
+
+	return s.Status(ctx, tenantID, customerID)
+}
+
+// ReleaseHold lifts a customer's credit hold, whether placed manually or by
+// the overdue check. An automatic hold is placed again on the next run if the
+// customer still has invoices past the threshold.
+func (s *Service) ReleaseHold(ctx context.Context, tenantID, customerID uuid.UUID, req HoldRequest, releasedBy *uuid.UUID) (*Status, error) {
+	req.Reason = strings.TrimSpace(req.Reason)
+	if req.Reason == "" {
+		return nil, fmt.Errorf("%w: reason is required", ErrInvalidOverride)
+	}
+
+	c, err := s.customers.Get(ctx, tenantID, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	released, err := s.repo.ReleaseHold(ctx, tenantID, c.ID, "", req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	if !released {
+		return nil, ErrNotOnHold
+	}
+
+	fields := []zap.Field{
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("customer_id", customerID.String()),
+		zap.String("reason", req.Reason),
+	}
+	if releasedBy != nil {
+		fields = append(fields, zap.String("released_by", releasedBy.String()))
+	}
+	s.logger.Info("customer credit hold released", fields...)
+
+	return s.Status(ctx, tenantID, customerID)
+}
+
+// GrantOverride authorises one sale on account up to the requested amount.
+func (s *Service) GrantOverride(ctx context.Context, tenantID, customerID uuid.UUID, req OverrideRequest, grantedBy *uuid.UUID) (*Override, error) {
+	req.Reason = strings.TrimSpace(req.Reason)
+	req.Currency = strings.ToUpper(strings.TrimSpace(req.Currency))
+	if req.Reason == "" {
+		return nil, fmt.Errorf("%w: reason is required", ErrInvalidOverride)
+	}
+	if !req.Amount.IsPositive() {
+		return nil, fmt.Errorf("%w: amount must be positive", ErrInvalidOverride)
+	}
+
+	now := time.Now().UTC()
+	expiresAt := now.Add(defaultOverrideTTL)
+	if req.ExpiresAt != nil {
+		if !req.ExpiresAt.After(now) {
+			return nil, fmt.Errorf("%w: expires_at must be in the future", ErrInvalidOverride)
+		}
+		expiresAt = *req.ExpiresAt
+	}
+
+	c, err := s.customers.Get(ctx, tenantID, customerID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Currency == "" {
+		req.Currency = c.DefaultCurrency
+	}
+
+	override, err := s.repo.CreateOverride(ctx, &Override{
+		TenantID:   tenantID,
+		CustomerID: c.ID,
+		Amount:     req.Amount,
+		Currency:   req.Currency,
+		Reason:     req.Reason,
+		GrantedBy:  grantedBy,
+		ExpiresAt:  expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("credit override granted",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("customer_id", customerID.String()),
+		zap.String("override_id", override.ID.String()),
+		zap.String("amount", override.Amount.String()),
+		zap.String("currency", override.Currency),
+	)
+
+	return override, nil
+}
+
+// ListOverrides returns a customer's overrides, newest first.
+func (s *Service) ListOverrides(ctx context.Context, tenantID, customerID uuid.UUID) ([]*Override, error) {
+	if _, err := s.customers.Get(ctx, tenantID, customerID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListOverrides(ctx, tenantID, customerID)
+}
+
+// RunCreditHolds places customers with invoices overdue beyond their tenant's
+// threshold on credit hold and releases automatic holds that no longer apply.
+// Manual holds are never released automatically.
+func (s *Service) RunCreditHolds(ctx context.Context) error {
+	now := time.Now().UTC()
+
+	thresholds, err := s.repo.AutoHoldTenants(ctx)
+	if err != nil {
+		return err
+	}
+
+	placed, released, failed := 0, 0, 0
+	for tenantID, days := range thresholds {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		p, r, err := s.applyHolds(ctx, tenantID, days, now)
+		placed += p
+		released += r
+		if err != nil {
+			failed++
+			s.logger.Warn("credit hold run failed",
+				zap.String("tenant_id", tenantID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+
+	if placed > 0 || released > 0 || failed > 0 {
+		s.logger.Info("credit hold run completed",
+			zap.Int("placed", placed),
+			zap.Int("released", released),
+			zap.Int("failed", failed),
+		)
+	}
+
+	return nil
+}
+
+func (s *Service) applyHolds(ctx context.Context, tenantID uuid.UUID, days int, now time.Time) (placed, released int, err error) {
+	cutoff := startOfDay(now).AddDate(0, 0, -days)
+	overdue, err := s.repo.OverdueCustomers(ctx, tenantID, cutoff)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	due := make(map[uuid.UUID]bool, len(overdue))
+	reason := fmt.Sprintf("invoices more than %d days overdue", days)
+	for _, customerID := range overdue {
+		due[customerID] = true
+		ok, err := s.repo.PlaceHold(ctx, tenantID, customerID, HoldAuto, reason, now)
+		if err != nil {
+			return placed, released, err
+		}
+		if ok {
+			placed++
+		}
+	}
+
+	held, err := s.repo.AutoHeldCustomers(ctx, tenantID)
+	if err != nil {
+		return placed, released, err
+	}
+	for _, customerID := range held {
+		if due[customerID] {
+			continue
+		}
+		ok, err := s.repo.ReleaseHold(ctx, tenantID, customerID, HoldAuto, "overdue invoices settled")
+		if err != nil {
+			return placed, released, err
+		}
+		if ok {
+			released++
+		}
+	}
+
+	return placed, released, nil
+}
+
+func (s *Service) status(ctx context.Context, c *customers.Customer) (*Status, error) {
+	ledger, err := s.customers.Ledger(ctx, c.TenantID, c.ID, customers.LedgerFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	oldestDue, err := s.repo.OldestOverdue(ctx, c.TenantID, c.Identities(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Status{
+		CustomerID:  c.ID,
+		Currency:    ledger.Currency,
+		CreditLimit: c.CreditLimit,
+		Exposure:    ledger.ClosingBalance,
+		Available:   available(c.CreditLimit, ledger.ClosingBalance),
+		OnHold:      c.CreditHold,
+		HoldSource:  c.CreditHoldSource,
+		HoldReason:  c.CreditHoldReason,
+		HoldSince:   c.CreditHoldAt,
+		OverdueDays: overdueDays(oldestDue, now),
+	}, nil
+}
+
+func documentCurrency(currency string) string {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		return "KES"
+	}
+	return currency
+}
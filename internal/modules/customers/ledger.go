@@ -0,0 +1,43 @@
+package customers
+
+import (
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// buildLedger orders the customer's documents by date and computes running
+// balances. Documents before from roll into the opening balance; documents
+// after to are dropped.
+func buildLedger(entries []LedgerEntry, from, to *time.Time) (opening decimal.Decimal, period []LedgerEntry, closing decimal.Decimal) {
+	sorted := make([]LedgerEntry, len(entries))
+	copy(sorted, entries)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].Date.Equal(sorted[j].Date) {
+			return sorted[i].Date.Before(sorted[j].Date)
+		}
+		// Same-day documents list charges before the payments against them.
+		return sorted[i].Debit.GreaterThan(sorted[j].Debit)
+	})
+
+	opening = decimal.Zero
+	balance := decimal.Zero
+	period = []LedgerEntry{}
+	for _, entry := range sorted {
+		if to != nil && entry.Date.After(*to) {
+			break
+		}
+
+		balance = balance.Add(entry.Debit).Sub(entry.Credit)
+		if from != nil && entry.Date.Before(*from) {
+			opening = balance
+			continue
+		}
+
+		entry.Balance = balance
+		period = append(period, entry)
+	}
+
+	return opening, period, balance
+}
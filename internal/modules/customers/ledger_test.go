@@ -0,0 +1,50 @@
+package customers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestBuildLedger(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 5, d, 0, 0, 0, 0, time.UTC) }
+	amount := decimal.RequireFromString
+
+	entries := []LedgerEntry{
+		{Date: day(20), Type: EntryPayment, Credit: amount("1500")},
+		{Date: day(2), Type: EntryInvoice, Debit: amount("1000")},
+		{Date: day(10), Type: EntryPayment, Credit: amount("400")},
+		{Date: day(10), Type: EntryInvoice, Debit: amount("2000")},
+		{Date: day(15), Type: EntryCreditNote, Credit: amount("100")},
+		{Date: day(28), Type: EntryInvoice, Debit: amount("300")},
+	}
+
+	from, to := day(5), day(25)
+	opening, period, closing := buildLedger(entries, &from, &to)
+
+	if !opening.Equal(amount("1000")) {
+		t.Fatalf("expected opening balance 1000, got %s", opening)
+	}
+	if len(period) != 4 {
+		t.Fatalf("expected 4 entries in period, got %d", len(period))
+	}
+	if period[0].Type != EntryInvoice || period[1].Type != EntryPayment {
+		t.Fatalf("expected same-day invoice before payment, got %s then %s", period[0].Type, period[1].Type)
+	}
+
+	wantBalances := []string{"3000", "2600", "2500", "1000"}
+	for i, want := range wantBalances {
+		if !period[i].Balance.Equal(amount(want)) {
+			t.Fatalf("entry %d: expected balance %s, got %s", i, want, period[i].Balance)
+		}
+	}
+	if !closing.Equal(amount("1000")) {
+		t.Fatalf("expected closing balance 1000, got %s", closing)
+	}
+
+	_, all, closing := buildLedger(entries, nil, nil)
+	if len(all) != len(entries) || !closing.Equal(amount("1300")) {
+		t.Fatalf("expected %d entries closing at 1300, got %d closing at %s", len(entries), len(all), closing)
+	}
+}
@@ -0,0 +1,140 @@
+package customers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/customers/profile"
+)
+
+// Customer statuses.
+const (
+	StatusActive   = "active"
+	StatusInactive = "inactive"
+)
+
+// Ledger entry types.
+const (
+	EntryInvoice    = "invoice"
+	EntryCreditNote = "credit_note"
+	EntryPayment    = "payment"
+	EntryRefund     = "refund"
+	EntryChargeback = "chargeback"
+	EntryWriteOff   = "write_off"
+	EntryRecovery   = "write_off_recovery"
+)
+
+// Customer is a billing customer owned by treasury.
+type Customer struct {
+	ID               uuid.UUID         `json:"id"`
+	TenantID         uuid.UUID         `json:"tenant_id"`
+	CustomerNumber   string            `json:"customer_number"`
+	LegalName        string            `json:"legal_name"`
+	TradingName      string            `json:"trading_name,omitempty"`
+	KRAPIN           string            `json:"kra_pin,omitempty"`
+	Email            string            `json:"email,omitempty"`
+	Phone            string            `json:"phone,omitempty"`
+	BillingAddresses []profile.Address `json:"billing_addresses"`
+	Contacts         []profile.Contact `json:"contacts"`
+	DefaultCurrency  string            `json:"default_currency"`
+	PaymentTermsDays int               `json:"payment_terms_days"`
+	CreditLimit      *decimal.Decimal  `json:"credit_limit,omitempty"`
+	CreditHold       bool              `json:"credit_hold"`
+	CreditHoldSource string            `json:"credit_hold_source,omitempty"` // auto, manual
+	CreditHoldReason string            `json:"credit_hold_reason,omitempty"`
+	CreditHoldAt     *time.Time        `json:"credit_hold_at,omitempty"`
+	AuthUserID       *uuid.UUID        `json:"auth_user_id,omitempty"`
+	Status           string            `json:"status"`
+	Metadata         map[string]any    `json:"metadata,omitempty"`
+	CreatedAt        time.Time         `json:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at"`
+}
+
+// Identities returns the IDs invoices and payments may reference the customer
+// by: the customer ID and, for customers who log in, their auth user ID.
+func (c *Customer) Identities() []uuid.UUID {
+	ids := []uuid.UUID{c.ID}
+	if c.AuthUserID != nil {
+		ids = append(ids, *c.AuthUserID)
+	}
+	return ids
+}
+
+// CreateRequest creates a customer.
+type CreateRequest struct {
+	LegalName        string            `json:"legal_name"`
+	TradingName      string            `json:"trading_name"`
+	KRAPIN           string            `json:"kra_pin"`
+	Email            string            `json:"email"`
+	Phone            string            `json:"phone"`
+	BillingAddresses []profile.Address `json:"billing_addresses"`
+	Contacts         []profile.Contact `json:"contacts"`
+	DefaultCurrency  string            `json:"default_currency"`
+	PaymentTermsDays *int              `json:"payment_terms_days"`
+	CreditLimit      *decimal.Decimal  `json:"credit_limit"`
+	AuthUserID       *uuid.UUID        `json:"auth_user_id"`
+	Metadata         map[string]any    `json:"metadata"`
+}
+
+// UpdateRequest changes the provided fields of a customer.
+type UpdateRequest struct {
+	LegalName        *string            `json:"legal_name"`
+	TradingName      *string            `json:"trading_name"`
+	KRAPIN           *string            `json:"kra_pin"`
+	Email            *string            `json:"email"`
+	Phone            *string            `json:"phone"`
+	BillingAddresses *[]profile.Address `json:"billing_addresses"`
+	Contacts         *[]profile.Contact `json:"contacts"`
+	DefaultCurrency  *string            `json:"default_currency"`
+	PaymentTermsDays *int               `json:"payment_terms_days"`
+	CreditLimit      *decimal.Decimal   `json:"credit_limit"`
+	// ClearCreditLimit removes the credit limit (no limit).
+	ClearCreditLimit bool           `json:"clear_credit_limit"`
+	AuthUserID       *uuid.UUID     `json:"auth_user_id"`
+	Status           *string        `json:"status"`
+	Metadata         map[string]any `json:"metadata"`
+}
+
+// ListFilter narrows customer listings.
+type ListFilter struct {
+	// Search matches the legal name, trading name, customer number or KRA PIN.
+	Search string
+	Status string
+	Limit  int
+	Offset int
+}
+
+// LedgerEntry is one document on a customer's sub-ledger. Debits increase
+// what the customer owes; credits reduce it.
+type LedgerEntry struct {
+	Date        time.Time       `json:"date"`
+	Type        string          `json:"type"`
+	SourceID    uuid.UUID       `json:"source_id"`
+	Reference   string          `json:"reference,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Debit       decimal.Decimal `json:"debit"`
+	Credit      decimal.Decimal `json:"credit"`
+	Balance     decimal.Decimal `json:"balance"`
+}
+
+// Ledger is a customer's sub-ledger in one currency over a period.
+type Ledger struct {
+	CustomerID     uuid.UUID       `json:"customer_id"`
+	Currency       string          `json:"currency"`
+	From           *time.Time      `json:"from,omitempty"`
+	To             *time.Time      `json:"to,omitempty"`
+	OpeningBalance decimal.Decimal `json:"opening_balance"`
+	Entries        []LedgerEntry   `json:"entries"`
+	ClosingBalance decimal.Decimal `json:"closing_balance"`
+}
+
+// LedgerFilter selects the currency and period of a customer ledger.
+type LedgerFilter struct {
+	// Currency defaults to the customer's default currency.
+	Currency string
+	From     *time.Time
+	// To is inclusive of the whole day.
+	To *time.Time
+}
@@ -0,0 +1,25 @@
+// Package profile holds the address and contact types stored on customer
+// and vendor records. It has no dependencies so the Ent schema can use them as
+// JSON types.
+package profile
+
+// Address is a billing address of a customer.
+type Address struct {
+	Label      string `json:"label,omitempty"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city,omitempty"`
+	County     string `json:"county,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country,omitempty"`
+	Default    bool   `json:"default,omitempty"`
+}
+
+// Contact is a person at a customer who receives invoices or statements.
+type Contact struct {
+	Name    string `json:"name"`
+	Role    string `json:"role,omitempty"`
+	Email   string `json:"email,omitempty"`
+	Phone   string `json:"phone,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
@@ -0,0 +1,28 @@
+package customers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCustomerNotFound  = errors.New("customer not found")
+	ErrDuplicateCustomer = errors.New("customer already exists")
+	ErrInvalidCustomer   = errors.New("invalid customer")
+)
+
+// Repository abstracts persistence for customers and their sub-ledger.
+type Repository interface {
+	Create(ctx context.Context, tenantID uuid.UUID, req CreateRequest) (*Customer, error)
+	Get(ctx context.Context, tenantID uuid.UUID, customerID uuid.UUID) (*Customer, error)
+	// GetByIdentity returns the customer referenced by a customer ID or a
+	// linked auth user ID.
+	GetByIdentity(ctx context.Context, tenantID uuid.UUID, identity uuid.UUID) (*Customer, error)
+	List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Customer, error)
+	Update(ctx context.Context, tenantID uuid.UUID, customerID uuid.UUID, req UpdateRequest) (*Customer, error)
+	// LedgerEntries returns every posted invoice, credit note and settled
+	// payment of the customer in the currency, without running balances.
+	LedgerEntries(ctx context.Context, customer *Customer, currency string) ([]LedgerEntry, error)
+}
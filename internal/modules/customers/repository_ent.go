@@ -0,0 +1,461 @@
+package customers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/customer"
+	"github.com/bengobox/treasury-api/internal/ent/invoice"
+	"github.com/bengobox/treasury-api/internal/ent/paymentintent"
+	"github.com/bengobox/treasury-api/internal/ent/paymenttransaction"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/writeoff"
+	"github.com/bengobox/treasury-api/internal/ent/writeoffrecovery"
+	"github.com/bengobox/treasury-api/internal/modules/customers/profile"
+	"github.com/bengobox/treasury-api/internal/modules/numbering"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+)
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// Create numbers and stores a new customer.
+func (r *EntRepository) Create(ctx context.Context, tenantID uuid.UUID, req CreateRequest) (*Customer, error) {
+	var created *ent.Customer
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		if err := checkUnique(ctx, tx.Client(), tenantID, uuid.Nil, req.KRAPIN, req.AuthUserID); err != nil {
+			return err
+		}
+
+		number, err := numbering.Next(ctx, tx, tenantID, numbering.SequenceCustomer, numbering.PrefixCustomer)
+		if err != nil {
+			return err
+		}
+
+		builder := tx.Customer.Create().
+			SetTenantID(tenantID).
+			SetCustomerNumber(number).
+			SetLegalName(req.LegalName).
+			SetTradingName(req.TradingName).
+			SetKraPin(req.KRAPIN).
+			SetEmail(req.Email).
+			SetPhone(req.Phone).
+			SetBillingAddresses(req.BillingAddresses).
+			SetContacts(req.Contacts).
+			SetDefaultCurrency(req.DefaultCurrency).
+			SetNillablePaymentTermsDays(req.PaymentTermsDays).
+			SetNillableCreditLimit(req.CreditLimit).
+			SetNillableAuthUserID(req.AuthUserID)
+		if req.Metadata != nil {
+			builder.SetMetadata(req.Metadata)
+		}
+
+		created, err = builder.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create customer: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntCustomer(created), nil
+}
+
+// Get retrieves a customer.
+func (r *EntRepository) Get(ctx context.Context, tenantID uuid.UUID, customerID uuid.UUID) (*Customer, error) {
+	entCustomer, err := r.client.Customer.Query().
+		Where(
+			customer.ID(customerID),
+			customer.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrCustomerNotFound
+		}
+		return nil, fmt.Errorf("get customer: %w", err)
+	}
+
+	return mapEntCustomer(entCustomer), nil
+}
+
+// GetByIdentity retrieves the customer with the ID or linked auth user ID.
+func (r *EntRepository) GetByIdentity(ctx context.Context, tenantID uuid.UUID, identity uuid.UUID) (*Customer, error) {
+	entCustomer, err := r.client.Customer.Query().
+		Where(
+			customer.TenantID(tenantID),
+			customer.Or(
+				customer.ID(identity),
+				customer.AuthUserID(identity),
+			),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrCustomerNotFound
+		}
+		return nil, fmt.Errorf("get customer by identity: %w", err)
+	}
+
+	return mapEntCustomer(entCustomer), nil
+}
+
+// List returns the tenant's customers ordered by legal name.
+func (r *EntRepository) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Customer, error) {
+	query := r.client.Customer.Query().
+		Where(customer.TenantID(tenantID))
+	if filter.Status != "" {
+		query = query.Where(customer.Status(filter.Status))
+	}
+	if search := strings.TrimSpace(filter.Search); search != "" {
+		query = query.Where(customer.Or(
+			customer.LegalNameContainsFold(search),
+			customer.TradingNameContainsFold(search),
+			customer.CustomerNumberEqualFold(search),
+			customer.KraPinEqualFold(search),
+		))
+	}
+
+	entCustomers, err := query.
+		Order(ent.Asc(customer.FieldLegalName), ent.Asc(customer.FieldCustomerNumber)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list customers: %w", err)
+	}
+
+	customers := make([]*Customer, len(entCustomers))
+	for i, entCustomer := range entCustomers {
+		customers[i] = mapEntCustomer(entCustomer)
+	}
+	return customers, nil
+}
+
+// Update applies the provided fields to a customer.
+func (r *EntRepository) Update(ctx context.Context, tenantID uuid.UUID, customerID uuid.UUID, req UpdateRequest) (*Customer, error) {
+	var updated *ent.Customer
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		current, err := tx.Customer.Query().
+			Where(
+				customer.ID(customerID),
+				customer.TenantID(tenantID),
+			).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrCustomerNotFound
+			}
+			return fmt.Errorf("lock customer: %w", err)
+		}
+
+		kraPIN := current.KraPin
+		if req.KRAPIN != nil {
+			kraPIN = *req.KRAPIN
+		}
+		if err := checkUnique(ctx, tx.Client(), tenantID, customerID, kraPIN, req.AuthUserID); err != nil {
+			return err
+		}
+
+		update := current.Update().
+			SetNillableLegalName(req.LegalName).
+			SetNillableTradingName(req.TradingName).
+			SetNillableKraPin(req.KRAPIN).
+			SetNillableEmail(req.Email).
+			SetNillablePhone(req.Phone).
+			SetNillableDefaultCurrency(req.DefaultCurrency).
+			SetNillablePaymentTermsDays(req.PaymentTermsDays).
+			SetNillableAuthUserID(req.AuthUserID).
+			SetNillableStatus(req.Status)
+		if req.BillingAddresses != nil {
+			update.SetBillingAddresses(*req.BillingAddresses)
+		}
+		if req.Contacts != nil {
+			update.SetContacts(*req.Contacts)
+		}
+		if req.ClearCreditLimit {
+			update.ClearCreditLimit()
+		} else if req.CreditLimit != nil {
+			update.SetCreditLimit(*req.CreditLimit)
+		}
+		if req.Metadata != nil {
+			update.SetMetadata(req.Metadata)
+		}
+
+		updated, err = update.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("update customer: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntCustomer(updated), nil
+}
+
+// LedgerEntries collects the customer's posted invoices, credit notes and bad
+// debt write-offs and its settled payments, refunds and chargebacks in the
+// currency.
+func (r *EntRepository) LedgerEntries(ctx context.Context, c *Customer, currency string) ([]LedgerEntry, error) {
+	identities := c.Identities()
+
+	entInvoices, err := r.client.Invoice.Query().
+		Where(
+			invoice.TenantID(c.TenantID),
+			invoice.CustomerIDIn(identities...),
+			invoice.Currency(currency),
+			invoice.StatusNotIn("draft", "cancelled"),
+			invoice.InvoiceTypeNEQ("proforma"),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list customer invoices: %w", err)
+	}
+
+	entries := make([]LedgerEntry, 0, len(entInvoices))
+	for _, inv := range entInvoices {
+		entry := LedgerEntry{
+			Date:      inv.InvoiceDate,
+			SourceID:  inv.ID,
+			Reference: inv.InvoiceNumber,
+			Debit:     decimal.Zero,
+			Credit:    decimal.Zero,
+		}
+		if inv.InvoiceType == "credit_note" {
+			entry.Type = EntryCreditNote
+			entry.Description = "Credit note"
+			entry.Credit = inv.TotalAmount.Abs()
+		} else {
+			entry.Type = EntryInvoice
+			entry.Description = invoiceDescription(inv)
+			entry.Debit = inv.TotalAmount
+		}
+		entries = append(entries, entry)
+	}
+
+	writeOffEntries, err := r.writeOffEntries(ctx, c.TenantID, entInvoices)
+	if err != nil {
+		return nil, err
+	}
+	entries = append(entries, writeOffEntries...)
+
+	intentIDs, err := r.client.PaymentIntent.Query().
+		Where(
+			paymentintent.TenantID(c.TenantID),
+			paymentintent.CustomerIDIn(identities...),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list customer payment intents: %w", err)
+	}
+	if len(intentIDs) == 0 {
+		return entries, nil
+	}
+
+	payments, err := r.client.PaymentTransaction.Query().
+		Where(
+			paymenttransaction.TenantID(c.TenantID),
+			paymenttransaction.PaymentIntentIDIn(intentIDs...),
+			paymenttransaction.Currency(currency),
+			paymenttransaction.Status("succeeded"),
+			paymenttransaction.TransactionTypeIn(EntryPayment, EntryRefund, EntryChargeback),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list customer payments: %w", err)
+	}
+
+	for _, payment := range payments {
+		entry := LedgerEntry{
+			Date:        payment.CreatedAt,
+			Type:        payment.TransactionType,
+			SourceID:    payment.ID,
+			Reference:   payment.ProviderReference,
+			Description: paymentDescription(payment),
+			Debit:       decimal.Zero,
+			Credit:      decimal.Zero,
+		}
+		if !payment.ProcessedAt.IsZero() {
+			entry.Date = payment.ProcessedAt
+		}
+		if payment.TransactionType == EntryPayment {
+			entry.Credit = payment.Amount
+		} else {
+			entry.Debit = payment.Amount
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// writeOffEntries credits approved bad debt write-offs of the invoices and
+// debits the amounts later recovered.
+func (r *EntRepository) writeOffEntries(ctx context.Context, tenantID uuid.UUID, entInvoices []*ent.Invoice) ([]LedgerEntry, error) {
+	numbers := make(map[uuid.UUID]string, len(entInvoices))
+	ids := make([]uuid.UUID, len(entInvoices))
+	for i, inv := range entInvoices {
+		numbers[inv.ID] = inv.InvoiceNumber
+		ids[i] = inv.ID
+	}
+
+	entWriteOffs, err := r.client.WriteOff.Query().
+		Where(
+			writeoff.TenantID(tenantID),
+			writeoff.InvoiceIDIn(ids...),
+			writeoff.Status("approved"),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list customer write-offs: %w", err)
+	}
+	if len(entWriteOffs) == 0 {
+		return nil, nil
+	}
+
+	var entries []LedgerEntry
+	writeOffIDs := make([]uuid.UUID, len(entWriteOffs))
+	for i, entWriteOff := range entWriteOffs {
+		writeOffIDs[i] = entWriteOff.ID
+		entries = append(entries, LedgerEntry{
+			Date:        entWriteOff.DecidedAt,
+			Type:        EntryWriteOff,
+			SourceID:    entWriteOff.ID,
+			Reference:   numbers[entWriteOff.InvoiceID],
+			Description: "Bad debt written off",
+			Debit:       decimal.Zero,
+			Credit:      entWriteOff.Amount,
+		})
+	}
+
+	entRecoveries, err := r.client.WriteOffRecovery.Query().
+		Where(writeoffrecovery.WriteOffIDIn(writeOffIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list customer write-off recoveries: %w", err)
+	}
+	for _, recovery := range entRecoveries {
+		entries = append(entries, LedgerEntry{
+			Date:        recovery.RecoveredAt,
+			Type:        EntryRecovery,
+			SourceID:    recovery.ID,
+			Reference:   numbers[recovery.InvoiceID],
+			Description: "Written-off balance reinstated",
+			Debit:       recovery.Amount,
+			Credit:      decimal.Zero,
+		})
+	}
+
+	return entries, nil
+}
+
+// checkUnique rejects a KRA PIN or auth user already used by another of the
+// tenant's customers.
+func checkUnique(ctx context.Context, client *ent.Client, tenantID, customerID uuid.UUID, kraPIN string, authUserID *uuid.UUID) error {
+	var conflicts []predicate.Customer
+	if kraPIN != "" {
+		conflicts = append(conflicts, customer.KraPinEqualFold(kraPIN))
+	}
+	if authUserID != nil {
+		conflicts = append(conflicts, customer.AuthUserID(*authUserID))
+	}
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	existing, err := client.Customer.Query().
+		Where(
+			customer.TenantID(tenantID),
+			customer.IDNEQ(customerID),
+			customer.Or(conflicts...),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("check customer uniqueness: %w", err)
+	}
+
+	return fmt.Errorf("%w: %s has the same KRA PIN or auth user", ErrDuplicateCustomer, existing.CustomerNumber)
+}
+
+func invoiceDescription(inv *ent.Invoice) string {
+	switch inv.InvoiceType {
+	case "debit_note":
+		return "Debit note"
+	case "recurring":
+		return "Recurring invoice"
+	default:
+		return "Invoice"
+	}
+}
+
+func paymentDescription(payment *ent.PaymentTransaction) string {
+	label := map[string]string{
+		EntryPayment:    "Payment received",
+		EntryRefund:     "Refund",
+		EntryChargeback: "Chargeback",
+	}[payment.TransactionType]
+	if payment.Provider != "" {
+		return fmt.Sprintf("%s (%s)", label, payment.Provider)
+	}
+	return label
+}
+
+func mapEntCustomer(entCustomer *ent.Customer) *Customer {
+	c := &Customer{
+		ID:               entCustomer.ID,
+		TenantID:         entCustomer.TenantID,
+		CustomerNumber:   entCustomer.CustomerNumber,
+		LegalName:        entCustomer.LegalName,
+		TradingName:      entCustomer.TradingName,
+		KRAPIN:           entCustomer.KraPin,
+		Email:            entCustomer.Email,
+		Phone:            entCustomer.Phone,
+		BillingAddresses: entCustomer.BillingAddresses,
+		Contacts:         entCustomer.Contacts,
+		DefaultCurrency:  entCustomer.DefaultCurrency,
+		PaymentTermsDays: entCustomer.PaymentTermsDays,
+		CreditLimit:      entCustomer.CreditLimit,
+		CreditHold:       entCustomer.CreditHold,
+		CreditHoldSource: entCustomer.CreditHoldSource,
+		CreditHoldReason: entCustomer.CreditHoldReason,
+		Status:           entCustomer.Status,
+		Metadata:         entCustomer.Metadata,
+		CreatedAt:        entCustomer.CreatedAt,
+		UpdatedAt:        entCustomer.UpdatedAt,
+	}
+	if c.BillingAddresses == nil {
+		c.BillingAddresses = []profile.Address{}
+	}
+	if c.Contacts == nil {
+		c.Contacts = []profile.Contact{}
+	}
+	if entCustomer.AuthUserID != uuid.Nil {
+		c.AuthUserID = &entCustomer.AuthUserID
+	}
+	if !entCustomer.CreditHoldAt.IsZero() {
+		c.CreditHoldAt = &entCustomer.CreditHoldAt
+	}
+	return c
+}
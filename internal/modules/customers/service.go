@@ -0,0 +1,211 @@
+package customers
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/customers/profile"
+)
+
+// kraPINPattern matches a KRA PIN: a letter, nine digits and a check letter.
+var kraPINPattern = regexp.MustCompile(`^[A-Z][0-9]{9}[A-Z]$`)
+
+// Service manages customer master records and the customer sub-ledger.
+type Service struct {
+	repo   Repository
+	logger *zap.Logger
+}
+
+// NewService creates a new customer service.
+func NewService(repo Repository, logger *zap.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Create validates and stores a new customer.
+func (s *Service) Create(ctx context.Context, tenantID uuid.UUID, req CreateRequest) (*Customer, error) {
+	req.LegalName = strings.TrimSpace(req.LegalName)
+	req.KRAPIN = strings.ToUpper(strings.TrimSpace(req.KRAPIN))
+	req.DefaultCurrency = strings.ToUpper(strings.TrimSpace(req.DefaultCurrency))
+	if req.DefaultCurrency == "" {
+		req.DefaultCurrency = "KES"
+	}
+
+	if req.LegalName == "" {
+		return nil, fmt.Errorf("%w: legal_name is required", ErrInvalidCustomer)
+	}
+	if err := validateDetails(req.KRAPIN, req.Email, req.DefaultCurrency, req.PaymentTermsDays, req.BillingAddresses, req.Contacts); err != nil {
+		return nil, err
+	}
+	if req.CreditLimit != nil && req.CreditLimit.IsNegative() {
+		return nil, fmt.Errorf("%w: credit_limit cannot be negative", ErrInvalidCustomer)
+	}
+
+	c, err := s.repo.Create(ctx, tenantID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("customer created",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("customer_id", c.ID.String()),
+		zap.String("customer_number", c.CustomerNumber),
+	)
+
+	return c, nil
+}
+
+// Get retrieves a customer.
+func (s *Service) Get(ctx context.Context, tenantID uuid.UUID, customerID uuid.UUID) (*Customer, error) {
+	return s.repo.Get(ctx, tenantID, customerID)
+}
+
+// Resolve retrieves the customer an invoice or payment references, by
+// customer ID or linked auth user ID.
+func (s *Service) Resolve(ctx context.Context, tenantID uuid.UUID, identity uuid.UUID) (*Customer, error) {
+	return s.repo.GetByIdentity(ctx, tenantID, identity)
+}
+
+// List returns the tenant's customers.
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Customer, error) {
+	return s.repo.List(ctx, tenantID, filter)
+}
+
+// Update validates and applies changes to a customer.
+func (s *Service) Update(ctx context.Context, tenantID uuid.UUID, customerID uuid.UUID, req UpdateRequest) (*Customer, error) {
+	current, err := s.repo.Get(ctx, tenantID, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.LegalName != nil {
+		name := strings.TrimSpace(*req.LegalName)
+		if name == "" {
+			return nil, fmt.Errorf("%w: legal_name is required", ErrInvalidCustomer)
+		}
+		req.LegalName = &name
+	}
+	if req.KRAPIN != nil {
+		pin := strings.ToUpper(strings.TrimSpace(*req.KRAPIN))
+		req.KRAPIN = &pin
+	}
+	if req.DefaultCurrency != nil {
+		currency := strings.ToUpper(strings.TrimSpace(*req.DefaultCurrency))
+		req.DefaultCurrency = &currency
+	}
+	if req.Status != nil && *req.Status != StatusActive && *req.Status != StatusInactive {
+		return nil, fmt.Errorf("%w: status must be active or inactive", ErrInvalidCustomer)
+	}
+	if req.CreditLimit != nil && req.CreditLimit.IsNegative() {
+		return nil, fmt.Errorf("%w: credit_limit cannot be negative", ErrInvalidCustomer)
+	}
+
+	kraPIN, email, currency := current.KRAPIN, current.Email, current.DefaultCurrency
+	addresses, contacts := current.BillingAddresses, current.Contacts
+	if req.KRAPIN != nil {
+		kraPIN = *req.KRAPIN
+	}
+	if req.Email != nil {
+		email = *req.Email
+	}
+	if req.DefaultCurrency != nil {
+		currency = *req.DefaultCurrency
+	}
+	if req.BillingAddresses != nil {
+		addresses = *req.BillingAddresses
+	}
+	if req.Contacts != nil {
+		contacts = *req.Contacts
+	}
+	if err := validateDetails(kraPIN, email, currency, req.PaymentTermsDays, addresses, contacts); err != nil {
+		return nil, err
+	}
+
+	c, err := s.repo.Update(ctx, tenantID, customerID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("customer updated",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("customer_id", customerID.String()),
+	)
+
+	return c, nil
+}
+
+// Ledger returns the customer's sub-ledger with running balances. Invoices
+// and payments are matched on the customer ID and its linked auth user.
+func (s *Service) Ledger(ctx context.Context, tenantID uuid.UUID, customerID uuid.UUID, filter LedgerFilter) (*Ledger, error) {
+	c, err := s.repo.Get(ctx, tenantID, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := strings.ToUpper(filter.Currency)
+	if currency == "" {
+		currency = c.DefaultCurrency
+	}
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return nil, fmt.Errorf("%w: to precedes from", ErrInvalidCustomer)
+	}
+
+	var to *time.Time
+	if filter.To != nil {
+		endOfDay := filter.To.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		to = &endOfDay
+	}
+
+	entries, err := s.repo.LedgerEntries(ctx, c, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	opening, period, closing := buildLedger(entries, filter.From, to)
+	return &Ledger{
+		CustomerID:     c.ID,
+		Currency:       currency,
+		From:           filter.From,
+		To:             filter.To,
+		OpeningBalance: opening,
+		Entries:        period,
+		ClosingBalance: closing,
+	}, nil
+}
+
+func validateDetails(kraPIN, email, currency string, paymentTermsDays *int, addresses []profile.Address, contacts []profile.Contact) error {
+	if kraPIN != "" && !kraPINPattern.MatchString(kraPIN) {
+		return fmt.Errorf("%w: kra_pin must be a letter, nine digits and a letter (e.g. P051234567X)", ErrInvalidCustomer)
+	}
+	if email != "" {
+		if _, err := mail.ParseAddress(email); err != nil {
+			return fmt.Errorf("%w: invalid email", ErrInvalidCustomer)
+		}
+	}
+	if len(currency) != 3 {
+		return fmt.Errorf("%w: default_currency must be an ISO currency code", ErrInvalidCustomer)
+	}
+	if paymentTermsDays != nil && (*paymentTermsDays < 0 || *paymentTermsDays > 365) {
+		return fmt.Errorf("%w: payment_terms_days must be between 0 and 365", ErrInvalidCustomer)
+	}
+	for i, address := range addresses {
+		if strings.TrimSpace(address.Line1) == "" {
+			return fmt.Errorf("%w: billing address %d line1 is required", ErrInvalidCustomer, i+1)
+		}
+	}
+	for i, contact := range contacts {
+		if strings.TrimSpace(contact.Name) == "" {
+			return fmt.Errorf("%w: contact %d name is required", ErrInvalidCustomer, i+1)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package dunning
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Step actions.
+const (
+	ActionReminder = "reminder"
+	ActionLateFee  = "late_fee"
+)
+
+// Late fee types.
+const (
+	LateFeeFlat    = "flat"
+	LateFeePercent = "percent"
+)
+
+// Events published for notifications-service and other consumers.
+const (
+	EventInvoiceDue      = "treasury.invoice.due"
+	EventInvoiceOverdue  = "treasury.invoice.overdue"
+	EventLateFeeCharged  = "treasury.invoice.late_fee_charged"
+	referenceTypeDunning = "dunning"
+)
+
+// Step is one step of a tenant's dunning sequence.
+type Step struct {
+	ID            uuid.UUID       `json:"id,omitempty"`
+	OffsetDays    int             `json:"offset_days"`
+	Action        string          `json:"action"`
+	Channel       string          `json:"channel,omitempty"`
+	Template      string          `json:"template,omitempty"`
+	LateFeeType   string          `json:"late_fee_type,omitempty"`
+	LateFeeAmount decimal.Decimal `json:"late_fee_amount"`
+}
+
+// Notice records a dunning step executed for an invoice.
+type Notice struct {
+	ID               uuid.UUID  `json:"id"`
+	InvoiceID        uuid.UUID  `json:"invoice_id"`
+	CustomerID       *uuid.UUID `json:"customer_id,omitempty"`
+	OffsetDays       int        `json:"offset_days"`
+	Action           string     `json:"action"`
+	Channel          string     `json:"channel,omitempty"`
+	LateFeeInvoiceID *uuid.UUID `json:"late_fee_invoice_id,omitempty"`
+	ExecutedAt       time.Time  `json:"executed_at"`
+}
+
+// Pause suspends dunning for a customer.
+type Pause struct {
+	CustomerID  uuid.UUID  `json:"customer_id"`
+	Reason      string     `json:"reason,omitempty"`
+	PausedBy    *uuid.UUID `json:"paused_by,omitempty"`
+	PausedUntil *time.Time `json:"paused_until,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// PauseRequest pauses dunning for a customer, optionally until a date.
+type PauseRequest struct {
+	Reason      string     `json:"reason"`
+	PausedUntil *time.Time `json:"paused_until"`
+}
@@ -0,0 +1,44 @@
+package dunning
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrInvalidSequence is returned when a dunning sequence is malformed.
+	ErrInvalidSequence = errors.New("invalid dunning sequence")
+	// ErrPauseNotFound is returned when a customer has no dunning pause.
+	ErrPauseNotFound = errors.New("dunning pause not found")
+	// ErrInvoiceNotFound is returned when an invoice does not exist.
+	ErrInvoiceNotFound = errors.New("invoice not found")
+	// errStepSkipped is returned when a candidate no longer qualifies for a step.
+	errStepSkipped = errors.New("dunning step skipped")
+)
+
+// Repository abstracts persistence for dunning sequences, pauses and notices.
+type Repository interface {
+	ListSteps(ctx context.Context, tenantID uuid.UUID) ([]*Step, error)
+	ReplaceSteps(ctx context.Context, tenantID uuid.UUID, steps []Step) ([]*Step, error)
+
+	ListPauses(ctx context.Context, tenantID uuid.UUID) ([]*Pause, error)
+	SavePause(ctx context.Context, tenantID, customerID uuid.UUID, req PauseRequest, pausedBy *uuid.UUID) (*Pause, error)
+	DeletePause(ctx context.Context, tenantID, customerID uuid.UUID) error
+
+	ListNotices(ctx context.Context, tenantID, invoiceID uuid.UUID) ([]*Notice, error)
+
+	// MarkOverdue moves up to limit sent invoices past their due date to
+	// overdue and enqueues an overdue event for each.
+	MarkOverdue(ctx context.Context, today time.Time, limit int) (int, error)
+	// TenantsWithSteps returns the tenants that configured a dunning sequence.
+	TenantsWithSteps(ctx context.Context) ([]uuid.UUID, error)
+	// StepCandidates returns open invoices due in [from, to) that have not
+	// had the step executed and whose customer is not paused.
+	StepCandidates(ctx context.Context, tenantID uuid.UUID, step *Step, from, to, now time.Time) ([]uuid.UUID, error)
+	// ExecuteStep runs a step for an invoice and records the notice.
+	// errStepSkipped is returned if the invoice no longer qualifies.
+	ExecuteStep(ctx context.Context, tenantID uuid.UUID, step *Step, invoiceID uuid.UUID, now time.Time) (*Notice, error)
+}
@@ -0,0 +1,566 @@
+package dunning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/dunningnotice"
+	"github.com/bengobox/treasury-api/internal/ent/dunningpause"
+	"github.com/bengobox/treasury-api/internal/ent/dunningstep"
+	"github.com/bengobox/treasury-api/internal/ent/invoice"
+	"github.com/bengobox/treasury-api/internal/ent/invoicepayment"
+	"github.com/bengobox/treasury-api/internal/modules/invoices"
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+	"github.com/bengobox/treasury-api/internal/modules/outbox"
+	"github.com/bengobox/treasury-api/internal/modules/receivables"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+)
+
+// Invoice types that never fall due.
+var exemptInvoiceTypes = []string{"credit_note", "proforma"}
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// ListSteps returns the tenant's dunning sequence ordered by offset.
+func (r *EntRepository) ListSteps(ctx context.Context, tenantID uuid.UUID) ([]*Step, error) {
+	entSteps, err := r.client.DunningStep.Query().
+		Where(dunningstep.TenantID(tenantID)).
+		Order(ent.Asc(dunningstep.FieldOffsetDays), ent.Asc(dunningstep.FieldAction)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list dunning steps: %w", err)
+	}
+
+	steps := make([]*Step, len(entSteps))
+	for i, entStep := range entSteps {
+		steps[i] = mapEntStep(entStep)
+	}
+	return steps, nil
+}
+
+// ReplaceSteps swaps the tenant's dunning sequence for the given steps.
+// Notices already recorded are kept, so re-adding a step never repeats it.
+func (r *EntRepository) ReplaceSteps(ctx context.Context, tenantID uuid.UUID, steps []Step) ([]*Step, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		if _, err := tx.DunningStep.Delete().
+			Where(dunningstep.TenantID(tenantID)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("delete dunning steps: %w", err)
+		}
+
+		builders := make([]*ent.DunningStepCreate, len(steps))
+		for i, step := range steps {
+			builder := tx.DunningStep.Create().
+				SetTenantID(tenantID).
+				SetOffsetDays(step.OffsetDays).
+				SetAction(step.Action).
+				SetChannel(step.Channel).
+				SetTemplate(step.Template).
+				SetLateFeeAmount(step.LateFeeAmount)
+			if step.Action == ActionLateFee {
+				builder.SetLateFeeType(step.LateFeeType)
+			}
+			builders[i] = builder
+		}
+
+		if len(builders) > 0 {
+			if _, err := tx.DunningStep.CreateBulk(builders...).Save(ctx); err != nil {
+				return fmt.Errorf("create dunning steps: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.ListSteps(ctx, tenantID)
+}
+
+// ListPauses returns the tenant's paused customers.
+func (r *EntRepository) ListPauses(ctx context.Context, tenantID uuid.UUID) ([]*Pause, error) {
+	entPauses, err := r.client.DunningPause.Query().
+		Where(dunningpause.TenantID(tenantID)).
+		Order(ent.Desc(dunningpause.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list dunning pauses: %w", err)
+	}
+
+	pauses := make([]*Pause, len(entPauses))
+	for i, entPause := range entPauses {
+		pauses[i] = mapEntPause(entPause)
+	}
+	return pauses, nil
+}
+
+// SavePause pauses dunning for a customer, replacing any existing pause.
+func (r *EntRepository) SavePause(ctx context.Context, tenantID, customerID uuid.UUID, req PauseRequest, pausedBy *uuid.UUID) (*Pause, error) {
+	create := r.client.DunningPause.Create().
+		SetTenantID(tenantID).
+		SetCustomerID(customerID).
+		SetReason(req.Reason).
+		SetNillablePausedBy(pausedBy).
+		SetNillablePausedUntil(req.PausedUntil)
+
+	id, err := create.
+		OnConflictColumns(dunningpause.FieldTenantID, dunningpause.FieldCustomerID).
+		Update(func(u *ent.DunningPauseUpsert) {
+			u.UpdateReason()
+			if pausedBy != nil {
+				u.UpdatePausedBy()
+			} else {
+				u.ClearPausedBy()
+			}
+			if req.PausedUntil != nil {
+				u.UpdatePausedUntil()
+			} else {
+				u.ClearPausedUntil()
+			}
+		}).
+		ID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("save dunning pause: %w", err)
+	}
+
+	entPause, err := r.client.DunningPause.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get dunning pause: %w", err)
+	}
+	return mapEntPause(entPause), nil
+}
+
+// DeletePause resumes dunning for a customer.
+func (r *EntRepository) DeletePause(ctx context.Context, tenantID, customerID uuid.UUID) error {
+	deleted, err := r.client.DunningPause.Delete().
+		Where(
+			dunningpause.TenantID(tenantID),
+			dunningpause.CustomerID(customerID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete dunning pause: %w", err)
+	}
+	if deleted == 0 {
+		return ErrPauseNotFound
+	}
+	return nil
+}
+
+// ListNotices returns the dunning history of an invoice.
+func (r *EntRepository) ListNotices(ctx context.Context, tenantID, invoiceID uuid.UUID) ([]*Notice, error) {
+	exists, err := r.client.Invoice.Query().
+		Where(invoice.ID(invoiceID), invoice.TenantID(tenantID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get invoice: %w", err)
+	}
+	if !exists {
+		return nil, ErrInvoiceNotFound
+	}
+
+	entNotices, err := r.client.DunningNotice.Query().
+		Where(
+			dunningnotice.TenantID(tenantID),
+			dunningnotice.InvoiceID(invoiceID),
+		).
+		Order(ent.Asc(dunningnotice.FieldExecutedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list dunning notices: %w", err)
+	}
+
+	notices := make([]*Notice, len(entNotices))
+	for i, entNotice := range entNotices {
+		notices[i] = mapEntNotice(entNotice)
+	}
+	return notices, nil
+}
+
+// MarkOverdue moves sent invoices whose due date has passed to overdue.
+func (r *EntRepository) MarkOverdue(ctx context.Context, today time.Time, limit int) (int, error) {
+	var marked int
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entInvoices, err := tx.Invoice.Query().
+			Where(
+				invoice.Status(invoices.StatusSent),
+				invoice.PaymentStatusIn(receivables.PaymentStatusUnpaid, receivables.PaymentStatusPartial),
+				invoice.InvoiceTypeNotIn(exemptInvoiceTypes...),
+				invoice.DueDateLT(today),
+			).
+			Order(ent.Asc(invoice.FieldDueDate)).
+			Limit(limit).
+			ForUpdate().
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("list overdue invoices: %w", err)
+		}
+		if len(entInvoices) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, len(entInvoices))
+		for i, inv := range entInvoices {
+			ids[i] = inv.ID
+		}
+		if err := tx.Invoice.Update().
+			Where(invoice.IDIn(ids...)).
+			SetStatus(invoices.StatusOverdue).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("mark invoices overdue: %w", err)
+		}
+
+		paid, err := paidAmounts(ctx, tx, ids)
+		if err != nil {
+			return err
+		}
+
+		for _, inv := range entInvoices {
+			payload := invoicePayload(inv, outstanding(inv, paid[inv.ID]), today)
+			if err := enqueueInvoiceEvent(ctx, tx, inv, EventInvoiceOverdue, payload); err != nil {
+				return err
+			}
+		}
+
+		marked = len(entInvoices)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return marked, nil
+}
+
+// TenantsWithSteps returns the tenants that configured a dunning sequence.
+func (r *EntRepository) TenantsWithSteps(ctx context.Context) ([]uuid.UUID, error) {
+	entSteps, err := r.client.DunningStep.Query().
+		Select(dunningstep.FieldTenantID).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list dunning tenants: %w", err)
+	}
+
+	seen := map[uuid.UUID]bool{}
+	var tenantIDs []uuid.UUID
+	for _, entStep := range entSteps {
+		if !seen[entStep.TenantID] {
+			seen[entStep.TenantID] = true
+			tenantIDs = append(tenantIDs, entStep.TenantID)
+		}
+	}
+	return tenantIDs, nil
+}
+
+// StepCandidates returns open invoices in the step's due window that have not
+// had the step executed and whose customer is not paused.
+func (r *EntRepository) StepCandidates(ctx context.Context, tenantID uuid.UUID, step *Step, from, to, now time.Time) ([]uuid.UUID, error) {
+	query := r.client.Invoice.Query().
+		Where(
+			invoice.TenantID(tenantID),
+			invoice.StatusIn(invoices.StatusSent, invoices.StatusOverdue),
+			invoice.PaymentStatusIn(receivables.PaymentStatusUnpaid, receivables.PaymentStatusPartial),
+			invoice.InvoiceTypeNotIn(exemptInvoiceTypes...),
+			invoice.DueDateGTE(from),
+			invoice.DueDateLT(to),
+		)
+	if step.Action == ActionLateFee {
+		// Late fees are never charged on late fees.
+		query = query.Where(invoice.Or(invoice.ReferenceTypeIsNil(), invoice.ReferenceTypeNEQ(referenceTypeDunning)))
+	}
+
+	entInvoices, err := query.
+		Order(ent.Asc(invoice.FieldDueDate)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list dunning candidates: %w", err)
+	}
+	if len(entInvoices) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, len(entInvoices))
+	for i, inv := range entInvoices {
+		ids[i] = inv.ID
+	}
+
+	done, err := r.client.DunningNotice.Query().
+		Where(
+			dunningnotice.InvoiceIDIn(ids...),
+			dunningnotice.OffsetDays(step.OffsetDays),
+			dunningnotice.Action(step.Action),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list dunning notices: %w", err)
+	}
+	executed := make(map[uuid.UUID]bool, len(done))
+	for _, entNotice := range done {
+		executed[entNotice.InvoiceID] = true
+	}
+
+	paused, err := pausedCustomers(ctx, r.client, tenantID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []uuid.UUID
+	for _, inv := range entInvoices {
+		if executed[inv.ID] || (inv.CustomerID != uuid.Nil && paused[inv.CustomerID]) {
+			continue
+		}
+		candidates = append(candidates, inv.ID)
+	}
+	return candidates, nil
+}
+
+// ExecuteStep runs a dunning step for an invoice: reminders enqueue a due
+// event for notifications-service, late fee steps raise an issued debit note.
+// The notice's unique step key guards against running a step twice.
+func (r *EntRepository) ExecuteStep(ctx context.Context, tenantID uuid.UUID, step *Step, invoiceID uuid.UUID, now time.Time) (*Notice, error) {
+	var notice *Notice
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		inv, err := tx.Invoice.Query().
+			Where(invoice.ID(invoiceID), invoice.TenantID(tenantID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrInvoiceNotFound
+			}
+			return fmt.Errorf("lock invoice: %w", err)
+		}
+		if (inv.Status != invoices.StatusSent && inv.Status != invoices.StatusOverdue) ||
+			(inv.PaymentStatus != receivables.PaymentStatusUnpaid && inv.PaymentStatus != receivables.PaymentStatusPartial) {
+			return errStepSkipped
+		}
+
+		paid, err := paidAmounts(ctx, tx, []uuid.UUID{inv.ID})
+		if err != nil {
+			return err
+		}
+		balance := outstanding(inv, paid[inv.ID])
+		if !balance.IsPositive() {
+			return errStepSkipped
+		}
+
+		create := tx.DunningNotice.Create().
+			SetTenantID(tenantID).
+			SetInvoiceID(inv.ID).
+			SetOffsetDays(step.OffsetDays).
+			SetAction(step.Action).
+			SetChannel(step.Channel).
+			SetExecutedAt(now)
+		if inv.CustomerID != uuid.Nil {
+			create.SetCustomerID(inv.CustomerID)
+		}
+
+		payload := invoicePayload(inv, balance, startOfDay(now))
+		payload["offset_days"] = step.OffsetDays
+
+		switch step.Action {
+		case ActionReminder:
+			payload["channel"] = step.Channel
+			payload["template"] = step.Template
+			if err := enqueueInvoiceEvent(ctx, tx, inv, EventInvoiceDue, payload); err != nil {
+				return err
+			}
+		case ActionLateFee:
+			fee, err := chargeLateFee(ctx, tx, inv, step, balance, now)
+			if err != nil {
+				return err
+			}
+			create.SetLateFeeInvoiceID(fee.ID)
+			payload["late_fee_invoice_id"] = fee.ID.String()
+			payload["late_fee_invoice_number"] = fee.InvoiceNumber
+			payload["late_fee_amount"] = fee.TotalAmount.String()
+			if err := enqueueInvoiceEvent(ctx, tx, inv, EventLateFeeCharged, payload); err != nil {
+				return err
+			}
+		}
+
+		entNotice, err := create.Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return errStepSkipped
+			}
+			return fmt.Errorf("record dunning notice: %w", err)
+		}
+		notice = mapEntNotice(entNotice)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return notice, nil
+}
+
+// chargeLateFee raises an issued debit note for a late fee against the
+// overdue invoice, credited to late fee income.
+func chargeLateFee(ctx context.Context, tx *ent.Tx, inv *ent.Invoice, step *Step, balance decimal.Decimal, now time.Time) (*invoices.Invoice, error) {
+	amount := lateFee(*step, balance)
+	if !amount.IsPositive() {
+		return nil, errStepSkipped
+	}
+
+	draft := invoices.Draft{
+		TenantID:       inv.TenantID,
+		InvoiceType:    "debit_note",
+		InvoiceDate:    now,
+		DueDate:        now,
+		Currency:       inv.Currency,
+		ReferenceType:  referenceTypeDunning,
+		ReferenceID:    &inv.ID,
+		RevenueAccount: ledger.AccountLateFeeIncome,
+		Issue:          true,
+		Metadata: map[string]any{
+			"late_fee_for":   inv.InvoiceNumber,
+			"offset_days":    step.OffsetDays,
+			"late_fee_type":  step.LateFeeType,
+			"late_fee_basis": balance.String(),
+		},
+		Lines: []invoices.DraftLine{{
+			Description:   fmt.Sprintf("Late payment fee on invoice %s", inv.InvoiceNumber),
+			Quantity:      decimal.NewFromInt(1),
+			UnitPrice:     amount,
+			ReferenceType: "invoice",
+			ReferenceID:   &inv.ID,
+		}},
+	}
+	if inv.CustomerID != uuid.Nil {
+		draft.CustomerID = &inv.CustomerID
+	}
+
+	return invoices.Create(ctx, tx, draft)
+}
+
+func pausedCustomers(ctx context.Context, client *ent.Client, tenantID uuid.UUID, now time.Time) (map[uuid.UUID]bool, error) {
+	entPauses, err := client.DunningPause.Query().
+		Where(
+			dunningpause.TenantID(tenantID),
+			dunningpause.Or(
+				dunningpause.PausedUntilIsNil(),
+				dunningpause.PausedUntilGT(now),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list dunning pauses: %w", err)
+	}
+
+	paused := make(map[uuid.UUID]bool, len(entPauses))
+	for _, entPause := range entPauses {
+		paused[entPause.CustomerID] = true
+	}
+	return paused, nil
+}
+
+func paidAmounts(ctx context.Context, tx *ent.Tx, invoiceIDs []uuid.UUID) (map[uuid.UUID]decimal.Decimal, error) {
+	entAllocations, err := tx.InvoicePayment.Query().
+		Where(invoicepayment.InvoiceIDIn(invoiceIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list invoice allocations: %w", err)
+	}
+
+	paid := map[uuid.UUID]decimal.Decimal{}
+	for _, entAllocation := range entAllocations {
+		paid[entAllocation.InvoiceID] = paid[entAllocation.InvoiceID].Add(entAllocation.AmountApplied)
+	}
+	return paid, nil
+}
+
+func outstanding(inv *ent.Invoice, paid decimal.Decimal) decimal.Decimal {
+	balance := inv.TotalAmount.Sub(paid).Sub(inv.WrittenOffAmount)
+	if balance.IsNegative() {
+		return decimal.Zero
+	}
+	return balance
+}
+
+func invoicePayload(inv *ent.Invoice, balance decimal.Decimal, today time.Time) map[string]any {
+	payload := map[string]any{
+		"invoice_id":     inv.ID.String(),
+		"invoice_number": inv.InvoiceNumber,
+		"amount":         inv.TotalAmount.String(),
+		"outstanding":    balance.String(),
+		"currency":       inv.Currency,
+		"due_date":       inv.DueDate.UTC().Format(time.DateOnly),
+		"days_past_due":  int(today.Sub(startOfDay(inv.DueDate)).Hours() / 24),
+	}
+	if inv.CustomerID != uuid.Nil {
+		payload["customer_id"] = inv.CustomerID.String()
+	}
+	return payload
+}
+
+func enqueueInvoiceEvent(ctx context.Context, tx *ent.Tx, inv *ent.Invoice, eventType string, payload map[string]any) error {
+	return outbox.Enqueue(ctx, tx, outbox.Event{
+		TenantID:      inv.TenantID,
+		AggregateType: "invoice",
+		AggregateID:   inv.ID,
+		EventType:     eventType,
+		Payload:       payload,
+	})
+}
+
+func mapEntStep(entStep *ent.DunningStep) *Step {
+	return &Step{
+		ID:            entStep.ID,
+		OffsetDays:    entStep.OffsetDays,
+		Action:        entStep.Action,
+		Channel:       entStep.Channel,
+		Template:      entStep.Template,
+		LateFeeType:   entStep.LateFeeType,
+		LateFeeAmount: entStep.LateFeeAmount,
+	}
+}
+
+func mapEntPause(entPause *ent.DunningPause) *Pause {
+	pause := &Pause{
+		CustomerID: entPause.CustomerID,
+		Reason:     entPause.Reason,
+		CreatedAt:  entPause.CreatedAt,
+	}
+	if entPause.PausedBy != uuid.Nil {
+		pause.PausedBy = &entPause.PausedBy
+	}
+	if !entPause.PausedUntil.IsZero() {
+		pause.PausedUntil = &entPause.PausedUntil
+	}
+	return pause
+}
+
+func mapEntNotice(entNotice *ent.DunningNotice) *Notice {
+	notice := &Notice{
+		ID:         entNotice.ID,
+		InvoiceID:  entNotice.InvoiceID,
+		OffsetDays: entNotice.OffsetDays,
+		Action:     entNotice.Action,
+		Channel:    entNotice.Channel,
+		ExecutedAt: entNotice.ExecutedAt,
+	}
+	if entNotice.CustomerID != uuid.Nil {
+		notice.CustomerID = &entNotice.CustomerID
+	}
+	if entNotice.LateFeeInvoiceID != uuid.Nil {
+		notice.LateFeeInvoiceID = &entNotice.LateFeeInvoiceID
+	}
+	return notice
+}
@@ -0,0 +1,77 @@
+package dunning
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// catchUpDays is how far back a missed step is still executed, so a worker
+// outage or a newly configured sequence does not flood customers with
+// reminders for long-overdue steps.
+const catchUpDays = 7
+
+// startOfDay truncates t to midnight UTC.
+func startOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// dueWindow returns the due date range [from, to) of invoices whose step
+// (due day + offset) falls within the catch-up window ending today.
+func dueWindow(today time.Time, offsetDays int) (from, to time.Time) {
+	to = today.AddDate(0, 0, 1-offsetDays)
+	from = to.AddDate(0, 0, -catchUpDays)
+	return from, to
+}
+
+// lateFee returns the fee a late fee step charges on the outstanding balance.
+func lateFee(step Step, outstanding decimal.Decimal) decimal.Decimal {
+	if step.LateFeeType == LateFeePercent {
+		return outstanding.Mul(step.LateFeeAmount).Round(2)
+	}
+	return step.LateFeeAmount.Round(2)
+}
+
+// validateSteps checks a dunning sequence before it replaces the current one.
+func validateSteps(steps []Step) error {
+	seen := map[string]bool{}
+	for i, step := range steps {
+		if step.OffsetDays < -60 || step.OffsetDays > 365 {
+			return fmt.Errorf("%w: step %d offset must be between -60 and 365 days", ErrInvalidSequence, i+1)
+		}
+
+		switch step.Action {
+		case ActionReminder:
+			if step.LateFeeType != "" || !step.LateFeeAmount.IsZero() {
+				return fmt.Errorf("%w: step %d reminder cannot carry a late fee", ErrInvalidSequence, i+1)
+			}
+		case ActionLateFee:
+			if step.OffsetDays <= 0 {
+				return fmt.Errorf("%w: step %d late fees only apply after the due date", ErrInvalidSequence, i+1)
+			}
+			if !step.LateFeeAmount.IsPositive() {
+				return fmt.Errorf("%w: step %d late fee amount must be positive", ErrInvalidSequence, i+1)
+			}
+			switch step.LateFeeType {
+			case LateFeeFlat:
+			case LateFeePercent:
+				if step.LateFeeAmount.GreaterThan(decimal.NewFromInt(1)) {
+					return fmt.Errorf("%w: step %d percent late fee is a fraction (0.05 for 5%%)", ErrInvalidSequence, i+1)
+				}
+			default:
+				return fmt.Errorf("%w: step %d late_fee_type must be flat or percent", ErrInvalidSequence, i+1)
+			}
+		default:
+			return fmt.Errorf("%w: step %d action must be reminder or late_fee", ErrInvalidSequence, i+1)
+		}
+
+		key := fmt.Sprintf("%d/%s", step.OffsetDays, step.Action)
+		if seen[key] {
+			return fmt.Errorf("%w: step %d duplicates another step", ErrInvalidSequence, i+1)
+		}
+		seen[key] = true
+	}
+	return nil
+}
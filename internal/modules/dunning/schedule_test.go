@@ -0,0 +1,66 @@
+package dunning
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestDueWindow(t *testing.T) {
+	today := time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)
+
+	// A reminder 3 days before due acts on invoices due on the 23rd, or up to
+	// a week earlier if the step was missed.
+	from, to := dueWindow(today, -3)
+	if !to.Equal(time.Date(2024, 3, 24, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected window end %s", to)
+	}
+	if !from.Equal(time.Date(2024, 3, 17, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected window start %s", from)
+	}
+
+	from, to = dueWindow(today, 14)
+	if !to.Equal(time.Date(2024, 3, 7, 0, 0, 0, 0, time.UTC)) || !from.Equal(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected +14 window %s - %s", from, to)
+	}
+}
+
+func TestLateFee(t *testing.T) {
+	outstanding := decimal.RequireFromString("12345.67")
+
+	flat := lateFee(Step{LateFeeType: LateFeeFlat, LateFeeAmount: decimal.NewFromInt(500)}, outstanding)
+	if !flat.Equal(decimal.NewFromInt(500)) {
+		t.Fatalf("expected flat fee of 500, got %s", flat)
+	}
+
+	percent := lateFee(Step{LateFeeType: LateFeePercent, LateFeeAmount: decimal.RequireFromString("0.02")}, outstanding)
+	if !percent.Equal(decimal.RequireFromString("246.91")) {
+		t.Fatalf("expected 2%% fee of 246.91, got %s", percent)
+	}
+}
+
+func TestValidateSteps(t *testing.T) {
+	valid := []Step{
+		{OffsetDays: -3, Action: ActionReminder},
+		{OffsetDays: 0, Action: ActionReminder},
+		{OffsetDays: 7, Action: ActionReminder},
+		{OffsetDays: 7, Action: ActionLateFee, LateFeeType: LateFeePercent, LateFeeAmount: decimal.RequireFromString("0.05")},
+	}
+	if err := validateSteps(valid); err != nil {
+		t.Fatalf("expected valid sequence, got %v", err)
+	}
+
+	invalid := [][]Step{
+		{{OffsetDays: 0, Action: ActionLateFee, LateFeeType: LateFeeFlat, LateFeeAmount: decimal.NewFromInt(100)}},
+		{{OffsetDays: 7, Action: ActionLateFee, LateFeeType: LateFeePercent, LateFeeAmount: decimal.NewFromInt(5)}},
+		{{OffsetDays: 1, Action: ActionReminder}, {OffsetDays: 1, Action: ActionReminder}},
+		{{OffsetDays: 1, Action: "sms"}},
+	}
+	for i, steps := range invalid {
+		if err := validateSteps(steps); !errors.Is(err, ErrInvalidSequence) {
+			t.Fatalf("case %d: expected ErrInvalidSequence, got %v", i, err)
+		}
+	}
+}
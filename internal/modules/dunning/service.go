@@ -0,0 +1,198 @@
+package dunning
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// overdueBatchSize bounds the invoices marked overdue per transaction.
+const overdueBatchSize = 200
+
+// runResult counts what a dunning run did.
+type runResult struct {
+	markedOverdue int
+	reminders     int
+	lateFees      int
+	failed        int
+}
+
+// Service manages dunning sequences and runs overdue detection and dunning steps.
+type Service struct {
+	repo   Repository
+	logger *zap.Logger
+}
+
+// NewService creates a new dunning service.
+func NewService(repo Repository, logger *zap.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// ListSteps returns the tenant's dunning sequence.
+func (s *Service) ListSteps(ctx context.Context, tenantID uuid.UUID) ([]*Step, error) {
+	return s.repo.ListSteps(ctx, tenantID)
+}
+
+// ReplaceSteps validates and saves the tenant's dunning sequence. An empty
+// sequence disables dunning; overdue detection still applies.
+func (s *Service) ReplaceSteps(ctx context.Context, tenantID uuid.UUID, steps []Step) ([]*Step, error) {
+	if err := validateSteps(steps); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(steps, func(i, j int) bool { return steps[i].OffsetDays < steps[j].OffsetDays })
+
+	saved, err := s.repo.ReplaceSteps(ctx, tenantID, steps)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("dunning sequence updated",
+		zap.String("tenant_id", tenantID.String()),
+		zap.Int("steps", len(saved)),
+	)
+
+	return saved, nil
+}
+
+// ListPauses returns the tenant's paused customers.
+func (s *Service) ListPauses(ctx context.Context, tenantID uuid.UUID) ([]*Pause, error) {
+	return s.repo.ListPauses(ctx, tenantID)
+}
+
+// PauseCustomer suspends dunning for a customer.
+func (s *Service) PauseCustomer(ctx context.Context, tenantID, customerID uuid.UUID, req PauseRequest, pausedBy *uuid.UUID) (*Pause, error) {
+	if req.PausedUntil != nil && !req.PausedUntil.After(time.Now()) {
+		return nil, fmt.Errorf("%w: paused_until must be in the future", ErrInvalidSequence)
+	}
+
+	pause, err := s.repo.SavePause(ctx, tenantID, customerID, req, pausedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := []zap.Field{
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("customer_id", customerID.String()),
+	}
+	if pausedBy != nil {
+		fields = append(fields, zap.String("paused_by", pausedBy.String()))
+	}
+	s.logger.Info("dunning paused", fields...)
+
+	return pause, nil
+}
+
+// ResumeCustomer removes a customer's dunning pause.
+func (s *Service) ResumeCustomer(ctx context.Context, tenantID, customerID uuid.UUID) error {
+	if err := s.repo.DeletePause(ctx, tenantID, customerID); err != nil {
+		return err
+	}
+
+	s.logger.Info("dunning resumed",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("customer_id", customerID.String()),
+	)
+
+	return nil
+}
+
+// ListNotices returns the dunning history of an invoice.
+func (s *Service) ListNotices(ctx context.Context, tenantID, invoiceID uuid.UUID) ([]*Notice, error) {
+	return s.repo.ListNotices(ctx, tenantID, invoiceID)
+}
+
+// RunDunning marks invoices past their due date overdue, then executes every
+// tenant's dunning steps that fell due since the last run. A failing invoice
+// is logged and retried on the next run.
+func (s *Service) RunDunning(ctx context.Context) error {
+	now := time.Now().UTC()
+	today := startOfDay(now)
+	result := &runResult{}
+
+	for {
+		marked, err := s.repo.MarkOverdue(ctx, today, overdueBatchSize)
+		if err != nil {
+			return fmt.Errorf("mark overdue: %w", err)
+		}
+		result.markedOverdue += marked
+		if marked < overdueBatchSize {
+			break
+		}
+	}
+
+	tenantIDs, err := s.repo.TenantsWithSteps(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, tenantID := range tenantIDs {
+		if err := s.runTenant(ctx, tenantID, now, today, result); err != nil {
+			return err
+		}
+	}
+
+	if result.markedOverdue > 0 || result.reminders > 0 || result.lateFees > 0 || result.failed > 0 {
+		s.logger.Info("dunning run completed",
+			zap.Int("marked_overdue", result.markedOverdue),
+			zap.Int("reminders", result.reminders),
+			zap.Int("late_fees", result.lateFees),
+			zap.Int("failed", result.failed),
+		)
+	}
+
+	return nil
+}
+
+func (s *Service) runTenant(ctx context.Context, tenantID uuid.UUID, now, today time.Time, result *runResult) error {
+	steps, err := s.repo.ListSteps(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+
+	for _, step := range steps {
+		from, to := dueWindow(today, step.OffsetDays)
+		invoiceIDs, err := s.repo.StepCandidates(ctx, tenantID, step, from, to, now)
+		if err != nil {
+			return err
+		}
+
+		for _, invoiceID := range invoiceIDs {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			notice, err := s.repo.ExecuteStep(ctx, tenantID, step, invoiceID, now)
+			if err != nil {
+				if errors.Is(err, errStepSkipped) {
+					continue
+				}
+				result.failed++
+				s.logger.Warn("dunning step failed",
+					zap.String("tenant_id", tenantID.String()),
+					zap.String("invoice_id", invoiceID.String()),
+					zap.Int("offset_days", step.OffsetDays),
+					zap.String("action", step.Action),
+					zap.Error(err),
+				)
+				continue
+			}
+
+			if notice.Action == ActionLateFee {
+				result.lateFees++
+			} else {
+				result.reminders++
+			}
+		}
+	}
+
+	return nil
+}
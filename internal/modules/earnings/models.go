@@ -0,0 +1,208 @@
+package earnings
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Payee types.
+const (
+	PayeeRider  = "rider"
+	PayeeDriver = "driver"
+)
+
+// Wallet entry types.
+const (
+	EntryEarning   = "earning"
+	EntryDeduction = "deduction"
+	EntryPayout    = "payout"
+)
+
+// Deduction categories.
+const (
+	DeductionAdvance   = "advance"
+	DeductionFuel      = "fuel"
+	DeductionEquipment = "equipment"
+	DeductionPenalty   = "penalty"
+	DeductionOther     = "other"
+)
+
+// Payout statuses.
+const (
+	PayoutPending   = "pending"
+	PayoutCompleted = "completed"
+	PayoutRejected  = "rejected"
+)
+
+// Reason codes of rejected payouts.
+const (
+	RejectInvalidAmount       = "invalid_amount"
+	RejectBelowMinimum        = "below_minimum"
+	RejectInsufficientBalance = "insufficient_balance"
+	// RejectDeclined: a user declined the payout.
+	RejectDeclined = "declined"
+)
+
+// Logistics events consumed and payout events published.
+const (
+	EventEarningsCalculated = "logistics.earnings.calculated"
+	EventPayoutRequested    = "logistics.payout.requested"
+	EventPayoutCompleted    = "treasury.payout.completed"
+	EventPayoutRejected     = "treasury.payout.rejected"
+)
+
+// Wallet holds a rider's or driver's earnings until they are paid out.
+type Wallet struct {
+	ID             uuid.UUID       `json:"id"`
+	TenantID       uuid.UUID       `json:"tenant_id"`
+	PayeeID        string          `json:"payee_id"`
+	PayeeType      string          `json:"payee_type"` // rider, driver
+	Name           string          `json:"name,omitempty"`
+	Phone          string          `json:"phone,omitempty"`
+	Currency       string          `json:"currency"`
+	Balance        decimal.Decimal `json:"balance"`
+	ReservedAmount decimal.Decimal `json:"reserved_amount"`
+	Available      decimal.Decimal `json:"available"`
+	TotalEarned    decimal.Decimal `json:"total_earned"`
+	TotalDeducted  decimal.Decimal `json:"total_deducted"`
+	TotalPaid      decimal.Decimal `json:"total_paid"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// Entry is a movement of a wallet's balance.
+type Entry struct {
+	ID             uuid.UUID       `json:"id"`
+	WalletID       uuid.UUID       `json:"wallet_id"`
+	EntryType      string          `json:"entry_type"` // earning, deduction, payout
+	Category       string          `json:"category,omitempty"`
+	Amount         decimal.Decimal `json:"amount"`
+	BalanceAfter   decimal.Decimal `json:"balance_after"`
+	ReferenceID    string          `json:"reference_id,omitempty"`
+	PayoutID       *uuid.UUID      `json:"payout_id,omitempty"`
+	Description    string          `json:"description,omitempty"`
+	JournalEntryID *uuid.UUID      `json:"journal_entry_id,omitempty"`
+	OccurredAt     time.Time       `json:"occurred_at"`
+	CreatedBy      *uuid.UUID      `json:"created_by,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// Payout pays part or all of a wallet's available balance to the payee.
+type Payout struct {
+	ID               uuid.UUID       `json:"id"`
+	TenantID         uuid.UUID       `json:"tenant_id"`
+	WalletID         uuid.UUID       `json:"wallet_id"`
+	PayeeID          string          `json:"payee_id,omitempty"`
+	PayoutNumber     string          `json:"payout_number"`
+	Amount           decimal.Decimal `json:"amount"`
+	Currency         string          `json:"currency"`
+	Destination      string          `json:"destination,omitempty"`
+	Status           string          `json:"status"` // pending, completed, rejected
+	RequestID        *string         `json:"request_id,omitempty"`
+	RejectionCode    string          `json:"rejection_code,omitempty"`
+	RejectionReason  string          `json:"rejection_reason,omitempty"`
+	PaymentReference string          `json:"payment_reference,omitempty"`
+	RequestedBy      *uuid.UUID      `json:"requested_by,omitempty"`
+	CompletedBy      *uuid.UUID      `json:"completed_by,omitempty"`
+	CompletedAt      *time.Time      `json:"completed_at,omitempty"`
+	JournalEntryID   *uuid.UUID      `json:"journal_entry_id,omitempty"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+}
+
+// Earnings is an earnings calculation from the logistics service: the gross
+// earned for a period and the deductions taken from it.
+type Earnings struct {
+	TenantID    uuid.UUID
+	EventID     string
+	EarningsID  string
+	PayeeID     string
+	PayeeType   string
+	Name        string
+	Phone       string
+	Currency    string
+	Amount      decimal.Decimal
+	Description string
+	Deductions  []Deduction
+	OccurredAt  time.Time
+}
+
+// Deduction is an amount recovered from a payee's earnings.
+type Deduction struct {
+	Category    string          `json:"category"` // advance, fuel, equipment, penalty, other
+	Amount      decimal.Decimal `json:"amount"`
+	Description string          `json:"description"`
+	ReferenceID string          `json:"reference_id"`
+}
+
+// EarningsCalculated is the data of a logistics.earnings.calculated event.
+type EarningsCalculated struct {
+	EarningsID   string          `json:"earnings_id"`
+	PayeeID      string          `json:"payee_id"`
+	PayeeType    string          `json:"payee_type"`
+	PayeeName    string          `json:"payee_name"`
+	Phone        string          `json:"phone"`
+	Currency     string          `json:"currency"`
+	Amount       decimal.Decimal `json:"amount"`
+	Description  string          `json:"description"`
+	Deductions   []Deduction     `json:"deductions"`
+	CalculatedAt *time.Time      `json:"calculated_at"`
+}
+
+// PayoutRequest asks for a payout from a payee's wallet. Without an amount
+// the whole available balance is paid out. RequestID makes a request from
+// the logistics service idempotent.
+type PayoutRequest struct {
+	RequestID string           `json:"payout_request_id"`
+	PayeeID   string           `json:"payee_id"`
+	Currency  string           `json:"currency"`
+	Amount    *decimal.Decimal `json:"amount"`
+}
+
+// CompletePayoutRequest records the payment of a pending payout.
+type CompletePayoutRequest struct {
+	PaymentReference string `json:"payment_reference"`
+}
+
+// RejectPayoutRequest declines a pending payout, releasing its amount.
+type RejectPayoutRequest struct {
+	Reason string `json:"reason"`
+}
+
+// Settings are a tenant's payout rules.
+type Settings struct {
+	TenantID      uuid.UUID       `json:"tenant_id"`
+	MinimumPayout decimal.Decimal `json:"minimum_payout"`
+	UpdatedAt     *time.Time      `json:"updated_at,omitempty"`
+}
+
+// DefaultSettings are used until a tenant saves its own: no minimum payout.
+func DefaultSettings(tenantID uuid.UUID) *Settings {
+	return &Settings{
+		TenantID:      tenantID,
+		MinimumPayout: decimal.Zero,
+	}
+}
+
+// UpdateSettingsRequest changes the provided settings fields.
+type UpdateSettingsRequest struct {
+	MinimumPayout *decimal.Decimal `json:"minimum_payout"`
+}
+
+// WalletFilter narrows wallet listings.
+type WalletFilter struct {
+	PayeeType string
+	PayeeID   string
+	Limit     int
+	Offset    int
+}
+
+// PayoutFilter narrows payout listings.
+type PayoutFilter struct {
+	Status   string
+	WalletID *uuid.UUID
+	Limit    int
+	Offset   int
+}
@@ -0,0 +1,70 @@
+package earnings
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrWalletNotFound is returned when a payee wallet does not exist for the tenant.
+	ErrWalletNotFound = errors.New("payee wallet not found")
+	// ErrPayoutNotFound is returned when a payout does not exist for the tenant.
+	ErrPayoutNotFound = errors.New("payout not found")
+	// ErrInvalidEntry is returned when earnings or a deduction are malformed.
+	ErrInvalidEntry = errors.New("invalid wallet entry")
+	// ErrInvalidPayout is returned when a payout request is malformed.
+	ErrInvalidPayout = errors.New("invalid payout request")
+	// ErrBelowMinimum is returned when a payout is below the tenant's minimum payout.
+	ErrBelowMinimum = errors.New("payout is below the minimum payout")
+	// ErrInsufficientBalance is returned when a payout exceeds the wallet's available balance.
+	ErrInsufficientBalance = errors.New("payout exceeds the available balance")
+	// ErrInvalidPeriod is returned when a statement period ends before it starts.
+	ErrInvalidPeriod = errors.New("invalid statement period")
+	// ErrInvalidSettings is returned when payout settings are out of range.
+	ErrInvalidSettings = errors.New("invalid earnings settings")
+	// ErrPayoutStatus is returned when the payout's status does not allow the action.
+	ErrPayoutStatus = errors.New("payout status does not allow this action")
+	// ErrPayoutExists is returned when a logistics payout request was already recorded.
+	ErrPayoutExists = errors.New("payout request already recorded")
+	// ErrSelfApproval is returned when the user who requested a payout tries to complete it.
+	ErrSelfApproval = errors.New("payout must be completed by another user")
+)
+
+// Repository abstracts persistence for payee wallets and payouts.
+type Repository interface {
+	// RecordEarnings credits an earnings calculation and its deductions to
+	// the payee's wallet, creating the wallet on first earnings. Earnings
+	// already recorded return false.
+	RecordEarnings(ctx context.Context, earnings *Earnings) (*Wallet, bool, error)
+	// AddDeduction debits a deduction from a wallet.
+	AddDeduction(ctx context.Context, tenantID, walletID uuid.UUID, deduction Deduction, createdBy *uuid.UUID, now time.Time) (*Wallet, error)
+	GetWallet(ctx context.Context, tenantID, walletID uuid.UUID) (*Wallet, error)
+	FindWallet(ctx context.Context, tenantID uuid.UUID, payeeID, currency string) (*Wallet, error)
+	ListWallets(ctx context.Context, tenantID uuid.UUID, filter WalletFilter) ([]*Wallet, error)
+	// StatementEntries returns the wallet's balance before from and its
+	// entries from from until to (exclusive), oldest first.
+	StatementEntries(ctx context.Context, tenantID, walletID uuid.UUID, from, to time.Time) (decimal.Decimal, []*Entry, error)
+
+	// RequestPayout reserves a payout from the wallet's available balance,
+	// checked against the minimum payout. A request ID already recorded
+	// returns ErrPayoutExists.
+	RequestPayout(ctx context.Context, walletID uuid.UUID, payout *Payout, minimum decimal.Decimal) (*Payout, error)
+	// RecordRejectedPayout records a logistics payout request that failed
+	// validation, and enqueues treasury.payout.rejected for it.
+	RecordRejectedPayout(ctx context.Context, walletID uuid.UUID, payout *Payout) (*Payout, error)
+	// CompletePayout pays a pending payout out of the wallet, posts it to the
+	// ledger and enqueues treasury.payout.completed.
+	CompletePayout(ctx context.Context, tenantID, payoutID uuid.UUID, paymentReference string, completedBy *uuid.UUID, now time.Time) (*Payout, error)
+	// RejectPayout declines a pending payout, releases its amount and
+	// enqueues treasury.payout.rejected.
+	RejectPayout(ctx context.Context, tenantID, payoutID uuid.UUID, reason string) (*Payout, error)
+	GetPayout(ctx context.Context, tenantID, payoutID uuid.UUID) (*Payout, error)
+	ListPayouts(ctx context.Context, tenantID uuid.UUID, filter PayoutFilter) ([]*Payout, error)
+
+	GetSettings(ctx context.Context, tenantID uuid.UUID) (*Settings, error)
+	SaveSettings(ctx context.Context, settings *Settings) (*Settings, error)
+}
@@ -0,0 +1,838 @@
+package earnings
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/earningssetting"
+	"github.com/bengobox/treasury-api/internal/ent/payeepayout"
+	"github.com/bengobox/treasury-api/internal/ent/payeewallet"
+	"github.com/bengobox/treasury-api/internal/ent/payeewalletentry"
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+	"github.com/bengobox/treasury-api/internal/modules/numbering"
+	"github.com/bengobox/treasury-api/internal/modules/outbox"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+)
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// RecordEarnings upserts the payee's wallet, then credits the earnings and
+// debits each deduction under the wallet's lock, so earnings redelivered
+// concurrently are credited once.
+func (r *EntRepository) RecordEarnings(ctx context.Context, earnings *Earnings) (*Wallet, bool, error) {
+	var (
+		walletID uuid.UUID
+		recorded bool
+	)
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		create := tx.PayeeWallet.Create().
+			SetTenantID(earnings.TenantID).
+			SetPayeeID(earnings.PayeeID).
+			SetPayeeType(earnings.PayeeType).
+			SetCurrency(earnings.Currency).
+			SetBalance(decimal.Zero).
+			SetReservedAmount(decimal.Zero).
+			SetTotalEarned(decimal.Zero).
+			SetTotalDeducted(decimal.Zero).
+			SetTotalPaid(decimal.Zero)
+		if earnings.Name != "" {
+			create.SetName(earnings.Name)
+		}
+		if earnings.Phone != "" {
+			create.SetPhone(earnings.Phone)
+		}
+		id, err := create.
+			OnConflictColumns(payeewallet.FieldTenantID, payeewallet.FieldPayeeID, payeewallet.FieldCurrency).
+			Update(func(u *ent.PayeeWalletUpsert) {
+				u.UpdatePayeeType()
+				if earnings.Name != "" {
+					u.UpdateName()
+				}
+				if earnings.Phone != "" {
+					u.UpdatePhone()
+				}
+			}).
+			ID(ctx)
+		if err != nil {
+			return fmt.Errorf("upsert payee wallet: %w", err)
+		}
+		walletID = id
+
+		entWallet, err := lockWallet(ctx, tx, earnings.TenantID, id)
+		if err != nil {
+			return err
+		}
+
+		exists, err := tx.PayeeWalletEntry.Query().
+			Where(
+				payeewalletentry.TenantID(earnings.TenantID),
+				payeewalletentry.EntryType(EntryEarning),
+				payeewalletentry.ReferenceID(earnings.EarningsID),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check payee earnings: %w", err)
+		}
+		if exists {
+			return nil
+		}
+
+		b := newTotals(entWallet)
+		if err := addEntry(ctx, tx, entWallet, b, &Entry{
+			EntryType:   EntryEarning,
+			Amount:      earnings.Amount,
+			ReferenceID: earnings.EarningsID,
+			Description: earnings.Description,
+			OccurredAt:  earnings.OccurredAt,
+		}, earnings.EventID); err != nil {
+			return err
+		}
+		for _, deduction := range earnings.Deductions {
+			if err := addEntry(ctx, tx, entWallet, b, &Entry{
+				EntryType:   EntryDeduction,
+				Category:    deduction.Category,
+				Amount:      deduction.Amount.Neg(),
+				ReferenceID: deduction.ReferenceID,
+				Description: deduction.Description,
+				OccurredAt:  earnings.OccurredAt,
+			}, earnings.EventID); err != nil {
+				return err
+			}
+		}
+
+		recorded = true
+		return b.save(ctx, entWallet)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	wallet, err := r.GetWallet(ctx, earnings.TenantID, walletID)
+	if err != nil {
+		return nil, false, err
+	}
+	return wallet, recorded, nil
+}
+
+// AddDeduction debits a deduction from the wallet. The balance may go
+// negative: an advance larger than the earnings is recovered from later
+// earnings.
+func (r *EntRepository) AddDeduction(ctx context.Context, tenantID, walletID uuid.UUID, deduction Deduction, createdBy *uuid.UUID, now time.Time) (*Wallet, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entWallet, err := lockWallet(ctx, tx, tenantID, walletID)
+		if err != nil {
+			return err
+		}
+
+		b := newTotals(entWallet)
+		if err := addEntry(ctx, tx, entWallet, b, &Entry{
+			EntryType:   EntryDeduction,
+			Category:    deduction.Category,
+			Amount:      deduction.Amount.Neg(),
+			ReferenceID: deduction.ReferenceID,
+			Description: deduction.Description,
+			OccurredAt:  now,
+			CreatedBy:   createdBy,
+		}, ""); err != nil {
+			return err
+		}
+		return b.save(ctx, entWallet)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetWallet(ctx, tenantID, walletID)
+}
+
+// GetWallet retrieves a payee wallet by ID.
+func (r *EntRepository) GetWallet(ctx context.Context, tenantID, walletID uuid.UUID) (*Wallet, error) {
+	entWallet, err := r.client.PayeeWallet.Query().
+		Where(
+			payeewallet.ID(walletID),
+			payeewallet.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrWalletNotFound
+		}
+		return nil, fmt.Errorf("get payee wallet: %w", err)
+	}
+
+	return mapEntWallet(entWallet), nil
+}
+
+// FindWallet retrieves the payee's wallet in a currency.
+func (r *EntRepository) FindWallet(ctx context.Context, tenantID uuid.UUID, payeeID, currency string) (*Wallet, error) {
+	entWallet, err := r.client.PayeeWallet.Query().
+		Where(
+			payeewallet.TenantID(tenantID),
+			payeewallet.PayeeID(payeeID),
+			payeewallet.Currency(currency),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrWalletNotFound
+		}
+		return nil, fmt.Errorf("find payee wallet: %w", err)
+	}
+
+	return mapEntWallet(entWallet), nil
+}
+
+// ListWallets returns the tenant's payee wallets.
+func (r *EntRepository) ListWallets(ctx context.Context, tenantID uuid.UUID, filter WalletFilter) ([]*Wallet, error) {
+	query := r.client.PayeeWallet.Query().
+		Where(payeewallet.TenantID(tenantID))
+	if filter.PayeeType != "" {
+		query = query.Where(payeewallet.PayeeType(filter.PayeeType))
+	}
+	if filter.PayeeID != "" {
+		query = query.Where(payeewallet.PayeeID(filter.PayeeID))
+	}
+
+	entWallets, err := query.
+		Order(ent.Asc(payeewallet.FieldPayeeID), ent.Asc(payeewallet.FieldCurrency)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list payee wallets: %w", err)
+	}
+
+	wallets := make([]*Wallet, len(entWallets))
+	for i, entWallet := range entWallets {
+		wallets[i] = mapEntWallet(entWallet)
+	}
+	return wallets, nil
+}
+
+// StatementEntries selects entries by when they were recorded, the order
+// their running balances follow.
+func (r *EntRepository) StatementEntries(ctx context.Context, tenantID, walletID uuid.UUID, from, to time.Time) (decimal.Decimal, []*Entry, error) {
+	opening := decimal.Zero
+	last, err := r.client.PayeeWalletEntry.Query().
+		Where(
+			payeewalletentry.TenantID(tenantID),
+			payeewalletentry.WalletID(walletID),
+			payeewalletentry.CreatedAtLT(from),
+		).
+		Order(ent.Desc(payeewalletentry.FieldCreatedAt)).
+		First(ctx)
+	switch {
+	case err == nil:
+		opening = last.BalanceAfter
+	case !ent.IsNotFound(err):
+		return decimal.Zero, nil, fmt.Errorf("get opening wallet balance: %w", err)
+	}
+
+	entEntries, err := r.client.PayeeWalletEntry.Query().
+		Where(
+			payeewalletentry.TenantID(tenantID),
+			payeewalletentry.WalletID(walletID),
+			payeewalletentry.CreatedAtGTE(from),
+			payeewalletentry.CreatedAtLT(to),
+		).
+		Order(ent.Asc(payeewalletentry.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return decimal.Zero, nil, fmt.Errorf("list wallet entries: %w", err)
+	}
+
+	entries := make([]*Entry, len(entEntries))
+	for i, entEntry := range entEntries {
+		entries[i] = mapEntEntry(entEntry)
+	}
+	return opening, entries, nil
+}
+
+// RequestPayout checks the payout against the wallet's available balance
+// under the wallet's lock, so concurrent requests cannot reserve more than
+// the balance. A payout without an amount takes the whole available balance.
+func (r *EntRepository) RequestPayout(ctx context.Context, walletID uuid.UUID, payout *Payout, minimum decimal.Decimal) (*Payout, error) {
+	var payoutID uuid.UUID
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entWallet, err := lockWallet(ctx, tx, payout.TenantID, walletID)
+		if err != nil {
+			return err
+		}
+
+		if payout.RequestID != nil {
+			exists, err := tx.PayeePayout.Query().
+				Where(
+					payeepayout.TenantID(payout.TenantID),
+					payeepayout.RequestID(*payout.RequestID),
+				).
+				Exist(ctx)
+			if err != nil {
+				return fmt.Errorf("check payout request: %w", err)
+			}
+			if exists {
+				return fmt.Errorf("%w: %s", ErrPayoutExists, *payout.RequestID)
+			}
+		}
+
+		free := available(entWallet.Balance, entWallet.ReservedAmount)
+		amount := payout.Amount
+		if amount.IsZero() {
+			if !free.IsPositive() {
+				return fmt.Errorf("%w: nothing is available to pay out", ErrInsufficientBalance)
+			}
+			amount = free
+		}
+		if err := checkPayout(amount, free, minimum); err != nil {
+			return err
+		}
+
+		entPayout, err := createPayout(ctx, tx, entWallet, payout, amount, PayoutPending)
+		if err != nil {
+			return err
+		}
+		payoutID = entPayout.ID
+
+		if err := entWallet.Update().
+			SetReservedAmount(entWallet.ReservedAmount.Add(amount)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("reserve payout: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetPayout(ctx, payout.TenantID, payoutID)
+}
+
+// RecordRejectedPayout keeps the rejected request, so the logistics service
+// is told once why it was refused.
+func (r *EntRepository) RecordRejectedPayout(ctx context.Context, walletID uuid.UUID, payout *Payout) (*Payout, error) {
+	var payoutID uuid.UUID
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entWallet, err := lockWallet(ctx, tx, payout.TenantID, walletID)
+		if err != nil {
+			return err
+		}
+
+		entPayout, err := createPayout(ctx, tx, entWallet, payout, payout.Amount, PayoutRejected)
+		if err != nil {
+			return err
+		}
+		payoutID = entPayout.ID
+
+		return enqueueRejected(ctx, tx, entWallet, entPayout)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetPayout(ctx, payout.TenantID, payoutID)
+}
+
+// CompletePayout settles the payout's reserved amount from the wallet.
+func (r *EntRepository) CompletePayout(ctx context.Context, tenantID, payoutID uuid.UUID, paymentReference string, completedBy *uuid.UUID, now time.Time) (*Payout, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entPayout, err := lockPayout(ctx, tx, tenantID, payoutID)
+		if err != nil {
+			return err
+		}
+		if completedBy != nil && entPayout.RequestedBy == *completedBy {
+			return ErrSelfApproval
+		}
+
+		entWallet, err := lockWallet(ctx, tx, tenantID, entPayout.WalletID)
+		if err != nil {
+			return err
+		}
+
+		b := newTotals(entWallet)
+		b.reserved = b.reserved.Sub(entPayout.Amount)
+		entry := &Entry{
+			EntryType:   EntryPayout,
+			Amount:      entPayout.Amount.Neg(),
+			ReferenceID: entPayout.PayoutNumber,
+			PayoutID:    &entPayout.ID,
+			Description: "Payout " + entPayout.PayoutNumber,
+			OccurredAt:  now,
+			CreatedBy:   completedBy,
+		}
+		if err := addEntry(ctx, tx, entWallet, b, entry, ""); err != nil {
+			return err
+		}
+		if err := b.save(ctx, entWallet); err != nil {
+			return err
+		}
+
+		update := entPayout.Update().
+			SetStatus(PayoutCompleted).
+			SetPaymentReference(paymentReference).
+			SetCompletedAt(now).
+			SetJournalEntryID(*entry.JournalEntryID)
+		if completedBy != nil {
+			update.SetCompletedBy(*completedBy)
+		}
+		completed, err := update.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("complete payout: %w", err)
+		}
+
+		return outbox.Enqueue(ctx, tx, outbox.Event{
+			TenantID:      tenantID,
+			AggregateType: "payee_payout",
+			AggregateID:   completed.ID,
+			EventType:     EventPayoutCompleted,
+			Payload: map[string]any{
+				"payout_id":         completed.ID.String(),
+				"payout_number":     completed.PayoutNumber,
+				"payout_request_id": requestID(completed),
+				"payee_id":          entWallet.PayeeID,
+				"payee_type":        entWallet.PayeeType,
+				"amount":            completed.Amount.String(),
+				"currency":          completed.Currency,
+				"destination":       completed.Destination,
+				"payment_reference": paymentReference,
+				"balance":           b.balance.String(),
+				"journal_entry_id":  entry.JournalEntryID.String(),
+				"completed_at":      now.UTC().Format(time.RFC3339),
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetPayout(ctx, tenantID, payoutID)
+}
+
+// RejectPayout declines the payout and releases its reserved amount.
+func (r *EntRepository) RejectPayout(ctx context.Context, tenantID, payoutID uuid.UUID, reason string) (*Payout, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entPayout, err := lockPayout(ctx, tx, tenantID, payoutID)
+		if err != nil {
+			return err
+		}
+
+		entWallet, err := lockWallet(ctx, tx, tenantID, entPayout.WalletID)
+		if err != nil {
+			return err
+		}
+		if err := entWallet.Update().
+			SetReservedAmount(entWallet.ReservedAmount.Sub(entPayout.Amount)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("release payout: %w", err)
+		}
+
+		rejected, err := entPayout.Update().
+			SetStatus(PayoutRejected).
+			SetRejectionCode(RejectDeclined).
+			SetRejectionReason(reason).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("reject payout: %w", err)
+		}
+
+		return enqueueRejected(ctx, tx, entWallet, rejected)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetPayout(ctx, tenantID, payoutID)
+}
+
+// GetPayout retrieves a payout by ID.
+func (r *EntRepository) GetPayout(ctx context.Context, tenantID, payoutID uuid.UUID) (*Payout, error) {
+	entPayout, err := r.client.PayeePayout.Query().
+		Where(
+			payeepayout.ID(payoutID),
+			payeepayout.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrPayoutNotFound
+		}
+		return nil, fmt.Errorf("get payout: %w", err)
+	}
+
+	payouts, err := r.mapPayouts(ctx, []*ent.PayeePayout{entPayout})
+	if err != nil {
+		return nil, err
+	}
+	return payouts[0], nil
+}
+
+// ListPayouts returns the tenant's payouts, newest first.
+func (r *EntRepository) ListPayouts(ctx context.Context, tenantID uuid.UUID, filter PayoutFilter) ([]*Payout, error) {
+	query := r.client.PayeePayout.Query().
+		Where(payeepayout.TenantID(tenantID))
+	if filter.Status != "" {
+		query = query.Where(payeepayout.Status(filter.Status))
+	}
+	if filter.WalletID != nil {
+		query = query.Where(payeepayout.WalletID(*filter.WalletID))
+	}
+
+	entPayouts, err := query.
+		Order(ent.Desc(payeepayout.FieldCreatedAt)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list payouts: %w", err)
+	}
+
+	return r.mapPayouts(ctx, entPayouts)
+}
+
+// GetSettings returns the tenant's settings, or the defaults if none are saved.
+func (r *EntRepository) GetSettings(ctx context.Context, tenantID uuid.UUID) (*Settings, error) {
+	entSettings, err := r.client.EarningsSetting.Query().
+		Where(earningssetting.TenantID(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DefaultSettings(tenantID), nil
+		}
+		return nil, fmt.Errorf("get earnings settings: %w", err)
+	}
+
+	return mapEntSettings(entSettings), nil
+}
+
+// SaveSettings creates or replaces the tenant's settings.
+func (r *EntRepository) SaveSettings(ctx context.Context, settings *Settings) (*Settings, error) {
+	id, err := r.client.EarningsSetting.Create().
+		SetTenantID(settings.TenantID).
+		SetMinimumPayout(settings.MinimumPayout).
+		OnConflictColumns(earningssetting.FieldTenantID).
+		UpdateNewValues().
+		ID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("save earnings settings: %w", err)
+	}
+
+	entSettings, err := r.client.EarningsSetting.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get earnings settings: %w", err)
+	}
+
+	return mapEntSettings(entSettings), nil
+}
+
+// totals tracks a locked wallet's running balance and totals while entries
+// are added.
+type totals struct {
+	balance  decimal.Decimal
+	reserved decimal.Decimal
+	earned   decimal.Decimal
+	deducted decimal.Decimal
+	paid     decimal.Decimal
+}
+
+func newTotals(entWallet *ent.PayeeWallet) *totals {
+	return &totals{
+		balance:  entWallet.Balance,
+		reserved: entWallet.ReservedAmount,
+		earned:   entWallet.TotalEarned,
+		deducted: entWallet.TotalDeducted,
+		paid:     entWallet.TotalPaid,
+	}
+}
+
+func (b *totals) save(ctx context.Context, entWallet *ent.PayeeWallet) error {
+	if err := entWallet.Update().
+		SetBalance(b.balance).
+		SetReservedAmount(b.reserved).
+		SetTotalEarned(b.earned).
+		SetTotalDeducted(b.deducted).
+		SetTotalPaid(b.paid).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("update payee wallet: %w", err)
+	}
+	return nil
+}
+
+// addEntry posts the entry's journal, records it against the running
+// balance and sets its journal entry ID.
+func addEntry(ctx context.Context, tx *ent.Tx, entWallet *ent.PayeeWallet, b *totals, entry *Entry, eventID string) error {
+	amount := entry.Amount.Abs()
+	switch entry.EntryType {
+	case EntryEarning:
+		b.earned = b.earned.Add(amount)
+	case EntryDeduction:
+		b.deducted = b.deducted.Add(amount)
+	case EntryPayout:
+		b.paid = b.paid.Add(amount)
+	}
+	b.balance = b.balance.Add(entry.Amount)
+
+	description := entry.Description
+	if description == "" {
+		description = entry.EntryType
+		if entry.Category != "" {
+			description += " " + entry.Category
+		}
+	}
+	journalID, err := ledger.PostJournal(ctx, tx, ledger.Journal{
+		TenantID:      entWallet.TenantID,
+		EntryDate:     entry.OccurredAt,
+		Currency:      entWallet.Currency,
+		ReferenceType: "payee_wallet",
+		ReferenceID:   entWallet.ID,
+		Description:   fmt.Sprintf("%s: %s", entWallet.PayeeID, description),
+		Metadata: map[string]any{
+			"entry_type":   entry.EntryType,
+			"category":     entry.Category,
+			"reference_id": entry.ReferenceID,
+		},
+		Lines: entryJournalLines(entry.EntryType, entry.Category, amount),
+	})
+	if err != nil {
+		return fmt.Errorf("post wallet entry journal: %w", err)
+	}
+	entry.JournalEntryID = &journalID
+
+	create := tx.PayeeWalletEntry.Create().
+		SetTenantID(entWallet.TenantID).
+		SetWalletID(entWallet.ID).
+		SetEntryType(entry.EntryType).
+		SetCategory(entry.Category).
+		SetAmount(entry.Amount).
+		SetBalanceAfter(b.balance).
+		SetReferenceID(entry.ReferenceID).
+		SetNillablePayoutID(entry.PayoutID).
+		SetEventID(eventID).
+		SetDescription(entry.Description).
+		SetJournalEntryID(journalID).
+		SetOccurredAt(entry.OccurredAt).
+		SetNillableCreatedBy(entry.CreatedBy)
+	if err := create.Exec(ctx); err != nil {
+		return fmt.Errorf("create wallet entry: %w", err)
+	}
+	return nil
+}
+
+func createPayout(ctx context.Context, tx *ent.Tx, entWallet *ent.PayeeWallet, payout *Payout, amount decimal.Decimal, status string) (*ent.PayeePayout, error) {
+	number, err := numbering.Next(ctx, tx, entWallet.TenantID, numbering.SequencePayeePayout, numbering.PrefixPayeePayout)
+	if err != nil {
+		return nil, err
+	}
+
+	entPayout, err := tx.PayeePayout.Create().
+		SetTenantID(entWallet.TenantID).
+		SetWalletID(entWallet.ID).
+		SetPayoutNumber(number).
+		SetAmount(amount).
+		SetCurrency(entWallet.Currency).
+		SetDestination(entWallet.Phone).
+		SetStatus(status).
+		SetNillableRequestID(payout.RequestID).
+		SetRejectionCode(payout.RejectionCode).
+		SetRejectionReason(payout.RejectionReason).
+		SetNillableRequestedBy(payout.RequestedBy).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrPayoutExists
+		}
+		return nil, fmt.Errorf("create payout: %w", err)
+	}
+	return entPayout, nil
+}
+
+func enqueueRejected(ctx context.Context, tx *ent.Tx, entWallet *ent.PayeeWallet, entPayout *ent.PayeePayout) error {
+	return outbox.Enqueue(ctx, tx, outbox.Event{
+		TenantID:      entWallet.TenantID,
+		AggregateType: "payee_payout",
+		AggregateID:   entPayout.ID,
+		EventType:     EventPayoutRejected,
+		Payload: map[string]any{
+			"payout_id":         entPayout.ID.String(),
+			"payout_number":     entPayout.PayoutNumber,
+			"payout_request_id": requestID(entPayout),
+			"payee_id":          entWallet.PayeeID,
+			"payee_type":        entWallet.PayeeType,
+			"amount":            entPayout.Amount.String(),
+			"currency":          entPayout.Currency,
+			"reason_code":       entPayout.RejectionCode,
+			"reason":            entPayout.RejectionReason,
+			"available":         available(entWallet.Balance, entWallet.ReservedAmount).String(),
+		},
+	})
+}
+
+func lockWallet(ctx context.Context, tx *ent.Tx, tenantID, walletID uuid.UUID) (*ent.PayeeWallet, error) {
+	entWallet, err := tx.PayeeWallet.Query().
+		Where(
+			payeewallet.ID(walletID),
+			payeewallet.TenantID(tenantID),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrWalletNotFound
+		}
+		return nil, fmt.Errorf("lock payee wallet: %w", err)
+	}
+	return entWallet, nil
+}
+
+// lockPayout locks a pending payout.
+func lockPayout(ctx context.Context, tx *ent.Tx, tenantID, payoutID uuid.UUID) (*ent.PayeePayout, error) {
+	entPayout, err := tx.PayeePayout.Query().
+		Where(
+			payeepayout.ID(payoutID),
+			payeepayout.TenantID(tenantID),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrPayoutNotFound
+		}
+		return nil, fmt.Errorf("lock payout: %w", err)
+	}
+	if entPayout.Status != PayoutPending {
+		return nil, fmt.Errorf("%w: payout is %s", ErrPayoutStatus, entPayout.Status)
+	}
+	return entPayout, nil
+}
+
+func requestID(entPayout *ent.PayeePayout) string {
+	if entPayout.RequestID == nil {
+		return ""
+	}
+	return *entPayout.RequestID
+}
+
+// mapPayouts maps payouts with their wallets' payee IDs.
+func (r *EntRepository) mapPayouts(ctx context.Context, entPayouts []*ent.PayeePayout) ([]*Payout, error) {
+	walletIDs := make([]uuid.UUID, 0, len(entPayouts))
+	for _, entPayout := range entPayouts {
+		walletIDs = append(walletIDs, entPayout.WalletID)
+	}
+	entWallets, err := r.client.PayeeWallet.Query().
+		Where(payeewallet.IDIn(walletIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load payee wallets: %w", err)
+	}
+	payees := make(map[uuid.UUID]string, len(entWallets))
+	for _, entWallet := range entWallets {
+		payees[entWallet.ID] = entWallet.PayeeID
+	}
+
+	payouts := make([]*Payout, len(entPayouts))
+	for i, entPayout := range entPayouts {
+		payouts[i] = mapEntPayout(entPayout)
+		payouts[i].PayeeID = payees[entPayout.WalletID]
+	}
+	return payouts, nil
+}
+
+func mapEntWallet(entWallet *ent.PayeeWallet) *Wallet {
+	return &Wallet{
+		ID:             entWallet.ID,
+		TenantID:       entWallet.TenantID,
+		PayeeID:        entWallet.PayeeID,
+		PayeeType:      entWallet.PayeeType,
+		Name:           entWallet.Name,
+		Phone:          entWallet.Phone,
+		Currency:       entWallet.Currency,
+		Balance:        entWallet.Balance,
+		ReservedAmount: entWallet.ReservedAmount,
+		Available:      available(entWallet.Balance, entWallet.ReservedAmount),
+		TotalEarned:    entWallet.TotalEarned,
+		TotalDeducted:  entWallet.TotalDeducted,
+		TotalPaid:      entWallet.TotalPaid,
+		CreatedAt:      entWallet.CreatedAt,
+		UpdatedAt:      entWallet.UpdatedAt,
+	}
+}
+
+func mapEntEntry(entEntry *ent.PayeeWalletEntry) *Entry {
+	entry := &Entry{
+		ID:           entEntry.ID,
+		WalletID:     entEntry.WalletID,
+		EntryType:    entEntry.EntryType,
+		Category:     entEntry.Category,
+		Amount:       entEntry.Amount,
+		BalanceAfter: entEntry.BalanceAfter,
+		ReferenceID:  entEntry.ReferenceID,
+		Description:  entEntry.Description,
+		OccurredAt:   entEntry.OccurredAt,
+		CreatedAt:    entEntry.CreatedAt,
+	}
+
+	// Optional fields - check for zero values
+	if entEntry.PayoutID != uuid.Nil {
+		entry.PayoutID = &entEntry.PayoutID
+	}
+	if entEntry.JournalEntryID != uuid.Nil {
+		entry.JournalEntryID = &entEntry.JournalEntryID
+	}
+	if entEntry.CreatedBy != uuid.Nil {
+		entry.CreatedBy = &entEntry.CreatedBy
+	}
+	return entry
+}
+
+func mapEntPayout(entPayout *ent.PayeePayout) *Payout {
+	payout := &Payout{
+		ID:               entPayout.ID,
+		TenantID:         entPayout.TenantID,
+		WalletID:         entPayout.WalletID,
+		PayoutNumber:     entPayout.PayoutNumber,
+		Amount:           entPayout.Amount,
+		Currency:         entPayout.Currency,
+		Destination:      entPayout.Destination,
+		Status:           entPayout.Status,
+		RequestID:        entPayout.RequestID,
+		RejectionCode:    entPayout.RejectionCode,
+		RejectionReason:  entPayout.RejectionReason,
+		PaymentReference: entPayout.PaymentReference,
+		CreatedAt:        entPayout.CreatedAt,
+		UpdatedAt:        entPayout.UpdatedAt,
+	}
+
+	// Optional fields - check for zero values
+	if entPayout.RequestedBy != uuid.Nil {
+		payout.RequestedBy = &entPayout.RequestedBy
+	}
+	if entPayout.CompletedBy != uuid.Nil {
+		payout.CompletedBy = &entPayout.CompletedBy
+	}
+	if !entPayout.CompletedAt.IsZero() {
+		payout.CompletedAt = &entPayout.CompletedAt
+	}
+	if entPayout.JournalEntryID != uuid.Nil {
+		payout.JournalEntryID = &entPayout.JournalEntryID
+	}
+	return payout
+}
+
+func mapEntSettings(entSettings *ent.EarningsSetting) *Settings {
+	updatedAt := entSettings.UpdatedAt
+	return &Settings{
+		TenantID:      entSettings.TenantID,
+		MinimumPayout: entSettings.MinimumPayout,
+		UpdatedAt:     &updatedAt,
+	}
+}
@@ -0,0 +1,370 @@
+package earnings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/platform/events"
+)
+
+// Service keeps riders' and drivers' earnings wallets: earnings and
+// deductions from the logistics service, and payouts of the available
+// balance.
+type Service struct {
+	repo   Repository
+	logger *zap.Logger
+}
+
+// NewService creates a new earnings service.
+func NewService(repo Repository, logger *zap.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// HandleEarningsCalculated consumes logistics.earnings.calculated. The
+// earnings ID is the deduplication key, so redelivered events are credited
+// once. Malformed earnings fail permanently.
+func (s *Service) HandleEarningsCalculated(ctx context.Context, env events.Envelope) error {
+	var data EarningsCalculated
+	if err := env.DecodeData(&data); err != nil {
+		return err
+	}
+
+	earnings, err := earningsFromEvent(env, data)
+	if err != nil {
+		return events.Permanent(err)
+	}
+
+	wallet, recorded, err := s.repo.RecordEarnings(ctx, earnings)
+	if err != nil {
+		return err
+	}
+	if recorded {
+		s.logger.Info("payee earnings recorded",
+			zap.String("tenant_id", earnings.TenantID.String()),
+			zap.String("payee_id", earnings.PayeeID),
+			zap.String("earnings_id", earnings.EarningsID),
+			zap.String("amount", earnings.Amount.String()),
+			zap.Int("deductions", len(earnings.Deductions)),
+			zap.String("balance", wallet.Balance.String()),
+		)
+	}
+	return nil
+}
+
+// HandlePayoutRequested consumes logistics.payout.requested. A request that
+// fails validation is recorded as rejected and treasury.payout.rejected tells
+// the logistics service why; a request already recorded is ignored.
+func (s *Service) HandlePayoutRequested(ctx context.Context, env events.Envelope) error {
+	var req PayoutRequest
+	if err := env.DecodeData(&req); err != nil {
+		return err
+	}
+	req.RequestID = strings.TrimSpace(req.RequestID)
+	if req.RequestID == "" {
+		return events.Permanent(fmt.Errorf("%w: payout_request_id is required", ErrInvalidPayout))
+	}
+
+	payout, err := s.requestPayout(ctx, env.TenantID, req, nil)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ErrPayoutExists):
+		return nil
+	case errors.Is(err, ErrWalletNotFound):
+		return events.Permanent(err)
+	case errors.Is(err, ErrInvalidPayout), errors.Is(err, ErrBelowMinimum), errors.Is(err, ErrInsufficientBalance):
+	default:
+		return err
+	}
+
+	if payout == nil {
+		// The payee has no wallet to record the request against.
+		return events.Permanent(err)
+	}
+	payout.Status = PayoutRejected
+	payout.RejectionCode = rejectionCode(err)
+	payout.RejectionReason = err.Error()
+	rejected, recordErr := s.repo.RecordRejectedPayout(ctx, payout.WalletID, payout)
+	if recordErr != nil {
+		if errors.Is(recordErr, ErrPayoutExists) {
+			return nil
+		}
+		return recordErr
+	}
+
+	s.logger.Warn("payout request rejected",
+		zap.String("tenant_id", env.TenantID.String()),
+		zap.String("payout_id", rejected.ID.String()),
+		zap.String("payout_request_id", req.RequestID),
+		zap.String("payee_id", req.PayeeID),
+		zap.String("reason_code", rejected.RejectionCode),
+	)
+	return nil
+}
+
+// RequestPayout reserves a payout from the payee's wallet for an operator
+// to complete. Without an amount the whole available balance is requested.
+func (s *Service) RequestPayout(ctx context.Context, tenantID uuid.UUID, req PayoutRequest, requestedBy *uuid.UUID) (*Payout, error) {
+	req.RequestID = strings.TrimSpace(req.RequestID)
+	payout, err := s.requestPayout(ctx, tenantID, req, requestedBy)
+	if err != nil {
+		return nil, err
+	}
+	return payout, nil
+}
+
+// requestPayout returns the unsaved payout along with a validation error, so
+// a rejected logistics request can be recorded.
+func (s *Service) requestPayout(ctx context.Context, tenantID uuid.UUID, req PayoutRequest, requestedBy *uuid.UUID) (*Payout, error) {
+	payeeID := strings.TrimSpace(req.PayeeID)
+	if payeeID == "" {
+		return nil, fmt.Errorf("%w: payee_id is required", ErrInvalidPayout)
+	}
+	currency := strings.ToUpper(strings.TrimSpace(req.Currency))
+	if currency == "" {
+		currency = "KES"
+	}
+
+	wallet, err := s.repo.FindWallet(ctx, tenantID, payeeID, currency)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := s.repo.GetSettings(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	payout := &Payout{
+		TenantID:    tenantID,
+		WalletID:    wallet.ID,
+		PayeeID:     wallet.PayeeID,
+		Amount:      decimal.Zero,
+		Currency:    wallet.Currency,
+		RequestedBy: requestedBy,
+	}
+	if req.RequestID != "" {
+		payout.RequestID = &req.RequestID
+	}
+	if req.Amount != nil {
+		if !req.Amount.IsPositive() {
+			return payout, fmt.Errorf("%w: amount must be positive", ErrInvalidPayout)
+		}
+		payout.Amount = *req.Amount
+	}
+
+	requested, err := s.repo.RequestPayout(ctx, wallet.ID, payout, settings.MinimumPayout)
+	if err != nil {
+		return payout, err
+	}
+
+	s.logger.Info("payout requested",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("payout_id", requested.ID.String()),
+		zap.String("payout_number", requested.PayoutNumber),
+		zap.String("payee_id", wallet.PayeeID),
+		zap.String("amount", requested.Amount.String()),
+	)
+	return requested, nil
+}
+
+// CompletePayout records the payment of a pending payout, made by M-Pesa or
+// bank transfer outside treasury, and publishes treasury.payout.completed.
+// The user who requested the payout cannot complete it.
+func (s *Service) CompletePayout(ctx context.Context, tenantID, payoutID uuid.UUID, req CompletePayoutRequest, completedBy *uuid.UUID) (*Payout, error) {
+	reference := strings.TrimSpace(req.PaymentReference)
+	if reference == "" {
+		return nil, fmt.Errorf("%w: payment_reference is required", ErrInvalidPayout)
+	}
+
+	payout, err := s.repo.CompletePayout(ctx, tenantID, payoutID, reference, completedBy, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("payout completed",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("payout_id", payout.ID.String()),
+		zap.String("payout_number", payout.PayoutNumber),
+		zap.String("payee_id", payout.PayeeID),
+		zap.String("amount", payout.Amount.String()),
+	)
+	return payout, nil
+}
+
+// RejectPayout declines a pending payout, releasing its amount back to the
+// available balance.
+func (s *Service) RejectPayout(ctx context.Context, tenantID, payoutID uuid.UUID, req RejectPayoutRequest) (*Payout, error) {
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, fmt.Errorf("%w: reason is required", ErrInvalidPayout)
+	}
+
+	payout, err := s.repo.RejectPayout(ctx, tenantID, payoutID, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("payout rejected",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("payout_id", payout.ID.String()),
+		zap.String("payout_number", payout.PayoutNumber),
+		zap.String("payee_id", payout.PayeeID),
+	)
+	return payout, nil
+}
+
+// AddDeduction deducts an advance, fuel or other charge from the payee's
+// wallet.
+func (s *Service) AddDeduction(ctx context.Context, tenantID, walletID uuid.UUID, deduction Deduction, createdBy *uuid.UUID) (*Wallet, error) {
+	deduction.Category = strings.TrimSpace(deduction.Category)
+	deduction.Description = strings.TrimSpace(deduction.Description)
+	deduction.ReferenceID = strings.TrimSpace(deduction.ReferenceID)
+	if err := validateDeduction(deduction); err != nil {
+		return nil, err
+	}
+
+	wallet, err := s.repo.AddDeduction(ctx, tenantID, walletID, deduction, createdBy, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("payee deduction recorded",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("wallet_id", walletID.String()),
+		zap.String("category", deduction.Category),
+		zap.String("amount", deduction.Amount.String()),
+	)
+	return wallet, nil
+}
+
+// Statement builds the payee's earnings statement from from until to,
+// inclusive. The period defaults to the current month to date.
+func (s *Service) Statement(ctx context.Context, tenantID, walletID uuid.UUID, from, to time.Time) (*Statement, error) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	if to.IsZero() {
+		to = today
+	}
+	if from.IsZero() {
+		from = time.Date(to.Year(), to.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	if to.Before(from) {
+		return nil, fmt.Errorf("%w: to cannot be before from", ErrInvalidPeriod)
+	}
+
+	wallet, err := s.repo.GetWallet(ctx, tenantID, walletID)
+	if err != nil {
+		return nil, err
+	}
+
+	opening, entries, err := s.repo.StatementEntries(ctx, tenantID, walletID, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	return buildStatement(wallet, from, to, opening, entries), nil
+}
+
+// GetWallet retrieves a payee wallet.
+func (s *Service) GetWallet(ctx context.Context, tenantID, walletID uuid.UUID) (*Wallet, error) {
+	return s.repo.GetWallet(ctx, tenantID, walletID)
+}
+
+// ListWallets returns the tenant's payee wallets.
+func (s *Service) ListWallets(ctx context.Context, tenantID uuid.UUID, filter WalletFilter) ([]*Wallet, error) {
+	return s.repo.ListWallets(ctx, tenantID, filter)
+}
+
+// GetPayout retrieves a payout.
+func (s *Service) GetPayout(ctx context.Context, tenantID, payoutID uuid.UUID) (*Payout, error) {
+	return s.repo.GetPayout(ctx, tenantID, payoutID)
+}
+
+// ListPayouts returns the tenant's payouts; filtering by the pending status
+// gives the queue of payouts to pay.
+func (s *Service) ListPayouts(ctx context.Context, tenantID uuid.UUID, filter PayoutFilter) ([]*Payout, error) {
+	return s.repo.ListPayouts(ctx, tenantID, filter)
+}
+
+// GetSettings returns the tenant's payout settings.
+func (s *Service) GetSettings(ctx context.Context, tenantID uuid.UUID) (*Settings, error) {
+	return s.repo.GetSettings(ctx, tenantID)
+}
+
+// UpdateSettings changes the tenant's minimum payout. It applies to payouts
+// requested afterwards.
+func (s *Service) UpdateSettings(ctx context.Context, tenantID uuid.UUID, req UpdateSettingsRequest) (*Settings, error) {
+	settings, err := s.repo.GetSettings(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.MinimumPayout != nil {
+		settings.MinimumPayout = *req.MinimumPayout
+	}
+	if settings.MinimumPayout.IsNegative() {
+		return nil, fmt.Errorf("%w: minimum_payout cannot be negative", ErrInvalidSettings)
+	}
+
+	return s.repo.SaveSettings(ctx, settings)
+}
+
+func earningsFromEvent(env events.Envelope, data EarningsCalculated) (*Earnings, error) {
+	data.EarningsID = strings.TrimSpace(data.EarningsID)
+	data.PayeeID = strings.TrimSpace(data.PayeeID)
+	if data.EarningsID == "" || data.PayeeID == "" {
+		return nil, fmt.Errorf("%w: earnings_id and payee_id are required", ErrInvalidEntry)
+	}
+	if !data.Amount.IsPositive() || !data.Amount.Equal(data.Amount.Round(2)) {
+		return nil, fmt.Errorf("%w: amount must be positive with at most two decimal places", ErrInvalidEntry)
+	}
+
+	payeeType := strings.TrimSpace(data.PayeeType)
+	switch payeeType {
+	case "":
+		payeeType = PayeeRider
+	case PayeeRider, PayeeDriver:
+	default:
+		return nil, fmt.Errorf("%w: payee_type must be rider or driver", ErrInvalidEntry)
+	}
+	currency := strings.ToUpper(strings.TrimSpace(data.Currency))
+	if currency == "" {
+		currency = "KES"
+	}
+	occurredAt := env.Timestamp
+	if data.CalculatedAt != nil {
+		occurredAt = *data.CalculatedAt
+	}
+	if occurredAt.IsZero() {
+		occurredAt = time.Now().UTC()
+	}
+
+	earnings := &Earnings{
+		TenantID:    env.TenantID,
+		EventID:     env.EventID,
+		EarningsID:  data.EarningsID,
+		PayeeID:     data.PayeeID,
+		PayeeType:   payeeType,
+		Name:        strings.TrimSpace(data.PayeeName),
+		Phone:       strings.TrimSpace(data.Phone),
+		Currency:    currency,
+		Amount:      data.Amount,
+		Description: data.Description,
+		OccurredAt:  occurredAt,
+	}
+	for i, deduction := range data.Deductions {
+		if err := validateDeduction(deduction); err != nil {
+			return nil, fmt.Errorf("deduction %d: %w", i+1, err)
+		}
+		earnings.Deductions = append(earnings.Deductions, deduction)
+	}
+	return earnings, nil
+}
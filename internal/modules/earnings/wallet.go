@@ -0,0 +1,157 @@
+package earnings
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+)
+
+var deductionCategories = map[string]bool{
+	DeductionAdvance:   true,
+	DeductionFuel:      true,
+	DeductionEquipment: true,
+	DeductionPenalty:   true,
+	DeductionOther:     true,
+}
+
+// available is the part of a wallet's balance not held by pending payouts.
+// A negative balance, from advances exceeding earnings, leaves nothing.
+func available(balance, reserved decimal.Decimal) decimal.Decimal {
+	if free := balance.Sub(reserved); free.IsPositive() {
+		return free
+	}
+	return decimal.Zero
+}
+
+// checkPayout validates a payout amount against the wallet's available
+// balance and the tenant's minimum payout.
+func checkPayout(amount, free, minimum decimal.Decimal) error {
+	if !amount.IsPositive() || !amount.Equal(amount.Round(2)) {
+		return fmt.Errorf("%w: amount must be positive with at most two decimal places", ErrInvalidPayout)
+	}
+	if amount.LessThan(minimum) {
+		return fmt.Errorf("%w: minimum payout is %s", ErrBelowMinimum, minimum.StringFixed(2))
+	}
+	if amount.GreaterThan(free) {
+		return fmt.Errorf("%w: available balance is %s", ErrInsufficientBalance, free.StringFixed(2))
+	}
+	return nil
+}
+
+// rejectionCode returns the reason code of a payout validation error.
+func rejectionCode(err error) string {
+	switch {
+	case errors.Is(err, ErrBelowMinimum):
+		return RejectBelowMinimum
+	case errors.Is(err, ErrInsufficientBalance):
+		return RejectInsufficientBalance
+	}
+	return RejectInvalidAmount
+}
+
+// validateDeduction checks a deduction's category and amount.
+func validateDeduction(d Deduction) error {
+	if !deductionCategories[d.Category] {
+		return fmt.Errorf("%w: deduction category must be advance, fuel, equipment, penalty or other", ErrInvalidEntry)
+	}
+	if !d.Amount.IsPositive() || !d.Amount.Equal(d.Amount.Round(2)) {
+		return fmt.Errorf("%w: deduction amount must be positive with at most two decimal places", ErrInvalidEntry)
+	}
+	return nil
+}
+
+// entryJournalLines books a wallet entry of the given absolute amount.
+// Earnings are an expense owed to the payee; an advance deduction recovers
+// the advance, other deductions reduce the earnings expense; a payout settles
+// what is owed from cash.
+func entryJournalLines(entryType, category string, amount decimal.Decimal) []ledger.Line {
+	switch entryType {
+	case EntryEarning:
+		return []ledger.Line{
+			ledger.Debit(ledger.AccountPayeeEarnings, amount),
+			ledger.Credit(ledger.AccountPayeesPayable, amount),
+		}
+	case EntryDeduction:
+		credit := ledger.AccountPayeeEarnings
+		if category == DeductionAdvance {
+			credit = ledger.AccountPayeeAdvances
+		}
+		return []ledger.Line{
+			ledger.Debit(ledger.AccountPayeesPayable, amount),
+			ledger.Credit(credit, amount),
+		}
+	}
+	return []ledger.Line{
+		ledger.Debit(ledger.AccountPayeesPayable, amount),
+		ledger.Credit(ledger.AccountCash, amount),
+	}
+}
+
+// Statement is a payee's earnings statement for a period.
+type Statement struct {
+	Wallet         *Wallet         `json:"wallet"`
+	From           time.Time       `json:"from"`
+	To             time.Time       `json:"to"`
+	OpeningBalance decimal.Decimal `json:"opening_balance"`
+	Earned         decimal.Decimal `json:"earned"`
+	Deducted       decimal.Decimal `json:"deducted"`
+	Paid           decimal.Decimal `json:"paid"`
+	ClosingBalance decimal.Decimal `json:"closing_balance"`
+	Entries        []*Entry        `json:"entries"`
+}
+
+// buildStatement totals the period's entries from the opening balance.
+func buildStatement(wallet *Wallet, from, to time.Time, opening decimal.Decimal, entries []*Entry) *Statement {
+	statement := &Statement{
+		Wallet:         wallet,
+		From:           from,
+		To:             to,
+		OpeningBalance: opening,
+		Earned:         decimal.Zero,
+		Deducted:       decimal.Zero,
+		Paid:           decimal.Zero,
+		ClosingBalance: opening,
+		Entries:        entries,
+	}
+	for _, entry := range entries {
+		switch entry.EntryType {
+		case EntryEarning:
+			statement.Earned = statement.Earned.Add(entry.Amount)
+		case EntryDeduction:
+			statement.Deducted = statement.Deducted.Sub(entry.Amount)
+		case EntryPayout:
+			statement.Paid = statement.Paid.Sub(entry.Amount)
+		}
+		statement.ClosingBalance = statement.ClosingBalance.Add(entry.Amount)
+	}
+	return statement
+}
+
+// WriteCSV writes the statement one line per entry, between opening and
+// closing balance lines.
+func (s *Statement) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	records := [][]string{
+		{"date", "entry_type", "category", "reference", "description", "amount", "balance"},
+		{s.From.Format(time.DateOnly), "opening_balance", "", "", "", "", s.OpeningBalance.StringFixed(2)},
+	}
+	for _, entry := range s.Entries {
+		records = append(records, []string{
+			entry.OccurredAt.Format(time.DateOnly), entry.EntryType, entry.Category, entry.ReferenceID,
+			entry.Description, entry.Amount.StringFixed(2), entry.BalanceAfter.StringFixed(2),
+		})
+	}
+	records = append(records, []string{s.To.Format(time.DateOnly), "closing_balance", "", "", "", "", s.ClosingBalance.StringFixed(2)})
+
+	if err := writer.WriteAll(records); err != nil {
+		return err
+	}
+	return writer.Error()
+}
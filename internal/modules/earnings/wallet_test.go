@@ -0,0 +1,100 @@
+package earnings
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+)
+
+func TestCheckPayout(t *testing.T) {
+	d := decimal.RequireFromString
+	free := available(d("1500"), d("500"))
+	if !free.Equal(d("1000")) {
+		t.Fatalf("expected 1000 available, got %s", free)
+	}
+	if !available(d("-200"), decimal.Zero).IsZero() {
+		t.Fatal("expected nothing available from a negative balance")
+	}
+
+	tests := []struct {
+		name   string
+		amount string
+		code   string
+	}{
+		{"valid", "1000", ""},
+		{"zero", "0", RejectInvalidAmount},
+		{"cents", "100.005", RejectInvalidAmount},
+		{"below minimum", "99.99", RejectBelowMinimum},
+		{"over available", "1000.01", RejectInsufficientBalance},
+	}
+
+	for _, tt := range tests {
+		err := checkPayout(d(tt.amount), free, d("100"))
+		if tt.code == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || rejectionCode(err) != tt.code {
+			t.Errorf("%s: expected %s, got %v", tt.name, tt.code, err)
+		}
+	}
+}
+
+func TestEntryJournalLines(t *testing.T) {
+	amount := decimal.RequireFromString("300")
+	tests := []struct {
+		entryType string
+		category  string
+		debit     string
+		credit    string
+	}{
+		{EntryEarning, "", ledger.AccountPayeeEarnings, ledger.AccountPayeesPayable},
+		{EntryDeduction, DeductionAdvance, ledger.AccountPayeesPayable, ledger.AccountPayeeAdvances},
+		{EntryDeduction, DeductionFuel, ledger.AccountPayeesPayable, ledger.AccountPayeeEarnings},
+		{EntryPayout, "", ledger.AccountPayeesPayable, ledger.AccountCash},
+	}
+
+	for _, tt := range tests {
+		lines := entryJournalLines(tt.entryType, tt.category, amount)
+		if len(lines) != 2 || lines[0].AccountCode != tt.debit || lines[1].AccountCode != tt.credit {
+			t.Errorf("%s %s: unexpected lines %+v", tt.entryType, tt.category, lines)
+		}
+	}
+}
+
+func TestBuildStatement(t *testing.T) {
+	d := decimal.RequireFromString
+	day := time.Date(2024, 10, 12, 18, 0, 0, 0, time.UTC)
+	entries := []*Entry{
+		{EntryType: EntryEarning, Amount: d("2450"), BalanceAfter: d("2650"), OccurredAt: day},
+		{EntryType: EntryDeduction, Category: DeductionFuel, Amount: d("-300"), BalanceAfter: d("2350"), OccurredAt: day},
+		{EntryType: EntryPayout, Amount: d("-2000"), BalanceAfter: d("350"), OccurredAt: day.Add(time.Hour)},
+	}
+
+	statement := buildStatement(&Wallet{}, day.Truncate(24*time.Hour), day.Truncate(24*time.Hour), d("200"), entries)
+	if !statement.Earned.Equal(d("2450")) || !statement.Deducted.Equal(d("300")) || !statement.Paid.Equal(d("2000")) {
+		t.Fatalf("unexpected totals earned %s deducted %s paid %s", statement.Earned, statement.Deducted, statement.Paid)
+	}
+	if !statement.ClosingBalance.Equal(d("350")) {
+		t.Fatalf("expected closing balance 350, got %s", statement.ClosingBalance)
+	}
+
+	var buf bytes.Buffer
+	if err := statement.WriteCSV(&buf); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 6 {
+		t.Fatalf("expected 6 csv lines, got %d", len(lines))
+	}
+	if lines[5] != "2024-10-12,closing_balance,,,,,350.00" {
+		t.Fatalf("unexpected closing line %q", lines[5])
+	}
+}
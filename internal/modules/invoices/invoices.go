@@ -0,0 +1,326 @@
+package invoices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/invoice"
+	"github.com/bengobox/treasury-api/internal/ent/invoiceline"
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+	"github.com/bengobox/treasury-api/internal/modules/numbering"
+	"github.com/bengobox/treasury-api/internal/modules/outbox"
+)
+
+// EventInvoiceCreated is published when an invoice is issued.
+const EventInvoiceCreated = "treasury.invoice.created"
+
+var (
+	// ErrInvoiceNotFound is returned when the invoice does not exist for the tenant.
+	ErrInvoiceNotFound = errors.New("invoice not found")
+	// ErrInvoiceNotDraft is returned when issuing an invoice that was already issued.
+	ErrInvoiceNotDraft = errors.New("invoice is not a draft")
+	// ErrInvalidDraft is returned when a draft cannot produce a valid invoice.
+	ErrInvalidDraft = errors.New("invalid invoice draft")
+)
+
+// Create numbers and stores an invoice with its lines inside the caller's
+// transaction. When draft.Issue is set the invoice is issued immediately.
+func Create(ctx context.Context, tx *ent.Tx, draft Draft) (*Invoice, error) {
+	if err := validateDraft(draft); err != nil {
+		return nil, err
+	}
+
+	invoiceDate := draft.InvoiceDate
+	if invoiceDate.IsZero() {
+		invoiceDate = time.Now()
+	}
+	dueDate := draft.DueDate
+	if dueDate.IsZero() {
+		dueDate = invoiceDate
+	}
+	currency := draft.Currency
+	if currency == "" {
+		currency = "KES"
+	}
+	invoiceType := draft.InvoiceType
+	if invoiceType == "" {
+		invoiceType = "standard"
+	}
+	metadata := draft.Metadata
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+
+	number, err := numbering.Next(ctx, tx, draft.TenantID, numbering.SequenceInvoice, numbering.PrefixInvoice)
+	if err != nil {
+		return nil, err
+	}
+
+	subtotal, taxTotal := decimal.Zero, decimal.Zero
+	for _, line := range draft.Lines {
+		lineTotal, tax := LineAmounts(line.Quantity, line.UnitPrice, line.TaxRate)
+		subtotal = subtotal.Add(lineTotal)
+		taxTotal = taxTotal.Add(tax)
+	}
+	total := subtotal.Add(taxTotal)
+	if total.IsNegative() {
+		return nil, fmt.Errorf("%w: invoice total cannot be negative", ErrInvalidDraft)
+	}
+
+	builder := tx.Invoice.Create().
+		SetTenantID(draft.TenantID).
+		SetInvoiceNumber(number).
+		SetInvoiceType(invoiceType).
+		SetInvoiceDate(invoiceDate).
+		SetDueDate(dueDate).
+		SetSubtotal(subtotal).
+		SetTaxAmount(taxTotal).
+		SetTotalAmount(total).
+		SetWrittenOffAmount(decimal.Zero).
+		SetCurrency(currency).
+		SetStatus(StatusDraft).
+		SetMetadata(metadata)
+	if draft.CustomerID != nil {
+		builder.SetCustomerID(*draft.CustomerID)
+	}
+	if draft.ReferenceID != nil {
+		builder.SetReferenceID(*draft.ReferenceID)
+	}
+	if draft.ReferenceType != "" {
+		builder.SetReferenceType(draft.ReferenceType)
+	}
+
+	entInvoice, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create invoice: %w", err)
+	}
+
+	lineBuilders := make([]*ent.InvoiceLineCreate, 0, len(draft.Lines))
+	for i, line := range draft.Lines {
+		lineTotal, tax := LineAmounts(line.Quantity, line.UnitPrice, line.TaxRate)
+		revenueAccount := line.RevenueAccount
+		if revenueAccount == "" {
+			revenueAccount = draft.RevenueAccount
+		}
+		lineMetadata := line.Metadata
+		if lineMetadata == nil {
+			lineMetadata = map[string]any{}
+		}
+
+		lb := tx.InvoiceLine.Create().
+			SetTenantID(draft.TenantID).
+			SetInvoiceID(entInvoice.ID).
+			SetLineNumber(i + 1).
+			SetDescription(line.Description).
+			SetQuantity(line.Quantity).
+			SetUnitPrice(line.UnitPrice).
+			SetTaxRate(line.TaxRate).
+			SetTaxAmount(tax).
+			SetLineTotal(lineTotal).
+			SetMetadata(lineMetadata)
+		if revenueAccount != "" {
+			lb.SetRevenueAccount(revenueAccount)
+		}
+		if line.ReferenceType != "" {
+			lb.SetReferenceType(line.ReferenceType)
+		}
+		if line.ReferenceID != nil {
+			lb.SetReferenceID(*line.ReferenceID)
+		}
+		lineBuilders = append(lineBuilders, lb)
+	}
+
+	entLines, err := tx.InvoiceLine.CreateBulk(lineBuilders...).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create invoice lines: %w", err)
+	}
+
+	created := mapEntInvoice(entInvoice, entLines)
+	if !draft.Issue {
+		return created, nil
+	}
+
+	return Issue(ctx, tx, draft.TenantID, entInvoice.ID)
+}
+
+// Issue posts the receivable journal for a draft invoice, marks it sent and
+// enqueues the treasury.invoice.created event.
+func Issue(ctx context.Context, tx *ent.Tx, tenantID, invoiceID uuid.UUID) (*Invoice, error) {
+	entInvoice, err := tx.Invoice.Query().
+		Where(invoice.ID(invoiceID), invoice.TenantID(tenantID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvoiceNotFound
+		}
+		return nil, fmt.Errorf("load invoice: %w", err)
+	}
+	if entInvoice.Status != StatusDraft {
+		return nil, ErrInvoiceNotDraft
+	}
+
+	entLines, err := tx.InvoiceLine.Query().
+		Where(invoiceline.InvoiceID(invoiceID)).
+		Order(ent.Asc(invoiceline.FieldLineNumber)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load invoice lines: %w", err)
+	}
+
+	inv := mapEntInvoice(entInvoice, entLines)
+
+	if inv.TotalAmount.IsPositive() {
+		_, err := ledger.PostJournal(ctx, tx, ledger.Journal{
+			TenantID:      tenantID,
+			EntryDate:     inv.InvoiceDate,
+			Currency:      inv.Currency,
+			ReferenceType: "invoice",
+			ReferenceID:   inv.ID,
+			Description:   "Invoice " + inv.InvoiceNumber + " issued",
+			Lines:         receivableJournalLines(inv.Lines, ledger.AccountSalesRevenue),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("post invoice journal: %w", err)
+		}
+	}
+
+	entInvoice, err = entInvoice.Update().
+		SetStatus(StatusSent).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("issue invoice: %w", err)
+	}
+	inv.Status = entInvoice.Status
+	inv.UpdatedAt = entInvoice.UpdatedAt
+
+	payload := map[string]any{
+		"invoice_id":     inv.ID.String(),
+		"invoice_number": inv.InvoiceNumber,
+		"amount":         inv.TotalAmount.String(),
+		"currency":       inv.Currency,
+		"due_date":       inv.DueDate.Format("2006-01-02"),
+	}
+	if inv.CustomerID != nil {
+		payload["customer_id"] = inv.CustomerID.String()
+	}
+	if inv.ReferenceType != "" {
+		payload["reference_type"] = inv.ReferenceType
+	}
+	if inv.ReferenceID != nil {
+		payload["reference_id"] = inv.ReferenceID.String()
+	}
+
+	err = outbox.Enqueue(ctx, tx, outbox.Event{
+		TenantID:      tenantID,
+		AggregateType: "invoice",
+		AggregateID:   inv.ID,
+		EventType:     EventInvoiceCreated,
+		Payload:       payload,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+// Get loads an invoice with its lines.
+func Get(ctx context.Context, client *ent.Client, tenantID, invoiceID uuid.UUID) (*Invoice, error) {
+	entInvoice, err := client.Invoice.Query().
+		Where(invoice.ID(invoiceID), invoice.TenantID(tenantID)).
+		WithLines(func(q *ent.InvoiceLineQuery) {
+			q.Order(ent.Asc(invoiceline.FieldLineNumber))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvoiceNotFound
+		}
+		return nil, fmt.Errorf("get invoice: %w", err)
+	}
+
+	return mapEntInvoice(entInvoice, entInvoice.Edges.Lines), nil
+}
+
+func validateDraft(draft Draft) error {
+	if draft.TenantID == uuid.Nil {
+		return fmt.Errorf("%w: tenant is required", ErrInvalidDraft)
+	}
+	if len(draft.Lines) == 0 {
+		return fmt.Errorf("%w: at least one line is required", ErrInvalidDraft)
+	}
+	for i, line := range draft.Lines {
+		if line.Description == "" {
+			return fmt.Errorf("%w: line %d description is required", ErrInvalidDraft, i+1)
+		}
+		if !line.Quantity.IsPositive() {
+			return fmt.Errorf("%w: line %d quantity must be positive", ErrInvalidDraft, i+1)
+		}
+		if line.TaxRate.IsNegative() {
+			return fmt.Errorf("%w: line %d tax rate cannot be negative", ErrInvalidDraft, i+1)
+		}
+	}
+	if !draft.DueDate.IsZero() && !draft.InvoiceDate.IsZero() && draft.DueDate.Before(draft.InvoiceDate) {
+		return fmt.Errorf("%w: due date precedes invoice date", ErrInvalidDraft)
+	}
+	return nil
+}
+
+func mapEntInvoice(entInvoice *ent.Invoice, entLines []*ent.InvoiceLine) *Invoice {
+	inv := &Invoice{
+		ID:            entInvoice.ID,
+		TenantID:      entInvoice.TenantID,
+		InvoiceNumber: entInvoice.InvoiceNumber,
+		InvoiceType:   entInvoice.InvoiceType,
+		InvoiceDate:   entInvoice.InvoiceDate,
+		DueDate:       entInvoice.DueDate,
+		Subtotal:      entInvoice.Subtotal,
+		TaxAmount:     entInvoice.TaxAmount,
+		TotalAmount:   entInvoice.TotalAmount,
+		Currency:      entInvoice.Currency,
+		Status:        entInvoice.Status,
+		PaymentStatus: entInvoice.PaymentStatus,
+		ReferenceType: entInvoice.ReferenceType,
+		Metadata:      entInvoice.Metadata,
+		CreatedAt:     entInvoice.CreatedAt,
+		UpdatedAt:     entInvoice.UpdatedAt,
+	}
+	if entInvoice.CustomerID != uuid.Nil {
+		id := entInvoice.CustomerID
+		inv.CustomerID = &id
+	}
+	if entInvoice.ReferenceID != uuid.Nil {
+		id := entInvoice.ReferenceID
+		inv.ReferenceID = &id
+	}
+
+	inv.Lines = make([]*Line, 0, len(entLines))
+	for _, entLine := range entLines {
+		line := &Line{
+			ID:             entLine.ID,
+			LineNumber:     entLine.LineNumber,
+			Description:    entLine.Description,
+			Quantity:       entLine.Quantity,
+			UnitPrice:      entLine.UnitPrice,
+			TaxRate:        entLine.TaxRate,
+			TaxAmount:      entLine.TaxAmount,
+			LineTotal:      entLine.LineTotal,
+			RevenueAccount: entLine.RevenueAccount,
+			ReferenceType:  entLine.ReferenceType,
+		}
+		if entLine.ReferenceID != uuid.Nil {
+			id := entLine.ReferenceID
+			line.ReferenceID = &id
+		}
+		inv.Lines = append(inv.Lines, line)
+	}
+
+	return inv
+}
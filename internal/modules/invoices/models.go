@@ -0,0 +1,86 @@
+package invoices
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Invoice statuses.
+const (
+	StatusDraft      = "draft"
+	StatusSent       = "sent"
+	StatusPaid       = "paid"
+	StatusOverdue    = "overdue"
+	StatusCancelled  = "cancelled"
+	StatusWrittenOff = "written_off"
+)
+
+// Invoice represents an invoice with its lines.
+type Invoice struct {
+	ID            uuid.UUID       `json:"id"`
+	TenantID      uuid.UUID       `json:"tenant_id"`
+	InvoiceNumber string          `json:"invoice_number"`
+	CustomerID    *uuid.UUID      `json:"customer_id,omitempty"`
+	InvoiceType   string          `json:"invoice_type"`
+	InvoiceDate   time.Time       `json:"invoice_date"`
+	DueDate       time.Time       `json:"due_date"`
+	Subtotal      decimal.Decimal `json:"subtotal"`
+	TaxAmount     decimal.Decimal `json:"tax_amount"`
+	TotalAmount   decimal.Decimal `json:"total_amount"`
+	Currency      string          `json:"currency"`
+	Status        string          `json:"status"`
+	PaymentStatus string          `json:"payment_status"`
+	ReferenceID   *uuid.UUID      `json:"reference_id,omitempty"`
+	ReferenceType string          `json:"reference_type,omitempty"`
+	Metadata      map[string]any  `json:"metadata,omitempty"`
+	Lines         []*Line         `json:"lines,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+// Line represents an invoice line item.
+type Line struct {
+	ID             uuid.UUID       `json:"id"`
+	LineNumber     int             `json:"line_number"`
+	Description    string          `json:"description"`
+	Quantity       decimal.Decimal `json:"quantity"`
+	UnitPrice      decimal.Decimal `json:"unit_price"`
+	TaxRate        decimal.Decimal `json:"tax_rate"`
+	TaxAmount      decimal.Decimal `json:"tax_amount"`
+	LineTotal      decimal.Decimal `json:"line_total"`
+	RevenueAccount string          `json:"revenue_account,omitempty"`
+	ReferenceType  string          `json:"reference_type,omitempty"`
+	ReferenceID    *uuid.UUID      `json:"reference_id,omitempty"`
+}
+
+// Draft describes an invoice to be created.
+type Draft struct {
+	TenantID      uuid.UUID
+	CustomerID    *uuid.UUID
+	InvoiceType   string // standard, recurring, ...
+	InvoiceDate   time.Time
+	DueDate       time.Time
+	Currency      string
+	ReferenceType string
+	ReferenceID   *uuid.UUID
+	// RevenueAccount is credited for lines without their own account.
+	RevenueAccount string
+	// Issue posts the receivable journal and moves the invoice to sent on creation.
+	Issue    bool
+	Metadata map[string]any
+	Lines    []DraftLine
+}
+
+// DraftLine describes an invoice line to be created.
+type DraftLine struct {
+	Description    string
+	Quantity       decimal.Decimal
+	UnitPrice      decimal.Decimal
+	TaxRate        decimal.Decimal
+	RevenueAccount string
+	ReferenceType  string
+	ReferenceID    *uuid.UUID
+	Metadata       map[string]any
+}
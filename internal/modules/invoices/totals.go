@@ -0,0 +1,71 @@
+package invoices
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+)
+
+// LineAmounts returns the pre-tax total and tax of a line, rounded to cents.
+func LineAmounts(quantity, unitPrice, taxRate decimal.Decimal) (lineTotal, tax decimal.Decimal) {
+	lineTotal = quantity.Mul(unitPrice).Round(2)
+	tax = lineTotal.Mul(taxRate).Round(2)
+	return lineTotal, tax
+}
+
+// Total returns the amount the draft will be invoiced for, tax included.
+func (d Draft) Total() decimal.Decimal {
+	total := decimal.Zero
+	for _, line := range d.Lines {
+		lineTotal, tax := LineAmounts(line.Quantity, line.UnitPrice, line.TaxRate)
+		total = total.Add(lineTotal).Add(tax)
+	}
+	return total
+}
+
+// receivableJournalLines builds the journal that recognises an issued invoice:
+// the total is debited to receivables and credited to revenue per account and
+// to output VAT. Negative net amounts (credits on the invoice) flip sides.
+func receivableJournalLines(lines []*Line, defaultRevenueAccount string) []ledger.Line {
+	total := decimal.Zero
+	tax := decimal.Zero
+	revenue := map[string]decimal.Decimal{}
+	for _, line := range lines {
+		account := line.RevenueAccount
+		if account == "" {
+			account = defaultRevenueAccount
+		}
+		revenue[account] = revenue[account].Add(line.LineTotal)
+		tax = tax.Add(line.TaxAmount)
+		total = total.Add(line.LineTotal).Add(line.TaxAmount)
+	}
+
+	journal := []ledger.Line{ledger.Debit(ledger.AccountReceivable, total)}
+
+	accounts := make([]string, 0, len(revenue))
+	for account := range revenue {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+
+	for _, account := range accounts {
+		journal = appendSigned(journal, account, revenue[account])
+	}
+	journal = appendSigned(journal, ledger.AccountVATOutput, tax)
+
+	return journal
+}
+
+// appendSigned appends a credit for positive amounts and a debit for negative ones.
+func appendSigned(lines []ledger.Line, account string, amount decimal.Decimal) []ledger.Line {
+	switch {
+	case amount.IsPositive():
+		return append(lines, ledger.Credit(account, amount))
+	case amount.IsNegative():
+		return append(lines, ledger.Debit(account, amount.Neg()))
+	default:
+		return lines
+	}
+}
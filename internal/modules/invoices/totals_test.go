@@ -0,0 +1,37 @@
+package invoices
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+)
+
+func TestLineAmounts(t *testing.T) {
+	lineTotal, tax := LineAmounts(decimal.RequireFromString("3"), decimal.RequireFromString("33.335"), decimal.RequireFromString("0.16"))
+
+	if !lineTotal.Equal(decimal.RequireFromString("100.01")) {
+		t.Fatalf("expected line total 100.01, got %s", lineTotal)
+	}
+	if !tax.Equal(decimal.RequireFromString("16")) {
+		t.Fatalf("expected tax 16.00, got %s", tax)
+	}
+}
+
+func TestReceivableJournalLinesBalance(t *testing.T) {
+	lines := []*Line{
+		{LineTotal: decimal.NewFromInt(1000), TaxAmount: decimal.NewFromInt(160)},
+		{LineTotal: decimal.NewFromInt(-200), RevenueAccount: ledger.AccountSubscriptionRevenue},
+		{LineTotal: decimal.NewFromInt(500), RevenueAccount: ledger.AccountSubscriptionRevenue, TaxAmount: decimal.NewFromInt(80)},
+	}
+
+	journal := ledger.Journal{TenantID: [16]byte{1}, Lines: receivableJournalLines(lines, ledger.AccountSalesRevenue)}
+	if err := journal.Validate(); err != nil {
+		t.Fatalf("expected balanced journal, got %v", err)
+	}
+
+	if !journal.Lines[0].Debit.Equal(decimal.NewFromInt(1540)) {
+		t.Fatalf("expected receivable debit of 1540, got %s", journal.Lines[0].Debit)
+	}
+}
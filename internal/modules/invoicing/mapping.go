@@ -0,0 +1,141 @@
+package invoicing
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/invoices"
+)
+
+// ErrInvalidSourceEvent is returned when an upstream event cannot be mapped to
+// an invoice.
+var ErrInvalidSourceEvent = errors.New("invalid source event")
+
+// orderDraft maps an order to an invoice draft. Itemised orders become one
+// line per item, taxed at the item's rate or the tenant default. Orders
+// without items are invoiced as a single untaxed line for the order total so
+// the invoice matches what the customer was charged.
+func orderDraft(tenantID uuid.UUID, order OrderCreated, settings *Settings, occurredAt time.Time) (invoices.Draft, error) {
+	if order.OrderID == uuid.Nil {
+		return invoices.Draft{}, fmt.Errorf("%w: order_id is required", ErrInvalidSourceEvent)
+	}
+
+	label := order.OrderNumber
+	if label == "" {
+		label = order.OrderID.String()
+	}
+
+	lines, err := itemLines(order.Items, settings.DefaultTaxRate)
+	if err != nil {
+		return invoices.Draft{}, err
+	}
+	if len(lines) == 0 {
+		if !order.TotalAmount.IsPositive() {
+			return invoices.Draft{}, fmt.Errorf("%w: order has no items and no total", ErrInvalidSourceEvent)
+		}
+		lines = []invoices.DraftLine{{
+			Description: "Order " + label,
+			Quantity:    decimal.NewFromInt(1),
+			UnitPrice:   order.TotalAmount,
+		}}
+	}
+
+	metadata := map[string]any{"order_number": order.OrderNumber}
+	if order.OutletID != nil {
+		metadata["outlet_id"] = order.OutletID.String()
+	}
+
+	orderID := order.OrderID
+	return invoices.Draft{
+		TenantID:      tenantID,
+		CustomerID:    order.CustomerID,
+		InvoiceDate:   occurredAt,
+		DueDate:       occurredAt.AddDate(0, 0, settings.OrderPaymentTermsDays),
+		Currency:      order.Currency,
+		ReferenceType: ReferenceOrder,
+		ReferenceID:   &orderID,
+		Issue:         settings.AutoIssueOrders,
+		Metadata:      metadata,
+		Lines:         lines,
+	}, nil
+}
+
+// milestoneDraft maps a completed milestone to an invoice draft.
+func milestoneDraft(tenantID uuid.UUID, milestone MilestoneCompleted, settings *Settings, occurredAt time.Time) (invoices.Draft, error) {
+	if milestone.MilestoneID == uuid.Nil {
+		return invoices.Draft{}, fmt.Errorf("%w: milestone_id is required", ErrInvalidSourceEvent)
+	}
+
+	lines, err := itemLines(milestone.Items, settings.DefaultTaxRate)
+	if err != nil {
+		return invoices.Draft{}, err
+	}
+	if len(lines) == 0 {
+		if !milestone.Amount.IsPositive() {
+			return invoices.Draft{}, fmt.Errorf("%w: milestone has no items and no amount", ErrInvalidSourceEvent)
+		}
+		taxRate := settings.DefaultTaxRate
+		if milestone.TaxRate != nil {
+			taxRate = *milestone.TaxRate
+		}
+		name := milestone.Name
+		if name == "" {
+			name = milestone.MilestoneID.String()
+		}
+		lines = []invoices.DraftLine{{
+			Description: "Milestone: " + name,
+			Quantity:    decimal.NewFromInt(1),
+			UnitPrice:   milestone.Amount,
+			TaxRate:     taxRate,
+		}}
+	}
+
+	milestoneID := milestone.MilestoneID
+	return invoices.Draft{
+		TenantID:      tenantID,
+		CustomerID:    milestone.CustomerID,
+		InvoiceDate:   occurredAt,
+		DueDate:       occurredAt.AddDate(0, 0, settings.MilestonePaymentTermsDays),
+		Currency:      milestone.Currency,
+		ReferenceType: ReferenceMilestone,
+		ReferenceID:   &milestoneID,
+		Issue:         settings.AutoIssueMilestones,
+		Metadata: map[string]any{
+			"project_id":     milestone.ProjectID.String(),
+			"milestone_name": milestone.Name,
+		},
+		Lines: lines,
+	}, nil
+}
+
+func itemLines(items []EventItem, defaultTaxRate decimal.Decimal) ([]invoices.DraftLine, error) {
+	lines := make([]invoices.DraftLine, 0, len(items))
+	for i, item := range items {
+		description := item.Description
+		if description == "" {
+			description = item.Name
+		}
+		if description == "" {
+			return nil, fmt.Errorf("%w: item %d has no description", ErrInvalidSourceEvent, i+1)
+		}
+		quantity := item.Quantity
+		if quantity.IsZero() {
+			quantity = decimal.NewFromInt(1)
+		}
+		taxRate := defaultTaxRate
+		if item.TaxRate != nil {
+			taxRate = *item.TaxRate
+		}
+		lines = append(lines, invoices.DraftLine{
+			Description: description,
+			Quantity:    quantity,
+			UnitPrice:   item.UnitPrice,
+			TaxRate:     taxRate,
+		})
+	}
+	return lines, nil
+}
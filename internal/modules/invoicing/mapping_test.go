@@ -0,0 +1,77 @@
+package invoicing
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestOrderDraftWithoutItemsUsesTotal(t *testing.T) {
+	settings := DefaultSettings(uuid.New())
+	settings.DefaultTaxRate = decimal.RequireFromString("0.16")
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	draft, err := orderDraft(settings.TenantID, OrderCreated{
+		OrderID:     uuid.New(),
+		OrderNumber: "C-1001",
+		TotalAmount: decimal.NewFromInt(1500),
+	}, settings, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(draft.Lines) != 1 || !draft.Lines[0].UnitPrice.Equal(decimal.NewFromInt(1500)) {
+		t.Fatalf("expected a single line for the order total, got %+v", draft.Lines)
+	}
+	if !draft.Lines[0].TaxRate.IsZero() {
+		t.Fatalf("expected the order total line to be untaxed, got %s", draft.Lines[0].TaxRate)
+	}
+	if draft.Issue {
+		t.Fatal("expected a draft when auto issue is off")
+	}
+	if draft.ReferenceType != ReferenceOrder || !draft.DueDate.Equal(now) {
+		t.Fatalf("unexpected reference or due date: %s %s", draft.ReferenceType, draft.DueDate)
+	}
+}
+
+func TestMilestoneDraftItemsUseDefaultTax(t *testing.T) {
+	settings := DefaultSettings(uuid.New())
+	settings.AutoIssueMilestones = true
+	settings.DefaultTaxRate = decimal.RequireFromString("0.16")
+	exempt := decimal.Zero
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	draft, err := milestoneDraft(settings.TenantID, MilestoneCompleted{
+		MilestoneID: uuid.New(),
+		Items: []EventItem{
+			{Name: "Design", Quantity: decimal.NewFromInt(10), UnitPrice: decimal.NewFromInt(100)},
+			{Description: "Permits", UnitPrice: decimal.NewFromInt(50), TaxRate: &exempt},
+		},
+	}, settings, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !draft.Issue {
+		t.Fatal("expected auto issue for milestones")
+	}
+	if !draft.Lines[0].TaxRate.Equal(settings.DefaultTaxRate) || !draft.Lines[1].TaxRate.IsZero() {
+		t.Fatalf("unexpected tax rates: %s, %s", draft.Lines[0].TaxRate, draft.Lines[1].TaxRate)
+	}
+	if !draft.Lines[1].Quantity.Equal(decimal.NewFromInt(1)) {
+		t.Fatalf("expected missing quantity to default to 1, got %s", draft.Lines[1].Quantity)
+	}
+	if !draft.DueDate.Equal(now.AddDate(0, 0, 30)) {
+		t.Fatalf("expected 30 day terms, got %s", draft.DueDate)
+	}
+}
+
+func TestMilestoneDraftRequiresMilestone(t *testing.T) {
+	_, err := milestoneDraft(uuid.New(), MilestoneCompleted{Amount: decimal.NewFromInt(10)}, DefaultSettings(uuid.New()), time.Now())
+	if !errors.Is(err, ErrInvalidSourceEvent) {
+		t.Fatalf("expected ErrInvalidSourceEvent, got %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package invoicing
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Upstream events that generate invoices and the reply published once the
+// invoice exists.
+const (
+	EventOrderCreated       = "cafe.order.created"
+	EventMilestoneCompleted = "projects.milestone.completed"
+	EventInvoiceGenerated   = "treasury.invoice.generated"
+)
+
+// Invoice reference types of event-sourced invoices. Each reference is
+// invoiced at most once per tenant.
+const (
+	ReferenceOrder     = "order"
+	ReferenceMilestone = "milestone"
+)
+
+// Settings are a tenant's invoicing preferences.
+type Settings struct {
+	TenantID                  uuid.UUID       `json:"tenant_id"`
+	AutoIssueOrders           bool            `json:"auto_issue_orders"`
+	AutoIssueMilestones       bool            `json:"auto_issue_milestones"`
+	OrderPaymentTermsDays     int             `json:"order_payment_terms_days"`
+	MilestonePaymentTermsDays int             `json:"milestone_payment_terms_days"`
+	DefaultTaxRate            decimal.Decimal `json:"default_tax_rate"`
+	CreditHoldDays            int             `json:"credit_hold_days"`
+	UpdatedAt                 *time.Time      `json:"updated_at,omitempty"`
+}
+
+// DefaultSettings are used until a tenant saves its own.
+func DefaultSettings(tenantID uuid.UUID) *Settings {
+	return &Settings{
+		TenantID:                  tenantID,
+		MilestonePaymentTermsDays: 30,
+		DefaultTaxRate:            decimal.Zero,
+	}
+}
+
+// UpdateSettingsRequest changes the provided settings fields.
+type UpdateSettingsRequest struct {
+	AutoIssueOrders           *bool            `json:"auto_issue_orders"`
+	AutoIssueMilestones       *bool            `json:"auto_issue_milestones"`
+	OrderPaymentTermsDays     *int             `json:"order_payment_terms_days"`
+	MilestonePaymentTermsDays *int             `json:"milestone_payment_terms_days"`
+	DefaultTaxRate            *decimal.Decimal `json:"default_tax_rate"`
+	CreditHoldDays            *int             `json:"credit_hold_days"`
+}
+
+// EventItem is an itemised line on an upstream order or milestone.
+type EventItem struct {
+	Description string           `json:"description"`
+	Name        string           `json:"name"`
+	Quantity    decimal.Decimal  `json:"quantity"`
+	UnitPrice   decimal.Decimal  `json:"unit_price"`
+	TaxRate     *decimal.Decimal `json:"tax_rate"`
+}
+
+// OrderCreated is the data of cafe.order.created.
+type OrderCreated struct {
+	OrderID     uuid.UUID       `json:"order_id"`
+	OrderNumber string          `json:"order_number"`
+	CustomerID  *uuid.UUID      `json:"customer_id"`
+	OutletID    *uuid.UUID      `json:"outlet_id"`
+	TotalAmount decimal.Decimal `json:"total_amount"`
+	Currency    string          `json:"currency"`
+	Items       []EventItem     `json:"items"`
+}
+
+// MilestoneCompleted is the data of projects.milestone.completed.
+type MilestoneCompleted struct {
+	ProjectID   uuid.UUID        `json:"project_id"`
+	MilestoneID uuid.UUID        `json:"milestone_id"`
+	CustomerID  *uuid.UUID       `json:"customer_id"`
+	Name        string           `json:"name"`
+	Amount      decimal.Decimal  `json:"amount"`
+	Currency    string           `json:"currency"`
+	TaxRate     *decimal.Decimal `json:"tax_rate"`
+	Items       []EventItem      `json:"items"`
+}
+
+// SourceEvent identifies the upstream event an invoice was generated from.
+type SourceEvent struct {
+	EventID   string
+	EventType string
+}
@@ -0,0 +1,21 @@
+package invoicing
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/bengobox/treasury-api/internal/modules/invoices"
+)
+
+// Repository abstracts persistence for invoicing settings and event-sourced invoices.
+type Repository interface {
+	GetSettings(ctx context.Context, tenantID uuid.UUID) (*Settings, error)
+	SaveSettings(ctx context.Context, settings *Settings) (*Settings, error)
+	// CreateFromSource creates the invoice for an upstream event unless its
+	// reference was already invoiced, and enqueues the reply event either way.
+	// created is false when the existing invoice is returned.
+	CreateFromSource(ctx context.Context, draft invoices.Draft, source SourceEvent) (inv *invoices.Invoice, created bool, err error)
+	GetInvoice(ctx context.Context, tenantID uuid.UUID, invoiceID uuid.UUID) (*invoices.Invoice, error)
+	IssueInvoice(ctx context.Context, tenantID uuid.UUID, invoiceID uuid.UUID) (*invoices.Invoice, error)
+}
@@ -0,0 +1,185 @@
+package invoicing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/invoice"
+	"github.com/bengobox/treasury-api/internal/ent/invoicesetting"
+	"github.com/bengobox/treasury-api/internal/modules/invoices"
+	"github.com/bengobox/treasury-api/internal/modules/outbox"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+)
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// GetSettings returns the tenant's settings, or the defaults if none were saved.
+func (r *EntRepository) GetSettings(ctx context.Context, tenantID uuid.UUID) (*Settings, error) {
+	entSettings, err := r.client.InvoiceSetting.Query().
+		Where(invoicesetting.TenantID(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DefaultSettings(tenantID), nil
+		}
+		return nil, fmt.Errorf("get invoice settings: %w", err)
+	}
+
+	return mapEntSettings(entSettings), nil
+}
+
+// SaveSettings creates or replaces the tenant's settings.
+func (r *EntRepository) SaveSettings(ctx context.Context, settings *Settings) (*Settings, error) {
+	id, err := r.client.InvoiceSetting.Create().
+		SetTenantID(settings.TenantID).
+		SetAutoIssueOrders(settings.AutoIssueOrders).
+		SetAutoIssueMilestones(settings.AutoIssueMilestones).
+		SetOrderPaymentTermsDays(settings.OrderPaymentTermsDays).
+		SetMilestonePaymentTermsDays(settings.MilestonePaymentTermsDays).
+		SetDefaultTaxRate(settings.DefaultTaxRate).
+		SetCreditHoldDays(settings.CreditHoldDays).
+		OnConflictColumns(invoicesetting.FieldTenantID).
+		UpdateNewValues().
+		ID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("save invoice settings: %w", err)
+	}
+
+	entSettings, err := r.client.InvoiceSetting.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get invoice settings: %w", err)
+	}
+
+	return mapEntSettings(entSettings), nil
+}
+
+// CreateFromSource creates an invoice for an order or milestone exactly once.
+// A concurrent delivery that loses the race on the unique reference index
+// returns the winner's invoice.
+func (r *EntRepository) CreateFromSource(ctx context.Context, draft invoices.Draft, source SourceEvent) (*invoices.Invoice, bool, error) {
+	var (
+		inv     *invoices.Invoice
+		created bool
+	)
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		existingID, err := tx.Invoice.Query().
+			Where(
+				invoice.TenantID(draft.TenantID),
+				invoice.ReferenceType(draft.ReferenceType),
+				invoice.ReferenceID(*draft.ReferenceID),
+			).
+			FirstID(ctx)
+		switch {
+		case err == nil:
+			inv, err = invoices.Get(ctx, tx.Client(), draft.TenantID, existingID)
+			if err != nil {
+				return err
+			}
+		case ent.IsNotFound(err):
+			inv, err = invoices.Create(ctx, tx, draft)
+			if err != nil {
+				return err
+			}
+			created = true
+		default:
+			return fmt.Errorf("find source invoice: %w", err)
+		}
+
+		return enqueueGenerated(ctx, tx, inv, source, !created)
+	})
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			existingID, qerr := r.client.Invoice.Query().
+				Where(
+					invoice.TenantID(draft.TenantID),
+					invoice.ReferenceType(draft.ReferenceType),
+					invoice.ReferenceID(*draft.ReferenceID),
+				).
+				FirstID(ctx)
+			if qerr != nil {
+				return nil, false, fmt.Errorf("find source invoice: %w", qerr)
+			}
+			inv, qerr = invoices.Get(ctx, r.client, draft.TenantID, existingID)
+			if qerr != nil {
+				return nil, false, qerr
+			}
+			return inv, false, nil
+		}
+		return nil, false, err
+	}
+
+	return inv, created, nil
+}
+
+// GetInvoice retrieves an invoice with its lines.
+func (r *EntRepository) GetInvoice(ctx context.Context, tenantID uuid.UUID, invoiceID uuid.UUID) (*invoices.Invoice, error) {
+	return invoices.Get(ctx, r.client, tenantID, invoiceID)
+}
+
+// IssueInvoice approves a draft invoice.
+func (r *EntRepository) IssueInvoice(ctx context.Context, tenantID uuid.UUID, invoiceID uuid.UUID) (*invoices.Invoice, error) {
+	var issued *invoices.Invoice
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		var err error
+		issued, err = invoices.Issue(ctx, tx, tenantID, invoiceID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return issued, nil
+}
+
+// enqueueGenerated tells the producer which invoice its event produced.
+func enqueueGenerated(ctx context.Context, tx *ent.Tx, inv *invoices.Invoice, source SourceEvent, duplicate bool) error {
+	payload := map[string]any{
+		"invoice_id":        inv.ID.String(),
+		"invoice_number":    inv.InvoiceNumber,
+		"status":            inv.Status,
+		"amount":            inv.TotalAmount.String(),
+		"currency":          inv.Currency,
+		"reference_type":    inv.ReferenceType,
+		"source_event_id":   source.EventID,
+		"source_event_type": source.EventType,
+		"duplicate":         duplicate,
+	}
+	if inv.ReferenceID != nil {
+		payload["reference_id"] = inv.ReferenceID.String()
+	}
+
+	return outbox.Enqueue(ctx, tx, outbox.Event{
+		TenantID:      inv.TenantID,
+		AggregateType: "invoice",
+		AggregateID:   inv.ID,
+		EventType:     EventInvoiceGenerated,
+		Payload:       payload,
+	})
+}
+
+func mapEntSettings(entSettings *ent.InvoiceSetting) *Settings {
+	updatedAt := entSettings.UpdatedAt
+	return &Settings{
+		TenantID:                  entSettings.TenantID,
+		AutoIssueOrders:           entSettings.AutoIssueOrders,
+		AutoIssueMilestones:       entSettings.AutoIssueMilestones,
+		OrderPaymentTermsDays:     entSettings.OrderPaymentTermsDays,
+		MilestonePaymentTermsDays: entSettings.MilestonePaymentTermsDays,
+		DefaultTaxRate:            entSettings.DefaultTaxRate,
+		CreditHoldDays:            entSettings.CreditHoldDays,
+		UpdatedAt:                 &updatedAt,
+	}
+}
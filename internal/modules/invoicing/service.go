@@ -0,0 +1,231 @@
+package invoicing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/credit"
+	"github.com/bengobox/treasury-api/internal/modules/invoices"
+	"github.com/bengobox/treasury-api/internal/platform/events"
+)
+
+// ErrInvalidSettings is returned when a settings update is out of range.
+var ErrInvalidSettings = errors.New("invalid invoice settings")
+
+// creditExemptTypes are not sales on account and skip credit control.
+var creditExemptTypes = map[string]bool{"credit_note": true, "proforma": true}
+
+// Service provides invoicing settings, invoice approval and invoice
+// generation from upstream events.
+type Service struct {
+	repo   Repository
+	credit *credit.Service
+	logger *zap.Logger
+}
+
+// NewService creates a new invoicing service.
+func NewService(repo Repository, creditService *credit.Service, logger *zap.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		credit: creditService,
+		logger: logger,
+	}
+}
+
+// GetSettings returns the tenant's invoicing settings.
+func (s *Service) GetSettings(ctx context.Context, tenantID uuid.UUID) (*Settings, error) {
+	return s.repo.GetSettings(ctx, tenantID)
+}
+
+// UpdateSettings applies the provided fields to the tenant's settings.
+func (s *Service) UpdateSettings(ctx context.Context, tenantID uuid.UUID, req UpdateSettingsRequest) (*Settings, error) {
+	settings, err := s.repo.GetSettings(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.AutoIssueOrders != nil {
+		settings.AutoIssueOrders = *req.AutoIssueOrders
+	}
+	if req.AutoIssueMilestones != nil {
+		settings.AutoIssueMilestones = *req.AutoIssueMilestones
+	}
+	if req.OrderPaymentTermsDays != nil {
+		settings.OrderPaymentTermsDays = *req.OrderPaymentTermsDays
+	}
+	if req.MilestonePaymentTermsDays != nil {
+		settings.MilestonePaymentTermsDays = *req.MilestonePaymentTermsDays
+	}
+	if req.DefaultTaxRate != nil {
+		settings.DefaultTaxRate = *req.DefaultTaxRate
+	}
+	if req.CreditHoldDays != nil {
+		settings.CreditHoldDays = *req.CreditHoldDays
+	}
+
+	if settings.OrderPaymentTermsDays < 0 || settings.MilestonePaymentTermsDays < 0 {
+		return nil, fmt.Errorf("%w: payment terms cannot be negative", ErrInvalidSettings)
+	}
+	if settings.DefaultTaxRate.IsNegative() || settings.DefaultTaxRate.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return nil, fmt.Errorf("%w: default_tax_rate must be a fraction between 0 and 1", ErrInvalidSettings)
+	}
+	if settings.CreditHoldDays < 0 || settings.CreditHoldDays > 365 {
+		return nil, fmt.Errorf("%w: credit_hold_days must be between 0 and 365", ErrInvalidSettings)
+	}
+
+	saved, err := s.repo.SaveSettings(ctx, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("invoice settings updated",
+		zap.String("tenant_id", tenantID.String()),
+		zap.Bool("auto_issue_orders", saved.AutoIssueOrders),
+		zap.Bool("auto_issue_milestones", saved.AutoIssueMilestones),
+	)
+
+	return saved, nil
+}
+
+// GetInvoice retrieves an invoice with its lines.
+func (s *Service) GetInvoice(ctx context.Context, tenantID uuid.UUID, invoiceID uuid.UUID) (*invoices.Invoice, error) {
+	return s.repo.GetInvoice(ctx, tenantID, invoiceID)
+}
+
+// IssueInvoice approves a draft invoice: the receivable is posted and the
+// invoice is sent. Invoices to customers are subject to credit control.
+func (s *Service) IssueInvoice(ctx context.Context, tenantID uuid.UUID, invoiceID uuid.UUID, issuedBy *uuid.UUID) (*invoices.Invoice, error) {
+	draft, err := s.repo.GetInvoice(ctx, tenantID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if draft.Status != invoices.StatusDraft {
+		return nil, invoices.ErrInvoiceNotDraft
+	}
+	if draft.CustomerID != nil && !creditExemptTypes[draft.InvoiceType] {
+		if err := s.credit.Authorize(ctx, tenantID, *draft.CustomerID, credit.DocumentInvoice, draft.ID, draft.Currency, draft.TotalAmount); err != nil {
+			return nil, err
+		}
+	}
+
+	inv, err := s.repo.IssueInvoice(ctx, tenantID, invoiceID)
+	if err != nil {
+		if draft.CustomerID != nil && !creditExemptTypes[draft.InvoiceType] {
+			if restoreErr := s.credit.RestoreOverride(ctx, tenantID, credit.DocumentInvoice, draft.ID); restoreErr != nil {
+				s.logger.Error("credit override not restored",
+					zap.String("tenant_id", tenantID.String()),
+					zap.String("invoice_id", invoiceID.String()),
+					zap.Error(restoreErr),
+				)
+			}
+		}
+		return nil, fmt.Errorf("issue invoice: %w", err)
+	}
+
+	fields := []zap.Field{
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("invoice_id", invoiceID.String()),
+		zap.String("invoice_number", inv.InvoiceNumber),
+	}
+	if issuedBy != nil {
+		fields = append(fields, zap.String("issued_by", issuedBy.String()))
+	}
+	s.logger.Info("invoice issued", fields...)
+
+	return inv, nil
+}
+
+// HandleOrderCreated invoices a cafe order.
+func (s *Service) HandleOrderCreated(ctx context.Context, env events.Envelope) error {
+	var order OrderCreated
+	if err := env.DecodeData(&order); err != nil {
+		return err
+	}
+
+	settings, err := s.repo.GetSettings(ctx, env.TenantID)
+	if err != nil {
+		return err
+	}
+
+	draft, err := orderDraft(env.TenantID, order, settings, eventTime(env))
+	if err != nil {
+		return events.Permanent(err)
+	}
+
+	return s.createFromSource(ctx, draft, env)
+}
+
+// HandleMilestoneCompleted invoices a completed project milestone.
+func (s *Service) HandleMilestoneCompleted(ctx context.Context, env events.Envelope) error {
+	var milestone MilestoneCompleted
+	if err := env.DecodeData(&milestone); err != nil {
+		return err
+	}
+
+	settings, err := s.repo.GetSettings(ctx, env.TenantID)
+	if err != nil {
+		return err
+	}
+
+	draft, err := milestoneDraft(env.TenantID, milestone, settings, eventTime(env))
+	if err != nil {
+		return events.Permanent(err)
+	}
+
+	return s.createFromSource(ctx, draft, env)
+}
+
+func (s *Service) createFromSource(ctx context.Context, draft invoices.Draft, env events.Envelope) error {
+	if draft.Issue && draft.CustomerID != nil {
+		// A sale the customer's credit does not cover is left in draft for
+		// approval, where an override can be applied, rather than issued.
+		if err := s.credit.Check(ctx, draft.TenantID, *draft.CustomerID, draft.Currency, draft.Total()); err != nil {
+			if !errors.Is(err, credit.ErrCreditHold) && !errors.Is(err, credit.ErrCreditLimitExceeded) {
+				return err
+			}
+			draft.Issue = false
+			if draft.Metadata == nil {
+				draft.Metadata = map[string]any{}
+			}
+			draft.Metadata["credit_block"] = err.Error()
+			s.logger.Warn("auto-issue blocked by credit control",
+				zap.String("tenant_id", draft.TenantID.String()),
+				zap.String("event_id", env.EventID),
+				zap.String("customer_id", draft.CustomerID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+
+	inv, created, err := s.repo.CreateFromSource(ctx, draft, SourceEvent{EventID: env.EventID, EventType: env.EventType})
+	if err != nil {
+		if errors.Is(err, invoices.ErrInvalidDraft) {
+			return events.Permanent(err)
+		}
+		return err
+	}
+
+	s.logger.Info("invoice generated from event",
+		zap.String("tenant_id", env.TenantID.String()),
+		zap.String("event_id", env.EventID),
+		zap.String("event_type", env.EventType),
+		zap.String("invoice_id", inv.ID.String()),
+		zap.String("status", inv.Status),
+		zap.Bool("duplicate", !created),
+	)
+
+	return nil
+}
+
+func eventTime(env events.Envelope) time.Time {
+	if env.Timestamp.IsZero() {
+		return time.Now().UTC()
+	}
+	return env.Timestamp
+}
@@ -0,0 +1,73 @@
+package ledger
+
+// System account codes used by automated postings. They are provisioned on
+// first use for each tenant so postings never fail on a fresh chart.
+const (
+	AccountCash                = "1000"
+	AccountSettlementClearing  = "1050"
+	AccountReceivable          = "1100"
+	AccountDoubtfulDebts       = "1190"
+	AccountPayeeAdvances       = "1200"
+	AccountVATInput            = "1400"
+	AccountPayable             = "2000"
+	AccountUnappliedReceipts   = "2150"
+	AccountVATOutput           = "2200"
+	AccountWithholdingPayable  = "2210"
+	AccountPayeesPayable       = "2300"
+	AccountCustomerWallets     = "2400"
+	AccountOutletWallets       = "2410"
+	AccountTenantWallets       = "2420"
+	AccountSalesRevenue        = "4000"
+	AccountSubscriptionRevenue = "4100"
+	AccountLateFeeIncome       = "4200"
+	AccountBadDebtRecovered    = "4300"
+	AccountDiscountsReceived   = "4400"
+	AccountInterestIncome      = "4500"
+	AccountGeneralExpenses     = "6000"
+	AccountBadDebtExpense      = "6100"
+	AccountBankCharges         = "6200"
+	AccountProcessingFees      = "6300"
+	AccountPayeeEarnings       = "6400"
+	AccountCashOverShort       = "6500"
+)
+
+type systemAccount struct {
+	Name string
+	Type string
+}
+
+var systemAccounts = map[string]systemAccount{
+	AccountCash:                {Name: "Cash and Cash Equivalents", Type: "asset"},
+	AccountSettlementClearing:  {Name: "Settlement Clearing", Type: "asset"},
+	AccountReceivable:          {Name: "Accounts Receivable", Type: "asset"},
+	AccountDoubtfulDebts:       {Name: "Allowance for Doubtful Debts", Type: "asset"},
+	AccountPayeeAdvances:       {Name: "Rider and Driver Advances", Type: "asset"},
+	AccountVATInput:            {Name: "VAT Input Recoverable", Type: "asset"},
+	AccountPayable:             {Name: "Accounts Payable", Type: "liability"},
+	AccountUnappliedReceipts:   {Name: "Unapplied Customer Receipts", Type: "liability"},
+	AccountVATOutput:           {Name: "VAT Output Payable", Type: "liability"},
+	AccountWithholdingPayable:  {Name: "Withholding Tax Payable", Type: "liability"},
+	AccountPayeesPayable:       {Name: "Rider and Driver Earnings Payable", Type: "liability"},
+	AccountCustomerWallets:     {Name: "Customer Wallet Balances", Type: "liability"},
+	AccountOutletWallets:       {Name: "Outlet Wallet Balances", Type: "liability"},
+	AccountTenantWallets:       {Name: "Tenant Wallet Balances", Type: "liability"},
+	AccountSalesRevenue:        {Name: "Sales Revenue", Type: "revenue"},
+	AccountSubscriptionRevenue: {Name: "Subscription Revenue", Type: "revenue"},
+	AccountLateFeeIncome:       {Name: "Late Fee Income", Type: "revenue"},
+	AccountBadDebtRecovered:    {Name: "Bad Debts Recovered", Type: "revenue"},
+	AccountDiscountsReceived:   {Name: "Discounts Received", Type: "revenue"},
+	AccountInterestIncome:      {Name: "Interest Income", Type: "revenue"},
+	AccountGeneralExpenses:     {Name: "General Expenses", Type: "expense"},
+	AccountBadDebtExpense:      {Name: "Bad Debt Expense", Type: "expense"},
+	AccountBankCharges:         {Name: "Bank Charges", Type: "expense"},
+	AccountProcessingFees:      {Name: "Payment Processing Fees", Type: "expense"},
+	AccountPayeeEarnings:       {Name: "Rider and Driver Earnings", Type: "expense"},
+	AccountCashOverShort:       {Name: "Cash Over and Short", Type: "expense"},
+}
+
+// SystemAccountType returns the type of a system account, which exists for
+// every tenant once first posted to.
+func SystemAccountType(code string) (string, bool) {
+	account, ok := systemAccounts[code]
+	return account.Type, ok
+}
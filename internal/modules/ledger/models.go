@@ -0,0 +1,54 @@
+package ledger
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Journal is a balanced set of ledger lines posted together.
+type Journal struct {
+	TenantID      uuid.UUID
+	EntryDate     time.Time
+	Currency      string
+	ReferenceType string // invoice, payment, allocation, bill
+	ReferenceID   uuid.UUID
+	Description   string
+	Metadata      map[string]any
+	Lines         []Line
+}
+
+// Line is a single debit or credit leg of a journal.
+type Line struct {
+	AccountCode string
+	Debit       decimal.Decimal
+	Credit      decimal.Decimal
+	Description string
+}
+
+// Debit builds a debit line for the given account code.
+func Debit(accountCode string, amount decimal.Decimal) Line {
+	return Line{AccountCode: accountCode, Debit: amount}
+}
+
+// Credit builds a credit line for the given account code.
+func Credit(accountCode string, amount decimal.Decimal) Line {
+	return Line{AccountCode: accountCode, Credit: amount}
+}
+
+// Reversed returns a copy of the journal with debits and credits swapped.
+func (j Journal) Reversed(description string) Journal {
+	reversed := j
+	reversed.Description = description
+	reversed.Lines = make([]Line, len(j.Lines))
+	for i, line := range j.Lines {
+		reversed.Lines[i] = Line{
+			AccountCode: line.AccountCode,
+			Debit:       line.Credit,
+			Credit:      line.Debit,
+			Description: line.Description,
+		}
+	}
+	return reversed
+}
@@ -0,0 +1,154 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/chartofaccount"
+)
+
+var (
+	// ErrUnbalancedJournal is returned when debits and credits do not net to zero.
+	ErrUnbalancedJournal = errors.New("journal debits and credits do not balance")
+	// ErrInvalidLine is returned when a line carries both, neither or negative amounts.
+	ErrInvalidLine = errors.New("journal line must carry exactly one positive debit or credit")
+	// ErrAccountNotFound is returned when a non-system account code is not in the chart.
+	ErrAccountNotFound = errors.New("ledger account not found")
+)
+
+// Validate checks the journal against the double-entry posting rules.
+func (j Journal) Validate() error {
+	if j.TenantID == uuid.Nil {
+		return errors.New("journal tenant is required")
+	}
+	if len(j.Lines) < 2 {
+		return errors.New("journal requires at least two lines")
+	}
+
+	debits, credits := decimal.Zero, decimal.Zero
+	for _, line := range j.Lines {
+		if line.AccountCode == "" {
+			return errors.New("journal line account code is required")
+		}
+		if line.Debit.IsNegative() || line.Credit.IsNegative() {
+			return ErrInvalidLine
+		}
+		if line.Debit.IsPositive() == line.Credit.IsPositive() {
+			return ErrInvalidLine
+		}
+		debits = debits.Add(line.Debit)
+		credits = credits.Add(line.Credit)
+	}
+
+	if !debits.Equal(credits) {
+		return fmt.Errorf("%w: debits %s, credits %s", ErrUnbalancedJournal, debits, credits)
+	}
+
+	return nil
+}
+
+// PostJournal validates the journal and writes its lines as ledger transactions
+// inside the caller's transaction. It returns the journal entry identifier
+// shared by all lines.
+func PostJournal(ctx context.Context, tx *ent.Tx, journal Journal) (uuid.UUID, error) {
+	if err := journal.Validate(); err != nil {
+		return uuid.Nil, err
+	}
+
+	entryDate := journal.EntryDate
+	if entryDate.IsZero() {
+		entryDate = time.Now()
+	}
+	currency := journal.Currency
+	if currency == "" {
+		currency = "KES"
+	}
+	metadata := journal.Metadata
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+
+	journalID := uuid.New()
+	builders := make([]*ent.LedgerTransactionCreate, 0, len(journal.Lines))
+	for _, line := range journal.Lines {
+		accountID, err := resolveAccount(ctx, tx, journal.TenantID, line.AccountCode)
+		if err != nil {
+			return uuid.Nil, err
+		}
+
+		description := line.Description
+		if description == "" {
+			description = journal.Description
+		}
+
+		builder := tx.LedgerTransaction.Create().
+			SetTenantID(journal.TenantID).
+			SetAccountID(accountID).
+			SetJournalEntryID(journalID).
+			SetDebitAmount(line.Debit).
+			SetCreditAmount(line.Credit).
+			SetCurrency(currency).
+			SetExchangeRate(decimal.NewFromInt(1)).
+			SetTransactionDate(entryDate).
+			SetDescription(description).
+			SetMetadata(metadata)
+
+		if journal.ReferenceType != "" {
+			builder.SetReferenceType(journal.ReferenceType)
+		}
+		if journal.ReferenceID != uuid.Nil {
+			builder.SetReferenceID(journal.ReferenceID)
+		}
+
+		builders = append(builders, builder)
+	}
+
+	if _, err := tx.LedgerTransaction.CreateBulk(builders...).Save(ctx); err != nil {
+		return uuid.Nil, fmt.Errorf("post journal: %w", err)
+	}
+
+	return journalID, nil
+}
+
+// resolveAccount looks up an account by code, provisioning system accounts on
+// first use for the tenant.
+func resolveAccount(ctx context.Context, tx *ent.Tx, tenantID uuid.UUID, code string) (uuid.UUID, error) {
+	if system, ok := systemAccounts[code]; ok {
+		err := tx.ChartOfAccount.Create().
+			SetTenantID(tenantID).
+			SetAccountCode(code).
+			SetAccountName(system.Name).
+			SetAccountType(system.Type).
+			SetMetadata(map[string]any{"system": true}).
+			OnConflictColumns(chartofaccount.FieldTenantID, chartofaccount.FieldAccountCode).
+			DoNothing().
+			Exec(ctx)
+		// On conflict nothing is returned for the insert's RETURNING clause:
+		// the account was provisioned earlier.
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, fmt.Errorf("provision account %s: %w", code, err)
+		}
+	}
+
+	id, err := tx.ChartOfAccount.Query().
+		Where(
+			chartofaccount.TenantID(tenantID),
+			chartofaccount.AccountCode(code),
+		).
+		OnlyID(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return uuid.Nil, fmt.Errorf("%w: %s", ErrAccountNotFound, code)
+		}
+		return uuid.Nil, fmt.Errorf("resolve account %s: %w", code, err)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,83 @@
+package ledger
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestJournalValidate(t *testing.T) {
+	tenantID := uuid.New()
+	hundred := decimal.NewFromInt(100)
+
+	tests := []struct {
+		name    string
+		journal Journal
+		wantErr error
+	}{
+		{
+			name: "balanced",
+			journal: Journal{TenantID: tenantID, Lines: []Line{
+				Debit(AccountUnappliedReceipts, hundred),
+				Credit(AccountReceivable, hundred),
+			}},
+		},
+		{
+			name: "unbalanced",
+			journal: Journal{TenantID: tenantID, Lines: []Line{
+				Debit(AccountUnappliedReceipts, hundred),
+				Credit(AccountReceivable, decimal.NewFromInt(90)),
+			}},
+			wantErr: ErrUnbalancedJournal,
+		},
+		{
+			name: "line with both sides",
+			journal: Journal{TenantID: tenantID, Lines: []Line{
+				{AccountCode: AccountCash, Debit: hundred, Credit: hundred},
+				Credit(AccountReceivable, hundred),
+			}},
+			wantErr: ErrInvalidLine,
+		},
+		{
+			name: "negative amount",
+			journal: Journal{TenantID: tenantID, Lines: []Line{
+				Debit(AccountCash, hundred.Neg()),
+				Credit(AccountReceivable, hundred.Neg()),
+			}},
+			wantErr: ErrInvalidLine,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.journal.Validate()
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestJournalReversed(t *testing.T) {
+	journal := Journal{TenantID: uuid.New(), Lines: []Line{
+		Debit(AccountUnappliedReceipts, decimal.NewFromInt(40)),
+		Credit(AccountReceivable, decimal.NewFromInt(40)),
+	}}
+
+	reversed := journal.Reversed("reversal")
+
+	if !reversed.Lines[0].Credit.Equal(decimal.NewFromInt(40)) || !reversed.Lines[1].Debit.Equal(decimal.NewFromInt(40)) {
+		t.Fatalf("expected sides to be swapped, got %+v", reversed.Lines)
+	}
+	if !journal.Lines[0].Debit.Equal(decimal.NewFromInt(40)) {
+		t.Fatal("expected original journal to be unchanged")
+	}
+	if err := reversed.Validate(); err != nil {
+		t.Fatalf("expected reversed journal to validate, got %v", err)
+	}
+}
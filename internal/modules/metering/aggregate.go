@@ -0,0 +1,46 @@
+package metering
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// reading is the part of a usage record aggregation needs.
+type reading struct {
+	Quantity   decimal.Decimal
+	OccurredAt time.Time
+}
+
+// aggregate reduces readings to the billable quantity: the total for sum, the
+// peak for max and the most recent report for last.
+func aggregate(mode string, readings []reading) decimal.Decimal {
+	if len(readings) == 0 {
+		return decimal.Zero
+	}
+
+	switch mode {
+	case AggregationMax:
+		peak := readings[0].Quantity
+		for _, r := range readings[1:] {
+			if r.Quantity.GreaterThan(peak) {
+				peak = r.Quantity
+			}
+		}
+		return peak
+	case AggregationLast:
+		latest := readings[0]
+		for _, r := range readings[1:] {
+			if !r.OccurredAt.Before(latest.OccurredAt) {
+				latest = r
+			}
+		}
+		return latest.Quantity
+	default:
+		total := decimal.Zero
+		for _, r := range readings {
+			total = total.Add(r.Quantity)
+		}
+		return total
+	}
+}
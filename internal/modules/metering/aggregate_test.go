@@ -0,0 +1,35 @@
+package metering
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestAggregate(t *testing.T) {
+	base := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	readings := []reading{
+		{Quantity: decimal.NewFromInt(40), OccurredAt: base.Add(2 * time.Hour)},
+		{Quantity: decimal.NewFromInt(75), OccurredAt: base},
+		{Quantity: decimal.NewFromInt(10), OccurredAt: base.Add(time.Hour)},
+	}
+
+	tests := []struct {
+		mode string
+		want int64
+	}{
+		{AggregationSum, 125},
+		{AggregationMax, 75},
+		{AggregationLast, 40},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			got := aggregate(tt.mode, readings)
+			if !got.Equal(decimal.NewFromInt(tt.want)) {
+				t.Fatalf("expected %d, got %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package metering
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionmeter"
+	"github.com/bengobox/treasury-api/internal/ent/usagerecord"
+	"github.com/bengobox/treasury-api/internal/modules/metering/pricing"
+)
+
+// UnbilledCharges prices the subscription's unbilled usage that occurred
+// before cutoff, one charge per meter with usage. It runs inside the billing
+// transaction so the same records can be marked billed with MarkBilled.
+func UnbilledCharges(ctx context.Context, tx *ent.Tx, subscriptionID uuid.UUID, cutoff time.Time) ([]*Charge, error) {
+	return unbilledCharges(ctx, tx.SubscriptionMeter, tx.UsageRecord, subscriptionID, &cutoff)
+}
+
+// MarkBilled links usage records to the billing cycle that invoiced them.
+func MarkBilled(ctx context.Context, tx *ent.Tx, recordIDs []uuid.UUID, cycleID uuid.UUID) error {
+	if len(recordIDs) == 0 {
+		return nil
+	}
+
+	err := tx.UsageRecord.Update().
+		Where(usagerecord.IDIn(recordIDs...)).
+		SetBillingCycleID(cycleID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("mark usage billed: %w", err)
+	}
+	return nil
+}
+
+func unbilledCharges(ctx context.Context, meters *ent.SubscriptionMeterClient, records *ent.UsageRecordClient, subscriptionID uuid.UUID, cutoff *time.Time) ([]*Charge, error) {
+	entMeters, err := meters.Query().
+		Where(subscriptionmeter.SubscriptionID(subscriptionID)).
+		Order(ent.Asc(subscriptionmeter.FieldCode)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list meters: %w", err)
+	}
+
+	charges := make([]*Charge, 0, len(entMeters))
+	for _, entMeter := range entMeters {
+		query := records.Query().
+			Where(
+				usagerecord.MeterID(entMeter.ID),
+				usagerecord.BillingCycleIDIsNil(),
+			)
+		if cutoff != nil {
+			query = query.Where(usagerecord.OccurredAtLT(*cutoff))
+		}
+		entRecords, err := query.All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list unbilled usage: %w", err)
+		}
+		if len(entRecords) == 0 {
+			continue
+		}
+
+		readings := make([]reading, len(entRecords))
+		ids := make([]uuid.UUID, len(entRecords))
+		for i, record := range entRecords {
+			readings[i] = reading{Quantity: record.Quantity, OccurredAt: record.OccurredAt}
+			ids[i] = record.ID
+		}
+
+		quantity := aggregate(entMeter.Aggregation, readings)
+		charges = append(charges, &Charge{
+			MeterID:     entMeter.ID,
+			MeterCode:   entMeter.Code,
+			Description: usageDescription(entMeter, quantity),
+			Quantity:    quantity,
+			Amount:      pricing.Amount(entMeter.PricingModel, quantity, entMeter.UnitPrice, entMeter.Tiers),
+			Records:     len(entRecords),
+			RecordIDs:   ids,
+		})
+	}
+
+	return charges, nil
+}
+
+func usageDescription(entMeter *ent.SubscriptionMeter, quantity decimal.Decimal) string {
+	name := entMeter.Name
+	if name == "" {
+		name = entMeter.Code
+	}
+	unit := entMeter.Unit
+	if unit == "" {
+		unit = "units"
+	}
+	return fmt.Sprintf("%s usage: %s %s", name, quantity.String(), unit)
+}
@@ -0,0 +1,83 @@
+package metering
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/metering/pricing"
+)
+
+// Aggregation modes reduce a period's usage records to a billable quantity.
+const (
+	AggregationSum  = "sum"
+	AggregationMax  = "max"
+	AggregationLast = "last"
+)
+
+// EventUsageMetered is the cafe event reporting subscription usage.
+const EventUsageMetered = "cafe.subscription.usage.metered"
+
+// Meter is a metered component of a subscription.
+type Meter struct {
+	ID             uuid.UUID       `json:"id"`
+	SubscriptionID uuid.UUID       `json:"subscription_id"`
+	Code           string          `json:"code"`
+	Name           string          `json:"name,omitempty"`
+	Unit           string          `json:"unit,omitempty"`
+	Aggregation    string          `json:"aggregation"`
+	PricingModel   string          `json:"pricing_model"`
+	UnitPrice      decimal.Decimal `json:"unit_price"`
+	Tiers          []pricing.Tier  `json:"tiers,omitempty"`
+	Active         bool            `json:"active"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// UsageRecord is a single usage report against a meter.
+type UsageRecord struct {
+	ID             uuid.UUID       `json:"id"`
+	SubscriptionID uuid.UUID       `json:"subscription_id"`
+	MeterID        uuid.UUID       `json:"meter_id"`
+	EventID        string          `json:"event_id"`
+	Quantity       decimal.Decimal `json:"quantity"`
+	OccurredAt     time.Time       `json:"occurred_at"`
+	BillingCycleID *uuid.UUID      `json:"billing_cycle_id,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// CreateMeterRequest describes a new meter on a subscription.
+type CreateMeterRequest struct {
+	Code         string          `json:"code"`
+	Name         string          `json:"name"`
+	Unit         string          `json:"unit"`
+	Aggregation  string          `json:"aggregation"`
+	PricingModel string          `json:"pricing_model"`
+	UnitPrice    decimal.Decimal `json:"unit_price"`
+	Tiers        []pricing.Tier  `json:"tiers"`
+}
+
+// RecordUsageRequest reports usage. EventID makes the report idempotent.
+// The subscription is identified by SubscriptionID or by the subscribing
+// service's ReferenceID.
+type RecordUsageRequest struct {
+	EventID        string          `json:"event_id"`
+	SubscriptionID *uuid.UUID      `json:"subscription_id"`
+	ReferenceID    string          `json:"reference_id"`
+	Meter          string          `json:"meter"`
+	Quantity       decimal.Decimal `json:"quantity"`
+	OccurredAt     *time.Time      `json:"occurred_at"`
+	Metadata       map[string]any  `json:"metadata"`
+}
+
+// Charge is the priced, not yet invoiced usage of one meter.
+type Charge struct {
+	MeterID     uuid.UUID       `json:"meter_id"`
+	MeterCode   string          `json:"meter_code"`
+	Description string          `json:"description"`
+	Quantity    decimal.Decimal `json:"quantity"`
+	Amount      decimal.Decimal `json:"amount"`
+	Records     int             `json:"records"`
+	RecordIDs   []uuid.UUID     `json:"-"`
+}
@@ -0,0 +1,123 @@
+// Package pricing prices metered usage. It has no dependencies on the rest of
+// the service so the Ent schema can store tiers with these types.
+package pricing
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Pricing models.
+const (
+	// ModelPerUnit charges every unit at the meter's unit price.
+	ModelPerUnit = "per_unit"
+	// ModelTiered charges the flat amount of the tier the total quantity falls in.
+	ModelTiered = "tiered"
+	// ModelVolume charges every unit at the unit price of the tier the total
+	// quantity falls in, plus that tier's flat amount.
+	ModelVolume = "volume"
+	// ModelGraduated charges each unit at the price of the tier it falls in,
+	// plus the flat amount of every tier reached.
+	ModelGraduated = "graduated"
+)
+
+// ErrInvalidTiers is returned when tiers are missing, unordered or unbounded
+// before the last tier.
+var ErrInvalidTiers = errors.New("invalid pricing tiers")
+
+// Tier is one price bracket. UpTo is the inclusive upper bound of the tier;
+// the last tier has no bound.
+type Tier struct {
+	UpTo       *decimal.Decimal `json:"up_to,omitempty"`
+	UnitPrice  decimal.Decimal  `json:"unit_price"`
+	FlatAmount decimal.Decimal  `json:"flat_amount"`
+}
+
+// Validate checks that the model is known and its tiers are usable.
+func Validate(model string, unitPrice decimal.Decimal, tiers []Tier) error {
+	switch model {
+	case ModelPerUnit:
+		if unitPrice.IsNegative() {
+			return errors.New("unit price cannot be negative")
+		}
+		return nil
+	case ModelTiered, ModelVolume, ModelGraduated:
+	default:
+		return fmt.Errorf("unknown pricing model %q", model)
+	}
+
+	if len(tiers) == 0 {
+		return fmt.Errorf("%w: at least one tier is required", ErrInvalidTiers)
+	}
+
+	previous := decimal.Zero
+	for i, tier := range tiers {
+		if tier.UnitPrice.IsNegative() || tier.FlatAmount.IsNegative() {
+			return fmt.Errorf("%w: tier %d has a negative price", ErrInvalidTiers, i+1)
+		}
+		last := i == len(tiers)-1
+		if tier.UpTo == nil {
+			if !last {
+				return fmt.Errorf("%w: only the last tier may be unbounded", ErrInvalidTiers)
+			}
+			continue
+		}
+		if !tier.UpTo.GreaterThan(previous) {
+			return fmt.Errorf("%w: tier %d bound must exceed the previous tier", ErrInvalidTiers, i+1)
+		}
+		previous = *tier.UpTo
+	}
+	if tiers[len(tiers)-1].UpTo != nil {
+		return fmt.Errorf("%w: the last tier must be unbounded", ErrInvalidTiers)
+	}
+
+	return nil
+}
+
+// Amount prices quantity under the model, rounded to cents. Tiers are assumed
+// to have passed Validate.
+func Amount(model string, quantity, unitPrice decimal.Decimal, tiers []Tier) decimal.Decimal {
+	if !quantity.IsPositive() {
+		return decimal.Zero
+	}
+
+	switch model {
+	case ModelTiered:
+		return tierFor(quantity, tiers).FlatAmount.Round(2)
+	case ModelVolume:
+		tier := tierFor(quantity, tiers)
+		return quantity.Mul(tier.UnitPrice).Add(tier.FlatAmount).Round(2)
+	case ModelGraduated:
+		return graduated(quantity, tiers).Round(2)
+	default:
+		return quantity.Mul(unitPrice).Round(2)
+	}
+}
+
+func tierFor(quantity decimal.Decimal, tiers []Tier) Tier {
+	for _, tier := range tiers {
+		if tier.UpTo == nil || quantity.LessThanOrEqual(*tier.UpTo) {
+			return tier
+		}
+	}
+	return tiers[len(tiers)-1]
+}
+
+func graduated(quantity decimal.Decimal, tiers []Tier) decimal.Decimal {
+	total := decimal.Zero
+	lower := decimal.Zero
+	for _, tier := range tiers {
+		upper := quantity
+		if tier.UpTo != nil && tier.UpTo.LessThan(quantity) {
+			upper = *tier.UpTo
+		}
+		total = total.Add(upper.Sub(lower).Mul(tier.UnitPrice)).Add(tier.FlatAmount)
+		if tier.UpTo == nil || !tier.UpTo.LessThan(quantity) {
+			break
+		}
+		lower = *tier.UpTo
+	}
+	return total
+}
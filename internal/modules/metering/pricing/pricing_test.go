@@ -0,0 +1,60 @@
+package pricing
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func bound(v int64) *decimal.Decimal {
+	d := decimal.NewFromInt(v)
+	return &d
+}
+
+var tiers = []Tier{
+	{UpTo: bound(100), UnitPrice: decimal.NewFromInt(10)},
+	{UpTo: bound(1000), UnitPrice: decimal.NewFromInt(8), FlatAmount: decimal.NewFromInt(50)},
+	{UnitPrice: decimal.NewFromInt(5), FlatAmount: decimal.NewFromInt(500)},
+}
+
+func TestAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		model    string
+		quantity int64
+		want     string
+	}{
+		{"per unit", ModelPerUnit, 150, "300"},
+		{"tiered flat fee", ModelTiered, 150, "50"},
+		{"volume uses the reached tier for all units", ModelVolume, 150, "1250"},
+		{"volume on a tier bound", ModelVolume, 100, "1000"},
+		{"graduated within first tier", ModelGraduated, 100, "1000"},
+		{"graduated across tiers", ModelGraduated, 1200, "9750"},
+		{"zero usage", ModelGraduated, 0, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Amount(tt.model, decimal.NewFromInt(tt.quantity), decimal.NewFromInt(2), tiers)
+			if !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Fatalf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := Validate(ModelGraduated, decimal.Zero, tiers); err != nil {
+		t.Fatalf("expected valid tiers, got %v", err)
+	}
+
+	unordered := []Tier{{UpTo: bound(100)}, {UpTo: bound(50)}, {}}
+	if err := Validate(ModelVolume, decimal.Zero, unordered); err == nil {
+		t.Fatal("expected unordered tiers to be rejected")
+	}
+
+	bounded := []Tier{{UpTo: bound(100)}}
+	if err := Validate(ModelTiered, decimal.Zero, bounded); err == nil {
+		t.Fatal("expected a bounded last tier to be rejected")
+	}
+}
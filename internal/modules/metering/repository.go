@@ -0,0 +1,28 @@
+package metering
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSubscriptionNotFound = errors.New("subscription not found")
+	ErrSubscriptionEnded    = errors.New("usage reported after the subscription ended")
+	ErrMeterNotFound        = errors.New("meter not found")
+	ErrMeterExists          = errors.New("meter code already exists on the subscription")
+	ErrInvalidMeter         = errors.New("invalid meter")
+	ErrInvalidUsage         = errors.New("invalid usage record")
+)
+
+// Repository abstracts persistence for meters and usage records.
+type Repository interface {
+	CreateMeter(ctx context.Context, tenantID uuid.UUID, subscriptionID uuid.UUID, meter *Meter) (*Meter, error)
+	ListMeters(ctx context.Context, tenantID uuid.UUID, subscriptionID uuid.UUID) ([]*Meter, error)
+	// RecordUsage stores a usage record. duplicate is true when the event was
+	// already recorded; the existing record is returned.
+	RecordUsage(ctx context.Context, tenantID uuid.UUID, req RecordUsageRequest) (record *UsageRecord, duplicate bool, err error)
+	// PendingCharges prices the subscription's usage that has not been invoiced.
+	PendingCharges(ctx context.Context, tenantID uuid.UUID, subscriptionID uuid.UUID) ([]*Charge, error)
+}
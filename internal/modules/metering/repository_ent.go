@@ -0,0 +1,224 @@
+package metering
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/subscription"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionmeter"
+	"github.com/bengobox/treasury-api/internal/ent/usagerecord"
+)
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// CreateMeter adds a meter to a subscription.
+func (r *EntRepository) CreateMeter(ctx context.Context, tenantID uuid.UUID, subscriptionID uuid.UUID, meter *Meter) (*Meter, error) {
+	exists, err := r.client.Subscription.Query().
+		Where(
+			subscription.ID(subscriptionID),
+			subscription.TenantID(tenantID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get subscription: %w", err)
+	}
+	if !exists {
+		return nil, ErrSubscriptionNotFound
+	}
+
+	builder := r.client.SubscriptionMeter.Create().
+		SetTenantID(tenantID).
+		SetSubscriptionID(subscriptionID).
+		SetCode(meter.Code).
+		SetName(meter.Name).
+		SetUnit(meter.Unit).
+		SetAggregation(meter.Aggregation).
+		SetPricingModel(meter.PricingModel).
+		SetUnitPrice(meter.UnitPrice)
+	if len(meter.Tiers) > 0 {
+		builder.SetTiers(meter.Tiers)
+	}
+
+	entMeter, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrMeterExists
+		}
+		return nil, fmt.Errorf("create meter: %w", err)
+	}
+
+	return mapEntMeter(entMeter), nil
+}
+
+// ListMeters lists the meters of a subscription.
+func (r *EntRepository) ListMeters(ctx context.Context, tenantID uuid.UUID, subscriptionID uuid.UUID) ([]*Meter, error) {
+	entMeters, err := r.client.SubscriptionMeter.Query().
+		Where(
+			subscriptionmeter.TenantID(tenantID),
+			subscriptionmeter.SubscriptionID(subscriptionID),
+		).
+		Order(ent.Asc(subscriptionmeter.FieldCode)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list meters: %w", err)
+	}
+
+	meters := make([]*Meter, len(entMeters))
+	for i, entMeter := range entMeters {
+		meters[i] = mapEntMeter(entMeter)
+	}
+
+	return meters, nil
+}
+
+// RecordUsage stores a usage record, ignoring events that were already recorded.
+func (r *EntRepository) RecordUsage(ctx context.Context, tenantID uuid.UUID, req RecordUsageRequest) (*UsageRecord, bool, error) {
+	existing, err := r.client.UsageRecord.Query().
+		Where(
+			usagerecord.TenantID(tenantID),
+			usagerecord.EventID(req.EventID),
+		).
+		Only(ctx)
+	if err == nil {
+		return mapEntUsageRecord(existing), true, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, false, fmt.Errorf("check usage event: %w", err)
+	}
+
+	query := r.client.Subscription.Query().
+		Where(subscription.TenantID(tenantID))
+	if req.SubscriptionID != nil {
+		query = query.Where(subscription.ID(*req.SubscriptionID))
+	} else {
+		query = query.Where(subscription.ReferenceID(req.ReferenceID))
+	}
+	entSub, err := query.
+		Order(ent.Desc(subscription.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, false, ErrSubscriptionNotFound
+		}
+		return nil, false, fmt.Errorf("get subscription: %w", err)
+	}
+
+	occurredAt := time.Now()
+	if req.OccurredAt != nil {
+		occurredAt = *req.OccurredAt
+	}
+	if !entSub.EndedAt.IsZero() && !occurredAt.Before(entSub.EndedAt) {
+		return nil, false, ErrSubscriptionEnded
+	}
+
+	entMeter, err := r.client.SubscriptionMeter.Query().
+		Where(
+			subscriptionmeter.SubscriptionID(entSub.ID),
+			subscriptionmeter.Code(req.Meter),
+			subscriptionmeter.Active(true),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, false, ErrMeterNotFound
+		}
+		return nil, false, fmt.Errorf("get meter: %w", err)
+	}
+
+	metadata := req.Metadata
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+
+	entRecord, err := r.client.UsageRecord.Create().
+		SetTenantID(tenantID).
+		SetSubscriptionID(entSub.ID).
+		SetMeterID(entMeter.ID).
+		SetEventID(req.EventID).
+		SetQuantity(req.Quantity).
+		SetOccurredAt(occurredAt).
+		SetMetadata(metadata).
+		Save(ctx)
+	if err != nil {
+		// A concurrent delivery of the same event won the insert.
+		if ent.IsConstraintError(err) {
+			existing, qerr := r.client.UsageRecord.Query().
+				Where(
+					usagerecord.TenantID(tenantID),
+					usagerecord.EventID(req.EventID),
+				).
+				Only(ctx)
+			if qerr != nil {
+				return nil, false, fmt.Errorf("get usage record: %w", qerr)
+			}
+			return mapEntUsageRecord(existing), true, nil
+		}
+		return nil, false, fmt.Errorf("create usage record: %w", err)
+	}
+
+	return mapEntUsageRecord(entRecord), false, nil
+}
+
+// PendingCharges prices all unbilled usage of a subscription.
+func (r *EntRepository) PendingCharges(ctx context.Context, tenantID uuid.UUID, subscriptionID uuid.UUID) ([]*Charge, error) {
+	exists, err := r.client.Subscription.Query().
+		Where(
+			subscription.ID(subscriptionID),
+			subscription.TenantID(tenantID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get subscription: %w", err)
+	}
+	if !exists {
+		return nil, ErrSubscriptionNotFound
+	}
+
+	return unbilledCharges(ctx, r.client.SubscriptionMeter, r.client.UsageRecord, subscriptionID, nil)
+}
+
+func mapEntMeter(entMeter *ent.SubscriptionMeter) *Meter {
+	return &Meter{
+		ID:             entMeter.ID,
+		SubscriptionID: entMeter.SubscriptionID,
+		Code:           entMeter.Code,
+		Name:           entMeter.Name,
+		Unit:           entMeter.Unit,
+		Aggregation:    entMeter.Aggregation,
+		PricingModel:   entMeter.PricingModel,
+		UnitPrice:      entMeter.UnitPrice,
+		Tiers:          entMeter.Tiers,
+		Active:         entMeter.Active,
+		CreatedAt:      entMeter.CreatedAt,
+		UpdatedAt:      entMeter.UpdatedAt,
+	}
+}
+
+func mapEntUsageRecord(entRecord *ent.UsageRecord) *UsageRecord {
+	record := &UsageRecord{
+		ID:             entRecord.ID,
+		SubscriptionID: entRecord.SubscriptionID,
+		MeterID:        entRecord.MeterID,
+		EventID:        entRecord.EventID,
+		Quantity:       entRecord.Quantity,
+		OccurredAt:     entRecord.OccurredAt,
+		CreatedAt:      entRecord.CreatedAt,
+	}
+	if entRecord.BillingCycleID != uuid.Nil {
+		cycleID := entRecord.BillingCycleID
+		record.BillingCycleID = &cycleID
+	}
+	return record
+}
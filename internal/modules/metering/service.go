@@ -0,0 +1,138 @@
+package metering
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/metering/pricing"
+	"github.com/bengobox/treasury-api/internal/platform/events"
+)
+
+// Service provides business logic for subscription meters and usage.
+type Service struct {
+	repo   Repository
+	logger *zap.Logger
+}
+
+// NewService creates a new metering service.
+func NewService(repo Repository, logger *zap.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// CreateMeter adds a priced meter to a subscription.
+func (s *Service) CreateMeter(ctx context.Context, tenantID uuid.UUID, subscriptionID uuid.UUID, req CreateMeterRequest) (*Meter, error) {
+	req.Code = strings.TrimSpace(req.Code)
+	if req.Code == "" {
+		return nil, fmt.Errorf("%w: code is required", ErrInvalidMeter)
+	}
+	if req.Aggregation == "" {
+		req.Aggregation = AggregationSum
+	}
+	switch req.Aggregation {
+	case AggregationSum, AggregationMax, AggregationLast:
+	default:
+		return nil, fmt.Errorf("%w: aggregation must be sum, max or last", ErrInvalidMeter)
+	}
+	if req.PricingModel == "" {
+		req.PricingModel = pricing.ModelPerUnit
+	}
+	if err := pricing.Validate(req.PricingModel, req.UnitPrice, req.Tiers); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMeter, err)
+	}
+
+	meter, err := s.repo.CreateMeter(ctx, tenantID, subscriptionID, &Meter{
+		Code:         req.Code,
+		Name:         req.Name,
+		Unit:         req.Unit,
+		Aggregation:  req.Aggregation,
+		PricingModel: req.PricingModel,
+		UnitPrice:    req.UnitPrice,
+		Tiers:        req.Tiers,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create meter: %w", err)
+	}
+
+	s.logger.Info("subscription meter created",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("subscription_id", subscriptionID.String()),
+		zap.String("meter", meter.Code),
+		zap.String("pricing_model", meter.PricingModel),
+	)
+
+	return meter, nil
+}
+
+// ListMeters lists a subscription's meters.
+func (s *Service) ListMeters(ctx context.Context, tenantID uuid.UUID, subscriptionID uuid.UUID) ([]*Meter, error) {
+	return s.repo.ListMeters(ctx, tenantID, subscriptionID)
+}
+
+// PendingCharges previews the usage charges the next invoice will carry.
+func (s *Service) PendingCharges(ctx context.Context, tenantID uuid.UUID, subscriptionID uuid.UUID) ([]*Charge, error) {
+	return s.repo.PendingCharges(ctx, tenantID, subscriptionID)
+}
+
+// RecordUsage stores a usage report. Reports are idempotent per event ID.
+func (s *Service) RecordUsage(ctx context.Context, tenantID uuid.UUID, req RecordUsageRequest) (*UsageRecord, bool, error) {
+	req.EventID = strings.TrimSpace(req.EventID)
+	req.Meter = strings.TrimSpace(req.Meter)
+	if req.EventID == "" {
+		return nil, false, fmt.Errorf("%w: event_id is required", ErrInvalidUsage)
+	}
+	if req.SubscriptionID == nil && req.ReferenceID == "" {
+		return nil, false, fmt.Errorf("%w: subscription_id or reference_id is required", ErrInvalidUsage)
+	}
+	if req.Meter == "" {
+		return nil, false, fmt.Errorf("%w: meter is required", ErrInvalidUsage)
+	}
+	if req.Quantity.IsNegative() {
+		return nil, false, fmt.Errorf("%w: quantity cannot be negative", ErrInvalidUsage)
+	}
+
+	record, duplicate, err := s.repo.RecordUsage(ctx, tenantID, req)
+	if err != nil {
+		return nil, false, fmt.Errorf("record usage: %w", err)
+	}
+
+	if duplicate {
+		s.logger.Debug("duplicate usage event ignored",
+			zap.String("tenant_id", tenantID.String()),
+			zap.String("event_id", req.EventID),
+		)
+	}
+
+	return record, duplicate, nil
+}
+
+// HandleUsageEvent consumes cafe.subscription.usage.metered. The envelope's
+// event ID is the deduplication key. Events that can never be recorded
+// (unknown subscription or meter, invalid data) fail permanently.
+func (s *Service) HandleUsageEvent(ctx context.Context, env events.Envelope) error {
+	var req RecordUsageRequest
+	if err := env.DecodeData(&req); err != nil {
+		return err
+	}
+	req.EventID = env.EventID
+	if req.OccurredAt == nil && !env.Timestamp.IsZero() {
+		occurredAt := env.Timestamp
+		req.OccurredAt = &occurredAt
+	}
+
+	_, _, err := s.RecordUsage(ctx, env.TenantID, req)
+	if errors.Is(err, ErrInvalidUsage) ||
+		errors.Is(err, ErrSubscriptionNotFound) ||
+		errors.Is(err, ErrSubscriptionEnded) ||
+		errors.Is(err, ErrMeterNotFound) {
+		return events.Permanent(err)
+	}
+	return err
+}
@@ -0,0 +1,57 @@
+package numbering
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/documentsequence"
+)
+
+// Sequence names and the prefixes used when formatting their numbers.
+const (
+	SequenceInvoice                = "invoice"
+	PrefixInvoice                  = "INV"
+	SequenceCustomer               = "customer"
+	PrefixCustomer                 = "CUS"
+	SequenceVendor                 = "vendor"
+	PrefixVendor                   = "VEN"
+	SequenceBill                   = "bill"
+	PrefixBill                     = "BILL"
+	SequencePaymentRun             = "payment_run"
+	PrefixPaymentRun               = "PRUN"
+	SequenceWithholdingCertificate = "withholding_certificate"
+	PrefixWithholdingCertificate   = "WHT"
+	SequenceSettlementBatch        = "settlement_batch"
+	PrefixSettlementBatch          = "STL"
+	SequencePayeePayout            = "payee_payout"
+	PrefixPayeePayout              = "PYT"
+)
+
+// Next increments the tenant's named sequence and returns the formatted
+// document number (e.g. INV-000042). The sequence row stays locked until the
+// caller's transaction ends, so numbers are gap-free per committed transaction.
+func Next(ctx context.Context, tx *ent.Tx, tenantID uuid.UUID, name, prefix string) (string, error) {
+	id, err := tx.DocumentSequence.Create().
+		SetTenantID(tenantID).
+		SetName(name).
+		SetLastValue(1).
+		OnConflictColumns(documentsequence.FieldTenantID, documentsequence.FieldName).
+		Update(func(u *ent.DocumentSequenceUpsert) {
+			u.AddLastValue(1)
+			u.UpdateUpdatedAt()
+		}).
+		ID(ctx)
+	if err != nil {
+		return "", fmt.Errorf("advance %s sequence: %w", name, err)
+	}
+
+	sequence, err := tx.DocumentSequence.Get(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("read %s sequence: %w", name, err)
+	}
+
+	return fmt.Sprintf("%s-%06d", prefix, sequence.LastValue), nil
+}
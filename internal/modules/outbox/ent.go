@@ -0,0 +1,49 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+)
+
+// Outbox event statuses stored on outbox_events.status.
+const (
+	StatusPending   = "PENDING"
+	StatusPublished = "PUBLISHED"
+	StatusFailed    = "FAILED"
+)
+
+// Event is a domain event waiting to be published to the event bus.
+type Event struct {
+	TenantID      uuid.UUID
+	AggregateType string
+	AggregateID   uuid.UUID
+	EventType     string
+	Payload       map[string]any
+}
+
+// Enqueue stores an event in the outbox within the caller's Ent transaction so
+// it is only published if the business change commits.
+func Enqueue(ctx context.Context, tx *ent.Tx, event Event) error {
+	payload := event.Payload
+	if payload == nil {
+		payload = map[string]any{}
+	}
+
+	err := tx.OutboxEvent.Create().
+		SetTenantID(event.TenantID).
+		SetAggregateType(event.AggregateType).
+		SetAggregateID(event.AggregateID).
+		SetEventType(event.EventType).
+		SetPayload(payload).
+		SetStatus(StatusPending).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("enqueue %s: %w", event.EventType, err)
+	}
+
+	return nil
+}
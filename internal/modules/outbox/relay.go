@@ -0,0 +1,104 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/outboxevent"
+)
+
+// maxAttempts bounds how often a failed event is retried before it is left for
+// manual intervention.
+const maxAttempts = 10
+
+// envelope is the wire format of published events.
+type envelope struct {
+	EventID   string         `json:"event_id"`
+	EventType string         `json:"event_type"`
+	TenantID  string         `json:"tenant_id"`
+	Timestamp time.Time      `json:"timestamp"`
+	Data      map[string]any `json:"data"`
+}
+
+// Relay publishes pending outbox events to JetStream.
+type Relay struct {
+	client    *ent.Client
+	js        nats.JetStreamContext
+	logger    *zap.Logger
+	batchSize int
+}
+
+// NewRelay creates a new outbox relay.
+func NewRelay(client *ent.Client, js nats.JetStreamContext, logger *zap.Logger) *Relay {
+	return &Relay{
+		client:    client,
+		js:        js,
+		logger:    logger,
+		batchSize: 100,
+	}
+}
+
+// PublishPending publishes one batch of pending or retryable events in
+// creation order. The outbox ID is used as the JetStream message ID so
+// redeliveries after a crash are deduplicated by the stream.
+func (r *Relay) PublishPending(ctx context.Context) error {
+	records, err := r.client.OutboxEvent.Query().
+		Where(
+			outboxevent.StatusIn(StatusPending, StatusFailed),
+			outboxevent.AttemptsLT(maxAttempts),
+		).
+		Order(ent.Asc(outboxevent.FieldCreatedAt)).
+		Limit(r.batchSize).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query pending outbox events: %w", err)
+	}
+
+	for _, record := range records {
+		data, err := json.Marshal(envelope{
+			EventID:   record.ID.String(),
+			EventType: record.EventType,
+			TenantID:  record.TenantID.String(),
+			Timestamp: record.CreatedAt.UTC(),
+			Data:      record.Payload,
+		})
+		if err != nil {
+			return fmt.Errorf("marshal outbox event %s: %w", record.ID, err)
+		}
+
+		now := time.Now()
+		if _, err := r.js.Publish(record.EventType, data, nats.MsgId(record.ID.String()), nats.Context(ctx)); err != nil {
+			r.logger.Warn("outbox publish failed",
+				zap.String("event_id", record.ID.String()),
+				zap.String("event_type", record.EventType),
+				zap.Error(err),
+			)
+			if uerr := r.client.OutboxEvent.UpdateOneID(record.ID).
+				SetStatus(StatusFailed).
+				AddAttempts(1).
+				SetLastAttemptAt(now).
+				SetErrorMessage(err.Error()).
+				Exec(ctx); uerr != nil {
+				return fmt.Errorf("mark outbox event failed: %w", uerr)
+			}
+			continue
+		}
+
+		if err := r.client.OutboxEvent.UpdateOneID(record.ID).
+			SetStatus(StatusPublished).
+			AddAttempts(1).
+			SetLastAttemptAt(now).
+			SetPublishedAt(now).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("mark outbox event published: %w", err)
+		}
+	}
+
+	return nil
+}
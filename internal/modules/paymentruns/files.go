@@ -0,0 +1,156 @@
+package paymentruns
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/vendors"
+)
+
+// payee is one vendor's share of a payment run: the vendor is paid once for
+// all of their bills.
+type payee struct {
+	Vendor      *vendors.Vendor
+	Items       []*Item
+	Amount      decimal.Decimal
+	Discount    decimal.Decimal
+	Withholding decimal.Decimal
+}
+
+// payees groups the run's proposed or paid items by vendor, in item order.
+func payees(run *Run, vendorsByID map[uuid.UUID]*vendors.Vendor) []*payee {
+	var grouped []*payee
+	byVendor := make(map[uuid.UUID]*payee)
+	for _, item := range run.Items {
+		if item.Status != ItemProposed && item.Status != ItemPaid {
+			continue
+		}
+		p, ok := byVendor[item.VendorID]
+		if !ok {
+			p = &payee{Vendor: vendorsByID[item.VendorID], Amount: decimal.Zero, Discount: decimal.Zero, Withholding: decimal.Zero}
+			byVendor[item.VendorID] = p
+			grouped = append(grouped, p)
+		}
+		p.Items = append(p.Items, item)
+		p.Amount = p.Amount.Add(item.Amount)
+		p.Discount = p.Discount.Add(item.DiscountAmount)
+		p.Withholding = p.Withholding.Add(item.WithholdingAmount)
+	}
+	return grouped
+}
+
+// destination returns the vendor's account for the method: account name,
+// account number, bank code and branch code for bank transfers, or the
+// identifier type (paybill or till), short code and account reference for
+// M-Pesa B2B.
+func destination(method string, v *vendors.Vendor) ([]string, error) {
+	switch method {
+	case MethodBankTransfer:
+		accountName := detail(v.PaymentDetails, "account_name")
+		if accountName == "" {
+			accountName = v.LegalName
+		}
+		accountNumber := detail(v.PaymentDetails, "account_number")
+		bankCode := detail(v.PaymentDetails, "bank_code")
+		if accountNumber == "" || bankCode == "" {
+			return nil, fmt.Errorf("%w: vendor %s needs payment_details account_number and bank_code", ErrInvalidRun, v.VendorNumber)
+		}
+		return []string{accountName, accountNumber, bankCode, detail(v.PaymentDetails, "branch_code")}, nil
+	case MethodMpesaB2B:
+		reference := detail(v.PaymentDetails, "account_reference", "account_number")
+		if paybill := detail(v.PaymentDetails, "paybill"); paybill != "" {
+			return []string{"paybill", paybill, reference}, nil
+		}
+		if till := detail(v.PaymentDetails, "till"); till != "" {
+			return []string{"till", till, ""}, nil
+		}
+		return nil, fmt.Errorf("%w: vendor %s needs payment_details paybill or till", ErrInvalidRun, v.VendorNumber)
+	default:
+		return nil, fmt.Errorf("%w: unsupported method %s", ErrInvalidRun, method)
+	}
+}
+
+// writePaymentFile writes the run's payment instructions as CSV, one line per
+// vendor, for upload to the bank or the M-Pesa business portal.
+func writePaymentFile(w io.Writer, run *Run, grouped []*payee) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{"payee_name", "account_name", "account_number", "bank_code", "branch_code", "amount", "currency", "payment_date", "reference", "narrative"}
+	if run.Method == MethodMpesaB2B {
+		header = []string{"payee_name", "identifier_type", "short_code", "account_reference", "amount", "currency", "payment_date", "reference", "narrative"}
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, p := range grouped {
+		account, err := destination(run.Method, p.Vendor)
+		if err != nil {
+			return err
+		}
+
+		record := append([]string{payeeName(p.Vendor)}, account...)
+		record = append(record,
+			p.Amount.StringFixed(2),
+			run.Currency,
+			run.PaymentDate.Format(time.DateOnly),
+			run.RunNumber,
+			narrative(p),
+		)
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// narrative lists the vendor's own invoice numbers, falling back to bill
+// numbers, so the vendor can match the payment.
+func narrative(p *payee) string {
+	refs := make([]string, len(p.Items))
+	for i, item := range p.Items {
+		refs[i] = item.VendorReference
+		if refs[i] == "" {
+			refs[i] = item.BillNumber
+		}
+	}
+	return truncate(strings.Join(refs, " "), 100)
+}
+
+func payeeName(v *vendors.Vendor) string {
+	if v.TradingName != "" {
+		return v.TradingName
+	}
+	return v.LegalName
+}
+
+// detail returns the first non-empty payment detail among keys. Numbers
+// decoded from JSON are formatted without exponents.
+func detail(details map[string]any, keys ...string) string {
+	for _, key := range keys {
+		var value string
+		switch v := details[key].(type) {
+		case nil:
+			continue
+		case string:
+			value = v
+		case float64:
+			value = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			value = fmt.Sprint(v)
+		}
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,141 @@
+package paymentruns
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/vendors"
+)
+
+// Payment run statuses.
+const (
+	StatusDraft     = "draft"
+	StatusApproved  = "approved"
+	StatusExecuted  = "executed"
+	StatusCancelled = "cancelled"
+)
+
+// Payment run item statuses.
+const (
+	ItemProposed  = "proposed"
+	ItemRemoved   = "removed"
+	ItemPaid      = "paid"
+	ItemCancelled = "cancelled"
+)
+
+// Payment methods of a run and the vendor payment method each one pays.
+const (
+	MethodBankTransfer = "bank_transfer"
+	MethodMpesaB2B     = "mpesa_b2b"
+)
+
+var vendorMethods = map[string]string{
+	MethodBankTransfer: "bank_transfer",
+	MethodMpesaB2B:     "mpesa",
+}
+
+// Outbox events published by the payment runs module.
+const (
+	EventPaymentRunExecuted = "treasury.payment_run.executed"
+	EventRemittanceAdvice   = "treasury.remittance.generated"
+)
+
+// Run is a batch of approved vendor bills paid together on one date.
+type Run struct {
+	ID                uuid.UUID       `json:"id"`
+	TenantID          uuid.UUID       `json:"tenant_id"`
+	RunNumber         string          `json:"run_number"`
+	PaymentDate       time.Time       `json:"payment_date"`
+	Currency          string          `json:"currency"`
+	Method            string          `json:"method"` // bank_transfer, mpesa_b2b
+	PaymentAccount    string          `json:"payment_account"`
+	DueBefore         *time.Time      `json:"due_before,omitempty"`
+	VendorIDs         []uuid.UUID     `json:"vendor_ids,omitempty"`
+	Status            string          `json:"status"` // draft, approved, executed, cancelled
+	TotalAmount       decimal.Decimal `json:"total_amount"`
+	DiscountAmount    decimal.Decimal `json:"discount_amount"`
+	WithholdingAmount decimal.Decimal `json:"withholding_amount"`
+	BillCount         int             `json:"bill_count"`
+	FileKey           string          `json:"file_key,omitempty"`
+	Notes             string          `json:"notes,omitempty"`
+	CreatedBy         *uuid.UUID      `json:"created_by,omitempty"`
+	ApprovedBy        *uuid.UUID      `json:"approved_by,omitempty"`
+	ApprovedAt        *time.Time      `json:"approved_at,omitempty"`
+	ExecutedBy        *uuid.UUID      `json:"executed_by,omitempty"`
+	ExecutedAt        *time.Time      `json:"executed_at,omitempty"`
+	CancelledAt       *time.Time      `json:"cancelled_at,omitempty"`
+	Metadata          map[string]any  `json:"metadata,omitempty"`
+	Items             []*Item         `json:"items,omitempty"`
+	CreatedAt         time.Time       `json:"created_at"`
+	UpdatedAt         time.Time       `json:"updated_at"`
+}
+
+// Item is a bill proposed for payment in a run.
+type Item struct {
+	ID                uuid.UUID       `json:"id"`
+	BillID            uuid.UUID       `json:"bill_id"`
+	VendorID          uuid.UUID       `json:"vendor_id"`
+	BillNumber        string          `json:"bill_number"`
+	VendorReference   string          `json:"vendor_reference,omitempty"`
+	DueDate           time.Time       `json:"due_date"`
+	Balance           decimal.Decimal `json:"balance"`
+	DiscountAmount    decimal.Decimal `json:"discount_amount"`
+	WithholdingAmount decimal.Decimal `json:"withholding_amount"`
+	Amount            decimal.Decimal `json:"amount"`
+	Status            string          `json:"status"` // proposed, removed, paid, cancelled
+	RemittanceKey     string          `json:"remittance_key,omitempty"`
+}
+
+// CreateRequest proposes a payment run. Approved and scheduled bills in the
+// currency, due on or before DueBefore and owed to vendors paid by the run's
+// method are selected; VendorIDs limits the selection to those vendors.
+// PaymentDate defaults to today, Currency to KES and PaymentAccount to cash
+// (1000).
+type CreateRequest struct {
+	PaymentDate    *time.Time     `json:"payment_date"`
+	Currency       string         `json:"currency"`
+	Method         string         `json:"method"`
+	PaymentAccount string         `json:"payment_account"`
+	DueBefore      *time.Time     `json:"due_before"`
+	VendorIDs      []uuid.UUID    `json:"vendor_ids"`
+	Notes          string         `json:"notes"`
+	Metadata       map[string]any `json:"metadata"`
+}
+
+// Execution records the documents produced when a run is executed.
+type Execution struct {
+	ExecutedBy  *uuid.UUID
+	ExecutedAt  time.Time
+	FileKey     string
+	Payload     map[string]any
+	Remittances []Document
+}
+
+// Document is a stored remittance advice and the payload of its event.
+type Document struct {
+	VendorID  uuid.UUID
+	ObjectKey string
+	Payload   map[string]any
+}
+
+// Remittance is the advice sent to one vendor listing the bills paid.
+type Remittance struct {
+	RunNumber   string
+	PaymentDate time.Time
+	Currency    string
+	Method      string
+	Vendor      *vendors.Vendor
+	Items       []*Item
+	Discount    decimal.Decimal
+	Withholding decimal.Decimal
+	Total       decimal.Decimal
+}
+
+// ListFilter narrows payment run listings.
+type ListFilter struct {
+	Status string
+	Limit  int
+	Offset int
+}
@@ -0,0 +1,47 @@
+package paymentruns
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/modules/bills"
+)
+
+// proposeItem proposes paying a bill's balance on the payment date, less the
+// early-payment discount the vendor offers when the date falls within the
+// discount window and less the withholding tax on the bill.
+func proposeItem(entBill *ent.VendorBill, paymentDate time.Time) *Item {
+	balance := bills.OpenBalance(entBill)
+	discount := bills.EarlyPaymentDiscount(balance, entBill.DiscountRate, entBill.DiscountUntil, paymentDate)
+	withheld := entBill.WithholdingAmount.Sub(entBill.WithholdingTaken)
+
+	return &Item{
+		BillID:            entBill.ID,
+		VendorID:          entBill.VendorID,
+		BillNumber:        entBill.BillNumber,
+		VendorReference:   entBill.VendorReference,
+		DueDate:           entBill.DueDate,
+		Balance:           balance,
+		DiscountAmount:    discount,
+		WithholdingAmount: withheld,
+		Amount:            balance.Sub(discount).Sub(withheld),
+		Status:            ItemProposed,
+	}
+}
+
+// summarize totals the items still proposed for payment.
+func summarize(items []*Item) (total, discount, withheld decimal.Decimal, count int) {
+	total, discount, withheld = decimal.Zero, decimal.Zero, decimal.Zero
+	for _, item := range items {
+		if item.Status != ItemProposed && item.Status != ItemPaid {
+			continue
+		}
+		total = total.Add(item.Amount)
+		discount = discount.Add(item.DiscountAmount)
+		withheld = withheld.Add(item.WithholdingAmount)
+		count++
+	}
+	return total, discount, withheld, count
+}
@@ -0,0 +1,110 @@
+package paymentruns
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/modules/vendors"
+)
+
+func amount(v string) decimal.Decimal {
+	return decimal.RequireFromString(v)
+}
+
+func TestProposeItem(t *testing.T) {
+	bill := &ent.VendorBill{
+		ID:            uuid.New(),
+		VendorID:      uuid.New(),
+		BillNumber:    "BILL-000012",
+		TotalAmount:   amount("11600"),
+		DiscountRate:  amount("0.02"),
+		DiscountUntil: time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC),
+		DueDate:       time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	early := proposeItem(bill, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+	if !early.DiscountAmount.Equal(amount("232")) || !early.Amount.Equal(amount("11368")) {
+		t.Fatalf("expected 232 discount and 11368 payment, got %s and %s", early.DiscountAmount, early.Amount)
+	}
+
+	late := proposeItem(bill, time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC))
+	if !late.DiscountAmount.IsZero() || !late.Amount.Equal(amount("11600")) {
+		t.Fatalf("expected full balance after the discount window, got %s and %s", late.DiscountAmount, late.Amount)
+	}
+
+	items := []*Item{early, late, {Status: ItemRemoved, Amount: amount("999")}}
+	total, discount, withheld, count := summarize(items)
+	if !total.Equal(amount("22968")) || !discount.Equal(amount("232")) || !withheld.IsZero() || count != 2 {
+		t.Fatalf("expected removed items excluded from totals, got %s, %s, %s, %d", total, discount, withheld, count)
+	}
+
+	bill.WithholdingAmount = amount("500")
+	withholding := proposeItem(bill, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+	if !withholding.WithholdingAmount.Equal(amount("500")) || !withholding.Amount.Equal(amount("10868")) {
+		t.Fatalf("expected withholding tax deducted from the payment, got %s and %s", withholding.WithholdingAmount, withholding.Amount)
+	}
+}
+
+func TestWritePaymentFile(t *testing.T) {
+	acme := &vendors.Vendor{
+		ID:           uuid.New(),
+		VendorNumber: "VEN-000001",
+		LegalName:    "Acme Supplies Ltd",
+		PaymentDetails: map[string]any{
+			"account_number": "0112233445",
+			"bank_code":      "01",
+			"branch_code":    "094",
+		},
+	}
+	run := &Run{
+		RunNumber:   "PRUN-000003",
+		PaymentDate: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+		Currency:    "KES",
+		Method:      MethodBankTransfer,
+		Items: []*Item{
+			{VendorID: acme.ID, BillNumber: "BILL-000012", VendorReference: "INV-881", Amount: amount("11368"), DiscountAmount: amount("232"), Status: ItemProposed},
+			{VendorID: acme.ID, BillNumber: "BILL-000015", Amount: amount("5000"), Status: ItemProposed},
+			{VendorID: acme.ID, BillNumber: "BILL-000016", Amount: amount("700"), Status: ItemRemoved},
+		},
+	}
+
+	grouped := payees(run, map[uuid.UUID]*vendors.Vendor{acme.ID: acme})
+	if len(grouped) != 1 || !grouped[0].Amount.Equal(amount("16368")) {
+		t.Fatalf("expected one payment of 16368 to the vendor, got %+v", grouped)
+	}
+
+	var file bytes.Buffer
+	if err := writePaymentFile(&file, run, grouped); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(file.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected header and one payment line, got %q", file.String())
+	}
+	want := "Acme Supplies Ltd,Acme Supplies Ltd,0112233445,01,094,16368.00,KES,2026-03-10,PRUN-000003,INV-881 BILL-000015"
+	if lines[1] != want {
+		t.Fatalf("expected %q, got %q", want, lines[1])
+	}
+
+	run.Method = MethodMpesaB2B
+	file.Reset()
+	if err := writePaymentFile(&file, run, grouped); !errors.Is(err, ErrInvalidRun) {
+		t.Fatalf("expected ErrInvalidRun for a vendor without a paybill or till, got %v", err)
+	}
+
+	acme.PaymentDetails = map[string]any{"paybill": float64(400200), "account_reference": "ACME"}
+	file.Reset()
+	if err := writePaymentFile(&file, run, grouped); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(file.String(), "Acme Supplies Ltd,paybill,400200,ACME,16368.00") {
+		t.Fatalf("expected paybill payment line, got %q", file.String())
+	}
+}
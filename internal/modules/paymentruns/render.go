@@ -0,0 +1,131 @@
+package paymentruns
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/shared/pdf"
+)
+
+const (
+	marginLeft  = 40.0
+	marginRight = pdf.PageWidth - 40.0
+	lineHeight  = 14.0
+	pageBottom  = 60.0
+)
+
+var methodLabels = map[string]string{
+	MethodBankTransfer: "bank transfer",
+	MethodMpesaB2B:     "M-Pesa",
+}
+
+// RenderRemittance lays a vendor's remittance advice out as an A4 PDF.
+func RenderRemittance(rem *Remittance) []byte {
+	doc := pdf.New()
+	page := doc.AddPage()
+	y := pdf.PageHeight - 50
+
+	page.Text(marginLeft, y, 18, true, "Remittance Advice")
+	page.TextRight(marginRight, y, 10, false, "Payment "+rem.RunNumber)
+	y -= 28
+
+	page.Text(marginLeft, y, 11, true, rem.Vendor.LegalName)
+	page.TextRight(marginRight, y, 10, false, "Vendor "+rem.Vendor.VendorNumber)
+	y -= lineHeight
+	if rem.Vendor.KRAPIN != "" {
+		page.Text(marginLeft, y, 9, false, "KRA PIN: "+rem.Vendor.KRAPIN)
+		y -= lineHeight
+	}
+	y -= 10
+
+	page.Text(marginLeft, y, 10, false, fmt.Sprintf("We have paid %s %s by %s on %s for the bills below.",
+		rem.Currency, money(rem.Total), methodLabels[rem.Method], formatDate(rem.PaymentDate)))
+	y -= 2 * lineHeight
+
+	header := func() {
+		page.Text(marginLeft, y, 9, true, "Your reference")
+		page.Text(marginLeft+110, y, 9, true, "Our reference")
+		page.Text(marginLeft+200, y, 9, true, "Due date")
+		page.TextRight(marginRight-225, y, 9, true, "Amount")
+		page.TextRight(marginRight-150, y, 9, true, "Discount")
+		page.TextRight(marginRight-75, y, 9, true, "Tax withheld")
+		page.TextRight(marginRight, y, 9, true, "Paid")
+		y -= 5
+		page.Line(marginLeft, y, marginRight, y)
+		y -= lineHeight
+	}
+	header()
+
+	for _, item := range rem.Items {
+		if y < pageBottom+3*lineHeight {
+			page = doc.AddPage()
+			y = pdf.PageHeight - 50
+			header()
+		}
+		page.Text(marginLeft, y, 9, false, truncate(item.VendorReference, 20))
+		page.Text(marginLeft+110, y, 9, false, item.BillNumber)
+		page.Text(marginLeft+200, y, 9, false, formatDate(item.DueDate))
+		page.TextRight(marginRight-225, y, 9, false, money(item.Balance))
+		if item.DiscountAmount.IsPositive() {
+			page.TextRight(marginRight-150, y, 9, false, money(item.DiscountAmount))
+		}
+		if item.WithholdingAmount.IsPositive() {
+			page.TextRight(marginRight-75, y, 9, false, money(item.WithholdingAmount))
+		}
+		page.TextRight(marginRight, y, 9, false, money(item.Amount))
+		y -= lineHeight
+	}
+
+	y -= 5
+	page.Line(marginLeft, y, marginRight, y)
+	y -= lineHeight
+	page.Text(marginLeft+200, y, 9, true, "Total ("+rem.Currency+")")
+	if rem.Discount.IsPositive() {
+		page.TextRight(marginRight-150, y, 9, true, money(rem.Discount))
+	}
+	if rem.Withholding.IsPositive() {
+		page.TextRight(marginRight-75, y, 9, true, money(rem.Withholding))
+	}
+	page.TextRight(marginRight, y, 9, true, money(rem.Total))
+
+	if rem.Withholding.IsPositive() {
+		y -= 2 * lineHeight
+		page.Text(marginLeft, y, 9, false, "Withholding tax was deducted as required by law; a certificate is issued for each bill.")
+	}
+
+	return doc.Bytes()
+}
+
+func money(amount decimal.Decimal) string {
+	fixed := amount.Abs().StringFixed(2)
+	whole, fraction, _ := strings.Cut(fixed, ".")
+
+	var b strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(digit)
+	}
+
+	formatted := b.String() + "." + fraction
+	if amount.IsNegative() {
+		return "(" + formatted + ")"
+	}
+	return formatted
+}
+
+func truncate(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(runes[:max-3]) + "..."
+}
+
+func formatDate(t time.Time) string {
+	return t.Format("02 Jan 2006")
+}
@@ -0,0 +1,46 @@
+package paymentruns
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrRunNotFound is returned when a payment run does not exist for the tenant.
+	ErrRunNotFound = errors.New("payment run not found")
+	// ErrItemNotFound is returned when a bill is not part of the payment run.
+	ErrItemNotFound = errors.New("payment run item not found")
+	// ErrInvalidRun is returned when a payment run request is malformed.
+	ErrInvalidRun = errors.New("invalid payment run")
+	// ErrNoBills is returned when no bills match a payment run's selection.
+	ErrNoBills = errors.New("no bills to pay")
+	// ErrRunStatus is returned when the run's status does not allow the action.
+	ErrRunStatus = errors.New("payment run status does not allow this action")
+	// ErrSelfApproval is returned when the user who proposed a run tries to approve it.
+	ErrSelfApproval = errors.New("payment run must be approved by another user")
+	// ErrBillChanged is returned when a bill in the run was paid or changed
+	// after it was proposed.
+	ErrBillChanged = errors.New("bill changed since the payment run was proposed")
+)
+
+// Repository abstracts persistence for payment runs.
+type Repository interface {
+	// Create selects the bills matching the run's criteria, proposes their
+	// payment and stores the draft run.
+	Create(ctx context.Context, run *Run) (*Run, error)
+	Get(ctx context.Context, tenantID, runID uuid.UUID) (*Run, error)
+	List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Run, error)
+	// RemoveItem drops a bill from a draft run.
+	RemoveItem(ctx context.Context, tenantID, runID, itemID uuid.UUID) (*Run, error)
+	// Approve approves a draft run and schedules its bills for the payment date.
+	Approve(ctx context.Context, tenantID, runID uuid.UUID, approvedBy *uuid.UUID, now time.Time) (*Run, error)
+	// Execute pays the bills of an approved run and enqueues the run and
+	// remittance advice events.
+	Execute(ctx context.Context, tenantID, runID uuid.UUID, execution Execution) (*Run, error)
+	// Cancel cancels a draft or approved run and returns its scheduled bills
+	// to approved.
+	Cancel(ctx context.Context, tenantID, runID uuid.UUID, now time.Time) (*Run, error)
+}
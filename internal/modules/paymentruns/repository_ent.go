@@ -0,0 +1,588 @@
+package paymentruns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrun"
+	"github.com/bengobox/treasury-api/internal/ent/paymentrunitem"
+	"github.com/bengobox/treasury-api/internal/ent/vendor"
+	"github.com/bengobox/treasury-api/internal/ent/vendorbill"
+	"github.com/bengobox/treasury-api/internal/modules/bills"
+	"github.com/bengobox/treasury-api/internal/modules/numbering"
+	"github.com/bengobox/treasury-api/internal/modules/outbox"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+)
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// Create selects the bills matching the run's criteria and stores the draft run.
+func (r *EntRepository) Create(ctx context.Context, run *Run) (*Run, error) {
+	var runID uuid.UUID
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entBills, err := selectBills(ctx, tx, run)
+		if err != nil {
+			return err
+		}
+		if len(entBills) == 0 {
+			return ErrNoBills
+		}
+
+		items := make([]*Item, len(entBills))
+		for i, entBill := range entBills {
+			items[i] = proposeItem(entBill, run.PaymentDate)
+		}
+		total, discount, withheld, count := summarize(items)
+
+		number, err := numbering.Next(ctx, tx, run.TenantID, numbering.SequencePaymentRun, numbering.PrefixPaymentRun)
+		if err != nil {
+			return err
+		}
+
+		builder := tx.PaymentRun.Create().
+			SetTenantID(run.TenantID).
+			SetRunNumber(number).
+			SetPaymentDate(run.PaymentDate).
+			SetCurrency(run.Currency).
+			SetMethod(run.Method).
+			SetPaymentAccount(run.PaymentAccount).
+			SetNillableDueBefore(run.DueBefore).
+			SetVendorIds(run.VendorIDs).
+			SetStatus(StatusDraft).
+			SetTotalAmount(total).
+			SetDiscountAmount(discount).
+			SetWithholdingAmount(withheld).
+			SetBillCount(count).
+			SetNotes(run.Notes).
+			SetNillableCreatedBy(run.CreatedBy)
+		if run.Metadata != nil {
+			builder.SetMetadata(run.Metadata)
+		}
+
+		created, err := builder.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create payment run: %w", err)
+		}
+		runID = created.ID
+
+		itemBuilders := make([]*ent.PaymentRunItemCreate, len(items))
+		for i, item := range items {
+			itemBuilders[i] = tx.PaymentRunItem.Create().
+				SetTenantID(run.TenantID).
+				SetRun(created).
+				SetBillID(item.BillID).
+				SetVendorID(item.VendorID).
+				SetBillNumber(item.BillNumber).
+				SetVendorReference(item.VendorReference).
+				SetDueDate(item.DueDate).
+				SetBalance(item.Balance).
+				SetDiscountAmount(item.DiscountAmount).
+				SetWithholdingAmount(item.WithholdingAmount).
+				SetAmount(item.Amount).
+				SetStatus(ItemProposed)
+		}
+		if _, err := tx.PaymentRunItem.CreateBulk(itemBuilders...).Save(ctx); err != nil {
+			if ent.IsConstraintError(err) {
+				return fmt.Errorf("%w: a selected bill was proposed in another run", ErrBillChanged)
+			}
+			return fmt.Errorf("create payment run items: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, run.TenantID, runID)
+}
+
+// Get retrieves a payment run with its items.
+func (r *EntRepository) Get(ctx context.Context, tenantID, runID uuid.UUID) (*Run, error) {
+	entRun, err := r.client.PaymentRun.Query().
+		Where(
+			paymentrun.ID(runID),
+			paymentrun.TenantID(tenantID),
+		).
+		WithItems(func(q *ent.PaymentRunItemQuery) {
+			q.Order(ent.Asc(paymentrunitem.FieldVendorID), ent.Asc(paymentrunitem.FieldDueDate), ent.Asc(paymentrunitem.FieldBillNumber))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrRunNotFound
+		}
+		return nil, fmt.Errorf("get payment run: %w", err)
+	}
+
+	return mapEntRun(entRun), nil
+}
+
+// List returns the tenant's payment runs, newest payment date first.
+func (r *EntRepository) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Run, error) {
+	query := r.client.PaymentRun.Query().
+		Where(paymentrun.TenantID(tenantID))
+	if filter.Status != "" {
+		query = query.Where(paymentrun.Status(filter.Status))
+	}
+
+	entRuns, err := query.
+		Order(ent.Desc(paymentrun.FieldPaymentDate), ent.Desc(paymentrun.FieldRunNumber)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list payment runs: %w", err)
+	}
+
+	runs := make([]*Run, len(entRuns))
+	for i, entRun := range entRuns {
+		runs[i] = mapEntRun(entRun)
+	}
+	return runs, nil
+}
+
+// RemoveItem drops a bill from a draft run and updates the run totals.
+func (r *EntRepository) RemoveItem(ctx context.Context, tenantID, runID, itemID uuid.UUID) (*Run, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entRun, err := lockRun(ctx, tx, tenantID, runID, StatusDraft)
+		if err != nil {
+			return err
+		}
+
+		removed, err := tx.PaymentRunItem.Update().
+			Where(
+				paymentrunitem.ID(itemID),
+				paymentrunitem.RunID(entRun.ID),
+				paymentrunitem.Status(ItemProposed),
+			).
+			SetStatus(ItemRemoved).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("remove payment run item: %w", err)
+		}
+		if removed == 0 {
+			return ErrItemNotFound
+		}
+
+		return updateTotals(ctx, tx, entRun)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, tenantID, runID)
+}
+
+// Approve approves a draft run and schedules its bills for the payment date.
+func (r *EntRepository) Approve(ctx context.Context, tenantID, runID uuid.UUID, approvedBy *uuid.UUID, now time.Time) (*Run, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entRun, err := lockRun(ctx, tx, tenantID, runID, StatusDraft)
+		if err != nil {
+			return err
+		}
+		if approvedBy != nil && entRun.CreatedBy == *approvedBy {
+			return ErrSelfApproval
+		}
+		if entRun.BillCount == 0 {
+			return fmt.Errorf("%w: every bill was removed from the run", ErrNoBills)
+		}
+
+		billIDs, err := proposedBillIDs(ctx, tx, entRun.ID)
+		if err != nil {
+			return err
+		}
+		scheduled, err := tx.VendorBill.Update().
+			Where(
+				vendorbill.IDIn(billIDs...),
+				vendorbill.TenantID(tenantID),
+				vendorbill.StatusIn(bills.StatusApproved, bills.StatusScheduled),
+			).
+			SetStatus(bills.StatusScheduled).
+			SetScheduledFor(entRun.PaymentDate).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("schedule run bills: %w", err)
+		}
+		if scheduled != len(billIDs) {
+			return fmt.Errorf("%w: remove bills that are no longer approved", ErrBillChanged)
+		}
+
+		builder := entRun.Update().
+			SetStatus(StatusApproved).
+			SetApprovedAt(now)
+		if approvedBy != nil {
+			builder.SetApprovedBy(*approvedBy)
+		}
+		if err := builder.Exec(ctx); err != nil {
+			return fmt.Errorf("approve payment run: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, tenantID, runID)
+}
+
+// Execute settles every proposed bill of an approved run against the run's
+// payment account, records the stored documents and enqueues the run and
+// remittance advice events.
+func (r *EntRepository) Execute(ctx context.Context, tenantID, runID uuid.UUID, execution Execution) (*Run, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entRun, err := lockRun(ctx, tx, tenantID, runID, StatusApproved)
+		if err != nil {
+			return err
+		}
+
+		entItems, err := tx.PaymentRunItem.Query().
+			Where(
+				paymentrunitem.RunID(entRun.ID),
+				paymentrunitem.Status(ItemProposed),
+			).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("load payment run items: %w", err)
+		}
+
+		for _, entItem := range entItems {
+			err := bills.Settle(ctx, tx, tenantID, entItem.BillID, bills.Settlement{
+				Amount:           entItem.Amount,
+				Discount:         entItem.DiscountAmount,
+				Withholding:      entItem.WithholdingAmount,
+				PaidAt:           entRun.PaymentDate,
+				PaymentAccount:   entRun.PaymentAccount,
+				PaymentReference: entRun.RunNumber,
+				Metadata: map[string]any{
+					"payment_run_id":     entRun.ID.String(),
+					"payment_run_number": entRun.RunNumber,
+				},
+			})
+			if err != nil {
+				if errors.Is(err, bills.ErrBillStatus) || errors.Is(err, bills.ErrBillNotFound) {
+					return fmt.Errorf("%w: %s: %v", ErrBillChanged, entItem.BillNumber, err)
+				}
+				return err
+			}
+		}
+
+		for _, doc := range execution.Remittances {
+			if err := tx.PaymentRunItem.Update().
+				Where(
+					paymentrunitem.RunID(entRun.ID),
+					paymentrunitem.VendorID(doc.VendorID),
+					paymentrunitem.Status(ItemProposed),
+				).
+				SetRemittanceKey(doc.ObjectKey).
+				Exec(ctx); err != nil {
+				return fmt.Errorf("record remittance advice: %w", err)
+			}
+			if err := outbox.Enqueue(ctx, tx, outbox.Event{
+				TenantID:      tenantID,
+				AggregateType: "payment_run",
+				AggregateID:   entRun.ID,
+				EventType:     EventRemittanceAdvice,
+				Payload:       doc.Payload,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.PaymentRunItem.Update().
+			Where(
+				paymentrunitem.RunID(entRun.ID),
+				paymentrunitem.Status(ItemProposed),
+			).
+			SetStatus(ItemPaid).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("mark payment run items paid: %w", err)
+		}
+
+		builder := entRun.Update().
+			SetStatus(StatusExecuted).
+			SetExecutedAt(execution.ExecutedAt).
+			SetFileKey(execution.FileKey)
+		if execution.ExecutedBy != nil {
+			builder.SetExecutedBy(*execution.ExecutedBy)
+		}
+		if err := builder.Exec(ctx); err != nil {
+			return fmt.Errorf("execute payment run: %w", err)
+		}
+
+		return outbox.Enqueue(ctx, tx, outbox.Event{
+			TenantID:      tenantID,
+			AggregateType: "payment_run",
+			AggregateID:   entRun.ID,
+			EventType:     EventPaymentRunExecuted,
+			Payload:       execution.Payload,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, tenantID, runID)
+}
+
+// Cancel cancels a draft or approved run. Bills the run scheduled return to
+// approved so they can be paid another way.
+func (r *EntRepository) Cancel(ctx context.Context, tenantID, runID uuid.UUID, now time.Time) (*Run, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entRun, err := lockRun(ctx, tx, tenantID, runID, StatusDraft, StatusApproved)
+		if err != nil {
+			return err
+		}
+
+		if entRun.Status == StatusApproved {
+			billIDs, err := proposedBillIDs(ctx, tx, entRun.ID)
+			if err != nil {
+				return err
+			}
+			if err := tx.VendorBill.Update().
+				Where(
+					vendorbill.IDIn(billIDs...),
+					vendorbill.TenantID(tenantID),
+					vendorbill.Status(bills.StatusScheduled),
+				).
+				SetStatus(bills.StatusApproved).
+				ClearScheduledFor().
+				Exec(ctx); err != nil {
+				return fmt.Errorf("unschedule run bills: %w", err)
+			}
+		}
+
+		if err := tx.PaymentRunItem.Update().
+			Where(
+				paymentrunitem.RunID(entRun.ID),
+				paymentrunitem.Status(ItemProposed),
+			).
+			SetStatus(ItemCancelled).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("cancel payment run items: %w", err)
+		}
+
+		if err := entRun.Update().
+			SetStatus(StatusCancelled).
+			SetCancelledAt(now).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("cancel payment run: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, tenantID, runID)
+}
+
+// selectBills returns the approved and scheduled bills matching the run's
+// criteria that are not already proposed in another run, grouped by vendor.
+func selectBills(ctx context.Context, tx *ent.Tx, run *Run) ([]*ent.VendorBill, error) {
+	vendorQuery := tx.Vendor.Query().
+		Where(
+			vendor.TenantID(run.TenantID),
+			vendor.PaymentMethod(vendorMethods[run.Method]),
+		)
+	if len(run.VendorIDs) > 0 {
+		vendorQuery = vendorQuery.Where(vendor.IDIn(run.VendorIDs...))
+	}
+	vendorIDs, err := vendorQuery.IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("select vendors: %w", err)
+	}
+	if len(vendorIDs) == 0 {
+		return nil, nil
+	}
+
+	proposed, err := tx.PaymentRunItem.Query().
+		Where(
+			paymentrunitem.TenantID(run.TenantID),
+			paymentrunitem.Status(ItemProposed),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load proposed bills: %w", err)
+	}
+	proposedIDs := make([]uuid.UUID, len(proposed))
+	for i, item := range proposed {
+		proposedIDs[i] = item.BillID
+	}
+
+	query := tx.VendorBill.Query().
+		Where(
+			vendorbill.TenantID(run.TenantID),
+			vendorbill.StatusIn(bills.StatusApproved, bills.StatusScheduled),
+			vendorbill.Currency(run.Currency),
+			vendorbill.VendorIDIn(vendorIDs...),
+		)
+	if run.DueBefore != nil {
+		query = query.Where(vendorbill.DueDateLTE(*run.DueBefore))
+	}
+	if len(proposedIDs) > 0 {
+		query = query.Where(vendorbill.IDNotIn(proposedIDs...))
+	}
+
+	entBills, err := query.
+		Order(ent.Asc(vendorbill.FieldVendorID), ent.Asc(vendorbill.FieldDueDate), ent.Asc(vendorbill.FieldBillNumber)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("select bills: %w", err)
+	}
+
+	selected := entBills[:0]
+	for _, entBill := range entBills {
+		if bills.OpenBalance(entBill).IsPositive() {
+			selected = append(selected, entBill)
+		}
+	}
+	return selected, nil
+}
+
+func lockRun(ctx context.Context, tx *ent.Tx, tenantID, runID uuid.UUID, statuses ...string) (*ent.PaymentRun, error) {
+	entRun, err := tx.PaymentRun.Query().
+		Where(
+			paymentrun.ID(runID),
+			paymentrun.TenantID(tenantID),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrRunNotFound
+		}
+		return nil, fmt.Errorf("lock payment run: %w", err)
+	}
+
+	for _, status := range statuses {
+		if entRun.Status == status {
+			return entRun, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: payment run is %s", ErrRunStatus, entRun.Status)
+}
+
+func proposedBillIDs(ctx context.Context, tx *ent.Tx, runID uuid.UUID) ([]uuid.UUID, error) {
+	entItems, err := tx.PaymentRunItem.Query().
+		Where(
+			paymentrunitem.RunID(runID),
+			paymentrunitem.Status(ItemProposed),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load payment run items: %w", err)
+	}
+
+	ids := make([]uuid.UUID, len(entItems))
+	for i, entItem := range entItems {
+		ids[i] = entItem.BillID
+	}
+	return ids, nil
+}
+
+func updateTotals(ctx context.Context, tx *ent.Tx, entRun *ent.PaymentRun) error {
+	entItems, err := tx.PaymentRunItem.Query().
+		Where(paymentrunitem.RunID(entRun.ID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("load payment run items: %w", err)
+	}
+
+	items := make([]*Item, len(entItems))
+	for i, entItem := range entItems {
+		items[i] = mapEntItem(entItem)
+	}
+	total, discount, withheld, count := summarize(items)
+
+	if err := entRun.Update().
+		SetTotalAmount(total).
+		SetDiscountAmount(discount).
+		SetWithholdingAmount(withheld).
+		SetBillCount(count).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("update payment run totals: %w", err)
+	}
+	return nil
+}
+
+func mapEntRun(entRun *ent.PaymentRun) *Run {
+	run := &Run{
+		ID:                entRun.ID,
+		TenantID:          entRun.TenantID,
+		RunNumber:         entRun.RunNumber,
+		PaymentDate:       entRun.PaymentDate,
+		Currency:          entRun.Currency,
+		Method:            entRun.Method,
+		PaymentAccount:    entRun.PaymentAccount,
+		VendorIDs:         entRun.VendorIds,
+		Status:            entRun.Status,
+		TotalAmount:       entRun.TotalAmount,
+		DiscountAmount:    entRun.DiscountAmount,
+		WithholdingAmount: entRun.WithholdingAmount,
+		BillCount:         entRun.BillCount,
+		FileKey:           entRun.FileKey,
+		Notes:             entRun.Notes,
+		Metadata:          entRun.Metadata,
+		CreatedAt:         entRun.CreatedAt,
+		UpdatedAt:         entRun.UpdatedAt,
+	}
+
+	// Optional fields - check for zero values
+	if !entRun.DueBefore.IsZero() {
+		run.DueBefore = &entRun.DueBefore
+	}
+	if entRun.CreatedBy != uuid.Nil {
+		run.CreatedBy = &entRun.CreatedBy
+	}
+	if entRun.ApprovedBy != uuid.Nil {
+		run.ApprovedBy = &entRun.ApprovedBy
+	}
+	if !entRun.ApprovedAt.IsZero() {
+		run.ApprovedAt = &entRun.ApprovedAt
+	}
+	if entRun.ExecutedBy != uuid.Nil {
+		run.ExecutedBy = &entRun.ExecutedBy
+	}
+	if !entRun.ExecutedAt.IsZero() {
+		run.ExecutedAt = &entRun.ExecutedAt
+	}
+	if !entRun.CancelledAt.IsZero() {
+		run.CancelledAt = &entRun.CancelledAt
+	}
+
+	for _, entItem := range entRun.Edges.Items {
+		run.Items = append(run.Items, mapEntItem(entItem))
+	}
+	return run
+}
+
+func mapEntItem(entItem *ent.PaymentRunItem) *Item {
+	return &Item{
+		ID:                entItem.ID,
+		BillID:            entItem.BillID,
+		VendorID:          entItem.VendorID,
+		BillNumber:        entItem.BillNumber,
+		VendorReference:   entItem.VendorReference,
+		DueDate:           entItem.DueDate,
+		Balance:           entItem.Balance,
+		DiscountAmount:    entItem.DiscountAmount,
+		WithholdingAmount: entItem.WithholdingAmount,
+		Amount:            entItem.Amount,
+		Status:            entItem.Status,
+		RemittanceKey:     entItem.RemittanceKey,
+	}
+}
@@ -0,0 +1,326 @@
+package paymentruns
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+	"github.com/bengobox/treasury-api/internal/modules/vendors"
+)
+
+// ObjectStore stores payment files and remittance advices.
+type ObjectStore interface {
+	Bucket() string
+	Put(ctx context.Context, key, contentType string, body []byte) error
+}
+
+// Service proposes, approves and executes vendor payment runs.
+type Service struct {
+	repo    Repository
+	vendors *vendors.Service
+	store   ObjectStore
+	logger  *zap.Logger
+}
+
+// NewService creates a new payment runs service.
+func NewService(repo Repository, vendorService *vendors.Service, store ObjectStore, logger *zap.Logger) *Service {
+	return &Service{
+		repo:    repo,
+		vendors: vendorService,
+		store:   store,
+		logger:  logger,
+	}
+}
+
+// Create proposes a payment run for the approved bills matching the request.
+// Each bill is proposed at its balance less any early-payment discount still
+// available on the payment date and less its withholding tax.
+func (s *Service) Create(ctx context.Context, tenantID uuid.UUID, req CreateRequest, createdBy *uuid.UUID) (*Run, error) {
+	if _, ok := vendorMethods[req.Method]; !ok {
+		return nil, fmt.Errorf("%w: method must be bank_transfer or mpesa_b2b", ErrInvalidRun)
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(req.Currency))
+	if currency == "" {
+		currency = "KES"
+	}
+	if len(currency) != 3 {
+		return nil, fmt.Errorf("%w: currency must be an ISO currency code", ErrInvalidRun)
+	}
+
+	paymentAccount := strings.TrimSpace(req.PaymentAccount)
+	if paymentAccount == "" {
+		paymentAccount = ledger.AccountCash
+	}
+	if paymentAccount == ledger.AccountPayable {
+		return nil, fmt.Errorf("%w: payment_account cannot be accounts payable", ErrInvalidRun)
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	paymentDate := today
+	if req.PaymentDate != nil {
+		paymentDate = *req.PaymentDate
+	}
+	if paymentDate.Before(today) {
+		return nil, fmt.Errorf("%w: payment_date cannot be in the past", ErrInvalidRun)
+	}
+
+	run, err := s.repo.Create(ctx, &Run{
+		TenantID:       tenantID,
+		PaymentDate:    paymentDate,
+		Currency:       currency,
+		Method:         req.Method,
+		PaymentAccount: paymentAccount,
+		DueBefore:      req.DueBefore,
+		VendorIDs:      req.VendorIDs,
+		Notes:          req.Notes,
+		CreatedBy:      createdBy,
+		Metadata:       req.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("payment run proposed",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("run_id", run.ID.String()),
+		zap.String("run_number", run.RunNumber),
+		zap.Int("bill_count", run.BillCount),
+		zap.String("total", run.TotalAmount.String()),
+	)
+
+	return run, nil
+}
+
+// Get retrieves a payment run with its items.
+func (s *Service) Get(ctx context.Context, tenantID, runID uuid.UUID) (*Run, error) {
+	return s.repo.Get(ctx, tenantID, runID)
+}
+
+// List returns the tenant's payment runs.
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Run, error) {
+	return s.repo.List(ctx, tenantID, filter)
+}
+
+// RemoveItem drops a bill from a draft run.
+func (s *Service) RemoveItem(ctx context.Context, tenantID, runID, itemID uuid.UUID) (*Run, error) {
+	return s.repo.RemoveItem(ctx, tenantID, runID, itemID)
+}
+
+// Approve approves a draft run and schedules its bills for the payment date.
+// The user who proposed the run cannot approve it.
+func (s *Service) Approve(ctx context.Context, tenantID, runID uuid.UUID, approvedBy *uuid.UUID) (*Run, error) {
+	run, err := s.repo.Approve(ctx, tenantID, runID, approvedBy, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("payment run approved",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("run_id", run.ID.String()),
+		zap.String("run_number", run.RunNumber),
+	)
+
+	return run, nil
+}
+
+// Execute pays an approved run. The payment file for the bank or the M-Pesa
+// business portal and a remittance advice per vendor are stored first; the
+// bills are then marked paid, with discounts taken credited to discounts
+// received and withholding tax certificates issued, and
+// treasury.payment_run.executed and one treasury.remittance.generated per
+// vendor are published.
+func (s *Service) Execute(ctx context.Context, tenantID, runID uuid.UUID, executedBy *uuid.UUID) (*Run, error) {
+	run, err := s.repo.Get(ctx, tenantID, runID)
+	if err != nil {
+		return nil, err
+	}
+	if run.Status != StatusApproved {
+		return nil, fmt.Errorf("%w: payment run is %s", ErrRunStatus, run.Status)
+	}
+
+	grouped, err := s.payees(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+
+	var file bytes.Buffer
+	if err := writePaymentFile(&file, run, grouped); err != nil {
+		return nil, err
+	}
+	fileKey := fmt.Sprintf("payment-runs/%s/%s/%s-%s.csv", tenantID, run.RunNumber, run.RunNumber, run.Method)
+	if err := s.store.Put(ctx, fileKey, "text/csv", file.Bytes()); err != nil {
+		return nil, fmt.Errorf("store payment file: %w", err)
+	}
+
+	docs := make([]Document, len(grouped))
+	for i, p := range grouped {
+		key := fmt.Sprintf("payment-runs/%s/%s/remittance-%s.pdf", tenantID, run.RunNumber, p.Vendor.VendorNumber)
+		if err := s.store.Put(ctx, key, "application/pdf", RenderRemittance(remittance(run, p))); err != nil {
+			return nil, fmt.Errorf("store remittance advice: %w", err)
+		}
+		docs[i] = Document{VendorID: p.Vendor.ID, ObjectKey: key, Payload: s.remittancePayload(run, p, key)}
+	}
+
+	executed, err := s.repo.Execute(ctx, tenantID, runID, Execution{
+		ExecutedBy:  executedBy,
+		ExecutedAt:  time.Now().UTC(),
+		FileKey:     fileKey,
+		Payload:     s.runPayload(run, fileKey),
+		Remittances: docs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("payment run executed",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("run_id", executed.ID.String()),
+		zap.String("run_number", executed.RunNumber),
+		zap.Int("vendors", len(grouped)),
+		zap.String("total", executed.TotalAmount.String()),
+		zap.String("file_key", fileKey),
+	)
+
+	return executed, nil
+}
+
+// Cancel cancels a draft or approved run, returning its bills to approved.
+func (s *Service) Cancel(ctx context.Context, tenantID, runID uuid.UUID) (*Run, error) {
+	run, err := s.repo.Cancel(ctx, tenantID, runID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("payment run cancelled",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("run_id", run.ID.String()),
+		zap.String("run_number", run.RunNumber),
+	)
+
+	return run, nil
+}
+
+// PaymentFile renders an approved or executed run's payment instructions as
+// CSV.
+func (s *Service) PaymentFile(ctx context.Context, tenantID, runID uuid.UUID) (*Run, []byte, error) {
+	run, err := s.repo.Get(ctx, tenantID, runID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if run.Status != StatusApproved && run.Status != StatusExecuted {
+		return nil, nil, fmt.Errorf("%w: payment run is %s", ErrRunStatus, run.Status)
+	}
+
+	grouped, err := s.payees(ctx, run)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var file bytes.Buffer
+	if err := writePaymentFile(&file, run, grouped); err != nil {
+		return nil, nil, err
+	}
+	return run, file.Bytes(), nil
+}
+
+// Remittance builds the remittance advice of one vendor paid in the run.
+func (s *Service) Remittance(ctx context.Context, tenantID, runID, vendorID uuid.UUID) (*Remittance, error) {
+	run, err := s.repo.Get(ctx, tenantID, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	grouped, err := s.payees(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range grouped {
+		if p.Vendor.ID == vendorID {
+			return remittance(run, p), nil
+		}
+	}
+	return nil, ErrItemNotFound
+}
+
+func (s *Service) payees(ctx context.Context, run *Run) ([]*payee, error) {
+	vendorsByID := make(map[uuid.UUID]*vendors.Vendor)
+	for _, item := range run.Items {
+		if _, ok := vendorsByID[item.VendorID]; ok {
+			continue
+		}
+		v, err := s.vendors.Get(ctx, run.TenantID, item.VendorID)
+		if err != nil {
+			return nil, fmt.Errorf("load vendor %s: %w", item.VendorID, err)
+		}
+		vendorsByID[item.VendorID] = v
+	}
+	return payees(run, vendorsByID), nil
+}
+
+func remittance(run *Run, p *payee) *Remittance {
+	return &Remittance{
+		RunNumber:   run.RunNumber,
+		PaymentDate: run.PaymentDate,
+		Currency:    run.Currency,
+		Method:      run.Method,
+		Vendor:      p.Vendor,
+		Items:       p.Items,
+		Discount:    p.Discount,
+		Withholding: p.Withholding,
+		Total:       p.Amount,
+	}
+}
+
+func (s *Service) runPayload(run *Run, fileKey string) map[string]any {
+	return map[string]any{
+		"run_id":             run.ID.String(),
+		"run_number":         run.RunNumber,
+		"payment_date":       run.PaymentDate.Format(time.DateOnly),
+		"currency":           run.Currency,
+		"method":             run.Method,
+		"payment_account":    run.PaymentAccount,
+		"total_amount":       run.TotalAmount.String(),
+		"discount_amount":    run.DiscountAmount.String(),
+		"withholding_amount": run.WithholdingAmount.String(),
+		"bill_count":         run.BillCount,
+		"bucket":             s.store.Bucket(),
+		"file_key":           fileKey,
+		"content_type":       "text/csv",
+	}
+}
+
+func (s *Service) remittancePayload(run *Run, p *payee, key string) map[string]any {
+	billNumbers := make([]string, len(p.Items))
+	for i, item := range p.Items {
+		billNumbers[i] = item.BillNumber
+	}
+
+	payload := map[string]any{
+		"run_id":             run.ID.String(),
+		"run_number":         run.RunNumber,
+		"vendor_id":          p.Vendor.ID.String(),
+		"vendor_number":      p.Vendor.VendorNumber,
+		"vendor_name":        p.Vendor.LegalName,
+		"payment_date":       run.PaymentDate.Format(time.DateOnly),
+		"method":             run.Method,
+		"currency":           run.Currency,
+		"amount":             p.Amount.String(),
+		"discount_amount":    p.Discount.String(),
+		"withholding_amount": p.Withholding.String(),
+		"bill_numbers":       billNumbers,
+		"bucket":             s.store.Bucket(),
+		"object_key":         key,
+		"content_type":       "application/pdf",
+	}
+	if email := strings.TrimSpace(p.Vendor.Email); email != "" {
+		payload["email"] = email
+	}
+	return payload
+}
@@ -9,19 +9,36 @@ import (
 
 // PaymentIntent represents a payment intent entity.
 type PaymentIntent struct {
-	ID            uuid.UUID
-	TenantID      uuid.UUID
-	ReferenceID   string
-	ReferenceType string // order, subscription, invoice
-	PaymentMethod string // mpesa, stripe, cash, bank_transfer
-	Currency      string
-	Amount        decimal.Decimal
-	Status        string // pending, processing, succeeded, failed, cancelled
-	CustomerID    *uuid.UUID
-	Description   *string
-	ExpiresAt     *time.Time
-	Metadata      map[string]any
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	ID            uuid.UUID       `json:"id"`
+	TenantID      uuid.UUID       `json:"tenant_id"`
+	ReferenceID   string          `json:"reference_id"`
+	ReferenceType string          `json:"reference_type"` // order, subscription, invoice
+	PaymentMethod string          `json:"payment_method"` // mpesa, stripe, cash, bank_transfer, on_account
+	Currency      string          `json:"currency"`
+	Amount        decimal.Decimal `json:"amount"`
+	Status        string          `json:"status"` // pending, processing, succeeded, failed, cancelled
+	CustomerID    *uuid.UUID      `json:"customer_id,omitempty"`
+	Description   *string         `json:"description,omitempty"`
+	ExpiresAt     *time.Time      `json:"expires_at,omitempty"`
+	Metadata      map[string]any  `json:"metadata,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
 }
 
+// MethodOnAccount charges the sale to the customer's account instead of
+// collecting payment, and is subject to credit control.
+const MethodOnAccount = "on_account"
+
+// CreateIntentRequest registers a payment intent for an order, subscription
+// or invoice.
+type CreateIntentRequest struct {
+	ReferenceID   string          `json:"reference_id"`
+	ReferenceType string          `json:"reference_type"`
+	PaymentMethod string          `json:"payment_method"`
+	Currency      string          `json:"currency"`
+	Amount        decimal.Decimal `json:"amount"`
+	CustomerID    *uuid.UUID      `json:"customer_id"`
+	Description   *string         `json:"description"`
+	ExpiresAt     *time.Time      `json:"expires_at"`
+	Metadata      map[string]any  `json:"metadata"`
+}
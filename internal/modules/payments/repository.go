@@ -2,10 +2,19 @@ package payments
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 )
 
+var (
+	// ErrDuplicateReference is returned when the tenant already has a payment
+	// intent for the reference.
+	ErrDuplicateReference = errors.New("payment intent already exists for reference")
+	// ErrInvalidIntent is returned when a payment intent request is malformed.
+	ErrInvalidIntent = errors.New("invalid payment intent")
+)
+
 // Repository abstracts persistence for payment intents.
 type Repository interface {
 	CreatePaymentIntent(ctx context.Context, tenantID uuid.UUID, intent *PaymentIntent) error
@@ -51,6 +51,9 @@ func (r *EntRepository) CreatePaymentIntent(ctx context.Context, tenantID uuid.U
 
 	_, err := builder.Save(ctx)
 	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ErrDuplicateReference
+		}
 		return fmt.Errorf("create payment intent: %w", err)
 	}
 
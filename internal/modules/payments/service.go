@@ -0,0 +1,106 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/credit"
+)
+
+var (
+	referenceTypes = map[string]bool{"order": true, "subscription": true, "invoice": true}
+	paymentMethods = map[string]bool{"mpesa": true, "stripe": true, "cash": true, "bank_transfer": true, MethodOnAccount: true}
+)
+
+// Service registers payment intents.
+type Service struct {
+	repo   Repository
+	credit *credit.Service
+	logger *zap.Logger
+}
+
+// NewService creates a new payments service.
+func NewService(repo Repository, creditService *credit.Service, logger *zap.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		credit: creditService,
+		logger: logger,
+	}
+}
+
+// CreateIntent validates and stores a pending payment intent. On-account
+// intents must name the customer and pass credit control.
+func (s *Service) CreateIntent(ctx context.Context, tenantID uuid.UUID, req CreateIntentRequest) (*PaymentIntent, error) {
+	req.ReferenceID = strings.TrimSpace(req.ReferenceID)
+	req.Currency = strings.ToUpper(strings.TrimSpace(req.Currency))
+	if req.Currency == "" {
+		req.Currency = "KES"
+	}
+
+	if req.ReferenceID == "" {
+		return nil, fmt.Errorf("%w: reference_id is required", ErrInvalidIntent)
+	}
+	if !referenceTypes[req.ReferenceType] {
+		return nil, fmt.Errorf("%w: unknown reference_type %q", ErrInvalidIntent, req.ReferenceType)
+	}
+	if !paymentMethods[req.PaymentMethod] {
+		return nil, fmt.Errorf("%w: unknown payment_method %q", ErrInvalidIntent, req.PaymentMethod)
+	}
+	if !req.Amount.IsPositive() {
+		return nil, fmt.Errorf("%w: amount must be positive", ErrInvalidIntent)
+	}
+	if req.Metadata == nil {
+		req.Metadata = map[string]any{}
+	}
+
+	intent := &PaymentIntent{
+		ID:            uuid.New(),
+		TenantID:      tenantID,
+		ReferenceID:   req.ReferenceID,
+		ReferenceType: req.ReferenceType,
+		PaymentMethod: req.PaymentMethod,
+		Currency:      req.Currency,
+		Amount:        req.Amount,
+		Status:        "pending",
+		CustomerID:    req.CustomerID,
+		Description:   req.Description,
+		ExpiresAt:     req.ExpiresAt,
+		Metadata:      req.Metadata,
+	}
+
+	if intent.PaymentMethod == MethodOnAccount {
+		if intent.CustomerID == nil {
+			return nil, fmt.Errorf("%w: customer_id is required for on-account payments", ErrInvalidIntent)
+		}
+		if err := s.credit.Authorize(ctx, tenantID, *intent.CustomerID, credit.DocumentPaymentIntent, intent.ID, intent.Currency, intent.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.CreatePaymentIntent(ctx, tenantID, intent); err != nil {
+		if intent.PaymentMethod == MethodOnAccount {
+			if restoreErr := s.credit.RestoreOverride(ctx, tenantID, credit.DocumentPaymentIntent, intent.ID); restoreErr != nil {
+				s.logger.Error("credit override not restored",
+					zap.String("tenant_id", tenantID.String()),
+					zap.String("intent_id", intent.ID.String()),
+					zap.Error(restoreErr),
+				)
+			}
+		}
+		return nil, err
+	}
+
+	s.logger.Info("payment intent created",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("intent_id", intent.ID.String()),
+		zap.String("reference_id", intent.ReferenceID),
+		zap.String("payment_method", intent.PaymentMethod),
+		zap.String("amount", intent.Amount.String()),
+	)
+
+	return s.repo.GetPaymentIntent(ctx, tenantID, intent.ID)
+}
@@ -0,0 +1,73 @@
+package receivables
+
+import (
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// PlanOldestFirst spreads the available amount across open invoices starting
+// with the earliest due date. Invoices are never overpaid.
+func PlanOldestFirst(available decimal.Decimal, open []*OpenInvoice) []AllocationRequest {
+	invoices := make([]*OpenInvoice, len(open))
+	copy(invoices, open)
+	sort.SliceStable(invoices, func(i, j int) bool {
+		if !invoices[i].DueDate.Equal(invoices[j].DueDate) {
+			return invoices[i].DueDate.Before(invoices[j].DueDate)
+		}
+		if !invoices[i].InvoiceDate.Equal(invoices[j].InvoiceDate) {
+			return invoices[i].InvoiceDate.Before(invoices[j].InvoiceDate)
+		}
+		return invoices[i].InvoiceNumber < invoices[j].InvoiceNumber
+	})
+
+	var plan []AllocationRequest
+	remaining := available
+	for _, invoice := range invoices {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		outstanding := invoice.Outstanding()
+		if !outstanding.IsPositive() {
+			continue
+		}
+
+		amount := decimal.Min(remaining, outstanding)
+		plan = append(plan, AllocationRequest{InvoiceID: invoice.ID, Amount: amount})
+		remaining = remaining.Sub(amount)
+	}
+
+	return plan
+}
+
+// DerivePaymentStatus maps the amount paid against an invoice total to a payment status.
+func DerivePaymentStatus(total, paid decimal.Decimal) string {
+	switch {
+	case !paid.IsPositive():
+		return PaymentStatusUnpaid
+	case paid.LessThan(total):
+		return PaymentStatusPartial
+	case paid.Equal(total):
+		return PaymentStatusPaid
+	default:
+		return PaymentStatusOverpaid
+	}
+}
+
+// deriveInvoiceStatus returns the invoice status after its payment status changed.
+// Fully paid invoices become paid; invoices that lose their payment fall back to
+// sent or overdue depending on the due date.
+func deriveInvoiceStatus(current, paymentStatus string, dueDate, now time.Time) string {
+	if paymentStatus == PaymentStatusPaid || paymentStatus == PaymentStatusOverpaid {
+		return "paid"
+	}
+	if current != "paid" {
+		return current
+	}
+	if dueDate.Before(now) {
+		return "overdue"
+	}
+	return "sent"
+}
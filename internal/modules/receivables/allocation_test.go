@@ -0,0 +1,74 @@
+package receivables
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestPlanOldestFirst(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := &OpenInvoice{ID: uuid.New(), InvoiceNumber: "INV-2", DueDate: base.AddDate(0, 0, 30), TotalAmount: decimal.NewFromInt(500)}
+	older := &OpenInvoice{ID: uuid.New(), InvoiceNumber: "INV-1", DueDate: base, TotalAmount: decimal.NewFromInt(300), PaidAmount: decimal.NewFromInt(100)}
+	settled := &OpenInvoice{ID: uuid.New(), InvoiceNumber: "INV-0", DueDate: base.AddDate(0, 0, -10), TotalAmount: decimal.NewFromInt(50), PaidAmount: decimal.NewFromInt(50)}
+
+	plan := PlanOldestFirst(decimal.NewFromInt(450), []*OpenInvoice{newer, older, settled})
+
+	if len(plan) != 2 {
+		t.Fatalf("expected 2 allocations, got %d", len(plan))
+	}
+	if plan[0].InvoiceID != older.ID || !plan[0].Amount.Equal(decimal.NewFromInt(200)) {
+		t.Fatalf("expected 200 against oldest invoice first, got %+v", plan[0])
+	}
+	if plan[1].InvoiceID != newer.ID || !plan[1].Amount.Equal(decimal.NewFromInt(250)) {
+		t.Fatalf("expected remaining 250 against newer invoice, got %+v", plan[1])
+	}
+}
+
+func TestPlanOldestFirstNeverOverpays(t *testing.T) {
+	invoice := &OpenInvoice{ID: uuid.New(), TotalAmount: decimal.NewFromInt(100)}
+
+	plan := PlanOldestFirst(decimal.NewFromInt(1000), []*OpenInvoice{invoice})
+
+	if len(plan) != 1 || !plan[0].Amount.Equal(decimal.NewFromInt(100)) {
+		t.Fatalf("expected a single 100 allocation, got %+v", plan)
+	}
+}
+
+func TestDerivePaymentStatus(t *testing.T) {
+	total := decimal.NewFromInt(100)
+	tests := map[string]struct {
+		paid decimal.Decimal
+		want string
+	}{
+		"unpaid":   {decimal.Zero, PaymentStatusUnpaid},
+		"partial":  {decimal.NewFromInt(40), PaymentStatusPartial},
+		"paid":     {decimal.NewFromInt(100), PaymentStatusPaid},
+		"overpaid": {decimal.NewFromInt(120), PaymentStatusOverpaid},
+	}
+
+	for name, tt := range tests {
+		if got := DerivePaymentStatus(total, tt.paid); got != tt.want {
+			t.Errorf("%s: expected %s, got %s", name, tt.want, got)
+		}
+	}
+}
+
+func TestDeriveInvoiceStatus(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	if got := deriveInvoiceStatus("sent", PaymentStatusPaid, now, now); got != "paid" {
+		t.Errorf("expected paid, got %s", got)
+	}
+	if got := deriveInvoiceStatus("paid", PaymentStatusPartial, now.AddDate(0, 0, -1), now); got != "overdue" {
+		t.Errorf("expected overdue after unallocation past due date, got %s", got)
+	}
+	if got := deriveInvoiceStatus("paid", PaymentStatusUnpaid, now.AddDate(0, 0, 5), now); got != "sent" {
+		t.Errorf("expected sent after unallocation before due date, got %s", got)
+	}
+	if got := deriveInvoiceStatus("overdue", PaymentStatusPartial, now, now); got != "overdue" {
+		t.Errorf("expected status to be kept, got %s", got)
+	}
+}
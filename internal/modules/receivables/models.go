@@ -0,0 +1,88 @@
+package receivables
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Invoice payment statuses derived from allocations.
+const (
+	PaymentStatusUnpaid   = "unpaid"
+	PaymentStatusPartial  = "partial"
+	PaymentStatusPaid     = "paid"
+	PaymentStatusOverpaid = "overpaid"
+)
+
+// Allocation methods.
+const (
+	AllocationMethodAuto   = "auto"
+	AllocationMethodManual = "manual"
+)
+
+// receiptBatchSize is how many receipts a worker run books per query.
+const receiptBatchSize = 200
+
+// Allocation represents a payment transaction applied to an invoice.
+type Allocation struct {
+	ID                   uuid.UUID       `json:"id"`
+	TenantID             uuid.UUID       `json:"tenant_id"`
+	InvoiceID            uuid.UUID       `json:"invoice_id"`
+	PaymentTransactionID uuid.UUID       `json:"payment_transaction_id"`
+	CustomerID           *uuid.UUID      `json:"customer_id,omitempty"`
+	Amount               decimal.Decimal `json:"amount"`
+	Currency             string          `json:"currency"`
+	Method               string          `json:"method"` // auto, manual
+	AllocatedBy          *uuid.UUID      `json:"allocated_by,omitempty"`
+	JournalEntryID       *uuid.UUID      `json:"journal_entry_id,omitempty"`
+	AppliedAt            time.Time       `json:"applied_at"`
+	CreatedAt            time.Time       `json:"created_at"`
+}
+
+// AllocationRequest asks for an amount of a payment to be applied to an invoice.
+type AllocationRequest struct {
+	InvoiceID uuid.UUID       `json:"invoice_id"`
+	Amount    decimal.Decimal `json:"amount"`
+}
+
+// OpenInvoice is an invoice that can still receive payment allocations.
+type OpenInvoice struct {
+	ID            uuid.UUID
+	InvoiceNumber string
+	CustomerID    *uuid.UUID
+	InvoiceDate   time.Time
+	DueDate       time.Time
+	Currency      string
+	TotalAmount   decimal.Decimal
+	PaidAmount    decimal.Decimal
+	WrittenOff    decimal.Decimal
+}
+
+// Outstanding returns the unpaid balance of the invoice, floored at zero.
+// Amounts written off as bad debt are no longer collectable.
+func (i *OpenInvoice) Outstanding() decimal.Decimal {
+	outstanding := i.TotalAmount.Sub(i.PaidAmount).Sub(i.WrittenOff)
+	if outstanding.IsNegative() {
+		return decimal.Zero
+	}
+	return outstanding
+}
+
+// UnallocatedPayment is a payment with an amount not yet applied to invoices.
+type UnallocatedPayment struct {
+	PaymentTransactionID uuid.UUID       `json:"payment_transaction_id"`
+	ProviderReference    string          `json:"provider_reference"`
+	Amount               decimal.Decimal `json:"amount"`
+	Allocated            decimal.Decimal `json:"allocated"`
+	Unallocated          decimal.Decimal `json:"unallocated"`
+	ProcessedAt          *time.Time      `json:"processed_at,omitempty"`
+}
+
+// CustomerCredit is a customer's unallocated payment balance in one currency.
+type CustomerCredit struct {
+	CustomerID  uuid.UUID            `json:"customer_id"`
+	Currency    string               `json:"currency"`
+	Unallocated decimal.Decimal      `json:"unallocated"`
+	Payments    []UnallocatedPayment `json:"payments"`
+}
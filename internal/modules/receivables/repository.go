@@ -0,0 +1,50 @@
+package receivables
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrPaymentNotFound       = errors.New("payment transaction not found")
+	ErrPaymentNotAllocatable = errors.New("only succeeded payments can be allocated")
+	ErrInvoiceNotFound       = errors.New("invoice not found")
+	ErrInvoiceNotOpen        = errors.New("invoice cannot receive payments")
+	ErrCurrencyMismatch      = errors.New("payment and invoice currencies differ")
+	ErrCustomerMismatch      = errors.New("invoice belongs to another customer")
+	ErrOverAllocation        = errors.New("allocation exceeds unallocated payment amount")
+	ErrExceedsOutstanding    = errors.New("allocation exceeds invoice outstanding amount")
+	ErrInvalidAllocation     = errors.New("invalid allocation request")
+	ErrAllocationNotFound    = errors.New("allocation not found")
+	ErrNoCustomer            = errors.New("payment has no customer to allocate against")
+)
+
+// PlanFunc decides how much of the available payment amount goes to each
+// candidate invoice. It runs inside the allocation transaction.
+type PlanFunc func(available decimal.Decimal, candidates []*OpenInvoice) ([]AllocationRequest, error)
+
+// AllocateOptions controls a payment allocation.
+type AllocateOptions struct {
+	Method      string
+	AllocatedBy *uuid.UUID
+	// InvoiceIDs restricts candidates to the given invoices. When empty, all open
+	// invoices of the payment's customer in the payment currency are candidates.
+	InvoiceIDs []uuid.UUID
+	Plan       PlanFunc
+}
+
+// Repository abstracts persistence for payment allocations.
+type Repository interface {
+	AllocatePayment(ctx context.Context, tenantID uuid.UUID, paymentID uuid.UUID, opts AllocateOptions) ([]*Allocation, error)
+	DeleteAllocation(ctx context.Context, tenantID uuid.UUID, allocationID uuid.UUID) (*Allocation, error)
+	ListPaymentAllocations(ctx context.Context, tenantID uuid.UUID, paymentID uuid.UUID) ([]*Allocation, error)
+	ListInvoiceAllocations(ctx context.Context, tenantID uuid.UUID, invoiceID uuid.UUID) ([]*Allocation, error)
+	GetCustomerCredit(ctx context.Context, tenantID uuid.UUID, customerID uuid.UUID) ([]*CustomerCredit, error)
+	// BookReceipts books up to limit succeeded payments, refunds and
+	// chargebacks that are not on the ledger yet, oldest first, and returns
+	// how many were booked.
+	BookReceipts(ctx context.Context, limit int) (int, error)
+}
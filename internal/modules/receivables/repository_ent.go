@@ -0,0 +1,619 @@
+package receivables
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/invoice"
+	"github.com/bengobox/treasury-api/internal/ent/invoicepayment"
+	"github.com/bengobox/treasury-api/internal/ent/paymentintent"
+	"github.com/bengobox/treasury-api/internal/ent/paymenttransaction"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+	"github.com/bengobox/treasury-api/internal/modules/settlements"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+)
+
+// receiptTypes are the payment transaction types booked against unapplied
+// receipts once they succeed.
+var receiptTypes = []string{"payment", "refund", "chargeback"}
+
+// Invoice types and statuses that never receive payment allocations.
+var (
+	nonPayableInvoiceTypes    = []string{"credit_note", "proforma"}
+	nonPayableInvoiceStatuses = []string{"draft", "cancelled", "written_off"}
+)
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// AllocatePayment applies a payment to invoices, posts the allocation journal
+// and recalculates invoice payment statuses in a single transaction. A
+// receipt the worker has not booked yet is booked first.
+func (r *EntRepository) AllocatePayment(ctx context.Context, tenantID uuid.UUID, paymentID uuid.UUID, opts AllocateOptions) ([]*Allocation, error) {
+	var allocations []*Allocation
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		payment, err := tx.PaymentTransaction.Query().
+			Where(
+				paymenttransaction.ID(paymentID),
+				paymenttransaction.TenantID(tenantID),
+			).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrPaymentNotFound
+			}
+			return fmt.Errorf("get payment transaction: %w", err)
+		}
+
+		if payment.TransactionType != "payment" || payment.Status != "succeeded" {
+			return ErrPaymentNotAllocatable
+		}
+		if err := bookReceipt(ctx, tx, payment); err != nil {
+			return err
+		}
+
+		allocated, err := sumAllocations(ctx, tx, invoicepayment.PaymentTransactionID(paymentID))
+		if err != nil {
+			return err
+		}
+		available := payment.Amount.Sub(allocated)
+
+		customerID, err := paymentCustomer(ctx, tx, tenantID, payment.PaymentIntentID)
+		if err != nil {
+			return err
+		}
+
+		candidates, invoices, err := loadCandidates(ctx, tx, tenantID, payment.Currency, customerID, opts.InvoiceIDs)
+		if err != nil {
+			return err
+		}
+
+		plan, err := opts.Plan(available, candidates)
+		if err != nil {
+			return err
+		}
+		if len(plan) == 0 {
+			return nil
+		}
+
+		total := decimal.Zero
+		lines := []ledger.Line{}
+		for _, req := range plan {
+			inv, ok := invoices[req.InvoiceID]
+			if !ok {
+				return fmt.Errorf("%w: invoice %s is not a candidate", ErrInvalidAllocation, req.InvoiceID)
+			}
+			if !req.Amount.IsPositive() {
+				return fmt.Errorf("%w: amount must be positive", ErrInvalidAllocation)
+			}
+			total = total.Add(req.Amount)
+
+			line := ledger.Credit(ledger.AccountReceivable, req.Amount)
+			line.Description = "Payment applied to invoice " + inv.InvoiceNumber
+			lines = append(lines, line)
+		}
+		if total.GreaterThan(available) {
+			return fmt.Errorf("%w: requested %s, available %s", ErrOverAllocation, total, available)
+		}
+		lines = append([]ledger.Line{ledger.Debit(ledger.AccountUnappliedReceipts, total)}, lines...)
+
+		now := time.Now()
+		journalID, err := ledger.PostJournal(ctx, tx, ledger.Journal{
+			TenantID:      tenantID,
+			EntryDate:     now,
+			Currency:      payment.Currency,
+			ReferenceType: "payment_allocation",
+			ReferenceID:   paymentID,
+			Description:   "Payment allocation " + payment.ProviderReference,
+			Lines:         lines,
+		})
+		if err != nil {
+			return fmt.Errorf("post allocation journal: %w", err)
+		}
+
+		for _, req := range plan {
+			inv := invoices[req.InvoiceID]
+			builder := tx.InvoicePayment.Create().
+				SetTenantID(tenantID).
+				SetInvoiceID(inv.ID).
+				SetPaymentTransactionID(paymentID).
+				SetAmountApplied(req.Amount).
+				SetCurrency(payment.Currency).
+				SetAllocationMethod(opts.Method).
+				SetJournalEntryID(journalID).
+				SetAppliedAt(now)
+
+			if inv.CustomerID != uuid.Nil {
+				builder.SetCustomerID(inv.CustomerID)
+			}
+			if opts.AllocatedBy != nil {
+				builder.SetAllocatedBy(*opts.AllocatedBy)
+			}
+
+			entAllocation, err := builder.Save(ctx)
+			if err != nil {
+				return fmt.Errorf("create allocation: %w", err)
+			}
+			allocations = append(allocations, mapEntAllocation(entAllocation))
+		}
+
+		for _, req := range plan {
+			if err := refreshInvoiceStatus(ctx, tx, invoices[req.InvoiceID]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allocations, nil
+}
+
+// DeleteAllocation removes an allocation, reverses its journal and
+// recalculates the invoice payment status.
+func (r *EntRepository) DeleteAllocation(ctx context.Context, tenantID uuid.UUID, allocationID uuid.UUID) (*Allocation, error) {
+	var deleted *Allocation
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entAllocation, err := tx.InvoicePayment.Query().
+			Where(
+				invoicepayment.ID(allocationID),
+				invoicepayment.TenantID(tenantID),
+			).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrAllocationNotFound
+			}
+			return fmt.Errorf("get allocation: %w", err)
+		}
+
+		inv, err := tx.Invoice.Query().
+			Where(invoice.ID(entAllocation.InvoiceID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("lock invoice: %w", err)
+		}
+
+		if err := tx.InvoicePayment.DeleteOneID(allocationID).Exec(ctx); err != nil {
+			return fmt.Errorf("delete allocation: %w", err)
+		}
+
+		_, err = ledger.PostJournal(ctx, tx, ledger.Journal{
+			TenantID:      tenantID,
+			EntryDate:     time.Now(),
+			Currency:      entAllocation.Currency,
+			ReferenceType: "payment_allocation",
+			ReferenceID:   entAllocation.PaymentTransactionID,
+			Description:   "Payment allocation reversed for invoice " + inv.InvoiceNumber,
+			Lines: []ledger.Line{
+				ledger.Debit(ledger.AccountReceivable, entAllocation.AmountApplied),
+				ledger.Credit(ledger.AccountUnappliedReceipts, entAllocation.AmountApplied),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("post allocation reversal: %w", err)
+		}
+
+		if err := refreshInvoiceStatus(ctx, tx, inv); err != nil {
+			return err
+		}
+
+		deleted = mapEntAllocation(entAllocation)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deleted, nil
+}
+
+// ListPaymentAllocations lists the allocations of a payment transaction.
+func (r *EntRepository) ListPaymentAllocations(ctx context.Context, tenantID uuid.UUID, paymentID uuid.UUID) ([]*Allocation, error) {
+	return r.listAllocations(ctx, tenantID, invoicepayment.PaymentTransactionID(paymentID))
+}
+
+// ListInvoiceAllocations lists the allocations applied to an invoice.
+func (r *EntRepository) ListInvoiceAllocations(ctx context.Context, tenantID uuid.UUID, invoiceID uuid.UUID) ([]*Allocation, error) {
+	return r.listAllocations(ctx, tenantID, invoicepayment.InvoiceID(invoiceID))
+}
+
+func (r *EntRepository) listAllocations(ctx context.Context, tenantID uuid.UUID, filter predicate.InvoicePayment) ([]*Allocation, error) {
+	entAllocations, err := r.client.InvoicePayment.Query().
+		Where(invoicepayment.TenantID(tenantID), filter).
+		Order(ent.Asc(invoicepayment.FieldAppliedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list allocations: %w", err)
+	}
+
+	allocations := make([]*Allocation, len(entAllocations))
+	for i, entAllocation := range entAllocations {
+		allocations[i] = mapEntAllocation(entAllocation)
+	}
+
+	return allocations, nil
+}
+
+// GetCustomerCredit returns the customer's unallocated payment balances per currency.
+func (r *EntRepository) GetCustomerCredit(ctx context.Context, tenantID uuid.UUID, customerID uuid.UUID) ([]*CustomerCredit, error) {
+	intentIDs, err := r.client.PaymentIntent.Query().
+		Where(
+			paymentintent.TenantID(tenantID),
+			paymentintent.CustomerID(customerID),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list customer payment intents: %w", err)
+	}
+	if len(intentIDs) == 0 {
+		return []*CustomerCredit{}, nil
+	}
+
+	payments, err := r.client.PaymentTransaction.Query().
+		Where(
+			paymenttransaction.TenantID(tenantID),
+			paymenttransaction.PaymentIntentIDIn(intentIDs...),
+			paymenttransaction.TransactionType("payment"),
+			paymenttransaction.Status("succeeded"),
+		).
+		Order(ent.Asc(paymenttransaction.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list customer payments: %w", err)
+	}
+
+	paymentIDs := make([]uuid.UUID, len(payments))
+	for i, payment := range payments {
+		paymentIDs[i] = payment.ID
+	}
+
+	entAllocations, err := r.client.InvoicePayment.Query().
+		Where(invoicepayment.PaymentTransactionIDIn(paymentIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list customer allocations: %w", err)
+	}
+
+	allocated := map[uuid.UUID]decimal.Decimal{}
+	for _, entAllocation := range entAllocations {
+		allocated[entAllocation.PaymentTransactionID] = allocated[entAllocation.PaymentTransactionID].Add(entAllocation.AmountApplied)
+	}
+
+	byCurrency := map[string]*CustomerCredit{}
+	credits := []*CustomerCredit{}
+	for _, payment := range payments {
+		unallocated := payment.Amount.Sub(allocated[payment.ID])
+		if !unallocated.IsPositive() {
+			continue
+		}
+
+		credit, ok := byCurrency[payment.Currency]
+		if !ok {
+			credit = &CustomerCredit{CustomerID: customerID, Currency: payment.Currency, Unallocated: decimal.Zero}
+			byCurrency[payment.Currency] = credit
+			credits = append(credits, credit)
+		}
+
+		entry := UnallocatedPayment{
+			PaymentTransactionID: payment.ID,
+			ProviderReference:    payment.ProviderReference,
+			Amount:               payment.Amount,
+			Allocated:            allocated[payment.ID],
+			Unallocated:          unallocated,
+		}
+		if !payment.ProcessedAt.IsZero() {
+			processedAt := payment.ProcessedAt
+			entry.ProcessedAt = &processedAt
+		}
+
+		credit.Unallocated = credit.Unallocated.Add(unallocated)
+		credit.Payments = append(credit.Payments, entry)
+	}
+
+	return credits, nil
+}
+
+// BookReceipts books the succeeded transactions not on the ledger yet, each in
+// its own transaction so one failure does not hold back the rest of the batch.
+// The errors of the transactions that failed are returned together.
+func (r *EntRepository) BookReceipts(ctx context.Context, limit int) (int, error) {
+	ids, err := r.client.PaymentTransaction.Query().
+		Where(
+			paymenttransaction.Status("succeeded"),
+			paymenttransaction.TransactionTypeIn(receiptTypes...),
+			paymenttransaction.AmountNEQ(decimal.Zero),
+			paymenttransaction.ReceiptJournalIDIsNil(),
+		).
+		Order(ent.Asc(paymenttransaction.FieldProcessedAt)).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list unbooked receipts: %w", err)
+	}
+
+	booked := 0
+	var errs []error
+	for _, id := range ids {
+		err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+			payment, err := tx.PaymentTransaction.Query().
+				Where(paymenttransaction.ID(id)).
+				ForUpdate().
+				Only(ctx)
+			if err != nil {
+				return fmt.Errorf("lock payment transaction: %w", err)
+			}
+			return bookReceipt(ctx, tx, payment)
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("payment transaction %s: %w", id, err))
+			continue
+		}
+		booked++
+	}
+
+	return booked, errors.Join(errs...)
+}
+
+// bookReceipt posts the journal of a succeeded transaction, locked by the
+// caller, unless it is already booked: a payment is Dr its receipt account /
+// Cr unapplied receipts until it is allocated, and a refund or chargeback
+// reverses that.
+func bookReceipt(ctx context.Context, tx *ent.Tx, payment *ent.PaymentTransaction) error {
+	if payment.ReceiptJournalID != nil {
+		return nil
+	}
+	amount := payment.Amount.Abs()
+	if amount.IsZero() {
+		return nil
+	}
+
+	journal := ledger.Journal{
+		TenantID:      payment.TenantID,
+		EntryDate:     payment.ProcessedAt,
+		Currency:      payment.Currency,
+		ReferenceType: "payment_receipt",
+		ReferenceID:   payment.ID,
+		Description:   "Payment received " + payment.ProviderReference,
+		Lines: []ledger.Line{
+			ledger.Debit(receiptAccount(payment.Provider), amount),
+			ledger.Credit(ledger.AccountUnappliedReceipts, amount),
+		},
+	}
+	if payment.TransactionType != "payment" {
+		journal = journal.Reversed(fmt.Sprintf("Payment %s %s", payment.TransactionType, payment.ProviderReference))
+	}
+
+	journalID, err := ledger.PostJournal(ctx, tx, journal)
+	if err != nil {
+		return fmt.Errorf("post receipt journal: %w", err)
+	}
+
+	if err := tx.PaymentTransaction.UpdateOne(payment).
+		SetReceiptJournalID(journalID).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("mark receipt booked: %w", err)
+	}
+	payment.ReceiptJournalID = &journalID
+
+	return nil
+}
+
+// receiptAccount returns the account a provider's collections are received
+// into: settlement clearing for providers paid out in settlement batches,
+// which the payout clears into cash, else cash.
+func receiptAccount(provider string) string {
+	if settlements.Settles(provider) {
+		return ledger.AccountSettlementClearing
+	}
+	return ledger.AccountCash
+}
+
+// paymentCustomer resolves the customer of a payment through its intent.
+func paymentCustomer(ctx context.Context, tx *ent.Tx, tenantID uuid.UUID, intentID uuid.UUID) (*uuid.UUID, error) {
+	intent, err := tx.PaymentIntent.Query().
+		Where(
+			paymentintent.ID(intentID),
+			paymentintent.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get payment intent: %w", err)
+	}
+
+	if intent.CustomerID == uuid.Nil {
+		return nil, nil
+	}
+	return &intent.CustomerID, nil
+}
+
+// loadCandidates locks the invoices a payment may be allocated to and computes
+// how much of each is already paid. Invoices named explicitly must belong to
+// the payment's customer when the payment has one.
+func loadCandidates(ctx context.Context, tx *ent.Tx, tenantID uuid.UUID, currency string, customerID *uuid.UUID, invoiceIDs []uuid.UUID) ([]*OpenInvoice, map[uuid.UUID]*ent.Invoice, error) {
+	query := tx.Invoice.Query().
+		Where(invoice.TenantID(tenantID))
+
+	if len(invoiceIDs) > 0 {
+		query = query.Where(invoice.IDIn(invoiceIDs...))
+	} else {
+		if customerID == nil {
+			return nil, nil, ErrNoCustomer
+		}
+		query = query.Where(
+			invoice.CustomerID(*customerID),
+			invoice.Currency(currency),
+			invoice.InvoiceTypeNotIn(nonPayableInvoiceTypes...),
+			invoice.StatusNotIn("draft", "cancelled", "paid", "written_off"),
+			invoice.PaymentStatusIn(PaymentStatusUnpaid, PaymentStatusPartial),
+		)
+	}
+
+	entInvoices, err := query.
+		Order(ent.Asc(invoice.FieldDueDate), ent.Asc(invoice.FieldInvoiceDate)).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("lock invoices: %w", err)
+	}
+
+	if len(invoiceIDs) > 0 {
+		if len(entInvoices) != len(uniqueIDs(invoiceIDs)) {
+			return nil, nil, ErrInvoiceNotFound
+		}
+		for _, inv := range entInvoices {
+			if slices.Contains(nonPayableInvoiceTypes, inv.InvoiceType) || slices.Contains(nonPayableInvoiceStatuses, inv.Status) {
+				return nil, nil, fmt.Errorf("%w: %s is %s", ErrInvoiceNotOpen, inv.InvoiceNumber, inv.Status)
+			}
+			if inv.Currency != currency {
+				return nil, nil, fmt.Errorf("%w: %s is in %s", ErrCurrencyMismatch, inv.InvoiceNumber, inv.Currency)
+			}
+			if customerID != nil && inv.CustomerID != *customerID {
+				return nil, nil, fmt.Errorf("%w: %s", ErrCustomerMismatch, inv.InvoiceNumber)
+			}
+		}
+	}
+
+	ids := make([]uuid.UUID, len(entInvoices))
+	for i, inv := range entInvoices {
+		ids[i] = inv.ID
+	}
+
+	entAllocations, err := tx.InvoicePayment.Query().
+		Where(invoicepayment.InvoiceIDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list invoice allocations: %w", err)
+	}
+
+	paid := map[uuid.UUID]decimal.Decimal{}
+	for _, entAllocation := range entAllocations {
+		paid[entAllocation.InvoiceID] = paid[entAllocation.InvoiceID].Add(entAllocation.AmountApplied)
+	}
+
+	candidates := make([]*OpenInvoice, len(entInvoices))
+	byID := make(map[uuid.UUID]*ent.Invoice, len(entInvoices))
+	for i, inv := range entInvoices {
+		candidates[i] = mapEntOpenInvoice(inv, paid[inv.ID])
+		byID[inv.ID] = inv
+	}
+
+	return candidates, byID, nil
+}
+
+// refreshInvoiceStatus recomputes payment_status and status from the
+// invoice's allocations.
+func refreshInvoiceStatus(ctx context.Context, tx *ent.Tx, inv *ent.Invoice) error {
+	paid, err := sumAllocations(ctx, tx, invoicepayment.InvoiceID(inv.ID))
+	if err != nil {
+		return err
+	}
+
+	paymentStatus := DerivePaymentStatus(inv.TotalAmount.Sub(inv.WrittenOffAmount), paid)
+	status := deriveInvoiceStatus(inv.Status, paymentStatus, inv.DueDate, time.Now())
+	if paymentStatus == inv.PaymentStatus && status == inv.Status {
+		return nil
+	}
+
+	if err := tx.Invoice.UpdateOneID(inv.ID).
+		SetPaymentStatus(paymentStatus).
+		SetStatus(status).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("update invoice payment status: %w", err)
+	}
+
+	return nil
+}
+
+func sumAllocations(ctx context.Context, tx *ent.Tx, filter predicate.InvoicePayment) (decimal.Decimal, error) {
+	entAllocations, err := tx.InvoicePayment.Query().
+		Where(filter).
+		All(ctx)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("sum allocations: %w", err)
+	}
+
+	total := decimal.Zero
+	for _, entAllocation := range entAllocations {
+		total = total.Add(entAllocation.AmountApplied)
+	}
+	return total, nil
+}
+
+func mapEntOpenInvoice(inv *ent.Invoice, paid decimal.Decimal) *OpenInvoice {
+	open := &OpenInvoice{
+		ID:            inv.ID,
+		InvoiceNumber: inv.InvoiceNumber,
+		InvoiceDate:   inv.InvoiceDate,
+		DueDate:       inv.DueDate,
+		Currency:      inv.Currency,
+		TotalAmount:   inv.TotalAmount,
+		PaidAmount:    paid,
+		WrittenOff:    inv.WrittenOffAmount,
+	}
+	if inv.CustomerID != uuid.Nil {
+		open.CustomerID = &inv.CustomerID
+	}
+	return open
+}
+
+// mapEntAllocation converts an Ent InvoicePayment to domain model.
+func mapEntAllocation(entAllocation *ent.InvoicePayment) *Allocation {
+	allocation := &Allocation{
+		ID:                   entAllocation.ID,
+		TenantID:             entAllocation.TenantID,
+		InvoiceID:            entAllocation.InvoiceID,
+		PaymentTransactionID: entAllocation.PaymentTransactionID,
+		Amount:               entAllocation.AmountApplied,
+		Currency:             entAllocation.Currency,
+		Method:               entAllocation.AllocationMethod,
+		AppliedAt:            entAllocation.AppliedAt,
+		CreatedAt:            entAllocation.CreatedAt,
+	}
+
+	// Optional fields - check for zero values
+	if entAllocation.CustomerID != uuid.Nil {
+		allocation.CustomerID = &entAllocation.CustomerID
+	}
+	if entAllocation.AllocatedBy != uuid.Nil {
+		allocation.AllocatedBy = &entAllocation.AllocatedBy
+	}
+	if entAllocation.JournalEntryID != uuid.Nil {
+		allocation.JournalEntryID = &entAllocation.JournalEntryID
+	}
+
+	return allocation
+}
+
+func uniqueIDs(ids []uuid.UUID) map[uuid.UUID]struct{} {
+	set := make(map[uuid.UUID]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set
+}
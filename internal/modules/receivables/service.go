@@ -0,0 +1,159 @@
+package receivables
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// Service provides business logic for applying payments to invoices.
+type Service struct {
+	repo   Repository
+	logger *zap.Logger
+}
+
+// NewService creates a new receivables service.
+func NewService(repo Repository, logger *zap.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// AutoAllocate applies the unallocated balance of a payment to the customer's
+// open invoices, oldest due date first.
+func (s *Service) AutoAllocate(ctx context.Context, tenantID uuid.UUID, paymentID uuid.UUID, allocatedBy *uuid.UUID) ([]*Allocation, error) {
+	allocations, err := s.repo.AllocatePayment(ctx, tenantID, paymentID, AllocateOptions{
+		Method:      AllocationMethodAuto,
+		AllocatedBy: allocatedBy,
+		Plan: func(available decimal.Decimal, candidates []*OpenInvoice) ([]AllocationRequest, error) {
+			return PlanOldestFirst(available, candidates), nil
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("auto allocate payment: %w", err)
+	}
+
+	s.logAllocations(tenantID, paymentID, AllocationMethodAuto, allocations)
+	return allocations, nil
+}
+
+// Allocate applies explicit amounts of a payment to the given invoices.
+func (s *Service) Allocate(ctx context.Context, tenantID uuid.UUID, paymentID uuid.UUID, requests []AllocationRequest, allocatedBy *uuid.UUID) ([]*Allocation, error) {
+	if len(requests) == 0 {
+		return nil, fmt.Errorf("%w: at least one allocation is required", ErrInvalidAllocation)
+	}
+
+	invoiceIDs := make([]uuid.UUID, 0, len(requests))
+	seen := map[uuid.UUID]bool{}
+	for _, req := range requests {
+		if req.InvoiceID == uuid.Nil {
+			return nil, fmt.Errorf("%w: invoice_id is required", ErrInvalidAllocation)
+		}
+		if !req.Amount.IsPositive() {
+			return nil, fmt.Errorf("%w: amount must be positive", ErrInvalidAllocation)
+		}
+		if seen[req.InvoiceID] {
+			return nil, fmt.Errorf("%w: invoice %s listed more than once", ErrInvalidAllocation, req.InvoiceID)
+		}
+		seen[req.InvoiceID] = true
+		invoiceIDs = append(invoiceIDs, req.InvoiceID)
+	}
+
+	allocations, err := s.repo.AllocatePayment(ctx, tenantID, paymentID, AllocateOptions{
+		Method:      AllocationMethodManual,
+		AllocatedBy: allocatedBy,
+		InvoiceIDs:  invoiceIDs,
+		Plan: func(available decimal.Decimal, candidates []*OpenInvoice) ([]AllocationRequest, error) {
+			outstanding := make(map[uuid.UUID]*OpenInvoice, len(candidates))
+			for _, candidate := range candidates {
+				outstanding[candidate.ID] = candidate
+			}
+
+			total := decimal.Zero
+			for _, req := range requests {
+				if inv, ok := outstanding[req.InvoiceID]; ok && req.Amount.GreaterThan(inv.Outstanding()) {
+					return nil, fmt.Errorf("%w: %s has %s outstanding, requested %s", ErrExceedsOutstanding, inv.InvoiceNumber, inv.Outstanding(), req.Amount)
+				}
+				total = total.Add(req.Amount)
+			}
+			if total.GreaterThan(available) {
+				return nil, fmt.Errorf("%w: requested %s, available %s", ErrOverAllocation, total, available)
+			}
+			return requests, nil
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("allocate payment: %w", err)
+	}
+
+	s.logAllocations(tenantID, paymentID, AllocationMethodManual, allocations)
+	return allocations, nil
+}
+
+// Unallocate removes an allocation and returns its amount to the payment's
+// unallocated balance.
+func (s *Service) Unallocate(ctx context.Context, tenantID uuid.UUID, allocationID uuid.UUID) (*Allocation, error) {
+	allocation, err := s.repo.DeleteAllocation(ctx, tenantID, allocationID)
+	if err != nil {
+		return nil, fmt.Errorf("unallocate payment: %w", err)
+	}
+
+	s.logger.Info("payment allocation removed",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("allocation_id", allocationID.String()),
+		zap.String("invoice_id", allocation.InvoiceID.String()),
+		zap.String("amount", allocation.Amount.String()),
+	)
+
+	return allocation, nil
+}
+
+// ListPaymentAllocations retrieves the allocations of a payment transaction.
+func (s *Service) ListPaymentAllocations(ctx context.Context, tenantID uuid.UUID, paymentID uuid.UUID) ([]*Allocation, error) {
+	return s.repo.ListPaymentAllocations(ctx, tenantID, paymentID)
+}
+
+// ListInvoiceAllocations retrieves the payments applied to an invoice.
+func (s *Service) ListInvoiceAllocations(ctx context.Context, tenantID uuid.UUID, invoiceID uuid.UUID) ([]*Allocation, error) {
+	return s.repo.ListInvoiceAllocations(ctx, tenantID, invoiceID)
+}
+
+// GetCustomerCredit retrieves the customer's unallocated payment balances.
+func (s *Service) GetCustomerCredit(ctx context.Context, tenantID uuid.UUID, customerID uuid.UUID) ([]*CustomerCredit, error) {
+	return s.repo.GetCustomerCredit(ctx, tenantID, customerID)
+}
+
+// RunReceipts books succeeded payments, refunds and chargebacks into unapplied
+// receipts, a batch at a time until none are left.
+func (s *Service) RunReceipts(ctx context.Context) error {
+	total := 0
+	for {
+		booked, err := s.repo.BookReceipts(ctx, receiptBatchSize)
+		total += booked
+		if err != nil || booked < receiptBatchSize {
+			if total > 0 {
+				s.logger.Info("receipts booked", zap.Int("count", total))
+			}
+			return err
+		}
+	}
+}
+
+func (s *Service) logAllocations(tenantID, paymentID uuid.UUID, method string, allocations []*Allocation) {
+	total := decimal.Zero
+	for _, allocation := range allocations {
+		total = total.Add(allocation.Amount)
+	}
+
+	s.logger.Info("payment allocated",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("payment_id", paymentID.String()),
+		zap.String("method", method),
+		zap.Int("invoices", len(allocations)),
+		zap.String("amount", total.String()),
+	)
+}
@@ -0,0 +1,479 @@
+package reconciliation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Item is a bank line, ledger cash line or payment transaction offered to the
+// engine. Amount is signed from the bank account's side: positive for money
+// in, negative for money out.
+type Item struct {
+	ID          uuid.UUID
+	Type        string
+	Date        time.Time
+	Amount      decimal.Decimal
+	Reference   string
+	Description string
+	// Counterparty is the payer or payee named on a bank line.
+	Counterparty string
+	// PaymentID is the payment transaction a bank line was linked to on import.
+	PaymentID *uuid.UUID
+}
+
+// CompiledRule is a tenant's custom rule prepared for the engine.
+type CompiledRule struct {
+	ID                 uuid.UUID
+	Name               string
+	MatchField         string
+	Pattern            *regexp.Regexp
+	Counterpart        string
+	CounterpartPattern *regexp.Regexp
+	AmountTolerance    decimal.Decimal
+	DateWindowDays     int
+	Confidence         int
+	AutoMatch          bool
+}
+
+// Options tune the built-in rules.
+type Options struct {
+	// DateWindowDays is how far apart a bank line and book entry may be dated.
+	DateWindowDays int
+	// MaxGroupSize bounds the entries combined by the grouping rules.
+	MaxGroupSize int
+}
+
+// Proposal is a grouping of bank lines and book entries found by a rule. The
+// engine's book entries are all ledger lines or all payment transactions;
+// manual matches may mix them.
+type Proposal struct {
+	Rule       string
+	RuleID     *uuid.UUID
+	Confidence int
+	// Auto is set when the match is confident enough to lock without review.
+	Auto    bool
+	Bank    []Item
+	Book    []Item
+	Reasons []string
+}
+
+// BankAmount sums the proposal's bank lines.
+func (p Proposal) BankAmount() decimal.Decimal {
+	return sum(p.Bank)
+}
+
+// BookAmount sums the proposal's book entries.
+func (p Proposal) BookAmount() decimal.Decimal {
+	return sum(p.Book)
+}
+
+// Fingerprint identifies the grouping by its items, whatever rule found it.
+func (p Proposal) Fingerprint() string {
+	ids := make([]string, 0, len(p.Bank)+len(p.Book))
+	for _, item := range append(append([]Item{}, p.Bank...), p.Book...) {
+		ids = append(ids, item.Type+":"+item.ID.String())
+	}
+	sort.Strings(ids)
+	digest := sha256.Sum256([]byte(strings.Join(ids, "|")))
+	return hex.EncodeToString(digest[:])
+}
+
+// maxGroupCandidates bounds the entries searched for a grouping so a busy
+// account does not make the search explode.
+const maxGroupCandidates = 12
+
+// Propose runs the custom rules in order, then the built-in rules, over items
+// not yet matched. Each item is used at most once, by the first rule that
+// matches it, and groupings in rejected (by fingerprint) are skipped.
+func Propose(bank, ledger, payments []Item, rules []CompiledRule, opts Options, rejected map[string]bool) []Proposal {
+	m := &matcher{
+		opts:     opts,
+		rejected: rejected,
+		used:     make(map[uuid.UUID]bool),
+	}
+	sortByDate(bank)
+	sortByDate(ledger)
+	sortByDate(payments)
+	book := map[string][]Item{TypeLedger: ledger, TypePayment: payments}
+
+	for _, rule := range rules {
+		m.custom(bank, book[rule.Counterpart], rule)
+	}
+	m.exactReference(bank, ledger, payments)
+	m.amountDate(bank, append(append([]Item{}, ledger...), payments...))
+	for _, side := range []string{TypeLedger, TypePayment} {
+		m.oneToMany(bank, book[side])
+		m.manyToOne(bank, book[side])
+	}
+	return m.proposals
+}
+
+type matcher struct {
+	opts      Options
+	rejected  map[string]bool
+	used      map[uuid.UUID]bool
+	proposals []Proposal
+}
+
+// propose records a proposal unless it was rejected before, marking its
+// items used.
+func (m *matcher) propose(p Proposal) bool {
+	if m.rejected[p.Fingerprint()] {
+		return false
+	}
+	for _, item := range append(append([]Item{}, p.Bank...), p.Book...) {
+		m.used[item.ID] = true
+	}
+	if p.Confidence > 100 {
+		p.Confidence = 100
+	}
+	if p.RuleID == nil {
+		p.Auto = p.Confidence >= AutoMatchConfidence
+	}
+	m.proposals = append(m.proposals, p)
+	return true
+}
+
+// isRejected reports whether pairing the bank line with the book entry was
+// rejected before, so the single-entry rules can move on to the next
+// candidate.
+func (m *matcher) isRejected(bank, book Item) bool {
+	if len(m.rejected) == 0 {
+		return false
+	}
+	return m.rejected[Proposal{Bank: []Item{bank}, Book: []Item{book}}.Fingerprint()]
+}
+
+// custom matches bank lines the rule's pattern selects to the closest single
+// counterpart within the rule's amount tolerance and date window.
+func (m *matcher) custom(bank, book []Item, rule CompiledRule) {
+	for _, b := range bank {
+		if m.used[b.ID] || !rule.Pattern.MatchString(fieldValue(b, rule.MatchField)) {
+			continue
+		}
+		var (
+			best     *Item
+			bestDiff decimal.Decimal
+			bestDays int
+		)
+		for i := range book {
+			c := &book[i]
+			if m.used[c.ID] || c.Amount.Sign() != b.Amount.Sign() || m.isRejected(b, *c) {
+				continue
+			}
+			days := daysApart(b.Date, c.Date)
+			diff := b.Amount.Sub(c.Amount).Abs()
+			if days > rule.DateWindowDays || diff.GreaterThan(rule.AmountTolerance) {
+				continue
+			}
+			if rule.CounterpartPattern != nil && !rule.CounterpartPattern.MatchString(c.Reference) &&
+				!rule.CounterpartPattern.MatchString(c.Description) {
+				continue
+			}
+			if best == nil || diff.LessThan(bestDiff) || (diff.Equal(bestDiff) && days < bestDays) {
+				best, bestDiff, bestDays = c, diff, days
+			}
+		}
+		if best == nil {
+			continue
+		}
+		ruleID := rule.ID
+		m.propose(Proposal{
+			Rule:       RuleCustom,
+			RuleID:     &ruleID,
+			Confidence: rule.Confidence,
+			Auto:       rule.AutoMatch,
+			Bank:       []Item{b},
+			Book:       []Item{*best},
+			Reasons:    []string{fmt.Sprintf("custom rule %q matched %s", rule.Name, rule.MatchField)},
+		})
+	}
+}
+
+// exactReference matches a bank line to one book entry of the same amount
+// carrying its reference: a payment linked on import or with the reference
+// as provider reference, or a ledger line with it as payment reference or in
+// its description.
+func (m *matcher) exactReference(bank, ledger, payments []Item) {
+	for _, b := range bank {
+		if m.used[b.ID] {
+			continue
+		}
+		ref := strings.TrimSpace(b.Reference)
+		if ref == "" && b.PaymentID == nil {
+			continue
+		}
+
+		var (
+			match      *Item
+			confidence int
+			reason     string
+		)
+		for i := range payments {
+			p := &payments[i]
+			if m.used[p.ID] || !p.Amount.Equal(b.Amount) || m.isRejected(b, *p) {
+				continue
+			}
+			if (b.PaymentID != nil && *b.PaymentID == p.ID) || (ref != "" && strings.EqualFold(p.Reference, ref)) {
+				match, confidence, reason = p, 100, "reference matches payment provider reference"
+				break
+			}
+		}
+		if match == nil && ref != "" {
+			for i := range ledger {
+				l := &ledger[i]
+				if m.used[l.ID] || !l.Amount.Equal(b.Amount) || m.isRejected(b, *l) {
+					continue
+				}
+				if strings.EqualFold(l.Reference, ref) {
+					match, confidence, reason = l, 100, "reference matches ledger payment reference"
+					break
+				}
+				if match == nil && len(ref) >= minDescriptionReference && containsWord(l.Description, ref) {
+					match, confidence, reason = l, 95, "reference found in ledger description"
+				}
+			}
+		}
+		if match == nil {
+			continue
+		}
+		if days := daysApart(b.Date, match.Date); days > m.opts.DateWindowDays {
+			confidence -= 10
+			reason += fmt.Sprintf(", dated %d days apart", days)
+		}
+		m.propose(Proposal{
+			Rule:       RuleExactReference,
+			Confidence: confidence,
+			Bank:       []Item{b},
+			Book:       []Item{*match},
+			Reasons:    []string{reason},
+		})
+	}
+}
+
+// amountDate matches a bank line to the closest-dated book entry of the same
+// amount inside the date window. Confidence drops with each day apart and
+// when other entries of the same amount could also be the match.
+func (m *matcher) amountDate(bank, book []Item) {
+	for _, b := range bank {
+		if m.used[b.ID] {
+			continue
+		}
+		var (
+			best       *Item
+			bestDays   int
+			candidates int
+		)
+		for i := range book {
+			c := &book[i]
+			if m.used[c.ID] || !c.Amount.Equal(b.Amount) || m.isRejected(b, *c) {
+				continue
+			}
+			days := daysApart(b.Date, c.Date)
+			if days > m.opts.DateWindowDays {
+				continue
+			}
+			candidates++
+			if best == nil || days < bestDays {
+				best, bestDays = c, days
+			}
+		}
+		if best == nil {
+			continue
+		}
+
+		confidence := 90 - 5*bestDays
+		reasons := []string{fmt.Sprintf("same amount, dated %d days apart", bestDays)}
+		if candidates > 1 {
+			confidence -= 20
+			reasons = append(reasons, fmt.Sprintf("%d entries of the same amount in the window", candidates))
+		}
+		if b.Counterparty != "" && containsWord(best.Description, b.Counterparty) {
+			confidence += 5
+			reasons = append(reasons, "counterparty named in description")
+		}
+		m.propose(Proposal{
+			Rule:       RuleAmountDate,
+			Confidence: confidence,
+			Bank:       []Item{b},
+			Book:       []Item{*best},
+			Reasons:    reasons,
+		})
+	}
+}
+
+// oneToMany matches one bank line to several book entries that add up to it,
+// such as a deposit of several receipts or a bulk transfer booked per bill.
+func (m *matcher) oneToMany(bank, book []Item) {
+	for _, b := range bank {
+		if m.used[b.ID] {
+			continue
+		}
+		group := m.findGroup(b, book)
+		if group == nil {
+			continue
+		}
+		m.propose(Proposal{
+			Rule:       RuleOneToMany,
+			Confidence: groupConfidence(b, group),
+			Bank:       []Item{b},
+			Book:       group,
+			Reasons:    []string{fmt.Sprintf("%d book entries add up to the bank line", len(group))},
+		})
+	}
+}
+
+// manyToOne matches several bank lines to one book entry they add up to,
+// such as a payment the bank split or a transfer and its charge booked once.
+func (m *matcher) manyToOne(bank, book []Item) {
+	for _, c := range book {
+		if m.used[c.ID] {
+			continue
+		}
+		group := m.findGroup(c, bank)
+		if group == nil {
+			continue
+		}
+		m.propose(Proposal{
+			Rule:       RuleManyToOne,
+			Confidence: groupConfidence(c, group),
+			Bank:       group,
+			Book:       []Item{c},
+			Reasons:    []string{fmt.Sprintf("%d bank lines add up to the book entry", len(group))},
+		})
+	}
+}
+
+// findGroup searches the unused items dated within the window of target, on
+// the same side of zero and smaller than it, for two or more adding up to
+// its amount. The closest-dated items are tried first.
+func (m *matcher) findGroup(target Item, items []Item) []Item {
+	maxSize := m.opts.MaxGroupSize
+	if maxSize < 2 {
+		return nil
+	}
+
+	var candidates []Item
+	for _, item := range items {
+		if m.used[item.ID] || item.Amount.Sign() != target.Amount.Sign() ||
+			item.Amount.Abs().GreaterThanOrEqual(target.Amount.Abs()) ||
+			daysApart(item.Date, target.Date) > m.opts.DateWindowDays {
+			continue
+		}
+		candidates = append(candidates, item)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return daysApart(candidates[i].Date, target.Date) < daysApart(candidates[j].Date, target.Date)
+	})
+	if len(candidates) > maxGroupCandidates {
+		candidates = candidates[:maxGroupCandidates]
+	}
+
+	var search func(start int, remaining decimal.Decimal, group []Item) []Item
+	search = func(start int, remaining decimal.Decimal, group []Item) []Item {
+		if remaining.IsZero() && len(group) >= 2 {
+			return group
+		}
+		if len(group) == maxSize {
+			return nil
+		}
+		for i := start; i < len(candidates); i++ {
+			next := remaining.Sub(candidates[i].Amount)
+			if next.Sign() == -target.Amount.Sign() {
+				continue
+			}
+			if found := search(i+1, next, append(group, candidates[i])); found != nil {
+				return found
+			}
+		}
+		return nil
+	}
+	found := search(0, target.Amount, nil)
+	if found == nil {
+		return nil
+	}
+	return append([]Item(nil), found...)
+}
+
+// groupConfidence scores a grouping lower than a single-entry match, and lower
+// again for every day its furthest entry is from the target.
+func groupConfidence(target Item, group []Item) int {
+	furthest := 0
+	for _, item := range group {
+		furthest = max(furthest, daysApart(item.Date, target.Date))
+	}
+	return 75 - 5*furthest - 2*(len(group)-2)
+}
+
+// minDescriptionReference is the shortest reference looked for inside
+// ledger descriptions, so short references do not match by chance.
+const minDescriptionReference = 6
+
+func fieldValue(item Item, name string) string {
+	switch name {
+	case FieldReference:
+		return item.Reference
+	case FieldCounterparty:
+		return item.Counterparty
+	default:
+		return item.Description
+	}
+}
+
+// containsWord reports whether text contains word, ignoring case, as a whole
+// word rather than part of a longer one.
+func containsWord(text, word string) bool {
+	text, word = strings.ToUpper(text), strings.ToUpper(strings.TrimSpace(word))
+	if word == "" {
+		return false
+	}
+	for offset := 0; ; {
+		i := strings.Index(text[offset:], word)
+		if i < 0 {
+			return false
+		}
+		start, end := offset+i, offset+i+len(word)
+		if (start == 0 || !isWordChar(text[start-1])) && (end == len(text) || !isWordChar(text[end])) {
+			return true
+		}
+		offset = start + 1
+	}
+}
+
+func isWordChar(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+func daysApart(a, b time.Time) int {
+	days := int(dateOf(a).Sub(dateOf(b)).Hours() / 24)
+	if days < 0 {
+		return -days
+	}
+	return days
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func sortByDate(items []Item) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Date.Before(items[j].Date)
+	})
+}
+
+func sum(items []Item) decimal.Decimal {
+	total := decimal.Zero
+	for _, item := range items {
+		total = total.Add(item.Amount)
+	}
+	return total
+}
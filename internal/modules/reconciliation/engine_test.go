@@ -0,0 +1,149 @@
+package reconciliation
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var opts = Options{DateWindowDays: DefaultDateWindowDays, MaxGroupSize: DefaultMaxGroupSize}
+
+func item(itemType string, day int, amount int64) Item {
+	return Item{
+		ID:     uuid.New(),
+		Type:   itemType,
+		Date:   time.Date(2024, 10, day, 0, 0, 0, 0, time.UTC),
+		Amount: decimal.NewFromInt(amount),
+	}
+}
+
+func TestProposeExactReference(t *testing.T) {
+	linked := item(TypeBank, 1, 1500)
+	payment := item(TypePayment, 2, 1500)
+	linked.PaymentID = &payment.ID
+
+	deposit := item(TypeBank, 3, 2400)
+	deposit.Reference = "INV0042"
+	receipt := item(TypeLedger, 10, 2400)
+	receipt.Description = "Receipt for inv0042 from Acme"
+
+	proposals := Propose([]Item{linked, deposit}, []Item{receipt}, []Item{payment}, nil, opts, nil)
+	if len(proposals) != 2 {
+		t.Fatalf("got %d proposals, want 2", len(proposals))
+	}
+
+	first := proposals[0]
+	if first.Rule != RuleExactReference || first.Confidence != 100 || !first.Auto || first.Book[0].ID != payment.ID {
+		t.Errorf("linked payment proposal = %+v", first)
+	}
+
+	// Found in the description, but dated outside the window: queued for review.
+	second := proposals[1]
+	if second.Rule != RuleExactReference || second.Confidence != 85 || second.Auto || second.Book[0].ID != receipt.ID {
+		t.Errorf("description reference proposal = %+v", second)
+	}
+}
+
+func TestProposeAmountDate(t *testing.T) {
+	bank := item(TypeBank, 5, -800)
+	bank.Counterparty = "Kenya Power"
+	near := item(TypeLedger, 6, -800)
+	near.Description = "Electricity - Kenya Power"
+	far := item(TypeLedger, 8, -800)
+
+	proposals := Propose([]Item{bank}, []Item{far, near}, nil, nil, opts, nil)
+	if len(proposals) != 1 {
+		t.Fatalf("got %d proposals, want 1", len(proposals))
+	}
+	// 90, less 5 for a day apart and 20 for a second candidate, plus 5 for
+	// the counterparty.
+	p := proposals[0]
+	if p.Rule != RuleAmountDate || p.Book[0].ID != near.ID || p.Confidence != 70 || p.Auto {
+		t.Errorf("proposal = %+v", p)
+	}
+}
+
+func TestProposeGroups(t *testing.T) {
+	deposit := item(TypeBank, 10, 1000)
+	receipts := []Item{item(TypeLedger, 9, 300), item(TypeLedger, 10, 450), item(TypeLedger, 10, 250)}
+
+	split := []Item{item(TypeBank, 20, -600), item(TypeBank, 21, -400)}
+	transfer := item(TypePayment, 20, -1000)
+
+	proposals := Propose(append([]Item{deposit}, split...), receipts, []Item{transfer}, nil, opts, nil)
+	if len(proposals) != 2 {
+		t.Fatalf("got %d proposals, want 2", len(proposals))
+	}
+
+	oneToMany := proposals[0]
+	if oneToMany.Rule != RuleOneToMany || len(oneToMany.Book) != 3 || !oneToMany.BookAmount().Equal(deposit.Amount) {
+		t.Errorf("one_to_many proposal = %+v", oneToMany)
+	}
+	if oneToMany.Confidence != 68 || oneToMany.Auto {
+		t.Errorf("one_to_many confidence = %d, auto %v", oneToMany.Confidence, oneToMany.Auto)
+	}
+
+	manyToOne := proposals[1]
+	if manyToOne.Rule != RuleManyToOne || len(manyToOne.Bank) != 2 || !manyToOne.BankAmount().Equal(transfer.Amount) {
+		t.Errorf("many_to_one proposal = %+v", manyToOne)
+	}
+}
+
+func TestProposeCustomRule(t *testing.T) {
+	charge := item(TypeBank, 31, -152)
+	charge.Description = "MONTHLY LEDGER FEE"
+	fee := item(TypeLedger, 31, -150)
+	fee.Description = "Bank charges October"
+
+	rule := CompiledRule{
+		ID:                 uuid.New(),
+		Name:               "Bank charges",
+		MatchField:         FieldDescription,
+		Pattern:            regexp.MustCompile("(?i)ledger fee"),
+		Counterpart:        TypeLedger,
+		CounterpartPattern: regexp.MustCompile("(?i)charges"),
+		AmountTolerance:    decimal.NewFromInt(5),
+		DateWindowDays:     0,
+		Confidence:         90,
+		AutoMatch:          true,
+	}
+
+	proposals := Propose([]Item{charge}, []Item{fee}, nil, []CompiledRule{rule}, opts, nil)
+	if len(proposals) != 1 {
+		t.Fatalf("got %d proposals, want 1", len(proposals))
+	}
+	p := proposals[0]
+	if p.Rule != RuleCustom || *p.RuleID != rule.ID || !p.Auto || p.Confidence != 90 {
+		t.Errorf("proposal = %+v", p)
+	}
+	if !p.BankAmount().Sub(p.BookAmount()).Equal(decimal.NewFromInt(-2)) {
+		t.Errorf("difference = %s, want -2", p.BankAmount().Sub(p.BookAmount()))
+	}
+
+	rule.AmountTolerance = decimal.NewFromInt(1)
+	if proposals := Propose([]Item{charge}, []Item{fee}, nil, []CompiledRule{rule}, opts, nil); len(proposals) != 0 {
+		t.Errorf("rule matched outside its tolerance: %+v", proposals)
+	}
+}
+
+func TestProposeSkipsRejected(t *testing.T) {
+	bank := item(TypeBank, 5, 700)
+	near := item(TypePayment, 5, 700)
+	far := item(TypePayment, 7, 700)
+
+	first := Propose([]Item{bank}, nil, []Item{near, far}, nil, opts, nil)
+	if len(first) != 1 || first[0].Book[0].ID != near.ID {
+		t.Fatalf("first run = %+v", first)
+	}
+
+	// Once rejected, the same grouping is not proposed again; the next
+	// candidate is.
+	rejected := map[string]bool{first[0].Fingerprint(): true}
+	second := Propose([]Item{bank}, nil, []Item{near, far}, nil, opts, rejected)
+	if len(second) != 1 || second[0].Book[0].ID != far.ID {
+		t.Errorf("second run = %+v", second)
+	}
+}
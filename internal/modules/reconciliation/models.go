@@ -0,0 +1,276 @@
+package reconciliation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Reconciliation statuses. A finalised reconciliation and its matches no
+// longer change.
+const (
+	StatusOpen      = "open"
+	StatusFinalised = "finalised"
+)
+
+// Match statuses. Items of a rejected or unmatched match are released for
+// matching again.
+const (
+	MatchSuggested = "suggested"
+	MatchMatched   = "matched"
+	MatchRejected  = "rejected"
+	MatchUnmatched = "unmatched"
+)
+
+// Match item statuses.
+const (
+	ItemSuggested = "suggested"
+	ItemMatched   = "matched"
+	ItemReleased  = "released"
+)
+
+// Match types.
+const (
+	MatchAutomatic = "automatic"
+	MatchManual    = "manual"
+)
+
+// Item types: a bank line and the book entries it is matched to.
+const (
+	TypeBank    = "bank"
+	TypeLedger  = "ledger"
+	TypePayment = "payment"
+)
+
+// Rules that propose matches. RuleOneToMany matches one bank line to several
+// book entries; RuleManyToOne several bank lines to one book entry.
+// RuleManual and RuleAdjustment record matches made by hand, the latter to an
+// adjusting journal posted from the bank line.
+const (
+	RuleCustom         = "custom"
+	RuleExactReference = "exact_reference"
+	RuleAmountDate     = "amount_date"
+	RuleOneToMany      = "one_to_many"
+	RuleManyToOne      = "many_to_one"
+	RuleManual         = "manual"
+	RuleAdjustment     = "adjustment"
+)
+
+// EventReconciliationFinalised is published when a reconciliation period is
+// finalised.
+const EventReconciliationFinalised = "treasury.bank_reconciliation.finalised"
+
+// referenceTypeAdjustment marks adjusting journals posted from a bank line.
+const referenceTypeAdjustment = "bank_adjustment"
+
+// Bank line fields a custom rule's pattern can be matched against.
+const (
+	FieldReference    = "reference"
+	FieldDescription  = "description"
+	FieldCounterparty = "counterparty"
+)
+
+// AutoMatchConfidence is the confidence from which a built-in rule's match is
+// locked into the reconciliation without review; less confident matches wait
+// in the suggestions queue.
+const AutoMatchConfidence = 95
+
+// Defaults for a matching run.
+const (
+	DefaultDateWindowDays = 3
+	DefaultMaxGroupSize   = 4
+	MaxDateWindowDays     = 31
+	MaxGroupSize          = 6
+)
+
+// Reconciliation is the record a bank account's matched entries are locked
+// into. Period and balances are set when it is finalised.
+type Reconciliation struct {
+	ID               uuid.UUID        `json:"id"`
+	TenantID         uuid.UUID        `json:"tenant_id"`
+	BankAccountID    uuid.UUID        `json:"bank_account_id"`
+	Status           string           `json:"status"` // open, finalised
+	MatchCount       int              `json:"match_count"`
+	PeriodStart      *time.Time       `json:"period_start,omitempty"`
+	PeriodEnd        *time.Time       `json:"period_end,omitempty"`
+	StatementBalance *decimal.Decimal `json:"statement_balance,omitempty"`
+	BookBalance      *decimal.Decimal `json:"book_balance,omitempty"`
+	Difference       *decimal.Decimal `json:"difference,omitempty"`
+	CreatedBy        *uuid.UUID       `json:"created_by,omitempty"`
+	FinalisedBy      *uuid.UUID       `json:"finalised_by,omitempty"`
+	FinalisedAt      *time.Time       `json:"finalised_at,omitempty"`
+	CreatedAt        time.Time        `json:"created_at"`
+	UpdatedAt        time.Time        `json:"updated_at"`
+}
+
+// Match groups bank lines with the ledger cash lines or payment transactions
+// they were matched to.
+type Match struct {
+	ID               uuid.UUID       `json:"id"`
+	TenantID         uuid.UUID       `json:"tenant_id"`
+	BankAccountID    uuid.UUID       `json:"bank_account_id"`
+	ReconciliationID *uuid.UUID      `json:"reconciliation_id,omitempty"`
+	Rule             string          `json:"rule"`
+	RuleID           *uuid.UUID      `json:"rule_id,omitempty"`
+	MatchType        string          `json:"match_type"` // automatic, manual
+	Confidence       int             `json:"confidence"`
+	Status           string          `json:"status"` // suggested, matched, rejected, unmatched
+	BankAmount       decimal.Decimal `json:"bank_amount"`
+	BookAmount       decimal.Decimal `json:"book_amount"`
+	Difference       decimal.Decimal `json:"difference"`
+	Reasons          []string        `json:"reasons,omitempty"`
+	MatchedBy        *uuid.UUID      `json:"matched_by,omitempty"`
+	MatchedAt        *time.Time      `json:"matched_at,omitempty"`
+	RejectedBy       *uuid.UUID      `json:"rejected_by,omitempty"`
+	RejectedAt       *time.Time      `json:"rejected_at,omitempty"`
+	UnmatchedBy      *uuid.UUID      `json:"unmatched_by,omitempty"`
+	UnmatchedAt      *time.Time      `json:"unmatched_at,omitempty"`
+	Items            []*MatchItem    `json:"items,omitempty"`
+	CreatedAt        time.Time       `json:"created_at"`
+}
+
+// MatchItem is a bank line, ledger cash line or payment transaction in a match.
+type MatchItem struct {
+	ItemType        string          `json:"item_type"` // bank, ledger, payment
+	ItemID          uuid.UUID       `json:"item_id"`
+	Amount          decimal.Decimal `json:"amount"`
+	TransactionDate time.Time       `json:"transaction_date"`
+	Reference       string          `json:"reference,omitempty"`
+	Description     string          `json:"description,omitempty"`
+}
+
+// MatchFilter narrows a match listing.
+type MatchFilter struct {
+	BankAccountID    *uuid.UUID
+	ReconciliationID *uuid.UUID
+	Status           string
+	Limit            int
+	Offset           int
+}
+
+// RunRequest tunes a matching run. Zero values take the defaults.
+type RunRequest struct {
+	DateWindowDays int `json:"date_window_days"`
+	MaxGroupSize   int `json:"max_group_size"`
+}
+
+// RunResult summarises a matching run.
+type RunResult struct {
+	ReconciliationID uuid.UUID `json:"reconciliation_id"`
+	BankLines        int       `json:"bank_lines"`
+	Matched          int       `json:"matched"`
+	Suggested        int       `json:"suggested"`
+	Unmatched        int       `json:"unmatched"`
+}
+
+// Rule is a tenant's custom matching rule. Bank lines whose MatchField
+// matches Pattern are matched to the closest ledger line or payment
+// transaction within AmountTolerance and DateWindowDays whose reference or
+// description matches CounterpartPattern, when set.
+type Rule struct {
+	ID                 uuid.UUID       `json:"id"`
+	TenantID           uuid.UUID       `json:"tenant_id"`
+	BankAccountID      *uuid.UUID      `json:"bank_account_id,omitempty"`
+	Name               string          `json:"name"`
+	Priority           int             `json:"priority"`
+	MatchField         string          `json:"match_field"` // reference, description, counterparty
+	Pattern            string          `json:"pattern"`
+	Counterpart        string          `json:"counterpart"` // ledger, payment
+	CounterpartPattern string          `json:"counterpart_pattern,omitempty"`
+	AmountTolerance    decimal.Decimal `json:"amount_tolerance"`
+	DateWindowDays     int             `json:"date_window_days"`
+	Confidence         int             `json:"confidence"`
+	AutoMatch          bool            `json:"auto_match"`
+	Active             bool            `json:"active"`
+	CreatedBy          *uuid.UUID      `json:"created_by,omitempty"`
+	CreatedAt          time.Time       `json:"created_at"`
+	UpdatedAt          time.Time       `json:"updated_at"`
+}
+
+// RuleRequest creates or replaces a custom rule. MatchField defaults to
+// description, Counterpart to ledger, DateWindowDays to 3, Confidence to 90
+// and Priority to 100.
+type RuleRequest struct {
+	BankAccountID      *uuid.UUID       `json:"bank_account_id"`
+	Name               string           `json:"name"`
+	Priority           *int             `json:"priority"`
+	MatchField         string           `json:"match_field"`
+	Pattern            string           `json:"pattern"`
+	Counterpart        string           `json:"counterpart"`
+	CounterpartPattern string           `json:"counterpart_pattern"`
+	AmountTolerance    *decimal.Decimal `json:"amount_tolerance"`
+	DateWindowDays     *int             `json:"date_window_days"`
+	Confidence         *int             `json:"confidence"`
+	AutoMatch          bool             `json:"auto_match"`
+	Active             *bool            `json:"active"`
+}
+
+// Unmatched lists the entries of a bank account not in a suggested or matched
+// match: bank lines without a book entry and book entries not yet seen on a
+// statement.
+type Unmatched struct {
+	BankAccountID uuid.UUID    `json:"bank_account_id"`
+	From          time.Time    `json:"from"`
+	To            time.Time    `json:"to"`
+	Bank          []*MatchItem `json:"bank"`
+	Ledger        []*MatchItem `json:"ledger"`
+	Payments      []*MatchItem `json:"payments"`
+}
+
+// ManualMatchRequest matches bank lines to book entries by hand. The bank
+// lines must add up to the book entries.
+type ManualMatchRequest struct {
+	BankAccountID         uuid.UUID   `json:"bank_account_id"`
+	BankTransactionIDs    []uuid.UUID `json:"bank_transaction_ids"`
+	LedgerTransactionIDs  []uuid.UUID `json:"ledger_transaction_ids"`
+	PaymentTransactionIDs []uuid.UUID `json:"payment_transaction_ids"`
+	Note                  string      `json:"note"`
+}
+
+// AdjustmentRequest books an unmatched bank line, such as a bank charge or
+// interest, with an adjusting journal. AccountCode defaults to bank charges
+// for money out and interest income for money in.
+type AdjustmentRequest struct {
+	BankTransactionID uuid.UUID `json:"bank_transaction_id"`
+	AccountCode       string    `json:"account_code"`
+	Description       string    `json:"description"`
+}
+
+// Adjustment is a posted adjusting journal and the match to its bank line.
+type Adjustment struct {
+	JournalEntryID uuid.UUID `json:"journal_entry_id"`
+	AccountCode    string    `json:"account_code"`
+	Match          *Match    `json:"match"`
+}
+
+// FinaliseRequest closes a reconciliation period. StatementBalance defaults
+// to the closing balance of the imported statement ending on PeriodEnd.
+type FinaliseRequest struct {
+	PeriodEnd        string           `json:"period_end"` // YYYY-MM-DD
+	StatementBalance *decimal.Decimal `json:"statement_balance"`
+}
+
+// Report reconciles the bank statement balance with the ledger cash balance
+// at the end of a period. Outstanding deposits and payments are booked but
+// not yet on the statement; unrecorded items are statement lines not matched
+// to the books. The adjusted balances agree when Difference is zero.
+type Report struct {
+	ReconciliationID         uuid.UUID       `json:"reconciliation_id"`
+	BankAccountID            uuid.UUID       `json:"bank_account_id"`
+	Status                   string          `json:"status"` // open (preview), finalised
+	PeriodStart              *time.Time      `json:"period_start,omitempty"`
+	PeriodEnd                time.Time       `json:"period_end"`
+	StatementBalance         decimal.Decimal `json:"statement_balance"`
+	OutstandingDeposits      decimal.Decimal `json:"outstanding_deposits"`
+	OutstandingPayments      decimal.Decimal `json:"outstanding_payments"`
+	AdjustedStatementBalance decimal.Decimal `json:"adjusted_statement_balance"`
+	BookBalance              decimal.Decimal `json:"book_balance"`
+	UnrecordedAmount         decimal.Decimal `json:"unrecorded_amount"`
+	AdjustedBookBalance      decimal.Decimal `json:"adjusted_book_balance"`
+	Difference               decimal.Decimal `json:"difference"`
+	Outstanding              []*MatchItem    `json:"outstanding"`
+	Unrecorded               []*MatchItem    `json:"unrecorded"`
+	FinalisedBy              *uuid.UUID      `json:"finalised_by,omitempty"`
+	FinalisedAt              *time.Time      `json:"finalised_at,omitempty"`
+}
@@ -0,0 +1,89 @@
+package reconciliation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// total sums the report's outstanding and unrecorded items and works out the
+// adjusted balances: the statement balance plus outstanding deposits less
+// outstanding payments, and the book balance plus the unrecorded statement
+// lines.
+func (r *Report) total() {
+	r.OutstandingDeposits, r.OutstandingPayments, r.UnrecordedAmount = decimal.Zero, decimal.Zero, decimal.Zero
+	for _, item := range r.Outstanding {
+		if item.Amount.IsPositive() {
+			r.OutstandingDeposits = r.OutstandingDeposits.Add(item.Amount)
+		} else {
+			r.OutstandingPayments = r.OutstandingPayments.Sub(item.Amount)
+		}
+	}
+	for _, item := range r.Unrecorded {
+		r.UnrecordedAmount = r.UnrecordedAmount.Add(item.Amount)
+	}
+
+	r.AdjustedStatementBalance = r.StatementBalance.Add(r.OutstandingDeposits).Sub(r.OutstandingPayments)
+	r.AdjustedBookBalance = r.BookBalance.Add(r.UnrecordedAmount)
+	r.Difference = r.AdjustedStatementBalance.Sub(r.AdjustedBookBalance)
+}
+
+func matchItems(items []Item) []*MatchItem {
+	sortByDate(items)
+	matchItems := make([]*MatchItem, len(items))
+	for i, item := range items {
+		matchItems[i] = &MatchItem{
+			ItemType:        item.Type,
+			ItemID:          item.ID,
+			Amount:          item.Amount,
+			TransactionDate: item.Date,
+			Reference:       item.Reference,
+			Description:     item.Description,
+		}
+	}
+	return matchItems
+}
+
+func itemsJSON(items []*MatchItem) []map[string]any {
+	rows := make([]map[string]any, len(items))
+	for i, item := range items {
+		rows[i] = map[string]any{
+			"item_type":        item.ItemType,
+			"item_id":          item.ItemID.String(),
+			"amount":           item.Amount.String(),
+			"transaction_date": item.TransactionDate.Format(time.RFC3339),
+			"reference":        item.Reference,
+			"description":      item.Description,
+		}
+	}
+	return rows
+}
+
+func itemsFromJSON(rows []map[string]any) []*MatchItem {
+	items := make([]*MatchItem, len(rows))
+	for i, row := range rows {
+		itemType, _ := row["item_type"].(string)
+		rawID, _ := row["item_id"].(string)
+		rawDate, _ := row["transaction_date"].(string)
+		reference, _ := row["reference"].(string)
+		description, _ := row["description"].(string)
+
+		id, _ := uuid.Parse(rawID)
+		date, _ := time.Parse(time.RFC3339, rawDate)
+		amount := decimal.Zero
+		if raw, ok := row["amount"].(string); ok {
+			amount, _ = decimal.NewFromString(raw)
+		}
+
+		items[i] = &MatchItem{
+			ItemType:        itemType,
+			ItemID:          id,
+			Amount:          amount,
+			TransactionDate: date,
+			Reference:       reference,
+			Description:     description,
+		}
+	}
+	return items
+}
@@ -0,0 +1,69 @@
+package reconciliation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestReportTotal(t *testing.T) {
+	amount := func(v int64) decimal.Decimal { return decimal.NewFromInt(v) }
+	report := &Report{
+		StatementBalance: amount(10000),
+		BookBalance:      amount(10650),
+		Outstanding: []*MatchItem{
+			{ItemType: TypeLedger, Amount: amount(2000)},  // deposit in transit
+			{ItemType: TypeLedger, Amount: amount(-1200)}, // payment not yet presented
+		},
+		Unrecorded: []*MatchItem{
+			{ItemType: TypeBank, Amount: amount(-150)}, // bank charge
+			{ItemType: TypeBank, Amount: amount(300)},  // interest
+		},
+	}
+	report.total()
+
+	checks := map[string][2]decimal.Decimal{
+		"outstanding deposits":       {report.OutstandingDeposits, amount(2000)},
+		"outstanding payments":       {report.OutstandingPayments, amount(1200)},
+		"unrecorded amount":          {report.UnrecordedAmount, amount(150)},
+		"adjusted statement balance": {report.AdjustedStatementBalance, amount(10800)},
+		"adjusted book balance":      {report.AdjustedBookBalance, amount(10800)},
+		"difference":                 {report.Difference, decimal.Zero},
+	}
+	for name, check := range checks {
+		if !check[0].Equal(check[1]) {
+			t.Errorf("%s = %s, want %s", name, check[0], check[1])
+		}
+	}
+
+	// A payment booked twice leaves a difference.
+	report.BookBalance = amount(9450)
+	report.total()
+	if !report.Difference.Equal(amount(1200)) {
+		t.Errorf("difference = %s, want 1200", report.Difference)
+	}
+}
+
+func TestItemsJSONRoundTrip(t *testing.T) {
+	items := []*MatchItem{{
+		ItemType:        TypeLedger,
+		ItemID:          uuid.New(),
+		Amount:          decimal.RequireFromString("-1234.56"),
+		TransactionDate: time.Date(2024, 10, 31, 0, 0, 0, 0, time.UTC),
+		Reference:       "CHQ001942",
+		Description:     "Payment to Acme Supplies",
+	}}
+
+	got := itemsFromJSON(itemsJSON(items))
+	if len(got) != 1 {
+		t.Fatalf("got %d items, want 1", len(got))
+	}
+	want := items[0]
+	if got[0].ItemType != want.ItemType || got[0].ItemID != want.ItemID || !got[0].Amount.Equal(want.Amount) ||
+		!got[0].TransactionDate.Equal(want.TransactionDate) || got[0].Reference != want.Reference ||
+		got[0].Description != want.Description {
+		t.Errorf("round trip = %+v, want %+v", got[0], want)
+	}
+}
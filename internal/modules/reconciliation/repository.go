@@ -0,0 +1,86 @@
+package reconciliation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/banking"
+)
+
+var (
+	// ErrReconciliationNotFound is returned when a reconciliation does not exist.
+	ErrReconciliationNotFound = errors.New("reconciliation not found")
+	// ErrMatchNotFound is returned when a reconciliation match does not exist.
+	ErrMatchNotFound = errors.New("reconciliation match not found")
+	// ErrMatchState is returned when the match's status does not allow the
+	// action, or one of its items was matched elsewhere in the meantime.
+	ErrMatchState = errors.New("reconciliation match cannot be changed in its current state")
+	// ErrReconciliationState is returned when a reconciliation is already
+	// finalised, or cannot be finalised while suggestions in its period wait
+	// for review.
+	ErrReconciliationState = errors.New("reconciliation cannot be changed in its current state")
+	// ErrBankLineNotFound is returned when a bank transaction does not exist.
+	ErrBankLineNotFound = errors.New("bank transaction not found")
+	// ErrInvalidMatch is returned when a manual match or adjustment is
+	// malformed, such as entries from another account or totals that differ.
+	ErrInvalidMatch = errors.New("invalid reconciliation match")
+	// ErrInvalidPeriod is returned when a reconciliation period end or
+	// statement balance is missing or out of range.
+	ErrInvalidPeriod = errors.New("invalid reconciliation period")
+	// ErrRuleNotFound is returned when a custom reconciliation rule does not exist.
+	ErrRuleNotFound = errors.New("reconciliation rule not found")
+	// ErrInvalidRule is returned when a custom reconciliation rule is malformed.
+	ErrInvalidRule = errors.New("invalid reconciliation rule")
+	// ErrInvalidRun is returned when matching run options are out of range.
+	ErrInvalidRun = errors.New("invalid reconciliation run")
+)
+
+// Repository abstracts persistence for bank reconciliation.
+type Repository interface {
+	// Run loads the account's unreconciled bank lines and the unmatched ledger
+	// cash lines and payment transactions dated around them, runs the engine
+	// and stores its proposals: confident ones locked into the account's open
+	// reconciliation, the rest as suggestions.
+	Run(ctx context.Context, tenantID uuid.UUID, account *banking.BankAccount, rules []CompiledRule, opts Options, runBy *uuid.UUID) (*RunResult, error)
+	GetReconciliation(ctx context.Context, tenantID uuid.UUID, reconciliationID uuid.UUID) (*Reconciliation, error)
+	ListReconciliations(ctx context.Context, tenantID uuid.UUID, bankAccountID *uuid.UUID) ([]*Reconciliation, error)
+
+	GetMatch(ctx context.Context, tenantID uuid.UUID, matchID uuid.UUID) (*Match, error)
+	ListMatches(ctx context.Context, tenantID uuid.UUID, filter MatchFilter) ([]*Match, error)
+	// AcceptMatch locks a suggested match into the account's open reconciliation.
+	AcceptMatch(ctx context.Context, tenantID uuid.UUID, matchID uuid.UUID, acceptedBy *uuid.UUID, now time.Time) (*Match, error)
+	// RejectMatch rejects a suggested match and releases its items.
+	RejectMatch(ctx context.Context, tenantID uuid.UUID, matchID uuid.UUID, rejectedBy *uuid.UUID, now time.Time) (*Match, error)
+
+	// Unmatched lists the account's entries dated in [from, to) that are not
+	// in a suggested or matched match.
+	Unmatched(ctx context.Context, tenantID uuid.UUID, account *banking.BankAccount, from, to time.Time) (*Unmatched, error)
+	// ManualMatch locks the given bank lines and book entries, which must add
+	// up, into the account's open reconciliation.
+	ManualMatch(ctx context.Context, tenantID uuid.UUID, req ManualMatchRequest, matchedBy *uuid.UUID, now time.Time) (*Match, error)
+	// UnmatchMatch releases a matched match's items, unless its
+	// reconciliation was finalised.
+	UnmatchMatch(ctx context.Context, tenantID uuid.UUID, matchID uuid.UUID, unmatchedBy *uuid.UUID, now time.Time) (*Match, error)
+	// Adjust posts an adjusting journal for an unmatched bank line and
+	// matches the line to the journal's cash leg.
+	Adjust(ctx context.Context, tenantID uuid.UUID, req AdjustmentRequest, adjustedBy *uuid.UUID, now time.Time) (*Adjustment, error)
+	// Report returns a finalised reconciliation's report, or previews an open
+	// one's as at periodEnd.
+	Report(ctx context.Context, tenantID uuid.UUID, reconciliationID uuid.UUID, periodEnd *time.Time, statementBalance *decimal.Decimal) (*Report, error)
+	// Finalise closes the open reconciliation at periodEnd, storing its report
+	// and marking its bank lines reconciled. Matches of later bank lines move
+	// to a new open reconciliation.
+	Finalise(ctx context.Context, tenantID uuid.UUID, reconciliationID uuid.UUID, periodEnd time.Time, statementBalance *decimal.Decimal, finalisedBy *uuid.UUID, now time.Time) (*Report, error)
+
+	CreateRule(ctx context.Context, rule *Rule) (*Rule, error)
+	UpdateRule(ctx context.Context, rule *Rule) (*Rule, error)
+	GetRule(ctx context.Context, tenantID uuid.UUID, ruleID uuid.UUID) (*Rule, error)
+	// ListRules returns the tenant's rules in priority order, only the active
+	// ones applying to bankAccountID when it is set.
+	ListRules(ctx context.Context, tenantID uuid.UUID, bankAccountID *uuid.UUID) ([]*Rule, error)
+	DeleteRule(ctx context.Context, tenantID uuid.UUID, ruleID uuid.UUID) error
+}
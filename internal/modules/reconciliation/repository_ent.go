@@ -0,0 +1,1601 @@
+package reconciliation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/bankaccount"
+	"github.com/bengobox/treasury-api/internal/ent/bankstatement"
+	"github.com/bengobox/treasury-api/internal/ent/banktransaction"
+	"github.com/bengobox/treasury-api/internal/ent/chartofaccount"
+	"github.com/bengobox/treasury-api/internal/ent/ledgertransaction"
+	"github.com/bengobox/treasury-api/internal/ent/paymenttransaction"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	entreconciliation "github.com/bengobox/treasury-api/internal/ent/reconciliation"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatch"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationmatchitem"
+	"github.com/bengobox/treasury-api/internal/ent/reconciliationrule"
+	"github.com/bengobox/treasury-api/internal/modules/banking"
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+	"github.com/bengobox/treasury-api/internal/modules/outbox"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+)
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// lookupBatchSize bounds the identifiers looked up per query so busy accounts
+// stay within the database's parameter limit.
+const lookupBatchSize = 1000
+
+// Run matches the account's unreconciled bank lines. Runs for an account are
+// serialised on the account row.
+func (r *EntRepository) Run(ctx context.Context, tenantID uuid.UUID, account *banking.BankAccount, rules []CompiledRule, opts Options, runBy *uuid.UUID) (*RunResult, error) {
+	result := &RunResult{}
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		if _, err := lockBankAccount(ctx, tx, tenantID, account.ID); err != nil {
+			return err
+		}
+		open, err := openReconciliation(ctx, tx, tenantID, account.ID, runBy)
+		if err != nil {
+			return err
+		}
+		result.ReconciliationID = open.ID
+
+		client := tx.Client()
+		bank, err := bankItems(ctx, client, tenantID, account.ID)
+		if err != nil {
+			return err
+		}
+		result.BankLines = len(bank)
+		if len(bank) == 0 {
+			return nil
+		}
+
+		// Book entries are looked for around the bank lines' dates only.
+		window := time.Duration(opts.DateWindowDays+1) * 24 * time.Hour
+		from, to := bank[0].Date, bank[0].Date
+		for _, item := range bank {
+			if item.Date.Before(from) {
+				from = item.Date
+			}
+			if item.Date.After(to) {
+				to = item.Date
+			}
+		}
+		from, to = from.Add(-window), to.Add(window)
+
+		ledger, err := ledgerItems(ctx, client, tenantID, account.LedgerAccountCode, from, to)
+		if err != nil {
+			return err
+		}
+		payments, err := paymentItems(ctx, client, tenantID, account, from, to)
+		if err != nil {
+			return err
+		}
+		rejected, err := rejectedFingerprints(ctx, client, account.ID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		proposals := Propose(bank, ledger, payments, rules, opts, rejected)
+		for _, p := range proposals {
+			if _, err := saveMatch(ctx, tx, tenantID, account.ID, open.ID, p, MatchAutomatic, nil, now); err != nil {
+				return err
+			}
+			if p.Auto {
+				result.Matched++
+			} else {
+				result.Suggested++
+			}
+		}
+
+		matchedLines := 0
+		for _, p := range proposals {
+			matchedLines += len(p.Bank)
+		}
+		result.Unmatched = len(bank) - matchedLines
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// saveMatch stores a proposal, locked into the reconciliation when it is
+// confident enough or made by hand and as a suggestion otherwise.
+func saveMatch(ctx context.Context, tx *ent.Tx, tenantID, bankAccountID, reconciliationID uuid.UUID, p Proposal, matchType string, matchedBy *uuid.UUID, now time.Time) (uuid.UUID, error) {
+	status, itemStatus := MatchSuggested, ItemSuggested
+	builder := tx.ReconciliationMatch.Create().
+		SetTenantID(tenantID).
+		SetBankAccountID(bankAccountID).
+		SetRule(p.Rule).
+		SetNillableRuleID(p.RuleID).
+		SetMatchType(matchType).
+		SetConfidence(p.Confidence).
+		SetBankAmount(p.BankAmount()).
+		SetBookAmount(p.BookAmount()).
+		SetDifference(p.BankAmount().Sub(p.BookAmount())).
+		SetFingerprint(p.Fingerprint()).
+		SetReasons(p.Reasons)
+	if p.Auto {
+		status, itemStatus = MatchMatched, ItemMatched
+		builder.SetReconciliationID(reconciliationID).SetNillableMatchedBy(matchedBy).SetMatchedAt(now)
+	}
+
+	saved, err := builder.SetStatus(status).Save(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("create reconciliation match: %w", err)
+	}
+
+	items := append(append([]Item{}, p.Bank...), p.Book...)
+	builders := make([]*ent.ReconciliationMatchItemCreate, len(items))
+	for i, item := range items {
+		builders[i] = tx.ReconciliationMatchItem.Create().
+			SetTenantID(tenantID).
+			SetMatchID(saved.ID).
+			SetItemType(item.Type).
+			SetItemID(item.ID).
+			SetAmount(item.Amount).
+			SetTransactionDate(item.Date).
+			SetReference(item.Reference).
+			SetDescription(item.Description).
+			SetStatus(itemStatus)
+	}
+	if _, err := tx.ReconciliationMatchItem.CreateBulk(builders...).Save(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return uuid.Nil, fmt.Errorf("%w: an entry was matched elsewhere in the meantime", ErrMatchState)
+		}
+		return uuid.Nil, fmt.Errorf("create reconciliation match items: %w", err)
+	}
+
+	if p.Auto {
+		ids := make([]uuid.UUID, len(p.Bank))
+		for i, item := range p.Bank {
+			ids[i] = item.ID
+		}
+		if err := markBankLines(ctx, tx, ids, banking.ReconciliationMatched); err != nil {
+			return uuid.Nil, err
+		}
+	}
+	return saved.ID, nil
+}
+
+func markBankLines(ctx context.Context, tx *ent.Tx, ids []uuid.UUID, status string) error {
+	err := tx.BankTransaction.Update().
+		Where(banktransaction.IDIn(ids...)).
+		SetReconciliationStatus(status).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("update bank transaction status: %w", err)
+	}
+	return nil
+}
+
+// bankItems loads the account's unreconciled bank lines not already in a
+// suggested match.
+func bankItems(ctx context.Context, client *ent.Client, tenantID, bankAccountID uuid.UUID) ([]Item, error) {
+	lines, err := client.BankTransaction.Query().
+		Where(
+			banktransaction.TenantID(tenantID),
+			banktransaction.BankAccountID(bankAccountID),
+			banktransaction.ReconciliationStatus(banking.ReconciliationUnreconciled),
+		).
+		Order(ent.Asc(banktransaction.FieldTransactionDate)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load bank transactions: %w", err)
+	}
+
+	items := make([]Item, len(lines))
+	for i, line := range lines {
+		items[i] = bankItem(line)
+	}
+	return unlocked(ctx, client, TypeBank, items)
+}
+
+// ledgerItems loads the postings to the account's ledger cash account dated
+// in [from, to) that are not in a suggested or matched match. Debits are
+// money in.
+func ledgerItems(ctx context.Context, client *ent.Client, tenantID uuid.UUID, code string, from, to time.Time) ([]Item, error) {
+	lines, err := client.LedgerTransaction.Query().
+		Where(
+			ledgertransaction.TenantID(tenantID),
+			ledgertransaction.HasAccountWith(
+				chartofaccount.TenantID(tenantID),
+				chartofaccount.AccountCode(code),
+			),
+			ledgertransaction.TransactionDateGTE(from),
+			ledgertransaction.TransactionDateLT(to),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load cash account postings: %w", err)
+	}
+
+	items := make([]Item, len(lines))
+	for i, line := range lines {
+		items[i] = ledgerItem(line)
+	}
+	return unlocked(ctx, client, TypeLedger, items)
+}
+
+// paymentItems loads succeeded payment transactions in the account's
+// currency processed in [from, to) that are not in a suggested or matched
+// match: M-Pesa payments for paybill and till accounts and the other
+// providers for bank accounts. Refunds and chargebacks are money out.
+func paymentItems(ctx context.Context, client *ent.Client, tenantID uuid.UUID, account *banking.BankAccount, from, to time.Time) ([]Item, error) {
+	provider := paymenttransaction.ProviderNEQ("mpesa")
+	if account.AccountType == banking.TypeMpesaPaybill || account.AccountType == banking.TypeMpesaTill {
+		provider = paymenttransaction.Provider("mpesa")
+	}
+
+	payments, err := client.PaymentTransaction.Query().
+		Where(
+			paymenttransaction.TenantID(tenantID),
+			paymenttransaction.Status("succeeded"),
+			paymenttransaction.Currency(account.Currency),
+			provider,
+			paymenttransaction.Or(
+				paymenttransaction.And(
+					paymenttransaction.ProcessedAtGTE(from),
+					paymenttransaction.ProcessedAtLT(to),
+				),
+				paymenttransaction.And(
+					paymenttransaction.ProcessedAtIsNil(),
+					paymenttransaction.CreatedAtGTE(from),
+					paymenttransaction.CreatedAtLT(to),
+				),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load payment transactions: %w", err)
+	}
+
+	items := make([]Item, len(payments))
+	for i, payment := range payments {
+		items[i] = paymentItem(payment)
+	}
+	return unlocked(ctx, client, TypePayment, items)
+}
+
+func bankItem(line *ent.BankTransaction) Item {
+	return Item{
+		ID:           line.ID,
+		Type:         TypeBank,
+		Date:         line.TransactionDate,
+		Amount:       line.Amount,
+		Reference:    line.Reference,
+		Description:  line.Description,
+		Counterparty: line.Counterparty,
+		PaymentID:    line.PaymentTransactionID,
+	}
+}
+
+// ledgerItem converts a cash account posting; debits are money in.
+func ledgerItem(line *ent.LedgerTransaction) Item {
+	reference, _ := line.Metadata["payment_reference"].(string)
+	return Item{
+		ID:          line.ID,
+		Type:        TypeLedger,
+		Date:        line.TransactionDate,
+		Amount:      line.DebitAmount.Sub(line.CreditAmount),
+		Reference:   reference,
+		Description: line.Description,
+	}
+}
+
+// paymentItem converts a payment transaction; refunds and chargebacks are
+// money out.
+func paymentItem(payment *ent.PaymentTransaction) Item {
+	date := payment.ProcessedAt
+	if date.IsZero() {
+		date = payment.CreatedAt
+	}
+	amount := payment.Amount
+	if payment.TransactionType == "refund" || payment.TransactionType == "chargeback" {
+		amount = amount.Neg()
+	}
+	return Item{
+		ID:        payment.ID,
+		Type:      TypePayment,
+		Date:      date,
+		Amount:    amount,
+		Reference: payment.ProviderReference,
+	}
+}
+
+// unlocked drops the items already in a suggested or matched match.
+func unlocked(ctx context.Context, client *ent.Client, itemType string, items []Item) ([]Item, error) {
+	locked := make(map[uuid.UUID]bool)
+	for start := 0; start < len(items); start += lookupBatchSize {
+		end := min(start+lookupBatchSize, len(items))
+		ids := make([]uuid.UUID, 0, end-start)
+		for _, item := range items[start:end] {
+			ids = append(ids, item.ID)
+		}
+		taken, err := client.ReconciliationMatchItem.Query().
+			Where(
+				reconciliationmatchitem.ItemType(itemType),
+				reconciliationmatchitem.ItemIDIn(ids...),
+				reconciliationmatchitem.StatusNEQ(ItemReleased),
+			).
+			Select(reconciliationmatchitem.FieldItemID).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("load matched %s items: %w", itemType, err)
+		}
+		for _, item := range taken {
+			locked[item.ItemID] = true
+		}
+	}
+
+	free := make([]Item, 0, len(items))
+	for _, item := range items {
+		if !locked[item.ID] {
+			free = append(free, item)
+		}
+	}
+	return free, nil
+}
+
+// rejectedFingerprints loads the groupings rejected as suggestions or
+// unmatched by hand, which are not proposed again.
+func rejectedFingerprints(ctx context.Context, client *ent.Client, bankAccountID uuid.UUID) (map[string]bool, error) {
+	fingerprints, err := client.ReconciliationMatch.Query().
+		Where(
+			reconciliationmatch.BankAccountID(bankAccountID),
+			reconciliationmatch.StatusIn(MatchRejected, MatchUnmatched),
+		).
+		Select(reconciliationmatch.FieldFingerprint).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load rejected matches: %w", err)
+	}
+
+	rejected := make(map[string]bool, len(fingerprints))
+	for _, fingerprint := range fingerprints {
+		rejected[fingerprint] = true
+	}
+	return rejected, nil
+}
+
+// openReconciliation returns the account's open reconciliation, starting one
+// when there is none. Callers hold the bank account lock.
+func openReconciliation(ctx context.Context, tx *ent.Tx, tenantID, bankAccountID uuid.UUID, createdBy *uuid.UUID) (*ent.Reconciliation, error) {
+	open, err := tx.Reconciliation.Query().
+		Where(
+			entreconciliation.TenantID(tenantID),
+			entreconciliation.BankAccountID(bankAccountID),
+			entreconciliation.Status(StatusOpen),
+		).
+		First(ctx)
+	if err == nil {
+		return open, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("load open reconciliation: %w", err)
+	}
+
+	open, err = tx.Reconciliation.Create().
+		SetTenantID(tenantID).
+		SetBankAccountID(bankAccountID).
+		SetStatus(StatusOpen).
+		SetNillableCreatedBy(createdBy).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create reconciliation: %w", err)
+	}
+	return open, nil
+}
+
+func lockBankAccount(ctx context.Context, tx *ent.Tx, tenantID, bankAccountID uuid.UUID) (*ent.BankAccount, error) {
+	entAccount, err := tx.BankAccount.Query().
+		Where(
+			bankaccount.ID(bankAccountID),
+			bankaccount.TenantID(tenantID),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, banking.ErrBankAccountNotFound
+		}
+		return nil, fmt.Errorf("lock bank account: %w", err)
+	}
+	return entAccount, nil
+}
+
+// GetReconciliation retrieves a reconciliation with its count of matches.
+func (r *EntRepository) GetReconciliation(ctx context.Context, tenantID uuid.UUID, reconciliationID uuid.UUID) (*Reconciliation, error) {
+	entReconciliation, err := r.client.Reconciliation.Query().
+		Where(
+			entreconciliation.ID(reconciliationID),
+			entreconciliation.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrReconciliationNotFound
+		}
+		return nil, fmt.Errorf("get reconciliation: %w", err)
+	}
+
+	reconciliation := mapEntReconciliation(entReconciliation)
+	reconciliation.MatchCount, err = r.client.ReconciliationMatch.Query().
+		Where(
+			reconciliationmatch.ReconciliationID(reconciliationID),
+			reconciliationmatch.Status(MatchMatched),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count reconciliation matches: %w", err)
+	}
+	return reconciliation, nil
+}
+
+// ListReconciliations returns the tenant's reconciliations, newest first.
+func (r *EntRepository) ListReconciliations(ctx context.Context, tenantID uuid.UUID, bankAccountID *uuid.UUID) ([]*Reconciliation, error) {
+	query := r.client.Reconciliation.Query().
+		Where(entreconciliation.TenantID(tenantID))
+	if bankAccountID != nil {
+		query = query.Where(entreconciliation.BankAccountID(*bankAccountID))
+	}
+
+	entReconciliations, err := query.
+		Order(ent.Desc(entreconciliation.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list reconciliations: %w", err)
+	}
+
+	reconciliations := make([]*Reconciliation, len(entReconciliations))
+	for i, entReconciliation := range entReconciliations {
+		reconciliations[i] = mapEntReconciliation(entReconciliation)
+	}
+	return reconciliations, nil
+}
+
+// GetMatch retrieves a match with its items.
+func (r *EntRepository) GetMatch(ctx context.Context, tenantID uuid.UUID, matchID uuid.UUID) (*Match, error) {
+	entMatch, err := r.client.ReconciliationMatch.Query().
+		Where(
+			reconciliationmatch.ID(matchID),
+			reconciliationmatch.TenantID(tenantID),
+		).
+		WithItems(orderItems).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrMatchNotFound
+		}
+		return nil, fmt.Errorf("get reconciliation match: %w", err)
+	}
+
+	return mapEntMatch(entMatch), nil
+}
+
+// ListMatches returns matches with their items, most confident first.
+func (r *EntRepository) ListMatches(ctx context.Context, tenantID uuid.UUID, filter MatchFilter) ([]*Match, error) {
+	predicates := []predicate.ReconciliationMatch{reconciliationmatch.TenantID(tenantID)}
+	if filter.BankAccountID != nil {
+		predicates = append(predicates, reconciliationmatch.BankAccountID(*filter.BankAccountID))
+	}
+	if filter.ReconciliationID != nil {
+		predicates = append(predicates, reconciliationmatch.ReconciliationID(*filter.ReconciliationID))
+	}
+	if filter.Status != "" {
+		predicates = append(predicates, reconciliationmatch.Status(filter.Status))
+	}
+
+	entMatches, err := r.client.ReconciliationMatch.Query().
+		Where(predicates...).
+		WithItems(orderItems).
+		Order(ent.Desc(reconciliationmatch.FieldConfidence), ent.Asc(reconciliationmatch.FieldCreatedAt)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list reconciliation matches: %w", err)
+	}
+
+	matches := make([]*Match, len(entMatches))
+	for i, entMatch := range entMatches {
+		matches[i] = mapEntMatch(entMatch)
+	}
+	return matches, nil
+}
+
+func orderItems(q *ent.ReconciliationMatchItemQuery) {
+	q.Order(ent.Asc(reconciliationmatchitem.FieldItemType), ent.Asc(reconciliationmatchitem.FieldTransactionDate))
+}
+
+// AcceptMatch locks a suggested match into the account's open reconciliation.
+func (r *EntRepository) AcceptMatch(ctx context.Context, tenantID uuid.UUID, matchID uuid.UUID, acceptedBy *uuid.UUID, now time.Time) (*Match, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entMatch, err := lockSuggestedMatch(ctx, tx, tenantID, matchID)
+		if err != nil {
+			return err
+		}
+		open, err := openReconciliation(ctx, tx, tenantID, entMatch.BankAccountID, acceptedBy)
+		if err != nil {
+			return err
+		}
+
+		err = entMatch.Update().
+			SetStatus(MatchMatched).
+			SetReconciliationID(open.ID).
+			SetNillableMatchedBy(acceptedBy).
+			SetMatchedAt(now).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("accept reconciliation match: %w", err)
+		}
+		err = tx.ReconciliationMatchItem.Update().
+			Where(reconciliationmatchitem.MatchID(entMatch.ID)).
+			SetStatus(ItemMatched).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("update reconciliation match items: %w", err)
+		}
+
+		bankIDs, err := matchBankLines(ctx, tx, entMatch.ID)
+		if err != nil {
+			return err
+		}
+		return markBankLines(ctx, tx, bankIDs, banking.ReconciliationMatched)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetMatch(ctx, tenantID, matchID)
+}
+
+// RejectMatch rejects a suggested match and releases its items for matching
+// again. The same grouping is not suggested again.
+func (r *EntRepository) RejectMatch(ctx context.Context, tenantID uuid.UUID, matchID uuid.UUID, rejectedBy *uuid.UUID, now time.Time) (*Match, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entMatch, err := lockSuggestedMatch(ctx, tx, tenantID, matchID)
+		if err != nil {
+			return err
+		}
+
+		err = entMatch.Update().
+			SetStatus(MatchRejected).
+			SetNillableRejectedBy(rejectedBy).
+			SetRejectedAt(now).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("reject reconciliation match: %w", err)
+		}
+		err = tx.ReconciliationMatchItem.Update().
+			Where(reconciliationmatchitem.MatchID(entMatch.ID)).
+			SetStatus(ItemReleased).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("release reconciliation match items: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetMatch(ctx, tenantID, matchID)
+}
+
+// lockSuggestedMatch locks a match that must still be a suggestion.
+func lockSuggestedMatch(ctx context.Context, tx *ent.Tx, tenantID, matchID uuid.UUID) (*ent.ReconciliationMatch, error) {
+	entMatch, err := lockMatch(ctx, tx, tenantID, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if entMatch.Status != MatchSuggested {
+		return nil, fmt.Errorf("%w: match is %s", ErrMatchState, entMatch.Status)
+	}
+	return entMatch, nil
+}
+
+// lockMatch locks the match's bank account, serialising with matching runs,
+// then the match itself.
+func lockMatch(ctx context.Context, tx *ent.Tx, tenantID, matchID uuid.UUID) (*ent.ReconciliationMatch, error) {
+	entMatch, err := tx.ReconciliationMatch.Query().
+		Where(
+			reconciliationmatch.ID(matchID),
+			reconciliationmatch.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrMatchNotFound
+		}
+		return nil, fmt.Errorf("get reconciliation match: %w", err)
+	}
+	if _, err := lockBankAccount(ctx, tx, tenantID, entMatch.BankAccountID); err != nil {
+		return nil, err
+	}
+
+	entMatch, err = tx.ReconciliationMatch.Query().
+		Where(reconciliationmatch.ID(matchID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("lock reconciliation match: %w", err)
+	}
+	return entMatch, nil
+}
+
+// matchBankLines returns the identifiers of a match's bank lines.
+func matchBankLines(ctx context.Context, tx *ent.Tx, matchID uuid.UUID) ([]uuid.UUID, error) {
+	items, err := tx.ReconciliationMatchItem.Query().
+		Where(
+			reconciliationmatchitem.MatchID(matchID),
+			reconciliationmatchitem.ItemType(TypeBank),
+		).
+		Select(reconciliationmatchitem.FieldItemID).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load matched bank lines: %w", err)
+	}
+	return itemIDs(items), nil
+}
+
+func itemIDs(items []*ent.ReconciliationMatchItem) []uuid.UUID {
+	ids := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		ids[i] = item.ItemID
+	}
+	return ids
+}
+
+// Unmatched lists the account's entries dated in [from, to) that are not in
+// a suggested or matched match.
+func (r *EntRepository) Unmatched(ctx context.Context, tenantID uuid.UUID, account *banking.BankAccount, from, to time.Time) (*Unmatched, error) {
+	bank, err := bankItems(ctx, r.client, tenantID, account.ID)
+	if err != nil {
+		return nil, err
+	}
+	inRange := make([]Item, 0, len(bank))
+	for _, item := range bank {
+		if !item.Date.Before(from) && item.Date.Before(to) {
+			inRange = append(inRange, item)
+		}
+	}
+
+	ledger, err := ledgerItems(ctx, r.client, tenantID, account.LedgerAccountCode, from, to)
+	if err != nil {
+		return nil, err
+	}
+	payments, err := paymentItems(ctx, r.client, tenantID, account, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Unmatched{
+		BankAccountID: account.ID,
+		From:          from,
+		To:            to.AddDate(0, 0, -1),
+		Bank:          matchItems(inRange),
+		Ledger:        matchItems(ledger),
+		Payments:      matchItems(payments),
+	}, nil
+}
+
+// ManualMatch locks the given bank lines and book entries into the account's
+// open reconciliation. The bank lines must be the account's and unreconciled,
+// the ledger lines postings to its cash account and the payments succeeded in
+// its currency, none of them in another suggested or matched match.
+func (r *EntRepository) ManualMatch(ctx context.Context, tenantID uuid.UUID, req ManualMatchRequest, matchedBy *uuid.UUID, now time.Time) (*Match, error) {
+	var matchID uuid.UUID
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entAccount, err := lockBankAccount(ctx, tx, tenantID, req.BankAccountID)
+		if err != nil {
+			return err
+		}
+		if entAccount.Status == banking.StatusClosed {
+			return fmt.Errorf("%w: account is closed", banking.ErrBankAccountState)
+		}
+
+		lines, err := tx.BankTransaction.Query().
+			Where(
+				banktransaction.TenantID(tenantID),
+				banktransaction.BankAccountID(entAccount.ID),
+				banktransaction.IDIn(req.BankTransactionIDs...),
+			).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("load bank transactions: %w", err)
+		}
+		if len(lines) != len(req.BankTransactionIDs) {
+			return fmt.Errorf("%w: bank transactions must be lines of the bank account", ErrInvalidMatch)
+		}
+		bank := make([]Item, len(lines))
+		for i, line := range lines {
+			if line.ReconciliationStatus != banking.ReconciliationUnreconciled {
+				return fmt.Errorf("%w: bank transaction %s is already %s", ErrMatchState, line.ID, line.ReconciliationStatus)
+			}
+			bank[i] = bankItem(line)
+		}
+
+		var book []Item
+		if len(req.LedgerTransactionIDs) > 0 {
+			postings, err := tx.LedgerTransaction.Query().
+				Where(
+					ledgertransaction.TenantID(tenantID),
+					ledgertransaction.IDIn(req.LedgerTransactionIDs...),
+					ledgertransaction.HasAccountWith(
+						chartofaccount.TenantID(tenantID),
+						chartofaccount.AccountCode(entAccount.LedgerAccountCode),
+					),
+				).
+				All(ctx)
+			if err != nil {
+				return fmt.Errorf("load cash account postings: %w", err)
+			}
+			if len(postings) != len(req.LedgerTransactionIDs) {
+				return fmt.Errorf("%w: ledger transactions must be postings to cash account %s", ErrInvalidMatch, entAccount.LedgerAccountCode)
+			}
+			for _, posting := range postings {
+				book = append(book, ledgerItem(posting))
+			}
+		}
+		if len(req.PaymentTransactionIDs) > 0 {
+			payments, err := tx.PaymentTransaction.Query().
+				Where(
+					paymenttransaction.TenantID(tenantID),
+					paymenttransaction.IDIn(req.PaymentTransactionIDs...),
+					paymenttransaction.Status("succeeded"),
+					paymenttransaction.Currency(entAccount.Currency),
+				).
+				All(ctx)
+			if err != nil {
+				return fmt.Errorf("load payment transactions: %w", err)
+			}
+			if len(payments) != len(req.PaymentTransactionIDs) {
+				return fmt.Errorf("%w: payment transactions must have succeeded in %s", ErrInvalidMatch, entAccount.Currency)
+			}
+			for _, payment := range payments {
+				book = append(book, paymentItem(payment))
+			}
+		}
+
+		p := Proposal{
+			Rule:       RuleManual,
+			Confidence: 100,
+			Auto:       true,
+			Bank:       bank,
+			Book:       book,
+		}
+		if !p.BankAmount().Equal(p.BookAmount()) {
+			return fmt.Errorf("%w: bank lines total %s but book entries %s; post an adjusting journal for the difference",
+				ErrInvalidMatch, p.BankAmount().StringFixed(2), p.BookAmount().StringFixed(2))
+		}
+		if req.Note != "" {
+			p.Reasons = []string{req.Note}
+		}
+		if err := checkUnlocked(ctx, tx.Client(), append(append([]Item{}, bank...), book...)); err != nil {
+			return err
+		}
+
+		open, err := openReconciliation(ctx, tx, tenantID, entAccount.ID, matchedBy)
+		if err != nil {
+			return err
+		}
+		matchID, err = saveMatch(ctx, tx, tenantID, entAccount.ID, open.ID, p, MatchManual, matchedBy, now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetMatch(ctx, tenantID, matchID)
+}
+
+// checkUnlocked rejects items already in a suggested or matched match, which
+// must be rejected or unmatched first.
+func checkUnlocked(ctx context.Context, client *ent.Client, items []Item) error {
+	byType := make(map[string][]Item)
+	for _, item := range items {
+		byType[item.Type] = append(byType[item.Type], item)
+	}
+	for itemType, typed := range byType {
+		free, err := unlocked(ctx, client, itemType, typed)
+		if err != nil {
+			return err
+		}
+		if len(free) != len(typed) {
+			return fmt.Errorf("%w: a %s entry is already in a suggested or matched match", ErrMatchState, itemType)
+		}
+	}
+	return nil
+}
+
+// UnmatchMatch releases a matched match's items and returns its bank lines
+// to unreconciled. Matches locked into a finalised reconciliation stay.
+func (r *EntRepository) UnmatchMatch(ctx context.Context, tenantID uuid.UUID, matchID uuid.UUID, unmatchedBy *uuid.UUID, now time.Time) (*Match, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entMatch, err := lockMatch(ctx, tx, tenantID, matchID)
+		if err != nil {
+			return err
+		}
+		if entMatch.Status != MatchMatched {
+			return fmt.Errorf("%w: match is %s", ErrMatchState, entMatch.Status)
+		}
+		if entMatch.ReconciliationID != nil {
+			finalised, err := tx.Reconciliation.Query().
+				Where(
+					entreconciliation.ID(*entMatch.ReconciliationID),
+					entreconciliation.Status(StatusFinalised),
+				).
+				Exist(ctx)
+			if err != nil {
+				return fmt.Errorf("load reconciliation: %w", err)
+			}
+			if finalised {
+				return fmt.Errorf("%w: match is in a finalised reconciliation", ErrMatchState)
+			}
+		}
+
+		err = entMatch.Update().
+			SetStatus(MatchUnmatched).
+			ClearReconciliationID().
+			SetNillableUnmatchedBy(unmatchedBy).
+			SetUnmatchedAt(now).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("unmatch reconciliation match: %w", err)
+		}
+		err = tx.ReconciliationMatchItem.Update().
+			Where(reconciliationmatchitem.MatchID(entMatch.ID)).
+			SetStatus(ItemReleased).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("release reconciliation match items: %w", err)
+		}
+
+		bankIDs, err := matchBankLines(ctx, tx, entMatch.ID)
+		if err != nil {
+			return err
+		}
+		return markBankLines(ctx, tx, bankIDs, banking.ReconciliationUnreconciled)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetMatch(ctx, tenantID, matchID)
+}
+
+// Adjust books an unmatched bank line with an adjusting journal dated on the
+// line: Dr the account / Cr cash for money out, Dr cash / Cr the account for
+// money in. The account defaults to bank charges or interest income. The
+// line is then matched to the journal's cash leg.
+func (r *EntRepository) Adjust(ctx context.Context, tenantID uuid.UUID, req AdjustmentRequest, adjustedBy *uuid.UUID, now time.Time) (*Adjustment, error) {
+	adjustment := &Adjustment{AccountCode: req.AccountCode}
+	var matchID uuid.UUID
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		line, err := tx.BankTransaction.Query().
+			Where(
+				banktransaction.ID(req.BankTransactionID),
+				banktransaction.TenantID(tenantID),
+			).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrBankLineNotFound
+			}
+			return fmt.Errorf("get bank transaction: %w", err)
+		}
+		entAccount, err := lockBankAccount(ctx, tx, tenantID, line.BankAccountID)
+		if err != nil {
+			return err
+		}
+		if entAccount.Status == banking.StatusClosed {
+			return fmt.Errorf("%w: account is closed", banking.ErrBankAccountState)
+		}
+
+		line, err = tx.BankTransaction.Query().
+			Where(banktransaction.ID(line.ID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("lock bank transaction: %w", err)
+		}
+		if line.ReconciliationStatus != banking.ReconciliationUnreconciled {
+			return fmt.Errorf("%w: bank transaction is already %s", ErrMatchState, line.ReconciliationStatus)
+		}
+		if line.Amount.IsZero() {
+			return fmt.Errorf("%w: bank transaction has no amount", ErrInvalidMatch)
+		}
+		bank := bankItem(line)
+		if err := checkUnlocked(ctx, tx.Client(), []Item{bank}); err != nil {
+			return err
+		}
+
+		amount := line.Amount.Abs()
+		var lines []ledger.Line
+		if line.Amount.IsNegative() {
+			if adjustment.AccountCode == "" {
+				adjustment.AccountCode = ledger.AccountBankCharges
+			}
+			lines = []ledger.Line{
+				ledger.Debit(adjustment.AccountCode, amount),
+				ledger.Credit(entAccount.LedgerAccountCode, amount),
+			}
+		} else {
+			if adjustment.AccountCode == "" {
+				adjustment.AccountCode = ledger.AccountInterestIncome
+			}
+			lines = []ledger.Line{
+				ledger.Debit(entAccount.LedgerAccountCode, amount),
+				ledger.Credit(adjustment.AccountCode, amount),
+			}
+		}
+		if adjustment.AccountCode == entAccount.LedgerAccountCode {
+			return fmt.Errorf("%w: account_code cannot be the bank account's cash account", ErrInvalidMatch)
+		}
+
+		description := req.Description
+		if description == "" {
+			description = "Bank adjustment: " + line.Description
+		}
+		adjustment.JournalEntryID, err = ledger.PostJournal(ctx, tx, ledger.Journal{
+			TenantID:      tenantID,
+			EntryDate:     line.TransactionDate,
+			Currency:      line.Currency,
+			ReferenceType: referenceTypeAdjustment,
+			ReferenceID:   line.ID,
+			Description:   description,
+			Metadata:      map[string]any{"payment_reference": line.Reference},
+			Lines:         lines,
+		})
+		if err != nil {
+			if errors.Is(err, ledger.ErrAccountNotFound) {
+				return fmt.Errorf("%w: %s", ErrInvalidMatch, err.Error())
+			}
+			return fmt.Errorf("post adjusting journal: %w", err)
+		}
+
+		cash, err := tx.LedgerTransaction.Query().
+			Where(
+				ledgertransaction.JournalEntryID(adjustment.JournalEntryID),
+				ledgertransaction.HasAccountWith(chartofaccount.AccountCode(entAccount.LedgerAccountCode)),
+			).
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("load adjusting journal cash leg: %w", err)
+		}
+
+		open, err := openReconciliation(ctx, tx, tenantID, entAccount.ID, adjustedBy)
+		if err != nil {
+			return err
+		}
+		matchID, err = saveMatch(ctx, tx, tenantID, entAccount.ID, open.ID, Proposal{
+			Rule:       RuleAdjustment,
+			Confidence: 100,
+			Auto:       true,
+			Bank:       []Item{bank},
+			Book:       []Item{ledgerItem(cash)},
+			Reasons:    []string{"adjusting journal posted to " + adjustment.AccountCode},
+		}, MatchManual, adjustedBy, now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	adjustment.Match, err = r.GetMatch(ctx, tenantID, matchID)
+	if err != nil {
+		return nil, err
+	}
+	return adjustment, nil
+}
+
+// Report returns a finalised reconciliation's stored report, or previews an
+// open one's as at the end of periodEnd.
+func (r *EntRepository) Report(ctx context.Context, tenantID uuid.UUID, reconciliationID uuid.UUID, periodEnd *time.Time, statementBalance *decimal.Decimal) (*Report, error) {
+	entReconciliation, err := r.client.Reconciliation.Query().
+		Where(
+			entreconciliation.ID(reconciliationID),
+			entreconciliation.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrReconciliationNotFound
+		}
+		return nil, fmt.Errorf("get reconciliation: %w", err)
+	}
+	if entReconciliation.Status == StatusFinalised {
+		return mapEntReport(entReconciliation), nil
+	}
+	if periodEnd == nil {
+		return nil, fmt.Errorf("%w: period_end is required to preview an open reconciliation", ErrInvalidPeriod)
+	}
+
+	entAccount, err := r.client.BankAccount.Get(ctx, entReconciliation.BankAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("get bank account: %w", err)
+	}
+	return buildReport(ctx, r.client, entReconciliation, entAccount, *periodEnd, statementBalance)
+}
+
+// Finalise closes the open reconciliation at the end of periodEnd. Matches of
+// bank lines dated after it move to a new open reconciliation; the bank lines
+// of the rest are marked reconciled and the report is stored.
+func (r *EntRepository) Finalise(ctx context.Context, tenantID uuid.UUID, reconciliationID uuid.UUID, periodEnd time.Time, statementBalance *decimal.Decimal, finalisedBy *uuid.UUID, now time.Time) (*Report, error) {
+	var report *Report
+	end := periodEnd.AddDate(0, 0, 1)
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entReconciliation, err := tx.Reconciliation.Query().
+			Where(
+				entreconciliation.ID(reconciliationID),
+				entreconciliation.TenantID(tenantID),
+			).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrReconciliationNotFound
+			}
+			return fmt.Errorf("get reconciliation: %w", err)
+		}
+		entAccount, err := lockBankAccount(ctx, tx, tenantID, entReconciliation.BankAccountID)
+		if err != nil {
+			return err
+		}
+		entReconciliation, err = tx.Reconciliation.Query().
+			Where(entreconciliation.ID(reconciliationID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("lock reconciliation: %w", err)
+		}
+		if entReconciliation.Status != StatusOpen {
+			return fmt.Errorf("%w: reconciliation is %s", ErrReconciliationState, entReconciliation.Status)
+		}
+
+		pending, err := tx.ReconciliationMatch.Query().
+			Where(
+				reconciliationmatch.BankAccountID(entAccount.ID),
+				reconciliationmatch.Status(MatchSuggested),
+				reconciliationmatch.HasItemsWith(
+					reconciliationmatchitem.ItemType(TypeBank),
+					reconciliationmatchitem.TransactionDateLT(end),
+				),
+			).
+			Count(ctx)
+		if err != nil {
+			return fmt.Errorf("count suggested matches: %w", err)
+		}
+		if pending > 0 {
+			return fmt.Errorf("%w: %d suggested matches in the period must be accepted or rejected first", ErrReconciliationState, pending)
+		}
+
+		report, err = buildReport(ctx, tx.Client(), entReconciliation, entAccount, periodEnd, statementBalance)
+		if err != nil {
+			return err
+		}
+
+		later, err := tx.ReconciliationMatch.Query().
+			Where(
+				reconciliationmatch.ReconciliationID(entReconciliation.ID),
+				reconciliationmatch.Status(MatchMatched),
+				reconciliationmatch.HasItemsWith(
+					reconciliationmatchitem.ItemType(TypeBank),
+					reconciliationmatchitem.TransactionDateGTE(end),
+				),
+			).
+			IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("load matches after the period: %w", err)
+		}
+
+		report.Status = StatusFinalised
+		report.FinalisedBy = finalisedBy
+		report.FinalisedAt = &now
+		err = entReconciliation.Update().
+			SetStatus(StatusFinalised).
+			SetNillablePeriodStart(report.PeriodStart).
+			SetPeriodEnd(report.PeriodEnd).
+			SetStatementBalance(report.StatementBalance).
+			SetBookBalance(report.BookBalance).
+			SetOutstandingDeposits(report.OutstandingDeposits).
+			SetOutstandingPayments(report.OutstandingPayments).
+			SetUnrecordedAmount(report.UnrecordedAmount).
+			SetDifference(report.Difference).
+			SetOutstandingItems(itemsJSON(report.Outstanding)).
+			SetUnrecordedItems(itemsJSON(report.Unrecorded)).
+			SetNillableFinalisedBy(finalisedBy).
+			SetFinalisedAt(now).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("finalise reconciliation: %w", err)
+		}
+
+		if len(later) > 0 {
+			next, err := openReconciliation(ctx, tx, tenantID, entAccount.ID, finalisedBy)
+			if err != nil {
+				return err
+			}
+			err = tx.ReconciliationMatch.Update().
+				Where(reconciliationmatch.IDIn(later...)).
+				SetReconciliationID(next.ID).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("move matches after the period: %w", err)
+			}
+		}
+
+		reconciled, err := tx.ReconciliationMatchItem.Query().
+			Where(
+				reconciliationmatchitem.ItemType(TypeBank),
+				reconciliationmatchitem.HasMatchWith(
+					reconciliationmatch.ReconciliationID(entReconciliation.ID),
+					reconciliationmatch.Status(MatchMatched),
+				),
+			).
+			Select(reconciliationmatchitem.FieldItemID).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("load reconciled bank lines: %w", err)
+		}
+		bankIDs := itemIDs(reconciled)
+		if err := markBankLines(ctx, tx, bankIDs, banking.ReconciliationReconciled); err != nil {
+			return err
+		}
+
+		return outbox.Enqueue(ctx, tx, outbox.Event{
+			TenantID:      tenantID,
+			AggregateType: "bank_reconciliation",
+			AggregateID:   entReconciliation.ID,
+			EventType:     EventReconciliationFinalised,
+			Payload: map[string]any{
+				"reconciliation_id":    entReconciliation.ID.String(),
+				"bank_account_id":      entAccount.ID.String(),
+				"currency":             entAccount.Currency,
+				"period_end":           report.PeriodEnd.Format("2006-01-02"),
+				"statement_balance":    report.StatementBalance.String(),
+				"book_balance":         report.BookBalance.String(),
+				"outstanding_deposits": report.OutstandingDeposits.String(),
+				"outstanding_payments": report.OutstandingPayments.String(),
+				"unrecorded_amount":    report.UnrecordedAmount.String(),
+				"difference":           report.Difference.String(),
+				"reconciled_lines":     len(bankIDs),
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// buildReport reconciles the account's statement balance with its cash
+// account balance at the end of periodEnd. Book entries outstanding at the
+// previous finalised period carry forward with the postings dated since; an
+// entry clears once matched to bank lines dated in the period. Unrecorded
+// items are the account's unreconciled bank lines dated in or before the
+// period.
+func buildReport(ctx context.Context, client *ent.Client, entReconciliation *ent.Reconciliation, entAccount *ent.BankAccount, periodEnd time.Time, statementBalance *decimal.Decimal) (*Report, error) {
+	end := periodEnd.AddDate(0, 0, 1)
+	report := &Report{
+		ReconciliationID: entReconciliation.ID,
+		BankAccountID:    entAccount.ID,
+		Status:           entReconciliation.Status,
+		PeriodEnd:        periodEnd,
+	}
+
+	previous, err := client.Reconciliation.Query().
+		Where(
+			entreconciliation.BankAccountID(entAccount.ID),
+			entreconciliation.Status(StatusFinalised),
+		).
+		Order(ent.Desc(entreconciliation.FieldPeriodEnd)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("load previous reconciliation: %w", err)
+	}
+	since := time.Time{}
+	var carried []*MatchItem
+	if previous != nil && previous.PeriodEnd != nil {
+		if !periodEnd.After(*previous.PeriodEnd) {
+			return nil, fmt.Errorf("%w: period_end must be after the previous period ending %s",
+				ErrInvalidPeriod, previous.PeriodEnd.Format("2006-01-02"))
+		}
+		since = previous.PeriodEnd.AddDate(0, 0, 1)
+		report.PeriodStart = &since
+		carried = itemsFromJSON(previous.OutstandingItems)
+	}
+
+	if statementBalance == nil {
+		statement, err := client.BankStatement.Query().
+			Where(
+				bankstatement.BankAccountID(entAccount.ID),
+				bankstatement.PeriodEndGTE(periodEnd),
+				bankstatement.PeriodEndLT(end),
+				bankstatement.ClosingBalanceNotNil(),
+			).
+			Order(ent.Desc(bankstatement.FieldCreatedAt)).
+			First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fmt.Errorf("%w: statement_balance is required as no imported statement ends on %s",
+					ErrInvalidPeriod, periodEnd.Format("2006-01-02"))
+			}
+			return nil, fmt.Errorf("load statement: %w", err)
+		}
+		statementBalance = statement.ClosingBalance
+	}
+	report.StatementBalance = *statementBalance
+
+	postings, err := client.LedgerTransaction.Query().
+		Where(
+			ledgertransaction.TenantID(entAccount.TenantID),
+			ledgertransaction.HasAccountWith(
+				chartofaccount.TenantID(entAccount.TenantID),
+				chartofaccount.AccountCode(entAccount.LedgerAccountCode),
+			),
+			ledgertransaction.TransactionDateLT(end),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load cash account postings: %w", err)
+	}
+	candidates := make([]Item, 0, len(carried))
+	seen := make(map[uuid.UUID]bool)
+	for _, item := range carried {
+		seen[item.ItemID] = true
+		candidates = append(candidates, Item{
+			ID:          item.ItemID,
+			Type:        item.ItemType,
+			Date:        item.TransactionDate,
+			Amount:      item.Amount,
+			Reference:   item.Reference,
+			Description: item.Description,
+		})
+	}
+	report.BookBalance = decimal.Zero
+	for _, posting := range postings {
+		item := ledgerItem(posting)
+		report.BookBalance = report.BookBalance.Add(item.Amount)
+		if !posting.TransactionDate.Before(since) && !seen[item.ID] {
+			candidates = append(candidates, item)
+		}
+	}
+
+	cleared, err := clearedBy(ctx, client, candidates, end)
+	if err != nil {
+		return nil, err
+	}
+	outstanding := make([]Item, 0, len(candidates))
+	for _, item := range candidates {
+		if !cleared[item.ID] {
+			outstanding = append(outstanding, item)
+		}
+	}
+	report.Outstanding = matchItems(outstanding)
+
+	lines, err := client.BankTransaction.Query().
+		Where(
+			banktransaction.BankAccountID(entAccount.ID),
+			banktransaction.ReconciliationStatus(banking.ReconciliationUnreconciled),
+			banktransaction.TransactionDateLT(end),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load unreconciled bank transactions: %w", err)
+	}
+	unrecorded := make([]Item, len(lines))
+	for i, line := range lines {
+		unrecorded[i] = bankItem(line)
+	}
+	report.Unrecorded = matchItems(unrecorded)
+
+	report.total()
+	return report, nil
+}
+
+// clearedBy returns the ledger entries matched to bank lines all dated before
+// end.
+func clearedBy(ctx context.Context, client *ent.Client, entries []Item, end time.Time) (map[uuid.UUID]bool, error) {
+	matchOf := make(map[uuid.UUID]uuid.UUID)
+	for start := 0; start < len(entries); start += lookupBatchSize {
+		stop := min(start+lookupBatchSize, len(entries))
+		ids := make([]uuid.UUID, 0, stop-start)
+		for _, item := range entries[start:stop] {
+			ids = append(ids, item.ID)
+		}
+		matched, err := client.ReconciliationMatchItem.Query().
+			Where(
+				reconciliationmatchitem.ItemType(TypeLedger),
+				reconciliationmatchitem.ItemIDIn(ids...),
+				reconciliationmatchitem.Status(ItemMatched),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("load matched ledger entries: %w", err)
+		}
+		for _, item := range matched {
+			matchOf[item.ItemID] = item.MatchID
+		}
+	}
+
+	matchIDs := make([]uuid.UUID, 0, len(matchOf))
+	for _, matchID := range matchOf {
+		matchIDs = append(matchIDs, matchID)
+	}
+	late := make(map[uuid.UUID]bool)
+	for start := 0; start < len(matchIDs); start += lookupBatchSize {
+		stop := min(start+lookupBatchSize, len(matchIDs))
+		items, err := client.ReconciliationMatchItem.Query().
+			Where(
+				reconciliationmatchitem.MatchIDIn(matchIDs[start:stop]...),
+				reconciliationmatchitem.ItemType(TypeBank),
+				reconciliationmatchitem.TransactionDateGTE(end),
+			).
+			Select(reconciliationmatchitem.FieldMatchID).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("load matched bank lines: %w", err)
+		}
+		for _, item := range items {
+			late[item.MatchID] = true
+		}
+	}
+
+	cleared := make(map[uuid.UUID]bool, len(matchOf))
+	for itemID, matchID := range matchOf {
+		if !late[matchID] {
+			cleared[itemID] = true
+		}
+	}
+	return cleared, nil
+}
+
+// CreateRule stores a custom rule.
+func (r *EntRepository) CreateRule(ctx context.Context, rule *Rule) (*Rule, error) {
+	entRule, err := r.client.ReconciliationRule.Create().
+		SetTenantID(rule.TenantID).
+		SetNillableBankAccountID(rule.BankAccountID).
+		SetName(rule.Name).
+		SetPriority(rule.Priority).
+		SetMatchField(rule.MatchField).
+		SetPattern(rule.Pattern).
+		SetCounterpart(rule.Counterpart).
+		SetCounterpartPattern(rule.CounterpartPattern).
+		SetAmountTolerance(rule.AmountTolerance).
+		SetDateWindowDays(rule.DateWindowDays).
+		SetConfidence(rule.Confidence).
+		SetAutoMatch(rule.AutoMatch).
+		SetActive(rule.Active).
+		SetNillableCreatedBy(rule.CreatedBy).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create reconciliation rule: %w", err)
+	}
+
+	return mapEntRule(entRule), nil
+}
+
+// UpdateRule replaces a custom rule's settings.
+func (r *EntRepository) UpdateRule(ctx context.Context, rule *Rule) (*Rule, error) {
+	builder := r.client.ReconciliationRule.UpdateOneID(rule.ID).
+		Where(reconciliationrule.TenantID(rule.TenantID)).
+		SetName(rule.Name).
+		SetPriority(rule.Priority).
+		SetMatchField(rule.MatchField).
+		SetPattern(rule.Pattern).
+		SetCounterpart(rule.Counterpart).
+		SetCounterpartPattern(rule.CounterpartPattern).
+		SetAmountTolerance(rule.AmountTolerance).
+		SetDateWindowDays(rule.DateWindowDays).
+		SetConfidence(rule.Confidence).
+		SetAutoMatch(rule.AutoMatch).
+		SetActive(rule.Active)
+	if rule.BankAccountID != nil {
+		builder.SetBankAccountID(*rule.BankAccountID)
+	} else {
+		builder.ClearBankAccountID()
+	}
+
+	entRule, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrRuleNotFound
+		}
+		return nil, fmt.Errorf("update reconciliation rule: %w", err)
+	}
+
+	return mapEntRule(entRule), nil
+}
+
+// GetRule retrieves a custom rule.
+func (r *EntRepository) GetRule(ctx context.Context, tenantID uuid.UUID, ruleID uuid.UUID) (*Rule, error) {
+	entRule, err := r.client.ReconciliationRule.Query().
+		Where(
+			reconciliationrule.ID(ruleID),
+			reconciliationrule.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrRuleNotFound
+		}
+		return nil, fmt.Errorf("get reconciliation rule: %w", err)
+	}
+
+	return mapEntRule(entRule), nil
+}
+
+// ListRules returns the tenant's custom rules in priority order.
+func (r *EntRepository) ListRules(ctx context.Context, tenantID uuid.UUID, bankAccountID *uuid.UUID) ([]*Rule, error) {
+	query := r.client.ReconciliationRule.Query().
+		Where(reconciliationrule.TenantID(tenantID))
+	if bankAccountID != nil {
+		query = query.Where(
+			reconciliationrule.Active(true),
+			reconciliationrule.Or(
+				reconciliationrule.BankAccountIDIsNil(),
+				reconciliationrule.BankAccountID(*bankAccountID),
+			),
+		)
+	}
+
+	entRules, err := query.
+		Order(ent.Asc(reconciliationrule.FieldPriority), ent.Asc(reconciliationrule.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list reconciliation rules: %w", err)
+	}
+
+	rules := make([]*Rule, len(entRules))
+	for i, entRule := range entRules {
+		rules[i] = mapEntRule(entRule)
+	}
+	return rules, nil
+}
+
+// DeleteRule removes a custom rule. Matches it proposed keep its identifier.
+func (r *EntRepository) DeleteRule(ctx context.Context, tenantID uuid.UUID, ruleID uuid.UUID) error {
+	deleted, err := r.client.ReconciliationRule.Delete().
+		Where(
+			reconciliationrule.ID(ruleID),
+			reconciliationrule.TenantID(tenantID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete reconciliation rule: %w", err)
+	}
+	if deleted == 0 {
+		return ErrRuleNotFound
+	}
+	return nil
+}
+
+func mapEntReconciliation(entReconciliation *ent.Reconciliation) *Reconciliation {
+	return &Reconciliation{
+		ID:               entReconciliation.ID,
+		TenantID:         entReconciliation.TenantID,
+		BankAccountID:    entReconciliation.BankAccountID,
+		Status:           entReconciliation.Status,
+		PeriodStart:      entReconciliation.PeriodStart,
+		PeriodEnd:        entReconciliation.PeriodEnd,
+		StatementBalance: entReconciliation.StatementBalance,
+		BookBalance:      entReconciliation.BookBalance,
+		Difference:       entReconciliation.Difference,
+		CreatedBy:        entReconciliation.CreatedBy,
+		FinalisedBy:      entReconciliation.FinalisedBy,
+		FinalisedAt:      entReconciliation.FinalisedAt,
+		CreatedAt:        entReconciliation.CreatedAt,
+		UpdatedAt:        entReconciliation.UpdatedAt,
+	}
+}
+
+// mapEntReport rebuilds the report stored when the reconciliation was
+// finalised.
+func mapEntReport(entReconciliation *ent.Reconciliation) *Report {
+	report := &Report{
+		ReconciliationID: entReconciliation.ID,
+		BankAccountID:    entReconciliation.BankAccountID,
+		Status:           entReconciliation.Status,
+		PeriodStart:      entReconciliation.PeriodStart,
+		Outstanding:      itemsFromJSON(entReconciliation.OutstandingItems),
+		Unrecorded:       itemsFromJSON(entReconciliation.UnrecordedItems),
+		FinalisedBy:      entReconciliation.FinalisedBy,
+		FinalisedAt:      entReconciliation.FinalisedAt,
+	}
+	if entReconciliation.PeriodEnd != nil {
+		report.PeriodEnd = *entReconciliation.PeriodEnd
+	}
+	if entReconciliation.StatementBalance != nil {
+		report.StatementBalance = *entReconciliation.StatementBalance
+	}
+	if entReconciliation.BookBalance != nil {
+		report.BookBalance = *entReconciliation.BookBalance
+	}
+	report.total()
+	return report
+}
+
+func mapEntMatch(entMatch *ent.ReconciliationMatch) *Match {
+	match := &Match{
+		ID:               entMatch.ID,
+		TenantID:         entMatch.TenantID,
+		BankAccountID:    entMatch.BankAccountID,
+		ReconciliationID: entMatch.ReconciliationID,
+		Rule:             entMatch.Rule,
+		RuleID:           entMatch.RuleID,
+		MatchType:        entMatch.MatchType,
+		Confidence:       entMatch.Confidence,
+		Status:           entMatch.Status,
+		BankAmount:       entMatch.BankAmount,
+		BookAmount:       entMatch.BookAmount,
+		Difference:       entMatch.Difference,
+		Reasons:          entMatch.Reasons,
+		MatchedBy:        entMatch.MatchedBy,
+		MatchedAt:        entMatch.MatchedAt,
+		RejectedBy:       entMatch.RejectedBy,
+		RejectedAt:       entMatch.RejectedAt,
+		UnmatchedBy:      entMatch.UnmatchedBy,
+		UnmatchedAt:      entMatch.UnmatchedAt,
+		CreatedAt:        entMatch.CreatedAt,
+	}
+
+	for _, entItem := range entMatch.Edges.Items {
+		match.Items = append(match.Items, &MatchItem{
+			ItemType:        entItem.ItemType,
+			ItemID:          entItem.ItemID,
+			Amount:          entItem.Amount,
+			TransactionDate: entItem.TransactionDate,
+			Reference:       entItem.Reference,
+			Description:     entItem.Description,
+		})
+	}
+
+	return match
+}
+
+func mapEntRule(entRule *ent.ReconciliationRule) *Rule {
+	return &Rule{
+		ID:                 entRule.ID,
+		TenantID:           entRule.TenantID,
+		BankAccountID:      entRule.BankAccountID,
+		Name:               entRule.Name,
+		Priority:           entRule.Priority,
+		MatchField:         entRule.MatchField,
+		Pattern:            entRule.Pattern,
+		Counterpart:        entRule.Counterpart,
+		CounterpartPattern: entRule.CounterpartPattern,
+		AmountTolerance:    entRule.AmountTolerance,
+		DateWindowDays:     entRule.DateWindowDays,
+		Confidence:         entRule.Confidence,
+		AutoMatch:          entRule.AutoMatch,
+		Active:             entRule.Active,
+		CreatedBy:          entRule.CreatedBy,
+		CreatedAt:          entRule.CreatedAt,
+		UpdatedAt:          entRule.UpdatedAt,
+	}
+}
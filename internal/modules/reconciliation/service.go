@@ -0,0 +1,438 @@
+package reconciliation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/banking"
+)
+
+var (
+	matchFields  = map[string]bool{FieldReference: true, FieldDescription: true, FieldCounterparty: true}
+	counterparts = map[string]bool{TypeLedger: true, TypePayment: true}
+	matchStates  = map[string]bool{MatchSuggested: true, MatchMatched: true, MatchRejected: true, MatchUnmatched: true}
+)
+
+// Service matches bank lines to the books and manages the suggestions queue
+// and custom rules.
+type Service struct {
+	repo    Repository
+	banking *banking.Service
+	logger  *zap.Logger
+}
+
+// NewService creates a new reconciliation service.
+func NewService(repo Repository, bankingService *banking.Service, logger *zap.Logger) *Service {
+	return &Service{
+		repo:    repo,
+		banking: bankingService,
+		logger:  logger,
+	}
+}
+
+// Run matches the bank account's unreconciled lines to its ledger cash
+// postings and payment transactions. The tenant's active rules run first,
+// then exact reference, amount and date, and grouping matches. Matches from
+// AutoMatchConfidence up, and those of custom rules set to auto-match, are
+// locked into the account's open reconciliation; the rest are queued as
+// suggestions.
+func (s *Service) Run(ctx context.Context, tenantID uuid.UUID, bankAccountID uuid.UUID, req RunRequest, runBy *uuid.UUID) (*RunResult, error) {
+	account, err := s.banking.Get(ctx, tenantID, bankAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if account.Status == banking.StatusClosed {
+		return nil, fmt.Errorf("%w: account is closed", banking.ErrBankAccountState)
+	}
+
+	opts := Options{DateWindowDays: req.DateWindowDays, MaxGroupSize: req.MaxGroupSize}
+	if opts.DateWindowDays == 0 {
+		opts.DateWindowDays = DefaultDateWindowDays
+	}
+	if opts.MaxGroupSize == 0 {
+		opts.MaxGroupSize = DefaultMaxGroupSize
+	}
+	if opts.DateWindowDays < 0 || opts.DateWindowDays > MaxDateWindowDays {
+		return nil, fmt.Errorf("%w: date_window_days must be between 1 and %d", ErrInvalidRun, MaxDateWindowDays)
+	}
+	if opts.MaxGroupSize < 2 || opts.MaxGroupSize > MaxGroupSize {
+		return nil, fmt.Errorf("%w: max_group_size must be between 2 and %d", ErrInvalidRun, MaxGroupSize)
+	}
+
+	rules, err := s.repo.ListRules(ctx, tenantID, &account.ID)
+	if err != nil {
+		return nil, err
+	}
+	compiled := make([]CompiledRule, 0, len(rules))
+	for _, rule := range rules {
+		c, err := compile(rule)
+		if err != nil {
+			// Rules are validated when saved; skip one that no longer compiles
+			// rather than stop reconciliation.
+			s.logger.Warn("skipping reconciliation rule",
+				zap.String("rule_id", rule.ID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		compiled = append(compiled, c)
+	}
+
+	result, err := s.repo.Run(ctx, tenantID, account, compiled, opts, runBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("reconciliation run completed",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("bank_account_id", account.ID.String()),
+		zap.String("reconciliation_id", result.ReconciliationID.String()),
+		zap.Int("bank_lines", result.BankLines),
+		zap.Int("matched", result.Matched),
+		zap.Int("suggested", result.Suggested),
+	)
+
+	return result, nil
+}
+
+// GetReconciliation retrieves a reconciliation.
+func (s *Service) GetReconciliation(ctx context.Context, tenantID uuid.UUID, reconciliationID uuid.UUID) (*Reconciliation, error) {
+	return s.repo.GetReconciliation(ctx, tenantID, reconciliationID)
+}
+
+// ListReconciliations returns the tenant's reconciliations, optionally for
+// one bank account.
+func (s *Service) ListReconciliations(ctx context.Context, tenantID uuid.UUID, bankAccountID *uuid.UUID) ([]*Reconciliation, error) {
+	return s.repo.ListReconciliations(ctx, tenantID, bankAccountID)
+}
+
+// GetMatch retrieves a match with its items.
+func (s *Service) GetMatch(ctx context.Context, tenantID uuid.UUID, matchID uuid.UUID) (*Match, error) {
+	return s.repo.GetMatch(ctx, tenantID, matchID)
+}
+
+// ListMatches returns matches, most confident first. Listing the suggested
+// status gives the suggestions queue.
+func (s *Service) ListMatches(ctx context.Context, tenantID uuid.UUID, filter MatchFilter) ([]*Match, error) {
+	filter.Status = strings.ToLower(strings.TrimSpace(filter.Status))
+	if filter.Status != "" && !matchStates[filter.Status] {
+		return nil, fmt.Errorf("%w: status must be suggested, matched, rejected or unmatched", ErrInvalidRun)
+	}
+	return s.repo.ListMatches(ctx, tenantID, filter)
+}
+
+// AcceptMatch locks a suggested match into the account's open reconciliation.
+func (s *Service) AcceptMatch(ctx context.Context, tenantID uuid.UUID, matchID uuid.UUID, acceptedBy *uuid.UUID) (*Match, error) {
+	match, err := s.repo.AcceptMatch(ctx, tenantID, matchID, acceptedBy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("reconciliation match accepted",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("match_id", match.ID.String()),
+		zap.String("rule", match.Rule),
+	)
+
+	return match, nil
+}
+
+// RejectMatch rejects a suggested match. Its items are matched again by later
+// runs, but never in the same grouping.
+func (s *Service) RejectMatch(ctx context.Context, tenantID uuid.UUID, matchID uuid.UUID, rejectedBy *uuid.UUID) (*Match, error) {
+	match, err := s.repo.RejectMatch(ctx, tenantID, matchID, rejectedBy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("reconciliation match rejected",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("match_id", match.ID.String()),
+		zap.String("rule", match.Rule),
+	)
+
+	return match, nil
+}
+
+// Unmatched lists the bank account's entries dated from from to to, inclusive,
+// that are not in a suggested or matched match.
+func (s *Service) Unmatched(ctx context.Context, tenantID uuid.UUID, bankAccountID uuid.UUID, from, to time.Time) (*Unmatched, error) {
+	from, to = dateOf(from), dateOf(to)
+	if to.Before(from) {
+		return nil, fmt.Errorf("%w: from must not be after to", ErrInvalidPeriod)
+	}
+	account, err := s.banking.Get(ctx, tenantID, bankAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.Unmatched(ctx, tenantID, account, from, to.AddDate(0, 0, 1))
+}
+
+// ManualMatch matches bank lines to ledger cash lines or payment
+// transactions by hand, locking them into the account's open reconciliation.
+func (s *Service) ManualMatch(ctx context.Context, tenantID uuid.UUID, req ManualMatchRequest, matchedBy *uuid.UUID) (*Match, error) {
+	req.Note = strings.TrimSpace(req.Note)
+	if req.BankAccountID == uuid.Nil {
+		return nil, fmt.Errorf("%w: bank_account_id is required", ErrInvalidMatch)
+	}
+	if len(req.BankTransactionIDs) == 0 {
+		return nil, fmt.Errorf("%w: at least one bank transaction is required", ErrInvalidMatch)
+	}
+	if len(req.LedgerTransactionIDs)+len(req.PaymentTransactionIDs) == 0 {
+		return nil, fmt.Errorf("%w: at least one ledger or payment transaction is required", ErrInvalidMatch)
+	}
+	seen := make(map[uuid.UUID]bool)
+	for _, ids := range [][]uuid.UUID{req.BankTransactionIDs, req.LedgerTransactionIDs, req.PaymentTransactionIDs} {
+		for _, id := range ids {
+			if seen[id] {
+				return nil, fmt.Errorf("%w: %s is listed twice", ErrInvalidMatch, id)
+			}
+			seen[id] = true
+		}
+	}
+
+	match, err := s.repo.ManualMatch(ctx, tenantID, req, matchedBy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("reconciliation match created manually",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("match_id", match.ID.String()),
+		zap.String("bank_account_id", match.BankAccountID.String()),
+		zap.Int("items", len(match.Items)),
+	)
+
+	return match, nil
+}
+
+// UnmatchMatch releases a matched match so its entries can be matched again.
+// Matches in a finalised reconciliation cannot be unmatched.
+func (s *Service) UnmatchMatch(ctx context.Context, tenantID uuid.UUID, matchID uuid.UUID, unmatchedBy *uuid.UUID) (*Match, error) {
+	match, err := s.repo.UnmatchMatch(ctx, tenantID, matchID, unmatchedBy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("reconciliation match unmatched",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("match_id", match.ID.String()),
+		zap.String("rule", match.Rule),
+	)
+
+	return match, nil
+}
+
+// Adjust books an unmatched bank line, such as a bank charge or interest,
+// with an adjusting journal and matches the line to it.
+func (s *Service) Adjust(ctx context.Context, tenantID uuid.UUID, req AdjustmentRequest, adjustedBy *uuid.UUID) (*Adjustment, error) {
+	req.AccountCode = strings.TrimSpace(req.AccountCode)
+	req.Description = strings.TrimSpace(req.Description)
+	if req.BankTransactionID == uuid.Nil {
+		return nil, fmt.Errorf("%w: bank_transaction_id is required", ErrInvalidMatch)
+	}
+
+	adjustment, err := s.repo.Adjust(ctx, tenantID, req, adjustedBy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("bank adjustment posted",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("bank_transaction_id", req.BankTransactionID.String()),
+		zap.String("journal_entry_id", adjustment.JournalEntryID.String()),
+		zap.String("account_code", adjustment.AccountCode),
+	)
+
+	return adjustment, nil
+}
+
+// Report returns a finalised reconciliation's report. For an open
+// reconciliation it previews the report as at periodEnd.
+func (s *Service) Report(ctx context.Context, tenantID uuid.UUID, reconciliationID uuid.UUID, periodEnd *time.Time, statementBalance *decimal.Decimal) (*Report, error) {
+	return s.repo.Report(ctx, tenantID, reconciliationID, periodEnd, statementBalance)
+}
+
+// Finalise closes the open reconciliation at the end of the period and
+// stores its report, which does not change afterwards. Suggestions dated in
+// the period must be reviewed first.
+func (s *Service) Finalise(ctx context.Context, tenantID uuid.UUID, reconciliationID uuid.UUID, req FinaliseRequest, finalisedBy *uuid.UUID) (*Report, error) {
+	periodEnd, err := time.Parse("2006-01-02", strings.TrimSpace(req.PeriodEnd))
+	if err != nil {
+		return nil, fmt.Errorf("%w: period_end must be a date (YYYY-MM-DD)", ErrInvalidPeriod)
+	}
+	now := time.Now()
+	if periodEnd.After(now) {
+		return nil, fmt.Errorf("%w: period_end cannot be in the future", ErrInvalidPeriod)
+	}
+
+	report, err := s.repo.Finalise(ctx, tenantID, reconciliationID, periodEnd, req.StatementBalance, finalisedBy, now)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("reconciliation finalised",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("reconciliation_id", report.ReconciliationID.String()),
+		zap.String("period_end", req.PeriodEnd),
+		zap.String("difference", report.Difference.String()),
+		zap.Int("outstanding", len(report.Outstanding)),
+		zap.Int("unrecorded", len(report.Unrecorded)),
+	)
+
+	return report, nil
+}
+
+// CreateRule validates and stores a custom rule.
+func (s *Service) CreateRule(ctx context.Context, tenantID uuid.UUID, req RuleRequest, createdBy *uuid.UUID) (*Rule, error) {
+	rule, err := s.buildRule(ctx, tenantID, req)
+	if err != nil {
+		return nil, err
+	}
+	rule.CreatedBy = createdBy
+
+	created, err := s.repo.CreateRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("reconciliation rule created",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("rule_id", created.ID.String()),
+		zap.String("name", created.Name),
+	)
+
+	return created, nil
+}
+
+// UpdateRule validates and replaces a custom rule's settings.
+func (s *Service) UpdateRule(ctx context.Context, tenantID uuid.UUID, ruleID uuid.UUID, req RuleRequest) (*Rule, error) {
+	rule, err := s.buildRule(ctx, tenantID, req)
+	if err != nil {
+		return nil, err
+	}
+	rule.ID = ruleID
+
+	return s.repo.UpdateRule(ctx, rule)
+}
+
+// GetRule retrieves a custom rule.
+func (s *Service) GetRule(ctx context.Context, tenantID uuid.UUID, ruleID uuid.UUID) (*Rule, error) {
+	return s.repo.GetRule(ctx, tenantID, ruleID)
+}
+
+// ListRules returns all of the tenant's custom rules in priority order.
+func (s *Service) ListRules(ctx context.Context, tenantID uuid.UUID) ([]*Rule, error) {
+	return s.repo.ListRules(ctx, tenantID, nil)
+}
+
+// DeleteRule removes a custom rule.
+func (s *Service) DeleteRule(ctx context.Context, tenantID uuid.UUID, ruleID uuid.UUID) error {
+	return s.repo.DeleteRule(ctx, tenantID, ruleID)
+}
+
+// buildRule applies a rule request's defaults and validates it.
+func (s *Service) buildRule(ctx context.Context, tenantID uuid.UUID, req RuleRequest) (*Rule, error) {
+	rule := &Rule{
+		TenantID:           tenantID,
+		BankAccountID:      req.BankAccountID,
+		Name:               strings.TrimSpace(req.Name),
+		Priority:           100,
+		MatchField:         strings.ToLower(strings.TrimSpace(req.MatchField)),
+		Pattern:            strings.TrimSpace(req.Pattern),
+		Counterpart:        strings.ToLower(strings.TrimSpace(req.Counterpart)),
+		CounterpartPattern: strings.TrimSpace(req.CounterpartPattern),
+		AmountTolerance:    decimal.Zero,
+		DateWindowDays:     DefaultDateWindowDays,
+		Confidence:         90,
+		AutoMatch:          req.AutoMatch,
+		Active:             true,
+	}
+	if req.Priority != nil {
+		rule.Priority = *req.Priority
+	}
+	if req.AmountTolerance != nil {
+		rule.AmountTolerance = *req.AmountTolerance
+	}
+	if req.DateWindowDays != nil {
+		rule.DateWindowDays = *req.DateWindowDays
+	}
+	if req.Confidence != nil {
+		rule.Confidence = *req.Confidence
+	}
+	if req.Active != nil {
+		rule.Active = *req.Active
+	}
+	if rule.MatchField == "" {
+		rule.MatchField = FieldDescription
+	}
+	if rule.Counterpart == "" {
+		rule.Counterpart = TypeLedger
+	}
+
+	if rule.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidRule)
+	}
+	if !matchFields[rule.MatchField] {
+		return nil, fmt.Errorf("%w: match_field must be reference, description or counterparty", ErrInvalidRule)
+	}
+	if !counterparts[rule.Counterpart] {
+		return nil, fmt.Errorf("%w: counterpart must be ledger or payment", ErrInvalidRule)
+	}
+	if rule.Pattern == "" {
+		return nil, fmt.Errorf("%w: pattern is required", ErrInvalidRule)
+	}
+	if rule.AmountTolerance.IsNegative() {
+		return nil, fmt.Errorf("%w: amount_tolerance cannot be negative", ErrInvalidRule)
+	}
+	if rule.DateWindowDays < 0 || rule.DateWindowDays > MaxDateWindowDays {
+		return nil, fmt.Errorf("%w: date_window_days must be between 0 and %d", ErrInvalidRule, MaxDateWindowDays)
+	}
+	if rule.Confidence < 1 || rule.Confidence > 100 {
+		return nil, fmt.Errorf("%w: confidence must be between 1 and 100", ErrInvalidRule)
+	}
+	if _, err := compile(rule); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRule, err.Error())
+	}
+	if rule.BankAccountID != nil {
+		if _, err := s.banking.Get(ctx, tenantID, *rule.BankAccountID); err != nil {
+			return nil, err
+		}
+	}
+
+	return rule, nil
+}
+
+// compile prepares a rule for the engine. Patterns match case-insensitively.
+func compile(rule *Rule) (CompiledRule, error) {
+	pattern, err := regexp.Compile("(?i)" + rule.Pattern)
+	if err != nil {
+		return CompiledRule{}, fmt.Errorf("pattern: %s", err.Error())
+	}
+	compiled := CompiledRule{
+		ID:              rule.ID,
+		Name:            rule.Name,
+		MatchField:      rule.MatchField,
+		Pattern:         pattern,
+		Counterpart:     rule.Counterpart,
+		AmountTolerance: rule.AmountTolerance,
+		DateWindowDays:  rule.DateWindowDays,
+		Confidence:      rule.Confidence,
+		AutoMatch:       rule.AutoMatch,
+	}
+	if rule.CounterpartPattern != "" {
+		compiled.CounterpartPattern, err = regexp.Compile("(?i)" + rule.CounterpartPattern)
+		if err != nil {
+			return CompiledRule{}, fmt.Errorf("counterpart_pattern: %s", err.Error())
+		}
+	}
+	return compiled, nil
+}
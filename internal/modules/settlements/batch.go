@@ -0,0 +1,85 @@
+package settlements
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// channelOf returns the settlement channel a payment provider collects
+// through, or "" when its collections are not settled.
+func channelOf(provider string) string {
+	for channel, providers := range channelProviders {
+		for _, p := range providers {
+			if p == provider {
+				return channel
+			}
+		}
+	}
+	return ""
+}
+
+// Settles reports whether collections through the provider are paid out to
+// the tenant in settlement batches, and so sit in settlement clearing until
+// the payout.
+func Settles(provider string) bool {
+	return channelOf(provider) != ""
+}
+
+// providers lists every provider settled through some channel.
+func providers() []string {
+	var all []string
+	for _, p := range channelProviders {
+		all = append(all, p...)
+	}
+	return all
+}
+
+// signed returns a payment transaction's effect on the amount disbursed:
+// refunds and chargebacks are deducted.
+func signed(itemType string, amount decimal.Decimal) decimal.Decimal {
+	if itemType == ItemRefund || itemType == ItemChargeback {
+		return amount.Abs().Neg()
+	}
+	return amount
+}
+
+// settlementFee returns the fee deducted from gross collections at rate,
+// rounded to cents, as a negative item amount.
+func settlementFee(gross, rate decimal.Decimal) decimal.Decimal {
+	if !gross.IsPositive() || !rate.IsPositive() {
+		return decimal.Zero
+	}
+	return gross.Mul(rate).Round(2).Neg()
+}
+
+// totals summarises a batch's included items.
+type totals struct {
+	Gross        decimal.Decimal
+	Refunds      decimal.Decimal
+	Fees         decimal.Decimal
+	Adjustments  decimal.Decimal
+	Net          decimal.Decimal
+	Transactions int
+}
+
+func summarize(items []*Item) totals {
+	t := totals{Gross: decimal.Zero, Refunds: decimal.Zero, Fees: decimal.Zero, Adjustments: decimal.Zero}
+	for _, item := range items {
+		if item.Status != ItemIncluded {
+			continue
+		}
+		switch item.ItemType {
+		case ItemPayment:
+			t.Gross = t.Gross.Add(item.Amount)
+			t.Transactions++
+		case ItemRefund, ItemChargeback:
+			t.Refunds = t.Refunds.Sub(item.Amount)
+			t.Transactions++
+		case ItemFee:
+			t.Fees = t.Fees.Sub(item.Amount)
+		case ItemAdjustment:
+			t.Adjustments = t.Adjustments.Add(item.Amount)
+		}
+	}
+	t.Net = t.Gross.Sub(t.Refunds).Sub(t.Fees).Add(t.Adjustments)
+	return t
+}
@@ -0,0 +1,64 @@
+package settlements
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestChannelOf(t *testing.T) {
+	cases := map[string]string{
+		"mpesa":         ChannelMpesa,
+		"stripe":        ChannelCard,
+		"paypal":        ChannelCard,
+		"bank_transfer": ChannelBank,
+		"cash":          "",
+		"blockchain":    "",
+	}
+	for provider, want := range cases {
+		if got := channelOf(provider); got != want {
+			t.Errorf("channelOf(%q) = %q, want %q", provider, got, want)
+		}
+	}
+}
+
+func TestSettlementFee(t *testing.T) {
+	rate := decimal.RequireFromString("0.015")
+	if got := settlementFee(decimal.RequireFromString("12345.67"), rate); !got.Equal(decimal.RequireFromString("-185.19")) {
+		t.Errorf("fee = %s, want -185.19", got)
+	}
+	if got := settlementFee(decimal.RequireFromString("-50"), rate); !got.IsZero() {
+		t.Errorf("fee on negative gross = %s, want 0", got)
+	}
+	if got := settlementFee(decimal.NewFromInt(1000), decimal.Zero); !got.IsZero() {
+		t.Errorf("fee at zero rate = %s, want 0", got)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	amount := func(v string) decimal.Decimal { return decimal.RequireFromString(v) }
+	items := []*Item{
+		{ItemType: ItemPayment, Amount: amount("5000"), Status: ItemIncluded},
+		{ItemType: ItemPayment, Amount: amount("2500"), Status: ItemIncluded},
+		{ItemType: ItemPayment, Amount: amount("900"), Status: ItemRemoved},
+		{ItemType: ItemRefund, Amount: signed(ItemRefund, amount("300")), Status: ItemIncluded},
+		{ItemType: ItemChargeback, Amount: signed(ItemChargeback, amount("200")), Status: ItemIncluded},
+		{ItemType: ItemFee, Amount: amount("-112.50"), Status: ItemIncluded},
+		{ItemType: ItemAdjustment, Amount: amount("-87.50"), Status: ItemIncluded},
+		{ItemType: ItemAdjustment, Amount: amount("50"), Status: ItemIncluded},
+	}
+
+	got := summarize(items)
+	want := totals{
+		Gross:        amount("7500"),
+		Refunds:      amount("500"),
+		Fees:         amount("112.50"),
+		Adjustments:  amount("-37.50"),
+		Net:          amount("6850"),
+		Transactions: 4,
+	}
+	if !got.Gross.Equal(want.Gross) || !got.Refunds.Equal(want.Refunds) || !got.Fees.Equal(want.Fees) ||
+		!got.Adjustments.Equal(want.Adjustments) || !got.Net.Equal(want.Net) || got.Transactions != want.Transactions {
+		t.Errorf("summarize = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,102 @@
+package settlements
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+)
+
+const (
+	// payoutCurrency is the only currency M-Pesa pays out.
+	payoutCurrency = "KES"
+	// maxPayoutAttempts bounds the times a payout request is sent while
+	// M-Pesa keeps failing with transient errors.
+	maxPayoutAttempts = 8
+	// resultTimeout is how long an accepted payout request may go without a
+	// result before it is failed for investigation.
+	resultTimeout = 2 * time.Hour
+	// duePayoutLimit bounds the payouts sent per worker run.
+	duePayoutLimit = 100
+)
+
+var (
+	phonePattern     = regexp.MustCompile(`^2547\d{8}$|^2541\d{8}$`)
+	shortCodePattern = regexp.MustCompile(`^\d{5,7}$`)
+)
+
+// retryDelay is the backoff before a payout request is sent again after its
+// attempt'th transient failure: 30 seconds doubling up to 30 minutes.
+func retryDelay(attempt int) time.Duration {
+	const (
+		base    = 30 * time.Second
+		ceiling = 30 * time.Minute
+	)
+	if attempt < 1 {
+		return base
+	}
+	if attempt > 7 {
+		return ceiling
+	}
+	return min(base<<(attempt-1), ceiling)
+}
+
+// originatorID is the idempotency key of a disbursement's sequence'th payout
+// request. It is stable across resends of a request so M-Pesa pays it once.
+func originatorID(disbursementID uuid.UUID, sequence int) string {
+	return fmt.Sprintf("%s-%d", disbursementID, sequence)
+}
+
+// validatePayout checks a payout destination: a Safaricom number in 2547…
+// or 2541… form for B2C, a paybill or till number for B2B.
+func validatePayout(method, party, accountReference string) error {
+	switch method {
+	case PayoutMpesaB2C:
+		if !phonePattern.MatchString(party) {
+			return fmt.Errorf("%w: payout_party must be a phone number like 254712345678", ErrInvalidSettings)
+		}
+		if accountReference != "" {
+			return fmt.Errorf("%w: payout_account_reference only applies to mpesa_b2b", ErrInvalidSettings)
+		}
+	case PayoutMpesaB2B:
+		if !shortCodePattern.MatchString(party) {
+			return fmt.Errorf("%w: payout_party must be a paybill or till number", ErrInvalidSettings)
+		}
+		if len(accountReference) > 13 {
+			return fmt.Errorf("%w: payout_account_reference cannot exceed 13 characters", ErrInvalidSettings)
+		}
+	case "":
+		if party != "" || accountReference != "" {
+			return fmt.Errorf("%w: payout_method is required", ErrInvalidSettings)
+		}
+	default:
+		return fmt.Errorf("%w: unknown payout_method %q", ErrInvalidSettings, method)
+	}
+	return nil
+}
+
+// payable checks a batch can be paid out through M-Pesa: in shillings, and in
+// whole shillings since M-Pesa pays no cents.
+func payable(currency string, net decimal.Decimal) error {
+	if currency != payoutCurrency {
+		return fmt.Errorf("%w: M-Pesa pays out %s only, batch is in %s", ErrInvalidBatch, payoutCurrency, currency)
+	}
+	if cents := net.Sub(net.Floor()); !cents.IsZero() {
+		return fmt.Errorf("%w: M-Pesa pays whole shillings; adjust the batch by -%s", ErrInvalidBatch, cents.StringFixed(2))
+	}
+	return nil
+}
+
+// payoutJournalLines books a paid-out batch: the cash received and the
+// settlement fee charged clear the collections held for the tenant.
+func payoutJournalLines(amount, fee decimal.Decimal) []ledger.Line {
+	lines := []ledger.Line{ledger.Debit(ledger.AccountCash, amount)}
+	if fee.IsPositive() {
+		lines = append(lines, ledger.Debit(ledger.AccountProcessingFees, fee))
+	}
+	return append(lines, ledger.Credit(ledger.AccountSettlementClearing, amount.Add(fee)))
+}
@@ -0,0 +1,94 @@
+package settlements
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{4, 4 * time.Minute},
+		{7, 30 * time.Minute},
+		{maxPayoutAttempts, 30 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := retryDelay(tt.attempt); got != tt.want {
+			t.Errorf("attempt %d: expected %s, got %s", tt.attempt, tt.want, got)
+		}
+	}
+}
+
+func TestValidatePayout(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		party            string
+		accountReference string
+		valid            bool
+	}{
+		{"b2c phone", PayoutMpesaB2C, "254712345678", "", true},
+		{"b2c local phone", PayoutMpesaB2C, "0712345678", "", false},
+		{"b2c with account", PayoutMpesaB2C, "254712345678", "ACC1", false},
+		{"b2b paybill", PayoutMpesaB2B, "888880", "ACC1", true},
+		{"b2b till", PayoutMpesaB2B, "5123456", "", true},
+		{"b2b phone", PayoutMpesaB2B, "254712345678", "", false},
+		{"not configured", "", "", "", true},
+		{"party without method", "", "254712345678", "", false},
+		{"unknown method", "bank", "123456", "", false},
+	}
+
+	for _, tt := range tests {
+		err := validatePayout(tt.method, tt.party, tt.accountReference)
+		if tt.valid && err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		}
+		if !tt.valid && !errors.Is(err, ErrInvalidSettings) {
+			t.Errorf("%s: expected ErrInvalidSettings, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestPayable(t *testing.T) {
+	if err := payable("KES", decimal.RequireFromString("1500")); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := payable("KES", decimal.RequireFromString("1500.50")); !errors.Is(err, ErrInvalidBatch) {
+		t.Fatalf("expected ErrInvalidBatch for cents, got %v", err)
+	}
+	if err := payable("USD", decimal.RequireFromString("1500")); !errors.Is(err, ErrInvalidBatch) {
+		t.Fatalf("expected ErrInvalidBatch for USD, got %v", err)
+	}
+}
+
+func TestPayoutJournalLines(t *testing.T) {
+	lines := payoutJournalLines(decimal.RequireFromString("9850"), decimal.RequireFromString("150"))
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d", len(lines))
+	}
+	debits, credits := decimal.Zero, decimal.Zero
+	for _, line := range lines {
+		debits = debits.Add(line.Debit)
+		credits = credits.Add(line.Credit)
+	}
+	if !debits.Equal(credits) || !credits.Equal(decimal.RequireFromString("10000")) {
+		t.Fatalf("expected balanced 10000, got debits %s credits %s", debits, credits)
+	}
+	if lines[2].AccountCode != ledger.AccountSettlementClearing {
+		t.Fatalf("expected clearing credit, got %s", lines[2].AccountCode)
+	}
+
+	if lines := payoutJournalLines(decimal.RequireFromString("500"), decimal.Zero); len(lines) != 2 {
+		t.Fatalf("expected no fee line, got %d lines", len(lines))
+	}
+}
@@ -0,0 +1,214 @@
+package settlements
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/platform/events"
+	"github.com/bengobox/treasury-api/internal/platform/mpesa"
+)
+
+// HandleExecute consumes treasury.settlement.execute, sending the payout
+// request of an approved batch. Redelivered events are harmless: a
+// disbursement already sent, or not due yet, is left alone.
+func (s *Service) HandleExecute(ctx context.Context, env events.Envelope) error {
+	var data struct {
+		DisbursementID uuid.UUID `json:"disbursement_id"`
+	}
+	if err := env.DecodeData(&data); err != nil {
+		return err
+	}
+	if data.DisbursementID == uuid.Nil {
+		return events.Permanent(errors.New("settlement execute event requires disbursement_id"))
+	}
+
+	if err := s.pay(ctx, env.TenantID, data.DisbursementID); err != nil {
+		if errors.Is(err, ErrDisbursementNotFound) {
+			return events.Permanent(err)
+		}
+		return err
+	}
+	return nil
+}
+
+// RunPayouts resends payout requests whose backoff has elapsed and fails
+// accepted requests that got no result in time.
+func (s *Service) RunPayouts(ctx context.Context) error {
+	now := time.Now().UTC()
+
+	due, err := s.repo.DueDisbursements(ctx, now, duePayoutLimit)
+	if err != nil {
+		return err
+	}
+	for _, disbursement := range due {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := s.pay(ctx, disbursement.TenantID, disbursement.ID); err != nil {
+			s.logger.Warn("settlement payout attempt failed",
+				zap.String("tenant_id", disbursement.TenantID.String()),
+				zap.String("disbursement_id", disbursement.ID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+
+	stale, err := s.repo.StaleDisbursements(ctx, now.Add(-resultTimeout))
+	if err != nil {
+		return err
+	}
+	for _, disbursement := range stale {
+		if err := s.fail(ctx, disbursement, ReasonResultTimeout, "No result received from M-Pesa"); err != nil {
+			s.logger.Warn("settlement payout timeout failed",
+				zap.String("tenant_id", disbursement.TenantID.String()),
+				zap.String("disbursement_id", disbursement.ID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return nil
+}
+
+// pay claims a due disbursement and sends its payout request. Transient
+// errors leave it pending for a resend after the backoff, until the attempts
+// run out; M-Pesa refusing the request fails it.
+func (s *Service) pay(ctx context.Context, tenantID, disbursementID uuid.UUID) error {
+	disbursement, err := s.repo.ClaimDisbursement(ctx, tenantID, disbursementID, time.Now().UTC())
+	if err != nil {
+		if errors.Is(err, ErrDisbursementStatus) {
+			return nil
+		}
+		return err
+	}
+
+	send := s.payer.B2C
+	if disbursement.Method == PayoutMpesaB2B {
+		send = s.payer.B2B
+	}
+	resp, err := send(ctx, mpesa.Payment{
+		OriginatorConversationID: disbursement.OriginatorConversationID,
+		Amount:                   disbursement.Amount,
+		PartyB:                   disbursement.Party,
+		AccountReference:         disbursement.AccountReference,
+		Remarks:                  "Settlement payout",
+	})
+
+	switch {
+	case err == nil:
+		if err := s.repo.MarkSubmitted(ctx, disbursement.ID, resp.ConversationID, time.Now().UTC()); err != nil {
+			return err
+		}
+		s.logger.Info("settlement payout submitted",
+			zap.String("tenant_id", tenantID.String()),
+			zap.String("batch_id", disbursement.BatchID.String()),
+			zap.String("disbursement_id", disbursement.ID.String()),
+			zap.String("originator_conversation_id", disbursement.OriginatorConversationID),
+			zap.String("amount", disbursement.Amount.String()),
+		)
+		return nil
+	case mpesa.IsTransient(err) && disbursement.Attempts < maxPayoutAttempts:
+		s.logger.Warn("settlement payout attempt failed, retry scheduled",
+			zap.String("tenant_id", tenantID.String()),
+			zap.String("disbursement_id", disbursement.ID.String()),
+			zap.Int("attempt", disbursement.Attempts),
+			zap.Duration("retry_in", retryDelay(disbursement.Attempts)),
+			zap.Error(err),
+		)
+		return s.repo.RecordAttemptError(ctx, disbursement.ID, err.Error())
+	case mpesa.IsTransient(err):
+		return s.fail(ctx, disbursement, ReasonRetriesExhausted, err.Error())
+	default:
+		return s.fail(ctx, disbursement, ReasonRequestRejected, err.Error())
+	}
+}
+
+func (s *Service) fail(ctx context.Context, disbursement *Disbursement, reason, description string) error {
+	failed, err := s.repo.FailDisbursement(ctx, disbursement.ID, reason, description, time.Now().UTC())
+	if err != nil {
+		if errors.Is(err, ErrDisbursementStatus) {
+			return nil
+		}
+		return err
+	}
+
+	s.logger.Warn("settlement payout failed",
+		zap.String("tenant_id", failed.TenantID.String()),
+		zap.String("batch_id", failed.BatchID.String()),
+		zap.String("disbursement_id", failed.ID.String()),
+		zap.String("reason", reason),
+		zap.String("description", description),
+	)
+	return nil
+}
+
+// HandleResult applies the result M-Pesa posts for a payout request.
+func (s *Service) HandleResult(ctx context.Context, result PayoutResult) error {
+	disbursement, err := s.repo.ResolveDisbursement(ctx, result, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("settlement payout result received",
+		zap.String("tenant_id", disbursement.TenantID.String()),
+		zap.String("batch_id", disbursement.BatchID.String()),
+		zap.String("disbursement_id", disbursement.ID.String()),
+		zap.String("status", disbursement.Status),
+		zap.String("result_code", result.ResultCode),
+		zap.String("transaction_id", result.TransactionID),
+	)
+	return nil
+}
+
+// HandleQueueTimeout replaces a payout request that timed out in M-Pesa's
+// queue, and so was never processed, with a new request sent on the next
+// worker run.
+func (s *Service) HandleQueueTimeout(ctx context.Context, originatorConversationID string) error {
+	disbursement, err := s.repo.RequeueDisbursement(ctx, originatorConversationID, time.Now().UTC())
+	if err != nil {
+		if errors.Is(err, ErrDisbursementStatus) {
+			return nil
+		}
+		return err
+	}
+
+	s.logger.Warn("settlement payout timed out in the M-Pesa queue, requeued",
+		zap.String("tenant_id", disbursement.TenantID.String()),
+		zap.String("disbursement_id", disbursement.ID.String()),
+		zap.String("originator_conversation_id", disbursement.OriginatorConversationID),
+	)
+	return nil
+}
+
+// RetryDisbursement sends a failed payout again under a new request. A payout
+// failed with result_timeout may have been made and must be checked with
+// M-Pesa first.
+func (s *Service) RetryDisbursement(ctx context.Context, tenantID, disbursementID uuid.UUID) (*Disbursement, error) {
+	disbursement, err := s.repo.RetryDisbursement(ctx, tenantID, disbursementID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("settlement payout retried",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("batch_id", disbursement.BatchID.String()),
+		zap.String("disbursement_id", disbursement.ID.String()),
+		zap.Int("sequence", disbursement.Sequence),
+	)
+	return disbursement, nil
+}
+
+// GetDisbursement retrieves a settlement disbursement.
+func (s *Service) GetDisbursement(ctx context.Context, tenantID, disbursementID uuid.UUID) (*Disbursement, error) {
+	return s.repo.GetDisbursement(ctx, tenantID, disbursementID)
+}
+
+// ListDisbursements returns the tenant's disbursements; filtering by the
+// failed status gives the queue of payouts needing attention.
+func (s *Service) ListDisbursements(ctx context.Context, tenantID uuid.UUID, filter DisbursementFilter) ([]*Disbursement, error) {
+	return s.repo.ListDisbursements(ctx, tenantID, filter)
+}
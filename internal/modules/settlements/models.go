@@ -0,0 +1,260 @@
+package settlements
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Settlement batch statuses, per docs/settlement-flows.md.
+const (
+	StatusDraft           = "draft"
+	StatusPendingApproval = "pending_approval"
+	StatusApproved        = "approved"
+	StatusProcessing      = "processing"
+	StatusCompleted       = "completed"
+	StatusFailed          = "failed"
+)
+
+// Settlement item types.
+const (
+	ItemPayment    = "payment"
+	ItemRefund     = "refund"
+	ItemChargeback = "chargeback"
+	ItemFee        = "fee"
+	ItemAdjustment = "adjustment"
+)
+
+// Settlement item statuses.
+const (
+	ItemIncluded = "included"
+	ItemRemoved  = "removed"
+)
+
+// Collection channels a tenant is settled for.
+const (
+	ChannelMpesa = "mpesa"
+	ChannelCard  = "card"
+	ChannelBank  = "bank"
+)
+
+// channelProviders lists the payment transaction providers collected through
+// each channel. Cash is banked by the tenant and never settled.
+var channelProviders = map[string][]string{
+	ChannelMpesa: {"mpesa"},
+	ChannelCard:  {"stripe", "paypal"},
+	ChannelBank:  {"bank_transfer"},
+}
+
+// Payout methods a tenant's settlements are disbursed with.
+const (
+	PayoutMpesaB2C = "mpesa_b2c"
+	PayoutMpesaB2B = "mpesa_b2b"
+)
+
+// Disbursement statuses.
+const (
+	DisbursementPending   = "pending"
+	DisbursementSubmitted = "submitted"
+	DisbursementSucceeded = "succeeded"
+	DisbursementFailed    = "failed"
+)
+
+// Reason codes of failed disbursements.
+const (
+	// ReasonRequestRejected: M-Pesa refused the payout request.
+	ReasonRequestRejected = "request_rejected"
+	// ReasonRetriesExhausted: the request kept failing with transient errors.
+	ReasonRetriesExhausted = "retries_exhausted"
+	// ReasonPayoutDeclined: M-Pesa accepted the request but the payout
+	// failed; see the result code.
+	ReasonPayoutDeclined = "payout_declined"
+	// ReasonResultTimeout: no result arrived for an accepted request. The
+	// payout may have been made and must be checked before retrying.
+	ReasonResultTimeout = "result_timeout"
+)
+
+// Settlement events.
+const (
+	// EventSettlementGenerated is published for each batch the aggregation
+	// creates.
+	EventSettlementGenerated = "treasury.settlement.generated"
+	// EventSettlementExecute asks the payout executor to disburse an
+	// approved batch.
+	EventSettlementExecute = "treasury.settlement.execute"
+	// EventSettlementCompleted is published when a batch was paid out.
+	EventSettlementCompleted = "treasury.settlement.completed"
+	// EventSettlementFailed is published when a batch's payout failed and
+	// needs attention.
+	EventSettlementFailed = "treasury.settlement.failed"
+)
+
+// Batch is the funds collected for a tenant through one channel in a
+// settlement window, less refunds, fees and adjustments.
+type Batch struct {
+	ID               uuid.UUID       `json:"id"`
+	TenantID         uuid.UUID       `json:"tenant_id"`
+	BatchNumber      string          `json:"batch_number"`
+	Channel          string          `json:"channel"` // mpesa, card, bank
+	Currency         string          `json:"currency"`
+	WindowStart      time.Time       `json:"window_start"`
+	WindowEnd        time.Time       `json:"window_end"`
+	Status           string          `json:"status"` // draft, pending_approval, approved, processing, completed, failed
+	FeeRate          decimal.Decimal `json:"fee_rate"`
+	GrossAmount      decimal.Decimal `json:"gross_amount"`
+	RefundAmount     decimal.Decimal `json:"refund_amount"`
+	FeeAmount        decimal.Decimal `json:"fee_amount"`
+	AdjustmentAmount decimal.Decimal `json:"adjustment_amount"`
+	NetAmount        decimal.Decimal `json:"net_amount"`
+	TransactionCount int             `json:"transaction_count"`
+	CreatedBy        *uuid.UUID      `json:"created_by,omitempty"`
+	SubmittedBy      *uuid.UUID      `json:"submitted_by,omitempty"`
+	SubmittedAt      *time.Time      `json:"submitted_at,omitempty"`
+	ApprovedBy       *uuid.UUID      `json:"approved_by,omitempty"`
+	ApprovedAt       *time.Time      `json:"approved_at,omitempty"`
+	RejectionReason  string          `json:"rejection_reason,omitempty"`
+	Items            []*Item         `json:"items,omitempty"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+}
+
+// Item is a line of a settlement batch. Amounts are signed by their effect
+// on the amount disbursed: payments are positive, refunds, chargebacks and
+// the fee negative.
+type Item struct {
+	ID                   uuid.UUID       `json:"id"`
+	ItemType             string          `json:"item_type"` // payment, refund, chargeback, fee, adjustment
+	PaymentTransactionID *uuid.UUID      `json:"payment_transaction_id,omitempty"`
+	ProviderReference    string          `json:"provider_reference,omitempty"`
+	TransactionDate      *time.Time      `json:"transaction_date,omitempty"`
+	Amount               decimal.Decimal `json:"amount"`
+	Description          string          `json:"description,omitempty"`
+	Status               string          `json:"status"` // included, removed
+	CreatedBy            *uuid.UUID      `json:"created_by,omitempty"`
+	CreatedAt            time.Time       `json:"created_at"`
+}
+
+// GenerateRequest aggregates a tenant's collections for the settlement
+// window ending on WindowEnd (exclusive), which defaults to the start of
+// today. Each window covers one day.
+type GenerateRequest struct {
+	WindowEnd *time.Time `json:"window_end"`
+}
+
+// AdjustmentRequest adds a signed adjustment line to a batch under review:
+// a positive amount adds to the disbursement, a negative one deducts.
+type AdjustmentRequest struct {
+	Amount      decimal.Decimal `json:"amount"`
+	Description string          `json:"description"`
+}
+
+// RejectRequest sends a batch under review back to draft.
+type RejectRequest struct {
+	Reason string `json:"reason"`
+}
+
+// Disbursement is the M-Pesa payout of an approved batch.
+type Disbursement struct {
+	ID                       uuid.UUID       `json:"id"`
+	TenantID                 uuid.UUID       `json:"tenant_id"`
+	BatchID                  uuid.UUID       `json:"batch_id"`
+	Method                   string          `json:"method"` // mpesa_b2c, mpesa_b2b
+	Party                    string          `json:"party"`
+	AccountReference         string          `json:"account_reference,omitempty"`
+	Amount                   decimal.Decimal `json:"amount"`
+	Currency                 string          `json:"currency"`
+	Sequence                 int             `json:"sequence"`
+	OriginatorConversationID string          `json:"originator_conversation_id"`
+	ConversationID           string          `json:"conversation_id,omitempty"`
+	Status                   string          `json:"status"` // pending, submitted, succeeded, failed
+	Attempts                 int             `json:"attempts"`
+	NextAttemptAt            *time.Time      `json:"next_attempt_at,omitempty"`
+	ResultCode               string          `json:"result_code,omitempty"`
+	ResultDescription        string          `json:"result_description,omitempty"`
+	FailureReason            string          `json:"failure_reason,omitempty"`
+	TransactionID            string          `json:"transaction_id,omitempty"`
+	JournalEntryID           *uuid.UUID      `json:"journal_entry_id,omitempty"`
+	SubmittedAt              *time.Time      `json:"submitted_at,omitempty"`
+	CompletedAt              *time.Time      `json:"completed_at,omitempty"`
+	CreatedAt                time.Time       `json:"created_at"`
+	UpdatedAt                time.Time       `json:"updated_at"`
+}
+
+// PayoutResult is M-Pesa's outcome of a payout request, posted to the result
+// callback.
+type PayoutResult struct {
+	OriginatorConversationID string
+	ConversationID           string
+	ResultCode               string
+	ResultDescription        string
+	TransactionID            string
+}
+
+// Succeeded reports whether the payout was made.
+func (r PayoutResult) Succeeded() bool {
+	return r.ResultCode == "0"
+}
+
+// Settings are a tenant's settlement fees, fractions of each channel's gross
+// collections deducted when the batch is generated, and where approved
+// batches are paid out.
+type Settings struct {
+	TenantID               uuid.UUID       `json:"tenant_id"`
+	MpesaFeeRate           decimal.Decimal `json:"mpesa_fee_rate"`
+	CardFeeRate            decimal.Decimal `json:"card_fee_rate"`
+	BankFeeRate            decimal.Decimal `json:"bank_fee_rate"`
+	PayoutMethod           string          `json:"payout_method,omitempty"` // mpesa_b2c, mpesa_b2b
+	PayoutParty            string          `json:"payout_party,omitempty"`
+	PayoutAccountReference string          `json:"payout_account_reference,omitempty"`
+	UpdatedAt              *time.Time      `json:"updated_at,omitempty"`
+}
+
+// DefaultSettings are used until a tenant saves its own: no settlement fees
+// and no payout destination, so batches cannot be approved.
+func DefaultSettings(tenantID uuid.UUID) *Settings {
+	return &Settings{
+		TenantID:     tenantID,
+		MpesaFeeRate: decimal.Zero,
+		CardFeeRate:  decimal.Zero,
+		BankFeeRate:  decimal.Zero,
+	}
+}
+
+// FeeRate returns the fee rate of the channel.
+func (s *Settings) FeeRate(channel string) decimal.Decimal {
+	switch channel {
+	case ChannelMpesa:
+		return s.MpesaFeeRate
+	case ChannelCard:
+		return s.CardFeeRate
+	case ChannelBank:
+		return s.BankFeeRate
+	}
+	return decimal.Zero
+}
+
+// UpdateSettingsRequest changes the provided settings fields.
+type UpdateSettingsRequest struct {
+	MpesaFeeRate           *decimal.Decimal `json:"mpesa_fee_rate"`
+	CardFeeRate            *decimal.Decimal `json:"card_fee_rate"`
+	BankFeeRate            *decimal.Decimal `json:"bank_fee_rate"`
+	PayoutMethod           *string          `json:"payout_method"`
+	PayoutParty            *string          `json:"payout_party"`
+	PayoutAccountReference *string          `json:"payout_account_reference"`
+}
+
+// ListFilter narrows settlement batch listings.
+type ListFilter struct {
+	Status  string
+	Channel string
+	Limit   int
+	Offset  int
+}
+
+// DisbursementFilter narrows disbursement listings.
+type DisbursementFilter struct {
+	Status string
+	Limit  int
+	Offset int
+}
@@ -0,0 +1,92 @@
+package settlements
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrBatchNotFound is returned when a settlement batch does not exist for the tenant.
+	ErrBatchNotFound = errors.New("settlement batch not found")
+	// ErrItemNotFound is returned when an item is not part of the settlement batch.
+	ErrItemNotFound = errors.New("settlement item not found")
+	// ErrInvalidBatch is returned when a settlement request is malformed.
+	ErrInvalidBatch = errors.New("invalid settlement request")
+	// ErrInvalidSettings is returned when settlement settings are out of range.
+	ErrInvalidSettings = errors.New("invalid settlement settings")
+	// ErrBatchExists is returned when another generation already batched the
+	// window or one of its transactions.
+	ErrBatchExists = errors.New("settlement batch already generated")
+	// ErrBatchStatus is returned when the batch's status does not allow the action.
+	ErrBatchStatus = errors.New("settlement batch status does not allow this action")
+	// ErrSelfApproval is returned when the user who submitted a batch tries to approve it.
+	ErrSelfApproval = errors.New("settlement batch must be approved by another user")
+	// ErrNothingToSettle is returned when a batch's net amount is not positive.
+	ErrNothingToSettle = errors.New("settlement batch has nothing to disburse")
+	// ErrDisbursementNotFound is returned when a settlement disbursement does not exist.
+	ErrDisbursementNotFound = errors.New("settlement disbursement not found")
+	// ErrDisbursementStatus is returned when the disbursement's status does not allow the action.
+	ErrDisbursementStatus = errors.New("settlement disbursement status does not allow this action")
+)
+
+// Repository abstracts persistence for settlement batches.
+type Repository interface {
+	// Generate batches the tenant's unsettled collections processed before
+	// windowEnd per channel and currency, skipping channels already batched
+	// for the window, and enqueues an event per batch created.
+	Generate(ctx context.Context, tenantID uuid.UUID, windowStart, windowEnd time.Time, settings *Settings, createdBy *uuid.UUID) ([]*Batch, error)
+	// SettlementTenants lists the tenants with unsettled collections
+	// processed before windowEnd.
+	SettlementTenants(ctx context.Context, windowEnd time.Time) ([]uuid.UUID, error)
+	Get(ctx context.Context, tenantID, batchID uuid.UUID) (*Batch, error)
+	List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Batch, error)
+	// AddAdjustment adds an adjustment line to a draft or pending batch.
+	AddAdjustment(ctx context.Context, tenantID, batchID uuid.UUID, item *Item) (*Batch, error)
+	// RemoveItem drops a transaction or adjustment from a draft or pending
+	// batch; a removed transaction is settled in a later batch.
+	RemoveItem(ctx context.Context, tenantID, batchID, itemID uuid.UUID) (*Batch, error)
+	// Submit confirms a draft batch's funding and sends it for approval.
+	Submit(ctx context.Context, tenantID, batchID uuid.UUID, submittedBy *uuid.UUID, now time.Time) (*Batch, error)
+	// Approve approves a pending batch and creates its disbursement to the
+	// settings' payout destination, enqueueing it for execution.
+	Approve(ctx context.Context, tenantID, batchID uuid.UUID, settings *Settings, approvedBy *uuid.UUID, now time.Time) (*Batch, error)
+	// Reject sends a pending batch back to draft.
+	Reject(ctx context.Context, tenantID, batchID uuid.UUID, reason string) (*Batch, error)
+
+	// ClaimDisbursement takes a pending disbursement that is due for its next
+	// payout attempt, counting the attempt and scheduling the one after, and
+	// moves its batch to processing. It returns ErrDisbursementStatus when the
+	// disbursement is not pending or not due.
+	ClaimDisbursement(ctx context.Context, tenantID, disbursementID uuid.UUID, now time.Time) (*Disbursement, error)
+	// DueDisbursements lists pending disbursements due for an attempt.
+	DueDisbursements(ctx context.Context, now time.Time, limit int) ([]*Disbursement, error)
+	// StaleDisbursements lists submitted disbursements without a result since
+	// before submittedBefore.
+	StaleDisbursements(ctx context.Context, submittedBefore time.Time) ([]*Disbursement, error)
+	// MarkSubmitted records M-Pesa's acceptance of the payout request.
+	MarkSubmitted(ctx context.Context, disbursementID uuid.UUID, conversationID string, now time.Time) error
+	// RecordAttemptError records a transient failure of a payout attempt.
+	RecordAttemptError(ctx context.Context, disbursementID uuid.UUID, description string) error
+	// FailDisbursement fails an unfinished disbursement and its batch with a
+	// reason code and enqueues treasury.settlement.failed.
+	FailDisbursement(ctx context.Context, disbursementID uuid.UUID, reason, description string, now time.Time) (*Disbursement, error)
+	// ResolveDisbursement applies M-Pesa's result to the disbursement of the
+	// request: a success posts the payout to the ledger and completes the
+	// batch, a failure fails both. A result for a finished disbursement is
+	// ignored.
+	ResolveDisbursement(ctx context.Context, result PayoutResult, now time.Time) (*Disbursement, error)
+	// RequeueDisbursement replaces a payout request that timed out in M-Pesa's
+	// queue, and so was never processed, with a new request due now.
+	RequeueDisbursement(ctx context.Context, originatorConversationID string, now time.Time) (*Disbursement, error)
+	// RetryDisbursement replaces a failed disbursement's request with a new
+	// one, returns its batch to processing and enqueues it for execution.
+	RetryDisbursement(ctx context.Context, tenantID, disbursementID uuid.UUID, now time.Time) (*Disbursement, error)
+	GetDisbursement(ctx context.Context, tenantID, disbursementID uuid.UUID) (*Disbursement, error)
+	ListDisbursements(ctx context.Context, tenantID uuid.UUID, filter DisbursementFilter) ([]*Disbursement, error)
+
+	GetSettings(ctx context.Context, tenantID uuid.UUID) (*Settings, error)
+	SaveSettings(ctx context.Context, settings *Settings) (*Settings, error)
+}
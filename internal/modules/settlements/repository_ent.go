@@ -0,0 +1,1188 @@
+package settlements
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/paymenttransaction"
+	"github.com/bengobox/treasury-api/internal/ent/predicate"
+	"github.com/bengobox/treasury-api/internal/ent/settlementbatch"
+	"github.com/bengobox/treasury-api/internal/ent/settlementdisbursement"
+	"github.com/bengobox/treasury-api/internal/ent/settlementitem"
+	"github.com/bengobox/treasury-api/internal/ent/settlementsetting"
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+	"github.com/bengobox/treasury-api/internal/modules/numbering"
+	"github.com/bengobox/treasury-api/internal/modules/outbox"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+)
+
+// carryOver is how far before a window unsettled collections are picked up:
+// transactions processed late, or removed from an earlier batch, are settled
+// in the next window.
+const carryOver = 30 * 24 * time.Hour
+
+// lookupBatchSize bounds the transaction identifiers looked up per query.
+const lookupBatchSize = 1000
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+type batchKey struct {
+	channel  string
+	currency string
+}
+
+// Generate batches the tenant's unsettled collections per channel and
+// currency, deducts the channel's settlement fee and enqueues
+// treasury.settlement.generated for each batch created.
+func (r *EntRepository) Generate(ctx context.Context, tenantID uuid.UUID, windowStart, windowEnd time.Time, settings *Settings, createdBy *uuid.UUID) ([]*Batch, error) {
+	var batchIDs []uuid.UUID
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		payments, err := unsettled(ctx, tx.Client(), tenantID, windowEnd)
+		if err != nil {
+			return err
+		}
+
+		groups := make(map[batchKey][]*ent.PaymentTransaction)
+		for _, payment := range payments {
+			key := batchKey{channel: channelOf(payment.Provider), currency: payment.Currency}
+			groups[key] = append(groups[key], payment)
+		}
+		keys := make([]batchKey, 0, len(groups))
+		for key := range groups {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			if keys[i].channel != keys[j].channel {
+				return keys[i].channel < keys[j].channel
+			}
+			return keys[i].currency < keys[j].currency
+		})
+
+		for _, key := range keys {
+			exists, err := tx.SettlementBatch.Query().
+				Where(
+					settlementbatch.TenantID(tenantID),
+					settlementbatch.Channel(key.channel),
+					settlementbatch.Currency(key.currency),
+					settlementbatch.WindowEnd(windowEnd),
+				).
+				Exist(ctx)
+			if err != nil {
+				return fmt.Errorf("check settlement batch: %w", err)
+			}
+			if exists {
+				continue
+			}
+
+			batchID, err := createBatch(ctx, tx, tenantID, key, windowStart, windowEnd, settings.FeeRate(key.channel), groups[key], createdBy)
+			if err != nil {
+				return err
+			}
+			batchIDs = append(batchIDs, batchID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	batches := make([]*Batch, len(batchIDs))
+	for i, batchID := range batchIDs {
+		batch, err := r.Get(ctx, tenantID, batchID)
+		if err != nil {
+			return nil, err
+		}
+		batches[i] = batch
+	}
+	return batches, nil
+}
+
+func createBatch(ctx context.Context, tx *ent.Tx, tenantID uuid.UUID, key batchKey, windowStart, windowEnd time.Time, feeRate decimal.Decimal, payments []*ent.PaymentTransaction, createdBy *uuid.UUID) (uuid.UUID, error) {
+	items := make([]*Item, len(payments))
+	for i, payment := range payments {
+		id, date := payment.ID, payment.ProcessedAt
+		items[i] = &Item{
+			ItemType:             payment.TransactionType,
+			PaymentTransactionID: &id,
+			ProviderReference:    payment.ProviderReference,
+			TransactionDate:      &date,
+			Amount:               signed(payment.TransactionType, payment.Amount),
+			Description:          fmt.Sprintf("%s %s", payment.Provider, payment.TransactionType),
+			Status:               ItemIncluded,
+		}
+	}
+	if fee := settlementFee(summarize(items).Gross, feeRate); !fee.IsZero() {
+		items = append(items, &Item{
+			ItemType:    ItemFee,
+			Amount:      fee,
+			Description: feeDescription(feeRate),
+			Status:      ItemIncluded,
+		})
+	}
+	t := summarize(items)
+
+	number, err := numbering.Next(ctx, tx, tenantID, numbering.SequenceSettlementBatch, numbering.PrefixSettlementBatch)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	entBatch, err := tx.SettlementBatch.Create().
+		SetTenantID(tenantID).
+		SetBatchNumber(number).
+		SetChannel(key.channel).
+		SetCurrency(key.currency).
+		SetWindowStart(windowStart).
+		SetWindowEnd(windowEnd).
+		SetStatus(StatusDraft).
+		SetFeeRate(feeRate).
+		SetGrossAmount(t.Gross).
+		SetRefundAmount(t.Refunds).
+		SetFeeAmount(t.Fees).
+		SetAdjustmentAmount(t.Adjustments).
+		SetNetAmount(t.Net).
+		SetTransactionCount(t.Transactions).
+		SetNillableCreatedBy(createdBy).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return uuid.Nil, fmt.Errorf("%w: %s %s for the window", ErrBatchExists, key.channel, key.currency)
+		}
+		return uuid.Nil, fmt.Errorf("create settlement batch: %w", err)
+	}
+
+	builders := make([]*ent.SettlementItemCreate, len(items))
+	for i, item := range items {
+		builders[i] = tx.SettlementItem.Create().
+			SetTenantID(tenantID).
+			SetBatch(entBatch).
+			SetItemType(item.ItemType).
+			SetNillablePaymentTransactionID(item.PaymentTransactionID).
+			SetProviderReference(item.ProviderReference).
+			SetNillableTransactionDate(item.TransactionDate).
+			SetAmount(item.Amount).
+			SetDescription(item.Description).
+			SetStatus(ItemIncluded)
+	}
+	if _, err := tx.SettlementItem.CreateBulk(builders...).Save(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return uuid.Nil, fmt.Errorf("%w: a transaction was settled in another batch", ErrBatchExists)
+		}
+		return uuid.Nil, fmt.Errorf("create settlement items: %w", err)
+	}
+
+	if err := outbox.Enqueue(ctx, tx, outbox.Event{
+		TenantID:      tenantID,
+		AggregateType: "settlement_batch",
+		AggregateID:   entBatch.ID,
+		EventType:     EventSettlementGenerated,
+		Payload:       generatedPayload(entBatch),
+	}); err != nil {
+		return uuid.Nil, err
+	}
+	return entBatch.ID, nil
+}
+
+// SettlementTenants lists the tenants with collections processed in the
+// carry-over period before windowEnd.
+func (r *EntRepository) SettlementTenants(ctx context.Context, windowEnd time.Time) ([]uuid.UUID, error) {
+	var rows []struct {
+		TenantID uuid.UUID `json:"tenant_id"`
+	}
+	err := r.client.PaymentTransaction.Query().
+		Where(collected(windowEnd)...).
+		Unique(true).
+		Select(paymenttransaction.FieldTenantID).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("list settlement tenants: %w", err)
+	}
+
+	tenantIDs := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		tenantIDs[i] = row.TenantID
+	}
+	return tenantIDs, nil
+}
+
+// Get retrieves a settlement batch with its items.
+func (r *EntRepository) Get(ctx context.Context, tenantID, batchID uuid.UUID) (*Batch, error) {
+	entBatch, err := r.client.SettlementBatch.Query().
+		Where(
+			settlementbatch.ID(batchID),
+			settlementbatch.TenantID(tenantID),
+		).
+		WithItems(func(q *ent.SettlementItemQuery) {
+			q.Order(ent.Asc(settlementitem.FieldCreatedAt), ent.Asc(settlementitem.FieldTransactionDate))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrBatchNotFound
+		}
+		return nil, fmt.Errorf("get settlement batch: %w", err)
+	}
+
+	return mapEntBatch(entBatch), nil
+}
+
+// List returns the tenant's settlement batches, newest window first.
+func (r *EntRepository) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Batch, error) {
+	query := r.client.SettlementBatch.Query().
+		Where(settlementbatch.TenantID(tenantID))
+	if filter.Status != "" {
+		query = query.Where(settlementbatch.Status(filter.Status))
+	}
+	if filter.Channel != "" {
+		query = query.Where(settlementbatch.Channel(filter.Channel))
+	}
+
+	entBatches, err := query.
+		Order(ent.Desc(settlementbatch.FieldWindowEnd), ent.Desc(settlementbatch.FieldBatchNumber)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list settlement batches: %w", err)
+	}
+
+	batches := make([]*Batch, len(entBatches))
+	for i, entBatch := range entBatches {
+		batches[i] = mapEntBatch(entBatch)
+	}
+	return batches, nil
+}
+
+// AddAdjustment adds an adjustment line to a draft or pending batch and
+// updates its totals.
+func (r *EntRepository) AddAdjustment(ctx context.Context, tenantID, batchID uuid.UUID, item *Item) (*Batch, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entBatch, err := lockBatch(ctx, tx, tenantID, batchID, StatusDraft, StatusPendingApproval)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.SettlementItem.Create().
+			SetTenantID(tenantID).
+			SetBatch(entBatch).
+			SetItemType(ItemAdjustment).
+			SetAmount(item.Amount).
+			SetDescription(item.Description).
+			SetStatus(ItemIncluded).
+			SetNillableCreatedBy(item.CreatedBy).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("add settlement adjustment: %w", err)
+		}
+
+		return updateTotals(ctx, tx, entBatch)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, tenantID, batchID)
+}
+
+// RemoveItem drops a transaction or adjustment from a draft or pending batch
+// and recalculates its fee and totals. The fee line itself cannot be removed.
+func (r *EntRepository) RemoveItem(ctx context.Context, tenantID, batchID, itemID uuid.UUID) (*Batch, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entBatch, err := lockBatch(ctx, tx, tenantID, batchID, StatusDraft, StatusPendingApproval)
+		if err != nil {
+			return err
+		}
+
+		removed, err := tx.SettlementItem.Update().
+			Where(
+				settlementitem.ID(itemID),
+				settlementitem.BatchID(entBatch.ID),
+				settlementitem.ItemTypeNEQ(ItemFee),
+				settlementitem.Status(ItemIncluded),
+			).
+			SetStatus(ItemRemoved).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("remove settlement item: %w", err)
+		}
+		if removed == 0 {
+			return ErrItemNotFound
+		}
+
+		return updateTotals(ctx, tx, entBatch)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, tenantID, batchID)
+}
+
+// Submit confirms a draft batch's funding and sends it for approval.
+func (r *EntRepository) Submit(ctx context.Context, tenantID, batchID uuid.UUID, submittedBy *uuid.UUID, now time.Time) (*Batch, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entBatch, err := lockBatch(ctx, tx, tenantID, batchID, StatusDraft)
+		if err != nil {
+			return err
+		}
+
+		builder := entBatch.Update().
+			SetStatus(StatusPendingApproval).
+			SetSubmittedAt(now).
+			ClearRejectionReason()
+		if submittedBy != nil {
+			builder.SetSubmittedBy(*submittedBy)
+		}
+		if err := builder.Exec(ctx); err != nil {
+			return fmt.Errorf("submit settlement batch: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, tenantID, batchID)
+}
+
+// Approve approves a pending batch, creates its disbursement to the tenant's
+// payout destination and enqueues treasury.settlement.execute for it.
+func (r *EntRepository) Approve(ctx context.Context, tenantID, batchID uuid.UUID, settings *Settings, approvedBy *uuid.UUID, now time.Time) (*Batch, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entBatch, err := lockBatch(ctx, tx, tenantID, batchID, StatusPendingApproval)
+		if err != nil {
+			return err
+		}
+		if approvedBy != nil && entBatch.SubmittedBy == *approvedBy {
+			return ErrSelfApproval
+		}
+		if !entBatch.NetAmount.IsPositive() {
+			return fmt.Errorf("%w: net amount is %s", ErrNothingToSettle, entBatch.NetAmount)
+		}
+		if err := payable(entBatch.Currency, entBatch.NetAmount); err != nil {
+			return err
+		}
+
+		builder := entBatch.Update().
+			SetStatus(StatusApproved).
+			SetApprovedAt(now)
+		if approvedBy != nil {
+			builder.SetApprovedBy(*approvedBy)
+		}
+		if err := builder.Exec(ctx); err != nil {
+			return fmt.Errorf("approve settlement batch: %w", err)
+		}
+
+		disbursementID := uuid.New()
+		entDisbursement, err := tx.SettlementDisbursement.Create().
+			SetID(disbursementID).
+			SetTenantID(tenantID).
+			SetBatchID(entBatch.ID).
+			SetMethod(settings.PayoutMethod).
+			SetParty(settings.PayoutParty).
+			SetAccountReference(settings.PayoutAccountReference).
+			SetAmount(entBatch.NetAmount).
+			SetCurrency(entBatch.Currency).
+			SetSequence(1).
+			SetOriginatorConversationID(originatorID(disbursementID, 1)).
+			SetStatus(DisbursementPending).
+			SetNextAttemptAt(now).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create settlement disbursement: %w", err)
+		}
+		return enqueueExecute(ctx, tx, entDisbursement)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, tenantID, batchID)
+}
+
+// Reject sends a pending batch back to draft with the reviewer's reason.
+func (r *EntRepository) Reject(ctx context.Context, tenantID, batchID uuid.UUID, reason string) (*Batch, error) {
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entBatch, err := lockBatch(ctx, tx, tenantID, batchID, StatusPendingApproval)
+		if err != nil {
+			return err
+		}
+
+		if err := entBatch.Update().
+			SetStatus(StatusDraft).
+			SetRejectionReason(reason).
+			ClearSubmittedBy().
+			ClearSubmittedAt().
+			Exec(ctx); err != nil {
+			return fmt.Errorf("reject settlement batch: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(ctx, tenantID, batchID)
+}
+
+// GetSettings returns the tenant's settings, or the defaults if none were saved.
+func (r *EntRepository) GetSettings(ctx context.Context, tenantID uuid.UUID) (*Settings, error) {
+	entSettings, err := r.client.SettlementSetting.Query().
+		Where(settlementsetting.TenantID(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DefaultSettings(tenantID), nil
+		}
+		return nil, fmt.Errorf("get settlement settings: %w", err)
+	}
+
+	return mapEntSettings(entSettings), nil
+}
+
+// SaveSettings creates or replaces the tenant's settings.
+func (r *EntRepository) SaveSettings(ctx context.Context, settings *Settings) (*Settings, error) {
+	id, err := r.client.SettlementSetting.Create().
+		SetTenantID(settings.TenantID).
+		SetMpesaFeeRate(settings.MpesaFeeRate).
+		SetCardFeeRate(settings.CardFeeRate).
+		SetBankFeeRate(settings.BankFeeRate).
+		SetPayoutMethod(settings.PayoutMethod).
+		SetPayoutParty(settings.PayoutParty).
+		SetPayoutAccountReference(settings.PayoutAccountReference).
+		OnConflictColumns(settlementsetting.FieldTenantID).
+		UpdateNewValues().
+		ID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("save settlement settings: %w", err)
+	}
+
+	entSettings, err := r.client.SettlementSetting.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get settlement settings: %w", err)
+	}
+
+	return mapEntSettings(entSettings), nil
+}
+
+// ClaimDisbursement counts a due payout attempt and schedules the next one,
+// so a crash between claiming and recording the outcome resends the same
+// request after the backoff.
+func (r *EntRepository) ClaimDisbursement(ctx context.Context, tenantID, disbursementID uuid.UUID, now time.Time) (*Disbursement, error) {
+	var claimed *ent.SettlementDisbursement
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entDisbursement, err := lockDisbursement(ctx, tx,
+			settlementdisbursement.ID(disbursementID),
+			settlementdisbursement.TenantID(tenantID),
+		)
+		if err != nil {
+			return err
+		}
+		if entDisbursement.Status != DisbursementPending || entDisbursement.NextAttemptAt.After(now) {
+			return fmt.Errorf("%w: settlement disbursement is %s", ErrDisbursementStatus, entDisbursement.Status)
+		}
+
+		attempts := entDisbursement.Attempts + 1
+		claimed, err = entDisbursement.Update().
+			SetAttempts(attempts).
+			SetNextAttemptAt(now.Add(retryDelay(attempts))).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("claim settlement disbursement: %w", err)
+		}
+
+		if err := tx.SettlementBatch.Update().
+			Where(
+				settlementbatch.ID(entDisbursement.BatchID),
+				settlementbatch.Status(StatusApproved),
+			).
+			SetStatus(StatusProcessing).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("start settlement batch processing: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntDisbursement(claimed), nil
+}
+
+// DueDisbursements lists pending disbursements due for an attempt, longest
+// waiting first.
+func (r *EntRepository) DueDisbursements(ctx context.Context, now time.Time, limit int) ([]*Disbursement, error) {
+	entDisbursements, err := r.client.SettlementDisbursement.Query().
+		Where(
+			settlementdisbursement.Status(DisbursementPending),
+			settlementdisbursement.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(settlementdisbursement.FieldNextAttemptAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list due settlement disbursements: %w", err)
+	}
+
+	return mapEntDisbursements(entDisbursements), nil
+}
+
+// StaleDisbursements lists submitted disbursements still awaiting a result
+// since before submittedBefore.
+func (r *EntRepository) StaleDisbursements(ctx context.Context, submittedBefore time.Time) ([]*Disbursement, error) {
+	entDisbursements, err := r.client.SettlementDisbursement.Query().
+		Where(
+			settlementdisbursement.Status(DisbursementSubmitted),
+			settlementdisbursement.SubmittedAtLT(submittedBefore),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list stale settlement disbursements: %w", err)
+	}
+
+	return mapEntDisbursements(entDisbursements), nil
+}
+
+// MarkSubmitted records M-Pesa's acceptance of a pending disbursement's
+// request. A result that arrived first has already finished it and is kept.
+func (r *EntRepository) MarkSubmitted(ctx context.Context, disbursementID uuid.UUID, conversationID string, now time.Time) error {
+	err := r.client.SettlementDisbursement.Update().
+		Where(
+			settlementdisbursement.ID(disbursementID),
+			settlementdisbursement.Status(DisbursementPending),
+		).
+		SetStatus(DisbursementSubmitted).
+		SetConversationID(conversationID).
+		SetSubmittedAt(now).
+		ClearResultDescription().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("mark settlement disbursement submitted: %w", err)
+	}
+	return nil
+}
+
+// RecordAttemptError keeps the last transient error of a pending disbursement
+// for support.
+func (r *EntRepository) RecordAttemptError(ctx context.Context, disbursementID uuid.UUID, description string) error {
+	err := r.client.SettlementDisbursement.Update().
+		Where(
+			settlementdisbursement.ID(disbursementID),
+			settlementdisbursement.Status(DisbursementPending),
+		).
+		SetResultDescription(description).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("record settlement disbursement error: %w", err)
+	}
+	return nil
+}
+
+// FailDisbursement fails an unfinished disbursement and its batch.
+func (r *EntRepository) FailDisbursement(ctx context.Context, disbursementID uuid.UUID, reason, description string, now time.Time) (*Disbursement, error) {
+	var failed *ent.SettlementDisbursement
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entDisbursement, err := lockDisbursement(ctx, tx, settlementdisbursement.ID(disbursementID))
+		if err != nil {
+			return err
+		}
+		if entDisbursement.Status != DisbursementPending && entDisbursement.Status != DisbursementSubmitted {
+			return fmt.Errorf("%w: settlement disbursement is %s", ErrDisbursementStatus, entDisbursement.Status)
+		}
+
+		failed, err = fail(ctx, tx, entDisbursement, reason, "", description, now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntDisbursement(failed), nil
+}
+
+// ResolveDisbursement applies a payout result to the disbursement of its
+// request. A success may still arrive after the disbursement was failed for
+// want of a result, and is then recorded.
+func (r *EntRepository) ResolveDisbursement(ctx context.Context, result PayoutResult, now time.Time) (*Disbursement, error) {
+	var resolved *ent.SettlementDisbursement
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entDisbursement, err := lockDisbursement(ctx, tx,
+			settlementdisbursement.OriginatorConversationID(result.OriginatorConversationID),
+		)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case entDisbursement.Status == DisbursementPending, entDisbursement.Status == DisbursementSubmitted:
+		case entDisbursement.Status == DisbursementFailed && entDisbursement.FailureReason == ReasonResultTimeout && result.Succeeded():
+		default:
+			// Already finished: M-Pesa repeats results it gets no answer to.
+			resolved = entDisbursement
+			return nil
+		}
+
+		if !result.Succeeded() {
+			resolved, err = fail(ctx, tx, entDisbursement, ReasonPayoutDeclined, result.ResultCode, result.ResultDescription, now)
+			return err
+		}
+
+		entBatch, err := tx.SettlementBatch.Get(ctx, entDisbursement.BatchID)
+		if err != nil {
+			return fmt.Errorf("get settlement batch: %w", err)
+		}
+
+		journalID, err := ledger.PostJournal(ctx, tx, ledger.Journal{
+			TenantID:      entDisbursement.TenantID,
+			EntryDate:     now,
+			Currency:      entDisbursement.Currency,
+			ReferenceType: "settlement_batch",
+			ReferenceID:   entBatch.ID,
+			Description:   "Settlement payout " + entBatch.BatchNumber,
+			Metadata: map[string]any{
+				"disbursement_id": entDisbursement.ID.String(),
+				"transaction_id":  result.TransactionID,
+			},
+			Lines: payoutJournalLines(entDisbursement.Amount, entBatch.FeeAmount.Abs()),
+		})
+		if err != nil {
+			return fmt.Errorf("post settlement payout journal: %w", err)
+		}
+
+		resolved, err = entDisbursement.Update().
+			SetStatus(DisbursementSucceeded).
+			SetConversationID(result.ConversationID).
+			SetTransactionID(result.TransactionID).
+			SetResultCode(result.ResultCode).
+			SetResultDescription(result.ResultDescription).
+			SetJournalEntryID(journalID).
+			SetCompletedAt(now).
+			ClearFailureReason().
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("complete settlement disbursement: %w", err)
+		}
+
+		if err := entBatch.Update().
+			SetStatus(StatusCompleted).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("complete settlement batch: %w", err)
+		}
+
+		return outbox.Enqueue(ctx, tx, outbox.Event{
+			TenantID:      entDisbursement.TenantID,
+			AggregateType: "settlement_batch",
+			AggregateID:   entBatch.ID,
+			EventType:     EventSettlementCompleted,
+			Payload: map[string]any{
+				"batch_id":         entBatch.ID.String(),
+				"batch_number":     entBatch.BatchNumber,
+				"disbursement_id":  resolved.ID.String(),
+				"method":           resolved.Method,
+				"amount":           resolved.Amount.String(),
+				"currency":         resolved.Currency,
+				"transaction_id":   resolved.TransactionID,
+				"journal_entry_id": journalID.String(),
+				"completed_at":     now.UTC().Format(time.RFC3339),
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntDisbursement(resolved), nil
+}
+
+// RequeueDisbursement replaces a request that timed out in M-Pesa's queue
+// with a new request, due now.
+func (r *EntRepository) RequeueDisbursement(ctx context.Context, originatorConversationID string, now time.Time) (*Disbursement, error) {
+	var requeued *ent.SettlementDisbursement
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entDisbursement, err := lockDisbursement(ctx, tx,
+			settlementdisbursement.OriginatorConversationID(originatorConversationID),
+		)
+		if err != nil {
+			return err
+		}
+		if entDisbursement.Status != DisbursementPending && entDisbursement.Status != DisbursementSubmitted {
+			return fmt.Errorf("%w: settlement disbursement is %s", ErrDisbursementStatus, entDisbursement.Status)
+		}
+
+		requeued, err = renew(ctx, entDisbursement, "Request timed out in the M-Pesa queue", now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntDisbursement(requeued), nil
+}
+
+// RetryDisbursement replaces a failed disbursement's request with a new one
+// and enqueues treasury.settlement.execute for it.
+func (r *EntRepository) RetryDisbursement(ctx context.Context, tenantID, disbursementID uuid.UUID, now time.Time) (*Disbursement, error) {
+	var retried *ent.SettlementDisbursement
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entDisbursement, err := lockDisbursement(ctx, tx,
+			settlementdisbursement.ID(disbursementID),
+			settlementdisbursement.TenantID(tenantID),
+		)
+		if err != nil {
+			return err
+		}
+		if entDisbursement.Status != DisbursementFailed {
+			return fmt.Errorf("%w: settlement disbursement is %s", ErrDisbursementStatus, entDisbursement.Status)
+		}
+
+		retried, err = renew(ctx, entDisbursement, "", now)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.SettlementBatch.UpdateOneID(entDisbursement.BatchID).
+			SetStatus(StatusProcessing).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("restart settlement batch processing: %w", err)
+		}
+		return enqueueExecute(ctx, tx, retried)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntDisbursement(retried), nil
+}
+
+// GetDisbursement retrieves a settlement disbursement.
+func (r *EntRepository) GetDisbursement(ctx context.Context, tenantID, disbursementID uuid.UUID) (*Disbursement, error) {
+	entDisbursement, err := r.client.SettlementDisbursement.Query().
+		Where(
+			settlementdisbursement.ID(disbursementID),
+			settlementdisbursement.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrDisbursementNotFound
+		}
+		return nil, fmt.Errorf("get settlement disbursement: %w", err)
+	}
+
+	return mapEntDisbursement(entDisbursement), nil
+}
+
+// ListDisbursements returns the tenant's disbursements, newest first.
+func (r *EntRepository) ListDisbursements(ctx context.Context, tenantID uuid.UUID, filter DisbursementFilter) ([]*Disbursement, error) {
+	query := r.client.SettlementDisbursement.Query().
+		Where(settlementdisbursement.TenantID(tenantID))
+	if filter.Status != "" {
+		query = query.Where(settlementdisbursement.Status(filter.Status))
+	}
+
+	entDisbursements, err := query.
+		Order(ent.Desc(settlementdisbursement.FieldCreatedAt)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list settlement disbursements: %w", err)
+	}
+
+	return mapEntDisbursements(entDisbursements), nil
+}
+
+// collected selects the succeeded payments, refunds and chargebacks of the
+// settled channels processed in the carry-over period before windowEnd.
+func collected(windowEnd time.Time) []predicate.PaymentTransaction {
+	return []predicate.PaymentTransaction{
+		paymenttransaction.Status("succeeded"),
+		paymenttransaction.TransactionTypeIn(ItemPayment, ItemRefund, ItemChargeback),
+		paymenttransaction.ProviderIn(providers()...),
+		paymenttransaction.ProcessedAtGTE(windowEnd.Add(-carryOver)),
+		paymenttransaction.ProcessedAtLT(windowEnd),
+	}
+}
+
+// unsettled returns the tenant's collections before windowEnd that are not
+// included in a settlement batch yet, oldest first.
+func unsettled(ctx context.Context, client *ent.Client, tenantID uuid.UUID, windowEnd time.Time) ([]*ent.PaymentTransaction, error) {
+	payments, err := client.PaymentTransaction.Query().
+		Where(paymenttransaction.TenantID(tenantID)).
+		Where(collected(windowEnd)...).
+		Order(ent.Asc(paymenttransaction.FieldProcessedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load collections: %w", err)
+	}
+
+	settled := make(map[uuid.UUID]bool)
+	for start := 0; start < len(payments); start += lookupBatchSize {
+		end := min(start+lookupBatchSize, len(payments))
+		ids := make([]uuid.UUID, 0, end-start)
+		for _, payment := range payments[start:end] {
+			ids = append(ids, payment.ID)
+		}
+
+		entItems, err := client.SettlementItem.Query().
+			Where(
+				settlementitem.PaymentTransactionIDIn(ids...),
+				settlementitem.Status(ItemIncluded),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("load settled transactions: %w", err)
+		}
+		for _, entItem := range entItems {
+			settled[*entItem.PaymentTransactionID] = true
+		}
+	}
+
+	pending := payments[:0]
+	for _, payment := range payments {
+		if !settled[payment.ID] {
+			pending = append(pending, payment)
+		}
+	}
+	return pending, nil
+}
+
+func lockBatch(ctx context.Context, tx *ent.Tx, tenantID, batchID uuid.UUID, statuses ...string) (*ent.SettlementBatch, error) {
+	entBatch, err := tx.SettlementBatch.Query().
+		Where(
+			settlementbatch.ID(batchID),
+			settlementbatch.TenantID(tenantID),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrBatchNotFound
+		}
+		return nil, fmt.Errorf("lock settlement batch: %w", err)
+	}
+
+	for _, status := range statuses {
+		if entBatch.Status == status {
+			return entBatch, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: settlement batch is %s", ErrBatchStatus, entBatch.Status)
+}
+
+// updateTotals recalculates the batch's fee on its remaining collections and
+// its totals.
+func updateTotals(ctx context.Context, tx *ent.Tx, entBatch *ent.SettlementBatch) error {
+	entItems, err := tx.SettlementItem.Query().
+		Where(settlementitem.BatchID(entBatch.ID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("load settlement items: %w", err)
+	}
+
+	items := make([]*Item, len(entItems))
+	var feeItem *Item
+	for i, entItem := range entItems {
+		items[i] = mapEntItem(entItem)
+		if entItem.ItemType == ItemFee {
+			feeItem = items[i]
+		}
+	}
+
+	fee := settlementFee(summarize(items).Gross, entBatch.FeeRate)
+	switch {
+	case feeItem != nil && !feeItem.Amount.Equal(fee):
+		if err := tx.SettlementItem.UpdateOneID(feeItem.ID).
+			SetAmount(fee).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("update settlement fee: %w", err)
+		}
+		feeItem.Amount = fee
+	case feeItem == nil && !fee.IsZero():
+		if err := tx.SettlementItem.Create().
+			SetTenantID(entBatch.TenantID).
+			SetBatchID(entBatch.ID).
+			SetItemType(ItemFee).
+			SetAmount(fee).
+			SetDescription(feeDescription(entBatch.FeeRate)).
+			SetStatus(ItemIncluded).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("add settlement fee: %w", err)
+		}
+		items = append(items, &Item{ItemType: ItemFee, Amount: fee, Status: ItemIncluded})
+	}
+
+	t := summarize(items)
+	if err := entBatch.Update().
+		SetGrossAmount(t.Gross).
+		SetRefundAmount(t.Refunds).
+		SetFeeAmount(t.Fees).
+		SetAdjustmentAmount(t.Adjustments).
+		SetNetAmount(t.Net).
+		SetTransactionCount(t.Transactions).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("update settlement batch totals: %w", err)
+	}
+	return nil
+}
+
+func lockDisbursement(ctx context.Context, tx *ent.Tx, where ...predicate.SettlementDisbursement) (*ent.SettlementDisbursement, error) {
+	entDisbursement, err := tx.SettlementDisbursement.Query().
+		Where(where...).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrDisbursementNotFound
+		}
+		return nil, fmt.Errorf("lock settlement disbursement: %w", err)
+	}
+	return entDisbursement, nil
+}
+
+// fail marks a locked disbursement and its batch failed and enqueues
+// treasury.settlement.failed, the queue support works failed payouts from.
+func fail(ctx context.Context, tx *ent.Tx, entDisbursement *ent.SettlementDisbursement, reason, resultCode, description string, now time.Time) (*ent.SettlementDisbursement, error) {
+	failed, err := entDisbursement.Update().
+		SetStatus(DisbursementFailed).
+		SetFailureReason(reason).
+		SetResultCode(resultCode).
+		SetResultDescription(description).
+		SetCompletedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail settlement disbursement: %w", err)
+	}
+
+	entBatch, err := tx.SettlementBatch.UpdateOneID(entDisbursement.BatchID).
+		SetStatus(StatusFailed).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail settlement batch: %w", err)
+	}
+
+	if err := outbox.Enqueue(ctx, tx, outbox.Event{
+		TenantID:      failed.TenantID,
+		AggregateType: "settlement_batch",
+		AggregateID:   entBatch.ID,
+		EventType:     EventSettlementFailed,
+		Payload: map[string]any{
+			"batch_id":           entBatch.ID.String(),
+			"batch_number":       entBatch.BatchNumber,
+			"disbursement_id":    failed.ID.String(),
+			"method":             failed.Method,
+			"amount":             failed.Amount.String(),
+			"currency":           failed.Currency,
+			"reason_code":        reason,
+			"result_code":        resultCode,
+			"result_description": description,
+			"attempts":           failed.Attempts,
+			"failed_at":          now.UTC().Format(time.RFC3339),
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return failed, nil
+}
+
+// renew replaces a disbursement's request with a new one under the next
+// originator conversation ID, due now.
+func renew(ctx context.Context, entDisbursement *ent.SettlementDisbursement, description string, now time.Time) (*ent.SettlementDisbursement, error) {
+	sequence := entDisbursement.Sequence + 1
+	builder := entDisbursement.Update().
+		SetSequence(sequence).
+		SetOriginatorConversationID(originatorID(entDisbursement.ID, sequence)).
+		SetStatus(DisbursementPending).
+		SetAttempts(0).
+		SetNextAttemptAt(now).
+		ClearConversationID().
+		ClearSubmittedAt().
+		ClearResultCode().
+		ClearFailureReason().
+		ClearCompletedAt()
+	if description != "" {
+		builder.SetResultDescription(description)
+	} else {
+		builder.ClearResultDescription()
+	}
+
+	renewed, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("renew settlement disbursement: %w", err)
+	}
+	return renewed, nil
+}
+
+func enqueueExecute(ctx context.Context, tx *ent.Tx, entDisbursement *ent.SettlementDisbursement) error {
+	return outbox.Enqueue(ctx, tx, outbox.Event{
+		TenantID:      entDisbursement.TenantID,
+		AggregateType: "settlement_batch",
+		AggregateID:   entDisbursement.BatchID,
+		EventType:     EventSettlementExecute,
+		Payload: map[string]any{
+			"batch_id":        entDisbursement.BatchID.String(),
+			"disbursement_id": entDisbursement.ID.String(),
+		},
+	})
+}
+
+func feeDescription(rate decimal.Decimal) string {
+	return fmt.Sprintf("Settlement fee at %s%%", rate.Mul(decimal.NewFromInt(100)).String())
+}
+
+func generatedPayload(entBatch *ent.SettlementBatch) map[string]any {
+	return map[string]any{
+		"batch_id":          entBatch.ID.String(),
+		"batch_number":      entBatch.BatchNumber,
+		"channel":           entBatch.Channel,
+		"currency":          entBatch.Currency,
+		"window_start":      entBatch.WindowStart.Format(time.DateOnly),
+		"window_end":        entBatch.WindowEnd.Format(time.DateOnly),
+		"status":            entBatch.Status,
+		"gross_amount":      entBatch.GrossAmount.String(),
+		"refund_amount":     entBatch.RefundAmount.String(),
+		"fee_amount":        entBatch.FeeAmount.String(),
+		"adjustment_amount": entBatch.AdjustmentAmount.String(),
+		"net_amount":        entBatch.NetAmount.String(),
+		"transaction_count": entBatch.TransactionCount,
+	}
+}
+
+func mapEntBatch(entBatch *ent.SettlementBatch) *Batch {
+	batch := &Batch{
+		ID:               entBatch.ID,
+		TenantID:         entBatch.TenantID,
+		BatchNumber:      entBatch.BatchNumber,
+		Channel:          entBatch.Channel,
+		Currency:         entBatch.Currency,
+		WindowStart:      entBatch.WindowStart,
+		WindowEnd:        entBatch.WindowEnd,
+		Status:           entBatch.Status,
+		FeeRate:          entBatch.FeeRate,
+		GrossAmount:      entBatch.GrossAmount,
+		RefundAmount:     entBatch.RefundAmount,
+		FeeAmount:        entBatch.FeeAmount,
+		AdjustmentAmount: entBatch.AdjustmentAmount,
+		NetAmount:        entBatch.NetAmount,
+		TransactionCount: entBatch.TransactionCount,
+		RejectionReason:  entBatch.RejectionReason,
+		CreatedAt:        entBatch.CreatedAt,
+		UpdatedAt:        entBatch.UpdatedAt,
+	}
+
+	// Optional fields - check for zero values
+	if entBatch.CreatedBy != uuid.Nil {
+		batch.CreatedBy = &entBatch.CreatedBy
+	}
+	if entBatch.SubmittedBy != uuid.Nil {
+		batch.SubmittedBy = &entBatch.SubmittedBy
+	}
+	if !entBatch.SubmittedAt.IsZero() {
+		batch.SubmittedAt = &entBatch.SubmittedAt
+	}
+	if entBatch.ApprovedBy != uuid.Nil {
+		batch.ApprovedBy = &entBatch.ApprovedBy
+	}
+	if !entBatch.ApprovedAt.IsZero() {
+		batch.ApprovedAt = &entBatch.ApprovedAt
+	}
+
+	for _, entItem := range entBatch.Edges.Items {
+		batch.Items = append(batch.Items, mapEntItem(entItem))
+	}
+	return batch
+}
+
+func mapEntItem(entItem *ent.SettlementItem) *Item {
+	item := &Item{
+		ID:                   entItem.ID,
+		ItemType:             entItem.ItemType,
+		PaymentTransactionID: entItem.PaymentTransactionID,
+		ProviderReference:    entItem.ProviderReference,
+		Amount:               entItem.Amount,
+		Description:          entItem.Description,
+		Status:               entItem.Status,
+		CreatedAt:            entItem.CreatedAt,
+	}
+	if !entItem.TransactionDate.IsZero() {
+		item.TransactionDate = &entItem.TransactionDate
+	}
+	if entItem.CreatedBy != uuid.Nil {
+		item.CreatedBy = &entItem.CreatedBy
+	}
+	return item
+}
+
+func mapEntSettings(entSettings *ent.SettlementSetting) *Settings {
+	updatedAt := entSettings.UpdatedAt
+	return &Settings{
+		TenantID:               entSettings.TenantID,
+		MpesaFeeRate:           entSettings.MpesaFeeRate,
+		CardFeeRate:            entSettings.CardFeeRate,
+		BankFeeRate:            entSettings.BankFeeRate,
+		PayoutMethod:           entSettings.PayoutMethod,
+		PayoutParty:            entSettings.PayoutParty,
+		PayoutAccountReference: entSettings.PayoutAccountReference,
+		UpdatedAt:              &updatedAt,
+	}
+}
+
+func mapEntDisbursements(entDisbursements []*ent.SettlementDisbursement) []*Disbursement {
+	disbursements := make([]*Disbursement, len(entDisbursements))
+	for i, entDisbursement := range entDisbursements {
+		disbursements[i] = mapEntDisbursement(entDisbursement)
+	}
+	return disbursements
+}
+
+func mapEntDisbursement(entDisbursement *ent.SettlementDisbursement) *Disbursement {
+	disbursement := &Disbursement{
+		ID:                       entDisbursement.ID,
+		TenantID:                 entDisbursement.TenantID,
+		BatchID:                  entDisbursement.BatchID,
+		Method:                   entDisbursement.Method,
+		Party:                    entDisbursement.Party,
+		AccountReference:         entDisbursement.AccountReference,
+		Amount:                   entDisbursement.Amount,
+		Currency:                 entDisbursement.Currency,
+		Sequence:                 entDisbursement.Sequence,
+		OriginatorConversationID: entDisbursement.OriginatorConversationID,
+		ConversationID:           entDisbursement.ConversationID,
+		Status:                   entDisbursement.Status,
+		Attempts:                 entDisbursement.Attempts,
+		ResultCode:               entDisbursement.ResultCode,
+		ResultDescription:        entDisbursement.ResultDescription,
+		FailureReason:            entDisbursement.FailureReason,
+		TransactionID:            entDisbursement.TransactionID,
+		CreatedAt:                entDisbursement.CreatedAt,
+		UpdatedAt:                entDisbursement.UpdatedAt,
+	}
+
+	// Optional fields - check for zero values
+	if entDisbursement.Status == DisbursementPending {
+		disbursement.NextAttemptAt = &entDisbursement.NextAttemptAt
+	}
+	if entDisbursement.JournalEntryID != uuid.Nil {
+		disbursement.JournalEntryID = &entDisbursement.JournalEntryID
+	}
+	if !entDisbursement.SubmittedAt.IsZero() {
+		disbursement.SubmittedAt = &entDisbursement.SubmittedAt
+	}
+	if !entDisbursement.CompletedAt.IsZero() {
+		disbursement.CompletedAt = &entDisbursement.CompletedAt
+	}
+	return disbursement
+}
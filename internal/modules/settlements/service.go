@@ -0,0 +1,297 @@
+package settlements
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/platform/mpesa"
+)
+
+// Payer sends payouts through M-Pesa.
+type Payer interface {
+	B2C(ctx context.Context, p mpesa.Payment) (*mpesa.Response, error)
+	B2B(ctx context.Context, p mpesa.Payment) (*mpesa.Response, error)
+}
+
+// Service aggregates tenants' collections into settlement batches, takes
+// them through review and approval and pays approved batches out.
+type Service struct {
+	repo   Repository
+	payer  Payer
+	logger *zap.Logger
+}
+
+// NewService creates a new settlements service.
+func NewService(repo Repository, payer Payer, logger *zap.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		payer:  payer,
+		logger: logger,
+	}
+}
+
+// Generate batches the tenant's unsettled M-Pesa, card and bank transfer
+// collections for the day ending at the request's window end, one batch per
+// channel and currency. Channels already batched for the window are skipped,
+// so generating twice is harmless.
+func (s *Service) Generate(ctx context.Context, tenantID uuid.UUID, req GenerateRequest, createdBy *uuid.UUID) ([]*Batch, error) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	windowEnd := today
+	if req.WindowEnd != nil {
+		windowEnd = req.WindowEnd.UTC().Truncate(24 * time.Hour)
+	}
+	if windowEnd.After(today) {
+		return nil, fmt.Errorf("%w: window_end cannot be in the future", ErrInvalidBatch)
+	}
+
+	return s.generate(ctx, tenantID, windowEnd, createdBy)
+}
+
+func (s *Service) generate(ctx context.Context, tenantID uuid.UUID, windowEnd time.Time, createdBy *uuid.UUID) ([]*Batch, error) {
+	settings, err := s.repo.GetSettings(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	batches, err := s.repo.Generate(ctx, tenantID, windowEnd.AddDate(0, 0, -1), windowEnd, settings, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, batch := range batches {
+		s.logger.Info("settlement batch generated",
+			zap.String("tenant_id", tenantID.String()),
+			zap.String("batch_id", batch.ID.String()),
+			zap.String("batch_number", batch.BatchNumber),
+			zap.String("channel", batch.Channel),
+			zap.String("currency", batch.Currency),
+			zap.Int("transactions", batch.TransactionCount),
+			zap.String("net", batch.NetAmount.String()),
+		)
+	}
+
+	return batches, nil
+}
+
+// RunDaily batches every tenant's collections for the previous day. Tenants
+// already batched for the window are skipped.
+func (s *Service) RunDaily(ctx context.Context) error {
+	windowEnd := time.Now().UTC().Truncate(24 * time.Hour)
+
+	tenantIDs, err := s.repo.SettlementTenants(ctx, windowEnd)
+	if err != nil {
+		return err
+	}
+
+	generated, failed := 0, 0
+	for _, tenantID := range tenantIDs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		batches, err := s.generate(ctx, tenantID, windowEnd, nil)
+		if err != nil {
+			if errors.Is(err, ErrBatchExists) {
+				continue
+			}
+			failed++
+			s.logger.Warn("settlement aggregation failed",
+				zap.String("tenant_id", tenantID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		generated += len(batches)
+	}
+
+	if generated > 0 || failed > 0 {
+		s.logger.Info("settlement aggregation run completed",
+			zap.String("window_end", windowEnd.Format(time.DateOnly)),
+			zap.Int("generated", generated),
+			zap.Int("failed", failed),
+		)
+	}
+
+	return nil
+}
+
+// Get retrieves a settlement batch with its items.
+func (s *Service) Get(ctx context.Context, tenantID, batchID uuid.UUID) (*Batch, error) {
+	return s.repo.Get(ctx, tenantID, batchID)
+}
+
+// List returns the tenant's settlement batches.
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Batch, error) {
+	return s.repo.List(ctx, tenantID, filter)
+}
+
+// AddAdjustment adds a signed adjustment to a batch under review.
+func (s *Service) AddAdjustment(ctx context.Context, tenantID, batchID uuid.UUID, req AdjustmentRequest, createdBy *uuid.UUID) (*Batch, error) {
+	description := strings.TrimSpace(req.Description)
+	if description == "" {
+		return nil, fmt.Errorf("%w: description is required", ErrInvalidBatch)
+	}
+	if req.Amount.IsZero() {
+		return nil, fmt.Errorf("%w: amount must not be zero", ErrInvalidBatch)
+	}
+	if !req.Amount.Equal(req.Amount.Round(2)) {
+		return nil, fmt.Errorf("%w: amount cannot have more than two decimal places", ErrInvalidBatch)
+	}
+
+	batch, err := s.repo.AddAdjustment(ctx, tenantID, batchID, &Item{
+		Amount:      req.Amount,
+		Description: description,
+		CreatedBy:   createdBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("settlement adjustment added",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("batch_id", batch.ID.String()),
+		zap.String("amount", req.Amount.String()),
+		zap.String("net", batch.NetAmount.String()),
+	)
+
+	return batch, nil
+}
+
+// RemoveItem drops a transaction or adjustment from a batch under review.
+func (s *Service) RemoveItem(ctx context.Context, tenantID, batchID, itemID uuid.UUID) (*Batch, error) {
+	return s.repo.RemoveItem(ctx, tenantID, batchID, itemID)
+}
+
+// Submit confirms a draft batch is funded and sends it for approval.
+func (s *Service) Submit(ctx context.Context, tenantID, batchID uuid.UUID, submittedBy *uuid.UUID) (*Batch, error) {
+	batch, err := s.repo.Submit(ctx, tenantID, batchID, submittedBy, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("settlement batch submitted",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("batch_id", batch.ID.String()),
+		zap.String("batch_number", batch.BatchNumber),
+	)
+
+	return batch, nil
+}
+
+// Approve approves a pending batch and queues its payout to the tenant's
+// payout destination. The user who submitted the batch cannot approve it,
+// and a batch must have a positive net amount M-Pesa can pay.
+func (s *Service) Approve(ctx context.Context, tenantID, batchID uuid.UUID, approvedBy *uuid.UUID) (*Batch, error) {
+	settings, err := s.repo.GetSettings(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if settings.PayoutMethod == "" {
+		return nil, fmt.Errorf("%w: no payout destination is configured in the settlement settings", ErrInvalidBatch)
+	}
+
+	batch, err := s.repo.Approve(ctx, tenantID, batchID, settings, approvedBy, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("settlement batch approved",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("batch_id", batch.ID.String()),
+		zap.String("batch_number", batch.BatchNumber),
+		zap.String("net", batch.NetAmount.String()),
+	)
+
+	return batch, nil
+}
+
+// Reject sends a pending batch back to draft for rework.
+func (s *Service) Reject(ctx context.Context, tenantID, batchID uuid.UUID, req RejectRequest) (*Batch, error) {
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, fmt.Errorf("%w: reason is required", ErrInvalidBatch)
+	}
+
+	batch, err := s.repo.Reject(ctx, tenantID, batchID, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("settlement batch rejected",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("batch_id", batch.ID.String()),
+		zap.String("batch_number", batch.BatchNumber),
+	)
+
+	return batch, nil
+}
+
+// GetSettings returns the tenant's settlement fees and payout destination.
+func (s *Service) GetSettings(ctx context.Context, tenantID uuid.UUID) (*Settings, error) {
+	return s.repo.GetSettings(ctx, tenantID)
+}
+
+// UpdateSettings changes the tenant's settlement fees and payout
+// destination. New rates apply to batches generated afterwards, a new
+// destination to batches approved afterwards.
+func (s *Service) UpdateSettings(ctx context.Context, tenantID uuid.UUID, req UpdateSettingsRequest) (*Settings, error) {
+	settings, err := s.repo.GetSettings(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.MpesaFeeRate != nil {
+		settings.MpesaFeeRate = *req.MpesaFeeRate
+	}
+	if req.CardFeeRate != nil {
+		settings.CardFeeRate = *req.CardFeeRate
+	}
+	if req.BankFeeRate != nil {
+		settings.BankFeeRate = *req.BankFeeRate
+	}
+	if req.PayoutMethod != nil {
+		settings.PayoutMethod = strings.TrimSpace(*req.PayoutMethod)
+	}
+	if req.PayoutParty != nil {
+		settings.PayoutParty = strings.TrimSpace(*req.PayoutParty)
+	}
+	if req.PayoutAccountReference != nil {
+		settings.PayoutAccountReference = strings.TrimSpace(*req.PayoutAccountReference)
+	}
+
+	one := decimal.NewFromInt(1)
+	for name, rate := range map[string]decimal.Decimal{
+		"mpesa_fee_rate": settings.MpesaFeeRate,
+		"card_fee_rate":  settings.CardFeeRate,
+		"bank_fee_rate":  settings.BankFeeRate,
+	} {
+		if rate.IsNegative() || rate.GreaterThanOrEqual(one) {
+			return nil, fmt.Errorf("%w: %s must be a fraction between 0 and 1", ErrInvalidSettings, name)
+		}
+	}
+	if err := validatePayout(settings.PayoutMethod, settings.PayoutParty, settings.PayoutAccountReference); err != nil {
+		return nil, err
+	}
+
+	saved, err := s.repo.SaveSettings(ctx, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("settlement settings updated",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("mpesa_fee_rate", saved.MpesaFeeRate.String()),
+		zap.String("card_fee_rate", saved.CardFeeRate.String()),
+		zap.String("bank_fee_rate", saved.BankFeeRate.String()),
+		zap.String("payout_method", saved.PayoutMethod),
+	)
+
+	return saved, nil
+}
@@ -0,0 +1,70 @@
+package statements
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/customers"
+	"github.com/bengobox/treasury-api/internal/modules/customers/profile"
+)
+
+// EventStatementGenerated is published when a statement PDF was stored.
+const EventStatementGenerated = "treasury.statement.generated"
+
+// Statement generation triggers.
+const (
+	TriggerScheduled = "scheduled"
+	TriggerManual    = "manual"
+)
+
+// Statement is a customer statement for a period in one currency.
+type Statement struct {
+	CustomerID       uuid.UUID               `json:"customer_id"`
+	CustomerNumber   string                  `json:"customer_number"`
+	CustomerName     string                  `json:"customer_name"`
+	KRAPIN           string                  `json:"kra_pin,omitempty"`
+	Email            string                  `json:"email,omitempty"`
+	BillingAddress   *profile.Address        `json:"billing_address,omitempty"`
+	Currency         string                  `json:"currency"`
+	From             time.Time               `json:"from"`
+	To               time.Time               `json:"to"`
+	OpeningBalance   decimal.Decimal         `json:"opening_balance"`
+	Entries          []customers.LedgerEntry `json:"entries"`
+	Invoiced         decimal.Decimal         `json:"invoiced"`
+	Payments         decimal.Decimal         `json:"payments"`
+	Credits          decimal.Decimal         `json:"credits"`
+	ClosingBalance   decimal.Decimal         `json:"closing_balance"`
+	Aging            []AgingBucket           `json:"aging"`
+	UnappliedCredits decimal.Decimal         `json:"unapplied_credits"`
+	GeneratedAt      time.Time               `json:"generated_at"`
+}
+
+// AgingBucket is one bucket of the statement's aging summary.
+type AgingBucket struct {
+	Label  string          `json:"label"`
+	Amount decimal.Decimal `json:"amount"`
+}
+
+// Record is a statement PDF stored in object storage.
+type Record struct {
+	ID             uuid.UUID       `json:"id"`
+	CustomerID     uuid.UUID       `json:"customer_id"`
+	Currency       string          `json:"currency"`
+	PeriodStart    time.Time       `json:"period_start"`
+	PeriodEnd      time.Time       `json:"period_end"`
+	OpeningBalance decimal.Decimal `json:"opening_balance"`
+	ClosingBalance decimal.Decimal `json:"closing_balance"`
+	ObjectKey      string          `json:"object_key"`
+	Trigger        string          `json:"trigger"`
+	GeneratedAt    time.Time       `json:"generated_at"`
+}
+
+// Request selects a statement's currency and period. From and To default
+// to the current month.
+type Request struct {
+	Currency string
+	From     *time.Time
+	To       *time.Time
+}
@@ -0,0 +1,197 @@
+package statements
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/customers"
+	"github.com/bengobox/treasury-api/internal/shared/pdf"
+)
+
+const (
+	marginLeft  = 40.0
+	marginRight = pdf.PageWidth - 40.0
+	lineHeight  = 14.0
+	pageBottom  = 60.0
+)
+
+// summarize totals the period's entries by kind. Refunds and chargebacks
+// reduce payments.
+func summarize(entries []customers.LedgerEntry) (invoiced, payments, credits decimal.Decimal) {
+	invoiced, payments, credits = decimal.Zero, decimal.Zero, decimal.Zero
+	for _, entry := range entries {
+		switch entry.Type {
+		case customers.EntryInvoice:
+			invoiced = invoiced.Add(entry.Debit)
+		case customers.EntryCreditNote:
+			credits = credits.Add(entry.Credit)
+		case customers.EntryPayment:
+			payments = payments.Add(entry.Credit)
+		case customers.EntryRefund, customers.EntryChargeback:
+			payments = payments.Sub(entry.Debit)
+		}
+	}
+	return invoiced, payments, credits
+}
+
+// RenderPDF lays the statement out as an A4 PDF.
+func RenderPDF(st *Statement) []byte {
+	doc := pdf.New()
+	page := doc.AddPage()
+	y := pdf.PageHeight - 50
+
+	page.Text(marginLeft, y, 18, true, "Statement of Account")
+	page.TextRight(marginRight, y, 10, false, fmt.Sprintf("%s to %s", formatDate(st.From), formatDate(st.To)))
+	y -= 28
+
+	page.Text(marginLeft, y, 11, true, st.CustomerName)
+	page.TextRight(marginRight, y, 10, false, "Customer "+st.CustomerNumber)
+	y -= lineHeight
+	if st.KRAPIN != "" {
+		page.Text(marginLeft, y, 9, false, "KRA PIN: "+st.KRAPIN)
+		y -= lineHeight
+	}
+	if st.BillingAddress != nil {
+		for _, line := range addressLines(st) {
+			page.Text(marginLeft, y, 9, false, line)
+			y -= lineHeight
+		}
+	}
+	y -= 10
+
+	summary := [][2]string{
+		{"Opening balance", money(st.OpeningBalance)},
+		{"Invoiced", money(st.Invoiced)},
+		{"Payments", money(st.Payments.Neg())},
+		{"Credits", money(st.Credits.Neg())},
+		{"Closing balance", money(st.ClosingBalance)},
+	}
+	for i, row := range summary {
+		bold := i == len(summary)-1
+		page.Text(marginLeft, y, 10, bold, row[0])
+		page.TextRight(marginLeft+260, y, 10, bold, st.Currency+" "+row[1])
+		y -= lineHeight
+	}
+	y -= 12
+
+	header := func() {
+		page.Text(marginLeft, y, 9, true, "Date")
+		page.Text(marginLeft+70, y, 9, true, "Reference")
+		page.Text(marginLeft+180, y, 9, true, "Description")
+		page.TextRight(marginRight-170, y, 9, true, "Debit")
+		page.TextRight(marginRight-85, y, 9, true, "Credit")
+		page.TextRight(marginRight, y, 9, true, "Balance")
+		y -= 5
+		page.Line(marginLeft, y, marginRight, y)
+		y -= lineHeight
+	}
+	header()
+
+	page.Text(marginLeft, y, 9, false, formatDate(st.From))
+	page.Text(marginLeft+180, y, 9, false, "Balance brought forward")
+	page.TextRight(marginRight, y, 9, false, money(st.OpeningBalance))
+	y -= lineHeight
+
+	for _, entry := range st.Entries {
+		if y < pageBottom+4*lineHeight {
+			page = doc.AddPage()
+			y = pdf.PageHeight - 50
+			header()
+		}
+		page.Text(marginLeft, y, 9, false, formatDate(entry.Date))
+		page.Text(marginLeft+70, y, 9, false, truncate(entry.Reference, 20))
+		page.Text(marginLeft+180, y, 9, false, truncate(entry.Description, 28))
+		if entry.Debit.IsPositive() {
+			page.TextRight(marginRight-170, y, 9, false, money(entry.Debit))
+		}
+		if entry.Credit.IsPositive() {
+			page.TextRight(marginRight-85, y, 9, false, money(entry.Credit))
+		}
+		page.TextRight(marginRight, y, 9, false, money(entry.Balance))
+		y -= lineHeight
+	}
+
+	y -= 5
+	page.Line(marginLeft, y, marginRight, y)
+	y -= lineHeight
+	page.Text(marginLeft+180, y, 9, true, "Closing balance")
+	page.TextRight(marginRight, y, 9, true, money(st.ClosingBalance))
+	y -= 2 * lineHeight
+
+	if len(st.Aging) > 0 {
+		if y < pageBottom+3*lineHeight {
+			page = doc.AddPage()
+			y = pdf.PageHeight - 50
+		}
+		page.Text(marginLeft, y, 10, true, fmt.Sprintf("Amounts due as at %s (%s)", formatDate(st.To), st.Currency))
+		y -= lineHeight
+		width := (marginRight - marginLeft) / float64(len(st.Aging)+1)
+		for i, bucket := range st.Aging {
+			x := marginLeft + width*float64(i+1)
+			page.TextRight(x, y, 9, true, bucket.Label)
+			page.TextRight(x, y-lineHeight, 9, false, money(bucket.Amount))
+		}
+		x := marginLeft + width*float64(len(st.Aging)+1)
+		page.TextRight(x, y, 9, true, "Unapplied")
+		page.TextRight(x, y-lineHeight, 9, false, money(st.UnappliedCredits.Neg()))
+	}
+
+	page.Text(marginLeft, pageBottom-20, 8, false, "Generated "+st.GeneratedAt.UTC().Format("2006-01-02 15:04 MST"))
+
+	return doc.Bytes()
+}
+
+func addressLines(st *Statement) []string {
+	address := st.BillingAddress
+	var lines []string
+	for _, line := range []string{address.Line1, address.Line2} {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	var locality []string
+	for _, part := range []string{address.City, address.PostalCode, address.County, address.Country} {
+		if part != "" {
+			locality = append(locality, part)
+		}
+	}
+	if len(locality) > 0 {
+		lines = append(lines, strings.Join(locality, ", "))
+	}
+	return lines
+}
+
+// money formats an amount with thousands separators and two decimals.
+func money(amount decimal.Decimal) string {
+	fixed := amount.Abs().StringFixed(2)
+	whole, fraction, _ := strings.Cut(fixed, ".")
+
+	var b strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(digit)
+	}
+
+	formatted := b.String() + "." + fraction
+	if amount.IsNegative() {
+		return "(" + formatted + ")"
+	}
+	return formatted
+}
+
+func truncate(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(runes[:max-3]) + "..."
+}
+
+func formatDate(t time.Time) string {
+	return t.Format("02 Jan 2006")
+}
@@ -0,0 +1,52 @@
+package statements
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/customers"
+)
+
+func TestSummarizeAndMoney(t *testing.T) {
+	amount := decimal.RequireFromString
+	entries := []customers.LedgerEntry{
+		{Type: customers.EntryInvoice, Debit: amount("5000")},
+		{Type: customers.EntryPayment, Credit: amount("3000")},
+		{Type: customers.EntryRefund, Debit: amount("500")},
+		{Type: customers.EntryCreditNote, Credit: amount("250")},
+	}
+
+	invoiced, payments, credits := summarize(entries)
+	if !invoiced.Equal(amount("5000")) || !payments.Equal(amount("2500")) || !credits.Equal(amount("250")) {
+		t.Fatalf("unexpected summary: invoiced %s payments %s credits %s", invoiced, payments, credits)
+	}
+
+	cases := map[string]string{"1234567.5": "1,234,567.50", "999": "999.00", "-1200": "(1,200.00)", "0": "0.00"}
+	for in, want := range cases {
+		if got := money(amount(in)); got != want {
+			t.Fatalf("money(%s) = %s, want %s", in, got, want)
+		}
+	}
+}
+
+func TestRenderPDFPaginates(t *testing.T) {
+	st := &Statement{
+		CustomerName:   "Acme Ltd",
+		CustomerNumber: "CUS-000001",
+		Currency:       "KES",
+		From:           time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		To:             time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC),
+		Aging:          []AgingBucket{{Label: "current", Amount: decimal.NewFromInt(10)}},
+	}
+	for i := 0; i < 120; i++ {
+		st.Entries = append(st.Entries, customers.LedgerEntry{Date: st.From, Type: customers.EntryInvoice, Reference: "INV", Debit: decimal.NewFromInt(10), Balance: decimal.NewFromInt(int64(10 * (i + 1)))})
+	}
+
+	out := RenderPDF(st)
+	if !bytes.HasPrefix(out, []byte("%PDF-")) || !bytes.Contains(out, []byte("/Count 3")) {
+		t.Fatalf("expected a three page PDF")
+	}
+}
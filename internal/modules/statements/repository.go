@@ -0,0 +1,23 @@
+package statements
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidRequest is returned when a statement request is malformed.
+var ErrInvalidRequest = errors.New("invalid statement request")
+
+// Repository abstracts persistence for generated statements.
+type Repository interface {
+	// TenantIDs returns the tenants that have customers.
+	TenantIDs(ctx context.Context) ([]uuid.UUID, error)
+	Exists(ctx context.Context, customerID uuid.UUID, currency string, from, to time.Time) (bool, error)
+	// Save records a stored statement, replacing an earlier one for the same
+	// period, and enqueues the generated event with the payload.
+	Save(ctx context.Context, tenantID uuid.UUID, record *Record, payload map[string]any) (*Record, error)
+	List(ctx context.Context, tenantID uuid.UUID, customerID uuid.UUID) ([]*Record, error)
+}
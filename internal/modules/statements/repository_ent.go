@@ -0,0 +1,143 @@
+package statements
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/customer"
+	"github.com/bengobox/treasury-api/internal/ent/customerstatement"
+	"github.com/bengobox/treasury-api/internal/modules/outbox"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+)
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// TenantIDs returns the tenants that have customers.
+func (r *EntRepository) TenantIDs(ctx context.Context) ([]uuid.UUID, error) {
+	var rows []struct {
+		TenantID uuid.UUID `json:"tenant_id"`
+	}
+	if err := r.client.Customer.Query().
+		GroupBy(customer.FieldTenantID).
+		Scan(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("list customer tenants: %w", err)
+	}
+
+	tenantIDs := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		tenantIDs[i] = row.TenantID
+	}
+	return tenantIDs, nil
+}
+
+// Exists reports whether a statement was already stored for the period.
+func (r *EntRepository) Exists(ctx context.Context, customerID uuid.UUID, currency string, from, to time.Time) (bool, error) {
+	exists, err := r.client.CustomerStatement.Query().
+		Where(
+			customerstatement.CustomerID(customerID),
+			customerstatement.Currency(currency),
+			customerstatement.PeriodStart(from),
+			customerstatement.PeriodEnd(to),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("check customer statement: %w", err)
+	}
+	return exists, nil
+}
+
+// Save records a stored statement and enqueues treasury.statement.generated.
+func (r *EntRepository) Save(ctx context.Context, tenantID uuid.UUID, record *Record, payload map[string]any) (*Record, error) {
+	var saved *ent.CustomerStatement
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		id, err := tx.CustomerStatement.Create().
+			SetTenantID(tenantID).
+			SetCustomerID(record.CustomerID).
+			SetCurrency(record.Currency).
+			SetPeriodStart(record.PeriodStart).
+			SetPeriodEnd(record.PeriodEnd).
+			SetOpeningBalance(record.OpeningBalance).
+			SetClosingBalance(record.ClosingBalance).
+			SetObjectKey(record.ObjectKey).
+			SetTrigger(record.Trigger).
+			OnConflictColumns(
+				customerstatement.FieldCustomerID,
+				customerstatement.FieldCurrency,
+				customerstatement.FieldPeriodStart,
+				customerstatement.FieldPeriodEnd,
+			).
+			UpdateNewValues().
+			ID(ctx)
+		if err != nil {
+			return fmt.Errorf("save customer statement: %w", err)
+		}
+
+		saved, err = tx.CustomerStatement.Get(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get customer statement: %w", err)
+		}
+
+		payload["statement_id"] = saved.ID.String()
+
+		return outbox.Enqueue(ctx, tx, outbox.Event{
+			TenantID:      tenantID,
+			AggregateType: "customer_statement",
+			AggregateID:   saved.ID,
+			EventType:     EventStatementGenerated,
+			Payload:       payload,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntRecord(saved), nil
+}
+
+// List returns the customer's stored statements, newest period first.
+func (r *EntRepository) List(ctx context.Context, tenantID uuid.UUID, customerID uuid.UUID) ([]*Record, error) {
+	entRecords, err := r.client.CustomerStatement.Query().
+		Where(
+			customerstatement.TenantID(tenantID),
+			customerstatement.CustomerID(customerID),
+		).
+		Order(ent.Desc(customerstatement.FieldPeriodEnd), ent.Asc(customerstatement.FieldCurrency)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list customer statements: %w", err)
+	}
+
+	records := make([]*Record, len(entRecords))
+	for i, entRecord := range entRecords {
+		records[i] = mapEntRecord(entRecord)
+	}
+	return records, nil
+}
+
+func mapEntRecord(entRecord *ent.CustomerStatement) *Record {
+	return &Record{
+		ID:             entRecord.ID,
+		CustomerID:     entRecord.CustomerID,
+		Currency:       entRecord.Currency,
+		PeriodStart:    entRecord.PeriodStart,
+		PeriodEnd:      entRecord.PeriodEnd,
+		OpeningBalance: entRecord.OpeningBalance,
+		ClosingBalance: entRecord.ClosingBalance,
+		ObjectKey:      entRecord.ObjectKey,
+		Trigger:        entRecord.Trigger,
+		GeneratedAt:    entRecord.GeneratedAt,
+	}
+}
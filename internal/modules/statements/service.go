@@ -0,0 +1,246 @@
+package statements
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/aging"
+	"github.com/bengobox/treasury-api/internal/modules/customers"
+)
+
+// ObjectStore stores rendered statements.
+type ObjectStore interface {
+	Bucket() string
+	Put(ctx context.Context, key, contentType string, body []byte) error
+}
+
+// Service builds customer statements and stores them for delivery.
+type Service struct {
+	repo      Repository
+	customers *customers.Service
+	aging     *aging.Service
+	store     ObjectStore
+	logger    *zap.Logger
+}
+
+// NewService creates a new statements service.
+func NewService(repo Repository, customersService *customers.Service, agingService *aging.Service, store ObjectStore, logger *zap.Logger) *Service {
+	return &Service{
+		repo:      repo,
+		customers: customersService,
+		aging:     agingService,
+		store:     store,
+		logger:    logger,
+	}
+}
+
+// Statement builds a customer's statement for the requested period.
+func (s *Service) Statement(ctx context.Context, tenantID uuid.UUID, customerID uuid.UUID, req Request) (*Statement, error) {
+	now := time.Now().UTC()
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if req.From != nil {
+		from = *req.From
+	}
+	if req.To != nil {
+		to = *req.To
+	}
+	if to.Before(from) {
+		return nil, fmt.Errorf("%w: to precedes from", ErrInvalidRequest)
+	}
+
+	customer, err := s.customers.Get(ctx, tenantID, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	ledger, err := s.customers.Ledger(ctx, tenantID, customerID, customers.LedgerFilter{
+		Currency: req.Currency,
+		From:     &from,
+		To:       &to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.aging.Receivables(ctx, tenantID, aging.Options{
+		AsOf:        to,
+		GroupBy:     aging.GroupByCustomer,
+		CustomerIDs: customer.Identities(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	st := &Statement{
+		CustomerID:     customer.ID,
+		CustomerNumber: customer.CustomerNumber,
+		CustomerName:   customer.LegalName,
+		KRAPIN:         customer.KRAPIN,
+		Email:          customer.Email,
+		Currency:       ledger.Currency,
+		From:           from,
+		To:             to,
+		OpeningBalance: ledger.OpeningBalance,
+		Entries:        ledger.Entries,
+		ClosingBalance: ledger.ClosingBalance,
+		GeneratedAt:    now,
+	}
+	st.Invoiced, st.Payments, st.Credits = summarize(ledger.Entries)
+	for i := range customer.BillingAddresses {
+		if i == 0 || customer.BillingAddresses[i].Default {
+			st.BillingAddress = &customer.BillingAddresses[i]
+		}
+	}
+
+	st.Aging = make([]AgingBucket, len(report.Buckets))
+	for i, label := range report.Buckets {
+		st.Aging[i].Label = label
+	}
+	for _, row := range report.Rows {
+		if row.Currency != st.Currency {
+			continue
+		}
+		for i, amount := range row.Buckets {
+			st.Aging[i].Amount = st.Aging[i].Amount.Add(amount)
+		}
+		st.UnappliedCredits = st.UnappliedCredits.Add(row.Credits)
+	}
+
+	return st, nil
+}
+
+// Generate renders a customer's statement to PDF, stores it and publishes
+// treasury.statement.generated.
+func (s *Service) Generate(ctx context.Context, tenantID uuid.UUID, customerID uuid.UUID, req Request, trigger string) (*Record, error) {
+	st, err := s.Statement(ctx, tenantID, customerID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("statements/%s/%s/%s_%s_%s.pdf",
+		tenantID, st.CustomerNumber, st.From.Format("20060102"), st.To.Format("20060102"), st.Currency)
+	if err := s.store.Put(ctx, key, "application/pdf", RenderPDF(st)); err != nil {
+		return nil, fmt.Errorf("store statement: %w", err)
+	}
+
+	record, err := s.repo.Save(ctx, tenantID, &Record{
+		CustomerID:     st.CustomerID,
+		Currency:       st.Currency,
+		PeriodStart:    st.From,
+		PeriodEnd:      st.To,
+		OpeningBalance: st.OpeningBalance,
+		ClosingBalance: st.ClosingBalance,
+		ObjectKey:      key,
+		Trigger:        trigger,
+	}, s.eventPayload(st, key, trigger))
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("customer statement generated",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("customer_id", customerID.String()),
+		zap.String("currency", st.Currency),
+		zap.String("object_key", key),
+		zap.String("trigger", trigger),
+	)
+
+	return record, nil
+}
+
+// List returns the customer's stored statements.
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, customerID uuid.UUID) ([]*Record, error) {
+	if _, err := s.customers.Get(ctx, tenantID, customerID); err != nil {
+		return nil, err
+	}
+	return s.repo.List(ctx, tenantID, customerID)
+}
+
+// RunMonthEnd generates last month's statements for every customer with an
+// outstanding balance at month end. Statements already stored are skipped,
+// so the job can run repeatedly during the month.
+func (s *Service) RunMonthEnd(ctx context.Context) error {
+	now := time.Now().UTC()
+	to := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+	from := time.Date(to.Year(), to.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	tenantIDs, err := s.repo.TenantIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	generated, failed := 0, 0
+	for _, tenantID := range tenantIDs {
+		report, err := s.aging.Receivables(ctx, tenantID, aging.Options{AsOf: to, GroupBy: aging.GroupByCustomer})
+		if err != nil {
+			return err
+		}
+
+		for _, row := range report.Rows {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			// Rows without a label reference an auth user with no customer record.
+			if row.PartyID == nil || row.Label == "" || row.Balance.IsZero() {
+				continue
+			}
+
+			exists, err := s.repo.Exists(ctx, *row.PartyID, row.Currency, from, to)
+			if err != nil {
+				return err
+			}
+			if exists {
+				continue
+			}
+
+			if _, err := s.Generate(ctx, tenantID, *row.PartyID, Request{Currency: row.Currency, From: &from, To: &to}, TriggerScheduled); err != nil {
+				failed++
+				s.logger.Warn("month-end statement failed",
+					zap.String("tenant_id", tenantID.String()),
+					zap.String("customer_id", row.PartyID.String()),
+					zap.String("currency", row.Currency),
+					zap.Error(err),
+				)
+				continue
+			}
+			generated++
+		}
+	}
+
+	if generated > 0 || failed > 0 {
+		s.logger.Info("month-end statements run completed",
+			zap.String("period", from.Format("2006-01")),
+			zap.Int("generated", generated),
+			zap.Int("failed", failed),
+		)
+	}
+
+	return nil
+}
+
+func (s *Service) eventPayload(st *Statement, key, trigger string) map[string]any {
+	payload := map[string]any{
+		"customer_id":     st.CustomerID.String(),
+		"customer_number": st.CustomerNumber,
+		"customer_name":   st.CustomerName,
+		"currency":        st.Currency,
+		"period_start":    st.From.Format(time.DateOnly),
+		"period_end":      st.To.Format(time.DateOnly),
+		"opening_balance": st.OpeningBalance.String(),
+		"closing_balance": st.ClosingBalance.String(),
+		"bucket":          s.store.Bucket(),
+		"object_key":      key,
+		"content_type":    "application/pdf",
+		"trigger":         trigger,
+	}
+	if email := strings.TrimSpace(st.Email); email != "" {
+		payload["email"] = email
+	}
+	return payload
+}
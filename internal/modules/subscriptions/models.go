@@ -0,0 +1,140 @@
+package subscriptions
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Subscription statuses.
+const (
+	StatusTrialing  = "trialing"
+	StatusActive    = "active"
+	StatusCancelled = "cancelled"
+)
+
+// Billing intervals.
+const (
+	IntervalDay   = "day"
+	IntervalWeek  = "week"
+	IntervalMonth = "month"
+	IntervalYear  = "year"
+)
+
+// Billing cycle statuses.
+const (
+	CycleStatusPending  = "pending"
+	CycleStatusInvoiced = "invoiced"
+	CycleStatusFailed   = "failed"
+)
+
+// Adjustment kinds.
+const (
+	AdjustmentProration = "proration"
+	AdjustmentCharge    = "charge"
+	AdjustmentCredit    = "credit"
+)
+
+// Subscription events published through the outbox.
+const (
+	EventSubscriptionCreated               = "treasury.subscription.created"
+	EventSubscriptionActivated             = "treasury.subscription.activated"
+	EventSubscriptionRenewed               = "treasury.subscription.renewed"
+	EventSubscriptionInvoiced              = "treasury.subscription.invoiced"
+	EventSubscriptionPlanChanged           = "treasury.subscription.plan_changed"
+	EventSubscriptionCancellationScheduled = "treasury.subscription.cancellation_scheduled"
+	EventSubscriptionCancelled             = "treasury.subscription.cancelled"
+)
+
+// Subscription represents a customer's recurring plan.
+type Subscription struct {
+	ID                 uuid.UUID       `json:"id"`
+	TenantID           uuid.UUID       `json:"tenant_id"`
+	CustomerID         uuid.UUID       `json:"customer_id"`
+	PlanCode           string          `json:"plan_code"`
+	PlanName           string          `json:"plan_name,omitempty"`
+	Price              decimal.Decimal `json:"price"`
+	Currency           string          `json:"currency"`
+	BillingInterval    string          `json:"billing_interval"`
+	IntervalCount      int             `json:"interval_count"`
+	BillingAnchorDay   *int            `json:"billing_anchor_day,omitempty"`
+	DaysUntilDue       int             `json:"days_until_due"`
+	Status             string          `json:"status"`
+	TrialEnd           *time.Time      `json:"trial_end,omitempty"`
+	CurrentPeriodStart time.Time       `json:"current_period_start"`
+	CurrentPeriodEnd   time.Time       `json:"current_period_end"`
+	NextBillingAt      time.Time       `json:"next_billing_at"`
+	CancelAtPeriodEnd  bool            `json:"cancel_at_period_end"`
+	CancelledAt        *time.Time      `json:"cancelled_at,omitempty"`
+	EndedAt            *time.Time      `json:"ended_at,omitempty"`
+	ReferenceID        string          `json:"reference_id,omitempty"`
+	Metadata           map[string]any  `json:"metadata,omitempty"`
+	CreatedAt          time.Time       `json:"created_at"`
+	UpdatedAt          time.Time       `json:"updated_at"`
+}
+
+// BillingCycle records the invoice generated for one subscription period.
+type BillingCycle struct {
+	ID             uuid.UUID       `json:"id"`
+	SubscriptionID uuid.UUID       `json:"subscription_id"`
+	CycleStart     time.Time       `json:"cycle_start"`
+	CycleEnd       time.Time       `json:"cycle_end"`
+	InvoiceID      *uuid.UUID      `json:"invoice_id,omitempty"`
+	Status         string          `json:"status"`
+	UsageAmount    decimal.Decimal `json:"usage_amount"`
+	BillingAmount  decimal.Decimal `json:"billing_amount"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// Adjustment is a one-off charge or credit billed on the next cycle.
+type Adjustment struct {
+	ID             uuid.UUID       `json:"id"`
+	SubscriptionID uuid.UUID       `json:"subscription_id"`
+	Kind           string          `json:"kind"`
+	Description    string          `json:"description"`
+	Amount         decimal.Decimal `json:"amount"`
+	Currency       string          `json:"currency"`
+	BillingCycleID *uuid.UUID      `json:"billing_cycle_id,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// CreateRequest describes a new subscription.
+type CreateRequest struct {
+	CustomerID       uuid.UUID       `json:"customer_id"`
+	PlanCode         string          `json:"plan_code"`
+	PlanName         string          `json:"plan_name"`
+	Price            decimal.Decimal `json:"price"`
+	Currency         string          `json:"currency"`
+	BillingInterval  string          `json:"billing_interval"`
+	IntervalCount    int             `json:"interval_count"`
+	BillingAnchorDay *int            `json:"billing_anchor_day"`
+	DaysUntilDue     int             `json:"days_until_due"`
+	TrialDays        int             `json:"trial_days"`
+	StartDate        *time.Time      `json:"start_date"`
+	ReferenceID      string          `json:"reference_id"`
+	Metadata         map[string]any  `json:"metadata"`
+}
+
+// ChangePlanRequest moves a subscription to another plan or price. When the
+// current period has already been invoiced the difference is prorated onto the
+// next invoice.
+type ChangePlanRequest struct {
+	PlanCode string          `json:"plan_code"`
+	PlanName string          `json:"plan_name"`
+	Price    decimal.Decimal `json:"price"`
+}
+
+// CancelRequest cancels a subscription immediately or at the end of the
+// current period.
+type CancelRequest struct {
+	AtPeriodEnd bool `json:"at_period_end"`
+}
+
+// ListFilter narrows subscription listings.
+type ListFilter struct {
+	CustomerID *uuid.UUID
+	Status     string
+	Limit      int
+	Offset     int
+}
@@ -0,0 +1,129 @@
+package subscriptions
+
+import (
+	"math"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// periodEnd returns the end (exclusive) of a billing period starting at start.
+// Monthly and yearly periods land on the anchor day, clamped to the length of
+// the target month, so a 31st anchor renews on the last day of short months.
+func periodEnd(start time.Time, interval string, count, anchorDay int) time.Time {
+	if count < 1 {
+		count = 1
+	}
+
+	switch interval {
+	case IntervalDay:
+		return start.AddDate(0, 0, count)
+	case IntervalWeek:
+		return start.AddDate(0, 0, 7*count)
+	case IntervalYear:
+		return addMonths(start, 12*count, anchorDay)
+	default:
+		return addMonths(start, count, anchorDay)
+	}
+}
+
+// periodStart is the inverse of periodEnd: the start of the full period that
+// ends at end.
+func periodStart(end time.Time, interval string, count, anchorDay int) time.Time {
+	if count < 1 {
+		count = 1
+	}
+
+	switch interval {
+	case IntervalDay:
+		return end.AddDate(0, 0, -count)
+	case IntervalWeek:
+		return end.AddDate(0, 0, -7*count)
+	case IntervalYear:
+		return addMonths(end, -12*count, anchorDay)
+	default:
+		return addMonths(end, -count, anchorDay)
+	}
+}
+
+// firstPeriodEnd returns the end of the first period starting at start. With
+// an anchor day later in the start month the first period is a stub that ends
+// on that anchor date; otherwise it runs to the anchor date one full interval
+// on.
+func firstPeriodEnd(start time.Time, interval string, count, anchorDay int) time.Time {
+	if anchorDay == 0 || (interval != IntervalMonth && interval != IntervalYear) {
+		return periodEnd(start, interval, count, anchorDay)
+	}
+
+	candidate := onDay(start.Year(), start.Month(), anchorDay, start)
+	if !candidate.After(start) {
+		return periodEnd(start, interval, count, anchorDay)
+	}
+	return candidate
+}
+
+// periodCharge returns the price billed for [start, end). Periods shorter
+// than a full interval (anchored stubs) are prorated by day.
+func periodCharge(price decimal.Decimal, start, end time.Time, interval string, count, anchorDay int) decimal.Decimal {
+	full := periodStart(end, interval, count, anchorDay)
+	if !start.After(full) {
+		return price
+	}
+	return prorate(price, start, end, full, end)
+}
+
+// prorate returns the share of amount covering [from, to) out of the period
+// [start, end), by whole days and rounded to cents.
+func prorate(amount decimal.Decimal, from, to, start, end time.Time) decimal.Decimal {
+	total := days(start, end)
+	if total <= 0 {
+		return decimal.Zero
+	}
+	used := days(from, to)
+	if used <= 0 {
+		return decimal.Zero
+	}
+	if used > total {
+		used = total
+	}
+	return amount.Mul(decimal.NewFromInt(int64(used))).Div(decimal.NewFromInt(int64(total))).Round(2)
+}
+
+// selectAdjustments picks pending adjustments, in order, for an invoice with
+// the given base amount. An adjustment that would push the invoice total below
+// zero is skipped and stays pending for a later cycle.
+func selectAdjustments(base decimal.Decimal, amounts []decimal.Decimal) []int {
+	selected := make([]int, 0, len(amounts))
+	total := base
+	for i, amount := range amounts {
+		if total.Add(amount).IsNegative() {
+			continue
+		}
+		total = total.Add(amount)
+		selected = append(selected, i)
+	}
+	return selected
+}
+
+func addMonths(t time.Time, months, anchorDay int) time.Time {
+	day := anchorDay
+	if day == 0 {
+		day = t.Day()
+	}
+	first := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).AddDate(0, months, 0)
+	return onDay(first.Year(), first.Month(), day, t)
+}
+
+// onDay returns the given day of the month, clamped to the month's length,
+// keeping the time of day of ref.
+func onDay(year int, month time.Month, day int, ref time.Time) time.Time {
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, ref.Location()).Day()
+	if day > last {
+		day = last
+	}
+	return time.Date(year, month, day, ref.Hour(), ref.Minute(), ref.Second(), ref.Nanosecond(), ref.Location())
+}
+
+func days(from, to time.Time) int {
+	return int(math.Round(to.Sub(from).Hours() / 24))
+}
@@ -0,0 +1,88 @@
+package subscriptions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestPeriodEnd(t *testing.T) {
+	tests := []struct {
+		name      string
+		start     time.Time
+		interval  string
+		count     int
+		anchorDay int
+		want      time.Time
+	}{
+		{"daily", date(2024, 1, 30), IntervalDay, 3, 0, date(2024, 2, 2)},
+		{"fortnightly", date(2024, 1, 1), IntervalWeek, 2, 0, date(2024, 1, 15)},
+		{"month end clamps", date(2024, 1, 31), IntervalMonth, 1, 31, date(2024, 2, 29)},
+		{"anchor restores after short month", date(2024, 2, 29), IntervalMonth, 1, 31, date(2024, 3, 31)},
+		{"quarterly", date(2024, 11, 15), IntervalMonth, 3, 15, date(2025, 2, 15)},
+		{"yearly leap day", date(2024, 2, 29), IntervalYear, 1, 29, date(2025, 2, 28)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := periodEnd(tt.start, tt.interval, tt.count, tt.anchorDay)
+			if !got.Equal(tt.want) {
+				t.Fatalf("expected %s, got %s", tt.want.Format(time.DateOnly), got.Format(time.DateOnly))
+			}
+		})
+	}
+}
+
+func TestFirstPeriodEndWithAnchor(t *testing.T) {
+	got := firstPeriodEnd(date(2024, 3, 20), IntervalMonth, 1, 1)
+	if !got.Equal(date(2024, 4, 1)) {
+		t.Fatalf("expected stub period to end on the anchor day, got %s", got.Format(time.DateOnly))
+	}
+
+	got = firstPeriodEnd(date(2024, 3, 1), IntervalMonth, 1, 1)
+	if !got.Equal(date(2024, 4, 1)) {
+		t.Fatalf("expected a full period when starting on the anchor day, got %s", got.Format(time.DateOnly))
+	}
+
+	got = firstPeriodEnd(date(2025, 3, 10), IntervalYear, 1, 10)
+	if !got.Equal(date(2026, 3, 10)) {
+		t.Fatalf("expected a full year when starting on the yearly anchor day, got %s", got.Format(time.DateOnly))
+	}
+
+	got = firstPeriodEnd(date(2025, 3, 10), IntervalYear, 1, 20)
+	if !got.Equal(date(2025, 3, 20)) {
+		t.Fatalf("expected yearly stub period to end on the anchor day, got %s", got.Format(time.DateOnly))
+	}
+}
+
+func TestPeriodCharge(t *testing.T) {
+	price := decimal.NewFromInt(3100)
+
+	full := periodCharge(price, date(2024, 3, 1), date(2024, 4, 1), IntervalMonth, 1, 1)
+	if !full.Equal(price) {
+		t.Fatalf("expected full price for a full period, got %s", full)
+	}
+
+	stub := periodCharge(price, date(2024, 3, 21), date(2024, 4, 1), IntervalMonth, 1, 1)
+	if !stub.Equal(decimal.NewFromInt(1100)) {
+		t.Fatalf("expected 11 of 31 days to cost 1100, got %s", stub)
+	}
+}
+
+func TestSelectAdjustments(t *testing.T) {
+	amounts := []decimal.Decimal{
+		decimal.NewFromInt(-300),
+		decimal.NewFromInt(-900),
+		decimal.NewFromInt(150),
+	}
+
+	got := selectAdjustments(decimal.NewFromInt(1000), amounts)
+	if len(got) != 2 || got[0] != 0 || got[1] != 2 {
+		t.Fatalf("expected adjustments 0 and 2 to be billed, got %v", got)
+	}
+}
@@ -0,0 +1,30 @@
+package subscriptions
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSubscriptionNotFound  = errors.New("subscription not found")
+	ErrSubscriptionCancelled = errors.New("subscription is cancelled")
+	ErrInvalidSubscription   = errors.New("invalid subscription request")
+)
+
+// Repository abstracts persistence for subscriptions and their billing cycles.
+type Repository interface {
+	Create(ctx context.Context, tenantID uuid.UUID, sub *Subscription) (*Subscription, error)
+	Get(ctx context.Context, tenantID uuid.UUID, id uuid.UUID) (*Subscription, error)
+	List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Subscription, error)
+	ChangePlan(ctx context.Context, tenantID uuid.UUID, id uuid.UUID, req ChangePlanRequest, now time.Time) (*Subscription, []*Adjustment, error)
+	Cancel(ctx context.Context, tenantID uuid.UUID, id uuid.UUID, atPeriodEnd bool, now time.Time) (*Subscription, error)
+	ListCycles(ctx context.Context, tenantID uuid.UUID, id uuid.UUID) ([]*BillingCycle, error)
+	// DueSubscriptionIDs returns subscriptions the billing worker needs to act on.
+	DueSubscriptionIDs(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error)
+	// Advance runs the billing steps that are due for a subscription and returns
+	// the cycles invoiced along the way.
+	Advance(ctx context.Context, id uuid.UUID, now time.Time) ([]*BillingCycle, error)
+}
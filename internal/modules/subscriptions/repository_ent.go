@@ -0,0 +1,785 @@
+package subscriptions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/billingcycle"
+	"github.com/bengobox/treasury-api/internal/ent/subscription"
+	"github.com/bengobox/treasury-api/internal/ent/subscriptionadjustment"
+	"github.com/bengobox/treasury-api/internal/modules/invoices"
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+	"github.com/bengobox/treasury-api/internal/modules/metering"
+	"github.com/bengobox/treasury-api/internal/modules/outbox"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+)
+
+// maxStepsPerAdvance bounds how many billing steps one Advance call takes, so a
+// subscription that fell far behind catches up over several worker runs.
+const maxStepsPerAdvance = 24
+
+// billableStatuses are the statuses the billing worker acts on.
+var billableStatuses = []string{StatusTrialing, StatusActive}
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// Create stores a subscription and enqueues its created event.
+func (r *EntRepository) Create(ctx context.Context, tenantID uuid.UUID, sub *Subscription) (*Subscription, error) {
+	var created *Subscription
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		metadata := sub.Metadata
+		if metadata == nil {
+			metadata = map[string]any{}
+		}
+
+		builder := tx.Subscription.Create().
+			SetTenantID(tenantID).
+			SetCustomerID(sub.CustomerID).
+			SetPlanCode(sub.PlanCode).
+			SetPlanName(sub.PlanName).
+			SetPrice(sub.Price).
+			SetCurrency(sub.Currency).
+			SetBillingInterval(sub.BillingInterval).
+			SetIntervalCount(sub.IntervalCount).
+			SetDaysUntilDue(sub.DaysUntilDue).
+			SetStatus(sub.Status).
+			SetCurrentPeriodStart(sub.CurrentPeriodStart).
+			SetCurrentPeriodEnd(sub.CurrentPeriodEnd).
+			SetNextBillingAt(sub.NextBillingAt).
+			SetMetadata(metadata)
+		if sub.BillingAnchorDay != nil {
+			builder.SetBillingAnchorDay(*sub.BillingAnchorDay)
+		}
+		if sub.TrialEnd != nil {
+			builder.SetTrialEnd(*sub.TrialEnd)
+		}
+		if sub.ReferenceID != "" {
+			builder.SetReferenceID(sub.ReferenceID)
+		}
+
+		entSub, err := builder.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create subscription: %w", err)
+		}
+
+		if err := enqueueSubscriptionEvent(ctx, tx, entSub, EventSubscriptionCreated, nil); err != nil {
+			return err
+		}
+
+		created = mapEntSubscription(entSub)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// Get retrieves a subscription by ID.
+func (r *EntRepository) Get(ctx context.Context, tenantID uuid.UUID, id uuid.UUID) (*Subscription, error) {
+	entSub, err := r.client.Subscription.Query().
+		Where(
+			subscription.ID(id),
+			subscription.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("get subscription: %w", err)
+	}
+
+	return mapEntSubscription(entSub), nil
+}
+
+// List returns the tenant's subscriptions, newest first.
+func (r *EntRepository) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Subscription, error) {
+	query := r.client.Subscription.Query().
+		Where(subscription.TenantID(tenantID))
+	if filter.CustomerID != nil {
+		query = query.Where(subscription.CustomerID(*filter.CustomerID))
+	}
+	if filter.Status != "" {
+		query = query.Where(subscription.Status(filter.Status))
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	entSubs, err := query.
+		Order(ent.Desc(subscription.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list subscriptions: %w", err)
+	}
+
+	subs := make([]*Subscription, len(entSubs))
+	for i, entSub := range entSubs {
+		subs[i] = mapEntSubscription(entSub)
+	}
+
+	return subs, nil
+}
+
+// ChangePlan switches the subscription's plan. If the current period has been
+// invoiced, the unused time on the old price is credited and the remaining
+// time on the new price charged through adjustments on the next invoice.
+func (r *EntRepository) ChangePlan(ctx context.Context, tenantID uuid.UUID, id uuid.UUID, req ChangePlanRequest, now time.Time) (*Subscription, []*Adjustment, error) {
+	var (
+		updated     *Subscription
+		adjustments []*Adjustment
+	)
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entSub, err := lockSubscription(ctx, tx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		if entSub.Status == StatusCancelled {
+			return ErrSubscriptionCancelled
+		}
+
+		billed, err := tx.BillingCycle.Query().
+			Where(
+				billingcycle.SubscriptionID(entSub.ID),
+				billingcycle.CycleStart(entSub.CurrentPeriodStart),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check current cycle: %w", err)
+		}
+
+		if billed && now.Before(entSub.CurrentPeriodEnd) {
+			from := now
+			if from.Before(entSub.CurrentPeriodStart) {
+				from = entSub.CurrentPeriodStart
+			}
+			start, end := entSub.CurrentPeriodStart, entSub.CurrentPeriodEnd
+
+			proration := []struct {
+				description string
+				amount      decimal.Decimal
+			}{
+				{
+					description: fmt.Sprintf("Unused time on %s from %s", planLabel(entSub.PlanName, entSub.PlanCode), from.Format(time.DateOnly)),
+					amount:      prorate(entSub.Price, from, end, start, end).Neg(),
+				},
+				{
+					description: fmt.Sprintf("Remaining time on %s from %s", planLabel(req.PlanName, req.PlanCode), from.Format(time.DateOnly)),
+					amount:      prorate(req.Price, from, end, start, end),
+				},
+			}
+
+			for _, p := range proration {
+				if p.amount.IsZero() {
+					continue
+				}
+				entAdjustment, err := tx.SubscriptionAdjustment.Create().
+					SetTenantID(tenantID).
+					SetSubscriptionID(entSub.ID).
+					SetKind(AdjustmentProration).
+					SetDescription(p.description).
+					SetAmount(p.amount).
+					SetCurrency(entSub.Currency).
+					SetMetadata(map[string]any{
+						"from_plan": entSub.PlanCode,
+						"to_plan":   req.PlanCode,
+					}).
+					Save(ctx)
+				if err != nil {
+					return fmt.Errorf("create proration adjustment: %w", err)
+				}
+				adjustments = append(adjustments, mapEntAdjustment(entAdjustment))
+			}
+		}
+
+		previousPlan, previousPrice := entSub.PlanCode, entSub.Price
+
+		entSub, err = entSub.Update().
+			SetPlanCode(req.PlanCode).
+			SetPlanName(req.PlanName).
+			SetPrice(req.Price).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("change subscription plan: %w", err)
+		}
+
+		err = enqueueSubscriptionEvent(ctx, tx, entSub, EventSubscriptionPlanChanged, map[string]any{
+			"previous_plan_code": previousPlan,
+			"previous_price":     previousPrice.String(),
+			"prorated":           len(adjustments) > 0,
+		})
+		if err != nil {
+			return err
+		}
+
+		updated = mapEntSubscription(entSub)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return updated, adjustments, nil
+}
+
+// Cancel cancels a subscription immediately or schedules cancellation for the
+// end of the current period.
+func (r *EntRepository) Cancel(ctx context.Context, tenantID uuid.UUID, id uuid.UUID, atPeriodEnd bool, now time.Time) (*Subscription, error) {
+	var cancelled *Subscription
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entSub, err := lockSubscription(ctx, tx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		if entSub.Status == StatusCancelled {
+			return ErrSubscriptionCancelled
+		}
+
+		update := entSub.Update().SetCancelledAt(now)
+		eventType := EventSubscriptionCancellationScheduled
+		if atPeriodEnd {
+			update.SetCancelAtPeriodEnd(true)
+		} else {
+			if _, err := billFinal(ctx, tx, entSub, now); err != nil {
+				return err
+			}
+			update.SetStatus(StatusCancelled).SetEndedAt(now)
+			eventType = EventSubscriptionCancelled
+		}
+
+		entSub, err = update.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("cancel subscription: %w", err)
+		}
+
+		if err := enqueueSubscriptionEvent(ctx, tx, entSub, eventType, nil); err != nil {
+			return err
+		}
+
+		cancelled = mapEntSubscription(entSub)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cancelled, nil
+}
+
+// ListCycles lists the billing cycles of a subscription, most recent first.
+func (r *EntRepository) ListCycles(ctx context.Context, tenantID uuid.UUID, id uuid.UUID) ([]*BillingCycle, error) {
+	if _, err := r.Get(ctx, tenantID, id); err != nil {
+		return nil, err
+	}
+
+	entCycles, err := r.client.BillingCycle.Query().
+		Where(
+			billingcycle.TenantID(tenantID),
+			billingcycle.SubscriptionID(id),
+		).
+		Order(ent.Desc(billingcycle.FieldCycleStart)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list billing cycles: %w", err)
+	}
+
+	cycles := make([]*BillingCycle, len(entCycles))
+	for i, entCycle := range entCycles {
+		cycles[i] = mapEntBillingCycle(entCycle)
+	}
+
+	return cycles, nil
+}
+
+// DueSubscriptionIDs returns subscriptions whose next billing step is due.
+func (r *EntRepository) DueSubscriptionIDs(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error) {
+	ids, err := r.client.Subscription.Query().
+		Where(
+			subscription.StatusIn(billableStatuses...),
+			subscription.NextBillingAtLTE(now),
+		).
+		Order(ent.Asc(subscription.FieldNextBillingAt)).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list due subscriptions: %w", err)
+	}
+
+	return ids, nil
+}
+
+// Advance locks the subscription and runs each due billing step: ending
+// trials, invoicing unbilled periods and renewing or cancelling at period end.
+// Every period is invoiced at most once, guarded by the unique billing cycle
+// per (subscription, cycle start).
+func (r *EntRepository) Advance(ctx context.Context, id uuid.UUID, now time.Time) ([]*BillingCycle, error) {
+	var cycles []*BillingCycle
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entSub, err := tx.Subscription.Query().
+			Where(subscription.ID(id)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrSubscriptionNotFound
+			}
+			return fmt.Errorf("lock subscription: %w", err)
+		}
+
+		for step := 0; step < maxStepsPerAdvance && isDue(entSub, now); step++ {
+			var cycle *BillingCycle
+			entSub, cycle, err = advanceOnce(ctx, tx, entSub)
+			if err != nil {
+				return err
+			}
+			if cycle != nil {
+				cycles = append(cycles, cycle)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cycles, nil
+}
+
+func isDue(entSub *ent.Subscription, now time.Time) bool {
+	if entSub.Status != StatusTrialing && entSub.Status != StatusActive {
+		return false
+	}
+	return !entSub.NextBillingAt.After(now)
+}
+
+// advanceOnce performs the single billing step the subscription is waiting on.
+func advanceOnce(ctx context.Context, tx *ent.Tx, entSub *ent.Subscription) (*ent.Subscription, *BillingCycle, error) {
+	anchorDay := entSub.BillingAnchorDay
+
+	// An active subscription waiting at its period start has not been invoiced.
+	if entSub.Status == StatusActive && entSub.NextBillingAt.Equal(entSub.CurrentPeriodStart) {
+		cycle, err := billPeriod(ctx, tx, entSub)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		updated, err := entSub.Update().
+			SetNextBillingAt(entSub.CurrentPeriodEnd).
+			Save(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("update subscription schedule: %w", err)
+		}
+		return updated, cycle, nil
+	}
+
+	// Otherwise the period (or trial) has ended.
+	switch {
+	case entSub.CancelAtPeriodEnd:
+		cycle, err := billFinal(ctx, tx, entSub, entSub.CurrentPeriodEnd)
+		if err != nil {
+			return nil, nil, err
+		}
+		updated, err := entSub.Update().
+			SetStatus(StatusCancelled).
+			SetEndedAt(entSub.CurrentPeriodEnd).
+			Save(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("end subscription: %w", err)
+		}
+		return updated, cycle, enqueueSubscriptionEvent(ctx, tx, updated, EventSubscriptionCancelled, nil)
+
+	case entSub.Status == StatusTrialing:
+		start := entSub.CurrentPeriodEnd
+		end := firstPeriodEnd(start, entSub.BillingInterval, entSub.IntervalCount, anchorDay)
+		updated, err := entSub.Update().
+			SetStatus(StatusActive).
+			SetCurrentPeriodStart(start).
+			SetCurrentPeriodEnd(end).
+			SetNextBillingAt(start).
+			Save(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("activate subscription: %w", err)
+		}
+		return updated, nil, enqueueSubscriptionEvent(ctx, tx, updated, EventSubscriptionActivated, nil)
+
+	default:
+		start := entSub.CurrentPeriodEnd
+		end := periodEnd(start, entSub.BillingInterval, entSub.IntervalCount, anchorDay)
+		updated, err := entSub.Update().
+			SetCurrentPeriodStart(start).
+			SetCurrentPeriodEnd(end).
+			SetNextBillingAt(start).
+			Save(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("renew subscription: %w", err)
+		}
+		return updated, nil, enqueueSubscriptionEvent(ctx, tx, updated, EventSubscriptionRenewed, nil)
+	}
+}
+
+// cycleBill describes what one billing cycle invoices.
+type cycleBill struct {
+	start, end time.Time
+	// plan adds the plan charge for [start, end).
+	plan bool
+	// usageCutoff bills unbilled metered usage that occurred before it.
+	usageCutoff time.Time
+}
+
+// billPeriod invoices the current period in advance together with the
+// metered usage of earlier periods (billed in arrears) and pending
+// adjustments. It returns nil when the period was already billed.
+func billPeriod(ctx context.Context, tx *ent.Tx, entSub *ent.Subscription) (*BillingCycle, error) {
+	return billCycle(ctx, tx, entSub, cycleBill{
+		start:       entSub.CurrentPeriodStart,
+		end:         entSub.CurrentPeriodEnd,
+		plan:        true,
+		usageCutoff: entSub.CurrentPeriodStart,
+	})
+}
+
+// billFinal invoices usage and adjustments still outstanding when a
+// subscription ends at the given time. It returns nil when nothing is owed.
+func billFinal(ctx context.Context, tx *ent.Tx, entSub *ent.Subscription, at time.Time) (*BillingCycle, error) {
+	return billCycle(ctx, tx, entSub, cycleBill{
+		start:       at,
+		end:         at,
+		usageCutoff: at,
+	})
+}
+
+// billCycle creates a billing cycle and its issued invoice. Each cycle start is
+// billed at most once.
+func billCycle(ctx context.Context, tx *ent.Tx, entSub *ent.Subscription, bill cycleBill) (*BillingCycle, error) {
+	exists, err := tx.BillingCycle.Query().
+		Where(
+			billingcycle.SubscriptionID(entSub.ID),
+			billingcycle.CycleStart(bill.start),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("check billing cycle: %w", err)
+	}
+	if exists {
+		return nil, nil
+	}
+
+	subscriptionID := entSub.ID
+	var lines []invoices.DraftLine
+
+	planCharge := decimal.Zero
+	if bill.plan {
+		planCharge = periodCharge(entSub.Price, bill.start, bill.end, entSub.BillingInterval, entSub.IntervalCount, entSub.BillingAnchorDay)
+		lines = append(lines, invoices.DraftLine{
+			Description:   fmt.Sprintf("%s (%s to %s)", planLabel(entSub.PlanName, entSub.PlanCode), bill.start.Format(time.DateOnly), bill.end.AddDate(0, 0, -1).Format(time.DateOnly)),
+			Quantity:      decimal.NewFromInt(1),
+			UnitPrice:     planCharge,
+			ReferenceType: "subscription",
+			ReferenceID:   &subscriptionID,
+		})
+	}
+
+	charges, err := metering.UnbilledCharges(ctx, tx, entSub.ID, bill.usageCutoff)
+	if err != nil {
+		return nil, err
+	}
+	usageTotal := decimal.Zero
+	var recordIDs []uuid.UUID
+	for _, charge := range charges {
+		recordIDs = append(recordIDs, charge.RecordIDs...)
+		if charge.Amount.IsZero() {
+			continue
+		}
+		meterID := charge.MeterID
+		lines = append(lines, invoices.DraftLine{
+			Description:   charge.Description,
+			Quantity:      decimal.NewFromInt(1),
+			UnitPrice:     charge.Amount,
+			ReferenceType: "subscription_meter",
+			ReferenceID:   &meterID,
+			Metadata: map[string]any{
+				"meter":    charge.MeterCode,
+				"quantity": charge.Quantity.String(),
+				"records":  charge.Records,
+			},
+		})
+		usageTotal = usageTotal.Add(charge.Amount)
+	}
+
+	pending, err := tx.SubscriptionAdjustment.Query().
+		Where(
+			subscriptionadjustment.SubscriptionID(entSub.ID),
+			subscriptionadjustment.BillingCycleIDIsNil(),
+			subscriptionadjustment.Currency(entSub.Currency),
+		).
+		Order(ent.Asc(subscriptionadjustment.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list pending adjustments: %w", err)
+	}
+
+	amounts := make([]decimal.Decimal, len(pending))
+	for i, adjustment := range pending {
+		amounts[i] = adjustment.Amount
+	}
+	selected := selectAdjustments(planCharge.Add(usageTotal), amounts)
+
+	total := planCharge.Add(usageTotal)
+	adjustmentIDs := make([]uuid.UUID, 0, len(selected))
+	for _, i := range selected {
+		adjustmentID := pending[i].ID
+		lines = append(lines, invoices.DraftLine{
+			Description:   pending[i].Description,
+			Quantity:      decimal.NewFromInt(1),
+			UnitPrice:     pending[i].Amount,
+			ReferenceType: "subscription_adjustment",
+			ReferenceID:   &adjustmentID,
+		})
+		total = total.Add(pending[i].Amount)
+		adjustmentIDs = append(adjustmentIDs, adjustmentID)
+	}
+
+	if !bill.plan && len(recordIDs) == 0 && len(adjustmentIDs) == 0 {
+		return nil, nil
+	}
+
+	entCycle, err := tx.BillingCycle.Create().
+		SetTenantID(entSub.TenantID).
+		SetSubscriptionID(entSub.ID).
+		SetCycleStart(bill.start).
+		SetCycleEnd(bill.end).
+		SetStatus(CycleStatusPending).
+		SetUsageAmount(usageTotal).
+		SetBillingAmount(total).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create billing cycle: %w", err)
+	}
+
+	cycleUpdate := entCycle.Update().SetStatus(CycleStatusInvoiced)
+
+	// Cycles without any non-zero line (free plans without usage) are recorded
+	// without an invoice.
+	billable := false
+	for _, line := range lines {
+		if !line.UnitPrice.IsZero() {
+			billable = true
+			break
+		}
+	}
+
+	var inv *invoices.Invoice
+	if billable {
+		customerID := entSub.CustomerID
+		inv, err = invoices.Create(ctx, tx, invoices.Draft{
+			TenantID:       entSub.TenantID,
+			CustomerID:     &customerID,
+			InvoiceType:    "recurring",
+			InvoiceDate:    bill.start,
+			DueDate:        bill.start.AddDate(0, 0, entSub.DaysUntilDue),
+			Currency:       entSub.Currency,
+			ReferenceType:  "subscription",
+			ReferenceID:    &subscriptionID,
+			RevenueAccount: ledger.AccountSubscriptionRevenue,
+			Issue:          true,
+			Metadata: map[string]any{
+				"billing_cycle_id": entCycle.ID.String(),
+				"plan_code":        entSub.PlanCode,
+			},
+			Lines: lines,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("invoice billing cycle: %w", err)
+		}
+		cycleUpdate.SetInvoiceID(inv.ID)
+	}
+
+	if err := metering.MarkBilled(ctx, tx, recordIDs, entCycle.ID); err != nil {
+		return nil, err
+	}
+
+	if len(adjustmentIDs) > 0 {
+		err := tx.SubscriptionAdjustment.Update().
+			Where(subscriptionadjustment.IDIn(adjustmentIDs...)).
+			SetBillingCycleID(entCycle.ID).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("mark adjustments billed: %w", err)
+		}
+	}
+
+	entCycle, err = cycleUpdate.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("update billing cycle: %w", err)
+	}
+
+	extra := map[string]any{
+		"billing_cycle_id": entCycle.ID.String(),
+		"cycle_start":      bill.start,
+		"cycle_end":        bill.end,
+		"amount":           total.String(),
+		"usage_amount":     usageTotal.String(),
+	}
+	if inv != nil {
+		extra["invoice_id"] = inv.ID.String()
+		extra["invoice_number"] = inv.InvoiceNumber
+	}
+	if err := enqueueSubscriptionEvent(ctx, tx, entSub, EventSubscriptionInvoiced, extra); err != nil {
+		return nil, err
+	}
+
+	return mapEntBillingCycle(entCycle), nil
+}
+
+func lockSubscription(ctx context.Context, tx *ent.Tx, tenantID uuid.UUID, id uuid.UUID) (*ent.Subscription, error) {
+	entSub, err := tx.Subscription.Query().
+		Where(
+			subscription.ID(id),
+			subscription.TenantID(tenantID),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("lock subscription: %w", err)
+	}
+	return entSub, nil
+}
+
+// enqueueSubscriptionEvent publishes the subscription's state with any extra
+// event-specific fields.
+func enqueueSubscriptionEvent(ctx context.Context, tx *ent.Tx, entSub *ent.Subscription, eventType string, extra map[string]any) error {
+	payload := map[string]any{
+		"subscription_id":      entSub.ID.String(),
+		"customer_id":          entSub.CustomerID.String(),
+		"plan_code":            entSub.PlanCode,
+		"price":                entSub.Price.String(),
+		"currency":             entSub.Currency,
+		"status":               entSub.Status,
+		"current_period_start": entSub.CurrentPeriodStart,
+		"current_period_end":   entSub.CurrentPeriodEnd,
+		"cancel_at_period_end": entSub.CancelAtPeriodEnd,
+	}
+	if entSub.ReferenceID != "" {
+		payload["reference_id"] = entSub.ReferenceID
+	}
+	for key, value := range extra {
+		payload[key] = value
+	}
+
+	return outbox.Enqueue(ctx, tx, outbox.Event{
+		TenantID:      entSub.TenantID,
+		AggregateType: "subscription",
+		AggregateID:   entSub.ID,
+		EventType:     eventType,
+		Payload:       payload,
+	})
+}
+
+func planLabel(name, code string) string {
+	if name != "" {
+		return name
+	}
+	return code
+}
+
+func mapEntSubscription(entSub *ent.Subscription) *Subscription {
+	sub := &Subscription{
+		ID:                 entSub.ID,
+		TenantID:           entSub.TenantID,
+		CustomerID:         entSub.CustomerID,
+		PlanCode:           entSub.PlanCode,
+		PlanName:           entSub.PlanName,
+		Price:              entSub.Price,
+		Currency:           entSub.Currency,
+		BillingInterval:    entSub.BillingInterval,
+		IntervalCount:      entSub.IntervalCount,
+		DaysUntilDue:       entSub.DaysUntilDue,
+		Status:             entSub.Status,
+		CurrentPeriodStart: entSub.CurrentPeriodStart,
+		CurrentPeriodEnd:   entSub.CurrentPeriodEnd,
+		NextBillingAt:      entSub.NextBillingAt,
+		CancelAtPeriodEnd:  entSub.CancelAtPeriodEnd,
+		ReferenceID:        entSub.ReferenceID,
+		Metadata:           entSub.Metadata,
+		CreatedAt:          entSub.CreatedAt,
+		UpdatedAt:          entSub.UpdatedAt,
+	}
+	if entSub.BillingAnchorDay != 0 {
+		anchorDay := entSub.BillingAnchorDay
+		sub.BillingAnchorDay = &anchorDay
+	}
+	if !entSub.TrialEnd.IsZero() {
+		trialEnd := entSub.TrialEnd
+		sub.TrialEnd = &trialEnd
+	}
+	if !entSub.CancelledAt.IsZero() {
+		cancelledAt := entSub.CancelledAt
+		sub.CancelledAt = &cancelledAt
+	}
+	if !entSub.EndedAt.IsZero() {
+		endedAt := entSub.EndedAt
+		sub.EndedAt = &endedAt
+	}
+	return sub
+}
+
+func mapEntBillingCycle(entCycle *ent.BillingCycle) *BillingCycle {
+	cycle := &BillingCycle{
+		ID:             entCycle.ID,
+		SubscriptionID: entCycle.SubscriptionID,
+		CycleStart:     entCycle.CycleStart,
+		CycleEnd:       entCycle.CycleEnd,
+		Status:         entCycle.Status,
+		UsageAmount:    entCycle.UsageAmount,
+		BillingAmount:  entCycle.BillingAmount,
+		CreatedAt:      entCycle.CreatedAt,
+	}
+	if entCycle.InvoiceID != uuid.Nil {
+		invoiceID := entCycle.InvoiceID
+		cycle.InvoiceID = &invoiceID
+	}
+	return cycle
+}
+
+func mapEntAdjustment(entAdjustment *ent.SubscriptionAdjustment) *Adjustment {
+	adjustment := &Adjustment{
+		ID:             entAdjustment.ID,
+		SubscriptionID: entAdjustment.SubscriptionID,
+		Kind:           entAdjustment.Kind,
+		Description:    entAdjustment.Description,
+		Amount:         entAdjustment.Amount,
+		Currency:       entAdjustment.Currency,
+		CreatedAt:      entAdjustment.CreatedAt,
+	}
+	if entAdjustment.BillingCycleID != uuid.Nil {
+		cycleID := entAdjustment.BillingCycleID
+		adjustment.BillingCycleID = &cycleID
+	}
+	return adjustment
+}
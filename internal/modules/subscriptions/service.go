@@ -0,0 +1,232 @@
+package subscriptions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// billingBatchSize is how many due subscriptions one billing run picks up.
+const billingBatchSize = 100
+
+// Service provides business logic for subscriptions and recurring billing.
+type Service struct {
+	repo   Repository
+	logger *zap.Logger
+}
+
+// NewService creates a new subscriptions service.
+func NewService(repo Repository, logger *zap.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Create starts a subscription. Subscriptions with a trial start billing when
+// the trial ends; others are invoiced for their first period by the next
+// billing run.
+func (s *Service) Create(ctx context.Context, tenantID uuid.UUID, req CreateRequest) (*Subscription, error) {
+	if err := validateCreate(&req); err != nil {
+		return nil, err
+	}
+
+	start := time.Now().UTC().Truncate(time.Second)
+	if req.StartDate != nil {
+		start = req.StartDate.UTC().Truncate(time.Second)
+	}
+
+	// Monthly and yearly plans renew on a fixed day so short months do not
+	// drift the cycle.
+	if req.BillingAnchorDay == nil && (req.BillingInterval == IntervalMonth || req.BillingInterval == IntervalYear) {
+		anchorDay := start.Day()
+		req.BillingAnchorDay = &anchorDay
+	}
+	anchorDay := 0
+	if req.BillingAnchorDay != nil {
+		anchorDay = *req.BillingAnchorDay
+	}
+
+	sub := &Subscription{
+		CustomerID:       req.CustomerID,
+		PlanCode:         req.PlanCode,
+		PlanName:         req.PlanName,
+		Price:            req.Price,
+		Currency:         req.Currency,
+		BillingInterval:  req.BillingInterval,
+		IntervalCount:    req.IntervalCount,
+		BillingAnchorDay: req.BillingAnchorDay,
+		DaysUntilDue:     req.DaysUntilDue,
+		ReferenceID:      req.ReferenceID,
+		Metadata:         req.Metadata,
+	}
+
+	if req.TrialDays > 0 {
+		trialEnd := start.AddDate(0, 0, req.TrialDays)
+		sub.Status = StatusTrialing
+		sub.TrialEnd = &trialEnd
+		sub.CurrentPeriodStart = start
+		sub.CurrentPeriodEnd = trialEnd
+		sub.NextBillingAt = trialEnd
+	} else {
+		sub.Status = StatusActive
+		sub.CurrentPeriodStart = start
+		sub.CurrentPeriodEnd = firstPeriodEnd(start, req.BillingInterval, req.IntervalCount, anchorDay)
+		sub.NextBillingAt = start
+	}
+
+	created, err := s.repo.Create(ctx, tenantID, sub)
+	if err != nil {
+		return nil, fmt.Errorf("create subscription: %w", err)
+	}
+
+	s.logger.Info("subscription created",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("subscription_id", created.ID.String()),
+		zap.String("plan_code", created.PlanCode),
+		zap.String("status", created.Status),
+	)
+
+	return created, nil
+}
+
+// Get retrieves a subscription.
+func (s *Service) Get(ctx context.Context, tenantID uuid.UUID, id uuid.UUID) (*Subscription, error) {
+	return s.repo.Get(ctx, tenantID, id)
+}
+
+// List lists the tenant's subscriptions.
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Subscription, error) {
+	return s.repo.List(ctx, tenantID, filter)
+}
+
+// ListCycles lists a subscription's billing cycles.
+func (s *Service) ListCycles(ctx context.Context, tenantID uuid.UUID, id uuid.UUID) ([]*BillingCycle, error) {
+	return s.repo.ListCycles(ctx, tenantID, id)
+}
+
+// ChangePlan moves a subscription to a new plan, prorating the current period
+// when it has already been invoiced.
+func (s *Service) ChangePlan(ctx context.Context, tenantID uuid.UUID, id uuid.UUID, req ChangePlanRequest) (*Subscription, []*Adjustment, error) {
+	req.PlanCode = strings.TrimSpace(req.PlanCode)
+	if req.PlanCode == "" {
+		return nil, nil, fmt.Errorf("%w: plan_code is required", ErrInvalidSubscription)
+	}
+	if req.Price.IsNegative() {
+		return nil, nil, fmt.Errorf("%w: price cannot be negative", ErrInvalidSubscription)
+	}
+
+	sub, adjustments, err := s.repo.ChangePlan(ctx, tenantID, id, req, time.Now().UTC())
+	if err != nil {
+		return nil, nil, fmt.Errorf("change subscription plan: %w", err)
+	}
+
+	s.logger.Info("subscription plan changed",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("subscription_id", id.String()),
+		zap.String("plan_code", sub.PlanCode),
+		zap.Int("adjustments", len(adjustments)),
+	)
+
+	return sub, adjustments, nil
+}
+
+// Cancel cancels a subscription now or at the end of its current period.
+func (s *Service) Cancel(ctx context.Context, tenantID uuid.UUID, id uuid.UUID, req CancelRequest) (*Subscription, error) {
+	sub, err := s.repo.Cancel(ctx, tenantID, id, req.AtPeriodEnd, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("cancel subscription: %w", err)
+	}
+
+	s.logger.Info("subscription cancelled",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("subscription_id", id.String()),
+		zap.Bool("at_period_end", req.AtPeriodEnd),
+	)
+
+	return sub, nil
+}
+
+// RunBilling advances every subscription whose next billing step is due. A
+// failure on one subscription is logged and does not stop the others; it is
+// retried on the next run.
+func (s *Service) RunBilling(ctx context.Context) error {
+	now := time.Now().UTC()
+
+	ids, err := s.repo.DueSubscriptionIDs(ctx, now, billingBatchSize)
+	if err != nil {
+		return err
+	}
+
+	invoiced := 0
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		cycles, err := s.repo.Advance(ctx, id, now)
+		if err != nil {
+			s.logger.Error("subscription billing failed",
+				zap.String("subscription_id", id.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		invoiced += len(cycles)
+	}
+
+	if len(ids) > 0 {
+		s.logger.Info("subscription billing run completed",
+			zap.Int("subscriptions", len(ids)),
+			zap.Int("cycles_invoiced", invoiced),
+		)
+	}
+
+	return nil
+}
+
+func validateCreate(req *CreateRequest) error {
+	req.PlanCode = strings.TrimSpace(req.PlanCode)
+	if req.CustomerID == uuid.Nil {
+		return fmt.Errorf("%w: customer_id is required", ErrInvalidSubscription)
+	}
+	if req.PlanCode == "" {
+		return fmt.Errorf("%w: plan_code is required", ErrInvalidSubscription)
+	}
+	if req.Price.IsNegative() {
+		return fmt.Errorf("%w: price cannot be negative", ErrInvalidSubscription)
+	}
+	if req.Currency == "" {
+		req.Currency = "KES"
+	}
+	if req.BillingInterval == "" {
+		req.BillingInterval = IntervalMonth
+	}
+	switch req.BillingInterval {
+	case IntervalDay, IntervalWeek, IntervalMonth, IntervalYear:
+	default:
+		return fmt.Errorf("%w: billing_interval must be day, week, month or year", ErrInvalidSubscription)
+	}
+	if req.IntervalCount == 0 {
+		req.IntervalCount = 1
+	}
+	if req.IntervalCount < 0 {
+		return fmt.Errorf("%w: interval_count must be positive", ErrInvalidSubscription)
+	}
+	if req.BillingAnchorDay != nil {
+		if *req.BillingAnchorDay < 1 || *req.BillingAnchorDay > 31 {
+			return fmt.Errorf("%w: billing_anchor_day must be between 1 and 31", ErrInvalidSubscription)
+		}
+		if req.BillingInterval != IntervalMonth && req.BillingInterval != IntervalYear {
+			return fmt.Errorf("%w: billing_anchor_day only applies to monthly and yearly plans", ErrInvalidSubscription)
+		}
+	}
+	if req.DaysUntilDue < 0 || req.TrialDays < 0 {
+		return fmt.Errorf("%w: days_until_due and trial_days cannot be negative", ErrInvalidSubscription)
+	}
+	return nil
+}
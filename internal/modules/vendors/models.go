@@ -0,0 +1,95 @@
+package vendors
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/customers/profile"
+)
+
+// Vendor statuses.
+const (
+	StatusActive   = "active"
+	StatusInactive = "inactive"
+)
+
+// Vendor is a supplier the tenant is billed by.
+type Vendor struct {
+	ID                    uuid.UUID         `json:"id"`
+	TenantID              uuid.UUID         `json:"tenant_id"`
+	VendorNumber          string            `json:"vendor_number"`
+	LegalName             string            `json:"legal_name"`
+	TradingName           string            `json:"trading_name,omitempty"`
+	KRAPIN                string            `json:"kra_pin,omitempty"`
+	Email                 string            `json:"email,omitempty"`
+	Phone                 string            `json:"phone,omitempty"`
+	Addresses             []profile.Address `json:"addresses"`
+	Contacts              []profile.Contact `json:"contacts"`
+	DefaultCurrency       string            `json:"default_currency"`
+	PaymentTermsDays      int               `json:"payment_terms_days"`
+	EarlyPaymentDiscount  decimal.Decimal   `json:"early_payment_discount"`
+	EarlyPaymentDays      int               `json:"early_payment_days"`
+	WithholdingCategory   string            `json:"withholding_category,omitempty"`
+	DefaultExpenseAccount string            `json:"default_expense_account,omitempty"`
+	PaymentMethod         string            `json:"payment_method,omitempty"` // bank_transfer, mpesa, cheque, cash
+	PaymentDetails        map[string]any    `json:"payment_details,omitempty"`
+	Status                string            `json:"status"`
+	Metadata              map[string]any    `json:"metadata,omitempty"`
+	CreatedAt             time.Time         `json:"created_at"`
+	UpdatedAt             time.Time         `json:"updated_at"`
+}
+
+// CreateRequest creates a vendor. EarlyPaymentDiscount is a fraction of the
+// bill (0.02 for "2/10 net 30") taken when the bill is paid within
+// EarlyPaymentDays of the bill date. WithholdingCategory is the service
+// category withholding tax is deducted under when the vendor's bills are paid.
+type CreateRequest struct {
+	LegalName             string            `json:"legal_name"`
+	TradingName           string            `json:"trading_name"`
+	KRAPIN                string            `json:"kra_pin"`
+	Email                 string            `json:"email"`
+	Phone                 string            `json:"phone"`
+	Addresses             []profile.Address `json:"addresses"`
+	Contacts              []profile.Contact `json:"contacts"`
+	DefaultCurrency       string            `json:"default_currency"`
+	PaymentTermsDays      *int              `json:"payment_terms_days"`
+	EarlyPaymentDiscount  decimal.Decimal   `json:"early_payment_discount"`
+	EarlyPaymentDays      int               `json:"early_payment_days"`
+	WithholdingCategory   string            `json:"withholding_category"`
+	DefaultExpenseAccount string            `json:"default_expense_account"`
+	PaymentMethod         string            `json:"payment_method"`
+	PaymentDetails        map[string]any    `json:"payment_details"`
+	Metadata              map[string]any    `json:"metadata"`
+}
+
+// UpdateRequest changes the provided fields of a vendor.
+type UpdateRequest struct {
+	LegalName             *string            `json:"legal_name"`
+	TradingName           *string            `json:"trading_name"`
+	KRAPIN                *string            `json:"kra_pin"`
+	Email                 *string            `json:"email"`
+	Phone                 *string            `json:"phone"`
+	Addresses             *[]profile.Address `json:"addresses"`
+	Contacts              *[]profile.Contact `json:"contacts"`
+	DefaultCurrency       *string            `json:"default_currency"`
+	PaymentTermsDays      *int               `json:"payment_terms_days"`
+	EarlyPaymentDiscount  *decimal.Decimal   `json:"early_payment_discount"`
+	EarlyPaymentDays      *int               `json:"early_payment_days"`
+	WithholdingCategory   *string            `json:"withholding_category"`
+	DefaultExpenseAccount *string            `json:"default_expense_account"`
+	PaymentMethod         *string            `json:"payment_method"`
+	PaymentDetails        map[string]any     `json:"payment_details"`
+	Status                *string            `json:"status"`
+	Metadata              map[string]any     `json:"metadata"`
+}
+
+// ListFilter narrows vendor listings.
+type ListFilter struct {
+	// Search matches the legal name, trading name, vendor number or KRA PIN.
+	Search string
+	Status string
+	Limit  int
+	Offset int
+}
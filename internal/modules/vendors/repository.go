@@ -0,0 +1,25 @@
+package vendors
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrVendorNotFound is returned when a vendor does not exist.
+	ErrVendorNotFound = errors.New("vendor not found")
+	// ErrDuplicateVendor is returned when another vendor has the same KRA PIN.
+	ErrDuplicateVendor = errors.New("vendor already exists")
+	// ErrInvalidVendor is returned when vendor details are malformed.
+	ErrInvalidVendor = errors.New("invalid vendor")
+)
+
+// Repository abstracts persistence for vendors.
+type Repository interface {
+	Create(ctx context.Context, tenantID uuid.UUID, req CreateRequest) (*Vendor, error)
+	Get(ctx context.Context, tenantID uuid.UUID, vendorID uuid.UUID) (*Vendor, error)
+	List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Vendor, error)
+	Update(ctx context.Context, tenantID uuid.UUID, vendorID uuid.UUID, req UpdateRequest) (*Vendor, error)
+}
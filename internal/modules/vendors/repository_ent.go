@@ -0,0 +1,246 @@
+package vendors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/vendor"
+	"github.com/bengobox/treasury-api/internal/modules/customers/profile"
+	"github.com/bengobox/treasury-api/internal/modules/numbering"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+)
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// Create numbers and stores a new vendor.
+func (r *EntRepository) Create(ctx context.Context, tenantID uuid.UUID, req CreateRequest) (*Vendor, error) {
+	var created *ent.Vendor
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		if err := checkUnique(ctx, tx.Client(), tenantID, uuid.Nil, req.KRAPIN); err != nil {
+			return err
+		}
+
+		number, err := numbering.Next(ctx, tx, tenantID, numbering.SequenceVendor, numbering.PrefixVendor)
+		if err != nil {
+			return err
+		}
+
+		builder := tx.Vendor.Create().
+			SetTenantID(tenantID).
+			SetVendorNumber(number).
+			SetLegalName(req.LegalName).
+			SetTradingName(req.TradingName).
+			SetKraPin(req.KRAPIN).
+			SetEmail(req.Email).
+			SetPhone(req.Phone).
+			SetAddresses(req.Addresses).
+			SetContacts(req.Contacts).
+			SetDefaultCurrency(req.DefaultCurrency).
+			SetNillablePaymentTermsDays(req.PaymentTermsDays).
+			SetEarlyPaymentDiscount(req.EarlyPaymentDiscount).
+			SetEarlyPaymentDays(req.EarlyPaymentDays).
+			SetWithholdingCategory(req.WithholdingCategory).
+			SetDefaultExpenseAccount(req.DefaultExpenseAccount).
+			SetPaymentMethod(req.PaymentMethod).
+			SetPaymentDetails(req.PaymentDetails)
+		if req.Metadata != nil {
+			builder.SetMetadata(req.Metadata)
+		}
+
+		created, err = builder.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create vendor: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntVendor(created), nil
+}
+
+// Get retrieves a vendor.
+func (r *EntRepository) Get(ctx context.Context, tenantID uuid.UUID, vendorID uuid.UUID) (*Vendor, error) {
+	entVendor, err := r.client.Vendor.Query().
+		Where(
+			vendor.ID(vendorID),
+			vendor.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrVendorNotFound
+		}
+		return nil, fmt.Errorf("get vendor: %w", err)
+	}
+
+	return mapEntVendor(entVendor), nil
+}
+
+// List returns the tenant's vendors ordered by legal name.
+func (r *EntRepository) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Vendor, error) {
+	query := r.client.Vendor.Query().
+		Where(vendor.TenantID(tenantID))
+	if filter.Status != "" {
+		query = query.Where(vendor.Status(filter.Status))
+	}
+	if search := strings.TrimSpace(filter.Search); search != "" {
+		query = query.Where(vendor.Or(
+			vendor.LegalNameContainsFold(search),
+			vendor.TradingNameContainsFold(search),
+			vendor.VendorNumberEqualFold(search),
+			vendor.KraPinEqualFold(search),
+		))
+	}
+
+	entVendors, err := query.
+		Order(ent.Asc(vendor.FieldLegalName), ent.Asc(vendor.FieldVendorNumber)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list vendors: %w", err)
+	}
+
+	vendors := make([]*Vendor, len(entVendors))
+	for i, entVendor := range entVendors {
+		vendors[i] = mapEntVendor(entVendor)
+	}
+	return vendors, nil
+}
+
+// Update applies the provided fields to a vendor.
+func (r *EntRepository) Update(ctx context.Context, tenantID uuid.UUID, vendorID uuid.UUID, req UpdateRequest) (*Vendor, error) {
+	var updated *ent.Vendor
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		current, err := tx.Vendor.Query().
+			Where(
+				vendor.ID(vendorID),
+				vendor.TenantID(tenantID),
+			).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrVendorNotFound
+			}
+			return fmt.Errorf("lock vendor: %w", err)
+		}
+
+		if req.KRAPIN != nil {
+			if err := checkUnique(ctx, tx.Client(), tenantID, vendorID, *req.KRAPIN); err != nil {
+				return err
+			}
+		}
+
+		update := current.Update().
+			SetNillableLegalName(req.LegalName).
+			SetNillableTradingName(req.TradingName).
+			SetNillableKraPin(req.KRAPIN).
+			SetNillableEmail(req.Email).
+			SetNillablePhone(req.Phone).
+			SetNillableDefaultCurrency(req.DefaultCurrency).
+			SetNillablePaymentTermsDays(req.PaymentTermsDays).
+			SetNillableEarlyPaymentDiscount(req.EarlyPaymentDiscount).
+			SetNillableEarlyPaymentDays(req.EarlyPaymentDays).
+			SetNillableWithholdingCategory(req.WithholdingCategory).
+			SetNillableDefaultExpenseAccount(req.DefaultExpenseAccount).
+			SetNillablePaymentMethod(req.PaymentMethod).
+			SetNillableStatus(req.Status)
+		if req.Addresses != nil {
+			update.SetAddresses(*req.Addresses)
+		}
+		if req.Contacts != nil {
+			update.SetContacts(*req.Contacts)
+		}
+		if req.PaymentDetails != nil {
+			update.SetPaymentDetails(req.PaymentDetails)
+		}
+		if req.Metadata != nil {
+			update.SetMetadata(req.Metadata)
+		}
+
+		updated, err = update.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("update vendor: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntVendor(updated), nil
+}
+
+// checkUnique rejects a KRA PIN already used by another of the tenant's vendors.
+func checkUnique(ctx context.Context, client *ent.Client, tenantID, vendorID uuid.UUID, kraPIN string) error {
+	if kraPIN == "" {
+		return nil
+	}
+
+	existing, err := client.Vendor.Query().
+		Where(
+			vendor.TenantID(tenantID),
+			vendor.IDNEQ(vendorID),
+			vendor.KraPinEqualFold(kraPIN),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("check vendor uniqueness: %w", err)
+	}
+
+	return fmt.Errorf("%w: %s has the same KRA PIN", ErrDuplicateVendor, existing.VendorNumber)
+}
+
+func mapEntVendor(entVendor *ent.Vendor) *Vendor {
+	v := &Vendor{
+		ID:                    entVendor.ID,
+		TenantID:              entVendor.TenantID,
+		VendorNumber:          entVendor.VendorNumber,
+		LegalName:             entVendor.LegalName,
+		TradingName:           entVendor.TradingName,
+		KRAPIN:                entVendor.KraPin,
+		Email:                 entVendor.Email,
+		Phone:                 entVendor.Phone,
+		Addresses:             entVendor.Addresses,
+		Contacts:              entVendor.Contacts,
+		DefaultCurrency:       entVendor.DefaultCurrency,
+		PaymentTermsDays:      entVendor.PaymentTermsDays,
+		EarlyPaymentDiscount:  entVendor.EarlyPaymentDiscount,
+		EarlyPaymentDays:      entVendor.EarlyPaymentDays,
+		WithholdingCategory:   entVendor.WithholdingCategory,
+		DefaultExpenseAccount: entVendor.DefaultExpenseAccount,
+		PaymentMethod:         entVendor.PaymentMethod,
+		PaymentDetails:        entVendor.PaymentDetails,
+		Status:                entVendor.Status,
+		Metadata:              entVendor.Metadata,
+		CreatedAt:             entVendor.CreatedAt,
+		UpdatedAt:             entVendor.UpdatedAt,
+	}
+	if v.Addresses == nil {
+		v.Addresses = []profile.Address{}
+	}
+	if v.Contacts == nil {
+		v.Contacts = []profile.Contact{}
+	}
+	return v
+}
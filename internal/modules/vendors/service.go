@@ -0,0 +1,210 @@
+package vendors
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/customers/profile"
+	"github.com/bengobox/treasury-api/internal/modules/withholding"
+)
+
+// kraPINPattern matches a KRA PIN: a letter, nine digits and a check letter.
+var kraPINPattern = regexp.MustCompile(`^[A-Z][0-9]{9}[A-Z]$`)
+
+// paymentMethods are the ways a vendor can be paid.
+var paymentMethods = map[string]bool{"bank_transfer": true, "mpesa": true, "cheque": true, "cash": true}
+
+// Service manages vendor master records.
+type Service struct {
+	repo   Repository
+	logger *zap.Logger
+}
+
+// NewService creates a new vendor service.
+func NewService(repo Repository, logger *zap.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Create validates and stores a new vendor.
+func (s *Service) Create(ctx context.Context, tenantID uuid.UUID, req CreateRequest) (*Vendor, error) {
+	req.LegalName = strings.TrimSpace(req.LegalName)
+	req.KRAPIN = strings.ToUpper(strings.TrimSpace(req.KRAPIN))
+	req.DefaultCurrency = strings.ToUpper(strings.TrimSpace(req.DefaultCurrency))
+	req.DefaultExpenseAccount = strings.TrimSpace(req.DefaultExpenseAccount)
+	req.WithholdingCategory = withholding.NormalizeCategory(req.WithholdingCategory)
+	if req.DefaultCurrency == "" {
+		req.DefaultCurrency = "KES"
+	}
+
+	if req.LegalName == "" {
+		return nil, fmt.Errorf("%w: legal_name is required", ErrInvalidVendor)
+	}
+	if err := validateDetails(req.KRAPIN, req.Email, req.DefaultCurrency, req.PaymentTermsDays, req.PaymentMethod, req.Addresses, req.Contacts); err != nil {
+		return nil, err
+	}
+	if err := validateDiscount(req.EarlyPaymentDiscount, req.EarlyPaymentDays); err != nil {
+		return nil, err
+	}
+
+	v, err := s.repo.Create(ctx, tenantID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("vendor created",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("vendor_id", v.ID.String()),
+		zap.String("vendor_number", v.VendorNumber),
+	)
+
+	return v, nil
+}
+
+// Get retrieves a vendor.
+func (s *Service) Get(ctx context.Context, tenantID uuid.UUID, vendorID uuid.UUID) (*Vendor, error) {
+	return s.repo.Get(ctx, tenantID, vendorID)
+}
+
+// List returns the tenant's vendors.
+func (s *Service) List(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Vendor, error) {
+	return s.repo.List(ctx, tenantID, filter)
+}
+
+// Update validates and applies the provided fields to a vendor.
+func (s *Service) Update(ctx context.Context, tenantID uuid.UUID, vendorID uuid.UUID, req UpdateRequest) (*Vendor, error) {
+	current, err := s.repo.Get(ctx, tenantID, vendorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.LegalName != nil {
+		name := strings.TrimSpace(*req.LegalName)
+		if name == "" {
+			return nil, fmt.Errorf("%w: legal_name is required", ErrInvalidVendor)
+		}
+		req.LegalName = &name
+	}
+	if req.KRAPIN != nil {
+		pin := strings.ToUpper(strings.TrimSpace(*req.KRAPIN))
+		req.KRAPIN = &pin
+	}
+	if req.DefaultCurrency != nil {
+		currency := strings.ToUpper(strings.TrimSpace(*req.DefaultCurrency))
+		req.DefaultCurrency = &currency
+	}
+	if req.WithholdingCategory != nil {
+		category := withholding.NormalizeCategory(*req.WithholdingCategory)
+		req.WithholdingCategory = &category
+	}
+	if req.Status != nil && *req.Status != StatusActive && *req.Status != StatusInactive {
+		return nil, fmt.Errorf("%w: status must be active or inactive", ErrInvalidVendor)
+	}
+
+	kraPIN, email, currency, method := current.KRAPIN, current.Email, current.DefaultCurrency, current.PaymentMethod
+	addresses, contacts := current.Addresses, current.Contacts
+	if req.KRAPIN != nil {
+		kraPIN = *req.KRAPIN
+	}
+	if req.Email != nil {
+		email = *req.Email
+	}
+	if req.DefaultCurrency != nil {
+		currency = *req.DefaultCurrency
+	}
+	if req.PaymentMethod != nil {
+		method = *req.PaymentMethod
+	}
+	if req.Addresses != nil {
+		addresses = *req.Addresses
+	}
+	if req.Contacts != nil {
+		contacts = *req.Contacts
+	}
+	if err := validateDetails(kraPIN, email, currency, req.PaymentTermsDays, method, addresses, contacts); err != nil {
+		return nil, err
+	}
+
+	discount, discountDays := current.EarlyPaymentDiscount, current.EarlyPaymentDays
+	if req.EarlyPaymentDiscount != nil {
+		discount = *req.EarlyPaymentDiscount
+	}
+	if req.EarlyPaymentDays != nil {
+		discountDays = *req.EarlyPaymentDays
+	}
+	if err := validateDiscount(discount, discountDays); err != nil {
+		return nil, err
+	}
+
+	v, err := s.repo.Update(ctx, tenantID, vendorID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := []zap.Field{
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("vendor_id", vendorID.String()),
+	}
+	if req.PaymentMethod != nil || req.PaymentDetails != nil {
+		// Payment detail changes are a common fraud vector; keep them visible.
+		fields = append(fields, zap.Bool("payment_details_changed", true))
+	}
+	s.logger.Info("vendor updated", fields...)
+
+	return v, nil
+}
+
+// validateDiscount checks early-payment terms: a discount below 100% that
+// needs a window to be taken in.
+func validateDiscount(discount decimal.Decimal, days int) error {
+	if discount.IsNegative() || discount.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return fmt.Errorf("%w: early_payment_discount must be a fraction between 0 and 1", ErrInvalidVendor)
+	}
+	if days < 0 || days > 365 {
+		return fmt.Errorf("%w: early_payment_days must be between 0 and 365", ErrInvalidVendor)
+	}
+	if discount.IsPositive() && days == 0 {
+		return fmt.Errorf("%w: early_payment_days is required with an early_payment_discount", ErrInvalidVendor)
+	}
+	return nil
+}
+
+func validateDetails(kraPIN, email, currency string, paymentTermsDays *int, paymentMethod string, addresses []profile.Address, contacts []profile.Contact) error {
+	if kraPIN != "" && !kraPINPattern.MatchString(kraPIN) {
+		return fmt.Errorf("%w: kra_pin must be a letter, nine digits and a letter (e.g. P051234567X)", ErrInvalidVendor)
+	}
+	if email != "" {
+		if _, err := mail.ParseAddress(email); err != nil {
+			return fmt.Errorf("%w: invalid email", ErrInvalidVendor)
+		}
+	}
+	if len(currency) != 3 {
+		return fmt.Errorf("%w: default_currency must be an ISO currency code", ErrInvalidVendor)
+	}
+	if paymentTermsDays != nil && (*paymentTermsDays < 0 || *paymentTermsDays > 365) {
+		return fmt.Errorf("%w: payment_terms_days must be between 0 and 365", ErrInvalidVendor)
+	}
+	if paymentMethod != "" && !paymentMethods[paymentMethod] {
+		return fmt.Errorf("%w: payment_method must be bank_transfer, mpesa, cheque or cash", ErrInvalidVendor)
+	}
+	for i, address := range addresses {
+		if strings.TrimSpace(address.Line1) == "" {
+			return fmt.Errorf("%w: address %d line1 is required", ErrInvalidVendor, i+1)
+		}
+	}
+	for i, contact := range contacts {
+		if strings.TrimSpace(contact.Name) == "" {
+			return fmt.Errorf("%w: contact %d name is required", ErrInvalidVendor, i+1)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package wallets
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Wallet owner types.
+const (
+	OwnerTenant   = "tenant"
+	OwnerOutlet   = "outlet"
+	OwnerCustomer = "customer"
+)
+
+// Transaction types.
+const (
+	TransactionCredit   = "credit"
+	TransactionDebit    = "debit"
+	TransactionTransfer = "transfer"
+	TransactionCapture  = "capture"
+)
+
+// Hold statuses.
+const (
+	HoldActive   = "active"
+	HoldCaptured = "captured"
+	HoldReleased = "released"
+)
+
+// EventBalanceChanged is published for each wallet whose balance or held
+// amount changes.
+const EventBalanceChanged = "treasury.wallet.balance.changed"
+
+// Wallet holds stored value for the tenant, an outlet or a customer. The
+// available balance is the balance less active holds and never negative.
+type Wallet struct {
+	ID         uuid.UUID       `json:"id"`
+	TenantID   uuid.UUID       `json:"tenant_id"`
+	OwnerType  string          `json:"owner_type"` // tenant, outlet, customer
+	OwnerID    string          `json:"owner_id"`
+	Name       string          `json:"name,omitempty"`
+	Currency   string          `json:"currency"`
+	Balance    decimal.Decimal `json:"balance"`
+	HeldAmount decimal.Decimal `json:"held_amount"`
+	Available  decimal.Decimal `json:"available"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
+
+// Hold sets funds aside in a wallet until they are captured or released.
+type Hold struct {
+	ID             uuid.UUID       `json:"id"`
+	TenantID       uuid.UUID       `json:"tenant_id"`
+	WalletID       uuid.UUID       `json:"wallet_id"`
+	Amount         decimal.Decimal `json:"amount"`
+	CapturedAmount decimal.Decimal `json:"captured_amount"`
+	Status         string          `json:"status"` // active, captured, released
+	Reference      *string         `json:"reference,omitempty"`
+	Description    string          `json:"description,omitempty"`
+	TransactionID  *uuid.UUID      `json:"transaction_id,omitempty"`
+	CreatedBy      *uuid.UUID      `json:"created_by,omitempty"`
+	ClosedAt       *time.Time      `json:"closed_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// Transaction moves funds into, out of or between wallets.
+type Transaction struct {
+	ID              uuid.UUID       `json:"id"`
+	TenantID        uuid.UUID       `json:"tenant_id"`
+	TransactionType string          `json:"transaction_type"` // credit, debit, transfer, capture
+	FromWalletID    *uuid.UUID      `json:"from_wallet_id,omitempty"`
+	ToWalletID      *uuid.UUID      `json:"to_wallet_id,omitempty"`
+	AccountCode     string          `json:"account_code,omitempty"`
+	Amount          decimal.Decimal `json:"amount"`
+	Currency        string          `json:"currency"`
+	HoldID          *uuid.UUID      `json:"hold_id,omitempty"`
+	Reference       *string         `json:"reference,omitempty"`
+	Description     string          `json:"description,omitempty"`
+	JournalEntryID  uuid.UUID       `json:"journal_entry_id"`
+	CreatedBy       *uuid.UUID      `json:"created_by,omitempty"`
+	CreatedAt       time.Time       `json:"created_at"`
+}
+
+// CreateWalletRequest opens a wallet. The tenant's own wallet needs no
+// owner_id.
+type CreateWalletRequest struct {
+	OwnerType string `json:"owner_type"`
+	OwnerID   string `json:"owner_id"`
+	Name      string `json:"name"`
+	Currency  string `json:"currency"`
+}
+
+// MovementRequest credits a wallet from, or debits it to, a ledger account:
+// 1000 Cash unless another is named.
+type MovementRequest struct {
+	Amount      decimal.Decimal `json:"amount"`
+	AccountCode string          `json:"account_code"`
+	Reference   string          `json:"reference"`
+	Description string          `json:"description"`
+}
+
+// TransferRequest moves funds between two wallets in the same currency.
+type TransferRequest struct {
+	FromWalletID uuid.UUID       `json:"from_wallet_id"`
+	ToWalletID   uuid.UUID       `json:"to_wallet_id"`
+	Amount       decimal.Decimal `json:"amount"`
+	Reference    string          `json:"reference"`
+	Description  string          `json:"description"`
+}
+
+// HoldRequest holds funds in a wallet.
+type HoldRequest struct {
+	Amount      decimal.Decimal `json:"amount"`
+	Reference   string          `json:"reference"`
+	Description string          `json:"description"`
+}
+
+// CaptureRequest captures a hold into another wallet or a ledger account.
+// Without an amount the whole hold is captured; any remainder is released.
+type CaptureRequest struct {
+	Amount      *decimal.Decimal `json:"amount"`
+	ToWalletID  *uuid.UUID       `json:"to_wallet_id"`
+	AccountCode string           `json:"account_code"`
+	Reference   string           `json:"reference"`
+	Description string           `json:"description"`
+}
+
+// WalletFilter narrows wallet listings.
+type WalletFilter struct {
+	OwnerType string
+	OwnerID   string
+	Limit     int
+	Offset    int
+}
+
+// HoldFilter narrows hold listings.
+type HoldFilter struct {
+	Status string
+	Limit  int
+	Offset int
+}
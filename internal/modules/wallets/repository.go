@@ -0,0 +1,62 @@
+package wallets
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrWalletNotFound is returned when a wallet does not exist for the tenant.
+	ErrWalletNotFound = errors.New("wallet not found")
+	// ErrHoldNotFound is returned when a hold does not exist on the wallet.
+	ErrHoldNotFound = errors.New("wallet hold not found")
+	// ErrTransactionNotFound is returned when a wallet transaction does not exist for the tenant.
+	ErrTransactionNotFound = errors.New("wallet transaction not found")
+	// ErrWalletExists is returned when the owner already has a wallet in the currency.
+	ErrWalletExists = errors.New("wallet already exists")
+	// ErrInvalidWallet is returned when a wallet's owner or currency is invalid.
+	ErrInvalidWallet = errors.New("invalid wallet")
+	// ErrInvalidTransaction is returned when a movement or hold is malformed.
+	ErrInvalidTransaction = errors.New("invalid wallet transaction")
+	// ErrInsufficientFunds is returned when a wallet's available balance does not cover a movement or hold.
+	ErrInsufficientFunds = errors.New("insufficient wallet funds")
+	// ErrHoldStatus is returned when the hold was already captured or released.
+	ErrHoldStatus = errors.New("wallet hold is not active")
+	// ErrDuplicateReference is returned when a transaction or hold with the reference exists.
+	ErrDuplicateReference = errors.New("wallet reference already used")
+)
+
+// Capture is a resolved capture of a hold.
+type Capture struct {
+	Amount      *decimal.Decimal
+	ToWalletID  *uuid.UUID
+	AccountCode string
+	Reference   *string
+	Description string
+	CreatedBy   *uuid.UUID
+}
+
+// Repository abstracts persistence for wallets. Every movement locks the
+// wallets it touches, so balances are checked and changed atomically.
+type Repository interface {
+	CreateWallet(ctx context.Context, wallet *Wallet) (*Wallet, error)
+	GetWallet(ctx context.Context, tenantID, walletID uuid.UUID) (*Wallet, error)
+	ListWallets(ctx context.Context, tenantID uuid.UUID, filter WalletFilter) ([]*Wallet, error)
+
+	// Post posts a credit, debit or transfer as one balanced journal and
+	// enqueues treasury.wallet.balance.changed for each wallet moved.
+	Post(ctx context.Context, txn *Transaction) (*Transaction, error)
+	GetTransaction(ctx context.Context, tenantID, transactionID uuid.UUID) (*Transaction, error)
+	ListTransactions(ctx context.Context, tenantID, walletID uuid.UUID, limit, offset int) ([]*Transaction, error)
+
+	PlaceHold(ctx context.Context, hold *Hold) (*Hold, error)
+	// CaptureHold moves all or part of an active hold out of the wallet and
+	// releases the rest.
+	CaptureHold(ctx context.Context, tenantID, walletID, holdID uuid.UUID, capture Capture) (*Hold, error)
+	ReleaseHold(ctx context.Context, tenantID, walletID, holdID uuid.UUID) (*Hold, error)
+	GetHold(ctx context.Context, tenantID, walletID, holdID uuid.UUID) (*Hold, error)
+	ListHolds(ctx context.Context, tenantID, walletID uuid.UUID, filter HoldFilter) ([]*Hold, error)
+}
@@ -0,0 +1,644 @@
+package wallets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/wallet"
+	"github.com/bengobox/treasury-api/internal/ent/wallethold"
+	"github.com/bengobox/treasury-api/internal/ent/wallettransaction"
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+	"github.com/bengobox/treasury-api/internal/modules/outbox"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+)
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// CreateWallet opens an empty wallet.
+func (r *EntRepository) CreateWallet(ctx context.Context, w *Wallet) (*Wallet, error) {
+	create := r.client.Wallet.Create().
+		SetTenantID(w.TenantID).
+		SetOwnerType(w.OwnerType).
+		SetOwnerID(w.OwnerID).
+		SetCurrency(w.Currency).
+		SetBalance(decimal.Zero).
+		SetHeldAmount(decimal.Zero)
+	if w.Name != "" {
+		create.SetName(w.Name)
+	}
+
+	entWallet, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: %s %s in %s", ErrWalletExists, w.OwnerType, w.OwnerID, w.Currency)
+		}
+		return nil, fmt.Errorf("create wallet: %w", err)
+	}
+
+	return mapEntWallet(entWallet), nil
+}
+
+// GetWallet retrieves a wallet by ID.
+func (r *EntRepository) GetWallet(ctx context.Context, tenantID, walletID uuid.UUID) (*Wallet, error) {
+	entWallet, err := r.client.Wallet.Query().
+		Where(
+			wallet.ID(walletID),
+			wallet.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrWalletNotFound
+		}
+		return nil, fmt.Errorf("get wallet: %w", err)
+	}
+
+	return mapEntWallet(entWallet), nil
+}
+
+// ListWallets returns the tenant's wallets.
+func (r *EntRepository) ListWallets(ctx context.Context, tenantID uuid.UUID, filter WalletFilter) ([]*Wallet, error) {
+	query := r.client.Wallet.Query().
+		Where(wallet.TenantID(tenantID))
+	if filter.OwnerType != "" {
+		query = query.Where(wallet.OwnerType(filter.OwnerType))
+	}
+	if filter.OwnerID != "" {
+		query = query.Where(wallet.OwnerID(filter.OwnerID))
+	}
+
+	entWallets, err := query.
+		Order(ent.Asc(wallet.FieldOwnerType), ent.Asc(wallet.FieldOwnerID), ent.Asc(wallet.FieldCurrency)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list wallets: %w", err)
+	}
+
+	wallets := make([]*Wallet, len(entWallets))
+	for i, entWallet := range entWallets {
+		wallets[i] = mapEntWallet(entWallet)
+	}
+	return wallets, nil
+}
+
+// Post locks the wallets moved and checks the debited wallet's available
+// balance before posting.
+func (r *EntRepository) Post(ctx context.Context, txn *Transaction) (*Transaction, error) {
+	var posted *ent.WalletTransaction
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		var ids []uuid.UUID
+		if txn.FromWalletID != nil {
+			ids = append(ids, *txn.FromWalletID)
+		}
+		if txn.ToWalletID != nil {
+			ids = append(ids, *txn.ToWalletID)
+		}
+		locked, err := lockWallets(ctx, tx, txn.TenantID, ids...)
+		if err != nil {
+			return err
+		}
+
+		if txn.FromWalletID != nil {
+			from := locked[*txn.FromWalletID]
+			if err := checkFunds(txn.Amount, from.Balance, from.HeldAmount); err != nil {
+				return err
+			}
+		}
+
+		posted, err = move(ctx, tx, txn, locked, decimal.Zero)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntTransaction(posted), nil
+}
+
+// GetTransaction retrieves a wallet transaction by ID.
+func (r *EntRepository) GetTransaction(ctx context.Context, tenantID, transactionID uuid.UUID) (*Transaction, error) {
+	entTransaction, err := r.client.WalletTransaction.Query().
+		Where(
+			wallettransaction.ID(transactionID),
+			wallettransaction.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("get wallet transaction: %w", err)
+	}
+
+	return mapEntTransaction(entTransaction), nil
+}
+
+// ListTransactions returns the transactions into or out of the wallet,
+// newest first.
+func (r *EntRepository) ListTransactions(ctx context.Context, tenantID, walletID uuid.UUID, limit, offset int) ([]*Transaction, error) {
+	entTransactions, err := r.client.WalletTransaction.Query().
+		Where(
+			wallettransaction.TenantID(tenantID),
+			wallettransaction.Or(
+				wallettransaction.FromWalletID(walletID),
+				wallettransaction.ToWalletID(walletID),
+			),
+		).
+		Order(ent.Desc(wallettransaction.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list wallet transactions: %w", err)
+	}
+
+	transactions := make([]*Transaction, len(entTransactions))
+	for i, entTransaction := range entTransactions {
+		transactions[i] = mapEntTransaction(entTransaction)
+	}
+	return transactions, nil
+}
+
+// PlaceHold holds funds from the wallet's available balance. Holds post
+// nothing: the funds stay in the wallet until captured.
+func (r *EntRepository) PlaceHold(ctx context.Context, hold *Hold) (*Hold, error) {
+	var placed *ent.WalletHold
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		locked, err := lockWallets(ctx, tx, hold.TenantID, hold.WalletID)
+		if err != nil {
+			return err
+		}
+		entWallet := locked[hold.WalletID]
+		if err := checkFunds(hold.Amount, entWallet.Balance, entWallet.HeldAmount); err != nil {
+			return err
+		}
+
+		placed, err = tx.WalletHold.Create().
+			SetTenantID(hold.TenantID).
+			SetWalletID(hold.WalletID).
+			SetAmount(hold.Amount).
+			SetCapturedAmount(decimal.Zero).
+			SetStatus(HoldActive).
+			SetNillableReference(hold.Reference).
+			SetDescription(hold.Description).
+			SetNillableCreatedBy(hold.CreatedBy).
+			Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return ErrDuplicateReference
+			}
+			return fmt.Errorf("create wallet hold: %w", err)
+		}
+
+		updated, err := entWallet.Update().
+			SetHeldAmount(entWallet.HeldAmount.Add(hold.Amount)).
+			Save(ctx)
+		if err != nil {
+			return balanceError("hold wallet funds", err)
+		}
+		return enqueueBalanceChanged(ctx, tx, updated, "hold", map[string]any{"hold_id": placed.ID.String()})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntHold(placed), nil
+}
+
+// CaptureHold posts the captured amount out of the wallet and clears the
+// whole hold from its held amount.
+func (r *EntRepository) CaptureHold(ctx context.Context, tenantID, walletID, holdID uuid.UUID, capture Capture) (*Hold, error) {
+	var captured *ent.WalletHold
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		ids := []uuid.UUID{walletID}
+		if capture.ToWalletID != nil {
+			ids = append(ids, *capture.ToWalletID)
+		}
+		locked, err := lockWallets(ctx, tx, tenantID, ids...)
+		if err != nil {
+			return err
+		}
+
+		entHold, err := lockHold(ctx, tx, tenantID, walletID, holdID)
+		if err != nil {
+			return err
+		}
+		amount, err := captureAmount(entHold.Amount, capture.Amount)
+		if err != nil {
+			return err
+		}
+
+		reference := capture.Reference
+		if reference == nil {
+			reference = entHold.Reference
+		}
+		description := capture.Description
+		if description == "" {
+			description = entHold.Description
+		}
+		posted, err := move(ctx, tx, &Transaction{
+			TenantID:        tenantID,
+			TransactionType: TransactionCapture,
+			FromWalletID:    &walletID,
+			ToWalletID:      capture.ToWalletID,
+			AccountCode:     capture.AccountCode,
+			Amount:          amount,
+			Currency:        locked[walletID].Currency,
+			HoldID:          &entHold.ID,
+			Reference:       reference,
+			Description:     description,
+			CreatedBy:       capture.CreatedBy,
+		}, locked, entHold.Amount)
+		if err != nil {
+			return err
+		}
+
+		captured, err = entHold.Update().
+			SetStatus(HoldCaptured).
+			SetCapturedAmount(amount).
+			SetTransactionID(posted.ID).
+			SetClosedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("capture wallet hold: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntHold(captured), nil
+}
+
+// ReleaseHold returns a hold's funds to the wallet's available balance.
+func (r *EntRepository) ReleaseHold(ctx context.Context, tenantID, walletID, holdID uuid.UUID) (*Hold, error) {
+	var released *ent.WalletHold
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		locked, err := lockWallets(ctx, tx, tenantID, walletID)
+		if err != nil {
+			return err
+		}
+		entHold, err := lockHold(ctx, tx, tenantID, walletID, holdID)
+		if err != nil {
+			return err
+		}
+
+		released, err = entHold.Update().
+			SetStatus(HoldReleased).
+			SetClosedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("release wallet hold: %w", err)
+		}
+
+		entWallet := locked[walletID]
+		updated, err := entWallet.Update().
+			SetHeldAmount(entWallet.HeldAmount.Sub(entHold.Amount)).
+			Save(ctx)
+		if err != nil {
+			return balanceError("release wallet funds", err)
+		}
+		return enqueueBalanceChanged(ctx, tx, updated, "release", map[string]any{"hold_id": released.ID.String()})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEntHold(released), nil
+}
+
+// GetHold retrieves a hold on the wallet.
+func (r *EntRepository) GetHold(ctx context.Context, tenantID, walletID, holdID uuid.UUID) (*Hold, error) {
+	entHold, err := r.client.WalletHold.Query().
+		Where(
+			wallethold.ID(holdID),
+			wallethold.TenantID(tenantID),
+			wallethold.WalletID(walletID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrHoldNotFound
+		}
+		return nil, fmt.Errorf("get wallet hold: %w", err)
+	}
+
+	return mapEntHold(entHold), nil
+}
+
+// ListHolds returns the wallet's holds, newest first.
+func (r *EntRepository) ListHolds(ctx context.Context, tenantID, walletID uuid.UUID, filter HoldFilter) ([]*Hold, error) {
+	query := r.client.WalletHold.Query().
+		Where(
+			wallethold.TenantID(tenantID),
+			wallethold.WalletID(walletID),
+		)
+	if filter.Status != "" {
+		query = query.Where(wallethold.Status(filter.Status))
+	}
+
+	entHolds, err := query.
+		Order(ent.Desc(wallethold.FieldCreatedAt)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list wallet holds: %w", err)
+	}
+
+	holds := make([]*Hold, len(entHolds))
+	for i, entHold := range entHolds {
+		holds[i] = mapEntHold(entHold)
+	}
+	return holds, nil
+}
+
+// move posts a transaction between locked wallets, or a locked wallet and a
+// ledger account, as one journal: Dr the source, Cr the destination. The
+// source's held amount is reduced by release, the hold being captured.
+func move(ctx context.Context, tx *ent.Tx, txn *Transaction, locked map[uuid.UUID]*ent.Wallet, release decimal.Decimal) (*ent.WalletTransaction, error) {
+	debitAccount, creditAccount := txn.AccountCode, txn.AccountCode
+	var from, to *ent.Wallet
+	if txn.FromWalletID != nil {
+		from = locked[*txn.FromWalletID]
+		debitAccount = walletAccounts[from.OwnerType]
+	}
+	if txn.ToWalletID != nil {
+		to = locked[*txn.ToWalletID]
+		creditAccount = walletAccounts[to.OwnerType]
+	}
+	for _, w := range []*ent.Wallet{from, to} {
+		if w != nil && w.Currency != txn.Currency {
+			return nil, fmt.Errorf("%w: wallet %s holds %s, not %s", ErrInvalidTransaction, w.ID, w.Currency, txn.Currency)
+		}
+	}
+
+	transactionID := uuid.New()
+	journalID, err := ledger.PostJournal(ctx, tx, ledger.Journal{
+		TenantID:      txn.TenantID,
+		EntryDate:     time.Now(),
+		Currency:      txn.Currency,
+		ReferenceType: "wallet_transaction",
+		ReferenceID:   transactionID,
+		Description:   transactionDescription(txn, from, to),
+		Metadata:      transactionMetadata(txn),
+		Lines: []ledger.Line{
+			ledger.Debit(debitAccount, txn.Amount),
+			ledger.Credit(creditAccount, txn.Amount),
+		},
+	})
+	if err != nil {
+		if errors.Is(err, ledger.ErrAccountNotFound) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidTransaction, err)
+		}
+		return nil, fmt.Errorf("post wallet transaction journal: %w", err)
+	}
+
+	create := tx.WalletTransaction.Create().
+		SetID(transactionID).
+		SetTenantID(txn.TenantID).
+		SetTransactionType(txn.TransactionType).
+		SetNillableFromWalletID(txn.FromWalletID).
+		SetNillableToWalletID(txn.ToWalletID).
+		SetAmount(txn.Amount).
+		SetCurrency(txn.Currency).
+		SetNillableHoldID(txn.HoldID).
+		SetNillableReference(txn.Reference).
+		SetDescription(txn.Description).
+		SetJournalEntryID(journalID).
+		SetNillableCreatedBy(txn.CreatedBy)
+	if from == nil || to == nil {
+		create.SetAccountCode(txn.AccountCode)
+	}
+	posted, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrDuplicateReference
+		}
+		return nil, fmt.Errorf("create wallet transaction: %w", err)
+	}
+
+	details := map[string]any{"transaction_id": posted.ID.String()}
+	if from != nil {
+		updated, err := from.Update().
+			SetBalance(from.Balance.Sub(txn.Amount)).
+			SetHeldAmount(from.HeldAmount.Sub(release)).
+			Save(ctx)
+		if err != nil {
+			return nil, balanceError("debit wallet", err)
+		}
+		if err := enqueueBalanceChanged(ctx, tx, updated, txn.TransactionType, details); err != nil {
+			return nil, err
+		}
+	}
+	if to != nil {
+		updated, err := to.Update().
+			SetBalance(to.Balance.Add(txn.Amount)).
+			Save(ctx)
+		if err != nil {
+			return nil, balanceError("credit wallet", err)
+		}
+		if err := enqueueBalanceChanged(ctx, tx, updated, txn.TransactionType, details); err != nil {
+			return nil, err
+		}
+	}
+	return posted, nil
+}
+
+// lockWallets locks the tenant's wallets in lockOrder.
+func lockWallets(ctx context.Context, tx *ent.Tx, tenantID uuid.UUID, ids ...uuid.UUID) (map[uuid.UUID]*ent.Wallet, error) {
+	locked := make(map[uuid.UUID]*ent.Wallet, len(ids))
+	for _, id := range lockOrder(ids...) {
+		entWallet, err := tx.Wallet.Query().
+			Where(
+				wallet.ID(id),
+				wallet.TenantID(tenantID),
+			).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fmt.Errorf("%w: %s", ErrWalletNotFound, id)
+			}
+			return nil, fmt.Errorf("lock wallet: %w", err)
+		}
+		locked[id] = entWallet
+	}
+	return locked, nil
+}
+
+// lockHold locks an active hold on the wallet.
+func lockHold(ctx context.Context, tx *ent.Tx, tenantID, walletID, holdID uuid.UUID) (*ent.WalletHold, error) {
+	entHold, err := tx.WalletHold.Query().
+		Where(
+			wallethold.ID(holdID),
+			wallethold.TenantID(tenantID),
+			wallethold.WalletID(walletID),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrHoldNotFound
+		}
+		return nil, fmt.Errorf("lock wallet hold: %w", err)
+	}
+	if entHold.Status != HoldActive {
+		return nil, fmt.Errorf("%w: hold is %s", ErrHoldStatus, entHold.Status)
+	}
+	return entHold, nil
+}
+
+// balanceError maps the wallet's check constraints, which only fail if a
+// movement bypassed the locks, to ErrInsufficientFunds.
+func balanceError(action string, err error) error {
+	if ent.IsConstraintError(err) {
+		return fmt.Errorf("%w: %v", ErrInsufficientFunds, err)
+	}
+	return fmt.Errorf("%s: %w", action, err)
+}
+
+func enqueueBalanceChanged(ctx context.Context, tx *ent.Tx, entWallet *ent.Wallet, change string, details map[string]any) error {
+	payload := map[string]any{
+		"wallet_id":   entWallet.ID.String(),
+		"owner_type":  entWallet.OwnerType,
+		"owner_id":    entWallet.OwnerID,
+		"currency":    entWallet.Currency,
+		"change":      change,
+		"balance":     entWallet.Balance.String(),
+		"held_amount": entWallet.HeldAmount.String(),
+		"available":   available(entWallet.Balance, entWallet.HeldAmount).String(),
+	}
+	for key, value := range details {
+		payload[key] = value
+	}
+
+	return outbox.Enqueue(ctx, tx, outbox.Event{
+		TenantID:      entWallet.TenantID,
+		AggregateType: "wallet",
+		AggregateID:   entWallet.ID,
+		EventType:     EventBalanceChanged,
+		Payload:       payload,
+	})
+}
+
+func transactionDescription(txn *Transaction, from, to *ent.Wallet) string {
+	if txn.Description != "" {
+		return txn.Description
+	}
+	switch {
+	case from != nil && to != nil:
+		return fmt.Sprintf("Wallet %s from %s %s to %s %s", txn.TransactionType, from.OwnerType, from.OwnerID, to.OwnerType, to.OwnerID)
+	case from != nil:
+		return fmt.Sprintf("Wallet %s from %s %s", txn.TransactionType, from.OwnerType, from.OwnerID)
+	default:
+		return fmt.Sprintf("Wallet %s to %s %s", txn.TransactionType, to.OwnerType, to.OwnerID)
+	}
+}
+
+func transactionMetadata(txn *Transaction) map[string]any {
+	metadata := map[string]any{"transaction_type": txn.TransactionType}
+	if txn.FromWalletID != nil {
+		metadata["from_wallet_id"] = txn.FromWalletID.String()
+	}
+	if txn.ToWalletID != nil {
+		metadata["to_wallet_id"] = txn.ToWalletID.String()
+	}
+	if txn.HoldID != nil {
+		metadata["hold_id"] = txn.HoldID.String()
+	}
+	if txn.Reference != nil {
+		metadata["reference"] = *txn.Reference
+	}
+	return metadata
+}
+
+func mapEntWallet(entWallet *ent.Wallet) *Wallet {
+	return &Wallet{
+		ID:         entWallet.ID,
+		TenantID:   entWallet.TenantID,
+		OwnerType:  entWallet.OwnerType,
+		OwnerID:    entWallet.OwnerID,
+		Name:       entWallet.Name,
+		Currency:   entWallet.Currency,
+		Balance:    entWallet.Balance,
+		HeldAmount: entWallet.HeldAmount,
+		Available:  available(entWallet.Balance, entWallet.HeldAmount),
+		CreatedAt:  entWallet.CreatedAt,
+		UpdatedAt:  entWallet.UpdatedAt,
+	}
+}
+
+func mapEntHold(entHold *ent.WalletHold) *Hold {
+	hold := &Hold{
+		ID:             entHold.ID,
+		TenantID:       entHold.TenantID,
+		WalletID:       entHold.WalletID,
+		Amount:         entHold.Amount,
+		CapturedAmount: entHold.CapturedAmount,
+		Status:         entHold.Status,
+		Reference:      entHold.Reference,
+		Description:    entHold.Description,
+		CreatedAt:      entHold.CreatedAt,
+		UpdatedAt:      entHold.UpdatedAt,
+	}
+
+	// Optional fields - check for zero values
+	if entHold.TransactionID != uuid.Nil {
+		hold.TransactionID = &entHold.TransactionID
+	}
+	if entHold.CreatedBy != uuid.Nil {
+		hold.CreatedBy = &entHold.CreatedBy
+	}
+	if !entHold.ClosedAt.IsZero() {
+		hold.ClosedAt = &entHold.ClosedAt
+	}
+	return hold
+}
+
+func mapEntTransaction(entTransaction *ent.WalletTransaction) *Transaction {
+	transaction := &Transaction{
+		ID:              entTransaction.ID,
+		TenantID:        entTransaction.TenantID,
+		TransactionType: entTransaction.TransactionType,
+		AccountCode:     entTransaction.AccountCode,
+		Amount:          entTransaction.Amount,
+		Currency:        entTransaction.Currency,
+		Reference:       entTransaction.Reference,
+		Description:     entTransaction.Description,
+		JournalEntryID:  entTransaction.JournalEntryID,
+		CreatedAt:       entTransaction.CreatedAt,
+	}
+
+	// Optional fields - check for zero values
+	if entTransaction.FromWalletID != uuid.Nil {
+		transaction.FromWalletID = &entTransaction.FromWalletID
+	}
+	if entTransaction.ToWalletID != uuid.Nil {
+		transaction.ToWalletID = &entTransaction.ToWalletID
+	}
+	if entTransaction.HoldID != uuid.Nil {
+		transaction.HoldID = &entTransaction.HoldID
+	}
+	if entTransaction.CreatedBy != uuid.Nil {
+		transaction.CreatedBy = &entTransaction.CreatedBy
+	}
+	return transaction
+}
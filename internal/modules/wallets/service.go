@@ -0,0 +1,295 @@
+package wallets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+)
+
+// Service keeps stored-value wallets for tenants, outlets and customers.
+// Every movement is a balanced journal against the owner type's wallet
+// balances account, and no movement or hold takes a wallet below zero.
+type Service struct {
+	repo   Repository
+	logger *zap.Logger
+}
+
+// NewService creates a new wallets service.
+func NewService(repo Repository, logger *zap.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// CreateWallet opens an empty wallet. The tenant's own wallet is owned by the
+// tenant ID.
+func (s *Service) CreateWallet(ctx context.Context, tenantID uuid.UUID, req CreateWalletRequest) (*Wallet, error) {
+	ownerType := strings.TrimSpace(req.OwnerType)
+	if _, ok := walletAccounts[ownerType]; !ok {
+		return nil, fmt.Errorf("%w: owner_type must be tenant, outlet or customer", ErrInvalidWallet)
+	}
+	ownerID := strings.TrimSpace(req.OwnerID)
+	if ownerType == OwnerTenant {
+		ownerID = tenantID.String()
+	}
+	if ownerID == "" {
+		return nil, fmt.Errorf("%w: owner_id is required", ErrInvalidWallet)
+	}
+	currency := strings.ToUpper(strings.TrimSpace(req.Currency))
+	if currency == "" {
+		currency = "KES"
+	}
+	if len(currency) != 3 {
+		return nil, fmt.Errorf("%w: currency must be an ISO 4217 code", ErrInvalidWallet)
+	}
+
+	wallet, err := s.repo.CreateWallet(ctx, &Wallet{
+		TenantID:  tenantID,
+		OwnerType: ownerType,
+		OwnerID:   ownerID,
+		Name:      strings.TrimSpace(req.Name),
+		Currency:  currency,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("wallet created",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("wallet_id", wallet.ID.String()),
+		zap.String("owner_type", wallet.OwnerType),
+		zap.String("owner_id", wallet.OwnerID),
+	)
+	return wallet, nil
+}
+
+// Credit funds a wallet from a ledger account, 1000 Cash by default.
+func (s *Service) Credit(ctx context.Context, tenantID, walletID uuid.UUID, req MovementRequest, createdBy *uuid.UUID) (*Transaction, error) {
+	return s.external(ctx, tenantID, walletID, TransactionCredit, req, createdBy)
+}
+
+// Debit withdraws from a wallet's available balance to a ledger account,
+// 1000 Cash by default.
+func (s *Service) Debit(ctx context.Context, tenantID, walletID uuid.UUID, req MovementRequest, createdBy *uuid.UUID) (*Transaction, error) {
+	return s.external(ctx, tenantID, walletID, TransactionDebit, req, createdBy)
+}
+
+func (s *Service) external(ctx context.Context, tenantID, walletID uuid.UUID, transactionType string, req MovementRequest, createdBy *uuid.UUID) (*Transaction, error) {
+	if err := checkAmount(req.Amount); err != nil {
+		return nil, err
+	}
+	accountCode := strings.TrimSpace(req.AccountCode)
+	if accountCode == "" {
+		accountCode = ledger.AccountCash
+	}
+	if isWalletAccount(accountCode) {
+		return nil, fmt.Errorf("%w: use a transfer to move funds between wallets", ErrInvalidTransaction)
+	}
+
+	wallet, err := s.repo.GetWallet(ctx, tenantID, walletID)
+	if err != nil {
+		return nil, err
+	}
+
+	txn := &Transaction{
+		TenantID:        tenantID,
+		TransactionType: transactionType,
+		AccountCode:     accountCode,
+		Amount:          req.Amount,
+		Currency:        wallet.Currency,
+		Reference:       reference(req.Reference),
+		Description:     strings.TrimSpace(req.Description),
+		CreatedBy:       createdBy,
+	}
+	if transactionType == TransactionCredit {
+		txn.ToWalletID = &walletID
+	} else {
+		txn.FromWalletID = &walletID
+	}
+	return s.post(ctx, txn)
+}
+
+// Transfer moves funds from one wallet's available balance to another
+// wallet in the same currency.
+func (s *Service) Transfer(ctx context.Context, tenantID uuid.UUID, req TransferRequest, createdBy *uuid.UUID) (*Transaction, error) {
+	if err := checkAmount(req.Amount); err != nil {
+		return nil, err
+	}
+	if req.FromWalletID == uuid.Nil || req.ToWalletID == uuid.Nil {
+		return nil, fmt.Errorf("%w: from_wallet_id and to_wallet_id are required", ErrInvalidTransaction)
+	}
+	if req.FromWalletID == req.ToWalletID {
+		return nil, fmt.Errorf("%w: cannot transfer to the same wallet", ErrInvalidTransaction)
+	}
+
+	from, err := s.repo.GetWallet(ctx, tenantID, req.FromWalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.post(ctx, &Transaction{
+		TenantID:        tenantID,
+		TransactionType: TransactionTransfer,
+		FromWalletID:    &req.FromWalletID,
+		ToWalletID:      &req.ToWalletID,
+		Amount:          req.Amount,
+		Currency:        from.Currency,
+		Reference:       reference(req.Reference),
+		Description:     strings.TrimSpace(req.Description),
+		CreatedBy:       createdBy,
+	})
+}
+
+func (s *Service) post(ctx context.Context, txn *Transaction) (*Transaction, error) {
+	posted, err := s.repo.Post(ctx, txn)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("wallet transaction posted",
+		zap.String("tenant_id", posted.TenantID.String()),
+		zap.String("transaction_id", posted.ID.String()),
+		zap.String("transaction_type", posted.TransactionType),
+		zap.String("amount", posted.Amount.String()),
+	)
+	return posted, nil
+}
+
+// PlaceHold holds funds from a wallet's available balance, for example while
+// an order is being fulfilled.
+func (s *Service) PlaceHold(ctx context.Context, tenantID, walletID uuid.UUID, req HoldRequest, createdBy *uuid.UUID) (*Hold, error) {
+	if err := checkAmount(req.Amount); err != nil {
+		return nil, err
+	}
+
+	hold, err := s.repo.PlaceHold(ctx, &Hold{
+		TenantID:    tenantID,
+		WalletID:    walletID,
+		Amount:      req.Amount,
+		Reference:   reference(req.Reference),
+		Description: strings.TrimSpace(req.Description),
+		CreatedBy:   createdBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("wallet hold placed",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("wallet_id", walletID.String()),
+		zap.String("hold_id", hold.ID.String()),
+		zap.String("amount", hold.Amount.String()),
+	)
+	return hold, nil
+}
+
+// CaptureHold moves held funds to another wallet or a ledger account, such
+// as 4000 Sales Revenue. Capturing less than the hold releases the rest.
+func (s *Service) CaptureHold(ctx context.Context, tenantID, walletID, holdID uuid.UUID, req CaptureRequest, createdBy *uuid.UUID) (*Hold, error) {
+	accountCode := strings.TrimSpace(req.AccountCode)
+	if (req.ToWalletID == nil) == (accountCode == "") {
+		return nil, fmt.Errorf("%w: either to_wallet_id or account_code is required", ErrInvalidTransaction)
+	}
+	if req.ToWalletID != nil && *req.ToWalletID == walletID {
+		return nil, fmt.Errorf("%w: cannot capture to the same wallet", ErrInvalidTransaction)
+	}
+	if isWalletAccount(accountCode) {
+		return nil, fmt.Errorf("%w: capture to a wallet with to_wallet_id", ErrInvalidTransaction)
+	}
+
+	hold, err := s.repo.CaptureHold(ctx, tenantID, walletID, holdID, Capture{
+		Amount:      req.Amount,
+		ToWalletID:  req.ToWalletID,
+		AccountCode: accountCode,
+		Reference:   reference(req.Reference),
+		Description: strings.TrimSpace(req.Description),
+		CreatedBy:   createdBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("wallet hold captured",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("wallet_id", walletID.String()),
+		zap.String("hold_id", hold.ID.String()),
+		zap.String("captured", hold.CapturedAmount.String()),
+	)
+	return hold, nil
+}
+
+// ReleaseHold returns held funds to the wallet's available balance.
+func (s *Service) ReleaseHold(ctx context.Context, tenantID, walletID, holdID uuid.UUID) (*Hold, error) {
+	hold, err := s.repo.ReleaseHold(ctx, tenantID, walletID, holdID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("wallet hold released",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("wallet_id", walletID.String()),
+		zap.String("hold_id", hold.ID.String()),
+	)
+	return hold, nil
+}
+
+// GetWallet retrieves a wallet with its available balance.
+func (s *Service) GetWallet(ctx context.Context, tenantID, walletID uuid.UUID) (*Wallet, error) {
+	return s.repo.GetWallet(ctx, tenantID, walletID)
+}
+
+// ListWallets returns the tenant's wallets.
+func (s *Service) ListWallets(ctx context.Context, tenantID uuid.UUID, filter WalletFilter) ([]*Wallet, error) {
+	return s.repo.ListWallets(ctx, tenantID, filter)
+}
+
+// GetTransaction retrieves a wallet transaction.
+func (s *Service) GetTransaction(ctx context.Context, tenantID, transactionID uuid.UUID) (*Transaction, error) {
+	return s.repo.GetTransaction(ctx, tenantID, transactionID)
+}
+
+// ListTransactions returns a wallet's transactions.
+func (s *Service) ListTransactions(ctx context.Context, tenantID, walletID uuid.UUID, limit, offset int) ([]*Transaction, error) {
+	if _, err := s.repo.GetWallet(ctx, tenantID, walletID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListTransactions(ctx, tenantID, walletID, limit, offset)
+}
+
+// GetHold retrieves a hold on a wallet.
+func (s *Service) GetHold(ctx context.Context, tenantID, walletID, holdID uuid.UUID) (*Hold, error) {
+	return s.repo.GetHold(ctx, tenantID, walletID, holdID)
+}
+
+// ListHolds returns a wallet's holds.
+func (s *Service) ListHolds(ctx context.Context, tenantID, walletID uuid.UUID, filter HoldFilter) ([]*Hold, error) {
+	if _, err := s.repo.GetWallet(ctx, tenantID, walletID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListHolds(ctx, tenantID, walletID, filter)
+}
+
+// isWalletAccount reports whether the account holds wallet balances, which
+// only wallet movements may post to.
+func isWalletAccount(accountCode string) bool {
+	for _, code := range walletAccounts {
+		if code == accountCode {
+			return true
+		}
+	}
+	return false
+}
+
+func reference(value string) *string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	return &value
+}
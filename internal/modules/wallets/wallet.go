@@ -0,0 +1,79 @@
+package wallets
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/modules/ledger"
+)
+
+// walletAccounts are the liability accounts wallet balances are kept in,
+// per owner type. A transfer between wallets of different owner types moves
+// the funds between these accounts.
+var walletAccounts = map[string]string{
+	OwnerTenant:   ledger.AccountTenantWallets,
+	OwnerOutlet:   ledger.AccountOutletWallets,
+	OwnerCustomer: ledger.AccountCustomerWallets,
+}
+
+// available is the part of a wallet's balance not held.
+func available(balance, held decimal.Decimal) decimal.Decimal {
+	if free := balance.Sub(held); free.IsPositive() {
+		return free
+	}
+	return decimal.Zero
+}
+
+// checkAmount requires a positive amount of at most two decimal places.
+func checkAmount(amount decimal.Decimal) error {
+	if !amount.IsPositive() || !amount.Equal(amount.Round(2)) {
+		return fmt.Errorf("%w: amount must be positive with at most two decimal places", ErrInvalidTransaction)
+	}
+	return nil
+}
+
+// checkFunds requires the amount to be covered by the wallet's available
+// balance.
+func checkFunds(amount, balance, held decimal.Decimal) error {
+	if free := available(balance, held); amount.GreaterThan(free) {
+		return fmt.Errorf("%w: available balance is %s", ErrInsufficientFunds, free.StringFixed(2))
+	}
+	return nil
+}
+
+// captureAmount returns the part of a hold to capture: all of it unless a
+// smaller amount is requested.
+func captureAmount(held decimal.Decimal, requested *decimal.Decimal) (decimal.Decimal, error) {
+	if requested == nil {
+		return held, nil
+	}
+	if err := checkAmount(*requested); err != nil {
+		return decimal.Zero, err
+	}
+	if requested.GreaterThan(held) {
+		return decimal.Zero, fmt.Errorf("%w: cannot capture more than the %s held", ErrInvalidTransaction, held.StringFixed(2))
+	}
+	return *requested, nil
+}
+
+// lockOrder returns the distinct wallet IDs in the order their rows are
+// locked. Every movement locks in this order, so two transfers between the
+// same wallets in opposite directions cannot deadlock.
+func lockOrder(ids ...uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	ordered := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if id != uuid.Nil && !seen[id] {
+			seen[id] = true
+			ordered = append(ordered, id)
+		}
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		return bytes.Compare(ordered[i][:], ordered[j][:]) < 0
+	})
+	return ordered
+}
@@ -0,0 +1,72 @@
+package wallets
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestCheckFunds(t *testing.T) {
+	d := decimal.RequireFromString
+	tests := []struct {
+		name    string
+		amount  string
+		balance string
+		held    string
+		wantErr bool
+	}{
+		{"covered", "400", "1000", "600", false},
+		{"held funds unavailable", "400.01", "1000", "600", true},
+		{"fully held", "1", "500", "500", true},
+		{"empty wallet", "1", "0", "0", true},
+	}
+
+	for _, tt := range tests {
+		err := checkFunds(d(tt.amount), d(tt.balance), d(tt.held))
+		if tt.wantErr != (err != nil) {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		}
+		if err != nil && !errors.Is(err, ErrInsufficientFunds) {
+			t.Errorf("%s: expected ErrInsufficientFunds, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestCaptureAmount(t *testing.T) {
+	d := decimal.RequireFromString
+	held := d("750")
+
+	amount, err := captureAmount(held, nil)
+	if err != nil || !amount.Equal(held) {
+		t.Fatalf("expected the whole hold captured, got %s %v", amount, err)
+	}
+
+	partial := d("500")
+	amount, err = captureAmount(held, &partial)
+	if err != nil || !amount.Equal(partial) {
+		t.Fatalf("expected 500 captured, got %s %v", amount, err)
+	}
+
+	for _, raw := range []string{"750.01", "0", "-5", "10.001"} {
+		requested := d(raw)
+		if _, err := captureAmount(held, &requested); !errors.Is(err, ErrInvalidTransaction) {
+			t.Errorf("capture %s: expected ErrInvalidTransaction, got %v", raw, err)
+		}
+	}
+}
+
+func TestLockOrder(t *testing.T) {
+	a := uuid.MustParse("00000000-0000-0000-0000-00000000000a")
+	b := uuid.MustParse("00000000-0000-0000-0000-00000000000b")
+
+	forward := lockOrder(b, a)
+	backward := lockOrder(a, b)
+	if len(forward) != 2 || forward[0] != a || backward[0] != a {
+		t.Fatalf("expected both directions locked from %s, got %v and %v", a, forward, backward)
+	}
+	if got := lockOrder(a, a, uuid.Nil); len(got) != 1 {
+		t.Fatalf("expected one distinct wallet, got %v", got)
+	}
+}
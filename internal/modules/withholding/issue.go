@@ -0,0 +1,107 @@
+package withholding
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/vendor"
+	"github.com/bengobox/treasury-api/internal/ent/withholdingrate"
+	"github.com/bengobox/treasury-api/internal/modules/numbering"
+	"github.com/bengobox/treasury-api/internal/modules/outbox"
+)
+
+// Withhold returns the tax withheld at rate from an amount before VAT,
+// rounded to cents.
+func Withhold(amount, rate decimal.Decimal) decimal.Decimal {
+	if !rate.IsPositive() || !amount.IsPositive() {
+		return decimal.Zero
+	}
+	return amount.Mul(rate).Round(2)
+}
+
+// Issue records the certificate for tax withheld from a bill payment inside
+// the caller's transaction and enqueues
+// treasury.withholding.certificate_issued. The vendor's name and PIN and the
+// category's name are captured as they stand on the payment date.
+func Issue(ctx context.Context, tx *ent.Tx, d Deduction) (*Certificate, error) {
+	entVendor, err := tx.Vendor.Query().
+		Where(
+			vendor.ID(d.VendorID),
+			vendor.TenantID(d.TenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load vendor %s: %w", d.VendorID, err)
+	}
+
+	categoryName := d.Category
+	entRate, err := tx.WithholdingRate.Query().
+		Where(
+			withholdingrate.TenantID(d.TenantID),
+			withholdingrate.Category(d.Category),
+		).
+		Only(ctx)
+	switch {
+	case err == nil:
+		categoryName = entRate.Name
+	case !ent.IsNotFound(err):
+		return nil, fmt.Errorf("load withholding rate: %w", err)
+	}
+
+	number, err := numbering.Next(ctx, tx, d.TenantID, numbering.SequenceWithholdingCertificate, numbering.PrefixWithholdingCertificate)
+	if err != nil {
+		return nil, err
+	}
+
+	entCert, err := tx.WithholdingCertificate.Create().
+		SetTenantID(d.TenantID).
+		SetCertificateNumber(number).
+		SetVendorID(entVendor.ID).
+		SetVendorName(entVendor.LegalName).
+		SetVendorPin(entVendor.KraPin).
+		SetBillID(d.BillID).
+		SetBillNumber(d.BillNumber).
+		SetVendorReference(d.VendorReference).
+		SetCategory(d.Category).
+		SetCategoryName(categoryName).
+		SetRate(d.Rate).
+		SetCurrency(d.Currency).
+		SetGrossAmount(d.GrossAmount).
+		SetTaxAmount(d.TaxAmount).
+		SetPaymentDate(d.PaymentDate).
+		SetPaymentReference(d.PaymentReference).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create withholding certificate: %w", err)
+	}
+
+	if err := outbox.Enqueue(ctx, tx, outbox.Event{
+		TenantID:      d.TenantID,
+		AggregateType: "withholding_certificate",
+		AggregateID:   entCert.ID,
+		EventType:     EventCertificateIssued,
+		Payload: map[string]any{
+			"certificate_id":     entCert.ID.String(),
+			"certificate_number": entCert.CertificateNumber,
+			"vendor_id":          entCert.VendorID.String(),
+			"vendor_name":        entCert.VendorName,
+			"vendor_pin":         entCert.VendorPin,
+			"bill_id":            entCert.BillID.String(),
+			"bill_number":        entCert.BillNumber,
+			"category":           entCert.Category,
+			"rate":               entCert.Rate.String(),
+			"currency":           entCert.Currency,
+			"gross_amount":       entCert.GrossAmount.String(),
+			"tax_amount":         entCert.TaxAmount.String(),
+			"payment_date":       entCert.PaymentDate.Format(time.DateOnly),
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return mapEntCertificate(entCert), nil
+}
@@ -0,0 +1,102 @@
+package withholding
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// EventCertificateIssued is published when tax is withheld from a vendor
+// payment.
+const EventCertificateIssued = "treasury.withholding.certificate_issued"
+
+// Rate is the withholding tax rate a tenant deducts from payments in a service
+// category, e.g. 5% on professional fees.
+type Rate struct {
+	ID        uuid.UUID       `json:"id"`
+	TenantID  uuid.UUID       `json:"tenant_id"`
+	Category  string          `json:"category"`
+	Name      string          `json:"name"`
+	Rate      decimal.Decimal `json:"rate"`
+	Active    bool            `json:"active"`
+	UpdatedBy *uuid.UUID      `json:"updated_by,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// SaveRateRequest creates or changes a category's rate. Active defaults to
+// true for a new category and is left as it is otherwise.
+type SaveRateRequest struct {
+	Name   string          `json:"name"`
+	Rate   decimal.Decimal `json:"rate"`
+	Active *bool           `json:"active"`
+}
+
+// Certificate records tax withheld from the payment of one vendor bill in one
+// service category.
+type Certificate struct {
+	ID                uuid.UUID       `json:"id"`
+	TenantID          uuid.UUID       `json:"tenant_id"`
+	CertificateNumber string          `json:"certificate_number"`
+	VendorID          uuid.UUID       `json:"vendor_id"`
+	VendorName        string          `json:"vendor_name"`
+	VendorPIN         string          `json:"vendor_pin,omitempty"`
+	BillID            uuid.UUID       `json:"bill_id"`
+	BillNumber        string          `json:"bill_number"`
+	VendorReference   string          `json:"vendor_reference,omitempty"`
+	Category          string          `json:"category"`
+	CategoryName      string          `json:"category_name"`
+	Rate              decimal.Decimal `json:"rate"`
+	Currency          string          `json:"currency"`
+	GrossAmount       decimal.Decimal `json:"gross_amount"`
+	TaxAmount         decimal.Decimal `json:"tax_amount"`
+	PaymentDate       time.Time       `json:"payment_date"`
+	PaymentReference  string          `json:"payment_reference,omitempty"`
+	CreatedAt         time.Time       `json:"created_at"`
+}
+
+// Deduction is tax withheld from a bill payment in one category, recorded by
+// the module paying the bill.
+type Deduction struct {
+	TenantID         uuid.UUID
+	VendorID         uuid.UUID
+	BillID           uuid.UUID
+	BillNumber       string
+	VendorReference  string
+	Category         string
+	Rate             decimal.Decimal
+	Currency         string
+	GrossAmount      decimal.Decimal
+	TaxAmount        decimal.Decimal
+	PaymentDate      time.Time
+	PaymentReference string
+}
+
+// Schedule lists the certificates issued in a month for filing the withholding
+// tax return, with totals per currency.
+type Schedule struct {
+	Month        string          `json:"month"`
+	From         time.Time       `json:"from"`
+	To           time.Time       `json:"to"`
+	Certificates []*Certificate  `json:"certificates"`
+	Totals       []ScheduleTotal `json:"totals"`
+}
+
+// ScheduleTotal sums a schedule's certificates in one currency.
+type ScheduleTotal struct {
+	Currency    string          `json:"currency"`
+	Count       int             `json:"count"`
+	GrossAmount decimal.Decimal `json:"gross_amount"`
+	TaxAmount   decimal.Decimal `json:"tax_amount"`
+}
+
+// ListFilter narrows certificate listings. From and To bound the payment date.
+type ListFilter struct {
+	VendorID *uuid.UUID
+	BillID   *uuid.UUID
+	From     *time.Time
+	To       *time.Time
+	Limit    int
+	Offset   int
+}
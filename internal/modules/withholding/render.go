@@ -0,0 +1,92 @@
+package withholding
+
+import (
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/shared/pdf"
+)
+
+const (
+	marginLeft  = 40.0
+	marginRight = pdf.PageWidth - 40.0
+	lineHeight  = 14.0
+)
+
+// RenderCertificate lays a withholding tax certificate out as an A4 PDF.
+func RenderCertificate(cert *Certificate) []byte {
+	doc := pdf.New()
+	page := doc.AddPage()
+	y := pdf.PageHeight - 50
+
+	page.Text(marginLeft, y, 18, true, "Withholding Tax Certificate")
+	page.TextRight(marginRight, y, 10, false, "Certificate "+cert.CertificateNumber)
+	y -= 28
+
+	page.Text(marginLeft, y, 9, true, "Withholdee")
+	y -= lineHeight
+	page.Text(marginLeft, y, 11, true, cert.VendorName)
+	y -= lineHeight
+	if cert.VendorPIN != "" {
+		page.Text(marginLeft, y, 9, false, "KRA PIN: "+cert.VendorPIN)
+		y -= lineHeight
+	}
+	y -= 10
+
+	rows := [][2]string{
+		{"Nature of payment", cert.CategoryName},
+		{"Invoice", invoiceReference(cert)},
+		{"Date of payment", formatDate(cert.PaymentDate)},
+		{"Payment reference", cert.PaymentReference},
+		{"Amount before VAT", cert.Currency + " " + money(cert.GrossAmount)},
+		{"Rate", cert.Rate.Shift(2).String() + "%"},
+		{"Tax withheld", cert.Currency + " " + money(cert.TaxAmount)},
+	}
+	for _, row := range rows {
+		if row[1] == "" {
+			continue
+		}
+		page.Text(marginLeft, y, 10, false, row[0])
+		page.Text(marginLeft+150, y, 10, row[0] == "Tax withheld", row[1])
+		y -= lineHeight + 2
+	}
+
+	y -= 10
+	page.Line(marginLeft, y, marginRight, y)
+	y -= lineHeight
+	page.Text(marginLeft, y, 9, false, "The tax above was deducted from the payment and is remitted to the Kenya Revenue Authority.")
+
+	return doc.Bytes()
+}
+
+func invoiceReference(cert *Certificate) string {
+	if cert.VendorReference == "" {
+		return cert.BillNumber
+	}
+	return cert.VendorReference + " (" + cert.BillNumber + ")"
+}
+
+func money(amount decimal.Decimal) string {
+	fixed := amount.Abs().StringFixed(2)
+	whole, fraction, _ := strings.Cut(fixed, ".")
+
+	var b strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(digit)
+	}
+
+	formatted := b.String() + "." + fraction
+	if amount.IsNegative() {
+		return "(" + formatted + ")"
+	}
+	return formatted
+}
+
+func formatDate(t time.Time) string {
+	return t.Format("02 Jan 2006")
+}
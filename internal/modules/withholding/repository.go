@@ -0,0 +1,33 @@
+package withholding
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrRateNotFound is returned when a service category has no active rate.
+	ErrRateNotFound = errors.New("withholding rate not found")
+	// ErrInvalidRate is returned when a withholding rate is malformed.
+	ErrInvalidRate = errors.New("invalid withholding rate")
+	// ErrCertificateNotFound is returned when a certificate does not exist for the tenant.
+	ErrCertificateNotFound = errors.New("withholding certificate not found")
+	// ErrInvalidSchedule is returned when a schedule month is malformed.
+	ErrInvalidSchedule = errors.New("invalid withholding schedule")
+)
+
+// Repository abstracts persistence for withholding rates and certificates.
+type Repository interface {
+	// SaveRate creates the category's rate or updates it.
+	SaveRate(ctx context.Context, tenantID uuid.UUID, category string, req SaveRateRequest, updatedBy *uuid.UUID) (*Rate, error)
+	GetRate(ctx context.Context, tenantID uuid.UUID, category string) (*Rate, error)
+	ListRates(ctx context.Context, tenantID uuid.UUID) ([]*Rate, error)
+	GetCertificate(ctx context.Context, tenantID, certificateID uuid.UUID) (*Certificate, error)
+	ListCertificates(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Certificate, error)
+	// CertificatesBetween returns the certificates for payments made on or
+	// after from and before to, oldest first.
+	CertificatesBetween(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]*Certificate, error)
+}
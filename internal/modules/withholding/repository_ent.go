@@ -0,0 +1,231 @@
+package withholding
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/withholdingcertificate"
+	"github.com/bengobox/treasury-api/internal/ent/withholdingrate"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+)
+
+// EntRepository implements the Repository interface using Ent ORM.
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository creates a new Ent-backed repository.
+func NewEntRepository(client *ent.Client) *EntRepository {
+	return &EntRepository{client: client}
+}
+
+// SaveRate creates the category's rate or updates it.
+func (r *EntRepository) SaveRate(ctx context.Context, tenantID uuid.UUID, category string, req SaveRateRequest, updatedBy *uuid.UUID) (*Rate, error) {
+	var rateID uuid.UUID
+
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		existing, err := tx.WithholdingRate.Query().
+			Where(
+				withholdingrate.TenantID(tenantID),
+				withholdingrate.Category(category),
+			).
+			ForUpdate().
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("lock withholding rate: %w", err)
+		}
+
+		if existing == nil {
+			builder := tx.WithholdingRate.Create().
+				SetTenantID(tenantID).
+				SetCategory(category).
+				SetName(req.Name).
+				SetRate(req.Rate).
+				SetNillableUpdatedBy(updatedBy)
+			if req.Active != nil {
+				builder.SetActive(*req.Active)
+			}
+			created, err := builder.Save(ctx)
+			if err != nil {
+				return fmt.Errorf("create withholding rate: %w", err)
+			}
+			rateID = created.ID
+			return nil
+		}
+
+		update := tx.WithholdingRate.UpdateOneID(existing.ID).
+			SetName(req.Name).
+			SetRate(req.Rate).
+			SetNillableUpdatedBy(updatedBy)
+		if req.Active != nil {
+			update.SetActive(*req.Active)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("update withholding rate: %w", err)
+		}
+		rateID = existing.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entRate, err := r.client.WithholdingRate.Get(ctx, rateID)
+	if err != nil {
+		return nil, fmt.Errorf("get withholding rate: %w", err)
+	}
+	return mapEntRate(entRate), nil
+}
+
+// GetRate retrieves a category's rate, active or not.
+func (r *EntRepository) GetRate(ctx context.Context, tenantID uuid.UUID, category string) (*Rate, error) {
+	entRate, err := r.client.WithholdingRate.Query().
+		Where(
+			withholdingrate.TenantID(tenantID),
+			withholdingrate.Category(category),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrRateNotFound
+		}
+		return nil, fmt.Errorf("get withholding rate: %w", err)
+	}
+	return mapEntRate(entRate), nil
+}
+
+// ListRates returns the tenant's rates by category.
+func (r *EntRepository) ListRates(ctx context.Context, tenantID uuid.UUID) ([]*Rate, error) {
+	entRates, err := r.client.WithholdingRate.Query().
+		Where(withholdingrate.TenantID(tenantID)).
+		Order(ent.Asc(withholdingrate.FieldCategory)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list withholding rates: %w", err)
+	}
+
+	rates := make([]*Rate, len(entRates))
+	for i, entRate := range entRates {
+		rates[i] = mapEntRate(entRate)
+	}
+	return rates, nil
+}
+
+// GetCertificate retrieves a certificate.
+func (r *EntRepository) GetCertificate(ctx context.Context, tenantID, certificateID uuid.UUID) (*Certificate, error) {
+	entCert, err := r.client.WithholdingCertificate.Query().
+		Where(
+			withholdingcertificate.ID(certificateID),
+			withholdingcertificate.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrCertificateNotFound
+		}
+		return nil, fmt.Errorf("get withholding certificate: %w", err)
+	}
+	return mapEntCertificate(entCert), nil
+}
+
+// ListCertificates returns certificates, latest payment first.
+func (r *EntRepository) ListCertificates(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Certificate, error) {
+	query := r.client.WithholdingCertificate.Query().
+		Where(withholdingcertificate.TenantID(tenantID))
+	if filter.VendorID != nil {
+		query = query.Where(withholdingcertificate.VendorID(*filter.VendorID))
+	}
+	if filter.BillID != nil {
+		query = query.Where(withholdingcertificate.BillID(*filter.BillID))
+	}
+	if filter.From != nil {
+		query = query.Where(withholdingcertificate.PaymentDateGTE(*filter.From))
+	}
+	if filter.To != nil {
+		query = query.Where(withholdingcertificate.PaymentDateLT(*filter.To))
+	}
+
+	entCerts, err := query.
+		Order(ent.Desc(withholdingcertificate.FieldPaymentDate), ent.Desc(withholdingcertificate.FieldCertificateNumber)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list withholding certificates: %w", err)
+	}
+
+	return mapEntCertificates(entCerts), nil
+}
+
+// CertificatesBetween returns the certificates for payments in [from, to).
+func (r *EntRepository) CertificatesBetween(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]*Certificate, error) {
+	entCerts, err := r.client.WithholdingCertificate.Query().
+		Where(
+			withholdingcertificate.TenantID(tenantID),
+			withholdingcertificate.PaymentDateGTE(from),
+			withholdingcertificate.PaymentDateLT(to),
+		).
+		Order(ent.Asc(withholdingcertificate.FieldPaymentDate), ent.Asc(withholdingcertificate.FieldCertificateNumber)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list withholding certificates: %w", err)
+	}
+
+	return mapEntCertificates(entCerts), nil
+}
+
+func mapEntRate(entRate *ent.WithholdingRate) *Rate {
+	rate := &Rate{
+		ID:        entRate.ID,
+		TenantID:  entRate.TenantID,
+		Category:  entRate.Category,
+		Name:      entRate.Name,
+		Rate:      entRate.Rate,
+		Active:    entRate.Active,
+		CreatedAt: entRate.CreatedAt,
+		UpdatedAt: entRate.UpdatedAt,
+	}
+
+	// Optional fields - check for zero values
+	if entRate.UpdatedBy != uuid.Nil {
+		updatedBy := entRate.UpdatedBy
+		rate.UpdatedBy = &updatedBy
+	}
+
+	return rate
+}
+
+func mapEntCertificates(entCerts []*ent.WithholdingCertificate) []*Certificate {
+	certs := make([]*Certificate, len(entCerts))
+	for i, entCert := range entCerts {
+		certs[i] = mapEntCertificate(entCert)
+	}
+	return certs
+}
+
+func mapEntCertificate(entCert *ent.WithholdingCertificate) *Certificate {
+	return &Certificate{
+		ID:                entCert.ID,
+		TenantID:          entCert.TenantID,
+		CertificateNumber: entCert.CertificateNumber,
+		VendorID:          entCert.VendorID,
+		VendorName:        entCert.VendorName,
+		VendorPIN:         entCert.VendorPin,
+		BillID:            entCert.BillID,
+		BillNumber:        entCert.BillNumber,
+		VendorReference:   entCert.VendorReference,
+		Category:          entCert.Category,
+		CategoryName:      entCert.CategoryName,
+		Rate:              entCert.Rate,
+		Currency:          entCert.Currency,
+		GrossAmount:       entCert.GrossAmount,
+		TaxAmount:         entCert.TaxAmount,
+		PaymentDate:       entCert.PaymentDate,
+		PaymentReference:  entCert.PaymentReference,
+		CreatedAt:         entCert.CreatedAt,
+	}
+}
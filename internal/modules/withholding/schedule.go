@@ -0,0 +1,85 @@
+package withholding
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const monthLayout = "2006-01"
+
+// ParseMonth parses a YYYY-MM month into the range of payment dates it covers.
+func ParseMonth(month string) (from, to time.Time, err error) {
+	from, err = time.Parse(monthLayout, month)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: month must be YYYY-MM", ErrInvalidSchedule)
+	}
+	return from, from.AddDate(0, 1, 0), nil
+}
+
+// BuildSchedule totals the certificates issued between from and to.
+func BuildSchedule(from, to time.Time, certs []*Certificate) *Schedule {
+	schedule := &Schedule{
+		Month:        from.Format(monthLayout),
+		From:         from,
+		To:           to,
+		Certificates: certs,
+		Totals:       []ScheduleTotal{},
+	}
+	if schedule.Certificates == nil {
+		schedule.Certificates = []*Certificate{}
+	}
+
+	totals := map[string]*ScheduleTotal{}
+	for _, cert := range certs {
+		total := totals[cert.Currency]
+		if total == nil {
+			total = &ScheduleTotal{Currency: cert.Currency, GrossAmount: decimal.Zero, TaxAmount: decimal.Zero}
+			totals[cert.Currency] = total
+		}
+		total.Count++
+		total.GrossAmount = total.GrossAmount.Add(cert.GrossAmount)
+		total.TaxAmount = total.TaxAmount.Add(cert.TaxAmount)
+	}
+	for _, total := range totals {
+		schedule.Totals = append(schedule.Totals, *total)
+	}
+	sort.Slice(schedule.Totals, func(i, j int) bool { return schedule.Totals[i].Currency < schedule.Totals[j].Currency })
+
+	return schedule
+}
+
+// WriteCSV writes the schedule one line per certificate, in the column order
+// of the withholding tax return.
+func (s *Schedule) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{
+		"vendor_pin", "vendor_name", "nature_of_payment", "payment_date", "invoice_number",
+		"currency", "gross_amount", "rate_percent", "tax_withheld", "certificate_number",
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for _, cert := range s.Certificates {
+		invoice := cert.VendorReference
+		if invoice == "" {
+			invoice = cert.BillNumber
+		}
+		record := []string{
+			cert.VendorPIN, cert.VendorName, cert.CategoryName, cert.PaymentDate.Format(time.DateOnly), invoice,
+			cert.Currency, cert.GrossAmount.StringFixed(2), cert.Rate.Shift(2).String(), cert.TaxAmount.StringFixed(2),
+			cert.CertificateNumber,
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
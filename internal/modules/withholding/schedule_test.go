@@ -0,0 +1,73 @@
+package withholding
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestWithhold(t *testing.T) {
+	if got := Withhold(decimal.RequireFromString("33333.33"), decimal.RequireFromString("0.03")); !got.Equal(decimal.RequireFromString("1000")) {
+		t.Fatalf("expected 1000 withheld, got %s", got)
+	}
+	if got := Withhold(decimal.RequireFromString("5000"), decimal.Zero); !got.IsZero() {
+		t.Fatalf("expected nothing withheld without a rate, got %s", got)
+	}
+}
+
+func TestNormalizeCategory(t *testing.T) {
+	if got := NormalizeCategory("  Professional   Fees "); got != "professional_fees" {
+		t.Fatalf("unexpected category %q", got)
+	}
+}
+
+func TestBuildSchedule(t *testing.T) {
+	from, to, err := ParseMonth("2026-02")
+	if err != nil {
+		t.Fatalf("parse month: %v", err)
+	}
+	if !to.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected month end %s", to)
+	}
+	if _, _, err := ParseMonth("02-2026"); !errors.Is(err, ErrInvalidSchedule) {
+		t.Fatalf("expected invalid month to be rejected, got %v", err)
+	}
+
+	amount := decimal.RequireFromString
+	certs := []*Certificate{
+		{CertificateNumber: "WHT-000001", VendorName: "Kamau & Associates", VendorPIN: "P051234567X", BillNumber: "BILL-000010", VendorReference: "INV-77",
+			CategoryName: "Professional fees", Rate: amount("0.05"), Currency: "KES", GrossAmount: amount("150000"), TaxAmount: amount("7500"), PaymentDate: from.AddDate(0, 0, 9)},
+		{CertificateNumber: "WHT-000002", VendorName: "Mjengo Builders", BillNumber: "BILL-000011",
+			CategoryName: "Contractual fees", Rate: amount("0.03"), Currency: "KES", GrossAmount: amount("20000"), TaxAmount: amount("600"), PaymentDate: from.AddDate(0, 0, 20)},
+		{CertificateNumber: "WHT-000003", VendorName: "Globex Consulting", BillNumber: "BILL-000012",
+			CategoryName: "Management fees", Rate: amount("0.05"), Currency: "USD", GrossAmount: amount("1000"), TaxAmount: amount("50"), PaymentDate: from.AddDate(0, 0, 25)},
+	}
+
+	schedule := BuildSchedule(from, to, certs)
+	if schedule.Month != "2026-02" || len(schedule.Totals) != 2 {
+		t.Fatalf("unexpected schedule %+v", schedule)
+	}
+	kes := schedule.Totals[0]
+	if kes.Currency != "KES" || kes.Count != 2 || !kes.GrossAmount.Equal(amount("170000")) || !kes.TaxAmount.Equal(amount("8100")) {
+		t.Fatalf("unexpected KES totals %+v", kes)
+	}
+
+	var buf bytes.Buffer
+	if err := schedule.WriteCSV(&buf); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected header and 3 lines, got %q", buf.String())
+	}
+	if lines[1] != "P051234567X,Kamau & Associates,Professional fees,2026-02-10,INV-77,KES,150000.00,5,7500.00,WHT-000001" {
+		t.Fatalf("unexpected line %q", lines[1])
+	}
+	if !strings.Contains(lines[2], ",BILL-000011,") {
+		t.Fatalf("expected the bill number when the vendor gave no reference, got %q", lines[2])
+	}
+}
@@ -0,0 +1,112 @@
+package withholding
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// Service manages withholding tax rates, certificates and monthly schedules.
+type Service struct {
+	repo   Repository
+	logger *zap.Logger
+}
+
+// NewService creates a new withholding service.
+func NewService(repo Repository, logger *zap.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// NormalizeCategory returns the canonical form of a category code:
+// lower case with underscores, e.g. "Professional Fees" becomes
+// professional_fees.
+func NormalizeCategory(category string) string {
+	return strings.Join(strings.Fields(strings.ToLower(category)), "_")
+}
+
+// SaveRate creates or changes the rate of a service category. Rates are
+// fractions of the amount before VAT: 0.05 withholds 5%.
+func (s *Service) SaveRate(ctx context.Context, tenantID uuid.UUID, category string, req SaveRateRequest, updatedBy *uuid.UUID) (*Rate, error) {
+	category = NormalizeCategory(category)
+	if category == "" {
+		return nil, fmt.Errorf("%w: category is required", ErrInvalidRate)
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidRate)
+	}
+	if !req.Rate.IsPositive() || req.Rate.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return nil, fmt.Errorf("%w: rate must be a fraction between 0 and 1", ErrInvalidRate)
+	}
+
+	rate, err := s.repo.SaveRate(ctx, tenantID, category, req, updatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("withholding rate saved",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("category", rate.Category),
+		zap.String("rate", rate.Rate.String()),
+		zap.Bool("active", rate.Active),
+	)
+
+	return rate, nil
+}
+
+// ListRates returns the tenant's rates.
+func (s *Service) ListRates(ctx context.Context, tenantID uuid.UUID) ([]*Rate, error) {
+	return s.repo.ListRates(ctx, tenantID)
+}
+
+// ActiveRate returns the rate of a category that can be used on new bills.
+func (s *Service) ActiveRate(ctx context.Context, tenantID uuid.UUID, category string) (*Rate, error) {
+	rate, err := s.repo.GetRate(ctx, tenantID, NormalizeCategory(category))
+	if err != nil {
+		return nil, err
+	}
+	if !rate.Active {
+		return nil, fmt.Errorf("%w: category %s is inactive", ErrRateNotFound, rate.Category)
+	}
+	return rate, nil
+}
+
+// GetCertificate retrieves a certificate.
+func (s *Service) GetCertificate(ctx context.Context, tenantID, certificateID uuid.UUID) (*Certificate, error) {
+	return s.repo.GetCertificate(ctx, tenantID, certificateID)
+}
+
+// ListCertificates returns the tenant's certificates.
+func (s *Service) ListCertificates(ctx context.Context, tenantID uuid.UUID, filter ListFilter) ([]*Certificate, error) {
+	return s.repo.ListCertificates(ctx, tenantID, filter)
+}
+
+// Schedule builds the withholding tax schedule of a YYYY-MM month from the
+// certificates for payments made in it. The month defaults to the previous
+// one, whose return is due by the 20th.
+func (s *Service) Schedule(ctx context.Context, tenantID uuid.UUID, month string) (*Schedule, error) {
+	month = strings.TrimSpace(month)
+	if month == "" {
+		now := time.Now().UTC()
+		month = now.AddDate(0, 0, -now.Day()).Format(monthLayout)
+	}
+	from, to, err := ParseMonth(month)
+	if err != nil {
+		return nil, err
+	}
+
+	certs, err := s.repo.CertificatesBetween(ctx, tenantID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return BuildSchedule(from, to, certs), nil
+}
@@ -0,0 +1,24 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/ent/invoice"
+)
+
+// Backfill zeroes amount columns that were added to tables with existing
+// rows, so those rows scan into decimal fields. It is idempotent and runs
+// after the schema migration.
+func Backfill(ctx context.Context, client *ent.Client) error {
+	if _, err := client.Invoice.Update().
+		Where(invoice.WrittenOffAmountIsNil()).
+		SetWrittenOffAmount(decimal.Zero).
+		Save(ctx); err != nil {
+		return fmt.Errorf("backfill invoice written-off amount: %w", err)
+	}
+	return nil
+}
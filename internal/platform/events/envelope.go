@@ -0,0 +1,63 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Envelope is the common wrapper of events exchanged between BengoBox services.
+type Envelope struct {
+	EventID   string          `json:"event_id"`
+	EventType string          `json:"event_type"`
+	TenantID  uuid.UUID       `json:"tenant_id"`
+	Timestamp time.Time       `json:"timestamp"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// Decode parses an inbound event envelope. Malformed envelopes are permanent
+// failures: redelivering them cannot succeed.
+func Decode(data []byte) (Envelope, error) {
+	var env Envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return Envelope{}, Permanent(fmt.Errorf("decode envelope: %w", err))
+	}
+	if env.EventID == "" || env.TenantID == uuid.Nil {
+		return Envelope{}, Permanent(errors.New("envelope requires event_id and tenant_id"))
+	}
+	return env, nil
+}
+
+// DecodeData unmarshals the envelope data into v, marking failures permanent.
+func (e Envelope) DecodeData(v any) error {
+	if err := json.Unmarshal(e.Data, v); err != nil {
+		return Permanent(fmt.Errorf("decode %s data: %w", e.EventType, err))
+	}
+	return nil
+}
+
+// permanentError marks a handler failure that must not be retried.
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps err so consumers terminate the message instead of
+// redelivering it.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return permanentError{err: err}
+}
+
+// IsPermanent reports whether err was marked with Permanent.
+func IsPermanent(err error) bool {
+	var p permanentError
+	return errors.As(err, &p)
+}
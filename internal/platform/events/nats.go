@@ -3,6 +3,7 @@ package events
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/nats-io/nats.go"
@@ -31,14 +32,24 @@ func EnsureStream(ctx context.Context, nc *nats.Conn, cfg config.EventsConfig) e
 		return fmt.Errorf("jetstream init: %w", err)
 	}
 
-	_, err = js.StreamInfo(cfg.StreamName)
+	// Event subjects are multi-token (treasury.invoice.created), so the stream
+	// needs the full wildcard.
+	subjects := []string{"treasury.>"}
+
+	info, err := js.StreamInfo(cfg.StreamName)
 	if err == nil {
-		return nil
+		if slices.Equal(info.Config.Subjects, subjects) {
+			return nil
+		}
+		streamCfg := info.Config
+		streamCfg.Subjects = subjects
+		_, err = js.UpdateStream(&streamCfg)
+		return err
 	}
 
 	_, err = js.AddStream(&nats.StreamConfig{
 		Name:     cfg.StreamName,
-		Subjects: []string{"treasury.*"},
+		Subjects: subjects,
 		Replicas: 1,
 	})
 	return err
@@ -0,0 +1,32 @@
+package mpesa
+
+import (
+	"strings"
+)
+
+// Callback is the body M-Pesa posts to the result and queue timeout URLs of
+// a payout request.
+type Callback struct {
+	Result CallbackResult `json:"Result"`
+}
+
+// CallbackResult is the outcome of a payout request. ResultCode 0 means the
+// payout was made.
+type CallbackResult struct {
+	ResultType               int    `json:"ResultType"`
+	ResultCode               Code   `json:"ResultCode"`
+	ResultDesc               string `json:"ResultDesc"`
+	OriginatorConversationID string `json:"OriginatorConversationID"`
+	ConversationID           string `json:"ConversationID"`
+	TransactionID            string `json:"TransactionID"`
+}
+
+// Code is a Daraja result code, sent as a number by some APIs and as a
+// string by others.
+type Code string
+
+// UnmarshalJSON accepts both forms.
+func (c *Code) UnmarshalJSON(data []byte) error {
+	*c = Code(strings.Trim(string(data), `"`))
+	return nil
+}
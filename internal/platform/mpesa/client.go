@@ -0,0 +1,245 @@
+package mpesa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/config"
+)
+
+// Daraja command IDs used for payouts.
+const (
+	CommandBusinessPayment  = "BusinessPayment"
+	CommandBusinessPayBill  = "BusinessPayBill"
+	CommandBusinessBuyGoods = "BusinessBuyGoods"
+)
+
+// Client calls the Safaricom Daraja API to pay out from the platform's
+// shortcode: B2C to a phone number, B2B to a paybill or till.
+type Client struct {
+	client         *http.Client
+	baseURL        string
+	consumerKey    string
+	consumerSecret string
+	shortCode      string
+	initiator      string
+	credential     string
+	resultURL      string
+	timeoutURL     string
+	now            func() time.Time
+
+	mu          sync.Mutex
+	token       string
+	tokenExpiry time.Time
+}
+
+// NewClient creates a Daraja client for the configured shortcode.
+func NewClient(cfg config.MpesaConfig) *Client {
+	callbackBase := strings.TrimRight(cfg.CallbackBaseURL, "/")
+	token := url.QueryEscape(cfg.CallbackToken)
+	return &Client{
+		client:         &http.Client{Timeout: cfg.Timeout},
+		baseURL:        strings.TrimRight(cfg.BaseURL, "/"),
+		consumerKey:    cfg.ConsumerKey,
+		consumerSecret: cfg.ConsumerSecret,
+		shortCode:      cfg.ShortCode,
+		initiator:      cfg.InitiatorName,
+		credential:     cfg.SecurityCredential,
+		resultURL:      fmt.Sprintf("%s/callbacks/mpesa/result?token=%s", callbackBase, token),
+		timeoutURL:     fmt.Sprintf("%s/callbacks/mpesa/timeout?token=%s", callbackBase, token),
+		now:            time.Now,
+	}
+}
+
+// Payment is a payout request. Daraja pays whole shillings only.
+type Payment struct {
+	// OriginatorConversationID identifies the request; Daraja rejects a
+	// second request with the same ID, so a resubmission after a network
+	// failure cannot pay twice.
+	OriginatorConversationID string
+	Amount                   decimal.Decimal
+	// PartyB is the phone number (B2C) or shortcode (B2B) paid.
+	PartyB string
+	// AccountReference is the paybill account number for B2B; empty pays a
+	// till.
+	AccountReference string
+	Remarks          string
+	Occasion         string
+}
+
+// Response is Daraja's acknowledgement that a payout was queued. The outcome
+// is posted later to the result URL.
+type Response struct {
+	ConversationID           string `json:"ConversationID"`
+	OriginatorConversationID string `json:"OriginatorConversationID"`
+	ResponseCode             string `json:"ResponseCode"`
+	ResponseDescription      string `json:"ResponseDescription"`
+}
+
+// APIError is a request Daraja refused.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("mpesa: status %d: %s: %s", e.StatusCode, e.Code, e.Message)
+	}
+	return fmt.Sprintf("mpesa: status %d: %s", e.StatusCode, e.Message)
+}
+
+// IsTransient reports whether a failed request may succeed if sent again:
+// network failures, timeouts, throttling and Daraja server errors.
+func IsTransient(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// B2C pays a phone number from the shortcode.
+func (c *Client) B2C(ctx context.Context, p Payment) (*Response, error) {
+	return c.post(ctx, "/mpesa/b2c/v3/paymentrequest", map[string]any{
+		"OriginatorConversationID": p.OriginatorConversationID,
+		"InitiatorName":            c.initiator,
+		"SecurityCredential":       c.credential,
+		"CommandID":                CommandBusinessPayment,
+		"Amount":                   p.Amount.StringFixed(0),
+		"PartyA":                   c.shortCode,
+		"PartyB":                   p.PartyB,
+		"Remarks":                  p.Remarks,
+		"QueueTimeOutURL":          c.timeoutURL,
+		"ResultURL":                c.resultURL,
+		"Occasion":                 p.Occasion,
+	})
+}
+
+// B2B pays a paybill account, or a till when AccountReference is empty,
+// from the shortcode.
+func (c *Client) B2B(ctx context.Context, p Payment) (*Response, error) {
+	command := CommandBusinessBuyGoods
+	if p.AccountReference != "" {
+		command = CommandBusinessPayBill
+	}
+	return c.post(ctx, "/mpesa/b2b/v1/paymentrequest", map[string]any{
+		"OriginatorConversationID": p.OriginatorConversationID,
+		"Initiator":                c.initiator,
+		"SecurityCredential":       c.credential,
+		"CommandID":                command,
+		"SenderIdentifierType":     "4",
+		"RecieverIdentifierType":   "4",
+		"Amount":                   p.Amount.StringFixed(0),
+		"PartyA":                   c.shortCode,
+		"PartyB":                   p.PartyB,
+		"AccountReference":         p.AccountReference,
+		"Remarks":                  p.Remarks,
+		"QueueTimeOutURL":          c.timeoutURL,
+		"ResultURL":                c.resultURL,
+	})
+}
+
+func (c *Client) post(ctx context.Context, path string, body map[string]any) (*Response, error) {
+	token, err := c.accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("mpesa: encode request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("mpesa: build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp Response
+	if err := c.do(req, &resp); err != nil {
+		return nil, err
+	}
+	if resp.ResponseCode != "0" {
+		return nil, &APIError{StatusCode: http.StatusOK, Code: resp.ResponseCode, Message: resp.ResponseDescription}
+	}
+	return &resp, nil
+}
+
+// accessToken returns a cached OAuth token, fetching a new one shortly
+// before the cached one expires.
+func (c *Client) accessToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && c.now().Before(c.tokenExpiry) {
+		return c.token, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/oauth/v1/generate?grant_type=client_credentials", nil)
+	if err != nil {
+		return "", fmt.Errorf("mpesa: build token request: %w", err)
+	}
+	req.SetBasicAuth(c.consumerKey, c.consumerSecret)
+
+	var grant struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   string `json:"expires_in"`
+	}
+	if err := c.do(req, &grant); err != nil {
+		return "", err
+	}
+
+	lifetime, err := time.ParseDuration(grant.ExpiresIn + "s")
+	if err != nil {
+		lifetime = time.Hour
+	}
+	c.token = grant.AccessToken
+	c.tokenExpiry = c.now().Add(lifetime - time.Minute)
+	return c.token, nil
+}
+
+func (c *Client) do(req *http.Request, v any) error {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("mpesa: %s: %w", req.URL.Path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return fmt.Errorf("mpesa: read %s response: %w", req.URL.Path, err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var failure struct {
+			ErrorCode    string `json:"errorCode"`
+			ErrorMessage string `json:"errorMessage"`
+		}
+		_ = json.Unmarshal(body, &failure)
+		if failure.ErrorMessage == "" {
+			failure.ErrorMessage = strings.TrimSpace(string(body))
+		}
+		return &APIError{StatusCode: resp.StatusCode, Code: failure.ErrorCode, Message: failure.ErrorMessage}
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("mpesa: decode %s response: %w", req.URL.Path, err)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package mpesa
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bengobox/treasury-api/internal/config"
+)
+
+func TestB2C(t *testing.T) {
+	var tokens int
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/v1/generate":
+			if user, pass, ok := r.BasicAuth(); !ok || user != "key" || pass != "secret" {
+				t.Errorf("unexpected basic auth %q:%q", user, pass)
+			}
+			tokens++
+			_, _ = w.Write([]byte(`{"access_token":"token","expires_in":"3599"}`))
+		case "/mpesa/b2c/v3/paymentrequest":
+			if got := r.Header.Get("Authorization"); got != "Bearer token" {
+				t.Errorf("unexpected authorization %q", got)
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("decode request: %v", err)
+			}
+			if body["OriginatorConversationID"] == "STL-000002-1" {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"errorCode":"500.003.02","errorMessage":"System is busy"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"ConversationID":"AG_1","OriginatorConversationID":"STL-000001-1","ResponseCode":"0","ResponseDescription":"Accept the service request successfully."}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(config.MpesaConfig{
+		BaseURL:         server.URL,
+		ConsumerKey:     "key",
+		ConsumerSecret:  "secret",
+		ShortCode:       "600000",
+		CallbackBaseURL: "https://treasury.example.com/",
+		CallbackToken:   "s3cret",
+		Timeout:         time.Second,
+	})
+
+	resp, err := client.B2C(context.Background(), Payment{
+		OriginatorConversationID: "STL-000001-1",
+		Amount:                   decimal.RequireFromString("1500"),
+		PartyB:                   "254700000000",
+	})
+	if err != nil {
+		t.Fatalf("b2c: %v", err)
+	}
+	if resp.ConversationID != "AG_1" {
+		t.Fatalf("expected conversation AG_1, got %s", resp.ConversationID)
+	}
+	if body["Amount"] != "1500" || body["PartyA"] != "600000" || body["CommandID"] != CommandBusinessPayment {
+		t.Fatalf("unexpected request %v", body)
+	}
+	if body["ResultURL"] != "https://treasury.example.com/callbacks/mpesa/result?token=s3cret" {
+		t.Fatalf("unexpected result URL %v", body["ResultURL"])
+	}
+
+	_, err = client.B2C(context.Background(), Payment{
+		OriginatorConversationID: "STL-000002-1",
+		Amount:                   decimal.RequireFromString("10"),
+		PartyB:                   "254700000000",
+	})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "500.003.02" {
+		t.Fatalf("expected API error 500.003.02, got %v", err)
+	}
+	if !IsTransient(err) {
+		t.Fatal("expected server error to be transient")
+	}
+	if tokens != 1 {
+		t.Fatalf("expected the token to be cached, fetched %d times", tokens)
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"throttled", &APIError{StatusCode: http.StatusTooManyRequests}, true},
+		{"bad request", &APIError{StatusCode: http.StatusBadRequest, Code: "400.002.02"}, false},
+		{"declined", &APIError{StatusCode: http.StatusOK, Code: "1"}, false},
+		{"deadline", context.DeadlineExceeded, true},
+		{"other", errors.New("boom"), false},
+	}
+
+	for _, tt := range tests {
+		if got := IsTransient(tt.err); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestCallbackResultCode(t *testing.T) {
+	for _, body := range []string{
+		`{"Result":{"ResultType":0,"ResultCode":0,"OriginatorConversationID":"a-1"}}`,
+		`{"Result":{"ResultType":0,"ResultCode":"0","OriginatorConversationID":"a-1"}}`,
+	} {
+		var callback Callback
+		if err := json.Unmarshal([]byte(body), &callback); err != nil {
+			t.Fatalf("decode %s: %v", body, err)
+		}
+		if callback.Result.ResultCode != "0" || callback.Result.OriginatorConversationID != "a-1" {
+			t.Fatalf("unexpected result %+v", callback.Result)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bengobox/treasury-api/internal/config"
+)
+
+// Client stores objects in an S3-compatible bucket (MinIO, AWS S3) using
+// path-style URLs and AWS Signature Version 4.
+type Client struct {
+	client    *http.Client
+	endpoint  string
+	bucket    string
+	region    string
+	accessKey string
+	secretKey string
+	now       func() time.Time
+}
+
+// NewClient creates an object storage client for the configured bucket.
+func NewClient(cfg config.StorageConfig) *Client {
+	return &Client{
+		client:    &http.Client{Timeout: 30 * time.Second},
+		endpoint:  strings.TrimRight(cfg.Endpoint, "/"),
+		bucket:    cfg.Bucket,
+		region:    cfg.Region,
+		accessKey: cfg.AccessKey,
+		secretKey: cfg.SecretKey,
+		now:       time.Now,
+	}
+}
+
+// Bucket returns the bucket objects are stored in.
+func (c *Client) Bucket() string {
+	return c.bucket
+}
+
+// Put uploads body under key, replacing any existing object.
+func (c *Client) Put(ctx context.Context, key, contentType string, body []byte) error {
+	objectURL := fmt.Sprintf("%s/%s/%s", c.endpoint, c.bucket, escapePath(key))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, objectURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("storage put request: %w", err)
+	}
+	req.ContentLength = int64(len(body))
+	req.Header.Set("Content-Type", contentType)
+
+	payloadHash := sha256.Sum256(body)
+	c.sign(req, hex.EncodeToString(payloadHash[:]), c.now())
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("storage put %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("storage put %s: status %d: %s", key, resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
+
+// sign adds the AWS Signature Version 4 authorization headers to req.
+func (c *Client) sign(req *http.Request, payloadHash string, at time.Time) {
+	at = at.UTC()
+	amzDate := at.Format("20060102T150405Z")
+	shortDate := at.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		lower := strings.ToLower(name)
+		if lower == "authorization" || lower == "user-agent" {
+			continue
+		}
+		headers[lower] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := shortDate + "/" + c.region + "/s3/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+c.secretKey), shortDate)
+	signingKey = hmacSHA256(signingKey, c.region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		c.accessKey, scope, signedHeaders, signature))
+}
+
+func canonicalQuery(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, key := range keys {
+		vals := values[key]
+		sort.Strings(vals)
+		for _, val := range vals {
+			parts = append(parts, url.QueryEscape(key)+"="+strings.ReplaceAll(url.QueryEscape(val), "+", "%20"))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// escapePath escapes each segment of an object key, keeping the slashes.
+func escapePath(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,143 @@
+// Package pdf writes simple text documents (statements, remittance advices)
+// as PDF using the standard Helvetica fonts, without external dependencies.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// A4 page size in points.
+const (
+	PageWidth  = 595.0
+	PageHeight = 842.0
+)
+
+// Document is a PDF document under construction.
+type Document struct {
+	pages []*Page
+}
+
+// Page is a page of a document. Coordinates are in points from the
+// bottom-left corner.
+type Page struct {
+	content bytes.Buffer
+}
+
+// New creates an empty document.
+func New() *Document {
+	return &Document{}
+}
+
+// AddPage appends a blank A4 page.
+func (d *Document) AddPage() *Page {
+	page := &Page{}
+	d.pages = append(d.pages, page)
+	return page
+}
+
+// Text draws text with its baseline starting at (x, y).
+func (p *Page) Text(x, y, size float64, bold bool, text string) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	fmt.Fprintf(&p.content, "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, y, escape(text))
+}
+
+// TextRight draws text ending at x, for right-aligned amount columns.
+func (p *Page) TextRight(x, y, size float64, bold bool, text string) {
+	p.Text(x-TextWidth(text, size, bold), y, size, bold, text)
+}
+
+// Line draws a thin line from (x1, y1) to (x2, y2).
+func (p *Page) Line(x1, y1, x2, y2 float64) {
+	fmt.Fprintf(&p.content, "0.5 w %.2f %.2f m %.2f %.2f l S\n", x1, y1, x2, y2)
+}
+
+// Bytes serialises the document.
+func (d *Document) Bytes() []byte {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+
+	var out bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n")
+
+	// Objects 1-4 are the catalog, page tree and fonts; each page then takes
+	// a page object and a content stream.
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			PageWidth, PageHeight, 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.content.Len(), page.content.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return out.Bytes()
+}
+
+// TextWidth approximates the width of text in points. Helvetica digits are
+// 0.556 em; other characters use an average width.
+func TextWidth(text string, size float64, bold bool) float64 {
+	width := 0.0
+	for _, r := range text {
+		switch {
+		case r >= '0' && r <= '9':
+			width += 0.556
+		case r == ' ' || r == '.' || r == ',':
+			width += 0.278
+		case r >= 'A' && r <= 'Z':
+			width += 0.667
+		default:
+			width += 0.5
+		}
+	}
+	if bold {
+		width *= 1.05
+	}
+	return width * size
+}
+
+// escape makes text safe for a PDF string literal. Characters outside
+// Latin-1 are replaced since the standard fonts cannot render them.
+func escape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case r == '\n' || r == '\r' || r == '\t':
+			b.WriteRune(' ')
+		case r < 0x20 || r > 0xff:
+			b.WriteRune('?')
+		case r > 0x7e:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,55 @@
+package pdf
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestDocumentBytes(t *testing.T) {
+	doc := New()
+	page := doc.AddPage()
+	page.Text(40, 800, 14, true, "Statement (May)")
+	page.Line(40, 790, 555, 790)
+	doc.AddPage().TextRight(555, 800, 10, false, "1,234.50")
+
+	out := doc.Bytes()
+	if !bytes.HasPrefix(out, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatal("missing PDF header or trailer")
+	}
+	if !bytes.Contains(out, []byte(`(Statement \(May\)) Tj`)) {
+		t.Fatal("expected parentheses to be escaped")
+	}
+	if !bytes.Contains(out, []byte("/Count 2")) {
+		t.Fatal("expected two pages")
+	}
+
+	text := string(out)
+	idx := strings.LastIndex(text, "startxref\n")
+	offset, err := strconv.Atoi(strings.Fields(text[idx+len("startxref\n"):])[0])
+	if err != nil {
+		t.Fatalf("parse startxref: %v", err)
+	}
+	if !strings.HasPrefix(text[offset:], "xref\n") {
+		t.Fatalf("startxref %d does not point at the xref table", offset)
+	}
+
+	// Every xref entry must point at the start of its object.
+	entries := strings.Split(text[offset:], "\n")[3:]
+	for i := 1; i <= 8; i++ {
+		objOffset, _ := strconv.Atoi(entries[i-1][:10])
+		if want := strconv.Itoa(i) + " 0 obj"; !strings.HasPrefix(text[objOffset:], want) {
+			t.Fatalf("xref entry %d points at %q", i, text[objOffset:objOffset+10])
+		}
+	}
+}
+
+func TestEscape(t *testing.T) {
+	if got := escape(`a\b`); got != `a\\b` {
+		t.Fatalf("unexpected escape %q", got)
+	}
+	if got := escape("Café ✓"); got != `Caf\351 ?` {
+		t.Fatalf("unexpected escape %q", got)
+	}
+}
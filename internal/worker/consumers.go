@@ -0,0 +1,63 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/platform/events"
+)
+
+const (
+	consumerMaxDeliver = 10
+	consumerRetryDelay = 30 * time.Second
+)
+
+// Consumer handles one inbound event subject through a durable JetStream
+// queue subscription shared by all worker replicas.
+type Consumer struct {
+	// Durable is the JetStream consumer name; it must be stable across deploys.
+	Durable string
+	Subject string
+	Handle  func(ctx context.Context, env events.Envelope) error
+}
+
+// subscribe binds the consumer. Handled messages are acked, permanent failures
+// terminated and anything else redelivered after a delay.
+func subscribe(ctx context.Context, js nats.JetStreamContext, group string, log *zap.Logger, consumer Consumer) (*nats.Subscription, error) {
+	return js.QueueSubscribe(consumer.Subject, group, func(msg *nats.Msg) {
+		env, err := events.Decode(msg.Data)
+		if err == nil {
+			err = consumer.Handle(ctx, env)
+		}
+
+		switch {
+		case err == nil:
+			if aerr := msg.Ack(); aerr != nil {
+				log.Warn("event ack failed", zap.String("subject", msg.Subject), zap.Error(aerr))
+			}
+		case events.IsPermanent(err):
+			log.Warn("event rejected",
+				zap.String("subject", msg.Subject),
+				zap.String("event_id", env.EventID),
+				zap.Error(err),
+			)
+			_ = msg.Term()
+		default:
+			log.Error("event handling failed",
+				zap.String("subject", msg.Subject),
+				zap.String("event_id", env.EventID),
+				zap.Error(err),
+			)
+			_ = msg.NakWithDelay(consumerRetryDelay)
+		}
+	},
+		nats.Durable(consumer.Durable),
+		nats.ManualAck(),
+		nats.AckExplicit(),
+		nats.MaxDeliver(consumerMaxDeliver),
+		nats.DeliverAll(),
+	)
+}
@@ -0,0 +1,44 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Job is a task the worker runs on a fixed interval.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// runJobs runs every job on its own ticker until ctx is cancelled. A job runs
+// once at startup and is never run concurrently with itself.
+func runJobs(ctx context.Context, log *zap.Logger, jobs []Job) {
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		wg.Add(1)
+		go func(job Job) {
+			defer wg.Done()
+
+			ticker := time.NewTicker(job.Interval)
+			defer ticker.Stop()
+
+			for {
+				if err := job.Run(ctx); err != nil && ctx.Err() == nil {
+					log.Error("worker job failed", zap.String("job", job.Name), zap.Error(err))
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}(job)
+	}
+	wg.Wait()
+}
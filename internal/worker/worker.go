@@ -0,0 +1,170 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/bengobox/treasury-api/internal/config"
+	"github.com/bengobox/treasury-api/internal/ent"
+	"github.com/bengobox/treasury-api/internal/modules/aging"
+	"github.com/bengobox/treasury-api/internal/modules/baddebts"
+	"github.com/bengobox/treasury-api/internal/modules/bills"
+	"github.com/bengobox/treasury-api/internal/modules/cashdrawers"
+	"github.com/bengobox/treasury-api/internal/modules/credit"
+	"github.com/bengobox/treasury-api/internal/modules/customers"
+	"github.com/bengobox/treasury-api/internal/modules/dunning"
+	"github.com/bengobox/treasury-api/internal/modules/earnings"
+	"github.com/bengobox/treasury-api/internal/modules/invoicing"
+	"github.com/bengobox/treasury-api/internal/modules/metering"
+	"github.com/bengobox/treasury-api/internal/modules/outbox"
+	"github.com/bengobox/treasury-api/internal/modules/receivables"
+	"github.com/bengobox/treasury-api/internal/modules/settlements"
+	"github.com/bengobox/treasury-api/internal/modules/statements"
+	"github.com/bengobox/treasury-api/internal/modules/subscriptions"
+	"github.com/bengobox/treasury-api/internal/modules/vendors"
+	"github.com/bengobox/treasury-api/internal/modules/withholding"
+	"github.com/bengobox/treasury-api/internal/platform/database"
+	"github.com/bengobox/treasury-api/internal/platform/events"
+	"github.com/bengobox/treasury-api/internal/platform/mpesa"
+	"github.com/bengobox/treasury-api/internal/platform/storage"
+	"github.com/bengobox/treasury-api/internal/shared/logger"
+)
+
+// Worker runs the treasury background jobs (outbox relay, scheduled billing,
+// receipts, dunning, statements, provisioning, settlements and their payouts)
+// and the event consumers.
+type Worker struct {
+	cfg       *config.Config
+	log       *zap.Logger
+	ent       *ent.Client
+	events    *nats.Conn
+	js        nats.JetStreamContext
+	jobs      []Job
+	consumers []Consumer
+}
+
+// New wires the worker's dependencies and jobs.
+func New(ctx context.Context) (*Worker, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	log, err := logger.New(cfg.App.Env)
+	if err != nil {
+		return nil, fmt.Errorf("logger init: %w", err)
+	}
+
+	entClient, err := database.NewEntClient(cfg.Postgres)
+	if err != nil {
+		return nil, fmt.Errorf("ent init: %w", err)
+	}
+
+	natsConn, err := events.Connect(cfg.Events)
+	if err != nil {
+		return nil, fmt.Errorf("event bus connection: %w", err)
+	}
+
+	if err := events.EnsureStream(ctx, natsConn, cfg.Events); err != nil {
+		return nil, fmt.Errorf("ensure stream: %w", err)
+	}
+
+	js, err := natsConn.JetStream()
+	if err != nil {
+		return nil, fmt.Errorf("jetstream init: %w", err)
+	}
+
+	relay := outbox.NewRelay(entClient, js, log)
+	subscriptionsService := subscriptions.NewService(subscriptions.NewEntRepository(entClient), log)
+	meteringService := metering.NewService(metering.NewEntRepository(entClient), log)
+	customersService := customers.NewService(customers.NewEntRepository(entClient), log)
+	creditService := credit.NewService(credit.NewEntRepository(entClient), customersService, log)
+	invoicingService := invoicing.NewService(invoicing.NewEntRepository(entClient), creditService, log)
+	dunningService := dunning.NewService(dunning.NewEntRepository(entClient), log)
+	agingService := aging.NewService(aging.NewEntRepository(entClient), log)
+	statementsService := statements.NewService(statements.NewEntRepository(entClient), customersService, agingService, storage.NewClient(cfg.Storage), log)
+	badDebtsService := baddebts.NewService(baddebts.NewEntRepository(entClient), agingService, log)
+	vendorsService := vendors.NewService(vendors.NewEntRepository(entClient), log)
+	withholdingService := withholding.NewService(withholding.NewEntRepository(entClient), log)
+	billsService := bills.NewService(bills.NewEntRepository(entClient), vendorsService, withholdingService, log)
+	settlementsService := settlements.NewService(settlements.NewEntRepository(entClient), mpesa.NewClient(cfg.Mpesa), log)
+	earningsService := earnings.NewService(earnings.NewEntRepository(entClient), log)
+	cashDrawersService := cashdrawers.NewService(cashdrawers.NewEntRepository(entClient), log)
+	receivablesService := receivables.NewService(receivables.NewEntRepository(entClient), log)
+
+	jobs := []Job{
+		{Name: "outbox-relay", Interval: cfg.Worker.OutboxInterval, Run: relay.PublishPending},
+		{Name: "subscription-billing", Interval: cfg.Worker.BillingInterval, Run: subscriptionsService.RunBilling},
+		{Name: "dunning", Interval: cfg.Worker.DunningInterval, Run: dunningService.RunDunning},
+		{Name: "payment-receipts", Interval: cfg.Worker.ReceiptInterval, Run: receivablesService.RunReceipts},
+		{Name: "month-end-statements", Interval: cfg.Worker.StatementInterval, Run: statementsService.RunMonthEnd},
+		{Name: "doubtful-debt-provisioning", Interval: cfg.Worker.ProvisionInterval, Run: badDebtsService.RunMonthEnd},
+		{Name: "credit-holds", Interval: cfg.Worker.CreditHoldInterval, Run: creditService.RunCreditHolds},
+		{Name: "settlement-aggregation", Interval: cfg.Worker.SettlementInterval, Run: settlementsService.RunDaily},
+		{Name: "settlement-payouts", Interval: cfg.Worker.DisbursementInterval, Run: settlementsService.RunPayouts},
+	}
+
+	consumers := []Consumer{
+		{Durable: "treasury-usage-metered", Subject: metering.EventUsageMetered, Handle: meteringService.HandleUsageEvent},
+		{Durable: "treasury-order-invoicing", Subject: invoicing.EventOrderCreated, Handle: invoicingService.HandleOrderCreated},
+		{Durable: "treasury-milestone-invoicing", Subject: invoicing.EventMilestoneCompleted, Handle: invoicingService.HandleMilestoneCompleted},
+		{Durable: "treasury-po-receipts", Subject: bills.EventPOReceived, Handle: billsService.HandlePOReceived},
+		{Durable: "treasury-settlement-execute", Subject: settlements.EventSettlementExecute, Handle: settlementsService.HandleExecute},
+		{Durable: "treasury-earnings-calculated", Subject: earnings.EventEarningsCalculated, Handle: earningsService.HandleEarningsCalculated},
+		{Durable: "treasury-payout-requested", Subject: earnings.EventPayoutRequested, Handle: earningsService.HandlePayoutRequested},
+		{Durable: "treasury-cash-drawer-closed", Subject: cashdrawers.EventDrawerClosed, Handle: cashDrawersService.HandleDrawerClosed},
+	}
+
+	return &Worker{
+		cfg:       cfg,
+		log:       log,
+		ent:       entClient,
+		events:    natsConn,
+		js:        js,
+		jobs:      jobs,
+		consumers: consumers,
+	}, nil
+}
+
+// Run subscribes the event consumers and blocks running the jobs until ctx is
+// cancelled. A consumer whose subject has no stream yet (the publishing
+// service has not created it) is skipped with a warning.
+func (w *Worker) Run(ctx context.Context) error {
+	for _, consumer := range w.consumers {
+		if _, err := subscribe(ctx, w.js, w.cfg.Events.DeliverGroup, w.log, consumer); err != nil {
+			w.log.Warn("event consumer not started",
+				zap.String("subject", consumer.Subject),
+				zap.Error(err),
+			)
+		}
+	}
+
+	w.log.Info("treasury worker starting",
+		zap.Int("jobs", len(w.jobs)),
+		zap.Int("consumers", len(w.consumers)),
+	)
+	runJobs(ctx, w.log, w.jobs)
+	w.log.Info("treasury worker stopped")
+	return nil
+}
+
+// Close releases the worker's connections.
+func (w *Worker) Close() {
+	if w.events != nil {
+		if err := w.events.Drain(); err != nil {
+			w.log.Warn("nats drain failed", zap.Error(err))
+		}
+		w.events.Close()
+	}
+
+	if w.ent != nil {
+		if err := w.ent.Close(); err != nil {
+			w.log.Warn("ent close failed", zap.Error(err))
+		}
+	}
+
+	_ = w.log.Sync()
+}